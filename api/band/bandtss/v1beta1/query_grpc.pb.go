@@ -26,6 +26,8 @@ const (
 	Query_IncomingGroup_FullMethodName   = "/band.bandtss.v1beta1.Query/IncomingGroup"
 	Query_Signing_FullMethodName         = "/band.bandtss.v1beta1.Query/Signing"
 	Query_GroupTransition_FullMethodName = "/band.bandtss.v1beta1.Query/GroupTransition"
+	Query_NamedGroup_FullMethodName      = "/band.bandtss.v1beta1.Query/NamedGroup"
+	Query_NamedGroups_FullMethodName     = "/band.bandtss.v1beta1.Query/NamedGroups"
 	Query_Params_FullMethodName          = "/band.bandtss.v1beta1.Query/Params"
 )
 
@@ -47,6 +49,10 @@ type QueryClient interface {
 	Signing(ctx context.Context, in *QuerySigningRequest, opts ...grpc.CallOption) (*QuerySigningResponse, error)
 	// GroupTransition queries the group transition information.
 	GroupTransition(ctx context.Context, in *QueryGroupTransitionRequest, opts ...grpc.CallOption) (*QueryGroupTransitionResponse, error)
+	// NamedGroup queries a named group by its name.
+	NamedGroup(ctx context.Context, in *QueryNamedGroupRequest, opts ...grpc.CallOption) (*QueryNamedGroupResponse, error)
+	// NamedGroups queries all named groups.
+	NamedGroups(ctx context.Context, in *QueryNamedGroupsRequest, opts ...grpc.CallOption) (*QueryNamedGroupsResponse, error)
 	// Params queries parameters of bandtss module
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -122,6 +128,24 @@ func (c *queryClient) GroupTransition(ctx context.Context, in *QueryGroupTransit
 	return out, nil
 }
 
+func (c *queryClient) NamedGroup(ctx context.Context, in *QueryNamedGroupRequest, opts ...grpc.CallOption) (*QueryNamedGroupResponse, error) {
+	out := new(QueryNamedGroupResponse)
+	err := c.cc.Invoke(ctx, Query_NamedGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NamedGroups(ctx context.Context, in *QueryNamedGroupsRequest, opts ...grpc.CallOption) (*QueryNamedGroupsResponse, error) {
+	out := new(QueryNamedGroupsResponse)
+	err := c.cc.Invoke(ctx, Query_NamedGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
@@ -149,6 +173,10 @@ type QueryServer interface {
 	Signing(context.Context, *QuerySigningRequest) (*QuerySigningResponse, error)
 	// GroupTransition queries the group transition information.
 	GroupTransition(context.Context, *QueryGroupTransitionRequest) (*QueryGroupTransitionResponse, error)
+	// NamedGroup queries a named group by its name.
+	NamedGroup(context.Context, *QueryNamedGroupRequest) (*QueryNamedGroupResponse, error)
+	// NamedGroups queries all named groups.
+	NamedGroups(context.Context, *QueryNamedGroupsRequest) (*QueryNamedGroupsResponse, error)
 	// Params queries parameters of bandtss module
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -179,6 +207,12 @@ func (UnimplementedQueryServer) Signing(context.Context, *QuerySigningRequest) (
 func (UnimplementedQueryServer) GroupTransition(context.Context, *QueryGroupTransitionRequest) (*QueryGroupTransitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GroupTransition not implemented")
 }
+func (UnimplementedQueryServer) NamedGroup(context.Context, *QueryNamedGroupRequest) (*QueryNamedGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamedGroup not implemented")
+}
+func (UnimplementedQueryServer) NamedGroups(context.Context, *QueryNamedGroupsRequest) (*QueryNamedGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamedGroups not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -321,6 +355,42 @@ func _Query_GroupTransition_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NamedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamedGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamedGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NamedGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamedGroup(ctx, req.(*QueryNamedGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NamedGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamedGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamedGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NamedGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamedGroups(ctx, req.(*QueryNamedGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -374,6 +444,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GroupTransition",
 			Handler:    _Query_GroupTransition_Handler,
 		},
+		{
+			MethodName: "NamedGroup",
+			Handler:    _Query_NamedGroup_Handler,
+		},
+		{
+			MethodName: "NamedGroups",
+			Handler:    _Query_NamedGroups_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
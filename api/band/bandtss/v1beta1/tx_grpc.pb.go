@@ -24,6 +24,9 @@ const (
 	Msg_UpdateParams_FullMethodName         = "/band.bandtss.v1beta1.Msg/UpdateParams"
 	Msg_TransitionGroup_FullMethodName      = "/band.bandtss.v1beta1.Msg/TransitionGroup"
 	Msg_ForceTransitionGroup_FullMethodName = "/band.bandtss.v1beta1.Msg/ForceTransitionGroup"
+	Msg_CreateNamedGroup_FullMethodName     = "/band.bandtss.v1beta1.Msg/CreateNamedGroup"
+	Msg_UpdateNamedGroup_FullMethodName     = "/band.bandtss.v1beta1.Msg/UpdateNamedGroup"
+	Msg_RemoveNamedGroup_FullMethodName     = "/band.bandtss.v1beta1.Msg/RemoveNamedGroup"
 )
 
 // MsgClient is the client API for Msg service.
@@ -41,6 +44,12 @@ type MsgClient interface {
 	// ForceTransitionGroup sets the given group to the incoming group without the signature of a transition
 	// message from a current group.
 	ForceTransitionGroup(ctx context.Context, in *MsgForceTransitionGroup, opts ...grpc.CallOption) (*MsgForceTransitionGroupResponse, error)
+	// CreateNamedGroup creates a new named group that signs requests alongside the current group.
+	CreateNamedGroup(ctx context.Context, in *MsgCreateNamedGroup, opts ...grpc.CallOption) (*MsgCreateNamedGroupResponse, error)
+	// UpdateNamedGroup updates the fee per signer of a named group.
+	UpdateNamedGroup(ctx context.Context, in *MsgUpdateNamedGroup, opts ...grpc.CallOption) (*MsgUpdateNamedGroupResponse, error)
+	// RemoveNamedGroup removes a named group from the module.
+	RemoveNamedGroup(ctx context.Context, in *MsgRemoveNamedGroup, opts ...grpc.CallOption) (*MsgRemoveNamedGroupResponse, error)
 }
 
 type msgClient struct {
@@ -96,6 +105,33 @@ func (c *msgClient) ForceTransitionGroup(ctx context.Context, in *MsgForceTransi
 	return out, nil
 }
 
+func (c *msgClient) CreateNamedGroup(ctx context.Context, in *MsgCreateNamedGroup, opts ...grpc.CallOption) (*MsgCreateNamedGroupResponse, error) {
+	out := new(MsgCreateNamedGroupResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateNamedGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateNamedGroup(ctx context.Context, in *MsgUpdateNamedGroup, opts ...grpc.CallOption) (*MsgUpdateNamedGroupResponse, error) {
+	out := new(MsgUpdateNamedGroupResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateNamedGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveNamedGroup(ctx context.Context, in *MsgRemoveNamedGroup, opts ...grpc.CallOption) (*MsgRemoveNamedGroupResponse, error) {
+	out := new(MsgRemoveNamedGroupResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveNamedGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -111,6 +147,12 @@ type MsgServer interface {
 	// ForceTransitionGroup sets the given group to the incoming group without the signature of a transition
 	// message from a current group.
 	ForceTransitionGroup(context.Context, *MsgForceTransitionGroup) (*MsgForceTransitionGroupResponse, error)
+	// CreateNamedGroup creates a new named group that signs requests alongside the current group.
+	CreateNamedGroup(context.Context, *MsgCreateNamedGroup) (*MsgCreateNamedGroupResponse, error)
+	// UpdateNamedGroup updates the fee per signer of a named group.
+	UpdateNamedGroup(context.Context, *MsgUpdateNamedGroup) (*MsgUpdateNamedGroupResponse, error)
+	// RemoveNamedGroup removes a named group from the module.
+	RemoveNamedGroup(context.Context, *MsgRemoveNamedGroup) (*MsgRemoveNamedGroupResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -133,6 +175,15 @@ func (UnimplementedMsgServer) TransitionGroup(context.Context, *MsgTransitionGro
 func (UnimplementedMsgServer) ForceTransitionGroup(context.Context, *MsgForceTransitionGroup) (*MsgForceTransitionGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceTransitionGroup not implemented")
 }
+func (UnimplementedMsgServer) CreateNamedGroup(context.Context, *MsgCreateNamedGroup) (*MsgCreateNamedGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNamedGroup not implemented")
+}
+func (UnimplementedMsgServer) UpdateNamedGroup(context.Context, *MsgUpdateNamedGroup) (*MsgUpdateNamedGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamedGroup not implemented")
+}
+func (UnimplementedMsgServer) RemoveNamedGroup(context.Context, *MsgRemoveNamedGroup) (*MsgRemoveNamedGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveNamedGroup not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -236,6 +287,60 @@ func _Msg_ForceTransitionGroup_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateNamedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateNamedGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateNamedGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateNamedGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateNamedGroup(ctx, req.(*MsgCreateNamedGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateNamedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNamedGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNamedGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateNamedGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNamedGroup(ctx, req.(*MsgUpdateNamedGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveNamedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveNamedGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveNamedGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveNamedGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveNamedGroup(ctx, req.(*MsgRemoveNamedGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -263,6 +368,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForceTransitionGroup",
 			Handler:    _Msg_ForceTransitionGroup_Handler,
 		},
+		{
+			MethodName: "CreateNamedGroup",
+			Handler:    _Msg_CreateNamedGroup_Handler,
+		},
+		{
+			MethodName: "UpdateNamedGroup",
+			Handler:    _Msg_UpdateNamedGroup_Handler,
+		},
+		{
+			MethodName: "RemoveNamedGroup",
+			Handler:    _Msg_RemoveNamedGroup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/bandtss/v1beta1/tx.proto",
@@ -3,7 +3,9 @@ package oraclev1
 
 import (
 	fmt "fmt"
+	v1beta1 "github.com/bandprotocol/chain/v3/api/band/feeds/v1beta1"
 	v1 "github.com/bandprotocol/chain/v3/api/band/oracle/v1"
+	v1beta11 "github.com/bandprotocol/chain/v3/api/band/tunnel/v1beta1"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -2808,28 +2810,74 @@ func (x *fastReflection_RequestCountProofResponse) ProtoMethods() *protoiface.Me
 	}
 }
 
+var _ protoreflect.List = (*_PriceProofRequest_1_list)(nil)
+
+type _PriceProofRequest_1_list struct {
+	list *[]string
+}
+
+func (x *_PriceProofRequest_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PriceProofRequest_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_PriceProofRequest_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PriceProofRequest_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PriceProofRequest_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PriceProofRequest at list field SignalIds as it is not of Message kind"))
+}
+
+func (x *_PriceProofRequest_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PriceProofRequest_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_PriceProofRequest_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_SingleProofResult                 protoreflect.MessageDescriptor
-	fd_SingleProofResult_proof           protoreflect.FieldDescriptor
-	fd_SingleProofResult_evm_proof_bytes protoreflect.FieldDescriptor
+	md_PriceProofRequest            protoreflect.MessageDescriptor
+	fd_PriceProofRequest_signal_ids protoreflect.FieldDescriptor
+	fd_PriceProofRequest_height     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_SingleProofResult = File_band_base_oracle_v1_proof_proto.Messages().ByName("SingleProofResult")
-	fd_SingleProofResult_proof = md_SingleProofResult.Fields().ByName("proof")
-	fd_SingleProofResult_evm_proof_bytes = md_SingleProofResult.Fields().ByName("evm_proof_bytes")
+	md_PriceProofRequest = File_band_base_oracle_v1_proof_proto.Messages().ByName("PriceProofRequest")
+	fd_PriceProofRequest_signal_ids = md_PriceProofRequest.Fields().ByName("signal_ids")
+	fd_PriceProofRequest_height = md_PriceProofRequest.Fields().ByName("height")
 }
 
-var _ protoreflect.Message = (*fastReflection_SingleProofResult)(nil)
+var _ protoreflect.Message = (*fastReflection_PriceProofRequest)(nil)
 
-type fastReflection_SingleProofResult SingleProofResult
+type fastReflection_PriceProofRequest PriceProofRequest
 
-func (x *SingleProofResult) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SingleProofResult)(x)
+func (x *PriceProofRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceProofRequest)(x)
 }
 
-func (x *SingleProofResult) slowProtoReflect() protoreflect.Message {
+func (x *PriceProofRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2841,43 +2889,43 @@ func (x *SingleProofResult) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SingleProofResult_messageType fastReflection_SingleProofResult_messageType
-var _ protoreflect.MessageType = fastReflection_SingleProofResult_messageType{}
+var _fastReflection_PriceProofRequest_messageType fastReflection_PriceProofRequest_messageType
+var _ protoreflect.MessageType = fastReflection_PriceProofRequest_messageType{}
 
-type fastReflection_SingleProofResult_messageType struct{}
+type fastReflection_PriceProofRequest_messageType struct{}
 
-func (x fastReflection_SingleProofResult_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SingleProofResult)(nil)
+func (x fastReflection_PriceProofRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceProofRequest)(nil)
 }
-func (x fastReflection_SingleProofResult_messageType) New() protoreflect.Message {
-	return new(fastReflection_SingleProofResult)
+func (x fastReflection_PriceProofRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceProofRequest)
 }
-func (x fastReflection_SingleProofResult_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SingleProofResult
+func (x fastReflection_PriceProofRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceProofRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SingleProofResult) Descriptor() protoreflect.MessageDescriptor {
-	return md_SingleProofResult
+func (x *fastReflection_PriceProofRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceProofRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SingleProofResult) Type() protoreflect.MessageType {
-	return _fastReflection_SingleProofResult_messageType
+func (x *fastReflection_PriceProofRequest) Type() protoreflect.MessageType {
+	return _fastReflection_PriceProofRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SingleProofResult) New() protoreflect.Message {
-	return new(fastReflection_SingleProofResult)
+func (x *fastReflection_PriceProofRequest) New() protoreflect.Message {
+	return new(fastReflection_PriceProofRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SingleProofResult) Interface() protoreflect.ProtoMessage {
-	return (*SingleProofResult)(x)
+func (x *fastReflection_PriceProofRequest) Interface() protoreflect.ProtoMessage {
+	return (*PriceProofRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2885,16 +2933,16 @@ func (x *fastReflection_SingleProofResult) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SingleProofResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Proof != nil {
-		value := protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
-		if !f(fd_SingleProofResult_proof, value) {
+func (x *fastReflection_PriceProofRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.SignalIds) != 0 {
+		value := protoreflect.ValueOfList(&_PriceProofRequest_1_list{list: &x.SignalIds})
+		if !f(fd_PriceProofRequest_signal_ids, value) {
 			return
 		}
 	}
-	if len(x.EvmProofBytes) != 0 {
-		value := protoreflect.ValueOfBytes(x.EvmProofBytes)
-		if !f(fd_SingleProofResult_evm_proof_bytes, value) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_PriceProofRequest_height, value) {
 			return
 		}
 	}
@@ -2911,17 +2959,17 @@ func (x *fastReflection_SingleProofResult) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SingleProofResult) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PriceProofRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.SingleProofResult.proof":
-		return x.Proof != nil
-	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
-		return len(x.EvmProofBytes) != 0
+	case "band.base.oracle.v1.PriceProofRequest.signal_ids":
+		return len(x.SignalIds) != 0
+	case "band.base.oracle.v1.PriceProofRequest.height":
+		return x.Height != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProofRequest"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2931,17 +2979,17 @@ func (x *fastReflection_SingleProofResult) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SingleProofResult) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PriceProofRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.SingleProofResult.proof":
-		x.Proof = nil
-	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
-		x.EvmProofBytes = nil
+	case "band.base.oracle.v1.PriceProofRequest.signal_ids":
+		x.SignalIds = nil
+	case "band.base.oracle.v1.PriceProofRequest.height":
+		x.Height = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProofRequest"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2951,19 +2999,22 @@ func (x *fastReflection_SingleProofResult) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SingleProofResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceProofRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.SingleProofResult.proof":
-		value := x.Proof
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
-		value := x.EvmProofBytes
-		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.PriceProofRequest.signal_ids":
+		if len(x.SignalIds) == 0 {
+			return protoreflect.ValueOfList(&_PriceProofRequest_1_list{})
+		}
+		listValue := &_PriceProofRequest_1_list{list: &x.SignalIds}
+		return protoreflect.ValueOfList(listValue)
+	case "band.base.oracle.v1.PriceProofRequest.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProofRequest"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProofRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2977,17 +3028,19 @@ func (x *fastReflection_SingleProofResult) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SingleProofResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PriceProofRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.SingleProofResult.proof":
-		x.Proof = value.Message().Interface().(*SingleProof)
-	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
-		x.EvmProofBytes = value.Bytes()
+	case "band.base.oracle.v1.PriceProofRequest.signal_ids":
+		lv := value.List()
+		clv := lv.(*_PriceProofRequest_1_list)
+		x.SignalIds = *clv.list
+	case "band.base.oracle.v1.PriceProofRequest.height":
+		x.Height = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProofRequest"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3001,48 +3054,49 @@ func (x *fastReflection_SingleProofResult) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SingleProofResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceProofRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.SingleProofResult.proof":
-		if x.Proof == nil {
-			x.Proof = new(SingleProof)
+	case "band.base.oracle.v1.PriceProofRequest.signal_ids":
+		if x.SignalIds == nil {
+			x.SignalIds = []string{}
 		}
-		return protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
-	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
-		panic(fmt.Errorf("field evm_proof_bytes of message band.base.oracle.v1.SingleProofResult is not mutable"))
+		value := &_PriceProofRequest_1_list{list: &x.SignalIds}
+		return protoreflect.ValueOfList(value)
+	case "band.base.oracle.v1.PriceProofRequest.height":
+		panic(fmt.Errorf("field height of message band.base.oracle.v1.PriceProofRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProofRequest"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SingleProofResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceProofRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.SingleProofResult.proof":
-		m := new(SingleProof)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
-		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.PriceProofRequest.signal_ids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_PriceProofRequest_1_list{list: &list})
+	case "band.base.oracle.v1.PriceProofRequest.height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProofRequest"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SingleProofResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PriceProofRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.SingleProofResult", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.PriceProofRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3050,7 +3104,7 @@ func (x *fastReflection_SingleProofResult) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SingleProofResult) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PriceProofRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3061,7 +3115,7 @@ func (x *fastReflection_SingleProofResult) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SingleProofResult) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PriceProofRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3073,7 +3127,7 @@ func (x *fastReflection_SingleProofResult) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SingleProofResult) IsValid() bool {
+func (x *fastReflection_PriceProofRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3083,9 +3137,9 @@ func (x *fastReflection_SingleProofResult) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SingleProofResult) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PriceProofRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SingleProofResult)
+		x := input.Message.Interface().(*PriceProofRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3097,13 +3151,14 @@ func (x *fastReflection_SingleProofResult) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Proof != nil {
-			l = options.Size(x.Proof)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.SignalIds) > 0 {
+			for _, s := range x.SignalIds {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.EvmProofBytes)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3115,7 +3170,7 @@ func (x *fastReflection_SingleProofResult) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SingleProofResult)
+		x := input.Message.Interface().(*PriceProofRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3134,26 +3189,19 @@ func (x *fastReflection_SingleProofResult) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.EvmProofBytes) > 0 {
-			i -= len(x.EvmProofBytes)
-			copy(dAtA[i:], x.EvmProofBytes)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvmProofBytes)))
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if x.Proof != nil {
-			encoded, err := options.Marshal(x.Proof)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.SignalIds) > 0 {
+			for iNdEx := len(x.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.SignalIds[iNdEx])
+				copy(dAtA[i:], x.SignalIds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalIds[iNdEx])))
+				i--
+				dAtA[i] = 0xa
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3166,7 +3214,7 @@ func (x *fastReflection_SingleProofResult) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SingleProofResult)
+		x := input.Message.Interface().(*PriceProofRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3198,17 +3246,17 @@ func (x *fastReflection_SingleProofResult) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SingleProofResult: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceProofRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SingleProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3218,33 +3266,29 @@ func (x *fastReflection_SingleProofResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Proof == nil {
-					x.Proof = &SingleProof{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proof); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.SignalIds = append(x.SignalIds, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				var byteLen int
+				x.Height = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3254,26 +3298,11 @@ func (x *fastReflection_SingleProofResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					x.Height |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.EvmProofBytes = append(x.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
-				if x.EvmProofBytes == nil {
-					x.EvmProofBytes = []byte{}
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3310,27 +3339,27 @@ func (x *fastReflection_SingleProofResult) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MultiProofResult                 protoreflect.MessageDescriptor
-	fd_MultiProofResult_proof           protoreflect.FieldDescriptor
-	fd_MultiProofResult_evm_proof_bytes protoreflect.FieldDescriptor
+	md_PriceProofResponse        protoreflect.MessageDescriptor
+	fd_PriceProofResponse_height protoreflect.FieldDescriptor
+	fd_PriceProofResponse_result protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_MultiProofResult = File_band_base_oracle_v1_proof_proto.Messages().ByName("MultiProofResult")
-	fd_MultiProofResult_proof = md_MultiProofResult.Fields().ByName("proof")
-	fd_MultiProofResult_evm_proof_bytes = md_MultiProofResult.Fields().ByName("evm_proof_bytes")
+	md_PriceProofResponse = File_band_base_oracle_v1_proof_proto.Messages().ByName("PriceProofResponse")
+	fd_PriceProofResponse_height = md_PriceProofResponse.Fields().ByName("height")
+	fd_PriceProofResponse_result = md_PriceProofResponse.Fields().ByName("result")
 }
 
-var _ protoreflect.Message = (*fastReflection_MultiProofResult)(nil)
+var _ protoreflect.Message = (*fastReflection_PriceProofResponse)(nil)
 
-type fastReflection_MultiProofResult MultiProofResult
+type fastReflection_PriceProofResponse PriceProofResponse
 
-func (x *MultiProofResult) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MultiProofResult)(x)
+func (x *PriceProofResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceProofResponse)(x)
 }
 
-func (x *MultiProofResult) slowProtoReflect() protoreflect.Message {
+func (x *PriceProofResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3342,43 +3371,43 @@ func (x *MultiProofResult) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MultiProofResult_messageType fastReflection_MultiProofResult_messageType
-var _ protoreflect.MessageType = fastReflection_MultiProofResult_messageType{}
+var _fastReflection_PriceProofResponse_messageType fastReflection_PriceProofResponse_messageType
+var _ protoreflect.MessageType = fastReflection_PriceProofResponse_messageType{}
 
-type fastReflection_MultiProofResult_messageType struct{}
+type fastReflection_PriceProofResponse_messageType struct{}
 
-func (x fastReflection_MultiProofResult_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MultiProofResult)(nil)
+func (x fastReflection_PriceProofResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceProofResponse)(nil)
 }
-func (x fastReflection_MultiProofResult_messageType) New() protoreflect.Message {
-	return new(fastReflection_MultiProofResult)
+func (x fastReflection_PriceProofResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceProofResponse)
 }
-func (x fastReflection_MultiProofResult_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MultiProofResult
+func (x fastReflection_PriceProofResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceProofResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MultiProofResult) Descriptor() protoreflect.MessageDescriptor {
-	return md_MultiProofResult
+func (x *fastReflection_PriceProofResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceProofResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MultiProofResult) Type() protoreflect.MessageType {
-	return _fastReflection_MultiProofResult_messageType
+func (x *fastReflection_PriceProofResponse) Type() protoreflect.MessageType {
+	return _fastReflection_PriceProofResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MultiProofResult) New() protoreflect.Message {
-	return new(fastReflection_MultiProofResult)
+func (x *fastReflection_PriceProofResponse) New() protoreflect.Message {
+	return new(fastReflection_PriceProofResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MultiProofResult) Interface() protoreflect.ProtoMessage {
-	return (*MultiProofResult)(x)
+func (x *fastReflection_PriceProofResponse) Interface() protoreflect.ProtoMessage {
+	return (*PriceProofResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3386,16 +3415,16 @@ func (x *fastReflection_MultiProofResult) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MultiProofResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Proof != nil {
-		value := protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
-		if !f(fd_MultiProofResult_proof, value) {
+func (x *fastReflection_PriceProofResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_PriceProofResponse_height, value) {
 			return
 		}
 	}
-	if len(x.EvmProofBytes) != 0 {
-		value := protoreflect.ValueOfBytes(x.EvmProofBytes)
-		if !f(fd_MultiProofResult_evm_proof_bytes, value) {
+	if x.Result != nil {
+		value := protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+		if !f(fd_PriceProofResponse_result, value) {
 			return
 		}
 	}
@@ -3412,17 +3441,17 @@ func (x *fastReflection_MultiProofResult) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MultiProofResult) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PriceProofResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiProofResult.proof":
-		return x.Proof != nil
-	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
-		return len(x.EvmProofBytes) != 0
+	case "band.base.oracle.v1.PriceProofResponse.height":
+		return x.Height != int64(0)
+	case "band.base.oracle.v1.PriceProofResponse.result":
+		return x.Result != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProofResponse"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3432,17 +3461,17 @@ func (x *fastReflection_MultiProofResult) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiProofResult) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PriceProofResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiProofResult.proof":
-		x.Proof = nil
-	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
-		x.EvmProofBytes = nil
+	case "band.base.oracle.v1.PriceProofResponse.height":
+		x.Height = int64(0)
+	case "band.base.oracle.v1.PriceProofResponse.result":
+		x.Result = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProofResponse"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3452,19 +3481,19 @@ func (x *fastReflection_MultiProofResult) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MultiProofResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceProofResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.MultiProofResult.proof":
-		value := x.Proof
+	case "band.base.oracle.v1.PriceProofResponse.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "band.base.oracle.v1.PriceProofResponse.result":
+		value := x.Result
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
-		value := x.EvmProofBytes
-		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProofResponse"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProofResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3478,17 +3507,17 @@ func (x *fastReflection_MultiProofResult) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiProofResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PriceProofResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiProofResult.proof":
-		x.Proof = value.Message().Interface().(*MultiProof)
-	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
-		x.EvmProofBytes = value.Bytes()
+	case "band.base.oracle.v1.PriceProofResponse.height":
+		x.Height = value.Int()
+	case "band.base.oracle.v1.PriceProofResponse.result":
+		x.Result = value.Message().Interface().(*PriceProofResult)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProofResponse"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3502,48 +3531,48 @@ func (x *fastReflection_MultiProofResult) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiProofResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceProofResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiProofResult.proof":
-		if x.Proof == nil {
-			x.Proof = new(MultiProof)
+	case "band.base.oracle.v1.PriceProofResponse.result":
+		if x.Result == nil {
+			x.Result = new(PriceProofResult)
 		}
-		return protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
-	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
-		panic(fmt.Errorf("field evm_proof_bytes of message band.base.oracle.v1.MultiProofResult is not mutable"))
+		return protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+	case "band.base.oracle.v1.PriceProofResponse.height":
+		panic(fmt.Errorf("field height of message band.base.oracle.v1.PriceProofResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProofResponse"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MultiProofResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceProofResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiProofResult.proof":
-		m := new(MultiProof)
+	case "band.base.oracle.v1.PriceProofResponse.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.base.oracle.v1.PriceProofResponse.result":
+		m := new(PriceProofResult)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
-		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProofResponse"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MultiProofResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PriceProofResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.MultiProofResult", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.PriceProofResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3551,7 +3580,7 @@ func (x *fastReflection_MultiProofResult) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MultiProofResult) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PriceProofResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3562,7 +3591,7 @@ func (x *fastReflection_MultiProofResult) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiProofResult) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PriceProofResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3574,7 +3603,7 @@ func (x *fastReflection_MultiProofResult) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MultiProofResult) IsValid() bool {
+func (x *fastReflection_PriceProofResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3584,9 +3613,9 @@ func (x *fastReflection_MultiProofResult) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PriceProofResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MultiProofResult)
+		x := input.Message.Interface().(*PriceProofResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3598,12 +3627,11 @@ func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Proof != nil {
-			l = options.Size(x.Proof)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
 		}
-		l = len(x.EvmProofBytes)
-		if l > 0 {
+		if x.Result != nil {
+			l = options.Size(x.Result)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -3616,7 +3644,7 @@ func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MultiProofResult)
+		x := input.Message.Interface().(*PriceProofResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3635,15 +3663,8 @@ func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.EvmProofBytes) > 0 {
-			i -= len(x.EvmProofBytes)
-			copy(dAtA[i:], x.EvmProofBytes)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvmProofBytes)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Proof != nil {
-			encoded, err := options.Marshal(x.Proof)
+		if x.Result != nil {
+			encoded, err := options.Marshal(x.Result)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3654,7 +3675,12 @@ func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3667,7 +3693,7 @@ func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MultiProofResult)
+		x := input.Message.Interface().(*PriceProofResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3699,17 +3725,17 @@ func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiProofResult: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceProofResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				var msglen int
+				x.Height = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3719,33 +3745,16 @@ func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Height |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Proof == nil {
-					x.Proof = &MultiProof{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proof); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3755,24 +3764,26 @@ func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.EvmProofBytes = append(x.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
-				if x.EvmProofBytes == nil {
-					x.EvmProofBytes = []byte{}
+				if x.Result == nil {
+					x.Result = &PriceProofResult{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Result); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -3811,27 +3822,29 @@ func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_CountProofResult                 protoreflect.MessageDescriptor
-	fd_CountProofResult_proof           protoreflect.FieldDescriptor
-	fd_CountProofResult_evm_proof_bytes protoreflect.FieldDescriptor
+	md_TunnelPacketProofRequest           protoreflect.MessageDescriptor
+	fd_TunnelPacketProofRequest_tunnel_id protoreflect.FieldDescriptor
+	fd_TunnelPacketProofRequest_sequence  protoreflect.FieldDescriptor
+	fd_TunnelPacketProofRequest_height    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_CountProofResult = File_band_base_oracle_v1_proof_proto.Messages().ByName("CountProofResult")
-	fd_CountProofResult_proof = md_CountProofResult.Fields().ByName("proof")
-	fd_CountProofResult_evm_proof_bytes = md_CountProofResult.Fields().ByName("evm_proof_bytes")
+	md_TunnelPacketProofRequest = File_band_base_oracle_v1_proof_proto.Messages().ByName("TunnelPacketProofRequest")
+	fd_TunnelPacketProofRequest_tunnel_id = md_TunnelPacketProofRequest.Fields().ByName("tunnel_id")
+	fd_TunnelPacketProofRequest_sequence = md_TunnelPacketProofRequest.Fields().ByName("sequence")
+	fd_TunnelPacketProofRequest_height = md_TunnelPacketProofRequest.Fields().ByName("height")
 }
 
-var _ protoreflect.Message = (*fastReflection_CountProofResult)(nil)
+var _ protoreflect.Message = (*fastReflection_TunnelPacketProofRequest)(nil)
 
-type fastReflection_CountProofResult CountProofResult
+type fastReflection_TunnelPacketProofRequest TunnelPacketProofRequest
 
-func (x *CountProofResult) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CountProofResult)(x)
+func (x *TunnelPacketProofRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TunnelPacketProofRequest)(x)
 }
 
-func (x *CountProofResult) slowProtoReflect() protoreflect.Message {
+func (x *TunnelPacketProofRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3843,43 +3856,43 @@ func (x *CountProofResult) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CountProofResult_messageType fastReflection_CountProofResult_messageType
-var _ protoreflect.MessageType = fastReflection_CountProofResult_messageType{}
+var _fastReflection_TunnelPacketProofRequest_messageType fastReflection_TunnelPacketProofRequest_messageType
+var _ protoreflect.MessageType = fastReflection_TunnelPacketProofRequest_messageType{}
 
-type fastReflection_CountProofResult_messageType struct{}
+type fastReflection_TunnelPacketProofRequest_messageType struct{}
 
-func (x fastReflection_CountProofResult_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CountProofResult)(nil)
+func (x fastReflection_TunnelPacketProofRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TunnelPacketProofRequest)(nil)
 }
-func (x fastReflection_CountProofResult_messageType) New() protoreflect.Message {
-	return new(fastReflection_CountProofResult)
+func (x fastReflection_TunnelPacketProofRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_TunnelPacketProofRequest)
 }
-func (x fastReflection_CountProofResult_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CountProofResult
+func (x fastReflection_TunnelPacketProofRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TunnelPacketProofRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CountProofResult) Descriptor() protoreflect.MessageDescriptor {
-	return md_CountProofResult
+func (x *fastReflection_TunnelPacketProofRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_TunnelPacketProofRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CountProofResult) Type() protoreflect.MessageType {
-	return _fastReflection_CountProofResult_messageType
+func (x *fastReflection_TunnelPacketProofRequest) Type() protoreflect.MessageType {
+	return _fastReflection_TunnelPacketProofRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CountProofResult) New() protoreflect.Message {
-	return new(fastReflection_CountProofResult)
+func (x *fastReflection_TunnelPacketProofRequest) New() protoreflect.Message {
+	return new(fastReflection_TunnelPacketProofRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CountProofResult) Interface() protoreflect.ProtoMessage {
-	return (*CountProofResult)(x)
+func (x *fastReflection_TunnelPacketProofRequest) Interface() protoreflect.ProtoMessage {
+	return (*TunnelPacketProofRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3887,16 +3900,22 @@ func (x *fastReflection_CountProofResult) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CountProofResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Proof != nil {
-		value := protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
-		if !f(fd_CountProofResult_proof, value) {
+func (x *fastReflection_TunnelPacketProofRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_TunnelPacketProofRequest_tunnel_id, value) {
 			return
 		}
 	}
-	if len(x.EvmProofBytes) != 0 {
-		value := protoreflect.ValueOfBytes(x.EvmProofBytes)
-		if !f(fd_CountProofResult_evm_proof_bytes, value) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_TunnelPacketProofRequest_sequence, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_TunnelPacketProofRequest_height, value) {
 			return
 		}
 	}
@@ -3913,17 +3932,19 @@ func (x *fastReflection_CountProofResult) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CountProofResult) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TunnelPacketProofRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CountProofResult.proof":
-		return x.Proof != nil
-	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
-		return len(x.EvmProofBytes) != 0
+	case "band.base.oracle.v1.TunnelPacketProofRequest.tunnel_id":
+		return x.TunnelId != uint64(0)
+	case "band.base.oracle.v1.TunnelPacketProofRequest.sequence":
+		return x.Sequence != uint64(0)
+	case "band.base.oracle.v1.TunnelPacketProofRequest.height":
+		return x.Height != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TunnelPacketProofRequest"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.TunnelPacketProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3933,17 +3954,19 @@ func (x *fastReflection_CountProofResult) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CountProofResult) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TunnelPacketProofRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CountProofResult.proof":
-		x.Proof = nil
-	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
-		x.EvmProofBytes = nil
+	case "band.base.oracle.v1.TunnelPacketProofRequest.tunnel_id":
+		x.TunnelId = uint64(0)
+	case "band.base.oracle.v1.TunnelPacketProofRequest.sequence":
+		x.Sequence = uint64(0)
+	case "band.base.oracle.v1.TunnelPacketProofRequest.height":
+		x.Height = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TunnelPacketProofRequest"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.TunnelPacketProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3953,19 +3976,22 @@ func (x *fastReflection_CountProofResult) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CountProofResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TunnelPacketProofRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.CountProofResult.proof":
-		value := x.Proof
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
-		value := x.EvmProofBytes
-		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.TunnelPacketProofRequest.tunnel_id":
+		value := x.TunnelId
+		return protoreflect.ValueOfUint64(value)
+	case "band.base.oracle.v1.TunnelPacketProofRequest.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "band.base.oracle.v1.TunnelPacketProofRequest.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TunnelPacketProofRequest"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.TunnelPacketProofRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3979,17 +4005,19 @@ func (x *fastReflection_CountProofResult) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CountProofResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TunnelPacketProofRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CountProofResult.proof":
-		x.Proof = value.Message().Interface().(*CountProof)
-	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
-		x.EvmProofBytes = value.Bytes()
+	case "band.base.oracle.v1.TunnelPacketProofRequest.tunnel_id":
+		x.TunnelId = value.Uint()
+	case "band.base.oracle.v1.TunnelPacketProofRequest.sequence":
+		x.Sequence = value.Uint()
+	case "band.base.oracle.v1.TunnelPacketProofRequest.height":
+		x.Height = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TunnelPacketProofRequest"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.TunnelPacketProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4003,48 +4031,48 @@ func (x *fastReflection_CountProofResult) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CountProofResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TunnelPacketProofRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CountProofResult.proof":
-		if x.Proof == nil {
-			x.Proof = new(CountProof)
-		}
-		return protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
-	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
-		panic(fmt.Errorf("field evm_proof_bytes of message band.base.oracle.v1.CountProofResult is not mutable"))
+	case "band.base.oracle.v1.TunnelPacketProofRequest.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.base.oracle.v1.TunnelPacketProofRequest is not mutable"))
+	case "band.base.oracle.v1.TunnelPacketProofRequest.sequence":
+		panic(fmt.Errorf("field sequence of message band.base.oracle.v1.TunnelPacketProofRequest is not mutable"))
+	case "band.base.oracle.v1.TunnelPacketProofRequest.height":
+		panic(fmt.Errorf("field height of message band.base.oracle.v1.TunnelPacketProofRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TunnelPacketProofRequest"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.TunnelPacketProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CountProofResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TunnelPacketProofRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CountProofResult.proof":
-		m := new(CountProof)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
-		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.TunnelPacketProofRequest.tunnel_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.base.oracle.v1.TunnelPacketProofRequest.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.base.oracle.v1.TunnelPacketProofRequest.height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TunnelPacketProofRequest"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.TunnelPacketProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CountProofResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TunnelPacketProofRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.CountProofResult", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.TunnelPacketProofRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4052,7 +4080,7 @@ func (x *fastReflection_CountProofResult) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CountProofResult) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TunnelPacketProofRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4063,7 +4091,7 @@ func (x *fastReflection_CountProofResult) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CountProofResult) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TunnelPacketProofRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4075,7 +4103,7 @@ func (x *fastReflection_CountProofResult) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CountProofResult) IsValid() bool {
+func (x *fastReflection_TunnelPacketProofRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4085,9 +4113,9 @@ func (x *fastReflection_CountProofResult) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CountProofResult) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TunnelPacketProofRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CountProofResult)
+		x := input.Message.Interface().(*TunnelPacketProofRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4099,13 +4127,14 @@ func (x *fastReflection_CountProofResult) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Proof != nil {
-			l = options.Size(x.Proof)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
 		}
-		l = len(x.EvmProofBytes)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4117,7 +4146,7 @@ func (x *fastReflection_CountProofResult) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CountProofResult)
+		x := input.Message.Interface().(*TunnelPacketProofRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4136,29 +4165,23 @@ func (x *fastReflection_CountProofResult) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.EvmProofBytes) > 0 {
-			i -= len(x.EvmProofBytes)
-			copy(dAtA[i:], x.EvmProofBytes)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvmProofBytes)))
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x18
 		}
-		if x.Proof != nil {
-			encoded, err := options.Marshal(x.Proof)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x10
 		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
 		} else {
 			input.Buf = dAtA
 		}
@@ -4168,7 +4191,7 @@ func (x *fastReflection_CountProofResult) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CountProofResult)
+		x := input.Message.Interface().(*TunnelPacketProofRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4200,17 +4223,17 @@ func (x *fastReflection_CountProofResult) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CountProofResult: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TunnelPacketProofRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CountProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TunnelPacketProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
 				}
-				var msglen int
+				x.TunnelId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4220,33 +4243,16 @@ func (x *fastReflection_CountProofResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.TunnelId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Proof == nil {
-					x.Proof = &CountProof{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proof); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 				}
-				var byteLen int
+				x.Sequence = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4256,26 +4262,30 @@ func (x *fastReflection_CountProofResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					x.Sequence |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				x.EvmProofBytes = append(x.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
-				if x.EvmProofBytes == nil {
-					x.EvmProofBytes = []byte{}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4312,29 +4322,27 @@ func (x *fastReflection_CountProofResult) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SingleProof                   protoreflect.MessageDescriptor
-	fd_SingleProof_block_height      protoreflect.FieldDescriptor
-	fd_SingleProof_oracle_data_proof protoreflect.FieldDescriptor
-	fd_SingleProof_block_relay_proof protoreflect.FieldDescriptor
+	md_TunnelPacketProofResponse        protoreflect.MessageDescriptor
+	fd_TunnelPacketProofResponse_height protoreflect.FieldDescriptor
+	fd_TunnelPacketProofResponse_result protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_SingleProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("SingleProof")
-	fd_SingleProof_block_height = md_SingleProof.Fields().ByName("block_height")
-	fd_SingleProof_oracle_data_proof = md_SingleProof.Fields().ByName("oracle_data_proof")
-	fd_SingleProof_block_relay_proof = md_SingleProof.Fields().ByName("block_relay_proof")
+	md_TunnelPacketProofResponse = File_band_base_oracle_v1_proof_proto.Messages().ByName("TunnelPacketProofResponse")
+	fd_TunnelPacketProofResponse_height = md_TunnelPacketProofResponse.Fields().ByName("height")
+	fd_TunnelPacketProofResponse_result = md_TunnelPacketProofResponse.Fields().ByName("result")
 }
 
-var _ protoreflect.Message = (*fastReflection_SingleProof)(nil)
+var _ protoreflect.Message = (*fastReflection_TunnelPacketProofResponse)(nil)
 
-type fastReflection_SingleProof SingleProof
+type fastReflection_TunnelPacketProofResponse TunnelPacketProofResponse
 
-func (x *SingleProof) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SingleProof)(x)
+func (x *TunnelPacketProofResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TunnelPacketProofResponse)(x)
 }
 
-func (x *SingleProof) slowProtoReflect() protoreflect.Message {
+func (x *TunnelPacketProofResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4346,43 +4354,43 @@ func (x *SingleProof) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SingleProof_messageType fastReflection_SingleProof_messageType
-var _ protoreflect.MessageType = fastReflection_SingleProof_messageType{}
+var _fastReflection_TunnelPacketProofResponse_messageType fastReflection_TunnelPacketProofResponse_messageType
+var _ protoreflect.MessageType = fastReflection_TunnelPacketProofResponse_messageType{}
 
-type fastReflection_SingleProof_messageType struct{}
+type fastReflection_TunnelPacketProofResponse_messageType struct{}
 
-func (x fastReflection_SingleProof_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SingleProof)(nil)
+func (x fastReflection_TunnelPacketProofResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TunnelPacketProofResponse)(nil)
 }
-func (x fastReflection_SingleProof_messageType) New() protoreflect.Message {
-	return new(fastReflection_SingleProof)
+func (x fastReflection_TunnelPacketProofResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_TunnelPacketProofResponse)
 }
-func (x fastReflection_SingleProof_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SingleProof
+func (x fastReflection_TunnelPacketProofResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TunnelPacketProofResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SingleProof) Descriptor() protoreflect.MessageDescriptor {
-	return md_SingleProof
+func (x *fastReflection_TunnelPacketProofResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_TunnelPacketProofResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SingleProof) Type() protoreflect.MessageType {
-	return _fastReflection_SingleProof_messageType
+func (x *fastReflection_TunnelPacketProofResponse) Type() protoreflect.MessageType {
+	return _fastReflection_TunnelPacketProofResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SingleProof) New() protoreflect.Message {
-	return new(fastReflection_SingleProof)
+func (x *fastReflection_TunnelPacketProofResponse) New() protoreflect.Message {
+	return new(fastReflection_TunnelPacketProofResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SingleProof) Interface() protoreflect.ProtoMessage {
-	return (*SingleProof)(x)
+func (x *fastReflection_TunnelPacketProofResponse) Interface() protoreflect.ProtoMessage {
+	return (*TunnelPacketProofResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4390,22 +4398,16 @@ func (x *fastReflection_SingleProof) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SingleProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BlockHeight != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_SingleProof_block_height, value) {
-			return
-		}
-	}
-	if x.OracleDataProof != nil {
-		value := protoreflect.ValueOfMessage(x.OracleDataProof.ProtoReflect())
-		if !f(fd_SingleProof_oracle_data_proof, value) {
+func (x *fastReflection_TunnelPacketProofResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_TunnelPacketProofResponse_height, value) {
 			return
 		}
 	}
-	if x.BlockRelayProof != nil {
-		value := protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
-		if !f(fd_SingleProof_block_relay_proof, value) {
+	if x.Result != nil {
+		value := protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+		if !f(fd_TunnelPacketProofResponse_result, value) {
 			return
 		}
 	}
@@ -4422,19 +4424,17 @@ func (x *fastReflection_SingleProof) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SingleProof) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TunnelPacketProofResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.SingleProof.block_height":
-		return x.BlockHeight != uint64(0)
-	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
-		return x.OracleDataProof != nil
-	case "band.base.oracle.v1.SingleProof.block_relay_proof":
-		return x.BlockRelayProof != nil
+	case "band.base.oracle.v1.TunnelPacketProofResponse.height":
+		return x.Height != int64(0)
+	case "band.base.oracle.v1.TunnelPacketProofResponse.result":
+		return x.Result != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TunnelPacketProofResponse"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.TunnelPacketProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4444,19 +4444,17 @@ func (x *fastReflection_SingleProof) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SingleProof) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TunnelPacketProofResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.SingleProof.block_height":
-		x.BlockHeight = uint64(0)
-	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
-		x.OracleDataProof = nil
-	case "band.base.oracle.v1.SingleProof.block_relay_proof":
-		x.BlockRelayProof = nil
+	case "band.base.oracle.v1.TunnelPacketProofResponse.height":
+		x.Height = int64(0)
+	case "band.base.oracle.v1.TunnelPacketProofResponse.result":
+		x.Result = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TunnelPacketProofResponse"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.TunnelPacketProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4466,22 +4464,19 @@ func (x *fastReflection_SingleProof) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SingleProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TunnelPacketProofResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.SingleProof.block_height":
-		value := x.BlockHeight
-		return protoreflect.ValueOfUint64(value)
-	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
-		value := x.OracleDataProof
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.base.oracle.v1.SingleProof.block_relay_proof":
-		value := x.BlockRelayProof
+	case "band.base.oracle.v1.TunnelPacketProofResponse.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "band.base.oracle.v1.TunnelPacketProofResponse.result":
+		value := x.Result
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TunnelPacketProofResponse"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.TunnelPacketProofResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4495,19 +4490,17 @@ func (x *fastReflection_SingleProof) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SingleProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TunnelPacketProofResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.SingleProof.block_height":
-		x.BlockHeight = value.Uint()
-	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
-		x.OracleDataProof = value.Message().Interface().(*OracleDataProof)
-	case "band.base.oracle.v1.SingleProof.block_relay_proof":
-		x.BlockRelayProof = value.Message().Interface().(*BlockRelayProof)
+	case "band.base.oracle.v1.TunnelPacketProofResponse.height":
+		x.Height = value.Int()
+	case "band.base.oracle.v1.TunnelPacketProofResponse.result":
+		x.Result = value.Message().Interface().(*PacketProofResult)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TunnelPacketProofResponse"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.TunnelPacketProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4521,56 +4514,48 @@ func (x *fastReflection_SingleProof) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SingleProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TunnelPacketProofResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
-		if x.OracleDataProof == nil {
-			x.OracleDataProof = new(OracleDataProof)
-		}
-		return protoreflect.ValueOfMessage(x.OracleDataProof.ProtoReflect())
-	case "band.base.oracle.v1.SingleProof.block_relay_proof":
-		if x.BlockRelayProof == nil {
-			x.BlockRelayProof = new(BlockRelayProof)
+	case "band.base.oracle.v1.TunnelPacketProofResponse.result":
+		if x.Result == nil {
+			x.Result = new(PacketProofResult)
 		}
-		return protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
-	case "band.base.oracle.v1.SingleProof.block_height":
-		panic(fmt.Errorf("field block_height of message band.base.oracle.v1.SingleProof is not mutable"))
+		return protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+	case "band.base.oracle.v1.TunnelPacketProofResponse.height":
+		panic(fmt.Errorf("field height of message band.base.oracle.v1.TunnelPacketProofResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TunnelPacketProofResponse"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.TunnelPacketProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SingleProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TunnelPacketProofResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.SingleProof.block_height":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
-		m := new(OracleDataProof)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.base.oracle.v1.SingleProof.block_relay_proof":
-		m := new(BlockRelayProof)
+	case "band.base.oracle.v1.TunnelPacketProofResponse.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.base.oracle.v1.TunnelPacketProofResponse.result":
+		m := new(PacketProofResult)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TunnelPacketProofResponse"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.TunnelPacketProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SingleProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TunnelPacketProofResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.SingleProof", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.TunnelPacketProofResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4578,7 +4563,7 @@ func (x *fastReflection_SingleProof) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SingleProof) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TunnelPacketProofResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4589,7 +4574,7 @@ func (x *fastReflection_SingleProof) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SingleProof) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TunnelPacketProofResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4601,7 +4586,7 @@ func (x *fastReflection_SingleProof) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SingleProof) IsValid() bool {
+func (x *fastReflection_TunnelPacketProofResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4611,9 +4596,9 @@ func (x *fastReflection_SingleProof) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TunnelPacketProofResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SingleProof)
+		x := input.Message.Interface().(*TunnelPacketProofResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4625,15 +4610,11 @@ func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.BlockHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockHeight))
-		}
-		if x.OracleDataProof != nil {
-			l = options.Size(x.OracleDataProof)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
 		}
-		if x.BlockRelayProof != nil {
-			l = options.Size(x.BlockRelayProof)
+		if x.Result != nil {
+			l = options.Size(x.Result)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4646,7 +4627,7 @@ func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SingleProof)
+		x := input.Message.Interface().(*TunnelPacketProofResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4665,22 +4646,8 @@ func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockRelayProof != nil {
-			encoded, err := options.Marshal(x.BlockRelayProof)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.OracleDataProof != nil {
-			encoded, err := options.Marshal(x.OracleDataProof)
+		if x.Result != nil {
+			encoded, err := options.Marshal(x.Result)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4693,8 +4660,8 @@ func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.BlockHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -4709,7 +4676,7 @@ func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SingleProof)
+		x := input.Message.Interface().(*TunnelPacketProofResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4741,17 +4708,17 @@ func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SingleProof: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TunnelPacketProofResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SingleProof: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TunnelPacketProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				x.BlockHeight = 0
+				x.Height = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4761,14 +4728,14 @@ func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BlockHeight |= uint64(b&0x7F) << shift
+					x.Height |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleDataProof", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4795,54 +4762,18 @@ func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.OracleDataProof == nil {
-					x.OracleDataProof = &OracleDataProof{}
+				if x.Result == nil {
+					x.Result = &PacketProofResult{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OracleDataProof); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Result); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.BlockRelayProof == nil {
-					x.BlockRelayProof = &BlockRelayProof{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockRelayProof); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
@@ -4873,81 +4804,28 @@ func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_MultiProof_2_list)(nil)
-
-type _MultiProof_2_list struct {
-	list *[]*OracleDataProof
-}
-
-func (x *_MultiProof_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MultiProof_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MultiProof_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*OracleDataProof)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MultiProof_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*OracleDataProof)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MultiProof_2_list) AppendMutable() protoreflect.Value {
-	v := new(OracleDataProof)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MultiProof_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MultiProof_2_list) NewElement() protoreflect.Value {
-	v := new(OracleDataProof)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MultiProof_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_MultiProof                         protoreflect.MessageDescriptor
-	fd_MultiProof_block_height            protoreflect.FieldDescriptor
-	fd_MultiProof_oracle_data_multi_proof protoreflect.FieldDescriptor
-	fd_MultiProof_block_relay_proof       protoreflect.FieldDescriptor
+	md_SingleProofResult                 protoreflect.MessageDescriptor
+	fd_SingleProofResult_proof           protoreflect.FieldDescriptor
+	fd_SingleProofResult_evm_proof_bytes protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_MultiProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("MultiProof")
-	fd_MultiProof_block_height = md_MultiProof.Fields().ByName("block_height")
-	fd_MultiProof_oracle_data_multi_proof = md_MultiProof.Fields().ByName("oracle_data_multi_proof")
-	fd_MultiProof_block_relay_proof = md_MultiProof.Fields().ByName("block_relay_proof")
+	md_SingleProofResult = File_band_base_oracle_v1_proof_proto.Messages().ByName("SingleProofResult")
+	fd_SingleProofResult_proof = md_SingleProofResult.Fields().ByName("proof")
+	fd_SingleProofResult_evm_proof_bytes = md_SingleProofResult.Fields().ByName("evm_proof_bytes")
 }
 
-var _ protoreflect.Message = (*fastReflection_MultiProof)(nil)
+var _ protoreflect.Message = (*fastReflection_SingleProofResult)(nil)
 
-type fastReflection_MultiProof MultiProof
+type fastReflection_SingleProofResult SingleProofResult
 
-func (x *MultiProof) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MultiProof)(x)
+func (x *SingleProofResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SingleProofResult)(x)
 }
 
-func (x *MultiProof) slowProtoReflect() protoreflect.Message {
+func (x *SingleProofResult) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4959,43 +4837,43 @@ func (x *MultiProof) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MultiProof_messageType fastReflection_MultiProof_messageType
-var _ protoreflect.MessageType = fastReflection_MultiProof_messageType{}
+var _fastReflection_SingleProofResult_messageType fastReflection_SingleProofResult_messageType
+var _ protoreflect.MessageType = fastReflection_SingleProofResult_messageType{}
 
-type fastReflection_MultiProof_messageType struct{}
+type fastReflection_SingleProofResult_messageType struct{}
 
-func (x fastReflection_MultiProof_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MultiProof)(nil)
+func (x fastReflection_SingleProofResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SingleProofResult)(nil)
 }
-func (x fastReflection_MultiProof_messageType) New() protoreflect.Message {
-	return new(fastReflection_MultiProof)
+func (x fastReflection_SingleProofResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_SingleProofResult)
 }
-func (x fastReflection_MultiProof_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MultiProof
+func (x fastReflection_SingleProofResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SingleProofResult
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MultiProof) Descriptor() protoreflect.MessageDescriptor {
-	return md_MultiProof
+func (x *fastReflection_SingleProofResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_SingleProofResult
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MultiProof) Type() protoreflect.MessageType {
-	return _fastReflection_MultiProof_messageType
+func (x *fastReflection_SingleProofResult) Type() protoreflect.MessageType {
+	return _fastReflection_SingleProofResult_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MultiProof) New() protoreflect.Message {
-	return new(fastReflection_MultiProof)
+func (x *fastReflection_SingleProofResult) New() protoreflect.Message {
+	return new(fastReflection_SingleProofResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MultiProof) Interface() protoreflect.ProtoMessage {
-	return (*MultiProof)(x)
+func (x *fastReflection_SingleProofResult) Interface() protoreflect.ProtoMessage {
+	return (*SingleProofResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5003,22 +4881,16 @@ func (x *fastReflection_MultiProof) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MultiProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BlockHeight != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_MultiProof_block_height, value) {
-			return
-		}
-	}
-	if len(x.OracleDataMultiProof) != 0 {
-		value := protoreflect.ValueOfList(&_MultiProof_2_list{list: &x.OracleDataMultiProof})
-		if !f(fd_MultiProof_oracle_data_multi_proof, value) {
+func (x *fastReflection_SingleProofResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Proof != nil {
+		value := protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+		if !f(fd_SingleProofResult_proof, value) {
 			return
 		}
 	}
-	if x.BlockRelayProof != nil {
-		value := protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
-		if !f(fd_MultiProof_block_relay_proof, value) {
+	if len(x.EvmProofBytes) != 0 {
+		value := protoreflect.ValueOfBytes(x.EvmProofBytes)
+		if !f(fd_SingleProofResult_evm_proof_bytes, value) {
 			return
 		}
 	}
@@ -5035,19 +4907,17 @@ func (x *fastReflection_MultiProof) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MultiProof) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SingleProofResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiProof.block_height":
-		return x.BlockHeight != uint64(0)
-	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
-		return len(x.OracleDataMultiProof) != 0
-	case "band.base.oracle.v1.MultiProof.block_relay_proof":
-		return x.BlockRelayProof != nil
+	case "band.base.oracle.v1.SingleProofResult.proof":
+		return x.Proof != nil
+	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
+		return len(x.EvmProofBytes) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5057,19 +4927,17 @@ func (x *fastReflection_MultiProof) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiProof) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SingleProofResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiProof.block_height":
-		x.BlockHeight = uint64(0)
-	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
-		x.OracleDataMultiProof = nil
-	case "band.base.oracle.v1.MultiProof.block_relay_proof":
-		x.BlockRelayProof = nil
+	case "band.base.oracle.v1.SingleProofResult.proof":
+		x.Proof = nil
+	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
+		x.EvmProofBytes = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5079,25 +4947,19 @@ func (x *fastReflection_MultiProof) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MultiProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SingleProofResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.MultiProof.block_height":
-		value := x.BlockHeight
-		return protoreflect.ValueOfUint64(value)
-	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
-		if len(x.OracleDataMultiProof) == 0 {
-			return protoreflect.ValueOfList(&_MultiProof_2_list{})
-		}
-		listValue := &_MultiProof_2_list{list: &x.OracleDataMultiProof}
-		return protoreflect.ValueOfList(listValue)
-	case "band.base.oracle.v1.MultiProof.block_relay_proof":
-		value := x.BlockRelayProof
+	case "band.base.oracle.v1.SingleProofResult.proof":
+		value := x.Proof
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
+		value := x.EvmProofBytes
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5111,21 +4973,17 @@ func (x *fastReflection_MultiProof) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SingleProofResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiProof.block_height":
-		x.BlockHeight = value.Uint()
-	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
-		lv := value.List()
-		clv := lv.(*_MultiProof_2_list)
-		x.OracleDataMultiProof = *clv.list
-	case "band.base.oracle.v1.MultiProof.block_relay_proof":
-		x.BlockRelayProof = value.Message().Interface().(*BlockRelayProof)
+	case "band.base.oracle.v1.SingleProofResult.proof":
+		x.Proof = value.Message().Interface().(*SingleProof)
+	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
+		x.EvmProofBytes = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5139,57 +4997,48 @@ func (x *fastReflection_MultiProof) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SingleProofResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
-		if x.OracleDataMultiProof == nil {
-			x.OracleDataMultiProof = []*OracleDataProof{}
-		}
-		value := &_MultiProof_2_list{list: &x.OracleDataMultiProof}
-		return protoreflect.ValueOfList(value)
-	case "band.base.oracle.v1.MultiProof.block_relay_proof":
-		if x.BlockRelayProof == nil {
-			x.BlockRelayProof = new(BlockRelayProof)
+	case "band.base.oracle.v1.SingleProofResult.proof":
+		if x.Proof == nil {
+			x.Proof = new(SingleProof)
 		}
-		return protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
-	case "band.base.oracle.v1.MultiProof.block_height":
-		panic(fmt.Errorf("field block_height of message band.base.oracle.v1.MultiProof is not mutable"))
+		return protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
+		panic(fmt.Errorf("field evm_proof_bytes of message band.base.oracle.v1.SingleProofResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MultiProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SingleProofResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiProof.block_height":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
-		list := []*OracleDataProof{}
-		return protoreflect.ValueOfList(&_MultiProof_2_list{list: &list})
-	case "band.base.oracle.v1.MultiProof.block_relay_proof":
-		m := new(BlockRelayProof)
+	case "band.base.oracle.v1.SingleProofResult.proof":
+		m := new(SingleProof)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.SingleProofResult.evm_proof_bytes":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MultiProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SingleProofResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.MultiProof", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.SingleProofResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5197,7 +5046,7 @@ func (x *fastReflection_MultiProof) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MultiProof) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SingleProofResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5208,7 +5057,7 @@ func (x *fastReflection_MultiProof) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiProof) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SingleProofResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5220,7 +5069,7 @@ func (x *fastReflection_MultiProof) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MultiProof) IsValid() bool {
+func (x *fastReflection_SingleProofResult) IsValid() bool {
 	return x != nil
 }
 
@@ -5230,9 +5079,9 @@ func (x *fastReflection_MultiProof) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SingleProofResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MultiProof)
+		x := input.Message.Interface().(*SingleProofResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5244,17 +5093,12 @@ func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.BlockHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockHeight))
-		}
-		if len(x.OracleDataMultiProof) > 0 {
-			for _, e := range x.OracleDataMultiProof {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Proof != nil {
+			l = options.Size(x.Proof)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.BlockRelayProof != nil {
-			l = options.Size(x.BlockRelayProof)
+		l = len(x.EvmProofBytes)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5267,7 +5111,7 @@ func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MultiProof)
+		x := input.Message.Interface().(*SingleProofResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5286,8 +5130,15 @@ func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockRelayProof != nil {
-			encoded, err := options.Marshal(x.BlockRelayProof)
+		if len(x.EvmProofBytes) > 0 {
+			i -= len(x.EvmProofBytes)
+			copy(dAtA[i:], x.EvmProofBytes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvmProofBytes)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Proof != nil {
+			encoded, err := options.Marshal(x.Proof)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5298,28 +5149,7 @@ func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.OracleDataMultiProof) > 0 {
-			for iNdEx := len(x.OracleDataMultiProof) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.OracleDataMultiProof[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.BlockHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
-			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5332,7 +5162,7 @@ func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MultiProof)
+		x := input.Message.Interface().(*SingleProofResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5364,34 +5194,15 @@ func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiProof: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SingleProofResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiProof: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SingleProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-				}
-				x.BlockHeight = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockHeight |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleDataMultiProof", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5418,16 +5229,18 @@ func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.OracleDataMultiProof = append(x.OracleDataMultiProof, &OracleDataProof{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OracleDataMultiProof[len(x.OracleDataMultiProof)-1]); err != nil {
+				if x.Proof == nil {
+					x.Proof = &SingleProof{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proof); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5437,26 +5250,24 @@ func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.BlockRelayProof == nil {
-					x.BlockRelayProof = &BlockRelayProof{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockRelayProof); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.EvmProofBytes = append(x.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.EvmProofBytes == nil {
+					x.EvmProofBytes = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -5495,29 +5306,27 @@ func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_CountProof                   protoreflect.MessageDescriptor
-	fd_CountProof_block_height      protoreflect.FieldDescriptor
-	fd_CountProof_count_proof       protoreflect.FieldDescriptor
-	fd_CountProof_block_relay_proof protoreflect.FieldDescriptor
+	md_MultiProofResult                 protoreflect.MessageDescriptor
+	fd_MultiProofResult_proof           protoreflect.FieldDescriptor
+	fd_MultiProofResult_evm_proof_bytes protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_CountProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("CountProof")
-	fd_CountProof_block_height = md_CountProof.Fields().ByName("block_height")
-	fd_CountProof_count_proof = md_CountProof.Fields().ByName("count_proof")
-	fd_CountProof_block_relay_proof = md_CountProof.Fields().ByName("block_relay_proof")
+	md_MultiProofResult = File_band_base_oracle_v1_proof_proto.Messages().ByName("MultiProofResult")
+	fd_MultiProofResult_proof = md_MultiProofResult.Fields().ByName("proof")
+	fd_MultiProofResult_evm_proof_bytes = md_MultiProofResult.Fields().ByName("evm_proof_bytes")
 }
 
-var _ protoreflect.Message = (*fastReflection_CountProof)(nil)
+var _ protoreflect.Message = (*fastReflection_MultiProofResult)(nil)
 
-type fastReflection_CountProof CountProof
+type fastReflection_MultiProofResult MultiProofResult
 
-func (x *CountProof) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CountProof)(x)
+func (x *MultiProofResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MultiProofResult)(x)
 }
 
-func (x *CountProof) slowProtoReflect() protoreflect.Message {
+func (x *MultiProofResult) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5529,43 +5338,43 @@ func (x *CountProof) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CountProof_messageType fastReflection_CountProof_messageType
-var _ protoreflect.MessageType = fastReflection_CountProof_messageType{}
+var _fastReflection_MultiProofResult_messageType fastReflection_MultiProofResult_messageType
+var _ protoreflect.MessageType = fastReflection_MultiProofResult_messageType{}
 
-type fastReflection_CountProof_messageType struct{}
+type fastReflection_MultiProofResult_messageType struct{}
 
-func (x fastReflection_CountProof_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CountProof)(nil)
+func (x fastReflection_MultiProofResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MultiProofResult)(nil)
 }
-func (x fastReflection_CountProof_messageType) New() protoreflect.Message {
-	return new(fastReflection_CountProof)
+func (x fastReflection_MultiProofResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_MultiProofResult)
 }
-func (x fastReflection_CountProof_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CountProof
+func (x fastReflection_MultiProofResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MultiProofResult
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CountProof) Descriptor() protoreflect.MessageDescriptor {
-	return md_CountProof
+func (x *fastReflection_MultiProofResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_MultiProofResult
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CountProof) Type() protoreflect.MessageType {
-	return _fastReflection_CountProof_messageType
+func (x *fastReflection_MultiProofResult) Type() protoreflect.MessageType {
+	return _fastReflection_MultiProofResult_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CountProof) New() protoreflect.Message {
-	return new(fastReflection_CountProof)
+func (x *fastReflection_MultiProofResult) New() protoreflect.Message {
+	return new(fastReflection_MultiProofResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CountProof) Interface() protoreflect.ProtoMessage {
-	return (*CountProof)(x)
+func (x *fastReflection_MultiProofResult) Interface() protoreflect.ProtoMessage {
+	return (*MultiProofResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5573,22 +5382,16 @@ func (x *fastReflection_CountProof) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CountProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BlockHeight != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BlockHeight)
-		if !f(fd_CountProof_block_height, value) {
-			return
-		}
-	}
-	if x.CountProof != nil {
-		value := protoreflect.ValueOfMessage(x.CountProof.ProtoReflect())
-		if !f(fd_CountProof_count_proof, value) {
+func (x *fastReflection_MultiProofResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Proof != nil {
+		value := protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+		if !f(fd_MultiProofResult_proof, value) {
 			return
 		}
 	}
-	if x.BlockRelayProof != nil {
-		value := protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
-		if !f(fd_CountProof_block_relay_proof, value) {
+	if len(x.EvmProofBytes) != 0 {
+		value := protoreflect.ValueOfBytes(x.EvmProofBytes)
+		if !f(fd_MultiProofResult_evm_proof_bytes, value) {
 			return
 		}
 	}
@@ -5605,19 +5408,17 @@ func (x *fastReflection_CountProof) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CountProof) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MultiProofResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CountProof.block_height":
-		return x.BlockHeight != uint64(0)
-	case "band.base.oracle.v1.CountProof.count_proof":
-		return x.CountProof != nil
-	case "band.base.oracle.v1.CountProof.block_relay_proof":
-		return x.BlockRelayProof != nil
+	case "band.base.oracle.v1.MultiProofResult.proof":
+		return x.Proof != nil
+	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
+		return len(x.EvmProofBytes) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5627,19 +5428,17 @@ func (x *fastReflection_CountProof) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CountProof) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MultiProofResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CountProof.block_height":
-		x.BlockHeight = uint64(0)
-	case "band.base.oracle.v1.CountProof.count_proof":
-		x.CountProof = nil
-	case "band.base.oracle.v1.CountProof.block_relay_proof":
-		x.BlockRelayProof = nil
+	case "band.base.oracle.v1.MultiProofResult.proof":
+		x.Proof = nil
+	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
+		x.EvmProofBytes = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5649,22 +5448,19 @@ func (x *fastReflection_CountProof) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CountProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MultiProofResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.CountProof.block_height":
-		value := x.BlockHeight
-		return protoreflect.ValueOfUint64(value)
-	case "band.base.oracle.v1.CountProof.count_proof":
-		value := x.CountProof
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.base.oracle.v1.CountProof.block_relay_proof":
-		value := x.BlockRelayProof
+	case "band.base.oracle.v1.MultiProofResult.proof":
+		value := x.Proof
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
+		value := x.EvmProofBytes
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5678,19 +5474,17 @@ func (x *fastReflection_CountProof) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CountProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MultiProofResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CountProof.block_height":
-		x.BlockHeight = value.Uint()
-	case "band.base.oracle.v1.CountProof.count_proof":
-		x.CountProof = value.Message().Interface().(*RequestsCountProof)
-	case "band.base.oracle.v1.CountProof.block_relay_proof":
-		x.BlockRelayProof = value.Message().Interface().(*BlockRelayProof)
+	case "band.base.oracle.v1.MultiProofResult.proof":
+		x.Proof = value.Message().Interface().(*MultiProof)
+	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
+		x.EvmProofBytes = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5704,56 +5498,48 @@ func (x *fastReflection_CountProof) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CountProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MultiProofResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CountProof.count_proof":
-		if x.CountProof == nil {
-			x.CountProof = new(RequestsCountProof)
-		}
-		return protoreflect.ValueOfMessage(x.CountProof.ProtoReflect())
-	case "band.base.oracle.v1.CountProof.block_relay_proof":
-		if x.BlockRelayProof == nil {
-			x.BlockRelayProof = new(BlockRelayProof)
+	case "band.base.oracle.v1.MultiProofResult.proof":
+		if x.Proof == nil {
+			x.Proof = new(MultiProof)
 		}
-		return protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
-	case "band.base.oracle.v1.CountProof.block_height":
-		panic(fmt.Errorf("field block_height of message band.base.oracle.v1.CountProof is not mutable"))
+		return protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
+		panic(fmt.Errorf("field evm_proof_bytes of message band.base.oracle.v1.MultiProofResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CountProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MultiProofResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CountProof.block_height":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.base.oracle.v1.CountProof.count_proof":
-		m := new(RequestsCountProof)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.base.oracle.v1.CountProof.block_relay_proof":
-		m := new(BlockRelayProof)
+	case "band.base.oracle.v1.MultiProofResult.proof":
+		m := new(MultiProof)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.MultiProofResult.evm_proof_bytes":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CountProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MultiProofResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.CountProof", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.MultiProofResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5761,7 +5547,7 @@ func (x *fastReflection_CountProof) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CountProof) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MultiProofResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5772,7 +5558,7 @@ func (x *fastReflection_CountProof) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CountProof) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MultiProofResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5784,7 +5570,7 @@ func (x *fastReflection_CountProof) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CountProof) IsValid() bool {
+func (x *fastReflection_MultiProofResult) IsValid() bool {
 	return x != nil
 }
 
@@ -5794,9 +5580,9 @@ func (x *fastReflection_CountProof) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MultiProofResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CountProof)
+		x := input.Message.Interface().(*MultiProofResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5808,15 +5594,12 @@ func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.BlockHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockHeight))
-		}
-		if x.CountProof != nil {
-			l = options.Size(x.CountProof)
+		if x.Proof != nil {
+			l = options.Size(x.Proof)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.BlockRelayProof != nil {
-			l = options.Size(x.BlockRelayProof)
+		l = len(x.EvmProofBytes)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5829,7 +5612,7 @@ func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CountProof)
+		x := input.Message.Interface().(*MultiProofResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5848,22 +5631,15 @@ func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockRelayProof != nil {
-			encoded, err := options.Marshal(x.BlockRelayProof)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.EvmProofBytes) > 0 {
+			i -= len(x.EvmProofBytes)
+			copy(dAtA[i:], x.EvmProofBytes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvmProofBytes)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
-		if x.CountProof != nil {
-			encoded, err := options.Marshal(x.CountProof)
+		if x.Proof != nil {
+			encoded, err := options.Marshal(x.Proof)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5874,12 +5650,7 @@ func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if x.BlockHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
-			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5892,7 +5663,7 @@ func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CountProof)
+		x := input.Message.Interface().(*MultiProofResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5924,34 +5695,15 @@ func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CountProof: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiProofResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CountProof: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-				}
-				x.BlockHeight = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockHeight |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CountProof", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5978,18 +5730,18 @@ func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.CountProof == nil {
-					x.CountProof = &RequestsCountProof{}
+				if x.Proof == nil {
+					x.Proof = &MultiProof{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CountProof); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proof); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5999,26 +5751,24 @@ func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.BlockRelayProof == nil {
-					x.BlockRelayProof = &BlockRelayProof{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockRelayProof); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.EvmProofBytes = append(x.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.EvmProofBytes == nil {
+					x.EvmProofBytes = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -6056,81 +5806,28 @@ func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_OracleDataProof_3_list)(nil)
-
-type _OracleDataProof_3_list struct {
-	list *[]*IAVLMerklePath
-}
-
-func (x *_OracleDataProof_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_OracleDataProof_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_OracleDataProof_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*IAVLMerklePath)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_OracleDataProof_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*IAVLMerklePath)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_OracleDataProof_3_list) AppendMutable() protoreflect.Value {
-	v := new(IAVLMerklePath)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_OracleDataProof_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_OracleDataProof_3_list) NewElement() protoreflect.Value {
-	v := new(IAVLMerklePath)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_OracleDataProof_3_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_OracleDataProof              protoreflect.MessageDescriptor
-	fd_OracleDataProof_result       protoreflect.FieldDescriptor
-	fd_OracleDataProof_version      protoreflect.FieldDescriptor
-	fd_OracleDataProof_merkle_paths protoreflect.FieldDescriptor
+	md_CountProofResult                 protoreflect.MessageDescriptor
+	fd_CountProofResult_proof           protoreflect.FieldDescriptor
+	fd_CountProofResult_evm_proof_bytes protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_OracleDataProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("OracleDataProof")
-	fd_OracleDataProof_result = md_OracleDataProof.Fields().ByName("result")
-	fd_OracleDataProof_version = md_OracleDataProof.Fields().ByName("version")
-	fd_OracleDataProof_merkle_paths = md_OracleDataProof.Fields().ByName("merkle_paths")
+	md_CountProofResult = File_band_base_oracle_v1_proof_proto.Messages().ByName("CountProofResult")
+	fd_CountProofResult_proof = md_CountProofResult.Fields().ByName("proof")
+	fd_CountProofResult_evm_proof_bytes = md_CountProofResult.Fields().ByName("evm_proof_bytes")
 }
 
-var _ protoreflect.Message = (*fastReflection_OracleDataProof)(nil)
+var _ protoreflect.Message = (*fastReflection_CountProofResult)(nil)
 
-type fastReflection_OracleDataProof OracleDataProof
+type fastReflection_CountProofResult CountProofResult
 
-func (x *OracleDataProof) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_OracleDataProof)(x)
+func (x *CountProofResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CountProofResult)(x)
 }
 
-func (x *OracleDataProof) slowProtoReflect() protoreflect.Message {
+func (x *CountProofResult) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6142,43 +5839,43 @@ func (x *OracleDataProof) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_OracleDataProof_messageType fastReflection_OracleDataProof_messageType
-var _ protoreflect.MessageType = fastReflection_OracleDataProof_messageType{}
+var _fastReflection_CountProofResult_messageType fastReflection_CountProofResult_messageType
+var _ protoreflect.MessageType = fastReflection_CountProofResult_messageType{}
 
-type fastReflection_OracleDataProof_messageType struct{}
+type fastReflection_CountProofResult_messageType struct{}
 
-func (x fastReflection_OracleDataProof_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_OracleDataProof)(nil)
+func (x fastReflection_CountProofResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CountProofResult)(nil)
 }
-func (x fastReflection_OracleDataProof_messageType) New() protoreflect.Message {
-	return new(fastReflection_OracleDataProof)
+func (x fastReflection_CountProofResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_CountProofResult)
 }
-func (x fastReflection_OracleDataProof_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleDataProof
+func (x fastReflection_CountProofResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CountProofResult
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_OracleDataProof) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleDataProof
+func (x *fastReflection_CountProofResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_CountProofResult
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_OracleDataProof) Type() protoreflect.MessageType {
-	return _fastReflection_OracleDataProof_messageType
+func (x *fastReflection_CountProofResult) Type() protoreflect.MessageType {
+	return _fastReflection_CountProofResult_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_OracleDataProof) New() protoreflect.Message {
-	return new(fastReflection_OracleDataProof)
+func (x *fastReflection_CountProofResult) New() protoreflect.Message {
+	return new(fastReflection_CountProofResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_OracleDataProof) Interface() protoreflect.ProtoMessage {
-	return (*OracleDataProof)(x)
+func (x *fastReflection_CountProofResult) Interface() protoreflect.ProtoMessage {
+	return (*CountProofResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6186,22 +5883,16 @@ func (x *fastReflection_OracleDataProof) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_OracleDataProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Result != nil {
-		value := protoreflect.ValueOfMessage(x.Result.ProtoReflect())
-		if !f(fd_OracleDataProof_result, value) {
-			return
-		}
-	}
-	if x.Version != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Version)
-		if !f(fd_OracleDataProof_version, value) {
+func (x *fastReflection_CountProofResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Proof != nil {
+		value := protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+		if !f(fd_CountProofResult_proof, value) {
 			return
 		}
 	}
-	if len(x.MerklePaths) != 0 {
-		value := protoreflect.ValueOfList(&_OracleDataProof_3_list{list: &x.MerklePaths})
-		if !f(fd_OracleDataProof_merkle_paths, value) {
+	if len(x.EvmProofBytes) != 0 {
+		value := protoreflect.ValueOfBytes(x.EvmProofBytes)
+		if !f(fd_CountProofResult_evm_proof_bytes, value) {
 			return
 		}
 	}
@@ -6218,19 +5909,17 @@ func (x *fastReflection_OracleDataProof) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_OracleDataProof) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CountProofResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.OracleDataProof.result":
-		return x.Result != nil
-	case "band.base.oracle.v1.OracleDataProof.version":
-		return x.Version != uint64(0)
-	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
-		return len(x.MerklePaths) != 0
+	case "band.base.oracle.v1.CountProofResult.proof":
+		return x.Proof != nil
+	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
+		return len(x.EvmProofBytes) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6240,19 +5929,17 @@ func (x *fastReflection_OracleDataProof) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleDataProof) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CountProofResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.OracleDataProof.result":
-		x.Result = nil
-	case "band.base.oracle.v1.OracleDataProof.version":
-		x.Version = uint64(0)
-	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
-		x.MerklePaths = nil
+	case "band.base.oracle.v1.CountProofResult.proof":
+		x.Proof = nil
+	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
+		x.EvmProofBytes = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6262,25 +5949,19 @@ func (x *fastReflection_OracleDataProof) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_OracleDataProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CountProofResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.OracleDataProof.result":
-		value := x.Result
+	case "band.base.oracle.v1.CountProofResult.proof":
+		value := x.Proof
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.base.oracle.v1.OracleDataProof.version":
-		value := x.Version
-		return protoreflect.ValueOfUint64(value)
-	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
-		if len(x.MerklePaths) == 0 {
-			return protoreflect.ValueOfList(&_OracleDataProof_3_list{})
-		}
-		listValue := &_OracleDataProof_3_list{list: &x.MerklePaths}
-		return protoreflect.ValueOfList(listValue)
+	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
+		value := x.EvmProofBytes
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6294,21 +5975,17 @@ func (x *fastReflection_OracleDataProof) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleDataProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CountProofResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.OracleDataProof.result":
-		x.Result = value.Message().Interface().(*v1.Result)
-	case "band.base.oracle.v1.OracleDataProof.version":
-		x.Version = value.Uint()
-	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
-		lv := value.List()
-		clv := lv.(*_OracleDataProof_3_list)
-		x.MerklePaths = *clv.list
+	case "band.base.oracle.v1.CountProofResult.proof":
+		x.Proof = value.Message().Interface().(*CountProof)
+	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
+		x.EvmProofBytes = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6322,57 +5999,48 @@ func (x *fastReflection_OracleDataProof) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleDataProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CountProofResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.OracleDataProof.result":
-		if x.Result == nil {
-			x.Result = new(v1.Result)
-		}
-		return protoreflect.ValueOfMessage(x.Result.ProtoReflect())
-	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
-		if x.MerklePaths == nil {
-			x.MerklePaths = []*IAVLMerklePath{}
+	case "band.base.oracle.v1.CountProofResult.proof":
+		if x.Proof == nil {
+			x.Proof = new(CountProof)
 		}
-		value := &_OracleDataProof_3_list{list: &x.MerklePaths}
-		return protoreflect.ValueOfList(value)
-	case "band.base.oracle.v1.OracleDataProof.version":
-		panic(fmt.Errorf("field version of message band.base.oracle.v1.OracleDataProof is not mutable"))
+		return protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
+		panic(fmt.Errorf("field evm_proof_bytes of message band.base.oracle.v1.CountProofResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_OracleDataProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CountProofResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.OracleDataProof.result":
-		m := new(v1.Result)
+	case "band.base.oracle.v1.CountProofResult.proof":
+		m := new(CountProof)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.base.oracle.v1.OracleDataProof.version":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
-		list := []*IAVLMerklePath{}
-		return protoreflect.ValueOfList(&_OracleDataProof_3_list{list: &list})
+	case "band.base.oracle.v1.CountProofResult.evm_proof_bytes":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_OracleDataProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CountProofResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.OracleDataProof", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.CountProofResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6380,7 +6048,7 @@ func (x *fastReflection_OracleDataProof) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_OracleDataProof) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CountProofResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6391,7 +6059,7 @@ func (x *fastReflection_OracleDataProof) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleDataProof) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CountProofResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6403,7 +6071,7 @@ func (x *fastReflection_OracleDataProof) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_OracleDataProof) IsValid() bool {
+func (x *fastReflection_CountProofResult) IsValid() bool {
 	return x != nil
 }
 
@@ -6413,9 +6081,9 @@ func (x *fastReflection_OracleDataProof) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CountProofResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*OracleDataProof)
+		x := input.Message.Interface().(*CountProofResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6427,18 +6095,13 @@ func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Result != nil {
-			l = options.Size(x.Result)
+		if x.Proof != nil {
+			l = options.Size(x.Proof)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Version != 0 {
-			n += 1 + runtime.Sov(uint64(x.Version))
-		}
-		if len(x.MerklePaths) > 0 {
-			for _, e := range x.MerklePaths {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.EvmProofBytes)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6450,7 +6113,7 @@ func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*OracleDataProof)
+		x := input.Message.Interface().(*CountProofResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6469,29 +6132,15 @@ func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MerklePaths) > 0 {
-			for iNdEx := len(x.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.MerklePaths[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if x.Version != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+		if len(x.EvmProofBytes) > 0 {
+			i -= len(x.EvmProofBytes)
+			copy(dAtA[i:], x.EvmProofBytes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvmProofBytes)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if x.Result != nil {
-			encoded, err := options.Marshal(x.Result)
+		if x.Proof != nil {
+			encoded, err := options.Marshal(x.Proof)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6515,7 +6164,7 @@ func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*OracleDataProof)
+		x := input.Message.Interface().(*CountProofResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6547,15 +6196,15 @@ func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleDataProof: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CountProofResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleDataProof: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CountProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6582,18 +6231,18 @@ func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Result == nil {
-					x.Result = &v1.Result{}
+				if x.Proof == nil {
+					x.Proof = &CountProof{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Result); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proof); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
 				}
-				x.Version = 0
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6603,43 +6252,24 @@ func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Version |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MerklePaths = append(x.MerklePaths, &IAVLMerklePath{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MerklePaths[len(x.MerklePaths)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.EvmProofBytes = append(x.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.EvmProofBytes == nil {
+					x.EvmProofBytes = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -6678,33 +6308,27 @@ func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_IAVLMerklePath                  protoreflect.MessageDescriptor
-	fd_IAVLMerklePath_is_data_on_right protoreflect.FieldDescriptor
-	fd_IAVLMerklePath_subtree_height   protoreflect.FieldDescriptor
-	fd_IAVLMerklePath_subtree_size     protoreflect.FieldDescriptor
-	fd_IAVLMerklePath_subtree_version  protoreflect.FieldDescriptor
-	fd_IAVLMerklePath_sibling_hash     protoreflect.FieldDescriptor
+	md_PriceProofResult                 protoreflect.MessageDescriptor
+	fd_PriceProofResult_proof           protoreflect.FieldDescriptor
+	fd_PriceProofResult_evm_proof_bytes protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_IAVLMerklePath = File_band_base_oracle_v1_proof_proto.Messages().ByName("IAVLMerklePath")
-	fd_IAVLMerklePath_is_data_on_right = md_IAVLMerklePath.Fields().ByName("is_data_on_right")
-	fd_IAVLMerklePath_subtree_height = md_IAVLMerklePath.Fields().ByName("subtree_height")
-	fd_IAVLMerklePath_subtree_size = md_IAVLMerklePath.Fields().ByName("subtree_size")
-	fd_IAVLMerklePath_subtree_version = md_IAVLMerklePath.Fields().ByName("subtree_version")
-	fd_IAVLMerklePath_sibling_hash = md_IAVLMerklePath.Fields().ByName("sibling_hash")
+	md_PriceProofResult = File_band_base_oracle_v1_proof_proto.Messages().ByName("PriceProofResult")
+	fd_PriceProofResult_proof = md_PriceProofResult.Fields().ByName("proof")
+	fd_PriceProofResult_evm_proof_bytes = md_PriceProofResult.Fields().ByName("evm_proof_bytes")
 }
 
-var _ protoreflect.Message = (*fastReflection_IAVLMerklePath)(nil)
+var _ protoreflect.Message = (*fastReflection_PriceProofResult)(nil)
 
-type fastReflection_IAVLMerklePath IAVLMerklePath
+type fastReflection_PriceProofResult PriceProofResult
 
-func (x *IAVLMerklePath) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_IAVLMerklePath)(x)
+func (x *PriceProofResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceProofResult)(x)
 }
 
-func (x *IAVLMerklePath) slowProtoReflect() protoreflect.Message {
+func (x *PriceProofResult) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6716,43 +6340,43 @@ func (x *IAVLMerklePath) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_IAVLMerklePath_messageType fastReflection_IAVLMerklePath_messageType
-var _ protoreflect.MessageType = fastReflection_IAVLMerklePath_messageType{}
+var _fastReflection_PriceProofResult_messageType fastReflection_PriceProofResult_messageType
+var _ protoreflect.MessageType = fastReflection_PriceProofResult_messageType{}
 
-type fastReflection_IAVLMerklePath_messageType struct{}
+type fastReflection_PriceProofResult_messageType struct{}
 
-func (x fastReflection_IAVLMerklePath_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_IAVLMerklePath)(nil)
+func (x fastReflection_PriceProofResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceProofResult)(nil)
 }
-func (x fastReflection_IAVLMerklePath_messageType) New() protoreflect.Message {
-	return new(fastReflection_IAVLMerklePath)
+func (x fastReflection_PriceProofResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceProofResult)
 }
-func (x fastReflection_IAVLMerklePath_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_IAVLMerklePath
+func (x fastReflection_PriceProofResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceProofResult
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_IAVLMerklePath) Descriptor() protoreflect.MessageDescriptor {
-	return md_IAVLMerklePath
+func (x *fastReflection_PriceProofResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceProofResult
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_IAVLMerklePath) Type() protoreflect.MessageType {
-	return _fastReflection_IAVLMerklePath_messageType
+func (x *fastReflection_PriceProofResult) Type() protoreflect.MessageType {
+	return _fastReflection_PriceProofResult_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_IAVLMerklePath) New() protoreflect.Message {
-	return new(fastReflection_IAVLMerklePath)
+func (x *fastReflection_PriceProofResult) New() protoreflect.Message {
+	return new(fastReflection_PriceProofResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_IAVLMerklePath) Interface() protoreflect.ProtoMessage {
-	return (*IAVLMerklePath)(x)
+func (x *fastReflection_PriceProofResult) Interface() protoreflect.ProtoMessage {
+	return (*PriceProofResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6760,34 +6384,16 @@ func (x *fastReflection_IAVLMerklePath) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_IAVLMerklePath) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.IsDataOnRight != false {
-		value := protoreflect.ValueOfBool(x.IsDataOnRight)
-		if !f(fd_IAVLMerklePath_is_data_on_right, value) {
-			return
-		}
-	}
-	if x.SubtreeHeight != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.SubtreeHeight)
-		if !f(fd_IAVLMerklePath_subtree_height, value) {
-			return
-		}
-	}
-	if x.SubtreeSize != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.SubtreeSize)
-		if !f(fd_IAVLMerklePath_subtree_size, value) {
-			return
-		}
-	}
-	if x.SubtreeVersion != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.SubtreeVersion)
-		if !f(fd_IAVLMerklePath_subtree_version, value) {
+func (x *fastReflection_PriceProofResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Proof != nil {
+		value := protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+		if !f(fd_PriceProofResult_proof, value) {
 			return
 		}
 	}
-	if len(x.SiblingHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.SiblingHash)
-		if !f(fd_IAVLMerklePath_sibling_hash, value) {
+	if len(x.EvmProofBytes) != 0 {
+		value := protoreflect.ValueOfBytes(x.EvmProofBytes)
+		if !f(fd_PriceProofResult_evm_proof_bytes, value) {
 			return
 		}
 	}
@@ -6804,23 +6410,17 @@ func (x *fastReflection_IAVLMerklePath) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_IAVLMerklePath) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PriceProofResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
-		return x.IsDataOnRight != false
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
-		return x.SubtreeHeight != uint32(0)
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
-		return x.SubtreeSize != uint64(0)
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
-		return x.SubtreeVersion != uint64(0)
-	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
-		return len(x.SiblingHash) != 0
+	case "band.base.oracle.v1.PriceProofResult.proof":
+		return x.Proof != nil
+	case "band.base.oracle.v1.PriceProofResult.evm_proof_bytes":
+		return len(x.EvmProofBytes) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6830,23 +6430,17 @@ func (x *fastReflection_IAVLMerklePath) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IAVLMerklePath) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PriceProofResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
-		x.IsDataOnRight = false
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
-		x.SubtreeHeight = uint32(0)
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
-		x.SubtreeSize = uint64(0)
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
-		x.SubtreeVersion = uint64(0)
-	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
-		x.SiblingHash = nil
+	case "band.base.oracle.v1.PriceProofResult.proof":
+		x.Proof = nil
+	case "band.base.oracle.v1.PriceProofResult.evm_proof_bytes":
+		x.EvmProofBytes = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6856,28 +6450,19 @@ func (x *fastReflection_IAVLMerklePath) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_IAVLMerklePath) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceProofResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
-		value := x.IsDataOnRight
-		return protoreflect.ValueOfBool(value)
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
-		value := x.SubtreeHeight
-		return protoreflect.ValueOfUint32(value)
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
-		value := x.SubtreeSize
-		return protoreflect.ValueOfUint64(value)
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
-		value := x.SubtreeVersion
-		return protoreflect.ValueOfUint64(value)
-	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
-		value := x.SiblingHash
+	case "band.base.oracle.v1.PriceProofResult.proof":
+		value := x.Proof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.PriceProofResult.evm_proof_bytes":
+		value := x.EvmProofBytes
 		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProofResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6891,23 +6476,17 @@ func (x *fastReflection_IAVLMerklePath) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IAVLMerklePath) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PriceProofResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
-		x.IsDataOnRight = value.Bool()
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
-		x.SubtreeHeight = uint32(value.Uint())
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
-		x.SubtreeSize = value.Uint()
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
-		x.SubtreeVersion = value.Uint()
-	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
-		x.SiblingHash = value.Bytes()
+	case "band.base.oracle.v1.PriceProofResult.proof":
+		x.Proof = value.Message().Interface().(*PriceMultiProof)
+	case "band.base.oracle.v1.PriceProofResult.evm_proof_bytes":
+		x.EvmProofBytes = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6921,56 +6500,48 @@ func (x *fastReflection_IAVLMerklePath) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IAVLMerklePath) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceProofResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
-		panic(fmt.Errorf("field is_data_on_right of message band.base.oracle.v1.IAVLMerklePath is not mutable"))
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
-		panic(fmt.Errorf("field subtree_height of message band.base.oracle.v1.IAVLMerklePath is not mutable"))
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
-		panic(fmt.Errorf("field subtree_size of message band.base.oracle.v1.IAVLMerklePath is not mutable"))
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
-		panic(fmt.Errorf("field subtree_version of message band.base.oracle.v1.IAVLMerklePath is not mutable"))
-	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
-		panic(fmt.Errorf("field sibling_hash of message band.base.oracle.v1.IAVLMerklePath is not mutable"))
+	case "band.base.oracle.v1.PriceProofResult.proof":
+		if x.Proof == nil {
+			x.Proof = new(PriceMultiProof)
+		}
+		return protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+	case "band.base.oracle.v1.PriceProofResult.evm_proof_bytes":
+		panic(fmt.Errorf("field evm_proof_bytes of message band.base.oracle.v1.PriceProofResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_IAVLMerklePath) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceProofResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
-		return protoreflect.ValueOfBool(false)
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
+	case "band.base.oracle.v1.PriceProofResult.proof":
+		m := new(PriceMultiProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.PriceProofResult.evm_proof_bytes":
 		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_IAVLMerklePath) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PriceProofResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.IAVLMerklePath", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.PriceProofResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6978,7 +6549,7 @@ func (x *fastReflection_IAVLMerklePath) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_IAVLMerklePath) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PriceProofResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6989,7 +6560,7 @@ func (x *fastReflection_IAVLMerklePath) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IAVLMerklePath) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PriceProofResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7001,7 +6572,7 @@ func (x *fastReflection_IAVLMerklePath) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_IAVLMerklePath) IsValid() bool {
+func (x *fastReflection_PriceProofResult) IsValid() bool {
 	return x != nil
 }
 
@@ -7011,9 +6582,9 @@ func (x *fastReflection_IAVLMerklePath) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PriceProofResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*IAVLMerklePath)
+		x := input.Message.Interface().(*PriceProofResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7025,19 +6596,11 @@ func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.IsDataOnRight {
-			n += 2
-		}
-		if x.SubtreeHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.SubtreeHeight))
-		}
-		if x.SubtreeSize != 0 {
-			n += 1 + runtime.Sov(uint64(x.SubtreeSize))
-		}
-		if x.SubtreeVersion != 0 {
-			n += 1 + runtime.Sov(uint64(x.SubtreeVersion))
+		if x.Proof != nil {
+			l = options.Size(x.Proof)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.SiblingHash)
+		l = len(x.EvmProofBytes)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -7051,7 +6614,7 @@ func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*IAVLMerklePath)
+		x := input.Message.Interface().(*PriceProofResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7070,37 +6633,26 @@ func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.SiblingHash) > 0 {
-			i -= len(x.SiblingHash)
-			copy(dAtA[i:], x.SiblingHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SiblingHash)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if x.SubtreeVersion != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubtreeVersion))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.SubtreeSize != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubtreeSize))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.SubtreeHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubtreeHeight))
+		if len(x.EvmProofBytes) > 0 {
+			i -= len(x.EvmProofBytes)
+			copy(dAtA[i:], x.EvmProofBytes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvmProofBytes)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if x.IsDataOnRight {
-			i--
-			if x.IsDataOnRight {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+		if x.Proof != nil {
+			encoded, err := options.Marshal(x.Proof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7113,7 +6665,7 @@ func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*IAVLMerklePath)
+		x := input.Message.Interface().(*PriceProofResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7145,17 +6697,17 @@ func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IAVLMerklePath: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceProofResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IAVLMerklePath: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsDataOnRight", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 				}
-				var v int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7165,72 +6717,31 @@ func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.IsDataOnRight = bool(v != 0)
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubtreeHeight", wireType)
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.SubtreeHeight = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.SubtreeHeight |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubtreeSize", wireType)
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.SubtreeSize = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.SubtreeSize |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubtreeVersion", wireType)
+				if x.Proof == nil {
+					x.Proof = &PriceMultiProof{}
 				}
-				x.SubtreeVersion = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.SubtreeVersion |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proof); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			case 5:
+				iNdEx = postIndex
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SiblingHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -7257,9 +6768,9 @@ func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SiblingHash = append(x.SiblingHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.SiblingHash == nil {
-					x.SiblingHash = []byte{}
+				x.EvmProofBytes = append(x.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.EvmProofBytes == nil {
+					x.EvmProofBytes = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -7297,83 +6808,28 @@ func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_BlockRelayProof_4_list)(nil)
-
-type _BlockRelayProof_4_list struct {
-	list *[]*TMSignature
-}
-
-func (x *_BlockRelayProof_4_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_BlockRelayProof_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_BlockRelayProof_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*TMSignature)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_BlockRelayProof_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*TMSignature)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_BlockRelayProof_4_list) AppendMutable() protoreflect.Value {
-	v := new(TMSignature)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_BlockRelayProof_4_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_BlockRelayProof_4_list) NewElement() protoreflect.Value {
-	v := new(TMSignature)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_BlockRelayProof_4_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_BlockRelayProof                           protoreflect.MessageDescriptor
-	fd_BlockRelayProof_multi_store_proof         protoreflect.FieldDescriptor
-	fd_BlockRelayProof_block_header_merkle_parts protoreflect.FieldDescriptor
-	fd_BlockRelayProof_common_encoded_vote_part  protoreflect.FieldDescriptor
-	fd_BlockRelayProof_signatures                protoreflect.FieldDescriptor
+	md_PacketProofResult                 protoreflect.MessageDescriptor
+	fd_PacketProofResult_proof           protoreflect.FieldDescriptor
+	fd_PacketProofResult_evm_proof_bytes protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_BlockRelayProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("BlockRelayProof")
-	fd_BlockRelayProof_multi_store_proof = md_BlockRelayProof.Fields().ByName("multi_store_proof")
-	fd_BlockRelayProof_block_header_merkle_parts = md_BlockRelayProof.Fields().ByName("block_header_merkle_parts")
-	fd_BlockRelayProof_common_encoded_vote_part = md_BlockRelayProof.Fields().ByName("common_encoded_vote_part")
-	fd_BlockRelayProof_signatures = md_BlockRelayProof.Fields().ByName("signatures")
+	md_PacketProofResult = File_band_base_oracle_v1_proof_proto.Messages().ByName("PacketProofResult")
+	fd_PacketProofResult_proof = md_PacketProofResult.Fields().ByName("proof")
+	fd_PacketProofResult_evm_proof_bytes = md_PacketProofResult.Fields().ByName("evm_proof_bytes")
 }
 
-var _ protoreflect.Message = (*fastReflection_BlockRelayProof)(nil)
+var _ protoreflect.Message = (*fastReflection_PacketProofResult)(nil)
 
-type fastReflection_BlockRelayProof BlockRelayProof
+type fastReflection_PacketProofResult PacketProofResult
 
-func (x *BlockRelayProof) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_BlockRelayProof)(x)
+func (x *PacketProofResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PacketProofResult)(x)
 }
 
-func (x *BlockRelayProof) slowProtoReflect() protoreflect.Message {
+func (x *PacketProofResult) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7385,43 +6841,43 @@ func (x *BlockRelayProof) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_BlockRelayProof_messageType fastReflection_BlockRelayProof_messageType
-var _ protoreflect.MessageType = fastReflection_BlockRelayProof_messageType{}
+var _fastReflection_PacketProofResult_messageType fastReflection_PacketProofResult_messageType
+var _ protoreflect.MessageType = fastReflection_PacketProofResult_messageType{}
 
-type fastReflection_BlockRelayProof_messageType struct{}
+type fastReflection_PacketProofResult_messageType struct{}
 
-func (x fastReflection_BlockRelayProof_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_BlockRelayProof)(nil)
+func (x fastReflection_PacketProofResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PacketProofResult)(nil)
 }
-func (x fastReflection_BlockRelayProof_messageType) New() protoreflect.Message {
-	return new(fastReflection_BlockRelayProof)
+func (x fastReflection_PacketProofResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_PacketProofResult)
 }
-func (x fastReflection_BlockRelayProof_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_BlockRelayProof
+func (x fastReflection_PacketProofResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PacketProofResult
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_BlockRelayProof) Descriptor() protoreflect.MessageDescriptor {
-	return md_BlockRelayProof
+func (x *fastReflection_PacketProofResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_PacketProofResult
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_BlockRelayProof) Type() protoreflect.MessageType {
-	return _fastReflection_BlockRelayProof_messageType
+func (x *fastReflection_PacketProofResult) Type() protoreflect.MessageType {
+	return _fastReflection_PacketProofResult_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_BlockRelayProof) New() protoreflect.Message {
-	return new(fastReflection_BlockRelayProof)
+func (x *fastReflection_PacketProofResult) New() protoreflect.Message {
+	return new(fastReflection_PacketProofResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_BlockRelayProof) Interface() protoreflect.ProtoMessage {
-	return (*BlockRelayProof)(x)
+func (x *fastReflection_PacketProofResult) Interface() protoreflect.ProtoMessage {
+	return (*PacketProofResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7429,28 +6885,16 @@ func (x *fastReflection_BlockRelayProof) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_BlockRelayProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.MultiStoreProof != nil {
-		value := protoreflect.ValueOfMessage(x.MultiStoreProof.ProtoReflect())
-		if !f(fd_BlockRelayProof_multi_store_proof, value) {
-			return
-		}
-	}
-	if x.BlockHeaderMerkleParts != nil {
-		value := protoreflect.ValueOfMessage(x.BlockHeaderMerkleParts.ProtoReflect())
-		if !f(fd_BlockRelayProof_block_header_merkle_parts, value) {
-			return
-		}
-	}
-	if x.CommonEncodedVotePart != nil {
-		value := protoreflect.ValueOfMessage(x.CommonEncodedVotePart.ProtoReflect())
-		if !f(fd_BlockRelayProof_common_encoded_vote_part, value) {
+func (x *fastReflection_PacketProofResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Proof != nil {
+		value := protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+		if !f(fd_PacketProofResult_proof, value) {
 			return
 		}
 	}
-	if len(x.Signatures) != 0 {
-		value := protoreflect.ValueOfList(&_BlockRelayProof_4_list{list: &x.Signatures})
-		if !f(fd_BlockRelayProof_signatures, value) {
+	if len(x.EvmProofBytes) != 0 {
+		value := protoreflect.ValueOfBytes(x.EvmProofBytes)
+		if !f(fd_PacketProofResult_evm_proof_bytes, value) {
 			return
 		}
 	}
@@ -7467,21 +6911,17 @@ func (x *fastReflection_BlockRelayProof) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_BlockRelayProof) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PacketProofResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
-		return x.MultiStoreProof != nil
-	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
-		return x.BlockHeaderMerkleParts != nil
-	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
-		return x.CommonEncodedVotePart != nil
-	case "band.base.oracle.v1.BlockRelayProof.signatures":
-		return len(x.Signatures) != 0
+	case "band.base.oracle.v1.PacketProofResult.proof":
+		return x.Proof != nil
+	case "band.base.oracle.v1.PacketProofResult.evm_proof_bytes":
+		return len(x.EvmProofBytes) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PacketProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PacketProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7491,21 +6931,17 @@ func (x *fastReflection_BlockRelayProof) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BlockRelayProof) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PacketProofResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
-		x.MultiStoreProof = nil
-	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
-		x.BlockHeaderMerkleParts = nil
-	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
-		x.CommonEncodedVotePart = nil
-	case "band.base.oracle.v1.BlockRelayProof.signatures":
-		x.Signatures = nil
+	case "band.base.oracle.v1.PacketProofResult.proof":
+		x.Proof = nil
+	case "band.base.oracle.v1.PacketProofResult.evm_proof_bytes":
+		x.EvmProofBytes = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PacketProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PacketProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7515,28 +6951,19 @@ func (x *fastReflection_BlockRelayProof) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_BlockRelayProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PacketProofResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
-		value := x.MultiStoreProof
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
-		value := x.BlockHeaderMerkleParts
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
-		value := x.CommonEncodedVotePart
+	case "band.base.oracle.v1.PacketProofResult.proof":
+		value := x.Proof
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.base.oracle.v1.BlockRelayProof.signatures":
-		if len(x.Signatures) == 0 {
-			return protoreflect.ValueOfList(&_BlockRelayProof_4_list{})
-		}
-		listValue := &_BlockRelayProof_4_list{list: &x.Signatures}
-		return protoreflect.ValueOfList(listValue)
+	case "band.base.oracle.v1.PacketProofResult.evm_proof_bytes":
+		value := x.EvmProofBytes
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PacketProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PacketProofResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7550,23 +6977,17 @@ func (x *fastReflection_BlockRelayProof) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BlockRelayProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PacketProofResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
-		x.MultiStoreProof = value.Message().Interface().(*MultiStoreProof)
-	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
-		x.BlockHeaderMerkleParts = value.Message().Interface().(*BlockHeaderMerkleParts)
-	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
-		x.CommonEncodedVotePart = value.Message().Interface().(*CommonEncodedVotePart)
-	case "band.base.oracle.v1.BlockRelayProof.signatures":
-		lv := value.List()
-		clv := lv.(*_BlockRelayProof_4_list)
-		x.Signatures = *clv.list
+	case "band.base.oracle.v1.PacketProofResult.proof":
+		x.Proof = value.Message().Interface().(*PacketProof)
+	case "band.base.oracle.v1.PacketProofResult.evm_proof_bytes":
+		x.EvmProofBytes = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PacketProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PacketProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7580,69 +7001,48 @@ func (x *fastReflection_BlockRelayProof) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BlockRelayProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PacketProofResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
-		if x.MultiStoreProof == nil {
-			x.MultiStoreProof = new(MultiStoreProof)
-		}
-		return protoreflect.ValueOfMessage(x.MultiStoreProof.ProtoReflect())
-	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
-		if x.BlockHeaderMerkleParts == nil {
-			x.BlockHeaderMerkleParts = new(BlockHeaderMerkleParts)
-		}
-		return protoreflect.ValueOfMessage(x.BlockHeaderMerkleParts.ProtoReflect())
-	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
-		if x.CommonEncodedVotePart == nil {
-			x.CommonEncodedVotePart = new(CommonEncodedVotePart)
+	case "band.base.oracle.v1.PacketProofResult.proof":
+		if x.Proof == nil {
+			x.Proof = new(PacketProof)
 		}
-		return protoreflect.ValueOfMessage(x.CommonEncodedVotePart.ProtoReflect())
-	case "band.base.oracle.v1.BlockRelayProof.signatures":
-		if x.Signatures == nil {
-			x.Signatures = []*TMSignature{}
+		return protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+	case "band.base.oracle.v1.PacketProofResult.evm_proof_bytes":
+		panic(fmt.Errorf("field evm_proof_bytes of message band.base.oracle.v1.PacketProofResult is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PacketProofResult"))
 		}
-		value := &_BlockRelayProof_4_list{list: &x.Signatures}
-		return protoreflect.ValueOfList(value)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
-		}
-		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PacketProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_BlockRelayProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PacketProofResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
-		m := new(MultiStoreProof)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
-		m := new(BlockHeaderMerkleParts)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
-		m := new(CommonEncodedVotePart)
+	case "band.base.oracle.v1.PacketProofResult.proof":
+		m := new(PacketProof)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.base.oracle.v1.BlockRelayProof.signatures":
-		list := []*TMSignature{}
-		return protoreflect.ValueOfList(&_BlockRelayProof_4_list{list: &list})
+	case "band.base.oracle.v1.PacketProofResult.evm_proof_bytes":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PacketProofResult"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PacketProofResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_BlockRelayProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PacketProofResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.BlockRelayProof", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.PacketProofResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7650,7 +7050,7 @@ func (x *fastReflection_BlockRelayProof) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_BlockRelayProof) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PacketProofResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7661,7 +7061,7 @@ func (x *fastReflection_BlockRelayProof) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BlockRelayProof) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PacketProofResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7673,7 +7073,7 @@ func (x *fastReflection_BlockRelayProof) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_BlockRelayProof) IsValid() bool {
+func (x *fastReflection_PacketProofResult) IsValid() bool {
 	return x != nil
 }
 
@@ -7683,9 +7083,9 @@ func (x *fastReflection_BlockRelayProof) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PacketProofResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*BlockRelayProof)
+		x := input.Message.Interface().(*PacketProofResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7697,24 +7097,14 @@ func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.MultiStoreProof != nil {
-			l = options.Size(x.MultiStoreProof)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.BlockHeaderMerkleParts != nil {
-			l = options.Size(x.BlockHeaderMerkleParts)
+		if x.Proof != nil {
+			l = options.Size(x.Proof)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.CommonEncodedVotePart != nil {
-			l = options.Size(x.CommonEncodedVotePart)
+		l = len(x.EvmProofBytes)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Signatures) > 0 {
-			for _, e := range x.Signatures {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7725,7 +7115,7 @@ func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*BlockRelayProof)
+		x := input.Message.Interface().(*PacketProofResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7744,52 +7134,15 @@ func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Signatures) > 0 {
-			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Signatures[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
-			}
-		}
-		if x.CommonEncodedVotePart != nil {
-			encoded, err := options.Marshal(x.CommonEncodedVotePart)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.BlockHeaderMerkleParts != nil {
-			encoded, err := options.Marshal(x.BlockHeaderMerkleParts)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.EvmProofBytes) > 0 {
+			i -= len(x.EvmProofBytes)
+			copy(dAtA[i:], x.EvmProofBytes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvmProofBytes)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.MultiStoreProof != nil {
-			encoded, err := options.Marshal(x.MultiStoreProof)
+		if x.Proof != nil {
+			encoded, err := options.Marshal(x.Proof)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7813,7 +7166,7 @@ func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*BlockRelayProof)
+		x := input.Message.Interface().(*PacketProofResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7845,15 +7198,15 @@ func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockRelayProof: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PacketProofResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockRelayProof: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PacketProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultiStoreProof", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -7880,90 +7233,18 @@ func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MultiStoreProof == nil {
-					x.MultiStoreProof = &MultiStoreProof{}
+				if x.Proof == nil {
+					x.Proof = &PacketProof{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MultiStoreProof); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proof); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderMerkleParts", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.BlockHeaderMerkleParts == nil {
-					x.BlockHeaderMerkleParts = &BlockHeaderMerkleParts{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockHeaderMerkleParts); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommonEncodedVotePart", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.CommonEncodedVotePart == nil {
-					x.CommonEncodedVotePart = &CommonEncodedVotePart{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CommonEncodedVotePart); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7973,24 +7254,24 @@ func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Signatures = append(x.Signatures, &TMSignature{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.EvmProofBytes = append(x.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.EvmProofBytes == nil {
+					x.EvmProofBytes = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -8029,35 +7310,29 @@ func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MultiStoreProof                                            protoreflect.MessageDescriptor
-	fd_MultiStoreProof_oracle_iavl_state_hash                     protoreflect.FieldDescriptor
-	fd_MultiStoreProof_mint_store_merkle_hash                     protoreflect.FieldDescriptor
-	fd_MultiStoreProof_params_to_restake_stores_merkle_hash       protoreflect.FieldDescriptor
-	fd_MultiStoreProof_rollingseed_to_transfer_stores_merkle_hash protoreflect.FieldDescriptor
-	fd_MultiStoreProof_tss_to_upgrade_stores_merkle_hash          protoreflect.FieldDescriptor
-	fd_MultiStoreProof_auth_to_icahost_stores_merkle_hash         protoreflect.FieldDescriptor
+	md_SingleProof                   protoreflect.MessageDescriptor
+	fd_SingleProof_block_height      protoreflect.FieldDescriptor
+	fd_SingleProof_oracle_data_proof protoreflect.FieldDescriptor
+	fd_SingleProof_block_relay_proof protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_MultiStoreProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("MultiStoreProof")
-	fd_MultiStoreProof_oracle_iavl_state_hash = md_MultiStoreProof.Fields().ByName("oracle_iavl_state_hash")
-	fd_MultiStoreProof_mint_store_merkle_hash = md_MultiStoreProof.Fields().ByName("mint_store_merkle_hash")
-	fd_MultiStoreProof_params_to_restake_stores_merkle_hash = md_MultiStoreProof.Fields().ByName("params_to_restake_stores_merkle_hash")
-	fd_MultiStoreProof_rollingseed_to_transfer_stores_merkle_hash = md_MultiStoreProof.Fields().ByName("rollingseed_to_transfer_stores_merkle_hash")
-	fd_MultiStoreProof_tss_to_upgrade_stores_merkle_hash = md_MultiStoreProof.Fields().ByName("tss_to_upgrade_stores_merkle_hash")
-	fd_MultiStoreProof_auth_to_icahost_stores_merkle_hash = md_MultiStoreProof.Fields().ByName("auth_to_icahost_stores_merkle_hash")
+	md_SingleProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("SingleProof")
+	fd_SingleProof_block_height = md_SingleProof.Fields().ByName("block_height")
+	fd_SingleProof_oracle_data_proof = md_SingleProof.Fields().ByName("oracle_data_proof")
+	fd_SingleProof_block_relay_proof = md_SingleProof.Fields().ByName("block_relay_proof")
 }
 
-var _ protoreflect.Message = (*fastReflection_MultiStoreProof)(nil)
+var _ protoreflect.Message = (*fastReflection_SingleProof)(nil)
 
-type fastReflection_MultiStoreProof MultiStoreProof
+type fastReflection_SingleProof SingleProof
 
-func (x *MultiStoreProof) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MultiStoreProof)(x)
+func (x *SingleProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SingleProof)(x)
 }
 
-func (x *MultiStoreProof) slowProtoReflect() protoreflect.Message {
+func (x *SingleProof) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8069,43 +7344,43 @@ func (x *MultiStoreProof) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MultiStoreProof_messageType fastReflection_MultiStoreProof_messageType
-var _ protoreflect.MessageType = fastReflection_MultiStoreProof_messageType{}
+var _fastReflection_SingleProof_messageType fastReflection_SingleProof_messageType
+var _ protoreflect.MessageType = fastReflection_SingleProof_messageType{}
 
-type fastReflection_MultiStoreProof_messageType struct{}
+type fastReflection_SingleProof_messageType struct{}
 
-func (x fastReflection_MultiStoreProof_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MultiStoreProof)(nil)
+func (x fastReflection_SingleProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SingleProof)(nil)
 }
-func (x fastReflection_MultiStoreProof_messageType) New() protoreflect.Message {
-	return new(fastReflection_MultiStoreProof)
+func (x fastReflection_SingleProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_SingleProof)
 }
-func (x fastReflection_MultiStoreProof_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MultiStoreProof
+func (x fastReflection_SingleProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SingleProof
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MultiStoreProof) Descriptor() protoreflect.MessageDescriptor {
-	return md_MultiStoreProof
+func (x *fastReflection_SingleProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_SingleProof
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MultiStoreProof) Type() protoreflect.MessageType {
-	return _fastReflection_MultiStoreProof_messageType
+func (x *fastReflection_SingleProof) Type() protoreflect.MessageType {
+	return _fastReflection_SingleProof_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MultiStoreProof) New() protoreflect.Message {
-	return new(fastReflection_MultiStoreProof)
-}
+func (x *fastReflection_SingleProof) New() protoreflect.Message {
+	return new(fastReflection_SingleProof)
+}
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MultiStoreProof) Interface() protoreflect.ProtoMessage {
-	return (*MultiStoreProof)(x)
+func (x *fastReflection_SingleProof) Interface() protoreflect.ProtoMessage {
+	return (*SingleProof)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8113,40 +7388,22 @@ func (x *fastReflection_MultiStoreProof) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MultiStoreProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.OracleIavlStateHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.OracleIavlStateHash)
-		if !f(fd_MultiStoreProof_oracle_iavl_state_hash, value) {
-			return
-		}
-	}
-	if len(x.MintStoreMerkleHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.MintStoreMerkleHash)
-		if !f(fd_MultiStoreProof_mint_store_merkle_hash, value) {
-			return
-		}
-	}
-	if len(x.ParamsToRestakeStoresMerkleHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.ParamsToRestakeStoresMerkleHash)
-		if !f(fd_MultiStoreProof_params_to_restake_stores_merkle_hash, value) {
-			return
-		}
-	}
-	if len(x.RollingseedToTransferStoresMerkleHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.RollingseedToTransferStoresMerkleHash)
-		if !f(fd_MultiStoreProof_rollingseed_to_transfer_stores_merkle_hash, value) {
+func (x *fastReflection_SingleProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_SingleProof_block_height, value) {
 			return
 		}
 	}
-	if len(x.TssToUpgradeStoresMerkleHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.TssToUpgradeStoresMerkleHash)
-		if !f(fd_MultiStoreProof_tss_to_upgrade_stores_merkle_hash, value) {
+	if x.OracleDataProof != nil {
+		value := protoreflect.ValueOfMessage(x.OracleDataProof.ProtoReflect())
+		if !f(fd_SingleProof_oracle_data_proof, value) {
 			return
 		}
 	}
-	if len(x.AuthToIcahostStoresMerkleHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.AuthToIcahostStoresMerkleHash)
-		if !f(fd_MultiStoreProof_auth_to_icahost_stores_merkle_hash, value) {
+	if x.BlockRelayProof != nil {
+		value := protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
+		if !f(fd_SingleProof_block_relay_proof, value) {
 			return
 		}
 	}
@@ -8163,25 +7420,19 @@ func (x *fastReflection_MultiStoreProof) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MultiStoreProof) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SingleProof) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
-		return len(x.OracleIavlStateHash) != 0
-	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
-		return len(x.MintStoreMerkleHash) != 0
-	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
-		return len(x.ParamsToRestakeStoresMerkleHash) != 0
-	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
-		return len(x.RollingseedToTransferStoresMerkleHash) != 0
-	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
-		return len(x.TssToUpgradeStoresMerkleHash) != 0
-	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
-		return len(x.AuthToIcahostStoresMerkleHash) != 0
+	case "band.base.oracle.v1.SingleProof.block_height":
+		return x.BlockHeight != uint64(0)
+	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
+		return x.OracleDataProof != nil
+	case "band.base.oracle.v1.SingleProof.block_relay_proof":
+		return x.BlockRelayProof != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8191,25 +7442,19 @@ func (x *fastReflection_MultiStoreProof) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiStoreProof) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SingleProof) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
-		x.OracleIavlStateHash = nil
-	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
-		x.MintStoreMerkleHash = nil
-	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
-		x.ParamsToRestakeStoresMerkleHash = nil
-	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
-		x.RollingseedToTransferStoresMerkleHash = nil
-	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
-		x.TssToUpgradeStoresMerkleHash = nil
-	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
-		x.AuthToIcahostStoresMerkleHash = nil
+	case "band.base.oracle.v1.SingleProof.block_height":
+		x.BlockHeight = uint64(0)
+	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
+		x.OracleDataProof = nil
+	case "band.base.oracle.v1.SingleProof.block_relay_proof":
+		x.BlockRelayProof = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8219,31 +7464,22 @@ func (x *fastReflection_MultiStoreProof) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MultiStoreProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SingleProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
-		value := x.OracleIavlStateHash
-		return protoreflect.ValueOfBytes(value)
-	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
-		value := x.MintStoreMerkleHash
-		return protoreflect.ValueOfBytes(value)
-	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
-		value := x.ParamsToRestakeStoresMerkleHash
-		return protoreflect.ValueOfBytes(value)
-	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
-		value := x.RollingseedToTransferStoresMerkleHash
-		return protoreflect.ValueOfBytes(value)
-	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
-		value := x.TssToUpgradeStoresMerkleHash
-		return protoreflect.ValueOfBytes(value)
-	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
-		value := x.AuthToIcahostStoresMerkleHash
-		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.SingleProof.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfUint64(value)
+	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
+		value := x.OracleDataProof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.SingleProof.block_relay_proof":
+		value := x.BlockRelayProof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8257,25 +7493,19 @@ func (x *fastReflection_MultiStoreProof) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiStoreProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SingleProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
-		x.OracleIavlStateHash = value.Bytes()
-	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
-		x.MintStoreMerkleHash = value.Bytes()
-	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
-		x.ParamsToRestakeStoresMerkleHash = value.Bytes()
-	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
-		x.RollingseedToTransferStoresMerkleHash = value.Bytes()
-	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
-		x.TssToUpgradeStoresMerkleHash = value.Bytes()
-	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
-		x.AuthToIcahostStoresMerkleHash = value.Bytes()
+	case "band.base.oracle.v1.SingleProof.block_height":
+		x.BlockHeight = value.Uint()
+	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
+		x.OracleDataProof = value.Message().Interface().(*OracleDataProof)
+	case "band.base.oracle.v1.SingleProof.block_relay_proof":
+		x.BlockRelayProof = value.Message().Interface().(*BlockRelayProof)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8289,60 +7519,56 @@ func (x *fastReflection_MultiStoreProof) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiStoreProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SingleProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
-		panic(fmt.Errorf("field oracle_iavl_state_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
-	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
-		panic(fmt.Errorf("field mint_store_merkle_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
-	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
-		panic(fmt.Errorf("field params_to_restake_stores_merkle_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
-	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
-		panic(fmt.Errorf("field rollingseed_to_transfer_stores_merkle_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
-	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
-		panic(fmt.Errorf("field tss_to_upgrade_stores_merkle_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
-	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
-		panic(fmt.Errorf("field auth_to_icahost_stores_merkle_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
+	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
+		if x.OracleDataProof == nil {
+			x.OracleDataProof = new(OracleDataProof)
+		}
+		return protoreflect.ValueOfMessage(x.OracleDataProof.ProtoReflect())
+	case "band.base.oracle.v1.SingleProof.block_relay_proof":
+		if x.BlockRelayProof == nil {
+			x.BlockRelayProof = new(BlockRelayProof)
+		}
+		return protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
+	case "band.base.oracle.v1.SingleProof.block_height":
+		panic(fmt.Errorf("field block_height of message band.base.oracle.v1.SingleProof is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MultiStoreProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SingleProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
-		return protoreflect.ValueOfBytes(nil)
-	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
-		return protoreflect.ValueOfBytes(nil)
-	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
-		return protoreflect.ValueOfBytes(nil)
-	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
-		return protoreflect.ValueOfBytes(nil)
-	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
-		return protoreflect.ValueOfBytes(nil)
-	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
-		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.SingleProof.block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.base.oracle.v1.SingleProof.oracle_data_proof":
+		m := new(OracleDataProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.SingleProof.block_relay_proof":
+		m := new(BlockRelayProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.SingleProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.SingleProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MultiStoreProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SingleProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.MultiStoreProof", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.SingleProof", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8350,7 +7576,7 @@ func (x *fastReflection_MultiStoreProof) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MultiStoreProof) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SingleProof) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8361,7 +7587,7 @@ func (x *fastReflection_MultiStoreProof) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MultiStoreProof) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SingleProof) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8373,7 +7599,7 @@ func (x *fastReflection_MultiStoreProof) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MultiStoreProof) IsValid() bool {
+func (x *fastReflection_SingleProof) IsValid() bool {
 	return x != nil
 }
 
@@ -8383,9 +7609,9 @@ func (x *fastReflection_MultiStoreProof) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SingleProof) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MultiStoreProof)
+		x := input.Message.Interface().(*SingleProof)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8397,28 +7623,15 @@ func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.OracleIavlStateHash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MintStoreMerkleHash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ParamsToRestakeStoresMerkleHash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.RollingseedToTransferStoresMerkleHash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
-		l = len(x.TssToUpgradeStoresMerkleHash)
-		if l > 0 {
+		if x.OracleDataProof != nil {
+			l = options.Size(x.OracleDataProof)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.AuthToIcahostStoresMerkleHash)
-		if l > 0 {
+		if x.BlockRelayProof != nil {
+			l = options.Size(x.BlockRelayProof)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -8431,7 +7644,7 @@ func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MultiStoreProof)
+		x := input.Message.Interface().(*SingleProof)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8450,47 +7663,38 @@ func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AuthToIcahostStoresMerkleHash) > 0 {
-			i -= len(x.AuthToIcahostStoresMerkleHash)
-			copy(dAtA[i:], x.AuthToIcahostStoresMerkleHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuthToIcahostStoresMerkleHash)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.TssToUpgradeStoresMerkleHash) > 0 {
-			i -= len(x.TssToUpgradeStoresMerkleHash)
-			copy(dAtA[i:], x.TssToUpgradeStoresMerkleHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TssToUpgradeStoresMerkleHash)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.RollingseedToTransferStoresMerkleHash) > 0 {
-			i -= len(x.RollingseedToTransferStoresMerkleHash)
-			copy(dAtA[i:], x.RollingseedToTransferStoresMerkleHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RollingseedToTransferStoresMerkleHash)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.ParamsToRestakeStoresMerkleHash) > 0 {
-			i -= len(x.ParamsToRestakeStoresMerkleHash)
-			copy(dAtA[i:], x.ParamsToRestakeStoresMerkleHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ParamsToRestakeStoresMerkleHash)))
+		if x.BlockRelayProof != nil {
+			encoded, err := options.Marshal(x.BlockRelayProof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.MintStoreMerkleHash) > 0 {
-			i -= len(x.MintStoreMerkleHash)
-			copy(dAtA[i:], x.MintStoreMerkleHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintStoreMerkleHash)))
+		if x.OracleDataProof != nil {
+			encoded, err := options.Marshal(x.OracleDataProof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.OracleIavlStateHash) > 0 {
-			i -= len(x.OracleIavlStateHash)
-			copy(dAtA[i:], x.OracleIavlStateHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OracleIavlStateHash)))
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8503,7 +7707,7 @@ func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MultiStoreProof)
+		x := input.Message.Interface().(*SingleProof)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8535,17 +7739,17 @@ func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiStoreProof: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SingleProof: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiStoreProof: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SingleProof: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleIavlStateHash", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
-				var byteLen int
+				x.BlockHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8555,65 +7759,16 @@ func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					x.BlockHeight |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.OracleIavlStateHash = append(x.OracleIavlStateHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.OracleIavlStateHash == nil {
-					x.OracleIavlStateHash = []byte{}
-				}
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintStoreMerkleHash", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.MintStoreMerkleHash = append(x.MintStoreMerkleHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.MintStoreMerkleHash == nil {
-					x.MintStoreMerkleHash = []byte{}
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParamsToRestakeStoresMerkleHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleDataProof", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8623,65 +7778,33 @@ func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ParamsToRestakeStoresMerkleHash = append(x.ParamsToRestakeStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.ParamsToRestakeStoresMerkleHash == nil {
-					x.ParamsToRestakeStoresMerkleHash = []byte{}
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RollingseedToTransferStoresMerkleHash", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if x.OracleDataProof == nil {
+					x.OracleDataProof = &OracleDataProof{}
 				}
-				x.RollingseedToTransferStoresMerkleHash = append(x.RollingseedToTransferStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.RollingseedToTransferStoresMerkleHash == nil {
-					x.RollingseedToTransferStoresMerkleHash = []byte{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OracleDataProof); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssToUpgradeStoresMerkleHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8691,58 +7814,26 @@ func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TssToUpgradeStoresMerkleHash = append(x.TssToUpgradeStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.TssToUpgradeStoresMerkleHash == nil {
-					x.TssToUpgradeStoresMerkleHash = []byte{}
-				}
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuthToIcahostStoresMerkleHash", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if x.BlockRelayProof == nil {
+					x.BlockRelayProof = &BlockRelayProof{}
 				}
-				x.AuthToIcahostStoresMerkleHash = append(x.AuthToIcahostStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.AuthToIcahostStoresMerkleHash == nil {
-					x.AuthToIcahostStoresMerkleHash = []byte{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockRelayProof); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -8780,42 +7871,83 @@ func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var (
-	md_BlockHeaderMerkleParts                                        protoreflect.MessageDescriptor
-	fd_BlockHeaderMerkleParts_version_and_chain_id_hash              protoreflect.FieldDescriptor
-	fd_BlockHeaderMerkleParts_height                                 protoreflect.FieldDescriptor
-	fd_BlockHeaderMerkleParts_time_second                            protoreflect.FieldDescriptor
-	fd_BlockHeaderMerkleParts_time_nano_second                       protoreflect.FieldDescriptor
-	fd_BlockHeaderMerkleParts_last_block_id_and_other                protoreflect.FieldDescriptor
-	fd_BlockHeaderMerkleParts_next_validator_hash_and_consensus_hash protoreflect.FieldDescriptor
-	fd_BlockHeaderMerkleParts_last_results_hash                      protoreflect.FieldDescriptor
-	fd_BlockHeaderMerkleParts_evidence_and_proposer_hash             protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_MultiProof_2_list)(nil)
 
-func init() {
-	file_band_base_oracle_v1_proof_proto_init()
-	md_BlockHeaderMerkleParts = File_band_base_oracle_v1_proof_proto.Messages().ByName("BlockHeaderMerkleParts")
-	fd_BlockHeaderMerkleParts_version_and_chain_id_hash = md_BlockHeaderMerkleParts.Fields().ByName("version_and_chain_id_hash")
-	fd_BlockHeaderMerkleParts_height = md_BlockHeaderMerkleParts.Fields().ByName("height")
-	fd_BlockHeaderMerkleParts_time_second = md_BlockHeaderMerkleParts.Fields().ByName("time_second")
-	fd_BlockHeaderMerkleParts_time_nano_second = md_BlockHeaderMerkleParts.Fields().ByName("time_nano_second")
-	fd_BlockHeaderMerkleParts_last_block_id_and_other = md_BlockHeaderMerkleParts.Fields().ByName("last_block_id_and_other")
-	fd_BlockHeaderMerkleParts_next_validator_hash_and_consensus_hash = md_BlockHeaderMerkleParts.Fields().ByName("next_validator_hash_and_consensus_hash")
-	fd_BlockHeaderMerkleParts_last_results_hash = md_BlockHeaderMerkleParts.Fields().ByName("last_results_hash")
-	fd_BlockHeaderMerkleParts_evidence_and_proposer_hash = md_BlockHeaderMerkleParts.Fields().ByName("evidence_and_proposer_hash")
+type _MultiProof_2_list struct {
+	list *[]*OracleDataProof
 }
 
-var _ protoreflect.Message = (*fastReflection_BlockHeaderMerkleParts)(nil)
-
-type fastReflection_BlockHeaderMerkleParts BlockHeaderMerkleParts
+func (x *_MultiProof_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-func (x *BlockHeaderMerkleParts) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_BlockHeaderMerkleParts)(x)
+func (x *_MultiProof_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *BlockHeaderMerkleParts) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *_MultiProof_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OracleDataProof)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MultiProof_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OracleDataProof)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MultiProof_2_list) AppendMutable() protoreflect.Value {
+	v := new(OracleDataProof)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MultiProof_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MultiProof_2_list) NewElement() protoreflect.Value {
+	v := new(OracleDataProof)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MultiProof_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MultiProof                         protoreflect.MessageDescriptor
+	fd_MultiProof_block_height            protoreflect.FieldDescriptor
+	fd_MultiProof_oracle_data_multi_proof protoreflect.FieldDescriptor
+	fd_MultiProof_block_relay_proof       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_MultiProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("MultiProof")
+	fd_MultiProof_block_height = md_MultiProof.Fields().ByName("block_height")
+	fd_MultiProof_oracle_data_multi_proof = md_MultiProof.Fields().ByName("oracle_data_multi_proof")
+	fd_MultiProof_block_relay_proof = md_MultiProof.Fields().ByName("block_relay_proof")
+}
+
+var _ protoreflect.Message = (*fastReflection_MultiProof)(nil)
+
+type fastReflection_MultiProof MultiProof
+
+func (x *MultiProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MultiProof)(x)
+}
+
+func (x *MultiProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -8825,43 +7957,43 @@ func (x *BlockHeaderMerkleParts) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_BlockHeaderMerkleParts_messageType fastReflection_BlockHeaderMerkleParts_messageType
-var _ protoreflect.MessageType = fastReflection_BlockHeaderMerkleParts_messageType{}
+var _fastReflection_MultiProof_messageType fastReflection_MultiProof_messageType
+var _ protoreflect.MessageType = fastReflection_MultiProof_messageType{}
 
-type fastReflection_BlockHeaderMerkleParts_messageType struct{}
+type fastReflection_MultiProof_messageType struct{}
 
-func (x fastReflection_BlockHeaderMerkleParts_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_BlockHeaderMerkleParts)(nil)
+func (x fastReflection_MultiProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MultiProof)(nil)
 }
-func (x fastReflection_BlockHeaderMerkleParts_messageType) New() protoreflect.Message {
-	return new(fastReflection_BlockHeaderMerkleParts)
+func (x fastReflection_MultiProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_MultiProof)
 }
-func (x fastReflection_BlockHeaderMerkleParts_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_BlockHeaderMerkleParts
+func (x fastReflection_MultiProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MultiProof
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_BlockHeaderMerkleParts) Descriptor() protoreflect.MessageDescriptor {
-	return md_BlockHeaderMerkleParts
+func (x *fastReflection_MultiProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_MultiProof
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_BlockHeaderMerkleParts) Type() protoreflect.MessageType {
-	return _fastReflection_BlockHeaderMerkleParts_messageType
+func (x *fastReflection_MultiProof) Type() protoreflect.MessageType {
+	return _fastReflection_MultiProof_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_BlockHeaderMerkleParts) New() protoreflect.Message {
-	return new(fastReflection_BlockHeaderMerkleParts)
+func (x *fastReflection_MultiProof) New() protoreflect.Message {
+	return new(fastReflection_MultiProof)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_BlockHeaderMerkleParts) Interface() protoreflect.ProtoMessage {
-	return (*BlockHeaderMerkleParts)(x)
+func (x *fastReflection_MultiProof) Interface() protoreflect.ProtoMessage {
+	return (*MultiProof)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8869,52 +8001,22 @@ func (x *fastReflection_BlockHeaderMerkleParts) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_BlockHeaderMerkleParts) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.VersionAndChainIdHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.VersionAndChainIdHash)
-		if !f(fd_BlockHeaderMerkleParts_version_and_chain_id_hash, value) {
-			return
-		}
-	}
-	if x.Height != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Height)
-		if !f(fd_BlockHeaderMerkleParts_height, value) {
-			return
-		}
-	}
-	if x.TimeSecond != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.TimeSecond)
-		if !f(fd_BlockHeaderMerkleParts_time_second, value) {
-			return
-		}
-	}
-	if x.TimeNanoSecond != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.TimeNanoSecond)
-		if !f(fd_BlockHeaderMerkleParts_time_nano_second, value) {
-			return
-		}
-	}
-	if len(x.LastBlockIdAndOther) != 0 {
-		value := protoreflect.ValueOfBytes(x.LastBlockIdAndOther)
-		if !f(fd_BlockHeaderMerkleParts_last_block_id_and_other, value) {
-			return
-		}
-	}
-	if len(x.NextValidatorHashAndConsensusHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.NextValidatorHashAndConsensusHash)
-		if !f(fd_BlockHeaderMerkleParts_next_validator_hash_and_consensus_hash, value) {
+func (x *fastReflection_MultiProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_MultiProof_block_height, value) {
 			return
 		}
 	}
-	if len(x.LastResultsHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.LastResultsHash)
-		if !f(fd_BlockHeaderMerkleParts_last_results_hash, value) {
+	if len(x.OracleDataMultiProof) != 0 {
+		value := protoreflect.ValueOfList(&_MultiProof_2_list{list: &x.OracleDataMultiProof})
+		if !f(fd_MultiProof_oracle_data_multi_proof, value) {
 			return
 		}
 	}
-	if len(x.EvidenceAndProposerHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.EvidenceAndProposerHash)
-		if !f(fd_BlockHeaderMerkleParts_evidence_and_proposer_hash, value) {
+	if x.BlockRelayProof != nil {
+		value := protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
+		if !f(fd_MultiProof_block_relay_proof, value) {
 			return
 		}
 	}
@@ -8931,29 +8033,19 @@ func (x *fastReflection_BlockHeaderMerkleParts) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_BlockHeaderMerkleParts) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MultiProof) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.version_and_chain_id_hash":
-		return len(x.VersionAndChainIdHash) != 0
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.height":
-		return x.Height != uint64(0)
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_second":
-		return x.TimeSecond != uint64(0)
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_nano_second":
-		return x.TimeNanoSecond != uint32(0)
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_block_id_and_other":
-		return len(x.LastBlockIdAndOther) != 0
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.next_validator_hash_and_consensus_hash":
-		return len(x.NextValidatorHashAndConsensusHash) != 0
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_results_hash":
-		return len(x.LastResultsHash) != 0
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.evidence_and_proposer_hash":
-		return len(x.EvidenceAndProposerHash) != 0
+	case "band.base.oracle.v1.MultiProof.block_height":
+		return x.BlockHeight != uint64(0)
+	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
+		return len(x.OracleDataMultiProof) != 0
+	case "band.base.oracle.v1.MultiProof.block_relay_proof":
+		return x.BlockRelayProof != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockHeaderMerkleParts"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.BlockHeaderMerkleParts does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8963,29 +8055,19 @@ func (x *fastReflection_BlockHeaderMerkleParts) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BlockHeaderMerkleParts) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MultiProof) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.version_and_chain_id_hash":
-		x.VersionAndChainIdHash = nil
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.height":
-		x.Height = uint64(0)
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_second":
-		x.TimeSecond = uint64(0)
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_nano_second":
-		x.TimeNanoSecond = uint32(0)
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_block_id_and_other":
-		x.LastBlockIdAndOther = nil
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.next_validator_hash_and_consensus_hash":
-		x.NextValidatorHashAndConsensusHash = nil
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_results_hash":
-		x.LastResultsHash = nil
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.evidence_and_proposer_hash":
-		x.EvidenceAndProposerHash = nil
+	case "band.base.oracle.v1.MultiProof.block_height":
+		x.BlockHeight = uint64(0)
+	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
+		x.OracleDataMultiProof = nil
+	case "band.base.oracle.v1.MultiProof.block_relay_proof":
+		x.BlockRelayProof = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockHeaderMerkleParts"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.BlockHeaderMerkleParts does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8995,37 +8077,25 @@ func (x *fastReflection_BlockHeaderMerkleParts) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_BlockHeaderMerkleParts) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MultiProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.version_and_chain_id_hash":
-		value := x.VersionAndChainIdHash
-		return protoreflect.ValueOfBytes(value)
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.height":
-		value := x.Height
-		return protoreflect.ValueOfUint64(value)
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_second":
-		value := x.TimeSecond
+	case "band.base.oracle.v1.MultiProof.block_height":
+		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_nano_second":
-		value := x.TimeNanoSecond
-		return protoreflect.ValueOfUint32(value)
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_block_id_and_other":
-		value := x.LastBlockIdAndOther
-		return protoreflect.ValueOfBytes(value)
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.next_validator_hash_and_consensus_hash":
-		value := x.NextValidatorHashAndConsensusHash
-		return protoreflect.ValueOfBytes(value)
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_results_hash":
-		value := x.LastResultsHash
-		return protoreflect.ValueOfBytes(value)
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.evidence_and_proposer_hash":
-		value := x.EvidenceAndProposerHash
-		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
+		if len(x.OracleDataMultiProof) == 0 {
+			return protoreflect.ValueOfList(&_MultiProof_2_list{})
+		}
+		listValue := &_MultiProof_2_list{list: &x.OracleDataMultiProof}
+		return protoreflect.ValueOfList(listValue)
+	case "band.base.oracle.v1.MultiProof.block_relay_proof":
+		value := x.BlockRelayProof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockHeaderMerkleParts"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.BlockHeaderMerkleParts does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9039,29 +8109,21 @@ func (x *fastReflection_BlockHeaderMerkleParts) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BlockHeaderMerkleParts) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MultiProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.version_and_chain_id_hash":
-		x.VersionAndChainIdHash = value.Bytes()
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.height":
-		x.Height = value.Uint()
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_second":
-		x.TimeSecond = value.Uint()
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_nano_second":
-		x.TimeNanoSecond = uint32(value.Uint())
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_block_id_and_other":
-		x.LastBlockIdAndOther = value.Bytes()
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.next_validator_hash_and_consensus_hash":
-		x.NextValidatorHashAndConsensusHash = value.Bytes()
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_results_hash":
-		x.LastResultsHash = value.Bytes()
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.evidence_and_proposer_hash":
-		x.EvidenceAndProposerHash = value.Bytes()
+	case "band.base.oracle.v1.MultiProof.block_height":
+		x.BlockHeight = value.Uint()
+	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
+		lv := value.List()
+		clv := lv.(*_MultiProof_2_list)
+		x.OracleDataMultiProof = *clv.list
+	case "band.base.oracle.v1.MultiProof.block_relay_proof":
+		x.BlockRelayProof = value.Message().Interface().(*BlockRelayProof)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockHeaderMerkleParts"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.BlockHeaderMerkleParts does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9075,68 +8137,57 @@ func (x *fastReflection_BlockHeaderMerkleParts) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BlockHeaderMerkleParts) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MultiProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.version_and_chain_id_hash":
-		panic(fmt.Errorf("field version_and_chain_id_hash of message band.base.oracle.v1.BlockHeaderMerkleParts is not mutable"))
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.height":
-		panic(fmt.Errorf("field height of message band.base.oracle.v1.BlockHeaderMerkleParts is not mutable"))
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_second":
-		panic(fmt.Errorf("field time_second of message band.base.oracle.v1.BlockHeaderMerkleParts is not mutable"))
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_nano_second":
-		panic(fmt.Errorf("field time_nano_second of message band.base.oracle.v1.BlockHeaderMerkleParts is not mutable"))
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_block_id_and_other":
-		panic(fmt.Errorf("field last_block_id_and_other of message band.base.oracle.v1.BlockHeaderMerkleParts is not mutable"))
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.next_validator_hash_and_consensus_hash":
-		panic(fmt.Errorf("field next_validator_hash_and_consensus_hash of message band.base.oracle.v1.BlockHeaderMerkleParts is not mutable"))
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_results_hash":
-		panic(fmt.Errorf("field last_results_hash of message band.base.oracle.v1.BlockHeaderMerkleParts is not mutable"))
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.evidence_and_proposer_hash":
-		panic(fmt.Errorf("field evidence_and_proposer_hash of message band.base.oracle.v1.BlockHeaderMerkleParts is not mutable"))
+	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
+		if x.OracleDataMultiProof == nil {
+			x.OracleDataMultiProof = []*OracleDataProof{}
+		}
+		value := &_MultiProof_2_list{list: &x.OracleDataMultiProof}
+		return protoreflect.ValueOfList(value)
+	case "band.base.oracle.v1.MultiProof.block_relay_proof":
+		if x.BlockRelayProof == nil {
+			x.BlockRelayProof = new(BlockRelayProof)
+		}
+		return protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
+	case "band.base.oracle.v1.MultiProof.block_height":
+		panic(fmt.Errorf("field block_height of message band.base.oracle.v1.MultiProof is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockHeaderMerkleParts"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.BlockHeaderMerkleParts does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_BlockHeaderMerkleParts) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MultiProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.version_and_chain_id_hash":
-		return protoreflect.ValueOfBytes(nil)
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.height":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_second":
+	case "band.base.oracle.v1.MultiProof.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_nano_second":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_block_id_and_other":
-		return protoreflect.ValueOfBytes(nil)
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.next_validator_hash_and_consensus_hash":
-		return protoreflect.ValueOfBytes(nil)
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_results_hash":
-		return protoreflect.ValueOfBytes(nil)
-	case "band.base.oracle.v1.BlockHeaderMerkleParts.evidence_and_proposer_hash":
-		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.MultiProof.oracle_data_multi_proof":
+		list := []*OracleDataProof{}
+		return protoreflect.ValueOfList(&_MultiProof_2_list{list: &list})
+	case "band.base.oracle.v1.MultiProof.block_relay_proof":
+		m := new(BlockRelayProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockHeaderMerkleParts"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.BlockHeaderMerkleParts does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_BlockHeaderMerkleParts) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MultiProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.BlockHeaderMerkleParts", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.MultiProof", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9144,7 +8195,7 @@ func (x *fastReflection_BlockHeaderMerkleParts) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_BlockHeaderMerkleParts) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MultiProof) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9155,7 +8206,7 @@ func (x *fastReflection_BlockHeaderMerkleParts) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BlockHeaderMerkleParts) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MultiProof) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9167,7 +8218,7 @@ func (x *fastReflection_BlockHeaderMerkleParts) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_BlockHeaderMerkleParts) IsValid() bool {
+func (x *fastReflection_MultiProof) IsValid() bool {
 	return x != nil
 }
 
@@ -9177,9 +8228,9 @@ func (x *fastReflection_BlockHeaderMerkleParts) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_BlockHeaderMerkleParts) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MultiProof) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*BlockHeaderMerkleParts)
+		x := input.Message.Interface().(*MultiProof)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9191,33 +8242,17 @@ func (x *fastReflection_BlockHeaderMerkleParts) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		l = len(x.VersionAndChainIdHash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Height != 0 {
-			n += 1 + runtime.Sov(uint64(x.Height))
-		}
-		if x.TimeSecond != 0 {
-			n += 1 + runtime.Sov(uint64(x.TimeSecond))
-		}
-		if x.TimeNanoSecond != 0 {
-			n += 1 + runtime.Sov(uint64(x.TimeNanoSecond))
-		}
-		l = len(x.LastBlockIdAndOther)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.NextValidatorHashAndConsensusHash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
-		l = len(x.LastResultsHash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.OracleDataMultiProof) > 0 {
+			for _, e := range x.OracleDataMultiProof {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.EvidenceAndProposerHash)
-		if l > 0 {
+		if x.BlockRelayProof != nil {
+			l = options.Size(x.BlockRelayProof)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -9230,7 +8265,7 @@ func (x *fastReflection_BlockHeaderMerkleParts) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*BlockHeaderMerkleParts)
+		x := input.Message.Interface().(*MultiProof)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9249,55 +8284,40 @@ func (x *fastReflection_BlockHeaderMerkleParts) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.EvidenceAndProposerHash) > 0 {
-			i -= len(x.EvidenceAndProposerHash)
-			copy(dAtA[i:], x.EvidenceAndProposerHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvidenceAndProposerHash)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.LastResultsHash) > 0 {
-			i -= len(x.LastResultsHash)
-			copy(dAtA[i:], x.LastResultsHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LastResultsHash)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.NextValidatorHashAndConsensusHash) > 0 {
-			i -= len(x.NextValidatorHashAndConsensusHash)
-			copy(dAtA[i:], x.NextValidatorHashAndConsensusHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NextValidatorHashAndConsensusHash)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.LastBlockIdAndOther) > 0 {
-			i -= len(x.LastBlockIdAndOther)
-			copy(dAtA[i:], x.LastBlockIdAndOther)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LastBlockIdAndOther)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if x.TimeNanoSecond != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeNanoSecond))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.TimeSecond != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeSecond))
+		if x.BlockRelayProof != nil {
+			encoded, err := options.Marshal(x.BlockRelayProof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x1a
 		}
-		if x.Height != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
-			i--
-			dAtA[i] = 0x10
+		if len(x.OracleDataMultiProof) > 0 {
+			for iNdEx := len(x.OracleDataMultiProof) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OracleDataMultiProof[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
 		}
-		if len(x.VersionAndChainIdHash) > 0 {
-			i -= len(x.VersionAndChainIdHash)
-			copy(dAtA[i:], x.VersionAndChainIdHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VersionAndChainIdHash)))
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -9310,7 +8330,7 @@ func (x *fastReflection_BlockHeaderMerkleParts) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*BlockHeaderMerkleParts)
+		x := input.Message.Interface().(*MultiProof)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9342,17 +8362,36 @@ func (x *fastReflection_BlockHeaderMerkleParts) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockHeaderMerkleParts: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiProof: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockHeaderMerkleParts: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiProof: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionAndChainIdHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleDataMultiProof", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9362,31 +8401,31 @@ func (x *fastReflection_BlockHeaderMerkleParts) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.VersionAndChainIdHash = append(x.VersionAndChainIdHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.VersionAndChainIdHash == nil {
-					x.VersionAndChainIdHash = []byte{}
+				x.OracleDataMultiProof = append(x.OracleDataMultiProof, &OracleDataProof{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OracleDataMultiProof[len(x.OracleDataMultiProof)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
 				}
-				x.Height = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9396,183 +8435,26 @@ func (x *fastReflection_BlockHeaderMerkleParts) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Height |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeSecond", wireType)
-				}
-				x.TimeSecond = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.TimeSecond |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeNanoSecond", wireType)
-				}
-				x.TimeNanoSecond = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.TimeNanoSecond |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastBlockIdAndOther", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.LastBlockIdAndOther = append(x.LastBlockIdAndOther[:0], dAtA[iNdEx:postIndex]...)
-				if x.LastBlockIdAndOther == nil {
-					x.LastBlockIdAndOther = []byte{}
-				}
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextValidatorHashAndConsensusHash", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.NextValidatorHashAndConsensusHash = append(x.NextValidatorHashAndConsensusHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.NextValidatorHashAndConsensusHash == nil {
-					x.NextValidatorHashAndConsensusHash = []byte{}
-				}
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastResultsHash", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LastResultsHash = append(x.LastResultsHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.LastResultsHash == nil {
-					x.LastResultsHash = []byte{}
-				}
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvidenceAndProposerHash", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if x.BlockRelayProof == nil {
+					x.BlockRelayProof = &BlockRelayProof{}
 				}
-				x.EvidenceAndProposerHash = append(x.EvidenceAndProposerHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.EvidenceAndProposerHash == nil {
-					x.EvidenceAndProposerHash = []byte{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockRelayProof); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -9611,27 +8493,29 @@ func (x *fastReflection_BlockHeaderMerkleParts) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_CommonEncodedVotePart                    protoreflect.MessageDescriptor
-	fd_CommonEncodedVotePart_signed_data_prefix protoreflect.FieldDescriptor
-	fd_CommonEncodedVotePart_signed_data_suffix protoreflect.FieldDescriptor
+	md_CountProof                   protoreflect.MessageDescriptor
+	fd_CountProof_block_height      protoreflect.FieldDescriptor
+	fd_CountProof_count_proof       protoreflect.FieldDescriptor
+	fd_CountProof_block_relay_proof protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_CommonEncodedVotePart = File_band_base_oracle_v1_proof_proto.Messages().ByName("CommonEncodedVotePart")
-	fd_CommonEncodedVotePart_signed_data_prefix = md_CommonEncodedVotePart.Fields().ByName("signed_data_prefix")
-	fd_CommonEncodedVotePart_signed_data_suffix = md_CommonEncodedVotePart.Fields().ByName("signed_data_suffix")
+	md_CountProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("CountProof")
+	fd_CountProof_block_height = md_CountProof.Fields().ByName("block_height")
+	fd_CountProof_count_proof = md_CountProof.Fields().ByName("count_proof")
+	fd_CountProof_block_relay_proof = md_CountProof.Fields().ByName("block_relay_proof")
 }
 
-var _ protoreflect.Message = (*fastReflection_CommonEncodedVotePart)(nil)
+var _ protoreflect.Message = (*fastReflection_CountProof)(nil)
 
-type fastReflection_CommonEncodedVotePart CommonEncodedVotePart
+type fastReflection_CountProof CountProof
 
-func (x *CommonEncodedVotePart) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CommonEncodedVotePart)(x)
+func (x *CountProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CountProof)(x)
 }
 
-func (x *CommonEncodedVotePart) slowProtoReflect() protoreflect.Message {
+func (x *CountProof) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9643,43 +8527,43 @@ func (x *CommonEncodedVotePart) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CommonEncodedVotePart_messageType fastReflection_CommonEncodedVotePart_messageType
-var _ protoreflect.MessageType = fastReflection_CommonEncodedVotePart_messageType{}
+var _fastReflection_CountProof_messageType fastReflection_CountProof_messageType
+var _ protoreflect.MessageType = fastReflection_CountProof_messageType{}
 
-type fastReflection_CommonEncodedVotePart_messageType struct{}
+type fastReflection_CountProof_messageType struct{}
 
-func (x fastReflection_CommonEncodedVotePart_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CommonEncodedVotePart)(nil)
+func (x fastReflection_CountProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CountProof)(nil)
 }
-func (x fastReflection_CommonEncodedVotePart_messageType) New() protoreflect.Message {
-	return new(fastReflection_CommonEncodedVotePart)
+func (x fastReflection_CountProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_CountProof)
 }
-func (x fastReflection_CommonEncodedVotePart_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CommonEncodedVotePart
+func (x fastReflection_CountProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CountProof
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CommonEncodedVotePart) Descriptor() protoreflect.MessageDescriptor {
-	return md_CommonEncodedVotePart
+func (x *fastReflection_CountProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_CountProof
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CommonEncodedVotePart) Type() protoreflect.MessageType {
-	return _fastReflection_CommonEncodedVotePart_messageType
+func (x *fastReflection_CountProof) Type() protoreflect.MessageType {
+	return _fastReflection_CountProof_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CommonEncodedVotePart) New() protoreflect.Message {
-	return new(fastReflection_CommonEncodedVotePart)
+func (x *fastReflection_CountProof) New() protoreflect.Message {
+	return new(fastReflection_CountProof)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CommonEncodedVotePart) Interface() protoreflect.ProtoMessage {
-	return (*CommonEncodedVotePart)(x)
+func (x *fastReflection_CountProof) Interface() protoreflect.ProtoMessage {
+	return (*CountProof)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9687,16 +8571,22 @@ func (x *fastReflection_CommonEncodedVotePart) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CommonEncodedVotePart) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.SignedDataPrefix) != 0 {
-		value := protoreflect.ValueOfBytes(x.SignedDataPrefix)
-		if !f(fd_CommonEncodedVotePart_signed_data_prefix, value) {
+func (x *fastReflection_CountProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_CountProof_block_height, value) {
 			return
 		}
 	}
-	if len(x.SignedDataSuffix) != 0 {
-		value := protoreflect.ValueOfBytes(x.SignedDataSuffix)
-		if !f(fd_CommonEncodedVotePart_signed_data_suffix, value) {
+	if x.CountProof != nil {
+		value := protoreflect.ValueOfMessage(x.CountProof.ProtoReflect())
+		if !f(fd_CountProof_count_proof, value) {
+			return
+		}
+	}
+	if x.BlockRelayProof != nil {
+		value := protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
+		if !f(fd_CountProof_block_relay_proof, value) {
 			return
 		}
 	}
@@ -9713,17 +8603,19 @@ func (x *fastReflection_CommonEncodedVotePart) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CommonEncodedVotePart) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CountProof) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_prefix":
-		return len(x.SignedDataPrefix) != 0
-	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_suffix":
-		return len(x.SignedDataSuffix) != 0
+	case "band.base.oracle.v1.CountProof.block_height":
+		return x.BlockHeight != uint64(0)
+	case "band.base.oracle.v1.CountProof.count_proof":
+		return x.CountProof != nil
+	case "band.base.oracle.v1.CountProof.block_relay_proof":
+		return x.BlockRelayProof != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CommonEncodedVotePart"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CommonEncodedVotePart does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9733,17 +8625,19 @@ func (x *fastReflection_CommonEncodedVotePart) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CommonEncodedVotePart) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CountProof) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_prefix":
-		x.SignedDataPrefix = nil
-	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_suffix":
-		x.SignedDataSuffix = nil
+	case "band.base.oracle.v1.CountProof.block_height":
+		x.BlockHeight = uint64(0)
+	case "band.base.oracle.v1.CountProof.count_proof":
+		x.CountProof = nil
+	case "band.base.oracle.v1.CountProof.block_relay_proof":
+		x.BlockRelayProof = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CommonEncodedVotePart"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CommonEncodedVotePart does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9753,19 +8647,22 @@ func (x *fastReflection_CommonEncodedVotePart) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CommonEncodedVotePart) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CountProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_prefix":
-		value := x.SignedDataPrefix
-		return protoreflect.ValueOfBytes(value)
-	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_suffix":
-		value := x.SignedDataSuffix
-		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.CountProof.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfUint64(value)
+	case "band.base.oracle.v1.CountProof.count_proof":
+		value := x.CountProof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.CountProof.block_relay_proof":
+		value := x.BlockRelayProof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CommonEncodedVotePart"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CommonEncodedVotePart does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9779,17 +8676,19 @@ func (x *fastReflection_CommonEncodedVotePart) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CommonEncodedVotePart) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CountProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_prefix":
-		x.SignedDataPrefix = value.Bytes()
-	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_suffix":
-		x.SignedDataSuffix = value.Bytes()
+	case "band.base.oracle.v1.CountProof.block_height":
+		x.BlockHeight = value.Uint()
+	case "band.base.oracle.v1.CountProof.count_proof":
+		x.CountProof = value.Message().Interface().(*RequestsCountProof)
+	case "band.base.oracle.v1.CountProof.block_relay_proof":
+		x.BlockRelayProof = value.Message().Interface().(*BlockRelayProof)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CommonEncodedVotePart"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CommonEncodedVotePart does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9803,44 +8702,56 @@ func (x *fastReflection_CommonEncodedVotePart) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CommonEncodedVotePart) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CountProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_prefix":
-		panic(fmt.Errorf("field signed_data_prefix of message band.base.oracle.v1.CommonEncodedVotePart is not mutable"))
-	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_suffix":
-		panic(fmt.Errorf("field signed_data_suffix of message band.base.oracle.v1.CommonEncodedVotePart is not mutable"))
+	case "band.base.oracle.v1.CountProof.count_proof":
+		if x.CountProof == nil {
+			x.CountProof = new(RequestsCountProof)
+		}
+		return protoreflect.ValueOfMessage(x.CountProof.ProtoReflect())
+	case "band.base.oracle.v1.CountProof.block_relay_proof":
+		if x.BlockRelayProof == nil {
+			x.BlockRelayProof = new(BlockRelayProof)
+		}
+		return protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
+	case "band.base.oracle.v1.CountProof.block_height":
+		panic(fmt.Errorf("field block_height of message band.base.oracle.v1.CountProof is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CommonEncodedVotePart"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CommonEncodedVotePart does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CommonEncodedVotePart) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CountProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_prefix":
-		return protoreflect.ValueOfBytes(nil)
-	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_suffix":
-		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.CountProof.block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.base.oracle.v1.CountProof.count_proof":
+		m := new(RequestsCountProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.CountProof.block_relay_proof":
+		m := new(BlockRelayProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CommonEncodedVotePart"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CountProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.CommonEncodedVotePart does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.CountProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CommonEncodedVotePart) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CountProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.CommonEncodedVotePart", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.CountProof", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9848,7 +8759,7 @@ func (x *fastReflection_CommonEncodedVotePart) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CommonEncodedVotePart) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CountProof) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9859,7 +8770,7 @@ func (x *fastReflection_CommonEncodedVotePart) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CommonEncodedVotePart) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CountProof) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9871,7 +8782,7 @@ func (x *fastReflection_CommonEncodedVotePart) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CommonEncodedVotePart) IsValid() bool {
+func (x *fastReflection_CountProof) IsValid() bool {
 	return x != nil
 }
 
@@ -9881,9 +8792,9 @@ func (x *fastReflection_CommonEncodedVotePart) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CommonEncodedVotePart) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CountProof) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CommonEncodedVotePart)
+		x := input.Message.Interface().(*CountProof)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9895,12 +8806,15 @@ func (x *fastReflection_CommonEncodedVotePart) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.SignedDataPrefix)
-		if l > 0 {
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.CountProof != nil {
+			l = options.Size(x.CountProof)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.SignedDataSuffix)
-		if l > 0 {
+		if x.BlockRelayProof != nil {
+			l = options.Size(x.BlockRelayProof)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -9913,7 +8827,7 @@ func (x *fastReflection_CommonEncodedVotePart) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CommonEncodedVotePart)
+		x := input.Message.Interface().(*CountProof)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9932,19 +8846,38 @@ func (x *fastReflection_CommonEncodedVotePart) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.SignedDataSuffix) > 0 {
-			i -= len(x.SignedDataSuffix)
-			copy(dAtA[i:], x.SignedDataSuffix)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignedDataSuffix)))
+		if x.BlockRelayProof != nil {
+			encoded, err := options.Marshal(x.BlockRelayProof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.CountProof != nil {
+			encoded, err := options.Marshal(x.CountProof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.SignedDataPrefix) > 0 {
-			i -= len(x.SignedDataPrefix)
-			copy(dAtA[i:], x.SignedDataPrefix)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignedDataPrefix)))
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -9957,7 +8890,7 @@ func (x *fastReflection_CommonEncodedVotePart) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CommonEncodedVotePart)
+		x := input.Message.Interface().(*CountProof)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9989,17 +8922,36 @@ func (x *fastReflection_CommonEncodedVotePart) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CommonEncodedVotePart: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CountProof: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CommonEncodedVotePart: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CountProof: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignedDataPrefix", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CountProof", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10009,31 +8961,33 @@ func (x *fastReflection_CommonEncodedVotePart) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SignedDataPrefix = append(x.SignedDataPrefix[:0], dAtA[iNdEx:postIndex]...)
-				if x.SignedDataPrefix == nil {
-					x.SignedDataPrefix = []byte{}
+				if x.CountProof == nil {
+					x.CountProof = &RequestsCountProof{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CountProof); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignedDataSuffix", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10043,24 +8997,26 @@ func (x *fastReflection_CommonEncodedVotePart) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SignedDataSuffix = append(x.SignedDataSuffix[:0], dAtA[iNdEx:postIndex]...)
-				if x.SignedDataSuffix == nil {
-					x.SignedDataSuffix = []byte{}
+				if x.BlockRelayProof == nil {
+					x.BlockRelayProof = &BlockRelayProof{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockRelayProof); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -10098,36 +9054,85 @@ func (x *fastReflection_CommonEncodedVotePart) ProtoMethods() *protoiface.Method
 	}
 }
 
-var (
-	md_TMSignature                   protoreflect.MessageDescriptor
-	fd_TMSignature_r                 protoreflect.FieldDescriptor
-	fd_TMSignature_s                 protoreflect.FieldDescriptor
-	fd_TMSignature_v                 protoreflect.FieldDescriptor
-	fd_TMSignature_encoded_timestamp protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_PriceMultiProof_2_list)(nil)
 
-func init() {
-	file_band_base_oracle_v1_proof_proto_init()
-	md_TMSignature = File_band_base_oracle_v1_proof_proto.Messages().ByName("TMSignature")
-	fd_TMSignature_r = md_TMSignature.Fields().ByName("r")
-	fd_TMSignature_s = md_TMSignature.Fields().ByName("s")
-	fd_TMSignature_v = md_TMSignature.Fields().ByName("v")
-	fd_TMSignature_encoded_timestamp = md_TMSignature.Fields().ByName("encoded_timestamp")
+type _PriceMultiProof_2_list struct {
+	list *[]*PriceDataProof
 }
 
-var _ protoreflect.Message = (*fastReflection_TMSignature)(nil)
+func (x *_PriceMultiProof_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_TMSignature TMSignature
+func (x *_PriceMultiProof_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *TMSignature) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TMSignature)(x)
+func (x *_PriceMultiProof_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceDataProof)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *TMSignature) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
+func (x *_PriceMultiProof_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceDataProof)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PriceMultiProof_2_list) AppendMutable() protoreflect.Value {
+	v := new(PriceDataProof)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PriceMultiProof_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PriceMultiProof_2_list) NewElement() protoreflect.Value {
+	v := new(PriceDataProof)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PriceMultiProof_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PriceMultiProof                        protoreflect.MessageDescriptor
+	fd_PriceMultiProof_block_height           protoreflect.FieldDescriptor
+	fd_PriceMultiProof_price_data_multi_proof protoreflect.FieldDescriptor
+	fd_PriceMultiProof_block_relay_proof      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_PriceMultiProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("PriceMultiProof")
+	fd_PriceMultiProof_block_height = md_PriceMultiProof.Fields().ByName("block_height")
+	fd_PriceMultiProof_price_data_multi_proof = md_PriceMultiProof.Fields().ByName("price_data_multi_proof")
+	fd_PriceMultiProof_block_relay_proof = md_PriceMultiProof.Fields().ByName("block_relay_proof")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceMultiProof)(nil)
+
+type fastReflection_PriceMultiProof PriceMultiProof
+
+func (x *PriceMultiProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceMultiProof)(x)
+}
+
+func (x *PriceMultiProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
 		return ms
@@ -10135,43 +9140,43 @@ func (x *TMSignature) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TMSignature_messageType fastReflection_TMSignature_messageType
-var _ protoreflect.MessageType = fastReflection_TMSignature_messageType{}
+var _fastReflection_PriceMultiProof_messageType fastReflection_PriceMultiProof_messageType
+var _ protoreflect.MessageType = fastReflection_PriceMultiProof_messageType{}
 
-type fastReflection_TMSignature_messageType struct{}
+type fastReflection_PriceMultiProof_messageType struct{}
 
-func (x fastReflection_TMSignature_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TMSignature)(nil)
+func (x fastReflection_PriceMultiProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceMultiProof)(nil)
 }
-func (x fastReflection_TMSignature_messageType) New() protoreflect.Message {
-	return new(fastReflection_TMSignature)
+func (x fastReflection_PriceMultiProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceMultiProof)
 }
-func (x fastReflection_TMSignature_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TMSignature
+func (x fastReflection_PriceMultiProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceMultiProof
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TMSignature) Descriptor() protoreflect.MessageDescriptor {
-	return md_TMSignature
+func (x *fastReflection_PriceMultiProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceMultiProof
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TMSignature) Type() protoreflect.MessageType {
-	return _fastReflection_TMSignature_messageType
+func (x *fastReflection_PriceMultiProof) Type() protoreflect.MessageType {
+	return _fastReflection_PriceMultiProof_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TMSignature) New() protoreflect.Message {
-	return new(fastReflection_TMSignature)
+func (x *fastReflection_PriceMultiProof) New() protoreflect.Message {
+	return new(fastReflection_PriceMultiProof)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TMSignature) Interface() protoreflect.ProtoMessage {
-	return (*TMSignature)(x)
+func (x *fastReflection_PriceMultiProof) Interface() protoreflect.ProtoMessage {
+	return (*PriceMultiProof)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10179,28 +9184,22 @@ func (x *fastReflection_TMSignature) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TMSignature) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.R) != 0 {
-		value := protoreflect.ValueOfBytes(x.R)
-		if !f(fd_TMSignature_r, value) {
-			return
-		}
-	}
-	if len(x.S) != 0 {
-		value := protoreflect.ValueOfBytes(x.S)
-		if !f(fd_TMSignature_s, value) {
+func (x *fastReflection_PriceMultiProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_PriceMultiProof_block_height, value) {
 			return
 		}
 	}
-	if x.V != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.V)
-		if !f(fd_TMSignature_v, value) {
+	if len(x.PriceDataMultiProof) != 0 {
+		value := protoreflect.ValueOfList(&_PriceMultiProof_2_list{list: &x.PriceDataMultiProof})
+		if !f(fd_PriceMultiProof_price_data_multi_proof, value) {
 			return
 		}
 	}
-	if len(x.EncodedTimestamp) != 0 {
-		value := protoreflect.ValueOfBytes(x.EncodedTimestamp)
-		if !f(fd_TMSignature_encoded_timestamp, value) {
+	if x.BlockRelayProof != nil {
+		value := protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
+		if !f(fd_PriceMultiProof_block_relay_proof, value) {
 			return
 		}
 	}
@@ -10217,21 +9216,19 @@ func (x *fastReflection_TMSignature) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TMSignature) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PriceMultiProof) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.TMSignature.r":
-		return len(x.R) != 0
-	case "band.base.oracle.v1.TMSignature.s":
-		return len(x.S) != 0
-	case "band.base.oracle.v1.TMSignature.v":
-		return x.V != uint32(0)
-	case "band.base.oracle.v1.TMSignature.encoded_timestamp":
-		return len(x.EncodedTimestamp) != 0
+	case "band.base.oracle.v1.PriceMultiProof.block_height":
+		return x.BlockHeight != uint64(0)
+	case "band.base.oracle.v1.PriceMultiProof.price_data_multi_proof":
+		return len(x.PriceDataMultiProof) != 0
+	case "band.base.oracle.v1.PriceMultiProof.block_relay_proof":
+		return x.BlockRelayProof != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TMSignature"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceMultiProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.TMSignature does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceMultiProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10241,21 +9238,19 @@ func (x *fastReflection_TMSignature) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TMSignature) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PriceMultiProof) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.TMSignature.r":
-		x.R = nil
-	case "band.base.oracle.v1.TMSignature.s":
-		x.S = nil
-	case "band.base.oracle.v1.TMSignature.v":
-		x.V = uint32(0)
-	case "band.base.oracle.v1.TMSignature.encoded_timestamp":
-		x.EncodedTimestamp = nil
+	case "band.base.oracle.v1.PriceMultiProof.block_height":
+		x.BlockHeight = uint64(0)
+	case "band.base.oracle.v1.PriceMultiProof.price_data_multi_proof":
+		x.PriceDataMultiProof = nil
+	case "band.base.oracle.v1.PriceMultiProof.block_relay_proof":
+		x.BlockRelayProof = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TMSignature"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceMultiProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.TMSignature does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceMultiProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10265,25 +9260,25 @@ func (x *fastReflection_TMSignature) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TMSignature) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceMultiProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.TMSignature.r":
-		value := x.R
-		return protoreflect.ValueOfBytes(value)
-	case "band.base.oracle.v1.TMSignature.s":
-		value := x.S
-		return protoreflect.ValueOfBytes(value)
-	case "band.base.oracle.v1.TMSignature.v":
-		value := x.V
-		return protoreflect.ValueOfUint32(value)
-	case "band.base.oracle.v1.TMSignature.encoded_timestamp":
-		value := x.EncodedTimestamp
-		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.PriceMultiProof.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfUint64(value)
+	case "band.base.oracle.v1.PriceMultiProof.price_data_multi_proof":
+		if len(x.PriceDataMultiProof) == 0 {
+			return protoreflect.ValueOfList(&_PriceMultiProof_2_list{})
+		}
+		listValue := &_PriceMultiProof_2_list{list: &x.PriceDataMultiProof}
+		return protoreflect.ValueOfList(listValue)
+	case "band.base.oracle.v1.PriceMultiProof.block_relay_proof":
+		value := x.BlockRelayProof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TMSignature"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceMultiProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.TMSignature does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceMultiProof does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10297,21 +9292,21 @@ func (x *fastReflection_TMSignature) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TMSignature) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PriceMultiProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.TMSignature.r":
-		x.R = value.Bytes()
-	case "band.base.oracle.v1.TMSignature.s":
-		x.S = value.Bytes()
-	case "band.base.oracle.v1.TMSignature.v":
-		x.V = uint32(value.Uint())
-	case "band.base.oracle.v1.TMSignature.encoded_timestamp":
-		x.EncodedTimestamp = value.Bytes()
+	case "band.base.oracle.v1.PriceMultiProof.block_height":
+		x.BlockHeight = value.Uint()
+	case "band.base.oracle.v1.PriceMultiProof.price_data_multi_proof":
+		lv := value.List()
+		clv := lv.(*_PriceMultiProof_2_list)
+		x.PriceDataMultiProof = *clv.list
+	case "band.base.oracle.v1.PriceMultiProof.block_relay_proof":
+		x.BlockRelayProof = value.Message().Interface().(*StoreBlockRelayProof)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TMSignature"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceMultiProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.TMSignature does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceMultiProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10325,52 +9320,57 @@ func (x *fastReflection_TMSignature) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TMSignature) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceMultiProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.TMSignature.r":
-		panic(fmt.Errorf("field r of message band.base.oracle.v1.TMSignature is not mutable"))
-	case "band.base.oracle.v1.TMSignature.s":
-		panic(fmt.Errorf("field s of message band.base.oracle.v1.TMSignature is not mutable"))
-	case "band.base.oracle.v1.TMSignature.v":
-		panic(fmt.Errorf("field v of message band.base.oracle.v1.TMSignature is not mutable"))
-	case "band.base.oracle.v1.TMSignature.encoded_timestamp":
-		panic(fmt.Errorf("field encoded_timestamp of message band.base.oracle.v1.TMSignature is not mutable"))
+	case "band.base.oracle.v1.PriceMultiProof.price_data_multi_proof":
+		if x.PriceDataMultiProof == nil {
+			x.PriceDataMultiProof = []*PriceDataProof{}
+		}
+		value := &_PriceMultiProof_2_list{list: &x.PriceDataMultiProof}
+		return protoreflect.ValueOfList(value)
+	case "band.base.oracle.v1.PriceMultiProof.block_relay_proof":
+		if x.BlockRelayProof == nil {
+			x.BlockRelayProof = new(StoreBlockRelayProof)
+		}
+		return protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
+	case "band.base.oracle.v1.PriceMultiProof.block_height":
+		panic(fmt.Errorf("field block_height of message band.base.oracle.v1.PriceMultiProof is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TMSignature"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceMultiProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.TMSignature does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceMultiProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TMSignature) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceMultiProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.TMSignature.r":
-		return protoreflect.ValueOfBytes(nil)
-	case "band.base.oracle.v1.TMSignature.s":
-		return protoreflect.ValueOfBytes(nil)
-	case "band.base.oracle.v1.TMSignature.v":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "band.base.oracle.v1.TMSignature.encoded_timestamp":
-		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.PriceMultiProof.block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.base.oracle.v1.PriceMultiProof.price_data_multi_proof":
+		list := []*PriceDataProof{}
+		return protoreflect.ValueOfList(&_PriceMultiProof_2_list{list: &list})
+	case "band.base.oracle.v1.PriceMultiProof.block_relay_proof":
+		m := new(StoreBlockRelayProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TMSignature"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceMultiProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.TMSignature does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceMultiProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TMSignature) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PriceMultiProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.TMSignature", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.PriceMultiProof", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10378,7 +9378,7 @@ func (x *fastReflection_TMSignature) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TMSignature) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PriceMultiProof) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10389,7 +9389,7 @@ func (x *fastReflection_TMSignature) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TMSignature) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PriceMultiProof) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10401,7 +9401,7 @@ func (x *fastReflection_TMSignature) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TMSignature) IsValid() bool {
+func (x *fastReflection_PriceMultiProof) IsValid() bool {
 	return x != nil
 }
 
@@ -10411,9 +9411,9 @@ func (x *fastReflection_TMSignature) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TMSignature) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PriceMultiProof) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TMSignature)
+		x := input.Message.Interface().(*PriceMultiProof)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10425,19 +9425,17 @@ func (x *fastReflection_TMSignature) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.R)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.S)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
-		if x.V != 0 {
-			n += 1 + runtime.Sov(uint64(x.V))
+		if len(x.PriceDataMultiProof) > 0 {
+			for _, e := range x.PriceDataMultiProof {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.EncodedTimestamp)
-		if l > 0 {
+		if x.BlockRelayProof != nil {
+			l = options.Size(x.BlockRelayProof)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -10450,7 +9448,7 @@ func (x *fastReflection_TMSignature) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TMSignature)
+		x := input.Message.Interface().(*PriceMultiProof)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10469,31 +9467,40 @@ func (x *fastReflection_TMSignature) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.EncodedTimestamp) > 0 {
-			i -= len(x.EncodedTimestamp)
-			copy(dAtA[i:], x.EncodedTimestamp)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EncodedTimestamp)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.V != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.V))
+		if x.BlockRelayProof != nil {
+			encoded, err := options.Marshal(x.BlockRelayProof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x1a
 		}
-		if len(x.S) > 0 {
-			i -= len(x.S)
-			copy(dAtA[i:], x.S)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.S)))
-			i--
-			dAtA[i] = 0x12
+		if len(x.PriceDataMultiProof) > 0 {
+			for iNdEx := len(x.PriceDataMultiProof) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PriceDataMultiProof[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
 		}
-		if len(x.R) > 0 {
-			i -= len(x.R)
-			copy(dAtA[i:], x.R)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.R)))
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -10506,7 +9513,7 @@ func (x *fastReflection_TMSignature) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TMSignature)
+		x := input.Message.Interface().(*PriceMultiProof)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10538,17 +9545,17 @@ func (x *fastReflection_TMSignature) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TMSignature: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceMultiProof: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TMSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceMultiProof: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
-				var byteLen int
+				x.BlockHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10558,31 +9565,16 @@ func (x *fastReflection_TMSignature) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					x.BlockHeight |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceDataMultiProof", wireType)
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.R = append(x.R[:0], dAtA[iNdEx:postIndex]...)
-				if x.R == nil {
-					x.R = []byte{}
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
-				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10592,50 +9584,31 @@ func (x *fastReflection_TMSignature) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.S = append(x.S[:0], dAtA[iNdEx:postIndex]...)
-				if x.S == nil {
-					x.S = []byte{}
+				x.PriceDataMultiProof = append(x.PriceDataMultiProof, &PriceDataProof{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceDataMultiProof[len(x.PriceDataMultiProof)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
-				}
-				x.V = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.V |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EncodedTimestamp", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10645,24 +9618,26 @@ func (x *fastReflection_TMSignature) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.EncodedTimestamp = append(x.EncodedTimestamp[:0], dAtA[iNdEx:postIndex]...)
-				if x.EncodedTimestamp == nil {
-					x.EncodedTimestamp = []byte{}
+				if x.BlockRelayProof == nil {
+					x.BlockRelayProof = &StoreBlockRelayProof{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockRelayProof); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -10700,81 +9675,30 @@ func (x *fastReflection_TMSignature) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_RequestsCountProof_3_list)(nil)
-
-type _RequestsCountProof_3_list struct {
-	list *[]*IAVLMerklePath
-}
-
-func (x *_RequestsCountProof_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_RequestsCountProof_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_RequestsCountProof_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*IAVLMerklePath)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_RequestsCountProof_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*IAVLMerklePath)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_RequestsCountProof_3_list) AppendMutable() protoreflect.Value {
-	v := new(IAVLMerklePath)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_RequestsCountProof_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_RequestsCountProof_3_list) NewElement() protoreflect.Value {
-	v := new(IAVLMerklePath)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_RequestsCountProof_3_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_RequestsCountProof              protoreflect.MessageDescriptor
-	fd_RequestsCountProof_count        protoreflect.FieldDescriptor
-	fd_RequestsCountProof_version      protoreflect.FieldDescriptor
-	fd_RequestsCountProof_merkle_paths protoreflect.FieldDescriptor
+	md_PacketProof                   protoreflect.MessageDescriptor
+	fd_PacketProof_block_height      protoreflect.FieldDescriptor
+	fd_PacketProof_packet_data_proof protoreflect.FieldDescriptor
+	fd_PacketProof_block_relay_proof protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_oracle_v1_proof_proto_init()
-	md_RequestsCountProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("RequestsCountProof")
-	fd_RequestsCountProof_count = md_RequestsCountProof.Fields().ByName("count")
-	fd_RequestsCountProof_version = md_RequestsCountProof.Fields().ByName("version")
-	fd_RequestsCountProof_merkle_paths = md_RequestsCountProof.Fields().ByName("merkle_paths")
+	md_PacketProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("PacketProof")
+	fd_PacketProof_block_height = md_PacketProof.Fields().ByName("block_height")
+	fd_PacketProof_packet_data_proof = md_PacketProof.Fields().ByName("packet_data_proof")
+	fd_PacketProof_block_relay_proof = md_PacketProof.Fields().ByName("block_relay_proof")
 }
 
-var _ protoreflect.Message = (*fastReflection_RequestsCountProof)(nil)
+var _ protoreflect.Message = (*fastReflection_PacketProof)(nil)
 
-type fastReflection_RequestsCountProof RequestsCountProof
+type fastReflection_PacketProof PacketProof
 
-func (x *RequestsCountProof) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RequestsCountProof)(x)
+func (x *PacketProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PacketProof)(x)
 }
 
-func (x *RequestsCountProof) slowProtoReflect() protoreflect.Message {
+func (x *PacketProof) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10786,43 +9710,43 @@ func (x *RequestsCountProof) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RequestsCountProof_messageType fastReflection_RequestsCountProof_messageType
-var _ protoreflect.MessageType = fastReflection_RequestsCountProof_messageType{}
+var _fastReflection_PacketProof_messageType fastReflection_PacketProof_messageType
+var _ protoreflect.MessageType = fastReflection_PacketProof_messageType{}
 
-type fastReflection_RequestsCountProof_messageType struct{}
+type fastReflection_PacketProof_messageType struct{}
 
-func (x fastReflection_RequestsCountProof_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RequestsCountProof)(nil)
+func (x fastReflection_PacketProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PacketProof)(nil)
 }
-func (x fastReflection_RequestsCountProof_messageType) New() protoreflect.Message {
-	return new(fastReflection_RequestsCountProof)
+func (x fastReflection_PacketProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_PacketProof)
 }
-func (x fastReflection_RequestsCountProof_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RequestsCountProof
+func (x fastReflection_PacketProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PacketProof
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RequestsCountProof) Descriptor() protoreflect.MessageDescriptor {
-	return md_RequestsCountProof
+func (x *fastReflection_PacketProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_PacketProof
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RequestsCountProof) Type() protoreflect.MessageType {
-	return _fastReflection_RequestsCountProof_messageType
+func (x *fastReflection_PacketProof) Type() protoreflect.MessageType {
+	return _fastReflection_PacketProof_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RequestsCountProof) New() protoreflect.Message {
-	return new(fastReflection_RequestsCountProof)
+func (x *fastReflection_PacketProof) New() protoreflect.Message {
+	return new(fastReflection_PacketProof)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RequestsCountProof) Interface() protoreflect.ProtoMessage {
-	return (*RequestsCountProof)(x)
+func (x *fastReflection_PacketProof) Interface() protoreflect.ProtoMessage {
+	return (*PacketProof)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10830,22 +9754,22 @@ func (x *fastReflection_RequestsCountProof) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RequestsCountProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Count != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Count)
-		if !f(fd_RequestsCountProof_count, value) {
+func (x *fastReflection_PacketProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_PacketProof_block_height, value) {
 			return
 		}
 	}
-	if x.Version != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Version)
-		if !f(fd_RequestsCountProof_version, value) {
+	if x.PacketDataProof != nil {
+		value := protoreflect.ValueOfMessage(x.PacketDataProof.ProtoReflect())
+		if !f(fd_PacketProof_packet_data_proof, value) {
 			return
 		}
 	}
-	if len(x.MerklePaths) != 0 {
-		value := protoreflect.ValueOfList(&_RequestsCountProof_3_list{list: &x.MerklePaths})
-		if !f(fd_RequestsCountProof_merkle_paths, value) {
+	if x.BlockRelayProof != nil {
+		value := protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
+		if !f(fd_PacketProof_block_relay_proof, value) {
 			return
 		}
 	}
@@ -10862,19 +9786,19 @@ func (x *fastReflection_RequestsCountProof) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RequestsCountProof) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PacketProof) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.RequestsCountProof.count":
-		return x.Count != uint64(0)
-	case "band.base.oracle.v1.RequestsCountProof.version":
-		return x.Version != uint64(0)
-	case "band.base.oracle.v1.RequestsCountProof.merkle_paths":
-		return len(x.MerklePaths) != 0
+	case "band.base.oracle.v1.PacketProof.block_height":
+		return x.BlockHeight != uint64(0)
+	case "band.base.oracle.v1.PacketProof.packet_data_proof":
+		return x.PacketDataProof != nil
+	case "band.base.oracle.v1.PacketProof.block_relay_proof":
+		return x.BlockRelayProof != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.RequestsCountProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PacketProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.RequestsCountProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PacketProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10884,19 +9808,19 @@ func (x *fastReflection_RequestsCountProof) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RequestsCountProof) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PacketProof) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.RequestsCountProof.count":
-		x.Count = uint64(0)
-	case "band.base.oracle.v1.RequestsCountProof.version":
-		x.Version = uint64(0)
-	case "band.base.oracle.v1.RequestsCountProof.merkle_paths":
-		x.MerklePaths = nil
+	case "band.base.oracle.v1.PacketProof.block_height":
+		x.BlockHeight = uint64(0)
+	case "band.base.oracle.v1.PacketProof.packet_data_proof":
+		x.PacketDataProof = nil
+	case "band.base.oracle.v1.PacketProof.block_relay_proof":
+		x.BlockRelayProof = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.RequestsCountProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PacketProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.RequestsCountProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PacketProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10906,25 +9830,22 @@ func (x *fastReflection_RequestsCountProof) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RequestsCountProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PacketProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.base.oracle.v1.RequestsCountProof.count":
-		value := x.Count
-		return protoreflect.ValueOfUint64(value)
-	case "band.base.oracle.v1.RequestsCountProof.version":
-		value := x.Version
+	case "band.base.oracle.v1.PacketProof.block_height":
+		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
-	case "band.base.oracle.v1.RequestsCountProof.merkle_paths":
-		if len(x.MerklePaths) == 0 {
-			return protoreflect.ValueOfList(&_RequestsCountProof_3_list{})
-		}
-		listValue := &_RequestsCountProof_3_list{list: &x.MerklePaths}
-		return protoreflect.ValueOfList(listValue)
+	case "band.base.oracle.v1.PacketProof.packet_data_proof":
+		value := x.PacketDataProof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.PacketProof.block_relay_proof":
+		value := x.BlockRelayProof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.RequestsCountProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PacketProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.RequestsCountProof does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PacketProof does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10938,21 +9859,19 @@ func (x *fastReflection_RequestsCountProof) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RequestsCountProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PacketProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.RequestsCountProof.count":
-		x.Count = value.Uint()
-	case "band.base.oracle.v1.RequestsCountProof.version":
-		x.Version = value.Uint()
-	case "band.base.oracle.v1.RequestsCountProof.merkle_paths":
-		lv := value.List()
-		clv := lv.(*_RequestsCountProof_3_list)
-		x.MerklePaths = *clv.list
+	case "band.base.oracle.v1.PacketProof.block_height":
+		x.BlockHeight = value.Uint()
+	case "band.base.oracle.v1.PacketProof.packet_data_proof":
+		x.PacketDataProof = value.Message().Interface().(*PacketDataProof)
+	case "band.base.oracle.v1.PacketProof.block_relay_proof":
+		x.BlockRelayProof = value.Message().Interface().(*StoreBlockRelayProof)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.RequestsCountProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PacketProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.RequestsCountProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PacketProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10966,53 +9885,56 @@ func (x *fastReflection_RequestsCountProof) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RequestsCountProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PacketProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.RequestsCountProof.merkle_paths":
-		if x.MerklePaths == nil {
-			x.MerklePaths = []*IAVLMerklePath{}
+	case "band.base.oracle.v1.PacketProof.packet_data_proof":
+		if x.PacketDataProof == nil {
+			x.PacketDataProof = new(PacketDataProof)
 		}
-		value := &_RequestsCountProof_3_list{list: &x.MerklePaths}
-		return protoreflect.ValueOfList(value)
-	case "band.base.oracle.v1.RequestsCountProof.count":
-		panic(fmt.Errorf("field count of message band.base.oracle.v1.RequestsCountProof is not mutable"))
-	case "band.base.oracle.v1.RequestsCountProof.version":
-		panic(fmt.Errorf("field version of message band.base.oracle.v1.RequestsCountProof is not mutable"))
+		return protoreflect.ValueOfMessage(x.PacketDataProof.ProtoReflect())
+	case "band.base.oracle.v1.PacketProof.block_relay_proof":
+		if x.BlockRelayProof == nil {
+			x.BlockRelayProof = new(StoreBlockRelayProof)
+		}
+		return protoreflect.ValueOfMessage(x.BlockRelayProof.ProtoReflect())
+	case "band.base.oracle.v1.PacketProof.block_height":
+		panic(fmt.Errorf("field block_height of message band.base.oracle.v1.PacketProof is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.RequestsCountProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PacketProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.RequestsCountProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PacketProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RequestsCountProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PacketProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.base.oracle.v1.RequestsCountProof.count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.base.oracle.v1.RequestsCountProof.version":
+	case "band.base.oracle.v1.PacketProof.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.base.oracle.v1.RequestsCountProof.merkle_paths":
-		list := []*IAVLMerklePath{}
-		return protoreflect.ValueOfList(&_RequestsCountProof_3_list{list: &list})
+	case "band.base.oracle.v1.PacketProof.packet_data_proof":
+		m := new(PacketDataProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.PacketProof.block_relay_proof":
+		m := new(StoreBlockRelayProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.RequestsCountProof"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PacketProof"))
 		}
-		panic(fmt.Errorf("message band.base.oracle.v1.RequestsCountProof does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.base.oracle.v1.PacketProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RequestsCountProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PacketProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.RequestsCountProof", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.PacketProof", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11020,7 +9942,7 @@ func (x *fastReflection_RequestsCountProof) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RequestsCountProof) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PacketProof) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11031,7 +9953,7 @@ func (x *fastReflection_RequestsCountProof) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RequestsCountProof) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PacketProof) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11043,7 +9965,7 @@ func (x *fastReflection_RequestsCountProof) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RequestsCountProof) IsValid() bool {
+func (x *fastReflection_PacketProof) IsValid() bool {
 	return x != nil
 }
 
@@ -11053,9 +9975,9 @@ func (x *fastReflection_RequestsCountProof) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RequestsCountProof) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PacketProof) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RequestsCountProof)
+		x := input.Message.Interface().(*PacketProof)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11067,17 +9989,16 @@ func (x *fastReflection_RequestsCountProof) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Count != 0 {
-			n += 1 + runtime.Sov(uint64(x.Count))
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
-		if x.Version != 0 {
-			n += 1 + runtime.Sov(uint64(x.Version))
+		if x.PacketDataProof != nil {
+			l = options.Size(x.PacketDataProof)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.MerklePaths) > 0 {
-			for _, e := range x.MerklePaths {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.BlockRelayProof != nil {
+			l = options.Size(x.BlockRelayProof)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -11089,7 +10010,7 @@ func (x *fastReflection_RequestsCountProof) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RequestsCountProof)
+		x := input.Message.Interface().(*PacketProof)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11108,29 +10029,36 @@ func (x *fastReflection_RequestsCountProof) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MerklePaths) > 0 {
-			for iNdEx := len(x.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.MerklePaths[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
+		if x.BlockRelayProof != nil {
+			encoded, err := options.Marshal(x.BlockRelayProof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
 		}
-		if x.Version != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+		if x.PacketDataProof != nil {
+			encoded, err := options.Marshal(x.PacketDataProof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if x.Count != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -11145,7 +10073,7 @@ func (x *fastReflection_RequestsCountProof) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RequestsCountProof)
+		x := input.Message.Interface().(*PacketProof)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11177,17 +10105,17 @@ func (x *fastReflection_RequestsCountProof) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestsCountProof: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PacketProof: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestsCountProof: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PacketProof: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
-				x.Count = 0
+				x.BlockHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11197,16 +10125,16 @@ func (x *fastReflection_RequestsCountProof) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Count |= uint64(b&0x7F) << shift
+					x.BlockHeight |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PacketDataProof", wireType)
 				}
-				x.Version = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11216,14 +10144,31 @@ func (x *fastReflection_RequestsCountProof) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Version |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PacketDataProof == nil {
+					x.PacketDataProof = &PacketDataProof{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PacketDataProof); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11250,8 +10195,10 @@ func (x *fastReflection_RequestsCountProof) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MerklePaths = append(x.MerklePaths, &IAVLMerklePath{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MerklePaths[len(x.MerklePaths)-1]); err != nil {
+				if x.BlockRelayProof == nil {
+					x.BlockRelayProof = &StoreBlockRelayProof{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockRelayProof); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -11290,597 +10237,9468 @@ func (x *fastReflection_RequestsCountProof) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: band/base/oracle/v1/proof.proto
+var _ protoreflect.List = (*_OracleDataProof_3_list)(nil)
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+type _OracleDataProof_3_list struct {
+	list *[]*IAVLMerklePath
+}
+
+func (x *_OracleDataProof_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OracleDataProof_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_OracleDataProof_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IAVLMerklePath)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OracleDataProof_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IAVLMerklePath)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OracleDataProof_3_list) AppendMutable() protoreflect.Value {
+	v := new(IAVLMerklePath)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OracleDataProof_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OracleDataProof_3_list) NewElement() protoreflect.Value {
+	v := new(IAVLMerklePath)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OracleDataProof_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_OracleDataProof              protoreflect.MessageDescriptor
+	fd_OracleDataProof_result       protoreflect.FieldDescriptor
+	fd_OracleDataProof_version      protoreflect.FieldDescriptor
+	fd_OracleDataProof_merkle_paths protoreflect.FieldDescriptor
 )
 
-// ProofRequest is request type for the Service/Proof RPC method.
-type ProofRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_OracleDataProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("OracleDataProof")
+	fd_OracleDataProof_result = md_OracleDataProof.Fields().ByName("result")
+	fd_OracleDataProof_version = md_OracleDataProof.Fields().ByName("version")
+	fd_OracleDataProof_merkle_paths = md_OracleDataProof.Fields().ByName("merkle_paths")
+}
 
-	// RequestID is ID of an oracle request
-	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// height is block height
-	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+var _ protoreflect.Message = (*fastReflection_OracleDataProof)(nil)
+
+type fastReflection_OracleDataProof OracleDataProof
+
+func (x *OracleDataProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OracleDataProof)(x)
 }
 
-func (x *ProofRequest) Reset() {
-	*x = ProofRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[0]
+func (x *OracleDataProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *ProofRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+var _fastReflection_OracleDataProof_messageType fastReflection_OracleDataProof_messageType
+var _ protoreflect.MessageType = fastReflection_OracleDataProof_messageType{}
+
+type fastReflection_OracleDataProof_messageType struct{}
+
+func (x fastReflection_OracleDataProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OracleDataProof)(nil)
+}
+func (x fastReflection_OracleDataProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_OracleDataProof)
+}
+func (x fastReflection_OracleDataProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleDataProof
 }
 
-func (*ProofRequest) ProtoMessage() {}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OracleDataProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleDataProof
+}
 
-// Deprecated: Use ProofRequest.ProtoReflect.Descriptor instead.
-func (*ProofRequest) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{0}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OracleDataProof) Type() protoreflect.MessageType {
+	return _fastReflection_OracleDataProof_messageType
 }
 
-func (x *ProofRequest) GetRequestId() uint64 {
-	if x != nil {
-		return x.RequestId
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OracleDataProof) New() protoreflect.Message {
+	return new(fastReflection_OracleDataProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OracleDataProof) Interface() protoreflect.ProtoMessage {
+	return (*OracleDataProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OracleDataProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Result != nil {
+		value := protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+		if !f(fd_OracleDataProof_result, value) {
+			return
+		}
+	}
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_OracleDataProof_version, value) {
+			return
+		}
+	}
+	if len(x.MerklePaths) != 0 {
+		value := protoreflect.ValueOfList(&_OracleDataProof_3_list{list: &x.MerklePaths})
+		if !f(fd_OracleDataProof_merkle_paths, value) {
+			return
+		}
 	}
-	return 0
 }
 
-func (x *ProofRequest) GetHeight() int64 {
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OracleDataProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.OracleDataProof.result":
+		return x.Result != nil
+	case "band.base.oracle.v1.OracleDataProof.version":
+		return x.Version != uint64(0)
+	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
+		return len(x.MerklePaths) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleDataProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.OracleDataProof.result":
+		x.Result = nil
+	case "band.base.oracle.v1.OracleDataProof.version":
+		x.Version = uint64(0)
+	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
+		x.MerklePaths = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OracleDataProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.oracle.v1.OracleDataProof.result":
+		value := x.Result
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.OracleDataProof.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
+	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
+		if len(x.MerklePaths) == 0 {
+			return protoreflect.ValueOfList(&_OracleDataProof_3_list{})
+		}
+		listValue := &_OracleDataProof_3_list{list: &x.MerklePaths}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleDataProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.OracleDataProof.result":
+		x.Result = value.Message().Interface().(*v1.Result)
+	case "band.base.oracle.v1.OracleDataProof.version":
+		x.Version = value.Uint()
+	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
+		lv := value.List()
+		clv := lv.(*_OracleDataProof_3_list)
+		x.MerklePaths = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleDataProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.OracleDataProof.result":
+		if x.Result == nil {
+			x.Result = new(v1.Result)
+		}
+		return protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
+		if x.MerklePaths == nil {
+			x.MerklePaths = []*IAVLMerklePath{}
+		}
+		value := &_OracleDataProof_3_list{list: &x.MerklePaths}
+		return protoreflect.ValueOfList(value)
+	case "band.base.oracle.v1.OracleDataProof.version":
+		panic(fmt.Errorf("field version of message band.base.oracle.v1.OracleDataProof is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OracleDataProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.OracleDataProof.result":
+		m := new(v1.Result)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.OracleDataProof.version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.base.oracle.v1.OracleDataProof.merkle_paths":
+		list := []*IAVLMerklePath{}
+		return protoreflect.ValueOfList(&_OracleDataProof_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.OracleDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.OracleDataProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OracleDataProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.OracleDataProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OracleDataProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleDataProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OracleDataProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OracleDataProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OracleDataProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Result != nil {
+			l = options.Size(x.Result)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		if len(x.MerklePaths) > 0 {
+			for _, e := range x.MerklePaths {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OracleDataProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MerklePaths) > 0 {
+			for iNdEx := len(x.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MerklePaths[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Result != nil {
+			encoded, err := options.Marshal(x.Result)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OracleDataProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleDataProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleDataProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Result == nil {
+					x.Result = &v1.Result{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Result); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MerklePaths = append(x.MerklePaths, &IAVLMerklePath{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MerklePaths[len(x.MerklePaths)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PriceDataProof_3_list)(nil)
+
+type _PriceDataProof_3_list struct {
+	list *[]*IAVLMerklePath
+}
+
+func (x *_PriceDataProof_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PriceDataProof_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PriceDataProof_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IAVLMerklePath)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PriceDataProof_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IAVLMerklePath)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PriceDataProof_3_list) AppendMutable() protoreflect.Value {
+	v := new(IAVLMerklePath)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PriceDataProof_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PriceDataProof_3_list) NewElement() protoreflect.Value {
+	v := new(IAVLMerklePath)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PriceDataProof_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PriceDataProof              protoreflect.MessageDescriptor
+	fd_PriceDataProof_price        protoreflect.FieldDescriptor
+	fd_PriceDataProof_version      protoreflect.FieldDescriptor
+	fd_PriceDataProof_merkle_paths protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_PriceDataProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("PriceDataProof")
+	fd_PriceDataProof_price = md_PriceDataProof.Fields().ByName("price")
+	fd_PriceDataProof_version = md_PriceDataProof.Fields().ByName("version")
+	fd_PriceDataProof_merkle_paths = md_PriceDataProof.Fields().ByName("merkle_paths")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceDataProof)(nil)
+
+type fastReflection_PriceDataProof PriceDataProof
+
+func (x *PriceDataProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceDataProof)(x)
+}
+
+func (x *PriceDataProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceDataProof_messageType fastReflection_PriceDataProof_messageType
+var _ protoreflect.MessageType = fastReflection_PriceDataProof_messageType{}
+
+type fastReflection_PriceDataProof_messageType struct{}
+
+func (x fastReflection_PriceDataProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceDataProof)(nil)
+}
+func (x fastReflection_PriceDataProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceDataProof)
+}
+func (x fastReflection_PriceDataProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceDataProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceDataProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceDataProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceDataProof) Type() protoreflect.MessageType {
+	return _fastReflection_PriceDataProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceDataProof) New() protoreflect.Message {
+	return new(fastReflection_PriceDataProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceDataProof) Interface() protoreflect.ProtoMessage {
+	return (*PriceDataProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceDataProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Price != nil {
+		value := protoreflect.ValueOfMessage(x.Price.ProtoReflect())
+		if !f(fd_PriceDataProof_price, value) {
+			return
+		}
+	}
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_PriceDataProof_version, value) {
+			return
+		}
+	}
+	if len(x.MerklePaths) != 0 {
+		value := protoreflect.ValueOfList(&_PriceDataProof_3_list{list: &x.MerklePaths})
+		if !f(fd_PriceDataProof_merkle_paths, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceDataProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.PriceDataProof.price":
+		return x.Price != nil
+	case "band.base.oracle.v1.PriceDataProof.version":
+		return x.Version != uint64(0)
+	case "band.base.oracle.v1.PriceDataProof.merkle_paths":
+		return len(x.MerklePaths) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceDataProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceDataProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.PriceDataProof.price":
+		x.Price = nil
+	case "band.base.oracle.v1.PriceDataProof.version":
+		x.Version = uint64(0)
+	case "band.base.oracle.v1.PriceDataProof.merkle_paths":
+		x.MerklePaths = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceDataProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceDataProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.oracle.v1.PriceDataProof.price":
+		value := x.Price
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.PriceDataProof.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
+	case "band.base.oracle.v1.PriceDataProof.merkle_paths":
+		if len(x.MerklePaths) == 0 {
+			return protoreflect.ValueOfList(&_PriceDataProof_3_list{})
+		}
+		listValue := &_PriceDataProof_3_list{list: &x.MerklePaths}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceDataProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceDataProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.PriceDataProof.price":
+		x.Price = value.Message().Interface().(*v1beta1.Price)
+	case "band.base.oracle.v1.PriceDataProof.version":
+		x.Version = value.Uint()
+	case "band.base.oracle.v1.PriceDataProof.merkle_paths":
+		lv := value.List()
+		clv := lv.(*_PriceDataProof_3_list)
+		x.MerklePaths = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceDataProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceDataProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.PriceDataProof.price":
+		if x.Price == nil {
+			x.Price = new(v1beta1.Price)
+		}
+		return protoreflect.ValueOfMessage(x.Price.ProtoReflect())
+	case "band.base.oracle.v1.PriceDataProof.merkle_paths":
+		if x.MerklePaths == nil {
+			x.MerklePaths = []*IAVLMerklePath{}
+		}
+		value := &_PriceDataProof_3_list{list: &x.MerklePaths}
+		return protoreflect.ValueOfList(value)
+	case "band.base.oracle.v1.PriceDataProof.version":
+		panic(fmt.Errorf("field version of message band.base.oracle.v1.PriceDataProof is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceDataProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceDataProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.PriceDataProof.price":
+		m := new(v1beta1.Price)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.PriceDataProof.version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.base.oracle.v1.PriceDataProof.merkle_paths":
+		list := []*IAVLMerklePath{}
+		return protoreflect.ValueOfList(&_PriceDataProof_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PriceDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PriceDataProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceDataProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.PriceDataProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceDataProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceDataProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceDataProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceDataProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceDataProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Price != nil {
+			l = options.Size(x.Price)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		if len(x.MerklePaths) > 0 {
+			for _, e := range x.MerklePaths {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceDataProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MerklePaths) > 0 {
+			for iNdEx := len(x.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MerklePaths[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Price != nil {
+			encoded, err := options.Marshal(x.Price)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceDataProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceDataProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceDataProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Price == nil {
+					x.Price = &v1beta1.Price{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Price); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MerklePaths = append(x.MerklePaths, &IAVLMerklePath{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MerklePaths[len(x.MerklePaths)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PacketDataProof_4_list)(nil)
+
+type _PacketDataProof_4_list struct {
+	list *[]*IAVLMerklePath
+}
+
+func (x *_PacketDataProof_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PacketDataProof_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PacketDataProof_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IAVLMerklePath)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PacketDataProof_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IAVLMerklePath)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PacketDataProof_4_list) AppendMutable() protoreflect.Value {
+	v := new(IAVLMerklePath)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PacketDataProof_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PacketDataProof_4_list) NewElement() protoreflect.Value {
+	v := new(IAVLMerklePath)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PacketDataProof_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PacketDataProof                protoreflect.MessageDescriptor
+	fd_PacketDataProof_packet         protoreflect.FieldDescriptor
+	fd_PacketDataProof_encoded_packet protoreflect.FieldDescriptor
+	fd_PacketDataProof_version        protoreflect.FieldDescriptor
+	fd_PacketDataProof_merkle_paths   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_PacketDataProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("PacketDataProof")
+	fd_PacketDataProof_packet = md_PacketDataProof.Fields().ByName("packet")
+	fd_PacketDataProof_encoded_packet = md_PacketDataProof.Fields().ByName("encoded_packet")
+	fd_PacketDataProof_version = md_PacketDataProof.Fields().ByName("version")
+	fd_PacketDataProof_merkle_paths = md_PacketDataProof.Fields().ByName("merkle_paths")
+}
+
+var _ protoreflect.Message = (*fastReflection_PacketDataProof)(nil)
+
+type fastReflection_PacketDataProof PacketDataProof
+
+func (x *PacketDataProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PacketDataProof)(x)
+}
+
+func (x *PacketDataProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PacketDataProof_messageType fastReflection_PacketDataProof_messageType
+var _ protoreflect.MessageType = fastReflection_PacketDataProof_messageType{}
+
+type fastReflection_PacketDataProof_messageType struct{}
+
+func (x fastReflection_PacketDataProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PacketDataProof)(nil)
+}
+func (x fastReflection_PacketDataProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_PacketDataProof)
+}
+func (x fastReflection_PacketDataProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PacketDataProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PacketDataProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_PacketDataProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PacketDataProof) Type() protoreflect.MessageType {
+	return _fastReflection_PacketDataProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PacketDataProof) New() protoreflect.Message {
+	return new(fastReflection_PacketDataProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PacketDataProof) Interface() protoreflect.ProtoMessage {
+	return (*PacketDataProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PacketDataProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Packet != nil {
+		value := protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+		if !f(fd_PacketDataProof_packet, value) {
+			return
+		}
+	}
+	if len(x.EncodedPacket) != 0 {
+		value := protoreflect.ValueOfBytes(x.EncodedPacket)
+		if !f(fd_PacketDataProof_encoded_packet, value) {
+			return
+		}
+	}
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_PacketDataProof_version, value) {
+			return
+		}
+	}
+	if len(x.MerklePaths) != 0 {
+		value := protoreflect.ValueOfList(&_PacketDataProof_4_list{list: &x.MerklePaths})
+		if !f(fd_PacketDataProof_merkle_paths, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PacketDataProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.PacketDataProof.packet":
+		return x.Packet != nil
+	case "band.base.oracle.v1.PacketDataProof.encoded_packet":
+		return len(x.EncodedPacket) != 0
+	case "band.base.oracle.v1.PacketDataProof.version":
+		return x.Version != uint64(0)
+	case "band.base.oracle.v1.PacketDataProof.merkle_paths":
+		return len(x.MerklePaths) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PacketDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PacketDataProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PacketDataProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.PacketDataProof.packet":
+		x.Packet = nil
+	case "band.base.oracle.v1.PacketDataProof.encoded_packet":
+		x.EncodedPacket = nil
+	case "band.base.oracle.v1.PacketDataProof.version":
+		x.Version = uint64(0)
+	case "band.base.oracle.v1.PacketDataProof.merkle_paths":
+		x.MerklePaths = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PacketDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PacketDataProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PacketDataProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.oracle.v1.PacketDataProof.packet":
+		value := x.Packet
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.PacketDataProof.encoded_packet":
+		value := x.EncodedPacket
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.PacketDataProof.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
+	case "band.base.oracle.v1.PacketDataProof.merkle_paths":
+		if len(x.MerklePaths) == 0 {
+			return protoreflect.ValueOfList(&_PacketDataProof_4_list{})
+		}
+		listValue := &_PacketDataProof_4_list{list: &x.MerklePaths}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PacketDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PacketDataProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PacketDataProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.PacketDataProof.packet":
+		x.Packet = value.Message().Interface().(*v1beta11.Packet)
+	case "band.base.oracle.v1.PacketDataProof.encoded_packet":
+		x.EncodedPacket = value.Bytes()
+	case "band.base.oracle.v1.PacketDataProof.version":
+		x.Version = value.Uint()
+	case "band.base.oracle.v1.PacketDataProof.merkle_paths":
+		lv := value.List()
+		clv := lv.(*_PacketDataProof_4_list)
+		x.MerklePaths = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PacketDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PacketDataProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PacketDataProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.PacketDataProof.packet":
+		if x.Packet == nil {
+			x.Packet = new(v1beta11.Packet)
+		}
+		return protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+	case "band.base.oracle.v1.PacketDataProof.merkle_paths":
+		if x.MerklePaths == nil {
+			x.MerklePaths = []*IAVLMerklePath{}
+		}
+		value := &_PacketDataProof_4_list{list: &x.MerklePaths}
+		return protoreflect.ValueOfList(value)
+	case "band.base.oracle.v1.PacketDataProof.encoded_packet":
+		panic(fmt.Errorf("field encoded_packet of message band.base.oracle.v1.PacketDataProof is not mutable"))
+	case "band.base.oracle.v1.PacketDataProof.version":
+		panic(fmt.Errorf("field version of message band.base.oracle.v1.PacketDataProof is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PacketDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PacketDataProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PacketDataProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.PacketDataProof.packet":
+		m := new(v1beta11.Packet)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.PacketDataProof.encoded_packet":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.PacketDataProof.version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.base.oracle.v1.PacketDataProof.merkle_paths":
+		list := []*IAVLMerklePath{}
+		return protoreflect.ValueOfList(&_PacketDataProof_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.PacketDataProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.PacketDataProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PacketDataProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.PacketDataProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PacketDataProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PacketDataProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PacketDataProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PacketDataProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PacketDataProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Packet != nil {
+			l = options.Size(x.Packet)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EncodedPacket)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		if len(x.MerklePaths) > 0 {
+			for _, e := range x.MerklePaths {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PacketDataProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MerklePaths) > 0 {
+			for iNdEx := len(x.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MerklePaths[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.EncodedPacket) > 0 {
+			i -= len(x.EncodedPacket)
+			copy(dAtA[i:], x.EncodedPacket)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EncodedPacket)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Packet != nil {
+			encoded, err := options.Marshal(x.Packet)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PacketDataProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PacketDataProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PacketDataProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Packet == nil {
+					x.Packet = &v1beta11.Packet{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packet); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EncodedPacket", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EncodedPacket = append(x.EncodedPacket[:0], dAtA[iNdEx:postIndex]...)
+				if x.EncodedPacket == nil {
+					x.EncodedPacket = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MerklePaths = append(x.MerklePaths, &IAVLMerklePath{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MerklePaths[len(x.MerklePaths)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_IAVLMerklePath                  protoreflect.MessageDescriptor
+	fd_IAVLMerklePath_is_data_on_right protoreflect.FieldDescriptor
+	fd_IAVLMerklePath_subtree_height   protoreflect.FieldDescriptor
+	fd_IAVLMerklePath_subtree_size     protoreflect.FieldDescriptor
+	fd_IAVLMerklePath_subtree_version  protoreflect.FieldDescriptor
+	fd_IAVLMerklePath_sibling_hash     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_IAVLMerklePath = File_band_base_oracle_v1_proof_proto.Messages().ByName("IAVLMerklePath")
+	fd_IAVLMerklePath_is_data_on_right = md_IAVLMerklePath.Fields().ByName("is_data_on_right")
+	fd_IAVLMerklePath_subtree_height = md_IAVLMerklePath.Fields().ByName("subtree_height")
+	fd_IAVLMerklePath_subtree_size = md_IAVLMerklePath.Fields().ByName("subtree_size")
+	fd_IAVLMerklePath_subtree_version = md_IAVLMerklePath.Fields().ByName("subtree_version")
+	fd_IAVLMerklePath_sibling_hash = md_IAVLMerklePath.Fields().ByName("sibling_hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_IAVLMerklePath)(nil)
+
+type fastReflection_IAVLMerklePath IAVLMerklePath
+
+func (x *IAVLMerklePath) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IAVLMerklePath)(x)
+}
+
+func (x *IAVLMerklePath) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_IAVLMerklePath_messageType fastReflection_IAVLMerklePath_messageType
+var _ protoreflect.MessageType = fastReflection_IAVLMerklePath_messageType{}
+
+type fastReflection_IAVLMerklePath_messageType struct{}
+
+func (x fastReflection_IAVLMerklePath_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IAVLMerklePath)(nil)
+}
+func (x fastReflection_IAVLMerklePath_messageType) New() protoreflect.Message {
+	return new(fastReflection_IAVLMerklePath)
+}
+func (x fastReflection_IAVLMerklePath_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IAVLMerklePath
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_IAVLMerklePath) Descriptor() protoreflect.MessageDescriptor {
+	return md_IAVLMerklePath
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_IAVLMerklePath) Type() protoreflect.MessageType {
+	return _fastReflection_IAVLMerklePath_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_IAVLMerklePath) New() protoreflect.Message {
+	return new(fastReflection_IAVLMerklePath)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_IAVLMerklePath) Interface() protoreflect.ProtoMessage {
+	return (*IAVLMerklePath)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_IAVLMerklePath) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.IsDataOnRight != false {
+		value := protoreflect.ValueOfBool(x.IsDataOnRight)
+		if !f(fd_IAVLMerklePath_is_data_on_right, value) {
+			return
+		}
+	}
+	if x.SubtreeHeight != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SubtreeHeight)
+		if !f(fd_IAVLMerklePath_subtree_height, value) {
+			return
+		}
+	}
+	if x.SubtreeSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SubtreeSize)
+		if !f(fd_IAVLMerklePath_subtree_size, value) {
+			return
+		}
+	}
+	if x.SubtreeVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SubtreeVersion)
+		if !f(fd_IAVLMerklePath_subtree_version, value) {
+			return
+		}
+	}
+	if len(x.SiblingHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.SiblingHash)
+		if !f(fd_IAVLMerklePath_sibling_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_IAVLMerklePath) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
+		return x.IsDataOnRight != false
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
+		return x.SubtreeHeight != uint32(0)
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
+		return x.SubtreeSize != uint64(0)
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
+		return x.SubtreeVersion != uint64(0)
+	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
+		return len(x.SiblingHash) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IAVLMerklePath) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
+		x.IsDataOnRight = false
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
+		x.SubtreeHeight = uint32(0)
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
+		x.SubtreeSize = uint64(0)
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
+		x.SubtreeVersion = uint64(0)
+	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
+		x.SiblingHash = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_IAVLMerklePath) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
+		value := x.IsDataOnRight
+		return protoreflect.ValueOfBool(value)
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
+		value := x.SubtreeHeight
+		return protoreflect.ValueOfUint32(value)
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
+		value := x.SubtreeSize
+		return protoreflect.ValueOfUint64(value)
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
+		value := x.SubtreeVersion
+		return protoreflect.ValueOfUint64(value)
+	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
+		value := x.SiblingHash
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IAVLMerklePath) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
+		x.IsDataOnRight = value.Bool()
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
+		x.SubtreeHeight = uint32(value.Uint())
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
+		x.SubtreeSize = value.Uint()
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
+		x.SubtreeVersion = value.Uint()
+	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
+		x.SiblingHash = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IAVLMerklePath) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
+		panic(fmt.Errorf("field is_data_on_right of message band.base.oracle.v1.IAVLMerklePath is not mutable"))
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
+		panic(fmt.Errorf("field subtree_height of message band.base.oracle.v1.IAVLMerklePath is not mutable"))
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
+		panic(fmt.Errorf("field subtree_size of message band.base.oracle.v1.IAVLMerklePath is not mutable"))
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
+		panic(fmt.Errorf("field subtree_version of message band.base.oracle.v1.IAVLMerklePath is not mutable"))
+	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
+		panic(fmt.Errorf("field sibling_hash of message band.base.oracle.v1.IAVLMerklePath is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_IAVLMerklePath) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.IAVLMerklePath.is_data_on_right":
+		return protoreflect.ValueOfBool(false)
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_height":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.base.oracle.v1.IAVLMerklePath.subtree_version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.base.oracle.v1.IAVLMerklePath.sibling_hash":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.IAVLMerklePath"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.IAVLMerklePath does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_IAVLMerklePath) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.IAVLMerklePath", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_IAVLMerklePath) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IAVLMerklePath) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_IAVLMerklePath) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_IAVLMerklePath) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*IAVLMerklePath)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.IsDataOnRight {
+			n += 2
+		}
+		if x.SubtreeHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubtreeHeight))
+		}
+		if x.SubtreeSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubtreeSize))
+		}
+		if x.SubtreeVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubtreeVersion))
+		}
+		l = len(x.SiblingHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*IAVLMerklePath)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.SiblingHash) > 0 {
+			i -= len(x.SiblingHash)
+			copy(dAtA[i:], x.SiblingHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SiblingHash)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.SubtreeVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubtreeVersion))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.SubtreeSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubtreeSize))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.SubtreeHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubtreeHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.IsDataOnRight {
+			i--
+			if x.IsDataOnRight {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*IAVLMerklePath)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IAVLMerklePath: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IAVLMerklePath: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsDataOnRight", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsDataOnRight = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubtreeHeight", wireType)
+				}
+				x.SubtreeHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubtreeHeight |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubtreeSize", wireType)
+				}
+				x.SubtreeSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubtreeSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubtreeVersion", wireType)
+				}
+				x.SubtreeVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubtreeVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SiblingHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SiblingHash = append(x.SiblingHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.SiblingHash == nil {
+					x.SiblingHash = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_BlockRelayProof_4_list)(nil)
+
+type _BlockRelayProof_4_list struct {
+	list *[]*TMSignature
+}
+
+func (x *_BlockRelayProof_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BlockRelayProof_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_BlockRelayProof_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TMSignature)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BlockRelayProof_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TMSignature)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BlockRelayProof_4_list) AppendMutable() protoreflect.Value {
+	v := new(TMSignature)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BlockRelayProof_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BlockRelayProof_4_list) NewElement() protoreflect.Value {
+	v := new(TMSignature)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BlockRelayProof_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_BlockRelayProof                           protoreflect.MessageDescriptor
+	fd_BlockRelayProof_multi_store_proof         protoreflect.FieldDescriptor
+	fd_BlockRelayProof_block_header_merkle_parts protoreflect.FieldDescriptor
+	fd_BlockRelayProof_common_encoded_vote_part  protoreflect.FieldDescriptor
+	fd_BlockRelayProof_signatures                protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_BlockRelayProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("BlockRelayProof")
+	fd_BlockRelayProof_multi_store_proof = md_BlockRelayProof.Fields().ByName("multi_store_proof")
+	fd_BlockRelayProof_block_header_merkle_parts = md_BlockRelayProof.Fields().ByName("block_header_merkle_parts")
+	fd_BlockRelayProof_common_encoded_vote_part = md_BlockRelayProof.Fields().ByName("common_encoded_vote_part")
+	fd_BlockRelayProof_signatures = md_BlockRelayProof.Fields().ByName("signatures")
+}
+
+var _ protoreflect.Message = (*fastReflection_BlockRelayProof)(nil)
+
+type fastReflection_BlockRelayProof BlockRelayProof
+
+func (x *BlockRelayProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BlockRelayProof)(x)
+}
+
+func (x *BlockRelayProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BlockRelayProof_messageType fastReflection_BlockRelayProof_messageType
+var _ protoreflect.MessageType = fastReflection_BlockRelayProof_messageType{}
+
+type fastReflection_BlockRelayProof_messageType struct{}
+
+func (x fastReflection_BlockRelayProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BlockRelayProof)(nil)
+}
+func (x fastReflection_BlockRelayProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_BlockRelayProof)
+}
+func (x fastReflection_BlockRelayProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BlockRelayProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BlockRelayProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_BlockRelayProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BlockRelayProof) Type() protoreflect.MessageType {
+	return _fastReflection_BlockRelayProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BlockRelayProof) New() protoreflect.Message {
+	return new(fastReflection_BlockRelayProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BlockRelayProof) Interface() protoreflect.ProtoMessage {
+	return (*BlockRelayProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BlockRelayProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MultiStoreProof != nil {
+		value := protoreflect.ValueOfMessage(x.MultiStoreProof.ProtoReflect())
+		if !f(fd_BlockRelayProof_multi_store_proof, value) {
+			return
+		}
+	}
+	if x.BlockHeaderMerkleParts != nil {
+		value := protoreflect.ValueOfMessage(x.BlockHeaderMerkleParts.ProtoReflect())
+		if !f(fd_BlockRelayProof_block_header_merkle_parts, value) {
+			return
+		}
+	}
+	if x.CommonEncodedVotePart != nil {
+		value := protoreflect.ValueOfMessage(x.CommonEncodedVotePart.ProtoReflect())
+		if !f(fd_BlockRelayProof_common_encoded_vote_part, value) {
+			return
+		}
+	}
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_BlockRelayProof_4_list{list: &x.Signatures})
+		if !f(fd_BlockRelayProof_signatures, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BlockRelayProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
+		return x.MultiStoreProof != nil
+	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
+		return x.BlockHeaderMerkleParts != nil
+	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
+		return x.CommonEncodedVotePart != nil
+	case "band.base.oracle.v1.BlockRelayProof.signatures":
+		return len(x.Signatures) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockRelayProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
+		x.MultiStoreProof = nil
+	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
+		x.BlockHeaderMerkleParts = nil
+	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
+		x.CommonEncodedVotePart = nil
+	case "band.base.oracle.v1.BlockRelayProof.signatures":
+		x.Signatures = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BlockRelayProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
+		value := x.MultiStoreProof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
+		value := x.BlockHeaderMerkleParts
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
+		value := x.CommonEncodedVotePart
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.BlockRelayProof.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_BlockRelayProof_4_list{})
+		}
+		listValue := &_BlockRelayProof_4_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockRelayProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
+		x.MultiStoreProof = value.Message().Interface().(*MultiStoreProof)
+	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
+		x.BlockHeaderMerkleParts = value.Message().Interface().(*BlockHeaderMerkleParts)
+	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
+		x.CommonEncodedVotePart = value.Message().Interface().(*CommonEncodedVotePart)
+	case "band.base.oracle.v1.BlockRelayProof.signatures":
+		lv := value.List()
+		clv := lv.(*_BlockRelayProof_4_list)
+		x.Signatures = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockRelayProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
+		if x.MultiStoreProof == nil {
+			x.MultiStoreProof = new(MultiStoreProof)
+		}
+		return protoreflect.ValueOfMessage(x.MultiStoreProof.ProtoReflect())
+	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
+		if x.BlockHeaderMerkleParts == nil {
+			x.BlockHeaderMerkleParts = new(BlockHeaderMerkleParts)
+		}
+		return protoreflect.ValueOfMessage(x.BlockHeaderMerkleParts.ProtoReflect())
+	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
+		if x.CommonEncodedVotePart == nil {
+			x.CommonEncodedVotePart = new(CommonEncodedVotePart)
+		}
+		return protoreflect.ValueOfMessage(x.CommonEncodedVotePart.ProtoReflect())
+	case "band.base.oracle.v1.BlockRelayProof.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []*TMSignature{}
+		}
+		value := &_BlockRelayProof_4_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BlockRelayProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.BlockRelayProof.multi_store_proof":
+		m := new(MultiStoreProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts":
+		m := new(BlockHeaderMerkleParts)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part":
+		m := new(CommonEncodedVotePart)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.BlockRelayProof.signatures":
+		list := []*TMSignature{}
+		return protoreflect.ValueOfList(&_BlockRelayProof_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockRelayProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.BlockRelayProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BlockRelayProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.BlockRelayProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BlockRelayProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockRelayProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BlockRelayProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BlockRelayProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BlockRelayProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MultiStoreProof != nil {
+			l = options.Size(x.MultiStoreProof)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockHeaderMerkleParts != nil {
+			l = options.Size(x.BlockHeaderMerkleParts)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CommonEncodedVotePart != nil {
+			l = options.Size(x.CommonEncodedVotePart)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signatures) > 0 {
+			for _, e := range x.Signatures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BlockRelayProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signatures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.CommonEncodedVotePart != nil {
+			encoded, err := options.Marshal(x.CommonEncodedVotePart)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BlockHeaderMerkleParts != nil {
+			encoded, err := options.Marshal(x.BlockHeaderMerkleParts)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.MultiStoreProof != nil {
+			encoded, err := options.Marshal(x.MultiStoreProof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BlockRelayProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockRelayProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockRelayProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultiStoreProof", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MultiStoreProof == nil {
+					x.MultiStoreProof = &MultiStoreProof{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MultiStoreProof); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderMerkleParts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BlockHeaderMerkleParts == nil {
+					x.BlockHeaderMerkleParts = &BlockHeaderMerkleParts{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockHeaderMerkleParts); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommonEncodedVotePart", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CommonEncodedVotePart == nil {
+					x.CommonEncodedVotePart = &CommonEncodedVotePart{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CommonEncodedVotePart); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, &TMSignature{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_StoreBlockRelayProof_4_list)(nil)
+
+type _StoreBlockRelayProof_4_list struct {
+	list *[]*TMSignature
+}
+
+func (x *_StoreBlockRelayProof_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StoreBlockRelayProof_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_StoreBlockRelayProof_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TMSignature)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StoreBlockRelayProof_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TMSignature)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StoreBlockRelayProof_4_list) AppendMutable() protoreflect.Value {
+	v := new(TMSignature)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StoreBlockRelayProof_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StoreBlockRelayProof_4_list) NewElement() protoreflect.Value {
+	v := new(TMSignature)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StoreBlockRelayProof_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_StoreBlockRelayProof                           protoreflect.MessageDescriptor
+	fd_StoreBlockRelayProof_multi_store_proof         protoreflect.FieldDescriptor
+	fd_StoreBlockRelayProof_block_header_merkle_parts protoreflect.FieldDescriptor
+	fd_StoreBlockRelayProof_common_encoded_vote_part  protoreflect.FieldDescriptor
+	fd_StoreBlockRelayProof_signatures                protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_StoreBlockRelayProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("StoreBlockRelayProof")
+	fd_StoreBlockRelayProof_multi_store_proof = md_StoreBlockRelayProof.Fields().ByName("multi_store_proof")
+	fd_StoreBlockRelayProof_block_header_merkle_parts = md_StoreBlockRelayProof.Fields().ByName("block_header_merkle_parts")
+	fd_StoreBlockRelayProof_common_encoded_vote_part = md_StoreBlockRelayProof.Fields().ByName("common_encoded_vote_part")
+	fd_StoreBlockRelayProof_signatures = md_StoreBlockRelayProof.Fields().ByName("signatures")
+}
+
+var _ protoreflect.Message = (*fastReflection_StoreBlockRelayProof)(nil)
+
+type fastReflection_StoreBlockRelayProof StoreBlockRelayProof
+
+func (x *StoreBlockRelayProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StoreBlockRelayProof)(x)
+}
+
+func (x *StoreBlockRelayProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StoreBlockRelayProof_messageType fastReflection_StoreBlockRelayProof_messageType
+var _ protoreflect.MessageType = fastReflection_StoreBlockRelayProof_messageType{}
+
+type fastReflection_StoreBlockRelayProof_messageType struct{}
+
+func (x fastReflection_StoreBlockRelayProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StoreBlockRelayProof)(nil)
+}
+func (x fastReflection_StoreBlockRelayProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_StoreBlockRelayProof)
+}
+func (x fastReflection_StoreBlockRelayProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StoreBlockRelayProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StoreBlockRelayProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_StoreBlockRelayProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StoreBlockRelayProof) Type() protoreflect.MessageType {
+	return _fastReflection_StoreBlockRelayProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StoreBlockRelayProof) New() protoreflect.Message {
+	return new(fastReflection_StoreBlockRelayProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StoreBlockRelayProof) Interface() protoreflect.ProtoMessage {
+	return (*StoreBlockRelayProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StoreBlockRelayProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MultiStoreProof != nil {
+		value := protoreflect.ValueOfMessage(x.MultiStoreProof.ProtoReflect())
+		if !f(fd_StoreBlockRelayProof_multi_store_proof, value) {
+			return
+		}
+	}
+	if x.BlockHeaderMerkleParts != nil {
+		value := protoreflect.ValueOfMessage(x.BlockHeaderMerkleParts.ProtoReflect())
+		if !f(fd_StoreBlockRelayProof_block_header_merkle_parts, value) {
+			return
+		}
+	}
+	if x.CommonEncodedVotePart != nil {
+		value := protoreflect.ValueOfMessage(x.CommonEncodedVotePart.ProtoReflect())
+		if !f(fd_StoreBlockRelayProof_common_encoded_vote_part, value) {
+			return
+		}
+	}
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_StoreBlockRelayProof_4_list{list: &x.Signatures})
+		if !f(fd_StoreBlockRelayProof_signatures, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StoreBlockRelayProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.StoreBlockRelayProof.multi_store_proof":
+		return x.MultiStoreProof != nil
+	case "band.base.oracle.v1.StoreBlockRelayProof.block_header_merkle_parts":
+		return x.BlockHeaderMerkleParts != nil
+	case "band.base.oracle.v1.StoreBlockRelayProof.common_encoded_vote_part":
+		return x.CommonEncodedVotePart != nil
+	case "band.base.oracle.v1.StoreBlockRelayProof.signatures":
+		return len(x.Signatures) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.StoreBlockRelayProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.StoreBlockRelayProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreBlockRelayProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.StoreBlockRelayProof.multi_store_proof":
+		x.MultiStoreProof = nil
+	case "band.base.oracle.v1.StoreBlockRelayProof.block_header_merkle_parts":
+		x.BlockHeaderMerkleParts = nil
+	case "band.base.oracle.v1.StoreBlockRelayProof.common_encoded_vote_part":
+		x.CommonEncodedVotePart = nil
+	case "band.base.oracle.v1.StoreBlockRelayProof.signatures":
+		x.Signatures = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.StoreBlockRelayProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.StoreBlockRelayProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StoreBlockRelayProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.oracle.v1.StoreBlockRelayProof.multi_store_proof":
+		value := x.MultiStoreProof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.StoreBlockRelayProof.block_header_merkle_parts":
+		value := x.BlockHeaderMerkleParts
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.StoreBlockRelayProof.common_encoded_vote_part":
+		value := x.CommonEncodedVotePart
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.oracle.v1.StoreBlockRelayProof.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_StoreBlockRelayProof_4_list{})
+		}
+		listValue := &_StoreBlockRelayProof_4_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.StoreBlockRelayProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.StoreBlockRelayProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreBlockRelayProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.StoreBlockRelayProof.multi_store_proof":
+		x.MultiStoreProof = value.Message().Interface().(*StoreMultiStoreProof)
+	case "band.base.oracle.v1.StoreBlockRelayProof.block_header_merkle_parts":
+		x.BlockHeaderMerkleParts = value.Message().Interface().(*BlockHeaderMerkleParts)
+	case "band.base.oracle.v1.StoreBlockRelayProof.common_encoded_vote_part":
+		x.CommonEncodedVotePart = value.Message().Interface().(*CommonEncodedVotePart)
+	case "band.base.oracle.v1.StoreBlockRelayProof.signatures":
+		lv := value.List()
+		clv := lv.(*_StoreBlockRelayProof_4_list)
+		x.Signatures = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.StoreBlockRelayProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.StoreBlockRelayProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreBlockRelayProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.StoreBlockRelayProof.multi_store_proof":
+		if x.MultiStoreProof == nil {
+			x.MultiStoreProof = new(StoreMultiStoreProof)
+		}
+		return protoreflect.ValueOfMessage(x.MultiStoreProof.ProtoReflect())
+	case "band.base.oracle.v1.StoreBlockRelayProof.block_header_merkle_parts":
+		if x.BlockHeaderMerkleParts == nil {
+			x.BlockHeaderMerkleParts = new(BlockHeaderMerkleParts)
+		}
+		return protoreflect.ValueOfMessage(x.BlockHeaderMerkleParts.ProtoReflect())
+	case "band.base.oracle.v1.StoreBlockRelayProof.common_encoded_vote_part":
+		if x.CommonEncodedVotePart == nil {
+			x.CommonEncodedVotePart = new(CommonEncodedVotePart)
+		}
+		return protoreflect.ValueOfMessage(x.CommonEncodedVotePart.ProtoReflect())
+	case "band.base.oracle.v1.StoreBlockRelayProof.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []*TMSignature{}
+		}
+		value := &_StoreBlockRelayProof_4_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.StoreBlockRelayProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.StoreBlockRelayProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StoreBlockRelayProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.StoreBlockRelayProof.multi_store_proof":
+		m := new(StoreMultiStoreProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.StoreBlockRelayProof.block_header_merkle_parts":
+		m := new(BlockHeaderMerkleParts)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.StoreBlockRelayProof.common_encoded_vote_part":
+		m := new(CommonEncodedVotePart)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.oracle.v1.StoreBlockRelayProof.signatures":
+		list := []*TMSignature{}
+		return protoreflect.ValueOfList(&_StoreBlockRelayProof_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.StoreBlockRelayProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.StoreBlockRelayProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StoreBlockRelayProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.StoreBlockRelayProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StoreBlockRelayProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreBlockRelayProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StoreBlockRelayProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StoreBlockRelayProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StoreBlockRelayProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MultiStoreProof != nil {
+			l = options.Size(x.MultiStoreProof)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockHeaderMerkleParts != nil {
+			l = options.Size(x.BlockHeaderMerkleParts)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CommonEncodedVotePart != nil {
+			l = options.Size(x.CommonEncodedVotePart)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signatures) > 0 {
+			for _, e := range x.Signatures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StoreBlockRelayProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signatures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.CommonEncodedVotePart != nil {
+			encoded, err := options.Marshal(x.CommonEncodedVotePart)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BlockHeaderMerkleParts != nil {
+			encoded, err := options.Marshal(x.BlockHeaderMerkleParts)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.MultiStoreProof != nil {
+			encoded, err := options.Marshal(x.MultiStoreProof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StoreBlockRelayProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreBlockRelayProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreBlockRelayProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultiStoreProof", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MultiStoreProof == nil {
+					x.MultiStoreProof = &StoreMultiStoreProof{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MultiStoreProof); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderMerkleParts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BlockHeaderMerkleParts == nil {
+					x.BlockHeaderMerkleParts = &BlockHeaderMerkleParts{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockHeaderMerkleParts); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommonEncodedVotePart", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CommonEncodedVotePart == nil {
+					x.CommonEncodedVotePart = &CommonEncodedVotePart{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CommonEncodedVotePart); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, &TMSignature{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_StoreMultiStoreProof_3_list)(nil)
+
+type _StoreMultiStoreProof_3_list struct {
+	list *[]*MultiStoreMerklePath
+}
+
+func (x *_StoreMultiStoreProof_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StoreMultiStoreProof_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_StoreMultiStoreProof_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MultiStoreMerklePath)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StoreMultiStoreProof_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MultiStoreMerklePath)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StoreMultiStoreProof_3_list) AppendMutable() protoreflect.Value {
+	v := new(MultiStoreMerklePath)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StoreMultiStoreProof_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StoreMultiStoreProof_3_list) NewElement() protoreflect.Value {
+	v := new(MultiStoreMerklePath)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StoreMultiStoreProof_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_StoreMultiStoreProof                 protoreflect.MessageDescriptor
+	fd_StoreMultiStoreProof_store_key       protoreflect.FieldDescriptor
+	fd_StoreMultiStoreProof_iavl_state_hash protoreflect.FieldDescriptor
+	fd_StoreMultiStoreProof_merkle_paths    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_StoreMultiStoreProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("StoreMultiStoreProof")
+	fd_StoreMultiStoreProof_store_key = md_StoreMultiStoreProof.Fields().ByName("store_key")
+	fd_StoreMultiStoreProof_iavl_state_hash = md_StoreMultiStoreProof.Fields().ByName("iavl_state_hash")
+	fd_StoreMultiStoreProof_merkle_paths = md_StoreMultiStoreProof.Fields().ByName("merkle_paths")
+}
+
+var _ protoreflect.Message = (*fastReflection_StoreMultiStoreProof)(nil)
+
+type fastReflection_StoreMultiStoreProof StoreMultiStoreProof
+
+func (x *StoreMultiStoreProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StoreMultiStoreProof)(x)
+}
+
+func (x *StoreMultiStoreProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StoreMultiStoreProof_messageType fastReflection_StoreMultiStoreProof_messageType
+var _ protoreflect.MessageType = fastReflection_StoreMultiStoreProof_messageType{}
+
+type fastReflection_StoreMultiStoreProof_messageType struct{}
+
+func (x fastReflection_StoreMultiStoreProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StoreMultiStoreProof)(nil)
+}
+func (x fastReflection_StoreMultiStoreProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_StoreMultiStoreProof)
+}
+func (x fastReflection_StoreMultiStoreProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StoreMultiStoreProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StoreMultiStoreProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_StoreMultiStoreProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StoreMultiStoreProof) Type() protoreflect.MessageType {
+	return _fastReflection_StoreMultiStoreProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StoreMultiStoreProof) New() protoreflect.Message {
+	return new(fastReflection_StoreMultiStoreProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StoreMultiStoreProof) Interface() protoreflect.ProtoMessage {
+	return (*StoreMultiStoreProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StoreMultiStoreProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StoreKey != "" {
+		value := protoreflect.ValueOfString(x.StoreKey)
+		if !f(fd_StoreMultiStoreProof_store_key, value) {
+			return
+		}
+	}
+	if len(x.IavlStateHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.IavlStateHash)
+		if !f(fd_StoreMultiStoreProof_iavl_state_hash, value) {
+			return
+		}
+	}
+	if len(x.MerklePaths) != 0 {
+		value := protoreflect.ValueOfList(&_StoreMultiStoreProof_3_list{list: &x.MerklePaths})
+		if !f(fd_StoreMultiStoreProof_merkle_paths, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StoreMultiStoreProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.StoreMultiStoreProof.store_key":
+		return x.StoreKey != ""
+	case "band.base.oracle.v1.StoreMultiStoreProof.iavl_state_hash":
+		return len(x.IavlStateHash) != 0
+	case "band.base.oracle.v1.StoreMultiStoreProof.merkle_paths":
+		return len(x.MerklePaths) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.StoreMultiStoreProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.StoreMultiStoreProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreMultiStoreProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.StoreMultiStoreProof.store_key":
+		x.StoreKey = ""
+	case "band.base.oracle.v1.StoreMultiStoreProof.iavl_state_hash":
+		x.IavlStateHash = nil
+	case "band.base.oracle.v1.StoreMultiStoreProof.merkle_paths":
+		x.MerklePaths = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.StoreMultiStoreProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.StoreMultiStoreProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StoreMultiStoreProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.oracle.v1.StoreMultiStoreProof.store_key":
+		value := x.StoreKey
+		return protoreflect.ValueOfString(value)
+	case "band.base.oracle.v1.StoreMultiStoreProof.iavl_state_hash":
+		value := x.IavlStateHash
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.StoreMultiStoreProof.merkle_paths":
+		if len(x.MerklePaths) == 0 {
+			return protoreflect.ValueOfList(&_StoreMultiStoreProof_3_list{})
+		}
+		listValue := &_StoreMultiStoreProof_3_list{list: &x.MerklePaths}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.StoreMultiStoreProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.StoreMultiStoreProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreMultiStoreProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.StoreMultiStoreProof.store_key":
+		x.StoreKey = value.Interface().(string)
+	case "band.base.oracle.v1.StoreMultiStoreProof.iavl_state_hash":
+		x.IavlStateHash = value.Bytes()
+	case "band.base.oracle.v1.StoreMultiStoreProof.merkle_paths":
+		lv := value.List()
+		clv := lv.(*_StoreMultiStoreProof_3_list)
+		x.MerklePaths = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.StoreMultiStoreProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.StoreMultiStoreProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreMultiStoreProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.StoreMultiStoreProof.merkle_paths":
+		if x.MerklePaths == nil {
+			x.MerklePaths = []*MultiStoreMerklePath{}
+		}
+		value := &_StoreMultiStoreProof_3_list{list: &x.MerklePaths}
+		return protoreflect.ValueOfList(value)
+	case "band.base.oracle.v1.StoreMultiStoreProof.store_key":
+		panic(fmt.Errorf("field store_key of message band.base.oracle.v1.StoreMultiStoreProof is not mutable"))
+	case "band.base.oracle.v1.StoreMultiStoreProof.iavl_state_hash":
+		panic(fmt.Errorf("field iavl_state_hash of message band.base.oracle.v1.StoreMultiStoreProof is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.StoreMultiStoreProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.StoreMultiStoreProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StoreMultiStoreProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.StoreMultiStoreProof.store_key":
+		return protoreflect.ValueOfString("")
+	case "band.base.oracle.v1.StoreMultiStoreProof.iavl_state_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.StoreMultiStoreProof.merkle_paths":
+		list := []*MultiStoreMerklePath{}
+		return protoreflect.ValueOfList(&_StoreMultiStoreProof_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.StoreMultiStoreProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.StoreMultiStoreProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StoreMultiStoreProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.StoreMultiStoreProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StoreMultiStoreProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreMultiStoreProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StoreMultiStoreProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StoreMultiStoreProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StoreMultiStoreProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.StoreKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.IavlStateHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MerklePaths) > 0 {
+			for _, e := range x.MerklePaths {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StoreMultiStoreProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MerklePaths) > 0 {
+			for iNdEx := len(x.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MerklePaths[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.IavlStateHash) > 0 {
+			i -= len(x.IavlStateHash)
+			copy(dAtA[i:], x.IavlStateHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IavlStateHash)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.StoreKey) > 0 {
+			i -= len(x.StoreKey)
+			copy(dAtA[i:], x.StoreKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StoreKey)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StoreMultiStoreProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreMultiStoreProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreMultiStoreProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StoreKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IavlStateHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IavlStateHash = append(x.IavlStateHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.IavlStateHash == nil {
+					x.IavlStateHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MerklePaths = append(x.MerklePaths, &MultiStoreMerklePath{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MerklePaths[len(x.MerklePaths)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MultiStoreMerklePath                  protoreflect.MessageDescriptor
+	fd_MultiStoreMerklePath_is_data_on_right protoreflect.FieldDescriptor
+	fd_MultiStoreMerklePath_sibling_hash     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_MultiStoreMerklePath = File_band_base_oracle_v1_proof_proto.Messages().ByName("MultiStoreMerklePath")
+	fd_MultiStoreMerklePath_is_data_on_right = md_MultiStoreMerklePath.Fields().ByName("is_data_on_right")
+	fd_MultiStoreMerklePath_sibling_hash = md_MultiStoreMerklePath.Fields().ByName("sibling_hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_MultiStoreMerklePath)(nil)
+
+type fastReflection_MultiStoreMerklePath MultiStoreMerklePath
+
+func (x *MultiStoreMerklePath) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MultiStoreMerklePath)(x)
+}
+
+func (x *MultiStoreMerklePath) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MultiStoreMerklePath_messageType fastReflection_MultiStoreMerklePath_messageType
+var _ protoreflect.MessageType = fastReflection_MultiStoreMerklePath_messageType{}
+
+type fastReflection_MultiStoreMerklePath_messageType struct{}
+
+func (x fastReflection_MultiStoreMerklePath_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MultiStoreMerklePath)(nil)
+}
+func (x fastReflection_MultiStoreMerklePath_messageType) New() protoreflect.Message {
+	return new(fastReflection_MultiStoreMerklePath)
+}
+func (x fastReflection_MultiStoreMerklePath_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MultiStoreMerklePath
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MultiStoreMerklePath) Descriptor() protoreflect.MessageDescriptor {
+	return md_MultiStoreMerklePath
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MultiStoreMerklePath) Type() protoreflect.MessageType {
+	return _fastReflection_MultiStoreMerklePath_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MultiStoreMerklePath) New() protoreflect.Message {
+	return new(fastReflection_MultiStoreMerklePath)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MultiStoreMerklePath) Interface() protoreflect.ProtoMessage {
+	return (*MultiStoreMerklePath)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MultiStoreMerklePath) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.IsDataOnRight != false {
+		value := protoreflect.ValueOfBool(x.IsDataOnRight)
+		if !f(fd_MultiStoreMerklePath_is_data_on_right, value) {
+			return
+		}
+	}
+	if len(x.SiblingHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.SiblingHash)
+		if !f(fd_MultiStoreMerklePath_sibling_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MultiStoreMerklePath) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.MultiStoreMerklePath.is_data_on_right":
+		return x.IsDataOnRight != false
+	case "band.base.oracle.v1.MultiStoreMerklePath.sibling_hash":
+		return len(x.SiblingHash) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreMerklePath"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreMerklePath does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MultiStoreMerklePath) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.MultiStoreMerklePath.is_data_on_right":
+		x.IsDataOnRight = false
+	case "band.base.oracle.v1.MultiStoreMerklePath.sibling_hash":
+		x.SiblingHash = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreMerklePath"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreMerklePath does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MultiStoreMerklePath) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.oracle.v1.MultiStoreMerklePath.is_data_on_right":
+		value := x.IsDataOnRight
+		return protoreflect.ValueOfBool(value)
+	case "band.base.oracle.v1.MultiStoreMerklePath.sibling_hash":
+		value := x.SiblingHash
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreMerklePath"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreMerklePath does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MultiStoreMerklePath) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.MultiStoreMerklePath.is_data_on_right":
+		x.IsDataOnRight = value.Bool()
+	case "band.base.oracle.v1.MultiStoreMerklePath.sibling_hash":
+		x.SiblingHash = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreMerklePath"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreMerklePath does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MultiStoreMerklePath) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.MultiStoreMerklePath.is_data_on_right":
+		panic(fmt.Errorf("field is_data_on_right of message band.base.oracle.v1.MultiStoreMerklePath is not mutable"))
+	case "band.base.oracle.v1.MultiStoreMerklePath.sibling_hash":
+		panic(fmt.Errorf("field sibling_hash of message band.base.oracle.v1.MultiStoreMerklePath is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreMerklePath"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreMerklePath does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MultiStoreMerklePath) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.MultiStoreMerklePath.is_data_on_right":
+		return protoreflect.ValueOfBool(false)
+	case "band.base.oracle.v1.MultiStoreMerklePath.sibling_hash":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreMerklePath"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreMerklePath does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MultiStoreMerklePath) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.MultiStoreMerklePath", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MultiStoreMerklePath) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MultiStoreMerklePath) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MultiStoreMerklePath) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MultiStoreMerklePath) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MultiStoreMerklePath)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.IsDataOnRight {
+			n += 2
+		}
+		l = len(x.SiblingHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MultiStoreMerklePath)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.SiblingHash) > 0 {
+			i -= len(x.SiblingHash)
+			copy(dAtA[i:], x.SiblingHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SiblingHash)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.IsDataOnRight {
+			i--
+			if x.IsDataOnRight {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MultiStoreMerklePath)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiStoreMerklePath: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiStoreMerklePath: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsDataOnRight", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsDataOnRight = bool(v != 0)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SiblingHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SiblingHash = append(x.SiblingHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.SiblingHash == nil {
+					x.SiblingHash = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MultiStoreProof                                            protoreflect.MessageDescriptor
+	fd_MultiStoreProof_oracle_iavl_state_hash                     protoreflect.FieldDescriptor
+	fd_MultiStoreProof_mint_store_merkle_hash                     protoreflect.FieldDescriptor
+	fd_MultiStoreProof_params_to_restake_stores_merkle_hash       protoreflect.FieldDescriptor
+	fd_MultiStoreProof_rollingseed_to_transfer_stores_merkle_hash protoreflect.FieldDescriptor
+	fd_MultiStoreProof_tss_to_upgrade_stores_merkle_hash          protoreflect.FieldDescriptor
+	fd_MultiStoreProof_auth_to_icahost_stores_merkle_hash         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_MultiStoreProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("MultiStoreProof")
+	fd_MultiStoreProof_oracle_iavl_state_hash = md_MultiStoreProof.Fields().ByName("oracle_iavl_state_hash")
+	fd_MultiStoreProof_mint_store_merkle_hash = md_MultiStoreProof.Fields().ByName("mint_store_merkle_hash")
+	fd_MultiStoreProof_params_to_restake_stores_merkle_hash = md_MultiStoreProof.Fields().ByName("params_to_restake_stores_merkle_hash")
+	fd_MultiStoreProof_rollingseed_to_transfer_stores_merkle_hash = md_MultiStoreProof.Fields().ByName("rollingseed_to_transfer_stores_merkle_hash")
+	fd_MultiStoreProof_tss_to_upgrade_stores_merkle_hash = md_MultiStoreProof.Fields().ByName("tss_to_upgrade_stores_merkle_hash")
+	fd_MultiStoreProof_auth_to_icahost_stores_merkle_hash = md_MultiStoreProof.Fields().ByName("auth_to_icahost_stores_merkle_hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_MultiStoreProof)(nil)
+
+type fastReflection_MultiStoreProof MultiStoreProof
+
+func (x *MultiStoreProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MultiStoreProof)(x)
+}
+
+func (x *MultiStoreProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MultiStoreProof_messageType fastReflection_MultiStoreProof_messageType
+var _ protoreflect.MessageType = fastReflection_MultiStoreProof_messageType{}
+
+type fastReflection_MultiStoreProof_messageType struct{}
+
+func (x fastReflection_MultiStoreProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MultiStoreProof)(nil)
+}
+func (x fastReflection_MultiStoreProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_MultiStoreProof)
+}
+func (x fastReflection_MultiStoreProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MultiStoreProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MultiStoreProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_MultiStoreProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MultiStoreProof) Type() protoreflect.MessageType {
+	return _fastReflection_MultiStoreProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MultiStoreProof) New() protoreflect.Message {
+	return new(fastReflection_MultiStoreProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MultiStoreProof) Interface() protoreflect.ProtoMessage {
+	return (*MultiStoreProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MultiStoreProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.OracleIavlStateHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.OracleIavlStateHash)
+		if !f(fd_MultiStoreProof_oracle_iavl_state_hash, value) {
+			return
+		}
+	}
+	if len(x.MintStoreMerkleHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.MintStoreMerkleHash)
+		if !f(fd_MultiStoreProof_mint_store_merkle_hash, value) {
+			return
+		}
+	}
+	if len(x.ParamsToRestakeStoresMerkleHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.ParamsToRestakeStoresMerkleHash)
+		if !f(fd_MultiStoreProof_params_to_restake_stores_merkle_hash, value) {
+			return
+		}
+	}
+	if len(x.RollingseedToTransferStoresMerkleHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.RollingseedToTransferStoresMerkleHash)
+		if !f(fd_MultiStoreProof_rollingseed_to_transfer_stores_merkle_hash, value) {
+			return
+		}
+	}
+	if len(x.TssToUpgradeStoresMerkleHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.TssToUpgradeStoresMerkleHash)
+		if !f(fd_MultiStoreProof_tss_to_upgrade_stores_merkle_hash, value) {
+			return
+		}
+	}
+	if len(x.AuthToIcahostStoresMerkleHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.AuthToIcahostStoresMerkleHash)
+		if !f(fd_MultiStoreProof_auth_to_icahost_stores_merkle_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MultiStoreProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
+		return len(x.OracleIavlStateHash) != 0
+	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
+		return len(x.MintStoreMerkleHash) != 0
+	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
+		return len(x.ParamsToRestakeStoresMerkleHash) != 0
+	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
+		return len(x.RollingseedToTransferStoresMerkleHash) != 0
+	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
+		return len(x.TssToUpgradeStoresMerkleHash) != 0
+	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
+		return len(x.AuthToIcahostStoresMerkleHash) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MultiStoreProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
+		x.OracleIavlStateHash = nil
+	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
+		x.MintStoreMerkleHash = nil
+	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
+		x.ParamsToRestakeStoresMerkleHash = nil
+	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
+		x.RollingseedToTransferStoresMerkleHash = nil
+	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
+		x.TssToUpgradeStoresMerkleHash = nil
+	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
+		x.AuthToIcahostStoresMerkleHash = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MultiStoreProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
+		value := x.OracleIavlStateHash
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
+		value := x.MintStoreMerkleHash
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
+		value := x.ParamsToRestakeStoresMerkleHash
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
+		value := x.RollingseedToTransferStoresMerkleHash
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
+		value := x.TssToUpgradeStoresMerkleHash
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
+		value := x.AuthToIcahostStoresMerkleHash
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MultiStoreProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
+		x.OracleIavlStateHash = value.Bytes()
+	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
+		x.MintStoreMerkleHash = value.Bytes()
+	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
+		x.ParamsToRestakeStoresMerkleHash = value.Bytes()
+	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
+		x.RollingseedToTransferStoresMerkleHash = value.Bytes()
+	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
+		x.TssToUpgradeStoresMerkleHash = value.Bytes()
+	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
+		x.AuthToIcahostStoresMerkleHash = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MultiStoreProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
+		panic(fmt.Errorf("field oracle_iavl_state_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
+	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
+		panic(fmt.Errorf("field mint_store_merkle_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
+	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
+		panic(fmt.Errorf("field params_to_restake_stores_merkle_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
+	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
+		panic(fmt.Errorf("field rollingseed_to_transfer_stores_merkle_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
+	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
+		panic(fmt.Errorf("field tss_to_upgrade_stores_merkle_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
+	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
+		panic(fmt.Errorf("field auth_to_icahost_stores_merkle_hash of message band.base.oracle.v1.MultiStoreProof is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MultiStoreProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.MultiStoreProof.oracle_iavl_state_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.MultiStoreProof.mint_store_merkle_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.MultiStoreProof.params_to_restake_stores_merkle_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.MultiStoreProof.rollingseed_to_transfer_stores_merkle_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.MultiStoreProof.tss_to_upgrade_stores_merkle_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.MultiStoreProof.auth_to_icahost_stores_merkle_hash":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.MultiStoreProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.MultiStoreProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MultiStoreProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.MultiStoreProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MultiStoreProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MultiStoreProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MultiStoreProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MultiStoreProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MultiStoreProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.OracleIavlStateHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MintStoreMerkleHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ParamsToRestakeStoresMerkleHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RollingseedToTransferStoresMerkleHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TssToUpgradeStoresMerkleHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AuthToIcahostStoresMerkleHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MultiStoreProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AuthToIcahostStoresMerkleHash) > 0 {
+			i -= len(x.AuthToIcahostStoresMerkleHash)
+			copy(dAtA[i:], x.AuthToIcahostStoresMerkleHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuthToIcahostStoresMerkleHash)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.TssToUpgradeStoresMerkleHash) > 0 {
+			i -= len(x.TssToUpgradeStoresMerkleHash)
+			copy(dAtA[i:], x.TssToUpgradeStoresMerkleHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TssToUpgradeStoresMerkleHash)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.RollingseedToTransferStoresMerkleHash) > 0 {
+			i -= len(x.RollingseedToTransferStoresMerkleHash)
+			copy(dAtA[i:], x.RollingseedToTransferStoresMerkleHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RollingseedToTransferStoresMerkleHash)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ParamsToRestakeStoresMerkleHash) > 0 {
+			i -= len(x.ParamsToRestakeStoresMerkleHash)
+			copy(dAtA[i:], x.ParamsToRestakeStoresMerkleHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ParamsToRestakeStoresMerkleHash)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MintStoreMerkleHash) > 0 {
+			i -= len(x.MintStoreMerkleHash)
+			copy(dAtA[i:], x.MintStoreMerkleHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintStoreMerkleHash)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.OracleIavlStateHash) > 0 {
+			i -= len(x.OracleIavlStateHash)
+			copy(dAtA[i:], x.OracleIavlStateHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OracleIavlStateHash)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MultiStoreProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiStoreProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultiStoreProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleIavlStateHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OracleIavlStateHash = append(x.OracleIavlStateHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.OracleIavlStateHash == nil {
+					x.OracleIavlStateHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintStoreMerkleHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintStoreMerkleHash = append(x.MintStoreMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.MintStoreMerkleHash == nil {
+					x.MintStoreMerkleHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParamsToRestakeStoresMerkleHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ParamsToRestakeStoresMerkleHash = append(x.ParamsToRestakeStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.ParamsToRestakeStoresMerkleHash == nil {
+					x.ParamsToRestakeStoresMerkleHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RollingseedToTransferStoresMerkleHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RollingseedToTransferStoresMerkleHash = append(x.RollingseedToTransferStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.RollingseedToTransferStoresMerkleHash == nil {
+					x.RollingseedToTransferStoresMerkleHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssToUpgradeStoresMerkleHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TssToUpgradeStoresMerkleHash = append(x.TssToUpgradeStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.TssToUpgradeStoresMerkleHash == nil {
+					x.TssToUpgradeStoresMerkleHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuthToIcahostStoresMerkleHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AuthToIcahostStoresMerkleHash = append(x.AuthToIcahostStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.AuthToIcahostStoresMerkleHash == nil {
+					x.AuthToIcahostStoresMerkleHash = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BlockHeaderMerkleParts                                        protoreflect.MessageDescriptor
+	fd_BlockHeaderMerkleParts_version_and_chain_id_hash              protoreflect.FieldDescriptor
+	fd_BlockHeaderMerkleParts_height                                 protoreflect.FieldDescriptor
+	fd_BlockHeaderMerkleParts_time_second                            protoreflect.FieldDescriptor
+	fd_BlockHeaderMerkleParts_time_nano_second                       protoreflect.FieldDescriptor
+	fd_BlockHeaderMerkleParts_last_block_id_and_other                protoreflect.FieldDescriptor
+	fd_BlockHeaderMerkleParts_next_validator_hash_and_consensus_hash protoreflect.FieldDescriptor
+	fd_BlockHeaderMerkleParts_last_results_hash                      protoreflect.FieldDescriptor
+	fd_BlockHeaderMerkleParts_evidence_and_proposer_hash             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_BlockHeaderMerkleParts = File_band_base_oracle_v1_proof_proto.Messages().ByName("BlockHeaderMerkleParts")
+	fd_BlockHeaderMerkleParts_version_and_chain_id_hash = md_BlockHeaderMerkleParts.Fields().ByName("version_and_chain_id_hash")
+	fd_BlockHeaderMerkleParts_height = md_BlockHeaderMerkleParts.Fields().ByName("height")
+	fd_BlockHeaderMerkleParts_time_second = md_BlockHeaderMerkleParts.Fields().ByName("time_second")
+	fd_BlockHeaderMerkleParts_time_nano_second = md_BlockHeaderMerkleParts.Fields().ByName("time_nano_second")
+	fd_BlockHeaderMerkleParts_last_block_id_and_other = md_BlockHeaderMerkleParts.Fields().ByName("last_block_id_and_other")
+	fd_BlockHeaderMerkleParts_next_validator_hash_and_consensus_hash = md_BlockHeaderMerkleParts.Fields().ByName("next_validator_hash_and_consensus_hash")
+	fd_BlockHeaderMerkleParts_last_results_hash = md_BlockHeaderMerkleParts.Fields().ByName("last_results_hash")
+	fd_BlockHeaderMerkleParts_evidence_and_proposer_hash = md_BlockHeaderMerkleParts.Fields().ByName("evidence_and_proposer_hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_BlockHeaderMerkleParts)(nil)
+
+type fastReflection_BlockHeaderMerkleParts BlockHeaderMerkleParts
+
+func (x *BlockHeaderMerkleParts) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BlockHeaderMerkleParts)(x)
+}
+
+func (x *BlockHeaderMerkleParts) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BlockHeaderMerkleParts_messageType fastReflection_BlockHeaderMerkleParts_messageType
+var _ protoreflect.MessageType = fastReflection_BlockHeaderMerkleParts_messageType{}
+
+type fastReflection_BlockHeaderMerkleParts_messageType struct{}
+
+func (x fastReflection_BlockHeaderMerkleParts_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BlockHeaderMerkleParts)(nil)
+}
+func (x fastReflection_BlockHeaderMerkleParts_messageType) New() protoreflect.Message {
+	return new(fastReflection_BlockHeaderMerkleParts)
+}
+func (x fastReflection_BlockHeaderMerkleParts_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BlockHeaderMerkleParts
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BlockHeaderMerkleParts) Descriptor() protoreflect.MessageDescriptor {
+	return md_BlockHeaderMerkleParts
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BlockHeaderMerkleParts) Type() protoreflect.MessageType {
+	return _fastReflection_BlockHeaderMerkleParts_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BlockHeaderMerkleParts) New() protoreflect.Message {
+	return new(fastReflection_BlockHeaderMerkleParts)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BlockHeaderMerkleParts) Interface() protoreflect.ProtoMessage {
+	return (*BlockHeaderMerkleParts)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BlockHeaderMerkleParts) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.VersionAndChainIdHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.VersionAndChainIdHash)
+		if !f(fd_BlockHeaderMerkleParts_version_and_chain_id_hash, value) {
+			return
+		}
+	}
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_BlockHeaderMerkleParts_height, value) {
+			return
+		}
+	}
+	if x.TimeSecond != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimeSecond)
+		if !f(fd_BlockHeaderMerkleParts_time_second, value) {
+			return
+		}
+	}
+	if x.TimeNanoSecond != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TimeNanoSecond)
+		if !f(fd_BlockHeaderMerkleParts_time_nano_second, value) {
+			return
+		}
+	}
+	if len(x.LastBlockIdAndOther) != 0 {
+		value := protoreflect.ValueOfBytes(x.LastBlockIdAndOther)
+		if !f(fd_BlockHeaderMerkleParts_last_block_id_and_other, value) {
+			return
+		}
+	}
+	if len(x.NextValidatorHashAndConsensusHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.NextValidatorHashAndConsensusHash)
+		if !f(fd_BlockHeaderMerkleParts_next_validator_hash_and_consensus_hash, value) {
+			return
+		}
+	}
+	if len(x.LastResultsHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.LastResultsHash)
+		if !f(fd_BlockHeaderMerkleParts_last_results_hash, value) {
+			return
+		}
+	}
+	if len(x.EvidenceAndProposerHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.EvidenceAndProposerHash)
+		if !f(fd_BlockHeaderMerkleParts_evidence_and_proposer_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BlockHeaderMerkleParts) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.version_and_chain_id_hash":
+		return len(x.VersionAndChainIdHash) != 0
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.height":
+		return x.Height != uint64(0)
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_second":
+		return x.TimeSecond != uint64(0)
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_nano_second":
+		return x.TimeNanoSecond != uint32(0)
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_block_id_and_other":
+		return len(x.LastBlockIdAndOther) != 0
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.next_validator_hash_and_consensus_hash":
+		return len(x.NextValidatorHashAndConsensusHash) != 0
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_results_hash":
+		return len(x.LastResultsHash) != 0
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.evidence_and_proposer_hash":
+		return len(x.EvidenceAndProposerHash) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockHeaderMerkleParts"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.BlockHeaderMerkleParts does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockHeaderMerkleParts) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.version_and_chain_id_hash":
+		x.VersionAndChainIdHash = nil
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.height":
+		x.Height = uint64(0)
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_second":
+		x.TimeSecond = uint64(0)
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_nano_second":
+		x.TimeNanoSecond = uint32(0)
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_block_id_and_other":
+		x.LastBlockIdAndOther = nil
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.next_validator_hash_and_consensus_hash":
+		x.NextValidatorHashAndConsensusHash = nil
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_results_hash":
+		x.LastResultsHash = nil
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.evidence_and_proposer_hash":
+		x.EvidenceAndProposerHash = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockHeaderMerkleParts"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.BlockHeaderMerkleParts does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BlockHeaderMerkleParts) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.version_and_chain_id_hash":
+		value := x.VersionAndChainIdHash
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_second":
+		value := x.TimeSecond
+		return protoreflect.ValueOfUint64(value)
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_nano_second":
+		value := x.TimeNanoSecond
+		return protoreflect.ValueOfUint32(value)
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_block_id_and_other":
+		value := x.LastBlockIdAndOther
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.next_validator_hash_and_consensus_hash":
+		value := x.NextValidatorHashAndConsensusHash
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_results_hash":
+		value := x.LastResultsHash
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.evidence_and_proposer_hash":
+		value := x.EvidenceAndProposerHash
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockHeaderMerkleParts"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.BlockHeaderMerkleParts does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockHeaderMerkleParts) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.version_and_chain_id_hash":
+		x.VersionAndChainIdHash = value.Bytes()
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.height":
+		x.Height = value.Uint()
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_second":
+		x.TimeSecond = value.Uint()
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_nano_second":
+		x.TimeNanoSecond = uint32(value.Uint())
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_block_id_and_other":
+		x.LastBlockIdAndOther = value.Bytes()
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.next_validator_hash_and_consensus_hash":
+		x.NextValidatorHashAndConsensusHash = value.Bytes()
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_results_hash":
+		x.LastResultsHash = value.Bytes()
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.evidence_and_proposer_hash":
+		x.EvidenceAndProposerHash = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockHeaderMerkleParts"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.BlockHeaderMerkleParts does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockHeaderMerkleParts) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.version_and_chain_id_hash":
+		panic(fmt.Errorf("field version_and_chain_id_hash of message band.base.oracle.v1.BlockHeaderMerkleParts is not mutable"))
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.height":
+		panic(fmt.Errorf("field height of message band.base.oracle.v1.BlockHeaderMerkleParts is not mutable"))
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_second":
+		panic(fmt.Errorf("field time_second of message band.base.oracle.v1.BlockHeaderMerkleParts is not mutable"))
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_nano_second":
+		panic(fmt.Errorf("field time_nano_second of message band.base.oracle.v1.BlockHeaderMerkleParts is not mutable"))
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_block_id_and_other":
+		panic(fmt.Errorf("field last_block_id_and_other of message band.base.oracle.v1.BlockHeaderMerkleParts is not mutable"))
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.next_validator_hash_and_consensus_hash":
+		panic(fmt.Errorf("field next_validator_hash_and_consensus_hash of message band.base.oracle.v1.BlockHeaderMerkleParts is not mutable"))
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_results_hash":
+		panic(fmt.Errorf("field last_results_hash of message band.base.oracle.v1.BlockHeaderMerkleParts is not mutable"))
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.evidence_and_proposer_hash":
+		panic(fmt.Errorf("field evidence_and_proposer_hash of message band.base.oracle.v1.BlockHeaderMerkleParts is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockHeaderMerkleParts"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.BlockHeaderMerkleParts does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BlockHeaderMerkleParts) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.version_and_chain_id_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_second":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.time_nano_second":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_block_id_and_other":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.next_validator_hash_and_consensus_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.last_results_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.BlockHeaderMerkleParts.evidence_and_proposer_hash":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.BlockHeaderMerkleParts"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.BlockHeaderMerkleParts does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BlockHeaderMerkleParts) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.BlockHeaderMerkleParts", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BlockHeaderMerkleParts) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockHeaderMerkleParts) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BlockHeaderMerkleParts) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BlockHeaderMerkleParts) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BlockHeaderMerkleParts)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.VersionAndChainIdHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.TimeSecond != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeSecond))
+		}
+		if x.TimeNanoSecond != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeNanoSecond))
+		}
+		l = len(x.LastBlockIdAndOther)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NextValidatorHashAndConsensusHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LastResultsHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EvidenceAndProposerHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BlockHeaderMerkleParts)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EvidenceAndProposerHash) > 0 {
+			i -= len(x.EvidenceAndProposerHash)
+			copy(dAtA[i:], x.EvidenceAndProposerHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvidenceAndProposerHash)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.LastResultsHash) > 0 {
+			i -= len(x.LastResultsHash)
+			copy(dAtA[i:], x.LastResultsHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LastResultsHash)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.NextValidatorHashAndConsensusHash) > 0 {
+			i -= len(x.NextValidatorHashAndConsensusHash)
+			copy(dAtA[i:], x.NextValidatorHashAndConsensusHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NextValidatorHashAndConsensusHash)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.LastBlockIdAndOther) > 0 {
+			i -= len(x.LastBlockIdAndOther)
+			copy(dAtA[i:], x.LastBlockIdAndOther)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LastBlockIdAndOther)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.TimeNanoSecond != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeNanoSecond))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.TimeSecond != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeSecond))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.VersionAndChainIdHash) > 0 {
+			i -= len(x.VersionAndChainIdHash)
+			copy(dAtA[i:], x.VersionAndChainIdHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VersionAndChainIdHash)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BlockHeaderMerkleParts)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockHeaderMerkleParts: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockHeaderMerkleParts: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VersionAndChainIdHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VersionAndChainIdHash = append(x.VersionAndChainIdHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.VersionAndChainIdHash == nil {
+					x.VersionAndChainIdHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeSecond", wireType)
+				}
+				x.TimeSecond = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeSecond |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeNanoSecond", wireType)
+				}
+				x.TimeNanoSecond = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeNanoSecond |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastBlockIdAndOther", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LastBlockIdAndOther = append(x.LastBlockIdAndOther[:0], dAtA[iNdEx:postIndex]...)
+				if x.LastBlockIdAndOther == nil {
+					x.LastBlockIdAndOther = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextValidatorHashAndConsensusHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NextValidatorHashAndConsensusHash = append(x.NextValidatorHashAndConsensusHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.NextValidatorHashAndConsensusHash == nil {
+					x.NextValidatorHashAndConsensusHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastResultsHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LastResultsHash = append(x.LastResultsHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.LastResultsHash == nil {
+					x.LastResultsHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvidenceAndProposerHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EvidenceAndProposerHash = append(x.EvidenceAndProposerHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.EvidenceAndProposerHash == nil {
+					x.EvidenceAndProposerHash = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CommonEncodedVotePart                    protoreflect.MessageDescriptor
+	fd_CommonEncodedVotePart_signed_data_prefix protoreflect.FieldDescriptor
+	fd_CommonEncodedVotePart_signed_data_suffix protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_CommonEncodedVotePart = File_band_base_oracle_v1_proof_proto.Messages().ByName("CommonEncodedVotePart")
+	fd_CommonEncodedVotePart_signed_data_prefix = md_CommonEncodedVotePart.Fields().ByName("signed_data_prefix")
+	fd_CommonEncodedVotePart_signed_data_suffix = md_CommonEncodedVotePart.Fields().ByName("signed_data_suffix")
+}
+
+var _ protoreflect.Message = (*fastReflection_CommonEncodedVotePart)(nil)
+
+type fastReflection_CommonEncodedVotePart CommonEncodedVotePart
+
+func (x *CommonEncodedVotePart) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CommonEncodedVotePart)(x)
+}
+
+func (x *CommonEncodedVotePart) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CommonEncodedVotePart_messageType fastReflection_CommonEncodedVotePart_messageType
+var _ protoreflect.MessageType = fastReflection_CommonEncodedVotePart_messageType{}
+
+type fastReflection_CommonEncodedVotePart_messageType struct{}
+
+func (x fastReflection_CommonEncodedVotePart_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CommonEncodedVotePart)(nil)
+}
+func (x fastReflection_CommonEncodedVotePart_messageType) New() protoreflect.Message {
+	return new(fastReflection_CommonEncodedVotePart)
+}
+func (x fastReflection_CommonEncodedVotePart_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CommonEncodedVotePart
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CommonEncodedVotePart) Descriptor() protoreflect.MessageDescriptor {
+	return md_CommonEncodedVotePart
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CommonEncodedVotePart) Type() protoreflect.MessageType {
+	return _fastReflection_CommonEncodedVotePart_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CommonEncodedVotePart) New() protoreflect.Message {
+	return new(fastReflection_CommonEncodedVotePart)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CommonEncodedVotePart) Interface() protoreflect.ProtoMessage {
+	return (*CommonEncodedVotePart)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CommonEncodedVotePart) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.SignedDataPrefix) != 0 {
+		value := protoreflect.ValueOfBytes(x.SignedDataPrefix)
+		if !f(fd_CommonEncodedVotePart_signed_data_prefix, value) {
+			return
+		}
+	}
+	if len(x.SignedDataSuffix) != 0 {
+		value := protoreflect.ValueOfBytes(x.SignedDataSuffix)
+		if !f(fd_CommonEncodedVotePart_signed_data_suffix, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CommonEncodedVotePart) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_prefix":
+		return len(x.SignedDataPrefix) != 0
+	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_suffix":
+		return len(x.SignedDataSuffix) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CommonEncodedVotePart"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.CommonEncodedVotePart does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CommonEncodedVotePart) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_prefix":
+		x.SignedDataPrefix = nil
+	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_suffix":
+		x.SignedDataSuffix = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CommonEncodedVotePart"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.CommonEncodedVotePart does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CommonEncodedVotePart) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_prefix":
+		value := x.SignedDataPrefix
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_suffix":
+		value := x.SignedDataSuffix
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CommonEncodedVotePart"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.CommonEncodedVotePart does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CommonEncodedVotePart) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_prefix":
+		x.SignedDataPrefix = value.Bytes()
+	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_suffix":
+		x.SignedDataSuffix = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CommonEncodedVotePart"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.CommonEncodedVotePart does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CommonEncodedVotePart) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_prefix":
+		panic(fmt.Errorf("field signed_data_prefix of message band.base.oracle.v1.CommonEncodedVotePart is not mutable"))
+	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_suffix":
+		panic(fmt.Errorf("field signed_data_suffix of message band.base.oracle.v1.CommonEncodedVotePart is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CommonEncodedVotePart"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.CommonEncodedVotePart does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CommonEncodedVotePart) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_prefix":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.CommonEncodedVotePart.signed_data_suffix":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.CommonEncodedVotePart"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.CommonEncodedVotePart does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CommonEncodedVotePart) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.CommonEncodedVotePart", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CommonEncodedVotePart) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CommonEncodedVotePart) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CommonEncodedVotePart) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CommonEncodedVotePart) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CommonEncodedVotePart)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SignedDataPrefix)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SignedDataSuffix)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CommonEncodedVotePart)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.SignedDataSuffix) > 0 {
+			i -= len(x.SignedDataSuffix)
+			copy(dAtA[i:], x.SignedDataSuffix)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignedDataSuffix)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.SignedDataPrefix) > 0 {
+			i -= len(x.SignedDataPrefix)
+			copy(dAtA[i:], x.SignedDataPrefix)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignedDataPrefix)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CommonEncodedVotePart)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CommonEncodedVotePart: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CommonEncodedVotePart: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignedDataPrefix", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignedDataPrefix = append(x.SignedDataPrefix[:0], dAtA[iNdEx:postIndex]...)
+				if x.SignedDataPrefix == nil {
+					x.SignedDataPrefix = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignedDataSuffix", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignedDataSuffix = append(x.SignedDataSuffix[:0], dAtA[iNdEx:postIndex]...)
+				if x.SignedDataSuffix == nil {
+					x.SignedDataSuffix = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TMSignature                   protoreflect.MessageDescriptor
+	fd_TMSignature_r                 protoreflect.FieldDescriptor
+	fd_TMSignature_s                 protoreflect.FieldDescriptor
+	fd_TMSignature_v                 protoreflect.FieldDescriptor
+	fd_TMSignature_encoded_timestamp protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_TMSignature = File_band_base_oracle_v1_proof_proto.Messages().ByName("TMSignature")
+	fd_TMSignature_r = md_TMSignature.Fields().ByName("r")
+	fd_TMSignature_s = md_TMSignature.Fields().ByName("s")
+	fd_TMSignature_v = md_TMSignature.Fields().ByName("v")
+	fd_TMSignature_encoded_timestamp = md_TMSignature.Fields().ByName("encoded_timestamp")
+}
+
+var _ protoreflect.Message = (*fastReflection_TMSignature)(nil)
+
+type fastReflection_TMSignature TMSignature
+
+func (x *TMSignature) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TMSignature)(x)
+}
+
+func (x *TMSignature) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TMSignature_messageType fastReflection_TMSignature_messageType
+var _ protoreflect.MessageType = fastReflection_TMSignature_messageType{}
+
+type fastReflection_TMSignature_messageType struct{}
+
+func (x fastReflection_TMSignature_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TMSignature)(nil)
+}
+func (x fastReflection_TMSignature_messageType) New() protoreflect.Message {
+	return new(fastReflection_TMSignature)
+}
+func (x fastReflection_TMSignature_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TMSignature
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TMSignature) Descriptor() protoreflect.MessageDescriptor {
+	return md_TMSignature
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TMSignature) Type() protoreflect.MessageType {
+	return _fastReflection_TMSignature_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TMSignature) New() protoreflect.Message {
+	return new(fastReflection_TMSignature)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TMSignature) Interface() protoreflect.ProtoMessage {
+	return (*TMSignature)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TMSignature) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.R) != 0 {
+		value := protoreflect.ValueOfBytes(x.R)
+		if !f(fd_TMSignature_r, value) {
+			return
+		}
+	}
+	if len(x.S) != 0 {
+		value := protoreflect.ValueOfBytes(x.S)
+		if !f(fd_TMSignature_s, value) {
+			return
+		}
+	}
+	if x.V != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.V)
+		if !f(fd_TMSignature_v, value) {
+			return
+		}
+	}
+	if len(x.EncodedTimestamp) != 0 {
+		value := protoreflect.ValueOfBytes(x.EncodedTimestamp)
+		if !f(fd_TMSignature_encoded_timestamp, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TMSignature) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.TMSignature.r":
+		return len(x.R) != 0
+	case "band.base.oracle.v1.TMSignature.s":
+		return len(x.S) != 0
+	case "band.base.oracle.v1.TMSignature.v":
+		return x.V != uint32(0)
+	case "band.base.oracle.v1.TMSignature.encoded_timestamp":
+		return len(x.EncodedTimestamp) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TMSignature"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.TMSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TMSignature) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.TMSignature.r":
+		x.R = nil
+	case "band.base.oracle.v1.TMSignature.s":
+		x.S = nil
+	case "band.base.oracle.v1.TMSignature.v":
+		x.V = uint32(0)
+	case "band.base.oracle.v1.TMSignature.encoded_timestamp":
+		x.EncodedTimestamp = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TMSignature"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.TMSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TMSignature) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.oracle.v1.TMSignature.r":
+		value := x.R
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.TMSignature.s":
+		value := x.S
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.oracle.v1.TMSignature.v":
+		value := x.V
+		return protoreflect.ValueOfUint32(value)
+	case "band.base.oracle.v1.TMSignature.encoded_timestamp":
+		value := x.EncodedTimestamp
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TMSignature"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.TMSignature does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TMSignature) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.TMSignature.r":
+		x.R = value.Bytes()
+	case "band.base.oracle.v1.TMSignature.s":
+		x.S = value.Bytes()
+	case "band.base.oracle.v1.TMSignature.v":
+		x.V = uint32(value.Uint())
+	case "band.base.oracle.v1.TMSignature.encoded_timestamp":
+		x.EncodedTimestamp = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TMSignature"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.TMSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TMSignature) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.TMSignature.r":
+		panic(fmt.Errorf("field r of message band.base.oracle.v1.TMSignature is not mutable"))
+	case "band.base.oracle.v1.TMSignature.s":
+		panic(fmt.Errorf("field s of message band.base.oracle.v1.TMSignature is not mutable"))
+	case "band.base.oracle.v1.TMSignature.v":
+		panic(fmt.Errorf("field v of message band.base.oracle.v1.TMSignature is not mutable"))
+	case "band.base.oracle.v1.TMSignature.encoded_timestamp":
+		panic(fmt.Errorf("field encoded_timestamp of message band.base.oracle.v1.TMSignature is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TMSignature"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.TMSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TMSignature) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.TMSignature.r":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.TMSignature.s":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.oracle.v1.TMSignature.v":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "band.base.oracle.v1.TMSignature.encoded_timestamp":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.TMSignature"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.TMSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TMSignature) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.TMSignature", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TMSignature) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TMSignature) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TMSignature) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TMSignature) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TMSignature)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.R)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.S)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.V != 0 {
+			n += 1 + runtime.Sov(uint64(x.V))
+		}
+		l = len(x.EncodedTimestamp)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TMSignature)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EncodedTimestamp) > 0 {
+			i -= len(x.EncodedTimestamp)
+			copy(dAtA[i:], x.EncodedTimestamp)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EncodedTimestamp)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.V != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.V))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.S) > 0 {
+			i -= len(x.S)
+			copy(dAtA[i:], x.S)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.S)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.R) > 0 {
+			i -= len(x.R)
+			copy(dAtA[i:], x.R)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.R)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TMSignature)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TMSignature: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TMSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.R = append(x.R[:0], dAtA[iNdEx:postIndex]...)
+				if x.R == nil {
+					x.R = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.S = append(x.S[:0], dAtA[iNdEx:postIndex]...)
+				if x.S == nil {
+					x.S = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+				}
+				x.V = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.V |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EncodedTimestamp", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EncodedTimestamp = append(x.EncodedTimestamp[:0], dAtA[iNdEx:postIndex]...)
+				if x.EncodedTimestamp == nil {
+					x.EncodedTimestamp = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_RequestsCountProof_3_list)(nil)
+
+type _RequestsCountProof_3_list struct {
+	list *[]*IAVLMerklePath
+}
+
+func (x *_RequestsCountProof_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RequestsCountProof_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_RequestsCountProof_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IAVLMerklePath)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RequestsCountProof_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IAVLMerklePath)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RequestsCountProof_3_list) AppendMutable() protoreflect.Value {
+	v := new(IAVLMerklePath)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RequestsCountProof_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RequestsCountProof_3_list) NewElement() protoreflect.Value {
+	v := new(IAVLMerklePath)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RequestsCountProof_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_RequestsCountProof              protoreflect.MessageDescriptor
+	fd_RequestsCountProof_count        protoreflect.FieldDescriptor
+	fd_RequestsCountProof_version      protoreflect.FieldDescriptor
+	fd_RequestsCountProof_merkle_paths protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_oracle_v1_proof_proto_init()
+	md_RequestsCountProof = File_band_base_oracle_v1_proof_proto.Messages().ByName("RequestsCountProof")
+	fd_RequestsCountProof_count = md_RequestsCountProof.Fields().ByName("count")
+	fd_RequestsCountProof_version = md_RequestsCountProof.Fields().ByName("version")
+	fd_RequestsCountProof_merkle_paths = md_RequestsCountProof.Fields().ByName("merkle_paths")
+}
+
+var _ protoreflect.Message = (*fastReflection_RequestsCountProof)(nil)
+
+type fastReflection_RequestsCountProof RequestsCountProof
+
+func (x *RequestsCountProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RequestsCountProof)(x)
+}
+
+func (x *RequestsCountProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_oracle_v1_proof_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RequestsCountProof_messageType fastReflection_RequestsCountProof_messageType
+var _ protoreflect.MessageType = fastReflection_RequestsCountProof_messageType{}
+
+type fastReflection_RequestsCountProof_messageType struct{}
+
+func (x fastReflection_RequestsCountProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RequestsCountProof)(nil)
+}
+func (x fastReflection_RequestsCountProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_RequestsCountProof)
+}
+func (x fastReflection_RequestsCountProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RequestsCountProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RequestsCountProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_RequestsCountProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RequestsCountProof) Type() protoreflect.MessageType {
+	return _fastReflection_RequestsCountProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RequestsCountProof) New() protoreflect.Message {
+	return new(fastReflection_RequestsCountProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RequestsCountProof) Interface() protoreflect.ProtoMessage {
+	return (*RequestsCountProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RequestsCountProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Count != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Count)
+		if !f(fd_RequestsCountProof_count, value) {
+			return
+		}
+	}
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_RequestsCountProof_version, value) {
+			return
+		}
+	}
+	if len(x.MerklePaths) != 0 {
+		value := protoreflect.ValueOfList(&_RequestsCountProof_3_list{list: &x.MerklePaths})
+		if !f(fd_RequestsCountProof_merkle_paths, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RequestsCountProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.RequestsCountProof.count":
+		return x.Count != uint64(0)
+	case "band.base.oracle.v1.RequestsCountProof.version":
+		return x.Version != uint64(0)
+	case "band.base.oracle.v1.RequestsCountProof.merkle_paths":
+		return len(x.MerklePaths) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.RequestsCountProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.RequestsCountProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestsCountProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.RequestsCountProof.count":
+		x.Count = uint64(0)
+	case "band.base.oracle.v1.RequestsCountProof.version":
+		x.Version = uint64(0)
+	case "band.base.oracle.v1.RequestsCountProof.merkle_paths":
+		x.MerklePaths = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.RequestsCountProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.RequestsCountProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RequestsCountProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.oracle.v1.RequestsCountProof.count":
+		value := x.Count
+		return protoreflect.ValueOfUint64(value)
+	case "band.base.oracle.v1.RequestsCountProof.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
+	case "band.base.oracle.v1.RequestsCountProof.merkle_paths":
+		if len(x.MerklePaths) == 0 {
+			return protoreflect.ValueOfList(&_RequestsCountProof_3_list{})
+		}
+		listValue := &_RequestsCountProof_3_list{list: &x.MerklePaths}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.RequestsCountProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.RequestsCountProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestsCountProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.RequestsCountProof.count":
+		x.Count = value.Uint()
+	case "band.base.oracle.v1.RequestsCountProof.version":
+		x.Version = value.Uint()
+	case "band.base.oracle.v1.RequestsCountProof.merkle_paths":
+		lv := value.List()
+		clv := lv.(*_RequestsCountProof_3_list)
+		x.MerklePaths = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.RequestsCountProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.RequestsCountProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestsCountProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.RequestsCountProof.merkle_paths":
+		if x.MerklePaths == nil {
+			x.MerklePaths = []*IAVLMerklePath{}
+		}
+		value := &_RequestsCountProof_3_list{list: &x.MerklePaths}
+		return protoreflect.ValueOfList(value)
+	case "band.base.oracle.v1.RequestsCountProof.count":
+		panic(fmt.Errorf("field count of message band.base.oracle.v1.RequestsCountProof is not mutable"))
+	case "band.base.oracle.v1.RequestsCountProof.version":
+		panic(fmt.Errorf("field version of message band.base.oracle.v1.RequestsCountProof is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.RequestsCountProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.RequestsCountProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RequestsCountProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.oracle.v1.RequestsCountProof.count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.base.oracle.v1.RequestsCountProof.version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.base.oracle.v1.RequestsCountProof.merkle_paths":
+		list := []*IAVLMerklePath{}
+		return protoreflect.ValueOfList(&_RequestsCountProof_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.oracle.v1.RequestsCountProof"))
+		}
+		panic(fmt.Errorf("message band.base.oracle.v1.RequestsCountProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RequestsCountProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.oracle.v1.RequestsCountProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RequestsCountProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestsCountProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RequestsCountProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RequestsCountProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RequestsCountProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Count != 0 {
+			n += 1 + runtime.Sov(uint64(x.Count))
+		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		if len(x.MerklePaths) > 0 {
+			for _, e := range x.MerklePaths {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RequestsCountProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MerklePaths) > 0 {
+			for iNdEx := len(x.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MerklePaths[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Count != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RequestsCountProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestsCountProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestsCountProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				}
+				x.Count = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Count |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MerklePaths = append(x.MerklePaths, &IAVLMerklePath{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MerklePaths[len(x.MerklePaths)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: band/base/oracle/v1/proof.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ProofRequest is request type for the Service/Proof RPC method.
+type ProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RequestID is ID of an oracle request
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// height is block height
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *ProofRequest) Reset() {
+	*x = ProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofRequest) ProtoMessage() {}
+
+// Deprecated: Use ProofRequest.ProtoReflect.Descriptor instead.
+func (*ProofRequest) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProofRequest) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *ProofRequest) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// ProofResponse is response type for the Service/Proof RPC method.
+type ProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// height is block height
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// result is the proof
+	Result *SingleProofResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ProofResponse) Reset() {
+	*x = ProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofResponse) ProtoMessage() {}
+
+// Deprecated: Use ProofResponse.ProtoReflect.Descriptor instead.
+func (*ProofResponse) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProofResponse) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ProofResponse) GetResult() *SingleProofResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// MultiProofRequest is request type for the Service/MultiProof RPC method.
+type MultiProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request_ids is the list of request IDs
+	RequestIds []uint64 `protobuf:"varint,1,rep,packed,name=request_ids,json=requestIds,proto3" json:"request_ids,omitempty"`
+}
+
+func (x *MultiProofRequest) Reset() {
+	*x = MultiProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiProofRequest) ProtoMessage() {}
+
+// Deprecated: Use MultiProofRequest.ProtoReflect.Descriptor instead.
+func (*MultiProofRequest) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MultiProofRequest) GetRequestIds() []uint64 {
+	if x != nil {
+		return x.RequestIds
+	}
+	return nil
+}
+
+// MultiProofResponse is response type for the Service/MultiProof RPC method.
+type MultiProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64             `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Result *MultiProofResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *MultiProofResponse) Reset() {
+	*x = MultiProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiProofResponse) ProtoMessage() {}
+
+// Deprecated: Use MultiProofResponse.ProtoReflect.Descriptor instead.
+func (*MultiProofResponse) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MultiProofResponse) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *MultiProofResponse) GetResult() *MultiProofResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// RequestCountProofRequest is request type for the Service/RequestCountProof RPC method.
+type RequestCountProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RequestCountProofRequest) Reset() {
+	*x = RequestCountProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestCountProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestCountProofRequest) ProtoMessage() {}
+
+// Deprecated: Use RequestCountProofRequest.ProtoReflect.Descriptor instead.
+func (*RequestCountProofRequest) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{4}
+}
+
+// RequestCountProofResponse is response type for the Service/RequestCountProof RPC method.
+type RequestCountProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64             `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Result *CountProofResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *RequestCountProofResponse) Reset() {
+	*x = RequestCountProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestCountProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestCountProofResponse) ProtoMessage() {}
+
+// Deprecated: Use RequestCountProofResponse.ProtoReflect.Descriptor instead.
+func (*RequestCountProofResponse) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RequestCountProofResponse) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *RequestCountProofResponse) GetResult() *CountProofResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// PriceProofRequest is request type for the Service/PriceProof RPC method.
+type PriceProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signal_ids is the list of signal IDs
+	SignalIds []string `protobuf:"bytes,1,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
+	// height is block height
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *PriceProofRequest) Reset() {
+	*x = PriceProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceProofRequest) ProtoMessage() {}
+
+// Deprecated: Use PriceProofRequest.ProtoReflect.Descriptor instead.
+func (*PriceProofRequest) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PriceProofRequest) GetSignalIds() []string {
+	if x != nil {
+		return x.SignalIds
+	}
+	return nil
+}
+
+func (x *PriceProofRequest) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// PriceProofResponse is response type for the Service/PriceProof RPC method.
+type PriceProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// height is block height
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// result is the proof
+	Result *PriceProofResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *PriceProofResponse) Reset() {
+	*x = PriceProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceProofResponse) ProtoMessage() {}
+
+// Deprecated: Use PriceProofResponse.ProtoReflect.Descriptor instead.
+func (*PriceProofResponse) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PriceProofResponse) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *PriceProofResponse) GetResult() *PriceProofResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// TunnelPacketProofRequest is request type for the Service/TunnelPacketProof RPC method.
+type TunnelPacketProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the ID of the tunnel
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// sequence is the sequence of the packet
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// height is block height
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *TunnelPacketProofRequest) Reset() {
+	*x = TunnelPacketProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelPacketProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelPacketProofRequest) ProtoMessage() {}
+
+// Deprecated: Use TunnelPacketProofRequest.ProtoReflect.Descriptor instead.
+func (*TunnelPacketProofRequest) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TunnelPacketProofRequest) GetTunnelId() uint64 {
+	if x != nil {
+		return x.TunnelId
+	}
+	return 0
+}
+
+func (x *TunnelPacketProofRequest) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *TunnelPacketProofRequest) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// TunnelPacketProofResponse is response type for the Service/TunnelPacketProof RPC method.
+type TunnelPacketProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// height is block height
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// result is the proof
+	Result *PacketProofResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *TunnelPacketProofResponse) Reset() {
+	*x = TunnelPacketProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelPacketProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelPacketProofResponse) ProtoMessage() {}
+
+// Deprecated: Use TunnelPacketProofResponse.ProtoReflect.Descriptor instead.
+func (*TunnelPacketProofResponse) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TunnelPacketProofResponse) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *TunnelPacketProofResponse) GetResult() *PacketProofResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// SingleProofResponse is the data structure for response of single proof
+type SingleProofResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Proof         *SingleProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	EvmProofBytes []byte       `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3" json:"evm_proof_bytes,omitempty"`
+}
+
+func (x *SingleProofResult) Reset() {
+	*x = SingleProofResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SingleProofResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleProofResult) ProtoMessage() {}
+
+// Deprecated: Use SingleProofResult.ProtoReflect.Descriptor instead.
+func (*SingleProofResult) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SingleProofResult) GetProof() *SingleProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *SingleProofResult) GetEvmProofBytes() []byte {
 	if x != nil {
-		return x.Height
+		return x.EvmProofBytes
 	}
-	return 0
+	return nil
 }
 
-// ProofResponse is response type for the Service/Proof RPC method.
-type ProofResponse struct {
+// MultiProofResult is the data structure for response of multi proof
+type MultiProofResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// height is block height
-	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	// result is the proof
-	Result *SingleProofResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	Proof         *MultiProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	EvmProofBytes []byte      `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3" json:"evm_proof_bytes,omitempty"`
 }
 
-func (x *ProofResponse) Reset() {
-	*x = ProofResponse{}
+func (x *MultiProofResult) Reset() {
+	*x = MultiProofResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[1]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProofResponse) String() string {
+func (x *MultiProofResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProofResponse) ProtoMessage() {}
+func (*MultiProofResult) ProtoMessage() {}
 
-// Deprecated: Use ProofResponse.ProtoReflect.Descriptor instead.
-func (*ProofResponse) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MultiProofResult.ProtoReflect.Descriptor instead.
+func (*MultiProofResult) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ProofResponse) GetHeight() int64 {
+func (x *MultiProofResult) GetProof() *MultiProof {
 	if x != nil {
-		return x.Height
+		return x.Proof
 	}
-	return 0
+	return nil
 }
 
-func (x *ProofResponse) GetResult() *SingleProofResult {
+func (x *MultiProofResult) GetEvmProofBytes() []byte {
 	if x != nil {
-		return x.Result
+		return x.EvmProofBytes
 	}
 	return nil
 }
 
-// MultiProofRequest is request type for the Service/MultiProof RPC method.
-type MultiProofRequest struct {
+// CountProofResult is the data structure for response of count proof
+type CountProofResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// request_ids is the list of request IDs
-	RequestIds []uint64 `protobuf:"varint,1,rep,packed,name=request_ids,json=requestIds,proto3" json:"request_ids,omitempty"`
+	Proof         *CountProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	EvmProofBytes []byte      `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3" json:"evm_proof_bytes,omitempty"`
 }
 
-func (x *MultiProofRequest) Reset() {
-	*x = MultiProofRequest{}
+func (x *CountProofResult) Reset() {
+	*x = CountProofResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[2]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MultiProofRequest) String() string {
+func (x *CountProofResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiProofRequest) ProtoMessage() {}
+func (*CountProofResult) ProtoMessage() {}
 
-// Deprecated: Use MultiProofRequest.ProtoReflect.Descriptor instead.
-func (*MultiProofRequest) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use CountProofResult.ProtoReflect.Descriptor instead.
+func (*CountProofResult) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *MultiProofRequest) GetRequestIds() []uint64 {
+func (x *CountProofResult) GetProof() *CountProof {
 	if x != nil {
-		return x.RequestIds
+		return x.Proof
 	}
 	return nil
 }
 
-// MultiProofResponse is response type for the Service/MultiProof RPC method.
-type MultiProofResponse struct {
+func (x *CountProofResult) GetEvmProofBytes() []byte {
+	if x != nil {
+		return x.EvmProofBytes
+	}
+	return nil
+}
+
+// PriceProofResult is the data structure for response of price proof
+type PriceProofResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Height int64             `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	Result *MultiProofResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	Proof         *PriceMultiProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	EvmProofBytes []byte           `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3" json:"evm_proof_bytes,omitempty"`
 }
 
-func (x *MultiProofResponse) Reset() {
-	*x = MultiProofResponse{}
+func (x *PriceProofResult) Reset() {
+	*x = PriceProofResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[3]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MultiProofResponse) String() string {
+func (x *PriceProofResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiProofResponse) ProtoMessage() {}
+func (*PriceProofResult) ProtoMessage() {}
 
-// Deprecated: Use MultiProofResponse.ProtoReflect.Descriptor instead.
-func (*MultiProofResponse) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use PriceProofResult.ProtoReflect.Descriptor instead.
+func (*PriceProofResult) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *MultiProofResponse) GetHeight() int64 {
+func (x *PriceProofResult) GetProof() *PriceMultiProof {
 	if x != nil {
-		return x.Height
+		return x.Proof
 	}
-	return 0
+	return nil
 }
 
-func (x *MultiProofResponse) GetResult() *MultiProofResult {
+func (x *PriceProofResult) GetEvmProofBytes() []byte {
 	if x != nil {
-		return x.Result
+		return x.EvmProofBytes
 	}
 	return nil
 }
 
-// RequestCountProofRequest is request type for the Service/RequestCountProof RPC method.
-type RequestCountProofRequest struct {
+// PacketProofResult is the data structure for response of tunnel packet proof
+type PacketProofResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Proof         *PacketProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	EvmProofBytes []byte       `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3" json:"evm_proof_bytes,omitempty"`
 }
 
-func (x *RequestCountProofRequest) Reset() {
-	*x = RequestCountProofRequest{}
+func (x *PacketProofResult) Reset() {
+	*x = PacketProofResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[4]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RequestCountProofRequest) String() string {
+func (x *PacketProofResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestCountProofRequest) ProtoMessage() {}
+func (*PacketProofResult) ProtoMessage() {}
 
-// Deprecated: Use RequestCountProofRequest.ProtoReflect.Descriptor instead.
-func (*RequestCountProofRequest) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use PacketProofResult.ProtoReflect.Descriptor instead.
+func (*PacketProofResult) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{14}
 }
 
-// RequestCountProofResponse is response type for the Service/RequestCountProof RPC method.
-type RequestCountProofResponse struct {
+func (x *PacketProofResult) GetProof() *PacketProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *PacketProofResult) GetEvmProofBytes() []byte {
+	if x != nil {
+		return x.EvmProofBytes
+	}
+	return nil
+}
+
+// SingleProof contains block height, oracle data proof and block relay proof
+type SingleProof struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Height int64             `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	Result *CountProofResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	BlockHeight     uint64           `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	OracleDataProof *OracleDataProof `protobuf:"bytes,2,opt,name=oracle_data_proof,json=oracleDataProof,proto3" json:"oracle_data_proof,omitempty"`
+	BlockRelayProof *BlockRelayProof `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof,omitempty"`
 }
 
-func (x *RequestCountProofResponse) Reset() {
-	*x = RequestCountProofResponse{}
+func (x *SingleProof) Reset() {
+	*x = SingleProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[5]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RequestCountProofResponse) String() string {
+func (x *SingleProof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestCountProofResponse) ProtoMessage() {}
+func (*SingleProof) ProtoMessage() {}
 
-// Deprecated: Use RequestCountProofResponse.ProtoReflect.Descriptor instead.
-func (*RequestCountProofResponse) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use SingleProof.ProtoReflect.Descriptor instead.
+func (*SingleProof) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *RequestCountProofResponse) GetHeight() int64 {
+func (x *SingleProof) GetBlockHeight() uint64 {
 	if x != nil {
-		return x.Height
+		return x.BlockHeight
 	}
 	return 0
 }
 
-func (x *RequestCountProofResponse) GetResult() *CountProofResult {
+func (x *SingleProof) GetOracleDataProof() *OracleDataProof {
 	if x != nil {
-		return x.Result
+		return x.OracleDataProof
 	}
 	return nil
 }
 
-// SingleProofResponse is the data structure for response of single proof
-type SingleProofResult struct {
+func (x *SingleProof) GetBlockRelayProof() *BlockRelayProof {
+	if x != nil {
+		return x.BlockRelayProof
+	}
+	return nil
+}
+
+// MultiProof contains block height, list of oracle data proof and block relay proof
+type MultiProof struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Proof         *SingleProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
-	EvmProofBytes []byte       `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3" json:"evm_proof_bytes,omitempty"`
+	BlockHeight          uint64             `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	OracleDataMultiProof []*OracleDataProof `protobuf:"bytes,2,rep,name=oracle_data_multi_proof,json=oracleDataMultiProof,proto3" json:"oracle_data_multi_proof,omitempty"`
+	BlockRelayProof      *BlockRelayProof   `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof,omitempty"`
 }
 
-func (x *SingleProofResult) Reset() {
-	*x = SingleProofResult{}
+func (x *MultiProof) Reset() {
+	*x = MultiProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[6]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SingleProofResult) String() string {
+func (x *MultiProof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleProofResult) ProtoMessage() {}
+func (*MultiProof) ProtoMessage() {}
 
-// Deprecated: Use SingleProofResult.ProtoReflect.Descriptor instead.
-func (*SingleProofResult) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MultiProof.ProtoReflect.Descriptor instead.
+func (*MultiProof) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SingleProofResult) GetProof() *SingleProof {
+func (x *MultiProof) GetBlockHeight() uint64 {
 	if x != nil {
-		return x.Proof
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *MultiProof) GetOracleDataMultiProof() []*OracleDataProof {
+	if x != nil {
+		return x.OracleDataMultiProof
 	}
 	return nil
 }
 
-func (x *SingleProofResult) GetEvmProofBytes() []byte {
+func (x *MultiProof) GetBlockRelayProof() *BlockRelayProof {
 	if x != nil {
-		return x.EvmProofBytes
+		return x.BlockRelayProof
 	}
 	return nil
 }
 
-// MultiProofResult is the data structure for response of multi proof
-type MultiProofResult struct {
+// CountProof contains block height, count proof and block relay proof
+type CountProof struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Proof         *MultiProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
-	EvmProofBytes []byte      `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3" json:"evm_proof_bytes,omitempty"`
+	BlockHeight     uint64              `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	CountProof      *RequestsCountProof `protobuf:"bytes,2,opt,name=count_proof,json=countProof,proto3" json:"count_proof,omitempty"`
+	BlockRelayProof *BlockRelayProof    `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof,omitempty"`
 }
 
-func (x *MultiProofResult) Reset() {
-	*x = MultiProofResult{}
+func (x *CountProof) Reset() {
+	*x = CountProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[7]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MultiProofResult) String() string {
+func (x *CountProof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiProofResult) ProtoMessage() {}
+func (*CountProof) ProtoMessage() {}
 
-// Deprecated: Use MultiProofResult.ProtoReflect.Descriptor instead.
-func (*MultiProofResult) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CountProof.ProtoReflect.Descriptor instead.
+func (*CountProof) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *MultiProofResult) GetProof() *MultiProof {
+func (x *CountProof) GetBlockHeight() uint64 {
 	if x != nil {
-		return x.Proof
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *CountProof) GetCountProof() *RequestsCountProof {
+	if x != nil {
+		return x.CountProof
 	}
 	return nil
 }
 
-func (x *MultiProofResult) GetEvmProofBytes() []byte {
+func (x *CountProof) GetBlockRelayProof() *BlockRelayProof {
 	if x != nil {
-		return x.EvmProofBytes
+		return x.BlockRelayProof
 	}
 	return nil
 }
 
-// CountProofResult is the data structure for response of count proof
-type CountProofResult struct {
+// PriceMultiProof contains block height, list of price data proof and store block relay proof
+type PriceMultiProof struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Proof         *CountProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
-	EvmProofBytes []byte      `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3" json:"evm_proof_bytes,omitempty"`
+	BlockHeight         uint64                `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	PriceDataMultiProof []*PriceDataProof     `protobuf:"bytes,2,rep,name=price_data_multi_proof,json=priceDataMultiProof,proto3" json:"price_data_multi_proof,omitempty"`
+	BlockRelayProof     *StoreBlockRelayProof `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof,omitempty"`
 }
 
-func (x *CountProofResult) Reset() {
-	*x = CountProofResult{}
+func (x *PriceMultiProof) Reset() {
+	*x = PriceMultiProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[8]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CountProofResult) String() string {
+func (x *PriceMultiProof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountProofResult) ProtoMessage() {}
+func (*PriceMultiProof) ProtoMessage() {}
+
+// Deprecated: Use PriceMultiProof.ProtoReflect.Descriptor instead.
+func (*PriceMultiProof) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{18}
+}
 
-// Deprecated: Use CountProofResult.ProtoReflect.Descriptor instead.
-func (*CountProofResult) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{8}
+func (x *PriceMultiProof) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
 }
 
-func (x *CountProofResult) GetProof() *CountProof {
+func (x *PriceMultiProof) GetPriceDataMultiProof() []*PriceDataProof {
 	if x != nil {
-		return x.Proof
+		return x.PriceDataMultiProof
 	}
 	return nil
 }
 
-func (x *CountProofResult) GetEvmProofBytes() []byte {
+func (x *PriceMultiProof) GetBlockRelayProof() *StoreBlockRelayProof {
 	if x != nil {
-		return x.EvmProofBytes
+		return x.BlockRelayProof
 	}
 	return nil
 }
 
-// SingleProof contains block height, oracle data proof and block relay proof
-type SingleProof struct {
+// PacketProof contains block height, packet data proof and store block relay proof
+type PacketProof struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlockHeight     uint64           `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	OracleDataProof *OracleDataProof `protobuf:"bytes,2,opt,name=oracle_data_proof,json=oracleDataProof,proto3" json:"oracle_data_proof,omitempty"`
-	BlockRelayProof *BlockRelayProof `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof,omitempty"`
+	BlockHeight     uint64                `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	PacketDataProof *PacketDataProof      `protobuf:"bytes,2,opt,name=packet_data_proof,json=packetDataProof,proto3" json:"packet_data_proof,omitempty"`
+	BlockRelayProof *StoreBlockRelayProof `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof,omitempty"`
 }
 
-func (x *SingleProof) Reset() {
-	*x = SingleProof{}
+func (x *PacketProof) Reset() {
+	*x = PacketProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[9]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SingleProof) String() string {
+func (x *PacketProof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleProof) ProtoMessage() {}
+func (*PacketProof) ProtoMessage() {}
 
-// Deprecated: Use SingleProof.ProtoReflect.Descriptor instead.
-func (*SingleProof) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use PacketProof.ProtoReflect.Descriptor instead.
+func (*PacketProof) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SingleProof) GetBlockHeight() uint64 {
+func (x *PacketProof) GetBlockHeight() uint64 {
 	if x != nil {
 		return x.BlockHeight
 	}
 	return 0
 }
 
-func (x *SingleProof) GetOracleDataProof() *OracleDataProof {
+func (x *PacketProof) GetPacketDataProof() *PacketDataProof {
 	if x != nil {
-		return x.OracleDataProof
+		return x.PacketDataProof
 	}
 	return nil
 }
 
-func (x *SingleProof) GetBlockRelayProof() *BlockRelayProof {
+func (x *PacketProof) GetBlockRelayProof() *StoreBlockRelayProof {
 	if x != nil {
 		return x.BlockRelayProof
 	}
 	return nil
 }
 
-// MultiProof contains block height, list of oracle data proof and block relay proof
-type MultiProof struct {
+// OracleDataProof contains result, version and merkle paths
+type OracleDataProof struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlockHeight          uint64             `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	OracleDataMultiProof []*OracleDataProof `protobuf:"bytes,2,rep,name=oracle_data_multi_proof,json=oracleDataMultiProof,proto3" json:"oracle_data_multi_proof,omitempty"`
-	BlockRelayProof      *BlockRelayProof   `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof,omitempty"`
+	Result      *v1.Result        `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Version     uint64            `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	MerklePaths []*IAVLMerklePath `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths,omitempty"`
 }
 
-func (x *MultiProof) Reset() {
-	*x = MultiProof{}
+func (x *OracleDataProof) Reset() {
+	*x = OracleDataProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[10]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MultiProof) String() string {
+func (x *OracleDataProof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiProof) ProtoMessage() {}
+func (*OracleDataProof) ProtoMessage() {}
 
-// Deprecated: Use MultiProof.ProtoReflect.Descriptor instead.
-func (*MultiProof) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use OracleDataProof.ProtoReflect.Descriptor instead.
+func (*OracleDataProof) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *MultiProof) GetBlockHeight() uint64 {
+func (x *OracleDataProof) GetResult() *v1.Result {
 	if x != nil {
-		return x.BlockHeight
+		return x.Result
 	}
-	return 0
+	return nil
 }
 
-func (x *MultiProof) GetOracleDataMultiProof() []*OracleDataProof {
+func (x *OracleDataProof) GetVersion() uint64 {
 	if x != nil {
-		return x.OracleDataMultiProof
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-func (x *MultiProof) GetBlockRelayProof() *BlockRelayProof {
+func (x *OracleDataProof) GetMerklePaths() []*IAVLMerklePath {
 	if x != nil {
-		return x.BlockRelayProof
+		return x.MerklePaths
 	}
 	return nil
 }
 
-// CountProof contains block height, count proof and block relay proof
-type CountProof struct {
+// PriceDataProof contains price, version and merkle paths
+type PriceDataProof struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlockHeight     uint64              `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	CountProof      *RequestsCountProof `protobuf:"bytes,2,opt,name=count_proof,json=countProof,proto3" json:"count_proof,omitempty"`
-	BlockRelayProof *BlockRelayProof    `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof,omitempty"`
+	Price       *v1beta1.Price    `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	Version     uint64            `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	MerklePaths []*IAVLMerklePath `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths,omitempty"`
 }
 
-func (x *CountProof) Reset() {
-	*x = CountProof{}
+func (x *PriceDataProof) Reset() {
+	*x = PriceDataProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[11]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CountProof) String() string {
+func (x *PriceDataProof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountProof) ProtoMessage() {}
+func (*PriceDataProof) ProtoMessage() {}
 
-// Deprecated: Use CountProof.ProtoReflect.Descriptor instead.
-func (*CountProof) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use PriceDataProof.ProtoReflect.Descriptor instead.
+func (*PriceDataProof) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *CountProof) GetBlockHeight() uint64 {
+func (x *PriceDataProof) GetPrice() *v1beta1.Price {
 	if x != nil {
-		return x.BlockHeight
+		return x.Price
 	}
-	return 0
+	return nil
 }
 
-func (x *CountProof) GetCountProof() *RequestsCountProof {
+func (x *PriceDataProof) GetVersion() uint64 {
 	if x != nil {
-		return x.CountProof
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-func (x *CountProof) GetBlockRelayProof() *BlockRelayProof {
+func (x *PriceDataProof) GetMerklePaths() []*IAVLMerklePath {
 	if x != nil {
-		return x.BlockRelayProof
+		return x.MerklePaths
 	}
 	return nil
 }
 
-// OracleDataProof contains result, version and merkle paths
-type OracleDataProof struct {
+// PacketDataProof contains packet, its encoded form as stored in the tunnel store, version and merkle paths.
+// The encoded packet is the value committed to the store and must be used for the verification.
+type PacketDataProof struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result      *v1.Result        `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	Version     uint64            `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	MerklePaths []*IAVLMerklePath `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths,omitempty"`
+	Packet        *v1beta11.Packet  `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet,omitempty"`
+	EncodedPacket []byte            `protobuf:"bytes,2,opt,name=encoded_packet,json=encodedPacket,proto3" json:"encoded_packet,omitempty"`
+	Version       uint64            `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	MerklePaths   []*IAVLMerklePath `protobuf:"bytes,4,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths,omitempty"`
 }
 
-func (x *OracleDataProof) Reset() {
-	*x = OracleDataProof{}
+func (x *PacketDataProof) Reset() {
+	*x = PacketDataProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[12]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OracleDataProof) String() string {
+func (x *PacketDataProof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OracleDataProof) ProtoMessage() {}
+func (*PacketDataProof) ProtoMessage() {}
 
-// Deprecated: Use OracleDataProof.ProtoReflect.Descriptor instead.
-func (*OracleDataProof) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use PacketDataProof.ProtoReflect.Descriptor instead.
+func (*PacketDataProof) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *OracleDataProof) GetResult() *v1.Result {
+func (x *PacketDataProof) GetPacket() *v1beta11.Packet {
 	if x != nil {
-		return x.Result
+		return x.Packet
 	}
 	return nil
 }
 
-func (x *OracleDataProof) GetVersion() uint64 {
+func (x *PacketDataProof) GetEncodedPacket() []byte {
+	if x != nil {
+		return x.EncodedPacket
+	}
+	return nil
+}
+
+func (x *PacketDataProof) GetVersion() uint64 {
 	if x != nil {
 		return x.Version
 	}
 	return 0
 }
 
-func (x *OracleDataProof) GetMerklePaths() []*IAVLMerklePath {
+func (x *PacketDataProof) GetMerklePaths() []*IAVLMerklePath {
 	if x != nil {
 		return x.MerklePaths
 	}
@@ -11903,7 +19721,7 @@ type IAVLMerklePath struct {
 func (x *IAVLMerklePath) Reset() {
 	*x = IAVLMerklePath{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[13]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11917,7 +19735,7 @@ func (*IAVLMerklePath) ProtoMessage() {}
 
 // Deprecated: Use IAVLMerklePath.ProtoReflect.Descriptor instead.
 func (*IAVLMerklePath) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{13}
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *IAVLMerklePath) GetIsDataOnRight() bool {
@@ -11961,56 +19779,218 @@ type BlockRelayProof struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MultiStoreProof        *MultiStoreProof        `protobuf:"bytes,1,opt,name=multi_store_proof,json=multiStoreProof,proto3" json:"multi_store_proof,omitempty"`
-	BlockHeaderMerkleParts *BlockHeaderMerkleParts `protobuf:"bytes,2,opt,name=block_header_merkle_parts,json=blockHeaderMerkleParts,proto3" json:"block_header_merkle_parts,omitempty"`
-	CommonEncodedVotePart  *CommonEncodedVotePart  `protobuf:"bytes,3,opt,name=common_encoded_vote_part,json=commonEncodedVotePart,proto3" json:"common_encoded_vote_part,omitempty"`
-	Signatures             []*TMSignature          `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	MultiStoreProof        *MultiStoreProof        `protobuf:"bytes,1,opt,name=multi_store_proof,json=multiStoreProof,proto3" json:"multi_store_proof,omitempty"`
+	BlockHeaderMerkleParts *BlockHeaderMerkleParts `protobuf:"bytes,2,opt,name=block_header_merkle_parts,json=blockHeaderMerkleParts,proto3" json:"block_header_merkle_parts,omitempty"`
+	CommonEncodedVotePart  *CommonEncodedVotePart  `protobuf:"bytes,3,opt,name=common_encoded_vote_part,json=commonEncodedVotePart,proto3" json:"common_encoded_vote_part,omitempty"`
+	Signatures             []*TMSignature          `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *BlockRelayProof) Reset() {
+	*x = BlockRelayProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockRelayProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRelayProof) ProtoMessage() {}
+
+// Deprecated: Use BlockRelayProof.ProtoReflect.Descriptor instead.
+func (*BlockRelayProof) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BlockRelayProof) GetMultiStoreProof() *MultiStoreProof {
+	if x != nil {
+		return x.MultiStoreProof
+	}
+	return nil
+}
+
+func (x *BlockRelayProof) GetBlockHeaderMerkleParts() *BlockHeaderMerkleParts {
+	if x != nil {
+		return x.BlockHeaderMerkleParts
+	}
+	return nil
+}
+
+func (x *BlockRelayProof) GetCommonEncodedVotePart() *CommonEncodedVotePart {
+	if x != nil {
+		return x.CommonEncodedVotePart
+	}
+	return nil
+}
+
+func (x *BlockRelayProof) GetSignatures() []*TMSignature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+// StoreBlockRelayProof contains store multi store proof, block header merkle parts, common encoded part and
+// signatures. It is the same as BlockRelayProof but proves the state hash of any store in the multistore.
+type StoreBlockRelayProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MultiStoreProof        *StoreMultiStoreProof   `protobuf:"bytes,1,opt,name=multi_store_proof,json=multiStoreProof,proto3" json:"multi_store_proof,omitempty"`
+	BlockHeaderMerkleParts *BlockHeaderMerkleParts `protobuf:"bytes,2,opt,name=block_header_merkle_parts,json=blockHeaderMerkleParts,proto3" json:"block_header_merkle_parts,omitempty"`
+	CommonEncodedVotePart  *CommonEncodedVotePart  `protobuf:"bytes,3,opt,name=common_encoded_vote_part,json=commonEncodedVotePart,proto3" json:"common_encoded_vote_part,omitempty"`
+	Signatures             []*TMSignature          `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *StoreBlockRelayProof) Reset() {
+	*x = StoreBlockRelayProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreBlockRelayProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreBlockRelayProof) ProtoMessage() {}
+
+// Deprecated: Use StoreBlockRelayProof.ProtoReflect.Descriptor instead.
+func (*StoreBlockRelayProof) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StoreBlockRelayProof) GetMultiStoreProof() *StoreMultiStoreProof {
+	if x != nil {
+		return x.MultiStoreProof
+	}
+	return nil
+}
+
+func (x *StoreBlockRelayProof) GetBlockHeaderMerkleParts() *BlockHeaderMerkleParts {
+	if x != nil {
+		return x.BlockHeaderMerkleParts
+	}
+	return nil
+}
+
+func (x *StoreBlockRelayProof) GetCommonEncodedVotePart() *CommonEncodedVotePart {
+	if x != nil {
+		return x.CommonEncodedVotePart
+	}
+	return nil
+}
+
+func (x *StoreBlockRelayProof) GetSignatures() []*TMSignature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+// StoreMultiStoreProof stores the IAVL state hash of a store and the Merkle steps from the store leaf
+// to Tendermint's application state hash at a given block. Unlike MultiStoreProof, it does not depend
+// on the position of the store in the multistore tree (see MultiStoreProof for the tree layout).
+type StoreMultiStoreProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// store_key is the name of the store, i.e. the key of the store leaf in the multistore
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	// iavl_state_hash is the root hash of the IAVL tree of the store
+	IavlStateHash []byte `protobuf:"bytes,2,opt,name=iavl_state_hash,json=iavlStateHash,proto3" json:"iavl_state_hash,omitempty"`
+	// merkle_paths is the list of Merkle steps from the store leaf to the application state hash
+	MerklePaths []*MultiStoreMerklePath `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths,omitempty"`
+}
+
+func (x *StoreMultiStoreProof) Reset() {
+	*x = StoreMultiStoreProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreMultiStoreProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreMultiStoreProof) ProtoMessage() {}
+
+// Deprecated: Use StoreMultiStoreProof.ProtoReflect.Descriptor instead.
+func (*StoreMultiStoreProof) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StoreMultiStoreProof) GetStoreKey() string {
+	if x != nil {
+		return x.StoreKey
+	}
+	return ""
+}
+
+func (x *StoreMultiStoreProof) GetIavlStateHash() []byte {
+	if x != nil {
+		return x.IavlStateHash
+	}
+	return nil
+}
+
+func (x *StoreMultiStoreProof) GetMerklePaths() []*MultiStoreMerklePath {
+	if x != nil {
+		return x.MerklePaths
+	}
+	return nil
+}
+
+// MultiStoreMerklePath represents a Merkle step to a store leaf in the multistore simple Merkle tree.
+type MultiStoreMerklePath struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsDataOnRight bool   `protobuf:"varint,1,opt,name=is_data_on_right,json=isDataOnRight,proto3" json:"is_data_on_right,omitempty"`
+	SiblingHash   []byte `protobuf:"bytes,2,opt,name=sibling_hash,json=siblingHash,proto3" json:"sibling_hash,omitempty"`
 }
 
-func (x *BlockRelayProof) Reset() {
-	*x = BlockRelayProof{}
+func (x *MultiStoreMerklePath) Reset() {
+	*x = MultiStoreMerklePath{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[14]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockRelayProof) String() string {
+func (x *MultiStoreMerklePath) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockRelayProof) ProtoMessage() {}
-
-// Deprecated: Use BlockRelayProof.ProtoReflect.Descriptor instead.
-func (*BlockRelayProof) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *BlockRelayProof) GetMultiStoreProof() *MultiStoreProof {
-	if x != nil {
-		return x.MultiStoreProof
-	}
-	return nil
-}
+func (*MultiStoreMerklePath) ProtoMessage() {}
 
-func (x *BlockRelayProof) GetBlockHeaderMerkleParts() *BlockHeaderMerkleParts {
-	if x != nil {
-		return x.BlockHeaderMerkleParts
-	}
-	return nil
+// Deprecated: Use MultiStoreMerklePath.ProtoReflect.Descriptor instead.
+func (*MultiStoreMerklePath) Descriptor() ([]byte, []int) {
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *BlockRelayProof) GetCommonEncodedVotePart() *CommonEncodedVotePart {
+func (x *MultiStoreMerklePath) GetIsDataOnRight() bool {
 	if x != nil {
-		return x.CommonEncodedVotePart
+		return x.IsDataOnRight
 	}
-	return nil
+	return false
 }
 
-func (x *BlockRelayProof) GetSignatures() []*TMSignature {
+func (x *MultiStoreMerklePath) GetSiblingHash() []byte {
 	if x != nil {
-		return x.Signatures
+		return x.SiblingHash
 	}
 	return nil
 }
@@ -12055,7 +20035,7 @@ type MultiStoreProof struct {
 func (x *MultiStoreProof) Reset() {
 	*x = MultiStoreProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[15]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12069,7 +20049,7 @@ func (*MultiStoreProof) ProtoMessage() {}
 
 // Deprecated: Use MultiStoreProof.ProtoReflect.Descriptor instead.
 func (*MultiStoreProof) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{15}
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *MultiStoreProof) GetOracleIavlStateHash() []byte {
@@ -12158,7 +20138,7 @@ type BlockHeaderMerkleParts struct {
 func (x *BlockHeaderMerkleParts) Reset() {
 	*x = BlockHeaderMerkleParts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[16]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12172,7 +20152,7 @@ func (*BlockHeaderMerkleParts) ProtoMessage() {}
 
 // Deprecated: Use BlockHeaderMerkleParts.ProtoReflect.Descriptor instead.
 func (*BlockHeaderMerkleParts) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{16}
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *BlockHeaderMerkleParts) GetVersionAndChainIdHash() []byte {
@@ -12244,7 +20224,7 @@ type CommonEncodedVotePart struct {
 func (x *CommonEncodedVotePart) Reset() {
 	*x = CommonEncodedVotePart{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[17]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12258,7 +20238,7 @@ func (*CommonEncodedVotePart) ProtoMessage() {}
 
 // Deprecated: Use CommonEncodedVotePart.ProtoReflect.Descriptor instead.
 func (*CommonEncodedVotePart) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{17}
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *CommonEncodedVotePart) GetSignedDataPrefix() []byte {
@@ -12294,7 +20274,7 @@ type TMSignature struct {
 func (x *TMSignature) Reset() {
 	*x = TMSignature{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[18]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12308,7 +20288,7 @@ func (*TMSignature) ProtoMessage() {}
 
 // Deprecated: Use TMSignature.ProtoReflect.Descriptor instead.
 func (*TMSignature) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{18}
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *TMSignature) GetR() []byte {
@@ -12353,7 +20333,7 @@ type RequestsCountProof struct {
 func (x *RequestsCountProof) Reset() {
 	*x = RequestsCountProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[19]
+		mi := &file_band_base_oracle_v1_proof_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12367,7 +20347,7 @@ func (*RequestsCountProof) ProtoMessage() {}
 
 // Deprecated: Use RequestsCountProof.ProtoReflect.Descriptor instead.
 func (*RequestsCountProof) Descriptor() ([]byte, []int) {
-	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{19}
+	return file_band_base_oracle_v1_proof_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *RequestsCountProof) GetCount() uint64 {
@@ -12400,66 +20380,119 @@ var file_band_base_oracle_v1_proof_proto_rawDesc = []byte{
 	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
 	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x61, 0x6e, 0x64,
+	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x62, 0x61, 0x6e, 0x64,
+	0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x66,
+	0x65, 0x65, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x61, 0x6e, 0x64,
 	0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x45, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x6f, 0x66,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x6d,
-	0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
-	0x6e, 0x67, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x34, 0x0a,
-	0x11, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x64, 0x73, 0x22, 0x71, 0x0a, 0x12, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x43, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f,
-	0x6f, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x78, 0x0a, 0x19, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x43, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xaf, 0x01, 0x0a,
-	0x11, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x75,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x45, 0x0a, 0x0c, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x6d, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x34, 0x0a, 0x11, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x64, 0x73, 0x22, 0x71, 0x0a, 0x12, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x43, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x78, 0x0a, 0x19, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x43, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4a,
+	0x0a, 0x11, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49,
+	0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x71, 0x0a, 0x12, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x43, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x6b, 0x0a,
+	0x18, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x79, 0x0a, 0x19, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x44, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xaf, 0x01, 0x0a, 0x11, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3c, 0x0a, 0x05, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x5c, 0x0a, 0x0f, 0x65, 0x76, 0x6d,
+	0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65,
+	0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e,
+	0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x0d, 0x65, 0x76, 0x6d, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x10, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x05,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x5c, 0x0a, 0x0f, 0x65, 0x76, 0x6d,
+	0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65,
+	0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e,
+	0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x0d, 0x65, 0x76, 0x6d, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x05,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x5c, 0x0a, 0x0f, 0x65, 0x76, 0x6d,
+	0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65,
+	0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e,
+	0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x0d, 0x65, 0x76, 0x6d, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x40, 0x0a, 0x05,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x5c,
+	0x0a, 0x0f, 0x65, 0x76, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74,
+	0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x0d, 0x65,
+	0x76, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xaf, 0x01, 0x0a,
+	0x11, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x75,
 	0x6c, 0x74, 0x12, 0x3c, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x20, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x12, 0x5c, 0x0a, 0x0f, 0x65, 0x76, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62,
-	0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73,
-	0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52,
-	0x0d, 0x65, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xad,
-	0x01, 0x0a, 0x10, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x12, 0x5c, 0x0a, 0x0f, 0x65, 0x76, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62,
-	0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73,
-	0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52,
-	0x0d, 0x65, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xad,
-	0x01, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72,
 	0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66,
 	0x12, 0x5c, 0x0a, 0x0f, 0x65, 0x76, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x79,
 	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67,
@@ -12509,237 +20542,366 @@ var file_band_base_oracle_v1_proof_proto_rawDesc = []byte{
 	0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
 	0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x6f,
 	0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
-	0x6c, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xaf, 0x01, 0x0a, 0x0f, 0x4f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x34, 0x0a, 0x06,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62,
-	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x0c,
-	0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x41, 0x56, 0x4c, 0x4d, 0x65, 0x72,
-	0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6d,
-	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0x85, 0x02, 0x0a, 0x0e, 0x49,
-	0x41, 0x56, 0x4c, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x27, 0x0a,
-	0x10, 0x69, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6f, 0x6e, 0x5f, 0x72, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x4f,
-	0x6e, 0x52, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x74, 0x72, 0x65,
-	0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
-	0x73, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x73, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x74, 0x72,
-	0x65, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x0c, 0x73, 0x69, 0x62,
-	0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x6c, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xf1, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x5e, 0x0a, 0x16, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12,
+	0x5b, 0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x79,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xe5, 0x01, 0x0a,
+	0x0b, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x56, 0x0a, 0x11, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x5b, 0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x22, 0xaf, 0x01, 0x0a, 0x0f, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x0c, 0x6d, 0x65, 0x72, 0x6b,
+	0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x41, 0x56, 0x4c, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50,
+	0x61, 0x74, 0x68, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0xaf, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x35, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x0c, 0x6d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x41, 0x56, 0x4c, 0x4d, 0x65, 0x72, 0x6b, 0x6c,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0x91, 0x02, 0x0a, 0x0f, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x39, 0x0a, 0x06,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x5b, 0x0a, 0x0e, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42,
 	0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
 	0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66,
 	0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78,
-	0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x48, 0x61,
-	0x73, 0x68, 0x22, 0x8a, 0x03, 0x0a, 0x0f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61,
-	0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x56, 0x0a, 0x11, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x6d,
-	0x75, 0x6c, 0x74, 0x69, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x6c,
-	0x0a, 0x19, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6d,
-	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x73, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x73, 0x12, 0x69, 0x0a, 0x18,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x76,
-	0x6f, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
-	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x56,
-	0x6f, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x4d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x00, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22,
-	0xb8, 0x06, 0x0a, 0x0f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x12, 0x80, 0x01, 0x0a, 0x16, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x69,
-	0x61, 0x76, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x42, 0x4b, 0xe2, 0xde, 0x1f, 0x13, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x49, 0x41, 0x56, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0xfa, 0xde, 0x1f,
-	0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65,
-	0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69,
-	0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65,
-	0x73, 0x52, 0x13, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x49, 0x61, 0x76, 0x6c, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x69, 0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f,
-	0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x13, 0x6d, 0x69,
-	0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x83, 0x01, 0x0a, 0x24, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x74, 0x6f, 0x5f,
-	0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x5f, 0x6d,
-	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62,
-	0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65,
-	0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x1f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x54, 0x6f,
-	0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x72,
-	0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x8f, 0x01, 0x0a, 0x2a, 0x72, 0x6f, 0x6c, 0x6c,
-	0x69, 0x6e, 0x67, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c,
-	0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde,
-	0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d,
-	0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c,
-	0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x52, 0x25, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x65, 0x64, 0x54,
-	0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x4d,
-	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x9d, 0x01, 0x0a, 0x21, 0x74, 0x73,
-	0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x54, 0xe2, 0xde, 0x1f, 0x1c, 0x54, 0x53, 0x53, 0x54, 0x6f,
-	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x72,
-	0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63,
-	0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x1c, 0x74, 0x73, 0x73,
-	0x54, 0x6f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x4d,
-	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x7f, 0x0a, 0x22, 0x61, 0x75, 0x74,
-	0x68, 0x5f, 0x74, 0x6f, 0x5f, 0x69, 0x63, 0x61, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63,
-	0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x1d, 0x61, 0x75, 0x74,
-	0x68, 0x54, 0x6f, 0x49, 0x63, 0x61, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73,
-	0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x22, 0xb6, 0x05, 0x0a, 0x16, 0x42,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x0d, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4c,
+	0x0a, 0x0c, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x41, 0x56, 0x4c, 0x4d,
+	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0x85, 0x02, 0x0a,
+	0x0e, 0x49, 0x41, 0x56, 0x4c, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x27, 0x0a, 0x10, 0x69, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6f, 0x6e, 0x5f, 0x72, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x44, 0x61, 0x74,
+	0x61, 0x4f, 0x6e, 0x52, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x74,
+	0x72, 0x65, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x73, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x75, 0x62,
+	0x74, 0x72, 0x65, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x0c, 0x73,
+	0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74,
+	0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48,
+	0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67,
+	0x48, 0x61, 0x73, 0x68, 0x22, 0x8a, 0x03, 0x0a, 0x0f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x6c, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x56, 0x0a, 0x11, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x12, 0x6c, 0x0a, 0x19, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x73,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x73, 0x12, 0x69,
+	0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64,
+	0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x64, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x4d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x22, 0x94, 0x03, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x6c, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x5b, 0x0a, 0x11, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x6c, 0x0a, 0x19, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70,
+	0x61, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x72, 0x6b,
+	0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x62,
 	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
-	0x50, 0x61, 0x72, 0x74, 0x73, 0x12, 0x6e, 0x0a, 0x19, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69,
+	0x50, 0x61, 0x72, 0x74, 0x73, 0x12, 0x69, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x50,
+	0x61, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74,
+	0x12, 0x46, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x4d, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0xf6, 0x01, 0x0a, 0x14, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x6d,
+	0x0a, 0x0f, 0x69, 0x61, 0x76, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x45, 0xe2, 0xde, 0x1f, 0x0d, 0x49, 0x41, 0x56,
+	0x4c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69,
 	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66,
 	0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f,
-	0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x15,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
-	0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x28,
-	0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x5f, 0x73, 0x65, 0x63, 0x6f,
-	0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x4e, 0x61,
-	0x6e, 0x6f, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x6a, 0x0a, 0x17, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x6f, 0x74,
-	0x68, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62,
-	0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73,
-	0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52,
-	0x13, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x4f,
-	0x74, 0x68, 0x65, 0x72, 0x12, 0x87, 0x01, 0x0a, 0x26, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x61, 0x6e, 0x64,
-	0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63,
-	0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x21, 0x6e, 0x65, 0x78,
-	0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x61, 0x73, 0x68, 0x41, 0x6e,
-	0x64, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x60,
-	0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x5f, 0x68,
-	0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x0d,
+	0x69, 0x61, 0x76, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x52, 0x0a,
+	0x0c, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x73, 0x22, 0x98, 0x01, 0x0a, 0x14, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x10, 0x69, 0x73,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6f, 0x6e, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x4f, 0x6e, 0x52, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x57, 0x0a, 0x0c, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67,
 	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62,
 	0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73,
 	0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52,
-	0x0f, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x48, 0x61, 0x73, 0x68,
-	0x12, 0x71, 0x0a, 0x1a, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x6e, 0x64,
-	0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f,
-	0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x17, 0x65, 0x76, 0x69, 0x64,
-	0x65, 0x6e, 0x63, 0x65, 0x41, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x48,
-	0x61, 0x73, 0x68, 0x22, 0xdf, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x12, 0x62, 0x0a,
-	0x12, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x70, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67,
+	0x0b, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x73, 0x68, 0x22, 0xb8, 0x06, 0x0a,
+	0x0f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x12, 0x80, 0x01, 0x0a, 0x16, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x61, 0x76, 0x6c,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x4b, 0xe2, 0xde, 0x1f, 0x13, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x49, 0x41, 0x56,
+	0x4c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66,
+	0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x13,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x49, 0x61, 0x76, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x69, 0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d,
+	0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x13, 0x6d, 0x69, 0x6e, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x83,
+	0x01, 0x0a, 0x24, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x6b,
+	0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa,
+	0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f,
+	0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x52, 0x1f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x8f, 0x01, 0x0a, 0x2a, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x73, 0x65, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67,
 	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62,
 	0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73,
 	0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52,
-	0x10, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x12, 0x62, 0x0a, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61,
-	0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa,
+	0x25, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x65, 0x64, 0x54, 0x6f, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x72, 0x6b,
+	0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x9d, 0x01, 0x0a, 0x21, 0x74, 0x73, 0x73, 0x5f, 0x74,
+	0x6f, 0x5f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73,
+	0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x54, 0xe2, 0xde, 0x1f, 0x1c, 0x54, 0x53, 0x53, 0x54, 0x6f, 0x55, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
+	0x48, 0x61, 0x73, 0x68, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65,
+	0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e,
+	0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x1c, 0x74, 0x73, 0x73, 0x54, 0x6f, 0x55,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x72, 0x6b,
+	0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x7f, 0x0a, 0x22, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74,
+	0x6f, 0x5f, 0x69, 0x63, 0x61, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73,
+	0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65,
+	0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e,
+	0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x1d, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f,
+	0x49, 0x63, 0x61, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x22, 0xb6, 0x05, 0x0a, 0x16, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x72,
+	0x74, 0x73, 0x12, 0x6e, 0x0a, 0x19, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63,
+	0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x15, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x4e, 0x61, 0x6e, 0x6f, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x6a, 0x0a, 0x17, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x6f, 0x74, 0x68, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f,
+	0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x13, 0x6c, 0x61,
+	0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x4f, 0x74, 0x68, 0x65,
+	0x72, 0x12, 0x87, 0x01, 0x0a, 0x26, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65,
+	0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e,
+	0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x21, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x61, 0x73, 0x68, 0x41, 0x6e, 0x64, 0x43, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x60, 0x0a, 0x11, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f,
+	0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x0f, 0x6c, 0x61,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x71, 0x0a,
+	0x1a, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74,
+	0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48,
+	0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x17, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x41, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68,
+	0x22, 0xdf, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x12, 0x62, 0x0a, 0x12, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f,
+	0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x10, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x62,
+	0x0a, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x75,
+	0x66, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74,
+	0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62,
+	0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x52, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75, 0x66, 0x66,
+	0x69, 0x78, 0x22, 0x86, 0x02, 0x0a, 0x0b, 0x54, 0x4d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x42, 0x0a, 0x01, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa,
 	0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
 	0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f,
 	0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x52, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53,
-	0x75, 0x66, 0x66, 0x69, 0x78, 0x22, 0x86, 0x02, 0x0a, 0x0b, 0x54, 0x4d, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x42, 0x0a, 0x01, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62,
-	0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65,
-	0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x01, 0x72, 0x12, 0x42, 0x0a, 0x01, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f,
-	0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x01, 0x73, 0x12, 0x0c, 0x0a,
-	0x01, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x76, 0x12, 0x61, 0x0a, 0x11, 0x65,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f,
-	0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x10, 0x65, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x92,
-	0x01, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x0c, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f,
-	0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x49, 0x41, 0x56, 0x4c, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61,
-	0x74, 0x68, 0x73, 0x32, 0xbc, 0x03, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x7f, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x74, 0x65, 0x73, 0x52, 0x01, 0x72, 0x12, 0x42, 0x0a, 0x01, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74,
+	0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48,
+	0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x01, 0x73, 0x12, 0x0c, 0x0a, 0x01, 0x76, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x76, 0x12, 0x61, 0x0a, 0x11, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d,
+	0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x10, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x92, 0x01, 0x0a, 0x12,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x0c, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x41, 0x56, 0x4c, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73,
+	0x32, 0xfc, 0x05, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7f, 0x0a, 0x05,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
 	0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x6f, 0x66, 0x2f, 0x7b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d,
-	0x12, 0x87, 0x01, 0x0a, 0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12,
-	0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x6d,
-	0x75, 0x6c, 0x74, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0xa5, 0x01, 0x0a, 0x11, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66,
-	0x12, 0x2d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2e, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f,
-	0x6f, 0x66, 0x42, 0xd7, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x41, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2,
-	0x02, 0x03, 0x42, 0x42, 0x4f, 0xaa, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x42, 0x61, 0x73,
-	0x65, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x42, 0x61,
-	0x6e, 0x64, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56,
-	0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x4f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x42, 0x61, 0x73, 0x65,
-	0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x2f, 0x7b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x87, 0x01,
+	0x0a, 0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x26, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0xa5, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x2d, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12,
+	0x86, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x26,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0xb4, 0x01, 0x0a, 0x11, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x2d,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2f, 0x7b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x7d, 0x42,
+	0xd7, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x62, 0x61, 0x6e, 0x64, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x42,
+	0x42, 0x4f, 0xaa, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x2e, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x5c,
+	0x42, 0x61, 0x73, 0x65, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x1f, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x16, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x42, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -12754,7 +20916,7 @@ func file_band_base_oracle_v1_proof_proto_rawDescGZIP() []byte {
 	return file_band_base_oracle_v1_proof_proto_rawDescData
 }
 
-var file_band_base_oracle_v1_proof_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_band_base_oracle_v1_proof_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_band_base_oracle_v1_proof_proto_goTypes = []interface{}{
 	(*ProofRequest)(nil),              // 0: band.base.oracle.v1.ProofRequest
 	(*ProofResponse)(nil),             // 1: band.base.oracle.v1.ProofResponse
@@ -12762,53 +20924,89 @@ var file_band_base_oracle_v1_proof_proto_goTypes = []interface{}{
 	(*MultiProofResponse)(nil),        // 3: band.base.oracle.v1.MultiProofResponse
 	(*RequestCountProofRequest)(nil),  // 4: band.base.oracle.v1.RequestCountProofRequest
 	(*RequestCountProofResponse)(nil), // 5: band.base.oracle.v1.RequestCountProofResponse
-	(*SingleProofResult)(nil),         // 6: band.base.oracle.v1.SingleProofResult
-	(*MultiProofResult)(nil),          // 7: band.base.oracle.v1.MultiProofResult
-	(*CountProofResult)(nil),          // 8: band.base.oracle.v1.CountProofResult
-	(*SingleProof)(nil),               // 9: band.base.oracle.v1.SingleProof
-	(*MultiProof)(nil),                // 10: band.base.oracle.v1.MultiProof
-	(*CountProof)(nil),                // 11: band.base.oracle.v1.CountProof
-	(*OracleDataProof)(nil),           // 12: band.base.oracle.v1.OracleDataProof
-	(*IAVLMerklePath)(nil),            // 13: band.base.oracle.v1.IAVLMerklePath
-	(*BlockRelayProof)(nil),           // 14: band.base.oracle.v1.BlockRelayProof
-	(*MultiStoreProof)(nil),           // 15: band.base.oracle.v1.MultiStoreProof
-	(*BlockHeaderMerkleParts)(nil),    // 16: band.base.oracle.v1.BlockHeaderMerkleParts
-	(*CommonEncodedVotePart)(nil),     // 17: band.base.oracle.v1.CommonEncodedVotePart
-	(*TMSignature)(nil),               // 18: band.base.oracle.v1.TMSignature
-	(*RequestsCountProof)(nil),        // 19: band.base.oracle.v1.RequestsCountProof
-	(*v1.Result)(nil),                 // 20: band.oracle.v1.Result
+	(*PriceProofRequest)(nil),         // 6: band.base.oracle.v1.PriceProofRequest
+	(*PriceProofResponse)(nil),        // 7: band.base.oracle.v1.PriceProofResponse
+	(*TunnelPacketProofRequest)(nil),  // 8: band.base.oracle.v1.TunnelPacketProofRequest
+	(*TunnelPacketProofResponse)(nil), // 9: band.base.oracle.v1.TunnelPacketProofResponse
+	(*SingleProofResult)(nil),         // 10: band.base.oracle.v1.SingleProofResult
+	(*MultiProofResult)(nil),          // 11: band.base.oracle.v1.MultiProofResult
+	(*CountProofResult)(nil),          // 12: band.base.oracle.v1.CountProofResult
+	(*PriceProofResult)(nil),          // 13: band.base.oracle.v1.PriceProofResult
+	(*PacketProofResult)(nil),         // 14: band.base.oracle.v1.PacketProofResult
+	(*SingleProof)(nil),               // 15: band.base.oracle.v1.SingleProof
+	(*MultiProof)(nil),                // 16: band.base.oracle.v1.MultiProof
+	(*CountProof)(nil),                // 17: band.base.oracle.v1.CountProof
+	(*PriceMultiProof)(nil),           // 18: band.base.oracle.v1.PriceMultiProof
+	(*PacketProof)(nil),               // 19: band.base.oracle.v1.PacketProof
+	(*OracleDataProof)(nil),           // 20: band.base.oracle.v1.OracleDataProof
+	(*PriceDataProof)(nil),            // 21: band.base.oracle.v1.PriceDataProof
+	(*PacketDataProof)(nil),           // 22: band.base.oracle.v1.PacketDataProof
+	(*IAVLMerklePath)(nil),            // 23: band.base.oracle.v1.IAVLMerklePath
+	(*BlockRelayProof)(nil),           // 24: band.base.oracle.v1.BlockRelayProof
+	(*StoreBlockRelayProof)(nil),      // 25: band.base.oracle.v1.StoreBlockRelayProof
+	(*StoreMultiStoreProof)(nil),      // 26: band.base.oracle.v1.StoreMultiStoreProof
+	(*MultiStoreMerklePath)(nil),      // 27: band.base.oracle.v1.MultiStoreMerklePath
+	(*MultiStoreProof)(nil),           // 28: band.base.oracle.v1.MultiStoreProof
+	(*BlockHeaderMerkleParts)(nil),    // 29: band.base.oracle.v1.BlockHeaderMerkleParts
+	(*CommonEncodedVotePart)(nil),     // 30: band.base.oracle.v1.CommonEncodedVotePart
+	(*TMSignature)(nil),               // 31: band.base.oracle.v1.TMSignature
+	(*RequestsCountProof)(nil),        // 32: band.base.oracle.v1.RequestsCountProof
+	(*v1.Result)(nil),                 // 33: band.oracle.v1.Result
+	(*v1beta1.Price)(nil),             // 34: band.feeds.v1beta1.Price
+	(*v1beta11.Packet)(nil),           // 35: band.tunnel.v1beta1.Packet
 }
 var file_band_base_oracle_v1_proof_proto_depIdxs = []int32{
-	6,  // 0: band.base.oracle.v1.ProofResponse.result:type_name -> band.base.oracle.v1.SingleProofResult
-	7,  // 1: band.base.oracle.v1.MultiProofResponse.result:type_name -> band.base.oracle.v1.MultiProofResult
-	8,  // 2: band.base.oracle.v1.RequestCountProofResponse.result:type_name -> band.base.oracle.v1.CountProofResult
-	9,  // 3: band.base.oracle.v1.SingleProofResult.proof:type_name -> band.base.oracle.v1.SingleProof
-	10, // 4: band.base.oracle.v1.MultiProofResult.proof:type_name -> band.base.oracle.v1.MultiProof
-	11, // 5: band.base.oracle.v1.CountProofResult.proof:type_name -> band.base.oracle.v1.CountProof
-	12, // 6: band.base.oracle.v1.SingleProof.oracle_data_proof:type_name -> band.base.oracle.v1.OracleDataProof
-	14, // 7: band.base.oracle.v1.SingleProof.block_relay_proof:type_name -> band.base.oracle.v1.BlockRelayProof
-	12, // 8: band.base.oracle.v1.MultiProof.oracle_data_multi_proof:type_name -> band.base.oracle.v1.OracleDataProof
-	14, // 9: band.base.oracle.v1.MultiProof.block_relay_proof:type_name -> band.base.oracle.v1.BlockRelayProof
-	19, // 10: band.base.oracle.v1.CountProof.count_proof:type_name -> band.base.oracle.v1.RequestsCountProof
-	14, // 11: band.base.oracle.v1.CountProof.block_relay_proof:type_name -> band.base.oracle.v1.BlockRelayProof
-	20, // 12: band.base.oracle.v1.OracleDataProof.result:type_name -> band.oracle.v1.Result
-	13, // 13: band.base.oracle.v1.OracleDataProof.merkle_paths:type_name -> band.base.oracle.v1.IAVLMerklePath
-	15, // 14: band.base.oracle.v1.BlockRelayProof.multi_store_proof:type_name -> band.base.oracle.v1.MultiStoreProof
-	16, // 15: band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts:type_name -> band.base.oracle.v1.BlockHeaderMerkleParts
-	17, // 16: band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part:type_name -> band.base.oracle.v1.CommonEncodedVotePart
-	18, // 17: band.base.oracle.v1.BlockRelayProof.signatures:type_name -> band.base.oracle.v1.TMSignature
-	13, // 18: band.base.oracle.v1.RequestsCountProof.merkle_paths:type_name -> band.base.oracle.v1.IAVLMerklePath
-	0,  // 19: band.base.oracle.v1.Service.Proof:input_type -> band.base.oracle.v1.ProofRequest
-	2,  // 20: band.base.oracle.v1.Service.MultiProof:input_type -> band.base.oracle.v1.MultiProofRequest
-	4,  // 21: band.base.oracle.v1.Service.RequestCountProof:input_type -> band.base.oracle.v1.RequestCountProofRequest
-	1,  // 22: band.base.oracle.v1.Service.Proof:output_type -> band.base.oracle.v1.ProofResponse
-	3,  // 23: band.base.oracle.v1.Service.MultiProof:output_type -> band.base.oracle.v1.MultiProofResponse
-	5,  // 24: band.base.oracle.v1.Service.RequestCountProof:output_type -> band.base.oracle.v1.RequestCountProofResponse
-	22, // [22:25] is the sub-list for method output_type
-	19, // [19:22] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	10, // 0: band.base.oracle.v1.ProofResponse.result:type_name -> band.base.oracle.v1.SingleProofResult
+	11, // 1: band.base.oracle.v1.MultiProofResponse.result:type_name -> band.base.oracle.v1.MultiProofResult
+	12, // 2: band.base.oracle.v1.RequestCountProofResponse.result:type_name -> band.base.oracle.v1.CountProofResult
+	13, // 3: band.base.oracle.v1.PriceProofResponse.result:type_name -> band.base.oracle.v1.PriceProofResult
+	14, // 4: band.base.oracle.v1.TunnelPacketProofResponse.result:type_name -> band.base.oracle.v1.PacketProofResult
+	15, // 5: band.base.oracle.v1.SingleProofResult.proof:type_name -> band.base.oracle.v1.SingleProof
+	16, // 6: band.base.oracle.v1.MultiProofResult.proof:type_name -> band.base.oracle.v1.MultiProof
+	17, // 7: band.base.oracle.v1.CountProofResult.proof:type_name -> band.base.oracle.v1.CountProof
+	18, // 8: band.base.oracle.v1.PriceProofResult.proof:type_name -> band.base.oracle.v1.PriceMultiProof
+	19, // 9: band.base.oracle.v1.PacketProofResult.proof:type_name -> band.base.oracle.v1.PacketProof
+	20, // 10: band.base.oracle.v1.SingleProof.oracle_data_proof:type_name -> band.base.oracle.v1.OracleDataProof
+	24, // 11: band.base.oracle.v1.SingleProof.block_relay_proof:type_name -> band.base.oracle.v1.BlockRelayProof
+	20, // 12: band.base.oracle.v1.MultiProof.oracle_data_multi_proof:type_name -> band.base.oracle.v1.OracleDataProof
+	24, // 13: band.base.oracle.v1.MultiProof.block_relay_proof:type_name -> band.base.oracle.v1.BlockRelayProof
+	32, // 14: band.base.oracle.v1.CountProof.count_proof:type_name -> band.base.oracle.v1.RequestsCountProof
+	24, // 15: band.base.oracle.v1.CountProof.block_relay_proof:type_name -> band.base.oracle.v1.BlockRelayProof
+	21, // 16: band.base.oracle.v1.PriceMultiProof.price_data_multi_proof:type_name -> band.base.oracle.v1.PriceDataProof
+	25, // 17: band.base.oracle.v1.PriceMultiProof.block_relay_proof:type_name -> band.base.oracle.v1.StoreBlockRelayProof
+	22, // 18: band.base.oracle.v1.PacketProof.packet_data_proof:type_name -> band.base.oracle.v1.PacketDataProof
+	25, // 19: band.base.oracle.v1.PacketProof.block_relay_proof:type_name -> band.base.oracle.v1.StoreBlockRelayProof
+	33, // 20: band.base.oracle.v1.OracleDataProof.result:type_name -> band.oracle.v1.Result
+	23, // 21: band.base.oracle.v1.OracleDataProof.merkle_paths:type_name -> band.base.oracle.v1.IAVLMerklePath
+	34, // 22: band.base.oracle.v1.PriceDataProof.price:type_name -> band.feeds.v1beta1.Price
+	23, // 23: band.base.oracle.v1.PriceDataProof.merkle_paths:type_name -> band.base.oracle.v1.IAVLMerklePath
+	35, // 24: band.base.oracle.v1.PacketDataProof.packet:type_name -> band.tunnel.v1beta1.Packet
+	23, // 25: band.base.oracle.v1.PacketDataProof.merkle_paths:type_name -> band.base.oracle.v1.IAVLMerklePath
+	28, // 26: band.base.oracle.v1.BlockRelayProof.multi_store_proof:type_name -> band.base.oracle.v1.MultiStoreProof
+	29, // 27: band.base.oracle.v1.BlockRelayProof.block_header_merkle_parts:type_name -> band.base.oracle.v1.BlockHeaderMerkleParts
+	30, // 28: band.base.oracle.v1.BlockRelayProof.common_encoded_vote_part:type_name -> band.base.oracle.v1.CommonEncodedVotePart
+	31, // 29: band.base.oracle.v1.BlockRelayProof.signatures:type_name -> band.base.oracle.v1.TMSignature
+	26, // 30: band.base.oracle.v1.StoreBlockRelayProof.multi_store_proof:type_name -> band.base.oracle.v1.StoreMultiStoreProof
+	29, // 31: band.base.oracle.v1.StoreBlockRelayProof.block_header_merkle_parts:type_name -> band.base.oracle.v1.BlockHeaderMerkleParts
+	30, // 32: band.base.oracle.v1.StoreBlockRelayProof.common_encoded_vote_part:type_name -> band.base.oracle.v1.CommonEncodedVotePart
+	31, // 33: band.base.oracle.v1.StoreBlockRelayProof.signatures:type_name -> band.base.oracle.v1.TMSignature
+	27, // 34: band.base.oracle.v1.StoreMultiStoreProof.merkle_paths:type_name -> band.base.oracle.v1.MultiStoreMerklePath
+	23, // 35: band.base.oracle.v1.RequestsCountProof.merkle_paths:type_name -> band.base.oracle.v1.IAVLMerklePath
+	0,  // 36: band.base.oracle.v1.Service.Proof:input_type -> band.base.oracle.v1.ProofRequest
+	2,  // 37: band.base.oracle.v1.Service.MultiProof:input_type -> band.base.oracle.v1.MultiProofRequest
+	4,  // 38: band.base.oracle.v1.Service.RequestCountProof:input_type -> band.base.oracle.v1.RequestCountProofRequest
+	6,  // 39: band.base.oracle.v1.Service.PriceProof:input_type -> band.base.oracle.v1.PriceProofRequest
+	8,  // 40: band.base.oracle.v1.Service.TunnelPacketProof:input_type -> band.base.oracle.v1.TunnelPacketProofRequest
+	1,  // 41: band.base.oracle.v1.Service.Proof:output_type -> band.base.oracle.v1.ProofResponse
+	3,  // 42: band.base.oracle.v1.Service.MultiProof:output_type -> band.base.oracle.v1.MultiProofResponse
+	5,  // 43: band.base.oracle.v1.Service.RequestCountProof:output_type -> band.base.oracle.v1.RequestCountProofResponse
+	7,  // 44: band.base.oracle.v1.Service.PriceProof:output_type -> band.base.oracle.v1.PriceProofResponse
+	9,  // 45: band.base.oracle.v1.Service.TunnelPacketProof:output_type -> band.base.oracle.v1.TunnelPacketProofResponse
+	41, // [41:46] is the sub-list for method output_type
+	36, // [36:41] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_band_base_oracle_v1_proof_proto_init() }
@@ -12890,7 +21088,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleProofResult); i {
+			switch v := v.(*PriceProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12902,7 +21100,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiProofResult); i {
+			switch v := v.(*PriceProofResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12914,7 +21112,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountProofResult); i {
+			switch v := v.(*TunnelPacketProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12926,7 +21124,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleProof); i {
+			switch v := v.(*TunnelPacketProofResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12938,7 +21136,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiProof); i {
+			switch v := v.(*SingleProofResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12950,7 +21148,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountProof); i {
+			switch v := v.(*MultiProofResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12962,7 +21160,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OracleDataProof); i {
+			switch v := v.(*CountProofResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12974,7 +21172,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IAVLMerklePath); i {
+			switch v := v.(*PriceProofResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12986,7 +21184,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockRelayProof); i {
+			switch v := v.(*PacketProofResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12998,7 +21196,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiStoreProof); i {
+			switch v := v.(*SingleProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13010,7 +21208,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHeaderMerkleParts); i {
+			switch v := v.(*MultiProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13022,7 +21220,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommonEncodedVotePart); i {
+			switch v := v.(*CountProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13034,7 +21232,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TMSignature); i {
+			switch v := v.(*PriceMultiProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13046,6 +21244,162 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			}
 		}
 		file_band_base_oracle_v1_proof_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_oracle_v1_proof_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OracleDataProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_oracle_v1_proof_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceDataProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_oracle_v1_proof_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketDataProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_oracle_v1_proof_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IAVLMerklePath); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_oracle_v1_proof_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockRelayProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_oracle_v1_proof_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreBlockRelayProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_oracle_v1_proof_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreMultiStoreProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_oracle_v1_proof_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiStoreMerklePath); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_oracle_v1_proof_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiStoreProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_oracle_v1_proof_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHeaderMerkleParts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_oracle_v1_proof_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommonEncodedVotePart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_oracle_v1_proof_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TMSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_oracle_v1_proof_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RequestsCountProof); i {
 			case 0:
 				return &v.state
@@ -13064,7 +21418,7 @@ func file_band_base_oracle_v1_proof_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_base_oracle_v1_proof_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,8 @@ const (
 	Service_Proof_FullMethodName             = "/band.base.oracle.v1.Service/Proof"
 	Service_MultiProof_FullMethodName        = "/band.base.oracle.v1.Service/MultiProof"
 	Service_RequestCountProof_FullMethodName = "/band.base.oracle.v1.Service/RequestCountProof"
+	Service_PriceProof_FullMethodName        = "/band.base.oracle.v1.Service/PriceProof"
+	Service_TunnelPacketProof_FullMethodName = "/band.base.oracle.v1.Service/TunnelPacketProof"
 )
 
 // ServiceClient is the client API for Service service.
@@ -34,6 +36,10 @@ type ServiceClient interface {
 	MultiProof(ctx context.Context, in *MultiProofRequest, opts ...grpc.CallOption) (*MultiProofResponse, error)
 	// RequestCountProof queries the count proof
 	RequestCountProof(ctx context.Context, in *RequestCountProofRequest, opts ...grpc.CallOption) (*RequestCountProofResponse, error)
+	// PriceProof queries the proof for the prices of given list of signal IDs in the feeds module
+	PriceProof(ctx context.Context, in *PriceProofRequest, opts ...grpc.CallOption) (*PriceProofResponse, error)
+	// TunnelPacketProof queries the proof for a packet of a tunnel
+	TunnelPacketProof(ctx context.Context, in *TunnelPacketProofRequest, opts ...grpc.CallOption) (*TunnelPacketProofResponse, error)
 }
 
 type serviceClient struct {
@@ -71,6 +77,24 @@ func (c *serviceClient) RequestCountProof(ctx context.Context, in *RequestCountP
 	return out, nil
 }
 
+func (c *serviceClient) PriceProof(ctx context.Context, in *PriceProofRequest, opts ...grpc.CallOption) (*PriceProofResponse, error) {
+	out := new(PriceProofResponse)
+	err := c.cc.Invoke(ctx, Service_PriceProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) TunnelPacketProof(ctx context.Context, in *TunnelPacketProofRequest, opts ...grpc.CallOption) (*TunnelPacketProofResponse, error) {
+	out := new(TunnelPacketProofResponse)
+	err := c.cc.Invoke(ctx, Service_TunnelPacketProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -81,6 +105,10 @@ type ServiceServer interface {
 	MultiProof(context.Context, *MultiProofRequest) (*MultiProofResponse, error)
 	// RequestCountProof queries the count proof
 	RequestCountProof(context.Context, *RequestCountProofRequest) (*RequestCountProofResponse, error)
+	// PriceProof queries the proof for the prices of given list of signal IDs in the feeds module
+	PriceProof(context.Context, *PriceProofRequest) (*PriceProofResponse, error)
+	// TunnelPacketProof queries the proof for a packet of a tunnel
+	TunnelPacketProof(context.Context, *TunnelPacketProofRequest) (*TunnelPacketProofResponse, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -97,6 +125,12 @@ func (UnimplementedServiceServer) MultiProof(context.Context, *MultiProofRequest
 func (UnimplementedServiceServer) RequestCountProof(context.Context, *RequestCountProofRequest) (*RequestCountProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestCountProof not implemented")
 }
+func (UnimplementedServiceServer) PriceProof(context.Context, *PriceProofRequest) (*PriceProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceProof not implemented")
+}
+func (UnimplementedServiceServer) TunnelPacketProof(context.Context, *TunnelPacketProofRequest) (*TunnelPacketProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TunnelPacketProof not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +198,42 @@ func _Service_RequestCountProof_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_PriceProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).PriceProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_PriceProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).PriceProof(ctx, req.(*PriceProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_TunnelPacketProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TunnelPacketProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).TunnelPacketProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_TunnelPacketProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).TunnelPacketProof(ctx, req.(*TunnelPacketProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +253,14 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestCountProof",
 			Handler:    _Service_RequestCountProof_Handler,
 		},
+		{
+			MethodName: "PriceProof",
+			Handler:    _Service_PriceProof_Handler,
+		},
+		{
+			MethodName: "TunnelPacketProof",
+			Handler:    _Service_TunnelPacketProof_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/base/oracle/v1/proof.proto",
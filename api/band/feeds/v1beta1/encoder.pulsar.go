@@ -32,6 +32,10 @@ const (
 	Encoder_ENCODER_FIXED_POINT_ABI Encoder = 1
 	// ENCODER_TICK_ABI is a tick abi encoder.
 	Encoder_ENCODER_TICK_ABI Encoder = 2
+	// ENCODER_FIXED_POINT_PACKED is a fixed-point price encoder with a tightly packed big-endian layout.
+	Encoder_ENCODER_FIXED_POINT_PACKED Encoder = 3
+	// ENCODER_FIXED_POINT_BORSH is a fixed-point price encoder with a Borsh layout.
+	Encoder_ENCODER_FIXED_POINT_BORSH Encoder = 4
 )
 
 // Enum value maps for Encoder.
@@ -40,11 +44,15 @@ var (
 		0: "ENCODER_UNSPECIFIED",
 		1: "ENCODER_FIXED_POINT_ABI",
 		2: "ENCODER_TICK_ABI",
+		3: "ENCODER_FIXED_POINT_PACKED",
+		4: "ENCODER_FIXED_POINT_BORSH",
 	}
 	Encoder_value = map[string]int32{
-		"ENCODER_UNSPECIFIED":     0,
-		"ENCODER_FIXED_POINT_ABI": 1,
-		"ENCODER_TICK_ABI":        2,
+		"ENCODER_UNSPECIFIED":        0,
+		"ENCODER_FIXED_POINT_ABI":    1,
+		"ENCODER_TICK_ABI":           2,
+		"ENCODER_FIXED_POINT_PACKED": 3,
+		"ENCODER_FIXED_POINT_BORSH":  4,
 	}
 )
 
@@ -82,13 +90,17 @@ var file_band_feeds_v1beta1_encoder_proto_rawDesc = []byte{
 	0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x12, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76,
 	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x5b, 0x0a, 0x07,
-	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43, 0x4f, 0x44,
-	0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x1b, 0x0a, 0x17, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x58, 0x45,
-	0x44, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x41, 0x42, 0x49, 0x10, 0x01, 0x12, 0x14, 0x0a,
-	0x10, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x49, 0x43, 0x4b, 0x5f, 0x41, 0x42,
-	0x49, 0x10, 0x02, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xd6, 0x01, 0x0a, 0x16, 0x63, 0x6f,
+	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x9a, 0x01, 0x0a,
+	0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43, 0x4f,
+	0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x58,
+	0x45, 0x44, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x41, 0x42, 0x49, 0x10, 0x01, 0x12, 0x14,
+	0x0a, 0x10, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x49, 0x43, 0x4b, 0x5f, 0x41,
+	0x42, 0x49, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f,
+	0x46, 0x49, 0x58, 0x45, 0x44, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x43, 0x4b,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f,
+	0x46, 0x49, 0x58, 0x45, 0x44, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x42, 0x4f, 0x52, 0x53,
+	0x48, 0x10, 0x04, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xd6, 0x01, 0x0a, 0x16, 0x63, 0x6f,
 	0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62,
 	0x65, 0x74, 0x61, 0x31, 0x42, 0x0c, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f,
 	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
@@ -1041,10 +1041,11 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Feed           protoreflect.MessageDescriptor
-	fd_Feed_signal_id protoreflect.FieldDescriptor
-	fd_Feed_power     protoreflect.FieldDescriptor
-	fd_Feed_interval  protoreflect.FieldDescriptor
+	md_Feed                    protoreflect.MessageDescriptor
+	fd_Feed_signal_id          protoreflect.FieldDescriptor
+	fd_Feed_power              protoreflect.FieldDescriptor
+	fd_Feed_interval           protoreflect.FieldDescriptor
+	fd_Feed_aggregation_method protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1053,6 +1054,7 @@ func init() {
 	fd_Feed_signal_id = md_Feed.Fields().ByName("signal_id")
 	fd_Feed_power = md_Feed.Fields().ByName("power")
 	fd_Feed_interval = md_Feed.Fields().ByName("interval")
+	fd_Feed_aggregation_method = md_Feed.Fields().ByName("aggregation_method")
 }
 
 var _ protoreflect.Message = (*fastReflection_Feed)(nil)
@@ -1138,6 +1140,12 @@ func (x *fastReflection_Feed) Range(f func(protoreflect.FieldDescriptor, protore
 			return
 		}
 	}
+	if x.AggregationMethod != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AggregationMethod))
+		if !f(fd_Feed_aggregation_method, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1159,6 +1167,8 @@ func (x *fastReflection_Feed) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Power != int64(0)
 	case "band.feeds.v1beta1.Feed.interval":
 		return x.Interval != int64(0)
+	case "band.feeds.v1beta1.Feed.aggregation_method":
+		return x.AggregationMethod != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
@@ -1181,6 +1191,8 @@ func (x *fastReflection_Feed) Clear(fd protoreflect.FieldDescriptor) {
 		x.Power = int64(0)
 	case "band.feeds.v1beta1.Feed.interval":
 		x.Interval = int64(0)
+	case "band.feeds.v1beta1.Feed.aggregation_method":
+		x.AggregationMethod = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
@@ -1206,6 +1218,9 @@ func (x *fastReflection_Feed) Get(descriptor protoreflect.FieldDescriptor) proto
 	case "band.feeds.v1beta1.Feed.interval":
 		value := x.Interval
 		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.Feed.aggregation_method":
+		value := x.AggregationMethod
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
@@ -1232,6 +1247,8 @@ func (x *fastReflection_Feed) Set(fd protoreflect.FieldDescriptor, value protore
 		x.Power = value.Int()
 	case "band.feeds.v1beta1.Feed.interval":
 		x.Interval = value.Int()
+	case "band.feeds.v1beta1.Feed.aggregation_method":
+		x.AggregationMethod = (AggregationMethod)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
@@ -1258,6 +1275,8 @@ func (x *fastReflection_Feed) Mutable(fd protoreflect.FieldDescriptor) protorefl
 		panic(fmt.Errorf("field power of message band.feeds.v1beta1.Feed is not mutable"))
 	case "band.feeds.v1beta1.Feed.interval":
 		panic(fmt.Errorf("field interval of message band.feeds.v1beta1.Feed is not mutable"))
+	case "band.feeds.v1beta1.Feed.aggregation_method":
+		panic(fmt.Errorf("field aggregation_method of message band.feeds.v1beta1.Feed is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
@@ -1277,6 +1296,8 @@ func (x *fastReflection_Feed) NewField(fd protoreflect.FieldDescriptor) protoref
 		return protoreflect.ValueOfInt64(int64(0))
 	case "band.feeds.v1beta1.Feed.interval":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.Feed.aggregation_method":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Feed"))
@@ -1356,6 +1377,9 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 		if x.Interval != 0 {
 			n += 1 + runtime.Sov(uint64(x.Interval))
 		}
+		if x.AggregationMethod != 0 {
+			n += 1 + runtime.Sov(uint64(x.AggregationMethod))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1385,6 +1409,11 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.AggregationMethod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AggregationMethod))
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.Interval != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
 			i--
@@ -1521,6 +1550,25 @@ func (x *fastReflection_Feed) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregationMethod", wireType)
+				}
+				x.AggregationMethod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AggregationMethod |= AggregationMethod(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1562,6 +1610,7 @@ var (
 	fd_FeedWithDeviation_power                 protoreflect.FieldDescriptor
 	fd_FeedWithDeviation_interval              protoreflect.FieldDescriptor
 	fd_FeedWithDeviation_deviation_basis_point protoreflect.FieldDescriptor
+	fd_FeedWithDeviation_aggregation_method    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1571,6 +1620,7 @@ func init() {
 	fd_FeedWithDeviation_power = md_FeedWithDeviation.Fields().ByName("power")
 	fd_FeedWithDeviation_interval = md_FeedWithDeviation.Fields().ByName("interval")
 	fd_FeedWithDeviation_deviation_basis_point = md_FeedWithDeviation.Fields().ByName("deviation_basis_point")
+	fd_FeedWithDeviation_aggregation_method = md_FeedWithDeviation.Fields().ByName("aggregation_method")
 }
 
 var _ protoreflect.Message = (*fastReflection_FeedWithDeviation)(nil)
@@ -1662,6 +1712,12 @@ func (x *fastReflection_FeedWithDeviation) Range(f func(protoreflect.FieldDescri
 			return
 		}
 	}
+	if x.AggregationMethod != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AggregationMethod))
+		if !f(fd_FeedWithDeviation_aggregation_method, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1685,6 +1741,8 @@ func (x *fastReflection_FeedWithDeviation) Has(fd protoreflect.FieldDescriptor)
 		return x.Interval != int64(0)
 	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
 		return x.DeviationBasisPoint != int64(0)
+	case "band.feeds.v1beta1.FeedWithDeviation.aggregation_method":
+		return x.AggregationMethod != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
@@ -1709,6 +1767,8 @@ func (x *fastReflection_FeedWithDeviation) Clear(fd protoreflect.FieldDescriptor
 		x.Interval = int64(0)
 	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
 		x.DeviationBasisPoint = int64(0)
+	case "band.feeds.v1beta1.FeedWithDeviation.aggregation_method":
+		x.AggregationMethod = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
@@ -1737,6 +1797,9 @@ func (x *fastReflection_FeedWithDeviation) Get(descriptor protoreflect.FieldDesc
 	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
 		value := x.DeviationBasisPoint
 		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.FeedWithDeviation.aggregation_method":
+		value := x.AggregationMethod
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
@@ -1765,6 +1828,8 @@ func (x *fastReflection_FeedWithDeviation) Set(fd protoreflect.FieldDescriptor,
 		x.Interval = value.Int()
 	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
 		x.DeviationBasisPoint = value.Int()
+	case "band.feeds.v1beta1.FeedWithDeviation.aggregation_method":
+		x.AggregationMethod = (AggregationMethod)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
@@ -1793,6 +1858,8 @@ func (x *fastReflection_FeedWithDeviation) Mutable(fd protoreflect.FieldDescript
 		panic(fmt.Errorf("field interval of message band.feeds.v1beta1.FeedWithDeviation is not mutable"))
 	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
 		panic(fmt.Errorf("field deviation_basis_point of message band.feeds.v1beta1.FeedWithDeviation is not mutable"))
+	case "band.feeds.v1beta1.FeedWithDeviation.aggregation_method":
+		panic(fmt.Errorf("field aggregation_method of message band.feeds.v1beta1.FeedWithDeviation is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
@@ -1814,6 +1881,8 @@ func (x *fastReflection_FeedWithDeviation) NewField(fd protoreflect.FieldDescrip
 		return protoreflect.ValueOfInt64(int64(0))
 	case "band.feeds.v1beta1.FeedWithDeviation.deviation_basis_point":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.FeedWithDeviation.aggregation_method":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedWithDeviation"))
@@ -1896,6 +1965,9 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 		if x.DeviationBasisPoint != 0 {
 			n += 1 + runtime.Sov(uint64(x.DeviationBasisPoint))
 		}
+		if x.AggregationMethod != 0 {
+			n += 1 + runtime.Sov(uint64(x.AggregationMethod))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1925,6 +1997,11 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.AggregationMethod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AggregationMethod))
+			i--
+			dAtA[i] = 0x28
+		}
 		if x.DeviationBasisPoint != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeviationBasisPoint))
 			i--
@@ -2085,6 +2162,25 @@ func (x *fastReflection_FeedWithDeviation) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregationMethod", wireType)
+				}
+				x.AggregationMethod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AggregationMethod |= AggregationMethod(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3207,31 +3303,1608 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Feeds = append(x.Feeds, &FeedWithDeviation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Feeds[len(x.Feeds)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+				}
+				x.LastUpdateTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastUpdateTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlock", wireType)
+				}
+				x.LastUpdateBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastUpdateBlock |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FeedAggregationMethod                    protoreflect.MessageDescriptor
+	fd_FeedAggregationMethod_signal_id          protoreflect.FieldDescriptor
+	fd_FeedAggregationMethod_aggregation_method protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_feeds_proto_init()
+	md_FeedAggregationMethod = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("FeedAggregationMethod")
+	fd_FeedAggregationMethod_signal_id = md_FeedAggregationMethod.Fields().ByName("signal_id")
+	fd_FeedAggregationMethod_aggregation_method = md_FeedAggregationMethod.Fields().ByName("aggregation_method")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeedAggregationMethod)(nil)
+
+type fastReflection_FeedAggregationMethod FeedAggregationMethod
+
+func (x *FeedAggregationMethod) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeedAggregationMethod)(x)
+}
+
+func (x *FeedAggregationMethod) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeedAggregationMethod_messageType fastReflection_FeedAggregationMethod_messageType
+var _ protoreflect.MessageType = fastReflection_FeedAggregationMethod_messageType{}
+
+type fastReflection_FeedAggregationMethod_messageType struct{}
+
+func (x fastReflection_FeedAggregationMethod_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeedAggregationMethod)(nil)
+}
+func (x fastReflection_FeedAggregationMethod_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeedAggregationMethod)
+}
+func (x fastReflection_FeedAggregationMethod_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeedAggregationMethod
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeedAggregationMethod) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeedAggregationMethod
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeedAggregationMethod) Type() protoreflect.MessageType {
+	return _fastReflection_FeedAggregationMethod_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeedAggregationMethod) New() protoreflect.Message {
+	return new(fastReflection_FeedAggregationMethod)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeedAggregationMethod) Interface() protoreflect.ProtoMessage {
+	return (*FeedAggregationMethod)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeedAggregationMethod) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_FeedAggregationMethod_signal_id, value) {
+			return
+		}
+	}
+	if x.AggregationMethod != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AggregationMethod))
+		if !f(fd_FeedAggregationMethod_aggregation_method, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeedAggregationMethod) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.FeedAggregationMethod.signal_id":
+		return x.SignalId != ""
+	case "band.feeds.v1beta1.FeedAggregationMethod.aggregation_method":
+		return x.AggregationMethod != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedAggregationMethod"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedAggregationMethod does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeedAggregationMethod) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.FeedAggregationMethod.signal_id":
+		x.SignalId = ""
+	case "band.feeds.v1beta1.FeedAggregationMethod.aggregation_method":
+		x.AggregationMethod = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedAggregationMethod"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedAggregationMethod does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeedAggregationMethod) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.FeedAggregationMethod.signal_id":
+		value := x.SignalId
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.FeedAggregationMethod.aggregation_method":
+		value := x.AggregationMethod
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedAggregationMethod"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedAggregationMethod does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeedAggregationMethod) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.FeedAggregationMethod.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.feeds.v1beta1.FeedAggregationMethod.aggregation_method":
+		x.AggregationMethod = (AggregationMethod)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedAggregationMethod"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedAggregationMethod does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeedAggregationMethod) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.FeedAggregationMethod.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.FeedAggregationMethod is not mutable"))
+	case "band.feeds.v1beta1.FeedAggregationMethod.aggregation_method":
+		panic(fmt.Errorf("field aggregation_method of message band.feeds.v1beta1.FeedAggregationMethod is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedAggregationMethod"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedAggregationMethod does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeedAggregationMethod) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.FeedAggregationMethod.signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.FeedAggregationMethod.aggregation_method":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.FeedAggregationMethod"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.FeedAggregationMethod does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeedAggregationMethod) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.FeedAggregationMethod", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeedAggregationMethod) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeedAggregationMethod) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeedAggregationMethod) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeedAggregationMethod) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeedAggregationMethod)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AggregationMethod != 0 {
+			n += 1 + runtime.Sov(uint64(x.AggregationMethod))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeedAggregationMethod)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AggregationMethod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AggregationMethod))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeedAggregationMethod)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeedAggregationMethod: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeedAggregationMethod: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregationMethod", wireType)
+				}
+				x.AggregationMethod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AggregationMethod |= AggregationMethod(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CircuitBreakerConfig                           protoreflect.MessageDescriptor
+	fd_CircuitBreakerConfig_signal_id                 protoreflect.FieldDescriptor
+	fd_CircuitBreakerConfig_max_deviation_basis_point protoreflect.FieldDescriptor
+	fd_CircuitBreakerConfig_confirmation_window       protoreflect.FieldDescriptor
+	fd_CircuitBreakerConfig_outlier_sigma_threshold   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_feeds_proto_init()
+	md_CircuitBreakerConfig = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("CircuitBreakerConfig")
+	fd_CircuitBreakerConfig_signal_id = md_CircuitBreakerConfig.Fields().ByName("signal_id")
+	fd_CircuitBreakerConfig_max_deviation_basis_point = md_CircuitBreakerConfig.Fields().ByName("max_deviation_basis_point")
+	fd_CircuitBreakerConfig_confirmation_window = md_CircuitBreakerConfig.Fields().ByName("confirmation_window")
+	fd_CircuitBreakerConfig_outlier_sigma_threshold = md_CircuitBreakerConfig.Fields().ByName("outlier_sigma_threshold")
+}
+
+var _ protoreflect.Message = (*fastReflection_CircuitBreakerConfig)(nil)
+
+type fastReflection_CircuitBreakerConfig CircuitBreakerConfig
+
+func (x *CircuitBreakerConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CircuitBreakerConfig)(x)
+}
+
+func (x *CircuitBreakerConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CircuitBreakerConfig_messageType fastReflection_CircuitBreakerConfig_messageType
+var _ protoreflect.MessageType = fastReflection_CircuitBreakerConfig_messageType{}
+
+type fastReflection_CircuitBreakerConfig_messageType struct{}
+
+func (x fastReflection_CircuitBreakerConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CircuitBreakerConfig)(nil)
+}
+func (x fastReflection_CircuitBreakerConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_CircuitBreakerConfig)
+}
+func (x fastReflection_CircuitBreakerConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CircuitBreakerConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CircuitBreakerConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_CircuitBreakerConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CircuitBreakerConfig) Type() protoreflect.MessageType {
+	return _fastReflection_CircuitBreakerConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CircuitBreakerConfig) New() protoreflect.Message {
+	return new(fastReflection_CircuitBreakerConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CircuitBreakerConfig) Interface() protoreflect.ProtoMessage {
+	return (*CircuitBreakerConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CircuitBreakerConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_CircuitBreakerConfig_signal_id, value) {
+			return
+		}
+	}
+	if x.MaxDeviationBasisPoint != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxDeviationBasisPoint)
+		if !f(fd_CircuitBreakerConfig_max_deviation_basis_point, value) {
+			return
+		}
+	}
+	if x.ConfirmationWindow != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ConfirmationWindow)
+		if !f(fd_CircuitBreakerConfig_confirmation_window, value) {
+			return
+		}
+	}
+	if x.OutlierSigmaThreshold != int64(0) {
+		value := protoreflect.ValueOfInt64(x.OutlierSigmaThreshold)
+		if !f(fd_CircuitBreakerConfig_outlier_sigma_threshold, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CircuitBreakerConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.CircuitBreakerConfig.signal_id":
+		return x.SignalId != ""
+	case "band.feeds.v1beta1.CircuitBreakerConfig.max_deviation_basis_point":
+		return x.MaxDeviationBasisPoint != int64(0)
+	case "band.feeds.v1beta1.CircuitBreakerConfig.confirmation_window":
+		return x.ConfirmationWindow != int64(0)
+	case "band.feeds.v1beta1.CircuitBreakerConfig.outlier_sigma_threshold":
+		return x.OutlierSigmaThreshold != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CircuitBreakerConfig"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CircuitBreakerConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreakerConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.CircuitBreakerConfig.signal_id":
+		x.SignalId = ""
+	case "band.feeds.v1beta1.CircuitBreakerConfig.max_deviation_basis_point":
+		x.MaxDeviationBasisPoint = int64(0)
+	case "band.feeds.v1beta1.CircuitBreakerConfig.confirmation_window":
+		x.ConfirmationWindow = int64(0)
+	case "band.feeds.v1beta1.CircuitBreakerConfig.outlier_sigma_threshold":
+		x.OutlierSigmaThreshold = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CircuitBreakerConfig"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CircuitBreakerConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CircuitBreakerConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.CircuitBreakerConfig.signal_id":
+		value := x.SignalId
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.CircuitBreakerConfig.max_deviation_basis_point":
+		value := x.MaxDeviationBasisPoint
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.CircuitBreakerConfig.confirmation_window":
+		value := x.ConfirmationWindow
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.CircuitBreakerConfig.outlier_sigma_threshold":
+		value := x.OutlierSigmaThreshold
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CircuitBreakerConfig"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CircuitBreakerConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreakerConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.CircuitBreakerConfig.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.feeds.v1beta1.CircuitBreakerConfig.max_deviation_basis_point":
+		x.MaxDeviationBasisPoint = value.Int()
+	case "band.feeds.v1beta1.CircuitBreakerConfig.confirmation_window":
+		x.ConfirmationWindow = value.Int()
+	case "band.feeds.v1beta1.CircuitBreakerConfig.outlier_sigma_threshold":
+		x.OutlierSigmaThreshold = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CircuitBreakerConfig"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CircuitBreakerConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreakerConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.CircuitBreakerConfig.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.CircuitBreakerConfig is not mutable"))
+	case "band.feeds.v1beta1.CircuitBreakerConfig.max_deviation_basis_point":
+		panic(fmt.Errorf("field max_deviation_basis_point of message band.feeds.v1beta1.CircuitBreakerConfig is not mutable"))
+	case "band.feeds.v1beta1.CircuitBreakerConfig.confirmation_window":
+		panic(fmt.Errorf("field confirmation_window of message band.feeds.v1beta1.CircuitBreakerConfig is not mutable"))
+	case "band.feeds.v1beta1.CircuitBreakerConfig.outlier_sigma_threshold":
+		panic(fmt.Errorf("field outlier_sigma_threshold of message band.feeds.v1beta1.CircuitBreakerConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CircuitBreakerConfig"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CircuitBreakerConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CircuitBreakerConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.CircuitBreakerConfig.signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.CircuitBreakerConfig.max_deviation_basis_point":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.CircuitBreakerConfig.confirmation_window":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.CircuitBreakerConfig.outlier_sigma_threshold":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CircuitBreakerConfig"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CircuitBreakerConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CircuitBreakerConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.CircuitBreakerConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CircuitBreakerConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreakerConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CircuitBreakerConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CircuitBreakerConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CircuitBreakerConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxDeviationBasisPoint != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxDeviationBasisPoint))
+		}
+		if x.ConfirmationWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.ConfirmationWindow))
+		}
+		if x.OutlierSigmaThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutlierSigmaThreshold))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CircuitBreakerConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.OutlierSigmaThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutlierSigmaThreshold))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ConfirmationWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ConfirmationWindow))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxDeviationBasisPoint != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxDeviationBasisPoint))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CircuitBreakerConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CircuitBreakerConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CircuitBreakerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDeviationBasisPoint", wireType)
+				}
+				x.MaxDeviationBasisPoint = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxDeviationBasisPoint |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConfirmationWindow", wireType)
+				}
+				x.ConfirmationWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ConfirmationWindow |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutlierSigmaThreshold", wireType)
+				}
+				x.OutlierSigmaThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutlierSigmaThreshold |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CircuitBreakerState                     protoreflect.MessageDescriptor
+	fd_CircuitBreakerState_signal_id           protoreflect.FieldDescriptor
+	fd_CircuitBreakerState_reference_price     protoreflect.FieldDescriptor
+	fd_CircuitBreakerState_reference_timestamp protoreflect.FieldDescriptor
+	fd_CircuitBreakerState_halted_at           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_feeds_v1beta1_feeds_proto_init()
+	md_CircuitBreakerState = File_band_feeds_v1beta1_feeds_proto.Messages().ByName("CircuitBreakerState")
+	fd_CircuitBreakerState_signal_id = md_CircuitBreakerState.Fields().ByName("signal_id")
+	fd_CircuitBreakerState_reference_price = md_CircuitBreakerState.Fields().ByName("reference_price")
+	fd_CircuitBreakerState_reference_timestamp = md_CircuitBreakerState.Fields().ByName("reference_timestamp")
+	fd_CircuitBreakerState_halted_at = md_CircuitBreakerState.Fields().ByName("halted_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_CircuitBreakerState)(nil)
+
+type fastReflection_CircuitBreakerState CircuitBreakerState
+
+func (x *CircuitBreakerState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CircuitBreakerState)(x)
+}
+
+func (x *CircuitBreakerState) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CircuitBreakerState_messageType fastReflection_CircuitBreakerState_messageType
+var _ protoreflect.MessageType = fastReflection_CircuitBreakerState_messageType{}
+
+type fastReflection_CircuitBreakerState_messageType struct{}
+
+func (x fastReflection_CircuitBreakerState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CircuitBreakerState)(nil)
+}
+func (x fastReflection_CircuitBreakerState_messageType) New() protoreflect.Message {
+	return new(fastReflection_CircuitBreakerState)
+}
+func (x fastReflection_CircuitBreakerState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CircuitBreakerState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CircuitBreakerState) Descriptor() protoreflect.MessageDescriptor {
+	return md_CircuitBreakerState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CircuitBreakerState) Type() protoreflect.MessageType {
+	return _fastReflection_CircuitBreakerState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CircuitBreakerState) New() protoreflect.Message {
+	return new(fastReflection_CircuitBreakerState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CircuitBreakerState) Interface() protoreflect.ProtoMessage {
+	return (*CircuitBreakerState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CircuitBreakerState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_CircuitBreakerState_signal_id, value) {
+			return
+		}
+	}
+	if x.ReferencePrice != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReferencePrice)
+		if !f(fd_CircuitBreakerState_reference_price, value) {
+			return
+		}
+	}
+	if x.ReferenceTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ReferenceTimestamp)
+		if !f(fd_CircuitBreakerState_reference_timestamp, value) {
+			return
+		}
+	}
+	if x.HaltedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.HaltedAt)
+		if !f(fd_CircuitBreakerState_halted_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CircuitBreakerState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.CircuitBreakerState.signal_id":
+		return x.SignalId != ""
+	case "band.feeds.v1beta1.CircuitBreakerState.reference_price":
+		return x.ReferencePrice != uint64(0)
+	case "band.feeds.v1beta1.CircuitBreakerState.reference_timestamp":
+		return x.ReferenceTimestamp != int64(0)
+	case "band.feeds.v1beta1.CircuitBreakerState.halted_at":
+		return x.HaltedAt != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CircuitBreakerState"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CircuitBreakerState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreakerState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.CircuitBreakerState.signal_id":
+		x.SignalId = ""
+	case "band.feeds.v1beta1.CircuitBreakerState.reference_price":
+		x.ReferencePrice = uint64(0)
+	case "band.feeds.v1beta1.CircuitBreakerState.reference_timestamp":
+		x.ReferenceTimestamp = int64(0)
+	case "band.feeds.v1beta1.CircuitBreakerState.halted_at":
+		x.HaltedAt = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CircuitBreakerState"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CircuitBreakerState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CircuitBreakerState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.feeds.v1beta1.CircuitBreakerState.signal_id":
+		value := x.SignalId
+		return protoreflect.ValueOfString(value)
+	case "band.feeds.v1beta1.CircuitBreakerState.reference_price":
+		value := x.ReferencePrice
+		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.CircuitBreakerState.reference_timestamp":
+		value := x.ReferenceTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.CircuitBreakerState.halted_at":
+		value := x.HaltedAt
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CircuitBreakerState"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CircuitBreakerState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreakerState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.CircuitBreakerState.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.feeds.v1beta1.CircuitBreakerState.reference_price":
+		x.ReferencePrice = value.Uint()
+	case "band.feeds.v1beta1.CircuitBreakerState.reference_timestamp":
+		x.ReferenceTimestamp = value.Int()
+	case "band.feeds.v1beta1.CircuitBreakerState.halted_at":
+		x.HaltedAt = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CircuitBreakerState"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CircuitBreakerState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreakerState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.CircuitBreakerState.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.feeds.v1beta1.CircuitBreakerState is not mutable"))
+	case "band.feeds.v1beta1.CircuitBreakerState.reference_price":
+		panic(fmt.Errorf("field reference_price of message band.feeds.v1beta1.CircuitBreakerState is not mutable"))
+	case "band.feeds.v1beta1.CircuitBreakerState.reference_timestamp":
+		panic(fmt.Errorf("field reference_timestamp of message band.feeds.v1beta1.CircuitBreakerState is not mutable"))
+	case "band.feeds.v1beta1.CircuitBreakerState.halted_at":
+		panic(fmt.Errorf("field halted_at of message band.feeds.v1beta1.CircuitBreakerState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CircuitBreakerState"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CircuitBreakerState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CircuitBreakerState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.feeds.v1beta1.CircuitBreakerState.signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.feeds.v1beta1.CircuitBreakerState.reference_price":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.CircuitBreakerState.reference_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.CircuitBreakerState.halted_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.CircuitBreakerState"))
+		}
+		panic(fmt.Errorf("message band.feeds.v1beta1.CircuitBreakerState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CircuitBreakerState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.feeds.v1beta1.CircuitBreakerState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CircuitBreakerState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreakerState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CircuitBreakerState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CircuitBreakerState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CircuitBreakerState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReferencePrice != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReferencePrice))
+		}
+		if x.ReferenceTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReferenceTimestamp))
+		}
+		if x.HaltedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.HaltedAt))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CircuitBreakerState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.HaltedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HaltedAt))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ReferenceTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReferenceTimestamp))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ReferencePrice != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReferencePrice))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CircuitBreakerState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CircuitBreakerState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CircuitBreakerState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Feeds = append(x.Feeds, &FeedWithDeviation{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Feeds[len(x.Feeds)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.SignalId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
 				}
-				x.LastUpdateTimestamp = 0
+				x.ReferencePrice = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3241,16 +4914,16 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.LastUpdateTimestamp |= int64(b&0x7F) << shift
+					x.ReferencePrice |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdateBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReferenceTimestamp", wireType)
 				}
-				x.LastUpdateBlock = 0
+				x.ReferenceTimestamp = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3260,7 +4933,26 @@ func (x *fastReflection_CurrentFeedWithDeviations) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.LastUpdateBlock |= int64(b&0x7F) << shift
+					x.ReferenceTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HaltedAt", wireType)
+				}
+				x.HaltedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HaltedAt |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -3326,7 +5018,7 @@ func (x *Price) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Price) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[6]
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3888,7 +5580,7 @@ func (x *SignalPrice) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SignalPrice) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[7]
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4408,7 +6100,7 @@ func (x *ValidatorPrice) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ValidatorPrice) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[8]
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5065,7 +6757,7 @@ func (x *ValidatorPriceList) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ValidatorPriceList) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[9]
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5572,7 +7264,7 @@ func (x *ReferenceSourceConfig) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ReferenceSourceConfig) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[10]
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6102,7 +7794,7 @@ func (x *FeedsSignatureOrder) ProtoReflect() protoreflect.Message {
 }
 
 func (x *FeedsSignatureOrder) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[11]
+	mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6589,6 +8281,9 @@ const (
 	PriceStatus_PRICE_STATUS_AVAILABLE PriceStatus = 3
 	// PRICE_STATUS_NOT_IN_CURRENT_FEEDS is a not in current feed price status.
 	PriceStatus_PRICE_STATUS_NOT_IN_CURRENT_FEEDS PriceStatus = 4
+	// PRICE_STATUS_HALTED is a halted price status that holds the previous price because the circuit breaker
+	// of the signal id is tripped.
+	PriceStatus_PRICE_STATUS_HALTED PriceStatus = 5
 )
 
 // Enum value maps for PriceStatus.
@@ -6599,6 +8294,7 @@ var (
 		2: "PRICE_STATUS_NOT_READY",
 		3: "PRICE_STATUS_AVAILABLE",
 		4: "PRICE_STATUS_NOT_IN_CURRENT_FEEDS",
+		5: "PRICE_STATUS_HALTED",
 	}
 	PriceStatus_value = map[string]int32{
 		"PRICE_STATUS_UNSPECIFIED":          0,
@@ -6606,6 +8302,7 @@ var (
 		"PRICE_STATUS_NOT_READY":            2,
 		"PRICE_STATUS_AVAILABLE":            3,
 		"PRICE_STATUS_NOT_IN_CURRENT_FEEDS": 4,
+		"PRICE_STATUS_HALTED":               5,
 	}
 )
 
@@ -6636,6 +8333,64 @@ func (PriceStatus) EnumDescriptor() ([]byte, []int) {
 	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{0}
 }
 
+// AggregationMethod is an enumerator that defines the method used to aggregate validator prices into a price.
+type AggregationMethod int32
+
+const (
+	// AGGREGATION_METHOD_UNSPECIFIED is an unspecified aggregation method that falls back to the weighted median.
+	AggregationMethod_AGGREGATION_METHOD_UNSPECIFIED AggregationMethod = 0
+	// AGGREGATION_METHOD_WEIGHTED_MEDIAN is a power-weighted median with time-decay multipliers.
+	AggregationMethod_AGGREGATION_METHOD_WEIGHTED_MEDIAN AggregationMethod = 1
+	// AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN is a power-weighted mean after trimming the lowest and highest
+	// quarter of the power.
+	AggregationMethod_AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN AggregationMethod = 2
+	// AGGREGATION_METHOD_WEIGHTED_MEAN is a power-weighted mean, similar to a VWAP without volume.
+	AggregationMethod_AGGREGATION_METHOD_WEIGHTED_MEAN AggregationMethod = 3
+)
+
+// Enum value maps for AggregationMethod.
+var (
+	AggregationMethod_name = map[int32]string{
+		0: "AGGREGATION_METHOD_UNSPECIFIED",
+		1: "AGGREGATION_METHOD_WEIGHTED_MEDIAN",
+		2: "AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN",
+		3: "AGGREGATION_METHOD_WEIGHTED_MEAN",
+	}
+	AggregationMethod_value = map[string]int32{
+		"AGGREGATION_METHOD_UNSPECIFIED":           0,
+		"AGGREGATION_METHOD_WEIGHTED_MEDIAN":       1,
+		"AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN": 2,
+		"AGGREGATION_METHOD_WEIGHTED_MEAN":         3,
+	}
+)
+
+func (x AggregationMethod) Enum() *AggregationMethod {
+	p := new(AggregationMethod)
+	*p = x
+	return p
+}
+
+func (x AggregationMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AggregationMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_feeds_v1beta1_feeds_proto_enumTypes[1].Descriptor()
+}
+
+func (AggregationMethod) Type() protoreflect.EnumType {
+	return &file_band_feeds_v1beta1_feeds_proto_enumTypes[1]
+}
+
+func (x AggregationMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AggregationMethod.Descriptor instead.
+func (AggregationMethod) EnumDescriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{1}
+}
+
 // SignalPriceStatus is a structure that defines the price status of a signal id.
 type SignalPriceStatus int32
 
@@ -6677,11 +8432,11 @@ func (x SignalPriceStatus) String() string {
 }
 
 func (SignalPriceStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_band_feeds_v1beta1_feeds_proto_enumTypes[1].Descriptor()
+	return file_band_feeds_v1beta1_feeds_proto_enumTypes[2].Descriptor()
 }
 
 func (SignalPriceStatus) Type() protoreflect.EnumType {
-	return &file_band_feeds_v1beta1_feeds_proto_enumTypes[1]
+	return &file_band_feeds_v1beta1_feeds_proto_enumTypes[2]
 }
 
 func (x SignalPriceStatus) Number() protoreflect.EnumNumber {
@@ -6690,7 +8445,7 @@ func (x SignalPriceStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SignalPriceStatus.Descriptor instead.
 func (SignalPriceStatus) EnumDescriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{1}
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{2}
 }
 
 // Signal is the data structure that contains signal id and power of that signal.
@@ -6797,6 +8552,8 @@ type Feed struct {
 	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
 	// interval is the interval of the price feed.
 	Interval int64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// aggregation_method is the method used to aggregate validator prices of the feed.
+	AggregationMethod AggregationMethod `protobuf:"varint,4,opt,name=aggregation_method,json=aggregationMethod,proto3,enum=band.feeds.v1beta1.AggregationMethod" json:"aggregation_method,omitempty"`
 }
 
 func (x *Feed) Reset() {
@@ -6840,6 +8597,13 @@ func (x *Feed) GetInterval() int64 {
 	return 0
 }
 
+func (x *Feed) GetAggregationMethod() AggregationMethod {
+	if x != nil {
+		return x.AggregationMethod
+	}
+	return AggregationMethod_AGGREGATION_METHOD_UNSPECIFIED
+}
+
 // FeedWithDeviation is a structure that holds a signal id, its total power, and its calculated interval and deviation.
 type FeedWithDeviation struct {
 	state         protoimpl.MessageState
@@ -6854,6 +8618,8 @@ type FeedWithDeviation struct {
 	Interval int64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
 	// deviation_basis_point is the maximum deviation value the feed can tolerate, expressed in basis points.
 	DeviationBasisPoint int64 `protobuf:"varint,4,opt,name=deviation_basis_point,json=deviationBasisPoint,proto3" json:"deviation_basis_point,omitempty"`
+	// aggregation_method is the method used to aggregate validator prices of the feed.
+	AggregationMethod AggregationMethod `protobuf:"varint,5,opt,name=aggregation_method,json=aggregationMethod,proto3,enum=band.feeds.v1beta1.AggregationMethod" json:"aggregation_method,omitempty"`
 }
 
 func (x *FeedWithDeviation) Reset() {
@@ -6904,6 +8670,13 @@ func (x *FeedWithDeviation) GetDeviationBasisPoint() int64 {
 	return 0
 }
 
+func (x *FeedWithDeviation) GetAggregationMethod() AggregationMethod {
+	if x != nil {
+		return x.AggregationMethod
+	}
+	return AggregationMethod_AGGREGATION_METHOD_UNSPECIFIED
+}
+
 // CurrentFeeds is a structure that holds a list of currently supported feeds, and its last update time and block.
 type CurrentFeeds struct {
 	state         protoimpl.MessageState
@@ -7015,6 +8788,182 @@ func (x *CurrentFeedWithDeviations) GetLastUpdateBlock() int64 {
 	return 0
 }
 
+// FeedAggregationMethod is a structure that defines the aggregation method of a signal id.
+type FeedAggregationMethod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signal_id is the signal id of the feed.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// aggregation_method is the method used to aggregate validator prices of the feed.
+	AggregationMethod AggregationMethod `protobuf:"varint,2,opt,name=aggregation_method,json=aggregationMethod,proto3,enum=band.feeds.v1beta1.AggregationMethod" json:"aggregation_method,omitempty"`
+}
+
+func (x *FeedAggregationMethod) Reset() {
+	*x = FeedAggregationMethod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedAggregationMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedAggregationMethod) ProtoMessage() {}
+
+// Deprecated: Use FeedAggregationMethod.ProtoReflect.Descriptor instead.
+func (*FeedAggregationMethod) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FeedAggregationMethod) GetSignalId() string {
+	if x != nil {
+		return x.SignalId
+	}
+	return ""
+}
+
+func (x *FeedAggregationMethod) GetAggregationMethod() AggregationMethod {
+	if x != nil {
+		return x.AggregationMethod
+	}
+	return AggregationMethod_AGGREGATION_METHOD_UNSPECIFIED
+}
+
+// CircuitBreakerConfig is a structure that defines the circuit breaker configuration of a signal id.
+type CircuitBreakerConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signal_id is the signal id of the feed.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// max_deviation_basis_point is the maximum deviation in basis point of a new price from the previous price
+	// before the price is halted. Zero disables the halting.
+	MaxDeviationBasisPoint int64 `protobuf:"varint,2,opt,name=max_deviation_basis_point,json=maxDeviationBasisPoint,proto3" json:"max_deviation_basis_point,omitempty"`
+	// confirmation_window is the duration in seconds that a deviated price has to persist before it is published.
+	ConfirmationWindow int64 `protobuf:"varint,3,opt,name=confirmation_window,json=confirmationWindow,proto3" json:"confirmation_window,omitempty"`
+	// outlier_sigma_threshold is the number of standard deviations from the median beyond which a validator price
+	// is flagged as an outlier. Zero disables the flagging.
+	OutlierSigmaThreshold int64 `protobuf:"varint,4,opt,name=outlier_sigma_threshold,json=outlierSigmaThreshold,proto3" json:"outlier_sigma_threshold,omitempty"`
+}
+
+func (x *CircuitBreakerConfig) Reset() {
+	*x = CircuitBreakerConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CircuitBreakerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreakerConfig) ProtoMessage() {}
+
+// Deprecated: Use CircuitBreakerConfig.ProtoReflect.Descriptor instead.
+func (*CircuitBreakerConfig) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CircuitBreakerConfig) GetSignalId() string {
+	if x != nil {
+		return x.SignalId
+	}
+	return ""
+}
+
+func (x *CircuitBreakerConfig) GetMaxDeviationBasisPoint() int64 {
+	if x != nil {
+		return x.MaxDeviationBasisPoint
+	}
+	return 0
+}
+
+func (x *CircuitBreakerConfig) GetConfirmationWindow() int64 {
+	if x != nil {
+		return x.ConfirmationWindow
+	}
+	return 0
+}
+
+func (x *CircuitBreakerConfig) GetOutlierSigmaThreshold() int64 {
+	if x != nil {
+		return x.OutlierSigmaThreshold
+	}
+	return 0
+}
+
+// CircuitBreakerState is a structure that defines the circuit breaker state of a signal id.
+type CircuitBreakerState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signal_id is the signal id of the feed.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// reference_price is the last published price that new prices are compared with.
+	ReferencePrice uint64 `protobuf:"varint,2,opt,name=reference_price,json=referencePrice,proto3" json:"reference_price,omitempty"`
+	// reference_timestamp is the timestamp of the reference price.
+	ReferenceTimestamp int64 `protobuf:"varint,3,opt,name=reference_timestamp,json=referenceTimestamp,proto3" json:"reference_timestamp,omitempty"`
+	// halted_at is the timestamp at which the price was halted, or zero if the price is not halted.
+	HaltedAt int64 `protobuf:"varint,4,opt,name=halted_at,json=haltedAt,proto3" json:"halted_at,omitempty"`
+}
+
+func (x *CircuitBreakerState) Reset() {
+	*x = CircuitBreakerState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CircuitBreakerState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreakerState) ProtoMessage() {}
+
+// Deprecated: Use CircuitBreakerState.ProtoReflect.Descriptor instead.
+func (*CircuitBreakerState) Descriptor() ([]byte, []int) {
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CircuitBreakerState) GetSignalId() string {
+	if x != nil {
+		return x.SignalId
+	}
+	return ""
+}
+
+func (x *CircuitBreakerState) GetReferencePrice() uint64 {
+	if x != nil {
+		return x.ReferencePrice
+	}
+	return 0
+}
+
+func (x *CircuitBreakerState) GetReferenceTimestamp() int64 {
+	if x != nil {
+		return x.ReferenceTimestamp
+	}
+	return 0
+}
+
+func (x *CircuitBreakerState) GetHaltedAt() int64 {
+	if x != nil {
+		return x.HaltedAt
+	}
+	return 0
+}
+
 // Price is a structure that defines the price of a signal id.
 type Price struct {
 	state         protoimpl.MessageState
@@ -7034,7 +8983,7 @@ type Price struct {
 func (x *Price) Reset() {
 	*x = Price{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[6]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7048,7 +8997,7 @@ func (*Price) ProtoMessage() {}
 
 // Deprecated: Use Price.ProtoReflect.Descriptor instead.
 func (*Price) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{6}
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Price) GetStatus() PriceStatus {
@@ -7096,7 +9045,7 @@ type SignalPrice struct {
 func (x *SignalPrice) Reset() {
 	*x = SignalPrice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[7]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7110,7 +9059,7 @@ func (*SignalPrice) ProtoMessage() {}
 
 // Deprecated: Use SignalPrice.ProtoReflect.Descriptor instead.
 func (*SignalPrice) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{7}
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SignalPrice) GetStatus() SignalPriceStatus {
@@ -7155,7 +9104,7 @@ type ValidatorPrice struct {
 func (x *ValidatorPrice) Reset() {
 	*x = ValidatorPrice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[8]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7169,7 +9118,7 @@ func (*ValidatorPrice) ProtoMessage() {}
 
 // Deprecated: Use ValidatorPrice.ProtoReflect.Descriptor instead.
 func (*ValidatorPrice) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{8}
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ValidatorPrice) GetSignalPriceStatus() SignalPriceStatus {
@@ -7223,7 +9172,7 @@ type ValidatorPriceList struct {
 func (x *ValidatorPriceList) Reset() {
 	*x = ValidatorPriceList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[9]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7237,7 +9186,7 @@ func (*ValidatorPriceList) ProtoMessage() {}
 
 // Deprecated: Use ValidatorPriceList.ProtoReflect.Descriptor instead.
 func (*ValidatorPriceList) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{9}
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ValidatorPriceList) GetValidator() string {
@@ -7269,7 +9218,7 @@ type ReferenceSourceConfig struct {
 func (x *ReferenceSourceConfig) Reset() {
 	*x = ReferenceSourceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[10]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7283,7 +9232,7 @@ func (*ReferenceSourceConfig) ProtoMessage() {}
 
 // Deprecated: Use ReferenceSourceConfig.ProtoReflect.Descriptor instead.
 func (*ReferenceSourceConfig) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{10}
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ReferenceSourceConfig) GetRegistryIpfsHash() string {
@@ -7315,7 +9264,7 @@ type FeedsSignatureOrder struct {
 func (x *FeedsSignatureOrder) Reset() {
 	*x = FeedsSignatureOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[11]
+		mi := &file_band_feeds_v1beta1_feeds_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7329,7 +9278,7 @@ func (*FeedsSignatureOrder) ProtoMessage() {}
 
 // Deprecated: Use FeedsSignatureOrder.ProtoReflect.Descriptor instead.
 func (*FeedsSignatureOrder) Descriptor() ([]byte, []int) {
-	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{11}
+	return file_band_feeds_v1beta1_feeds_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *FeedsSignatureOrder) GetSignalIds() []string {
@@ -7369,147 +9318,207 @@ var file_band_feeds_v1beta1_feeds_proto_rawDesc = []byte{
 	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
 	0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
 	0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0x69, 0x0a, 0x04, 0x46, 0x65, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0xbf, 0x01, 0x0a, 0x04, 0x46, 0x65, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f,
+	0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x54, 0x0a, 0x12, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x11, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
+	0x01, 0x22, 0x80, 0x02, 0x0a, 0x11, 0x46, 0x65, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65,
+	0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
 	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08,
 	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
 	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
 	0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xaa, 0x01, 0x0a, 0x11, 0x46,
-	0x65, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49,
-	0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70,
-	0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65,
-	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x32, 0x0a,
-	0x15, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73,
-	0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x65,
-	0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xaa, 0x01, 0x0a, 0x0c, 0x43, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x05, 0x66, 0x65, 0x65, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66,
-	0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x65, 0x65,
-	0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x12, 0x32,
-	0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c,
-	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c,
-	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x04,
-	0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc4, 0x01, 0x0a, 0x19, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x46, 0x65, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x41, 0x0a, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x44,
-	0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05,
-	0x66, 0x65, 0x65, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa5, 0x01, 0x0a, 0x05,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65,
-	0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52,
-	0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3a, 0x04, 0xe8,
-	0xa0, 0x1f, 0x01, 0x22, 0x93, 0x01, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xef, 0x01, 0x0a, 0x0e, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x13,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61,
+	0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x54, 0x0a, 0x12, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x11, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x3a, 0x04,
+	0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xaa, 0x01, 0x0a, 0x0c, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
+	0x01, 0x22, 0xc4, 0x01, 0x0a, 0x19, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65,
+	0x64, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x41, 0x0a, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x76, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x66, 0x65, 0x65,
+	0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x9e, 0x01, 0x0a, 0x15, 0x46, 0x65, 0x65,
+	0x64, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x54, 0x0a,
+	0x12, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x52, 0x11, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xeb, 0x01, 0x0a, 0x14, 0x43, 0x69,
+	0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x39, 0x0a,
+	0x19, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62,
+	0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x16, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61,
+	0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x36, 0x0a, 0x17, 0x6f, 0x75, 0x74,
+	0x6c, 0x69, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6d, 0x61, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x6f, 0x75, 0x74, 0x6c,
+	0x69, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6d, 0x61, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb7, 0x01, 0x0a, 0x13, 0x43, 0x69, 0x72, 0x63,
+	0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44,
+	0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xa5, 0x01, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x93, 0x01, 0x0a, 0x0b, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61,
 	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64,
 	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53,
 	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb0, 0x01, 0x0a, 0x12,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x53, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x8c,
-	0x01, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x42, 0x0a, 0x12, 0x72, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x70, 0x66, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x79, 0x49, 0x50, 0x46, 0x53, 0x48, 0x61, 0x73, 0x68, 0x52, 0x10, 0x72, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x72, 0x79, 0x49, 0x70, 0x66, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x10,
-	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x80, 0x01,
-	0x0a, 0x13, 0x46, 0x65, 0x65, 0x64, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x73, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
-	0x49, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64,
-	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00,
-	0x2a, 0xb4, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22,
-	0x0a, 0x1e, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x49, 0x44,
-	0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x02, 0x12, 0x1a,
-	0x0a, 0x16, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41,
-	0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x52,
-	0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49,
-	0x4e, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x45, 0x45, 0x44, 0x53, 0x10,
-	0x04, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xab, 0x01, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f,
+	0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
+	0xef, 0x01, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x55, 0x0a, 0x13, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde,
+	0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
+	0x01, 0x22, 0xb0, 0x01, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d,
+	0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x53, 0x0a, 0x10, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x3a, 0x04,
+	0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x8c, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x42,
+	0x0a, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x70, 0x66, 0x73, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x49, 0x50, 0x46, 0x53, 0x48, 0x61, 0x73, 0x68,
+	0x52, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x49, 0x70, 0x66, 0x73, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0xe8,
+	0xa0, 0x1f, 0x01, 0x22, 0x80, 0x01, 0x0a, 0x13, 0x46, 0x65, 0x65, 0x64, 0x73, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x0a, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x73, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
+	0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x2a, 0xcd, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x49, 0x47,
+	0x4e, 0x41, 0x4c, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x49, 0x43,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x41,
+	0x44, 0x59, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03,
+	0x12, 0x25, 0x0a, 0x21, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54, 0x5f,
+	0x46, 0x45, 0x45, 0x44, 0x53, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x49, 0x43, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x48, 0x41, 0x4c, 0x54, 0x45, 0x44, 0x10, 0x05,
+	0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xb9, 0x01, 0x0a, 0x11, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x22, 0x0a, 0x1e,
+	0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48,
+	0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x26, 0x0a, 0x22, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x5f,
+	0x4d, 0x45, 0x44, 0x49, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x2c, 0x0a, 0x28, 0x41, 0x47, 0x47, 0x52,
+	0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x54,
+	0x52, 0x49, 0x4d, 0x4d, 0x45, 0x44, 0x5f, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x5f,
+	0x4d, 0x45, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x57, 0x45, 0x49,
+	0x47, 0x48, 0x54, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x41, 0x4e, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3,
+	0x1e, 0x00, 0x2a, 0xab, 0x01, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x49, 0x47, 0x4e,
+	0x41, 0x4c, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a,
 	0x1f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x52, 0x49,
-	0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x55, 0x50, 0x50,
-	0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x49, 0x47, 0x4e, 0x41,
-	0x4c, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
-	0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d,
-	0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x1a,
-	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xd4, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x42, 0x0a, 0x46, 0x65, 0x65, 0x64, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x64, 0x73, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x46, 0x58, 0xaa, 0x02, 0x12, 0x42, 0x61, 0x6e,
-	0x64, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca,
-	0x02, 0x12, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64,
-	0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x46, 0x65,
-	0x65, 0x64, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x52, 0x49,
+	0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49,
+	0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x49, 0x47, 0x4e, 0x41,
+	0x4c, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41,
+	0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00,
+	0x42, 0xd4, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65,
+	0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0a, 0x46, 0x65, 0x65,
+	0x64, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x64, 0x73, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2,
+	0x02, 0x03, 0x42, 0x46, 0x58, 0xaa, 0x02, 0x12, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x46, 0x65, 0x65,
+	0x64, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x12, 0x42, 0x61, 0x6e,
+	0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2,
+	0x02, 0x1e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x14, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x64, 0x73, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7524,39 +9533,46 @@ func file_band_feeds_v1beta1_feeds_proto_rawDescGZIP() []byte {
 	return file_band_feeds_v1beta1_feeds_proto_rawDescData
 }
 
-var file_band_feeds_v1beta1_feeds_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_band_feeds_v1beta1_feeds_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_band_feeds_v1beta1_feeds_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_band_feeds_v1beta1_feeds_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_band_feeds_v1beta1_feeds_proto_goTypes = []interface{}{
 	(PriceStatus)(0),                  // 0: band.feeds.v1beta1.PriceStatus
-	(SignalPriceStatus)(0),            // 1: band.feeds.v1beta1.SignalPriceStatus
-	(*Signal)(nil),                    // 2: band.feeds.v1beta1.Signal
-	(*Vote)(nil),                      // 3: band.feeds.v1beta1.Vote
-	(*Feed)(nil),                      // 4: band.feeds.v1beta1.Feed
-	(*FeedWithDeviation)(nil),         // 5: band.feeds.v1beta1.FeedWithDeviation
-	(*CurrentFeeds)(nil),              // 6: band.feeds.v1beta1.CurrentFeeds
-	(*CurrentFeedWithDeviations)(nil), // 7: band.feeds.v1beta1.CurrentFeedWithDeviations
-	(*Price)(nil),                     // 8: band.feeds.v1beta1.Price
-	(*SignalPrice)(nil),               // 9: band.feeds.v1beta1.SignalPrice
-	(*ValidatorPrice)(nil),            // 10: band.feeds.v1beta1.ValidatorPrice
-	(*ValidatorPriceList)(nil),        // 11: band.feeds.v1beta1.ValidatorPriceList
-	(*ReferenceSourceConfig)(nil),     // 12: band.feeds.v1beta1.ReferenceSourceConfig
-	(*FeedsSignatureOrder)(nil),       // 13: band.feeds.v1beta1.FeedsSignatureOrder
-	(Encoder)(0),                      // 14: band.feeds.v1beta1.Encoder
+	(AggregationMethod)(0),            // 1: band.feeds.v1beta1.AggregationMethod
+	(SignalPriceStatus)(0),            // 2: band.feeds.v1beta1.SignalPriceStatus
+	(*Signal)(nil),                    // 3: band.feeds.v1beta1.Signal
+	(*Vote)(nil),                      // 4: band.feeds.v1beta1.Vote
+	(*Feed)(nil),                      // 5: band.feeds.v1beta1.Feed
+	(*FeedWithDeviation)(nil),         // 6: band.feeds.v1beta1.FeedWithDeviation
+	(*CurrentFeeds)(nil),              // 7: band.feeds.v1beta1.CurrentFeeds
+	(*CurrentFeedWithDeviations)(nil), // 8: band.feeds.v1beta1.CurrentFeedWithDeviations
+	(*FeedAggregationMethod)(nil),     // 9: band.feeds.v1beta1.FeedAggregationMethod
+	(*CircuitBreakerConfig)(nil),      // 10: band.feeds.v1beta1.CircuitBreakerConfig
+	(*CircuitBreakerState)(nil),       // 11: band.feeds.v1beta1.CircuitBreakerState
+	(*Price)(nil),                     // 12: band.feeds.v1beta1.Price
+	(*SignalPrice)(nil),               // 13: band.feeds.v1beta1.SignalPrice
+	(*ValidatorPrice)(nil),            // 14: band.feeds.v1beta1.ValidatorPrice
+	(*ValidatorPriceList)(nil),        // 15: band.feeds.v1beta1.ValidatorPriceList
+	(*ReferenceSourceConfig)(nil),     // 16: band.feeds.v1beta1.ReferenceSourceConfig
+	(*FeedsSignatureOrder)(nil),       // 17: band.feeds.v1beta1.FeedsSignatureOrder
+	(Encoder)(0),                      // 18: band.feeds.v1beta1.Encoder
 }
 var file_band_feeds_v1beta1_feeds_proto_depIdxs = []int32{
-	2,  // 0: band.feeds.v1beta1.Vote.signals:type_name -> band.feeds.v1beta1.Signal
-	4,  // 1: band.feeds.v1beta1.CurrentFeeds.feeds:type_name -> band.feeds.v1beta1.Feed
-	5,  // 2: band.feeds.v1beta1.CurrentFeedWithDeviations.feeds:type_name -> band.feeds.v1beta1.FeedWithDeviation
-	0,  // 3: band.feeds.v1beta1.Price.status:type_name -> band.feeds.v1beta1.PriceStatus
-	1,  // 4: band.feeds.v1beta1.SignalPrice.status:type_name -> band.feeds.v1beta1.SignalPriceStatus
-	1,  // 5: band.feeds.v1beta1.ValidatorPrice.signal_price_status:type_name -> band.feeds.v1beta1.SignalPriceStatus
-	10, // 6: band.feeds.v1beta1.ValidatorPriceList.validator_prices:type_name -> band.feeds.v1beta1.ValidatorPrice
-	14, // 7: band.feeds.v1beta1.FeedsSignatureOrder.encoder:type_name -> band.feeds.v1beta1.Encoder
-	8,  // [8:8] is the sub-list for method output_type
-	8,  // [8:8] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	3,  // 0: band.feeds.v1beta1.Vote.signals:type_name -> band.feeds.v1beta1.Signal
+	1,  // 1: band.feeds.v1beta1.Feed.aggregation_method:type_name -> band.feeds.v1beta1.AggregationMethod
+	1,  // 2: band.feeds.v1beta1.FeedWithDeviation.aggregation_method:type_name -> band.feeds.v1beta1.AggregationMethod
+	5,  // 3: band.feeds.v1beta1.CurrentFeeds.feeds:type_name -> band.feeds.v1beta1.Feed
+	6,  // 4: band.feeds.v1beta1.CurrentFeedWithDeviations.feeds:type_name -> band.feeds.v1beta1.FeedWithDeviation
+	1,  // 5: band.feeds.v1beta1.FeedAggregationMethod.aggregation_method:type_name -> band.feeds.v1beta1.AggregationMethod
+	0,  // 6: band.feeds.v1beta1.Price.status:type_name -> band.feeds.v1beta1.PriceStatus
+	2,  // 7: band.feeds.v1beta1.SignalPrice.status:type_name -> band.feeds.v1beta1.SignalPriceStatus
+	2,  // 8: band.feeds.v1beta1.ValidatorPrice.signal_price_status:type_name -> band.feeds.v1beta1.SignalPriceStatus
+	14, // 9: band.feeds.v1beta1.ValidatorPriceList.validator_prices:type_name -> band.feeds.v1beta1.ValidatorPrice
+	18, // 10: band.feeds.v1beta1.FeedsSignatureOrder.encoder:type_name -> band.feeds.v1beta1.Encoder
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_band_feeds_v1beta1_feeds_proto_init() }
@@ -7639,7 +9655,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Price); i {
+			switch v := v.(*FeedAggregationMethod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7651,7 +9667,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignalPrice); i {
+			switch v := v.(*CircuitBreakerConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7663,7 +9679,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorPrice); i {
+			switch v := v.(*CircuitBreakerState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7675,7 +9691,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorPriceList); i {
+			switch v := v.(*Price); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7687,7 +9703,7 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReferenceSourceConfig); i {
+			switch v := v.(*SignalPrice); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7699,6 +9715,42 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 			}
 		}
 		file_band_feeds_v1beta1_feeds_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_feeds_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPriceList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_feeds_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReferenceSourceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_feeds_v1beta1_feeds_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FeedsSignatureOrder); i {
 			case 0:
 				return &v.state
@@ -7716,8 +9768,8 @@ func file_band_feeds_v1beta1_feeds_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_feeds_v1beta1_feeds_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   12,
+			NumEnums:      3,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
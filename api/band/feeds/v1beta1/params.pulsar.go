@@ -29,6 +29,8 @@ var (
 	fd_Params_current_feeds_update_interval    protoreflect.FieldDescriptor
 	fd_Params_price_quorum                     protoreflect.FieldDescriptor
 	fd_Params_max_signal_ids_per_signing       protoreflect.FieldDescriptor
+	fd_Params_price_history_retention          protoreflect.FieldDescriptor
+	fd_Params_max_price_history_entries        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -47,6 +49,8 @@ func init() {
 	fd_Params_current_feeds_update_interval = md_Params.Fields().ByName("current_feeds_update_interval")
 	fd_Params_price_quorum = md_Params.Fields().ByName("price_quorum")
 	fd_Params_max_signal_ids_per_signing = md_Params.Fields().ByName("max_signal_ids_per_signing")
+	fd_Params_price_history_retention = md_Params.Fields().ByName("price_history_retention")
+	fd_Params_max_price_history_entries = md_Params.Fields().ByName("max_price_history_entries")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -192,6 +196,18 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.PriceHistoryRetention != int64(0) {
+		value := protoreflect.ValueOfInt64(x.PriceHistoryRetention)
+		if !f(fd_Params_price_history_retention, value) {
+			return
+		}
+	}
+	if x.MaxPriceHistoryEntries != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPriceHistoryEntries)
+		if !f(fd_Params_max_price_history_entries, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -233,6 +249,10 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.PriceQuorum != ""
 	case "band.feeds.v1beta1.Params.max_signal_ids_per_signing":
 		return x.MaxSignalIdsPerSigning != uint64(0)
+	case "band.feeds.v1beta1.Params.price_history_retention":
+		return x.PriceHistoryRetention != int64(0)
+	case "band.feeds.v1beta1.Params.max_price_history_entries":
+		return x.MaxPriceHistoryEntries != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Params"))
@@ -275,6 +295,10 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.PriceQuorum = ""
 	case "band.feeds.v1beta1.Params.max_signal_ids_per_signing":
 		x.MaxSignalIdsPerSigning = uint64(0)
+	case "band.feeds.v1beta1.Params.price_history_retention":
+		x.PriceHistoryRetention = int64(0)
+	case "band.feeds.v1beta1.Params.max_price_history_entries":
+		x.MaxPriceHistoryEntries = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Params"))
@@ -330,6 +354,12 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "band.feeds.v1beta1.Params.max_signal_ids_per_signing":
 		value := x.MaxSignalIdsPerSigning
 		return protoreflect.ValueOfUint64(value)
+	case "band.feeds.v1beta1.Params.price_history_retention":
+		value := x.PriceHistoryRetention
+		return protoreflect.ValueOfInt64(value)
+	case "band.feeds.v1beta1.Params.max_price_history_entries":
+		value := x.MaxPriceHistoryEntries
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Params"))
@@ -376,6 +406,10 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.PriceQuorum = value.Interface().(string)
 	case "band.feeds.v1beta1.Params.max_signal_ids_per_signing":
 		x.MaxSignalIdsPerSigning = value.Uint()
+	case "band.feeds.v1beta1.Params.price_history_retention":
+		x.PriceHistoryRetention = value.Int()
+	case "band.feeds.v1beta1.Params.max_price_history_entries":
+		x.MaxPriceHistoryEntries = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Params"))
@@ -422,6 +456,10 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field price_quorum of message band.feeds.v1beta1.Params is not mutable"))
 	case "band.feeds.v1beta1.Params.max_signal_ids_per_signing":
 		panic(fmt.Errorf("field max_signal_ids_per_signing of message band.feeds.v1beta1.Params is not mutable"))
+	case "band.feeds.v1beta1.Params.price_history_retention":
+		panic(fmt.Errorf("field price_history_retention of message band.feeds.v1beta1.Params is not mutable"))
+	case "band.feeds.v1beta1.Params.max_price_history_entries":
+		panic(fmt.Errorf("field max_price_history_entries of message band.feeds.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Params"))
@@ -461,6 +499,10 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "band.feeds.v1beta1.Params.max_signal_ids_per_signing":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.feeds.v1beta1.Params.price_history_retention":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.feeds.v1beta1.Params.max_price_history_entries":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.feeds.v1beta1.Params"))
@@ -571,6 +613,12 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.MaxSignalIdsPerSigning != 0 {
 			n += 1 + runtime.Sov(uint64(x.MaxSignalIdsPerSigning))
 		}
+		if x.PriceHistoryRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.PriceHistoryRetention))
+		}
+		if x.MaxPriceHistoryEntries != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPriceHistoryEntries))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -600,6 +648,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxPriceHistoryEntries != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPriceHistoryEntries))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.PriceHistoryRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PriceHistoryRetention))
+			i--
+			dAtA[i] = 0x70
+		}
 		if x.MaxSignalIdsPerSigning != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSignalIdsPerSigning))
 			i--
@@ -991,6 +1049,44 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryRetention", wireType)
+				}
+				x.PriceHistoryRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PriceHistoryRetention |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPriceHistoryEntries", wireType)
+				}
+				x.MaxPriceHistoryEntries = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPriceHistoryEntries |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1074,6 +1170,12 @@ type Params struct {
 	PriceQuorum string `protobuf:"bytes,12,opt,name=price_quorum,json=priceQuorum,proto3" json:"price_quorum,omitempty"`
 	// max_signal_ids_per_signing is the maximum number of signals allowed in a single tss signing request.
 	MaxSignalIdsPerSigning uint64 `protobuf:"varint,13,opt,name=max_signal_ids_per_signing,json=maxSignalIdsPerSigning,proto3" json:"max_signal_ids_per_signing,omitempty"`
+	// price_history_retention is the duration (in seconds) that aggregated prices are kept in the price history.
+	// Setting it to zero disables the price history and the TWAP queries.
+	PriceHistoryRetention int64 `protobuf:"varint,14,opt,name=price_history_retention,json=priceHistoryRetention,proto3" json:"price_history_retention,omitempty"`
+	// max_price_history_entries is the maximum number of entries kept in the price history of each signal id.
+	// The oldest entries are pruned once a signal id has more entries than this limit.
+	MaxPriceHistoryEntries uint64 `protobuf:"varint,15,opt,name=max_price_history_entries,json=maxPriceHistoryEntries,proto3" json:"max_price_history_entries,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1187,6 +1289,20 @@ func (x *Params) GetMaxSignalIdsPerSigning() uint64 {
 	return 0
 }
 
+func (x *Params) GetPriceHistoryRetention() int64 {
+	if x != nil {
+		return x.PriceHistoryRetention
+	}
+	return 0
+}
+
+func (x *Params) GetMaxPriceHistoryEntries() uint64 {
+	if x != nil {
+		return x.MaxPriceHistoryEntries
+	}
+	return 0
+}
+
 var File_band_feeds_v1beta1_params_proto protoreflect.FileDescriptor
 
 var file_band_feeds_v1beta1_params_proto_rawDesc = []byte{
@@ -1196,7 +1312,7 @@ var file_band_feeds_v1beta1_params_proto_rawDesc = []byte{
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9a, 0x06, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
 	0x73, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
 	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69,
@@ -1238,21 +1354,29 @@ var file_band_feeds_v1beta1_params_proto_rawDesc = []byte{
 	0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x16, 0x4d, 0x61, 0x78, 0x53,
 	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x73, 0x50, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x69,
 	0x6e, 0x67, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73,
-	0x50, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
-	0x42, 0xd5, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65,
-	0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x64, 0x73, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0xa2, 0x02, 0x03, 0x42, 0x46, 0x58, 0xaa, 0x02, 0x12, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x46, 0x65,
-	0x65, 0x64, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x12, 0x42, 0x61,
-	0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0xe2, 0x02, 0x1e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x14, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x64, 0x73, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x50, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x04, 0xe8,
+	0xa0, 0x1f, 0x01, 0x42, 0xd5, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0b,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x64, 0x73, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x46, 0x58, 0xaa, 0x02, 0x12, 0x42, 0x61, 0x6e, 0x64,
+	0x2e, 0x46, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02,
+	0x12, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0xe2, 0x02, 0x1e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x46, 0x65, 0x65, 0x64, 0x73,
+	0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x46, 0x65, 0x65,
+	0x64, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -23,7 +23,10 @@ const (
 	Query_IsFeeder_FullMethodName              = "/band.feeds.v1beta1.Query/IsFeeder"
 	Query_Params_FullMethodName                = "/band.feeds.v1beta1.Query/Params"
 	Query_Price_FullMethodName                 = "/band.feeds.v1beta1.Query/Price"
+	Query_CircuitBreaker_FullMethodName        = "/band.feeds.v1beta1.Query/CircuitBreaker"
 	Query_Prices_FullMethodName                = "/band.feeds.v1beta1.Query/Prices"
+	Query_PriceHistory_FullMethodName          = "/band.feeds.v1beta1.Query/PriceHistory"
+	Query_TWAP_FullMethodName                  = "/band.feeds.v1beta1.Query/TWAP"
 	Query_AllPrices_FullMethodName             = "/band.feeds.v1beta1.Query/AllPrices"
 	Query_ReferenceSourceConfig_FullMethodName = "/band.feeds.v1beta1.Query/ReferenceSourceConfig"
 	Query_SignalTotalPowers_FullMethodName     = "/band.feeds.v1beta1.Query/SignalTotalPowers"
@@ -44,8 +47,14 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Price is an RPC method that returns price by signal id.
 	Price(ctx context.Context, in *QueryPriceRequest, opts ...grpc.CallOption) (*QueryPriceResponse, error)
+	// CircuitBreaker is an RPC method that returns the circuit breaker configuration and state by signal id.
+	CircuitBreaker(ctx context.Context, in *QueryCircuitBreakerRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error)
 	// Prices is an RPC method that prices by signal ids.
 	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
+	// PriceHistory is an RPC method that returns the price history of a signal id within a time range.
+	PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error)
+	// TWAP is an RPC method that returns the time-weighted average price of a signal id over a duration.
+	TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error)
 	// AllPrices is an RPC method that returns all prices.
 	AllPrices(ctx context.Context, in *QueryAllPricesRequest, opts ...grpc.CallOption) (*QueryAllPricesResponse, error)
 	// ReferenceSourceConfig is an RPC method that returns information on the reference price source.
@@ -105,6 +114,15 @@ func (c *queryClient) Price(ctx context.Context, in *QueryPriceRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) CircuitBreaker(ctx context.Context, in *QueryCircuitBreakerRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error) {
+	out := new(QueryCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, Query_CircuitBreaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error) {
 	out := new(QueryPricesResponse)
 	err := c.cc.Invoke(ctx, Query_Prices_FullMethodName, in, out, opts...)
@@ -114,6 +132,24 @@ func (c *queryClient) Prices(ctx context.Context, in *QueryPricesRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error) {
+	out := new(QueryPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_PriceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error) {
+	out := new(QueryTWAPResponse)
+	err := c.cc.Invoke(ctx, Query_TWAP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AllPrices(ctx context.Context, in *QueryAllPricesRequest, opts ...grpc.CallOption) (*QueryAllPricesResponse, error) {
 	out := new(QueryAllPricesResponse)
 	err := c.cc.Invoke(ctx, Query_AllPrices_FullMethodName, in, out, opts...)
@@ -180,8 +216,14 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Price is an RPC method that returns price by signal id.
 	Price(context.Context, *QueryPriceRequest) (*QueryPriceResponse, error)
+	// CircuitBreaker is an RPC method that returns the circuit breaker configuration and state by signal id.
+	CircuitBreaker(context.Context, *QueryCircuitBreakerRequest) (*QueryCircuitBreakerResponse, error)
 	// Prices is an RPC method that prices by signal ids.
 	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
+	// PriceHistory is an RPC method that returns the price history of a signal id within a time range.
+	PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error)
+	// TWAP is an RPC method that returns the time-weighted average price of a signal id over a duration.
+	TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error)
 	// AllPrices is an RPC method that returns all prices.
 	AllPrices(context.Context, *QueryAllPricesRequest) (*QueryAllPricesResponse, error)
 	// ReferenceSourceConfig is an RPC method that returns information on the reference price source.
@@ -214,9 +256,18 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) Price(context.Context, *QueryPriceRequest) (*QueryPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Price not implemented")
 }
+func (UnimplementedQueryServer) CircuitBreaker(context.Context, *QueryCircuitBreakerRequest) (*QueryCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreaker not implemented")
+}
 func (UnimplementedQueryServer) Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
 }
+func (UnimplementedQueryServer) PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceHistory not implemented")
+}
+func (UnimplementedQueryServer) TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TWAP not implemented")
+}
 func (UnimplementedQueryServer) AllPrices(context.Context, *QueryAllPricesRequest) (*QueryAllPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllPrices not implemented")
 }
@@ -320,6 +371,24 @@ func _Query_Price_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreaker(ctx, req.(*QueryCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Prices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPricesRequest)
 	if err := dec(in); err != nil {
@@ -338,6 +407,42 @@ func _Query_Prices_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceHistory(ctx, req.(*QueryPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TWAP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TWAP(ctx, req.(*QueryTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AllPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAllPricesRequest)
 	if err := dec(in); err != nil {
@@ -469,10 +574,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Price",
 			Handler:    _Query_Price_Handler,
 		},
+		{
+			MethodName: "CircuitBreaker",
+			Handler:    _Query_CircuitBreaker_Handler,
+		},
 		{
 			MethodName: "Prices",
 			Handler:    _Query_Prices_Handler,
 		},
+		{
+			MethodName: "PriceHistory",
+			Handler:    _Query_PriceHistory_Handler,
+		},
+		{
+			MethodName: "TWAP",
+			Handler:    _Query_TWAP_Handler,
+		},
 		{
 			MethodName: "AllPrices",
 			Handler:    _Query_AllPrices_Handler,
@@ -19,10 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Vote_FullMethodName                        = "/band.feeds.v1beta1.Msg/Vote"
-	Msg_SubmitSignalPrices_FullMethodName          = "/band.feeds.v1beta1.Msg/SubmitSignalPrices"
-	Msg_UpdateReferenceSourceConfig_FullMethodName = "/band.feeds.v1beta1.Msg/UpdateReferenceSourceConfig"
-	Msg_UpdateParams_FullMethodName                = "/band.feeds.v1beta1.Msg/UpdateParams"
+	Msg_Vote_FullMethodName                         = "/band.feeds.v1beta1.Msg/Vote"
+	Msg_SubmitSignalPrices_FullMethodName           = "/band.feeds.v1beta1.Msg/SubmitSignalPrices"
+	Msg_UpdateReferenceSourceConfig_FullMethodName  = "/band.feeds.v1beta1.Msg/UpdateReferenceSourceConfig"
+	Msg_UpdateFeedAggregationMethods_FullMethodName = "/band.feeds.v1beta1.Msg/UpdateFeedAggregationMethods"
+	Msg_UpdateCircuitBreakerConfigs_FullMethodName  = "/band.feeds.v1beta1.Msg/UpdateCircuitBreakerConfigs"
+	Msg_UpdateParams_FullMethodName                 = "/band.feeds.v1beta1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -35,6 +37,10 @@ type MsgClient interface {
 	SubmitSignalPrices(ctx context.Context, in *MsgSubmitSignalPrices, opts ...grpc.CallOption) (*MsgSubmitSignalPricesResponse, error)
 	// UpdateReferenceSourceConfig is an RPC method to update reference price source configuration.
 	UpdateReferenceSourceConfig(ctx context.Context, in *MsgUpdateReferenceSourceConfig, opts ...grpc.CallOption) (*MsgUpdateReferenceSourceConfigResponse, error)
+	// UpdateFeedAggregationMethods is an RPC method to update aggregation methods of signal ids.
+	UpdateFeedAggregationMethods(ctx context.Context, in *MsgUpdateFeedAggregationMethods, opts ...grpc.CallOption) (*MsgUpdateFeedAggregationMethodsResponse, error)
+	// UpdateCircuitBreakerConfigs is an RPC method to update circuit breaker configurations of signal ids.
+	UpdateCircuitBreakerConfigs(ctx context.Context, in *MsgUpdateCircuitBreakerConfigs, opts ...grpc.CallOption) (*MsgUpdateCircuitBreakerConfigsResponse, error)
 	// UpdateParams is an RPC method to update parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -74,6 +80,24 @@ func (c *msgClient) UpdateReferenceSourceConfig(ctx context.Context, in *MsgUpda
 	return out, nil
 }
 
+func (c *msgClient) UpdateFeedAggregationMethods(ctx context.Context, in *MsgUpdateFeedAggregationMethods, opts ...grpc.CallOption) (*MsgUpdateFeedAggregationMethodsResponse, error) {
+	out := new(MsgUpdateFeedAggregationMethodsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateFeedAggregationMethods_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCircuitBreakerConfigs(ctx context.Context, in *MsgUpdateCircuitBreakerConfigs, opts ...grpc.CallOption) (*MsgUpdateCircuitBreakerConfigsResponse, error) {
+	out := new(MsgUpdateCircuitBreakerConfigsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateCircuitBreakerConfigs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -93,6 +117,10 @@ type MsgServer interface {
 	SubmitSignalPrices(context.Context, *MsgSubmitSignalPrices) (*MsgSubmitSignalPricesResponse, error)
 	// UpdateReferenceSourceConfig is an RPC method to update reference price source configuration.
 	UpdateReferenceSourceConfig(context.Context, *MsgUpdateReferenceSourceConfig) (*MsgUpdateReferenceSourceConfigResponse, error)
+	// UpdateFeedAggregationMethods is an RPC method to update aggregation methods of signal ids.
+	UpdateFeedAggregationMethods(context.Context, *MsgUpdateFeedAggregationMethods) (*MsgUpdateFeedAggregationMethodsResponse, error)
+	// UpdateCircuitBreakerConfigs is an RPC method to update circuit breaker configurations of signal ids.
+	UpdateCircuitBreakerConfigs(context.Context, *MsgUpdateCircuitBreakerConfigs) (*MsgUpdateCircuitBreakerConfigsResponse, error)
 	// UpdateParams is an RPC method to update parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
@@ -111,6 +139,12 @@ func (UnimplementedMsgServer) SubmitSignalPrices(context.Context, *MsgSubmitSign
 func (UnimplementedMsgServer) UpdateReferenceSourceConfig(context.Context, *MsgUpdateReferenceSourceConfig) (*MsgUpdateReferenceSourceConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateReferenceSourceConfig not implemented")
 }
+func (UnimplementedMsgServer) UpdateFeedAggregationMethods(context.Context, *MsgUpdateFeedAggregationMethods) (*MsgUpdateFeedAggregationMethodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeedAggregationMethods not implemented")
+}
+func (UnimplementedMsgServer) UpdateCircuitBreakerConfigs(context.Context, *MsgUpdateCircuitBreakerConfigs) (*MsgUpdateCircuitBreakerConfigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCircuitBreakerConfigs not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -181,6 +215,42 @@ func _Msg_UpdateReferenceSourceConfig_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateFeedAggregationMethods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeedAggregationMethods)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeedAggregationMethods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateFeedAggregationMethods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeedAggregationMethods(ctx, req.(*MsgUpdateFeedAggregationMethods))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCircuitBreakerConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCircuitBreakerConfigs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCircuitBreakerConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateCircuitBreakerConfigs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCircuitBreakerConfigs(ctx, req.(*MsgUpdateCircuitBreakerConfigs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -218,6 +288,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateReferenceSourceConfig",
 			Handler:    _Msg_UpdateReferenceSourceConfig_Handler,
 		},
+		{
+			MethodName: "UpdateFeedAggregationMethods",
+			Handler:    _Msg_UpdateFeedAggregationMethods_Handler,
+		},
+		{
+			MethodName: "UpdateCircuitBreakerConfigs",
+			Handler:    _Msg_UpdateCircuitBreakerConfigs_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
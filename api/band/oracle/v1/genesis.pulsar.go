@@ -115,11 +115,168 @@ func (x *_GenesisState_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*RequestSubscription
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RequestSubscription)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RequestSubscription)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(RequestSubscription)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(RequestSubscription)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*DataSourceVersion
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DataSourceVersion)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DataSourceVersion)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(DataSourceVersion)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(DataSourceVersion)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*OracleScriptVersion
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OracleScriptVersion)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OracleScriptVersion)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(OracleScriptVersion)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(OracleScriptVersion)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                protoreflect.MessageDescriptor
-	fd_GenesisState_params         protoreflect.FieldDescriptor
-	fd_GenesisState_data_sources   protoreflect.FieldDescriptor
-	fd_GenesisState_oracle_scripts protoreflect.FieldDescriptor
+	md_GenesisState                            protoreflect.MessageDescriptor
+	fd_GenesisState_params                     protoreflect.FieldDescriptor
+	fd_GenesisState_data_sources               protoreflect.FieldDescriptor
+	fd_GenesisState_oracle_scripts             protoreflect.FieldDescriptor
+	fd_GenesisState_request_subscription_count protoreflect.FieldDescriptor
+	fd_GenesisState_request_subscriptions      protoreflect.FieldDescriptor
+	fd_GenesisState_data_source_versions       protoreflect.FieldDescriptor
+	fd_GenesisState_oracle_script_versions     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -128,6 +285,10 @@ func init() {
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_data_sources = md_GenesisState.Fields().ByName("data_sources")
 	fd_GenesisState_oracle_scripts = md_GenesisState.Fields().ByName("oracle_scripts")
+	fd_GenesisState_request_subscription_count = md_GenesisState.Fields().ByName("request_subscription_count")
+	fd_GenesisState_request_subscriptions = md_GenesisState.Fields().ByName("request_subscriptions")
+	fd_GenesisState_data_source_versions = md_GenesisState.Fields().ByName("data_source_versions")
+	fd_GenesisState_oracle_script_versions = md_GenesisState.Fields().ByName("oracle_script_versions")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -213,6 +374,30 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.RequestSubscriptionCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestSubscriptionCount)
+		if !f(fd_GenesisState_request_subscription_count, value) {
+			return
+		}
+	}
+	if len(x.RequestSubscriptions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.RequestSubscriptions})
+		if !f(fd_GenesisState_request_subscriptions, value) {
+			return
+		}
+	}
+	if len(x.DataSourceVersions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.DataSourceVersions})
+		if !f(fd_GenesisState_data_source_versions, value) {
+			return
+		}
+	}
+	if len(x.OracleScriptVersions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.OracleScriptVersions})
+		if !f(fd_GenesisState_oracle_script_versions, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -234,6 +419,14 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.DataSources) != 0
 	case "band.oracle.v1.GenesisState.oracle_scripts":
 		return len(x.OracleScripts) != 0
+	case "band.oracle.v1.GenesisState.request_subscription_count":
+		return x.RequestSubscriptionCount != uint64(0)
+	case "band.oracle.v1.GenesisState.request_subscriptions":
+		return len(x.RequestSubscriptions) != 0
+	case "band.oracle.v1.GenesisState.data_source_versions":
+		return len(x.DataSourceVersions) != 0
+	case "band.oracle.v1.GenesisState.oracle_script_versions":
+		return len(x.OracleScriptVersions) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.GenesisState"))
@@ -256,6 +449,14 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.DataSources = nil
 	case "band.oracle.v1.GenesisState.oracle_scripts":
 		x.OracleScripts = nil
+	case "band.oracle.v1.GenesisState.request_subscription_count":
+		x.RequestSubscriptionCount = uint64(0)
+	case "band.oracle.v1.GenesisState.request_subscriptions":
+		x.RequestSubscriptions = nil
+	case "band.oracle.v1.GenesisState.data_source_versions":
+		x.DataSourceVersions = nil
+	case "band.oracle.v1.GenesisState.oracle_script_versions":
+		x.OracleScriptVersions = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.GenesisState"))
@@ -287,6 +488,27 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_3_list{list: &x.OracleScripts}
 		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.GenesisState.request_subscription_count":
+		value := x.RequestSubscriptionCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.GenesisState.request_subscriptions":
+		if len(x.RequestSubscriptions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.RequestSubscriptions}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.GenesisState.data_source_versions":
+		if len(x.DataSourceVersions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.DataSourceVersions}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.GenesisState.oracle_script_versions":
+		if len(x.OracleScriptVersions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.OracleScriptVersions}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.GenesisState"))
@@ -317,6 +539,20 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_3_list)
 		x.OracleScripts = *clv.list
+	case "band.oracle.v1.GenesisState.request_subscription_count":
+		x.RequestSubscriptionCount = value.Uint()
+	case "band.oracle.v1.GenesisState.request_subscriptions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.RequestSubscriptions = *clv.list
+	case "band.oracle.v1.GenesisState.data_source_versions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.DataSourceVersions = *clv.list
+	case "band.oracle.v1.GenesisState.oracle_script_versions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.OracleScriptVersions = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.GenesisState"))
@@ -354,6 +590,26 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_3_list{list: &x.OracleScripts}
 		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.GenesisState.request_subscriptions":
+		if x.RequestSubscriptions == nil {
+			x.RequestSubscriptions = []*RequestSubscription{}
+		}
+		value := &_GenesisState_5_list{list: &x.RequestSubscriptions}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.GenesisState.data_source_versions":
+		if x.DataSourceVersions == nil {
+			x.DataSourceVersions = []*DataSourceVersion{}
+		}
+		value := &_GenesisState_6_list{list: &x.DataSourceVersions}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.GenesisState.oracle_script_versions":
+		if x.OracleScriptVersions == nil {
+			x.OracleScriptVersions = []*OracleScriptVersion{}
+		}
+		value := &_GenesisState_7_list{list: &x.OracleScriptVersions}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.GenesisState.request_subscription_count":
+		panic(fmt.Errorf("field request_subscription_count of message band.oracle.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.GenesisState"))
@@ -376,6 +632,17 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "band.oracle.v1.GenesisState.oracle_scripts":
 		list := []*OracleScript{}
 		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "band.oracle.v1.GenesisState.request_subscription_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.GenesisState.request_subscriptions":
+		list := []*RequestSubscription{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "band.oracle.v1.GenesisState.data_source_versions":
+		list := []*DataSourceVersion{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "band.oracle.v1.GenesisState.oracle_script_versions":
+		list := []*OracleScriptVersion{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.GenesisState"))
@@ -461,6 +728,27 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.RequestSubscriptionCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestSubscriptionCount))
+		}
+		if len(x.RequestSubscriptions) > 0 {
+			for _, e := range x.RequestSubscriptions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DataSourceVersions) > 0 {
+			for _, e := range x.DataSourceVersions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.OracleScriptVersions) > 0 {
+			for _, e := range x.OracleScriptVersions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -490,6 +778,59 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.OracleScriptVersions) > 0 {
+			for iNdEx := len(x.OracleScriptVersions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OracleScriptVersions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.DataSourceVersions) > 0 {
+			for iNdEx := len(x.DataSourceVersions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DataSourceVersions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.RequestSubscriptions) > 0 {
+			for iNdEx := len(x.RequestSubscriptions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RequestSubscriptions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.RequestSubscriptionCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestSubscriptionCount))
+			i--
+			dAtA[i] = 0x20
+		}
 		if len(x.OracleScripts) > 0 {
 			for iNdEx := len(x.OracleScripts) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.OracleScripts[iNdEx])
@@ -689,6 +1030,127 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestSubscriptionCount", wireType)
+				}
+				x.RequestSubscriptionCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestSubscriptionCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestSubscriptions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequestSubscriptions = append(x.RequestSubscriptions, &RequestSubscription{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RequestSubscriptions[len(x.RequestSubscriptions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceVersions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DataSourceVersions = append(x.DataSourceVersions, &DataSourceVersion{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DataSourceVersions[len(x.DataSourceVersions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OracleScriptVersions = append(x.OracleScriptVersions, &OracleScriptVersion{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OracleScriptVersions[len(x.OracleScriptVersions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -749,6 +1211,15 @@ type GenesisState struct {
 	DataSources []*DataSource `protobuf:"bytes,2,rep,name=data_sources,json=dataSources,proto3" json:"data_sources,omitempty"`
 	// OracleScripts are list of oracle scripts to be installed during genesis phase.
 	OracleScripts []*OracleScript `protobuf:"bytes,3,rep,name=oracle_scripts,json=oracleScripts,proto3" json:"oracle_scripts,omitempty"`
+	// RequestSubscriptionCount is the number of request subscriptions ever
+	// created.
+	RequestSubscriptionCount uint64 `protobuf:"varint,4,opt,name=request_subscription_count,json=requestSubscriptionCount,proto3" json:"request_subscription_count,omitempty"`
+	// RequestSubscriptions are the active and inactive request subscriptions.
+	RequestSubscriptions []*RequestSubscription `protobuf:"bytes,5,rep,name=request_subscriptions,json=requestSubscriptions,proto3" json:"request_subscriptions,omitempty"`
+	// DataSourceVersions are the version histories of the data sources.
+	DataSourceVersions []*DataSourceVersion `protobuf:"bytes,6,rep,name=data_source_versions,json=dataSourceVersions,proto3" json:"data_source_versions,omitempty"`
+	// OracleScriptVersions are the version histories of the oracle scripts.
+	OracleScriptVersions []*OracleScriptVersion `protobuf:"bytes,7,rep,name=oracle_script_versions,json=oracleScriptVersions,proto3" json:"oracle_script_versions,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -792,6 +1263,34 @@ func (x *GenesisState) GetOracleScripts() []*OracleScript {
 	return nil
 }
 
+func (x *GenesisState) GetRequestSubscriptionCount() uint64 {
+	if x != nil {
+		return x.RequestSubscriptionCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetRequestSubscriptions() []*RequestSubscription {
+	if x != nil {
+		return x.RequestSubscriptions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDataSourceVersions() []*DataSourceVersion {
+	if x != nil {
+		return x.DataSourceVersions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetOracleScriptVersions() []*OracleScriptVersion {
+	if x != nil {
+		return x.OracleScriptVersions
+	}
+	return nil
+}
+
 var File_band_oracle_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_band_oracle_v1_genesis_proto_rawDesc = []byte{
@@ -801,7 +1300,7 @@ var file_band_oracle_v1_genesis_proto_rawDesc = []byte{
 	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c,
 	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xd4, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
+	0x6f, 0x22, 0xae, 0x04, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
 	0x74, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
 	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
@@ -814,19 +1313,41 @@ var file_band_oracle_v1_genesis_proto_rawDesc = []byte{
 	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
 	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72,
 	0x69, 0x70, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x42, 0xba, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d,
-	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42,
-	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76,
-	0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x15, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x59, 0x0a, 0x14, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x64,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x5f, 0x0a, 0x16, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x42, 0xba, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x62, 0x61, 0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e,
+	0x42, 0x61, 0x6e, 0x64, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x0e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x1a, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42,
+	0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -843,20 +1364,26 @@ func file_band_oracle_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_band_oracle_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_band_oracle_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: band.oracle.v1.GenesisState
-	(*Params)(nil),       // 1: band.oracle.v1.Params
-	(*DataSource)(nil),   // 2: band.oracle.v1.DataSource
-	(*OracleScript)(nil), // 3: band.oracle.v1.OracleScript
+	(*GenesisState)(nil),        // 0: band.oracle.v1.GenesisState
+	(*Params)(nil),              // 1: band.oracle.v1.Params
+	(*DataSource)(nil),          // 2: band.oracle.v1.DataSource
+	(*OracleScript)(nil),        // 3: band.oracle.v1.OracleScript
+	(*RequestSubscription)(nil), // 4: band.oracle.v1.RequestSubscription
+	(*DataSourceVersion)(nil),   // 5: band.oracle.v1.DataSourceVersion
+	(*OracleScriptVersion)(nil), // 6: band.oracle.v1.OracleScriptVersion
 }
 var file_band_oracle_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: band.oracle.v1.GenesisState.params:type_name -> band.oracle.v1.Params
 	2, // 1: band.oracle.v1.GenesisState.data_sources:type_name -> band.oracle.v1.DataSource
 	3, // 2: band.oracle.v1.GenesisState.oracle_scripts:type_name -> band.oracle.v1.OracleScript
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: band.oracle.v1.GenesisState.request_subscriptions:type_name -> band.oracle.v1.RequestSubscription
+	5, // 4: band.oracle.v1.GenesisState.data_source_versions:type_name -> band.oracle.v1.DataSourceVersion
+	6, // 5: band.oracle.v1.GenesisState.oracle_script_versions:type_name -> band.oracle.v1.OracleScriptVersion
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_band_oracle_v1_genesis_proto_init() }
@@ -1570,10 +1570,11 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_RawRequest                protoreflect.MessageDescriptor
-	fd_RawRequest_external_id    protoreflect.FieldDescriptor
-	fd_RawRequest_data_source_id protoreflect.FieldDescriptor
-	fd_RawRequest_calldata       protoreflect.FieldDescriptor
+	md_RawRequest                     protoreflect.MessageDescriptor
+	fd_RawRequest_external_id         protoreflect.FieldDescriptor
+	fd_RawRequest_data_source_id      protoreflect.FieldDescriptor
+	fd_RawRequest_calldata            protoreflect.FieldDescriptor
+	fd_RawRequest_data_source_version protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1582,6 +1583,7 @@ func init() {
 	fd_RawRequest_external_id = md_RawRequest.Fields().ByName("external_id")
 	fd_RawRequest_data_source_id = md_RawRequest.Fields().ByName("data_source_id")
 	fd_RawRequest_calldata = md_RawRequest.Fields().ByName("calldata")
+	fd_RawRequest_data_source_version = md_RawRequest.Fields().ByName("data_source_version")
 }
 
 var _ protoreflect.Message = (*fastReflection_RawRequest)(nil)
@@ -1667,6 +1669,12 @@ func (x *fastReflection_RawRequest) Range(f func(protoreflect.FieldDescriptor, p
 			return
 		}
 	}
+	if x.DataSourceVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceVersion)
+		if !f(fd_RawRequest_data_source_version, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1688,6 +1696,8 @@ func (x *fastReflection_RawRequest) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.DataSourceId != uint64(0)
 	case "band.oracle.v1.RawRequest.calldata":
 		return len(x.Calldata) != 0
+	case "band.oracle.v1.RawRequest.data_source_version":
+		return x.DataSourceVersion != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
@@ -1710,6 +1720,8 @@ func (x *fastReflection_RawRequest) Clear(fd protoreflect.FieldDescriptor) {
 		x.DataSourceId = uint64(0)
 	case "band.oracle.v1.RawRequest.calldata":
 		x.Calldata = nil
+	case "band.oracle.v1.RawRequest.data_source_version":
+		x.DataSourceVersion = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
@@ -1735,6 +1747,9 @@ func (x *fastReflection_RawRequest) Get(descriptor protoreflect.FieldDescriptor)
 	case "band.oracle.v1.RawRequest.calldata":
 		value := x.Calldata
 		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.RawRequest.data_source_version":
+		value := x.DataSourceVersion
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
@@ -1761,6 +1776,8 @@ func (x *fastReflection_RawRequest) Set(fd protoreflect.FieldDescriptor, value p
 		x.DataSourceId = value.Uint()
 	case "band.oracle.v1.RawRequest.calldata":
 		x.Calldata = value.Bytes()
+	case "band.oracle.v1.RawRequest.data_source_version":
+		x.DataSourceVersion = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
@@ -1787,6 +1804,8 @@ func (x *fastReflection_RawRequest) Mutable(fd protoreflect.FieldDescriptor) pro
 		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.RawRequest is not mutable"))
 	case "band.oracle.v1.RawRequest.calldata":
 		panic(fmt.Errorf("field calldata of message band.oracle.v1.RawRequest is not mutable"))
+	case "band.oracle.v1.RawRequest.data_source_version":
+		panic(fmt.Errorf("field data_source_version of message band.oracle.v1.RawRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
@@ -1806,6 +1825,8 @@ func (x *fastReflection_RawRequest) NewField(fd protoreflect.FieldDescriptor) pr
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "band.oracle.v1.RawRequest.calldata":
 		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.RawRequest.data_source_version":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
@@ -1885,6 +1906,9 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.DataSourceVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceVersion))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1914,6 +1938,11 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DataSourceVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceVersion))
+			i--
+			dAtA[i] = 0x20
+		}
 		if len(x.Calldata) > 0 {
 			i -= len(x.Calldata)
 			copy(dAtA[i:], x.Calldata)
@@ -2052,6 +2081,25 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 					x.Calldata = []byte{}
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceVersion", wireType)
+				}
+				x.DataSourceVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DataSourceVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2754,20 +2802,21 @@ func (x *_Request_13_list) IsValid() bool {
 }
 
 var (
-	md_Request                      protoreflect.MessageDescriptor
-	fd_Request_oracle_script_id     protoreflect.FieldDescriptor
-	fd_Request_calldata             protoreflect.FieldDescriptor
-	fd_Request_requested_validators protoreflect.FieldDescriptor
-	fd_Request_min_count            protoreflect.FieldDescriptor
-	fd_Request_request_height       protoreflect.FieldDescriptor
-	fd_Request_request_time         protoreflect.FieldDescriptor
-	fd_Request_client_id            protoreflect.FieldDescriptor
-	fd_Request_raw_requests         protoreflect.FieldDescriptor
-	fd_Request_ibc_channel          protoreflect.FieldDescriptor
-	fd_Request_execute_gas          protoreflect.FieldDescriptor
-	fd_Request_tss_encoder          protoreflect.FieldDescriptor
-	fd_Request_requester            protoreflect.FieldDescriptor
-	fd_Request_fee_limit            protoreflect.FieldDescriptor
+	md_Request                       protoreflect.MessageDescriptor
+	fd_Request_oracle_script_id      protoreflect.FieldDescriptor
+	fd_Request_calldata              protoreflect.FieldDescriptor
+	fd_Request_requested_validators  protoreflect.FieldDescriptor
+	fd_Request_min_count             protoreflect.FieldDescriptor
+	fd_Request_request_height        protoreflect.FieldDescriptor
+	fd_Request_request_time          protoreflect.FieldDescriptor
+	fd_Request_client_id             protoreflect.FieldDescriptor
+	fd_Request_raw_requests          protoreflect.FieldDescriptor
+	fd_Request_ibc_channel           protoreflect.FieldDescriptor
+	fd_Request_execute_gas           protoreflect.FieldDescriptor
+	fd_Request_tss_encoder           protoreflect.FieldDescriptor
+	fd_Request_requester             protoreflect.FieldDescriptor
+	fd_Request_fee_limit             protoreflect.FieldDescriptor
+	fd_Request_oracle_script_version protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2786,6 +2835,7 @@ func init() {
 	fd_Request_tss_encoder = md_Request.Fields().ByName("tss_encoder")
 	fd_Request_requester = md_Request.Fields().ByName("requester")
 	fd_Request_fee_limit = md_Request.Fields().ByName("fee_limit")
+	fd_Request_oracle_script_version = md_Request.Fields().ByName("oracle_script_version")
 }
 
 var _ protoreflect.Message = (*fastReflection_Request)(nil)
@@ -2931,6 +2981,12 @@ func (x *fastReflection_Request) Range(f func(protoreflect.FieldDescriptor, prot
 			return
 		}
 	}
+	if x.OracleScriptVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptVersion)
+		if !f(fd_Request_oracle_script_version, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2972,6 +3028,8 @@ func (x *fastReflection_Request) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Requester != ""
 	case "band.oracle.v1.Request.fee_limit":
 		return len(x.FeeLimit) != 0
+	case "band.oracle.v1.Request.oracle_script_version":
+		return x.OracleScriptVersion != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
@@ -3014,6 +3072,8 @@ func (x *fastReflection_Request) Clear(fd protoreflect.FieldDescriptor) {
 		x.Requester = ""
 	case "band.oracle.v1.Request.fee_limit":
 		x.FeeLimit = nil
+	case "band.oracle.v1.Request.oracle_script_version":
+		x.OracleScriptVersion = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
@@ -3078,6 +3138,9 @@ func (x *fastReflection_Request) Get(descriptor protoreflect.FieldDescriptor) pr
 		}
 		listValue := &_Request_13_list{list: &x.FeeLimit}
 		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.Request.oracle_script_version":
+		value := x.OracleScriptVersion
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
@@ -3130,6 +3193,8 @@ func (x *fastReflection_Request) Set(fd protoreflect.FieldDescriptor, value prot
 		lv := value.List()
 		clv := lv.(*_Request_13_list)
 		x.FeeLimit = *clv.list
+	case "band.oracle.v1.Request.oracle_script_version":
+		x.OracleScriptVersion = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
@@ -3191,6 +3256,8 @@ func (x *fastReflection_Request) Mutable(fd protoreflect.FieldDescriptor) protor
 		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.Request is not mutable"))
 	case "band.oracle.v1.Request.requester":
 		panic(fmt.Errorf("field requester of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.oracle_script_version":
+		panic(fmt.Errorf("field oracle_script_version of message band.oracle.v1.Request is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
@@ -3234,6 +3301,8 @@ func (x *fastReflection_Request) NewField(fd protoreflect.FieldDescriptor) proto
 	case "band.oracle.v1.Request.fee_limit":
 		list := []*v1beta1.Coin{}
 		return protoreflect.ValueOfList(&_Request_13_list{list: &list})
+	case "band.oracle.v1.Request.oracle_script_version":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
@@ -3355,6 +3424,9 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.OracleScriptVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptVersion))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3384,6 +3456,11 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.OracleScriptVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptVersion))
+			i--
+			dAtA[i] = 0x70
+		}
 		if len(x.FeeLimit) > 0 {
 			for iNdEx := len(x.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.FeeLimit[iNdEx])
@@ -3887,6 +3964,25 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
+				}
+				x.OracleScriptVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8518,27 +8614,35 @@ func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_ActiveValidator         protoreflect.MessageDescriptor
-	fd_ActiveValidator_address protoreflect.FieldDescriptor
-	fd_ActiveValidator_power   protoreflect.FieldDescriptor
+	md_ValidatorReportInfo                        protoreflect.MessageDescriptor
+	fd_ValidatorReportInfo_validator              protoreflect.FieldDescriptor
+	fd_ValidatorReportInfo_window_size            protoreflect.FieldDescriptor
+	fd_ValidatorReportInfo_index_offset           protoreflect.FieldDescriptor
+	fd_ValidatorReportInfo_missed_reports_counter protoreflect.FieldDescriptor
+	fd_ValidatorReportInfo_total_reports          protoreflect.FieldDescriptor
+	fd_ValidatorReportInfo_total_missed_reports   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_ActiveValidator = File_band_oracle_v1_oracle_proto.Messages().ByName("ActiveValidator")
-	fd_ActiveValidator_address = md_ActiveValidator.Fields().ByName("address")
-	fd_ActiveValidator_power = md_ActiveValidator.Fields().ByName("power")
+	md_ValidatorReportInfo = File_band_oracle_v1_oracle_proto.Messages().ByName("ValidatorReportInfo")
+	fd_ValidatorReportInfo_validator = md_ValidatorReportInfo.Fields().ByName("validator")
+	fd_ValidatorReportInfo_window_size = md_ValidatorReportInfo.Fields().ByName("window_size")
+	fd_ValidatorReportInfo_index_offset = md_ValidatorReportInfo.Fields().ByName("index_offset")
+	fd_ValidatorReportInfo_missed_reports_counter = md_ValidatorReportInfo.Fields().ByName("missed_reports_counter")
+	fd_ValidatorReportInfo_total_reports = md_ValidatorReportInfo.Fields().ByName("total_reports")
+	fd_ValidatorReportInfo_total_missed_reports = md_ValidatorReportInfo.Fields().ByName("total_missed_reports")
 }
 
-var _ protoreflect.Message = (*fastReflection_ActiveValidator)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorReportInfo)(nil)
 
-type fastReflection_ActiveValidator ActiveValidator
+type fastReflection_ValidatorReportInfo ValidatorReportInfo
 
-func (x *ActiveValidator) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ActiveValidator)(x)
+func (x *ValidatorReportInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorReportInfo)(x)
 }
 
-func (x *ActiveValidator) slowProtoReflect() protoreflect.Message {
+func (x *ValidatorReportInfo) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8550,43 +8654,43 @@ func (x *ActiveValidator) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ActiveValidator_messageType fastReflection_ActiveValidator_messageType
-var _ protoreflect.MessageType = fastReflection_ActiveValidator_messageType{}
+var _fastReflection_ValidatorReportInfo_messageType fastReflection_ValidatorReportInfo_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorReportInfo_messageType{}
 
-type fastReflection_ActiveValidator_messageType struct{}
+type fastReflection_ValidatorReportInfo_messageType struct{}
 
-func (x fastReflection_ActiveValidator_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ActiveValidator)(nil)
+func (x fastReflection_ValidatorReportInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorReportInfo)(nil)
 }
-func (x fastReflection_ActiveValidator_messageType) New() protoreflect.Message {
-	return new(fastReflection_ActiveValidator)
+func (x fastReflection_ValidatorReportInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorReportInfo)
 }
-func (x fastReflection_ActiveValidator_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ActiveValidator
+func (x fastReflection_ValidatorReportInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorReportInfo
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ActiveValidator) Descriptor() protoreflect.MessageDescriptor {
-	return md_ActiveValidator
+func (x *fastReflection_ValidatorReportInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorReportInfo
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ActiveValidator) Type() protoreflect.MessageType {
-	return _fastReflection_ActiveValidator_messageType
+func (x *fastReflection_ValidatorReportInfo) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorReportInfo_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ActiveValidator) New() protoreflect.Message {
-	return new(fastReflection_ActiveValidator)
+func (x *fastReflection_ValidatorReportInfo) New() protoreflect.Message {
+	return new(fastReflection_ValidatorReportInfo)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ActiveValidator) Interface() protoreflect.ProtoMessage {
-	return (*ActiveValidator)(x)
+func (x *fastReflection_ValidatorReportInfo) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorReportInfo)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8594,16 +8698,40 @@ func (x *fastReflection_ActiveValidator) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ActiveValidator) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_ActiveValidator_address, value) {
+func (x *fastReflection_ValidatorReportInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_ValidatorReportInfo_validator, value) {
 			return
 		}
 	}
-	if x.Power != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Power)
-		if !f(fd_ActiveValidator_power, value) {
+	if x.WindowSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WindowSize)
+		if !f(fd_ValidatorReportInfo_window_size, value) {
+			return
+		}
+	}
+	if x.IndexOffset != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IndexOffset)
+		if !f(fd_ValidatorReportInfo_index_offset, value) {
+			return
+		}
+	}
+	if x.MissedReportsCounter != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MissedReportsCounter)
+		if !f(fd_ValidatorReportInfo_missed_reports_counter, value) {
+			return
+		}
+	}
+	if x.TotalReports != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalReports)
+		if !f(fd_ValidatorReportInfo_total_reports, value) {
+			return
+		}
+	}
+	if x.TotalMissedReports != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalMissedReports)
+		if !f(fd_ValidatorReportInfo_total_missed_reports, value) {
 			return
 		}
 	}
@@ -8620,17 +8748,25 @@ func (x *fastReflection_ActiveValidator) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ActiveValidator) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorReportInfo) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.ActiveValidator.address":
-		return x.Address != ""
-	case "band.oracle.v1.ActiveValidator.power":
-		return x.Power != uint64(0)
+	case "band.oracle.v1.ValidatorReportInfo.validator":
+		return x.Validator != ""
+	case "band.oracle.v1.ValidatorReportInfo.window_size":
+		return x.WindowSize != uint64(0)
+	case "band.oracle.v1.ValidatorReportInfo.index_offset":
+		return x.IndexOffset != uint64(0)
+	case "band.oracle.v1.ValidatorReportInfo.missed_reports_counter":
+		return x.MissedReportsCounter != uint64(0)
+	case "band.oracle.v1.ValidatorReportInfo.total_reports":
+		return x.TotalReports != uint64(0)
+	case "band.oracle.v1.ValidatorReportInfo.total_missed_reports":
+		return x.TotalMissedReports != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorReportInfo"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorReportInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8640,17 +8776,25 @@ func (x *fastReflection_ActiveValidator) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ActiveValidator) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorReportInfo) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.ActiveValidator.address":
-		x.Address = ""
-	case "band.oracle.v1.ActiveValidator.power":
-		x.Power = uint64(0)
+	case "band.oracle.v1.ValidatorReportInfo.validator":
+		x.Validator = ""
+	case "band.oracle.v1.ValidatorReportInfo.window_size":
+		x.WindowSize = uint64(0)
+	case "band.oracle.v1.ValidatorReportInfo.index_offset":
+		x.IndexOffset = uint64(0)
+	case "band.oracle.v1.ValidatorReportInfo.missed_reports_counter":
+		x.MissedReportsCounter = uint64(0)
+	case "band.oracle.v1.ValidatorReportInfo.total_reports":
+		x.TotalReports = uint64(0)
+	case "band.oracle.v1.ValidatorReportInfo.total_missed_reports":
+		x.TotalMissedReports = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorReportInfo"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorReportInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8660,19 +8804,31 @@ func (x *fastReflection_ActiveValidator) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ActiveValidator) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorReportInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.ActiveValidator.address":
-		value := x.Address
+	case "band.oracle.v1.ValidatorReportInfo.validator":
+		value := x.Validator
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.ActiveValidator.power":
-		value := x.Power
+	case "band.oracle.v1.ValidatorReportInfo.window_size":
+		value := x.WindowSize
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.ValidatorReportInfo.index_offset":
+		value := x.IndexOffset
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.ValidatorReportInfo.missed_reports_counter":
+		value := x.MissedReportsCounter
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.ValidatorReportInfo.total_reports":
+		value := x.TotalReports
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.ValidatorReportInfo.total_missed_reports":
+		value := x.TotalMissedReports
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorReportInfo"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorReportInfo does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8686,17 +8842,25 @@ func (x *fastReflection_ActiveValidator) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ActiveValidator) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorReportInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.ActiveValidator.address":
-		x.Address = value.Interface().(string)
-	case "band.oracle.v1.ActiveValidator.power":
-		x.Power = value.Uint()
+	case "band.oracle.v1.ValidatorReportInfo.validator":
+		x.Validator = value.Interface().(string)
+	case "band.oracle.v1.ValidatorReportInfo.window_size":
+		x.WindowSize = value.Uint()
+	case "band.oracle.v1.ValidatorReportInfo.index_offset":
+		x.IndexOffset = value.Uint()
+	case "band.oracle.v1.ValidatorReportInfo.missed_reports_counter":
+		x.MissedReportsCounter = value.Uint()
+	case "band.oracle.v1.ValidatorReportInfo.total_reports":
+		x.TotalReports = value.Uint()
+	case "band.oracle.v1.ValidatorReportInfo.total_missed_reports":
+		x.TotalMissedReports = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorReportInfo"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorReportInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8710,44 +8874,60 @@ func (x *fastReflection_ActiveValidator) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ActiveValidator) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorReportInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.ActiveValidator.address":
-		panic(fmt.Errorf("field address of message band.oracle.v1.ActiveValidator is not mutable"))
-	case "band.oracle.v1.ActiveValidator.power":
-		panic(fmt.Errorf("field power of message band.oracle.v1.ActiveValidator is not mutable"))
+	case "band.oracle.v1.ValidatorReportInfo.validator":
+		panic(fmt.Errorf("field validator of message band.oracle.v1.ValidatorReportInfo is not mutable"))
+	case "band.oracle.v1.ValidatorReportInfo.window_size":
+		panic(fmt.Errorf("field window_size of message band.oracle.v1.ValidatorReportInfo is not mutable"))
+	case "band.oracle.v1.ValidatorReportInfo.index_offset":
+		panic(fmt.Errorf("field index_offset of message band.oracle.v1.ValidatorReportInfo is not mutable"))
+	case "band.oracle.v1.ValidatorReportInfo.missed_reports_counter":
+		panic(fmt.Errorf("field missed_reports_counter of message band.oracle.v1.ValidatorReportInfo is not mutable"))
+	case "band.oracle.v1.ValidatorReportInfo.total_reports":
+		panic(fmt.Errorf("field total_reports of message band.oracle.v1.ValidatorReportInfo is not mutable"))
+	case "band.oracle.v1.ValidatorReportInfo.total_missed_reports":
+		panic(fmt.Errorf("field total_missed_reports of message band.oracle.v1.ValidatorReportInfo is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorReportInfo"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorReportInfo does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ActiveValidator) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorReportInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.ActiveValidator.address":
+	case "band.oracle.v1.ValidatorReportInfo.validator":
 		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.ActiveValidator.power":
+	case "band.oracle.v1.ValidatorReportInfo.window_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.ValidatorReportInfo.index_offset":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.ValidatorReportInfo.missed_reports_counter":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.ValidatorReportInfo.total_reports":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.ValidatorReportInfo.total_missed_reports":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorReportInfo"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorReportInfo does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ActiveValidator) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorReportInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.ActiveValidator", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.ValidatorReportInfo", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8755,7 +8935,7 @@ func (x *fastReflection_ActiveValidator) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ActiveValidator) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorReportInfo) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8766,7 +8946,7 @@ func (x *fastReflection_ActiveValidator) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ActiveValidator) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorReportInfo) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8778,7 +8958,7 @@ func (x *fastReflection_ActiveValidator) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ActiveValidator) IsValid() bool {
+func (x *fastReflection_ValidatorReportInfo) IsValid() bool {
 	return x != nil
 }
 
@@ -8788,9 +8968,9 @@ func (x *fastReflection_ActiveValidator) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorReportInfo) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ActiveValidator)
+		x := input.Message.Interface().(*ValidatorReportInfo)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8802,12 +8982,24 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
+		l = len(x.Validator)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Power != 0 {
-			n += 1 + runtime.Sov(uint64(x.Power))
+		if x.WindowSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.WindowSize))
+		}
+		if x.IndexOffset != 0 {
+			n += 1 + runtime.Sov(uint64(x.IndexOffset))
+		}
+		if x.MissedReportsCounter != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissedReportsCounter))
+		}
+		if x.TotalReports != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalReports))
+		}
+		if x.TotalMissedReports != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalMissedReports))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8819,7 +9011,7 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ActiveValidator)
+		x := input.Message.Interface().(*ValidatorReportInfo)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8838,15 +9030,35 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Power != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
+		if x.TotalMissedReports != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalMissedReports))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.TotalReports != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalReports))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MissedReportsCounter != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissedReportsCounter))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.IndexOffset != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IndexOffset))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.WindowSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WindowSize))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -8861,7 +9073,7 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ActiveValidator)
+		x := input.Message.Interface().(*ValidatorReportInfo)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8893,15 +9105,15 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActiveValidator: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorReportInfo: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActiveValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorReportInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8929,13 +9141,13 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
+				x.Validator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WindowSize", wireType)
 				}
-				x.Power = 0
+				x.WindowSize = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8945,86 +9157,144 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Power |= uint64(b&0x7F) << shift
+					x.WindowSize |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.IndexOffset = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IndexOffset |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedReportsCounter", wireType)
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.MissedReportsCounter = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MissedReportsCounter |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalReports", wireType)
+				}
+				x.TotalReports = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalReports |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalMissedReports", wireType)
+				}
+				x.TotalMissedReports = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalMissedReports |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
-	md_Params                           protoreflect.MessageDescriptor
-	fd_Params_max_raw_request_count     protoreflect.FieldDescriptor
-	fd_Params_max_ask_count             protoreflect.FieldDescriptor
-	fd_Params_max_calldata_size         protoreflect.FieldDescriptor
-	fd_Params_max_report_data_size      protoreflect.FieldDescriptor
-	fd_Params_expiration_block_count    protoreflect.FieldDescriptor
-	fd_Params_base_owasm_gas            protoreflect.FieldDescriptor
-	fd_Params_per_validator_request_gas protoreflect.FieldDescriptor
-	fd_Params_sampling_try_count        protoreflect.FieldDescriptor
-	fd_Params_oracle_reward_percentage  protoreflect.FieldDescriptor
-	fd_Params_inactive_penalty_duration protoreflect.FieldDescriptor
-	fd_Params_ibc_request_enabled       protoreflect.FieldDescriptor
+	md_ActiveValidator         protoreflect.MessageDescriptor
+	fd_ActiveValidator_address protoreflect.FieldDescriptor
+	fd_ActiveValidator_power   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_Params = File_band_oracle_v1_oracle_proto.Messages().ByName("Params")
-	fd_Params_max_raw_request_count = md_Params.Fields().ByName("max_raw_request_count")
-	fd_Params_max_ask_count = md_Params.Fields().ByName("max_ask_count")
-	fd_Params_max_calldata_size = md_Params.Fields().ByName("max_calldata_size")
-	fd_Params_max_report_data_size = md_Params.Fields().ByName("max_report_data_size")
-	fd_Params_expiration_block_count = md_Params.Fields().ByName("expiration_block_count")
-	fd_Params_base_owasm_gas = md_Params.Fields().ByName("base_owasm_gas")
-	fd_Params_per_validator_request_gas = md_Params.Fields().ByName("per_validator_request_gas")
-	fd_Params_sampling_try_count = md_Params.Fields().ByName("sampling_try_count")
-	fd_Params_oracle_reward_percentage = md_Params.Fields().ByName("oracle_reward_percentage")
-	fd_Params_inactive_penalty_duration = md_Params.Fields().ByName("inactive_penalty_duration")
-	fd_Params_ibc_request_enabled = md_Params.Fields().ByName("ibc_request_enabled")
+	md_ActiveValidator = File_band_oracle_v1_oracle_proto.Messages().ByName("ActiveValidator")
+	fd_ActiveValidator_address = md_ActiveValidator.Fields().ByName("address")
+	fd_ActiveValidator_power = md_ActiveValidator.Fields().ByName("power")
 }
 
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
+var _ protoreflect.Message = (*fastReflection_ActiveValidator)(nil)
 
-type fastReflection_Params Params
+type fastReflection_ActiveValidator ActiveValidator
 
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
+func (x *ActiveValidator) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ActiveValidator)(x)
 }
 
-func (x *Params) slowProtoReflect() protoreflect.Message {
+func (x *ActiveValidator) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9036,43 +9306,43 @@ func (x *Params) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+var _fastReflection_ActiveValidator_messageType fastReflection_ActiveValidator_messageType
+var _ protoreflect.MessageType = fastReflection_ActiveValidator_messageType{}
 
-type fastReflection_Params_messageType struct{}
+type fastReflection_ActiveValidator_messageType struct{}
 
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
+func (x fastReflection_ActiveValidator_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ActiveValidator)(nil)
 }
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x fastReflection_ActiveValidator_messageType) New() protoreflect.Message {
+	return new(fastReflection_ActiveValidator)
 }
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x fastReflection_ActiveValidator_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ActiveValidator
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x *fastReflection_ActiveValidator) Descriptor() protoreflect.MessageDescriptor {
+	return md_ActiveValidator
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
+func (x *fastReflection_ActiveValidator) Type() protoreflect.MessageType {
+	return _fastReflection_ActiveValidator_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x *fastReflection_ActiveValidator) New() protoreflect.Message {
+	return new(fastReflection_ActiveValidator)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
+func (x *fastReflection_ActiveValidator) Interface() protoreflect.ProtoMessage {
+	return (*ActiveValidator)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9080,70 +9350,16 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.MaxRawRequestCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MaxRawRequestCount)
-		if !f(fd_Params_max_raw_request_count, value) {
-			return
-		}
-	}
-	if x.MaxAskCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MaxAskCount)
-		if !f(fd_Params_max_ask_count, value) {
-			return
-		}
-	}
-	if x.MaxCalldataSize != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MaxCalldataSize)
-		if !f(fd_Params_max_calldata_size, value) {
-			return
-		}
-	}
-	if x.MaxReportDataSize != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MaxReportDataSize)
-		if !f(fd_Params_max_report_data_size, value) {
-			return
-		}
-	}
-	if x.ExpirationBlockCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExpirationBlockCount)
-		if !f(fd_Params_expiration_block_count, value) {
-			return
-		}
-	}
-	if x.BaseOwasmGas != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BaseOwasmGas)
-		if !f(fd_Params_base_owasm_gas, value) {
-			return
-		}
-	}
-	if x.PerValidatorRequestGas != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.PerValidatorRequestGas)
-		if !f(fd_Params_per_validator_request_gas, value) {
-			return
-		}
-	}
-	if x.SamplingTryCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.SamplingTryCount)
-		if !f(fd_Params_sampling_try_count, value) {
-			return
-		}
-	}
-	if x.OracleRewardPercentage != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OracleRewardPercentage)
-		if !f(fd_Params_oracle_reward_percentage, value) {
-			return
-		}
-	}
-	if x.InactivePenaltyDuration != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.InactivePenaltyDuration)
-		if !f(fd_Params_inactive_penalty_duration, value) {
+func (x *fastReflection_ActiveValidator) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ActiveValidator_address, value) {
 			return
 		}
 	}
-	if x.IbcRequestEnabled != false {
-		value := protoreflect.ValueOfBool(x.IbcRequestEnabled)
-		if !f(fd_Params_ibc_request_enabled, value) {
+	if x.Power != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Power)
+		if !f(fd_ActiveValidator_power, value) {
 			return
 		}
 	}
@@ -9160,35 +9376,17 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ActiveValidator) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.Params.max_raw_request_count":
-		return x.MaxRawRequestCount != uint64(0)
-	case "band.oracle.v1.Params.max_ask_count":
-		return x.MaxAskCount != uint64(0)
-	case "band.oracle.v1.Params.max_calldata_size":
-		return x.MaxCalldataSize != uint64(0)
-	case "band.oracle.v1.Params.max_report_data_size":
-		return x.MaxReportDataSize != uint64(0)
-	case "band.oracle.v1.Params.expiration_block_count":
-		return x.ExpirationBlockCount != uint64(0)
-	case "band.oracle.v1.Params.base_owasm_gas":
-		return x.BaseOwasmGas != uint64(0)
-	case "band.oracle.v1.Params.per_validator_request_gas":
-		return x.PerValidatorRequestGas != uint64(0)
-	case "band.oracle.v1.Params.sampling_try_count":
-		return x.SamplingTryCount != uint64(0)
-	case "band.oracle.v1.Params.oracle_reward_percentage":
-		return x.OracleRewardPercentage != uint64(0)
-	case "band.oracle.v1.Params.inactive_penalty_duration":
-		return x.InactivePenaltyDuration != uint64(0)
-	case "band.oracle.v1.Params.ibc_request_enabled":
-		return x.IbcRequestEnabled != false
+	case "band.oracle.v1.ActiveValidator.address":
+		return x.Address != ""
+	case "band.oracle.v1.ActiveValidator.power":
+		return x.Power != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9198,35 +9396,17 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ActiveValidator) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Params.max_raw_request_count":
-		x.MaxRawRequestCount = uint64(0)
-	case "band.oracle.v1.Params.max_ask_count":
-		x.MaxAskCount = uint64(0)
-	case "band.oracle.v1.Params.max_calldata_size":
-		x.MaxCalldataSize = uint64(0)
-	case "band.oracle.v1.Params.max_report_data_size":
-		x.MaxReportDataSize = uint64(0)
-	case "band.oracle.v1.Params.expiration_block_count":
-		x.ExpirationBlockCount = uint64(0)
-	case "band.oracle.v1.Params.base_owasm_gas":
-		x.BaseOwasmGas = uint64(0)
-	case "band.oracle.v1.Params.per_validator_request_gas":
-		x.PerValidatorRequestGas = uint64(0)
-	case "band.oracle.v1.Params.sampling_try_count":
-		x.SamplingTryCount = uint64(0)
-	case "band.oracle.v1.Params.oracle_reward_percentage":
-		x.OracleRewardPercentage = uint64(0)
-	case "band.oracle.v1.Params.inactive_penalty_duration":
-		x.InactivePenaltyDuration = uint64(0)
-	case "band.oracle.v1.Params.ibc_request_enabled":
-		x.IbcRequestEnabled = false
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
+	case "band.oracle.v1.ActiveValidator.address":
+		x.Address = ""
+	case "band.oracle.v1.ActiveValidator.power":
+		x.Power = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9236,46 +9416,19 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ActiveValidator) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.Params.max_raw_request_count":
-		value := x.MaxRawRequestCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.max_ask_count":
-		value := x.MaxAskCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.max_calldata_size":
-		value := x.MaxCalldataSize
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.max_report_data_size":
-		value := x.MaxReportDataSize
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.expiration_block_count":
-		value := x.ExpirationBlockCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.base_owasm_gas":
-		value := x.BaseOwasmGas
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.per_validator_request_gas":
-		value := x.PerValidatorRequestGas
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.sampling_try_count":
-		value := x.SamplingTryCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.oracle_reward_percentage":
-		value := x.OracleRewardPercentage
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.inactive_penalty_duration":
-		value := x.InactivePenaltyDuration
+	case "band.oracle.v1.ActiveValidator.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.ActiveValidator.power":
+		value := x.Power
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.ibc_request_enabled":
-		value := x.IbcRequestEnabled
-		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9289,35 +9442,17 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ActiveValidator) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Params.max_raw_request_count":
-		x.MaxRawRequestCount = value.Uint()
-	case "band.oracle.v1.Params.max_ask_count":
-		x.MaxAskCount = value.Uint()
-	case "band.oracle.v1.Params.max_calldata_size":
-		x.MaxCalldataSize = value.Uint()
-	case "band.oracle.v1.Params.max_report_data_size":
-		x.MaxReportDataSize = value.Uint()
-	case "band.oracle.v1.Params.expiration_block_count":
-		x.ExpirationBlockCount = value.Uint()
-	case "band.oracle.v1.Params.base_owasm_gas":
-		x.BaseOwasmGas = value.Uint()
-	case "band.oracle.v1.Params.per_validator_request_gas":
-		x.PerValidatorRequestGas = value.Uint()
-	case "band.oracle.v1.Params.sampling_try_count":
-		x.SamplingTryCount = value.Uint()
-	case "band.oracle.v1.Params.oracle_reward_percentage":
-		x.OracleRewardPercentage = value.Uint()
-	case "band.oracle.v1.Params.inactive_penalty_duration":
-		x.InactivePenaltyDuration = value.Uint()
-	case "band.oracle.v1.Params.ibc_request_enabled":
-		x.IbcRequestEnabled = value.Bool()
+	case "band.oracle.v1.ActiveValidator.address":
+		x.Address = value.Interface().(string)
+	case "band.oracle.v1.ActiveValidator.power":
+		x.Power = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9331,80 +9466,44 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ActiveValidator) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Params.max_raw_request_count":
-		panic(fmt.Errorf("field max_raw_request_count of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.max_ask_count":
-		panic(fmt.Errorf("field max_ask_count of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.max_calldata_size":
-		panic(fmt.Errorf("field max_calldata_size of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.max_report_data_size":
-		panic(fmt.Errorf("field max_report_data_size of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.expiration_block_count":
-		panic(fmt.Errorf("field expiration_block_count of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.base_owasm_gas":
-		panic(fmt.Errorf("field base_owasm_gas of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.per_validator_request_gas":
-		panic(fmt.Errorf("field per_validator_request_gas of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.sampling_try_count":
-		panic(fmt.Errorf("field sampling_try_count of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.oracle_reward_percentage":
-		panic(fmt.Errorf("field oracle_reward_percentage of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.inactive_penalty_duration":
-		panic(fmt.Errorf("field inactive_penalty_duration of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.ibc_request_enabled":
-		panic(fmt.Errorf("field ibc_request_enabled of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.ActiveValidator.address":
+		panic(fmt.Errorf("field address of message band.oracle.v1.ActiveValidator is not mutable"))
+	case "band.oracle.v1.ActiveValidator.power":
+		panic(fmt.Errorf("field power of message band.oracle.v1.ActiveValidator is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ActiveValidator) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Params.max_raw_request_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.max_ask_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.max_calldata_size":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.max_report_data_size":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.expiration_block_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.base_owasm_gas":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.per_validator_request_gas":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.sampling_try_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.oracle_reward_percentage":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.inactive_penalty_duration":
+	case "band.oracle.v1.ActiveValidator.address":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.ActiveValidator.power":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.ibc_request_enabled":
-		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ActiveValidator) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.ActiveValidator", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9412,7 +9511,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ActiveValidator) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9423,7 +9522,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ActiveValidator) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9435,7 +9534,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_ActiveValidator) IsValid() bool {
 	return x != nil
 }
 
@@ -9445,9 +9544,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*ActiveValidator)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9459,38 +9558,12 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.MaxRawRequestCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxRawRequestCount))
-		}
-		if x.MaxAskCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxAskCount))
-		}
-		if x.MaxCalldataSize != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxCalldataSize))
-		}
-		if x.MaxReportDataSize != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxReportDataSize))
-		}
-		if x.ExpirationBlockCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExpirationBlockCount))
-		}
-		if x.BaseOwasmGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.BaseOwasmGas))
-		}
-		if x.PerValidatorRequestGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.PerValidatorRequestGas))
-		}
-		if x.SamplingTryCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.SamplingTryCount))
-		}
-		if x.OracleRewardPercentage != 0 {
-			n += 1 + runtime.Sov(uint64(x.OracleRewardPercentage))
-		}
-		if x.InactivePenaltyDuration != 0 {
-			n += 1 + runtime.Sov(uint64(x.InactivePenaltyDuration))
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.IbcRequestEnabled {
-			n += 2
+		if x.Power != 0 {
+			n += 1 + runtime.Sov(uint64(x.Power))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -9502,7 +9575,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*ActiveValidator)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9521,83 +9594,35 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.IbcRequestEnabled {
-			i--
-			if x.IbcRequestEnabled {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x58
-		}
-		if x.InactivePenaltyDuration != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.InactivePenaltyDuration))
-			i--
-			dAtA[i] = 0x50
-		}
-		if x.OracleRewardPercentage != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleRewardPercentage))
-			i--
-			dAtA[i] = 0x48
-		}
-		if x.SamplingTryCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SamplingTryCount))
+		if x.Power != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
 			i--
-			dAtA[i] = 0x40
+			dAtA[i] = 0x10
 		}
-		if x.PerValidatorRequestGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PerValidatorRequestGas))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
-			dAtA[i] = 0x38
+			dAtA[i] = 0xa
 		}
-		if x.BaseOwasmGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseOwasmGas))
-			i--
-			dAtA[i] = 0x30
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
 		}
-		if x.ExpirationBlockCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpirationBlockCount))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.MaxReportDataSize != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxReportDataSize))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.MaxCalldataSize != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxCalldataSize))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.MaxAskCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAskCount))
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.MaxRawRequestCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRawRequestCount))
-			i--
-			dAtA[i] = 0x8
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ActiveValidator)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
 		}
 		options := runtime.UnmarshalInputToOptions(input)
 		_ = options
@@ -9624,150 +9649,17 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActiveValidator: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActiveValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRawRequestCount", wireType)
-				}
-				x.MaxRawRequestCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MaxRawRequestCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAskCount", wireType)
-				}
-				x.MaxAskCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MaxAskCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxCalldataSize", wireType)
-				}
-				x.MaxCalldataSize = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MaxCalldataSize |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxReportDataSize", wireType)
-				}
-				x.MaxReportDataSize = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MaxReportDataSize |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlockCount", wireType)
-				}
-				x.ExpirationBlockCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ExpirationBlockCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseOwasmGas", wireType)
-				}
-				x.BaseOwasmGas = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BaseOwasmGas |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 7:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PerValidatorRequestGas", wireType)
-				}
-				x.PerValidatorRequestGas = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.PerValidatorRequestGas |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SamplingTryCount", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				x.SamplingTryCount = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9777,54 +9669,29 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.SamplingTryCount |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 9:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleRewardPercentage", wireType)
-				}
-				x.OracleRewardPercentage = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OracleRewardPercentage |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			case 10:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InactivePenaltyDuration", wireType)
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.InactivePenaltyDuration = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.InactivePenaltyDuration |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-			case 11:
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcRequestEnabled", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 				}
-				var v int
+				x.Power = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9834,12 +9701,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					x.Power |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.IbcRequestEnabled = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9875,72 +9741,113 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_PendingResolveList_1_list)(nil)
+var _ protoreflect.List = (*_Params_18_list)(nil)
 
-type _PendingResolveList_1_list struct {
-	list *[]uint64
+type _Params_18_list struct {
+	list *[]*v1beta1.Coin
 }
 
-func (x *_PendingResolveList_1_list) Len() int {
+func (x *_Params_18_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_PendingResolveList_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfUint64((*x.list)[i])
+func (x *_Params_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_PendingResolveList_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := valueUnwrapped
+func (x *_Params_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_PendingResolveList_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := valueUnwrapped
+func (x *_Params_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_PendingResolveList_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message PendingResolveList at list field RequestIds as it is not of Message kind"))
+func (x *_Params_18_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_PendingResolveList_1_list) Truncate(n int) {
+func (x *_Params_18_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_PendingResolveList_1_list) NewElement() protoreflect.Value {
-	v := uint64(0)
-	return protoreflect.ValueOfUint64(v)
+func (x *_Params_18_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_PendingResolveList_1_list) IsValid() bool {
+func (x *_Params_18_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_PendingResolveList             protoreflect.MessageDescriptor
-	fd_PendingResolveList_request_ids protoreflect.FieldDescriptor
+	md_Params                                     protoreflect.MessageDescriptor
+	fd_Params_max_raw_request_count               protoreflect.FieldDescriptor
+	fd_Params_max_ask_count                       protoreflect.FieldDescriptor
+	fd_Params_max_calldata_size                   protoreflect.FieldDescriptor
+	fd_Params_max_report_data_size                protoreflect.FieldDescriptor
+	fd_Params_expiration_block_count              protoreflect.FieldDescriptor
+	fd_Params_base_owasm_gas                      protoreflect.FieldDescriptor
+	fd_Params_per_validator_request_gas           protoreflect.FieldDescriptor
+	fd_Params_sampling_try_count                  protoreflect.FieldDescriptor
+	fd_Params_oracle_reward_percentage            protoreflect.FieldDescriptor
+	fd_Params_inactive_penalty_duration           protoreflect.FieldDescriptor
+	fd_Params_ibc_request_enabled                 protoreflect.FieldDescriptor
+	fd_Params_max_latest_result_index_size        protoreflect.FieldDescriptor
+	fd_Params_price_oracle_script_id              protoreflect.FieldDescriptor
+	fd_Params_report_window_size                  protoreflect.FieldDescriptor
+	fd_Params_max_missed_report_percentage        protoreflect.FieldDescriptor
+	fd_Params_max_callback_gas_limit              protoreflect.FieldDescriptor
+	fd_Params_min_subscription_interval           protoreflect.FieldDescriptor
+	fd_Params_subscription_base_request_fee       protoreflect.FieldDescriptor
+	fd_Params_max_subscription_requests_per_block protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_PendingResolveList = File_band_oracle_v1_oracle_proto.Messages().ByName("PendingResolveList")
-	fd_PendingResolveList_request_ids = md_PendingResolveList.Fields().ByName("request_ids")
+	md_Params = File_band_oracle_v1_oracle_proto.Messages().ByName("Params")
+	fd_Params_max_raw_request_count = md_Params.Fields().ByName("max_raw_request_count")
+	fd_Params_max_ask_count = md_Params.Fields().ByName("max_ask_count")
+	fd_Params_max_calldata_size = md_Params.Fields().ByName("max_calldata_size")
+	fd_Params_max_report_data_size = md_Params.Fields().ByName("max_report_data_size")
+	fd_Params_expiration_block_count = md_Params.Fields().ByName("expiration_block_count")
+	fd_Params_base_owasm_gas = md_Params.Fields().ByName("base_owasm_gas")
+	fd_Params_per_validator_request_gas = md_Params.Fields().ByName("per_validator_request_gas")
+	fd_Params_sampling_try_count = md_Params.Fields().ByName("sampling_try_count")
+	fd_Params_oracle_reward_percentage = md_Params.Fields().ByName("oracle_reward_percentage")
+	fd_Params_inactive_penalty_duration = md_Params.Fields().ByName("inactive_penalty_duration")
+	fd_Params_ibc_request_enabled = md_Params.Fields().ByName("ibc_request_enabled")
+	fd_Params_max_latest_result_index_size = md_Params.Fields().ByName("max_latest_result_index_size")
+	fd_Params_price_oracle_script_id = md_Params.Fields().ByName("price_oracle_script_id")
+	fd_Params_report_window_size = md_Params.Fields().ByName("report_window_size")
+	fd_Params_max_missed_report_percentage = md_Params.Fields().ByName("max_missed_report_percentage")
+	fd_Params_max_callback_gas_limit = md_Params.Fields().ByName("max_callback_gas_limit")
+	fd_Params_min_subscription_interval = md_Params.Fields().ByName("min_subscription_interval")
+	fd_Params_subscription_base_request_fee = md_Params.Fields().ByName("subscription_base_request_fee")
+	fd_Params_max_subscription_requests_per_block = md_Params.Fields().ByName("max_subscription_requests_per_block")
 }
 
-var _ protoreflect.Message = (*fastReflection_PendingResolveList)(nil)
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
 
-type fastReflection_PendingResolveList PendingResolveList
+type fastReflection_Params Params
 
-func (x *PendingResolveList) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_PendingResolveList)(x)
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
 }
 
-func (x *PendingResolveList) slowProtoReflect() protoreflect.Message {
+func (x *Params) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9952,43 +9859,43 @@ func (x *PendingResolveList) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_PendingResolveList_messageType fastReflection_PendingResolveList_messageType
-var _ protoreflect.MessageType = fastReflection_PendingResolveList_messageType{}
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
 
-type fastReflection_PendingResolveList_messageType struct{}
+type fastReflection_Params_messageType struct{}
 
-func (x fastReflection_PendingResolveList_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_PendingResolveList)(nil)
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
 }
-func (x fastReflection_PendingResolveList_messageType) New() protoreflect.Message {
-	return new(fastReflection_PendingResolveList)
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
 }
-func (x fastReflection_PendingResolveList_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_PendingResolveList
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_PendingResolveList) Descriptor() protoreflect.MessageDescriptor {
-	return md_PendingResolveList
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_PendingResolveList) Type() protoreflect.MessageType {
-	return _fastReflection_PendingResolveList_messageType
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_PendingResolveList) New() protoreflect.Message {
-	return new(fastReflection_PendingResolveList)
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_PendingResolveList) Interface() protoreflect.ProtoMessage {
-	return (*PendingResolveList)(x)
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9996,10 +9903,118 @@ func (x *fastReflection_PendingResolveList) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_PendingResolveList) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.RequestIds) != 0 {
-		value := protoreflect.ValueOfList(&_PendingResolveList_1_list{list: &x.RequestIds})
-		if !f(fd_PendingResolveList_request_ids, value) {
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxRawRequestCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRawRequestCount)
+		if !f(fd_Params_max_raw_request_count, value) {
+			return
+		}
+	}
+	if x.MaxAskCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxAskCount)
+		if !f(fd_Params_max_ask_count, value) {
+			return
+		}
+	}
+	if x.MaxCalldataSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxCalldataSize)
+		if !f(fd_Params_max_calldata_size, value) {
+			return
+		}
+	}
+	if x.MaxReportDataSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxReportDataSize)
+		if !f(fd_Params_max_report_data_size, value) {
+			return
+		}
+	}
+	if x.ExpirationBlockCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExpirationBlockCount)
+		if !f(fd_Params_expiration_block_count, value) {
+			return
+		}
+	}
+	if x.BaseOwasmGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BaseOwasmGas)
+		if !f(fd_Params_base_owasm_gas, value) {
+			return
+		}
+	}
+	if x.PerValidatorRequestGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PerValidatorRequestGas)
+		if !f(fd_Params_per_validator_request_gas, value) {
+			return
+		}
+	}
+	if x.SamplingTryCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SamplingTryCount)
+		if !f(fd_Params_sampling_try_count, value) {
+			return
+		}
+	}
+	if x.OracleRewardPercentage != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleRewardPercentage)
+		if !f(fd_Params_oracle_reward_percentage, value) {
+			return
+		}
+	}
+	if x.InactivePenaltyDuration != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InactivePenaltyDuration)
+		if !f(fd_Params_inactive_penalty_duration, value) {
+			return
+		}
+	}
+	if x.IbcRequestEnabled != false {
+		value := protoreflect.ValueOfBool(x.IbcRequestEnabled)
+		if !f(fd_Params_ibc_request_enabled, value) {
+			return
+		}
+	}
+	if x.MaxLatestResultIndexSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxLatestResultIndexSize)
+		if !f(fd_Params_max_latest_result_index_size, value) {
+			return
+		}
+	}
+	if x.PriceOracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PriceOracleScriptId)
+		if !f(fd_Params_price_oracle_script_id, value) {
+			return
+		}
+	}
+	if x.ReportWindowSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReportWindowSize)
+		if !f(fd_Params_report_window_size, value) {
+			return
+		}
+	}
+	if x.MaxMissedReportPercentage != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxMissedReportPercentage)
+		if !f(fd_Params_max_missed_report_percentage, value) {
+			return
+		}
+	}
+	if x.MaxCallbackGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxCallbackGasLimit)
+		if !f(fd_Params_max_callback_gas_limit, value) {
+			return
+		}
+	}
+	if x.MinSubscriptionInterval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinSubscriptionInterval)
+		if !f(fd_Params_min_subscription_interval, value) {
+			return
+		}
+	}
+	if len(x.SubscriptionBaseRequestFee) != 0 {
+		value := protoreflect.ValueOfList(&_Params_18_list{list: &x.SubscriptionBaseRequestFee})
+		if !f(fd_Params_subscription_base_request_fee, value) {
+			return
+		}
+	}
+	if x.MaxSubscriptionRequestsPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxSubscriptionRequestsPerBlock)
+		if !f(fd_Params_max_subscription_requests_per_block, value) {
 			return
 		}
 	}
@@ -10016,15 +10031,51 @@ func (x *fastReflection_PendingResolveList) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_PendingResolveList) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.PendingResolveList.request_ids":
-		return len(x.RequestIds) != 0
+	case "band.oracle.v1.Params.max_raw_request_count":
+		return x.MaxRawRequestCount != uint64(0)
+	case "band.oracle.v1.Params.max_ask_count":
+		return x.MaxAskCount != uint64(0)
+	case "band.oracle.v1.Params.max_calldata_size":
+		return x.MaxCalldataSize != uint64(0)
+	case "band.oracle.v1.Params.max_report_data_size":
+		return x.MaxReportDataSize != uint64(0)
+	case "band.oracle.v1.Params.expiration_block_count":
+		return x.ExpirationBlockCount != uint64(0)
+	case "band.oracle.v1.Params.base_owasm_gas":
+		return x.BaseOwasmGas != uint64(0)
+	case "band.oracle.v1.Params.per_validator_request_gas":
+		return x.PerValidatorRequestGas != uint64(0)
+	case "band.oracle.v1.Params.sampling_try_count":
+		return x.SamplingTryCount != uint64(0)
+	case "band.oracle.v1.Params.oracle_reward_percentage":
+		return x.OracleRewardPercentage != uint64(0)
+	case "band.oracle.v1.Params.inactive_penalty_duration":
+		return x.InactivePenaltyDuration != uint64(0)
+	case "band.oracle.v1.Params.ibc_request_enabled":
+		return x.IbcRequestEnabled != false
+	case "band.oracle.v1.Params.max_latest_result_index_size":
+		return x.MaxLatestResultIndexSize != uint64(0)
+	case "band.oracle.v1.Params.price_oracle_script_id":
+		return x.PriceOracleScriptId != uint64(0)
+	case "band.oracle.v1.Params.report_window_size":
+		return x.ReportWindowSize != uint64(0)
+	case "band.oracle.v1.Params.max_missed_report_percentage":
+		return x.MaxMissedReportPercentage != uint64(0)
+	case "band.oracle.v1.Params.max_callback_gas_limit":
+		return x.MaxCallbackGasLimit != uint64(0)
+	case "band.oracle.v1.Params.min_subscription_interval":
+		return x.MinSubscriptionInterval != uint64(0)
+	case "band.oracle.v1.Params.subscription_base_request_fee":
+		return len(x.SubscriptionBaseRequestFee) != 0
+	case "band.oracle.v1.Params.max_subscription_requests_per_block":
+		return x.MaxSubscriptionRequestsPerBlock != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10034,15 +10085,51 @@ func (x *fastReflection_PendingResolveList) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PendingResolveList) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.PendingResolveList.request_ids":
-		x.RequestIds = nil
+	case "band.oracle.v1.Params.max_raw_request_count":
+		x.MaxRawRequestCount = uint64(0)
+	case "band.oracle.v1.Params.max_ask_count":
+		x.MaxAskCount = uint64(0)
+	case "band.oracle.v1.Params.max_calldata_size":
+		x.MaxCalldataSize = uint64(0)
+	case "band.oracle.v1.Params.max_report_data_size":
+		x.MaxReportDataSize = uint64(0)
+	case "band.oracle.v1.Params.expiration_block_count":
+		x.ExpirationBlockCount = uint64(0)
+	case "band.oracle.v1.Params.base_owasm_gas":
+		x.BaseOwasmGas = uint64(0)
+	case "band.oracle.v1.Params.per_validator_request_gas":
+		x.PerValidatorRequestGas = uint64(0)
+	case "band.oracle.v1.Params.sampling_try_count":
+		x.SamplingTryCount = uint64(0)
+	case "band.oracle.v1.Params.oracle_reward_percentage":
+		x.OracleRewardPercentage = uint64(0)
+	case "band.oracle.v1.Params.inactive_penalty_duration":
+		x.InactivePenaltyDuration = uint64(0)
+	case "band.oracle.v1.Params.ibc_request_enabled":
+		x.IbcRequestEnabled = false
+	case "band.oracle.v1.Params.max_latest_result_index_size":
+		x.MaxLatestResultIndexSize = uint64(0)
+	case "band.oracle.v1.Params.price_oracle_script_id":
+		x.PriceOracleScriptId = uint64(0)
+	case "band.oracle.v1.Params.report_window_size":
+		x.ReportWindowSize = uint64(0)
+	case "band.oracle.v1.Params.max_missed_report_percentage":
+		x.MaxMissedReportPercentage = uint64(0)
+	case "band.oracle.v1.Params.max_callback_gas_limit":
+		x.MaxCallbackGasLimit = uint64(0)
+	case "band.oracle.v1.Params.min_subscription_interval":
+		x.MinSubscriptionInterval = uint64(0)
+	case "band.oracle.v1.Params.subscription_base_request_fee":
+		x.SubscriptionBaseRequestFee = nil
+	case "band.oracle.v1.Params.max_subscription_requests_per_block":
+		x.MaxSubscriptionRequestsPerBlock = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10052,43 +10139,133 @@ func (x *fastReflection_PendingResolveList) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_PendingResolveList) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.PendingResolveList.request_ids":
-		if len(x.RequestIds) == 0 {
-			return protoreflect.ValueOfList(&_PendingResolveList_1_list{})
-		}
-		listValue := &_PendingResolveList_1_list{list: &x.RequestIds}
-		return protoreflect.ValueOfList(listValue)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
-		}
-		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", descriptor.FullName()))
-	}
-}
-
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PendingResolveList) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "band.oracle.v1.PendingResolveList.request_ids":
-		lv := value.List()
-		clv := lv.(*_PendingResolveList_1_list)
-		x.RequestIds = *clv.list
+	case "band.oracle.v1.Params.max_raw_request_count":
+		value := x.MaxRawRequestCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.max_ask_count":
+		value := x.MaxAskCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.max_calldata_size":
+		value := x.MaxCalldataSize
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.max_report_data_size":
+		value := x.MaxReportDataSize
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.expiration_block_count":
+		value := x.ExpirationBlockCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.base_owasm_gas":
+		value := x.BaseOwasmGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.per_validator_request_gas":
+		value := x.PerValidatorRequestGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.sampling_try_count":
+		value := x.SamplingTryCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.oracle_reward_percentage":
+		value := x.OracleRewardPercentage
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.inactive_penalty_duration":
+		value := x.InactivePenaltyDuration
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.ibc_request_enabled":
+		value := x.IbcRequestEnabled
+		return protoreflect.ValueOfBool(value)
+	case "band.oracle.v1.Params.max_latest_result_index_size":
+		value := x.MaxLatestResultIndexSize
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.price_oracle_script_id":
+		value := x.PriceOracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.report_window_size":
+		value := x.ReportWindowSize
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.max_missed_report_percentage":
+		value := x.MaxMissedReportPercentage
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.max_callback_gas_limit":
+		value := x.MaxCallbackGasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.min_subscription_interval":
+		value := x.MinSubscriptionInterval
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.subscription_base_request_fee":
+		if len(x.SubscriptionBaseRequestFee) == 0 {
+			return protoreflect.ValueOfList(&_Params_18_list{})
+		}
+		listValue := &_Params_18_list{list: &x.SubscriptionBaseRequestFee}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.Params.max_subscription_requests_per_block":
+		value := x.MaxSubscriptionRequestsPerBlock
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.Params.max_raw_request_count":
+		x.MaxRawRequestCount = value.Uint()
+	case "band.oracle.v1.Params.max_ask_count":
+		x.MaxAskCount = value.Uint()
+	case "band.oracle.v1.Params.max_calldata_size":
+		x.MaxCalldataSize = value.Uint()
+	case "band.oracle.v1.Params.max_report_data_size":
+		x.MaxReportDataSize = value.Uint()
+	case "band.oracle.v1.Params.expiration_block_count":
+		x.ExpirationBlockCount = value.Uint()
+	case "band.oracle.v1.Params.base_owasm_gas":
+		x.BaseOwasmGas = value.Uint()
+	case "band.oracle.v1.Params.per_validator_request_gas":
+		x.PerValidatorRequestGas = value.Uint()
+	case "band.oracle.v1.Params.sampling_try_count":
+		x.SamplingTryCount = value.Uint()
+	case "band.oracle.v1.Params.oracle_reward_percentage":
+		x.OracleRewardPercentage = value.Uint()
+	case "band.oracle.v1.Params.inactive_penalty_duration":
+		x.InactivePenaltyDuration = value.Uint()
+	case "band.oracle.v1.Params.ibc_request_enabled":
+		x.IbcRequestEnabled = value.Bool()
+	case "band.oracle.v1.Params.max_latest_result_index_size":
+		x.MaxLatestResultIndexSize = value.Uint()
+	case "band.oracle.v1.Params.price_oracle_script_id":
+		x.PriceOracleScriptId = value.Uint()
+	case "band.oracle.v1.Params.report_window_size":
+		x.ReportWindowSize = value.Uint()
+	case "band.oracle.v1.Params.max_missed_report_percentage":
+		x.MaxMissedReportPercentage = value.Uint()
+	case "band.oracle.v1.Params.max_callback_gas_limit":
+		x.MaxCallbackGasLimit = value.Uint()
+	case "band.oracle.v1.Params.min_subscription_interval":
+		x.MinSubscriptionInterval = value.Uint()
+	case "band.oracle.v1.Params.subscription_base_request_fee":
+		lv := value.List()
+		clv := lv.(*_Params_18_list)
+		x.SubscriptionBaseRequestFee = *clv.list
+	case "band.oracle.v1.Params.max_subscription_requests_per_block":
+		x.MaxSubscriptionRequestsPerBlock = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10102,45 +10279,117 @@ func (x *fastReflection_PendingResolveList) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PendingResolveList) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.PendingResolveList.request_ids":
-		if x.RequestIds == nil {
-			x.RequestIds = []uint64{}
+	case "band.oracle.v1.Params.subscription_base_request_fee":
+		if x.SubscriptionBaseRequestFee == nil {
+			x.SubscriptionBaseRequestFee = []*v1beta1.Coin{}
 		}
-		value := &_PendingResolveList_1_list{list: &x.RequestIds}
+		value := &_Params_18_list{list: &x.SubscriptionBaseRequestFee}
 		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.Params.max_raw_request_count":
+		panic(fmt.Errorf("field max_raw_request_count of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_ask_count":
+		panic(fmt.Errorf("field max_ask_count of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_calldata_size":
+		panic(fmt.Errorf("field max_calldata_size of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_report_data_size":
+		panic(fmt.Errorf("field max_report_data_size of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.expiration_block_count":
+		panic(fmt.Errorf("field expiration_block_count of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.base_owasm_gas":
+		panic(fmt.Errorf("field base_owasm_gas of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.per_validator_request_gas":
+		panic(fmt.Errorf("field per_validator_request_gas of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.sampling_try_count":
+		panic(fmt.Errorf("field sampling_try_count of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.oracle_reward_percentage":
+		panic(fmt.Errorf("field oracle_reward_percentage of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.inactive_penalty_duration":
+		panic(fmt.Errorf("field inactive_penalty_duration of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.ibc_request_enabled":
+		panic(fmt.Errorf("field ibc_request_enabled of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_latest_result_index_size":
+		panic(fmt.Errorf("field max_latest_result_index_size of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.price_oracle_script_id":
+		panic(fmt.Errorf("field price_oracle_script_id of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.report_window_size":
+		panic(fmt.Errorf("field report_window_size of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_missed_report_percentage":
+		panic(fmt.Errorf("field max_missed_report_percentage of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_callback_gas_limit":
+		panic(fmt.Errorf("field max_callback_gas_limit of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.min_subscription_interval":
+		panic(fmt.Errorf("field min_subscription_interval of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_subscription_requests_per_block":
+		panic(fmt.Errorf("field max_subscription_requests_per_block of message band.oracle.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_PendingResolveList) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.PendingResolveList.request_ids":
-		list := []uint64{}
-		return protoreflect.ValueOfList(&_PendingResolveList_1_list{list: &list})
+	case "band.oracle.v1.Params.max_raw_request_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.max_ask_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.max_calldata_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.max_report_data_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.expiration_block_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.base_owasm_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.per_validator_request_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.sampling_try_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.oracle_reward_percentage":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.inactive_penalty_duration":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.ibc_request_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "band.oracle.v1.Params.max_latest_result_index_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.price_oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.report_window_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.max_missed_report_percentage":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.max_callback_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.min_subscription_interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.subscription_base_request_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_18_list{list: &list})
+	case "band.oracle.v1.Params.max_subscription_requests_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_PendingResolveList) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.PendingResolveList", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Params", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10148,7 +10397,7 @@ func (x *fastReflection_PendingResolveList) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_PendingResolveList) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10159,7 +10408,7 @@ func (x *fastReflection_PendingResolveList) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PendingResolveList) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10171,7 +10420,7 @@ func (x *fastReflection_PendingResolveList) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_PendingResolveList) IsValid() bool {
+func (x *fastReflection_Params) IsValid() bool {
 	return x != nil
 }
 
@@ -10181,9 +10430,9 @@ func (x *fastReflection_PendingResolveList) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_PendingResolveList) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*PendingResolveList)
+		x := input.Message.Interface().(*Params)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10195,12 +10444,65 @@ func (x *fastReflection_PendingResolveList) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.RequestIds) > 0 {
-			l = 0
-			for _, e := range x.RequestIds {
-				l += runtime.Sov(uint64(e))
+		if x.MaxRawRequestCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRawRequestCount))
+		}
+		if x.MaxAskCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxAskCount))
+		}
+		if x.MaxCalldataSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxCalldataSize))
+		}
+		if x.MaxReportDataSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxReportDataSize))
+		}
+		if x.ExpirationBlockCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpirationBlockCount))
+		}
+		if x.BaseOwasmGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseOwasmGas))
+		}
+		if x.PerValidatorRequestGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.PerValidatorRequestGas))
+		}
+		if x.SamplingTryCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.SamplingTryCount))
+		}
+		if x.OracleRewardPercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleRewardPercentage))
+		}
+		if x.InactivePenaltyDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.InactivePenaltyDuration))
+		}
+		if x.IbcRequestEnabled {
+			n += 2
+		}
+		if x.MaxLatestResultIndexSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxLatestResultIndexSize))
+		}
+		if x.PriceOracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PriceOracleScriptId))
+		}
+		if x.ReportWindowSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReportWindowSize))
+		}
+		if x.MaxMissedReportPercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxMissedReportPercentage))
+		}
+		if x.MaxCallbackGasLimit != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxCallbackGasLimit))
+		}
+		if x.MinSubscriptionInterval != 0 {
+			n += 2 + runtime.Sov(uint64(x.MinSubscriptionInterval))
+		}
+		if len(x.SubscriptionBaseRequestFee) > 0 {
+			for _, e := range x.SubscriptionBaseRequestFee {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
 			}
-			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.MaxSubscriptionRequestsPerBlock != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxSubscriptionRequestsPerBlock))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -10212,7 +10514,7 @@ func (x *fastReflection_PendingResolveList) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*PendingResolveList)
+		x := input.Message.Interface().(*Params)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10231,27 +10533,126 @@ func (x *fastReflection_PendingResolveList) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.RequestIds) > 0 {
-			var pksize2 int
-			for _, num := range x.RequestIds {
-				pksize2 += runtime.Sov(uint64(num))
-			}
-			i -= pksize2
-			j1 := i
-			for _, num := range x.RequestIds {
-				for num >= 1<<7 {
-					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j1++
+		if x.MaxSubscriptionRequestsPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSubscriptionRequestsPerBlock))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if len(x.SubscriptionBaseRequestFee) > 0 {
+			for iNdEx := len(x.SubscriptionBaseRequestFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SubscriptionBaseRequestFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
 				}
-				dAtA[j1] = uint8(num)
-				j1++
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
 			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+		}
+		if x.MinSubscriptionInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinSubscriptionInterval))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
 		}
-		if input.Buf != nil {
+		if x.MaxCallbackGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxCallbackGasLimit))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.MaxMissedReportPercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMissedReportPercentage))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.ReportWindowSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReportWindowSize))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.PriceOracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PriceOracleScriptId))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.MaxLatestResultIndexSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxLatestResultIndexSize))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.IbcRequestEnabled {
+			i--
+			if x.IbcRequestEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.InactivePenaltyDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InactivePenaltyDuration))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.OracleRewardPercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleRewardPercentage))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.SamplingTryCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SamplingTryCount))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.PerValidatorRequestGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PerValidatorRequestGas))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.BaseOwasmGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseOwasmGas))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ExpirationBlockCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpirationBlockCount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MaxReportDataSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxReportDataSize))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxCalldataSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxCalldataSize))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxAskCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAskCount))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.MaxRawRequestCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRawRequestCount))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
 			input.Buf = dAtA
@@ -10262,7 +10663,7 @@ func (x *fastReflection_PendingResolveList) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*PendingResolveList)
+		x := input.Message.Interface().(*Params)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10294,146 +10695,7197 @@ func (x *fastReflection_PendingResolveList) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingResolveList: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingResolveList: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType == 0 {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRawRequestCount", wireType)
+				}
+				x.MaxRawRequestCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					x.RequestIds = append(x.RequestIds, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRawRequestCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAskCount", wireType)
+				}
+				x.MaxAskCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					if postIndex > l {
+					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					var elementCount int
-					var count int
-					for _, integer := range dAtA[iNdEx:postIndex] {
-						if integer < 128 {
-							count++
-						}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAskCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-					elementCount = count
-					if elementCount != 0 && len(x.RequestIds) == 0 {
-						x.RequestIds = make([]uint64, 0, elementCount)
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxCalldataSize", wireType)
+				}
+				x.MaxCalldataSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					for iNdEx < postIndex {
-						var v uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.RequestIds = append(x.RequestIds, v)
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxCalldataSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestIds", wireType)
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxReportDataSize", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.MaxReportDataSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxReportDataSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlockCount", wireType)
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.ExpirationBlockCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpirationBlockCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_IBCChannel            protoreflect.MessageDescriptor
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseOwasmGas", wireType)
+				}
+				x.BaseOwasmGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseOwasmGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PerValidatorRequestGas", wireType)
+				}
+				x.PerValidatorRequestGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PerValidatorRequestGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SamplingTryCount", wireType)
+				}
+				x.SamplingTryCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SamplingTryCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleRewardPercentage", wireType)
+				}
+				x.OracleRewardPercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleRewardPercentage |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InactivePenaltyDuration", wireType)
+				}
+				x.InactivePenaltyDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InactivePenaltyDuration |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcRequestEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IbcRequestEnabled = bool(v != 0)
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxLatestResultIndexSize", wireType)
+				}
+				x.MaxLatestResultIndexSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxLatestResultIndexSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceOracleScriptId", wireType)
+				}
+				x.PriceOracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PriceOracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportWindowSize", wireType)
+				}
+				x.ReportWindowSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReportWindowSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMissedReportPercentage", wireType)
+				}
+				x.MaxMissedReportPercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxMissedReportPercentage |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxCallbackGasLimit", wireType)
+				}
+				x.MaxCallbackGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxCallbackGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinSubscriptionInterval", wireType)
+				}
+				x.MinSubscriptionInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinSubscriptionInterval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubscriptionBaseRequestFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubscriptionBaseRequestFee = append(x.SubscriptionBaseRequestFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SubscriptionBaseRequestFee[len(x.SubscriptionBaseRequestFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSubscriptionRequestsPerBlock", wireType)
+				}
+				x.MaxSubscriptionRequestsPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSubscriptionRequestsPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PendingResolveList_1_list)(nil)
+
+type _PendingResolveList_1_list struct {
+	list *[]uint64
+}
+
+func (x *_PendingResolveList_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PendingResolveList_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_PendingResolveList_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PendingResolveList_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PendingResolveList_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PendingResolveList at list field RequestIds as it is not of Message kind"))
+}
+
+func (x *_PendingResolveList_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PendingResolveList_1_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_PendingResolveList_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PendingResolveList             protoreflect.MessageDescriptor
+	fd_PendingResolveList_request_ids protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_PendingResolveList = File_band_oracle_v1_oracle_proto.Messages().ByName("PendingResolveList")
+	fd_PendingResolveList_request_ids = md_PendingResolveList.Fields().ByName("request_ids")
+}
+
+var _ protoreflect.Message = (*fastReflection_PendingResolveList)(nil)
+
+type fastReflection_PendingResolveList PendingResolveList
+
+func (x *PendingResolveList) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PendingResolveList)(x)
+}
+
+func (x *PendingResolveList) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PendingResolveList_messageType fastReflection_PendingResolveList_messageType
+var _ protoreflect.MessageType = fastReflection_PendingResolveList_messageType{}
+
+type fastReflection_PendingResolveList_messageType struct{}
+
+func (x fastReflection_PendingResolveList_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PendingResolveList)(nil)
+}
+func (x fastReflection_PendingResolveList_messageType) New() protoreflect.Message {
+	return new(fastReflection_PendingResolveList)
+}
+func (x fastReflection_PendingResolveList_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingResolveList
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PendingResolveList) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingResolveList
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PendingResolveList) Type() protoreflect.MessageType {
+	return _fastReflection_PendingResolveList_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PendingResolveList) New() protoreflect.Message {
+	return new(fastReflection_PendingResolveList)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PendingResolveList) Interface() protoreflect.ProtoMessage {
+	return (*PendingResolveList)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PendingResolveList) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.RequestIds) != 0 {
+		value := protoreflect.ValueOfList(&_PendingResolveList_1_list{list: &x.RequestIds})
+		if !f(fd_PendingResolveList_request_ids, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PendingResolveList) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.PendingResolveList.request_ids":
+		return len(x.RequestIds) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingResolveList) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.PendingResolveList.request_ids":
+		x.RequestIds = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PendingResolveList) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.PendingResolveList.request_ids":
+		if len(x.RequestIds) == 0 {
+			return protoreflect.ValueOfList(&_PendingResolveList_1_list{})
+		}
+		listValue := &_PendingResolveList_1_list{list: &x.RequestIds}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingResolveList) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.PendingResolveList.request_ids":
+		lv := value.List()
+		clv := lv.(*_PendingResolveList_1_list)
+		x.RequestIds = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingResolveList) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.PendingResolveList.request_ids":
+		if x.RequestIds == nil {
+			x.RequestIds = []uint64{}
+		}
+		value := &_PendingResolveList_1_list{list: &x.RequestIds}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PendingResolveList) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.PendingResolveList.request_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_PendingResolveList_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PendingResolveList"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PendingResolveList does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PendingResolveList) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.PendingResolveList", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PendingResolveList) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingResolveList) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PendingResolveList) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PendingResolveList) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PendingResolveList)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.RequestIds) > 0 {
+			l = 0
+			for _, e := range x.RequestIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PendingResolveList)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RequestIds) > 0 {
+			var pksize2 int
+			for _, num := range x.RequestIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.RequestIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PendingResolveList)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingResolveList: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingResolveList: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.RequestIds = append(x.RequestIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.RequestIds) == 0 {
+						x.RequestIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.RequestIds = append(x.RequestIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestIds", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_IBCChannel            protoreflect.MessageDescriptor
 	fd_IBCChannel_port_id    protoreflect.FieldDescriptor
 	fd_IBCChannel_channel_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_IBCChannel = File_band_oracle_v1_oracle_proto.Messages().ByName("IBCChannel")
-	fd_IBCChannel_port_id = md_IBCChannel.Fields().ByName("port_id")
-	fd_IBCChannel_channel_id = md_IBCChannel.Fields().ByName("channel_id")
+	md_IBCChannel = File_band_oracle_v1_oracle_proto.Messages().ByName("IBCChannel")
+	fd_IBCChannel_port_id = md_IBCChannel.Fields().ByName("port_id")
+	fd_IBCChannel_channel_id = md_IBCChannel.Fields().ByName("channel_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_IBCChannel)(nil)
+
+type fastReflection_IBCChannel IBCChannel
+
+func (x *IBCChannel) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IBCChannel)(x)
+}
+
+func (x *IBCChannel) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_IBCChannel_messageType fastReflection_IBCChannel_messageType
+var _ protoreflect.MessageType = fastReflection_IBCChannel_messageType{}
+
+type fastReflection_IBCChannel_messageType struct{}
+
+func (x fastReflection_IBCChannel_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IBCChannel)(nil)
+}
+func (x fastReflection_IBCChannel_messageType) New() protoreflect.Message {
+	return new(fastReflection_IBCChannel)
+}
+func (x fastReflection_IBCChannel_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCChannel
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_IBCChannel) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCChannel
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_IBCChannel) Type() protoreflect.MessageType {
+	return _fastReflection_IBCChannel_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_IBCChannel) New() protoreflect.Message {
+	return new(fastReflection_IBCChannel)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_IBCChannel) Interface() protoreflect.ProtoMessage {
+	return (*IBCChannel)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_IBCChannel) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PortId != "" {
+		value := protoreflect.ValueOfString(x.PortId)
+		if !f(fd_IBCChannel_port_id, value) {
+			return
+		}
+	}
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_IBCChannel_channel_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_IBCChannel) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.IBCChannel.port_id":
+		return x.PortId != ""
+	case "band.oracle.v1.IBCChannel.channel_id":
+		return x.ChannelId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCChannel) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.IBCChannel.port_id":
+		x.PortId = ""
+	case "band.oracle.v1.IBCChannel.channel_id":
+		x.ChannelId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_IBCChannel) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.IBCChannel.port_id":
+		value := x.PortId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.IBCChannel.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCChannel) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.IBCChannel.port_id":
+		x.PortId = value.Interface().(string)
+	case "band.oracle.v1.IBCChannel.channel_id":
+		x.ChannelId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCChannel) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.IBCChannel.port_id":
+		panic(fmt.Errorf("field port_id of message band.oracle.v1.IBCChannel is not mutable"))
+	case "band.oracle.v1.IBCChannel.channel_id":
+		panic(fmt.Errorf("field channel_id of message band.oracle.v1.IBCChannel is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_IBCChannel) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.IBCChannel.port_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.IBCChannel.channel_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_IBCChannel) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.IBCChannel", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_IBCChannel) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IBCChannel) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_IBCChannel) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_IBCChannel) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*IBCChannel)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.PortId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*IBCChannel)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.PortId) > 0 {
+			i -= len(x.PortId)
+			copy(dAtA[i:], x.PortId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PortId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*IBCChannel)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCChannel: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RequestCallback           protoreflect.MessageDescriptor
+	fd_RequestCallback_target    protoreflect.FieldDescriptor
+	fd_RequestCallback_gas_limit protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_RequestCallback = File_band_oracle_v1_oracle_proto.Messages().ByName("RequestCallback")
+	fd_RequestCallback_target = md_RequestCallback.Fields().ByName("target")
+	fd_RequestCallback_gas_limit = md_RequestCallback.Fields().ByName("gas_limit")
+}
+
+var _ protoreflect.Message = (*fastReflection_RequestCallback)(nil)
+
+type fastReflection_RequestCallback RequestCallback
+
+func (x *RequestCallback) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RequestCallback)(x)
+}
+
+func (x *RequestCallback) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RequestCallback_messageType fastReflection_RequestCallback_messageType
+var _ protoreflect.MessageType = fastReflection_RequestCallback_messageType{}
+
+type fastReflection_RequestCallback_messageType struct{}
+
+func (x fastReflection_RequestCallback_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RequestCallback)(nil)
+}
+func (x fastReflection_RequestCallback_messageType) New() protoreflect.Message {
+	return new(fastReflection_RequestCallback)
+}
+func (x fastReflection_RequestCallback_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RequestCallback
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RequestCallback) Descriptor() protoreflect.MessageDescriptor {
+	return md_RequestCallback
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RequestCallback) Type() protoreflect.MessageType {
+	return _fastReflection_RequestCallback_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RequestCallback) New() protoreflect.Message {
+	return new(fastReflection_RequestCallback)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RequestCallback) Interface() protoreflect.ProtoMessage {
+	return (*RequestCallback)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RequestCallback) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Target != "" {
+		value := protoreflect.ValueOfString(x.Target)
+		if !f(fd_RequestCallback_target, value) {
+			return
+		}
+	}
+	if x.GasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasLimit)
+		if !f(fd_RequestCallback_gas_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RequestCallback) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestCallback.target":
+		return x.Target != ""
+	case "band.oracle.v1.RequestCallback.gas_limit":
+		return x.GasLimit != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestCallback"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestCallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestCallback) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestCallback.target":
+		x.Target = ""
+	case "band.oracle.v1.RequestCallback.gas_limit":
+		x.GasLimit = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestCallback"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestCallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RequestCallback) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.RequestCallback.target":
+		value := x.Target
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.RequestCallback.gas_limit":
+		value := x.GasLimit
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestCallback"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestCallback does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestCallback) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestCallback.target":
+		x.Target = value.Interface().(string)
+	case "band.oracle.v1.RequestCallback.gas_limit":
+		x.GasLimit = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestCallback"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestCallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestCallback) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestCallback.target":
+		panic(fmt.Errorf("field target of message band.oracle.v1.RequestCallback is not mutable"))
+	case "band.oracle.v1.RequestCallback.gas_limit":
+		panic(fmt.Errorf("field gas_limit of message band.oracle.v1.RequestCallback is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestCallback"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestCallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RequestCallback) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestCallback.target":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.RequestCallback.gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestCallback"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestCallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RequestCallback) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RequestCallback", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RequestCallback) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestCallback) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RequestCallback) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RequestCallback) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RequestCallback)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Target)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasLimit))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RequestCallback)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasLimit))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Target) > 0 {
+			i -= len(x.Target)
+			copy(dAtA[i:], x.Target)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Target)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RequestCallback)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestCallback: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Target = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				}
+				x.GasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RequestVerification                protoreflect.MessageDescriptor
+	fd_RequestVerification_chain_id       protoreflect.FieldDescriptor
+	fd_RequestVerification_validator      protoreflect.FieldDescriptor
+	fd_RequestVerification_request_id     protoreflect.FieldDescriptor
+	fd_RequestVerification_external_id    protoreflect.FieldDescriptor
+	fd_RequestVerification_data_source_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_RequestVerification = File_band_oracle_v1_oracle_proto.Messages().ByName("RequestVerification")
+	fd_RequestVerification_chain_id = md_RequestVerification.Fields().ByName("chain_id")
+	fd_RequestVerification_validator = md_RequestVerification.Fields().ByName("validator")
+	fd_RequestVerification_request_id = md_RequestVerification.Fields().ByName("request_id")
+	fd_RequestVerification_external_id = md_RequestVerification.Fields().ByName("external_id")
+	fd_RequestVerification_data_source_id = md_RequestVerification.Fields().ByName("data_source_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_RequestVerification)(nil)
+
+type fastReflection_RequestVerification RequestVerification
+
+func (x *RequestVerification) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RequestVerification)(x)
+}
+
+func (x *RequestVerification) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RequestVerification_messageType fastReflection_RequestVerification_messageType
+var _ protoreflect.MessageType = fastReflection_RequestVerification_messageType{}
+
+type fastReflection_RequestVerification_messageType struct{}
+
+func (x fastReflection_RequestVerification_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RequestVerification)(nil)
+}
+func (x fastReflection_RequestVerification_messageType) New() protoreflect.Message {
+	return new(fastReflection_RequestVerification)
+}
+func (x fastReflection_RequestVerification_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RequestVerification
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RequestVerification) Descriptor() protoreflect.MessageDescriptor {
+	return md_RequestVerification
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RequestVerification) Type() protoreflect.MessageType {
+	return _fastReflection_RequestVerification_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RequestVerification) New() protoreflect.Message {
+	return new(fastReflection_RequestVerification)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RequestVerification) Interface() protoreflect.ProtoMessage {
+	return (*RequestVerification)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RequestVerification) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChainId != "" {
+		value := protoreflect.ValueOfString(x.ChainId)
+		if !f(fd_RequestVerification_chain_id, value) {
+			return
+		}
+	}
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_RequestVerification_validator, value) {
+			return
+		}
+	}
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_RequestVerification_request_id, value) {
+			return
+		}
+	}
+	if x.ExternalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExternalId)
+		if !f(fd_RequestVerification_external_id, value) {
+			return
+		}
+	}
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_RequestVerification_data_source_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RequestVerification) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestVerification.chain_id":
+		return x.ChainId != ""
+	case "band.oracle.v1.RequestVerification.validator":
+		return x.Validator != ""
+	case "band.oracle.v1.RequestVerification.request_id":
+		return x.RequestId != uint64(0)
+	case "band.oracle.v1.RequestVerification.external_id":
+		return x.ExternalId != uint64(0)
+	case "band.oracle.v1.RequestVerification.data_source_id":
+		return x.DataSourceId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestVerification) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestVerification.chain_id":
+		x.ChainId = ""
+	case "band.oracle.v1.RequestVerification.validator":
+		x.Validator = ""
+	case "band.oracle.v1.RequestVerification.request_id":
+		x.RequestId = uint64(0)
+	case "band.oracle.v1.RequestVerification.external_id":
+		x.ExternalId = uint64(0)
+	case "band.oracle.v1.RequestVerification.data_source_id":
+		x.DataSourceId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RequestVerification) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.RequestVerification.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.RequestVerification.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.RequestVerification.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RequestVerification.external_id":
+		value := x.ExternalId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RequestVerification.data_source_id":
+		value := x.DataSourceId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestVerification) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestVerification.chain_id":
+		x.ChainId = value.Interface().(string)
+	case "band.oracle.v1.RequestVerification.validator":
+		x.Validator = value.Interface().(string)
+	case "band.oracle.v1.RequestVerification.request_id":
+		x.RequestId = value.Uint()
+	case "band.oracle.v1.RequestVerification.external_id":
+		x.ExternalId = value.Uint()
+	case "band.oracle.v1.RequestVerification.data_source_id":
+		x.DataSourceId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestVerification) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestVerification.chain_id":
+		panic(fmt.Errorf("field chain_id of message band.oracle.v1.RequestVerification is not mutable"))
+	case "band.oracle.v1.RequestVerification.validator":
+		panic(fmt.Errorf("field validator of message band.oracle.v1.RequestVerification is not mutable"))
+	case "band.oracle.v1.RequestVerification.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.RequestVerification is not mutable"))
+	case "band.oracle.v1.RequestVerification.external_id":
+		panic(fmt.Errorf("field external_id of message band.oracle.v1.RequestVerification is not mutable"))
+	case "band.oracle.v1.RequestVerification.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.RequestVerification is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RequestVerification) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestVerification.chain_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.RequestVerification.validator":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.RequestVerification.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RequestVerification.external_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RequestVerification.data_source_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RequestVerification) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RequestVerification", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RequestVerification) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestVerification) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RequestVerification) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RequestVerification) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RequestVerification)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.ExternalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExternalId))
+		}
+		if x.DataSourceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RequestVerification)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ExternalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ChainId) > 0 {
+			i -= len(x.ChainId)
+			copy(dAtA[i:], x.ChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RequestVerification)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestVerification: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				}
+				x.ExternalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExternalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+				}
+				x.DataSourceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DataSourceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PriceResult              protoreflect.MessageDescriptor
+	fd_PriceResult_symbol       protoreflect.FieldDescriptor
+	fd_PriceResult_multiplier   protoreflect.FieldDescriptor
+	fd_PriceResult_px           protoreflect.FieldDescriptor
+	fd_PriceResult_request_id   protoreflect.FieldDescriptor
+	fd_PriceResult_resolve_time protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_PriceResult = File_band_oracle_v1_oracle_proto.Messages().ByName("PriceResult")
+	fd_PriceResult_symbol = md_PriceResult.Fields().ByName("symbol")
+	fd_PriceResult_multiplier = md_PriceResult.Fields().ByName("multiplier")
+	fd_PriceResult_px = md_PriceResult.Fields().ByName("px")
+	fd_PriceResult_request_id = md_PriceResult.Fields().ByName("request_id")
+	fd_PriceResult_resolve_time = md_PriceResult.Fields().ByName("resolve_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceResult)(nil)
+
+type fastReflection_PriceResult PriceResult
+
+func (x *PriceResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceResult)(x)
+}
+
+func (x *PriceResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceResult_messageType fastReflection_PriceResult_messageType
+var _ protoreflect.MessageType = fastReflection_PriceResult_messageType{}
+
+type fastReflection_PriceResult_messageType struct{}
+
+func (x fastReflection_PriceResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceResult)(nil)
+}
+func (x fastReflection_PriceResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceResult)
+}
+func (x fastReflection_PriceResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceResult
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceResult
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceResult) Type() protoreflect.MessageType {
+	return _fastReflection_PriceResult_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceResult) New() protoreflect.Message {
+	return new(fastReflection_PriceResult)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceResult) Interface() protoreflect.ProtoMessage {
+	return (*PriceResult)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Symbol != "" {
+		value := protoreflect.ValueOfString(x.Symbol)
+		if !f(fd_PriceResult_symbol, value) {
+			return
+		}
+	}
+	if x.Multiplier != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Multiplier)
+		if !f(fd_PriceResult_multiplier, value) {
+			return
+		}
+	}
+	if x.Px != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Px)
+		if !f(fd_PriceResult_px, value) {
+			return
+		}
+	}
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_PriceResult_request_id, value) {
+			return
+		}
+	}
+	if x.ResolveTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ResolveTime)
+		if !f(fd_PriceResult_resolve_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceResult) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.PriceResult.symbol":
+		return x.Symbol != ""
+	case "band.oracle.v1.PriceResult.multiplier":
+		return x.Multiplier != uint64(0)
+	case "band.oracle.v1.PriceResult.px":
+		return x.Px != uint64(0)
+	case "band.oracle.v1.PriceResult.request_id":
+		return x.RequestId != uint64(0)
+	case "band.oracle.v1.PriceResult.resolve_time":
+		return x.ResolveTime != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceResult) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.PriceResult.symbol":
+		x.Symbol = ""
+	case "band.oracle.v1.PriceResult.multiplier":
+		x.Multiplier = uint64(0)
+	case "band.oracle.v1.PriceResult.px":
+		x.Px = uint64(0)
+	case "band.oracle.v1.PriceResult.request_id":
+		x.RequestId = uint64(0)
+	case "band.oracle.v1.PriceResult.resolve_time":
+		x.ResolveTime = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.PriceResult.symbol":
+		value := x.Symbol
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.PriceResult.multiplier":
+		value := x.Multiplier
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.PriceResult.px":
+		value := x.Px
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.PriceResult.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.PriceResult.resolve_time":
+		value := x.ResolveTime
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.PriceResult.symbol":
+		x.Symbol = value.Interface().(string)
+	case "band.oracle.v1.PriceResult.multiplier":
+		x.Multiplier = value.Uint()
+	case "band.oracle.v1.PriceResult.px":
+		x.Px = value.Uint()
+	case "band.oracle.v1.PriceResult.request_id":
+		x.RequestId = value.Uint()
+	case "band.oracle.v1.PriceResult.resolve_time":
+		x.ResolveTime = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.PriceResult.symbol":
+		panic(fmt.Errorf("field symbol of message band.oracle.v1.PriceResult is not mutable"))
+	case "band.oracle.v1.PriceResult.multiplier":
+		panic(fmt.Errorf("field multiplier of message band.oracle.v1.PriceResult is not mutable"))
+	case "band.oracle.v1.PriceResult.px":
+		panic(fmt.Errorf("field px of message band.oracle.v1.PriceResult is not mutable"))
+	case "band.oracle.v1.PriceResult.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.PriceResult is not mutable"))
+	case "band.oracle.v1.PriceResult.resolve_time":
+		panic(fmt.Errorf("field resolve_time of message band.oracle.v1.PriceResult is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.PriceResult.symbol":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.PriceResult.multiplier":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.PriceResult.px":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.PriceResult.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.PriceResult.resolve_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.PriceResult", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceResult) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceResult) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceResult) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceResult) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceResult)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Symbol)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Multiplier != 0 {
+			n += 1 + runtime.Sov(uint64(x.Multiplier))
+		}
+		if x.Px != 0 {
+			n += 1 + runtime.Sov(uint64(x.Px))
+		}
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.ResolveTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResolveTime))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceResult)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ResolveTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveTime))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Px != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Px))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Multiplier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Multiplier))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Symbol) > 0 {
+			i -= len(x.Symbol)
+			copy(dAtA[i:], x.Symbol)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Symbol)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceResult)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceResult: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Symbol = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+				}
+				x.Multiplier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Multiplier |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Px", wireType)
+				}
+				x.Px = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Px |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+				}
+				x.ResolveTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResolveTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_OracleResultSignatureOrder            protoreflect.MessageDescriptor
+	fd_OracleResultSignatureOrder_request_id protoreflect.FieldDescriptor
+	fd_OracleResultSignatureOrder_encoder    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_OracleResultSignatureOrder = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleResultSignatureOrder")
+	fd_OracleResultSignatureOrder_request_id = md_OracleResultSignatureOrder.Fields().ByName("request_id")
+	fd_OracleResultSignatureOrder_encoder = md_OracleResultSignatureOrder.Fields().ByName("encoder")
+}
+
+var _ protoreflect.Message = (*fastReflection_OracleResultSignatureOrder)(nil)
+
+type fastReflection_OracleResultSignatureOrder OracleResultSignatureOrder
+
+func (x *OracleResultSignatureOrder) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OracleResultSignatureOrder)(x)
+}
+
+func (x *OracleResultSignatureOrder) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OracleResultSignatureOrder_messageType fastReflection_OracleResultSignatureOrder_messageType
+var _ protoreflect.MessageType = fastReflection_OracleResultSignatureOrder_messageType{}
+
+type fastReflection_OracleResultSignatureOrder_messageType struct{}
+
+func (x fastReflection_OracleResultSignatureOrder_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OracleResultSignatureOrder)(nil)
+}
+func (x fastReflection_OracleResultSignatureOrder_messageType) New() protoreflect.Message {
+	return new(fastReflection_OracleResultSignatureOrder)
+}
+func (x fastReflection_OracleResultSignatureOrder_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleResultSignatureOrder
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OracleResultSignatureOrder) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleResultSignatureOrder
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OracleResultSignatureOrder) Type() protoreflect.MessageType {
+	return _fastReflection_OracleResultSignatureOrder_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OracleResultSignatureOrder) New() protoreflect.Message {
+	return new(fastReflection_OracleResultSignatureOrder)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OracleResultSignatureOrder) Interface() protoreflect.ProtoMessage {
+	return (*OracleResultSignatureOrder)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OracleResultSignatureOrder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_OracleResultSignatureOrder_request_id, value) {
+			return
+		}
+	}
+	if x.Encoder != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Encoder))
+		if !f(fd_OracleResultSignatureOrder_encoder, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OracleResultSignatureOrder) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
+		return x.RequestId != uint64(0)
+	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
+		return x.Encoder != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleResultSignatureOrder) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
+		x.RequestId = uint64(0)
+	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
+		x.Encoder = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OracleResultSignatureOrder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
+		value := x.Encoder
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleResultSignatureOrder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
+		x.RequestId = value.Uint()
+	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
+		x.Encoder = (Encoder)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleResultSignatureOrder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.OracleResultSignatureOrder is not mutable"))
+	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
+		panic(fmt.Errorf("field encoder of message band.oracle.v1.OracleResultSignatureOrder is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OracleResultSignatureOrder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OracleResultSignatureOrder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleResultSignatureOrder", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OracleResultSignatureOrder) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleResultSignatureOrder) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OracleResultSignatureOrder) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OracleResultSignatureOrder) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OracleResultSignatureOrder)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.Encoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.Encoder))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OracleResultSignatureOrder)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Encoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Encoder))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OracleResultSignatureOrder)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleResultSignatureOrder: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleResultSignatureOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+				}
+				x.Encoder = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Encoder |= Encoder(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TreasuryFee_2_list)(nil)
+
+type _TreasuryFee_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_TreasuryFee_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TreasuryFee_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TreasuryFee_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TreasuryFee_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TreasuryFee_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TreasuryFee_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TreasuryFee_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TreasuryFee_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TreasuryFee          protoreflect.MessageDescriptor
+	fd_TreasuryFee_treasury protoreflect.FieldDescriptor
+	fd_TreasuryFee_fee      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_TreasuryFee = File_band_oracle_v1_oracle_proto.Messages().ByName("TreasuryFee")
+	fd_TreasuryFee_treasury = md_TreasuryFee.Fields().ByName("treasury")
+	fd_TreasuryFee_fee = md_TreasuryFee.Fields().ByName("fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_TreasuryFee)(nil)
+
+type fastReflection_TreasuryFee TreasuryFee
+
+func (x *TreasuryFee) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TreasuryFee)(x)
+}
+
+func (x *TreasuryFee) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TreasuryFee_messageType fastReflection_TreasuryFee_messageType
+var _ protoreflect.MessageType = fastReflection_TreasuryFee_messageType{}
+
+type fastReflection_TreasuryFee_messageType struct{}
+
+func (x fastReflection_TreasuryFee_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TreasuryFee)(nil)
+}
+func (x fastReflection_TreasuryFee_messageType) New() protoreflect.Message {
+	return new(fastReflection_TreasuryFee)
+}
+func (x fastReflection_TreasuryFee_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TreasuryFee
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TreasuryFee) Descriptor() protoreflect.MessageDescriptor {
+	return md_TreasuryFee
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TreasuryFee) Type() protoreflect.MessageType {
+	return _fastReflection_TreasuryFee_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TreasuryFee) New() protoreflect.Message {
+	return new(fastReflection_TreasuryFee)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TreasuryFee) Interface() protoreflect.ProtoMessage {
+	return (*TreasuryFee)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TreasuryFee) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Treasury != "" {
+		value := protoreflect.ValueOfString(x.Treasury)
+		if !f(fd_TreasuryFee_treasury, value) {
+			return
+		}
+	}
+	if len(x.Fee) != 0 {
+		value := protoreflect.ValueOfList(&_TreasuryFee_2_list{list: &x.Fee})
+		if !f(fd_TreasuryFee_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TreasuryFee) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.TreasuryFee.treasury":
+		return x.Treasury != ""
+	case "band.oracle.v1.TreasuryFee.fee":
+		return len(x.Fee) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.TreasuryFee"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.TreasuryFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TreasuryFee) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.TreasuryFee.treasury":
+		x.Treasury = ""
+	case "band.oracle.v1.TreasuryFee.fee":
+		x.Fee = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.TreasuryFee"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.TreasuryFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TreasuryFee) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.TreasuryFee.treasury":
+		value := x.Treasury
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.TreasuryFee.fee":
+		if len(x.Fee) == 0 {
+			return protoreflect.ValueOfList(&_TreasuryFee_2_list{})
+		}
+		listValue := &_TreasuryFee_2_list{list: &x.Fee}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.TreasuryFee"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.TreasuryFee does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TreasuryFee) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.TreasuryFee.treasury":
+		x.Treasury = value.Interface().(string)
+	case "band.oracle.v1.TreasuryFee.fee":
+		lv := value.List()
+		clv := lv.(*_TreasuryFee_2_list)
+		x.Fee = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.TreasuryFee"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.TreasuryFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TreasuryFee) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.TreasuryFee.fee":
+		if x.Fee == nil {
+			x.Fee = []*v1beta1.Coin{}
+		}
+		value := &_TreasuryFee_2_list{list: &x.Fee}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.TreasuryFee.treasury":
+		panic(fmt.Errorf("field treasury of message band.oracle.v1.TreasuryFee is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.TreasuryFee"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.TreasuryFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TreasuryFee) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.TreasuryFee.treasury":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.TreasuryFee.fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_TreasuryFee_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.TreasuryFee"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.TreasuryFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TreasuryFee) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.TreasuryFee", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TreasuryFee) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TreasuryFee) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TreasuryFee) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TreasuryFee) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TreasuryFee)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Treasury)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Fee) > 0 {
+			for _, e := range x.Fee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TreasuryFee)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Fee) > 0 {
+			for iNdEx := len(x.Fee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Fee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Treasury) > 0 {
+			i -= len(x.Treasury)
+			copy(dAtA[i:], x.Treasury)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Treasury)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TreasuryFee)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TreasuryFee: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TreasuryFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Treasury = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fee = append(x.Fee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee[len(x.Fee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_FeeEscrow_2_list)(nil)
+
+type _FeeEscrow_2_list struct {
+	list *[]*TreasuryFee
+}
+
+func (x *_FeeEscrow_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeEscrow_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeEscrow_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TreasuryFee)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeEscrow_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TreasuryFee)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeEscrow_2_list) AppendMutable() protoreflect.Value {
+	v := new(TreasuryFee)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeEscrow_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeEscrow_2_list) NewElement() protoreflect.Value {
+	v := new(TreasuryFee)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeEscrow_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FeeEscrow_3_list)(nil)
+
+type _FeeEscrow_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeeEscrow_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeEscrow_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeEscrow_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeEscrow_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeEscrow_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeEscrow_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeEscrow_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeEscrow_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FeeEscrow_4_list)(nil)
+
+type _FeeEscrow_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeeEscrow_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeEscrow_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeEscrow_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeEscrow_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeEscrow_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeEscrow_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeEscrow_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeEscrow_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_FeeEscrow               protoreflect.MessageDescriptor
+	fd_FeeEscrow_payer         protoreflect.FieldDescriptor
+	fd_FeeEscrow_treasury_fees protoreflect.FieldDescriptor
+	fd_FeeEscrow_total         protoreflect.FieldDescriptor
+	fd_FeeEscrow_released      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_FeeEscrow = File_band_oracle_v1_oracle_proto.Messages().ByName("FeeEscrow")
+	fd_FeeEscrow_payer = md_FeeEscrow.Fields().ByName("payer")
+	fd_FeeEscrow_treasury_fees = md_FeeEscrow.Fields().ByName("treasury_fees")
+	fd_FeeEscrow_total = md_FeeEscrow.Fields().ByName("total")
+	fd_FeeEscrow_released = md_FeeEscrow.Fields().ByName("released")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeEscrow)(nil)
+
+type fastReflection_FeeEscrow FeeEscrow
+
+func (x *FeeEscrow) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeEscrow)(x)
+}
+
+func (x *FeeEscrow) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeEscrow_messageType fastReflection_FeeEscrow_messageType
+var _ protoreflect.MessageType = fastReflection_FeeEscrow_messageType{}
+
+type fastReflection_FeeEscrow_messageType struct{}
+
+func (x fastReflection_FeeEscrow_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeEscrow)(nil)
+}
+func (x fastReflection_FeeEscrow_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeEscrow)
+}
+func (x fastReflection_FeeEscrow_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeEscrow
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeEscrow) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeEscrow
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeEscrow) Type() protoreflect.MessageType {
+	return _fastReflection_FeeEscrow_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeEscrow) New() protoreflect.Message {
+	return new(fastReflection_FeeEscrow)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeEscrow) Interface() protoreflect.ProtoMessage {
+	return (*FeeEscrow)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeEscrow) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Payer != "" {
+		value := protoreflect.ValueOfString(x.Payer)
+		if !f(fd_FeeEscrow_payer, value) {
+			return
+		}
+	}
+	if len(x.TreasuryFees) != 0 {
+		value := protoreflect.ValueOfList(&_FeeEscrow_2_list{list: &x.TreasuryFees})
+		if !f(fd_FeeEscrow_treasury_fees, value) {
+			return
+		}
+	}
+	if len(x.Total) != 0 {
+		value := protoreflect.ValueOfList(&_FeeEscrow_3_list{list: &x.Total})
+		if !f(fd_FeeEscrow_total, value) {
+			return
+		}
+	}
+	if len(x.Released) != 0 {
+		value := protoreflect.ValueOfList(&_FeeEscrow_4_list{list: &x.Released})
+		if !f(fd_FeeEscrow_released, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeEscrow) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.FeeEscrow.payer":
+		return x.Payer != ""
+	case "band.oracle.v1.FeeEscrow.treasury_fees":
+		return len(x.TreasuryFees) != 0
+	case "band.oracle.v1.FeeEscrow.total":
+		return len(x.Total) != 0
+	case "band.oracle.v1.FeeEscrow.released":
+		return len(x.Released) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeEscrow"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.FeeEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeEscrow) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.FeeEscrow.payer":
+		x.Payer = ""
+	case "band.oracle.v1.FeeEscrow.treasury_fees":
+		x.TreasuryFees = nil
+	case "band.oracle.v1.FeeEscrow.total":
+		x.Total = nil
+	case "band.oracle.v1.FeeEscrow.released":
+		x.Released = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeEscrow"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.FeeEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeEscrow) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.FeeEscrow.payer":
+		value := x.Payer
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.FeeEscrow.treasury_fees":
+		if len(x.TreasuryFees) == 0 {
+			return protoreflect.ValueOfList(&_FeeEscrow_2_list{})
+		}
+		listValue := &_FeeEscrow_2_list{list: &x.TreasuryFees}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.FeeEscrow.total":
+		if len(x.Total) == 0 {
+			return protoreflect.ValueOfList(&_FeeEscrow_3_list{})
+		}
+		listValue := &_FeeEscrow_3_list{list: &x.Total}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.FeeEscrow.released":
+		if len(x.Released) == 0 {
+			return protoreflect.ValueOfList(&_FeeEscrow_4_list{})
+		}
+		listValue := &_FeeEscrow_4_list{list: &x.Released}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeEscrow"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.FeeEscrow does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeEscrow) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.FeeEscrow.payer":
+		x.Payer = value.Interface().(string)
+	case "band.oracle.v1.FeeEscrow.treasury_fees":
+		lv := value.List()
+		clv := lv.(*_FeeEscrow_2_list)
+		x.TreasuryFees = *clv.list
+	case "band.oracle.v1.FeeEscrow.total":
+		lv := value.List()
+		clv := lv.(*_FeeEscrow_3_list)
+		x.Total = *clv.list
+	case "band.oracle.v1.FeeEscrow.released":
+		lv := value.List()
+		clv := lv.(*_FeeEscrow_4_list)
+		x.Released = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeEscrow"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.FeeEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeEscrow) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.FeeEscrow.treasury_fees":
+		if x.TreasuryFees == nil {
+			x.TreasuryFees = []*TreasuryFee{}
+		}
+		value := &_FeeEscrow_2_list{list: &x.TreasuryFees}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.FeeEscrow.total":
+		if x.Total == nil {
+			x.Total = []*v1beta1.Coin{}
+		}
+		value := &_FeeEscrow_3_list{list: &x.Total}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.FeeEscrow.released":
+		if x.Released == nil {
+			x.Released = []*v1beta1.Coin{}
+		}
+		value := &_FeeEscrow_4_list{list: &x.Released}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.FeeEscrow.payer":
+		panic(fmt.Errorf("field payer of message band.oracle.v1.FeeEscrow is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeEscrow"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.FeeEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeEscrow) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.FeeEscrow.payer":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.FeeEscrow.treasury_fees":
+		list := []*TreasuryFee{}
+		return protoreflect.ValueOfList(&_FeeEscrow_2_list{list: &list})
+	case "band.oracle.v1.FeeEscrow.total":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeeEscrow_3_list{list: &list})
+	case "band.oracle.v1.FeeEscrow.released":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeeEscrow_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeEscrow"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.FeeEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeEscrow) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.FeeEscrow", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeEscrow) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeEscrow) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeEscrow) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeEscrow) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeEscrow)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Payer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.TreasuryFees) > 0 {
+			for _, e := range x.TreasuryFees {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Total) > 0 {
+			for _, e := range x.Total {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Released) > 0 {
+			for _, e := range x.Released {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeEscrow)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Released) > 0 {
+			for iNdEx := len(x.Released) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Released[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Total) > 0 {
+			for iNdEx := len(x.Total) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Total[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.TreasuryFees) > 0 {
+			for iNdEx := len(x.TreasuryFees) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TreasuryFees[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Payer) > 0 {
+			i -= len(x.Payer)
+			copy(dAtA[i:], x.Payer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeEscrow)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeEscrow: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Payer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TreasuryFees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TreasuryFees = append(x.TreasuryFees, &TreasuryFee{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TreasuryFees[len(x.TreasuryFees)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Total = append(x.Total, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Total[len(x.Total)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Released = append(x.Released, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Released[len(x.Released)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_RequestSubscription_9_list)(nil)
+
+type _RequestSubscription_9_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_RequestSubscription_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RequestSubscription_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_RequestSubscription_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RequestSubscription_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RequestSubscription_9_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RequestSubscription_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RequestSubscription_9_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RequestSubscription_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_RequestSubscription                     protoreflect.MessageDescriptor
+	fd_RequestSubscription_id                  protoreflect.FieldDescriptor
+	fd_RequestSubscription_owner               protoreflect.FieldDescriptor
+	fd_RequestSubscription_fee_payer           protoreflect.FieldDescriptor
+	fd_RequestSubscription_oracle_script_id    protoreflect.FieldDescriptor
+	fd_RequestSubscription_calldata            protoreflect.FieldDescriptor
+	fd_RequestSubscription_ask_count           protoreflect.FieldDescriptor
+	fd_RequestSubscription_min_count           protoreflect.FieldDescriptor
+	fd_RequestSubscription_client_id           protoreflect.FieldDescriptor
+	fd_RequestSubscription_fee_limit           protoreflect.FieldDescriptor
+	fd_RequestSubscription_prepare_gas         protoreflect.FieldDescriptor
+	fd_RequestSubscription_execute_gas         protoreflect.FieldDescriptor
+	fd_RequestSubscription_tss_encoder         protoreflect.FieldDescriptor
+	fd_RequestSubscription_interval            protoreflect.FieldDescriptor
+	fd_RequestSubscription_next_request_height protoreflect.FieldDescriptor
+	fd_RequestSubscription_is_active           protoreflect.FieldDescriptor
+	fd_RequestSubscription_last_request_id     protoreflect.FieldDescriptor
+	fd_RequestSubscription_request_count       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_RequestSubscription = File_band_oracle_v1_oracle_proto.Messages().ByName("RequestSubscription")
+	fd_RequestSubscription_id = md_RequestSubscription.Fields().ByName("id")
+	fd_RequestSubscription_owner = md_RequestSubscription.Fields().ByName("owner")
+	fd_RequestSubscription_fee_payer = md_RequestSubscription.Fields().ByName("fee_payer")
+	fd_RequestSubscription_oracle_script_id = md_RequestSubscription.Fields().ByName("oracle_script_id")
+	fd_RequestSubscription_calldata = md_RequestSubscription.Fields().ByName("calldata")
+	fd_RequestSubscription_ask_count = md_RequestSubscription.Fields().ByName("ask_count")
+	fd_RequestSubscription_min_count = md_RequestSubscription.Fields().ByName("min_count")
+	fd_RequestSubscription_client_id = md_RequestSubscription.Fields().ByName("client_id")
+	fd_RequestSubscription_fee_limit = md_RequestSubscription.Fields().ByName("fee_limit")
+	fd_RequestSubscription_prepare_gas = md_RequestSubscription.Fields().ByName("prepare_gas")
+	fd_RequestSubscription_execute_gas = md_RequestSubscription.Fields().ByName("execute_gas")
+	fd_RequestSubscription_tss_encoder = md_RequestSubscription.Fields().ByName("tss_encoder")
+	fd_RequestSubscription_interval = md_RequestSubscription.Fields().ByName("interval")
+	fd_RequestSubscription_next_request_height = md_RequestSubscription.Fields().ByName("next_request_height")
+	fd_RequestSubscription_is_active = md_RequestSubscription.Fields().ByName("is_active")
+	fd_RequestSubscription_last_request_id = md_RequestSubscription.Fields().ByName("last_request_id")
+	fd_RequestSubscription_request_count = md_RequestSubscription.Fields().ByName("request_count")
+}
+
+var _ protoreflect.Message = (*fastReflection_RequestSubscription)(nil)
+
+type fastReflection_RequestSubscription RequestSubscription
+
+func (x *RequestSubscription) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RequestSubscription)(x)
+}
+
+func (x *RequestSubscription) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RequestSubscription_messageType fastReflection_RequestSubscription_messageType
+var _ protoreflect.MessageType = fastReflection_RequestSubscription_messageType{}
+
+type fastReflection_RequestSubscription_messageType struct{}
+
+func (x fastReflection_RequestSubscription_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RequestSubscription)(nil)
+}
+func (x fastReflection_RequestSubscription_messageType) New() protoreflect.Message {
+	return new(fastReflection_RequestSubscription)
+}
+func (x fastReflection_RequestSubscription_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RequestSubscription
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RequestSubscription) Descriptor() protoreflect.MessageDescriptor {
+	return md_RequestSubscription
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RequestSubscription) Type() protoreflect.MessageType {
+	return _fastReflection_RequestSubscription_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RequestSubscription) New() protoreflect.Message {
+	return new(fastReflection_RequestSubscription)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RequestSubscription) Interface() protoreflect.ProtoMessage {
+	return (*RequestSubscription)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RequestSubscription) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_RequestSubscription_id, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_RequestSubscription_owner, value) {
+			return
+		}
+	}
+	if x.FeePayer != "" {
+		value := protoreflect.ValueOfString(x.FeePayer)
+		if !f(fd_RequestSubscription_fee_payer, value) {
+			return
+		}
+	}
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_RequestSubscription_oracle_script_id, value) {
+			return
+		}
+	}
+	if len(x.Calldata) != 0 {
+		value := protoreflect.ValueOfBytes(x.Calldata)
+		if !f(fd_RequestSubscription_calldata, value) {
+			return
+		}
+	}
+	if x.AskCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AskCount)
+		if !f(fd_RequestSubscription_ask_count, value) {
+			return
+		}
+	}
+	if x.MinCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinCount)
+		if !f(fd_RequestSubscription_min_count, value) {
+			return
+		}
+	}
+	if x.ClientId != "" {
+		value := protoreflect.ValueOfString(x.ClientId)
+		if !f(fd_RequestSubscription_client_id, value) {
+			return
+		}
+	}
+	if len(x.FeeLimit) != 0 {
+		value := protoreflect.ValueOfList(&_RequestSubscription_9_list{list: &x.FeeLimit})
+		if !f(fd_RequestSubscription_fee_limit, value) {
+			return
+		}
+	}
+	if x.PrepareGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PrepareGas)
+		if !f(fd_RequestSubscription_prepare_gas, value) {
+			return
+		}
+	}
+	if x.ExecuteGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecuteGas)
+		if !f(fd_RequestSubscription_execute_gas, value) {
+			return
+		}
+	}
+	if x.TssEncoder != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TssEncoder))
+		if !f(fd_RequestSubscription_tss_encoder, value) {
+			return
+		}
+	}
+	if x.Interval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Interval)
+		if !f(fd_RequestSubscription_interval, value) {
+			return
+		}
+	}
+	if x.NextRequestHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NextRequestHeight)
+		if !f(fd_RequestSubscription_next_request_height, value) {
+			return
+		}
+	}
+	if x.IsActive != false {
+		value := protoreflect.ValueOfBool(x.IsActive)
+		if !f(fd_RequestSubscription_is_active, value) {
+			return
+		}
+	}
+	if x.LastRequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastRequestId)
+		if !f(fd_RequestSubscription_last_request_id, value) {
+			return
+		}
+	}
+	if x.RequestCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestCount)
+		if !f(fd_RequestSubscription_request_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RequestSubscription) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestSubscription.id":
+		return x.Id != uint64(0)
+	case "band.oracle.v1.RequestSubscription.owner":
+		return x.Owner != ""
+	case "band.oracle.v1.RequestSubscription.fee_payer":
+		return x.FeePayer != ""
+	case "band.oracle.v1.RequestSubscription.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.RequestSubscription.calldata":
+		return len(x.Calldata) != 0
+	case "band.oracle.v1.RequestSubscription.ask_count":
+		return x.AskCount != uint64(0)
+	case "band.oracle.v1.RequestSubscription.min_count":
+		return x.MinCount != uint64(0)
+	case "band.oracle.v1.RequestSubscription.client_id":
+		return x.ClientId != ""
+	case "band.oracle.v1.RequestSubscription.fee_limit":
+		return len(x.FeeLimit) != 0
+	case "band.oracle.v1.RequestSubscription.prepare_gas":
+		return x.PrepareGas != uint64(0)
+	case "band.oracle.v1.RequestSubscription.execute_gas":
+		return x.ExecuteGas != uint64(0)
+	case "band.oracle.v1.RequestSubscription.tss_encoder":
+		return x.TssEncoder != 0
+	case "band.oracle.v1.RequestSubscription.interval":
+		return x.Interval != uint64(0)
+	case "band.oracle.v1.RequestSubscription.next_request_height":
+		return x.NextRequestHeight != int64(0)
+	case "band.oracle.v1.RequestSubscription.is_active":
+		return x.IsActive != false
+	case "band.oracle.v1.RequestSubscription.last_request_id":
+		return x.LastRequestId != uint64(0)
+	case "band.oracle.v1.RequestSubscription.request_count":
+		return x.RequestCount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestSubscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestSubscription) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestSubscription.id":
+		x.Id = uint64(0)
+	case "band.oracle.v1.RequestSubscription.owner":
+		x.Owner = ""
+	case "band.oracle.v1.RequestSubscription.fee_payer":
+		x.FeePayer = ""
+	case "band.oracle.v1.RequestSubscription.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.RequestSubscription.calldata":
+		x.Calldata = nil
+	case "band.oracle.v1.RequestSubscription.ask_count":
+		x.AskCount = uint64(0)
+	case "band.oracle.v1.RequestSubscription.min_count":
+		x.MinCount = uint64(0)
+	case "band.oracle.v1.RequestSubscription.client_id":
+		x.ClientId = ""
+	case "band.oracle.v1.RequestSubscription.fee_limit":
+		x.FeeLimit = nil
+	case "band.oracle.v1.RequestSubscription.prepare_gas":
+		x.PrepareGas = uint64(0)
+	case "band.oracle.v1.RequestSubscription.execute_gas":
+		x.ExecuteGas = uint64(0)
+	case "band.oracle.v1.RequestSubscription.tss_encoder":
+		x.TssEncoder = 0
+	case "band.oracle.v1.RequestSubscription.interval":
+		x.Interval = uint64(0)
+	case "band.oracle.v1.RequestSubscription.next_request_height":
+		x.NextRequestHeight = int64(0)
+	case "band.oracle.v1.RequestSubscription.is_active":
+		x.IsActive = false
+	case "band.oracle.v1.RequestSubscription.last_request_id":
+		x.LastRequestId = uint64(0)
+	case "band.oracle.v1.RequestSubscription.request_count":
+		x.RequestCount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestSubscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RequestSubscription) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.RequestSubscription.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RequestSubscription.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.RequestSubscription.fee_payer":
+		value := x.FeePayer
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.RequestSubscription.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RequestSubscription.calldata":
+		value := x.Calldata
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.RequestSubscription.ask_count":
+		value := x.AskCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RequestSubscription.min_count":
+		value := x.MinCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RequestSubscription.client_id":
+		value := x.ClientId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.RequestSubscription.fee_limit":
+		if len(x.FeeLimit) == 0 {
+			return protoreflect.ValueOfList(&_RequestSubscription_9_list{})
+		}
+		listValue := &_RequestSubscription_9_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.RequestSubscription.prepare_gas":
+		value := x.PrepareGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RequestSubscription.execute_gas":
+		value := x.ExecuteGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RequestSubscription.tss_encoder":
+		value := x.TssEncoder
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.RequestSubscription.interval":
+		value := x.Interval
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RequestSubscription.next_request_height":
+		value := x.NextRequestHeight
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.RequestSubscription.is_active":
+		value := x.IsActive
+		return protoreflect.ValueOfBool(value)
+	case "band.oracle.v1.RequestSubscription.last_request_id":
+		value := x.LastRequestId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RequestSubscription.request_count":
+		value := x.RequestCount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestSubscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestSubscription does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestSubscription) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestSubscription.id":
+		x.Id = value.Uint()
+	case "band.oracle.v1.RequestSubscription.owner":
+		x.Owner = value.Interface().(string)
+	case "band.oracle.v1.RequestSubscription.fee_payer":
+		x.FeePayer = value.Interface().(string)
+	case "band.oracle.v1.RequestSubscription.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.RequestSubscription.calldata":
+		x.Calldata = value.Bytes()
+	case "band.oracle.v1.RequestSubscription.ask_count":
+		x.AskCount = value.Uint()
+	case "band.oracle.v1.RequestSubscription.min_count":
+		x.MinCount = value.Uint()
+	case "band.oracle.v1.RequestSubscription.client_id":
+		x.ClientId = value.Interface().(string)
+	case "band.oracle.v1.RequestSubscription.fee_limit":
+		lv := value.List()
+		clv := lv.(*_RequestSubscription_9_list)
+		x.FeeLimit = *clv.list
+	case "band.oracle.v1.RequestSubscription.prepare_gas":
+		x.PrepareGas = value.Uint()
+	case "band.oracle.v1.RequestSubscription.execute_gas":
+		x.ExecuteGas = value.Uint()
+	case "band.oracle.v1.RequestSubscription.tss_encoder":
+		x.TssEncoder = (Encoder)(value.Enum())
+	case "band.oracle.v1.RequestSubscription.interval":
+		x.Interval = value.Uint()
+	case "band.oracle.v1.RequestSubscription.next_request_height":
+		x.NextRequestHeight = value.Int()
+	case "band.oracle.v1.RequestSubscription.is_active":
+		x.IsActive = value.Bool()
+	case "band.oracle.v1.RequestSubscription.last_request_id":
+		x.LastRequestId = value.Uint()
+	case "band.oracle.v1.RequestSubscription.request_count":
+		x.RequestCount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestSubscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestSubscription) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestSubscription.fee_limit":
+		if x.FeeLimit == nil {
+			x.FeeLimit = []*v1beta1.Coin{}
+		}
+		value := &_RequestSubscription_9_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.RequestSubscription.id":
+		panic(fmt.Errorf("field id of message band.oracle.v1.RequestSubscription is not mutable"))
+	case "band.oracle.v1.RequestSubscription.owner":
+		panic(fmt.Errorf("field owner of message band.oracle.v1.RequestSubscription is not mutable"))
+	case "band.oracle.v1.RequestSubscription.fee_payer":
+		panic(fmt.Errorf("field fee_payer of message band.oracle.v1.RequestSubscription is not mutable"))
+	case "band.oracle.v1.RequestSubscription.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.RequestSubscription is not mutable"))
+	case "band.oracle.v1.RequestSubscription.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.RequestSubscription is not mutable"))
+	case "band.oracle.v1.RequestSubscription.ask_count":
+		panic(fmt.Errorf("field ask_count of message band.oracle.v1.RequestSubscription is not mutable"))
+	case "band.oracle.v1.RequestSubscription.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.RequestSubscription is not mutable"))
+	case "band.oracle.v1.RequestSubscription.client_id":
+		panic(fmt.Errorf("field client_id of message band.oracle.v1.RequestSubscription is not mutable"))
+	case "band.oracle.v1.RequestSubscription.prepare_gas":
+		panic(fmt.Errorf("field prepare_gas of message band.oracle.v1.RequestSubscription is not mutable"))
+	case "band.oracle.v1.RequestSubscription.execute_gas":
+		panic(fmt.Errorf("field execute_gas of message band.oracle.v1.RequestSubscription is not mutable"))
+	case "band.oracle.v1.RequestSubscription.tss_encoder":
+		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.RequestSubscription is not mutable"))
+	case "band.oracle.v1.RequestSubscription.interval":
+		panic(fmt.Errorf("field interval of message band.oracle.v1.RequestSubscription is not mutable"))
+	case "band.oracle.v1.RequestSubscription.next_request_height":
+		panic(fmt.Errorf("field next_request_height of message band.oracle.v1.RequestSubscription is not mutable"))
+	case "band.oracle.v1.RequestSubscription.is_active":
+		panic(fmt.Errorf("field is_active of message band.oracle.v1.RequestSubscription is not mutable"))
+	case "band.oracle.v1.RequestSubscription.last_request_id":
+		panic(fmt.Errorf("field last_request_id of message band.oracle.v1.RequestSubscription is not mutable"))
+	case "band.oracle.v1.RequestSubscription.request_count":
+		panic(fmt.Errorf("field request_count of message band.oracle.v1.RequestSubscription is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestSubscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RequestSubscription) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.RequestSubscription.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RequestSubscription.owner":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.RequestSubscription.fee_payer":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.RequestSubscription.oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RequestSubscription.calldata":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.RequestSubscription.ask_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RequestSubscription.min_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RequestSubscription.client_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.RequestSubscription.fee_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_RequestSubscription_9_list{list: &list})
+	case "band.oracle.v1.RequestSubscription.prepare_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RequestSubscription.execute_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RequestSubscription.tss_encoder":
+		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.RequestSubscription.interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RequestSubscription.next_request_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.RequestSubscription.is_active":
+		return protoreflect.ValueOfBool(false)
+	case "band.oracle.v1.RequestSubscription.last_request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RequestSubscription.request_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestSubscription"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.RequestSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RequestSubscription) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RequestSubscription", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RequestSubscription) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RequestSubscription) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RequestSubscription) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RequestSubscription) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RequestSubscription)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FeePayer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		}
+		l = len(x.Calldata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AskCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AskCount))
+		}
+		if x.MinCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinCount))
+		}
+		l = len(x.ClientId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FeeLimit) > 0 {
+			for _, e := range x.FeeLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PrepareGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrepareGas))
+		}
+		if x.ExecuteGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecuteGas))
+		}
+		if x.TssEncoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.TssEncoder))
+		}
+		if x.Interval != 0 {
+			n += 1 + runtime.Sov(uint64(x.Interval))
+		}
+		if x.NextRequestHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextRequestHeight))
+		}
+		if x.IsActive {
+			n += 2
+		}
+		if x.LastRequestId != 0 {
+			n += 2 + runtime.Sov(uint64(x.LastRequestId))
+		}
+		if x.RequestCount != 0 {
+			n += 2 + runtime.Sov(uint64(x.RequestCount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RequestSubscription)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RequestCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestCount))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.LastRequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastRequestId))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.IsActive {
+			i--
+			if x.IsActive {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.NextRequestHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextRequestHeight))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.Interval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.TssEncoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TssEncoder))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.ExecuteGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGas))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.PrepareGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrepareGas))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.FeeLimit) > 0 {
+			for iNdEx := len(x.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.ClientId) > 0 {
+			i -= len(x.ClientId)
+			copy(dAtA[i:], x.ClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.MinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.AskCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Calldata) > 0 {
+			i -= len(x.Calldata)
+			copy(dAtA[i:], x.Calldata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.FeePayer) > 0 {
+			i -= len(x.FeePayer)
+			copy(dAtA[i:], x.FeePayer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeePayer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RequestSubscription)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestSubscription: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeePayer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				}
+				x.OracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Calldata == nil {
+					x.Calldata = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				}
+				x.AskCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AskCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				}
+				x.MinCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClientId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeLimit = append(x.FeeLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeLimit[len(x.FeeLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+				}
+				x.PrepareGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrepareGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+				}
+				x.ExecuteGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecuteGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssEncoder", wireType)
+				}
+				x.TssEncoder = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TssEncoder |= Encoder(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				}
+				x.Interval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Interval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextRequestHeight", wireType)
+				}
+				x.NextRequestHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextRequestHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsActive = bool(v != 0)
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastRequestId", wireType)
+				}
+				x.LastRequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastRequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestCount", wireType)
+				}
+				x.RequestCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DataSourceVersion_5_list)(nil)
+
+type _DataSourceVersion_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_DataSourceVersion_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DataSourceVersion_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DataSourceVersion_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DataSourceVersion_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DataSourceVersion_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DataSourceVersion_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DataSourceVersion_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DataSourceVersion_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DataSourceVersion                protoreflect.MessageDescriptor
+	fd_DataSourceVersion_data_source_id protoreflect.FieldDescriptor
+	fd_DataSourceVersion_version        protoreflect.FieldDescriptor
+	fd_DataSourceVersion_filename       protoreflect.FieldDescriptor
+	fd_DataSourceVersion_treasury       protoreflect.FieldDescriptor
+	fd_DataSourceVersion_fee            protoreflect.FieldDescriptor
+	fd_DataSourceVersion_editor         protoreflect.FieldDescriptor
+	fd_DataSourceVersion_height         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_DataSourceVersion = File_band_oracle_v1_oracle_proto.Messages().ByName("DataSourceVersion")
+	fd_DataSourceVersion_data_source_id = md_DataSourceVersion.Fields().ByName("data_source_id")
+	fd_DataSourceVersion_version = md_DataSourceVersion.Fields().ByName("version")
+	fd_DataSourceVersion_filename = md_DataSourceVersion.Fields().ByName("filename")
+	fd_DataSourceVersion_treasury = md_DataSourceVersion.Fields().ByName("treasury")
+	fd_DataSourceVersion_fee = md_DataSourceVersion.Fields().ByName("fee")
+	fd_DataSourceVersion_editor = md_DataSourceVersion.Fields().ByName("editor")
+	fd_DataSourceVersion_height = md_DataSourceVersion.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_DataSourceVersion)(nil)
+
+type fastReflection_DataSourceVersion DataSourceVersion
+
+func (x *DataSourceVersion) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DataSourceVersion)(x)
+}
+
+func (x *DataSourceVersion) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DataSourceVersion_messageType fastReflection_DataSourceVersion_messageType
+var _ protoreflect.MessageType = fastReflection_DataSourceVersion_messageType{}
+
+type fastReflection_DataSourceVersion_messageType struct{}
+
+func (x fastReflection_DataSourceVersion_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DataSourceVersion)(nil)
+}
+func (x fastReflection_DataSourceVersion_messageType) New() protoreflect.Message {
+	return new(fastReflection_DataSourceVersion)
+}
+func (x fastReflection_DataSourceVersion_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DataSourceVersion
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DataSourceVersion) Descriptor() protoreflect.MessageDescriptor {
+	return md_DataSourceVersion
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DataSourceVersion) Type() protoreflect.MessageType {
+	return _fastReflection_DataSourceVersion_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DataSourceVersion) New() protoreflect.Message {
+	return new(fastReflection_DataSourceVersion)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DataSourceVersion) Interface() protoreflect.ProtoMessage {
+	return (*DataSourceVersion)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DataSourceVersion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_DataSourceVersion_data_source_id, value) {
+			return
+		}
+	}
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_DataSourceVersion_version, value) {
+			return
+		}
+	}
+	if x.Filename != "" {
+		value := protoreflect.ValueOfString(x.Filename)
+		if !f(fd_DataSourceVersion_filename, value) {
+			return
+		}
+	}
+	if x.Treasury != "" {
+		value := protoreflect.ValueOfString(x.Treasury)
+		if !f(fd_DataSourceVersion_treasury, value) {
+			return
+		}
+	}
+	if len(x.Fee) != 0 {
+		value := protoreflect.ValueOfList(&_DataSourceVersion_5_list{list: &x.Fee})
+		if !f(fd_DataSourceVersion_fee, value) {
+			return
+		}
+	}
+	if x.Editor != "" {
+		value := protoreflect.ValueOfString(x.Editor)
+		if !f(fd_DataSourceVersion_editor, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_DataSourceVersion_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DataSourceVersion) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
+		return x.DataSourceId != uint64(0)
+	case "band.oracle.v1.DataSourceVersion.version":
+		return x.Version != uint64(0)
+	case "band.oracle.v1.DataSourceVersion.filename":
+		return x.Filename != ""
+	case "band.oracle.v1.DataSourceVersion.treasury":
+		return x.Treasury != ""
+	case "band.oracle.v1.DataSourceVersion.fee":
+		return len(x.Fee) != 0
+	case "band.oracle.v1.DataSourceVersion.editor":
+		return x.Editor != ""
+	case "band.oracle.v1.DataSourceVersion.height":
+		return x.Height != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DataSourceVersion) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
+		x.DataSourceId = uint64(0)
+	case "band.oracle.v1.DataSourceVersion.version":
+		x.Version = uint64(0)
+	case "band.oracle.v1.DataSourceVersion.filename":
+		x.Filename = ""
+	case "band.oracle.v1.DataSourceVersion.treasury":
+		x.Treasury = ""
+	case "band.oracle.v1.DataSourceVersion.fee":
+		x.Fee = nil
+	case "band.oracle.v1.DataSourceVersion.editor":
+		x.Editor = ""
+	case "band.oracle.v1.DataSourceVersion.height":
+		x.Height = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DataSourceVersion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
+		value := x.DataSourceId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.DataSourceVersion.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.DataSourceVersion.filename":
+		value := x.Filename
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.DataSourceVersion.treasury":
+		value := x.Treasury
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.DataSourceVersion.fee":
+		if len(x.Fee) == 0 {
+			return protoreflect.ValueOfList(&_DataSourceVersion_5_list{})
+		}
+		listValue := &_DataSourceVersion_5_list{list: &x.Fee}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.DataSourceVersion.editor":
+		value := x.Editor
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.DataSourceVersion.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DataSourceVersion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
+		x.DataSourceId = value.Uint()
+	case "band.oracle.v1.DataSourceVersion.version":
+		x.Version = value.Uint()
+	case "band.oracle.v1.DataSourceVersion.filename":
+		x.Filename = value.Interface().(string)
+	case "band.oracle.v1.DataSourceVersion.treasury":
+		x.Treasury = value.Interface().(string)
+	case "band.oracle.v1.DataSourceVersion.fee":
+		lv := value.List()
+		clv := lv.(*_DataSourceVersion_5_list)
+		x.Fee = *clv.list
+	case "band.oracle.v1.DataSourceVersion.editor":
+		x.Editor = value.Interface().(string)
+	case "band.oracle.v1.DataSourceVersion.height":
+		x.Height = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DataSourceVersion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.DataSourceVersion.fee":
+		if x.Fee == nil {
+			x.Fee = []*v1beta1.Coin{}
+		}
+		value := &_DataSourceVersion_5_list{list: &x.Fee}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.DataSourceVersion is not mutable"))
+	case "band.oracle.v1.DataSourceVersion.version":
+		panic(fmt.Errorf("field version of message band.oracle.v1.DataSourceVersion is not mutable"))
+	case "band.oracle.v1.DataSourceVersion.filename":
+		panic(fmt.Errorf("field filename of message band.oracle.v1.DataSourceVersion is not mutable"))
+	case "band.oracle.v1.DataSourceVersion.treasury":
+		panic(fmt.Errorf("field treasury of message band.oracle.v1.DataSourceVersion is not mutable"))
+	case "band.oracle.v1.DataSourceVersion.editor":
+		panic(fmt.Errorf("field editor of message band.oracle.v1.DataSourceVersion is not mutable"))
+	case "band.oracle.v1.DataSourceVersion.height":
+		panic(fmt.Errorf("field height of message band.oracle.v1.DataSourceVersion is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DataSourceVersion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.DataSourceVersion.data_source_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.DataSourceVersion.version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.DataSourceVersion.filename":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.DataSourceVersion.treasury":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.DataSourceVersion.fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_DataSourceVersion_5_list{list: &list})
+	case "band.oracle.v1.DataSourceVersion.editor":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.DataSourceVersion.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersion"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DataSourceVersion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.DataSourceVersion", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DataSourceVersion) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DataSourceVersion) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DataSourceVersion) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DataSourceVersion) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DataSourceVersion)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DataSourceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceId))
+		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		l = len(x.Filename)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Treasury)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Fee) > 0 {
+			for _, e := range x.Fee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Editor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DataSourceVersion)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Editor) > 0 {
+			i -= len(x.Editor)
+			copy(dAtA[i:], x.Editor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Editor)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Fee) > 0 {
+			for iNdEx := len(x.Fee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Fee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Treasury) > 0 {
+			i -= len(x.Treasury)
+			copy(dAtA[i:], x.Treasury)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Treasury)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Filename) > 0 {
+			i -= len(x.Filename)
+			copy(dAtA[i:], x.Filename)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Filename)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DataSourceVersion)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DataSourceVersion: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DataSourceVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+				}
+				x.DataSourceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DataSourceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Filename = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Treasury = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fee = append(x.Fee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee[len(x.Fee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Editor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Editor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_OracleScriptVersion                  protoreflect.MessageDescriptor
+	fd_OracleScriptVersion_oracle_script_id protoreflect.FieldDescriptor
+	fd_OracleScriptVersion_version          protoreflect.FieldDescriptor
+	fd_OracleScriptVersion_filename         protoreflect.FieldDescriptor
+	fd_OracleScriptVersion_schema           protoreflect.FieldDescriptor
+	fd_OracleScriptVersion_source_code_url  protoreflect.FieldDescriptor
+	fd_OracleScriptVersion_editor           protoreflect.FieldDescriptor
+	fd_OracleScriptVersion_height           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_OracleScriptVersion = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleScriptVersion")
+	fd_OracleScriptVersion_oracle_script_id = md_OracleScriptVersion.Fields().ByName("oracle_script_id")
+	fd_OracleScriptVersion_version = md_OracleScriptVersion.Fields().ByName("version")
+	fd_OracleScriptVersion_filename = md_OracleScriptVersion.Fields().ByName("filename")
+	fd_OracleScriptVersion_schema = md_OracleScriptVersion.Fields().ByName("schema")
+	fd_OracleScriptVersion_source_code_url = md_OracleScriptVersion.Fields().ByName("source_code_url")
+	fd_OracleScriptVersion_editor = md_OracleScriptVersion.Fields().ByName("editor")
+	fd_OracleScriptVersion_height = md_OracleScriptVersion.Fields().ByName("height")
 }
 
-var _ protoreflect.Message = (*fastReflection_IBCChannel)(nil)
+var _ protoreflect.Message = (*fastReflection_OracleScriptVersion)(nil)
 
-type fastReflection_IBCChannel IBCChannel
+type fastReflection_OracleScriptVersion OracleScriptVersion
 
-func (x *IBCChannel) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_IBCChannel)(x)
+func (x *OracleScriptVersion) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OracleScriptVersion)(x)
 }
 
-func (x *IBCChannel) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
+func (x *OracleScriptVersion) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10444,43 +17896,43 @@ func (x *IBCChannel) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_IBCChannel_messageType fastReflection_IBCChannel_messageType
-var _ protoreflect.MessageType = fastReflection_IBCChannel_messageType{}
+var _fastReflection_OracleScriptVersion_messageType fastReflection_OracleScriptVersion_messageType
+var _ protoreflect.MessageType = fastReflection_OracleScriptVersion_messageType{}
 
-type fastReflection_IBCChannel_messageType struct{}
+type fastReflection_OracleScriptVersion_messageType struct{}
 
-func (x fastReflection_IBCChannel_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_IBCChannel)(nil)
+func (x fastReflection_OracleScriptVersion_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OracleScriptVersion)(nil)
 }
-func (x fastReflection_IBCChannel_messageType) New() protoreflect.Message {
-	return new(fastReflection_IBCChannel)
+func (x fastReflection_OracleScriptVersion_messageType) New() protoreflect.Message {
+	return new(fastReflection_OracleScriptVersion)
 }
-func (x fastReflection_IBCChannel_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_IBCChannel
+func (x fastReflection_OracleScriptVersion_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleScriptVersion
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_IBCChannel) Descriptor() protoreflect.MessageDescriptor {
-	return md_IBCChannel
+func (x *fastReflection_OracleScriptVersion) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleScriptVersion
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_IBCChannel) Type() protoreflect.MessageType {
-	return _fastReflection_IBCChannel_messageType
+func (x *fastReflection_OracleScriptVersion) Type() protoreflect.MessageType {
+	return _fastReflection_OracleScriptVersion_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_IBCChannel) New() protoreflect.Message {
-	return new(fastReflection_IBCChannel)
+func (x *fastReflection_OracleScriptVersion) New() protoreflect.Message {
+	return new(fastReflection_OracleScriptVersion)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_IBCChannel) Interface() protoreflect.ProtoMessage {
-	return (*IBCChannel)(x)
+func (x *fastReflection_OracleScriptVersion) Interface() protoreflect.ProtoMessage {
+	return (*OracleScriptVersion)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10488,16 +17940,46 @@ func (x *fastReflection_IBCChannel) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_IBCChannel) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.PortId != "" {
-		value := protoreflect.ValueOfString(x.PortId)
-		if !f(fd_IBCChannel_port_id, value) {
+func (x *fastReflection_OracleScriptVersion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_OracleScriptVersion_oracle_script_id, value) {
 			return
 		}
 	}
-	if x.ChannelId != "" {
-		value := protoreflect.ValueOfString(x.ChannelId)
-		if !f(fd_IBCChannel_channel_id, value) {
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_OracleScriptVersion_version, value) {
+			return
+		}
+	}
+	if x.Filename != "" {
+		value := protoreflect.ValueOfString(x.Filename)
+		if !f(fd_OracleScriptVersion_filename, value) {
+			return
+		}
+	}
+	if x.Schema != "" {
+		value := protoreflect.ValueOfString(x.Schema)
+		if !f(fd_OracleScriptVersion_schema, value) {
+			return
+		}
+	}
+	if x.SourceCodeUrl != "" {
+		value := protoreflect.ValueOfString(x.SourceCodeUrl)
+		if !f(fd_OracleScriptVersion_source_code_url, value) {
+			return
+		}
+	}
+	if x.Editor != "" {
+		value := protoreflect.ValueOfString(x.Editor)
+		if !f(fd_OracleScriptVersion_editor, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_OracleScriptVersion_height, value) {
 			return
 		}
 	}
@@ -10514,17 +17996,27 @@ func (x *fastReflection_IBCChannel) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_IBCChannel) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_OracleScriptVersion) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.IBCChannel.port_id":
-		return x.PortId != ""
-	case "band.oracle.v1.IBCChannel.channel_id":
-		return x.ChannelId != ""
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.OracleScriptVersion.version":
+		return x.Version != uint64(0)
+	case "band.oracle.v1.OracleScriptVersion.filename":
+		return x.Filename != ""
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		return x.Schema != ""
+	case "band.oracle.v1.OracleScriptVersion.source_code_url":
+		return x.SourceCodeUrl != ""
+	case "band.oracle.v1.OracleScriptVersion.editor":
+		return x.Editor != ""
+	case "band.oracle.v1.OracleScriptVersion.height":
+		return x.Height != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10534,17 +18026,27 @@ func (x *fastReflection_IBCChannel) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IBCChannel) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_OracleScriptVersion) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.IBCChannel.port_id":
-		x.PortId = ""
-	case "band.oracle.v1.IBCChannel.channel_id":
-		x.ChannelId = ""
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.OracleScriptVersion.version":
+		x.Version = uint64(0)
+	case "band.oracle.v1.OracleScriptVersion.filename":
+		x.Filename = ""
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		x.Schema = ""
+	case "band.oracle.v1.OracleScriptVersion.source_code_url":
+		x.SourceCodeUrl = ""
+	case "band.oracle.v1.OracleScriptVersion.editor":
+		x.Editor = ""
+	case "band.oracle.v1.OracleScriptVersion.height":
+		x.Height = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10554,19 +18056,34 @@ func (x *fastReflection_IBCChannel) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_IBCChannel) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleScriptVersion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.IBCChannel.port_id":
-		value := x.PortId
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleScriptVersion.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleScriptVersion.filename":
+		value := x.Filename
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.IBCChannel.channel_id":
-		value := x.ChannelId
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		value := x.Schema
 		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.OracleScriptVersion.source_code_url":
+		value := x.SourceCodeUrl
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.OracleScriptVersion.editor":
+		value := x.Editor
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.OracleScriptVersion.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10580,17 +18097,27 @@ func (x *fastReflection_IBCChannel) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IBCChannel) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_OracleScriptVersion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.IBCChannel.port_id":
-		x.PortId = value.Interface().(string)
-	case "band.oracle.v1.IBCChannel.channel_id":
-		x.ChannelId = value.Interface().(string)
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.OracleScriptVersion.version":
+		x.Version = value.Uint()
+	case "band.oracle.v1.OracleScriptVersion.filename":
+		x.Filename = value.Interface().(string)
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		x.Schema = value.Interface().(string)
+	case "band.oracle.v1.OracleScriptVersion.source_code_url":
+		x.SourceCodeUrl = value.Interface().(string)
+	case "band.oracle.v1.OracleScriptVersion.editor":
+		x.Editor = value.Interface().(string)
+	case "band.oracle.v1.OracleScriptVersion.height":
+		x.Height = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10604,44 +18131,64 @@ func (x *fastReflection_IBCChannel) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IBCChannel) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleScriptVersion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.IBCChannel.port_id":
-		panic(fmt.Errorf("field port_id of message band.oracle.v1.IBCChannel is not mutable"))
-	case "band.oracle.v1.IBCChannel.channel_id":
-		panic(fmt.Errorf("field channel_id of message band.oracle.v1.IBCChannel is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.OracleScriptVersion is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.version":
+		panic(fmt.Errorf("field version of message band.oracle.v1.OracleScriptVersion is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.filename":
+		panic(fmt.Errorf("field filename of message band.oracle.v1.OracleScriptVersion is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		panic(fmt.Errorf("field schema of message band.oracle.v1.OracleScriptVersion is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.source_code_url":
+		panic(fmt.Errorf("field source_code_url of message band.oracle.v1.OracleScriptVersion is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.editor":
+		panic(fmt.Errorf("field editor of message band.oracle.v1.OracleScriptVersion is not mutable"))
+	case "band.oracle.v1.OracleScriptVersion.height":
+		panic(fmt.Errorf("field height of message band.oracle.v1.OracleScriptVersion is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_IBCChannel) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OracleScriptVersion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.IBCChannel.port_id":
+	case "band.oracle.v1.OracleScriptVersion.oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.OracleScriptVersion.version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.OracleScriptVersion.filename":
 		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.IBCChannel.channel_id":
+	case "band.oracle.v1.OracleScriptVersion.schema":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.OracleScriptVersion.source_code_url":
 		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.OracleScriptVersion.editor":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.OracleScriptVersion.height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCChannel"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScriptVersion"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.IBCChannel does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.OracleScriptVersion does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_IBCChannel) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_OracleScriptVersion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.IBCChannel", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleScriptVersion", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10649,7 +18196,7 @@ func (x *fastReflection_IBCChannel) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_IBCChannel) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_OracleScriptVersion) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10660,7 +18207,7 @@ func (x *fastReflection_IBCChannel) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IBCChannel) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_OracleScriptVersion) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10672,7 +18219,7 @@ func (x *fastReflection_IBCChannel) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_IBCChannel) IsValid() bool {
+func (x *fastReflection_OracleScriptVersion) IsValid() bool {
 	return x != nil
 }
 
@@ -10682,9 +18229,9 @@ func (x *fastReflection_IBCChannel) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_IBCChannel) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_OracleScriptVersion) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*IBCChannel)
+		x := input.Message.Interface().(*OracleScriptVersion)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10696,14 +18243,31 @@ func (x *fastReflection_IBCChannel) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.PortId)
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		l = len(x.Filename)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ChannelId)
+		l = len(x.Schema)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SourceCodeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Editor)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -10714,7 +18278,7 @@ func (x *fastReflection_IBCChannel) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*IBCChannel)
+		x := input.Message.Interface().(*OracleScriptVersion)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10733,19 +18297,48 @@ func (x *fastReflection_IBCChannel) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ChannelId) > 0 {
-			i -= len(x.ChannelId)
-			copy(dAtA[i:], x.ChannelId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x38
 		}
-		if len(x.PortId) > 0 {
-			i -= len(x.PortId)
-			copy(dAtA[i:], x.PortId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PortId)))
+		if len(x.Editor) > 0 {
+			i -= len(x.Editor)
+			copy(dAtA[i:], x.Editor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Editor)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x32
+		}
+		if len(x.SourceCodeUrl) > 0 {
+			i -= len(x.SourceCodeUrl)
+			copy(dAtA[i:], x.SourceCodeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SourceCodeUrl)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Schema) > 0 {
+			i -= len(x.Schema)
+			copy(dAtA[i:], x.Schema)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Schema)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Filename) > 0 {
+			i -= len(x.Filename)
+			copy(dAtA[i:], x.Filename)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Filename)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -10758,7 +18351,7 @@ func (x *fastReflection_IBCChannel) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*IBCChannel)
+		x := input.Message.Interface().(*OracleScriptVersion)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10790,15 +18383,53 @@ func (x *fastReflection_IBCChannel) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCChannel: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleScriptVersion: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleScriptVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				}
+				x.OracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -10826,11 +18457,11 @@ func (x *fastReflection_IBCChannel) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PortId = string(dAtA[iNdEx:postIndex])
+				x.Filename = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -10858,8 +18489,91 @@ func (x *fastReflection_IBCChannel) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				x.Schema = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceCodeUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SourceCodeUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Editor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Editor = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -10896,34 +18610,28 @@ func (x *fastReflection_IBCChannel) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_RequestVerification                protoreflect.MessageDescriptor
-	fd_RequestVerification_chain_id       protoreflect.FieldDescriptor
-	fd_RequestVerification_validator      protoreflect.FieldDescriptor
-	fd_RequestVerification_request_id     protoreflect.FieldDescriptor
-	fd_RequestVerification_external_id    protoreflect.FieldDescriptor
-	fd_RequestVerification_data_source_id protoreflect.FieldDescriptor
+	md_DataSourceVersionPin                protoreflect.MessageDescriptor
+	fd_DataSourceVersionPin_data_source_id protoreflect.FieldDescriptor
+	fd_DataSourceVersionPin_version        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_RequestVerification = File_band_oracle_v1_oracle_proto.Messages().ByName("RequestVerification")
-	fd_RequestVerification_chain_id = md_RequestVerification.Fields().ByName("chain_id")
-	fd_RequestVerification_validator = md_RequestVerification.Fields().ByName("validator")
-	fd_RequestVerification_request_id = md_RequestVerification.Fields().ByName("request_id")
-	fd_RequestVerification_external_id = md_RequestVerification.Fields().ByName("external_id")
-	fd_RequestVerification_data_source_id = md_RequestVerification.Fields().ByName("data_source_id")
+	md_DataSourceVersionPin = File_band_oracle_v1_oracle_proto.Messages().ByName("DataSourceVersionPin")
+	fd_DataSourceVersionPin_data_source_id = md_DataSourceVersionPin.Fields().ByName("data_source_id")
+	fd_DataSourceVersionPin_version = md_DataSourceVersionPin.Fields().ByName("version")
 }
 
-var _ protoreflect.Message = (*fastReflection_RequestVerification)(nil)
+var _ protoreflect.Message = (*fastReflection_DataSourceVersionPin)(nil)
 
-type fastReflection_RequestVerification RequestVerification
+type fastReflection_DataSourceVersionPin DataSourceVersionPin
 
-func (x *RequestVerification) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RequestVerification)(x)
+func (x *DataSourceVersionPin) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DataSourceVersionPin)(x)
 }
 
-func (x *RequestVerification) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
+func (x *DataSourceVersionPin) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10934,43 +18642,43 @@ func (x *RequestVerification) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RequestVerification_messageType fastReflection_RequestVerification_messageType
-var _ protoreflect.MessageType = fastReflection_RequestVerification_messageType{}
+var _fastReflection_DataSourceVersionPin_messageType fastReflection_DataSourceVersionPin_messageType
+var _ protoreflect.MessageType = fastReflection_DataSourceVersionPin_messageType{}
 
-type fastReflection_RequestVerification_messageType struct{}
+type fastReflection_DataSourceVersionPin_messageType struct{}
 
-func (x fastReflection_RequestVerification_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RequestVerification)(nil)
+func (x fastReflection_DataSourceVersionPin_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DataSourceVersionPin)(nil)
 }
-func (x fastReflection_RequestVerification_messageType) New() protoreflect.Message {
-	return new(fastReflection_RequestVerification)
+func (x fastReflection_DataSourceVersionPin_messageType) New() protoreflect.Message {
+	return new(fastReflection_DataSourceVersionPin)
 }
-func (x fastReflection_RequestVerification_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RequestVerification
+func (x fastReflection_DataSourceVersionPin_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DataSourceVersionPin
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RequestVerification) Descriptor() protoreflect.MessageDescriptor {
-	return md_RequestVerification
+func (x *fastReflection_DataSourceVersionPin) Descriptor() protoreflect.MessageDescriptor {
+	return md_DataSourceVersionPin
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RequestVerification) Type() protoreflect.MessageType {
-	return _fastReflection_RequestVerification_messageType
+func (x *fastReflection_DataSourceVersionPin) Type() protoreflect.MessageType {
+	return _fastReflection_DataSourceVersionPin_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RequestVerification) New() protoreflect.Message {
-	return new(fastReflection_RequestVerification)
+func (x *fastReflection_DataSourceVersionPin) New() protoreflect.Message {
+	return new(fastReflection_DataSourceVersionPin)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RequestVerification) Interface() protoreflect.ProtoMessage {
-	return (*RequestVerification)(x)
+func (x *fastReflection_DataSourceVersionPin) Interface() protoreflect.ProtoMessage {
+	return (*DataSourceVersionPin)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10978,34 +18686,16 @@ func (x *fastReflection_RequestVerification) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RequestVerification) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ChainId != "" {
-		value := protoreflect.ValueOfString(x.ChainId)
-		if !f(fd_RequestVerification_chain_id, value) {
-			return
-		}
-	}
-	if x.Validator != "" {
-		value := protoreflect.ValueOfString(x.Validator)
-		if !f(fd_RequestVerification_validator, value) {
-			return
-		}
-	}
-	if x.RequestId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_RequestVerification_request_id, value) {
-			return
-		}
-	}
-	if x.ExternalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExternalId)
-		if !f(fd_RequestVerification_external_id, value) {
-			return
-		}
-	}
+func (x *fastReflection_DataSourceVersionPin) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.DataSourceId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.DataSourceId)
-		if !f(fd_RequestVerification_data_source_id, value) {
+		if !f(fd_DataSourceVersionPin_data_source_id, value) {
+			return
+		}
+	}
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_DataSourceVersionPin_version, value) {
 			return
 		}
 	}
@@ -11022,23 +18712,17 @@ func (x *fastReflection_RequestVerification) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RequestVerification) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DataSourceVersionPin) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.RequestVerification.chain_id":
-		return x.ChainId != ""
-	case "band.oracle.v1.RequestVerification.validator":
-		return x.Validator != ""
-	case "band.oracle.v1.RequestVerification.request_id":
-		return x.RequestId != uint64(0)
-	case "band.oracle.v1.RequestVerification.external_id":
-		return x.ExternalId != uint64(0)
-	case "band.oracle.v1.RequestVerification.data_source_id":
+	case "band.oracle.v1.DataSourceVersionPin.data_source_id":
 		return x.DataSourceId != uint64(0)
+	case "band.oracle.v1.DataSourceVersionPin.version":
+		return x.Version != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersionPin"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersionPin does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11048,23 +18732,17 @@ func (x *fastReflection_RequestVerification) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RequestVerification) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DataSourceVersionPin) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.RequestVerification.chain_id":
-		x.ChainId = ""
-	case "band.oracle.v1.RequestVerification.validator":
-		x.Validator = ""
-	case "band.oracle.v1.RequestVerification.request_id":
-		x.RequestId = uint64(0)
-	case "band.oracle.v1.RequestVerification.external_id":
-		x.ExternalId = uint64(0)
-	case "band.oracle.v1.RequestVerification.data_source_id":
+	case "band.oracle.v1.DataSourceVersionPin.data_source_id":
 		x.DataSourceId = uint64(0)
+	case "band.oracle.v1.DataSourceVersionPin.version":
+		x.Version = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersionPin"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersionPin does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11074,28 +18752,19 @@ func (x *fastReflection_RequestVerification) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RequestVerification) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DataSourceVersionPin) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.RequestVerification.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.RequestVerification.validator":
-		value := x.Validator
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.RequestVerification.request_id":
-		value := x.RequestId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.RequestVerification.external_id":
-		value := x.ExternalId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.RequestVerification.data_source_id":
+	case "band.oracle.v1.DataSourceVersionPin.data_source_id":
 		value := x.DataSourceId
 		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.DataSourceVersionPin.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersionPin"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersionPin does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11109,23 +18778,17 @@ func (x *fastReflection_RequestVerification) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RequestVerification) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DataSourceVersionPin) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.RequestVerification.chain_id":
-		x.ChainId = value.Interface().(string)
-	case "band.oracle.v1.RequestVerification.validator":
-		x.Validator = value.Interface().(string)
-	case "band.oracle.v1.RequestVerification.request_id":
-		x.RequestId = value.Uint()
-	case "band.oracle.v1.RequestVerification.external_id":
-		x.ExternalId = value.Uint()
-	case "band.oracle.v1.RequestVerification.data_source_id":
+	case "band.oracle.v1.DataSourceVersionPin.data_source_id":
 		x.DataSourceId = value.Uint()
+	case "band.oracle.v1.DataSourceVersionPin.version":
+		x.Version = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersionPin"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersionPin does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11139,56 +18802,44 @@ func (x *fastReflection_RequestVerification) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RequestVerification) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DataSourceVersionPin) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.RequestVerification.chain_id":
-		panic(fmt.Errorf("field chain_id of message band.oracle.v1.RequestVerification is not mutable"))
-	case "band.oracle.v1.RequestVerification.validator":
-		panic(fmt.Errorf("field validator of message band.oracle.v1.RequestVerification is not mutable"))
-	case "band.oracle.v1.RequestVerification.request_id":
-		panic(fmt.Errorf("field request_id of message band.oracle.v1.RequestVerification is not mutable"))
-	case "band.oracle.v1.RequestVerification.external_id":
-		panic(fmt.Errorf("field external_id of message band.oracle.v1.RequestVerification is not mutable"))
-	case "band.oracle.v1.RequestVerification.data_source_id":
-		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.RequestVerification is not mutable"))
+	case "band.oracle.v1.DataSourceVersionPin.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.DataSourceVersionPin is not mutable"))
+	case "band.oracle.v1.DataSourceVersionPin.version":
+		panic(fmt.Errorf("field version of message band.oracle.v1.DataSourceVersionPin is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersionPin"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersionPin does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RequestVerification) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DataSourceVersionPin) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.RequestVerification.chain_id":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.RequestVerification.validator":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.RequestVerification.request_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.RequestVerification.external_id":
+	case "band.oracle.v1.DataSourceVersionPin.data_source_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.RequestVerification.data_source_id":
+	case "band.oracle.v1.DataSourceVersionPin.version":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RequestVerification"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSourceVersionPin"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RequestVerification does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.DataSourceVersionPin does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RequestVerification) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DataSourceVersionPin) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RequestVerification", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.DataSourceVersionPin", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11196,7 +18847,7 @@ func (x *fastReflection_RequestVerification) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RequestVerification) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DataSourceVersionPin) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11207,7 +18858,7 @@ func (x *fastReflection_RequestVerification) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RequestVerification) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DataSourceVersionPin) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11219,7 +18870,7 @@ func (x *fastReflection_RequestVerification) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RequestVerification) IsValid() bool {
+func (x *fastReflection_DataSourceVersionPin) IsValid() bool {
 	return x != nil
 }
 
@@ -11229,9 +18880,9 @@ func (x *fastReflection_RequestVerification) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RequestVerification) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DataSourceVersionPin) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RequestVerification)
+		x := input.Message.Interface().(*DataSourceVersionPin)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11243,23 +18894,12 @@ func (x *fastReflection_RequestVerification) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.ChainId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Validator)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.RequestId != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestId))
-		}
-		if x.ExternalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExternalId))
-		}
 		if x.DataSourceId != 0 {
 			n += 1 + runtime.Sov(uint64(x.DataSourceId))
 		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -11270,7 +18910,7 @@ func (x *fastReflection_RequestVerification) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RequestVerification)
+		x := input.Message.Interface().(*DataSourceVersionPin)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11289,34 +18929,15 @@ func (x *fastReflection_RequestVerification) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.DataSourceId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.ExternalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.RequestId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.Validator) > 0 {
-			i -= len(x.Validator)
-			copy(dAtA[i:], x.Validator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if len(x.ChainId) > 0 {
-			i -= len(x.ChainId)
-			copy(dAtA[i:], x.ChainId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -11329,7 +18950,7 @@ func (x *fastReflection_RequestVerification) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RequestVerification)
+		x := input.Message.Interface().(*DataSourceVersionPin)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11361,100 +18982,17 @@ func (x *fastReflection_RequestVerification) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestVerification: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DataSourceVersionPin: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RequestVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DataSourceVersionPin: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ChainId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Validator = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-				}
-				x.RequestId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
 				}
-				x.ExternalId = 0
+				x.DataSourceId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11464,16 +19002,16 @@ func (x *fastReflection_RequestVerification) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ExternalId |= uint64(b&0x7F) << shift
+					x.DataSourceId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 5:
+			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 				}
-				x.DataSourceId = 0
+				x.Version = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11483,7 +19021,7 @@ func (x *fastReflection_RequestVerification) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.DataSourceId |= uint64(b&0x7F) << shift
+					x.Version |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -11523,2650 +19061,2358 @@ func (x *fastReflection_RequestVerification) ProtoMethods() *protoiface.Methods
 	}
 }
 
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: band/oracle/v1/oracle.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ResolveStatus encodes the status of an oracle request.
+type ResolveStatus int32
+
+const (
+	// Open - the request is not yet resolved.
+	ResolveStatus_RESOLVE_STATUS_OPEN_UNSPECIFIED ResolveStatus = 0
+	// Success - the request has been resolved successfully with no errors.
+	ResolveStatus_RESOLVE_STATUS_SUCCESS ResolveStatus = 1
+	// Failure - an error occurred during the request's resolve call.
+	ResolveStatus_RESOLVE_STATUS_FAILURE ResolveStatus = 2
+	// Expired - the request does not get enough reports from validator within the
+	// timeframe.
+	ResolveStatus_RESOLVE_STATUS_EXPIRED ResolveStatus = 3
+)
+
+// Enum value maps for ResolveStatus.
 var (
-	md_PriceResult              protoreflect.MessageDescriptor
-	fd_PriceResult_symbol       protoreflect.FieldDescriptor
-	fd_PriceResult_multiplier   protoreflect.FieldDescriptor
-	fd_PriceResult_px           protoreflect.FieldDescriptor
-	fd_PriceResult_request_id   protoreflect.FieldDescriptor
-	fd_PriceResult_resolve_time protoreflect.FieldDescriptor
+	ResolveStatus_name = map[int32]string{
+		0: "RESOLVE_STATUS_OPEN_UNSPECIFIED",
+		1: "RESOLVE_STATUS_SUCCESS",
+		2: "RESOLVE_STATUS_FAILURE",
+		3: "RESOLVE_STATUS_EXPIRED",
+	}
+	ResolveStatus_value = map[string]int32{
+		"RESOLVE_STATUS_OPEN_UNSPECIFIED": 0,
+		"RESOLVE_STATUS_SUCCESS":          1,
+		"RESOLVE_STATUS_FAILURE":          2,
+		"RESOLVE_STATUS_EXPIRED":          3,
+	}
 )
 
-func init() {
-	file_band_oracle_v1_oracle_proto_init()
-	md_PriceResult = File_band_oracle_v1_oracle_proto.Messages().ByName("PriceResult")
-	fd_PriceResult_symbol = md_PriceResult.Fields().ByName("symbol")
-	fd_PriceResult_multiplier = md_PriceResult.Fields().ByName("multiplier")
-	fd_PriceResult_px = md_PriceResult.Fields().ByName("px")
-	fd_PriceResult_request_id = md_PriceResult.Fields().ByName("request_id")
-	fd_PriceResult_resolve_time = md_PriceResult.Fields().ByName("resolve_time")
+func (x ResolveStatus) Enum() *ResolveStatus {
+	p := new(ResolveStatus)
+	*p = x
+	return p
 }
 
-var _ protoreflect.Message = (*fastReflection_PriceResult)(nil)
+func (x ResolveStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-type fastReflection_PriceResult PriceResult
+func (ResolveStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_oracle_v1_oracle_proto_enumTypes[0].Descriptor()
+}
 
-func (x *PriceResult) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_PriceResult)(x)
+func (ResolveStatus) Type() protoreflect.EnumType {
+	return &file_band_oracle_v1_oracle_proto_enumTypes[0]
 }
 
-func (x *PriceResult) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x ResolveStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-var _fastReflection_PriceResult_messageType fastReflection_PriceResult_messageType
-var _ protoreflect.MessageType = fastReflection_PriceResult_messageType{}
+// Deprecated: Use ResolveStatus.Descriptor instead.
+func (ResolveStatus) EnumDescriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{0}
+}
 
-type fastReflection_PriceResult_messageType struct{}
+// Encoder is an enumerator that defines the mode of encoding message in tss module.
+type Encoder int32
 
-func (x fastReflection_PriceResult_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_PriceResult)(nil)
+const (
+	// ENCODER_UNSPECIFIED is for unspecified value
+	Encoder_ENCODER_UNSPECIFIED Encoder = 0
+	// ENCODER_PROTO is for proto encoding
+	Encoder_ENCODER_PROTO Encoder = 1
+	// ENCODER_FULL_ABI is for ABI encoding for full data
+	Encoder_ENCODER_FULL_ABI Encoder = 2
+	// ENCODER_PARTIAL_ABI is for ABI encoding for only important data
+	Encoder_ENCODER_PARTIAL_ABI Encoder = 3
+)
+
+// Enum value maps for Encoder.
+var (
+	Encoder_name = map[int32]string{
+		0: "ENCODER_UNSPECIFIED",
+		1: "ENCODER_PROTO",
+		2: "ENCODER_FULL_ABI",
+		3: "ENCODER_PARTIAL_ABI",
+	}
+	Encoder_value = map[string]int32{
+		"ENCODER_UNSPECIFIED": 0,
+		"ENCODER_PROTO":       1,
+		"ENCODER_FULL_ABI":    2,
+		"ENCODER_PARTIAL_ABI": 3,
+	}
+)
+
+func (x Encoder) Enum() *Encoder {
+	p := new(Encoder)
+	*p = x
+	return p
 }
-func (x fastReflection_PriceResult_messageType) New() protoreflect.Message {
-	return new(fastReflection_PriceResult)
+
+func (x Encoder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
-func (x fastReflection_PriceResult_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_PriceResult
+
+func (Encoder) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_oracle_v1_oracle_proto_enumTypes[1].Descriptor()
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_PriceResult) Descriptor() protoreflect.MessageDescriptor {
-	return md_PriceResult
+func (Encoder) Type() protoreflect.EnumType {
+	return &file_band_oracle_v1_oracle_proto_enumTypes[1]
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_PriceResult) Type() protoreflect.MessageType {
-	return _fastReflection_PriceResult_messageType
+func (x Encoder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_PriceResult) New() protoreflect.Message {
-	return new(fastReflection_PriceResult)
+// Deprecated: Use Encoder.Descriptor instead.
+func (Encoder) EnumDescriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_PriceResult) Interface() protoreflect.ProtoMessage {
-	return (*PriceResult)(x)
+// DataSource is the data structure for storing data sources in the storage.
+type DataSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Owner is an address of the account who own the data source
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Name is data source name used for display
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Description is data source description used for display
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Filename is string of file name used as reference for locating
+	// data source file stored in bandchain nodes
+	Filename string `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Treasury is the account address who receive data source fee from requester.
+	Treasury string `protobuf:"bytes,5,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	// Fee is the data source fee per ask_count that data provider will receive
+	// from requester.
+	Fee []*v1beta1.Coin `protobuf:"bytes,6,rep,name=fee,proto3" json:"fee,omitempty"`
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_PriceResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Symbol != "" {
-		value := protoreflect.ValueOfString(x.Symbol)
-		if !f(fd_PriceResult_symbol, value) {
-			return
-		}
-	}
-	if x.Multiplier != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Multiplier)
-		if !f(fd_PriceResult_multiplier, value) {
-			return
-		}
-	}
-	if x.Px != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Px)
-		if !f(fd_PriceResult_px, value) {
-			return
-		}
-	}
-	if x.RequestId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_PriceResult_request_id, value) {
-			return
-		}
-	}
-	if x.ResolveTime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ResolveTime)
-		if !f(fd_PriceResult_resolve_time, value) {
-			return
-		}
+func (x *DataSource) Reset() {
+	*x = DataSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_PriceResult) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "band.oracle.v1.PriceResult.symbol":
-		return x.Symbol != ""
-	case "band.oracle.v1.PriceResult.multiplier":
-		return x.Multiplier != uint64(0)
-	case "band.oracle.v1.PriceResult.px":
-		return x.Px != uint64(0)
-	case "band.oracle.v1.PriceResult.request_id":
-		return x.RequestId != uint64(0)
-	case "band.oracle.v1.PriceResult.resolve_time":
-		return x.ResolveTime != int64(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
-		}
-		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", fd.FullName()))
+func (x *DataSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataSource) ProtoMessage() {}
+
+// Deprecated: Use DataSource.ProtoReflect.Descriptor instead.
+func (*DataSource) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DataSource) GetOwner() string {
+	if x != nil {
+		return x.Owner
 	}
+	return ""
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PriceResult) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "band.oracle.v1.PriceResult.symbol":
-		x.Symbol = ""
-	case "band.oracle.v1.PriceResult.multiplier":
-		x.Multiplier = uint64(0)
-	case "band.oracle.v1.PriceResult.px":
-		x.Px = uint64(0)
-	case "band.oracle.v1.PriceResult.request_id":
-		x.RequestId = uint64(0)
-	case "band.oracle.v1.PriceResult.resolve_time":
-		x.ResolveTime = int64(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
-		}
-		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", fd.FullName()))
+func (x *DataSource) GetName() string {
+	if x != nil {
+		return x.Name
 	}
+	return ""
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_PriceResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "band.oracle.v1.PriceResult.symbol":
-		value := x.Symbol
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.PriceResult.multiplier":
-		value := x.Multiplier
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.PriceResult.px":
-		value := x.Px
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.PriceResult.request_id":
-		value := x.RequestId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.PriceResult.resolve_time":
-		value := x.ResolveTime
-		return protoreflect.ValueOfInt64(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
-		}
-		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", descriptor.FullName()))
+func (x *DataSource) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
+	return ""
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PriceResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "band.oracle.v1.PriceResult.symbol":
-		x.Symbol = value.Interface().(string)
-	case "band.oracle.v1.PriceResult.multiplier":
-		x.Multiplier = value.Uint()
-	case "band.oracle.v1.PriceResult.px":
-		x.Px = value.Uint()
-	case "band.oracle.v1.PriceResult.request_id":
-		x.RequestId = value.Uint()
-	case "band.oracle.v1.PriceResult.resolve_time":
-		x.ResolveTime = value.Int()
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
-		}
-		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", fd.FullName()))
+func (x *DataSource) GetFilename() string {
+	if x != nil {
+		return x.Filename
 	}
+	return ""
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PriceResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "band.oracle.v1.PriceResult.symbol":
-		panic(fmt.Errorf("field symbol of message band.oracle.v1.PriceResult is not mutable"))
-	case "band.oracle.v1.PriceResult.multiplier":
-		panic(fmt.Errorf("field multiplier of message band.oracle.v1.PriceResult is not mutable"))
-	case "band.oracle.v1.PriceResult.px":
-		panic(fmt.Errorf("field px of message band.oracle.v1.PriceResult is not mutable"))
-	case "band.oracle.v1.PriceResult.request_id":
-		panic(fmt.Errorf("field request_id of message band.oracle.v1.PriceResult is not mutable"))
-	case "band.oracle.v1.PriceResult.resolve_time":
-		panic(fmt.Errorf("field resolve_time of message band.oracle.v1.PriceResult is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
-		}
-		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", fd.FullName()))
+func (x *DataSource) GetTreasury() string {
+	if x != nil {
+		return x.Treasury
 	}
+	return ""
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_PriceResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "band.oracle.v1.PriceResult.symbol":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.PriceResult.multiplier":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.PriceResult.px":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.PriceResult.request_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.PriceResult.resolve_time":
-		return protoreflect.ValueOfInt64(int64(0))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.PriceResult"))
-		}
-		panic(fmt.Errorf("message band.oracle.v1.PriceResult does not contain field %s", fd.FullName()))
+func (x *DataSource) GetFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.Fee
 	}
+	return nil
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_PriceResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.PriceResult", d.FullName()))
+// OracleScript is the data structure for storing oracle scripts in the storage.
+type OracleScript struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Owner is an address of the account who own the oracle script
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Name is oracle script name used for display
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Description is oracle script description used for display
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Filename is string of file name used as reference for locating
+	// compiled oracle script WASM file stored in bandchain nodes
+	Filename string `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Schema is the schema of the oracle script input/output
+	// which is formatted in OBI format e.g.
+	// "{symbol:string,multiplier:u64}/{px:u64}"
+	Schema string `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
+	// SourceCodeURL is the URL of oracle script's source code.
+	// It is recommendded to store source code on IPFS and get its URL to preserve
+	// decentralization.
+	SourceCodeUrl string `protobuf:"bytes,6,opt,name=source_code_url,json=sourceCodeUrl,proto3" json:"source_code_url,omitempty"`
+}
+
+func (x *OracleScript) Reset() {
+	*x = OracleScript{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	panic("unreachable")
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_PriceResult) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *OracleScript) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PriceResult) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (*OracleScript) ProtoMessage() {}
+
+// Deprecated: Use OracleScript.ProtoReflect.Descriptor instead.
+func (*OracleScript) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_PriceResult) IsValid() bool {
-	return x != nil
+func (x *OracleScript) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_PriceResult) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*PriceResult)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		l = len(x.Symbol)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Multiplier != 0 {
-			n += 1 + runtime.Sov(uint64(x.Multiplier))
-		}
-		if x.Px != 0 {
-			n += 1 + runtime.Sov(uint64(x.Px))
-		}
-		if x.RequestId != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestId))
-		}
-		if x.ResolveTime != 0 {
-			n += 1 + runtime.Sov(uint64(x.ResolveTime))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *OracleScript) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OracleScript) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OracleScript) GetFilename() string {
+	if x != nil {
+		return x.Filename
 	}
+	return ""
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*PriceResult)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.ResolveTime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveTime))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.RequestId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.Px != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Px))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.Multiplier != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Multiplier))
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.Symbol) > 0 {
-			i -= len(x.Symbol)
-			copy(dAtA[i:], x.Symbol)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Symbol)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+func (x *OracleScript) GetSchema() string {
+	if x != nil {
+		return x.Schema
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*PriceResult)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceResult: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceResult: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Symbol = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
-				}
-				x.Multiplier = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Multiplier |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Px", wireType)
-				}
-				x.Px = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Px |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-				}
-				x.RequestId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
-				}
-				x.ResolveTime = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ResolveTime |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
+	return ""
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (x *OracleScript) GetSourceCodeUrl() string {
+	if x != nil {
+		return x.SourceCodeUrl
+	}
+	return ""
+}
+
+// RawRequest is the data structure for storing raw requests in the storage.
+type RawRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ExternalID is an ID of the raw request
+	ExternalId uint64 `protobuf:"varint,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// DataSourceID is an ID of data source script that relates to the raw request
+	DataSourceId uint64 `protobuf:"varint,2,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// Calldata is the data used as argument params for executing data source
+	// script
+	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// DataSourceVersion is the version of the data source script to be executed.
+	// It is zero for requests made before data sources were versioned.
+	DataSourceVersion uint64 `protobuf:"varint,4,opt,name=data_source_version,json=dataSourceVersion,proto3" json:"data_source_version,omitempty"`
+}
+
+func (x *RawRequest) Reset() {
+	*x = RawRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+}
+
+func (x *RawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RawRequest) ProtoMessage() {}
+
+// Deprecated: Use RawRequest.ProtoReflect.Descriptor instead.
+func (*RawRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RawRequest) GetExternalId() uint64 {
+	if x != nil {
+		return x.ExternalId
 	}
+	return 0
 }
 
-var (
-	md_OracleResultSignatureOrder            protoreflect.MessageDescriptor
-	fd_OracleResultSignatureOrder_request_id protoreflect.FieldDescriptor
-	fd_OracleResultSignatureOrder_encoder    protoreflect.FieldDescriptor
-)
+func (x *RawRequest) GetDataSourceId() uint64 {
+	if x != nil {
+		return x.DataSourceId
+	}
+	return 0
+}
 
-func init() {
-	file_band_oracle_v1_oracle_proto_init()
-	md_OracleResultSignatureOrder = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleResultSignatureOrder")
-	fd_OracleResultSignatureOrder_request_id = md_OracleResultSignatureOrder.Fields().ByName("request_id")
-	fd_OracleResultSignatureOrder_encoder = md_OracleResultSignatureOrder.Fields().ByName("encoder")
+func (x *RawRequest) GetCalldata() []byte {
+	if x != nil {
+		return x.Calldata
+	}
+	return nil
 }
 
-var _ protoreflect.Message = (*fastReflection_OracleResultSignatureOrder)(nil)
+func (x *RawRequest) GetDataSourceVersion() uint64 {
+	if x != nil {
+		return x.DataSourceVersion
+	}
+	return 0
+}
 
-type fastReflection_OracleResultSignatureOrder OracleResultSignatureOrder
+// RawReport is the data structure for storing raw reporter in the storage.
+type RawReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *OracleResultSignatureOrder) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_OracleResultSignatureOrder)(x)
+	// ExternalID is an ID of the raw request
+	ExternalId uint64 `protobuf:"varint,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// ExitCode is status code provided by validators to specify error, if any.
+	// Exit code is usually filled by the exit code returned from execution of
+	// specified data source script. With code 0 means there is no error.
+	ExitCode uint32 `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	// Data is raw result provided by validators.
+	// It is usually filled by the result from execution of specified data source
+	// script.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *OracleResultSignatureOrder) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *RawReport) Reset() {
+	*x = RawReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		ms.StoreMessageInfo(mi)
 	}
-	return mi.MessageOf(x)
 }
 
-var _fastReflection_OracleResultSignatureOrder_messageType fastReflection_OracleResultSignatureOrder_messageType
-var _ protoreflect.MessageType = fastReflection_OracleResultSignatureOrder_messageType{}
+func (x *RawReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-type fastReflection_OracleResultSignatureOrder_messageType struct{}
+func (*RawReport) ProtoMessage() {}
 
-func (x fastReflection_OracleResultSignatureOrder_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_OracleResultSignatureOrder)(nil)
+// Deprecated: Use RawReport.ProtoReflect.Descriptor instead.
+func (*RawReport) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{3}
 }
-func (x fastReflection_OracleResultSignatureOrder_messageType) New() protoreflect.Message {
-	return new(fastReflection_OracleResultSignatureOrder)
+
+func (x *RawReport) GetExternalId() uint64 {
+	if x != nil {
+		return x.ExternalId
+	}
+	return 0
 }
-func (x fastReflection_OracleResultSignatureOrder_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleResultSignatureOrder
+
+func (x *RawReport) GetExitCode() uint32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_OracleResultSignatureOrder) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleResultSignatureOrder
+func (x *RawReport) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_OracleResultSignatureOrder) Type() protoreflect.MessageType {
-	return _fastReflection_OracleResultSignatureOrder_messageType
+// Request is the data structure for storing requests in the storage.
+type Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OracleScriptID is ID of an oracle script
+	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// Calldata is the data used as argument params for the oracle script
+	Calldata []byte `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// RequestedValidators is a list of validator addresses that are assigned for
+	// fulfilling the request
+	RequestedValidators []string `protobuf:"bytes,3,rep,name=requested_validators,json=requestedValidators,proto3" json:"requested_validators,omitempty"`
+	// MinCount is minimum number of validators required for fulfilling the
+	// request
+	MinCount uint64 `protobuf:"varint,4,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// RequestHeight is block height that the request has been created
+	RequestHeight int64 `protobuf:"varint,5,opt,name=request_height,json=requestHeight,proto3" json:"request_height,omitempty"`
+	// RequestTime is timestamp of the chain's block which contains the request
+	RequestTime int64 `protobuf:"varint,6,opt,name=request_time,json=requestTime,proto3" json:"request_time,omitempty"`
+	// ClientID is arbitrary id provided by requester.
+	// It is used by client-side for referencing the request
+	ClientId string `protobuf:"bytes,7,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// RawRequests is a list of raw requests specified by execution of oracle
+	// script
+	RawRequests []*RawRequest `protobuf:"bytes,8,rep,name=raw_requests,json=rawRequests,proto3" json:"raw_requests,omitempty"`
+	// IBCChannel is an IBC channel info of the other chain, which contains a
+	// channel and a port to allow bandchain connect to that chain. This field
+	// allows other chain be able to request data from bandchain via IBC.
+	IbcChannel *IBCChannel `protobuf:"bytes,9,opt,name=ibc_channel,json=ibcChannel,proto3" json:"ibc_channel,omitempty"`
+	// ExecuteGas is amount of gas to reserve for executing
+	ExecuteGas uint64 `protobuf:"varint,10,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	// TSSEncoder is the mode of encoding oracle result signature order.
+	TssEncoder Encoder `protobuf:"varint,11,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// Requester is the address of person who requests the data.
+	Requester string `protobuf:"bytes,12,opt,name=requester,proto3" json:"requester,omitempty"`
+	// FeeLimit is the maximum tokens that will be paid for this request.
+	FeeLimit []*v1beta1.Coin `protobuf:"bytes,13,rep,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	// OracleScriptVersion is the version of the oracle script used by this
+	// request. It is zero for requests made before oracle scripts were versioned.
+	OracleScriptVersion uint64 `protobuf:"varint,14,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_OracleResultSignatureOrder) New() protoreflect.Message {
-	return new(fastReflection_OracleResultSignatureOrder)
+func (x *Request) Reset() {
+	*x = Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_OracleResultSignatureOrder) Interface() protoreflect.ProtoMessage {
-	return (*OracleResultSignatureOrder)(x)
+func (x *Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_OracleResultSignatureOrder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.RequestId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_OracleResultSignatureOrder_request_id, value) {
-			return
-		}
+func (*Request) ProtoMessage() {}
+
+// Deprecated: Use Request.ProtoReflect.Descriptor instead.
+func (*Request) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Request) GetOracleScriptId() uint64 {
+	if x != nil {
+		return x.OracleScriptId
 	}
-	if x.Encoder != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Encoder))
-		if !f(fd_OracleResultSignatureOrder_encoder, value) {
-			return
-		}
+	return 0
+}
+
+func (x *Request) GetCalldata() []byte {
+	if x != nil {
+		return x.Calldata
 	}
+	return nil
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_OracleResultSignatureOrder) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
-		return x.RequestId != uint64(0)
-	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
-		return x.Encoder != 0
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
-		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", fd.FullName()))
+func (x *Request) GetRequestedValidators() []string {
+	if x != nil {
+		return x.RequestedValidators
+	}
+	return nil
+}
+
+func (x *Request) GetMinCount() uint64 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
+}
+
+func (x *Request) GetRequestHeight() int64 {
+	if x != nil {
+		return x.RequestHeight
+	}
+	return 0
+}
+
+func (x *Request) GetRequestTime() int64 {
+	if x != nil {
+		return x.RequestTime
 	}
+	return 0
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleResultSignatureOrder) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
-		x.RequestId = uint64(0)
-	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
-		x.Encoder = 0
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
-		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", fd.FullName()))
+func (x *Request) GetClientId() string {
+	if x != nil {
+		return x.ClientId
 	}
+	return ""
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_OracleResultSignatureOrder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
-		value := x.RequestId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
-		value := x.Encoder
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
-		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", descriptor.FullName()))
+func (x *Request) GetRawRequests() []*RawRequest {
+	if x != nil {
+		return x.RawRequests
 	}
+	return nil
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleResultSignatureOrder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
-		x.RequestId = value.Uint()
-	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
-		x.Encoder = (Encoder)(value.Enum())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
-		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", fd.FullName()))
+func (x *Request) GetIbcChannel() *IBCChannel {
+	if x != nil {
+		return x.IbcChannel
 	}
+	return nil
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleResultSignatureOrder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
-		panic(fmt.Errorf("field request_id of message band.oracle.v1.OracleResultSignatureOrder is not mutable"))
-	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
-		panic(fmt.Errorf("field encoder of message band.oracle.v1.OracleResultSignatureOrder is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
-		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", fd.FullName()))
+func (x *Request) GetExecuteGas() uint64 {
+	if x != nil {
+		return x.ExecuteGas
 	}
+	return 0
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_OracleResultSignatureOrder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "band.oracle.v1.OracleResultSignatureOrder.request_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleResultSignatureOrder.encoder":
-		return protoreflect.ValueOfEnum(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResultSignatureOrder"))
-		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResultSignatureOrder does not contain field %s", fd.FullName()))
+func (x *Request) GetTssEncoder() Encoder {
+	if x != nil {
+		return x.TssEncoder
 	}
+	return Encoder_ENCODER_UNSPECIFIED
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_OracleResultSignatureOrder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleResultSignatureOrder", d.FullName()))
+func (x *Request) GetRequester() string {
+	if x != nil {
+		return x.Requester
 	}
-	panic("unreachable")
+	return ""
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_OracleResultSignatureOrder) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *Request) GetFeeLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.FeeLimit
+	}
+	return nil
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleResultSignatureOrder) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *Request) GetOracleScriptVersion() uint64 {
+	if x != nil {
+		return x.OracleScriptVersion
+	}
+	return 0
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_OracleResultSignatureOrder) IsValid() bool {
-	return x != nil
+// Report is the data structure for storing reports in the storage.
+type Report struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Validator is a validator address who submit the report
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// InBeforeResolve indicates whether the report is submitted before the
+	// request resolved
+	InBeforeResolve bool `protobuf:"varint,2,opt,name=in_before_resolve,json=inBeforeResolve,proto3" json:"in_before_resolve,omitempty"`
+	// RawReports is list of raw reports provided by the validator.
+	// Each raw report has different external ID
+	RawReports []*RawReport `protobuf:"bytes,3,rep,name=raw_reports,json=rawReports,proto3" json:"raw_reports,omitempty"`
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_OracleResultSignatureOrder) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*OracleResultSignatureOrder)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.RequestId != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestId))
-		}
-		if x.Encoder != 0 {
-			n += 1 + runtime.Sov(uint64(x.Encoder))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *Report) Reset() {
+	*x = Report{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*OracleResultSignatureOrder)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.Encoder != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Encoder))
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.RequestId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
-			i--
-			dAtA[i] = 0x8
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Report) GetValidator() string {
+	if x != nil {
+		return x.Validator
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*OracleResultSignatureOrder)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleResultSignatureOrder: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleResultSignatureOrder: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-				}
-				x.RequestId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
-				}
-				x.Encoder = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Encoder |= Encoder(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
+	return ""
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (x *Report) GetInBeforeResolve() bool {
+	if x != nil {
+		return x.InBeforeResolve
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return false
+}
+
+func (x *Report) GetRawReports() []*RawReport {
+	if x != nil {
+		return x.RawReports
 	}
+	return nil
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: band/oracle/v1/oracle.proto
+// OracleRequestPacketData encodes an oracle request sent from other blockchains
+// to BandChain.
+type OracleRequestPacketData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+	// ClientID is the unique identifier of this oracle request, as specified by
+	// the client. This same unique ID will be sent back to the requester with the
+	// oracle response.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// OracleScriptID is the unique identifier of the oracle script to be
+	// executed.
+	OracleScriptId uint64 `protobuf:"varint,2,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// Calldata is the OBI-encoded calldata bytes available for oracle executor to
+	// read.
+	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is the number of validators that are requested to respond to this
+	// oracle request. Higher value means more security, at a higher gas cost.
+	AskCount uint64 `protobuf:"varint,4,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is the minimum number of validators necessary for the request to
+	// proceed to the execution phase. Higher value means more security, at the
+	// cost of liveness.
+	MinCount uint64 `protobuf:"varint,5,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// FeeLimit is the maximum tokens that will be paid to all data source
+	// providers.
+	FeeLimit []*v1beta1.Coin `protobuf:"bytes,6,rep,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	// PrepareGas is amount of gas to pay to prepare raw requests
+	PrepareGas uint64 `protobuf:"varint,7,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
+	// ExecuteGas is amount of gas to reserve for executing
+	ExecuteGas uint64 `protobuf:"varint,8,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	// TSSEncoder is the mode of encoding oracle result signature order.
+	TssEncoder Encoder `protobuf:"varint,9,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+}
 
-// ResolveStatus encodes the status of an oracle request.
-type ResolveStatus int32
+func (x *OracleRequestPacketData) Reset() {
+	*x = OracleRequestPacketData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-const (
-	// Open - the request is not yet resolved.
-	ResolveStatus_RESOLVE_STATUS_OPEN_UNSPECIFIED ResolveStatus = 0
-	// Success - the request has been resolved successfully with no errors.
-	ResolveStatus_RESOLVE_STATUS_SUCCESS ResolveStatus = 1
-	// Failure - an error occurred during the request's resolve call.
-	ResolveStatus_RESOLVE_STATUS_FAILURE ResolveStatus = 2
-	// Expired - the request does not get enough reports from validator within the
-	// timeframe.
-	ResolveStatus_RESOLVE_STATUS_EXPIRED ResolveStatus = 3
-)
+func (x *OracleRequestPacketData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-// Enum value maps for ResolveStatus.
-var (
-	ResolveStatus_name = map[int32]string{
-		0: "RESOLVE_STATUS_OPEN_UNSPECIFIED",
-		1: "RESOLVE_STATUS_SUCCESS",
-		2: "RESOLVE_STATUS_FAILURE",
-		3: "RESOLVE_STATUS_EXPIRED",
+func (*OracleRequestPacketData) ProtoMessage() {}
+
+// Deprecated: Use OracleRequestPacketData.ProtoReflect.Descriptor instead.
+func (*OracleRequestPacketData) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OracleRequestPacketData) GetClientId() string {
+	if x != nil {
+		return x.ClientId
 	}
-	ResolveStatus_value = map[string]int32{
-		"RESOLVE_STATUS_OPEN_UNSPECIFIED": 0,
-		"RESOLVE_STATUS_SUCCESS":          1,
-		"RESOLVE_STATUS_FAILURE":          2,
-		"RESOLVE_STATUS_EXPIRED":          3,
+	return ""
+}
+
+func (x *OracleRequestPacketData) GetOracleScriptId() uint64 {
+	if x != nil {
+		return x.OracleScriptId
 	}
-)
+	return 0
+}
 
-func (x ResolveStatus) Enum() *ResolveStatus {
-	p := new(ResolveStatus)
-	*p = x
-	return p
+func (x *OracleRequestPacketData) GetCalldata() []byte {
+	if x != nil {
+		return x.Calldata
+	}
+	return nil
 }
 
-func (x ResolveStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *OracleRequestPacketData) GetAskCount() uint64 {
+	if x != nil {
+		return x.AskCount
+	}
+	return 0
 }
 
-func (ResolveStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_band_oracle_v1_oracle_proto_enumTypes[0].Descriptor()
+func (x *OracleRequestPacketData) GetMinCount() uint64 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
 }
 
-func (ResolveStatus) Type() protoreflect.EnumType {
-	return &file_band_oracle_v1_oracle_proto_enumTypes[0]
+func (x *OracleRequestPacketData) GetFeeLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.FeeLimit
+	}
+	return nil
 }
 
-func (x ResolveStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *OracleRequestPacketData) GetPrepareGas() uint64 {
+	if x != nil {
+		return x.PrepareGas
+	}
+	return 0
 }
 
-// Deprecated: Use ResolveStatus.Descriptor instead.
-func (ResolveStatus) EnumDescriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{0}
+func (x *OracleRequestPacketData) GetExecuteGas() uint64 {
+	if x != nil {
+		return x.ExecuteGas
+	}
+	return 0
 }
 
-// Encoder is an enumerator that defines the mode of encoding message in tss module.
-type Encoder int32
-
-const (
-	// ENCODER_UNSPECIFIED is for unspecified value
-	Encoder_ENCODER_UNSPECIFIED Encoder = 0
-	// ENCODER_PROTO is for proto encoding
-	Encoder_ENCODER_PROTO Encoder = 1
-	// ENCODER_FULL_ABI is for ABI encoding for full data
-	Encoder_ENCODER_FULL_ABI Encoder = 2
-	// ENCODER_PARTIAL_ABI is for ABI encoding for only important data
-	Encoder_ENCODER_PARTIAL_ABI Encoder = 3
-)
-
-// Enum value maps for Encoder.
-var (
-	Encoder_name = map[int32]string{
-		0: "ENCODER_UNSPECIFIED",
-		1: "ENCODER_PROTO",
-		2: "ENCODER_FULL_ABI",
-		3: "ENCODER_PARTIAL_ABI",
-	}
-	Encoder_value = map[string]int32{
-		"ENCODER_UNSPECIFIED": 0,
-		"ENCODER_PROTO":       1,
-		"ENCODER_FULL_ABI":    2,
-		"ENCODER_PARTIAL_ABI": 3,
+func (x *OracleRequestPacketData) GetTssEncoder() Encoder {
+	if x != nil {
+		return x.TssEncoder
 	}
-)
-
-func (x Encoder) Enum() *Encoder {
-	p := new(Encoder)
-	*p = x
-	return p
+	return Encoder_ENCODER_UNSPECIFIED
 }
 
-func (x Encoder) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+// OracleRequestPacketAcknowledgement encodes an oracle request acknowledgement
+// send back to requester chain.
+type OracleRequestPacketAcknowledgement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RequestID is BandChain's unique identifier for this oracle request.
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
-func (Encoder) Descriptor() protoreflect.EnumDescriptor {
-	return file_band_oracle_v1_oracle_proto_enumTypes[1].Descriptor()
+func (x *OracleRequestPacketAcknowledgement) Reset() {
+	*x = OracleRequestPacketAcknowledgement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (Encoder) Type() protoreflect.EnumType {
-	return &file_band_oracle_v1_oracle_proto_enumTypes[1]
+func (x *OracleRequestPacketAcknowledgement) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x Encoder) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (*OracleRequestPacketAcknowledgement) ProtoMessage() {}
+
+// Deprecated: Use OracleRequestPacketAcknowledgement.ProtoReflect.Descriptor instead.
+func (*OracleRequestPacketAcknowledgement) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{7}
 }
 
-// Deprecated: Use Encoder.Descriptor instead.
-func (Encoder) EnumDescriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
+func (x *OracleRequestPacketAcknowledgement) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
 }
 
-// DataSource is the data structure for storing data sources in the storage.
-type DataSource struct {
+// OracleResponsePacketData encodes an oracle response from BandChain to the
+// requester.
+type OracleResponsePacketData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Owner is an address of the account who own the data source
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	// Name is data source name used for display
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Description is data source description used for display
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// Filename is string of file name used as reference for locating
-	// data source file stored in bandchain nodes
-	Filename string `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
-	// Treasury is the account address who receive data source fee from requester.
-	Treasury string `protobuf:"bytes,5,opt,name=treasury,proto3" json:"treasury,omitempty"`
-	// Fee is the data source fee per ask_count that data provider will receive
-	// from requester.
-	Fee []*v1beta1.Coin `protobuf:"bytes,6,rep,name=fee,proto3" json:"fee,omitempty"`
+	// ClientID is the unique identifier matched with that of the oracle request
+	// packet.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// RequestID is BandChain's unique identifier for this oracle request.
+	RequestId uint64 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// AnsCount is the number of validators among to the asked validators that
+	// actually responded to this oracle request prior to this oracle request
+	// being resolved.
+	AnsCount uint64 `protobuf:"varint,3,opt,name=ans_count,json=ansCount,proto3" json:"ans_count,omitempty"`
+	// RequestTime is the UNIX epoch time at which the request was sent to
+	// BandChain.
+	RequestTime int64 `protobuf:"varint,4,opt,name=request_time,json=requestTime,proto3" json:"request_time,omitempty"`
+	// ResolveTime is the UNIX epoch time at which the request was resolved to the
+	// final result.
+	ResolveTime int64 `protobuf:"varint,5,opt,name=resolve_time,json=resolveTime,proto3" json:"resolve_time,omitempty"`
+	// ResolveStatus is the status of this oracle request, which can be OK,
+	// FAILURE, or EXPIRED.
+	ResolveStatus ResolveStatus `protobuf:"varint,6,opt,name=resolve_status,json=resolveStatus,proto3,enum=band.oracle.v1.ResolveStatus" json:"resolve_status,omitempty"`
+	// Result is the final aggregated value encoded in OBI format. Only available
+	// if status if OK.
+	Result []byte `protobuf:"bytes,7,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *DataSource) Reset() {
-	*x = DataSource{}
+func (x *OracleResponsePacketData) Reset() {
+	*x = OracleResponsePacketData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[0]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DataSource) String() string {
+func (x *OracleResponsePacketData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataSource) ProtoMessage() {}
+func (*OracleResponsePacketData) ProtoMessage() {}
 
-// Deprecated: Use DataSource.ProtoReflect.Descriptor instead.
-func (*DataSource) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use OracleResponsePacketData.ProtoReflect.Descriptor instead.
+func (*OracleResponsePacketData) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DataSource) GetOwner() string {
+func (x *OracleResponsePacketData) GetClientId() string {
 	if x != nil {
-		return x.Owner
+		return x.ClientId
 	}
 	return ""
 }
 
-func (x *DataSource) GetName() string {
+func (x *OracleResponsePacketData) GetRequestId() uint64 {
 	if x != nil {
-		return x.Name
+		return x.RequestId
 	}
-	return ""
+	return 0
 }
 
-func (x *DataSource) GetDescription() string {
+func (x *OracleResponsePacketData) GetAnsCount() uint64 {
 	if x != nil {
-		return x.Description
+		return x.AnsCount
 	}
-	return ""
+	return 0
 }
 
-func (x *DataSource) GetFilename() string {
+func (x *OracleResponsePacketData) GetRequestTime() int64 {
 	if x != nil {
-		return x.Filename
+		return x.RequestTime
 	}
-	return ""
+	return 0
 }
 
-func (x *DataSource) GetTreasury() string {
+func (x *OracleResponsePacketData) GetResolveTime() int64 {
 	if x != nil {
-		return x.Treasury
+		return x.ResolveTime
 	}
-	return ""
+	return 0
 }
 
-func (x *DataSource) GetFee() []*v1beta1.Coin {
+func (x *OracleResponsePacketData) GetResolveStatus() ResolveStatus {
 	if x != nil {
-		return x.Fee
+		return x.ResolveStatus
+	}
+	return ResolveStatus_RESOLVE_STATUS_OPEN_UNSPECIFIED
+}
+
+func (x *OracleResponsePacketData) GetResult() []byte {
+	if x != nil {
+		return x.Result
 	}
 	return nil
 }
 
-// OracleScript is the data structure for storing oracle scripts in the storage.
-type OracleScript struct {
+// Result encodes a result of request and store in chain
+type Result struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Owner is an address of the account who own the oracle script
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	// Name is oracle script name used for display
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Description is oracle script description used for display
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// Filename is string of file name used as reference for locating
-	// compiled oracle script WASM file stored in bandchain nodes
-	Filename string `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
-	// Schema is the schema of the oracle script input/output
-	// which is formatted in OBI format e.g.
-	// "{symbol:string,multiplier:u64}/{px:u64}"
-	Schema string `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
-	// SourceCodeURL is the URL of oracle script's source code.
-	// It is recommendded to store source code on IPFS and get its URL to preserve
-	// decentralization.
-	SourceCodeUrl string `protobuf:"bytes,6,opt,name=source_code_url,json=sourceCodeUrl,proto3" json:"source_code_url,omitempty"`
+	// ClientID is the unique identifier of this oracle request, as specified by
+	// the client. This same unique ID will be sent back to the requester with the
+	// oracle response.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// OracleScriptID is the unique identifier of the oracle script to be
+	// executed.
+	OracleScriptId uint64 `protobuf:"varint,2,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// Calldata is the calldata bytes available for oracle executor to read.
+	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is the number of validators that are requested to respond to this
+	// oracle request. Higher value means more security, at a higher gas cost.
+	AskCount uint64 `protobuf:"varint,4,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is the minimum number of validators necessary for the request to
+	// proceed to the execution phase. Higher value means more security, at the
+	// cost of liveness.
+	MinCount uint64 `protobuf:"varint,5,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// RequestID is BandChain's unique identifier for this oracle request.
+	RequestId uint64 `protobuf:"varint,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// AnsCount is the number of validators among to the asked validators that
+	// actually responded to this oracle request prior to this oracle request
+	// being resolved.
+	AnsCount uint64 `protobuf:"varint,7,opt,name=ans_count,json=ansCount,proto3" json:"ans_count,omitempty"`
+	// RequestTime is the UNIX epoch time at which the request was sent to
+	// BandChain.
+	RequestTime int64 `protobuf:"varint,8,opt,name=request_time,json=requestTime,proto3" json:"request_time,omitempty"`
+	// ResolveTime is the UNIX epoch time at which the request was resolved to the
+	// final result.
+	ResolveTime int64 `protobuf:"varint,9,opt,name=resolve_time,json=resolveTime,proto3" json:"resolve_time,omitempty"`
+	// ResolveStatus is the status of this oracle request, which can be OK,
+	// FAILURE, or EXPIRED.
+	ResolveStatus ResolveStatus `protobuf:"varint,10,opt,name=resolve_status,json=resolveStatus,proto3,enum=band.oracle.v1.ResolveStatus" json:"resolve_status,omitempty"`
+	// Result is the final aggregated value only available if status if OK.
+	Result []byte `protobuf:"bytes,11,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *OracleScript) Reset() {
-	*x = OracleScript{}
+func (x *Result) Reset() {
+	*x = Result{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[1]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OracleScript) String() string {
+func (x *Result) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OracleScript) ProtoMessage() {}
+func (*Result) ProtoMessage() {}
 
-// Deprecated: Use OracleScript.ProtoReflect.Descriptor instead.
-func (*OracleScript) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Result.ProtoReflect.Descriptor instead.
+func (*Result) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *OracleScript) GetOwner() string {
+func (x *Result) GetClientId() string {
 	if x != nil {
-		return x.Owner
+		return x.ClientId
 	}
 	return ""
 }
 
-func (x *OracleScript) GetName() string {
+func (x *Result) GetOracleScriptId() uint64 {
 	if x != nil {
-		return x.Name
+		return x.OracleScriptId
 	}
-	return ""
+	return 0
 }
 
-func (x *OracleScript) GetDescription() string {
+func (x *Result) GetCalldata() []byte {
 	if x != nil {
-		return x.Description
+		return x.Calldata
 	}
-	return ""
+	return nil
 }
 
-func (x *OracleScript) GetFilename() string {
+func (x *Result) GetAskCount() uint64 {
 	if x != nil {
-		return x.Filename
+		return x.AskCount
 	}
-	return ""
+	return 0
 }
 
-func (x *OracleScript) GetSchema() string {
+func (x *Result) GetMinCount() uint64 {
 	if x != nil {
-		return x.Schema
+		return x.MinCount
 	}
-	return ""
+	return 0
 }
 
-func (x *OracleScript) GetSourceCodeUrl() string {
+func (x *Result) GetRequestId() uint64 {
 	if x != nil {
-		return x.SourceCodeUrl
+		return x.RequestId
 	}
-	return ""
-}
-
-// RawRequest is the data structure for storing raw requests in the storage.
-type RawRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// ExternalID is an ID of the raw request
-	ExternalId uint64 `protobuf:"varint,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
-	// DataSourceID is an ID of data source script that relates to the raw request
-	DataSourceId uint64 `protobuf:"varint,2,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
-	// Calldata is the data used as argument params for executing data source
-	// script
-	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	return 0
 }
 
-func (x *RawRequest) Reset() {
-	*x = RawRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Result) GetAnsCount() uint64 {
+	if x != nil {
+		return x.AnsCount
 	}
+	return 0
 }
 
-func (x *RawRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RawRequest) ProtoMessage() {}
-
-// Deprecated: Use RawRequest.ProtoReflect.Descriptor instead.
-func (*RawRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
+func (x *Result) GetRequestTime() int64 {
+	if x != nil {
+		return x.RequestTime
+	}
+	return 0
 }
 
-func (x *RawRequest) GetExternalId() uint64 {
+func (x *Result) GetResolveTime() int64 {
 	if x != nil {
-		return x.ExternalId
+		return x.ResolveTime
 	}
 	return 0
 }
 
-func (x *RawRequest) GetDataSourceId() uint64 {
+func (x *Result) GetResolveStatus() ResolveStatus {
 	if x != nil {
-		return x.DataSourceId
+		return x.ResolveStatus
 	}
-	return 0
+	return ResolveStatus_RESOLVE_STATUS_OPEN_UNSPECIFIED
 }
 
-func (x *RawRequest) GetCalldata() []byte {
+func (x *Result) GetResult() []byte {
 	if x != nil {
-		return x.Calldata
+		return x.Result
 	}
 	return nil
 }
 
-// RawReport is the data structure for storing raw reporter in the storage.
-type RawReport struct {
+// SigningResult encodes a result of signing of request
+type SigningResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ExternalID is an ID of the raw request
-	ExternalId uint64 `protobuf:"varint,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
-	// ExitCode is status code provided by validators to specify error, if any.
-	// Exit code is usually filled by the exit code returned from execution of
-	// specified data source script. With code 0 means there is no error.
-	ExitCode uint32 `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
-	// Data is raw result provided by validators.
-	// It is usually filled by the result from execution of specified data source
-	// script.
-	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// signing_id is the id of the bandtss signing
+	SigningId uint64 `protobuf:"varint,1,opt,name=signing_id,json=signingId,proto3" json:"signing_id,omitempty"`
+	// error_codespace is the codespace of the error
+	ErrorCodespace string `protobuf:"bytes,2,opt,name=error_codespace,json=errorCodespace,proto3" json:"error_codespace,omitempty"`
+	// error_code is the code in the codespace of the error
+	ErrorCode uint64 `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
 }
 
-func (x *RawReport) Reset() {
-	*x = RawReport{}
+func (x *SigningResult) Reset() {
+	*x = SigningResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[3]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RawReport) String() string {
+func (x *SigningResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RawReport) ProtoMessage() {}
+func (*SigningResult) ProtoMessage() {}
 
-// Deprecated: Use RawReport.ProtoReflect.Descriptor instead.
-func (*RawReport) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SigningResult.ProtoReflect.Descriptor instead.
+func (*SigningResult) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *RawReport) GetExternalId() uint64 {
+func (x *SigningResult) GetSigningId() uint64 {
 	if x != nil {
-		return x.ExternalId
+		return x.SigningId
 	}
 	return 0
 }
 
-func (x *RawReport) GetExitCode() uint32 {
+func (x *SigningResult) GetErrorCodespace() string {
 	if x != nil {
-		return x.ExitCode
+		return x.ErrorCodespace
 	}
-	return 0
+	return ""
 }
 
-func (x *RawReport) GetData() []byte {
+func (x *SigningResult) GetErrorCode() uint64 {
 	if x != nil {
-		return x.Data
+		return x.ErrorCode
 	}
-	return nil
+	return 0
 }
 
-// Request is the data structure for storing requests in the storage.
-type Request struct {
+// ValidatorStatus maintains whether a validator is an active oracle provider.
+type ValidatorStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// OracleScriptID is ID of an oracle script
-	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
-	// Calldata is the data used as argument params for the oracle script
-	Calldata []byte `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
-	// RequestedValidators is a list of validator addresses that are assigned for
-	// fulfilling the request
-	RequestedValidators []string `protobuf:"bytes,3,rep,name=requested_validators,json=requestedValidators,proto3" json:"requested_validators,omitempty"`
-	// MinCount is minimum number of validators required for fulfilling the
-	// request
-	MinCount uint64 `protobuf:"varint,4,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
-	// RequestHeight is block height that the request has been created
-	RequestHeight int64 `protobuf:"varint,5,opt,name=request_height,json=requestHeight,proto3" json:"request_height,omitempty"`
-	// RequestTime is timestamp of the chain's block which contains the request
-	RequestTime int64 `protobuf:"varint,6,opt,name=request_time,json=requestTime,proto3" json:"request_time,omitempty"`
-	// ClientID is arbitrary id provided by requester.
-	// It is used by client-side for referencing the request
-	ClientId string `protobuf:"bytes,7,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	// RawRequests is a list of raw requests specified by execution of oracle
-	// script
-	RawRequests []*RawRequest `protobuf:"bytes,8,rep,name=raw_requests,json=rawRequests,proto3" json:"raw_requests,omitempty"`
-	// IBCChannel is an IBC channel info of the other chain, which contains a
-	// channel and a port to allow bandchain connect to that chain. This field
-	// allows other chain be able to request data from bandchain via IBC.
-	IbcChannel *IBCChannel `protobuf:"bytes,9,opt,name=ibc_channel,json=ibcChannel,proto3" json:"ibc_channel,omitempty"`
-	// ExecuteGas is amount of gas to reserve for executing
-	ExecuteGas uint64 `protobuf:"varint,10,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
-	// TSSEncoder is the mode of encoding oracle result signature order.
-	TssEncoder Encoder `protobuf:"varint,11,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
-	// Requester is the address of person who requests the data.
-	Requester string `protobuf:"bytes,12,opt,name=requester,proto3" json:"requester,omitempty"`
-	// FeeLimit is the maximum tokens that will be paid for this request.
-	FeeLimit []*v1beta1.Coin `protobuf:"bytes,13,rep,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	// IsActive is a boolean indicating active status of validator.
+	// The validator will be deactivated when they are unable to send reports
+	// to fulfill oracle request before the request expired.
+	IsActive bool `protobuf:"varint,1,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Since is a block timestamp when validator has been activated/deactivated
+	Since *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
 }
 
-func (x *Request) Reset() {
-	*x = Request{}
+func (x *ValidatorStatus) Reset() {
+	*x = ValidatorStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[4]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Request) String() string {
+func (x *ValidatorStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Request) ProtoMessage() {}
+func (*ValidatorStatus) ProtoMessage() {}
 
-// Deprecated: Use Request.ProtoReflect.Descriptor instead.
-func (*Request) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ValidatorStatus.ProtoReflect.Descriptor instead.
+func (*ValidatorStatus) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Request) GetOracleScriptId() uint64 {
+func (x *ValidatorStatus) GetIsActive() bool {
 	if x != nil {
-		return x.OracleScriptId
+		return x.IsActive
 	}
-	return 0
+	return false
 }
 
-func (x *Request) GetCalldata() []byte {
+func (x *ValidatorStatus) GetSince() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Calldata
+		return x.Since
 	}
 	return nil
 }
 
-func (x *Request) GetRequestedValidators() []string {
-	if x != nil {
-		return x.RequestedValidators
-	}
-	return nil
-}
+// ValidatorReportInfo tracks the reports of a validator over a sliding window
+// of its latest report assignments.
+type ValidatorReportInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Request) GetMinCount() uint64 {
-	if x != nil {
-		return x.MinCount
+	// Validator is the address of the validator
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// WindowSize is the report window size at which the window is recorded
+	WindowSize uint64 `protobuf:"varint,2,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
+	// IndexOffset is the number of report assignments recorded in the window
+	// since it was last reset
+	IndexOffset uint64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// MissedReportsCounter is the number of missed reports in the window
+	MissedReportsCounter uint64 `protobuf:"varint,4,opt,name=missed_reports_counter,json=missedReportsCounter,proto3" json:"missed_reports_counter,omitempty"`
+	// TotalReports is the total number of reports the validator has sent
+	TotalReports uint64 `protobuf:"varint,5,opt,name=total_reports,json=totalReports,proto3" json:"total_reports,omitempty"`
+	// TotalMissedReports is the total number of reports the validator has missed
+	TotalMissedReports uint64 `protobuf:"varint,6,opt,name=total_missed_reports,json=totalMissedReports,proto3" json:"total_missed_reports,omitempty"`
+}
+
+func (x *ValidatorReportInfo) Reset() {
+	*x = ValidatorReportInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Request) GetRequestHeight() int64 {
-	if x != nil {
-		return x.RequestHeight
-	}
-	return 0
+func (x *ValidatorReportInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Request) GetRequestTime() int64 {
-	if x != nil {
-		return x.RequestTime
-	}
-	return 0
-}
+func (*ValidatorReportInfo) ProtoMessage() {}
 
-func (x *Request) GetClientId() string {
-	if x != nil {
-		return x.ClientId
-	}
-	return ""
+// Deprecated: Use ValidatorReportInfo.ProtoReflect.Descriptor instead.
+func (*ValidatorReportInfo) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Request) GetRawRequests() []*RawRequest {
+func (x *ValidatorReportInfo) GetValidator() string {
 	if x != nil {
-		return x.RawRequests
+		return x.Validator
 	}
-	return nil
+	return ""
 }
 
-func (x *Request) GetIbcChannel() *IBCChannel {
+func (x *ValidatorReportInfo) GetWindowSize() uint64 {
 	if x != nil {
-		return x.IbcChannel
+		return x.WindowSize
 	}
-	return nil
+	return 0
 }
 
-func (x *Request) GetExecuteGas() uint64 {
+func (x *ValidatorReportInfo) GetIndexOffset() uint64 {
 	if x != nil {
-		return x.ExecuteGas
+		return x.IndexOffset
 	}
 	return 0
 }
 
-func (x *Request) GetTssEncoder() Encoder {
+func (x *ValidatorReportInfo) GetMissedReportsCounter() uint64 {
 	if x != nil {
-		return x.TssEncoder
+		return x.MissedReportsCounter
 	}
-	return Encoder_ENCODER_UNSPECIFIED
+	return 0
 }
 
-func (x *Request) GetRequester() string {
+func (x *ValidatorReportInfo) GetTotalReports() uint64 {
 	if x != nil {
-		return x.Requester
+		return x.TotalReports
 	}
-	return ""
+	return 0
 }
 
-func (x *Request) GetFeeLimit() []*v1beta1.Coin {
+func (x *ValidatorReportInfo) GetTotalMissedReports() uint64 {
 	if x != nil {
-		return x.FeeLimit
+		return x.TotalMissedReports
 	}
-	return nil
+	return 0
 }
 
-// Report is the data structure for storing reports in the storage.
-type Report struct {
+// ActiveValidator is information of currently active validator
+type ActiveValidator struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Validator is a validator address who submit the report
-	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
-	// InBeforeResolve indicates whether the report is submitted before the
-	// request resolved
-	InBeforeResolve bool `protobuf:"varint,2,opt,name=in_before_resolve,json=inBeforeResolve,proto3" json:"in_before_resolve,omitempty"`
-	// RawReports is list of raw reports provided by the validator.
-	// Each raw report has different external ID
-	RawReports []*RawReport `protobuf:"bytes,3,rep,name=raw_reports,json=rawReports,proto3" json:"raw_reports,omitempty"`
+	// Address is a validator address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Power is an amount of token that the validator is holding
+	Power uint64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
 }
 
-func (x *Report) Reset() {
-	*x = Report{}
+func (x *ActiveValidator) Reset() {
+	*x = ActiveValidator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Report) String() string {
+func (x *ActiveValidator) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Report) ProtoMessage() {}
+func (*ActiveValidator) ProtoMessage() {}
 
-// Deprecated: Use Report.ProtoReflect.Descriptor instead.
-func (*Report) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ActiveValidator.ProtoReflect.Descriptor instead.
+func (*ActiveValidator) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Report) GetValidator() string {
+func (x *ActiveValidator) GetAddress() string {
 	if x != nil {
-		return x.Validator
+		return x.Address
 	}
 	return ""
 }
 
-func (x *Report) GetInBeforeResolve() bool {
-	if x != nil {
-		return x.InBeforeResolve
-	}
-	return false
-}
-
-func (x *Report) GetRawReports() []*RawReport {
+func (x *ActiveValidator) GetPower() uint64 {
 	if x != nil {
-		return x.RawReports
+		return x.Power
 	}
-	return nil
+	return 0
 }
 
-// OracleRequestPacketData encodes an oracle request sent from other blockchains
-// to BandChain.
-type OracleRequestPacketData struct {
+// Params is the data structure that keeps the parameters of the oracle module.
+type Params struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ClientID is the unique identifier of this oracle request, as specified by
-	// the client. This same unique ID will be sent back to the requester with the
-	// oracle response.
-	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	// OracleScriptID is the unique identifier of the oracle script to be
-	// executed.
-	OracleScriptId uint64 `protobuf:"varint,2,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
-	// Calldata is the OBI-encoded calldata bytes available for oracle executor to
-	// read.
-	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
-	// AskCount is the number of validators that are requested to respond to this
-	// oracle request. Higher value means more security, at a higher gas cost.
-	AskCount uint64 `protobuf:"varint,4,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
-	// MinCount is the minimum number of validators necessary for the request to
-	// proceed to the execution phase. Higher value means more security, at the
-	// cost of liveness.
-	MinCount uint64 `protobuf:"varint,5,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
-	// FeeLimit is the maximum tokens that will be paid to all data source
-	// providers.
-	FeeLimit []*v1beta1.Coin `protobuf:"bytes,6,rep,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
-	// PrepareGas is amount of gas to pay to prepare raw requests
-	PrepareGas uint64 `protobuf:"varint,7,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
-	// ExecuteGas is amount of gas to reserve for executing
-	ExecuteGas uint64 `protobuf:"varint,8,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
-	// TSSEncoder is the mode of encoding oracle result signature order.
-	TssEncoder Encoder `protobuf:"varint,9,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// MaxRawRequestCount is the maximum number of data source raw requests a
+	// request can make.
+	MaxRawRequestCount uint64 `protobuf:"varint,1,opt,name=max_raw_request_count,json=maxRawRequestCount,proto3" json:"max_raw_request_count,omitempty"`
+	// MaxAskCount is the maximum number of validators a request can target.
+	MaxAskCount uint64 `protobuf:"varint,2,opt,name=max_ask_count,json=maxAskCount,proto3" json:"max_ask_count,omitempty"`
+	// MaxCalldataSize is the maximum size limit of calldata (bytes) in a request.
+	MaxCalldataSize uint64 `protobuf:"varint,3,opt,name=max_calldata_size,json=maxCalldataSize,proto3" json:"max_calldata_size,omitempty"`
+	// MaxReportDataSize is the maximum size limit of report data (bytes) in a
+	// report.
+	MaxReportDataSize uint64 `protobuf:"varint,4,opt,name=max_report_data_size,json=maxReportDataSize,proto3" json:"max_report_data_size,omitempty"`
+	// ExpirationBlockCount is the number of blocks a request stays valid before
+	// it gets expired due to insufficient reports.
+	ExpirationBlockCount uint64 `protobuf:"varint,5,opt,name=expiration_block_count,json=expirationBlockCount,proto3" json:"expiration_block_count,omitempty"`
+	// BaseOwasmGas is the base amount of Cosmos-SDK gas charged for owasm
+	// execution.
+	BaseOwasmGas uint64 `protobuf:"varint,6,opt,name=base_owasm_gas,json=baseOwasmGas,proto3" json:"base_owasm_gas,omitempty"`
+	// PerValidatorRequestGas is the amount of Cosmos-SDK gas charged per
+	// requested validator.
+	PerValidatorRequestGas uint64 `protobuf:"varint,7,opt,name=per_validator_request_gas,json=perValidatorRequestGas,proto3" json:"per_validator_request_gas,omitempty"`
+	// SamplingTryCount the number of validator sampling tries to pick the highest
+	// voting power subset of validators to perform an oracle task.
+	SamplingTryCount uint64 `protobuf:"varint,8,opt,name=sampling_try_count,json=samplingTryCount,proto3" json:"sampling_try_count,omitempty"`
+	// OracleRewardPercentage is the percentage of block rewards allocated to
+	// active oracle validators.
+	OracleRewardPercentage uint64 `protobuf:"varint,9,opt,name=oracle_reward_percentage,json=oracleRewardPercentage,proto3" json:"oracle_reward_percentage,omitempty"`
+	// InactivePenaltyDuration is the duration period where a validator cannot
+	// activate back after missing an oracle report.
+	InactivePenaltyDuration uint64 `protobuf:"varint,10,opt,name=inactive_penalty_duration,json=inactivePenaltyDuration,proto3" json:"inactive_penalty_duration,omitempty"`
+	// IBCRequestEnabled is a flag indicating whether sending oracle request via
+	// IBC is allowed
+	IbcRequestEnabled bool `protobuf:"varint,11,opt,name=ibc_request_enabled,json=ibcRequestEnabled,proto3" json:"ibc_request_enabled,omitempty"`
+	// MaxLatestResultIndexSize is the maximum number of entries in each of the
+	// latest result and the latest price indexes. The entries of the oldest
+	// requests are evicted first, also when it is lowered by a parameter update.
+	// Nothing is indexed if it is zero.
+	MaxLatestResultIndexSize uint64 `protobuf:"varint,12,opt,name=max_latest_result_index_size,json=maxLatestResultIndexSize,proto3" json:"max_latest_result_index_size,omitempty"`
+	// PriceOracleScriptID is the ID of the standard price reference oracle
+	// script whose results are indexed by symbol for the RequestPrice query.
+	// Prices are not indexed if it is zero.
+	PriceOracleScriptId uint64 `protobuf:"varint,13,opt,name=price_oracle_script_id,json=priceOracleScriptId,proto3" json:"price_oracle_script_id,omitempty"`
+	// ReportWindowSize is the number of the latest report assignments of a
+	// validator that are kept to count its missed reports. A validator is
+	// deactivated on its first missed report if it is zero.
+	ReportWindowSize uint64 `protobuf:"varint,14,opt,name=report_window_size,json=reportWindowSize,proto3" json:"report_window_size,omitempty"`
+	// MaxMissedReportPercentage is the percentage of the report window that a
+	// validator can miss before it gets deactivated.
+	MaxMissedReportPercentage uint64 `protobuf:"varint,15,opt,name=max_missed_report_percentage,json=maxMissedReportPercentage,proto3" json:"max_missed_report_percentage,omitempty"`
+	// MaxCallbackGasLimit is the maximum gas limit of the callback of a request.
+	// Requests cannot have a callback if it is zero.
+	MaxCallbackGasLimit uint64 `protobuf:"varint,16,opt,name=max_callback_gas_limit,json=maxCallbackGasLimit,proto3" json:"max_callback_gas_limit,omitempty"`
+	// MinSubscriptionInterval is the minimum number of blocks between two
+	// requests spawned by a request subscription. Request subscriptions cannot be
+	// created if it is zero.
+	MinSubscriptionInterval uint64 `protobuf:"varint,17,opt,name=min_subscription_interval,json=minSubscriptionInterval,proto3" json:"min_subscription_interval,omitempty"`
+	// SubscriptionBaseRequestFee is the fee charged from the deposit of a
+	// request subscription for every request it spawns, on top of the data
+	// source fees of the request.
+	SubscriptionBaseRequestFee []*v1beta1.Coin `protobuf:"bytes,18,rep,name=subscription_base_request_fee,json=subscriptionBaseRequestFee,proto3" json:"subscription_base_request_fee,omitempty"`
+	// MaxSubscriptionRequestsPerBlock is the maximum number of requests that
+	// request subscriptions can spawn in a block. Due subscriptions over the
+	// limit are carried over to the next block.
+	MaxSubscriptionRequestsPerBlock uint64 `protobuf:"varint,19,opt,name=max_subscription_requests_per_block,json=maxSubscriptionRequestsPerBlock,proto3" json:"max_subscription_requests_per_block,omitempty"`
 }
 
-func (x *OracleRequestPacketData) Reset() {
-	*x = OracleRequestPacketData{}
+func (x *Params) Reset() {
+	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[6]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OracleRequestPacketData) String() string {
+func (x *Params) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OracleRequestPacketData) ProtoMessage() {}
+func (*Params) ProtoMessage() {}
 
-// Deprecated: Use OracleRequestPacketData.ProtoReflect.Descriptor instead.
-func (*OracleRequestPacketData) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *OracleRequestPacketData) GetClientId() string {
+func (x *Params) GetMaxRawRequestCount() uint64 {
 	if x != nil {
-		return x.ClientId
+		return x.MaxRawRequestCount
 	}
-	return ""
+	return 0
 }
 
-func (x *OracleRequestPacketData) GetOracleScriptId() uint64 {
+func (x *Params) GetMaxAskCount() uint64 {
 	if x != nil {
-		return x.OracleScriptId
+		return x.MaxAskCount
 	}
 	return 0
 }
 
-func (x *OracleRequestPacketData) GetCalldata() []byte {
+func (x *Params) GetMaxCalldataSize() uint64 {
 	if x != nil {
-		return x.Calldata
+		return x.MaxCalldataSize
 	}
-	return nil
+	return 0
 }
 
-func (x *OracleRequestPacketData) GetAskCount() uint64 {
+func (x *Params) GetMaxReportDataSize() uint64 {
 	if x != nil {
-		return x.AskCount
+		return x.MaxReportDataSize
 	}
 	return 0
 }
 
-func (x *OracleRequestPacketData) GetMinCount() uint64 {
+func (x *Params) GetExpirationBlockCount() uint64 {
 	if x != nil {
-		return x.MinCount
+		return x.ExpirationBlockCount
 	}
 	return 0
 }
 
-func (x *OracleRequestPacketData) GetFeeLimit() []*v1beta1.Coin {
+func (x *Params) GetBaseOwasmGas() uint64 {
 	if x != nil {
-		return x.FeeLimit
+		return x.BaseOwasmGas
 	}
-	return nil
+	return 0
 }
 
-func (x *OracleRequestPacketData) GetPrepareGas() uint64 {
+func (x *Params) GetPerValidatorRequestGas() uint64 {
 	if x != nil {
-		return x.PrepareGas
+		return x.PerValidatorRequestGas
 	}
 	return 0
 }
 
-func (x *OracleRequestPacketData) GetExecuteGas() uint64 {
+func (x *Params) GetSamplingTryCount() uint64 {
 	if x != nil {
-		return x.ExecuteGas
+		return x.SamplingTryCount
 	}
 	return 0
 }
 
-func (x *OracleRequestPacketData) GetTssEncoder() Encoder {
+func (x *Params) GetOracleRewardPercentage() uint64 {
 	if x != nil {
-		return x.TssEncoder
+		return x.OracleRewardPercentage
 	}
-	return Encoder_ENCODER_UNSPECIFIED
+	return 0
 }
 
-// OracleRequestPacketAcknowledgement encodes an oracle request acknowledgement
-// send back to requester chain.
-type OracleRequestPacketAcknowledgement struct {
+func (x *Params) GetInactivePenaltyDuration() uint64 {
+	if x != nil {
+		return x.InactivePenaltyDuration
+	}
+	return 0
+}
+
+func (x *Params) GetIbcRequestEnabled() bool {
+	if x != nil {
+		return x.IbcRequestEnabled
+	}
+	return false
+}
+
+func (x *Params) GetMaxLatestResultIndexSize() uint64 {
+	if x != nil {
+		return x.MaxLatestResultIndexSize
+	}
+	return 0
+}
+
+func (x *Params) GetPriceOracleScriptId() uint64 {
+	if x != nil {
+		return x.PriceOracleScriptId
+	}
+	return 0
+}
+
+func (x *Params) GetReportWindowSize() uint64 {
+	if x != nil {
+		return x.ReportWindowSize
+	}
+	return 0
+}
+
+func (x *Params) GetMaxMissedReportPercentage() uint64 {
+	if x != nil {
+		return x.MaxMissedReportPercentage
+	}
+	return 0
+}
+
+func (x *Params) GetMaxCallbackGasLimit() uint64 {
+	if x != nil {
+		return x.MaxCallbackGasLimit
+	}
+	return 0
+}
+
+func (x *Params) GetMinSubscriptionInterval() uint64 {
+	if x != nil {
+		return x.MinSubscriptionInterval
+	}
+	return 0
+}
+
+func (x *Params) GetSubscriptionBaseRequestFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.SubscriptionBaseRequestFee
+	}
+	return nil
+}
+
+func (x *Params) GetMaxSubscriptionRequestsPerBlock() uint64 {
+	if x != nil {
+		return x.MaxSubscriptionRequestsPerBlock
+	}
+	return 0
+}
+
+// PendingResolveList is a list of requests that are waiting to be resolved
+type PendingResolveList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// RequestID is BandChain's unique identifier for this oracle request.
-	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// RequestIDs is a list of request IDs that are waiting to be resolved
+	RequestIds []uint64 `protobuf:"varint,1,rep,packed,name=request_ids,json=requestIds,proto3" json:"request_ids,omitempty"`
 }
 
-func (x *OracleRequestPacketAcknowledgement) Reset() {
-	*x = OracleRequestPacketAcknowledgement{}
+func (x *PendingResolveList) Reset() {
+	*x = PendingResolveList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[7]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OracleRequestPacketAcknowledgement) String() string {
+func (x *PendingResolveList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OracleRequestPacketAcknowledgement) ProtoMessage() {}
+func (*PendingResolveList) ProtoMessage() {}
 
-// Deprecated: Use OracleRequestPacketAcknowledgement.ProtoReflect.Descriptor instead.
-func (*OracleRequestPacketAcknowledgement) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use PendingResolveList.ProtoReflect.Descriptor instead.
+func (*PendingResolveList) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *OracleRequestPacketAcknowledgement) GetRequestId() uint64 {
+func (x *PendingResolveList) GetRequestIds() []uint64 {
 	if x != nil {
-		return x.RequestId
+		return x.RequestIds
 	}
-	return 0
+	return nil
 }
 
-// OracleResponsePacketData encodes an oracle response from BandChain to the
-// requester.
-type OracleResponsePacketData struct {
+// IBCChannel is information of IBC protocol to allow communicating with other
+// chain
+type IBCChannel struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ClientID is the unique identifier matched with that of the oracle request
-	// packet.
-	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	// RequestID is BandChain's unique identifier for this oracle request.
-	RequestId uint64 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// AnsCount is the number of validators among to the asked validators that
-	// actually responded to this oracle request prior to this oracle request
-	// being resolved.
-	AnsCount uint64 `protobuf:"varint,3,opt,name=ans_count,json=ansCount,proto3" json:"ans_count,omitempty"`
-	// RequestTime is the UNIX epoch time at which the request was sent to
-	// BandChain.
-	RequestTime int64 `protobuf:"varint,4,opt,name=request_time,json=requestTime,proto3" json:"request_time,omitempty"`
-	// ResolveTime is the UNIX epoch time at which the request was resolved to the
-	// final result.
-	ResolveTime int64 `protobuf:"varint,5,opt,name=resolve_time,json=resolveTime,proto3" json:"resolve_time,omitempty"`
-	// ResolveStatus is the status of this oracle request, which can be OK,
-	// FAILURE, or EXPIRED.
-	ResolveStatus ResolveStatus `protobuf:"varint,6,opt,name=resolve_status,json=resolveStatus,proto3,enum=band.oracle.v1.ResolveStatus" json:"resolve_status,omitempty"`
-	// Result is the final aggregated value encoded in OBI format. Only available
-	// if status if OK.
-	Result []byte `protobuf:"bytes,7,opt,name=result,proto3" json:"result,omitempty"`
+	// PortID is port ID used for sending response packet when request is
+	// resolved.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// ChannelID is channel ID used for sending response packet when request is
+	// resolved.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (x *OracleResponsePacketData) Reset() {
-	*x = OracleResponsePacketData{}
+func (x *IBCChannel) Reset() {
+	*x = IBCChannel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OracleResponsePacketData) String() string {
+func (x *IBCChannel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OracleResponsePacketData) ProtoMessage() {}
+func (*IBCChannel) ProtoMessage() {}
 
-// Deprecated: Use OracleResponsePacketData.ProtoReflect.Descriptor instead.
-func (*OracleResponsePacketData) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use IBCChannel.ProtoReflect.Descriptor instead.
+func (*IBCChannel) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *OracleResponsePacketData) GetClientId() string {
+func (x *IBCChannel) GetPortId() string {
 	if x != nil {
-		return x.ClientId
+		return x.PortId
 	}
 	return ""
 }
 
-func (x *OracleResponsePacketData) GetRequestId() uint64 {
+func (x *IBCChannel) GetChannelId() string {
 	if x != nil {
-		return x.RequestId
+		return x.ChannelId
 	}
-	return 0
+	return ""
 }
 
-func (x *OracleResponsePacketData) GetAnsCount() uint64 {
-	if x != nil {
-		return x.AnsCount
-	}
-	return 0
+// RequestCallback is information of a registered in-process module that
+// receives the response of a request when the request is resolved.
+type RequestCallback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Target is the name of the callback target registered on the oracle
+	// keeper.
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// GasLimit is the maximum gas that the callback target can use to handle the
+	// response.
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
-func (x *OracleResponsePacketData) GetRequestTime() int64 {
-	if x != nil {
-		return x.RequestTime
+func (x *RequestCallback) Reset() {
+	*x = RequestCallback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *OracleResponsePacketData) GetResolveTime() int64 {
-	if x != nil {
-		return x.ResolveTime
-	}
-	return 0
+func (x *RequestCallback) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *OracleResponsePacketData) GetResolveStatus() ResolveStatus {
+func (*RequestCallback) ProtoMessage() {}
+
+// Deprecated: Use RequestCallback.ProtoReflect.Descriptor instead.
+func (*RequestCallback) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RequestCallback) GetTarget() string {
 	if x != nil {
-		return x.ResolveStatus
+		return x.Target
 	}
-	return ResolveStatus_RESOLVE_STATUS_OPEN_UNSPECIFIED
+	return ""
 }
 
-func (x *OracleResponsePacketData) GetResult() []byte {
+func (x *RequestCallback) GetGasLimit() uint64 {
 	if x != nil {
-		return x.Result
+		return x.GasLimit
 	}
-	return nil
+	return 0
 }
 
-// Result encodes a result of request and store in chain
-type Result struct {
+// RequestVerification is a message that is constructed and signed by a reporter
+// to be used as a part of verification of oracle request.
+type RequestVerification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ClientID is the unique identifier of this oracle request, as specified by
-	// the client. This same unique ID will be sent back to the requester with the
-	// oracle response.
-	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	// OracleScriptID is the unique identifier of the oracle script to be
-	// executed.
-	OracleScriptId uint64 `protobuf:"varint,2,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
-	// Calldata is the calldata bytes available for oracle executor to read.
-	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
-	// AskCount is the number of validators that are requested to respond to this
-	// oracle request. Higher value means more security, at a higher gas cost.
-	AskCount uint64 `protobuf:"varint,4,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
-	// MinCount is the minimum number of validators necessary for the request to
-	// proceed to the execution phase. Higher value means more security, at the
-	// cost of liveness.
-	MinCount uint64 `protobuf:"varint,5,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
-	// RequestID is BandChain's unique identifier for this oracle request.
-	RequestId uint64 `protobuf:"varint,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// AnsCount is the number of validators among to the asked validators that
-	// actually responded to this oracle request prior to this oracle request
-	// being resolved.
-	AnsCount uint64 `protobuf:"varint,7,opt,name=ans_count,json=ansCount,proto3" json:"ans_count,omitempty"`
-	// RequestTime is the UNIX epoch time at which the request was sent to
-	// BandChain.
-	RequestTime int64 `protobuf:"varint,8,opt,name=request_time,json=requestTime,proto3" json:"request_time,omitempty"`
-	// ResolveTime is the UNIX epoch time at which the request was resolved to the
-	// final result.
-	ResolveTime int64 `protobuf:"varint,9,opt,name=resolve_time,json=resolveTime,proto3" json:"resolve_time,omitempty"`
-	// ResolveStatus is the status of this oracle request, which can be OK,
-	// FAILURE, or EXPIRED.
-	ResolveStatus ResolveStatus `protobuf:"varint,10,opt,name=resolve_status,json=resolveStatus,proto3,enum=band.oracle.v1.ResolveStatus" json:"resolve_status,omitempty"`
-	// Result is the final aggregated value only available if status if OK.
-	Result []byte `protobuf:"bytes,11,opt,name=result,proto3" json:"result,omitempty"`
+	// ChainID is the ID of targeted chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Validator is an validator address
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// RequestID is the targeted request ID
+	RequestId uint64 `protobuf:"varint,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// ExternalID is the oracle's external ID of data source
+	ExternalId uint64 `protobuf:"varint,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// DataSourceID is the ID of data source
+	DataSourceId uint64 `protobuf:"varint,5,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
 }
 
-func (x *Result) Reset() {
-	*x = Result{}
+func (x *RequestVerification) Reset() {
+	*x = RequestVerification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Result) String() string {
+func (x *RequestVerification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Result) ProtoMessage() {}
+func (*RequestVerification) ProtoMessage() {}
 
-// Deprecated: Use Result.ProtoReflect.Descriptor instead.
-func (*Result) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use RequestVerification.ProtoReflect.Descriptor instead.
+func (*RequestVerification) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Result) GetClientId() string {
+func (x *RequestVerification) GetChainId() string {
 	if x != nil {
-		return x.ClientId
+		return x.ChainId
 	}
 	return ""
 }
 
-func (x *Result) GetOracleScriptId() uint64 {
+func (x *RequestVerification) GetValidator() string {
 	if x != nil {
-		return x.OracleScriptId
+		return x.Validator
 	}
-	return 0
+	return ""
 }
 
-func (x *Result) GetCalldata() []byte {
+func (x *RequestVerification) GetRequestId() uint64 {
 	if x != nil {
-		return x.Calldata
+		return x.RequestId
 	}
-	return nil
+	return 0
 }
 
-func (x *Result) GetAskCount() uint64 {
+func (x *RequestVerification) GetExternalId() uint64 {
 	if x != nil {
-		return x.AskCount
+		return x.ExternalId
 	}
 	return 0
 }
 
-func (x *Result) GetMinCount() uint64 {
+func (x *RequestVerification) GetDataSourceId() uint64 {
 	if x != nil {
-		return x.MinCount
+		return x.DataSourceId
 	}
 	return 0
 }
 
-func (x *Result) GetRequestId() uint64 {
-	if x != nil {
-		return x.RequestId
+// PriceResult is a result from standard price reference
+type PriceResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Symbol is unit of data indicating what the data is. It is price currencies
+	// for this case.
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Multiplier is a number used for left-shifting value to eliminate decimal
+	// digits
+	Multiplier uint64 `protobuf:"varint,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	// Px is the actual data, which is rate number multiplied by the multiplier.
+	Px uint64 `protobuf:"varint,3,opt,name=px,proto3" json:"px,omitempty"`
+	// RequestID is oracle request ID that contains this price
+	RequestId uint64 `protobuf:"varint,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// ResolveTime is epoch timestamp indicating the time when the request had
+	// been resolved
+	ResolveTime int64 `protobuf:"varint,5,opt,name=resolve_time,json=resolveTime,proto3" json:"resolve_time,omitempty"`
+}
+
+func (x *PriceResult) Reset() {
+	*x = PriceResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Result) GetAnsCount() uint64 {
+func (x *PriceResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceResult) ProtoMessage() {}
+
+// Deprecated: Use PriceResult.ProtoReflect.Descriptor instead.
+func (*PriceResult) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PriceResult) GetSymbol() string {
 	if x != nil {
-		return x.AnsCount
+		return x.Symbol
 	}
-	return 0
+	return ""
 }
 
-func (x *Result) GetRequestTime() int64 {
+func (x *PriceResult) GetMultiplier() uint64 {
 	if x != nil {
-		return x.RequestTime
+		return x.Multiplier
 	}
 	return 0
 }
 
-func (x *Result) GetResolveTime() int64 {
+func (x *PriceResult) GetPx() uint64 {
 	if x != nil {
-		return x.ResolveTime
+		return x.Px
 	}
 	return 0
 }
 
-func (x *Result) GetResolveStatus() ResolveStatus {
+func (x *PriceResult) GetRequestId() uint64 {
 	if x != nil {
-		return x.ResolveStatus
+		return x.RequestId
 	}
-	return ResolveStatus_RESOLVE_STATUS_OPEN_UNSPECIFIED
+	return 0
 }
 
-func (x *Result) GetResult() []byte {
+func (x *PriceResult) GetResolveTime() int64 {
 	if x != nil {
-		return x.Result
+		return x.ResolveTime
 	}
-	return nil
+	return 0
 }
 
-// SigningResult encodes a result of signing of request
-type SigningResult struct {
+// OracleResultSignatureOrder defines a request id to request bandtss signature from the oracle result.
+type OracleResultSignatureOrder struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// signing_id is the id of the bandtss signing
-	SigningId uint64 `protobuf:"varint,1,opt,name=signing_id,json=signingId,proto3" json:"signing_id,omitempty"`
-	// error_codespace is the codespace of the error
-	ErrorCodespace string `protobuf:"bytes,2,opt,name=error_codespace,json=errorCodespace,proto3" json:"error_codespace,omitempty"`
-	// error_code is the code in the codespace of the error
-	ErrorCode uint64 `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// RequestID is oracle's unique identifier for this oracle request.
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// encoder is the mode of encoding oracle result signature order.
+	Encoder Encoder `protobuf:"varint,2,opt,name=encoder,proto3,enum=band.oracle.v1.Encoder" json:"encoder,omitempty"`
 }
 
-func (x *SigningResult) Reset() {
-	*x = SigningResult{}
+func (x *OracleResultSignatureOrder) Reset() {
+	*x = OracleResultSignatureOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SigningResult) String() string {
+func (x *OracleResultSignatureOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SigningResult) ProtoMessage() {}
+func (*OracleResultSignatureOrder) ProtoMessage() {}
 
-// Deprecated: Use SigningResult.ProtoReflect.Descriptor instead.
-func (*SigningResult) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use OracleResultSignatureOrder.ProtoReflect.Descriptor instead.
+func (*OracleResultSignatureOrder) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *SigningResult) GetSigningId() uint64 {
+func (x *OracleResultSignatureOrder) GetRequestId() uint64 {
 	if x != nil {
-		return x.SigningId
+		return x.RequestId
 	}
 	return 0
 }
 
-func (x *SigningResult) GetErrorCodespace() string {
-	if x != nil {
-		return x.ErrorCodespace
-	}
-	return ""
-}
-
-func (x *SigningResult) GetErrorCode() uint64 {
+func (x *OracleResultSignatureOrder) GetEncoder() Encoder {
 	if x != nil {
-		return x.ErrorCode
+		return x.Encoder
 	}
-	return 0
+	return Encoder_ENCODER_UNSPECIFIED
 }
 
-// ValidatorStatus maintains whether a validator is an active oracle provider.
-type ValidatorStatus struct {
+// TreasuryFee is the fee that is paid to the treasury of a data source for a
+// report of a request.
+type TreasuryFee struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// IsActive is a boolean indicating active status of validator.
-	// The validator will be deactivated when they are unable to send reports
-	// to fulfill oracle request before the request expired.
-	IsActive bool `protobuf:"varint,1,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	// Since is a block timestamp when validator has been activated/deactivated
-	Since *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	// Treasury is the account address of the data source treasury.
+	Treasury string `protobuf:"bytes,1,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	// Fee is the fee paid to the treasury for each report.
+	Fee []*v1beta1.Coin `protobuf:"bytes,2,rep,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func (x *ValidatorStatus) Reset() {
-	*x = ValidatorStatus{}
+func (x *TreasuryFee) Reset() {
+	*x = TreasuryFee{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidatorStatus) String() string {
+func (x *TreasuryFee) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorStatus) ProtoMessage() {}
+func (*TreasuryFee) ProtoMessage() {}
 
-// Deprecated: Use ValidatorStatus.ProtoReflect.Descriptor instead.
-func (*ValidatorStatus) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use TreasuryFee.ProtoReflect.Descriptor instead.
+func (*TreasuryFee) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ValidatorStatus) GetIsActive() bool {
+func (x *TreasuryFee) GetTreasury() string {
 	if x != nil {
-		return x.IsActive
+		return x.Treasury
 	}
-	return false
+	return ""
 }
 
-func (x *ValidatorStatus) GetSince() *timestamppb.Timestamp {
+func (x *TreasuryFee) GetFee() []*v1beta1.Coin {
 	if x != nil {
-		return x.Since
+		return x.Fee
 	}
 	return nil
 }
 
-// ActiveValidator is information of currently active validator
-type ActiveValidator struct {
+// FeeEscrow is the data source fees of a request that are held by the oracle
+// module until the requested validators report.
+type FeeEscrow struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Address is a validator address
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// Power is an amount of token that the validator is holding
-	Power uint64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	// Payer is the account address that paid the fees and receives the refund.
+	Payer string `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	// TreasuryFees are the fees released to the data source treasuries when a
+	// requested validator reports.
+	TreasuryFees []*TreasuryFee `protobuf:"bytes,2,rep,name=treasury_fees,json=treasuryFees,proto3" json:"treasury_fees,omitempty"`
+	// Total is the total fees held in escrow for the request.
+	Total []*v1beta1.Coin `protobuf:"bytes,3,rep,name=total,proto3" json:"total,omitempty"`
+	// Released is the fees already released to the data source treasuries.
+	Released []*v1beta1.Coin `protobuf:"bytes,4,rep,name=released,proto3" json:"released,omitempty"`
 }
 
-func (x *ActiveValidator) Reset() {
-	*x = ActiveValidator{}
+func (x *FeeEscrow) Reset() {
+	*x = FeeEscrow{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ActiveValidator) String() string {
+func (x *FeeEscrow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActiveValidator) ProtoMessage() {}
+func (*FeeEscrow) ProtoMessage() {}
 
-// Deprecated: Use ActiveValidator.ProtoReflect.Descriptor instead.
-func (*ActiveValidator) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use FeeEscrow.ProtoReflect.Descriptor instead.
+func (*FeeEscrow) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ActiveValidator) GetAddress() string {
+func (x *FeeEscrow) GetPayer() string {
 	if x != nil {
-		return x.Address
+		return x.Payer
 	}
 	return ""
 }
 
-func (x *ActiveValidator) GetPower() uint64 {
+func (x *FeeEscrow) GetTreasuryFees() []*TreasuryFee {
 	if x != nil {
-		return x.Power
+		return x.TreasuryFees
 	}
-	return 0
+	return nil
 }
 
-// Params is the data structure that keeps the parameters of the oracle module.
-type Params struct {
+func (x *FeeEscrow) GetTotal() []*v1beta1.Coin {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *FeeEscrow) GetReleased() []*v1beta1.Coin {
+	if x != nil {
+		return x.Released
+	}
+	return nil
+}
+
+// RequestSubscription is a request template that spawns a new oracle request
+// every interval blocks, paid from the deposit of its fee payer account.
+type RequestSubscription struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// MaxRawRequestCount is the maximum number of data source raw requests a
-	// request can make.
-	MaxRawRequestCount uint64 `protobuf:"varint,1,opt,name=max_raw_request_count,json=maxRawRequestCount,proto3" json:"max_raw_request_count,omitempty"`
-	// MaxAskCount is the maximum number of validators a request can target.
-	MaxAskCount uint64 `protobuf:"varint,2,opt,name=max_ask_count,json=maxAskCount,proto3" json:"max_ask_count,omitempty"`
-	// MaxCalldataSize is the maximum size limit of calldata (bytes) in a request.
-	MaxCalldataSize uint64 `protobuf:"varint,3,opt,name=max_calldata_size,json=maxCalldataSize,proto3" json:"max_calldata_size,omitempty"`
-	// MaxReportDataSize is the maximum size limit of report data (bytes) in a
-	// report.
-	MaxReportDataSize uint64 `protobuf:"varint,4,opt,name=max_report_data_size,json=maxReportDataSize,proto3" json:"max_report_data_size,omitempty"`
-	// ExpirationBlockCount is the number of blocks a request stays valid before
-	// it gets expired due to insufficient reports.
-	ExpirationBlockCount uint64 `protobuf:"varint,5,opt,name=expiration_block_count,json=expirationBlockCount,proto3" json:"expiration_block_count,omitempty"`
-	// BaseOwasmGas is the base amount of Cosmos-SDK gas charged for owasm
-	// execution.
-	BaseOwasmGas uint64 `protobuf:"varint,6,opt,name=base_owasm_gas,json=baseOwasmGas,proto3" json:"base_owasm_gas,omitempty"`
-	// PerValidatorRequestGas is the amount of Cosmos-SDK gas charged per
-	// requested validator.
-	PerValidatorRequestGas uint64 `protobuf:"varint,7,opt,name=per_validator_request_gas,json=perValidatorRequestGas,proto3" json:"per_validator_request_gas,omitempty"`
-	// SamplingTryCount the number of validator sampling tries to pick the highest
-	// voting power subset of validators to perform an oracle task.
-	SamplingTryCount uint64 `protobuf:"varint,8,opt,name=sampling_try_count,json=samplingTryCount,proto3" json:"sampling_try_count,omitempty"`
-	// OracleRewardPercentage is the percentage of block rewards allocated to
-	// active oracle validators.
-	OracleRewardPercentage uint64 `protobuf:"varint,9,opt,name=oracle_reward_percentage,json=oracleRewardPercentage,proto3" json:"oracle_reward_percentage,omitempty"`
-	// InactivePenaltyDuration is the duration period where a validator cannot
-	// activate back after missing an oracle report.
-	InactivePenaltyDuration uint64 `protobuf:"varint,10,opt,name=inactive_penalty_duration,json=inactivePenaltyDuration,proto3" json:"inactive_penalty_duration,omitempty"`
-	// IBCRequestEnabled is a flag indicating whether sending oracle request via
-	// IBC is allowed
-	IbcRequestEnabled bool `protobuf:"varint,11,opt,name=ibc_request_enabled,json=ibcRequestEnabled,proto3" json:"ibc_request_enabled,omitempty"`
-}
-
-func (x *Params) Reset() {
-	*x = Params{}
+	// ID is the unique identifier of the request subscription.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Owner is the account address that can cancel the request subscription.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// FeePayer is the account address that holds the deposit and pays the fees
+	// of the spawned requests.
+	FeePayer string `protobuf:"bytes,3,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// OracleScriptID is the identifier of the oracle script to be called.
+	OracleScriptId uint64 `protobuf:"varint,4,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// Calldata is the OBI-encoded call parameters for the oracle script.
+	Calldata []byte `protobuf:"bytes,5,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is the number of validators to perform the oracle task.
+	AskCount uint64 `protobuf:"varint,6,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is the minimum number of validators sufficient to resolve the
+	// oracle tasks.
+	MinCount uint64 `protobuf:"varint,7,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// ClientID is the client-provided unique identifier to track the requests.
+	ClientId string `protobuf:"bytes,8,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// FeeLimit is the maximum tokens that will be paid to all data source
+	// providers of each request.
+	FeeLimit []*v1beta1.Coin `protobuf:"bytes,9,rep,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	// PrepareGas is amount of gas to pay to prepare raw requests
+	PrepareGas uint64 `protobuf:"varint,10,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
+	// ExecuteGas is amount of gas to reserve for executing
+	ExecuteGas uint64 `protobuf:"varint,11,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	// TSSEncoder is the mode of encoding oracle result signature order.
+	TssEncoder Encoder `protobuf:"varint,12,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// Interval is the number of blocks between two spawned requests.
+	Interval uint64 `protobuf:"varint,13,opt,name=interval,proto3" json:"interval,omitempty"`
+	// NextRequestHeight is the block height at which the next request will be
+	// spawned.
+	NextRequestHeight int64 `protobuf:"varint,14,opt,name=next_request_height,json=nextRequestHeight,proto3" json:"next_request_height,omitempty"`
+	// IsActive is false if the subscription failed to spawn a request, e.g. its
+	// deposit ran out. It becomes active again on the next top-up.
+	IsActive bool `protobuf:"varint,15,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// LastRequestID is the identifier of the latest spawned request.
+	LastRequestId uint64 `protobuf:"varint,16,opt,name=last_request_id,json=lastRequestId,proto3" json:"last_request_id,omitempty"`
+	// RequestCount is the number of requests spawned by the subscription.
+	RequestCount uint64 `protobuf:"varint,17,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+}
+
+func (x *RequestSubscription) Reset() {
+	*x = RequestSubscription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Params) String() string {
+func (x *RequestSubscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Params) ProtoMessage() {}
+func (*RequestSubscription) ProtoMessage() {}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use RequestSubscription.ProtoReflect.Descriptor instead.
+func (*RequestSubscription) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Params) GetMaxRawRequestCount() uint64 {
+func (x *RequestSubscription) GetId() uint64 {
 	if x != nil {
-		return x.MaxRawRequestCount
+		return x.Id
 	}
 	return 0
 }
 
-func (x *Params) GetMaxAskCount() uint64 {
+func (x *RequestSubscription) GetOwner() string {
 	if x != nil {
-		return x.MaxAskCount
+		return x.Owner
 	}
-	return 0
+	return ""
 }
 
-func (x *Params) GetMaxCalldataSize() uint64 {
+func (x *RequestSubscription) GetFeePayer() string {
 	if x != nil {
-		return x.MaxCalldataSize
+		return x.FeePayer
 	}
-	return 0
+	return ""
 }
 
-func (x *Params) GetMaxReportDataSize() uint64 {
+func (x *RequestSubscription) GetOracleScriptId() uint64 {
 	if x != nil {
-		return x.MaxReportDataSize
+		return x.OracleScriptId
 	}
 	return 0
 }
 
-func (x *Params) GetExpirationBlockCount() uint64 {
+func (x *RequestSubscription) GetCalldata() []byte {
 	if x != nil {
-		return x.ExpirationBlockCount
+		return x.Calldata
 	}
-	return 0
+	return nil
 }
 
-func (x *Params) GetBaseOwasmGas() uint64 {
+func (x *RequestSubscription) GetAskCount() uint64 {
 	if x != nil {
-		return x.BaseOwasmGas
+		return x.AskCount
 	}
 	return 0
 }
 
-func (x *Params) GetPerValidatorRequestGas() uint64 {
+func (x *RequestSubscription) GetMinCount() uint64 {
 	if x != nil {
-		return x.PerValidatorRequestGas
+		return x.MinCount
 	}
 	return 0
 }
 
-func (x *Params) GetSamplingTryCount() uint64 {
+func (x *RequestSubscription) GetClientId() string {
 	if x != nil {
-		return x.SamplingTryCount
+		return x.ClientId
 	}
-	return 0
+	return ""
 }
 
-func (x *Params) GetOracleRewardPercentage() uint64 {
+func (x *RequestSubscription) GetFeeLimit() []*v1beta1.Coin {
 	if x != nil {
-		return x.OracleRewardPercentage
+		return x.FeeLimit
 	}
-	return 0
+	return nil
 }
 
-func (x *Params) GetInactivePenaltyDuration() uint64 {
+func (x *RequestSubscription) GetPrepareGas() uint64 {
 	if x != nil {
-		return x.InactivePenaltyDuration
+		return x.PrepareGas
 	}
 	return 0
 }
 
-func (x *Params) GetIbcRequestEnabled() bool {
+func (x *RequestSubscription) GetExecuteGas() uint64 {
 	if x != nil {
-		return x.IbcRequestEnabled
-	}
-	return false
-}
-
-// PendingResolveList is a list of requests that are waiting to be resolved
-type PendingResolveList struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// RequestIDs is a list of request IDs that are waiting to be resolved
-	RequestIds []uint64 `protobuf:"varint,1,rep,packed,name=request_ids,json=requestIds,proto3" json:"request_ids,omitempty"`
-}
-
-func (x *PendingResolveList) Reset() {
-	*x = PendingResolveList{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		return x.ExecuteGas
 	}
+	return 0
 }
 
-func (x *PendingResolveList) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PendingResolveList) ProtoMessage() {}
-
-// Deprecated: Use PendingResolveList.ProtoReflect.Descriptor instead.
-func (*PendingResolveList) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *PendingResolveList) GetRequestIds() []uint64 {
+func (x *RequestSubscription) GetTssEncoder() Encoder {
 	if x != nil {
-		return x.RequestIds
-	}
-	return nil
-}
-
-// IBCChannel is information of IBC protocol to allow communicating with other
-// chain
-type IBCChannel struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// PortID is port ID used for sending response packet when request is
-	// resolved.
-	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	// ChannelID is channel ID used for sending response packet when request is
-	// resolved.
-	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-}
-
-func (x *IBCChannel) Reset() {
-	*x = IBCChannel{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		return x.TssEncoder
 	}
+	return Encoder_ENCODER_UNSPECIFIED
 }
 
-func (x *IBCChannel) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RequestSubscription) GetInterval() uint64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
 }
 
-func (*IBCChannel) ProtoMessage() {}
+func (x *RequestSubscription) GetNextRequestHeight() int64 {
+	if x != nil {
+		return x.NextRequestHeight
+	}
+	return 0
+}
 
-// Deprecated: Use IBCChannel.ProtoReflect.Descriptor instead.
-func (*IBCChannel) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{15}
+func (x *RequestSubscription) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
 }
 
-func (x *IBCChannel) GetPortId() string {
+func (x *RequestSubscription) GetLastRequestId() uint64 {
 	if x != nil {
-		return x.PortId
+		return x.LastRequestId
 	}
-	return ""
+	return 0
 }
 
-func (x *IBCChannel) GetChannelId() string {
+func (x *RequestSubscription) GetRequestCount() uint64 {
 	if x != nil {
-		return x.ChannelId
+		return x.RequestCount
 	}
-	return ""
+	return 0
 }
 
-// RequestVerification is a message that is constructed and signed by a reporter
-// to be used as a part of verification of oracle request.
-type RequestVerification struct {
+// DataSourceVersion is an immutable snapshot of a data source that is recorded
+// when the data source is created or edited.
+type DataSourceVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ChainID is the ID of targeted chain
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// Validator is an validator address
-	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
-	// RequestID is the targeted request ID
-	RequestId uint64 `protobuf:"varint,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// ExternalID is the oracle's external ID of data source
-	ExternalId uint64 `protobuf:"varint,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
-	// DataSourceID is the ID of data source
-	DataSourceId uint64 `protobuf:"varint,5,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
-}
-
-func (x *RequestVerification) Reset() {
-	*x = RequestVerification{}
+	// DataSourceID is the ID of the data source.
+	DataSourceId uint64 `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// Version is the version number of the data source, starting from 1.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Filename is the file name of the data source executable of this version.
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Treasury is the account address who received the data source fee.
+	Treasury string `protobuf:"bytes,4,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	// Fee is the data source fee per ask_count of this version.
+	Fee []*v1beta1.Coin `protobuf:"bytes,5,rep,name=fee,proto3" json:"fee,omitempty"`
+	// Editor is the account address who created this version.
+	Editor string `protobuf:"bytes,6,opt,name=editor,proto3" json:"editor,omitempty"`
+	// Height is the block height at which this version was created.
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *DataSourceVersion) Reset() {
+	*x = DataSourceVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RequestVerification) String() string {
+func (x *DataSourceVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestVerification) ProtoMessage() {}
+func (*DataSourceVersion) ProtoMessage() {}
 
-// Deprecated: Use RequestVerification.ProtoReflect.Descriptor instead.
-func (*RequestVerification) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use DataSourceVersion.ProtoReflect.Descriptor instead.
+func (*DataSourceVersion) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *RequestVerification) GetChainId() string {
+func (x *DataSourceVersion) GetDataSourceId() uint64 {
 	if x != nil {
-		return x.ChainId
+		return x.DataSourceId
+	}
+	return 0
+}
+
+func (x *DataSourceVersion) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DataSourceVersion) GetFilename() string {
+	if x != nil {
+		return x.Filename
 	}
 	return ""
 }
 
-func (x *RequestVerification) GetValidator() string {
+func (x *DataSourceVersion) GetTreasury() string {
 	if x != nil {
-		return x.Validator
+		return x.Treasury
 	}
 	return ""
 }
 
-func (x *RequestVerification) GetRequestId() uint64 {
+func (x *DataSourceVersion) GetFee() []*v1beta1.Coin {
 	if x != nil {
-		return x.RequestId
+		return x.Fee
 	}
-	return 0
+	return nil
 }
 
-func (x *RequestVerification) GetExternalId() uint64 {
+func (x *DataSourceVersion) GetEditor() string {
 	if x != nil {
-		return x.ExternalId
+		return x.Editor
 	}
-	return 0
+	return ""
 }
 
-func (x *RequestVerification) GetDataSourceId() uint64 {
+func (x *DataSourceVersion) GetHeight() int64 {
 	if x != nil {
-		return x.DataSourceId
+		return x.Height
 	}
 	return 0
 }
 
-// PriceResult is a result from standard price reference
-type PriceResult struct {
+// OracleScriptVersion is an immutable snapshot of an oracle script that is
+// recorded when the oracle script is created or edited.
+type OracleScriptVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Symbol is unit of data indicating what the data is. It is price currencies
-	// for this case.
-	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	// Multiplier is a number used for left-shifting value to eliminate decimal
-	// digits
-	Multiplier uint64 `protobuf:"varint,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
-	// Px is the actual data, which is rate number multiplied by the multiplier.
-	Px uint64 `protobuf:"varint,3,opt,name=px,proto3" json:"px,omitempty"`
-	// RequestID is oracle request ID that contains this price
-	RequestId uint64 `protobuf:"varint,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// ResolveTime is epoch timestamp indicating the time when the request had
-	// been resolved
-	ResolveTime int64 `protobuf:"varint,5,opt,name=resolve_time,json=resolveTime,proto3" json:"resolve_time,omitempty"`
-}
-
-func (x *PriceResult) Reset() {
-	*x = PriceResult{}
+	// OracleScriptID is the ID of the oracle script.
+	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// Version is the version number of the oracle script, starting from 1.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Filename is the file name of the compiled oracle script of this version.
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Schema is the schema of the oracle script input/output of this version.
+	Schema string `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	// SourceCodeURL is the URL of the oracle script's source code of this
+	// version.
+	SourceCodeUrl string `protobuf:"bytes,5,opt,name=source_code_url,json=sourceCodeUrl,proto3" json:"source_code_url,omitempty"`
+	// Editor is the account address who created this version.
+	Editor string `protobuf:"bytes,6,opt,name=editor,proto3" json:"editor,omitempty"`
+	// Height is the block height at which this version was created.
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *OracleScriptVersion) Reset() {
+	*x = OracleScriptVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PriceResult) String() string {
+func (x *OracleScriptVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PriceResult) ProtoMessage() {}
+func (*OracleScriptVersion) ProtoMessage() {}
 
-// Deprecated: Use PriceResult.ProtoReflect.Descriptor instead.
-func (*PriceResult) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use OracleScriptVersion.ProtoReflect.Descriptor instead.
+func (*OracleScriptVersion) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *PriceResult) GetSymbol() string {
+func (x *OracleScriptVersion) GetOracleScriptId() uint64 {
 	if x != nil {
-		return x.Symbol
+		return x.OracleScriptId
 	}
-	return ""
+	return 0
 }
 
-func (x *PriceResult) GetMultiplier() uint64 {
+func (x *OracleScriptVersion) GetVersion() uint64 {
 	if x != nil {
-		return x.Multiplier
+		return x.Version
 	}
 	return 0
 }
 
-func (x *PriceResult) GetPx() uint64 {
+func (x *OracleScriptVersion) GetFilename() string {
 	if x != nil {
-		return x.Px
+		return x.Filename
 	}
-	return 0
+	return ""
 }
 
-func (x *PriceResult) GetRequestId() uint64 {
+func (x *OracleScriptVersion) GetSchema() string {
 	if x != nil {
-		return x.RequestId
+		return x.Schema
 	}
-	return 0
+	return ""
 }
 
-func (x *PriceResult) GetResolveTime() int64 {
+func (x *OracleScriptVersion) GetSourceCodeUrl() string {
 	if x != nil {
-		return x.ResolveTime
+		return x.SourceCodeUrl
+	}
+	return ""
+}
+
+func (x *OracleScriptVersion) GetEditor() string {
+	if x != nil {
+		return x.Editor
+	}
+	return ""
+}
+
+func (x *OracleScriptVersion) GetHeight() int64 {
+	if x != nil {
+		return x.Height
 	}
 	return 0
 }
 
-// OracleResultSignatureOrder defines a request id to request bandtss signature from the oracle result.
-type OracleResultSignatureOrder struct {
+// DataSourceVersionPin pins a data source called by an oracle script to a
+// specific version.
+type DataSourceVersionPin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// RequestID is oracle's unique identifier for this oracle request.
-	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// encoder is the mode of encoding oracle result signature order.
-	Encoder Encoder `protobuf:"varint,2,opt,name=encoder,proto3,enum=band.oracle.v1.Encoder" json:"encoder,omitempty"`
+	// DataSourceID is the ID of the data source.
+	DataSourceId uint64 `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// Version is the version of the data source to be executed.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *OracleResultSignatureOrder) Reset() {
-	*x = OracleResultSignatureOrder{}
+func (x *DataSourceVersionPin) Reset() {
+	*x = DataSourceVersionPin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OracleResultSignatureOrder) String() string {
+func (x *DataSourceVersionPin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OracleResultSignatureOrder) ProtoMessage() {}
+func (*DataSourceVersionPin) ProtoMessage() {}
 
-// Deprecated: Use OracleResultSignatureOrder.ProtoReflect.Descriptor instead.
-func (*OracleResultSignatureOrder) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DataSourceVersionPin.ProtoReflect.Descriptor instead.
+func (*DataSourceVersionPin) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *OracleResultSignatureOrder) GetRequestId() uint64 {
+func (x *DataSourceVersionPin) GetDataSourceId() uint64 {
 	if x != nil {
-		return x.RequestId
+		return x.DataSourceId
 	}
 	return 0
 }
 
-func (x *OracleResultSignatureOrder) GetEncoder() Encoder {
+func (x *DataSourceVersionPin) GetVersion() uint64 {
 	if x != nil {
-		return x.Encoder
+		return x.Version
 	}
-	return Encoder_ENCODER_UNSPECIFIED
+	return 0
 }
 
 var File_band_oracle_v1_oracle_proto protoreflect.FileDescriptor
@@ -14208,7 +21454,7 @@ var file_band_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75, 0x72, 0x6c,
 	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x53, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb5,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xe5,
 	0x01, 0x0a, 0x0a, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a,
 	0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
@@ -14220,6 +21466,9 @@ var file_band_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x2e, 0x0a, 0x13, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x64,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
 	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x81, 0x01, 0x0a, 0x09, 0x52, 0x61, 0x77, 0x52, 0x65,
 	0x70, 0x6f, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45,
@@ -14228,7 +21477,7 @@ var file_band_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65,
 	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc5, 0x05, 0x0a, 0x07, 0x52,
+	0x64, 0x61, 0x74, 0x61, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xf9, 0x05, 0x0a, 0x07, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
 	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
 	0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
@@ -14272,165 +21521,223 @@ var file_band_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x73, 0x52, 0x08, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x04, 0xe8, 0xa0,
-	0x1f, 0x01, 0x22, 0x9a, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x69,
-	0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
-	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x61, 0x77, 0x5f, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62,
-	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61,
-	0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72,
-	0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0xe6, 0x03, 0x0a, 0x17, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x09, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x68, 0x0a, 0x09,
-	0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00,
-	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x66, 0x65,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72,
-	0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x65, 0x47, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73, 0x73, 0x5f,
-	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53, 0x53, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x65, 0x0a, 0x22, 0x4f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x41,
-	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39,
-	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0xc7, 0x02, 0x0a, 0x18, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x09,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f,
-	0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65,
-	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xdb, 0x03, 0x0a, 0x06, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f,
-	0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52,
-	0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61,
-	0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f,
-	0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc9, 0x01, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x6a, 0x0a, 0x0a, 0x73, 0x69, 0x67,
-	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x4b, 0xe2,
-	0xde, 0x1f, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x3a,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33,
-	0x2f, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x3a, 0x04, 0xe8,
-	0xa0, 0x1f, 0x01, 0x22, 0x70, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
-	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x3a,
-	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x41, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x22, 0xc4, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x73,
-	0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d,
-	0x61, 0x78, 0x41, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61,
-	0x78, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a,
-	0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x77, 0x61, 0x73, 0x6d, 0x5f, 0x67, 0x61, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x4f, 0x77, 0x61, 0x73, 0x6d,
-	0x47, 0x61, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x67, 0x61, 0x73,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x70, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x61, 0x73, 0x12, 0x2c,
-	0x0a, 0x12, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x61, 0x6d, 0x70,
-	0x6c, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x18,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x69, 0x6e, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x13, 0x69, 0x62, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42,
-	0x15, 0xe2, 0xde, 0x1f, 0x11, 0x49, 0x42, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x11, 0x69, 0x62, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
+	0x6e, 0x73, 0x52, 0x08, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x32, 0x0a, 0x15,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x9a, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x42, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x72,
+	0x61, 0x77, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0a, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x3a, 0x04, 0xe8,
+	0xa0, 0x1f, 0x01, 0x22, 0xe6, 0x03, 0x0a, 0x17, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61,
+	0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x68, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30,
+	0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73,
+	0x52, 0x08, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x47, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b,
+	0x74, 0x73, 0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a,
+	0x54, 0x53, 0x53, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x65, 0x0a, 0x22,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x3a, 0x04, 0xe8,
+	0xa0, 0x1f, 0x01, 0x22, 0xc7, 0x02, 0x0a, 0x18, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde,
+	0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xdb, 0x03,
+	0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f,
+	0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2,
+	0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49,
+	0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2,
+	0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc9, 0x01, 0x0a, 0x0d,
+	0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x6a, 0x0a,
+	0x0a, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x4b, 0xe2, 0xde, 0x1f, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44,
+	0xfa, 0xde, 0x1f, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
+	0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x70, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x05, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x8a, 0x02, 0x0a, 0x13, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x30,
+	0x0a, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x41, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x22, 0xa3, 0x09, 0x0a, 0x06, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x77, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x61,
+	0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x6d, 0x61, 0x78, 0x41, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d,
+	0x61, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x6c, 0x6c, 0x64,
+	0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x77, 0x61, 0x73, 0x6d, 0x5f, 0x67, 0x61, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x4f, 0x77, 0x61, 0x73,
+	0x6d, 0x47, 0x61, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x67, 0x61,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x70, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x61, 0x73, 0x12,
+	0x2c, 0x0a, 0x12, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x79, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a,
+	0x18, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x16, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x69, 0x6e, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x69, 0x6e, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x13, 0x69, 0x62, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x15, 0xe2, 0xde, 0x1f, 0x11, 0x49, 0x42, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x11, 0x69, 0x62, 0x63, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x1c, 0x6d, 0x61,
+	0x78, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x18, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x5e, 0x0a, 0x16, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x42, 0x29, 0xe2, 0xde, 0x1f, 0x13,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x13, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3f, 0x0a, 0x1c, 0x6d, 0x61, 0x78, 0x5f,
+	0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19,
+	0x6d, 0x61, 0x78, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x43, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3a,
+	0x0a, 0x19, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x17, 0x6d, 0x69, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x8e, 0x01, 0x0a, 0x1d, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x12, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8,
+	0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52,
+	0x1a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x65, 0x65, 0x12, 0x4c, 0x0a, 0x23, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1f, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
 	0x3b, 0x0a, 0x12, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
 	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75,
@@ -14439,81 +21746,210 @@ var file_band_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72,
 	0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
 	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa3, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x26, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0b, 0xe2, 0xde, 0x1f, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52,
-	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64,
+	0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x4c, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa3, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26,
+	0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0b, 0xe2, 0xde, 0x1f, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46,
+	0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb3, 0x01, 0x0a,
+	0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
+	0x6c, 0x69, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x70, 0x78, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x1a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a,
+	0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x8e, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x79, 0x46, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x79, 0x12, 0x5d, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x03, 0x66, 0x65, 0x65,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xbb, 0x02, 0x0a, 0x09, 0x46, 0x65, 0x65, 0x45, 0x73,
+	0x63, 0x72, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x79, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0d, 0x74, 0x72,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x46, 0x65, 0x65, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x46, 0x65,
+	0x65, 0x73, 0x12, 0x61, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x67, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x3a, 0x04,
+	0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x83, 0x06, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xde, 0x1f, 0x02, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x65,
+	0x65, 0x5f, 0x70, 0x61, 0x79, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x65, 0x65, 0x50, 0x61, 0x79, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a,
+	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x68, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x67, 0x61,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65,
+	0x47, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x67,
+	0x61, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73, 0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53, 0x53, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x46, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x1e, 0xe2, 0xde, 0x1f, 0x0d, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
+	0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc2, 0x02, 0x0a, 0x11, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
 	0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61,
 	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61,
 	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb3,
-	0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
-	0x6c, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x78, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x70, 0x78, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x1a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31,
-	0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x72, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x2a, 0xfb, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f,
-	0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x1f, 0x52, 0x45, 0x53,
-	0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x17,
-	0x8a, 0x9d, 0x20, 0x13, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c,
-	0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
-	0x53, 0x10, 0x01, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x12,
-	0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20,
-	0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c,
-	0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
-	0x44, 0x10, 0x03, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x1a,
-	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x6a, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
-	0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x43,
-	0x4f, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10,
-	0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x41, 0x42, 0x49,
-	0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41,
-	0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x41, 0x42, 0x49, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e,
-	0x00, 0x42, 0xb9, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61,
-	0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x61,
-	0x6e, 0x64, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x42,
-	0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a,
-	0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61, 0x6e,
-	0x64, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x12, 0x5d, 0x0a, 0x03, 0x66, 0x65,
+	0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x64, 0x69,
+	0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x64, 0x69, 0x74, 0x6f,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
+	0xa4, 0x02, 0x0a, 0x13, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x39, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
+	0xe2, 0xde, 0x1f, 0x0d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x52,
+	0x4c, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x7e, 0x0a, 0x14, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x69, 0x6e, 0x12, 0x46,
+	0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x2a, 0xfb, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x1f, 0x52, 0x45, 0x53, 0x4f,
+	0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x17, 0x8a,
+	0x9d, 0x20, 0x13, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53,
+	0x10, 0x01, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x12, 0x36,
+	0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16,
+	0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44,
+	0x10, 0x03, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x1a, 0x04,
+	0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x6a, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12,
+	0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x43, 0x4f,
+	0x44, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x45,
+	0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x41, 0x42, 0x49, 0x10,
+	0x02, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x52,
+	0x54, 0x49, 0x41, 0x4c, 0x5f, 0x41, 0x42, 0x49, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00,
+	0x42, 0xb9, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e,
+	0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x61, 0x6e,
+	0x64, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x42, 0x61,
+	0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x42,
+	0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64,
+	0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -14529,7 +21965,7 @@ func file_band_oracle_v1_oracle_proto_rawDescGZIP() []byte {
 }
 
 var file_band_oracle_v1_oracle_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_band_oracle_v1_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_band_oracle_v1_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_band_oracle_v1_oracle_proto_goTypes = []interface{}{
 	(ResolveStatus)(0),                         // 0: band.oracle.v1.ResolveStatus
 	(Encoder)(0),                               // 1: band.oracle.v1.Encoder
@@ -14545,34 +21981,50 @@ var file_band_oracle_v1_oracle_proto_goTypes = []interface{}{
 	(*Result)(nil),                             // 11: band.oracle.v1.Result
 	(*SigningResult)(nil),                      // 12: band.oracle.v1.SigningResult
 	(*ValidatorStatus)(nil),                    // 13: band.oracle.v1.ValidatorStatus
-	(*ActiveValidator)(nil),                    // 14: band.oracle.v1.ActiveValidator
-	(*Params)(nil),                             // 15: band.oracle.v1.Params
-	(*PendingResolveList)(nil),                 // 16: band.oracle.v1.PendingResolveList
-	(*IBCChannel)(nil),                         // 17: band.oracle.v1.IBCChannel
-	(*RequestVerification)(nil),                // 18: band.oracle.v1.RequestVerification
-	(*PriceResult)(nil),                        // 19: band.oracle.v1.PriceResult
-	(*OracleResultSignatureOrder)(nil),         // 20: band.oracle.v1.OracleResultSignatureOrder
-	(*v1beta1.Coin)(nil),                       // 21: cosmos.base.v1beta1.Coin
-	(*timestamppb.Timestamp)(nil),              // 22: google.protobuf.Timestamp
+	(*ValidatorReportInfo)(nil),                // 14: band.oracle.v1.ValidatorReportInfo
+	(*ActiveValidator)(nil),                    // 15: band.oracle.v1.ActiveValidator
+	(*Params)(nil),                             // 16: band.oracle.v1.Params
+	(*PendingResolveList)(nil),                 // 17: band.oracle.v1.PendingResolveList
+	(*IBCChannel)(nil),                         // 18: band.oracle.v1.IBCChannel
+	(*RequestCallback)(nil),                    // 19: band.oracle.v1.RequestCallback
+	(*RequestVerification)(nil),                // 20: band.oracle.v1.RequestVerification
+	(*PriceResult)(nil),                        // 21: band.oracle.v1.PriceResult
+	(*OracleResultSignatureOrder)(nil),         // 22: band.oracle.v1.OracleResultSignatureOrder
+	(*TreasuryFee)(nil),                        // 23: band.oracle.v1.TreasuryFee
+	(*FeeEscrow)(nil),                          // 24: band.oracle.v1.FeeEscrow
+	(*RequestSubscription)(nil),                // 25: band.oracle.v1.RequestSubscription
+	(*DataSourceVersion)(nil),                  // 26: band.oracle.v1.DataSourceVersion
+	(*OracleScriptVersion)(nil),                // 27: band.oracle.v1.OracleScriptVersion
+	(*DataSourceVersionPin)(nil),               // 28: band.oracle.v1.DataSourceVersionPin
+	(*v1beta1.Coin)(nil),                       // 29: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil),              // 30: google.protobuf.Timestamp
 }
 var file_band_oracle_v1_oracle_proto_depIdxs = []int32{
-	21, // 0: band.oracle.v1.DataSource.fee:type_name -> cosmos.base.v1beta1.Coin
+	29, // 0: band.oracle.v1.DataSource.fee:type_name -> cosmos.base.v1beta1.Coin
 	4,  // 1: band.oracle.v1.Request.raw_requests:type_name -> band.oracle.v1.RawRequest
-	17, // 2: band.oracle.v1.Request.ibc_channel:type_name -> band.oracle.v1.IBCChannel
+	18, // 2: band.oracle.v1.Request.ibc_channel:type_name -> band.oracle.v1.IBCChannel
 	1,  // 3: band.oracle.v1.Request.tss_encoder:type_name -> band.oracle.v1.Encoder
-	21, // 4: band.oracle.v1.Request.fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	29, // 4: band.oracle.v1.Request.fee_limit:type_name -> cosmos.base.v1beta1.Coin
 	5,  // 5: band.oracle.v1.Report.raw_reports:type_name -> band.oracle.v1.RawReport
-	21, // 6: band.oracle.v1.OracleRequestPacketData.fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	29, // 6: band.oracle.v1.OracleRequestPacketData.fee_limit:type_name -> cosmos.base.v1beta1.Coin
 	1,  // 7: band.oracle.v1.OracleRequestPacketData.tss_encoder:type_name -> band.oracle.v1.Encoder
 	0,  // 8: band.oracle.v1.OracleResponsePacketData.resolve_status:type_name -> band.oracle.v1.ResolveStatus
 	0,  // 9: band.oracle.v1.Result.resolve_status:type_name -> band.oracle.v1.ResolveStatus
-	22, // 10: band.oracle.v1.ValidatorStatus.since:type_name -> google.protobuf.Timestamp
-	1,  // 11: band.oracle.v1.OracleResultSignatureOrder.encoder:type_name -> band.oracle.v1.Encoder
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	30, // 10: band.oracle.v1.ValidatorStatus.since:type_name -> google.protobuf.Timestamp
+	29, // 11: band.oracle.v1.Params.subscription_base_request_fee:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 12: band.oracle.v1.OracleResultSignatureOrder.encoder:type_name -> band.oracle.v1.Encoder
+	29, // 13: band.oracle.v1.TreasuryFee.fee:type_name -> cosmos.base.v1beta1.Coin
+	23, // 14: band.oracle.v1.FeeEscrow.treasury_fees:type_name -> band.oracle.v1.TreasuryFee
+	29, // 15: band.oracle.v1.FeeEscrow.total:type_name -> cosmos.base.v1beta1.Coin
+	29, // 16: band.oracle.v1.FeeEscrow.released:type_name -> cosmos.base.v1beta1.Coin
+	29, // 17: band.oracle.v1.RequestSubscription.fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 18: band.oracle.v1.RequestSubscription.tss_encoder:type_name -> band.oracle.v1.Encoder
+	29, // 19: band.oracle.v1.DataSourceVersion.fee:type_name -> cosmos.base.v1beta1.Coin
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_band_oracle_v1_oracle_proto_init() }
@@ -14726,7 +22178,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActiveValidator); i {
+			switch v := v.(*ValidatorReportInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14738,7 +22190,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Params); i {
+			switch v := v.(*ActiveValidator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14750,7 +22202,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PendingResolveList); i {
+			switch v := v.(*Params); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14762,7 +22214,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IBCChannel); i {
+			switch v := v.(*PendingResolveList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14774,7 +22226,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestVerification); i {
+			switch v := v.(*IBCChannel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14786,7 +22238,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PriceResult); i {
+			switch v := v.(*RequestCallback); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14798,6 +22250,30 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestVerification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OracleResultSignatureOrder); i {
 			case 0:
 				return &v.state
@@ -14809,6 +22285,78 @@ func file_band_oracle_v1_oracle_proto_init() {
 				return nil
 			}
 		}
+		file_band_oracle_v1_oracle_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TreasuryFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeEscrow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSourceVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OracleScriptVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSourceVersionPin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -14816,7 +22364,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_oracle_v1_oracle_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   19,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
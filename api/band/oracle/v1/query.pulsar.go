@@ -2,6 +2,8 @@
 package oraclev1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	v1beta11 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -7129,27 +7131,25 @@ func (x *fastReflection_QueryValidatorResponse) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_QueryIsReporterRequest                   protoreflect.MessageDescriptor
-	fd_QueryIsReporterRequest_validator_address protoreflect.FieldDescriptor
-	fd_QueryIsReporterRequest_reporter_address  protoreflect.FieldDescriptor
+	md_QueryValidatorReportInfoRequest                   protoreflect.MessageDescriptor
+	fd_QueryValidatorReportInfoRequest_validator_address protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryIsReporterRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryIsReporterRequest")
-	fd_QueryIsReporterRequest_validator_address = md_QueryIsReporterRequest.Fields().ByName("validator_address")
-	fd_QueryIsReporterRequest_reporter_address = md_QueryIsReporterRequest.Fields().ByName("reporter_address")
+	md_QueryValidatorReportInfoRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryValidatorReportInfoRequest")
+	fd_QueryValidatorReportInfoRequest_validator_address = md_QueryValidatorReportInfoRequest.Fields().ByName("validator_address")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryIsReporterRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryValidatorReportInfoRequest)(nil)
 
-type fastReflection_QueryIsReporterRequest QueryIsReporterRequest
+type fastReflection_QueryValidatorReportInfoRequest QueryValidatorReportInfoRequest
 
-func (x *QueryIsReporterRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryIsReporterRequest)(x)
+func (x *QueryValidatorReportInfoRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorReportInfoRequest)(x)
 }
 
-func (x *QueryIsReporterRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryValidatorReportInfoRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7161,43 +7161,43 @@ func (x *QueryIsReporterRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryIsReporterRequest_messageType fastReflection_QueryIsReporterRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryIsReporterRequest_messageType{}
+var _fastReflection_QueryValidatorReportInfoRequest_messageType fastReflection_QueryValidatorReportInfoRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorReportInfoRequest_messageType{}
 
-type fastReflection_QueryIsReporterRequest_messageType struct{}
+type fastReflection_QueryValidatorReportInfoRequest_messageType struct{}
 
-func (x fastReflection_QueryIsReporterRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryIsReporterRequest)(nil)
+func (x fastReflection_QueryValidatorReportInfoRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorReportInfoRequest)(nil)
 }
-func (x fastReflection_QueryIsReporterRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryIsReporterRequest)
+func (x fastReflection_QueryValidatorReportInfoRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorReportInfoRequest)
 }
-func (x fastReflection_QueryIsReporterRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryIsReporterRequest
+func (x fastReflection_QueryValidatorReportInfoRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorReportInfoRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryIsReporterRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryIsReporterRequest
+func (x *fastReflection_QueryValidatorReportInfoRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorReportInfoRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryIsReporterRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryIsReporterRequest_messageType
+func (x *fastReflection_QueryValidatorReportInfoRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorReportInfoRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryIsReporterRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryIsReporterRequest)
+func (x *fastReflection_QueryValidatorReportInfoRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorReportInfoRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryIsReporterRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryIsReporterRequest)(x)
+func (x *fastReflection_QueryValidatorReportInfoRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorReportInfoRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7205,16 +7205,10 @@ func (x *fastReflection_QueryIsReporterRequest) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryIsReporterRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryValidatorReportInfoRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ValidatorAddress != "" {
 		value := protoreflect.ValueOfString(x.ValidatorAddress)
-		if !f(fd_QueryIsReporterRequest_validator_address, value) {
-			return
-		}
-	}
-	if x.ReporterAddress != "" {
-		value := protoreflect.ValueOfString(x.ReporterAddress)
-		if !f(fd_QueryIsReporterRequest_reporter_address, value) {
+		if !f(fd_QueryValidatorReportInfoRequest_validator_address, value) {
 			return
 		}
 	}
@@ -7231,17 +7225,15 @@ func (x *fastReflection_QueryIsReporterRequest) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryIsReporterRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryValidatorReportInfoRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
+	case "band.oracle.v1.QueryValidatorReportInfoRequest.validator_address":
 		return x.ValidatorAddress != ""
-	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
-		return x.ReporterAddress != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfoRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7251,17 +7243,15 @@ func (x *fastReflection_QueryIsReporterRequest) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryIsReporterRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryValidatorReportInfoRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
+	case "band.oracle.v1.QueryValidatorReportInfoRequest.validator_address":
 		x.ValidatorAddress = ""
-	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
-		x.ReporterAddress = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfoRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7271,19 +7261,16 @@ func (x *fastReflection_QueryIsReporterRequest) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryIsReporterRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorReportInfoRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
+	case "band.oracle.v1.QueryValidatorReportInfoRequest.validator_address":
 		value := x.ValidatorAddress
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
-		value := x.ReporterAddress
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfoRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfoRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7297,17 +7284,15 @@ func (x *fastReflection_QueryIsReporterRequest) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryIsReporterRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryValidatorReportInfoRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
+	case "band.oracle.v1.QueryValidatorReportInfoRequest.validator_address":
 		x.ValidatorAddress = value.Interface().(string)
-	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
-		x.ReporterAddress = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfoRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7321,44 +7306,40 @@ func (x *fastReflection_QueryIsReporterRequest) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryIsReporterRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorReportInfoRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
-		panic(fmt.Errorf("field validator_address of message band.oracle.v1.QueryIsReporterRequest is not mutable"))
-	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
-		panic(fmt.Errorf("field reporter_address of message band.oracle.v1.QueryIsReporterRequest is not mutable"))
+	case "band.oracle.v1.QueryValidatorReportInfoRequest.validator_address":
+		panic(fmt.Errorf("field validator_address of message band.oracle.v1.QueryValidatorReportInfoRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfoRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryIsReporterRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorReportInfoRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
+	case "band.oracle.v1.QueryValidatorReportInfoRequest.validator_address":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfoRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryIsReporterRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryValidatorReportInfoRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryIsReporterRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryValidatorReportInfoRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7366,7 +7347,7 @@ func (x *fastReflection_QueryIsReporterRequest) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryIsReporterRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryValidatorReportInfoRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7377,7 +7358,7 @@ func (x *fastReflection_QueryIsReporterRequest) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryIsReporterRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryValidatorReportInfoRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7389,7 +7370,7 @@ func (x *fastReflection_QueryIsReporterRequest) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryIsReporterRequest) IsValid() bool {
+func (x *fastReflection_QueryValidatorReportInfoRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7399,9 +7380,9 @@ func (x *fastReflection_QueryIsReporterRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryIsReporterRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryValidatorReportInfoRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryIsReporterRequest)
+		x := input.Message.Interface().(*QueryValidatorReportInfoRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7417,10 +7398,6 @@ func (x *fastReflection_QueryIsReporterRequest) ProtoMethods() *protoiface.Metho
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ReporterAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7431,7 +7408,7 @@ func (x *fastReflection_QueryIsReporterRequest) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryIsReporterRequest)
+		x := input.Message.Interface().(*QueryValidatorReportInfoRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7450,13 +7427,6 @@ func (x *fastReflection_QueryIsReporterRequest) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ReporterAddress) > 0 {
-			i -= len(x.ReporterAddress)
-			copy(dAtA[i:], x.ReporterAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReporterAddress)))
-			i--
-			dAtA[i] = 0x12
-		}
 		if len(x.ValidatorAddress) > 0 {
 			i -= len(x.ValidatorAddress)
 			copy(dAtA[i:], x.ValidatorAddress)
@@ -7475,7 +7445,7 @@ func (x *fastReflection_QueryIsReporterRequest) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryIsReporterRequest)
+		x := input.Message.Interface().(*QueryValidatorReportInfoRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7507,10 +7477,10 @@ func (x *fastReflection_QueryIsReporterRequest) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryIsReporterRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorReportInfoRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryIsReporterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorReportInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -7545,38 +7515,6 @@ func (x *fastReflection_QueryIsReporterRequest) ProtoMethods() *protoiface.Metho
 				}
 				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReporterAddress", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ReporterAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7613,25 +7551,25 @@ func (x *fastReflection_QueryIsReporterRequest) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_QueryIsReporterResponse             protoreflect.MessageDescriptor
-	fd_QueryIsReporterResponse_is_reporter protoreflect.FieldDescriptor
+	md_QueryValidatorReportInfoResponse             protoreflect.MessageDescriptor
+	fd_QueryValidatorReportInfoResponse_report_info protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryIsReporterResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryIsReporterResponse")
-	fd_QueryIsReporterResponse_is_reporter = md_QueryIsReporterResponse.Fields().ByName("is_reporter")
+	md_QueryValidatorReportInfoResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryValidatorReportInfoResponse")
+	fd_QueryValidatorReportInfoResponse_report_info = md_QueryValidatorReportInfoResponse.Fields().ByName("report_info")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryIsReporterResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryValidatorReportInfoResponse)(nil)
 
-type fastReflection_QueryIsReporterResponse QueryIsReporterResponse
+type fastReflection_QueryValidatorReportInfoResponse QueryValidatorReportInfoResponse
 
-func (x *QueryIsReporterResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryIsReporterResponse)(x)
+func (x *QueryValidatorReportInfoResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorReportInfoResponse)(x)
 }
 
-func (x *QueryIsReporterResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryValidatorReportInfoResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7643,43 +7581,43 @@ func (x *QueryIsReporterResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryIsReporterResponse_messageType fastReflection_QueryIsReporterResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryIsReporterResponse_messageType{}
+var _fastReflection_QueryValidatorReportInfoResponse_messageType fastReflection_QueryValidatorReportInfoResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorReportInfoResponse_messageType{}
 
-type fastReflection_QueryIsReporterResponse_messageType struct{}
+type fastReflection_QueryValidatorReportInfoResponse_messageType struct{}
 
-func (x fastReflection_QueryIsReporterResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryIsReporterResponse)(nil)
+func (x fastReflection_QueryValidatorReportInfoResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorReportInfoResponse)(nil)
 }
-func (x fastReflection_QueryIsReporterResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryIsReporterResponse)
+func (x fastReflection_QueryValidatorReportInfoResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorReportInfoResponse)
 }
-func (x fastReflection_QueryIsReporterResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryIsReporterResponse
+func (x fastReflection_QueryValidatorReportInfoResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorReportInfoResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryIsReporterResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryIsReporterResponse
+func (x *fastReflection_QueryValidatorReportInfoResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorReportInfoResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryIsReporterResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryIsReporterResponse_messageType
+func (x *fastReflection_QueryValidatorReportInfoResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorReportInfoResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryIsReporterResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryIsReporterResponse)
+func (x *fastReflection_QueryValidatorReportInfoResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorReportInfoResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryIsReporterResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryIsReporterResponse)(x)
+func (x *fastReflection_QueryValidatorReportInfoResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorReportInfoResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7687,10 +7625,10 @@ func (x *fastReflection_QueryIsReporterResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryIsReporterResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.IsReporter != false {
-		value := protoreflect.ValueOfBool(x.IsReporter)
-		if !f(fd_QueryIsReporterResponse_is_reporter, value) {
+func (x *fastReflection_QueryValidatorReportInfoResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ReportInfo != nil {
+		value := protoreflect.ValueOfMessage(x.ReportInfo.ProtoReflect())
+		if !f(fd_QueryValidatorReportInfoResponse_report_info, value) {
 			return
 		}
 	}
@@ -7707,15 +7645,15 @@ func (x *fastReflection_QueryIsReporterResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryIsReporterResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryValidatorReportInfoResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
-		return x.IsReporter != false
+	case "band.oracle.v1.QueryValidatorReportInfoResponse.report_info":
+		return x.ReportInfo != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfoResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7725,15 +7663,15 @@ func (x *fastReflection_QueryIsReporterResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryIsReporterResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryValidatorReportInfoResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
-		x.IsReporter = false
+	case "band.oracle.v1.QueryValidatorReportInfoResponse.report_info":
+		x.ReportInfo = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfoResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7743,16 +7681,16 @@ func (x *fastReflection_QueryIsReporterResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryIsReporterResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorReportInfoResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
-		value := x.IsReporter
-		return protoreflect.ValueOfBool(value)
+	case "band.oracle.v1.QueryValidatorReportInfoResponse.report_info":
+		value := x.ReportInfo
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfoResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfoResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7766,15 +7704,15 @@ func (x *fastReflection_QueryIsReporterResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryIsReporterResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryValidatorReportInfoResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
-		x.IsReporter = value.Bool()
+	case "band.oracle.v1.QueryValidatorReportInfoResponse.report_info":
+		x.ReportInfo = value.Message().Interface().(*ValidatorReportInfo)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfoResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7788,40 +7726,44 @@ func (x *fastReflection_QueryIsReporterResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryIsReporterResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorReportInfoResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
-		panic(fmt.Errorf("field is_reporter of message band.oracle.v1.QueryIsReporterResponse is not mutable"))
+	case "band.oracle.v1.QueryValidatorReportInfoResponse.report_info":
+		if x.ReportInfo == nil {
+			x.ReportInfo = new(ValidatorReportInfo)
+		}
+		return protoreflect.ValueOfMessage(x.ReportInfo.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfoResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryIsReporterResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorReportInfoResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
-		return protoreflect.ValueOfBool(false)
+	case "band.oracle.v1.QueryValidatorReportInfoResponse.report_info":
+		m := new(ValidatorReportInfo)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfoResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryIsReporterResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryValidatorReportInfoResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryIsReporterResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryValidatorReportInfoResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7829,7 +7771,7 @@ func (x *fastReflection_QueryIsReporterResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryIsReporterResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryValidatorReportInfoResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7840,7 +7782,7 @@ func (x *fastReflection_QueryIsReporterResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryIsReporterResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryValidatorReportInfoResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7852,7 +7794,7 @@ func (x *fastReflection_QueryIsReporterResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryIsReporterResponse) IsValid() bool {
+func (x *fastReflection_QueryValidatorReportInfoResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7862,9 +7804,9 @@ func (x *fastReflection_QueryIsReporterResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryIsReporterResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryValidatorReportInfoResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryIsReporterResponse)
+		x := input.Message.Interface().(*QueryValidatorReportInfoResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7876,8 +7818,9 @@ func (x *fastReflection_QueryIsReporterResponse) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if x.IsReporter {
-			n += 2
+		if x.ReportInfo != nil {
+			l = options.Size(x.ReportInfo)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7889,7 +7832,7 @@ func (x *fastReflection_QueryIsReporterResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryIsReporterResponse)
+		x := input.Message.Interface().(*QueryValidatorReportInfoResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7908,15 +7851,19 @@ func (x *fastReflection_QueryIsReporterResponse) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.IsReporter {
-			i--
-			if x.IsReporter {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+		if x.ReportInfo != nil {
+			encoded, err := options.Marshal(x.ReportInfo)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7929,7 +7876,7 @@ func (x *fastReflection_QueryIsReporterResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryIsReporterResponse)
+		x := input.Message.Interface().(*QueryValidatorReportInfoResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7961,17 +7908,17 @@ func (x *fastReflection_QueryIsReporterResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryIsReporterResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorReportInfoResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryIsReporterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorReportInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsReporter", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportInfo", wireType)
 				}
-				var v int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7981,12 +7928,28 @@ func (x *fastReflection_QueryIsReporterResponse) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.IsReporter = bool(v != 0)
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ReportInfo == nil {
+					x.ReportInfo = &ValidatorReportInfo{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReportInfo); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8023,25 +7986,25 @@ func (x *fastReflection_QueryIsReporterResponse) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_QueryReportersRequest                   protoreflect.MessageDescriptor
-	fd_QueryReportersRequest_validator_address protoreflect.FieldDescriptor
+	md_QueryValidatorReportInfosRequest            protoreflect.MessageDescriptor
+	fd_QueryValidatorReportInfosRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryReportersRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryReportersRequest")
-	fd_QueryReportersRequest_validator_address = md_QueryReportersRequest.Fields().ByName("validator_address")
+	md_QueryValidatorReportInfosRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryValidatorReportInfosRequest")
+	fd_QueryValidatorReportInfosRequest_pagination = md_QueryValidatorReportInfosRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryReportersRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryValidatorReportInfosRequest)(nil)
 
-type fastReflection_QueryReportersRequest QueryReportersRequest
+type fastReflection_QueryValidatorReportInfosRequest QueryValidatorReportInfosRequest
 
-func (x *QueryReportersRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryReportersRequest)(x)
+func (x *QueryValidatorReportInfosRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorReportInfosRequest)(x)
 }
 
-func (x *QueryReportersRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryValidatorReportInfosRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8053,43 +8016,43 @@ func (x *QueryReportersRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryReportersRequest_messageType fastReflection_QueryReportersRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryReportersRequest_messageType{}
+var _fastReflection_QueryValidatorReportInfosRequest_messageType fastReflection_QueryValidatorReportInfosRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorReportInfosRequest_messageType{}
 
-type fastReflection_QueryReportersRequest_messageType struct{}
+type fastReflection_QueryValidatorReportInfosRequest_messageType struct{}
 
-func (x fastReflection_QueryReportersRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryReportersRequest)(nil)
+func (x fastReflection_QueryValidatorReportInfosRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorReportInfosRequest)(nil)
 }
-func (x fastReflection_QueryReportersRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryReportersRequest)
+func (x fastReflection_QueryValidatorReportInfosRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorReportInfosRequest)
 }
-func (x fastReflection_QueryReportersRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryReportersRequest
+func (x fastReflection_QueryValidatorReportInfosRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorReportInfosRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryReportersRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryReportersRequest
+func (x *fastReflection_QueryValidatorReportInfosRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorReportInfosRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryReportersRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryReportersRequest_messageType
+func (x *fastReflection_QueryValidatorReportInfosRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorReportInfosRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryReportersRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryReportersRequest)
+func (x *fastReflection_QueryValidatorReportInfosRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorReportInfosRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryReportersRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryReportersRequest)(x)
+func (x *fastReflection_QueryValidatorReportInfosRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorReportInfosRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8097,10 +8060,10 @@ func (x *fastReflection_QueryReportersRequest) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryReportersRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ValidatorAddress != "" {
-		value := protoreflect.ValueOfString(x.ValidatorAddress)
-		if !f(fd_QueryReportersRequest_validator_address, value) {
+func (x *fastReflection_QueryValidatorReportInfosRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryValidatorReportInfosRequest_pagination, value) {
 			return
 		}
 	}
@@ -8117,15 +8080,15 @@ func (x *fastReflection_QueryReportersRequest) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryReportersRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryValidatorReportInfosRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryReportersRequest.validator_address":
-		return x.ValidatorAddress != ""
+	case "band.oracle.v1.QueryValidatorReportInfosRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfosRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfosRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8135,15 +8098,15 @@ func (x *fastReflection_QueryReportersRequest) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReportersRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryValidatorReportInfosRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryReportersRequest.validator_address":
-		x.ValidatorAddress = ""
+	case "band.oracle.v1.QueryValidatorReportInfosRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfosRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfosRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8153,16 +8116,16 @@ func (x *fastReflection_QueryReportersRequest) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryReportersRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorReportInfosRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryReportersRequest.validator_address":
-		value := x.ValidatorAddress
-		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.QueryValidatorReportInfosRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfosRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfosRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8176,15 +8139,15 @@ func (x *fastReflection_QueryReportersRequest) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReportersRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryValidatorReportInfosRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryReportersRequest.validator_address":
-		x.ValidatorAddress = value.Interface().(string)
+	case "band.oracle.v1.QueryValidatorReportInfosRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfosRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfosRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8198,40 +8161,44 @@ func (x *fastReflection_QueryReportersRequest) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReportersRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorReportInfosRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryReportersRequest.validator_address":
-		panic(fmt.Errorf("field validator_address of message band.oracle.v1.QueryReportersRequest is not mutable"))
+	case "band.oracle.v1.QueryValidatorReportInfosRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfosRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfosRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryReportersRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorReportInfosRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryReportersRequest.validator_address":
-		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.QueryValidatorReportInfosRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfosRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfosRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryReportersRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryValidatorReportInfosRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryReportersRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryValidatorReportInfosRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8239,7 +8206,7 @@ func (x *fastReflection_QueryReportersRequest) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryReportersRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryValidatorReportInfosRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8250,7 +8217,7 @@ func (x *fastReflection_QueryReportersRequest) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReportersRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryValidatorReportInfosRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8262,7 +8229,7 @@ func (x *fastReflection_QueryReportersRequest) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryReportersRequest) IsValid() bool {
+func (x *fastReflection_QueryValidatorReportInfosRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -8272,9 +8239,9 @@ func (x *fastReflection_QueryReportersRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryReportersRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryValidatorReportInfosRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryReportersRequest)
+		x := input.Message.Interface().(*QueryValidatorReportInfosRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8286,8 +8253,8 @@ func (x *fastReflection_QueryReportersRequest) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.ValidatorAddress)
-		if l > 0 {
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -8300,7 +8267,7 @@ func (x *fastReflection_QueryReportersRequest) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryReportersRequest)
+		x := input.Message.Interface().(*QueryValidatorReportInfosRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8319,10 +8286,17 @@ func (x *fastReflection_QueryReportersRequest) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ValidatorAddress) > 0 {
-			i -= len(x.ValidatorAddress)
-			copy(dAtA[i:], x.ValidatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -8337,7 +8311,7 @@ func (x *fastReflection_QueryReportersRequest) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryReportersRequest)
+		x := input.Message.Interface().(*QueryValidatorReportInfosRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8369,17 +8343,17 @@ func (x *fastReflection_QueryReportersRequest) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReportersRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorReportInfosRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReportersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorReportInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8389,23 +8363,27 @@ func (x *fastReflection_QueryReportersRequest) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8442,72 +8420,79 @@ func (x *fastReflection_QueryReportersRequest) ProtoMethods() *protoiface.Method
 	}
 }
 
-var _ protoreflect.List = (*_QueryReportersResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryValidatorReportInfosResponse_1_list)(nil)
 
-type _QueryReportersResponse_1_list struct {
-	list *[]string
+type _QueryValidatorReportInfosResponse_1_list struct {
+	list *[]*ValidatorReportInfo
 }
 
-func (x *_QueryReportersResponse_1_list) Len() int {
+func (x *_QueryValidatorReportInfosResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryReportersResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+func (x *_QueryValidatorReportInfosResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryReportersResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_QueryValidatorReportInfosResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorReportInfo)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryReportersResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_QueryValidatorReportInfosResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorReportInfo)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryReportersResponse_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message QueryReportersResponse at list field Reporter as it is not of Message kind"))
+func (x *_QueryValidatorReportInfosResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorReportInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryReportersResponse_1_list) Truncate(n int) {
+func (x *_QueryValidatorReportInfosResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryReportersResponse_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *_QueryValidatorReportInfosResponse_1_list) NewElement() protoreflect.Value {
+	v := new(ValidatorReportInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryReportersResponse_1_list) IsValid() bool {
+func (x *_QueryValidatorReportInfosResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryReportersResponse          protoreflect.MessageDescriptor
-	fd_QueryReportersResponse_reporter protoreflect.FieldDescriptor
+	md_QueryValidatorReportInfosResponse              protoreflect.MessageDescriptor
+	fd_QueryValidatorReportInfosResponse_report_infos protoreflect.FieldDescriptor
+	fd_QueryValidatorReportInfosResponse_pagination   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryReportersResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryReportersResponse")
-	fd_QueryReportersResponse_reporter = md_QueryReportersResponse.Fields().ByName("reporter")
+	md_QueryValidatorReportInfosResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryValidatorReportInfosResponse")
+	fd_QueryValidatorReportInfosResponse_report_infos = md_QueryValidatorReportInfosResponse.Fields().ByName("report_infos")
+	fd_QueryValidatorReportInfosResponse_pagination = md_QueryValidatorReportInfosResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryReportersResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryValidatorReportInfosResponse)(nil)
 
-type fastReflection_QueryReportersResponse QueryReportersResponse
+type fastReflection_QueryValidatorReportInfosResponse QueryValidatorReportInfosResponse
 
-func (x *QueryReportersResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryReportersResponse)(x)
+func (x *QueryValidatorReportInfosResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorReportInfosResponse)(x)
 }
 
-func (x *QueryReportersResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryValidatorReportInfosResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8519,43 +8504,43 @@ func (x *QueryReportersResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryReportersResponse_messageType fastReflection_QueryReportersResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryReportersResponse_messageType{}
+var _fastReflection_QueryValidatorReportInfosResponse_messageType fastReflection_QueryValidatorReportInfosResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorReportInfosResponse_messageType{}
 
-type fastReflection_QueryReportersResponse_messageType struct{}
+type fastReflection_QueryValidatorReportInfosResponse_messageType struct{}
 
-func (x fastReflection_QueryReportersResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryReportersResponse)(nil)
+func (x fastReflection_QueryValidatorReportInfosResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorReportInfosResponse)(nil)
 }
-func (x fastReflection_QueryReportersResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryReportersResponse)
+func (x fastReflection_QueryValidatorReportInfosResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorReportInfosResponse)
 }
-func (x fastReflection_QueryReportersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryReportersResponse
+func (x fastReflection_QueryValidatorReportInfosResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorReportInfosResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryReportersResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryReportersResponse
+func (x *fastReflection_QueryValidatorReportInfosResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorReportInfosResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryReportersResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryReportersResponse_messageType
+func (x *fastReflection_QueryValidatorReportInfosResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorReportInfosResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryReportersResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryReportersResponse)
+func (x *fastReflection_QueryValidatorReportInfosResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorReportInfosResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryReportersResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryReportersResponse)(x)
+func (x *fastReflection_QueryValidatorReportInfosResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorReportInfosResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8563,10 +8548,16 @@ func (x *fastReflection_QueryReportersResponse) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryReportersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Reporter) != 0 {
-		value := protoreflect.ValueOfList(&_QueryReportersResponse_1_list{list: &x.Reporter})
-		if !f(fd_QueryReportersResponse_reporter, value) {
+func (x *fastReflection_QueryValidatorReportInfosResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ReportInfos) != 0 {
+		value := protoreflect.ValueOfList(&_QueryValidatorReportInfosResponse_1_list{list: &x.ReportInfos})
+		if !f(fd_QueryValidatorReportInfosResponse_report_infos, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryValidatorReportInfosResponse_pagination, value) {
 			return
 		}
 	}
@@ -8583,15 +8574,17 @@ func (x *fastReflection_QueryReportersResponse) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryReportersResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryValidatorReportInfosResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryReportersResponse.reporter":
-		return len(x.Reporter) != 0
+	case "band.oracle.v1.QueryValidatorReportInfosResponse.report_infos":
+		return len(x.ReportInfos) != 0
+	case "band.oracle.v1.QueryValidatorReportInfosResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfosResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfosResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8601,15 +8594,17 @@ func (x *fastReflection_QueryReportersResponse) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReportersResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryValidatorReportInfosResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryReportersResponse.reporter":
-		x.Reporter = nil
+	case "band.oracle.v1.QueryValidatorReportInfosResponse.report_infos":
+		x.ReportInfos = nil
+	case "band.oracle.v1.QueryValidatorReportInfosResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfosResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfosResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8619,19 +8614,22 @@ func (x *fastReflection_QueryReportersResponse) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryReportersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorReportInfosResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryReportersResponse.reporter":
-		if len(x.Reporter) == 0 {
-			return protoreflect.ValueOfList(&_QueryReportersResponse_1_list{})
+	case "band.oracle.v1.QueryValidatorReportInfosResponse.report_infos":
+		if len(x.ReportInfos) == 0 {
+			return protoreflect.ValueOfList(&_QueryValidatorReportInfosResponse_1_list{})
 		}
-		listValue := &_QueryReportersResponse_1_list{list: &x.Reporter}
+		listValue := &_QueryValidatorReportInfosResponse_1_list{list: &x.ReportInfos}
 		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.QueryValidatorReportInfosResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfosResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfosResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8645,17 +8643,19 @@ func (x *fastReflection_QueryReportersResponse) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReportersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryValidatorReportInfosResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryReportersResponse.reporter":
+	case "band.oracle.v1.QueryValidatorReportInfosResponse.report_infos":
 		lv := value.List()
-		clv := lv.(*_QueryReportersResponse_1_list)
-		x.Reporter = *clv.list
+		clv := lv.(*_QueryValidatorReportInfosResponse_1_list)
+		x.ReportInfos = *clv.list
+	case "band.oracle.v1.QueryValidatorReportInfosResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfosResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfosResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8669,45 +8669,53 @@ func (x *fastReflection_QueryReportersResponse) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReportersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorReportInfosResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryReportersResponse.reporter":
-		if x.Reporter == nil {
-			x.Reporter = []string{}
+	case "band.oracle.v1.QueryValidatorReportInfosResponse.report_infos":
+		if x.ReportInfos == nil {
+			x.ReportInfos = []*ValidatorReportInfo{}
 		}
-		value := &_QueryReportersResponse_1_list{list: &x.Reporter}
+		value := &_QueryValidatorReportInfosResponse_1_list{list: &x.ReportInfos}
 		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.QueryValidatorReportInfosResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfosResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfosResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryReportersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorReportInfosResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryReportersResponse.reporter":
-		list := []string{}
-		return protoreflect.ValueOfList(&_QueryReportersResponse_1_list{list: &list})
+	case "band.oracle.v1.QueryValidatorReportInfosResponse.report_infos":
+		list := []*ValidatorReportInfo{}
+		return protoreflect.ValueOfList(&_QueryValidatorReportInfosResponse_1_list{list: &list})
+	case "band.oracle.v1.QueryValidatorReportInfosResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryValidatorReportInfosResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryValidatorReportInfosResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryReportersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryValidatorReportInfosResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryReportersResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryValidatorReportInfosResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8715,7 +8723,7 @@ func (x *fastReflection_QueryReportersResponse) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryReportersResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryValidatorReportInfosResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8726,7 +8734,7 @@ func (x *fastReflection_QueryReportersResponse) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryReportersResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryValidatorReportInfosResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8738,7 +8746,7 @@ func (x *fastReflection_QueryReportersResponse) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryReportersResponse) IsValid() bool {
+func (x *fastReflection_QueryValidatorReportInfosResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8748,9 +8756,9 @@ func (x *fastReflection_QueryReportersResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryReportersResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryValidatorReportInfosResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryReportersResponse)
+		x := input.Message.Interface().(*QueryValidatorReportInfosResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8762,12 +8770,16 @@ func (x *fastReflection_QueryReportersResponse) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if len(x.Reporter) > 0 {
-			for _, s := range x.Reporter {
-				l = len(s)
+		if len(x.ReportInfos) > 0 {
+			for _, e := range x.ReportInfos {
+				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8778,7 +8790,7 @@ func (x *fastReflection_QueryReportersResponse) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryReportersResponse)
+		x := input.Message.Interface().(*QueryValidatorReportInfosResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8797,11 +8809,32 @@ func (x *fastReflection_QueryReportersResponse) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Reporter) > 0 {
-			for iNdEx := len(x.Reporter) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Reporter[iNdEx])
-				copy(dAtA[i:], x.Reporter[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reporter[iNdEx])))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ReportInfos) > 0 {
+			for iNdEx := len(x.ReportInfos) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReportInfos[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
 				dAtA[i] = 0xa
 			}
@@ -8817,7 +8850,7 @@ func (x *fastReflection_QueryReportersResponse) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryReportersResponse)
+		x := input.Message.Interface().(*QueryValidatorReportInfosResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8849,17 +8882,17 @@ func (x *fastReflection_QueryReportersResponse) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReportersResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorReportInfosResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReportersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorReportInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportInfos", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8869,32 +8902,70 @@ func (x *fastReflection_QueryReportersResponse) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Reporter = append(x.Reporter, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+				x.ReportInfos = append(x.ReportInfos, &ValidatorReportInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReportInfos[len(x.ReportInfos)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
@@ -8923,23 +8994,27 @@ func (x *fastReflection_QueryReportersResponse) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_QueryActiveValidatorsRequest protoreflect.MessageDescriptor
+	md_QueryIsReporterRequest                   protoreflect.MessageDescriptor
+	fd_QueryIsReporterRequest_validator_address protoreflect.FieldDescriptor
+	fd_QueryIsReporterRequest_reporter_address  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryActiveValidatorsRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryActiveValidatorsRequest")
+	md_QueryIsReporterRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryIsReporterRequest")
+	fd_QueryIsReporterRequest_validator_address = md_QueryIsReporterRequest.Fields().ByName("validator_address")
+	fd_QueryIsReporterRequest_reporter_address = md_QueryIsReporterRequest.Fields().ByName("reporter_address")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryActiveValidatorsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryIsReporterRequest)(nil)
 
-type fastReflection_QueryActiveValidatorsRequest QueryActiveValidatorsRequest
+type fastReflection_QueryIsReporterRequest QueryIsReporterRequest
 
-func (x *QueryActiveValidatorsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryActiveValidatorsRequest)(x)
+func (x *QueryIsReporterRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryIsReporterRequest)(x)
 }
 
-func (x *QueryActiveValidatorsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryIsReporterRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8951,43 +9026,43 @@ func (x *QueryActiveValidatorsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryActiveValidatorsRequest_messageType fastReflection_QueryActiveValidatorsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryActiveValidatorsRequest_messageType{}
+var _fastReflection_QueryIsReporterRequest_messageType fastReflection_QueryIsReporterRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryIsReporterRequest_messageType{}
 
-type fastReflection_QueryActiveValidatorsRequest_messageType struct{}
+type fastReflection_QueryIsReporterRequest_messageType struct{}
 
-func (x fastReflection_QueryActiveValidatorsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryActiveValidatorsRequest)(nil)
+func (x fastReflection_QueryIsReporterRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryIsReporterRequest)(nil)
 }
-func (x fastReflection_QueryActiveValidatorsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryActiveValidatorsRequest)
+func (x fastReflection_QueryIsReporterRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryIsReporterRequest)
 }
-func (x fastReflection_QueryActiveValidatorsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActiveValidatorsRequest
+func (x fastReflection_QueryIsReporterRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryIsReporterRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryActiveValidatorsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActiveValidatorsRequest
+func (x *fastReflection_QueryIsReporterRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryIsReporterRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryActiveValidatorsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryActiveValidatorsRequest_messageType
+func (x *fastReflection_QueryIsReporterRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryIsReporterRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryActiveValidatorsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryActiveValidatorsRequest)
+func (x *fastReflection_QueryIsReporterRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryIsReporterRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryActiveValidatorsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryActiveValidatorsRequest)(x)
+func (x *fastReflection_QueryIsReporterRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryIsReporterRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8995,7 +9070,19 @@ func (x *fastReflection_QueryActiveValidatorsRequest) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryActiveValidatorsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryIsReporterRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_QueryIsReporterRequest_validator_address, value) {
+			return
+		}
+	}
+	if x.ReporterAddress != "" {
+		value := protoreflect.ValueOfString(x.ReporterAddress)
+		if !f(fd_QueryIsReporterRequest_reporter_address, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -9009,13 +9096,17 @@ func (x *fastReflection_QueryActiveValidatorsRequest) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryActiveValidatorsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryIsReporterRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
+		return x.ValidatorAddress != ""
+	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
+		return x.ReporterAddress != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9025,13 +9116,17 @@ func (x *fastReflection_QueryActiveValidatorsRequest) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveValidatorsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryIsReporterRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
+		x.ValidatorAddress = ""
+	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
+		x.ReporterAddress = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9041,13 +9136,19 @@ func (x *fastReflection_QueryActiveValidatorsRequest) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryActiveValidatorsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryIsReporterRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
+		value := x.ReporterAddress
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9061,13 +9162,17 @@ func (x *fastReflection_QueryActiveValidatorsRequest) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveValidatorsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryIsReporterRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
+		x.ReporterAddress = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9081,36 +9186,44 @@ func (x *fastReflection_QueryActiveValidatorsRequest) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveValidatorsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryIsReporterRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
+		panic(fmt.Errorf("field validator_address of message band.oracle.v1.QueryIsReporterRequest is not mutable"))
+	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
+		panic(fmt.Errorf("field reporter_address of message band.oracle.v1.QueryIsReporterRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryActiveValidatorsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryIsReporterRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.oracle.v1.QueryIsReporterRequest.validator_address":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.QueryIsReporterRequest.reporter_address":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryActiveValidatorsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryIsReporterRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryActiveValidatorsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryIsReporterRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9118,7 +9231,7 @@ func (x *fastReflection_QueryActiveValidatorsRequest) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryActiveValidatorsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryIsReporterRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9129,7 +9242,7 @@ func (x *fastReflection_QueryActiveValidatorsRequest) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveValidatorsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryIsReporterRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9141,7 +9254,7 @@ func (x *fastReflection_QueryActiveValidatorsRequest) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryActiveValidatorsRequest) IsValid() bool {
+func (x *fastReflection_QueryIsReporterRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -9151,9 +9264,9 @@ func (x *fastReflection_QueryActiveValidatorsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryActiveValidatorsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryIsReporterRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryActiveValidatorsRequest)
+		x := input.Message.Interface().(*QueryIsReporterRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9165,6 +9278,14 @@ func (x *fastReflection_QueryActiveValidatorsRequest) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReporterAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9175,7 +9296,7 @@ func (x *fastReflection_QueryActiveValidatorsRequest) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActiveValidatorsRequest)
+		x := input.Message.Interface().(*QueryIsReporterRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9194,6 +9315,20 @@ func (x *fastReflection_QueryActiveValidatorsRequest) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ReporterAddress) > 0 {
+			i -= len(x.ReporterAddress)
+			copy(dAtA[i:], x.ReporterAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReporterAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -9205,7 +9340,7 @@ func (x *fastReflection_QueryActiveValidatorsRequest) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActiveValidatorsRequest)
+		x := input.Message.Interface().(*QueryIsReporterRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9237,34 +9372,98 @@ func (x *fastReflection_QueryActiveValidatorsRequest) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveValidatorsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryIsReporterRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryIsReporterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReporterAddress", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReporterAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
 		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
 	}
 	return &protoiface.Methods{
@@ -9278,77 +9477,26 @@ func (x *fastReflection_QueryActiveValidatorsRequest) ProtoMethods() *protoiface
 	}
 }
 
-var _ protoreflect.List = (*_QueryActiveValidatorsResponse_1_list)(nil)
-
-type _QueryActiveValidatorsResponse_1_list struct {
-	list *[]*ActiveValidator
-}
-
-func (x *_QueryActiveValidatorsResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryActiveValidatorsResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryActiveValidatorsResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ActiveValidator)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryActiveValidatorsResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ActiveValidator)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryActiveValidatorsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(ActiveValidator)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryActiveValidatorsResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryActiveValidatorsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(ActiveValidator)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryActiveValidatorsResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryActiveValidatorsResponse            protoreflect.MessageDescriptor
-	fd_QueryActiveValidatorsResponse_validators protoreflect.FieldDescriptor
+	md_QueryIsReporterResponse             protoreflect.MessageDescriptor
+	fd_QueryIsReporterResponse_is_reporter protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryActiveValidatorsResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryActiveValidatorsResponse")
-	fd_QueryActiveValidatorsResponse_validators = md_QueryActiveValidatorsResponse.Fields().ByName("validators")
+	md_QueryIsReporterResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryIsReporterResponse")
+	fd_QueryIsReporterResponse_is_reporter = md_QueryIsReporterResponse.Fields().ByName("is_reporter")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryActiveValidatorsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryIsReporterResponse)(nil)
 
-type fastReflection_QueryActiveValidatorsResponse QueryActiveValidatorsResponse
+type fastReflection_QueryIsReporterResponse QueryIsReporterResponse
 
-func (x *QueryActiveValidatorsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryActiveValidatorsResponse)(x)
+func (x *QueryIsReporterResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryIsReporterResponse)(x)
 }
 
-func (x *QueryActiveValidatorsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryIsReporterResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9360,43 +9508,43 @@ func (x *QueryActiveValidatorsResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryActiveValidatorsResponse_messageType fastReflection_QueryActiveValidatorsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryActiveValidatorsResponse_messageType{}
+var _fastReflection_QueryIsReporterResponse_messageType fastReflection_QueryIsReporterResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryIsReporterResponse_messageType{}
 
-type fastReflection_QueryActiveValidatorsResponse_messageType struct{}
+type fastReflection_QueryIsReporterResponse_messageType struct{}
 
-func (x fastReflection_QueryActiveValidatorsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryActiveValidatorsResponse)(nil)
+func (x fastReflection_QueryIsReporterResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryIsReporterResponse)(nil)
 }
-func (x fastReflection_QueryActiveValidatorsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryActiveValidatorsResponse)
+func (x fastReflection_QueryIsReporterResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryIsReporterResponse)
 }
-func (x fastReflection_QueryActiveValidatorsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActiveValidatorsResponse
+func (x fastReflection_QueryIsReporterResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryIsReporterResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryActiveValidatorsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActiveValidatorsResponse
+func (x *fastReflection_QueryIsReporterResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryIsReporterResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryActiveValidatorsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryActiveValidatorsResponse_messageType
+func (x *fastReflection_QueryIsReporterResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryIsReporterResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryActiveValidatorsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryActiveValidatorsResponse)
+func (x *fastReflection_QueryIsReporterResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryIsReporterResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryActiveValidatorsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryActiveValidatorsResponse)(x)
+func (x *fastReflection_QueryIsReporterResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryIsReporterResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9404,10 +9552,10 @@ func (x *fastReflection_QueryActiveValidatorsResponse) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryActiveValidatorsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Validators) != 0 {
-		value := protoreflect.ValueOfList(&_QueryActiveValidatorsResponse_1_list{list: &x.Validators})
-		if !f(fd_QueryActiveValidatorsResponse_validators, value) {
+func (x *fastReflection_QueryIsReporterResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.IsReporter != false {
+		value := protoreflect.ValueOfBool(x.IsReporter)
+		if !f(fd_QueryIsReporterResponse_is_reporter, value) {
 			return
 		}
 	}
@@ -9424,15 +9572,15 @@ func (x *fastReflection_QueryActiveValidatorsResponse) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryActiveValidatorsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryIsReporterResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
-		return len(x.Validators) != 0
+	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
+		return x.IsReporter != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9442,15 +9590,15 @@ func (x *fastReflection_QueryActiveValidatorsResponse) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveValidatorsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryIsReporterResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
-		x.Validators = nil
+	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
+		x.IsReporter = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9460,19 +9608,16 @@ func (x *fastReflection_QueryActiveValidatorsResponse) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryActiveValidatorsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryIsReporterResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
-		if len(x.Validators) == 0 {
-			return protoreflect.ValueOfList(&_QueryActiveValidatorsResponse_1_list{})
-		}
-		listValue := &_QueryActiveValidatorsResponse_1_list{list: &x.Validators}
-		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
+		value := x.IsReporter
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9486,17 +9631,15 @@ func (x *fastReflection_QueryActiveValidatorsResponse) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveValidatorsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryIsReporterResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
-		lv := value.List()
-		clv := lv.(*_QueryActiveValidatorsResponse_1_list)
-		x.Validators = *clv.list
+	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
+		x.IsReporter = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9510,45 +9653,40 @@ func (x *fastReflection_QueryActiveValidatorsResponse) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveValidatorsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryIsReporterResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
-		if x.Validators == nil {
-			x.Validators = []*ActiveValidator{}
-		}
-		value := &_QueryActiveValidatorsResponse_1_list{list: &x.Validators}
-		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
+		panic(fmt.Errorf("field is_reporter of message band.oracle.v1.QueryIsReporterResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryActiveValidatorsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryIsReporterResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
-		list := []*ActiveValidator{}
-		return protoreflect.ValueOfList(&_QueryActiveValidatorsResponse_1_list{list: &list})
+	case "band.oracle.v1.QueryIsReporterResponse.is_reporter":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryIsReporterResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryIsReporterResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryActiveValidatorsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryIsReporterResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryActiveValidatorsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryIsReporterResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9556,7 +9694,7 @@ func (x *fastReflection_QueryActiveValidatorsResponse) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryActiveValidatorsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryIsReporterResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9567,7 +9705,7 @@ func (x *fastReflection_QueryActiveValidatorsResponse) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveValidatorsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryIsReporterResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9579,7 +9717,7 @@ func (x *fastReflection_QueryActiveValidatorsResponse) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryActiveValidatorsResponse) IsValid() bool {
+func (x *fastReflection_QueryIsReporterResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9589,9 +9727,9 @@ func (x *fastReflection_QueryActiveValidatorsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryActiveValidatorsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryIsReporterResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryActiveValidatorsResponse)
+		x := input.Message.Interface().(*QueryIsReporterResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9603,11 +9741,8 @@ func (x *fastReflection_QueryActiveValidatorsResponse) ProtoMethods() *protoifac
 		var n int
 		var l int
 		_ = l
-		if len(x.Validators) > 0 {
-			for _, e := range x.Validators {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.IsReporter {
+			n += 2
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -9619,7 +9754,7 @@ func (x *fastReflection_QueryActiveValidatorsResponse) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActiveValidatorsResponse)
+		x := input.Message.Interface().(*QueryIsReporterResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9638,21 +9773,15 @@ func (x *fastReflection_QueryActiveValidatorsResponse) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Validators) > 0 {
-			for iNdEx := len(x.Validators) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Validators[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
+		if x.IsReporter {
+			i--
+			if x.IsReporter {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
 			}
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -9665,7 +9794,7 @@ func (x *fastReflection_QueryActiveValidatorsResponse) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActiveValidatorsResponse)
+		x := input.Message.Interface().(*QueryIsReporterResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9697,17 +9826,17 @@ func (x *fastReflection_QueryActiveValidatorsResponse) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveValidatorsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryIsReporterResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryIsReporterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsReporter", wireType)
 				}
-				var msglen int
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9717,26 +9846,12 @@ func (x *fastReflection_QueryActiveValidatorsResponse) ProtoMethods() *protoifac
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Validators = append(x.Validators, &ActiveValidator{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Validators[len(x.Validators)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
+				x.IsReporter = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9773,31 +9888,25 @@ func (x *fastReflection_QueryActiveValidatorsResponse) ProtoMethods() *protoifac
 }
 
 var (
-	md_QueryRequestSearchRequest                  protoreflect.MessageDescriptor
-	fd_QueryRequestSearchRequest_oracle_script_id protoreflect.FieldDescriptor
-	fd_QueryRequestSearchRequest_calldata         protoreflect.FieldDescriptor
-	fd_QueryRequestSearchRequest_ask_count        protoreflect.FieldDescriptor
-	fd_QueryRequestSearchRequest_min_count        protoreflect.FieldDescriptor
+	md_QueryReportersRequest                   protoreflect.MessageDescriptor
+	fd_QueryReportersRequest_validator_address protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryRequestSearchRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestSearchRequest")
-	fd_QueryRequestSearchRequest_oracle_script_id = md_QueryRequestSearchRequest.Fields().ByName("oracle_script_id")
-	fd_QueryRequestSearchRequest_calldata = md_QueryRequestSearchRequest.Fields().ByName("calldata")
-	fd_QueryRequestSearchRequest_ask_count = md_QueryRequestSearchRequest.Fields().ByName("ask_count")
-	fd_QueryRequestSearchRequest_min_count = md_QueryRequestSearchRequest.Fields().ByName("min_count")
+	md_QueryReportersRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryReportersRequest")
+	fd_QueryReportersRequest_validator_address = md_QueryReportersRequest.Fields().ByName("validator_address")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryRequestSearchRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryReportersRequest)(nil)
 
-type fastReflection_QueryRequestSearchRequest QueryRequestSearchRequest
+type fastReflection_QueryReportersRequest QueryReportersRequest
 
-func (x *QueryRequestSearchRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryRequestSearchRequest)(x)
+func (x *QueryReportersRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryReportersRequest)(x)
 }
 
-func (x *QueryRequestSearchRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryReportersRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9809,43 +9918,43 @@ func (x *QueryRequestSearchRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryRequestSearchRequest_messageType fastReflection_QueryRequestSearchRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryRequestSearchRequest_messageType{}
+var _fastReflection_QueryReportersRequest_messageType fastReflection_QueryReportersRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryReportersRequest_messageType{}
 
-type fastReflection_QueryRequestSearchRequest_messageType struct{}
+type fastReflection_QueryReportersRequest_messageType struct{}
 
-func (x fastReflection_QueryRequestSearchRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryRequestSearchRequest)(nil)
+func (x fastReflection_QueryReportersRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryReportersRequest)(nil)
 }
-func (x fastReflection_QueryRequestSearchRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestSearchRequest)
+func (x fastReflection_QueryReportersRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryReportersRequest)
 }
-func (x fastReflection_QueryRequestSearchRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestSearchRequest
+func (x fastReflection_QueryReportersRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryReportersRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryRequestSearchRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestSearchRequest
+func (x *fastReflection_QueryReportersRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryReportersRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryRequestSearchRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryRequestSearchRequest_messageType
+func (x *fastReflection_QueryReportersRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryReportersRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryRequestSearchRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestSearchRequest)
+func (x *fastReflection_QueryReportersRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryReportersRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryRequestSearchRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryRequestSearchRequest)(x)
+func (x *fastReflection_QueryReportersRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryReportersRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9853,28 +9962,10 @@ func (x *fastReflection_QueryRequestSearchRequest) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryRequestSearchRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.OracleScriptId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OracleScriptId)
-		if !f(fd_QueryRequestSearchRequest_oracle_script_id, value) {
-			return
-		}
-	}
-	if x.Calldata != "" {
-		value := protoreflect.ValueOfString(x.Calldata)
-		if !f(fd_QueryRequestSearchRequest_calldata, value) {
-			return
-		}
-	}
-	if x.AskCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.AskCount)
-		if !f(fd_QueryRequestSearchRequest_ask_count, value) {
-			return
-		}
-	}
-	if x.MinCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MinCount)
-		if !f(fd_QueryRequestSearchRequest_min_count, value) {
+func (x *fastReflection_QueryReportersRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_QueryReportersRequest_validator_address, value) {
 			return
 		}
 	}
@@ -9891,21 +9982,15 @@ func (x *fastReflection_QueryRequestSearchRequest) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryRequestSearchRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryReportersRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
-		return x.OracleScriptId != uint64(0)
-	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
-		return x.Calldata != ""
-	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
-		return x.AskCount != uint64(0)
-	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
-		return x.MinCount != uint64(0)
+	case "band.oracle.v1.QueryReportersRequest.validator_address":
+		return x.ValidatorAddress != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9915,21 +10000,15 @@ func (x *fastReflection_QueryRequestSearchRequest) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestSearchRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryReportersRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
-		x.OracleScriptId = uint64(0)
-	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
-		x.Calldata = ""
-	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
-		x.AskCount = uint64(0)
-	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
-		x.MinCount = uint64(0)
+	case "band.oracle.v1.QueryReportersRequest.validator_address":
+		x.ValidatorAddress = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9939,25 +10018,16 @@ func (x *fastReflection_QueryRequestSearchRequest) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryRequestSearchRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryReportersRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
-		value := x.OracleScriptId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
-		value := x.Calldata
+	case "band.oracle.v1.QueryReportersRequest.validator_address":
+		value := x.ValidatorAddress
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
-		value := x.AskCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
-		value := x.MinCount
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9971,21 +10041,15 @@ func (x *fastReflection_QueryRequestSearchRequest) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestSearchRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryReportersRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
-		x.OracleScriptId = value.Uint()
-	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
-		x.Calldata = value.Interface().(string)
-	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
-		x.AskCount = value.Uint()
-	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
-		x.MinCount = value.Uint()
+	case "band.oracle.v1.QueryReportersRequest.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9999,52 +10063,40 @@ func (x *fastReflection_QueryRequestSearchRequest) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestSearchRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryReportersRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
-		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.QueryRequestSearchRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
-		panic(fmt.Errorf("field calldata of message band.oracle.v1.QueryRequestSearchRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
-		panic(fmt.Errorf("field ask_count of message band.oracle.v1.QueryRequestSearchRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
-		panic(fmt.Errorf("field min_count of message band.oracle.v1.QueryRequestSearchRequest is not mutable"))
+	case "band.oracle.v1.QueryReportersRequest.validator_address":
+		panic(fmt.Errorf("field validator_address of message band.oracle.v1.QueryReportersRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryRequestSearchRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryReportersRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
+	case "band.oracle.v1.QueryReportersRequest.validator_address":
 		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
-		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryRequestSearchRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryReportersRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestSearchRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryReportersRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10052,7 +10104,7 @@ func (x *fastReflection_QueryRequestSearchRequest) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryRequestSearchRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryReportersRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10063,7 +10115,7 @@ func (x *fastReflection_QueryRequestSearchRequest) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestSearchRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryReportersRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10075,7 +10127,7 @@ func (x *fastReflection_QueryRequestSearchRequest) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryRequestSearchRequest) IsValid() bool {
+func (x *fastReflection_QueryReportersRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -10085,9 +10137,9 @@ func (x *fastReflection_QueryRequestSearchRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryRequestSearchRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryReportersRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryRequestSearchRequest)
+		x := input.Message.Interface().(*QueryReportersRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10099,21 +10151,12 @@ func (x *fastReflection_QueryRequestSearchRequest) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if x.OracleScriptId != 0 {
-			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
-		}
-		l = len(x.Calldata)
+		l = len(x.ValidatorAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.AskCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.AskCount))
-		}
-		if x.MinCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.MinCount))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
 		}
 		return protoiface.SizeOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10122,7 +10165,7 @@ func (x *fastReflection_QueryRequestSearchRequest) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestSearchRequest)
+		x := input.Message.Interface().(*QueryReportersRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10141,27 +10184,12 @@ func (x *fastReflection_QueryRequestSearchRequest) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MinCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.AskCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.Calldata) > 0 {
-			i -= len(x.Calldata)
-			copy(dAtA[i:], x.Calldata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.OracleScriptId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -10174,7 +10202,7 @@ func (x *fastReflection_QueryRequestSearchRequest) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestSearchRequest)
+		x := input.Message.Interface().(*QueryReportersRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10206,34 +10234,15 @@ func (x *fastReflection_QueryRequestSearchRequest) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSearchRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReportersRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReportersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
-				}
-				x.OracleScriptId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OracleScriptId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -10261,46 +10270,8 @@ func (x *fastReflection_QueryRequestSearchRequest) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Calldata = string(dAtA[iNdEx:postIndex])
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
-				}
-				x.AskCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.AskCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
-				}
-				x.MinCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MinCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -10336,26 +10307,72 @@ func (x *fastReflection_QueryRequestSearchRequest) ProtoMethods() *protoiface.Me
 	}
 }
 
+var _ protoreflect.List = (*_QueryReportersResponse_1_list)(nil)
+
+type _QueryReportersResponse_1_list struct {
+	list *[]string
+}
+
+func (x *_QueryReportersResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryReportersResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_QueryReportersResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryReportersResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryReportersResponse_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryReportersResponse at list field Reporter as it is not of Message kind"))
+}
+
+func (x *_QueryReportersResponse_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryReportersResponse_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryReportersResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryRequestSearchResponse         protoreflect.MessageDescriptor
-	fd_QueryRequestSearchResponse_request protoreflect.FieldDescriptor
+	md_QueryReportersResponse          protoreflect.MessageDescriptor
+	fd_QueryReportersResponse_reporter protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryRequestSearchResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestSearchResponse")
-	fd_QueryRequestSearchResponse_request = md_QueryRequestSearchResponse.Fields().ByName("request")
+	md_QueryReportersResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryReportersResponse")
+	fd_QueryReportersResponse_reporter = md_QueryReportersResponse.Fields().ByName("reporter")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryRequestSearchResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryReportersResponse)(nil)
 
-type fastReflection_QueryRequestSearchResponse QueryRequestSearchResponse
+type fastReflection_QueryReportersResponse QueryReportersResponse
 
-func (x *QueryRequestSearchResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryRequestSearchResponse)(x)
+func (x *QueryReportersResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryReportersResponse)(x)
 }
 
-func (x *QueryRequestSearchResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryReportersResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10367,43 +10384,43 @@ func (x *QueryRequestSearchResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryRequestSearchResponse_messageType fastReflection_QueryRequestSearchResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryRequestSearchResponse_messageType{}
+var _fastReflection_QueryReportersResponse_messageType fastReflection_QueryReportersResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryReportersResponse_messageType{}
 
-type fastReflection_QueryRequestSearchResponse_messageType struct{}
+type fastReflection_QueryReportersResponse_messageType struct{}
 
-func (x fastReflection_QueryRequestSearchResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryRequestSearchResponse)(nil)
+func (x fastReflection_QueryReportersResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryReportersResponse)(nil)
 }
-func (x fastReflection_QueryRequestSearchResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestSearchResponse)
+func (x fastReflection_QueryReportersResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryReportersResponse)
 }
-func (x fastReflection_QueryRequestSearchResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestSearchResponse
+func (x fastReflection_QueryReportersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryReportersResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryRequestSearchResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestSearchResponse
+func (x *fastReflection_QueryReportersResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryReportersResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryRequestSearchResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryRequestSearchResponse_messageType
+func (x *fastReflection_QueryReportersResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryReportersResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryRequestSearchResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestSearchResponse)
+func (x *fastReflection_QueryReportersResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryReportersResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryRequestSearchResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryRequestSearchResponse)(x)
+func (x *fastReflection_QueryReportersResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryReportersResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10411,10 +10428,10 @@ func (x *fastReflection_QueryRequestSearchResponse) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryRequestSearchResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Request != nil {
-		value := protoreflect.ValueOfMessage(x.Request.ProtoReflect())
-		if !f(fd_QueryRequestSearchResponse_request, value) {
+func (x *fastReflection_QueryReportersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Reporter) != 0 {
+		value := protoreflect.ValueOfList(&_QueryReportersResponse_1_list{list: &x.Reporter})
+		if !f(fd_QueryReportersResponse_reporter, value) {
 			return
 		}
 	}
@@ -10431,15 +10448,15 @@ func (x *fastReflection_QueryRequestSearchResponse) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryRequestSearchResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryReportersResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestSearchResponse.request":
-		return x.Request != nil
+	case "band.oracle.v1.QueryReportersResponse.reporter":
+		return len(x.Reporter) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10449,15 +10466,15 @@ func (x *fastReflection_QueryRequestSearchResponse) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestSearchResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryReportersResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestSearchResponse.request":
-		x.Request = nil
+	case "band.oracle.v1.QueryReportersResponse.reporter":
+		x.Reporter = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10467,16 +10484,19 @@ func (x *fastReflection_QueryRequestSearchResponse) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryRequestSearchResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryReportersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryRequestSearchResponse.request":
-		value := x.Request
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.oracle.v1.QueryReportersResponse.reporter":
+		if len(x.Reporter) == 0 {
+			return protoreflect.ValueOfList(&_QueryReportersResponse_1_list{})
+		}
+		listValue := &_QueryReportersResponse_1_list{list: &x.Reporter}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10490,15 +10510,17 @@ func (x *fastReflection_QueryRequestSearchResponse) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestSearchResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryReportersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestSearchResponse.request":
-		x.Request = value.Message().Interface().(*QueryRequestResponse)
+	case "band.oracle.v1.QueryReportersResponse.reporter":
+		lv := value.List()
+		clv := lv.(*_QueryReportersResponse_1_list)
+		x.Reporter = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10512,44 +10534,45 @@ func (x *fastReflection_QueryRequestSearchResponse) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestSearchResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryReportersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestSearchResponse.request":
-		if x.Request == nil {
-			x.Request = new(QueryRequestResponse)
+	case "band.oracle.v1.QueryReportersResponse.reporter":
+		if x.Reporter == nil {
+			x.Reporter = []string{}
 		}
-		return protoreflect.ValueOfMessage(x.Request.ProtoReflect())
+		value := &_QueryReportersResponse_1_list{list: &x.Reporter}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryRequestSearchResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryReportersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestSearchResponse.request":
-		m := new(QueryRequestResponse)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.oracle.v1.QueryReportersResponse.reporter":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryReportersResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportersResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryRequestSearchResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryReportersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestSearchResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryReportersResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10557,7 +10580,7 @@ func (x *fastReflection_QueryRequestSearchResponse) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryRequestSearchResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryReportersResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10568,7 +10591,7 @@ func (x *fastReflection_QueryRequestSearchResponse) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestSearchResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryReportersResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10580,7 +10603,7 @@ func (x *fastReflection_QueryRequestSearchResponse) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryRequestSearchResponse) IsValid() bool {
+func (x *fastReflection_QueryReportersResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -10590,9 +10613,9 @@ func (x *fastReflection_QueryRequestSearchResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryRequestSearchResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryReportersResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryRequestSearchResponse)
+		x := input.Message.Interface().(*QueryReportersResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10604,9 +10627,11 @@ func (x *fastReflection_QueryRequestSearchResponse) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		if x.Request != nil {
-			l = options.Size(x.Request)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Reporter) > 0 {
+			for _, s := range x.Reporter {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -10618,7 +10643,7 @@ func (x *fastReflection_QueryRequestSearchResponse) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestSearchResponse)
+		x := input.Message.Interface().(*QueryReportersResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10637,19 +10662,14 @@ func (x *fastReflection_QueryRequestSearchResponse) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Request != nil {
-			encoded, err := options.Marshal(x.Request)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Reporter) > 0 {
+			for iNdEx := len(x.Reporter) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Reporter[iNdEx])
+				copy(dAtA[i:], x.Reporter[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reporter[iNdEx])))
+				i--
+				dAtA[i] = 0xa
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -10662,7 +10682,7 @@ func (x *fastReflection_QueryRequestSearchResponse) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestSearchResponse)
+		x := input.Message.Interface().(*QueryReportersResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10694,17 +10714,17 @@ func (x *fastReflection_QueryRequestSearchResponse) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSearchResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReportersResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReportersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10714,27 +10734,23 @@ func (x *fastReflection_QueryRequestSearchResponse) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Request == nil {
-					x.Request = &QueryRequestResponse{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Request); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Reporter = append(x.Reporter, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -10771,76 +10787,24 @@ func (x *fastReflection_QueryRequestSearchResponse) ProtoMethods() *protoiface.M
 	}
 }
 
-var _ protoreflect.List = (*_QueryRequestPriceRequest_1_list)(nil)
-
-type _QueryRequestPriceRequest_1_list struct {
-	list *[]string
-}
-
-func (x *_QueryRequestPriceRequest_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryRequestPriceRequest_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_QueryRequestPriceRequest_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryRequestPriceRequest_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryRequestPriceRequest_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message QueryRequestPriceRequest at list field Symbols as it is not of Message kind"))
-}
-
-func (x *_QueryRequestPriceRequest_1_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryRequestPriceRequest_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_QueryRequestPriceRequest_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryRequestPriceRequest           protoreflect.MessageDescriptor
-	fd_QueryRequestPriceRequest_symbols   protoreflect.FieldDescriptor
-	fd_QueryRequestPriceRequest_ask_count protoreflect.FieldDescriptor
-	fd_QueryRequestPriceRequest_min_count protoreflect.FieldDescriptor
+	md_QueryActiveValidatorsRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryRequestPriceRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestPriceRequest")
-	fd_QueryRequestPriceRequest_symbols = md_QueryRequestPriceRequest.Fields().ByName("symbols")
-	fd_QueryRequestPriceRequest_ask_count = md_QueryRequestPriceRequest.Fields().ByName("ask_count")
-	fd_QueryRequestPriceRequest_min_count = md_QueryRequestPriceRequest.Fields().ByName("min_count")
+	md_QueryActiveValidatorsRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryActiveValidatorsRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryRequestPriceRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryActiveValidatorsRequest)(nil)
 
-type fastReflection_QueryRequestPriceRequest QueryRequestPriceRequest
+type fastReflection_QueryActiveValidatorsRequest QueryActiveValidatorsRequest
 
-func (x *QueryRequestPriceRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryRequestPriceRequest)(x)
+func (x *QueryActiveValidatorsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryActiveValidatorsRequest)(x)
 }
 
-func (x *QueryRequestPriceRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryActiveValidatorsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10852,43 +10816,43 @@ func (x *QueryRequestPriceRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryRequestPriceRequest_messageType fastReflection_QueryRequestPriceRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryRequestPriceRequest_messageType{}
+var _fastReflection_QueryActiveValidatorsRequest_messageType fastReflection_QueryActiveValidatorsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryActiveValidatorsRequest_messageType{}
 
-type fastReflection_QueryRequestPriceRequest_messageType struct{}
+type fastReflection_QueryActiveValidatorsRequest_messageType struct{}
 
-func (x fastReflection_QueryRequestPriceRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryRequestPriceRequest)(nil)
+func (x fastReflection_QueryActiveValidatorsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryActiveValidatorsRequest)(nil)
 }
-func (x fastReflection_QueryRequestPriceRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestPriceRequest)
+func (x fastReflection_QueryActiveValidatorsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryActiveValidatorsRequest)
 }
-func (x fastReflection_QueryRequestPriceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestPriceRequest
+func (x fastReflection_QueryActiveValidatorsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActiveValidatorsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryRequestPriceRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestPriceRequest
+func (x *fastReflection_QueryActiveValidatorsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActiveValidatorsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryRequestPriceRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryRequestPriceRequest_messageType
+func (x *fastReflection_QueryActiveValidatorsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryActiveValidatorsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryRequestPriceRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestPriceRequest)
+func (x *fastReflection_QueryActiveValidatorsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryActiveValidatorsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryRequestPriceRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryRequestPriceRequest)(x)
+func (x *fastReflection_QueryActiveValidatorsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryActiveValidatorsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10896,25 +10860,7 @@ func (x *fastReflection_QueryRequestPriceRequest) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryRequestPriceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Symbols) != 0 {
-		value := protoreflect.ValueOfList(&_QueryRequestPriceRequest_1_list{list: &x.Symbols})
-		if !f(fd_QueryRequestPriceRequest_symbols, value) {
-			return
-		}
-	}
-	if x.AskCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.AskCount)
-		if !f(fd_QueryRequestPriceRequest_ask_count, value) {
-			return
-		}
-	}
-	if x.MinCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MinCount)
-		if !f(fd_QueryRequestPriceRequest_min_count, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryActiveValidatorsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -10928,19 +10874,13 @@ func (x *fastReflection_QueryRequestPriceRequest) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryRequestPriceRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryActiveValidatorsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
-		return len(x.Symbols) != 0
-	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
-		return x.AskCount != uint64(0)
-	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
-		return x.MinCount != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10950,19 +10890,13 @@ func (x *fastReflection_QueryRequestPriceRequest) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestPriceRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryActiveValidatorsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
-		x.Symbols = nil
-	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
-		x.AskCount = uint64(0)
-	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
-		x.MinCount = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10972,25 +10906,13 @@ func (x *fastReflection_QueryRequestPriceRequest) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryRequestPriceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryActiveValidatorsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
-		if len(x.Symbols) == 0 {
-			return protoreflect.ValueOfList(&_QueryRequestPriceRequest_1_list{})
-		}
-		listValue := &_QueryRequestPriceRequest_1_list{list: &x.Symbols}
-		return protoreflect.ValueOfList(listValue)
-	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
-		value := x.AskCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
-		value := x.MinCount
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11004,21 +10926,13 @@ func (x *fastReflection_QueryRequestPriceRequest) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestPriceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryActiveValidatorsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
-		lv := value.List()
-		clv := lv.(*_QueryRequestPriceRequest_1_list)
-		x.Symbols = *clv.list
-	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
-		x.AskCount = value.Uint()
-	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
-		x.MinCount = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11032,53 +10946,36 @@ func (x *fastReflection_QueryRequestPriceRequest) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestPriceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryActiveValidatorsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
-		if x.Symbols == nil {
-			x.Symbols = []string{}
-		}
-		value := &_QueryRequestPriceRequest_1_list{list: &x.Symbols}
-		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
-		panic(fmt.Errorf("field ask_count of message band.oracle.v1.QueryRequestPriceRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
-		panic(fmt.Errorf("field min_count of message band.oracle.v1.QueryRequestPriceRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryRequestPriceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryActiveValidatorsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
-		list := []string{}
-		return protoreflect.ValueOfList(&_QueryRequestPriceRequest_1_list{list: &list})
-	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
-		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryRequestPriceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryActiveValidatorsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestPriceRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryActiveValidatorsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11086,7 +10983,7 @@ func (x *fastReflection_QueryRequestPriceRequest) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryRequestPriceRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryActiveValidatorsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11097,7 +10994,7 @@ func (x *fastReflection_QueryRequestPriceRequest) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestPriceRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryActiveValidatorsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11109,7 +11006,7 @@ func (x *fastReflection_QueryRequestPriceRequest) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryRequestPriceRequest) IsValid() bool {
+func (x *fastReflection_QueryActiveValidatorsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -11119,9 +11016,9 @@ func (x *fastReflection_QueryRequestPriceRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryRequestPriceRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryActiveValidatorsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryRequestPriceRequest)
+		x := input.Message.Interface().(*QueryActiveValidatorsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11133,18 +11030,6 @@ func (x *fastReflection_QueryRequestPriceRequest) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		if len(x.Symbols) > 0 {
-			for _, s := range x.Symbols {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.AskCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.AskCount))
-		}
-		if x.MinCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.MinCount))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -11155,7 +11040,7 @@ func (x *fastReflection_QueryRequestPriceRequest) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestPriceRequest)
+		x := input.Message.Interface().(*QueryActiveValidatorsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11174,25 +11059,6 @@ func (x *fastReflection_QueryRequestPriceRequest) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MinCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.AskCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.Symbols) > 0 {
-			for iNdEx := len(x.Symbols) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Symbols[iNdEx])
-				copy(dAtA[i:], x.Symbols[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Symbols[iNdEx])))
-				i--
-				dAtA[i] = 0xa
-			}
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -11204,7 +11070,7 @@ func (x *fastReflection_QueryRequestPriceRequest) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestPriceRequest)
+		x := input.Message.Interface().(*QueryActiveValidatorsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11236,82 +11102,12 @@ func (x *fastReflection_QueryRequestPriceRequest) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestPriceRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveValidatorsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Symbols", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Symbols = append(x.Symbols, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
-				}
-				x.AskCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.AskCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
-				}
-				x.MinCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MinCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -11347,77 +11143,77 @@ func (x *fastReflection_QueryRequestPriceRequest) ProtoMethods() *protoiface.Met
 	}
 }
 
-var _ protoreflect.List = (*_QueryRequestPriceResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryActiveValidatorsResponse_1_list)(nil)
 
-type _QueryRequestPriceResponse_1_list struct {
-	list *[]*PriceResult
+type _QueryActiveValidatorsResponse_1_list struct {
+	list *[]*ActiveValidator
 }
 
-func (x *_QueryRequestPriceResponse_1_list) Len() int {
+func (x *_QueryActiveValidatorsResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryRequestPriceResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryActiveValidatorsResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryRequestPriceResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryActiveValidatorsResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*PriceResult)
+	concreteValue := valueUnwrapped.Interface().(*ActiveValidator)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryRequestPriceResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryActiveValidatorsResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*PriceResult)
+	concreteValue := valueUnwrapped.Interface().(*ActiveValidator)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryRequestPriceResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(PriceResult)
+func (x *_QueryActiveValidatorsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(ActiveValidator)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryRequestPriceResponse_1_list) Truncate(n int) {
+func (x *_QueryActiveValidatorsResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryRequestPriceResponse_1_list) NewElement() protoreflect.Value {
-	v := new(PriceResult)
+func (x *_QueryActiveValidatorsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(ActiveValidator)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryRequestPriceResponse_1_list) IsValid() bool {
+func (x *_QueryActiveValidatorsResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryRequestPriceResponse               protoreflect.MessageDescriptor
-	fd_QueryRequestPriceResponse_price_results protoreflect.FieldDescriptor
+	md_QueryActiveValidatorsResponse            protoreflect.MessageDescriptor
+	fd_QueryActiveValidatorsResponse_validators protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryRequestPriceResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestPriceResponse")
-	fd_QueryRequestPriceResponse_price_results = md_QueryRequestPriceResponse.Fields().ByName("price_results")
+	md_QueryActiveValidatorsResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryActiveValidatorsResponse")
+	fd_QueryActiveValidatorsResponse_validators = md_QueryActiveValidatorsResponse.Fields().ByName("validators")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryRequestPriceResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryActiveValidatorsResponse)(nil)
 
-type fastReflection_QueryRequestPriceResponse QueryRequestPriceResponse
+type fastReflection_QueryActiveValidatorsResponse QueryActiveValidatorsResponse
 
-func (x *QueryRequestPriceResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryRequestPriceResponse)(x)
+func (x *QueryActiveValidatorsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryActiveValidatorsResponse)(x)
 }
 
-func (x *QueryRequestPriceResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryActiveValidatorsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -11429,43 +11225,43 @@ func (x *QueryRequestPriceResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryRequestPriceResponse_messageType fastReflection_QueryRequestPriceResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryRequestPriceResponse_messageType{}
+var _fastReflection_QueryActiveValidatorsResponse_messageType fastReflection_QueryActiveValidatorsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryActiveValidatorsResponse_messageType{}
 
-type fastReflection_QueryRequestPriceResponse_messageType struct{}
+type fastReflection_QueryActiveValidatorsResponse_messageType struct{}
 
-func (x fastReflection_QueryRequestPriceResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryRequestPriceResponse)(nil)
+func (x fastReflection_QueryActiveValidatorsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryActiveValidatorsResponse)(nil)
 }
-func (x fastReflection_QueryRequestPriceResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestPriceResponse)
+func (x fastReflection_QueryActiveValidatorsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryActiveValidatorsResponse)
 }
-func (x fastReflection_QueryRequestPriceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestPriceResponse
+func (x fastReflection_QueryActiveValidatorsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActiveValidatorsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryRequestPriceResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestPriceResponse
+func (x *fastReflection_QueryActiveValidatorsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActiveValidatorsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryRequestPriceResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryRequestPriceResponse_messageType
+func (x *fastReflection_QueryActiveValidatorsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryActiveValidatorsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryRequestPriceResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestPriceResponse)
+func (x *fastReflection_QueryActiveValidatorsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryActiveValidatorsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryRequestPriceResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryRequestPriceResponse)(x)
+func (x *fastReflection_QueryActiveValidatorsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryActiveValidatorsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -11473,10 +11269,10 @@ func (x *fastReflection_QueryRequestPriceResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryRequestPriceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.PriceResults) != 0 {
-		value := protoreflect.ValueOfList(&_QueryRequestPriceResponse_1_list{list: &x.PriceResults})
-		if !f(fd_QueryRequestPriceResponse_price_results, value) {
+func (x *fastReflection_QueryActiveValidatorsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Validators) != 0 {
+		value := protoreflect.ValueOfList(&_QueryActiveValidatorsResponse_1_list{list: &x.Validators})
+		if !f(fd_QueryActiveValidatorsResponse_validators, value) {
 			return
 		}
 	}
@@ -11493,15 +11289,15 @@ func (x *fastReflection_QueryRequestPriceResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryRequestPriceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryActiveValidatorsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
-		return len(x.PriceResults) != 0
+	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
+		return len(x.Validators) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11511,15 +11307,15 @@ func (x *fastReflection_QueryRequestPriceResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestPriceResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryActiveValidatorsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
-		x.PriceResults = nil
+	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
+		x.Validators = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11529,19 +11325,19 @@ func (x *fastReflection_QueryRequestPriceResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryRequestPriceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryActiveValidatorsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
-		if len(x.PriceResults) == 0 {
-			return protoreflect.ValueOfList(&_QueryRequestPriceResponse_1_list{})
+	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
+		if len(x.Validators) == 0 {
+			return protoreflect.ValueOfList(&_QueryActiveValidatorsResponse_1_list{})
 		}
-		listValue := &_QueryRequestPriceResponse_1_list{list: &x.PriceResults}
+		listValue := &_QueryActiveValidatorsResponse_1_list{list: &x.Validators}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11555,17 +11351,17 @@ func (x *fastReflection_QueryRequestPriceResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestPriceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryActiveValidatorsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
+	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
 		lv := value.List()
-		clv := lv.(*_QueryRequestPriceResponse_1_list)
-		x.PriceResults = *clv.list
+		clv := lv.(*_QueryActiveValidatorsResponse_1_list)
+		x.Validators = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11579,45 +11375,45 @@ func (x *fastReflection_QueryRequestPriceResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestPriceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryActiveValidatorsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
-		if x.PriceResults == nil {
-			x.PriceResults = []*PriceResult{}
+	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
+		if x.Validators == nil {
+			x.Validators = []*ActiveValidator{}
 		}
-		value := &_QueryRequestPriceResponse_1_list{list: &x.PriceResults}
+		value := &_QueryActiveValidatorsResponse_1_list{list: &x.Validators}
 		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryRequestPriceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryActiveValidatorsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
-		list := []*PriceResult{}
-		return protoreflect.ValueOfList(&_QueryRequestPriceResponse_1_list{list: &list})
+	case "band.oracle.v1.QueryActiveValidatorsResponse.validators":
+		list := []*ActiveValidator{}
+		return protoreflect.ValueOfList(&_QueryActiveValidatorsResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryActiveValidatorsResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryActiveValidatorsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryRequestPriceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryActiveValidatorsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestPriceResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryActiveValidatorsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11625,7 +11421,7 @@ func (x *fastReflection_QueryRequestPriceResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryRequestPriceResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryActiveValidatorsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11636,7 +11432,7 @@ func (x *fastReflection_QueryRequestPriceResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestPriceResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryActiveValidatorsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11648,7 +11444,7 @@ func (x *fastReflection_QueryRequestPriceResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryRequestPriceResponse) IsValid() bool {
+func (x *fastReflection_QueryActiveValidatorsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -11658,9 +11454,9 @@ func (x *fastReflection_QueryRequestPriceResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryRequestPriceResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryActiveValidatorsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryRequestPriceResponse)
+		x := input.Message.Interface().(*QueryActiveValidatorsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11672,8 +11468,8 @@ func (x *fastReflection_QueryRequestPriceResponse) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if len(x.PriceResults) > 0 {
-			for _, e := range x.PriceResults {
+		if len(x.Validators) > 0 {
+			for _, e := range x.Validators {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -11688,7 +11484,7 @@ func (x *fastReflection_QueryRequestPriceResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestPriceResponse)
+		x := input.Message.Interface().(*QueryActiveValidatorsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11707,9 +11503,9 @@ func (x *fastReflection_QueryRequestPriceResponse) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.PriceResults) > 0 {
-			for iNdEx := len(x.PriceResults) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.PriceResults[iNdEx])
+		if len(x.Validators) > 0 {
+			for iNdEx := len(x.Validators) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Validators[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11734,7 +11530,7 @@ func (x *fastReflection_QueryRequestPriceResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestPriceResponse)
+		x := input.Message.Interface().(*QueryActiveValidatorsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11766,15 +11562,15 @@ func (x *fastReflection_QueryRequestPriceResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestPriceResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveValidatorsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceResults", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11801,8 +11597,8 @@ func (x *fastReflection_QueryRequestPriceResponse) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PriceResults = append(x.PriceResults, &PriceResult{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceResults[len(x.PriceResults)-1]); err != nil {
+				x.Validators = append(x.Validators, &ActiveValidator{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Validators[len(x.Validators)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -11842,39 +11638,31 @@ func (x *fastReflection_QueryRequestPriceResponse) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_QueryRequestVerificationRequest                protoreflect.MessageDescriptor
-	fd_QueryRequestVerificationRequest_chain_id       protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationRequest_validator      protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationRequest_request_id     protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationRequest_external_id    protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationRequest_data_source_id protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationRequest_reporter       protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationRequest_signature      protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationRequest_max_delay      protoreflect.FieldDescriptor
+	md_QueryRequestSearchRequest                  protoreflect.MessageDescriptor
+	fd_QueryRequestSearchRequest_oracle_script_id protoreflect.FieldDescriptor
+	fd_QueryRequestSearchRequest_calldata         protoreflect.FieldDescriptor
+	fd_QueryRequestSearchRequest_ask_count        protoreflect.FieldDescriptor
+	fd_QueryRequestSearchRequest_min_count        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryRequestVerificationRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestVerificationRequest")
-	fd_QueryRequestVerificationRequest_chain_id = md_QueryRequestVerificationRequest.Fields().ByName("chain_id")
-	fd_QueryRequestVerificationRequest_validator = md_QueryRequestVerificationRequest.Fields().ByName("validator")
-	fd_QueryRequestVerificationRequest_request_id = md_QueryRequestVerificationRequest.Fields().ByName("request_id")
-	fd_QueryRequestVerificationRequest_external_id = md_QueryRequestVerificationRequest.Fields().ByName("external_id")
-	fd_QueryRequestVerificationRequest_data_source_id = md_QueryRequestVerificationRequest.Fields().ByName("data_source_id")
-	fd_QueryRequestVerificationRequest_reporter = md_QueryRequestVerificationRequest.Fields().ByName("reporter")
-	fd_QueryRequestVerificationRequest_signature = md_QueryRequestVerificationRequest.Fields().ByName("signature")
-	fd_QueryRequestVerificationRequest_max_delay = md_QueryRequestVerificationRequest.Fields().ByName("max_delay")
+	md_QueryRequestSearchRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestSearchRequest")
+	fd_QueryRequestSearchRequest_oracle_script_id = md_QueryRequestSearchRequest.Fields().ByName("oracle_script_id")
+	fd_QueryRequestSearchRequest_calldata = md_QueryRequestSearchRequest.Fields().ByName("calldata")
+	fd_QueryRequestSearchRequest_ask_count = md_QueryRequestSearchRequest.Fields().ByName("ask_count")
+	fd_QueryRequestSearchRequest_min_count = md_QueryRequestSearchRequest.Fields().ByName("min_count")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryRequestVerificationRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryRequestSearchRequest)(nil)
 
-type fastReflection_QueryRequestVerificationRequest QueryRequestVerificationRequest
+type fastReflection_QueryRequestSearchRequest QueryRequestSearchRequest
 
-func (x *QueryRequestVerificationRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryRequestVerificationRequest)(x)
+func (x *QueryRequestSearchRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRequestSearchRequest)(x)
 }
 
-func (x *QueryRequestVerificationRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryRequestSearchRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -11886,43 +11674,43 @@ func (x *QueryRequestVerificationRequest) slowProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryRequestVerificationRequest_messageType fastReflection_QueryRequestVerificationRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryRequestVerificationRequest_messageType{}
+var _fastReflection_QueryRequestSearchRequest_messageType fastReflection_QueryRequestSearchRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRequestSearchRequest_messageType{}
 
-type fastReflection_QueryRequestVerificationRequest_messageType struct{}
+type fastReflection_QueryRequestSearchRequest_messageType struct{}
 
-func (x fastReflection_QueryRequestVerificationRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryRequestVerificationRequest)(nil)
+func (x fastReflection_QueryRequestSearchRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRequestSearchRequest)(nil)
 }
-func (x fastReflection_QueryRequestVerificationRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestVerificationRequest)
+func (x fastReflection_QueryRequestSearchRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestSearchRequest)
 }
-func (x fastReflection_QueryRequestVerificationRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestVerificationRequest
+func (x fastReflection_QueryRequestSearchRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestSearchRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryRequestVerificationRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestVerificationRequest
+func (x *fastReflection_QueryRequestSearchRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestSearchRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryRequestVerificationRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryRequestVerificationRequest_messageType
+func (x *fastReflection_QueryRequestSearchRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRequestSearchRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryRequestVerificationRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestVerificationRequest)
+func (x *fastReflection_QueryRequestSearchRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestSearchRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryRequestVerificationRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryRequestVerificationRequest)(x)
+func (x *fastReflection_QueryRequestSearchRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryRequestSearchRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -11930,52 +11718,28 @@ func (x *fastReflection_QueryRequestVerificationRequest) Interface() protoreflec
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryRequestVerificationRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ChainId != "" {
-		value := protoreflect.ValueOfString(x.ChainId)
-		if !f(fd_QueryRequestVerificationRequest_chain_id, value) {
-			return
-		}
-	}
-	if x.Validator != "" {
-		value := protoreflect.ValueOfString(x.Validator)
-		if !f(fd_QueryRequestVerificationRequest_validator, value) {
-			return
-		}
-	}
-	if x.RequestId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_QueryRequestVerificationRequest_request_id, value) {
-			return
-		}
-	}
-	if x.ExternalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExternalId)
-		if !f(fd_QueryRequestVerificationRequest_external_id, value) {
-			return
-		}
-	}
-	if x.DataSourceId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.DataSourceId)
-		if !f(fd_QueryRequestVerificationRequest_data_source_id, value) {
+func (x *fastReflection_QueryRequestSearchRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_QueryRequestSearchRequest_oracle_script_id, value) {
 			return
 		}
 	}
-	if x.Reporter != "" {
-		value := protoreflect.ValueOfString(x.Reporter)
-		if !f(fd_QueryRequestVerificationRequest_reporter, value) {
+	if x.Calldata != "" {
+		value := protoreflect.ValueOfString(x.Calldata)
+		if !f(fd_QueryRequestSearchRequest_calldata, value) {
 			return
 		}
 	}
-	if len(x.Signature) != 0 {
-		value := protoreflect.ValueOfBytes(x.Signature)
-		if !f(fd_QueryRequestVerificationRequest_signature, value) {
+	if x.AskCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AskCount)
+		if !f(fd_QueryRequestSearchRequest_ask_count, value) {
 			return
 		}
 	}
-	if x.MaxDelay != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MaxDelay)
-		if !f(fd_QueryRequestVerificationRequest_max_delay, value) {
+	if x.MinCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinCount)
+		if !f(fd_QueryRequestSearchRequest_min_count, value) {
 			return
 		}
 	}
@@ -11992,29 +11756,21 @@ func (x *fastReflection_QueryRequestVerificationRequest) Range(f func(protorefle
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryRequestVerificationRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryRequestSearchRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
-		return x.ChainId != ""
-	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
-		return x.Validator != ""
-	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
-		return x.RequestId != uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
-		return x.ExternalId != uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
-		return x.DataSourceId != uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
-		return x.Reporter != ""
-	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
-		return len(x.Signature) != 0
-	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
-		return x.MaxDelay != uint64(0)
+	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
+		return x.Calldata != ""
+	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
+		return x.AskCount != uint64(0)
+	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
+		return x.MinCount != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12024,29 +11780,21 @@ func (x *fastReflection_QueryRequestVerificationRequest) Has(fd protoreflect.Fie
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestVerificationRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryRequestSearchRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
-		x.ChainId = ""
-	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
-		x.Validator = ""
-	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
-		x.RequestId = uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
-		x.ExternalId = uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
-		x.DataSourceId = uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
-		x.Reporter = ""
-	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
-		x.Signature = nil
-	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
-		x.MaxDelay = uint64(0)
+	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
+		x.Calldata = ""
+	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
+		x.AskCount = uint64(0)
+	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
+		x.MinCount = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12056,37 +11804,25 @@ func (x *fastReflection_QueryRequestVerificationRequest) Clear(fd protoreflect.F
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryRequestVerificationRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryRequestSearchRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
-		value := x.Validator
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
-		value := x.RequestId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
-		value := x.ExternalId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
-		value := x.DataSourceId
+	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
+		value := x.OracleScriptId
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
-		value := x.Reporter
+	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
+		value := x.Calldata
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
-		value := x.Signature
-		return protoreflect.ValueOfBytes(value)
-	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
-		value := x.MaxDelay
+	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
+		value := x.AskCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
+		value := x.MinCount
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -12100,29 +11836,21 @@ func (x *fastReflection_QueryRequestVerificationRequest) Get(descriptor protoref
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestVerificationRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryRequestSearchRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
-		x.ChainId = value.Interface().(string)
-	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
-		x.Validator = value.Interface().(string)
-	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
-		x.RequestId = value.Uint()
-	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
-		x.ExternalId = value.Uint()
-	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
-		x.DataSourceId = value.Uint()
-	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
-		x.Reporter = value.Interface().(string)
-	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
-		x.Signature = value.Bytes()
-	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
-		x.MaxDelay = value.Uint()
+	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
+		x.Calldata = value.Interface().(string)
+	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
+		x.AskCount = value.Uint()
+	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
+		x.MinCount = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12136,68 +11864,52 @@ func (x *fastReflection_QueryRequestVerificationRequest) Set(fd protoreflect.Fie
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestVerificationRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryRequestSearchRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
-		panic(fmt.Errorf("field chain_id of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
-		panic(fmt.Errorf("field validator of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
-		panic(fmt.Errorf("field request_id of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
-		panic(fmt.Errorf("field external_id of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
-		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
-		panic(fmt.Errorf("field reporter of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
-		panic(fmt.Errorf("field signature of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
-		panic(fmt.Errorf("field max_delay of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.QueryRequestSearchRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.QueryRequestSearchRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
+		panic(fmt.Errorf("field ask_count of message band.oracle.v1.QueryRequestSearchRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.QueryRequestSearchRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryRequestVerificationRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryRequestSearchRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
+	case "band.oracle.v1.QueryRequestSearchRequest.oracle_script_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
+	case "band.oracle.v1.QueryRequestSearchRequest.calldata":
 		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
-		return protoreflect.ValueOfBytes(nil)
-	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
+	case "band.oracle.v1.QueryRequestSearchRequest.ask_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryRequestSearchRequest.min_count":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryRequestVerificationRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryRequestSearchRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestVerificationRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestSearchRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -12205,7 +11917,7 @@ func (x *fastReflection_QueryRequestVerificationRequest) WhichOneof(d protorefle
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryRequestVerificationRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryRequestSearchRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -12216,7 +11928,7 @@ func (x *fastReflection_QueryRequestVerificationRequest) GetUnknown() protorefle
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestVerificationRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryRequestSearchRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -12228,7 +11940,7 @@ func (x *fastReflection_QueryRequestVerificationRequest) SetUnknown(fields proto
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryRequestVerificationRequest) IsValid() bool {
+func (x *fastReflection_QueryRequestSearchRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -12238,9 +11950,9 @@ func (x *fastReflection_QueryRequestVerificationRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryRequestSearchRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryRequestVerificationRequest)
+		x := input.Message.Interface().(*QueryRequestSearchRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12252,33 +11964,18 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 		var n int
 		var l int
 		_ = l
-		l = len(x.ChainId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Validator)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.RequestId != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestId))
-		}
-		if x.ExternalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExternalId))
-		}
-		if x.DataSourceId != 0 {
-			n += 1 + runtime.Sov(uint64(x.DataSourceId))
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
 		}
-		l = len(x.Reporter)
+		l = len(x.Calldata)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Signature)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.AskCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AskCount))
 		}
-		if x.MaxDelay != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxDelay))
+		if x.MinCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinCount))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -12290,7 +11987,7 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestVerificationRequest)
+		x := input.Message.Interface().(*QueryRequestSearchRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12309,53 +12006,27 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MaxDelay != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxDelay))
-			i--
-			dAtA[i] = 0x40
-		}
-		if len(x.Signature) > 0 {
-			i -= len(x.Signature)
-			copy(dAtA[i:], x.Signature)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.Reporter) > 0 {
-			i -= len(x.Reporter)
-			copy(dAtA[i:], x.Reporter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reporter)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if x.DataSourceId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.ExternalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
+		if x.MinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
 			i--
 			dAtA[i] = 0x20
 		}
-		if x.RequestId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+		if x.AskCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
 			i--
 			dAtA[i] = 0x18
 		}
-		if len(x.Validator) > 0 {
-			i -= len(x.Validator)
-			copy(dAtA[i:], x.Validator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+		if len(x.Calldata) > 0 {
+			i -= len(x.Calldata)
+			copy(dAtA[i:], x.Calldata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.ChainId) > 0 {
-			i -= len(x.ChainId)
-			copy(dAtA[i:], x.ChainId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -12368,7 +12039,7 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestVerificationRequest)
+		x := input.Message.Interface().(*QueryRequestSearchRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12400,17 +12071,17 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestVerificationRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSearchRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
 				}
-				var stringLen uint64
+				x.OracleScriptId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12420,27 +12091,14 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.OracleScriptId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ChainId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -12468,13 +12126,13 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Validator = string(dAtA[iNdEx:postIndex])
+				x.Calldata = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
 				}
-				x.RequestId = 0
+				x.AskCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12484,120 +12142,16 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
+					x.AskCount |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
-				}
-				x.ExternalId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ExternalId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
-				}
-				x.DataSourceId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.DataSourceId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Reporter = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Signature = append(x.Signature[:0], dAtA[iNdEx:postIndex]...)
-				if x.Signature == nil {
-					x.Signature = []byte{}
-				}
-				iNdEx = postIndex
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDelay", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
 				}
-				x.MaxDelay = 0
+				x.MinCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12607,7 +12161,7 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MaxDelay |= uint64(b&0x7F) << shift
+					x.MinCount |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -12648,35 +12202,25 @@ func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoif
 }
 
 var (
-	md_QueryRequestVerificationResponse                protoreflect.MessageDescriptor
-	fd_QueryRequestVerificationResponse_chain_id       protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationResponse_validator      protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationResponse_request_id     protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationResponse_external_id    protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationResponse_data_source_id protoreflect.FieldDescriptor
-	fd_QueryRequestVerificationResponse_is_delay       protoreflect.FieldDescriptor
+	md_QueryRequestSearchResponse         protoreflect.MessageDescriptor
+	fd_QueryRequestSearchResponse_request protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_query_proto_init()
-	md_QueryRequestVerificationResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestVerificationResponse")
-	fd_QueryRequestVerificationResponse_chain_id = md_QueryRequestVerificationResponse.Fields().ByName("chain_id")
-	fd_QueryRequestVerificationResponse_validator = md_QueryRequestVerificationResponse.Fields().ByName("validator")
-	fd_QueryRequestVerificationResponse_request_id = md_QueryRequestVerificationResponse.Fields().ByName("request_id")
-	fd_QueryRequestVerificationResponse_external_id = md_QueryRequestVerificationResponse.Fields().ByName("external_id")
-	fd_QueryRequestVerificationResponse_data_source_id = md_QueryRequestVerificationResponse.Fields().ByName("data_source_id")
-	fd_QueryRequestVerificationResponse_is_delay = md_QueryRequestVerificationResponse.Fields().ByName("is_delay")
+	md_QueryRequestSearchResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestSearchResponse")
+	fd_QueryRequestSearchResponse_request = md_QueryRequestSearchResponse.Fields().ByName("request")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryRequestVerificationResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryRequestSearchResponse)(nil)
 
-type fastReflection_QueryRequestVerificationResponse QueryRequestVerificationResponse
+type fastReflection_QueryRequestSearchResponse QueryRequestSearchResponse
 
-func (x *QueryRequestVerificationResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryRequestVerificationResponse)(x)
+func (x *QueryRequestSearchResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRequestSearchResponse)(x)
 }
 
-func (x *QueryRequestVerificationResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryRequestSearchResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_query_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -12688,43 +12232,43 @@ func (x *QueryRequestVerificationResponse) slowProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryRequestVerificationResponse_messageType fastReflection_QueryRequestVerificationResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryRequestVerificationResponse_messageType{}
+var _fastReflection_QueryRequestSearchResponse_messageType fastReflection_QueryRequestSearchResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRequestSearchResponse_messageType{}
 
-type fastReflection_QueryRequestVerificationResponse_messageType struct{}
+type fastReflection_QueryRequestSearchResponse_messageType struct{}
 
-func (x fastReflection_QueryRequestVerificationResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryRequestVerificationResponse)(nil)
+func (x fastReflection_QueryRequestSearchResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRequestSearchResponse)(nil)
 }
-func (x fastReflection_QueryRequestVerificationResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestVerificationResponse)
+func (x fastReflection_QueryRequestSearchResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestSearchResponse)
 }
-func (x fastReflection_QueryRequestVerificationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestVerificationResponse
+func (x fastReflection_QueryRequestSearchResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestSearchResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryRequestVerificationResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRequestVerificationResponse
+func (x *fastReflection_QueryRequestSearchResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestSearchResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryRequestVerificationResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryRequestVerificationResponse_messageType
+func (x *fastReflection_QueryRequestSearchResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRequestSearchResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryRequestVerificationResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryRequestVerificationResponse)
+func (x *fastReflection_QueryRequestSearchResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestSearchResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryRequestVerificationResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryRequestVerificationResponse)(x)
+func (x *fastReflection_QueryRequestSearchResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryRequestSearchResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -12732,40 +12276,10 @@ func (x *fastReflection_QueryRequestVerificationResponse) Interface() protorefle
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryRequestVerificationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ChainId != "" {
-		value := protoreflect.ValueOfString(x.ChainId)
-		if !f(fd_QueryRequestVerificationResponse_chain_id, value) {
-			return
-		}
-	}
-	if x.Validator != "" {
-		value := protoreflect.ValueOfString(x.Validator)
-		if !f(fd_QueryRequestVerificationResponse_validator, value) {
-			return
-		}
-	}
-	if x.RequestId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_QueryRequestVerificationResponse_request_id, value) {
-			return
-		}
-	}
-	if x.ExternalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExternalId)
-		if !f(fd_QueryRequestVerificationResponse_external_id, value) {
-			return
-		}
-	}
-	if x.DataSourceId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.DataSourceId)
-		if !f(fd_QueryRequestVerificationResponse_data_source_id, value) {
-			return
-		}
-	}
-	if x.IsDelay != false {
-		value := protoreflect.ValueOfBool(x.IsDelay)
-		if !f(fd_QueryRequestVerificationResponse_is_delay, value) {
+func (x *fastReflection_QueryRequestSearchResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Request != nil {
+		value := protoreflect.ValueOfMessage(x.Request.ProtoReflect())
+		if !f(fd_QueryRequestSearchResponse_request, value) {
 			return
 		}
 	}
@@ -12782,25 +12296,15 @@ func (x *fastReflection_QueryRequestVerificationResponse) Range(f func(protorefl
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryRequestVerificationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryRequestSearchResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
-		return x.ChainId != ""
-	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
-		return x.Validator != ""
-	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
-		return x.RequestId != uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
-		return x.ExternalId != uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
-		return x.DataSourceId != uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
-		return x.IsDelay != false
+	case "band.oracle.v1.QueryRequestSearchResponse.request":
+		return x.Request != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12810,25 +12314,15 @@ func (x *fastReflection_QueryRequestVerificationResponse) Has(fd protoreflect.Fi
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestVerificationResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryRequestSearchResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
-		x.ChainId = ""
-	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
-		x.Validator = ""
-	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
-		x.RequestId = uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
-		x.ExternalId = uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
-		x.DataSourceId = uint64(0)
-	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
-		x.IsDelay = false
+	case "band.oracle.v1.QueryRequestSearchResponse.request":
+		x.Request = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12838,31 +12332,16 @@ func (x *fastReflection_QueryRequestVerificationResponse) Clear(fd protoreflect.
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryRequestVerificationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryRequestSearchResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
-		value := x.Validator
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
-		value := x.RequestId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
-		value := x.ExternalId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
-		value := x.DataSourceId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
-		value := x.IsDelay
-		return protoreflect.ValueOfBool(value)
+	case "band.oracle.v1.QueryRequestSearchResponse.request":
+		value := x.Request
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -12876,25 +12355,15 @@ func (x *fastReflection_QueryRequestVerificationResponse) Get(descriptor protore
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestVerificationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryRequestSearchResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
-		x.ChainId = value.Interface().(string)
-	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
-		x.Validator = value.Interface().(string)
-	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
-		x.RequestId = value.Uint()
-	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
-		x.ExternalId = value.Uint()
-	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
-		x.DataSourceId = value.Uint()
-	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
-		x.IsDelay = value.Bool()
+	case "band.oracle.v1.QueryRequestSearchResponse.request":
+		x.Request = value.Message().Interface().(*QueryRequestResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12908,60 +12377,44 @@ func (x *fastReflection_QueryRequestVerificationResponse) Set(fd protoreflect.Fi
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestVerificationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryRequestSearchResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
-		panic(fmt.Errorf("field chain_id of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
-		panic(fmt.Errorf("field validator of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
-		panic(fmt.Errorf("field request_id of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
-		panic(fmt.Errorf("field external_id of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
-		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
-	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
-		panic(fmt.Errorf("field is_delay of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
+	case "band.oracle.v1.QueryRequestSearchResponse.request":
+		if x.Request == nil {
+			x.Request = new(QueryRequestResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Request.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryRequestVerificationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryRequestSearchResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
-		return protoreflect.ValueOfBool(false)
+	case "band.oracle.v1.QueryRequestSearchResponse.request":
+		m := new(QueryRequestResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSearchResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSearchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryRequestVerificationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryRequestSearchResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestVerificationResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestSearchResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -12969,7 +12422,7 @@ func (x *fastReflection_QueryRequestVerificationResponse) WhichOneof(d protorefl
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryRequestVerificationResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryRequestSearchResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -12980,7 +12433,7 @@ func (x *fastReflection_QueryRequestVerificationResponse) GetUnknown() protorefl
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRequestVerificationResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryRequestSearchResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -12992,7 +12445,7 @@ func (x *fastReflection_QueryRequestVerificationResponse) SetUnknown(fields prot
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryRequestVerificationResponse) IsValid() bool {
+func (x *fastReflection_QueryRequestSearchResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -13002,9 +12455,9 @@ func (x *fastReflection_QueryRequestVerificationResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryRequestSearchResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryRequestVerificationResponse)
+		x := input.Message.Interface().(*QueryRequestSearchResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13016,26 +12469,10 @@ func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoi
 		var n int
 		var l int
 		_ = l
-		l = len(x.ChainId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Validator)
-		if l > 0 {
+		if x.Request != nil {
+			l = options.Size(x.Request)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.RequestId != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestId))
-		}
-		if x.ExternalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExternalId))
-		}
-		if x.DataSourceId != 0 {
-			n += 1 + runtime.Sov(uint64(x.DataSourceId))
-		}
-		if x.IsDelay {
-			n += 2
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -13046,7 +12483,7 @@ func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoi
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestVerificationResponse)
+		x := input.Message.Interface().(*QueryRequestSearchResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13065,42 +12502,17 @@ func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoi
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.IsDelay {
-			i--
-			if x.IsDelay {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+		if x.Request != nil {
+			encoded, err := options.Marshal(x.Request)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.DataSourceId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.ExternalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.RequestId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.Validator) > 0 {
-			i -= len(x.Validator)
-			copy(dAtA[i:], x.Validator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.ChainId) > 0 {
-			i -= len(x.ChainId)
-			copy(dAtA[i:], x.ChainId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -13115,7 +12527,7 @@ func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoi
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRequestVerificationResponse)
+		x := input.Message.Interface().(*QueryRequestSearchResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13147,17 +12559,17 @@ func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoi
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestVerificationResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSearchResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -13167,147 +12579,42 @@ func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoi
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ChainId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				if x.Request == nil {
+					x.Request = &QueryRequestResponse{}
 				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Request); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Validator = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-				}
-				x.RequestId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
-				}
-				x.ExternalId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ExternalId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
-				}
-				x.DataSourceId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.DataSourceId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsDelay", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.IsDelay = bool(v != 0)
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -13329,1251 +12636,8426 @@ func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoi
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: band/oracle/v1/query.proto
+var _ protoreflect.List = (*_QueryRequestPriceRequest_1_list)(nil)
+
+type _QueryRequestPriceRequest_1_list struct {
+	list *[]string
+}
+
+func (x *_QueryRequestPriceRequest_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryRequestPriceRequest_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_QueryRequestPriceRequest_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryRequestPriceRequest_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryRequestPriceRequest_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryRequestPriceRequest at list field Symbols as it is not of Message kind"))
+}
+
+func (x *_QueryRequestPriceRequest_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryRequestPriceRequest_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryRequestPriceRequest_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryRequestPriceRequest           protoreflect.MessageDescriptor
+	fd_QueryRequestPriceRequest_symbols   protoreflect.FieldDescriptor
+	fd_QueryRequestPriceRequest_ask_count protoreflect.FieldDescriptor
+	fd_QueryRequestPriceRequest_min_count protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryRequestPriceRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestPriceRequest")
+	fd_QueryRequestPriceRequest_symbols = md_QueryRequestPriceRequest.Fields().ByName("symbols")
+	fd_QueryRequestPriceRequest_ask_count = md_QueryRequestPriceRequest.Fields().ByName("ask_count")
+	fd_QueryRequestPriceRequest_min_count = md_QueryRequestPriceRequest.Fields().ByName("min_count")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRequestPriceRequest)(nil)
+
+type fastReflection_QueryRequestPriceRequest QueryRequestPriceRequest
+
+func (x *QueryRequestPriceRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRequestPriceRequest)(x)
+}
+
+func (x *QueryRequestPriceRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRequestPriceRequest_messageType fastReflection_QueryRequestPriceRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRequestPriceRequest_messageType{}
+
+type fastReflection_QueryRequestPriceRequest_messageType struct{}
+
+func (x fastReflection_QueryRequestPriceRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRequestPriceRequest)(nil)
+}
+func (x fastReflection_QueryRequestPriceRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestPriceRequest)
+}
+func (x fastReflection_QueryRequestPriceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestPriceRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRequestPriceRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestPriceRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRequestPriceRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRequestPriceRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRequestPriceRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestPriceRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRequestPriceRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryRequestPriceRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRequestPriceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Symbols) != 0 {
+		value := protoreflect.ValueOfList(&_QueryRequestPriceRequest_1_list{list: &x.Symbols})
+		if !f(fd_QueryRequestPriceRequest_symbols, value) {
+			return
+		}
+	}
+	if x.AskCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AskCount)
+		if !f(fd_QueryRequestPriceRequest_ask_count, value) {
+			return
+		}
+	}
+	if x.MinCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinCount)
+		if !f(fd_QueryRequestPriceRequest_min_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRequestPriceRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
+		return len(x.Symbols) != 0
+	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
+		return x.AskCount != uint64(0)
+	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
+		return x.MinCount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestPriceRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
+		x.Symbols = nil
+	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
+		x.AskCount = uint64(0)
+	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
+		x.MinCount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRequestPriceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
+		if len(x.Symbols) == 0 {
+			return protoreflect.ValueOfList(&_QueryRequestPriceRequest_1_list{})
+		}
+		listValue := &_QueryRequestPriceRequest_1_list{list: &x.Symbols}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
+		value := x.AskCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
+		value := x.MinCount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestPriceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
+		lv := value.List()
+		clv := lv.(*_QueryRequestPriceRequest_1_list)
+		x.Symbols = *clv.list
+	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
+		x.AskCount = value.Uint()
+	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
+		x.MinCount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestPriceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
+		if x.Symbols == nil {
+			x.Symbols = []string{}
+		}
+		value := &_QueryRequestPriceRequest_1_list{list: &x.Symbols}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
+		panic(fmt.Errorf("field ask_count of message band.oracle.v1.QueryRequestPriceRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.QueryRequestPriceRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRequestPriceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestPriceRequest.symbols":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryRequestPriceRequest_1_list{list: &list})
+	case "band.oracle.v1.QueryRequestPriceRequest.ask_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryRequestPriceRequest.min_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRequestPriceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestPriceRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRequestPriceRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestPriceRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRequestPriceRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRequestPriceRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRequestPriceRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Symbols) > 0 {
+			for _, s := range x.Symbols {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.AskCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AskCount))
+		}
+		if x.MinCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinCount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestPriceRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.AskCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Symbols) > 0 {
+			for iNdEx := len(x.Symbols) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Symbols[iNdEx])
+				copy(dAtA[i:], x.Symbols[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Symbols[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestPriceRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestPriceRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Symbols", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Symbols = append(x.Symbols, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				}
+				x.AskCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AskCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				}
+				x.MinCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryRequestPriceResponse_1_list)(nil)
+
+type _QueryRequestPriceResponse_1_list struct {
+	list *[]*PriceResult
+}
+
+func (x *_QueryRequestPriceResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryRequestPriceResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryRequestPriceResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceResult)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryRequestPriceResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceResult)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryRequestPriceResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(PriceResult)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryRequestPriceResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryRequestPriceResponse_1_list) NewElement() protoreflect.Value {
+	v := new(PriceResult)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryRequestPriceResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryRequestPriceResponse               protoreflect.MessageDescriptor
+	fd_QueryRequestPriceResponse_price_results protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryRequestPriceResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestPriceResponse")
+	fd_QueryRequestPriceResponse_price_results = md_QueryRequestPriceResponse.Fields().ByName("price_results")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRequestPriceResponse)(nil)
+
+type fastReflection_QueryRequestPriceResponse QueryRequestPriceResponse
+
+func (x *QueryRequestPriceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRequestPriceResponse)(x)
+}
+
+func (x *QueryRequestPriceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRequestPriceResponse_messageType fastReflection_QueryRequestPriceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRequestPriceResponse_messageType{}
+
+type fastReflection_QueryRequestPriceResponse_messageType struct{}
+
+func (x fastReflection_QueryRequestPriceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRequestPriceResponse)(nil)
+}
+func (x fastReflection_QueryRequestPriceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestPriceResponse)
+}
+func (x fastReflection_QueryRequestPriceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestPriceResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRequestPriceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestPriceResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRequestPriceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRequestPriceResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRequestPriceResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestPriceResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRequestPriceResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryRequestPriceResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRequestPriceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.PriceResults) != 0 {
+		value := protoreflect.ValueOfList(&_QueryRequestPriceResponse_1_list{list: &x.PriceResults})
+		if !f(fd_QueryRequestPriceResponse_price_results, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRequestPriceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
+		return len(x.PriceResults) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestPriceResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
+		x.PriceResults = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRequestPriceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
+		if len(x.PriceResults) == 0 {
+			return protoreflect.ValueOfList(&_QueryRequestPriceResponse_1_list{})
+		}
+		listValue := &_QueryRequestPriceResponse_1_list{list: &x.PriceResults}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestPriceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
+		lv := value.List()
+		clv := lv.(*_QueryRequestPriceResponse_1_list)
+		x.PriceResults = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestPriceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
+		if x.PriceResults == nil {
+			x.PriceResults = []*PriceResult{}
+		}
+		value := &_QueryRequestPriceResponse_1_list{list: &x.PriceResults}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRequestPriceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestPriceResponse.price_results":
+		list := []*PriceResult{}
+		return protoreflect.ValueOfList(&_QueryRequestPriceResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestPriceResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestPriceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRequestPriceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestPriceResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRequestPriceResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestPriceResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRequestPriceResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRequestPriceResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRequestPriceResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.PriceResults) > 0 {
+			for _, e := range x.PriceResults {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestPriceResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PriceResults) > 0 {
+			for iNdEx := len(x.PriceResults) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PriceResults[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestPriceResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestPriceResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceResults", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceResults = append(x.PriceResults, &PriceResult{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceResults[len(x.PriceResults)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryRequestVerificationRequest                protoreflect.MessageDescriptor
+	fd_QueryRequestVerificationRequest_chain_id       protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationRequest_validator      protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationRequest_request_id     protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationRequest_external_id    protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationRequest_data_source_id protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationRequest_reporter       protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationRequest_signature      protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationRequest_max_delay      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryRequestVerificationRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestVerificationRequest")
+	fd_QueryRequestVerificationRequest_chain_id = md_QueryRequestVerificationRequest.Fields().ByName("chain_id")
+	fd_QueryRequestVerificationRequest_validator = md_QueryRequestVerificationRequest.Fields().ByName("validator")
+	fd_QueryRequestVerificationRequest_request_id = md_QueryRequestVerificationRequest.Fields().ByName("request_id")
+	fd_QueryRequestVerificationRequest_external_id = md_QueryRequestVerificationRequest.Fields().ByName("external_id")
+	fd_QueryRequestVerificationRequest_data_source_id = md_QueryRequestVerificationRequest.Fields().ByName("data_source_id")
+	fd_QueryRequestVerificationRequest_reporter = md_QueryRequestVerificationRequest.Fields().ByName("reporter")
+	fd_QueryRequestVerificationRequest_signature = md_QueryRequestVerificationRequest.Fields().ByName("signature")
+	fd_QueryRequestVerificationRequest_max_delay = md_QueryRequestVerificationRequest.Fields().ByName("max_delay")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRequestVerificationRequest)(nil)
+
+type fastReflection_QueryRequestVerificationRequest QueryRequestVerificationRequest
+
+func (x *QueryRequestVerificationRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRequestVerificationRequest)(x)
+}
+
+func (x *QueryRequestVerificationRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRequestVerificationRequest_messageType fastReflection_QueryRequestVerificationRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRequestVerificationRequest_messageType{}
+
+type fastReflection_QueryRequestVerificationRequest_messageType struct{}
+
+func (x fastReflection_QueryRequestVerificationRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRequestVerificationRequest)(nil)
+}
+func (x fastReflection_QueryRequestVerificationRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestVerificationRequest)
+}
+func (x fastReflection_QueryRequestVerificationRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestVerificationRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRequestVerificationRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestVerificationRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRequestVerificationRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRequestVerificationRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRequestVerificationRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestVerificationRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRequestVerificationRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryRequestVerificationRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRequestVerificationRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChainId != "" {
+		value := protoreflect.ValueOfString(x.ChainId)
+		if !f(fd_QueryRequestVerificationRequest_chain_id, value) {
+			return
+		}
+	}
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_QueryRequestVerificationRequest_validator, value) {
+			return
+		}
+	}
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_QueryRequestVerificationRequest_request_id, value) {
+			return
+		}
+	}
+	if x.ExternalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExternalId)
+		if !f(fd_QueryRequestVerificationRequest_external_id, value) {
+			return
+		}
+	}
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_QueryRequestVerificationRequest_data_source_id, value) {
+			return
+		}
+	}
+	if x.Reporter != "" {
+		value := protoreflect.ValueOfString(x.Reporter)
+		if !f(fd_QueryRequestVerificationRequest_reporter, value) {
+			return
+		}
+	}
+	if len(x.Signature) != 0 {
+		value := protoreflect.ValueOfBytes(x.Signature)
+		if !f(fd_QueryRequestVerificationRequest_signature, value) {
+			return
+		}
+	}
+	if x.MaxDelay != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxDelay)
+		if !f(fd_QueryRequestVerificationRequest_max_delay, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRequestVerificationRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
+		return x.ChainId != ""
+	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
+		return x.Validator != ""
+	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
+		return x.RequestId != uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
+		return x.ExternalId != uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
+		return x.DataSourceId != uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
+		return x.Reporter != ""
+	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
+		return len(x.Signature) != 0
+	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
+		return x.MaxDelay != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestVerificationRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
+		x.ChainId = ""
+	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
+		x.Validator = ""
+	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
+		x.RequestId = uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
+		x.ExternalId = uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
+		x.DataSourceId = uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
+		x.Reporter = ""
+	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
+		x.Signature = nil
+	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
+		x.MaxDelay = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRequestVerificationRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
+		value := x.ExternalId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
+		value := x.DataSourceId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
+		value := x.Reporter
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
+		value := x.Signature
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
+		value := x.MaxDelay
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestVerificationRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
+		x.ChainId = value.Interface().(string)
+	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
+		x.Validator = value.Interface().(string)
+	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
+		x.RequestId = value.Uint()
+	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
+		x.ExternalId = value.Uint()
+	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
+		x.DataSourceId = value.Uint()
+	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
+		x.Reporter = value.Interface().(string)
+	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
+		x.Signature = value.Bytes()
+	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
+		x.MaxDelay = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestVerificationRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
+		panic(fmt.Errorf("field chain_id of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
+		panic(fmt.Errorf("field validator of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
+		panic(fmt.Errorf("field external_id of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
+		panic(fmt.Errorf("field reporter of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
+		panic(fmt.Errorf("field signature of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
+		panic(fmt.Errorf("field max_delay of message band.oracle.v1.QueryRequestVerificationRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRequestVerificationRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestVerificationRequest.chain_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.QueryRequestVerificationRequest.validator":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.QueryRequestVerificationRequest.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryRequestVerificationRequest.external_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryRequestVerificationRequest.data_source_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryRequestVerificationRequest.reporter":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.QueryRequestVerificationRequest.signature":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.QueryRequestVerificationRequest.max_delay":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRequestVerificationRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestVerificationRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRequestVerificationRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestVerificationRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRequestVerificationRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRequestVerificationRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRequestVerificationRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.ExternalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExternalId))
+		}
+		if x.DataSourceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceId))
+		}
+		l = len(x.Reporter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxDelay != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxDelay))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestVerificationRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxDelay != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxDelay))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Reporter) > 0 {
+			i -= len(x.Reporter)
+			copy(dAtA[i:], x.Reporter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reporter)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ExternalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ChainId) > 0 {
+			i -= len(x.ChainId)
+			copy(dAtA[i:], x.ChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestVerificationRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestVerificationRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				}
+				x.ExternalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExternalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+				}
+				x.DataSourceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DataSourceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reporter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = append(x.Signature[:0], dAtA[iNdEx:postIndex]...)
+				if x.Signature == nil {
+					x.Signature = []byte{}
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDelay", wireType)
+				}
+				x.MaxDelay = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxDelay |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryRequestVerificationResponse                protoreflect.MessageDescriptor
+	fd_QueryRequestVerificationResponse_chain_id       protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationResponse_validator      protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationResponse_request_id     protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationResponse_external_id    protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationResponse_data_source_id protoreflect.FieldDescriptor
+	fd_QueryRequestVerificationResponse_is_delay       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryRequestVerificationResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestVerificationResponse")
+	fd_QueryRequestVerificationResponse_chain_id = md_QueryRequestVerificationResponse.Fields().ByName("chain_id")
+	fd_QueryRequestVerificationResponse_validator = md_QueryRequestVerificationResponse.Fields().ByName("validator")
+	fd_QueryRequestVerificationResponse_request_id = md_QueryRequestVerificationResponse.Fields().ByName("request_id")
+	fd_QueryRequestVerificationResponse_external_id = md_QueryRequestVerificationResponse.Fields().ByName("external_id")
+	fd_QueryRequestVerificationResponse_data_source_id = md_QueryRequestVerificationResponse.Fields().ByName("data_source_id")
+	fd_QueryRequestVerificationResponse_is_delay = md_QueryRequestVerificationResponse.Fields().ByName("is_delay")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRequestVerificationResponse)(nil)
+
+type fastReflection_QueryRequestVerificationResponse QueryRequestVerificationResponse
+
+func (x *QueryRequestVerificationResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRequestVerificationResponse)(x)
+}
+
+func (x *QueryRequestVerificationResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRequestVerificationResponse_messageType fastReflection_QueryRequestVerificationResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRequestVerificationResponse_messageType{}
+
+type fastReflection_QueryRequestVerificationResponse_messageType struct{}
+
+func (x fastReflection_QueryRequestVerificationResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRequestVerificationResponse)(nil)
+}
+func (x fastReflection_QueryRequestVerificationResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestVerificationResponse)
+}
+func (x fastReflection_QueryRequestVerificationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestVerificationResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRequestVerificationResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestVerificationResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRequestVerificationResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRequestVerificationResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRequestVerificationResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestVerificationResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRequestVerificationResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryRequestVerificationResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRequestVerificationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChainId != "" {
+		value := protoreflect.ValueOfString(x.ChainId)
+		if !f(fd_QueryRequestVerificationResponse_chain_id, value) {
+			return
+		}
+	}
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_QueryRequestVerificationResponse_validator, value) {
+			return
+		}
+	}
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_QueryRequestVerificationResponse_request_id, value) {
+			return
+		}
+	}
+	if x.ExternalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExternalId)
+		if !f(fd_QueryRequestVerificationResponse_external_id, value) {
+			return
+		}
+	}
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_QueryRequestVerificationResponse_data_source_id, value) {
+			return
+		}
+	}
+	if x.IsDelay != false {
+		value := protoreflect.ValueOfBool(x.IsDelay)
+		if !f(fd_QueryRequestVerificationResponse_is_delay, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRequestVerificationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
+		return x.ChainId != ""
+	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
+		return x.Validator != ""
+	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
+		return x.RequestId != uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
+		return x.ExternalId != uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
+		return x.DataSourceId != uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
+		return x.IsDelay != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestVerificationResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
+		x.ChainId = ""
+	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
+		x.Validator = ""
+	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
+		x.RequestId = uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
+		x.ExternalId = uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
+		x.DataSourceId = uint64(0)
+	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
+		x.IsDelay = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRequestVerificationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
+		value := x.ExternalId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
+		value := x.DataSourceId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
+		value := x.IsDelay
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestVerificationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
+		x.ChainId = value.Interface().(string)
+	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
+		x.Validator = value.Interface().(string)
+	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
+		x.RequestId = value.Uint()
+	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
+		x.ExternalId = value.Uint()
+	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
+		x.DataSourceId = value.Uint()
+	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
+		x.IsDelay = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestVerificationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
+		panic(fmt.Errorf("field chain_id of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
+		panic(fmt.Errorf("field validator of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
+		panic(fmt.Errorf("field external_id of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
+	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
+		panic(fmt.Errorf("field is_delay of message band.oracle.v1.QueryRequestVerificationResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRequestVerificationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestVerificationResponse.chain_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.QueryRequestVerificationResponse.validator":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.QueryRequestVerificationResponse.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryRequestVerificationResponse.external_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryRequestVerificationResponse.data_source_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryRequestVerificationResponse.is_delay":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestVerificationResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestVerificationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRequestVerificationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestVerificationResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRequestVerificationResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestVerificationResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRequestVerificationResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRequestVerificationResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.ExternalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExternalId))
+		}
+		if x.DataSourceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceId))
+		}
+		if x.IsDelay {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestVerificationResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.IsDelay {
+			i--
+			if x.IsDelay {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ExternalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ChainId) > 0 {
+			i -= len(x.ChainId)
+			copy(dAtA[i:], x.ChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestVerificationResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestVerificationResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				}
+				x.ExternalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExternalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+				}
+				x.DataSourceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DataSourceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsDelay", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsDelay = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryRequestSubscriptionRequest                 protoreflect.MessageDescriptor
+	fd_QueryRequestSubscriptionRequest_subscription_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryRequestSubscriptionRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestSubscriptionRequest")
+	fd_QueryRequestSubscriptionRequest_subscription_id = md_QueryRequestSubscriptionRequest.Fields().ByName("subscription_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRequestSubscriptionRequest)(nil)
+
+type fastReflection_QueryRequestSubscriptionRequest QueryRequestSubscriptionRequest
+
+func (x *QueryRequestSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRequestSubscriptionRequest)(x)
+}
+
+func (x *QueryRequestSubscriptionRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRequestSubscriptionRequest_messageType fastReflection_QueryRequestSubscriptionRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRequestSubscriptionRequest_messageType{}
+
+type fastReflection_QueryRequestSubscriptionRequest_messageType struct{}
+
+func (x fastReflection_QueryRequestSubscriptionRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRequestSubscriptionRequest)(nil)
+}
+func (x fastReflection_QueryRequestSubscriptionRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestSubscriptionRequest)
+}
+func (x fastReflection_QueryRequestSubscriptionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestSubscriptionRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRequestSubscriptionRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestSubscriptionRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRequestSubscriptionRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRequestSubscriptionRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRequestSubscriptionRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestSubscriptionRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRequestSubscriptionRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryRequestSubscriptionRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRequestSubscriptionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SubscriptionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SubscriptionId)
+		if !f(fd_QueryRequestSubscriptionRequest_subscription_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRequestSubscriptionRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionRequest.subscription_id":
+		return x.SubscriptionId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSubscriptionRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionRequest.subscription_id":
+		x.SubscriptionId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRequestSubscriptionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionRequest.subscription_id":
+		value := x.SubscriptionId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSubscriptionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionRequest.subscription_id":
+		x.SubscriptionId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSubscriptionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionRequest.subscription_id":
+		panic(fmt.Errorf("field subscription_id of message band.oracle.v1.QueryRequestSubscriptionRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRequestSubscriptionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionRequest.subscription_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRequestSubscriptionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestSubscriptionRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRequestSubscriptionRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSubscriptionRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRequestSubscriptionRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRequestSubscriptionRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRequestSubscriptionRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SubscriptionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubscriptionId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestSubscriptionRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SubscriptionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubscriptionId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestSubscriptionRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSubscriptionRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSubscriptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
+				}
+				x.SubscriptionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubscriptionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryRequestSubscriptionResponse_2_list)(nil)
+
+type _QueryRequestSubscriptionResponse_2_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryRequestSubscriptionResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryRequestSubscriptionResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryRequestSubscriptionResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryRequestSubscriptionResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryRequestSubscriptionResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryRequestSubscriptionResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryRequestSubscriptionResponse_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryRequestSubscriptionResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryRequestSubscriptionResponse              protoreflect.MessageDescriptor
+	fd_QueryRequestSubscriptionResponse_subscription protoreflect.FieldDescriptor
+	fd_QueryRequestSubscriptionResponse_balance      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryRequestSubscriptionResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestSubscriptionResponse")
+	fd_QueryRequestSubscriptionResponse_subscription = md_QueryRequestSubscriptionResponse.Fields().ByName("subscription")
+	fd_QueryRequestSubscriptionResponse_balance = md_QueryRequestSubscriptionResponse.Fields().ByName("balance")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRequestSubscriptionResponse)(nil)
+
+type fastReflection_QueryRequestSubscriptionResponse QueryRequestSubscriptionResponse
+
+func (x *QueryRequestSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRequestSubscriptionResponse)(x)
+}
+
+func (x *QueryRequestSubscriptionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRequestSubscriptionResponse_messageType fastReflection_QueryRequestSubscriptionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRequestSubscriptionResponse_messageType{}
+
+type fastReflection_QueryRequestSubscriptionResponse_messageType struct{}
+
+func (x fastReflection_QueryRequestSubscriptionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRequestSubscriptionResponse)(nil)
+}
+func (x fastReflection_QueryRequestSubscriptionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestSubscriptionResponse)
+}
+func (x fastReflection_QueryRequestSubscriptionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestSubscriptionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRequestSubscriptionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestSubscriptionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRequestSubscriptionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRequestSubscriptionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRequestSubscriptionResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestSubscriptionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRequestSubscriptionResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryRequestSubscriptionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRequestSubscriptionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Subscription != nil {
+		value := protoreflect.ValueOfMessage(x.Subscription.ProtoReflect())
+		if !f(fd_QueryRequestSubscriptionResponse_subscription, value) {
+			return
+		}
+	}
+	if len(x.Balance) != 0 {
+		value := protoreflect.ValueOfList(&_QueryRequestSubscriptionResponse_2_list{list: &x.Balance})
+		if !f(fd_QueryRequestSubscriptionResponse_balance, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRequestSubscriptionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionResponse.subscription":
+		return x.Subscription != nil
+	case "band.oracle.v1.QueryRequestSubscriptionResponse.balance":
+		return len(x.Balance) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSubscriptionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionResponse.subscription":
+		x.Subscription = nil
+	case "band.oracle.v1.QueryRequestSubscriptionResponse.balance":
+		x.Balance = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRequestSubscriptionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionResponse.subscription":
+		value := x.Subscription
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.oracle.v1.QueryRequestSubscriptionResponse.balance":
+		if len(x.Balance) == 0 {
+			return protoreflect.ValueOfList(&_QueryRequestSubscriptionResponse_2_list{})
+		}
+		listValue := &_QueryRequestSubscriptionResponse_2_list{list: &x.Balance}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSubscriptionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionResponse.subscription":
+		x.Subscription = value.Message().Interface().(*RequestSubscription)
+	case "band.oracle.v1.QueryRequestSubscriptionResponse.balance":
+		lv := value.List()
+		clv := lv.(*_QueryRequestSubscriptionResponse_2_list)
+		x.Balance = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSubscriptionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionResponse.subscription":
+		if x.Subscription == nil {
+			x.Subscription = new(RequestSubscription)
+		}
+		return protoreflect.ValueOfMessage(x.Subscription.ProtoReflect())
+	case "band.oracle.v1.QueryRequestSubscriptionResponse.balance":
+		if x.Balance == nil {
+			x.Balance = []*v1beta11.Coin{}
+		}
+		value := &_QueryRequestSubscriptionResponse_2_list{list: &x.Balance}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRequestSubscriptionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionResponse.subscription":
+		m := new(RequestSubscription)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.oracle.v1.QueryRequestSubscriptionResponse.balance":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryRequestSubscriptionResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRequestSubscriptionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestSubscriptionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRequestSubscriptionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSubscriptionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRequestSubscriptionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRequestSubscriptionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRequestSubscriptionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Subscription != nil {
+			l = options.Size(x.Subscription)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Balance) > 0 {
+			for _, e := range x.Balance {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestSubscriptionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Balance) > 0 {
+			for iNdEx := len(x.Balance) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Balance[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Subscription != nil {
+			encoded, err := options.Marshal(x.Subscription)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestSubscriptionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSubscriptionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Subscription", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Subscription == nil {
+					x.Subscription = &RequestSubscription{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Subscription); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Balance = append(x.Balance, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Balance[len(x.Balance)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryRequestSubscriptionsRequest            protoreflect.MessageDescriptor
+	fd_QueryRequestSubscriptionsRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryRequestSubscriptionsRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestSubscriptionsRequest")
+	fd_QueryRequestSubscriptionsRequest_pagination = md_QueryRequestSubscriptionsRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRequestSubscriptionsRequest)(nil)
+
+type fastReflection_QueryRequestSubscriptionsRequest QueryRequestSubscriptionsRequest
+
+func (x *QueryRequestSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRequestSubscriptionsRequest)(x)
+}
+
+func (x *QueryRequestSubscriptionsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRequestSubscriptionsRequest_messageType fastReflection_QueryRequestSubscriptionsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRequestSubscriptionsRequest_messageType{}
+
+type fastReflection_QueryRequestSubscriptionsRequest_messageType struct{}
+
+func (x fastReflection_QueryRequestSubscriptionsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRequestSubscriptionsRequest)(nil)
+}
+func (x fastReflection_QueryRequestSubscriptionsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestSubscriptionsRequest)
+}
+func (x fastReflection_QueryRequestSubscriptionsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestSubscriptionsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRequestSubscriptionsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestSubscriptionsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRequestSubscriptionsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRequestSubscriptionsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRequestSubscriptionsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestSubscriptionsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRequestSubscriptionsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryRequestSubscriptionsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRequestSubscriptionsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryRequestSubscriptionsRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRequestSubscriptionsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionsRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSubscriptionsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionsRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRequestSubscriptionsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSubscriptionsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSubscriptionsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRequestSubscriptionsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRequestSubscriptionsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestSubscriptionsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRequestSubscriptionsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSubscriptionsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRequestSubscriptionsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRequestSubscriptionsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRequestSubscriptionsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestSubscriptionsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestSubscriptionsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSubscriptionsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSubscriptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryRequestSubscriptionsResponse_1_list)(nil)
+
+type _QueryRequestSubscriptionsResponse_1_list struct {
+	list *[]*RequestSubscription
+}
+
+func (x *_QueryRequestSubscriptionsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryRequestSubscriptionsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryRequestSubscriptionsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RequestSubscription)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryRequestSubscriptionsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RequestSubscription)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryRequestSubscriptionsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(RequestSubscription)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryRequestSubscriptionsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryRequestSubscriptionsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(RequestSubscription)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryRequestSubscriptionsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryRequestSubscriptionsResponse               protoreflect.MessageDescriptor
+	fd_QueryRequestSubscriptionsResponse_subscriptions protoreflect.FieldDescriptor
+	fd_QueryRequestSubscriptionsResponse_pagination    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryRequestSubscriptionsResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryRequestSubscriptionsResponse")
+	fd_QueryRequestSubscriptionsResponse_subscriptions = md_QueryRequestSubscriptionsResponse.Fields().ByName("subscriptions")
+	fd_QueryRequestSubscriptionsResponse_pagination = md_QueryRequestSubscriptionsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRequestSubscriptionsResponse)(nil)
+
+type fastReflection_QueryRequestSubscriptionsResponse QueryRequestSubscriptionsResponse
+
+func (x *QueryRequestSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRequestSubscriptionsResponse)(x)
+}
+
+func (x *QueryRequestSubscriptionsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRequestSubscriptionsResponse_messageType fastReflection_QueryRequestSubscriptionsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRequestSubscriptionsResponse_messageType{}
+
+type fastReflection_QueryRequestSubscriptionsResponse_messageType struct{}
+
+func (x fastReflection_QueryRequestSubscriptionsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRequestSubscriptionsResponse)(nil)
+}
+func (x fastReflection_QueryRequestSubscriptionsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestSubscriptionsResponse)
+}
+func (x fastReflection_QueryRequestSubscriptionsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestSubscriptionsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRequestSubscriptionsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRequestSubscriptionsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRequestSubscriptionsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRequestSubscriptionsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRequestSubscriptionsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryRequestSubscriptionsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRequestSubscriptionsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryRequestSubscriptionsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRequestSubscriptionsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Subscriptions) != 0 {
+		value := protoreflect.ValueOfList(&_QueryRequestSubscriptionsResponse_1_list{list: &x.Subscriptions})
+		if !f(fd_QueryRequestSubscriptionsResponse_subscriptions, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryRequestSubscriptionsResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRequestSubscriptionsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionsResponse.subscriptions":
+		return len(x.Subscriptions) != 0
+	case "band.oracle.v1.QueryRequestSubscriptionsResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSubscriptionsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionsResponse.subscriptions":
+		x.Subscriptions = nil
+	case "band.oracle.v1.QueryRequestSubscriptionsResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRequestSubscriptionsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionsResponse.subscriptions":
+		if len(x.Subscriptions) == 0 {
+			return protoreflect.ValueOfList(&_QueryRequestSubscriptionsResponse_1_list{})
+		}
+		listValue := &_QueryRequestSubscriptionsResponse_1_list{list: &x.Subscriptions}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.QueryRequestSubscriptionsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSubscriptionsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionsResponse.subscriptions":
+		lv := value.List()
+		clv := lv.(*_QueryRequestSubscriptionsResponse_1_list)
+		x.Subscriptions = *clv.list
+	case "band.oracle.v1.QueryRequestSubscriptionsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSubscriptionsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionsResponse.subscriptions":
+		if x.Subscriptions == nil {
+			x.Subscriptions = []*RequestSubscription{}
+		}
+		value := &_QueryRequestSubscriptionsResponse_1_list{list: &x.Subscriptions}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.QueryRequestSubscriptionsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRequestSubscriptionsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryRequestSubscriptionsResponse.subscriptions":
+		list := []*RequestSubscription{}
+		return protoreflect.ValueOfList(&_QueryRequestSubscriptionsResponse_1_list{list: &list})
+	case "band.oracle.v1.QueryRequestSubscriptionsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryRequestSubscriptionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryRequestSubscriptionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRequestSubscriptionsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryRequestSubscriptionsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRequestSubscriptionsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRequestSubscriptionsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRequestSubscriptionsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRequestSubscriptionsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRequestSubscriptionsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Subscriptions) > 0 {
+			for _, e := range x.Subscriptions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestSubscriptionsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Subscriptions) > 0 {
+			for iNdEx := len(x.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Subscriptions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRequestSubscriptionsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSubscriptionsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRequestSubscriptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Subscriptions = append(x.Subscriptions, &RequestSubscription{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Subscriptions[len(x.Subscriptions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryDataSourceVersionsRequest                protoreflect.MessageDescriptor
+	fd_QueryDataSourceVersionsRequest_data_source_id protoreflect.FieldDescriptor
+	fd_QueryDataSourceVersionsRequest_pagination     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryDataSourceVersionsRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryDataSourceVersionsRequest")
+	fd_QueryDataSourceVersionsRequest_data_source_id = md_QueryDataSourceVersionsRequest.Fields().ByName("data_source_id")
+	fd_QueryDataSourceVersionsRequest_pagination = md_QueryDataSourceVersionsRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDataSourceVersionsRequest)(nil)
+
+type fastReflection_QueryDataSourceVersionsRequest QueryDataSourceVersionsRequest
+
+func (x *QueryDataSourceVersionsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDataSourceVersionsRequest)(x)
+}
+
+func (x *QueryDataSourceVersionsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDataSourceVersionsRequest_messageType fastReflection_QueryDataSourceVersionsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDataSourceVersionsRequest_messageType{}
+
+type fastReflection_QueryDataSourceVersionsRequest_messageType struct{}
+
+func (x fastReflection_QueryDataSourceVersionsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDataSourceVersionsRequest)(nil)
+}
+func (x fastReflection_QueryDataSourceVersionsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDataSourceVersionsRequest)
+}
+func (x fastReflection_QueryDataSourceVersionsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDataSourceVersionsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDataSourceVersionsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDataSourceVersionsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDataSourceVersionsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDataSourceVersionsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDataSourceVersionsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryDataSourceVersionsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDataSourceVersionsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryDataSourceVersionsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDataSourceVersionsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_QueryDataSourceVersionsRequest_data_source_id, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryDataSourceVersionsRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDataSourceVersionsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.data_source_id":
+		return x.DataSourceId != uint64(0)
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDataSourceVersionsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.data_source_id":
+		x.DataSourceId = uint64(0)
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDataSourceVersionsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.data_source_id":
+		value := x.DataSourceId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDataSourceVersionsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.data_source_id":
+		x.DataSourceId = value.Uint()
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDataSourceVersionsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.QueryDataSourceVersionsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDataSourceVersionsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.data_source_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryDataSourceVersionsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDataSourceVersionsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryDataSourceVersionsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDataSourceVersionsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDataSourceVersionsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDataSourceVersionsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDataSourceVersionsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDataSourceVersionsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DataSourceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceId))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDataSourceVersionsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDataSourceVersionsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDataSourceVersionsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDataSourceVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+				}
+				x.DataSourceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DataSourceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryDataSourceVersionsResponse_1_list)(nil)
+
+type _QueryDataSourceVersionsResponse_1_list struct {
+	list *[]*DataSourceVersion
+}
+
+func (x *_QueryDataSourceVersionsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryDataSourceVersionsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryDataSourceVersionsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DataSourceVersion)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryDataSourceVersionsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DataSourceVersion)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryDataSourceVersionsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(DataSourceVersion)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDataSourceVersionsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryDataSourceVersionsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(DataSourceVersion)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDataSourceVersionsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryDataSourceVersionsResponse            protoreflect.MessageDescriptor
+	fd_QueryDataSourceVersionsResponse_versions   protoreflect.FieldDescriptor
+	fd_QueryDataSourceVersionsResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryDataSourceVersionsResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryDataSourceVersionsResponse")
+	fd_QueryDataSourceVersionsResponse_versions = md_QueryDataSourceVersionsResponse.Fields().ByName("versions")
+	fd_QueryDataSourceVersionsResponse_pagination = md_QueryDataSourceVersionsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDataSourceVersionsResponse)(nil)
+
+type fastReflection_QueryDataSourceVersionsResponse QueryDataSourceVersionsResponse
+
+func (x *QueryDataSourceVersionsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDataSourceVersionsResponse)(x)
+}
+
+func (x *QueryDataSourceVersionsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDataSourceVersionsResponse_messageType fastReflection_QueryDataSourceVersionsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDataSourceVersionsResponse_messageType{}
+
+type fastReflection_QueryDataSourceVersionsResponse_messageType struct{}
+
+func (x fastReflection_QueryDataSourceVersionsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDataSourceVersionsResponse)(nil)
+}
+func (x fastReflection_QueryDataSourceVersionsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDataSourceVersionsResponse)
+}
+func (x fastReflection_QueryDataSourceVersionsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDataSourceVersionsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDataSourceVersionsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDataSourceVersionsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDataSourceVersionsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDataSourceVersionsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDataSourceVersionsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryDataSourceVersionsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDataSourceVersionsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryDataSourceVersionsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDataSourceVersionsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Versions) != 0 {
+		value := protoreflect.ValueOfList(&_QueryDataSourceVersionsResponse_1_list{list: &x.Versions})
+		if !f(fd_QueryDataSourceVersionsResponse_versions, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryDataSourceVersionsResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDataSourceVersionsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.versions":
+		return len(x.Versions) != 0
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDataSourceVersionsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.versions":
+		x.Versions = nil
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDataSourceVersionsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.versions":
+		if len(x.Versions) == 0 {
+			return protoreflect.ValueOfList(&_QueryDataSourceVersionsResponse_1_list{})
+		}
+		listValue := &_QueryDataSourceVersionsResponse_1_list{list: &x.Versions}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDataSourceVersionsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.versions":
+		lv := value.List()
+		clv := lv.(*_QueryDataSourceVersionsResponse_1_list)
+		x.Versions = *clv.list
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDataSourceVersionsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.versions":
+		if x.Versions == nil {
+			x.Versions = []*DataSourceVersion{}
+		}
+		value := &_QueryDataSourceVersionsResponse_1_list{list: &x.Versions}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDataSourceVersionsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.versions":
+		list := []*DataSourceVersion{}
+		return protoreflect.ValueOfList(&_QueryDataSourceVersionsResponse_1_list{list: &list})
+	case "band.oracle.v1.QueryDataSourceVersionsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryDataSourceVersionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryDataSourceVersionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDataSourceVersionsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryDataSourceVersionsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDataSourceVersionsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDataSourceVersionsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDataSourceVersionsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDataSourceVersionsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDataSourceVersionsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Versions) > 0 {
+			for _, e := range x.Versions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDataSourceVersionsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Versions) > 0 {
+			for iNdEx := len(x.Versions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Versions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDataSourceVersionsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDataSourceVersionsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDataSourceVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Versions = append(x.Versions, &DataSourceVersion{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Versions[len(x.Versions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryOracleScriptVersionsRequest                  protoreflect.MessageDescriptor
+	fd_QueryOracleScriptVersionsRequest_oracle_script_id protoreflect.FieldDescriptor
+	fd_QueryOracleScriptVersionsRequest_pagination       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryOracleScriptVersionsRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryOracleScriptVersionsRequest")
+	fd_QueryOracleScriptVersionsRequest_oracle_script_id = md_QueryOracleScriptVersionsRequest.Fields().ByName("oracle_script_id")
+	fd_QueryOracleScriptVersionsRequest_pagination = md_QueryOracleScriptVersionsRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryOracleScriptVersionsRequest)(nil)
+
+type fastReflection_QueryOracleScriptVersionsRequest QueryOracleScriptVersionsRequest
+
+func (x *QueryOracleScriptVersionsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOracleScriptVersionsRequest)(x)
+}
+
+func (x *QueryOracleScriptVersionsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryOracleScriptVersionsRequest_messageType fastReflection_QueryOracleScriptVersionsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOracleScriptVersionsRequest_messageType{}
+
+type fastReflection_QueryOracleScriptVersionsRequest_messageType struct{}
+
+func (x fastReflection_QueryOracleScriptVersionsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOracleScriptVersionsRequest)(nil)
+}
+func (x fastReflection_QueryOracleScriptVersionsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOracleScriptVersionsRequest)
+}
+func (x fastReflection_QueryOracleScriptVersionsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOracleScriptVersionsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOracleScriptVersionsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOracleScriptVersionsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryOracleScriptVersionsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryOracleScriptVersionsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryOracleScriptVersionsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_QueryOracleScriptVersionsRequest_oracle_script_id, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryOracleScriptVersionsRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOracleScriptVersionsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.QueryOracleScriptVersionsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryOracleScriptVersionsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryOracleScriptVersionsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryOracleScriptVersionsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryOracleScriptVersionsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryOracleScriptVersionsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOracleScriptVersionsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryOracleScriptVersionsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryOracleScriptVersionsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryOracleScriptVersionsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOracleScriptVersionsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOracleScriptVersionsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOracleScriptVersionsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOracleScriptVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				}
+				x.OracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryOracleScriptVersionsResponse_1_list)(nil)
+
+type _QueryOracleScriptVersionsResponse_1_list struct {
+	list *[]*OracleScriptVersion
+}
+
+func (x *_QueryOracleScriptVersionsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryOracleScriptVersionsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryOracleScriptVersionsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OracleScriptVersion)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryOracleScriptVersionsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OracleScriptVersion)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryOracleScriptVersionsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(OracleScriptVersion)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryOracleScriptVersionsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryOracleScriptVersionsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(OracleScriptVersion)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryOracleScriptVersionsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryOracleScriptVersionsResponse            protoreflect.MessageDescriptor
+	fd_QueryOracleScriptVersionsResponse_versions   protoreflect.FieldDescriptor
+	fd_QueryOracleScriptVersionsResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryOracleScriptVersionsResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryOracleScriptVersionsResponse")
+	fd_QueryOracleScriptVersionsResponse_versions = md_QueryOracleScriptVersionsResponse.Fields().ByName("versions")
+	fd_QueryOracleScriptVersionsResponse_pagination = md_QueryOracleScriptVersionsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryOracleScriptVersionsResponse)(nil)
+
+type fastReflection_QueryOracleScriptVersionsResponse QueryOracleScriptVersionsResponse
+
+func (x *QueryOracleScriptVersionsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOracleScriptVersionsResponse)(x)
+}
+
+func (x *QueryOracleScriptVersionsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryOracleScriptVersionsResponse_messageType fastReflection_QueryOracleScriptVersionsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOracleScriptVersionsResponse_messageType{}
+
+type fastReflection_QueryOracleScriptVersionsResponse_messageType struct{}
+
+func (x fastReflection_QueryOracleScriptVersionsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOracleScriptVersionsResponse)(nil)
+}
+func (x fastReflection_QueryOracleScriptVersionsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOracleScriptVersionsResponse)
+}
+func (x fastReflection_QueryOracleScriptVersionsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOracleScriptVersionsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOracleScriptVersionsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOracleScriptVersionsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryOracleScriptVersionsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryOracleScriptVersionsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryOracleScriptVersionsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Versions) != 0 {
+		value := protoreflect.ValueOfList(&_QueryOracleScriptVersionsResponse_1_list{list: &x.Versions})
+		if !f(fd_QueryOracleScriptVersionsResponse_versions, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryOracleScriptVersionsResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.versions":
+		return len(x.Versions) != 0
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.versions":
+		x.Versions = nil
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.versions":
+		if len(x.Versions) == 0 {
+			return protoreflect.ValueOfList(&_QueryOracleScriptVersionsResponse_1_list{})
+		}
+		listValue := &_QueryOracleScriptVersionsResponse_1_list{list: &x.Versions}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.versions":
+		lv := value.List()
+		clv := lv.(*_QueryOracleScriptVersionsResponse_1_list)
+		x.Versions = *clv.list
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOracleScriptVersionsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.versions":
+		if x.Versions == nil {
+			x.Versions = []*OracleScriptVersion{}
+		}
+		value := &_QueryOracleScriptVersionsResponse_1_list{list: &x.Versions}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryOracleScriptVersionsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.versions":
+		list := []*OracleScriptVersion{}
+		return protoreflect.ValueOfList(&_QueryOracleScriptVersionsResponse_1_list{list: &list})
+	case "band.oracle.v1.QueryOracleScriptVersionsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryOracleScriptVersionsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryOracleScriptVersionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryOracleScriptVersionsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryOracleScriptVersionsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryOracleScriptVersionsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOracleScriptVersionsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryOracleScriptVersionsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryOracleScriptVersionsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryOracleScriptVersionsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Versions) > 0 {
+			for _, e := range x.Versions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOracleScriptVersionsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Versions) > 0 {
+			for iNdEx := len(x.Versions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Versions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOracleScriptVersionsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOracleScriptVersionsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOracleScriptVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Versions = append(x.Versions, &OracleScriptVersion{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Versions[len(x.Versions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: band/oracle/v1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryCountsRequest is request type for the Query/Count RPC method.
+type QueryCountsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryCountsRequest) Reset() {
+	*x = QueryCountsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCountsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryCountsRequest.ProtoReflect.Descriptor instead.
+func (*QueryCountsRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryCountsResponse is response type for the Query/Count RPC method.
+type QueryCountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DataSourceCount is total number of data sources available on the chain
+	DataSourceCount uint64 `protobuf:"varint,1,opt,name=data_source_count,json=dataSourceCount,proto3" json:"data_source_count,omitempty"`
+	// OracleScriptCount is total number of oracle scripts available on the chain
+	OracleScriptCount uint64 `protobuf:"varint,2,opt,name=oracle_script_count,json=oracleScriptCount,proto3" json:"oracle_script_count,omitempty"`
+	// RequestCount is total number of requests submitted to the chain
+	RequestCount uint64 `protobuf:"varint,3,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+}
+
+func (x *QueryCountsResponse) Reset() {
+	*x = QueryCountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCountsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryCountsResponse.ProtoReflect.Descriptor instead.
+func (*QueryCountsResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryCountsResponse) GetDataSourceCount() uint64 {
+	if x != nil {
+		return x.DataSourceCount
+	}
+	return 0
+}
+
+func (x *QueryCountsResponse) GetOracleScriptCount() uint64 {
+	if x != nil {
+		return x.OracleScriptCount
+	}
+	return 0
+}
+
+func (x *QueryCountsResponse) GetRequestCount() uint64 {
+	if x != nil {
+		return x.RequestCount
+	}
+	return 0
+}
+
+// QueryDataRequest is request type for the Query/Data RPC method.
+type QueryDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DataHash is SHA256 hash of the file's content, which can be data source or
+	// oracle script
+	DataHash string `protobuf:"bytes,1,opt,name=data_hash,json=dataHash,proto3" json:"data_hash,omitempty"`
+}
+
+func (x *QueryDataRequest) Reset() {
+	*x = QueryDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDataRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryDataRequest.ProtoReflect.Descriptor instead.
+func (*QueryDataRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryDataRequest) GetDataHash() string {
+	if x != nil {
+		return x.DataHash
+	}
+	return ""
+}
+
+// QueryDataResponse is response type for the Query/Data RPC method.
+type QueryDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Data is file's content, which can be data source or oracle script
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *QueryDataResponse) Reset() {
+	*x = QueryDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDataResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryDataResponse.ProtoReflect.Descriptor instead.
+func (*QueryDataResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryDataResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// QueryDataSourceRequest is request type for the Query/DataSource RPC method.
+type QueryDataSourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DataSourceID is ID of a data source script
+	DataSourceId uint64 `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+}
+
+func (x *QueryDataSourceRequest) Reset() {
+	*x = QueryDataSourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDataSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDataSourceRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryDataSourceRequest.ProtoReflect.Descriptor instead.
+func (*QueryDataSourceRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryDataSourceRequest) GetDataSourceId() uint64 {
+	if x != nil {
+		return x.DataSourceId
+	}
+	return 0
+}
+
+// QueryDataSourceResponse is response type for the Query/DataSource RPC method.
+type QueryDataSourceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DataSource is summary information of a data source
+	DataSource *DataSource `protobuf:"bytes,1,opt,name=data_source,json=dataSource,proto3" json:"data_source,omitempty"`
+}
+
+func (x *QueryDataSourceResponse) Reset() {
+	*x = QueryDataSourceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDataSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDataSourceResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryDataSourceResponse.ProtoReflect.Descriptor instead.
+func (*QueryDataSourceResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryDataSourceResponse) GetDataSource() *DataSource {
+	if x != nil {
+		return x.DataSource
+	}
+	return nil
+}
+
+// QueryOracleScriptRequest is request type for the Query/OracleScript RPC
+// method.
+type QueryOracleScriptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OracleScriptID is ID of an oracle script
+	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+}
+
+func (x *QueryOracleScriptRequest) Reset() {
+	*x = QueryOracleScriptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryOracleScriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryOracleScriptRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryOracleScriptRequest.ProtoReflect.Descriptor instead.
+func (*QueryOracleScriptRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryOracleScriptRequest) GetOracleScriptId() uint64 {
+	if x != nil {
+		return x.OracleScriptId
+	}
+	return 0
+}
+
+// QueryOracleScriptResponse is response type for the Query/OracleScript RPC
+// method.
+type QueryOracleScriptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OracleScript is summary information of an oracle script
+	OracleScript *OracleScript `protobuf:"bytes,1,opt,name=oracle_script,json=oracleScript,proto3" json:"oracle_script,omitempty"`
+}
+
+func (x *QueryOracleScriptResponse) Reset() {
+	*x = QueryOracleScriptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryOracleScriptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryOracleScriptResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryOracleScriptResponse.ProtoReflect.Descriptor instead.
+func (*QueryOracleScriptResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryOracleScriptResponse) GetOracleScript() *OracleScript {
+	if x != nil {
+		return x.OracleScript
+	}
+	return nil
+}
+
+// QueryRequestRequest is request type for the Query/Request RPC method.
+type QueryRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RequestID is ID of an oracle request
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *QueryRequestRequest) Reset() {
+	*x = QueryRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRequestRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryRequestRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequestRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryRequestRequest) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+// QueryRequestResponse is response type for the Query/Request RPC method.
+type QueryRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Request is an oracle request
+	Request *Request `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// Reports is list of result data as raw reports that are fulfilled by
+	// assigned validators
+	Reports []*Report `protobuf:"bytes,2,rep,name=reports,proto3" json:"reports,omitempty"`
+	// Result is a final form of result data
+	Result *Result `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	// Signing is the signing detail in the bandtss module.
+	Signing *SigningResult `protobuf:"bytes,4,opt,name=signing,proto3" json:"signing,omitempty"`
+}
+
+func (x *QueryRequestResponse) Reset() {
+	*x = QueryRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRequestResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryRequestResponse.ProtoReflect.Descriptor instead.
+func (*QueryRequestResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryRequestResponse) GetRequest() *Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *QueryRequestResponse) GetReports() []*Report {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *QueryRequestResponse) GetResult() *Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *QueryRequestResponse) GetSigning() *SigningResult {
+	if x != nil {
+		return x.Signing
+	}
+	return nil
+}
+
+// QueryPendingRequestsRequest is request type for the Query/PendingRequests RPC
+// method.
+type QueryPendingRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ValidatorAddress is address of a validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (x *QueryPendingRequestsRequest) Reset() {
+	*x = QueryPendingRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPendingRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPendingRequestsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPendingRequestsRequest.ProtoReflect.Descriptor instead.
+func (*QueryPendingRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryPendingRequestsRequest) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryPendingRequestsResponse is response type for the Query/PendingRequests
+// RPC method.
+type QueryPendingRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RequestIDs is a list of pending request IDs assigned to the given validator
+	RequestIds []uint64 `protobuf:"varint,1,rep,packed,name=request_ids,json=requestIds,proto3" json:"request_ids,omitempty"`
+}
+
+func (x *QueryPendingRequestsResponse) Reset() {
+	*x = QueryPendingRequestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPendingRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPendingRequestsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryPendingRequestsResponse.ProtoReflect.Descriptor instead.
+func (*QueryPendingRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryPendingRequestsResponse) GetRequestIds() []uint64 {
+	if x != nil {
+		return x.RequestIds
+	}
+	return nil
+}
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (*QueryParamsRequest) ProtoMessage() {}
 
-// QueryCountsRequest is request type for the Query/Count RPC method.
-type QueryCountsRequest struct {
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{12}
+}
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// pagination defines an optional pagination for the request.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
 }
 
-func (x *QueryCountsRequest) Reset() {
-	*x = QueryCountsRequest{}
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[0]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryCountsRequest) String() string {
+func (x *QueryParamsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryCountsRequest) ProtoMessage() {}
+func (*QueryParamsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryCountsRequest.ProtoReflect.Descriptor instead.
-func (*QueryCountsRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{13}
 }
 
-// QueryCountsResponse is response type for the Query/Count RPC method.
-type QueryCountsResponse struct {
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// QueryValidatorRequest is request type for the Query/Validator RPC method.
+type QueryValidatorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// DataSourceCount is total number of data sources available on the chain
-	DataSourceCount uint64 `protobuf:"varint,1,opt,name=data_source_count,json=dataSourceCount,proto3" json:"data_source_count,omitempty"`
-	// OracleScriptCount is total number of oracle scripts available on the chain
-	OracleScriptCount uint64 `protobuf:"varint,2,opt,name=oracle_script_count,json=oracleScriptCount,proto3" json:"oracle_script_count,omitempty"`
-	// RequestCount is total number of requests submitted to the chain
-	RequestCount uint64 `protobuf:"varint,3,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	// ValidatorAddress is address of a validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (x *QueryCountsResponse) Reset() {
-	*x = QueryCountsResponse{}
+func (x *QueryValidatorRequest) Reset() {
+	*x = QueryValidatorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[1]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryCountsResponse) String() string {
+func (x *QueryValidatorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryCountsResponse) ProtoMessage() {}
+func (*QueryValidatorRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryCountsResponse.ProtoReflect.Descriptor instead.
-func (*QueryCountsResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryValidatorRequest.ProtoReflect.Descriptor instead.
+func (*QueryValidatorRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *QueryCountsResponse) GetDataSourceCount() uint64 {
+func (x *QueryValidatorRequest) GetValidatorAddress() string {
 	if x != nil {
-		return x.DataSourceCount
+		return x.ValidatorAddress
 	}
-	return 0
+	return ""
 }
 
-func (x *QueryCountsResponse) GetOracleScriptCount() uint64 {
-	if x != nil {
-		return x.OracleScriptCount
+// QueryValidatorResponse is response type for the Query/Validator RPC method.
+type QueryValidatorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Status is status of a validator e.g. active/inactive
+	Status *ValidatorStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *QueryValidatorResponse) Reset() {
+	*x = QueryValidatorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *QueryCountsResponse) GetRequestCount() uint64 {
+func (x *QueryValidatorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryValidatorResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryValidatorResponse.ProtoReflect.Descriptor instead.
+func (*QueryValidatorResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *QueryValidatorResponse) GetStatus() *ValidatorStatus {
 	if x != nil {
-		return x.RequestCount
+		return x.Status
 	}
-	return 0
+	return nil
 }
 
-// QueryDataRequest is request type for the Query/Data RPC method.
-type QueryDataRequest struct {
+// QueryValidatorReportInfoRequest is request type for the
+// Query/ValidatorReportInfo RPC method.
+type QueryValidatorReportInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// DataHash is SHA256 hash of the file's content, which can be data source or
-	// oracle script
-	DataHash string `protobuf:"bytes,1,opt,name=data_hash,json=dataHash,proto3" json:"data_hash,omitempty"`
+	// ValidatorAddress is address of a validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (x *QueryDataRequest) Reset() {
-	*x = QueryDataRequest{}
+func (x *QueryValidatorReportInfoRequest) Reset() {
+	*x = QueryValidatorReportInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[2]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryDataRequest) String() string {
+func (x *QueryValidatorReportInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryDataRequest) ProtoMessage() {}
+func (*QueryValidatorReportInfoRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryDataRequest.ProtoReflect.Descriptor instead.
-func (*QueryDataRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryValidatorReportInfoRequest.ProtoReflect.Descriptor instead.
+func (*QueryValidatorReportInfoRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QueryDataRequest) GetDataHash() string {
+func (x *QueryValidatorReportInfoRequest) GetValidatorAddress() string {
 	if x != nil {
-		return x.DataHash
+		return x.ValidatorAddress
 	}
 	return ""
 }
 
-// QueryDataResponse is response type for the Query/Data RPC method.
-type QueryDataResponse struct {
+// QueryValidatorReportInfoResponse is response type for the
+// Query/ValidatorReportInfo RPC method.
+type QueryValidatorReportInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Data is file's content, which can be data source or oracle script
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// ReportInfo is the report window stats of the validator
+	ReportInfo *ValidatorReportInfo `protobuf:"bytes,1,opt,name=report_info,json=reportInfo,proto3" json:"report_info,omitempty"`
 }
 
-func (x *QueryDataResponse) Reset() {
-	*x = QueryDataResponse{}
+func (x *QueryValidatorReportInfoResponse) Reset() {
+	*x = QueryValidatorReportInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[3]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryDataResponse) String() string {
+func (x *QueryValidatorReportInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryDataResponse) ProtoMessage() {}
+func (*QueryValidatorReportInfoResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryDataResponse.ProtoReflect.Descriptor instead.
-func (*QueryDataResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryValidatorReportInfoResponse.ProtoReflect.Descriptor instead.
+func (*QueryValidatorReportInfoResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *QueryDataResponse) GetData() []byte {
+func (x *QueryValidatorReportInfoResponse) GetReportInfo() *ValidatorReportInfo {
 	if x != nil {
-		return x.Data
+		return x.ReportInfo
 	}
 	return nil
 }
 
-// QueryDataSourceRequest is request type for the Query/DataSource RPC method.
-type QueryDataSourceRequest struct {
+// QueryValidatorReportInfosRequest is request type for the
+// Query/ValidatorReportInfos RPC method.
+type QueryValidatorReportInfosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// DataSourceID is ID of a data source script
-	DataSourceId uint64 `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryDataSourceRequest) Reset() {
-	*x = QueryDataSourceRequest{}
+func (x *QueryValidatorReportInfosRequest) Reset() {
+	*x = QueryValidatorReportInfosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[4]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryDataSourceRequest) String() string {
+func (x *QueryValidatorReportInfosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryDataSourceRequest) ProtoMessage() {}
+func (*QueryValidatorReportInfosRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryDataSourceRequest.ProtoReflect.Descriptor instead.
-func (*QueryDataSourceRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QueryValidatorReportInfosRequest.ProtoReflect.Descriptor instead.
+func (*QueryValidatorReportInfosRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueryDataSourceRequest) GetDataSourceId() uint64 {
+func (x *QueryValidatorReportInfosRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
-		return x.DataSourceId
+		return x.Pagination
 	}
-	return 0
+	return nil
 }
 
-// QueryDataSourceResponse is response type for the Query/DataSource RPC method.
-type QueryDataSourceResponse struct {
+// QueryValidatorReportInfosResponse is response type for the
+// Query/ValidatorReportInfos RPC method.
+type QueryValidatorReportInfosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// DataSource is summary information of a data source
-	DataSource *DataSource `protobuf:"bytes,1,opt,name=data_source,json=dataSource,proto3" json:"data_source,omitempty"`
+	// ReportInfos is the report window stats of the validators
+	ReportInfos []*ValidatorReportInfo `protobuf:"bytes,1,rep,name=report_infos,json=reportInfos,proto3" json:"report_infos,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryDataSourceResponse) Reset() {
-	*x = QueryDataSourceResponse{}
+func (x *QueryValidatorReportInfosResponse) Reset() {
+	*x = QueryValidatorReportInfosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[5]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryDataSourceResponse) String() string {
+func (x *QueryValidatorReportInfosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryDataSourceResponse) ProtoMessage() {}
+func (*QueryValidatorReportInfosResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryDataSourceResponse.ProtoReflect.Descriptor instead.
-func (*QueryDataSourceResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryValidatorReportInfosResponse.ProtoReflect.Descriptor instead.
+func (*QueryValidatorReportInfosResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *QueryDataSourceResponse) GetDataSource() *DataSource {
+func (x *QueryValidatorReportInfosResponse) GetReportInfos() []*ValidatorReportInfo {
 	if x != nil {
-		return x.DataSource
+		return x.ReportInfos
 	}
 	return nil
 }
 
-// QueryOracleScriptRequest is request type for the Query/OracleScript RPC
-// method.
-type QueryOracleScriptRequest struct {
+func (x *QueryValidatorReportInfosResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryIsReporterRequest is request type for the Query/Reporter RPC method.
+type QueryIsReporterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ValidatorAddress is a validator address
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// ReporterAddress is a candidate account
+	ReporterAddress string `protobuf:"bytes,2,opt,name=reporter_address,json=reporterAddress,proto3" json:"reporter_address,omitempty"`
+}
+
+func (x *QueryIsReporterRequest) Reset() {
+	*x = QueryIsReporterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryIsReporterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryIsReporterRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryIsReporterRequest.ProtoReflect.Descriptor instead.
+func (*QueryIsReporterRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *QueryIsReporterRequest) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+func (x *QueryIsReporterRequest) GetReporterAddress() string {
+	if x != nil {
+		return x.ReporterAddress
+	}
+	return ""
+}
+
+// QueryIsReporterResponse is response type for the Query/Reporter RPC method.
+type QueryIsReporterResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// OracleScriptID is ID of an oracle script
-	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// IsReporter is true if this account has been granted by validator
+	IsReporter bool `protobuf:"varint,1,opt,name=is_reporter,json=isReporter,proto3" json:"is_reporter,omitempty"`
 }
 
-func (x *QueryOracleScriptRequest) Reset() {
-	*x = QueryOracleScriptRequest{}
+func (x *QueryIsReporterResponse) Reset() {
+	*x = QueryIsReporterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[6]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryOracleScriptRequest) String() string {
+func (x *QueryIsReporterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryOracleScriptRequest) ProtoMessage() {}
+func (*QueryIsReporterResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryOracleScriptRequest.ProtoReflect.Descriptor instead.
-func (*QueryOracleScriptRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QueryIsReporterResponse.ProtoReflect.Descriptor instead.
+func (*QueryIsReporterResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *QueryOracleScriptRequest) GetOracleScriptId() uint64 {
+func (x *QueryIsReporterResponse) GetIsReporter() bool {
 	if x != nil {
-		return x.OracleScriptId
+		return x.IsReporter
 	}
-	return 0
+	return false
 }
 
-// QueryOracleScriptResponse is response type for the Query/OracleScript RPC
-// method.
-type QueryOracleScriptResponse struct {
+// QueryReportersRequest is request type for the Query/Reporters RPC method.
+type QueryReportersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// OracleScript is summary information of an oracle script
-	OracleScript *OracleScript `protobuf:"bytes,1,opt,name=oracle_script,json=oracleScript,proto3" json:"oracle_script,omitempty"`
+	// ValidatorAddress is a validator address
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (x *QueryOracleScriptResponse) Reset() {
-	*x = QueryOracleScriptResponse{}
+func (x *QueryReportersRequest) Reset() {
+	*x = QueryReportersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[7]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryOracleScriptResponse) String() string {
+func (x *QueryReportersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryOracleScriptResponse) ProtoMessage() {}
+func (*QueryReportersRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryOracleScriptResponse.ProtoReflect.Descriptor instead.
-func (*QueryOracleScriptResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use QueryReportersRequest.ProtoReflect.Descriptor instead.
+func (*QueryReportersRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *QueryOracleScriptResponse) GetOracleScript() *OracleScript {
+func (x *QueryReportersRequest) GetValidatorAddress() string {
 	if x != nil {
-		return x.OracleScript
+		return x.ValidatorAddress
 	}
-	return nil
+	return ""
 }
 
-// QueryRequestRequest is request type for the Query/Request RPC method.
-type QueryRequestRequest struct {
+// QueryReportersResponse is response type for the Query/Reporters RPC method.
+type QueryReportersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// RequestID is ID of an oracle request
-	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Reporter is a list of account addresses of reporters
+	Reporter []string `protobuf:"bytes,1,rep,name=reporter,proto3" json:"reporter,omitempty"`
 }
 
-func (x *QueryRequestRequest) Reset() {
-	*x = QueryRequestRequest{}
+func (x *QueryReportersResponse) Reset() {
+	*x = QueryReportersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[8]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequestRequest) String() string {
+func (x *QueryReportersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequestRequest) ProtoMessage() {}
+func (*QueryReportersResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryRequestRequest.ProtoReflect.Descriptor instead.
-func (*QueryRequestRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use QueryReportersResponse.ProtoReflect.Descriptor instead.
+func (*QueryReportersResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *QueryRequestRequest) GetRequestId() uint64 {
+func (x *QueryReportersResponse) GetReporter() []string {
 	if x != nil {
-		return x.RequestId
+		return x.Reporter
 	}
-	return 0
+	return nil
 }
 
-// QueryRequestResponse is response type for the Query/Request RPC method.
-type QueryRequestResponse struct {
+// QueryActiveValidatorsRequest is request type for the Query/ActiveValidators
+// RPC method.
+type QueryActiveValidatorsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Request is an oracle request
-	Request *Request `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	// Reports is list of result data as raw reports that are fulfilled by
-	// assigned validators
-	Reports []*Report `protobuf:"bytes,2,rep,name=reports,proto3" json:"reports,omitempty"`
-	// Result is a final form of result data
-	Result *Result `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
-	// Signing is the signing detail in the bandtss module.
-	Signing *SigningResult `protobuf:"bytes,4,opt,name=signing,proto3" json:"signing,omitempty"`
 }
 
-func (x *QueryRequestResponse) Reset() {
-	*x = QueryRequestResponse{}
+func (x *QueryActiveValidatorsRequest) Reset() {
+	*x = QueryActiveValidatorsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[9]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequestResponse) String() string {
+func (x *QueryActiveValidatorsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequestResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryRequestResponse.ProtoReflect.Descriptor instead.
-func (*QueryRequestResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *QueryRequestResponse) GetRequest() *Request {
-	if x != nil {
-		return x.Request
-	}
-	return nil
-}
-
-func (x *QueryRequestResponse) GetReports() []*Report {
-	if x != nil {
-		return x.Reports
-	}
-	return nil
-}
-
-func (x *QueryRequestResponse) GetResult() *Result {
-	if x != nil {
-		return x.Result
-	}
-	return nil
-}
+func (*QueryActiveValidatorsRequest) ProtoMessage() {}
 
-func (x *QueryRequestResponse) GetSigning() *SigningResult {
-	if x != nil {
-		return x.Signing
-	}
-	return nil
+// Deprecated: Use QueryActiveValidatorsRequest.ProtoReflect.Descriptor instead.
+func (*QueryActiveValidatorsRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{24}
 }
 
-// QueryPendingRequestsRequest is request type for the Query/PendingRequests RPC
-// method.
-type QueryPendingRequestsRequest struct {
+// QueryActiveValidatorsResponse is response type for the Query/ActiveValidators
+// RPC method.
+type QueryActiveValidatorsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ValidatorAddress is address of a validator
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Validators is a list of active validators
+	Validators []*ActiveValidator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
 }
 
-func (x *QueryPendingRequestsRequest) Reset() {
-	*x = QueryPendingRequestsRequest{}
+func (x *QueryActiveValidatorsResponse) Reset() {
+	*x = QueryActiveValidatorsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[10]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPendingRequestsRequest) String() string {
+func (x *QueryActiveValidatorsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPendingRequestsRequest) ProtoMessage() {}
+func (*QueryActiveValidatorsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryPendingRequestsRequest.ProtoReflect.Descriptor instead.
-func (*QueryPendingRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use QueryActiveValidatorsResponse.ProtoReflect.Descriptor instead.
+func (*QueryActiveValidatorsResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *QueryPendingRequestsRequest) GetValidatorAddress() string {
+func (x *QueryActiveValidatorsResponse) GetValidators() []*ActiveValidator {
 	if x != nil {
-		return x.ValidatorAddress
+		return x.Validators
 	}
-	return ""
+	return nil
 }
 
-// QueryPendingRequestsResponse is response type for the Query/PendingRequests
-// RPC method.
-type QueryPendingRequestsResponse struct {
+// QueryRequestSearchRequest is request type for the Query/RequestSearch RPC
+// method.
+type QueryRequestSearchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// RequestIDs is a list of pending request IDs assigned to the given validator
-	RequestIds []uint64 `protobuf:"varint,1,rep,packed,name=request_ids,json=requestIds,proto3" json:"request_ids,omitempty"`
+	// OracleScriptID is ID of an oracle script
+	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// Calldata is OBI-encoded data in hex format as argument params for the
+	// oracle script
+	Calldata string `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is number of validators allowed for fulfilling the request
+	AskCount uint64 `protobuf:"varint,3,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is number of validators required for fulfilling the request
+	MinCount uint64 `protobuf:"varint,4,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
 }
 
-func (x *QueryPendingRequestsResponse) Reset() {
-	*x = QueryPendingRequestsResponse{}
+func (x *QueryRequestSearchRequest) Reset() {
+	*x = QueryRequestSearchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[11]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPendingRequestsResponse) String() string {
+func (x *QueryRequestSearchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPendingRequestsResponse) ProtoMessage() {}
+func (*QueryRequestSearchRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryPendingRequestsResponse.ProtoReflect.Descriptor instead.
-func (*QueryPendingRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use QueryRequestSearchRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequestSearchRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *QueryPendingRequestsResponse) GetRequestIds() []uint64 {
+func (x *QueryRequestSearchRequest) GetOracleScriptId() uint64 {
 	if x != nil {
-		return x.RequestIds
+		return x.OracleScriptId
 	}
-	return nil
-}
-
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	return 0
 }
 
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QueryRequestSearchRequest) GetCalldata() string {
+	if x != nil {
+		return x.Calldata
 	}
+	return ""
 }
 
-func (x *QueryParamsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QueryRequestSearchRequest) GetAskCount() uint64 {
+	if x != nil {
+		return x.AskCount
+	}
+	return 0
 }
 
-func (*QueryParamsRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{12}
+func (x *QueryRequestSearchRequest) GetMinCount() uint64 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
 }
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
+// QueryRequestSearchResponse is response type for the Query/RequestSearch RPC
+// method.
+type QueryRequestSearchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// pagination defines an optional pagination for the request.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// Request is details of an oracle request
+	Request *QueryRequestResponse `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
+func (x *QueryRequestSearchResponse) Reset() {
+	*x = QueryRequestSearchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[13]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsResponse) String() string {
+func (x *QueryRequestSearchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsResponse) ProtoMessage() {}
+func (*QueryRequestSearchResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use QueryRequestSearchResponse.ProtoReflect.Descriptor instead.
+func (*QueryRequestSearchResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *QueryParamsResponse) GetParams() *Params {
+func (x *QueryRequestSearchResponse) GetRequest() *QueryRequestResponse {
 	if x != nil {
-		return x.Params
+		return x.Request
 	}
 	return nil
 }
 
-// QueryValidatorRequest is request type for the Query/Validator RPC method.
-type QueryValidatorRequest struct {
+// QueryRequestPriceRequest is request type for the Query/RequestPrice RPC
+// method.
+type QueryRequestPriceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ValidatorAddress is address of a validator
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Symbol is unit of data indicating what the data is
+	Symbols []string `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	// AskCount is number of validators allowed for fulfilling the request
+	AskCount uint64 `protobuf:"varint,2,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is number of validators required for fulfilling the request
+	MinCount uint64 `protobuf:"varint,3,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
 }
 
-func (x *QueryValidatorRequest) Reset() {
-	*x = QueryValidatorRequest{}
+func (x *QueryRequestPriceRequest) Reset() {
+	*x = QueryRequestPriceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[14]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryValidatorRequest) String() string {
+func (x *QueryRequestPriceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryValidatorRequest) ProtoMessage() {}
+func (*QueryRequestPriceRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryValidatorRequest.ProtoReflect.Descriptor instead.
-func (*QueryValidatorRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use QueryRequestPriceRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequestPriceRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *QueryValidatorRequest) GetValidatorAddress() string {
+func (x *QueryRequestPriceRequest) GetSymbols() []string {
 	if x != nil {
-		return x.ValidatorAddress
+		return x.Symbols
 	}
-	return ""
+	return nil
 }
 
-// QueryValidatorResponse is response type for the Query/Validator RPC method.
-type QueryValidatorResponse struct {
+func (x *QueryRequestPriceRequest) GetAskCount() uint64 {
+	if x != nil {
+		return x.AskCount
+	}
+	return 0
+}
+
+func (x *QueryRequestPriceRequest) GetMinCount() uint64 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
+}
+
+// QueryRequestPriceResponse is response type for the Query/RequestPrice RPC
+// method.
+type QueryRequestPriceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Status is status of a validator e.g. active/inactive
-	Status *ValidatorStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// PriceResult is a list of price results for given symbols
+	PriceResults []*PriceResult `protobuf:"bytes,1,rep,name=price_results,json=priceResults,proto3" json:"price_results,omitempty"`
 }
 
-func (x *QueryValidatorResponse) Reset() {
-	*x = QueryValidatorResponse{}
+func (x *QueryRequestPriceResponse) Reset() {
+	*x = QueryRequestPriceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[15]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryValidatorResponse) String() string {
+func (x *QueryRequestPriceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryValidatorResponse) ProtoMessage() {}
+func (*QueryRequestPriceResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryValidatorResponse.ProtoReflect.Descriptor instead.
-func (*QueryValidatorResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use QueryRequestPriceResponse.ProtoReflect.Descriptor instead.
+func (*QueryRequestPriceResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *QueryValidatorResponse) GetStatus() *ValidatorStatus {
+func (x *QueryRequestPriceResponse) GetPriceResults() []*PriceResult {
 	if x != nil {
-		return x.Status
+		return x.PriceResults
 	}
 	return nil
 }
 
-// QueryIsReporterRequest is request type for the Query/Reporter RPC method.
-type QueryIsReporterRequest struct {
+// QueryRequestVerificationRequest is request type for the
+// Query/RequestVerification RPC
+type QueryRequestVerificationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ValidatorAddress is a validator address
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	// ReporterAddress is a candidate account
-	ReporterAddress string `protobuf:"bytes,2,opt,name=reporter_address,json=reporterAddress,proto3" json:"reporter_address,omitempty"`
+	// ChainID is the chain ID to identify which chain ID is used for the
+	// verification
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Validator is a validator address
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// RequestID is oracle request ID
+	RequestId uint64 `protobuf:"varint,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// ExternalID is an oracle's external ID
+	ExternalId uint64 `protobuf:"varint,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// DataSourceID is the ID of data source
+	DataSourceId uint64 `protobuf:"varint,5,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// Reporter is an bech32-encoded public key of the reporter authorized by the
+	// validator
+	Reporter string `protobuf:"bytes,6,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	// Signature is a signature signed by the reporter using reporter's private
+	// key
+	Signature []byte `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	// MaxDelay is a max number of RequestID delay acceptable in case of node
+	// delay
+	MaxDelay uint64 `protobuf:"varint,8,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"`
 }
 
-func (x *QueryIsReporterRequest) Reset() {
-	*x = QueryIsReporterRequest{}
+func (x *QueryRequestVerificationRequest) Reset() {
+	*x = QueryRequestVerificationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[16]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryIsReporterRequest) String() string {
+func (x *QueryRequestVerificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryIsReporterRequest) ProtoMessage() {}
+func (*QueryRequestVerificationRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryIsReporterRequest.ProtoReflect.Descriptor instead.
-func (*QueryIsReporterRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use QueryRequestVerificationRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequestVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *QueryIsReporterRequest) GetValidatorAddress() string {
+func (x *QueryRequestVerificationRequest) GetChainId() string {
 	if x != nil {
-		return x.ValidatorAddress
+		return x.ChainId
 	}
 	return ""
 }
 
-func (x *QueryIsReporterRequest) GetReporterAddress() string {
+func (x *QueryRequestVerificationRequest) GetValidator() string {
 	if x != nil {
-		return x.ReporterAddress
+		return x.Validator
 	}
 	return ""
 }
 
-// QueryIsReporterResponse is response type for the Query/Reporter RPC method.
-type QueryIsReporterResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// IsReporter is true if this account has been granted by validator
-	IsReporter bool `protobuf:"varint,1,opt,name=is_reporter,json=isReporter,proto3" json:"is_reporter,omitempty"`
-}
-
-func (x *QueryIsReporterResponse) Reset() {
-	*x = QueryIsReporterResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QueryRequestVerificationRequest) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
 	}
+	return 0
 }
 
-func (x *QueryIsReporterResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*QueryIsReporterResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryIsReporterResponse.ProtoReflect.Descriptor instead.
-func (*QueryIsReporterResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *QueryIsReporterResponse) GetIsReporter() bool {
+func (x *QueryRequestVerificationRequest) GetExternalId() uint64 {
 	if x != nil {
-		return x.IsReporter
+		return x.ExternalId
 	}
-	return false
-}
-
-// QueryReportersRequest is request type for the Query/Reporters RPC method.
-type QueryReportersRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// ValidatorAddress is a validator address
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	return 0
 }
 
-func (x *QueryReportersRequest) Reset() {
-	*x = QueryReportersRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QueryRequestVerificationRequest) GetDataSourceId() uint64 {
+	if x != nil {
+		return x.DataSourceId
 	}
+	return 0
 }
 
-func (x *QueryReportersRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QueryRequestVerificationRequest) GetReporter() string {
+	if x != nil {
+		return x.Reporter
+	}
+	return ""
 }
 
-func (*QueryReportersRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryReportersRequest.ProtoReflect.Descriptor instead.
-func (*QueryReportersRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{18}
+func (x *QueryRequestVerificationRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
 }
 
-func (x *QueryReportersRequest) GetValidatorAddress() string {
+func (x *QueryRequestVerificationRequest) GetMaxDelay() uint64 {
 	if x != nil {
-		return x.ValidatorAddress
+		return x.MaxDelay
 	}
-	return ""
+	return 0
 }
 
-// QueryReportersResponse is response type for the Query/Reporters RPC method.
-type QueryReportersResponse struct {
+// QueryRequestVerificationResponse is response type for the
+// Query/RequestVerification RPC
+type QueryRequestVerificationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Reporter is a list of account addresses of reporters
-	Reporter []string `protobuf:"bytes,1,rep,name=reporter,proto3" json:"reporter,omitempty"`
+	// ChainID is the targeted chain ID
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Validator is the targeted validator address
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// RequestID is the ID of targeted request
+	RequestId uint64 `protobuf:"varint,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// ExternalID is the ID of targeted oracle's external data source
+	ExternalId uint64 `protobuf:"varint,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// DataSourceID is the ID of a data source that relates to the targeted
+	// external ID
+	DataSourceId uint64 `protobuf:"varint,5,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// IsDelay is a flag to tell that this request is not exist yet but in
+	// MaxDelay range
+	IsDelay bool `protobuf:"varint,6,opt,name=is_delay,json=isDelay,proto3" json:"is_delay,omitempty"`
 }
 
-func (x *QueryReportersResponse) Reset() {
-	*x = QueryReportersResponse{}
+func (x *QueryRequestVerificationResponse) Reset() {
+	*x = QueryRequestVerificationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[19]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryReportersResponse) String() string {
+func (x *QueryRequestVerificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryReportersResponse) ProtoMessage() {}
+func (*QueryRequestVerificationResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryReportersResponse.ProtoReflect.Descriptor instead.
-func (*QueryReportersResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use QueryRequestVerificationResponse.ProtoReflect.Descriptor instead.
+func (*QueryRequestVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *QueryReportersResponse) GetReporter() []string {
+func (x *QueryRequestVerificationResponse) GetChainId() string {
 	if x != nil {
-		return x.Reporter
+		return x.ChainId
 	}
-	return nil
+	return ""
 }
 
-// QueryActiveValidatorsRequest is request type for the Query/ActiveValidators
-// RPC method.
-type QueryActiveValidatorsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *QueryRequestVerificationResponse) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
 }
 
-func (x *QueryActiveValidatorsRequest) Reset() {
-	*x = QueryActiveValidatorsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QueryRequestVerificationResponse) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
 	}
+	return 0
 }
 
-func (x *QueryActiveValidatorsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QueryRequestVerificationResponse) GetExternalId() uint64 {
+	if x != nil {
+		return x.ExternalId
+	}
+	return 0
 }
 
-func (*QueryActiveValidatorsRequest) ProtoMessage() {}
+func (x *QueryRequestVerificationResponse) GetDataSourceId() uint64 {
+	if x != nil {
+		return x.DataSourceId
+	}
+	return 0
+}
 
-// Deprecated: Use QueryActiveValidatorsRequest.ProtoReflect.Descriptor instead.
-func (*QueryActiveValidatorsRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{20}
+func (x *QueryRequestVerificationResponse) GetIsDelay() bool {
+	if x != nil {
+		return x.IsDelay
+	}
+	return false
 }
 
-// QueryActiveValidatorsResponse is response type for the Query/ActiveValidators
-// RPC method.
-type QueryActiveValidatorsResponse struct {
+// QueryRequestSubscriptionRequest is request type for the
+// Query/RequestSubscription RPC method.
+type QueryRequestSubscriptionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Validators is a list of active validators
-	Validators []*ActiveValidator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	// SubscriptionID is the identifier of the request subscription
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
 }
 
-func (x *QueryActiveValidatorsResponse) Reset() {
-	*x = QueryActiveValidatorsResponse{}
+func (x *QueryRequestSubscriptionRequest) Reset() {
+	*x = QueryRequestSubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[21]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryActiveValidatorsResponse) String() string {
+func (x *QueryRequestSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryActiveValidatorsResponse) ProtoMessage() {}
+func (*QueryRequestSubscriptionRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryActiveValidatorsResponse.ProtoReflect.Descriptor instead.
-func (*QueryActiveValidatorsResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use QueryRequestSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequestSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *QueryActiveValidatorsResponse) GetValidators() []*ActiveValidator {
+func (x *QueryRequestSubscriptionRequest) GetSubscriptionId() uint64 {
 	if x != nil {
-		return x.Validators
+		return x.SubscriptionId
 	}
-	return nil
+	return 0
 }
 
-// QueryRequestSearchRequest is request type for the Query/RequestSearch RPC
-// method.
-type QueryRequestSearchRequest struct {
+// QueryRequestSubscriptionResponse is response type for the
+// Query/RequestSubscription RPC method.
+type QueryRequestSubscriptionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// OracleScriptID is ID of an oracle script
-	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
-	// Calldata is OBI-encoded data in hex format as argument params for the
-	// oracle script
-	Calldata string `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
-	// AskCount is number of validators allowed for fulfilling the request
-	AskCount uint64 `protobuf:"varint,3,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
-	// MinCount is number of validators required for fulfilling the request
-	MinCount uint64 `protobuf:"varint,4,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// Subscription is the request subscription
+	Subscription *RequestSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// Balance is the remaining deposit in the fee payer account of the
+	// subscription
+	Balance []*v1beta11.Coin `protobuf:"bytes,2,rep,name=balance,proto3" json:"balance,omitempty"`
 }
 
-func (x *QueryRequestSearchRequest) Reset() {
-	*x = QueryRequestSearchRequest{}
+func (x *QueryRequestSubscriptionResponse) Reset() {
+	*x = QueryRequestSubscriptionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[22]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequestSearchRequest) String() string {
+func (x *QueryRequestSubscriptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequestSearchRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryRequestSearchRequest.ProtoReflect.Descriptor instead.
-func (*QueryRequestSearchRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *QueryRequestSearchRequest) GetOracleScriptId() uint64 {
-	if x != nil {
-		return x.OracleScriptId
-	}
-	return 0
-}
+func (*QueryRequestSubscriptionResponse) ProtoMessage() {}
 
-func (x *QueryRequestSearchRequest) GetCalldata() string {
-	if x != nil {
-		return x.Calldata
-	}
-	return ""
+// Deprecated: Use QueryRequestSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*QueryRequestSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *QueryRequestSearchRequest) GetAskCount() uint64 {
+func (x *QueryRequestSubscriptionResponse) GetSubscription() *RequestSubscription {
 	if x != nil {
-		return x.AskCount
+		return x.Subscription
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryRequestSearchRequest) GetMinCount() uint64 {
+func (x *QueryRequestSubscriptionResponse) GetBalance() []*v1beta11.Coin {
 	if x != nil {
-		return x.MinCount
+		return x.Balance
 	}
-	return 0
+	return nil
 }
 
-// QueryRequestSearchResponse is response type for the Query/RequestSearch RPC
-// method.
-type QueryRequestSearchResponse struct {
+// QueryRequestSubscriptionsRequest is request type for the
+// Query/RequestSubscriptions RPC method.
+type QueryRequestSubscriptionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Request is details of an oracle request
-	Request *QueryRequestResponse `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryRequestSearchResponse) Reset() {
-	*x = QueryRequestSearchResponse{}
+func (x *QueryRequestSubscriptionsRequest) Reset() {
+	*x = QueryRequestSubscriptionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[23]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequestSearchResponse) String() string {
+func (x *QueryRequestSubscriptionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequestSearchResponse) ProtoMessage() {}
+func (*QueryRequestSubscriptionsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryRequestSearchResponse.ProtoReflect.Descriptor instead.
-func (*QueryRequestSearchResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use QueryRequestSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequestSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *QueryRequestSearchResponse) GetRequest() *QueryRequestResponse {
+func (x *QueryRequestSubscriptionsRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
-		return x.Request
+		return x.Pagination
 	}
 	return nil
 }
 
-// QueryRequestPriceRequest is request type for the Query/RequestPrice RPC
-// method.
-type QueryRequestPriceRequest struct {
+// QueryRequestSubscriptionsResponse is response type for the
+// Query/RequestSubscriptions RPC method.
+type QueryRequestSubscriptionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Symbol is unit of data indicating what the data is
-	Symbols []string `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
-	// AskCount is number of validators allowed for fulfilling the request
-	AskCount uint64 `protobuf:"varint,2,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
-	// MinCount is number of validators required for fulfilling the request
-	MinCount uint64 `protobuf:"varint,3,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// Subscriptions is the list of request subscriptions
+	Subscriptions []*RequestSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryRequestPriceRequest) Reset() {
-	*x = QueryRequestPriceRequest{}
+func (x *QueryRequestSubscriptionsResponse) Reset() {
+	*x = QueryRequestSubscriptionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[24]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequestPriceRequest) String() string {
+func (x *QueryRequestSubscriptionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequestPriceRequest) ProtoMessage() {}
+func (*QueryRequestSubscriptionsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryRequestPriceRequest.ProtoReflect.Descriptor instead.
-func (*QueryRequestPriceRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use QueryRequestSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*QueryRequestSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *QueryRequestPriceRequest) GetSymbols() []string {
+func (x *QueryRequestSubscriptionsResponse) GetSubscriptions() []*RequestSubscription {
 	if x != nil {
-		return x.Symbols
+		return x.Subscriptions
 	}
 	return nil
 }
 
-func (x *QueryRequestPriceRequest) GetAskCount() uint64 {
-	if x != nil {
-		return x.AskCount
-	}
-	return 0
-}
-
-func (x *QueryRequestPriceRequest) GetMinCount() uint64 {
+func (x *QueryRequestSubscriptionsResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
-		return x.MinCount
+		return x.Pagination
 	}
-	return 0
+	return nil
 }
 
-// QueryRequestPriceResponse is response type for the Query/RequestPrice RPC
-// method.
-type QueryRequestPriceResponse struct {
+// QueryDataSourceVersionsRequest is request type for the
+// Query/DataSourceVersions RPC method.
+type QueryDataSourceVersionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// PriceResult is a list of price results for given symbols
-	PriceResults []*PriceResult `protobuf:"bytes,1,rep,name=price_results,json=priceResults,proto3" json:"price_results,omitempty"`
+	// DataSourceID is ID of a data source script
+	DataSourceId uint64 `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryRequestPriceResponse) Reset() {
-	*x = QueryRequestPriceResponse{}
+func (x *QueryDataSourceVersionsRequest) Reset() {
+	*x = QueryDataSourceVersionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[25]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequestPriceResponse) String() string {
+func (x *QueryDataSourceVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequestPriceResponse) ProtoMessage() {}
+func (*QueryDataSourceVersionsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryRequestPriceResponse.ProtoReflect.Descriptor instead.
-func (*QueryRequestPriceResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use QueryDataSourceVersionsRequest.ProtoReflect.Descriptor instead.
+func (*QueryDataSourceVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *QueryRequestPriceResponse) GetPriceResults() []*PriceResult {
+func (x *QueryDataSourceVersionsRequest) GetDataSourceId() uint64 {
 	if x != nil {
-		return x.PriceResults
+		return x.DataSourceId
+	}
+	return 0
+}
+
+func (x *QueryDataSourceVersionsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
 	}
 	return nil
 }
 
-// QueryRequestVerificationRequest is request type for the
-// Query/RequestVerification RPC
-type QueryRequestVerificationRequest struct {
+// QueryDataSourceVersionsResponse is response type for the
+// Query/DataSourceVersions RPC method.
+type QueryDataSourceVersionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ChainID is the chain ID to identify which chain ID is used for the
-	// verification
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// Validator is a validator address
-	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
-	// RequestID is oracle request ID
-	RequestId uint64 `protobuf:"varint,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// ExternalID is an oracle's external ID
-	ExternalId uint64 `protobuf:"varint,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
-	// DataSourceID is the ID of data source
-	DataSourceId uint64 `protobuf:"varint,5,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
-	// Reporter is an bech32-encoded public key of the reporter authorized by the
-	// validator
-	Reporter string `protobuf:"bytes,6,opt,name=reporter,proto3" json:"reporter,omitempty"`
-	// Signature is a signature signed by the reporter using reporter's private
-	// key
-	Signature []byte `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
-	// MaxDelay is a max number of RequestID delay acceptable in case of node
-	// delay
-	MaxDelay uint64 `protobuf:"varint,8,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"`
+	// Versions is the list of versions of the data source
+	Versions []*DataSourceVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryRequestVerificationRequest) Reset() {
-	*x = QueryRequestVerificationRequest{}
+func (x *QueryDataSourceVersionsResponse) Reset() {
+	*x = QueryDataSourceVersionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[26]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequestVerificationRequest) String() string {
+func (x *QueryDataSourceVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequestVerificationRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryRequestVerificationRequest.ProtoReflect.Descriptor instead.
-func (*QueryRequestVerificationRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *QueryRequestVerificationRequest) GetChainId() string {
-	if x != nil {
-		return x.ChainId
-	}
-	return ""
-}
-
-func (x *QueryRequestVerificationRequest) GetValidator() string {
-	if x != nil {
-		return x.Validator
-	}
-	return ""
-}
-
-func (x *QueryRequestVerificationRequest) GetRequestId() uint64 {
-	if x != nil {
-		return x.RequestId
-	}
-	return 0
-}
-
-func (x *QueryRequestVerificationRequest) GetExternalId() uint64 {
-	if x != nil {
-		return x.ExternalId
-	}
-	return 0
-}
-
-func (x *QueryRequestVerificationRequest) GetDataSourceId() uint64 {
-	if x != nil {
-		return x.DataSourceId
-	}
-	return 0
-}
-
-func (x *QueryRequestVerificationRequest) GetReporter() string {
-	if x != nil {
-		return x.Reporter
-	}
-	return ""
+func (*QueryDataSourceVersionsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryDataSourceVersionsResponse.ProtoReflect.Descriptor instead.
+func (*QueryDataSourceVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *QueryRequestVerificationRequest) GetSignature() []byte {
+func (x *QueryDataSourceVersionsResponse) GetVersions() []*DataSourceVersion {
 	if x != nil {
-		return x.Signature
+		return x.Versions
 	}
 	return nil
 }
 
-func (x *QueryRequestVerificationRequest) GetMaxDelay() uint64 {
+func (x *QueryDataSourceVersionsResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
-		return x.MaxDelay
+		return x.Pagination
 	}
-	return 0
+	return nil
 }
 
-// QueryRequestVerificationResponse is response type for the
-// Query/RequestVerification RPC
-type QueryRequestVerificationResponse struct {
+// QueryOracleScriptVersionsRequest is request type for the
+// Query/OracleScriptVersions RPC method.
+type QueryOracleScriptVersionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ChainID is the targeted chain ID
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// Validator is the targeted validator address
-	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
-	// RequestID is the ID of targeted request
-	RequestId uint64 `protobuf:"varint,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// ExternalID is the ID of targeted oracle's external data source
-	ExternalId uint64 `protobuf:"varint,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
-	// DataSourceID is the ID of a data source that relates to the targeted
-	// external ID
-	DataSourceId uint64 `protobuf:"varint,5,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
-	// IsDelay is a flag to tell that this request is not exist yet but in
-	// MaxDelay range
-	IsDelay bool `protobuf:"varint,6,opt,name=is_delay,json=isDelay,proto3" json:"is_delay,omitempty"`
+	// OracleScriptID is ID of an oracle script
+	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryRequestVerificationResponse) Reset() {
-	*x = QueryRequestVerificationResponse{}
+func (x *QueryOracleScriptVersionsRequest) Reset() {
+	*x = QueryOracleScriptVersionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[27]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequestVerificationResponse) String() string {
+func (x *QueryOracleScriptVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequestVerificationResponse) ProtoMessage() {}
+func (*QueryOracleScriptVersionsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryRequestVerificationResponse.ProtoReflect.Descriptor instead.
-func (*QueryRequestVerificationResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use QueryOracleScriptVersionsRequest.ProtoReflect.Descriptor instead.
+func (*QueryOracleScriptVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *QueryRequestVerificationResponse) GetChainId() string {
+func (x *QueryOracleScriptVersionsRequest) GetOracleScriptId() uint64 {
 	if x != nil {
-		return x.ChainId
+		return x.OracleScriptId
 	}
-	return ""
+	return 0
 }
 
-func (x *QueryRequestVerificationResponse) GetValidator() string {
+func (x *QueryOracleScriptVersionsRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
-		return x.Validator
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (x *QueryRequestVerificationResponse) GetRequestId() uint64 {
-	if x != nil {
-		return x.RequestId
-	}
-	return 0
+// QueryOracleScriptVersionsResponse is response type for the
+// Query/OracleScriptVersions RPC method.
+type QueryOracleScriptVersionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Versions is the list of versions of the oracle script
+	Versions []*OracleScriptVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryRequestVerificationResponse) GetExternalId() uint64 {
-	if x != nil {
-		return x.ExternalId
+func (x *QueryOracleScriptVersionsResponse) Reset() {
+	*x = QueryOracleScriptVersionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *QueryRequestVerificationResponse) GetDataSourceId() uint64 {
+func (x *QueryOracleScriptVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryOracleScriptVersionsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryOracleScriptVersionsResponse.ProtoReflect.Descriptor instead.
+func (*QueryOracleScriptVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *QueryOracleScriptVersionsResponse) GetVersions() []*OracleScriptVersion {
 	if x != nil {
-		return x.DataSourceId
+		return x.Versions
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryRequestVerificationResponse) GetIsDelay() bool {
+func (x *QueryOracleScriptVersionsResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
-		return x.IsDelay
+		return x.Pagination
 	}
-	return false
+	return nil
 }
 
 var File_band_oracle_v1_query_proto protoreflect.FileDescriptor
@@ -14586,329 +21068,511 @@ var file_band_oracle_v1_query_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
 	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x61, 0x6e,
-	0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x96,
-	0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x11, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64,
-	0x61, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x64, 0x61, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x22, 0x27, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x3e, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x64,
-	0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
-	0x64, 0x22, 0x56, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b,
-	0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0a, 0x64,
-	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x44, 0x0a, 0x18, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x22,
-	0x5e, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0d,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x52, 0x0c, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22,
-	0x34, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xea, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
-	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x69, 0x67,
-	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x61, 0x6e,
-	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x69,
-	0x6e, 0x67, 0x22, 0x6d, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4,
-	0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x22, 0x4f, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x44, 0x73, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x34, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x67, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x13, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x64,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e,
+	0x0a, 0x13, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x48, 0x61, 0x73, 0x68, 0x22, 0x27, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3e, 0x0a,
+	0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x22, 0x56, 0x0a,
+	0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x44, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x22, 0x5e, 0x0a, 0x19, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0d, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x0c, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x34, 0x0a, 0x13, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x22, 0xea, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a,
+	0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x6d,
+	0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a,
+	0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x4f, 0x0a,
+	0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+	0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x04, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x44, 0x73, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x73, 0x22, 0x14,
+	0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x22, 0x67, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x51, 0x0a, 0x16, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x71, 0x0a,
+	0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d,
+	0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x6e, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x6a, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xba, 0x01, 0x0a,
+	0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73,
+	0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xad, 0x01, 0x0a, 0x16, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x0a, 0x17, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0x67, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e,
 	0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
 	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41,
 	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x51,
-	0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0xad, 0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x11,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x10,
-	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x22, 0x3a, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x69, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0x67, 0x0a,
-	0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x4e, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0x1e, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x60, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09,
-	0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e,
-	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69,
-	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x6e, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73,
-	0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61,
-	0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x40, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x73, 0x22, 0xba, 0x02, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x49, 0x64, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x61, 0x79,
-	0x22, 0xff, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
-	0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49,
-	0x64, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x64, 0x65,
-	0x6c, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x44, 0x65, 0x6c,
-	0x61, 0x79, 0x32, 0xb9, 0x0f, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x6c, 0x0a, 0x06,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x61, 0x6e,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x4e,
+	0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0x1e,
+	0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x60,
+	0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3f, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x22, 0x9b, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28,
+	0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5c,
+	0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6e, 0x0a, 0x18,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x19,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0c, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xba, 0x02, 0x0a, 0x1f,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2,
+	0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22, 0xff, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d,
+	0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22, 0x4a, 0x0a, 0x1f, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xd8, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x65, 0x0a, 0x07, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x22, 0x6a, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbd, 0x01,
+	0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8e, 0x01,
+	0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x24, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xaf,
+	0x01, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x43, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x94, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12,
+	0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb3, 0x01, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a,
+	0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xe1, 0x17,
+	0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x6c, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x12, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x13, 0x12, 0x11, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x70, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x7b, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x8f, 0x01, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12,
+	0x28, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x99, 0x01, 0x0a, 0x0c, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x28, 0x2e, 0x62, 0x61, 0x6e,
 	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x70, 0x0a, 0x04, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x20, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12,
-	0x1b, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61,
-	0x2f, 0x7b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x8f, 0x01, 0x0a,
-	0x0a, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31,
-	0x2f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64,
-	0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x99,
-	0x01, 0x0a, 0x0c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12,
-	0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x7e, 0x0a, 0x07, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
+	0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x7e, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
 	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x61, 0x6e,
-	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xa5, 0x01, 0x0a, 0x0f, 0x50,
-	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2b,
-	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x62, 0x61,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xa5, 0x01, 0x0a, 0x0f, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x8d, 0x01,
+	0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x2e, 0x62, 0x61,
 	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x31, 0x12, 0x2f, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x7d, 0x12, 0x8d, 0x01, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2b, 0x12, 0x29, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0xb7, 0x01,
+	0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37,
+	0x12, 0x35, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x9c, 0x01, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73,
+	0x12, 0x30, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
 	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x7d, 0x12, 0xa1, 0x01, 0x0a, 0x0a, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65,
-	0x72, 0x12, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a, 0x2f, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x2f,
-	0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x8c, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x61,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0xa1, 0x01, 0x0a, 0x0a, 0x49, 0x73, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a,
+	0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x8c, 0x01, 0x0a, 0x09, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12,
+	0x28, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x95, 0x01, 0x0a, 0x10, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2c,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x12, 0x6c, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x2e, 0x62, 0x61,
 	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
-	0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x95, 0x01, 0x0a, 0x10, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2c, 0x2e, 0x62, 0x61, 0x6e,
-	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12,
-	0x1c, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x6c, 0x0a,
-	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x61,
+	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x89, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x12, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
+	0x12, 0x19, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x86, 0x01, 0x0a, 0x0c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0xb0, 0x01, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x62, 0x61,
 	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x89, 0x01, 0x0a, 0x0d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x29, 0x2e,
+	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31,
+	0x2f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xba, 0x01, 0x0a, 0x14, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x30, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0xb4, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
 	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x86, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76,
-	0x31, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73,
-	0x12, 0x9b, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f,
-	0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0xb8,
-	0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76,
-	0x31, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x4f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x5c,
-	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x42, 0x61, 0x6e, 0x64,
-	0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x4f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xa5, 0x01, 0x0a, 0x14,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x22, 0x12, 0x20, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x9b, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f,
+	0x76, 0x31, 0x2f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x42, 0xb8, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e,
+	0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x61, 0x6e,
+	0x64, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x42, 0x61,
+	0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x42,
+	0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64,
+	0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -14923,92 +21587,138 @@ func file_band_oracle_v1_query_proto_rawDescGZIP() []byte {
 	return file_band_oracle_v1_query_proto_rawDescData
 }
 
-var file_band_oracle_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_band_oracle_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_band_oracle_v1_query_proto_goTypes = []interface{}{
-	(*QueryCountsRequest)(nil),               // 0: band.oracle.v1.QueryCountsRequest
-	(*QueryCountsResponse)(nil),              // 1: band.oracle.v1.QueryCountsResponse
-	(*QueryDataRequest)(nil),                 // 2: band.oracle.v1.QueryDataRequest
-	(*QueryDataResponse)(nil),                // 3: band.oracle.v1.QueryDataResponse
-	(*QueryDataSourceRequest)(nil),           // 4: band.oracle.v1.QueryDataSourceRequest
-	(*QueryDataSourceResponse)(nil),          // 5: band.oracle.v1.QueryDataSourceResponse
-	(*QueryOracleScriptRequest)(nil),         // 6: band.oracle.v1.QueryOracleScriptRequest
-	(*QueryOracleScriptResponse)(nil),        // 7: band.oracle.v1.QueryOracleScriptResponse
-	(*QueryRequestRequest)(nil),              // 8: band.oracle.v1.QueryRequestRequest
-	(*QueryRequestResponse)(nil),             // 9: band.oracle.v1.QueryRequestResponse
-	(*QueryPendingRequestsRequest)(nil),      // 10: band.oracle.v1.QueryPendingRequestsRequest
-	(*QueryPendingRequestsResponse)(nil),     // 11: band.oracle.v1.QueryPendingRequestsResponse
-	(*QueryParamsRequest)(nil),               // 12: band.oracle.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),              // 13: band.oracle.v1.QueryParamsResponse
-	(*QueryValidatorRequest)(nil),            // 14: band.oracle.v1.QueryValidatorRequest
-	(*QueryValidatorResponse)(nil),           // 15: band.oracle.v1.QueryValidatorResponse
-	(*QueryIsReporterRequest)(nil),           // 16: band.oracle.v1.QueryIsReporterRequest
-	(*QueryIsReporterResponse)(nil),          // 17: band.oracle.v1.QueryIsReporterResponse
-	(*QueryReportersRequest)(nil),            // 18: band.oracle.v1.QueryReportersRequest
-	(*QueryReportersResponse)(nil),           // 19: band.oracle.v1.QueryReportersResponse
-	(*QueryActiveValidatorsRequest)(nil),     // 20: band.oracle.v1.QueryActiveValidatorsRequest
-	(*QueryActiveValidatorsResponse)(nil),    // 21: band.oracle.v1.QueryActiveValidatorsResponse
-	(*QueryRequestSearchRequest)(nil),        // 22: band.oracle.v1.QueryRequestSearchRequest
-	(*QueryRequestSearchResponse)(nil),       // 23: band.oracle.v1.QueryRequestSearchResponse
-	(*QueryRequestPriceRequest)(nil),         // 24: band.oracle.v1.QueryRequestPriceRequest
-	(*QueryRequestPriceResponse)(nil),        // 25: band.oracle.v1.QueryRequestPriceResponse
-	(*QueryRequestVerificationRequest)(nil),  // 26: band.oracle.v1.QueryRequestVerificationRequest
-	(*QueryRequestVerificationResponse)(nil), // 27: band.oracle.v1.QueryRequestVerificationResponse
-	(*DataSource)(nil),                       // 28: band.oracle.v1.DataSource
-	(*OracleScript)(nil),                     // 29: band.oracle.v1.OracleScript
-	(*Request)(nil),                          // 30: band.oracle.v1.Request
-	(*Report)(nil),                           // 31: band.oracle.v1.Report
-	(*Result)(nil),                           // 32: band.oracle.v1.Result
-	(*SigningResult)(nil),                    // 33: band.oracle.v1.SigningResult
-	(*Params)(nil),                           // 34: band.oracle.v1.Params
-	(*ValidatorStatus)(nil),                  // 35: band.oracle.v1.ValidatorStatus
-	(*ActiveValidator)(nil),                  // 36: band.oracle.v1.ActiveValidator
-	(*PriceResult)(nil),                      // 37: band.oracle.v1.PriceResult
+	(*QueryCountsRequest)(nil),                // 0: band.oracle.v1.QueryCountsRequest
+	(*QueryCountsResponse)(nil),               // 1: band.oracle.v1.QueryCountsResponse
+	(*QueryDataRequest)(nil),                  // 2: band.oracle.v1.QueryDataRequest
+	(*QueryDataResponse)(nil),                 // 3: band.oracle.v1.QueryDataResponse
+	(*QueryDataSourceRequest)(nil),            // 4: band.oracle.v1.QueryDataSourceRequest
+	(*QueryDataSourceResponse)(nil),           // 5: band.oracle.v1.QueryDataSourceResponse
+	(*QueryOracleScriptRequest)(nil),          // 6: band.oracle.v1.QueryOracleScriptRequest
+	(*QueryOracleScriptResponse)(nil),         // 7: band.oracle.v1.QueryOracleScriptResponse
+	(*QueryRequestRequest)(nil),               // 8: band.oracle.v1.QueryRequestRequest
+	(*QueryRequestResponse)(nil),              // 9: band.oracle.v1.QueryRequestResponse
+	(*QueryPendingRequestsRequest)(nil),       // 10: band.oracle.v1.QueryPendingRequestsRequest
+	(*QueryPendingRequestsResponse)(nil),      // 11: band.oracle.v1.QueryPendingRequestsResponse
+	(*QueryParamsRequest)(nil),                // 12: band.oracle.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),               // 13: band.oracle.v1.QueryParamsResponse
+	(*QueryValidatorRequest)(nil),             // 14: band.oracle.v1.QueryValidatorRequest
+	(*QueryValidatorResponse)(nil),            // 15: band.oracle.v1.QueryValidatorResponse
+	(*QueryValidatorReportInfoRequest)(nil),   // 16: band.oracle.v1.QueryValidatorReportInfoRequest
+	(*QueryValidatorReportInfoResponse)(nil),  // 17: band.oracle.v1.QueryValidatorReportInfoResponse
+	(*QueryValidatorReportInfosRequest)(nil),  // 18: band.oracle.v1.QueryValidatorReportInfosRequest
+	(*QueryValidatorReportInfosResponse)(nil), // 19: band.oracle.v1.QueryValidatorReportInfosResponse
+	(*QueryIsReporterRequest)(nil),            // 20: band.oracle.v1.QueryIsReporterRequest
+	(*QueryIsReporterResponse)(nil),           // 21: band.oracle.v1.QueryIsReporterResponse
+	(*QueryReportersRequest)(nil),             // 22: band.oracle.v1.QueryReportersRequest
+	(*QueryReportersResponse)(nil),            // 23: band.oracle.v1.QueryReportersResponse
+	(*QueryActiveValidatorsRequest)(nil),      // 24: band.oracle.v1.QueryActiveValidatorsRequest
+	(*QueryActiveValidatorsResponse)(nil),     // 25: band.oracle.v1.QueryActiveValidatorsResponse
+	(*QueryRequestSearchRequest)(nil),         // 26: band.oracle.v1.QueryRequestSearchRequest
+	(*QueryRequestSearchResponse)(nil),        // 27: band.oracle.v1.QueryRequestSearchResponse
+	(*QueryRequestPriceRequest)(nil),          // 28: band.oracle.v1.QueryRequestPriceRequest
+	(*QueryRequestPriceResponse)(nil),         // 29: band.oracle.v1.QueryRequestPriceResponse
+	(*QueryRequestVerificationRequest)(nil),   // 30: band.oracle.v1.QueryRequestVerificationRequest
+	(*QueryRequestVerificationResponse)(nil),  // 31: band.oracle.v1.QueryRequestVerificationResponse
+	(*QueryRequestSubscriptionRequest)(nil),   // 32: band.oracle.v1.QueryRequestSubscriptionRequest
+	(*QueryRequestSubscriptionResponse)(nil),  // 33: band.oracle.v1.QueryRequestSubscriptionResponse
+	(*QueryRequestSubscriptionsRequest)(nil),  // 34: band.oracle.v1.QueryRequestSubscriptionsRequest
+	(*QueryRequestSubscriptionsResponse)(nil), // 35: band.oracle.v1.QueryRequestSubscriptionsResponse
+	(*QueryDataSourceVersionsRequest)(nil),    // 36: band.oracle.v1.QueryDataSourceVersionsRequest
+	(*QueryDataSourceVersionsResponse)(nil),   // 37: band.oracle.v1.QueryDataSourceVersionsResponse
+	(*QueryOracleScriptVersionsRequest)(nil),  // 38: band.oracle.v1.QueryOracleScriptVersionsRequest
+	(*QueryOracleScriptVersionsResponse)(nil), // 39: band.oracle.v1.QueryOracleScriptVersionsResponse
+	(*DataSource)(nil),                        // 40: band.oracle.v1.DataSource
+	(*OracleScript)(nil),                      // 41: band.oracle.v1.OracleScript
+	(*Request)(nil),                           // 42: band.oracle.v1.Request
+	(*Report)(nil),                            // 43: band.oracle.v1.Report
+	(*Result)(nil),                            // 44: band.oracle.v1.Result
+	(*SigningResult)(nil),                     // 45: band.oracle.v1.SigningResult
+	(*Params)(nil),                            // 46: band.oracle.v1.Params
+	(*ValidatorStatus)(nil),                   // 47: band.oracle.v1.ValidatorStatus
+	(*ValidatorReportInfo)(nil),               // 48: band.oracle.v1.ValidatorReportInfo
+	(*v1beta1.PageRequest)(nil),               // 49: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),              // 50: cosmos.base.query.v1beta1.PageResponse
+	(*ActiveValidator)(nil),                   // 51: band.oracle.v1.ActiveValidator
+	(*PriceResult)(nil),                       // 52: band.oracle.v1.PriceResult
+	(*RequestSubscription)(nil),               // 53: band.oracle.v1.RequestSubscription
+	(*v1beta11.Coin)(nil),                     // 54: cosmos.base.v1beta1.Coin
+	(*DataSourceVersion)(nil),                 // 55: band.oracle.v1.DataSourceVersion
+	(*OracleScriptVersion)(nil),               // 56: band.oracle.v1.OracleScriptVersion
 }
 var file_band_oracle_v1_query_proto_depIdxs = []int32{
-	28, // 0: band.oracle.v1.QueryDataSourceResponse.data_source:type_name -> band.oracle.v1.DataSource
-	29, // 1: band.oracle.v1.QueryOracleScriptResponse.oracle_script:type_name -> band.oracle.v1.OracleScript
-	30, // 2: band.oracle.v1.QueryRequestResponse.request:type_name -> band.oracle.v1.Request
-	31, // 3: band.oracle.v1.QueryRequestResponse.reports:type_name -> band.oracle.v1.Report
-	32, // 4: band.oracle.v1.QueryRequestResponse.result:type_name -> band.oracle.v1.Result
-	33, // 5: band.oracle.v1.QueryRequestResponse.signing:type_name -> band.oracle.v1.SigningResult
-	34, // 6: band.oracle.v1.QueryParamsResponse.params:type_name -> band.oracle.v1.Params
-	35, // 7: band.oracle.v1.QueryValidatorResponse.status:type_name -> band.oracle.v1.ValidatorStatus
-	36, // 8: band.oracle.v1.QueryActiveValidatorsResponse.validators:type_name -> band.oracle.v1.ActiveValidator
-	9,  // 9: band.oracle.v1.QueryRequestSearchResponse.request:type_name -> band.oracle.v1.QueryRequestResponse
-	37, // 10: band.oracle.v1.QueryRequestPriceResponse.price_results:type_name -> band.oracle.v1.PriceResult
-	0,  // 11: band.oracle.v1.Query.Counts:input_type -> band.oracle.v1.QueryCountsRequest
-	2,  // 12: band.oracle.v1.Query.Data:input_type -> band.oracle.v1.QueryDataRequest
-	4,  // 13: band.oracle.v1.Query.DataSource:input_type -> band.oracle.v1.QueryDataSourceRequest
-	6,  // 14: band.oracle.v1.Query.OracleScript:input_type -> band.oracle.v1.QueryOracleScriptRequest
-	8,  // 15: band.oracle.v1.Query.Request:input_type -> band.oracle.v1.QueryRequestRequest
-	10, // 16: band.oracle.v1.Query.PendingRequests:input_type -> band.oracle.v1.QueryPendingRequestsRequest
-	14, // 17: band.oracle.v1.Query.Validator:input_type -> band.oracle.v1.QueryValidatorRequest
-	16, // 18: band.oracle.v1.Query.IsReporter:input_type -> band.oracle.v1.QueryIsReporterRequest
-	18, // 19: band.oracle.v1.Query.Reporters:input_type -> band.oracle.v1.QueryReportersRequest
-	20, // 20: band.oracle.v1.Query.ActiveValidators:input_type -> band.oracle.v1.QueryActiveValidatorsRequest
-	12, // 21: band.oracle.v1.Query.Params:input_type -> band.oracle.v1.QueryParamsRequest
-	22, // 22: band.oracle.v1.Query.RequestSearch:input_type -> band.oracle.v1.QueryRequestSearchRequest
-	24, // 23: band.oracle.v1.Query.RequestPrice:input_type -> band.oracle.v1.QueryRequestPriceRequest
-	26, // 24: band.oracle.v1.Query.RequestVerification:input_type -> band.oracle.v1.QueryRequestVerificationRequest
-	1,  // 25: band.oracle.v1.Query.Counts:output_type -> band.oracle.v1.QueryCountsResponse
-	3,  // 26: band.oracle.v1.Query.Data:output_type -> band.oracle.v1.QueryDataResponse
-	5,  // 27: band.oracle.v1.Query.DataSource:output_type -> band.oracle.v1.QueryDataSourceResponse
-	7,  // 28: band.oracle.v1.Query.OracleScript:output_type -> band.oracle.v1.QueryOracleScriptResponse
-	9,  // 29: band.oracle.v1.Query.Request:output_type -> band.oracle.v1.QueryRequestResponse
-	11, // 30: band.oracle.v1.Query.PendingRequests:output_type -> band.oracle.v1.QueryPendingRequestsResponse
-	15, // 31: band.oracle.v1.Query.Validator:output_type -> band.oracle.v1.QueryValidatorResponse
-	17, // 32: band.oracle.v1.Query.IsReporter:output_type -> band.oracle.v1.QueryIsReporterResponse
-	19, // 33: band.oracle.v1.Query.Reporters:output_type -> band.oracle.v1.QueryReportersResponse
-	21, // 34: band.oracle.v1.Query.ActiveValidators:output_type -> band.oracle.v1.QueryActiveValidatorsResponse
-	13, // 35: band.oracle.v1.Query.Params:output_type -> band.oracle.v1.QueryParamsResponse
-	23, // 36: band.oracle.v1.Query.RequestSearch:output_type -> band.oracle.v1.QueryRequestSearchResponse
-	25, // 37: band.oracle.v1.Query.RequestPrice:output_type -> band.oracle.v1.QueryRequestPriceResponse
-	27, // 38: band.oracle.v1.Query.RequestVerification:output_type -> band.oracle.v1.QueryRequestVerificationResponse
-	25, // [25:39] is the sub-list for method output_type
-	11, // [11:25] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	40, // 0: band.oracle.v1.QueryDataSourceResponse.data_source:type_name -> band.oracle.v1.DataSource
+	41, // 1: band.oracle.v1.QueryOracleScriptResponse.oracle_script:type_name -> band.oracle.v1.OracleScript
+	42, // 2: band.oracle.v1.QueryRequestResponse.request:type_name -> band.oracle.v1.Request
+	43, // 3: band.oracle.v1.QueryRequestResponse.reports:type_name -> band.oracle.v1.Report
+	44, // 4: band.oracle.v1.QueryRequestResponse.result:type_name -> band.oracle.v1.Result
+	45, // 5: band.oracle.v1.QueryRequestResponse.signing:type_name -> band.oracle.v1.SigningResult
+	46, // 6: band.oracle.v1.QueryParamsResponse.params:type_name -> band.oracle.v1.Params
+	47, // 7: band.oracle.v1.QueryValidatorResponse.status:type_name -> band.oracle.v1.ValidatorStatus
+	48, // 8: band.oracle.v1.QueryValidatorReportInfoResponse.report_info:type_name -> band.oracle.v1.ValidatorReportInfo
+	49, // 9: band.oracle.v1.QueryValidatorReportInfosRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	48, // 10: band.oracle.v1.QueryValidatorReportInfosResponse.report_infos:type_name -> band.oracle.v1.ValidatorReportInfo
+	50, // 11: band.oracle.v1.QueryValidatorReportInfosResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	51, // 12: band.oracle.v1.QueryActiveValidatorsResponse.validators:type_name -> band.oracle.v1.ActiveValidator
+	9,  // 13: band.oracle.v1.QueryRequestSearchResponse.request:type_name -> band.oracle.v1.QueryRequestResponse
+	52, // 14: band.oracle.v1.QueryRequestPriceResponse.price_results:type_name -> band.oracle.v1.PriceResult
+	53, // 15: band.oracle.v1.QueryRequestSubscriptionResponse.subscription:type_name -> band.oracle.v1.RequestSubscription
+	54, // 16: band.oracle.v1.QueryRequestSubscriptionResponse.balance:type_name -> cosmos.base.v1beta1.Coin
+	49, // 17: band.oracle.v1.QueryRequestSubscriptionsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	53, // 18: band.oracle.v1.QueryRequestSubscriptionsResponse.subscriptions:type_name -> band.oracle.v1.RequestSubscription
+	50, // 19: band.oracle.v1.QueryRequestSubscriptionsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	49, // 20: band.oracle.v1.QueryDataSourceVersionsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	55, // 21: band.oracle.v1.QueryDataSourceVersionsResponse.versions:type_name -> band.oracle.v1.DataSourceVersion
+	50, // 22: band.oracle.v1.QueryDataSourceVersionsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	49, // 23: band.oracle.v1.QueryOracleScriptVersionsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	56, // 24: band.oracle.v1.QueryOracleScriptVersionsResponse.versions:type_name -> band.oracle.v1.OracleScriptVersion
+	50, // 25: band.oracle.v1.QueryOracleScriptVersionsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	0,  // 26: band.oracle.v1.Query.Counts:input_type -> band.oracle.v1.QueryCountsRequest
+	2,  // 27: band.oracle.v1.Query.Data:input_type -> band.oracle.v1.QueryDataRequest
+	4,  // 28: band.oracle.v1.Query.DataSource:input_type -> band.oracle.v1.QueryDataSourceRequest
+	6,  // 29: band.oracle.v1.Query.OracleScript:input_type -> band.oracle.v1.QueryOracleScriptRequest
+	8,  // 30: band.oracle.v1.Query.Request:input_type -> band.oracle.v1.QueryRequestRequest
+	10, // 31: band.oracle.v1.Query.PendingRequests:input_type -> band.oracle.v1.QueryPendingRequestsRequest
+	14, // 32: band.oracle.v1.Query.Validator:input_type -> band.oracle.v1.QueryValidatorRequest
+	16, // 33: band.oracle.v1.Query.ValidatorReportInfo:input_type -> band.oracle.v1.QueryValidatorReportInfoRequest
+	18, // 34: band.oracle.v1.Query.ValidatorReportInfos:input_type -> band.oracle.v1.QueryValidatorReportInfosRequest
+	20, // 35: band.oracle.v1.Query.IsReporter:input_type -> band.oracle.v1.QueryIsReporterRequest
+	22, // 36: band.oracle.v1.Query.Reporters:input_type -> band.oracle.v1.QueryReportersRequest
+	24, // 37: band.oracle.v1.Query.ActiveValidators:input_type -> band.oracle.v1.QueryActiveValidatorsRequest
+	12, // 38: band.oracle.v1.Query.Params:input_type -> band.oracle.v1.QueryParamsRequest
+	26, // 39: band.oracle.v1.Query.RequestSearch:input_type -> band.oracle.v1.QueryRequestSearchRequest
+	28, // 40: band.oracle.v1.Query.RequestPrice:input_type -> band.oracle.v1.QueryRequestPriceRequest
+	36, // 41: band.oracle.v1.Query.DataSourceVersions:input_type -> band.oracle.v1.QueryDataSourceVersionsRequest
+	38, // 42: band.oracle.v1.Query.OracleScriptVersions:input_type -> band.oracle.v1.QueryOracleScriptVersionsRequest
+	32, // 43: band.oracle.v1.Query.RequestSubscription:input_type -> band.oracle.v1.QueryRequestSubscriptionRequest
+	34, // 44: band.oracle.v1.Query.RequestSubscriptions:input_type -> band.oracle.v1.QueryRequestSubscriptionsRequest
+	30, // 45: band.oracle.v1.Query.RequestVerification:input_type -> band.oracle.v1.QueryRequestVerificationRequest
+	1,  // 46: band.oracle.v1.Query.Counts:output_type -> band.oracle.v1.QueryCountsResponse
+	3,  // 47: band.oracle.v1.Query.Data:output_type -> band.oracle.v1.QueryDataResponse
+	5,  // 48: band.oracle.v1.Query.DataSource:output_type -> band.oracle.v1.QueryDataSourceResponse
+	7,  // 49: band.oracle.v1.Query.OracleScript:output_type -> band.oracle.v1.QueryOracleScriptResponse
+	9,  // 50: band.oracle.v1.Query.Request:output_type -> band.oracle.v1.QueryRequestResponse
+	11, // 51: band.oracle.v1.Query.PendingRequests:output_type -> band.oracle.v1.QueryPendingRequestsResponse
+	15, // 52: band.oracle.v1.Query.Validator:output_type -> band.oracle.v1.QueryValidatorResponse
+	17, // 53: band.oracle.v1.Query.ValidatorReportInfo:output_type -> band.oracle.v1.QueryValidatorReportInfoResponse
+	19, // 54: band.oracle.v1.Query.ValidatorReportInfos:output_type -> band.oracle.v1.QueryValidatorReportInfosResponse
+	21, // 55: band.oracle.v1.Query.IsReporter:output_type -> band.oracle.v1.QueryIsReporterResponse
+	23, // 56: band.oracle.v1.Query.Reporters:output_type -> band.oracle.v1.QueryReportersResponse
+	25, // 57: band.oracle.v1.Query.ActiveValidators:output_type -> band.oracle.v1.QueryActiveValidatorsResponse
+	13, // 58: band.oracle.v1.Query.Params:output_type -> band.oracle.v1.QueryParamsResponse
+	27, // 59: band.oracle.v1.Query.RequestSearch:output_type -> band.oracle.v1.QueryRequestSearchResponse
+	29, // 60: band.oracle.v1.Query.RequestPrice:output_type -> band.oracle.v1.QueryRequestPriceResponse
+	37, // 61: band.oracle.v1.Query.DataSourceVersions:output_type -> band.oracle.v1.QueryDataSourceVersionsResponse
+	39, // 62: band.oracle.v1.Query.OracleScriptVersions:output_type -> band.oracle.v1.QueryOracleScriptVersionsResponse
+	33, // 63: band.oracle.v1.Query.RequestSubscription:output_type -> band.oracle.v1.QueryRequestSubscriptionResponse
+	35, // 64: band.oracle.v1.Query.RequestSubscriptions:output_type -> band.oracle.v1.QueryRequestSubscriptionsResponse
+	31, // 65: band.oracle.v1.Query.RequestVerification:output_type -> band.oracle.v1.QueryRequestVerificationResponse
+	46, // [46:66] is the sub-list for method output_type
+	26, // [26:46] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_band_oracle_v1_query_proto_init() }
@@ -15211,7 +21921,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryIsReporterRequest); i {
+			switch v := v.(*QueryValidatorReportInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15223,7 +21933,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryIsReporterResponse); i {
+			switch v := v.(*QueryValidatorReportInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15235,7 +21945,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryReportersRequest); i {
+			switch v := v.(*QueryValidatorReportInfosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15247,7 +21957,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryReportersResponse); i {
+			switch v := v.(*QueryValidatorReportInfosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15259,7 +21969,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryActiveValidatorsRequest); i {
+			switch v := v.(*QueryIsReporterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15271,7 +21981,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryActiveValidatorsResponse); i {
+			switch v := v.(*QueryIsReporterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15283,7 +21993,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequestSearchRequest); i {
+			switch v := v.(*QueryReportersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15295,7 +22005,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequestSearchResponse); i {
+			switch v := v.(*QueryReportersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15307,7 +22017,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequestPriceRequest); i {
+			switch v := v.(*QueryActiveValidatorsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15319,7 +22029,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequestPriceResponse); i {
+			switch v := v.(*QueryActiveValidatorsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15331,7 +22041,7 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequestVerificationRequest); i {
+			switch v := v.(*QueryRequestSearchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15343,6 +22053,54 @@ func file_band_oracle_v1_query_proto_init() {
 			}
 		}
 		file_band_oracle_v1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequestSearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequestPriceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequestPriceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequestVerificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryRequestVerificationResponse); i {
 			case 0:
 				return &v.state
@@ -15354,6 +22112,102 @@ func file_band_oracle_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_band_oracle_v1_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequestSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequestSubscriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequestSubscriptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequestSubscriptionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDataSourceVersionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDataSourceVersionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryOracleScriptVersionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryOracleScriptVersionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -15361,7 +22215,7 @@ func file_band_oracle_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_oracle_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
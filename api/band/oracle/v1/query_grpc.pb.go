@@ -19,20 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Counts_FullMethodName              = "/band.oracle.v1.Query/Counts"
-	Query_Data_FullMethodName                = "/band.oracle.v1.Query/Data"
-	Query_DataSource_FullMethodName          = "/band.oracle.v1.Query/DataSource"
-	Query_OracleScript_FullMethodName        = "/band.oracle.v1.Query/OracleScript"
-	Query_Request_FullMethodName             = "/band.oracle.v1.Query/Request"
-	Query_PendingRequests_FullMethodName     = "/band.oracle.v1.Query/PendingRequests"
-	Query_Validator_FullMethodName           = "/band.oracle.v1.Query/Validator"
-	Query_IsReporter_FullMethodName          = "/band.oracle.v1.Query/IsReporter"
-	Query_Reporters_FullMethodName           = "/band.oracle.v1.Query/Reporters"
-	Query_ActiveValidators_FullMethodName    = "/band.oracle.v1.Query/ActiveValidators"
-	Query_Params_FullMethodName              = "/band.oracle.v1.Query/Params"
-	Query_RequestSearch_FullMethodName       = "/band.oracle.v1.Query/RequestSearch"
-	Query_RequestPrice_FullMethodName        = "/band.oracle.v1.Query/RequestPrice"
-	Query_RequestVerification_FullMethodName = "/band.oracle.v1.Query/RequestVerification"
+	Query_Counts_FullMethodName               = "/band.oracle.v1.Query/Counts"
+	Query_Data_FullMethodName                 = "/band.oracle.v1.Query/Data"
+	Query_DataSource_FullMethodName           = "/band.oracle.v1.Query/DataSource"
+	Query_OracleScript_FullMethodName         = "/band.oracle.v1.Query/OracleScript"
+	Query_Request_FullMethodName              = "/band.oracle.v1.Query/Request"
+	Query_PendingRequests_FullMethodName      = "/band.oracle.v1.Query/PendingRequests"
+	Query_Validator_FullMethodName            = "/band.oracle.v1.Query/Validator"
+	Query_ValidatorReportInfo_FullMethodName  = "/band.oracle.v1.Query/ValidatorReportInfo"
+	Query_ValidatorReportInfos_FullMethodName = "/band.oracle.v1.Query/ValidatorReportInfos"
+	Query_IsReporter_FullMethodName           = "/band.oracle.v1.Query/IsReporter"
+	Query_Reporters_FullMethodName            = "/band.oracle.v1.Query/Reporters"
+	Query_ActiveValidators_FullMethodName     = "/band.oracle.v1.Query/ActiveValidators"
+	Query_Params_FullMethodName               = "/band.oracle.v1.Query/Params"
+	Query_RequestSearch_FullMethodName        = "/band.oracle.v1.Query/RequestSearch"
+	Query_RequestPrice_FullMethodName         = "/band.oracle.v1.Query/RequestPrice"
+	Query_DataSourceVersions_FullMethodName   = "/band.oracle.v1.Query/DataSourceVersions"
+	Query_OracleScriptVersions_FullMethodName = "/band.oracle.v1.Query/OracleScriptVersions"
+	Query_RequestSubscription_FullMethodName  = "/band.oracle.v1.Query/RequestSubscription"
+	Query_RequestSubscriptions_FullMethodName = "/band.oracle.v1.Query/RequestSubscriptions"
+	Query_RequestVerification_FullMethodName  = "/band.oracle.v1.Query/RequestVerification"
 )
 
 // QueryClient is the client API for Query service.
@@ -56,6 +62,11 @@ type QueryClient interface {
 	PendingRequests(ctx context.Context, in *QueryPendingRequestsRequest, opts ...grpc.CallOption) (*QueryPendingRequestsResponse, error)
 	// Validator queries properties of given validator address.
 	Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error)
+	// ValidatorReportInfo queries the report window stats of given validator
+	// address.
+	ValidatorReportInfo(ctx context.Context, in *QueryValidatorReportInfoRequest, opts ...grpc.CallOption) (*QueryValidatorReportInfoResponse, error)
+	// ValidatorReportInfos queries the report window stats of all validators.
+	ValidatorReportInfos(ctx context.Context, in *QueryValidatorReportInfosRequest, opts ...grpc.CallOption) (*QueryValidatorReportInfosResponse, error)
 	// IsReporter queries grant of account on this validator.
 	IsReporter(ctx context.Context, in *QueryIsReporterRequest, opts ...grpc.CallOption) (*QueryIsReporterResponse, error)
 	// Reporters queries all reporters associated with given validator address.
@@ -69,6 +80,15 @@ type QueryClient interface {
 	// RequestPrice queries the latest price on standard price reference oracle
 	// script.
 	RequestPrice(ctx context.Context, in *QueryRequestPriceRequest, opts ...grpc.CallOption) (*QueryRequestPriceResponse, error)
+	// DataSourceVersions queries the version history of a data source.
+	DataSourceVersions(ctx context.Context, in *QueryDataSourceVersionsRequest, opts ...grpc.CallOption) (*QueryDataSourceVersionsResponse, error)
+	// OracleScriptVersions queries the version history of an oracle script.
+	OracleScriptVersions(ctx context.Context, in *QueryOracleScriptVersionsRequest, opts ...grpc.CallOption) (*QueryOracleScriptVersionsResponse, error)
+	// RequestSubscription queries a request subscription and its remaining
+	// deposit.
+	RequestSubscription(ctx context.Context, in *QueryRequestSubscriptionRequest, opts ...grpc.CallOption) (*QueryRequestSubscriptionResponse, error)
+	// RequestSubscriptions queries all request subscriptions.
+	RequestSubscriptions(ctx context.Context, in *QueryRequestSubscriptionsRequest, opts ...grpc.CallOption) (*QueryRequestSubscriptionsResponse, error)
 	// RequestVerification verifies a request to make sure that
 	// all information that will be used to report the data is valid
 	RequestVerification(ctx context.Context, in *QueryRequestVerificationRequest, opts ...grpc.CallOption) (*QueryRequestVerificationResponse, error)
@@ -145,6 +165,24 @@ func (c *queryClient) Validator(ctx context.Context, in *QueryValidatorRequest,
 	return out, nil
 }
 
+func (c *queryClient) ValidatorReportInfo(ctx context.Context, in *QueryValidatorReportInfoRequest, opts ...grpc.CallOption) (*QueryValidatorReportInfoResponse, error) {
+	out := new(QueryValidatorReportInfoResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorReportInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorReportInfos(ctx context.Context, in *QueryValidatorReportInfosRequest, opts ...grpc.CallOption) (*QueryValidatorReportInfosResponse, error) {
+	out := new(QueryValidatorReportInfosResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorReportInfos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) IsReporter(ctx context.Context, in *QueryIsReporterRequest, opts ...grpc.CallOption) (*QueryIsReporterResponse, error) {
 	out := new(QueryIsReporterResponse)
 	err := c.cc.Invoke(ctx, Query_IsReporter_FullMethodName, in, out, opts...)
@@ -199,6 +237,42 @@ func (c *queryClient) RequestPrice(ctx context.Context, in *QueryRequestPriceReq
 	return out, nil
 }
 
+func (c *queryClient) DataSourceVersions(ctx context.Context, in *QueryDataSourceVersionsRequest, opts ...grpc.CallOption) (*QueryDataSourceVersionsResponse, error) {
+	out := new(QueryDataSourceVersionsResponse)
+	err := c.cc.Invoke(ctx, Query_DataSourceVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OracleScriptVersions(ctx context.Context, in *QueryOracleScriptVersionsRequest, opts ...grpc.CallOption) (*QueryOracleScriptVersionsResponse, error) {
+	out := new(QueryOracleScriptVersionsResponse)
+	err := c.cc.Invoke(ctx, Query_OracleScriptVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RequestSubscription(ctx context.Context, in *QueryRequestSubscriptionRequest, opts ...grpc.CallOption) (*QueryRequestSubscriptionResponse, error) {
+	out := new(QueryRequestSubscriptionResponse)
+	err := c.cc.Invoke(ctx, Query_RequestSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RequestSubscriptions(ctx context.Context, in *QueryRequestSubscriptionsRequest, opts ...grpc.CallOption) (*QueryRequestSubscriptionsResponse, error) {
+	out := new(QueryRequestSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, Query_RequestSubscriptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) RequestVerification(ctx context.Context, in *QueryRequestVerificationRequest, opts ...grpc.CallOption) (*QueryRequestVerificationResponse, error) {
 	out := new(QueryRequestVerificationResponse)
 	err := c.cc.Invoke(ctx, Query_RequestVerification_FullMethodName, in, out, opts...)
@@ -229,6 +303,11 @@ type QueryServer interface {
 	PendingRequests(context.Context, *QueryPendingRequestsRequest) (*QueryPendingRequestsResponse, error)
 	// Validator queries properties of given validator address.
 	Validator(context.Context, *QueryValidatorRequest) (*QueryValidatorResponse, error)
+	// ValidatorReportInfo queries the report window stats of given validator
+	// address.
+	ValidatorReportInfo(context.Context, *QueryValidatorReportInfoRequest) (*QueryValidatorReportInfoResponse, error)
+	// ValidatorReportInfos queries the report window stats of all validators.
+	ValidatorReportInfos(context.Context, *QueryValidatorReportInfosRequest) (*QueryValidatorReportInfosResponse, error)
 	// IsReporter queries grant of account on this validator.
 	IsReporter(context.Context, *QueryIsReporterRequest) (*QueryIsReporterResponse, error)
 	// Reporters queries all reporters associated with given validator address.
@@ -242,6 +321,15 @@ type QueryServer interface {
 	// RequestPrice queries the latest price on standard price reference oracle
 	// script.
 	RequestPrice(context.Context, *QueryRequestPriceRequest) (*QueryRequestPriceResponse, error)
+	// DataSourceVersions queries the version history of a data source.
+	DataSourceVersions(context.Context, *QueryDataSourceVersionsRequest) (*QueryDataSourceVersionsResponse, error)
+	// OracleScriptVersions queries the version history of an oracle script.
+	OracleScriptVersions(context.Context, *QueryOracleScriptVersionsRequest) (*QueryOracleScriptVersionsResponse, error)
+	// RequestSubscription queries a request subscription and its remaining
+	// deposit.
+	RequestSubscription(context.Context, *QueryRequestSubscriptionRequest) (*QueryRequestSubscriptionResponse, error)
+	// RequestSubscriptions queries all request subscriptions.
+	RequestSubscriptions(context.Context, *QueryRequestSubscriptionsRequest) (*QueryRequestSubscriptionsResponse, error)
 	// RequestVerification verifies a request to make sure that
 	// all information that will be used to report the data is valid
 	RequestVerification(context.Context, *QueryRequestVerificationRequest) (*QueryRequestVerificationResponse, error)
@@ -273,6 +361,12 @@ func (UnimplementedQueryServer) PendingRequests(context.Context, *QueryPendingRe
 func (UnimplementedQueryServer) Validator(context.Context, *QueryValidatorRequest) (*QueryValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
 }
+func (UnimplementedQueryServer) ValidatorReportInfo(context.Context, *QueryValidatorReportInfoRequest) (*QueryValidatorReportInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorReportInfo not implemented")
+}
+func (UnimplementedQueryServer) ValidatorReportInfos(context.Context, *QueryValidatorReportInfosRequest) (*QueryValidatorReportInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorReportInfos not implemented")
+}
 func (UnimplementedQueryServer) IsReporter(context.Context, *QueryIsReporterRequest) (*QueryIsReporterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsReporter not implemented")
 }
@@ -291,6 +385,18 @@ func (UnimplementedQueryServer) RequestSearch(context.Context, *QueryRequestSear
 func (UnimplementedQueryServer) RequestPrice(context.Context, *QueryRequestPriceRequest) (*QueryRequestPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestPrice not implemented")
 }
+func (UnimplementedQueryServer) DataSourceVersions(context.Context, *QueryDataSourceVersionsRequest) (*QueryDataSourceVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataSourceVersions not implemented")
+}
+func (UnimplementedQueryServer) OracleScriptVersions(context.Context, *QueryOracleScriptVersionsRequest) (*QueryOracleScriptVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleScriptVersions not implemented")
+}
+func (UnimplementedQueryServer) RequestSubscription(context.Context, *QueryRequestSubscriptionRequest) (*QueryRequestSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestSubscription not implemented")
+}
+func (UnimplementedQueryServer) RequestSubscriptions(context.Context, *QueryRequestSubscriptionsRequest) (*QueryRequestSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestSubscriptions not implemented")
+}
 func (UnimplementedQueryServer) RequestVerification(context.Context, *QueryRequestVerificationRequest) (*QueryRequestVerificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestVerification not implemented")
 }
@@ -433,6 +539,42 @@ func _Query_Validator_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorReportInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorReportInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorReportInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorReportInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorReportInfo(ctx, req.(*QueryValidatorReportInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorReportInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorReportInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorReportInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorReportInfos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorReportInfos(ctx, req.(*QueryValidatorReportInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_IsReporter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryIsReporterRequest)
 	if err := dec(in); err != nil {
@@ -541,6 +683,78 @@ func _Query_RequestPrice_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DataSourceVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataSourceVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataSourceVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DataSourceVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataSourceVersions(ctx, req.(*QueryDataSourceVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OracleScriptVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleScriptVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleScriptVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OracleScriptVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleScriptVersions(ctx, req.(*QueryOracleScriptVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RequestSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequestSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RequestSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RequestSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RequestSubscription(ctx, req.(*QueryRequestSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RequestSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequestSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RequestSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RequestSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RequestSubscriptions(ctx, req.(*QueryRequestSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_RequestVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRequestVerificationRequest)
 	if err := dec(in); err != nil {
@@ -594,6 +808,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Validator",
 			Handler:    _Query_Validator_Handler,
 		},
+		{
+			MethodName: "ValidatorReportInfo",
+			Handler:    _Query_ValidatorReportInfo_Handler,
+		},
+		{
+			MethodName: "ValidatorReportInfos",
+			Handler:    _Query_ValidatorReportInfos_Handler,
+		},
 		{
 			MethodName: "IsReporter",
 			Handler:    _Query_IsReporter_Handler,
@@ -618,6 +840,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestPrice",
 			Handler:    _Query_RequestPrice_Handler,
 		},
+		{
+			MethodName: "DataSourceVersions",
+			Handler:    _Query_DataSourceVersions_Handler,
+		},
+		{
+			MethodName: "OracleScriptVersions",
+			Handler:    _Query_OracleScriptVersions_Handler,
+		},
+		{
+			MethodName: "RequestSubscription",
+			Handler:    _Query_RequestSubscription_Handler,
+		},
+		{
+			MethodName: "RequestSubscriptions",
+			Handler:    _Query_RequestSubscriptions_Handler,
+		},
 		{
 			MethodName: "RequestVerification",
 			Handler:    _Query_RequestVerification_Handler,
@@ -19,14 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_RequestData_FullMethodName        = "/band.oracle.v1.Msg/RequestData"
-	Msg_ReportData_FullMethodName         = "/band.oracle.v1.Msg/ReportData"
-	Msg_CreateDataSource_FullMethodName   = "/band.oracle.v1.Msg/CreateDataSource"
-	Msg_EditDataSource_FullMethodName     = "/band.oracle.v1.Msg/EditDataSource"
-	Msg_CreateOracleScript_FullMethodName = "/band.oracle.v1.Msg/CreateOracleScript"
-	Msg_EditOracleScript_FullMethodName   = "/band.oracle.v1.Msg/EditOracleScript"
-	Msg_Activate_FullMethodName           = "/band.oracle.v1.Msg/Activate"
-	Msg_UpdateParams_FullMethodName       = "/band.oracle.v1.Msg/UpdateParams"
+	Msg_RequestData_FullMethodName               = "/band.oracle.v1.Msg/RequestData"
+	Msg_ReportData_FullMethodName                = "/band.oracle.v1.Msg/ReportData"
+	Msg_CreateDataSource_FullMethodName          = "/band.oracle.v1.Msg/CreateDataSource"
+	Msg_EditDataSource_FullMethodName            = "/band.oracle.v1.Msg/EditDataSource"
+	Msg_CreateOracleScript_FullMethodName        = "/band.oracle.v1.Msg/CreateOracleScript"
+	Msg_EditOracleScript_FullMethodName          = "/band.oracle.v1.Msg/EditOracleScript"
+	Msg_Activate_FullMethodName                  = "/band.oracle.v1.Msg/Activate"
+	Msg_CreateRequestSubscription_FullMethodName = "/band.oracle.v1.Msg/CreateRequestSubscription"
+	Msg_TopUpRequestSubscription_FullMethodName  = "/band.oracle.v1.Msg/TopUpRequestSubscription"
+	Msg_CancelRequestSubscription_FullMethodName = "/band.oracle.v1.Msg/CancelRequestSubscription"
+	Msg_UpdateParams_FullMethodName              = "/band.oracle.v1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -47,6 +50,15 @@ type MsgClient interface {
 	EditOracleScript(ctx context.Context, in *MsgEditOracleScript, opts ...grpc.CallOption) (*MsgEditOracleScriptResponse, error)
 	// Activate defines a method for applying to be an oracle validator.
 	Activate(ctx context.Context, in *MsgActivate, opts ...grpc.CallOption) (*MsgActivateResponse, error)
+	// CreateRequestSubscription defines a method for creating a request
+	// subscription that spawns requests at a fixed block interval.
+	CreateRequestSubscription(ctx context.Context, in *MsgCreateRequestSubscription, opts ...grpc.CallOption) (*MsgCreateRequestSubscriptionResponse, error)
+	// TopUpRequestSubscription defines a method for adding deposit to a request
+	// subscription.
+	TopUpRequestSubscription(ctx context.Context, in *MsgTopUpRequestSubscription, opts ...grpc.CallOption) (*MsgTopUpRequestSubscriptionResponse, error)
+	// CancelRequestSubscription defines a method for cancelling a request
+	// subscription and refunding its remaining deposit.
+	CancelRequestSubscription(ctx context.Context, in *MsgCancelRequestSubscription, opts ...grpc.CallOption) (*MsgCancelRequestSubscriptionResponse, error)
 	// UpdateParams defines a governance operation for updating the x/oracle module
 	// parameters.
 	//
@@ -125,6 +137,33 @@ func (c *msgClient) Activate(ctx context.Context, in *MsgActivate, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) CreateRequestSubscription(ctx context.Context, in *MsgCreateRequestSubscription, opts ...grpc.CallOption) (*MsgCreateRequestSubscriptionResponse, error) {
+	out := new(MsgCreateRequestSubscriptionResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateRequestSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TopUpRequestSubscription(ctx context.Context, in *MsgTopUpRequestSubscription, opts ...grpc.CallOption) (*MsgTopUpRequestSubscriptionResponse, error) {
+	out := new(MsgTopUpRequestSubscriptionResponse)
+	err := c.cc.Invoke(ctx, Msg_TopUpRequestSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRequestSubscription(ctx context.Context, in *MsgCancelRequestSubscription, opts ...grpc.CallOption) (*MsgCancelRequestSubscriptionResponse, error) {
+	out := new(MsgCancelRequestSubscriptionResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelRequestSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -152,6 +191,15 @@ type MsgServer interface {
 	EditOracleScript(context.Context, *MsgEditOracleScript) (*MsgEditOracleScriptResponse, error)
 	// Activate defines a method for applying to be an oracle validator.
 	Activate(context.Context, *MsgActivate) (*MsgActivateResponse, error)
+	// CreateRequestSubscription defines a method for creating a request
+	// subscription that spawns requests at a fixed block interval.
+	CreateRequestSubscription(context.Context, *MsgCreateRequestSubscription) (*MsgCreateRequestSubscriptionResponse, error)
+	// TopUpRequestSubscription defines a method for adding deposit to a request
+	// subscription.
+	TopUpRequestSubscription(context.Context, *MsgTopUpRequestSubscription) (*MsgTopUpRequestSubscriptionResponse, error)
+	// CancelRequestSubscription defines a method for cancelling a request
+	// subscription and refunding its remaining deposit.
+	CancelRequestSubscription(context.Context, *MsgCancelRequestSubscription) (*MsgCancelRequestSubscriptionResponse, error)
 	// UpdateParams defines a governance operation for updating the x/oracle module
 	// parameters.
 	//
@@ -185,6 +233,15 @@ func (UnimplementedMsgServer) EditOracleScript(context.Context, *MsgEditOracleSc
 func (UnimplementedMsgServer) Activate(context.Context, *MsgActivate) (*MsgActivateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Activate not implemented")
 }
+func (UnimplementedMsgServer) CreateRequestSubscription(context.Context, *MsgCreateRequestSubscription) (*MsgCreateRequestSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRequestSubscription not implemented")
+}
+func (UnimplementedMsgServer) TopUpRequestSubscription(context.Context, *MsgTopUpRequestSubscription) (*MsgTopUpRequestSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpRequestSubscription not implemented")
+}
+func (UnimplementedMsgServer) CancelRequestSubscription(context.Context, *MsgCancelRequestSubscription) (*MsgCancelRequestSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRequestSubscription not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -327,6 +384,60 @@ func _Msg_Activate_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateRequestSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRequestSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRequestSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateRequestSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRequestSubscription(ctx, req.(*MsgCreateRequestSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TopUpRequestSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTopUpRequestSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TopUpRequestSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TopUpRequestSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TopUpRequestSubscription(ctx, req.(*MsgTopUpRequestSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRequestSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRequestSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRequestSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelRequestSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRequestSubscription(ctx, req.(*MsgCancelRequestSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -380,6 +491,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Activate",
 			Handler:    _Msg_Activate_Handler,
 		},
+		{
+			MethodName: "CreateRequestSubscription",
+			Handler:    _Msg_CreateRequestSubscription_Handler,
+		},
+		{
+			MethodName: "TopUpRequestSubscription",
+			Handler:    _Msg_TopUpRequestSubscription_Handler,
+		},
+		{
+			MethodName: "CancelRequestSubscription",
+			Handler:    _Msg_CancelRequestSubscription_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -864,14 +864,15 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Params                     protoreflect.MessageDescriptor
-	fd_Params_max_group_size      protoreflect.FieldDescriptor
-	fd_Params_max_de_size         protoreflect.FieldDescriptor
-	fd_Params_creation_period     protoreflect.FieldDescriptor
-	fd_Params_signing_period      protoreflect.FieldDescriptor
-	fd_Params_max_signing_attempt protoreflect.FieldDescriptor
-	fd_Params_max_memo_length     protoreflect.FieldDescriptor
-	fd_Params_max_message_length  protoreflect.FieldDescriptor
+	md_Params                      protoreflect.MessageDescriptor
+	fd_Params_max_group_size       protoreflect.FieldDescriptor
+	fd_Params_max_de_size          protoreflect.FieldDescriptor
+	fd_Params_creation_period      protoreflect.FieldDescriptor
+	fd_Params_signing_period       protoreflect.FieldDescriptor
+	fd_Params_max_signing_attempt  protoreflect.FieldDescriptor
+	fd_Params_max_memo_length      protoreflect.FieldDescriptor
+	fd_Params_max_message_length   protoreflect.FieldDescriptor
+	fd_Params_batch_signing_window protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -884,6 +885,7 @@ func init() {
 	fd_Params_max_signing_attempt = md_Params.Fields().ByName("max_signing_attempt")
 	fd_Params_max_memo_length = md_Params.Fields().ByName("max_memo_length")
 	fd_Params_max_message_length = md_Params.Fields().ByName("max_message_length")
+	fd_Params_batch_signing_window = md_Params.Fields().ByName("batch_signing_window")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -993,6 +995,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.BatchSigningWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BatchSigningWindow)
+		if !f(fd_Params_batch_signing_window, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1022,6 +1030,8 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MaxMemoLength != uint64(0)
 	case "band.tss.v1beta1.Params.max_message_length":
 		return x.MaxMessageLength != uint64(0)
+	case "band.tss.v1beta1.Params.batch_signing_window":
+		return x.BatchSigningWindow != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.Params"))
@@ -1052,6 +1062,8 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MaxMemoLength = uint64(0)
 	case "band.tss.v1beta1.Params.max_message_length":
 		x.MaxMessageLength = uint64(0)
+	case "band.tss.v1beta1.Params.batch_signing_window":
+		x.BatchSigningWindow = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.Params"))
@@ -1089,6 +1101,9 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "band.tss.v1beta1.Params.max_message_length":
 		value := x.MaxMessageLength
 		return protoreflect.ValueOfUint64(value)
+	case "band.tss.v1beta1.Params.batch_signing_window":
+		value := x.BatchSigningWindow
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.Params"))
@@ -1123,6 +1138,8 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MaxMemoLength = value.Uint()
 	case "band.tss.v1beta1.Params.max_message_length":
 		x.MaxMessageLength = value.Uint()
+	case "band.tss.v1beta1.Params.batch_signing_window":
+		x.BatchSigningWindow = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.Params"))
@@ -1157,6 +1174,8 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field max_memo_length of message band.tss.v1beta1.Params is not mutable"))
 	case "band.tss.v1beta1.Params.max_message_length":
 		panic(fmt.Errorf("field max_message_length of message band.tss.v1beta1.Params is not mutable"))
+	case "band.tss.v1beta1.Params.batch_signing_window":
+		panic(fmt.Errorf("field batch_signing_window of message band.tss.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.Params"))
@@ -1184,6 +1203,8 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "band.tss.v1beta1.Params.max_message_length":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tss.v1beta1.Params.batch_signing_window":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.Params"))
@@ -1274,6 +1295,9 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.MaxMessageLength != 0 {
 			n += 1 + runtime.Sov(uint64(x.MaxMessageLength))
 		}
+		if x.BatchSigningWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.BatchSigningWindow))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1303,6 +1327,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.BatchSigningWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BatchSigningWindow))
+			i--
+			dAtA[i] = 0x40
+		}
 		if x.MaxMessageLength != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMessageLength))
 			i--
@@ -1520,6 +1549,25 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchSigningWindow", wireType)
+				}
+				x.BatchSigningWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BatchSigningWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2151,6 +2199,9 @@ type Params struct {
 	MaxMemoLength uint64 `protobuf:"varint,6,opt,name=max_memo_length,json=maxMemoLength,proto3" json:"max_memo_length,omitempty"`
 	// max_message_length is the maximum length of the message in the TextSignatureOrder.
 	MaxMessageLength uint64 `protobuf:"varint,7,opt,name=max_message_length,json=maxMessageLength,proto3" json:"max_message_length,omitempty"`
+	// batch_signing_window is the number of blocks that a batch collects signing requests before they
+	// are signed together over a merkle root. Zero disables batching.
+	BatchSigningWindow uint64 `protobuf:"varint,8,opt,name=batch_signing_window,json=batchSigningWindow,proto3" json:"batch_signing_window,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -2222,6 +2273,13 @@ func (x *Params) GetMaxMessageLength() uint64 {
 	return 0
 }
 
+func (x *Params) GetBatchSigningWindow() uint64 {
+	if x != nil {
+		return x.BatchSigningWindow
+	}
+	return 0
+}
+
 // DEGenesis defines an account address and de pair used in the tss module's genesis state.
 type DEGenesis struct {
 	state         protoimpl.MessageState
@@ -2294,7 +2352,7 @@ var file_band_tss_v1beta1_genesis_proto_rawDesc = []byte{
 	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73,
 	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x45, 0x47, 0x65, 0x6e, 0x65,
 	0x73, 0x69, 0x73, 0x42, 0x0b, 0xc8, 0xde, 0x1f, 0x00, 0xe2, 0xde, 0x1f, 0x03, 0x44, 0x45, 0x73,
-	0x52, 0x03, 0x64, 0x65, 0x73, 0x22, 0xb9, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x03, 0x64, 0x65, 0x73, 0x22, 0xeb, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
 	0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x73, 0x69,
 	0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x47, 0x72, 0x6f,
 	0x75, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2d, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65,
@@ -2313,28 +2371,31 @@ var file_band_tss_v1beta1_genesis_proto_rawDesc = []byte{
 	0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x6f, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x2c, 0x0a,
 	0x12, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x65, 0x6e,
 	0x67, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
-	0x01, 0x22, 0x71, 0x0a, 0x09, 0x44, 0x45, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x32,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x30, 0x0a, 0x02, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x44, 0x45, 0x42, 0x0a, 0xc8, 0xde, 0x1f, 0x00, 0xe2, 0xde, 0x1f, 0x02, 0x44, 0x45,
-	0x52, 0x02, 0x64, 0x65, 0x42, 0xc8, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e,
-	0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c, 0x47,
-	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x40, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x73, 0x73, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2,
-	0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54, 0x73, 0x73,
-	0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x5c,
-	0x54, 0x73, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1c, 0x42, 0x61,
-	0x6e, 0x64, 0x5c, 0x54, 0x73, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x42, 0x61, 0x6e,
-	0x64, 0x3a, 0x3a, 0x54, 0x73, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x30, 0x0a, 0x14, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x3a, 0x04, 0xe8,
+	0xa0, 0x1f, 0x01, 0x22, 0x71, 0x0a, 0x09, 0x44, 0x45, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x02, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x44, 0x45, 0x42, 0x0a, 0xc8, 0xde, 0x1f, 0x00, 0xe2, 0xde, 0x1f, 0x02,
+	0x44, 0x45, 0x52, 0x02, 0x64, 0x65, 0x42, 0xc8, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42,
+	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76,
+	0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x73, 0x73, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54,
+	0x73, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x10, 0x42, 0x61, 0x6e,
+	0x64, 0x5c, 0x54, 0x73, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1c,
+	0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x73, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x42,
+	0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x73, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4466,6 +4466,7 @@ var (
 	md_QueryDERequest            protoreflect.MessageDescriptor
 	fd_QueryDERequest_address    protoreflect.FieldDescriptor
 	fd_QueryDERequest_pagination protoreflect.FieldDescriptor
+	fd_QueryDERequest_curve      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -4473,6 +4474,7 @@ func init() {
 	md_QueryDERequest = File_band_tss_v1beta1_query_proto.Messages().ByName("QueryDERequest")
 	fd_QueryDERequest_address = md_QueryDERequest.Fields().ByName("address")
 	fd_QueryDERequest_pagination = md_QueryDERequest.Fields().ByName("pagination")
+	fd_QueryDERequest_curve = md_QueryDERequest.Fields().ByName("curve")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryDERequest)(nil)
@@ -4552,6 +4554,12 @@ func (x *fastReflection_QueryDERequest) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if x.Curve != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Curve))
+		if !f(fd_QueryDERequest_curve, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4571,6 +4579,8 @@ func (x *fastReflection_QueryDERequest) Has(fd protoreflect.FieldDescriptor) boo
 		return x.Address != ""
 	case "band.tss.v1beta1.QueryDERequest.pagination":
 		return x.Pagination != nil
+	case "band.tss.v1beta1.QueryDERequest.curve":
+		return x.Curve != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QueryDERequest"))
@@ -4591,6 +4601,8 @@ func (x *fastReflection_QueryDERequest) Clear(fd protoreflect.FieldDescriptor) {
 		x.Address = ""
 	case "band.tss.v1beta1.QueryDERequest.pagination":
 		x.Pagination = nil
+	case "band.tss.v1beta1.QueryDERequest.curve":
+		x.Curve = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QueryDERequest"))
@@ -4613,6 +4625,9 @@ func (x *fastReflection_QueryDERequest) Get(descriptor protoreflect.FieldDescrip
 	case "band.tss.v1beta1.QueryDERequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.tss.v1beta1.QueryDERequest.curve":
+		value := x.Curve
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QueryDERequest"))
@@ -4637,6 +4652,8 @@ func (x *fastReflection_QueryDERequest) Set(fd protoreflect.FieldDescriptor, val
 		x.Address = value.Interface().(string)
 	case "band.tss.v1beta1.QueryDERequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "band.tss.v1beta1.QueryDERequest.curve":
+		x.Curve = (Curve)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QueryDERequest"))
@@ -4664,6 +4681,8 @@ func (x *fastReflection_QueryDERequest) Mutable(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	case "band.tss.v1beta1.QueryDERequest.address":
 		panic(fmt.Errorf("field address of message band.tss.v1beta1.QueryDERequest is not mutable"))
+	case "band.tss.v1beta1.QueryDERequest.curve":
+		panic(fmt.Errorf("field curve of message band.tss.v1beta1.QueryDERequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QueryDERequest"))
@@ -4682,6 +4701,8 @@ func (x *fastReflection_QueryDERequest) NewField(fd protoreflect.FieldDescriptor
 	case "band.tss.v1beta1.QueryDERequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.tss.v1beta1.QueryDERequest.curve":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QueryDERequest"))
@@ -4759,6 +4780,9 @@ func (x *fastReflection_QueryDERequest) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Curve != 0 {
+			n += 1 + runtime.Sov(uint64(x.Curve))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4788,6 +4812,11 @@ func (x *fastReflection_QueryDERequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Curve != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Curve))
+			i--
+			dAtA[i] = 0x18
+		}
 		if x.Pagination != nil {
 			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
@@ -4926,6 +4955,25 @@ func (x *fastReflection_QueryDERequest) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+				}
+				x.Curve = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Curve |= Curve(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8286,25 +8334,25 @@ func (x *fastReflection_QuerySigningResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QuerySigningsRequest            protoreflect.MessageDescriptor
-	fd_QuerySigningsRequest_pagination protoreflect.FieldDescriptor
+	md_QueryBatchProofRequest            protoreflect.MessageDescriptor
+	fd_QueryBatchProofRequest_signing_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tss_v1beta1_query_proto_init()
-	md_QuerySigningsRequest = File_band_tss_v1beta1_query_proto.Messages().ByName("QuerySigningsRequest")
-	fd_QuerySigningsRequest_pagination = md_QuerySigningsRequest.Fields().ByName("pagination")
+	md_QueryBatchProofRequest = File_band_tss_v1beta1_query_proto.Messages().ByName("QueryBatchProofRequest")
+	fd_QueryBatchProofRequest_signing_id = md_QueryBatchProofRequest.Fields().ByName("signing_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QuerySigningsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryBatchProofRequest)(nil)
 
-type fastReflection_QuerySigningsRequest QuerySigningsRequest
+type fastReflection_QueryBatchProofRequest QueryBatchProofRequest
 
-func (x *QuerySigningsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QuerySigningsRequest)(x)
+func (x *QueryBatchProofRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBatchProofRequest)(x)
 }
 
-func (x *QuerySigningsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryBatchProofRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tss_v1beta1_query_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8316,43 +8364,43 @@ func (x *QuerySigningsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QuerySigningsRequest_messageType fastReflection_QuerySigningsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QuerySigningsRequest_messageType{}
+var _fastReflection_QueryBatchProofRequest_messageType fastReflection_QueryBatchProofRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBatchProofRequest_messageType{}
 
-type fastReflection_QuerySigningsRequest_messageType struct{}
+type fastReflection_QueryBatchProofRequest_messageType struct{}
 
-func (x fastReflection_QuerySigningsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QuerySigningsRequest)(nil)
+func (x fastReflection_QueryBatchProofRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBatchProofRequest)(nil)
 }
-func (x fastReflection_QuerySigningsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QuerySigningsRequest)
+func (x fastReflection_QueryBatchProofRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBatchProofRequest)
 }
-func (x fastReflection_QuerySigningsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuerySigningsRequest
+func (x fastReflection_QueryBatchProofRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBatchProofRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QuerySigningsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuerySigningsRequest
+func (x *fastReflection_QueryBatchProofRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBatchProofRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QuerySigningsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QuerySigningsRequest_messageType
+func (x *fastReflection_QueryBatchProofRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBatchProofRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QuerySigningsRequest) New() protoreflect.Message {
-	return new(fastReflection_QuerySigningsRequest)
+func (x *fastReflection_QueryBatchProofRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryBatchProofRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QuerySigningsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QuerySigningsRequest)(x)
+func (x *fastReflection_QueryBatchProofRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryBatchProofRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8360,10 +8408,10 @@ func (x *fastReflection_QuerySigningsRequest) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QuerySigningsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QuerySigningsRequest_pagination, value) {
+func (x *fastReflection_QueryBatchProofRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SigningId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SigningId)
+		if !f(fd_QueryBatchProofRequest_signing_id, value) {
 			return
 		}
 	}
@@ -8380,15 +8428,15 @@ func (x *fastReflection_QuerySigningsRequest) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QuerySigningsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryBatchProofRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tss.v1beta1.QuerySigningsRequest.pagination":
-		return x.Pagination != nil
+	case "band.tss.v1beta1.QueryBatchProofRequest.signing_id":
+		return x.SigningId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QuerySigningsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QueryBatchProofRequest"))
 		}
-		panic(fmt.Errorf("message band.tss.v1beta1.QuerySigningsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tss.v1beta1.QueryBatchProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8398,15 +8446,15 @@ func (x *fastReflection_QuerySigningsRequest) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySigningsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryBatchProofRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tss.v1beta1.QuerySigningsRequest.pagination":
-		x.Pagination = nil
+	case "band.tss.v1beta1.QueryBatchProofRequest.signing_id":
+		x.SigningId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QuerySigningsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QueryBatchProofRequest"))
 		}
-		panic(fmt.Errorf("message band.tss.v1beta1.QuerySigningsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tss.v1beta1.QueryBatchProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8416,16 +8464,16 @@ func (x *fastReflection_QuerySigningsRequest) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QuerySigningsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBatchProofRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tss.v1beta1.QuerySigningsRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.tss.v1beta1.QueryBatchProofRequest.signing_id":
+		value := x.SigningId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QuerySigningsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QueryBatchProofRequest"))
 		}
-		panic(fmt.Errorf("message band.tss.v1beta1.QuerySigningsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tss.v1beta1.QueryBatchProofRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8439,15 +8487,15 @@ func (x *fastReflection_QuerySigningsRequest) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySigningsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryBatchProofRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tss.v1beta1.QuerySigningsRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "band.tss.v1beta1.QueryBatchProofRequest.signing_id":
+		x.SigningId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QuerySigningsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QueryBatchProofRequest"))
 		}
-		panic(fmt.Errorf("message band.tss.v1beta1.QuerySigningsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tss.v1beta1.QueryBatchProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8461,44 +8509,40 @@ func (x *fastReflection_QuerySigningsRequest) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySigningsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBatchProofRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tss.v1beta1.QuerySigningsRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "band.tss.v1beta1.QueryBatchProofRequest.signing_id":
+		panic(fmt.Errorf("field signing_id of message band.tss.v1beta1.QueryBatchProofRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QuerySigningsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QueryBatchProofRequest"))
 		}
-		panic(fmt.Errorf("message band.tss.v1beta1.QuerySigningsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tss.v1beta1.QueryBatchProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QuerySigningsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBatchProofRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tss.v1beta1.QuerySigningsRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.tss.v1beta1.QueryBatchProofRequest.signing_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QuerySigningsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QueryBatchProofRequest"))
 		}
-		panic(fmt.Errorf("message band.tss.v1beta1.QuerySigningsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tss.v1beta1.QueryBatchProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QuerySigningsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryBatchProofRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tss.v1beta1.QuerySigningsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tss.v1beta1.QueryBatchProofRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8506,7 +8550,7 @@ func (x *fastReflection_QuerySigningsRequest) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QuerySigningsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryBatchProofRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8517,7 +8561,7 @@ func (x *fastReflection_QuerySigningsRequest) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySigningsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryBatchProofRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8529,7 +8573,7 @@ func (x *fastReflection_QuerySigningsRequest) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QuerySigningsRequest) IsValid() bool {
+func (x *fastReflection_QueryBatchProofRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -8539,9 +8583,9 @@ func (x *fastReflection_QuerySigningsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QuerySigningsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryBatchProofRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QuerySigningsRequest)
+		x := input.Message.Interface().(*QueryBatchProofRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8553,9 +8597,8 @@ func (x *fastReflection_QuerySigningsRequest) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.SigningId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SigningId))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8567,7 +8610,7 @@ func (x *fastReflection_QuerySigningsRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QuerySigningsRequest)
+		x := input.Message.Interface().(*QueryBatchProofRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8586,19 +8629,10 @@ func (x *fastReflection_QuerySigningsRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.SigningId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigningId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8611,7 +8645,7 @@ func (x *fastReflection_QuerySigningsRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QuerySigningsRequest)
+		x := input.Message.Interface().(*QueryBatchProofRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8643,17 +8677,17 @@ func (x *fastReflection_QuerySigningsRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySigningsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBatchProofRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySigningsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBatchProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningId", wireType)
 				}
-				var msglen int
+				x.SigningId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8663,28 +8697,11 @@ func (x *fastReflection_QuerySigningsRequest) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.SigningId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8720,79 +8737,28 @@ func (x *fastReflection_QuerySigningsRequest) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_QuerySigningsResponse_1_list)(nil)
-
-type _QuerySigningsResponse_1_list struct {
-	list *[]*SigningResult
-}
-
-func (x *_QuerySigningsResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QuerySigningsResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QuerySigningsResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*SigningResult)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QuerySigningsResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*SigningResult)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QuerySigningsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(SigningResult)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QuerySigningsResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QuerySigningsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(SigningResult)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QuerySigningsResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QuerySigningsResponse                 protoreflect.MessageDescriptor
-	fd_QuerySigningsResponse_signing_results protoreflect.FieldDescriptor
-	fd_QuerySigningsResponse_pagination      protoreflect.FieldDescriptor
+	md_QueryBatchProofResponse                      protoreflect.MessageDescriptor
+	fd_QueryBatchProofResponse_proof                protoreflect.FieldDescriptor
+	fd_QueryBatchProofResponse_batch_signing_result protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tss_v1beta1_query_proto_init()
-	md_QuerySigningsResponse = File_band_tss_v1beta1_query_proto.Messages().ByName("QuerySigningsResponse")
-	fd_QuerySigningsResponse_signing_results = md_QuerySigningsResponse.Fields().ByName("signing_results")
-	fd_QuerySigningsResponse_pagination = md_QuerySigningsResponse.Fields().ByName("pagination")
+	md_QueryBatchProofResponse = File_band_tss_v1beta1_query_proto.Messages().ByName("QueryBatchProofResponse")
+	fd_QueryBatchProofResponse_proof = md_QueryBatchProofResponse.Fields().ByName("proof")
+	fd_QueryBatchProofResponse_batch_signing_result = md_QueryBatchProofResponse.Fields().ByName("batch_signing_result")
 }
 
-var _ protoreflect.Message = (*fastReflection_QuerySigningsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryBatchProofResponse)(nil)
 
-type fastReflection_QuerySigningsResponse QuerySigningsResponse
+type fastReflection_QueryBatchProofResponse QueryBatchProofResponse
 
-func (x *QuerySigningsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QuerySigningsResponse)(x)
+func (x *QueryBatchProofResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBatchProofResponse)(x)
 }
 
-func (x *QuerySigningsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryBatchProofResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tss_v1beta1_query_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8804,43 +8770,43 @@ func (x *QuerySigningsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QuerySigningsResponse_messageType fastReflection_QuerySigningsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QuerySigningsResponse_messageType{}
+var _fastReflection_QueryBatchProofResponse_messageType fastReflection_QueryBatchProofResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBatchProofResponse_messageType{}
 
-type fastReflection_QuerySigningsResponse_messageType struct{}
+type fastReflection_QueryBatchProofResponse_messageType struct{}
 
-func (x fastReflection_QuerySigningsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QuerySigningsResponse)(nil)
+func (x fastReflection_QueryBatchProofResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBatchProofResponse)(nil)
 }
-func (x fastReflection_QuerySigningsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QuerySigningsResponse)
+func (x fastReflection_QueryBatchProofResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBatchProofResponse)
 }
-func (x fastReflection_QuerySigningsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuerySigningsResponse
+func (x fastReflection_QueryBatchProofResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBatchProofResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QuerySigningsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuerySigningsResponse
+func (x *fastReflection_QueryBatchProofResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBatchProofResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QuerySigningsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QuerySigningsResponse_messageType
+func (x *fastReflection_QueryBatchProofResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBatchProofResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QuerySigningsResponse) New() protoreflect.Message {
-	return new(fastReflection_QuerySigningsResponse)
+func (x *fastReflection_QueryBatchProofResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryBatchProofResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QuerySigningsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QuerySigningsResponse)(x)
+func (x *fastReflection_QueryBatchProofResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryBatchProofResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8848,16 +8814,16 @@ func (x *fastReflection_QuerySigningsResponse) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QuerySigningsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.SigningResults) != 0 {
-		value := protoreflect.ValueOfList(&_QuerySigningsResponse_1_list{list: &x.SigningResults})
-		if !f(fd_QuerySigningsResponse_signing_results, value) {
+func (x *fastReflection_QueryBatchProofResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Proof != nil {
+		value := protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+		if !f(fd_QueryBatchProofResponse_proof, value) {
 			return
 		}
 	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QuerySigningsResponse_pagination, value) {
+	if x.BatchSigningResult != nil {
+		value := protoreflect.ValueOfMessage(x.BatchSigningResult.ProtoReflect())
+		if !f(fd_QueryBatchProofResponse_batch_signing_result, value) {
 			return
 		}
 	}
@@ -8874,17 +8840,17 @@ func (x *fastReflection_QuerySigningsResponse) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QuerySigningsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryBatchProofResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tss.v1beta1.QuerySigningsResponse.signing_results":
-		return len(x.SigningResults) != 0
-	case "band.tss.v1beta1.QuerySigningsResponse.pagination":
-		return x.Pagination != nil
+	case "band.tss.v1beta1.QueryBatchProofResponse.proof":
+		return x.Proof != nil
+	case "band.tss.v1beta1.QueryBatchProofResponse.batch_signing_result":
+		return x.BatchSigningResult != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QuerySigningsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QueryBatchProofResponse"))
 		}
-		panic(fmt.Errorf("message band.tss.v1beta1.QuerySigningsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tss.v1beta1.QueryBatchProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8894,17 +8860,17 @@ func (x *fastReflection_QuerySigningsResponse) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySigningsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryBatchProofResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tss.v1beta1.QuerySigningsResponse.signing_results":
-		x.SigningResults = nil
-	case "band.tss.v1beta1.QuerySigningsResponse.pagination":
-		x.Pagination = nil
+	case "band.tss.v1beta1.QueryBatchProofResponse.proof":
+		x.Proof = nil
+	case "band.tss.v1beta1.QueryBatchProofResponse.batch_signing_result":
+		x.BatchSigningResult = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QuerySigningsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QueryBatchProofResponse"))
 		}
-		panic(fmt.Errorf("message band.tss.v1beta1.QuerySigningsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tss.v1beta1.QueryBatchProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8914,13 +8880,1013 @@ func (x *fastReflection_QuerySigningsResponse) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QuerySigningsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBatchProofResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tss.v1beta1.QuerySigningsResponse.signing_results":
-		if len(x.SigningResults) == 0 {
-			return protoreflect.ValueOfList(&_QuerySigningsResponse_1_list{})
-		}
-		listValue := &_QuerySigningsResponse_1_list{list: &x.SigningResults}
+	case "band.tss.v1beta1.QueryBatchProofResponse.proof":
+		value := x.Proof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.tss.v1beta1.QueryBatchProofResponse.batch_signing_result":
+		value := x.BatchSigningResult
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QueryBatchProofResponse"))
+		}
+		panic(fmt.Errorf("message band.tss.v1beta1.QueryBatchProofResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBatchProofResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tss.v1beta1.QueryBatchProofResponse.proof":
+		x.Proof = value.Message().Interface().(*BatchItemProof)
+	case "band.tss.v1beta1.QueryBatchProofResponse.batch_signing_result":
+		x.BatchSigningResult = value.Message().Interface().(*SigningResult)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QueryBatchProofResponse"))
+		}
+		panic(fmt.Errorf("message band.tss.v1beta1.QueryBatchProofResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBatchProofResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tss.v1beta1.QueryBatchProofResponse.proof":
+		if x.Proof == nil {
+			x.Proof = new(BatchItemProof)
+		}
+		return protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+	case "band.tss.v1beta1.QueryBatchProofResponse.batch_signing_result":
+		if x.BatchSigningResult == nil {
+			x.BatchSigningResult = new(SigningResult)
+		}
+		return protoreflect.ValueOfMessage(x.BatchSigningResult.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QueryBatchProofResponse"))
+		}
+		panic(fmt.Errorf("message band.tss.v1beta1.QueryBatchProofResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryBatchProofResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tss.v1beta1.QueryBatchProofResponse.proof":
+		m := new(BatchItemProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.tss.v1beta1.QueryBatchProofResponse.batch_signing_result":
+		m := new(SigningResult)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QueryBatchProofResponse"))
+		}
+		panic(fmt.Errorf("message band.tss.v1beta1.QueryBatchProofResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryBatchProofResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tss.v1beta1.QueryBatchProofResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryBatchProofResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBatchProofResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryBatchProofResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryBatchProofResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryBatchProofResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Proof != nil {
+			l = options.Size(x.Proof)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BatchSigningResult != nil {
+			l = options.Size(x.BatchSigningResult)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBatchProofResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BatchSigningResult != nil {
+			encoded, err := options.Marshal(x.BatchSigningResult)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Proof != nil {
+			encoded, err := options.Marshal(x.Proof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBatchProofResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBatchProofResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBatchProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Proof == nil {
+					x.Proof = &BatchItemProof{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proof); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchSigningResult", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BatchSigningResult == nil {
+					x.BatchSigningResult = &SigningResult{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BatchSigningResult); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySigningsRequest            protoreflect.MessageDescriptor
+	fd_QuerySigningsRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tss_v1beta1_query_proto_init()
+	md_QuerySigningsRequest = File_band_tss_v1beta1_query_proto.Messages().ByName("QuerySigningsRequest")
+	fd_QuerySigningsRequest_pagination = md_QuerySigningsRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySigningsRequest)(nil)
+
+type fastReflection_QuerySigningsRequest QuerySigningsRequest
+
+func (x *QuerySigningsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySigningsRequest)(x)
+}
+
+func (x *QuerySigningsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tss_v1beta1_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySigningsRequest_messageType fastReflection_QuerySigningsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySigningsRequest_messageType{}
+
+type fastReflection_QuerySigningsRequest_messageType struct{}
+
+func (x fastReflection_QuerySigningsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySigningsRequest)(nil)
+}
+func (x fastReflection_QuerySigningsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySigningsRequest)
+}
+func (x fastReflection_QuerySigningsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySigningsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySigningsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySigningsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySigningsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySigningsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySigningsRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySigningsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySigningsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySigningsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySigningsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QuerySigningsRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySigningsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tss.v1beta1.QuerySigningsRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QuerySigningsRequest"))
+		}
+		panic(fmt.Errorf("message band.tss.v1beta1.QuerySigningsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySigningsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tss.v1beta1.QuerySigningsRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QuerySigningsRequest"))
+		}
+		panic(fmt.Errorf("message band.tss.v1beta1.QuerySigningsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySigningsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tss.v1beta1.QuerySigningsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QuerySigningsRequest"))
+		}
+		panic(fmt.Errorf("message band.tss.v1beta1.QuerySigningsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySigningsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tss.v1beta1.QuerySigningsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QuerySigningsRequest"))
+		}
+		panic(fmt.Errorf("message band.tss.v1beta1.QuerySigningsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySigningsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tss.v1beta1.QuerySigningsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QuerySigningsRequest"))
+		}
+		panic(fmt.Errorf("message band.tss.v1beta1.QuerySigningsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySigningsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tss.v1beta1.QuerySigningsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QuerySigningsRequest"))
+		}
+		panic(fmt.Errorf("message band.tss.v1beta1.QuerySigningsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySigningsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tss.v1beta1.QuerySigningsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySigningsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySigningsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySigningsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySigningsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySigningsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySigningsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySigningsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySigningsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySigningsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QuerySigningsResponse_1_list)(nil)
+
+type _QuerySigningsResponse_1_list struct {
+	list *[]*SigningResult
+}
+
+func (x *_QuerySigningsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySigningsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySigningsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SigningResult)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySigningsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SigningResult)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySigningsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(SigningResult)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySigningsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySigningsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(SigningResult)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySigningsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QuerySigningsResponse                 protoreflect.MessageDescriptor
+	fd_QuerySigningsResponse_signing_results protoreflect.FieldDescriptor
+	fd_QuerySigningsResponse_pagination      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tss_v1beta1_query_proto_init()
+	md_QuerySigningsResponse = File_band_tss_v1beta1_query_proto.Messages().ByName("QuerySigningsResponse")
+	fd_QuerySigningsResponse_signing_results = md_QuerySigningsResponse.Fields().ByName("signing_results")
+	fd_QuerySigningsResponse_pagination = md_QuerySigningsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySigningsResponse)(nil)
+
+type fastReflection_QuerySigningsResponse QuerySigningsResponse
+
+func (x *QuerySigningsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySigningsResponse)(x)
+}
+
+func (x *QuerySigningsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tss_v1beta1_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySigningsResponse_messageType fastReflection_QuerySigningsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySigningsResponse_messageType{}
+
+type fastReflection_QuerySigningsResponse_messageType struct{}
+
+func (x fastReflection_QuerySigningsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySigningsResponse)(nil)
+}
+func (x fastReflection_QuerySigningsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySigningsResponse)
+}
+func (x fastReflection_QuerySigningsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySigningsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySigningsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySigningsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySigningsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySigningsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySigningsResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySigningsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySigningsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySigningsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySigningsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.SigningResults) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySigningsResponse_1_list{list: &x.SigningResults})
+		if !f(fd_QuerySigningsResponse_signing_results, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QuerySigningsResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySigningsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tss.v1beta1.QuerySigningsResponse.signing_results":
+		return len(x.SigningResults) != 0
+	case "band.tss.v1beta1.QuerySigningsResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QuerySigningsResponse"))
+		}
+		panic(fmt.Errorf("message band.tss.v1beta1.QuerySigningsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySigningsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tss.v1beta1.QuerySigningsResponse.signing_results":
+		x.SigningResults = nil
+	case "band.tss.v1beta1.QuerySigningsResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tss.v1beta1.QuerySigningsResponse"))
+		}
+		panic(fmt.Errorf("message band.tss.v1beta1.QuerySigningsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySigningsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tss.v1beta1.QuerySigningsResponse.signing_results":
+		if len(x.SigningResults) == 0 {
+			return protoreflect.ValueOfList(&_QuerySigningsResponse_1_list{})
+		}
+		listValue := &_QuerySigningsResponse_1_list{list: &x.SigningResults}
 		return protoreflect.ValueOfList(listValue)
 	case "band.tss.v1beta1.QuerySigningsResponse.pagination":
 		value := x.Pagination
@@ -9311,7 +10277,7 @@ func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tss_v1beta1_query_proto_msgTypes[20]
+	mi := &file_band_tss_v1beta1_query_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9669,7 +10635,7 @@ func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tss_v1beta1_query_proto_msgTypes[21]
+	mi := &file_band_tss_v1beta1_query_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10494,6 +11460,8 @@ type QueryDERequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// pagination defines pagination settings for the request.
 	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// curve is the curve of the DEs to query.
+	Curve Curve `protobuf:"varint,3,opt,name=curve,proto3,enum=band.tss.v1beta1.Curve" json:"curve,omitempty"`
 }
 
 func (x *QueryDERequest) Reset() {
@@ -10530,6 +11498,13 @@ func (x *QueryDERequest) GetPagination() *v1beta1.PageRequest {
 	return nil
 }
 
+func (x *QueryDERequest) GetCurve() Curve {
+	if x != nil {
+		return x.Curve
+	}
+	return Curve_CURVE_SECP256K1
+}
+
 // QueryDEResponse is the response type for the Query/DE RPC method.
 type QueryDEResponse struct {
 	state         protoimpl.MessageState
@@ -10798,6 +11773,89 @@ func (x *QuerySigningResponse) GetSigningResult() *SigningResult {
 	return nil
 }
 
+// QueryBatchProofRequest is the request type for the Query/BatchProof RPC method.
+type QueryBatchProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signing_id is the ID of the signing included in a batch.
+	SigningId uint64 `protobuf:"varint,1,opt,name=signing_id,json=signingId,proto3" json:"signing_id,omitempty"`
+}
+
+func (x *QueryBatchProofRequest) Reset() {
+	*x = QueryBatchProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tss_v1beta1_query_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBatchProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBatchProofRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryBatchProofRequest.ProtoReflect.Descriptor instead.
+func (*QueryBatchProofRequest) Descriptor() ([]byte, []int) {
+	return file_band_tss_v1beta1_query_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *QueryBatchProofRequest) GetSigningId() uint64 {
+	if x != nil {
+		return x.SigningId
+	}
+	return 0
+}
+
+// QueryBatchProofResponse is the response type for the Query/BatchProof RPC method.
+type QueryBatchProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proof is the merkle inclusion proof of the signing's message in the batch.
+	Proof *BatchItemProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	// batch_signing_result is the result of the signing over the merkle root.
+	BatchSigningResult *SigningResult `protobuf:"bytes,2,opt,name=batch_signing_result,json=batchSigningResult,proto3" json:"batch_signing_result,omitempty"`
+}
+
+func (x *QueryBatchProofResponse) Reset() {
+	*x = QueryBatchProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tss_v1beta1_query_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBatchProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBatchProofResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryBatchProofResponse.ProtoReflect.Descriptor instead.
+func (*QueryBatchProofResponse) Descriptor() ([]byte, []int) {
+	return file_band_tss_v1beta1_query_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QueryBatchProofResponse) GetProof() *BatchItemProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *QueryBatchProofResponse) GetBatchSigningResult() *SigningResult {
+	if x != nil {
+		return x.BatchSigningResult
+	}
+	return nil
+}
+
 // QuerySigningsRequest is the request type for the Query/Signings RPC method.
 type QuerySigningsRequest struct {
 	state         protoimpl.MessageState
@@ -10811,7 +11869,7 @@ type QuerySigningsRequest struct {
 func (x *QuerySigningsRequest) Reset() {
 	*x = QuerySigningsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tss_v1beta1_query_proto_msgTypes[18]
+		mi := &file_band_tss_v1beta1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10825,7 +11883,7 @@ func (*QuerySigningsRequest) ProtoMessage() {}
 
 // Deprecated: Use QuerySigningsRequest.ProtoReflect.Descriptor instead.
 func (*QuerySigningsRequest) Descriptor() ([]byte, []int) {
-	return file_band_tss_v1beta1_query_proto_rawDescGZIP(), []int{18}
+	return file_band_tss_v1beta1_query_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *QuerySigningsRequest) GetPagination() *v1beta1.PageRequest {
@@ -10850,7 +11908,7 @@ type QuerySigningsResponse struct {
 func (x *QuerySigningsResponse) Reset() {
 	*x = QuerySigningsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tss_v1beta1_query_proto_msgTypes[19]
+		mi := &file_band_tss_v1beta1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10864,7 +11922,7 @@ func (*QuerySigningsResponse) ProtoMessage() {}
 
 // Deprecated: Use QuerySigningsResponse.ProtoReflect.Descriptor instead.
 func (*QuerySigningsResponse) Descriptor() ([]byte, []int) {
-	return file_band_tss_v1beta1_query_proto_rawDescGZIP(), []int{19}
+	return file_band_tss_v1beta1_query_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *QuerySigningsResponse) GetSigningResults() []*SigningResult {
@@ -10891,7 +11949,7 @@ type QueryParamsRequest struct {
 func (x *QueryParamsRequest) Reset() {
 	*x = QueryParamsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tss_v1beta1_query_proto_msgTypes[20]
+		mi := &file_band_tss_v1beta1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10905,7 +11963,7 @@ func (*QueryParamsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_band_tss_v1beta1_query_proto_rawDescGZIP(), []int{20}
+	return file_band_tss_v1beta1_query_proto_rawDescGZIP(), []int{22}
 }
 
 // QueryParamsResponse is the response type for the Query/Params RPC method.
@@ -10921,7 +11979,7 @@ type QueryParamsResponse struct {
 func (x *QueryParamsResponse) Reset() {
 	*x = QueryParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tss_v1beta1_query_proto_msgTypes[21]
+		mi := &file_band_tss_v1beta1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10935,7 +11993,7 @@ func (*QueryParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_band_tss_v1beta1_query_proto_rawDescGZIP(), []int{21}
+	return file_band_tss_v1beta1_query_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *QueryParamsResponse) GetParams() *Params {
@@ -11008,15 +12066,18 @@ var file_band_tss_v1beta1_query_proto_rawDesc = []byte{
 	0x6e, 0x74, 0x65, 0x65, 0x22, 0x37, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x47,
 	0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d,
 	0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x22, 0x72, 0x0a,
-	0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x45, 0x52, 0x65, 0x73,
+	0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x22, 0xa1, 0x01,
+	0x0a, 0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x05, 0x63, 0x75, 0x72, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x76, 0x65, 0x52, 0x05, 0x63, 0x75, 0x72, 0x76,
+	0x65, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x45, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x03, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31,
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x45, 0x42, 0x0b, 0xc8, 0xde, 0x1f, 0x00, 0xe2, 0xde,
@@ -11054,135 +12115,159 @@ var file_band_tss_v1beta1_query_proto_rawDesc = []byte{
 	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31,
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
 	0x75, 0x6c, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x5e, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xaa, 0x01, 0x0a, 0x15, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x48, 0x0a, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53,
-	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0e, 0x73, 0x69,
-	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x37, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49,
+	0x64, 0x22, 0xb0, 0x01, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a,
+	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x57, 0x0a, 0x14, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x12, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0x5e, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
 	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4d, 0x0a, 0x13, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x32, 0xb6, 0x0b, 0x0a, 0x05, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x12, 0x72, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x24,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xaa, 0x01, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48,
+	0x0a, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74,
+	0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4d, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
 	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x32, 0xd2, 0x0c, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x72, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12,
+	0x13, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x72, 0x0a, 0x06, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x24,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4,
 	0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x72, 0x0a, 0x06, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x12, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x7a, 0x0a, 0x05,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x61, 0x6e,
-	0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x88, 0x01, 0x0a, 0x07, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x61,
+	0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x7a, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73,
+	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x69, 0x64, 0x7d, 0x12, 0x88, 0x01, 0x0a, 0x07, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74,
+	0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
+	0x93, 0x01, 0x0a, 0x09, 0x49, 0x73, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x12, 0x27, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73,
+	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49,
+	0x73, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x69, 0x73, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x65, 0x2f, 0x7b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x7d, 0x2f, 0x7b, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x65, 0x7d, 0x12, 0x71, 0x0a, 0x02, 0x44, 0x45, 0x12, 0x20, 0x2e, 0x62, 0x61,
 	0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x74, 0x73,
-	0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
-	0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x73, 0x12, 0x93, 0x01, 0x0a, 0x09, 0x49, 0x73, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65,
-	0x65, 0x12, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x47, 0x72, 0x61, 0x6e,
-	0x74, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x61, 0x6e,
+	0x75, 0x65, 0x72, 0x79, 0x44, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x45, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x7b,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x99, 0x01, 0x0a, 0x0d, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2b, 0x2e, 0x62, 0x61, 0x6e,
 	0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x49, 0x73, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x74,
-	0x73, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x69, 0x73, 0x5f, 0x67, 0x72,
-	0x61, 0x6e, 0x74, 0x65, 0x65, 0x2f, 0x7b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x7d, 0x2f,
-	0x7b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x7d, 0x12, 0x71, 0x0a, 0x02, 0x44, 0x45, 0x12,
-	0x20, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x45, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x74,
-	0x73, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x99, 0x01, 0x0a,
-	0x0d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2b,
-	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x27, 0x12, 0x25, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0xa1, 0x01, 0x0a, 0x0f, 0x50, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2d, 0x2e, 0x62,
-	0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e,
-	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x62, 0x61,
+	0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74,
+	0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f,
+	0x74, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x7d, 0x12, 0xa1, 0x01, 0x0a, 0x0f, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74,
+	0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12,
+	0x27, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x84, 0x01, 0x0a, 0x07, 0x53, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x61,
 	0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x69,
-	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e,
-	0x67, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x84, 0x01, 0x0a,
-	0x07, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x74, 0x73,
+	0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x2f, 0x7b, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0x99, 0x01, 0x0a, 0x0a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x28,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
 	0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12,
-	0x22, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x69,
-	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
-	0x69, 0x64, 0x7d, 0x12, 0x7a, 0x0a, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12,
-	0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x79, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x74, 0x73,
+	0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x2f, 0x7b, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x7a, 0x0a, 0x08, 0x53,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74,
+	0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
 	0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
+	0x12, 0x15, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x72, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74,
 	0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12,
-	0x72, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13,
-	0x2f, 0x74, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0xc6, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0a, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x3b, 0x74, 0x73, 0x73, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42,
-	0x54, 0x58, 0xaa, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54, 0x73, 0x73, 0x2e, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x73, 0x73,
-	0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1c, 0x42, 0x61, 0x6e, 0x64, 0x5c,
-	0x54, 0x73, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a,
-	0x54, 0x73, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x74, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc6, 0x01, 0x0a, 0x14,
+	0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x73, 0x73, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
+	0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x73,
+	0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x73, 0x73, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x10, 0x42, 0x61, 0x6e,
+	0x64, 0x2e, 0x54, 0x73, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x10,
+	0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x73, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0xe2, 0x02, 0x1c, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x73, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x12, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x73, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -11197,7 +12282,7 @@ func file_band_tss_v1beta1_query_proto_rawDescGZIP() []byte {
 	return file_band_tss_v1beta1_query_proto_rawDescData
 }
 
-var file_band_tss_v1beta1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_band_tss_v1beta1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_band_tss_v1beta1_query_proto_goTypes = []interface{}{
 	(*QueryCountsRequest)(nil),           // 0: band.tss.v1beta1.QueryCountsRequest
 	(*QueryCountsResponse)(nil),          // 1: band.tss.v1beta1.QueryCountsResponse
@@ -11217,59 +12302,68 @@ var file_band_tss_v1beta1_query_proto_goTypes = []interface{}{
 	(*QueryPendingSigningsResponse)(nil), // 15: band.tss.v1beta1.QueryPendingSigningsResponse
 	(*QuerySigningRequest)(nil),          // 16: band.tss.v1beta1.QuerySigningRequest
 	(*QuerySigningResponse)(nil),         // 17: band.tss.v1beta1.QuerySigningResponse
-	(*QuerySigningsRequest)(nil),         // 18: band.tss.v1beta1.QuerySigningsRequest
-	(*QuerySigningsResponse)(nil),        // 19: band.tss.v1beta1.QuerySigningsResponse
-	(*QueryParamsRequest)(nil),           // 20: band.tss.v1beta1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),          // 21: band.tss.v1beta1.QueryParamsResponse
-	(*GroupResult)(nil),                  // 22: band.tss.v1beta1.GroupResult
-	(*v1beta1.PageRequest)(nil),          // 23: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta1.PageResponse)(nil),         // 24: cosmos.base.query.v1beta1.PageResponse
-	(*Member)(nil),                       // 25: band.tss.v1beta1.Member
-	(*DE)(nil),                           // 26: band.tss.v1beta1.DE
-	(*SigningResult)(nil),                // 27: band.tss.v1beta1.SigningResult
-	(*Params)(nil),                       // 28: band.tss.v1beta1.Params
+	(*QueryBatchProofRequest)(nil),       // 18: band.tss.v1beta1.QueryBatchProofRequest
+	(*QueryBatchProofResponse)(nil),      // 19: band.tss.v1beta1.QueryBatchProofResponse
+	(*QuerySigningsRequest)(nil),         // 20: band.tss.v1beta1.QuerySigningsRequest
+	(*QuerySigningsResponse)(nil),        // 21: band.tss.v1beta1.QuerySigningsResponse
+	(*QueryParamsRequest)(nil),           // 22: band.tss.v1beta1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),          // 23: band.tss.v1beta1.QueryParamsResponse
+	(*GroupResult)(nil),                  // 24: band.tss.v1beta1.GroupResult
+	(*v1beta1.PageRequest)(nil),          // 25: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),         // 26: cosmos.base.query.v1beta1.PageResponse
+	(*Member)(nil),                       // 27: band.tss.v1beta1.Member
+	(Curve)(0),                           // 28: band.tss.v1beta1.Curve
+	(*DE)(nil),                           // 29: band.tss.v1beta1.DE
+	(*SigningResult)(nil),                // 30: band.tss.v1beta1.SigningResult
+	(*BatchItemProof)(nil),               // 31: band.tss.v1beta1.BatchItemProof
+	(*Params)(nil),                       // 32: band.tss.v1beta1.Params
 }
 var file_band_tss_v1beta1_query_proto_depIdxs = []int32{
-	22, // 0: band.tss.v1beta1.QueryGroupResponse.group_result:type_name -> band.tss.v1beta1.GroupResult
-	23, // 1: band.tss.v1beta1.QueryGroupsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	22, // 2: band.tss.v1beta1.QueryGroupsResponse.groups:type_name -> band.tss.v1beta1.GroupResult
-	24, // 3: band.tss.v1beta1.QueryGroupsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	25, // 4: band.tss.v1beta1.QueryMembersResponse.members:type_name -> band.tss.v1beta1.Member
-	23, // 5: band.tss.v1beta1.QueryDERequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	26, // 6: band.tss.v1beta1.QueryDEResponse.des:type_name -> band.tss.v1beta1.DE
-	24, // 7: band.tss.v1beta1.QueryDEResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	27, // 8: band.tss.v1beta1.QuerySigningResponse.signing_result:type_name -> band.tss.v1beta1.SigningResult
-	23, // 9: band.tss.v1beta1.QuerySigningsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	27, // 10: band.tss.v1beta1.QuerySigningsResponse.signing_results:type_name -> band.tss.v1beta1.SigningResult
-	24, // 11: band.tss.v1beta1.QuerySigningsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	28, // 12: band.tss.v1beta1.QueryParamsResponse.params:type_name -> band.tss.v1beta1.Params
-	0,  // 13: band.tss.v1beta1.Query.Counts:input_type -> band.tss.v1beta1.QueryCountsRequest
-	4,  // 14: band.tss.v1beta1.Query.Groups:input_type -> band.tss.v1beta1.QueryGroupsRequest
-	2,  // 15: band.tss.v1beta1.Query.Group:input_type -> band.tss.v1beta1.QueryGroupRequest
-	6,  // 16: band.tss.v1beta1.Query.Members:input_type -> band.tss.v1beta1.QueryMembersRequest
-	8,  // 17: band.tss.v1beta1.Query.IsGrantee:input_type -> band.tss.v1beta1.QueryIsGranteeRequest
-	10, // 18: band.tss.v1beta1.Query.DE:input_type -> band.tss.v1beta1.QueryDERequest
-	12, // 19: band.tss.v1beta1.Query.PendingGroups:input_type -> band.tss.v1beta1.QueryPendingGroupsRequest
-	14, // 20: band.tss.v1beta1.Query.PendingSignings:input_type -> band.tss.v1beta1.QueryPendingSigningsRequest
-	16, // 21: band.tss.v1beta1.Query.Signing:input_type -> band.tss.v1beta1.QuerySigningRequest
-	18, // 22: band.tss.v1beta1.Query.Signings:input_type -> band.tss.v1beta1.QuerySigningsRequest
-	20, // 23: band.tss.v1beta1.Query.Params:input_type -> band.tss.v1beta1.QueryParamsRequest
-	1,  // 24: band.tss.v1beta1.Query.Counts:output_type -> band.tss.v1beta1.QueryCountsResponse
-	5,  // 25: band.tss.v1beta1.Query.Groups:output_type -> band.tss.v1beta1.QueryGroupsResponse
-	3,  // 26: band.tss.v1beta1.Query.Group:output_type -> band.tss.v1beta1.QueryGroupResponse
-	7,  // 27: band.tss.v1beta1.Query.Members:output_type -> band.tss.v1beta1.QueryMembersResponse
-	9,  // 28: band.tss.v1beta1.Query.IsGrantee:output_type -> band.tss.v1beta1.QueryIsGranteeResponse
-	11, // 29: band.tss.v1beta1.Query.DE:output_type -> band.tss.v1beta1.QueryDEResponse
-	13, // 30: band.tss.v1beta1.Query.PendingGroups:output_type -> band.tss.v1beta1.QueryPendingGroupsResponse
-	15, // 31: band.tss.v1beta1.Query.PendingSignings:output_type -> band.tss.v1beta1.QueryPendingSigningsResponse
-	17, // 32: band.tss.v1beta1.Query.Signing:output_type -> band.tss.v1beta1.QuerySigningResponse
-	19, // 33: band.tss.v1beta1.Query.Signings:output_type -> band.tss.v1beta1.QuerySigningsResponse
-	21, // 34: band.tss.v1beta1.Query.Params:output_type -> band.tss.v1beta1.QueryParamsResponse
-	24, // [24:35] is the sub-list for method output_type
-	13, // [13:24] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	24, // 0: band.tss.v1beta1.QueryGroupResponse.group_result:type_name -> band.tss.v1beta1.GroupResult
+	25, // 1: band.tss.v1beta1.QueryGroupsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	24, // 2: band.tss.v1beta1.QueryGroupsResponse.groups:type_name -> band.tss.v1beta1.GroupResult
+	26, // 3: band.tss.v1beta1.QueryGroupsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	27, // 4: band.tss.v1beta1.QueryMembersResponse.members:type_name -> band.tss.v1beta1.Member
+	25, // 5: band.tss.v1beta1.QueryDERequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	28, // 6: band.tss.v1beta1.QueryDERequest.curve:type_name -> band.tss.v1beta1.Curve
+	29, // 7: band.tss.v1beta1.QueryDEResponse.des:type_name -> band.tss.v1beta1.DE
+	26, // 8: band.tss.v1beta1.QueryDEResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	30, // 9: band.tss.v1beta1.QuerySigningResponse.signing_result:type_name -> band.tss.v1beta1.SigningResult
+	31, // 10: band.tss.v1beta1.QueryBatchProofResponse.proof:type_name -> band.tss.v1beta1.BatchItemProof
+	30, // 11: band.tss.v1beta1.QueryBatchProofResponse.batch_signing_result:type_name -> band.tss.v1beta1.SigningResult
+	25, // 12: band.tss.v1beta1.QuerySigningsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	30, // 13: band.tss.v1beta1.QuerySigningsResponse.signing_results:type_name -> band.tss.v1beta1.SigningResult
+	26, // 14: band.tss.v1beta1.QuerySigningsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	32, // 15: band.tss.v1beta1.QueryParamsResponse.params:type_name -> band.tss.v1beta1.Params
+	0,  // 16: band.tss.v1beta1.Query.Counts:input_type -> band.tss.v1beta1.QueryCountsRequest
+	4,  // 17: band.tss.v1beta1.Query.Groups:input_type -> band.tss.v1beta1.QueryGroupsRequest
+	2,  // 18: band.tss.v1beta1.Query.Group:input_type -> band.tss.v1beta1.QueryGroupRequest
+	6,  // 19: band.tss.v1beta1.Query.Members:input_type -> band.tss.v1beta1.QueryMembersRequest
+	8,  // 20: band.tss.v1beta1.Query.IsGrantee:input_type -> band.tss.v1beta1.QueryIsGranteeRequest
+	10, // 21: band.tss.v1beta1.Query.DE:input_type -> band.tss.v1beta1.QueryDERequest
+	12, // 22: band.tss.v1beta1.Query.PendingGroups:input_type -> band.tss.v1beta1.QueryPendingGroupsRequest
+	14, // 23: band.tss.v1beta1.Query.PendingSignings:input_type -> band.tss.v1beta1.QueryPendingSigningsRequest
+	16, // 24: band.tss.v1beta1.Query.Signing:input_type -> band.tss.v1beta1.QuerySigningRequest
+	18, // 25: band.tss.v1beta1.Query.BatchProof:input_type -> band.tss.v1beta1.QueryBatchProofRequest
+	20, // 26: band.tss.v1beta1.Query.Signings:input_type -> band.tss.v1beta1.QuerySigningsRequest
+	22, // 27: band.tss.v1beta1.Query.Params:input_type -> band.tss.v1beta1.QueryParamsRequest
+	1,  // 28: band.tss.v1beta1.Query.Counts:output_type -> band.tss.v1beta1.QueryCountsResponse
+	5,  // 29: band.tss.v1beta1.Query.Groups:output_type -> band.tss.v1beta1.QueryGroupsResponse
+	3,  // 30: band.tss.v1beta1.Query.Group:output_type -> band.tss.v1beta1.QueryGroupResponse
+	7,  // 31: band.tss.v1beta1.Query.Members:output_type -> band.tss.v1beta1.QueryMembersResponse
+	9,  // 32: band.tss.v1beta1.Query.IsGrantee:output_type -> band.tss.v1beta1.QueryIsGranteeResponse
+	11, // 33: band.tss.v1beta1.Query.DE:output_type -> band.tss.v1beta1.QueryDEResponse
+	13, // 34: band.tss.v1beta1.Query.PendingGroups:output_type -> band.tss.v1beta1.QueryPendingGroupsResponse
+	15, // 35: band.tss.v1beta1.Query.PendingSignings:output_type -> band.tss.v1beta1.QueryPendingSigningsResponse
+	17, // 36: band.tss.v1beta1.Query.Signing:output_type -> band.tss.v1beta1.QuerySigningResponse
+	19, // 37: band.tss.v1beta1.Query.BatchProof:output_type -> band.tss.v1beta1.QueryBatchProofResponse
+	21, // 38: band.tss.v1beta1.Query.Signings:output_type -> band.tss.v1beta1.QuerySigningsResponse
+	23, // 39: band.tss.v1beta1.Query.Params:output_type -> band.tss.v1beta1.QueryParamsResponse
+	28, // [28:40] is the sub-list for method output_type
+	16, // [16:28] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_band_tss_v1beta1_query_proto_init() }
@@ -11497,7 +12591,7 @@ func file_band_tss_v1beta1_query_proto_init() {
 			}
 		}
 		file_band_tss_v1beta1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuerySigningsRequest); i {
+			switch v := v.(*QueryBatchProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11509,7 +12603,7 @@ func file_band_tss_v1beta1_query_proto_init() {
 			}
 		}
 		file_band_tss_v1beta1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuerySigningsResponse); i {
+			switch v := v.(*QueryBatchProofResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11521,7 +12615,7 @@ func file_band_tss_v1beta1_query_proto_init() {
 			}
 		}
 		file_band_tss_v1beta1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsRequest); i {
+			switch v := v.(*QuerySigningsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11533,6 +12627,30 @@ func file_band_tss_v1beta1_query_proto_init() {
 			}
 		}
 		file_band_tss_v1beta1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySigningsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tss_v1beta1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tss_v1beta1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryParamsResponse); i {
 			case 0:
 				return &v.state
@@ -11551,7 +12669,7 @@ func file_band_tss_v1beta1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_tss_v1beta1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
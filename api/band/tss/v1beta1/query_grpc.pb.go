@@ -28,6 +28,7 @@ const (
 	Query_PendingGroups_FullMethodName   = "/band.tss.v1beta1.Query/PendingGroups"
 	Query_PendingSignings_FullMethodName = "/band.tss.v1beta1.Query/PendingSignings"
 	Query_Signing_FullMethodName         = "/band.tss.v1beta1.Query/Signing"
+	Query_BatchProof_FullMethodName      = "/band.tss.v1beta1.Query/BatchProof"
 	Query_Signings_FullMethodName        = "/band.tss.v1beta1.Query/Signings"
 	Query_Params_FullMethodName          = "/band.tss.v1beta1.Query/Params"
 )
@@ -54,6 +55,8 @@ type QueryClient interface {
 	PendingSignings(ctx context.Context, in *QueryPendingSigningsRequest, opts ...grpc.CallOption) (*QueryPendingSigningsResponse, error)
 	// Signing queries signing details from the given id.
 	Signing(ctx context.Context, in *QuerySigningRequest, opts ...grpc.CallOption) (*QuerySigningResponse, error)
+	// BatchProof queries the merkle inclusion proof of a batched signing and the signature of its batch.
+	BatchProof(ctx context.Context, in *QueryBatchProofRequest, opts ...grpc.CallOption) (*QueryBatchProofResponse, error)
 	// Signings queries signings details.
 	Signings(ctx context.Context, in *QuerySigningsRequest, opts ...grpc.CallOption) (*QuerySigningsResponse, error)
 	// Params returns all parameters of the module.
@@ -149,6 +152,15 @@ func (c *queryClient) Signing(ctx context.Context, in *QuerySigningRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) BatchProof(ctx context.Context, in *QueryBatchProofRequest, opts ...grpc.CallOption) (*QueryBatchProofResponse, error) {
+	out := new(QueryBatchProofResponse)
+	err := c.cc.Invoke(ctx, Query_BatchProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Signings(ctx context.Context, in *QuerySigningsRequest, opts ...grpc.CallOption) (*QuerySigningsResponse, error) {
 	out := new(QuerySigningsResponse)
 	err := c.cc.Invoke(ctx, Query_Signings_FullMethodName, in, out, opts...)
@@ -189,6 +201,8 @@ type QueryServer interface {
 	PendingSignings(context.Context, *QueryPendingSigningsRequest) (*QueryPendingSigningsResponse, error)
 	// Signing queries signing details from the given id.
 	Signing(context.Context, *QuerySigningRequest) (*QuerySigningResponse, error)
+	// BatchProof queries the merkle inclusion proof of a batched signing and the signature of its batch.
+	BatchProof(context.Context, *QueryBatchProofRequest) (*QueryBatchProofResponse, error)
 	// Signings queries signings details.
 	Signings(context.Context, *QuerySigningsRequest) (*QuerySigningsResponse, error)
 	// Params returns all parameters of the module.
@@ -227,6 +241,9 @@ func (UnimplementedQueryServer) PendingSignings(context.Context, *QueryPendingSi
 func (UnimplementedQueryServer) Signing(context.Context, *QuerySigningRequest) (*QuerySigningResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Signing not implemented")
 }
+func (UnimplementedQueryServer) BatchProof(context.Context, *QueryBatchProofRequest) (*QueryBatchProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchProof not implemented")
+}
 func (UnimplementedQueryServer) Signings(context.Context, *QuerySigningsRequest) (*QuerySigningsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Signings not implemented")
 }
@@ -408,6 +425,24 @@ func _Query_Signing_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BatchProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BatchProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchProof(ctx, req.(*QueryBatchProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Signings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySigningsRequest)
 	if err := dec(in); err != nil {
@@ -487,6 +522,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Signing",
 			Handler:    _Query_Signing_Handler,
 		},
+		{
+			MethodName: "BatchProof",
+			Handler:    _Query_BatchProof_Handler,
+		},
 		{
 			MethodName: "Signings",
 			Handler:    _Query_Signings_Handler,
@@ -130,6 +130,10 @@ var (
 	fd_Params_axelar_ibc_channel          protoreflect.FieldDescriptor
 	fd_Params_axelar_gmp_account          protoreflect.FieldDescriptor
 	fd_Params_axelar_fee_recipient        protoreflect.FieldDescriptor
+	fd_Params_local_route_gas_limit       protoreflect.FieldDescriptor
+	fd_Params_max_routes                  protoreflect.FieldDescriptor
+	fd_Params_max_packet_retry_attempts   protoreflect.FieldDescriptor
+	fd_Params_packet_retry_base_blocks    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -147,6 +151,10 @@ func init() {
 	fd_Params_axelar_ibc_channel = md_Params.Fields().ByName("axelar_ibc_channel")
 	fd_Params_axelar_gmp_account = md_Params.Fields().ByName("axelar_gmp_account")
 	fd_Params_axelar_fee_recipient = md_Params.Fields().ByName("axelar_fee_recipient")
+	fd_Params_local_route_gas_limit = md_Params.Fields().ByName("local_route_gas_limit")
+	fd_Params_max_routes = md_Params.Fields().ByName("max_routes")
+	fd_Params_max_packet_retry_attempts = md_Params.Fields().ByName("max_packet_retry_attempts")
+	fd_Params_packet_retry_base_blocks = md_Params.Fields().ByName("packet_retry_base_blocks")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -286,6 +294,30 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.LocalRouteGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LocalRouteGasLimit)
+		if !f(fd_Params_local_route_gas_limit, value) {
+			return
+		}
+	}
+	if x.MaxRoutes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRoutes)
+		if !f(fd_Params_max_routes, value) {
+			return
+		}
+	}
+	if x.MaxPacketRetryAttempts != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPacketRetryAttempts)
+		if !f(fd_Params_max_packet_retry_attempts, value) {
+			return
+		}
+	}
+	if x.PacketRetryBaseBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PacketRetryBaseBlocks)
+		if !f(fd_Params_packet_retry_base_blocks, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -325,6 +357,14 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.AxelarGmpAccount != ""
 	case "band.tunnel.v1beta1.Params.axelar_fee_recipient":
 		return x.AxelarFeeRecipient != ""
+	case "band.tunnel.v1beta1.Params.local_route_gas_limit":
+		return x.LocalRouteGasLimit != uint64(0)
+	case "band.tunnel.v1beta1.Params.max_routes":
+		return x.MaxRoutes != uint64(0)
+	case "band.tunnel.v1beta1.Params.max_packet_retry_attempts":
+		return x.MaxPacketRetryAttempts != uint64(0)
+	case "band.tunnel.v1beta1.Params.packet_retry_base_blocks":
+		return x.PacketRetryBaseBlocks != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Params"))
@@ -365,6 +405,14 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.AxelarGmpAccount = ""
 	case "band.tunnel.v1beta1.Params.axelar_fee_recipient":
 		x.AxelarFeeRecipient = ""
+	case "band.tunnel.v1beta1.Params.local_route_gas_limit":
+		x.LocalRouteGasLimit = uint64(0)
+	case "band.tunnel.v1beta1.Params.max_routes":
+		x.MaxRoutes = uint64(0)
+	case "band.tunnel.v1beta1.Params.max_packet_retry_attempts":
+		x.MaxPacketRetryAttempts = uint64(0)
+	case "band.tunnel.v1beta1.Params.packet_retry_base_blocks":
+		x.PacketRetryBaseBlocks = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Params"))
@@ -423,6 +471,18 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "band.tunnel.v1beta1.Params.axelar_fee_recipient":
 		value := x.AxelarFeeRecipient
 		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.Params.local_route_gas_limit":
+		value := x.LocalRouteGasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.Params.max_routes":
+		value := x.MaxRoutes
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.Params.max_packet_retry_attempts":
+		value := x.MaxPacketRetryAttempts
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.Params.packet_retry_base_blocks":
+		value := x.PacketRetryBaseBlocks
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Params"))
@@ -471,6 +531,14 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.AxelarGmpAccount = value.Interface().(string)
 	case "band.tunnel.v1beta1.Params.axelar_fee_recipient":
 		x.AxelarFeeRecipient = value.Interface().(string)
+	case "band.tunnel.v1beta1.Params.local_route_gas_limit":
+		x.LocalRouteGasLimit = value.Uint()
+	case "band.tunnel.v1beta1.Params.max_routes":
+		x.MaxRoutes = value.Uint()
+	case "band.tunnel.v1beta1.Params.max_packet_retry_attempts":
+		x.MaxPacketRetryAttempts = value.Uint()
+	case "band.tunnel.v1beta1.Params.packet_retry_base_blocks":
+		x.PacketRetryBaseBlocks = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Params"))
@@ -523,6 +591,14 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field axelar_gmp_account of message band.tunnel.v1beta1.Params is not mutable"))
 	case "band.tunnel.v1beta1.Params.axelar_fee_recipient":
 		panic(fmt.Errorf("field axelar_fee_recipient of message band.tunnel.v1beta1.Params is not mutable"))
+	case "band.tunnel.v1beta1.Params.local_route_gas_limit":
+		panic(fmt.Errorf("field local_route_gas_limit of message band.tunnel.v1beta1.Params is not mutable"))
+	case "band.tunnel.v1beta1.Params.max_routes":
+		panic(fmt.Errorf("field max_routes of message band.tunnel.v1beta1.Params is not mutable"))
+	case "band.tunnel.v1beta1.Params.max_packet_retry_attempts":
+		panic(fmt.Errorf("field max_packet_retry_attempts of message band.tunnel.v1beta1.Params is not mutable"))
+	case "band.tunnel.v1beta1.Params.packet_retry_base_blocks":
+		panic(fmt.Errorf("field packet_retry_base_blocks of message band.tunnel.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Params"))
@@ -562,6 +638,14 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "band.tunnel.v1beta1.Params.axelar_fee_recipient":
 		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.Params.local_route_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.Params.max_routes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.Params.max_packet_retry_attempts":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.Params.packet_retry_base_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Params"))
@@ -678,6 +762,18 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.LocalRouteGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.LocalRouteGasLimit))
+		}
+		if x.MaxRoutes != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRoutes))
+		}
+		if x.MaxPacketRetryAttempts != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPacketRetryAttempts))
+		}
+		if x.PacketRetryBaseBlocks != 0 {
+			n += 2 + runtime.Sov(uint64(x.PacketRetryBaseBlocks))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -707,6 +803,28 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.PacketRetryBaseBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PacketRetryBaseBlocks))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.MaxPacketRetryAttempts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPacketRetryAttempts))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.MaxRoutes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRoutes))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.LocalRouteGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LocalRouteGasLimit))
+			i--
+			dAtA[i] = 0x68
+		}
 		if len(x.AxelarFeeRecipient) > 0 {
 			i -= len(x.AxelarFeeRecipient)
 			copy(dAtA[i:], x.AxelarFeeRecipient)
@@ -1171,6 +1289,82 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.AxelarFeeRecipient = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LocalRouteGasLimit", wireType)
+				}
+				x.LocalRouteGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LocalRouteGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRoutes", wireType)
+				}
+				x.MaxRoutes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRoutes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPacketRetryAttempts", wireType)
+				}
+				x.MaxPacketRetryAttempts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPacketRetryAttempts |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PacketRetryBaseBlocks", wireType)
+				}
+				x.PacketRetryBaseBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PacketRetryBaseBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1250,6 +1444,15 @@ type Params struct {
 	AxelarGmpAccount string `protobuf:"bytes,11,opt,name=axelar_gmp_account,json=axelarGmpAccount,proto3" json:"axelar_gmp_account,omitempty"`
 	// axelar_fee_recipient is the account address on axelar chain that receive fee from tunnel.
 	AxelarFeeRecipient string `protobuf:"bytes,12,opt,name=axelar_fee_recipient,json=axelarFeeRecipient,proto3" json:"axelar_fee_recipient,omitempty"`
+	// local_route_gas_limit is the maximum gas that a consumer module can use to process a packet of a local route.
+	LocalRouteGasLimit uint64 `protobuf:"varint,13,opt,name=local_route_gas_limit,json=localRouteGasLimit,proto3" json:"local_route_gas_limit,omitempty"`
+	// max_routes defines the maximum number of routes allowed per tunnel.
+	MaxRoutes uint64 `protobuf:"varint,14,opt,name=max_routes,json=maxRoutes,proto3" json:"max_routes,omitempty"`
+	// max_packet_retry_attempts is the maximum number of retries of a failed route delivery before it is moved to
+	// the dead-letter store.
+	MaxPacketRetryAttempts uint64 `protobuf:"varint,15,opt,name=max_packet_retry_attempts,json=maxPacketRetryAttempts,proto3" json:"max_packet_retry_attempts,omitempty"`
+	// packet_retry_base_blocks is the number of blocks to wait before the first retry. The wait doubles on each retry.
+	PacketRetryBaseBlocks uint64 `protobuf:"varint,16,opt,name=packet_retry_base_blocks,json=packetRetryBaseBlocks,proto3" json:"packet_retry_base_blocks,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1356,6 +1559,34 @@ func (x *Params) GetAxelarFeeRecipient() string {
 	return ""
 }
 
+func (x *Params) GetLocalRouteGasLimit() uint64 {
+	if x != nil {
+		return x.LocalRouteGasLimit
+	}
+	return 0
+}
+
+func (x *Params) GetMaxRoutes() uint64 {
+	if x != nil {
+		return x.MaxRoutes
+	}
+	return 0
+}
+
+func (x *Params) GetMaxPacketRetryAttempts() uint64 {
+	if x != nil {
+		return x.MaxPacketRetryAttempts
+	}
+	return 0
+}
+
+func (x *Params) GetPacketRetryBaseBlocks() uint64 {
+	if x != nil {
+		return x.PacketRetryBaseBlocks
+	}
+	return 0
+}
+
 var File_band_tunnel_v1beta1_params_proto protoreflect.FileDescriptor
 
 var file_band_tunnel_v1beta1_params_proto_rawDesc = []byte{
@@ -1365,7 +1596,7 @@ var file_band_tunnel_v1beta1_params_proto_rawDesc = []byte{
 	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x98, 0x06,
+	0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xde, 0x07,
 	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x6c, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f,
 	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
@@ -1415,21 +1646,34 @@ var file_band_tunnel_v1beta1_params_proto_rawDesc = []byte{
 	0x12, 0x30, 0x0a, 0x14, 0x61, 0x78, 0x65, 0x6c, 0x61, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72,
 	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
 	0x61, 0x78, 0x65, 0x6c, 0x61, 0x72, 0x46, 0x65, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
-	0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xdc, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d,
-	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x54,
-	0x58, 0xaa, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f,
+	0x6e, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12,
+	0x37, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x15, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x42, 0x61,
+	0x73, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xdc,
+	0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x3b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13,
 	0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x15, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
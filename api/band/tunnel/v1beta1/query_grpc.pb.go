@@ -19,14 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Tunnels_FullMethodName   = "/band.tunnel.v1beta1.Query/Tunnels"
-	Query_Tunnel_FullMethodName    = "/band.tunnel.v1beta1.Query/Tunnel"
-	Query_Deposits_FullMethodName  = "/band.tunnel.v1beta1.Query/Deposits"
-	Query_Deposit_FullMethodName   = "/band.tunnel.v1beta1.Query/Deposit"
-	Query_Packets_FullMethodName   = "/band.tunnel.v1beta1.Query/Packets"
-	Query_Packet_FullMethodName    = "/band.tunnel.v1beta1.Query/Packet"
-	Query_TotalFees_FullMethodName = "/band.tunnel.v1beta1.Query/TotalFees"
-	Query_Params_FullMethodName    = "/band.tunnel.v1beta1.Query/Params"
+	Query_Tunnels_FullMethodName           = "/band.tunnel.v1beta1.Query/Tunnels"
+	Query_Tunnel_FullMethodName            = "/band.tunnel.v1beta1.Query/Tunnel"
+	Query_Deposits_FullMethodName          = "/band.tunnel.v1beta1.Query/Deposits"
+	Query_Deposit_FullMethodName           = "/band.tunnel.v1beta1.Query/Deposit"
+	Query_Packets_FullMethodName           = "/band.tunnel.v1beta1.Query/Packets"
+	Query_Packet_FullMethodName            = "/band.tunnel.v1beta1.Query/Packet"
+	Query_NextTrigger_FullMethodName       = "/band.tunnel.v1beta1.Query/NextTrigger"
+	Query_PacketRetries_FullMethodName     = "/band.tunnel.v1beta1.Query/PacketRetries"
+	Query_DeadLetterPackets_FullMethodName = "/band.tunnel.v1beta1.Query/DeadLetterPackets"
+	Query_TopUpConfig_FullMethodName       = "/band.tunnel.v1beta1.Query/TopUpConfig"
+	Query_RemainingPackets_FullMethodName  = "/band.tunnel.v1beta1.Query/RemainingPackets"
+	Query_TotalFees_FullMethodName         = "/band.tunnel.v1beta1.Query/TotalFees"
+	Query_Params_FullMethodName            = "/band.tunnel.v1beta1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -45,6 +50,17 @@ type QueryClient interface {
 	Packets(ctx context.Context, in *QueryPacketsRequest, opts ...grpc.CallOption) (*QueryPacketsResponse, error)
 	// Packet is a RPC method that returns a packet by its tunnel ID and sequence.
 	Packet(ctx context.Context, in *QueryPacketRequest, opts ...grpc.CallOption) (*QueryPacketResponse, error)
+	// NextTrigger is a RPC method that returns the next scheduled trigger of a tunnel.
+	NextTrigger(ctx context.Context, in *QueryNextTriggerRequest, opts ...grpc.CallOption) (*QueryNextTriggerResponse, error)
+	// PacketRetries is a RPC method that returns all pending packet retries of a tunnel.
+	PacketRetries(ctx context.Context, in *QueryPacketRetriesRequest, opts ...grpc.CallOption) (*QueryPacketRetriesResponse, error)
+	// DeadLetterPackets is a RPC method that returns all dead-lettered packets of a tunnel.
+	DeadLetterPackets(ctx context.Context, in *QueryDeadLetterPacketsRequest, opts ...grpc.CallOption) (*QueryDeadLetterPacketsResponse, error)
+	// TopUpConfig is a RPC method that returns the top-up config of a tunnel.
+	TopUpConfig(ctx context.Context, in *QueryTopUpConfigRequest, opts ...grpc.CallOption) (*QueryTopUpConfigResponse, error)
+	// RemainingPackets is a RPC method that estimates the number of packets that the fee payer of a tunnel
+	// can pay for at the current params.
+	RemainingPackets(ctx context.Context, in *QueryRemainingPacketsRequest, opts ...grpc.CallOption) (*QueryRemainingPacketsResponse, error)
 	// TotalFees is a RPC method that returns the total fees collected by the tunnel
 	TotalFees(ctx context.Context, in *QueryTotalFeesRequest, opts ...grpc.CallOption) (*QueryTotalFeesResponse, error)
 	// Params is a RPC method that returns all parameters of the module.
@@ -113,6 +129,51 @@ func (c *queryClient) Packet(ctx context.Context, in *QueryPacketRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) NextTrigger(ctx context.Context, in *QueryNextTriggerRequest, opts ...grpc.CallOption) (*QueryNextTriggerResponse, error) {
+	out := new(QueryNextTriggerResponse)
+	err := c.cc.Invoke(ctx, Query_NextTrigger_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PacketRetries(ctx context.Context, in *QueryPacketRetriesRequest, opts ...grpc.CallOption) (*QueryPacketRetriesResponse, error) {
+	out := new(QueryPacketRetriesResponse)
+	err := c.cc.Invoke(ctx, Query_PacketRetries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeadLetterPackets(ctx context.Context, in *QueryDeadLetterPacketsRequest, opts ...grpc.CallOption) (*QueryDeadLetterPacketsResponse, error) {
+	out := new(QueryDeadLetterPacketsResponse)
+	err := c.cc.Invoke(ctx, Query_DeadLetterPackets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TopUpConfig(ctx context.Context, in *QueryTopUpConfigRequest, opts ...grpc.CallOption) (*QueryTopUpConfigResponse, error) {
+	out := new(QueryTopUpConfigResponse)
+	err := c.cc.Invoke(ctx, Query_TopUpConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RemainingPackets(ctx context.Context, in *QueryRemainingPacketsRequest, opts ...grpc.CallOption) (*QueryRemainingPacketsResponse, error) {
+	out := new(QueryRemainingPacketsResponse)
+	err := c.cc.Invoke(ctx, Query_RemainingPackets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TotalFees(ctx context.Context, in *QueryTotalFeesRequest, opts ...grpc.CallOption) (*QueryTotalFeesResponse, error) {
 	out := new(QueryTotalFeesResponse)
 	err := c.cc.Invoke(ctx, Query_TotalFees_FullMethodName, in, out, opts...)
@@ -147,6 +208,17 @@ type QueryServer interface {
 	Packets(context.Context, *QueryPacketsRequest) (*QueryPacketsResponse, error)
 	// Packet is a RPC method that returns a packet by its tunnel ID and sequence.
 	Packet(context.Context, *QueryPacketRequest) (*QueryPacketResponse, error)
+	// NextTrigger is a RPC method that returns the next scheduled trigger of a tunnel.
+	NextTrigger(context.Context, *QueryNextTriggerRequest) (*QueryNextTriggerResponse, error)
+	// PacketRetries is a RPC method that returns all pending packet retries of a tunnel.
+	PacketRetries(context.Context, *QueryPacketRetriesRequest) (*QueryPacketRetriesResponse, error)
+	// DeadLetterPackets is a RPC method that returns all dead-lettered packets of a tunnel.
+	DeadLetterPackets(context.Context, *QueryDeadLetterPacketsRequest) (*QueryDeadLetterPacketsResponse, error)
+	// TopUpConfig is a RPC method that returns the top-up config of a tunnel.
+	TopUpConfig(context.Context, *QueryTopUpConfigRequest) (*QueryTopUpConfigResponse, error)
+	// RemainingPackets is a RPC method that estimates the number of packets that the fee payer of a tunnel
+	// can pay for at the current params.
+	RemainingPackets(context.Context, *QueryRemainingPacketsRequest) (*QueryRemainingPacketsResponse, error)
 	// TotalFees is a RPC method that returns the total fees collected by the tunnel
 	TotalFees(context.Context, *QueryTotalFeesRequest) (*QueryTotalFeesResponse, error)
 	// Params is a RPC method that returns all parameters of the module.
@@ -176,6 +248,21 @@ func (UnimplementedQueryServer) Packets(context.Context, *QueryPacketsRequest) (
 func (UnimplementedQueryServer) Packet(context.Context, *QueryPacketRequest) (*QueryPacketResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Packet not implemented")
 }
+func (UnimplementedQueryServer) NextTrigger(context.Context, *QueryNextTriggerRequest) (*QueryNextTriggerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextTrigger not implemented")
+}
+func (UnimplementedQueryServer) PacketRetries(context.Context, *QueryPacketRetriesRequest) (*QueryPacketRetriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PacketRetries not implemented")
+}
+func (UnimplementedQueryServer) DeadLetterPackets(context.Context, *QueryDeadLetterPacketsRequest) (*QueryDeadLetterPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeadLetterPackets not implemented")
+}
+func (UnimplementedQueryServer) TopUpConfig(context.Context, *QueryTopUpConfigRequest) (*QueryTopUpConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpConfig not implemented")
+}
+func (UnimplementedQueryServer) RemainingPackets(context.Context, *QueryRemainingPacketsRequest) (*QueryRemainingPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemainingPackets not implemented")
+}
 func (UnimplementedQueryServer) TotalFees(context.Context, *QueryTotalFeesRequest) (*QueryTotalFeesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalFees not implemented")
 }
@@ -303,6 +390,96 @@ func _Query_Packet_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NextTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NextTrigger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextTrigger(ctx, req.(*QueryNextTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PacketRetries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketRetriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PacketRetries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PacketRetries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PacketRetries(ctx, req.(*QueryPacketRetriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeadLetterPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeadLetterPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeadLetterPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DeadLetterPackets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeadLetterPackets(ctx, req.(*QueryDeadLetterPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TopUpConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopUpConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TopUpConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TopUpConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TopUpConfig(ctx, req.(*QueryTopUpConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemainingPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemainingPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemainingPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RemainingPackets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemainingPackets(ctx, req.(*QueryRemainingPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TotalFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTotalFeesRequest)
 	if err := dec(in); err != nil {
@@ -370,6 +547,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Packet",
 			Handler:    _Query_Packet_Handler,
 		},
+		{
+			MethodName: "NextTrigger",
+			Handler:    _Query_NextTrigger_Handler,
+		},
+		{
+			MethodName: "PacketRetries",
+			Handler:    _Query_PacketRetries_Handler,
+		},
+		{
+			MethodName: "DeadLetterPackets",
+			Handler:    _Query_DeadLetterPackets_Handler,
+		},
+		{
+			MethodName: "TopUpConfig",
+			Handler:    _Query_TopUpConfig_Handler,
+		},
+		{
+			MethodName: "RemainingPackets",
+			Handler:    _Query_RemainingPackets_Handler,
+		},
 		{
 			MethodName: "TotalFees",
 			Handler:    _Query_TotalFees_Handler,
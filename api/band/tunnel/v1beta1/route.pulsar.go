@@ -21,6 +21,8 @@ var (
 	fd_TSSRoute_destination_chain_id         protoreflect.FieldDescriptor
 	fd_TSSRoute_destination_contract_address protoreflect.FieldDescriptor
 	fd_TSSRoute_encoder                      protoreflect.FieldDescriptor
+	fd_TSSRoute_batch                        protoreflect.FieldDescriptor
+	fd_TSSRoute_group_name                   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -29,6 +31,8 @@ func init() {
 	fd_TSSRoute_destination_chain_id = md_TSSRoute.Fields().ByName("destination_chain_id")
 	fd_TSSRoute_destination_contract_address = md_TSSRoute.Fields().ByName("destination_contract_address")
 	fd_TSSRoute_encoder = md_TSSRoute.Fields().ByName("encoder")
+	fd_TSSRoute_batch = md_TSSRoute.Fields().ByName("batch")
+	fd_TSSRoute_group_name = md_TSSRoute.Fields().ByName("group_name")
 }
 
 var _ protoreflect.Message = (*fastReflection_TSSRoute)(nil)
@@ -114,6 +118,18 @@ func (x *fastReflection_TSSRoute) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if x.Batch != false {
+		value := protoreflect.ValueOfBool(x.Batch)
+		if !f(fd_TSSRoute_batch, value) {
+			return
+		}
+	}
+	if x.GroupName != "" {
+		value := protoreflect.ValueOfString(x.GroupName)
+		if !f(fd_TSSRoute_group_name, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -135,6 +151,10 @@ func (x *fastReflection_TSSRoute) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.DestinationContractAddress != ""
 	case "band.tunnel.v1beta1.TSSRoute.encoder":
 		return x.Encoder != 0
+	case "band.tunnel.v1beta1.TSSRoute.batch":
+		return x.Batch != false
+	case "band.tunnel.v1beta1.TSSRoute.group_name":
+		return x.GroupName != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TSSRoute"))
@@ -157,6 +177,10 @@ func (x *fastReflection_TSSRoute) Clear(fd protoreflect.FieldDescriptor) {
 		x.DestinationContractAddress = ""
 	case "band.tunnel.v1beta1.TSSRoute.encoder":
 		x.Encoder = 0
+	case "band.tunnel.v1beta1.TSSRoute.batch":
+		x.Batch = false
+	case "band.tunnel.v1beta1.TSSRoute.group_name":
+		x.GroupName = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TSSRoute"))
@@ -182,6 +206,12 @@ func (x *fastReflection_TSSRoute) Get(descriptor protoreflect.FieldDescriptor) p
 	case "band.tunnel.v1beta1.TSSRoute.encoder":
 		value := x.Encoder
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.tunnel.v1beta1.TSSRoute.batch":
+		value := x.Batch
+		return protoreflect.ValueOfBool(value)
+	case "band.tunnel.v1beta1.TSSRoute.group_name":
+		value := x.GroupName
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TSSRoute"))
@@ -208,6 +238,10 @@ func (x *fastReflection_TSSRoute) Set(fd protoreflect.FieldDescriptor, value pro
 		x.DestinationContractAddress = value.Interface().(string)
 	case "band.tunnel.v1beta1.TSSRoute.encoder":
 		x.Encoder = (v1beta1.Encoder)(value.Enum())
+	case "band.tunnel.v1beta1.TSSRoute.batch":
+		x.Batch = value.Bool()
+	case "band.tunnel.v1beta1.TSSRoute.group_name":
+		x.GroupName = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TSSRoute"))
@@ -234,6 +268,10 @@ func (x *fastReflection_TSSRoute) Mutable(fd protoreflect.FieldDescriptor) proto
 		panic(fmt.Errorf("field destination_contract_address of message band.tunnel.v1beta1.TSSRoute is not mutable"))
 	case "band.tunnel.v1beta1.TSSRoute.encoder":
 		panic(fmt.Errorf("field encoder of message band.tunnel.v1beta1.TSSRoute is not mutable"))
+	case "band.tunnel.v1beta1.TSSRoute.batch":
+		panic(fmt.Errorf("field batch of message band.tunnel.v1beta1.TSSRoute is not mutable"))
+	case "band.tunnel.v1beta1.TSSRoute.group_name":
+		panic(fmt.Errorf("field group_name of message band.tunnel.v1beta1.TSSRoute is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TSSRoute"))
@@ -253,6 +291,10 @@ func (x *fastReflection_TSSRoute) NewField(fd protoreflect.FieldDescriptor) prot
 		return protoreflect.ValueOfString("")
 	case "band.tunnel.v1beta1.TSSRoute.encoder":
 		return protoreflect.ValueOfEnum(0)
+	case "band.tunnel.v1beta1.TSSRoute.batch":
+		return protoreflect.ValueOfBool(false)
+	case "band.tunnel.v1beta1.TSSRoute.group_name":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TSSRoute"))
@@ -333,6 +375,13 @@ func (x *fastReflection_TSSRoute) ProtoMethods() *protoiface.Methods {
 		if x.Encoder != 0 {
 			n += 1 + runtime.Sov(uint64(x.Encoder))
 		}
+		if x.Batch {
+			n += 2
+		}
+		l = len(x.GroupName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -362,6 +411,23 @@ func (x *fastReflection_TSSRoute) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.GroupName) > 0 {
+			i -= len(x.GroupName)
+			copy(dAtA[i:], x.GroupName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GroupName)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Batch {
+			i--
+			if x.Batch {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.Encoder != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Encoder))
 			i--
@@ -513,6 +579,58 @@ func (x *fastReflection_TSSRoute) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Batch = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GroupName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2418,6 +2536,7 @@ var (
 	md_IBCHookRoute                              protoreflect.MessageDescriptor
 	fd_IBCHookRoute_channel_id                   protoreflect.FieldDescriptor
 	fd_IBCHookRoute_destination_contract_address protoreflect.FieldDescriptor
+	fd_IBCHookRoute_encoder                      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2425,6 +2544,7 @@ func init() {
 	md_IBCHookRoute = File_band_tunnel_v1beta1_route_proto.Messages().ByName("IBCHookRoute")
 	fd_IBCHookRoute_channel_id = md_IBCHookRoute.Fields().ByName("channel_id")
 	fd_IBCHookRoute_destination_contract_address = md_IBCHookRoute.Fields().ByName("destination_contract_address")
+	fd_IBCHookRoute_encoder = md_IBCHookRoute.Fields().ByName("encoder")
 }
 
 var _ protoreflect.Message = (*fastReflection_IBCHookRoute)(nil)
@@ -2504,6 +2624,12 @@ func (x *fastReflection_IBCHookRoute) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.Encoder != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Encoder))
+		if !f(fd_IBCHookRoute_encoder, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2523,6 +2649,8 @@ func (x *fastReflection_IBCHookRoute) Has(fd protoreflect.FieldDescriptor) bool
 		return x.ChannelId != ""
 	case "band.tunnel.v1beta1.IBCHookRoute.destination_contract_address":
 		return x.DestinationContractAddress != ""
+	case "band.tunnel.v1beta1.IBCHookRoute.encoder":
+		return x.Encoder != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookRoute"))
@@ -2543,6 +2671,8 @@ func (x *fastReflection_IBCHookRoute) Clear(fd protoreflect.FieldDescriptor) {
 		x.ChannelId = ""
 	case "band.tunnel.v1beta1.IBCHookRoute.destination_contract_address":
 		x.DestinationContractAddress = ""
+	case "band.tunnel.v1beta1.IBCHookRoute.encoder":
+		x.Encoder = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookRoute"))
@@ -2565,6 +2695,9 @@ func (x *fastReflection_IBCHookRoute) Get(descriptor protoreflect.FieldDescripto
 	case "band.tunnel.v1beta1.IBCHookRoute.destination_contract_address":
 		value := x.DestinationContractAddress
 		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.IBCHookRoute.encoder":
+		value := x.Encoder
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookRoute"))
@@ -2589,6 +2722,8 @@ func (x *fastReflection_IBCHookRoute) Set(fd protoreflect.FieldDescriptor, value
 		x.ChannelId = value.Interface().(string)
 	case "band.tunnel.v1beta1.IBCHookRoute.destination_contract_address":
 		x.DestinationContractAddress = value.Interface().(string)
+	case "band.tunnel.v1beta1.IBCHookRoute.encoder":
+		x.Encoder = (v1beta1.Encoder)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookRoute"))
@@ -2613,6 +2748,8 @@ func (x *fastReflection_IBCHookRoute) Mutable(fd protoreflect.FieldDescriptor) p
 		panic(fmt.Errorf("field channel_id of message band.tunnel.v1beta1.IBCHookRoute is not mutable"))
 	case "band.tunnel.v1beta1.IBCHookRoute.destination_contract_address":
 		panic(fmt.Errorf("field destination_contract_address of message band.tunnel.v1beta1.IBCHookRoute is not mutable"))
+	case "band.tunnel.v1beta1.IBCHookRoute.encoder":
+		panic(fmt.Errorf("field encoder of message band.tunnel.v1beta1.IBCHookRoute is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookRoute"))
@@ -2630,6 +2767,8 @@ func (x *fastReflection_IBCHookRoute) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfString("")
 	case "band.tunnel.v1beta1.IBCHookRoute.destination_contract_address":
 		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.IBCHookRoute.encoder":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookRoute"))
@@ -2707,6 +2846,9 @@ func (x *fastReflection_IBCHookRoute) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Encoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.Encoder))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2736,6 +2878,11 @@ func (x *fastReflection_IBCHookRoute) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Encoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Encoder))
+			i--
+			dAtA[i] = 0x18
+		}
 		if len(x.DestinationContractAddress) > 0 {
 			i -= len(x.DestinationContractAddress)
 			copy(dAtA[i:], x.DestinationContractAddress)
@@ -2863,6 +3010,25 @@ func (x *fastReflection_IBCHookRoute) ProtoMethods() *protoiface.Methods {
 				}
 				x.DestinationContractAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+				}
+				x.Encoder = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Encoder |= v1beta1.Encoder(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3759,7 +3925,7 @@ func (x *IBCHookMemo_Payload) ProtoReflect() protoreflect.Message {
 }
 
 func (x *IBCHookMemo_Payload) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[13]
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4256,7 +4422,7 @@ func (x *IBCHookMemo_Payload_Msg) ProtoReflect() protoreflect.Message {
 }
 
 func (x *IBCHookMemo_Payload_Msg) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[14]
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4691,7 +4857,7 @@ func (x *IBCHookMemo_Payload_Msg_ReceivePacket) ProtoReflect() protoreflect.Mess
 }
 
 func (x *IBCHookMemo_Payload_Msg_ReceivePacket) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[15]
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5107,29 +5273,25 @@ func (x *fastReflection_IBCHookMemo_Payload_Msg_ReceivePacket) ProtoMethods() *p
 }
 
 var (
-	md_RouterRoute                              protoreflect.MessageDescriptor
-	fd_RouterRoute_destination_chain_id         protoreflect.FieldDescriptor
-	fd_RouterRoute_destination_contract_address protoreflect.FieldDescriptor
-	fd_RouterRoute_destination_gas_limit        protoreflect.FieldDescriptor
+	md_IBCHookEncodedMemo      protoreflect.MessageDescriptor
+	fd_IBCHookEncodedMemo_wasm protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_route_proto_init()
-	md_RouterRoute = File_band_tunnel_v1beta1_route_proto.Messages().ByName("RouterRoute")
-	fd_RouterRoute_destination_chain_id = md_RouterRoute.Fields().ByName("destination_chain_id")
-	fd_RouterRoute_destination_contract_address = md_RouterRoute.Fields().ByName("destination_contract_address")
-	fd_RouterRoute_destination_gas_limit = md_RouterRoute.Fields().ByName("destination_gas_limit")
+	md_IBCHookEncodedMemo = File_band_tunnel_v1beta1_route_proto.Messages().ByName("IBCHookEncodedMemo")
+	fd_IBCHookEncodedMemo_wasm = md_IBCHookEncodedMemo.Fields().ByName("wasm")
 }
 
-var _ protoreflect.Message = (*fastReflection_RouterRoute)(nil)
+var _ protoreflect.Message = (*fastReflection_IBCHookEncodedMemo)(nil)
 
-type fastReflection_RouterRoute RouterRoute
+type fastReflection_IBCHookEncodedMemo IBCHookEncodedMemo
 
-func (x *RouterRoute) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RouterRoute)(x)
+func (x *IBCHookEncodedMemo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IBCHookEncodedMemo)(x)
 }
 
-func (x *RouterRoute) slowProtoReflect() protoreflect.Message {
+func (x *IBCHookEncodedMemo) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5141,43 +5303,43 @@ func (x *RouterRoute) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RouterRoute_messageType fastReflection_RouterRoute_messageType
-var _ protoreflect.MessageType = fastReflection_RouterRoute_messageType{}
+var _fastReflection_IBCHookEncodedMemo_messageType fastReflection_IBCHookEncodedMemo_messageType
+var _ protoreflect.MessageType = fastReflection_IBCHookEncodedMemo_messageType{}
 
-type fastReflection_RouterRoute_messageType struct{}
+type fastReflection_IBCHookEncodedMemo_messageType struct{}
 
-func (x fastReflection_RouterRoute_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RouterRoute)(nil)
+func (x fastReflection_IBCHookEncodedMemo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IBCHookEncodedMemo)(nil)
 }
-func (x fastReflection_RouterRoute_messageType) New() protoreflect.Message {
-	return new(fastReflection_RouterRoute)
+func (x fastReflection_IBCHookEncodedMemo_messageType) New() protoreflect.Message {
+	return new(fastReflection_IBCHookEncodedMemo)
 }
-func (x fastReflection_RouterRoute_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RouterRoute
+func (x fastReflection_IBCHookEncodedMemo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCHookEncodedMemo
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RouterRoute) Descriptor() protoreflect.MessageDescriptor {
-	return md_RouterRoute
+func (x *fastReflection_IBCHookEncodedMemo) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCHookEncodedMemo
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RouterRoute) Type() protoreflect.MessageType {
-	return _fastReflection_RouterRoute_messageType
+func (x *fastReflection_IBCHookEncodedMemo) Type() protoreflect.MessageType {
+	return _fastReflection_IBCHookEncodedMemo_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RouterRoute) New() protoreflect.Message {
-	return new(fastReflection_RouterRoute)
+func (x *fastReflection_IBCHookEncodedMemo) New() protoreflect.Message {
+	return new(fastReflection_IBCHookEncodedMemo)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RouterRoute) Interface() protoreflect.ProtoMessage {
-	return (*RouterRoute)(x)
+func (x *fastReflection_IBCHookEncodedMemo) Interface() protoreflect.ProtoMessage {
+	return (*IBCHookEncodedMemo)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5185,22 +5347,10 @@ func (x *fastReflection_RouterRoute) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RouterRoute) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DestinationChainId != "" {
-		value := protoreflect.ValueOfString(x.DestinationChainId)
-		if !f(fd_RouterRoute_destination_chain_id, value) {
-			return
-		}
-	}
-	if x.DestinationContractAddress != "" {
-		value := protoreflect.ValueOfString(x.DestinationContractAddress)
-		if !f(fd_RouterRoute_destination_contract_address, value) {
-			return
-		}
-	}
-	if x.DestinationGasLimit != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.DestinationGasLimit)
-		if !f(fd_RouterRoute_destination_gas_limit, value) {
+func (x *fastReflection_IBCHookEncodedMemo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Wasm != nil {
+		value := protoreflect.ValueOfMessage(x.Wasm.ProtoReflect())
+		if !f(fd_IBCHookEncodedMemo_wasm, value) {
 			return
 		}
 	}
@@ -5217,19 +5367,15 @@ func (x *fastReflection_RouterRoute) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RouterRoute) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_IBCHookEncodedMemo) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterRoute.destination_chain_id":
-		return x.DestinationChainId != ""
-	case "band.tunnel.v1beta1.RouterRoute.destination_contract_address":
-		return x.DestinationContractAddress != ""
-	case "band.tunnel.v1beta1.RouterRoute.destination_gas_limit":
-		return x.DestinationGasLimit != uint64(0)
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.wasm":
+		return x.Wasm != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterRoute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterRoute does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5239,19 +5385,15 @@ func (x *fastReflection_RouterRoute) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterRoute) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_IBCHookEncodedMemo) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterRoute.destination_chain_id":
-		x.DestinationChainId = ""
-	case "band.tunnel.v1beta1.RouterRoute.destination_contract_address":
-		x.DestinationContractAddress = ""
-	case "band.tunnel.v1beta1.RouterRoute.destination_gas_limit":
-		x.DestinationGasLimit = uint64(0)
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.wasm":
+		x.Wasm = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterRoute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterRoute does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5261,22 +5403,16 @@ func (x *fastReflection_RouterRoute) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RouterRoute) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_IBCHookEncodedMemo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.RouterRoute.destination_chain_id":
-		value := x.DestinationChainId
-		return protoreflect.ValueOfString(value)
-	case "band.tunnel.v1beta1.RouterRoute.destination_contract_address":
-		value := x.DestinationContractAddress
-		return protoreflect.ValueOfString(value)
-	case "band.tunnel.v1beta1.RouterRoute.destination_gas_limit":
-		value := x.DestinationGasLimit
-		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.wasm":
+		value := x.Wasm
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterRoute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterRoute does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5290,19 +5426,15 @@ func (x *fastReflection_RouterRoute) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterRoute) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_IBCHookEncodedMemo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterRoute.destination_chain_id":
-		x.DestinationChainId = value.Interface().(string)
-	case "band.tunnel.v1beta1.RouterRoute.destination_contract_address":
-		x.DestinationContractAddress = value.Interface().(string)
-	case "band.tunnel.v1beta1.RouterRoute.destination_gas_limit":
-		x.DestinationGasLimit = value.Uint()
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.wasm":
+		x.Wasm = value.Message().Interface().(*IBCHookEncodedMemo_Payload)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterRoute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterRoute does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5316,48 +5448,44 @@ func (x *fastReflection_RouterRoute) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterRoute) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_IBCHookEncodedMemo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterRoute.destination_chain_id":
-		panic(fmt.Errorf("field destination_chain_id of message band.tunnel.v1beta1.RouterRoute is not mutable"))
-	case "band.tunnel.v1beta1.RouterRoute.destination_contract_address":
-		panic(fmt.Errorf("field destination_contract_address of message band.tunnel.v1beta1.RouterRoute is not mutable"))
-	case "band.tunnel.v1beta1.RouterRoute.destination_gas_limit":
-		panic(fmt.Errorf("field destination_gas_limit of message band.tunnel.v1beta1.RouterRoute is not mutable"))
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.wasm":
+		if x.Wasm == nil {
+			x.Wasm = new(IBCHookEncodedMemo_Payload)
+		}
+		return protoreflect.ValueOfMessage(x.Wasm.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterRoute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterRoute does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RouterRoute) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_IBCHookEncodedMemo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterRoute.destination_chain_id":
-		return protoreflect.ValueOfString("")
-	case "band.tunnel.v1beta1.RouterRoute.destination_contract_address":
-		return protoreflect.ValueOfString("")
-	case "band.tunnel.v1beta1.RouterRoute.destination_gas_limit":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.wasm":
+		m := new(IBCHookEncodedMemo_Payload)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterRoute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterRoute does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RouterRoute) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_IBCHookEncodedMemo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.RouterRoute", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.IBCHookEncodedMemo", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5365,7 +5493,7 @@ func (x *fastReflection_RouterRoute) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RouterRoute) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_IBCHookEncodedMemo) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5376,7 +5504,7 @@ func (x *fastReflection_RouterRoute) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterRoute) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_IBCHookEncodedMemo) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5388,7 +5516,7 @@ func (x *fastReflection_RouterRoute) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RouterRoute) IsValid() bool {
+func (x *fastReflection_IBCHookEncodedMemo) IsValid() bool {
 	return x != nil
 }
 
@@ -5398,9 +5526,9 @@ func (x *fastReflection_RouterRoute) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RouterRoute) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_IBCHookEncodedMemo) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RouterRoute)
+		x := input.Message.Interface().(*IBCHookEncodedMemo)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5412,17 +5540,10 @@ func (x *fastReflection_RouterRoute) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.DestinationChainId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.DestinationContractAddress)
-		if l > 0 {
+		if x.Wasm != nil {
+			l = options.Size(x.Wasm)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.DestinationGasLimit != 0 {
-			n += 1 + runtime.Sov(uint64(x.DestinationGasLimit))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5433,7 +5554,7 @@ func (x *fastReflection_RouterRoute) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RouterRoute)
+		x := input.Message.Interface().(*IBCHookEncodedMemo)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5452,24 +5573,19 @@ func (x *fastReflection_RouterRoute) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.DestinationGasLimit != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DestinationGasLimit))
+		if x.Wasm != nil {
+			encoded, err := options.Marshal(x.Wasm)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.DestinationContractAddress) > 0 {
-			i -= len(x.DestinationContractAddress)
-			copy(dAtA[i:], x.DestinationContractAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationContractAddress)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.DestinationChainId) > 0 {
-			i -= len(x.DestinationChainId)
-			copy(dAtA[i:], x.DestinationChainId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationChainId)))
-			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5482,7 +5598,7 @@ func (x *fastReflection_RouterRoute) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RouterRoute)
+		x := input.Message.Interface().(*IBCHookEncodedMemo)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5514,17 +5630,17 @@ func (x *fastReflection_RouterRoute) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterRoute: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCHookEncodedMemo: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCHookEncodedMemo: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationChainId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5534,75 +5650,28 @@ func (x *fastReflection_RouterRoute) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DestinationChainId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationContractAddress", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.Wasm == nil {
+					x.Wasm = &IBCHookEncodedMemo_Payload{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Wasm); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.DestinationContractAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationGasLimit", wireType)
-				}
-				x.DestinationGasLimit = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.DestinationGasLimit |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5639,26 +5708,28 @@ func (x *fastReflection_RouterRoute) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_RouterPacketReceipt          protoreflect.MessageDescriptor
-	fd_RouterPacketReceipt_sequence protoreflect.FieldDescriptor
+	md_IBCHookEncodedMemo_Payload          protoreflect.MessageDescriptor
+	fd_IBCHookEncodedMemo_Payload_contract protoreflect.FieldDescriptor
+	fd_IBCHookEncodedMemo_Payload_msg      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_route_proto_init()
-	md_RouterPacketReceipt = File_band_tunnel_v1beta1_route_proto.Messages().ByName("RouterPacketReceipt")
-	fd_RouterPacketReceipt_sequence = md_RouterPacketReceipt.Fields().ByName("sequence")
+	md_IBCHookEncodedMemo_Payload = File_band_tunnel_v1beta1_route_proto.Messages().ByName("IBCHookEncodedMemo").Messages().ByName("Payload")
+	fd_IBCHookEncodedMemo_Payload_contract = md_IBCHookEncodedMemo_Payload.Fields().ByName("contract")
+	fd_IBCHookEncodedMemo_Payload_msg = md_IBCHookEncodedMemo_Payload.Fields().ByName("msg")
 }
 
-var _ protoreflect.Message = (*fastReflection_RouterPacketReceipt)(nil)
+var _ protoreflect.Message = (*fastReflection_IBCHookEncodedMemo_Payload)(nil)
 
-type fastReflection_RouterPacketReceipt RouterPacketReceipt
+type fastReflection_IBCHookEncodedMemo_Payload IBCHookEncodedMemo_Payload
 
-func (x *RouterPacketReceipt) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RouterPacketReceipt)(x)
+func (x *IBCHookEncodedMemo_Payload) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IBCHookEncodedMemo_Payload)(x)
 }
 
-func (x *RouterPacketReceipt) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[9]
+func (x *IBCHookEncodedMemo_Payload) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5669,43 +5740,43 @@ func (x *RouterPacketReceipt) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RouterPacketReceipt_messageType fastReflection_RouterPacketReceipt_messageType
-var _ protoreflect.MessageType = fastReflection_RouterPacketReceipt_messageType{}
+var _fastReflection_IBCHookEncodedMemo_Payload_messageType fastReflection_IBCHookEncodedMemo_Payload_messageType
+var _ protoreflect.MessageType = fastReflection_IBCHookEncodedMemo_Payload_messageType{}
 
-type fastReflection_RouterPacketReceipt_messageType struct{}
+type fastReflection_IBCHookEncodedMemo_Payload_messageType struct{}
 
-func (x fastReflection_RouterPacketReceipt_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RouterPacketReceipt)(nil)
+func (x fastReflection_IBCHookEncodedMemo_Payload_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IBCHookEncodedMemo_Payload)(nil)
 }
-func (x fastReflection_RouterPacketReceipt_messageType) New() protoreflect.Message {
-	return new(fastReflection_RouterPacketReceipt)
+func (x fastReflection_IBCHookEncodedMemo_Payload_messageType) New() protoreflect.Message {
+	return new(fastReflection_IBCHookEncodedMemo_Payload)
 }
-func (x fastReflection_RouterPacketReceipt_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RouterPacketReceipt
+func (x fastReflection_IBCHookEncodedMemo_Payload_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCHookEncodedMemo_Payload
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RouterPacketReceipt) Descriptor() protoreflect.MessageDescriptor {
-	return md_RouterPacketReceipt
+func (x *fastReflection_IBCHookEncodedMemo_Payload) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCHookEncodedMemo_Payload
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RouterPacketReceipt) Type() protoreflect.MessageType {
-	return _fastReflection_RouterPacketReceipt_messageType
+func (x *fastReflection_IBCHookEncodedMemo_Payload) Type() protoreflect.MessageType {
+	return _fastReflection_IBCHookEncodedMemo_Payload_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RouterPacketReceipt) New() protoreflect.Message {
-	return new(fastReflection_RouterPacketReceipt)
+func (x *fastReflection_IBCHookEncodedMemo_Payload) New() protoreflect.Message {
+	return new(fastReflection_IBCHookEncodedMemo_Payload)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RouterPacketReceipt) Interface() protoreflect.ProtoMessage {
-	return (*RouterPacketReceipt)(x)
+func (x *fastReflection_IBCHookEncodedMemo_Payload) Interface() protoreflect.ProtoMessage {
+	return (*IBCHookEncodedMemo_Payload)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5713,10 +5784,16 @@ func (x *fastReflection_RouterPacketReceipt) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RouterPacketReceipt) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Sequence != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Sequence)
-		if !f(fd_RouterPacketReceipt_sequence, value) {
+func (x *fastReflection_IBCHookEncodedMemo_Payload) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Contract != "" {
+		value := protoreflect.ValueOfString(x.Contract)
+		if !f(fd_IBCHookEncodedMemo_Payload_contract, value) {
+			return
+		}
+	}
+	if x.Msg != nil {
+		value := protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
+		if !f(fd_IBCHookEncodedMemo_Payload_msg, value) {
 			return
 		}
 	}
@@ -5733,15 +5810,17 @@ func (x *fastReflection_RouterPacketReceipt) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RouterPacketReceipt) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_IBCHookEncodedMemo_Payload) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterPacketReceipt.sequence":
-		return x.Sequence != uint64(0)
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.contract":
+		return x.Contract != ""
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.msg":
+		return x.Msg != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterPacketReceipt"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterPacketReceipt does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5751,15 +5830,17 @@ func (x *fastReflection_RouterPacketReceipt) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterPacketReceipt) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_IBCHookEncodedMemo_Payload) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterPacketReceipt.sequence":
-		x.Sequence = uint64(0)
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.contract":
+		x.Contract = ""
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.msg":
+		x.Msg = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterPacketReceipt"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterPacketReceipt does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5769,16 +5850,19 @@ func (x *fastReflection_RouterPacketReceipt) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RouterPacketReceipt) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_IBCHookEncodedMemo_Payload) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.RouterPacketReceipt.sequence":
-		value := x.Sequence
-		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.contract":
+		value := x.Contract
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.msg":
+		value := x.Msg
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterPacketReceipt"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterPacketReceipt does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5792,15 +5876,17 @@ func (x *fastReflection_RouterPacketReceipt) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterPacketReceipt) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_IBCHookEncodedMemo_Payload) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterPacketReceipt.sequence":
-		x.Sequence = value.Uint()
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.contract":
+		x.Contract = value.Interface().(string)
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.msg":
+		x.Msg = value.Message().Interface().(*IBCHookEncodedMemo_Payload_Msg)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterPacketReceipt"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterPacketReceipt does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5814,40 +5900,48 @@ func (x *fastReflection_RouterPacketReceipt) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterPacketReceipt) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_IBCHookEncodedMemo_Payload) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterPacketReceipt.sequence":
-		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.RouterPacketReceipt is not mutable"))
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.msg":
+		if x.Msg == nil {
+			x.Msg = new(IBCHookEncodedMemo_Payload_Msg)
+		}
+		return protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.contract":
+		panic(fmt.Errorf("field contract of message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterPacketReceipt"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterPacketReceipt does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RouterPacketReceipt) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_IBCHookEncodedMemo_Payload) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterPacketReceipt.sequence":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.contract":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.msg":
+		m := new(IBCHookEncodedMemo_Payload_Msg)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterPacketReceipt"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterPacketReceipt does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RouterPacketReceipt) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_IBCHookEncodedMemo_Payload) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.RouterPacketReceipt", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.IBCHookEncodedMemo.Payload", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5855,7 +5949,7 @@ func (x *fastReflection_RouterPacketReceipt) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RouterPacketReceipt) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_IBCHookEncodedMemo_Payload) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5866,7 +5960,7 @@ func (x *fastReflection_RouterPacketReceipt) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterPacketReceipt) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_IBCHookEncodedMemo_Payload) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5878,7 +5972,7 @@ func (x *fastReflection_RouterPacketReceipt) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RouterPacketReceipt) IsValid() bool {
+func (x *fastReflection_IBCHookEncodedMemo_Payload) IsValid() bool {
 	return x != nil
 }
 
@@ -5888,9 +5982,9 @@ func (x *fastReflection_RouterPacketReceipt) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RouterPacketReceipt) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_IBCHookEncodedMemo_Payload) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RouterPacketReceipt)
+		x := input.Message.Interface().(*IBCHookEncodedMemo_Payload)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5902,8 +5996,13 @@ func (x *fastReflection_RouterPacketReceipt) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Sequence != 0 {
-			n += 1 + runtime.Sov(uint64(x.Sequence))
+		l = len(x.Contract)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Msg != nil {
+			l = options.Size(x.Msg)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5915,7 +6014,7 @@ func (x *fastReflection_RouterPacketReceipt) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RouterPacketReceipt)
+		x := input.Message.Interface().(*IBCHookEncodedMemo_Payload)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5934,10 +6033,26 @@ func (x *fastReflection_RouterPacketReceipt) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Sequence != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+		if x.Msg != nil {
+			encoded, err := options.Marshal(x.Msg)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0x12
+		}
+		if len(x.Contract) > 0 {
+			i -= len(x.Contract)
+			copy(dAtA[i:], x.Contract)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Contract)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5950,7 +6065,7 @@ func (x *fastReflection_RouterPacketReceipt) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RouterPacketReceipt)
+		x := input.Message.Interface().(*IBCHookEncodedMemo_Payload)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5982,17 +6097,17 @@ func (x *fastReflection_RouterPacketReceipt) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterPacketReceipt: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCHookEncodedMemo_Payload: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCHookEncodedMemo_Payload: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 				}
-				x.Sequence = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6002,67 +6117,116 @@ func (x *fastReflection_RouterPacketReceipt) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Sequence |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Contract = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_RouterMemo      protoreflect.MessageDescriptor
-	fd_RouterMemo_wasm protoreflect.FieldDescriptor
-)
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Msg == nil {
+					x.Msg = &IBCHookEncodedMemo_Payload_Msg{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Msg); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_IBCHookEncodedMemo_Payload_Msg                        protoreflect.MessageDescriptor
+	fd_IBCHookEncodedMemo_Payload_Msg_receive_encoded_packet protoreflect.FieldDescriptor
+)
 
 func init() {
 	file_band_tunnel_v1beta1_route_proto_init()
-	md_RouterMemo = File_band_tunnel_v1beta1_route_proto.Messages().ByName("RouterMemo")
-	fd_RouterMemo_wasm = md_RouterMemo.Fields().ByName("wasm")
+	md_IBCHookEncodedMemo_Payload_Msg = File_band_tunnel_v1beta1_route_proto.Messages().ByName("IBCHookEncodedMemo").Messages().ByName("Payload").Messages().ByName("Msg")
+	fd_IBCHookEncodedMemo_Payload_Msg_receive_encoded_packet = md_IBCHookEncodedMemo_Payload_Msg.Fields().ByName("receive_encoded_packet")
 }
 
-var _ protoreflect.Message = (*fastReflection_RouterMemo)(nil)
+var _ protoreflect.Message = (*fastReflection_IBCHookEncodedMemo_Payload_Msg)(nil)
 
-type fastReflection_RouterMemo RouterMemo
+type fastReflection_IBCHookEncodedMemo_Payload_Msg IBCHookEncodedMemo_Payload_Msg
 
-func (x *RouterMemo) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RouterMemo)(x)
+func (x *IBCHookEncodedMemo_Payload_Msg) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IBCHookEncodedMemo_Payload_Msg)(x)
 }
 
-func (x *RouterMemo) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[10]
+func (x *IBCHookEncodedMemo_Payload_Msg) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6073,43 +6237,43 @@ func (x *RouterMemo) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RouterMemo_messageType fastReflection_RouterMemo_messageType
-var _ protoreflect.MessageType = fastReflection_RouterMemo_messageType{}
+var _fastReflection_IBCHookEncodedMemo_Payload_Msg_messageType fastReflection_IBCHookEncodedMemo_Payload_Msg_messageType
+var _ protoreflect.MessageType = fastReflection_IBCHookEncodedMemo_Payload_Msg_messageType{}
 
-type fastReflection_RouterMemo_messageType struct{}
+type fastReflection_IBCHookEncodedMemo_Payload_Msg_messageType struct{}
 
-func (x fastReflection_RouterMemo_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RouterMemo)(nil)
+func (x fastReflection_IBCHookEncodedMemo_Payload_Msg_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IBCHookEncodedMemo_Payload_Msg)(nil)
 }
-func (x fastReflection_RouterMemo_messageType) New() protoreflect.Message {
-	return new(fastReflection_RouterMemo)
+func (x fastReflection_IBCHookEncodedMemo_Payload_Msg_messageType) New() protoreflect.Message {
+	return new(fastReflection_IBCHookEncodedMemo_Payload_Msg)
 }
-func (x fastReflection_RouterMemo_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RouterMemo
+func (x fastReflection_IBCHookEncodedMemo_Payload_Msg_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCHookEncodedMemo_Payload_Msg
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RouterMemo) Descriptor() protoreflect.MessageDescriptor {
-	return md_RouterMemo
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCHookEncodedMemo_Payload_Msg
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RouterMemo) Type() protoreflect.MessageType {
-	return _fastReflection_RouterMemo_messageType
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg) Type() protoreflect.MessageType {
+	return _fastReflection_IBCHookEncodedMemo_Payload_Msg_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RouterMemo) New() protoreflect.Message {
-	return new(fastReflection_RouterMemo)
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg) New() protoreflect.Message {
+	return new(fastReflection_IBCHookEncodedMemo_Payload_Msg)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RouterMemo) Interface() protoreflect.ProtoMessage {
-	return (*RouterMemo)(x)
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg) Interface() protoreflect.ProtoMessage {
+	return (*IBCHookEncodedMemo_Payload_Msg)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6117,10 +6281,10 @@ func (x *fastReflection_RouterMemo) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RouterMemo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Wasm != nil {
-		value := protoreflect.ValueOfMessage(x.Wasm.ProtoReflect())
-		if !f(fd_RouterMemo_wasm, value) {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ReceiveEncodedPacket != nil {
+		value := protoreflect.ValueOfMessage(x.ReceiveEncodedPacket.ProtoReflect())
+		if !f(fd_IBCHookEncodedMemo_Payload_Msg_receive_encoded_packet, value) {
 			return
 		}
 	}
@@ -6137,15 +6301,15 @@ func (x *fastReflection_RouterMemo) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RouterMemo) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.wasm":
-		return x.Wasm != nil
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.receive_encoded_packet":
+		return x.ReceiveEncodedPacket != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6155,15 +6319,15 @@ func (x *fastReflection_RouterMemo) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterMemo) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.wasm":
-		x.Wasm = nil
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.receive_encoded_packet":
+		x.ReceiveEncodedPacket = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6173,16 +6337,16 @@ func (x *fastReflection_RouterMemo) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RouterMemo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.wasm":
-		value := x.Wasm
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.receive_encoded_packet":
+		value := x.ReceiveEncodedPacket
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6196,15 +6360,15 @@ func (x *fastReflection_RouterMemo) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterMemo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.wasm":
-		x.Wasm = value.Message().Interface().(*RouterMemo_Payload)
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.receive_encoded_packet":
+		x.ReceiveEncodedPacket = value.Message().Interface().(*IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6218,44 +6382,44 @@ func (x *fastReflection_RouterMemo) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterMemo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.wasm":
-		if x.Wasm == nil {
-			x.Wasm = new(RouterMemo_Payload)
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.receive_encoded_packet":
+		if x.ReceiveEncodedPacket == nil {
+			x.ReceiveEncodedPacket = new(IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket)
 		}
-		return protoreflect.ValueOfMessage(x.Wasm.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.ReceiveEncodedPacket.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RouterMemo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.wasm":
-		m := new(RouterMemo_Payload)
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.receive_encoded_packet":
+		m := new(IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RouterMemo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.RouterMemo", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6263,7 +6427,7 @@ func (x *fastReflection_RouterMemo) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RouterMemo) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6274,7 +6438,7 @@ func (x *fastReflection_RouterMemo) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterMemo) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6286,7 +6450,7 @@ func (x *fastReflection_RouterMemo) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RouterMemo) IsValid() bool {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg) IsValid() bool {
 	return x != nil
 }
 
@@ -6296,9 +6460,9 @@ func (x *fastReflection_RouterMemo) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RouterMemo) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RouterMemo)
+		x := input.Message.Interface().(*IBCHookEncodedMemo_Payload_Msg)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6310,8 +6474,8 @@ func (x *fastReflection_RouterMemo) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Wasm != nil {
-			l = options.Size(x.Wasm)
+		if x.ReceiveEncodedPacket != nil {
+			l = options.Size(x.ReceiveEncodedPacket)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6324,7 +6488,7 @@ func (x *fastReflection_RouterMemo) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RouterMemo)
+		x := input.Message.Interface().(*IBCHookEncodedMemo_Payload_Msg)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6343,8 +6507,8 @@ func (x *fastReflection_RouterMemo) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Wasm != nil {
-			encoded, err := options.Marshal(x.Wasm)
+		if x.ReceiveEncodedPacket != nil {
+			encoded, err := options.Marshal(x.ReceiveEncodedPacket)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6368,7 +6532,7 @@ func (x *fastReflection_RouterMemo) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RouterMemo)
+		x := input.Message.Interface().(*IBCHookEncodedMemo_Payload_Msg)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6400,15 +6564,15 @@ func (x *fastReflection_RouterMemo) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterMemo: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCHookEncodedMemo_Payload_Msg: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterMemo: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCHookEncodedMemo_Payload_Msg: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiveEncodedPacket", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6435,10 +6599,10 @@ func (x *fastReflection_RouterMemo) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Wasm == nil {
-					x.Wasm = &RouterMemo_Payload{}
+				if x.ReceiveEncodedPacket == nil {
+					x.ReceiveEncodedPacket = &IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Wasm); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReceiveEncodedPacket); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -6478,28 +6642,28 @@ func (x *fastReflection_RouterMemo) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_RouterMemo_Payload          protoreflect.MessageDescriptor
-	fd_RouterMemo_Payload_contract protoreflect.FieldDescriptor
-	fd_RouterMemo_Payload_msg      protoreflect.FieldDescriptor
+	md_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket         protoreflect.MessageDescriptor
+	fd_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket_encoder protoreflect.FieldDescriptor
+	fd_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket_payload protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_route_proto_init()
-	md_RouterMemo_Payload = File_band_tunnel_v1beta1_route_proto.Messages().ByName("RouterMemo").Messages().ByName("Payload")
-	fd_RouterMemo_Payload_contract = md_RouterMemo_Payload.Fields().ByName("contract")
-	fd_RouterMemo_Payload_msg = md_RouterMemo_Payload.Fields().ByName("msg")
+	md_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket = File_band_tunnel_v1beta1_route_proto.Messages().ByName("IBCHookEncodedMemo").Messages().ByName("Payload").Messages().ByName("Msg").Messages().ByName("ReceiveEncodedPacket")
+	fd_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket_encoder = md_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket.Fields().ByName("encoder")
+	fd_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket_payload = md_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket.Fields().ByName("payload")
 }
 
-var _ protoreflect.Message = (*fastReflection_RouterMemo_Payload)(nil)
+var _ protoreflect.Message = (*fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket)(nil)
 
-type fastReflection_RouterMemo_Payload RouterMemo_Payload
+type fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket
 
-func (x *RouterMemo_Payload) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RouterMemo_Payload)(x)
+func (x *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket)(x)
 }
 
-func (x *RouterMemo_Payload) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[16]
+func (x *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6510,43 +6674,43 @@ func (x *RouterMemo_Payload) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RouterMemo_Payload_messageType fastReflection_RouterMemo_Payload_messageType
-var _ protoreflect.MessageType = fastReflection_RouterMemo_Payload_messageType{}
+var _fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket_messageType fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket_messageType
+var _ protoreflect.MessageType = fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket_messageType{}
 
-type fastReflection_RouterMemo_Payload_messageType struct{}
+type fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket_messageType struct{}
 
-func (x fastReflection_RouterMemo_Payload_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RouterMemo_Payload)(nil)
+func (x fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket)(nil)
 }
-func (x fastReflection_RouterMemo_Payload_messageType) New() protoreflect.Message {
-	return new(fastReflection_RouterMemo_Payload)
+func (x fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket_messageType) New() protoreflect.Message {
+	return new(fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket)
 }
-func (x fastReflection_RouterMemo_Payload_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RouterMemo_Payload
+func (x fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RouterMemo_Payload) Descriptor() protoreflect.MessageDescriptor {
-	return md_RouterMemo_Payload
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RouterMemo_Payload) Type() protoreflect.MessageType {
-	return _fastReflection_RouterMemo_Payload_messageType
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) Type() protoreflect.MessageType {
+	return _fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RouterMemo_Payload) New() protoreflect.Message {
-	return new(fastReflection_RouterMemo_Payload)
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) New() protoreflect.Message {
+	return new(fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RouterMemo_Payload) Interface() protoreflect.ProtoMessage {
-	return (*RouterMemo_Payload)(x)
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) Interface() protoreflect.ProtoMessage {
+	return (*IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6554,16 +6718,16 @@ func (x *fastReflection_RouterMemo_Payload) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RouterMemo_Payload) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Contract != "" {
-		value := protoreflect.ValueOfString(x.Contract)
-		if !f(fd_RouterMemo_Payload_contract, value) {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Encoder != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Encoder))
+		if !f(fd_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket_encoder, value) {
 			return
 		}
 	}
-	if x.Msg != nil {
-		value := protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
-		if !f(fd_RouterMemo_Payload_msg, value) {
+	if x.Payload != "" {
+		value := protoreflect.ValueOfString(x.Payload)
+		if !f(fd_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket_payload, value) {
 			return
 		}
 	}
@@ -6580,17 +6744,17 @@ func (x *fastReflection_RouterMemo_Payload) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RouterMemo_Payload) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.Payload.contract":
-		return x.Contract != ""
-	case "band.tunnel.v1beta1.RouterMemo.Payload.msg":
-		return x.Msg != nil
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket.encoder":
+		return x.Encoder != 0
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket.payload":
+		return x.Payload != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6600,17 +6764,17 @@ func (x *fastReflection_RouterMemo_Payload) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterMemo_Payload) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.Payload.contract":
-		x.Contract = ""
-	case "band.tunnel.v1beta1.RouterMemo.Payload.msg":
-		x.Msg = nil
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket.encoder":
+		x.Encoder = 0
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket.payload":
+		x.Payload = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6620,19 +6784,19 @@ func (x *fastReflection_RouterMemo_Payload) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RouterMemo_Payload) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.Payload.contract":
-		value := x.Contract
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket.encoder":
+		value := x.Encoder
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket.payload":
+		value := x.Payload
 		return protoreflect.ValueOfString(value)
-	case "band.tunnel.v1beta1.RouterMemo.Payload.msg":
-		value := x.Msg
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6646,17 +6810,17 @@ func (x *fastReflection_RouterMemo_Payload) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterMemo_Payload) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.Payload.contract":
-		x.Contract = value.Interface().(string)
-	case "band.tunnel.v1beta1.RouterMemo.Payload.msg":
-		x.Msg = value.Message().Interface().(*RouterMemo_Payload_Msg)
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket.encoder":
+		x.Encoder = (v1beta1.Encoder)(value.Enum())
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket.payload":
+		x.Payload = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6670,48 +6834,44 @@ func (x *fastReflection_RouterMemo_Payload) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterMemo_Payload) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.Payload.msg":
-		if x.Msg == nil {
-			x.Msg = new(RouterMemo_Payload_Msg)
-		}
-		return protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
-	case "band.tunnel.v1beta1.RouterMemo.Payload.contract":
-		panic(fmt.Errorf("field contract of message band.tunnel.v1beta1.RouterMemo.Payload is not mutable"))
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket.encoder":
+		panic(fmt.Errorf("field encoder of message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket is not mutable"))
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket.payload":
+		panic(fmt.Errorf("field payload of message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RouterMemo_Payload) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.Payload.contract":
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket.encoder":
+		return protoreflect.ValueOfEnum(0)
+	case "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket.payload":
 		return protoreflect.ValueOfString("")
-	case "band.tunnel.v1beta1.RouterMemo.Payload.msg":
-		m := new(RouterMemo_Payload_Msg)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RouterMemo_Payload) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.RouterMemo.Payload", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6719,7 +6879,7 @@ func (x *fastReflection_RouterMemo_Payload) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RouterMemo_Payload) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6730,7 +6890,7 @@ func (x *fastReflection_RouterMemo_Payload) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterMemo_Payload) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6742,7 +6902,7 @@ func (x *fastReflection_RouterMemo_Payload) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RouterMemo_Payload) IsValid() bool {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) IsValid() bool {
 	return x != nil
 }
 
@@ -6752,9 +6912,9 @@ func (x *fastReflection_RouterMemo_Payload) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RouterMemo_Payload) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RouterMemo_Payload)
+		x := input.Message.Interface().(*IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6766,14 +6926,13 @@ func (x *fastReflection_RouterMemo_Payload) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Contract)
+		if x.Encoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.Encoder))
+		}
+		l = len(x.Payload)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Msg != nil {
-			l = options.Size(x.Msg)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6784,7 +6943,7 @@ func (x *fastReflection_RouterMemo_Payload) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RouterMemo_Payload)
+		x := input.Message.Interface().(*IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6803,26 +6962,17 @@ func (x *fastReflection_RouterMemo_Payload) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Msg != nil {
-			encoded, err := options.Marshal(x.Msg)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Payload) > 0 {
+			i -= len(x.Payload)
+			copy(dAtA[i:], x.Payload)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payload)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Contract) > 0 {
-			i -= len(x.Contract)
-			copy(dAtA[i:], x.Contract)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Contract)))
+		if x.Encoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Encoder))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6835,7 +6985,7 @@ func (x *fastReflection_RouterMemo_Payload) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RouterMemo_Payload)
+		x := input.Message.Interface().(*IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6867,17 +7017,17 @@ func (x *fastReflection_RouterMemo_Payload) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterMemo_Payload: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterMemo_Payload: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
 				}
-				var stringLen uint64
+				x.Encoder = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6887,29 +7037,16 @@ func (x *fastReflection_RouterMemo_Payload) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Encoder |= v1beta1.Encoder(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Contract = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6919,27 +7056,23 @@ func (x *fastReflection_RouterMemo_Payload) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Msg == nil {
-					x.Msg = &RouterMemo_Payload_Msg{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Msg); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Payload = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6977,26 +7110,32 @@ func (x *fastReflection_RouterMemo_Payload) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_RouterMemo_Payload_Msg                   protoreflect.MessageDescriptor
-	fd_RouterMemo_Payload_Msg_receive_band_data protoreflect.FieldDescriptor
+	md_RouterRoute                              protoreflect.MessageDescriptor
+	fd_RouterRoute_destination_chain_id         protoreflect.FieldDescriptor
+	fd_RouterRoute_destination_contract_address protoreflect.FieldDescriptor
+	fd_RouterRoute_destination_gas_limit        protoreflect.FieldDescriptor
+	fd_RouterRoute_encoder                      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_route_proto_init()
-	md_RouterMemo_Payload_Msg = File_band_tunnel_v1beta1_route_proto.Messages().ByName("RouterMemo").Messages().ByName("Payload").Messages().ByName("Msg")
-	fd_RouterMemo_Payload_Msg_receive_band_data = md_RouterMemo_Payload_Msg.Fields().ByName("receive_band_data")
+	md_RouterRoute = File_band_tunnel_v1beta1_route_proto.Messages().ByName("RouterRoute")
+	fd_RouterRoute_destination_chain_id = md_RouterRoute.Fields().ByName("destination_chain_id")
+	fd_RouterRoute_destination_contract_address = md_RouterRoute.Fields().ByName("destination_contract_address")
+	fd_RouterRoute_destination_gas_limit = md_RouterRoute.Fields().ByName("destination_gas_limit")
+	fd_RouterRoute_encoder = md_RouterRoute.Fields().ByName("encoder")
 }
 
-var _ protoreflect.Message = (*fastReflection_RouterMemo_Payload_Msg)(nil)
+var _ protoreflect.Message = (*fastReflection_RouterRoute)(nil)
 
-type fastReflection_RouterMemo_Payload_Msg RouterMemo_Payload_Msg
+type fastReflection_RouterRoute RouterRoute
 
-func (x *RouterMemo_Payload_Msg) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RouterMemo_Payload_Msg)(x)
+func (x *RouterRoute) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RouterRoute)(x)
 }
 
-func (x *RouterMemo_Payload_Msg) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[17]
+func (x *RouterRoute) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7007,43 +7146,43 @@ func (x *RouterMemo_Payload_Msg) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RouterMemo_Payload_Msg_messageType fastReflection_RouterMemo_Payload_Msg_messageType
-var _ protoreflect.MessageType = fastReflection_RouterMemo_Payload_Msg_messageType{}
+var _fastReflection_RouterRoute_messageType fastReflection_RouterRoute_messageType
+var _ protoreflect.MessageType = fastReflection_RouterRoute_messageType{}
 
-type fastReflection_RouterMemo_Payload_Msg_messageType struct{}
+type fastReflection_RouterRoute_messageType struct{}
 
-func (x fastReflection_RouterMemo_Payload_Msg_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RouterMemo_Payload_Msg)(nil)
+func (x fastReflection_RouterRoute_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RouterRoute)(nil)
 }
-func (x fastReflection_RouterMemo_Payload_Msg_messageType) New() protoreflect.Message {
-	return new(fastReflection_RouterMemo_Payload_Msg)
+func (x fastReflection_RouterRoute_messageType) New() protoreflect.Message {
+	return new(fastReflection_RouterRoute)
 }
-func (x fastReflection_RouterMemo_Payload_Msg_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RouterMemo_Payload_Msg
+func (x fastReflection_RouterRoute_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RouterRoute
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RouterMemo_Payload_Msg) Descriptor() protoreflect.MessageDescriptor {
-	return md_RouterMemo_Payload_Msg
+func (x *fastReflection_RouterRoute) Descriptor() protoreflect.MessageDescriptor {
+	return md_RouterRoute
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RouterMemo_Payload_Msg) Type() protoreflect.MessageType {
-	return _fastReflection_RouterMemo_Payload_Msg_messageType
+func (x *fastReflection_RouterRoute) Type() protoreflect.MessageType {
+	return _fastReflection_RouterRoute_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RouterMemo_Payload_Msg) New() protoreflect.Message {
-	return new(fastReflection_RouterMemo_Payload_Msg)
+func (x *fastReflection_RouterRoute) New() protoreflect.Message {
+	return new(fastReflection_RouterRoute)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RouterMemo_Payload_Msg) Interface() protoreflect.ProtoMessage {
-	return (*RouterMemo_Payload_Msg)(x)
+func (x *fastReflection_RouterRoute) Interface() protoreflect.ProtoMessage {
+	return (*RouterRoute)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7051,10 +7190,28 @@ func (x *fastReflection_RouterMemo_Payload_Msg) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RouterMemo_Payload_Msg) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ReceiveBandData != nil {
-		value := protoreflect.ValueOfMessage(x.ReceiveBandData.ProtoReflect())
-		if !f(fd_RouterMemo_Payload_Msg_receive_band_data, value) {
+func (x *fastReflection_RouterRoute) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DestinationChainId != "" {
+		value := protoreflect.ValueOfString(x.DestinationChainId)
+		if !f(fd_RouterRoute_destination_chain_id, value) {
+			return
+		}
+	}
+	if x.DestinationContractAddress != "" {
+		value := protoreflect.ValueOfString(x.DestinationContractAddress)
+		if !f(fd_RouterRoute_destination_contract_address, value) {
+			return
+		}
+	}
+	if x.DestinationGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DestinationGasLimit)
+		if !f(fd_RouterRoute_destination_gas_limit, value) {
+			return
+		}
+	}
+	if x.Encoder != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Encoder))
+		if !f(fd_RouterRoute_encoder, value) {
 			return
 		}
 	}
@@ -7071,15 +7228,21 @@ func (x *fastReflection_RouterMemo_Payload_Msg) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RouterMemo_Payload_Msg) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RouterRoute) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.receive_band_data":
-		return x.ReceiveBandData != nil
+	case "band.tunnel.v1beta1.RouterRoute.destination_chain_id":
+		return x.DestinationChainId != ""
+	case "band.tunnel.v1beta1.RouterRoute.destination_contract_address":
+		return x.DestinationContractAddress != ""
+	case "band.tunnel.v1beta1.RouterRoute.destination_gas_limit":
+		return x.DestinationGasLimit != uint64(0)
+	case "band.tunnel.v1beta1.RouterRoute.encoder":
+		return x.Encoder != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterRoute"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterRoute does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7089,15 +7252,21 @@ func (x *fastReflection_RouterMemo_Payload_Msg) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterMemo_Payload_Msg) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RouterRoute) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.receive_band_data":
-		x.ReceiveBandData = nil
+	case "band.tunnel.v1beta1.RouterRoute.destination_chain_id":
+		x.DestinationChainId = ""
+	case "band.tunnel.v1beta1.RouterRoute.destination_contract_address":
+		x.DestinationContractAddress = ""
+	case "band.tunnel.v1beta1.RouterRoute.destination_gas_limit":
+		x.DestinationGasLimit = uint64(0)
+	case "band.tunnel.v1beta1.RouterRoute.encoder":
+		x.Encoder = 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterRoute"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterRoute does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7107,16 +7276,25 @@ func (x *fastReflection_RouterMemo_Payload_Msg) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RouterMemo_Payload_Msg) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RouterRoute) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.receive_band_data":
-		value := x.ReceiveBandData
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.tunnel.v1beta1.RouterRoute.destination_chain_id":
+		value := x.DestinationChainId
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.RouterRoute.destination_contract_address":
+		value := x.DestinationContractAddress
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.RouterRoute.destination_gas_limit":
+		value := x.DestinationGasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.RouterRoute.encoder":
+		value := x.Encoder
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterRoute"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterRoute does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7130,15 +7308,21 @@ func (x *fastReflection_RouterMemo_Payload_Msg) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterMemo_Payload_Msg) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RouterRoute) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.receive_band_data":
-		x.ReceiveBandData = value.Message().Interface().(*RouterMemo_Payload_Msg_ReceiveBandDataArgs)
+	case "band.tunnel.v1beta1.RouterRoute.destination_chain_id":
+		x.DestinationChainId = value.Interface().(string)
+	case "band.tunnel.v1beta1.RouterRoute.destination_contract_address":
+		x.DestinationContractAddress = value.Interface().(string)
+	case "band.tunnel.v1beta1.RouterRoute.destination_gas_limit":
+		x.DestinationGasLimit = value.Uint()
+	case "band.tunnel.v1beta1.RouterRoute.encoder":
+		x.Encoder = (v1beta1.Encoder)(value.Enum())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterRoute"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterRoute does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7152,44 +7336,52 @@ func (x *fastReflection_RouterMemo_Payload_Msg) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterMemo_Payload_Msg) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RouterRoute) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.receive_band_data":
-		if x.ReceiveBandData == nil {
-			x.ReceiveBandData = new(RouterMemo_Payload_Msg_ReceiveBandDataArgs)
-		}
-		return protoreflect.ValueOfMessage(x.ReceiveBandData.ProtoReflect())
+	case "band.tunnel.v1beta1.RouterRoute.destination_chain_id":
+		panic(fmt.Errorf("field destination_chain_id of message band.tunnel.v1beta1.RouterRoute is not mutable"))
+	case "band.tunnel.v1beta1.RouterRoute.destination_contract_address":
+		panic(fmt.Errorf("field destination_contract_address of message band.tunnel.v1beta1.RouterRoute is not mutable"))
+	case "band.tunnel.v1beta1.RouterRoute.destination_gas_limit":
+		panic(fmt.Errorf("field destination_gas_limit of message band.tunnel.v1beta1.RouterRoute is not mutable"))
+	case "band.tunnel.v1beta1.RouterRoute.encoder":
+		panic(fmt.Errorf("field encoder of message band.tunnel.v1beta1.RouterRoute is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterRoute"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterRoute does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RouterMemo_Payload_Msg) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RouterRoute) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.receive_band_data":
-		m := new(RouterMemo_Payload_Msg_ReceiveBandDataArgs)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.tunnel.v1beta1.RouterRoute.destination_chain_id":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.RouterRoute.destination_contract_address":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.RouterRoute.destination_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.RouterRoute.encoder":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterRoute"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterRoute does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RouterMemo_Payload_Msg) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RouterRoute) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.RouterMemo.Payload.Msg", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.RouterRoute", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7197,7 +7389,7 @@ func (x *fastReflection_RouterMemo_Payload_Msg) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RouterMemo_Payload_Msg) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RouterRoute) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7208,7 +7400,7 @@ func (x *fastReflection_RouterMemo_Payload_Msg) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterMemo_Payload_Msg) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RouterRoute) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7220,7 +7412,7 @@ func (x *fastReflection_RouterMemo_Payload_Msg) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RouterMemo_Payload_Msg) IsValid() bool {
+func (x *fastReflection_RouterRoute) IsValid() bool {
 	return x != nil
 }
 
@@ -7230,9 +7422,9 @@ func (x *fastReflection_RouterMemo_Payload_Msg) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RouterMemo_Payload_Msg) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RouterRoute) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RouterMemo_Payload_Msg)
+		x := input.Message.Interface().(*RouterRoute)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7244,10 +7436,20 @@ func (x *fastReflection_RouterMemo_Payload_Msg) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if x.ReceiveBandData != nil {
-			l = options.Size(x.ReceiveBandData)
+		l = len(x.DestinationChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DestinationContractAddress)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.DestinationGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.DestinationGasLimit))
+		}
+		if x.Encoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.Encoder))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7258,7 +7460,7 @@ func (x *fastReflection_RouterMemo_Payload_Msg) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RouterMemo_Payload_Msg)
+		x := input.Message.Interface().(*RouterRoute)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7277,17 +7479,27 @@ func (x *fastReflection_RouterMemo_Payload_Msg) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ReceiveBandData != nil {
-			encoded, err := options.Marshal(x.ReceiveBandData)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.Encoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Encoder))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.DestinationGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DestinationGasLimit))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.DestinationContractAddress) > 0 {
+			i -= len(x.DestinationContractAddress)
+			copy(dAtA[i:], x.DestinationContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.DestinationChainId) > 0 {
+			i -= len(x.DestinationChainId)
+			copy(dAtA[i:], x.DestinationChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationChainId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7302,7 +7514,7 @@ func (x *fastReflection_RouterMemo_Payload_Msg) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RouterMemo_Payload_Msg)
+		x := input.Message.Interface().(*RouterRoute)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7334,17 +7546,17 @@ func (x *fastReflection_RouterMemo_Payload_Msg) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterMemo_Payload_Msg: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterRoute: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterMemo_Payload_Msg: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiveBandData", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationChainId", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7354,28 +7566,94 @@ func (x *fastReflection_RouterMemo_Payload_Msg) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ReceiveBandData == nil {
-					x.ReceiveBandData = &RouterMemo_Payload_Msg_ReceiveBandDataArgs{}
+				x.DestinationChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationContractAddress", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReceiveBandData); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				x.DestinationContractAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationGasLimit", wireType)
+				}
+				x.DestinationGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DestinationGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+				}
+				x.Encoder = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Encoder |= v1beta1.Encoder(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7412,32 +7690,26 @@ func (x *fastReflection_RouterMemo_Payload_Msg) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_RouterMemo_Payload_Msg_ReceiveBandDataArgs                       protoreflect.MessageDescriptor
-	fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_dest_chain_id         protoreflect.FieldDescriptor
-	fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_dest_contract_address protoreflect.FieldDescriptor
-	fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_gas_limit             protoreflect.FieldDescriptor
-	fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_payload               protoreflect.FieldDescriptor
+	md_RouterPacketReceipt          protoreflect.MessageDescriptor
+	fd_RouterPacketReceipt_sequence protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_route_proto_init()
-	md_RouterMemo_Payload_Msg_ReceiveBandDataArgs = File_band_tunnel_v1beta1_route_proto.Messages().ByName("RouterMemo").Messages().ByName("Payload").Messages().ByName("Msg").Messages().ByName("ReceiveBandDataArgs")
-	fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_dest_chain_id = md_RouterMemo_Payload_Msg_ReceiveBandDataArgs.Fields().ByName("dest_chain_id")
-	fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_dest_contract_address = md_RouterMemo_Payload_Msg_ReceiveBandDataArgs.Fields().ByName("dest_contract_address")
-	fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_gas_limit = md_RouterMemo_Payload_Msg_ReceiveBandDataArgs.Fields().ByName("gas_limit")
-	fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_payload = md_RouterMemo_Payload_Msg_ReceiveBandDataArgs.Fields().ByName("payload")
+	md_RouterPacketReceipt = File_band_tunnel_v1beta1_route_proto.Messages().ByName("RouterPacketReceipt")
+	fd_RouterPacketReceipt_sequence = md_RouterPacketReceipt.Fields().ByName("sequence")
 }
 
-var _ protoreflect.Message = (*fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs)(nil)
+var _ protoreflect.Message = (*fastReflection_RouterPacketReceipt)(nil)
 
-type fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs RouterMemo_Payload_Msg_ReceiveBandDataArgs
+type fastReflection_RouterPacketReceipt RouterPacketReceipt
 
-func (x *RouterMemo_Payload_Msg_ReceiveBandDataArgs) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs)(x)
+func (x *RouterPacketReceipt) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RouterPacketReceipt)(x)
 }
 
-func (x *RouterMemo_Payload_Msg_ReceiveBandDataArgs) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[18]
+func (x *RouterPacketReceipt) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7448,43 +7720,43 @@ func (x *RouterMemo_Payload_Msg_ReceiveBandDataArgs) slowProtoReflect() protoref
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs_messageType fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs_messageType
-var _ protoreflect.MessageType = fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs_messageType{}
+var _fastReflection_RouterPacketReceipt_messageType fastReflection_RouterPacketReceipt_messageType
+var _ protoreflect.MessageType = fastReflection_RouterPacketReceipt_messageType{}
 
-type fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs_messageType struct{}
+type fastReflection_RouterPacketReceipt_messageType struct{}
 
-func (x fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs)(nil)
+func (x fastReflection_RouterPacketReceipt_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RouterPacketReceipt)(nil)
 }
-func (x fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs_messageType) New() protoreflect.Message {
-	return new(fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs)
+func (x fastReflection_RouterPacketReceipt_messageType) New() protoreflect.Message {
+	return new(fastReflection_RouterPacketReceipt)
 }
-func (x fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RouterMemo_Payload_Msg_ReceiveBandDataArgs
+func (x fastReflection_RouterPacketReceipt_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RouterPacketReceipt
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Descriptor() protoreflect.MessageDescriptor {
-	return md_RouterMemo_Payload_Msg_ReceiveBandDataArgs
+func (x *fastReflection_RouterPacketReceipt) Descriptor() protoreflect.MessageDescriptor {
+	return md_RouterPacketReceipt
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Type() protoreflect.MessageType {
-	return _fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs_messageType
+func (x *fastReflection_RouterPacketReceipt) Type() protoreflect.MessageType {
+	return _fastReflection_RouterPacketReceipt_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) New() protoreflect.Message {
-	return new(fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs)
+func (x *fastReflection_RouterPacketReceipt) New() protoreflect.Message {
+	return new(fastReflection_RouterPacketReceipt)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Interface() protoreflect.ProtoMessage {
-	return (*RouterMemo_Payload_Msg_ReceiveBandDataArgs)(x)
+func (x *fastReflection_RouterPacketReceipt) Interface() protoreflect.ProtoMessage {
+	return (*RouterPacketReceipt)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7492,28 +7764,10 @@ func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Interface()
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DestChainId != "" {
-		value := protoreflect.ValueOfString(x.DestChainId)
-		if !f(fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_dest_chain_id, value) {
-			return
-		}
-	}
-	if x.DestContractAddress != "" {
-		value := protoreflect.ValueOfString(x.DestContractAddress)
-		if !f(fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_dest_contract_address, value) {
-			return
-		}
-	}
-	if x.GasLimit != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.GasLimit)
-		if !f(fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_gas_limit, value) {
-			return
-		}
-	}
-	if x.Payload != "" {
-		value := protoreflect.ValueOfString(x.Payload)
-		if !f(fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_payload, value) {
+func (x *fastReflection_RouterPacketReceipt) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_RouterPacketReceipt_sequence, value) {
 			return
 		}
 	}
@@ -7530,21 +7784,15 @@ func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Range(f func
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RouterPacketReceipt) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_chain_id":
-		return x.DestChainId != ""
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_contract_address":
-		return x.DestContractAddress != ""
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.gas_limit":
-		return x.GasLimit != uint64(0)
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.payload":
-		return x.Payload != ""
+	case "band.tunnel.v1beta1.RouterPacketReceipt.sequence":
+		return x.Sequence != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7554,21 +7802,15 @@ func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Has(fd proto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RouterPacketReceipt) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_chain_id":
-		x.DestChainId = ""
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_contract_address":
-		x.DestContractAddress = ""
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.gas_limit":
-		x.GasLimit = uint64(0)
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.payload":
-		x.Payload = ""
+	case "band.tunnel.v1beta1.RouterPacketReceipt.sequence":
+		x.Sequence = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7578,25 +7820,16 @@ func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Clear(fd pro
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RouterPacketReceipt) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_chain_id":
-		value := x.DestChainId
-		return protoreflect.ValueOfString(value)
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_contract_address":
-		value := x.DestContractAddress
-		return protoreflect.ValueOfString(value)
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.gas_limit":
-		value := x.GasLimit
+	case "band.tunnel.v1beta1.RouterPacketReceipt.sequence":
+		value := x.Sequence
 		return protoreflect.ValueOfUint64(value)
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.payload":
-		value := x.Payload
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterPacketReceipt does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7610,21 +7843,15 @@ func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Get(descript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RouterPacketReceipt) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_chain_id":
-		x.DestChainId = value.Interface().(string)
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_contract_address":
-		x.DestContractAddress = value.Interface().(string)
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.gas_limit":
-		x.GasLimit = value.Uint()
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.payload":
-		x.Payload = value.Interface().(string)
+	case "band.tunnel.v1beta1.RouterPacketReceipt.sequence":
+		x.Sequence = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7638,52 +7865,40 @@ func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Set(fd proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RouterPacketReceipt) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_chain_id":
-		panic(fmt.Errorf("field dest_chain_id of message band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs is not mutable"))
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_contract_address":
-		panic(fmt.Errorf("field dest_contract_address of message band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs is not mutable"))
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.gas_limit":
-		panic(fmt.Errorf("field gas_limit of message band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs is not mutable"))
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.payload":
-		panic(fmt.Errorf("field payload of message band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs is not mutable"))
+	case "band.tunnel.v1beta1.RouterPacketReceipt.sequence":
+		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.RouterPacketReceipt is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RouterPacketReceipt) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_chain_id":
-		return protoreflect.ValueOfString("")
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_contract_address":
-		return protoreflect.ValueOfString("")
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.gas_limit":
+	case "band.tunnel.v1beta1.RouterPacketReceipt.sequence":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.payload":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RouterPacketReceipt) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.RouterPacketReceipt", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7691,7 +7906,7 @@ func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) WhichOneof(d
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RouterPacketReceipt) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7702,7 +7917,7 @@ func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) GetUnknown()
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RouterPacketReceipt) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7714,7 +7929,7 @@ func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) SetUnknown(f
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) IsValid() bool {
+func (x *fastReflection_RouterPacketReceipt) IsValid() bool {
 	return x != nil
 }
 
@@ -7724,9 +7939,9 @@ func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) IsValid() bo
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RouterPacketReceipt) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RouterMemo_Payload_Msg_ReceiveBandDataArgs)
+		x := input.Message.Interface().(*RouterPacketReceipt)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7738,20 +7953,8 @@ func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) ProtoMethods
 		var n int
 		var l int
 		_ = l
-		l = len(x.DestChainId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.DestContractAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.GasLimit != 0 {
-			n += 1 + runtime.Sov(uint64(x.GasLimit))
-		}
-		l = len(x.Payload)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7763,7 +7966,7 @@ func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) ProtoMethods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RouterMemo_Payload_Msg_ReceiveBandDataArgs)
+		x := input.Message.Interface().(*RouterPacketReceipt)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7782,31 +7985,10 @@ func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) ProtoMethods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Payload) > 0 {
-			i -= len(x.Payload)
-			copy(dAtA[i:], x.Payload)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payload)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.GasLimit != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasLimit))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.DestContractAddress) > 0 {
-			i -= len(x.DestContractAddress)
-			copy(dAtA[i:], x.DestContractAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestContractAddress)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.DestChainId) > 0 {
-			i -= len(x.DestChainId)
-			copy(dAtA[i:], x.DestChainId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestChainId)))
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7819,7 +8001,7 @@ func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) ProtoMethods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RouterMemo_Payload_Msg_ReceiveBandDataArgs)
+		x := input.Message.Interface().(*RouterPacketReceipt)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7851,49 +8033,17 @@ func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) ProtoMethods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterMemo_Payload_Msg_ReceiveBandDataArgs: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterPacketReceipt: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterMemo_Payload_Msg_ReceiveBandDataArgs: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DestChainId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestContractAddress", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 				}
-				var stringLen uint64
+				x.Sequence = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7903,46 +8053,3334 @@ func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) ProtoMethods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Sequence |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DestContractAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
-				x.GasLimit = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.GasLimit |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RouterMemo      protoreflect.MessageDescriptor
+	fd_RouterMemo_wasm protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_route_proto_init()
+	md_RouterMemo = File_band_tunnel_v1beta1_route_proto.Messages().ByName("RouterMemo")
+	fd_RouterMemo_wasm = md_RouterMemo.Fields().ByName("wasm")
+}
+
+var _ protoreflect.Message = (*fastReflection_RouterMemo)(nil)
+
+type fastReflection_RouterMemo RouterMemo
+
+func (x *RouterMemo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RouterMemo)(x)
+}
+
+func (x *RouterMemo) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RouterMemo_messageType fastReflection_RouterMemo_messageType
+var _ protoreflect.MessageType = fastReflection_RouterMemo_messageType{}
+
+type fastReflection_RouterMemo_messageType struct{}
+
+func (x fastReflection_RouterMemo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RouterMemo)(nil)
+}
+func (x fastReflection_RouterMemo_messageType) New() protoreflect.Message {
+	return new(fastReflection_RouterMemo)
+}
+func (x fastReflection_RouterMemo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RouterMemo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RouterMemo) Descriptor() protoreflect.MessageDescriptor {
+	return md_RouterMemo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RouterMemo) Type() protoreflect.MessageType {
+	return _fastReflection_RouterMemo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RouterMemo) New() protoreflect.Message {
+	return new(fastReflection_RouterMemo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RouterMemo) Interface() protoreflect.ProtoMessage {
+	return (*RouterMemo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RouterMemo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Wasm != nil {
+		value := protoreflect.ValueOfMessage(x.Wasm.ProtoReflect())
+		if !f(fd_RouterMemo_wasm, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RouterMemo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.wasm":
+		return x.Wasm != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RouterMemo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.wasm":
+		x.Wasm = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RouterMemo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.wasm":
+		value := x.Wasm
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RouterMemo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.wasm":
+		x.Wasm = value.Message().Interface().(*RouterMemo_Payload)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RouterMemo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.wasm":
+		if x.Wasm == nil {
+			x.Wasm = new(RouterMemo_Payload)
+		}
+		return protoreflect.ValueOfMessage(x.Wasm.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RouterMemo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.wasm":
+		m := new(RouterMemo_Payload)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RouterMemo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.RouterMemo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RouterMemo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RouterMemo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RouterMemo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RouterMemo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RouterMemo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Wasm != nil {
+			l = options.Size(x.Wasm)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RouterMemo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Wasm != nil {
+			encoded, err := options.Marshal(x.Wasm)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RouterMemo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterMemo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterMemo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Wasm == nil {
+					x.Wasm = &RouterMemo_Payload{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Wasm); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RouterMemo_Payload          protoreflect.MessageDescriptor
+	fd_RouterMemo_Payload_contract protoreflect.FieldDescriptor
+	fd_RouterMemo_Payload_msg      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_route_proto_init()
+	md_RouterMemo_Payload = File_band_tunnel_v1beta1_route_proto.Messages().ByName("RouterMemo").Messages().ByName("Payload")
+	fd_RouterMemo_Payload_contract = md_RouterMemo_Payload.Fields().ByName("contract")
+	fd_RouterMemo_Payload_msg = md_RouterMemo_Payload.Fields().ByName("msg")
+}
+
+var _ protoreflect.Message = (*fastReflection_RouterMemo_Payload)(nil)
+
+type fastReflection_RouterMemo_Payload RouterMemo_Payload
+
+func (x *RouterMemo_Payload) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RouterMemo_Payload)(x)
+}
+
+func (x *RouterMemo_Payload) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RouterMemo_Payload_messageType fastReflection_RouterMemo_Payload_messageType
+var _ protoreflect.MessageType = fastReflection_RouterMemo_Payload_messageType{}
+
+type fastReflection_RouterMemo_Payload_messageType struct{}
+
+func (x fastReflection_RouterMemo_Payload_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RouterMemo_Payload)(nil)
+}
+func (x fastReflection_RouterMemo_Payload_messageType) New() protoreflect.Message {
+	return new(fastReflection_RouterMemo_Payload)
+}
+func (x fastReflection_RouterMemo_Payload_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RouterMemo_Payload
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RouterMemo_Payload) Descriptor() protoreflect.MessageDescriptor {
+	return md_RouterMemo_Payload
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RouterMemo_Payload) Type() protoreflect.MessageType {
+	return _fastReflection_RouterMemo_Payload_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RouterMemo_Payload) New() protoreflect.Message {
+	return new(fastReflection_RouterMemo_Payload)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RouterMemo_Payload) Interface() protoreflect.ProtoMessage {
+	return (*RouterMemo_Payload)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RouterMemo_Payload) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Contract != "" {
+		value := protoreflect.ValueOfString(x.Contract)
+		if !f(fd_RouterMemo_Payload_contract, value) {
+			return
+		}
+	}
+	if x.Msg != nil {
+		value := protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
+		if !f(fd_RouterMemo_Payload_msg, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RouterMemo_Payload) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.Payload.contract":
+		return x.Contract != ""
+	case "band.tunnel.v1beta1.RouterMemo.Payload.msg":
+		return x.Msg != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RouterMemo_Payload) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.Payload.contract":
+		x.Contract = ""
+	case "band.tunnel.v1beta1.RouterMemo.Payload.msg":
+		x.Msg = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RouterMemo_Payload) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.Payload.contract":
+		value := x.Contract
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.RouterMemo.Payload.msg":
+		value := x.Msg
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RouterMemo_Payload) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.Payload.contract":
+		x.Contract = value.Interface().(string)
+	case "band.tunnel.v1beta1.RouterMemo.Payload.msg":
+		x.Msg = value.Message().Interface().(*RouterMemo_Payload_Msg)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RouterMemo_Payload) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.Payload.msg":
+		if x.Msg == nil {
+			x.Msg = new(RouterMemo_Payload_Msg)
+		}
+		return protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
+	case "band.tunnel.v1beta1.RouterMemo.Payload.contract":
+		panic(fmt.Errorf("field contract of message band.tunnel.v1beta1.RouterMemo.Payload is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RouterMemo_Payload) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.Payload.contract":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.RouterMemo.Payload.msg":
+		m := new(RouterMemo_Payload_Msg)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RouterMemo_Payload) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.RouterMemo.Payload", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RouterMemo_Payload) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RouterMemo_Payload) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RouterMemo_Payload) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RouterMemo_Payload) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RouterMemo_Payload)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Contract)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Msg != nil {
+			l = options.Size(x.Msg)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RouterMemo_Payload)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Msg != nil {
+			encoded, err := options.Marshal(x.Msg)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Contract) > 0 {
+			i -= len(x.Contract)
+			copy(dAtA[i:], x.Contract)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Contract)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RouterMemo_Payload)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterMemo_Payload: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterMemo_Payload: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Contract = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Msg == nil {
+					x.Msg = &RouterMemo_Payload_Msg{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Msg); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RouterMemo_Payload_Msg                   protoreflect.MessageDescriptor
+	fd_RouterMemo_Payload_Msg_receive_band_data protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_route_proto_init()
+	md_RouterMemo_Payload_Msg = File_band_tunnel_v1beta1_route_proto.Messages().ByName("RouterMemo").Messages().ByName("Payload").Messages().ByName("Msg")
+	fd_RouterMemo_Payload_Msg_receive_band_data = md_RouterMemo_Payload_Msg.Fields().ByName("receive_band_data")
+}
+
+var _ protoreflect.Message = (*fastReflection_RouterMemo_Payload_Msg)(nil)
+
+type fastReflection_RouterMemo_Payload_Msg RouterMemo_Payload_Msg
+
+func (x *RouterMemo_Payload_Msg) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RouterMemo_Payload_Msg)(x)
+}
+
+func (x *RouterMemo_Payload_Msg) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RouterMemo_Payload_Msg_messageType fastReflection_RouterMemo_Payload_Msg_messageType
+var _ protoreflect.MessageType = fastReflection_RouterMemo_Payload_Msg_messageType{}
+
+type fastReflection_RouterMemo_Payload_Msg_messageType struct{}
+
+func (x fastReflection_RouterMemo_Payload_Msg_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RouterMemo_Payload_Msg)(nil)
+}
+func (x fastReflection_RouterMemo_Payload_Msg_messageType) New() protoreflect.Message {
+	return new(fastReflection_RouterMemo_Payload_Msg)
+}
+func (x fastReflection_RouterMemo_Payload_Msg_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RouterMemo_Payload_Msg
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RouterMemo_Payload_Msg) Descriptor() protoreflect.MessageDescriptor {
+	return md_RouterMemo_Payload_Msg
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RouterMemo_Payload_Msg) Type() protoreflect.MessageType {
+	return _fastReflection_RouterMemo_Payload_Msg_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RouterMemo_Payload_Msg) New() protoreflect.Message {
+	return new(fastReflection_RouterMemo_Payload_Msg)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RouterMemo_Payload_Msg) Interface() protoreflect.ProtoMessage {
+	return (*RouterMemo_Payload_Msg)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RouterMemo_Payload_Msg) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ReceiveBandData != nil {
+		value := protoreflect.ValueOfMessage(x.ReceiveBandData.ProtoReflect())
+		if !f(fd_RouterMemo_Payload_Msg_receive_band_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RouterMemo_Payload_Msg) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.receive_band_data":
+		return x.ReceiveBandData != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RouterMemo_Payload_Msg) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.receive_band_data":
+		x.ReceiveBandData = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RouterMemo_Payload_Msg) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.receive_band_data":
+		value := x.ReceiveBandData
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RouterMemo_Payload_Msg) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.receive_band_data":
+		x.ReceiveBandData = value.Message().Interface().(*RouterMemo_Payload_Msg_ReceiveBandDataArgs)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RouterMemo_Payload_Msg) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.receive_band_data":
+		if x.ReceiveBandData == nil {
+			x.ReceiveBandData = new(RouterMemo_Payload_Msg_ReceiveBandDataArgs)
+		}
+		return protoreflect.ValueOfMessage(x.ReceiveBandData.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RouterMemo_Payload_Msg) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.receive_band_data":
+		m := new(RouterMemo_Payload_Msg_ReceiveBandDataArgs)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RouterMemo_Payload_Msg) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.RouterMemo.Payload.Msg", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RouterMemo_Payload_Msg) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RouterMemo_Payload_Msg) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RouterMemo_Payload_Msg) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RouterMemo_Payload_Msg) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RouterMemo_Payload_Msg)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ReceiveBandData != nil {
+			l = options.Size(x.ReceiveBandData)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RouterMemo_Payload_Msg)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ReceiveBandData != nil {
+			encoded, err := options.Marshal(x.ReceiveBandData)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RouterMemo_Payload_Msg)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterMemo_Payload_Msg: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterMemo_Payload_Msg: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiveBandData", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ReceiveBandData == nil {
+					x.ReceiveBandData = &RouterMemo_Payload_Msg_ReceiveBandDataArgs{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReceiveBandData); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RouterMemo_Payload_Msg_ReceiveBandDataArgs                       protoreflect.MessageDescriptor
+	fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_dest_chain_id         protoreflect.FieldDescriptor
+	fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_dest_contract_address protoreflect.FieldDescriptor
+	fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_gas_limit             protoreflect.FieldDescriptor
+	fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_payload               protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_route_proto_init()
+	md_RouterMemo_Payload_Msg_ReceiveBandDataArgs = File_band_tunnel_v1beta1_route_proto.Messages().ByName("RouterMemo").Messages().ByName("Payload").Messages().ByName("Msg").Messages().ByName("ReceiveBandDataArgs")
+	fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_dest_chain_id = md_RouterMemo_Payload_Msg_ReceiveBandDataArgs.Fields().ByName("dest_chain_id")
+	fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_dest_contract_address = md_RouterMemo_Payload_Msg_ReceiveBandDataArgs.Fields().ByName("dest_contract_address")
+	fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_gas_limit = md_RouterMemo_Payload_Msg_ReceiveBandDataArgs.Fields().ByName("gas_limit")
+	fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_payload = md_RouterMemo_Payload_Msg_ReceiveBandDataArgs.Fields().ByName("payload")
+}
+
+var _ protoreflect.Message = (*fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs)(nil)
+
+type fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs RouterMemo_Payload_Msg_ReceiveBandDataArgs
+
+func (x *RouterMemo_Payload_Msg_ReceiveBandDataArgs) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs)(x)
+}
+
+func (x *RouterMemo_Payload_Msg_ReceiveBandDataArgs) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs_messageType fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs_messageType
+var _ protoreflect.MessageType = fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs_messageType{}
+
+type fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs_messageType struct{}
+
+func (x fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs)(nil)
+}
+func (x fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs_messageType) New() protoreflect.Message {
+	return new(fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs)
+}
+func (x fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RouterMemo_Payload_Msg_ReceiveBandDataArgs
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Descriptor() protoreflect.MessageDescriptor {
+	return md_RouterMemo_Payload_Msg_ReceiveBandDataArgs
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Type() protoreflect.MessageType {
+	return _fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) New() protoreflect.Message {
+	return new(fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Interface() protoreflect.ProtoMessage {
+	return (*RouterMemo_Payload_Msg_ReceiveBandDataArgs)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DestChainId != "" {
+		value := protoreflect.ValueOfString(x.DestChainId)
+		if !f(fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_dest_chain_id, value) {
+			return
+		}
+	}
+	if x.DestContractAddress != "" {
+		value := protoreflect.ValueOfString(x.DestContractAddress)
+		if !f(fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_dest_contract_address, value) {
+			return
+		}
+	}
+	if x.GasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasLimit)
+		if !f(fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_gas_limit, value) {
+			return
+		}
+	}
+	if x.Payload != "" {
+		value := protoreflect.ValueOfString(x.Payload)
+		if !f(fd_RouterMemo_Payload_Msg_ReceiveBandDataArgs_payload, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_chain_id":
+		return x.DestChainId != ""
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_contract_address":
+		return x.DestContractAddress != ""
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.gas_limit":
+		return x.GasLimit != uint64(0)
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.payload":
+		return x.Payload != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_chain_id":
+		x.DestChainId = ""
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_contract_address":
+		x.DestContractAddress = ""
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.gas_limit":
+		x.GasLimit = uint64(0)
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.payload":
+		x.Payload = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_chain_id":
+		value := x.DestChainId
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_contract_address":
+		value := x.DestContractAddress
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.gas_limit":
+		value := x.GasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.payload":
+		value := x.Payload
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_chain_id":
+		x.DestChainId = value.Interface().(string)
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_contract_address":
+		x.DestContractAddress = value.Interface().(string)
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.gas_limit":
+		x.GasLimit = value.Uint()
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.payload":
+		x.Payload = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_chain_id":
+		panic(fmt.Errorf("field dest_chain_id of message band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs is not mutable"))
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_contract_address":
+		panic(fmt.Errorf("field dest_contract_address of message band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs is not mutable"))
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.gas_limit":
+		panic(fmt.Errorf("field gas_limit of message band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs is not mutable"))
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.payload":
+		panic(fmt.Errorf("field payload of message band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_chain_id":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.dest_contract_address":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs.payload":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RouterMemo_Payload_Msg_ReceiveBandDataArgs)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.DestChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DestContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasLimit))
+		}
+		l = len(x.Payload)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RouterMemo_Payload_Msg_ReceiveBandDataArgs)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Payload) > 0 {
+			i -= len(x.Payload)
+			copy(dAtA[i:], x.Payload)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payload)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.GasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasLimit))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.DestContractAddress) > 0 {
+			i -= len(x.DestContractAddress)
+			copy(dAtA[i:], x.DestContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.DestChainId) > 0 {
+			i -= len(x.DestChainId)
+			copy(dAtA[i:], x.DestChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestChainId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RouterMemo_Payload_Msg_ReceiveBandDataArgs)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterMemo_Payload_Msg_ReceiveBandDataArgs: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RouterMemo_Payload_Msg_ReceiveBandDataArgs: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DestChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DestContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				}
+				x.GasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Payload = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AxelarRoute                              protoreflect.MessageDescriptor
+	fd_AxelarRoute_destination_chain_id         protoreflect.FieldDescriptor
+	fd_AxelarRoute_destination_contract_address protoreflect.FieldDescriptor
+	fd_AxelarRoute_fee                          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_route_proto_init()
+	md_AxelarRoute = File_band_tunnel_v1beta1_route_proto.Messages().ByName("AxelarRoute")
+	fd_AxelarRoute_destination_chain_id = md_AxelarRoute.Fields().ByName("destination_chain_id")
+	fd_AxelarRoute_destination_contract_address = md_AxelarRoute.Fields().ByName("destination_contract_address")
+	fd_AxelarRoute_fee = md_AxelarRoute.Fields().ByName("fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_AxelarRoute)(nil)
+
+type fastReflection_AxelarRoute AxelarRoute
+
+func (x *AxelarRoute) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AxelarRoute)(x)
+}
+
+func (x *AxelarRoute) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AxelarRoute_messageType fastReflection_AxelarRoute_messageType
+var _ protoreflect.MessageType = fastReflection_AxelarRoute_messageType{}
+
+type fastReflection_AxelarRoute_messageType struct{}
+
+func (x fastReflection_AxelarRoute_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AxelarRoute)(nil)
+}
+func (x fastReflection_AxelarRoute_messageType) New() protoreflect.Message {
+	return new(fastReflection_AxelarRoute)
+}
+func (x fastReflection_AxelarRoute_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AxelarRoute
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AxelarRoute) Descriptor() protoreflect.MessageDescriptor {
+	return md_AxelarRoute
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AxelarRoute) Type() protoreflect.MessageType {
+	return _fastReflection_AxelarRoute_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AxelarRoute) New() protoreflect.Message {
+	return new(fastReflection_AxelarRoute)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AxelarRoute) Interface() protoreflect.ProtoMessage {
+	return (*AxelarRoute)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AxelarRoute) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DestinationChainId != "" {
+		value := protoreflect.ValueOfString(x.DestinationChainId)
+		if !f(fd_AxelarRoute_destination_chain_id, value) {
+			return
+		}
+	}
+	if x.DestinationContractAddress != "" {
+		value := protoreflect.ValueOfString(x.DestinationContractAddress)
+		if !f(fd_AxelarRoute_destination_contract_address, value) {
+			return
+		}
+	}
+	if x.Fee != nil {
+		value := protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
+		if !f(fd_AxelarRoute_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AxelarRoute) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.AxelarRoute.destination_chain_id":
+		return x.DestinationChainId != ""
+	case "band.tunnel.v1beta1.AxelarRoute.destination_contract_address":
+		return x.DestinationContractAddress != ""
+	case "band.tunnel.v1beta1.AxelarRoute.fee":
+		return x.Fee != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AxelarRoute) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.AxelarRoute.destination_chain_id":
+		x.DestinationChainId = ""
+	case "band.tunnel.v1beta1.AxelarRoute.destination_contract_address":
+		x.DestinationContractAddress = ""
+	case "band.tunnel.v1beta1.AxelarRoute.fee":
+		x.Fee = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AxelarRoute) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.AxelarRoute.destination_chain_id":
+		value := x.DestinationChainId
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.AxelarRoute.destination_contract_address":
+		value := x.DestinationContractAddress
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.AxelarRoute.fee":
+		value := x.Fee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarRoute does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AxelarRoute) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.AxelarRoute.destination_chain_id":
+		x.DestinationChainId = value.Interface().(string)
+	case "band.tunnel.v1beta1.AxelarRoute.destination_contract_address":
+		x.DestinationContractAddress = value.Interface().(string)
+	case "band.tunnel.v1beta1.AxelarRoute.fee":
+		x.Fee = value.Message().Interface().(*v1beta11.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AxelarRoute) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.AxelarRoute.fee":
+		if x.Fee == nil {
+			x.Fee = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
+	case "band.tunnel.v1beta1.AxelarRoute.destination_chain_id":
+		panic(fmt.Errorf("field destination_chain_id of message band.tunnel.v1beta1.AxelarRoute is not mutable"))
+	case "band.tunnel.v1beta1.AxelarRoute.destination_contract_address":
+		panic(fmt.Errorf("field destination_contract_address of message band.tunnel.v1beta1.AxelarRoute is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AxelarRoute) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.AxelarRoute.destination_chain_id":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.AxelarRoute.destination_contract_address":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.AxelarRoute.fee":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AxelarRoute) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.AxelarRoute", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AxelarRoute) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AxelarRoute) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AxelarRoute) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AxelarRoute) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AxelarRoute)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.DestinationChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DestinationContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Fee != nil {
+			l = options.Size(x.Fee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AxelarRoute)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Fee != nil {
+			encoded, err := options.Marshal(x.Fee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.DestinationContractAddress) > 0 {
+			i -= len(x.DestinationContractAddress)
+			copy(dAtA[i:], x.DestinationContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.DestinationChainId) > 0 {
+			i -= len(x.DestinationChainId)
+			copy(dAtA[i:], x.DestinationChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationChainId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AxelarRoute)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AxelarRoute: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AxelarRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DestinationChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DestinationContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Fee == nil {
+					x.Fee = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AxelarPacketReceipt          protoreflect.MessageDescriptor
+	fd_AxelarPacketReceipt_sequence protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_route_proto_init()
+	md_AxelarPacketReceipt = File_band_tunnel_v1beta1_route_proto.Messages().ByName("AxelarPacketReceipt")
+	fd_AxelarPacketReceipt_sequence = md_AxelarPacketReceipt.Fields().ByName("sequence")
+}
+
+var _ protoreflect.Message = (*fastReflection_AxelarPacketReceipt)(nil)
+
+type fastReflection_AxelarPacketReceipt AxelarPacketReceipt
+
+func (x *AxelarPacketReceipt) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AxelarPacketReceipt)(x)
+}
+
+func (x *AxelarPacketReceipt) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AxelarPacketReceipt_messageType fastReflection_AxelarPacketReceipt_messageType
+var _ protoreflect.MessageType = fastReflection_AxelarPacketReceipt_messageType{}
+
+type fastReflection_AxelarPacketReceipt_messageType struct{}
+
+func (x fastReflection_AxelarPacketReceipt_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AxelarPacketReceipt)(nil)
+}
+func (x fastReflection_AxelarPacketReceipt_messageType) New() protoreflect.Message {
+	return new(fastReflection_AxelarPacketReceipt)
+}
+func (x fastReflection_AxelarPacketReceipt_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AxelarPacketReceipt
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AxelarPacketReceipt) Descriptor() protoreflect.MessageDescriptor {
+	return md_AxelarPacketReceipt
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AxelarPacketReceipt) Type() protoreflect.MessageType {
+	return _fastReflection_AxelarPacketReceipt_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AxelarPacketReceipt) New() protoreflect.Message {
+	return new(fastReflection_AxelarPacketReceipt)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AxelarPacketReceipt) Interface() protoreflect.ProtoMessage {
+	return (*AxelarPacketReceipt)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AxelarPacketReceipt) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_AxelarPacketReceipt_sequence, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AxelarPacketReceipt) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.AxelarPacketReceipt.sequence":
+		return x.Sequence != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarPacketReceipt"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarPacketReceipt does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AxelarPacketReceipt) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.AxelarPacketReceipt.sequence":
+		x.Sequence = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarPacketReceipt"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarPacketReceipt does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AxelarPacketReceipt) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.AxelarPacketReceipt.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarPacketReceipt"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarPacketReceipt does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AxelarPacketReceipt) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.AxelarPacketReceipt.sequence":
+		x.Sequence = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarPacketReceipt"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarPacketReceipt does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AxelarPacketReceipt) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.AxelarPacketReceipt.sequence":
+		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.AxelarPacketReceipt is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarPacketReceipt"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarPacketReceipt does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AxelarPacketReceipt) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.AxelarPacketReceipt.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarPacketReceipt"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarPacketReceipt does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AxelarPacketReceipt) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.AxelarPacketReceipt", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AxelarPacketReceipt) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AxelarPacketReceipt) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AxelarPacketReceipt) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AxelarPacketReceipt) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AxelarPacketReceipt)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AxelarPacketReceipt)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AxelarPacketReceipt)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AxelarPacketReceipt: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AxelarPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_LocalRoute          protoreflect.MessageDescriptor
+	fd_LocalRoute_consumer protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_route_proto_init()
+	md_LocalRoute = File_band_tunnel_v1beta1_route_proto.Messages().ByName("LocalRoute")
+	fd_LocalRoute_consumer = md_LocalRoute.Fields().ByName("consumer")
+}
+
+var _ protoreflect.Message = (*fastReflection_LocalRoute)(nil)
+
+type fastReflection_LocalRoute LocalRoute
+
+func (x *LocalRoute) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LocalRoute)(x)
+}
+
+func (x *LocalRoute) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LocalRoute_messageType fastReflection_LocalRoute_messageType
+var _ protoreflect.MessageType = fastReflection_LocalRoute_messageType{}
+
+type fastReflection_LocalRoute_messageType struct{}
+
+func (x fastReflection_LocalRoute_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LocalRoute)(nil)
+}
+func (x fastReflection_LocalRoute_messageType) New() protoreflect.Message {
+	return new(fastReflection_LocalRoute)
+}
+func (x fastReflection_LocalRoute_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LocalRoute
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LocalRoute) Descriptor() protoreflect.MessageDescriptor {
+	return md_LocalRoute
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LocalRoute) Type() protoreflect.MessageType {
+	return _fastReflection_LocalRoute_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LocalRoute) New() protoreflect.Message {
+	return new(fastReflection_LocalRoute)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LocalRoute) Interface() protoreflect.ProtoMessage {
+	return (*LocalRoute)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LocalRoute) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Consumer != "" {
+		value := protoreflect.ValueOfString(x.Consumer)
+		if !f(fd_LocalRoute_consumer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LocalRoute) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.LocalRoute.consumer":
+		return x.Consumer != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LocalRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LocalRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LocalRoute) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.LocalRoute.consumer":
+		x.Consumer = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LocalRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LocalRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LocalRoute) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.LocalRoute.consumer":
+		value := x.Consumer
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LocalRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LocalRoute does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LocalRoute) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.LocalRoute.consumer":
+		x.Consumer = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LocalRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LocalRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LocalRoute) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.LocalRoute.consumer":
+		panic(fmt.Errorf("field consumer of message band.tunnel.v1beta1.LocalRoute is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LocalRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LocalRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LocalRoute) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.LocalRoute.consumer":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LocalRoute"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LocalRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LocalRoute) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.LocalRoute", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LocalRoute) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LocalRoute) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LocalRoute) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LocalRoute) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LocalRoute)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Consumer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LocalRoute)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Consumer) > 0 {
+			i -= len(x.Consumer)
+			copy(dAtA[i:], x.Consumer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Consumer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LocalRoute)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-			case 4:
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LocalRoute: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LocalRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7970,7 +11408,7 @@ func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) ProtoMethods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Payload = string(dAtA[iNdEx:postIndex])
+				x.Consumer = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8008,30 +11446,30 @@ func (x *fastReflection_RouterMemo_Payload_Msg_ReceiveBandDataArgs) ProtoMethods
 }
 
 var (
-	md_AxelarRoute                              protoreflect.MessageDescriptor
-	fd_AxelarRoute_destination_chain_id         protoreflect.FieldDescriptor
-	fd_AxelarRoute_destination_contract_address protoreflect.FieldDescriptor
-	fd_AxelarRoute_fee                          protoreflect.FieldDescriptor
+	md_LocalPacketReceipt               protoreflect.MessageDescriptor
+	fd_LocalPacketReceipt_success       protoreflect.FieldDescriptor
+	fd_LocalPacketReceipt_gas_used      protoreflect.FieldDescriptor
+	fd_LocalPacketReceipt_error_message protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_route_proto_init()
-	md_AxelarRoute = File_band_tunnel_v1beta1_route_proto.Messages().ByName("AxelarRoute")
-	fd_AxelarRoute_destination_chain_id = md_AxelarRoute.Fields().ByName("destination_chain_id")
-	fd_AxelarRoute_destination_contract_address = md_AxelarRoute.Fields().ByName("destination_contract_address")
-	fd_AxelarRoute_fee = md_AxelarRoute.Fields().ByName("fee")
+	md_LocalPacketReceipt = File_band_tunnel_v1beta1_route_proto.Messages().ByName("LocalPacketReceipt")
+	fd_LocalPacketReceipt_success = md_LocalPacketReceipt.Fields().ByName("success")
+	fd_LocalPacketReceipt_gas_used = md_LocalPacketReceipt.Fields().ByName("gas_used")
+	fd_LocalPacketReceipt_error_message = md_LocalPacketReceipt.Fields().ByName("error_message")
 }
 
-var _ protoreflect.Message = (*fastReflection_AxelarRoute)(nil)
+var _ protoreflect.Message = (*fastReflection_LocalPacketReceipt)(nil)
 
-type fastReflection_AxelarRoute AxelarRoute
+type fastReflection_LocalPacketReceipt LocalPacketReceipt
 
-func (x *AxelarRoute) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AxelarRoute)(x)
+func (x *LocalPacketReceipt) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LocalPacketReceipt)(x)
 }
 
-func (x *AxelarRoute) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[11]
+func (x *LocalPacketReceipt) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8042,43 +11480,43 @@ func (x *AxelarRoute) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AxelarRoute_messageType fastReflection_AxelarRoute_messageType
-var _ protoreflect.MessageType = fastReflection_AxelarRoute_messageType{}
+var _fastReflection_LocalPacketReceipt_messageType fastReflection_LocalPacketReceipt_messageType
+var _ protoreflect.MessageType = fastReflection_LocalPacketReceipt_messageType{}
 
-type fastReflection_AxelarRoute_messageType struct{}
+type fastReflection_LocalPacketReceipt_messageType struct{}
 
-func (x fastReflection_AxelarRoute_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AxelarRoute)(nil)
+func (x fastReflection_LocalPacketReceipt_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LocalPacketReceipt)(nil)
 }
-func (x fastReflection_AxelarRoute_messageType) New() protoreflect.Message {
-	return new(fastReflection_AxelarRoute)
+func (x fastReflection_LocalPacketReceipt_messageType) New() protoreflect.Message {
+	return new(fastReflection_LocalPacketReceipt)
 }
-func (x fastReflection_AxelarRoute_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AxelarRoute
+func (x fastReflection_LocalPacketReceipt_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LocalPacketReceipt
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AxelarRoute) Descriptor() protoreflect.MessageDescriptor {
-	return md_AxelarRoute
+func (x *fastReflection_LocalPacketReceipt) Descriptor() protoreflect.MessageDescriptor {
+	return md_LocalPacketReceipt
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AxelarRoute) Type() protoreflect.MessageType {
-	return _fastReflection_AxelarRoute_messageType
+func (x *fastReflection_LocalPacketReceipt) Type() protoreflect.MessageType {
+	return _fastReflection_LocalPacketReceipt_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AxelarRoute) New() protoreflect.Message {
-	return new(fastReflection_AxelarRoute)
+func (x *fastReflection_LocalPacketReceipt) New() protoreflect.Message {
+	return new(fastReflection_LocalPacketReceipt)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AxelarRoute) Interface() protoreflect.ProtoMessage {
-	return (*AxelarRoute)(x)
+func (x *fastReflection_LocalPacketReceipt) Interface() protoreflect.ProtoMessage {
+	return (*LocalPacketReceipt)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8086,22 +11524,22 @@ func (x *fastReflection_AxelarRoute) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AxelarRoute) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DestinationChainId != "" {
-		value := protoreflect.ValueOfString(x.DestinationChainId)
-		if !f(fd_AxelarRoute_destination_chain_id, value) {
+func (x *fastReflection_LocalPacketReceipt) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Success != false {
+		value := protoreflect.ValueOfBool(x.Success)
+		if !f(fd_LocalPacketReceipt_success, value) {
 			return
 		}
 	}
-	if x.DestinationContractAddress != "" {
-		value := protoreflect.ValueOfString(x.DestinationContractAddress)
-		if !f(fd_AxelarRoute_destination_contract_address, value) {
+	if x.GasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasUsed)
+		if !f(fd_LocalPacketReceipt_gas_used, value) {
 			return
 		}
 	}
-	if x.Fee != nil {
-		value := protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
-		if !f(fd_AxelarRoute_fee, value) {
+	if x.ErrorMessage != "" {
+		value := protoreflect.ValueOfString(x.ErrorMessage)
+		if !f(fd_LocalPacketReceipt_error_message, value) {
 			return
 		}
 	}
@@ -8118,19 +11556,19 @@ func (x *fastReflection_AxelarRoute) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AxelarRoute) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_LocalPacketReceipt) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.AxelarRoute.destination_chain_id":
-		return x.DestinationChainId != ""
-	case "band.tunnel.v1beta1.AxelarRoute.destination_contract_address":
-		return x.DestinationContractAddress != ""
-	case "band.tunnel.v1beta1.AxelarRoute.fee":
-		return x.Fee != nil
+	case "band.tunnel.v1beta1.LocalPacketReceipt.success":
+		return x.Success != false
+	case "band.tunnel.v1beta1.LocalPacketReceipt.gas_used":
+		return x.GasUsed != uint64(0)
+	case "band.tunnel.v1beta1.LocalPacketReceipt.error_message":
+		return x.ErrorMessage != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarRoute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LocalPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarRoute does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LocalPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8140,19 +11578,19 @@ func (x *fastReflection_AxelarRoute) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AxelarRoute) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_LocalPacketReceipt) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.AxelarRoute.destination_chain_id":
-		x.DestinationChainId = ""
-	case "band.tunnel.v1beta1.AxelarRoute.destination_contract_address":
-		x.DestinationContractAddress = ""
-	case "band.tunnel.v1beta1.AxelarRoute.fee":
-		x.Fee = nil
+	case "band.tunnel.v1beta1.LocalPacketReceipt.success":
+		x.Success = false
+	case "band.tunnel.v1beta1.LocalPacketReceipt.gas_used":
+		x.GasUsed = uint64(0)
+	case "band.tunnel.v1beta1.LocalPacketReceipt.error_message":
+		x.ErrorMessage = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarRoute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LocalPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarRoute does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LocalPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8162,22 +11600,22 @@ func (x *fastReflection_AxelarRoute) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AxelarRoute) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LocalPacketReceipt) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.AxelarRoute.destination_chain_id":
-		value := x.DestinationChainId
-		return protoreflect.ValueOfString(value)
-	case "band.tunnel.v1beta1.AxelarRoute.destination_contract_address":
-		value := x.DestinationContractAddress
+	case "band.tunnel.v1beta1.LocalPacketReceipt.success":
+		value := x.Success
+		return protoreflect.ValueOfBool(value)
+	case "band.tunnel.v1beta1.LocalPacketReceipt.gas_used":
+		value := x.GasUsed
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.LocalPacketReceipt.error_message":
+		value := x.ErrorMessage
 		return protoreflect.ValueOfString(value)
-	case "band.tunnel.v1beta1.AxelarRoute.fee":
-		value := x.Fee
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarRoute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LocalPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarRoute does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LocalPacketReceipt does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8191,19 +11629,19 @@ func (x *fastReflection_AxelarRoute) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AxelarRoute) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_LocalPacketReceipt) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.AxelarRoute.destination_chain_id":
-		x.DestinationChainId = value.Interface().(string)
-	case "band.tunnel.v1beta1.AxelarRoute.destination_contract_address":
-		x.DestinationContractAddress = value.Interface().(string)
-	case "band.tunnel.v1beta1.AxelarRoute.fee":
-		x.Fee = value.Message().Interface().(*v1beta11.Coin)
+	case "band.tunnel.v1beta1.LocalPacketReceipt.success":
+		x.Success = value.Bool()
+	case "band.tunnel.v1beta1.LocalPacketReceipt.gas_used":
+		x.GasUsed = value.Uint()
+	case "band.tunnel.v1beta1.LocalPacketReceipt.error_message":
+		x.ErrorMessage = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarRoute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LocalPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarRoute does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LocalPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8217,52 +11655,48 @@ func (x *fastReflection_AxelarRoute) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AxelarRoute) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LocalPacketReceipt) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.AxelarRoute.fee":
-		if x.Fee == nil {
-			x.Fee = new(v1beta11.Coin)
-		}
-		return protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
-	case "band.tunnel.v1beta1.AxelarRoute.destination_chain_id":
-		panic(fmt.Errorf("field destination_chain_id of message band.tunnel.v1beta1.AxelarRoute is not mutable"))
-	case "band.tunnel.v1beta1.AxelarRoute.destination_contract_address":
-		panic(fmt.Errorf("field destination_contract_address of message band.tunnel.v1beta1.AxelarRoute is not mutable"))
+	case "band.tunnel.v1beta1.LocalPacketReceipt.success":
+		panic(fmt.Errorf("field success of message band.tunnel.v1beta1.LocalPacketReceipt is not mutable"))
+	case "band.tunnel.v1beta1.LocalPacketReceipt.gas_used":
+		panic(fmt.Errorf("field gas_used of message band.tunnel.v1beta1.LocalPacketReceipt is not mutable"))
+	case "band.tunnel.v1beta1.LocalPacketReceipt.error_message":
+		panic(fmt.Errorf("field error_message of message band.tunnel.v1beta1.LocalPacketReceipt is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarRoute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LocalPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarRoute does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LocalPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AxelarRoute) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LocalPacketReceipt) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.AxelarRoute.destination_chain_id":
-		return protoreflect.ValueOfString("")
-	case "band.tunnel.v1beta1.AxelarRoute.destination_contract_address":
+	case "band.tunnel.v1beta1.LocalPacketReceipt.success":
+		return protoreflect.ValueOfBool(false)
+	case "band.tunnel.v1beta1.LocalPacketReceipt.gas_used":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.LocalPacketReceipt.error_message":
 		return protoreflect.ValueOfString("")
-	case "band.tunnel.v1beta1.AxelarRoute.fee":
-		m := new(v1beta11.Coin)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarRoute"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.LocalPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarRoute does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.LocalPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AxelarRoute) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_LocalPacketReceipt) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.AxelarRoute", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.LocalPacketReceipt", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8270,7 +11704,7 @@ func (x *fastReflection_AxelarRoute) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AxelarRoute) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_LocalPacketReceipt) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8281,7 +11715,7 @@ func (x *fastReflection_AxelarRoute) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AxelarRoute) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_LocalPacketReceipt) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8293,7 +11727,7 @@ func (x *fastReflection_AxelarRoute) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AxelarRoute) IsValid() bool {
+func (x *fastReflection_LocalPacketReceipt) IsValid() bool {
 	return x != nil
 }
 
@@ -8303,9 +11737,9 @@ func (x *fastReflection_AxelarRoute) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AxelarRoute) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_LocalPacketReceipt) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AxelarRoute)
+		x := input.Message.Interface().(*LocalPacketReceipt)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8317,16 +11751,14 @@ func (x *fastReflection_AxelarRoute) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.DestinationChainId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Success {
+			n += 2
 		}
-		l = len(x.DestinationContractAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.GasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasUsed))
 		}
-		if x.Fee != nil {
-			l = options.Size(x.Fee)
+		l = len(x.ErrorMessage)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -8339,7 +11771,7 @@ func (x *fastReflection_AxelarRoute) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AxelarRoute)
+		x := input.Message.Interface().(*LocalPacketReceipt)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8358,33 +11790,27 @@ func (x *fastReflection_AxelarRoute) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Fee != nil {
-			encoded, err := options.Marshal(x.Fee)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.ErrorMessage) > 0 {
+			i -= len(x.ErrorMessage)
+			copy(dAtA[i:], x.ErrorMessage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ErrorMessage)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.DestinationContractAddress) > 0 {
-			i -= len(x.DestinationContractAddress)
-			copy(dAtA[i:], x.DestinationContractAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationContractAddress)))
+		if x.GasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasUsed))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if len(x.DestinationChainId) > 0 {
-			i -= len(x.DestinationChainId)
-			copy(dAtA[i:], x.DestinationChainId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationChainId)))
+		if x.Success {
 			i--
-			dAtA[i] = 0xa
+			if x.Success {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8397,7 +11823,7 @@ func (x *fastReflection_AxelarRoute) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AxelarRoute)
+		x := input.Message.Interface().(*LocalPacketReceipt)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8429,17 +11855,17 @@ func (x *fastReflection_AxelarRoute) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AxelarRoute: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LocalPacketReceipt: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AxelarRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LocalPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationChainId", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 				}
-				var stringLen uint64
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8449,29 +11875,17 @@ func (x *fastReflection_AxelarRoute) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DestinationChainId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
+				x.Success = bool(v != 0)
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationContractAddress", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 				}
-				var stringLen uint64
+				x.GasUsed = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8481,29 +11895,16 @@ func (x *fastReflection_AxelarRoute) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.GasUsed |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DestinationContractAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8513,27 +11914,23 @@ func (x *fastReflection_AxelarRoute) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Fee == nil {
-					x.Fee = &v1beta11.Coin{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.ErrorMessage = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8571,26 +11968,26 @@ func (x *fastReflection_AxelarRoute) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_AxelarPacketReceipt          protoreflect.MessageDescriptor
-	fd_AxelarPacketReceipt_sequence protoreflect.FieldDescriptor
+	md_FailedPacketReceipt        protoreflect.MessageDescriptor
+	fd_FailedPacketReceipt_reason protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_route_proto_init()
-	md_AxelarPacketReceipt = File_band_tunnel_v1beta1_route_proto.Messages().ByName("AxelarPacketReceipt")
-	fd_AxelarPacketReceipt_sequence = md_AxelarPacketReceipt.Fields().ByName("sequence")
+	md_FailedPacketReceipt = File_band_tunnel_v1beta1_route_proto.Messages().ByName("FailedPacketReceipt")
+	fd_FailedPacketReceipt_reason = md_FailedPacketReceipt.Fields().ByName("reason")
 }
 
-var _ protoreflect.Message = (*fastReflection_AxelarPacketReceipt)(nil)
+var _ protoreflect.Message = (*fastReflection_FailedPacketReceipt)(nil)
 
-type fastReflection_AxelarPacketReceipt AxelarPacketReceipt
+type fastReflection_FailedPacketReceipt FailedPacketReceipt
 
-func (x *AxelarPacketReceipt) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AxelarPacketReceipt)(x)
+func (x *FailedPacketReceipt) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FailedPacketReceipt)(x)
 }
 
-func (x *AxelarPacketReceipt) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[12]
+func (x *FailedPacketReceipt) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8601,43 +11998,43 @@ func (x *AxelarPacketReceipt) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AxelarPacketReceipt_messageType fastReflection_AxelarPacketReceipt_messageType
-var _ protoreflect.MessageType = fastReflection_AxelarPacketReceipt_messageType{}
+var _fastReflection_FailedPacketReceipt_messageType fastReflection_FailedPacketReceipt_messageType
+var _ protoreflect.MessageType = fastReflection_FailedPacketReceipt_messageType{}
 
-type fastReflection_AxelarPacketReceipt_messageType struct{}
+type fastReflection_FailedPacketReceipt_messageType struct{}
 
-func (x fastReflection_AxelarPacketReceipt_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AxelarPacketReceipt)(nil)
+func (x fastReflection_FailedPacketReceipt_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FailedPacketReceipt)(nil)
 }
-func (x fastReflection_AxelarPacketReceipt_messageType) New() protoreflect.Message {
-	return new(fastReflection_AxelarPacketReceipt)
+func (x fastReflection_FailedPacketReceipt_messageType) New() protoreflect.Message {
+	return new(fastReflection_FailedPacketReceipt)
 }
-func (x fastReflection_AxelarPacketReceipt_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AxelarPacketReceipt
+func (x fastReflection_FailedPacketReceipt_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FailedPacketReceipt
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AxelarPacketReceipt) Descriptor() protoreflect.MessageDescriptor {
-	return md_AxelarPacketReceipt
+func (x *fastReflection_FailedPacketReceipt) Descriptor() protoreflect.MessageDescriptor {
+	return md_FailedPacketReceipt
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AxelarPacketReceipt) Type() protoreflect.MessageType {
-	return _fastReflection_AxelarPacketReceipt_messageType
+func (x *fastReflection_FailedPacketReceipt) Type() protoreflect.MessageType {
+	return _fastReflection_FailedPacketReceipt_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AxelarPacketReceipt) New() protoreflect.Message {
-	return new(fastReflection_AxelarPacketReceipt)
+func (x *fastReflection_FailedPacketReceipt) New() protoreflect.Message {
+	return new(fastReflection_FailedPacketReceipt)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AxelarPacketReceipt) Interface() protoreflect.ProtoMessage {
-	return (*AxelarPacketReceipt)(x)
+func (x *fastReflection_FailedPacketReceipt) Interface() protoreflect.ProtoMessage {
+	return (*FailedPacketReceipt)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8645,10 +12042,10 @@ func (x *fastReflection_AxelarPacketReceipt) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AxelarPacketReceipt) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Sequence != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Sequence)
-		if !f(fd_AxelarPacketReceipt_sequence, value) {
+func (x *fastReflection_FailedPacketReceipt) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_FailedPacketReceipt_reason, value) {
 			return
 		}
 	}
@@ -8665,15 +12062,15 @@ func (x *fastReflection_AxelarPacketReceipt) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AxelarPacketReceipt) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_FailedPacketReceipt) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.AxelarPacketReceipt.sequence":
-		return x.Sequence != uint64(0)
+	case "band.tunnel.v1beta1.FailedPacketReceipt.reason":
+		return x.Reason != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarPacketReceipt"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FailedPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarPacketReceipt does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FailedPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8683,15 +12080,15 @@ func (x *fastReflection_AxelarPacketReceipt) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AxelarPacketReceipt) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_FailedPacketReceipt) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.AxelarPacketReceipt.sequence":
-		x.Sequence = uint64(0)
+	case "band.tunnel.v1beta1.FailedPacketReceipt.reason":
+		x.Reason = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarPacketReceipt"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FailedPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarPacketReceipt does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FailedPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8701,16 +12098,16 @@ func (x *fastReflection_AxelarPacketReceipt) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AxelarPacketReceipt) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FailedPacketReceipt) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.AxelarPacketReceipt.sequence":
-		value := x.Sequence
-		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.FailedPacketReceipt.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarPacketReceipt"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FailedPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarPacketReceipt does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FailedPacketReceipt does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8724,15 +12121,15 @@ func (x *fastReflection_AxelarPacketReceipt) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AxelarPacketReceipt) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_FailedPacketReceipt) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.AxelarPacketReceipt.sequence":
-		x.Sequence = value.Uint()
+	case "band.tunnel.v1beta1.FailedPacketReceipt.reason":
+		x.Reason = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarPacketReceipt"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FailedPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarPacketReceipt does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FailedPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8746,40 +12143,40 @@ func (x *fastReflection_AxelarPacketReceipt) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AxelarPacketReceipt) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FailedPacketReceipt) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.AxelarPacketReceipt.sequence":
-		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.AxelarPacketReceipt is not mutable"))
+	case "band.tunnel.v1beta1.FailedPacketReceipt.reason":
+		panic(fmt.Errorf("field reason of message band.tunnel.v1beta1.FailedPacketReceipt is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarPacketReceipt"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FailedPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarPacketReceipt does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FailedPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AxelarPacketReceipt) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FailedPacketReceipt) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.AxelarPacketReceipt.sequence":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.FailedPacketReceipt.reason":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.AxelarPacketReceipt"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.FailedPacketReceipt"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.AxelarPacketReceipt does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.FailedPacketReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AxelarPacketReceipt) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_FailedPacketReceipt) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.AxelarPacketReceipt", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.FailedPacketReceipt", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8787,7 +12184,7 @@ func (x *fastReflection_AxelarPacketReceipt) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AxelarPacketReceipt) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_FailedPacketReceipt) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8798,7 +12195,7 @@ func (x *fastReflection_AxelarPacketReceipt) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AxelarPacketReceipt) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_FailedPacketReceipt) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8810,7 +12207,7 @@ func (x *fastReflection_AxelarPacketReceipt) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AxelarPacketReceipt) IsValid() bool {
+func (x *fastReflection_FailedPacketReceipt) IsValid() bool {
 	return x != nil
 }
 
@@ -8820,9 +12217,9 @@ func (x *fastReflection_AxelarPacketReceipt) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AxelarPacketReceipt) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_FailedPacketReceipt) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AxelarPacketReceipt)
+		x := input.Message.Interface().(*FailedPacketReceipt)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8834,8 +12231,9 @@ func (x *fastReflection_AxelarPacketReceipt) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Sequence != 0 {
-			n += 1 + runtime.Sov(uint64(x.Sequence))
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8847,7 +12245,7 @@ func (x *fastReflection_AxelarPacketReceipt) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AxelarPacketReceipt)
+		x := input.Message.Interface().(*FailedPacketReceipt)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8866,10 +12264,12 @@ func (x *fastReflection_AxelarPacketReceipt) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Sequence != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8882,7 +12282,7 @@ func (x *fastReflection_AxelarPacketReceipt) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AxelarPacketReceipt)
+		x := input.Message.Interface().(*FailedPacketReceipt)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8914,17 +12314,17 @@ func (x *fastReflection_AxelarPacketReceipt) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AxelarPacketReceipt: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FailedPacketReceipt: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AxelarPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FailedPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 				}
-				x.Sequence = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8934,11 +12334,24 @@ func (x *fastReflection_AxelarPacketReceipt) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Sequence |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8999,6 +12412,11 @@ type TSSRoute struct {
 	DestinationContractAddress string `protobuf:"bytes,2,opt,name=destination_contract_address,json=destinationContractAddress,proto3" json:"destination_contract_address,omitempty"`
 	// encoder is the mode of encoding packet data.
 	Encoder v1beta1.Encoder `protobuf:"varint,3,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
+	// batch indicates whether packets are signed together with other requests over a merkle root.
+	Batch bool `protobuf:"varint,4,opt,name=batch,proto3" json:"batch,omitempty"`
+	// group_name is the name of the bandtss named group that signs packets. If empty, packets are
+	// signed by the current group.
+	GroupName string `protobuf:"bytes,5,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
 }
 
 func (x *TSSRoute) Reset() {
@@ -9042,6 +12460,20 @@ func (x *TSSRoute) GetEncoder() v1beta1.Encoder {
 	return v1beta1.Encoder(0)
 }
 
+func (x *TSSRoute) GetBatch() bool {
+	if x != nil {
+		return x.Batch
+	}
+	return false
+}
+
+func (x *TSSRoute) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
 // TSSPacketReceipt represents a receipt for a tss packet and implements the PacketReceiptI interface.
 type TSSPacketReceipt struct {
 	state         protoimpl.MessageState
@@ -9227,6 +12659,9 @@ type IBCHookRoute struct {
 	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 	// destination_contract_address is the destination contract address
 	DestinationContractAddress string `protobuf:"bytes,2,opt,name=destination_contract_address,json=destinationContractAddress,proto3" json:"destination_contract_address,omitempty"`
+	// encoder is the mode of encoding packet data. If it is unspecified, the packet is sent as JSON in
+	// the memo; otherwise the encoded packet is sent by IBCHookEncodedMemo.
+	Encoder v1beta1.Encoder `protobuf:"varint,3,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
 }
 
 func (x *IBCHookRoute) Reset() {
@@ -9263,6 +12698,13 @@ func (x *IBCHookRoute) GetDestinationContractAddress() string {
 	return ""
 }
 
+func (x *IBCHookRoute) GetEncoder() v1beta1.Encoder {
+	if x != nil {
+		return x.Encoder
+	}
+	return v1beta1.Encoder(0)
+}
+
 // IBCHookPacketReceipt represents a receipt for a IBC hook packet and implements the PacketReceiptI interface.
 type IBCHookPacketReceipt struct {
 	state         protoimpl.MessageState
@@ -9337,6 +12779,43 @@ func (x *IBCHookMemo) GetWasm() *IBCHookMemo_Payload {
 	return nil
 }
 
+// IBCHookEncodedMemo is the type for an encoded data packet that will be stringtify to be a memo of IBC hook packet
+type IBCHookEncodedMemo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// wasm is the payload for calling destination contract
+	Wasm *IBCHookEncodedMemo_Payload `protobuf:"bytes,1,opt,name=wasm,proto3" json:"wasm,omitempty"`
+}
+
+func (x *IBCHookEncodedMemo) Reset() {
+	*x = IBCHookEncodedMemo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IBCHookEncodedMemo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IBCHookEncodedMemo) ProtoMessage() {}
+
+// Deprecated: Use IBCHookEncodedMemo.ProtoReflect.Descriptor instead.
+func (*IBCHookEncodedMemo) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *IBCHookEncodedMemo) GetWasm() *IBCHookEncodedMemo_Payload {
+	if x != nil {
+		return x.Wasm
+	}
+	return nil
+}
+
 // RouterRoute is the type for a Router route
 type RouterRoute struct {
 	state         protoimpl.MessageState
@@ -9349,12 +12828,14 @@ type RouterRoute struct {
 	DestinationContractAddress string `protobuf:"bytes,2,opt,name=destination_contract_address,json=destinationContractAddress,proto3" json:"destination_contract_address,omitempty"`
 	// destination_gas_limit is the destination gas limit
 	DestinationGasLimit uint64 `protobuf:"varint,3,opt,name=destination_gas_limit,json=destinationGasLimit,proto3" json:"destination_gas_limit,omitempty"`
+	// encoder is the mode of encoding packet data. If it is unspecified, the fixed-point abi encoder is used.
+	Encoder v1beta1.Encoder `protobuf:"varint,4,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
 }
 
 func (x *RouterRoute) Reset() {
 	*x = RouterRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[8]
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9368,7 +12849,7 @@ func (*RouterRoute) ProtoMessage() {}
 
 // Deprecated: Use RouterRoute.ProtoReflect.Descriptor instead.
 func (*RouterRoute) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{8}
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RouterRoute) GetDestinationChainId() string {
@@ -9392,6 +12873,13 @@ func (x *RouterRoute) GetDestinationGasLimit() uint64 {
 	return 0
 }
 
+func (x *RouterRoute) GetEncoder() v1beta1.Encoder {
+	if x != nil {
+		return x.Encoder
+	}
+	return v1beta1.Encoder(0)
+}
+
 // RouterPacketReceipt represents a receipt for a Router packet and implements the PacketReceiptI interface.
 type RouterPacketReceipt struct {
 	state         protoimpl.MessageState
@@ -9405,7 +12893,7 @@ type RouterPacketReceipt struct {
 func (x *RouterPacketReceipt) Reset() {
 	*x = RouterPacketReceipt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[9]
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9419,7 +12907,7 @@ func (*RouterPacketReceipt) ProtoMessage() {}
 
 // Deprecated: Use RouterPacketReceipt.ProtoReflect.Descriptor instead.
 func (*RouterPacketReceipt) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{9}
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RouterPacketReceipt) GetSequence() uint64 {
@@ -9429,133 +12917,264 @@ func (x *RouterPacketReceipt) GetSequence() uint64 {
 	return 0
 }
 
-// RouterMemo is the type for a data packet that will be stringtify to be a memo of IBC packet
-type RouterMemo struct {
+// RouterMemo is the type for a data packet that will be stringtify to be a memo of IBC packet
+type RouterMemo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// wasm is the payload for calling destination contract
+	Wasm *RouterMemo_Payload `protobuf:"bytes,1,opt,name=wasm,proto3" json:"wasm,omitempty"`
+}
+
+func (x *RouterMemo) Reset() {
+	*x = RouterMemo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouterMemo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouterMemo) ProtoMessage() {}
+
+// Deprecated: Use RouterMemo.ProtoReflect.Descriptor instead.
+func (*RouterMemo) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RouterMemo) GetWasm() *RouterMemo_Payload {
+	if x != nil {
+		return x.Wasm
+	}
+	return nil
+}
+
+// AxelarRoute represents a route for Axelar packets and implements the RouteI interface.
+type AxelarRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// destination_chain_id is the destination chain ID
+	DestinationChainId string `protobuf:"bytes,1,opt,name=destination_chain_id,json=destinationChainId,proto3" json:"destination_chain_id,omitempty"`
+	// destination_contract_address is the destination contract address
+	DestinationContractAddress string `protobuf:"bytes,2,opt,name=destination_contract_address,json=destinationContractAddress,proto3" json:"destination_contract_address,omitempty"`
+	// fee is the fee for each packet in the Axelar network.
+	Fee *v1beta11.Coin `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (x *AxelarRoute) Reset() {
+	*x = AxelarRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AxelarRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AxelarRoute) ProtoMessage() {}
+
+// Deprecated: Use AxelarRoute.ProtoReflect.Descriptor instead.
+func (*AxelarRoute) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AxelarRoute) GetDestinationChainId() string {
+	if x != nil {
+		return x.DestinationChainId
+	}
+	return ""
+}
+
+func (x *AxelarRoute) GetDestinationContractAddress() string {
+	if x != nil {
+		return x.DestinationContractAddress
+	}
+	return ""
+}
+
+func (x *AxelarRoute) GetFee() *v1beta11.Coin {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+// AxelarPacketReceipt represents a receipt for a Axelar packet and implements the PacketReceiptI interface.
+type AxelarPacketReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sequence is representing the sequence of the Axelar packet.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *AxelarPacketReceipt) Reset() {
+	*x = AxelarPacketReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AxelarPacketReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AxelarPacketReceipt) ProtoMessage() {}
+
+// Deprecated: Use AxelarPacketReceipt.ProtoReflect.Descriptor instead.
+func (*AxelarPacketReceipt) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AxelarPacketReceipt) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+// LocalRoute represents a route for delivering packets to a consumer module on BandChain and implements the RouteI
+// interface.
+type LocalRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// wasm is the payload for calling destination contract
-	Wasm *RouterMemo_Payload `protobuf:"bytes,1,opt,name=wasm,proto3" json:"wasm,omitempty"`
+	// consumer is the name of the consumer module registered with the tunnel keeper
+	Consumer string `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
 }
 
-func (x *RouterMemo) Reset() {
-	*x = RouterMemo{}
+func (x *LocalRoute) Reset() {
+	*x = LocalRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[10]
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RouterMemo) String() string {
+func (x *LocalRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouterMemo) ProtoMessage() {}
+func (*LocalRoute) ProtoMessage() {}
 
-// Deprecated: Use RouterMemo.ProtoReflect.Descriptor instead.
-func (*RouterMemo) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use LocalRoute.ProtoReflect.Descriptor instead.
+func (*LocalRoute) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *RouterMemo) GetWasm() *RouterMemo_Payload {
+func (x *LocalRoute) GetConsumer() string {
 	if x != nil {
-		return x.Wasm
+		return x.Consumer
 	}
-	return nil
+	return ""
 }
 
-// AxelarRoute represents a route for Axelar packets and implements the RouteI interface.
-type AxelarRoute struct {
+// LocalPacketReceipt represents a receipt for a local packet and implements the PacketReceiptI interface.
+type LocalPacketReceipt struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// destination_chain_id is the destination chain ID
-	DestinationChainId string `protobuf:"bytes,1,opt,name=destination_chain_id,json=destinationChainId,proto3" json:"destination_chain_id,omitempty"`
-	// destination_contract_address is the destination contract address
-	DestinationContractAddress string `protobuf:"bytes,2,opt,name=destination_contract_address,json=destinationContractAddress,proto3" json:"destination_contract_address,omitempty"`
-	// fee is the fee for each packet in the Axelar network.
-	Fee *v1beta11.Coin `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	// success is true if the consumer module processed the packet successfully
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// gas_used is the gas used by the consumer module to process the packet
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// error_message is the reason of the failure if the consumer module failed to process the packet
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 }
 
-func (x *AxelarRoute) Reset() {
-	*x = AxelarRoute{}
+func (x *LocalPacketReceipt) Reset() {
+	*x = LocalPacketReceipt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[11]
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AxelarRoute) String() string {
+func (x *LocalPacketReceipt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AxelarRoute) ProtoMessage() {}
+func (*LocalPacketReceipt) ProtoMessage() {}
 
-// Deprecated: Use AxelarRoute.ProtoReflect.Descriptor instead.
-func (*AxelarRoute) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use LocalPacketReceipt.ProtoReflect.Descriptor instead.
+func (*LocalPacketReceipt) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *AxelarRoute) GetDestinationChainId() string {
+func (x *LocalPacketReceipt) GetSuccess() bool {
 	if x != nil {
-		return x.DestinationChainId
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *AxelarRoute) GetDestinationContractAddress() string {
+func (x *LocalPacketReceipt) GetGasUsed() uint64 {
 	if x != nil {
-		return x.DestinationContractAddress
+		return x.GasUsed
 	}
-	return ""
+	return 0
 }
 
-func (x *AxelarRoute) GetFee() *v1beta11.Coin {
+func (x *LocalPacketReceipt) GetErrorMessage() string {
 	if x != nil {
-		return x.Fee
+		return x.ErrorMessage
 	}
-	return nil
+	return ""
 }
 
-// AxelarPacketReceipt represents a receipt for a Axelar packet and implements the PacketReceiptI interface.
-type AxelarPacketReceipt struct {
+// FailedPacketReceipt represents a receipt of a route that failed to deliver a packet and implements the
+// PacketReceiptI interface.
+type FailedPacketReceipt struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// sequence is representing the sequence of the Axelar packet.
-	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// reason is the reason of the failure
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *AxelarPacketReceipt) Reset() {
-	*x = AxelarPacketReceipt{}
+func (x *FailedPacketReceipt) Reset() {
+	*x = FailedPacketReceipt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[12]
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AxelarPacketReceipt) String() string {
+func (x *FailedPacketReceipt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AxelarPacketReceipt) ProtoMessage() {}
+func (*FailedPacketReceipt) ProtoMessage() {}
 
-// Deprecated: Use AxelarPacketReceipt.ProtoReflect.Descriptor instead.
-func (*AxelarPacketReceipt) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use FailedPacketReceipt.ProtoReflect.Descriptor instead.
+func (*FailedPacketReceipt) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *AxelarPacketReceipt) GetSequence() uint64 {
+func (x *FailedPacketReceipt) GetReason() string {
 	if x != nil {
-		return x.Sequence
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
 // Payload defines target contract and detail of function call (msg).
@@ -9573,7 +13192,7 @@ type IBCHookMemo_Payload struct {
 func (x *IBCHookMemo_Payload) Reset() {
 	*x = IBCHookMemo_Payload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[13]
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9617,7 +13236,7 @@ type IBCHookMemo_Payload_Msg struct {
 func (x *IBCHookMemo_Payload_Msg) Reset() {
 	*x = IBCHookMemo_Payload_Msg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[14]
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9654,7 +13273,7 @@ type IBCHookMemo_Payload_Msg_ReceivePacket struct {
 func (x *IBCHookMemo_Payload_Msg_ReceivePacket) Reset() {
 	*x = IBCHookMemo_Payload_Msg_ReceivePacket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[15]
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9678,6 +13297,135 @@ func (x *IBCHookMemo_Payload_Msg_ReceivePacket) GetPacket() *TunnelPricesPacketD
 	return nil
 }
 
+// Payload defines target contract and detail of function call (msg).
+type IBCHookEncodedMemo_Payload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// contract is destination contract address
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// msg is the ibc hook message
+	Msg *IBCHookEncodedMemo_Payload_Msg `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *IBCHookEncodedMemo_Payload) Reset() {
+	*x = IBCHookEncodedMemo_Payload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IBCHookEncodedMemo_Payload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IBCHookEncodedMemo_Payload) ProtoMessage() {}
+
+// Deprecated: Use IBCHookEncodedMemo_Payload.ProtoReflect.Descriptor instead.
+func (*IBCHookEncodedMemo_Payload) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *IBCHookEncodedMemo_Payload) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *IBCHookEncodedMemo_Payload) GetMsg() *IBCHookEncodedMemo_Payload_Msg {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+// Msg defines function name (`receive_encoded_packet`) and a type of function arguments.
+type IBCHookEncodedMemo_Payload_Msg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// receive_encoded_packet is the function name on the destination contract
+	ReceiveEncodedPacket *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket `protobuf:"bytes,1,opt,name=receive_encoded_packet,json=receiveEncodedPacket,proto3" json:"receive_encoded_packet,omitempty"`
+}
+
+func (x *IBCHookEncodedMemo_Payload_Msg) Reset() {
+	*x = IBCHookEncodedMemo_Payload_Msg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IBCHookEncodedMemo_Payload_Msg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IBCHookEncodedMemo_Payload_Msg) ProtoMessage() {}
+
+// Deprecated: Use IBCHookEncodedMemo_Payload_Msg.ProtoReflect.Descriptor instead.
+func (*IBCHookEncodedMemo_Payload_Msg) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{8, 0, 0}
+}
+
+func (x *IBCHookEncodedMemo_Payload_Msg) GetReceiveEncodedPacket() *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket {
+	if x != nil {
+		return x.ReceiveEncodedPacket
+	}
+	return nil
+}
+
+// ReceiveEncodedPacket represents the arguments of `receive_encoded_packet` function
+type IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// encoder is the mode of encoding the packet
+	Encoder v1beta1.Encoder `protobuf:"varint,1,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
+	// payload is the base64-encoded packet
+	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) Reset() {
+	*x = IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) ProtoMessage() {}
+
+// Deprecated: Use IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket.ProtoReflect.Descriptor instead.
+func (*IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{8, 0, 0, 0}
+}
+
+func (x *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) GetEncoder() v1beta1.Encoder {
+	if x != nil {
+		return x.Encoder
+	}
+	return v1beta1.Encoder(0)
+}
+
+func (x *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
 // Payload defines target contract and detail of function call (msg).
 type RouterMemo_Payload struct {
 	state         protoimpl.MessageState
@@ -9693,7 +13441,7 @@ type RouterMemo_Payload struct {
 func (x *RouterMemo_Payload) Reset() {
 	*x = RouterMemo_Payload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[16]
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9707,7 +13455,7 @@ func (*RouterMemo_Payload) ProtoMessage() {}
 
 // Deprecated: Use RouterMemo_Payload.ProtoReflect.Descriptor instead.
 func (*RouterMemo_Payload) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{10, 0}
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{11, 0}
 }
 
 func (x *RouterMemo_Payload) GetContract() string {
@@ -9737,7 +13485,7 @@ type RouterMemo_Payload_Msg struct {
 func (x *RouterMemo_Payload_Msg) Reset() {
 	*x = RouterMemo_Payload_Msg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[17]
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9751,7 +13499,7 @@ func (*RouterMemo_Payload_Msg) ProtoMessage() {}
 
 // Deprecated: Use RouterMemo_Payload_Msg.ProtoReflect.Descriptor instead.
 func (*RouterMemo_Payload_Msg) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{10, 0, 0}
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{11, 0, 0}
 }
 
 func (x *RouterMemo_Payload_Msg) GetReceiveBandData() *RouterMemo_Payload_Msg_ReceiveBandDataArgs {
@@ -9780,7 +13528,7 @@ type RouterMemo_Payload_Msg_ReceiveBandDataArgs struct {
 func (x *RouterMemo_Payload_Msg_ReceiveBandDataArgs) Reset() {
 	*x = RouterMemo_Payload_Msg_ReceiveBandDataArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[18]
+		mi := &file_band_tunnel_v1beta1_route_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9794,7 +13542,7 @@ func (*RouterMemo_Payload_Msg_ReceiveBandDataArgs) ProtoMessage() {}
 
 // Deprecated: Use RouterMemo_Payload_Msg_ReceiveBandDataArgs.ProtoReflect.Descriptor instead.
 func (*RouterMemo_Payload_Msg_ReceiveBandDataArgs) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{10, 0, 0, 0}
+	return file_band_tunnel_v1beta1_route_proto_rawDescGZIP(), []int{11, 0, 0, 0}
 }
 
 func (x *RouterMemo_Payload_Msg_ReceiveBandDataArgs) GetDestChainId() string {
@@ -9840,7 +13588,7 @@ var file_band_tunnel_v1beta1_route_proto_rawDesc = []byte{
 	0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x6e, 0x63, 0x6f,
 	0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x62, 0x61, 0x6e, 0x64, 0x2f,
 	0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x66, 0x65,
-	0x65, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd9, 0x01, 0x0a, 0x08, 0x54, 0x53,
+	0x65, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8e, 0x02, 0x0a, 0x08, 0x54, 0x53,
 	0x53, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xe2, 0xde, 0x1f, 0x12, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
@@ -9853,158 +13601,213 @@ var file_band_tunnel_v1beta1_route_proto_rawDesc = []byte{
 	0x73, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73,
 	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
-	0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a, 0x0a, 0xca, 0xb4, 0x2d, 0x06, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x49, 0x22, 0x92, 0x01, 0x0a, 0x10, 0x54, 0x53, 0x53, 0x50, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x6a, 0x0a, 0x0a, 0x73, 0x69,
-	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x4b,
-	0xe2, 0xde, 0x1f, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0xfa, 0xde, 0x1f,
-	0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76,
-	0x33, 0x2f, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x52, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x3a, 0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x22, 0x44, 0x0a, 0x08, 0x49, 0x42,
-	0x43, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x49, 0x64, 0x3a, 0x0a, 0xca, 0xb4, 0x2d, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49,
-	0x22, 0x42, 0x0a, 0x10, 0x49, 0x42, 0x43, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
-	0x3a, 0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x49, 0x22, 0xb7, 0x01, 0x0a, 0x16, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44,
-	0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65,
-	0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8a,
-	0x01, 0x0a, 0x0c, 0x49, 0x42, 0x43, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12,
-	0x2c, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x49, 0x44, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x40, 0x0a,
-	0x1c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x1a, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a,
-	0x0a, 0xca, 0xb4, 0x2d, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x22, 0x46, 0x0a, 0x14, 0x49,
-	0x42, 0x43, 0x48, 0x6f, 0x6f, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x3a,
-	0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x49, 0x22, 0x8c, 0x03, 0x0a, 0x0b, 0x49, 0x42, 0x43, 0x48, 0x6f, 0x6f, 0x6b, 0x4d,
-	0x65, 0x6d, 0x6f, 0x12, 0x42, 0x0a, 0x04, 0x77, 0x61, 0x73, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
+	0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x3a, 0x0a,
+	0xca, 0xb4, 0x2d, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x22, 0x92, 0x01, 0x0a, 0x10, 0x54,
+	0x53, 0x53, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12,
+	0x6a, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x4b, 0xe2, 0xde, 0x1f, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x3a, 0x12, 0xca, 0xb4, 0x2d,
+	0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x22,
+	0x44, 0x0a, 0x08, 0x49, 0x42, 0x43, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x3a, 0x0a, 0xca, 0xb4, 0x2d, 0x06, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x49, 0x22, 0x42, 0x0a, 0x10, 0x49, 0x42, 0x43, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x22, 0xb7, 0x01, 0x0a, 0x16, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xc1, 0x01, 0x0a, 0x0c, 0x49, 0x42, 0x43, 0x48, 0x6f, 0x6f, 0x6b, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x12, 0x40, 0x0a, 0x1c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a, 0x0a, 0xca, 0xb4, 0x2d,
+	0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x22, 0x46, 0x0a, 0x14, 0x49, 0x42, 0x43, 0x48, 0x6f,
+	0x6f, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x12, 0xca, 0xb4, 0x2d,
+	0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x22,
+	0x8c, 0x03, 0x0a, 0x0b, 0x49, 0x42, 0x43, 0x48, 0x6f, 0x6f, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x12,
+	0x42, 0x0a, 0x04, 0x77, 0x61, 0x73, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x48, 0x6f, 0x6f, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x2e,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x77,
+	0x61, 0x73, 0x6d, 0x1a, 0xb8, 0x02, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x44, 0x0a, 0x03, 0x6d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x49,
+	0x42, 0x43, 0x48, 0x6f, 0x6f, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x6d, 0x73,
+	0x67, 0x1a, 0xca, 0x01, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x67, 0x0a, 0x0e, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
 	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x48, 0x6f, 0x6f, 0x6b, 0x4d,
-	0x65, 0x6d, 0x6f, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f,
-	0x00, 0x52, 0x04, 0x77, 0x61, 0x73, 0x6d, 0x1a, 0xb8, 0x02, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12,
-	0x44, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62,
-	0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x48, 0x6f, 0x6f, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x2e, 0x50,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x03, 0x6d, 0x73, 0x67, 0x1a, 0xca, 0x01, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x67, 0x0a,
-	0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e,
+	0x65, 0x6d, 0x6f, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x2e,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x1a, 0x5a, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x49, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0xcc,
+	0x03, 0x0a, 0x12, 0x49, 0x42, 0x43, 0x48, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x12, 0x49, 0x0a, 0x04, 0x77, 0x61, 0x73, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x48, 0x6f, 0x6f,
+	0x6b, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x2e, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x77, 0x61, 0x73, 0x6d,
+	0x1a, 0xea, 0x02, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x4b, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e,
 	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x48,
-	0x6f, 0x6f, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
-	0x4d, 0x73, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x1a, 0x5a, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
-	0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x49, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x22, 0xd9, 0x01, 0x0a, 0x0b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x12, 0x48, 0x0a, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x16, 0xe2, 0xde, 0x1f, 0x12, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x1c,
-	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x1a, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32,
-	0x0a, 0x15, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x61,
-	0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x64,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x3a, 0x0a, 0xca, 0xb4, 0x2d, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x22, 0x45,
-	0x0a, 0x13, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x3a, 0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x49, 0x22, 0xef, 0x03, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72,
-	0x4d, 0x65, 0x6d, 0x6f, 0x12, 0x41, 0x0a, 0x04, 0x77, 0x61, 0x73, 0x6d, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x4d,
-	0x65, 0x6d, 0x6f, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f,
-	0x00, 0x52, 0x04, 0x77, 0x61, 0x73, 0x6d, 0x1a, 0x9d, 0x03, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12,
-	0x43, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62,
-	0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x2e, 0x50, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x03, 0x6d, 0x73, 0x67, 0x1a, 0xb0, 0x02, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x71, 0x0a, 0x11,
-	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x2e, 0x4d, 0x73, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x42, 0x61, 0x6e, 0x64,
-	0x44, 0x61, 0x74, 0x61, 0x41, 0x72, 0x67, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f,
-	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x42, 0x61, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x1a,
-	0xb5, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x42, 0x61, 0x6e, 0x64, 0x44,
-	0x61, 0x74, 0x61, 0x41, 0x72, 0x67, 0x73, 0x12, 0x33, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x5f,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f,
-	0xe2, 0xde, 0x1f, 0x0b, 0x44, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x15,
-	0x64, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x65, 0x73,
-	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xe5, 0x01, 0x0a, 0x0b, 0x41, 0x78, 0x65, 0x6c,
-	0x61, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xe2, 0xde, 0x1f, 0x12, 0x44, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0xfa, 0xde, 0x1f,
-	0x09, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x12, 0x64, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x40,
-	0x0a, 0x1c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x31, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03,
-	0x66, 0x65, 0x65, 0x3a, 0x0a, 0xca, 0xb4, 0x2d, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x22,
-	0x45, 0x0a, 0x13, 0x41, 0x78, 0x65, 0x6c, 0x61, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x3a, 0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x42, 0xdf, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62,
-	0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x42, 0x0a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e,
-	0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02,
-	0x13, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e,
-	0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42,
-	0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0xa8, 0xe2, 0x1e, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x2e, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x03, 0x6d, 0x73, 0x67, 0x1a, 0xf5, 0x01, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x84, 0x01,
+	0x0a, 0x16, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x48,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x48, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
+	0x4d, 0x73, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x1a, 0x67, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x45,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x35, 0x0a, 0x07,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x90, 0x02,
+	0x0a, 0x0b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x48, 0x0a,
+	0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xe2, 0xde, 0x1f,
+	0x12, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x44, 0x52, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x1c, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x35, 0x0a,
+	0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x72, 0x3a, 0x0a, 0xca, 0xb4, 0x2d, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49,
+	0x22, 0x45, 0x0a, 0x13, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x3a, 0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x22, 0xef, 0x03, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x12, 0x41, 0x0a, 0x04, 0x77, 0x61, 0x73, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x04, 0x77, 0x61, 0x73, 0x6d, 0x1a, 0x9d, 0x03, 0x0a, 0x07, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x12, 0x43, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x2e,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x1a, 0xb0, 0x02, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x71,
+	0x0a, 0x11, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x42, 0x61,
+	0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x41, 0x72, 0x67, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x42, 0x61, 0x6e, 0x64, 0x44, 0x61, 0x74,
+	0x61, 0x1a, 0xb5, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x42, 0x61, 0x6e,
+	0x64, 0x44, 0x61, 0x74, 0x61, 0x41, 0x72, 0x67, 0x73, 0x12, 0x33, 0x0a, 0x0d, 0x64, 0x65, 0x73,
+	0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0f, 0xe2, 0xde, 0x1f, 0x0b, 0x44, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x44, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x32,
+	0x0a, 0x15, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64,
+	0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xe5, 0x01, 0x0a, 0x0b, 0x41, 0x78,
+	0x65, 0x6c, 0x61, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x14, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xe2, 0xde, 0x1f, 0x12, 0x44, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0xfa,
+	0xde, 0x1f, 0x09, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x12, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
+	0x12, 0x40, 0x0a, 0x1c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x31, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x03, 0x66, 0x65, 0x65, 0x3a, 0x0a, 0xca, 0xb4, 0x2d, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x49, 0x22, 0x45, 0x0a, 0x13, 0x41, 0x78, 0x65, 0x6c, 0x61, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x3a, 0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x22, 0x34, 0x0a, 0x0a, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x3a, 0x0a, 0xca, 0xb4, 0x2d, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x22, 0x82,
+	0x01, 0x0a, 0x12, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a,
+	0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x49, 0x22, 0x41, 0x0a, 0x13, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x3a, 0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x42, 0xdf, 0x01, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x17, 0x63,
+	0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42,
+	0x54, 0x58, 0xaa, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x5c,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02,
+	0x1f, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x15, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -10019,47 +13822,60 @@ func file_band_tunnel_v1beta1_route_proto_rawDescGZIP() []byte {
 	return file_band_tunnel_v1beta1_route_proto_rawDescData
 }
 
-var file_band_tunnel_v1beta1_route_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_band_tunnel_v1beta1_route_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_band_tunnel_v1beta1_route_proto_goTypes = []interface{}{
-	(*TSSRoute)(nil),                                   // 0: band.tunnel.v1beta1.TSSRoute
-	(*TSSPacketReceipt)(nil),                           // 1: band.tunnel.v1beta1.TSSPacketReceipt
-	(*IBCRoute)(nil),                                   // 2: band.tunnel.v1beta1.IBCRoute
-	(*IBCPacketReceipt)(nil),                           // 3: band.tunnel.v1beta1.IBCPacketReceipt
-	(*TunnelPricesPacketData)(nil),                     // 4: band.tunnel.v1beta1.TunnelPricesPacketData
-	(*IBCHookRoute)(nil),                               // 5: band.tunnel.v1beta1.IBCHookRoute
-	(*IBCHookPacketReceipt)(nil),                       // 6: band.tunnel.v1beta1.IBCHookPacketReceipt
-	(*IBCHookMemo)(nil),                                // 7: band.tunnel.v1beta1.IBCHookMemo
-	(*RouterRoute)(nil),                                // 8: band.tunnel.v1beta1.RouterRoute
-	(*RouterPacketReceipt)(nil),                        // 9: band.tunnel.v1beta1.RouterPacketReceipt
-	(*RouterMemo)(nil),                                 // 10: band.tunnel.v1beta1.RouterMemo
-	(*AxelarRoute)(nil),                                // 11: band.tunnel.v1beta1.AxelarRoute
-	(*AxelarPacketReceipt)(nil),                        // 12: band.tunnel.v1beta1.AxelarPacketReceipt
-	(*IBCHookMemo_Payload)(nil),                        // 13: band.tunnel.v1beta1.IBCHookMemo.Payload
-	(*IBCHookMemo_Payload_Msg)(nil),                    // 14: band.tunnel.v1beta1.IBCHookMemo.Payload.Msg
-	(*IBCHookMemo_Payload_Msg_ReceivePacket)(nil),      // 15: band.tunnel.v1beta1.IBCHookMemo.Payload.Msg.ReceivePacket
-	(*RouterMemo_Payload)(nil),                         // 16: band.tunnel.v1beta1.RouterMemo.Payload
-	(*RouterMemo_Payload_Msg)(nil),                     // 17: band.tunnel.v1beta1.RouterMemo.Payload.Msg
-	(*RouterMemo_Payload_Msg_ReceiveBandDataArgs)(nil), // 18: band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs
-	(v1beta1.Encoder)(0),                               // 19: band.feeds.v1beta1.Encoder
-	(*v1beta1.Price)(nil),                              // 20: band.feeds.v1beta1.Price
-	(*v1beta11.Coin)(nil),                              // 21: cosmos.base.v1beta1.Coin
+	(*TSSRoute)(nil),                                            // 0: band.tunnel.v1beta1.TSSRoute
+	(*TSSPacketReceipt)(nil),                                    // 1: band.tunnel.v1beta1.TSSPacketReceipt
+	(*IBCRoute)(nil),                                            // 2: band.tunnel.v1beta1.IBCRoute
+	(*IBCPacketReceipt)(nil),                                    // 3: band.tunnel.v1beta1.IBCPacketReceipt
+	(*TunnelPricesPacketData)(nil),                              // 4: band.tunnel.v1beta1.TunnelPricesPacketData
+	(*IBCHookRoute)(nil),                                        // 5: band.tunnel.v1beta1.IBCHookRoute
+	(*IBCHookPacketReceipt)(nil),                                // 6: band.tunnel.v1beta1.IBCHookPacketReceipt
+	(*IBCHookMemo)(nil),                                         // 7: band.tunnel.v1beta1.IBCHookMemo
+	(*IBCHookEncodedMemo)(nil),                                  // 8: band.tunnel.v1beta1.IBCHookEncodedMemo
+	(*RouterRoute)(nil),                                         // 9: band.tunnel.v1beta1.RouterRoute
+	(*RouterPacketReceipt)(nil),                                 // 10: band.tunnel.v1beta1.RouterPacketReceipt
+	(*RouterMemo)(nil),                                          // 11: band.tunnel.v1beta1.RouterMemo
+	(*AxelarRoute)(nil),                                         // 12: band.tunnel.v1beta1.AxelarRoute
+	(*AxelarPacketReceipt)(nil),                                 // 13: band.tunnel.v1beta1.AxelarPacketReceipt
+	(*LocalRoute)(nil),                                          // 14: band.tunnel.v1beta1.LocalRoute
+	(*LocalPacketReceipt)(nil),                                  // 15: band.tunnel.v1beta1.LocalPacketReceipt
+	(*FailedPacketReceipt)(nil),                                 // 16: band.tunnel.v1beta1.FailedPacketReceipt
+	(*IBCHookMemo_Payload)(nil),                                 // 17: band.tunnel.v1beta1.IBCHookMemo.Payload
+	(*IBCHookMemo_Payload_Msg)(nil),                             // 18: band.tunnel.v1beta1.IBCHookMemo.Payload.Msg
+	(*IBCHookMemo_Payload_Msg_ReceivePacket)(nil),               // 19: band.tunnel.v1beta1.IBCHookMemo.Payload.Msg.ReceivePacket
+	(*IBCHookEncodedMemo_Payload)(nil),                          // 20: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload
+	(*IBCHookEncodedMemo_Payload_Msg)(nil),                      // 21: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg
+	(*IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket)(nil), // 22: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket
+	(*RouterMemo_Payload)(nil),                                  // 23: band.tunnel.v1beta1.RouterMemo.Payload
+	(*RouterMemo_Payload_Msg)(nil),                              // 24: band.tunnel.v1beta1.RouterMemo.Payload.Msg
+	(*RouterMemo_Payload_Msg_ReceiveBandDataArgs)(nil),          // 25: band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs
+	(v1beta1.Encoder)(0),                                        // 26: band.feeds.v1beta1.Encoder
+	(*v1beta1.Price)(nil),                                       // 27: band.feeds.v1beta1.Price
+	(*v1beta11.Coin)(nil),                                       // 28: cosmos.base.v1beta1.Coin
 }
 var file_band_tunnel_v1beta1_route_proto_depIdxs = []int32{
-	19, // 0: band.tunnel.v1beta1.TSSRoute.encoder:type_name -> band.feeds.v1beta1.Encoder
-	20, // 1: band.tunnel.v1beta1.TunnelPricesPacketData.prices:type_name -> band.feeds.v1beta1.Price
-	13, // 2: band.tunnel.v1beta1.IBCHookMemo.wasm:type_name -> band.tunnel.v1beta1.IBCHookMemo.Payload
-	16, // 3: band.tunnel.v1beta1.RouterMemo.wasm:type_name -> band.tunnel.v1beta1.RouterMemo.Payload
-	21, // 4: band.tunnel.v1beta1.AxelarRoute.fee:type_name -> cosmos.base.v1beta1.Coin
-	14, // 5: band.tunnel.v1beta1.IBCHookMemo.Payload.msg:type_name -> band.tunnel.v1beta1.IBCHookMemo.Payload.Msg
-	15, // 6: band.tunnel.v1beta1.IBCHookMemo.Payload.Msg.receive_packet:type_name -> band.tunnel.v1beta1.IBCHookMemo.Payload.Msg.ReceivePacket
-	4,  // 7: band.tunnel.v1beta1.IBCHookMemo.Payload.Msg.ReceivePacket.packet:type_name -> band.tunnel.v1beta1.TunnelPricesPacketData
-	17, // 8: band.tunnel.v1beta1.RouterMemo.Payload.msg:type_name -> band.tunnel.v1beta1.RouterMemo.Payload.Msg
-	18, // 9: band.tunnel.v1beta1.RouterMemo.Payload.Msg.receive_band_data:type_name -> band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	26, // 0: band.tunnel.v1beta1.TSSRoute.encoder:type_name -> band.feeds.v1beta1.Encoder
+	27, // 1: band.tunnel.v1beta1.TunnelPricesPacketData.prices:type_name -> band.feeds.v1beta1.Price
+	26, // 2: band.tunnel.v1beta1.IBCHookRoute.encoder:type_name -> band.feeds.v1beta1.Encoder
+	17, // 3: band.tunnel.v1beta1.IBCHookMemo.wasm:type_name -> band.tunnel.v1beta1.IBCHookMemo.Payload
+	20, // 4: band.tunnel.v1beta1.IBCHookEncodedMemo.wasm:type_name -> band.tunnel.v1beta1.IBCHookEncodedMemo.Payload
+	26, // 5: band.tunnel.v1beta1.RouterRoute.encoder:type_name -> band.feeds.v1beta1.Encoder
+	23, // 6: band.tunnel.v1beta1.RouterMemo.wasm:type_name -> band.tunnel.v1beta1.RouterMemo.Payload
+	28, // 7: band.tunnel.v1beta1.AxelarRoute.fee:type_name -> cosmos.base.v1beta1.Coin
+	18, // 8: band.tunnel.v1beta1.IBCHookMemo.Payload.msg:type_name -> band.tunnel.v1beta1.IBCHookMemo.Payload.Msg
+	19, // 9: band.tunnel.v1beta1.IBCHookMemo.Payload.Msg.receive_packet:type_name -> band.tunnel.v1beta1.IBCHookMemo.Payload.Msg.ReceivePacket
+	4,  // 10: band.tunnel.v1beta1.IBCHookMemo.Payload.Msg.ReceivePacket.packet:type_name -> band.tunnel.v1beta1.TunnelPricesPacketData
+	21, // 11: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.msg:type_name -> band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg
+	22, // 12: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.receive_encoded_packet:type_name -> band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket
+	26, // 13: band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket.encoder:type_name -> band.feeds.v1beta1.Encoder
+	24, // 14: band.tunnel.v1beta1.RouterMemo.Payload.msg:type_name -> band.tunnel.v1beta1.RouterMemo.Payload.Msg
+	25, // 15: band.tunnel.v1beta1.RouterMemo.Payload.Msg.receive_band_data:type_name -> band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_band_tunnel_v1beta1_route_proto_init() }
@@ -10165,7 +13981,7 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_route_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RouterRoute); i {
+			switch v := v.(*IBCHookEncodedMemo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10177,7 +13993,7 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_route_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RouterPacketReceipt); i {
+			switch v := v.(*RouterRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10189,7 +14005,7 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_route_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RouterMemo); i {
+			switch v := v.(*RouterPacketReceipt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10201,7 +14017,7 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_route_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AxelarRoute); i {
+			switch v := v.(*RouterMemo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10213,7 +14029,7 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_route_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AxelarPacketReceipt); i {
+			switch v := v.(*AxelarRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10225,7 +14041,7 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_route_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IBCHookMemo_Payload); i {
+			switch v := v.(*AxelarPacketReceipt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10237,7 +14053,7 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_route_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IBCHookMemo_Payload_Msg); i {
+			switch v := v.(*LocalRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10249,7 +14065,7 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_route_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IBCHookMemo_Payload_Msg_ReceivePacket); i {
+			switch v := v.(*LocalPacketReceipt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10261,7 +14077,7 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_route_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RouterMemo_Payload); i {
+			switch v := v.(*FailedPacketReceipt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10273,7 +14089,7 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_route_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RouterMemo_Payload_Msg); i {
+			switch v := v.(*IBCHookMemo_Payload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10285,6 +14101,90 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_route_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IBCHookMemo_Payload_Msg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_route_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IBCHookMemo_Payload_Msg_ReceivePacket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_route_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IBCHookEncodedMemo_Payload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_route_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IBCHookEncodedMemo_Payload_Msg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_route_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_route_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouterMemo_Payload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_route_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouterMemo_Payload_Msg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_route_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RouterMemo_Payload_Msg_ReceiveBandDataArgs); i {
 			case 0:
 				return &v.state
@@ -10303,7 +14203,7 @@ func file_band_tunnel_v1beta1_route_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_tunnel_v1beta1_route_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
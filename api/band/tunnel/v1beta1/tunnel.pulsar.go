@@ -120,6 +120,57 @@ func (x *_Tunnel_7_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Tunnel_11_list)(nil)
+
+type _Tunnel_11_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_Tunnel_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Tunnel_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Tunnel_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Tunnel_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Tunnel_11_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Tunnel_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Tunnel_11_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Tunnel_11_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Tunnel                   protoreflect.MessageDescriptor
 	fd_Tunnel_id                protoreflect.FieldDescriptor
@@ -132,6 +183,8 @@ var (
 	fd_Tunnel_is_active         protoreflect.FieldDescriptor
 	fd_Tunnel_created_at        protoreflect.FieldDescriptor
 	fd_Tunnel_creator           protoreflect.FieldDescriptor
+	fd_Tunnel_routes            protoreflect.FieldDescriptor
+	fd_Tunnel_schedule          protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -147,6 +200,8 @@ func init() {
 	fd_Tunnel_is_active = md_Tunnel.Fields().ByName("is_active")
 	fd_Tunnel_created_at = md_Tunnel.Fields().ByName("created_at")
 	fd_Tunnel_creator = md_Tunnel.Fields().ByName("creator")
+	fd_Tunnel_routes = md_Tunnel.Fields().ByName("routes")
+	fd_Tunnel_schedule = md_Tunnel.Fields().ByName("schedule")
 }
 
 var _ protoreflect.Message = (*fastReflection_Tunnel)(nil)
@@ -274,6 +329,18 @@ func (x *fastReflection_Tunnel) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.Routes) != 0 {
+		value := protoreflect.ValueOfList(&_Tunnel_11_list{list: &x.Routes})
+		if !f(fd_Tunnel_routes, value) {
+			return
+		}
+	}
+	if x.Schedule != "" {
+		value := protoreflect.ValueOfString(x.Schedule)
+		if !f(fd_Tunnel_schedule, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -309,6 +376,10 @@ func (x *fastReflection_Tunnel) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.CreatedAt != int64(0)
 	case "band.tunnel.v1beta1.Tunnel.creator":
 		return x.Creator != ""
+	case "band.tunnel.v1beta1.Tunnel.routes":
+		return len(x.Routes) != 0
+	case "band.tunnel.v1beta1.Tunnel.schedule":
+		return x.Schedule != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Tunnel"))
@@ -345,6 +416,10 @@ func (x *fastReflection_Tunnel) Clear(fd protoreflect.FieldDescriptor) {
 		x.CreatedAt = int64(0)
 	case "band.tunnel.v1beta1.Tunnel.creator":
 		x.Creator = ""
+	case "band.tunnel.v1beta1.Tunnel.routes":
+		x.Routes = nil
+	case "band.tunnel.v1beta1.Tunnel.schedule":
+		x.Schedule = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Tunnel"))
@@ -397,6 +472,15 @@ func (x *fastReflection_Tunnel) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "band.tunnel.v1beta1.Tunnel.creator":
 		value := x.Creator
 		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.Tunnel.routes":
+		if len(x.Routes) == 0 {
+			return protoreflect.ValueOfList(&_Tunnel_11_list{})
+		}
+		listValue := &_Tunnel_11_list{list: &x.Routes}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.Tunnel.schedule":
+		value := x.Schedule
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Tunnel"))
@@ -441,6 +525,12 @@ func (x *fastReflection_Tunnel) Set(fd protoreflect.FieldDescriptor, value proto
 		x.CreatedAt = value.Int()
 	case "band.tunnel.v1beta1.Tunnel.creator":
 		x.Creator = value.Interface().(string)
+	case "band.tunnel.v1beta1.Tunnel.routes":
+		lv := value.List()
+		clv := lv.(*_Tunnel_11_list)
+		x.Routes = *clv.list
+	case "band.tunnel.v1beta1.Tunnel.schedule":
+		x.Schedule = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Tunnel"))
@@ -478,6 +568,12 @@ func (x *fastReflection_Tunnel) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Tunnel_7_list{list: &x.TotalDeposit}
 		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.Tunnel.routes":
+		if x.Routes == nil {
+			x.Routes = []*anypb.Any{}
+		}
+		value := &_Tunnel_11_list{list: &x.Routes}
+		return protoreflect.ValueOfList(value)
 	case "band.tunnel.v1beta1.Tunnel.id":
 		panic(fmt.Errorf("field id of message band.tunnel.v1beta1.Tunnel is not mutable"))
 	case "band.tunnel.v1beta1.Tunnel.sequence":
@@ -492,6 +588,8 @@ func (x *fastReflection_Tunnel) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field created_at of message band.tunnel.v1beta1.Tunnel is not mutable"))
 	case "band.tunnel.v1beta1.Tunnel.creator":
 		panic(fmt.Errorf("field creator of message band.tunnel.v1beta1.Tunnel is not mutable"))
+	case "band.tunnel.v1beta1.Tunnel.schedule":
+		panic(fmt.Errorf("field schedule of message band.tunnel.v1beta1.Tunnel is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Tunnel"))
@@ -528,6 +626,11 @@ func (x *fastReflection_Tunnel) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfInt64(int64(0))
 	case "band.tunnel.v1beta1.Tunnel.creator":
 		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.Tunnel.routes":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_Tunnel_11_list{list: &list})
+	case "band.tunnel.v1beta1.Tunnel.schedule":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Tunnel"))
@@ -636,6 +739,16 @@ func (x *fastReflection_Tunnel) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Routes) > 0 {
+			for _, e := range x.Routes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Schedule)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -665,6 +778,29 @@ func (x *fastReflection_Tunnel) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Schedule) > 0 {
+			i -= len(x.Schedule)
+			copy(dAtA[i:], x.Schedule)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Schedule)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.Routes) > 0 {
+			for iNdEx := len(x.Routes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Routes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
 		if len(x.Creator) > 0 {
 			i -= len(x.Creator)
 			copy(dAtA[i:], x.Creator)
@@ -1068,6 +1204,72 @@ func (x *fastReflection_Tunnel) ProtoMethods() *protoiface.Methods {
 				}
 				x.Creator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Routes = append(x.Routes, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Routes[len(x.Routes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Schedule = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2238,6 +2440,57 @@ func (x *_Packet_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Packet_6_list)(nil)
+
+type _Packet_6_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_Packet_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Packet_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Packet_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Packet_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Packet_6_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Packet_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Packet_6_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Packet_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Packet            protoreflect.MessageDescriptor
 	fd_Packet_tunnel_id  protoreflect.FieldDescriptor
@@ -2245,6 +2498,7 @@ var (
 	fd_Packet_prices     protoreflect.FieldDescriptor
 	fd_Packet_receipt    protoreflect.FieldDescriptor
 	fd_Packet_created_at protoreflect.FieldDescriptor
+	fd_Packet_receipts   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2255,6 +2509,7 @@ func init() {
 	fd_Packet_prices = md_Packet.Fields().ByName("prices")
 	fd_Packet_receipt = md_Packet.Fields().ByName("receipt")
 	fd_Packet_created_at = md_Packet.Fields().ByName("created_at")
+	fd_Packet_receipts = md_Packet.Fields().ByName("receipts")
 }
 
 var _ protoreflect.Message = (*fastReflection_Packet)(nil)
@@ -2352,6 +2607,12 @@ func (x *fastReflection_Packet) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.Receipts) != 0 {
+		value := protoreflect.ValueOfList(&_Packet_6_list{list: &x.Receipts})
+		if !f(fd_Packet_receipts, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2377,6 +2638,8 @@ func (x *fastReflection_Packet) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Receipt != nil
 	case "band.tunnel.v1beta1.Packet.created_at":
 		return x.CreatedAt != int64(0)
+	case "band.tunnel.v1beta1.Packet.receipts":
+		return len(x.Receipts) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
@@ -2403,6 +2666,8 @@ func (x *fastReflection_Packet) Clear(fd protoreflect.FieldDescriptor) {
 		x.Receipt = nil
 	case "band.tunnel.v1beta1.Packet.created_at":
 		x.CreatedAt = int64(0)
+	case "band.tunnel.v1beta1.Packet.receipts":
+		x.Receipts = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
@@ -2437,6 +2702,12 @@ func (x *fastReflection_Packet) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "band.tunnel.v1beta1.Packet.created_at":
 		value := x.CreatedAt
 		return protoreflect.ValueOfInt64(value)
+	case "band.tunnel.v1beta1.Packet.receipts":
+		if len(x.Receipts) == 0 {
+			return protoreflect.ValueOfList(&_Packet_6_list{})
+		}
+		listValue := &_Packet_6_list{list: &x.Receipts}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
@@ -2469,6 +2740,10 @@ func (x *fastReflection_Packet) Set(fd protoreflect.FieldDescriptor, value proto
 		x.Receipt = value.Message().Interface().(*anypb.Any)
 	case "band.tunnel.v1beta1.Packet.created_at":
 		x.CreatedAt = value.Int()
+	case "band.tunnel.v1beta1.Packet.receipts":
+		lv := value.List()
+		clv := lv.(*_Packet_6_list)
+		x.Receipts = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
@@ -2500,6 +2775,12 @@ func (x *fastReflection_Packet) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.Receipt = new(anypb.Any)
 		}
 		return protoreflect.ValueOfMessage(x.Receipt.ProtoReflect())
+	case "band.tunnel.v1beta1.Packet.receipts":
+		if x.Receipts == nil {
+			x.Receipts = []*anypb.Any{}
+		}
+		value := &_Packet_6_list{list: &x.Receipts}
+		return protoreflect.ValueOfList(value)
 	case "band.tunnel.v1beta1.Packet.tunnel_id":
 		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.Packet is not mutable"))
 	case "band.tunnel.v1beta1.Packet.sequence":
@@ -2531,6 +2812,9 @@ func (x *fastReflection_Packet) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "band.tunnel.v1beta1.Packet.created_at":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "band.tunnel.v1beta1.Packet.receipts":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_Packet_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Packet"))
@@ -2619,6 +2903,12 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 		if x.CreatedAt != 0 {
 			n += 1 + runtime.Sov(uint64(x.CreatedAt))
 		}
+		if len(x.Receipts) > 0 {
+			for _, e := range x.Receipts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2648,6 +2938,22 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Receipts) > 0 {
+			for iNdEx := len(x.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Receipts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
 		if x.CreatedAt != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
 			i--
@@ -2869,6 +3175,40 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Receipts = append(x.Receipts, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Receipts[len(x.Receipts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2904,129 +3244,86 @@ func (x *fastReflection_Packet) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Deposit_3_list)(nil)
+var (
+	md_PacketRetry                   protoreflect.MessageDescriptor
+	fd_PacketRetry_tunnel_id         protoreflect.FieldDescriptor
+	fd_PacketRetry_sequence          protoreflect.FieldDescriptor
+	fd_PacketRetry_route_index       protoreflect.FieldDescriptor
+	fd_PacketRetry_attempts          protoreflect.FieldDescriptor
+	fd_PacketRetry_next_retry_height protoreflect.FieldDescriptor
+	fd_PacketRetry_last_error        protoreflect.FieldDescriptor
+	fd_PacketRetry_route_hash        protoreflect.FieldDescriptor
+)
 
-type _Deposit_3_list struct {
-	list *[]*v1beta1.Coin
+func init() {
+	file_band_tunnel_v1beta1_tunnel_proto_init()
+	md_PacketRetry = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("PacketRetry")
+	fd_PacketRetry_tunnel_id = md_PacketRetry.Fields().ByName("tunnel_id")
+	fd_PacketRetry_sequence = md_PacketRetry.Fields().ByName("sequence")
+	fd_PacketRetry_route_index = md_PacketRetry.Fields().ByName("route_index")
+	fd_PacketRetry_attempts = md_PacketRetry.Fields().ByName("attempts")
+	fd_PacketRetry_next_retry_height = md_PacketRetry.Fields().ByName("next_retry_height")
+	fd_PacketRetry_last_error = md_PacketRetry.Fields().ByName("last_error")
+	fd_PacketRetry_route_hash = md_PacketRetry.Fields().ByName("route_hash")
 }
 
-func (x *_Deposit_3_list) Len() int {
-	if x.list == nil {
-		return 0
+var _ protoreflect.Message = (*fastReflection_PacketRetry)(nil)
+
+type fastReflection_PacketRetry PacketRetry
+
+func (x *PacketRetry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PacketRetry)(x)
+}
+
+func (x *PacketRetry) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return len(*x.list)
+	return mi.MessageOf(x)
 }
 
-func (x *_Deposit_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+var _fastReflection_PacketRetry_messageType fastReflection_PacketRetry_messageType
+var _ protoreflect.MessageType = fastReflection_PacketRetry_messageType{}
+
+type fastReflection_PacketRetry_messageType struct{}
+
+func (x fastReflection_PacketRetry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PacketRetry)(nil)
+}
+func (x fastReflection_PacketRetry_messageType) New() protoreflect.Message {
+	return new(fastReflection_PacketRetry)
+}
+func (x fastReflection_PacketRetry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PacketRetry
 }
 
-func (x *_Deposit_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Deposit_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Deposit_3_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Deposit_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Deposit_3_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Deposit_3_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_Deposit           protoreflect.MessageDescriptor
-	fd_Deposit_tunnel_id protoreflect.FieldDescriptor
-	fd_Deposit_depositor protoreflect.FieldDescriptor
-	fd_Deposit_amount    protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_band_tunnel_v1beta1_tunnel_proto_init()
-	md_Deposit = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("Deposit")
-	fd_Deposit_tunnel_id = md_Deposit.Fields().ByName("tunnel_id")
-	fd_Deposit_depositor = md_Deposit.Fields().ByName("depositor")
-	fd_Deposit_amount = md_Deposit.Fields().ByName("amount")
-}
-
-var _ protoreflect.Message = (*fastReflection_Deposit)(nil)
-
-type fastReflection_Deposit Deposit
-
-func (x *Deposit) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Deposit)(x)
-}
-
-func (x *Deposit) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_Deposit_messageType fastReflection_Deposit_messageType
-var _ protoreflect.MessageType = fastReflection_Deposit_messageType{}
-
-type fastReflection_Deposit_messageType struct{}
-
-func (x fastReflection_Deposit_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Deposit)(nil)
-}
-func (x fastReflection_Deposit_messageType) New() protoreflect.Message {
-	return new(fastReflection_Deposit)
-}
-func (x fastReflection_Deposit_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Deposit
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_Deposit) Descriptor() protoreflect.MessageDescriptor {
-	return md_Deposit
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PacketRetry) Descriptor() protoreflect.MessageDescriptor {
+	return md_PacketRetry
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Deposit) Type() protoreflect.MessageType {
-	return _fastReflection_Deposit_messageType
+func (x *fastReflection_PacketRetry) Type() protoreflect.MessageType {
+	return _fastReflection_PacketRetry_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Deposit) New() protoreflect.Message {
-	return new(fastReflection_Deposit)
+func (x *fastReflection_PacketRetry) New() protoreflect.Message {
+	return new(fastReflection_PacketRetry)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Deposit) Interface() protoreflect.ProtoMessage {
-	return (*Deposit)(x)
+func (x *fastReflection_PacketRetry) Interface() protoreflect.ProtoMessage {
+	return (*PacketRetry)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3034,22 +3331,46 @@ func (x *fastReflection_Deposit) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Deposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_PacketRetry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.TunnelId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.TunnelId)
-		if !f(fd_Deposit_tunnel_id, value) {
+		if !f(fd_PacketRetry_tunnel_id, value) {
 			return
 		}
 	}
-	if x.Depositor != "" {
-		value := protoreflect.ValueOfString(x.Depositor)
-		if !f(fd_Deposit_depositor, value) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_PacketRetry_sequence, value) {
 			return
 		}
 	}
-	if len(x.Amount) != 0 {
-		value := protoreflect.ValueOfList(&_Deposit_3_list{list: &x.Amount})
-		if !f(fd_Deposit_amount, value) {
+	if x.RouteIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RouteIndex)
+		if !f(fd_PacketRetry_route_index, value) {
+			return
+		}
+	}
+	if x.Attempts != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Attempts)
+		if !f(fd_PacketRetry_attempts, value) {
+			return
+		}
+	}
+	if x.NextRetryHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NextRetryHeight)
+		if !f(fd_PacketRetry_next_retry_height, value) {
+			return
+		}
+	}
+	if x.LastError != "" {
+		value := protoreflect.ValueOfString(x.LastError)
+		if !f(fd_PacketRetry_last_error, value) {
+			return
+		}
+	}
+	if len(x.RouteHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.RouteHash)
+		if !f(fd_PacketRetry_route_hash, value) {
 			return
 		}
 	}
@@ -3066,19 +3387,27 @@ func (x *fastReflection_Deposit) Range(f func(protoreflect.FieldDescriptor, prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Deposit) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PacketRetry) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Deposit.tunnel_id":
+	case "band.tunnel.v1beta1.PacketRetry.tunnel_id":
 		return x.TunnelId != uint64(0)
-	case "band.tunnel.v1beta1.Deposit.depositor":
-		return x.Depositor != ""
-	case "band.tunnel.v1beta1.Deposit.amount":
-		return len(x.Amount) != 0
+	case "band.tunnel.v1beta1.PacketRetry.sequence":
+		return x.Sequence != uint64(0)
+	case "band.tunnel.v1beta1.PacketRetry.route_index":
+		return x.RouteIndex != uint64(0)
+	case "band.tunnel.v1beta1.PacketRetry.attempts":
+		return x.Attempts != uint64(0)
+	case "band.tunnel.v1beta1.PacketRetry.next_retry_height":
+		return x.NextRetryHeight != int64(0)
+	case "band.tunnel.v1beta1.PacketRetry.last_error":
+		return x.LastError != ""
+	case "band.tunnel.v1beta1.PacketRetry.route_hash":
+		return len(x.RouteHash) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.PacketRetry"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.PacketRetry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3088,19 +3417,27 @@ func (x *fastReflection_Deposit) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Deposit) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PacketRetry) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Deposit.tunnel_id":
+	case "band.tunnel.v1beta1.PacketRetry.tunnel_id":
 		x.TunnelId = uint64(0)
-	case "band.tunnel.v1beta1.Deposit.depositor":
-		x.Depositor = ""
-	case "band.tunnel.v1beta1.Deposit.amount":
-		x.Amount = nil
+	case "band.tunnel.v1beta1.PacketRetry.sequence":
+		x.Sequence = uint64(0)
+	case "band.tunnel.v1beta1.PacketRetry.route_index":
+		x.RouteIndex = uint64(0)
+	case "band.tunnel.v1beta1.PacketRetry.attempts":
+		x.Attempts = uint64(0)
+	case "band.tunnel.v1beta1.PacketRetry.next_retry_height":
+		x.NextRetryHeight = int64(0)
+	case "band.tunnel.v1beta1.PacketRetry.last_error":
+		x.LastError = ""
+	case "band.tunnel.v1beta1.PacketRetry.route_hash":
+		x.RouteHash = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.PacketRetry"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.PacketRetry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3110,25 +3447,34 @@ func (x *fastReflection_Deposit) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Deposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PacketRetry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.Deposit.tunnel_id":
+	case "band.tunnel.v1beta1.PacketRetry.tunnel_id":
 		value := x.TunnelId
 		return protoreflect.ValueOfUint64(value)
-	case "band.tunnel.v1beta1.Deposit.depositor":
-		value := x.Depositor
+	case "band.tunnel.v1beta1.PacketRetry.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.PacketRetry.route_index":
+		value := x.RouteIndex
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.PacketRetry.attempts":
+		value := x.Attempts
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.PacketRetry.next_retry_height":
+		value := x.NextRetryHeight
+		return protoreflect.ValueOfInt64(value)
+	case "band.tunnel.v1beta1.PacketRetry.last_error":
+		value := x.LastError
 		return protoreflect.ValueOfString(value)
-	case "band.tunnel.v1beta1.Deposit.amount":
-		if len(x.Amount) == 0 {
-			return protoreflect.ValueOfList(&_Deposit_3_list{})
-		}
-		listValue := &_Deposit_3_list{list: &x.Amount}
-		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.PacketRetry.route_hash":
+		value := x.RouteHash
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.PacketRetry"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.PacketRetry does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3142,21 +3488,27 @@ func (x *fastReflection_Deposit) Get(descriptor protoreflect.FieldDescriptor) pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Deposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PacketRetry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Deposit.tunnel_id":
+	case "band.tunnel.v1beta1.PacketRetry.tunnel_id":
 		x.TunnelId = value.Uint()
-	case "band.tunnel.v1beta1.Deposit.depositor":
-		x.Depositor = value.Interface().(string)
-	case "band.tunnel.v1beta1.Deposit.amount":
-		lv := value.List()
-		clv := lv.(*_Deposit_3_list)
-		x.Amount = *clv.list
+	case "band.tunnel.v1beta1.PacketRetry.sequence":
+		x.Sequence = value.Uint()
+	case "band.tunnel.v1beta1.PacketRetry.route_index":
+		x.RouteIndex = value.Uint()
+	case "band.tunnel.v1beta1.PacketRetry.attempts":
+		x.Attempts = value.Uint()
+	case "band.tunnel.v1beta1.PacketRetry.next_retry_height":
+		x.NextRetryHeight = value.Int()
+	case "band.tunnel.v1beta1.PacketRetry.last_error":
+		x.LastError = value.Interface().(string)
+	case "band.tunnel.v1beta1.PacketRetry.route_hash":
+		x.RouteHash = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.PacketRetry"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.PacketRetry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3170,53 +3522,64 @@ func (x *fastReflection_Deposit) Set(fd protoreflect.FieldDescriptor, value prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Deposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PacketRetry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Deposit.amount":
-		if x.Amount == nil {
-			x.Amount = []*v1beta1.Coin{}
-		}
-		value := &_Deposit_3_list{list: &x.Amount}
-		return protoreflect.ValueOfList(value)
-	case "band.tunnel.v1beta1.Deposit.tunnel_id":
-		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.Deposit is not mutable"))
-	case "band.tunnel.v1beta1.Deposit.depositor":
-		panic(fmt.Errorf("field depositor of message band.tunnel.v1beta1.Deposit is not mutable"))
+	case "band.tunnel.v1beta1.PacketRetry.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.PacketRetry is not mutable"))
+	case "band.tunnel.v1beta1.PacketRetry.sequence":
+		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.PacketRetry is not mutable"))
+	case "band.tunnel.v1beta1.PacketRetry.route_index":
+		panic(fmt.Errorf("field route_index of message band.tunnel.v1beta1.PacketRetry is not mutable"))
+	case "band.tunnel.v1beta1.PacketRetry.attempts":
+		panic(fmt.Errorf("field attempts of message band.tunnel.v1beta1.PacketRetry is not mutable"))
+	case "band.tunnel.v1beta1.PacketRetry.next_retry_height":
+		panic(fmt.Errorf("field next_retry_height of message band.tunnel.v1beta1.PacketRetry is not mutable"))
+	case "band.tunnel.v1beta1.PacketRetry.last_error":
+		panic(fmt.Errorf("field last_error of message band.tunnel.v1beta1.PacketRetry is not mutable"))
+	case "band.tunnel.v1beta1.PacketRetry.route_hash":
+		panic(fmt.Errorf("field route_hash of message band.tunnel.v1beta1.PacketRetry is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.PacketRetry"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.PacketRetry does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Deposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PacketRetry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.Deposit.tunnel_id":
+	case "band.tunnel.v1beta1.PacketRetry.tunnel_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.tunnel.v1beta1.Deposit.depositor":
+	case "band.tunnel.v1beta1.PacketRetry.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.PacketRetry.route_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.PacketRetry.attempts":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.PacketRetry.next_retry_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.tunnel.v1beta1.PacketRetry.last_error":
 		return protoreflect.ValueOfString("")
-	case "band.tunnel.v1beta1.Deposit.amount":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Deposit_3_list{list: &list})
+	case "band.tunnel.v1beta1.PacketRetry.route_hash":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.PacketRetry"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.PacketRetry does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Deposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PacketRetry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.Deposit", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.PacketRetry", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3224,7 +3587,7 @@ func (x *fastReflection_Deposit) WhichOneof(d protoreflect.OneofDescriptor) prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Deposit) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PacketRetry) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3235,7 +3598,7 @@ func (x *fastReflection_Deposit) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Deposit) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PacketRetry) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3247,7 +3610,7 @@ func (x *fastReflection_Deposit) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Deposit) IsValid() bool {
+func (x *fastReflection_PacketRetry) IsValid() bool {
 	return x != nil
 }
 
@@ -3257,9 +3620,9 @@ func (x *fastReflection_Deposit) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PacketRetry) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Deposit)
+		x := input.Message.Interface().(*PacketRetry)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3274,15 +3637,25 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 		if x.TunnelId != 0 {
 			n += 1 + runtime.Sov(uint64(x.TunnelId))
 		}
-		l = len(x.Depositor)
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if x.RouteIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.RouteIndex))
+		}
+		if x.Attempts != 0 {
+			n += 1 + runtime.Sov(uint64(x.Attempts))
+		}
+		if x.NextRetryHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextRetryHeight))
+		}
+		l = len(x.LastError)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Amount) > 0 {
-			for _, e := range x.Amount {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.RouteHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3294,7 +3667,7 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Deposit)
+		x := input.Message.Interface().(*PacketRetry)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3313,28 +3686,39 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Amount) > 0 {
-			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Amount[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
+		if len(x.RouteHash) > 0 {
+			i -= len(x.RouteHash)
+			copy(dAtA[i:], x.RouteHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RouteHash)))
+			i--
+			dAtA[i] = 0x3a
 		}
-		if len(x.Depositor) > 0 {
-			i -= len(x.Depositor)
-			copy(dAtA[i:], x.Depositor)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
+		if len(x.LastError) > 0 {
+			i -= len(x.LastError)
+			copy(dAtA[i:], x.LastError)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LastError)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x32
+		}
+		if x.NextRetryHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextRetryHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Attempts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Attempts))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.RouteIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RouteIndex))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x10
 		}
 		if x.TunnelId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
@@ -3352,7 +3736,7 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Deposit)
+		x := input.Message.Interface().(*PacketRetry)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3384,10 +3768,10 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PacketRetry: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PacketRetry: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -3410,10 +3794,10 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 					}
 				}
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 				}
-				var stringLen uint64
+				x.Sequence = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3423,29 +3807,105 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Sequence |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RouteIndex", wireType)
 				}
-				postIndex := iNdEx + intStringLen
+				x.RouteIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RouteIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+				}
+				x.Attempts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Attempts |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextRetryHeight", wireType)
+				}
+				x.NextRetryHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextRetryHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Depositor = string(dAtA[iNdEx:postIndex])
+				x.LastError = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RouteHash", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3455,24 +3915,24 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = append(x.Amount, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.RouteHash = append(x.RouteHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.RouteHash == nil {
+					x.RouteHash = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -3511,29 +3971,37 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SignalDeviation                    protoreflect.MessageDescriptor
-	fd_SignalDeviation_signal_id          protoreflect.FieldDescriptor
-	fd_SignalDeviation_soft_deviation_bps protoreflect.FieldDescriptor
-	fd_SignalDeviation_hard_deviation_bps protoreflect.FieldDescriptor
+	md_DeadLetterPacket             protoreflect.MessageDescriptor
+	fd_DeadLetterPacket_tunnel_id   protoreflect.FieldDescriptor
+	fd_DeadLetterPacket_sequence    protoreflect.FieldDescriptor
+	fd_DeadLetterPacket_route_index protoreflect.FieldDescriptor
+	fd_DeadLetterPacket_attempts    protoreflect.FieldDescriptor
+	fd_DeadLetterPacket_last_error  protoreflect.FieldDescriptor
+	fd_DeadLetterPacket_created_at  protoreflect.FieldDescriptor
+	fd_DeadLetterPacket_route_hash  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_tunnel_proto_init()
-	md_SignalDeviation = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("SignalDeviation")
-	fd_SignalDeviation_signal_id = md_SignalDeviation.Fields().ByName("signal_id")
-	fd_SignalDeviation_soft_deviation_bps = md_SignalDeviation.Fields().ByName("soft_deviation_bps")
-	fd_SignalDeviation_hard_deviation_bps = md_SignalDeviation.Fields().ByName("hard_deviation_bps")
+	md_DeadLetterPacket = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("DeadLetterPacket")
+	fd_DeadLetterPacket_tunnel_id = md_DeadLetterPacket.Fields().ByName("tunnel_id")
+	fd_DeadLetterPacket_sequence = md_DeadLetterPacket.Fields().ByName("sequence")
+	fd_DeadLetterPacket_route_index = md_DeadLetterPacket.Fields().ByName("route_index")
+	fd_DeadLetterPacket_attempts = md_DeadLetterPacket.Fields().ByName("attempts")
+	fd_DeadLetterPacket_last_error = md_DeadLetterPacket.Fields().ByName("last_error")
+	fd_DeadLetterPacket_created_at = md_DeadLetterPacket.Fields().ByName("created_at")
+	fd_DeadLetterPacket_route_hash = md_DeadLetterPacket.Fields().ByName("route_hash")
 }
 
-var _ protoreflect.Message = (*fastReflection_SignalDeviation)(nil)
+var _ protoreflect.Message = (*fastReflection_DeadLetterPacket)(nil)
 
-type fastReflection_SignalDeviation SignalDeviation
+type fastReflection_DeadLetterPacket DeadLetterPacket
 
-func (x *SignalDeviation) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SignalDeviation)(x)
+func (x *DeadLetterPacket) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DeadLetterPacket)(x)
 }
 
-func (x *SignalDeviation) slowProtoReflect() protoreflect.Message {
+func (x *DeadLetterPacket) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3545,43 +4013,43 @@ func (x *SignalDeviation) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SignalDeviation_messageType fastReflection_SignalDeviation_messageType
-var _ protoreflect.MessageType = fastReflection_SignalDeviation_messageType{}
+var _fastReflection_DeadLetterPacket_messageType fastReflection_DeadLetterPacket_messageType
+var _ protoreflect.MessageType = fastReflection_DeadLetterPacket_messageType{}
 
-type fastReflection_SignalDeviation_messageType struct{}
+type fastReflection_DeadLetterPacket_messageType struct{}
 
-func (x fastReflection_SignalDeviation_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SignalDeviation)(nil)
+func (x fastReflection_DeadLetterPacket_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DeadLetterPacket)(nil)
 }
-func (x fastReflection_SignalDeviation_messageType) New() protoreflect.Message {
-	return new(fastReflection_SignalDeviation)
+func (x fastReflection_DeadLetterPacket_messageType) New() protoreflect.Message {
+	return new(fastReflection_DeadLetterPacket)
 }
-func (x fastReflection_SignalDeviation_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SignalDeviation
+func (x fastReflection_DeadLetterPacket_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DeadLetterPacket
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SignalDeviation) Descriptor() protoreflect.MessageDescriptor {
-	return md_SignalDeviation
+func (x *fastReflection_DeadLetterPacket) Descriptor() protoreflect.MessageDescriptor {
+	return md_DeadLetterPacket
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SignalDeviation) Type() protoreflect.MessageType {
-	return _fastReflection_SignalDeviation_messageType
+func (x *fastReflection_DeadLetterPacket) Type() protoreflect.MessageType {
+	return _fastReflection_DeadLetterPacket_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SignalDeviation) New() protoreflect.Message {
-	return new(fastReflection_SignalDeviation)
+func (x *fastReflection_DeadLetterPacket) New() protoreflect.Message {
+	return new(fastReflection_DeadLetterPacket)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SignalDeviation) Interface() protoreflect.ProtoMessage {
-	return (*SignalDeviation)(x)
+func (x *fastReflection_DeadLetterPacket) Interface() protoreflect.ProtoMessage {
+	return (*DeadLetterPacket)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3589,22 +4057,46 @@ func (x *fastReflection_SignalDeviation) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SignalDeviation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.SignalId != "" {
-		value := protoreflect.ValueOfString(x.SignalId)
-		if !f(fd_SignalDeviation_signal_id, value) {
+func (x *fastReflection_DeadLetterPacket) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_DeadLetterPacket_tunnel_id, value) {
 			return
 		}
 	}
-	if x.SoftDeviationBps != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.SoftDeviationBps)
-		if !f(fd_SignalDeviation_soft_deviation_bps, value) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_DeadLetterPacket_sequence, value) {
 			return
 		}
 	}
-	if x.HardDeviationBps != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.HardDeviationBps)
-		if !f(fd_SignalDeviation_hard_deviation_bps, value) {
+	if x.RouteIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RouteIndex)
+		if !f(fd_DeadLetterPacket_route_index, value) {
+			return
+		}
+	}
+	if x.Attempts != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Attempts)
+		if !f(fd_DeadLetterPacket_attempts, value) {
+			return
+		}
+	}
+	if x.LastError != "" {
+		value := protoreflect.ValueOfString(x.LastError)
+		if !f(fd_DeadLetterPacket_last_error, value) {
+			return
+		}
+	}
+	if x.CreatedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreatedAt)
+		if !f(fd_DeadLetterPacket_created_at, value) {
+			return
+		}
+	}
+	if len(x.RouteHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.RouteHash)
+		if !f(fd_DeadLetterPacket_route_hash, value) {
 			return
 		}
 	}
@@ -3621,19 +4113,27 @@ func (x *fastReflection_SignalDeviation) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SignalDeviation) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DeadLetterPacket) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
-		return x.SignalId != ""
-	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
-		return x.SoftDeviationBps != uint64(0)
-	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
-		return x.HardDeviationBps != uint64(0)
+	case "band.tunnel.v1beta1.DeadLetterPacket.tunnel_id":
+		return x.TunnelId != uint64(0)
+	case "band.tunnel.v1beta1.DeadLetterPacket.sequence":
+		return x.Sequence != uint64(0)
+	case "band.tunnel.v1beta1.DeadLetterPacket.route_index":
+		return x.RouteIndex != uint64(0)
+	case "band.tunnel.v1beta1.DeadLetterPacket.attempts":
+		return x.Attempts != uint64(0)
+	case "band.tunnel.v1beta1.DeadLetterPacket.last_error":
+		return x.LastError != ""
+	case "band.tunnel.v1beta1.DeadLetterPacket.created_at":
+		return x.CreatedAt != int64(0)
+	case "band.tunnel.v1beta1.DeadLetterPacket.route_hash":
+		return len(x.RouteHash) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.DeadLetterPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.DeadLetterPacket does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3643,19 +4143,27 @@ func (x *fastReflection_SignalDeviation) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignalDeviation) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DeadLetterPacket) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
-		x.SignalId = ""
-	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
-		x.SoftDeviationBps = uint64(0)
-	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
-		x.HardDeviationBps = uint64(0)
+	case "band.tunnel.v1beta1.DeadLetterPacket.tunnel_id":
+		x.TunnelId = uint64(0)
+	case "band.tunnel.v1beta1.DeadLetterPacket.sequence":
+		x.Sequence = uint64(0)
+	case "band.tunnel.v1beta1.DeadLetterPacket.route_index":
+		x.RouteIndex = uint64(0)
+	case "band.tunnel.v1beta1.DeadLetterPacket.attempts":
+		x.Attempts = uint64(0)
+	case "band.tunnel.v1beta1.DeadLetterPacket.last_error":
+		x.LastError = ""
+	case "band.tunnel.v1beta1.DeadLetterPacket.created_at":
+		x.CreatedAt = int64(0)
+	case "band.tunnel.v1beta1.DeadLetterPacket.route_hash":
+		x.RouteHash = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.DeadLetterPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.DeadLetterPacket does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3665,22 +4173,34 @@ func (x *fastReflection_SignalDeviation) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SignalDeviation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DeadLetterPacket) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
-		value := x.SignalId
-		return protoreflect.ValueOfString(value)
-	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
-		value := x.SoftDeviationBps
+	case "band.tunnel.v1beta1.DeadLetterPacket.tunnel_id":
+		value := x.TunnelId
 		return protoreflect.ValueOfUint64(value)
-	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
-		value := x.HardDeviationBps
+	case "band.tunnel.v1beta1.DeadLetterPacket.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.DeadLetterPacket.route_index":
+		value := x.RouteIndex
 		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.DeadLetterPacket.attempts":
+		value := x.Attempts
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.DeadLetterPacket.last_error":
+		value := x.LastError
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.DeadLetterPacket.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfInt64(value)
+	case "band.tunnel.v1beta1.DeadLetterPacket.route_hash":
+		value := x.RouteHash
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.DeadLetterPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.DeadLetterPacket does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3694,19 +4214,27 @@ func (x *fastReflection_SignalDeviation) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignalDeviation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DeadLetterPacket) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
-		x.SignalId = value.Interface().(string)
-	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
-		x.SoftDeviationBps = value.Uint()
-	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
-		x.HardDeviationBps = value.Uint()
+	case "band.tunnel.v1beta1.DeadLetterPacket.tunnel_id":
+		x.TunnelId = value.Uint()
+	case "band.tunnel.v1beta1.DeadLetterPacket.sequence":
+		x.Sequence = value.Uint()
+	case "band.tunnel.v1beta1.DeadLetterPacket.route_index":
+		x.RouteIndex = value.Uint()
+	case "band.tunnel.v1beta1.DeadLetterPacket.attempts":
+		x.Attempts = value.Uint()
+	case "band.tunnel.v1beta1.DeadLetterPacket.last_error":
+		x.LastError = value.Interface().(string)
+	case "band.tunnel.v1beta1.DeadLetterPacket.created_at":
+		x.CreatedAt = value.Int()
+	case "band.tunnel.v1beta1.DeadLetterPacket.route_hash":
+		x.RouteHash = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.DeadLetterPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.DeadLetterPacket does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3720,48 +4248,64 @@ func (x *fastReflection_SignalDeviation) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignalDeviation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DeadLetterPacket) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
-		panic(fmt.Errorf("field signal_id of message band.tunnel.v1beta1.SignalDeviation is not mutable"))
-	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
-		panic(fmt.Errorf("field soft_deviation_bps of message band.tunnel.v1beta1.SignalDeviation is not mutable"))
-	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
-		panic(fmt.Errorf("field hard_deviation_bps of message band.tunnel.v1beta1.SignalDeviation is not mutable"))
+	case "band.tunnel.v1beta1.DeadLetterPacket.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.DeadLetterPacket is not mutable"))
+	case "band.tunnel.v1beta1.DeadLetterPacket.sequence":
+		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.DeadLetterPacket is not mutable"))
+	case "band.tunnel.v1beta1.DeadLetterPacket.route_index":
+		panic(fmt.Errorf("field route_index of message band.tunnel.v1beta1.DeadLetterPacket is not mutable"))
+	case "band.tunnel.v1beta1.DeadLetterPacket.attempts":
+		panic(fmt.Errorf("field attempts of message band.tunnel.v1beta1.DeadLetterPacket is not mutable"))
+	case "band.tunnel.v1beta1.DeadLetterPacket.last_error":
+		panic(fmt.Errorf("field last_error of message band.tunnel.v1beta1.DeadLetterPacket is not mutable"))
+	case "band.tunnel.v1beta1.DeadLetterPacket.created_at":
+		panic(fmt.Errorf("field created_at of message band.tunnel.v1beta1.DeadLetterPacket is not mutable"))
+	case "band.tunnel.v1beta1.DeadLetterPacket.route_hash":
+		panic(fmt.Errorf("field route_hash of message band.tunnel.v1beta1.DeadLetterPacket is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.DeadLetterPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.DeadLetterPacket does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SignalDeviation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DeadLetterPacket) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
-		return protoreflect.ValueOfString("")
-	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+	case "band.tunnel.v1beta1.DeadLetterPacket.tunnel_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+	case "band.tunnel.v1beta1.DeadLetterPacket.sequence":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.DeadLetterPacket.route_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.DeadLetterPacket.attempts":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.DeadLetterPacket.last_error":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.DeadLetterPacket.created_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.tunnel.v1beta1.DeadLetterPacket.route_hash":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.DeadLetterPacket"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.DeadLetterPacket does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SignalDeviation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DeadLetterPacket) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.SignalDeviation", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.DeadLetterPacket", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3769,7 +4313,7 @@ func (x *fastReflection_SignalDeviation) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SignalDeviation) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DeadLetterPacket) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3780,7 +4324,7 @@ func (x *fastReflection_SignalDeviation) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SignalDeviation) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DeadLetterPacket) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3792,7 +4336,7 @@ func (x *fastReflection_SignalDeviation) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SignalDeviation) IsValid() bool {
+func (x *fastReflection_DeadLetterPacket) IsValid() bool {
 	return x != nil
 }
 
@@ -3802,9 +4346,9 @@ func (x *fastReflection_SignalDeviation) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DeadLetterPacket) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SignalDeviation)
+		x := input.Message.Interface().(*DeadLetterPacket)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3816,15 +4360,28 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.SignalId)
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if x.RouteIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.RouteIndex))
+		}
+		if x.Attempts != 0 {
+			n += 1 + runtime.Sov(uint64(x.Attempts))
+		}
+		l = len(x.LastError)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.SoftDeviationBps != 0 {
-			n += 1 + runtime.Sov(uint64(x.SoftDeviationBps))
+		if x.CreatedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedAt))
 		}
-		if x.HardDeviationBps != 0 {
-			n += 1 + runtime.Sov(uint64(x.HardDeviationBps))
+		l = len(x.RouteHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3836,7 +4393,7 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SignalDeviation)
+		x := input.Message.Interface().(*DeadLetterPacket)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3855,35 +4412,57 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.HardDeviationBps != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.HardDeviationBps))
+		if len(x.RouteHash) > 0 {
+			i -= len(x.RouteHash)
+			copy(dAtA[i:], x.RouteHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RouteHash)))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x3a
 		}
-		if x.SoftDeviationBps != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SoftDeviationBps))
+		if x.CreatedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x30
 		}
-		if len(x.SignalId) > 0 {
-			i -= len(x.SignalId)
-			copy(dAtA[i:], x.SignalId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+		if len(x.LastError) > 0 {
+			i -= len(x.LastError)
+			copy(dAtA[i:], x.LastError)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LastError)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x2a
 		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+		if x.Attempts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Attempts))
+			i--
+			dAtA[i] = 0x20
 		}
-		return protoiface.MarshalOutput{
+		if x.RouteIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RouteIndex))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 			Buf:               input.Buf,
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SignalDeviation)
+		x := input.Message.Interface().(*DeadLetterPacket)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3915,15 +4494,91 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalDeviation: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DeadLetterPacket: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DeadLetterPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				}
+				x.TunnelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TunnelId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RouteIndex", wireType)
+				}
+				x.RouteIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RouteIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+				}
+				x.Attempts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Attempts |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3951,13 +4606,13 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SignalId = string(dAtA[iNdEx:postIndex])
+				x.LastError = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 6:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SoftDeviationBps", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 				}
-				x.SoftDeviationBps = 0
+				x.CreatedAt = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3967,16 +4622,16 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.SoftDeviationBps |= uint64(b&0x7F) << shift
+					x.CreatedAt |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HardDeviationBps", wireType)
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RouteHash", wireType)
 				}
-				x.HardDeviationBps = 0
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3986,11 +4641,26 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.HardDeviationBps |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RouteHash = append(x.RouteHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.RouteHash == nil {
+					x.RouteHash = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4026,83 +4696,136 @@ func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_TunnelSignatureOrder_2_list)(nil)
+var _ protoreflect.List = (*_TopUpConfig_2_list)(nil)
 
-type _TunnelSignatureOrder_2_list struct {
-	list *[]*v1beta11.Price
+type _TopUpConfig_2_list struct {
+	list *[]*v1beta1.Coin
 }
 
-func (x *_TunnelSignatureOrder_2_list) Len() int {
+func (x *_TopUpConfig_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_TunnelSignatureOrder_2_list) Get(i int) protoreflect.Value {
+func (x *_TopUpConfig_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_TunnelSignatureOrder_2_list) Set(i int, value protoreflect.Value) {
+func (x *_TopUpConfig_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta11.Price)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_TunnelSignatureOrder_2_list) Append(value protoreflect.Value) {
+func (x *_TopUpConfig_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta11.Price)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_TunnelSignatureOrder_2_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta11.Price)
+func (x *_TopUpConfig_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_TunnelSignatureOrder_2_list) Truncate(n int) {
+func (x *_TopUpConfig_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_TunnelSignatureOrder_2_list) NewElement() protoreflect.Value {
-	v := new(v1beta11.Price)
+func (x *_TopUpConfig_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_TunnelSignatureOrder_2_list) IsValid() bool {
+func (x *_TopUpConfig_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopUpConfig_5_list)(nil)
+
+type _TopUpConfig_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_TopUpConfig_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopUpConfig_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TopUpConfig_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopUpConfig_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopUpConfig_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TopUpConfig_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopUpConfig_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TopUpConfig_5_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_TunnelSignatureOrder            protoreflect.MessageDescriptor
-	fd_TunnelSignatureOrder_sequence   protoreflect.FieldDescriptor
-	fd_TunnelSignatureOrder_prices     protoreflect.FieldDescriptor
-	fd_TunnelSignatureOrder_created_at protoreflect.FieldDescriptor
-	fd_TunnelSignatureOrder_encoder    protoreflect.FieldDescriptor
+	md_TopUpConfig                       protoreflect.MessageDescriptor
+	fd_TopUpConfig_tunnel_id             protoreflect.FieldDescriptor
+	fd_TopUpConfig_low_balance_threshold protoreflect.FieldDescriptor
+	fd_TopUpConfig_funder                protoreflect.FieldDescriptor
+	fd_TopUpConfig_grant_type            protoreflect.FieldDescriptor
+	fd_TopUpConfig_top_up_amount         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_tunnel_v1beta1_tunnel_proto_init()
-	md_TunnelSignatureOrder = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("TunnelSignatureOrder")
-	fd_TunnelSignatureOrder_sequence = md_TunnelSignatureOrder.Fields().ByName("sequence")
-	fd_TunnelSignatureOrder_prices = md_TunnelSignatureOrder.Fields().ByName("prices")
-	fd_TunnelSignatureOrder_created_at = md_TunnelSignatureOrder.Fields().ByName("created_at")
-	fd_TunnelSignatureOrder_encoder = md_TunnelSignatureOrder.Fields().ByName("encoder")
+	md_TopUpConfig = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("TopUpConfig")
+	fd_TopUpConfig_tunnel_id = md_TopUpConfig.Fields().ByName("tunnel_id")
+	fd_TopUpConfig_low_balance_threshold = md_TopUpConfig.Fields().ByName("low_balance_threshold")
+	fd_TopUpConfig_funder = md_TopUpConfig.Fields().ByName("funder")
+	fd_TopUpConfig_grant_type = md_TopUpConfig.Fields().ByName("grant_type")
+	fd_TopUpConfig_top_up_amount = md_TopUpConfig.Fields().ByName("top_up_amount")
 }
 
-var _ protoreflect.Message = (*fastReflection_TunnelSignatureOrder)(nil)
+var _ protoreflect.Message = (*fastReflection_TopUpConfig)(nil)
 
-type fastReflection_TunnelSignatureOrder TunnelSignatureOrder
+type fastReflection_TopUpConfig TopUpConfig
 
-func (x *TunnelSignatureOrder) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TunnelSignatureOrder)(x)
+func (x *TopUpConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopUpConfig)(x)
 }
 
-func (x *TunnelSignatureOrder) slowProtoReflect() protoreflect.Message {
+func (x *TopUpConfig) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4114,43 +4837,43 @@ func (x *TunnelSignatureOrder) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TunnelSignatureOrder_messageType fastReflection_TunnelSignatureOrder_messageType
-var _ protoreflect.MessageType = fastReflection_TunnelSignatureOrder_messageType{}
+var _fastReflection_TopUpConfig_messageType fastReflection_TopUpConfig_messageType
+var _ protoreflect.MessageType = fastReflection_TopUpConfig_messageType{}
 
-type fastReflection_TunnelSignatureOrder_messageType struct{}
+type fastReflection_TopUpConfig_messageType struct{}
 
-func (x fastReflection_TunnelSignatureOrder_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TunnelSignatureOrder)(nil)
+func (x fastReflection_TopUpConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopUpConfig)(nil)
 }
-func (x fastReflection_TunnelSignatureOrder_messageType) New() protoreflect.Message {
-	return new(fastReflection_TunnelSignatureOrder)
+func (x fastReflection_TopUpConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopUpConfig)
 }
-func (x fastReflection_TunnelSignatureOrder_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TunnelSignatureOrder
+func (x fastReflection_TopUpConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopUpConfig
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TunnelSignatureOrder) Descriptor() protoreflect.MessageDescriptor {
-	return md_TunnelSignatureOrder
+func (x *fastReflection_TopUpConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopUpConfig
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TunnelSignatureOrder) Type() protoreflect.MessageType {
-	return _fastReflection_TunnelSignatureOrder_messageType
+func (x *fastReflection_TopUpConfig) Type() protoreflect.MessageType {
+	return _fastReflection_TopUpConfig_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TunnelSignatureOrder) New() protoreflect.Message {
-	return new(fastReflection_TunnelSignatureOrder)
+func (x *fastReflection_TopUpConfig) New() protoreflect.Message {
+	return new(fastReflection_TopUpConfig)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TunnelSignatureOrder) Interface() protoreflect.ProtoMessage {
-	return (*TunnelSignatureOrder)(x)
+func (x *fastReflection_TopUpConfig) Interface() protoreflect.ProtoMessage {
+	return (*TopUpConfig)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4158,28 +4881,34 @@ func (x *fastReflection_TunnelSignatureOrder) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TunnelSignatureOrder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Sequence != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Sequence)
-		if !f(fd_TunnelSignatureOrder_sequence, value) {
+func (x *fastReflection_TopUpConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_TopUpConfig_tunnel_id, value) {
 			return
 		}
 	}
-	if len(x.Prices) != 0 {
-		value := protoreflect.ValueOfList(&_TunnelSignatureOrder_2_list{list: &x.Prices})
-		if !f(fd_TunnelSignatureOrder_prices, value) {
+	if len(x.LowBalanceThreshold) != 0 {
+		value := protoreflect.ValueOfList(&_TopUpConfig_2_list{list: &x.LowBalanceThreshold})
+		if !f(fd_TopUpConfig_low_balance_threshold, value) {
 			return
 		}
 	}
-	if x.CreatedAt != int64(0) {
-		value := protoreflect.ValueOfInt64(x.CreatedAt)
-		if !f(fd_TunnelSignatureOrder_created_at, value) {
+	if x.Funder != "" {
+		value := protoreflect.ValueOfString(x.Funder)
+		if !f(fd_TopUpConfig_funder, value) {
 			return
 		}
 	}
-	if x.Encoder != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Encoder))
-		if !f(fd_TunnelSignatureOrder_encoder, value) {
+	if x.GrantType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.GrantType))
+		if !f(fd_TopUpConfig_grant_type, value) {
+			return
+		}
+	}
+	if len(x.TopUpAmount) != 0 {
+		value := protoreflect.ValueOfList(&_TopUpConfig_5_list{list: &x.TopUpAmount})
+		if !f(fd_TopUpConfig_top_up_amount, value) {
 			return
 		}
 	}
@@ -4196,21 +4925,23 @@ func (x *fastReflection_TunnelSignatureOrder) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TunnelSignatureOrder) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TopUpConfig) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
-		return x.Sequence != uint64(0)
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
-		return len(x.Prices) != 0
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
-		return x.CreatedAt != int64(0)
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
-		return x.Encoder != 0
+	case "band.tunnel.v1beta1.TopUpConfig.tunnel_id":
+		return x.TunnelId != uint64(0)
+	case "band.tunnel.v1beta1.TopUpConfig.low_balance_threshold":
+		return len(x.LowBalanceThreshold) != 0
+	case "band.tunnel.v1beta1.TopUpConfig.funder":
+		return x.Funder != ""
+	case "band.tunnel.v1beta1.TopUpConfig.grant_type":
+		return x.GrantType != 0
+	case "band.tunnel.v1beta1.TopUpConfig.top_up_amount":
+		return len(x.TopUpAmount) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TopUpConfig"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TopUpConfig does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4220,21 +4951,23 @@ func (x *fastReflection_TunnelSignatureOrder) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TunnelSignatureOrder) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TopUpConfig) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
-		x.Sequence = uint64(0)
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
-		x.Prices = nil
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
-		x.CreatedAt = int64(0)
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
-		x.Encoder = 0
+	case "band.tunnel.v1beta1.TopUpConfig.tunnel_id":
+		x.TunnelId = uint64(0)
+	case "band.tunnel.v1beta1.TopUpConfig.low_balance_threshold":
+		x.LowBalanceThreshold = nil
+	case "band.tunnel.v1beta1.TopUpConfig.funder":
+		x.Funder = ""
+	case "band.tunnel.v1beta1.TopUpConfig.grant_type":
+		x.GrantType = 0
+	case "band.tunnel.v1beta1.TopUpConfig.top_up_amount":
+		x.TopUpAmount = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TopUpConfig"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TopUpConfig does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4244,28 +4977,34 @@ func (x *fastReflection_TunnelSignatureOrder) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TunnelSignatureOrder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopUpConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
-		value := x.Sequence
+	case "band.tunnel.v1beta1.TopUpConfig.tunnel_id":
+		value := x.TunnelId
 		return protoreflect.ValueOfUint64(value)
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
-		if len(x.Prices) == 0 {
-			return protoreflect.ValueOfList(&_TunnelSignatureOrder_2_list{})
+	case "band.tunnel.v1beta1.TopUpConfig.low_balance_threshold":
+		if len(x.LowBalanceThreshold) == 0 {
+			return protoreflect.ValueOfList(&_TopUpConfig_2_list{})
 		}
-		listValue := &_TunnelSignatureOrder_2_list{list: &x.Prices}
+		listValue := &_TopUpConfig_2_list{list: &x.LowBalanceThreshold}
 		return protoreflect.ValueOfList(listValue)
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
-		value := x.CreatedAt
-		return protoreflect.ValueOfInt64(value)
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
-		value := x.Encoder
+	case "band.tunnel.v1beta1.TopUpConfig.funder":
+		value := x.Funder
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.TopUpConfig.grant_type":
+		value := x.GrantType
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.tunnel.v1beta1.TopUpConfig.top_up_amount":
+		if len(x.TopUpAmount) == 0 {
+			return protoreflect.ValueOfList(&_TopUpConfig_5_list{})
+		}
+		listValue := &_TopUpConfig_5_list{list: &x.TopUpAmount}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TopUpConfig"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TopUpConfig does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4279,23 +5018,27 @@ func (x *fastReflection_TunnelSignatureOrder) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TunnelSignatureOrder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TopUpConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
-		x.Sequence = value.Uint()
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
+	case "band.tunnel.v1beta1.TopUpConfig.tunnel_id":
+		x.TunnelId = value.Uint()
+	case "band.tunnel.v1beta1.TopUpConfig.low_balance_threshold":
 		lv := value.List()
-		clv := lv.(*_TunnelSignatureOrder_2_list)
-		x.Prices = *clv.list
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
-		x.CreatedAt = value.Int()
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
-		x.Encoder = (v1beta11.Encoder)(value.Enum())
+		clv := lv.(*_TopUpConfig_2_list)
+		x.LowBalanceThreshold = *clv.list
+	case "band.tunnel.v1beta1.TopUpConfig.funder":
+		x.Funder = value.Interface().(string)
+	case "band.tunnel.v1beta1.TopUpConfig.grant_type":
+		x.GrantType = (FunderGrantType)(value.Enum())
+	case "band.tunnel.v1beta1.TopUpConfig.top_up_amount":
+		lv := value.List()
+		clv := lv.(*_TopUpConfig_5_list)
+		x.TopUpAmount = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TopUpConfig"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TopUpConfig does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4309,57 +5052,66 @@ func (x *fastReflection_TunnelSignatureOrder) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TunnelSignatureOrder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopUpConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
-		if x.Prices == nil {
-			x.Prices = []*v1beta11.Price{}
+	case "band.tunnel.v1beta1.TopUpConfig.low_balance_threshold":
+		if x.LowBalanceThreshold == nil {
+			x.LowBalanceThreshold = []*v1beta1.Coin{}
 		}
-		value := &_TunnelSignatureOrder_2_list{list: &x.Prices}
+		value := &_TopUpConfig_2_list{list: &x.LowBalanceThreshold}
 		return protoreflect.ValueOfList(value)
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
-		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.TunnelSignatureOrder is not mutable"))
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
-		panic(fmt.Errorf("field created_at of message band.tunnel.v1beta1.TunnelSignatureOrder is not mutable"))
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
-		panic(fmt.Errorf("field encoder of message band.tunnel.v1beta1.TunnelSignatureOrder is not mutable"))
+	case "band.tunnel.v1beta1.TopUpConfig.top_up_amount":
+		if x.TopUpAmount == nil {
+			x.TopUpAmount = []*v1beta1.Coin{}
+		}
+		value := &_TopUpConfig_5_list{list: &x.TopUpAmount}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.TopUpConfig.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.TopUpConfig is not mutable"))
+	case "band.tunnel.v1beta1.TopUpConfig.funder":
+		panic(fmt.Errorf("field funder of message band.tunnel.v1beta1.TopUpConfig is not mutable"))
+	case "band.tunnel.v1beta1.TopUpConfig.grant_type":
+		panic(fmt.Errorf("field grant_type of message band.tunnel.v1beta1.TopUpConfig is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TopUpConfig"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TopUpConfig does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TunnelSignatureOrder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopUpConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
+	case "band.tunnel.v1beta1.TopUpConfig.tunnel_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
-		list := []*v1beta11.Price{}
-		return protoreflect.ValueOfList(&_TunnelSignatureOrder_2_list{list: &list})
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
+	case "band.tunnel.v1beta1.TopUpConfig.low_balance_threshold":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_TopUpConfig_2_list{list: &list})
+	case "band.tunnel.v1beta1.TopUpConfig.funder":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.TopUpConfig.grant_type":
 		return protoreflect.ValueOfEnum(0)
+	case "band.tunnel.v1beta1.TopUpConfig.top_up_amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_TopUpConfig_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TopUpConfig"))
 		}
-		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TopUpConfig does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TunnelSignatureOrder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TopUpConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.TunnelSignatureOrder", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.TopUpConfig", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4367,7 +5119,7 @@ func (x *fastReflection_TunnelSignatureOrder) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TunnelSignatureOrder) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TopUpConfig) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4378,7 +5130,7 @@ func (x *fastReflection_TunnelSignatureOrder) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TunnelSignatureOrder) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TopUpConfig) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4390,7 +5142,7 @@ func (x *fastReflection_TunnelSignatureOrder) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TunnelSignatureOrder) IsValid() bool {
+func (x *fastReflection_TopUpConfig) IsValid() bool {
 	return x != nil
 }
 
@@ -4400,9 +5152,9 @@ func (x *fastReflection_TunnelSignatureOrder) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TopUpConfig) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TunnelSignatureOrder)
+		x := input.Message.Interface().(*TopUpConfig)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4414,20 +5166,27 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Sequence != 0 {
-			n += 1 + runtime.Sov(uint64(x.Sequence))
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
 		}
-		if len(x.Prices) > 0 {
-			for _, e := range x.Prices {
+		if len(x.LowBalanceThreshold) > 0 {
+			for _, e := range x.LowBalanceThreshold {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.CreatedAt != 0 {
-			n += 1 + runtime.Sov(uint64(x.CreatedAt))
+		l = len(x.Funder)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Encoder != 0 {
-			n += 1 + runtime.Sov(uint64(x.Encoder))
+		if x.GrantType != 0 {
+			n += 1 + runtime.Sov(uint64(x.GrantType))
+		}
+		if len(x.TopUpAmount) > 0 {
+			for _, e := range x.TopUpAmount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4439,7 +5198,7 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TunnelSignatureOrder)
+		x := input.Message.Interface().(*TopUpConfig)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4458,19 +5217,37 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Encoder != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Encoder))
+		if len(x.TopUpAmount) > 0 {
+			for iNdEx := len(x.TopUpAmount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TopUpAmount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.GrantType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GrantType))
 			i--
 			dAtA[i] = 0x20
 		}
-		if x.CreatedAt != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
+		if len(x.Funder) > 0 {
+			i -= len(x.Funder)
+			copy(dAtA[i:], x.Funder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Funder)))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x1a
 		}
-		if len(x.Prices) > 0 {
-			for iNdEx := len(x.Prices) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Prices[iNdEx])
+		if len(x.LowBalanceThreshold) > 0 {
+			for iNdEx := len(x.LowBalanceThreshold) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LowBalanceThreshold[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4484,8 +5261,8 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 				dAtA[i] = 0x12
 			}
 		}
-		if x.Sequence != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -4500,7 +5277,7 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TunnelSignatureOrder)
+		x := input.Message.Interface().(*TopUpConfig)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4532,17 +5309,17 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TunnelSignatureOrder: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopUpConfig: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TunnelSignatureOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopUpConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
 				}
-				x.Sequence = 0
+				x.TunnelId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4552,14 +5329,14 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Sequence |= uint64(b&0x7F) << shift
+					x.TunnelId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LowBalanceThreshold", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4586,16 +5363,16 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Prices = append(x.Prices, &v1beta11.Price{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Prices[len(x.Prices)-1]); err != nil {
+				x.LowBalanceThreshold = append(x.LowBalanceThreshold, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LowBalanceThreshold[len(x.LowBalanceThreshold)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
 				}
-				x.CreatedAt = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4605,16 +5382,29 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.CreatedAt |= int64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Funder = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GrantType", wireType)
 				}
-				x.Encoder = 0
+				x.GrantType = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4624,11 +5414,45 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Encoder |= v1beta11.Encoder(b&0x7F) << shift
+					x.GrantType |= FunderGrantType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopUpAmount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TopUpAmount = append(x.TopUpAmount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopUpAmount[len(x.TopUpAmount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4664,300 +5488,3113 @@ func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: band/tunnel/v1beta1/tunnel.proto
+var _ protoreflect.List = (*_Deposit_3_list)(nil)
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+type _Deposit_3_list struct {
+	list *[]*v1beta1.Coin
+}
 
-// Tunnel contains the information of the tunnel that is created by the user
-type Tunnel struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *_Deposit_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-	// id is the tunnel ID
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// sequence is representing the sequence of the tunnel packet.
-	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	// route is the route for delivering the signal prices
-	Route *anypb.Any `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
-	// fee_payer is the address of the fee payer
-	FeePayer string `protobuf:"bytes,4,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
-	// signal_deviations is the list of signal deviations
-	SignalDeviations []*SignalDeviation `protobuf:"bytes,5,rep,name=signal_deviations,json=signalDeviations,proto3" json:"signal_deviations,omitempty"`
-	// interval is the interval for delivering the signal prices
-	Interval uint64 `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
-	// total_deposit is the total deposit on the tunnel.
-	TotalDeposit []*v1beta1.Coin `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3" json:"total_deposit,omitempty"`
-	// is_active is the flag to indicate if the tunnel is active
-	IsActive bool `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	// created_at is the timestamp when the tunnel is created
-	CreatedAt int64 `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// creator is the address of the creator
-	Creator string `protobuf:"bytes,10,opt,name=creator,proto3" json:"creator,omitempty"`
+func (x *_Deposit_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *Tunnel) Reset() {
-	*x = Tunnel{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *_Deposit_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *Tunnel) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_Deposit_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (*Tunnel) ProtoMessage() {}
+func (x *_Deposit_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-// Deprecated: Use Tunnel.ProtoReflect.Descriptor instead.
-func (*Tunnel) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{0}
+func (x *_Deposit_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Deposit_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Deposit_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Deposit           protoreflect.MessageDescriptor
+	fd_Deposit_tunnel_id protoreflect.FieldDescriptor
+	fd_Deposit_depositor protoreflect.FieldDescriptor
+	fd_Deposit_amount    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tunnel_proto_init()
+	md_Deposit = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("Deposit")
+	fd_Deposit_tunnel_id = md_Deposit.Fields().ByName("tunnel_id")
+	fd_Deposit_depositor = md_Deposit.Fields().ByName("depositor")
+	fd_Deposit_amount = md_Deposit.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_Deposit)(nil)
+
+type fastReflection_Deposit Deposit
+
+func (x *Deposit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Deposit)(x)
+}
+
+func (x *Deposit) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Deposit_messageType fastReflection_Deposit_messageType
+var _ protoreflect.MessageType = fastReflection_Deposit_messageType{}
+
+type fastReflection_Deposit_messageType struct{}
+
+func (x fastReflection_Deposit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Deposit)(nil)
+}
+func (x fastReflection_Deposit_messageType) New() protoreflect.Message {
+	return new(fastReflection_Deposit)
+}
+func (x fastReflection_Deposit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Deposit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Deposit) Descriptor() protoreflect.MessageDescriptor {
+	return md_Deposit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Deposit) Type() protoreflect.MessageType {
+	return _fastReflection_Deposit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Deposit) New() protoreflect.Message {
+	return new(fastReflection_Deposit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Deposit) Interface() protoreflect.ProtoMessage {
+	return (*Deposit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Deposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TunnelId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TunnelId)
+		if !f(fd_Deposit_tunnel_id, value) {
+			return
+		}
+	}
+	if x.Depositor != "" {
+		value := protoreflect.ValueOfString(x.Depositor)
+		if !f(fd_Deposit_depositor, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_Deposit_3_list{list: &x.Amount})
+		if !f(fd_Deposit_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Deposit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.Deposit.tunnel_id":
+		return x.TunnelId != uint64(0)
+	case "band.tunnel.v1beta1.Deposit.depositor":
+		return x.Depositor != ""
+	case "band.tunnel.v1beta1.Deposit.amount":
+		return len(x.Amount) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Deposit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.Deposit.tunnel_id":
+		x.TunnelId = uint64(0)
+	case "band.tunnel.v1beta1.Deposit.depositor":
+		x.Depositor = ""
+	case "band.tunnel.v1beta1.Deposit.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Deposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.Deposit.tunnel_id":
+		value := x.TunnelId
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.Deposit.depositor":
+		value := x.Depositor
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.Deposit.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_Deposit_3_list{})
+		}
+		listValue := &_Deposit_3_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Deposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.Deposit.tunnel_id":
+		x.TunnelId = value.Uint()
+	case "band.tunnel.v1beta1.Deposit.depositor":
+		x.Depositor = value.Interface().(string)
+	case "band.tunnel.v1beta1.Deposit.amount":
+		lv := value.List()
+		clv := lv.(*_Deposit_3_list)
+		x.Amount = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Deposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.Deposit.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_Deposit_3_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.Deposit.tunnel_id":
+		panic(fmt.Errorf("field tunnel_id of message band.tunnel.v1beta1.Deposit is not mutable"))
+	case "band.tunnel.v1beta1.Deposit.depositor":
+		panic(fmt.Errorf("field depositor of message band.tunnel.v1beta1.Deposit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Deposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.Deposit.tunnel_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.Deposit.depositor":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.Deposit.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Deposit_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Deposit"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Deposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Deposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.Deposit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Deposit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Deposit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Deposit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Deposit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TunnelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TunnelId))
+		}
+		l = len(x.Depositor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Deposit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Depositor) > 0 {
+			i -= len(x.Depositor)
+			copy(dAtA[i:], x.Depositor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TunnelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TunnelId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Deposit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+				}
+				x.TunnelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TunnelId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Depositor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SignalDeviation                    protoreflect.MessageDescriptor
+	fd_SignalDeviation_signal_id          protoreflect.FieldDescriptor
+	fd_SignalDeviation_soft_deviation_bps protoreflect.FieldDescriptor
+	fd_SignalDeviation_hard_deviation_bps protoreflect.FieldDescriptor
+	fd_SignalDeviation_derivation         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tunnel_proto_init()
+	md_SignalDeviation = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("SignalDeviation")
+	fd_SignalDeviation_signal_id = md_SignalDeviation.Fields().ByName("signal_id")
+	fd_SignalDeviation_soft_deviation_bps = md_SignalDeviation.Fields().ByName("soft_deviation_bps")
+	fd_SignalDeviation_hard_deviation_bps = md_SignalDeviation.Fields().ByName("hard_deviation_bps")
+	fd_SignalDeviation_derivation = md_SignalDeviation.Fields().ByName("derivation")
+}
+
+var _ protoreflect.Message = (*fastReflection_SignalDeviation)(nil)
+
+type fastReflection_SignalDeviation SignalDeviation
+
+func (x *SignalDeviation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignalDeviation)(x)
+}
+
+func (x *SignalDeviation) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SignalDeviation_messageType fastReflection_SignalDeviation_messageType
+var _ protoreflect.MessageType = fastReflection_SignalDeviation_messageType{}
+
+type fastReflection_SignalDeviation_messageType struct{}
+
+func (x fastReflection_SignalDeviation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignalDeviation)(nil)
+}
+func (x fastReflection_SignalDeviation_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignalDeviation)
+}
+func (x fastReflection_SignalDeviation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalDeviation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SignalDeviation) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignalDeviation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SignalDeviation) Type() protoreflect.MessageType {
+	return _fastReflection_SignalDeviation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SignalDeviation) New() protoreflect.Message {
+	return new(fastReflection_SignalDeviation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SignalDeviation) Interface() protoreflect.ProtoMessage {
+	return (*SignalDeviation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SignalDeviation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_SignalDeviation_signal_id, value) {
+			return
+		}
+	}
+	if x.SoftDeviationBps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SoftDeviationBps)
+		if !f(fd_SignalDeviation_soft_deviation_bps, value) {
+			return
+		}
+	}
+	if x.HardDeviationBps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HardDeviationBps)
+		if !f(fd_SignalDeviation_hard_deviation_bps, value) {
+			return
+		}
+	}
+	if x.Derivation != nil {
+		value := protoreflect.ValueOfMessage(x.Derivation.ProtoReflect())
+		if !f(fd_SignalDeviation_derivation, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SignalDeviation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+		return x.SignalId != ""
+	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+		return x.SoftDeviationBps != uint64(0)
+	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+		return x.HardDeviationBps != uint64(0)
+	case "band.tunnel.v1beta1.SignalDeviation.derivation":
+		return x.Derivation != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalDeviation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+		x.SignalId = ""
+	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+		x.SoftDeviationBps = uint64(0)
+	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+		x.HardDeviationBps = uint64(0)
+	case "band.tunnel.v1beta1.SignalDeviation.derivation":
+		x.Derivation = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SignalDeviation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+		value := x.SignalId
+		return protoreflect.ValueOfString(value)
+	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+		value := x.SoftDeviationBps
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+		value := x.HardDeviationBps
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.SignalDeviation.derivation":
+		value := x.Derivation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalDeviation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+		x.SoftDeviationBps = value.Uint()
+	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+		x.HardDeviationBps = value.Uint()
+	case "band.tunnel.v1beta1.SignalDeviation.derivation":
+		x.Derivation = value.Message().Interface().(*Derivation)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalDeviation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.SignalDeviation.derivation":
+		if x.Derivation == nil {
+			x.Derivation = new(Derivation)
+		}
+		return protoreflect.ValueOfMessage(x.Derivation.ProtoReflect())
+	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+		panic(fmt.Errorf("field signal_id of message band.tunnel.v1beta1.SignalDeviation is not mutable"))
+	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+		panic(fmt.Errorf("field soft_deviation_bps of message band.tunnel.v1beta1.SignalDeviation is not mutable"))
+	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+		panic(fmt.Errorf("field hard_deviation_bps of message band.tunnel.v1beta1.SignalDeviation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SignalDeviation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.SignalDeviation.signal_id":
+		return protoreflect.ValueOfString("")
+	case "band.tunnel.v1beta1.SignalDeviation.soft_deviation_bps":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.SignalDeviation.hard_deviation_bps":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.SignalDeviation.derivation":
+		m := new(Derivation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.SignalDeviation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.SignalDeviation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SignalDeviation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.SignalDeviation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SignalDeviation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignalDeviation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SignalDeviation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SignalDeviation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SignalDeviation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SoftDeviationBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.SoftDeviationBps))
+		}
+		if x.HardDeviationBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.HardDeviationBps))
+		}
+		if x.Derivation != nil {
+			l = options.Size(x.Derivation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SignalDeviation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Derivation != nil {
+			encoded, err := options.Marshal(x.Derivation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.HardDeviationBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HardDeviationBps))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.SoftDeviationBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SoftDeviationBps))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SignalDeviation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalDeviation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignalDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SoftDeviationBps", wireType)
+				}
+				x.SoftDeviationBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SoftDeviationBps |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HardDeviationBps", wireType)
+				}
+				x.HardDeviationBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HardDeviationBps |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Derivation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Derivation == nil {
+					x.Derivation = &Derivation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Derivation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Derivation_2_list)(nil)
+
+type _Derivation_2_list struct {
+	list *[]string
+}
+
+func (x *_Derivation_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Derivation_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Derivation_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Derivation_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Derivation_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Derivation at list field SourceSignalIds as it is not of Message kind"))
+}
+
+func (x *_Derivation_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Derivation_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Derivation_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Derivation                   protoreflect.MessageDescriptor
+	fd_Derivation_method            protoreflect.FieldDescriptor
+	fd_Derivation_source_signal_ids protoreflect.FieldDescriptor
+	fd_Derivation_exponent          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tunnel_proto_init()
+	md_Derivation = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("Derivation")
+	fd_Derivation_method = md_Derivation.Fields().ByName("method")
+	fd_Derivation_source_signal_ids = md_Derivation.Fields().ByName("source_signal_ids")
+	fd_Derivation_exponent = md_Derivation.Fields().ByName("exponent")
+}
+
+var _ protoreflect.Message = (*fastReflection_Derivation)(nil)
+
+type fastReflection_Derivation Derivation
+
+func (x *Derivation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Derivation)(x)
+}
+
+func (x *Derivation) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Derivation_messageType fastReflection_Derivation_messageType
+var _ protoreflect.MessageType = fastReflection_Derivation_messageType{}
+
+type fastReflection_Derivation_messageType struct{}
+
+func (x fastReflection_Derivation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Derivation)(nil)
+}
+func (x fastReflection_Derivation_messageType) New() protoreflect.Message {
+	return new(fastReflection_Derivation)
+}
+func (x fastReflection_Derivation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Derivation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Derivation) Descriptor() protoreflect.MessageDescriptor {
+	return md_Derivation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Derivation) Type() protoreflect.MessageType {
+	return _fastReflection_Derivation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Derivation) New() protoreflect.Message {
+	return new(fastReflection_Derivation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Derivation) Interface() protoreflect.ProtoMessage {
+	return (*Derivation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Derivation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Method != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Method))
+		if !f(fd_Derivation_method, value) {
+			return
+		}
+	}
+	if len(x.SourceSignalIds) != 0 {
+		value := protoreflect.ValueOfList(&_Derivation_2_list{list: &x.SourceSignalIds})
+		if !f(fd_Derivation_source_signal_ids, value) {
+			return
+		}
+	}
+	if x.Exponent != int32(0) {
+		value := protoreflect.ValueOfInt32(x.Exponent)
+		if !f(fd_Derivation_exponent, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Derivation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.Derivation.method":
+		return x.Method != 0
+	case "band.tunnel.v1beta1.Derivation.source_signal_ids":
+		return len(x.SourceSignalIds) != 0
+	case "band.tunnel.v1beta1.Derivation.exponent":
+		return x.Exponent != int32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Derivation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Derivation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Derivation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.Derivation.method":
+		x.Method = 0
+	case "band.tunnel.v1beta1.Derivation.source_signal_ids":
+		x.SourceSignalIds = nil
+	case "band.tunnel.v1beta1.Derivation.exponent":
+		x.Exponent = int32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Derivation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Derivation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Derivation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.Derivation.method":
+		value := x.Method
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.tunnel.v1beta1.Derivation.source_signal_ids":
+		if len(x.SourceSignalIds) == 0 {
+			return protoreflect.ValueOfList(&_Derivation_2_list{})
+		}
+		listValue := &_Derivation_2_list{list: &x.SourceSignalIds}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.Derivation.exponent":
+		value := x.Exponent
+		return protoreflect.ValueOfInt32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Derivation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Derivation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Derivation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.Derivation.method":
+		x.Method = (DerivationMethod)(value.Enum())
+	case "band.tunnel.v1beta1.Derivation.source_signal_ids":
+		lv := value.List()
+		clv := lv.(*_Derivation_2_list)
+		x.SourceSignalIds = *clv.list
+	case "band.tunnel.v1beta1.Derivation.exponent":
+		x.Exponent = int32(value.Int())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Derivation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Derivation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Derivation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.Derivation.source_signal_ids":
+		if x.SourceSignalIds == nil {
+			x.SourceSignalIds = []string{}
+		}
+		value := &_Derivation_2_list{list: &x.SourceSignalIds}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.Derivation.method":
+		panic(fmt.Errorf("field method of message band.tunnel.v1beta1.Derivation is not mutable"))
+	case "band.tunnel.v1beta1.Derivation.exponent":
+		panic(fmt.Errorf("field exponent of message band.tunnel.v1beta1.Derivation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Derivation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Derivation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Derivation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.Derivation.method":
+		return protoreflect.ValueOfEnum(0)
+	case "band.tunnel.v1beta1.Derivation.source_signal_ids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Derivation_2_list{list: &list})
+	case "band.tunnel.v1beta1.Derivation.exponent":
+		return protoreflect.ValueOfInt32(int32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.Derivation"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.Derivation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Derivation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.Derivation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Derivation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Derivation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Derivation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Derivation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Derivation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Method != 0 {
+			n += 1 + runtime.Sov(uint64(x.Method))
+		}
+		if len(x.SourceSignalIds) > 0 {
+			for _, s := range x.SourceSignalIds {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Exponent != 0 {
+			n += 1 + runtime.Sov(uint64(x.Exponent))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Derivation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Exponent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exponent))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.SourceSignalIds) > 0 {
+			for iNdEx := len(x.SourceSignalIds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.SourceSignalIds[iNdEx])
+				copy(dAtA[i:], x.SourceSignalIds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SourceSignalIds[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Method != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Method))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Derivation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Derivation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Derivation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				}
+				x.Method = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Method |= DerivationMethod(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceSignalIds", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SourceSignalIds = append(x.SourceSignalIds, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+				}
+				x.Exponent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Exponent |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TunnelSignatureOrder_2_list)(nil)
+
+type _TunnelSignatureOrder_2_list struct {
+	list *[]*v1beta11.Price
+}
+
+func (x *_TunnelSignatureOrder_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TunnelSignatureOrder_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TunnelSignatureOrder_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Price)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TunnelSignatureOrder_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Price)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TunnelSignatureOrder_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Price)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TunnelSignatureOrder_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TunnelSignatureOrder_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Price)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TunnelSignatureOrder_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TunnelSignatureOrder            protoreflect.MessageDescriptor
+	fd_TunnelSignatureOrder_sequence   protoreflect.FieldDescriptor
+	fd_TunnelSignatureOrder_prices     protoreflect.FieldDescriptor
+	fd_TunnelSignatureOrder_created_at protoreflect.FieldDescriptor
+	fd_TunnelSignatureOrder_encoder    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_tunnel_v1beta1_tunnel_proto_init()
+	md_TunnelSignatureOrder = File_band_tunnel_v1beta1_tunnel_proto.Messages().ByName("TunnelSignatureOrder")
+	fd_TunnelSignatureOrder_sequence = md_TunnelSignatureOrder.Fields().ByName("sequence")
+	fd_TunnelSignatureOrder_prices = md_TunnelSignatureOrder.Fields().ByName("prices")
+	fd_TunnelSignatureOrder_created_at = md_TunnelSignatureOrder.Fields().ByName("created_at")
+	fd_TunnelSignatureOrder_encoder = md_TunnelSignatureOrder.Fields().ByName("encoder")
+}
+
+var _ protoreflect.Message = (*fastReflection_TunnelSignatureOrder)(nil)
+
+type fastReflection_TunnelSignatureOrder TunnelSignatureOrder
+
+func (x *TunnelSignatureOrder) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TunnelSignatureOrder)(x)
+}
+
+func (x *TunnelSignatureOrder) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TunnelSignatureOrder_messageType fastReflection_TunnelSignatureOrder_messageType
+var _ protoreflect.MessageType = fastReflection_TunnelSignatureOrder_messageType{}
+
+type fastReflection_TunnelSignatureOrder_messageType struct{}
+
+func (x fastReflection_TunnelSignatureOrder_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TunnelSignatureOrder)(nil)
+}
+func (x fastReflection_TunnelSignatureOrder_messageType) New() protoreflect.Message {
+	return new(fastReflection_TunnelSignatureOrder)
+}
+func (x fastReflection_TunnelSignatureOrder_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TunnelSignatureOrder
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TunnelSignatureOrder) Descriptor() protoreflect.MessageDescriptor {
+	return md_TunnelSignatureOrder
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TunnelSignatureOrder) Type() protoreflect.MessageType {
+	return _fastReflection_TunnelSignatureOrder_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TunnelSignatureOrder) New() protoreflect.Message {
+	return new(fastReflection_TunnelSignatureOrder)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TunnelSignatureOrder) Interface() protoreflect.ProtoMessage {
+	return (*TunnelSignatureOrder)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TunnelSignatureOrder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_TunnelSignatureOrder_sequence, value) {
+			return
+		}
+	}
+	if len(x.Prices) != 0 {
+		value := protoreflect.ValueOfList(&_TunnelSignatureOrder_2_list{list: &x.Prices})
+		if !f(fd_TunnelSignatureOrder_prices, value) {
+			return
+		}
+	}
+	if x.CreatedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreatedAt)
+		if !f(fd_TunnelSignatureOrder_created_at, value) {
+			return
+		}
+	}
+	if x.Encoder != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Encoder))
+		if !f(fd_TunnelSignatureOrder_encoder, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TunnelSignatureOrder) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
+		return x.Sequence != uint64(0)
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
+		return len(x.Prices) != 0
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
+		return x.CreatedAt != int64(0)
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
+		return x.Encoder != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TunnelSignatureOrder) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
+		x.Sequence = uint64(0)
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
+		x.Prices = nil
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
+		x.CreatedAt = int64(0)
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
+		x.Encoder = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TunnelSignatureOrder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
+		if len(x.Prices) == 0 {
+			return protoreflect.ValueOfList(&_TunnelSignatureOrder_2_list{})
+		}
+		listValue := &_TunnelSignatureOrder_2_list{list: &x.Prices}
+		return protoreflect.ValueOfList(listValue)
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfInt64(value)
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
+		value := x.Encoder
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TunnelSignatureOrder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
+		x.Sequence = value.Uint()
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
+		lv := value.List()
+		clv := lv.(*_TunnelSignatureOrder_2_list)
+		x.Prices = *clv.list
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
+		x.CreatedAt = value.Int()
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
+		x.Encoder = (v1beta11.Encoder)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TunnelSignatureOrder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
+		if x.Prices == nil {
+			x.Prices = []*v1beta11.Price{}
+		}
+		value := &_TunnelSignatureOrder_2_list{list: &x.Prices}
+		return protoreflect.ValueOfList(value)
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
+		panic(fmt.Errorf("field sequence of message band.tunnel.v1beta1.TunnelSignatureOrder is not mutable"))
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
+		panic(fmt.Errorf("field created_at of message band.tunnel.v1beta1.TunnelSignatureOrder is not mutable"))
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
+		panic(fmt.Errorf("field encoder of message band.tunnel.v1beta1.TunnelSignatureOrder is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TunnelSignatureOrder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.prices":
+		list := []*v1beta11.Price{}
+		return protoreflect.ValueOfList(&_TunnelSignatureOrder_2_list{list: &list})
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.created_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.tunnel.v1beta1.TunnelSignatureOrder.encoder":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.tunnel.v1beta1.TunnelSignatureOrder"))
+		}
+		panic(fmt.Errorf("message band.tunnel.v1beta1.TunnelSignatureOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TunnelSignatureOrder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.tunnel.v1beta1.TunnelSignatureOrder", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TunnelSignatureOrder) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TunnelSignatureOrder) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TunnelSignatureOrder) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TunnelSignatureOrder) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TunnelSignatureOrder)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if len(x.Prices) > 0 {
+			for _, e := range x.Prices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CreatedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedAt))
+		}
+		if x.Encoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.Encoder))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TunnelSignatureOrder)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Encoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Encoder))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.CreatedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Prices) > 0 {
+			for iNdEx := len(x.Prices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Prices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TunnelSignatureOrder)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TunnelSignatureOrder: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TunnelSignatureOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Prices = append(x.Prices, &v1beta11.Price{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Prices[len(x.Prices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				}
+				x.CreatedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreatedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+				}
+				x.Encoder = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Encoder |= v1beta11.Encoder(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: band/tunnel/v1beta1/tunnel.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FunderGrantType defines the types of grant that a funder gives to the fee payer of a tunnel for top-ups.
+type FunderGrantType int32
+
+const (
+	// FUNDER_GRANT_TYPE_UNSPECIFIED is an unspecified grant type.
+	FunderGrantType_FUNDER_GRANT_TYPE_UNSPECIFIED FunderGrantType = 0
+	// FUNDER_GRANT_TYPE_FEEGRANT is an x/feegrant allowance from the funder to the fee payer.
+	FunderGrantType_FUNDER_GRANT_TYPE_FEEGRANT FunderGrantType = 1
+	// FUNDER_GRANT_TYPE_AUTHZ is an x/authz send authorization from the funder to the fee payer.
+	FunderGrantType_FUNDER_GRANT_TYPE_AUTHZ FunderGrantType = 2
+)
+
+// Enum value maps for FunderGrantType.
+var (
+	FunderGrantType_name = map[int32]string{
+		0: "FUNDER_GRANT_TYPE_UNSPECIFIED",
+		1: "FUNDER_GRANT_TYPE_FEEGRANT",
+		2: "FUNDER_GRANT_TYPE_AUTHZ",
+	}
+	FunderGrantType_value = map[string]int32{
+		"FUNDER_GRANT_TYPE_UNSPECIFIED": 0,
+		"FUNDER_GRANT_TYPE_FEEGRANT":    1,
+		"FUNDER_GRANT_TYPE_AUTHZ":       2,
+	}
+)
+
+func (x FunderGrantType) Enum() *FunderGrantType {
+	p := new(FunderGrantType)
+	*p = x
+	return p
+}
+
+func (x FunderGrantType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FunderGrantType) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_tunnel_v1beta1_tunnel_proto_enumTypes[0].Descriptor()
+}
+
+func (FunderGrantType) Type() protoreflect.EnumType {
+	return &file_band_tunnel_v1beta1_tunnel_proto_enumTypes[0]
+}
+
+func (x FunderGrantType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FunderGrantType.Descriptor instead.
+func (FunderGrantType) EnumDescriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{0}
+}
+
+// DerivationMethod defines the methods to compute the price of a derived signal.
+type DerivationMethod int32
+
+const (
+	// DERIVATION_METHOD_UNSPECIFIED is an unspecified derivation method.
+	DerivationMethod_DERIVATION_METHOD_UNSPECIFIED DerivationMethod = 0
+	// DERIVATION_METHOD_CROSS is the cross rate of two signals, i.e. price(source 0) / price(source 1).
+	DerivationMethod_DERIVATION_METHOD_CROSS DerivationMethod = 1
+	// DERIVATION_METHOD_INVERSE is the inverse of a signal, i.e. 1 / price(source 0).
+	DerivationMethod_DERIVATION_METHOD_INVERSE DerivationMethod = 2
+	// DERIVATION_METHOD_RESCALE is a signal rescaled by a power of ten, i.e. price(source 0) * 10^exponent.
+	DerivationMethod_DERIVATION_METHOD_RESCALE DerivationMethod = 3
+)
+
+// Enum value maps for DerivationMethod.
+var (
+	DerivationMethod_name = map[int32]string{
+		0: "DERIVATION_METHOD_UNSPECIFIED",
+		1: "DERIVATION_METHOD_CROSS",
+		2: "DERIVATION_METHOD_INVERSE",
+		3: "DERIVATION_METHOD_RESCALE",
+	}
+	DerivationMethod_value = map[string]int32{
+		"DERIVATION_METHOD_UNSPECIFIED": 0,
+		"DERIVATION_METHOD_CROSS":       1,
+		"DERIVATION_METHOD_INVERSE":     2,
+		"DERIVATION_METHOD_RESCALE":     3,
+	}
+)
+
+func (x DerivationMethod) Enum() *DerivationMethod {
+	p := new(DerivationMethod)
+	*p = x
+	return p
+}
+
+func (x DerivationMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DerivationMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_band_tunnel_v1beta1_tunnel_proto_enumTypes[1].Descriptor()
+}
+
+func (DerivationMethod) Type() protoreflect.EnumType {
+	return &file_band_tunnel_v1beta1_tunnel_proto_enumTypes[1]
+}
+
+func (x DerivationMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DerivationMethod.Descriptor instead.
+func (DerivationMethod) EnumDescriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{1}
+}
+
+// Tunnel contains the information of the tunnel that is created by the user
+type Tunnel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the tunnel ID
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sequence is representing the sequence of the tunnel packet.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// route is the first route of the tunnel. It is kept for clients of single route tunnels; routes holds all
+	// routes of the tunnel including this one.
+	Route *anypb.Any `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
+	// fee_payer is the address of the fee payer
+	FeePayer string `protobuf:"bytes,4,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// signal_deviations is the list of signal deviations
+	SignalDeviations []*SignalDeviation `protobuf:"bytes,5,rep,name=signal_deviations,json=signalDeviations,proto3" json:"signal_deviations,omitempty"`
+	// interval is the interval for delivering the signal prices
+	Interval uint64 `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	// total_deposit is the total deposit on the tunnel.
+	TotalDeposit []*v1beta1.Coin `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3" json:"total_deposit,omitempty"`
+	// is_active is the flag to indicate if the tunnel is active
+	IsActive bool `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// created_at is the timestamp when the tunnel is created
+	CreatedAt int64 `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// creator is the address of the creator
+	Creator string `protobuf:"bytes,10,opt,name=creator,proto3" json:"creator,omitempty"`
+	// routes is the list of routes for delivering the signal prices
+	Routes []*anypb.Any `protobuf:"bytes,11,rep,name=routes,proto3" json:"routes,omitempty"`
+	// schedule is an optional cron expression in UTC for delivering the signal prices. If it is set,
+	// it replaces the interval trigger.
+	Schedule string `protobuf:"bytes,12,opt,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (x *Tunnel) Reset() {
+	*x = Tunnel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tunnel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tunnel) ProtoMessage() {}
+
+// Deprecated: Use Tunnel.ProtoReflect.Descriptor instead.
+func (*Tunnel) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Tunnel) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Tunnel) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Tunnel) GetRoute() *anypb.Any {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *Tunnel) GetFeePayer() string {
+	if x != nil {
+		return x.FeePayer
+	}
+	return ""
+}
+
+func (x *Tunnel) GetSignalDeviations() []*SignalDeviation {
+	if x != nil {
+		return x.SignalDeviations
+	}
+	return nil
+}
+
+func (x *Tunnel) GetInterval() uint64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *Tunnel) GetTotalDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.TotalDeposit
+	}
+	return nil
+}
+
+func (x *Tunnel) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Tunnel) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Tunnel) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *Tunnel) GetRoutes() []*anypb.Any {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *Tunnel) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+// LatestPrices is the type for prices that tunnel produces
+type LatestPrices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the tunnel ID
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// prices is the list of prices information from feeds module.
+	Prices []*v1beta11.Price `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
+	// last_interval is the last interval when the signal prices are produced by interval trigger
+	LastInterval int64 `protobuf:"varint,3,opt,name=last_interval,json=lastInterval,proto3" json:"last_interval,omitempty"`
+}
+
+func (x *LatestPrices) Reset() {
+	*x = LatestPrices{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LatestPrices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatestPrices) ProtoMessage() {}
+
+// Deprecated: Use LatestPrices.ProtoReflect.Descriptor instead.
+func (*LatestPrices) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LatestPrices) GetTunnelId() uint64 {
+	if x != nil {
+		return x.TunnelId
+	}
+	return 0
+}
+
+func (x *LatestPrices) GetPrices() []*v1beta11.Price {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *LatestPrices) GetLastInterval() int64 {
+	if x != nil {
+		return x.LastInterval
+	}
+	return 0
+}
+
+// TotalFees is the type for the total fees collected by the tunnel
+type TotalFees struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// total_base_packet_fee is the total base packet fee collected by the tunnel
+	TotalBasePacketFee []*v1beta1.Coin `protobuf:"bytes,1,rep,name=total_base_packet_fee,json=totalBasePacketFee,proto3" json:"total_base_packet_fee,omitempty"`
+}
+
+func (x *TotalFees) Reset() {
+	*x = TotalFees{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TotalFees) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TotalFees) ProtoMessage() {}
+
+// Deprecated: Use TotalFees.ProtoReflect.Descriptor instead.
+func (*TotalFees) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TotalFees) GetTotalBasePacketFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.TotalBasePacketFee
+	}
+	return nil
+}
+
+// Packet is the packet that tunnel produces
+type Packet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the tunnel ID
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// sequence is representing the sequence of the tunnel packet.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// prices is the list of prices information from feeds module.
+	Prices []*v1beta11.Price `protobuf:"bytes,3,rep,name=prices,proto3" json:"prices,omitempty"`
+	// receipt is the receipt of the first route of the tunnel. It is kept for clients of single route tunnels;
+	// receipts holds the receipts of all routes including this one.
+	Receipt *anypb.Any `protobuf:"bytes,4,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	// created_at is the timestamp when the packet is created
+	CreatedAt int64 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// receipts represent the confirmations of the packet delivery to the destinations, one for each route of the
+	// tunnel in the same order. A route that failed to deliver the packet has a FailedPacketReceipt.
+	Receipts []*anypb.Any `protobuf:"bytes,6,rep,name=receipts,proto3" json:"receipts,omitempty"`
+}
+
+func (x *Packet) Reset() {
+	*x = Packet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Packet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Packet) ProtoMessage() {}
+
+// Deprecated: Use Packet.ProtoReflect.Descriptor instead.
+func (*Packet) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Packet) GetTunnelId() uint64 {
+	if x != nil {
+		return x.TunnelId
+	}
+	return 0
+}
+
+func (x *Packet) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Packet) GetPrices() []*v1beta11.Price {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
 }
 
-func (x *Tunnel) GetId() uint64 {
+func (x *Packet) GetReceipt() *anypb.Any {
 	if x != nil {
-		return x.Id
+		return x.Receipt
 	}
-	return 0
+	return nil
 }
 
-func (x *Tunnel) GetSequence() uint64 {
+func (x *Packet) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Sequence
+		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *Tunnel) GetRoute() *anypb.Any {
+func (x *Packet) GetReceipts() []*anypb.Any {
 	if x != nil {
-		return x.Route
+		return x.Receipts
 	}
 	return nil
 }
 
-func (x *Tunnel) GetFeePayer() string {
-	if x != nil {
-		return x.FeePayer
+// PacketRetry is a route delivery of a packet that failed and is waiting to be retried.
+type PacketRetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tunnel_id is the tunnel ID
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// sequence is the sequence of the packet
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// route_index is the index of the route of the tunnel that failed to deliver the packet
+	RouteIndex uint64 `protobuf:"varint,3,opt,name=route_index,json=routeIndex,proto3" json:"route_index,omitempty"`
+	// attempts is the number of retries that have been made
+	Attempts uint64 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// next_retry_height is the block height at which the packet will be retried
+	NextRetryHeight int64 `protobuf:"varint,5,opt,name=next_retry_height,json=nextRetryHeight,proto3" json:"next_retry_height,omitempty"`
+	// last_error is the error of the latest delivery attempt
+	LastError string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// route_hash is the hash of the route at the time the delivery failed
+	RouteHash []byte `protobuf:"bytes,7,opt,name=route_hash,json=routeHash,proto3" json:"route_hash,omitempty"`
+}
+
+func (x *PacketRetry) Reset() {
+	*x = PacketRetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Tunnel) GetSignalDeviations() []*SignalDeviation {
+func (x *PacketRetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketRetry) ProtoMessage() {}
+
+// Deprecated: Use PacketRetry.ProtoReflect.Descriptor instead.
+func (*PacketRetry) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PacketRetry) GetTunnelId() uint64 {
 	if x != nil {
-		return x.SignalDeviations
+		return x.TunnelId
 	}
-	return nil
+	return 0
 }
 
-func (x *Tunnel) GetInterval() uint64 {
+func (x *PacketRetry) GetSequence() uint64 {
 	if x != nil {
-		return x.Interval
+		return x.Sequence
 	}
 	return 0
 }
 
-func (x *Tunnel) GetTotalDeposit() []*v1beta1.Coin {
+func (x *PacketRetry) GetRouteIndex() uint64 {
 	if x != nil {
-		return x.TotalDeposit
+		return x.RouteIndex
 	}
-	return nil
+	return 0
 }
 
-func (x *Tunnel) GetIsActive() bool {
+func (x *PacketRetry) GetAttempts() uint64 {
 	if x != nil {
-		return x.IsActive
+		return x.Attempts
 	}
-	return false
+	return 0
 }
 
-func (x *Tunnel) GetCreatedAt() int64 {
+func (x *PacketRetry) GetNextRetryHeight() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.NextRetryHeight
 	}
 	return 0
 }
 
-func (x *Tunnel) GetCreator() string {
+func (x *PacketRetry) GetLastError() string {
 	if x != nil {
-		return x.Creator
+		return x.LastError
 	}
 	return ""
 }
 
-// LatestPrices is the type for prices that tunnel produces
-type LatestPrices struct {
+func (x *PacketRetry) GetRouteHash() []byte {
+	if x != nil {
+		return x.RouteHash
+	}
+	return nil
+}
+
+// DeadLetterPacket is a route delivery of a packet that has exhausted all of its retries.
+type DeadLetterPacket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// tunnel_id is the tunnel ID
 	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
-	// prices is the list of prices information from feeds module.
-	Prices []*v1beta11.Price `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
-	// last_interval is the last interval when the signal prices are produced by interval trigger
-	LastInterval int64 `protobuf:"varint,3,opt,name=last_interval,json=lastInterval,proto3" json:"last_interval,omitempty"`
-}
-
-func (x *LatestPrices) Reset() {
-	*x = LatestPrices{}
+	// sequence is the sequence of the packet
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// route_index is the index of the route of the tunnel that failed to deliver the packet
+	RouteIndex uint64 `protobuf:"varint,3,opt,name=route_index,json=routeIndex,proto3" json:"route_index,omitempty"`
+	// attempts is the number of retries that have been made
+	Attempts uint64 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// last_error is the error of the latest delivery attempt
+	LastError string `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// created_at is the timestamp when the packet is moved to the dead-letter store
+	CreatedAt int64 `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// route_hash is the hash of the route at the time the delivery failed
+	RouteHash []byte `protobuf:"bytes,7,opt,name=route_hash,json=routeHash,proto3" json:"route_hash,omitempty"`
+}
+
+func (x *DeadLetterPacket) Reset() {
+	*x = DeadLetterPacket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[1]
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LatestPrices) String() string {
+func (x *DeadLetterPacket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LatestPrices) ProtoMessage() {}
+func (*DeadLetterPacket) ProtoMessage() {}
 
-// Deprecated: Use LatestPrices.ProtoReflect.Descriptor instead.
-func (*LatestPrices) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use DeadLetterPacket.ProtoReflect.Descriptor instead.
+func (*DeadLetterPacket) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *LatestPrices) GetTunnelId() uint64 {
+func (x *DeadLetterPacket) GetTunnelId() uint64 {
 	if x != nil {
 		return x.TunnelId
 	}
 	return 0
 }
 
-func (x *LatestPrices) GetPrices() []*v1beta11.Price {
+func (x *DeadLetterPacket) GetSequence() uint64 {
 	if x != nil {
-		return x.Prices
+		return x.Sequence
 	}
-	return nil
+	return 0
 }
 
-func (x *LatestPrices) GetLastInterval() int64 {
+func (x *DeadLetterPacket) GetRouteIndex() uint64 {
 	if x != nil {
-		return x.LastInterval
+		return x.RouteIndex
 	}
 	return 0
 }
 
-// TotalFees is the type for the total fees collected by the tunnel
-type TotalFees struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// total_base_packet_fee is the total base packet fee collected by the tunnel
-	TotalBasePacketFee []*v1beta1.Coin `protobuf:"bytes,1,rep,name=total_base_packet_fee,json=totalBasePacketFee,proto3" json:"total_base_packet_fee,omitempty"`
-}
-
-func (x *TotalFees) Reset() {
-	*x = TotalFees{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *DeadLetterPacket) GetAttempts() uint64 {
+	if x != nil {
+		return x.Attempts
 	}
+	return 0
 }
 
-func (x *TotalFees) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DeadLetterPacket) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
 }
 
-func (*TotalFees) ProtoMessage() {}
-
-// Deprecated: Use TotalFees.ProtoReflect.Descriptor instead.
-func (*TotalFees) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{2}
+func (x *DeadLetterPacket) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
 }
 
-func (x *TotalFees) GetTotalBasePacketFee() []*v1beta1.Coin {
+func (x *DeadLetterPacket) GetRouteHash() []byte {
 	if x != nil {
-		return x.TotalBasePacketFee
+		return x.RouteHash
 	}
 	return nil
 }
 
-// Packet is the packet that tunnel produces
-type Packet struct {
+// TopUpConfig defines the low balance threshold of the fee payer of a tunnel and an optional funder
+// that tops up the fee payer when its balance falls below the threshold.
+type TopUpConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// tunnel_id is the tunnel ID
 	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
-	// sequence is representing the sequence of the tunnel packet.
-	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	// prices is the list of prices information from feeds module.
-	Prices []*v1beta11.Price `protobuf:"bytes,3,rep,name=prices,proto3" json:"prices,omitempty"`
-	// receipt represents the confirmation of the packet delivery to the destination via the specified route.
-	Receipt *anypb.Any `protobuf:"bytes,4,opt,name=receipt,proto3" json:"receipt,omitempty"`
-	// created_at is the timestamp when the packet is created
-	CreatedAt int64 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-}
-
-func (x *Packet) Reset() {
-	*x = Packet{}
+	// low_balance_threshold is the balance of the fee payer below which a low balance event is emitted
+	// and the fee payer is topped up.
+	LowBalanceThreshold []*v1beta1.Coin `protobuf:"bytes,2,rep,name=low_balance_threshold,json=lowBalanceThreshold,proto3" json:"low_balance_threshold,omitempty"`
+	// funder is the address of the account that tops up the fee payer. It is optional.
+	Funder string `protobuf:"bytes,3,opt,name=funder,proto3" json:"funder,omitempty"`
+	// grant_type is the type of grant that the funder gives to the fee payer.
+	GrantType FunderGrantType `protobuf:"varint,4,opt,name=grant_type,json=grantType,proto3,enum=band.tunnel.v1beta1.FunderGrantType" json:"grant_type,omitempty"`
+	// top_up_amount is the amount that the funder sends to the fee payer for each top-up.
+	TopUpAmount []*v1beta1.Coin `protobuf:"bytes,5,rep,name=top_up_amount,json=topUpAmount,proto3" json:"top_up_amount,omitempty"`
+}
+
+func (x *TopUpConfig) Reset() {
+	*x = TopUpConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[3]
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Packet) String() string {
+func (x *TopUpConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Packet) ProtoMessage() {}
+func (*TopUpConfig) ProtoMessage() {}
 
-// Deprecated: Use Packet.ProtoReflect.Descriptor instead.
-func (*Packet) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use TopUpConfig.ProtoReflect.Descriptor instead.
+func (*TopUpConfig) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Packet) GetTunnelId() uint64 {
+func (x *TopUpConfig) GetTunnelId() uint64 {
 	if x != nil {
 		return x.TunnelId
 	}
 	return 0
 }
 
-func (x *Packet) GetSequence() uint64 {
+func (x *TopUpConfig) GetLowBalanceThreshold() []*v1beta1.Coin {
 	if x != nil {
-		return x.Sequence
+		return x.LowBalanceThreshold
 	}
-	return 0
+	return nil
 }
 
-func (x *Packet) GetPrices() []*v1beta11.Price {
+func (x *TopUpConfig) GetFunder() string {
 	if x != nil {
-		return x.Prices
+		return x.Funder
 	}
-	return nil
+	return ""
 }
 
-func (x *Packet) GetReceipt() *anypb.Any {
+func (x *TopUpConfig) GetGrantType() FunderGrantType {
 	if x != nil {
-		return x.Receipt
+		return x.GrantType
 	}
-	return nil
+	return FunderGrantType_FUNDER_GRANT_TYPE_UNSPECIFIED
 }
 
-func (x *Packet) GetCreatedAt() int64 {
+func (x *TopUpConfig) GetTopUpAmount() []*v1beta1.Coin {
 	if x != nil {
-		return x.CreatedAt
+		return x.TopUpAmount
 	}
-	return 0
+	return nil
 }
 
 // Deposit defines an amount deposited by an account address to the tunnel.
@@ -4977,7 +8614,7 @@ type Deposit struct {
 func (x *Deposit) Reset() {
 	*x = Deposit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[4]
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4991,7 +8628,7 @@ func (*Deposit) ProtoMessage() {}
 
 // Deprecated: Use Deposit.ProtoReflect.Descriptor instead.
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{4}
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Deposit) GetTunnelId() uint64 {
@@ -5027,12 +8664,15 @@ type SignalDeviation struct {
 	SoftDeviationBps uint64 `protobuf:"varint,2,opt,name=soft_deviation_bps,json=softDeviationBps,proto3" json:"soft_deviation_bps,omitempty"`
 	// hard_deviation_bps is the hard deviation in basis points
 	HardDeviationBps uint64 `protobuf:"varint,3,opt,name=hard_deviation_bps,json=hardDeviationBps,proto3" json:"hard_deviation_bps,omitempty"`
+	// derivation is an optional derivation to compute the price of the signal from the prices of other signals.
+	// If it is set, the signal ID is the name of the derived signal.
+	Derivation *Derivation `protobuf:"bytes,4,opt,name=derivation,proto3" json:"derivation,omitempty"`
 }
 
 func (x *SignalDeviation) Reset() {
 	*x = SignalDeviation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[5]
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5046,7 +8686,7 @@ func (*SignalDeviation) ProtoMessage() {}
 
 // Deprecated: Use SignalDeviation.ProtoReflect.Descriptor instead.
 func (*SignalDeviation) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{5}
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SignalDeviation) GetSignalId() string {
@@ -5070,6 +8710,68 @@ func (x *SignalDeviation) GetHardDeviationBps() uint64 {
 	return 0
 }
 
+func (x *SignalDeviation) GetDerivation() *Derivation {
+	if x != nil {
+		return x.Derivation
+	}
+	return nil
+}
+
+// Derivation defines how the price of a derived signal is computed from the prices of its source signals.
+type Derivation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// method is the method to compute the price of the derived signal.
+	Method DerivationMethod `protobuf:"varint,1,opt,name=method,proto3,enum=band.tunnel.v1beta1.DerivationMethod" json:"method,omitempty"`
+	// source_signal_ids is the list of signal IDs of the source prices.
+	SourceSignalIds []string `protobuf:"bytes,2,rep,name=source_signal_ids,json=sourceSignalIds,proto3" json:"source_signal_ids,omitempty"`
+	// exponent is the power of ten to rescale the source price by. It is only used by the rescale method.
+	Exponent int32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+}
+
+func (x *Derivation) Reset() {
+	*x = Derivation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Derivation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Derivation) ProtoMessage() {}
+
+// Deprecated: Use Derivation.ProtoReflect.Descriptor instead.
+func (*Derivation) Descriptor() ([]byte, []int) {
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Derivation) GetMethod() DerivationMethod {
+	if x != nil {
+		return x.Method
+	}
+	return DerivationMethod_DERIVATION_METHOD_UNSPECIFIED
+}
+
+func (x *Derivation) GetSourceSignalIds() []string {
+	if x != nil {
+		return x.SourceSignalIds
+	}
+	return nil
+}
+
+func (x *Derivation) GetExponent() int32 {
+	if x != nil {
+		return x.Exponent
+	}
+	return 0
+}
+
 // TunnelSignatureOrder defines a general signature order for sending signature to tss group.
 type TunnelSignatureOrder struct {
 	state         protoimpl.MessageState
@@ -5089,7 +8791,7 @@ type TunnelSignatureOrder struct {
 func (x *TunnelSignatureOrder) Reset() {
 	*x = TunnelSignatureOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[6]
+		mi := &file_band_tunnel_v1beta1_tunnel_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5103,7 +8805,7 @@ func (*TunnelSignatureOrder) ProtoMessage() {}
 
 // Deprecated: Use TunnelSignatureOrder.ProtoReflect.Descriptor instead.
 func (*TunnelSignatureOrder) Descriptor() ([]byte, []int) {
-	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{6}
+	return file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TunnelSignatureOrder) GetSequence() uint64 {
@@ -5152,7 +8854,7 @@ var file_band_tunnel_v1beta1_tunnel_proto_rawDesc = []byte{
 	0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x6e, 0x63, 0x6f, 0x64,
 	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x66,
 	0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x66, 0x65, 0x65,
-	0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8d, 0x04, 0x0a, 0x06, 0x54, 0x75, 0x6e,
+	0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe3, 0x04, 0x0a, 0x06, 0x54, 0x75, 0x6e,
 	0x6e, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
 	0x06, 0xe2, 0xde, 0x1f, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73,
 	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73,
@@ -5185,95 +8887,196 @@ var file_band_tunnel_v1beta1_tunnel_proto_rawDesc = []byte{
 	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
 	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x9d, 0x01, 0x0a, 0x0c, 0x4c, 0x61, 0x74,
-	0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde,
-	0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02,
+	0x6f, 0x72, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x0a, 0xca, 0xb4, 0x2d, 0x06, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x49, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x9d,
+	0x01, 0x0a, 0x0c, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12,
+	0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44,
+	0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x91,
+	0x01, 0x0a, 0x09, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x12, 0x7e, 0x0a, 0x15,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42,
+	0x61, 0x73, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x22, 0xb1, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x29, 0x0a,
+	0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64,
 	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a,
-	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
-	0x61, 0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x91, 0x01, 0x0a, 0x09, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x12, 0x7e, 0x0a, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x73, 0x52, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xeb, 0x01, 0x0a,
-	0x06, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x42, 0x0a,
+	0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x6e, 0x79, 0x42, 0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x44, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x12, 0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x52, 0x08, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x22, 0x81, 0x02, 0x0a, 0x0b, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x48, 0x61, 0x73, 0x68, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xf9, 0x01, 0x0a, 0x10, 0x44,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44,
+	0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb2, 0x03, 0x0a, 0x0b, 0x54, 0x6f, 0x70, 0x55, 0x70,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x12, 0x84, 0x01, 0x0a, 0x15, 0x6c, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x13, 0x6c, 0x6f, 0x77, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x66, 0x75, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x06, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0a, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x74, 0x0a, 0x0d, 0x74, 0x6f, 0x70, 0x5f, 0x75, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x70, 0x55, 0x70, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xdc, 0x01, 0x0a, 0x07,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65,
 	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08,
 	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x49, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x68, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x8b, 0x02, 0x0a, 0x0f, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52,
+	0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x12, 0x73, 0x6f, 0x66,
+	0x74, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x70, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10, 0x53, 0x6f, 0x66, 0x74, 0x44,
+	0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x50, 0x53, 0x52, 0x10, 0x73, 0x6f, 0x66,
+	0x74, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70, 0x73, 0x12, 0x42, 0x0a,
+	0x12, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x62, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10, 0x48,
+	0x61, 0x72, 0x64, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x50, 0x53, 0x52,
+	0x10, 0x68, 0x61, 0x72, 0x64, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70,
+	0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xae, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x3f, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x13, 0xe2, 0xde, 0x1f, 0x0f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x49, 0x44, 0x73, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc7, 0x01, 0x0a, 0x14, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x37,
-	0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
 	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
 	0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x12,
-	0xca, 0xb4, 0x2d, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
-	0x74, 0x49, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xdc, 0x01, 0x0a, 0x07, 0x44,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x54,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x52, 0x08, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
-	0x64, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09,
-	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x68, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xca, 0x01, 0x0a, 0x0f, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x08,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x12, 0x73, 0x6f, 0x66, 0x74,
-	0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65,
-	0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x50, 0x53, 0x52, 0x10, 0x73, 0x6f, 0x66, 0x74,
-	0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70, 0x73, 0x12, 0x42, 0x0a, 0x12,
-	0x68, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62,
-	0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10, 0x48, 0x61,
-	0x72, 0x64, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x50, 0x53, 0x52, 0x10,
-	0x68, 0x61, 0x72, 0x64, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70, 0x73,
-	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc7, 0x01, 0x0a, 0x14, 0x54, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
-	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66, 0x65, 0x65, 0x64,
-	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00,
-	0x42, 0xe0, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0b, 0x54, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x54, 0x58, 0xaa, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64,
-	0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca,
-	0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa8,
-	0xe2, 0x1e, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x66,
+	0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a, 0x04, 0x88,
+	0xa0, 0x1f, 0x00, 0x2a, 0x77, 0x0a, 0x0f, 0x46, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x52,
+	0x5f, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x46, 0x55, 0x4e,
+	0x44, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46,
+	0x45, 0x45, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x55, 0x4e,
+	0x44, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41,
+	0x55, 0x54, 0x48, 0x5a, 0x10, 0x02, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x96, 0x01, 0x0a,
+	0x10, 0x44, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x21, 0x0a, 0x1d, 0x44, 0x45, 0x52, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x45, 0x52, 0x49, 0x56, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x43, 0x52, 0x4f, 0x53, 0x53, 0x10,
+	0x01, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x45, 0x52, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x49, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x45, 0x10, 0x02,
+	0x12, 0x1d, 0x0a, 0x19, 0x44, 0x45, 0x52, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d,
+	0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x52, 0x45, 0x53, 0x43, 0x41, 0x4c, 0x45, 0x10, 0x03, 0x1a,
+	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xe0, 0x01, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x17, 0x63, 0x6f,
+	0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0b, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x42,
+	0x54, 0x58, 0xaa, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x5c,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02,
+	0x1f, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x15, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5288,36 +9091,50 @@ func file_band_tunnel_v1beta1_tunnel_proto_rawDescGZIP() []byte {
 	return file_band_tunnel_v1beta1_tunnel_proto_rawDescData
 }
 
-var file_band_tunnel_v1beta1_tunnel_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_band_tunnel_v1beta1_tunnel_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_band_tunnel_v1beta1_tunnel_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_band_tunnel_v1beta1_tunnel_proto_goTypes = []interface{}{
-	(*Tunnel)(nil),               // 0: band.tunnel.v1beta1.Tunnel
-	(*LatestPrices)(nil),         // 1: band.tunnel.v1beta1.LatestPrices
-	(*TotalFees)(nil),            // 2: band.tunnel.v1beta1.TotalFees
-	(*Packet)(nil),               // 3: band.tunnel.v1beta1.Packet
-	(*Deposit)(nil),              // 4: band.tunnel.v1beta1.Deposit
-	(*SignalDeviation)(nil),      // 5: band.tunnel.v1beta1.SignalDeviation
-	(*TunnelSignatureOrder)(nil), // 6: band.tunnel.v1beta1.TunnelSignatureOrder
-	(*anypb.Any)(nil),            // 7: google.protobuf.Any
-	(*v1beta1.Coin)(nil),         // 8: cosmos.base.v1beta1.Coin
-	(*v1beta11.Price)(nil),       // 9: band.feeds.v1beta1.Price
-	(v1beta11.Encoder)(0),        // 10: band.feeds.v1beta1.Encoder
+	(FunderGrantType)(0),         // 0: band.tunnel.v1beta1.FunderGrantType
+	(DerivationMethod)(0),        // 1: band.tunnel.v1beta1.DerivationMethod
+	(*Tunnel)(nil),               // 2: band.tunnel.v1beta1.Tunnel
+	(*LatestPrices)(nil),         // 3: band.tunnel.v1beta1.LatestPrices
+	(*TotalFees)(nil),            // 4: band.tunnel.v1beta1.TotalFees
+	(*Packet)(nil),               // 5: band.tunnel.v1beta1.Packet
+	(*PacketRetry)(nil),          // 6: band.tunnel.v1beta1.PacketRetry
+	(*DeadLetterPacket)(nil),     // 7: band.tunnel.v1beta1.DeadLetterPacket
+	(*TopUpConfig)(nil),          // 8: band.tunnel.v1beta1.TopUpConfig
+	(*Deposit)(nil),              // 9: band.tunnel.v1beta1.Deposit
+	(*SignalDeviation)(nil),      // 10: band.tunnel.v1beta1.SignalDeviation
+	(*Derivation)(nil),           // 11: band.tunnel.v1beta1.Derivation
+	(*TunnelSignatureOrder)(nil), // 12: band.tunnel.v1beta1.TunnelSignatureOrder
+	(*anypb.Any)(nil),            // 13: google.protobuf.Any
+	(*v1beta1.Coin)(nil),         // 14: cosmos.base.v1beta1.Coin
+	(*v1beta11.Price)(nil),       // 15: band.feeds.v1beta1.Price
+	(v1beta11.Encoder)(0),        // 16: band.feeds.v1beta1.Encoder
 }
 var file_band_tunnel_v1beta1_tunnel_proto_depIdxs = []int32{
-	7,  // 0: band.tunnel.v1beta1.Tunnel.route:type_name -> google.protobuf.Any
-	5,  // 1: band.tunnel.v1beta1.Tunnel.signal_deviations:type_name -> band.tunnel.v1beta1.SignalDeviation
-	8,  // 2: band.tunnel.v1beta1.Tunnel.total_deposit:type_name -> cosmos.base.v1beta1.Coin
-	9,  // 3: band.tunnel.v1beta1.LatestPrices.prices:type_name -> band.feeds.v1beta1.Price
-	8,  // 4: band.tunnel.v1beta1.TotalFees.total_base_packet_fee:type_name -> cosmos.base.v1beta1.Coin
-	9,  // 5: band.tunnel.v1beta1.Packet.prices:type_name -> band.feeds.v1beta1.Price
-	7,  // 6: band.tunnel.v1beta1.Packet.receipt:type_name -> google.protobuf.Any
-	8,  // 7: band.tunnel.v1beta1.Deposit.amount:type_name -> cosmos.base.v1beta1.Coin
-	9,  // 8: band.tunnel.v1beta1.TunnelSignatureOrder.prices:type_name -> band.feeds.v1beta1.Price
-	10, // 9: band.tunnel.v1beta1.TunnelSignatureOrder.encoder:type_name -> band.feeds.v1beta1.Encoder
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	13, // 0: band.tunnel.v1beta1.Tunnel.route:type_name -> google.protobuf.Any
+	10, // 1: band.tunnel.v1beta1.Tunnel.signal_deviations:type_name -> band.tunnel.v1beta1.SignalDeviation
+	14, // 2: band.tunnel.v1beta1.Tunnel.total_deposit:type_name -> cosmos.base.v1beta1.Coin
+	13, // 3: band.tunnel.v1beta1.Tunnel.routes:type_name -> google.protobuf.Any
+	15, // 4: band.tunnel.v1beta1.LatestPrices.prices:type_name -> band.feeds.v1beta1.Price
+	14, // 5: band.tunnel.v1beta1.TotalFees.total_base_packet_fee:type_name -> cosmos.base.v1beta1.Coin
+	15, // 6: band.tunnel.v1beta1.Packet.prices:type_name -> band.feeds.v1beta1.Price
+	13, // 7: band.tunnel.v1beta1.Packet.receipt:type_name -> google.protobuf.Any
+	13, // 8: band.tunnel.v1beta1.Packet.receipts:type_name -> google.protobuf.Any
+	14, // 9: band.tunnel.v1beta1.TopUpConfig.low_balance_threshold:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 10: band.tunnel.v1beta1.TopUpConfig.grant_type:type_name -> band.tunnel.v1beta1.FunderGrantType
+	14, // 11: band.tunnel.v1beta1.TopUpConfig.top_up_amount:type_name -> cosmos.base.v1beta1.Coin
+	14, // 12: band.tunnel.v1beta1.Deposit.amount:type_name -> cosmos.base.v1beta1.Coin
+	11, // 13: band.tunnel.v1beta1.SignalDeviation.derivation:type_name -> band.tunnel.v1beta1.Derivation
+	1,  // 14: band.tunnel.v1beta1.Derivation.method:type_name -> band.tunnel.v1beta1.DerivationMethod
+	15, // 15: band.tunnel.v1beta1.TunnelSignatureOrder.prices:type_name -> band.feeds.v1beta1.Price
+	16, // 16: band.tunnel.v1beta1.TunnelSignatureOrder.encoder:type_name -> band.feeds.v1beta1.Encoder
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_band_tunnel_v1beta1_tunnel_proto_init() }
@@ -5375,7 +9192,7 @@ func file_band_tunnel_v1beta1_tunnel_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Deposit); i {
+			switch v := v.(*PacketRetry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5387,7 +9204,7 @@ func file_band_tunnel_v1beta1_tunnel_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignalDeviation); i {
+			switch v := v.(*DeadLetterPacket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5399,6 +9216,54 @@ func file_band_tunnel_v1beta1_tunnel_proto_init() {
 			}
 		}
 		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopUpConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Deposit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignalDeviation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Derivation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_tunnel_v1beta1_tunnel_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TunnelSignatureOrder); i {
 			case 0:
 				return &v.state
@@ -5416,13 +9281,14 @@ func file_band_tunnel_v1beta1_tunnel_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_tunnel_v1beta1_tunnel_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      2,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_band_tunnel_v1beta1_tunnel_proto_goTypes,
 		DependencyIndexes: file_band_tunnel_v1beta1_tunnel_proto_depIdxs,
+		EnumInfos:         file_band_tunnel_v1beta1_tunnel_proto_enumTypes,
 		MessageInfos:      file_band_tunnel_v1beta1_tunnel_proto_msgTypes,
 	}.Build()
 	File_band_tunnel_v1beta1_tunnel_proto = out.File
@@ -26,6 +26,9 @@ const (
 	Msg_ActivateTunnel_FullMethodName           = "/band.tunnel.v1beta1.Msg/ActivateTunnel"
 	Msg_DeactivateTunnel_FullMethodName         = "/band.tunnel.v1beta1.Msg/DeactivateTunnel"
 	Msg_TriggerTunnel_FullMethodName            = "/band.tunnel.v1beta1.Msg/TriggerTunnel"
+	Msg_RetryPacket_FullMethodName              = "/band.tunnel.v1beta1.Msg/RetryPacket"
+	Msg_SetTopUpConfig_FullMethodName           = "/band.tunnel.v1beta1.Msg/SetTopUpConfig"
+	Msg_RemoveTopUpConfig_FullMethodName        = "/band.tunnel.v1beta1.Msg/RemoveTopUpConfig"
 	Msg_DepositToTunnel_FullMethodName          = "/band.tunnel.v1beta1.Msg/DepositToTunnel"
 	Msg_WithdrawFromTunnel_FullMethodName       = "/band.tunnel.v1beta1.Msg/WithdrawFromTunnel"
 	Msg_UpdateParams_FullMethodName             = "/band.tunnel.v1beta1.Msg/UpdateParams"
@@ -49,6 +52,12 @@ type MsgClient interface {
 	DeactivateTunnel(ctx context.Context, in *MsgDeactivateTunnel, opts ...grpc.CallOption) (*MsgDeactivateTunnelResponse, error)
 	// TriggerTunnel is a RPC method to manually trigger a tunnel.
 	TriggerTunnel(ctx context.Context, in *MsgTriggerTunnel, opts ...grpc.CallOption) (*MsgTriggerTunnelResponse, error)
+	// RetryPacket is a RPC method to manually retry a dead-lettered packet delivery of a tunnel.
+	RetryPacket(ctx context.Context, in *MsgRetryPacket, opts ...grpc.CallOption) (*MsgRetryPacketResponse, error)
+	// SetTopUpConfig is a RPC method to set the low balance threshold and the funder of a tunnel.
+	SetTopUpConfig(ctx context.Context, in *MsgSetTopUpConfig, opts ...grpc.CallOption) (*MsgSetTopUpConfigResponse, error)
+	// RemoveTopUpConfig is a RPC method to remove the low balance threshold and the funder of a tunnel.
+	RemoveTopUpConfig(ctx context.Context, in *MsgRemoveTopUpConfig, opts ...grpc.CallOption) (*MsgRemoveTopUpConfigResponse, error)
 	// DepositToTunnel is a RPC method to deposit to an existing tunnel.
 	DepositToTunnel(ctx context.Context, in *MsgDepositToTunnel, opts ...grpc.CallOption) (*MsgDepositToTunnelResponse, error)
 	// WithdrawFromTunnel is a RPC method to withdraw a deposit from an existing tunnel.
@@ -128,6 +137,33 @@ func (c *msgClient) TriggerTunnel(ctx context.Context, in *MsgTriggerTunnel, opt
 	return out, nil
 }
 
+func (c *msgClient) RetryPacket(ctx context.Context, in *MsgRetryPacket, opts ...grpc.CallOption) (*MsgRetryPacketResponse, error) {
+	out := new(MsgRetryPacketResponse)
+	err := c.cc.Invoke(ctx, Msg_RetryPacket_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTopUpConfig(ctx context.Context, in *MsgSetTopUpConfig, opts ...grpc.CallOption) (*MsgSetTopUpConfigResponse, error) {
+	out := new(MsgSetTopUpConfigResponse)
+	err := c.cc.Invoke(ctx, Msg_SetTopUpConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveTopUpConfig(ctx context.Context, in *MsgRemoveTopUpConfig, opts ...grpc.CallOption) (*MsgRemoveTopUpConfigResponse, error) {
+	out := new(MsgRemoveTopUpConfigResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveTopUpConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) DepositToTunnel(ctx context.Context, in *MsgDepositToTunnel, opts ...grpc.CallOption) (*MsgDepositToTunnelResponse, error) {
 	out := new(MsgDepositToTunnelResponse)
 	err := c.cc.Invoke(ctx, Msg_DepositToTunnel_FullMethodName, in, out, opts...)
@@ -173,6 +209,12 @@ type MsgServer interface {
 	DeactivateTunnel(context.Context, *MsgDeactivateTunnel) (*MsgDeactivateTunnelResponse, error)
 	// TriggerTunnel is a RPC method to manually trigger a tunnel.
 	TriggerTunnel(context.Context, *MsgTriggerTunnel) (*MsgTriggerTunnelResponse, error)
+	// RetryPacket is a RPC method to manually retry a dead-lettered packet delivery of a tunnel.
+	RetryPacket(context.Context, *MsgRetryPacket) (*MsgRetryPacketResponse, error)
+	// SetTopUpConfig is a RPC method to set the low balance threshold and the funder of a tunnel.
+	SetTopUpConfig(context.Context, *MsgSetTopUpConfig) (*MsgSetTopUpConfigResponse, error)
+	// RemoveTopUpConfig is a RPC method to remove the low balance threshold and the funder of a tunnel.
+	RemoveTopUpConfig(context.Context, *MsgRemoveTopUpConfig) (*MsgRemoveTopUpConfigResponse, error)
 	// DepositToTunnel is a RPC method to deposit to an existing tunnel.
 	DepositToTunnel(context.Context, *MsgDepositToTunnel) (*MsgDepositToTunnelResponse, error)
 	// WithdrawFromTunnel is a RPC method to withdraw a deposit from an existing tunnel.
@@ -207,6 +249,15 @@ func (UnimplementedMsgServer) DeactivateTunnel(context.Context, *MsgDeactivateTu
 func (UnimplementedMsgServer) TriggerTunnel(context.Context, *MsgTriggerTunnel) (*MsgTriggerTunnelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TriggerTunnel not implemented")
 }
+func (UnimplementedMsgServer) RetryPacket(context.Context, *MsgRetryPacket) (*MsgRetryPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryPacket not implemented")
+}
+func (UnimplementedMsgServer) SetTopUpConfig(context.Context, *MsgSetTopUpConfig) (*MsgSetTopUpConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTopUpConfig not implemented")
+}
+func (UnimplementedMsgServer) RemoveTopUpConfig(context.Context, *MsgRemoveTopUpConfig) (*MsgRemoveTopUpConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTopUpConfig not implemented")
+}
 func (UnimplementedMsgServer) DepositToTunnel(context.Context, *MsgDepositToTunnel) (*MsgDepositToTunnelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositToTunnel not implemented")
 }
@@ -355,6 +406,60 @@ func _Msg_TriggerTunnel_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RetryPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryPacket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RetryPacket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryPacket(ctx, req.(*MsgRetryPacket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTopUpConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTopUpConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTopUpConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetTopUpConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTopUpConfig(ctx, req.(*MsgSetTopUpConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveTopUpConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveTopUpConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveTopUpConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveTopUpConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveTopUpConfig(ctx, req.(*MsgRemoveTopUpConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_DepositToTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgDepositToTunnel)
 	if err := dec(in); err != nil {
@@ -444,6 +549,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TriggerTunnel",
 			Handler:    _Msg_TriggerTunnel_Handler,
 		},
+		{
+			MethodName: "RetryPacket",
+			Handler:    _Msg_RetryPacket_Handler,
+		},
+		{
+			MethodName: "SetTopUpConfig",
+			Handler:    _Msg_SetTopUpConfig_Handler,
+		},
+		{
+			MethodName: "RemoveTopUpConfig",
+			Handler:    _Msg_RemoveTopUpConfig_Handler,
+		},
 		{
 			MethodName: "DepositToTunnel",
 			Handler:    _Msg_DepositToTunnel_Handler,
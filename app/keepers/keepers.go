@@ -453,6 +453,10 @@ func NewAppKeeper(
 		authtypes.FeeCollectorName,
 	)
 
+	// Add modules that receive the responses of oracle requests with a callback here; the router is
+	// sealed after all keepers are created.
+	oracleCallbackRouter := oracletypes.NewCallbackRouter()
+
 	appKeepers.OracleKeeper = oraclekeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[oracletypes.StoreKey],
@@ -468,6 +472,7 @@ func NewAppKeeper(
 		appKeepers.RollingseedKeeper,
 		appKeepers.BandtssKeeper,
 		appKeepers.ScopedOracleKeeper,
+		oracleCallbackRouter,
 		owasmVM,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -482,11 +487,16 @@ func NewAppKeeper(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// Add tunnel consumer modules that receive packets of tunnels with a local route here
+	tunnelConsumerRouter := tunneltypes.NewConsumerRouter()
+
 	appKeepers.TunnelKeeper = tunnelkeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[tunneltypes.StoreKey],
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
+		appKeepers.FeeGrantKeeper,
+		appKeepers.AuthzKeeper,
 		appKeepers.FeedsKeeper,
 		appKeepers.BandtssKeeper,
 		appKeepers.IBCKeeper.ChannelKeeper,
@@ -494,6 +504,7 @@ func NewAppKeeper(
 		appKeepers.IBCKeeper.PortKeeper,
 		appKeepers.ScopedTunnelKeeper,
 		appKeepers.TransferKeeper,
+		tunnelConsumerRouter,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -513,6 +524,8 @@ func NewAppKeeper(
 	// could create invalid or non-deterministic behavior.
 	tssContentRouter.Seal()
 	tssCbRouter.Seal()
+	oracleCallbackRouter.Seal()
+	tunnelConsumerRouter.Seal()
 
 	// Middleware Stacks
 	appKeepers.ICAModule = ica.NewAppModule(nil, &appKeepers.ICAHostKeeper)
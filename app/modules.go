@@ -81,6 +81,7 @@ var maccPerms = map[string][]string{
 	govtypes.ModuleName:            {authtypes.Burner},
 	ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 	ibcfeetypes.ModuleName:         nil,
+	oracletypes.ModuleName:         nil,
 	bandtsstypes.ModuleName:        nil,
 	restaketypes.ModuleName:        nil,
 	tunneltypes.ModuleName:         {authtypes.Minter},
@@ -0,0 +1,96 @@
+package v3_2
+
+import (
+	"context"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/bandprotocol/chain/v3/app/keepers"
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
+	tunneltypes "github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(c context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		ctx := sdk.UnwrapSDKContext(c)
+
+		vm, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		// set the params that are introduced in this upgrade to their default values and keep the others
+		if err := setFeedsParams(ctx, keepers); err != nil {
+			return nil, err
+		}
+
+		if err := setOracleParams(ctx, keepers); err != nil {
+			return nil, err
+		}
+
+		if err := setTSSParams(ctx, keepers); err != nil {
+			return nil, err
+		}
+
+		if err := setTunnelParams(ctx, keepers); err != nil {
+			return nil, err
+		}
+
+		return vm, nil
+	}
+}
+
+func setFeedsParams(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	defaultParams := feedstypes.DefaultParams()
+
+	params := keepers.FeedsKeeper.GetParams(ctx)
+	params.PriceHistoryRetention = defaultParams.PriceHistoryRetention
+	params.MaxPriceHistoryEntries = defaultParams.MaxPriceHistoryEntries
+
+	return keepers.FeedsKeeper.SetParams(ctx, params)
+}
+
+func setOracleParams(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	defaultParams := oracletypes.DefaultParams()
+
+	params := keepers.OracleKeeper.GetParams(ctx)
+	params.MaxLatestResultIndexSize = defaultParams.MaxLatestResultIndexSize
+	params.ReportWindowSize = defaultParams.ReportWindowSize
+	params.MaxMissedReportPercentage = defaultParams.MaxMissedReportPercentage
+	params.MaxCallbackGasLimit = defaultParams.MaxCallbackGasLimit
+	params.MinSubscriptionInterval = defaultParams.MinSubscriptionInterval
+	params.SubscriptionBaseRequestFee = defaultParams.SubscriptionBaseRequestFee
+	params.MaxSubscriptionRequestsPerBlock = defaultParams.MaxSubscriptionRequestsPerBlock
+
+	return keepers.OracleKeeper.SetParams(ctx, params)
+}
+
+func setTSSParams(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	defaultParams := tsstypes.DefaultParams()
+
+	params := keepers.TSSKeeper.GetParams(ctx)
+	params.BatchSigningWindow = defaultParams.BatchSigningWindow
+
+	return keepers.TSSKeeper.SetParams(ctx, params)
+}
+
+func setTunnelParams(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	defaultParams := tunneltypes.DefaultParams()
+
+	params := keepers.TunnelKeeper.GetParams(ctx)
+	params.LocalRouteGasLimit = defaultParams.LocalRouteGasLimit
+	params.MaxRoutes = defaultParams.MaxRoutes
+	params.MaxPacketRetryAttempts = defaultParams.MaxPacketRetryAttempts
+	params.PacketRetryBaseBlocks = defaultParams.PacketRetryBaseBlocks
+
+	return keepers.TunnelKeeper.SetParams(ctx, params)
+}
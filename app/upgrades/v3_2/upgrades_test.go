@@ -0,0 +1,146 @@
+package v3_2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	band "github.com/bandprotocol/chain/v3/app"
+	"github.com/bandprotocol/chain/v3/app/upgrades"
+	"github.com/bandprotocol/chain/v3/app/upgrades/v3_2"
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
+	tunneltypes "github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+type UpgradeTestSuite struct {
+	suite.Suite
+
+	app *band.BandApp
+	ctx sdk.Context
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+func (s *UpgradeTestSuite) SetupTest() {
+	bandtesting.SetCustomUpgrades([]upgrades.Upgrade{v3_2.Upgrade})
+
+	dir := testutil.GetTempDir(s.T())
+	s.app = bandtesting.SetupWithCustomHome(false, dir)
+	s.ctx = s.app.BaseApp.NewUncachedContext(false, cmtproto.Header{})
+
+	_, err := s.app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: s.app.LastBlockHeight() + 1})
+	s.Require().NoError(err)
+	_, err = s.app.Commit()
+	s.Require().NoError(err)
+}
+
+// TestUpgrade ensures the test that does not error out.
+func (s *UpgradeTestSuite) TestUpgrade() {
+	preUpgradeChecks(s)
+
+	upgradeHeight := int64(2)
+	s.ConfirmUpgradeSucceeded(v3_2.UpgradeName, upgradeHeight)
+
+	postUpgradeChecks(s)
+}
+
+// preUpgradeChecks stores the params as they are on a chain before the upgrade, where the new
+// params do not exist and read as zero. The params are set to the store directly as they are
+// not valid without the new params.
+func preUpgradeChecks(s *UpgradeTestSuite) {
+	feedsParams := s.app.FeedsKeeper.GetParams(s.ctx)
+	feedsParams.MaxCurrentFeeds = 5
+	feedsParams.PriceHistoryRetention = 0
+	feedsParams.MaxPriceHistoryEntries = 0
+	s.setParams(feedstypes.StoreKey, feedstypes.ParamsKey, &feedsParams)
+	s.Require().Error(s.app.FeedsKeeper.GetParams(s.ctx).Validate())
+
+	oracleParams := s.app.OracleKeeper.GetParams(s.ctx)
+	oracleParams.MaxAskCount = 8
+	oracleParams.MaxLatestResultIndexSize = 0
+	oracleParams.ReportWindowSize = 0
+	oracleParams.MaxMissedReportPercentage = 0
+	oracleParams.MaxCallbackGasLimit = 0
+	oracleParams.MinSubscriptionInterval = 0
+	oracleParams.SubscriptionBaseRequestFee = nil
+	oracleParams.MaxSubscriptionRequestsPerBlock = 0
+	s.setParams(oracletypes.StoreKey, oracletypes.ParamsKeyPrefix, &oracleParams)
+
+	tssParams := s.app.TSSKeeper.GetParams(s.ctx)
+	tssParams.MaxGroupSize = 30
+	tssParams.BatchSigningWindow = 0
+	s.setParams(tsstypes.StoreKey, tsstypes.ParamsKey, &tssParams)
+
+	tunnelParams := s.app.TunnelKeeper.GetParams(s.ctx)
+	tunnelParams.MaxSignals = 10
+	tunnelParams.LocalRouteGasLimit = 0
+	tunnelParams.MaxRoutes = 0
+	tunnelParams.MaxPacketRetryAttempts = 0
+	tunnelParams.PacketRetryBaseBlocks = 0
+	s.setParams(tunneltypes.StoreKey, tunneltypes.ParamsKey, &tunnelParams)
+	s.Require().Error(s.app.TunnelKeeper.GetParams(s.ctx).Validate())
+}
+
+// postUpgradeChecks ensures that the new params are set to their default values and the existing
+// params are kept.
+func postUpgradeChecks(s *UpgradeTestSuite) {
+	feedsParams := s.app.FeedsKeeper.GetParams(s.ctx)
+	s.Require().NoError(feedsParams.Validate())
+	s.Require().Equal(uint64(5), feedsParams.MaxCurrentFeeds)
+	s.Require().Equal(feedstypes.DefaultPriceHistoryRetention, feedsParams.PriceHistoryRetention)
+	s.Require().Equal(feedstypes.DefaultMaxPriceHistoryEntries, feedsParams.MaxPriceHistoryEntries)
+
+	oracleParams := s.app.OracleKeeper.GetParams(s.ctx)
+	s.Require().NoError(oracleParams.Validate())
+	s.Require().Equal(uint64(8), oracleParams.MaxAskCount)
+	s.Require().Equal(oracletypes.DefaultMaxLatestResultIndexSize, oracleParams.MaxLatestResultIndexSize)
+	s.Require().Equal(oracletypes.DefaultReportWindowSize, oracleParams.ReportWindowSize)
+	s.Require().Equal(oracletypes.DefaultMaxMissedReportPercentage, oracleParams.MaxMissedReportPercentage)
+	s.Require().Equal(oracletypes.DefaultMaxCallbackGasLimit, oracleParams.MaxCallbackGasLimit)
+	s.Require().Equal(oracletypes.DefaultMinSubscriptionInterval, oracleParams.MinSubscriptionInterval)
+	s.Require().Equal(oracletypes.DefaultSubscriptionBaseRequestFee, oracleParams.SubscriptionBaseRequestFee)
+	s.Require().Equal(oracletypes.DefaultMaxSubscriptionRequestsPerBlock, oracleParams.MaxSubscriptionRequestsPerBlock)
+
+	tssParams := s.app.TSSKeeper.GetParams(s.ctx)
+	s.Require().NoError(tssParams.Validate())
+	s.Require().Equal(uint64(30), tssParams.MaxGroupSize)
+	s.Require().Equal(tsstypes.DefaultBatchSigningWindow, tssParams.BatchSigningWindow)
+
+	tunnelParams := s.app.TunnelKeeper.GetParams(s.ctx)
+	s.Require().NoError(tunnelParams.Validate())
+	s.Require().Equal(uint64(10), tunnelParams.MaxSignals)
+	s.Require().Equal(tunneltypes.DefaultLocalRouteGasLimit, tunnelParams.LocalRouteGasLimit)
+	s.Require().Equal(tunneltypes.DefaultMaxRoutes, tunnelParams.MaxRoutes)
+	s.Require().Equal(tunneltypes.DefaultMaxPacketRetryAttempts, tunnelParams.MaxPacketRetryAttempts)
+	s.Require().Equal(tunneltypes.DefaultPacketRetryBaseBlocks, tunnelParams.PacketRetryBaseBlocks)
+}
+
+func (s *UpgradeTestSuite) setParams(storeKey string, key []byte, params codec.ProtoMarshaler) {
+	s.ctx.KVStore(s.app.GetKey(storeKey)).Set(key, s.app.AppCodec().MustMarshal(params))
+}
+
+func (s *UpgradeTestSuite) ConfirmUpgradeSucceeded(upgradeName string, upgradeHeight int64) {
+	plan := upgradetypes.Plan{Name: upgradeName, Height: upgradeHeight}
+	err := s.app.AppKeepers.UpgradeKeeper.ScheduleUpgrade(s.ctx, plan)
+	s.Require().NoError(err)
+	_, err = s.app.AppKeepers.UpgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().NoError(err)
+
+	s.ctx = s.ctx.WithBlockHeight(upgradeHeight)
+	_, err = s.app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: s.ctx.BlockHeight()})
+	s.Require().NoError(err)
+}
@@ -327,7 +327,7 @@ func (ba *BenchmarkApp) RequestSignature(
 func (ba *BenchmarkApp) AddDEs(addr sdk.AccAddress) {
 	ctx, msgSrvr, k := ba.Ctx, ba.TSSMsgSrvr, ba.TSSKeeper
 
-	deQueue := k.GetDEQueue(ctx, addr)
+	deQueue := k.GetDEQueue(ctx, tsstypes.CURVE_SECP256K1, addr)
 	count := deQueue.Tail - deQueue.Head
 	if count < uint64(30) {
 		_, err := msgSrvr.SubmitDEs(ctx, &tsstypes.MsgSubmitDEs{
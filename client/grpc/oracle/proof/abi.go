@@ -293,3 +293,269 @@ var verifyCountFormat = []byte(`
   }
 ]
 `)
+
+var storeRelayFormat = []byte(`
+[
+  {
+    "components": [
+      {
+        "internalType": "string",
+        "name": "storeKey",
+        "type": "string"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "iavlStateHash",
+        "type": "bytes32"
+      },
+      {
+        "components": [
+          {
+            "internalType": "bool",
+            "name": "isDataOnRight",
+            "type": "bool"
+          },
+          {
+            "internalType": "bytes32",
+            "name": "siblingHash",
+            "type": "bytes32"
+          }
+        ],
+        "internalType": "struct MultiStoreMerklePath.Data[]",
+        "name": "merklePaths",
+        "type": "tuple[]"
+      }
+    ],
+    "internalType": "struct StoreMultiStore.Data",
+    "name": "multiStore",
+    "type": "tuple"
+  },
+  {
+    "components": [
+      {
+        "internalType": "bytes32",
+        "name": "versionAndChainIdHash",
+        "type": "bytes32"
+      },
+      {
+        "internalType": "uint64",
+        "name": "height",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint64",
+        "name": "timeSecond",
+        "type": "uint64"
+      },
+      {
+        "internalType": "uint32",
+        "name": "timeNanoSecond",
+        "type": "uint32"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "lastBlockIdAndOther",
+        "type": "bytes32"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "nextValidatorHashAndConsensusHash",
+        "type": "bytes32"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "lastResultsHash",
+        "type": "bytes32"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "evidenceAndProposerHash",
+        "type": "bytes32"
+      }
+    ],
+    "internalType": "struct BlockHeaderMerkleParts.Data",
+    "name": "merkleParts",
+    "type": "tuple"
+  },
+  {
+    "components": [
+      {
+        "internalType": "bytes",
+        "name": "signedDataPrefix",
+        "type": "bytes"
+      },
+      {
+        "internalType": "bytes",
+        "name": "signedDataSuffix",
+        "type": "bytes"
+      }
+    ],
+    "internalType": "struct CommonEncodedVotePart.Data",
+    "name": "commonEncodedVotePart",
+    "type": "tuple"
+  },
+  {
+    "components": [
+      {
+        "internalType": "bytes32",
+        "name": "r",
+        "type": "bytes32"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "s",
+        "type": "bytes32"
+      },
+      {
+        "internalType": "uint8",
+        "name": "v",
+        "type": "uint8"
+      },
+      {
+        "internalType": "bytes",
+        "name": "encodedTimestamp",
+        "type": "bytes"
+      }
+    ],
+    "internalType": "struct TMSignature.Data[]",
+    "name": "signatures",
+    "type": "tuple[]"
+  }
+]
+`)
+
+var verifyPriceFormat = []byte(`
+[
+  {
+    "internalType": "uint256",
+    "name": "blockHeight",
+    "type": "uint256"
+  },
+  {
+    "components": [
+      {
+        "internalType": "uint8",
+        "name": "status",
+        "type": "uint8"
+      },
+      {
+        "internalType": "string",
+        "name": "signalID",
+        "type": "string"
+      },
+      {
+        "internalType": "uint64",
+        "name": "price",
+        "type": "uint64"
+      },
+      {
+        "internalType": "int64",
+        "name": "timestamp",
+        "type": "int64"
+      }
+    ],
+    "internalType": "struct IFeedsBridge.Price",
+    "name": "price",
+    "type": "tuple"
+  },
+  {
+    "internalType": "uint256",
+    "name": "version",
+    "type": "uint256"
+  },
+  {
+    "components": [
+      {
+        "internalType": "bool",
+        "name": "isDataOnRight",
+        "type": "bool"
+      },
+      {
+        "internalType": "uint8",
+        "name": "subtreeHeight",
+        "type": "uint8"
+      },
+      {
+        "internalType": "uint256",
+        "name": "subtreeSize",
+        "type": "uint256"
+      },
+      {
+        "internalType": "uint256",
+        "name": "subtreeVersion",
+        "type": "uint256"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "siblingHash",
+        "type": "bytes32"
+      }
+    ],
+    "internalType": "struct IAVLMerklePath.Data[]",
+    "name": "merklePaths",
+    "type": "tuple[]"
+  }
+]
+`)
+
+var verifyPacketFormat = []byte(`
+[
+  {
+    "internalType": "uint256",
+    "name": "blockHeight",
+    "type": "uint256"
+  },
+  {
+    "internalType": "uint64",
+    "name": "tunnelID",
+    "type": "uint64"
+  },
+  {
+    "internalType": "uint64",
+    "name": "sequence",
+    "type": "uint64"
+  },
+  {
+    "internalType": "bytes",
+    "name": "encodedPacket",
+    "type": "bytes"
+  },
+  {
+    "internalType": "uint256",
+    "name": "version",
+    "type": "uint256"
+  },
+  {
+    "components": [
+      {
+        "internalType": "bool",
+        "name": "isDataOnRight",
+        "type": "bool"
+      },
+      {
+        "internalType": "uint8",
+        "name": "subtreeHeight",
+        "type": "uint8"
+      },
+      {
+        "internalType": "uint256",
+        "name": "subtreeSize",
+        "type": "uint256"
+      },
+      {
+        "internalType": "uint256",
+        "name": "subtreeVersion",
+        "type": "uint256"
+      },
+      {
+        "internalType": "bytes32",
+        "name": "siblingHash",
+        "type": "bytes32"
+      }
+    ],
+    "internalType": "struct IAVLMerklePath.Data[]",
+    "name": "merklePaths",
+    "type": "tuple[]"
+  }
+]
+`)
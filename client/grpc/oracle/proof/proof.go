@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 
 	ics23 "github.com/cosmos/ics23/go"
@@ -18,9 +19,12 @@ import (
 )
 
 var (
-	relayArguments       abi.Arguments
-	verifyArguments      abi.Arguments
-	verifyCountArguments abi.Arguments
+	relayArguments        abi.Arguments
+	verifyArguments       abi.Arguments
+	verifyCountArguments  abi.Arguments
+	storeRelayArguments   abi.Arguments
+	verifyPriceArguments  abi.Arguments
+	verifyPacketArguments abi.Arguments
 )
 
 const (
@@ -40,6 +44,18 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	err = json.Unmarshal(storeRelayFormat, &storeRelayArguments)
+	if err != nil {
+		panic(err)
+	}
+	err = json.Unmarshal(verifyPriceFormat, &verifyPriceArguments)
+	if err != nil {
+		panic(err)
+	}
+	err = json.Unmarshal(verifyPacketFormat, &verifyPacketArguments)
+	if err != nil {
+		panic(err)
+	}
 }
 
 func (blockRelay *BlockRelayProof) encodeToEthData() ([]byte, error) {
@@ -81,6 +97,47 @@ func (o *RequestsCountProof) encodeToEthData(blockHeight uint64) ([]byte, error)
 	)
 }
 
+func (blockRelay *StoreBlockRelayProof) encodeToEthData() ([]byte, error) {
+	parseSignatures := make([]TMSignatureEthereum, len(blockRelay.Signatures))
+	for i, sig := range blockRelay.Signatures {
+		parseSignatures[i] = sig.encodeToEthFormat()
+	}
+	return storeRelayArguments.Pack(
+		blockRelay.MultiStoreProof.encodeToEthFormat(),
+		blockRelay.BlockHeaderMerkleParts.encodeToEthFormat(),
+		blockRelay.CommonEncodedVotePart.encodeToEthFormat(),
+		parseSignatures,
+	)
+}
+
+func (p *PriceDataProof) encodeToEthData(blockHeight uint64) ([]byte, error) {
+	parsePaths := make([]IAVLMerklePathEthereum, len(p.MerklePaths))
+	for i, path := range p.MerklePaths {
+		parsePaths[i] = path.encodeToEthFormat()
+	}
+	return verifyPriceArguments.Pack(
+		big.NewInt(int64(blockHeight)),
+		transformPrice(p.Price),
+		big.NewInt(int64(p.Version)),
+		parsePaths,
+	)
+}
+
+func (p *PacketDataProof) encodeToEthData(blockHeight uint64) ([]byte, error) {
+	parsePaths := make([]IAVLMerklePathEthereum, len(p.MerklePaths))
+	for i, path := range p.MerklePaths {
+		parsePaths[i] = path.encodeToEthFormat()
+	}
+	return verifyPacketArguments.Pack(
+		big.NewInt(int64(blockHeight)),
+		p.Packet.TunnelID,
+		p.Packet.Sequence,
+		[]byte(p.EncodedPacket),
+		big.NewInt(int64(p.Version)),
+		parsePaths,
+	)
+}
+
 type CommonEncodedVotePartEthereum struct {
 	SignedDataPrefix []byte
 	SignedDataSuffix []byte
@@ -95,13 +152,14 @@ func (commonVote *CommonEncodedVotePart) encodeToEthFormat() CommonEncodedVotePa
 
 func getProofsByKey(
 	ctx client.Context,
+	storeName string,
 	key []byte,
 	queryOptions rpcclient.ABCIQueryOptions,
 	getMultiStoreEp bool,
 ) ([]byte, *ics23.ExistenceProof, *ics23.ExistenceProof, error) {
 	resp, err := ctx.Client.ABCIQueryWithOptions(
 		context.Background(),
-		"/store/oracle/key",
+		fmt.Sprintf("/store/%s/key", storeName),
 		key,
 		queryOptions,
 	)
@@ -119,6 +177,20 @@ func getProofsByKey(
 		return nil, &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, fmt.Errorf("Proof ops not found")
 	}
 
+	iavlEp, multiStoreEp, err := getExistenceProofs(ops, getMultiStoreEp)
+	if err != nil {
+		return nil, &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, err
+	}
+
+	return resp.Response.GetValue(), iavlEp, multiStoreEp, nil
+}
+
+// getExistenceProofs extracts the IAVL existence proof and, if requested, the multistore existence proof
+// from the given proof ops.
+func getExistenceProofs(
+	ops []cmtcrypto.ProofOp,
+	getMultiStoreEp bool,
+) (*ics23.ExistenceProof, *ics23.ExistenceProof, error) {
 	// Extract iavl proof and multistore existence proof
 	var iavlEp *ics23.ExistenceProof
 	var multiStoreEp *ics23.ExistenceProof
@@ -133,7 +205,7 @@ func getProofsByKey(
 			iavlOps := storetypes.NewIavlCommitmentOp(op.Key, proof)
 			iavlEp = iavlOps.Proof.GetExist()
 			if iavlEp == nil {
-				return nil, &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, fmt.Errorf(
+				return &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, fmt.Errorf(
 					"IAVL existence proof not found",
 				)
 			}
@@ -147,15 +219,15 @@ func getProofsByKey(
 				multiStoreOps := storetypes.NewSimpleMerkleCommitmentOp(op.Key, proof)
 				multiStoreEp = multiStoreOps.Proof.GetExist()
 				if multiStoreEp == nil {
-					return nil, &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, fmt.Errorf(
+					return &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, fmt.Errorf(
 						"MultiStore existence proof not found",
 					)
 				}
 			}
 		default:
-			return nil, &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, fmt.Errorf("unknown proof ops found")
+			return &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, fmt.Errorf("unknown proof ops found")
 		}
 	}
 
-	return resp.Response.GetValue(), iavlEp, multiStoreEp, nil
+	return iavlEp, multiStoreEp, nil
 }
@@ -6,7 +6,9 @@ package proof
 import (
 	context "context"
 	fmt "fmt"
+	types1 "github.com/bandprotocol/chain/v3/x/feeds/types"
 	types "github.com/bandprotocol/chain/v3/x/oracle/types"
+	types2 "github.com/bandprotocol/chain/v3/x/tunnel/types"
 	github_com_cometbft_cometbft_libs_bytes "github.com/cometbft/cometbft/libs/bytes"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -330,6 +332,235 @@ func (m *RequestCountProofResponse) GetResult() CountProofResult {
 	return CountProofResult{}
 }
 
+// PriceProofRequest is request type for the Service/PriceProof RPC method.
+type PriceProofRequest struct {
+	// signal_ids is the list of signal IDs
+	SignalIds []string `protobuf:"bytes,1,rep,name=signal_ids,json=signalIds,proto3" json:"signal_ids,omitempty"`
+	// height is block height
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *PriceProofRequest) Reset()         { *m = PriceProofRequest{} }
+func (m *PriceProofRequest) String() string { return proto.CompactTextString(m) }
+func (*PriceProofRequest) ProtoMessage()    {}
+func (*PriceProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{6}
+}
+func (m *PriceProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceProofRequest.Merge(m, src)
+}
+func (m *PriceProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceProofRequest proto.InternalMessageInfo
+
+func (m *PriceProofRequest) GetSignalIds() []string {
+	if m != nil {
+		return m.SignalIds
+	}
+	return nil
+}
+
+func (m *PriceProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// PriceProofResponse is response type for the Service/PriceProof RPC method.
+type PriceProofResponse struct {
+	// height is block height
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// result is the proof
+	Result PriceProofResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result"`
+}
+
+func (m *PriceProofResponse) Reset()         { *m = PriceProofResponse{} }
+func (m *PriceProofResponse) String() string { return proto.CompactTextString(m) }
+func (*PriceProofResponse) ProtoMessage()    {}
+func (*PriceProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{7}
+}
+func (m *PriceProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceProofResponse.Merge(m, src)
+}
+func (m *PriceProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceProofResponse proto.InternalMessageInfo
+
+func (m *PriceProofResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PriceProofResponse) GetResult() PriceProofResult {
+	if m != nil {
+		return m.Result
+	}
+	return PriceProofResult{}
+}
+
+// TunnelPacketProofRequest is request type for the Service/TunnelPacketProof RPC method.
+type TunnelPacketProofRequest struct {
+	// tunnel_id is the ID of the tunnel
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// sequence is the sequence of the packet
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// height is block height
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *TunnelPacketProofRequest) Reset()         { *m = TunnelPacketProofRequest{} }
+func (m *TunnelPacketProofRequest) String() string { return proto.CompactTextString(m) }
+func (*TunnelPacketProofRequest) ProtoMessage()    {}
+func (*TunnelPacketProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{8}
+}
+func (m *TunnelPacketProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TunnelPacketProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TunnelPacketProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TunnelPacketProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TunnelPacketProofRequest.Merge(m, src)
+}
+func (m *TunnelPacketProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TunnelPacketProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TunnelPacketProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TunnelPacketProofRequest proto.InternalMessageInfo
+
+func (m *TunnelPacketProofRequest) GetTunnelId() uint64 {
+	if m != nil {
+		return m.TunnelId
+	}
+	return 0
+}
+
+func (m *TunnelPacketProofRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *TunnelPacketProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// TunnelPacketProofResponse is response type for the Service/TunnelPacketProof RPC method.
+type TunnelPacketProofResponse struct {
+	// height is block height
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// result is the proof
+	Result PacketProofResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result"`
+}
+
+func (m *TunnelPacketProofResponse) Reset()         { *m = TunnelPacketProofResponse{} }
+func (m *TunnelPacketProofResponse) String() string { return proto.CompactTextString(m) }
+func (*TunnelPacketProofResponse) ProtoMessage()    {}
+func (*TunnelPacketProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{9}
+}
+func (m *TunnelPacketProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TunnelPacketProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TunnelPacketProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TunnelPacketProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TunnelPacketProofResponse.Merge(m, src)
+}
+func (m *TunnelPacketProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TunnelPacketProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TunnelPacketProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TunnelPacketProofResponse proto.InternalMessageInfo
+
+func (m *TunnelPacketProofResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TunnelPacketProofResponse) GetResult() PacketProofResult {
+	if m != nil {
+		return m.Result
+	}
+	return PacketProofResult{}
+}
+
 // SingleProofResponse is the data structure for response of single proof
 type SingleProofResult struct {
 	Proof         SingleProof                                      `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
@@ -340,7 +571,7 @@ func (m *SingleProofResult) Reset()         { *m = SingleProofResult{} }
 func (m *SingleProofResult) String() string { return proto.CompactTextString(m) }
 func (*SingleProofResult) ProtoMessage()    {}
 func (*SingleProofResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{6}
+	return fileDescriptor_9deb752797273b09, []int{10}
 }
 func (m *SingleProofResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,7 +624,7 @@ func (m *MultiProofResult) Reset()         { *m = MultiProofResult{} }
 func (m *MultiProofResult) String() string { return proto.CompactTextString(m) }
 func (*MultiProofResult) ProtoMessage()    {}
 func (*MultiProofResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{7}
+	return fileDescriptor_9deb752797273b09, []int{11}
 }
 func (m *MultiProofResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,7 +677,7 @@ func (m *CountProofResult) Reset()         { *m = CountProofResult{} }
 func (m *CountProofResult) String() string { return proto.CompactTextString(m) }
 func (*CountProofResult) ProtoMessage()    {}
 func (*CountProofResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{8}
+	return fileDescriptor_9deb752797273b09, []int{12}
 }
 func (m *CountProofResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -489,6 +720,112 @@ func (m *CountProofResult) GetEvmProofBytes() github_com_cometbft_cometbft_libs_
 	return nil
 }
 
+// PriceProofResult is the data structure for response of price proof
+type PriceProofResult struct {
+	Proof         PriceMultiProof                                  `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
+	EvmProofBytes github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"evm_proof_bytes,omitempty"`
+}
+
+func (m *PriceProofResult) Reset()         { *m = PriceProofResult{} }
+func (m *PriceProofResult) String() string { return proto.CompactTextString(m) }
+func (*PriceProofResult) ProtoMessage()    {}
+func (*PriceProofResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{13}
+}
+func (m *PriceProofResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceProofResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceProofResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceProofResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceProofResult.Merge(m, src)
+}
+func (m *PriceProofResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceProofResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceProofResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceProofResult proto.InternalMessageInfo
+
+func (m *PriceProofResult) GetProof() PriceMultiProof {
+	if m != nil {
+		return m.Proof
+	}
+	return PriceMultiProof{}
+}
+
+func (m *PriceProofResult) GetEvmProofBytes() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EvmProofBytes
+	}
+	return nil
+}
+
+// PacketProofResult is the data structure for response of tunnel packet proof
+type PacketProofResult struct {
+	Proof         PacketProof                                      `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
+	EvmProofBytes github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"evm_proof_bytes,omitempty"`
+}
+
+func (m *PacketProofResult) Reset()         { *m = PacketProofResult{} }
+func (m *PacketProofResult) String() string { return proto.CompactTextString(m) }
+func (*PacketProofResult) ProtoMessage()    {}
+func (*PacketProofResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{14}
+}
+func (m *PacketProofResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketProofResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketProofResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketProofResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketProofResult.Merge(m, src)
+}
+func (m *PacketProofResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketProofResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketProofResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketProofResult proto.InternalMessageInfo
+
+func (m *PacketProofResult) GetProof() PacketProof {
+	if m != nil {
+		return m.Proof
+	}
+	return PacketProof{}
+}
+
+func (m *PacketProofResult) GetEvmProofBytes() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EvmProofBytes
+	}
+	return nil
+}
+
 // SingleProof contains block height, oracle data proof and block relay proof
 type SingleProof struct {
 	BlockHeight     uint64          `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
@@ -500,7 +837,7 @@ func (m *SingleProof) Reset()         { *m = SingleProof{} }
 func (m *SingleProof) String() string { return proto.CompactTextString(m) }
 func (*SingleProof) ProtoMessage()    {}
 func (*SingleProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{9}
+	return fileDescriptor_9deb752797273b09, []int{15}
 }
 func (m *SingleProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,7 +898,7 @@ func (m *MultiProof) Reset()         { *m = MultiProof{} }
 func (m *MultiProof) String() string { return proto.CompactTextString(m) }
 func (*MultiProof) ProtoMessage()    {}
 func (*MultiProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{10}
+	return fileDescriptor_9deb752797273b09, []int{16}
 }
 func (m *MultiProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -622,7 +959,7 @@ func (m *CountProof) Reset()         { *m = CountProof{} }
 func (m *CountProof) String() string { return proto.CompactTextString(m) }
 func (*CountProof) ProtoMessage()    {}
 func (*CountProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{11}
+	return fileDescriptor_9deb752797273b09, []int{17}
 }
 func (m *CountProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -672,25 +1009,25 @@ func (m *CountProof) GetBlockRelayProof() BlockRelayProof {
 	return BlockRelayProof{}
 }
 
-// OracleDataProof contains result, version and merkle paths
-type OracleDataProof struct {
-	Result      types.Result     `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
-	Version     uint64           `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	MerklePaths []IAVLMerklePath `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths"`
+// PriceMultiProof contains block height, list of price data proof and store block relay proof
+type PriceMultiProof struct {
+	BlockHeight         uint64               `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	PriceDataMultiProof []PriceDataProof     `protobuf:"bytes,2,rep,name=price_data_multi_proof,json=priceDataMultiProof,proto3" json:"price_data_multi_proof"`
+	BlockRelayProof     StoreBlockRelayProof `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof"`
 }
 
-func (m *OracleDataProof) Reset()         { *m = OracleDataProof{} }
-func (m *OracleDataProof) String() string { return proto.CompactTextString(m) }
-func (*OracleDataProof) ProtoMessage()    {}
-func (*OracleDataProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{12}
+func (m *PriceMultiProof) Reset()         { *m = PriceMultiProof{} }
+func (m *PriceMultiProof) String() string { return proto.CompactTextString(m) }
+func (*PriceMultiProof) ProtoMessage()    {}
+func (*PriceMultiProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{18}
 }
-func (m *OracleDataProof) XXX_Unmarshal(b []byte) error {
+func (m *PriceMultiProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OracleDataProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PriceMultiProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OracleDataProof.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PriceMultiProof.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -700,33 +1037,286 @@ func (m *OracleDataProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *OracleDataProof) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OracleDataProof.Merge(m, src)
+func (m *PriceMultiProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceMultiProof.Merge(m, src)
 }
-func (m *OracleDataProof) XXX_Size() int {
+func (m *PriceMultiProof) XXX_Size() int {
 	return m.Size()
 }
-func (m *OracleDataProof) XXX_DiscardUnknown() {
-	xxx_messageInfo_OracleDataProof.DiscardUnknown(m)
+func (m *PriceMultiProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceMultiProof.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OracleDataProof proto.InternalMessageInfo
+var xxx_messageInfo_PriceMultiProof proto.InternalMessageInfo
 
-func (m *OracleDataProof) GetResult() types.Result {
+func (m *PriceMultiProof) GetBlockHeight() uint64 {
 	if m != nil {
-		return m.Result
+		return m.BlockHeight
 	}
-	return types.Result{}
+	return 0
 }
 
-func (m *OracleDataProof) GetVersion() uint64 {
+func (m *PriceMultiProof) GetPriceDataMultiProof() []PriceDataProof {
 	if m != nil {
-		return m.Version
+		return m.PriceDataMultiProof
 	}
-	return 0
+	return nil
 }
 
-func (m *OracleDataProof) GetMerklePaths() []IAVLMerklePath {
+func (m *PriceMultiProof) GetBlockRelayProof() StoreBlockRelayProof {
+	if m != nil {
+		return m.BlockRelayProof
+	}
+	return StoreBlockRelayProof{}
+}
+
+// PacketProof contains block height, packet data proof and store block relay proof
+type PacketProof struct {
+	BlockHeight     uint64               `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	PacketDataProof PacketDataProof      `protobuf:"bytes,2,opt,name=packet_data_proof,json=packetDataProof,proto3" json:"packet_data_proof"`
+	BlockRelayProof StoreBlockRelayProof `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof"`
+}
+
+func (m *PacketProof) Reset()         { *m = PacketProof{} }
+func (m *PacketProof) String() string { return proto.CompactTextString(m) }
+func (*PacketProof) ProtoMessage()    {}
+func (*PacketProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{19}
+}
+func (m *PacketProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketProof.Merge(m, src)
+}
+func (m *PacketProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketProof proto.InternalMessageInfo
+
+func (m *PacketProof) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *PacketProof) GetPacketDataProof() PacketDataProof {
+	if m != nil {
+		return m.PacketDataProof
+	}
+	return PacketDataProof{}
+}
+
+func (m *PacketProof) GetBlockRelayProof() StoreBlockRelayProof {
+	if m != nil {
+		return m.BlockRelayProof
+	}
+	return StoreBlockRelayProof{}
+}
+
+// OracleDataProof contains result, version and merkle paths
+type OracleDataProof struct {
+	Result      types.Result     `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
+	Version     uint64           `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	MerklePaths []IAVLMerklePath `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths"`
+}
+
+func (m *OracleDataProof) Reset()         { *m = OracleDataProof{} }
+func (m *OracleDataProof) String() string { return proto.CompactTextString(m) }
+func (*OracleDataProof) ProtoMessage()    {}
+func (*OracleDataProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{20}
+}
+func (m *OracleDataProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleDataProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleDataProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleDataProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleDataProof.Merge(m, src)
+}
+func (m *OracleDataProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleDataProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleDataProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleDataProof proto.InternalMessageInfo
+
+func (m *OracleDataProof) GetResult() types.Result {
+	if m != nil {
+		return m.Result
+	}
+	return types.Result{}
+}
+
+func (m *OracleDataProof) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *OracleDataProof) GetMerklePaths() []IAVLMerklePath {
+	if m != nil {
+		return m.MerklePaths
+	}
+	return nil
+}
+
+// PriceDataProof contains price, version and merkle paths
+type PriceDataProof struct {
+	Price       types1.Price     `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+	Version     uint64           `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	MerklePaths []IAVLMerklePath `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths"`
+}
+
+func (m *PriceDataProof) Reset()         { *m = PriceDataProof{} }
+func (m *PriceDataProof) String() string { return proto.CompactTextString(m) }
+func (*PriceDataProof) ProtoMessage()    {}
+func (*PriceDataProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{21}
+}
+func (m *PriceDataProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceDataProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceDataProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceDataProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceDataProof.Merge(m, src)
+}
+func (m *PriceDataProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceDataProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceDataProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceDataProof proto.InternalMessageInfo
+
+func (m *PriceDataProof) GetPrice() types1.Price {
+	if m != nil {
+		return m.Price
+	}
+	return types1.Price{}
+}
+
+func (m *PriceDataProof) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *PriceDataProof) GetMerklePaths() []IAVLMerklePath {
+	if m != nil {
+		return m.MerklePaths
+	}
+	return nil
+}
+
+// PacketDataProof contains packet, its encoded form as stored in the tunnel store, version and merkle paths.
+// The encoded packet is the value committed to the store and must be used for the verification.
+type PacketDataProof struct {
+	Packet        types2.Packet                                    `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+	EncodedPacket github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=encoded_packet,json=encodedPacket,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"encoded_packet,omitempty"`
+	Version       uint64                                           `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	MerklePaths   []IAVLMerklePath                                 `protobuf:"bytes,4,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths"`
+}
+
+func (m *PacketDataProof) Reset()         { *m = PacketDataProof{} }
+func (m *PacketDataProof) String() string { return proto.CompactTextString(m) }
+func (*PacketDataProof) ProtoMessage()    {}
+func (*PacketDataProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{22}
+}
+func (m *PacketDataProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketDataProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketDataProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketDataProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketDataProof.Merge(m, src)
+}
+func (m *PacketDataProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketDataProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketDataProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketDataProof proto.InternalMessageInfo
+
+func (m *PacketDataProof) GetPacket() types2.Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return types2.Packet{}
+}
+
+func (m *PacketDataProof) GetEncodedPacket() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EncodedPacket
+	}
+	return nil
+}
+
+func (m *PacketDataProof) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *PacketDataProof) GetMerklePaths() []IAVLMerklePath {
 	if m != nil {
 		return m.MerklePaths
 	}
@@ -746,7 +1336,7 @@ func (m *IAVLMerklePath) Reset()         { *m = IAVLMerklePath{} }
 func (m *IAVLMerklePath) String() string { return proto.CompactTextString(m) }
 func (*IAVLMerklePath) ProtoMessage()    {}
 func (*IAVLMerklePath) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{13}
+	return fileDescriptor_9deb752797273b09, []int{23}
 }
 func (m *IAVLMerklePath) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -822,7 +1412,7 @@ func (m *BlockRelayProof) Reset()         { *m = BlockRelayProof{} }
 func (m *BlockRelayProof) String() string { return proto.CompactTextString(m) }
 func (*BlockRelayProof) ProtoMessage()    {}
 func (*BlockRelayProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{14}
+	return fileDescriptor_9deb752797273b09, []int{24}
 }
 func (m *BlockRelayProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,51 +1469,27 @@ func (m *BlockRelayProof) GetSignatures() []TMSignature {
 	return nil
 }
 
-// MultiStoreProof stores a compact of other Cosmos-SDK modules' storage hash in multistore to
-// compute (in combination with oracle store hash) Tendermint's application state hash at a given block.
-//
-//	                                 __________________________[AppHash]__________________________
-//	                                /                                                             \
-//	                  ___________[N24]____________                                    ___________[N25]________
-//	                 /                            \                                  /                        \
-//	          _____[N20]_____                 ____[N21]______                   ____[N22]______              _[N23]_
-//	         /               \               /               \                 /               \            /       \
-//	     _[N12]_          _[N13]_          _[N14]_          _[N15]_          _[N16]_          _[N17]_     [N18]     [Q]
-//	    /       \        /       \        /       \        /       \        /       \        /       \    /   \
-//	  [N0]     [N1]    [N2]     [N3]    [N4]     [N5]    [N6]     [N7]    [N8]     [N9]   [N10]    [N11] [O] [P]
-//	 /   \    /   \    /  \    /   \   /   \    /   \   /   \    /   \   /   \    /   \   /   \    /   \
-//	[0] [1]  [2] [3] [4] [5]  [6] [7] [8] [9]  [A] [B] [C] [D]  [E] [F] [G] [H]  [I] [J] [K] [L]  [M] [N]
-//
-// [0] - acc (auth) [1] - authz         [2] - bandtss       [3] - bank        [4] - capability [5] - consensus
-// [6] - crisis     [7] - distribution  [8] - evidence      [9] - feeds       [A] - feegrant   [B] - feeibc
-// [C] - globalfee  [D] - gov           [E] - ibc           [F] - icahost     [G] - mint       [H] - oracle
-// [I] - params     [J] - restake       [K] - rollingseed   [L] - slashing    [M] - staking    [N] - transfer
-// [O] - tss        [P] - tunnel        [Q] - upgrade
-//
-// Notice that NOT all leaves of the Merkle tree are needed in order to compute the Merkle
-// root hash, since we only want to validate the correctness of [H] In fact, only
-// [G], [N9], [N17], [N23], and [N24] are needed in order to compute [AppHash].
-type MultiStoreProof struct {
-	OracleIAVLStateHash                   github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=oracle_iavl_state_hash,json=oracleIavlStateHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"oracle_iavl_state_hash,omitempty"`
-	MintStoreMerkleHash                   github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=mint_store_merkle_hash,json=mintStoreMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"mint_store_merkle_hash,omitempty"`
-	ParamsToRestakeStoresMerkleHash       github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=params_to_restake_stores_merkle_hash,json=paramsToRestakeStoresMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"params_to_restake_stores_merkle_hash,omitempty"`
-	RollingseedToTransferStoresMerkleHash github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,4,opt,name=rollingseed_to_transfer_stores_merkle_hash,json=rollingseedToTransferStoresMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"rollingseed_to_transfer_stores_merkle_hash,omitempty"`
-	TSSToUpgradeStoresMerkleHash          github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,5,opt,name=tss_to_upgrade_stores_merkle_hash,json=tssToUpgradeStoresMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"tss_to_upgrade_stores_merkle_hash,omitempty"`
-	AuthToIcahostStoresMerkleHash         github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,6,opt,name=auth_to_icahost_stores_merkle_hash,json=authToIcahostStoresMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"auth_to_icahost_stores_merkle_hash,omitempty"`
+// StoreBlockRelayProof contains store multi store proof, block header merkle parts, common encoded part and
+// signatures. It is the same as BlockRelayProof but proves the state hash of any store in the multistore.
+type StoreBlockRelayProof struct {
+	MultiStoreProof        StoreMultiStoreProof   `protobuf:"bytes,1,opt,name=multi_store_proof,json=multiStoreProof,proto3" json:"multi_store_proof"`
+	BlockHeaderMerkleParts BlockHeaderMerkleParts `protobuf:"bytes,2,opt,name=block_header_merkle_parts,json=blockHeaderMerkleParts,proto3" json:"block_header_merkle_parts"`
+	CommonEncodedVotePart  CommonEncodedVotePart  `protobuf:"bytes,3,opt,name=common_encoded_vote_part,json=commonEncodedVotePart,proto3" json:"common_encoded_vote_part"`
+	Signatures             []TMSignature          `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures"`
 }
 
-func (m *MultiStoreProof) Reset()         { *m = MultiStoreProof{} }
-func (m *MultiStoreProof) String() string { return proto.CompactTextString(m) }
-func (*MultiStoreProof) ProtoMessage()    {}
-func (*MultiStoreProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{15}
+func (m *StoreBlockRelayProof) Reset()         { *m = StoreBlockRelayProof{} }
+func (m *StoreBlockRelayProof) String() string { return proto.CompactTextString(m) }
+func (*StoreBlockRelayProof) ProtoMessage()    {}
+func (*StoreBlockRelayProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{25}
 }
-func (m *MultiStoreProof) XXX_Unmarshal(b []byte) error {
+func (m *StoreBlockRelayProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiStoreProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StoreBlockRelayProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiStoreProof.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StoreBlockRelayProof.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -933,61 +1499,274 @@ func (m *MultiStoreProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MultiStoreProof) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiStoreProof.Merge(m, src)
+func (m *StoreBlockRelayProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreBlockRelayProof.Merge(m, src)
 }
-func (m *MultiStoreProof) XXX_Size() int {
+func (m *StoreBlockRelayProof) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiStoreProof) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiStoreProof.DiscardUnknown(m)
+func (m *StoreBlockRelayProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreBlockRelayProof.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiStoreProof proto.InternalMessageInfo
+var xxx_messageInfo_StoreBlockRelayProof proto.InternalMessageInfo
 
-func (m *MultiStoreProof) GetOracleIAVLStateHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+func (m *StoreBlockRelayProof) GetMultiStoreProof() StoreMultiStoreProof {
 	if m != nil {
-		return m.OracleIAVLStateHash
+		return m.MultiStoreProof
 	}
-	return nil
+	return StoreMultiStoreProof{}
 }
 
-func (m *MultiStoreProof) GetMintStoreMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+func (m *StoreBlockRelayProof) GetBlockHeaderMerkleParts() BlockHeaderMerkleParts {
 	if m != nil {
-		return m.MintStoreMerkleHash
+		return m.BlockHeaderMerkleParts
 	}
-	return nil
+	return BlockHeaderMerkleParts{}
 }
 
-func (m *MultiStoreProof) GetParamsToRestakeStoresMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+func (m *StoreBlockRelayProof) GetCommonEncodedVotePart() CommonEncodedVotePart {
 	if m != nil {
-		return m.ParamsToRestakeStoresMerkleHash
+		return m.CommonEncodedVotePart
 	}
-	return nil
+	return CommonEncodedVotePart{}
 }
 
-func (m *MultiStoreProof) GetRollingseedToTransferStoresMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+func (m *StoreBlockRelayProof) GetSignatures() []TMSignature {
 	if m != nil {
-		return m.RollingseedToTransferStoresMerkleHash
+		return m.Signatures
 	}
 	return nil
 }
 
-func (m *MultiStoreProof) GetTSSToUpgradeStoresMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
-	if m != nil {
-		return m.TSSToUpgradeStoresMerkleHash
-	}
-	return nil
+// StoreMultiStoreProof stores the IAVL state hash of a store and the Merkle steps from the store leaf
+// to Tendermint's application state hash at a given block. Unlike MultiStoreProof, it does not depend
+// on the position of the store in the multistore tree (see MultiStoreProof for the tree layout).
+type StoreMultiStoreProof struct {
+	// store_key is the name of the store, i.e. the key of the store leaf in the multistore
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	// iavl_state_hash is the root hash of the IAVL tree of the store
+	IAVLStateHash github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=iavl_state_hash,json=iavlStateHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"iavl_state_hash,omitempty"`
+	// merkle_paths is the list of Merkle steps from the store leaf to the application state hash
+	MerklePaths []MultiStoreMerklePath `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths"`
 }
 
-func (m *MultiStoreProof) GetAuthToIcahostStoresMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
-	if m != nil {
-		return m.AuthToIcahostStoresMerkleHash
-	}
-	return nil
+func (m *StoreMultiStoreProof) Reset()         { *m = StoreMultiStoreProof{} }
+func (m *StoreMultiStoreProof) String() string { return proto.CompactTextString(m) }
+func (*StoreMultiStoreProof) ProtoMessage()    {}
+func (*StoreMultiStoreProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{26}
 }
-
-// BlockHeaderMerkleParts stores a group of hashes using for computing Tendermint's block
+func (m *StoreMultiStoreProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreMultiStoreProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreMultiStoreProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreMultiStoreProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreMultiStoreProof.Merge(m, src)
+}
+func (m *StoreMultiStoreProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreMultiStoreProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreMultiStoreProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreMultiStoreProof proto.InternalMessageInfo
+
+func (m *StoreMultiStoreProof) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *StoreMultiStoreProof) GetIAVLStateHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.IAVLStateHash
+	}
+	return nil
+}
+
+func (m *StoreMultiStoreProof) GetMerklePaths() []MultiStoreMerklePath {
+	if m != nil {
+		return m.MerklePaths
+	}
+	return nil
+}
+
+// MultiStoreMerklePath represents a Merkle step to a store leaf in the multistore simple Merkle tree.
+type MultiStoreMerklePath struct {
+	IsDataOnRight bool                                             `protobuf:"varint,1,opt,name=is_data_on_right,json=isDataOnRight,proto3" json:"is_data_on_right,omitempty"`
+	SiblingHash   github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=sibling_hash,json=siblingHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"sibling_hash,omitempty"`
+}
+
+func (m *MultiStoreMerklePath) Reset()         { *m = MultiStoreMerklePath{} }
+func (m *MultiStoreMerklePath) String() string { return proto.CompactTextString(m) }
+func (*MultiStoreMerklePath) ProtoMessage()    {}
+func (*MultiStoreMerklePath) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{27}
+}
+func (m *MultiStoreMerklePath) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiStoreMerklePath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiStoreMerklePath.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiStoreMerklePath) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiStoreMerklePath.Merge(m, src)
+}
+func (m *MultiStoreMerklePath) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiStoreMerklePath) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiStoreMerklePath.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiStoreMerklePath proto.InternalMessageInfo
+
+func (m *MultiStoreMerklePath) GetIsDataOnRight() bool {
+	if m != nil {
+		return m.IsDataOnRight
+	}
+	return false
+}
+
+func (m *MultiStoreMerklePath) GetSiblingHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.SiblingHash
+	}
+	return nil
+}
+
+// MultiStoreProof stores a compact of other Cosmos-SDK modules' storage hash in multistore to
+// compute (in combination with oracle store hash) Tendermint's application state hash at a given block.
+//
+//	                                 __________________________[AppHash]__________________________
+//	                                /                                                             \
+//	                  ___________[N24]____________                                    ___________[N25]________
+//	                 /                            \                                  /                        \
+//	          _____[N20]_____                 ____[N21]______                   ____[N22]______              _[N23]_
+//	         /               \               /               \                 /               \            /       \
+//	     _[N12]_          _[N13]_          _[N14]_          _[N15]_          _[N16]_          _[N17]_     [N18]     [Q]
+//	    /       \        /       \        /       \        /       \        /       \        /       \    /   \
+//	  [N0]     [N1]    [N2]     [N3]    [N4]     [N5]    [N6]     [N7]    [N8]     [N9]   [N10]    [N11] [O] [P]
+//	 /   \    /   \    /  \    /   \   /   \    /   \   /   \    /   \   /   \    /   \   /   \    /   \
+//	[0] [1]  [2] [3] [4] [5]  [6] [7] [8] [9]  [A] [B] [C] [D]  [E] [F] [G] [H]  [I] [J] [K] [L]  [M] [N]
+//
+// [0] - acc (auth) [1] - authz         [2] - bandtss       [3] - bank        [4] - capability [5] - consensus
+// [6] - crisis     [7] - distribution  [8] - evidence      [9] - feeds       [A] - feegrant   [B] - feeibc
+// [C] - globalfee  [D] - gov           [E] - ibc           [F] - icahost     [G] - mint       [H] - oracle
+// [I] - params     [J] - restake       [K] - rollingseed   [L] - slashing    [M] - staking    [N] - transfer
+// [O] - tss        [P] - tunnel        [Q] - upgrade
+//
+// Notice that NOT all leaves of the Merkle tree are needed in order to compute the Merkle
+// root hash, since we only want to validate the correctness of [H] In fact, only
+// [G], [N9], [N17], [N23], and [N24] are needed in order to compute [AppHash].
+type MultiStoreProof struct {
+	OracleIAVLStateHash                   github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=oracle_iavl_state_hash,json=oracleIavlStateHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"oracle_iavl_state_hash,omitempty"`
+	MintStoreMerkleHash                   github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=mint_store_merkle_hash,json=mintStoreMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"mint_store_merkle_hash,omitempty"`
+	ParamsToRestakeStoresMerkleHash       github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=params_to_restake_stores_merkle_hash,json=paramsToRestakeStoresMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"params_to_restake_stores_merkle_hash,omitempty"`
+	RollingseedToTransferStoresMerkleHash github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,4,opt,name=rollingseed_to_transfer_stores_merkle_hash,json=rollingseedToTransferStoresMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"rollingseed_to_transfer_stores_merkle_hash,omitempty"`
+	TSSToUpgradeStoresMerkleHash          github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,5,opt,name=tss_to_upgrade_stores_merkle_hash,json=tssToUpgradeStoresMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"tss_to_upgrade_stores_merkle_hash,omitempty"`
+	AuthToIcahostStoresMerkleHash         github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,6,opt,name=auth_to_icahost_stores_merkle_hash,json=authToIcahostStoresMerkleHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"auth_to_icahost_stores_merkle_hash,omitempty"`
+}
+
+func (m *MultiStoreProof) Reset()         { *m = MultiStoreProof{} }
+func (m *MultiStoreProof) String() string { return proto.CompactTextString(m) }
+func (*MultiStoreProof) ProtoMessage()    {}
+func (*MultiStoreProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{28}
+}
+func (m *MultiStoreProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiStoreProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiStoreProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiStoreProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiStoreProof.Merge(m, src)
+}
+func (m *MultiStoreProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiStoreProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiStoreProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiStoreProof proto.InternalMessageInfo
+
+func (m *MultiStoreProof) GetOracleIAVLStateHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.OracleIAVLStateHash
+	}
+	return nil
+}
+
+func (m *MultiStoreProof) GetMintStoreMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.MintStoreMerkleHash
+	}
+	return nil
+}
+
+func (m *MultiStoreProof) GetParamsToRestakeStoresMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.ParamsToRestakeStoresMerkleHash
+	}
+	return nil
+}
+
+func (m *MultiStoreProof) GetRollingseedToTransferStoresMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.RollingseedToTransferStoresMerkleHash
+	}
+	return nil
+}
+
+func (m *MultiStoreProof) GetTSSToUpgradeStoresMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.TSSToUpgradeStoresMerkleHash
+	}
+	return nil
+}
+
+func (m *MultiStoreProof) GetAuthToIcahostStoresMerkleHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.AuthToIcahostStoresMerkleHash
+	}
+	return nil
+}
+
+// BlockHeaderMerkleParts stores a group of hashes using for computing Tendermint's block
 // header hash from app hash, and height.
 //
 // In Tendermint, a block header hash is the Merkle hash of a binary tree with 14 leaf nodes.
@@ -1028,7 +1807,7 @@ func (m *BlockHeaderMerkleParts) Reset()         { *m = BlockHeaderMerkleParts{}
 func (m *BlockHeaderMerkleParts) String() string { return proto.CompactTextString(m) }
 func (*BlockHeaderMerkleParts) ProtoMessage()    {}
 func (*BlockHeaderMerkleParts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{16}
+	return fileDescriptor_9deb752797273b09, []int{29}
 }
 func (m *BlockHeaderMerkleParts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1123,7 +1902,7 @@ func (m *CommonEncodedVotePart) Reset()         { *m = CommonEncodedVotePart{} }
 func (m *CommonEncodedVotePart) String() string { return proto.CompactTextString(m) }
 func (*CommonEncodedVotePart) ProtoMessage()    {}
 func (*CommonEncodedVotePart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{17}
+	return fileDescriptor_9deb752797273b09, []int{30}
 }
 func (m *CommonEncodedVotePart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1182,7 +1961,7 @@ func (m *TMSignature) Reset()         { *m = TMSignature{} }
 func (m *TMSignature) String() string { return proto.CompactTextString(m) }
 func (*TMSignature) ProtoMessage()    {}
 func (*TMSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{18}
+	return fileDescriptor_9deb752797273b09, []int{31}
 }
 func (m *TMSignature) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1250,7 +2029,7 @@ func (m *RequestsCountProof) Reset()         { *m = RequestsCountProof{} }
 func (m *RequestsCountProof) String() string { return proto.CompactTextString(m) }
 func (*RequestsCountProof) ProtoMessage()    {}
 func (*RequestsCountProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{19}
+	return fileDescriptor_9deb752797273b09, []int{32}
 }
 func (m *RequestsCountProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1307,15 +2086,28 @@ func init() {
 	proto.RegisterType((*MultiProofResponse)(nil), "band.base.oracle.v1.MultiProofResponse")
 	proto.RegisterType((*RequestCountProofRequest)(nil), "band.base.oracle.v1.RequestCountProofRequest")
 	proto.RegisterType((*RequestCountProofResponse)(nil), "band.base.oracle.v1.RequestCountProofResponse")
+	proto.RegisterType((*PriceProofRequest)(nil), "band.base.oracle.v1.PriceProofRequest")
+	proto.RegisterType((*PriceProofResponse)(nil), "band.base.oracle.v1.PriceProofResponse")
+	proto.RegisterType((*TunnelPacketProofRequest)(nil), "band.base.oracle.v1.TunnelPacketProofRequest")
+	proto.RegisterType((*TunnelPacketProofResponse)(nil), "band.base.oracle.v1.TunnelPacketProofResponse")
 	proto.RegisterType((*SingleProofResult)(nil), "band.base.oracle.v1.SingleProofResult")
 	proto.RegisterType((*MultiProofResult)(nil), "band.base.oracle.v1.MultiProofResult")
 	proto.RegisterType((*CountProofResult)(nil), "band.base.oracle.v1.CountProofResult")
+	proto.RegisterType((*PriceProofResult)(nil), "band.base.oracle.v1.PriceProofResult")
+	proto.RegisterType((*PacketProofResult)(nil), "band.base.oracle.v1.PacketProofResult")
 	proto.RegisterType((*SingleProof)(nil), "band.base.oracle.v1.SingleProof")
 	proto.RegisterType((*MultiProof)(nil), "band.base.oracle.v1.MultiProof")
 	proto.RegisterType((*CountProof)(nil), "band.base.oracle.v1.CountProof")
+	proto.RegisterType((*PriceMultiProof)(nil), "band.base.oracle.v1.PriceMultiProof")
+	proto.RegisterType((*PacketProof)(nil), "band.base.oracle.v1.PacketProof")
 	proto.RegisterType((*OracleDataProof)(nil), "band.base.oracle.v1.OracleDataProof")
+	proto.RegisterType((*PriceDataProof)(nil), "band.base.oracle.v1.PriceDataProof")
+	proto.RegisterType((*PacketDataProof)(nil), "band.base.oracle.v1.PacketDataProof")
 	proto.RegisterType((*IAVLMerklePath)(nil), "band.base.oracle.v1.IAVLMerklePath")
 	proto.RegisterType((*BlockRelayProof)(nil), "band.base.oracle.v1.BlockRelayProof")
+	proto.RegisterType((*StoreBlockRelayProof)(nil), "band.base.oracle.v1.StoreBlockRelayProof")
+	proto.RegisterType((*StoreMultiStoreProof)(nil), "band.base.oracle.v1.StoreMultiStoreProof")
+	proto.RegisterType((*MultiStoreMerklePath)(nil), "band.base.oracle.v1.MultiStoreMerklePath")
 	proto.RegisterType((*MultiStoreProof)(nil), "band.base.oracle.v1.MultiStoreProof")
 	proto.RegisterType((*BlockHeaderMerkleParts)(nil), "band.base.oracle.v1.BlockHeaderMerkleParts")
 	proto.RegisterType((*CommonEncodedVotePart)(nil), "band.base.oracle.v1.CommonEncodedVotePart")
@@ -1326,106 +2118,135 @@ func init() {
 func init() { proto.RegisterFile("band/base/oracle/v1/proof.proto", fileDescriptor_9deb752797273b09) }
 
 var fileDescriptor_9deb752797273b09 = []byte{
-	// 1577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcb, 0x6f, 0x1b, 0xd5,
-	0x1a, 0xcf, 0xc4, 0x4e, 0xda, 0xfb, 0x39, 0x69, 0x92, 0x93, 0x36, 0x75, 0x73, 0xdb, 0x38, 0x99,
-	0xdb, 0x36, 0x69, 0xab, 0x6b, 0xdf, 0x3e, 0x36, 0x57, 0xf7, 0x6e, 0x92, 0xb6, 0x28, 0x11, 0x7d,
-	0xc9, 0x36, 0x41, 0x42, 0x48, 0xc3, 0xf1, 0xcc, 0x89, 0x3d, 0x74, 0xe6, 0x1c, 0x77, 0xce, 0xb1,
-	0x95, 0x16, 0xa1, 0x22, 0x10, 0x20, 0xb1, 0x01, 0xc1, 0x9a, 0x25, 0x4b, 0xd4, 0x1d, 0x62, 0xc1,
-	0x1f, 0xd0, 0x65, 0x25, 0x16, 0xb0, 0x0a, 0x55, 0xca, 0x8a, 0x3f, 0x81, 0x15, 0x3a, 0x8f, 0xb1,
-	0xc7, 0xf6, 0xa4, 0x09, 0x75, 0xdb, 0xdd, 0xcc, 0x99, 0xef, 0xfc, 0x7e, 0xbf, 0xef, 0x31, 0xdf,
-	0x79, 0x40, 0xa1, 0x86, 0xa9, 0x57, 0xaa, 0x61, 0x4e, 0x4a, 0x2c, 0xc2, 0x6e, 0x40, 0x4a, 0xed,
-	0x8b, 0xa5, 0x66, 0xc4, 0xd8, 0x56, 0xb1, 0x19, 0x31, 0xc1, 0xd0, 0xac, 0x34, 0x28, 0x4a, 0x83,
-	0xa2, 0x36, 0x28, 0xb6, 0x2f, 0xce, 0x9f, 0xac, 0x33, 0x56, 0x0f, 0x48, 0x09, 0x37, 0xfd, 0x12,
-	0xa6, 0x94, 0x09, 0x2c, 0x7c, 0x46, 0xb9, 0x9e, 0x32, 0x7f, 0xb4, 0xce, 0xea, 0x4c, 0x3d, 0x96,
-	0xe4, 0x93, 0x19, 0xfd, 0xa7, 0x62, 0xea, 0x92, 0x18, 0x34, 0xf5, 0xd1, 0xbe, 0x0e, 0x13, 0x77,
-	0x24, 0x69, 0x99, 0xdc, 0x6b, 0x11, 0x2e, 0xd0, 0x29, 0x80, 0x48, 0x3f, 0x3a, 0xbe, 0x97, 0xb7,
-	0x16, 0xad, 0x95, 0x6c, 0xf9, 0x1f, 0x66, 0x64, 0xc3, 0x43, 0x73, 0x30, 0xde, 0x20, 0x7e, 0xbd,
-	0x21, 0xf2, 0xa3, 0x8b, 0xd6, 0x4a, 0xa6, 0x6c, 0xde, 0xec, 0x10, 0x26, 0x0d, 0x0c, 0x6f, 0x32,
-	0xca, 0x49, 0xc2, 0xd0, 0x4a, 0x1a, 0xa2, 0x6b, 0x30, 0x1e, 0x11, 0xde, 0x0a, 0x34, 0x40, 0xee,
-	0xd2, 0xd9, 0x62, 0x8a, 0x9b, 0xc5, 0x8a, 0x4f, 0xeb, 0x01, 0x89, 0x11, 0x5b, 0x81, 0x58, 0xcb,
-	0x3e, 0xde, 0x29, 0x8c, 0x94, 0xcd, 0x5c, 0xfb, 0x0a, 0xcc, 0xdc, 0x6c, 0x05, 0xc2, 0xef, 0x91,
-	0x5e, 0x80, 0x5c, 0x57, 0x3a, 0xcf, 0x5b, 0x8b, 0x99, 0x95, 0x6c, 0x19, 0x3a, 0xda, 0xb9, 0x7d,
-	0x0f, 0x50, 0x72, 0xd6, 0x3e, 0x4a, 0xaf, 0xf6, 0x29, 0x3d, 0x93, 0xaa, 0xb4, 0x07, 0x70, 0x50,
-	0xe8, 0x3c, 0xe4, 0x8d, 0xbc, 0xab, 0xac, 0x45, 0x45, 0x52, 0xaf, 0xbd, 0x0d, 0x27, 0x52, 0xbe,
-	0xbd, 0x14, 0x55, 0x3d, 0x80, 0x83, 0xaa, 0x1e, 0x59, 0x30, 0x33, 0x10, 0x62, 0xf4, 0x7f, 0x18,
-	0x53, 0xf5, 0xa7, 0x18, 0x73, 0x97, 0x16, 0xf7, 0xcb, 0x8c, 0x01, 0xd5, 0x93, 0xd0, 0xbb, 0x30,
-	0x45, 0xda, 0xa1, 0xa3, 0x5e, 0x9c, 0xda, 0x7d, 0x41, 0xb8, 0x52, 0x38, 0xb1, 0x76, 0xe5, 0xcf,
-	0x9d, 0xc2, 0x7f, 0xea, 0xbe, 0x68, 0xb4, 0x6a, 0x45, 0x97, 0x85, 0x25, 0x97, 0x85, 0x44, 0xd4,
-	0xb6, 0x44, 0xf7, 0x21, 0xf0, 0x6b, 0xbc, 0xa4, 0xa6, 0x14, 0xd7, 0xc9, 0xf6, 0x9a, 0x7c, 0x28,
-	0x4f, 0x92, 0x76, 0xa8, 0x59, 0xe4, 0xab, 0xfd, 0xbd, 0x05, 0xd3, 0xfd, 0xa1, 0x46, 0xff, 0xeb,
-	0x15, 0x5c, 0xd8, 0x27, 0x41, 0xaf, 0x5b, 0x6f, 0x7f, 0x12, 0x0e, 0xa6, 0xb7, 0x3b, 0xeb, 0x75,
-	0xea, 0x7d, 0x6a, 0x41, 0x2e, 0x91, 0x5a, 0xb4, 0x04, 0x13, 0xb5, 0x80, 0xb9, 0x77, 0x9d, 0x44,
-	0x11, 0x66, 0xcb, 0x39, 0x35, 0xb6, 0xae, 0x2b, 0x71, 0x13, 0x66, 0xb4, 0x6a, 0xc7, 0xc3, 0x02,
-	0x6b, 0x61, 0xa6, 0x28, 0x4f, 0xa7, 0x7a, 0x76, 0x5b, 0x3d, 0x5d, 0xc3, 0x02, 0x27, 0xdd, 0x9b,
-	0x62, 0xbd, 0xc3, 0x12, 0x57, 0x53, 0x47, 0x24, 0xc0, 0xf7, 0x0d, 0x6e, 0xe6, 0x39, 0xb8, 0x6b,
-	0xd2, 0xba, 0x2c, 0x8d, 0x7b, 0x70, 0x6b, 0xbd, 0xc3, 0xf6, 0x1f, 0x16, 0x40, 0xb7, 0x18, 0x0e,
-	0xe2, 0x21, 0x86, 0xe3, 0x49, 0x0f, 0x43, 0x39, 0xb9, 0xe3, 0x67, 0xe6, 0x6f, 0xfa, 0x79, 0xb4,
-	0xeb, 0x67, 0x42, 0xc5, 0xab, 0x72, 0xf6, 0x17, 0x0b, 0xa0, 0x5b, 0x49, 0x07, 0x71, 0xf6, 0x16,
-	0xe4, 0x5c, 0x39, 0xa1, 0x27, 0x91, 0xcb, 0xa9, 0x1a, 0x4c, 0xd7, 0xe2, 0x03, 0xa5, 0x0a, 0x6e,
-	0x97, 0xf2, 0x55, 0x79, 0xf6, 0xc8, 0x82, 0xa9, 0xbe, 0x08, 0xa3, 0x2b, 0x9d, 0xa6, 0xa8, 0xff,
-	0xac, 0x39, 0x4d, 0x90, 0x54, 0x3c, 0xd8, 0x05, 0x51, 0x1e, 0x0e, 0xb5, 0x49, 0xc4, 0x7d, 0x46,
-	0x95, 0xb7, 0xd9, 0x72, 0xfc, 0x8a, 0x6e, 0xc0, 0x44, 0x48, 0xa2, 0xbb, 0x01, 0x71, 0x9a, 0x58,
-	0x34, 0x78, 0x3e, 0xa3, 0xb2, 0xfd, 0xaf, 0x54, 0xd9, 0x1b, 0xab, 0x9b, 0x37, 0x6e, 0x2a, 0xe3,
-	0x3b, 0x58, 0x34, 0x0c, 0x45, 0x2e, 0xec, 0x8c, 0x70, 0xfb, 0xd3, 0x51, 0x38, 0xd2, 0x6b, 0x85,
-	0x96, 0x61, 0xda, 0xe7, 0xba, 0xaa, 0x18, 0x75, 0xa2, 0x4e, 0x4e, 0x0e, 0x97, 0x27, 0x7d, 0x2e,
-	0xfd, 0xba, 0x4d, 0xcb, 0x2a, 0x2b, 0x67, 0xe0, 0x08, 0x6f, 0xd5, 0x44, 0x44, 0x88, 0x93, 0x58,
-	0x77, 0x27, 0xcb, 0x93, 0x66, 0xd4, 0x24, 0x6f, 0x09, 0x26, 0x62, 0x33, 0xee, 0x3f, 0x20, 0x2a,
-	0xce, 0xd9, 0x72, 0xce, 0x8c, 0x55, 0xfc, 0x07, 0x04, 0x2d, 0xc3, 0x54, 0x6c, 0x12, 0x7b, 0x9d,
-	0x55, 0x56, 0x31, 0xc1, 0xa6, 0x71, 0xfe, 0x6d, 0x98, 0xe0, 0x7e, 0x2d, 0xf0, 0x69, 0xdd, 0x69,
-	0x60, 0xde, 0xc8, 0x8f, 0x0d, 0xd1, 0x65, 0x72, 0x06, 0x69, 0x1d, 0xf3, 0x86, 0xfd, 0x45, 0x06,
-	0xa6, 0xfa, 0x92, 0x2c, 0xab, 0x44, 0xff, 0x56, 0x5c, 0xb0, 0x88, 0x38, 0xc9, 0xf6, 0x78, 0x7a,
-	0xef, 0x76, 0x5e, 0x91, 0xc6, 0x3d, 0x55, 0x12, 0xf6, 0x0e, 0xa3, 0x00, 0x4e, 0xc4, 0x05, 0x8f,
-	0x3d, 0x12, 0x39, 0x9d, 0x74, 0x46, 0x82, 0x9b, 0xda, 0xbe, 0xb0, 0x77, 0x15, 0xae, 0xab, 0x49,
-	0x71, 0xbe, 0x22, 0xc1, 0x0d, 0xcd, 0x5c, 0x2d, 0xf5, 0x2b, 0xf2, 0x21, 0xef, 0xb2, 0x30, 0x64,
-	0xd4, 0x21, 0xd4, 0x65, 0x1e, 0xf1, 0x9c, 0x36, 0x13, 0x9a, 0xcd, 0x94, 0xfc, 0xf9, 0x3d, 0x7a,
-	0xbd, 0x9c, 0x74, 0x5d, 0xcf, 0xd9, 0x64, 0x42, 0xc1, 0x19, 0xae, 0x63, 0x6e, 0xda, 0x47, 0xf4,
-	0x06, 0x00, 0xf7, 0xeb, 0x14, 0x8b, 0x56, 0x44, 0x78, 0x3e, 0xab, 0x0a, 0x33, 0x7d, 0xa5, 0xae,
-	0xde, 0xac, 0xc4, 0x86, 0xf1, 0xef, 0xd9, 0x9d, 0x69, 0xff, 0x38, 0x0e, 0x53, 0x7d, 0xb1, 0x44,
-	0x1f, 0x59, 0x30, 0x67, 0x1a, 0x9e, 0x8f, 0xdb, 0x81, 0xc3, 0x05, 0x16, 0x44, 0x17, 0x81, 0xa5,
-	0x8a, 0xe0, 0xcd, 0xdd, 0x9d, 0xc2, 0xac, 0xfe, 0xf9, 0x64, 0x41, 0x57, 0xe4, 0x77, 0x99, 0xda,
-	0x17, 0xaa, 0x8d, 0x59, 0x4d, 0xb5, 0x81, 0xdb, 0x41, 0x07, 0x08, 0xf9, 0x30, 0x17, 0xfa, 0x54,
-	0x98, 0x72, 0x30, 0x59, 0x53, 0x0a, 0x86, 0x59, 0xec, 0x66, 0x25, 0xa6, 0x72, 0x54, 0x67, 0x4d,
-	0x51, 0x7d, 0x62, 0xc1, 0xe9, 0x26, 0x8e, 0x70, 0xc8, 0x1d, 0xc1, 0x9c, 0x88, 0x70, 0x81, 0xef,
-	0x12, 0x4d, 0xcc, 0x7b, 0x98, 0x33, 0x43, 0x30, 0x17, 0x34, 0x43, 0x95, 0x95, 0x35, 0xbe, 0x12,
-	0xc1, 0x13, 0x2a, 0xbe, 0xb4, 0xe0, 0x7c, 0xc4, 0x02, 0xf9, 0x93, 0x70, 0x42, 0x3c, 0x29, 0x45,
-	0x44, 0x98, 0xf2, 0x2d, 0x12, 0xa5, 0x69, 0xc9, 0x0e, 0xa1, 0xe5, 0x4c, 0x82, 0xa7, 0xca, 0xaa,
-	0x86, 0x65, 0x40, 0xd1, 0xb7, 0x16, 0x2c, 0x09, 0xae, 0x82, 0xd2, 0x6a, 0xd6, 0x23, 0xec, 0xa5,
-	0x06, 0x45, 0x77, 0x85, 0xea, 0xee, 0x4e, 0xe1, 0x64, 0xb5, 0x52, 0xa9, 0xb2, 0xb7, 0xb4, 0x69,
-	0x3f, 0xda, 0x0b, 0x09, 0x3d, 0x29, 0x38, 0xdf, 0x13, 0x11, 0x3d, 0x04, 0x1b, 0xb7, 0x44, 0x43,
-	0xea, 0xf3, 0x5d, 0xdc, 0x60, 0x5c, 0xa4, 0xe9, 0x1b, 0x1f, 0x22, 0x50, 0xa7, 0x24, 0x7e, 0x95,
-	0x6d, 0x68, 0xf4, 0x7e, 0x01, 0xf6, 0x0f, 0x63, 0x30, 0x97, 0xde, 0x26, 0x10, 0x85, 0x13, 0xa6,
-	0xb9, 0x3a, 0x98, 0x7a, 0x8e, 0xdb, 0xc0, 0x3e, 0x75, 0x7c, 0x2f, 0xf9, 0x0f, 0xbd, 0x98, 0xa4,
-	0x63, 0x06, 0x76, 0x95, 0x7a, 0x57, 0x25, 0xe8, 0x86, 0xa7, 0x62, 0xd1, 0x7b, 0x1c, 0xcb, 0x76,
-	0x4e, 0x09, 0x05, 0xc8, 0x09, 0x3f, 0x24, 0x0e, 0x27, 0x2e, 0xa3, 0x9e, 0x59, 0x0e, 0x40, 0x0e,
-	0x55, 0xd4, 0x08, 0x5a, 0x81, 0x69, 0x65, 0x40, 0x31, 0x65, 0xb1, 0x55, 0x56, 0xad, 0x2c, 0x47,
-	0xe4, 0xf8, 0x2d, 0x4c, 0x99, 0xb1, 0x7c, 0x1f, 0x8e, 0x07, 0x98, 0x0b, 0x47, 0xb7, 0x53, 0xdf,
-	0x53, 0x8e, 0x31, 0xd1, 0x20, 0xd1, 0x50, 0x2b, 0xc3, 0xac, 0x04, 0x55, 0x51, 0xdc, 0xf0, 0x56,
-	0xa9, 0x77, 0x5b, 0x02, 0xa2, 0xcf, 0x2d, 0x38, 0x4b, 0xc9, 0xb6, 0x70, 0xda, 0x38, 0xf0, 0x3d,
-	0x2c, 0x58, 0xa4, 0x22, 0xa7, 0x63, 0x29, 0x8f, 0x45, 0x94, 0xb7, 0xf8, 0xf0, 0xf9, 0x5d, 0x92,
-	0x1c, 0x9b, 0x31, 0x85, 0x0c, 0xa4, 0x0c, 0x6b, 0x8c, 0xaf, 0x02, 0xfb, 0x1e, 0xcc, 0x28, 0xaf,
-	0xf5, 0x56, 0xc1, 0x70, 0x1e, 0x1a, 0x82, 0x73, 0x4a, 0xc2, 0xe9, 0x6d, 0x88, 0x66, 0xb8, 0x07,
-	0xf3, 0xa4, 0xed, 0x7b, 0x84, 0xba, 0x44, 0xf9, 0xd7, 0x8c, 0x58, 0x93, 0x71, 0xa2, 0x3d, 0xce,
-	0x1f, 0x1e, 0x82, 0xea, 0x78, 0x8c, 0xbb, 0x4a, 0xbd, 0x3b, 0x06, 0x55, 0x15, 0xee, 0x6f, 0x16,
-	0x1c, 0x4b, 0x5d, 0x72, 0x50, 0x0d, 0x90, 0x5c, 0x1b, 0x88, 0x17, 0xef, 0xe5, 0xc9, 0x96, 0xbf,
-	0x3d, 0x54, 0xc1, 0x4e, 0x6b, 0x3c, 0xbd, 0x47, 0x93, 0x68, 0xfd, 0x1c, 0xbc, 0xb5, 0x25, 0x39,
-	0x46, 0x5f, 0x0e, 0x47, 0x45, 0xa1, 0xd9, 0x9f, 0x8d, 0x42, 0x2e, 0xb1, 0xee, 0xa1, 0x35, 0xb0,
-	0xa2, 0xa1, 0xdc, 0xb0, 0x22, 0x89, 0x31, 0xdc, 0x51, 0xcb, 0xe2, 0x68, 0x02, 0xac, 0xb6, 0xfa,
-	0x0b, 0x27, 0xcb, 0x56, 0x1b, 0x61, 0x98, 0x89, 0xf7, 0x09, 0xf2, 0x67, 0xe3, 0x02, 0x87, 0xcd,
-	0xa1, 0x3a, 0xfb, 0xb4, 0x81, 0xab, 0xc6, 0x68, 0xf6, 0xd7, 0x16, 0xa0, 0xc1, 0x6d, 0x3a, 0x3a,
-	0x0a, 0x63, 0x6a, 0x8b, 0x6e, 0x0e, 0x00, 0xfa, 0xe5, 0x75, 0x6d, 0x84, 0x2f, 0xfd, 0x94, 0x81,
-	0x43, 0x15, 0x12, 0xb5, 0x7d, 0x97, 0xa0, 0x87, 0x30, 0x66, 0x8e, 0x26, 0xa9, 0x60, 0xc9, 0x8b,
-	0x92, 0x79, 0xfb, 0x79, 0x26, 0xfa, 0xbe, 0xc4, 0x2e, 0x7d, 0xfc, 0xf3, 0xef, 0xdf, 0x8c, 0x9e,
-	0x43, 0xcb, 0x25, 0x69, 0xab, 0x1a, 0x6f, 0xf7, 0xae, 0x4b, 0xdf, 0xab, 0x95, 0x3e, 0xe8, 0x5e,
-	0x0f, 0x7d, 0x28, 0x7b, 0x4d, 0xf2, 0x38, 0x78, 0x76, 0xdf, 0xdb, 0x1d, 0xad, 0x65, 0x79, 0xff,
-	0x5b, 0x20, 0x2d, 0x68, 0x45, 0x09, 0xb2, 0xd1, 0x62, 0xaa, 0xa0, 0xc4, 0x59, 0x12, 0x7d, 0x67,
-	0xc1, 0xcc, 0xc0, 0x45, 0x10, 0xfa, 0xf7, 0xf3, 0x8e, 0x5e, 0x03, 0x97, 0x49, 0xf3, 0xc5, 0x83,
-	0x9a, 0x1b, 0x79, 0x17, 0x95, 0xbc, 0x0b, 0xe8, 0x5c, 0xaa, 0x3c, 0x13, 0x28, 0xee, 0x24, 0x8e,
-	0x84, 0x6b, 0x95, 0xc7, 0xbb, 0x0b, 0xd6, 0x93, 0xdd, 0x05, 0xeb, 0xe9, 0xee, 0x82, 0xf5, 0xd5,
-	0xb3, 0x85, 0x91, 0x27, 0xcf, 0x16, 0x46, 0x7e, 0x7d, 0xb6, 0x30, 0xf2, 0xce, 0x7f, 0x13, 0x15,
-	0x2b, 0xe1, 0xd4, 0xd5, 0xa2, 0xcb, 0x82, 0x92, 0xc1, 0xbd, 0x5c, 0x72, 0x03, 0x9f, 0x50, 0x51,
-	0xaa, 0x47, 0x4d, 0xb7, 0x27, 0x27, 0xb5, 0x71, 0x65, 0x7b, 0xf9, 0xaf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x55, 0x8b, 0xf2, 0x48, 0x0f, 0x15, 0x00, 0x00,
+	// 2034 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcf, 0x6f, 0x23, 0x49,
+	0xf5, 0x9f, 0x8e, 0x9d, 0x4c, 0xf2, 0x9c, 0xc4, 0x49, 0x25, 0x93, 0x71, 0x3c, 0xb3, 0x71, 0xe2,
+	0xef, 0xce, 0x24, 0xb3, 0xab, 0xaf, 0x4d, 0x66, 0x07, 0x89, 0x05, 0x0e, 0x3b, 0x99, 0x1d, 0x34,
+	0x61, 0x77, 0x76, 0xa2, 0xb6, 0x09, 0x12, 0x8b, 0x68, 0xca, 0xdd, 0x15, 0xbb, 0x49, 0xbb, 0xcb,
+	0xd3, 0x55, 0xb6, 0x26, 0xbb, 0x1a, 0x2d, 0x02, 0xb1, 0x2b, 0x71, 0xe1, 0xe7, 0x81, 0x0b, 0x47,
+	0x8e, 0x68, 0x25, 0x84, 0x10, 0x7f, 0xc2, 0x1e, 0x57, 0xe2, 0x00, 0xa7, 0xb0, 0xca, 0xc0, 0x85,
+	0x1b, 0x17, 0x0e, 0x88, 0x03, 0xaa, 0x1f, 0x6d, 0x77, 0xdb, 0xed, 0xc4, 0x8c, 0x33, 0xe1, 0xc2,
+	0xad, 0xbb, 0xfa, 0xd5, 0xe7, 0x7d, 0xde, 0x7b, 0xf5, 0x5e, 0x57, 0xd5, 0x83, 0x42, 0x0d, 0xfb,
+	0x4e, 0xb9, 0x86, 0x19, 0x29, 0xd3, 0x00, 0xdb, 0x1e, 0x29, 0x77, 0xb6, 0xcb, 0xad, 0x80, 0xd2,
+	0x83, 0x52, 0x2b, 0xa0, 0x9c, 0xa2, 0x25, 0x21, 0x50, 0x12, 0x02, 0x25, 0x25, 0x50, 0xea, 0x6c,
+	0xe7, 0xaf, 0xd7, 0x29, 0xad, 0x7b, 0xa4, 0x8c, 0x5b, 0x6e, 0x19, 0xfb, 0x3e, 0xe5, 0x98, 0xbb,
+	0xd4, 0x67, 0x6a, 0x4a, 0x7e, 0xb9, 0x4e, 0xeb, 0x54, 0x3e, 0x96, 0xc5, 0x93, 0x1e, 0x5d, 0x93,
+	0x9a, 0x0e, 0x08, 0x71, 0x58, 0xb9, 0xb3, 0x5d, 0x23, 0x1c, 0x6f, 0xab, 0x37, 0xfd, 0xfd, 0x9a,
+	0xfc, 0xde, 0x23, 0xa1, 0xb5, 0xa9, 0x8f, 0xeb, 0xf2, 0x23, 0x6f, 0xfb, 0x3e, 0xf1, 0xba, 0xb3,
+	0xd5, 0xab, 0x92, 0x28, 0xde, 0x87, 0xd9, 0x3d, 0x41, 0xdb, 0x24, 0x8f, 0xdb, 0x84, 0x71, 0xf4,
+	0x12, 0x40, 0xa0, 0x1e, 0x2d, 0xd7, 0xc9, 0x19, 0xeb, 0xc6, 0x56, 0xda, 0x9c, 0xd1, 0x23, 0xbb,
+	0x0e, 0x5a, 0x81, 0xa9, 0x06, 0x71, 0xeb, 0x0d, 0x9e, 0x9b, 0x58, 0x37, 0xb6, 0x52, 0xa6, 0x7e,
+	0x2b, 0x36, 0x61, 0x4e, 0xc3, 0xb0, 0x16, 0xf5, 0x19, 0x89, 0x08, 0x1a, 0x51, 0x41, 0xf4, 0x26,
+	0x4c, 0x05, 0x84, 0xb5, 0x3d, 0x05, 0x90, 0xb9, 0x7d, 0xb3, 0x94, 0xe0, 0xa8, 0x52, 0xc5, 0xf5,
+	0xeb, 0x1e, 0x09, 0x11, 0xdb, 0x1e, 0xdf, 0x49, 0x7f, 0x72, 0x5c, 0xb8, 0x64, 0xea, 0xb9, 0xc5,
+	0x3b, 0xb0, 0xf8, 0xb0, 0xed, 0x71, 0x37, 0x46, 0xbd, 0x00, 0x99, 0x1e, 0x75, 0x96, 0x33, 0xd6,
+	0x53, 0x5b, 0x69, 0x13, 0xba, 0xdc, 0x59, 0xf1, 0x31, 0xa0, 0xe8, 0xac, 0x33, 0x98, 0xde, 0xeb,
+	0x63, 0x7a, 0x23, 0x91, 0x69, 0x0c, 0x70, 0x90, 0x68, 0x1e, 0x72, 0x9a, 0xde, 0x3d, 0xda, 0xf6,
+	0x79, 0x94, 0x6f, 0xf1, 0x09, 0xac, 0x26, 0x7c, 0x3b, 0x17, 0x56, 0x31, 0xc0, 0x41, 0x56, 0x5f,
+	0x85, 0xc5, 0xbd, 0xc0, 0xb5, 0x49, 0x7f, 0xe4, 0x99, 0x5b, 0xf7, 0xb1, 0xd7, 0xf5, 0xde, 0x8c,
+	0x39, 0xa3, 0x46, 0x76, 0x1d, 0x36, 0x34, 0xf2, 0x8f, 0x01, 0x45, 0xb1, 0xce, 0x85, 0x7e, 0x0c,
+	0x70, 0x90, 0xfe, 0x21, 0xe4, 0xaa, 0x72, 0x0d, 0xef, 0x61, 0xfb, 0x90, 0xc4, 0x9c, 0x8a, 0xae,
+	0xc1, 0x8c, 0x5a, 0xdf, 0xbd, 0xe5, 0x3b, 0xad, 0x06, 0x76, 0x1d, 0x94, 0x87, 0x69, 0x26, 0xe4,
+	0x7c, 0x9b, 0x48, 0xfd, 0x69, 0xb3, 0xfb, 0x1e, 0x61, 0x9c, 0x8a, 0xd9, 0x77, 0x04, 0xab, 0x09,
+	0xca, 0xce, 0x65, 0x95, 0xc7, 0x11, 0x07, 0xed, 0xfc, 0xd8, 0x80, 0xc5, 0x81, 0x4c, 0x40, 0x5f,
+	0x86, 0x49, 0x59, 0x68, 0xa4, 0xca, 0xcc, 0xed, 0xf5, 0xb3, 0x12, 0x48, 0x83, 0xaa, 0x49, 0xe8,
+	0x9b, 0x90, 0x25, 0x9d, 0xa6, 0x25, 0x5f, 0xac, 0xda, 0x11, 0x27, 0x4c, 0x52, 0x9c, 0xdd, 0xb9,
+	0xf3, 0xcf, 0xe3, 0xc2, 0xe7, 0xea, 0x2e, 0x6f, 0xb4, 0x6b, 0x25, 0x9b, 0x36, 0xcb, 0x36, 0x6d,
+	0x12, 0x5e, 0x3b, 0xe0, 0xbd, 0x07, 0xcf, 0xad, 0xb1, 0xb2, 0x9c, 0x52, 0x7a, 0x40, 0x9e, 0xec,
+	0x88, 0x07, 0x73, 0x8e, 0x74, 0x9a, 0x4a, 0x8b, 0x78, 0x2d, 0xfe, 0xda, 0x80, 0x85, 0xfe, 0x8c,
+	0x40, 0x5f, 0x8a, 0x13, 0x2e, 0x9c, 0x91, 0x47, 0x17, 0xcd, 0xb7, 0x3f, 0x57, 0x46, 0xe3, 0xdb,
+	0x9b, 0x75, 0x91, 0x7c, 0x7f, 0x63, 0xc0, 0x42, 0x7f, 0x72, 0xa0, 0x37, 0xe2, 0x7c, 0x5f, 0x1e,
+	0x9e, 0x52, 0xff, 0x1d, 0x27, 0x8b, 0x65, 0x3c, 0xb0, 0xd4, 0x47, 0x5b, 0xc6, 0x91, 0x69, 0x17,
+	0xc9, 0xf8, 0x33, 0x03, 0x32, 0x91, 0x0c, 0x42, 0x1b, 0x30, 0x5b, 0xf3, 0xa8, 0x7d, 0x68, 0x45,
+	0x92, 0x3d, 0x6d, 0x66, 0xe4, 0xd8, 0x03, 0x95, 0xf1, 0xfb, 0xb0, 0xa8, 0x68, 0x5b, 0x0e, 0xe6,
+	0x58, 0x11, 0xcb, 0x4d, 0x9c, 0x12, 0x90, 0x47, 0xf2, 0xe9, 0x4d, 0xcc, 0x71, 0xd4, 0xbc, 0x2c,
+	0x8d, 0x0f, 0x0b, 0x5c, 0xa5, 0x3a, 0x20, 0x1e, 0x3e, 0xd2, 0xb8, 0xa9, 0x53, 0x70, 0x77, 0x84,
+	0xb4, 0x29, 0x84, 0x63, 0xb8, 0xb5, 0xf8, 0x70, 0xf1, 0x6f, 0x06, 0x40, 0x6f, 0x39, 0x8c, 0x62,
+	0x21, 0x86, 0xab, 0x51, 0x0b, 0x9b, 0x62, 0x72, 0xd7, 0xce, 0xd4, 0x7f, 0x68, 0xe7, 0x72, 0xcf,
+	0xce, 0x08, 0x8b, 0x17, 0x65, 0xec, 0x1f, 0x0d, 0x80, 0x5e, 0xc2, 0x8e, 0x62, 0xec, 0x3b, 0x90,
+	0xb1, 0xc5, 0x84, 0x58, 0x20, 0x37, 0x13, 0x39, 0xe8, 0x3f, 0x0f, 0x1b, 0xa8, 0x08, 0x60, 0xf7,
+	0x54, 0xbe, 0x28, 0xcb, 0xfe, 0x6e, 0x40, 0xb6, 0x2f, 0xb5, 0x47, 0x31, 0xef, 0x5b, 0xb0, 0xd2,
+	0x12, 0xb3, 0x86, 0x85, 0xf2, 0xff, 0x86, 0xd7, 0x90, 0xfe, 0x48, 0x2e, 0xb5, 0xc2, 0xd1, 0x08,
+	0x85, 0x77, 0x87, 0x9b, 0x7b, 0x2b, 0xf9, 0x7f, 0xc5, 0x69, 0x40, 0x46, 0xb4, 0xf9, 0xaf, 0x06,
+	0x64, 0x22, 0x85, 0x61, 0xc4, 0xec, 0x6c, 0xc9, 0x19, 0xa3, 0x66, 0xa7, 0xc2, 0x1f, 0xc8, 0xce,
+	0x56, 0x7c, 0xf8, 0xc5, 0xda, 0xf9, 0xb1, 0x01, 0xd9, 0xbe, 0xec, 0x41, 0x77, 0xba, 0x1b, 0x0b,
+	0x55, 0x36, 0x57, 0x94, 0x96, 0xe8, 0x6a, 0x1c, 0xdc, 0x48, 0xa0, 0x1c, 0x5c, 0xee, 0x90, 0x80,
+	0xb9, 0xd4, 0xd7, 0xdb, 0x9e, 0xf0, 0x15, 0xbd, 0x0d, 0xb3, 0x4d, 0x12, 0x1c, 0x7a, 0xc4, 0x6a,
+	0x61, 0xde, 0x60, 0xb9, 0xd4, 0x29, 0xe1, 0xdf, 0xbd, 0xbb, 0xff, 0xf6, 0x43, 0x29, 0xbc, 0x87,
+	0x79, 0x43, 0xab, 0xc8, 0x34, 0xbb, 0x23, 0xb2, 0xd2, 0xcf, 0xc7, 0x17, 0x09, 0xfa, 0xbc, 0x28,
+	0xf3, 0xae, 0x4d, 0x34, 0xdf, 0x55, 0x85, 0xac, 0x0e, 0x30, 0xfa, 0x40, 0xa2, 0xd6, 0x55, 0xaf,
+	0xbe, 0xbb, 0x36, 0xb9, 0x30, 0xc6, 0x3f, 0x99, 0x80, 0x6c, 0x5f, 0xac, 0xd1, 0xeb, 0x30, 0xa5,
+	0xe2, 0xac, 0x39, 0x5f, 0x53, 0xd8, 0xfa, 0xd8, 0xd4, 0x25, 0x2d, 0x45, 0x42, 0x47, 0xab, 0x09,
+	0xe8, 0x5d, 0x98, 0x27, 0xbe, 0x4d, 0x1d, 0xe2, 0x58, 0x1a, 0x62, 0xbc, 0xbf, 0x92, 0xc2, 0x52,
+	0xaa, 0xa2, 0x3e, 0x49, 0x9d, 0xee, 0x93, 0xf4, 0x58, 0x3e, 0xf9, 0xc1, 0x04, 0xcc, 0xc7, 0xa5,
+	0xd0, 0x26, 0x2c, 0xb8, 0x4c, 0xe5, 0x0e, 0xf5, 0xad, 0xa0, 0x9b, 0x66, 0xd3, 0xe6, 0x9c, 0xcb,
+	0x84, 0xe7, 0x1e, 0xf9, 0xa6, 0x4c, 0xb4, 0x1b, 0x30, 0xcf, 0xda, 0x35, 0x1e, 0x10, 0x62, 0x45,
+	0x4e, 0x0b, 0x73, 0xe6, 0x9c, 0x1e, 0xd5, 0xf9, 0xb8, 0x01, 0xb3, 0xa1, 0x18, 0x73, 0xdf, 0x23,
+	0xda, 0x9e, 0x8c, 0x1e, 0xab, 0xb8, 0xef, 0x11, 0xb4, 0x09, 0xd9, 0x50, 0x24, 0xb4, 0x3a, 0x2d,
+	0xa5, 0x42, 0x05, 0xfb, 0xda, 0xf8, 0xaf, 0xc3, 0x2c, 0x73, 0x6b, 0x9e, 0xeb, 0xd7, 0xad, 0x06,
+	0x66, 0x8d, 0xdc, 0xe4, 0x18, 0x1e, 0xcf, 0x68, 0xa4, 0x07, 0x98, 0x35, 0x8a, 0x3f, 0x4c, 0x41,
+	0xb6, 0x2f, 0x55, 0x45, 0x21, 0x51, 0xd5, 0x92, 0x89, 0x44, 0xb6, 0xce, 0xde, 0x77, 0xc9, 0xa2,
+	0x28, 0xb3, 0x3e, 0x96, 0xeb, 0xcd, 0xf8, 0x30, 0xf2, 0x60, 0x35, 0xac, 0x61, 0xd8, 0x21, 0x81,
+	0xd5, 0x0d, 0x67, 0xc0, 0x99, 0x2e, 0x54, 0xaf, 0x0e, 0xff, 0x4f, 0x3c, 0x90, 0x93, 0xc2, 0x78,
+	0x05, 0x9c, 0x69, 0x35, 0x2b, 0xb5, 0xc4, 0xaf, 0xc8, 0x85, 0x9c, 0x4d, 0x9b, 0x4d, 0xea, 0x5b,
+	0xe1, 0x6a, 0xed, 0x50, 0xae, 0xb4, 0xe9, 0xea, 0xf5, 0xca, 0x90, 0x4d, 0xaf, 0x98, 0x74, 0x5f,
+	0xcd, 0xd9, 0xa7, 0x5c, 0xc2, 0x69, 0x5d, 0x57, 0xec, 0xa4, 0x8f, 0xe8, 0x2b, 0xfa, 0x54, 0xc9,
+	0xdb, 0x01, 0x09, 0x17, 0x66, 0xf2, 0x5e, 0xaf, 0xfa, 0xb0, 0x12, 0x0a, 0x86, 0x3f, 0xd0, 0xde,
+	0xcc, 0xe2, 0xcf, 0x53, 0xb0, 0x9c, 0x54, 0x3c, 0x45, 0x09, 0x1e, 0x16, 0x91, 0x53, 0x4a, 0xf0,
+	0xff, 0xc2, 0x72, 0x3e, 0x61, 0xf9, 0x87, 0x01, 0xcb, 0x49, 0x0e, 0x15, 0xe7, 0x70, 0x15, 0x90,
+	0x43, 0x72, 0x24, 0xc3, 0x31, 0x63, 0x4e, 0xcb, 0x81, 0xb7, 0xc8, 0x11, 0x6a, 0x42, 0xd6, 0xc5,
+	0x1d, 0xcf, 0x62, 0x1c, 0x73, 0xa2, 0xb2, 0x56, 0xd5, 0xc9, 0xfb, 0x27, 0xc7, 0x85, 0x39, 0x51,
+	0x7b, 0x2a, 0xe2, 0x8b, 0xc8, 0xc2, 0xe7, 0x2b, 0x9c, 0x02, 0xbd, 0x0b, 0x81, 0xcc, 0xc4, 0x5f,
+	0xc6, 0xad, 0x33, 0xf2, 0xf5, 0xf4, 0x22, 0xf9, 0x0b, 0x03, 0x96, 0x93, 0x64, 0x47, 0x2f, 0x95,
+	0xfd, 0x75, 0x6b, 0xe2, 0xbc, 0xea, 0xd6, 0xef, 0xa7, 0x20, 0xdb, 0x1f, 0x8e, 0xef, 0x1a, 0xb0,
+	0xa2, 0x77, 0xef, 0xfd, 0x9e, 0x37, 0xa4, 0xde, 0xb7, 0x4e, 0x8e, 0x0b, 0x4b, 0x6a, 0xb7, 0x31,
+	0xbe, 0xff, 0x97, 0x94, 0xaa, 0xdd, 0x58, 0x14, 0x5c, 0x58, 0x69, 0xba, 0x3e, 0xd7, 0x79, 0xaa,
+	0x03, 0x32, 0xb6, 0xe5, 0x4b, 0x02, 0x33, 0x12, 0x03, 0xa9, 0xea, 0xfb, 0x06, 0xbc, 0xdc, 0xc2,
+	0x01, 0x6e, 0x32, 0x8b, 0x53, 0x2b, 0x20, 0x8c, 0xe3, 0x43, 0xa2, 0x14, 0xb3, 0x98, 0xe6, 0xd4,
+	0x18, 0x9a, 0x0b, 0x4a, 0x43, 0x95, 0x9a, 0x0a, 0x5f, 0x92, 0x60, 0x11, 0x16, 0x3f, 0x32, 0xe0,
+	0x95, 0x80, 0x7a, 0x22, 0x2e, 0x8c, 0x10, 0x47, 0x50, 0xe1, 0x01, 0xf6, 0xd9, 0x01, 0x09, 0x92,
+	0xb8, 0xa4, 0xc7, 0xe0, 0x72, 0x23, 0xa2, 0xa7, 0x4a, 0xab, 0x5a, 0xcb, 0x00, 0xa3, 0x5f, 0x1a,
+	0xb0, 0xc1, 0x99, 0x74, 0x4a, 0xbb, 0x55, 0x0f, 0xb0, 0x93, 0xe8, 0x14, 0xf5, 0x03, 0xad, 0x9e,
+	0x1c, 0x17, 0xae, 0x57, 0x2b, 0x95, 0x2a, 0xfd, 0x9a, 0x12, 0xed, 0x47, 0x7b, 0x2e, 0xa2, 0xd7,
+	0x39, 0x63, 0x43, 0x11, 0xd1, 0x07, 0x50, 0xc4, 0x6d, 0xde, 0x10, 0xfc, 0x5c, 0x1b, 0x37, 0x28,
+	0xe3, 0x49, 0xfc, 0xa6, 0xc6, 0x70, 0xd4, 0x4b, 0x02, 0xbf, 0x4a, 0x77, 0x15, 0x7a, 0x3f, 0x81,
+	0xe2, 0xef, 0x26, 0x61, 0x25, 0xb9, 0x74, 0x23, 0x1f, 0x56, 0xf5, 0x3e, 0xc4, 0xc2, 0xbe, 0x63,
+	0xd9, 0x0d, 0xec, 0xfa, 0x96, 0xeb, 0x44, 0x73, 0xe8, 0xf9, 0x28, 0x5d, 0xd1, 0xb0, 0x77, 0x7d,
+	0xe7, 0x9e, 0x00, 0xdd, 0x75, 0xa4, 0x2f, 0xe2, 0xf7, 0xad, 0xe9, 0xee, 0xd5, 0x62, 0x01, 0x32,
+	0xdc, 0x6d, 0x12, 0x8b, 0x11, 0x9b, 0xfa, 0x8e, 0xde, 0x39, 0x81, 0x18, 0xaa, 0xc8, 0x11, 0xb4,
+	0x05, 0x0b, 0x52, 0xc0, 0xc7, 0x3e, 0x0d, 0xa5, 0xd2, 0x72, 0x13, 0x36, 0x2f, 0xc6, 0xdf, 0xc1,
+	0x3e, 0xd5, 0x92, 0xdf, 0x81, 0xab, 0x1e, 0x66, 0xdc, 0x52, 0xbf, 0x38, 0xd7, 0x91, 0x86, 0x51,
+	0xde, 0x20, 0xc1, 0x58, 0x9b, 0xa8, 0x25, 0x01, 0x2a, 0xbd, 0xb8, 0xeb, 0xdc, 0xf5, 0x9d, 0x47,
+	0x02, 0x10, 0x7d, 0x64, 0xc0, 0x4d, 0x9f, 0x3c, 0xe1, 0x56, 0x07, 0x7b, 0xae, 0x83, 0x39, 0x0d,
+	0xa4, 0xe7, 0x94, 0x2f, 0xa9, 0xcf, 0x88, 0xcf, 0xda, 0x6c, 0xfc, 0xf8, 0x6e, 0x08, 0x1d, 0xfb,
+	0xa1, 0x0a, 0xe1, 0x48, 0xe1, 0xd6, 0x10, 0x5f, 0x3a, 0xf6, 0xdb, 0xb0, 0x28, 0xad, 0x56, 0x67,
+	0x23, 0xad, 0xf3, 0xf2, 0x18, 0x3a, 0xb3, 0x02, 0x4e, 0x9d, 0xbb, 0x94, 0x86, 0xc7, 0x90, 0x27,
+	0x1d, 0xd7, 0x21, 0xbe, 0x4d, 0xa4, 0x7d, 0xad, 0x80, 0xb6, 0x28, 0x23, 0xca, 0xe2, 0xdc, 0xf4,
+	0x18, 0xaa, 0xae, 0x86, 0xb8, 0x77, 0x7d, 0x67, 0x4f, 0xa3, 0xca, 0x85, 0xfb, 0x67, 0x03, 0xae,
+	0x24, 0x6e, 0x03, 0x50, 0x0d, 0x90, 0xf8, 0x5f, 0x13, 0x27, 0x3c, 0xfa, 0x92, 0x03, 0xf7, 0xc9,
+	0x58, 0x0b, 0x76, 0x41, 0xe1, 0xa9, 0x03, 0x93, 0x40, 0xeb, 0xd7, 0xc1, 0xda, 0x07, 0x42, 0xc7,
+	0xc4, 0xf9, 0xe8, 0xa8, 0x48, 0xb4, 0xe2, 0x87, 0x13, 0x90, 0x89, 0xec, 0x45, 0xd0, 0x0e, 0x18,
+	0xc1, 0x58, 0x66, 0x18, 0x81, 0xc0, 0x18, 0xef, 0xde, 0xd0, 0x60, 0x68, 0x16, 0x8c, 0x8e, 0xcc,
+	0xc2, 0x39, 0xd3, 0xe8, 0x20, 0x0c, 0x8b, 0xe1, 0xde, 0x4d, 0x24, 0x1b, 0xe3, 0xb8, 0xd9, 0x1a,
+	0xab, 0xb2, 0x2f, 0x68, 0xb8, 0x6a, 0x88, 0x56, 0xfc, 0xa9, 0x01, 0x68, 0xf0, 0xce, 0x09, 0x2d,
+	0xc3, 0xa4, 0xbc, 0x6f, 0xd2, 0xd7, 0x1f, 0xea, 0xe5, 0xa2, 0xce, 0xd1, 0xb7, 0xff, 0x35, 0x09,
+	0x97, 0x2b, 0x24, 0xe8, 0x88, 0xb3, 0xfb, 0x07, 0x30, 0xa9, 0x2f, 0x66, 0x86, 0xdc, 0x22, 0xf5,
+	0xda, 0x35, 0xf9, 0xe2, 0x69, 0x22, 0xaa, 0xc9, 0x52, 0x2c, 0x7f, 0xef, 0x0f, 0x7f, 0xf9, 0xd9,
+	0xc4, 0x2d, 0xb4, 0x59, 0x16, 0xb2, 0xb2, 0xf0, 0xf6, 0x1a, 0x9d, 0xaa, 0xe9, 0x5a, 0x7e, 0xbf,
+	0xd7, 0xf9, 0x7b, 0x8a, 0x3e, 0x8a, 0xdf, 0x6d, 0xde, 0x3c, 0xb3, 0x71, 0xa7, 0xb8, 0x6c, 0x9e,
+	0x29, 0xa7, 0x09, 0x6d, 0x49, 0x42, 0x45, 0xb4, 0x9e, 0x48, 0x28, 0x72, 0x9b, 0x86, 0x7e, 0x65,
+	0xc0, 0xe2, 0x40, 0x8f, 0x0f, 0xfd, 0xff, 0x69, 0xf7, 0x88, 0x03, 0x7d, 0xc2, 0x7c, 0x69, 0x54,
+	0x71, 0x4d, 0x6f, 0x5b, 0xd2, 0x7b, 0x15, 0xdd, 0x4a, 0xa4, 0xa7, 0x1d, 0xc5, 0xac, 0xc8, 0xfd,
+	0x26, 0xfa, 0xd0, 0x00, 0xe8, 0xf5, 0x15, 0x86, 0x78, 0x6c, 0xa0, 0x65, 0x98, 0xdf, 0x3c, 0x53,
+	0x4e, 0x53, 0xda, 0x94, 0x94, 0x36, 0x50, 0x21, 0x4e, 0x49, 0x75, 0xb5, 0xd5, 0x4d, 0xa4, 0x22,
+	0xf2, 0x5b, 0x03, 0x16, 0x07, 0xda, 0x6d, 0x43, 0x1c, 0x36, 0xac, 0x07, 0x98, 0x2f, 0x8d, 0x2a,
+	0xae, 0xd9, 0xbd, 0x21, 0xd9, 0x7d, 0x11, 0x7d, 0x21, 0xce, 0x4e, 0xb7, 0xcd, 0xf5, 0xc5, 0xa1,
+	0x5e, 0x67, 0xdd, 0xe6, 0xe2, 0xd3, 0xf2, 0xfb, 0x61, 0xef, 0xf0, 0xe9, 0x4e, 0xe5, 0x93, 0x93,
+	0x35, 0xe3, 0xd3, 0x93, 0x35, 0xe3, 0xb3, 0x93, 0x35, 0xe3, 0xc7, 0xcf, 0xd6, 0x2e, 0x7d, 0xfa,
+	0x6c, 0xed, 0xd2, 0x9f, 0x9e, 0xad, 0x5d, 0xfa, 0xc6, 0xeb, 0x91, 0x8c, 0x17, 0xe8, 0xb2, 0xeb,
+	0x6e, 0x53, 0xaf, 0xac, 0xd5, 0xbc, 0x56, 0xb6, 0x3d, 0x97, 0xf8, 0xbc, 0x5c, 0x0f, 0x5a, 0x76,
+	0x6c, 0x4d, 0xd7, 0xa6, 0xa4, 0xec, 0x6b, 0xff, 0x1e, 0x00, 0xc2, 0x65, 0x94, 0x97, 0x6c, 0x20,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1446,6 +2267,10 @@ type ServiceClient interface {
 	MultiProof(ctx context.Context, in *MultiProofRequest, opts ...grpc.CallOption) (*MultiProofResponse, error)
 	// RequestCountProof queries the count proof
 	RequestCountProof(ctx context.Context, in *RequestCountProofRequest, opts ...grpc.CallOption) (*RequestCountProofResponse, error)
+	// PriceProof queries the proof for the prices of given list of signal IDs in the feeds module
+	PriceProof(ctx context.Context, in *PriceProofRequest, opts ...grpc.CallOption) (*PriceProofResponse, error)
+	// TunnelPacketProof queries the proof for a packet of a tunnel
+	TunnelPacketProof(ctx context.Context, in *TunnelPacketProofRequest, opts ...grpc.CallOption) (*TunnelPacketProofResponse, error)
 }
 
 type serviceClient struct {
@@ -1483,6 +2308,24 @@ func (c *serviceClient) RequestCountProof(ctx context.Context, in *RequestCountP
 	return out, nil
 }
 
+func (c *serviceClient) PriceProof(ctx context.Context, in *PriceProofRequest, opts ...grpc.CallOption) (*PriceProofResponse, error) {
+	out := new(PriceProofResponse)
+	err := c.cc.Invoke(ctx, "/band.base.oracle.v1.Service/PriceProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) TunnelPacketProof(ctx context.Context, in *TunnelPacketProofRequest, opts ...grpc.CallOption) (*TunnelPacketProofResponse, error) {
+	out := new(TunnelPacketProofResponse)
+	err := c.cc.Invoke(ctx, "/band.base.oracle.v1.Service/TunnelPacketProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// Proof queries the proof for given request ID
@@ -1491,6 +2334,10 @@ type ServiceServer interface {
 	MultiProof(context.Context, *MultiProofRequest) (*MultiProofResponse, error)
 	// RequestCountProof queries the count proof
 	RequestCountProof(context.Context, *RequestCountProofRequest) (*RequestCountProofResponse, error)
+	// PriceProof queries the proof for the prices of given list of signal IDs in the feeds module
+	PriceProof(context.Context, *PriceProofRequest) (*PriceProofResponse, error)
+	// TunnelPacketProof queries the proof for a packet of a tunnel
+	TunnelPacketProof(context.Context, *TunnelPacketProofRequest) (*TunnelPacketProofResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -1506,6 +2353,12 @@ func (*UnimplementedServiceServer) MultiProof(ctx context.Context, req *MultiPro
 func (*UnimplementedServiceServer) RequestCountProof(ctx context.Context, req *RequestCountProofRequest) (*RequestCountProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestCountProof not implemented")
 }
+func (*UnimplementedServiceServer) PriceProof(ctx context.Context, req *PriceProofRequest) (*PriceProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceProof not implemented")
+}
+func (*UnimplementedServiceServer) TunnelPacketProof(ctx context.Context, req *TunnelPacketProofRequest) (*TunnelPacketProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TunnelPacketProof not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -1565,6 +2418,43 @@ func _Service_RequestCountProof_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_PriceProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).PriceProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.base.oracle.v1.Service/PriceProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).PriceProof(ctx, req.(*PriceProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_TunnelPacketProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TunnelPacketProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).TunnelPacketProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.base.oracle.v1.Service/TunnelPacketProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).TunnelPacketProof(ctx, req.(*TunnelPacketProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Service_serviceDesc = _Service_serviceDesc
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "band.base.oracle.v1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -1581,6 +2471,14 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RequestCountProof",
 			Handler:    _Service_RequestCountProof_Handler,
 		},
+		{
+			MethodName: "PriceProof",
+			Handler:    _Service_PriceProof_Handler,
+		},
+		{
+			MethodName: "TunnelPacketProof",
+			Handler:    _Service_TunnelPacketProof_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/base/oracle/v1/proof.proto",
@@ -1797,7 +2695,7 @@ func (m *RequestCountProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *SingleProofResult) Marshal() (dAtA []byte, err error) {
+func (m *PriceProofRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1807,37 +2705,34 @@ func (m *SingleProofResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SingleProofResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceProofRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SingleProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EvmProofBytes) > 0 {
-		i -= len(m.EvmProofBytes)
-		copy(dAtA[i:], m.EvmProofBytes)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	{
-		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.SignalIds) > 0 {
+		for iNdEx := len(m.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignalIds[iNdEx])
+			copy(dAtA[i:], m.SignalIds[iNdEx])
+			i = encodeVarintProof(dAtA, i, uint64(len(m.SignalIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiProofResult) Marshal() (dAtA []byte, err error) {
+func (m *PriceProofResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1847,25 +2742,18 @@ func (m *MultiProofResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiProofResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceProofResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EvmProofBytes) > 0 {
-		i -= len(m.EvmProofBytes)
-		copy(dAtA[i:], m.EvmProofBytes)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
-		i--
-		dAtA[i] = 0x12
-	}
 	{
-		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1873,11 +2761,16 @@ func (m *MultiProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CountProofResult) Marshal() (dAtA []byte, err error) {
+func (m *TunnelPacketProofRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1887,37 +2780,35 @@ func (m *CountProofResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CountProofResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *TunnelPacketProofRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CountProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TunnelPacketProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EvmProofBytes) > 0 {
-		i -= len(m.EvmProofBytes)
-		copy(dAtA[i:], m.EvmProofBytes)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	{
-		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
+	if m.Sequence != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TunnelId != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *SingleProof) Marshal() (dAtA []byte, err error) {
+func (m *TunnelPacketProofResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1927,28 +2818,18 @@ func (m *SingleProof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SingleProof) MarshalTo(dAtA []byte) (int, error) {
+func (m *TunnelPacketProofResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SingleProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TunnelPacketProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.OracleDataProof.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1957,15 +2838,15 @@ func (m *SingleProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	if m.BlockHeight != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiProof) Marshal() (dAtA []byte, err error) {
+func (m *SingleProofResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1975,18 +2856,25 @@ func (m *MultiProof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiProof) MarshalTo(dAtA []byte) (int, error) {
+func (m *SingleProofResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SingleProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmProofBytes) > 0 {
+		i -= len(m.EvmProofBytes)
+		copy(dAtA[i:], m.EvmProofBytes)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
-		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1994,30 +2882,11 @@ func (m *MultiProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.OracleDataMultiProof) > 0 {
-		for iNdEx := len(m.OracleDataMultiProof) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OracleDataMultiProof[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProof(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *CountProof) Marshal() (dAtA []byte, err error) {
+func (m *MultiProofResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2027,18 +2896,25 @@ func (m *CountProof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CountProof) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiProofResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmProofBytes) > 0 {
+		i -= len(m.EvmProofBytes)
+		copy(dAtA[i:], m.EvmProofBytes)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
-		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2046,9 +2922,39 @@ func (m *CountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CountProofResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CountProofResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CountProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmProofBytes) > 0 {
+		i -= len(m.EvmProofBytes)
+		copy(dAtA[i:], m.EvmProofBytes)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
-		size, err := m.CountProof.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2056,16 +2962,11 @@ func (m *CountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if m.BlockHeight != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *OracleDataProof) Marshal() (dAtA []byte, err error) {
+func (m *PriceProofResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2075,37 +2976,25 @@ func (m *OracleDataProof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OracleDataProof) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceProofResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OracleDataProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MerklePaths) > 0 {
-		for iNdEx := len(m.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MerklePaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProof(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.Version != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.Version))
+	if len(m.EvmProofBytes) > 0 {
+		i -= len(m.EvmProofBytes)
+		copy(dAtA[i:], m.EvmProofBytes)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	{
-		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2117,7 +3006,7 @@ func (m *OracleDataProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *IAVLMerklePath) Marshal() (dAtA []byte, err error) {
+func (m *PacketProofResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2127,52 +3016,37 @@ func (m *IAVLMerklePath) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IAVLMerklePath) MarshalTo(dAtA []byte) (int, error) {
+func (m *PacketProofResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IAVLMerklePath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PacketProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SiblingHash) > 0 {
-		i -= len(m.SiblingHash)
-		copy(dAtA[i:], m.SiblingHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.SiblingHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.SubtreeVersion != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.SubtreeVersion))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.SubtreeSize != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.SubtreeSize))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.SubtreeHeight != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.SubtreeHeight))
+	if len(m.EvmProofBytes) > 0 {
+		i -= len(m.EvmProofBytes)
+		copy(dAtA[i:], m.EvmProofBytes)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.IsDataOnRight {
-		i--
-		if m.IsDataOnRight {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x8
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockRelayProof) Marshal() (dAtA []byte, err error) {
+func (m *SingleProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2182,32 +3056,18 @@ func (m *BlockRelayProof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockRelayProof) MarshalTo(dAtA []byte) (int, error) {
+func (m *SingleProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockRelayProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SingleProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProof(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
 	{
-		size, err := m.CommonEncodedVotePart.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2217,7 +3077,7 @@ func (m *BlockRelayProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x1a
 	{
-		size, err := m.BlockHeaderMerkleParts.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.OracleDataProof.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2226,20 +3086,15 @@ func (m *BlockRelayProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	{
-		size, err := m.MultiStoreProof.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
+	if m.BlockHeight != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiStoreProof) Marshal() (dAtA []byte, err error) {
+func (m *MultiProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2249,62 +3104,49 @@ func (m *MultiStoreProof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiStoreProof) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiStoreProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AuthToIcahostStoresMerkleHash) > 0 {
-		i -= len(m.AuthToIcahostStoresMerkleHash)
-		copy(dAtA[i:], m.AuthToIcahostStoresMerkleHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.AuthToIcahostStoresMerkleHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.TSSToUpgradeStoresMerkleHash) > 0 {
-		i -= len(m.TSSToUpgradeStoresMerkleHash)
-		copy(dAtA[i:], m.TSSToUpgradeStoresMerkleHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.TSSToUpgradeStoresMerkleHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.RollingseedToTransferStoresMerkleHash) > 0 {
-		i -= len(m.RollingseedToTransferStoresMerkleHash)
-		copy(dAtA[i:], m.RollingseedToTransferStoresMerkleHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.RollingseedToTransferStoresMerkleHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ParamsToRestakeStoresMerkleHash) > 0 {
-		i -= len(m.ParamsToRestakeStoresMerkleHash)
-		copy(dAtA[i:], m.ParamsToRestakeStoresMerkleHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.ParamsToRestakeStoresMerkleHash)))
-		i--
-		dAtA[i] = 0x1a
+	{
+		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
-	if len(m.MintStoreMerkleHash) > 0 {
-		i -= len(m.MintStoreMerkleHash)
-		copy(dAtA[i:], m.MintStoreMerkleHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.MintStoreMerkleHash)))
-		i--
-		dAtA[i] = 0x12
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OracleDataMultiProof) > 0 {
+		for iNdEx := len(m.OracleDataMultiProof) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleDataMultiProof[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.OracleIAVLStateHash) > 0 {
-		i -= len(m.OracleIAVLStateHash)
-		copy(dAtA[i:], m.OracleIAVLStateHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.OracleIAVLStateHash)))
+	if m.BlockHeight != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockHeaderMerkleParts) Marshal() (dAtA []byte, err error) {
+func (m *CountProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2314,70 +3156,45 @@ func (m *BlockHeaderMerkleParts) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockHeaderMerkleParts) MarshalTo(dAtA []byte) (int, error) {
+func (m *CountProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockHeaderMerkleParts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EvidenceAndProposerHash) > 0 {
-		i -= len(m.EvidenceAndProposerHash)
-		copy(dAtA[i:], m.EvidenceAndProposerHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.EvidenceAndProposerHash)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.LastResultsHash) > 0 {
-		i -= len(m.LastResultsHash)
-		copy(dAtA[i:], m.LastResultsHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.LastResultsHash)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.NextValidatorHashAndConsensusHash) > 0 {
-		i -= len(m.NextValidatorHashAndConsensusHash)
-		copy(dAtA[i:], m.NextValidatorHashAndConsensusHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.NextValidatorHashAndConsensusHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.LastBlockIdAndOther) > 0 {
-		i -= len(m.LastBlockIdAndOther)
-		copy(dAtA[i:], m.LastBlockIdAndOther)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.LastBlockIdAndOther)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.TimeNanoSecond != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.TimeNanoSecond))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TimeSecond != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.TimeSecond))
-		i--
-		dAtA[i] = 0x18
+	{
+		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
-	if m.Height != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CountProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
-	if len(m.VersionAndChainIdHash) > 0 {
-		i -= len(m.VersionAndChainIdHash)
-		copy(dAtA[i:], m.VersionAndChainIdHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.VersionAndChainIdHash)))
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CommonEncodedVotePart) Marshal() (dAtA []byte, err error) {
+func (m *PriceMultiProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2387,34 +3204,49 @@ func (m *CommonEncodedVotePart) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CommonEncodedVotePart) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceMultiProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommonEncodedVotePart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceMultiProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SignedDataSuffix) > 0 {
-		i -= len(m.SignedDataSuffix)
-		copy(dAtA[i:], m.SignedDataSuffix)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.SignedDataSuffix)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
-	if len(m.SignedDataPrefix) > 0 {
-		i -= len(m.SignedDataPrefix)
-		copy(dAtA[i:], m.SignedDataPrefix)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.SignedDataPrefix)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PriceDataMultiProof) > 0 {
+		for iNdEx := len(m.PriceDataMultiProof) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceDataMultiProof[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TMSignature) Marshal() (dAtA []byte, err error) {
+func (m *PacketProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2424,46 +3256,45 @@ func (m *TMSignature) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TMSignature) MarshalTo(dAtA []byte) (int, error) {
+func (m *PacketProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TMSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PacketProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EncodedTimestamp) > 0 {
-		i -= len(m.EncodedTimestamp)
-		copy(dAtA[i:], m.EncodedTimestamp)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.EncodedTimestamp)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.V != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.V))
-		i--
-		dAtA[i] = 0x18
+	{
+		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
-	if len(m.S) > 0 {
-		i -= len(m.S)
-		copy(dAtA[i:], m.S)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.S)))
-		i--
-		dAtA[i] = 0x12
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.PacketDataProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
-	if len(m.R) > 0 {
-		i -= len(m.R)
-		copy(dAtA[i:], m.R)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.R)))
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RequestsCountProof) Marshal() (dAtA []byte, err error) {
+func (m *OracleDataProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2473,12 +3304,12 @@ func (m *RequestsCountProof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RequestsCountProof) MarshalTo(dAtA []byte) (int, error) {
+func (m *OracleDataProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RequestsCountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OracleDataProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2502,410 +3333,2644 @@ func (m *RequestsCountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Count != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x8
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProof(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProof(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PriceDataProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ProofRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RequestId != 0 {
-		n += 1 + sovProof(uint64(m.RequestId))
-	}
-	if m.Height != 0 {
-		n += 1 + sovProof(uint64(m.Height))
-	}
-	return n
+
+func (m *PriceDataProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProofResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PriceDataProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovProof(uint64(m.Height))
+	if len(m.MerklePaths) > 0 {
+		for iNdEx := len(m.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerklePaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = m.Result.Size()
-	n += 1 + l + sovProof(uint64(l))
-	return n
-}
-
-func (m *MultiProofRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Version != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if len(m.RequestIds) > 0 {
-		l = 0
-		for _, e := range m.RequestIds {
-			l += sovProof(uint64(e))
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovProof(uint64(l)) + l
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MultiProofResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovProof(uint64(m.Height))
+func (m *PacketDataProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Result.Size()
-	n += 1 + l + sovProof(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RequestCountProofRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *PacketDataProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RequestCountProofResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PacketDataProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovProof(uint64(m.Height))
+	if len(m.MerklePaths) > 0 {
+		for iNdEx := len(m.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerklePaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = m.Result.Size()
-	n += 1 + l + sovProof(uint64(l))
-	return n
-}
-
-func (m *SingleProofResult) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Version != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	l = m.Proof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = len(m.EvmProofBytes)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if len(m.EncodedPacket) > 0 {
+		i -= len(m.EncodedPacket)
+		copy(dAtA[i:], m.EncodedPacket)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EncodedPacket)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MultiProofResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Proof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = len(m.EvmProofBytes)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+func (m *IAVLMerklePath) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CountProofResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Proof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = len(m.EvmProofBytes)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
-	}
-	return n
+func (m *IAVLMerklePath) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SingleProof) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *IAVLMerklePath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovProof(uint64(m.BlockHeight))
+	if len(m.SiblingHash) > 0 {
+		i -= len(m.SiblingHash)
+		copy(dAtA[i:], m.SiblingHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.SiblingHash)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = m.OracleDataProof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = m.BlockRelayProof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	return n
-}
-
-func (m *MultiProof) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SubtreeVersion != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.SubtreeVersion))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovProof(uint64(m.BlockHeight))
+	if m.SubtreeSize != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.SubtreeSize))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.OracleDataMultiProof) > 0 {
-		for _, e := range m.OracleDataMultiProof {
-			l = e.Size()
-			n += 1 + l + sovProof(uint64(l))
+	if m.SubtreeHeight != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.SubtreeHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IsDataOnRight {
+		i--
+		if m.IsDataOnRight {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.BlockRelayProof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CountProof) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovProof(uint64(m.BlockHeight))
+func (m *BlockRelayProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.CountProof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = m.BlockRelayProof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OracleDataProof) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BlockRelayProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockRelayProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Result.Size()
-	n += 1 + l + sovProof(uint64(l))
-	if m.Version != 0 {
-		n += 1 + sovProof(uint64(m.Version))
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if len(m.MerklePaths) > 0 {
-		for _, e := range m.MerklePaths {
-			l = e.Size()
-			n += 1 + l + sovProof(uint64(l))
+	{
+		size, err := m.CommonEncodedVotePart.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.BlockHeaderMerkleParts.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MultiStoreProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *IAVLMerklePath) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StoreBlockRelayProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreBlockRelayProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreBlockRelayProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.IsDataOnRight {
-		n += 2
-	}
-	if m.SubtreeHeight != 0 {
-		n += 1 + sovProof(uint64(m.SubtreeHeight))
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.SubtreeSize != 0 {
-		n += 1 + sovProof(uint64(m.SubtreeSize))
+	{
+		size, err := m.CommonEncodedVotePart.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
-	if m.SubtreeVersion != 0 {
-		n += 1 + sovProof(uint64(m.SubtreeVersion))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.BlockHeaderMerkleParts.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
-	l = len(m.SiblingHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MultiStoreProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *BlockRelayProof) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StoreMultiStoreProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreMultiStoreProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreMultiStoreProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.MultiStoreProof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = m.BlockHeaderMerkleParts.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = m.CommonEncodedVotePart.Size()
-	n += 1 + l + sovProof(uint64(l))
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovProof(uint64(l))
+	if len(m.MerklePaths) > 0 {
+		for iNdEx := len(m.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerklePaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if len(m.IAVLStateHash) > 0 {
+		i -= len(m.IAVLStateHash)
+		copy(dAtA[i:], m.IAVLStateHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.IAVLStateHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MultiStoreProof) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MultiStoreMerklePath) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiStoreMerklePath) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStoreMerklePath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OracleIAVLStateHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
-	}
-	l = len(m.MintStoreMerkleHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
-	}
-	l = len(m.ParamsToRestakeStoresMerkleHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
-	}
-	l = len(m.RollingseedToTransferStoresMerkleHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
-	}
-	l = len(m.TSSToUpgradeStoresMerkleHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if len(m.SiblingHash) > 0 {
+		i -= len(m.SiblingHash)
+		copy(dAtA[i:], m.SiblingHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.SiblingHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.AuthToIcahostStoresMerkleHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if m.IsDataOnRight {
+		i--
+		if m.IsDataOnRight {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BlockHeaderMerkleParts) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MultiStoreProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiStoreProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStoreProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.VersionAndChainIdHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if len(m.AuthToIcahostStoresMerkleHash) > 0 {
+		i -= len(m.AuthToIcahostStoresMerkleHash)
+		copy(dAtA[i:], m.AuthToIcahostStoresMerkleHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.AuthToIcahostStoresMerkleHash)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Height != 0 {
-		n += 1 + sovProof(uint64(m.Height))
+	if len(m.TSSToUpgradeStoresMerkleHash) > 0 {
+		i -= len(m.TSSToUpgradeStoresMerkleHash)
+		copy(dAtA[i:], m.TSSToUpgradeStoresMerkleHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.TSSToUpgradeStoresMerkleHash)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.TimeSecond != 0 {
-		n += 1 + sovProof(uint64(m.TimeSecond))
+	if len(m.RollingseedToTransferStoresMerkleHash) > 0 {
+		i -= len(m.RollingseedToTransferStoresMerkleHash)
+		copy(dAtA[i:], m.RollingseedToTransferStoresMerkleHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.RollingseedToTransferStoresMerkleHash)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.TimeNanoSecond != 0 {
-		n += 1 + sovProof(uint64(m.TimeNanoSecond))
+	if len(m.ParamsToRestakeStoresMerkleHash) > 0 {
+		i -= len(m.ParamsToRestakeStoresMerkleHash)
+		copy(dAtA[i:], m.ParamsToRestakeStoresMerkleHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.ParamsToRestakeStoresMerkleHash)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.LastBlockIdAndOther)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if len(m.MintStoreMerkleHash) > 0 {
+		i -= len(m.MintStoreMerkleHash)
+		copy(dAtA[i:], m.MintStoreMerkleHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.MintStoreMerkleHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.NextValidatorHashAndConsensusHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if len(m.OracleIAVLStateHash) > 0 {
+		i -= len(m.OracleIAVLStateHash)
+		copy(dAtA[i:], m.OracleIAVLStateHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.OracleIAVLStateHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.LastResultsHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockHeaderMerkleParts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.EvidenceAndProposerHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *BlockHeaderMerkleParts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockHeaderMerkleParts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvidenceAndProposerHash) > 0 {
+		i -= len(m.EvidenceAndProposerHash)
+		copy(dAtA[i:], m.EvidenceAndProposerHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvidenceAndProposerHash)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
+	if len(m.LastResultsHash) > 0 {
+		i -= len(m.LastResultsHash)
+		copy(dAtA[i:], m.LastResultsHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.LastResultsHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.NextValidatorHashAndConsensusHash) > 0 {
+		i -= len(m.NextValidatorHashAndConsensusHash)
+		copy(dAtA[i:], m.NextValidatorHashAndConsensusHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.NextValidatorHashAndConsensusHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LastBlockIdAndOther) > 0 {
+		i -= len(m.LastBlockIdAndOther)
+		copy(dAtA[i:], m.LastBlockIdAndOther)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.LastBlockIdAndOther)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TimeNanoSecond != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.TimeNanoSecond))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TimeSecond != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.TimeSecond))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.VersionAndChainIdHash) > 0 {
+		i -= len(m.VersionAndChainIdHash)
+		copy(dAtA[i:], m.VersionAndChainIdHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.VersionAndChainIdHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CommonEncodedVotePart) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommonEncodedVotePart) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CommonEncodedVotePart) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommonEncodedVotePart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SignedDataPrefix)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if len(m.SignedDataSuffix) > 0 {
+		i -= len(m.SignedDataSuffix)
+		copy(dAtA[i:], m.SignedDataSuffix)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.SignedDataSuffix)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.SignedDataSuffix)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if len(m.SignedDataPrefix) > 0 {
+		i -= len(m.SignedDataPrefix)
+		copy(dAtA[i:], m.SignedDataPrefix)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.SignedDataPrefix)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TMSignature) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TMSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TMSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TMSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.R)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
-	}
-	l = len(m.S)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if len(m.EncodedTimestamp) > 0 {
+		i -= len(m.EncodedTimestamp)
+		copy(dAtA[i:], m.EncodedTimestamp)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EncodedTimestamp)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.V != 0 {
-		n += 1 + sovProof(uint64(m.V))
+		i = encodeVarintProof(dAtA, i, uint64(m.V))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.EncodedTimestamp)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.S)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.R)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RequestsCountProof) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RequestsCountProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestsCountProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestsCountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Count != 0 {
-		n += 1 + sovProof(uint64(m.Count))
-	}
-	if m.Version != 0 {
-		n += 1 + sovProof(uint64(m.Version))
-	}
 	if len(m.MerklePaths) > 0 {
-		for _, e := range m.MerklePaths {
-			l = e.Size()
-			n += 1 + l + sovProof(uint64(l))
+		for iNdEx := len(m.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerklePaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Count != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProof(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProof(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovProof(uint64(m.RequestId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *MultiProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RequestIds) > 0 {
+		l = 0
+		for _, e := range m.RequestIds {
+			l += sovProof(uint64(e))
+		}
+		n += 1 + sovProof(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MultiProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *RequestCountProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RequestCountProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *PriceProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignalIds) > 0 {
+		for _, s := range m.SignalIds {
+			l = len(s)
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *PriceProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *TunnelPacketProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovProof(uint64(m.TunnelId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovProof(uint64(m.Sequence))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *TunnelPacketProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *SingleProofResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = len(m.EvmProofBytes)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *MultiProofResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = len(m.EvmProofBytes)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *CountProofResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = len(m.EvmProofBytes)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceProofResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = len(m.EvmProofBytes)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *PacketProofResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = len(m.EvmProofBytes)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *SingleProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovProof(uint64(m.BlockHeight))
+	}
+	l = m.OracleDataProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.BlockRelayProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *MultiProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovProof(uint64(m.BlockHeight))
+	}
+	if len(m.OracleDataMultiProof) > 0 {
+		for _, e := range m.OracleDataMultiProof {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	l = m.BlockRelayProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *CountProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovProof(uint64(m.BlockHeight))
+	}
+	l = m.CountProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.BlockRelayProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *PriceMultiProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovProof(uint64(m.BlockHeight))
+	}
+	if len(m.PriceDataMultiProof) > 0 {
+		for _, e := range m.PriceDataMultiProof {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	l = m.BlockRelayProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *PacketProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovProof(uint64(m.BlockHeight))
+	}
+	l = m.PacketDataProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.BlockRelayProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *OracleDataProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Result.Size()
+	n += 1 + l + sovProof(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovProof(uint64(m.Version))
+	}
+	if len(m.MerklePaths) > 0 {
+		for _, e := range m.MerklePaths {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceDataProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovProof(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovProof(uint64(m.Version))
+	}
+	if len(m.MerklePaths) > 0 {
+		for _, e := range m.MerklePaths {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketDataProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = len(m.EncodedPacket)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovProof(uint64(m.Version))
+	}
+	if len(m.MerklePaths) > 0 {
+		for _, e := range m.MerklePaths {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IAVLMerklePath) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsDataOnRight {
+		n += 2
+	}
+	if m.SubtreeHeight != 0 {
+		n += 1 + sovProof(uint64(m.SubtreeHeight))
+	}
+	if m.SubtreeSize != 0 {
+		n += 1 + sovProof(uint64(m.SubtreeSize))
+	}
+	if m.SubtreeVersion != 0 {
+		n += 1 + sovProof(uint64(m.SubtreeVersion))
+	}
+	l = len(m.SiblingHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockRelayProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MultiStoreProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.BlockHeaderMerkleParts.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.CommonEncodedVotePart.Size()
+	n += 1 + l + sovProof(uint64(l))
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreBlockRelayProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MultiStoreProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.BlockHeaderMerkleParts.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.CommonEncodedVotePart.Size()
+	n += 1 + l + sovProof(uint64(l))
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreMultiStoreProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.IAVLStateHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if len(m.MerklePaths) > 0 {
+		for _, e := range m.MerklePaths {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiStoreMerklePath) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsDataOnRight {
+		n += 2
+	}
+	l = len(m.SiblingHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *MultiStoreProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OracleIAVLStateHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.MintStoreMerkleHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.ParamsToRestakeStoresMerkleHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.RollingseedToTransferStoresMerkleHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.TSSToUpgradeStoresMerkleHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.AuthToIcahostStoresMerkleHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockHeaderMerkleParts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VersionAndChainIdHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	if m.TimeSecond != 0 {
+		n += 1 + sovProof(uint64(m.TimeSecond))
+	}
+	if m.TimeNanoSecond != 0 {
+		n += 1 + sovProof(uint64(m.TimeNanoSecond))
+	}
+	l = len(m.LastBlockIdAndOther)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.NextValidatorHashAndConsensusHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.LastResultsHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.EvidenceAndProposerHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *CommonEncodedVotePart) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignedDataPrefix)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.SignedDataSuffix)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *TMSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.R)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.V != 0 {
+		n += 1 + sovProof(uint64(m.V))
+	}
+	l = len(m.EncodedTimestamp)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *RequestsCountProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovProof(uint64(m.Count))
+	}
+	if m.Version != 0 {
+		n += 1 + sovProof(uint64(m.Version))
+	}
+	if len(m.MerklePaths) > 0 {
+		for _, e := range m.MerklePaths {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProof(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProof(x uint64) (n int) {
+	return sovProof(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProof
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RequestIds = append(m.RequestIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProof
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProof
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProof
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RequestIds) == 0 {
+					m.RequestIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProof
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RequestIds = append(m.RequestIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestCountProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestCountProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestCountProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestCountProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestCountProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestCountProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalIds = append(m.SignalIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TunnelPacketProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TunnelPacketProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TunnelPacketProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+			}
+			m.TunnelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TunnelPacketProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TunnelPacketProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TunnelPacketProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SingleProofResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SingleProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmProofBytes == nil {
+				m.EvmProofBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiProofResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmProofBytes == nil {
+				m.EvmProofBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CountProofResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CountProofResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CountProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmProofBytes == nil {
+				m.EvmProofBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovProof(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozProof(x uint64) (n int) {
-	return sovProof(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ProofRequest) Unmarshal(dAtA []byte) error {
+func (m *PriceProofResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2928,17 +5993,17 @@ func (m *ProofRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProofRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceProofResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			m.RequestId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -2948,16 +6013,30 @@ func (m *ProofRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
 			}
-			m.Height = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -2967,11 +6046,26 @@ func (m *ProofRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmProofBytes == nil {
+				m.EvmProofBytes = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProof(dAtA[iNdEx:])
@@ -2993,7 +6087,7 @@ func (m *ProofRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProofResponse) Unmarshal(dAtA []byte) error {
+func (m *PacketProofResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3016,17 +6110,17 @@ func (m *ProofResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProofResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketProofResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3036,16 +6130,30 @@ func (m *ProofResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3055,23 +6163,24 @@ func (m *ProofResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmProofBytes == nil {
+				m.EvmProofBytes = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3095,7 +6204,7 @@ func (m *ProofResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiProofRequest) Unmarshal(dAtA []byte) error {
+func (m *SingleProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3118,88 +6227,97 @@ func (m *MultiProofRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiProofRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SingleProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SingleProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProof
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
 				}
-				m.RequestIds = append(m.RequestIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProof
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthProof
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthProof
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDataProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.RequestIds) == 0 {
-					m.RequestIds = make([]uint64, 0, elementCount)
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleDataProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowProof
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RequestIds = append(m.RequestIds, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestIds", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProof(dAtA[iNdEx:])
@@ -3221,7 +6339,7 @@ func (m *MultiProofRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiProofResponse) Unmarshal(dAtA []byte) error {
+func (m *MultiProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3244,17 +6362,17 @@ func (m *MultiProofResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiProofResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.Height = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3264,14 +6382,14 @@ func (m *MultiProofResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDataMultiProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3298,60 +6416,44 @@ func (m *MultiProofResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OracleDataMultiProof = append(m.OracleDataMultiProof, OracleDataProof{})
+			if err := m.OracleDataMultiProof[len(m.OracleDataMultiProof)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProof(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProof
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthProof
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RequestCountProofRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProof
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCountProofRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCountProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProof(dAtA[iNdEx:])
@@ -3373,7 +6475,7 @@ func (m *RequestCountProofRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestCountProofResponse) Unmarshal(dAtA []byte) error {
+func (m *CountProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3396,17 +6498,17 @@ func (m *RequestCountProofResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCountProofResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CountProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCountProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CountProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.Height = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3416,14 +6518,14 @@ func (m *RequestCountProofResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CountProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3450,7 +6552,40 @@ func (m *RequestCountProofResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CountProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3475,7 +6610,7 @@ func (m *RequestCountProofResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
+func (m *PriceMultiProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3498,15 +6633,34 @@ func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SingleProofResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceMultiProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SingleProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceMultiProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDataMultiProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3533,15 +6687,16 @@ func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PriceDataMultiProof = append(m.PriceDataMultiProof, PriceDataProof{})
+			if err := m.PriceDataMultiProof[len(m.PriceDataMultiProof)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3551,24 +6706,23 @@ func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.EvmProofBytes == nil {
-				m.EvmProofBytes = []byte{}
+			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3592,7 +6746,7 @@ func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
+func (m *PacketProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3615,15 +6769,34 @@ func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiProofResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketDataProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3650,15 +6823,15 @@ func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PacketDataProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3668,24 +6841,23 @@ func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.EvmProofBytes == nil {
-				m.EvmProofBytes = []byte{}
+			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3709,7 +6881,7 @@ func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CountProofResult) Unmarshal(dAtA []byte) error {
+func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3732,15 +6904,15 @@ func (m *CountProofResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CountProofResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleDataProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CountProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleDataProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3752,30 +6924,49 @@ func (m *CountProofResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProof
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProof
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3785,24 +6976,24 @@ func (m *CountProofResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.EvmProofBytes == nil {
-				m.EvmProofBytes = []byte{}
+			m.MerklePaths = append(m.MerklePaths, IAVLMerklePath{})
+			if err := m.MerklePaths[len(m.MerklePaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3826,7 +7017,7 @@ func (m *CountProofResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SingleProof) Unmarshal(dAtA []byte) error {
+func (m *PriceDataProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3849,34 +7040,15 @@ func (m *SingleProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SingleProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceDataProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SingleProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceDataProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProof
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleDataProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3903,13 +7075,32 @@ func (m *SingleProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OracleDataProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3936,7 +7127,8 @@ func (m *SingleProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MerklePaths = append(m.MerklePaths, IAVLMerklePath{})
+			if err := m.MerklePaths[len(m.MerklePaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3961,7 +7153,7 @@ func (m *SingleProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiProof) Unmarshal(dAtA []byte) error {
+func (m *PacketDataProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3984,17 +7176,17 @@ func (m *MultiProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketDataProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketDataProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
-			m.BlockHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4004,16 +7196,30 @@ func (m *MultiProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleDataMultiProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedPacket", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4023,29 +7229,48 @@ func (m *MultiProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleDataMultiProof = append(m.OracleDataMultiProof, OracleDataProof{})
-			if err := m.OracleDataMultiProof[len(m.OracleDataMultiProof)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EncodedPacket = append(m.EncodedPacket[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncodedPacket == nil {
+				m.EncodedPacket = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4072,7 +7297,8 @@ func (m *MultiProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MerklePaths = append(m.MerklePaths, IAVLMerklePath{})
+			if err := m.MerklePaths[len(m.MerklePaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4097,7 +7323,7 @@ func (m *MultiProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CountProof) Unmarshal(dAtA []byte) error {
+func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4120,17 +7346,17 @@ func (m *CountProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CountProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: IAVLMerklePath: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CountProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IAVLMerklePath: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDataOnRight", wireType)
 			}
-			m.BlockHeight = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4140,16 +7366,17 @@ func (m *CountProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsDataOnRight = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CountProof", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeHeight", wireType)
 			}
-			var msglen int
+			m.SubtreeHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4159,30 +7386,54 @@ func (m *CountProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SubtreeHeight |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProof
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeSize", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProof
+			m.SubtreeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubtreeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeVersion", wireType)
 			}
-			if err := m.CountProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SubtreeVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubtreeVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SiblingHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4192,23 +7443,24 @@ func (m *CountProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SiblingHash = append(m.SiblingHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SiblingHash == nil {
+				m.SiblingHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4232,7 +7484,7 @@ func (m *CountProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
+func (m *BlockRelayProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4255,15 +7507,48 @@ func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleDataProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockRelayProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleDataProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockRelayProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiStoreProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MultiStoreProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderMerkleParts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4290,15 +7575,15 @@ func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlockHeaderMerkleParts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonEncodedVotePart", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4308,14 +7593,28 @@ func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommonEncodedVotePart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4342,8 +7641,8 @@ func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MerklePaths = append(m.MerklePaths, IAVLMerklePath{})
-			if err := m.MerklePaths[len(m.MerklePaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Signatures = append(m.Signatures, TMSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4368,7 +7667,7 @@ func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
+func (m *StoreBlockRelayProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4391,17 +7690,17 @@ func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IAVLMerklePath: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoreBlockRelayProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IAVLMerklePath: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoreBlockRelayProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsDataOnRight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiStoreProof", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4411,17 +7710,30 @@ func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsDataOnRight = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MultiStoreProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderMerkleParts", wireType)
 			}
-			m.SubtreeHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4431,16 +7743,30 @@ func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubtreeHeight |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockHeaderMerkleParts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonEncodedVotePart", wireType)
 			}
-			m.SubtreeSize = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4450,35 +7776,30 @@ func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubtreeSize |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeVersion", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthProof
 			}
-			m.SubtreeVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProof
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SubtreeVersion |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommonEncodedVotePart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SiblingHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4488,24 +7809,24 @@ func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SiblingHash = append(m.SiblingHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.SiblingHash == nil {
-				m.SiblingHash = []byte{}
+			m.Signatures = append(m.Signatures, TMSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -4529,7 +7850,7 @@ func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockRelayProof) Unmarshal(dAtA []byte) error {
+func (m *StoreMultiStoreProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4552,17 +7873,17 @@ func (m *BlockRelayProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockRelayProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoreMultiStoreProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockRelayProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoreMultiStoreProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultiStoreProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4572,30 +7893,29 @@ func (m *BlockRelayProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MultiStoreProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderMerkleParts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IAVLStateHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4605,28 +7925,29 @@ func (m *BlockRelayProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockHeaderMerkleParts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.IAVLStateHash = append(m.IAVLStateHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.IAVLStateHash == nil {
+				m.IAVLStateHash = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommonEncodedVotePart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4653,15 +7974,86 @@ func (m *BlockRelayProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommonEncodedVotePart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MerklePaths = append(m.MerklePaths, MultiStoreMerklePath{})
+			if err := m.MerklePaths[len(m.MerklePaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiStoreMerklePath) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiStoreMerklePath: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiStoreMerklePath: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDataOnRight", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDataOnRight = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SiblingHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4671,24 +8063,24 @@ func (m *BlockRelayProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, TMSignature{})
-			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SiblingHash = append(m.SiblingHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SiblingHash == nil {
+				m.SiblingHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
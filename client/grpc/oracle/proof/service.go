@@ -10,13 +10,16 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
 
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	tunneltypes "github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
 // RegisterProofService registers the node gRPC service on the provided gRPC router.
@@ -80,6 +83,7 @@ func (s proofServer) Proof(ctx context.Context, req *ProofRequest) (*ProofRespon
 	// Get the proofs for the requested id and height
 	value, iavlEp, multiStoreEp, err := getProofsByKey(
 		cliCtx,
+		types.StoreKey,
 		types.ResultStoreKey(requestID),
 		rpcclient.ABCIQueryOptions{Height: commit.Height - 1, Prove: true},
 		true,
@@ -200,6 +204,7 @@ func (s proofServer) MultiProof(ctx context.Context, req *MultiProofRequest) (*M
 
 		value, iavlEp, multiStoreEp, err := getProofsByKey(
 			cliCtx,
+			types.StoreKey,
 			types.ResultStoreKey(requestID),
 			rpcclient.ABCIQueryOptions{Height: commit.Height - 1, Prove: true},
 			idx == 0,
@@ -294,6 +299,7 @@ func (s proofServer) RequestCountProof(
 	// Get the proofs for the count from the IAVL tree
 	value, iavlEp, multiStoreEp, err := getProofsByKey(
 		cliCtx,
+		types.StoreKey,
 		types.RequestCountStoreKey,
 		rpcclient.ABCIQueryOptions{Height: commit.Height - 1, Prove: true},
 		true,
@@ -367,3 +373,215 @@ func (s proofServer) RequestCountProof(
 		},
 	}, nil
 }
+
+// PriceProof returns a proof for the prices of provided signal IDs and block height
+func (s proofServer) PriceProof(ctx context.Context, req *PriceProofRequest) (*PriceProofResponse, error) {
+	// If there are no signal IDs, return an error
+	signalIDs := req.SignalIds
+	if len(signalIDs) == 0 {
+		return nil, fmt.Errorf("please provide signal ids")
+	}
+
+	cliCtx, commit, err := getCanonicalCommit(s.clientCtx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a StoreBlockRelayProof object with the relevant information
+	blockRelay, err := getStoreBlockRelayProof(commit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create lists to store the price data proof objects and encoded bytes for each signal ID
+	priceDataBytesList := make([][]byte, len(signalIDs))
+	priceDataList := make([]PriceDataProof, len(signalIDs))
+
+	// Loop through each signal ID and get the relevant proofs
+	for idx, signalID := range signalIDs {
+		value, iavlEp, multiStoreEp, err := getProofsByKey(
+			cliCtx,
+			feedstypes.StoreKey,
+			feedstypes.PriceStoreKey(signalID),
+			rpcclient.ABCIQueryOptions{Height: commit.Height - 1, Prove: true},
+			idx == 0,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// Unmarshal the value into a Price object
+		var price feedstypes.Price
+		if err := cliCtx.Codec.Unmarshal(value, &price); err != nil {
+			return nil, err
+		}
+
+		// Create a PriceDataProof object with the relevant information
+		priceData := PriceDataProof{
+			Price:       price,
+			Version:     decodeIAVLLeafPrefix(iavlEp.Leaf.Prefix),
+			MerklePaths: GetMerklePaths(iavlEp),
+		}
+		priceDataBytes, err := priceData.encodeToEthData(uint64(commit.Height))
+		if err != nil {
+			return nil, err
+		}
+		priceDataBytesList[idx] = priceDataBytes
+		priceDataList[idx] = priceData
+
+		// All prices are in the same store, so the multistore proof of the first one is used
+		if idx == 0 {
+			blockRelay.MultiStoreProof = GetStoreMultiStoreProof(feedstypes.StoreKey, multiStoreEp)
+		}
+	}
+
+	blockRelayBytes, err := blockRelay.encodeToEthData()
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate byte for proofbytes
+	var relayAndVerifyArguments abi.Arguments
+	format := `[{"type":"bytes"},{"type":"bytes[]"}]`
+	err = json.Unmarshal([]byte(format), &relayAndVerifyArguments)
+	if err != nil {
+		panic(err)
+	}
+
+	// Pack the encoded block relay bytes and price data bytes into a evm proof bytes
+	evmProofBytes, err := relayAndVerifyArguments.Pack(blockRelayBytes, priceDataBytesList)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PriceProofResponse{
+		Height: cliCtx.Height,
+		Result: PriceProofResult{
+			Proof: PriceMultiProof{
+				BlockHeight:         uint64(commit.Height),
+				PriceDataMultiProof: priceDataList,
+				BlockRelayProof:     blockRelay,
+			},
+			EvmProofBytes: evmProofBytes,
+		},
+	}, nil
+}
+
+// TunnelPacketProof returns a proof for the packet of provided tunnel ID and sequence and block height
+func (s proofServer) TunnelPacketProof(
+	ctx context.Context,
+	req *TunnelPacketProofRequest,
+) (*TunnelPacketProofResponse, error) {
+	cliCtx, commit, err := getCanonicalCommit(s.clientCtx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the proofs for the requested packet and height
+	value, iavlEp, multiStoreEp, err := getProofsByKey(
+		cliCtx,
+		tunneltypes.StoreKey,
+		tunneltypes.TunnelPacketStoreKey(req.TunnelId, req.Sequence),
+		rpcclient.ABCIQueryOptions{Height: commit.Height - 1, Prove: true},
+		true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a StoreBlockRelayProof object with the relevant information
+	blockRelay, err := getStoreBlockRelayProof(commit)
+	if err != nil {
+		return nil, err
+	}
+	blockRelay.MultiStoreProof = GetStoreMultiStoreProof(tunneltypes.StoreKey, multiStoreEp)
+
+	// Unmarshal the value into a Packet object
+	var packet tunneltypes.Packet
+	if err := cliCtx.Codec.Unmarshal(value, &packet); err != nil {
+		return nil, err
+	}
+
+	// Create a PacketDataProof object with the relevant information
+	packetData := PacketDataProof{
+		Packet:        packet,
+		EncodedPacket: value,
+		Version:       decodeIAVLLeafPrefix(iavlEp.Leaf.Prefix),
+		MerklePaths:   GetMerklePaths(iavlEp),
+	}
+
+	blockRelayBytes, err := blockRelay.encodeToEthData()
+	if err != nil {
+		return nil, err
+	}
+	packetDataBytes, err := packetData.encodeToEthData(uint64(commit.Height))
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate byte for proofbytes
+	var relayAndVerifyArguments abi.Arguments
+	format := `[{"type":"bytes"},{"type":"bytes"}]`
+	err = json.Unmarshal([]byte(format), &relayAndVerifyArguments)
+	if err != nil {
+		panic(err)
+	}
+
+	// Pack the encoded block relay bytes and packet data bytes into a evm proof bytes
+	evmProofBytes, err := relayAndVerifyArguments.Pack(blockRelayBytes, packetDataBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TunnelPacketProofResponse{
+		Height: cliCtx.Height,
+		Result: PacketProofResult{
+			Proof: PacketProof{
+				BlockHeight:     uint64(commit.Height),
+				PacketDataProof: packetData,
+				BlockRelayProof: blockRelay,
+			},
+			EvmProofBytes: evmProofBytes,
+		},
+	}, nil
+}
+
+// getCanonicalCommit returns the client context set to the given height, or the latest block height - 1
+// if the height is 0, together with the canonical commit at that height.
+func getCanonicalCommit(cliCtx client.Context, height int64) (client.Context, *coretypes.ResultCommit, error) {
+	cliCtx.Height = height
+	if cliCtx.Height == 0 {
+		status, err := cliCtx.Client.Status(context.Background())
+		if err != nil {
+			return cliCtx, nil, err
+		}
+		cliCtx.Height = status.SyncInfo.LatestBlockHeight - 1
+	}
+	if cliCtx.Height < 0 {
+		return cliCtx, nil, fmt.Errorf("negative height in request")
+	}
+
+	commit, err := cliCtx.Client.Commit(context.Background(), &cliCtx.Height)
+	if err != nil {
+		return cliCtx, nil, err
+	}
+	if !commit.CanonicalCommit {
+		return cliCtx, nil, fmt.Errorf("commit is not canonical")
+	}
+
+	return cliCtx, commit, nil
+}
+
+// getStoreBlockRelayProof returns a StoreBlockRelayProof of the given commit without the multistore proof.
+func getStoreBlockRelayProof(commit *coretypes.ResultCommit) (StoreBlockRelayProof, error) {
+	signatures, commonVote, err := GetSignaturesAndPrefix(&commit.SignedHeader)
+	if err != nil {
+		return StoreBlockRelayProof{}, err
+	}
+
+	return StoreBlockRelayProof{
+		BlockHeaderMerkleParts: GetBlockHeaderMerkleParts(commit.Header),
+		CommonEncodedVotePart:  commonVote,
+		Signatures:             signatures,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package proof
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+
+	ics23 "github.com/cosmos/ics23/go"
+)
+
+// MultiStoreMerklePathEthereum is an Ethereum version of MultiStoreMerklePath for solidity ABI-encoding.
+type MultiStoreMerklePathEthereum struct {
+	IsDataOnRight bool
+	SiblingHash   common.Hash
+}
+
+// StoreMultiStoreProofEthereum is an Ethereum version of StoreMultiStoreProof for solidity ABI-encoding.
+type StoreMultiStoreProofEthereum struct {
+	StoreKey      string
+	IAVLStateHash common.Hash `abi:"iavlStateHash"`
+	MerklePaths   []MultiStoreMerklePathEthereum
+}
+
+func (m *StoreMultiStoreProof) encodeToEthFormat() StoreMultiStoreProofEthereum {
+	paths := make([]MultiStoreMerklePathEthereum, len(m.MerklePaths))
+	for i, path := range m.MerklePaths {
+		paths[i] = MultiStoreMerklePathEthereum{
+			IsDataOnRight: path.IsDataOnRight,
+			SiblingHash:   common.BytesToHash(path.SiblingHash),
+		}
+	}
+	return StoreMultiStoreProofEthereum{
+		StoreKey:      m.StoreKey,
+		IAVLStateHash: common.BytesToHash(m.IAVLStateHash),
+		MerklePaths:   paths,
+	}
+}
+
+// GetStoreMultiStoreProof converts the multistore proof of the given store from Tendermint to
+// StoreMultiStoreProof version.
+func GetStoreMultiStoreProof(storeKey string, multiStoreEp *ics23.ExistenceProof) StoreMultiStoreProof {
+	paths := make([]MultiStoreMerklePath, 0, len(multiStoreEp.Path))
+	for _, step := range multiStoreEp.Path {
+		// an inner node of the simple Merkle tree is prefixed with 0x01, the sibling hash follows
+		// the prefix if it is on the left and is the suffix if it is on the right.
+		if len(step.Prefix) > 1 {
+			paths = append(paths, MultiStoreMerklePath{
+				IsDataOnRight: true,
+				SiblingHash:   tmbytes.HexBytes(step.Prefix[1:]),
+			})
+		} else {
+			paths = append(paths, MultiStoreMerklePath{
+				IsDataOnRight: false,
+				SiblingHash:   tmbytes.HexBytes(step.Suffix),
+			})
+		}
+	}
+	return StoreMultiStoreProof{
+		StoreKey:      storeKey,
+		IAVLStateHash: tmbytes.HexBytes(multiStoreEp.Value),
+		MerklePaths:   paths,
+	}
+}
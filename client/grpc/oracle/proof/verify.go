@@ -0,0 +1,238 @@
+package proof
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	tunneltypes "github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// simpleLeafHash returns the hash of a leaf node of Tendermint's simple Merkle tree.
+func simpleLeafHash(item []byte) []byte {
+	// leaf prefix is 0
+	return tmhash.Sum(append([]byte{0}, item...))
+}
+
+// simpleInnerHash returns the hash of an inner node of Tendermint's simple Merkle tree.
+func simpleInnerHash(left, right []byte) []byte {
+	// branch prefix is 1
+	return tmhash.Sum(append([]byte{1}, append(left, right...)...))
+}
+
+// parentHash returns the hash of the IAVL inner node of this Merkle step given the hash of its subtree.
+func (merklePath *IAVLMerklePath) parentHash(subtreeHash []byte) []byte {
+	preimage := convertVarIntToBytes(int64(merklePath.SubtreeHeight))
+	preimage = append(preimage, convertVarIntToBytes(int64(merklePath.SubtreeSize))...)
+	preimage = append(preimage, convertVarIntToBytes(int64(merklePath.SubtreeVersion))...)
+
+	left, right := subtreeHash, []byte(merklePath.SiblingHash)
+	if merklePath.IsDataOnRight {
+		left, right = right, left
+	}
+	preimage = append(preimage, tmhash.Size)
+	preimage = append(preimage, left...)
+	preimage = append(preimage, tmhash.Size)
+	preimage = append(preimage, right...)
+
+	return tmhash.Sum(preimage)
+}
+
+// calculateIAVLStateHash returns the root hash of an IAVL tree computed from the leaf of the given
+// key and value at the given version and the Merkle steps from the leaf to the root.
+func calculateIAVLStateHash(key []byte, value []byte, version uint64, merklePaths []IAVLMerklePath) []byte {
+	// ref: https://github.com/cosmos/iavl/blob/master/proof_ics23.go
+	leafNode := convertVarIntToBytes(0)
+	leafNode = append(leafNode, convertVarIntToBytes(1)...)
+	leafNode = append(leafNode, convertVarIntToBytes(int64(version))...)
+	leafNode = append(leafNode, encodeUvarint(uint64(len(key)))...)
+	leafNode = append(leafNode, key...)
+	leafNode = append(leafNode, tmhash.Size)
+	leafNode = append(leafNode, tmhash.Sum(value)...)
+
+	currentHash := tmhash.Sum(leafNode)
+	for _, path := range merklePaths {
+		currentHash = path.parentHash(currentHash)
+	}
+	return currentHash
+}
+
+// CalculateAppHash returns Tendermint's application state hash computed from the IAVL state hash of the
+// store and the Merkle steps from the store leaf to the root of the multistore.
+func (m *StoreMultiStoreProof) CalculateAppHash() []byte {
+	leaf := encodeUvarint(uint64(len(m.StoreKey)))
+	leaf = append(leaf, []byte(m.StoreKey)...)
+	leaf = append(leaf, tmhash.Size)
+	leaf = append(leaf, tmhash.Sum(m.IAVLStateHash)...)
+
+	currentHash := simpleLeafHash(leaf)
+	for _, path := range m.MerklePaths {
+		if path.IsDataOnRight {
+			currentHash = simpleInnerHash(path.SiblingHash, currentHash)
+		} else {
+			currentHash = simpleInnerHash(currentHash, path.SiblingHash)
+		}
+	}
+	return currentHash
+}
+
+// CalculateBlockHash returns Tendermint's block header hash computed from the block header merkle parts
+// and the given application state hash.
+func (bp *BlockHeaderMerkleParts) CalculateBlockHash(appHash []byte) []byte {
+	blockTime := time.Unix(int64(bp.TimeSecond), int64(bp.TimeNanoSecond))
+
+	return simpleInnerHash(
+		simpleInnerHash(
+			simpleInnerHash(
+				bp.VersionAndChainIdHash,
+				simpleInnerHash(
+					simpleLeafHash(cdcEncode(int64(bp.Height))),
+					simpleLeafHash(encodeTime(blockTime)),
+				),
+			),
+			bp.LastBlockIdAndOther,
+		),
+		simpleInnerHash(
+			simpleInnerHash(
+				bp.NextValidatorHashAndConsensusHash,
+				simpleInnerHash(
+					simpleLeafHash(cdcEncode(tmbytes.HexBytes(appHash))),
+					bp.LastResultsHash,
+				),
+			),
+			bp.EvidenceAndProposerHash,
+		),
+	)
+}
+
+// CalculateBlockHash returns the hash of the block that commits the IAVL state hash of the store.
+func (blockRelay *StoreBlockRelayProof) CalculateBlockHash() []byte {
+	return blockRelay.BlockHeaderMerkleParts.CalculateBlockHash(blockRelay.MultiStoreProof.CalculateAppHash())
+}
+
+// RecoverSigners returns the EVM addresses of the validators that signed the precommit votes of the given
+// block hash on the given chain. The caller is responsible for checking that the signers hold enough
+// voting power.
+func (blockRelay *StoreBlockRelayProof) RecoverSigners(blockHash []byte, chainID string) ([]common.Address, error) {
+	commonPart := append([]byte{}, blockRelay.CommonEncodedVotePart.SignedDataPrefix...)
+	commonPart = append(commonPart, blockHash...)
+	commonPart = append(commonPart, blockRelay.CommonEncodedVotePart.SignedDataSuffix...)
+
+	chainIDBytes := []byte(chainID)
+	encodedChainIDConstant := append([]byte{50, uint8(len(chainIDBytes))}, chainIDBytes...)
+
+	signers := make([]common.Address, len(blockRelay.Signatures))
+	for i, sig := range blockRelay.Signatures {
+		msg := append([]byte{}, commonPart...)
+		msg = append(msg, []byte{42, uint8(len(sig.EncodedTimestamp))}...)
+		msg = append(msg, sig.EncodedTimestamp...)
+		msg = append(msg, encodedChainIDConstant...)
+		msg = append([]byte{uint8(len(msg))}, msg...)
+
+		sigBytes := append([]byte{}, sig.R...)
+		sigBytes = append(sigBytes, sig.S...)
+		sigBytes = append(sigBytes, uint8(sig.V)-27)
+		pub, err := crypto.SigToPub(tmhash.Sum(msg), sigBytes)
+		if err != nil {
+			return nil, err
+		}
+		signers[i] = crypto.PubkeyToAddress(*pub)
+	}
+	return signers, nil
+}
+
+// verify checks that the given IAVL state hash of the given store is committed in the block of the given
+// height and hash.
+func (blockRelay *StoreBlockRelayProof) verify(
+	storeKey string,
+	iavlStateHash []byte,
+	blockHeight uint64,
+	blockHash []byte,
+) error {
+	if blockRelay.MultiStoreProof.StoreKey != storeKey {
+		return fmt.Errorf("invalid store key: expect %s, got %s", storeKey, blockRelay.MultiStoreProof.StoreKey)
+	}
+	if !bytes.Equal(blockRelay.MultiStoreProof.IAVLStateHash, iavlStateHash) {
+		return fmt.Errorf("IAVL state hash mismatch")
+	}
+	if blockRelay.BlockHeaderMerkleParts.Height != blockHeight {
+		return fmt.Errorf(
+			"block height mismatch: expect %d, got %d",
+			blockHeight,
+			blockRelay.BlockHeaderMerkleParts.Height,
+		)
+	}
+	if !bytes.Equal(blockRelay.CalculateBlockHash(), blockHash) {
+		return fmt.Errorf("block hash mismatch")
+	}
+	return nil
+}
+
+// CalculateIAVLStateHash returns the root hash of the feeds store computed from the price.
+func (p *PriceDataProof) CalculateIAVLStateHash() ([]byte, error) {
+	value, err := p.Price.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return calculateIAVLStateHash(feedstypes.PriceStoreKey(p.Price.SignalID), value, p.Version, p.MerklePaths), nil
+}
+
+// CalculateIAVLStateHash returns the root hash of the tunnel store computed from the encoded packet.
+func (p *PacketDataProof) CalculateIAVLStateHash() []byte {
+	return calculateIAVLStateHash(
+		tunneltypes.TunnelPacketStoreKey(p.Packet.TunnelID, p.Packet.Sequence),
+		p.EncodedPacket,
+		p.Version,
+		p.MerklePaths,
+	)
+}
+
+// VerifyPriceMultiProof verifies that all prices of the given proof are committed in the block of the given
+// hash. The block hash must come from a trusted source, e.g. a light client, or be checked against the
+// signers returned by StoreBlockRelayProof.RecoverSigners.
+func VerifyPriceMultiProof(proof PriceMultiProof, blockHash []byte) error {
+	if len(proof.PriceDataMultiProof) == 0 {
+		return fmt.Errorf("no price in proof")
+	}
+
+	for _, priceData := range proof.PriceDataMultiProof {
+		iavlStateHash, err := priceData.CalculateIAVLStateHash()
+		if err != nil {
+			return err
+		}
+		err = proof.BlockRelayProof.verify(feedstypes.StoreKey, iavlStateHash, proof.BlockHeight, blockHash)
+		if err != nil {
+			return fmt.Errorf("invalid proof of signal id %s: %w", priceData.Price.SignalID, err)
+		}
+	}
+	return nil
+}
+
+// VerifyPacketProof verifies that the packet of the given proof is committed in the block of the given hash.
+// The block hash must come from a trusted source, e.g. a light client, or be checked against the signers
+// returned by StoreBlockRelayProof.RecoverSigners.
+func VerifyPacketProof(proof PacketProof, blockHash []byte) error {
+	packetData := proof.PacketDataProof
+
+	encodedPacket, err := packetData.Packet.Marshal()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(encodedPacket, packetData.EncodedPacket) {
+		return fmt.Errorf("packet does not match the encoded packet")
+	}
+
+	return proof.BlockRelayProof.verify(
+		tunneltypes.StoreKey,
+		packetData.CalculateIAVLStateHash(),
+		proof.BlockHeight,
+		blockHash,
+	)
+}
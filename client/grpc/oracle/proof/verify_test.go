@@ -0,0 +1,367 @@
+package proof
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+	tmversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	"github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	tunneltypes "github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+var (
+	testPrices = []feedstypes.Price{
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1500000000, 1729000000),
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BTC-USD", 67000000000000, 1729000000),
+		feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, "CS:ETH-USD", 0, 1729000000),
+	}
+	testPacket = tunneltypes.Packet{
+		TunnelID:  1,
+		Sequence:  2,
+		Prices:    testPrices[:2],
+		CreatedAt: 1729000000,
+	}
+)
+
+// newTestMultiStore commits the test prices and packet to a multistore with a few mounted stores and
+// returns the multistore and its commit ID.
+func newTestMultiStore(t *testing.T) (*rootmulti.Store, storetypes.CommitID) {
+	rs := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	keys := storetypes.NewKVStoreKeys("acc", "bank", feedstypes.StoreKey, "oracle", "staking", tunneltypes.StoreKey)
+	for _, key := range keys {
+		rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, rs.LoadLatestVersion())
+
+	for name, key := range keys {
+		rs.GetKVStore(key).Set([]byte("key"), []byte(name))
+	}
+
+	feedsStore := rs.GetKVStore(keys[feedstypes.StoreKey])
+	for _, price := range testPrices {
+		bz, err := price.Marshal()
+		require.NoError(t, err)
+		feedsStore.Set(feedstypes.PriceStoreKey(price.SignalID), bz)
+	}
+
+	bz, err := testPacket.Marshal()
+	require.NoError(t, err)
+	rs.GetKVStore(keys[tunneltypes.StoreKey]).
+		Set(tunneltypes.TunnelPacketStoreKey(testPacket.TunnelID, testPacket.Sequence), bz)
+
+	return rs, rs.Commit()
+}
+
+// queryExistenceProofs queries the value and the proofs of the given key in the given store.
+func queryExistenceProofs(
+	t *testing.T,
+	rs *rootmulti.Store,
+	storeName string,
+	key []byte,
+	height int64,
+) ([]byte, StoreMultiStoreProof, uint64, []IAVLMerklePath) {
+	res, err := rs.Query(&storetypes.RequestQuery{
+		Path:   "/" + storeName + "/key",
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	require.NoError(t, err)
+
+	iavlEp, multiStoreEp, err := getExistenceProofs(res.ProofOps.Ops, true)
+	require.NoError(t, err)
+
+	return res.Value, GetStoreMultiStoreProof(storeName, multiStoreEp), decodeIAVLLeafPrefix(
+		iavlEp.Leaf.Prefix,
+	), GetMerklePaths(iavlEp)
+}
+
+// newTestHeader returns a block header that commits the given app hash.
+func newTestHeader(height int64, appHash []byte) types.Header {
+	return types.Header{
+		Version: tmversion.Consensus{Block: 11},
+		ChainID: "bandchain",
+		Height:  height,
+		Time:    parseTime("2024-10-15T13:46:40.123456789Z"),
+		LastBlockID: types.BlockID{
+			Hash: hexToBytes("622A4600128DECC6C42E471F06F00C654785485D5AB4437556F41743DC4684C8"),
+			PartSetHeader: types.PartSetHeader{
+				Total: 1,
+				Hash:  hexToBytes("733EDAE763A4635509BE9E55E06A2CBF726056A0898B6B4D3AF74683ECCF3475"),
+			},
+		},
+		ValidatorsHash:     hexToBytes("113928F64B9B2A1F1A58D87C93147D822CF2069309B55D47717700D4074A43B6"),
+		NextValidatorsHash: hexToBytes("113928F64B9B2A1F1A58D87C93147D822CF2069309B55D47717700D4074A43B6"),
+		ConsensusHash:      hexToBytes("188E4357E7B1201E6C2B418759CB8246FAB30CF2FFA87433E21690B7BC8BC88C"),
+		AppHash:            appHash,
+		ProposerAddress:    hexToBytes("F23391B5DBF982E37FB7DADEA64AAE21CAE4C172"),
+	}
+}
+
+func TestCalculateBlockHash(t *testing.T) {
+	header := types.Header{
+		Version: tmversion.Consensus{Block: 11},
+		ChainID: "bandchain",
+		Height:  25000,
+		Time:    parseTime("2021-08-25T00:05:31.290650376Z"),
+		LastBlockID: types.BlockID{
+			Hash: hexToBytes("622A4600128DECC6C42E471F06F00C654785485D5AB4437556F41743DC4684C8"),
+			PartSetHeader: types.PartSetHeader{
+				Total: 1,
+				Hash:  hexToBytes("733EDAE763A4635509BE9E55E06A2CBF726056A0898B6B4D3AF74683ECCF3475"),
+			},
+		},
+		LastCommitHash:     hexToBytes("021C8BBD047747AE943C5F7991B6848DE371B313FF3C15E5B2EDA94DD834BB42"),
+		DataHash:           hexToBytes("E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855"),
+		ValidatorsHash:     hexToBytes("113928F64B9B2A1F1A58D87C93147D822CF2069309B55D47717700D4074A43B6"),
+		NextValidatorsHash: hexToBytes("113928F64B9B2A1F1A58D87C93147D822CF2069309B55D47717700D4074A43B6"),
+		ConsensusHash:      hexToBytes("188E4357E7B1201E6C2B418759CB8246FAB30CF2FFA87433E21690B7BC8BC88C"),
+		AppHash:            hexToBytes("37D2CA95F226A7AFE3C41DE288F8158B737E78C4B733B1CCB0061D3236E926BE"),
+		LastResultsHash:    hexToBytes("E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855"),
+		EvidenceHash:       hexToBytes("E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855"),
+		ProposerAddress:    hexToBytes("F23391B5DBF982E37FB7DADEA64AAE21CAE4C172"),
+	}
+	blockMerkleParts := GetBlockHeaderMerkleParts(&header)
+
+	require.Equal(
+		t,
+		hexToBytes("3489F21785ACE1CE4214CB2B57F3A98DC0B7377D1BA1E1180B6E199E33B0FC5A"),
+		blockMerkleParts.CalculateBlockHash(header.AppHash),
+	)
+}
+
+func TestRecoverSigners(t *testing.T) {
+	blockRelay := StoreBlockRelayProof{
+		CommonEncodedVotePart: CommonEncodedVotePart{
+			SignedDataPrefix: hexToBytes("080211A86100000000000022480A20"),
+			SignedDataSuffix: hexToBytes(
+				"1224080112206BF91EFBA26A4CD86EBBD0E54DCFC9BD2C790859CFA96215661A47E4921A6301",
+			),
+		},
+		Signatures: []TMSignature{
+			{
+				R:                hexToBytes("84B8585B71240FEE0E674952B79ED25D793F1B31B42DD37B80F75B98510B5754"),
+				S:                hexToBytes("1EC44DD7C5389474DF8E5C25CC6ED8B573CCA2E009AA824EE825BDC693935927"),
+				V:                28,
+				EncodedTimestamp: hexToBytes("08CD9296890610EAE9963D"),
+			},
+			{
+				R:                hexToBytes("394365193F819CF539381366D31B6C5849AAA31AE8BA6F95C62C5C80656BFB5C"),
+				S:                hexToBytes("6A07E4A3C0ABCEAE5F854D492DF699438FB84762F152F739DDEAC48DDCFCB5CC"),
+				V:                28,
+				EncodedTimestamp: hexToBytes("08CD9296890610EA928633"),
+			},
+		},
+	}
+
+	signers, err := blockRelay.RecoverSigners(
+		hexToBytes("3489F21785ACE1CE4214CB2B57F3A98DC0B7377D1BA1E1180B6E199E33B0FC5A"),
+		"bandchain",
+	)
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{
+		common.HexToAddress("0x652D89a66Eb4eA55366c45b1f9ACfc8e2179E1c5"),
+		common.HexToAddress("0x88e1cd00710495EEB93D4f522d16bC8B87Cb00FE"),
+	}, signers)
+}
+
+func TestGetStoreMultiStoreProof(t *testing.T) {
+	rs, commitID := newTestMultiStore(t)
+
+	for _, storeName := range []string{feedstypes.StoreKey, "oracle", tunneltypes.StoreKey} {
+		_, multiStoreProof, _, _ := queryExistenceProofs(t, rs, storeName, []byte("key"), commitID.Version)
+
+		require.Equal(t, storeName, multiStoreProof.StoreKey)
+		require.Equal(t, rs.GetCommitKVStore(rs.StoreKeysByName()[storeName]).LastCommitID().Hash,
+			[]byte(multiStoreProof.IAVLStateHash))
+		require.Equal(t, commitID.Hash, multiStoreProof.CalculateAppHash())
+	}
+}
+
+func TestVerifyPriceMultiProof(t *testing.T) {
+	rs, commitID := newTestMultiStore(t)
+
+	header := newTestHeader(commitID.Version+1, commitID.Hash)
+	blockRelay := StoreBlockRelayProof{BlockHeaderMerkleParts: GetBlockHeaderMerkleParts(&header)}
+	proof := PriceMultiProof{BlockHeight: uint64(header.Height)}
+	for _, price := range testPrices {
+		value, multiStoreProof, version, merklePaths := queryExistenceProofs(
+			t, rs, feedstypes.StoreKey, feedstypes.PriceStoreKey(price.SignalID), commitID.Version,
+		)
+
+		var p feedstypes.Price
+		require.NoError(t, p.Unmarshal(value))
+		require.Equal(t, price, p)
+
+		blockRelay.MultiStoreProof = multiStoreProof
+		proof.PriceDataMultiProof = append(proof.PriceDataMultiProof, PriceDataProof{
+			Price:       p,
+			Version:     version,
+			MerklePaths: merklePaths,
+		})
+	}
+	proof.BlockRelayProof = blockRelay
+
+	blockHash := header.Hash()
+	require.Equal(t, []byte(blockHash), blockRelay.CalculateBlockHash())
+	require.NoError(t, VerifyPriceMultiProof(proof, blockHash))
+
+	// a proof of another block must be rejected
+	require.ErrorContains(
+		t,
+		VerifyPriceMultiProof(proof, hexToBytes("3489F21785ACE1CE4214CB2B57F3A98DC0B7377D1BA1E1180B6E199E33B0FC5A")),
+		"block hash mismatch",
+	)
+
+	// a tampered price must be rejected
+	proof.PriceDataMultiProof[1].Price.Price++
+	require.ErrorContains(t, VerifyPriceMultiProof(proof, blockHash), "invalid proof of signal id CS:BTC-USD")
+}
+
+func TestVerifyPacketProof(t *testing.T) {
+	rs, commitID := newTestMultiStore(t)
+
+	header := newTestHeader(commitID.Version+1, commitID.Hash)
+	value, multiStoreProof, version, merklePaths := queryExistenceProofs(
+		t,
+		rs,
+		tunneltypes.StoreKey,
+		tunneltypes.TunnelPacketStoreKey(testPacket.TunnelID, testPacket.Sequence),
+		commitID.Version,
+	)
+
+	proof := PacketProof{
+		BlockHeight: uint64(header.Height),
+		PacketDataProof: PacketDataProof{
+			Packet:        testPacket,
+			EncodedPacket: value,
+			Version:       version,
+			MerklePaths:   merklePaths,
+		},
+		BlockRelayProof: StoreBlockRelayProof{
+			MultiStoreProof:        multiStoreProof,
+			BlockHeaderMerkleParts: GetBlockHeaderMerkleParts(&header),
+		},
+	}
+	require.NoError(t, VerifyPacketProof(proof, header.Hash()))
+
+	// a proof of the feeds store must be rejected
+	proof.BlockRelayProof.MultiStoreProof.StoreKey = feedstypes.StoreKey
+	require.ErrorContains(t, VerifyPacketProof(proof, header.Hash()), "invalid store key")
+	proof.BlockRelayProof.MultiStoreProof.StoreKey = tunneltypes.StoreKey
+
+	// the packet must match the committed encoded packet
+	proof.PacketDataProof.Packet.Sequence = 3
+	require.ErrorContains(t, VerifyPacketProof(proof, header.Hash()), "packet does not match the encoded packet")
+}
+
+func TestEncodeStoreRelay(t *testing.T) {
+	blockRelay := StoreBlockRelayProof{
+		MultiStoreProof: StoreMultiStoreProof{
+			StoreKey:      feedstypes.StoreKey,
+			IAVLStateHash: hexToBytes("5248463E932D16F7D092E268C0DED87B23D3B0E71856F1C6AE2AA91F6C713320"),
+			MerklePaths: []MultiStoreMerklePath{
+				{
+					IsDataOnRight: true,
+					SiblingHash:   hexToBytes("7FD5F5C7C2920C187618542901CDC5717BE8204F24BE856E80902A1BB04737E4"),
+				},
+				{
+					IsDataOnRight: false,
+					SiblingHash:   hexToBytes("F981716562A49DE06E3DCAFBFB6388C294BAA4FA9D45777E25740A92F81CF65E"),
+				},
+			},
+		},
+		BlockHeaderMerkleParts: BlockHeaderMerkleParts{
+			VersionAndChainIdHash: hexToBytes("3F02642D9E70D5C1C493A4F732BFE9C9B95A4A42651703B816EDCFC8FADA5312"),
+			Height:                25000,
+			TimeSecond:            1629849931,
+			TimeNanoSecond:        290650376,
+			LastBlockIdAndOther:   hexToBytes("9B4825C99C3E739E1DC171FFB0E2BF34E99EEE41B34E407E40CF594834427B09"),
+			NextValidatorHashAndConsensusHash: hexToBytes(
+				"BF23413F237906B07202B3355E7311651ACE6BD2A34FD6FC3BD98EFE4FB78755",
+			),
+			LastResultsHash:         hexToBytes("9FB9C7533CAF1D218DA3AF6D277F6B101C42E3C3B75D784242DA663604DD53C2"),
+			EvidenceAndProposerHash: hexToBytes("7D11A74E40884411901BD7A70631734990B1FDBF5DE9E4C92C63B7650A6A6659"),
+		},
+		CommonEncodedVotePart: CommonEncodedVotePart{
+			SignedDataPrefix: hexToBytes("080211A86100000000000022480A20"),
+			SignedDataSuffix: hexToBytes(
+				"1224080112206BF91EFBA26A4CD86EBBD0E54DCFC9BD2C790859CFA96215661A47E4921A6301",
+			),
+		},
+		Signatures: []TMSignature{
+			{
+				R:                hexToBytes("84B8585B71240FEE0E674952B79ED25D793F1B31B42DD37B80F75B98510B5754"),
+				S:                hexToBytes("1EC44DD7C5389474DF8E5C25CC6ED8B573CCA2E009AA824EE825BDC693935927"),
+				V:                28,
+				EncodedTimestamp: hexToBytes("08CD9296890610EAE9963D"),
+			},
+		},
+	}
+
+	result, err := blockRelay.encodeToEthData()
+	require.Nil(t, err)
+	expect := hexToBytes(
+		"00000000000000000000000000000000000000000000000000000000000001603f02642d9e70d5c1c493a4f732bfe9c9b95a4a42651703b816edcfc8fada531200000000000000000000000000000000000000000000000000000000000061a8000000000000000000000000000000000000000000000000000000006125894b000000000000000000000000000000000000000000000000000000001152f9089b4825c99c3e739e1dc171ffb0e2bf34e99eee41b34e407e40cf594834427b09bf23413f237906b07202b3355e7311651ace6bd2a34fd6fc3bd98efe4fb787559fb9c7533caf1d218da3af6d277f6b101c42e3c3b75d784242da663604dd53c27d11a74e40884411901bd7a70631734990b1fdbf5de9e4c92c63b7650a6a665900000000000000000000000000000000000000000000000000000000000002a0000000000000000000000000000000000000000000000000000000000000038000000000000000000000000000000000000000000000000000000000000000605248463e932d16f7d092e268c0ded87b23d3b0e71856f1c6ae2aa91f6c71332000000000000000000000000000000000000000000000000000000000000000a000000000000000000000000000000000000000000000000000000000000000056665656473000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000017fd5f5c7c2920c187618542901cdc5717be8204f24be856e80902a1bb04737e40000000000000000000000000000000000000000000000000000000000000000f981716562a49de06e3dcafbfb6388c294baa4fa9d45777e25740a92f81cf65e00000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000080000000000000000000000000000000000000000000000000000000000000000f080211a86100000000000022480a20000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000261224080112206bf91efba26a4cd86ebbd0e54dcfc9bd2c790859cfa96215661a47e4921a630100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000002084b8585b71240fee0e674952b79ed25d793f1b31b42dd37b80f75b98510b57541ec44dd7c5389474df8e5c25cc6ed8b573cca2e009aa824ee825bdc693935927000000000000000000000000000000000000000000000000000000000000001c0000000000000000000000000000000000000000000000000000000000000080000000000000000000000000000000000000000000000000000000000000000b08cd9296890610eae9963d000000000000000000000000000000000000000000",
+	)
+	require.Equal(t, expect, result)
+}
+
+func TestEncodeVerifyPrice(t *testing.T) {
+	data := PriceDataProof{
+		Price:   testPrices[0],
+		Version: 217,
+		MerklePaths: []IAVLMerklePath{
+			{
+				IsDataOnRight:  true,
+				SubtreeHeight:  1,
+				SubtreeSize:    2,
+				SubtreeVersion: 217,
+				SiblingHash:    hexToBytes("EB739BB22F48B7F3053A90BA2BA4FE07FAB262CADF8664489565C50FF505B8BD"),
+			},
+		},
+	}
+
+	result, err := data.encodeToEthData(25000)
+	require.Nil(t, err)
+	expect := hexToBytes(
+		"00000000000000000000000000000000000000000000000000000000000061a8000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000d90000000000000000000000000000000000000000000000000000000000000140000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000000000000059682f0000000000000000000000000000000000000000000000000000000000670e7240000000000000000000000000000000000000000000000000000000000000000b43533a42414e442d555344000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000d9eb739bb22f48b7f3053a90ba2ba4fe07fab262cadf8664489565c50ff505b8bd",
+	)
+	require.Equal(t, expect, result)
+}
+
+func TestEncodeVerifyPacket(t *testing.T) {
+	data := PacketDataProof{
+		Packet:        testPacket,
+		EncodedPacket: tmbytes.HexBytes(hexToBytes("08011002")),
+		Version:       217,
+		MerklePaths: []IAVLMerklePath{
+			{
+				IsDataOnRight:  false,
+				SubtreeHeight:  1,
+				SubtreeSize:    2,
+				SubtreeVersion: 217,
+				SiblingHash:    hexToBytes("EB739BB22F48B7F3053A90BA2BA4FE07FAB262CADF8664489565C50FF505B8BD"),
+			},
+		},
+	}
+
+	result, err := data.encodeToEthData(25000)
+	require.Nil(t, err)
+	expect := hexToBytes(
+		"00000000000000000000000000000000000000000000000000000000000061a80000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000d9000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000040801100200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000d9eb739bb22f48b7f3053a90ba2ba4fe07fab262cadf8664489565c50ff505b8bd",
+	)
+	require.Equal(t, expect, result)
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bandprotocol/chain/v3/cylinder/context"
+	"github.com/bandprotocol/chain/v3/cylinder/store"
+)
+
+const (
+	flagNewKeyFile = "new-key-file"
+
+	// newStorePassphraseEnv is the environment variable of the new passphrase of the encrypted store, which
+	// is used when no new key file is given.
+	newStorePassphraseEnv = "CYLINDER_NEW_STORE_PASSPHRASE"
+)
+
+// encryptStoreCmd returns a Cobra command for encrypting a plaintext store.
+func encryptStoreCmd(ctx *context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "encrypt-store",
+		Short: "Encrypt the data in cylinder's store",
+		Long: fmt.Sprintf(
+			"Encrypt the data in cylinder's store with a key derived from the configured store-key-file or "+
+				"the passphrase in %s. The same secret is required to run cylinder afterward.",
+			context.StorePassphraseEnv,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			secret, err := ctx.StoreSecret()
+			if err != nil {
+				return err
+			}
+			if secret == nil {
+				return fmt.Errorf("store-key-file or %s is required", context.StorePassphraseEnv)
+			}
+
+			// open the store in plaintext to encrypt it
+			db, err := ctx.OpenGoLevelDB()
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			if err := store.NewStore(db).Encrypt(secret); err != nil {
+				return err
+			}
+
+			ctx.Logger.Info(":lock: Successfully encrypted the store")
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// rotateKeyCmd returns a Cobra command for re-encrypting an encrypted store with a new secret.
+func rotateKeyCmd(ctx *context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-key",
+		Short: "Re-encrypt the data in cylinder's store with a new secret",
+		Long: fmt.Sprintf(
+			"Re-encrypt the data in cylinder's store with a key derived from the new key file or the new "+
+				"passphrase in %s. Update store-key-file or %s to the new secret afterward.",
+			newStorePassphraseEnv,
+			context.StorePassphraseEnv,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// get 'new-key-file' flag
+			newKeyFile, err := cmd.Flags().GetString(flagNewKeyFile)
+			if err != nil {
+				return err
+			}
+
+			newSecret, err := context.LoadStoreSecret(newKeyFile, newStorePassphraseEnv)
+			if err != nil {
+				return err
+			}
+			if newSecret == nil {
+				return fmt.Errorf("%s or %s is required", flagNewKeyFile, newStorePassphraseEnv)
+			}
+
+			ctx, err = ctx.WithGoLevelDB()
+			if err != nil {
+				return err
+			}
+			defer ctx.Store.DB.Close()
+
+			if err := ctx.Store.RotateKey(newSecret); err != nil {
+				return err
+			}
+
+			ctx.Logger.Info(":key: Successfully rotated the key of the store")
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagNewKeyFile, "", "The new key file to encrypt the store with")
+
+	return cmd
+}
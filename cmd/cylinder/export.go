@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"os"
 	"strconv"
 
@@ -14,7 +15,8 @@ import (
 )
 
 const (
-	flagOutput = "output"
+	flagOutput    = "output"
+	flagPlaintext = "plaintext"
 )
 
 // exportCmd returns a Cobra command for exporting data from store.
@@ -66,12 +68,12 @@ func exportGroupsCmd(ctx *context.Context) *cobra.Command {
 						return err
 					}
 
-					group, err := ctx.Store.GetGroup(pubKey)
+					groupsByPubKey, err := ctx.Store.GetGroupsByPubKey(pubKey)
 					if err != nil {
 						return err
 					}
 
-					groups = append(groups, group)
+					groups = append(groups, groupsByPubKey...)
 				}
 			}
 
@@ -81,24 +83,12 @@ func exportGroupsCmd(ctx *context.Context) *cobra.Command {
 				return err
 			}
 
-			// create file
-			f, err := os.Create(output)
-			if err != nil {
-				return err
-			}
-			defer f.Close()
-
-			// write data to the file
-			_, err = f.Write(bytes)
-			if err != nil {
-				return err
-			}
-
-			return nil
+			return writeExport(ctx, cmd, output, bytes)
 		},
 	}
 
 	cmd.Flags().String(flagOutput, "", "Specific output filename")
+	cmd.Flags().Bool(flagPlaintext, false, "Export data in plaintext instead of encrypting it with the store secret")
 
 	_ = cmd.MarkFlagRequired(flagOutput)
 
@@ -153,24 +143,12 @@ func exportDKGsCmd(ctx *context.Context) *cobra.Command {
 				return err
 			}
 
-			// create file
-			f, err := os.Create(output)
-			if err != nil {
-				return err
-			}
-			defer f.Close()
-
-			// write data to the file
-			_, err = f.Write(bytes)
-			if err != nil {
-				return err
-			}
-
-			return nil
+			return writeExport(ctx, cmd, output, bytes)
 		},
 	}
 
 	cmd.Flags().String(flagOutput, "", "Specific output filename")
+	cmd.Flags().Bool(flagPlaintext, false, "Export data in plaintext instead of encrypting it with the store secret")
 
 	_ = cmd.MarkFlagRequired(flagOutput)
 
@@ -207,26 +185,46 @@ func exportDEsCmd(ctx *context.Context) *cobra.Command {
 				return err
 			}
 
-			// create file
-			f, err := os.Create(output)
-			if err != nil {
-				return err
-			}
-			defer f.Close()
-
-			// write data to the file
-			_, err = f.Write(bytes)
-			if err != nil {
-				return err
-			}
-
-			return nil
+			return writeExport(ctx, cmd, output, bytes)
 		},
 	}
 
 	cmd.Flags().String(flagOutput, "", "Specific output filename")
+	cmd.Flags().Bool(flagPlaintext, false, "Export data in plaintext instead of encrypting it with the store secret")
 
 	_ = cmd.MarkFlagRequired(flagOutput)
 
 	return cmd
 }
+
+// writeExport writes the exported data to the output file. The data is encrypted with the store secret
+// unless the plaintext flag is set.
+func writeExport(ctx *context.Context, cmd *cobra.Command, output string, data []byte) error {
+	// get 'plaintext' flag
+	plaintext, err := cmd.Flags().GetBool(flagPlaintext)
+	if err != nil {
+		return err
+	}
+
+	if !plaintext {
+		secret, err := ctx.StoreSecret()
+		if err != nil {
+			return err
+		}
+		if secret == nil {
+			return fmt.Errorf(
+				"store-key-file or %s is required to encrypt the export; use --%s to export in plaintext",
+				context.StorePassphraseEnv,
+				flagPlaintext,
+			)
+		}
+
+		data, err = store.SealExport(secret, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	// write data to the file that is readable only by the owner
+	return os.WriteFile(output, data, 0o600)
+}
@@ -52,6 +52,12 @@ func importGroupsCmd(ctx *context.Context) *cobra.Command {
 				return err
 			}
 
+			// decrypt the file if it is encrypted
+			bytes, err = openExport(ctx, bytes)
+			if err != nil {
+				return err
+			}
+
 			// unmarshal json to data
 			var groups []store.Group
 			err = json.Unmarshal(bytes, &groups)
@@ -98,6 +104,12 @@ func importDKGsCmd(ctx *context.Context) *cobra.Command {
 				return err
 			}
 
+			// decrypt the file if it is encrypted
+			bytes, err = openExport(ctx, bytes)
+			if err != nil {
+				return err
+			}
+
 			// unmarshal json to data
 			var dkgs []store.DKG
 			err = json.Unmarshal(bytes, &dkgs)
@@ -145,6 +157,12 @@ func importDEsCmd(ctx *context.Context) *cobra.Command {
 				return err
 			}
 
+			// decrypt the file if it is encrypted
+			bytes, err = openExport(ctx, bytes)
+			if err != nil {
+				return err
+			}
+
 			// unmarshal json to data
 			var des []store.DE
 			if err := json.Unmarshal(bytes, &des); err != nil {
@@ -165,3 +183,14 @@ func importDEsCmd(ctx *context.Context) *cobra.Command {
 
 	return cmd
 }
+
+// openExport returns the exported data from the file content, decrypting it with the store secret if it is
+// encrypted.
+func openExport(ctx *context.Context, bytes []byte) ([]byte, error) {
+	secret, err := ctx.StoreSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	return store.OpenExport(secret, bytes)
+}
@@ -77,6 +77,8 @@ func NewRootCmd(ctx *context.Context) *cobra.Command {
 		exportCmd(ctx),
 		runCmd(ctx),
 		removeUnusedDECmd(ctx),
+		encryptStoreCmd(ctx),
+		rotateKeyCmd(ctx),
 		version.NewVersionCommand(),
 	)
 
@@ -32,6 +32,7 @@ const (
 	flagCheckDEInterval     = "check-de-interval"
 	flagCheckStatusInterval = "check-status-interval"
 	flagMetricsListenAddr   = "metrics-listen-addr"
+	flagCurves              = "curves"
 )
 
 // runCmd returns a Cobra command to run the cylinder process.
@@ -123,12 +124,13 @@ func runCmd(ctx *context.Context) *cobra.Command {
 	cmd.Flags().Duration(flagCheckDEInterval, time.Minute, "The interval of checking DE")
 	cmd.Flags().Duration(flagCheckStatusInterval, time.Minute, "The interval of checking the status of the member")
 	cmd.Flags().String(flagMetricsListenAddr, "", "address to use for metrics server.")
+	cmd.Flags().StringSlice(flagCurves, []string{"secp256k1"}, "The curves of DEs to be generated (secp256k1, ed25519)")
 
 	flagNames := []string{
 		flags.FlagChainID, flags.FlagNode, flagGranter, flags.FlagGasPrices, flagLogLevel,
 		flagBroadcastTimeout, flagRPCPollInterval, flagMaxTry,
 		flagGasAdjustStart, flagGasAdjustStep, flagRandomSecret, flagCheckDEInterval,
-		flagCheckStatusInterval, flagMetricsListenAddr,
+		flagCheckStatusInterval, flagMetricsListenAddr, flagCurves,
 	}
 
 	for _, flagName := range flagNames {
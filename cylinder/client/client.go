@@ -151,13 +151,19 @@ func (c *Client) QuerySigning(signingID tss.SigningID) (*SigningResponse, error)
 	return NewSigningResponse(sr), nil
 }
 
-// QueryDE queries the DE information with the given address.
+// QueryDE queries the DE information on the given curve with the given address.
 // It returns the de response or an error.
-func (c *Client) QueryDE(address string, offset uint64, limit uint64) (*DEResponse, error) {
+func (c *Client) QueryDE(
+	address string,
+	curve tsstypes.Curve,
+	offset uint64,
+	limit uint64,
+) (*DEResponse, error) {
 	queryClient := tsstypes.NewQueryClient(c.context)
 
 	input := &tsstypes.QueryDERequest{
 		Address: address,
+		Curve:   curve,
 		Pagination: &query.PageRequest{
 			Offset:     offset,
 			Limit:      limit,
@@ -200,30 +206,33 @@ func (c *Client) QueryDE(address string, offset uint64, limit uint64) (*DERespon
 	return resp, nil
 }
 
-// QueryAllDE queries all DEs with the given address.
+// QueryAllDE queries all DEs on every curve with the given address.
 func (c *Client) QueryAllDE(address string) ([]tsstypes.DE, error) {
 	des := make([]tsstypes.DE, 0)
 	queryClient := tsstypes.NewQueryClient(c.context)
 
-	var nextKey []byte
-	for {
-		input := &tsstypes.QueryDERequest{
-			Address: address,
-			Pagination: &query.PageRequest{
-				Key: nextKey,
-			},
-		}
+	for _, curve := range tsstypes.Curves() {
+		var nextKey []byte
+		for {
+			input := &tsstypes.QueryDERequest{
+				Address: address,
+				Curve:   curve,
+				Pagination: &query.PageRequest{
+					Key: nextKey,
+				},
+			}
 
-		res, err := queryWithRetry(queryClient.DE, input, c.maxTry, c.pollInterval)
-		if err != nil {
-			return nil, err
-		}
+			res, err := queryWithRetry(queryClient.DE, input, c.maxTry, c.pollInterval)
+			if err != nil {
+				return nil, err
+			}
 
-		des = append(des, res.DEs...)
+			des = append(des, res.DEs...)
 
-		nextKey = res.GetPagination().GetNextKey()
-		if len(nextKey) == 0 {
-			break
+			nextKey = res.GetPagination().GetNextKey()
+			if len(nextKey) == 0 {
+				break
+			}
 		}
 	}
 
@@ -73,3 +73,30 @@ func (gr GroupResult) IsMember(address string) bool {
 	_, err := gr.GetMemberID(address)
 	return err == nil
 }
+
+// GetMember retrieves the member for the specified member ID.
+func (gr GroupResult) GetMember(mid tss.MemberID) (types.Member, error) {
+	for _, member := range gr.Members {
+		if member.ID == mid {
+			return member, nil
+		}
+	}
+
+	return types.Member{}, fmt.Errorf("no member with MemberID(%d)", mid)
+}
+
+// IsDealer returns boolean to show if the member deals a secret in the DKG process of the group.
+// Every member deals in a group that doesn't reshare the secret of another group.
+func (gr GroupResult) IsDealer(mid tss.MemberID) bool {
+	if gr.Group.SourceGroupID == 0 {
+		return true
+	}
+
+	member, err := gr.GetMember(mid)
+	return err == nil && gr.Group.IsDealer(member)
+}
+
+// GetSourceDealerIDs returns the member IDs in the source group of all dealers of a reshared group.
+func (gr GroupResult) GetSourceDealerIDs() []tss.MemberID {
+	return types.Members(gr.Members).GetSourceIDs()
+}
@@ -182,3 +182,50 @@ func TestGetEncryptedSecretShare(t *testing.T) {
 		})
 	}
 }
+
+func TestIsDealer(t *testing.T) {
+	members := []types.Member{
+		{ID: 1, SourceMemberID: 2},
+		{ID: 2, SourceMemberID: 0},
+		{ID: 3, SourceMemberID: 1},
+	}
+
+	tests := []struct {
+		name                    string
+		queryGroupResponse      *types.QueryGroupResponse
+		expectedDealers         map[tss.MemberID]bool
+		expectedSourceDealerIDs []tss.MemberID
+	}{
+		{
+			name: "New group",
+			queryGroupResponse: &types.QueryGroupResponse{
+				GroupResult: types.GroupResult{
+					Members: members,
+				},
+			},
+			expectedDealers:         map[tss.MemberID]bool{1: true, 2: true, 3: true},
+			expectedSourceDealerIDs: []tss.MemberID{2, 1},
+		},
+		{
+			name: "Reshared group",
+			queryGroupResponse: &types.QueryGroupResponse{
+				GroupResult: types.GroupResult{
+					Group:   types.Group{SourceGroupID: 1},
+					Members: members,
+				},
+			},
+			expectedDealers:         map[tss.MemberID]bool{1: true, 2: false, 3: true, 4: false},
+			expectedSourceDealerIDs: []tss.MemberID{2, 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			groupResponse := client.NewGroupResult(test.queryGroupResponse)
+			for mid, expected := range test.expectedDealers {
+				assert.Equal(t, expected, groupResponse.IsDealer(mid))
+			}
+			assert.Equal(t, test.expectedSourceDealerIDs, groupResponse.GetSourceDealerIDs())
+		})
+	}
+}
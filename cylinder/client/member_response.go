@@ -18,5 +18,6 @@ func NewMemberResponse(mr *bandtsstypes.QueryMemberResponse) *MemberResponse {
 	if mr.IncomingGroupMember.Address != "" {
 		members = append(members, mr.IncomingGroupMember)
 	}
+	members = append(members, mr.NamedGroupMembers...)
 	return &MemberResponse{members}
 }
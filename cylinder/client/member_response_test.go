@@ -73,6 +73,32 @@ func TestNewMemberResponse(t *testing.T) {
 			},
 			expectedAddrs: []string{"band address 2"},
 		},
+		{
+			name: "current and named group members present",
+			queryResponse: &bandtsstypes.QueryMemberResponse{
+				CurrentGroupMember: bandtsstypes.Member{
+					Address:  "band address 1",
+					GroupID:  1,
+					IsActive: true,
+					Since:    fixedTime,
+				},
+				IncomingGroupMember: bandtsstypes.Member{
+					Address:  "",
+					GroupID:  0,
+					IsActive: false,
+					Since:    time.Time{},
+				},
+				NamedGroupMembers: []bandtsstypes.Member{
+					{
+						Address:  "band address 1",
+						GroupID:  3,
+						IsActive: true,
+						Since:    fixedTime,
+					},
+				},
+			},
+			expectedAddrs: []string{"band address 1", "band address 1"},
+		},
 		{
 			name: "no members present",
 			queryResponse: &bandtsstypes.QueryMemberResponse{
@@ -1,7 +1,9 @@
 package context
 
 import (
+	"bytes"
 	"fmt"
+	"os"
 	"path/filepath"
 	"time"
 
@@ -36,8 +38,14 @@ type Config struct {
 	CheckDEInterval     time.Duration `mapstructure:"check-de-interval"`     // The interval for updating DE
 	CheckStatusInterval time.Duration `mapstructure:"check-status-interval"` // The interval for checking the status of the member
 	MetricsListenAddr   string        `mapstructure:"metrics-listen-addr"`   // Address to use for metrics server
+	StoreKeyFile        string        `mapstructure:"store-key-file"`        // The key file of the encrypted store
+	Curves              []string      `mapstructure:"curves"`                // The curves of DEs to be generated
 }
 
+// StorePassphraseEnv is the environment variable of the passphrase of the encrypted store, which is used
+// when no key file is configured.
+const StorePassphraseEnv = "CYLINDER_STORE_PASSPHRASE"
+
 // Context holds the context information for the Cylinder process.
 type Context struct {
 	Config            *Config
@@ -91,17 +99,27 @@ func (ctx *Context) InitLog() error {
 	return nil
 }
 
-// WithGoLevelDB initializes the database of the context with GoLevelDB.
-func (ctx *Context) WithGoLevelDB() (*Context, error) {
+// OpenGoLevelDB opens the GoLevelDB database of cylinder.
+func (ctx *Context) OpenGoLevelDB() (dbm.DB, error) {
 	db, err := dbm.NewDB("cylinder", dbm.GoLevelDBBackend, ctx.DataDir)
 	if err != nil {
 		return nil, fmt.Errorf("%w; possibly due to being run in another process", err)
 	}
 
+	return db, nil
+}
+
+// WithGoLevelDB initializes the database of the context with GoLevelDB.
+func (ctx *Context) WithGoLevelDB() (*Context, error) {
+	db, err := ctx.OpenGoLevelDB()
+	if err != nil {
+		return nil, err
+	}
+
 	return ctx.WithDB(db)
 }
 
-// WithDB sets the DB for the context.
+// WithDB sets the DB for the context and opens the store with the store secret.
 func (ctx *Context) WithDB(db dbm.DB) (*Context, error) {
 	if ctx.Store != nil {
 		if err := ctx.Store.DB.Close(); err != nil {
@@ -109,6 +127,54 @@ func (ctx *Context) WithDB(db dbm.DB) (*Context, error) {
 		}
 	}
 
-	ctx.Store = store.NewStore(db)
+	secret, err := ctx.StoreSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.Store, err = store.OpenStore(db, secret)
+	if err != nil {
+		return nil, err
+	}
+
 	return ctx, nil
 }
+
+// StoreSecret returns the secret of the encrypted store from the configured key file or the passphrase in
+// the environment, or nil if neither is provided.
+func (ctx *Context) StoreSecret() ([]byte, error) {
+	keyFile := ""
+	if ctx.Config != nil {
+		keyFile = ctx.Config.StoreKeyFile
+	}
+
+	return LoadStoreSecret(keyFile, StorePassphraseEnv)
+}
+
+// LoadStoreSecret returns the content of the given key file, or the passphrase in the given environment
+// variable if no key file is given, or nil if neither is provided.
+func LoadStoreSecret(keyFile string, passphraseEnv string) ([]byte, error) {
+	passphrase := os.Getenv(passphraseEnv)
+	if keyFile == "" {
+		if passphrase == "" {
+			return nil, nil
+		}
+		return []byte(passphrase), nil
+	}
+
+	if passphrase != "" {
+		return nil, fmt.Errorf("both key file and passphrase in %s are provided", passphraseEnv)
+	}
+
+	secret, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+
+	secret = bytes.TrimSpace(secret)
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("key file %s is empty", keyFile)
+	}
+
+	return secret, nil
+}
@@ -0,0 +1,207 @@
+package store
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+const (
+	// defaultKDFTime is the default number of passes of Argon2id over the memory.
+	defaultKDFTime = 3
+	// defaultKDFMemory is the default memory of Argon2id in KiB.
+	defaultKDFMemory = 64 * 1024
+	// defaultKDFThreads is the default number of threads of Argon2id.
+	defaultKDFThreads = 4
+	// kdfSaltSize is the size of the random salt of Argon2id.
+	kdfSaltSize = 16
+	// sealedExportVersion is the version of the format of encrypted exports.
+	sealedExportVersion = 1
+)
+
+var (
+	// encryptionCheckValue is encrypted into the encryption information to verify the secret.
+	encryptionCheckValue = []byte("cylinder")
+	// sealedExportAD is the associated data of encrypted exports.
+	sealedExportAD = []byte("cylinder-export")
+
+	// ErrStoreEncrypted is returned when an encrypted store is opened without a secret.
+	ErrStoreEncrypted = errors.New("store is encrypted; a store passphrase or key file is required")
+	// ErrStoreNotEncrypted is returned when a plaintext store is opened with a secret.
+	ErrStoreNotEncrypted = errors.New("store is not encrypted; run `cylinder encrypt-store` to encrypt it")
+	// ErrInvalidSecret is returned when the secret cannot decrypt the store or the export.
+	ErrInvalidSecret = errors.New("invalid store passphrase or key file")
+)
+
+// KDFParams represents the parameters of Argon2id that derives an encryption key from a secret.
+type KDFParams struct {
+	Salt    []byte `json:"salt"`    // Random salt of the derivation
+	Time    uint32 `json:"time"`    // Number of passes over the memory
+	Memory  uint32 `json:"memory"`  // Memory in KiB
+	Threads uint8  `json:"threads"` // Number of threads
+}
+
+// NewKDFParams returns the default KDF parameters with a new random salt.
+func NewKDFParams() (KDFParams, error) {
+	salt := make([]byte, kdfSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return KDFParams{}, err
+	}
+
+	return KDFParams{
+		Salt:    salt,
+		Time:    defaultKDFTime,
+		Memory:  defaultKDFMemory,
+		Threads: defaultKDFThreads,
+	}, nil
+}
+
+// Cipher encrypts and decrypts data with XChaCha20-Poly1305 using a key derived from a secret.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher creates a new Cipher with the key derived from the given secret and KDF parameters.
+func NewCipher(secret []byte, params KDFParams) (*Cipher, error) {
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("secret must not be empty")
+	}
+	if len(params.Salt) == 0 || params.Time == 0 || params.Memory == 0 || params.Threads == 0 {
+		return nil, fmt.Errorf("invalid KDF parameters")
+	}
+
+	key := argon2.IDKey(secret, params.Salt, params.Time, params.Memory, params.Threads, chacha20poly1305.KeySize)
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cipher{aead: aead}, nil
+}
+
+// Seal encrypts and authenticates the plaintext and the associated data. The random nonce is prepended
+// to the returned ciphertext.
+func (c *Cipher) Seal(plaintext []byte, ad []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize(), c.aead.NonceSize()+len(plaintext)+c.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return c.aead.Seal(nonce, nonce, plaintext, ad), nil
+}
+
+// Open authenticates and decrypts the ciphertext created by Seal with the same associated data.
+func (c *Cipher) Open(ciphertext []byte, ad []byte) ([]byte, error) {
+	if len(ciphertext) < c.aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext is too short")
+	}
+
+	nonce, sealed := ciphertext[:c.aead.NonceSize()], ciphertext[c.aead.NonceSize():]
+	return c.aead.Open(nil, nonce, sealed, ad)
+}
+
+// EncryptionInfo represents the information of an encrypted store.
+type EncryptionInfo struct {
+	KDF   KDFParams `json:"kdf"`   // Parameters to derive the key of the store from the secret
+	Check []byte    `json:"check"` // Encrypted check value to verify the secret
+}
+
+// newEncryptionInfo creates the encryption information and the cipher of a store from the given secret.
+func newEncryptionInfo(secret []byte) (EncryptionInfo, *Cipher, error) {
+	params, err := NewKDFParams()
+	if err != nil {
+		return EncryptionInfo{}, nil, err
+	}
+
+	c, err := NewCipher(secret, params)
+	if err != nil {
+		return EncryptionInfo{}, nil, err
+	}
+
+	check, err := c.Seal(encryptionCheckValue, EncryptionInfoStoreKey)
+	if err != nil {
+		return EncryptionInfo{}, nil, err
+	}
+
+	return EncryptionInfo{KDF: params, Check: check}, c, nil
+}
+
+// cipher returns the cipher of the store derived from the given secret after verifying the secret.
+func (info EncryptionInfo) cipher(secret []byte) (*Cipher, error) {
+	c, err := NewCipher(secret, info.KDF)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.Open(info.Check, EncryptionInfoStoreKey); err != nil {
+		return nil, ErrInvalidSecret
+	}
+
+	return c, nil
+}
+
+// SealedExport represents exported data that is encrypted with a key derived from the store secret.
+type SealedExport struct {
+	Version    uint32    `json:"version"`    // Version of the format
+	KDF        KDFParams `json:"kdf"`        // Parameters to derive the key from the secret
+	Ciphertext []byte    `json:"ciphertext"` // Encrypted exported data
+}
+
+// SealExport encrypts the exported data with a key derived from the given secret and a new salt, and
+// returns it in the JSON format of SealedExport.
+func SealExport(secret []byte, data []byte) ([]byte, error) {
+	params, err := NewKDFParams()
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := NewCipher(secret, params)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := c.Seal(data, sealedExportAD)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(SealedExport{
+		Version:    sealedExportVersion,
+		KDF:        params,
+		Ciphertext: ciphertext,
+	})
+}
+
+// OpenExport returns the exported data from the given file content. An export in the format of
+// SealedExport is decrypted with the given secret, while a plaintext export is returned as is.
+func OpenExport(secret []byte, bz []byte) ([]byte, error) {
+	var sealed SealedExport
+	if err := json.Unmarshal(bz, &sealed); err != nil {
+		// A plaintext export is a JSON array that cannot be unmarshaled to SealedExport.
+		return bz, nil
+	}
+
+	if sealed.Version != sealedExportVersion {
+		return nil, fmt.Errorf("unsupported export version: %d", sealed.Version)
+	}
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("export is encrypted; a store passphrase or key file is required")
+	}
+
+	c, err := NewCipher(secret, sealed.KDF)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.Open(sealed.Ciphertext, sealedExportAD)
+	if err != nil {
+		return nil, ErrInvalidSecret
+	}
+
+	return data, nil
+}
@@ -4,9 +4,20 @@ import (
 	"github.com/bandprotocol/chain/v3/pkg/tss"
 )
 
-// Group represents a tss group.
+// Group represents a tss group. A group without group ID is stored by its public key only, as groups were
+// stored before reshared groups could share the public key of another group.
 type Group struct {
-	GroupPubKey tss.Point    `json:"group_pub_key"` // Public key of the group
-	MemberID    tss.MemberID `json:"member_id"`     // Member ID associated with the group
-	PrivKey     tss.Scalar   `json:"priv_key"`      // Private key associated with the group
+	GroupID     tss.GroupID  `json:"group_id,omitempty"` // Group ID of the group
+	GroupPubKey tss.Point    `json:"group_pub_key"`      // Public key of the group
+	MemberID    tss.MemberID `json:"member_id"`          // Member ID associated with the group
+	PrivKey     tss.Scalar   `json:"priv_key"`           // Private key associated with the group
+}
+
+// storeKey returns the key that the group is stored with.
+func (g Group) storeKey() []byte {
+	if g.GroupID != 0 {
+		return GroupByIDStoreKey(g.GroupID)
+	}
+
+	return GroupStoreKey(g.GroupPubKey)
 }
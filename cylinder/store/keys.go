@@ -16,6 +16,19 @@ var (
 	GroupStoreKeyPrefix = []byte{0x02}
 	// DEStoreKeyPrefix is the prefix for DE store.
 	DEStoreKeyPrefix = []byte{0x03}
+	// GroupByIDStoreKeyPrefix is the prefix for group store by group ID.
+	GroupByIDStoreKeyPrefix = []byte{0x04}
+
+	// EncryptionInfoStoreKey is the key that keeps the encryption information of an encrypted store.
+	EncryptionInfoStoreKey = append(GlobalStoreKeyPrefix, []byte("encryption")...)
+
+	// dataStoreKeyPrefixes are the prefixes of all stores that keep secret data.
+	dataStoreKeyPrefixes = [][]byte{
+		DKGStoreKeyPrefix,
+		GroupStoreKeyPrefix,
+		DEStoreKeyPrefix,
+		GroupByIDStoreKeyPrefix,
+	}
 )
 
 // DKGStoreKey returns the key to retrieve all data for a group.
@@ -28,6 +41,11 @@ func GroupStoreKey(pubKey tss.Point) []byte {
 	return append(GroupStoreKeyPrefix, pubKey...)
 }
 
+// GroupByIDStoreKey returns the key to retrieve all data for a group by its group ID.
+func GroupByIDStoreKey(groupID tss.GroupID) []byte {
+	return append(GroupByIDStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(groupID))...)
+}
+
 // DEStoreKey returns the key to retrieve private (d, e) by public (D, E).
 func DEStoreKey(pubDE types.DE) []byte {
 	bz := append(DEStoreKeyPrefix, pubDE.PubD...)
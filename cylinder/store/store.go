@@ -1,6 +1,7 @@
 package store
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
@@ -14,19 +15,195 @@ import (
 
 // Store represents a data store for storing data information for Cylinder process
 type Store struct {
-	DB dbm.DB
+	DB     dbm.DB
+	cipher *Cipher // Cipher of the values, nil if the store is not encrypted
 }
 
-// NewStore creates a new instance of Store with the provided database.
+// NewStore creates a new instance of Store with the provided database that keeps values in plaintext.
 func NewStore(db dbm.DB) *Store {
 	return &Store{
 		DB: db,
 	}
 }
 
+// OpenStore opens the store in the provided database. An encrypted store requires the secret that it is
+// encrypted with, while a plaintext store requires no secret.
+func OpenStore(db dbm.DB, secret []byte) (*Store, error) {
+	info, err := GetEncryptionInfo(db)
+	if err != nil {
+		return nil, err
+	}
+
+	if info == nil {
+		if len(secret) != 0 {
+			return nil, ErrStoreNotEncrypted
+		}
+		return NewStore(db), nil
+	}
+
+	if len(secret) == 0 {
+		return nil, ErrStoreEncrypted
+	}
+	c, err := info.cipher(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{DB: db, cipher: c}, nil
+}
+
+// GetEncryptionInfo retrieves the encryption information of the store in the provided database, or nil
+// if the store is not encrypted.
+func GetEncryptionInfo(db dbm.DB) (*EncryptionInfo, error) {
+	bytes, err := db.Get(EncryptionInfoStoreKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes == nil {
+		return nil, nil
+	}
+
+	var info EncryptionInfo
+	err = json.Unmarshal(bytes, &info)
+	if err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// IsEncrypted returns whether the values of the store are encrypted.
+func (s *Store) IsEncrypted() bool {
+	return s.cipher != nil
+}
+
+// Encrypt encrypts all values of a plaintext store with a key derived from the given secret.
+func (s *Store) Encrypt(secret []byte) error {
+	info, err := GetEncryptionInfo(s.DB)
+	if err != nil {
+		return err
+	}
+
+	if s.cipher != nil || info != nil {
+		return fmt.Errorf("store is already encrypted")
+	}
+
+	return s.reencrypt(secret)
+}
+
+// RotateKey re-encrypts all values of an encrypted store with a key derived from the given new secret.
+func (s *Store) RotateKey(newSecret []byte) error {
+	if s.cipher == nil {
+		return ErrStoreNotEncrypted
+	}
+
+	return s.reencrypt(newSecret)
+}
+
+// reencrypt encrypts all values of the store with a key derived from the given secret and a new salt, and
+// writes them together with the new encryption information atomically.
+func (s *Store) reencrypt(secret []byte) error {
+	info, c, err := newEncryptionInfo(secret)
+	if err != nil {
+		return err
+	}
+
+	infoBytes, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	batch := s.DB.NewBatch()
+	defer batch.Close()
+
+	for _, prefix := range dataStoreKeyPrefixes {
+		if err := s.reencryptPrefix(batch, c, prefix); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Set(EncryptionInfoStoreKey, infoBytes); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	s.cipher = c
+	return nil
+}
+
+// reencryptPrefix writes all values with the given prefix encrypted by the given cipher to the batch.
+func (s *Store) reencryptPrefix(batch dbm.Batch, c *Cipher, prefix []byte) error {
+	iterator, err := s.DB.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := append([]byte{}, iterator.Key()...)
+
+		plaintext, err := s.decrypt(key, iterator.Value())
+		if err != nil {
+			return err
+		}
+
+		ciphertext, err := c.Seal(plaintext, key)
+		if err != nil {
+			return err
+		}
+
+		if err := batch.Set(key, ciphertext); err != nil {
+			return err
+		}
+	}
+
+	return iterator.Error()
+}
+
+// marshal marshals the value of the given key to JSON and encrypts it if the store is encrypted.
+func (s *Store) marshal(key []byte, value interface{}) ([]byte, error) {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cipher == nil {
+		return bytes, nil
+	}
+
+	return s.cipher.Seal(bytes, key)
+}
+
+// unmarshal decrypts the bytes of the given key if the store is encrypted and unmarshals them from JSON.
+func (s *Store) unmarshal(key []byte, bytes []byte, value interface{}) error {
+	plaintext, err := s.decrypt(key, bytes)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(plaintext, value)
+}
+
+// decrypt decrypts the bytes of the given key if the store is encrypted.
+func (s *Store) decrypt(key []byte, bytes []byte) ([]byte, error) {
+	if s.cipher == nil {
+		return bytes, nil
+	}
+
+	plaintext, err := s.cipher.Open(bytes, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt value of key %X: %w", key, err)
+	}
+
+	return plaintext, nil
+}
+
 // SetDKG stores the dkg information by the given group id.
 func (s *Store) SetDKG(dkg DKG) error {
-	bytes, err := json.Marshal(dkg)
+	bytes, err := s.marshal(DKGStoreKey(dkg.GroupID), dkg)
 	if err != nil {
 		return err
 	}
@@ -45,7 +222,7 @@ func (s *Store) GetAllDKGs() ([]DKG, error) {
 	dkgs := make([]DKG, 0) // prevent nil slice when exporting data.
 	for ; iterator.Valid(); iterator.Next() {
 		var dkg DKG
-		err = json.Unmarshal(iterator.Value(), &dkg)
+		err = s.unmarshal(iterator.Key(), iterator.Value(), &dkg)
 		if err != nil {
 			return nil, err
 		}
@@ -68,7 +245,7 @@ func (s *Store) GetDKG(groupID tss.GroupID) (DKG, error) {
 	}
 
 	var dkg DKG
-	err = json.Unmarshal(bytes, &dkg)
+	err = s.unmarshal(DKGStoreKey(groupID), bytes, &dkg)
 	if err != nil {
 		return DKG{}, err
 	}
@@ -81,39 +258,67 @@ func (s *Store) DeleteDKG(groupID tss.GroupID) error {
 	return s.DB.DeleteSync(DKGStoreKey(groupID))
 }
 
-// SetGroup stores the group information
+// SetGroup stores the group information by its group ID, or by its public key if the group ID is not set.
 func (s *Store) SetGroup(group Group) error {
-	bytes, err := json.Marshal(group)
+	bytes, err := s.marshal(group.storeKey(), group)
 	if err != nil {
 		return err
 	}
 
-	return s.DB.Set(GroupStoreKey(group.GroupPubKey), bytes)
+	return s.DB.Set(group.storeKey(), bytes)
 }
 
 // GetAllGroups retrieves all groups information
 func (s *Store) GetAllGroups() ([]Group, error) {
-	iterator, err := s.DB.Iterator(GroupStoreKeyPrefix, storetypes.PrefixEndBytes(GroupStoreKeyPrefix))
+	groups := make([]Group, 0) // prevent nil slice when exporting data.
+	for _, prefix := range [][]byte{GroupStoreKeyPrefix, GroupByIDStoreKeyPrefix} {
+		iterator, err := s.DB.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+		if err != nil {
+			return nil, err
+		}
+
+		for ; iterator.Valid(); iterator.Next() {
+			var group Group
+			err = s.unmarshal(iterator.Key(), iterator.Value(), &group)
+			if err != nil {
+				iterator.Close()
+				return nil, err
+			}
+
+			groups = append(groups, group)
+		}
+
+		err = iterator.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return groups, nil
+}
+
+// GetGroupsByPubKey retrieves all groups information with the given public key.
+func (s *Store) GetGroupsByPubKey(pubKey tss.Point) ([]Group, error) {
+	groups, err := s.GetAllGroups()
 	if err != nil {
 		return nil, err
 	}
-	defer iterator.Close()
 
-	groups := make([]Group, 0) // prevent nil slice when exporting data.
-	for ; iterator.Valid(); iterator.Next() {
-		var group Group
-		err = json.Unmarshal(iterator.Value(), &group)
-		if err != nil {
-			return nil, err
+	var matched []Group
+	for _, group := range groups {
+		if bytes.Equal(group.GroupPubKey, pubKey) {
+			matched = append(matched, group)
 		}
+	}
 
-		groups = append(groups, group)
+	if len(matched) == 0 {
+		return nil, fmt.Errorf("group with public key (%s) doesn't exist", pubKey)
 	}
 
-	return groups, err
+	return matched, nil
 }
 
-// GetGroup retrieves the group information by the given public key.
+// GetGroup retrieves the group information stored by the given public key.
 func (s *Store) GetGroup(pubKey tss.Point) (Group, error) {
 	bytes, err := s.DB.Get(GroupStoreKey(pubKey))
 	if err != nil {
@@ -125,7 +330,27 @@ func (s *Store) GetGroup(pubKey tss.Point) (Group, error) {
 	}
 
 	var group Group
-	err = json.Unmarshal(bytes, &group)
+	err = s.unmarshal(GroupStoreKey(pubKey), bytes, &group)
+	if err != nil {
+		return Group{}, err
+	}
+
+	return group, err
+}
+
+// GetGroupByID retrieves the group information stored by the given group ID.
+func (s *Store) GetGroupByID(groupID tss.GroupID) (Group, error) {
+	bytes, err := s.DB.Get(GroupByIDStoreKey(groupID))
+	if err != nil {
+		return Group{}, err
+	}
+
+	if bytes == nil {
+		return Group{}, fmt.Errorf("group with group ID (%d) doesn't exist", groupID)
+	}
+
+	var group Group
+	err = s.unmarshal(GroupByIDStoreKey(groupID), bytes, &group)
 	if err != nil {
 		return Group{}, err
 	}
@@ -133,9 +358,20 @@ func (s *Store) GetGroup(pubKey tss.Point) (Group, error) {
 	return group, err
 }
 
+// GetGroupByIDOrPubKey retrieves the group information stored by the given group ID, or stored by the given
+// public key if the group is not stored by its group ID.
+func (s *Store) GetGroupByIDOrPubKey(groupID tss.GroupID, pubKey tss.Point) (Group, error) {
+	group, err := s.GetGroupByID(groupID)
+	if err == nil {
+		return group, nil
+	}
+
+	return s.GetGroup(pubKey)
+}
+
 // SetDE stores the private (d, E)
 func (s *Store) SetDE(privDE DE) error {
-	bytes, err := json.Marshal(privDE)
+	bytes, err := s.marshal(DEStoreKey(privDE.PubDE), privDE)
 	if err != nil {
 		return err
 	}
@@ -154,7 +390,7 @@ func (s *Store) GetAllDEs() ([]DE, error) {
 	des := make([]DE, 0) // prevent nil slice when exporting data.
 	for ; iterator.Valid(); iterator.Next() {
 		var de DE
-		err = json.Unmarshal(iterator.Value(), &de)
+		err = s.unmarshal(iterator.Key(), iterator.Value(), &de)
 		if err != nil {
 			return nil, err
 		}
@@ -177,7 +413,7 @@ func (s *Store) GetDE(pubDE types.DE) (DE, error) {
 	}
 
 	var de DE
-	err = json.Unmarshal(bytes, &de)
+	err = s.unmarshal(DEStoreKey(pubDE), bytes, &de)
 	if err != nil {
 		return DE{}, err
 	}
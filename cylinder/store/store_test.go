@@ -0,0 +1,215 @@
+package store_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/bandprotocol/chain/v3/cylinder/store"
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/x/tss/types"
+)
+
+var (
+	testGroup = store.Group{
+		GroupPubKey: tss.Point([]byte("group-pub-key")),
+		MemberID:    1,
+		PrivKey:     tss.Scalar([]byte("group-priv-key")),
+	}
+	testReshareGroup = store.Group{
+		GroupID:     2,
+		GroupPubKey: tss.Point([]byte("group-pub-key")),
+		MemberID:    3,
+		PrivKey:     tss.Scalar([]byte("reshare-group-priv-key")),
+	}
+	testDKG = store.DKG{
+		GroupID:        1,
+		MemberID:       1,
+		Coefficients:   tss.Scalars{[]byte("coefficient")},
+		OneTimePrivKey: tss.Scalar([]byte("one-time-priv-key")),
+	}
+	testDE = store.DE{
+		PubDE: types.DE{PubD: []byte("pub-d"), PubE: []byte("pub-e")},
+		PrivD: tss.Scalar([]byte("priv-d")),
+		PrivE: tss.Scalar([]byte("priv-e")),
+	}
+)
+
+func setTestData(t *testing.T, s *store.Store) {
+	require.NoError(t, s.SetGroup(testGroup))
+	require.NoError(t, s.SetGroup(testReshareGroup))
+	require.NoError(t, s.SetDKG(testDKG))
+	require.NoError(t, s.SetDE(testDE))
+}
+
+func requireTestData(t *testing.T, s *store.Store) {
+	group, err := s.GetGroup(testGroup.GroupPubKey)
+	require.NoError(t, err)
+	require.Equal(t, testGroup, group)
+
+	group, err = s.GetGroupByID(testReshareGroup.GroupID)
+	require.NoError(t, err)
+	require.Equal(t, testReshareGroup, group)
+
+	dkgs, err := s.GetAllDKGs()
+	require.NoError(t, err)
+	require.Equal(t, []store.DKG{testDKG}, dkgs)
+
+	de, err := s.GetDE(testDE.PubDE)
+	require.NoError(t, err)
+	require.Equal(t, testDE, de)
+}
+
+// requireNoPlaintext checks that no value in the database contains the private keys in plaintext.
+func requireNoPlaintext(t *testing.T, db dbm.DB) {
+	iterator, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		for _, secret := range [][]byte{
+			testGroup.PrivKey,
+			testReshareGroup.PrivKey,
+			testDKG.OneTimePrivKey,
+			testDE.PrivD,
+			testDE.PrivE,
+		} {
+			encoded, err := json.Marshal(secret)
+			require.NoError(t, err)
+			require.False(t, bytes.Contains(iterator.Value(), encoded))
+		}
+	}
+}
+
+func TestEncryptStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	s, err := store.OpenStore(db, nil)
+	require.NoError(t, err)
+	require.False(t, s.IsEncrypted())
+	setTestData(t, s)
+
+	// Encrypt the plaintext store.
+	secret := []byte("passphrase")
+	require.NoError(t, s.Encrypt(secret))
+	require.True(t, s.IsEncrypted())
+	requireTestData(t, s)
+	requireNoPlaintext(t, db)
+	require.EqualError(t, s.Encrypt(secret), "store is already encrypted")
+
+	// Reopen the encrypted store.
+	_, err = store.OpenStore(db, nil)
+	require.ErrorIs(t, err, store.ErrStoreEncrypted)
+	_, err = store.OpenStore(db, []byte("wrong passphrase"))
+	require.ErrorIs(t, err, store.ErrInvalidSecret)
+	require.EqualError(t, store.NewStore(db).Encrypt(secret), "store is already encrypted")
+
+	s, err = store.OpenStore(db, secret)
+	require.NoError(t, err)
+	requireTestData(t, s)
+
+	// New data is also encrypted.
+	newDE := store.DE{
+		PubDE: types.DE{PubD: []byte("pub-d-2"), PubE: []byte("pub-e-2")},
+		PrivD: tss.Scalar([]byte("priv-d")),
+		PrivE: tss.Scalar([]byte("priv-e")),
+	}
+	require.NoError(t, s.SetDE(newDE))
+	requireNoPlaintext(t, db)
+	des, err := s.GetAllDEs()
+	require.NoError(t, err)
+	require.Len(t, des, 2)
+}
+
+func TestOpenPlaintextStoreWithSecret(t *testing.T) {
+	_, err := store.OpenStore(dbm.NewMemDB(), []byte("passphrase"))
+	require.ErrorIs(t, err, store.ErrStoreNotEncrypted)
+}
+
+func TestRotateKey(t *testing.T) {
+	db := dbm.NewMemDB()
+	s := store.NewStore(db)
+	setTestData(t, s)
+	require.ErrorIs(t, s.RotateKey([]byte("passphrase")), store.ErrStoreNotEncrypted)
+
+	require.NoError(t, s.Encrypt([]byte("old passphrase")))
+	require.NoError(t, s.RotateKey([]byte("new passphrase")))
+	requireTestData(t, s)
+
+	_, err := store.OpenStore(db, []byte("old passphrase"))
+	require.ErrorIs(t, err, store.ErrInvalidSecret)
+	s, err = store.OpenStore(db, []byte("new passphrase"))
+	require.NoError(t, err)
+	requireTestData(t, s)
+	requireNoPlaintext(t, db)
+}
+
+func TestEncryptedValueBoundToKey(t *testing.T) {
+	db := dbm.NewMemDB()
+	s := store.NewStore(db)
+	require.NoError(t, s.Encrypt([]byte("passphrase")))
+	require.NoError(t, s.SetDE(testDE))
+
+	// A ciphertext moved to another key cannot be decrypted.
+	otherPubDE := types.DE{PubD: []byte("pub-d-2"), PubE: []byte("pub-e-2")}
+	value, err := db.Get(store.DEStoreKey(testDE.PubDE))
+	require.NoError(t, err)
+	require.NoError(t, db.Set(store.DEStoreKey(otherPubDE), value))
+
+	_, err = s.GetDE(otherPubDE)
+	require.ErrorContains(t, err, "failed to decrypt value")
+}
+
+func TestSealExport(t *testing.T) {
+	data := []byte(`[{"priv_key":"c2VjcmV0"}]`)
+	secret := []byte("passphrase")
+
+	sealed, err := store.SealExport(secret, data)
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(sealed, data))
+
+	opened, err := store.OpenExport(secret, sealed)
+	require.NoError(t, err)
+	require.Equal(t, data, opened)
+
+	_, err = store.OpenExport([]byte("wrong passphrase"), sealed)
+	require.ErrorIs(t, err, store.ErrInvalidSecret)
+	_, err = store.OpenExport(nil, sealed)
+	require.EqualError(t, err, "export is encrypted; a store passphrase or key file is required")
+
+	// A plaintext export is returned as is.
+	opened, err = store.OpenExport(nil, data)
+	require.NoError(t, err)
+	require.Equal(t, data, opened)
+}
+
+func TestGroupsSharingPublicKey(t *testing.T) {
+	s := store.NewStore(dbm.NewMemDB())
+	setTestData(t, s)
+
+	groups, err := s.GetAllGroups()
+	require.NoError(t, err)
+	require.Equal(t, []store.Group{testGroup, testReshareGroup}, groups)
+
+	groups, err = s.GetGroupsByPubKey(testGroup.GroupPubKey)
+	require.NoError(t, err)
+	require.Equal(t, []store.Group{testGroup, testReshareGroup}, groups)
+
+	_, err = s.GetGroupsByPubKey(tss.Point([]byte("other-pub-key")))
+	require.Error(t, err)
+
+	// A group stored by group ID is found by its group ID, and a group stored by public key is the fallback.
+	group, err := s.GetGroupByIDOrPubKey(testReshareGroup.GroupID, testReshareGroup.GroupPubKey)
+	require.NoError(t, err)
+	require.Equal(t, testReshareGroup, group)
+
+	group, err = s.GetGroupByIDOrPubKey(1, testGroup.GroupPubKey)
+	require.NoError(t, err)
+	require.Equal(t, testGroup, group)
+
+	_, err = s.GetGroupByID(1)
+	require.Error(t, err)
+}
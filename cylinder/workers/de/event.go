@@ -44,8 +44,10 @@ func ParsePubDEFromEvents(events sdk.StringEvents, eventType string) ([]PubDE, e
 	return pubDEs, nil
 }
 
-// CountAssignedSignings counts the number of assigned signings for the given address.
-func CountAssignedSignings(events sdk.StringEvents, address string) int64 {
+// CountAssignedSignings counts the number of assigned signings on the given curve for the given address.
+// Events of the same type may be merged into one, so the curve of a signing is tracked from the attribute
+// that follows its group ID; a signing without the curve attribute is on secp256k1.
+func CountAssignedSignings(events sdk.StringEvents, address string, curve types.Curve) int64 {
 	cnt := int64(0)
 
 	for _, ev := range events {
@@ -53,9 +55,17 @@ func CountAssignedSignings(events sdk.StringEvents, address string) int64 {
 			continue
 		}
 
+		signingCurve := types.CURVE_SECP256K1
 		for _, attr := range ev.Attributes {
-			if attr.Key == types.AttributeKeyAddress && attr.Value == address {
-				cnt++
+			switch attr.Key {
+			case types.AttributeKeyGroupID:
+				signingCurve = types.CURVE_SECP256K1
+			case types.AttributeKeyCurve:
+				signingCurve = types.Curve(types.Curve_value[attr.Value])
+			case types.AttributeKeyAddress:
+				if attr.Value == address && signingCurve == curve {
+					cnt++
+				}
 			}
 		}
 	}
@@ -202,6 +202,7 @@ func TestCountAssignedSignings(t *testing.T) {
 		name    string
 		events  sdk.StringEvents
 		address string
+		curve   types.Curve
 		expOut  int64
 	}{
 		{
@@ -228,6 +229,7 @@ func TestCountAssignedSignings(t *testing.T) {
 				)),
 			}),
 			"member 1",
+			types.CURVE_SECP256K1,
 			1,
 		},
 		{
@@ -273,6 +275,7 @@ func TestCountAssignedSignings(t *testing.T) {
 				)),
 			}),
 			"member 1",
+			types.CURVE_SECP256K1,
 			2,
 		},
 		{
@@ -315,19 +318,53 @@ func TestCountAssignedSignings(t *testing.T) {
 				)),
 			}),
 			"member 1",
+			types.CURVE_SECP256K1,
 			2,
 		},
 		{
 			"no event",
 			sdk.StringifyEvents([]abci.Event{}),
 			"member 3",
+			types.CURVE_SECP256K1,
 			0,
 		},
+		{
+			"success - filter by curve (merge type)",
+			sdk.StringifyEvents([]abci.Event{
+				abci.Event(sdk.NewEvent(
+					types.EventTypeRequestSignature,
+					sdk.NewAttribute(types.AttributeKeyGroupID, "1"),
+					sdk.NewAttribute(types.AttributeKeyCurve, types.CURVE_SECP256K1.String()),
+					sdk.NewAttribute(types.AttributeKeySigningID, "1"),
+					sdk.NewAttribute(types.AttributeKeyMemberID, "1"),
+					sdk.NewAttribute(types.AttributeKeyAddress, "member 1"),
+				)),
+				abci.Event(sdk.NewEvent(
+					types.EventTypeRequestSignature,
+					sdk.NewAttribute(types.AttributeKeyGroupID, "2"),
+					sdk.NewAttribute(types.AttributeKeyCurve, types.CURVE_ED25519.String()),
+					sdk.NewAttribute(types.AttributeKeySigningID, "2"),
+					sdk.NewAttribute(types.AttributeKeyMemberID, "1"),
+					sdk.NewAttribute(types.AttributeKeyAddress, "member 1"),
+				)),
+				abci.Event(sdk.NewEvent(
+					types.EventTypeRequestSignature,
+					sdk.NewAttribute(types.AttributeKeyGroupID, "2"),
+					sdk.NewAttribute(types.AttributeKeyCurve, types.CURVE_ED25519.String()),
+					sdk.NewAttribute(types.AttributeKeySigningID, "3"),
+					sdk.NewAttribute(types.AttributeKeyMemberID, "1"),
+					sdk.NewAttribute(types.AttributeKeyAddress, "member 1"),
+				)),
+			}),
+			"member 1",
+			types.CURVE_ED25519,
+			2,
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			actual := de.CountAssignedSignings(test.events, test.address)
+			actual := de.CountAssignedSignings(test.events, test.address, test.curve)
 			assert.Equal(t, test.expOut, actual)
 		})
 	}
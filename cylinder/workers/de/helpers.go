@@ -16,27 +16,35 @@ type DEGetter interface {
 	HasDE(de types.DE) bool
 }
 
-// GenerateDEs generates n pairs of DE by using secret value as a random factor
-func GenerateDEs(n uint64, secret tss.Scalar, db DEGetter) (privDEs []store.DE, err error) {
+// GenerateDEs generates n pairs of DE on the given curve by using secret value as a random factor
+func GenerateDEs(n uint64, curve types.Curve, secret tss.Scalar, db DEGetter) (privDEs []store.DE, err error) {
+	scheme := curve.Scheme()
 	privDEs = make([]store.DE, 0, n)
 	attempt := 0
 
 	for len(privDEs) < int(n) && attempt < MaxDuplicateDEAttempts {
-		privD, err := tss.GenerateSigningNonce(secret)
+		privD, err := scheme.GenerateSigningNonce(secret)
 		if err != nil {
 			return nil, err
 		}
 
-		privE, err := tss.GenerateSigningNonce(secret)
+		privE, err := scheme.GenerateSigningNonce(secret)
 		if err != nil {
 			return nil, err
 		}
 
-		pubDE := types.DE{
-			PubD: privD.Point(),
-			PubE: privE.Point(),
+		pubD, err := scheme.ComputePublicKey(privD)
+		if err != nil {
+			return nil, err
 		}
 
+		pubE, err := scheme.ComputePublicKey(privE)
+		if err != nil {
+			return nil, err
+		}
+
+		pubDE := types.NewDE(pubD, pubE, curve)
+
 		if db.HasDE(pubDE) {
 			attempt += 1
 			continue
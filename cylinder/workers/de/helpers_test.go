@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/bandprotocol/chain/v3/cylinder/workers/de"
+	"github.com/bandprotocol/chain/v3/pkg/tss/ed25519"
 	"github.com/bandprotocol/chain/v3/x/tss/types"
 )
 
@@ -14,7 +15,7 @@ type MockDEGetter struct{}
 func (m *MockDEGetter) HasDE(de types.DE) bool { return false }
 
 func TestGenerateDEs(t *testing.T) {
-	privDEs, err := de.GenerateDEs(10, []byte("secret"), &MockDEGetter{})
+	privDEs, err := de.GenerateDEs(10, types.CURVE_SECP256K1, []byte("secret"), &MockDEGetter{})
 	assert.NoError(t, err)
 
 	for _, privDE := range privDEs {
@@ -23,5 +24,25 @@ func TestGenerateDEs(t *testing.T) {
 
 		err = privDE.PrivE.Validate()
 		assert.NoError(t, err)
+
+		err = privDE.PubDE.Validate()
+		assert.NoError(t, err)
+	}
+}
+
+func TestGenerateDEsEd25519(t *testing.T) {
+	privDEs, err := de.GenerateDEs(10, types.CURVE_ED25519, []byte("secret"), &MockDEGetter{})
+	assert.NoError(t, err)
+	assert.Len(t, privDEs, 10)
+
+	for _, privDE := range privDEs {
+		assert.Equal(t, types.CURVE_ED25519, privDE.PubDE.Curve)
+
+		err = privDE.PubDE.Validate()
+		assert.NoError(t, err)
+
+		pubD, err := ed25519.ComputePublicKey(privDE.PrivD)
+		assert.NoError(t, err)
+		assert.Equal(t, pubD, privDE.PubDE.PubD)
 	}
 }
@@ -2,6 +2,7 @@ package de
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -20,17 +21,24 @@ import (
 
 const MAX_DE_BATCH_SIZE = int64(50)
 
-// UpdateDE is a worker responsible for updating DEs in the store and chains
+// UpdateDE is a worker responsible for updating DEs of every configured curve in the store and chains
 type UpdateDE struct {
 	context          *context.Context
 	logger           *logger.Logger
 	client           *client.Client
 	eventCh          <-chan ctypes.ResultEvent
-	deCounter        *DECounter
+	curves           []types.Curve
+	deCounters       map[types.Curve]*DECounter
 	maxDESizeOnChain uint64
 	receiver         msg.ResponseReceiver
 	reqID            uint64
-	cacheDEs         map[uint64]int64
+	cacheDEs         map[uint64]pendingDEs
+}
+
+// pendingDEs is the DEs of a curve that are submitted to the chain but not yet committed.
+type pendingDEs struct {
+	curve types.Curve
+	size  int64
 }
 
 var _ cylinder.Worker = &UpdateDE{}
@@ -47,6 +55,16 @@ func NewUpdateDE(ctx *context.Context) (*UpdateDE, error) {
 		return nil, err
 	}
 
+	curves, err := parseCurves(ctx.Config.Curves)
+	if err != nil {
+		return nil, err
+	}
+
+	deCounters := make(map[types.Curve]*DECounter)
+	for _, curve := range curves {
+		deCounters[curve] = NewDECounter()
+	}
+
 	receiver := msg.ResponseReceiver{
 		ReqType:    msg.RequestTypeUpdateDE,
 		ResponseCh: make(chan msg.Response),
@@ -58,11 +76,37 @@ func NewUpdateDE(ctx *context.Context) (*UpdateDE, error) {
 		client:           cli,
 		maxDESizeOnChain: params.MaxDESize,
 		receiver:         receiver,
-		deCounter:        NewDECounter(),
-		cacheDEs:         make(map[uint64]int64),
+		curves:           curves,
+		deCounters:       deCounters,
+		cacheDEs:         make(map[uint64]pendingDEs),
 	}, nil
 }
 
+// parseCurves parses the configured curves of DEs. Only secp256k1 DEs are generated if no curve is configured.
+func parseCurves(names []string) ([]types.Curve, error) {
+	if len(names) == 0 {
+		return []types.Curve{types.CURVE_SECP256K1}, nil
+	}
+
+	seen := make(map[types.Curve]bool)
+	curves := make([]types.Curve, 0, len(names))
+	for _, name := range names {
+		curve, err := types.ParseCurve(strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+
+		if seen[curve] {
+			continue
+		}
+
+		seen[curve] = true
+		curves = append(curves, curve)
+	}
+
+	return curves, nil
+}
+
 // Start starts the UpdateDE worker.
 func (u *UpdateDE) Start() {
 	u.logger.Info("start")
@@ -115,21 +159,21 @@ func (u *UpdateDE) subscribe() (err error) {
 	return err
 }
 
-// updateDE generates new DEs and submit them to the chain.
-func (u *UpdateDE) updateDE(numNewDE int64) error {
-	u.logger.Info(":delivery_truck: Updating DE")
+// updateDE generates new DEs on the given curve and submit them to the chain.
+func (u *UpdateDE) updateDE(curve types.Curve, numNewDE int64) error {
+	u.logger.Info(":delivery_truck: Updating DE on curve %s", curve)
 
 	for i := int64(0); i < numNewDE; i += MAX_DE_BATCH_SIZE {
 		size := min(MAX_DE_BATCH_SIZE, numNewDE-i)
 
-		pubDEs, err := u.generateAndSaveDEs(size)
+		pubDEs, err := u.generateAndSaveDEs(curve, size)
 		if err != nil {
 			u.logger.Error(":cold_sweat: Failed to generate and save DEs: %s", err)
 			continue
 		}
 
 		u.reqID += 1
-		u.cacheDEs[u.reqID] = size
+		u.cacheDEs[u.reqID] = pendingDEs{curve: curve, size: size}
 		u.context.PriorityMsgRequestCh <- msg.NewRequest(
 			msg.RequestTypeUpdateDE,
 			u.reqID,
@@ -141,11 +185,12 @@ func (u *UpdateDE) updateDE(numNewDE int64) error {
 	return nil
 }
 
-// generateAndSaveDEs generates new DE pairs and saves them into the store.
-func (u *UpdateDE) generateAndSaveDEs(numNewDE int64) ([]types.DE, error) {
+// generateAndSaveDEs generates new DE pairs on the given curve and saves them into the store.
+func (u *UpdateDE) generateAndSaveDEs(curve types.Curve, numNewDE int64) ([]types.DE, error) {
 	// Generate new DE pairs
 	privDEs, err := GenerateDEs(
 		uint64(numNewDE),
+		curve,
 		u.context.Config.RandomSecret,
 		u.context.Store,
 	)
@@ -165,7 +210,7 @@ func (u *UpdateDE) generateAndSaveDEs(numNewDE int64) ([]types.DE, error) {
 		metrics.IncOffChainDELeftGauge()
 	}
 
-	u.logger.Info(":white_check_mark: Successfully generated %d new DE pairs", numNewDE)
+	u.logger.Info(":white_check_mark: Successfully generated %d new DE pairs on curve %s", numNewDE, curve)
 	return pubDEs, nil
 }
 
@@ -222,7 +267,7 @@ func (u *UpdateDE) shouldContinueUpdateDE() (bool, error) {
 	return true, nil
 }
 
-// intervalUpdateDE updates DE on the chain so that the remaining DE is
+// intervalUpdateDE updates DE of every curve on the chain so that the remaining DE is
 // always above the minimum threshold.
 func (u *UpdateDE) intervalUpdateDE() error {
 	// also update the maxDESizeOnChain
@@ -232,83 +277,125 @@ func (u *UpdateDE) intervalUpdateDE() error {
 	}
 	u.maxDESizeOnChain = params.MaxDESize
 
-	deCount, blockHeight, err := u.getDECount()
-	if err != nil {
-		return err
+	totalDECount := uint64(0)
+	for _, curve := range u.curves {
+		deCount, err := u.intervalUpdateCurveDE(curve)
+		if err != nil {
+			return err
+		}
+
+		totalDECount += deCount
 	}
 
-	metrics.SetOnChainDELeftGauge(float64(deCount))
+	metrics.SetOnChainDELeftGauge(float64(totalDECount))
+
+	return nil
+}
+
+// intervalUpdateCurveDE updates DE of the given curve on the chain so that the remaining DE is
+// always above the minimum threshold. It returns the number of DEs on the chain.
+func (u *UpdateDE) intervalUpdateCurveDE(curve types.Curve) (uint64, error) {
+	deCount, blockHeight, err := u.getDECount(curve)
+	if err != nil {
+		return 0, err
+	}
 
-	numDEToBeCreated := u.deCounter.AfterSyncWithChain(deCount, u.maxDESizeOnChain, blockHeight)
-	u.logger.Debug(":eyes: deCounter after AfterSyncWithChain [intervalUpdateDE]: %s", u.deCounter.String())
+	deCounter := u.deCounters[curve]
+	numDEToBeCreated := deCounter.AfterSyncWithChain(deCount, u.maxDESizeOnChain, blockHeight)
+	u.logger.Debug(
+		":eyes: deCounter of curve %s after AfterSyncWithChain [intervalUpdateDE]: %s",
+		curve,
+		deCounter.String(),
+	)
 	if numDEToBeCreated == 0 {
-		u.logger.Debug(":eyes: the number of DEs is sufficient, skip interval update DE")
-		return nil
+		u.logger.Debug(":eyes: the number of DEs on curve %s is sufficient, skip interval update DE", curve)
+		return deCount, nil
 	}
 
 	if ok, err := u.shouldContinueUpdateDE(); err != nil || !ok {
-		u.deCounter.AfterDEsRejected(numDEToBeCreated)
-		return err
+		deCounter.AfterDEsRejected(numDEToBeCreated)
+		return deCount, err
 	}
 
 	if numDEToBeCreated > 0 {
 		u.logger.Info(
-			":delivery_truck: the number of DEs is less than the expected size, do an interval update len = %d",
+			":delivery_truck: the number of DEs on curve %s is less than the expected size, do an interval update len = %d",
+			curve,
 			numDEToBeCreated,
 		)
-		if err := u.updateDE(numDEToBeCreated); err != nil {
-			u.deCounter.AfterDEsRejected(numDEToBeCreated)
-			return err
+		if err := u.updateDE(curve, numDEToBeCreated); err != nil {
+			deCounter.AfterDEsRejected(numDEToBeCreated)
+			return deCount, err
 		}
 	}
 
-	return nil
+	return deCount, nil
 }
 
-// updateDEFromEvent updates DEs from the subscribed event.
+// updateDEFromEvent updates DEs of every curve from the subscribed event.
 func (u *UpdateDE) updateDEFromEvent(resultEvent ctypes.ResultEvent) error {
-	memberAddress := u.context.Config.Granter
-
-	var blockHeight, deUsed int64
+	var blockHeight int64
+	var events sdk.StringEvents
 	switch data := resultEvent.Data.(type) {
 	case tmtypes.EventDataTx:
 		blockHeight = data.Height
-		deUsed = CountAssignedSignings(sdk.StringifyEvents(data.Result.Events), memberAddress)
+		events = sdk.StringifyEvents(data.Result.Events)
 	case tmtypes.EventDataNewBlock:
 		blockHeight = data.Block.Height
-		deUsed = CountAssignedSignings(sdk.StringifyEvents(data.ResultFinalizeBlock.Events), memberAddress)
+		events = sdk.StringifyEvents(data.ResultFinalizeBlock.Events)
 	default:
 		return nil
 	}
 
+	for _, curve := range u.curves {
+		deUsed := CountAssignedSignings(events, u.context.Config.Granter, curve)
+		if err := u.updateCurveDEFromUsage(curve, deUsed, blockHeight); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateCurveDEFromUsage updates DEs of the given curve from the number of DEs used in the block.
+func (u *UpdateDE) updateCurveDEFromUsage(curve types.Curve, deUsed int64, blockHeight int64) error {
+	deCounter := u.deCounters[curve]
 	threshold := min(u.maxDESizeOnChain/6, uint64(MAX_DE_BATCH_SIZE))
-	numDEToBECreated := u.deCounter.EvaluateDECreationFromUsage(deUsed, threshold, blockHeight)
-	u.logger.Debug(":eyes: deCounter after EvaluateDECreationFromUsage [updateDEFromEvent]: %s", u.deCounter.String())
+	numDEToBECreated := deCounter.EvaluateDECreationFromUsage(deUsed, threshold, blockHeight)
+	u.logger.Debug(
+		":eyes: deCounter of curve %s after EvaluateDECreationFromUsage [updateDEFromEvent]: %s",
+		curve,
+		deCounter.String(),
+	)
 
 	if numDEToBECreated == 0 {
-		u.logger.Debug(":eyes: DEs are sufficient, skip update DE from event")
+		u.logger.Debug(":eyes: DEs on curve %s are sufficient, skip update DE from event", curve)
 		return nil
 	}
 
 	if ok, err := u.shouldContinueUpdateDE(); err != nil || !ok {
-		u.deCounter.AfterDEsRejected(numDEToBECreated)
+		deCounter.AfterDEsRejected(numDEToBECreated)
 		return err
 	}
 
-	u.logger.Info(":delivery_truck: DEs are used over the threshold, adding new DEs len = %d", numDEToBECreated)
+	u.logger.Info(
+		":delivery_truck: DEs on curve %s are used over the threshold, adding new DEs len = %d",
+		curve,
+		numDEToBECreated,
+	)
 
-	if err := u.updateDE(numDEToBECreated); err != nil {
-		u.deCounter.AfterDEsRejected(numDEToBECreated)
+	if err := u.updateDE(curve, numDEToBECreated); err != nil {
+		deCounter.AfterDEsRejected(numDEToBECreated)
 		return err
 	}
 
 	return nil
 }
 
-// getDECount queries the number of DEs on the chain.
-func (u *UpdateDE) getDECount() (uint64, int64, error) {
+// getDECount queries the number of DEs on the given curve on the chain.
+func (u *UpdateDE) getDECount(curve types.Curve) (uint64, int64, error) {
 	// Query DE information
-	deRes, err := u.client.QueryDE(u.context.Config.Granter, 0, 1)
+	deRes, err := u.client.QueryDE(u.context.Config.Granter, curve, 0, 1)
 	if err != nil {
 		u.logger.Error(":cold_sweat: Failed to query DE information: %s", err)
 		return 0, 0, err
@@ -320,16 +407,18 @@ func (u *UpdateDE) getDECount() (uint64, int64, error) {
 // listenMsgResponses listens to the MsgResponseReceiver channel and handle properly.
 func (u *UpdateDE) listenMsgResponses() {
 	for res := range u.receiver.ResponseCh {
-		lenDEs := u.cacheDEs[res.Request.ID]
+		pending := u.cacheDEs[res.Request.ID]
+		lenDEs := pending.size
+		deCounter := u.deCounters[pending.curve]
 
 		if res.Success {
 			u.logger.Info(":smiling_face_with_sunglasses: Successfully submitted DEs ReqID: %d", res.Request.ID)
 
-			u.deCounter.AfterDEsCommitted(lenDEs)
+			deCounter.AfterDEsCommitted(lenDEs)
 			u.logger.Debug(
 				":eyes: deCounter after AfterDEsCommitted [listenMsgResponses] ReqID: %d: %s",
 				res.Request.ID,
-				u.deCounter.String(),
+				deCounter.String(),
 			)
 		} else {
 			u.logger.Error(
@@ -339,8 +428,8 @@ func (u *UpdateDE) listenMsgResponses() {
 				res.Err,
 			)
 
-			u.deCounter.AfterDEsRejected(lenDEs)
-			u.logger.Debug(":eyes: deCounter after AfterDEsRejected [listenMsgResponses]: %s", u.deCounter.String())
+			deCounter.AfterDEsRejected(lenDEs)
+			u.logger.Debug(":eyes: deCounter after AfterDEsRejected [listenMsgResponses]: %s", deCounter.String())
 		}
 
 		delete(u.cacheDEs, res.Request.ID)
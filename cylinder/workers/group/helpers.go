@@ -7,15 +7,22 @@ import (
 	"github.com/bandprotocol/chain/v3/x/tss/types"
 )
 
-// getOwnPrivKey calculates the own private key for the group member.
+// getOwnPrivKey calculates the own private key for the group member from the secret shares of all dealers.
 // It returns the own private key, a slice of complaints (if any), and an error, if any.
 func getOwnPrivKey(dkg store.DKG, groupRes *client.GroupResult) (tss.Scalar, []types.Complaint, error) {
+	scheme := groupRes.Group.Curve.Scheme()
+
 	var secretShares tss.Scalars
 	var complaints []types.Complaint
 	for senderID := uint64(1); senderID <= groupRes.Group.Size_; senderID++ {
+		// Skip the member that doesn't deal in a reshared group
+		if !groupRes.IsDealer(tss.MemberID(senderID)) {
+			continue
+		}
+
 		// Calculate your own secret value
 		if senderID == uint64(dkg.MemberID) {
-			secretShare, err := tss.ComputeSecretShare(dkg.Coefficients, dkg.MemberID)
+			secretShare, err := scheme.ComputeSecretShare(dkg.Coefficients, dkg.MemberID)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -46,7 +53,7 @@ func getOwnPrivKey(dkg store.DKG, groupRes *client.GroupResult) (tss.Scalar, []t
 		return nil, complaints, nil
 	}
 
-	ownPrivKey, err := tss.ComputeOwnPrivateKey(secretShares...)
+	ownPrivKey, err := scheme.ComputeOwnPrivateKey(secretShares...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -94,7 +101,7 @@ func getSecretShare(
 	}
 
 	// Verify secret share
-	err = tss.VerifySecretShare(receiverID, secretShare, r1Sender.CoefficientCommits)
+	err = groupRes.Group.Curve.Scheme().VerifySecretShare(receiverID, secretShare, r1Sender.CoefficientCommits)
 	if err != nil {
 		// Generate complaint if we fail to verify secret share
 		sig, keySym, err := tss.SignComplaint(
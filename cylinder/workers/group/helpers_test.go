@@ -98,6 +98,48 @@ func TestGetOwnPrivKey(t *testing.T) {
 	}
 }
 
+func TestGetOwnPrivKeyReshare(t *testing.T) {
+	for _, tc := range testutil.TestCases {
+		// The last member of the group doesn't deal.
+		nonDealerID := tc.Group.Members[len(tc.Group.Members)-1].ID
+
+		for _, member := range tc.Group.Members {
+			t.Run(fmt.Sprintf("Test: %s, Member: %d", tc.Name, member.ID), func(t *testing.T) {
+				dkg, groupRes := getTestData(tc, member)
+				groupRes.Group.SourceGroupID = 1
+				for _, m := range tc.Group.Members {
+					sourceMemberID := m.ID
+					if m.ID == nonDealerID {
+						sourceMemberID = 0
+					}
+					groupRes.Members = append(groupRes.Members, types.Member{ID: m.ID, SourceMemberID: sourceMemberID})
+				}
+				if member.ID == nonDealerID {
+					dkg.Coefficients = nil
+				}
+
+				var secretShares tss.Scalars
+				for _, m := range tc.Group.Members {
+					if m.ID == nonDealerID {
+						continue
+					}
+
+					secretShare, err := tss.ComputeSecretShare(m.Coefficients, member.ID)
+					assert.NoError(t, err)
+					secretShares = append(secretShares, secretShare)
+				}
+				expPrivKey, err := tss.ComputeOwnPrivateKey(secretShares...)
+				assert.NoError(t, err)
+
+				privKey, complaints, err := getOwnPrivKey(dkg, &groupRes)
+				assert.NoError(t, err)
+				assert.Nil(t, complaints)
+				assert.Equal(t, expPrivKey, privKey)
+			})
+		}
+	}
+}
+
 func TestGetSecretShare(t *testing.T) {
 	tests := []struct {
 		name           string
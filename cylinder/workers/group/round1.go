@@ -120,7 +120,7 @@ func (r *Round1) handleGroup(gid tss.GroupID) {
 	logger.Info(":delivery_truck: Processing incoming group")
 
 	// Generate round1 data
-	data, err := tss.GenerateRound1Info(mid, groupRes.Group.Threshold, groupRes.DKGContext)
+	data, err := r.generateRound1Info(mid, groupRes)
 	if err != nil {
 		logger.Error(":cold_sweat: Failed to generate round1 data with error: %s", err)
 
@@ -168,6 +168,50 @@ func (r *Round1) handleGroup(gid tss.GroupID) {
 	metrics.IncProcessRound1SuccessCount(uint64(gid))
 }
 
+// generateRound1Info generates the round1 data of the member in the group. In a reshared group, a dealer
+// shares its weighted share of the source group secret, while a member that doesn't deal shares nothing.
+func (r *Round1) generateRound1Info(mid tss.MemberID, groupRes *client.GroupResult) (*tss.Round1Info, error) {
+	scheme := groupRes.Group.Curve.Scheme()
+	if groupRes.Group.SourceGroupID == 0 {
+		return scheme.GenerateRound1Info(mid, groupRes.Group.Threshold, groupRes.DKGContext)
+	}
+
+	member, err := groupRes.GetMember(mid)
+	if err != nil {
+		return nil, err
+	}
+
+	if member.SourceMemberID == 0 {
+		return scheme.GenerateReshareRound1Info(mid, groupRes.Group.Threshold, groupRes.DKGContext, nil)
+	}
+
+	// Get own private key of the source group
+	sourceGroupRes, err := r.client.QueryGroup(groupRes.Group.SourceGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceGroup, err := r.context.Store.GetGroupByIDOrPubKey(sourceGroupRes.Group.ID, sourceGroupRes.Group.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if sourceGroup.MemberID != member.SourceMemberID {
+		return nil, fmt.Errorf(
+			"member ID (%d) of the source group in store doesn't match the source member ID (%d)",
+			sourceGroup.MemberID,
+			member.SourceMemberID,
+		)
+	}
+
+	secret, err := scheme.ComputeReshareSecret(member.SourceMemberID, groupRes.GetSourceDealerIDs(), sourceGroup.PrivKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return scheme.GenerateReshareRound1Info(mid, groupRes.Group.Threshold, groupRes.DKGContext, secret)
+}
+
 // Start starts the Round1 worker.
 // It subscribes to the events, and continuously processes incoming events by calling handleABCIEvents.
 func (r *Round1) Start() {
@@ -124,25 +124,28 @@ func (r *Round2) handleGroup(gid tss.GroupID) {
 		return
 	}
 
-	// Get all one time public keys in the group
-	oneTimePubKeys := make(tss.Points, groupRes.Group.Size_)
-	for _, data := range groupRes.Round1Infos {
-		oneTimePubKeys[data.MemberID-1] = data.OneTimePubKey
-	}
-
-	// Compute encrypted secret shares
-	encSecretShares, err := tss.ComputeEncryptedSecretShares(
-		dkg.MemberID,
-		dkg.OneTimePrivKey,
-		oneTimePubKeys,
-		dkg.Coefficients,
-		tss.DefaultNonce16Generator{},
-	)
-	if err != nil {
-		logger.Error(":cold_sweat: Failed to generate encrypted secret shares: %s", err)
+	// Compute encrypted secret shares; a member that doesn't deal in a reshared group has no shares to send.
+	var encSecretShares tss.EncSecretShares
+	if groupRes.IsDealer(dkg.MemberID) {
+		// Get all one time public keys in the group
+		oneTimePubKeys := make(tss.Points, groupRes.Group.Size_)
+		for _, data := range groupRes.Round1Infos {
+			oneTimePubKeys[data.MemberID-1] = data.OneTimePubKey
+		}
 
-		metrics.IncProcessRound2FailureCount(uint64(gid))
-		return
+		encSecretShares, err = groupRes.Group.Curve.Scheme().ComputeEncryptedSecretShares(
+			dkg.MemberID,
+			dkg.OneTimePrivKey,
+			oneTimePubKeys,
+			dkg.Coefficients,
+			tss.DefaultNonce16Generator{},
+		)
+		if err != nil {
+			logger.Error(":cold_sweat: Failed to generate encrypted secret shares: %s", err)
+
+			metrics.IncProcessRound2FailureCount(uint64(gid))
+			return
+		}
 	}
 
 	// Generate message for round 2
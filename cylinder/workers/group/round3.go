@@ -117,7 +117,7 @@ func (r *Round3) handleGroup(gid tss.GroupID) {
 	// Log
 	logger.Info(":delivery_truck: Processing incoming group")
 
-	group, err := r.context.Store.GetGroup(groupRes.Group.PubKey)
+	group, err := r.getGroup(groupRes)
 	if err != nil {
 		// Set DKG data
 		dkg, err := r.context.Store.GetDKG(gid)
@@ -154,6 +154,7 @@ func (r *Round3) handleGroup(gid tss.GroupID) {
 
 		// Generate own private key and update it in store
 		group = store.Group{
+			GroupID:     gid,
 			GroupPubKey: groupRes.Group.PubKey,
 			MemberID:    dkg.MemberID,
 			PrivKey:     ownPrivKey,
@@ -179,10 +180,19 @@ func (r *Round3) handleGroup(gid tss.GroupID) {
 	}
 
 	// Sign own public key
-	ownPubKeySig, err := tss.SignOwnPubKey(
+	scheme := groupRes.Group.Curve.Scheme()
+	ownPubKey, err := scheme.ComputePublicKey(group.PrivKey)
+	if err != nil {
+		logger.Error(":cold_sweat: Failed to compute own public key: %s", err)
+
+		metrics.IncProcessRound3FailureCount(uint64(gid))
+		return
+	}
+
+	ownPubKeySig, err := scheme.SignOwnPubKey(
 		group.MemberID,
 		groupRes.DKGContext,
-		group.PrivKey.Point(),
+		ownPubKey,
 		group.PrivKey,
 	)
 	if err != nil {
@@ -205,6 +215,16 @@ func (r *Round3) handleGroup(gid tss.GroupID) {
 	metrics.IncProcessRound3ConfirmCount(uint64(gid))
 }
 
+// getGroup retrieves the group that is already stored. A group that is not reshared may be stored by its
+// public key only, while a reshared group shares the public key with its source group.
+func (r *Round3) getGroup(groupRes *client.GroupResult) (store.Group, error) {
+	if groupRes.Group.SourceGroupID != 0 {
+		return r.context.Store.GetGroupByID(groupRes.Group.ID)
+	}
+
+	return r.context.Store.GetGroupByIDOrPubKey(groupRes.Group.ID, groupRes.Group.PubKey)
+}
+
 // Start starts the Round3 worker.
 // It subscribes to events and starts processing incoming events.
 func (r *Round3) Start() {
@@ -106,7 +106,7 @@ func (s *Signing) handleSigning(sid tss.SigningID) {
 	logger.Info(":delivery_truck: Processing incoming signing request")
 
 	// Set group data
-	group, err := s.context.Store.GetGroup(signing.GroupPubKey)
+	group, err := s.context.Store.GetGroupByIDOrPubKey(signing.GroupID, signing.GroupPubKey)
 	if err != nil {
 		logger.Error(":cold_sweat: Failed to find group in store: %s", err)
 
@@ -128,8 +128,11 @@ func (s *Signing) handleSigning(sid tss.SigningID) {
 		return
 	}
 
+	// The DE is assigned from the queue of the group curve, so the stored DE tells the curve of the group.
+	scheme := privDE.PubDE.Curve.Scheme()
+
 	// Compute own private nonce
-	privNonce, err := tss.ComputeOwnPrivNonce(privDE.PrivD, privDE.PrivE, assignedMember.BindingFactor)
+	privNonce, err := scheme.ComputeOwnPrivNonce(privDE.PrivD, privDE.PrivE, assignedMember.BindingFactor)
 	if err != nil {
 		logger.Error(":cold_sweat: Failed to compute own private nonce: %s", err)
 
@@ -138,7 +141,7 @@ func (s *Signing) handleSigning(sid tss.SigningID) {
 	}
 
 	// Compute lagrange
-	lagrange, err := tss.ComputeLagrangeCoefficient(group.MemberID, signingRes.GetMemberIDs())
+	lagrange, err := scheme.ComputeLagrangeCoefficient(group.MemberID, signingRes.GetMemberIDs())
 	if err != nil {
 		logger.Error(":cold_sweat: Failed to compute lagrange coefficient: %s", err)
 
@@ -147,7 +150,7 @@ func (s *Signing) handleSigning(sid tss.SigningID) {
 	}
 
 	// Sign the signing
-	sig, err := tss.SignSigning(
+	sig, err := scheme.SignSigning(
 		signing.GroupPubNonce,
 		signing.GroupPubKey,
 		signing.Message,
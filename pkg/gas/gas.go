@@ -0,0 +1,35 @@
+package gas
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// IsOutOfGasError checks if the recovered panic value is an out of gas or gas overflow error type
+func IsOutOfGasError(r any) (bool, string) {
+	switch e := r.(type) {
+	case storetypes.ErrorOutOfGas:
+		return true, e.Descriptor
+	case storetypes.ErrorGasOverflow:
+		return true, e.Descriptor
+	default:
+		return false, ""
+	}
+}
+
+// CallWithRecovery calls fn and converts a panic, including running out of gas, into an error.
+// The name describes the callee in the error of a panic that is not about gas.
+func CallWithRecovery(name string, fn func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if isErr, descriptor := IsOutOfGasError(r); isErr {
+				err = fmt.Errorf("out of gas: %s", descriptor)
+			} else {
+				err = fmt.Errorf("%s panicked: %v", name, r)
+			}
+		}
+	}()
+
+	return fn()
+}
@@ -0,0 +1,42 @@
+package gas_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/bandprotocol/chain/v3/pkg/gas"
+)
+
+func TestIsOutOfGasError(t *testing.T) {
+	isErr, descriptor := gas.IsOutOfGasError(storetypes.ErrorOutOfGas{Descriptor: "read"})
+	require.True(t, isErr)
+	require.Equal(t, "read", descriptor)
+
+	isErr, descriptor = gas.IsOutOfGasError(storetypes.ErrorGasOverflow{Descriptor: "write"})
+	require.True(t, isErr)
+	require.Equal(t, "write", descriptor)
+
+	isErr, _ = gas.IsOutOfGasError("other")
+	require.False(t, isErr)
+}
+
+func TestCallWithRecovery(t *testing.T) {
+	err := gas.CallWithRecovery("consumer", func() error { return nil })
+	require.NoError(t, err)
+
+	err = gas.CallWithRecovery("consumer", func() error { return errors.New("failed") })
+	require.EqualError(t, err, "failed")
+
+	err = gas.CallWithRecovery("consumer", func() error {
+		storetypes.NewGasMeter(1).ConsumeGas(2, "read")
+		return nil
+	})
+	require.EqualError(t, err, "out of gas: read")
+
+	err = gas.CallWithRecovery("consumer", func() error { panic("boom") })
+	require.EqualError(t, err, "consumer panicked: boom")
+}
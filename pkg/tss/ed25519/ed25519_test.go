@@ -0,0 +1,403 @@
+package ed25519_test
+
+import (
+	stded25519 "crypto/ed25519"
+	"crypto/sha512"
+	"encoding/hex"
+	"testing"
+
+	"filippo.io/edwards25519"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/pkg/tss/ed25519"
+)
+
+type ED25519TestSuite struct {
+	suite.Suite
+
+	dkgContext []byte
+	threshold  uint64
+	members    []tss.MemberID
+	round1s    []*tss.Round1Info
+}
+
+func (suite *ED25519TestSuite) SetupTest() {
+	suite.dkgContext = []byte("DKGContext")
+	suite.threshold = 2
+	suite.members = []tss.MemberID{1, 2, 3}
+	suite.round1s = nil
+
+	for _, mid := range suite.members {
+		round1, err := ed25519.GenerateRound1Info(mid, suite.threshold, suite.dkgContext)
+		suite.Require().NoError(err)
+		suite.round1s = append(suite.round1s, round1)
+	}
+}
+
+func TestED25519TestSuite(t *testing.T) {
+	suite.Run(t, new(ED25519TestSuite))
+}
+
+func hexDecode(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// runDKG runs round 2 and round 3 for the given round 1 information and returns the private keys of
+// all members and the group public key.
+func (suite *ED25519TestSuite) runDKG(round1s []*tss.Round1Info) (tss.Scalars, tss.Point) {
+	var oneTimePubKeys tss.Points
+	var a0Commits tss.Points
+	for _, round1 := range round1s {
+		oneTimePubKeys = append(oneTimePubKeys, round1.OneTimePubKey)
+		if round1.A0PubKey != nil {
+			a0Commits = append(a0Commits, round1.A0PubKey)
+		}
+	}
+
+	// Round 2: every dealer shares its polynomial to the other members.
+	encSecretShares := make(map[tss.MemberID]tss.EncSecretShares)
+	for i, round1 := range round1s {
+		if round1.Coefficients == nil {
+			continue
+		}
+
+		mid := tss.NewMemberID(i + 1)
+		encs, err := ed25519.ComputeEncryptedSecretShares(
+			mid,
+			round1.OneTimePrivKey,
+			oneTimePubKeys,
+			round1.Coefficients,
+			tss.DefaultNonce16Generator{},
+		)
+		suite.Require().NoError(err)
+		encSecretShares[mid] = encs
+	}
+
+	// Round 3: every member decrypts and verifies its shares.
+	var privKeys tss.Scalars
+	for i, round1 := range round1s {
+		mid := tss.NewMemberID(i + 1)
+
+		var shares tss.Scalars
+		for j, dealer := range round1s {
+			if dealer.Coefficients == nil {
+				continue
+			}
+
+			dealerID := tss.NewMemberID(j + 1)
+			if dealerID == mid {
+				share, err := ed25519.ComputeSecretShare(dealer.Coefficients, mid)
+				suite.Require().NoError(err)
+				shares = append(shares, share)
+				continue
+			}
+
+			idx := int(mid) - 1
+			if mid > dealerID {
+				idx--
+			}
+
+			keySym, err := tss.ComputeSecretSym(round1.OneTimePrivKey, dealer.OneTimePubKey)
+			suite.Require().NoError(err)
+
+			share, err := tss.DecryptSecretShare(encSecretShares[dealerID][idx], keySym)
+			suite.Require().NoError(err)
+
+			err = ed25519.VerifySecretShare(mid, share, dealer.CoefficientCommits)
+			suite.Require().NoError(err)
+
+			shares = append(shares, share)
+		}
+
+		privKey, err := ed25519.ComputeOwnPrivateKey(shares...)
+		suite.Require().NoError(err)
+		privKeys = append(privKeys, privKey)
+	}
+
+	groupPubKey, err := ed25519.ComputeGroupPublicKey(a0Commits...)
+	suite.Require().NoError(err)
+
+	return privKeys, groupPubKey
+}
+
+// sign runs the signing process over the data with the given signers and returns the group signature.
+func (suite *ED25519TestSuite) sign(
+	signers []tss.MemberID,
+	privKeys tss.Scalars,
+	groupPubKey tss.Point,
+	data []byte,
+) tss.Signature {
+	var privDs, privEs tss.Scalars
+	var pubDs, pubEs tss.Points
+	for range signers {
+		d, err := ed25519.GenerateKeyPair()
+		suite.Require().NoError(err)
+		e, err := ed25519.GenerateKeyPair()
+		suite.Require().NoError(err)
+
+		privDs = append(privDs, d.PrivKey)
+		pubDs = append(pubDs, d.PubKey)
+		privEs = append(privEs, e.PrivKey)
+		pubEs = append(pubEs, e.PubKey)
+	}
+
+	commitment, err := tss.ComputeCommitment(signers, pubDs, pubEs)
+	suite.Require().NoError(err)
+
+	var ownPubNonces tss.Points
+	var ownPrivNonces tss.Scalars
+	for i, mid := range signers {
+		bindingFactor, err := ed25519.ComputeOwnBindingFactor(mid, data, commitment)
+		suite.Require().NoError(err)
+
+		ownPubNonce, err := ed25519.ComputeOwnPubNonce(pubDs[i], pubEs[i], bindingFactor)
+		suite.Require().NoError(err)
+
+		ownPrivNonce, err := ed25519.ComputeOwnPrivNonce(privDs[i], privEs[i], bindingFactor)
+		suite.Require().NoError(err)
+
+		ownPubNonces = append(ownPubNonces, ownPubNonce)
+		ownPrivNonces = append(ownPrivNonces, ownPrivNonce)
+	}
+
+	groupPubNonce, err := ed25519.ComputeGroupPublicNonce(ownPubNonces...)
+	suite.Require().NoError(err)
+
+	var signatures tss.Signatures
+	for i, mid := range signers {
+		privKey := privKeys[mid-1]
+		lagrange, err := ed25519.ComputeLagrangeCoefficient(mid, signers)
+		suite.Require().NoError(err)
+
+		signature, err := ed25519.SignSigning(groupPubNonce, groupPubKey, data, lagrange, ownPrivNonces[i], privKey)
+		suite.Require().NoError(err)
+
+		ownPubKey, err := ed25519.ComputePublicKey(privKey)
+		suite.Require().NoError(err)
+
+		err = ed25519.VerifySignature(groupPubNonce, groupPubKey, data, lagrange, signature, ownPubKey)
+		suite.Require().NoError(err)
+
+		signatures = append(signatures, signature)
+	}
+
+	groupSignature, err := ed25519.CombineSignatures(signatures...)
+	suite.Require().NoError(err)
+
+	return groupSignature
+}
+
+func (suite *ED25519TestSuite) TestRFC8032Vector() {
+	// RFC 8032 section 7.1, TEST 1.
+	seed := hexDecode("9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60")
+	pubKey := tss.Point(hexDecode("d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a"))
+	message := []byte{}
+	expected := tss.Signature(hexDecode(
+		"e5564300c360ac729086e2cc806e828a84877f1eb8e5d974d873e065224901555fb8821590a33bacc61e39701cf9b46bd25bf5f0595bbe24655141438e7a100b",
+	))
+
+	// Derive the private scalar and the deterministic nonce of RFC 8032 from the seed.
+	h := sha512.Sum512(seed)
+	privKey, err := edwards25519.NewScalar().SetBytesWithClamping(h[:32])
+	suite.Require().NoError(err)
+	nonceHash := sha512.Sum512(append(h[32:], message...))
+	nonce, err := edwards25519.NewScalar().SetUniformBytes(nonceHash[:])
+	suite.Require().NoError(err)
+
+	computedPubKey, err := ed25519.ComputePublicKey(privKey.Bytes())
+	suite.Require().NoError(err)
+	suite.Require().Equal(pubKey, computedPubKey)
+
+	pubNonce, err := ed25519.ComputePublicKey(nonce.Bytes())
+	suite.Require().NoError(err)
+
+	signature, err := ed25519.SignSigning(pubNonce, pubKey, message, nil, nonce.Bytes(), privKey.Bytes())
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, signature)
+
+	suite.Require().NoError(ed25519.VerifyGroupSignature(pubKey, message, expected))
+	suite.Require().Error(ed25519.VerifyGroupSignature(pubKey, []byte("other"), expected))
+}
+
+func (suite *ED25519TestSuite) TestGenerateRound1Info() {
+	for i, round1 := range suite.round1s {
+		mid := suite.members[i]
+
+		err := tss.VerifyOneTimeSignature(mid, suite.dkgContext, round1.OneTimeSignature, round1.OneTimePubKey)
+		suite.Require().NoError(err)
+
+		err = ed25519.VerifyA0Signature(mid, suite.dkgContext, round1.A0Signature, round1.A0PubKey)
+		suite.Require().NoError(err)
+
+		err = ed25519.VerifyA0Signature(mid+1, suite.dkgContext, round1.A0Signature, round1.A0PubKey)
+		suite.Require().ErrorIs(err, tss.ErrInvalidSignature)
+
+		suite.Require().Len(round1.Coefficients, int(suite.threshold))
+		suite.Require().Equal(round1.A0PubKey, round1.CoefficientCommits[0])
+		for j, coeff := range round1.Coefficients {
+			commit, err := ed25519.ComputePublicKey(coeff)
+			suite.Require().NoError(err)
+			suite.Require().Equal(round1.CoefficientCommits[j], commit)
+			suite.Require().NoError(ed25519.ValidatePoint(commit))
+		}
+	}
+}
+
+func (suite *ED25519TestSuite) TestDKGAndSigning() {
+	privKeys, groupPubKey := suite.runDKG(suite.round1s)
+
+	// Own public keys computed from the sum of commits match the private keys.
+	var sumCommits tss.Points
+	for k := uint64(0); k < suite.threshold; k++ {
+		var commits tss.Points
+		for _, round1 := range suite.round1s {
+			commits = append(commits, round1.CoefficientCommits[k])
+		}
+
+		sum, err := ed25519.SumPoints(commits...)
+		suite.Require().NoError(err)
+		sumCommits = append(sumCommits, sum)
+	}
+
+	for i, mid := range suite.members {
+		ownPubKey, err := ed25519.ComputeOwnPublicKey(sumCommits, mid)
+		suite.Require().NoError(err)
+
+		expected, err := ed25519.ComputePublicKey(privKeys[i])
+		suite.Require().NoError(err)
+		suite.Require().Equal(expected, ownPubKey)
+
+		sig, err := ed25519.SignOwnPubKey(mid, suite.dkgContext, ownPubKey, privKeys[i])
+		suite.Require().NoError(err)
+		suite.Require().NoError(ed25519.VerifyOwnPubKeySignature(mid, suite.dkgContext, sig, ownPubKey))
+	}
+
+	// Any threshold subset of members produces a standard Ed25519 signature.
+	data := []byte("data")
+	for _, signers := range [][]tss.MemberID{{1, 2}, {1, 3}, {2, 3}, {1, 2, 3}} {
+		signature := suite.sign(signers, privKeys, groupPubKey, data)
+
+		suite.Require().NoError(ed25519.ValidateSignature(signature))
+		suite.Require().NoError(ed25519.VerifyGroupSignature(groupPubKey, data, signature))
+		suite.Require().True(stded25519.Verify(stded25519.PublicKey(groupPubKey), data, signature))
+	}
+}
+
+func (suite *ED25519TestSuite) TestReshare() {
+	privKeys, groupPubKey := suite.runDKG(suite.round1s)
+
+	// Members 1 and 3 of the source group deal their shares to a new group of three members,
+	// where the second member of the new group does not deal.
+	sourceDealerIDs := []tss.MemberID{1, 3}
+	var round1s []*tss.Round1Info
+	for i, sourceMid := range []tss.MemberID{1, 0, 3} {
+		mid := tss.NewMemberID(i + 1)
+
+		var secret tss.Scalar
+		if sourceMid != 0 {
+			var err error
+			secret, err = ed25519.ComputeReshareSecret(sourceMid, sourceDealerIDs, privKeys[sourceMid-1])
+			suite.Require().NoError(err)
+
+			sourcePubKey, err := ed25519.ComputePublicKey(privKeys[sourceMid-1])
+			suite.Require().NoError(err)
+
+			commit, err := ed25519.ComputePublicKey(secret)
+			suite.Require().NoError(err)
+			suite.Require().NoError(ed25519.VerifyReshareCommit(sourceMid, sourceDealerIDs, sourcePubKey, commit))
+			suite.Require().ErrorIs(
+				ed25519.VerifyReshareCommit(sourceMid, sourceDealerIDs, sourcePubKey, sourcePubKey),
+				tss.ErrInvalidReshareCommit,
+			)
+		}
+
+		round1, err := ed25519.GenerateReshareRound1Info(mid, suite.threshold, suite.dkgContext, secret)
+		suite.Require().NoError(err)
+		round1s = append(round1s, round1)
+	}
+
+	newPrivKeys, newGroupPubKey := suite.runDKG(round1s)
+	suite.Require().Equal(groupPubKey, newGroupPubKey)
+
+	data := []byte("reshare")
+	signature := suite.sign([]tss.MemberID{2, 3}, newPrivKeys, newGroupPubKey, data)
+	suite.Require().True(stded25519.Verify(stded25519.PublicKey(groupPubKey), data, signature))
+}
+
+func (suite *ED25519TestSuite) TestVerifyComplaint() {
+	sender, receiver := suite.round1s[0], suite.round1s[1]
+	senderID, receiverID := suite.members[0], suite.members[1]
+
+	complaintSignature, keySym, err := tss.SignComplaint(
+		receiver.OneTimePubKey,
+		sender.OneTimePubKey,
+		receiver.OneTimePrivKey,
+	)
+	suite.Require().NoError(err)
+
+	// A valid share makes the complaint fail.
+	share, err := ed25519.ComputeSecretShare(sender.Coefficients, receiverID)
+	suite.Require().NoError(err)
+	enc, err := tss.Encrypt(share, keySym, tss.DefaultNonce16Generator{})
+	suite.Require().NoError(err)
+
+	err = ed25519.VerifyComplaint(
+		receiver.OneTimePubKey,
+		sender.OneTimePubKey,
+		keySym,
+		complaintSignature,
+		enc,
+		receiverID,
+		sender.CoefficientCommits,
+	)
+	suite.Require().ErrorIs(err, tss.ErrValidSecretShare)
+
+	// A share for another member makes the complaint succeed.
+	share, err = ed25519.ComputeSecretShare(sender.Coefficients, senderID)
+	suite.Require().NoError(err)
+	enc, err = tss.Encrypt(share, keySym, tss.DefaultNonce16Generator{})
+	suite.Require().NoError(err)
+
+	err = ed25519.VerifyComplaint(
+		receiver.OneTimePubKey,
+		sender.OneTimePubKey,
+		keySym,
+		complaintSignature,
+		enc,
+		receiverID,
+		sender.CoefficientCommits,
+	)
+	suite.Require().NoError(err)
+}
+
+func (suite *ED25519TestSuite) TestValidate() {
+	kp, err := ed25519.GenerateKeyPair()
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(ed25519.ValidateScalar(kp.PrivKey))
+	suite.Require().Error(ed25519.ValidateScalar(make(tss.Scalar, 32)))
+	suite.Require().Error(ed25519.ValidateScalar(tss.Scalar(hexDecode(
+		"edd3f55c1a631258d69cf7a2def9de1400000000000000000000000000000010",
+	))))
+
+	suite.Require().NoError(ed25519.ValidatePoint(kp.PubKey))
+	// secp256k1 point
+	suite.Require().Error(ed25519.ValidatePoint(tss.Point(hexDecode(
+		"0383764b806848430ed195ef8017fb4e768893ea07782e679c31e5ff1b8b453973",
+	))))
+	// identity point
+	suite.Require().Error(ed25519.ValidatePoint(tss.Point(hexDecode(
+		"0100000000000000000000000000000000000000000000000000000000000000",
+	))))
+	// point of order 8
+	suite.Require().Error(ed25519.ValidatePoint(tss.Point(hexDecode(
+		"c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a",
+	))))
+
+	suite.Require().Error(ed25519.ValidateSignature(tss.Signature(kp.PubKey)))
+}
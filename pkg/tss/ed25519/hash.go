@@ -0,0 +1,90 @@
+package ed25519
+
+import (
+	"crypto/sha512"
+
+	"filippo.io/edwards25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+)
+
+const (
+	ContextString = "BAND-TSS-ed25519-v0"
+)
+
+// Hash calculates the SHA-512 hash of the given data.
+// It returns the hash value as a byte slice.
+func Hash(data ...[]byte) []byte {
+	h := sha512.New()
+	for _, d := range data {
+		h.Write(d)
+	}
+
+	return h.Sum(nil)
+}
+
+// hashToScalar computes the SHA-512 hash of the given data and reduces it modulo the group order.
+func hashToScalar(data ...[]byte) tss.Scalar {
+	// SetUniformBytes only fails if the input is not 64 bytes long, which a SHA-512 hash always is.
+	scalar, _ := edwards25519.NewScalar().SetUniformBytes(Hash(data...))
+	return scalar.Bytes()
+}
+
+// HashRound1A0 computes a hash of the provided data for Round1A0 and returns it as a scalar (H1(m)).
+func HashRound1A0(pubNonce tss.Point, mid tss.MemberID, dkgContext []byte, a0Pub tss.Point) tss.Scalar {
+	return hashToScalar(
+		[]byte(ContextString),
+		[]byte("round1A0"),
+		pubNonce,
+		sdk.Uint64ToBigEndian(uint64(mid)),
+		dkgContext,
+		a0Pub,
+	)
+}
+
+// HashRound3OwnPubKey computes a hash of the provided data for Round3OwnPubKey and returns it as a scalar (H4(m)).
+func HashRound3OwnPubKey(pubNonce tss.Point, mid tss.MemberID, dkgContext []byte, ownPub tss.Point) tss.Scalar {
+	return hashToScalar(
+		[]byte(ContextString),
+		[]byte("round3OwnPubKey"),
+		pubNonce,
+		sdk.Uint64ToBigEndian(uint64(mid)),
+		dkgContext,
+		ownPub,
+	)
+}
+
+// HashSignMsg computes a hash of the message for signing purposes and returns the hash as a byte slice (H5(m)).
+func HashSignMsg(data []byte) []byte {
+	return Hash([]byte(ContextString), []byte("signMsg"), data)
+}
+
+// HashSignCommitment computes a hash of commitment and returns the hash as a byte slice (H6(m)).
+func HashSignCommitment(data []byte) []byte {
+	return Hash([]byte(ContextString), []byte("signCommitment"), data)
+}
+
+// HashBindingFactor computes a hash to generate binding factor and returns it as a scalar (H7(m)).
+func HashBindingFactor(mid tss.MemberID, data []byte, commitment []byte) tss.Scalar {
+	return hashToScalar(
+		[]byte(ContextString),
+		[]byte("bindingFactor"),
+		sdk.Uint64ToBigEndian(uint64(mid)),
+		HashSignMsg(data),
+		HashSignCommitment(commitment),
+	)
+}
+
+// HashChallenge computes a hash to generate challenge of signing a signature and returns it as a scalar (H8(m)).
+// It is the challenge of RFC 8032, SHA-512(R || A || M), without a context string so that the group signature
+// is a plain Ed25519 signature of the data.
+func HashChallenge(groupPubNonce, groupPubKey tss.Point, data []byte) tss.Scalar {
+	return hashToScalar(groupPubNonce, groupPubKey, data)
+}
+
+// HashNonce computes a hash of the provided data for the nonce and returns it as a scalar (H9(m)).
+func HashNonce(random []byte, secretKey tss.Scalar) tss.Scalar {
+	return hashToScalar([]byte(ContextString), []byte("nonce"), random, secretKey)
+}
@@ -0,0 +1,96 @@
+package ed25519
+
+import (
+	"filippo.io/edwards25519"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+)
+
+// GenerateKeyPairs generates a specified number of Ed25519 key pairs.
+// It returns a slice of KeyPair values and an error, if any.
+func GenerateKeyPairs(n uint64) (tss.KeyPairs, error) {
+	var kps tss.KeyPairs
+	for i := uint64(0); i < n; i++ {
+		kp, err := GenerateKeyPair()
+		if err != nil {
+			return nil, tss.NewError(err, "number: %d", i)
+		}
+
+		kps = append(kps, kp)
+	}
+
+	return kps, nil
+}
+
+// GenerateKeyPair generates a new Ed25519 key pair whose private key is a scalar (not an RFC 8032 seed).
+// It returns a KeyPair value and an error, if any.
+func GenerateKeyPair() (tss.KeyPair, error) {
+	privKey, err := RandomScalar()
+	if err != nil {
+		return tss.KeyPair{}, tss.NewError(tss.ErrGenerateKeyPairFailed, "%s", err.Error())
+	}
+
+	pubKey, err := ComputePublicKey(privKey)
+	if err != nil {
+		return tss.KeyPair{}, tss.NewError(tss.ErrGenerateKeyPairFailed, "%s", err.Error())
+	}
+
+	return tss.KeyPair{
+		PrivKey: privKey,
+		PubKey:  pubKey,
+	}, nil
+}
+
+// ComputePublicKey computes the public key of a private key scalar.
+// Formula: Y = x * G
+func ComputePublicKey(rawPrivKey tss.Scalar) (tss.Point, error) {
+	privKey, err := parseScalar(rawPrivKey)
+	if err != nil {
+		return nil, tss.NewError(err, "parse private key")
+	}
+
+	return new(edwards25519.Point).ScalarBaseMult(privKey).Bytes(), nil
+}
+
+// GenerateDKGNonce generates a DKG (Distributed Key Generation) nonce consisting of a scalar and a point.
+func GenerateDKGNonce() (tss.Scalar, tss.Point, error) {
+	kp, err := GenerateKeyPair()
+	if err != nil {
+		return nil, nil, tss.NewError(err, "generate key pair")
+	}
+
+	return kp.PrivKey, kp.PubKey, nil
+}
+
+// GenerateSigningNonce produces a signing nonce derived from a given private key and fresh randomness.
+// The wide hash reduction gives a uniform distribution over the group order.
+func GenerateSigningNonce(secret tss.Scalar) (tss.Scalar, error) {
+	random, err := tss.RandomBytes(32)
+	if err != nil {
+		return nil, err
+	}
+
+	return HashNonce(random, secret), nil
+}
+
+// RandomScalar creates a uniformly random non-zero scalar by reducing 64 random bytes modulo the group order.
+func RandomScalar() (tss.Scalar, error) {
+	for {
+		random, err := tss.RandomBytes(64)
+		if err != nil {
+			return nil, err
+		}
+
+		scalar, err := edwards25519.NewScalar().SetUniformBytes(random)
+		if err != nil {
+			return nil, tss.NewError(tss.ErrRandomError, "%s", err.Error())
+		}
+
+		// The probability of a zero scalar is negligible, but it would be an invalid private key.
+		if scalar.Equal(edwards25519.NewScalar()) == 1 {
+			continue
+		}
+
+		return scalar.Bytes(), nil
+	}
+}
@@ -0,0 +1,85 @@
+package ed25519
+
+import (
+	"filippo.io/edwards25519"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+)
+
+// SumScalars computes the sum of multiple scalars.
+func SumScalars(rawScalars ...tss.Scalar) (tss.Scalar, error) {
+	scalars, err := parseScalars(rawScalars)
+	if err != nil {
+		return nil, tss.NewError(err, "parse scalars")
+	}
+
+	return sumScalars(scalars...).Bytes(), nil
+}
+
+// SumPoints computes the sum of multiple points.
+func SumPoints(rawPoints ...tss.Point) (tss.Point, error) {
+	points, err := parsePoints(rawPoints)
+	if err != nil {
+		return nil, tss.NewError(err, "parse points")
+	}
+
+	return sumPoints(points...).Bytes(), nil
+}
+
+// scalarMultPoint computes s * P for an encoded scalar and point.
+func scalarMultPoint(rawScalar tss.Scalar, rawPoint tss.Point) (tss.Point, error) {
+	scalar, err := parseScalar(rawScalar)
+	if err != nil {
+		return nil, tss.NewError(err, "parse scalar")
+	}
+
+	point, err := parsePoint(rawPoint)
+	if err != nil {
+		return nil, tss.NewError(err, "parse point")
+	}
+
+	return new(edwards25519.Point).ScalarMult(scalar, point).Bytes(), nil
+}
+
+// solveScalarPolynomial solves a scalar polynomial equation using Horner's method.
+func solveScalarPolynomial(coefficients []*edwards25519.Scalar, x *edwards25519.Scalar) *edwards25519.Scalar {
+	result := edwards25519.NewScalar()
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		// result = result * x + coefficients[i]
+		result.MultiplyAdd(result, x, coefficients[i])
+	}
+
+	return result
+}
+
+// solvePointPolynomial solves a point polynomial equation using Horner's method.
+func solvePointPolynomial(coefficients []*edwards25519.Point, x *edwards25519.Scalar) *edwards25519.Point {
+	result := edwards25519.NewIdentityPoint()
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		// result = result * x + coefficients[i]
+		result.ScalarMult(x, result)
+		result.Add(result, coefficients[i])
+	}
+
+	return result
+}
+
+// sumPoints computes the sum of multiple edwards25519.Point.
+func sumPoints(points ...*edwards25519.Point) *edwards25519.Point {
+	sum := edwards25519.NewIdentityPoint()
+	for _, point := range points {
+		sum.Add(sum, point)
+	}
+
+	return sum
+}
+
+// sumScalars computes the sum of multiple edwards25519.Scalar.
+func sumScalars(scalars ...*edwards25519.Scalar) *edwards25519.Scalar {
+	sum := edwards25519.NewScalar()
+	for _, scalar := range scalars {
+		sum.Add(sum, scalar)
+	}
+
+	return sum
+}
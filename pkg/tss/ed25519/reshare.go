@@ -0,0 +1,131 @@
+package ed25519
+
+import (
+	"bytes"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+)
+
+// GenerateReshareRound1Info generates the data of round 1 for a member in the resharing process of an
+// Ed25519 group. A dealer gives its weighted share of the source group secret as the secret that its
+// polynomial shares, while a member that does not deal gives a nil secret and gets only the one-time information.
+func GenerateReshareRound1Info(
+	mid tss.MemberID,
+	threshold uint64,
+	dkgContext []byte,
+	secret tss.Scalar,
+) (*tss.Round1Info, error) {
+	// Get one-time information.
+	oneTime, err := tss.GenerateKeyPair()
+	if err != nil {
+		return nil, tss.NewError(err, "generate one time key pair")
+	}
+
+	oneTimeSignature, err := tss.SignOneTime(mid, dkgContext, oneTime.PubKey, oneTime.PrivKey)
+	if err != nil {
+		return nil, tss.NewError(err, "sign one time")
+	}
+
+	round1Info := &tss.Round1Info{
+		OneTimePrivKey:   oneTime.PrivKey,
+		OneTimePubKey:    oneTime.PubKey,
+		OneTimeSignature: oneTimeSignature,
+	}
+	if secret == nil {
+		return round1Info, nil
+	}
+
+	// Get a0 information from the secret.
+	a0PrivKey := secret
+	a0PubKey, err := ComputePublicKey(secret)
+	if err != nil {
+		return nil, tss.NewError(err, "compute A0")
+	}
+
+	a0Signature, err := SignA0(mid, dkgContext, a0PubKey, a0PrivKey)
+	if err != nil {
+		return nil, tss.NewError(err, "sign A0")
+	}
+
+	// Generate threshold - 1 key pairs (commits without a0).
+	kps, err := GenerateKeyPairs(threshold - 1)
+	if err != nil {
+		return nil, tss.NewError(err, "generate key pairs")
+	}
+
+	// Get coefficients.
+	coefficientCommits := tss.Points{a0PubKey}
+	coefficients := tss.Scalars{a0PrivKey}
+	for _, kp := range kps {
+		coefficientCommits = append(coefficientCommits, kp.PubKey)
+		coefficients = append(coefficients, kp.PrivKey)
+	}
+
+	round1Info.A0PrivKey = a0PrivKey
+	round1Info.A0PubKey = a0PubKey
+	round1Info.A0Signature = a0Signature
+	round1Info.Coefficients = coefficients
+	round1Info.CoefficientCommits = coefficientCommits
+
+	return round1Info, nil
+}
+
+// ComputeReshareSecret computes the secret that a dealer shares in the resharing process from its private key
+// in the source group. The formula used is: a0 = λi * si
+func ComputeReshareSecret(
+	sourceMid tss.MemberID,
+	sourceDealerIDs []tss.MemberID,
+	rawPrivKey tss.Scalar,
+) (tss.Scalar, error) {
+	lagrange, err := ComputeLagrangeCoefficient(sourceMid, sourceDealerIDs)
+	if err != nil {
+		return nil, tss.NewError(err, "compute lagrange coefficient")
+	}
+
+	scalars, err := parseScalars(tss.Scalars{lagrange, rawPrivKey})
+	if err != nil {
+		return nil, tss.NewError(err, "parse private key")
+	}
+
+	return scalars[0].Multiply(scalars[0], scalars[1]).Bytes(), nil
+}
+
+// ComputeReshareCommit computes the commit of the secret that a dealer shares in the resharing process from
+// its own public key in the source group. The formula used is: A0 = λi * Yi
+func ComputeReshareCommit(
+	sourceMid tss.MemberID,
+	sourceDealerIDs []tss.MemberID,
+	rawPubKey tss.Point,
+) (tss.Point, error) {
+	lagrange, err := ComputeLagrangeCoefficient(sourceMid, sourceDealerIDs)
+	if err != nil {
+		return nil, tss.NewError(err, "compute lagrange coefficient")
+	}
+
+	commit, err := scalarMultPoint(lagrange, rawPubKey)
+	if err != nil {
+		return nil, tss.NewError(err, "compute commit")
+	}
+
+	return commit, nil
+}
+
+// VerifyReshareCommit verifies that the A0 commit of a dealer in the resharing process commits to its
+// weighted share of the source group secret, which keeps the public key of the source group.
+func VerifyReshareCommit(
+	sourceMid tss.MemberID,
+	sourceDealerIDs []tss.MemberID,
+	rawPubKey tss.Point,
+	a0Commit tss.Point,
+) error {
+	commit, err := ComputeReshareCommit(sourceMid, sourceDealerIDs, rawPubKey)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(commit, a0Commit) {
+		return tss.ErrInvalidReshareCommit
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package ed25519
+
+import (
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+)
+
+// GenerateRound1Info generates the data of round 1 for a member in the DKG process of an Ed25519 group.
+// The one-time key, which is only used to encrypt secret shares between members, stays on secp256k1
+// so that share encryption and complaints are the same for every curve.
+func GenerateRound1Info(
+	mid tss.MemberID,
+	threshold uint64,
+	dkgContext []byte,
+) (*tss.Round1Info, error) {
+	// Get one-time information.
+	oneTime, err := tss.GenerateKeyPair()
+	if err != nil {
+		return nil, tss.NewError(err, "generate one time key pair")
+	}
+
+	oneTimeSignature, err := tss.SignOneTime(mid, dkgContext, oneTime.PubKey, oneTime.PrivKey)
+	if err != nil {
+		return nil, tss.NewError(err, "sign one time")
+	}
+
+	// Generate threshold key pairs (commits).
+	kps, err := GenerateKeyPairs(threshold)
+	if err != nil {
+		return nil, tss.NewError(err, "generate key pairs")
+	}
+
+	// Get a0 information.
+	a0PrivKey := kps[0].PrivKey
+	a0PubKey := kps[0].PubKey
+	a0Signature, err := SignA0(mid, dkgContext, a0PubKey, a0PrivKey)
+	if err != nil {
+		return nil, tss.NewError(err, "sign A0")
+	}
+
+	// Get coefficients.
+	var coefficientCommits tss.Points
+	var coefficients tss.Scalars
+	for _, kp := range kps {
+		coefficientCommits = append(coefficientCommits, kp.PubKey)
+		coefficients = append(coefficients, kp.PrivKey)
+	}
+
+	return &tss.Round1Info{
+		OneTimePrivKey:     oneTime.PrivKey,
+		OneTimePubKey:      oneTime.PubKey,
+		OneTimeSignature:   oneTimeSignature,
+		A0PrivKey:          a0PrivKey,
+		A0PubKey:           a0PubKey,
+		A0Signature:        a0Signature,
+		Coefficients:       coefficients,
+		CoefficientCommits: coefficientCommits,
+	}, nil
+}
+
+// SignA0 generates a signature for the A0 in round 1.
+func SignA0(
+	mid tss.MemberID,
+	dkgContext []byte,
+	a0Pub tss.Point,
+	a0Priv tss.Scalar,
+) (tss.Signature, error) {
+	nonce, pubNonce, err := GenerateDKGNonce()
+	if err != nil {
+		return nil, err
+	}
+
+	challenge := HashRound1A0(pubNonce, mid, dkgContext, a0Pub)
+	return Sign(a0Priv, challenge, nonce, nil)
+}
+
+// VerifyA0Signature verifies the signature for the A0 in round 1.
+func VerifyA0Signature(
+	mid tss.MemberID,
+	dkgContext []byte,
+	signature tss.Signature,
+	a0Pub tss.Point,
+) error {
+	r, s, err := splitSignature(signature)
+	if err != nil {
+		return err
+	}
+
+	challenge := HashRound1A0(r, mid, dkgContext, a0Pub)
+	return Verify(r, s, challenge, a0Pub, nil)
+}
@@ -0,0 +1,59 @@
+package ed25519
+
+import (
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+)
+
+// ComputeEncryptedSecretShares computes the encrypted secret shares for a member. The shares are Ed25519
+// scalars that are encrypted with the secp256k1 one-time keys of the members.
+func ComputeEncryptedSecretShares(
+	mid tss.MemberID,
+	rawPrivKey tss.Scalar,
+	rawPubKeys tss.Points,
+	rawCoefficients tss.Scalars,
+	n16g tss.INonce16Generator,
+) (tss.EncSecretShares, error) {
+	// Compute the key sym for each member 1..n except mid.
+	var keySyms tss.Points
+	for i, rawPubKey := range rawPubKeys {
+		idx := i + 1
+		if tss.NewMemberID(idx) == mid {
+			continue
+		}
+
+		keySym, err := tss.ComputeSecretSym(rawPrivKey, rawPubKey)
+		if err != nil {
+			return nil, tss.NewError(err, "compute key sym")
+		}
+
+		keySyms = append(keySyms, keySym)
+	}
+
+	// Calculate the secret share for each member 1..n except mid.
+	var secretShares tss.Scalars
+	for i := uint32(1); i <= uint32(len(rawPubKeys)); i++ {
+		if tss.NewMemberID(i) == mid {
+			continue
+		}
+
+		secretShare, err := ComputeSecretShare(rawCoefficients, tss.NewMemberID(i))
+		if err != nil {
+			return nil, tss.NewError(err, "compute secret share: member id: %d", i)
+		}
+
+		secretShares = append(secretShares, secretShare)
+	}
+
+	// Encrypt each secret share using its corresponding key sym.
+	return tss.EncryptSecretShares(secretShares, keySyms, n16g)
+}
+
+// ComputeSecretShare computes the secret share for a given set of coefficients and x.
+func ComputeSecretShare(rawCoefficients tss.Scalars, mid tss.MemberID) (tss.Scalar, error) {
+	coefficients, err := parseScalars(rawCoefficients)
+	if err != nil {
+		return nil, tss.NewError(err, "parse coefficients")
+	}
+
+	return solveScalarPolynomial(coefficients, memberIDScalar(mid)).Bytes(), nil
+}
@@ -0,0 +1,136 @@
+package ed25519
+
+import (
+	"bytes"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+)
+
+// ComputeOwnPublicKey computes the own public key for a given set of sum commits and x.
+// The formula used is: Yi = Σ(k=0 to t-1) (i^k * Σ(j=1 to n) (Commit_jk))
+func ComputeOwnPublicKey(rawSumCommits tss.Points, mid tss.MemberID) (tss.Point, error) {
+	sumCommits, err := parsePoints(rawSumCommits)
+	if err != nil {
+		return nil, tss.NewError(err, "parse sum commits")
+	}
+
+	return solvePointPolynomial(sumCommits, memberIDScalar(mid)).Bytes(), nil
+}
+
+// ComputeGroupPublicKey computes the group public key from a set of A0 commits.
+// The formula used is: Y = Σ(i=1 to n) (Commit_j0)
+func ComputeGroupPublicKey(rawA0Commits ...tss.Point) (tss.Point, error) {
+	a0Commits, err := parsePoints(rawA0Commits)
+	if err != nil {
+		return nil, tss.NewError(err, "parse a0 commits")
+	}
+
+	return sumPoints(a0Commits...).Bytes(), nil
+}
+
+// ComputeOwnPrivateKey computes the own private key from a set of secret shares.
+// The formula used is: si = Σ(j=1 to n) (f_j(i))
+func ComputeOwnPrivateKey(rawSecretShares ...tss.Scalar) (tss.Scalar, error) {
+	privKey, err := SumScalars(rawSecretShares...)
+	if err != nil {
+		return nil, tss.NewError(err, "parse secret shares")
+	}
+
+	return privKey, nil
+}
+
+// VerifySecretShare verifies the validity of a secret share for a given member.
+// It compares the computed yG from the secret share with the yG computed from the commits.
+func VerifySecretShare(mid tss.MemberID, rawSecretShare tss.Scalar, rawCommits tss.Points) error {
+	// Compute yG from the secret share.
+	yG, err := ComputePublicKey(rawSecretShare)
+	if err != nil {
+		return tss.NewError(err, "parse secret share")
+	}
+
+	// Compute yG from the commits.
+	ssc, err := ComputeSecretShareCommit(rawCommits, mid)
+	if err != nil {
+		return tss.NewError(err, "compute secret share commit")
+	}
+
+	// Compare the two yG values to check validity.
+	if !bytes.Equal(ssc, yG) {
+		return tss.ErrInvalidSecretShare
+	}
+
+	return nil
+}
+
+// ComputeSecretShareCommit computes the secret share commit for a given set of commits and x.
+// The formula used is: y * G = f_ij(x) * G = c_0 + c_1 * x^1 + ... + c_n-1 * x^(n-1) + c_n * x^n
+func ComputeSecretShareCommit(rawCommits tss.Points, mid tss.MemberID) (tss.Point, error) {
+	commits, err := parsePoints(rawCommits)
+	if err != nil {
+		return nil, tss.NewError(err, "parse commits")
+	}
+
+	return solvePointPolynomial(commits, memberIDScalar(mid)).Bytes(), nil
+}
+
+// SignOwnPubKey signs the own public key using the given DKG context, own public key, and own private key.
+func SignOwnPubKey(
+	mid tss.MemberID,
+	dkgContext []byte,
+	ownPub tss.Point,
+	ownPriv tss.Scalar,
+) (tss.Signature, error) {
+	nonce, pubNonce, err := GenerateDKGNonce()
+	if err != nil {
+		return nil, err
+	}
+
+	challenge := HashRound3OwnPubKey(pubNonce, mid, dkgContext, ownPub)
+	return Sign(ownPriv, challenge, nonce, nil)
+}
+
+// VerifyOwnPubKeySignature verifies the signature of an own public key using the given DKG context, own public key, and signature.
+func VerifyOwnPubKeySignature(
+	mid tss.MemberID,
+	dkgContext []byte,
+	signature tss.Signature,
+	ownPub tss.Point,
+) error {
+	r, s, err := splitSignature(signature)
+	if err != nil {
+		return err
+	}
+
+	challenge := HashRound3OwnPubKey(r, mid, dkgContext, ownPub)
+	return Verify(r, s, challenge, ownPub, nil)
+}
+
+// VerifyComplaint verifies the complaint using the complaint signature and encrypted secret share.
+// The complaint signature is over the secp256k1 one-time keys, while the secret share is checked against
+// the Ed25519 coefficient commits.
+func VerifyComplaint(
+	oneTimePubI tss.Point,
+	oneTimePubJ tss.Point,
+	keySym tss.Point,
+	complaintSignature tss.ComplaintSignature,
+	encSecretShare tss.EncSecretShare,
+	midI tss.MemberID,
+	commits tss.Points,
+) error {
+	err := tss.VerifyComplaintSignature(oneTimePubI, oneTimePubJ, keySym, complaintSignature)
+	if err != nil {
+		return tss.NewError(err, "verify complaint signature")
+	}
+
+	secretShare, err := tss.DecryptSecretShare(encSecretShare, keySym)
+	if err != nil {
+		return tss.NewError(err, "decrypt secret share")
+	}
+
+	err = VerifySecretShare(midI, secretShare, commits)
+	if err == nil {
+		return tss.ErrValidSecretShare
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package ed25519
+
+import (
+	"filippo.io/edwards25519"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+)
+
+// Sign generates an Ed25519 schnorr signature for the given private key, challenge, and nonce.
+// Formula: R = k * G, S = k + c * λ * x
+func Sign(
+	rawPrivKey tss.Scalar,
+	rawChallenge tss.Scalar,
+	rawNonce tss.Scalar,
+	rawLagrange tss.Scalar,
+) (tss.Signature, error) {
+	privKey, err := parseScalar(rawPrivKey)
+	if err != nil {
+		return nil, tss.NewError(err, "parse private key")
+	}
+
+	challenge, err := parseScalar(rawChallenge)
+	if err != nil {
+		return nil, tss.NewError(err, "parse challenge")
+	}
+
+	nonce, err := parseScalar(rawNonce)
+	if err != nil {
+		return nil, tss.NewError(err, "parse nonce")
+	}
+
+	// If there is lagrange value, multiply it into challenge
+	// C = CL
+	if rawLagrange != nil {
+		lagrange, err := parseScalar(rawLagrange)
+		if err != nil {
+			return nil, tss.NewError(err, "parse lagrange")
+		}
+
+		challenge.Multiply(challenge, lagrange)
+	}
+
+	signatureR := new(edwards25519.Point).ScalarBaseMult(nonce)
+	signatureS := edwards25519.NewScalar().MultiplyAdd(challenge, privKey, nonce)
+
+	return NewSignature(signatureR.Bytes(), signatureS.Bytes())
+}
+
+// Verify verifies the given Ed25519 schnorr signature against the challenge and public key.
+// Formula: S * G == R + c * λ * Y
+func Verify(
+	rawSignatureR tss.Point,
+	rawSignatureS tss.Scalar,
+	rawChallenge tss.Scalar,
+	rawPubKey tss.Point,
+	rawLagrange tss.Scalar,
+) error {
+	signatureR, err := parsePoint(rawSignatureR)
+	if err != nil {
+		return tss.NewError(err, "parse signature R")
+	}
+
+	signatureS, err := parseScalar(rawSignatureS)
+	if err != nil {
+		return tss.NewError(err, "parse signature S")
+	}
+
+	challenge, err := parseScalar(rawChallenge)
+	if err != nil {
+		return tss.NewError(err, "parse challenge")
+	}
+
+	pubKey, err := parsePoint(rawPubKey)
+	if err != nil {
+		return tss.NewError(err, "parse public key")
+	}
+
+	// If there is lagrange value, multiply it into challenge
+	// C = CL
+	if rawLagrange != nil {
+		lagrange, err := parseScalar(rawLagrange)
+		if err != nil {
+			return tss.NewError(err, "parse lagrange")
+		}
+
+		challenge.Multiply(challenge, lagrange)
+	}
+
+	// R' = S * G - C * Y
+	negChallenge := edwards25519.NewScalar().Negate(challenge)
+	expectedR := new(edwards25519.Point).VarTimeDoubleScalarBaseMult(negChallenge, pubKey, signatureS)
+	if expectedR.Equal(signatureR) != 1 {
+		return tss.NewError(tss.ErrInvalidSignature, "R mismatch")
+	}
+
+	return nil
+}
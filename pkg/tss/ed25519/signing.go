@@ -0,0 +1,172 @@
+package ed25519
+
+import (
+	"errors"
+
+	"filippo.io/edwards25519"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+)
+
+// ComputeLagrangeCoefficient calculates the Lagrange coefficient for a given member ID and total number of members.
+// Formula: λi = Π(j in memberList, j != i) (j / (j - i))
+func ComputeLagrangeCoefficient(mid tss.MemberID, memberList []tss.MemberID) (tss.Scalar, error) {
+	seen := make(map[tss.MemberID]bool)
+	isInList := false
+	for _, id := range memberList {
+		if id == mid {
+			isInList = true
+		}
+
+		if seen[id] {
+			return nil, errors.New("duplicate values in memberList")
+		}
+
+		seen[id] = true
+	}
+
+	if !isInList {
+		return nil, errors.New("mid not found in memberList")
+	}
+
+	i := memberIDScalar(mid)
+	numerator := memberIDScalar(1)
+	denominator := memberIDScalar(1)
+	for _, id := range memberList {
+		if id == mid {
+			continue
+		}
+
+		j := memberIDScalar(id)
+		numerator.Multiply(numerator, j)
+		denominator.Multiply(denominator, edwards25519.NewScalar().Subtract(j, i))
+	}
+
+	return numerator.Multiply(numerator, edwards25519.NewScalar().Invert(denominator)).Bytes(), nil
+}
+
+// ComputeOwnBindingFactor calculates the own binding factor (Lo) value for a given member ID, data, and commitment.
+// bindingFactor = HashBindingFactor(i, data , B)
+// B = <<i,Di,Ei>,...>
+func ComputeOwnBindingFactor(mid tss.MemberID, data []byte, commitment []byte) (tss.Scalar, error) {
+	return HashBindingFactor(mid, data, commitment), nil
+}
+
+// ComputeOwnPubNonce calculates the own public nonce for a given public D, public E, and binding factor.
+// Formula: D + bindingFactor * E
+func ComputeOwnPubNonce(rawPubD tss.Point, rawPubE tss.Point, rawBindingFactor tss.Scalar) (tss.Point, error) {
+	points, err := parsePoints(tss.Points{rawPubD, rawPubE})
+	if err != nil {
+		return nil, tss.NewError(err, "parse public D and public E")
+	}
+
+	bindingFactor, err := parseScalar(rawBindingFactor)
+	if err != nil {
+		return nil, tss.NewError(err, "parse binding factor")
+	}
+
+	pubD, pubE := points[0], points[1]
+	ownPubNonce := new(edwards25519.Point).ScalarMult(bindingFactor, pubE)
+	ownPubNonce.Add(pubD, ownPubNonce)
+
+	return ownPubNonce.Bytes(), nil
+}
+
+// ComputeOwnPrivNonce calculates the own private nonce for a given private d, private e, and binding factor.
+// Formula: d + bindingFactor * e
+func ComputeOwnPrivNonce(rawPrivD tss.Scalar, rawPrivE tss.Scalar, rawBindingFactor tss.Scalar) (tss.Scalar, error) {
+	scalars, err := parseScalars(tss.Scalars{rawPrivD, rawPrivE, rawBindingFactor})
+	if err != nil {
+		return nil, tss.NewError(err, "parse private D, private E and binding factor")
+	}
+
+	privD, privE, bindingFactor := scalars[0], scalars[1], scalars[2]
+	return edwards25519.NewScalar().MultiplyAdd(bindingFactor, privE, privD).Bytes(), nil
+}
+
+// ComputeGroupPublicNonce calculates the group public nonce for a given slice of own public nonces.
+// Formula: Sum(PubNonce1, PubNonce2, ..., PubNonceN)
+func ComputeGroupPublicNonce(rawOwnPubNonces ...tss.Point) (tss.Point, error) {
+	pubNonce, err := SumPoints(rawOwnPubNonces...)
+	if err != nil {
+		return nil, tss.NewError(err, "parse own public nonces")
+	}
+
+	return pubNonce, nil
+}
+
+// CombineSignatures performs combining all signatures by sum up R and sum up S.
+func CombineSignatures(rawSignatures ...tss.Signature) (tss.Signature, error) {
+	var allR tss.Points
+	var allS tss.Scalars
+	for idx, rawSignature := range rawSignatures {
+		r, s, err := splitSignature(rawSignature)
+		if err != nil {
+			return nil, tss.NewError(err, "parse signature: index: %d", idx)
+		}
+
+		allR = append(allR, r)
+		allS = append(allS, s)
+	}
+
+	r, err := SumPoints(allR...)
+	if err != nil {
+		return nil, tss.NewError(err, "sum R")
+	}
+
+	s, err := SumScalars(allS...)
+	if err != nil {
+		return nil, tss.NewError(err, "sum S")
+	}
+
+	return NewSignature(r, s)
+}
+
+// SignSigning performs signing using the group public nonce, group public key, data, Lagrange coefficient,
+// own private nonce, and own private key.
+func SignSigning(
+	groupPubNonce tss.Point,
+	groupPubKey tss.Point,
+	data []byte,
+	rawLagrange tss.Scalar,
+	ownPrivNonce tss.Scalar,
+	ownPrivKey tss.Scalar,
+) (tss.Signature, error) {
+	challenge := HashChallenge(groupPubNonce, groupPubKey, data)
+	return Sign(ownPrivKey, challenge, ownPrivNonce, rawLagrange)
+}
+
+// VerifySignature verifies the signature using the group public nonce, group public key, data, Lagrange coefficient,
+// and own public key.
+func VerifySignature(
+	groupPubNonce tss.Point,
+	groupPubKey tss.Point,
+	data []byte,
+	rawLagrange tss.Scalar,
+	signature tss.Signature,
+	ownPubKey tss.Point,
+) error {
+	r, s, err := splitSignature(signature)
+	if err != nil {
+		return err
+	}
+
+	challenge := HashChallenge(groupPubNonce, groupPubKey, data)
+	return Verify(r, s, challenge, ownPubKey, rawLagrange)
+}
+
+// VerifyGroupSignature verifies the group signature using the group public key, data.
+// A valid group signature is also a valid RFC 8032 Ed25519 signature of the data.
+func VerifyGroupSignature(
+	groupPubKey tss.Point,
+	data []byte,
+	signature tss.Signature,
+) error {
+	r, s, err := splitSignature(signature)
+	if err != nil {
+		return err
+	}
+
+	challenge := HashChallenge(r, groupPubKey, data)
+	return Verify(r, s, challenge, groupPubKey, nil)
+}
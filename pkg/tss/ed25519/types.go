@@ -0,0 +1,181 @@
+package ed25519
+
+import (
+	"encoding/binary"
+
+	"filippo.io/edwards25519"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+)
+
+const (
+	// ScalarSize is the size of an encoded Ed25519 scalar in bytes.
+	ScalarSize = 32
+
+	// PointSize is the size of an encoded Ed25519 point in bytes.
+	PointSize = 32
+
+	// SignatureSize is the size of an encoded Ed25519 signature (R || S) in bytes.
+	SignatureSize = PointSize + ScalarSize
+)
+
+// orderMinusOne is the order of the prime-order subgroup minus one (l - 1) in little-endian form.
+var orderMinusOne, _ = edwards25519.NewScalar().SetCanonicalBytes([]byte{
+	0xec, 0xd3, 0xf5, 0x5c, 0x1a, 0x63, 0x12, 0x58,
+	0xd6, 0x9c, 0xf7, 0xa2, 0xde, 0xf9, 0xde, 0x14,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10,
+})
+
+// ValidateScalar returns an error if the scalar is not a canonical non-zero Ed25519 scalar.
+func ValidateScalar(s tss.Scalar) error {
+	scalar, err := parseScalar(s)
+	if err != nil {
+		return err
+	}
+
+	if scalar.Equal(edwards25519.NewScalar()) == 1 {
+		return tss.NewError(tss.ErrInvalidOrder, "zero scalar")
+	}
+
+	return nil
+}
+
+// ValidatePoint returns an error if the point is not a valid Ed25519 point of the prime-order subgroup
+// or if it is the identity point.
+func ValidatePoint(p tss.Point) error {
+	point, err := parsePoint(p)
+	if err != nil {
+		return err
+	}
+
+	if point.Equal(edwards25519.NewIdentityPoint()) == 1 {
+		return tss.NewError(tss.ErrNotOnCurve, "identity point")
+	}
+
+	// [l]P is the identity only for points of the prime-order subgroup.
+	check := new(edwards25519.Point).ScalarMult(orderMinusOne, point)
+	check.Add(check, point)
+	if check.Equal(edwards25519.NewIdentityPoint()) != 1 {
+		return tss.NewError(tss.ErrNotOnCurve, "small order component")
+	}
+
+	return nil
+}
+
+// ValidateSignature returns an error if the signature is not a valid encoded Ed25519 signature.
+func ValidateSignature(sig tss.Signature) error {
+	r, s, err := splitSignature(sig)
+	if err != nil {
+		return err
+	}
+
+	if err := ValidatePoint(r); err != nil {
+		return tss.NewError(err, "parse r")
+	}
+
+	if _, err := parseScalar(s); err != nil {
+		return tss.NewError(err, "parse s")
+	}
+
+	return nil
+}
+
+// NewSignature creates an Ed25519 signature from its R and S components. The encoding R || S is the one
+// of RFC 8032, so a group signature can be verified by any standard Ed25519 verifier.
+func NewSignature(r tss.Point, s tss.Scalar) (tss.Signature, error) {
+	if len(r) != PointSize {
+		return nil, tss.NewError(tss.ErrInvalidLength, "length of r: %d != %d", len(r), PointSize)
+	}
+
+	if len(s) != ScalarSize {
+		return nil, tss.NewError(tss.ErrInvalidLength, "length of s: %d != %d", len(s), ScalarSize)
+	}
+
+	return tss.Signature(tss.ConcatBytes(r, s)), nil
+}
+
+// SignatureR returns the R part of an Ed25519 signature, or an empty point if the signature is too short.
+func SignatureR(sig tss.Signature) tss.Point {
+	if len(sig) < PointSize {
+		return []byte{}
+	}
+	return tss.Point(sig[:PointSize])
+}
+
+// splitSignature splits an Ed25519 signature into its R and S components.
+func splitSignature(sig tss.Signature) (tss.Point, tss.Scalar, error) {
+	if len(sig) != SignatureSize {
+		return nil, nil, tss.NewError(tss.ErrInvalidLength, "length: %d != %d", len(sig), SignatureSize)
+	}
+
+	return tss.Point(sig[:PointSize]), tss.Scalar(sig[PointSize:]), nil
+}
+
+// parseScalar converts a Scalar to an edwards25519.Scalar.
+func parseScalar(s tss.Scalar) (*edwards25519.Scalar, error) {
+	if len(s) != ScalarSize {
+		return nil, tss.NewError(tss.ErrInvalidLength, "length: %d != %d", len(s), ScalarSize)
+	}
+
+	scalar, err := edwards25519.NewScalar().SetCanonicalBytes(s)
+	if err != nil {
+		return nil, tss.NewError(tss.ErrInvalidOrder, "%s", err.Error())
+	}
+
+	return scalar, nil
+}
+
+// parseScalars converts Scalars to a slice of edwards25519.Scalar.
+func parseScalars(ss tss.Scalars) ([]*edwards25519.Scalar, error) {
+	var scalars []*edwards25519.Scalar
+	for idx, s := range ss {
+		scalar, err := parseScalar(s)
+		if err != nil {
+			return nil, tss.NewError(err, "index: %d", idx)
+		}
+
+		scalars = append(scalars, scalar)
+	}
+
+	return scalars, nil
+}
+
+// parsePoint converts a Point to an edwards25519.Point.
+func parsePoint(p tss.Point) (*edwards25519.Point, error) {
+	if len(p) != PointSize {
+		return nil, tss.NewError(tss.ErrInvalidLength, "length: %d != %d", len(p), PointSize)
+	}
+
+	point, err := new(edwards25519.Point).SetBytes(p)
+	if err != nil {
+		return nil, tss.NewError(tss.ErrParseError, "%s", err.Error())
+	}
+
+	return point, nil
+}
+
+// parsePoints converts Points to a slice of edwards25519.Point.
+func parsePoints(ps tss.Points) ([]*edwards25519.Point, error) {
+	var points []*edwards25519.Point
+	for idx, p := range ps {
+		point, err := parsePoint(p)
+		if err != nil {
+			return nil, tss.NewError(err, "index: %d", idx)
+		}
+
+		points = append(points, point)
+	}
+
+	return points, nil
+}
+
+// memberIDScalar converts a MemberID to an edwards25519.Scalar.
+func memberIDScalar(mid tss.MemberID) *edwards25519.Scalar {
+	var buf [ScalarSize]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(mid))
+
+	// A uint64 value is always less than the group order, so it is canonical.
+	scalar, _ := edwards25519.NewScalar().SetCanonicalBytes(buf[:])
+	return scalar
+}
@@ -24,6 +24,7 @@ var (
 	ErrNotInOrder            = ErrorKind("not in order")
 	ErrInvalidPubkeyFormat   = ErrorKind("invalid pubkey format")
 	ErrRandomError           = ErrorKind("random error")
+	ErrInvalidReshareCommit  = ErrorKind("invalid reshare commit")
 )
 
 // Error represents a tss error.
@@ -0,0 +1,88 @@
+package tss
+
+import (
+	"bytes"
+)
+
+// Merkle tree prefixes that separate the hash of a leaf from the hash of an inner node.
+var (
+	merkleLeafPrefix = []byte{0x00}
+	merkleNodePrefix = []byte{0x01}
+)
+
+// HashMerkleLeaf computes the merkle leaf of the given data.
+func HashMerkleLeaf(data []byte) []byte {
+	return Hash(merkleLeafPrefix, data)
+}
+
+// HashMerkleNode computes the parent of two merkle nodes. The nodes are sorted before hashing, so
+// a proof doesn't need to carry the position of each sibling.
+func HashMerkleNode(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+
+	return Hash(merkleNodePrefix, a, b)
+}
+
+// ComputeMerkleRoot computes the merkle root of the given leaves. A node without a sibling is
+// carried up to the next level as it is.
+func ComputeMerkleRoot(leaves [][]byte) ([]byte, error) {
+	if len(leaves) == 0 {
+		return nil, NewError(ErrInvalidLength, "no merkle leaf")
+	}
+
+	level := leaves
+	for len(level) > 1 {
+		level = nextMerkleLevel(level)
+	}
+
+	return level[0], nil
+}
+
+// ComputeMerkleProof computes the list of sibling hashes from the leaf at the given index up to
+// the merkle root.
+func ComputeMerkleProof(leaves [][]byte, index int) ([][]byte, error) {
+	if index < 0 || index >= len(leaves) {
+		return nil, NewError(ErrInvalidOrder, "leaf index %d out of range", index)
+	}
+
+	var proof [][]byte
+	level := leaves
+	for len(level) > 1 {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+
+		level = nextMerkleLevel(level)
+		index /= 2
+	}
+
+	return proof, nil
+}
+
+// VerifyMerkleProof verifies that the leaf is included in the merkle tree of the given root.
+func VerifyMerkleProof(leaf []byte, proof [][]byte, root []byte) bool {
+	node := leaf
+	for _, sibling := range proof {
+		node = HashMerkleNode(node, sibling)
+	}
+
+	return bytes.Equal(node, root)
+}
+
+// nextMerkleLevel computes the parent level of the given merkle nodes.
+func nextMerkleLevel(nodes [][]byte) [][]byte {
+	next := make([][]byte, 0, (len(nodes)+1)/2)
+	for i := 0; i < len(nodes); i += 2 {
+		if i+1 == len(nodes) {
+			next = append(next, nodes[i])
+			continue
+		}
+
+		next = append(next, HashMerkleNode(nodes[i], nodes[i+1]))
+	}
+
+	return next
+}
@@ -0,0 +1,55 @@
+package tss_test
+
+import (
+	"fmt"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+)
+
+func (suite *TSSTestSuite) TestComputeMerkleRoot() {
+	a := tss.HashMerkleLeaf([]byte("a"))
+	b := tss.HashMerkleLeaf([]byte("b"))
+	c := tss.HashMerkleLeaf([]byte("c"))
+
+	// Single leaf is the root itself.
+	root, err := tss.ComputeMerkleRoot([][]byte{a})
+	suite.Require().NoError(err)
+	suite.Require().Equal(a, root)
+
+	// The parent doesn't depend on the order of the children.
+	suite.Require().Equal(tss.HashMerkleNode(a, b), tss.HashMerkleNode(b, a))
+
+	// A node without a sibling is carried up to the next level.
+	root, err = tss.ComputeMerkleRoot([][]byte{a, b, c})
+	suite.Require().NoError(err)
+	suite.Require().Equal(tss.HashMerkleNode(tss.HashMerkleNode(a, b), c), root)
+
+	// No leaf
+	_, err = tss.ComputeMerkleRoot(nil)
+	suite.Require().ErrorIs(err, tss.ErrInvalidLength)
+}
+
+func (suite *TSSTestSuite) TestComputeAndVerifyMerkleProof() {
+	for _, size := range []int{1, 2, 3, 4, 5, 8, 13} {
+		var leaves [][]byte
+		for i := 0; i < size; i++ {
+			leaves = append(leaves, tss.HashMerkleLeaf([]byte(fmt.Sprintf("message %d", i))))
+		}
+
+		root, err := tss.ComputeMerkleRoot(leaves)
+		suite.Require().NoError(err)
+
+		for i, leaf := range leaves {
+			proof, err := tss.ComputeMerkleProof(leaves, i)
+			suite.Require().NoError(err)
+			suite.Require().True(tss.VerifyMerkleProof(leaf, proof, root), "size %d, index %d", size, i)
+
+			// A leaf that isn't in the tree shouldn't be verified.
+			suite.Require().False(tss.VerifyMerkleProof(tss.HashMerkleLeaf([]byte("other")), proof, root))
+		}
+	}
+
+	// Out of range index
+	_, err := tss.ComputeMerkleProof([][]byte{tss.HashMerkleLeaf([]byte("a"))}, 1)
+	suite.Require().ErrorIs(err, tss.ErrInvalidOrder)
+}
@@ -0,0 +1,111 @@
+package tss
+
+import (
+	"bytes"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+// GenerateReshareRound1Info generates the data of round 1 for a member in the resharing process of TSS.
+// A dealer gives its weighted share of the source group secret as the secret that its polynomial
+// shares, while a member that does not deal gives a nil secret and gets only the one-time information.
+func GenerateReshareRound1Info(
+	mid MemberID,
+	threshold uint64,
+	dkgContext []byte,
+	secret Scalar,
+) (*Round1Info, error) {
+	// Generate threshold key pairs (onetime, commits without a0).
+	kps, err := GenerateKeyPairs(threshold)
+	if err != nil {
+		return nil, NewError(err, "generate key pairs")
+	}
+
+	// Get one-time information.
+	oneTimePrivKey := kps[0].PrivKey
+	oneTimePubKey := kps[0].PubKey
+	oneTimeSignature, err := SignOneTime(mid, dkgContext, oneTimePubKey, oneTimePrivKey)
+	if err != nil {
+		return nil, NewError(err, "sign one time")
+	}
+
+	round1Info := &Round1Info{
+		OneTimePrivKey:   oneTimePrivKey,
+		OneTimePubKey:    oneTimePubKey,
+		OneTimeSignature: oneTimeSignature,
+	}
+	if secret == nil {
+		return round1Info, nil
+	}
+
+	// Get a0 information from the secret.
+	a0PrivKey := secret
+	a0PubKey := secret.Point()
+	a0Signature, err := SignA0(mid, dkgContext, a0PubKey, a0PrivKey)
+	if err != nil {
+		return nil, NewError(err, "sign A0")
+	}
+
+	// Get coefficients.
+	coefficientCommits := Points{a0PubKey}
+	coefficients := Scalars{a0PrivKey}
+	for i := 1; i < len(kps); i++ {
+		coefficientCommits = append(coefficientCommits, kps[i].PubKey)
+		coefficients = append(coefficients, kps[i].PrivKey)
+	}
+
+	round1Info.A0PrivKey = a0PrivKey
+	round1Info.A0PubKey = a0PubKey
+	round1Info.A0Signature = a0Signature
+	round1Info.Coefficients = coefficients
+	round1Info.CoefficientCommits = coefficientCommits
+
+	return round1Info, nil
+}
+
+// ComputeReshareSecret computes the secret that a dealer shares in the resharing process from its private key
+// in the source group. The formula used is: a0 = λi * si, where λi is the Lagrange coefficient of the dealer
+// over the source member IDs of all dealers, so the secrets of all dealers sum to the source group secret.
+func ComputeReshareSecret(sourceMid MemberID, sourceDealerIDs []MemberID, rawPrivKey Scalar) (Scalar, error) {
+	lagrange, err := ComputeLagrangeCoefficient(sourceMid, sourceDealerIDs)
+	if err != nil {
+		return nil, NewError(err, "compute lagrange coefficient")
+	}
+
+	secret := new(secp256k1.ModNScalar).Mul2(lagrange.modNScalar(), rawPrivKey.modNScalar())
+	return NewScalarFromModNScalar(secret), nil
+}
+
+// ComputeReshareCommit computes the commit of the secret that a dealer shares in the resharing process from
+// its own public key in the source group. The formula used is: A0 = λi * Yi
+func ComputeReshareCommit(sourceMid MemberID, sourceDealerIDs []MemberID, rawPubKey Point) (Point, error) {
+	lagrange, err := ComputeLagrangeCoefficient(sourceMid, sourceDealerIDs)
+	if err != nil {
+		return nil, NewError(err, "compute lagrange coefficient")
+	}
+
+	pubKey, err := rawPubKey.jacobianPoint()
+	if err != nil {
+		return nil, NewError(err, "parse public key")
+	}
+
+	commit := new(secp256k1.JacobianPoint)
+	secp256k1.ScalarMultNonConst(lagrange.modNScalar(), pubKey, commit)
+
+	return NewPointFromJacobianPoint(commit), nil
+}
+
+// VerifyReshareCommit verifies that the A0 commit of a dealer in the resharing process commits to its
+// weighted share of the source group secret, which keeps the public key of the source group.
+func VerifyReshareCommit(sourceMid MemberID, sourceDealerIDs []MemberID, rawPubKey Point, a0Commit Point) error {
+	commit, err := ComputeReshareCommit(sourceMid, sourceDealerIDs, rawPubKey)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(commit, a0Commit) {
+		return ErrInvalidReshareCommit
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package tss_test
+
+import (
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/pkg/tss/testutil"
+)
+
+func (suite *TSSTestSuite) TestGenerateReshareRound1Info() {
+	mid := tss.NewMemberID(1)
+	dkgContext := []byte("DKGContext")
+	threshold := uint64(3)
+	secret := suite.privKey
+
+	// Dealer case
+	data, err := tss.GenerateReshareRound1Info(mid, threshold, dkgContext, secret)
+	suite.Require().NoError(err)
+
+	err = tss.VerifyOneTimeSignature(mid, dkgContext, data.OneTimeSignature, data.OneTimePubKey)
+	suite.Require().NoError(err)
+
+	err = tss.VerifyA0Signature(mid, dkgContext, data.A0Signature, data.A0PubKey)
+	suite.Require().NoError(err)
+
+	suite.Require().Len(data.Coefficients, int(threshold))
+	suite.Require().Equal(secret, data.Coefficients[0])
+	suite.Require().Equal(suite.pubKey, data.CoefficientCommits[0])
+	for i, coeff := range data.Coefficients {
+		suite.Require().Equal(data.CoefficientCommits[i], coeff.Point())
+	}
+
+	// Non-dealer case
+	data, err = tss.GenerateReshareRound1Info(mid, threshold, dkgContext, nil)
+	suite.Require().NoError(err)
+
+	err = tss.VerifyOneTimeSignature(mid, dkgContext, data.OneTimeSignature, data.OneTimePubKey)
+	suite.Require().NoError(err)
+	suite.Require().Nil(data.A0Signature)
+	suite.Require().Empty(data.Coefficients)
+	suite.Require().Empty(data.CoefficientCommits)
+}
+
+func (suite *TSSTestSuite) TestVerifyReshareCommit() {
+	for _, tc := range suite.testCases {
+		sourceDealerIDs := []tss.MemberID{}
+		for _, member := range tc.Group.Members {
+			sourceDealerIDs = append(sourceDealerIDs, member.ID)
+		}
+
+		for _, member := range tc.Group.Members {
+			suite.Run(tc.Name, func() {
+				secret, err := tss.ComputeReshareSecret(member.ID, sourceDealerIDs, member.PrivKey)
+				suite.Require().NoError(err)
+
+				// Success case
+				err = tss.VerifyReshareCommit(member.ID, sourceDealerIDs, member.PubKey(), secret.Point())
+				suite.Require().NoError(err)
+
+				// Wrong commit case
+				err = tss.VerifyReshareCommit(member.ID, sourceDealerIDs, member.PubKey(), testutil.FalsePubKey)
+				suite.Require().ErrorIs(err, tss.ErrInvalidReshareCommit)
+
+				// Not a dealer case
+				err = tss.VerifyReshareCommit(member.ID, []tss.MemberID{}, member.PubKey(), secret.Point())
+				suite.Require().Error(err)
+			})
+		}
+	}
+}
+
+func (suite *TSSTestSuite) TestReshareKeepsGroupPublicKey() {
+	newThreshold := uint64(3)
+	dkgContext := []byte("DKGContext")
+
+	for _, tc := range suite.testCases {
+		suite.Run(tc.Name, func() {
+			// The first threshold members of the source group deal.
+			dealers := tc.Group.Members[:tc.Group.Threshold]
+			var sourceDealerIDs []tss.MemberID
+			for _, dealer := range dealers {
+				sourceDealerIDs = append(sourceDealerIDs, dealer.ID)
+			}
+
+			var a0Commits tss.Points
+			var coefficientsList []tss.Scalars
+			for i, dealer := range dealers {
+				secret, err := tss.ComputeReshareSecret(dealer.ID, sourceDealerIDs, dealer.PrivKey)
+				suite.Require().NoError(err)
+
+				data, err := tss.GenerateReshareRound1Info(tss.NewMemberID(i+1), newThreshold, dkgContext, secret)
+				suite.Require().NoError(err)
+
+				err = tss.VerifyReshareCommit(dealer.ID, sourceDealerIDs, dealer.PubKey(), data.CoefficientCommits[0])
+				suite.Require().NoError(err)
+
+				a0Commits = append(a0Commits, data.CoefficientCommits[0])
+				coefficientsList = append(coefficientsList, data.Coefficients)
+			}
+
+			// The sum of A0 commits is the public key of the source group.
+			pubKey, err := tss.ComputeGroupPublicKey(a0Commits...)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.Group.PubKey, pubKey)
+
+			// The new private keys of any threshold members interpolate the source group secret.
+			var newMids []tss.MemberID
+			var weightedPubKeys tss.Points
+			for mid := tss.MemberID(1); mid <= tss.MemberID(newThreshold); mid++ {
+				newMids = append(newMids, mid)
+			}
+			for _, mid := range newMids {
+				var secretShares tss.Scalars
+				for _, coefficients := range coefficientsList {
+					secretShare, err := tss.ComputeSecretShare(coefficients, mid)
+					suite.Require().NoError(err)
+					secretShares = append(secretShares, secretShare)
+				}
+
+				privKey, err := tss.ComputeOwnPrivateKey(secretShares...)
+				suite.Require().NoError(err)
+
+				weightedPubKey, err := tss.ComputeReshareCommit(mid, newMids, privKey.Point())
+				suite.Require().NoError(err)
+				weightedPubKeys = append(weightedPubKeys, weightedPubKey)
+			}
+
+			newPubKey, err := tss.SumPoints(weightedPubKeys...)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.Group.PubKey, newPubKey)
+		})
+	}
+}
@@ -0,0 +1 @@
+bandd tx tunnel create-tunnel multi ./scripts/tunnel/routes.json 1000000000uband 60 ./scripts/tunnel/signal_deviations.json --from requester --keyring-backend test --gas-prices 0.0025uband -y --chain-id bandchain
@@ -0,0 +1 @@
+bandd tx tunnel retry-packet 1 1 0 --from requester --keyring-backend test --gas-prices 0.0025uband -y --chain-id bandchain
@@ -0,0 +1 @@
+bandd tx tunnel set-top-up-config 1 100000uband --funder $(bandd keys show account1 -a --keyring-backend test) --grant-type feegrant --top-up-amount 1000000uband --from requester --keyring-backend test --gas-prices 0.0025uband -y --chain-id bandchain
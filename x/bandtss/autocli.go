@@ -30,6 +30,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 							Name:  "status",
 							Usage: "Filter members by status",
 						},
+						"group_name": {
+							Name:  "group-name",
+							Usage: "The name of the named group to query members from.",
+						},
 					},
 				},
 				{
@@ -66,6 +70,19 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query the group transition information",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{},
 				},
+				{
+					RpcMethod: "NamedGroup",
+					Use:       "named-group [name]",
+					Short:     "Query the named group information by name",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+					},
+				},
+				{
+					RpcMethod: "NamedGroups",
+					Use:       "named-groups",
+					Short:     "Query all named groups",
+				},
 				{
 					RpcMethod: "Params",
 					Use:       "params",
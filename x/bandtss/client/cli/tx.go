@@ -21,6 +21,8 @@ import (
 const (
 	flagExpiration = "expiration"
 	flagFeeLimit   = "fee-limit"
+	flagBatch      = "batch"
+	flagGroupName  = "group-name"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -63,6 +65,8 @@ func GetTxCmdRequestSignature() *cobra.Command {
 	}
 
 	cmd.PersistentFlags().String(flagFeeLimit, "", "The maximum tokens that will be paid for this request")
+	cmd.PersistentFlags().Bool(flagBatch, false, "Sign the content together with other requests over a merkle root")
+	cmd.PersistentFlags().String(flagGroupName, "", "The name of the named group to sign the content, if not the current group")
 
 	_ = cmd.MarkPersistentFlagRequired(flagFeeLimit)
 
@@ -111,6 +115,16 @@ func GetTxCmdTextRequestSignature() *cobra.Command {
 				return err
 			}
 
+			msg.Batch, err = cmd.Flags().GetBool(flagBatch)
+			if err != nil {
+				return err
+			}
+
+			msg.GroupName, err = cmd.Flags().GetString(flagGroupName)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
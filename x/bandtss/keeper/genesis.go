@@ -38,6 +38,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 
 	k.SetCurrentGroup(ctx, data.CurrentGroup)
 
+	for _, namedGroup := range data.NamedGroups {
+		k.SetNamedGroup(ctx, namedGroup)
+	}
+
 	for _, member := range data.Members {
 		k.SetMember(ctx, member)
 	}
@@ -49,5 +53,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		Params:       k.GetParams(ctx),
 		Members:      k.GetMembers(ctx),
 		CurrentGroup: k.GetCurrentGroup(ctx),
+		NamedGroups:  k.GetNamedGroups(ctx),
 	}
 }
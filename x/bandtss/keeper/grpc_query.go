@@ -51,9 +51,17 @@ func (q queryServer) Member(
 	incomingGroupID := q.k.GetIncomingGroupID(ctx)
 	incomingGroupMember, _ := q.k.GetMember(ctx, address, incomingGroupID)
 
+	var namedGroupMembers []types.Member
+	for _, namedGroup := range q.k.GetNamedGroups(ctx) {
+		if member, err := q.k.GetMember(ctx, address, namedGroup.GroupID); err == nil {
+			namedGroupMembers = append(namedGroupMembers, member)
+		}
+	}
+
 	return &types.QueryMemberResponse{
 		CurrentGroupMember:  currentGroupMember,
 		IncomingGroupMember: incomingGroupMember,
+		NamedGroupMembers:   namedGroupMembers,
 	}, nil
 }
 
@@ -65,7 +73,13 @@ func (q queryServer) Members(
 ) (*types.QueryMembersResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	var groupID tss.GroupID
-	if req.IsIncomingGroup {
+	if req.GroupName != "" {
+		namedGroup, err := q.k.GetNamedGroup(ctx, req.GroupName)
+		if err != nil {
+			return nil, err
+		}
+		groupID = namedGroup.GroupID
+	} else if req.IsIncomingGroup {
 		groupID = q.k.GetIncomingGroupID(ctx)
 	} else {
 		groupID = q.k.GetCurrentGroup(ctx).GroupID
@@ -221,6 +235,44 @@ func (q queryServer) Signing(
 		Requester:                  signing.Requester,
 		CurrentGroupSigningResult:  currentGroupSigningResult,
 		IncomingGroupSigningResult: incomingGroupSigningResult,
+		GroupName:                  signing.GroupName,
+	}, nil
+}
+
+// NamedGroup queries the named group information.
+func (q queryServer) NamedGroup(
+	goCtx context.Context,
+	req *types.QueryNamedGroupRequest,
+) (*types.QueryNamedGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	namedGroup, err := q.k.GetNamedGroup(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := q.k.tssKeeper.GetGroup(ctx, namedGroup.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryNamedGroupResponse{
+		NamedGroup: namedGroup,
+		Size_:      group.Size_,
+		Threshold:  group.Threshold,
+		PubKey:     group.PubKey,
+	}, nil
+}
+
+// NamedGroups queries all named groups.
+func (q queryServer) NamedGroups(
+	goCtx context.Context,
+	req *types.QueryNamedGroupsRequest,
+) (*types.QueryNamedGroupsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryNamedGroupsResponse{
+		NamedGroups: q.k.GetNamedGroups(ctx),
 	}, nil
 }
 
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"fmt"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/x/bandtss/types"
+	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
+)
+
+// CreateNamedGroup creates a new tss group for the given name. The named group is active and
+// its members are added into the module once the group creation is completed.
+func (k Keeper) CreateNamedGroup(
+	ctx sdk.Context,
+	name string,
+	members []sdk.AccAddress,
+	threshold uint64,
+	curve tsstypes.Curve,
+	feePerSigner sdk.Coins,
+) (types.NamedGroup, error) {
+	if k.HasNamedGroup(ctx, name) {
+		return types.NamedGroup{}, types.ErrNamedGroupAlreadyExists.Wrapf("name: %s", name)
+	}
+
+	groupID, err := k.tssKeeper.CreateGroup(ctx, members, threshold, types.ModuleName, curve)
+	if err != nil {
+		return types.NamedGroup{}, err
+	}
+
+	namedGroup := types.NewNamedGroup(name, groupID, feePerSigner, types.NAMED_GROUP_STATUS_CREATING_GROUP)
+	k.SetNamedGroup(ctx, namedGroup)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateNamedGroup,
+		sdk.NewAttribute(types.AttributeKeyGroupName, name),
+		sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", groupID)),
+		sdk.NewAttribute(types.AttributeKeyFeePerSigner, feePerSigner.String()),
+	))
+
+	return namedGroup, nil
+}
+
+// RemoveNamedGroup removes the named group and its members from the module. The signings
+// that are already requested from the group are still processed by the tss module.
+func (k Keeper) RemoveNamedGroup(ctx sdk.Context, name string) error {
+	namedGroup, err := k.GetNamedGroup(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if namedGroup.Status == types.NAMED_GROUP_STATUS_ACTIVE {
+		k.DeleteMembers(ctx, namedGroup.GroupID)
+	}
+	k.DeleteNamedGroup(ctx, name)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveNamedGroup,
+		sdk.NewAttribute(types.AttributeKeyGroupName, name),
+		sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", namedGroup.GroupID)),
+	))
+
+	return nil
+}
+
+// handleNamedGroupCreationFailed removes the named group whose group creation is failed or
+// expired. It returns true if the group is created for a named group.
+func (k Keeper) handleNamedGroupCreationFailed(ctx sdk.Context, groupID tss.GroupID) bool {
+	namedGroup, found := k.GetNamedGroupByGroupID(ctx, groupID)
+	if !found {
+		return false
+	}
+
+	if namedGroup.Status == types.NAMED_GROUP_STATUS_CREATING_GROUP {
+		k.DeleteNamedGroup(ctx, namedGroup.Name)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeNamedGroupFailed,
+			sdk.NewAttribute(types.AttributeKeyGroupName, namedGroup.Name),
+			sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", groupID)),
+		))
+	}
+
+	return true
+}
+
+// GetActiveNamedGroup retrieves the named group of the given name and returns an error if the
+// group is not ready to sign requests.
+func (k Keeper) GetActiveNamedGroup(ctx sdk.Context, name string) (types.NamedGroup, error) {
+	namedGroup, err := k.GetNamedGroup(ctx, name)
+	if err != nil {
+		return types.NamedGroup{}, err
+	}
+
+	if namedGroup.Status != types.NAMED_GROUP_STATUS_ACTIVE {
+		return types.NamedGroup{}, types.ErrNamedGroupNotActive.Wrapf("name: %s", name)
+	}
+
+	return namedGroup, nil
+}
+
+// GetNamedGroupByGroupID retrieves the named group that is signed by the given group ID.
+func (k Keeper) GetNamedGroupByGroupID(ctx sdk.Context, groupID tss.GroupID) (types.NamedGroup, bool) {
+	for _, namedGroup := range k.GetNamedGroups(ctx) {
+		if namedGroup.GroupID == groupID {
+			return namedGroup, true
+		}
+	}
+
+	return types.NamedGroup{}, false
+}
+
+// =====================================
+// Named group store
+// =====================================
+
+// SetNamedGroup sets a named group information in the store.
+func (k Keeper) SetNamedGroup(ctx sdk.Context, namedGroup types.NamedGroup) {
+	ctx.KVStore(k.storeKey).Set(types.NamedGroupStoreKey(namedGroup.Name), k.cdc.MustMarshal(&namedGroup))
+}
+
+// HasNamedGroup checks that the named group of the given name exists in the store.
+func (k Keeper) HasNamedGroup(ctx sdk.Context, name string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.NamedGroupStoreKey(name))
+}
+
+// GetNamedGroup retrieves a named group by its name.
+func (k Keeper) GetNamedGroup(ctx sdk.Context, name string) (types.NamedGroup, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.NamedGroupStoreKey(name))
+	if bz == nil {
+		return types.NamedGroup{}, types.ErrNamedGroupNotFound.Wrapf("name: %s", name)
+	}
+
+	var namedGroup types.NamedGroup
+	k.cdc.MustUnmarshal(bz, &namedGroup)
+	return namedGroup, nil
+}
+
+// GetNamedGroupsIterator gets an iterator over all named groups.
+func (k Keeper) GetNamedGroupsIterator(ctx sdk.Context) dbm.Iterator {
+	return storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.NamedGroupStoreKeyPrefix)
+}
+
+// GetNamedGroups retrieves all named groups from the store.
+func (k Keeper) GetNamedGroups(ctx sdk.Context) []types.NamedGroup {
+	iterator := k.GetNamedGroupsIterator(ctx)
+	defer iterator.Close()
+
+	var namedGroups []types.NamedGroup
+	for ; iterator.Valid(); iterator.Next() {
+		var namedGroup types.NamedGroup
+		k.cdc.MustUnmarshal(iterator.Value(), &namedGroup)
+		namedGroups = append(namedGroups, namedGroup)
+	}
+
+	return namedGroups
+}
+
+// DeleteNamedGroup removes the named group of the given name from the store.
+func (k Keeper) DeleteNamedGroup(ctx sdk.Context, name string) {
+	ctx.KVStore(k.storeKey).Delete(types.NamedGroupStoreKey(name))
+}
@@ -7,31 +7,34 @@ import (
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/x/bandtss/types"
 )
 
 // AllocateTokens allocates a portion of fee collected in the previous blocks to members in the
-// current group. Note that this reward is also subjected to comm tax and this reward is
-// calculate after allocation in oracle module.
+// current group and the active named groups. The reward is split equally among the groups that
+// have valid members and then among the valid members of each group. Note that this reward is
+// also subjected to comm tax and this reward is calculate after allocation in oracle module.
 func (k Keeper) AllocateTokens(ctx sdk.Context) error {
-	gid := k.GetCurrentGroup(ctx).GroupID
-	if gid == tss.GroupID(0) {
-		return nil
+	groupIDs := make([]tss.GroupID, 0)
+	if gid := k.GetCurrentGroup(ctx).GroupID; gid != tss.GroupID(0) {
+		groupIDs = append(groupIDs, gid)
+	}
+	for _, namedGroup := range k.GetNamedGroups(ctx) {
+		if namedGroup.Status == types.NAMED_GROUP_STATUS_ACTIVE {
+			groupIDs = append(groupIDs, namedGroup.GroupID)
+		}
 	}
 
-	// Get all active members in the current group.
-	members := k.tssKeeper.MustGetMembers(ctx, gid)
-	validMembers := make([]sdk.AccAddress, 0, len(members))
-	for _, m := range members {
-		acc := sdk.MustAccAddressFromBech32(m.Address)
-
-		deQueue := k.tssKeeper.GetDEQueue(ctx, acc)
-		if deQueue.Tail > deQueue.Head && m.IsActive {
-			validMembers = append(validMembers, acc)
+	// Get all active members in each group that have DEs on the curve of the group.
+	groupMembers := make([][]sdk.AccAddress, 0, len(groupIDs))
+	for _, gid := range groupIDs {
+		if validMembers := k.getValidMembers(ctx, gid); len(validMembers) > 0 {
+			groupMembers = append(groupMembers, validMembers)
 		}
 	}
 
 	// No active members performing tss tasks, nothing needs to be done here.
-	if len(validMembers) == 0 {
+	if len(groupMembers) == 0 {
 		return nil
 	}
 
@@ -56,26 +59,49 @@ func (k Keeper) AllocateTokens(ctx sdk.Context) error {
 	}
 
 	rewardMultiplier := math.LegacyOneDec().Sub(communityTax)
+	groupReward := tssReward.MulDecTruncate(rewardMultiplier).
+		MulDecTruncate(math.LegacyOneDec().QuoTruncate(math.LegacyNewDec(int64(len(groupMembers)))))
 
-	// calculate the reward for each active member.
-	n := math.LegacyNewDec(int64(len(validMembers)))
-	powerFraction := math.LegacyNewDec(1).QuoTruncate(n)
-	reward := tssReward.MulDecTruncate(rewardMultiplier).MulDecTruncate(powerFraction)
-	rewardInt, _ := reward.TruncateDecimal()
-
-	// Allocate non-community pool tokens to active members.
-	for _, acc := range validMembers {
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, distrtypes.ModuleName, acc, rewardInt)
-		if err != nil {
-			return err
+	// Allocate non-community pool tokens to active members of each group.
+	communityFund := tssRewardInt
+	for _, validMembers := range groupMembers {
+		// calculate the reward for each active member.
+		n := math.LegacyNewDec(int64(len(validMembers)))
+		powerFraction := math.LegacyNewDec(1).QuoTruncate(n)
+		reward := groupReward.MulDecTruncate(powerFraction)
+		rewardInt, _ := reward.TruncateDecimal()
+
+		for _, acc := range validMembers {
+			err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, distrtypes.ModuleName, acc, rewardInt)
+			if err != nil {
+				return err
+			}
 		}
+
+		communityFund = communityFund.Sub(rewardInt.MulInt(math.NewInt(int64(len(validMembers))))...)
 	}
 
 	// Allocate the remaining coins to the community pool.
-	communityFund := tssRewardInt.Sub(rewardInt.MulInt(math.NewInt(int64(len(validMembers))))...)
 	return k.distrKeeper.FundCommunityPool(
 		ctx,
 		communityFund,
 		k.authKeeper.GetModuleAccount(ctx, distrtypes.ModuleName).GetAddress(),
 	)
 }
+
+// getValidMembers returns the active members of the group that have DEs on the curve of the group.
+func (k Keeper) getValidMembers(ctx sdk.Context, groupID tss.GroupID) []sdk.AccAddress {
+	group := k.tssKeeper.MustGetGroup(ctx, groupID)
+	members := k.tssKeeper.MustGetMembers(ctx, groupID)
+	validMembers := make([]sdk.AccAddress, 0, len(members))
+	for _, m := range members {
+		acc := sdk.MustAccAddressFromBech32(m.Address)
+
+		deQueue := k.tssKeeper.GetDEQueue(ctx, group.Curve, acc)
+		if deQueue.Tail > deQueue.Head && m.IsActive {
+			validMembers = append(validMembers, acc)
+		}
+	}
+
+	return validMembers
+}
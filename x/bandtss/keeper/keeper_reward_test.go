@@ -145,7 +145,7 @@ func (s *AppTestSuite) TestAllocateTokensAllActive() {
 	k.SetCurrentGroup(ctx, types.NewCurrentGroup(groupCtx.GroupID, s.ctx.BlockTime()))
 
 	for _, acc := range groupCtx.Accounts {
-		deQueue := s.app.TSSKeeper.GetDEQueue(ctx, acc.Address)
+		deQueue := s.app.TSSKeeper.GetDEQueue(ctx, tsstypes.CURVE_SECP256K1, acc.Address)
 		s.Require().Greater(deQueue.Tail, deQueue.Head)
 	}
 
@@ -188,3 +188,46 @@ func (s *AppTestSuite) TestAllocateTokensAllActive() {
 		)
 	}
 }
+
+func (s *AppTestSuite) TestAllocateTokensWithNamedGroup() {
+	ctx, app, k := s.ctx, s.app, s.app.BandtssKeeper
+
+	feeCollector, err := SetupFeeCollector(app, ctx, k)
+	s.Require().NoError(err)
+
+	groupCtx := s.SetupNewGroup(3, 2)
+	_, err = s.CreateNamedGroup("fast", groupCtx.Accounts[:2], 2, nil)
+	s.Require().NoError(err)
+
+	balancesBefore := make([]sdk.Coins, len(groupCtx.Accounts))
+	for i, acc := range groupCtx.Accounts {
+		balancesBefore[i] = app.BankKeeper.GetAllBalances(ctx, acc.Address)
+	}
+
+	err = k.AllocateTokens(ctx)
+	s.Require().NoError(err)
+
+	s.Require().Equal(
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 500000)),
+		app.BankKeeper.GetAllBalances(ctx, feeCollector.GetAddress()),
+	)
+
+	// The reward is split equally among the two groups, then among members of each group.
+	// From 490000uband, each group gets 245000uband; a member of the current group gets 81666uband
+	// and a member of the named group gets 122500uband.
+	expRewards := []int64{204166, 204166, 81666}
+	for i, acc := range groupCtx.Accounts {
+		s.Require().Equal(
+			sdk.NewCoins(sdk.NewInt64Coin("uband", expRewards[i])),
+			app.BankKeeper.GetAllBalances(ctx, acc.Address).Sub(balancesBefore[i]...),
+			fmt.Sprintf("incorrect balance for member %d", i),
+		)
+	}
+
+	feePool, err := app.DistrKeeper.FeePool.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(
+		sdk.DecCoins{{Denom: "uband", Amount: math.LegacyNewDec(10002)}},
+		feePool.CommunityPool,
+	)
+}
@@ -13,16 +13,24 @@ import (
 	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
 )
 
-// CreateDirectSigningRequest creates a new signing process and returns the result.
+// CreateDirectSigningRequest creates a new signing process and returns the result. The content
+// is signed by the named group of the given name, or by the current group if the name is empty.
+// If batch is set, the content is signed together with other requests over a merkle root.
 func (k Keeper) CreateDirectSigningRequest(
 	ctx sdk.Context,
 	content tsstypes.Content,
 	memo string,
 	sender sdk.AccAddress,
 	feeLimit sdk.Coins,
+	groupName string,
+	batch bool,
 ) (types.SigningID, error) {
 	originator := tsstypes.NewDirectOriginator(ctx.ChainID(), sender.String(), memo)
-	return k.createSigningRequest(ctx, &originator, content, sender, feeLimit)
+	if groupName != "" {
+		return k.createNamedGroupSigningRequest(ctx, &originator, content, sender, feeLimit, groupName, batch)
+	}
+
+	return k.createSigningRequest(ctx, &originator, content, sender, feeLimit, batch)
 }
 
 func (k Keeper) CreateTunnelSigningRequest(
@@ -33,6 +41,8 @@ func (k Keeper) CreateTunnelSigningRequest(
 	content tsstypes.Content,
 	sender sdk.AccAddress,
 	feeLimit sdk.Coins,
+	groupName string,
+	batch bool,
 ) (types.SigningID, error) {
 	originator := tsstypes.NewTunnelOriginator(
 		ctx.ChainID(),
@@ -40,16 +50,22 @@ func (k Keeper) CreateTunnelSigningRequest(
 		destinationChainID,
 		destinationContractAddr,
 	)
-	return k.createSigningRequest(ctx, &originator, content, sender, feeLimit)
+	if groupName != "" {
+		return k.createNamedGroupSigningRequest(ctx, &originator, content, sender, feeLimit, groupName, batch)
+	}
+
+	return k.createSigningRequest(ctx, &originator, content, sender, feeLimit, batch)
 }
 
-// createSigningRequest creates a new signing process and returns the result.
+// createSigningRequest creates a new signing process of the current group, and of the incoming
+// group if any, and returns the result.
 func (k Keeper) createSigningRequest(
 	ctx sdk.Context,
 	originator tsstypes.Originator,
 	content tsstypes.Content,
 	sender sdk.AccAddress,
 	feeLimit sdk.Coins,
+	batch bool,
 ) (types.SigningID, error) {
 	currentGroupID := k.GetCurrentGroup(ctx).GroupID
 	incomingGroupID := k.GetIncomingGroupID(ctx)
@@ -67,21 +83,7 @@ func (k Keeper) createSigningRequest(
 		}
 
 		feePerSigner = k.GetParams(ctx).FeePerSigner
-		totalFee = feePerSigner.MulInt(math.NewInt(int64(currentGroup.Threshold)))
-		for _, fc := range totalFee {
-			limitAmt := feeLimit.AmountOf(fc.Denom)
-			if fc.Amount.GT(limitAmt) {
-				return 0, types.ErrFeeExceedsLimit.Wrapf(
-					"require: %s, limit: %s%s",
-					fc.String(),
-					limitAmt.String(),
-					fc.Denom,
-				)
-			}
-		}
-
-		// transfer fee to module account.
-		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, totalFee)
+		totalFee, err = k.chargeSigningFee(ctx, sender, feePerSigner, currentGroup.Threshold, feeLimit)
 		if err != nil {
 			return 0, err
 		}
@@ -90,7 +92,7 @@ func (k Keeper) createSigningRequest(
 	currentGroupSigningID := tss.SigningID(0)
 	incomingGroupSigningID := tss.SigningID(0)
 	if currentGroupID != 0 {
-		signingID, err := k.tssKeeper.RequestSigning(ctx, currentGroupID, originator, content)
+		signingID, err := k.requestSigning(ctx, currentGroupID, originator, content, batch)
 		if err != nil {
 			return 0, err
 		}
@@ -101,7 +103,7 @@ func (k Keeper) createSigningRequest(
 	// the process, as the signing request for incoming group is optional.
 	if incomingGroupID != 0 {
 		cacheCtx, writeFn := ctx.CacheContext()
-		signingID, err := k.tssKeeper.RequestSigning(cacheCtx, incomingGroupID, originator, content)
+		signingID, err := k.requestSigning(cacheCtx, incomingGroupID, originator, content, batch)
 		if err != nil {
 			codespace, code, _ := errorsmod.ABCIInfo(err, false)
 			ctx.EventManager().EmitEvent(sdk.NewEvent(
@@ -122,7 +124,7 @@ func (k Keeper) createSigningRequest(
 	}
 
 	// save signing info
-	bandtssSigningID := k.AddSigning(ctx, feePerSigner, sender, currentGroupSigningID, incomingGroupSigningID)
+	bandtssSigningID := k.AddSigning(ctx, feePerSigner, sender, currentGroupSigningID, incomingGroupSigningID, "")
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -139,19 +141,129 @@ func (k Keeper) createSigningRequest(
 	return bandtssSigningID, nil
 }
 
-// GetSigningFee returns the fee required for signing a message.
-func (k Keeper) GetSigningFee(ctx sdk.Context) (sdk.Coins, error) {
-	currentGroup := k.GetCurrentGroup(ctx)
-	if currentGroup.GroupID == 0 {
-		return sdk.Coins{}, nil
+// createNamedGroupSigningRequest creates a new signing process of the named group of the given
+// name and returns the result.
+func (k Keeper) createNamedGroupSigningRequest(
+	ctx sdk.Context,
+	originator tsstypes.Originator,
+	content tsstypes.Content,
+	sender sdk.AccAddress,
+	feeLimit sdk.Coins,
+	groupName string,
+	batch bool,
+) (types.SigningID, error) {
+	namedGroup, err := k.GetActiveNamedGroup(ctx, groupName)
+	if err != nil {
+		return 0, err
+	}
+
+	// charged fee if necessary; If any coins are found that exceed limit then return error
+	feePerSigner := sdk.NewCoins()
+	totalFee := sdk.NewCoins()
+	if sender.String() != k.authority {
+		group, err := k.tssKeeper.GetGroup(ctx, namedGroup.GroupID)
+		if err != nil {
+			return 0, err
+		}
+
+		feePerSigner = namedGroup.FeePerSigner
+		totalFee, err = k.chargeSigningFee(ctx, sender, feePerSigner, group.Threshold, feeLimit)
+		if err != nil {
+			return 0, err
+		}
 	}
 
-	group, err := k.tssKeeper.GetGroup(ctx, currentGroup.GroupID)
+	signingID, err := k.requestSigning(ctx, namedGroup.GroupID, originator, content, batch)
 	if err != nil {
-		return sdk.Coins{}, err
+		return 0, err
+	}
+
+	// save signing info; the signing of the named group is kept as the current group signing.
+	bandtssSigningID := k.AddSigning(ctx, feePerSigner, sender, signingID, 0, groupName)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSigningRequestCreated,
+			sdk.NewAttribute(types.AttributeKeySigningID, fmt.Sprintf("%d", bandtssSigningID)),
+			sdk.NewAttribute(types.AttributeKeyGroupName, groupName),
+			sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", namedGroup.GroupID)),
+			sdk.NewAttribute(types.AttributeKeyCurrentGroupSigningID, fmt.Sprintf("%d", signingID)),
+			sdk.NewAttribute(types.AttributeKeyTotalFee, totalFee.String()),
+		),
+	)
+
+	return bandtssSigningID, nil
+}
+
+// chargeSigningFee transfers the fee of a signing from the sender to the module account and
+// returns the total fee. It returns an error if the fee exceeds the given limit.
+func (k Keeper) chargeSigningFee(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	feePerSigner sdk.Coins,
+	threshold uint64,
+	feeLimit sdk.Coins,
+) (sdk.Coins, error) {
+	totalFee := feePerSigner.MulInt(math.NewIntFromUint64(threshold))
+	for _, fc := range totalFee {
+		limitAmt := feeLimit.AmountOf(fc.Denom)
+		if fc.Amount.GT(limitAmt) {
+			return nil, types.ErrFeeExceedsLimit.Wrapf(
+				"require: %s, limit: %s%s",
+				fc.String(),
+				limitAmt.String(),
+				fc.Denom,
+			)
+		}
+	}
+
+	// transfer fee to module account.
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, totalFee); err != nil {
+		return nil, err
+	}
+
+	return totalFee, nil
+}
+
+// requestSigning requests a signing of the content from the tss module, either on its own or in
+// a batch of the group.
+func (k Keeper) requestSigning(
+	ctx sdk.Context,
+	groupID tss.GroupID,
+	originator tsstypes.Originator,
+	content tsstypes.Content,
+	batch bool,
+) (tss.SigningID, error) {
+	if batch {
+		return k.tssKeeper.RequestBatchSigning(ctx, groupID, originator, content)
 	}
 
+	return k.tssKeeper.RequestSigning(ctx, groupID, originator, content)
+}
+
+// GetSigningFee returns the fee required for signing a message by the named group of the given
+// name, or by the current group if the name is empty.
+func (k Keeper) GetSigningFee(ctx sdk.Context, groupName string) (sdk.Coins, error) {
+	groupID := k.GetCurrentGroup(ctx).GroupID
 	feePerSigner := k.GetParams(ctx).FeePerSigner
+	if groupName != "" {
+		namedGroup, err := k.GetActiveNamedGroup(ctx, groupName)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+
+		groupID = namedGroup.GroupID
+		feePerSigner = namedGroup.FeePerSigner
+	}
+
+	if groupID == 0 {
+		return sdk.Coins{}, nil
+	}
+
+	group, err := k.tssKeeper.GetGroup(ctx, groupID)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
 
 	return feePerSigner.MulInt(math.NewIntFromUint64(group.Threshold)), nil
 }
@@ -182,9 +294,10 @@ func (k Keeper) AddSigning(
 	sender sdk.AccAddress,
 	currentGroupSigningID tss.SigningID,
 	incomingGroupSigningID tss.SigningID,
+	groupName string,
 ) types.SigningID {
 	id := types.SigningID(k.GetSigningCount(ctx) + 1)
-	signing := types.NewSigning(id, feePerSigner, sender, currentGroupSigningID, incomingGroupSigningID)
+	signing := types.NewSigning(id, feePerSigner, sender, currentGroupSigningID, incomingGroupSigningID, groupName)
 	k.SetSigning(ctx, signing)
 
 	if currentGroupSigningID != 0 {
@@ -377,7 +377,7 @@ func (s *KeeperTestSuite) TestCreateDirectSigningRequest() {
 				tc.preProcess(s)
 			}
 
-			_, err := s.keeper.CreateDirectSigningRequest(s.ctx, content, "", tc.input.sender, tc.input.feeLimit)
+			_, err := s.keeper.CreateDirectSigningRequest(s.ctx, content, "", tc.input.sender, tc.input.feeLimit, "", false)
 			if tc.expectErr != nil {
 				s.Require().ErrorIs(err, tc.expectErr)
 			} else {
@@ -404,7 +404,7 @@ func (s *KeeperTestSuite) TestCreateDirectSigningRequestWithAuthority() {
 	).Return(tss.SigningID(1), nil)
 
 	feeLimit := sdk.NewCoins(sdk.NewInt64Coin("uband", 100))
-	_, err := s.keeper.CreateDirectSigningRequest(s.ctx, content, "", s.authority, feeLimit)
+	_, err := s.keeper.CreateDirectSigningRequest(s.ctx, content, "", s.authority, feeLimit, "", false)
 	s.Require().NoError(err)
 
 	actualMappedSigningID := s.keeper.GetSigningIDMapping(s.ctx, tss.SigningID(1))
@@ -421,3 +421,24 @@ func (s *KeeperTestSuite) TestCreateDirectSigningRequestWithAuthority() {
 		IncomingGroupSigningID: tss.SigningID(0),
 	}, bandtssSigning)
 }
+
+func (s *KeeperTestSuite) TestCreateDirectSigningRequestBatch() {
+	currentGID := tss.GroupID(1)
+	content := &tsstypes.TextSignatureOrder{Message: []byte("test")}
+
+	// the signing request is added into the batch of the group instead of being signed on its own.
+	s.keeper.SetCurrentGroup(s.ctx, types.NewCurrentGroup(currentGID, s.ctx.BlockTime()))
+	s.tssKeeper.EXPECT().RequestBatchSigning(
+		gomock.Any(),
+		currentGID,
+		gomock.Any(),
+		content,
+	).Return(tss.SigningID(1), nil)
+
+	feeLimit := sdk.NewCoins(sdk.NewInt64Coin("uband", 100))
+	_, err := s.keeper.CreateDirectSigningRequest(s.ctx, content, "", s.authority, feeLimit, "", true)
+	s.Require().NoError(err)
+
+	actualMappedSigningID := s.keeper.GetSigningIDMapping(s.ctx, tss.SigningID(1))
+	s.Require().Equal(types.SigningID(1), actualMappedSigningID)
+}
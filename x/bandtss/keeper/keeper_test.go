@@ -92,11 +92,46 @@ func (s *AppTestSuite) CreateNewGroup(
 		members = append(members, account.Address.String())
 	}
 
-	transitionMsg := types.NewMsgTransitionGroup(members, threshold, execTime, s.authority.String())
+	transitionMsg := types.NewMsgTransitionGroup(members, threshold, execTime, s.authority.String(), false, tsstypes.CURVE_SECP256K1)
 	if _, err := s.msgSrvr.TransitionGroup(s.ctx, transitionMsg); err != nil {
 		return nil, err
 	}
 
+	return s.CompleteGroupCreation(accounts, threshold)
+}
+
+// CreateNamedGroup creates a named group of the given accounts and completes its group creation.
+func (s *AppTestSuite) CreateNamedGroup(
+	name string,
+	accounts []bandtesting.Account,
+	threshold uint64,
+	feePerSigner sdk.Coins,
+) (*tsstestutils.GroupContext, error) {
+	var members []string
+	for _, account := range accounts {
+		members = append(members, account.Address.String())
+	}
+
+	msg := types.NewMsgCreateNamedGroup(
+		name,
+		members,
+		threshold,
+		tsstypes.CURVE_SECP256K1,
+		feePerSigner,
+		s.authority.String(),
+	)
+	if _, err := s.msgSrvr.CreateNamedGroup(s.ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return s.CompleteGroupCreation(accounts, threshold)
+}
+
+// CompleteGroupCreation submits all DKG rounds of the latest group and fills DEs of its members.
+func (s *AppTestSuite) CompleteGroupCreation(
+	accounts []bandtesting.Account,
+	threshold uint64,
+) (*tsstestutils.GroupContext, error) {
 	groupID := tss.GroupID(s.app.TSSKeeper.GetGroupCount(s.ctx))
 	dkgContext, err := s.app.TSSKeeper.GetDKGContext(s.ctx, groupID)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/bandprotocol/chain/v3/pkg/tss"
 	"github.com/bandprotocol/chain/v3/x/bandtss/types"
 	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
 )
@@ -52,12 +53,32 @@ func (k msgServer) TransitionGroup(
 		return nil, err
 	}
 
-	groupID, err := k.tssKeeper.CreateGroup(
-		ctx,
-		members,
-		req.Threshold,
-		types.ModuleName,
-	)
+	// create a new group by a new DKG process, or by resharing the secret of the current group
+	// to keep the group public key.
+	var groupID tss.GroupID
+	var err error
+	if req.Reshare {
+		currentGroupID := k.Keeper.GetCurrentGroup(ctx).GroupID
+		if currentGroupID == 0 {
+			return nil, types.ErrNoCurrentGroup.Wrap("no current group to reshare")
+		}
+
+		groupID, err = k.tssKeeper.CreateReshareGroup(
+			ctx,
+			currentGroupID,
+			members,
+			req.Threshold,
+			types.ModuleName,
+		)
+	} else {
+		groupID, err = k.tssKeeper.CreateGroup(
+			ctx,
+			members,
+			req.Threshold,
+			types.ModuleName,
+			req.Curve,
+		)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +122,9 @@ func (k msgServer) ForceTransitionGroup(
 	if currentGroupID == req.IncomingGroupID {
 		return nil, types.ErrInvalidGroupID.Wrap("incoming group is the same as the current group")
 	}
+	if namedGroup, found := k.Keeper.GetNamedGroupByGroupID(ctx, req.IncomingGroupID); found {
+		return nil, types.ErrInvalidGroupID.Wrapf("incoming group is the named group %s", namedGroup.Name)
+	}
 
 	incomingGroup, err := k.tssKeeper.GetGroup(ctx, req.IncomingGroupID)
 	if err != nil {
@@ -149,7 +173,15 @@ func (k msgServer) RequestSignature(
 	}
 
 	// Execute the handler to process the request.
-	_, err = k.Keeper.CreateDirectSigningRequest(ctx, content, req.Memo, feePayer, req.FeeLimit)
+	_, err = k.Keeper.CreateDirectSigningRequest(
+		ctx,
+		content,
+		req.Memo,
+		feePayer,
+		req.FeeLimit,
+		req.GroupName,
+		req.Batch,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -193,3 +225,76 @@ func (k msgServer) UpdateParams(
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// CreateNamedGroup creates a new group that signs requests under the given name alongside the
+// current group.
+func (k msgServer) CreateNamedGroup(
+	goCtx context.Context,
+	req *types.MsgCreateNamedGroup,
+) (*types.MsgCreateNamedGroupResponse, error) {
+	if k.Keeper.GetAuthority() != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("expected %s got %s", k.Keeper.GetAuthority(), req.Authority)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// validate members
+	var members []sdk.AccAddress
+	for _, m := range req.Members {
+		address, err := sdk.AccAddressFromBech32(m)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid member address: %s", err)
+		}
+		members = append(members, address)
+	}
+
+	_, err := k.Keeper.CreateNamedGroup(ctx, req.Name, members, req.Threshold, req.Curve, req.FeePerSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateNamedGroupResponse{}, nil
+}
+
+// UpdateNamedGroup updates the fee per signer of the named group.
+func (k msgServer) UpdateNamedGroup(
+	goCtx context.Context,
+	req *types.MsgUpdateNamedGroup,
+) (*types.MsgUpdateNamedGroupResponse, error) {
+	if k.Keeper.GetAuthority() != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("expected %s got %s", k.Keeper.GetAuthority(), req.Authority)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	namedGroup, err := k.Keeper.GetNamedGroup(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	namedGroup.FeePerSigner = req.FeePerSigner
+	k.Keeper.SetNamedGroup(ctx, namedGroup)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateNamedGroup,
+		sdk.NewAttribute(types.AttributeKeyGroupName, namedGroup.Name),
+		sdk.NewAttribute(types.AttributeKeyFeePerSigner, namedGroup.FeePerSigner.String()),
+	))
+
+	return &types.MsgUpdateNamedGroupResponse{}, nil
+}
+
+// RemoveNamedGroup removes the named group from the module.
+func (k msgServer) RemoveNamedGroup(
+	goCtx context.Context,
+	req *types.MsgRemoveNamedGroup,
+) (*types.MsgRemoveNamedGroupResponse, error) {
+	if k.Keeper.GetAuthority() != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("expected %s got %s", k.Keeper.GetAuthority(), req.Authority)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.RemoveNamedGroup(ctx, req.Name); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveNamedGroupResponse{}, nil
+}
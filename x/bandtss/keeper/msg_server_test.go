@@ -13,6 +13,7 @@ import (
 	bandtesting "github.com/bandprotocol/chain/v3/testing"
 	"github.com/bandprotocol/chain/v3/x/bandtss/types"
 	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	tsstestutils "github.com/bandprotocol/chain/v3/x/tss/testutil"
 	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
 	tunneltypes "github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
@@ -77,6 +78,66 @@ func (s *AppTestSuite) TestSuccessTransitionGroupReqNoCurrentGroup() {
 	s.Require().Equal(expectedTransition, transition)
 }
 
+func (s *AppTestSuite) TestSuccessTransitionGroupReqReshare() {
+	ctx, k := s.ctx, s.app.BandtssKeeper
+	groupCtx := s.SetupNewGroup(5, 3)
+
+	var members []string
+	for _, account := range groupCtx.Accounts[:3] {
+		members = append(members, account.Address.String())
+	}
+	members = append(members, bandtesting.Alice.Address.String())
+
+	_, err := s.msgSrvr.TransitionGroup(ctx, &types.MsgTransitionGroup{
+		Members:   members,
+		Threshold: 2,
+		ExecTime:  ctx.BlockTime().Add(10 * time.Second),
+		Authority: s.authority.String(),
+		Reshare:   true,
+	})
+	s.Require().NoError(err)
+
+	// Check if the incoming group reshares the secret of the current group.
+	s.Require().Equal(groupCtx.GroupID, k.GetCurrentGroup(ctx).GroupID)
+	transition, found := k.GetGroupTransition(ctx)
+	s.Require().True(found)
+	s.Require().Equal(types.TRANSITION_STATUS_CREATING_GROUP, transition.Status)
+	s.Require().Equal(groupCtx.GroupID+1, transition.IncomingGroupID)
+
+	incomingGroup := s.app.TSSKeeper.MustGetGroup(ctx, transition.IncomingGroupID)
+	s.Require().Equal(groupCtx.GroupID, incomingGroup.SourceGroupID)
+
+	incomingMembers, err := s.app.TSSKeeper.GetGroupMembers(ctx, transition.IncomingGroupID)
+	s.Require().NoError(err)
+	s.Require().Equal([]tss.MemberID{1, 2, 3}, tsstypes.Members(incomingMembers).GetSourceIDs())
+}
+
+func (s *AppTestSuite) TestFailTransitionGroupReshare() {
+	ctx := s.ctx
+	members := []string{bandtesting.Alice.Address.String()}
+
+	// No current group to reshare
+	_, err := s.msgSrvr.TransitionGroup(ctx, &types.MsgTransitionGroup{
+		Members:   members,
+		Threshold: 1,
+		ExecTime:  ctx.BlockTime().Add(10 * time.Second),
+		Authority: s.authority.String(),
+		Reshare:   true,
+	})
+	s.Require().ErrorIs(err, types.ErrNoCurrentGroup)
+
+	// Not enough members of the current group to deal
+	s.SetupNewGroup(5, 3)
+	_, err = s.msgSrvr.TransitionGroup(ctx, &types.MsgTransitionGroup{
+		Members:   members,
+		Threshold: 1,
+		ExecTime:  ctx.BlockTime().Add(10 * time.Second),
+		Authority: s.authority.String(),
+		Reshare:   true,
+	})
+	s.Require().ErrorIs(err, tsstypes.ErrGroupCreationFailed)
+}
+
 func (s *AppTestSuite) TestFailTransitionGroup() {
 	ctx := s.ctx
 	tssParams := s.app.TSSKeeper.GetParams(ctx)
@@ -693,3 +754,165 @@ func (s *AppTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *AppTestSuite) TestSuccessCreateNamedGroup() {
+	ctx, k := s.ctx, s.app.BandtssKeeper
+
+	s.SetupNewGroup(5, 3)
+	feePerSigner := sdk.NewCoins(sdk.NewInt64Coin("uband", 5))
+	groupCtx, err := s.CreateNamedGroup("fast", tsstestutils.GenerateAccounts(3), 2, feePerSigner)
+	s.Require().NoError(err)
+
+	namedGroup, err := k.GetNamedGroup(ctx, "fast")
+	s.Require().NoError(err)
+	s.Require().Equal(
+		types.NewNamedGroup("fast", groupCtx.GroupID, feePerSigner, types.NAMED_GROUP_STATUS_ACTIVE),
+		namedGroup,
+	)
+
+	// the members of the named group are added alongside the members of the current group.
+	for _, acc := range groupCtx.Accounts {
+		member, err := k.GetMember(ctx, acc.Address, groupCtx.GroupID)
+		s.Require().NoError(err)
+		s.Require().True(member.IsActive)
+	}
+	s.Require().NotEqual(groupCtx.GroupID, k.GetCurrentGroup(ctx).GroupID)
+
+	res, err := s.queryClient.NamedGroup(ctx, &types.QueryNamedGroupRequest{Name: "fast"})
+	s.Require().NoError(err)
+	s.Require().Equal(namedGroup, res.NamedGroup)
+	s.Require().Equal(uint64(3), res.Size_)
+	s.Require().Equal(uint64(2), res.Threshold)
+
+	memberRes, err := s.queryClient.Member(ctx, &types.QueryMemberRequest{
+		Address: groupCtx.Accounts[0].Address.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(memberRes.NamedGroupMembers, 1)
+	s.Require().Equal(groupCtx.GroupID, memberRes.NamedGroupMembers[0].GroupID)
+}
+
+func (s *AppTestSuite) TestFailCreateNamedGroup() {
+	ctx, msgSrvr := s.ctx, s.msgSrvr
+
+	members := []string{bandtesting.Alice.Address.String(), bandtesting.Bob.Address.String()}
+	msg := types.NewMsgCreateNamedGroup("fast", members, 2, tsstypes.CURVE_SECP256K1, nil, s.authority.String())
+
+	// invalid authority
+	invalidMsg := *msg
+	invalidMsg.Authority = bandtesting.Alice.Address.String()
+	_, err := msgSrvr.CreateNamedGroup(ctx, &invalidMsg)
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	// duplicated name
+	_, err = msgSrvr.CreateNamedGroup(ctx, msg)
+	s.Require().NoError(err)
+	_, err = msgSrvr.CreateNamedGroup(ctx, msg)
+	s.Require().ErrorIs(err, types.ErrNamedGroupAlreadyExists)
+
+	// request a signature on the named group whose group creation isn't completed.
+	reqMsg, err := types.NewMsgRequestSignature(
+		tsstypes.NewTextSignatureOrder([]byte("msg")),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 1000)),
+		bandtesting.FeePayer.Address.String(),
+	)
+	s.Require().NoError(err)
+	reqMsg.GroupName = "fast"
+	_, err = msgSrvr.RequestSignature(ctx, reqMsg)
+	s.Require().ErrorIs(err, types.ErrNamedGroupNotActive)
+
+	reqMsg.GroupName = "unknown"
+	_, err = msgSrvr.RequestSignature(ctx, reqMsg)
+	s.Require().ErrorIs(err, types.ErrNamedGroupNotFound)
+}
+
+func (s *AppTestSuite) TestSuccessRequestSignatureOnNamedGroup() {
+	ctx, msgSrvr, k := s.ctx, s.msgSrvr, s.app.BandtssKeeper
+
+	s.SetupNewGroup(5, 3)
+	feePerSigner := sdk.NewCoins(sdk.NewInt64Coin("uband", 5))
+	groupCtx, err := s.CreateNamedGroup("fast", tsstestutils.GenerateAccounts(3), 2, feePerSigner)
+	s.Require().NoError(err)
+
+	balancesBefore := s.app.BankKeeper.GetAllBalances(ctx, bandtesting.FeePayer.Address)
+
+	msg, err := types.NewMsgRequestSignature(
+		tsstypes.NewTextSignatureOrder([]byte("msg")),
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 1000)),
+		bandtesting.FeePayer.Address.String(),
+	)
+	s.Require().NoError(err)
+	msg.GroupName = "fast"
+
+	_, err = msgSrvr.RequestSignature(ctx, msg)
+	s.Require().NoError(err)
+
+	// the fee of the named group is paid instead of the fee of the current group.
+	balancesAfter := s.app.BankKeeper.GetAllBalances(ctx, bandtesting.FeePayer.Address)
+	s.Require().Equal(feePerSigner.MulInt(math.NewInt(2)), balancesBefore.Sub(balancesAfter...))
+
+	bandtssSigningID := types.SigningID(k.GetSigningCount(ctx))
+	tssSigningID := tss.SigningID(s.app.TSSKeeper.GetSigningCount(ctx))
+
+	bandtssSigning, err := k.GetSigning(ctx, bandtssSigningID)
+	s.Require().NoError(err)
+	s.Require().Equal(
+		types.NewSigning(bandtssSigningID, feePerSigner, bandtesting.FeePayer.Address, tssSigningID, 0, "fast"),
+		bandtssSigning,
+	)
+
+	tssSigning, err := s.app.TSSKeeper.GetSigning(ctx, tssSigningID)
+	s.Require().NoError(err)
+	s.Require().Equal(groupCtx.GroupID, tssSigning.GroupID)
+
+	fee, err := k.GetSigningFee(ctx, "fast")
+	s.Require().NoError(err)
+	s.Require().Equal(feePerSigner.MulInt(math.NewInt(2)), fee)
+}
+
+func (s *AppTestSuite) TestUpdateAndRemoveNamedGroup() {
+	ctx, msgSrvr, k := s.ctx, s.msgSrvr, s.app.BandtssKeeper
+
+	groupCtx, err := s.CreateNamedGroup("fast", tsstestutils.GenerateAccounts(3), 2, nil)
+	s.Require().NoError(err)
+
+	// update fee per signer
+	feePerSigner := sdk.NewCoins(sdk.NewInt64Coin("uband", 20))
+	_, err = msgSrvr.UpdateNamedGroup(ctx, types.NewMsgUpdateNamedGroup("fast", feePerSigner, s.authority.String()))
+	s.Require().NoError(err)
+
+	namedGroup, err := k.GetNamedGroup(ctx, "fast")
+	s.Require().NoError(err)
+	s.Require().Equal(feePerSigner, namedGroup.FeePerSigner)
+
+	_, err = msgSrvr.UpdateNamedGroup(ctx, types.NewMsgUpdateNamedGroup("slow", feePerSigner, s.authority.String()))
+	s.Require().ErrorIs(err, types.ErrNamedGroupNotFound)
+
+	// remove the named group along with its members.
+	_, err = msgSrvr.RemoveNamedGroup(ctx, types.NewMsgRemoveNamedGroup("fast", bandtesting.Alice.Address.String()))
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = msgSrvr.RemoveNamedGroup(ctx, types.NewMsgRemoveNamedGroup("fast", s.authority.String()))
+	s.Require().NoError(err)
+	s.Require().False(k.HasNamedGroup(ctx, "fast"))
+	for _, acc := range groupCtx.Accounts {
+		s.Require().False(k.HasMember(ctx, acc.Address, groupCtx.GroupID))
+	}
+
+	_, err = msgSrvr.RemoveNamedGroup(ctx, types.NewMsgRemoveNamedGroup("fast", s.authority.String()))
+	s.Require().ErrorIs(err, types.ErrNamedGroupNotFound)
+}
+
+func (s *AppTestSuite) TestFailForceTransitionGroupToNamedGroup() {
+	ctx, msgSrvr := s.ctx, s.msgSrvr
+
+	groupCtx, err := s.CreateNamedGroup("fast", tsstestutils.GenerateAccounts(3), 2, nil)
+	s.Require().NoError(err)
+
+	_, err = msgSrvr.ForceTransitionGroup(ctx, types.NewMsgForceTransitionGroup(
+		groupCtx.GroupID,
+		ctx.BlockTime().Add(10*time.Minute),
+		s.authority.String(),
+	))
+	s.Require().ErrorIs(err, types.ErrInvalidGroupID)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -24,6 +25,29 @@ func NewTSSCallback(k Keeper) TSSCallback {
 }
 
 func (cb TSSCallback) OnGroupCreationCompleted(ctx sdk.Context, groupID tss.GroupID) {
+	// if the group is created for a named group, add members and activate the named group.
+	namedGroup, found := cb.k.GetNamedGroupByGroupID(ctx, groupID)
+	if found {
+		if namedGroup.Status != types.NAMED_GROUP_STATUS_CREATING_GROUP {
+			return
+		}
+
+		// This shouldn't return error as the group is newly created.
+		if err := cb.k.AddMembers(ctx, groupID); err != nil {
+			panic(err)
+		}
+
+		namedGroup.Status = types.NAMED_GROUP_STATUS_ACTIVE
+		cb.k.SetNamedGroup(ctx, namedGroup)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeNamedGroupActivated,
+			sdk.NewAttribute(types.AttributeKeyGroupName, namedGroup.Name),
+			sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", groupID)),
+		))
+		return
+	}
+
 	transition, found := cb.k.GetGroupTransition(ctx)
 	if !found ||
 		transition.IncomingGroupID != groupID ||
@@ -36,8 +60,9 @@ func (cb TSSCallback) OnGroupCreationCompleted(ctx sdk.Context, groupID tss.Grou
 	transition.IncomingGroupPubKey = group.PubKey
 
 	// if the current group is not set, the transition is forced; update status and set
-	// member into the group. Otherwise, create a signing request for transition.
-	if transition.CurrentGroupID == 0 {
+	// member into the group. The same applies to a group that reshares the secret of the current
+	// group, as its public key doesn't change. Otherwise, create a signing request for transition.
+	if transition.CurrentGroupID == 0 || bytes.Equal(group.PubKey, transition.CurrentGroupPubKey) {
 		// This shouldn't return error as the group is newly created.
 		if err := cb.k.AddMembers(ctx, group.ID); err != nil {
 			panic(err)
@@ -77,6 +102,10 @@ func (cb TSSCallback) OnGroupCreationCompleted(ctx sdk.Context, groupID tss.Grou
 }
 
 func (cb TSSCallback) OnGroupCreationFailed(ctx sdk.Context, groupID tss.GroupID) {
+	if cb.k.handleNamedGroupCreationFailed(ctx, groupID) {
+		return
+	}
+
 	transition, found := cb.k.GetGroupTransition(ctx)
 	if found &&
 		transition.IncomingGroupID == groupID &&
@@ -86,6 +115,10 @@ func (cb TSSCallback) OnGroupCreationFailed(ctx sdk.Context, groupID tss.GroupID
 }
 
 func (cb TSSCallback) OnGroupCreationExpired(ctx sdk.Context, groupID tss.GroupID) {
+	if cb.k.handleNamedGroupCreationFailed(ctx, groupID) {
+		return
+	}
+
 	transition, found := cb.k.GetGroupTransition(ctx)
 	if found &&
 		transition.IncomingGroupID == groupID &&
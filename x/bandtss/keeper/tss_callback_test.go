@@ -855,6 +855,40 @@ func (s *KeeperTestSuite) TestCallbackOnGroupCreationComplete() {
 				}
 			},
 		},
+		{
+			name:  "reshared group with the same public key",
+			input: 2,
+			preProcess: func(s *KeeperTestSuite) {
+				s.tssKeeper.EXPECT().MustGetGroup(gomock.Any(), tss.GroupID(2)).
+					Return(tsstypes.Group{
+						ID:            2,
+						ModuleOwner:   types.ModuleName,
+						Status:        tsstypes.GROUP_STATUS_ACTIVE,
+						PubKey:        []byte("pubkey"),
+						SourceGroupID: 1,
+					})
+				s.keeper.SetGroupTransition(s.ctx, types.GroupTransition{
+					Status:             types.TRANSITION_STATUS_CREATING_GROUP,
+					CurrentGroupID:     tss.GroupID(1),
+					CurrentGroupPubKey: tss.Point([]byte("pubkey")),
+					IncomingGroupID:    tss.GroupID(2),
+					ExecTime:           s.ctx.BlockTime().Add(10 * time.Minute),
+				})
+				s.keeper.SetCurrentGroup(s.ctx, types.NewCurrentGroup(1, s.ctx.BlockTime()))
+				s.tssKeeper.EXPECT().MustGetMembers(gomock.Any(), tss.GroupID(2)).Return(members)
+			},
+			postCheck: func(s *KeeperTestSuite) {
+				transition, found := s.keeper.GetGroupTransition(s.ctx)
+				s.Require().True(found)
+				s.Require().Equal(types.TRANSITION_STATUS_WAITING_EXECUTION, transition.Status)
+				s.Require().Equal(tss.GroupID(2), transition.IncomingGroupID)
+				s.Require().Equal(tss.GroupID(1), transition.CurrentGroupID)
+				s.Require().Equal(tss.SigningID(0), transition.SigningID)
+				s.Require().Equal(tss.Point([]byte("pubkey")), transition.IncomingGroupPubKey)
+
+				s.Require().Equal(tss.GroupID(1), s.keeper.GetCurrentGroup(s.ctx).GroupID)
+			},
+		},
 		{
 			name:  "existing current group id but insufficient member",
 			input: 2,
@@ -908,6 +942,42 @@ func (s *KeeperTestSuite) TestCallbackOnGroupCreationComplete() {
 				}
 			},
 		},
+		{
+			name:  "named group",
+			input: 3,
+			preProcess: func(s *KeeperTestSuite) {
+				s.keeper.SetNamedGroup(s.ctx, types.NewNamedGroup(
+					"fast",
+					tss.GroupID(3),
+					sdk.NewCoins(sdk.NewInt64Coin("uband", 10)),
+					types.NAMED_GROUP_STATUS_CREATING_GROUP,
+				))
+				s.keeper.SetGroupTransition(s.ctx, types.GroupTransition{
+					Status:          types.TRANSITION_STATUS_CREATING_GROUP,
+					CurrentGroupID:  tss.GroupID(1),
+					IncomingGroupID: tss.GroupID(2),
+					ExecTime:        s.ctx.BlockTime().Add(10 * time.Minute),
+				})
+				s.keeper.SetCurrentGroup(s.ctx, types.NewCurrentGroup(1, s.ctx.BlockTime()))
+				s.tssKeeper.EXPECT().MustGetMembers(gomock.Any(), tss.GroupID(3)).Return(members)
+			},
+			postCheck: func(s *KeeperTestSuite) {
+				namedGroup, err := s.keeper.GetNamedGroup(s.ctx, "fast")
+				s.Require().NoError(err)
+				s.Require().Equal(types.NAMED_GROUP_STATUS_ACTIVE, namedGroup.Status)
+
+				// the group transition isn't affected by the named group.
+				transition, found := s.keeper.GetGroupTransition(s.ctx)
+				s.Require().True(found)
+				s.Require().Equal(types.TRANSITION_STATUS_CREATING_GROUP, transition.Status)
+
+				for _, member := range members {
+					m, err := s.keeper.GetMember(s.ctx, sdk.MustAccAddressFromBech32(member.Address), tss.GroupID(3))
+					s.Require().NoError(err)
+					s.Require().True(m.IsActive)
+				}
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -924,3 +994,25 @@ func (s *KeeperTestSuite) TestCallbackOnGroupCreationComplete() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestCallbackOnNamedGroupCreationFailed() {
+	ctx, k := s.ctx, s.keeper
+
+	k.SetNamedGroup(ctx, types.NewNamedGroup("fast", 3, nil, types.NAMED_GROUP_STATUS_CREATING_GROUP))
+	k.SetNamedGroup(ctx, types.NewNamedGroup("secure", 4, nil, types.NAMED_GROUP_STATUS_CREATING_GROUP))
+	k.SetGroupTransition(ctx, types.GroupTransition{
+		Status:          types.TRANSITION_STATUS_CREATING_GROUP,
+		IncomingGroupID: tss.GroupID(2),
+		ExecTime:        ctx.BlockTime().Add(10 * time.Minute),
+	})
+
+	s.tssCallback.OnGroupCreationFailed(ctx, 3)
+	s.tssCallback.OnGroupCreationExpired(ctx, 4)
+
+	s.Require().False(k.HasNamedGroup(ctx, "fast"))
+	s.Require().False(k.HasNamedGroup(ctx, "secure"))
+
+	// the group transition isn't affected by the named groups.
+	_, found := k.GetGroupTransition(ctx)
+	s.Require().True(found)
+}
@@ -256,18 +256,33 @@ func (mr *MockTSSKeeperMockRecorder) ActivateMember(ctx, groupID, address any) *
 }
 
 // CreateGroup mocks base method.
-func (m *MockTSSKeeper) CreateGroup(ctx types0.Context, members []types0.AccAddress, threshold uint64, moduleOwner string) (tss.GroupID, error) {
+func (m *MockTSSKeeper) CreateGroup(ctx types0.Context, members []types0.AccAddress, threshold uint64, moduleOwner string, curve types.Curve) (tss.GroupID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateGroup", ctx, members, threshold, moduleOwner)
+	ret := m.ctrl.Call(m, "CreateGroup", ctx, members, threshold, moduleOwner, curve)
 	ret0, _ := ret[0].(tss.GroupID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateGroup indicates an expected call of CreateGroup.
-func (mr *MockTSSKeeperMockRecorder) CreateGroup(ctx, members, threshold, moduleOwner any) *gomock.Call {
+func (mr *MockTSSKeeperMockRecorder) CreateGroup(ctx, members, threshold, moduleOwner, curve any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockTSSKeeper)(nil).CreateGroup), ctx, members, threshold, moduleOwner)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockTSSKeeper)(nil).CreateGroup), ctx, members, threshold, moduleOwner, curve)
+}
+
+// CreateReshareGroup mocks base method.
+func (m *MockTSSKeeper) CreateReshareGroup(ctx types0.Context, sourceGroupID tss.GroupID, members []types0.AccAddress, threshold uint64, moduleOwner string) (tss.GroupID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReshareGroup", ctx, sourceGroupID, members, threshold, moduleOwner)
+	ret0, _ := ret[0].(tss.GroupID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReshareGroup indicates an expected call of CreateReshareGroup.
+func (mr *MockTSSKeeperMockRecorder) CreateReshareGroup(ctx, sourceGroupID, members, threshold, moduleOwner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReshareGroup", reflect.TypeOf((*MockTSSKeeper)(nil).CreateReshareGroup), ctx, sourceGroupID, members, threshold, moduleOwner)
 }
 
 // DeactivateMember mocks base method.
@@ -285,17 +300,17 @@ func (mr *MockTSSKeeperMockRecorder) DeactivateMember(ctx, groupID, address any)
 }
 
 // GetDEQueue mocks base method.
-func (m *MockTSSKeeper) GetDEQueue(ctx types0.Context, address types0.AccAddress) types.DEQueue {
+func (m *MockTSSKeeper) GetDEQueue(ctx types0.Context, curve types.Curve, address types0.AccAddress) types.DEQueue {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDEQueue", ctx, address)
+	ret := m.ctrl.Call(m, "GetDEQueue", ctx, curve, address)
 	ret0, _ := ret[0].(types.DEQueue)
 	return ret0
 }
 
 // GetDEQueue indicates an expected call of GetDEQueue.
-func (mr *MockTSSKeeperMockRecorder) GetDEQueue(ctx, address any) *gomock.Call {
+func (mr *MockTSSKeeperMockRecorder) GetDEQueue(ctx, curve, address any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDEQueue", reflect.TypeOf((*MockTSSKeeper)(nil).GetDEQueue), ctx, address)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDEQueue", reflect.TypeOf((*MockTSSKeeper)(nil).GetDEQueue), ctx, curve, address)
 }
 
 // GetGroup mocks base method.
@@ -400,6 +415,21 @@ func (mr *MockTSSKeeperMockRecorder) MustGetSigning(ctx, signingID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MustGetSigning", reflect.TypeOf((*MockTSSKeeper)(nil).MustGetSigning), ctx, signingID)
 }
 
+// RequestBatchSigning mocks base method.
+func (m *MockTSSKeeper) RequestBatchSigning(ctx types0.Context, groupID tss.GroupID, originator types.Originator, content types.Content) (tss.SigningID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestBatchSigning", ctx, groupID, originator, content)
+	ret0, _ := ret[0].(tss.SigningID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestBatchSigning indicates an expected call of RequestBatchSigning.
+func (mr *MockTSSKeeperMockRecorder) RequestBatchSigning(ctx, groupID, originator, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestBatchSigning", reflect.TypeOf((*MockTSSKeeper)(nil).RequestBatchSigning), ctx, groupID, originator, content)
+}
+
 // RequestSigning mocks base method.
 func (m *MockTSSKeeper) RequestSigning(ctx types0.Context, groupID tss.GroupID, originator types.Originator, content types.Content) (tss.SigningID, error) {
 	m.ctrl.T.Helper()
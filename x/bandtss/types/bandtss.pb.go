@@ -33,6 +33,38 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// NamedGroupStatus is an enumeration of the possible statuses of a named group.
+type NamedGroupStatus int32
+
+const (
+	// NAMED_GROUP_STATUS_UNSPECIFIED is the status of a named group that has not been specified.
+	NAMED_GROUP_STATUS_UNSPECIFIED NamedGroupStatus = 0
+	// NAMED_GROUP_STATUS_CREATING_GROUP is the status of a named group whose tss group is being created.
+	NAMED_GROUP_STATUS_CREATING_GROUP NamedGroupStatus = 1
+	// NAMED_GROUP_STATUS_ACTIVE is the status of a named group that is ready to sign requests.
+	NAMED_GROUP_STATUS_ACTIVE NamedGroupStatus = 2
+)
+
+var NamedGroupStatus_name = map[int32]string{
+	0: "NAMED_GROUP_STATUS_UNSPECIFIED",
+	1: "NAMED_GROUP_STATUS_CREATING_GROUP",
+	2: "NAMED_GROUP_STATUS_ACTIVE",
+}
+
+var NamedGroupStatus_value = map[string]int32{
+	"NAMED_GROUP_STATUS_UNSPECIFIED":    0,
+	"NAMED_GROUP_STATUS_CREATING_GROUP": 1,
+	"NAMED_GROUP_STATUS_ACTIVE":         2,
+}
+
+func (x NamedGroupStatus) String() string {
+	return proto.EnumName(NamedGroupStatus_name, int32(x))
+}
+
+func (NamedGroupStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2bc325518cc10c44, []int{0}
+}
+
 // TransitionStatus is an enumeration of the possible statuses of a group transition process.
 type TransitionStatus int32
 
@@ -70,7 +102,7 @@ func (x TransitionStatus) String() string {
 }
 
 func (TransitionStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2bc325518cc10c44, []int{0}
+	return fileDescriptor_2bc325518cc10c44, []int{1}
 }
 
 // Member maintains member information for monitoring their liveness activity.
@@ -209,10 +241,14 @@ type Signing struct {
 	FeePerSigner github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee_per_signer,json=feePerSigner,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_per_signer"`
 	// requester is the address who pays the bandtss signing.
 	Requester string `protobuf:"bytes,3,opt,name=requester,proto3" json:"requester,omitempty"`
-	// current_group_signing_id is a tss signing ID of a current group.
+	// current_group_signing_id is a tss signing ID of a current group, or of the named group if
+	// group_name is set.
 	CurrentGroupSigningID github_com_bandprotocol_chain_v3_pkg_tss.SigningID `protobuf:"varint,4,opt,name=current_group_signing_id,json=currentGroupSigningId,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.SigningID" json:"current_group_signing_id,omitempty"`
 	// incoming_group_signing_id is a tss signing ID of an incoming group, if any.
 	IncomingGroupSigningID github_com_bandprotocol_chain_v3_pkg_tss.SigningID `protobuf:"varint,5,opt,name=incoming_group_signing_id,json=incomingGroupSigningId,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.SigningID" json:"incoming_group_signing_id,omitempty"`
+	// group_name is the name of the named group that signs the bandtss signing. It is empty if the
+	// signing is signed by the current group.
+	GroupName string `protobuf:"bytes,6,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
 }
 
 func (m *Signing) Reset()         { *m = Signing{} }
@@ -283,6 +319,87 @@ func (m *Signing) GetIncomingGroupSigningID() github_com_bandprotocol_chain_v3_p
 	return 0
 }
 
+func (m *Signing) GetGroupName() string {
+	if m != nil {
+		return m.GroupName
+	}
+	return ""
+}
+
+// NamedGroup is a group that is managed by the bandtss module alongside the current group and is
+// selected by requesters by its name.
+type NamedGroup struct {
+	// name is the unique name of the group.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// group_id is the ID of the group.
+	GroupID github_com_bandprotocol_chain_v3_pkg_tss.GroupID `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.GroupID" json:"group_id,omitempty"`
+	// fee_per_signer is the tokens that will be paid per signer for a signing of this group.
+	FeePerSigner github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fee_per_signer,json=feePerSigner,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_per_signer"`
+	// status is the status of the named group.
+	Status NamedGroupStatus `protobuf:"varint,4,opt,name=status,proto3,enum=band.bandtss.v1beta1.NamedGroupStatus" json:"status,omitempty"`
+}
+
+func (m *NamedGroup) Reset()         { *m = NamedGroup{} }
+func (m *NamedGroup) String() string { return proto.CompactTextString(m) }
+func (*NamedGroup) ProtoMessage()    {}
+func (*NamedGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2bc325518cc10c44, []int{3}
+}
+func (m *NamedGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamedGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamedGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamedGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamedGroup.Merge(m, src)
+}
+func (m *NamedGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamedGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamedGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamedGroup proto.InternalMessageInfo
+
+func (m *NamedGroup) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NamedGroup) GetGroupID() github_com_bandprotocol_chain_v3_pkg_tss.GroupID {
+	if m != nil {
+		return m.GroupID
+	}
+	return 0
+}
+
+func (m *NamedGroup) GetFeePerSigner() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeePerSigner
+	}
+	return nil
+}
+
+func (m *NamedGroup) GetStatus() NamedGroupStatus {
+	if m != nil {
+		return m.Status
+	}
+	return NAMED_GROUP_STATUS_UNSPECIFIED
+}
+
 // GroupTransition defines the group transition information of the current group and incoming group.
 type GroupTransition struct {
 	// signing_id is a tss signing ID of group transition signing request.
@@ -308,7 +425,7 @@ func (m *GroupTransition) Reset()         { *m = GroupTransition{} }
 func (m *GroupTransition) String() string { return proto.CompactTextString(m) }
 func (*GroupTransition) ProtoMessage()    {}
 func (*GroupTransition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2bc325518cc10c44, []int{3}
+	return fileDescriptor_2bc325518cc10c44, []int{4}
 }
 func (m *GroupTransition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,7 +522,7 @@ func (m *GroupTransitionSignatureOrder) Reset()         { *m = GroupTransitionSi
 func (m *GroupTransitionSignatureOrder) String() string { return proto.CompactTextString(m) }
 func (*GroupTransitionSignatureOrder) ProtoMessage()    {}
 func (*GroupTransitionSignatureOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2bc325518cc10c44, []int{4}
+	return fileDescriptor_2bc325518cc10c44, []int{5}
 }
 func (m *GroupTransitionSignatureOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -449,10 +566,12 @@ func (m *GroupTransitionSignatureOrder) GetTransitionTime() time.Time {
 }
 
 func init() {
+	proto.RegisterEnum("band.bandtss.v1beta1.NamedGroupStatus", NamedGroupStatus_name, NamedGroupStatus_value)
 	proto.RegisterEnum("band.bandtss.v1beta1.TransitionStatus", TransitionStatus_name, TransitionStatus_value)
 	proto.RegisterType((*Member)(nil), "band.bandtss.v1beta1.Member")
 	proto.RegisterType((*CurrentGroup)(nil), "band.bandtss.v1beta1.CurrentGroup")
 	proto.RegisterType((*Signing)(nil), "band.bandtss.v1beta1.Signing")
+	proto.RegisterType((*NamedGroup)(nil), "band.bandtss.v1beta1.NamedGroup")
 	proto.RegisterType((*GroupTransition)(nil), "band.bandtss.v1beta1.GroupTransition")
 	proto.RegisterType((*GroupTransitionSignatureOrder)(nil), "band.bandtss.v1beta1.GroupTransitionSignatureOrder")
 }
@@ -462,67 +581,73 @@ func init() {
 }
 
 var fileDescriptor_2bc325518cc10c44 = []byte{
-	// 957 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0xd6, 0xca, 0x1f, 0x92, 0xd6, 0x86, 0xac, 0xac, 0x63, 0x43, 0xf6, 0xfb, 0x86, 0x54, 0x95,
-	0xa2, 0x15, 0x0a, 0x84, 0x4c, 0x94, 0x9e, 0x7c, 0x68, 0x21, 0xc9, 0xb2, 0x4a, 0x14, 0x96, 0x05,
-	0x4a, 0x46, 0x8b, 0x5c, 0x08, 0x7e, 0xac, 0x98, 0x85, 0x2d, 0xae, 0xc2, 0x5d, 0x19, 0xf6, 0x3f,
-	0x08, 0xd0, 0x4b, 0x7e, 0x42, 0x81, 0x5e, 0x8a, 0x1e, 0x0a, 0x14, 0xe8, 0x8f, 0x08, 0x72, 0xca,
-	0xb1, 0x97, 0xd2, 0x05, 0x7d, 0xe9, 0x6f, 0xf0, 0xa9, 0xe0, 0x92, 0xfa, 0xb4, 0x8b, 0x3a, 0x46,
-	0x2e, 0xd2, 0xce, 0xce, 0xcc, 0xce, 0x3c, 0xcf, 0x33, 0x5c, 0x12, 0x96, 0x2d, 0xd3, 0x73, 0xd4,
-	0xe8, 0x87, 0x33, 0xa6, 0x9e, 0x3d, 0xb3, 0x30, 0x37, 0x9f, 0x8d, 0x6d, 0x65, 0xe8, 0x53, 0x4e,
-	0xd1, 0xc3, 0xc8, 0x54, 0xc6, 0x7b, 0x49, 0xcc, 0xee, 0x43, 0x97, 0xba, 0x54, 0x04, 0xa8, 0xd1,
-	0x2a, 0x8e, 0xdd, 0x95, 0x5d, 0x4a, 0xdd, 0x53, 0xac, 0x0a, 0xcb, 0x1a, 0xf5, 0x55, 0x4e, 0x06,
-	0x98, 0x71, 0x73, 0x30, 0x4c, 0x02, 0x24, 0x9b, 0xb2, 0x01, 0x65, 0xaa, 0x65, 0x32, 0x3c, 0xa9,
-	0x67, 0x53, 0xe2, 0x25, 0xfe, 0x9d, 0xd8, 0x6f, 0xc4, 0x27, 0xc7, 0x46, 0xec, 0x2a, 0xff, 0x09,
-	0xe0, 0xea, 0x21, 0x1e, 0x58, 0xd8, 0x47, 0x45, 0x98, 0x31, 0x1d, 0xc7, 0xc7, 0x8c, 0x15, 0x41,
-	0x09, 0x54, 0x72, 0xfa, 0xd8, 0x44, 0x2f, 0x60, 0xd6, 0xf5, 0xe9, 0x68, 0x68, 0x10, 0xa7, 0x98,
-	0x2e, 0x81, 0xca, 0x72, 0xfd, 0xeb, 0x30, 0x90, 0x33, 0xad, 0x68, 0x4f, 0xdb, 0xbf, 0x0e, 0xe4,
-	0xa7, 0x2e, 0xe1, 0x2f, 0x47, 0x96, 0x62, 0xd3, 0x81, 0xc0, 0x29, 0xce, 0xb6, 0xe9, 0xa9, 0x6a,
-	0xbf, 0x34, 0x89, 0xa7, 0x9e, 0x3d, 0x57, 0x87, 0x27, 0xae, 0x1a, 0x21, 0x4d, 0x72, 0xf4, 0x8c,
-	0x38, 0x50, 0x73, 0xd0, 0xff, 0x60, 0x8e, 0x30, 0xc3, 0xb4, 0x39, 0x39, 0xc3, 0xc5, 0xa5, 0x12,
-	0xa8, 0x64, 0xf5, 0x2c, 0x61, 0x35, 0x61, 0xa3, 0x3d, 0xb8, 0xc2, 0x88, 0x67, 0xe3, 0xe2, 0x72,
-	0x09, 0x54, 0xd6, 0xaa, 0xbb, 0x4a, 0xcc, 0x84, 0x32, 0x66, 0x42, 0xe9, 0x8d, 0x99, 0xa8, 0x67,
-	0xdf, 0x06, 0x72, 0xea, 0xcd, 0xa5, 0x0c, 0xf4, 0x38, 0x65, 0x6f, 0xf9, 0xef, 0x1f, 0x65, 0x50,
-	0xfe, 0x0d, 0xc0, 0xf5, 0xc6, 0xc8, 0xf7, 0xb1, 0xc7, 0x45, 0xe9, 0x39, 0x2c, 0xe0, 0x23, 0x63,
-	0x69, 0xc2, 0xb5, 0x18, 0x88, 0x11, 0x29, 0x24, 0xa8, 0xba, 0x6b, 0xd3, 0x30, 0x4e, 0x8c, 0x5c,
-	0xe5, 0x60, 0x09, 0x66, 0xba, 0xc4, 0xf5, 0x88, 0xe7, 0xa2, 0xc7, 0x30, 0x3d, 0x69, 0x74, 0x33,
-	0x0c, 0xe4, 0xb4, 0xe8, 0x31, 0x97, 0xb8, 0xb5, 0x7d, 0x3d, 0x4d, 0x1c, 0xf4, 0x0a, 0xe6, 0xfb,
-	0x18, 0x1b, 0x43, 0xec, 0x1b, 0x8c, 0xb8, 0x1e, 0xf6, 0x8b, 0xe9, 0xd2, 0x52, 0x65, 0xad, 0xba,
-	0xa3, 0x24, 0x5a, 0x47, 0x83, 0x31, 0x1e, 0x32, 0xa5, 0x41, 0x89, 0x57, 0x7f, 0x1a, 0x55, 0xfe,
-	0xe5, 0x52, 0xae, 0xcc, 0xa0, 0x4d, 0xa6, 0x28, 0xfe, 0x7b, 0xc2, 0x9c, 0x13, 0x95, 0x5f, 0x0c,
-	0x31, 0x13, 0x09, 0x4c, 0x5f, 0xef, 0x63, 0xdc, 0xc1, 0x7e, 0x57, 0x14, 0x40, 0xff, 0x87, 0x39,
-	0x1f, 0xbf, 0x1a, 0x61, 0xc6, 0xb1, 0x2f, 0x64, 0xcb, 0xe9, 0xd3, 0x0d, 0xf4, 0x1a, 0xc0, 0xa2,
-	0x1d, 0xb3, 0x6e, 0xc4, 0x6c, 0xb3, 0xb8, 0xe1, 0x88, 0xf5, 0x65, 0x01, 0xe6, 0x28, 0x0c, 0xe4,
-	0xad, 0x59, 0x65, 0x26, 0x90, 0xae, 0x03, 0xb9, 0x7a, 0x67, 0x0d, 0xa6, 0x44, 0x6c, 0xd9, 0xb7,
-	0x1c, 0xe6, 0xa0, 0x1f, 0x00, 0xdc, 0x21, 0x9e, 0x4d, 0x07, 0x51, 0xf5, 0x1b, 0xbd, 0xac, 0x88,
-	0x5e, 0x3a, 0x61, 0x20, 0x6f, 0x6b, 0x49, 0xd0, 0x47, 0x69, 0x66, 0x9b, 0xdc, 0x76, 0x9a, 0x93,
-	0x0c, 0xe5, 0xe5, 0x0a, 0xdc, 0x10, 0x8e, 0x9e, 0x6f, 0x7a, 0x8c, 0x70, 0x42, 0x3d, 0x64, 0x41,
-	0x38, 0xd3, 0x57, 0x2c, 0x78, 0x23, 0x9c, 0x95, 0xfa, 0x9e, 0xad, 0xe4, 0xd8, 0x84, 0x8b, 0x21,
-	0x2c, 0xcc, 0xab, 0x32, 0x79, 0x9e, 0x0f, 0xc2, 0x40, 0xce, 0xcf, 0xaa, 0x71, 0xcf, 0x47, 0x21,
-	0x3f, 0x2b, 0x82, 0xe6, 0x20, 0x0c, 0xb7, 0xe6, 0x2b, 0x0e, 0x47, 0x96, 0x71, 0x82, 0x2f, 0xc4,
-	0xc8, 0xac, 0xd7, 0xab, 0xd7, 0x81, 0xac, 0xdc, 0xb9, 0x48, 0x87, 0x12, 0x8f, 0xeb, 0x68, 0xb6,
-	0x44, 0x67, 0x64, 0x7d, 0x8b, 0x2f, 0x10, 0x83, 0x0f, 0x16, 0x34, 0x9e, 0xcc, 0x59, 0x2b, 0x0c,
-	0xe4, 0x8d, 0x39, 0x6d, 0xef, 0x09, 0x6d, 0x63, 0x4e, 0x52, 0xcd, 0x41, 0x2e, 0xdc, 0x5e, 0x28,
-	0x3a, 0x06, 0xb7, 0x72, 0x6f, 0x70, 0x9b, 0x73, 0x45, 0x12, 0x74, 0x5f, 0xc1, 0x55, 0xc6, 0x4d,
-	0x3e, 0x62, 0xc5, 0xd5, 0x12, 0xa8, 0xe4, 0xab, 0x9f, 0x29, 0xb7, 0xbd, 0x3c, 0x94, 0xe9, 0x30,
-	0x75, 0x45, 0xb4, 0x9e, 0x64, 0xa1, 0x1a, 0xcc, 0xe1, 0x73, 0x6c, 0xc7, 0x97, 0x52, 0xe6, 0x03,
-	0x2e, 0xa5, 0x6c, 0x94, 0x16, 0x39, 0x90, 0x02, 0x37, 0x09, 0x33, 0xfa, 0xd4, 0xb7, 0xb1, 0xc1,
-	0x27, 0x75, 0x8a, 0x59, 0x71, 0x5f, 0x3f, 0x20, 0xec, 0x20, 0xf2, 0x4c, 0x1b, 0x28, 0xbf, 0x03,
-	0xf0, 0xd1, 0xc2, 0x84, 0x47, 0x13, 0x69, 0xf2, 0x91, 0x8f, 0x8f, 0x7c, 0x07, 0xfb, 0xe8, 0x10,
-	0x66, 0xc6, 0x74, 0x01, 0x41, 0xd7, 0x97, 0x0b, 0xaa, 0xd8, 0x74, 0x80, 0xb9, 0xd5, 0xe7, 0xd3,
-	0xc5, 0x29, 0xb1, 0x98, 0x6a, 0x5d, 0x70, 0xcc, 0x94, 0x6f, 0xf0, 0x79, 0x3d, 0x5a, 0xe8, 0xab,
-	0xc3, 0x98, 0xa3, 0x43, 0xb8, 0x31, 0xed, 0xeb, 0xc3, 0xaf, 0xdf, 0xfc, 0x34, 0x39, 0x72, 0xef,
-	0xad, 0xbd, 0xfb, 0xfd, 0x49, 0xa6, 0x41, 0x3d, 0x8e, 0x3d, 0xfe, 0xc5, 0xaf, 0x00, 0x16, 0x16,
-	0xc9, 0x45, 0x9f, 0xc0, 0x47, 0x3d, 0xbd, 0xd6, 0xee, 0x6a, 0x3d, 0xed, 0xa8, 0x6d, 0x74, 0x7b,
-	0xb5, 0xde, 0x71, 0xd7, 0x38, 0x6e, 0x77, 0x3b, 0xcd, 0x86, 0x76, 0xa0, 0x35, 0xf7, 0x0b, 0x29,
-	0xf4, 0x29, 0x2c, 0xdd, 0x0c, 0x69, 0xe8, 0xcd, 0x5a, 0x4f, 0x6b, 0xb7, 0x8c, 0x96, 0x7e, 0x74,
-	0xdc, 0x29, 0x00, 0x54, 0x86, 0xd2, 0xcd, 0xa8, 0xef, 0x6a, 0x9a, 0x08, 0xea, 0x6a, 0xad, 0x76,
-	0x21, 0x8d, 0x3e, 0x87, 0x8f, 0xff, 0x3d, 0xa6, 0xf9, 0x7d, 0xb3, 0x71, 0x1c, 0x39, 0x0a, 0x4b,
-	0xbb, 0xcb, 0xaf, 0x7f, 0x92, 0x52, 0xf5, 0xf6, 0xcf, 0xa1, 0x04, 0xde, 0x86, 0x12, 0x78, 0x1f,
-	0x4a, 0xe0, 0xaf, 0x50, 0x02, 0x6f, 0xae, 0xa4, 0xd4, 0xfb, 0x2b, 0x29, 0xf5, 0xc7, 0x95, 0x94,
-	0x7a, 0xf1, 0xdf, 0xa3, 0x7f, 0x3e, 0xf9, 0x78, 0x11, 0x2f, 0x00, 0x6b, 0x55, 0x84, 0x3c, 0xff,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x1b, 0x02, 0x05, 0xd9, 0x08, 0x00, 0x00,
+	// 1051 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x16, 0x65, 0x59, 0x1f, 0x63, 0xc3, 0x56, 0xd6, 0xb1, 0x21, 0xfb, 0x7d, 0x4d, 0x2a, 0x4a,
+	0x3f, 0x84, 0x00, 0x21, 0x13, 0xa7, 0x27, 0x1f, 0x5a, 0x48, 0xb2, 0xec, 0x12, 0x85, 0x65, 0x81,
+	0x92, 0xdb, 0x22, 0x17, 0x82, 0x1f, 0x6b, 0x66, 0x61, 0x8b, 0x54, 0xb8, 0x94, 0x61, 0x5f, 0x7b,
+	0x0a, 0xd0, 0x4b, 0x7e, 0x42, 0x81, 0x5e, 0x8a, 0x1e, 0x0a, 0x14, 0x68, 0xff, 0x43, 0x90, 0x53,
+	0x8e, 0xbd, 0x54, 0x2e, 0xe8, 0x4b, 0x7f, 0x83, 0x81, 0x02, 0x05, 0x77, 0xa9, 0x4f, 0xab, 0x6d,
+	0x6c, 0x24, 0x17, 0x7b, 0x77, 0xe7, 0x99, 0x9d, 0x99, 0x67, 0x9e, 0x59, 0x0a, 0x4a, 0xa6, 0xe1,
+	0xda, 0x4a, 0xf4, 0x27, 0xa0, 0x54, 0x39, 0x7d, 0x6c, 0xe2, 0xc0, 0x78, 0x3c, 0xd8, 0xcb, 0x5d,
+	0xdf, 0x0b, 0x3c, 0x74, 0x37, 0xda, 0xca, 0x83, 0xb3, 0x18, 0xb3, 0x71, 0xd7, 0xf1, 0x1c, 0x8f,
+	0x01, 0x94, 0x68, 0xc5, 0xb1, 0x1b, 0x92, 0xe3, 0x79, 0xce, 0x09, 0x56, 0xd8, 0xce, 0xec, 0x1d,
+	0x29, 0x01, 0xe9, 0x60, 0x1a, 0x18, 0x9d, 0x6e, 0x0c, 0x10, 0x2d, 0x8f, 0x76, 0x3c, 0xaa, 0x98,
+	0x06, 0xc5, 0xc3, 0x78, 0x96, 0x47, 0xdc, 0xd8, 0xbe, 0xce, 0xed, 0x3a, 0xbf, 0x99, 0x6f, 0xb8,
+	0xa9, 0xf4, 0xbb, 0x00, 0xe9, 0x7d, 0xdc, 0x31, 0xb1, 0x8f, 0x0a, 0x90, 0x31, 0x6c, 0xdb, 0xc7,
+	0x94, 0x16, 0x84, 0xa2, 0x50, 0xce, 0x69, 0x83, 0x2d, 0x7a, 0x0a, 0x59, 0xc7, 0xf7, 0x7a, 0x5d,
+	0x9d, 0xd8, 0x85, 0x64, 0x51, 0x28, 0xa7, 0xaa, 0x9f, 0x85, 0x7d, 0x29, 0xb3, 0x17, 0x9d, 0xa9,
+	0x3b, 0x57, 0x7d, 0xe9, 0x91, 0x43, 0x82, 0x67, 0x3d, 0x53, 0xb6, 0xbc, 0x0e, 0xab, 0x93, 0xdd,
+	0x6d, 0x79, 0x27, 0x8a, 0xf5, 0xcc, 0x20, 0xae, 0x72, 0xfa, 0x44, 0xe9, 0x1e, 0x3b, 0x4a, 0x54,
+	0x69, 0xec, 0xa3, 0x65, 0xd8, 0x85, 0xaa, 0x8d, 0xfe, 0x07, 0x39, 0x42, 0x75, 0xc3, 0x0a, 0xc8,
+	0x29, 0x2e, 0xcc, 0x15, 0x85, 0x72, 0x56, 0xcb, 0x12, 0x5a, 0x61, 0x7b, 0xb4, 0x0d, 0xf3, 0x94,
+	0xb8, 0x16, 0x2e, 0xa4, 0x8a, 0x42, 0x79, 0x61, 0x6b, 0x43, 0xe6, 0x4c, 0xc8, 0x03, 0x26, 0xe4,
+	0xf6, 0x80, 0x89, 0x6a, 0xf6, 0x55, 0x5f, 0x4a, 0xbc, 0xbc, 0x90, 0x04, 0x8d, 0xbb, 0x6c, 0xa7,
+	0xfe, 0xfc, 0x4e, 0x12, 0x4a, 0x3f, 0x0b, 0xb0, 0x58, 0xeb, 0xf9, 0x3e, 0x76, 0x03, 0x16, 0x7a,
+	0xa2, 0x16, 0xe1, 0x1d, 0xd7, 0x52, 0x87, 0x05, 0x5e, 0x88, 0x1e, 0x75, 0xa8, 0x90, 0xbc, 0x41,
+	0xd2, 0xc0, 0x1d, 0x23, 0x53, 0xe9, 0xaf, 0x39, 0xc8, 0xb4, 0x88, 0xe3, 0x12, 0xd7, 0x41, 0xf7,
+	0x21, 0x39, 0x4c, 0x74, 0x25, 0xec, 0x4b, 0x49, 0x96, 0x63, 0x2e, 0x36, 0xab, 0x3b, 0x5a, 0x92,
+	0xd8, 0xe8, 0x39, 0x2c, 0x1d, 0x61, 0xac, 0x77, 0xb1, 0xaf, 0x53, 0xe2, 0xb8, 0xd8, 0x2f, 0x24,
+	0x8b, 0x73, 0xe5, 0x85, 0xad, 0x75, 0x39, 0xee, 0x75, 0x24, 0x8c, 0x81, 0xc8, 0xe4, 0x9a, 0x47,
+	0xdc, 0xea, 0xa3, 0x28, 0xf2, 0x8f, 0x17, 0x52, 0x79, 0xac, 0xda, 0x58, 0x45, 0xfc, 0xdf, 0x43,
+	0x6a, 0x1f, 0x2b, 0xc1, 0x79, 0x17, 0x53, 0xe6, 0x40, 0xb5, 0xc5, 0x23, 0x8c, 0x9b, 0xd8, 0x6f,
+	0xb1, 0x00, 0xe8, 0xff, 0x90, 0xf3, 0xf1, 0xf3, 0x1e, 0xa6, 0x01, 0xf6, 0x59, 0xdb, 0x72, 0xda,
+	0xe8, 0x00, 0xbd, 0x10, 0xa0, 0x60, 0x71, 0xd6, 0x75, 0xce, 0x36, 0xe5, 0x09, 0x47, 0xac, 0xa7,
+	0x58, 0x31, 0x07, 0x61, 0x5f, 0x5a, 0x1d, 0xef, 0xcc, 0xb0, 0xa4, 0xab, 0xbe, 0xb4, 0xf5, 0xd6,
+	0x3d, 0x18, 0x11, 0xb1, 0x6a, 0xcd, 0xb8, 0xcc, 0x46, 0xdf, 0x0a, 0xb0, 0x4e, 0x5c, 0xcb, 0xeb,
+	0x44, 0xd1, 0xaf, 0xe5, 0x32, 0xcf, 0x72, 0x69, 0x86, 0x7d, 0x69, 0x4d, 0x8d, 0x41, 0xef, 0x24,
+	0x99, 0x35, 0x32, 0xeb, 0x36, 0x1b, 0x6d, 0x02, 0xf0, 0x1c, 0x5c, 0xa3, 0x83, 0x0b, 0x69, 0xce,
+	0x1b, 0x3b, 0x69, 0x18, 0x9d, 0x81, 0x66, 0x7f, 0x4d, 0x02, 0x44, 0x5b, 0x9b, 0x2b, 0x16, 0x41,
+	0x8a, 0xa1, 0xf9, 0x50, 0xb2, 0xf5, 0x7b, 0x9d, 0xc8, 0xeb, 0x6a, 0x9a, 0x7b, 0xdf, 0x6a, 0xfa,
+	0x14, 0xd2, 0x34, 0x30, 0x82, 0x1e, 0x65, 0xe2, 0x58, 0xda, 0xfa, 0x48, 0x9e, 0xf5, 0x3c, 0xca,
+	0x23, 0x52, 0x5a, 0x0c, 0xad, 0xc5, 0x5e, 0x31, 0x6f, 0x17, 0xf3, 0xb0, 0xcc, 0xac, 0x6d, 0xdf,
+	0x70, 0x29, 0x09, 0x88, 0xe7, 0x22, 0x13, 0x60, 0xac, 0xdd, 0x7c, 0x8e, 0x6a, 0xe1, 0xf8, 0x04,
+	0xdd, 0xb2, 0xc3, 0x39, 0x3a, 0x6c, 0x6a, 0x17, 0xf2, 0x93, 0x62, 0x1f, 0x36, 0x65, 0x37, 0xec,
+	0x4b, 0x4b, 0xe3, 0x22, 0xbf, 0x65, 0x6f, 0x96, 0xc6, 0xb5, 0xad, 0xda, 0x08, 0xc3, 0xea, 0x64,
+	0xc4, 0x6e, 0xcf, 0xd4, 0x8f, 0xf1, 0x39, 0x9b, 0xc4, 0xc5, 0xea, 0xd6, 0x55, 0x5f, 0x92, 0xdf,
+	0x3a, 0x48, 0xd3, 0x23, 0x6e, 0xa0, 0xa1, 0xf1, 0x10, 0xcd, 0x9e, 0xf9, 0x05, 0x3e, 0x47, 0x14,
+	0xee, 0x4c, 0x8d, 0xce, 0x70, 0x7c, 0xf7, 0xc2, 0xbe, 0xb4, 0x3c, 0x31, 0x32, 0xb7, 0x2c, 0x6d,
+	0x79, 0x62, 0x52, 0x54, 0x1b, 0x39, 0xb0, 0x36, 0x15, 0x74, 0x50, 0xdc, 0xfc, 0xad, 0x8b, 0x5b,
+	0x99, 0x08, 0x12, 0x57, 0x37, 0x12, 0x5d, 0xfa, 0xdf, 0x44, 0x37, 0x12, 0xd3, 0xa4, 0xe8, 0x50,
+	0x05, 0x72, 0xf8, 0x0c, 0x5b, 0xfc, 0xad, 0xcf, 0xdc, 0xe0, 0xad, 0xcf, 0x46, 0x6e, 0x91, 0x01,
+	0xc9, 0xb0, 0x42, 0xa8, 0x7e, 0xe4, 0xf9, 0x16, 0xd6, 0x83, 0x61, 0x9c, 0x42, 0x96, 0x7d, 0x06,
+	0xef, 0x10, 0xba, 0x1b, 0x59, 0x46, 0x09, 0x94, 0x5e, 0x0b, 0xb0, 0x39, 0xa5, 0xf0, 0x48, 0x91,
+	0x46, 0xd0, 0xf3, 0xf1, 0x81, 0x6f, 0x63, 0x1f, 0xed, 0x43, 0x66, 0x40, 0x97, 0xc0, 0xe8, 0xfa,
+	0x64, 0xaa, 0x2b, 0x96, 0xd7, 0xc1, 0x81, 0x79, 0x14, 0x8c, 0x16, 0x27, 0xc4, 0xa4, 0x8a, 0x79,
+	0x1e, 0x60, 0x2a, 0x7f, 0x8e, 0xcf, 0xaa, 0xd1, 0x42, 0x4b, 0x77, 0x39, 0x47, 0xfb, 0xb0, 0x3c,
+	0xca, 0xeb, 0xe6, 0x5f, 0xb5, 0xa5, 0x91, 0x73, 0x64, 0xde, 0x5e, 0x78, 0xfd, 0xcb, 0xc3, 0x4c,
+	0xcd, 0x73, 0x03, 0xec, 0x06, 0x0f, 0xbe, 0x11, 0x20, 0x3f, 0x3d, 0xd1, 0xa8, 0x04, 0x62, 0xa3,
+	0xb2, 0x5f, 0xdf, 0xd1, 0xf7, 0xb4, 0x83, 0xc3, 0xa6, 0xde, 0x6a, 0x57, 0xda, 0x87, 0x2d, 0xfd,
+	0xb0, 0xd1, 0x6a, 0xd6, 0x6b, 0xea, 0xae, 0x5a, 0xdf, 0xc9, 0x27, 0xd0, 0x87, 0x70, 0x6f, 0x06,
+	0xa6, 0xa6, 0xd5, 0x2b, 0x6d, 0xb5, 0xb1, 0xc7, 0x4f, 0xf3, 0x02, 0xda, 0x84, 0xf5, 0x19, 0xb0,
+	0x4a, 0xad, 0xad, 0x7e, 0x59, 0xcf, 0x27, 0x37, 0x52, 0x2f, 0xbe, 0x17, 0x13, 0x0f, 0x7e, 0x12,
+	0x20, 0x3f, 0xdd, 0x61, 0x74, 0x0f, 0x36, 0xdb, 0x5a, 0xa5, 0xd1, 0x52, 0xdb, 0xea, 0x41, 0x63,
+	0x76, 0x0e, 0x1f, 0x40, 0xf1, 0x3a, 0xe4, 0x5a, 0x0a, 0x25, 0x10, 0xaf, 0xa3, 0xbe, 0xaa, 0xa8,
+	0x0c, 0xd4, 0x52, 0xf7, 0x1a, 0xf9, 0x24, 0xfa, 0x18, 0xee, 0xff, 0x33, 0xa6, 0xfe, 0x75, 0xbd,
+	0x76, 0x18, 0x19, 0xf2, 0x73, 0x3c, 0xe1, 0x6a, 0xe3, 0x87, 0x50, 0x14, 0x5e, 0x85, 0xa2, 0xf0,
+	0x26, 0x14, 0x85, 0x3f, 0x42, 0x51, 0x78, 0x79, 0x29, 0x26, 0xde, 0x5c, 0x8a, 0x89, 0xdf, 0x2e,
+	0xc5, 0xc4, 0xd3, 0xff, 0x9e, 0xbf, 0xb3, 0xe1, 0x0f, 0x53, 0xf6, 0x1c, 0x9b, 0x69, 0x06, 0x79,
+	0xf2, 0xf7, 0x00, 0x46, 0xb7, 0x25, 0x89, 0xb5, 0x0a, 0x00, 0x00,
 }
 
 func (this *Member) Equal(that interface{}) bool {
@@ -624,6 +749,47 @@ func (this *Signing) Equal(that interface{}) bool {
 	if this.IncomingGroupSigningID != that1.IncomingGroupSigningID {
 		return false
 	}
+	if this.GroupName != that1.GroupName {
+		return false
+	}
+	return true
+}
+func (this *NamedGroup) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NamedGroup)
+	if !ok {
+		that2, ok := that.(NamedGroup)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.GroupID != that1.GroupID {
+		return false
+	}
+	if len(this.FeePerSigner) != len(that1.FeePerSigner) {
+		return false
+	}
+	for i := range this.FeePerSigner {
+		if !this.FeePerSigner[i].Equal(&that1.FeePerSigner[i]) {
+			return false
+		}
+	}
+	if this.Status != that1.Status {
+		return false
+	}
 	return true
 }
 func (this *GroupTransition) Equal(that interface{}) bool {
@@ -807,6 +973,13 @@ func (m *Signing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintBandtss(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.IncomingGroupSigningID != 0 {
 		i = encodeVarintBandtss(dAtA, i, uint64(m.IncomingGroupSigningID))
 		i--
@@ -846,6 +1019,60 @@ func (m *Signing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NamedGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamedGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamedGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintBandtss(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FeePerSigner) > 0 {
+		for iNdEx := len(m.FeePerSigner) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeePerSigner[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBandtss(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintBandtss(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBandtss(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GroupTransition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1030,6 +1257,35 @@ func (m *Signing) Size() (n int) {
 	if m.IncomingGroupSigningID != 0 {
 		n += 1 + sovBandtss(uint64(m.IncomingGroupSigningID))
 	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovBandtss(uint64(l))
+	}
+	return n
+}
+
+func (m *NamedGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBandtss(uint64(l))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovBandtss(uint64(m.GroupID))
+	}
+	if len(m.FeePerSigner) > 0 {
+		for _, e := range m.FeePerSigner {
+			l = e.Size()
+			n += 1 + l + sovBandtss(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovBandtss(uint64(m.Status))
+	}
 	return n
 }
 
@@ -1496,6 +1752,192 @@ func (m *Signing) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandtss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBandtss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBandtss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBandtss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBandtss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamedGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBandtss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamedGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamedGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandtss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBandtss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBandtss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandtss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= github_com_bandprotocol_chain_v3_pkg_tss.GroupID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerSigner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandtss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBandtss
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBandtss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePerSigner = append(m.FeePerSigner, types.Coin{})
+			if err := m.FeePerSigner[len(m.FeePerSigner)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandtss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= NamedGroupStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBandtss(dAtA[iNdEx:])
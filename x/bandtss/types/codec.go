@@ -18,6 +18,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgRequestSignature{}, "bandtss/MsgRequestSignature")
 	legacy.RegisterAminoMsg(cdc, &MsgActivate{}, "bandtss/MsgActivate")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "bandtss/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateNamedGroup{}, "bandtss/MsgCreateNamedGroup")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateNamedGroup{}, "bandtss/MsgUpdateNamedGroup")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveNamedGroup{}, "bandtss/MsgRemoveNamedGroup")
 
 	cdc.RegisterConcrete(Params{}, "bandtss/Params", nil)
 }
@@ -30,6 +33,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgRequestSignature{},
 		&MsgActivate{},
 		&MsgUpdateParams{},
+		&MsgCreateNamedGroup{},
+		&MsgUpdateNamedGroup{},
+		&MsgRemoveNamedGroup{},
 	)
 
 	registry.RegisterImplementations(
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/bandprotocol/chain/v3/pkg/tss"
 )
@@ -74,6 +75,7 @@ func NewSigning(
 	requester sdk.AccAddress,
 	currentGroupSigningID tss.SigningID,
 	incomingGroupSigningID tss.SigningID,
+	groupName string,
 ) Signing {
 	return Signing{
 		ID:                     id,
@@ -81,5 +83,54 @@ func NewSigning(
 		Requester:              requester.String(),
 		CurrentGroupSigningID:  currentGroupSigningID,
 		IncomingGroupSigningID: incomingGroupSigningID,
+		GroupName:              groupName,
 	}
 }
+
+// NewNamedGroup creates a new named group object.
+func NewNamedGroup(
+	name string,
+	groupID tss.GroupID,
+	feePerSigner sdk.Coins,
+	status NamedGroupStatus,
+) NamedGroup {
+	return NamedGroup{
+		Name:         name,
+		GroupID:      groupID,
+		FeePerSigner: feePerSigner,
+		Status:       status,
+	}
+}
+
+// Validate performs basic validation of named group information.
+func (g NamedGroup) Validate() error {
+	if err := ValidateGroupName(g.Name); err != nil {
+		return err
+	}
+
+	if g.GroupID == 0 {
+		return ErrInvalidGroupID.Wrap("group id cannot be 0")
+	}
+
+	if !g.FeePerSigner.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrap(g.FeePerSigner.String())
+	}
+
+	return nil
+}
+
+// ValidateGroupName checks that the group name is not empty, not too long and only contains
+// lowercase letters, digits, hyphens and underscores.
+func ValidateGroupName(name string) error {
+	if len(name) == 0 || len(name) > MaxGroupNameLength {
+		return ErrInvalidGroupName.Wrapf("group name length must be between 1 and %d", MaxGroupNameLength)
+	}
+
+	for _, c := range name {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' && c != '_' {
+			return ErrInvalidGroupName.Wrapf("group name %s contains an invalid character %q", name, c)
+		}
+	}
+
+	return nil
+}
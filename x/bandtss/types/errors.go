@@ -21,4 +21,8 @@ var (
 	ErrNoIncomingGroup           = errorsmod.Register(ModuleName, 16, "no incoming group")
 	ErrInvalidGroupID            = errorsmod.Register(ModuleName, 17, "invalid group ID")
 	ErrInvalidMember             = errorsmod.Register(ModuleName, 18, "invalid member")
+	ErrNamedGroupNotFound        = errorsmod.Register(ModuleName, 19, "named group not found")
+	ErrNamedGroupAlreadyExists   = errorsmod.Register(ModuleName, 20, "named group already exists")
+	ErrNamedGroupNotActive       = errorsmod.Register(ModuleName, 21, "named group is not active")
+	ErrInvalidGroupName          = errorsmod.Register(ModuleName, 22, "invalid group name")
 )
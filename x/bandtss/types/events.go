@@ -9,6 +9,11 @@ const (
 	EventTypeSigningRequestCreated  = "bandtss_signing_request_created"
 	EventTypeCreateSigningFailed    = "create_signing_failed"
 	EventTypeMemberDeleted          = "member_deleted"
+	EventTypeCreateNamedGroup       = "create_named_group"
+	EventTypeNamedGroupActivated    = "named_group_activated"
+	EventTypeNamedGroupFailed       = "named_group_failed"
+	EventTypeUpdateNamedGroup       = "update_named_group"
+	EventTypeRemoveNamedGroup       = "remove_named_group"
 
 	AttributeKeyAddress                = "address"
 	AttributeKeySigningID              = "bandtss_signing_id"
@@ -27,4 +32,6 @@ const (
 	AttributeKeySigningErrReason       = "signing_error_reason"
 	AttributeKeySigningErrCode         = "signing_error_code"
 	AttributeKeySigningErrCodespace    = "signing_error_codespace"
+	AttributeKeyGroupName              = "group_name"
+	AttributeKeyFeePerSigner           = "fee_per_signer"
 )
@@ -50,6 +50,15 @@ type TSSKeeper interface {
 		members []sdk.AccAddress,
 		threshold uint64,
 		moduleOwner string,
+		curve tsstypes.Curve,
+	) (tss.GroupID, error)
+
+	CreateReshareGroup(
+		ctx sdk.Context,
+		sourceGroupID tss.GroupID,
+		members []sdk.AccAddress,
+		threshold uint64,
+		moduleOwner string,
 	) (tss.GroupID, error)
 
 	RequestSigning(
@@ -59,12 +68,19 @@ type TSSKeeper interface {
 		content tsstypes.Content,
 	) (tss.SigningID, error)
 
+	RequestBatchSigning(
+		ctx sdk.Context,
+		groupID tss.GroupID,
+		originator tsstypes.Originator,
+		content tsstypes.Content,
+	) (tss.SigningID, error)
+
 	MustGetMembers(ctx sdk.Context, groupID tss.GroupID) []tsstypes.Member
 	GetMemberByAddress(ctx sdk.Context, groupID tss.GroupID, address string) (tsstypes.Member, error)
 	ActivateMember(ctx sdk.Context, groupID tss.GroupID, address sdk.AccAddress) error
 	DeactivateMember(ctx sdk.Context, groupID tss.GroupID, address sdk.AccAddress) error
 
-	GetDEQueue(ctx sdk.Context, address sdk.AccAddress) tsstypes.DEQueue
+	GetDEQueue(ctx sdk.Context, curve tsstypes.Curve, address sdk.AccAddress) tsstypes.DEQueue
 	GetGroup(ctx sdk.Context, groupID tss.GroupID) (tsstypes.Group, error)
 	MustGetGroup(ctx sdk.Context, groupID tss.GroupID) tsstypes.Group
 
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
 )
 
 // NewGenesisState creates a new GenesisState instance.
@@ -9,11 +11,13 @@ func NewGenesisState(
 	params Params,
 	members []Member,
 	currentGroup CurrentGroup,
+	namedGroups []NamedGroup,
 ) *GenesisState {
 	return &GenesisState{
 		Params:       params,
 		Members:      members,
 		CurrentGroup: currentGroup,
+		NamedGroups:  namedGroups,
 	}
 }
 
@@ -23,6 +27,7 @@ func DefaultGenesisState() *GenesisState {
 		DefaultParams(),
 		[]Member{},
 		CurrentGroup{},
+		[]NamedGroup{},
 	)
 }
 
@@ -33,13 +38,35 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	groupIDs := make(map[tss.GroupID]bool)
+	if gs.CurrentGroup.GroupID != 0 {
+		groupIDs[gs.CurrentGroup.GroupID] = true
+	}
+
+	names := make(map[string]bool)
+	for _, g := range gs.NamedGroups {
+		if err := g.Validate(); err != nil {
+			return err
+		}
+
+		if names[g.Name] {
+			return fmt.Errorf("duplicated named group %s", g.Name)
+		}
+		names[g.Name] = true
+
+		if groupIDs[g.GroupID] {
+			return fmt.Errorf("group %d of named group %s is already in use", g.GroupID, g.Name)
+		}
+		groupIDs[g.GroupID] = true
+	}
+
 	for _, m := range gs.Members {
 		if err := m.Validate(); err != nil {
 			return err
 		}
 
-		if m.GroupID != gs.CurrentGroup.GroupID {
-			return fmt.Errorf("member %s is not in current group %d", m.Address, gs.CurrentGroup.GroupID)
+		if !groupIDs[m.GroupID] {
+			return fmt.Errorf("member %s is not in current group or any named group", m.Address)
 		}
 	}
 
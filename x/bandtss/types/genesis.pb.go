@@ -37,6 +37,8 @@ type GenesisState struct {
 	Members []Member `protobuf:"bytes,2,rep,name=members,proto3" json:"members"`
 	// current_group is the current group information.
 	CurrentGroup CurrentGroup `protobuf:"bytes,3,opt,name=current_group,json=currentGroup,proto3" json:"current_group"`
+	// named_groups is an array containing the named groups information.
+	NamedGroups []NamedGroup `protobuf:"bytes,4,rep,name=named_groups,json=namedGroups,proto3" json:"named_groups"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -93,6 +95,13 @@ func (m *GenesisState) GetCurrentGroup() CurrentGroup {
 	return CurrentGroup{}
 }
 
+func (m *GenesisState) GetNamedGroups() []NamedGroup {
+	if m != nil {
+		return m.NamedGroups
+	}
+	return nil
+}
+
 // Params defines the set of module parameters.
 type Params struct {
 	// reward_percentage is the percentage of block rewards allocated to active tss members.
@@ -186,39 +195,41 @@ func init() {
 }
 
 var fileDescriptor_3fe6a4345855d3c9 = []byte{
-	// 505 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0x26, 0x04, 0xb4, 0x04, 0x54, 0xac, 0xa0, 0x26, 0x15, 0xb2, 0xab, 0x9c, 0x7a,
-	0x61, 0xb7, 0xa5, 0xb7, 0x8a, 0x0b, 0x06, 0xa9, 0x12, 0x52, 0xa5, 0xc8, 0xe5, 0x04, 0x07, 0x6b,
-	0xed, 0x4c, 0xdc, 0x15, 0xf1, 0xae, 0xd9, 0x5d, 0x87, 0xf4, 0x2d, 0x38, 0x72, 0xe4, 0xcc, 0x93,
-	0xf4, 0xd8, 0x1b, 0x9c, 0x1a, 0x94, 0x5c, 0x78, 0x0c, 0xb4, 0x6b, 0x3b, 0x2d, 0xc8, 0x48, 0x70,
-	0xf1, 0x9f, 0x99, 0x6f, 0x7e, 0xf3, 0xad, 0x67, 0x8c, 0x46, 0x31, 0xe5, 0x13, 0x62, 0x2e, 0x5a,
-	0x29, 0x32, 0x3f, 0x8c, 0x41, 0xd3, 0x43, 0x92, 0x02, 0x07, 0xc5, 0x14, 0xce, 0xa5, 0xd0, 0xc2,
-	0xed, 0x9b, 0x34, 0xae, 0x34, 0xb8, 0xd2, 0xec, 0xf6, 0x53, 0x91, 0x0a, 0x2b, 0x20, 0xe6, 0xa9,
-	0xd4, 0xee, 0x7a, 0xa9, 0x10, 0xe9, 0x0c, 0x88, 0x7d, 0x8b, 0x8b, 0x29, 0x99, 0x14, 0x92, 0x6a,
-	0x26, 0x78, 0x9d, 0x4f, 0x84, 0xca, 0x84, 0x22, 0x31, 0x55, 0xb0, 0x69, 0x97, 0x08, 0x56, 0xe7,
-	0x9b, 0xfd, 0xd4, 0xbd, 0xad, 0x66, 0xf4, 0xcd, 0x41, 0xbd, 0x93, 0xd2, 0xe1, 0x99, 0xa6, 0x1a,
-	0xdc, 0x63, 0xd4, 0xcd, 0xa9, 0xa4, 0x99, 0x1a, 0x38, 0x7b, 0xce, 0xfe, 0xfd, 0x67, 0x4f, 0x70,
-	0x93, 0x63, 0x3c, 0xb6, 0x9a, 0xa0, 0x73, 0x79, 0xed, 0xb7, 0xc2, 0xaa, 0xc2, 0x7d, 0x8e, 0xee,
-	0x66, 0x90, 0xc5, 0x20, 0xd5, 0x60, 0x6b, 0xaf, 0xfd, 0xf7, 0xe2, 0x53, 0x2b, 0xaa, 0x8a, 0xeb,
-	0x12, 0xf7, 0x14, 0x3d, 0x48, 0x0a, 0x29, 0x81, 0xeb, 0x28, 0x95, 0xa2, 0xc8, 0x07, 0x6d, 0x6b,
-	0x60, 0xd4, 0xcc, 0x78, 0x59, 0x4a, 0x4f, 0x8c, 0xb2, 0x22, 0xf5, 0x92, 0x5b, 0xb1, 0xd1, 0xb2,
-	0x8d, 0xba, 0xa5, 0x4b, 0xf7, 0x05, 0x7a, 0x24, 0xe1, 0x23, 0x95, 0x93, 0x28, 0x07, 0x99, 0x00,
-	0xd7, 0x34, 0x05, 0x7b, 0xbc, 0x4e, 0xd0, 0x5f, 0x5d, 0xfb, 0xdb, 0xa1, 0x4d, 0x8e, 0x37, 0xb9,
-	0x70, 0x5b, 0xfe, 0x11, 0x71, 0x23, 0x34, 0x64, 0x9c, 0x26, 0x9a, 0xcd, 0x21, 0xca, 0x81, 0xd3,
-	0x99, 0xbe, 0x88, 0xea, 0x71, 0x0c, 0xb6, 0xac, 0xd1, 0x21, 0x2e, 0xe7, 0x85, 0xeb, 0x79, 0xe1,
-	0x57, 0x95, 0x20, 0xb8, 0x67, 0xfc, 0x7d, 0x5e, 0xfa, 0x4e, 0xb8, 0x53, 0x53, 0xc6, 0x25, 0xa4,
-	0x96, 0xb8, 0xef, 0xd0, 0x4e, 0xc6, 0x78, 0xa4, 0x25, 0xe5, 0x8a, 0x99, 0xc8, 0x0d, 0xbe, 0xfd,
-	0xef, 0xf8, 0xc7, 0x19, 0xe3, 0x6f, 0x36, 0x88, 0xdf, 0xe0, 0x74, 0xd1, 0x08, 0xef, 0xfc, 0x0f,
-	0x9c, 0x2e, 0x1a, 0xe0, 0x1f, 0xd0, 0xc3, 0x29, 0x98, 0xaf, 0x22, 0x23, 0xc5, 0x52, 0x0e, 0x72,
-	0x70, 0xc7, 0x0e, 0x7f, 0x88, 0xcb, 0xfd, 0xc4, 0x66, 0x3f, 0x6f, 0xe6, 0x26, 0x18, 0x0f, 0x0e,
-	0x0c, 0xf3, 0xeb, 0xd2, 0xdf, 0x4f, 0x99, 0x3e, 0x2f, 0x62, 0x9c, 0x88, 0x8c, 0x54, 0xcb, 0x5c,
-	0xde, 0x9e, 0xaa, 0xc9, 0x7b, 0xa2, 0x2f, 0x72, 0x50, 0xb6, 0x40, 0x85, 0xbd, 0x29, 0xc0, 0x18,
-	0xe4, 0x99, 0x6d, 0x70, 0xdc, 0xf9, 0xf9, 0xc5, 0x77, 0x82, 0xd7, 0x97, 0x2b, 0xcf, 0xb9, 0x5a,
-	0x79, 0xce, 0x8f, 0x95, 0xe7, 0x7c, 0x5a, 0x7b, 0xad, 0xab, 0xb5, 0xd7, 0xfa, 0xbe, 0xf6, 0x5a,
-	0x6f, 0x0f, 0x6e, 0x71, 0xcd, 0xe2, 0xd8, 0x53, 0x25, 0x62, 0x46, 0x92, 0x73, 0xca, 0x38, 0x99,
-	0x1f, 0x91, 0xc5, 0xe6, 0xbf, 0xb0, 0x5d, 0xe2, 0xae, 0x95, 0x1c, 0xfd, 0x0a, 0x00, 0x00, 0xff,
-	0xff, 0xe9, 0x4e, 0xb2, 0x50, 0xc4, 0x03, 0x00, 0x00,
+	// 529 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3d, 0x6f, 0x13, 0x4d,
+	0x10, 0xc7, 0x7d, 0xb6, 0x1f, 0x3f, 0x68, 0x63, 0x50, 0x38, 0x19, 0xe5, 0x1c, 0xa1, 0xb3, 0xe5,
+	0x2a, 0x0d, 0xbb, 0x09, 0xe9, 0x22, 0x1a, 0x0c, 0x52, 0x04, 0x52, 0x90, 0xe5, 0x50, 0x41, 0x71,
+	0xda, 0x3b, 0x8f, 0x2f, 0x2b, 0x7c, 0xbb, 0xc7, 0xee, 0xda, 0x38, 0xdf, 0x82, 0x92, 0x12, 0x51,
+	0xf2, 0x49, 0x52, 0xa6, 0xa4, 0x8a, 0x91, 0xdd, 0xf0, 0x31, 0xd0, 0xee, 0xbd, 0xc4, 0xa0, 0x43,
+	0x82, 0xe6, 0x5e, 0x66, 0xfe, 0xf3, 0x9b, 0x99, 0x9d, 0x59, 0x34, 0x08, 0x29, 0x9f, 0x10, 0xf3,
+	0xd0, 0x4a, 0x91, 0xc5, 0x51, 0x08, 0x9a, 0x1e, 0x91, 0x18, 0x38, 0x28, 0xa6, 0x70, 0x2a, 0x85,
+	0x16, 0x6e, 0xc7, 0xb8, 0x71, 0xae, 0xc1, 0xb9, 0x66, 0xbf, 0x13, 0x8b, 0x58, 0x58, 0x01, 0x31,
+	0x5f, 0x99, 0x76, 0xdf, 0x8f, 0x85, 0x88, 0x67, 0x40, 0xec, 0x5f, 0x38, 0x9f, 0x92, 0xc9, 0x5c,
+	0x52, 0xcd, 0x04, 0x2f, 0xfc, 0x91, 0x50, 0x89, 0x50, 0x24, 0xa4, 0x0a, 0xca, 0x74, 0x91, 0x60,
+	0x85, 0xbf, 0xba, 0x9e, 0x22, 0xb7, 0xd5, 0x0c, 0xbe, 0xd4, 0x51, 0xfb, 0x34, 0xab, 0xf0, 0x5c,
+	0x53, 0x0d, 0xee, 0x09, 0x6a, 0xa5, 0x54, 0xd2, 0x44, 0x79, 0x4e, 0xdf, 0x39, 0xd8, 0x79, 0xfc,
+	0x10, 0x57, 0x55, 0x8c, 0x47, 0x56, 0x33, 0x6c, 0x5e, 0xdd, 0xf4, 0x6a, 0xe3, 0x3c, 0xc2, 0x7d,
+	0x82, 0xfe, 0x4f, 0x20, 0x09, 0x41, 0x2a, 0xaf, 0xde, 0x6f, 0xfc, 0x39, 0xf8, 0xcc, 0x8a, 0xf2,
+	0xe0, 0x22, 0xc4, 0x3d, 0x43, 0x77, 0xa3, 0xb9, 0x94, 0xc0, 0x75, 0x10, 0x4b, 0x31, 0x4f, 0xbd,
+	0x86, 0x2d, 0x60, 0x50, 0xcd, 0x78, 0x96, 0x49, 0x4f, 0x8d, 0x32, 0x27, 0xb5, 0xa3, 0x2d, 0x9b,
+	0xfb, 0x02, 0xb5, 0x39, 0x4d, 0x60, 0x92, 0xc1, 0x94, 0xd7, 0xb4, 0x15, 0xf5, 0xab, 0x69, 0xaf,
+	0x8c, 0x72, 0x9b, 0xb5, 0xc3, 0x4b, 0x8b, 0x1a, 0xac, 0x1a, 0xa8, 0x95, 0x35, 0xec, 0x3e, 0x45,
+	0xf7, 0x25, 0x7c, 0xa0, 0x72, 0x12, 0xa4, 0x20, 0x23, 0xe0, 0x9a, 0xc6, 0x60, 0x4f, 0xaa, 0x39,
+	0xec, 0xac, 0x6f, 0x7a, 0xbb, 0x63, 0xeb, 0x1c, 0x95, 0xbe, 0xf1, 0xae, 0xfc, 0xcd, 0xe2, 0x06,
+	0xa8, 0xcb, 0x38, 0x8d, 0x34, 0x5b, 0x40, 0x90, 0x02, 0xa7, 0x33, 0x7d, 0x19, 0x14, 0x93, 0xf5,
+	0xea, 0xb6, 0xe7, 0x2e, 0xce, 0x46, 0x8f, 0x8b, 0xd1, 0xe3, 0xe7, 0xb9, 0x60, 0x78, 0xc7, 0x94,
+	0xf7, 0x69, 0xd5, 0x73, 0xc6, 0x7b, 0x05, 0x65, 0x94, 0x41, 0x0a, 0x89, 0xfb, 0x16, 0xed, 0x25,
+	0x8c, 0x07, 0x5a, 0x52, 0xae, 0x98, 0xb1, 0xdc, 0xe2, 0x1b, 0x7f, 0x8f, 0x7f, 0x90, 0x30, 0xfe,
+	0xba, 0x44, 0xfc, 0x02, 0xa7, 0xcb, 0x4a, 0x78, 0xf3, 0x5f, 0xe0, 0x74, 0x59, 0x01, 0x7f, 0x8f,
+	0xee, 0x4d, 0xc1, 0x9c, 0x8a, 0x0c, 0x14, 0x8b, 0x39, 0x48, 0xef, 0x3f, 0x3b, 0xb5, 0x2e, 0xce,
+	0x56, 0x1d, 0x9b, 0x55, 0xbf, 0x5d, 0x01, 0xc1, 0xf8, 0xf0, 0xd0, 0x30, 0xbf, 0xae, 0x7a, 0x07,
+	0x31, 0xd3, 0x17, 0xf3, 0x10, 0x47, 0x22, 0x21, 0xf9, 0xbd, 0xc8, 0x5e, 0x8f, 0xd4, 0xe4, 0x1d,
+	0xd1, 0x97, 0x29, 0x28, 0x1b, 0xa0, 0xc6, 0xed, 0x29, 0xc0, 0x08, 0xe4, 0xb9, 0x4d, 0x70, 0xd2,
+	0xfc, 0xf1, 0xb9, 0xe7, 0x0c, 0x5f, 0x5e, 0xad, 0x7d, 0xe7, 0x7a, 0xed, 0x3b, 0xdf, 0xd7, 0xbe,
+	0xf3, 0x71, 0xe3, 0xd7, 0xae, 0x37, 0x7e, 0xed, 0xdb, 0xc6, 0xaf, 0xbd, 0x39, 0xdc, 0xe2, 0x9a,
+	0xad, 0xb1, 0x5d, 0x45, 0x62, 0x46, 0xa2, 0x0b, 0xca, 0x38, 0x59, 0x1c, 0x93, 0x65, 0x79, 0xc5,
+	0x6c, 0x96, 0xb0, 0x65, 0x25, 0xc7, 0x3f, 0x07, 0x00, 0xe3, 0x62, 0x0f, 0xfc, 0x0f, 0x04, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -282,6 +293,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NamedGroups) > 0 {
+		for iNdEx := len(m.NamedGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NamedGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.CurrentGroup.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -412,6 +437,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.CurrentGroup.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.NamedGroups) > 0 {
+		for _, e := range m.NamedGroups {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -574,6 +605,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamedGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamedGroups = append(m.NamedGroups, NamedGroup{})
+			if err := m.NamedGroups[len(m.NamedGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -39,6 +39,41 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid genesisState - members of a named group",
+			types.GenesisState{
+				Params:       types.DefaultParams(),
+				Members:      validMembers,
+				CurrentGroup: types.NewCurrentGroup(2, time.Now()),
+				NamedGroups: []types.NamedGroup{
+					types.NewNamedGroup("fast", 1, nil, types.NAMED_GROUP_STATUS_ACTIVE),
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesisState - duplicated named group",
+			types.GenesisState{
+				Params:       types.DefaultParams(),
+				CurrentGroup: types.NewCurrentGroup(1, time.Now()),
+				NamedGroups: []types.NamedGroup{
+					types.NewNamedGroup("fast", 2, nil, types.NAMED_GROUP_STATUS_ACTIVE),
+					types.NewNamedGroup("fast", 3, nil, types.NAMED_GROUP_STATUS_ACTIVE),
+				},
+			},
+			true,
+		},
+		{
+			"invalid genesisState - named group uses the current group",
+			types.GenesisState{
+				Params:       types.DefaultParams(),
+				CurrentGroup: types.NewCurrentGroup(1, time.Now()),
+				NamedGroups: []types.NamedGroup{
+					types.NewNamedGroup("fast", 1, nil, types.NAMED_GROUP_STATUS_ACTIVE),
+				},
+			},
+			true,
+		},
 		{
 			"invalid genesisState - members not belongs to current group",
 			types.GenesisState{
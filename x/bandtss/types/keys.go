@@ -33,6 +33,7 @@ var (
 	MemberStoreKeyPrefix           = []byte{0x10}
 	SigningInfoStoreKeyPrefix      = []byte{0x11}
 	SigningIDMappingStoreKeyPrefix = []byte{0x12}
+	NamedGroupStoreKeyPrefix       = []byte{0x13}
 
 	// param store key
 	ParamsKey = []byte{0x90}
@@ -58,3 +59,8 @@ func SigningInfoStoreKey(id SigningID) []byte {
 func SigningIDMappingStoreKey(id tss.SigningID) []byte {
 	return append(SigningIDMappingStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(id))...)
 }
+
+// NamedGroupStoreKey returns the key for storing the named group information.
+func NamedGroupStoreKey(name string) []byte {
+	return append(NamedGroupStoreKeyPrefix, []byte(name)...)
+}
@@ -20,12 +20,18 @@ var (
 	_ sdk.Msg = &MsgRequestSignature{}
 	_ sdk.Msg = &MsgActivate{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgCreateNamedGroup{}
+	_ sdk.Msg = &MsgUpdateNamedGroup{}
+	_ sdk.Msg = &MsgRemoveNamedGroup{}
 
 	_ sdk.HasValidateBasic = (*MsgTransitionGroup)(nil)
 	_ sdk.HasValidateBasic = (*MsgForceTransitionGroup)(nil)
 	_ sdk.HasValidateBasic = (*MsgRequestSignature)(nil)
 	_ sdk.HasValidateBasic = (*MsgActivate)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgCreateNamedGroup)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateNamedGroup)(nil)
+	_ sdk.HasValidateBasic = (*MsgRemoveNamedGroup)(nil)
 
 	_ types.UnpackInterfacesMessage = &MsgRequestSignature{}
 )
@@ -40,12 +46,16 @@ func NewMsgTransitionGroup(
 	threshold uint64,
 	execTime time.Time,
 	authority string,
+	reshare bool,
+	curve tsstypes.Curve,
 ) *MsgTransitionGroup {
 	return &MsgTransitionGroup{
 		Members:   members,
 		Threshold: threshold,
 		Authority: authority,
 		ExecTime:  execTime,
+		Reshare:   reshare,
+		Curve:     curve,
 	}
 }
 
@@ -84,6 +94,11 @@ func (m MsgTransitionGroup) ValidateBasic() error {
 		)
 	}
 
+	// Validate curve
+	if err := m.Curve.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -165,6 +180,13 @@ func (m MsgRequestSignature) ValidateBasic() error {
 		return sdkerrors.ErrInvalidCoins.Wrap(m.FeeLimit.String())
 	}
 
+	// validate group name if the request is signed by a named group
+	if m.GroupName != "" {
+		if err := ValidateGroupName(m.GroupName); err != nil {
+			return err
+		}
+	}
+
 	content, ok := m.Content.GetCachedValue().(tsstypes.Content)
 	if !ok {
 		return sdkerrors.ErrInvalidType.Wrapf("expected type Content, got %T", content)
@@ -248,3 +270,129 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 
 	return nil
 }
+
+// ====================================
+// MsgCreateNamedGroup
+// ====================================
+
+// NewMsgCreateNamedGroup creates a new MsgCreateNamedGroup instance.
+func NewMsgCreateNamedGroup(
+	name string,
+	members []string,
+	threshold uint64,
+	curve tsstypes.Curve,
+	feePerSigner sdk.Coins,
+	authority string,
+) *MsgCreateNamedGroup {
+	return &MsgCreateNamedGroup{
+		Name:         name,
+		Members:      members,
+		Threshold:    threshold,
+		Curve:        curve,
+		FeePerSigner: feePerSigner,
+		Authority:    authority,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgCreateNamedGroup) ValidateBasic() error {
+	// Validate authority address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if err := ValidateGroupName(m.Name); err != nil {
+		return err
+	}
+
+	// Validate members address
+	existed := make(map[string]bool)
+	for _, member := range m.Members {
+		if _, err := sdk.AccAddressFromBech32(member); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid member address: %s", err)
+		}
+
+		if existed[member] {
+			return ErrMemberDuplicated.Wrapf("member %s is duplicated", member)
+		}
+		existed[member] = true
+	}
+
+	// Validate threshold must be less than or equal to members but not zero
+	if m.Threshold == 0 {
+		return ErrInvalidThreshold.Wrap("threshold must be greater than zero")
+	}
+	if m.Threshold > uint64(len(m.Members)) {
+		return ErrInvalidThreshold.Wrapf(
+			"threshold (%d) must be less than or equal to the number of members (%d)",
+			m.Threshold,
+			len(m.Members),
+		)
+	}
+
+	// Validate curve
+	if err := m.Curve.Validate(); err != nil {
+		return err
+	}
+
+	// Validate fee per signer
+	if !m.FeePerSigner.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrap(m.FeePerSigner.String())
+	}
+
+	return nil
+}
+
+// ====================================
+// MsgUpdateNamedGroup
+// ====================================
+
+// NewMsgUpdateNamedGroup creates a new MsgUpdateNamedGroup instance.
+func NewMsgUpdateNamedGroup(name string, feePerSigner sdk.Coins, authority string) *MsgUpdateNamedGroup {
+	return &MsgUpdateNamedGroup{
+		Name:         name,
+		FeePerSigner: feePerSigner,
+		Authority:    authority,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgUpdateNamedGroup) ValidateBasic() error {
+	// Validate authority address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if err := ValidateGroupName(m.Name); err != nil {
+		return err
+	}
+
+	// Validate fee per signer
+	if !m.FeePerSigner.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrap(m.FeePerSigner.String())
+	}
+
+	return nil
+}
+
+// ====================================
+// MsgRemoveNamedGroup
+// ====================================
+
+// NewMsgRemoveNamedGroup creates a new MsgRemoveNamedGroup instance.
+func NewMsgRemoveNamedGroup(name string, authority string) *MsgRemoveNamedGroup {
+	return &MsgRemoveNamedGroup{
+		Name:      name,
+		Authority: authority,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgRemoveNamedGroup) ValidateBasic() error {
+	// Validate authority address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return ValidateGroupName(m.Name)
+}
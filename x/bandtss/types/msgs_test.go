@@ -6,10 +6,13 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bandprotocol/chain/v3/pkg/tss"
 	"github.com/bandprotocol/chain/v3/x/bandtss/types"
+	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
 )
 
 var (
@@ -27,7 +30,7 @@ var (
 
 func TestNewMsgTransitionGroup(t *testing.T) {
 	execTime := time.Now().Add(time.Hour)
-	msg := types.NewMsgTransitionGroup(validMembers, 1, execTime, validSender)
+	msg := types.NewMsgTransitionGroup(validMembers, 1, execTime, validSender, false, tsstypes.CURVE_SECP256K1)
 	require.Equal(t, validMembers, msg.Members)
 	require.Equal(t, uint64(1), msg.Threshold)
 	require.Equal(t, execTime, msg.ExecTime)
@@ -37,18 +40,28 @@ func TestNewMsgTransitionGroup(t *testing.T) {
 func TestMsgTransitionGroup_ValidateBasic(t *testing.T) {
 	// Valid input
 	execTime := time.Now().Add(time.Hour)
-	msg := types.NewMsgTransitionGroup(validMembers, 1, execTime, validSender)
+	msg := types.NewMsgTransitionGroup(validMembers, 1, execTime, validSender, false, tsstypes.CURVE_SECP256K1)
 	err := msg.ValidateBasic()
 	require.NoError(t, err)
 
 	// duplicate members
 	duplicatedMembers := []string{validMembers[0], validMembers[0]}
-	msg = types.NewMsgTransitionGroup(duplicatedMembers, 1, execTime, validSender)
+	msg = types.NewMsgTransitionGroup(duplicatedMembers, 1, execTime, validSender, false, tsstypes.CURVE_SECP256K1)
 	err = msg.ValidateBasic()
 	require.Error(t, err)
 
 	// validate threshold
-	msg = types.NewMsgTransitionGroup(validMembers, 3, execTime, validSender)
+	msg = types.NewMsgTransitionGroup(validMembers, 3, execTime, validSender, false, tsstypes.CURVE_SECP256K1)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// ed25519 curve
+	msg = types.NewMsgTransitionGroup(validMembers, 1, execTime, validSender, false, tsstypes.CURVE_ED25519)
+	err = msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// unknown curve
+	msg = types.NewMsgTransitionGroup(validMembers, 1, execTime, validSender, false, tsstypes.Curve(99))
 	err = msg.ValidateBasic()
 	require.Error(t, err)
 }
@@ -154,3 +167,84 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 	err = msg.ValidateBasic()
 	require.Error(t, err)
 }
+
+// ====================================
+// MsgCreateNamedGroup
+// ====================================
+
+func TestNewMsgCreateNamedGroup(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uband", 10))
+	msg := types.NewMsgCreateNamedGroup("fast", validMembers, 1, tsstypes.CURVE_SECP256K1, fee, validSender)
+	require.Equal(t, "fast", msg.Name)
+	require.Equal(t, validMembers, msg.Members)
+	require.Equal(t, uint64(1), msg.Threshold)
+	require.Equal(t, tsstypes.CURVE_SECP256K1, msg.Curve)
+	require.Equal(t, fee, msg.FeePerSigner)
+	require.Equal(t, validSender, msg.Authority)
+}
+
+func TestMsgCreateNamedGroup_ValidateBasic(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uband", 10))
+
+	// Valid input
+	msg := types.NewMsgCreateNamedGroup("fast_feeds-1", validMembers, 1, tsstypes.CURVE_SECP256K1, fee, validSender)
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// invalid name
+	for _, name := range []string{"", "Fast", "fast group", "a-very-long-group-name-over-the-limit"} {
+		msg = types.NewMsgCreateNamedGroup(name, validMembers, 1, tsstypes.CURVE_SECP256K1, fee, validSender)
+		err = msg.ValidateBasic()
+		require.ErrorIs(t, err, types.ErrInvalidGroupName)
+	}
+
+	// duplicate members
+	duplicatedMembers := []string{validMembers[0], validMembers[0]}
+	msg = types.NewMsgCreateNamedGroup("fast", duplicatedMembers, 1, tsstypes.CURVE_SECP256K1, fee, validSender)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// validate threshold
+	msg = types.NewMsgCreateNamedGroup("fast", validMembers, 3, tsstypes.CURVE_SECP256K1, fee, validSender)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+}
+
+// ====================================
+// MsgUpdateNamedGroup
+// ====================================
+
+func TestMsgUpdateNamedGroup_ValidateBasic(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uband", 10))
+
+	// Valid input
+	msg := types.NewMsgUpdateNamedGroup("fast", fee, validSender)
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// invalid name
+	msg = types.NewMsgUpdateNamedGroup("", fee, validSender)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// invalid fee
+	msg = types.NewMsgUpdateNamedGroup("fast", sdk.Coins{sdk.Coin{Denom: "uband", Amount: math.NewInt(-1)}}, validSender)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+}
+
+// ====================================
+// MsgRemoveNamedGroup
+// ====================================
+
+func TestMsgRemoveNamedGroup_ValidateBasic(t *testing.T) {
+	// Valid input
+	msg := types.NewMsgRemoveNamedGroup("fast", validSender)
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// invalid authority
+	msg = types.NewMsgRemoveNamedGroup("fast", "invalid")
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+}
@@ -162,6 +162,9 @@ type QueryMembersRequest struct {
 	IsIncomingGroup bool `protobuf:"varint,2,opt,name=is_incoming_group,json=isIncomingGroup,proto3" json:"is_incoming_group,omitempty"`
 	// pagination defines pagination settings for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// group_name is the name of the named group to query members from. If set, is_incoming_group
+	// is ignored.
+	GroupName string `protobuf:"bytes,4,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
 }
 
 func (m *QueryMembersRequest) Reset()         { *m = QueryMembersRequest{} }
@@ -218,6 +221,13 @@ func (m *QueryMembersRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryMembersRequest) GetGroupName() string {
+	if m != nil {
+		return m.GroupName
+	}
+	return ""
+}
+
 // QueryMembersResponse is the response type for the Query/Members RPC method.
 type QueryMembersResponse struct {
 	// members are those individuals who correspond to the provided is_active status.
@@ -325,6 +335,8 @@ type QueryMemberResponse struct {
 	CurrentGroupMember Member `protobuf:"bytes,1,opt,name=current_group_member,json=currentGroupMember,proto3" json:"current_group_member"`
 	// incoming_group_member is the member detail.
 	IncomingGroupMember Member `protobuf:"bytes,2,opt,name=incoming_group_member,json=incomingGroupMember,proto3" json:"incoming_group_member"`
+	// named_group_members is the member detail in each named group that the address belongs to.
+	NamedGroupMembers []Member `protobuf:"bytes,3,rep,name=named_group_members,json=namedGroupMembers,proto3" json:"named_group_members"`
 }
 
 func (m *QueryMemberResponse) Reset()         { *m = QueryMemberResponse{} }
@@ -374,6 +386,13 @@ func (m *QueryMemberResponse) GetIncomingGroupMember() Member {
 	return Member{}
 }
 
+func (m *QueryMemberResponse) GetNamedGroupMembers() []Member {
+	if m != nil {
+		return m.NamedGroupMembers
+	}
+	return nil
+}
+
 // QueryCurrentGroupRequest is the request type for the Query/CurrentGroup RPC method.
 type QueryCurrentGroupRequest struct {
 }
@@ -677,6 +696,8 @@ type QuerySigningResponse struct {
 	CurrentGroupSigningResult *types.SigningResult `protobuf:"bytes,3,opt,name=current_group_signing_result,json=currentGroupSigningResult,proto3" json:"current_group_signing_result,omitempty"`
 	// incoming_group_signing_result is the signing result from the incoming group.
 	IncomingGroupSigningResult *types.SigningResult `protobuf:"bytes,4,opt,name=incoming_group_signing_result,json=incomingGroupSigningResult,proto3" json:"incoming_group_signing_result,omitempty"`
+	// group_name is the name of the named group that signs the bandtss signing, if any.
+	GroupName string `protobuf:"bytes,5,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
 }
 
 func (m *QuerySigningResponse) Reset()         { *m = QuerySigningResponse{} }
@@ -740,6 +761,13 @@ func (m *QuerySigningResponse) GetIncomingGroupSigningResult() *types.SigningRes
 	return nil
 }
 
+func (m *QuerySigningResponse) GetGroupName() string {
+	if m != nil {
+		return m.GroupName
+	}
+	return ""
+}
+
 // QueryGroupTransitionRequest is the request type for the Query/GroupTransition RPC method.
 type QueryGroupTransitionRequest struct {
 }
@@ -823,6 +851,208 @@ func (m *QueryGroupTransitionResponse) GetGroupTransition() *GroupTransition {
 	return nil
 }
 
+// QueryNamedGroupRequest is the request type for the Query/NamedGroup RPC method.
+type QueryNamedGroupRequest struct {
+	// name is the name of the named group.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryNamedGroupRequest) Reset()         { *m = QueryNamedGroupRequest{} }
+func (m *QueryNamedGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNamedGroupRequest) ProtoMessage()    {}
+func (*QueryNamedGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d619290a87c09054, []int{14}
+}
+func (m *QueryNamedGroupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamedGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamedGroupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamedGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamedGroupRequest.Merge(m, src)
+}
+func (m *QueryNamedGroupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamedGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamedGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamedGroupRequest proto.InternalMessageInfo
+
+func (m *QueryNamedGroupRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// QueryNamedGroupResponse is the response type for the Query/NamedGroup RPC method.
+type QueryNamedGroupResponse struct {
+	// named_group is the named group information.
+	NamedGroup NamedGroup `protobuf:"bytes,1,opt,name=named_group,json=namedGroup,proto3" json:"named_group"`
+	// size is the number of members in the group.
+	Size_ uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// threshold is the minimum number of members needed to generate a valid signature.
+	Threshold uint64 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// pub_key is the public key generated by the group.
+	PubKey github_com_bandprotocol_chain_v3_pkg_tss.Point `protobuf:"bytes,4,opt,name=pub_key,json=pubKey,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.Point" json:"pub_key,omitempty"`
+}
+
+func (m *QueryNamedGroupResponse) Reset()         { *m = QueryNamedGroupResponse{} }
+func (m *QueryNamedGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNamedGroupResponse) ProtoMessage()    {}
+func (*QueryNamedGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d619290a87c09054, []int{15}
+}
+func (m *QueryNamedGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamedGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamedGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamedGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamedGroupResponse.Merge(m, src)
+}
+func (m *QueryNamedGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamedGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamedGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamedGroupResponse proto.InternalMessageInfo
+
+func (m *QueryNamedGroupResponse) GetNamedGroup() NamedGroup {
+	if m != nil {
+		return m.NamedGroup
+	}
+	return NamedGroup{}
+}
+
+func (m *QueryNamedGroupResponse) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *QueryNamedGroupResponse) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *QueryNamedGroupResponse) GetPubKey() github_com_bandprotocol_chain_v3_pkg_tss.Point {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+// QueryNamedGroupsRequest is the request type for the Query/NamedGroups RPC method.
+type QueryNamedGroupsRequest struct {
+}
+
+func (m *QueryNamedGroupsRequest) Reset()         { *m = QueryNamedGroupsRequest{} }
+func (m *QueryNamedGroupsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNamedGroupsRequest) ProtoMessage()    {}
+func (*QueryNamedGroupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d619290a87c09054, []int{16}
+}
+func (m *QueryNamedGroupsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamedGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamedGroupsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamedGroupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamedGroupsRequest.Merge(m, src)
+}
+func (m *QueryNamedGroupsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamedGroupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamedGroupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamedGroupsRequest proto.InternalMessageInfo
+
+// QueryNamedGroupsResponse is the response type for the Query/NamedGroups RPC method.
+type QueryNamedGroupsResponse struct {
+	// named_groups is the list of named groups.
+	NamedGroups []NamedGroup `protobuf:"bytes,1,rep,name=named_groups,json=namedGroups,proto3" json:"named_groups"`
+}
+
+func (m *QueryNamedGroupsResponse) Reset()         { *m = QueryNamedGroupsResponse{} }
+func (m *QueryNamedGroupsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNamedGroupsResponse) ProtoMessage()    {}
+func (*QueryNamedGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d619290a87c09054, []int{17}
+}
+func (m *QueryNamedGroupsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamedGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamedGroupsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamedGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamedGroupsResponse.Merge(m, src)
+}
+func (m *QueryNamedGroupsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamedGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamedGroupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamedGroupsResponse proto.InternalMessageInfo
+
+func (m *QueryNamedGroupsResponse) GetNamedGroups() []NamedGroup {
+	if m != nil {
+		return m.NamedGroups
+	}
+	return nil
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -831,7 +1061,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d619290a87c09054, []int{14}
+	return fileDescriptor_d619290a87c09054, []int{18}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -870,7 +1100,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d619290a87c09054, []int{15}
+	return fileDescriptor_d619290a87c09054, []int{19}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -922,6 +1152,10 @@ func init() {
 	proto.RegisterType((*QuerySigningResponse)(nil), "band.bandtss.v1beta1.QuerySigningResponse")
 	proto.RegisterType((*QueryGroupTransitionRequest)(nil), "band.bandtss.v1beta1.QueryGroupTransitionRequest")
 	proto.RegisterType((*QueryGroupTransitionResponse)(nil), "band.bandtss.v1beta1.QueryGroupTransitionResponse")
+	proto.RegisterType((*QueryNamedGroupRequest)(nil), "band.bandtss.v1beta1.QueryNamedGroupRequest")
+	proto.RegisterType((*QueryNamedGroupResponse)(nil), "band.bandtss.v1beta1.QueryNamedGroupResponse")
+	proto.RegisterType((*QueryNamedGroupsRequest)(nil), "band.bandtss.v1beta1.QueryNamedGroupsRequest")
+	proto.RegisterType((*QueryNamedGroupsResponse)(nil), "band.bandtss.v1beta1.QueryNamedGroupsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "band.bandtss.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "band.bandtss.v1beta1.QueryParamsResponse")
 }
@@ -929,87 +1163,97 @@ func init() {
 func init() { proto.RegisterFile("band/bandtss/v1beta1/query.proto", fileDescriptor_d619290a87c09054) }
 
 var fileDescriptor_d619290a87c09054 = []byte{
-	// 1268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xb1, 0x6f, 0xdb, 0x56,
-	0x13, 0x17, 0x15, 0x45, 0x8a, 0x9f, 0x9d, 0xd8, 0xdf, 0x8b, 0x3f, 0x54, 0xa6, 0x6d, 0x51, 0x66,
-	0x52, 0x47, 0x31, 0x50, 0xd2, 0x56, 0xda, 0x0e, 0x59, 0xda, 0xc8, 0x91, 0x03, 0x35, 0x75, 0xa0,
-	0x50, 0x4a, 0x86, 0x2c, 0x2a, 0x25, 0x3d, 0xd3, 0x84, 0x25, 0x92, 0xe6, 0x23, 0x8d, 0xba, 0x41,
-	0x3a, 0x04, 0x1d, 0x9a, 0xcd, 0x40, 0x87, 0x0c, 0x1d, 0xb3, 0x75, 0xe8, 0x3f, 0xd1, 0x25, 0x40,
-	0x97, 0x00, 0x5d, 0x3a, 0x39, 0x85, 0xdd, 0xb5, 0x5b, 0xa7, 0x4c, 0x05, 0xdf, 0x3b, 0xca, 0xa2,
-	0xc4, 0xd0, 0xea, 0xde, 0xc5, 0x96, 0xde, 0xdd, 0xbd, 0xfb, 0xdd, 0xdd, 0xbb, 0xdf, 0x9d, 0x50,
-	0xb1, 0xad, 0x5b, 0x5d, 0x35, 0xf8, 0xe3, 0x51, 0xaa, 0x1e, 0x6c, 0xb4, 0x89, 0xa7, 0x6f, 0xa8,
-	0xfb, 0x3e, 0x71, 0x0f, 0x15, 0xc7, 0xb5, 0x3d, 0x1b, 0xcf, 0x07, 0x42, 0x05, 0x34, 0x14, 0xd0,
-	0x10, 0xe7, 0x0d, 0xdb, 0xb0, 0x99, 0x82, 0x1a, 0x7c, 0xe2, 0xba, 0xa2, 0x64, 0xd8, 0xb6, 0xd1,
-	0x23, 0x2a, 0xfb, 0xd6, 0xf6, 0x77, 0x54, 0xcf, 0xec, 0x13, 0xea, 0xe9, 0x7d, 0x07, 0x14, 0xd6,
-	0x3a, 0x36, 0xed, 0xdb, 0x54, 0x6d, 0xeb, 0x94, 0x70, 0x2f, 0x03, 0x9f, 0x8e, 0x6e, 0x98, 0x96,
-	0xee, 0x99, 0xb6, 0x05, 0xba, 0x85, 0x61, 0xdd, 0x50, 0xab, 0x63, 0x9b, 0xa1, 0x7c, 0x09, 0x9c,
-	0xe9, 0x8e, 0xa9, 0xea, 0x96, 0x65, 0x7b, 0xcc, 0x98, 0x82, 0x54, 0x64, 0x81, 0x0d, 0x07, 0x15,
-	0xc0, 0xe7, 0x32, 0x39, 0x36, 0xe8, 0x30, 0xc4, 0x24, 0x1d, 0x83, 0x58, 0x84, 0x9a, 0xa0, 0x23,
-	0xcf, 0x23, 0xfc, 0x30, 0x88, 0x61, 0xd3, 0xf6, 0x2d, 0x8f, 0x6a, 0x64, 0xdf, 0x27, 0xd4, 0x93,
-	0x6f, 0xa3, 0xab, 0x91, 0x53, 0xea, 0xd8, 0x16, 0x25, 0xf8, 0x1a, 0xba, 0x4c, 0x4d, 0xc3, 0x32,
-	0x2d, 0xa3, 0xd5, 0x09, 0x24, 0x79, 0xa1, 0x28, 0x94, 0x32, 0xda, 0x0c, 0x1c, 0x32, 0x6d, 0xf9,
-	0x57, 0x01, 0x8c, 0xb7, 0x49, 0xbf, 0x4d, 0xdc, 0xf0, 0x4e, 0xfc, 0x39, 0xca, 0x52, 0x4f, 0xf7,
-	0x7c, 0xca, 0xac, 0xae, 0x94, 0x4b, 0x4a, 0x5c, 0x55, 0x14, 0x6e, 0xd5, 0x60, 0x9a, 0x5b, 0x66,
-	0xcf, 0x23, 0xae, 0x06, 0x76, 0x78, 0x0d, 0xfd, 0xcf, 0xa4, 0x2d, 0xd3, 0xea, 0xd8, 0xfd, 0x00,
-	0x82, 0xe1, 0xda, 0xbe, 0x93, 0x4f, 0x17, 0x85, 0xd2, 0x25, 0x6d, 0xd6, 0xa4, 0x35, 0x38, 0xbf,
-	0x17, 0x1c, 0xe3, 0x2d, 0x84, 0xce, 0xaa, 0x91, 0xbf, 0x50, 0x14, 0x4a, 0xd3, 0xe5, 0x55, 0x85,
-	0x97, 0x43, 0x09, 0xca, 0xa1, 0xf0, 0x07, 0x12, 0xba, 0xad, 0xeb, 0x06, 0x01, 0xa4, 0xda, 0x90,
-	0xa5, 0xfc, 0x52, 0x40, 0xf3, 0xd1, 0x68, 0x20, 0x17, 0x9f, 0xa2, 0x5c, 0x9f, 0x1f, 0xe5, 0x85,
-	0xe2, 0x85, 0xd2, 0x74, 0x79, 0x29, 0x29, 0x1e, 0x2d, 0x54, 0xc6, 0xf7, 0x22, 0xc0, 0xd2, 0x0c,
-	0xd8, 0x8d, 0x73, 0x81, 0x71, 0xa7, 0x11, 0x64, 0x0a, 0x54, 0x0e, 0x1c, 0x40, 0x96, 0xf3, 0x28,
-	0xa7, 0x77, 0xbb, 0x2e, 0xa1, 0x3c, 0xcd, 0x53, 0x5a, 0xf8, 0x55, 0xfe, 0x25, 0x5a, 0x97, 0x41,
-	0x20, 0x4d, 0x34, 0xdf, 0xf1, 0x5d, 0x97, 0x58, 0x1e, 0xcf, 0x68, 0x8b, 0x23, 0x65, 0xe6, 0xe7,
-	0x44, 0x55, 0xc9, 0xbc, 0x3e, 0x96, 0x52, 0x1a, 0x06, 0x7b, 0x96, 0x79, 0x2e, 0xc1, 0x8f, 0xd1,
-	0xff, 0xa3, 0x85, 0x0a, 0xaf, 0x4d, 0x4f, 0x7c, 0xed, 0x55, 0x73, 0xb8, 0xa2, 0x5c, 0x24, 0x8b,
-	0x28, 0xcf, 0x5f, 0xe6, 0x90, 0xcb, 0xf0, 0xd5, 0xfe, 0x9d, 0x46, 0x0b, 0x31, 0x42, 0x88, 0xf3,
-	0x09, 0xba, 0xc4, 0x81, 0x98, 0x5d, 0xfe, 0x6e, 0x2b, 0x9f, 0x9d, 0x1c, 0x4b, 0x39, 0xa6, 0x54,
-	0xbb, 0xfb, 0xee, 0x58, 0x5a, 0x37, 0x4c, 0x6f, 0xd7, 0x6f, 0x2b, 0x1d, 0xbb, 0xcf, 0x9a, 0x86,
-	0x35, 0x48, 0xc7, 0xee, 0xa9, 0x9d, 0x5d, 0xdd, 0xb4, 0xd4, 0x83, 0x5b, 0xaa, 0xb3, 0x67, 0xb0,
-	0x16, 0x04, 0x1b, 0x2d, 0xc7, 0x2e, 0xac, 0x75, 0x31, 0x46, 0x19, 0x6a, 0x7e, 0x43, 0x58, 0x70,
-	0x19, 0x8d, 0x7d, 0xc6, 0x4b, 0x68, 0xca, 0xdb, 0x75, 0x09, 0xdd, 0xb5, 0x7b, 0x5d, 0xf6, 0x00,
-	0x33, 0xda, 0xd9, 0x01, 0xbe, 0x8f, 0x72, 0x8e, 0xdf, 0x6e, 0xed, 0x91, 0xc3, 0x7c, 0xa6, 0x28,
-	0x94, 0x66, 0x2a, 0xe5, 0x77, 0xc7, 0x92, 0x32, 0x31, 0x82, 0xba, 0x6d, 0x5a, 0x9e, 0x96, 0x75,
-	0xfc, 0xf6, 0x7d, 0x72, 0x88, 0x3f, 0x19, 0xb4, 0xd6, 0x45, 0xd6, 0x5a, 0xcb, 0x3c, 0xbb, 0xc3,
-	0x99, 0x65, 0x90, 0x79, 0x57, 0x0d, 0xfa, 0xa9, 0x8a, 0xa6, 0xf5, 0x8e, 0x67, 0x1e, 0x90, 0x56,
-	0xc0, 0x71, 0xf9, 0x2c, 0xab, 0x8c, 0xa8, 0x70, 0x4e, 0x52, 0x42, 0x02, 0x54, 0x9a, 0x21, 0x01,
-	0x56, 0x2e, 0x05, 0x75, 0x39, 0x7a, 0x2b, 0x09, 0x1a, 0xe2, 0x86, 0x81, 0x48, 0x5e, 0x84, 0xac,
-	0x47, 0x1a, 0x30, 0xac, 0xc9, 0xcf, 0x69, 0x24, 0xc6, 0x49, 0xff, 0x2b, 0x4a, 0x7c, 0x51, 0xe4,
-	0x8f, 0xa1, 0x4b, 0x1b, 0x9c, 0x53, 0xc3, 0xbe, 0x5e, 0x46, 0x28, 0xa4, 0xde, 0x30, 0x55, 0xda,
-	0x14, 0x9c, 0xd4, 0xba, 0xf2, 0x5f, 0x69, 0xa0, 0xa9, 0x81, 0x19, 0x24, 0x78, 0x1f, 0x5d, 0xd9,
-	0x21, 0xa4, 0xe5, 0x10, 0xb7, 0x15, 0x68, 0xb3, 0xbe, 0x0e, 0xd8, 0x6a, 0x21, 0x42, 0x39, 0x21,
-	0xa0, 0x4d, 0xdb, 0xb4, 0x2a, 0xeb, 0x41, 0x95, 0x7f, 0x7a, 0x2b, 0x95, 0x86, 0x02, 0x87, 0x39,
-	0xc6, 0xff, 0x7d, 0x44, 0xbb, 0x7b, 0xaa, 0x77, 0xe8, 0x10, 0xca, 0x0c, 0xa8, 0x36, 0xb3, 0x43,
-	0x48, 0x9d, 0xb8, 0x0d, 0xe6, 0x20, 0xc8, 0xb1, 0xcb, 0x51, 0x43, 0xbb, 0x4f, 0x69, 0x67, 0x07,
-	0xf8, 0x2b, 0xb4, 0x14, 0xa5, 0x9b, 0x30, 0x2c, 0x97, 0x50, 0xbf, 0xe7, 0x01, 0x55, 0x4b, 0xe3,
-	0xc9, 0x3a, 0x8b, 0xcc, 0xef, 0x79, 0xda, 0xc2, 0x30, 0xe7, 0x44, 0x44, 0xb8, 0x8d, 0x96, 0x47,
-	0xa8, 0x67, 0xc4, 0x45, 0x66, 0x32, 0x17, 0x62, 0x84, 0x7f, 0x22, 0x32, 0x79, 0x19, 0x2d, 0xb2,
-	0x74, 0x33, 0x51, 0xd3, 0xd5, 0x2d, 0x6a, 0x06, 0xa4, 0x1c, 0xbe, 0x7a, 0x07, 0x2d, 0xc5, 0x8b,
-	0xa1, 0x2a, 0x75, 0x34, 0xc7, 0x91, 0x79, 0x03, 0x19, 0xf0, 0xed, 0x87, 0xf1, 0xc4, 0x38, 0x7a,
-	0xd1, 0xac, 0x11, 0x3d, 0x18, 0xcc, 0xf1, 0xba, 0xee, 0xea, 0xfd, 0xc1, 0x1c, 0x7f, 0x08, 0x8f,
-	0x29, 0x3c, 0x05, 0xf7, 0xb7, 0x51, 0xd6, 0x61, 0x27, 0xc9, 0x24, 0xcf, 0xad, 0x80, 0x8d, 0xc1,
-	0x62, 0xed, 0x3b, 0x01, 0xe1, 0xf1, 0x19, 0x8d, 0xaf, 0xa3, 0xe2, 0x76, 0x75, 0xbb, 0x52, 0xd5,
-	0x5a, 0x8d, 0xe6, 0x9d, 0xe6, 0xa3, 0x46, 0x6b, 0xab, 0xf6, 0x65, 0xb3, 0xaa, 0xb5, 0x1e, 0x3d,
-	0x68, 0xd4, 0xab, 0x9b, 0xb5, 0xad, 0x5a, 0xf5, 0xee, 0x5c, 0x0a, 0x4b, 0x68, 0x31, 0x56, 0xeb,
-	0xce, 0x66, 0xb3, 0xf6, 0xb8, 0x3a, 0x27, 0xe0, 0x15, 0xb4, 0x1c, 0xab, 0x50, 0x7b, 0x00, 0x2a,
-	0x69, 0x31, 0xf3, 0xfd, 0xab, 0x42, 0xaa, 0xfc, 0x62, 0x0a, 0x5d, 0x64, 0xa1, 0xe1, 0x6f, 0x51,
-	0x96, 0xaf, 0x29, 0xf8, 0x3d, 0x1b, 0xc5, 0xf8, 0x7e, 0x23, 0xde, 0x9c, 0x40, 0x93, 0xe7, 0x4a,
-	0x96, 0x9e, 0xff, 0xf6, 0xe7, 0x0f, 0xe9, 0x05, 0xfc, 0xc1, 0xd8, 0x22, 0xd5, 0xe1, 0x5e, 0x9f,
-	0x0b, 0x28, 0x07, 0xcb, 0x01, 0x4e, 0xba, 0x37, 0xba, 0x0e, 0x89, 0x6b, 0x93, 0xa8, 0x02, 0x86,
-	0x22, 0xc3, 0x20, 0xe2, 0xfc, 0x18, 0x86, 0x70, 0xab, 0x78, 0x21, 0xa0, 0x2c, 0x4c, 0xde, 0xd2,
-	0xb9, 0x17, 0x4f, 0x92, 0x85, 0xe8, 0x92, 0x20, 0xaf, 0x31, 0x04, 0xd7, 0xb1, 0xfc, 0x3e, 0x04,
-	0xea, 0x53, 0xd8, 0x33, 0x9e, 0xe1, 0x97, 0x02, 0x9a, 0x19, 0x9e, 0xc0, 0x58, 0x49, 0xca, 0xf6,
-	0xf8, 0x1c, 0x17, 0xd5, 0x89, 0xf5, 0x01, 0xdd, 0x2a, 0x43, 0x57, 0xc4, 0x85, 0xf1, 0x1a, 0x0d,
-	0x53, 0x0d, 0xfe, 0x51, 0x40, 0x97, 0xa3, 0x6b, 0x62, 0x92, 0xab, 0xb8, 0x79, 0x26, 0xae, 0x4f,
-	0x6e, 0x00, 0xe0, 0x6e, 0x30, 0x70, 0x2b, 0x58, 0x1a, 0x03, 0x17, 0x65, 0x29, 0x7c, 0x24, 0xa0,
-	0x1c, 0xb0, 0x4c, 0xe2, 0x43, 0x8a, 0x4e, 0x86, 0xc4, 0x87, 0x34, 0x32, 0x0d, 0x64, 0x85, 0x61,
-	0x29, 0xe1, 0xd5, 0x31, 0x2c, 0x40, 0x91, 0x54, 0x7d, 0x7a, 0x36, 0x66, 0x9e, 0xe1, 0x57, 0x02,
-	0x9a, 0x1d, 0xa1, 0x1e, 0xbc, 0x91, 0xe0, 0x2f, 0x9e, 0x0e, 0xc5, 0xf2, 0xbf, 0x31, 0x01, 0xa8,
-	0x37, 0x19, 0xd4, 0x6b, 0x78, 0x65, 0xfc, 0x07, 0xcc, 0x08, 0x73, 0x06, 0x0c, 0xc0, 0xa9, 0x2a,
-	0xf1, 0xed, 0x47, 0x98, 0x31, 0xf1, 0xed, 0x47, 0xd9, 0x32, 0x81, 0x01, 0x38, 0x25, 0x56, 0xbe,
-	0x78, 0x7d, 0x52, 0x10, 0xde, 0x9c, 0x14, 0x84, 0x3f, 0x4e, 0x0a, 0xc2, 0xd1, 0x69, 0x21, 0xf5,
-	0xe6, 0xb4, 0x90, 0xfa, 0xfd, 0xb4, 0x90, 0x7a, 0x72, 0xfe, 0xf6, 0xf2, 0xf5, 0xe0, 0x52, 0x36,
-	0x50, 0xdb, 0x59, 0xa6, 0x72, 0xeb, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x08, 0x2c, 0x11, 0xcf,
-	0xd5, 0x0e, 0x00, 0x00,
+	// 1440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xbf, 0x73, 0x13, 0x47,
+	0x14, 0xf6, 0xc9, 0xc2, 0xc2, 0x4f, 0xe6, 0xd7, 0xda, 0x09, 0xf2, 0x61, 0xfd, 0xe0, 0x00, 0x23,
+	0x7b, 0xe0, 0x0e, 0x4c, 0x92, 0x82, 0x26, 0x41, 0x46, 0x66, 0x14, 0x82, 0xc7, 0x9c, 0x05, 0x05,
+	0x8d, 0x72, 0x92, 0x96, 0xf3, 0x0d, 0xd6, 0xdd, 0x71, 0x7b, 0x62, 0xe2, 0x30, 0xa4, 0x60, 0x52,
+	0x24, 0x33, 0x29, 0x98, 0xa1, 0xa0, 0x48, 0x49, 0x97, 0x22, 0x7d, 0xfe, 0x03, 0x4a, 0x66, 0xd2,
+	0xa4, 0x32, 0x89, 0x49, 0x95, 0x3a, 0x15, 0x55, 0xe6, 0x76, 0xdf, 0x49, 0x77, 0x3e, 0x21, 0x5f,
+	0xaa, 0x34, 0x69, 0x6c, 0x69, 0xf7, 0x7b, 0xfb, 0xbe, 0xb7, 0x6f, 0xdf, 0xf7, 0x9e, 0xa0, 0xd2,
+	0x36, 0xec, 0xae, 0x16, 0xfc, 0xf1, 0x19, 0xd3, 0x1e, 0x5d, 0x6e, 0x53, 0xdf, 0xb8, 0xac, 0x3d,
+	0xec, 0x53, 0x6f, 0x47, 0x75, 0x3d, 0xc7, 0x77, 0xc8, 0x5c, 0xb0, 0xa9, 0x22, 0x42, 0x45, 0x84,
+	0x3c, 0x67, 0x3a, 0xa6, 0xc3, 0x01, 0x5a, 0xf0, 0x49, 0x60, 0xe5, 0xb2, 0xe9, 0x38, 0xe6, 0x36,
+	0xd5, 0xf8, 0xb7, 0x76, 0xff, 0xbe, 0xe6, 0x5b, 0x3d, 0xca, 0x7c, 0xa3, 0xe7, 0x22, 0x60, 0xb9,
+	0xe3, 0xb0, 0x9e, 0xc3, 0xb4, 0xb6, 0xc1, 0xa8, 0xf0, 0x32, 0xf0, 0xe9, 0x1a, 0xa6, 0x65, 0x1b,
+	0xbe, 0xe5, 0xd8, 0x88, 0x2d, 0x45, 0xb1, 0x21, 0xaa, 0xe3, 0x58, 0xe1, 0xfe, 0x02, 0x3a, 0x33,
+	0x5c, 0x4b, 0x33, 0x6c, 0xdb, 0xf1, 0xb9, 0x31, 0xc3, 0x5d, 0x99, 0x07, 0x16, 0x0d, 0xca, 0x67,
+	0xe1, 0x9e, 0x32, 0x32, 0xe8, 0x30, 0xc4, 0x71, 0x18, 0x93, 0xda, 0x94, 0x59, 0x88, 0x51, 0xe6,
+	0x80, 0xdc, 0x0e, 0x62, 0x58, 0x75, 0xfa, 0xb6, 0xcf, 0x74, 0xfa, 0xb0, 0x4f, 0x99, 0xaf, 0x5c,
+	0x85, 0xd9, 0xd8, 0x2a, 0x73, 0x1d, 0x9b, 0x51, 0x72, 0x06, 0x8e, 0x30, 0xcb, 0xb4, 0x2d, 0xdb,
+	0x6c, 0x75, 0x82, 0x9d, 0x82, 0x54, 0x91, 0xaa, 0x59, 0x7d, 0x06, 0x17, 0x39, 0x5a, 0xf9, 0x4b,
+	0x42, 0xe3, 0x5b, 0xb4, 0xd7, 0xa6, 0x5e, 0x78, 0x26, 0xf9, 0x0c, 0xa6, 0x98, 0x6f, 0xf8, 0x7d,
+	0xc6, 0xad, 0x8e, 0xae, 0x54, 0xd5, 0x51, 0x59, 0x51, 0x85, 0xd5, 0x26, 0x47, 0xae, 0x59, 0xdb,
+	0x3e, 0xf5, 0x74, 0xb4, 0x23, 0xcb, 0x70, 0xc2, 0x62, 0x2d, 0xcb, 0xee, 0x38, 0xbd, 0x80, 0x82,
+	0xe9, 0x39, 0x7d, 0xb7, 0x90, 0xa9, 0x48, 0xd5, 0xc3, 0xfa, 0x31, 0x8b, 0x35, 0x70, 0xfd, 0x46,
+	0xb0, 0x4c, 0xd6, 0x00, 0x86, 0xd9, 0x28, 0x4c, 0x56, 0xa4, 0x6a, 0x7e, 0x65, 0x51, 0x15, 0xe9,
+	0x50, 0x83, 0x74, 0xa8, 0xe2, 0x81, 0x84, 0x6e, 0x37, 0x0c, 0x93, 0x22, 0x53, 0x3d, 0x62, 0x49,
+	0x8a, 0x00, 0xdc, 0x4f, 0xcb, 0x36, 0x7a, 0xb4, 0x90, 0xad, 0x48, 0xd5, 0x69, 0x7d, 0x9a, 0xaf,
+	0xac, 0x1b, 0x3d, 0xaa, 0xbc, 0x90, 0x60, 0x2e, 0x1e, 0x2c, 0x5e, 0xd5, 0x27, 0x90, 0xeb, 0x89,
+	0xa5, 0x82, 0x54, 0x99, 0xac, 0xe6, 0x57, 0x16, 0xc6, 0x85, 0xab, 0x87, 0x60, 0x72, 0x23, 0xc6,
+	0x3b, 0xc3, 0x79, 0x9f, 0x3f, 0x90, 0xb7, 0x70, 0x1a, 0x25, 0xae, 0xa8, 0x98, 0x58, 0x74, 0x80,
+	0x49, 0x28, 0x40, 0xce, 0xe8, 0x76, 0x3d, 0xca, 0x44, 0x16, 0xa6, 0xf5, 0xf0, 0xab, 0xf2, 0x22,
+	0x13, 0x4b, 0xdb, 0x20, 0x90, 0x26, 0xcc, 0x75, 0xfa, 0x9e, 0x47, 0x6d, 0x5f, 0x5c, 0x78, 0x4b,
+	0x30, 0xe5, 0xe6, 0x07, 0x44, 0x55, 0xcb, 0xbe, 0xda, 0x2d, 0x4f, 0xe8, 0x04, 0xed, 0x79, 0x62,
+	0xc4, 0x0e, 0xb9, 0x0b, 0x1f, 0xc4, 0xf3, 0x18, 0x1e, 0x9b, 0x49, 0x7d, 0xec, 0xac, 0x15, 0x4d,
+	0x38, 0x9e, 0xab, 0xc3, 0x6c, 0x90, 0xa8, 0x6e, 0xec, 0x50, 0x56, 0x98, 0xac, 0x4c, 0xa6, 0x3c,
+	0xf5, 0x04, 0x37, 0x8f, 0x1c, 0xc9, 0x14, 0x19, 0x0a, 0xa2, 0x18, 0x22, 0x61, 0x84, 0x85, 0xf2,
+	0x77, 0x06, 0xe6, 0x47, 0x6c, 0xe2, 0xdd, 0xdd, 0x83, 0xc3, 0x82, 0x87, 0xd5, 0x15, 0xa5, 0x52,
+	0xfb, 0x74, 0x6f, 0xb7, 0x9c, 0xe3, 0xa0, 0xc6, 0xf5, 0x77, 0xbb, 0xe5, 0x4b, 0xa6, 0xe5, 0x6f,
+	0xf5, 0xdb, 0x6a, 0xc7, 0xe9, 0xf1, 0x3a, 0xe5, 0x35, 0xd9, 0x71, 0xb6, 0xb5, 0xce, 0x96, 0x61,
+	0xd9, 0xda, 0xa3, 0x2b, 0x9a, 0xfb, 0xc0, 0xe4, 0x55, 0x8f, 0x36, 0x7a, 0x8e, 0x1f, 0xd8, 0xe8,
+	0x12, 0x02, 0x59, 0x66, 0x7d, 0x4d, 0xf9, 0x85, 0x65, 0x75, 0xfe, 0x99, 0x2c, 0xc0, 0xb4, 0xbf,
+	0xe5, 0x51, 0xb6, 0xe5, 0x6c, 0x77, 0xf9, 0x9b, 0xcf, 0xea, 0xc3, 0x05, 0x72, 0x13, 0x72, 0x6e,
+	0xbf, 0xdd, 0x7a, 0x40, 0x77, 0xf8, 0x3b, 0x9e, 0xa9, 0xad, 0xbc, 0xdb, 0x2d, 0xab, 0xa9, 0x19,
+	0x6c, 0x38, 0x96, 0xed, 0xeb, 0x53, 0x6e, 0xbf, 0x7d, 0x93, 0xee, 0x90, 0x8f, 0x07, 0xd5, 0x7c,
+	0x88, 0x57, 0x73, 0x51, 0xdc, 0x6d, 0xf4, 0x5e, 0x39, 0x65, 0x51, 0xc8, 0x83, 0x12, 0xae, 0x43,
+	0xde, 0xe8, 0xf8, 0xd6, 0x23, 0xda, 0x0a, 0x64, 0xb5, 0x30, 0xc5, 0xb3, 0x2d, 0xab, 0x42, 0x06,
+	0xd5, 0x50, 0x73, 0xd5, 0x66, 0xa8, 0xb9, 0xb5, 0xc3, 0x41, 0x56, 0x9e, 0xbd, 0x29, 0x4b, 0x3a,
+	0x08, 0xc3, 0x60, 0x4b, 0x39, 0x85, 0xb7, 0x1e, 0xab, 0xf9, 0x30, 0x27, 0x3f, 0x67, 0x40, 0x1e,
+	0xb5, 0xfb, 0x7f, 0x52, 0x46, 0x27, 0x45, 0xf9, 0x08, 0x2b, 0x7f, 0x53, 0xc8, 0x78, 0xa8, 0x15,
+	0x45, 0x80, 0x50, 0xed, 0xc3, 0xab, 0xd2, 0xa7, 0x71, 0xa5, 0xd1, 0x55, 0x7e, 0x98, 0x84, 0xb9,
+	0xb8, 0x19, 0x5e, 0xf0, 0x43, 0x38, 0x7a, 0x9f, 0xd2, 0x96, 0x4b, 0xbd, 0x56, 0x80, 0xe6, 0x5a,
+	0x11, 0x94, 0xdf, 0x7c, 0x4c, 0xc6, 0x42, 0x42, 0xab, 0x8e, 0x65, 0xd7, 0x2e, 0x05, 0x59, 0xfe,
+	0xe9, 0x4d, 0xb9, 0x1a, 0x09, 0x1c, 0x5b, 0xa7, 0xf8, 0x77, 0x91, 0x75, 0x1f, 0x68, 0xfe, 0x8e,
+	0x4b, 0x19, 0x37, 0x60, 0xfa, 0xcc, 0x7d, 0x4a, 0x37, 0xa8, 0xb7, 0xc9, 0x1d, 0x04, 0x77, 0xec,
+	0x09, 0xd6, 0x28, 0x21, 0xd3, 0xfa, 0x70, 0x81, 0x7c, 0x09, 0x0b, 0x71, 0x09, 0x0b, 0xc3, 0xf2,
+	0x28, 0xeb, 0x6f, 0xfb, 0xd8, 0x1d, 0xca, 0xc9, 0xcb, 0x1a, 0x46, 0xd6, 0xdf, 0xf6, 0xf5, 0xf9,
+	0xa8, 0x8e, 0xc5, 0xb6, 0x48, 0x1b, 0x8a, 0xfb, 0xe4, 0x6c, 0x9f, 0x8b, 0x6c, 0x3a, 0x17, 0x72,
+	0x4c, 0xd3, 0xe2, 0x3e, 0xe2, 0x9d, 0xe8, 0xd0, 0xfe, 0x4e, 0x54, 0x84, 0x53, 0x3c, 0x1b, 0xdc,
+	0xb2, 0xe9, 0x19, 0x36, 0xb3, 0x82, 0x3e, 0x10, 0x16, 0x85, 0x0b, 0x0b, 0xa3, 0xb7, 0x31, 0x69,
+	0x1b, 0x70, 0x5c, 0x9c, 0xee, 0x0f, 0xf6, 0x50, 0xe2, 0xcf, 0x8d, 0x56, 0xcd, 0xfd, 0x07, 0x1d,
+	0x33, 0xe3, 0x0b, 0xca, 0x05, 0xf8, 0x90, 0x7b, 0x5c, 0x1f, 0x08, 0x6a, 0xf8, 0xb0, 0x08, 0x64,
+	0x79, 0x0c, 0xa2, 0x03, 0xf1, 0xcf, 0xca, 0x1f, 0x12, 0x9c, 0x4c, 0xc0, 0x91, 0xdb, 0x0d, 0xc8,
+	0x47, 0x44, 0x1d, 0x69, 0x55, 0x46, 0xd3, 0x1a, 0x9a, 0xa3, 0xa0, 0xc3, 0x50, 0xd0, 0xff, 0xe3,
+	0xf2, 0x54, 0xe6, 0x13, 0x21, 0x0e, 0x06, 0x2e, 0x0a, 0x85, 0xe4, 0x16, 0x86, 0xdf, 0x80, 0x99,
+	0x48, 0xf8, 0xe1, 0x3c, 0x91, 0x36, 0xfe, 0xfc, 0x30, 0x7e, 0x36, 0x98, 0xf6, 0x36, 0x0c, 0xcf,
+	0xe8, 0x0d, 0x9c, 0xdf, 0x86, 0xd9, 0xd8, 0x2a, 0xfa, 0xbd, 0x0a, 0x53, 0x2e, 0x5f, 0x19, 0xdf,
+	0xeb, 0x85, 0x15, 0x7a, 0x43, 0x8b, 0xe5, 0x6f, 0x25, 0x20, 0xc9, 0x49, 0x8e, 0x9c, 0x85, 0xca,
+	0xad, 0xfa, 0xad, 0x5a, 0x5d, 0x6f, 0x6d, 0x36, 0xaf, 0x35, 0xef, 0x6c, 0xb6, 0xd6, 0x1a, 0x5f,
+	0x34, 0xeb, 0x7a, 0xeb, 0xce, 0xfa, 0xe6, 0x46, 0x7d, 0xb5, 0xb1, 0xd6, 0xa8, 0x5f, 0x3f, 0x3e,
+	0x41, 0xca, 0x70, 0x6a, 0x24, 0xea, 0xda, 0x6a, 0xb3, 0x71, 0xb7, 0x7e, 0x5c, 0x22, 0xa7, 0xa1,
+	0x38, 0x12, 0xd0, 0x58, 0x47, 0x48, 0x46, 0xce, 0x7e, 0xf7, 0xb2, 0x34, 0xb1, 0xf2, 0x4b, 0x1e,
+	0x0e, 0xf1, 0xd0, 0xc8, 0x37, 0x30, 0x25, 0x86, 0x59, 0xf2, 0x9e, 0xb9, 0x33, 0x39, 0x05, 0xcb,
+	0x4b, 0x29, 0x90, 0xe2, 0xae, 0x94, 0xf2, 0xd3, 0x5f, 0xff, 0x7c, 0x9e, 0x99, 0x27, 0x27, 0x13,
+	0xe3, 0x76, 0x47, 0x78, 0x7d, 0x2a, 0x41, 0x0e, 0x07, 0x0a, 0x32, 0xee, 0xdc, 0xf8, 0xd0, 0x2c,
+	0x2f, 0xa7, 0x81, 0x22, 0x87, 0x0a, 0xe7, 0x20, 0x93, 0x42, 0x82, 0x43, 0x38, 0x5c, 0x7e, 0x2f,
+	0xc1, 0x94, 0xb0, 0x1a, 0x7b, 0x0b, 0xb1, 0x91, 0x51, 0x5e, 0x4a, 0x81, 0x44, 0x06, 0xcb, 0x9c,
+	0xc1, 0x59, 0xa2, 0xbc, 0x8f, 0x81, 0xf6, 0x18, 0xc7, 0xcd, 0x27, 0xe4, 0x85, 0x04, 0x33, 0xd1,
+	0xa1, 0x89, 0xa8, 0xe3, 0x6e, 0x3b, 0x39, 0x7a, 0xc9, 0x5a, 0x6a, 0x3c, 0xb2, 0x5b, 0xe4, 0xec,
+	0x2a, 0xa4, 0x94, 0xcc, 0x51, 0xb4, 0x3b, 0x90, 0x1f, 0x25, 0x38, 0x12, 0xff, 0x31, 0x31, 0xce,
+	0xd5, 0xa8, 0x11, 0x44, 0xbe, 0x94, 0xde, 0x00, 0xc9, 0x9d, 0xe7, 0xe4, 0x4e, 0x93, 0x72, 0x82,
+	0x5c, 0xbc, 0xb1, 0x90, 0x67, 0x12, 0xe4, 0xb0, 0x31, 0x8c, 0x7d, 0x48, 0xf1, 0x66, 0x2e, 0x2f,
+	0xa7, 0x81, 0x22, 0x17, 0x95, 0x73, 0xa9, 0x92, 0xc5, 0x04, 0x17, 0xec, 0x6a, 0x4c, 0x7b, 0x3c,
+	0x9c, 0x0c, 0x9e, 0x90, 0x97, 0x12, 0x1c, 0xdb, 0xd7, 0x0e, 0xc8, 0xe5, 0x31, 0xfe, 0x46, 0xb7,
+	0x28, 0x79, 0xe5, 0xdf, 0x98, 0x20, 0xd5, 0x25, 0x4e, 0xf5, 0x0c, 0x39, 0x9d, 0xfc, 0x99, 0xbb,
+	0xaf, 0x9b, 0x05, 0x0f, 0x0e, 0x86, 0xea, 0x48, 0x2e, 0x8c, 0xf1, 0x96, 0x68, 0x59, 0xf2, 0xc5,
+	0x94, 0x68, 0xa4, 0x75, 0x81, 0xd3, 0x5a, 0x24, 0x67, 0x13, 0xb4, 0xa2, 0x4a, 0xae, 0x3d, 0x0e,
+	0xbe, 0x3d, 0x21, 0xcf, 0x25, 0xc8, 0x0f, 0x0f, 0x61, 0x24, 0x9d, 0xb3, 0x81, 0x46, 0xa8, 0x69,
+	0xe1, 0x48, 0xee, 0x1c, 0x27, 0x57, 0x26, 0xc5, 0xb1, 0xe4, 0x02, 0xc5, 0x14, 0xd2, 0x3e, 0x56,
+	0x2b, 0x62, 0x9d, 0x44, 0x5e, 0x4a, 0x81, 0x3c, 0x50, 0x31, 0x45, 0x0b, 0xa9, 0x7d, 0xfe, 0x6a,
+	0xaf, 0x24, 0xbd, 0xde, 0x2b, 0x49, 0xbf, 0xef, 0x95, 0xa4, 0x67, 0x6f, 0x4b, 0x13, 0xaf, 0xdf,
+	0x96, 0x26, 0x7e, 0x7b, 0x5b, 0x9a, 0xb8, 0x77, 0xf0, 0x80, 0xfe, 0xd5, 0xe0, 0x50, 0x3e, 0x33,
+	0xb6, 0xa7, 0x38, 0xe4, 0xca, 0x3f, 0x03, 0x00, 0xd0, 0xc6, 0xce, 0x0b, 0x2b, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1038,6 +1282,10 @@ type QueryClient interface {
 	Signing(ctx context.Context, in *QuerySigningRequest, opts ...grpc.CallOption) (*QuerySigningResponse, error)
 	// GroupTransition queries the group transition information.
 	GroupTransition(ctx context.Context, in *QueryGroupTransitionRequest, opts ...grpc.CallOption) (*QueryGroupTransitionResponse, error)
+	// NamedGroup queries a named group by its name.
+	NamedGroup(ctx context.Context, in *QueryNamedGroupRequest, opts ...grpc.CallOption) (*QueryNamedGroupResponse, error)
+	// NamedGroups queries all named groups.
+	NamedGroups(ctx context.Context, in *QueryNamedGroupsRequest, opts ...grpc.CallOption) (*QueryNamedGroupsResponse, error)
 	// Params queries parameters of bandtss module
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -1113,6 +1361,24 @@ func (c *queryClient) GroupTransition(ctx context.Context, in *QueryGroupTransit
 	return out, nil
 }
 
+func (c *queryClient) NamedGroup(ctx context.Context, in *QueryNamedGroupRequest, opts ...grpc.CallOption) (*QueryNamedGroupResponse, error) {
+	out := new(QueryNamedGroupResponse)
+	err := c.cc.Invoke(ctx, "/band.bandtss.v1beta1.Query/NamedGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NamedGroups(ctx context.Context, in *QueryNamedGroupsRequest, opts ...grpc.CallOption) (*QueryNamedGroupsResponse, error) {
+	out := new(QueryNamedGroupsResponse)
+	err := c.cc.Invoke(ctx, "/band.bandtss.v1beta1.Query/NamedGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/band.bandtss.v1beta1.Query/Params", in, out, opts...)
@@ -1138,6 +1404,10 @@ type QueryServer interface {
 	Signing(context.Context, *QuerySigningRequest) (*QuerySigningResponse, error)
 	// GroupTransition queries the group transition information.
 	GroupTransition(context.Context, *QueryGroupTransitionRequest) (*QueryGroupTransitionResponse, error)
+	// NamedGroup queries a named group by its name.
+	NamedGroup(context.Context, *QueryNamedGroupRequest) (*QueryNamedGroupResponse, error)
+	// NamedGroups queries all named groups.
+	NamedGroups(context.Context, *QueryNamedGroupsRequest) (*QueryNamedGroupsResponse, error)
 	// Params queries parameters of bandtss module
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -1167,6 +1437,12 @@ func (*UnimplementedQueryServer) Signing(ctx context.Context, req *QuerySigningR
 func (*UnimplementedQueryServer) GroupTransition(ctx context.Context, req *QueryGroupTransitionRequest) (*QueryGroupTransitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GroupTransition not implemented")
 }
+func (*UnimplementedQueryServer) NamedGroup(ctx context.Context, req *QueryNamedGroupRequest) (*QueryNamedGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamedGroup not implemented")
+}
+func (*UnimplementedQueryServer) NamedGroups(ctx context.Context, req *QueryNamedGroupsRequest) (*QueryNamedGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamedGroups not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -1301,6 +1577,42 @@ func _Query_GroupTransition_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NamedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamedGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamedGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.bandtss.v1beta1.Query/NamedGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamedGroup(ctx, req.(*QueryNamedGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NamedGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamedGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamedGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.bandtss.v1beta1.Query/NamedGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamedGroups(ctx, req.(*QueryNamedGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -1351,6 +1663,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GroupTransition",
 			Handler:    _Query_GroupTransition_Handler,
 		},
+		{
+			MethodName: "NamedGroup",
+			Handler:    _Query_NamedGroup_Handler,
+		},
+		{
+			MethodName: "NamedGroups",
+			Handler:    _Query_NamedGroups_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1431,6 +1751,13 @@ func (m *QueryMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1560,6 +1887,20 @@ func (m *QueryMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NamedGroupMembers) > 0 {
+		for iNdEx := len(m.NamedGroupMembers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NamedGroupMembers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.IncomingGroupMember.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1785,6 +2126,13 @@ func (m *QuerySigningResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.IncomingGroupSigningResult != nil {
 		{
 			size, err := m.IncomingGroupSigningResult.MarshalToSizedBuffer(dAtA[:i])
@@ -1891,7 +2239,7 @@ func (m *QueryGroupTransitionResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryNamedGroupRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1901,20 +2249,27 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryNamedGroupRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryNamedGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryNamedGroupResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1924,18 +2279,35 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryNamedGroupResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryNamedGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.NamedGroup.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1947,27 +2319,143 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryNamedGroupsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryCountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryNamedGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamedGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCountsResponse) Size() (n int) {
+func (m *QueryNamedGroupsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamedGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamedGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NamedGroups) > 0 {
+		for iNdEx := len(m.NamedGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NamedGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCountsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1995,6 +2483,10 @@ func (m *QueryMembersRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2040,6 +2532,12 @@ func (m *QueryMemberResponse) Size() (n int) {
 	n += 1 + l + sovQuery(uint64(l))
 	l = m.IncomingGroupMember.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if len(m.NamedGroupMembers) > 0 {
+		for _, e := range m.NamedGroupMembers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2149,6 +2647,10 @@ func (m *QuerySigningResponse) Size() (n int) {
 		l = m.IncomingGroupSigningResult.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2174,6 +2676,64 @@ func (m *QueryGroupTransitionResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryNamedGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamedGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NamedGroup.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Size_ != 0 {
+		n += 1 + sovQuery(uint64(m.Size_))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovQuery(uint64(m.Threshold))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamedGroupsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNamedGroupsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NamedGroups) > 0 {
+		for _, e := range m.NamedGroups {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2423,6 +2983,38 @@ func (m *QueryMembersRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2741,6 +3333,40 @@ func (m *QueryMemberResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamedGroupMembers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamedGroupMembers = append(m.NamedGroupMembers, Member{})
+			if err := m.NamedGroupMembers[len(m.NamedGroupMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3451,17 +4077,49 @@ func (m *QuerySigningResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -3608,6 +4266,377 @@ func (m *QueryGroupTransitionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryNamedGroupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamedGroupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamedGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamedGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamedGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamedGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamedGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NamedGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamedGroupsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamedGroupsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamedGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamedGroupsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamedGroupsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamedGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamedGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamedGroups = append(m.NamedGroups, NamedGroup{})
+			if err := m.NamedGroups[len(m.NamedGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
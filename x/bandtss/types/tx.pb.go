@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_bandprotocol_chain_v3_pkg_tss "github.com/bandprotocol/chain/v3/pkg/tss"
+	types2 "github.com/bandprotocol/chain/v3/x/tss/types"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -49,6 +50,12 @@ type MsgRequestSignature struct {
 	FeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fee_limit,json=feeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_limit"`
 	// sender is the requester of the signing process.
 	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	// batch indicates whether the content is signed together with other requests over a merkle root
+	// instead of being signed on its own.
+	Batch bool `protobuf:"varint,5,opt,name=batch,proto3" json:"batch,omitempty"`
+	// group_name is the name of the named group that signs the content. If empty, the content is
+	// signed by the current group.
+	GroupName string `protobuf:"bytes,6,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
 }
 
 func (m *MsgRequestSignature) Reset()         { *m = MsgRequestSignature{} }
@@ -315,6 +322,12 @@ type MsgTransitionGroup struct {
 	ExecTime time.Time `protobuf:"bytes,3,opt,name=exec_time,json=execTime,proto3,stdtime" json:"exec_time"`
 	// authority is the address that controls the module (defaults to x/gov unless overwritten).
 	Authority string `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+	// reshare is a flag to reshare the secret of the current group to the members instead of running
+	// a new DKG process, so the group public key stays the same.
+	Reshare bool `protobuf:"varint,5,opt,name=reshare,proto3" json:"reshare,omitempty"`
+	// curve is the elliptic curve of the new group. It is ignored when resharing, as the new group
+	// takes the curve of the current group.
+	Curve types2.Curve `protobuf:"varint,6,opt,name=curve,proto3,enum=band.tss.v1beta1.Curve" json:"curve,omitempty"`
 }
 
 func (m *MsgTransitionGroup) Reset()         { *m = MsgTransitionGroup{} }
@@ -378,6 +391,20 @@ func (m *MsgTransitionGroup) GetAuthority() string {
 	return ""
 }
 
+func (m *MsgTransitionGroup) GetReshare() bool {
+	if m != nil {
+		return m.Reshare
+	}
+	return false
+}
+
+func (m *MsgTransitionGroup) GetCurve() types2.Curve {
+	if m != nil {
+		return m.Curve
+	}
+	return types2.CURVE_SECP256K1
+}
+
 // MsgTransitionGroupResponse is the Msg/TransitionGroup response type.
 type MsgTransitionGroupResponse struct {
 }
@@ -516,6 +543,327 @@ func (m *MsgForceTransitionGroupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgForceTransitionGroupResponse proto.InternalMessageInfo
 
+// MsgCreateNamedGroup is the Msg/CreateNamedGroup request type.
+type MsgCreateNamedGroup struct {
+	// name is the unique name of the group.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// members is a list of members in this group.
+	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	// threshold is a minimum number of members required to produce a signature.
+	Threshold uint64 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// curve is the elliptic curve of the group.
+	Curve types2.Curve `protobuf:"varint,4,opt,name=curve,proto3,enum=band.tss.v1beta1.Curve" json:"curve,omitempty"`
+	// fee_per_signer is the tokens that will be paid per signer for a signing of this group.
+	FeePerSigner github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=fee_per_signer,json=feePerSigner,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_per_signer"`
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,6,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgCreateNamedGroup) Reset()         { *m = MsgCreateNamedGroup{} }
+func (m *MsgCreateNamedGroup) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateNamedGroup) ProtoMessage()    {}
+func (*MsgCreateNamedGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1607716805749e77, []int{10}
+}
+func (m *MsgCreateNamedGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateNamedGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateNamedGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateNamedGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateNamedGroup.Merge(m, src)
+}
+func (m *MsgCreateNamedGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateNamedGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateNamedGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateNamedGroup proto.InternalMessageInfo
+
+func (m *MsgCreateNamedGroup) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgCreateNamedGroup) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *MsgCreateNamedGroup) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *MsgCreateNamedGroup) GetCurve() types2.Curve {
+	if m != nil {
+		return m.Curve
+	}
+	return types2.CURVE_SECP256K1
+}
+
+func (m *MsgCreateNamedGroup) GetFeePerSigner() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeePerSigner
+	}
+	return nil
+}
+
+func (m *MsgCreateNamedGroup) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgCreateNamedGroupResponse is the Msg/CreateNamedGroup response type.
+type MsgCreateNamedGroupResponse struct {
+}
+
+func (m *MsgCreateNamedGroupResponse) Reset()         { *m = MsgCreateNamedGroupResponse{} }
+func (m *MsgCreateNamedGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateNamedGroupResponse) ProtoMessage()    {}
+func (*MsgCreateNamedGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1607716805749e77, []int{11}
+}
+func (m *MsgCreateNamedGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateNamedGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateNamedGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateNamedGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateNamedGroupResponse.Merge(m, src)
+}
+func (m *MsgCreateNamedGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateNamedGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateNamedGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateNamedGroupResponse proto.InternalMessageInfo
+
+// MsgUpdateNamedGroup is the Msg/UpdateNamedGroup request type.
+type MsgUpdateNamedGroup struct {
+	// name is the name of the group to be updated.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// fee_per_signer is the tokens that will be paid per signer for a signing of this group.
+	FeePerSigner github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee_per_signer,json=feePerSigner,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_per_signer"`
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgUpdateNamedGroup) Reset()         { *m = MsgUpdateNamedGroup{} }
+func (m *MsgUpdateNamedGroup) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNamedGroup) ProtoMessage()    {}
+func (*MsgUpdateNamedGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1607716805749e77, []int{12}
+}
+func (m *MsgUpdateNamedGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateNamedGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateNamedGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateNamedGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNamedGroup.Merge(m, src)
+}
+func (m *MsgUpdateNamedGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateNamedGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNamedGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateNamedGroup proto.InternalMessageInfo
+
+func (m *MsgUpdateNamedGroup) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgUpdateNamedGroup) GetFeePerSigner() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeePerSigner
+	}
+	return nil
+}
+
+func (m *MsgUpdateNamedGroup) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgUpdateNamedGroupResponse is the Msg/UpdateNamedGroup response type.
+type MsgUpdateNamedGroupResponse struct {
+}
+
+func (m *MsgUpdateNamedGroupResponse) Reset()         { *m = MsgUpdateNamedGroupResponse{} }
+func (m *MsgUpdateNamedGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNamedGroupResponse) ProtoMessage()    {}
+func (*MsgUpdateNamedGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1607716805749e77, []int{13}
+}
+func (m *MsgUpdateNamedGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateNamedGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateNamedGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateNamedGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNamedGroupResponse.Merge(m, src)
+}
+func (m *MsgUpdateNamedGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateNamedGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNamedGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateNamedGroupResponse proto.InternalMessageInfo
+
+// MsgRemoveNamedGroup is the Msg/RemoveNamedGroup request type.
+type MsgRemoveNamedGroup struct {
+	// name is the name of the group to be removed.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgRemoveNamedGroup) Reset()         { *m = MsgRemoveNamedGroup{} }
+func (m *MsgRemoveNamedGroup) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveNamedGroup) ProtoMessage()    {}
+func (*MsgRemoveNamedGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1607716805749e77, []int{14}
+}
+func (m *MsgRemoveNamedGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveNamedGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveNamedGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveNamedGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveNamedGroup.Merge(m, src)
+}
+func (m *MsgRemoveNamedGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveNamedGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveNamedGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveNamedGroup proto.InternalMessageInfo
+
+func (m *MsgRemoveNamedGroup) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgRemoveNamedGroup) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgRemoveNamedGroupResponse is the Msg/RemoveNamedGroup response type.
+type MsgRemoveNamedGroupResponse struct {
+}
+
+func (m *MsgRemoveNamedGroupResponse) Reset()         { *m = MsgRemoveNamedGroupResponse{} }
+func (m *MsgRemoveNamedGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveNamedGroupResponse) ProtoMessage()    {}
+func (*MsgRemoveNamedGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1607716805749e77, []int{15}
+}
+func (m *MsgRemoveNamedGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveNamedGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveNamedGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveNamedGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveNamedGroupResponse.Merge(m, src)
+}
+func (m *MsgRemoveNamedGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveNamedGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveNamedGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveNamedGroupResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRequestSignature)(nil), "band.bandtss.v1beta1.MsgRequestSignature")
 	proto.RegisterType((*MsgRequestSignatureResponse)(nil), "band.bandtss.v1beta1.MsgRequestSignatureResponse")
@@ -527,68 +875,90 @@ func init() {
 	proto.RegisterType((*MsgTransitionGroupResponse)(nil), "band.bandtss.v1beta1.MsgTransitionGroupResponse")
 	proto.RegisterType((*MsgForceTransitionGroup)(nil), "band.bandtss.v1beta1.MsgForceTransitionGroup")
 	proto.RegisterType((*MsgForceTransitionGroupResponse)(nil), "band.bandtss.v1beta1.MsgForceTransitionGroupResponse")
+	proto.RegisterType((*MsgCreateNamedGroup)(nil), "band.bandtss.v1beta1.MsgCreateNamedGroup")
+	proto.RegisterType((*MsgCreateNamedGroupResponse)(nil), "band.bandtss.v1beta1.MsgCreateNamedGroupResponse")
+	proto.RegisterType((*MsgUpdateNamedGroup)(nil), "band.bandtss.v1beta1.MsgUpdateNamedGroup")
+	proto.RegisterType((*MsgUpdateNamedGroupResponse)(nil), "band.bandtss.v1beta1.MsgUpdateNamedGroupResponse")
+	proto.RegisterType((*MsgRemoveNamedGroup)(nil), "band.bandtss.v1beta1.MsgRemoveNamedGroup")
+	proto.RegisterType((*MsgRemoveNamedGroupResponse)(nil), "band.bandtss.v1beta1.MsgRemoveNamedGroupResponse")
 }
 
 func init() { proto.RegisterFile("band/bandtss/v1beta1/tx.proto", fileDescriptor_1607716805749e77) }
 
 var fileDescriptor_1607716805749e77 = []byte{
-	// 889 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x31, 0x6f, 0xdb, 0x46,
-	0x14, 0x16, 0x2d, 0xd5, 0x92, 0xce, 0x05, 0xdc, 0x30, 0x2a, 0x2c, 0x33, 0x8e, 0xe8, 0xa8, 0x28,
-	0xa0, 0x18, 0x10, 0xcf, 0x76, 0xd0, 0x0e, 0xee, 0x50, 0x58, 0x29, 0x1a, 0xb8, 0xa8, 0x80, 0x82,
-	0x49, 0x81, 0x22, 0x8b, 0x40, 0x91, 0xe7, 0xd3, 0x21, 0xe6, 0x1d, 0xcb, 0x3b, 0x19, 0x36, 0xd0,
-	0xa1, 0xe8, 0x54, 0x74, 0xca, 0x4f, 0xe8, 0xdc, 0x2e, 0x2e, 0x9a, 0xa9, 0x53, 0xc7, 0xa0, 0x53,
-	0xd0, 0xa9, 0x93, 0x53, 0xc8, 0x83, 0xff, 0x41, 0x87, 0x4e, 0xc5, 0x1d, 0x8f, 0xb4, 0x4c, 0x51,
-	0x8d, 0x82, 0x2c, 0x24, 0xef, 0xbd, 0xef, 0xdd, 0x7b, 0xdf, 0x77, 0xef, 0x1d, 0xc1, 0xed, 0xa1,
-	0x47, 0x03, 0x28, 0x1f, 0x82, 0x73, 0x78, 0xbc, 0x33, 0x44, 0xc2, 0xdb, 0x81, 0xe2, 0xc4, 0x89,
-	0x62, 0x26, 0x98, 0xd9, 0x90, 0x1e, 0x47, 0xbb, 0x1d, 0xed, 0xb6, 0x1a, 0x98, 0x61, 0xa6, 0x00,
-	0x50, 0x7e, 0x25, 0x58, 0x6b, 0x1d, 0x33, 0x86, 0x8f, 0x10, 0x54, 0xab, 0xe1, 0xf8, 0x10, 0x7a,
-	0xf4, 0x54, 0xbb, 0xec, 0xbc, 0x4b, 0x90, 0x10, 0x71, 0xe1, 0x85, 0x91, 0x06, 0xdc, 0xf0, 0x42,
-	0x42, 0x19, 0x54, 0x4f, 0x6d, 0x6a, 0xf9, 0x8c, 0x87, 0x8c, 0xc3, 0xa1, 0xc7, 0x51, 0x56, 0x98,
-	0xcf, 0x08, 0x4d, 0xd3, 0x25, 0xfe, 0x41, 0x52, 0x47, 0xb2, 0xd0, 0xae, 0x35, 0x1d, 0x1a, 0x72,
-	0x0c, 0x8f, 0x77, 0xe4, 0x4b, 0x3b, 0xda, 0x85, 0x6c, 0x31, 0xa2, 0x88, 0x13, 0x1d, 0xdc, 0xfe,
-	0x75, 0x09, 0xdc, 0xec, 0x73, 0xec, 0xa2, 0xaf, 0xc7, 0x88, 0x8b, 0x87, 0x04, 0x53, 0x4f, 0x8c,
-	0x63, 0x64, 0x7e, 0x04, 0xaa, 0x3e, 0xa3, 0x02, 0x51, 0xd1, 0x34, 0x36, 0x8d, 0xce, 0xca, 0x6e,
-	0xc3, 0x49, 0x58, 0x39, 0x29, 0x2b, 0x67, 0x9f, 0x9e, 0xf6, 0x56, 0xfe, 0x78, 0xd6, 0xad, 0xde,
-	0x4f, 0x80, 0x6e, 0x1a, 0x61, 0x9a, 0xa0, 0x12, 0xa2, 0x90, 0x35, 0x97, 0x36, 0x8d, 0x4e, 0xdd,
-	0x55, 0xdf, 0xe6, 0x08, 0xd4, 0x0f, 0x11, 0x1a, 0x1c, 0x91, 0x90, 0x88, 0x66, 0x79, 0xb3, 0xdc,
-	0x59, 0xd9, 0x5d, 0x77, 0x34, 0x0f, 0x49, 0x3a, 0x95, 0xdb, 0xb9, 0xcf, 0x08, 0xed, 0x6d, 0x3f,
-	0x3f, 0xb7, 0x4b, 0x3f, 0xbd, 0xb4, 0x3b, 0x98, 0x88, 0xd1, 0x78, 0xe8, 0xf8, 0x2c, 0xd4, 0xa4,
-	0xf5, 0xab, 0xcb, 0x83, 0x27, 0x50, 0x9c, 0x46, 0x88, 0xab, 0x00, 0xee, 0xd6, 0x0e, 0x11, 0xfa,
-	0x5c, 0x6e, 0x6e, 0x6e, 0x83, 0x65, 0x8e, 0x68, 0x80, 0xe2, 0x66, 0x45, 0xe6, 0xef, 0x35, 0xff,
-	0x7c, 0xd6, 0x6d, 0xe8, 0x4c, 0xfb, 0x41, 0x10, 0x23, 0xce, 0x1f, 0x8a, 0x98, 0x50, 0xec, 0x6a,
-	0xdc, 0x1e, 0xfc, 0xfe, 0x47, 0xbb, 0xf4, 0xdd, 0xe5, 0xd9, 0x96, 0x36, 0xfc, 0x70, 0x79, 0xb6,
-	0x75, 0x2b, 0xd5, 0xad, 0x40, 0x9d, 0xf6, 0x6d, 0x70, 0xab, 0xc0, 0xec, 0x22, 0x1e, 0x31, 0xca,
-	0x51, 0xfb, 0x77, 0x03, 0xac, 0xf4, 0x39, 0xde, 0xf7, 0x05, 0x39, 0xf6, 0x04, 0x9a, 0xaa, 0xc8,
-	0x58, 0xac, 0x22, 0xf3, 0x31, 0xa8, 0xe1, 0x98, 0x8d, 0xa3, 0x01, 0x09, 0x94, 0x8a, 0x95, 0xde,
-	0xc7, 0x93, 0x73, 0xbb, 0xfa, 0x40, 0xda, 0x0e, 0x3e, 0xf9, 0xf7, 0xdc, 0xde, 0x9e, 0x12, 0x46,
-	0x56, 0xaa, 0x4e, 0xc5, 0x67, 0x47, 0xd0, 0x1f, 0x79, 0x84, 0xc2, 0xe3, 0x7b, 0x30, 0x7a, 0x82,
-	0xa1, 0x6c, 0x63, 0x1d, 0xe3, 0x56, 0xd5, 0x86, 0x07, 0xc1, 0xde, 0x7b, 0x39, 0xa6, 0x37, 0xa7,
-	0x98, 0xa6, 0x25, 0xb7, 0xdf, 0x55, 0x6d, 0x91, 0x2e, 0x33, 0x66, 0xbf, 0x18, 0x60, 0xb5, 0xcf,
-	0xf1, 0x97, 0x51, 0xe0, 0x09, 0xf4, 0x85, 0x17, 0x7b, 0x21, 0x37, 0xf7, 0xc0, 0x72, 0xa4, 0xbe,
-	0x74, 0xa7, 0x6c, 0x38, 0x45, 0x63, 0xe4, 0x24, 0xe8, 0x5e, 0x45, 0x9e, 0xac, 0xab, 0x23, 0xcc,
-	0x0f, 0x41, 0xdd, 0x1b, 0x8b, 0x11, 0x8b, 0x89, 0x38, 0x4d, 0xda, 0xe5, 0x7f, 0xc4, 0xb9, 0x82,
-	0xee, 0x6d, 0x49, 0x0e, 0x57, 0x6b, 0x49, 0x63, 0x6d, 0x8a, 0xc6, 0x74, 0x7d, 0xed, 0x75, 0xb0,
-	0x96, 0x33, 0x65, 0x74, 0xfe, 0x31, 0x80, 0xd9, 0xe7, 0xf8, 0x51, 0xec, 0x51, 0x4e, 0x04, 0x61,
-	0x54, 0x89, 0x65, 0x36, 0x41, 0x35, 0x44, 0xe1, 0x10, 0xc5, 0x92, 0x52, 0xb9, 0x53, 0x77, 0xd3,
-	0xa5, 0xb9, 0x01, 0xea, 0x62, 0x14, 0x23, 0x3e, 0x62, 0x47, 0xfa, 0x60, 0xdc, 0x2b, 0x83, 0xb9,
-	0x0f, 0xea, 0xe8, 0x04, 0xf9, 0x03, 0x39, 0xef, 0xcd, 0xb2, 0x12, 0xc3, 0x9a, 0x19, 0x9b, 0x47,
-	0xe9, 0x65, 0xd0, 0xab, 0x49, 0x29, 0x9e, 0xbe, 0xb4, 0x0d, 0xb7, 0x26, 0xc3, 0xa4, 0xe3, 0xba,
-	0x20, 0x95, 0xc5, 0x05, 0xe9, 0xce, 0x0a, 0x62, 0x4d, 0x09, 0x92, 0x63, 0xd8, 0xde, 0x00, 0xd6,
-	0xac, 0x35, 0x93, 0xe5, 0xb7, 0x25, 0x25, 0xd9, 0xa7, 0x2c, 0xf6, 0x51, 0x5e, 0x1b, 0x0e, 0x6e,
-	0x10, 0xea, 0xb3, 0x90, 0x50, 0x3c, 0xc8, 0x5a, 0xd4, 0x50, 0x2d, 0xfa, 0x60, 0x72, 0x6e, 0xaf,
-	0x1e, 0x68, 0xe7, 0x9b, 0xb4, 0xea, 0x2a, 0xb9, 0xb6, 0x49, 0x4e, 0xd8, 0xa5, 0x37, 0x17, 0xb6,
-	0xbc, 0xb8, 0xb0, 0x70, 0x56, 0xd8, 0x8d, 0x54, 0xd8, 0x22, 0x81, 0xda, 0x77, 0x80, 0x3d, 0x47,
-	0xbb, 0x54, 0xdf, 0xdd, 0x9f, 0x2b, 0xa0, 0xdc, 0xe7, 0xd8, 0x8c, 0xc0, 0x3b, 0x33, 0x17, 0xef,
-	0xdd, 0xe2, 0xe9, 0x29, 0xb8, 0x6e, 0xac, 0x9d, 0x85, 0xa1, 0x69, 0x66, 0xf3, 0x2b, 0x50, 0xcb,
-	0x6e, 0xa5, 0x3b, 0x73, 0xc3, 0x53, 0x88, 0x75, 0xf7, 0x95, 0x90, 0x6c, 0xe7, 0x00, 0xbc, 0x7d,
-	0xed, 0x56, 0x78, 0x7f, 0x6e, 0xe8, 0x34, 0xcc, 0xea, 0x2e, 0x04, 0xcb, 0xb2, 0x84, 0x60, 0x35,
-	0xdf, 0x90, 0x9d, 0xb9, 0x3b, 0xe4, 0x90, 0xd6, 0xf6, 0xa2, 0xc8, 0x2c, 0xdd, 0x37, 0xa0, 0x51,
-	0x38, 0x04, 0xf3, 0xab, 0x2e, 0x82, 0x5b, 0x1f, 0xbc, 0x16, 0x3c, 0xcd, 0x6e, 0xbd, 0xf5, 0xed,
-	0xe5, 0xd9, 0x96, 0xd1, 0xfb, 0xec, 0xf9, 0xa4, 0x65, 0xbc, 0x98, 0xb4, 0x8c, 0xbf, 0x27, 0x2d,
-	0xe3, 0xe9, 0x45, 0xab, 0xf4, 0xe2, 0xa2, 0x55, 0xfa, 0xeb, 0xa2, 0x55, 0x7a, 0xfc, 0xea, 0xc9,
-	0x3a, 0xc9, 0x7e, 0xff, 0xea, 0x5f, 0x39, 0x5c, 0x56, 0x90, 0x7b, 0xff, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0x2f, 0x0c, 0x12, 0x01, 0x09, 0x09, 0x00, 0x00,
+	// 1141 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x31, 0x6f, 0xdb, 0xc6,
+	0x17, 0x37, 0x25, 0xd9, 0x92, 0xce, 0x41, 0x9c, 0xd0, 0xfa, 0xc3, 0x34, 0x63, 0x4b, 0x8e, 0xfe,
+	0x28, 0x20, 0x1b, 0x10, 0x69, 0x39, 0x68, 0x07, 0x77, 0x28, 0x2c, 0x17, 0x0d, 0x5c, 0xd4, 0x45,
+	0x40, 0xa7, 0x40, 0x91, 0x45, 0xa0, 0xc8, 0x33, 0x45, 0xc4, 0xbc, 0x63, 0x78, 0x27, 0xc1, 0x06,
+	0x3a, 0xb4, 0x45, 0x87, 0xa2, 0x53, 0xfa, 0x0d, 0x3a, 0x77, 0x72, 0x81, 0x4c, 0x5d, 0xda, 0x31,
+	0xe8, 0x14, 0x74, 0xea, 0xe4, 0x14, 0xf2, 0xe0, 0x02, 0xfd, 0x06, 0x9d, 0x8a, 0x3b, 0x1e, 0x69,
+	0x8a, 0x92, 0x22, 0xb6, 0x09, 0xba, 0x58, 0xbc, 0xf7, 0x7e, 0xef, 0xde, 0x7b, 0xbf, 0xf7, 0xee,
+	0xdd, 0x19, 0xac, 0x77, 0x4d, 0x64, 0xeb, 0xec, 0x0f, 0x25, 0x44, 0x1f, 0xb4, 0xba, 0x90, 0x9a,
+	0x2d, 0x9d, 0x9e, 0x6a, 0x7e, 0x80, 0x29, 0x96, 0x2b, 0x4c, 0xa3, 0x09, 0xb5, 0x26, 0xd4, 0x6a,
+	0xc5, 0xc1, 0x0e, 0xe6, 0x00, 0x9d, 0x7d, 0x85, 0x58, 0x75, 0xd5, 0xc1, 0xd8, 0x39, 0x81, 0x3a,
+	0x5f, 0x75, 0xfb, 0xc7, 0xba, 0x89, 0xce, 0x84, 0xaa, 0x96, 0x56, 0x51, 0xd7, 0x83, 0x84, 0x9a,
+	0x9e, 0x2f, 0x00, 0xb7, 0x4d, 0xcf, 0x45, 0x58, 0xe7, 0x7f, 0x85, 0xa8, 0x6a, 0x61, 0xe2, 0x61,
+	0xa2, 0x77, 0x4d, 0x02, 0xe3, 0xc0, 0x2c, 0xec, 0xa2, 0xc8, 0x5d, 0xa8, 0xef, 0x84, 0x71, 0x84,
+	0x0b, 0xa1, 0x5a, 0x11, 0xa6, 0x1e, 0x71, 0xf4, 0x41, 0x8b, 0xfd, 0x08, 0x45, 0x7d, 0x62, 0xb6,
+	0x0e, 0x44, 0x90, 0xb8, 0x91, 0xb1, 0xca, 0x31, 0x23, 0x6c, 0x10, 0xa1, 0xab, 0xff, 0x91, 0x03,
+	0xcb, 0x87, 0xc4, 0x31, 0xe0, 0x93, 0x3e, 0x24, 0xf4, 0xc8, 0x75, 0x90, 0x49, 0xfb, 0x01, 0x94,
+	0xdf, 0x05, 0x45, 0x0b, 0x23, 0x0a, 0x11, 0x55, 0xa4, 0x0d, 0xa9, 0xb1, 0xb8, 0x53, 0xd1, 0xc2,
+	0x8c, 0xb5, 0x28, 0x63, 0x6d, 0x0f, 0x9d, 0xb5, 0x17, 0x7f, 0x79, 0xd6, 0x2c, 0xee, 0x87, 0x40,
+	0x23, 0xb2, 0x90, 0x65, 0x50, 0xf0, 0xa0, 0x87, 0x95, 0xdc, 0x86, 0xd4, 0x28, 0x1b, 0xfc, 0x5b,
+	0xee, 0x81, 0xf2, 0x31, 0x84, 0x9d, 0x13, 0xd7, 0x73, 0xa9, 0x92, 0xdf, 0xc8, 0x37, 0x16, 0x77,
+	0x56, 0x35, 0x91, 0x23, 0x23, 0x24, 0x2a, 0x85, 0xb6, 0x8f, 0x5d, 0xd4, 0xde, 0x7e, 0x7e, 0x51,
+	0x9b, 0xfb, 0xfe, 0x65, 0xad, 0xe1, 0xb8, 0xb4, 0xd7, 0xef, 0x6a, 0x16, 0xf6, 0x04, 0x21, 0xe2,
+	0xa7, 0x49, 0xec, 0xc7, 0x3a, 0x3d, 0xf3, 0x21, 0xe1, 0x06, 0xc4, 0x28, 0x1d, 0x43, 0xf8, 0x11,
+	0xdb, 0x5c, 0xde, 0x06, 0x0b, 0x04, 0x22, 0x1b, 0x06, 0x4a, 0x81, 0xf9, 0x6f, 0x2b, 0xbf, 0x3e,
+	0x6b, 0x56, 0x84, 0xa7, 0x3d, 0xdb, 0x0e, 0x20, 0x21, 0x47, 0x34, 0x70, 0x91, 0x63, 0x08, 0x9c,
+	0x5c, 0x01, 0xf3, 0x5d, 0x93, 0x5a, 0x3d, 0x65, 0x7e, 0x43, 0x6a, 0x94, 0x8c, 0x70, 0x21, 0xaf,
+	0x03, 0xe0, 0x04, 0xb8, 0xef, 0x77, 0x90, 0xe9, 0x41, 0x65, 0x81, 0xe7, 0x52, 0xe6, 0x92, 0x8f,
+	0x4d, 0x0f, 0xee, 0xea, 0x5f, 0x7f, 0x57, 0x9b, 0xfb, 0xf2, 0xea, 0x7c, 0x4b, 0xec, 0xf2, 0xcd,
+	0xd5, 0xf9, 0xd6, 0x9d, 0xa8, 0x10, 0x13, 0x28, 0xad, 0xaf, 0x83, 0x3b, 0x13, 0xc4, 0x06, 0x24,
+	0x3e, 0x46, 0x04, 0xd6, 0x7f, 0x96, 0xc0, 0xe2, 0x21, 0x71, 0xf6, 0x2c, 0xea, 0x0e, 0x4c, 0x0a,
+	0x13, 0x69, 0x48, 0x19, 0xd3, 0x78, 0x04, 0x4a, 0x61, 0xc0, 0xae, 0xcd, 0xa9, 0x2f, 0xb4, 0xdf,
+	0x1b, 0x5e, 0xd4, 0x8a, 0xf7, 0x99, 0xec, 0xe0, 0xfd, 0xbf, 0x2e, 0x6a, 0xdb, 0x09, 0x36, 0x59,
+	0xa4, 0xbc, 0x94, 0x16, 0x3e, 0xd1, 0xad, 0x9e, 0xe9, 0x22, 0x7d, 0x70, 0x4f, 0xf7, 0x1f, 0x3b,
+	0xbc, 0x39, 0x84, 0x8d, 0x51, 0xe4, 0x1b, 0x1e, 0xd8, 0xbb, 0xff, 0x4f, 0x65, 0xba, 0x9c, 0xc8,
+	0x34, 0x0a, 0xb9, 0xfe, 0x3f, 0xb0, 0x9c, 0x58, 0xc6, 0x99, 0xfd, 0x20, 0x81, 0xa5, 0x43, 0xe2,
+	0x7c, 0xe2, 0xdb, 0x26, 0x85, 0x0f, 0xcc, 0xc0, 0xf4, 0x88, 0xbc, 0x0b, 0x16, 0x7c, 0xfe, 0x25,
+	0xda, 0x6b, 0x4d, 0x9b, 0x74, 0x2e, 0xb5, 0x10, 0xdd, 0x2e, 0xb0, 0x76, 0x30, 0x84, 0x85, 0xfc,
+	0x0e, 0x28, 0x9b, 0x7d, 0xda, 0xc3, 0x81, 0x4b, 0xcf, 0x94, 0xdc, 0x0c, 0x72, 0xae, 0xa1, 0xbb,
+	0x5b, 0x2c, 0x87, 0xeb, 0x35, 0x4b, 0x63, 0x25, 0x91, 0x46, 0x32, 0xbe, 0xfa, 0x2a, 0x58, 0x49,
+	0x89, 0xe2, 0x74, 0x7e, 0xca, 0x01, 0xf9, 0x90, 0x38, 0x0f, 0x03, 0x13, 0x11, 0x97, 0xba, 0x18,
+	0x71, 0xb2, 0x64, 0x05, 0x14, 0x3d, 0xe8, 0x75, 0x61, 0xc0, 0x52, 0xca, 0x37, 0xca, 0x46, 0xb4,
+	0x94, 0xd7, 0x40, 0x99, 0xf6, 0x02, 0x48, 0x7a, 0xf8, 0x44, 0x14, 0xc6, 0xb8, 0x16, 0xc8, 0x7b,
+	0xa0, 0x0c, 0x4f, 0xa1, 0xd5, 0x61, 0x03, 0x44, 0xc9, 0x73, 0x32, 0xd4, 0xb1, 0xb3, 0xf6, 0x30,
+	0x9a, 0x2e, 0xed, 0x12, 0xa3, 0xe2, 0xe9, 0xcb, 0x9a, 0x64, 0x94, 0x98, 0x19, 0x53, 0x8c, 0x12,
+	0x52, 0xc8, 0x4c, 0x08, 0x0b, 0x99, 0x45, 0x61, 0x06, 0x50, 0x74, 0x7e, 0xb4, 0x94, 0x9b, 0x60,
+	0xde, 0xea, 0x07, 0x83, 0xb0, 0xed, 0x6f, 0xee, 0xac, 0x84, 0xd5, 0x49, 0x56, 0x66, 0x9f, 0xa9,
+	0x8d, 0x10, 0xb5, 0xdb, 0x1c, 0x67, 0x56, 0x4d, 0x30, 0x9b, 0xa2, 0xaa, 0xbe, 0x06, 0xd4, 0x71,
+	0x69, 0xcc, 0xef, 0x8f, 0x39, 0xce, 0xfd, 0x07, 0x38, 0xb0, 0x60, 0x9a, 0x64, 0x02, 0x6e, 0xbb,
+	0xc8, 0xc2, 0x9e, 0x8b, 0x9c, 0x4e, 0xdc, 0xeb, 0x12, 0xef, 0xf5, 0xfb, 0xc3, 0x8b, 0xda, 0xd2,
+	0x81, 0x50, 0xbe, 0x4e, 0xcf, 0x2f, 0xb9, 0x23, 0x9b, 0xa4, 0x2a, 0x94, 0x7b, 0xfd, 0x0a, 0xe5,
+	0xb3, 0xb7, 0xac, 0x3e, 0x4e, 0xec, 0x5a, 0x44, 0xec, 0x24, 0x82, 0xea, 0x77, 0x41, 0x6d, 0x0a,
+	0x77, 0x31, 0xbf, 0x7f, 0x86, 0x23, 0x7f, 0x3f, 0x80, 0x26, 0x85, 0x6c, 0x94, 0xd9, 0x21, 0xb7,
+	0x32, 0x28, 0xf0, 0x49, 0x27, 0x85, 0x53, 0x9b, 0x7d, 0x27, 0x9b, 0x3a, 0xf7, 0x8a, 0xa6, 0xce,
+	0xa7, 0x9b, 0x3a, 0xee, 0x9f, 0x42, 0x96, 0xfe, 0x91, 0x9f, 0x80, 0x9b, 0xec, 0x72, 0xf0, 0x61,
+	0xd0, 0x21, 0xae, 0x83, 0x60, 0xa0, 0xcc, 0xbf, 0xf9, 0x1b, 0xe2, 0xc6, 0x31, 0x84, 0x0f, 0x60,
+	0x70, 0xc4, 0x1d, 0x8c, 0x56, 0x64, 0x21, 0x7b, 0x45, 0xb4, 0xf1, 0x8a, 0x24, 0xa7, 0x7e, 0x9a,
+	0x55, 0x31, 0xf5, 0xd3, 0xe2, 0xb8, 0x18, 0x5f, 0x85, 0xc5, 0x08, 0x07, 0xcd, 0x8c, 0x62, 0x8c,
+	0xb3, 0x94, 0xfb, 0x4f, 0x59, 0xca, 0xbf, 0x31, 0x96, 0xd2, 0xe9, 0x0a, 0x96, 0xd2, 0xe2, 0x98,
+	0xa5, 0x6f, 0x25, 0xf1, 0x4a, 0xf1, 0xf0, 0x60, 0x16, 0x4b, 0xff, 0xf6, 0x76, 0x98, 0x11, 0x72,
+	0xda, 0x77, 0x7c, 0x9d, 0x8f, 0x8a, 0xa3, 0x90, 0x77, 0xbe, 0x28, 0x82, 0xfc, 0x21, 0x71, 0x64,
+	0x1f, 0xdc, 0x1a, 0x7b, 0x5c, 0x6d, 0x4e, 0xbe, 0xec, 0x26, 0xbc, 0x0e, 0xd4, 0x56, 0x66, 0x68,
+	0xe4, 0x59, 0xfe, 0x14, 0x94, 0xe2, 0x47, 0xc4, 0xdd, 0xa9, 0xe6, 0x11, 0x44, 0xdd, 0x9c, 0x09,
+	0x89, 0x77, 0xb6, 0xc1, 0x8d, 0x91, 0x4b, 0xfc, 0xad, 0xa9, 0xa6, 0x49, 0x98, 0xda, 0xcc, 0x04,
+	0x8b, 0xbd, 0x78, 0x60, 0x29, 0x3d, 0xf6, 0x1b, 0x53, 0x77, 0x48, 0x21, 0xd5, 0xed, 0xac, 0xc8,
+	0xd8, 0xdd, 0x67, 0xa0, 0x32, 0xf1, 0xaa, 0x99, 0x1e, 0xf5, 0x24, 0xb8, 0xfa, 0xf6, 0x3f, 0x82,
+	0xc7, 0xde, 0x7d, 0x70, 0x6b, 0x6c, 0x10, 0x4f, 0xaf, 0x48, 0x1a, 0xaa, 0xb6, 0x32, 0x43, 0x93,
+	0x1e, 0xc7, 0xa6, 0xcd, 0xe6, 0x8c, 0x0a, 0x65, 0xf2, 0x38, 0xed, 0xf4, 0x86, 0x47, 0x20, 0x75,
+	0x72, 0x5f, 0x75, 0x04, 0x46, 0xa1, 0x6a, 0x2b, 0x33, 0x34, 0xf2, 0xa8, 0xce, 0x7f, 0x7e, 0x75,
+	0xbe, 0x25, 0xb5, 0x3f, 0x7c, 0x3e, 0xac, 0x4a, 0x2f, 0x86, 0x55, 0xe9, 0xf7, 0x61, 0x55, 0x7a,
+	0x7a, 0x59, 0x9d, 0x7b, 0x71, 0x59, 0x9d, 0xfb, 0xed, 0xb2, 0x3a, 0xf7, 0x68, 0xf6, 0xab, 0xe0,
+	0x34, 0xfe, 0xa7, 0x8a, 0x4f, 0xc7, 0xee, 0x02, 0x87, 0xdc, 0xfb, 0x7b, 0x00, 0xc7, 0xd8, 0xda,
+	0x70, 0x5f, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -614,6 +984,12 @@ type MsgClient interface {
 	// ForceTransitionGroup sets the given group to the incoming group without the signature of a transition
 	// message from a current group.
 	ForceTransitionGroup(ctx context.Context, in *MsgForceTransitionGroup, opts ...grpc.CallOption) (*MsgForceTransitionGroupResponse, error)
+	// CreateNamedGroup creates a new named group that signs requests alongside the current group.
+	CreateNamedGroup(ctx context.Context, in *MsgCreateNamedGroup, opts ...grpc.CallOption) (*MsgCreateNamedGroupResponse, error)
+	// UpdateNamedGroup updates the fee per signer of a named group.
+	UpdateNamedGroup(ctx context.Context, in *MsgUpdateNamedGroup, opts ...grpc.CallOption) (*MsgUpdateNamedGroupResponse, error)
+	// RemoveNamedGroup removes a named group from the module.
+	RemoveNamedGroup(ctx context.Context, in *MsgRemoveNamedGroup, opts ...grpc.CallOption) (*MsgRemoveNamedGroupResponse, error)
 }
 
 type msgClient struct {
@@ -669,6 +1045,33 @@ func (c *msgClient) ForceTransitionGroup(ctx context.Context, in *MsgForceTransi
 	return out, nil
 }
 
+func (c *msgClient) CreateNamedGroup(ctx context.Context, in *MsgCreateNamedGroup, opts ...grpc.CallOption) (*MsgCreateNamedGroupResponse, error) {
+	out := new(MsgCreateNamedGroupResponse)
+	err := c.cc.Invoke(ctx, "/band.bandtss.v1beta1.Msg/CreateNamedGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateNamedGroup(ctx context.Context, in *MsgUpdateNamedGroup, opts ...grpc.CallOption) (*MsgUpdateNamedGroupResponse, error) {
+	out := new(MsgUpdateNamedGroupResponse)
+	err := c.cc.Invoke(ctx, "/band.bandtss.v1beta1.Msg/UpdateNamedGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveNamedGroup(ctx context.Context, in *MsgRemoveNamedGroup, opts ...grpc.CallOption) (*MsgRemoveNamedGroupResponse, error) {
+	out := new(MsgRemoveNamedGroupResponse)
+	err := c.cc.Invoke(ctx, "/band.bandtss.v1beta1.Msg/RemoveNamedGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RequestSignature submits a general message to be signed by a specific group.
@@ -682,6 +1085,12 @@ type MsgServer interface {
 	// ForceTransitionGroup sets the given group to the incoming group without the signature of a transition
 	// message from a current group.
 	ForceTransitionGroup(context.Context, *MsgForceTransitionGroup) (*MsgForceTransitionGroupResponse, error)
+	// CreateNamedGroup creates a new named group that signs requests alongside the current group.
+	CreateNamedGroup(context.Context, *MsgCreateNamedGroup) (*MsgCreateNamedGroupResponse, error)
+	// UpdateNamedGroup updates the fee per signer of a named group.
+	UpdateNamedGroup(context.Context, *MsgUpdateNamedGroup) (*MsgUpdateNamedGroupResponse, error)
+	// RemoveNamedGroup removes a named group from the module.
+	RemoveNamedGroup(context.Context, *MsgRemoveNamedGroup) (*MsgRemoveNamedGroupResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -703,6 +1112,15 @@ func (*UnimplementedMsgServer) TransitionGroup(ctx context.Context, req *MsgTran
 func (*UnimplementedMsgServer) ForceTransitionGroup(ctx context.Context, req *MsgForceTransitionGroup) (*MsgForceTransitionGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceTransitionGroup not implemented")
 }
+func (*UnimplementedMsgServer) CreateNamedGroup(ctx context.Context, req *MsgCreateNamedGroup) (*MsgCreateNamedGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNamedGroup not implemented")
+}
+func (*UnimplementedMsgServer) UpdateNamedGroup(ctx context.Context, req *MsgUpdateNamedGroup) (*MsgUpdateNamedGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamedGroup not implemented")
+}
+func (*UnimplementedMsgServer) RemoveNamedGroup(ctx context.Context, req *MsgRemoveNamedGroup) (*MsgRemoveNamedGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveNamedGroup not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -798,40 +1216,106 @@ func _Msg_ForceTransitionGroup_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "band.bandtss.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RequestSignature",
-			Handler:    _Msg_RequestSignature_Handler,
-		},
-		{
-			MethodName: "Activate",
-			Handler:    _Msg_Activate_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "TransitionGroup",
-			Handler:    _Msg_TransitionGroup_Handler,
-		},
-		{
-			MethodName: "ForceTransitionGroup",
-			Handler:    _Msg_ForceTransitionGroup_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "band/bandtss/v1beta1/tx.proto",
-}
-
-func (m *MsgRequestSignature) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateNamedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateNamedGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateNamedGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.bandtss.v1beta1.Msg/CreateNamedGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateNamedGroup(ctx, req.(*MsgCreateNamedGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateNamedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNamedGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNamedGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.bandtss.v1beta1.Msg/UpdateNamedGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNamedGroup(ctx, req.(*MsgUpdateNamedGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveNamedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveNamedGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveNamedGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.bandtss.v1beta1.Msg/RemoveNamedGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveNamedGroup(ctx, req.(*MsgRemoveNamedGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "band.bandtss.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestSignature",
+			Handler:    _Msg_RequestSignature_Handler,
+		},
+		{
+			MethodName: "Activate",
+			Handler:    _Msg_Activate_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "TransitionGroup",
+			Handler:    _Msg_TransitionGroup_Handler,
+		},
+		{
+			MethodName: "ForceTransitionGroup",
+			Handler:    _Msg_ForceTransitionGroup_Handler,
+		},
+		{
+			MethodName: "CreateNamedGroup",
+			Handler:    _Msg_CreateNamedGroup_Handler,
+		},
+		{
+			MethodName: "UpdateNamedGroup",
+			Handler:    _Msg_UpdateNamedGroup_Handler,
+		},
+		{
+			MethodName: "RemoveNamedGroup",
+			Handler:    _Msg_RemoveNamedGroup_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "band/bandtss/v1beta1/tx.proto",
+}
+
+func (m *MsgRequestSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
@@ -847,6 +1331,23 @@ func (m *MsgRequestSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Batch {
+		i--
+		if m.Batch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -1054,6 +1555,21 @@ func (m *MsgTransitionGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Curve != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Curve))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Reshare {
+		i--
+		if m.Reshare {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -1175,103 +1691,337 @@ func (m *MsgForceTransitionGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCreateNamedGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRequestSignature) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCreateNamedGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateNamedGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Content != nil {
-		l = m.Content.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.FeePerSigner) > 0 {
+		for iNdEx := len(m.FeePerSigner) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeePerSigner[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if len(m.FeeLimit) > 0 {
-		for _, e := range m.FeeLimit {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.Curve != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Curve))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestSignatureResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateNamedGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateNamedGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateNamedGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgActivate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateNamedGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNamedGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNamedGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.GroupID != 0 {
-		n += 1 + sovTx(uint64(m.GroupID))
+	if len(m.FeePerSigner) > 0 {
+		for iNdEx := len(m.FeePerSigner) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeePerSigner[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgActivateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateNamedGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgUpdateNamedGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateNamedGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTransitionGroup) Size() (n int) {
+func (m *MsgRemoveNamedGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveNamedGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveNamedGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveNamedGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveNamedGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveNamedGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRequestSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Content != nil {
+		l = m.Content.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FeeLimit) > 0 {
+		for _, e := range m.FeeLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Batch {
+		n += 2
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestSignatureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgActivate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GroupID != 0 {
+		n += 1 + sovTx(uint64(m.GroupID))
+	}
+	return n
+}
+
+func (m *MsgActivateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransitionGroup) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1292,6 +2042,12 @@ func (m *MsgTransitionGroup) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Reshare {
+		n += 2
+	}
+	if m.Curve != 0 {
+		n += 1 + sovTx(uint64(m.Curve))
+	}
 	return n
 }
 
@@ -1331,6 +2087,108 @@ func (m *MsgForceTransitionGroupResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateNamedGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	if m.Curve != 0 {
+		n += 1 + sovTx(uint64(m.Curve))
+	}
+	if len(m.FeePerSigner) > 0 {
+		for _, e := range m.FeePerSigner {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateNamedGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateNamedGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FeePerSigner) > 0 {
+		for _, e := range m.FeePerSigner {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateNamedGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveNamedGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveNamedGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1498,8 +2356,631 @@ func (m *MsgRequestSignature) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Batch = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestSignatureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestSignatureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestSignatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= github_com_bandprotocol_chain_v3_pkg_tss.GroupID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransitionGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransitionGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransitionGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reshare", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reshare = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			m.Curve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Curve |= types2.Curve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1521,7 +3002,7 @@ func (m *MsgRequestSignature) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestSignatureResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransitionGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1544,10 +3025,10 @@ func (m *MsgRequestSignatureResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestSignatureResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransitionGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestSignatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransitionGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1571,7 +3052,7 @@ func (m *MsgRequestSignatureResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivate) Unmarshal(dAtA []byte) error {
+func (m *MsgForceTransitionGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1594,17 +3075,36 @@ func (m *MsgActivate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceTransitionGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceTransitionGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncomingGroupID", wireType)
+			}
+			m.IncomingGroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IncomingGroupID |= github_com_bandprotocol_chain_v3_pkg_tss.GroupID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1614,29 +3114,30 @@ func (m *MsgActivate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			m.GroupID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1646,11 +3147,24 @@ func (m *MsgActivate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupID |= github_com_bandprotocol_chain_v3_pkg_tss.GroupID(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1672,7 +3186,7 @@ func (m *MsgActivate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgForceTransitionGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1695,10 +3209,10 @@ func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceTransitionGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceTransitionGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1722,7 +3236,7 @@ func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateNamedGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1745,15 +3259,117 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateNamedGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateNamedGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			m.Curve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Curve |= types2.Curve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerSigner", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1780,11 +3396,12 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeePerSigner = append(m.FeePerSigner, types1.Coin{})
+			if err := m.FeePerSigner[len(m.FeePerSigner)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
@@ -1837,7 +3454,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateNamedGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1860,10 +3477,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateNamedGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateNamedGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1887,7 +3504,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransitionGroup) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateNamedGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1910,15 +3527,15 @@ func (m *MsgTransitionGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransitionGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateNamedGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransitionGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateNamedGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1946,30 +3563,11 @@ func (m *MsgTransitionGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
-			}
-			m.Threshold = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Threshold |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerSigner", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1996,11 +3594,12 @@ func (m *MsgTransitionGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.FeePerSigner = append(m.FeePerSigner, types1.Coin{})
+			if err := m.FeePerSigner[len(m.FeePerSigner)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
@@ -2053,7 +3652,7 @@ func (m *MsgTransitionGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransitionGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateNamedGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2076,10 +3675,10 @@ func (m *MsgTransitionGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransitionGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateNamedGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransitionGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateNamedGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2103,7 +3702,7 @@ func (m *MsgTransitionGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceTransitionGroup) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveNamedGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2126,36 +3725,17 @@ func (m *MsgForceTransitionGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceTransitionGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveNamedGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceTransitionGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveNamedGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncomingGroupID", wireType)
-			}
-			m.IncomingGroupID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IncomingGroupID |= github_com_bandprotocol_chain_v3_pkg_tss.GroupID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2165,26 +3745,25 @@ func (m *MsgForceTransitionGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
@@ -2237,7 +3816,7 @@ func (m *MsgForceTransitionGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceTransitionGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveNamedGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2260,10 +3839,10 @@ func (m *MsgForceTransitionGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceTransitionGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveNamedGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceTransitionGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveNamedGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
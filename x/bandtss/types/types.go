@@ -2,3 +2,6 @@ package types
 
 // SigningID is the type-safe unique identifier type for bandtss signing info.
 type SigningID uint64
+
+// MaxGroupNameLength is the maximum length of the name of a named group.
+const MaxGroupNameLength = 32
@@ -12,8 +12,10 @@ import (
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker)
 
+	params := k.GetParams(ctx)
+
 	// re-calculate current feeds every `CurrentFeedsUpdateInterval` blocks
-	if ctx.BlockHeight()%k.GetParams(ctx).CurrentFeedsUpdateInterval == 0 {
+	if ctx.BlockHeight()%params.CurrentFeedsUpdateInterval == 0 {
 		// delete all prices to reset the state
 		// it will be set again when the price is calculated in this endblock.
 		k.DeleteAllPrices(ctx)
@@ -21,6 +23,12 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 		// update current feeds
 		feeds := k.CalculateNewCurrentFeeds(ctx)
 		k.SetCurrentFeeds(ctx, feeds)
+
+		// reset the circuit breaker states of signals that are no longer in the current feeds
+		k.PruneCircuitBreakerStates(ctx, feeds)
+
+		// prune the price history of all signals including the ones that are no longer in the current feeds
+		k.PruneAllPriceHistory(ctx, ctx.BlockTime().Unix()-params.PriceHistoryRetention)
 	}
 
 	// re-calculate prices of all current feeds
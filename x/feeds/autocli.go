@@ -37,12 +37,33 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Get the price for a given signal ID",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "signal_id"}},
 				},
+				{
+					RpcMethod:      "CircuitBreaker",
+					Use:            "circuit-breaker [signal-id]",
+					Short:          "Get the circuit breaker configuration and state for a given signal ID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "signal_id"}},
+				},
 				{
 					RpcMethod:      "Prices",
 					Use:            "prices [signal-ids]",
 					Short:          "Get prices for a list of signal IDs",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "signal_ids"}},
 				},
+				{
+					RpcMethod:      "PriceHistory",
+					Use:            "price-history [signal-id]",
+					Short:          "Get the price history for a given signal ID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "signal_id"}},
+				},
+				{
+					RpcMethod: "TWAP",
+					Use:       "twap [signal-id] [duration]",
+					Short:     "Get the time-weighted average price for a given signal ID over a duration (in seconds)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "signal_id"},
+						{ProtoField: "duration"},
+					},
+				},
 				{
 					RpcMethod: "AllPrices",
 					Use:       "all-prices",
@@ -22,6 +22,8 @@ import (
 const (
 	flagExpiration = "expiration"
 	flagFeeLimit   = "fee-limit"
+	flagBatch      = "batch"
+	flagGroupName  = "group-name"
 )
 
 // getGrantMsgTypes returns types for GrantMsg.
@@ -44,6 +46,8 @@ func GetTxCmd() *cobra.Command {
 		GetTxCmdAddFeeders(),
 		GetTxCmdRemoveFeeders(),
 		GetTxCmdUpdateReferenceSourceConfig(),
+		GetTxCmdUpdateFeedAggregationMethods(),
+		GetTxCmdUpdateCircuitBreakerConfigs(),
 		GetTxCmdVote(),
 	)
 
@@ -136,6 +140,114 @@ $ %s tx feeds update-reference-source-config <YOUR_IPFS_HASH> 1.0.0 --from mykey
 	return cmd
 }
 
+// GetTxCmdUpdateFeedAggregationMethods creates a CLI command for updating aggregation methods of signal ids
+func GetTxCmdUpdateFeedAggregationMethods() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-aggregation-methods [signal_id1],[method1] [signal_id2],[method2] ...",
+		Short: "Update aggregation methods of signal ids",
+		Args:  cobra.MinimumNArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Update aggregation methods of signal ids. The method is one of AGGREGATION_METHOD_UNSPECIFIED,
+AGGREGATION_METHOD_WEIGHTED_MEDIAN, AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN or AGGREGATION_METHOD_WEIGHTED_MEAN.
+Example:
+$ %s tx feeds update-aggregation-methods CS:BAND-USD,AGGREGATION_METHOD_WEIGHTED_MEAN --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			admin := clientCtx.GetFromAddress()
+			var feedAggregationMethods []types.FeedAggregationMethod
+			for i, arg := range args {
+				idAndMethod := strings.SplitN(arg, ",", 2)
+				if len(idAndMethod) != 2 {
+					return fmt.Errorf("argument %d is not valid", i)
+				}
+				method, ok := types.AggregationMethod_value[idAndMethod[1]]
+				if !ok {
+					return fmt.Errorf("aggregation method %s is not valid", idAndMethod[1])
+				}
+				feedAggregationMethods = append(
+					feedAggregationMethods, types.NewFeedAggregationMethod(
+						idAndMethod[0],
+						types.AggregationMethod(method),
+					),
+				)
+			}
+
+			msg := types.NewMsgUpdateFeedAggregationMethods(admin.String(), feedAggregationMethods)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetTxCmdUpdateCircuitBreakerConfigs creates a CLI command for updating circuit breaker configs of signal ids
+func GetTxCmdUpdateCircuitBreakerConfigs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "update-circuit-breaker-configs " +
+			"[signal_id1],[max_deviation_bps1],[confirmation_window1],[outlier_sigma1] ...",
+		Short: "Update circuit breaker configs of signal ids",
+		Args:  cobra.MinimumNArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Update circuit breaker configs of signal ids. A price that deviates from the previous price by more than
+the max deviation (in basis point) is halted until the deviation persists for the confirmation window (in seconds).
+Validator prices beyond the outlier sigma from the median are flagged. Zero values disable the circuit breaker.
+Example:
+$ %s tx feeds update-circuit-breaker-configs CS:BAND-USD,1000,60,3 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			admin := clientCtx.GetFromAddress()
+			var configs []types.CircuitBreakerConfig
+			for i, arg := range args {
+				fields := strings.Split(arg, ",")
+				if len(fields) != 4 {
+					return fmt.Errorf("argument %d is not valid", i)
+				}
+
+				values := make([]int64, 0, 3)
+				for _, field := range fields[1:] {
+					value, err := strconv.ParseInt(field, 0, 64)
+					if err != nil {
+						return err
+					}
+					values = append(values, value)
+				}
+
+				configs = append(
+					configs,
+					types.NewCircuitBreakerConfig(fields[0], values[0], values[1], values[2]),
+				)
+			}
+
+			msg := types.NewMsgUpdateCircuitBreakerConfigs(admin.String(), configs)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetTxCmdVote creates a CLI command for voting signals
 func GetTxCmdVote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -190,10 +302,10 @@ $ %s tx feeds vote CS:BAND-USD,1000000 --from mykey
 func GetCmdRequestSignature() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "feeds-prices [signal_id1,signal_id2,...] [encoder]",
-		Short: "Request bandtss signature prices from list of signal id and encoder (1: fixed-point abi, 2: tick abi)",
+		Short: "Request bandtss signature prices from list of signal id and encoder (1: fixed-point abi, 2: tick abi, 3: fixed-point packed, 4: fixed-point borsh)",
 		Args:  cobra.ExactArgs(2),
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Request bandtss signature from list of signal id and encoder (1: fixed-point abi, 2: tick abi)
+			fmt.Sprintf(`Request bandtss signature from list of signal id and encoder (1: fixed-point abi, 2: tick abi, 3: fixed-point packed, 4: fixed-point borsh)
 Example:
 $ %s tx bandtss request-signature feeds-prices CS:ETH-USD,CS:USDT-USD 1 --fee-limit 10uband
 `,
@@ -231,6 +343,16 @@ $ %s tx bandtss request-signature feeds-prices CS:ETH-USD,CS:USDT-USD 1 --fee-li
 				return err
 			}
 
+			msg.Batch, err = cmd.Flags().GetBool(flagBatch)
+			if err != nil {
+				return err
+			}
+
+			msg.GroupName, err = cmd.Flags().GetString(flagGroupName)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -80,3 +80,45 @@ func emitEventUpdatePrice(ctx sdk.Context, price types.Price) {
 		),
 	)
 }
+
+func emitEventUpdateAggregationMethod(ctx sdk.Context, fam types.FeedAggregationMethod) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateAggregationMethod,
+			sdk.NewAttribute(types.AttributeKeySignalID, fam.SignalID),
+			sdk.NewAttribute(types.AttributeKeyAggregationMethod, fam.AggregationMethod.String()),
+		),
+	)
+}
+
+func emitEventUpdateCircuitBreakerConfig(ctx sdk.Context, config types.CircuitBreakerConfig) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateCircuitBreakerConfig,
+			sdk.NewAttribute(types.AttributeKeySignalID, config.SignalID),
+			sdk.NewAttribute(
+				types.AttributeKeyMaxDeviationBasisPoint,
+				fmt.Sprintf("%d", config.MaxDeviationBasisPoint),
+			),
+			sdk.NewAttribute(types.AttributeKeyConfirmationWindow, fmt.Sprintf("%d", config.ConfirmationWindow)),
+			sdk.NewAttribute(types.AttributeKeyOutlierSigmaThreshold, fmt.Sprintf("%d", config.OutlierSigmaThreshold)),
+		),
+	)
+}
+
+func emitEventOutlierValidatorPrices(
+	ctx sdk.Context,
+	signalID string,
+	medianPrice uint64,
+	validators []sdk.ValAddress,
+) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeySignalID, signalID),
+		sdk.NewAttribute(types.AttributeKeyMedianPrice, fmt.Sprintf("%d", medianPrice)),
+	}
+	for _, val := range validators {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyValidator, val.String()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeOutlierValidatorPrices, attributes...))
+}
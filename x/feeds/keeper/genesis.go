@@ -19,6 +19,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	signalTotalPowers := CalculateSignalTotalPowersFromVotes(genState.Votes)
 	k.SetSignalTotalPowers(ctx, signalTotalPowers)
 
+	for _, fam := range genState.FeedAggregationMethods {
+		k.SetFeedAggregationMethod(ctx, fam)
+	}
+
+	for _, config := range genState.CircuitBreakerConfigs {
+		k.SetCircuitBreakerConfig(ctx, config)
+	}
+
 	feeds := k.CalculateNewCurrentFeeds(ctx)
 	k.SetCurrentFeeds(ctx, feeds)
 
@@ -33,6 +41,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.GetParams(ctx),
 		k.GetVotes(ctx),
 		k.GetReferenceSourceConfig(ctx),
+		k.GetFeedAggregationMethods(ctx),
+		k.GetCircuitBreakerConfigs(ctx),
 	)
 }
 
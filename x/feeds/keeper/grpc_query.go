@@ -44,7 +44,7 @@ func (q queryServer) CurrentFeeds(
 		)
 		feedWithDeviations = append(
 			feedWithDeviations,
-			types.NewFeedWithDeviation(feed.SignalID, feed.Power, feed.Interval, deviation),
+			types.NewFeedWithDeviation(feed.SignalID, feed.Power, feed.Interval, deviation, feed.AggregationMethod),
 		)
 	}
 
@@ -101,6 +101,23 @@ func (q queryServer) Price(
 	}, nil
 }
 
+// CircuitBreaker queries the circuit breaker configuration and state of a signal id.
+func (q queryServer) CircuitBreaker(
+	goCtx context.Context, req *types.QueryCircuitBreakerRequest,
+) (*types.QueryCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	state, found := q.keeper.GetCircuitBreakerState(ctx, req.SignalId)
+	if !found {
+		state = types.NewCircuitBreakerState(req.SignalId, 0, 0, 0)
+	}
+
+	return &types.QueryCircuitBreakerResponse{
+		Config: q.keeper.GetCircuitBreakerConfig(ctx, req.SignalId),
+		State:  state,
+	}, nil
+}
+
 // Prices queries prices by signal ids.
 func (q queryServer) Prices(
 	goCtx context.Context, req *types.QueryPricesRequest,
@@ -112,6 +129,79 @@ func (q queryServer) Prices(
 	}, nil
 }
 
+// PriceHistory queries the price history of a signal id within a time range.
+func (q queryServer) PriceHistory(
+	goCtx context.Context, req *types.QueryPriceHistoryRequest,
+) (*types.QueryPriceHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	endTime := req.EndTime
+	if endTime == 0 {
+		endTime = ctx.BlockTime().Unix()
+	}
+
+	if req.StartTime < 0 || req.StartTime > endTime {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid time range: %d - %d", req.StartTime, endTime)
+	}
+
+	// include the price that is effective at the start time
+	startTime := req.StartTime
+	if latest, found := q.keeper.GetLatestPriceHistory(ctx, req.SignalId, startTime); found {
+		startTime = latest.Timestamp
+	}
+
+	store := ctx.KVStore(q.keeper.storeKey)
+	priceHistoryStore := prefix.NewStore(store, types.PriceHistoryBySignalIDStoreKey(req.SignalId))
+
+	filteredPrices, pageRes, err := query.GenericFilteredPaginate(
+		q.keeper.cdc,
+		priceHistoryStore,
+		req.Pagination,
+		func(key []byte, price *types.Price) (*types.Price, error) {
+			if price.Timestamp < startTime || price.Timestamp > endTime {
+				return nil, nil
+			}
+
+			return price, nil
+		},
+		func() *types.Price {
+			return &types.Price{}
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	prices := make([]types.Price, 0, len(filteredPrices))
+	for _, price := range filteredPrices {
+		prices = append(prices, *price)
+	}
+
+	return &types.QueryPriceHistoryResponse{
+		Prices:     prices,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TWAP queries the time-weighted average price of a signal id over a duration.
+func (q queryServer) TWAP(
+	goCtx context.Context, req *types.QueryTWAPRequest,
+) (*types.QueryTWAPResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	price, startTime, err := q.keeper.GetTWAP(ctx, req.SignalId, req.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTWAPResponse{
+		SignalId:  req.SignalId,
+		Price:     price,
+		StartTime: startTime,
+		EndTime:   ctx.BlockTime().Unix(),
+	}, nil
+}
+
 // AllPrices queries all prices.
 func (q queryServer) AllPrices(
 	goCtx context.Context, req *types.QueryAllPricesRequest,
@@ -74,6 +74,35 @@ func (suite *KeeperTestSuite) TestQueryPrice() {
 	}, res)
 }
 
+func (suite *KeeperTestSuite) TestQueryCircuitBreaker() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	// setup
+	config := types.NewCircuitBreakerConfig("CS:BAND-USD", 1000, 60, 3)
+	state := types.NewCircuitBreakerState("CS:BAND-USD", 100000000, 1234567890, 1234567900)
+	suite.feedsKeeper.SetCircuitBreakerConfig(ctx, config)
+	suite.feedsKeeper.SetCircuitBreakerState(ctx, state)
+
+	// query and check
+	res, err := queryClient.CircuitBreaker(context.Background(), &types.QueryCircuitBreakerRequest{
+		SignalId: "CS:BAND-USD",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryCircuitBreakerResponse{
+		Config: config,
+		State:  state,
+	}, res)
+
+	res, err = queryClient.CircuitBreaker(context.Background(), &types.QueryCircuitBreakerRequest{
+		SignalId: "CS:ATOM-USD",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryCircuitBreakerResponse{
+		Config: types.CircuitBreakerConfig{SignalID: "CS:ATOM-USD"},
+		State:  types.CircuitBreakerState{SignalID: "CS:ATOM-USD"},
+	}, res)
+}
+
 func (suite *KeeperTestSuite) TestQueryPrices() {
 	ctx, queryClient := suite.ctx, suite.queryClient
 
@@ -500,3 +529,115 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 		Params: suite.feedsKeeper.GetParams(ctx),
 	}, res)
 }
+
+func (suite *KeeperTestSuite) TestQueryPriceHistory() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+	now := ctx.BlockTime().Unix()
+
+	// setup
+	prices := []types.Price{
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, now-300),
+		types.NewPrice(types.PRICE_STATUS_NOT_READY, "CS:BAND-USD", 0, now-200),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 3000, now-100),
+	}
+	for _, price := range prices {
+		suite.feedsKeeper.SetPriceHistory(ctx, price)
+	}
+
+	testCases := []struct {
+		name           string
+		req            *types.QueryPriceHistoryRequest
+		expectedPrices []types.Price
+		expectErr      bool
+	}{
+		{
+			name:           "all history",
+			req:            &types.QueryPriceHistoryRequest{SignalId: "CS:BAND-USD"},
+			expectedPrices: prices,
+		},
+		{
+			name: "include the price effective at start time",
+			req: &types.QueryPriceHistoryRequest{
+				SignalId:  "CS:BAND-USD",
+				StartTime: now - 150,
+				EndTime:   now - 150,
+			},
+			expectedPrices: prices[1:2],
+		},
+		{
+			name: "with pagination",
+			req: &types.QueryPriceHistoryRequest{
+				SignalId:   "CS:BAND-USD",
+				Pagination: &query.PageRequest{Limit: 2},
+			},
+			expectedPrices: prices[:2],
+		},
+		{
+			name:           "no history",
+			req:            &types.QueryPriceHistoryRequest{SignalId: "CS:ETH-USD"},
+			expectedPrices: nil,
+		},
+		{
+			name: "invalid time range",
+			req: &types.QueryPriceHistoryRequest{
+				SignalId:  "CS:BAND-USD",
+				StartTime: now,
+				EndTime:   now - 100,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := queryClient.PriceHistory(context.Background(), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedPrices, res.Prices)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryTWAP() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+	now := ctx.BlockTime().Unix()
+
+	// setup
+	suite.feedsKeeper.SetPriceHistory(
+		ctx,
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, now-200),
+	)
+	suite.feedsKeeper.SetPriceHistory(
+		ctx,
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 3000, now-50),
+	)
+
+	// query and check
+	res, err := queryClient.TWAP(context.Background(), &types.QueryTWAPRequest{
+		SignalId: "CS:BAND-USD",
+		Duration: 100,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryTWAPResponse{
+		SignalId:  "CS:BAND-USD",
+		Price:     2000,
+		StartTime: now - 100,
+		EndTime:   now,
+	}, res)
+
+	_, err = queryClient.TWAP(context.Background(), &types.QueryTWAPRequest{
+		SignalId: "CS:BAND-USD",
+		Duration: -1,
+	})
+	suite.Require().ErrorContains(err, types.ErrInvalidTWAPDuration.Error())
+
+	_, err = queryClient.TWAP(context.Background(), &types.QueryTWAPRequest{
+		SignalId: "CS:ETH-USD",
+		Duration: 100,
+	})
+	suite.Require().ErrorContains(err, types.ErrNotEnoughPriceHistory.Error())
+}
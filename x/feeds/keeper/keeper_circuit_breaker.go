@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// GetCircuitBreakerConfig returns the circuit breaker config of a signal id.
+// It returns an empty config if the signal id has no circuit breaker.
+func (k Keeper) GetCircuitBreakerConfig(ctx sdk.Context, signalID string) types.CircuitBreakerConfig {
+	bz := ctx.KVStore(k.storeKey).Get(types.CircuitBreakerConfigStoreKey(signalID))
+	if bz == nil {
+		return types.CircuitBreakerConfig{SignalID: signalID}
+	}
+
+	var config types.CircuitBreakerConfig
+	k.cdc.MustUnmarshal(bz, &config)
+
+	return config
+}
+
+// GetCircuitBreakerConfigs returns all circuit breaker configs.
+func (k Keeper) GetCircuitBreakerConfigs(ctx sdk.Context) (configs []types.CircuitBreakerConfig) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.CircuitBreakerConfigStoreKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var config types.CircuitBreakerConfig
+		k.cdc.MustUnmarshal(iterator.Value(), &config)
+		configs = append(configs, config)
+	}
+
+	return configs
+}
+
+// SetCircuitBreakerConfig sets the circuit breaker config of a signal id.
+// An empty config removes the circuit breaker and disabling the halting resets the circuit breaker state.
+func (k Keeper) SetCircuitBreakerConfig(ctx sdk.Context, config types.CircuitBreakerConfig) {
+	if config.MaxDeviationBasisPoint == 0 {
+		k.DeleteCircuitBreakerState(ctx, config.SignalID)
+	}
+
+	key := types.CircuitBreakerConfigStoreKey(config.SignalID)
+	if config.IsEmpty() {
+		ctx.KVStore(k.storeKey).Delete(key)
+		return
+	}
+
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&config))
+}
+
+// SetCircuitBreakerConfigs sets multiple circuit breaker configs and emits an event for each of them.
+func (k Keeper) SetCircuitBreakerConfigs(ctx sdk.Context, configs []types.CircuitBreakerConfig) {
+	for _, config := range configs {
+		k.SetCircuitBreakerConfig(ctx, config)
+		emitEventUpdateCircuitBreakerConfig(ctx, config)
+	}
+}
+
+// GetCircuitBreakerState returns the circuit breaker state of a signal id.
+func (k Keeper) GetCircuitBreakerState(ctx sdk.Context, signalID string) (types.CircuitBreakerState, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CircuitBreakerStateStoreKey(signalID))
+	if bz == nil {
+		return types.CircuitBreakerState{}, false
+	}
+
+	var state types.CircuitBreakerState
+	k.cdc.MustUnmarshal(bz, &state)
+
+	return state, true
+}
+
+// SetCircuitBreakerState sets the circuit breaker state of a signal id.
+func (k Keeper) SetCircuitBreakerState(ctx sdk.Context, state types.CircuitBreakerState) {
+	ctx.KVStore(k.storeKey).Set(types.CircuitBreakerStateStoreKey(state.SignalID), k.cdc.MustMarshal(&state))
+}
+
+// DeleteCircuitBreakerState deletes the circuit breaker state of a signal id.
+func (k Keeper) DeleteCircuitBreakerState(ctx sdk.Context, signalID string) {
+	ctx.KVStore(k.storeKey).Delete(types.CircuitBreakerStateStoreKey(signalID))
+}
+
+// PruneCircuitBreakerStates deletes the circuit breaker states of signal ids that are not in the given feeds.
+func (k Keeper) PruneCircuitBreakerStates(ctx sdk.Context, feeds []types.Feed) {
+	signalIDs := make(map[string]struct{}, len(feeds))
+	for _, feed := range feeds {
+		signalIDs[feed.SignalID] = struct{}{}
+	}
+
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.CircuitBreakerStateStoreKeyPrefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var state types.CircuitBreakerState
+		k.cdc.MustUnmarshal(iterator.Value(), &state)
+		if _, ok := signalIDs[state.SignalID]; !ok {
+			keys = append(keys, iterator.Key())
+		}
+	}
+
+	for _, key := range keys {
+		ctx.KVStore(k.storeKey).Delete(key)
+	}
+}
+
+// ApplyCircuitBreaker checks the calculated price against the circuit breaker of its signal id.
+// If the price deviates from the reference price by more than the maximum deviation, the reference price is
+// held with a halted status until the deviation persists for the confirmation window.
+func (k Keeper) ApplyCircuitBreaker(
+	ctx sdk.Context,
+	config types.CircuitBreakerConfig,
+	price types.Price,
+) types.Price {
+	if config.MaxDeviationBasisPoint == 0 || price.Status != types.PRICE_STATUS_AVAILABLE {
+		return price
+	}
+
+	state, found := k.GetCircuitBreakerState(ctx, price.SignalID)
+	deviation := types.CalculateDeviationBasisPoint(state.ReferencePrice, price.Price)
+
+	// publish the price and use it as the new reference if it is within the maximum deviation
+	// or the deviation has persisted for the confirmation window.
+	if !found || deviation.LTE(sdkmath.NewInt(config.MaxDeviationBasisPoint)) ||
+		(state.HaltedAt != 0 && price.Timestamp-state.HaltedAt >= config.ConfirmationWindow) {
+		k.SetCircuitBreakerState(ctx, types.NewCircuitBreakerState(price.SignalID, price.Price, price.Timestamp, 0))
+		return price
+	}
+
+	if state.HaltedAt == 0 {
+		state.HaltedAt = price.Timestamp
+		k.SetCircuitBreakerState(ctx, state)
+	}
+
+	// the held price is stamped with the current block time so that it is recorded as a new entry in
+	// the price history instead of replacing the published price at the reference timestamp.
+	return types.NewPrice(types.PRICE_STATUS_HALTED, price.SignalID, state.ReferencePrice, ctx.BlockTime().Unix())
+}
+
+// flagOutlierValidatorPrices emits an event naming the validators whose prices differ from the median
+// by more than the given number of standard deviations.
+func (k Keeper) flagOutlierValidatorPrices(
+	ctx sdk.Context,
+	signalID string,
+	validatorPriceInfos []types.ValidatorPriceInfo,
+	validators []sdk.ValAddress,
+	sigmaThreshold int64,
+) error {
+	median, err := types.MedianValidatorPriceInfos(validatorPriceInfos)
+	if err != nil {
+		return err
+	}
+
+	outliers := types.FindOutlierValidatorPriceInfos(validatorPriceInfos, median, sigmaThreshold)
+	if len(outliers) == 0 {
+		return nil
+	}
+
+	outlierValidators := make([]sdk.ValAddress, 0, len(outliers))
+	for _, idx := range outliers {
+		outlierValidators = append(outlierValidators, validators[idx])
+	}
+
+	emitEventOutlierValidatorPrices(ctx, signalID, median, outlierValidators)
+
+	return nil
+}
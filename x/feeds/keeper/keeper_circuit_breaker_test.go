@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func (suite *KeeperTestSuite) TestGetSetCircuitBreakerConfigs() {
+	ctx := suite.ctx
+
+	// default config
+	suite.Require().Equal(
+		types.CircuitBreakerConfig{SignalID: "CS:BAND-USD"},
+		suite.feedsKeeper.GetCircuitBreakerConfig(ctx, "CS:BAND-USD"),
+	)
+
+	// set
+	configs := []types.CircuitBreakerConfig{
+		types.NewCircuitBreakerConfig("CS:ATOM-USD", 0, 0, 3),
+		types.NewCircuitBreakerConfig("CS:BAND-USD", 1000, 60, 0),
+	}
+	suite.feedsKeeper.SetCircuitBreakerConfigs(ctx, configs)
+
+	// get
+	suite.Require().Equal(configs[1], suite.feedsKeeper.GetCircuitBreakerConfig(ctx, "CS:BAND-USD"))
+	suite.Require().Equal(configs, suite.feedsKeeper.GetCircuitBreakerConfigs(ctx))
+
+	// disabling the halting resets the state
+	suite.feedsKeeper.SetCircuitBreakerState(ctx, types.NewCircuitBreakerState("CS:BAND-USD", 1000, 1, 0))
+	suite.feedsKeeper.SetCircuitBreakerConfigs(ctx, []types.CircuitBreakerConfig{
+		types.NewCircuitBreakerConfig("CS:BAND-USD", 0, 0, 0),
+	})
+	suite.Require().Equal(configs[:1], suite.feedsKeeper.GetCircuitBreakerConfigs(ctx))
+	_, found := suite.feedsKeeper.GetCircuitBreakerState(ctx, "CS:BAND-USD")
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestApplyCircuitBreaker() {
+	ctx := suite.ctx
+	config := types.NewCircuitBreakerConfig("CS:BAND-USD", 1000, 60, 0)
+	startTime := ctx.BlockTime().Unix()
+
+	applyAt := func(offset int64, status types.PriceStatus, price uint64) types.Price {
+		blockTime := time.Unix(startTime+offset, 0)
+		return suite.feedsKeeper.ApplyCircuitBreaker(
+			ctx.WithBlockTime(blockTime),
+			config,
+			types.NewPrice(status, "CS:BAND-USD", price, blockTime.Unix()),
+		)
+	}
+
+	// first price is published as the reference
+	suite.Require().Equal(
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, startTime),
+		applyAt(0, types.PRICE_STATUS_AVAILABLE, 1000),
+	)
+
+	// price within the maximum deviation is published
+	suite.Require().Equal(
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1100, startTime+10),
+		applyAt(10, types.PRICE_STATUS_AVAILABLE, 1100),
+	)
+
+	// price beyond the maximum deviation is halted
+	suite.Require().Equal(
+		types.NewPrice(types.PRICE_STATUS_HALTED, "CS:BAND-USD", 1100, startTime+20),
+		applyAt(20, types.PRICE_STATUS_AVAILABLE, 2000),
+	)
+	state, found := suite.feedsKeeper.GetCircuitBreakerState(ctx, "CS:BAND-USD")
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewCircuitBreakerState("CS:BAND-USD", 1100, startTime+10, startTime+20), state)
+
+	// not available price is not affected
+	suite.Require().Equal(
+		types.NewPrice(types.PRICE_STATUS_NOT_READY, "CS:BAND-USD", 0, startTime+30),
+		applyAt(30, types.PRICE_STATUS_NOT_READY, 0),
+	)
+
+	// price is still halted within the confirmation window
+	suite.Require().Equal(
+		types.NewPrice(types.PRICE_STATUS_HALTED, "CS:BAND-USD", 1100, startTime+79),
+		applyAt(79, types.PRICE_STATUS_AVAILABLE, 2000),
+	)
+
+	// price is published after the confirmation window
+	suite.Require().Equal(
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 2000, startTime+80),
+		applyAt(80, types.PRICE_STATUS_AVAILABLE, 2000),
+	)
+	state, found = suite.feedsKeeper.GetCircuitBreakerState(ctx, "CS:BAND-USD")
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewCircuitBreakerState("CS:BAND-USD", 2000, startTime+80, 0), state)
+
+	// halted price recovers when the price returns within the maximum deviation
+	suite.Require().Equal(types.PRICE_STATUS_HALTED, applyAt(90, types.PRICE_STATUS_AVAILABLE, 1000).Status)
+	suite.Require().Equal(
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 2100, startTime+100),
+		applyAt(100, types.PRICE_STATUS_AVAILABLE, 2100),
+	)
+	state, _ = suite.feedsKeeper.GetCircuitBreakerState(ctx, "CS:BAND-USD")
+	suite.Require().Equal(int64(0), state.HaltedAt)
+}
+
+func (suite *KeeperTestSuite) TestApplyCircuitBreakerTWAP() {
+	ctx := suite.ctx
+	config := types.NewCircuitBreakerConfig("CS:BAND-USD", 1000, 60, 0)
+	startTime := ctx.BlockTime().Unix()
+
+	// apply the circuit breaker and record the price history as the end block does
+	recordAt := func(offset int64, price uint64) {
+		blockCtx := ctx.WithBlockTime(time.Unix(startTime+offset, 0))
+		p := suite.feedsKeeper.ApplyCircuitBreaker(
+			blockCtx,
+			config,
+			types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", price, startTime+offset),
+		)
+		suite.feedsKeeper.RecordPriceHistory(blockCtx, p)
+	}
+
+	recordAt(0, 1000)
+	recordAt(10, 1050)
+	// the breaker is tripped; the published prices stay in the history
+	recordAt(20, 2000)
+	recordAt(30, 2000)
+
+	ctx = ctx.WithBlockTime(time.Unix(startTime+40, 0))
+	history := suite.feedsKeeper.GetPriceHistory(ctx, "CS:BAND-USD", 0, startTime+40)
+	suite.Require().Equal([]types.Price{
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, startTime),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1050, startTime+10),
+		types.NewPrice(types.PRICE_STATUS_HALTED, "CS:BAND-USD", 1050, startTime+20),
+	}, history)
+
+	// the halted interval is left out of the TWAP, but the published intervals before it are kept
+	twap, _, err := suite.feedsKeeper.GetTWAP(ctx, "CS:BAND-USD", 40)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1025), twap)
+}
+
+func (suite *KeeperTestSuite) TestApplyCircuitBreakerDisabled() {
+	ctx := suite.ctx
+	price := types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, ctx.BlockTime().Unix())
+
+	suite.Require().Equal(
+		price,
+		suite.feedsKeeper.ApplyCircuitBreaker(ctx, types.CircuitBreakerConfig{SignalID: "CS:BAND-USD"}, price),
+	)
+	_, found := suite.feedsKeeper.GetCircuitBreakerState(ctx, "CS:BAND-USD")
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestPruneCircuitBreakerStates() {
+	ctx := suite.ctx
+
+	suite.feedsKeeper.SetCircuitBreakerState(ctx, types.NewCircuitBreakerState("CS:ATOM-USD", 10, 1, 0))
+	suite.feedsKeeper.SetCircuitBreakerState(ctx, types.NewCircuitBreakerState("CS:BAND-USD", 1000, 1, 0))
+
+	suite.feedsKeeper.PruneCircuitBreakerStates(ctx, []types.Feed{{SignalID: "CS:BAND-USD", Interval: 60}})
+
+	_, found := suite.feedsKeeper.GetCircuitBreakerState(ctx, "CS:ATOM-USD")
+	suite.Require().False(found)
+	_, found = suite.feedsKeeper.GetCircuitBreakerState(ctx, "CS:BAND-USD")
+	suite.Require().True(found)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bandprotocol/chain/v3/x/feeds/types"
@@ -47,6 +49,7 @@ func (k Keeper) CalculateNewCurrentFeeds(ctx sdk.Context) []types.Feed {
 					signalTotalPower.ID,
 					signalTotalPower.Power,
 					interval,
+					k.GetFeedAggregationMethod(ctx, signalTotalPower.ID),
 				),
 			)
 		}
@@ -54,3 +57,58 @@ func (k Keeper) CalculateNewCurrentFeeds(ctx sdk.Context) []types.Feed {
 
 	return feeds
 }
+
+// GetFeedAggregationMethod returns the aggregation method of a signal id.
+func (k Keeper) GetFeedAggregationMethod(ctx sdk.Context, signalID string) types.AggregationMethod {
+	bz := ctx.KVStore(k.storeKey).Get(types.AggregationMethodStoreKey(signalID))
+	if bz == nil {
+		return types.AGGREGATION_METHOD_UNSPECIFIED
+	}
+
+	var fam types.FeedAggregationMethod
+	k.cdc.MustUnmarshal(bz, &fam)
+
+	return fam.AggregationMethod
+}
+
+// GetFeedAggregationMethods returns all aggregation methods of signal ids that do not use the default method.
+func (k Keeper) GetFeedAggregationMethods(ctx sdk.Context) (fams []types.FeedAggregationMethod) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AggregationMethodStoreKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var fam types.FeedAggregationMethod
+		k.cdc.MustUnmarshal(iterator.Value(), &fam)
+		fams = append(fams, fam)
+	}
+
+	return fams
+}
+
+// SetFeedAggregationMethod sets the aggregation method of a signal id.
+// The unspecified aggregation method removes the configuration so that the default method is used.
+func (k Keeper) SetFeedAggregationMethod(ctx sdk.Context, fam types.FeedAggregationMethod) {
+	key := types.AggregationMethodStoreKey(fam.SignalID)
+	if fam.AggregationMethod == types.AGGREGATION_METHOD_UNSPECIFIED {
+		ctx.KVStore(k.storeKey).Delete(key)
+		return
+	}
+
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&fam))
+}
+
+// SetFeedAggregationMethods sets the aggregation methods of signal ids and applies them to the current feeds
+// without changing the last update time and block of the current feeds.
+func (k Keeper) SetFeedAggregationMethods(ctx sdk.Context, fams []types.FeedAggregationMethod) {
+	for _, fam := range fams {
+		k.SetFeedAggregationMethod(ctx, fam)
+		emitEventUpdateAggregationMethod(ctx, fam)
+	}
+
+	currentFeeds := k.GetCurrentFeeds(ctx)
+	for i, feed := range currentFeeds.Feeds {
+		currentFeeds.Feeds[i].AggregationMethod = k.GetFeedAggregationMethod(ctx, feed.SignalID)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.CurrentFeedsStoreKey, k.cdc.MustMarshal(&currentFeeds))
+}
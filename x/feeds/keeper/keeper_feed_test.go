@@ -49,3 +49,78 @@ func (suite *KeeperTestSuite) TestCalculateNewCurrentFeeds() {
 		},
 	}, feeds)
 }
+
+func (suite *KeeperTestSuite) TestGetSetFeedAggregationMethods() {
+	ctx := suite.ctx
+
+	// default aggregation method
+	suite.Require().Equal(
+		types.AGGREGATION_METHOD_UNSPECIFIED,
+		suite.feedsKeeper.GetFeedAggregationMethod(ctx, "CS:BAND-USD"),
+	)
+
+	// set
+	suite.feedsKeeper.SetCurrentFeeds(ctx, []types.Feed{
+		{SignalID: "CS:BAND-USD", Power: 60000000000, Interval: 60},
+		{SignalID: "CS:ATOM-USD", Power: 30000000000, Interval: 120},
+	})
+	lastUpdateBlock := suite.feedsKeeper.GetCurrentFeeds(ctx).LastUpdateBlock
+
+	suite.feedsKeeper.SetFeedAggregationMethods(ctx.WithBlockHeight(ctx.BlockHeight()+1), []types.FeedAggregationMethod{
+		types.NewFeedAggregationMethod("CS:BAND-USD", types.AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN),
+		types.NewFeedAggregationMethod("CS:ETH-USD", types.AGGREGATION_METHOD_WEIGHTED_MEAN),
+	})
+
+	// get
+	suite.Require().Equal(
+		types.AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN,
+		suite.feedsKeeper.GetFeedAggregationMethod(ctx, "CS:BAND-USD"),
+	)
+	suite.Require().Equal([]types.FeedAggregationMethod{
+		types.NewFeedAggregationMethod("CS:BAND-USD", types.AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN),
+		types.NewFeedAggregationMethod("CS:ETH-USD", types.AGGREGATION_METHOD_WEIGHTED_MEAN),
+	}, suite.feedsKeeper.GetFeedAggregationMethods(ctx))
+
+	// current feeds are updated in place
+	currentFeeds := suite.feedsKeeper.GetCurrentFeeds(ctx)
+	suite.Require().Equal(lastUpdateBlock, currentFeeds.LastUpdateBlock)
+	suite.Require().Equal([]types.Feed{
+		{
+			SignalID:          "CS:BAND-USD",
+			Power:             60000000000,
+			Interval:          60,
+			AggregationMethod: types.AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN,
+		},
+		{SignalID: "CS:ATOM-USD", Power: 30000000000, Interval: 120},
+	}, currentFeeds.Feeds)
+
+	// reset to default
+	suite.feedsKeeper.SetFeedAggregationMethods(ctx, []types.FeedAggregationMethod{
+		types.NewFeedAggregationMethod("CS:BAND-USD", types.AGGREGATION_METHOD_UNSPECIFIED),
+	})
+	suite.Require().Equal([]types.FeedAggregationMethod{
+		types.NewFeedAggregationMethod("CS:ETH-USD", types.AGGREGATION_METHOD_WEIGHTED_MEAN),
+	}, suite.feedsKeeper.GetFeedAggregationMethods(ctx))
+	suite.Require().Equal(
+		types.AGGREGATION_METHOD_UNSPECIFIED,
+		suite.feedsKeeper.GetCurrentFeeds(ctx).Feeds[0].AggregationMethod,
+	)
+}
+
+func (suite *KeeperTestSuite) TestCalculateNewCurrentFeedsWithAggregationMethod() {
+	ctx := suite.ctx
+
+	suite.feedsKeeper.SetSignalTotalPower(ctx, types.Signal{
+		ID:    "CS:BAND-USD",
+		Power: 60000000000,
+	})
+	suite.feedsKeeper.SetFeedAggregationMethod(
+		ctx,
+		types.NewFeedAggregationMethod("CS:BAND-USD", types.AGGREGATION_METHOD_WEIGHTED_MEAN),
+	)
+
+	feeds := suite.feedsKeeper.CalculateNewCurrentFeeds(ctx)
+	suite.Require().Equal([]types.Feed{
+		types.NewFeed("CS:BAND-USD", 60000000000, 60, types.AGGREGATION_METHOD_WEIGHTED_MEAN),
+	}, feeds)
+}
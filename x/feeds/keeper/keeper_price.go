@@ -176,9 +176,12 @@ func (k Keeper) CalculatePrices(ctx sdk.Context) error {
 		return err
 	}
 	powerQuorum := totalBondedToken.Mul(priceQuorum).TruncateInt()
+	// a validator that misses the reports of several feeds is recorded as missing once per block
+	missedValidators := make(map[string]bool)
 	// calculate prices for each feed
 	for _, feed := range currentFeeds.Feeds {
 		var validatorPriceInfos []types.ValidatorPriceInfo
+		var validators []sdk.ValAddress
 		for _, valInfo := range validatorsByPower {
 			valPrice := allValidatorPrices[valInfo.Address.String()][feed.SignalID]
 
@@ -193,8 +196,9 @@ func (k Keeper) CalculatePrices(ctx sdk.Context) error {
 				ctx.BlockHeight(),
 				gracePeriod,
 			)
-			if missReport {
+			if missReport && !missedValidators[valInfo.Address.String()] {
 				k.oracleKeeper.MissReport(ctx, valInfo.Address, ctx.BlockTime())
+				missedValidators[valInfo.Address.String()] = true
 			}
 
 			// check if the price is available
@@ -208,6 +212,7 @@ func (k Keeper) CalculatePrices(ctx sdk.Context) error {
 						valPrice.Timestamp,
 					),
 				)
+				validators = append(validators, valInfo.Address)
 			}
 		}
 
@@ -217,17 +222,57 @@ func (k Keeper) CalculatePrices(ctx sdk.Context) error {
 			return err
 		}
 
+		config := k.GetCircuitBreakerConfig(ctx, feed.SignalID)
+
+		// flag validators whose prices are far from the median
+		if config.OutlierSigmaThreshold > 0 && price.Status == types.PRICE_STATUS_AVAILABLE {
+			err := k.flagOutlierValidatorPrices(
+				ctx,
+				feed.SignalID,
+				validatorPriceInfos,
+				validators,
+				config.OutlierSigmaThreshold,
+			)
+			if err != nil {
+				k.Logger(ctx).Error(
+					"failed to flag outlier validator prices",
+					"signal_id", feed.SignalID,
+					"error", err,
+				)
+			}
+		}
+
+		// hold the previous price if the circuit breaker is tripped
+		price = k.ApplyCircuitBreaker(ctx, config, price)
+
 		// set the calculated price in the store
 		k.SetPrice(ctx, price)
 
+		// record the price in the price history and prune the outdated ones
+		if params.PriceHistoryRetention > 0 {
+			k.RecordPriceHistory(ctx, price)
+			k.PrunePriceHistory(ctx, feed.SignalID, ctx.BlockTime().Unix()-params.PriceHistoryRetention, true)
+			k.TrimPriceHistory(ctx, feed.SignalID, params.MaxPriceHistoryEntries)
+		}
+
 		// emit event for updated price
 		emitEventUpdatePrice(ctx, price)
 	}
 
+	// the other validators are recorded as fulfilling their reports in this block, so that the oracle report
+	// window of a validator slides with every block rather than only with its misses
+	if len(currentFeeds.Feeds) > 0 {
+		for _, valInfo := range validatorsByPower {
+			if !missedValidators[valInfo.Address.String()] {
+				k.oracleKeeper.FulfillReport(ctx, valInfo.Address, ctx.BlockTime())
+			}
+		}
+	}
+
 	return nil
 }
 
-// CalculatePrice calculates the final price from validator prices and punishes validators who did not report.
+// CalculatePrice calculates the final price from validator prices by the aggregation method of the feed.
 func (k Keeper) CalculatePrice(
 	ctx sdk.Context,
 	feed types.Feed,
@@ -258,7 +303,7 @@ func (k Keeper) CalculatePrice(
 		), nil
 	}
 
-	price, err := types.MedianValidatorPriceInfos(validatorPriceInfos)
+	price, err := types.AggregateValidatorPriceInfos(feed.AggregationMethod, validatorPriceInfos)
 	if err != nil {
 		// should not happen
 		return types.Price{}, err
@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// SetPriceHistory sets a price to the price history of its signal id.
+func (k Keeper) SetPriceHistory(ctx sdk.Context, price types.Price) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.PriceHistoryStoreKey(price.SignalID, price.Timestamp)
+	if !store.Has(key) {
+		k.setPriceHistoryCount(ctx, price.SignalID, k.GetPriceHistoryCount(ctx, price.SignalID)+1)
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&price))
+}
+
+// GetPriceHistoryCount returns the number of historical prices of a signal id.
+func (k Keeper) GetPriceHistoryCount(ctx sdk.Context, signalID string) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.PriceHistoryCountStoreKey(signalID))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setPriceHistoryCount sets the number of historical prices of a signal id.
+func (k Keeper) setPriceHistoryCount(ctx sdk.Context, signalID string, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if count == 0 {
+		store.Delete(types.PriceHistoryCountStoreKey(signalID))
+		return
+	}
+
+	store.Set(types.PriceHistoryCountStoreKey(signalID), sdk.Uint64ToBigEndian(count))
+}
+
+// deletePriceHistories deletes the given historical price keys of a signal id.
+func (k Keeper) deletePriceHistories(ctx sdk.Context, signalID string, keys [][]byte) {
+	if len(keys) == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	count := k.GetPriceHistoryCount(ctx, signalID)
+	k.setPriceHistoryCount(ctx, signalID, count-min(count, uint64(len(keys))))
+}
+
+// GetLatestPriceHistory returns the latest historical price of a signal id at or before the given timestamp.
+func (k Keeper) GetLatestPriceHistory(ctx sdk.Context, signalID string, timestamp int64) (types.Price, bool) {
+	iterator := ctx.KVStore(k.storeKey).ReverseIterator(
+		types.PriceHistoryBySignalIDStoreKey(signalID),
+		types.PriceHistoryStoreKey(signalID, timestamp+1),
+	)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.Price{}, false
+	}
+
+	var price types.Price
+	k.cdc.MustUnmarshal(iterator.Value(), &price)
+
+	return price, true
+}
+
+// GetPriceHistory returns historical prices of a signal id between startTime and endTime (inclusive),
+// together with the price that is effective at startTime.
+func (k Keeper) GetPriceHistory(ctx sdk.Context, signalID string, startTime int64, endTime int64) []types.Price {
+	prices := []types.Price{}
+	if latest, found := k.GetLatestPriceHistory(ctx, signalID, startTime); found {
+		prices = append(prices, latest)
+	}
+
+	iterator := ctx.KVStore(k.storeKey).Iterator(
+		types.PriceHistoryStoreKey(signalID, startTime+1),
+		types.PriceHistoryStoreKey(signalID, endTime+1),
+	)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.Price
+		k.cdc.MustUnmarshal(iterator.Value(), &price)
+		prices = append(prices, price)
+	}
+
+	return prices
+}
+
+// RecordPriceHistory records a price to the price history if its status or value differs from
+// the latest recorded price of the signal id.
+func (k Keeper) RecordPriceHistory(ctx sdk.Context, price types.Price) {
+	latest, found := k.GetLatestPriceHistory(ctx, price.SignalID, price.Timestamp)
+	if found && latest.Status == price.Status && latest.Price == price.Price {
+		return
+	}
+
+	k.SetPriceHistory(ctx, price)
+}
+
+// PrunePriceHistory deletes historical prices of a signal id older than the cutoff timestamp.
+// If keepLatest is true, the latest price before the cutoff is kept as it is still effective at the cutoff.
+func (k Keeper) PrunePriceHistory(ctx sdk.Context, signalID string, cutoff int64, keepLatest bool) {
+	iterator := ctx.KVStore(k.storeKey).ReverseIterator(
+		types.PriceHistoryBySignalIDStoreKey(signalID),
+		types.PriceHistoryStoreKey(signalID, cutoff),
+	)
+	defer iterator.Close()
+
+	if keepLatest && iterator.Valid() {
+		iterator.Next()
+	}
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	k.deletePriceHistories(ctx, signalID, keys)
+}
+
+// TrimPriceHistory deletes the oldest historical prices of a signal id so that at most maxEntries
+// entries are kept.
+func (k Keeper) TrimPriceHistory(ctx sdk.Context, signalID string, maxEntries uint64) {
+	count := k.GetPriceHistoryCount(ctx, signalID)
+	if count <= maxEntries {
+		return
+	}
+
+	iterator := storetypes.KVStorePrefixIterator(
+		ctx.KVStore(k.storeKey),
+		types.PriceHistoryBySignalIDStoreKey(signalID),
+	)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid() && uint64(len(keys)) < count-maxEntries; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	k.deletePriceHistories(ctx, signalID, keys)
+}
+
+// PruneAllPriceHistory deletes historical prices of all signal ids older than the cutoff timestamp.
+// The latest price before the cutoff is only kept for signal ids in the current feeds.
+func (k Keeper) PruneAllPriceHistory(ctx sdk.Context, cutoff int64) {
+	currentSignalIDs := make(map[string]bool)
+	for _, feed := range k.GetCurrentFeeds(ctx).Feeds {
+		currentSignalIDs[feed.SignalID] = true
+	}
+
+	for _, signalID := range k.getPriceHistorySignalIDs(ctx) {
+		k.PrunePriceHistory(ctx, signalID, cutoff, currentSignalIDs[signalID])
+	}
+}
+
+// getPriceHistorySignalIDs returns all signal ids that have a price history.
+func (k Keeper) getPriceHistorySignalIDs(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+
+	var signalIDs []string
+	start := types.PriceHistoryStoreKeyPrefix
+	end := storetypes.PrefixEndBytes(types.PriceHistoryStoreKeyPrefix)
+	for {
+		iterator := store.Iterator(start, end)
+		if !iterator.Valid() {
+			iterator.Close()
+			break
+		}
+
+		key := iterator.Key()
+		iterator.Close()
+
+		// key format: prefix | signalIDLen (1 byte) | signalID | timestamp (8 bytes)
+		signalIDLen := int(key[1])
+		signalID := string(key[2 : 2+signalIDLen])
+		signalIDs = append(signalIDs, signalID)
+
+		// skip to the next signal id
+		start = storetypes.PrefixEndBytes(types.PriceHistoryBySignalIDStoreKey(signalID))
+	}
+
+	return signalIDs
+}
+
+// GetTWAP returns the time-weighted average price of a signal id over the given duration ending at the
+// current block time, and the start time of the window covered by the price history.
+func (k Keeper) GetTWAP(ctx sdk.Context, signalID string, duration int64) (uint64, int64, error) {
+	retention := k.GetParams(ctx).PriceHistoryRetention
+	if duration <= 0 || duration > retention {
+		return 0, 0, types.ErrInvalidTWAPDuration.Wrapf(
+			"duration must be between 1 and %d seconds: %d", retention, duration,
+		)
+	}
+
+	endTime := ctx.BlockTime().Unix()
+	startTime := endTime - duration
+
+	prices := k.GetPriceHistory(ctx, signalID, startTime, endTime)
+	price, err := types.CalculateTWAP(prices, startTime, endTime)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return price, max(startTime, prices[0].Timestamp), nil
+}
@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func (suite *KeeperTestSuite) TestGetSetPriceHistory() {
+	ctx := suite.ctx
+	now := ctx.BlockTime().Unix()
+
+	prices := []types.Price{
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, now-300),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 2000, now-200),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 3000, now-100),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:ETH-USD", 4000, now-150),
+	}
+	for _, price := range prices {
+		suite.feedsKeeper.SetPriceHistory(ctx, price)
+	}
+
+	// get latest price at or before the given timestamp
+	latest, found := suite.feedsKeeper.GetLatestPriceHistory(ctx, "CS:BAND-USD", now-150)
+	suite.Require().True(found)
+	suite.Require().Equal(prices[1], latest)
+
+	latest, found = suite.feedsKeeper.GetLatestPriceHistory(ctx, "CS:BAND-USD", now-200)
+	suite.Require().True(found)
+	suite.Require().Equal(prices[1], latest)
+
+	_, found = suite.feedsKeeper.GetLatestPriceHistory(ctx, "CS:BAND-USD", now-301)
+	suite.Require().False(found)
+
+	// get price history including the price effective at the start time
+	history := suite.feedsKeeper.GetPriceHistory(ctx, "CS:BAND-USD", now-250, now)
+	suite.Require().Equal(prices[:3], history)
+
+	history = suite.feedsKeeper.GetPriceHistory(ctx, "CS:BAND-USD", now-400, now-150)
+	suite.Require().Equal(prices[:2], history)
+
+	history = suite.feedsKeeper.GetPriceHistory(ctx, "CS:BTC-USD", now-400, now)
+	suite.Require().Empty(history)
+}
+
+func (suite *KeeperTestSuite) TestRecordPriceHistory() {
+	ctx := suite.ctx
+	now := ctx.BlockTime().Unix()
+
+	suite.feedsKeeper.RecordPriceHistory(
+		ctx,
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, now-20),
+	)
+
+	// same price should not be recorded
+	suite.feedsKeeper.RecordPriceHistory(
+		ctx,
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, now-10),
+	)
+
+	// changed price should be recorded
+	suite.feedsKeeper.RecordPriceHistory(
+		ctx,
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 2000, now),
+	)
+
+	history := suite.feedsKeeper.GetPriceHistory(ctx, "CS:BAND-USD", 0, now)
+	suite.Require().Equal([]types.Price{
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, now-20),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 2000, now),
+	}, history)
+}
+
+func (suite *KeeperTestSuite) TestPrunePriceHistory() {
+	ctx := suite.ctx
+	now := ctx.BlockTime().Unix()
+
+	for i := int64(0); i < 5; i++ {
+		suite.feedsKeeper.SetPriceHistory(
+			ctx,
+			types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", uint64(1000+i), now-100*(4-i)),
+		)
+		suite.feedsKeeper.SetPriceHistory(
+			ctx,
+			types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:ETH-USD", uint64(2000+i), now-100*(4-i)),
+		)
+	}
+
+	// keep the latest price before the cutoff
+	suite.feedsKeeper.PrunePriceHistory(ctx, "CS:BAND-USD", now-150, true)
+	history := suite.feedsKeeper.GetPriceHistory(ctx, "CS:BAND-USD", 0, now)
+	suite.Require().Equal([]types.Price{
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1002, now-200),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1003, now-100),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1004, now),
+	}, history)
+
+	// other signals are not affected
+	history = suite.feedsKeeper.GetPriceHistory(ctx, "CS:ETH-USD", 0, now)
+	suite.Require().Len(history, 5)
+
+	// prune all signals; none of them is in the current feeds
+	suite.feedsKeeper.PruneAllPriceHistory(ctx, now-150)
+	history = suite.feedsKeeper.GetPriceHistory(ctx, "CS:BAND-USD", 0, now)
+	suite.Require().Equal([]types.Price{
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1003, now-100),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1004, now),
+	}, history)
+
+	history = suite.feedsKeeper.GetPriceHistory(ctx, "CS:ETH-USD", 0, now)
+	suite.Require().Equal([]types.Price{
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:ETH-USD", 2003, now-100),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:ETH-USD", 2004, now),
+	}, history)
+	suite.Require().Equal(uint64(2), suite.feedsKeeper.GetPriceHistoryCount(ctx, "CS:ETH-USD"))
+}
+
+func (suite *KeeperTestSuite) TestTrimPriceHistory() {
+	ctx := suite.ctx
+	now := ctx.BlockTime().Unix()
+
+	for i := int64(0); i < 5; i++ {
+		suite.feedsKeeper.SetPriceHistory(
+			ctx,
+			types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", uint64(1000+i), now-10*(4-i)),
+		)
+	}
+	// overwriting an entry does not change the count
+	suite.feedsKeeper.SetPriceHistory(ctx, types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1004, now))
+	suite.Require().Equal(uint64(5), suite.feedsKeeper.GetPriceHistoryCount(ctx, "CS:BAND-USD"))
+
+	// nothing is deleted when the count is within the limit
+	suite.feedsKeeper.TrimPriceHistory(ctx, "CS:BAND-USD", 5)
+	suite.Require().Len(suite.feedsKeeper.GetPriceHistory(ctx, "CS:BAND-USD", 0, now), 5)
+
+	// the oldest entries are deleted
+	suite.feedsKeeper.TrimPriceHistory(ctx, "CS:BAND-USD", 2)
+	suite.Require().Equal([]types.Price{
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1003, now-10),
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1004, now),
+	}, suite.feedsKeeper.GetPriceHistory(ctx, "CS:BAND-USD", 0, now))
+	suite.Require().Equal(uint64(2), suite.feedsKeeper.GetPriceHistoryCount(ctx, "CS:BAND-USD"))
+}
+
+func (suite *KeeperTestSuite) TestPruneAllPriceHistoryKeepCurrentFeeds() {
+	ctx := suite.ctx
+	now := ctx.BlockTime().Unix()
+
+	suite.feedsKeeper.SetCurrentFeeds(ctx, []types.Feed{
+		{SignalID: "CS:BAND-USD", Power: 1e10, Interval: 60},
+	})
+	suite.feedsKeeper.SetPriceHistory(
+		ctx,
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, now-200),
+	)
+	suite.feedsKeeper.SetPriceHistory(
+		ctx,
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:ETH-USD", 2000, now-200),
+	)
+
+	suite.feedsKeeper.PruneAllPriceHistory(ctx, now-100)
+
+	history := suite.feedsKeeper.GetPriceHistory(ctx, "CS:BAND-USD", 0, now)
+	suite.Require().Equal([]types.Price{
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, now-200),
+	}, history)
+
+	history = suite.feedsKeeper.GetPriceHistory(ctx, "CS:ETH-USD", 0, now)
+	suite.Require().Empty(history)
+}
+
+func (suite *KeeperTestSuite) TestGetTWAP() {
+	ctx := suite.ctx
+	now := ctx.BlockTime().Unix()
+
+	suite.feedsKeeper.SetPriceHistory(
+		ctx,
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, now-200),
+	)
+	suite.feedsKeeper.SetPriceHistory(
+		ctx,
+		types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 2000, now-50),
+	)
+
+	price, startTime, err := suite.feedsKeeper.GetTWAP(ctx, "CS:BAND-USD", 100)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1500), price)
+	suite.Require().Equal(now-100, startTime)
+
+	price, startTime, err = suite.feedsKeeper.GetTWAP(ctx, "CS:BAND-USD", 400)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1250), price)
+	suite.Require().Equal(now-200, startTime)
+
+	// invalid duration
+	_, _, err = suite.feedsKeeper.GetTWAP(ctx, "CS:BAND-USD", 0)
+	suite.Require().ErrorIs(err, types.ErrInvalidTWAPDuration)
+
+	_, _, err = suite.feedsKeeper.GetTWAP(ctx, "CS:BAND-USD", types.DefaultPriceHistoryRetention+1)
+	suite.Require().ErrorIs(err, types.ErrInvalidTWAPDuration)
+
+	// no history
+	_, _, err = suite.feedsKeeper.GetTWAP(ctx, "CS:ETH-USD", 100)
+	suite.Require().ErrorIs(err, types.ErrNotEnoughPriceHistory)
+}
@@ -165,6 +165,10 @@ func (suite *KeeperTestSuite) TestCalculatePrices() {
 
 				// Mock bonded tokens and quorum
 				suite.stakingKeeper.EXPECT().TotalBondedTokens(ctx).Return(sdkmath.NewInt(11000), nil)
+
+				// Both validators have reported in this block
+				suite.oracleKeeper.EXPECT().FulfillReport(ctx, ValidValidator, ctx.BlockTime())
+				suite.oracleKeeper.EXPECT().FulfillReport(ctx, ValidValidator2, ctx.BlockTime())
 			},
 			expectError: false,
 			expectedPrices: []types.Price{
@@ -224,6 +228,75 @@ func (suite *KeeperTestSuite) TestCalculatePrices() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCalculatePricesMissReportOncePerBlock() {
+	ctx := suite.ctx
+	suite.feedsKeeper.SetCurrentFeeds(ctx, []types.Feed{
+		{SignalID: "CS:BAND-USD", Interval: 60},
+		{SignalID: "CS:ATOM-USD", Interval: 60},
+		{SignalID: "CS:OSMO-USD", Interval: 60},
+	})
+
+	// the validator has not reported any of the feeds long after the grace period
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithBlockHeight(ctx.BlockHeight() + 3600)
+	suite.stakingKeeper.EXPECT().
+		IterateBondedValidatorsByPower(ctx, gomock.Any()).
+		DoAndReturn(func(ctx sdk.Context, fn func(index int64, validator stakingtypes.ValidatorI) bool) error {
+			fn(0, stakingtypes.Validator{OperatorAddress: ValidValidator.String(), Tokens: sdkmath.NewInt(5000)})
+			return nil
+		})
+	suite.stakingKeeper.EXPECT().TotalBondedTokens(ctx).Return(sdkmath.NewInt(5000), nil)
+	suite.oracleKeeper.EXPECT().MissReport(ctx, ValidValidator, ctx.BlockTime()).Times(1)
+
+	err := suite.feedsKeeper.CalculatePrices(ctx)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestCalculatePricesMissThenRecover() {
+	ctx := suite.ctx
+	suite.feedsKeeper.SetCurrentFeeds(ctx, []types.Feed{
+		{SignalID: "CS:BAND-USD", Interval: 60},
+	})
+	mockValidators := func(ctx sdk.Context) {
+		suite.stakingKeeper.EXPECT().
+			IterateBondedValidatorsByPower(ctx, gomock.Any()).
+			DoAndReturn(func(ctx sdk.Context, fn func(index int64, validator stakingtypes.ValidatorI) bool) error {
+				fn(0, stakingtypes.Validator{OperatorAddress: ValidValidator.String(), Tokens: sdkmath.NewInt(5000)})
+				return nil
+			})
+		suite.stakingKeeper.EXPECT().TotalBondedTokens(ctx).Return(sdkmath.NewInt(5000), nil)
+	}
+
+	// the validator has not reported long after the grace period, so it misses the block
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithBlockHeight(ctx.BlockHeight() + 3600)
+	mockValidators(ctx)
+	suite.oracleKeeper.EXPECT().MissReport(ctx, ValidValidator, ctx.BlockTime())
+
+	err := suite.feedsKeeper.CalculatePrices(ctx)
+	suite.Require().NoError(err)
+
+	// once it reports again, every block is recorded as fulfilled so that the miss slides out of its window
+	err = suite.feedsKeeper.SetValidatorPriceList(ctx, ValidValidator, []types.ValidatorPrice{
+		{
+			SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE,
+			SignalID:          "CS:BAND-USD",
+			Price:             1000,
+			Timestamp:         ctx.BlockTime().Unix(),
+			BlockHeight:       ctx.BlockHeight(),
+		},
+	})
+	suite.Require().NoError(err)
+
+	for i := int64(1); i <= 3; i++ {
+		ctx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(i) * time.Second)).
+			WithBlockHeight(ctx.BlockHeight() + i)
+		mockValidators(ctx)
+		suite.oracleKeeper.EXPECT().FulfillReport(ctx, ValidValidator, ctx.BlockTime())
+
+		err := suite.feedsKeeper.CalculatePrices(ctx)
+		suite.Require().NoError(err)
+	}
+}
+
 func (suite *KeeperTestSuite) TestCalculatePrice() {
 	ctx := suite.ctx
 
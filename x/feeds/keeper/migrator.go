@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place state migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator instance.
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{
+		keeper: k,
+	}
+}
+
+// Migrate1to2 migrates the x/feeds module state from the consensus version 1 to
+// version 2. Version 2 only adds the price history, aggregation method and circuit
+// breaker stores, which start empty, so no stored data is changed. The new params
+// are set by the v3_2 upgrade handler.
+func (m Migrator) Migrate1to2(_ sdk.Context) error {
+	return nil
+}
@@ -205,6 +205,52 @@ func (k msgServer) UpdateReferenceSourceConfig(
 	return &types.MsgUpdateReferenceSourceConfigResponse{}, nil
 }
 
+// UpdateFeedAggregationMethods updates aggregation methods of signal ids.
+func (k msgServer) UpdateFeedAggregationMethods(
+	goCtx context.Context,
+	msg *types.MsgUpdateFeedAggregationMethods,
+) (*types.MsgUpdateFeedAggregationMethodsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check if the request is from the admin or the authority
+	admin := k.Keeper.GetParams(ctx).Admin
+	if admin != msg.Admin && k.Keeper.GetAuthority() != msg.Admin {
+		return nil, types.ErrInvalidSigner.Wrapf(
+			"invalid admin; expected %s or %s, got %s",
+			admin,
+			k.Keeper.GetAuthority(),
+			msg.Admin,
+		)
+	}
+
+	k.Keeper.SetFeedAggregationMethods(ctx, msg.FeedAggregationMethods)
+
+	return &types.MsgUpdateFeedAggregationMethodsResponse{}, nil
+}
+
+// UpdateCircuitBreakerConfigs updates circuit breaker configurations of signal ids.
+func (k msgServer) UpdateCircuitBreakerConfigs(
+	goCtx context.Context,
+	msg *types.MsgUpdateCircuitBreakerConfigs,
+) (*types.MsgUpdateCircuitBreakerConfigsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check if the request is from the admin or the authority
+	admin := k.Keeper.GetParams(ctx).Admin
+	if admin != msg.Admin && k.Keeper.GetAuthority() != msg.Admin {
+		return nil, types.ErrInvalidSigner.Wrapf(
+			"invalid admin; expected %s or %s, got %s",
+			admin,
+			k.Keeper.GetAuthority(),
+			msg.Admin,
+		)
+	}
+
+	k.Keeper.SetCircuitBreakerConfigs(ctx, msg.CircuitBreakerConfigs)
+
+	return &types.MsgUpdateCircuitBreakerConfigsResponse{}, nil
+}
+
 // UpdateParams updates the feeds module params.
 func (k msgServer) UpdateParams(
 	goCtx context.Context,
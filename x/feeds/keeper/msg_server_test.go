@@ -308,6 +308,120 @@ func (suite *KeeperTestSuite) TestMsgUpdateReferenceSourceConfig() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgUpdateFeedAggregationMethods() {
+	params := suite.feedsKeeper.GetParams(suite.ctx)
+	feedAggregationMethods := []types.FeedAggregationMethod{
+		types.NewFeedAggregationMethod("CS:BAND-USD", types.AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN),
+	}
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdateFeedAggregationMethods
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid admin",
+			input: &types.MsgUpdateFeedAggregationMethods{
+				Admin:                  "invalid",
+				FeedAggregationMethods: feedAggregationMethods,
+			},
+			expErr:    true,
+			expErrMsg: "invalid admin",
+		},
+		{
+			name: "all good by admin",
+			input: &types.MsgUpdateFeedAggregationMethods{
+				Admin:                  params.Admin,
+				FeedAggregationMethods: feedAggregationMethods,
+			},
+			expErr: false,
+		},
+		{
+			name: "all good by authority",
+			input: &types.MsgUpdateFeedAggregationMethods{
+				Admin:                  suite.feedsKeeper.GetAuthority(),
+				FeedAggregationMethods: feedAggregationMethods,
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.UpdateFeedAggregationMethods(suite.ctx, tc.input)
+
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(
+					types.AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN,
+					suite.feedsKeeper.GetFeedAggregationMethod(suite.ctx, "CS:BAND-USD"),
+				)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgUpdateCircuitBreakerConfigs() {
+	params := suite.feedsKeeper.GetParams(suite.ctx)
+	circuitBreakerConfigs := []types.CircuitBreakerConfig{
+		types.NewCircuitBreakerConfig("CS:BAND-USD", 1000, 60, 3),
+	}
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdateCircuitBreakerConfigs
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid admin",
+			input: &types.MsgUpdateCircuitBreakerConfigs{
+				Admin:                 "invalid",
+				CircuitBreakerConfigs: circuitBreakerConfigs,
+			},
+			expErr:    true,
+			expErrMsg: "invalid admin",
+		},
+		{
+			name: "all good by admin",
+			input: &types.MsgUpdateCircuitBreakerConfigs{
+				Admin:                 params.Admin,
+				CircuitBreakerConfigs: circuitBreakerConfigs,
+			},
+			expErr: false,
+		},
+		{
+			name: "all good by authority",
+			input: &types.MsgUpdateCircuitBreakerConfigs{
+				Admin:                 suite.feedsKeeper.GetAuthority(),
+				CircuitBreakerConfigs: circuitBreakerConfigs,
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.UpdateCircuitBreakerConfigs(suite.ctx, tc.input)
+
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(
+					circuitBreakerConfigs[0],
+					suite.feedsKeeper.GetCircuitBreakerConfig(suite.ctx, "CS:BAND-USD"),
+				)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 	params := types.DefaultParams()
 
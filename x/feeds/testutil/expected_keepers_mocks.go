@@ -85,6 +85,18 @@ func (m *MockOracleKeeper) EXPECT() *MockOracleKeeperMockRecorder {
 	return m.recorder
 }
 
+// FulfillReport mocks base method.
+func (m *MockOracleKeeper) FulfillReport(ctx types0.Context, val types0.ValAddress, requestTime time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FulfillReport", ctx, val, requestTime)
+}
+
+// FulfillReport indicates an expected call of FulfillReport.
+func (mr *MockOracleKeeperMockRecorder) FulfillReport(ctx, val, requestTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FulfillReport", reflect.TypeOf((*MockOracleKeeper)(nil).FulfillReport), ctx, val, requestTime)
+}
+
 // GetValidatorStatus mocks base method.
 func (m *MockOracleKeeper) GetValidatorStatus(ctx types0.Context, val types0.ValAddress) types.ValidatorStatus {
 	m.ctrl.T.Helper()
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"cmp"
+	"slices"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// AggregateValidatorPriceInfos aggregates the available prices of ValidatorPriceInfo entries
+// into a single price by the given aggregation method.
+func AggregateValidatorPriceInfos(method AggregationMethod, validatorPriceInfos []ValidatorPriceInfo) (uint64, error) {
+	switch method {
+	case AGGREGATION_METHOD_UNSPECIFIED, AGGREGATION_METHOD_WEIGHTED_MEDIAN:
+		return MedianValidatorPriceInfos(validatorPriceInfos)
+	case AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN:
+		return TrimmedMeanValidatorPriceInfos(validatorPriceInfos)
+	case AGGREGATION_METHOD_WEIGHTED_MEAN:
+		return MeanValidatorPriceInfos(validatorPriceInfos)
+	default:
+		return 0, ErrInvalidAggregationMethod.Wrapf("aggregation method: %s", method)
+	}
+}
+
+// MeanValidatorPriceInfos calculates a power-weighted mean price from ValidatorPriceInfo entries
+// with available prices.
+func MeanValidatorPriceInfos(validatorPriceInfos []ValidatorPriceInfo) (uint64, error) {
+	weightedPrices := make([]WeightedPrice, 0, len(validatorPriceInfos))
+	for _, priceInfo := range validatorPriceInfos {
+		if priceInfo.SignalPriceStatus == SIGNAL_PRICE_STATUS_AVAILABLE {
+			weightedPrices = append(weightedPrices, NewWeightedPrice(priceInfo.Power, priceInfo.Price))
+		}
+	}
+
+	return MeanWeightedPrice(weightedPrices)
+}
+
+// TrimmedMeanValidatorPriceInfos calculates a power-weighted mean price from ValidatorPriceInfo entries
+// with available prices after trimming the lowest and highest quarter of the total power.
+// An entry that crosses a trimming boundary only contributes its power inside the boundaries.
+func TrimmedMeanValidatorPriceInfos(validatorPriceInfos []ValidatorPriceInfo) (uint64, error) {
+	var validPrices []ValidatorPriceInfo
+	totalPower := sdkmath.NewInt(0)
+	for _, priceInfo := range validatorPriceInfos {
+		if priceInfo.SignalPriceStatus == SIGNAL_PRICE_STATUS_AVAILABLE {
+			validPrices = append(validPrices, priceInfo)
+			totalPower = totalPower.Add(priceInfo.Power)
+		}
+	}
+
+	// sort by price (ascending)
+	slices.SortStableFunc(validPrices, func(priceA, priceB ValidatorPriceInfo) int {
+		return cmp.Compare(priceA.Price, priceB.Price)
+	})
+
+	// scale up power to avoid losing precision on the trimming boundaries
+	scalingFactor := getTrimScalingFactor()
+	lowerBound := totalPower.Mul(scalingFactor).QuoRaw(4)
+	upperBound := totalPower.Mul(scalingFactor).Sub(lowerBound)
+
+	weightedPrices := make([]WeightedPrice, 0, len(validPrices))
+	currentPower := sdkmath.NewInt(0)
+	for _, priceInfo := range validPrices {
+		start := currentPower
+		end := currentPower.Add(priceInfo.Power.Mul(scalingFactor))
+		currentPower = end
+
+		// take only the power within [lowerBound, upperBound)
+		weight := sdkmath.MinInt(end, upperBound).Sub(sdkmath.MaxInt(start, lowerBound))
+		if weight.IsPositive() {
+			weightedPrices = append(weightedPrices, NewWeightedPrice(weight, priceInfo.Price))
+		}
+	}
+
+	return MeanWeightedPrice(weightedPrices)
+}
+
+// MeanWeightedPrice calculates the weighted mean price from a list of weighted prices.
+func MeanWeightedPrice(weightedPrices []WeightedPrice) (uint64, error) {
+	totalWeight := sdkmath.NewInt(0)
+	weightedSum := sdkmath.NewInt(0)
+	for _, wp := range weightedPrices {
+		totalWeight = totalWeight.Add(wp.Weight)
+		weightedSum = weightedSum.Add(wp.Weight.Mul(sdkmath.NewIntFromUint64(wp.Price)))
+	}
+
+	// return an error if mean cannot be determined
+	if !totalWeight.IsPositive() {
+		return 0, ErrInvalidWeightedPrices
+	}
+
+	return weightedSum.Quo(totalWeight).Uint64(), nil
+}
+
+// getTrimScalingFactor returns the scaling factor used to split power on the trimming boundaries.
+func getTrimScalingFactor() sdkmath.Int {
+	return sdkmath.NewInt(4)
+}
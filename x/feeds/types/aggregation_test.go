@@ -0,0 +1,169 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func newAvailablePriceInfo(power int64, price uint64) types.ValidatorPriceInfo {
+	return types.NewValidatorPriceInfo(types.SIGNAL_PRICE_STATUS_AVAILABLE, sdkmath.NewInt(power), price, 100)
+}
+
+func TestMeanValidatorPriceInfos(t *testing.T) {
+	tests := []struct {
+		name       string
+		priceInfos []types.ValidatorPriceInfo
+		expPrice   uint64
+		expErr     error
+	}{
+		{
+			name: "equal powers",
+			priceInfos: []types.ValidatorPriceInfo{
+				newAvailablePriceInfo(10, 1000),
+				newAvailablePriceInfo(10, 2000),
+				newAvailablePriceInfo(10, 3000),
+			},
+			expPrice: 2000,
+		},
+		{
+			name: "weighted by power",
+			priceInfos: []types.ValidatorPriceInfo{
+				newAvailablePriceInfo(30, 1000),
+				newAvailablePriceInfo(10, 5000),
+			},
+			expPrice: 2000,
+		},
+		{
+			name: "skip unavailable prices",
+			priceInfos: []types.ValidatorPriceInfo{
+				newAvailablePriceInfo(10, 1000),
+				types.NewValidatorPriceInfo(types.SIGNAL_PRICE_STATUS_UNAVAILABLE, sdkmath.NewInt(100), 0, 100),
+				newAvailablePriceInfo(10, 3000),
+			},
+			expPrice: 2000,
+		},
+		{
+			name:       "no available price",
+			priceInfos: []types.ValidatorPriceInfo{},
+			expErr:     types.ErrInvalidWeightedPrices,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price, err := types.MeanValidatorPriceInfos(tt.priceInfos)
+			if tt.expErr != nil {
+				require.ErrorIs(t, err, tt.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expPrice, price)
+		})
+	}
+}
+
+func TestTrimmedMeanValidatorPriceInfos(t *testing.T) {
+	tests := []struct {
+		name       string
+		priceInfos []types.ValidatorPriceInfo
+		expPrice   uint64
+		expErr     error
+	}{
+		{
+			name: "trim outliers",
+			priceInfos: []types.ValidatorPriceInfo{
+				newAvailablePriceInfo(10, 1),
+				newAvailablePriceInfo(10, 1000),
+				newAvailablePriceInfo(10, 3000),
+				newAvailablePriceInfo(10, 1_000_000),
+			},
+			expPrice: 2000,
+		},
+		{
+			name: "split power on boundaries",
+			priceInfos: []types.ValidatorPriceInfo{
+				newAvailablePriceInfo(20, 1000),
+				newAvailablePriceInfo(20, 4000),
+			},
+			expPrice: 2500,
+		},
+		{
+			name: "unsorted input",
+			priceInfos: []types.ValidatorPriceInfo{
+				newAvailablePriceInfo(10, 1_000_000),
+				newAvailablePriceInfo(10, 3000),
+				newAvailablePriceInfo(10, 1),
+				newAvailablePriceInfo(10, 1000),
+			},
+			expPrice: 2000,
+		},
+		{
+			name: "single price",
+			priceInfos: []types.ValidatorPriceInfo{
+				newAvailablePriceInfo(1, 1000),
+			},
+			expPrice: 1000,
+		},
+		{
+			name:       "no available price",
+			priceInfos: []types.ValidatorPriceInfo{},
+			expErr:     types.ErrInvalidWeightedPrices,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price, err := types.TrimmedMeanValidatorPriceInfos(tt.priceInfos)
+			if tt.expErr != nil {
+				require.ErrorIs(t, err, tt.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expPrice, price)
+		})
+	}
+}
+
+func TestAggregateValidatorPriceInfos(t *testing.T) {
+	priceInfos := []types.ValidatorPriceInfo{
+		newAvailablePriceInfo(10, 1000),
+		newAvailablePriceInfo(10, 2000),
+		newAvailablePriceInfo(10, 6000),
+	}
+
+	medianPrice, err := types.MedianValidatorPriceInfos(priceInfos)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		method   types.AggregationMethod
+		expPrice uint64
+		expErr   error
+	}{
+		{"unspecified", types.AGGREGATION_METHOD_UNSPECIFIED, medianPrice, nil},
+		{"weighted median", types.AGGREGATION_METHOD_WEIGHTED_MEDIAN, medianPrice, nil},
+		{"trimmed weighted mean", types.AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN, 2500, nil},
+		{"weighted mean", types.AGGREGATION_METHOD_WEIGHTED_MEAN, 3000, nil},
+		{"invalid", types.AggregationMethod(100), 0, types.ErrInvalidAggregationMethod},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price, err := types.AggregateValidatorPriceInfos(tt.method, priceInfos)
+			if tt.expErr != nil {
+				require.ErrorIs(t, err, tt.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expPrice, price)
+		})
+	}
+}
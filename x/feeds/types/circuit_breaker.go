@@ -0,0 +1,145 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// NewCircuitBreakerConfig creates a new circuit breaker config instance
+func NewCircuitBreakerConfig(
+	signalID string,
+	maxDeviationBasisPoint int64,
+	confirmationWindow int64,
+	outlierSigmaThreshold int64,
+) CircuitBreakerConfig {
+	return CircuitBreakerConfig{
+		SignalID:               signalID,
+		MaxDeviationBasisPoint: maxDeviationBasisPoint,
+		ConfirmationWindow:     confirmationWindow,
+		OutlierSigmaThreshold:  outlierSigmaThreshold,
+	}
+}
+
+// IsEmpty returns true if neither the halting nor the outlier flagging is enabled.
+func (c CircuitBreakerConfig) IsEmpty() bool {
+	return c.MaxDeviationBasisPoint == 0 && c.OutlierSigmaThreshold == 0
+}
+
+// Validate validates the circuit breaker config
+func (c CircuitBreakerConfig) Validate() error {
+	if c.SignalID == "" {
+		return ErrInvalidSignal.Wrap("signal id cannot be empty")
+	}
+
+	if uint64(len(c.SignalID)) > MaxSignalIDCharacters {
+		return ErrSignalIDTooLarge.Wrapf(
+			"maximum number of characters is %d but received %d characters",
+			MaxSignalIDCharacters, len(c.SignalID),
+		)
+	}
+
+	if c.MaxDeviationBasisPoint < 0 {
+		return ErrInvalidCircuitBreakerConfig.Wrapf(
+			"max deviation basis point cannot be negative: %d", c.MaxDeviationBasisPoint,
+		)
+	}
+
+	if c.ConfirmationWindow < 0 {
+		return ErrInvalidCircuitBreakerConfig.Wrapf(
+			"confirmation window cannot be negative: %d", c.ConfirmationWindow,
+		)
+	}
+
+	if c.MaxDeviationBasisPoint > 0 && c.ConfirmationWindow == 0 {
+		return ErrInvalidCircuitBreakerConfig.Wrap("confirmation window must be positive when halting is enabled")
+	}
+
+	if c.OutlierSigmaThreshold < 0 {
+		return ErrInvalidCircuitBreakerConfig.Wrapf(
+			"outlier sigma threshold cannot be negative: %d", c.OutlierSigmaThreshold,
+		)
+	}
+
+	return nil
+}
+
+// NewCircuitBreakerState creates a new circuit breaker state instance
+func NewCircuitBreakerState(
+	signalID string,
+	referencePrice uint64,
+	referenceTimestamp int64,
+	haltedAt int64,
+) CircuitBreakerState {
+	return CircuitBreakerState{
+		SignalID:           signalID,
+		ReferencePrice:     referencePrice,
+		ReferenceTimestamp: referenceTimestamp,
+		HaltedAt:           haltedAt,
+	}
+}
+
+// CalculateDeviationBasisPoint calculates the deviation between the old price and
+// the new price in basis points, i.e., |(newPrice - oldPrice)| * 10000 / oldPrice
+func CalculateDeviationBasisPoint(oldPrice, newPrice uint64) sdkmath.Int {
+	if oldPrice == 0 || newPrice == oldPrice {
+		return sdkmath.ZeroInt()
+	}
+
+	oldPriceInt := sdkmath.NewIntFromUint64(oldPrice)
+	return sdkmath.NewIntFromUint64(newPrice).Sub(oldPriceInt).Abs().MulRaw(10000).Quo(oldPriceInt)
+}
+
+// FindOutlierValidatorPriceInfos returns the indices of ValidatorPriceInfo entries with available prices
+// whose price differs from the median by more than sigmaThreshold power-weighted standard deviations.
+func FindOutlierValidatorPriceInfos(
+	validatorPriceInfos []ValidatorPriceInfo,
+	median uint64,
+	sigmaThreshold int64,
+) []int {
+	totalPower := sdkmath.LegacyZeroDec()
+	weightedSum := sdkmath.LegacyZeroDec()
+	for _, priceInfo := range validatorPriceInfos {
+		if priceInfo.SignalPriceStatus == SIGNAL_PRICE_STATUS_AVAILABLE {
+			power := sdkmath.LegacyNewDecFromInt(priceInfo.Power)
+			totalPower = totalPower.Add(power)
+			weightedSum = weightedSum.Add(power.Mul(sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(priceInfo.Price))))
+		}
+	}
+
+	if !totalPower.IsPositive() {
+		return nil
+	}
+
+	// calculate the power-weighted variance around the power-weighted mean
+	mean := weightedSum.Quo(totalPower)
+	weightedSquaredDiffSum := sdkmath.LegacyZeroDec()
+	for _, priceInfo := range validatorPriceInfos {
+		if priceInfo.SignalPriceStatus == SIGNAL_PRICE_STATUS_AVAILABLE {
+			diff := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(priceInfo.Price)).Sub(mean)
+			weightedSquaredDiffSum = weightedSquaredDiffSum.Add(
+				sdkmath.LegacyNewDecFromInt(priceInfo.Power).Mul(diff).Mul(diff),
+			)
+		}
+	}
+
+	sigma, err := weightedSquaredDiffSum.Quo(totalPower).ApproxSqrt()
+	if err != nil || !sigma.IsPositive() {
+		return nil
+	}
+
+	threshold := sigma.MulInt64(sigmaThreshold)
+	medianDec := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(median))
+
+	var outliers []int
+	for i, priceInfo := range validatorPriceInfos {
+		if priceInfo.SignalPriceStatus != SIGNAL_PRICE_STATUS_AVAILABLE {
+			continue
+		}
+
+		diff := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(priceInfo.Price)).Sub(medianDec).Abs()
+		if diff.GT(threshold) {
+			outliers = append(outliers, i)
+		}
+	}
+
+	return outliers
+}
@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func TestCircuitBreakerConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  types.CircuitBreakerConfig
+		wantErr error
+	}{
+		{"valid config", types.NewCircuitBreakerConfig("CS:BAND-USD", 1000, 60, 3), nil},
+		{"valid outlier only config", types.NewCircuitBreakerConfig("CS:BAND-USD", 0, 0, 3), nil},
+		{"valid empty config", types.NewCircuitBreakerConfig("CS:BAND-USD", 0, 0, 0), nil},
+		{"empty signal id", types.NewCircuitBreakerConfig("", 1000, 60, 3), types.ErrInvalidSignal},
+		{
+			"negative max deviation",
+			types.NewCircuitBreakerConfig("CS:BAND-USD", -1, 60, 3),
+			types.ErrInvalidCircuitBreakerConfig,
+		},
+		{
+			"negative confirmation window",
+			types.NewCircuitBreakerConfig("CS:BAND-USD", 0, -1, 3),
+			types.ErrInvalidCircuitBreakerConfig,
+		},
+		{
+			"halting without confirmation window",
+			types.NewCircuitBreakerConfig("CS:BAND-USD", 1000, 0, 3),
+			types.ErrInvalidCircuitBreakerConfig,
+		},
+		{
+			"negative outlier sigma threshold",
+			types.NewCircuitBreakerConfig("CS:BAND-USD", 1000, 60, -1),
+			types.ErrInvalidCircuitBreakerConfig,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCalculateDeviationBasisPoint(t *testing.T) {
+	require.Equal(t, sdkmath.NewInt(0), types.CalculateDeviationBasisPoint(0, 1000))
+	require.Equal(t, sdkmath.NewInt(0), types.CalculateDeviationBasisPoint(1000, 1000))
+	require.Equal(t, sdkmath.NewInt(1000), types.CalculateDeviationBasisPoint(1000, 1100))
+	require.Equal(t, sdkmath.NewInt(1000), types.CalculateDeviationBasisPoint(1000, 900))
+	require.Equal(t, sdkmath.NewInt(10000), types.CalculateDeviationBasisPoint(1000, 0))
+}
+
+func TestFindOutlierValidatorPriceInfos(t *testing.T) {
+	validatorPriceInfos := []types.ValidatorPriceInfo{
+		types.NewValidatorPriceInfo(types.SIGNAL_PRICE_STATUS_AVAILABLE, sdkmath.NewInt(1), 100, 0),
+		types.NewValidatorPriceInfo(types.SIGNAL_PRICE_STATUS_AVAILABLE, sdkmath.NewInt(1), 101, 0),
+		types.NewValidatorPriceInfo(types.SIGNAL_PRICE_STATUS_UNAVAILABLE, sdkmath.NewInt(1), 0, 0),
+		types.NewValidatorPriceInfo(types.SIGNAL_PRICE_STATUS_AVAILABLE, sdkmath.NewInt(1), 99, 0),
+		types.NewValidatorPriceInfo(types.SIGNAL_PRICE_STATUS_AVAILABLE, sdkmath.NewInt(1), 100, 0),
+		types.NewValidatorPriceInfo(types.SIGNAL_PRICE_STATUS_AVAILABLE, sdkmath.NewInt(1), 200, 0),
+	}
+
+	// mean is 120 and standard deviation is about 40
+	require.Equal(t, []int{5}, types.FindOutlierValidatorPriceInfos(validatorPriceInfos, 100, 2))
+	require.Empty(t, types.FindOutlierValidatorPriceInfos(validatorPriceInfos, 100, 3))
+
+	// the same prices have no outliers
+	samePrices := []types.ValidatorPriceInfo{
+		types.NewValidatorPriceInfo(types.SIGNAL_PRICE_STATUS_AVAILABLE, sdkmath.NewInt(1), 100, 0),
+		types.NewValidatorPriceInfo(types.SIGNAL_PRICE_STATUS_AVAILABLE, sdkmath.NewInt(2), 100, 0),
+	}
+	require.Empty(t, types.FindOutlierValidatorPriceInfos(samePrices, 100, 1))
+
+	// no available prices
+	require.Empty(t, types.FindOutlierValidatorPriceInfos(nil, 100, 1))
+}
@@ -15,6 +15,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "feeds/MsgVote")
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitSignalPrices{}, "feeds/MsgSubmitSignalPrices")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateReferenceSourceConfig{}, "feeds/MsgUpdateReferenceSourceConfig")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateFeedAggregationMethods{}, "feeds/MsgUpdateFeedAggregationMethods")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateCircuitBreakerConfigs{}, "feeds/MsgUpdateCircuitBreakerConfigs")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "feeds/MsgUpdateParams")
 
 	cdc.RegisterConcrete(&FeedsSignatureOrder{}, "feeds/FeedsSignatureOrder", nil)
@@ -28,6 +30,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgVote{},
 		&MsgSubmitSignalPrices{},
 		&MsgUpdateReferenceSourceConfig{},
+		&MsgUpdateFeedAggregationMethods{},
+		&MsgUpdateCircuitBreakerConfigs{},
 		&MsgUpdateParams{},
 	)
 
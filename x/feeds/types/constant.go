@@ -4,6 +4,10 @@ const (
 	// MaxSignalIDCharacters defines the maximum number of characters allowed in a signal ID.
 	MaxSignalIDCharacters uint64 = 32
 
+	// DerivedSignalIDPrefix is the signal ID prefix reserved for signals derived from the prices of
+	// other signals, such as the derived signals of tunnels. No feed signal can have this prefix.
+	DerivedSignalIDPrefix = "DS:"
+
 	// ExpectedBlockTime specifies the expected block time (in seconds).
 	ExpectedBlockTime int64 = 1
 )
@@ -31,18 +31,26 @@ const (
 	ENCODER_FIXED_POINT_ABI Encoder = 1
 	// ENCODER_TICK_ABI is a tick abi encoder.
 	ENCODER_TICK_ABI Encoder = 2
+	// ENCODER_FIXED_POINT_PACKED is a fixed-point price encoder with a tightly packed big-endian layout.
+	ENCODER_FIXED_POINT_PACKED Encoder = 3
+	// ENCODER_FIXED_POINT_BORSH is a fixed-point price encoder with a Borsh layout.
+	ENCODER_FIXED_POINT_BORSH Encoder = 4
 )
 
 var Encoder_name = map[int32]string{
 	0: "ENCODER_UNSPECIFIED",
 	1: "ENCODER_FIXED_POINT_ABI",
 	2: "ENCODER_TICK_ABI",
+	3: "ENCODER_FIXED_POINT_PACKED",
+	4: "ENCODER_FIXED_POINT_BORSH",
 }
 
 var Encoder_value = map[string]int32{
-	"ENCODER_UNSPECIFIED":     0,
-	"ENCODER_FIXED_POINT_ABI": 1,
-	"ENCODER_TICK_ABI":        2,
+	"ENCODER_UNSPECIFIED":        0,
+	"ENCODER_FIXED_POINT_ABI":    1,
+	"ENCODER_TICK_ABI":           2,
+	"ENCODER_FIXED_POINT_PACKED": 3,
+	"ENCODER_FIXED_POINT_BORSH":  4,
 }
 
 func (x Encoder) String() string {
@@ -60,20 +68,21 @@ func init() {
 func init() { proto.RegisterFile("band/feeds/v1beta1/encoder.proto", fileDescriptor_ac3e992b65436f01) }
 
 var fileDescriptor_ac3e992b65436f01 = []byte{
-	// 226 bytes of a gzipped FileDescriptorProto
+	// 251 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4a, 0xcc, 0x4b,
 	0xd1, 0x4f, 0x4b, 0x4d, 0x4d, 0x29, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f,
 	0xcd, 0x4b, 0xce, 0x4f, 0x49, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x02, 0xa9,
 	0xd0, 0x03, 0xab, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83,
-	0x58, 0x10, 0x95, 0x5a, 0xd1, 0x5c, 0xec, 0xae, 0x10, 0xad, 0x42, 0xe2, 0x5c, 0xc2, 0xae, 0x7e,
-	0xce, 0xfe, 0x2e, 0xae, 0x41, 0xf1, 0xa1, 0x7e, 0xc1, 0x01, 0xae, 0xce, 0x9e, 0x6e, 0x9e, 0xae,
-	0x2e, 0x02, 0x0c, 0x42, 0xd2, 0x5c, 0xe2, 0x30, 0x09, 0x37, 0xcf, 0x08, 0x57, 0x97, 0xf8, 0x00,
-	0x7f, 0x4f, 0xbf, 0x90, 0x78, 0x47, 0x27, 0x4f, 0x01, 0x46, 0x21, 0x11, 0x2e, 0x01, 0x98, 0x64,
-	0x88, 0xa7, 0xb3, 0x37, 0x58, 0x94, 0x49, 0x8a, 0xa5, 0x63, 0xb1, 0x1c, 0x83, 0x93, 0xc7, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x83, 0xdc, 0x0a, 0x76, 0x4c, 0x72, 0x7e, 0x8e, 0x7e, 0x72, 0x46,
-	0x62, 0x66, 0x9e, 0x7e, 0x99, 0xb1, 0x7e, 0x05, 0xd4, 0x83, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49,
-	0x6c, 0x60, 0x05, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xef, 0x0d, 0x10, 0xc0, 0xfb, 0x00,
-	0x00, 0x00,
+	0x58, 0x10, 0x95, 0x5a, 0xb3, 0x18, 0xb9, 0xd8, 0x5d, 0x21, 0x7a, 0x85, 0xc4, 0xb9, 0x84, 0x5d,
+	0xfd, 0x9c, 0xfd, 0x5d, 0x5c, 0x83, 0xe2, 0x43, 0xfd, 0x82, 0x03, 0x5c, 0x9d, 0x3d, 0xdd, 0x3c,
+	0x5d, 0x5d, 0x04, 0x18, 0x84, 0xa4, 0xb9, 0xc4, 0x61, 0x12, 0x6e, 0x9e, 0x11, 0xae, 0x2e, 0xf1,
+	0x01, 0xfe, 0x9e, 0x7e, 0x21, 0xf1, 0x8e, 0x4e, 0x9e, 0x02, 0x8c, 0x42, 0x22, 0x5c, 0x02, 0x30,
+	0xc9, 0x10, 0x4f, 0x67, 0x6f, 0xb0, 0x28, 0x93, 0x90, 0x1c, 0x97, 0x14, 0x36, 0x2d, 0x01, 0x8e,
+	0xce, 0xde, 0xae, 0x2e, 0x02, 0xcc, 0x42, 0xb2, 0x5c, 0x92, 0xd8, 0xe4, 0x9d, 0xfc, 0x83, 0x82,
+	0x3d, 0x04, 0x58, 0xa4, 0x58, 0x3a, 0x16, 0xcb, 0x31, 0x38, 0x79, 0x9c, 0x78, 0x24, 0xc7, 0x78,
+	0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7,
+	0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x5e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e,
+	0xae, 0x3e, 0xc8, 0xaf, 0x60, 0xcf, 0x24, 0xe7, 0xe7, 0xe8, 0x27, 0x67, 0x24, 0x66, 0xe6, 0xe9,
+	0x97, 0x19, 0xeb, 0x57, 0x40, 0x03, 0xa8, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xac, 0xc0,
+	0x18, 0x30, 0x00, 0xc3, 0xf1, 0xdf, 0x37, 0x3b, 0x01, 0x00, 0x00,
 }
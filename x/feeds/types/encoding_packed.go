@@ -0,0 +1,29 @@
+package types
+
+import "encoding/binary"
+
+// AppendPackedRelayPrices appends the relay prices to bz in the packed layout, which is the number of
+// prices as a big-endian uint32 followed by the 32-byte signal ID and the big-endian uint64 price of
+// each relay price, without any padding.
+func AppendPackedRelayPrices(bz []byte, relayPrices []RelayPrice) []byte {
+	bz = binary.BigEndian.AppendUint32(bz, uint32(len(relayPrices)))
+	for _, rp := range relayPrices {
+		bz = append(bz, rp.SignalID[:]...)
+		bz = binary.BigEndian.AppendUint64(bz, rp.Price)
+	}
+
+	return bz
+}
+
+// AppendBorshRelayPrices appends the relay prices to bz in the Borsh layout of Vec<([u8; 32], u64)>,
+// which is the number of prices as a little-endian uint32 followed by the 32-byte signal ID and the
+// little-endian uint64 price of each relay price.
+func AppendBorshRelayPrices(bz []byte, relayPrices []RelayPrice) []byte {
+	bz = binary.LittleEndian.AppendUint32(bz, uint32(len(relayPrices)))
+	for _, rp := range relayPrices {
+		bz = append(bz, rp.SignalID[:]...)
+		bz = binary.LittleEndian.AppendUint64(bz, rp.Price)
+	}
+
+	return bz
+}
@@ -1,14 +1,18 @@
 package types
 
 import (
+	"encoding/binary"
+
 	"github.com/ethereum/go-ethereum/accounts/abi"
 
 	"github.com/bandprotocol/chain/v3/pkg/tickmath"
 )
 
 const (
-	EncoderFixedPointABIPrefix = "\xcb\xa0\xad\x5a" // tss.Hash([]byte("FixedPointABI"))[:4]
-	EncoderTickABIPrefix       = "\xdb\x99\xb2\xb3" // tss.Hash([]byte("TickABI"))[:4]
+	EncoderFixedPointABIPrefix    = "\xcb\xa0\xad\x5a" // tss.Hash([]byte("FixedPointABI"))[:4]
+	EncoderTickABIPrefix          = "\xdb\x99\xb2\xb3" // tss.Hash([]byte("TickABI"))[:4]
+	EncoderFixedPointPackedPrefix = "\xeb\xa9\xe8\x9b" // tss.Hash([]byte("FixedPointPacked"))[:4]
+	EncoderFixedPointBorshPrefix  = "\xa8\x4e\xa1\x74" // tss.Hash([]byte("FixedPointBorsh"))[:4]
 )
 
 var (
@@ -103,6 +107,22 @@ func EncodeTSS(prices []Price, timestamp int64, encoder Encoder) ([]byte, error)
 		}
 
 		return append([]byte(EncoderTickABIPrefix), bz...), nil
+	case ENCODER_FIXED_POINT_PACKED:
+		relayPrices, err := ToRelayPrices(prices)
+		if err != nil {
+			return nil, err
+		}
+
+		bz := AppendPackedRelayPrices([]byte(EncoderFixedPointPackedPrefix), relayPrices)
+		return binary.BigEndian.AppendUint64(bz, uint64(timestamp)), nil
+	case ENCODER_FIXED_POINT_BORSH:
+		relayPrices, err := ToRelayPrices(prices)
+		if err != nil {
+			return nil, err
+		}
+
+		bz := AppendBorshRelayPrices([]byte(EncoderFixedPointBorshPrefix), relayPrices)
+		return binary.LittleEndian.AppendUint64(bz, uint64(timestamp)), nil
 	default:
 		return nil, ErrInvalidEncoder.Wrapf("invalid encoder: %s", encoder)
 	}
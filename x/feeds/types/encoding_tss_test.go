@@ -13,6 +13,8 @@ import (
 func TestEncoderPrefix(t *testing.T) {
 	require.Equal(t, []byte(types.EncoderFixedPointABIPrefix), tss.Hash([]byte("FixedPointABI"))[:4])
 	require.Equal(t, []byte(types.EncoderTickABIPrefix), tss.Hash([]byte("TickABI"))[:4])
+	require.Equal(t, []byte(types.EncoderFixedPointPackedPrefix), tss.Hash([]byte("FixedPointPacked"))[:4])
+	require.Equal(t, []byte(types.EncoderFixedPointBorshPrefix), tss.Hash([]byte("FixedPointBorsh"))[:4])
 }
 
 func TestPriceEncoderEncodingABI(t *testing.T) {
@@ -39,6 +41,44 @@ func TestTickPriceEncoderEncodingABI(t *testing.T) {
 	require.Equal(t, expected, hex.EncodeToString(result))
 }
 
+func TestPriceEncoderEncodingPacked(t *testing.T) {
+	prices := []types.Price{
+		{SignalID: "testSignal", Price: 100, Status: types.PRICE_STATUS_AVAILABLE},
+	}
+
+	result, err := types.EncodeTSS(prices, 123456789, types.ENCODER_FIXED_POINT_PACKED)
+	require.NoError(t, err)
+
+	expected := ("eba9e89b" +
+		// number of prices (uint32)
+		"00000001" +
+		// signal ID (bytes32) and price (uint64)
+		"00000000000000000000000000000000000000000000746573745369676e616c" +
+		"0000000000000064" +
+		// timestamp (int64)
+		"00000000075bcd15")
+	require.Equal(t, expected, hex.EncodeToString(result))
+}
+
+func TestPriceEncoderEncodingBorsh(t *testing.T) {
+	prices := []types.Price{
+		{SignalID: "testSignal", Price: 100, Status: types.PRICE_STATUS_AVAILABLE},
+	}
+
+	result, err := types.EncodeTSS(prices, 123456789, types.ENCODER_FIXED_POINT_BORSH)
+	require.NoError(t, err)
+
+	expected := ("a84ea174" +
+		// number of prices (u32 little-endian)
+		"01000000" +
+		// signal ID ([u8; 32]) and price (u64 little-endian)
+		"00000000000000000000000000000000000000000000746573745369676e616c" +
+		"6400000000000000" +
+		// timestamp (i64 little-endian)
+		"15cd5b0700000000")
+	require.Equal(t, expected, hex.EncodeToString(result))
+}
+
 func TestToRelayPrices(t *testing.T) {
 	signalIDAtom, err := types.StringToBytes32("CS:ATOM-USD")
 	require.NoError(t, err)
@@ -9,20 +9,28 @@ const (
 	EventTypeUpdateCurrentFeeds          = "update_current_feeds"
 	EventTypeUpdateReferenceSourceConfig = "update_reference_source_config"
 	EventTypeUpdateParams                = "update_params"
+	EventTypeUpdateAggregationMethod     = "update_aggregation_method"
+	EventTypeUpdateCircuitBreakerConfig  = "update_circuit_breaker_config"
+	EventTypeOutlierValidatorPrices      = "outlier_validator_prices"
 
-	AttributeKeySignalPriceStatus   = "signal_price_status"
-	AttributeKeyPriceStatus         = "price_status"
-	AttributeKeyValidator           = "validator"
-	AttributeKeyPrice               = "price"
-	AttributeKeyTimestamp           = "timestamp"
-	AttributeKeySignalID            = "signal_id"
-	AttributeKeyPower               = "power"
-	AttributeKeyInterval            = "interval"
-	AttributeKeyLastUpdateTimestamp = "last_update_timestamp"
-	AttributeKeyLastUpdateBlock     = "last_update_block"
-	AttributeKeyDeviationBasisPoint = "deviation_basis_point"
-	AttributeKeyErrorMessage        = "error_message"
-	AttributeKeyRegistryIPFSHash    = "registry_ipfs_hash"
-	AttributeKeyRegistryVersion     = "registry_version"
-	AttributeKeyParams              = "params"
+	AttributeKeySignalPriceStatus      = "signal_price_status"
+	AttributeKeyPriceStatus            = "price_status"
+	AttributeKeyValidator              = "validator"
+	AttributeKeyPrice                  = "price"
+	AttributeKeyTimestamp              = "timestamp"
+	AttributeKeySignalID               = "signal_id"
+	AttributeKeyPower                  = "power"
+	AttributeKeyInterval               = "interval"
+	AttributeKeyLastUpdateTimestamp    = "last_update_timestamp"
+	AttributeKeyLastUpdateBlock        = "last_update_block"
+	AttributeKeyDeviationBasisPoint    = "deviation_basis_point"
+	AttributeKeyErrorMessage           = "error_message"
+	AttributeKeyRegistryIPFSHash       = "registry_ipfs_hash"
+	AttributeKeyRegistryVersion        = "registry_version"
+	AttributeKeyParams                 = "params"
+	AttributeKeyAggregationMethod      = "aggregation_method"
+	AttributeKeyMaxDeviationBasisPoint = "max_deviation_basis_point"
+	AttributeKeyConfirmationWindow     = "confirmation_window"
+	AttributeKeyOutlierSigmaThreshold  = "outlier_sigma_threshold"
+	AttributeKeyMedianPrice            = "median_price"
 )
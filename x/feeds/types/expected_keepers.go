@@ -26,6 +26,7 @@ type AuthzKeeper interface {
 // OracleKeeper defines the expected oracle keeper
 type OracleKeeper interface {
 	MissReport(ctx sdk.Context, val sdk.ValAddress, requestTime time.Time)
+	FulfillReport(ctx sdk.Context, val sdk.ValAddress, requestTime time.Time)
 	GetValidatorStatus(ctx sdk.Context, val sdk.ValAddress) oracletypes.ValidatorStatus
 }
 
@@ -5,11 +5,13 @@ func NewFeed(
 	signalID string,
 	power int64,
 	interval int64,
+	aggregationMethod AggregationMethod,
 ) Feed {
 	return Feed{
-		SignalID: signalID,
-		Power:    power,
-		Interval: interval,
+		SignalID:          signalID,
+		Power:             power,
+		Interval:          interval,
+		AggregationMethod: aggregationMethod,
 	}
 }
 
@@ -19,15 +21,45 @@ func NewFeedWithDeviation(
 	power int64,
 	interval int64,
 	deviation int64,
+	aggregationMethod AggregationMethod,
 ) FeedWithDeviation {
 	return FeedWithDeviation{
 		SignalID:            signalID,
 		Power:               power,
 		Interval:            interval,
 		DeviationBasisPoint: deviation,
+		AggregationMethod:   aggregationMethod,
 	}
 }
 
+// NewFeedAggregationMethod creates a new feed aggregation method instance
+func NewFeedAggregationMethod(signalID string, aggregationMethod AggregationMethod) FeedAggregationMethod {
+	return FeedAggregationMethod{
+		SignalID:          signalID,
+		AggregationMethod: aggregationMethod,
+	}
+}
+
+// Validate validates the feed aggregation method
+func (f FeedAggregationMethod) Validate() error {
+	if f.SignalID == "" {
+		return ErrInvalidSignal.Wrap("signal id cannot be empty")
+	}
+
+	if uint64(len(f.SignalID)) > MaxSignalIDCharacters {
+		return ErrSignalIDTooLarge.Wrapf(
+			"maximum number of characters is %d but received %d characters",
+			MaxSignalIDCharacters, len(f.SignalID),
+		)
+	}
+
+	if _, ok := AggregationMethod_name[int32(f.AggregationMethod)]; !ok {
+		return ErrInvalidAggregationMethod.Wrapf("aggregation method: %d", f.AggregationMethod)
+	}
+
+	return nil
+}
+
 // NewCurrentFeeds creates a new current feeds instance
 func NewCurrentFeeds(
 	feeds []Feed,
@@ -38,6 +38,9 @@ const (
 	PRICE_STATUS_AVAILABLE PriceStatus = 3
 	// PRICE_STATUS_NOT_IN_CURRENT_FEEDS is a not in current feed price status.
 	PRICE_STATUS_NOT_IN_CURRENT_FEEDS PriceStatus = 4
+	// PRICE_STATUS_HALTED is a halted price status that holds the previous price because the circuit breaker
+	// of the signal id is tripped.
+	PRICE_STATUS_HALTED PriceStatus = 5
 )
 
 var PriceStatus_name = map[int32]string{
@@ -46,6 +49,7 @@ var PriceStatus_name = map[int32]string{
 	2: "PRICE_STATUS_NOT_READY",
 	3: "PRICE_STATUS_AVAILABLE",
 	4: "PRICE_STATUS_NOT_IN_CURRENT_FEEDS",
+	5: "PRICE_STATUS_HALTED",
 }
 
 var PriceStatus_value = map[string]int32{
@@ -54,6 +58,7 @@ var PriceStatus_value = map[string]int32{
 	"PRICE_STATUS_NOT_READY":            2,
 	"PRICE_STATUS_AVAILABLE":            3,
 	"PRICE_STATUS_NOT_IN_CURRENT_FEEDS": 4,
+	"PRICE_STATUS_HALTED":               5,
 }
 
 func (x PriceStatus) String() string {
@@ -64,6 +69,43 @@ func (PriceStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_fc3afe81d3b13674, []int{0}
 }
 
+// AggregationMethod is an enumerator that defines the method used to aggregate validator prices into a price.
+type AggregationMethod int32
+
+const (
+	// AGGREGATION_METHOD_UNSPECIFIED is an unspecified aggregation method that falls back to the weighted median.
+	AGGREGATION_METHOD_UNSPECIFIED AggregationMethod = 0
+	// AGGREGATION_METHOD_WEIGHTED_MEDIAN is a power-weighted median with time-decay multipliers.
+	AGGREGATION_METHOD_WEIGHTED_MEDIAN AggregationMethod = 1
+	// AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN is a power-weighted mean after trimming the lowest and highest
+	// quarter of the power.
+	AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN AggregationMethod = 2
+	// AGGREGATION_METHOD_WEIGHTED_MEAN is a power-weighted mean, similar to a VWAP without volume.
+	AGGREGATION_METHOD_WEIGHTED_MEAN AggregationMethod = 3
+)
+
+var AggregationMethod_name = map[int32]string{
+	0: "AGGREGATION_METHOD_UNSPECIFIED",
+	1: "AGGREGATION_METHOD_WEIGHTED_MEDIAN",
+	2: "AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN",
+	3: "AGGREGATION_METHOD_WEIGHTED_MEAN",
+}
+
+var AggregationMethod_value = map[string]int32{
+	"AGGREGATION_METHOD_UNSPECIFIED":           0,
+	"AGGREGATION_METHOD_WEIGHTED_MEDIAN":       1,
+	"AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN": 2,
+	"AGGREGATION_METHOD_WEIGHTED_MEAN":         3,
+}
+
+func (x AggregationMethod) String() string {
+	return proto.EnumName(AggregationMethod_name, int32(x))
+}
+
+func (AggregationMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{1}
+}
+
 // SignalPriceStatus is a structure that defines the price status of a signal id.
 type SignalPriceStatus int32
 
@@ -97,7 +139,7 @@ func (x SignalPriceStatus) String() string {
 }
 
 func (SignalPriceStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{1}
+	return fileDescriptor_fc3afe81d3b13674, []int{2}
 }
 
 // Signal is the data structure that contains signal id and power of that signal.
@@ -218,6 +260,8 @@ type Feed struct {
 	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
 	// interval is the interval of the price feed.
 	Interval int64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// aggregation_method is the method used to aggregate validator prices of the feed.
+	AggregationMethod AggregationMethod `protobuf:"varint,4,opt,name=aggregation_method,json=aggregationMethod,proto3,enum=band.feeds.v1beta1.AggregationMethod" json:"aggregation_method,omitempty"`
 }
 
 func (m *Feed) Reset()         { *m = Feed{} }
@@ -274,6 +318,13 @@ func (m *Feed) GetInterval() int64 {
 	return 0
 }
 
+func (m *Feed) GetAggregationMethod() AggregationMethod {
+	if m != nil {
+		return m.AggregationMethod
+	}
+	return AGGREGATION_METHOD_UNSPECIFIED
+}
+
 // FeedWithDeviation is a structure that holds a signal id, its total power, and its calculated interval and deviation.
 type FeedWithDeviation struct {
 	// signal_id is the unique string that identifies the unit of feed.
@@ -284,6 +335,8 @@ type FeedWithDeviation struct {
 	Interval int64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
 	// deviation_basis_point is the maximum deviation value the feed can tolerate, expressed in basis points.
 	DeviationBasisPoint int64 `protobuf:"varint,4,opt,name=deviation_basis_point,json=deviationBasisPoint,proto3" json:"deviation_basis_point,omitempty"`
+	// aggregation_method is the method used to aggregate validator prices of the feed.
+	AggregationMethod AggregationMethod `protobuf:"varint,5,opt,name=aggregation_method,json=aggregationMethod,proto3,enum=band.feeds.v1beta1.AggregationMethod" json:"aggregation_method,omitempty"`
 }
 
 func (m *FeedWithDeviation) Reset()         { *m = FeedWithDeviation{} }
@@ -347,6 +400,13 @@ func (m *FeedWithDeviation) GetDeviationBasisPoint() int64 {
 	return 0
 }
 
+func (m *FeedWithDeviation) GetAggregationMethod() AggregationMethod {
+	if m != nil {
+		return m.AggregationMethod
+	}
+	return AGGREGATION_METHOD_UNSPECIFIED
+}
+
 // CurrentFeeds is a structure that holds a list of currently supported feeds, and its last update time and block.
 type CurrentFeeds struct {
 	// feeds is a list of currently supported feeds.
@@ -476,6 +536,209 @@ func (m *CurrentFeedWithDeviations) GetLastUpdateBlock() int64 {
 	return 0
 }
 
+// FeedAggregationMethod is a structure that defines the aggregation method of a signal id.
+type FeedAggregationMethod struct {
+	// signal_id is the signal id of the feed.
+	SignalID string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// aggregation_method is the method used to aggregate validator prices of the feed.
+	AggregationMethod AggregationMethod `protobuf:"varint,2,opt,name=aggregation_method,json=aggregationMethod,proto3,enum=band.feeds.v1beta1.AggregationMethod" json:"aggregation_method,omitempty"`
+}
+
+func (m *FeedAggregationMethod) Reset()         { *m = FeedAggregationMethod{} }
+func (m *FeedAggregationMethod) String() string { return proto.CompactTextString(m) }
+func (*FeedAggregationMethod) ProtoMessage()    {}
+func (*FeedAggregationMethod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{6}
+}
+func (m *FeedAggregationMethod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeedAggregationMethod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeedAggregationMethod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeedAggregationMethod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedAggregationMethod.Merge(m, src)
+}
+func (m *FeedAggregationMethod) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeedAggregationMethod) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedAggregationMethod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeedAggregationMethod proto.InternalMessageInfo
+
+func (m *FeedAggregationMethod) GetSignalID() string {
+	if m != nil {
+		return m.SignalID
+	}
+	return ""
+}
+
+func (m *FeedAggregationMethod) GetAggregationMethod() AggregationMethod {
+	if m != nil {
+		return m.AggregationMethod
+	}
+	return AGGREGATION_METHOD_UNSPECIFIED
+}
+
+// CircuitBreakerConfig is a structure that defines the circuit breaker configuration of a signal id.
+type CircuitBreakerConfig struct {
+	// signal_id is the signal id of the feed.
+	SignalID string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// max_deviation_basis_point is the maximum deviation in basis point of a new price from the previous price
+	// before the price is halted. Zero disables the halting.
+	MaxDeviationBasisPoint int64 `protobuf:"varint,2,opt,name=max_deviation_basis_point,json=maxDeviationBasisPoint,proto3" json:"max_deviation_basis_point,omitempty"`
+	// confirmation_window is the duration in seconds that a deviated price has to persist before it is published.
+	ConfirmationWindow int64 `protobuf:"varint,3,opt,name=confirmation_window,json=confirmationWindow,proto3" json:"confirmation_window,omitempty"`
+	// outlier_sigma_threshold is the number of standard deviations from the median beyond which a validator price
+	// is flagged as an outlier. Zero disables the flagging.
+	OutlierSigmaThreshold int64 `protobuf:"varint,4,opt,name=outlier_sigma_threshold,json=outlierSigmaThreshold,proto3" json:"outlier_sigma_threshold,omitempty"`
+}
+
+func (m *CircuitBreakerConfig) Reset()         { *m = CircuitBreakerConfig{} }
+func (m *CircuitBreakerConfig) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerConfig) ProtoMessage()    {}
+func (*CircuitBreakerConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{7}
+}
+func (m *CircuitBreakerConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerConfig.Merge(m, src)
+}
+func (m *CircuitBreakerConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerConfig proto.InternalMessageInfo
+
+func (m *CircuitBreakerConfig) GetSignalID() string {
+	if m != nil {
+		return m.SignalID
+	}
+	return ""
+}
+
+func (m *CircuitBreakerConfig) GetMaxDeviationBasisPoint() int64 {
+	if m != nil {
+		return m.MaxDeviationBasisPoint
+	}
+	return 0
+}
+
+func (m *CircuitBreakerConfig) GetConfirmationWindow() int64 {
+	if m != nil {
+		return m.ConfirmationWindow
+	}
+	return 0
+}
+
+func (m *CircuitBreakerConfig) GetOutlierSigmaThreshold() int64 {
+	if m != nil {
+		return m.OutlierSigmaThreshold
+	}
+	return 0
+}
+
+// CircuitBreakerState is a structure that defines the circuit breaker state of a signal id.
+type CircuitBreakerState struct {
+	// signal_id is the signal id of the feed.
+	SignalID string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// reference_price is the last published price that new prices are compared with.
+	ReferencePrice uint64 `protobuf:"varint,2,opt,name=reference_price,json=referencePrice,proto3" json:"reference_price,omitempty"`
+	// reference_timestamp is the timestamp of the reference price.
+	ReferenceTimestamp int64 `protobuf:"varint,3,opt,name=reference_timestamp,json=referenceTimestamp,proto3" json:"reference_timestamp,omitempty"`
+	// halted_at is the timestamp at which the price was halted, or zero if the price is not halted.
+	HaltedAt int64 `protobuf:"varint,4,opt,name=halted_at,json=haltedAt,proto3" json:"halted_at,omitempty"`
+}
+
+func (m *CircuitBreakerState) Reset()         { *m = CircuitBreakerState{} }
+func (m *CircuitBreakerState) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerState) ProtoMessage()    {}
+func (*CircuitBreakerState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc3afe81d3b13674, []int{8}
+}
+func (m *CircuitBreakerState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerState.Merge(m, src)
+}
+func (m *CircuitBreakerState) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerState) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerState proto.InternalMessageInfo
+
+func (m *CircuitBreakerState) GetSignalID() string {
+	if m != nil {
+		return m.SignalID
+	}
+	return ""
+}
+
+func (m *CircuitBreakerState) GetReferencePrice() uint64 {
+	if m != nil {
+		return m.ReferencePrice
+	}
+	return 0
+}
+
+func (m *CircuitBreakerState) GetReferenceTimestamp() int64 {
+	if m != nil {
+		return m.ReferenceTimestamp
+	}
+	return 0
+}
+
+func (m *CircuitBreakerState) GetHaltedAt() int64 {
+	if m != nil {
+		return m.HaltedAt
+	}
+	return 0
+}
+
 // Price is a structure that defines the price of a signal id.
 type Price struct {
 	// status is the status of a the price.
@@ -492,7 +755,7 @@ func (m *Price) Reset()         { *m = Price{} }
 func (m *Price) String() string { return proto.CompactTextString(m) }
 func (*Price) ProtoMessage()    {}
 func (*Price) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{6}
+	return fileDescriptor_fc3afe81d3b13674, []int{9}
 }
 func (m *Price) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -563,7 +826,7 @@ func (m *SignalPrice) Reset()         { *m = SignalPrice{} }
 func (m *SignalPrice) String() string { return proto.CompactTextString(m) }
 func (*SignalPrice) ProtoMessage()    {}
 func (*SignalPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{7}
+	return fileDescriptor_fc3afe81d3b13674, []int{10}
 }
 func (m *SignalPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -631,7 +894,7 @@ func (m *ValidatorPrice) Reset()         { *m = ValidatorPrice{} }
 func (m *ValidatorPrice) String() string { return proto.CompactTextString(m) }
 func (*ValidatorPrice) ProtoMessage()    {}
 func (*ValidatorPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{8}
+	return fileDescriptor_fc3afe81d3b13674, []int{11}
 }
 func (m *ValidatorPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -708,7 +971,7 @@ func (m *ValidatorPriceList) Reset()         { *m = ValidatorPriceList{} }
 func (m *ValidatorPriceList) String() string { return proto.CompactTextString(m) }
 func (*ValidatorPriceList) ProtoMessage()    {}
 func (*ValidatorPriceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{9}
+	return fileDescriptor_fc3afe81d3b13674, []int{12}
 }
 func (m *ValidatorPriceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -763,7 +1026,7 @@ func (m *ReferenceSourceConfig) Reset()         { *m = ReferenceSourceConfig{} }
 func (m *ReferenceSourceConfig) String() string { return proto.CompactTextString(m) }
 func (*ReferenceSourceConfig) ProtoMessage()    {}
 func (*ReferenceSourceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{10}
+	return fileDescriptor_fc3afe81d3b13674, []int{13}
 }
 func (m *ReferenceSourceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -818,7 +1081,7 @@ func (m *FeedsSignatureOrder) Reset()         { *m = FeedsSignatureOrder{} }
 func (m *FeedsSignatureOrder) String() string { return proto.CompactTextString(m) }
 func (*FeedsSignatureOrder) ProtoMessage()    {}
 func (*FeedsSignatureOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc3afe81d3b13674, []int{11}
+	return fileDescriptor_fc3afe81d3b13674, []int{14}
 }
 func (m *FeedsSignatureOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -849,6 +1112,7 @@ var xxx_messageInfo_FeedsSignatureOrder proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterEnum("band.feeds.v1beta1.PriceStatus", PriceStatus_name, PriceStatus_value)
+	proto.RegisterEnum("band.feeds.v1beta1.AggregationMethod", AggregationMethod_name, AggregationMethod_value)
 	proto.RegisterEnum("band.feeds.v1beta1.SignalPriceStatus", SignalPriceStatus_name, SignalPriceStatus_value)
 	proto.RegisterType((*Signal)(nil), "band.feeds.v1beta1.Signal")
 	proto.RegisterType((*Vote)(nil), "band.feeds.v1beta1.Vote")
@@ -856,6 +1120,9 @@ func init() {
 	proto.RegisterType((*FeedWithDeviation)(nil), "band.feeds.v1beta1.FeedWithDeviation")
 	proto.RegisterType((*CurrentFeeds)(nil), "band.feeds.v1beta1.CurrentFeeds")
 	proto.RegisterType((*CurrentFeedWithDeviations)(nil), "band.feeds.v1beta1.CurrentFeedWithDeviations")
+	proto.RegisterType((*FeedAggregationMethod)(nil), "band.feeds.v1beta1.FeedAggregationMethod")
+	proto.RegisterType((*CircuitBreakerConfig)(nil), "band.feeds.v1beta1.CircuitBreakerConfig")
+	proto.RegisterType((*CircuitBreakerState)(nil), "band.feeds.v1beta1.CircuitBreakerState")
 	proto.RegisterType((*Price)(nil), "band.feeds.v1beta1.Price")
 	proto.RegisterType((*SignalPrice)(nil), "band.feeds.v1beta1.SignalPrice")
 	proto.RegisterType((*ValidatorPrice)(nil), "band.feeds.v1beta1.ValidatorPrice")
@@ -867,69 +1134,88 @@ func init() {
 func init() { proto.RegisterFile("band/feeds/v1beta1/feeds.proto", fileDescriptor_fc3afe81d3b13674) }
 
 var fileDescriptor_fc3afe81d3b13674 = []byte{
-	// 989 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x6f, 0x1a, 0x47,
-	0x14, 0x66, 0x31, 0x76, 0xcc, 0xb3, 0x6b, 0xe3, 0xb1, 0x13, 0x6d, 0x68, 0x02, 0xb6, 0x2b, 0x4b,
-	0x8e, 0xd5, 0x80, 0xe2, 0xb4, 0xaa, 0x64, 0xb5, 0xaa, 0xc0, 0xac, 0xeb, 0x55, 0x2d, 0x8c, 0x76,
-	0xc1, 0x51, 0x7b, 0x59, 0x2d, 0xec, 0x00, 0xa3, 0xe2, 0x5d, 0x34, 0x33, 0xd0, 0xe4, 0xd6, 0x63,
-	0x0e, 0x3d, 0x54, 0xea, 0x1f, 0x88, 0x54, 0xf5, 0xd2, 0x5e, 0x7a, 0xf0, 0x4f, 0xe8, 0x21, 0xc7,
-	0x28, 0xa7, 0x9e, 0x50, 0x85, 0x2f, 0xbd, 0xf5, 0x2f, 0x54, 0x3b, 0x33, 0x0b, 0xc6, 0x86, 0x56,
-	0xaa, 0x64, 0xe5, 0xc6, 0x7c, 0xdf, 0xf7, 0xf6, 0x7d, 0xef, 0xcd, 0xdb, 0xc7, 0x42, 0xa6, 0xee,
-	0xfa, 0x5e, 0xbe, 0x89, 0xb1, 0xc7, 0xf2, 0xfd, 0x27, 0x75, 0xcc, 0xdd, 0x27, 0xf2, 0x94, 0xeb,
-	0xd2, 0x80, 0x07, 0x08, 0x85, 0x7c, 0x4e, 0x22, 0x8a, 0x4f, 0xdf, 0x6f, 0x04, 0xec, 0x3c, 0x60,
-	0x8e, 0x50, 0xe4, 0xe5, 0x41, 0xca, 0xd3, 0x1b, 0xad, 0xa0, 0x15, 0x48, 0x3c, 0xfc, 0xa5, 0xd0,
-	0xcd, 0x29, 0x49, 0xb0, 0xdf, 0x08, 0x3c, 0x4c, 0xa5, 0x62, 0xfb, 0x53, 0x58, 0xb0, 0x49, 0xcb,
-	0x77, 0x3b, 0xe8, 0x1e, 0xc4, 0x89, 0xa7, 0x6b, 0x9b, 0xda, 0x6e, 0xb2, 0xb8, 0x30, 0x1c, 0x64,
-	0xe3, 0x66, 0xc9, 0x8a, 0x13, 0x0f, 0x6d, 0xc0, 0x7c, 0x37, 0xf8, 0x16, 0x53, 0x3d, 0xbe, 0xa9,
-	0xed, 0xce, 0x59, 0xf2, 0x70, 0x90, 0xf8, 0xeb, 0x55, 0x56, 0xdb, 0x7e, 0x0e, 0x89, 0xb3, 0x80,
-	0x63, 0x94, 0x83, 0xf9, 0x7e, 0xc0, 0x31, 0x55, 0xe1, 0xfa, 0xdb, 0x8b, 0xc7, 0x1b, 0xca, 0x5e,
-	0xc1, 0xf3, 0x28, 0x66, 0xcc, 0xe6, 0x94, 0xf8, 0x2d, 0x4b, 0xca, 0xd0, 0x01, 0xdc, 0x61, 0x22,
-	0x2b, 0xd3, 0xe3, 0x9b, 0x73, 0xbb, 0x4b, 0xfb, 0xe9, 0xdc, 0xcd, 0x72, 0x73, 0xd2, 0x58, 0x31,
-	0xf1, 0x7a, 0x90, 0x8d, 0x59, 0x51, 0x80, 0xca, 0x4c, 0x20, 0x71, 0x84, 0xb1, 0x87, 0x1e, 0x41,
-	0x52, 0x12, 0xce, 0xc8, 0xfc, 0xf2, 0x70, 0x90, 0x5d, 0x94, 0xb1, 0x66, 0xc9, 0x5a, 0x94, 0xb4,
-	0x39, 0xa3, 0x10, 0x94, 0x86, 0x45, 0xe2, 0x73, 0x4c, 0xfb, 0x6e, 0x47, 0x9f, 0x13, 0xc4, 0xe8,
-	0xac, 0x52, 0xfd, 0xa2, 0xc1, 0x5a, 0x98, 0xeb, 0x19, 0xe1, 0xed, 0x12, 0xee, 0x13, 0x97, 0x93,
-	0xc0, 0xbf, 0xd5, 0xc4, 0x68, 0x1f, 0xee, 0x7a, 0x51, 0x26, 0xa7, 0xee, 0x32, 0xc2, 0x9c, 0x6e,
-	0x40, 0x7c, 0xae, 0x27, 0x84, 0x70, 0x7d, 0x44, 0x16, 0x43, 0xae, 0x12, 0x52, 0x63, 0xb3, 0xcb,
-	0x87, 0x3d, 0x4a, 0xb1, 0xcf, 0x43, 0xcf, 0x0c, 0x7d, 0x04, 0xf3, 0xa2, 0xab, 0xba, 0x26, 0x1a,
-	0xad, 0x4f, 0x6b, 0x74, 0xa8, 0x54, 0x6d, 0x96, 0xe2, 0xd0, 0x40, 0xc7, 0x65, 0xdc, 0xe9, 0x75,
-	0x3d, 0x97, 0x63, 0x87, 0x93, 0x73, 0xcc, 0xb8, 0x7b, 0xde, 0x55, 0x25, 0xac, 0x87, 0x64, 0x4d,
-	0x70, 0xd5, 0x88, 0x42, 0x7b, 0xb0, 0x76, 0x35, 0xa6, 0xde, 0x09, 0x1a, 0xdf, 0xa8, 0xca, 0x56,
-	0xc7, 0xfa, 0x62, 0x08, 0x2b, 0xb3, 0xbf, 0x6b, 0x70, 0xff, 0x8a, 0xd9, 0x89, 0x06, 0x33, 0x54,
-	0x98, 0x74, 0xbe, 0x33, 0xcb, 0xf9, 0x44, 0xd8, 0xbb, 0x28, 0xe3, 0x67, 0x0d, 0xe6, 0x2b, 0x94,
-	0x34, 0x30, 0xfa, 0x04, 0x16, 0x18, 0x77, 0x79, 0x8f, 0x89, 0x89, 0x58, 0xd9, 0xcf, 0x4e, 0xf3,
-	0x2c, 0xa4, 0xb6, 0x90, 0x59, 0x4a, 0x3e, 0x39, 0x4d, 0xf1, 0xff, 0x9c, 0xa6, 0xf0, 0x09, 0xc2,
-	0x53, 0xc2, 0x92, 0x07, 0xf4, 0x00, 0x92, 0xe3, 0xea, 0xe4, 0x94, 0x8c, 0x01, 0xe5, 0xf3, 0x47,
-	0x0d, 0x96, 0xe4, 0x03, 0xa5, 0xdb, 0xcf, 0xae, 0xb9, 0xdd, 0x99, 0xfd, 0x12, 0xde, 0x86, 0x67,
-	0xe5, 0xea, 0x6f, 0x0d, 0x56, 0xce, 0xdc, 0x0e, 0xf1, 0x5c, 0x1e, 0x50, 0x69, 0xac, 0x06, 0xeb,
-	0xea, 0xc9, 0x42, 0xe8, 0xfc, 0x1f, 0x97, 0x6b, 0xec, 0x3a, 0x74, 0xcb, 0x4d, 0x46, 0x5b, 0xb0,
-	0x2c, 0x86, 0xc5, 0x69, 0x63, 0xd2, 0x6a, 0x73, 0x7d, 0x5e, 0x08, 0x96, 0x04, 0x76, 0x2c, 0x20,
-	0x55, 0xf1, 0x6f, 0x1a, 0xa0, 0xc9, 0x8a, 0x4f, 0x08, 0xe3, 0xe8, 0x73, 0x48, 0xf6, 0x23, 0x54,
-	0x6d, 0x94, 0xad, 0xb7, 0x17, 0x8f, 0x1f, 0xaa, 0x45, 0x3a, 0x8a, 0x98, 0xdc, 0xa8, 0xe3, 0x18,
-	0x64, 0x43, 0x6a, 0x74, 0x90, 0x9d, 0x8b, 0xd6, 0xeb, 0xf6, 0xb4, 0x9e, 0x4d, 0x5a, 0x50, 0x2f,
-	0xce, 0x6a, 0x7f, 0x02, 0x8d, 0xd6, 0xed, 0xf7, 0x1a, 0xdc, 0xb5, 0x70, 0x13, 0x53, 0xec, 0x37,
-	0xb0, 0x1d, 0xf4, 0x68, 0x03, 0x1f, 0x06, 0x7e, 0x93, 0xb4, 0x50, 0x11, 0x10, 0xc5, 0x2d, 0xc2,
-	0x38, 0x7d, 0xe1, 0x90, 0x6e, 0x93, 0x39, 0x6d, 0x97, 0xb5, 0x95, 0xfd, 0x8d, 0xe1, 0x20, 0x9b,
-	0xb2, 0x14, 0x6b, 0x56, 0x8e, 0xec, 0x63, 0x97, 0xb5, 0xad, 0x54, 0xa4, 0x37, 0xbb, 0x4d, 0x16,
-	0x22, 0xe8, 0x11, 0x8c, 0x30, 0xa7, 0x8f, 0x29, 0x23, 0x81, 0x2f, 0xef, 0xc7, 0x5a, 0x8d, 0xf0,
-	0x33, 0x09, 0x2b, 0x3b, 0xdf, 0x69, 0xb0, 0x2e, 0xd6, 0x9b, 0xb8, 0x3a, 0xde, 0xa3, 0xf8, 0x94,
-	0x7a, 0x98, 0xa2, 0x0f, 0x01, 0x46, 0x37, 0x2c, 0xf7, 0x46, 0xb2, 0xf8, 0xde, 0x70, 0x90, 0x4d,
-	0x46, 0x57, 0xcc, 0xac, 0x64, 0x74, 0xc7, 0x0c, 0x7d, 0x0c, 0x77, 0xd4, 0x9f, 0xa1, 0xc8, 0xb6,
-	0xb2, 0xff, 0xfe, 0xb4, 0x36, 0x19, 0x52, 0x62, 0x45, 0xda, 0x83, 0xc4, 0xcb, 0x57, 0xd9, 0xd8,
-	0xde, 0x85, 0x06, 0x4b, 0x57, 0x87, 0xeb, 0x01, 0xe8, 0x15, 0xcb, 0x3c, 0x34, 0x1c, 0xbb, 0x5a,
-	0xa8, 0xd6, 0x6c, 0xa7, 0x56, 0xb6, 0x2b, 0xc6, 0xa1, 0x79, 0x64, 0x1a, 0xa5, 0x54, 0x0c, 0x6d,
-	0x43, 0xe6, 0x1a, 0xfb, 0x65, 0xf9, 0xf4, 0x59, 0xd9, 0xb1, 0xcd, 0x2f, 0xca, 0x85, 0x13, 0xc7,
-	0x2c, 0xa5, 0x34, 0x94, 0x86, 0x7b, 0x13, 0x9a, 0xf2, 0x69, 0xd5, 0xb1, 0x8c, 0x42, 0xe9, 0xab,
-	0x54, 0xfc, 0x06, 0x57, 0x38, 0x2b, 0x98, 0x27, 0x85, 0xe2, 0x89, 0x91, 0x9a, 0x43, 0x3b, 0xb0,
-	0x75, 0x23, 0xce, 0x2c, 0x3b, 0x87, 0x35, 0xcb, 0x32, 0xca, 0x55, 0xe7, 0xc8, 0x30, 0x4a, 0x76,
-	0x2a, 0x91, 0x4e, 0xbc, 0xfc, 0x29, 0x13, 0xdb, 0xfb, 0x55, 0x83, 0xb5, 0x1b, 0x2f, 0x0b, 0xfa,
-	0x00, 0xb2, 0xca, 0xc9, 0xbf, 0xd4, 0x30, 0x5b, 0x54, 0xab, 0x54, 0x4e, 0xad, 0xaa, 0x11, 0x16,
-	0x31, 0x53, 0x34, 0x76, 0x1c, 0x47, 0x5b, 0xf0, 0x70, 0x9a, 0xe8, 0x4a, 0x51, 0xd2, 0x6d, 0xf1,
-	0xf8, 0xf5, 0x30, 0xa3, 0xbd, 0x19, 0x66, 0xb4, 0x3f, 0x87, 0x19, 0xed, 0x87, 0xcb, 0x4c, 0xec,
-	0xcd, 0x65, 0x26, 0xf6, 0xc7, 0x65, 0x26, 0xf6, 0x75, 0xae, 0x45, 0x78, 0xbb, 0x57, 0xcf, 0x35,
-	0x82, 0xf3, 0x7c, 0x78, 0x69, 0xe2, 0x6b, 0xa6, 0x11, 0x74, 0xf2, 0x8d, 0xb6, 0x4b, 0xfc, 0x7c,
-	0xff, 0x69, 0xfe, 0xb9, 0xfa, 0xee, 0xe1, 0x2f, 0xba, 0x98, 0xd5, 0x17, 0x84, 0xe0, 0xe9, 0x3f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x51, 0x26, 0xa8, 0x77, 0x09, 0x00, 0x00,
+	// 1284 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xd8, 0x4e, 0x1a, 0xbf, 0x94, 0xc4, 0x19, 0x27, 0xad, 0x9b, 0xb6, 0x76, 0x62, 0x08,
+	0xa4, 0x55, 0x6b, 0xab, 0x29, 0x3f, 0x44, 0x05, 0x42, 0xeb, 0x78, 0x93, 0xac, 0x48, 0x1c, 0x6b,
+	0xed, 0x24, 0x82, 0xcb, 0x6a, 0xe3, 0x9d, 0xd8, 0xa3, 0xda, 0x5e, 0x6b, 0x66, 0xec, 0xa6, 0x37,
+	0x8e, 0x3d, 0x70, 0x40, 0xe2, 0x8e, 0x2a, 0x21, 0x2e, 0x70, 0xe1, 0x50, 0x09, 0x71, 0xe2, 0xc2,
+	0xa1, 0x17, 0xa4, 0xaa, 0x27, 0x4e, 0x11, 0x72, 0x2f, 0x48, 0x1c, 0xf8, 0x17, 0xd0, 0xee, 0xcc,
+	0xda, 0x71, 0xe2, 0x14, 0x15, 0x35, 0xe2, 0xe6, 0x79, 0xdf, 0x37, 0x33, 0xdf, 0xf7, 0xde, 0xf3,
+	0xcc, 0x2c, 0xa4, 0xf6, 0xed, 0x96, 0x93, 0x3b, 0x20, 0xc4, 0xe1, 0xb9, 0xee, 0x9d, 0x7d, 0x22,
+	0xec, 0x3b, 0x72, 0x94, 0x6d, 0x33, 0x57, 0xb8, 0x18, 0x7b, 0x78, 0x56, 0x46, 0x14, 0x3e, 0x7f,
+	0xa5, 0xea, 0xf2, 0xa6, 0xcb, 0x2d, 0x9f, 0x91, 0x93, 0x03, 0x49, 0x9f, 0x9f, 0xad, 0xb9, 0x35,
+	0x57, 0xc6, 0xbd, 0x5f, 0x2a, 0xba, 0x30, 0x62, 0x13, 0xd2, 0xaa, 0xba, 0x0e, 0x61, 0x92, 0x91,
+	0xf9, 0x08, 0xc6, 0xcb, 0xb4, 0xd6, 0xb2, 0x1b, 0xf8, 0x12, 0x84, 0xa9, 0x93, 0x44, 0x0b, 0x68,
+	0x39, 0x96, 0x1f, 0xef, 0x1d, 0xa5, 0xc3, 0x46, 0xc1, 0x0c, 0x53, 0x07, 0xcf, 0xc2, 0x58, 0xdb,
+	0x7d, 0x40, 0x58, 0x32, 0xbc, 0x80, 0x96, 0x23, 0xa6, 0x1c, 0xdc, 0x8b, 0xfe, 0xf9, 0x38, 0x8d,
+	0x32, 0x87, 0x10, 0xdd, 0x75, 0x05, 0xc1, 0x59, 0x18, 0xeb, 0xba, 0x82, 0x30, 0x35, 0x3d, 0xf9,
+	0xfc, 0xc9, 0xed, 0x59, 0x25, 0x4f, 0x73, 0x1c, 0x46, 0x38, 0x2f, 0x0b, 0x46, 0x5b, 0x35, 0x53,
+	0xd2, 0xf0, 0x3d, 0xb8, 0xc0, 0xfd, 0x5d, 0x79, 0x32, 0xbc, 0x10, 0x59, 0x9e, 0x5c, 0x99, 0xcf,
+	0x9e, 0xb6, 0x9b, 0x95, 0xc2, 0xf2, 0xd1, 0xa7, 0x47, 0xe9, 0x90, 0x19, 0x4c, 0x50, 0x3b, 0xff,
+	0x82, 0x20, 0xba, 0x46, 0x88, 0x83, 0x6f, 0x40, 0x4c, 0x22, 0x56, 0x5f, 0xfd, 0xc5, 0xde, 0x51,
+	0x7a, 0x42, 0x4e, 0x36, 0x0a, 0xe6, 0x84, 0x84, 0x8d, 0x33, 0x9c, 0xe0, 0x79, 0x98, 0xa0, 0x2d,
+	0x41, 0x58, 0xd7, 0x6e, 0x24, 0x23, 0x3e, 0xd0, 0x1f, 0xe3, 0x0a, 0x60, 0xbb, 0x56, 0x63, 0xa4,
+	0x66, 0x0b, 0xea, 0xb6, 0xac, 0x26, 0x11, 0x75, 0xd7, 0x49, 0x46, 0x17, 0xd0, 0xf2, 0xd4, 0xca,
+	0xd2, 0x28, 0xc9, 0xda, 0x80, 0xbd, 0xe5, 0x93, 0xcd, 0x19, 0xfb, 0x64, 0x48, 0x39, 0xf8, 0x22,
+	0x0c, 0x33, 0x9e, 0x83, 0x3d, 0x2a, 0xea, 0x05, 0xd2, 0xa5, 0x3e, 0xe3, 0x7c, 0xed, 0xac, 0xc0,
+	0x9c, 0x13, 0xec, 0x64, 0xed, 0xdb, 0x9c, 0x72, 0xab, 0xed, 0xd2, 0x96, 0xf0, 0x1d, 0x45, 0xcc,
+	0x44, 0x1f, 0xcc, 0x7b, 0x58, 0xc9, 0x83, 0xce, 0x48, 0xc1, 0xd8, 0x6b, 0x49, 0xc1, 0xf7, 0x08,
+	0x2e, 0xae, 0x76, 0x18, 0x23, 0x2d, 0xe1, 0x65, 0x82, 0xe3, 0x77, 0x61, 0xcc, 0x5f, 0x2c, 0x89,
+	0xfc, 0xae, 0x48, 0x8e, 0x5a, 0xdf, 0x63, 0xaa, 0x9e, 0x90, 0x64, 0xcf, 0x56, 0xc3, 0xe6, 0xc2,
+	0xea, 0xb4, 0x1d, 0x5b, 0x10, 0x4b, 0xd0, 0x26, 0xe1, 0xc2, 0x6e, 0xb6, 0x55, 0x62, 0x12, 0x1e,
+	0xb8, 0xe3, 0x63, 0x95, 0x00, 0xc2, 0x37, 0x61, 0xe6, 0xf8, 0x9c, 0xfd, 0x86, 0x5b, 0xbd, 0xaf,
+	0xf2, 0x35, 0x3d, 0xe0, 0xe7, 0xbd, 0xb0, 0x12, 0xfb, 0x2b, 0x82, 0x2b, 0xc7, 0xc4, 0x0e, 0x95,
+	0x8d, 0x63, 0x6d, 0x58, 0xf9, 0xd2, 0x59, 0xca, 0x87, 0xa6, 0xfd, 0x1f, 0x36, 0xbe, 0x41, 0x30,
+	0xe7, 0x09, 0x39, 0x55, 0xa6, 0x57, 0x69, 0xbd, 0xd1, 0x4d, 0x11, 0x7e, 0x2d, 0x4d, 0xf1, 0x17,
+	0x82, 0xd9, 0x55, 0xca, 0xaa, 0x1d, 0x2a, 0xf2, 0x8c, 0xd8, 0xf7, 0x09, 0x5b, 0x75, 0x5b, 0x07,
+	0xb4, 0xf6, 0x2a, 0xfa, 0x3e, 0x84, 0x2b, 0x4d, 0xfb, 0xd0, 0x1a, 0xdd, 0xec, 0x32, 0x9d, 0x97,
+	0x9a, 0xf6, 0x61, 0x61, 0x44, 0xbf, 0xe7, 0x20, 0x51, 0xf5, 0xf6, 0x63, 0x4d, 0x39, 0xf3, 0x01,
+	0x6d, 0x39, 0xee, 0x03, 0x95, 0x53, 0x7c, 0x1c, 0xda, 0xf3, 0x11, 0xfc, 0x3e, 0x5c, 0x76, 0x3b,
+	0xa2, 0x41, 0x09, 0xb3, 0x38, 0xad, 0x35, 0x6d, 0x4b, 0xd4, 0x19, 0xe1, 0x75, 0xb7, 0xe1, 0xa8,
+	0xbf, 0xd5, 0x9c, 0x82, 0xcb, 0x1e, 0x5a, 0x09, 0x40, 0xe5, 0xf6, 0x27, 0x04, 0x89, 0x61, 0xb7,
+	0x65, 0x61, 0x0b, 0xf2, 0x2a, 0x66, 0xdf, 0x81, 0x69, 0x46, 0x0e, 0x08, 0x23, 0xad, 0x2a, 0xb1,
+	0xda, 0x8c, 0x56, 0x89, 0x6f, 0x31, 0x6a, 0x4e, 0xf5, 0xc3, 0x25, 0x2f, 0xea, 0x59, 0x1b, 0x10,
+	0x07, 0xed, 0xa5, 0xac, 0xf5, 0xa1, 0x41, 0x77, 0x5d, 0x85, 0x58, 0xdd, 0x6e, 0x08, 0xe2, 0x58,
+	0x76, 0x70, 0x46, 0x4c, 0xc8, 0x80, 0x26, 0x32, 0xdf, 0x21, 0x18, 0x93, 0xeb, 0x7e, 0x00, 0xe3,
+	0x5c, 0xd8, 0xa2, 0xc3, 0x7d, 0xa1, 0x53, 0x2b, 0xe9, 0x51, 0x1d, 0xe0, 0x53, 0xcb, 0x3e, 0xcd,
+	0x54, 0xf4, 0x61, 0x93, 0xe1, 0x7f, 0x3d, 0xec, 0x7c, 0x6b, 0x11, 0xdf, 0x9a, 0x1c, 0xe0, 0x6b,
+	0x10, 0x1b, 0xf8, 0x90, 0x02, 0x07, 0x01, 0x95, 0xe1, 0xaf, 0x11, 0x4c, 0xca, 0x05, 0xa5, 0xda,
+	0x8f, 0x4f, 0xa8, 0x5d, 0x3a, 0xfb, 0xea, 0x39, 0x0f, 0xcd, 0x4a, 0xd5, 0xdf, 0x08, 0xa6, 0x76,
+	0xed, 0x06, 0x75, 0x6c, 0xe1, 0x32, 0x29, 0x6c, 0x07, 0x12, 0x6a, 0x65, 0x9f, 0x68, 0xfd, 0x17,
+	0x95, 0x33, 0xfc, 0x64, 0xe8, 0x9c, 0x93, 0x8c, 0x17, 0xe1, 0xa2, 0x7f, 0xea, 0x58, 0x75, 0x42,
+	0x6b, 0x75, 0xe1, 0xdf, 0x0c, 0x11, 0x73, 0xd2, 0x8f, 0x6d, 0xf8, 0x21, 0xe5, 0xf8, 0x47, 0x04,
+	0x78, 0xd8, 0xf1, 0x26, 0xe5, 0x02, 0x7f, 0x02, 0xb1, 0x6e, 0x10, 0x55, 0x8d, 0xbe, 0xf8, 0xfc,
+	0xc9, 0xed, 0xeb, 0xea, 0xf9, 0xd0, 0x9f, 0x31, 0xfc, 0x8e, 0x18, 0xcc, 0xc1, 0x65, 0x88, 0xf7,
+	0x07, 0x32, 0x73, 0xc1, 0xa3, 0x22, 0x33, 0x2a, 0x67, 0xc3, 0x12, 0xd4, 0x09, 0x3c, 0xdd, 0x1d,
+	0x8a, 0x06, 0x8f, 0x8c, 0x2f, 0x11, 0xcc, 0x99, 0xc1, 0xdf, 0xa2, 0xec, 0x76, 0x58, 0x95, 0xa8,
+	0xb3, 0x28, 0x0f, 0x98, 0x91, 0x1a, 0xe5, 0x82, 0x3d, 0xb4, 0x68, 0xfb, 0x80, 0x5b, 0x75, 0x9b,
+	0xd7, 0x95, 0xfc, 0xd9, 0xde, 0x51, 0x3a, 0x6e, 0x2a, 0xd4, 0x28, 0xad, 0x95, 0x37, 0x6c, 0x5e,
+	0x37, 0xe3, 0x01, 0xdf, 0x68, 0x1f, 0x70, 0x2f, 0x82, 0x6f, 0x40, 0x3f, 0x66, 0x75, 0x09, 0xe3,
+	0xd4, 0x6d, 0xc9, 0xfa, 0x98, 0xd3, 0x41, 0x7c, 0x57, 0x86, 0x83, 0x17, 0x03, 0x82, 0x84, 0x7f,
+	0x4f, 0xfa, 0xa5, 0x13, 0x1d, 0x46, 0xb6, 0x99, 0x43, 0x18, 0xbe, 0x05, 0xd0, 0xaf, 0xb0, 0xbc,
+	0x80, 0x62, 0xf9, 0x37, 0x7a, 0x47, 0xe9, 0x58, 0x50, 0x62, 0x6e, 0xc6, 0x82, 0x1a, 0x73, 0xfc,
+	0x1e, 0x5c, 0x50, 0x4f, 0x40, 0x75, 0x60, 0x5f, 0x1d, 0x95, 0x26, 0x5d, 0x52, 0xcc, 0x80, 0x7b,
+	0x2f, 0xfa, 0xe8, 0x71, 0x3a, 0x74, 0xf3, 0x37, 0x04, 0x93, 0xc7, 0x9b, 0xeb, 0x1a, 0x24, 0x4b,
+	0xa6, 0xb1, 0xaa, 0x5b, 0xe5, 0x8a, 0x56, 0xd9, 0x29, 0x5b, 0x3b, 0xc5, 0x72, 0x49, 0x5f, 0x35,
+	0xd6, 0x0c, 0xbd, 0x10, 0x0f, 0xe1, 0x0c, 0xa4, 0x4e, 0xa0, 0x9f, 0x16, 0xb7, 0xf7, 0x8a, 0x56,
+	0xd9, 0x58, 0x2f, 0x6a, 0x9b, 0x96, 0x51, 0x88, 0x23, 0x3c, 0x0f, 0x97, 0x86, 0x38, 0xc5, 0xed,
+	0x8a, 0x65, 0xea, 0x5a, 0xe1, 0xb3, 0x78, 0xf8, 0x14, 0xa6, 0xed, 0x6a, 0xc6, 0xa6, 0x96, 0xdf,
+	0xd4, 0xe3, 0x11, 0xbc, 0x04, 0x8b, 0xa7, 0xe6, 0x19, 0x45, 0x6b, 0x75, 0xc7, 0x34, 0xf5, 0x62,
+	0xc5, 0x5a, 0xd3, 0xf5, 0x42, 0x39, 0x1e, 0xc5, 0x97, 0x21, 0x31, 0x44, 0xdb, 0xd0, 0x36, 0x2b,
+	0x7a, 0x21, 0x3e, 0x36, 0x1f, 0x7d, 0xf4, 0x6d, 0x2a, 0x74, 0xf3, 0x67, 0x04, 0x33, 0xa7, 0x6f,
+	0xc2, 0x0c, 0xa4, 0xb4, 0xf5, 0x75, 0x53, 0x5f, 0xd7, 0x2a, 0xc6, 0x76, 0xd1, 0xda, 0xd2, 0x2b,
+	0x1b, 0xdb, 0x85, 0x13, 0xde, 0xde, 0x86, 0xcc, 0x08, 0xce, 0x9e, 0x6e, 0xac, 0x6f, 0x54, 0xf4,
+	0x82, 0xb5, 0xa5, 0x17, 0x0c, 0xad, 0x18, 0x47, 0xf8, 0x16, 0x2c, 0x8f, 0xe0, 0x55, 0x4c, 0x63,
+	0x6b, 0x4b, 0x1f, 0xe2, 0x6b, 0xc5, 0x78, 0x18, 0xbf, 0x05, 0x0b, 0x2f, 0x5f, 0x55, 0x2b, 0xc6,
+	0x23, 0x4a, 0xfb, 0x0f, 0x08, 0x66, 0x4e, 0x9d, 0x00, 0xf8, 0x4d, 0x48, 0xab, 0xf4, 0xbe, 0xa4,
+	0x30, 0x67, 0x93, 0x76, 0x4a, 0xa5, 0x6d, 0xd3, 0xcb, 0x10, 0x3a, 0x9b, 0x34, 0x28, 0x43, 0x18,
+	0x2f, 0xc2, 0xf5, 0x51, 0xa4, 0x63, 0x95, 0x92, 0x6a, 0xf3, 0x1b, 0x4f, 0x7b, 0x29, 0xf4, 0xac,
+	0x97, 0x42, 0x7f, 0xf4, 0x52, 0xe8, 0xab, 0x17, 0xa9, 0xd0, 0xb3, 0x17, 0xa9, 0xd0, 0xef, 0x2f,
+	0x52, 0xa1, 0xcf, 0xb3, 0x35, 0x2a, 0xea, 0x9d, 0xfd, 0x6c, 0xd5, 0x6d, 0xe6, 0xbc, 0x4e, 0xf4,
+	0x3f, 0x4c, 0xaa, 0x6e, 0x23, 0x57, 0xad, 0xdb, 0xb4, 0x95, 0xeb, 0xde, 0xcd, 0x1d, 0xaa, 0x4f,
+	0x18, 0xf1, 0xb0, 0x4d, 0xf8, 0xfe, 0xb8, 0x4f, 0xb8, 0xfb, 0xcf, 0x00, 0x05, 0xbd, 0xef, 0xbf,
+	0x42, 0x0d, 0x00, 0x00,
 }
 
 func (this *Signal) Equal(that interface{}) bool {
@@ -1019,6 +1305,9 @@ func (this *Feed) Equal(that interface{}) bool {
 	if this.Interval != that1.Interval {
 		return false
 	}
+	if this.AggregationMethod != that1.AggregationMethod {
+		return false
+	}
 	return true
 }
 func (this *FeedWithDeviation) Equal(that interface{}) bool {
@@ -1052,6 +1341,9 @@ func (this *FeedWithDeviation) Equal(that interface{}) bool {
 	if this.DeviationBasisPoint != that1.DeviationBasisPoint {
 		return false
 	}
+	if this.AggregationMethod != that1.AggregationMethod {
+		return false
+	}
 	return true
 }
 func (this *CurrentFeeds) Equal(that interface{}) bool {
@@ -1124,6 +1416,66 @@ func (this *CurrentFeedWithDeviations) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *FeedAggregationMethod) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeedAggregationMethod)
+	if !ok {
+		that2, ok := that.(FeedAggregationMethod)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SignalID != that1.SignalID {
+		return false
+	}
+	if this.AggregationMethod != that1.AggregationMethod {
+		return false
+	}
+	return true
+}
+func (this *CircuitBreakerConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CircuitBreakerConfig)
+	if !ok {
+		that2, ok := that.(CircuitBreakerConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SignalID != that1.SignalID {
+		return false
+	}
+	if this.MaxDeviationBasisPoint != that1.MaxDeviationBasisPoint {
+		return false
+	}
+	if this.ConfirmationWindow != that1.ConfirmationWindow {
+		return false
+	}
+	if this.OutlierSigmaThreshold != that1.OutlierSigmaThreshold {
+		return false
+	}
+	return true
+}
 func (this *Price) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1381,6 +1733,11 @@ func (m *Feed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AggregationMethod != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.AggregationMethod))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Interval != 0 {
 		i = encodeVarintFeeds(dAtA, i, uint64(m.Interval))
 		i--
@@ -1421,6 +1778,11 @@ func (m *FeedWithDeviation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AggregationMethod != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.AggregationMethod))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.DeviationBasisPoint != 0 {
 		i = encodeVarintFeeds(dAtA, i, uint64(m.DeviationBasisPoint))
 		i--
@@ -1540,7 +1902,7 @@ func (m *CurrentFeedWithDeviations) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *Price) Marshal() (dAtA []byte, err error) {
+func (m *FeedAggregationMethod) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1550,42 +1912,32 @@ func (m *Price) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Price) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeedAggregationMethod) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Price) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeedAggregationMethod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Price != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Price))
+	if m.AggregationMethod != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.AggregationMethod))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x10
 	}
 	if len(m.SignalID) > 0 {
 		i -= len(m.SignalID)
 		copy(dAtA[i:], m.SignalID)
 		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.Status != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SignalPrice) Marshal() (dAtA []byte, err error) {
+func (m *CircuitBreakerConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1595,37 +1947,42 @@ func (m *SignalPrice) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SignalPrice) MarshalTo(dAtA []byte) (int, error) {
+func (m *CircuitBreakerConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignalPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CircuitBreakerConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Price != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Price))
+	if m.OutlierSigmaThreshold != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.OutlierSigmaThreshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ConfirmationWindow != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.ConfirmationWindow))
 		i--
 		dAtA[i] = 0x18
 	}
+	if m.MaxDeviationBasisPoint != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.MaxDeviationBasisPoint))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.SignalID) > 0 {
 		i -= len(m.SignalID)
 		copy(dAtA[i:], m.SignalID)
 		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.Status != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorPrice) Marshal() (dAtA []byte, err error) {
+func (m *CircuitBreakerState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1635,23 +1992,153 @@ func (m *ValidatorPrice) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorPrice) MarshalTo(dAtA []byte) (int, error) {
+func (m *CircuitBreakerState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CircuitBreakerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Timestamp != 0 {
-		i = encodeVarintFeeds(dAtA, i, uint64(m.Timestamp))
+	if m.HaltedAt != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.HaltedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReferenceTimestamp != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.ReferenceTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReferencePrice != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.ReferencePrice))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalID) > 0 {
+		i -= len(m.SignalID)
+		copy(dAtA[i:], m.SignalID)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Price) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Price) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Price) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Price != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SignalID) > 0 {
+		i -= len(m.SignalID)
+		copy(dAtA[i:], m.SignalID)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignalPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Price != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SignalID) > 0 {
+		i -= len(m.SignalID)
+		copy(dAtA[i:], m.SignalID)
+		i = encodeVarintFeeds(dAtA, i, uint64(len(m.SignalID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintFeeds(dAtA, i, uint64(m.Timestamp))
 		i--
 		dAtA[i] = 0x20
 	}
@@ -1855,6 +2342,9 @@ func (m *Feed) Size() (n int) {
 	if m.Interval != 0 {
 		n += 1 + sovFeeds(uint64(m.Interval))
 	}
+	if m.AggregationMethod != 0 {
+		n += 1 + sovFeeds(uint64(m.AggregationMethod))
+	}
 	return n
 }
 
@@ -1877,6 +2367,9 @@ func (m *FeedWithDeviation) Size() (n int) {
 	if m.DeviationBasisPoint != 0 {
 		n += 1 + sovFeeds(uint64(m.DeviationBasisPoint))
 	}
+	if m.AggregationMethod != 0 {
+		n += 1 + sovFeeds(uint64(m.AggregationMethod))
+	}
 	return n
 }
 
@@ -1922,6 +2415,66 @@ func (m *CurrentFeedWithDeviations) Size() (n int) {
 	return n
 }
 
+func (m *FeedAggregationMethod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalID)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.AggregationMethod != 0 {
+		n += 1 + sovFeeds(uint64(m.AggregationMethod))
+	}
+	return n
+}
+
+func (m *CircuitBreakerConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalID)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.MaxDeviationBasisPoint != 0 {
+		n += 1 + sovFeeds(uint64(m.MaxDeviationBasisPoint))
+	}
+	if m.ConfirmationWindow != 0 {
+		n += 1 + sovFeeds(uint64(m.ConfirmationWindow))
+	}
+	if m.OutlierSigmaThreshold != 0 {
+		n += 1 + sovFeeds(uint64(m.OutlierSigmaThreshold))
+	}
+	return n
+}
+
+func (m *CircuitBreakerState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalID)
+	if l > 0 {
+		n += 1 + l + sovFeeds(uint64(l))
+	}
+	if m.ReferencePrice != 0 {
+		n += 1 + sovFeeds(uint64(m.ReferencePrice))
+	}
+	if m.ReferenceTimestamp != 0 {
+		n += 1 + sovFeeds(uint64(m.ReferenceTimestamp))
+	}
+	if m.HaltedAt != 0 {
+		n += 1 + sovFeeds(uint64(m.HaltedAt))
+	}
+	return n
+}
+
 func (m *Price) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2364,6 +2917,25 @@ func (m *Feed) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationMethod", wireType)
+			}
+			m.AggregationMethod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregationMethod |= AggregationMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeeds(dAtA[iNdEx:])
@@ -2503,6 +3075,25 @@ func (m *FeedWithDeviation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationMethod", wireType)
+			}
+			m.AggregationMethod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregationMethod |= AggregationMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeeds(dAtA[iNdEx:])
@@ -2768,6 +3359,385 @@ func (m *CurrentFeedWithDeviations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FeedAggregationMethod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeedAggregationMethod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeedAggregationMethod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationMethod", wireType)
+			}
+			m.AggregationMethod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregationMethod |= AggregationMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviationBasisPoint", wireType)
+			}
+			m.MaxDeviationBasisPoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeviationBasisPoint |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationWindow", wireType)
+			}
+			m.ConfirmationWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmationWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutlierSigmaThreshold", wireType)
+			}
+			m.OutlierSigmaThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutlierSigmaThreshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
+			}
+			m.ReferencePrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferencePrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceTimestamp", wireType)
+			}
+			m.ReferenceTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferenceTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedAt", wireType)
+			}
+			m.HaltedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Price) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,17 +5,27 @@ func NewGenesisState(
 	params Params,
 	votes []Vote,
 	rs ReferenceSourceConfig,
+	feedAggregationMethods []FeedAggregationMethod,
+	circuitBreakerConfigs []CircuitBreakerConfig,
 ) *GenesisState {
 	return &GenesisState{
-		Params:                params,
-		Votes:                 votes,
-		ReferenceSourceConfig: rs,
+		Params:                 params,
+		Votes:                  votes,
+		ReferenceSourceConfig:  rs,
+		FeedAggregationMethods: feedAggregationMethods,
+		CircuitBreakerConfigs:  circuitBreakerConfigs,
 	}
 }
 
 // DefaultGenesisState returns the default genesis state
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), []Vote{}, DefaultReferenceSourceConfig())
+	return NewGenesisState(
+		DefaultParams(),
+		[]Vote{},
+		DefaultReferenceSourceConfig(),
+		[]FeedAggregationMethod{},
+		[]CircuitBreakerConfig{},
+	)
 }
 
 // Validate performs basic genesis state validation
@@ -34,5 +44,29 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	signalIDs := make(map[string]struct{})
+	for _, fam := range gs.FeedAggregationMethods {
+		if err := fam.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := signalIDs[fam.SignalID]; exists {
+			return ErrDuplicateSignalID.Wrapf("duplicate signal ID found: %s", fam.SignalID)
+		}
+		signalIDs[fam.SignalID] = struct{}{}
+	}
+
+	circuitBreakerSignalIDs := make(map[string]struct{})
+	for _, config := range gs.CircuitBreakerConfigs {
+		if err := config.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := circuitBreakerSignalIDs[config.SignalID]; exists {
+			return ErrDuplicateSignalID.Wrapf("duplicate signal ID found: %s", config.SignalID)
+		}
+		circuitBreakerSignalIDs[config.SignalID] = struct{}{}
+	}
+
 	return nil
 }
@@ -31,6 +31,10 @@ type GenesisState struct {
 	Votes []Vote `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes"`
 	// reference_source_config is the information about reference price config.
 	ReferenceSourceConfig ReferenceSourceConfig `protobuf:"bytes,3,opt,name=reference_source_config,json=referenceSourceConfig,proto3" json:"reference_source_config"`
+	// feed_aggregation_methods is a list of aggregation methods of signal ids that do not use the default method.
+	FeedAggregationMethods []FeedAggregationMethod `protobuf:"bytes,4,rep,name=feed_aggregation_methods,json=feedAggregationMethods,proto3" json:"feed_aggregation_methods"`
+	// circuit_breaker_configs is a list of circuit breaker configurations of signal ids.
+	CircuitBreakerConfigs []CircuitBreakerConfig `protobuf:"bytes,5,rep,name=circuit_breaker_configs,json=circuitBreakerConfigs,proto3" json:"circuit_breaker_configs"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +91,20 @@ func (m *GenesisState) GetReferenceSourceConfig() ReferenceSourceConfig {
 	return ReferenceSourceConfig{}
 }
 
+func (m *GenesisState) GetFeedAggregationMethods() []FeedAggregationMethod {
+	if m != nil {
+		return m.FeedAggregationMethods
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitBreakerConfigs() []CircuitBreakerConfig {
+	if m != nil {
+		return m.CircuitBreakerConfigs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "band.feeds.v1beta1.GenesisState")
 }
@@ -94,25 +112,30 @@ func init() {
 func init() { proto.RegisterFile("band/feeds/v1beta1/genesis.proto", fileDescriptor_3665d63bc534e43f) }
 
 var fileDescriptor_3665d63bc534e43f = []byte{
-	// 285 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x63, 0x0a, 0x1d, 0x52, 0xa6, 0x08, 0x44, 0x94, 0xc1, 0x8d, 0x98, 0xca, 0x62, 0xab,
-	0x2d, 0x03, 0x33, 0x0c, 0x30, 0xa2, 0x56, 0x62, 0x60, 0xa9, 0x1c, 0xf7, 0xe2, 0x46, 0xa2, 0x71,
-	0x64, 0xbb, 0x11, 0xbc, 0x05, 0x8f, 0xd5, 0xb1, 0x23, 0x13, 0x42, 0xc9, 0xc0, 0x6b, 0xa0, 0x38,
-	0x66, 0xaa, 0xb7, 0x93, 0xfe, 0xef, 0xff, 0xee, 0x74, 0x61, 0x9a, 0xb1, 0x72, 0x4d, 0x73, 0x80,
-	0xb5, 0xa6, 0xf5, 0x34, 0x03, 0xc3, 0xa6, 0x54, 0x40, 0x09, 0xba, 0xd0, 0xa4, 0x52, 0xd2, 0xc8,
-	0x28, 0xea, 0x08, 0x62, 0x09, 0xe2, 0x88, 0xe4, 0x42, 0x48, 0x21, 0x6d, 0x4c, 0xbb, 0xa9, 0x27,
-	0x93, 0xb1, 0xc7, 0x55, 0x31, 0xc5, 0xb6, 0x4e, 0x95, 0x60, 0x0f, 0xd0, 0x8b, 0x6d, 0x7e, 0xfd,
-	0x8b, 0xc2, 0xf3, 0xc7, 0x7e, 0xf9, 0xd2, 0x30, 0x03, 0xd1, 0x5d, 0x38, 0xec, 0x05, 0x31, 0x4a,
-	0xd1, 0x64, 0x34, 0x4b, 0xc8, 0xf1, 0x31, 0xe4, 0xd9, 0x12, 0xf7, 0xa7, 0xfb, 0xef, 0x71, 0xb0,
-	0x70, 0x7c, 0x74, 0x1b, 0x9e, 0xd5, 0xd2, 0x80, 0x8e, 0x4f, 0xd2, 0xc1, 0x64, 0x34, 0x8b, 0x7d,
-	0xc5, 0x17, 0x69, 0xc0, 0xd5, 0x7a, 0x38, 0x12, 0xe1, 0x95, 0x82, 0x1c, 0x14, 0x94, 0x1c, 0x56,
-	0x5a, 0xee, 0x14, 0x87, 0x15, 0x97, 0x65, 0x5e, 0x88, 0x78, 0x60, 0x0f, 0xb8, 0xf1, 0x79, 0x16,
-	0xff, 0x95, 0xa5, 0x6d, 0x3c, 0xd8, 0x82, 0x13, 0x5f, 0x2a, 0x6f, 0xf8, 0xb4, 0x6f, 0x30, 0x3a,
-	0x34, 0x18, 0xfd, 0x34, 0x18, 0x7d, 0xb6, 0x38, 0x38, 0xb4, 0x38, 0xf8, 0x6a, 0x71, 0xf0, 0x4a,
-	0x44, 0x61, 0x36, 0xbb, 0x8c, 0x70, 0xb9, 0xa5, 0xdd, 0x2e, 0xfb, 0x19, 0x2e, 0xdf, 0x28, 0xdf,
-	0xb0, 0xa2, 0xa4, 0xf5, 0x9c, 0xbe, 0xbb, 0x0f, 0x9a, 0x8f, 0x0a, 0x74, 0x36, 0xb4, 0xc0, 0xfc,
-	0x2f, 0x00, 0x00, 0xff, 0xff, 0x44, 0xfc, 0x4b, 0xbb, 0xc9, 0x01, 0x00, 0x00,
+	// 361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xb1, 0x4e, 0xeb, 0x30,
+	0x18, 0x85, 0x93, 0x9b, 0xb6, 0x43, 0x7a, 0xa7, 0xe8, 0x5e, 0x1a, 0x65, 0x48, 0x23, 0xa6, 0xb2,
+	0xc4, 0x6a, 0xcb, 0xc0, 0x4a, 0x2b, 0x01, 0x0b, 0x12, 0x6a, 0x25, 0x06, 0x96, 0xc8, 0x71, 0xfe,
+	0xb8, 0x16, 0x34, 0xae, 0x6c, 0xb7, 0x82, 0xb7, 0xe0, 0x79, 0x78, 0x82, 0x8e, 0x1d, 0x99, 0x10,
+	0x6a, 0x5f, 0x04, 0xc5, 0x31, 0x42, 0x08, 0xb3, 0x45, 0x3a, 0xdf, 0x77, 0x8e, 0xad, 0xd8, 0x4f,
+	0x72, 0x5c, 0x15, 0xa8, 0x04, 0x28, 0x24, 0xda, 0x0c, 0x73, 0x50, 0x78, 0x88, 0x28, 0x54, 0x20,
+	0x99, 0x4c, 0x57, 0x82, 0x2b, 0x1e, 0x04, 0x35, 0x91, 0x6a, 0x22, 0x35, 0x44, 0xf4, 0x8f, 0x72,
+	0xca, 0x75, 0x8c, 0xea, 0xaf, 0x86, 0x8c, 0xfa, 0x96, 0xae, 0x15, 0x16, 0x78, 0x69, 0xaa, 0xa2,
+	0xd8, 0x02, 0x34, 0xc5, 0x3a, 0x3f, 0x7e, 0xf1, 0xfc, 0xbf, 0x97, 0xcd, 0xf8, 0x5c, 0x61, 0x05,
+	0xc1, 0x99, 0xdf, 0x69, 0x0a, 0x42, 0x37, 0x71, 0x07, 0xdd, 0x51, 0x94, 0xfe, 0x3c, 0x4c, 0x7a,
+	0xa3, 0x89, 0x49, 0x6b, 0xfb, 0xd6, 0x77, 0x66, 0x86, 0x0f, 0x4e, 0xfd, 0xf6, 0x86, 0x2b, 0x90,
+	0xe1, 0x9f, 0xc4, 0x1b, 0x74, 0x47, 0xa1, 0x4d, 0xbc, 0xe5, 0x0a, 0x8c, 0xd6, 0xc0, 0x01, 0xf5,
+	0x7b, 0x02, 0x4a, 0x10, 0x50, 0x11, 0xc8, 0x24, 0x5f, 0x0b, 0x02, 0x19, 0xe1, 0x55, 0xc9, 0x68,
+	0xe8, 0xe9, 0x03, 0x9c, 0xd8, 0x7a, 0x66, 0x9f, 0xca, 0x5c, 0x1b, 0x53, 0x2d, 0x98, 0xe2, 0xff,
+	0xc2, 0x16, 0x06, 0xcc, 0x0f, 0xeb, 0x8e, 0x0c, 0x53, 0x2a, 0x80, 0x62, 0xc5, 0x78, 0x95, 0x2d,
+	0x41, 0x2d, 0x78, 0x21, 0xc3, 0x56, 0xe2, 0xfd, 0xb6, 0x74, 0x01, 0x50, 0x9c, 0x7f, 0x29, 0xd7,
+	0xda, 0x30, 0x4b, 0x47, 0xa5, 0x2d, 0x94, 0x41, 0xe9, 0xf7, 0x08, 0x13, 0x64, 0xcd, 0x54, 0x96,
+	0x0b, 0xc0, 0xf7, 0x20, 0xcc, 0x95, 0x64, 0xd8, 0xd6, 0x4b, 0x03, 0xdb, 0xd2, 0xb4, 0x51, 0x26,
+	0x8d, 0xf1, 0xfd, 0x4a, 0xc4, 0x92, 0xc9, 0xc9, 0xd5, 0x76, 0x1f, 0xbb, 0xbb, 0x7d, 0xec, 0xbe,
+	0xef, 0x63, 0xf7, 0xf9, 0x10, 0x3b, 0xbb, 0x43, 0xec, 0xbc, 0x1e, 0x62, 0xe7, 0x2e, 0xa5, 0x4c,
+	0x2d, 0xd6, 0x79, 0x4a, 0xf8, 0x12, 0xd5, 0x53, 0xfa, 0x67, 0x13, 0xfe, 0x80, 0xc8, 0x02, 0xb3,
+	0x0a, 0x6d, 0xc6, 0xe8, 0xd1, 0x3c, 0x0a, 0xf5, 0xb4, 0x02, 0x99, 0x77, 0x34, 0x30, 0xfe, 0x18,
+	0x00, 0x79, 0x4f, 0xf5, 0x4f, 0x9c, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +158,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CircuitBreakerConfigs) > 0 {
+		for iNdEx := len(m.CircuitBreakerConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakerConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FeedAggregationMethods) > 0 {
+		for iNdEx := len(m.FeedAggregationMethods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeedAggregationMethods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.ReferenceSourceConfig.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -199,6 +250,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.ReferenceSourceConfig.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FeedAggregationMethods) > 0 {
+		for _, e := range m.FeedAggregationMethods {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CircuitBreakerConfigs) > 0 {
+		for _, e := range m.CircuitBreakerConfigs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -337,6 +400,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedAggregationMethods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedAggregationMethods = append(m.FeedAggregationMethods, FeedAggregationMethod{})
+			if err := m.FeedAggregationMethods[len(m.FeedAggregationMethods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerConfigs = append(m.CircuitBreakerConfigs, CircuitBreakerConfig{})
+			if err := m.CircuitBreakerConfigs[len(m.CircuitBreakerConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
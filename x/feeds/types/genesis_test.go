@@ -35,6 +35,56 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid feed aggregation method",
+			GenesisState{
+				Params:                DefaultParams(),
+				Votes:                 []Vote{},
+				ReferenceSourceConfig: DefaultReferenceSourceConfig(),
+				FeedAggregationMethods: []FeedAggregationMethod{
+					{SignalID: "", AggregationMethod: AGGREGATION_METHOD_WEIGHTED_MEAN},
+				},
+			},
+			true,
+		},
+		{
+			"duplicate feed aggregation method",
+			GenesisState{
+				Params:                DefaultParams(),
+				Votes:                 []Vote{},
+				ReferenceSourceConfig: DefaultReferenceSourceConfig(),
+				FeedAggregationMethods: []FeedAggregationMethod{
+					{SignalID: "CS:BAND-USD", AggregationMethod: AGGREGATION_METHOD_WEIGHTED_MEAN},
+					{SignalID: "CS:BAND-USD", AggregationMethod: AGGREGATION_METHOD_WEIGHTED_MEDIAN},
+				},
+			},
+			true,
+		},
+		{
+			"invalid circuit breaker config",
+			GenesisState{
+				Params:                DefaultParams(),
+				Votes:                 []Vote{},
+				ReferenceSourceConfig: DefaultReferenceSourceConfig(),
+				CircuitBreakerConfigs: []CircuitBreakerConfig{
+					{SignalID: "CS:BAND-USD", MaxDeviationBasisPoint: -1},
+				},
+			},
+			true,
+		},
+		{
+			"duplicate circuit breaker config",
+			GenesisState{
+				Params:                DefaultParams(),
+				Votes:                 []Vote{},
+				ReferenceSourceConfig: DefaultReferenceSourceConfig(),
+				CircuitBreakerConfigs: []CircuitBreakerConfig{
+					NewCircuitBreakerConfig("CS:BAND-USD", 1000, 60, 3),
+					NewCircuitBreakerConfig("CS:BAND-USD", 0, 0, 3),
+				},
+			},
+			true,
+		},
 		{
 			"invalid reference source config",
 			GenesisState{
@@ -28,10 +28,15 @@ var (
 	CurrentFeedsStoreKey          = []byte{0x01}
 
 	// store prefixes
-	ValidatorPriceListStoreKeyPrefix = []byte{0x10}
-	PriceStoreKeyPrefix              = []byte{0x11}
-	VoteStoreKeyPrefix               = []byte{0x12}
-	SignalTotalPowerStoreKeyPrefix   = []byte{0x13}
+	ValidatorPriceListStoreKeyPrefix   = []byte{0x10}
+	PriceStoreKeyPrefix                = []byte{0x11}
+	VoteStoreKeyPrefix                 = []byte{0x12}
+	SignalTotalPowerStoreKeyPrefix     = []byte{0x13}
+	PriceHistoryStoreKeyPrefix         = []byte{0x14}
+	AggregationMethodStoreKeyPrefix    = []byte{0x15}
+	CircuitBreakerConfigStoreKeyPrefix = []byte{0x16}
+	CircuitBreakerStateStoreKeyPrefix  = []byte{0x17}
+	PriceHistoryCountStoreKeyPrefix    = []byte{0x18}
 
 	// index prefixes
 	SignalTotalPowerByPowerIndexKeyPrefix = []byte{0x80}
@@ -60,6 +65,36 @@ func PriceStoreKey(signalID string) []byte {
 	return append(PriceStoreKeyPrefix, []byte(signalID)...)
 }
 
+// PriceHistoryBySignalIDStoreKey creates a key prefix for storing the price history of a signal id
+func PriceHistoryBySignalIDStoreKey(signalID string) []byte {
+	return append(PriceHistoryStoreKeyPrefix, address.MustLengthPrefix([]byte(signalID))...)
+}
+
+// PriceHistoryStoreKey creates a key for storing a historical price of a signal id at the given timestamp
+func PriceHistoryStoreKey(signalID string, timestamp int64) []byte {
+	return append(PriceHistoryBySignalIDStoreKey(signalID), sdk.Uint64ToBigEndian(uint64(timestamp))...)
+}
+
+// PriceHistoryCountStoreKey creates a key for storing the number of historical prices of a signal id
+func PriceHistoryCountStoreKey(signalID string) []byte {
+	return append(PriceHistoryCountStoreKeyPrefix, []byte(signalID)...)
+}
+
+// AggregationMethodStoreKey creates a key for storing the aggregation method of a signal id
+func AggregationMethodStoreKey(signalID string) []byte {
+	return append(AggregationMethodStoreKeyPrefix, []byte(signalID)...)
+}
+
+// CircuitBreakerConfigStoreKey creates a key for storing a circuit breaker config of a signal id
+func CircuitBreakerConfigStoreKey(signalID string) []byte {
+	return append(CircuitBreakerConfigStoreKeyPrefix, []byte(signalID)...)
+}
+
+// CircuitBreakerStateStoreKey creates a key for storing a circuit breaker state of a signal id
+func CircuitBreakerStateStoreKey(signalID string) []byte {
+	return append(CircuitBreakerStateStoreKeyPrefix, []byte(signalID)...)
+}
+
 // SignalTotalPowerByPowerIndexKey creates a key for storing signal-total-powers by power index
 func SignalTotalPowerByPowerIndexKey(signalID string, power int64) []byte {
 	powerBytes := make([]byte, 8)
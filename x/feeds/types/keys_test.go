@@ -69,3 +69,29 @@ func TestSignalTotalPowerByPowerIndexKey(t *testing.T) {
 	expectLargePower, _ := hex.DecodeString("807fffffffffffffff00")
 	require.Equal(t, expectLargePower, SignalTotalPowerByPowerIndexKey("", largePower))
 }
+
+func TestPriceHistoryStoreKey(t *testing.T) {
+	// Prefix: 0x14
+	expect, _ := hex.DecodeString("140442414e44")
+	require.Equal(t, expect, PriceHistoryBySignalIDStoreKey("BAND"))
+
+	// Test with signal ID "BAND" and timestamp 100
+	expect, _ = hex.DecodeString("140442414e440000000000000064")
+	require.Equal(t, expect, PriceHistoryStoreKey("BAND", 100))
+}
+
+func TestAggregationMethodStoreKey(t *testing.T) {
+	// Prefix: 0x15
+	expect, _ := hex.DecodeString("1542414e44")
+	require.Equal(t, expect, AggregationMethodStoreKey("BAND"))
+}
+
+func TestCircuitBreakerStoreKeys(t *testing.T) {
+	// Prefix: 0x16
+	expect, _ := hex.DecodeString("1642414e44")
+	require.Equal(t, expect, CircuitBreakerConfigStoreKey("BAND"))
+
+	// Prefix: 0x17
+	expect, _ = hex.DecodeString("1742414e44")
+	require.Equal(t, expect, CircuitBreakerStateStoreKey("BAND"))
+}
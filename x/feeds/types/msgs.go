@@ -12,11 +12,15 @@ var (
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgUpdateReferenceSourceConfig)(nil)
 	_ sdk.Msg = (*MsgVote)(nil)
+	_ sdk.Msg = (*MsgUpdateFeedAggregationMethods)(nil)
+	_ sdk.Msg = (*MsgUpdateCircuitBreakerConfigs)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgSubmitSignalPrices)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateReferenceSourceConfig)(nil)
 	_ sdk.HasValidateBasic = (*MsgVote)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateFeedAggregationMethods)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateCircuitBreakerConfigs)(nil)
 )
 
 // ====================================
@@ -139,6 +143,96 @@ func (m *MsgUpdateReferenceSourceConfig) ValidateBasic() error {
 	return nil
 }
 
+// ====================================
+// MsgUpdateFeedAggregationMethods
+// ====================================
+
+// NewMsgUpdateFeedAggregationMethods creates a new MsgUpdateFeedAggregationMethods instance.
+func NewMsgUpdateFeedAggregationMethods(
+	admin string,
+	feedAggregationMethods []FeedAggregationMethod,
+) *MsgUpdateFeedAggregationMethods {
+	return &MsgUpdateFeedAggregationMethods{
+		Admin:                  admin,
+		FeedAggregationMethods: feedAggregationMethods,
+	}
+}
+
+// ValidateBasic does a check on the provided data.
+func (m *MsgUpdateFeedAggregationMethods) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return errorsmod.Wrap(err, "invalid admin address")
+	}
+
+	if len(m.FeedAggregationMethods) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("feed aggregation methods cannot be empty")
+	}
+
+	// Map to track signal IDs for duplicate check
+	signalIDSet := make(map[string]struct{})
+
+	for _, fam := range m.FeedAggregationMethods {
+		if err := fam.Validate(); err != nil {
+			return err
+		}
+
+		// Check for duplicate signal IDs
+		if _, exists := signalIDSet[fam.SignalID]; exists {
+			return ErrDuplicateSignalID.Wrapf(
+				"duplicate signal ID found: %s", fam.SignalID,
+			)
+		}
+		signalIDSet[fam.SignalID] = struct{}{}
+	}
+
+	return nil
+}
+
+// ====================================
+// MsgUpdateCircuitBreakerConfigs
+// ====================================
+
+// NewMsgUpdateCircuitBreakerConfigs creates a new MsgUpdateCircuitBreakerConfigs instance.
+func NewMsgUpdateCircuitBreakerConfigs(
+	admin string,
+	circuitBreakerConfigs []CircuitBreakerConfig,
+) *MsgUpdateCircuitBreakerConfigs {
+	return &MsgUpdateCircuitBreakerConfigs{
+		Admin:                 admin,
+		CircuitBreakerConfigs: circuitBreakerConfigs,
+	}
+}
+
+// ValidateBasic does a check on the provided data.
+func (m *MsgUpdateCircuitBreakerConfigs) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return errorsmod.Wrap(err, "invalid admin address")
+	}
+
+	if len(m.CircuitBreakerConfigs) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("circuit breaker configs cannot be empty")
+	}
+
+	// Map to track signal IDs for duplicate check
+	signalIDSet := make(map[string]struct{})
+
+	for _, config := range m.CircuitBreakerConfigs {
+		if err := config.Validate(); err != nil {
+			return err
+		}
+
+		// Check for duplicate signal IDs
+		if _, exists := signalIDSet[config.SignalID]; exists {
+			return ErrDuplicateSignalID.Wrapf(
+				"duplicate signal ID found: %s", config.SignalID,
+			)
+		}
+		signalIDSet[config.SignalID] = struct{}{}
+	}
+
+	return nil
+}
+
 // ====================================
 // MsgVote
 // ====================================
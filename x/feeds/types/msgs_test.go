@@ -22,10 +22,16 @@ var (
 			Power: 10000000000,
 		},
 	}
-	ValidParams                = DefaultParams()
-	ValidReferenceSourceConfig = DefaultReferenceSourceConfig()
-	ValidTimestamp             = int64(1234567890)
-	ValidSignalPrices          = []SignalPrice{
+	ValidParams                 = DefaultParams()
+	ValidReferenceSourceConfig  = DefaultReferenceSourceConfig()
+	ValidTimestamp              = int64(1234567890)
+	ValidFeedAggregationMethods = []FeedAggregationMethod{
+		{
+			SignalID:          "CS:BAND-USD",
+			AggregationMethod: AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN,
+		},
+	}
+	ValidSignalPrices = []SignalPrice{
 		{
 			Status:   SIGNAL_PRICE_STATUS_AVAILABLE,
 			SignalID: "CS:BTC-USD",
@@ -106,6 +112,90 @@ func TestMsgUpdateReferenceSourceConfig_ValidateBasic(t *testing.T) {
 	require.Error(t, err)
 }
 
+// ====================================
+// MsgUpdateFeedAggregationMethods
+// ====================================
+
+func TestNewMsgUpdateFeedAggregationMethods(t *testing.T) {
+	msg := NewMsgUpdateFeedAggregationMethods(ValidAdmin, ValidFeedAggregationMethods)
+	require.Equal(t, ValidAdmin, msg.Admin)
+	require.Equal(t, ValidFeedAggregationMethods, msg.FeedAggregationMethods)
+}
+
+func TestMsgUpdateFeedAggregationMethods_ValidateBasic(t *testing.T) {
+	// Valid admin
+	msg := NewMsgUpdateFeedAggregationMethods(ValidAdmin, ValidFeedAggregationMethods)
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid admin
+	msg = NewMsgUpdateFeedAggregationMethods(InvalidAdmin, ValidFeedAggregationMethods)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Empty feed aggregation methods
+	msg = NewMsgUpdateFeedAggregationMethods(ValidAdmin, []FeedAggregationMethod{})
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Invalid aggregation method
+	msg = NewMsgUpdateFeedAggregationMethods(ValidAdmin, []FeedAggregationMethod{
+		{SignalID: "CS:BAND-USD", AggregationMethod: AggregationMethod(100)},
+	})
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrInvalidAggregationMethod)
+
+	// Duplicate signal id
+	msg = NewMsgUpdateFeedAggregationMethods(
+		ValidAdmin,
+		append(ValidFeedAggregationMethods, ValidFeedAggregationMethods...),
+	)
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrDuplicateSignalID)
+}
+
+// ====================================
+// MsgUpdateCircuitBreakerConfigs
+// ====================================
+
+func TestNewMsgUpdateCircuitBreakerConfigs(t *testing.T) {
+	configs := []CircuitBreakerConfig{NewCircuitBreakerConfig("CS:BAND-USD", 1000, 60, 3)}
+	msg := NewMsgUpdateCircuitBreakerConfigs(ValidAdmin, configs)
+	require.Equal(t, ValidAdmin, msg.Admin)
+	require.Equal(t, configs, msg.CircuitBreakerConfigs)
+}
+
+func TestMsgUpdateCircuitBreakerConfigs_ValidateBasic(t *testing.T) {
+	configs := []CircuitBreakerConfig{NewCircuitBreakerConfig("CS:BAND-USD", 1000, 60, 3)}
+
+	// Valid admin
+	msg := NewMsgUpdateCircuitBreakerConfigs(ValidAdmin, configs)
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid admin
+	msg = NewMsgUpdateCircuitBreakerConfigs(InvalidAdmin, configs)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Empty circuit breaker configs
+	msg = NewMsgUpdateCircuitBreakerConfigs(ValidAdmin, []CircuitBreakerConfig{})
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Invalid circuit breaker config
+	msg = NewMsgUpdateCircuitBreakerConfigs(ValidAdmin, []CircuitBreakerConfig{
+		NewCircuitBreakerConfig("CS:BAND-USD", 1000, 0, 3),
+	})
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrInvalidCircuitBreakerConfig)
+
+	// Duplicate signal id
+	msg = NewMsgUpdateCircuitBreakerConfigs(ValidAdmin, append(configs, configs...))
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrDuplicateSignalID)
+}
+
 // ====================================
 // MsgVote
 // ====================================
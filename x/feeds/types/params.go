@@ -21,6 +21,8 @@ var (
 	DefaultCurrentFeedsUpdateInterval = int64(86400)
 	DefaultPriceQuorum                = math.LegacyNewDecWithPrec(30, 2)
 	DefaultMaxSignalIDsPerSigning     = uint64(25)
+	DefaultPriceHistoryRetention      = int64(3600)
+	DefaultMaxPriceHistoryEntries     = uint64(3600)
 )
 
 // NewParams creates a new Params instance
@@ -38,6 +40,8 @@ func NewParams(
 	currentFeedsUpdateInterval int64,
 	priceQuorum string,
 	maxSignalIDsPerSigning uint64,
+	priceHistoryRetention int64,
+	maxPriceHistoryEntries uint64,
 ) Params {
 	return Params{
 		Admin:                         admin,
@@ -53,6 +57,8 @@ func NewParams(
 		CurrentFeedsUpdateInterval:    currentFeedsUpdateInterval,
 		PriceQuorum:                   priceQuorum,
 		MaxSignalIDsPerSigning:        maxSignalIDsPerSigning,
+		PriceHistoryRetention:         priceHistoryRetention,
+		MaxPriceHistoryEntries:        maxPriceHistoryEntries,
 	}
 }
 
@@ -72,6 +78,8 @@ func DefaultParams() Params {
 		DefaultCurrentFeedsUpdateInterval,
 		DefaultPriceQuorum.String(),
 		DefaultMaxSignalIDsPerSigning,
+		DefaultPriceHistoryRetention,
+		DefaultMaxPriceHistoryEntries,
 	)
 }
 
@@ -126,5 +134,13 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if p.PriceHistoryRetention < 0 {
+		return fmt.Errorf("price history retention cannot be negative: %d", p.PriceHistoryRetention)
+	}
+
+	if err := validateUint64("max price history entries", true, p.MaxPriceHistoryEntries); err != nil {
+		return err
+	}
+
 	return nil
 }
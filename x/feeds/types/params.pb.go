@@ -55,6 +55,12 @@ type Params struct {
 	PriceQuorum string `protobuf:"bytes,12,opt,name=price_quorum,json=priceQuorum,proto3" json:"price_quorum,omitempty"`
 	// max_signal_ids_per_signing is the maximum number of signals allowed in a single tss signing request.
 	MaxSignalIDsPerSigning uint64 `protobuf:"varint,13,opt,name=max_signal_ids_per_signing,json=maxSignalIdsPerSigning,proto3" json:"max_signal_ids_per_signing,omitempty"`
+	// price_history_retention is the duration (in seconds) that aggregated prices are kept in the price history.
+	// Setting it to zero disables the price history and the TWAP queries.
+	PriceHistoryRetention int64 `protobuf:"varint,14,opt,name=price_history_retention,json=priceHistoryRetention,proto3" json:"price_history_retention,omitempty"`
+	// max_price_history_entries is the maximum number of entries kept in the price history of each signal id.
+	// The oldest entries are pruned once a signal id has more entries than this limit.
+	MaxPriceHistoryEntries uint64 `protobuf:"varint,15,opt,name=max_price_history_entries,json=maxPriceHistoryEntries,proto3" json:"max_price_history_entries,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -181,6 +187,20 @@ func (m *Params) GetMaxSignalIDsPerSigning() uint64 {
 	return 0
 }
 
+func (m *Params) GetPriceHistoryRetention() int64 {
+	if m != nil {
+		return m.PriceHistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPriceHistoryEntries() uint64 {
+	if m != nil {
+		return m.MaxPriceHistoryEntries
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "band.feeds.v1beta1.Params")
 }
@@ -188,41 +208,44 @@ func init() {
 func init() { proto.RegisterFile("band/feeds/v1beta1/params.proto", fileDescriptor_2d6fe56a3e836005) }
 
 var fileDescriptor_2d6fe56a3e836005 = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x41, 0x6f, 0xd3, 0x3c,
-	0x1c, 0xc6, 0x97, 0x77, 0x5b, 0x5f, 0xe6, 0x76, 0x42, 0x44, 0xd5, 0x94, 0x55, 0x5a, 0x5a, 0xe0,
-	0x52, 0x21, 0x91, 0x30, 0xed, 0x04, 0xb7, 0x95, 0x0a, 0xd8, 0x01, 0xa9, 0xb4, 0x83, 0x03, 0x17,
-	0xcb, 0xb1, 0x4d, 0x6a, 0x91, 0xd8, 0xc1, 0x76, 0xdb, 0xec, 0x5b, 0xf0, 0x11, 0xb8, 0xf1, 0x05,
-	0xf8, 0x10, 0x1c, 0x27, 0x4e, 0x9c, 0x10, 0x6a, 0x2f, 0x7c, 0x0c, 0xe4, 0x7f, 0xd2, 0xb5, 0x48,
-	0x70, 0x8b, 0x9f, 0xe7, 0xf7, 0x58, 0xfe, 0x3f, 0xb1, 0x51, 0x37, 0x21, 0x92, 0xc5, 0xef, 0x38,
-	0x67, 0x26, 0x9e, 0x9f, 0x26, 0xdc, 0x92, 0xd3, 0xb8, 0x20, 0x9a, 0xe4, 0x26, 0x2a, 0xb4, 0xb2,
-	0xca, 0xf7, 0x1d, 0x10, 0x01, 0x10, 0xd5, 0x40, 0xa7, 0x9d, 0xaa, 0x54, 0x81, 0x1d, 0xbb, 0xaf,
-	0x8a, 0xec, 0x1c, 0x53, 0x65, 0x72, 0x65, 0x70, 0x65, 0x54, 0x8b, 0xca, 0xba, 0xf7, 0x79, 0x1f,
-	0x35, 0x46, 0xb0, 0xab, 0x1f, 0xa1, 0x7d, 0xc2, 0x72, 0x21, 0x03, 0xaf, 0xe7, 0xf5, 0x0f, 0x06,
-	0xc1, 0xb7, 0x2f, 0x0f, 0xdb, 0x35, 0x7b, 0xce, 0x98, 0xe6, 0xc6, 0x4c, 0xac, 0x16, 0x32, 0x1d,
-	0x57, 0x98, 0xff, 0x1c, 0xf5, 0x48, 0x96, 0xa9, 0x05, 0x49, 0x32, 0x8e, 0x93, 0x4c, 0xd1, 0xf7,
-	0xd8, 0x8a, 0x9c, 0x63, 0x26, 0x0c, 0xd5, 0xbc, 0x20, 0x92, 0x5e, 0x05, 0xff, 0xf5, 0xbc, 0xfe,
-	0xee, 0xf8, 0xe4, 0x86, 0x1b, 0x38, 0xec, 0x52, 0xe4, 0x7c, 0xb8, 0x81, 0xfc, 0xbb, 0xa8, 0x95,
-	0x6a, 0x42, 0x39, 0x2e, 0xb8, 0x16, 0x8a, 0x05, 0xbb, 0x10, 0x6a, 0x82, 0x36, 0x02, 0xc9, 0x21,
-	0xb9, 0x90, 0x58, 0x48, 0xcb, 0xf5, 0x9c, 0x64, 0xc1, 0x5e, 0x85, 0xe4, 0x42, 0x5e, 0xd4, 0x12,
-	0x20, 0xa4, 0xdc, 0x20, 0xfb, 0x35, 0x42, 0xca, 0x1b, 0xe4, 0x11, 0x6a, 0x17, 0x6a, 0xc1, 0x35,
-	0x36, 0x96, 0x17, 0xd8, 0x4e, 0x35, 0x37, 0x53, 0x95, 0xb1, 0xa0, 0x01, 0xa8, 0x0f, 0xde, 0xc4,
-	0xf2, 0xe2, 0x72, 0xed, 0xf8, 0x0f, 0xd0, 0x1d, 0xb7, 0x29, 0x9d, 0x69, 0xcd, 0xa5, 0xc5, 0x50,
-	0x76, 0xf0, 0x7f, 0xcf, 0xeb, 0xef, 0x8d, 0x6f, 0xe7, 0xa4, 0x7c, 0x5a, 0xe9, 0xcf, 0x9c, 0xec,
-	0xdf, 0x47, 0x87, 0x54, 0xa9, 0x8c, 0xa9, 0x85, 0x84, 0x22, 0x82, 0x5b, 0xb0, 0x6d, 0x6b, 0x2d,
-	0xba, 0xb1, 0xfd, 0xc7, 0xe8, 0xd8, 0x0d, 0xc2, 0xf8, 0x5c, 0x10, 0x2b, 0x94, 0xc4, 0x09, 0x31,
-	0xc2, 0xe0, 0x42, 0x09, 0x69, 0x83, 0x03, 0x08, 0x1c, 0xe5, 0x42, 0x0e, 0xd7, 0xfe, 0xc0, 0xd9,
-	0x23, 0xe7, 0x42, 0x94, 0x94, 0xff, 0x88, 0xa2, 0x3a, 0x4a, 0xca, 0xbf, 0x45, 0xcf, 0xd1, 0xc9,
-	0x1f, 0x23, 0xe0, 0x59, 0xc1, 0x88, 0xe5, 0x9b, 0xb2, 0x9a, 0x10, 0xef, 0xd0, 0xad, 0x79, 0x5e,
-	0x03, 0xb2, 0x5d, 0x6f, 0xa1, 0x05, 0xe5, 0xf8, 0xc3, 0x4c, 0xe9, 0x59, 0x1e, 0xb4, 0xdc, 0x25,
-	0x19, 0x37, 0x41, 0x7b, 0x05, 0x92, 0xff, 0x06, 0x75, 0xdc, 0x01, 0x8d, 0x48, 0x25, 0xc9, 0xb0,
-	0x60, 0xc6, 0xfd, 0x50, 0x58, 0x0a, 0x99, 0x06, 0x87, 0xae, 0xb5, 0x41, 0x67, 0xf9, 0xa3, 0x7b,
-	0xf4, 0x92, 0x94, 0x13, 0x80, 0x2e, 0x86, 0x66, 0xc4, 0xf5, 0xa4, 0x22, 0xe0, 0xf4, 0xb5, 0xce,
-	0xb6, 0xf4, 0x27, 0x7b, 0xbf, 0x3e, 0x75, 0xbd, 0xc1, 0x8b, 0xaf, 0xcb, 0xd0, 0xbb, 0x5e, 0x86,
-	0xde, 0xcf, 0x65, 0xe8, 0x7d, 0x5c, 0x85, 0x3b, 0xd7, 0xab, 0x70, 0xe7, 0xfb, 0x2a, 0xdc, 0x79,
-	0x1b, 0xa5, 0xc2, 0x4e, 0x67, 0x49, 0x44, 0x55, 0x1e, 0xbb, 0x37, 0x01, 0x37, 0x9b, 0xaa, 0x2c,
-	0xa6, 0x53, 0x22, 0x64, 0x3c, 0x3f, 0x8b, 0xcb, 0xfa, 0x1d, 0xd9, 0xab, 0x82, 0x9b, 0xa4, 0x01,
-	0xc0, 0xd9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc8, 0xef, 0xfe, 0xd0, 0x62, 0x03, 0x00, 0x00,
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xc1, 0x6e, 0x13, 0x3d,
+	0x14, 0x85, 0x3b, 0x7f, 0xdb, 0xfc, 0xd4, 0x6d, 0xa9, 0x18, 0x95, 0xe2, 0x46, 0x6a, 0x1a, 0x60,
+	0x53, 0x21, 0x91, 0xa1, 0xaa, 0x84, 0x04, 0xbb, 0x86, 0x02, 0xed, 0x02, 0x29, 0x24, 0x85, 0x05,
+	0x1b, 0xcb, 0x33, 0xbe, 0x4c, 0x2c, 0x66, 0xec, 0xc1, 0x76, 0xd2, 0xe9, 0x5b, 0xb0, 0x66, 0xc5,
+	0x43, 0xf0, 0x10, 0x2c, 0x2b, 0x56, 0xac, 0x10, 0x4a, 0x37, 0x3c, 0x06, 0xf2, 0x9d, 0x49, 0x93,
+	0x4a, 0xb0, 0x1b, 0x9f, 0xf3, 0x1d, 0xdb, 0xf7, 0x7a, 0x2e, 0xd9, 0x8d, 0xb9, 0x12, 0xd1, 0x7b,
+	0x00, 0x61, 0xa3, 0xf1, 0x7e, 0x0c, 0x8e, 0xef, 0x47, 0x05, 0x37, 0x3c, 0xb7, 0x9d, 0xc2, 0x68,
+	0xa7, 0xc3, 0xd0, 0x03, 0x1d, 0x04, 0x3a, 0x35, 0xd0, 0xdc, 0x4c, 0x75, 0xaa, 0xd1, 0x8e, 0xfc,
+	0x57, 0x45, 0x36, 0xb7, 0x13, 0x6d, 0x73, 0x6d, 0x59, 0x65, 0x54, 0x8b, 0xca, 0xba, 0xf7, 0xb9,
+	0x41, 0x1a, 0x3d, 0xdc, 0x35, 0xec, 0x90, 0x65, 0x2e, 0x72, 0xa9, 0x68, 0xd0, 0x0e, 0xf6, 0x56,
+	0xba, 0xf4, 0xfb, 0xd7, 0x87, 0x9b, 0x35, 0x7b, 0x28, 0x84, 0x01, 0x6b, 0x07, 0xce, 0x48, 0x95,
+	0xf6, 0x2b, 0x2c, 0x7c, 0x49, 0xda, 0x3c, 0xcb, 0xf4, 0x19, 0x8f, 0x33, 0x60, 0x71, 0xa6, 0x93,
+	0x0f, 0xcc, 0xc9, 0x1c, 0x98, 0x90, 0x36, 0x31, 0x50, 0x70, 0x95, 0x9c, 0xd3, 0xff, 0xda, 0xc1,
+	0xde, 0x62, 0x7f, 0xe7, 0x8a, 0xeb, 0x7a, 0xec, 0x54, 0xe6, 0x70, 0x34, 0x83, 0xc2, 0xbb, 0x64,
+	0x2d, 0x35, 0x3c, 0x01, 0x56, 0x80, 0x91, 0x5a, 0xd0, 0x45, 0x0c, 0xad, 0xa2, 0xd6, 0x43, 0xc9,
+	0x23, 0xb9, 0x54, 0x4c, 0x2a, 0x07, 0x66, 0xcc, 0x33, 0xba, 0x54, 0x21, 0xb9, 0x54, 0x27, 0xb5,
+	0x84, 0x08, 0x2f, 0x67, 0xc8, 0x72, 0x8d, 0xf0, 0xf2, 0x0a, 0x79, 0x44, 0x36, 0x0b, 0x7d, 0x06,
+	0x86, 0x59, 0x07, 0x05, 0x73, 0x43, 0x03, 0x76, 0xa8, 0x33, 0x41, 0x1b, 0x88, 0x86, 0xe8, 0x0d,
+	0x1c, 0x14, 0xa7, 0x53, 0x27, 0x7c, 0x40, 0x6e, 0xf9, 0x4d, 0x93, 0x91, 0x31, 0xa0, 0x1c, 0xc3,
+	0x66, 0xd3, 0xff, 0xdb, 0xc1, 0xde, 0x52, 0x7f, 0x23, 0xe7, 0xe5, 0xb3, 0x4a, 0x7f, 0xe1, 0xe5,
+	0xf0, 0x3e, 0x59, 0x4f, 0xb4, 0xce, 0x84, 0x3e, 0x53, 0xd8, 0x08, 0x7a, 0x03, 0xb7, 0x5d, 0x9b,
+	0x8a, 0xbe, 0xec, 0xf0, 0x09, 0xd9, 0xf6, 0x85, 0x08, 0x18, 0x4b, 0xee, 0xa4, 0x56, 0x2c, 0xe6,
+	0x56, 0x5a, 0x56, 0x68, 0xa9, 0x1c, 0x5d, 0xc1, 0xc0, 0x56, 0x2e, 0xd5, 0xd1, 0xd4, 0xef, 0x7a,
+	0xbb, 0xe7, 0x5d, 0x8c, 0xf2, 0xf2, 0x1f, 0x51, 0x52, 0x47, 0x79, 0xf9, 0xb7, 0xe8, 0x21, 0xd9,
+	0xb9, 0x56, 0x02, 0x1b, 0x15, 0x82, 0x3b, 0x98, 0x35, 0x6b, 0x15, 0xe3, 0xcd, 0x64, 0xae, 0x9e,
+	0x37, 0x88, 0xcc, 0xb7, 0xb7, 0x30, 0x32, 0x01, 0xf6, 0x71, 0xa4, 0xcd, 0x28, 0xa7, 0x6b, 0xfe,
+	0x27, 0xe9, 0xaf, 0xa2, 0xf6, 0x1a, 0xa5, 0xf0, 0x2d, 0x69, 0xfa, 0x0b, 0x5a, 0x99, 0x2a, 0x9e,
+	0x31, 0x29, 0xac, 0x7f, 0x50, 0x5c, 0x4a, 0x95, 0xd2, 0x75, 0xdf, 0xb5, 0x6e, 0x73, 0xf2, 0x73,
+	0x77, 0xeb, 0x15, 0x2f, 0x07, 0x08, 0x9d, 0x1c, 0xd9, 0x1e, 0x98, 0x41, 0x45, 0xe0, 0xed, 0x6b,
+	0x5d, 0xcc, 0xe9, 0xe1, 0x63, 0x72, 0xa7, 0x3a, 0x7a, 0x28, 0xad, 0xd3, 0xe6, 0x9c, 0x19, 0x70,
+	0xa0, 0x7c, 0x89, 0xf4, 0x26, 0xde, 0xfb, 0x36, 0xda, 0xc7, 0x95, 0xdb, 0x9f, 0x9a, 0xd3, 0x86,
+	0x5d, 0xcf, 0x82, 0x72, 0x46, 0x82, 0xa5, 0x1b, 0xf8, 0x88, 0xfe, 0xc8, 0xde, 0x5c, 0xf8, 0x79,
+	0xe5, 0x3e, 0x5d, 0xfa, 0xfd, 0x65, 0x37, 0xe8, 0x1e, 0x7f, 0x9b, 0xb4, 0x82, 0x8b, 0x49, 0x2b,
+	0xf8, 0x35, 0x69, 0x05, 0x9f, 0x2e, 0x5b, 0x0b, 0x17, 0x97, 0xad, 0x85, 0x1f, 0x97, 0xad, 0x85,
+	0x77, 0x9d, 0x54, 0xba, 0xe1, 0x28, 0xee, 0x24, 0x3a, 0x8f, 0xfc, 0x18, 0xe2, 0x30, 0x25, 0x3a,
+	0x8b, 0x92, 0x21, 0x97, 0x2a, 0x1a, 0x1f, 0x44, 0x65, 0x3d, 0xba, 0xee, 0xbc, 0x00, 0x1b, 0x37,
+	0x10, 0x38, 0xf8, 0x33, 0x00, 0x03, 0xc1, 0x89, 0x52, 0xd5, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -283,6 +306,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.MaxSignalIDsPerSigning != that1.MaxSignalIDsPerSigning {
 		return false
 	}
+	if this.PriceHistoryRetention != that1.PriceHistoryRetention {
+		return false
+	}
+	if this.MaxPriceHistoryEntries != that1.MaxPriceHistoryEntries {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -305,6 +334,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPriceHistoryEntries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPriceHistoryEntries))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.PriceHistoryRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PriceHistoryRetention))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.MaxSignalIDsPerSigning != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxSignalIDsPerSigning))
 		i--
@@ -435,6 +474,12 @@ func (m *Params) Size() (n int) {
 	if m.MaxSignalIDsPerSigning != 0 {
 		n += 1 + sovParams(uint64(m.MaxSignalIDsPerSigning))
 	}
+	if m.PriceHistoryRetention != 0 {
+		n += 1 + sovParams(uint64(m.PriceHistoryRetention))
+	}
+	if m.MaxPriceHistoryEntries != 0 {
+		n += 1 + sovParams(uint64(m.MaxPriceHistoryEntries))
+	}
 	return n
 }
 
@@ -746,6 +791,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryRetention", wireType)
+			}
+			m.PriceHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceHistoryRetention |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceHistoryEntries", wireType)
+			}
+			m.MaxPriceHistoryEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceHistoryEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -75,6 +75,16 @@ func TestParams_Validate(t *testing.T) {
 			params.CurrentFeedsUpdateInterval = 0 // Invalid value
 			return params
 		}(), fmt.Errorf("current feeds update interval must be positive: 0")},
+		{"invalid PriceHistoryRetention", func() types.Params {
+			params := types.DefaultParams()
+			params.PriceHistoryRetention = -1 // Invalid value
+			return params
+		}(), fmt.Errorf("price history retention cannot be negative: -1")},
+		{"invalid MaxPriceHistoryEntries", func() types.Params {
+			params := types.DefaultParams()
+			params.MaxPriceHistoryEntries = 0 // Invalid value
+			return params
+		}(), fmt.Errorf("max price history entries must be positive: 0")},
 	}
 
 	for _, tt := range tests {
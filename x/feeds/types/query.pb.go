@@ -390,6 +390,107 @@ func (m *QueryPriceResponse) GetPrice() Price {
 	return Price{}
 }
 
+// QueryCircuitBreakerRequest is the request type for the Query/CircuitBreaker RPC method.
+type QueryCircuitBreakerRequest struct {
+	// signal_id is the signal id to query the circuit breaker for.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+}
+
+func (m *QueryCircuitBreakerRequest) Reset()         { *m = QueryCircuitBreakerRequest{} }
+func (m *QueryCircuitBreakerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerRequest) ProtoMessage()    {}
+func (*QueryCircuitBreakerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{8}
+}
+func (m *QueryCircuitBreakerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerRequest.Merge(m, src)
+}
+func (m *QueryCircuitBreakerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakerRequest proto.InternalMessageInfo
+
+func (m *QueryCircuitBreakerRequest) GetSignalId() string {
+	if m != nil {
+		return m.SignalId
+	}
+	return ""
+}
+
+// QueryCircuitBreakerResponse is the response type for the Query/CircuitBreaker RPC method.
+type QueryCircuitBreakerResponse struct {
+	// config is the circuit breaker configuration of the signal id.
+	Config CircuitBreakerConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
+	// state is the circuit breaker state of the signal id.
+	State CircuitBreakerState `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+}
+
+func (m *QueryCircuitBreakerResponse) Reset()         { *m = QueryCircuitBreakerResponse{} }
+func (m *QueryCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerResponse) ProtoMessage()    {}
+func (*QueryCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{9}
+}
+func (m *QueryCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerResponse.Merge(m, src)
+}
+func (m *QueryCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakerResponse proto.InternalMessageInfo
+
+func (m *QueryCircuitBreakerResponse) GetConfig() CircuitBreakerConfig {
+	if m != nil {
+		return m.Config
+	}
+	return CircuitBreakerConfig{}
+}
+
+func (m *QueryCircuitBreakerResponse) GetState() CircuitBreakerState {
+	if m != nil {
+		return m.State
+	}
+	return CircuitBreakerState{}
+}
+
 // QueryPricesRequest is the request type for the Query/Prices RPC method.
 type QueryPricesRequest struct {
 	// signal_ids is a list of signal ids to query prices for.
@@ -400,7 +501,7 @@ func (m *QueryPricesRequest) Reset()         { *m = QueryPricesRequest{} }
 func (m *QueryPricesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPricesRequest) ProtoMessage()    {}
 func (*QueryPricesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39690037efa6f66f, []int{8}
+	return fileDescriptor_39690037efa6f66f, []int{10}
 }
 func (m *QueryPricesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,7 +547,7 @@ func (m *QueryPricesResponse) Reset()         { *m = QueryPricesResponse{} }
 func (m *QueryPricesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPricesResponse) ProtoMessage()    {}
 func (*QueryPricesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39690037efa6f66f, []int{9}
+	return fileDescriptor_39690037efa6f66f, []int{11}
 }
 func (m *QueryPricesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -482,6 +583,262 @@ func (m *QueryPricesResponse) GetPrices() []Price {
 	return nil
 }
 
+// QueryPriceHistoryRequest is the request type for the Query/PriceHistory RPC method.
+type QueryPriceHistoryRequest struct {
+	// signal_id is the signal id to query the price history for.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// start_time is the start of the time range (unix timestamp in seconds, inclusive).
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the end of the time range (unix timestamp in seconds, inclusive). Zero means the current block time.
+	EndTime int64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// pagination is the pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPriceHistoryRequest) Reset()         { *m = QueryPriceHistoryRequest{} }
+func (m *QueryPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceHistoryRequest) ProtoMessage()    {}
+func (*QueryPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{12}
+}
+func (m *QueryPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceHistoryRequest.Merge(m, src)
+}
+func (m *QueryPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryPriceHistoryRequest) GetSignalId() string {
+	if m != nil {
+		return m.SignalId
+	}
+	return ""
+}
+
+func (m *QueryPriceHistoryRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *QueryPriceHistoryRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *QueryPriceHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPriceHistoryResponse is the response type for the Query/PriceHistory RPC method.
+type QueryPriceHistoryResponse struct {
+	// prices is a list of historical prices ordered by timestamp.
+	Prices []Price `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
+	// pagination is the pagination information in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPriceHistoryResponse) Reset()         { *m = QueryPriceHistoryResponse{} }
+func (m *QueryPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceHistoryResponse) ProtoMessage()    {}
+func (*QueryPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{13}
+}
+func (m *QueryPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceHistoryResponse.Merge(m, src)
+}
+func (m *QueryPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryPriceHistoryResponse) GetPrices() []Price {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+func (m *QueryPriceHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTWAPRequest is the request type for the Query/TWAP RPC method.
+type QueryTWAPRequest struct {
+	// signal_id is the signal id to query the time-weighted average price for.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// duration is the length of the window (in seconds) ending at the current block time.
+	Duration int64 `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *QueryTWAPRequest) Reset()         { *m = QueryTWAPRequest{} }
+func (m *QueryTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPRequest) ProtoMessage()    {}
+func (*QueryTWAPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{14}
+}
+func (m *QueryTWAPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTWAPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPRequest.Merge(m, src)
+}
+func (m *QueryTWAPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTWAPRequest proto.InternalMessageInfo
+
+func (m *QueryTWAPRequest) GetSignalId() string {
+	if m != nil {
+		return m.SignalId
+	}
+	return ""
+}
+
+func (m *QueryTWAPRequest) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// QueryTWAPResponse is the response type for the Query/TWAP RPC method.
+type QueryTWAPResponse struct {
+	// signal_id is the signal id of the time-weighted average price.
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// price is the time-weighted average price over the window.
+	Price uint64 `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	// start_time is the start of the window (unix timestamp in seconds) covered by the price history.
+	StartTime int64 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the end of the window (unix timestamp in seconds).
+	EndTime int64 `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *QueryTWAPResponse) Reset()         { *m = QueryTWAPResponse{} }
+func (m *QueryTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPResponse) ProtoMessage()    {}
+func (*QueryTWAPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39690037efa6f66f, []int{15}
+}
+func (m *QueryTWAPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTWAPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPResponse.Merge(m, src)
+}
+func (m *QueryTWAPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTWAPResponse proto.InternalMessageInfo
+
+func (m *QueryTWAPResponse) GetSignalId() string {
+	if m != nil {
+		return m.SignalId
+	}
+	return ""
+}
+
+func (m *QueryTWAPResponse) GetPrice() uint64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *QueryTWAPResponse) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *QueryTWAPResponse) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
 // QueryAllPricesRequest is the request type for the Query/AllPrices RPC method.
 type QueryAllPricesRequest struct {
 	// pagination is the pagination for the request.
@@ -492,7 +849,7 @@ func (m *QueryAllPricesRequest) Reset()         { *m = QueryAllPricesRequest{} }
 func (m *QueryAllPricesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllPricesRequest) ProtoMessage()    {}
 func (*QueryAllPricesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39690037efa6f66f, []int{10}
+	return fileDescriptor_39690037efa6f66f, []int{16}
 }
 func (m *QueryAllPricesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,7 +897,7 @@ func (m *QueryAllPricesResponse) Reset()         { *m = QueryAllPricesResponse{}
 func (m *QueryAllPricesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllPricesResponse) ProtoMessage()    {}
 func (*QueryAllPricesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39690037efa6f66f, []int{11}
+	return fileDescriptor_39690037efa6f66f, []int{17}
 }
 func (m *QueryAllPricesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -591,7 +948,7 @@ func (m *QueryReferenceSourceConfigRequest) Reset()         { *m = QueryReferenc
 func (m *QueryReferenceSourceConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryReferenceSourceConfigRequest) ProtoMessage()    {}
 func (*QueryReferenceSourceConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39690037efa6f66f, []int{12}
+	return fileDescriptor_39690037efa6f66f, []int{18}
 }
 func (m *QueryReferenceSourceConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -630,7 +987,7 @@ func (m *QueryReferenceSourceConfigResponse) Reset()         { *m = QueryReferen
 func (m *QueryReferenceSourceConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryReferenceSourceConfigResponse) ProtoMessage()    {}
 func (*QueryReferenceSourceConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39690037efa6f66f, []int{13}
+	return fileDescriptor_39690037efa6f66f, []int{19}
 }
 func (m *QueryReferenceSourceConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -678,7 +1035,7 @@ func (m *QuerySignalTotalPowersRequest) Reset()         { *m = QuerySignalTotalP
 func (m *QuerySignalTotalPowersRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySignalTotalPowersRequest) ProtoMessage()    {}
 func (*QuerySignalTotalPowersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39690037efa6f66f, []int{14}
+	return fileDescriptor_39690037efa6f66f, []int{20}
 }
 func (m *QuerySignalTotalPowersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +1090,7 @@ func (m *QuerySignalTotalPowersResponse) Reset()         { *m = QuerySignalTotal
 func (m *QuerySignalTotalPowersResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySignalTotalPowersResponse) ProtoMessage()    {}
 func (*QuerySignalTotalPowersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39690037efa6f66f, []int{15}
+	return fileDescriptor_39690037efa6f66f, []int{21}
 }
 func (m *QuerySignalTotalPowersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -786,7 +1143,7 @@ func (m *QueryValidValidatorRequest) Reset()         { *m = QueryValidValidatorR
 func (m *QueryValidValidatorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidValidatorRequest) ProtoMessage()    {}
 func (*QueryValidValidatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39690037efa6f66f, []int{16}
+	return fileDescriptor_39690037efa6f66f, []int{22}
 }
 func (m *QueryValidValidatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -832,7 +1189,7 @@ func (m *QueryValidValidatorResponse) Reset()         { *m = QueryValidValidator
 func (m *QueryValidValidatorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidValidatorResponse) ProtoMessage()    {}
 func (*QueryValidValidatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39690037efa6f66f, []int{17}
+	return fileDescriptor_39690037efa6f66f, []int{23}
 }
 func (m *QueryValidValidatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -880,7 +1237,7 @@ func (m *QueryValidatorPricesRequest) Reset()         { *m = QueryValidatorPrice
 func (m *QueryValidatorPricesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorPricesRequest) ProtoMessage()    {}
 func (*QueryValidatorPricesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39690037efa6f66f, []int{18}
+	return fileDescriptor_39690037efa6f66f, []int{24}
 }
 func (m *QueryValidatorPricesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,7 +1290,7 @@ func (m *QueryValidatorPricesResponse) Reset()         { *m = QueryValidatorPric
 func (m *QueryValidatorPricesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorPricesResponse) ProtoMessage()    {}
 func (*QueryValidatorPricesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39690037efa6f66f, []int{19}
+	return fileDescriptor_39690037efa6f66f, []int{25}
 }
 func (m *QueryValidatorPricesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -979,7 +1336,7 @@ func (m *QueryVoteRequest) Reset()         { *m = QueryVoteRequest{} }
 func (m *QueryVoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteRequest) ProtoMessage()    {}
 func (*QueryVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39690037efa6f66f, []int{20}
+	return fileDescriptor_39690037efa6f66f, []int{26}
 }
 func (m *QueryVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1025,7 +1382,7 @@ func (m *QueryVoteResponse) Reset()         { *m = QueryVoteResponse{} }
 func (m *QueryVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteResponse) ProtoMessage()    {}
 func (*QueryVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39690037efa6f66f, []int{21}
+	return fileDescriptor_39690037efa6f66f, []int{27}
 }
 func (m *QueryVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1070,8 +1427,14 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "band.feeds.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryPriceRequest)(nil), "band.feeds.v1beta1.QueryPriceRequest")
 	proto.RegisterType((*QueryPriceResponse)(nil), "band.feeds.v1beta1.QueryPriceResponse")
+	proto.RegisterType((*QueryCircuitBreakerRequest)(nil), "band.feeds.v1beta1.QueryCircuitBreakerRequest")
+	proto.RegisterType((*QueryCircuitBreakerResponse)(nil), "band.feeds.v1beta1.QueryCircuitBreakerResponse")
 	proto.RegisterType((*QueryPricesRequest)(nil), "band.feeds.v1beta1.QueryPricesRequest")
 	proto.RegisterType((*QueryPricesResponse)(nil), "band.feeds.v1beta1.QueryPricesResponse")
+	proto.RegisterType((*QueryPriceHistoryRequest)(nil), "band.feeds.v1beta1.QueryPriceHistoryRequest")
+	proto.RegisterType((*QueryPriceHistoryResponse)(nil), "band.feeds.v1beta1.QueryPriceHistoryResponse")
+	proto.RegisterType((*QueryTWAPRequest)(nil), "band.feeds.v1beta1.QueryTWAPRequest")
+	proto.RegisterType((*QueryTWAPResponse)(nil), "band.feeds.v1beta1.QueryTWAPResponse")
 	proto.RegisterType((*QueryAllPricesRequest)(nil), "band.feeds.v1beta1.QueryAllPricesRequest")
 	proto.RegisterType((*QueryAllPricesResponse)(nil), "band.feeds.v1beta1.QueryAllPricesResponse")
 	proto.RegisterType((*QueryReferenceSourceConfigRequest)(nil), "band.feeds.v1beta1.QueryReferenceSourceConfigRequest")
@@ -1089,80 +1452,97 @@ func init() {
 func init() { proto.RegisterFile("band/feeds/v1beta1/query.proto", fileDescriptor_39690037efa6f66f) }
 
 var fileDescriptor_39690037efa6f66f = []byte{
-	// 1157 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xdf, 0x6f, 0xdb, 0x54,
-	0x14, 0xc7, 0xeb, 0xb0, 0x84, 0xe6, 0x50, 0xd8, 0x7a, 0x97, 0xb2, 0xd6, 0x6d, 0x33, 0x7a, 0xd7,
-	0x75, 0x6d, 0x69, 0xec, 0xb6, 0xd9, 0x00, 0xf1, 0x82, 0xd6, 0xa1, 0xc1, 0x40, 0x62, 0x23, 0x45,
-	0x80, 0x90, 0x50, 0xe4, 0x38, 0xb7, 0xae, 0xa5, 0xd4, 0x37, 0xf3, 0x75, 0xc2, 0xa6, 0xaa, 0x42,
-	0x20, 0x81, 0xc4, 0x03, 0x12, 0x68, 0x6f, 0xfc, 0x01, 0x3c, 0xf0, 0x3c, 0xf8, 0x1b, 0xf6, 0x38,
-	0x8d, 0x17, 0x9e, 0x10, 0x6a, 0x79, 0xe2, 0xaf, 0x40, 0xbe, 0xf7, 0x38, 0x8d, 0x5d, 0x7b, 0xf1,
-	0x7e, 0x3c, 0x35, 0xf6, 0xf9, 0x9e, 0x73, 0x3e, 0xe7, 0xdc, 0x1f, 0xc7, 0x85, 0x6a, 0xcb, 0xf2,
-	0xda, 0xe6, 0x0e, 0x63, 0x6d, 0x61, 0xf6, 0x37, 0x5a, 0x2c, 0xb0, 0x36, 0xcc, 0xdb, 0x3d, 0xe6,
-	0xdf, 0x35, 0xba, 0x3e, 0x0f, 0x38, 0x21, 0xa1, 0xdd, 0x90, 0x76, 0x03, 0xed, 0x7a, 0xc5, 0xe1,
-	0x0e, 0x97, 0x66, 0x33, 0xfc, 0xa5, 0x94, 0xfa, 0x9c, 0xc3, 0xb9, 0xd3, 0x61, 0xa6, 0xd5, 0x75,
-	0x4d, 0xcb, 0xf3, 0x78, 0x60, 0x05, 0x2e, 0xf7, 0x04, 0x5a, 0x67, 0x6c, 0x2e, 0xf6, 0xb8, 0x68,
-	0x2a, 0x37, 0xf5, 0x80, 0xa6, 0x55, 0xf5, 0x64, 0xb6, 0x2c, 0xc1, 0x54, 0xee, 0x01, 0x49, 0xd7,
-	0x72, 0x5c, 0x4f, 0xc6, 0x41, 0x6d, 0x1a, 0xae, 0x82, 0x53, 0xf6, 0xf3, 0x29, 0xf6, 0xae, 0xe5,
-	0x5b, 0x7b, 0x28, 0xa0, 0x3a, 0x4c, 0x7f, 0x1c, 0xa6, 0xb8, 0xd6, 0xf3, 0x7d, 0xe6, 0x05, 0xd7,
-	0x43, 0x65, 0x83, 0xdd, 0xee, 0x31, 0x11, 0xd0, 0x1e, 0xcc, 0xa4, 0xd8, 0x44, 0x97, 0x7b, 0x82,
-	0x91, 0xcf, 0xe1, 0x65, 0x5b, 0xbd, 0x6f, 0xca, 0xf0, 0xd3, 0xda, 0x6b, 0xda, 0xf2, 0x4b, 0x9b,
-	0x35, 0xe3, 0x64, 0x83, 0x8c, 0xa1, 0x00, 0x9f, 0xb9, 0xc1, 0xee, 0xbb, 0xac, 0xef, 0xaa, 0x66,
-	0x6c, 0x9d, 0x7a, 0xf0, 0xf7, 0xf9, 0xb1, 0xc6, 0x84, 0x3d, 0x94, 0x81, 0xfe, 0xa0, 0x41, 0x45,
-	0xe6, 0xbd, 0x21, 0xc2, 0x17, 0xcc, 0x47, 0x1e, 0xf2, 0x0e, 0x94, 0xfb, 0x56, 0xc7, 0x6d, 0x5b,
-	0x01, 0xf7, 0x65, 0xba, 0xf2, 0xd6, 0xc2, 0xa3, 0xfb, 0xb5, 0x79, 0xec, 0xde, 0xa7, 0x91, 0xed,
-	0x6a, 0xbb, 0xed, 0x33, 0x21, 0xb6, 0x03, 0xdf, 0xf5, 0x9c, 0xc6, 0xb1, 0x0f, 0x59, 0x87, 0xd2,
-	0x8e, 0x8c, 0x38, 0x5d, 0x90, 0xde, 0xd3, 0x8f, 0xee, 0xd7, 0x2a, 0xe8, 0x1d, 0x77, 0x42, 0x1d,
-	0xbd, 0x0c, 0x53, 0x09, 0x14, 0x2c, 0x7f, 0x16, 0xca, 0xae, 0x68, 0x62, 0xb4, 0x90, 0x65, 0xbc,
-	0x31, 0xee, 0xa2, 0x88, 0x56, 0x80, 0x48, 0xaf, 0x5b, 0xb2, 0xd3, 0x51, 0x3b, 0x6f, 0xc2, 0xd9,
-	0xd8, 0x5b, 0x8c, 0xf4, 0x16, 0x94, 0xd4, 0x8a, 0x60, 0x07, 0xf5, 0xb4, 0x0e, 0x2a, 0x1f, 0x6c,
-	0x17, 0xea, 0xe9, 0x3a, 0x4c, 0xaa, 0x80, 0xbe, 0x6b, 0xb3, 0xa8, 0x49, 0xb3, 0x50, 0x16, 0xae,
-	0xe3, 0x59, 0x9d, 0xa6, 0xdb, 0x56, 0x4d, 0x6a, 0x8c, 0xab, 0x17, 0x37, 0xda, 0xf4, 0xc3, 0x08,
-	0x4c, 0x79, 0x20, 0xc1, 0x15, 0x28, 0x76, 0xc3, 0x17, 0x08, 0x30, 0x93, 0x0a, 0x10, 0x0a, 0x30,
-	0xbf, 0x52, 0xd3, 0xfa, 0x70, 0xb0, 0xa8, 0x4a, 0x32, 0x0f, 0x30, 0xc8, 0x1f, 0x96, 0xf4, 0xc2,
-	0x72, 0xb9, 0x51, 0x8e, 0x00, 0x04, 0xfd, 0x28, 0x6a, 0x02, 0x3a, 0x21, 0xc2, 0x9b, 0x50, 0x92,
-	0x41, 0x95, 0x47, 0x0e, 0x06, 0x94, 0xd3, 0x26, 0x2e, 0xd0, 0xd5, 0x4e, 0x27, 0xce, 0x71, 0x1d,
-	0xe0, 0xf8, 0xb4, 0x60, 0x65, 0x4b, 0x06, 0x2e, 0x76, 0x78, 0xb4, 0x0c, 0x75, 0xac, 0x8f, 0x3b,
-	0xec, 0x44, 0x3d, 0x6c, 0x0c, 0x79, 0xd2, 0x5f, 0x34, 0x78, 0x35, 0x99, 0xe1, 0x19, 0xa1, 0xc9,
-	0x7b, 0x31, 0xb6, 0x82, 0x64, 0xbb, 0x34, 0x92, 0x4d, 0x65, 0x8d, 0xc1, 0x5d, 0x80, 0x05, 0xc9,
-	0xd6, 0x60, 0x3b, 0xcc, 0x67, 0x9e, 0xcd, 0xb6, 0x79, 0xcf, 0xb7, 0xd9, 0x35, 0xee, 0xed, 0xb8,
-	0x4e, 0xb4, 0xef, 0x7e, 0xd4, 0x80, 0x3e, 0x4e, 0x85, 0xd5, 0x38, 0x70, 0xce, 0x8f, 0x04, 0x4d,
-	0x21, 0x15, 0x4d, 0x5b, 0x4a, 0xb0, 0x7b, 0x2b, 0x69, 0xe5, 0xa5, 0xc6, 0xc4, 0x72, 0xa7, 0xfc,
-	0x34, 0x23, 0xfd, 0x5e, 0x83, 0x79, 0xc9, 0xb3, 0x2d, 0x77, 0xc5, 0x27, 0x3c, 0xb0, 0x3a, 0xb7,
-	0xf8, 0x57, 0xcc, 0xcf, 0xb9, 0x87, 0x12, 0x4b, 0x5b, 0x78, 0xea, 0xa5, 0xfd, 0x5d, 0x83, 0x6a,
-	0x16, 0x08, 0x36, 0xe5, 0x03, 0x38, 0x8b, 0x24, 0x41, 0x68, 0x6d, 0x76, 0xa5, 0x19, 0xd7, 0x3b,
-	0xf5, 0xa4, 0xaa, 0x58, 0x8d, 0x49, 0x91, 0x8c, 0xf9, 0xfc, 0x56, 0xfd, 0x4b, 0xd0, 0x25, 0xb6,
-	0xbc, 0xf0, 0x06, 0xb7, 0xde, 0xf3, 0xba, 0x25, 0x69, 0x1d, 0x66, 0x53, 0xc3, 0x63, 0x4b, 0x2a,
-	0x50, 0x94, 0x5a, 0xbc, 0xf5, 0xd4, 0x03, 0x3d, 0x18, 0x76, 0x0a, 0xf5, 0xf1, 0xd3, 0xf8, 0xcc,
-	0x57, 0x77, 0x7c, 0x4b, 0x14, 0x92, 0xd7, 0x8a, 0x80, 0xb9, 0xf4, 0xf4, 0x08, 0xbd, 0x0d, 0x67,
-	0x06, 0xb1, 0x9a, 0xb1, 0x43, 0x4b, 0xd3, 0x16, 0x31, 0x1e, 0x06, 0xb7, 0xf3, 0xe9, 0x7e, 0x3c,
-	0x38, 0xdd, 0x82, 0x33, 0x2a, 0x29, 0x0f, 0x06, 0xd7, 0xaf, 0x01, 0xc5, 0x3e, 0x0f, 0x58, 0x54,
-	0x64, 0xf6, 0x84, 0x51, 0x32, 0x7a, 0x13, 0xef, 0x70, 0x15, 0x03, 0x69, 0xdf, 0x86, 0x17, 0x55,
-	0x69, 0x39, 0x76, 0x1a, 0xc2, 0x45, 0x0e, 0x9b, 0xff, 0x4d, 0x40, 0x51, 0x46, 0x24, 0x3f, 0x6b,
-	0x30, 0x31, 0x3c, 0xba, 0xc9, 0x5a, 0x5a, 0x94, 0xac, 0xe9, 0xaf, 0xd7, 0x72, 0xaa, 0x15, 0x33,
-	0x5d, 0xfc, 0xf6, 0xcf, 0x7f, 0xef, 0x15, 0xaa, 0x64, 0x2e, 0xf1, 0xb5, 0x11, 0xfb, 0x48, 0x20,
-	0xf7, 0x34, 0x18, 0x8f, 0x66, 0x29, 0x59, 0xce, 0xcc, 0x90, 0x98, 0xfc, 0xfa, 0x4a, 0x0e, 0x25,
-	0x72, 0x6c, 0x4a, 0x8e, 0x35, 0xb2, 0x9a, 0xf2, 0x55, 0xc4, 0x7c, 0x73, 0x7f, 0xb0, 0x88, 0x07,
-	0xe6, 0xbe, 0x7a, 0x77, 0x40, 0xee, 0x40, 0x49, 0x0d, 0x58, 0xb2, 0x94, 0x99, 0x28, 0x36, 0xcb,
-	0xf5, 0x4b, 0x23, 0x75, 0x88, 0x33, 0x2f, 0x71, 0xce, 0x91, 0xa9, 0xd4, 0x8f, 0x30, 0xf2, 0x8d,
-	0x06, 0x45, 0xb9, 0x9b, 0xc8, 0xc5, 0xec, 0x88, 0x43, 0xe3, 0x5d, 0x5f, 0x1a, 0x25, 0xc3, 0xbc,
-	0x2b, 0x32, 0xef, 0x05, 0xb2, 0x90, 0xcc, 0x2b, 0xb7, 0xae, 0xb9, 0x3f, 0x38, 0x4c, 0xaa, 0x7a,
-	0x35, 0x97, 0x46, 0x04, 0xcf, 0x53, 0x7d, 0xec, 0xd8, 0x65, 0x57, 0xaf, 0xf2, 0x7d, 0xa7, 0x41,
-	0x79, 0x30, 0x56, 0x49, 0xf6, 0x22, 0x27, 0x87, 0xbb, 0xbe, 0x9a, 0x47, 0x8a, 0x0c, 0x0b, 0x92,
-	0x61, 0x96, 0xcc, 0x24, 0x18, 0xac, 0x4e, 0x07, 0x6f, 0x02, 0xf2, 0x87, 0x06, 0x53, 0xa9, 0x83,
-	0x8c, 0x5c, 0xc9, 0x4c, 0xf4, 0xb8, 0x91, 0xab, 0xbf, 0xf1, 0xa4, 0x6e, 0xc8, 0x6a, 0x48, 0xd6,
-	0x65, 0xb2, 0x94, 0x60, 0xcd, 0x18, 0xcc, 0xe4, 0x57, 0x0d, 0x26, 0x4f, 0x0c, 0x2f, 0xb2, 0x91,
-	0x99, 0x3d, 0x6b, 0xe2, 0xea, 0x9b, 0x4f, 0xe2, 0x82, 0xb0, 0xab, 0x12, 0x76, 0x91, 0xd0, 0x04,
-	0x6c, 0xca, 0xc0, 0x0c, 0x41, 0x5f, 0x89, 0xcf, 0x13, 0x62, 0x64, 0xa6, 0x4c, 0x9d, 0x6b, 0xba,
-	0x99, 0x5b, 0x8f, 0x7c, 0x75, 0xc9, 0x57, 0x23, 0xaf, 0x27, 0xf8, 0x06, 0x37, 0x80, 0x88, 0xdd,
-	0x06, 0xf2, 0x27, 0xf9, 0x4d, 0x83, 0xd3, 0x89, 0x21, 0x42, 0x46, 0x64, 0x3e, 0x31, 0xed, 0xf4,
-	0xf5, 0xfc, 0x0e, 0xc8, 0x7a, 0x59, 0xb2, 0x1a, 0x64, 0x2d, 0x1f, 0x2b, 0xee, 0xdb, 0xaf, 0xe1,
-	0x54, 0x38, 0x37, 0xc8, 0x62, 0x76, 0xbe, 0xe3, 0xd1, 0xa4, 0x5f, 0x1c, 0xa1, 0x1a, 0xb1, 0xac,
-	0x72, 0x5e, 0x85, 0x18, 0xe1, 0xdf, 0x03, 0xf9, 0xb8, 0xf5, 0xfe, 0x83, 0xc3, 0xaa, 0xf6, 0xf0,
-	0xb0, 0xaa, 0xfd, 0x73, 0x58, 0xd5, 0x7e, 0x3a, 0xaa, 0x8e, 0x3d, 0x3c, 0xaa, 0x8e, 0xfd, 0x75,
-	0x54, 0x1d, 0xfb, 0xc2, 0x70, 0xdc, 0x60, 0xb7, 0xd7, 0x32, 0x6c, 0xbe, 0x67, 0x86, 0x69, 0xe5,
-	0x7f, 0x9b, 0x36, 0xef, 0x98, 0xf6, 0xae, 0xe5, 0x7a, 0x66, 0xbf, 0x6e, 0xde, 0xc1, 0xf8, 0xc1,
-	0xdd, 0x2e, 0x13, 0xad, 0x92, 0x14, 0xd4, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x75, 0x45, 0x1c,
-	0x50, 0x80, 0x0f, 0x00, 0x00,
+	// 1425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0xd4, 0x46,
+	0x14, 0xcf, 0x90, 0x0f, 0xb2, 0x0f, 0x0a, 0x64, 0x48, 0x4a, 0xe2, 0x90, 0xa5, 0x19, 0x20, 0x24,
+	0x81, 0xd8, 0x24, 0x81, 0xb6, 0xf4, 0x52, 0x11, 0xaa, 0x14, 0x8a, 0x54, 0xe8, 0x06, 0x41, 0x55,
+	0xa9, 0x5a, 0x79, 0xbd, 0x93, 0x8d, 0xd5, 0x8d, 0xbd, 0xd8, 0xde, 0x05, 0x84, 0xa2, 0x7e, 0xa9,
+	0x95, 0x7a, 0xa8, 0xd4, 0x8a, 0x5b, 0xa5, 0xf6, 0xd8, 0x03, 0xea, 0x91, 0x56, 0xea, 0xad, 0x47,
+	0x8e, 0x88, 0x5e, 0x7a, 0xaa, 0x2a, 0xe8, 0x1f, 0x52, 0x79, 0xe6, 0x79, 0x63, 0x3b, 0xe3, 0xb5,
+	0xf9, 0x38, 0xf4, 0x94, 0xf5, 0xbc, 0xdf, 0x7b, 0xef, 0x37, 0xef, 0xcd, 0x3c, 0xff, 0x1c, 0x28,
+	0xd7, 0x4c, 0xa7, 0x6e, 0xac, 0x73, 0x5e, 0xf7, 0x8d, 0xce, 0x62, 0x8d, 0x07, 0xe6, 0xa2, 0x71,
+	0xb3, 0xcd, 0xbd, 0x3b, 0x7a, 0xcb, 0x73, 0x03, 0x97, 0xd2, 0xd0, 0xae, 0x0b, 0xbb, 0x8e, 0x76,
+	0x6d, 0xb4, 0xe1, 0x36, 0x5c, 0x61, 0x36, 0xc2, 0x5f, 0x12, 0xa9, 0x1d, 0x6e, 0xb8, 0x6e, 0xa3,
+	0xc9, 0x0d, 0xb3, 0x65, 0x1b, 0xa6, 0xe3, 0xb8, 0x81, 0x19, 0xd8, 0xae, 0xe3, 0xa3, 0x75, 0xc2,
+	0x72, 0xfd, 0x4d, 0xd7, 0xaf, 0x4a, 0x37, 0xf9, 0x80, 0xa6, 0x79, 0xf9, 0x64, 0xd4, 0x4c, 0x9f,
+	0xcb, 0xdc, 0x5d, 0x26, 0x2d, 0xb3, 0x61, 0x3b, 0x22, 0x0e, 0x62, 0x55, 0x74, 0x25, 0x39, 0x69,
+	0x3f, 0xa2, 0xb0, 0xb7, 0x4c, 0xcf, 0xdc, 0x44, 0x00, 0xd3, 0x60, 0xfc, 0x83, 0x30, 0xc5, 0x85,
+	0xb6, 0xe7, 0x71, 0x27, 0x58, 0x0d, 0x91, 0x15, 0x7e, 0xb3, 0xcd, 0xfd, 0x80, 0xb5, 0x61, 0x42,
+	0x61, 0xf3, 0x5b, 0xae, 0xe3, 0x73, 0xfa, 0x21, 0xbc, 0x62, 0xc9, 0xf5, 0xaa, 0x08, 0x3f, 0x4e,
+	0x5e, 0x23, 0xb3, 0x7b, 0x96, 0x16, 0xf4, 0x9d, 0x05, 0xd2, 0x63, 0x01, 0x6e, 0xd8, 0xc1, 0xc6,
+	0x3b, 0xbc, 0x63, 0xcb, 0x62, 0xac, 0x0c, 0x3c, 0xfc, 0xfb, 0x48, 0x5f, 0x65, 0xaf, 0x15, 0xcb,
+	0xc0, 0xbe, 0x21, 0x30, 0x2a, 0xf2, 0x5e, 0xf2, 0xc3, 0x05, 0xee, 0x21, 0x1f, 0xfa, 0x36, 0x94,
+	0x3a, 0x66, 0xd3, 0xae, 0x9b, 0x81, 0xeb, 0x89, 0x74, 0xa5, 0x95, 0xe9, 0xc7, 0x0f, 0x16, 0xa6,
+	0xb0, 0x7a, 0xd7, 0x23, 0xdb, 0xf9, 0x7a, 0xdd, 0xe3, 0xbe, 0xbf, 0x16, 0x78, 0xb6, 0xd3, 0xa8,
+	0x6c, 0xfb, 0xd0, 0xd3, 0x30, 0xb4, 0x2e, 0x22, 0x8e, 0xef, 0x12, 0xde, 0xe3, 0x8f, 0x1f, 0x2c,
+	0x8c, 0xa2, 0x77, 0xd2, 0x09, 0x71, 0xec, 0x0c, 0x8c, 0xa5, 0xa8, 0xe0, 0xf6, 0x27, 0xa1, 0x64,
+	0xfb, 0x55, 0x8c, 0x16, 0x72, 0x19, 0xae, 0x0c, 0xdb, 0x08, 0x62, 0xa3, 0x40, 0x85, 0xd7, 0x55,
+	0x51, 0xe9, 0xa8, 0x9c, 0x57, 0xe0, 0x60, 0x62, 0x15, 0x23, 0xbd, 0x09, 0x43, 0xb2, 0x23, 0x58,
+	0x41, 0x4d, 0x55, 0x41, 0xe9, 0x83, 0xe5, 0x42, 0x3c, 0x3b, 0x0d, 0x23, 0x32, 0xa0, 0x67, 0x5b,
+	0x3c, 0x2a, 0xd2, 0x24, 0x94, 0x7c, 0xbb, 0xe1, 0x98, 0xcd, 0xaa, 0x5d, 0x97, 0x45, 0xaa, 0x0c,
+	0xcb, 0x85, 0x4b, 0x75, 0x76, 0x19, 0x68, 0xdc, 0x03, 0x19, 0x9c, 0x85, 0xc1, 0x56, 0xb8, 0x80,
+	0x04, 0x26, 0x94, 0x04, 0x42, 0x00, 0xe6, 0x97, 0x68, 0x76, 0x0e, 0x34, 0x79, 0x3c, 0x6c, 0xcf,
+	0x6a, 0xdb, 0xc1, 0x8a, 0xc7, 0xcd, 0x4f, 0xb8, 0x57, 0x88, 0xc7, 0x7d, 0x02, 0x93, 0x4a, 0x5f,
+	0x64, 0xb4, 0x0a, 0x43, 0x96, 0xeb, 0xac, 0xdb, 0x0d, 0xa4, 0x34, 0xab, 0x3c, 0x55, 0x09, 0xdf,
+	0x0b, 0x02, 0x1f, 0x55, 0x48, 0x7a, 0xd3, 0x0b, 0x30, 0xe8, 0x07, 0x66, 0xc0, 0x45, 0xbf, 0xf7,
+	0x2c, 0x9d, 0xc8, 0x0f, 0xb3, 0x16, 0xc2, 0xa3, 0x7d, 0x0a, 0x5f, 0xb6, 0x1c, 0x2f, 0x5a, 0xd4,
+	0x4d, 0x3a, 0x05, 0xd0, 0xdd, 0x5f, 0xd8, 0xba, 0xfe, 0xd9, 0x52, 0xa5, 0x14, 0x6d, 0xd0, 0x67,
+	0xef, 0xc3, 0xc1, 0x84, 0x13, 0x6e, 0xec, 0x0d, 0x18, 0x12, 0xc5, 0x93, 0x1e, 0x05, 0x6a, 0x8d,
+	0x70, 0xf6, 0x3b, 0xc1, 0x8b, 0x2a, 0x8c, 0x17, 0x6d, 0x3f, 0x70, 0xbd, 0x3b, 0x45, 0x6a, 0x2d,
+	0x88, 0x06, 0xa6, 0x17, 0x54, 0x03, 0x7b, 0x53, 0x16, 0xa2, 0xbf, 0x52, 0x12, 0x2b, 0xd7, 0xec,
+	0x4d, 0x4e, 0x27, 0x60, 0x98, 0x3b, 0x75, 0x69, 0xec, 0x17, 0xc6, 0xdd, 0xdc, 0xa9, 0x0b, 0xd3,
+	0x2a, 0xc0, 0xf6, 0xc0, 0x19, 0x1f, 0x10, 0x25, 0x9c, 0xd1, 0xf1, 0xbe, 0xd4, 0x4c, 0x9f, 0xeb,
+	0x72, 0x32, 0x6e, 0x1f, 0xd2, 0x46, 0x74, 0x0c, 0x2b, 0x31, 0x4f, 0xf6, 0x23, 0x81, 0x09, 0x05,
+	0xf7, 0x17, 0x2c, 0x09, 0x7d, 0x37, 0x41, 0x2f, 0xea, 0x70, 0x1e, 0x3d, 0x99, 0x35, 0xc1, 0xef,
+	0x32, 0x1c, 0x10, 0xf4, 0xae, 0xdd, 0x38, 0x7f, 0xb5, 0x50, 0x49, 0x35, 0x18, 0xae, 0xb7, 0xbd,
+	0xed, 0xbc, 0xfd, 0x95, 0xee, 0x33, 0xfb, 0x8c, 0xc0, 0x48, 0x2c, 0xda, 0xf6, 0xb8, 0xc8, 0x0e,
+	0x37, 0x1a, 0xdd, 0xbf, 0x30, 0xd6, 0x00, 0x5e, 0xaf, 0x54, 0xdf, 0xfa, 0x7b, 0xf5, 0x6d, 0x20,
+	0xd1, 0x37, 0x56, 0xc5, 0xa1, 0x75, 0xbe, 0xd9, 0x4c, 0x9e, 0xd9, 0x64, 0x43, 0xc9, 0x73, 0x37,
+	0xf4, 0x07, 0x02, 0xaf, 0xa6, 0x33, 0xfc, 0x6f, 0xba, 0x79, 0x14, 0xa6, 0x05, 0xb7, 0x0a, 0x5f,
+	0xe7, 0x1e, 0x77, 0x2c, 0xbe, 0xe6, 0xb6, 0x3d, 0x8b, 0xcb, 0xf9, 0x10, 0xcd, 0xe2, 0x6f, 0x09,
+	0xb0, 0x5e, 0x28, 0xdc, 0x4d, 0x03, 0x0e, 0x79, 0x11, 0xa0, 0xea, 0x0b, 0x44, 0x35, 0x31, 0x98,
+	0xe6, 0x54, 0xdb, 0x53, 0xc6, 0xc4, 0xed, 0x8e, 0x79, 0x2a, 0x23, 0xfb, 0x9a, 0xc0, 0x94, 0xe0,
+	0xb3, 0x26, 0x0e, 0xc5, 0x35, 0x37, 0x30, 0x9b, 0x57, 0xdd, 0x5b, 0xdc, 0x2b, 0x38, 0x6f, 0xe8,
+	0xaa, 0xa2, 0x7c, 0xcf, 0xd3, 0xda, 0x5f, 0x09, 0x94, 0xb3, 0x88, 0x60, 0x51, 0xde, 0x83, 0x83,
+	0xc8, 0x24, 0x08, 0xad, 0xd5, 0x96, 0x30, 0x63, 0xbf, 0x95, 0x6f, 0x2f, 0x19, 0xab, 0x32, 0xe2,
+	0xa7, 0x63, 0xbe, 0xbc, 0xae, 0x7f, 0x8c, 0x2f, 0x23, 0x21, 0x02, 0xba, 0x4a, 0xe0, 0x65, 0x29,
+	0x07, 0xb6, 0x8c, 0xef, 0xab, 0x74, 0x78, 0x2c, 0xc9, 0x28, 0x0c, 0x0a, 0x2c, 0x2a, 0x01, 0xf9,
+	0xc0, 0xb6, 0xe2, 0x4e, 0x21, 0x3e, 0x79, 0x1b, 0x5f, 0x58, 0xce, 0x24, 0x8f, 0xc4, 0xae, 0xf4,
+	0x2b, 0xc8, 0x87, 0xc3, 0xea, 0xf4, 0x48, 0x7a, 0x0d, 0x0e, 0x74, 0x63, 0x55, 0x13, 0x97, 0x96,
+	0xa9, 0x9a, 0x98, 0x0c, 0x83, 0xc7, 0x79, 0x7f, 0x27, 0x19, 0x9c, 0xad, 0xe0, 0x2c, 0xbd, 0xee,
+	0x06, 0x5d, 0x49, 0xa2, 0xc3, 0x60, 0xc7, 0x0d, 0x78, 0xb4, 0xc9, 0x6c, 0xd5, 0x25, 0x61, 0xec,
+	0x0a, 0x8c, 0xc4, 0x62, 0x20, 0xdb, 0xb7, 0x60, 0xb7, 0xdc, 0x5a, 0x81, 0x93, 0x86, 0xe4, 0x22,
+	0x87, 0xa5, 0x3f, 0x0e, 0xc0, 0xa0, 0x88, 0x48, 0xbf, 0x27, 0xb0, 0x37, 0x2e, 0x67, 0xe9, 0x29,
+	0x55, 0x94, 0x2c, 0x45, 0xac, 0x2d, 0x14, 0x44, 0x4b, 0xce, 0xec, 0xd8, 0x17, 0x7f, 0xfe, 0x7b,
+	0x6f, 0x57, 0x99, 0x1e, 0x4e, 0x29, 0xf0, 0x84, 0x70, 0xa6, 0xf7, 0x08, 0x0c, 0x47, 0xfa, 0x92,
+	0xce, 0x66, 0x66, 0x48, 0xa9, 0x61, 0x6d, 0xae, 0x00, 0x12, 0x79, 0x2c, 0x09, 0x1e, 0xa7, 0xe8,
+	0xbc, 0xe2, 0x4b, 0x81, 0x7b, 0xc6, 0xdd, 0x6e, 0x13, 0xb7, 0x8c, 0xbb, 0x72, 0x6d, 0x8b, 0xde,
+	0x86, 0x21, 0x29, 0x3a, 0xe9, 0x4c, 0x66, 0xa2, 0x84, 0xbe, 0xd5, 0x4e, 0xe4, 0xe2, 0x90, 0xce,
+	0x94, 0xa0, 0x73, 0x88, 0x8e, 0x29, 0x3f, 0x4c, 0xe8, 0xe7, 0x04, 0x06, 0xc5, 0x69, 0xa2, 0xc7,
+	0xb3, 0x23, 0xc6, 0x24, 0xaf, 0x36, 0x93, 0x07, 0xc3, 0xbc, 0x73, 0x22, 0xef, 0x51, 0x3a, 0x9d,
+	0xce, 0x1b, 0xa2, 0x7c, 0xe3, 0x6e, 0xf7, 0x32, 0x6d, 0xd1, 0x5f, 0x08, 0xec, 0x4b, 0x0a, 0x43,
+	0xaa, 0x67, 0xf7, 0x5e, 0x25, 0x80, 0x35, 0xa3, 0x30, 0x1e, 0xe9, 0x9d, 0x13, 0xf4, 0x96, 0xe9,
+	0x62, 0x2e, 0x3d, 0xc3, 0x92, 0x11, 0xaa, 0x35, 0xe4, 0x16, 0x36, 0x4b, 0xbe, 0x46, 0x73, 0x6a,
+	0x51, 0xa4, 0x59, 0x89, 0x29, 0x91, 0xdd, 0x2c, 0x99, 0xef, 0x27, 0x02, 0x7b, 0xe3, 0xb2, 0xae,
+	0xc7, 0x85, 0x52, 0x28, 0x57, 0x6d, 0xa1, 0x20, 0x1a, 0xc9, 0x2c, 0x0a, 0x32, 0x27, 0xe9, 0x5c,
+	0x7e, 0x89, 0x36, 0x90, 0xcf, 0x97, 0x04, 0x06, 0x42, 0x29, 0x46, 0x8f, 0x65, 0xa6, 0x8a, 0xe9,
+	0x3e, 0xed, 0x78, 0x0e, 0x0a, 0x89, 0xe8, 0x82, 0xc8, 0x2c, 0x9d, 0xc9, 0x27, 0x12, 0xdc, 0x32,
+	0x5b, 0xf4, 0x2b, 0x02, 0xa5, 0xae, 0x58, 0xa2, 0xd9, 0x57, 0x37, 0x2d, 0xd9, 0xb4, 0xf9, 0x22,
+	0x50, 0x24, 0x35, 0x2d, 0x48, 0x4d, 0xd2, 0x89, 0x14, 0x29, 0xb3, 0xd9, 0xc4, 0xf9, 0x4e, 0x7f,
+	0x23, 0x30, 0xa6, 0x94, 0x27, 0xf4, 0x6c, 0x66, 0xa2, 0x5e, 0x42, 0x4a, 0x7b, 0xfd, 0x59, 0xdd,
+	0x72, 0x0a, 0x98, 0x21, 0xb7, 0xe8, 0xcf, 0x04, 0x46, 0x76, 0x48, 0x12, 0xba, 0x98, 0x99, 0x3d,
+	0x4b, 0x47, 0x69, 0x4b, 0xcf, 0xe2, 0x82, 0x64, 0xe7, 0x05, 0xd9, 0x63, 0x94, 0xa5, 0xc8, 0x2a,
+	0x64, 0x50, 0x48, 0x74, 0x5f, 0x52, 0x25, 0xf4, 0x98, 0x1c, 0x4a, 0xb5, 0xd2, 0x63, 0x72, 0xa8,
+	0xe5, 0x07, 0x5b, 0x16, 0xfc, 0x16, 0xe8, 0xc9, 0x14, 0xbf, 0xee, 0x5c, 0xf7, 0x13, 0x33, 0x5e,
+	0xfc, 0xa4, 0xf7, 0x09, 0xec, 0x4f, 0x49, 0x03, 0x9a, 0x93, 0x79, 0x87, 0x86, 0xd1, 0x4e, 0x17,
+	0x77, 0x40, 0xae, 0x67, 0x04, 0x57, 0x9d, 0x9e, 0x2a, 0xc6, 0x15, 0xcf, 0xed, 0xa7, 0x30, 0x10,
+	0xaa, 0x81, 0x1e, 0x97, 0x38, 0x26, 0x38, 0xb4, 0xe3, 0x39, 0xa8, 0x9c, 0xb6, 0x0a, 0x15, 0x12,
+	0xd2, 0x08, 0xff, 0x6e, 0x89, 0xc7, 0x95, 0x8b, 0x0f, 0x9f, 0x94, 0xc9, 0xa3, 0x27, 0x65, 0xf2,
+	0xcf, 0x93, 0x32, 0xf9, 0xee, 0x69, 0xb9, 0xef, 0xd1, 0xd3, 0x72, 0xdf, 0x5f, 0x4f, 0xcb, 0x7d,
+	0x1f, 0xe9, 0x0d, 0x3b, 0xd8, 0x68, 0xd7, 0x74, 0xcb, 0xdd, 0x34, 0xc2, 0xb4, 0xe2, 0xff, 0x6a,
+	0x96, 0xdb, 0x34, 0xac, 0x0d, 0xd3, 0x76, 0x8c, 0xce, 0xb2, 0x71, 0x1b, 0xe3, 0x07, 0x77, 0x5a,
+	0xdc, 0xaf, 0x0d, 0x09, 0xc0, 0xf2, 0x7f, 0x03, 0x00, 0x79, 0x1b, 0x33, 0x0c, 0x6a, 0x14, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1185,8 +1565,14 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Price is an RPC method that returns price by signal id.
 	Price(ctx context.Context, in *QueryPriceRequest, opts ...grpc.CallOption) (*QueryPriceResponse, error)
+	// CircuitBreaker is an RPC method that returns the circuit breaker configuration and state by signal id.
+	CircuitBreaker(ctx context.Context, in *QueryCircuitBreakerRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error)
 	// Prices is an RPC method that prices by signal ids.
 	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
+	// PriceHistory is an RPC method that returns the price history of a signal id within a time range.
+	PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error)
+	// TWAP is an RPC method that returns the time-weighted average price of a signal id over a duration.
+	TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error)
 	// AllPrices is an RPC method that returns all prices.
 	AllPrices(ctx context.Context, in *QueryAllPricesRequest, opts ...grpc.CallOption) (*QueryAllPricesResponse, error)
 	// ReferenceSourceConfig is an RPC method that returns information on the reference price source.
@@ -1246,6 +1632,15 @@ func (c *queryClient) Price(ctx context.Context, in *QueryPriceRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) CircuitBreaker(ctx context.Context, in *QueryCircuitBreakerRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error) {
+	out := new(QueryCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/CircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error) {
 	out := new(QueryPricesResponse)
 	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/Prices", in, out, opts...)
@@ -1255,6 +1650,24 @@ func (c *queryClient) Prices(ctx context.Context, in *QueryPricesRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error) {
+	out := new(QueryPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/PriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error) {
+	out := new(QueryTWAPResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/TWAP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AllPrices(ctx context.Context, in *QueryAllPricesRequest, opts ...grpc.CallOption) (*QueryAllPricesResponse, error) {
 	out := new(QueryAllPricesResponse)
 	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Query/AllPrices", in, out, opts...)
@@ -1319,8 +1732,14 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Price is an RPC method that returns price by signal id.
 	Price(context.Context, *QueryPriceRequest) (*QueryPriceResponse, error)
+	// CircuitBreaker is an RPC method that returns the circuit breaker configuration and state by signal id.
+	CircuitBreaker(context.Context, *QueryCircuitBreakerRequest) (*QueryCircuitBreakerResponse, error)
 	// Prices is an RPC method that prices by signal ids.
 	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
+	// PriceHistory is an RPC method that returns the price history of a signal id within a time range.
+	PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error)
+	// TWAP is an RPC method that returns the time-weighted average price of a signal id over a duration.
+	TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error)
 	// AllPrices is an RPC method that returns all prices.
 	AllPrices(context.Context, *QueryAllPricesRequest) (*QueryAllPricesResponse, error)
 	// ReferenceSourceConfig is an RPC method that returns information on the reference price source.
@@ -1352,9 +1771,18 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) Price(ctx context.Context, req *QueryPriceRequest) (*QueryPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Price not implemented")
 }
+func (*UnimplementedQueryServer) CircuitBreaker(ctx context.Context, req *QueryCircuitBreakerRequest) (*QueryCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreaker not implemented")
+}
 func (*UnimplementedQueryServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
 }
+func (*UnimplementedQueryServer) PriceHistory(ctx context.Context, req *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceHistory not implemented")
+}
+func (*UnimplementedQueryServer) TWAP(ctx context.Context, req *QueryTWAPRequest) (*QueryTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TWAP not implemented")
+}
 func (*UnimplementedQueryServer) AllPrices(ctx context.Context, req *QueryAllPricesRequest) (*QueryAllPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllPrices not implemented")
 }
@@ -1450,6 +1878,24 @@ func _Query_Price_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/CircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreaker(ctx, req.(*QueryCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Prices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPricesRequest)
 	if err := dec(in); err != nil {
@@ -1468,6 +1914,42 @@ func _Query_Prices_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/PriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceHistory(ctx, req.(*QueryPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Query/TWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TWAP(ctx, req.(*QueryTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AllPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAllPricesRequest)
 	if err := dec(in); err != nil {
@@ -1596,10 +2078,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Price",
 			Handler:    _Query_Price_Handler,
 		},
+		{
+			MethodName: "CircuitBreaker",
+			Handler:    _Query_CircuitBreaker_Handler,
+		},
 		{
 			MethodName: "Prices",
 			Handler:    _Query_Prices_Handler,
 		},
+		{
+			MethodName: "PriceHistory",
+			Handler:    _Query_PriceHistory_Handler,
+		},
+		{
+			MethodName: "TWAP",
+			Handler:    _Query_TWAP_Handler,
+		},
 		{
 			MethodName: "AllPrices",
 			Handler:    _Query_AllPrices_Handler,
@@ -1874,6 +2368,79 @@ func (m *QueryPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCircuitBreakerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignalId) > 0 {
+		i -= len(m.SignalId)
+		copy(dAtA[i:], m.SignalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryPricesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1943,7 +2510,7 @@ func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPricesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1953,12 +2520,12 @@ func (m *QueryAllPricesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1973,12 +2540,29 @@ func (m *QueryAllPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalId) > 0 {
+		i -= len(m.SignalId)
+		copy(dAtA[i:], m.SignalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalId)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPricesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1988,12 +2572,12 @@ func (m *QueryAllPricesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2027,7 +2611,7 @@ func (m *QueryAllPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryReferenceSourceConfigRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTWAPRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2037,20 +2621,32 @@ func (m *QueryReferenceSourceConfigRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryReferenceSourceConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReferenceSourceConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalId) > 0 {
+		i -= len(m.SignalId)
+		copy(dAtA[i:], m.SignalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryReferenceSourceConfigResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTWAPResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2060,30 +2656,42 @@ func (m *QueryReferenceSourceConfigResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryReferenceSourceConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReferenceSourceConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.ReferenceSourceConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Price != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalId) > 0 {
+		i -= len(m.SignalId)
+		copy(dAtA[i:], m.SignalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySignalTotalPowersRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllPricesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2093,12 +2701,12 @@ func (m *QuerySignalTotalPowersRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySignalTotalPowersRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllPricesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySignalTotalPowersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2113,21 +2721,12 @@ func (m *QuerySignalTotalPowersRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SignalIds) > 0 {
-		for iNdEx := len(m.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SignalIds[iNdEx])
-			copy(dAtA[i:], m.SignalIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySignalTotalPowersResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllPricesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2137,12 +2736,12 @@ func (m *QuerySignalTotalPowersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySignalTotalPowersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllPricesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySignalTotalPowersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2159,10 +2758,10 @@ func (m *QuerySignalTotalPowersResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.SignalTotalPowers) > 0 {
-		for iNdEx := len(m.SignalTotalPowers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.SignalTotalPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2176,7 +2775,7 @@ func (m *QuerySignalTotalPowersResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidValidatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryReferenceSourceConfigRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2186,27 +2785,20 @@ func (m *QueryValidValidatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryReferenceSourceConfigRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryReferenceSourceConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidValidatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryReferenceSourceConfigResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2216,30 +2808,30 @@ func (m *QueryValidValidatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryReferenceSourceConfigResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryReferenceSourceConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Valid {
-		i--
-		if m.Valid {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size, err := m.ReferenceSourceConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x8
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorPricesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySignalTotalPowersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2249,36 +2841,41 @@ func (m *QueryValidatorPricesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySignalTotalPowersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySignalTotalPowersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.SignalIds) > 0 {
 		for iNdEx := len(m.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.SignalIds[iNdEx])
 			copy(dAtA[i:], m.SignalIds[iNdEx])
 			i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalIds[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorPricesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySignalTotalPowersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2288,7 +2885,158 @@ func (m *QueryValidatorPricesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySignalTotalPowersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalTotalPowersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignalTotalPowers) > 0 {
+		for iNdEx := len(m.SignalTotalPowers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalTotalPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignalIds) > 0 {
+		for iNdEx := len(m.SignalIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignalIds[iNdEx])
+			copy(dAtA[i:], m.SignalIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPricesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -2486,6 +3234,32 @@ func (m *QueryPriceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCircuitBreakerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryPricesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2516,6 +3290,86 @@ func (m *QueryPricesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTWAPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovQuery(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *QueryTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 1 + sovQuery(uint64(m.Price))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	return n
+}
+
 func (m *QueryAllPricesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3314,7 +4168,7 @@ func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCircuitBreakerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3337,15 +4191,15 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCircuitBreakerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCircuitBreakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3373,7 +4227,7 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignalIds = append(m.SignalIds, string(dAtA[iNdEx:postIndex]))
+			m.SignalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3396,7 +4250,7 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3419,15 +4273,15 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCircuitBreakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3454,11 +4308,725 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, Price{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalIds = append(m.SignalIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTWAPRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTWAPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTWAPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTWAPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
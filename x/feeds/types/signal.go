@@ -1,6 +1,9 @@
 package types
 
-import "math"
+import (
+	"math"
+	"strings"
+)
 
 // NewSignal creates a new signal
 func NewSignal(id string, power int64) Signal {
@@ -34,6 +37,14 @@ func (s *Signal) Validate() error {
 		)
 	}
 
+	// Check if the signal ID uses the prefix reserved for derived signals
+	if strings.HasPrefix(s.ID, DerivedSignalIDPrefix) {
+		return ErrInvalidSignal.Wrapf(
+			"signal ID prefix %s is reserved for derived signals",
+			DerivedSignalIDPrefix,
+		)
+	}
+
 	return nil
 }
 
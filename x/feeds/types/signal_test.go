@@ -39,3 +39,26 @@ func TestSumPowerOverflow(t *testing.T) {
 	_, err := SumPower(signals)
 	require.ErrorContains(t, err, "overflows int64")
 }
+
+func TestSignalValidate(t *testing.T) {
+	cases := map[string]struct {
+		signal Signal
+		expErr error
+	}{
+		"valid signal":            {signal: NewSignal("CS:BAND-USD", 100)},
+		"non-positive power":      {signal: NewSignal("CS:BAND-USD", 0), expErr: ErrInvalidSignal},
+		"signal ID too large":     {signal: NewSignal("CS:BAND-USD-BAND-USD-BAND-USD-BAND", 100), expErr: ErrSignalIDTooLarge},
+		"reserved derived prefix": {signal: NewSignal("DS:BAND-USD", 100), expErr: ErrInvalidSignal},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.signal.Validate()
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
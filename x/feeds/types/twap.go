@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// CalculateTWAP calculates the time-weighted average price between startTime and endTime from a list of
+// historical prices sorted by timestamp. Each price is effective from its timestamp until the timestamp of
+// the next price, and only prices with available status are taken into account.
+func CalculateTWAP(prices []Price, startTime int64, endTime int64) (uint64, error) {
+	totalDuration := sdkmath.ZeroInt()
+	weightedSum := sdkmath.ZeroInt()
+
+	for i, price := range prices {
+		from := max(price.Timestamp, startTime)
+		to := endTime
+		if i+1 < len(prices) {
+			to = min(prices[i+1].Timestamp, endTime)
+		}
+
+		if to <= from || price.Status != PRICE_STATUS_AVAILABLE {
+			continue
+		}
+
+		duration := sdkmath.NewInt(to - from)
+		totalDuration = totalDuration.Add(duration)
+		weightedSum = weightedSum.Add(sdkmath.NewIntFromUint64(price.Price).Mul(duration))
+	}
+
+	if totalDuration.IsZero() {
+		return 0, ErrNotEnoughPriceHistory.Wrapf("no available price between %d and %d", startTime, endTime)
+	}
+
+	return weightedSum.Quo(totalDuration).Uint64(), nil
+}
@@ -0,0 +1,111 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func TestCalculateTWAP(t *testing.T) {
+	tests := []struct {
+		name      string
+		prices    []types.Price
+		startTime int64
+		endTime   int64
+		expPrice  uint64
+		expErr    error
+	}{
+		{
+			name: "single price",
+			prices: []types.Price{
+				types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, 50),
+			},
+			startTime: 100,
+			endTime:   200,
+			expPrice:  1000,
+		},
+		{
+			name: "price effective before start time",
+			prices: []types.Price{
+				types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, 50),
+				types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 2000, 150),
+			},
+			startTime: 100,
+			endTime:   200,
+			expPrice:  1500,
+		},
+		{
+			name: "weighted by duration",
+			prices: []types.Price{
+				types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, 100),
+				types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 2000, 190),
+			},
+			startTime: 100,
+			endTime:   200,
+			expPrice:  1100,
+		},
+		{
+			name: "skip unavailable prices",
+			prices: []types.Price{
+				types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, 100),
+				types.NewPrice(types.PRICE_STATUS_NOT_READY, "CS:BAND-USD", 0, 150),
+				types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 4000, 175),
+			},
+			startTime: 100,
+			endTime:   200,
+			expPrice:  2000,
+		},
+		{
+			name: "history starts after start time",
+			prices: []types.Price{
+				types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, 150),
+				types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 3000, 175),
+			},
+			startTime: 100,
+			endTime:   200,
+			expPrice:  2000,
+		},
+		{
+			name: "large prices",
+			prices: []types.Price{
+				types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1<<63, 100),
+				types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1<<63+2, 150),
+			},
+			startTime: 100,
+			endTime:   200,
+			expPrice:  1<<63 + 1,
+		},
+		{
+			name:      "no price",
+			prices:    []types.Price{},
+			startTime: 100,
+			endTime:   200,
+			expErr:    types.ErrNotEnoughPriceHistory,
+		},
+		{
+			name: "no available price",
+			prices: []types.Price{
+				types.NewPrice(types.PRICE_STATUS_NOT_READY, "CS:BAND-USD", 0, 100),
+				types.NewPrice(types.PRICE_STATUS_AVAILABLE, "CS:BAND-USD", 1000, 200),
+			},
+			startTime: 100,
+			endTime:   200,
+			expErr:    types.ErrNotEnoughPriceHistory,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price, err := types.CalculateTWAP(tt.prices, tt.startTime, tt.endTime)
+			if tt.expErr != nil {
+				require.ErrorIs(t, err, tt.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expPrice, price)
+		})
+	}
+}
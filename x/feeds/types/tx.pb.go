@@ -318,6 +318,194 @@ func (m *MsgUpdateReferenceSourceConfigResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateReferenceSourceConfigResponse proto.InternalMessageInfo
 
+// MsgUpdateFeedAggregationMethods is the transaction message to update aggregation methods of signal ids.
+type MsgUpdateFeedAggregationMethods struct {
+	// admin is the address of the admin or the governance account that is performing the operation.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// feed_aggregation_methods is a list of aggregation methods to update.
+	FeedAggregationMethods []FeedAggregationMethod `protobuf:"bytes,2,rep,name=feed_aggregation_methods,json=feedAggregationMethods,proto3" json:"feed_aggregation_methods"`
+}
+
+func (m *MsgUpdateFeedAggregationMethods) Reset()         { *m = MsgUpdateFeedAggregationMethods{} }
+func (m *MsgUpdateFeedAggregationMethods) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFeedAggregationMethods) ProtoMessage()    {}
+func (*MsgUpdateFeedAggregationMethods) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{6}
+}
+func (m *MsgUpdateFeedAggregationMethods) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFeedAggregationMethods) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeedAggregationMethods.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFeedAggregationMethods) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeedAggregationMethods.Merge(m, src)
+}
+func (m *MsgUpdateFeedAggregationMethods) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeedAggregationMethods) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeedAggregationMethods.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFeedAggregationMethods proto.InternalMessageInfo
+
+func (m *MsgUpdateFeedAggregationMethods) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgUpdateFeedAggregationMethods) GetFeedAggregationMethods() []FeedAggregationMethod {
+	if m != nil {
+		return m.FeedAggregationMethods
+	}
+	return nil
+}
+
+// MsgUpdateFeedAggregationMethodsResponse is the response type for the Msg/UpdateFeedAggregationMethods RPC method.
+type MsgUpdateFeedAggregationMethodsResponse struct {
+}
+
+func (m *MsgUpdateFeedAggregationMethodsResponse) Reset() {
+	*m = MsgUpdateFeedAggregationMethodsResponse{}
+}
+func (m *MsgUpdateFeedAggregationMethodsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFeedAggregationMethodsResponse) ProtoMessage()    {}
+func (*MsgUpdateFeedAggregationMethodsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{7}
+}
+func (m *MsgUpdateFeedAggregationMethodsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFeedAggregationMethodsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeedAggregationMethodsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFeedAggregationMethodsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeedAggregationMethodsResponse.Merge(m, src)
+}
+func (m *MsgUpdateFeedAggregationMethodsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeedAggregationMethodsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeedAggregationMethodsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFeedAggregationMethodsResponse proto.InternalMessageInfo
+
+// MsgUpdateCircuitBreakerConfigs is the transaction message to update circuit breaker configurations of signal ids.
+type MsgUpdateCircuitBreakerConfigs struct {
+	// admin is the address of the admin or the governance account that is performing the operation.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// circuit_breaker_configs is a list of circuit breaker configurations to update.
+	CircuitBreakerConfigs []CircuitBreakerConfig `protobuf:"bytes,2,rep,name=circuit_breaker_configs,json=circuitBreakerConfigs,proto3" json:"circuit_breaker_configs"`
+}
+
+func (m *MsgUpdateCircuitBreakerConfigs) Reset()         { *m = MsgUpdateCircuitBreakerConfigs{} }
+func (m *MsgUpdateCircuitBreakerConfigs) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCircuitBreakerConfigs) ProtoMessage()    {}
+func (*MsgUpdateCircuitBreakerConfigs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{8}
+}
+func (m *MsgUpdateCircuitBreakerConfigs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCircuitBreakerConfigs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCircuitBreakerConfigs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCircuitBreakerConfigs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCircuitBreakerConfigs.Merge(m, src)
+}
+func (m *MsgUpdateCircuitBreakerConfigs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCircuitBreakerConfigs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCircuitBreakerConfigs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCircuitBreakerConfigs proto.InternalMessageInfo
+
+func (m *MsgUpdateCircuitBreakerConfigs) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgUpdateCircuitBreakerConfigs) GetCircuitBreakerConfigs() []CircuitBreakerConfig {
+	if m != nil {
+		return m.CircuitBreakerConfigs
+	}
+	return nil
+}
+
+// MsgUpdateCircuitBreakerConfigsResponse is the response type for the Msg/UpdateCircuitBreakerConfigs RPC method.
+type MsgUpdateCircuitBreakerConfigsResponse struct {
+}
+
+func (m *MsgUpdateCircuitBreakerConfigsResponse) Reset() {
+	*m = MsgUpdateCircuitBreakerConfigsResponse{}
+}
+func (m *MsgUpdateCircuitBreakerConfigsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCircuitBreakerConfigsResponse) ProtoMessage()    {}
+func (*MsgUpdateCircuitBreakerConfigsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1bc41512ee10d84, []int{9}
+}
+func (m *MsgUpdateCircuitBreakerConfigsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCircuitBreakerConfigsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCircuitBreakerConfigsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCircuitBreakerConfigsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCircuitBreakerConfigsResponse.Merge(m, src)
+}
+func (m *MsgUpdateCircuitBreakerConfigsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCircuitBreakerConfigsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCircuitBreakerConfigsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCircuitBreakerConfigsResponse proto.InternalMessageInfo
+
 // MsgUpdateParams is the transaction message to update parameters.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -330,7 +518,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1bc41512ee10d84, []int{6}
+	return fileDescriptor_e1bc41512ee10d84, []int{10}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,7 +569,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1bc41512ee10d84, []int{7}
+	return fileDescriptor_e1bc41512ee10d84, []int{11}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -417,6 +605,10 @@ func init() {
 	proto.RegisterType((*MsgSubmitSignalPricesResponse)(nil), "band.feeds.v1beta1.MsgSubmitSignalPricesResponse")
 	proto.RegisterType((*MsgUpdateReferenceSourceConfig)(nil), "band.feeds.v1beta1.MsgUpdateReferenceSourceConfig")
 	proto.RegisterType((*MsgUpdateReferenceSourceConfigResponse)(nil), "band.feeds.v1beta1.MsgUpdateReferenceSourceConfigResponse")
+	proto.RegisterType((*MsgUpdateFeedAggregationMethods)(nil), "band.feeds.v1beta1.MsgUpdateFeedAggregationMethods")
+	proto.RegisterType((*MsgUpdateFeedAggregationMethodsResponse)(nil), "band.feeds.v1beta1.MsgUpdateFeedAggregationMethodsResponse")
+	proto.RegisterType((*MsgUpdateCircuitBreakerConfigs)(nil), "band.feeds.v1beta1.MsgUpdateCircuitBreakerConfigs")
+	proto.RegisterType((*MsgUpdateCircuitBreakerConfigsResponse)(nil), "band.feeds.v1beta1.MsgUpdateCircuitBreakerConfigsResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "band.feeds.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "band.feeds.v1beta1.MsgUpdateParamsResponse")
 }
@@ -424,50 +616,59 @@ func init() {
 func init() { proto.RegisterFile("band/feeds/v1beta1/tx.proto", fileDescriptor_e1bc41512ee10d84) }
 
 var fileDescriptor_e1bc41512ee10d84 = []byte{
-	// 673 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x4b, 0x1b, 0x41,
-	0x14, 0xce, 0x1a, 0x7f, 0x90, 0x51, 0x29, 0x0e, 0x8a, 0x71, 0xa3, 0x1b, 0x1b, 0x4b, 0x89, 0x16,
-	0x77, 0x49, 0x84, 0x52, 0x72, 0x29, 0xb5, 0x17, 0x29, 0x04, 0x24, 0xa1, 0x1e, 0x7a, 0x49, 0x27,
-	0xbb, 0xe3, 0x3a, 0xe0, 0xee, 0x2c, 0x33, 0x93, 0xa0, 0xb7, 0xd2, 0x63, 0xa1, 0x50, 0xe8, 0xdf,
-	0x51, 0xf0, 0xe0, 0xb1, 0x7f, 0x80, 0x47, 0xf1, 0xd4, 0x53, 0x29, 0x7a, 0xf0, 0xd6, 0x43, 0xff,
-	0x82, 0xb2, 0x33, 0xb3, 0x9b, 0xaa, 0x9b, 0x68, 0x2f, 0xc9, 0xee, 0x7c, 0xdf, 0xfb, 0xde, 0xfb,
-	0xde, 0xbc, 0x97, 0x80, 0x52, 0x17, 0x85, 0x9e, 0xb3, 0x8f, 0xb1, 0xc7, 0x9d, 0x7e, 0xad, 0x8b,
-	0x05, 0xaa, 0x39, 0xe2, 0xc8, 0x8e, 0x18, 0x15, 0x14, 0xc2, 0x18, 0xb4, 0x25, 0x68, 0x6b, 0xd0,
-	0x9c, 0xf7, 0xa9, 0x4f, 0x25, 0xec, 0xc4, 0x4f, 0x8a, 0x69, 0x5a, 0x19, 0x32, 0x2a, 0x4e, 0xe1,
-	0xe5, 0x0c, 0x3c, 0x42, 0x0c, 0x05, 0x09, 0x61, 0xc9, 0xa5, 0x3c, 0xa0, 0xbc, 0xa3, 0x94, 0xd5,
-	0x8b, 0x86, 0x16, 0xd5, 0x9b, 0x13, 0x70, 0xdf, 0xe9, 0xd7, 0xe2, 0x2f, 0x0d, 0xcc, 0xa1, 0x80,
-	0x84, 0xd4, 0x91, 0x9f, 0xea, 0xa8, 0xf2, 0xd5, 0x00, 0x53, 0x4d, 0xee, 0xef, 0x51, 0x81, 0xa1,
-	0x0d, 0x26, 0xfa, 0x54, 0x60, 0x56, 0x34, 0x56, 0x8d, 0x6a, 0x61, 0xbb, 0x78, 0x71, 0xba, 0x39,
-	0xaf, 0x85, 0x5f, 0x79, 0x1e, 0xc3, 0x9c, 0xb7, 0x05, 0x23, 0xa1, 0xdf, 0x52, 0x34, 0xd8, 0x00,
-	0x53, 0x9c, 0xf8, 0x21, 0x3a, 0xe4, 0xc5, 0xb1, 0xd5, 0x7c, 0x75, 0xba, 0x6e, 0xda, 0x77, 0xfd,
-	0xdb, 0x6d, 0x49, 0xd9, 0x1e, 0x3f, 0xfb, 0x59, 0xce, 0xb5, 0x92, 0x80, 0xc6, 0xf2, 0xc7, 0xeb,
-	0x93, 0x0d, 0xa5, 0xf3, 0xe9, 0xfa, 0x64, 0x63, 0x56, 0x39, 0xd5, 0x95, 0x54, 0xe6, 0xc0, 0x23,
-	0xfd, 0xd8, 0xc2, 0x3c, 0xa2, 0x21, 0xc7, 0x95, 0x3f, 0x06, 0x58, 0x68, 0x72, 0xbf, 0xdd, 0xeb,
-	0x06, 0x44, 0x28, 0xcd, 0x5d, 0x46, 0x5c, 0xcc, 0xe1, 0x4b, 0x50, 0xe8, 0xa3, 0x43, 0xe2, 0x21,
-	0x41, 0x93, 0xd2, 0x1f, 0x5f, 0x9c, 0x6e, 0xae, 0xe8, 0xd2, 0xf7, 0x12, 0xec, 0xa6, 0x87, 0x41,
-	0x0c, 0x5c, 0x06, 0x05, 0x41, 0x02, 0xcc, 0x05, 0x0a, 0xa2, 0xe2, 0xd8, 0xaa, 0x51, 0xcd, 0xb7,
-	0x06, 0x07, 0xf0, 0x0d, 0x98, 0x55, 0x45, 0x77, 0x22, 0x99, 0xaf, 0x98, 0x97, 0x5e, 0xcb, 0xc3,
-	0xbd, 0xca, 0xba, 0xb4, 0xe1, 0x19, 0xfe, 0x4f, 0xa9, 0x0d, 0x3b, 0x76, 0x3d, 0xc8, 0x1c, 0x3b,
-	0x2f, 0xa5, 0xce, 0xef, 0x5a, 0xab, 0x94, 0xc1, 0x4a, 0x26, 0x90, 0x76, 0xe5, 0xb7, 0x01, 0xac,
-	0x26, 0xf7, 0xdf, 0x46, 0x1e, 0x8a, 0x7b, 0xb5, 0x8f, 0x19, 0x0e, 0x5d, 0xdc, 0xa6, 0x3d, 0xe6,
-	0xe2, 0xd7, 0x34, 0xdc, 0x27, 0x7e, 0x7c, 0xab, 0xc8, 0x0b, 0x48, 0x78, 0xff, 0xad, 0x4a, 0x1a,
-	0xf4, 0xc1, 0x22, 0x4b, 0x84, 0x3a, 0x5c, 0x2a, 0x75, 0x5c, 0x29, 0x25, 0x7b, 0x33, 0x5d, 0x5f,
-	0xcf, 0x72, 0x9e, 0x99, 0x5b, 0xf7, 0x60, 0x81, 0x65, 0x81, 0x8d, 0x2d, 0x39, 0x02, 0x32, 0x69,
-	0xdc, 0x88, 0x27, 0x69, 0x23, 0x46, 0xb8, 0xa9, 0x54, 0xc1, 0xd3, 0xd1, 0x8c, 0xb4, 0x35, 0xdf,
-	0x0c, 0x39, 0x44, 0x8a, 0xba, 0x2b, 0x57, 0x07, 0x3e, 0x07, 0x05, 0xd4, 0x13, 0x07, 0x94, 0x11,
-	0x71, 0x7c, 0x6f, 0x3f, 0x06, 0x54, 0xf8, 0x02, 0x4c, 0xaa, 0xe5, 0xd3, 0x2d, 0xc8, 0x1c, 0x74,
-	0x95, 0x43, 0x7b, 0xd6, 0xfc, 0x46, 0x55, 0xde, 0x78, 0xaa, 0x14, 0x1b, 0x5d, 0xb8, 0x65, 0x54,
-	0xc5, 0x55, 0x96, 0xc0, 0xe2, 0xad, 0xa3, 0xc4, 0x4a, 0xfd, 0x7b, 0x1e, 0xe4, 0x9b, 0xdc, 0x87,
-	0x3b, 0x60, 0x5c, 0x2e, 0x6a, 0x29, 0x2b, 0xbd, 0x5e, 0x18, 0x73, 0x6d, 0x04, 0x98, 0x28, 0x42,
-	0x06, 0x60, 0xc6, 0x26, 0xad, 0x0f, 0x09, 0xbd, 0x4b, 0x35, 0x6b, 0x0f, 0xa6, 0xa6, 0x39, 0x3f,
-	0x1b, 0xa0, 0x34, 0x6a, 0x50, 0xeb, 0x43, 0x24, 0x47, 0xc4, 0x98, 0x8d, 0xff, 0x8f, 0x49, 0xeb,
-	0x79, 0x0f, 0x66, 0x6e, 0x0c, 0xc7, 0xda, 0x48, 0x2d, 0x45, 0x32, 0x9f, 0x3d, 0x80, 0x94, 0x64,
-	0x30, 0x27, 0x3e, 0x5c, 0x9f, 0x6c, 0x18, 0xdb, 0x3b, 0x67, 0x97, 0x96, 0x71, 0x7e, 0x69, 0x19,
-	0xbf, 0x2e, 0x2d, 0xe3, 0xcb, 0x95, 0x95, 0x3b, 0xbf, 0xb2, 0x72, 0x3f, 0xae, 0xac, 0xdc, 0x3b,
-	0xdb, 0x27, 0xe2, 0xa0, 0xd7, 0xb5, 0x5d, 0x1a, 0x38, 0xb1, 0xae, 0xfc, 0x4d, 0x76, 0xe9, 0xa1,
-	0xe3, 0x1e, 0x20, 0x12, 0x3a, 0xfd, 0x2d, 0xe7, 0x48, 0xff, 0x07, 0x88, 0xe3, 0x08, 0xf3, 0xee,
-	0xa4, 0x24, 0x6c, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xe4, 0xb7, 0x17, 0xf2, 0x85, 0x06, 0x00,
-	0x00,
+	// 827 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6b, 0xe3, 0x46,
+	0x14, 0xb6, 0xd6, 0x9b, 0x5d, 0x3c, 0xbb, 0xcb, 0xb2, 0x62, 0x53, 0x7b, 0xe5, 0xac, 0x9c, 0x7a,
+	0xfb, 0xc3, 0x49, 0x59, 0x09, 0xdb, 0xa5, 0x14, 0xf5, 0x50, 0xd6, 0x0b, 0x65, 0x29, 0x18, 0x82,
+	0x4d, 0x73, 0xe8, 0xc5, 0x1d, 0x4b, 0x63, 0x79, 0x68, 0xa4, 0x31, 0x33, 0x63, 0x93, 0xdc, 0x4a,
+	0x8f, 0x85, 0x42, 0xa0, 0x7f, 0x47, 0x21, 0x87, 0xfc, 0x11, 0x39, 0x86, 0x9c, 0x7a, 0x6a, 0x4b,
+	0x72, 0xc8, 0xa9, 0x3d, 0xf4, 0x2f, 0x28, 0x9a, 0x19, 0xc9, 0x71, 0x32, 0x91, 0xe3, 0xbd, 0x24,
+	0xd6, 0xbc, 0xef, 0x7d, 0xef, 0x7d, 0x9f, 0xde, 0x3c, 0x04, 0xaa, 0x43, 0x18, 0x07, 0xee, 0x08,
+	0xa1, 0x80, 0xb9, 0xb3, 0xe6, 0x10, 0x71, 0xd8, 0x74, 0xf9, 0xbe, 0x33, 0xa1, 0x84, 0x13, 0xd3,
+	0x4c, 0x82, 0x8e, 0x08, 0x3a, 0x2a, 0x68, 0x3d, 0x0f, 0x49, 0x48, 0x44, 0xd8, 0x4d, 0x7e, 0x49,
+	0xa4, 0x65, 0x6b, 0x68, 0x64, 0x9e, 0x8c, 0xd7, 0x34, 0xf1, 0x09, 0xa4, 0x30, 0x4a, 0x01, 0x2f,
+	0x7c, 0xc2, 0x22, 0xc2, 0x06, 0x92, 0x59, 0x3e, 0xa8, 0x50, 0x59, 0x3e, 0xb9, 0x11, 0x0b, 0xdd,
+	0x59, 0x33, 0xf9, 0xa7, 0x02, 0xcf, 0x60, 0x84, 0x63, 0xe2, 0x8a, 0xbf, 0xf2, 0xa8, 0xfe, 0x9b,
+	0x01, 0x1e, 0x76, 0x59, 0xb8, 0x4b, 0x38, 0x32, 0x1d, 0xb0, 0x36, 0x23, 0x1c, 0xd1, 0x8a, 0xb1,
+	0x69, 0x34, 0x4a, 0x9d, 0xca, 0xd9, 0xf1, 0xeb, 0xe7, 0x8a, 0xf8, 0x4d, 0x10, 0x50, 0xc4, 0x58,
+	0x9f, 0x53, 0x1c, 0x87, 0x3d, 0x09, 0x33, 0x3d, 0xf0, 0x90, 0xe1, 0x30, 0x86, 0x7b, 0xac, 0x72,
+	0x6f, 0xb3, 0xd8, 0x78, 0xd4, 0xb2, 0x9c, 0x9b, 0xfa, 0x9d, 0xbe, 0x80, 0x74, 0xee, 0x9f, 0xfc,
+	0x59, 0x2b, 0xf4, 0xd2, 0x04, 0x6f, 0xe3, 0xe7, 0xcb, 0xa3, 0x6d, 0xc9, 0xf3, 0xcb, 0xe5, 0xd1,
+	0xf6, 0x13, 0xa9, 0x54, 0x75, 0x52, 0x7f, 0x06, 0x9e, 0xaa, 0x9f, 0x3d, 0xc4, 0x26, 0x24, 0x66,
+	0xa8, 0xfe, 0x9f, 0x01, 0xd6, 0xbb, 0x2c, 0xec, 0x4f, 0x87, 0x11, 0xe6, 0x92, 0x73, 0x87, 0x62,
+	0x1f, 0x31, 0xf3, 0x6b, 0x50, 0x9a, 0xc1, 0x3d, 0x1c, 0x40, 0x4e, 0xd2, 0xd6, 0x3f, 0x3c, 0x3b,
+	0x7e, 0xfd, 0x52, 0xb5, 0xbe, 0x9b, 0xc6, 0x16, 0x35, 0xcc, 0x73, 0xcc, 0x0d, 0x50, 0xe2, 0x38,
+	0x42, 0x8c, 0xc3, 0x68, 0x52, 0xb9, 0xb7, 0x69, 0x34, 0x8a, 0xbd, 0xf9, 0x81, 0xf9, 0x2d, 0x78,
+	0x22, 0x9b, 0x1e, 0x4c, 0x44, 0xbd, 0x4a, 0x51, 0x68, 0xad, 0xdd, 0xae, 0x55, 0xf4, 0xa5, 0x04,
+	0x3f, 0x66, 0xf3, 0x23, 0xe6, 0x39, 0x89, 0xea, 0x79, 0xe5, 0x44, 0x79, 0x35, 0x53, 0x7e, 0x53,
+	0x5a, 0xbd, 0x06, 0x5e, 0x6a, 0x03, 0x99, 0x2b, 0xff, 0x1a, 0xc0, 0xee, 0xb2, 0xf0, 0xbb, 0x49,
+	0x00, 0x13, 0xaf, 0x46, 0x88, 0xa2, 0xd8, 0x47, 0x7d, 0x32, 0xa5, 0x3e, 0x7a, 0x4b, 0xe2, 0x11,
+	0x0e, 0x93, 0xb7, 0x0a, 0x83, 0x08, 0xc7, 0xcb, 0xdf, 0xaa, 0x80, 0x99, 0x21, 0x28, 0xd3, 0x94,
+	0x68, 0xc0, 0x04, 0xd3, 0xc0, 0x17, 0x54, 0xc2, 0x9b, 0x47, 0xad, 0x2d, 0x9d, 0x72, 0x6d, 0x6d,
+	0xe5, 0xc1, 0x3a, 0xd5, 0x05, 0xbd, 0xb6, 0x18, 0x01, 0x51, 0x34, 0x31, 0xe2, 0xa3, 0xcc, 0x88,
+	0x1c, 0x35, 0xf5, 0x06, 0xf8, 0x24, 0x1f, 0x71, 0x75, 0x60, 0x6a, 0x19, 0xf4, 0x1b, 0x84, 0x82,
+	0x37, 0x61, 0x48, 0x51, 0x08, 0x39, 0x26, 0x71, 0x17, 0xf1, 0x31, 0x09, 0xd8, 0xca, 0xde, 0x60,
+	0x50, 0x49, 0xba, 0x1c, 0xc0, 0x39, 0xd5, 0x20, 0x92, 0x5c, 0xea, 0x0a, 0x68, 0xcd, 0xd1, 0x56,
+	0x57, 0xe6, 0x7c, 0x30, 0xd2, 0xb6, 0xe6, 0x7d, 0xbe, 0xe8, 0xce, 0xc7, 0xd7, 0xdc, 0xd1, 0x0b,
+	0xaa, 0x6f, 0x81, 0x4f, 0x97, 0x40, 0x32, 0x7f, 0xfe, 0xb9, 0x3a, 0x3a, 0x6f, 0x31, 0xf5, 0xa7,
+	0x98, 0x77, 0x28, 0x82, 0x3f, 0x22, 0x2a, 0x9d, 0x5c, 0xdd, 0x9e, 0x11, 0x28, 0xfb, 0x92, 0x68,
+	0x30, 0x94, 0x4c, 0x6a, 0x72, 0x52, 0x77, 0x1a, 0x3a, 0x77, 0x74, 0xb5, 0xd3, 0xc9, 0xf1, 0x75,
+	0x7d, 0x2d, 0x9b, 0x1c, 0xad, 0x98, 0x85, 0xc9, 0xd1, 0x22, 0x32, 0x67, 0x7e, 0x37, 0xc0, 0xd3,
+	0x0c, 0xba, 0x23, 0x96, 0xae, 0xf9, 0x05, 0x28, 0xc1, 0x29, 0x1f, 0x13, 0x8a, 0xf9, 0xc1, 0x52,
+	0x3b, 0xe6, 0x50, 0xf3, 0x4b, 0xf0, 0x40, 0xae, 0x6d, 0x75, 0x79, 0xb4, 0x2b, 0x52, 0xd6, 0x50,
+	0x9a, 0x15, 0xde, 0x6b, 0x88, 0x5d, 0x91, 0x31, 0x25, 0x42, 0xd7, 0xaf, 0x09, 0x95, 0x79, 0xf5,
+	0x17, 0xa0, 0x7c, 0xed, 0x28, 0x95, 0xd2, 0xfa, 0x6b, 0x0d, 0x14, 0xbb, 0x2c, 0x34, 0xdf, 0x81,
+	0xfb, 0x62, 0xc5, 0x57, 0x75, 0xe5, 0xd5, 0xaa, 0xb5, 0x5e, 0xe5, 0x04, 0x53, 0x46, 0x93, 0x02,
+	0x53, 0xb3, 0x83, 0xb7, 0x6e, 0x49, 0xbd, 0x09, 0xb5, 0x9a, 0x77, 0x86, 0x66, 0x35, 0x7f, 0x35,
+	0x40, 0x35, 0x6f, 0xc5, 0xb5, 0x6e, 0xa1, 0xcc, 0xc9, 0xb1, 0xbc, 0xd5, 0x73, 0xb2, 0x7e, 0x0e,
+	0x0d, 0xb0, 0x91, 0xbb, 0x57, 0xda, 0xb9, 0xe4, 0xfa, 0x24, 0xeb, 0xab, 0xf7, 0x48, 0xd2, 0x58,
+	0xa4, 0xbf, 0xca, 0xf9, 0x16, 0x69, 0x73, 0x2c, 0x6f, 0xf5, 0x9c, 0xac, 0x9f, 0x1f, 0xc0, 0xe3,
+	0x85, 0xfb, 0xf3, 0x2a, 0x97, 0x4b, 0x82, 0xac, 0xcf, 0xee, 0x00, 0x4a, 0x2b, 0x58, 0x6b, 0x3f,
+	0x5d, 0x1e, 0x6d, 0x1b, 0x9d, 0x77, 0x27, 0xe7, 0xb6, 0x71, 0x7a, 0x6e, 0x1b, 0x7f, 0x9f, 0xdb,
+	0xc6, 0xe1, 0x85, 0x5d, 0x38, 0xbd, 0xb0, 0x0b, 0x7f, 0x5c, 0xd8, 0x85, 0xef, 0x9d, 0x10, 0xf3,
+	0xf1, 0x74, 0xe8, 0xf8, 0x24, 0x72, 0x13, 0x5e, 0xf1, 0xc1, 0xe3, 0x93, 0x3d, 0xd7, 0x1f, 0x43,
+	0x1c, 0xbb, 0xb3, 0xb6, 0xbb, 0xaf, 0x3e, 0xb0, 0xf8, 0xc1, 0x04, 0xb1, 0xe1, 0x03, 0x01, 0x68,
+	0xff, 0x3f, 0x00, 0xab, 0xa9, 0xdc, 0x2a, 0xe2, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -488,6 +689,10 @@ type MsgClient interface {
 	SubmitSignalPrices(ctx context.Context, in *MsgSubmitSignalPrices, opts ...grpc.CallOption) (*MsgSubmitSignalPricesResponse, error)
 	// UpdateReferenceSourceConfig is an RPC method to update reference price source configuration.
 	UpdateReferenceSourceConfig(ctx context.Context, in *MsgUpdateReferenceSourceConfig, opts ...grpc.CallOption) (*MsgUpdateReferenceSourceConfigResponse, error)
+	// UpdateFeedAggregationMethods is an RPC method to update aggregation methods of signal ids.
+	UpdateFeedAggregationMethods(ctx context.Context, in *MsgUpdateFeedAggregationMethods, opts ...grpc.CallOption) (*MsgUpdateFeedAggregationMethodsResponse, error)
+	// UpdateCircuitBreakerConfigs is an RPC method to update circuit breaker configurations of signal ids.
+	UpdateCircuitBreakerConfigs(ctx context.Context, in *MsgUpdateCircuitBreakerConfigs, opts ...grpc.CallOption) (*MsgUpdateCircuitBreakerConfigsResponse, error)
 	// UpdateParams is an RPC method to update parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -527,6 +732,24 @@ func (c *msgClient) UpdateReferenceSourceConfig(ctx context.Context, in *MsgUpda
 	return out, nil
 }
 
+func (c *msgClient) UpdateFeedAggregationMethods(ctx context.Context, in *MsgUpdateFeedAggregationMethods, opts ...grpc.CallOption) (*MsgUpdateFeedAggregationMethodsResponse, error) {
+	out := new(MsgUpdateFeedAggregationMethodsResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Msg/UpdateFeedAggregationMethods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCircuitBreakerConfigs(ctx context.Context, in *MsgUpdateCircuitBreakerConfigs, opts ...grpc.CallOption) (*MsgUpdateCircuitBreakerConfigsResponse, error) {
+	out := new(MsgUpdateCircuitBreakerConfigsResponse)
+	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Msg/UpdateCircuitBreakerConfigs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/band.feeds.v1beta1.Msg/UpdateParams", in, out, opts...)
@@ -544,6 +767,10 @@ type MsgServer interface {
 	SubmitSignalPrices(context.Context, *MsgSubmitSignalPrices) (*MsgSubmitSignalPricesResponse, error)
 	// UpdateReferenceSourceConfig is an RPC method to update reference price source configuration.
 	UpdateReferenceSourceConfig(context.Context, *MsgUpdateReferenceSourceConfig) (*MsgUpdateReferenceSourceConfigResponse, error)
+	// UpdateFeedAggregationMethods is an RPC method to update aggregation methods of signal ids.
+	UpdateFeedAggregationMethods(context.Context, *MsgUpdateFeedAggregationMethods) (*MsgUpdateFeedAggregationMethodsResponse, error)
+	// UpdateCircuitBreakerConfigs is an RPC method to update circuit breaker configurations of signal ids.
+	UpdateCircuitBreakerConfigs(context.Context, *MsgUpdateCircuitBreakerConfigs) (*MsgUpdateCircuitBreakerConfigsResponse, error)
 	// UpdateParams is an RPC method to update parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
@@ -561,6 +788,12 @@ func (*UnimplementedMsgServer) SubmitSignalPrices(ctx context.Context, req *MsgS
 func (*UnimplementedMsgServer) UpdateReferenceSourceConfig(ctx context.Context, req *MsgUpdateReferenceSourceConfig) (*MsgUpdateReferenceSourceConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateReferenceSourceConfig not implemented")
 }
+func (*UnimplementedMsgServer) UpdateFeedAggregationMethods(ctx context.Context, req *MsgUpdateFeedAggregationMethods) (*MsgUpdateFeedAggregationMethodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeedAggregationMethods not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCircuitBreakerConfigs(ctx context.Context, req *MsgUpdateCircuitBreakerConfigs) (*MsgUpdateCircuitBreakerConfigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCircuitBreakerConfigs not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -623,6 +856,42 @@ func _Msg_UpdateReferenceSourceConfig_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateFeedAggregationMethods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeedAggregationMethods)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeedAggregationMethods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Msg/UpdateFeedAggregationMethods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeedAggregationMethods(ctx, req.(*MsgUpdateFeedAggregationMethods))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCircuitBreakerConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCircuitBreakerConfigs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCircuitBreakerConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.feeds.v1beta1.Msg/UpdateCircuitBreakerConfigs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCircuitBreakerConfigs(ctx, req.(*MsgUpdateCircuitBreakerConfigs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -657,6 +926,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateReferenceSourceConfig",
 			Handler:    _Msg_UpdateReferenceSourceConfig_Handler,
 		},
+		{
+			MethodName: "UpdateFeedAggregationMethods",
+			Handler:    _Msg_UpdateFeedAggregationMethods_Handler,
+		},
+		{
+			MethodName: "UpdateCircuitBreakerConfigs",
+			Handler:    _Msg_UpdateCircuitBreakerConfigs_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -868,7 +1145,7 @@ func (m *MsgUpdateReferenceSourceConfigResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateFeedAggregationMethods) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -878,37 +1155,41 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateFeedAggregationMethods) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateFeedAggregationMethods) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.FeedAggregationMethods) > 0 {
+		for iNdEx := len(m.FeedAggregationMethods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeedAggregationMethods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateFeedAggregationMethodsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -918,12 +1199,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateFeedAggregationMethodsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateFeedAggregationMethodsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -931,48 +1212,178 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateCircuitBreakerConfigs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgVote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateCircuitBreakerConfigs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCircuitBreakerConfigs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Signals) > 0 {
-		for _, e := range m.Signals {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.CircuitBreakerConfigs) > 0 {
+		for iNdEx := len(m.CircuitBreakerConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakerConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateCircuitBreakerConfigsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitSignalPrices) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateCircuitBreakerConfigsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCircuitBreakerConfigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signals) > 0 {
+		for _, e := range m.Signals {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitSignalPrices) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1025,6 +1436,62 @@ func (m *MsgUpdateReferenceSourceConfigResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateFeedAggregationMethods) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FeedAggregationMethods) > 0 {
+		for _, e := range m.FeedAggregationMethods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateFeedAggregationMethodsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateCircuitBreakerConfigs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CircuitBreakerConfigs) > 0 {
+		for _, e := range m.CircuitBreakerConfigs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateCircuitBreakerConfigsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1571,6 +2038,338 @@ func (m *MsgUpdateReferenceSourceConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateFeedAggregationMethods) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFeedAggregationMethods: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFeedAggregationMethods: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedAggregationMethods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedAggregationMethods = append(m.FeedAggregationMethods, FeedAggregationMethod{})
+			if err := m.FeedAggregationMethods[len(m.FeedAggregationMethods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFeedAggregationMethodsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFeedAggregationMethodsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFeedAggregationMethodsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCircuitBreakerConfigs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCircuitBreakerConfigs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCircuitBreakerConfigs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerConfigs = append(m.CircuitBreakerConfigs, CircuitBreakerConfig{})
+			if err := m.CircuitBreakerConfigs[len(m.CircuitBreakerConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCircuitBreakerConfigsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCircuitBreakerConfigsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCircuitBreakerConfigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
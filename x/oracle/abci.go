@@ -13,7 +13,13 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker)
 
 	// Reward a portion of block rewards (inflation + tx fee) to active oracle validators.
-	return k.AllocateTokens(ctx, ctx.VoteInfos())
+	if err := k.AllocateTokens(ctx, ctx.VoteInfos()); err != nil {
+		return err
+	}
+
+	// Spawn the requests of the request subscriptions that are due at this block.
+	k.ProcessRequestSubscriptions(ctx)
+	return nil
 }
 
 // EndBlocker cleans up the state during end block. See comment in the implementation!
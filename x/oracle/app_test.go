@@ -13,6 +13,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	band "github.com/bandprotocol/chain/v3/app"
 	bandtesting "github.com/bandprotocol/chain/v3/testing"
@@ -109,6 +111,10 @@ func (s *AppTestSuite) TestSuccessRequestOracleData() {
 		bandtesting.Validators[0].Address.String(),
 		nil,
 	)
+	expectRequest.OracleScriptVersion = 1
+	for i := range expectRequest.RawRequests {
+		expectRequest.RawRequests[i].DataSourceVersion = 1
+	}
 
 	request, err := s.app.OracleKeeper.GetRequest(ctx, types.RequestID(1))
 	require.NoError(err)
@@ -296,6 +302,10 @@ func (s *AppTestSuite) TestExpiredRequestOracleData() {
 		bandtesting.Validators[0].Address.String(),
 		nil,
 	)
+	expectRequest.OracleScriptVersion = 1
+	for i := range expectRequest.RawRequests {
+		expectRequest.RawRequests[i].DataSourceVersion = 1
+	}
 
 	request, err := s.app.OracleKeeper.GetRequest(ctx, types.RequestID(1))
 	require.NoError(err)
@@ -316,7 +326,42 @@ func (s *AppTestSuite) TestExpiredRequestOracleData() {
 		types.RESOLVE_STATUS_EXPIRED,
 		[]byte{},
 	)
+	oracleModule := authtypes.NewModuleAddress(types.ModuleName).String()
+	requester := bandtesting.Validators[0].Address.String()
 	expectEvents := []abci.Event{{
+		Type: banktypes.EventTypeCoinSpent,
+		Attributes: []abci.EventAttribute{
+			{Key: banktypes.AttributeKeySpender, Value: oracleModule},
+			{Key: sdk.AttributeKeyAmount, Value: "9000000uband"},
+		},
+	}, {
+		Type: banktypes.EventTypeCoinReceived,
+		Attributes: []abci.EventAttribute{
+			{Key: banktypes.AttributeKeyReceiver, Value: requester},
+			{Key: sdk.AttributeKeyAmount, Value: "9000000uband"},
+		},
+	}, {
+		Type: banktypes.EventTypeTransfer,
+		Attributes: []abci.EventAttribute{
+			{Key: banktypes.AttributeKeyRecipient, Value: requester},
+			{Key: banktypes.AttributeKeySender, Value: oracleModule},
+			{Key: sdk.AttributeKeyAmount, Value: "9000000uband"},
+		},
+	}, {
+		Type: sdk.EventTypeMessage,
+		Attributes: []abci.EventAttribute{
+			{Key: sdk.AttributeKeySender, Value: oracleModule},
+		},
+	}, {
+		Type: types.EventTypeFeeReceipt,
+		Attributes: []abci.EventAttribute{
+			{Key: types.AttributeKeyID, Value: fmt.Sprint(resPacket.RequestID)},
+			{Key: types.AttributeKeyPayer, Value: requester},
+			{Key: types.AttributeKeyTotalFees, Value: "9000000uband"},
+			{Key: types.AttributeKeyReleasedFees, Value: ""},
+			{Key: types.AttributeKeyRefund, Value: "9000000uband"},
+		},
+	}, {
 		Type: types.EventTypeResolve,
 		Attributes: []abci.EventAttribute{
 			{Key: types.AttributeKeyID, Value: fmt.Sprint(resPacket.RequestID)},
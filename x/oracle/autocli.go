@@ -53,6 +53,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Get active status of a validator",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
 				},
+				{
+					RpcMethod:      "ValidatorReportInfo",
+					Use:            "validator-report-info [validator-address]",
+					Short:          "Get the report window stats of a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
+				},
+				{
+					RpcMethod: "ValidatorReportInfos",
+					Use:       "validator-report-infos",
+					Short:     "Get the report window stats of all validators",
+				},
 				{
 					RpcMethod: "IsReporter",
 					Use:       "is-reporter [validator-address] [reporter-address]",
@@ -78,6 +89,50 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Get current parameters of Bandchain's oracle module",
 				},
+				{
+					RpcMethod: "RequestSearch",
+					Use:       "request-search [oracle-script-id] [calldata-hex] [ask-count] [min-count]",
+					Short:     "Search for the latest successful request that matches the given input",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "oracle_script_id"},
+						{ProtoField: "calldata"},
+						{ProtoField: "ask_count"},
+						{ProtoField: "min_count"},
+					},
+				},
+				{
+					RpcMethod: "RequestPrice",
+					Use:       "request-price [ask-count] [min-count] [symbol...]",
+					Short:     "Get the latest prices of the given symbols from the standard price reference oracle script",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "ask_count"},
+						{ProtoField: "min_count"},
+						{ProtoField: "symbols", Varargs: true},
+					},
+				},
+				{
+					RpcMethod:      "DataSourceVersions",
+					Use:            "data-source-versions [id]",
+					Short:          "Get the version history of a data source",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "data_source_id"}},
+				},
+				{
+					RpcMethod:      "OracleScriptVersions",
+					Use:            "oracle-script-versions [id]",
+					Short:          "Get the version history of an oracle script",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "oracle_script_id"}},
+				},
+				{
+					RpcMethod:      "RequestSubscription",
+					Use:            "request-subscription [id]",
+					Short:          "Get a request subscription and its remaining deposit",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subscription_id"}},
+				},
+				{
+					RpcMethod: "RequestSubscriptions",
+					Use:       "request-subscriptions",
+					Short:     "Get all request subscriptions",
+				},
 				{
 					RpcMethod: "RequestVerification",
 					Use:       "verify-request [chain-id] [validator-addr] [request-id] [data-source-external-id] [reporter-pubkey] [reporter-signature-hex]",
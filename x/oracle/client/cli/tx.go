@@ -33,9 +33,19 @@ const (
 	flagExecuteGas    = "execute-gas"
 	flagTSSEncoder    = "tss-encoder"
 	flagFeeLimit      = "fee-limit"
+	flagBatch         = "batch"
+	flagGroupName     = "group-name"
 	flagFee           = "fee"
 	flagTreasury      = "treasury"
 	flagExpiration    = "expiration"
+
+	flagCallbackTarget   = "callback-target"
+	flagCallbackGasLimit = "callback-gas-limit"
+
+	flagInitialDeposit = "initial-deposit"
+
+	flagOracleScriptVersion = "oracle-script-version"
+	flagDataSourceVersions  = "data-source-versions"
 )
 
 // NewTxCmd returns the transaction commands for this module
@@ -56,6 +66,9 @@ func NewTxCmd() *cobra.Command {
 		GetCmdActivate(),
 		GetCmdAddReporters(),
 		GetCmdRemoveReporters(),
+		GetCmdCreateRequestSubscription(),
+		GetCmdTopUpRequestSubscription(),
+		GetCmdCancelRequestSubscription(),
 	)
 
 	return txCmd
@@ -146,6 +159,32 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --fee-l
 				types.Encoder(tssEncoder),
 			)
 
+			callbackTarget, err := cmd.Flags().GetString(flagCallbackTarget)
+			if err != nil {
+				return err
+			}
+			if callbackTarget != "" {
+				callbackGasLimit, err := cmd.Flags().GetUint64(flagCallbackGasLimit)
+				if err != nil {
+					return err
+				}
+				msg.Callback = types.NewRequestCallback(callbackTarget, callbackGasLimit)
+			}
+
+			msg.OracleScriptVersion, err = cmd.Flags().GetUint64(flagOracleScriptVersion)
+			if err != nil {
+				return err
+			}
+
+			dsVersions, err := cmd.Flags().GetStringSlice(flagDataSourceVersions)
+			if err != nil {
+				return err
+			}
+			msg.DataSourceVersions, err = parseDataSourceVersionPins(dsVersions)
+			if err != nil {
+				return err
+			}
+
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -162,6 +201,243 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --fee-l
 		String(flagFeeLimit, "", "The maximum tokens paid to all data source and tss signature providers, if any")
 	cmd.Flags().
 		Int32(flagTSSEncoder, 0, "The encode type of oracle result that will be sent to tss (1=proto, 2=ABI, 3=Partial ABI)")
+	cmd.Flags().String(flagCallbackTarget, "", "The registered module that receives the response of the request, if any")
+	cmd.Flags().Uint64(flagCallbackGasLimit, 100000, "The maximum gas that the callback target can use")
+	cmd.Flags().Uint64(flagOracleScriptVersion, 0, "The version of the oracle script to call (0=latest)")
+	cmd.Flags().StringSlice(
+		flagDataSourceVersions,
+		nil,
+		"The versions of the data sources to execute as [data-source-id]:[version], e.g. 1:2,3:1",
+	)
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseDataSourceVersionPins parses data source version pins in the format of [data-source-id]:[version].
+func parseDataSourceVersionPins(pins []string) ([]types.DataSourceVersionPin, error) {
+	var res []types.DataSourceVersionPin
+	for _, pin := range pins {
+		idAndVersion := strings.Split(pin, ":")
+		if len(idAndVersion) != 2 {
+			return nil, fmt.Errorf("invalid data source version: %s", pin)
+		}
+		id, err := strconv.ParseUint(idAndVersion[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		version, err := strconv.ParseUint(idAndVersion[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, types.NewDataSourceVersionPin(types.DataSourceID(id), version))
+	}
+	return res, nil
+}
+
+// GetCmdCreateRequestSubscription implements the create request subscription command handler.
+func GetCmdCreateRequestSubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-request-subscription [oracle-script-id] [ask-count] [min-count] [interval] (-c [calldata]) (-m [client-id]) (--prepare-gas=[prepare-gas] (--execute-gas=[execute-gas])) (--fee-limit=[fee-limit]) (--initial-deposit=[initial-deposit])",
+		Short: "Create a subscription that makes a new data request every interval blocks",
+		Args:  cobra.ExactArgs(4),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a subscription that makes a new data request via an existing oracle script every interval blocks.
+The fees of the requests are paid from the deposit of the subscription.
+Example:
+$ %s tx oracle create-request-subscription 1 4 3 100 -c 1234abcdef --fee-limit 10uband --initial-deposit 1000uband --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			int64OracleScriptID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			oracleScriptID := types.OracleScriptID(int64OracleScriptID)
+
+			askCount, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			minCount, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			interval, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			calldata, err := cmd.Flags().GetBytesHex(flagCalldata)
+			if err != nil {
+				return err
+			}
+
+			clientID, err := cmd.Flags().GetString(flagClientID)
+			if err != nil {
+				return err
+			}
+
+			prepareGas, err := cmd.Flags().GetUint64(flagPrepareGas)
+			if err != nil {
+				return err
+			}
+
+			executeGas, err := cmd.Flags().GetUint64(flagExecuteGas)
+			if err != nil {
+				return err
+			}
+
+			coinStr, err := cmd.Flags().GetString(flagFeeLimit)
+			if err != nil {
+				return err
+			}
+
+			feeLimit, err := sdk.ParseCoinsNormalized(coinStr)
+			if err != nil {
+				return err
+			}
+
+			tssEncoder, err := cmd.Flags().GetInt32(flagTSSEncoder)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(flagInitialDeposit)
+			if err != nil {
+				return err
+			}
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateRequestSubscription(
+				oracleScriptID,
+				calldata,
+				askCount,
+				minCount,
+				clientID,
+				feeLimit,
+				prepareGas,
+				executeGas,
+				types.Encoder(tssEncoder),
+				interval,
+				initialDeposit,
+				clientCtx.GetFromAddress(),
+			)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().BytesHexP(flagCalldata, "c", nil, "Calldata used in calling the oracle script")
+	cmd.Flags().StringP(flagClientID, "m", "", "Requester can match up the requests with responses by clientID")
+	cmd.Flags().Uint64(flagPrepareGas, 50000, "Prepare gas used in fee counting for prepare request")
+	cmd.Flags().Uint64(flagExecuteGas, 300000, "Execute gas used in fee counting for execute request")
+	cmd.Flags().
+		String(flagFeeLimit, "", "The maximum tokens paid to all data source and tss signature providers of each request")
+	cmd.Flags().
+		Int32(flagTSSEncoder, 0, "The encode type of oracle result that will be sent to tss (1=proto, 2=ABI, 3=Partial ABI)")
+	cmd.Flags().String(flagInitialDeposit, "", "The deposit used to pay the fees of the requests")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdTopUpRequestSubscription implements the top up request subscription command handler.
+func GetCmdTopUpRequestSubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-up-request-subscription [subscription-id] [amount]",
+		Short: "Add deposit to a request subscription",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add deposit to a request subscription. An inactive subscription is reactivated.
+Example:
+$ %s tx oracle top-up-request-subscription 1 1000uband --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subscriptionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTopUpRequestSubscription(subscriptionID, amount, clientCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCancelRequestSubscription implements the cancel request subscription command handler.
+func GetCmdCancelRequestSubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-request-subscription [subscription-id]",
+		Short: "Cancel a request subscription and refund its remaining deposit",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a request subscription and refund its remaining deposit to the owner.
+Example:
+$ %s tx oracle cancel-request-subscription 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			subscriptionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelRequestSubscription(subscriptionID, clientCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -730,6 +1006,16 @@ $ %s tx bandtss request-signature oracle-result 1 2 --fee-limit 10uband
 				return err
 			}
 
+			msg.Batch, err = cmd.Flags().GetBool(flagBatch)
+			if err != nil {
+				return err
+			}
+
+			msg.GroupName, err = cmd.Flags().GetString(flagGroupName)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
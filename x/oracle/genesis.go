@@ -17,6 +17,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *types.GenesisState) {
 		panic(errorsmod.Wrapf(err, "set params"))
 	}
 
+	// check if the module account exists
+	if moduleAcc := k.GetOracleAccount(ctx); moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
 	k.SetDataSourceCount(ctx, 0)
 	k.SetOracleScriptCount(ctx, 0)
 	k.SetRequestCount(ctx, 0)
@@ -27,6 +32,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *types.GenesisState) {
 	for _, oracleScript := range data.OracleScripts {
 		_ = k.AddOracleScript(ctx, oracleScript)
 	}
+	// The data sources and oracle scripts above get their first versions when added. The exported version
+	// histories, if any, replace them.
+	for _, v := range data.DataSourceVersions {
+		k.SetDataSourceVersion(ctx, v)
+	}
+	for _, v := range data.OracleScriptVersions {
+		k.SetOracleScriptVersion(ctx, v)
+	}
+
+	k.SetRequestSubscriptionCount(ctx, data.RequestSubscriptionCount)
+	for _, sub := range data.RequestSubscriptions {
+		k.SetRequestSubscription(ctx, sub)
+		if sub.IsActive {
+			k.ScheduleRequestSubscription(ctx, sub)
+		}
+	}
 
 	k.SetPort(ctx, types.PortID)
 	// Only try to bind to port if it is not already bound, since we may already own
@@ -44,8 +65,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *types.GenesisState) {
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:        k.GetParams(ctx),
-		DataSources:   k.GetAllDataSources(ctx),
-		OracleScripts: k.GetAllOracleScripts(ctx),
+		Params:                   k.GetParams(ctx),
+		DataSources:              k.GetAllDataSources(ctx),
+		OracleScripts:            k.GetAllOracleScripts(ctx),
+		RequestSubscriptionCount: k.GetRequestSubscriptionCount(ctx),
+		RequestSubscriptions:     k.GetAllRequestSubscriptions(ctx),
+		DataSourceVersions:       k.GetAllDataSourceVersions(ctx),
+		OracleScriptVersions:     k.GetAllOracleScriptVersions(ctx),
 	}
 }
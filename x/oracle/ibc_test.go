@@ -14,6 +14,7 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	band "github.com/bandprotocol/chain/v3/app"
@@ -102,12 +103,12 @@ func (suite *IBCTestSuite) sendOracleRequestPacket(
 	return packet
 }
 
-func (suite *IBCTestSuite) checkChainBTreasuryBalances(expect sdk.Coins) {
-	treasuryBalances := suite.bandApp.BankKeeper.GetAllBalances(
+func (suite *IBCTestSuite) checkChainBFeeEscrowBalances(expect sdk.Coins) {
+	escrowBalances := suite.bandApp.BankKeeper.GetAllBalances(
 		suite.chainB.GetContext(),
-		bandtesting.Treasury.Address,
+		authtypes.NewModuleAddress(oracletypes.ModuleName),
 	)
-	suite.Require().Equal(expect, treasuryBalances)
+	suite.Require().Equal(expect, escrowBalances)
 }
 
 func (suite *IBCTestSuite) checkChainBSenderBalances(expect sdk.Coins) {
@@ -140,8 +141,8 @@ func (suite *IBCTestSuite) TestHandleIBCRequestSuccess() {
 	err := path.RelayPacket(packet)
 	suite.Require().NoError(err) // relay committed
 
-	// Treasury get fees from relayer
-	suite.checkChainBTreasuryBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(12000000))))
+	// Fees from relayer are escrowed until validators report
+	suite.checkChainBFeeEscrowBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(12000000))))
 
 	raws1 := []oracletypes.RawReport{
 		oracletypes.NewRawReport(1, 0, []byte("data1")),
@@ -697,7 +698,7 @@ func (suite *IBCTestSuite) TestIBCResolveRequestOutOfGas() {
 	err := path.RelayPacket(packet)
 	suite.Require().NoError(err) // relay committed
 
-	suite.checkChainBTreasuryBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(6000000))))
+	suite.checkChainBFeeEscrowBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(6000000))))
 	suite.checkChainBSenderBalances(expectedSenderBalance)
 
 	raws := []oracletypes.RawReport{
@@ -766,7 +767,7 @@ func (suite *IBCTestSuite) TestIBCResolveReadNilExternalData() {
 	err := path.RelayPacket(packet)
 	suite.Require().NoError(err) // relay committed
 
-	suite.checkChainBTreasuryBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(4000000))))
+	suite.checkChainBFeeEscrowBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(4000000))))
 	suite.checkChainBSenderBalances(expectedSenderBalance)
 
 	raws1 := []oracletypes.RawReport{
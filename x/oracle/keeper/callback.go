@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/pkg/gas"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// SetRequestCallback sets the callback of a request to the store.
+func (k Keeper) SetRequestCallback(ctx sdk.Context, id types.RequestID, callback types.RequestCallback) {
+	ctx.KVStore(k.storeKey).Set(types.RequestCallbackStoreKey(id), k.cdc.MustMarshal(&callback))
+}
+
+// GetRequestCallback returns the callback of a request, if any.
+func (k Keeper) GetRequestCallback(ctx sdk.Context, id types.RequestID) (types.RequestCallback, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RequestCallbackStoreKey(id))
+	if bz == nil {
+		return types.RequestCallback{}, false
+	}
+	var callback types.RequestCallback
+	k.cdc.MustUnmarshal(bz, &callback)
+	return callback, true
+}
+
+// DeleteRequestCallback deletes the callback of a request from the store.
+func (k Keeper) DeleteRequestCallback(ctx sdk.Context, id types.RequestID) {
+	ctx.KVStore(k.storeKey).Delete(types.RequestCallbackStoreKey(id))
+}
+
+// AddRequestCallback checks the callback of a new request against the registered targets and the maximum
+// callback gas limit, charges its gas limit upfront, and saves it to be invoked when the request is resolved.
+func (k Keeper) AddRequestCallback(ctx sdk.Context, id types.RequestID, callback types.RequestCallback) error {
+	if err := callback.Validate(); err != nil {
+		return err
+	}
+
+	maxGasLimit := k.GetParams(ctx).MaxCallbackGasLimit
+	if callback.GasLimit > maxGasLimit {
+		return types.ErrInvalidCallback.Wrapf("gas limit %d exceeds maximum %d", callback.GasLimit, maxGasLimit)
+	}
+	if !k.callbackRouter.HasRoute(callback.Target) {
+		return types.ErrCallbackTargetNotFound.Wrapf("target: %s", callback.Target)
+	}
+
+	// the callback is run in EndBlock, so its gas is paid by the requester
+	ctx.GasMeter().ConsumeGas(callback.GasLimit, "ORACLE_CALLBACK_FEE")
+
+	k.SetRequestCallback(ctx, id, callback)
+	return nil
+}
+
+// InvokeRequestCallback delivers the response of a resolved request to its callback target, if any. The target
+// can use gas up to the gas limit of the callback, and its failure is emitted as an event without reverting
+// the resolution of the request.
+func (k Keeper) InvokeRequestCallback(ctx sdk.Context, id types.RequestID, packet types.OracleResponsePacketData) {
+	callback, found := k.GetRequestCallback(ctx, id)
+	if !found {
+		return
+	}
+	k.DeleteRequestCallback(ctx, id)
+
+	hooks, found := k.callbackRouter.GetRoute(callback.Target)
+	if !found {
+		k.emitCallbackFailEvent(ctx, id, callback, 0, types.ErrCallbackTargetNotFound.Error())
+		return
+	}
+
+	// invoke the target with a capped gas meter in a cached context
+	gasMeter := storetypes.NewGasMeter(callback.GasLimit)
+	cacheCtx, writeFn := ctx.CacheContext()
+	err := gas.CallWithRecovery("callback", func() error {
+		return hooks.OnOracleResponse(cacheCtx.WithGasMeter(gasMeter), packet)
+	})
+	gasUsed := gasMeter.GasConsumedToLimit()
+	if err != nil {
+		k.emitCallbackFailEvent(ctx, id, callback, gasUsed, err.Error())
+		return
+	}
+
+	writeFn()
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCallback,
+		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyCallbackTarget, callback.Target),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+	))
+}
+
+// emitCallbackFailEvent emits an event of a failed callback of a request.
+func (k Keeper) emitCallbackFailEvent(
+	ctx sdk.Context,
+	id types.RequestID,
+	callback types.RequestCallback,
+	gasUsed uint64,
+	reason string,
+) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCallbackFail,
+		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyCallbackTarget, callback.Target),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
+	))
+}
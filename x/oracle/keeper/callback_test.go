@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"errors"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+var callbackStoreKey = []byte("callback")
+
+// mockCallbackHooks records the responses it receives to the oracle store, so that the tests can check
+// whether the state changes of a callback are kept.
+type mockCallbackHooks struct {
+	storeKey storetypes.StoreKey
+	gas      uint64
+	err      error
+	panics   bool
+}
+
+func (h mockCallbackHooks) OnOracleResponse(ctx sdk.Context, packet types.OracleResponsePacketData) error {
+	ctx.KVStore(h.storeKey).Set(callbackStoreKey, packet.Result)
+	ctx.GasMeter().ConsumeGas(h.gas, "mock callback")
+	if h.panics {
+		panic("mock callback panic")
+	}
+	return h.err
+}
+
+func (suite *KeeperTestSuite) TestAddRequestCallback() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.callbackRouter.AddRoute("consumer", mockCallbackHooks{storeKey: suite.key})
+
+	// the target must be registered
+	err := k.AddRequestCallback(ctx, 1, *types.NewRequestCallback("unknown", 1000))
+	require.ErrorIs(err, types.ErrCallbackTargetNotFound)
+
+	// the gas limit must not exceed the maximum
+	err = k.AddRequestCallback(ctx, 1, *types.NewRequestCallback("consumer", types.DefaultMaxCallbackGasLimit+1))
+	require.ErrorIs(err, types.ErrInvalidCallback)
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	err = k.AddRequestCallback(ctx, 1, *types.NewRequestCallback("consumer", 1000))
+	require.NoError(err)
+	require.GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, uint64(1000))
+
+	callback, found := k.GetRequestCallback(ctx, 1)
+	require.True(found)
+	require.Equal(*types.NewRequestCallback("consumer", 1000), callback)
+}
+
+func (suite *KeeperTestSuite) TestInvokeRequestCallback() {
+	testCases := []struct {
+		name         string
+		hooks        mockCallbackHooks
+		expEventType string
+		expStored    bool
+	}{
+		{"success", mockCallbackHooks{gas: 100}, types.EventTypeCallback, true},
+		{"error", mockCallbackHooks{gas: 100, err: errors.New("mock error")}, types.EventTypeCallbackFail, false},
+		{"out of gas", mockCallbackHooks{gas: 200000}, types.EventTypeCallbackFail, false},
+		{"panic", mockCallbackHooks{panics: true}, types.EventTypeCallbackFail, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+			k := suite.oracleKeeper
+			require := suite.Require()
+
+			tc.hooks.storeKey = suite.key
+			suite.callbackRouter.AddRoute("consumer", tc.hooks)
+			k.SetRequestCallback(ctx, 1, *types.NewRequestCallback("consumer", 100000))
+
+			packet := types.NewOracleResponsePacketData(
+				basicClientID, 1, 2, 1589535020, 1589535022, types.RESOLVE_STATUS_SUCCESS, basicResult,
+			)
+			require.NotPanics(func() { k.InvokeRequestCallback(ctx, 1, packet) })
+
+			events := ctx.EventManager().Events()
+			require.Len(events, 1)
+			require.Equal(tc.expEventType, events[0].Type)
+			require.Equal(tc.expStored, ctx.KVStore(suite.key).Has(callbackStoreKey))
+
+			// the callback is only invoked once
+			_, found := k.GetRequestCallback(ctx, 1)
+			require.False(found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSaveResultInvokesCallback() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.callbackRouter.AddRoute("consumer", mockCallbackHooks{storeKey: suite.key})
+
+	k.SetRequest(ctx, 1, defaultRequest())
+	k.SetRequestCallback(ctx, 1, *types.NewRequestCallback("consumer", 100000))
+	k.SaveResult(ctx, 1, types.RESOLVE_STATUS_SUCCESS, basicResult)
+
+	require.Equal(basicResult, ctx.KVStore(suite.key).Get(callbackStoreKey))
+	require.Equal(types.RESOLVE_STATUS_SUCCESS, k.MustGetResult(ctx, 1).ResolveStatus)
+}
@@ -79,17 +79,18 @@ func addSimpleDataSourceAndOracleScript(ctx sdk.Context, k keeper.Keeper, dir st
 	// Add data source
 	for i := 1; i <= 3; i++ {
 		idxStr := fmt.Sprintf("%d", i)
-		k.SetDataSource(
+		dataSource := types.NewDataSource(
+			owner,
+			"name"+idxStr,
+			"desc"+idxStr,
+			"filename"+idxStr,
+			bandtesting.Coins1band,
+			treasury,
+		)
+		k.SetDataSource(ctx, types.DataSourceID(i), dataSource)
+		k.SetDataSourceVersion(
 			ctx,
-			types.DataSourceID(i),
-			types.NewDataSource(
-				owner,
-				"name"+idxStr,
-				"desc"+idxStr,
-				"filename"+idxStr,
-				bandtesting.Coins1band,
-				treasury,
-			),
+			types.NewDataSourceVersion(types.DataSourceID(i), 1, dataSource, owner.String(), ctx.BlockHeight()),
 		)
 	}
 	fc := filecache.New(dir)
@@ -41,16 +41,19 @@ func (k Keeper) SetDataSource(ctx sdk.Context, id types.DataSourceID, dataSource
 	store.Set(types.DataSourceStoreKey(id), k.cdc.MustMarshal(&dataSource))
 }
 
-// AddDataSource adds the given data source to the storage.
+// AddDataSource adds the given data source to the storage and records it as the first version.
 func (k Keeper) AddDataSource(ctx sdk.Context, dataSource types.DataSource) types.DataSourceID {
 	id := k.GetNextDataSourceID(ctx)
 	k.SetDataSource(ctx, id, dataSource)
+	k.addDataSourceVersion(ctx, id, dataSource, dataSource.Owner)
 	return id
 }
 
-// MustEditDataSource edits the given data source by id and flushes it to the storage.
+// MustEditDataSource edits the given data source by id and flushes it to the storage. The edited data
+// source is recorded as a new version edited by the previous owner.
 func (k Keeper) MustEditDataSource(ctx sdk.Context, id types.DataSourceID, new types.DataSource) {
 	dataSource := k.MustGetDataSource(ctx, id)
+	editor := dataSource.Owner
 	dataSource.Owner = new.Owner
 	dataSource.Name = modify(dataSource.Name, new.Name)
 	dataSource.Description = modify(dataSource.Description, new.Description)
@@ -58,6 +61,7 @@ func (k Keeper) MustEditDataSource(ctx sdk.Context, id types.DataSourceID, new t
 	dataSource.Treasury = new.Treasury
 	dataSource.Fee = new.Fee
 	k.SetDataSource(ctx, id, dataSource)
+	k.addDataSourceVersion(ctx, id, dataSource, editor)
 }
 
 // GetAllDataSources returns the list of all data sources in the store, or nil if there is none.
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
@@ -10,26 +12,42 @@ import (
 type FeeCollector interface {
 	Collect(sdk.Context, sdk.Coins, sdk.AccAddress) error
 	Collected() sdk.Coins
+	TreasuryFees() []types.TreasuryFee
 }
 
 type feeCollector struct {
-	bankKeeper types.BankKeeper
-	payer      sdk.AccAddress
-	collected  sdk.Coins
-	limit      sdk.Coins
+	bankKeeper   types.BankKeeper
+	payer        sdk.AccAddress
+	askCount     uint64
+	collected    sdk.Coins
+	limit        sdk.Coins
+	treasuryFees []types.TreasuryFee
 }
 
-func newFeeCollector(bankKeeper types.BankKeeper, feeLimit sdk.Coins, payer sdk.AccAddress) FeeCollector {
+func newFeeCollector(
+	bankKeeper types.BankKeeper,
+	feeLimit sdk.Coins,
+	payer sdk.AccAddress,
+	askCount uint64,
+) FeeCollector {
 	return &feeCollector{
 		bankKeeper: bankKeeper,
 		payer:      payer,
+		askCount:   askCount,
 		collected:  sdk.NewCoins(),
 		limit:      feeLimit,
 	}
 }
 
-func (coll *feeCollector) Collect(ctx sdk.Context, coins sdk.Coins, treasury sdk.AccAddress) error {
-	coll.collected = coll.collected.Add(coins...)
+// Collect escrows the fee of a data source for all asked validators in the oracle module account. The fee
+// for each report is released to the treasury of the data source when a requested validator reports.
+func (coll *feeCollector) Collect(ctx sdk.Context, fee sdk.Coins, treasury sdk.AccAddress) error {
+	total := sdk.NewCoins()
+	for _, c := range fee {
+		c.Amount = c.Amount.Mul(math.NewIntFromUint64(coll.askCount))
+		total = total.Add(c)
+	}
+	coll.collected = coll.collected.Add(total...)
 
 	// If found any collected coin that exceed limit then return error
 	for _, c := range coll.collected {
@@ -44,10 +62,28 @@ func (coll *feeCollector) Collect(ctx sdk.Context, coins sdk.Coins, treasury sdk
 		}
 	}
 
+	coll.addTreasuryFee(treasury, fee)
+
 	// Actual send coins
-	return coll.bankKeeper.SendCoins(ctx, coll.payer, treasury, coins)
+	return coll.bankKeeper.SendCoinsFromAccountToModule(ctx, coll.payer, types.ModuleName, total)
+}
+
+// addTreasuryFee adds the fee for each report to the treasury, merging the fees of data sources that
+// share the same treasury.
+func (coll *feeCollector) addTreasuryFee(treasury sdk.AccAddress, fee sdk.Coins) {
+	for i, tf := range coll.treasuryFees {
+		if tf.Treasury == treasury.String() {
+			coll.treasuryFees[i].Fee = tf.Fee.Add(fee...)
+			return
+		}
+	}
+	coll.treasuryFees = append(coll.treasuryFees, types.NewTreasuryFee(treasury, fee))
 }
 
 func (coll *feeCollector) Collected() sdk.Coins {
 	return coll.collected
 }
+
+func (coll *feeCollector) TreasuryFees() []types.TreasuryFee {
+	return coll.treasuryFees
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// SetFeeEscrow saves the escrowed data source fees of a request to the store.
+func (k Keeper) SetFeeEscrow(ctx sdk.Context, id types.RequestID, escrow types.FeeEscrow) {
+	ctx.KVStore(k.storeKey).Set(types.FeeEscrowStoreKey(id), k.cdc.MustMarshal(&escrow))
+}
+
+// GetFeeEscrow returns the escrowed data source fees of a request, if any.
+func (k Keeper) GetFeeEscrow(ctx sdk.Context, id types.RequestID) (types.FeeEscrow, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.FeeEscrowStoreKey(id))
+	if bz == nil {
+		return types.FeeEscrow{}, false
+	}
+	var escrow types.FeeEscrow
+	k.cdc.MustUnmarshal(bz, &escrow)
+	return escrow, true
+}
+
+// DeleteFeeEscrow removes the escrowed data source fees of a request from the store.
+func (k Keeper) DeleteFeeEscrow(ctx sdk.Context, id types.RequestID) {
+	ctx.KVStore(k.storeKey).Delete(types.FeeEscrowStoreKey(id))
+}
+
+// ReleaseReportFee pays the data source treasuries their fees for the report of the given validator from
+// the escrow of the request.
+func (k Keeper) ReleaseReportFee(ctx sdk.Context, id types.RequestID, val sdk.ValAddress) error {
+	escrow, found := k.GetFeeEscrow(ctx, id)
+	if !found {
+		return nil
+	}
+
+	fee := escrow.FeePerReport()
+	if !escrow.Remaining().IsAllGTE(fee) {
+		// this should not happen as each requested validator can report only once
+		return nil
+	}
+
+	// The fees are sent from the module address directly, so that any account can be a treasury, as it was
+	// when the fees were sent from the payer.
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	for _, tf := range escrow.TreasuryFees {
+		treasury, err := sdk.AccAddressFromBech32(tf.Treasury)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoins(ctx, moduleAddr, treasury, tf.Fee); err != nil {
+			return err
+		}
+	}
+
+	escrow.Released = escrow.Released.Add(fee...)
+	k.SetFeeEscrow(ctx, id, escrow)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeReleaseFee,
+		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyValidator, val.String()),
+		sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+	))
+	return nil
+}
+
+// RefundFeeEscrow refunds the fees of the validators that have not reported to the payer and closes the
+// escrow of the request. It emits a receipt with the breakdown of the escrowed fees.
+func (k Keeper) RefundFeeEscrow(ctx sdk.Context, id types.RequestID) {
+	escrow, found := k.GetFeeEscrow(ctx, id)
+	if !found {
+		return
+	}
+
+	refund := escrow.Remaining()
+	if !refund.IsZero() {
+		payer, err := sdk.AccAddressFromBech32(escrow.Payer)
+		if err != nil {
+			panic(err)
+		}
+		moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+		if err := k.bankKeeper.SendCoins(ctx, moduleAddr, payer, refund); err != nil {
+			// this should not happen as the module account holds all escrowed fees
+			k.Logger(ctx).Error(fmt.Sprintf("failed to refund fees of request %d: %s", id, err))
+			return
+		}
+	}
+	k.DeleteFeeEscrow(ctx, id)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFeeReceipt,
+		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyPayer, escrow.Payer),
+		sdk.NewAttribute(types.AttributeKeyTotalFees, escrow.Total.String()),
+		sdk.NewAttribute(types.AttributeKeyReleasedFees, escrow.Released.String()),
+		sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+	))
+}
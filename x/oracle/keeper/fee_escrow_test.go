@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+var oracleModuleAddress = authtypes.NewModuleAddress(types.ModuleName)
+
+func (suite *KeeperTestSuite) TestPrepareRequestSetFeeEscrow() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx.WithBlockTime(bandtesting.ParseTime(1581589790)).WithBlockHeight(42)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+
+	msg := types.NewMsgRequestData(
+		1, basicCalldata, 2, 1, basicClientID, bandtesting.Coins100band,
+		testDefaultPrepareGas, testDefaultExecuteGas, alice, 0,
+	)
+
+	suite.rollingseedKeeper.EXPECT().
+		GetRollingSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY"))
+	suite.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), alice, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uband", 2000000))).
+		Times(3)
+
+	id, err := k.PrepareRequest(ctx, msg, alice, nil)
+	require.NoError(err)
+
+	escrow, found := k.GetFeeEscrow(ctx, id)
+	require.True(found)
+	require.Equal(types.NewFeeEscrow(
+		alice,
+		[]types.TreasuryFee{types.NewTreasuryFee(treasury, sdk.NewCoins(sdk.NewInt64Coin("uband", 3000000)))},
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 6000000)),
+	), escrow)
+}
+
+func (suite *KeeperTestSuite) TestReleaseReportFee() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	validator := sdk.ValAddress([]byte("validator___________"))
+	escrow := types.NewFeeEscrow(
+		alice,
+		[]types.TreasuryFee{
+			types.NewTreasuryFee(treasury, bandtesting.Coins1band),
+			types.NewTreasuryFee(bob, sdk.NewCoins(sdk.NewInt64Coin("uband", 2000000))),
+		},
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 6000000)),
+	)
+	k.SetFeeEscrow(ctx, 1, escrow)
+
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), oracleModuleAddress, treasury, bandtesting.Coins1band)
+	suite.bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), oracleModuleAddress, bob, sdk.NewCoins(sdk.NewInt64Coin("uband", 2000000)))
+
+	err := k.ReleaseReportFee(ctx, 1, validator)
+	require.NoError(err)
+
+	escrow, found := k.GetFeeEscrow(ctx, 1)
+	require.True(found)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("uband", 3000000)), escrow.Released)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("uband", 3000000)), escrow.Remaining())
+
+	event := ctx.EventManager().Events().ToABCIEvents()[0]
+	require.Equal(abci.Event{
+		Type: types.EventTypeReleaseFee,
+		Attributes: []abci.EventAttribute{
+			{Key: types.AttributeKeyID, Value: "1"},
+			{Key: types.AttributeKeyValidator, Value: validator.String()},
+			{Key: types.AttributeKeyFee, Value: "3000000uband"},
+		},
+	}, event)
+
+	// the fee is not released again once the escrow is exhausted
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), oracleModuleAddress, treasury, bandtesting.Coins1band)
+	suite.bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), oracleModuleAddress, bob, sdk.NewCoins(sdk.NewInt64Coin("uband", 2000000)))
+	require.NoError(k.ReleaseReportFee(ctx, 1, validator))
+	require.NoError(k.ReleaseReportFee(ctx, 1, validator))
+
+	escrow, _ = k.GetFeeEscrow(ctx, 1)
+	require.True(escrow.Remaining().IsZero())
+
+	// requests without fees have no escrow
+	require.NoError(k.ReleaseReportFee(ctx, 2, validator))
+}
+
+func (suite *KeeperTestSuite) TestRefundFeeEscrow() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	escrow := types.NewFeeEscrow(
+		alice,
+		[]types.TreasuryFee{types.NewTreasuryFee(treasury, bandtesting.Coins1band)},
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 3000000)),
+	)
+	escrow.Released = bandtesting.Coins1band
+	k.SetFeeEscrow(ctx, 1, escrow)
+
+	suite.bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), oracleModuleAddress, alice, sdk.NewCoins(sdk.NewInt64Coin("uband", 2000000)))
+
+	k.RefundFeeEscrow(ctx, 1)
+
+	_, found := k.GetFeeEscrow(ctx, 1)
+	require.False(found)
+
+	event := ctx.EventManager().Events().ToABCIEvents()[0]
+	require.Equal(abci.Event{
+		Type: types.EventTypeFeeReceipt,
+		Attributes: []abci.EventAttribute{
+			{Key: types.AttributeKeyID, Value: "1"},
+			{Key: types.AttributeKeyPayer, Value: alice.String()},
+			{Key: types.AttributeKeyTotalFees, Value: "3000000uband"},
+			{Key: types.AttributeKeyReleasedFees, Value: "1000000uband"},
+			{Key: types.AttributeKeyRefund, Value: "2000000uband"},
+		},
+	}, event)
+}
+
+func (suite *KeeperTestSuite) TestResolveExpiredRefundFeeEscrow() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.SetRequest(ctx, 1, defaultRequest())
+	k.SetFeeEscrow(ctx, 1, types.NewFeeEscrow(
+		alice,
+		[]types.TreasuryFee{types.NewTreasuryFee(treasury, bandtesting.Coins1band)},
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 2000000)),
+	))
+
+	suite.bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), oracleModuleAddress, alice, sdk.NewCoins(sdk.NewInt64Coin("uband", 2000000)))
+
+	k.ResolveExpired(ctx, 1)
+
+	_, found := k.GetFeeEscrow(ctx, 1)
+	require.False(found)
+}
+
+func (suite *KeeperTestSuite) TestResolveFailureKeepsFeeEscrowUntilExpired() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	// three validators were asked, and the first one has reported and been paid
+	escrow := types.NewFeeEscrow(
+		alice,
+		[]types.TreasuryFee{types.NewTreasuryFee(treasury, bandtesting.Coins1band)},
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 3000000)),
+	)
+	escrow.Released = bandtesting.Coins1band
+	request := defaultRequest()
+	request.RequestedValidators = append(request.RequestedValidators, validators[2].Address.String())
+	k.SetRequest(ctx, 1, request)
+	k.SetFeeEscrow(ctx, 1, escrow)
+
+	// nothing is refunded when the request fails
+	k.ResolveFailure(ctx, 1, "failed")
+
+	escrow, found := k.GetFeeEscrow(ctx, 1)
+	require.True(found)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("uband", 2000000)), escrow.Remaining())
+
+	// a validator that reports after the failure is still paid
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), oracleModuleAddress, treasury, bandtesting.Coins1band)
+	require.NoError(k.ReleaseReportFee(ctx, 1, validators[1].Address))
+
+	// only the fee of the validator that never reported is refunded when the request expires
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), oracleModuleAddress, alice, bandtesting.Coins1band)
+	k.RefundFeeEscrow(ctx, 1)
+
+	_, found = k.GetFeeEscrow(ctx, 1)
+	require.False(found)
+}
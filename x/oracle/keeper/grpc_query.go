@@ -10,7 +10,10 @@ import (
 
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 
+	"cosmossdk.io/store/prefix"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -220,6 +223,173 @@ func (k Querier) Validator(
 	return &types.QueryValidatorResponse{Status: &status}, nil
 }
 
+// ValidatorReportInfo queries the report window stats of a validator.
+func (k Querier) ValidatorReportInfo(
+	c context.Context,
+	req *types.QueryValidatorReportInfoRequest,
+) (*types.QueryValidatorReportInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	val, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryValidatorReportInfoResponse{ReportInfo: k.GetValidatorReportInfo(ctx, val)}, nil
+}
+
+// ValidatorReportInfos queries the report window stats of all validators.
+func (k Querier) ValidatorReportInfos(
+	c context.Context,
+	req *types.QueryValidatorReportInfosRequest,
+) (*types.QueryValidatorReportInfosResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var infos []types.ValidatorReportInfo
+	infoStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorReportInfoKeyPrefix)
+
+	pageRes, err := query.Paginate(infoStore, req.Pagination, func(key []byte, value []byte) error {
+		var info types.ValidatorReportInfo
+		if err := k.cdc.Unmarshal(value, &info); err != nil {
+			return err
+		}
+
+		infos = append(infos, info)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorReportInfosResponse{ReportInfos: infos, Pagination: pageRes}, nil
+}
+
+// RequestSubscription queries a request subscription and the remaining deposit in its fee payer account.
+func (k Querier) RequestSubscription(
+	c context.Context,
+	req *types.QueryRequestSubscriptionRequest,
+) (*types.QueryRequestSubscriptionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sub, err := k.GetRequestSubscription(ctx, req.SubscriptionId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	feePayer, err := sdk.AccAddressFromBech32(sub.FeePayer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRequestSubscriptionResponse{
+		Subscription: sub,
+		Balance:      k.bankKeeper.GetAllBalances(ctx, feePayer),
+	}, nil
+}
+
+// RequestSubscriptions queries all request subscriptions.
+func (k Querier) RequestSubscriptions(
+	c context.Context,
+	req *types.QueryRequestSubscriptionsRequest,
+) (*types.QueryRequestSubscriptionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var subs []types.RequestSubscription
+	subStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RequestSubscriptionStoreKeyPrefix)
+
+	pageRes, err := query.Paginate(subStore, req.Pagination, func(key []byte, value []byte) error {
+		var sub types.RequestSubscription
+		if err := k.cdc.Unmarshal(value, &sub); err != nil {
+			return err
+		}
+
+		subs = append(subs, sub)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRequestSubscriptionsResponse{Subscriptions: subs, Pagination: pageRes}, nil
+}
+
+// DataSourceVersions queries the version history of a data source.
+func (k Querier) DataSourceVersions(
+	c context.Context,
+	req *types.QueryDataSourceVersionsRequest,
+) (*types.QueryDataSourceVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	id := types.DataSourceID(req.DataSourceId)
+	if !k.HasDataSource(ctx, id) {
+		return nil, types.ErrDataSourceNotFound.Wrapf("id: %d", id)
+	}
+
+	var versions []types.DataSourceVersion
+	versionStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DataSourceVersionsPrefixKey(id))
+
+	pageRes, err := query.Paginate(versionStore, req.Pagination, func(key []byte, value []byte) error {
+		var version types.DataSourceVersion
+		if err := k.cdc.Unmarshal(value, &version); err != nil {
+			return err
+		}
+
+		versions = append(versions, version)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDataSourceVersionsResponse{Versions: versions, Pagination: pageRes}, nil
+}
+
+// OracleScriptVersions queries the version history of an oracle script.
+func (k Querier) OracleScriptVersions(
+	c context.Context,
+	req *types.QueryOracleScriptVersionsRequest,
+) (*types.QueryOracleScriptVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	id := types.OracleScriptID(req.OracleScriptId)
+	if !k.HasOracleScript(ctx, id) {
+		return nil, types.ErrOracleScriptNotFound.Wrapf("id: %d", id)
+	}
+
+	var versions []types.OracleScriptVersion
+	versionStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OracleScriptVersionsPrefixKey(id))
+
+	pageRes, err := query.Paginate(versionStore, req.Pagination, func(key []byte, value []byte) error {
+		var version types.OracleScriptVersion
+		if err := k.cdc.Unmarshal(value, &version); err != nil {
+			return err
+		}
+
+		versions = append(versions, version)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOracleScriptVersionsResponse{Versions: versions, Pagination: pageRes}, nil
+}
+
 // IsReporter queries grant of account on this validator
 func (k Querier) IsReporter(
 	c context.Context,
@@ -315,7 +485,33 @@ func (k Querier) RequestSearch(
 	c context.Context,
 	req *types.QueryRequestSearchRequest,
 ) (*types.QueryRequestSearchResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "This feature can be taken from extra/rest branch")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	calldata, err := hex.DecodeString(req.Calldata)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unable to decode calldata: %s", err))
+	}
+
+	rid, found := k.GetLatestResultRequestID(
+		ctx,
+		types.OracleScriptID(req.OracleScriptId),
+		calldata,
+		req.AskCount,
+		req.MinCount,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "no successful request matches the given input")
+	}
+
+	request, err := k.Request(c, &types.QueryRequestRequest{RequestId: uint64(rid)})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRequestSearchResponse{Request: request}, nil
 }
 
 // RequestPrice queries the latest price on standard price reference oracle
@@ -324,7 +520,25 @@ func (k Querier) RequestPrice(
 	c context.Context,
 	req *types.QueryRequestPriceRequest,
 ) (*types.QueryRequestPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "This feature can be taken from extra/rest branch")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if len(req.Symbols) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "symbols cannot be empty")
+	}
+
+	priceResults := make([]*types.PriceResult, 0, len(req.Symbols))
+	for _, symbol := range req.Symbols {
+		priceResult, err := k.GetLatestPrice(ctx, symbol, req.AskCount, req.MinCount)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		priceResults = append(priceResults, &priceResult)
+	}
+
+	return &types.QueryRequestPriceResponse{PriceResults: priceResults}, nil
 }
 
 // RequestVerification verifies oracle request for validation before executing data sources
@@ -722,3 +722,36 @@ func (suite *KeeperTestSuite) TestPendingRequestsSuccess() {
 	require.Equal(&types.QueryPendingRequestsResponse{RequestIDs: []uint64{3}}, r)
 	require.NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestQueryValidatorReportInfos() {
+	ctx := suite.ctx
+	querier := suite.queryClient
+	require := suite.Require()
+	k := suite.oracleKeeper
+
+	now := time.Now().UTC()
+	next := now.Add(time.Duration(10))
+	ctx = ctx.WithBlockTime(next)
+	k.SetValidatorStatus(ctx, validators[0].Address, types.NewValidatorStatus(true, now))
+	k.SetValidatorStatus(ctx, validators[1].Address, types.NewValidatorStatus(true, now))
+	k.FulfillReport(ctx, validators[0].Address, next)
+	k.FulfillReport(ctx, validators[1].Address, next)
+
+	res, err := querier.ValidatorReportInfo(context.Background(), &types.QueryValidatorReportInfoRequest{
+		ValidatorAddress: validators[0].Address.String(),
+	})
+	require.NoError(err)
+	require.Equal(
+		types.NewValidatorReportInfo(validators[0].Address.String(), types.DefaultReportWindowSize, 1, 0, 1, 0),
+		res.ReportInfo,
+	)
+
+	_, err = querier.ValidatorReportInfo(context.Background(), &types.QueryValidatorReportInfoRequest{
+		ValidatorAddress: "invalid",
+	})
+	require.Error(err)
+
+	resAll, err := querier.ValidatorReportInfos(context.Background(), &types.QueryValidatorReportInfosRequest{})
+	require.NoError(err)
+	require.Len(resAll.ReportInfos, 2)
+}
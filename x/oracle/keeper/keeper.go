@@ -40,6 +40,7 @@ type Keeper struct {
 	rollingseedKepper types.RollingseedKeeper
 	bandtssKeeper     types.BandtssKeeper
 	scopedKeeper      capabilitykeeper.ScopedKeeper
+	callbackRouter    *types.CallbackRouter
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -62,6 +63,7 @@ func NewKeeper(
 	rollingseedKepper types.RollingseedKeeper,
 	bandtssKeeper types.BandtssKeeper,
 	scopeKeeper capabilitykeeper.ScopedKeeper,
+	callbackRouter *types.CallbackRouter,
 	owasmVM *owasm.Vm,
 	authority string,
 ) Keeper {
@@ -81,6 +83,7 @@ func NewKeeper(
 		rollingseedKepper: rollingseedKepper,
 		bandtssKeeper:     bandtssKeeper,
 		scopedKeeper:      scopeKeeper,
+		callbackRouter:    callbackRouter,
 		authority:         authority,
 	}
 }
@@ -90,6 +93,11 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// GetOracleAccount returns the oracle ModuleAccount that holds escrowed data source fees.
+func (k Keeper) GetOracleAccount(ctx sdk.Context) sdk.ModuleAccountI {
+	return k.authKeeper.GetModuleAccount(ctx, types.ModuleName)
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -44,6 +44,7 @@ type KeeperTestSuite struct {
 	authzKeeper       *oracletestutil.MockAuthzKeeper
 	rollingseedKeeper *oracletestutil.MockRollingseedKeeper
 	bandtssKeeper     *oracletestutil.MockBandtssKeeper
+	callbackRouter    *types.CallbackRouter
 
 	key         storetypes.StoreKey
 	queryClient types.QueryClient
@@ -75,6 +76,8 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.rollingseedKeeper = oracletestutil.NewMockRollingseedKeeper(ctrl)
 	suite.bandtssKeeper = oracletestutil.NewMockBandtssKeeper(ctrl)
 
+	suite.callbackRouter = types.NewCallbackRouter()
+
 	suite.key = key
 	suite.homeDir = testutil.GetTempDir(suite.T())
 	suite.fileDir = filepath.Join(suite.homeDir, "files")
@@ -98,6 +101,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 		suite.rollingseedKeeper,
 		suite.bandtssKeeper,
 		capabilitykeeper.ScopedKeeper{},
+		suite.callbackRouter,
 		owasmVM,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
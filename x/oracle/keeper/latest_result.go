@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// latestIndex is a bounded index from a key to the ID of the latest request with that key. The entries are
+// also kept in a queue ordered by request ID, so that the entries of the oldest requests are evicted first.
+type latestIndex struct {
+	storePrefix []byte
+	queuePrefix []byte
+	countKey    []byte
+}
+
+var (
+	latestResultIndex = latestIndex{
+		storePrefix: types.LatestResultStoreKeyPrefix,
+		queuePrefix: types.LatestResultQueueStoreKeyPrefix,
+		countKey:    types.LatestResultCountStoreKey,
+	}
+	latestPriceIndex = latestIndex{
+		storePrefix: types.LatestPriceStoreKeyPrefix,
+		queuePrefix: types.LatestPriceQueueStoreKeyPrefix,
+		countKey:    types.LatestPriceCountStoreKey,
+	}
+)
+
+// storeKey returns the store key of the given index key.
+func (idx latestIndex) storeKey(indexKey []byte) []byte {
+	return append(append([]byte{}, idx.storePrefix...), indexKey...)
+}
+
+// get returns the request ID of the given index key.
+func (idx latestIndex) get(store storetypes.KVStore, indexKey []byte) (types.RequestID, bool) {
+	bz := store.Get(idx.storeKey(indexKey))
+	if bz == nil {
+		return 0, false
+	}
+	return types.RequestID(sdk.BigEndianToUint64(bz)), true
+}
+
+// getCount returns the number of entries in the index.
+func (idx latestIndex) getCount(store storetypes.KVStore) uint64 {
+	return sdk.BigEndianToUint64(store.Get(idx.countKey))
+}
+
+// set points the given index key at the request ID unless it already points at a newer request, then evicts
+// the entries of the oldest requests until the index has at most maxSize entries.
+func (idx latestIndex) set(store storetypes.KVStore, indexKey []byte, id types.RequestID, maxSize uint64) {
+	count := idx.getCount(store)
+	if prevID, found := idx.get(store, indexKey); found {
+		if prevID >= id {
+			return
+		}
+		store.Delete(types.LatestIndexQueueKey(idx.queuePrefix, prevID, indexKey))
+	} else {
+		count++
+	}
+
+	store.Set(idx.storeKey(indexKey), sdk.Uint64ToBigEndian(uint64(id)))
+	store.Set(types.LatestIndexQueueKey(idx.queuePrefix, id, indexKey), []byte{0x01})
+	store.Set(idx.countKey, sdk.Uint64ToBigEndian(count))
+
+	idx.prune(store, maxSize)
+}
+
+// prune evicts the entries of the oldest requests until the index has at most maxSize entries.
+func (idx latestIndex) prune(store storetypes.KVStore, maxSize uint64) {
+	count := idx.getCount(store)
+	if count <= maxSize {
+		return
+	}
+
+	iterator := storetypes.KVStorePrefixIterator(store, idx.queuePrefix)
+	defer iterator.Close()
+
+	for ; count > maxSize && iterator.Valid(); iterator.Next() {
+		// the queue key is the queue prefix, the request ID and the index key
+		evictedKey := iterator.Key()[len(idx.queuePrefix)+8:]
+		store.Delete(idx.storeKey(evictedKey))
+		store.Delete(iterator.Key())
+		count--
+	}
+
+	store.Set(idx.countKey, sdk.Uint64ToBigEndian(count))
+}
+
+// IndexLatestResult points the latest result index at the given successful result. If the result is of
+// the standard price reference oracle script, the latest price index of each of its symbols is also
+// updated. Nothing is indexed if the maximum index size is zero.
+func (k Keeper) IndexLatestResult(ctx sdk.Context, result types.Result) {
+	params := k.GetParams(ctx)
+	if params.MaxLatestResultIndexSize == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	latestResultIndex.set(
+		store,
+		types.LatestResultIndexKey(result.OracleScriptID, result.Calldata, result.AskCount, result.MinCount),
+		result.RequestID,
+		params.MaxLatestResultIndexSize,
+	)
+
+	if params.PriceOracleScriptID == 0 || result.OracleScriptID != params.PriceOracleScriptID {
+		return
+	}
+
+	// skip the results that do not follow the standard price schema
+	priceResults, err := types.DecodePriceResults(result)
+	if err != nil {
+		return
+	}
+
+	for _, priceResult := range priceResults {
+		latestPriceIndex.set(
+			store,
+			types.LatestPriceIndexKey(priceResult.Symbol, result.AskCount, result.MinCount),
+			result.RequestID,
+			params.MaxLatestResultIndexSize,
+		)
+	}
+}
+
+// PruneLatestResultIndexes evicts the entries of the oldest requests from the latest result and latest
+// price indexes until they fit in the maximum index size, which may have been lowered since the entries
+// were indexed. The indexes are emptied if the maximum index size is zero.
+func (k Keeper) PruneLatestResultIndexes(ctx sdk.Context) {
+	maxSize := k.GetParams(ctx).MaxLatestResultIndexSize
+	store := ctx.KVStore(k.storeKey)
+	latestResultIndex.prune(store, maxSize)
+	latestPriceIndex.prune(store, maxSize)
+}
+
+// GetLatestResultRequestID returns the ID of the latest request with a successful result for the given
+// oracle script ID, calldata, ask count and min count.
+func (k Keeper) GetLatestResultRequestID(
+	ctx sdk.Context,
+	oracleScriptID types.OracleScriptID,
+	calldata []byte,
+	askCount, minCount uint64,
+) (types.RequestID, bool) {
+	return latestResultIndex.get(
+		ctx.KVStore(k.storeKey),
+		types.LatestResultIndexKey(oracleScriptID, calldata, askCount, minCount),
+	)
+}
+
+// GetLatestPrice returns the latest price of the given symbol, ask count and min count from the results of
+// the standard price reference oracle script.
+func (k Keeper) GetLatestPrice(
+	ctx sdk.Context,
+	symbol string,
+	askCount, minCount uint64,
+) (types.PriceResult, error) {
+	id, found := latestPriceIndex.get(ctx.KVStore(k.storeKey), types.LatestPriceIndexKey(symbol, askCount, minCount))
+	if !found {
+		return types.PriceResult{}, types.ErrPriceNotFound.Wrapf(
+			"symbol: %s, ask count: %d, min count: %d",
+			symbol,
+			askCount,
+			minCount,
+		)
+	}
+
+	result, err := k.GetResult(ctx, id)
+	if err != nil {
+		return types.PriceResult{}, err
+	}
+
+	priceResults, err := types.DecodePriceResults(result)
+	if err != nil {
+		return types.PriceResult{}, err
+	}
+
+	for _, priceResult := range priceResults {
+		if priceResult.Symbol == symbol {
+			return priceResult, nil
+		}
+	}
+
+	return types.PriceResult{}, types.ErrPriceNotFound.Wrapf("symbol: %s, request id: %d", symbol, id)
+}
@@ -0,0 +1,281 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/bandprotocol/chain/v3/pkg/obi"
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/x/oracle/keeper"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+var (
+	priceCalldata = obi.MustEncode(types.PriceCalldata{Symbols: []string{"BTC", "ETH"}, Multiplier: 1000000000})
+	priceResult   = obi.MustEncode(types.PriceOutput{Rates: []uint64{60000000000000, 3000000000000}})
+)
+
+func (suite *KeeperTestSuite) setLatestResultIndexParams(maxSize uint64, priceOracleScriptID types.OracleScriptID) {
+	params := suite.oracleKeeper.GetParams(suite.ctx)
+	params.MaxLatestResultIndexSize = maxSize
+	params.PriceOracleScriptID = priceOracleScriptID
+	suite.Require().NoError(suite.oracleKeeper.SetParams(suite.ctx, params))
+}
+
+func newTestResult(id types.RequestID, oracleScriptID types.OracleScriptID, calldata []byte, result []byte) types.Result {
+	return types.NewResult(
+		basicClientID, oracleScriptID, calldata, 2, 2, id, 2, bandtesting.ParseTime(0).Unix(),
+		bandtesting.ParseTime(int64(id)).Unix(), types.RESOLVE_STATUS_SUCCESS, result,
+	)
+}
+
+func (suite *KeeperTestSuite) TestIndexLatestResult() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.setLatestResultIndexParams(10, 0)
+
+	k.IndexLatestResult(ctx, newTestResult(2, 1, basicCalldata, basicResult))
+	id, found := k.GetLatestResultRequestID(ctx, 1, basicCalldata, 2, 2)
+	require.True(found)
+	require.Equal(types.RequestID(2), id)
+
+	// an older request does not replace a newer one
+	k.IndexLatestResult(ctx, newTestResult(1, 1, basicCalldata, basicResult))
+	id, found = k.GetLatestResultRequestID(ctx, 1, basicCalldata, 2, 2)
+	require.True(found)
+	require.Equal(types.RequestID(2), id)
+
+	// a newer request replaces an older one
+	k.IndexLatestResult(ctx, newTestResult(3, 1, basicCalldata, basicResult))
+	id, found = k.GetLatestResultRequestID(ctx, 1, basicCalldata, 2, 2)
+	require.True(found)
+	require.Equal(types.RequestID(3), id)
+
+	// other inputs are indexed separately
+	_, found = k.GetLatestResultRequestID(ctx, 1, basicCalldata, 3, 2)
+	require.False(found)
+	_, found = k.GetLatestResultRequestID(ctx, 2, basicCalldata, 2, 2)
+	require.False(found)
+}
+
+func (suite *KeeperTestSuite) TestIndexLatestResultEviction() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.setLatestResultIndexParams(2, 0)
+
+	k.IndexLatestResult(ctx, newTestResult(1, 1, []byte("calldata_1"), basicResult))
+	k.IndexLatestResult(ctx, newTestResult(2, 1, []byte("calldata_2"), basicResult))
+	// refreshing the first entry makes the second entry the oldest one
+	k.IndexLatestResult(ctx, newTestResult(3, 1, []byte("calldata_1"), basicResult))
+	k.IndexLatestResult(ctx, newTestResult(4, 1, []byte("calldata_3"), basicResult))
+
+	id, found := k.GetLatestResultRequestID(ctx, 1, []byte("calldata_1"), 2, 2)
+	require.True(found)
+	require.Equal(types.RequestID(3), id)
+	_, found = k.GetLatestResultRequestID(ctx, 1, []byte("calldata_2"), 2, 2)
+	require.False(found)
+	id, found = k.GetLatestResultRequestID(ctx, 1, []byte("calldata_3"), 2, 2)
+	require.True(found)
+	require.Equal(types.RequestID(4), id)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParamsPrunesLatestResultIndexes() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	suite.setLatestResultIndexParams(10, 1)
+
+	for i := 1; i <= 3; i++ {
+		k.IndexLatestResult(ctx, newTestResult(types.RequestID(i), 2, []byte(fmt.Sprintf("calldata_%d", i)), basicResult))
+	}
+	k.IndexLatestResult(ctx, newTestResult(4, 1, priceCalldata, priceResult))
+
+	// shrinking the cap evicts the entries of the oldest requests
+	params := k.GetParams(ctx)
+	params.MaxLatestResultIndexSize = 2
+	_, err := msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(k.GetAuthority(), params))
+	require.NoError(err)
+
+	for i := 1; i <= 2; i++ {
+		_, found := k.GetLatestResultRequestID(ctx, 2, []byte(fmt.Sprintf("calldata_%d", i)), 2, 2)
+		require.False(found)
+	}
+	id, found := k.GetLatestResultRequestID(ctx, 2, []byte("calldata_3"), 2, 2)
+	require.True(found)
+	require.Equal(types.RequestID(3), id)
+	id, found = k.GetLatestResultRequestID(ctx, 1, priceCalldata, 2, 2)
+	require.True(found)
+	require.Equal(types.RequestID(4), id)
+
+	// the price index of two symbols still fits in the cap
+	k.SetResult(ctx, 4, newTestResult(4, 1, priceCalldata, priceResult))
+	_, err = k.GetLatestPrice(ctx, "BTC", 2, 2)
+	require.NoError(err)
+
+	// the entries evicted by the new cap leave room for new entries
+	k.IndexLatestResult(ctx, newTestResult(5, 2, []byte("calldata_5"), basicResult))
+	_, found = k.GetLatestResultRequestID(ctx, 2, []byte("calldata_3"), 2, 2)
+	require.False(found)
+	_, found = k.GetLatestResultRequestID(ctx, 2, []byte("calldata_5"), 2, 2)
+	require.True(found)
+
+	// a cap of zero empties the indexes
+	params.MaxLatestResultIndexSize = 0
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(k.GetAuthority(), params))
+	require.NoError(err)
+
+	_, found = k.GetLatestResultRequestID(ctx, 2, []byte("calldata_5"), 2, 2)
+	require.False(found)
+	_, found = k.GetLatestResultRequestID(ctx, 1, priceCalldata, 2, 2)
+	require.False(found)
+	_, err = k.GetLatestPrice(ctx, "BTC", 2, 2)
+	require.ErrorIs(err, types.ErrPriceNotFound)
+}
+
+func (suite *KeeperTestSuite) TestIndexLatestResultDisabled() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.setLatestResultIndexParams(0, 1)
+
+	k.IndexLatestResult(ctx, newTestResult(1, 1, priceCalldata, priceResult))
+	_, found := k.GetLatestResultRequestID(ctx, 1, priceCalldata, 2, 2)
+	require.False(found)
+	_, err := k.GetLatestPrice(ctx, "BTC", 2, 2)
+	require.ErrorIs(err, types.ErrPriceNotFound)
+}
+
+func (suite *KeeperTestSuite) TestGetLatestPrice() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.setLatestResultIndexParams(10, 1)
+
+	result := newTestResult(1, 1, priceCalldata, priceResult)
+	k.SetResult(ctx, 1, result)
+	k.IndexLatestResult(ctx, result)
+
+	price, err := k.GetLatestPrice(ctx, "ETH", 2, 2)
+	require.NoError(err)
+	require.Equal(types.PriceResult{
+		Symbol:      "ETH",
+		Multiplier:  1000000000,
+		Px:          3000000000000,
+		RequestID:   1,
+		ResolveTime: bandtesting.ParseTime(1).Unix(),
+	}, price)
+
+	_, err = k.GetLatestPrice(ctx, "BAND", 2, 2)
+	require.ErrorIs(err, types.ErrPriceNotFound)
+	_, err = k.GetLatestPrice(ctx, "ETH", 3, 2)
+	require.ErrorIs(err, types.ErrPriceNotFound)
+
+	// results of other oracle scripts are not indexed as prices
+	result = newTestResult(2, 2, obi.MustEncode(types.PriceCalldata{Symbols: []string{"BAND"}}), basicResult)
+	k.SetResult(ctx, 2, result)
+	k.IndexLatestResult(ctx, result)
+	_, err = k.GetLatestPrice(ctx, "BAND", 2, 2)
+	require.ErrorIs(err, types.ErrPriceNotFound)
+}
+
+func (suite *KeeperTestSuite) TestSaveResultIndexesSuccessOnly() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.setLatestResultIndexParams(10, 0)
+
+	k.SetRequest(ctx, 1, defaultRequest())
+	k.SaveResult(ctx, 1, types.RESOLVE_STATUS_FAILURE, nil)
+	_, found := k.GetLatestResultRequestID(ctx, 1, basicCalldata, 2, 2)
+	require.False(found)
+
+	k.SetRequest(ctx, 2, defaultRequest())
+	k.SaveResult(ctx, 2, types.RESOLVE_STATUS_SUCCESS, basicResult)
+	id, found := k.GetLatestResultRequestID(ctx, 1, basicCalldata, 2, 2)
+	require.True(found)
+	require.Equal(types.RequestID(2), id)
+}
+
+func (suite *KeeperTestSuite) TestQueryRequestSearch() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	querier := suite.queryClient
+	require := suite.Require()
+
+	suite.setLatestResultIndexParams(10, 0)
+
+	k.SetRequest(ctx, 1, defaultRequest())
+	k.SaveResult(ctx, 1, types.RESOLVE_STATUS_SUCCESS, basicResult)
+
+	res, err := querier.RequestSearch(context.Background(), &types.QueryRequestSearchRequest{
+		OracleScriptId: 1,
+		Calldata:       hex.EncodeToString(basicCalldata),
+		AskCount:       2,
+		MinCount:       2,
+	})
+	require.NoError(err)
+	require.Equal(types.RequestID(1), res.Request.Result.RequestID)
+	require.Equal(basicResult, res.Request.Result.Result)
+
+	_, err = querier.RequestSearch(context.Background(), &types.QueryRequestSearchRequest{
+		OracleScriptId: 1,
+		Calldata:       hex.EncodeToString(basicCalldata),
+		AskCount:       3,
+		MinCount:       2,
+	})
+	require.ErrorContains(err, "no successful request matches the given input")
+
+	_, err = querier.RequestSearch(context.Background(), &types.QueryRequestSearchRequest{
+		OracleScriptId: 1,
+		Calldata:       "invalid",
+		AskCount:       2,
+		MinCount:       2,
+	})
+	require.ErrorContains(err, "unable to decode calldata")
+}
+
+func (suite *KeeperTestSuite) TestQueryRequestPrice() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	querier := suite.queryClient
+	require := suite.Require()
+
+	suite.setLatestResultIndexParams(10, 1)
+
+	request := defaultRequest()
+	request.Calldata = priceCalldata
+	k.SetRequest(ctx, 1, request)
+	k.SaveResult(ctx, 1, types.RESOLVE_STATUS_SUCCESS, priceResult)
+
+	res, err := querier.RequestPrice(context.Background(), &types.QueryRequestPriceRequest{
+		Symbols:  []string{"BTC", "ETH"},
+		AskCount: 2,
+		MinCount: 2,
+	})
+	require.NoError(err)
+	require.Len(res.PriceResults, 2)
+	require.Equal("BTC", res.PriceResults[0].Symbol)
+	require.Equal(uint64(60000000000000), res.PriceResults[0].Px)
+	require.Equal("ETH", res.PriceResults[1].Symbol)
+	require.Equal(uint64(3000000000000), res.PriceResults[1].Px)
+
+	_, err = querier.RequestPrice(context.Background(), &types.QueryRequestPriceRequest{
+		Symbols:  []string{"BTC", "BAND"},
+		AskCount: 2,
+		MinCount: 2,
+	})
+	require.ErrorContains(err, "price not found")
+
+	_, err = querier.RequestPrice(context.Background(), &types.QueryRequestPriceRequest{AskCount: 2, MinCount: 2})
+	require.ErrorContains(err, "symbols cannot be empty")
+}
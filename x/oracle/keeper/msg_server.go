@@ -34,10 +34,17 @@ func (k msgServer) RequestData(
 		return nil, err
 	}
 
-	_, err = k.PrepareRequest(ctx, msg, payer, nil)
+	id, err := k.PrepareRequest(ctx, msg, payer, nil)
 	if err != nil {
 		return nil, err
 	}
+
+	if msg.Callback != nil {
+		if err := k.AddRequestCallback(ctx, id, *msg.Callback); err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.MsgRequestDataResponse{}, nil
 }
 
@@ -67,6 +74,11 @@ func (k msgServer) ReportData(goCtx context.Context, msg *types.MsgReportData) (
 		return nil, err
 	}
 
+	// pay the data source fees of this report from the escrow of the request
+	if err := k.ReleaseReportFee(ctx, msg.RequestID, validator); err != nil {
+		return nil, err
+	}
+
 	// if request has not been resolved, check if it need to resolve at the endblock
 	if reportInTime {
 		req := k.MustGetRequest(ctx, msg.RequestID)
@@ -290,6 +302,55 @@ func (k msgServer) Activate(goCtx context.Context, msg *types.MsgActivate) (*typ
 	return &types.MsgActivateResponse{}, nil
 }
 
+func (k msgServer) CreateRequestSubscription(
+	goCtx context.Context,
+	msg *types.MsgCreateRequestSubscription,
+) (*types.MsgCreateRequestSubscriptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	id, err := k.Keeper.CreateRequestSubscription(ctx, msg, msg.Interval, msg.InitialDeposit, owner)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgCreateRequestSubscriptionResponse{SubscriptionID: id}, nil
+}
+
+func (k msgServer) TopUpRequestSubscription(
+	goCtx context.Context,
+	msg *types.MsgTopUpRequestSubscription,
+) (*types.MsgTopUpRequestSubscriptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.TopUpRequestSubscription(ctx, msg.SubscriptionID, msg.Amount, sender); err != nil {
+		return nil, err
+	}
+	return &types.MsgTopUpRequestSubscriptionResponse{}, nil
+}
+
+func (k msgServer) CancelRequestSubscription(
+	goCtx context.Context,
+	msg *types.MsgCancelRequestSubscription,
+) (*types.MsgCancelRequestSubscriptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.CancelRequestSubscription(ctx, msg.SubscriptionID, owner); err != nil {
+		return nil, err
+	}
+	return &types.MsgCancelRequestSubscriptionResponse{}, nil
+}
+
 func (k msgServer) UpdateParams(
 	goCtx context.Context,
 	msg *types.MsgUpdateParams,
@@ -307,6 +368,9 @@ func (k msgServer) UpdateParams(
 		return nil, err
 	}
 
+	// the latest result indexes must fit in the new maximum index size
+	k.PruneLatestResultIndexes(ctx)
+
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeUpdateParams,
 		sdk.NewAttribute(types.AttributeKeyParams, msg.Params.String()),
@@ -41,16 +41,19 @@ func (k Keeper) SetOracleScript(ctx sdk.Context, id types.OracleScriptID, oracle
 	store.Set(types.OracleScriptStoreKey(id), k.cdc.MustMarshal(&oracleScript))
 }
 
-// AddOracleScript adds the given oracle script to the storage.
+// AddOracleScript adds the given oracle script to the storage and records it as the first version.
 func (k Keeper) AddOracleScript(ctx sdk.Context, oracleScript types.OracleScript) types.OracleScriptID {
 	id := k.GetNextOracleScriptID(ctx)
 	k.SetOracleScript(ctx, id, oracleScript)
+	k.addOracleScriptVersion(ctx, id, oracleScript, oracleScript.Owner)
 	return id
 }
 
 // MustEditOracleScript edits the given oracle script by id and flushes it to the storage. Panic if not exists.
+// The edited oracle script is recorded as a new version edited by the previous owner.
 func (k Keeper) MustEditOracleScript(ctx sdk.Context, id types.OracleScriptID, new types.OracleScript) {
 	oracleScript := k.MustGetOracleScript(ctx, id)
+	editor := oracleScript.Owner
 	oracleScript.Owner = new.Owner
 	oracleScript.Name = modify(oracleScript.Name, new.Name)
 	oracleScript.Description = modify(oracleScript.Description, new.Description)
@@ -58,6 +61,7 @@ func (k Keeper) MustEditOracleScript(ctx sdk.Context, id types.OracleScriptID, n
 	oracleScript.Schema = modify(oracleScript.Schema, new.Schema)
 	oracleScript.SourceCodeURL = modify(oracleScript.SourceCodeURL, new.SourceCodeURL)
 	k.SetOracleScript(ctx, id, oracleScript)
+	k.addOracleScriptVersion(ctx, id, oracleScript, editor)
 }
 
 // GetAllOracleScripts returns the list of all oracle scripts in the store, or nil if there is none.
@@ -4,8 +4,6 @@ import (
 	"encoding/hex"
 	"fmt"
 
-	"cosmossdk.io/math"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -118,10 +116,24 @@ func (k Keeper) PrepareRequest(
 		int64(params.MaxRawRequestCount),
 		int64(k.GetSpanSize(ctx)),
 	)
-	script, err := k.GetOracleScript(ctx, req.OracleScriptID)
+	if !k.HasOracleScript(ctx, req.OracleScriptID) {
+		return 0, types.ErrOracleScriptNotFound.Wrapf("id: %d", req.OracleScriptID)
+	}
+
+	// Use the pinned versions of the oracle script and data sources if any, or their latest versions.
+	var pinnedScriptVersion uint64
+	pinnedDataSourceVersions := make(map[types.DataSourceID]uint64)
+	if vr, ok := r.(types.VersionedRequestSpec); ok {
+		pinnedScriptVersion = vr.GetOracleScriptVersion()
+		for _, pin := range vr.GetDataSourceVersions() {
+			pinnedDataSourceVersions[pin.DataSourceID] = pin.Version
+		}
+	}
+	script, err := k.getOracleScriptVersion(ctx, req.OracleScriptID, pinnedScriptVersion)
 	if err != nil {
 		return 0, err
 	}
+	req.OracleScriptVersion = script.Version
 
 	// Consume fee and execute owasm code
 	ctx.GasMeter().ConsumeGas(params.BaseOwasmGas, "BASE_OWASM_FEE")
@@ -137,15 +149,27 @@ func (k Keeper) PrepareRequest(
 	if len(req.RawRequests) == 0 {
 		return 0, types.ErrEmptyRawRequests
 	}
+	dsVersions := make([]types.DataSourceVersion, len(req.RawRequests))
+	for i, raw := range req.RawRequests {
+		dsVersions[i], err = k.getDataSourceVersion(ctx, raw.DataSourceID, pinnedDataSourceVersions[raw.DataSourceID])
+		if err != nil {
+			return 0, err
+		}
+		req.RawRequests[i].DataSourceVersion = dsVersions[i].Version
+	}
 	// Collect ds fee
-	totalFees, err := k.CollectFee(ctx, feePayer, req.FeeLimit, askCount, req.RawRequests)
+	escrow, err := k.CollectFee(ctx, feePayer, req.FeeLimit, askCount, dsVersions)
 	if err != nil {
 		return 0, err
 	}
+	totalFees := escrow.Total
 
 	// We now have everything we need to the request, so let's add it to the store.
 	req.FeeLimit = req.FeeLimit.Sub(totalFees...)
 	id := k.AddRequest(ctx, req)
+	if !totalFees.IsZero() {
+		k.SetFeeEscrow(ctx, id, escrow)
+	}
 
 	// Emit an event describing a data request and asked validators.
 	event := sdk.NewEvent(types.EventTypeRequest)
@@ -169,18 +193,14 @@ func (k Keeper) PrepareRequest(
 	ctx.GasMeter().ConsumeGas(r.GetExecuteGas(), "OWASM_EXECUTE_FEE")
 
 	// Emit an event for each of the raw data requests.
-	for _, rawReq := range env.GetRawRequests() {
-		ds, err := k.GetDataSource(ctx, rawReq.DataSourceID)
-		if err != nil {
-			return 0, err
-		}
+	for i, rawReq := range req.RawRequests {
 		ctx.EventManager().EmitEvent(sdk.NewEvent(
 			types.EventTypeRawRequest,
 			sdk.NewAttribute(types.AttributeKeyDataSourceID, fmt.Sprintf("%d", rawReq.DataSourceID)),
-			sdk.NewAttribute(types.AttributeKeyDataSourceHash, ds.Filename),
+			sdk.NewAttribute(types.AttributeKeyDataSourceHash, dsVersions[i].Filename),
 			sdk.NewAttribute(types.AttributeKeyExternalID, fmt.Sprintf("%d", rawReq.ExternalID)),
 			sdk.NewAttribute(types.AttributeKeyCalldata, string(rawReq.Calldata)),
-			sdk.NewAttribute(types.AttributeKeyFee, ds.Fee.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, dsVersions[i].Fee.String()),
 		))
 	}
 	return id, nil
@@ -191,8 +211,15 @@ func (k Keeper) PrepareRequest(
 func (k Keeper) ResolveRequest(ctx sdk.Context, reqID types.RequestID) {
 	req := k.MustGetRequest(ctx, reqID)
 	env := types.NewExecuteEnv(req, k.GetReports(ctx, reqID), ctx.BlockTime(), int64(k.GetSpanSize(ctx)))
-	script := k.MustGetOracleScript(ctx, req.OracleScriptID)
-	code := k.GetFile(script.Filename)
+	filename := k.MustGetOracleScript(ctx, req.OracleScriptID).Filename
+	if req.OracleScriptVersion != 0 {
+		script, err := k.GetOracleScriptVersion(ctx, req.OracleScriptID, req.OracleScriptVersion)
+		if err != nil {
+			panic(err)
+		}
+		filename = script.Filename
+	}
+	code := k.GetFile(filename)
 	output, err := k.owasmVM.Execute(code, ConvertToOwasmGas(req.GetExecuteGas()), env)
 
 	if err != nil {
@@ -204,41 +231,60 @@ func (k Keeper) ResolveRequest(ctx sdk.Context, reqID types.RequestID) {
 	}
 }
 
-// CollectFee subtract fee from fee payer and send them to treasury
+// getOracleScriptVersion returns the given version of an oracle script, or its latest version if the
+// given version is zero.
+func (k Keeper) getOracleScriptVersion(
+	ctx sdk.Context,
+	id types.OracleScriptID,
+	version uint64,
+) (types.OracleScriptVersion, error) {
+	if version == 0 {
+		return k.GetLatestOracleScriptVersion(ctx, id)
+	}
+	return k.GetOracleScriptVersion(ctx, id, version)
+}
+
+// getDataSourceVersion returns the given version of a data source, or its latest version if the given
+// version is zero.
+func (k Keeper) getDataSourceVersion(
+	ctx sdk.Context,
+	id types.DataSourceID,
+	version uint64,
+) (types.DataSourceVersion, error) {
+	if !k.HasDataSource(ctx, id) {
+		return types.DataSourceVersion{}, types.ErrDataSourceNotFound.Wrapf("id: %d", id)
+	}
+	if version == 0 {
+		return k.GetLatestDataSourceVersion(ctx, id)
+	}
+	return k.GetDataSourceVersion(ctx, id, version)
+}
+
+// CollectFee subtracts the fees of the given data source versions for all asked validators from the fee
+// payer and holds them in escrow for the treasuries of those versions until the validators report.
 func (k Keeper) CollectFee(
 	ctx sdk.Context,
 	payer sdk.AccAddress,
 	feeLimit sdk.Coins,
 	askCount uint64,
-	rawRequests []types.RawRequest,
-) (sdk.Coins, error) {
-	collector := newFeeCollector(k.bankKeeper, feeLimit, payer)
-
-	for _, r := range rawRequests {
-		ds, err := k.GetDataSource(ctx, r.DataSourceID)
-		if err != nil {
-			return nil, err
-		}
+	dsVersions []types.DataSourceVersion,
+) (types.FeeEscrow, error) {
+	collector := newFeeCollector(k.bankKeeper, feeLimit, payer, askCount)
 
+	for _, ds := range dsVersions {
 		if ds.Fee.Empty() {
 			continue
 		}
 
-		fee := sdk.NewCoins()
-		for _, c := range ds.Fee {
-			c.Amount = c.Amount.Mul(math.NewInt(int64(askCount)))
-			fee = fee.Add(c)
-		}
-
 		treasury, err := sdk.AccAddressFromBech32(ds.Treasury)
 		if err != nil {
-			return nil, err
+			return types.FeeEscrow{}, err
 		}
 
-		if err := collector.Collect(ctx, fee, treasury); err != nil {
-			return nil, err
+		if err := collector.Collect(ctx, ds.Fee, treasury); err != nil {
+			return types.FeeEscrow{}, err
 		}
 	}
 
-	return collector.Collected(), nil
+	return types.NewFeeEscrow(payer, collector.TreasuryFees(), collector.Collected()), nil
 }
@@ -195,15 +195,15 @@ func (suite *KeeperTestSuite) TestPrepareRequestSuccessBasic() {
 		GetRollingSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY"))
 
-	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, treasury, bandtesting.Coins1band)
-	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, treasury, bandtesting.Coins1band)
-	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, treasury, bandtesting.Coins1band)
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), alice, types.ModuleName, bandtesting.Coins1band)
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), alice, types.ModuleName, bandtesting.Coins1band)
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), alice, types.ModuleName, bandtesting.Coins1band)
 
 	id, err := k.PrepareRequest(ctx, msg, alice, nil)
 	require.NoError(err)
 	require.Equal(types.RequestID(1), id)
 
-	require.Equal(types.NewRequest(
+	expectRequest := types.NewRequest(
 		1,
 		basicCalldata,
 		[]sdk.ValAddress{validators[0].Address},
@@ -221,7 +221,12 @@ func (suite *KeeperTestSuite) TestPrepareRequestSuccessBasic() {
 		0,
 		alice.String(),
 		sdk.NewCoins(sdk.NewInt64Coin("uband", 97000000)),
-	), k.MustGetRequest(ctx, 1))
+	)
+	expectRequest.OracleScriptVersion = 1
+	for i := range expectRequest.RawRequests {
+		expectRequest.RawRequests[i].DataSourceVersion = 1
+	}
+	require.Equal(expectRequest, k.MustGetRequest(ctx, 1))
 
 	// assert gas consumption
 	params := k.GetParams(ctx)
@@ -295,7 +300,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestNotEnoughMaxFee() {
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1band).
 		Return(nil).AnyTimes()
 
 	ctx = ctx.WithBlockTime(bandtesting.ParseTime(1581589790)).WithBlockHeight(42)
@@ -388,7 +393,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestNotEnoughFund() {
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY"))
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.Alice.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		SendCoinsFromAccountToModule(gomock.Any(), bandtesting.Alice.Address, types.ModuleName, bandtesting.Coins1band).
 		Return(errorsmod.Wrapf(
 			sdkerrors.ErrInsufficientFunds,
 			"spendable balance %s is smaller than %s",
@@ -467,7 +472,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestInvalidAskCountFail() {
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY"))
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1band).
 		Return(nil).AnyTimes()
 
 	params := k.GetParams(ctx)
@@ -551,7 +556,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestBaseOwasmFeePanic() {
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1band).
 		Return(nil).AnyTimes()
 
 	params := k.GetParams(ctx)
@@ -597,7 +602,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestPerValidatorRequestFeePanic() {
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1band).
 		Return(nil).AnyTimes()
 
 	params := k.GetParams(ctx)
@@ -746,7 +751,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestUnknownDataSource() {
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1band).
 		Return(nil).AnyTimes()
 
 	m := types.NewMsgRequestData(4, obi.MustEncode(testdata.Wasm4Input{
@@ -772,7 +777,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestInvalidDataSourceCount() {
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1band).
 		Return(nil).AnyTimes()
 
 	params := k.GetParams(ctx)
@@ -809,7 +814,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestTooMuchWasmGas() {
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1band).
 		Return(nil).AnyTimes()
 
 	m := types.NewMsgRequestData(
@@ -858,7 +863,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestTooLargeCalldata() {
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1band).
 		Return(nil).AnyTimes()
 
 	m := types.NewMsgRequestData(
@@ -1210,8 +1215,8 @@ func (suite *KeeperTestSuite) TestResolveRequestSuccessComplex() {
 	require.Equal(result, k.MustGetResult(ctx, 42))
 }
 
-func rawRequestsFromFees(ctx sdk.Context, k keeper.Keeper, fees []sdk.Coins) []types.RawRequest {
-	var rawRequests []types.RawRequest
+func dataSourceVersionsFromFees(ctx sdk.Context, k keeper.Keeper, fees []sdk.Coins) []types.DataSourceVersion {
+	var dsVersions []types.DataSourceVersion
 	for _, f := range fees {
 		id := k.AddDataSource(ctx, types.NewDataSource(
 			bandtesting.Owner.Address,
@@ -1222,12 +1227,14 @@ func rawRequestsFromFees(ctx sdk.Context, k keeper.Keeper, fees []sdk.Coins) []t
 			bandtesting.Treasury.Address,
 		))
 
-		rawRequests = append(rawRequests, types.NewRawRequest(
-			0, id, nil,
-		))
+		dsVersion, err := k.GetLatestDataSourceVersion(ctx, id)
+		if err != nil {
+			panic(err)
+		}
+		dsVersions = append(dsVersions, dsVersion)
 	}
 
-	return rawRequests
+	return dsVersions
 }
 
 func (suite *KeeperTestSuite) TestCollectFeeEmptyFee() {
@@ -1235,7 +1242,7 @@ func (suite *KeeperTestSuite) TestCollectFeeEmptyFee() {
 	k := suite.oracleKeeper
 	require := suite.Require()
 
-	raws := rawRequestsFromFees(ctx, k, []sdk.Coins{
+	dsVersions := dataSourceVersionsFromFees(ctx, k, []sdk.Coins{
 		bandtesting.EmptyCoins,
 		bandtesting.EmptyCoins,
 		bandtesting.EmptyCoins,
@@ -1243,21 +1250,21 @@ func (suite *KeeperTestSuite) TestCollectFeeEmptyFee() {
 		bandtesting.EmptyCoins,
 	})
 
-	coins, err := k.CollectFee(ctx, bandtesting.Alice.Address, bandtesting.EmptyCoins, 1, raws)
+	escrow, err := k.CollectFee(ctx, bandtesting.Alice.Address, bandtesting.EmptyCoins, 1, dsVersions)
 	require.NoError(err)
-	require.Empty(coins)
+	require.Empty(escrow.Total)
 
-	coins, err = k.CollectFee(ctx, bandtesting.Alice.Address, bandtesting.Coins100band, 1, raws)
+	escrow, err = k.CollectFee(ctx, bandtesting.Alice.Address, bandtesting.Coins100band, 1, dsVersions)
 	require.NoError(err)
-	require.Empty(coins)
+	require.Empty(escrow.Total)
 
-	coins, err = k.CollectFee(ctx, bandtesting.Alice.Address, bandtesting.EmptyCoins, 2, raws)
+	escrow, err = k.CollectFee(ctx, bandtesting.Alice.Address, bandtesting.EmptyCoins, 2, dsVersions)
 	require.NoError(err)
-	require.Empty(coins)
+	require.Empty(escrow.Total)
 
-	coins, err = k.CollectFee(ctx, bandtesting.Alice.Address, bandtesting.Coins100band, 2, raws)
+	escrow, err = k.CollectFee(ctx, bandtesting.Alice.Address, bandtesting.Coins100band, 2, dsVersions)
 	require.NoError(err)
-	require.Empty(coins)
+	require.Empty(escrow.Total)
 }
 
 func (suite *KeeperTestSuite) TestCollectFeeBasicSuccess() {
@@ -1267,14 +1274,14 @@ func (suite *KeeperTestSuite) TestCollectFeeBasicSuccess() {
 
 	oracletestutil.ChainGoMockCalls(
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+			SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1band).
 			Return(nil),
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(2000000)))).
+			SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(2000000)))).
 			Return(nil),
 	)
 
-	raws := rawRequestsFromFees(ctx, k, []sdk.Coins{
+	dsVersions := dataSourceVersionsFromFees(ctx, k, []sdk.Coins{
 		bandtesting.EmptyCoins,
 		bandtesting.Coins1band,
 		bandtesting.EmptyCoins,
@@ -1282,9 +1289,9 @@ func (suite *KeeperTestSuite) TestCollectFeeBasicSuccess() {
 		bandtesting.EmptyCoins,
 	})
 
-	coins, err := k.CollectFee(ctx, bandtesting.FeePayer.Address, bandtesting.Coins100band, 1, raws)
+	escrow, err := k.CollectFee(ctx, bandtesting.FeePayer.Address, bandtesting.Coins100band, 1, dsVersions)
 	require.NoError(err)
-	require.Equal(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(3000000))), coins)
+	require.Equal(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(3000000))), escrow.Total)
 }
 
 func (suite *KeeperTestSuite) TestCollectFeeBasicSuccessWithOtherAskCount() {
@@ -1294,14 +1301,14 @@ func (suite *KeeperTestSuite) TestCollectFeeBasicSuccessWithOtherAskCount() {
 
 	oracletestutil.ChainGoMockCalls(
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(4000000)))).
+			SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(4000000)))).
 			Return(nil),
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(8000000)))).
+			SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(8000000)))).
 			Return(nil),
 	)
 
-	raws := rawRequestsFromFees(ctx, k, []sdk.Coins{
+	dsVersions := dataSourceVersionsFromFees(ctx, k, []sdk.Coins{
 		bandtesting.EmptyCoins,
 		bandtesting.Coins1band,
 		bandtesting.EmptyCoins,
@@ -1309,9 +1316,9 @@ func (suite *KeeperTestSuite) TestCollectFeeBasicSuccessWithOtherAskCount() {
 		bandtesting.EmptyCoins,
 	})
 
-	coins, err := k.CollectFee(ctx, bandtesting.FeePayer.Address, bandtesting.Coins100band, 4, raws)
+	escrow, err := k.CollectFee(ctx, bandtesting.FeePayer.Address, bandtesting.Coins100band, 4, dsVersions)
 	require.NoError(err)
-	require.Equal(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(12000000))), coins)
+	require.Equal(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(12000000))), escrow.Total)
 }
 
 func (suite *KeeperTestSuite) TestCollectFeeWithMixedAndFeeNotEnough() {
@@ -1320,10 +1327,10 @@ func (suite *KeeperTestSuite) TestCollectFeeWithMixedAndFeeNotEnough() {
 	require := suite.Require()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+		SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1band).
 		Return(nil)
 
-	raws := rawRequestsFromFees(ctx, k, []sdk.Coins{
+	dsVersions := dataSourceVersionsFromFees(ctx, k, []sdk.Coins{
 		bandtesting.EmptyCoins,
 		bandtesting.Coins1band,
 		bandtesting.EmptyCoins,
@@ -1331,13 +1338,13 @@ func (suite *KeeperTestSuite) TestCollectFeeWithMixedAndFeeNotEnough() {
 		bandtesting.EmptyCoins,
 	})
 
-	coins, err := k.CollectFee(ctx, bandtesting.FeePayer.Address, bandtesting.EmptyCoins, 1, raws)
+	escrow, err := k.CollectFee(ctx, bandtesting.FeePayer.Address, bandtesting.EmptyCoins, 1, dsVersions)
 	require.ErrorIs(err, types.ErrNotEnoughFee)
-	require.Nil(coins)
+	require.Empty(escrow)
 
-	coins, err = k.CollectFee(ctx, bandtesting.FeePayer.Address, bandtesting.Coins1band, 1, raws)
+	escrow, err = k.CollectFee(ctx, bandtesting.FeePayer.Address, bandtesting.Coins1band, 1, dsVersions)
 	require.ErrorIs(err, types.ErrNotEnoughFee)
-	require.Nil(coins)
+	require.Empty(escrow)
 }
 
 func (suite *KeeperTestSuite) TestCollectFeeWithEnoughFeeButInsufficientBalance() {
@@ -1347,10 +1354,10 @@ func (suite *KeeperTestSuite) TestCollectFeeWithEnoughFeeButInsufficientBalance(
 
 	oracletestutil.ChainGoMockCalls(
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.Alice.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+			SendCoinsFromAccountToModule(gomock.Any(), bandtesting.Alice.Address, types.ModuleName, bandtesting.Coins1band).
 			Return(nil),
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.Alice.Address, bandtesting.Treasury.Address, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(2000000)))).
+			SendCoinsFromAccountToModule(gomock.Any(), bandtesting.Alice.Address, types.ModuleName, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(2000000)))).
 			Return(errorsmod.Wrapf(
 				sdkerrors.ErrInsufficientFunds,
 				"spendable balance %s is smaller than %s",
@@ -1358,7 +1365,7 @@ func (suite *KeeperTestSuite) TestCollectFeeWithEnoughFeeButInsufficientBalance(
 			)),
 	)
 
-	raws := rawRequestsFromFees(ctx, k, []sdk.Coins{
+	dsVersions := dataSourceVersionsFromFees(ctx, k, []sdk.Coins{
 		bandtesting.EmptyCoins,
 		bandtesting.Coins1band,
 		bandtesting.EmptyCoins,
@@ -1366,8 +1373,8 @@ func (suite *KeeperTestSuite) TestCollectFeeWithEnoughFeeButInsufficientBalance(
 		bandtesting.EmptyCoins,
 	})
 
-	coins, err := k.CollectFee(ctx, bandtesting.Alice.Address, bandtesting.Coins100band, 1, raws)
-	require.Nil(coins)
+	escrow, err := k.CollectFee(ctx, bandtesting.Alice.Address, bandtesting.Coins100band, 1, dsVersions)
+	require.Empty(escrow)
 	// MAX is 100m but have only 1m in account
 	// First ds collect 1m so there no balance enough for next ds but it doesn't touch limit
 	require.EqualError(err, "spendable balance 0uband is smaller than 2band: insufficient funds")
@@ -1380,14 +1387,14 @@ func (suite *KeeperTestSuite) TestCollectFeeWithWithManyUnitSuccess() {
 
 	oracletestutil.ChainGoMockCalls(
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1band).
+			SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1band).
 			Return(nil),
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(2000000)), sdk.NewCoin("uabc", math.NewInt(1000000)))).
+			SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(2000000)), sdk.NewCoin("uabc", math.NewInt(1000000)))).
 			Return(nil),
 	)
 
-	raws := rawRequestsFromFees(ctx, k, []sdk.Coins{
+	dsVersions := dataSourceVersionsFromFees(ctx, k, []sdk.Coins{
 		bandtesting.EmptyCoins,
 		bandtesting.Coins1band,
 		bandtesting.EmptyCoins,
@@ -1395,18 +1402,18 @@ func (suite *KeeperTestSuite) TestCollectFeeWithWithManyUnitSuccess() {
 		bandtesting.EmptyCoins,
 	})
 
-	coins, err := k.CollectFee(
+	escrow, err := k.CollectFee(
 		ctx,
 		bandtesting.FeePayer.Address,
 		sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(1000000000)), sdk.NewCoin("uabc", math.NewInt(1000000))),
 		1,
-		raws,
+		dsVersions,
 	)
 	require.NoError(err)
 
 	// Coins sum is correct
 	require.True(
 		sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(3000000)), sdk.NewCoin("uabc", math.NewInt(1000000))).
-			Equal(coins),
+			Equal(escrow.Total),
 	)
 }
@@ -56,8 +56,9 @@ func (k Keeper) ProcessExpiredRequests(ctx sdk.Context) {
 	lastReqID := types.RequestID(k.GetRequestCount(ctx))
 	expirationBlockCount := int64(k.GetParams(ctx).ExpirationBlockCount)
 	// Loop through all data requests in chronological order. If a request reaches its
-	// expiration height, we will deactivate validators that didn't report data on the
-	// request. We also resolve requests to status EXPIRED if they are not yet resolved.
+	// expiration height, we will record the reports of the requested validators and
+	// deactivate the ones that missed too many reports. We also resolve requests to status
+	// EXPIRED if they are not yet resolved.
 	for ; currentReqID <= lastReqID; currentReqID++ {
 		req := k.MustGetRequest(ctx, currentReqID)
 
@@ -72,14 +73,19 @@ func (k Keeper) ProcessExpiredRequests(ctx sdk.Context) {
 			k.ResolveExpired(ctx, currentReqID)
 		}
 
-		// Deactivate all validators that do not report to this request.
+		// Record the reports of all requested validators, deactivating the ones that miss too many reports.
 		for _, val := range req.RequestedValidators {
 			v, _ := sdk.ValAddressFromBech32(val)
-			if !k.HasReport(ctx, currentReqID, v) {
+			if k.HasReport(ctx, currentReqID, v) {
+				k.FulfillReport(ctx, v, time.Unix(req.RequestTime, 0))
+			} else {
 				k.MissReport(ctx, v, time.Unix(req.RequestTime, 0))
 			}
 		}
 
+		// Refund the fees of the validators that did not report to a successful or failed request
+		k.RefundFeeEscrow(ctx, currentReqID)
+
 		// Cleanup request and reports
 		k.DeleteRequest(ctx, currentReqID)
 		k.DeleteReports(ctx, currentReqID)
@@ -121,6 +121,8 @@ func (k Keeper) safeCreateSigning(
 		"",
 		sdk.MustAccAddressFromBech32(requester),
 		feeLimit,
+		"",
+		false,
 	)
 	if err != nil {
 		return 0, err
@@ -160,7 +162,9 @@ func (k Keeper) handleCreateSigningFailed(
 	ctx.EventManager().EmitEvent(existingEvents)
 }
 
-// ResolveFailure resolves the given request as failure with the given reason.
+// ResolveFailure resolves the given request as failure with the given reason. As for a successful request,
+// the fee escrow stays open until the request expires, so that the validators that report until then are
+// paid, and only the fees of the validators that never report are refunded.
 func (k Keeper) ResolveFailure(ctx sdk.Context, id types.RequestID, reason string) {
 	k.SaveResult(ctx, id, types.RESOLVE_STATUS_FAILURE, []byte{})
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
@@ -174,6 +178,7 @@ func (k Keeper) ResolveFailure(ctx sdk.Context, id types.RequestID, reason strin
 // ResolveExpired resolves the given request as expired.
 func (k Keeper) ResolveExpired(ctx sdk.Context, id types.RequestID) {
 	k.SaveResult(ctx, id, types.RESOLVE_STATUS_EXPIRED, []byte{})
+	k.RefundFeeEscrow(ctx, id)
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeResolve,
 		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
@@ -187,7 +192,7 @@ func (k Keeper) SaveResult(
 ) {
 	r := k.MustGetRequest(ctx, id)
 	reportCount := k.GetReportCount(ctx, id)
-	k.SetResult(ctx, id, types.NewResult(
+	res := types.NewResult(
 		r.ClientID,                         // ClientID
 		r.OracleScriptID,                   // OracleScriptID
 		r.Calldata,                         // Calldata
@@ -199,7 +204,20 @@ func (k Keeper) SaveResult(
 		ctx.BlockTime().Unix(),             // ResolveTime
 		status,                             // ResolveStatus
 		result,                             // Result
-	))
+	)
+	k.SetResult(ctx, id, res)
+
+	// keep track of the latest successful result of the same query
+	if status == types.RESOLVE_STATUS_SUCCESS {
+		k.IndexLatestResult(ctx, res)
+	}
+
+	packetData := types.NewOracleResponsePacketData(
+		r.ClientID, id, reportCount, r.RequestTime, ctx.BlockTime().Unix(), status, result,
+	)
+
+	// deliver the response to the in-process callback target of the request, if any
+	k.InvokeRequestCallback(ctx, id, packetData)
 
 	if r.IBCChannel != nil {
 		sourceChannel := r.IBCChannel.ChannelId
@@ -214,10 +232,6 @@ func (k Keeper) SaveResult(
 			return
 		}
 
-		packetData := types.NewOracleResponsePacketData(
-			r.ClientID, id, reportCount, r.RequestTime, ctx.BlockTime().Unix(), status, result,
-		)
-
 		if _, err := k.ics4Wrapper.SendPacket(
 			ctx,
 			channelCap,
@@ -89,12 +89,16 @@ func (suite *KeeperTestSuite) TestResolveSuccessButInsufficientMember() {
 		"",
 		sdk.MustAccAddressFromBech32(request.Requester),
 		request.FeeLimit,
+		"",
+		false,
 	).DoAndReturn(func(
 		ctx sdk.Context,
 		content *types.OracleResultSignatureOrder,
 		memo string,
 		sender sdk.AccAddress,
 		feeLimit sdk.Coins,
+		groupName string,
+		batch bool,
 	) (bandtsstypes.SigningID, error) {
 		ctx.KVStore(suite.key).Set([]byte{0xff, 0xff}, []byte("test"))
 		return 0, tsstypes.ErrInsufficientSigners
@@ -157,12 +161,16 @@ func (suite *KeeperTestSuite) TestResolveSuccessAndGetSigningID() {
 		"",
 		sdk.MustAccAddressFromBech32(request.Requester),
 		request.FeeLimit,
+		"",
+		false,
 	).DoAndReturn(func(
 		ctx sdk.Context,
 		content *types.OracleResultSignatureOrder,
 		memo string,
 		sender sdk.AccAddress,
 		feeLimit sdk.Coins,
+		groupName string,
+		batch bool,
 	) (bandtsstypes.SigningID, error) {
 		ctx.KVStore(suite.key).Set([]byte{0xff, 0xff}, []byte("test"))
 		return bandtsstypes.SigningID(1), nil
@@ -0,0 +1,342 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// SetRequestSubscriptionCount sets the number of request subscription count to the given value.
+func (k Keeper) SetRequestSubscriptionCount(ctx sdk.Context, count uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	ctx.KVStore(k.storeKey).Set(types.RequestSubscriptionCountStoreKey, bz)
+}
+
+// GetRequestSubscriptionCount returns the number of request subscriptions ever created.
+func (k Keeper) GetRequestSubscriptionCount(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.RequestSubscriptionCountStoreKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GetNextRequestSubscriptionID increments and returns the current number of request subscriptions.
+func (k Keeper) GetNextRequestSubscriptionID(ctx sdk.Context) uint64 {
+	count := k.GetRequestSubscriptionCount(ctx)
+	k.SetRequestSubscriptionCount(ctx, count+1)
+	return count + 1
+}
+
+// SetRequestSubscription saves the given request subscription to the store.
+func (k Keeper) SetRequestSubscription(ctx sdk.Context, sub types.RequestSubscription) {
+	ctx.KVStore(k.storeKey).Set(types.RequestSubscriptionStoreKey(sub.ID), k.cdc.MustMarshal(&sub))
+}
+
+// GetRequestSubscription returns the request subscription of the given ID.
+func (k Keeper) GetRequestSubscription(ctx sdk.Context, id uint64) (types.RequestSubscription, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RequestSubscriptionStoreKey(id))
+	if bz == nil {
+		return types.RequestSubscription{}, types.ErrSubscriptionNotFound.Wrapf("id: %d", id)
+	}
+	var sub types.RequestSubscription
+	k.cdc.MustUnmarshal(bz, &sub)
+	return sub, nil
+}
+
+// DeleteRequestSubscription removes the request subscription of the given ID from the store.
+func (k Keeper) DeleteRequestSubscription(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.RequestSubscriptionStoreKey(id))
+}
+
+// GetAllRequestSubscriptions returns the list of all request subscriptions in the store.
+func (k Keeper) GetAllRequestSubscriptions(ctx sdk.Context) (subs []types.RequestSubscription) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.RequestSubscriptionStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var sub types.RequestSubscription
+		k.cdc.MustUnmarshal(iterator.Value(), &sub)
+		subs = append(subs, sub)
+	}
+	return subs
+}
+
+// ScheduleRequestSubscription adds the request subscription to the queue at the height of its next request.
+func (k Keeper) ScheduleRequestSubscription(ctx sdk.Context, sub types.RequestSubscription) {
+	key := types.RequestSubscriptionQueueStoreKey(sub.NextRequestHeight, sub.ID)
+	ctx.KVStore(k.storeKey).Set(key, []byte{})
+}
+
+// UnscheduleRequestSubscription removes the request subscription from the queue.
+func (k Keeper) UnscheduleRequestSubscription(ctx sdk.Context, sub types.RequestSubscription) {
+	key := types.RequestSubscriptionQueueStoreKey(sub.NextRequestHeight, sub.ID)
+	ctx.KVStore(k.storeKey).Delete(key)
+}
+
+// GenerateSubscriptionAccount generates a new fee payer account for a request subscription, which holds
+// the deposit of the subscription. Nobody owns the private key of the account.
+func (k Keeper) GenerateSubscriptionAccount(ctx sdk.Context, key string) (sdk.AccAddress, error) {
+	header := ctx.BlockHeader()
+
+	buf := []byte(key)
+	buf = append(buf, header.AppHash...)
+	buf = append(buf, header.DataHash...)
+
+	moduleCred, err := authtypes.NewModuleCredential(types.ModuleName, []byte(types.SubscriptionAccountsKey), buf)
+	if err != nil {
+		return nil, err
+	}
+
+	accAddr := sdk.AccAddress(moduleCred.Address())
+
+	if acc := k.authKeeper.GetAccount(ctx, accAddr); acc != nil {
+		// this should not happen
+		return nil, types.ErrAccountAlreadyExist.Wrapf(
+			"existing account for newly generated key account address %s",
+			accAddr.String(),
+		)
+	}
+
+	acc, err := authtypes.NewBaseAccountWithPubKey(moduleCred)
+	if err != nil {
+		return nil, err
+	}
+
+	k.authKeeper.NewAccount(ctx, acc)
+	k.authKeeper.SetAccount(ctx, acc)
+
+	return accAddr, nil
+}
+
+// CreateRequestSubscription creates a new request subscription from the given template, funds its fee payer
+// account with the initial deposit of the owner, and schedules its first request at the next block.
+func (k Keeper) CreateRequestSubscription(
+	ctx sdk.Context,
+	spec types.RequestSpec,
+	interval uint64,
+	initialDeposit sdk.Coins,
+	owner sdk.AccAddress,
+) (uint64, error) {
+	minInterval := k.GetParams(ctx).MinSubscriptionInterval
+	if minInterval == 0 {
+		return 0, types.ErrSubscriptionDisabled
+	}
+	if interval < minInterval {
+		return 0, types.ErrInvalidSubscriptionInterval.Wrapf("got: %d, min: %d", interval, minInterval)
+	}
+	if !k.HasOracleScript(ctx, spec.GetOracleScriptID()) {
+		return 0, types.ErrOracleScriptNotFound.Wrapf("id: %d", spec.GetOracleScriptID())
+	}
+
+	id := k.GetNextRequestSubscriptionID(ctx)
+	feePayer, err := k.GenerateSubscriptionAccount(ctx, fmt.Sprintf("%d", id))
+	if err != nil {
+		return 0, err
+	}
+
+	if !initialDeposit.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, owner, feePayer, initialDeposit); err != nil {
+			return 0, err
+		}
+	}
+
+	sub := types.NewRequestSubscription(
+		id,
+		owner,
+		feePayer,
+		spec.GetOracleScriptID(),
+		spec.GetCalldata(),
+		spec.GetAskCount(),
+		spec.GetMinCount(),
+		spec.GetClientID(),
+		spec.GetFeeLimit(),
+		spec.GetPrepareGas(),
+		spec.GetExecuteGas(),
+		spec.GetTSSEncoder(),
+		interval,
+		ctx.BlockHeight()+1,
+	)
+	k.SetRequestSubscription(ctx, sub)
+	k.ScheduleRequestSubscription(ctx, sub)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateSubscription,
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyOwner, sub.Owner),
+		sdk.NewAttribute(types.AttributeKeyFeePayer, sub.FeePayer),
+		sdk.NewAttribute(types.AttributeKeyOracleScriptID, fmt.Sprintf("%d", sub.OracleScriptID)),
+		sdk.NewAttribute(types.AttributeKeyInterval, fmt.Sprintf("%d", interval)),
+		sdk.NewAttribute(types.AttributeKeyAmount, initialDeposit.String()),
+	))
+
+	return id, nil
+}
+
+// TopUpRequestSubscription transfers the given amount from the sender to the fee payer account of the request
+// subscription. An inactive subscription is reactivated and spawns its next request at the next block.
+func (k Keeper) TopUpRequestSubscription(ctx sdk.Context, id uint64, amount sdk.Coins, sender sdk.AccAddress) error {
+	sub, err := k.GetRequestSubscription(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	feePayer, err := sdk.AccAddressFromBech32(sub.FeePayer)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, sender, feePayer, amount); err != nil {
+		return err
+	}
+
+	if !sub.IsActive {
+		sub.IsActive = true
+		sub.NextRequestHeight = ctx.BlockHeight() + 1
+		k.SetRequestSubscription(ctx, sub)
+		k.ScheduleRequestSubscription(ctx, sub)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTopUpSubscription,
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+	))
+
+	return nil
+}
+
+// CancelRequestSubscription removes the request subscription and refunds the remaining deposit in its fee
+// payer account to the owner. Requests that were already spawned are not affected.
+func (k Keeper) CancelRequestSubscription(ctx sdk.Context, id uint64, owner sdk.AccAddress) error {
+	sub, err := k.GetRequestSubscription(ctx, id)
+	if err != nil {
+		return err
+	}
+	if sub.Owner != owner.String() {
+		return types.ErrInvalidSubscriptionOwner.Wrapf("expected: %s, got: %s", sub.Owner, owner.String())
+	}
+
+	feePayer, err := sdk.AccAddressFromBech32(sub.FeePayer)
+	if err != nil {
+		return err
+	}
+	refund := k.bankKeeper.GetAllBalances(ctx, feePayer)
+	if !refund.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, feePayer, owner, refund); err != nil {
+			return err
+		}
+	}
+
+	if sub.IsActive {
+		k.UnscheduleRequestSubscription(ctx, sub)
+	}
+	k.DeleteRequestSubscription(ctx, id)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelSubscription,
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+	))
+
+	return nil
+}
+
+// ProcessRequestSubscriptions spawns the requests of the request subscriptions that are due at the current
+// block height, up to the max subscription requests per block. The remaining due subscriptions stay in the
+// queue and are processed in the following blocks.
+func (k Keeper) ProcessRequestSubscriptions(ctx sdk.Context) {
+	limit := k.GetParams(ctx).MaxSubscriptionRequestsPerBlock
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.RequestSubscriptionQueueStoreKeyPrefix,
+		types.RequestSubscriptionQueueStoreKey(ctx.BlockHeight()+1, 0),
+	)
+	var keys [][]byte
+	for ; iterator.Valid() && uint64(len(keys)) < limit; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+
+		_, id := types.SplitRequestSubscriptionQueueStoreKey(key)
+		sub, err := k.GetRequestSubscription(ctx, id)
+		if err != nil {
+			// this should not happen as a subscription is removed from the queue when it is cancelled
+			continue
+		}
+		k.spawnSubscriptionRequest(ctx, sub)
+	}
+}
+
+// spawnSubscriptionRequest charges the subscription base request fee and creates a new request from the
+// template of the request subscription, paid by its fee payer account. The base request fee is kept even if
+// the request cannot be created. The subscription is deactivated if its deposit cannot cover the base request
+// fee or the request cannot be created, and is otherwise scheduled for its next request.
+func (k Keeper) spawnSubscriptionRequest(ctx sdk.Context, sub types.RequestSubscription) {
+	feePayer, err := sdk.AccAddressFromBech32(sub.FeePayer)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := k.deductSubscriptionBaseRequestFee(ctx, feePayer); err != nil {
+		k.deactivateRequestSubscription(ctx, sub, err)
+		return
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	reqID, err := k.PrepareRequest(cacheCtx, &sub, feePayer, nil)
+	if err != nil {
+		k.deactivateRequestSubscription(ctx, sub, err)
+		return
+	}
+	writeFn()
+
+	sub.LastRequestID = reqID
+	sub.RequestCount++
+	sub.NextRequestHeight = ctx.BlockHeight() + int64(sub.Interval)
+	k.SetRequestSubscription(ctx, sub)
+	k.ScheduleRequestSubscription(ctx, sub)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSubscriptionRequest,
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, fmt.Sprintf("%d", sub.ID)),
+		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", reqID)),
+	))
+}
+
+// deductSubscriptionBaseRequestFee sends the subscription base request fee from the fee payer account of a
+// request subscription to the fee collector, where it is distributed with the block rewards.
+func (k Keeper) deductSubscriptionBaseRequestFee(ctx sdk.Context, feePayer sdk.AccAddress) error {
+	fee := k.GetParams(ctx).SubscriptionBaseRequestFee
+	if fee.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, feePayer, k.feeCollectorName, fee); err != nil {
+		return types.ErrInsufficientSubscriptionDeposit.Wrapf("cannot pay base request fee %s: %s", fee, err)
+	}
+
+	return nil
+}
+
+// deactivateRequestSubscription deactivates the request subscription after it failed to spawn a request.
+// It becomes active again on the next top-up.
+func (k Keeper) deactivateRequestSubscription(ctx sdk.Context, sub types.RequestSubscription, reason error) {
+	sub.IsActive = false
+	k.SetRequestSubscription(ctx, sub)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSubscriptionFail,
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, fmt.Sprintf("%d", sub.ID)),
+		sdk.NewAttribute(types.AttributeKeyReason, reason.Error()),
+	))
+}
@@ -0,0 +1,308 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+var subscriptionFeePayer = sdk.AccAddress([]byte("subscription_payer__"))
+
+func (suite *KeeperTestSuite) setRequestSubscription(nextRequestHeight int64) types.RequestSubscription {
+	return suite.setRequestSubscriptionWithID(1, nextRequestHeight)
+}
+
+func (suite *KeeperTestSuite) setRequestSubscriptionWithID(id uint64, nextRequestHeight int64) types.RequestSubscription {
+	sub := types.NewRequestSubscription(
+		id,
+		owner,
+		subscriptionFeePayer,
+		1,
+		basicCalldata,
+		1,
+		1,
+		basicClientID,
+		bandtesting.Coins100band,
+		testDefaultPrepareGas,
+		testDefaultExecuteGas,
+		0,
+		10,
+		nextRequestHeight,
+	)
+	suite.oracleKeeper.SetRequestSubscriptionCount(suite.ctx, id)
+	suite.oracleKeeper.SetRequestSubscription(suite.ctx, sub)
+	suite.oracleKeeper.ScheduleRequestSubscription(suite.ctx, sub)
+	return sub
+}
+
+func (suite *KeeperTestSuite) expectSubscriptionBaseRequestFee() *gomock.Call {
+	return suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(
+		gomock.Any(),
+		subscriptionFeePayer,
+		authtypes.FeeCollectorName,
+		types.DefaultSubscriptionBaseRequestFee,
+	)
+}
+
+func (suite *KeeperTestSuite) TestCreateRequestSubscription() {
+	ctx := suite.ctx.WithBlockHeight(42)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+
+	msg := types.NewMsgCreateRequestSubscription(
+		1, basicCalldata, 1, 1, basicClientID, bandtesting.Coins100band,
+		testDefaultPrepareGas, testDefaultExecuteGas, 0, 10, bandtesting.Coins1000000band, owner,
+	)
+
+	var feePayer sdk.AccAddress
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil)
+	suite.authKeeper.EXPECT().NewAccount(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, acc sdk.AccountI) sdk.AccountI {
+			feePayer = acc.GetAddress()
+			return acc
+		},
+	)
+	suite.authKeeper.EXPECT().SetAccount(gomock.Any(), gomock.Any())
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), owner, gomock.Any(), bandtesting.Coins1000000band)
+
+	id, err := k.CreateRequestSubscription(ctx, msg, msg.Interval, msg.InitialDeposit, owner)
+	require.NoError(err)
+	require.Equal(uint64(1), id)
+	require.Equal(uint64(1), k.GetRequestSubscriptionCount(ctx))
+
+	sub, err := k.GetRequestSubscription(ctx, 1)
+	require.NoError(err)
+	require.Equal(types.NewRequestSubscription(
+		1, owner, feePayer, 1, basicCalldata, 1, 1, basicClientID, bandtesting.Coins100band,
+		testDefaultPrepareGas, testDefaultExecuteGas, 0, 10, 43,
+	), sub)
+}
+
+func (suite *KeeperTestSuite) TestCreateRequestSubscriptionFail() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	msg := types.NewMsgCreateRequestSubscription(
+		1, basicCalldata, 1, 1, basicClientID, bandtesting.Coins100band,
+		testDefaultPrepareGas, testDefaultExecuteGas, 0, 10, bandtesting.Coins1000000band, owner,
+	)
+
+	// the oracle script must exist
+	msg.OracleScriptID = 42
+	_, err := k.CreateRequestSubscription(ctx, msg, 10, msg.InitialDeposit, owner)
+	require.ErrorIs(err, types.ErrOracleScriptNotFound)
+
+	// the interval must not be less than the minimum interval
+	_, err = k.CreateRequestSubscription(ctx, msg, 9, msg.InitialDeposit, owner)
+	require.ErrorIs(err, types.ErrInvalidSubscriptionInterval)
+
+	// subscriptions are disabled if the minimum interval is zero
+	params := k.GetParams(ctx)
+	params.MinSubscriptionInterval = 0
+	require.NoError(k.SetParams(ctx, params))
+	_, err = k.CreateRequestSubscription(ctx, msg, 10, msg.InitialDeposit, owner)
+	require.ErrorIs(err, types.ErrSubscriptionDisabled)
+
+	require.Equal(uint64(0), k.GetRequestSubscriptionCount(ctx))
+}
+
+func (suite *KeeperTestSuite) TestProcessRequestSubscriptions() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx.WithBlockTime(bandtesting.ParseTime(1581589790)).WithBlockHeight(41)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+	suite.setRequestSubscription(42)
+
+	// nothing is spawned before the next request height
+	k.ProcessRequestSubscriptions(ctx)
+	require.Equal(uint64(0), k.GetRequestCount(ctx))
+
+	ctx = ctx.WithBlockHeight(42)
+	suite.rollingseedKeeper.EXPECT().
+		GetRollingSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY"))
+	suite.expectSubscriptionBaseRequestFee()
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), subscriptionFeePayer, types.ModuleName, bandtesting.Coins1band).Times(3)
+
+	k.ProcessRequestSubscriptions(ctx)
+	require.Equal(uint64(1), k.GetRequestCount(ctx))
+	request := k.MustGetRequest(ctx, 1)
+	require.Equal(subscriptionFeePayer.String(), request.Requester)
+	require.Equal(basicClientID, request.ClientID)
+
+	sub, err := k.GetRequestSubscription(ctx, 1)
+	require.NoError(err)
+	require.True(sub.IsActive)
+	require.Equal(types.RequestID(1), sub.LastRequestID)
+	require.Equal(uint64(1), sub.RequestCount)
+	require.Equal(int64(52), sub.NextRequestHeight)
+
+	// the subscription is not spawned again until its next request height
+	k.ProcessRequestSubscriptions(ctx.WithBlockHeight(51))
+	require.Equal(uint64(1), k.GetRequestCount(ctx))
+}
+
+func (suite *KeeperTestSuite) TestProcessRequestSubscriptionsInsufficientDeposit() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx.WithBlockTime(bandtesting.ParseTime(1581589790)).WithBlockHeight(42)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+	suite.setRequestSubscription(42)
+
+	suite.rollingseedKeeper.EXPECT().
+		GetRollingSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY"))
+	suite.expectSubscriptionBaseRequestFee()
+	suite.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), subscriptionFeePayer, types.ModuleName, bandtesting.Coins1band).
+		Return(errors.New("insufficient funds"))
+
+	k.ProcessRequestSubscriptions(ctx)
+	require.Equal(uint64(0), k.GetRequestCount(ctx))
+
+	sub, err := k.GetRequestSubscription(ctx, 1)
+	require.NoError(err)
+	require.False(sub.IsActive)
+	require.Equal(uint64(0), sub.RequestCount)
+
+	// an inactive subscription is not spawned anymore
+	k.ProcessRequestSubscriptions(ctx.WithBlockHeight(100))
+	require.Equal(uint64(0), k.GetRequestCount(ctx))
+
+	// a top-up reactivates the subscription at the next block
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, subscriptionFeePayer, bandtesting.Coins1000000band)
+	err = k.TopUpRequestSubscription(ctx.WithBlockHeight(100), 1, bandtesting.Coins1000000band, alice)
+	require.NoError(err)
+
+	sub, err = k.GetRequestSubscription(ctx, 1)
+	require.NoError(err)
+	require.True(sub.IsActive)
+	require.Equal(int64(101), sub.NextRequestHeight)
+}
+
+func (suite *KeeperTestSuite) TestProcessRequestSubscriptionsInsufficientBaseRequestFee() {
+	ctx := suite.ctx.WithBlockTime(bandtesting.ParseTime(1581589790)).WithBlockHeight(42)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+	suite.setRequestSubscription(42)
+
+	// the request is not prepared if the deposit cannot cover the base request fee
+	suite.expectSubscriptionBaseRequestFee().Return(errors.New("insufficient funds"))
+
+	k.ProcessRequestSubscriptions(ctx)
+	require.Equal(uint64(0), k.GetRequestCount(ctx))
+
+	sub, err := k.GetRequestSubscription(ctx, 1)
+	require.NoError(err)
+	require.False(sub.IsActive)
+	require.Equal(uint64(0), sub.RequestCount)
+}
+
+func (suite *KeeperTestSuite) TestProcessRequestSubscriptionsPerBlockLimit() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx.WithBlockTime(bandtesting.ParseTime(1581589790)).WithBlockHeight(42)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	params := k.GetParams(ctx)
+	params.MaxSubscriptionRequestsPerBlock = 1
+	require.NoError(k.SetParams(ctx, params))
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+	suite.setRequestSubscriptionWithID(1, 42)
+	suite.setRequestSubscriptionWithID(2, 42)
+
+	suite.rollingseedKeeper.EXPECT().
+		GetRollingSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY")).
+		Times(2)
+	suite.expectSubscriptionBaseRequestFee().Times(2)
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), subscriptionFeePayer, types.ModuleName, bandtesting.Coins1band).Times(6)
+
+	// only one of the due subscriptions is spawned in a block
+	k.ProcessRequestSubscriptions(ctx)
+	require.Equal(uint64(1), k.GetRequestCount(ctx))
+
+	sub, err := k.GetRequestSubscription(ctx, 2)
+	require.NoError(err)
+	require.Equal(uint64(0), sub.RequestCount)
+
+	// the other one is carried over to the next block
+	k.ProcessRequestSubscriptions(ctx.WithBlockHeight(43))
+	require.Equal(uint64(2), k.GetRequestCount(ctx))
+
+	sub, err = k.GetRequestSubscription(ctx, 2)
+	require.NoError(err)
+	require.Equal(uint64(1), sub.RequestCount)
+	require.Equal(int64(53), sub.NextRequestHeight)
+}
+
+func (suite *KeeperTestSuite) TestTopUpRequestSubscriptionNotFound() {
+	err := suite.oracleKeeper.TopUpRequestSubscription(suite.ctx, 1, bandtesting.Coins1000000band, alice)
+	suite.Require().ErrorIs(err, types.ErrSubscriptionNotFound)
+}
+
+func (suite *KeeperTestSuite) TestCancelRequestSubscription() {
+	ctx := suite.ctx.WithBlockHeight(42)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.setRequestSubscription(42)
+
+	// only the owner can cancel the subscription
+	err := k.CancelRequestSubscription(ctx, 1, alice)
+	require.ErrorIs(err, types.ErrInvalidSubscriptionOwner)
+
+	suite.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), subscriptionFeePayer).Return(bandtesting.Coins1000000band)
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), subscriptionFeePayer, owner, bandtesting.Coins1000000band)
+
+	err = k.CancelRequestSubscription(ctx, 1, owner)
+	require.NoError(err)
+
+	_, err = k.GetRequestSubscription(ctx, 1)
+	require.ErrorIs(err, types.ErrSubscriptionNotFound)
+
+	// the cancelled subscription is removed from the queue
+	k.ProcessRequestSubscriptions(ctx)
+	require.Equal(uint64(0), k.GetRequestCount(ctx))
+}
+
+func (suite *KeeperTestSuite) TestQueryRequestSubscriptions() {
+	ctx := suite.ctx
+	querier := suite.queryClient
+	require := suite.Require()
+
+	sub := suite.setRequestSubscription(42)
+
+	suite.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), subscriptionFeePayer).Return(bandtesting.Coins1000000band)
+	res, err := querier.RequestSubscription(ctx, &types.QueryRequestSubscriptionRequest{SubscriptionId: 1})
+	require.NoError(err)
+	require.Equal(sub, res.Subscription)
+	require.Equal(bandtesting.Coins1000000band, res.Balance)
+
+	_, err = querier.RequestSubscription(ctx, &types.QueryRequestSubscriptionRequest{SubscriptionId: 2})
+	require.ErrorContains(err, "request subscription not found")
+
+	resAll, err := querier.RequestSubscriptions(ctx, &types.QueryRequestSubscriptionsRequest{})
+	require.NoError(err)
+	require.Equal([]types.RequestSubscription{sub}, resAll.Subscriptions)
+}
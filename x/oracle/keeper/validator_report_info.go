@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// GetValidatorReportInfo returns the report info of the given validator. The report info is empty if the
+// validator has never been assigned to a request.
+func (k Keeper) GetValidatorReportInfo(ctx sdk.Context, val sdk.ValAddress) types.ValidatorReportInfo {
+	bz := ctx.KVStore(k.storeKey).Get(types.ValidatorReportInfoStoreKey(val))
+	if bz == nil {
+		return types.NewValidatorReportInfo(val.String(), 0, 0, 0, 0, 0)
+	}
+	var info types.ValidatorReportInfo
+	k.cdc.MustUnmarshal(bz, &info)
+	return info
+}
+
+// SetValidatorReportInfo sets the report info of the given validator.
+func (k Keeper) SetValidatorReportInfo(ctx sdk.Context, val sdk.ValAddress, info types.ValidatorReportInfo) {
+	ctx.KVStore(k.storeKey).Set(types.ValidatorReportInfoStoreKey(val), k.cdc.MustMarshal(&info))
+}
+
+// GetMissedReport returns whether the validator missed the report at the given index of its report window.
+func (k Keeper) GetMissedReport(ctx sdk.Context, val sdk.ValAddress, index uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MissedReportStoreKey(val, index))
+}
+
+// SetMissedReport sets whether the validator missed the report at the given index of its report window.
+func (k Keeper) SetMissedReport(ctx sdk.Context, val sdk.ValAddress, index uint64, missed bool) {
+	store := ctx.KVStore(k.storeKey)
+	if missed {
+		store.Set(types.MissedReportStoreKey(val, index), []byte{0x01})
+	} else {
+		store.Delete(types.MissedReportStoreKey(val, index))
+	}
+}
+
+// resetReportWindow clears the report window of the given validator, keeping its total counts.
+func (k Keeper) resetReportWindow(ctx sdk.Context, val sdk.ValAddress, info *types.ValidatorReportInfo, windowSize uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.MissedReportsPrefixKey(val))
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	info.WindowSize = windowSize
+	info.IndexOffset = 0
+	info.MissedReportsCounter = 0
+}
+
+// recordReport records whether the validator missed the report of its latest assignment in its report window
+// and returns the updated report info. The window is reset if the report window size has changed.
+func (k Keeper) recordReport(
+	ctx sdk.Context,
+	val sdk.ValAddress,
+	missed bool,
+	windowSize uint64,
+) types.ValidatorReportInfo {
+	info := k.GetValidatorReportInfo(ctx, val)
+	if info.WindowSize != windowSize {
+		k.resetReportWindow(ctx, val, &info, windowSize)
+	}
+
+	index := info.IndexOffset % windowSize
+	previouslyMissed := k.GetMissedReport(ctx, val, index)
+	switch {
+	case !previouslyMissed && missed:
+		k.SetMissedReport(ctx, val, index, true)
+		info.MissedReportsCounter++
+	case previouslyMissed && !missed:
+		k.SetMissedReport(ctx, val, index, false)
+		info.MissedReportsCounter--
+	}
+
+	info.IndexOffset++
+	if missed {
+		info.TotalMissedReports++
+	} else {
+		info.TotalReports++
+	}
+
+	k.SetValidatorReportInfo(ctx, val, info)
+	return info
+}
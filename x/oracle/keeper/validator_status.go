@@ -127,15 +127,45 @@ func (k Keeper) Activate(ctx sdk.Context, val sdk.ValAddress) error {
 	return nil
 }
 
-// MissReport changes the given validator's status to inactive. No-op if already inactive or
-// if the validator was active after the time the request happened.
+// MissReport records a missed report of the given validator in its report window and changes its status
+// to inactive if it has missed more reports in the window than allowed. No-op if already inactive or if the
+// validator was active after the time the request happened.
 func (k Keeper) MissReport(ctx sdk.Context, val sdk.ValAddress, requestTime time.Time) {
 	status := k.GetValidatorStatus(ctx, val)
-	if status.IsActive && status.Since.Before(requestTime) {
-		k.SetValidatorStatus(ctx, val, types.NewValidatorStatus(false, ctx.BlockHeader().Time))
-		ctx.EventManager().EmitEvent(sdk.NewEvent(
-			types.EventTypeDeactivate,
-			sdk.NewAttribute(types.AttributeKeyValidator, val.String()),
-		))
+	if !status.IsActive || !status.Since.Before(requestTime) {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	if params.ReportWindowSize > 0 {
+		info := k.recordReport(ctx, val, true, params.ReportWindowSize)
+		maxMissedReports := params.ReportWindowSize * params.MaxMissedReportPercentage / 100
+		if info.MissedReportsCounter <= maxMissedReports {
+			return
+		}
+
+		// the window is reset so that the validator starts with a clean window once it is activated again
+		k.resetReportWindow(ctx, val, &info, params.ReportWindowSize)
+		k.SetValidatorReportInfo(ctx, val, info)
+	}
+
+	k.SetValidatorStatus(ctx, val, types.NewValidatorStatus(false, ctx.BlockHeader().Time))
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDeactivate,
+		sdk.NewAttribute(types.AttributeKeyValidator, val.String()),
+	))
+}
+
+// FulfillReport records a fulfilled report of the given validator in its report window. No-op if the validator
+// is inactive or if it was active after the time the request happened.
+func (k Keeper) FulfillReport(ctx sdk.Context, val sdk.ValAddress, requestTime time.Time) {
+	status := k.GetValidatorStatus(ctx, val)
+	if !status.IsActive || !status.Since.Before(requestTime) {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	if params.ReportWindowSize > 0 {
+		k.recordReport(ctx, val, false, params.ReportWindowSize)
 	}
 }
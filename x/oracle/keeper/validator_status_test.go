@@ -273,3 +273,117 @@ func (suite *KeeperTestSuite) TestMissReportAlreadyInactiveNoop() {
 	vs := k.GetValidatorStatus(ctx, validators[0].Address)
 	require.Equal(types.NewValidatorStatus(false, now), vs)
 }
+
+func (suite *KeeperTestSuite) setReportWindowParams(windowSize, maxMissedReportPercentage uint64) {
+	params := suite.oracleKeeper.GetParams(suite.ctx)
+	params.ReportWindowSize = windowSize
+	params.MaxMissedReportPercentage = maxMissedReportPercentage
+	suite.Require().NoError(suite.oracleKeeper.SetParams(suite.ctx, params))
+}
+
+func (suite *KeeperTestSuite) TestMissReportWithinAllowedMisses() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	// 4 reports can be missed in a window of 10 reports
+	suite.setReportWindowParams(10, 40)
+
+	now := time.Now().UTC()
+	next := now.Add(time.Duration(10))
+	k.SetValidatorStatus(ctx, validators[0].Address, types.NewValidatorStatus(true, now))
+	for i := 0; i < 4; i++ {
+		k.MissReport(ctx.WithBlockTime(next), validators[0].Address, next)
+	}
+	require.Equal(types.NewValidatorStatus(true, now), k.GetValidatorStatus(ctx, validators[0].Address))
+	require.Equal(
+		types.NewValidatorReportInfo(validators[0].Address.String(), 10, 4, 4, 0, 4),
+		k.GetValidatorReportInfo(ctx, validators[0].Address),
+	)
+
+	// the fifth missed report exceeds the allowed misses and resets the window
+	k.MissReport(ctx.WithBlockTime(next), validators[0].Address, next)
+	require.Equal(types.NewValidatorStatus(false, next), k.GetValidatorStatus(ctx, validators[0].Address))
+	require.Equal(
+		types.NewValidatorReportInfo(validators[0].Address.String(), 10, 0, 0, 0, 5),
+		k.GetValidatorReportInfo(ctx, validators[0].Address),
+	)
+	for i := uint64(0); i < 10; i++ {
+		require.False(k.GetMissedReport(ctx, validators[0].Address, i))
+	}
+}
+
+func (suite *KeeperTestSuite) TestMissReportSlidingWindow() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	// 1 report can be missed in a window of 4 reports
+	suite.setReportWindowParams(4, 25)
+
+	now := time.Now().UTC()
+	next := now.Add(time.Duration(10))
+	ctx = ctx.WithBlockTime(next)
+	k.SetValidatorStatus(ctx, validators[0].Address, types.NewValidatorStatus(true, now))
+
+	// the first missed report slides out of the window before the second one
+	k.MissReport(ctx, validators[0].Address, next)
+	k.FulfillReport(ctx, validators[0].Address, next)
+	k.FulfillReport(ctx, validators[0].Address, next)
+	k.FulfillReport(ctx, validators[0].Address, next)
+	k.MissReport(ctx, validators[0].Address, next)
+	require.True(k.GetValidatorStatus(ctx, validators[0].Address).IsActive)
+	require.Equal(
+		types.NewValidatorReportInfo(validators[0].Address.String(), 4, 5, 1, 3, 2),
+		k.GetValidatorReportInfo(ctx, validators[0].Address),
+	)
+
+	// the second missed report is still in the window
+	k.FulfillReport(ctx, validators[0].Address, next)
+	k.MissReport(ctx, validators[0].Address, next)
+	require.False(k.GetValidatorStatus(ctx, validators[0].Address).IsActive)
+}
+
+func (suite *KeeperTestSuite) TestMissReportWindowSizeChanged() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.setReportWindowParams(10, 50)
+
+	now := time.Now().UTC()
+	next := now.Add(time.Duration(10))
+	ctx = ctx.WithBlockTime(next)
+	k.SetValidatorStatus(ctx, validators[0].Address, types.NewValidatorStatus(true, now))
+	k.MissReport(ctx, validators[0].Address, next)
+	k.MissReport(ctx, validators[0].Address, next)
+
+	// the window is reset at the new window size
+	suite.setReportWindowParams(20, 50)
+	k.FulfillReport(ctx, validators[0].Address, next)
+	require.Equal(
+		types.NewValidatorReportInfo(validators[0].Address.String(), 20, 1, 0, 1, 2),
+		k.GetValidatorReportInfo(ctx, validators[0].Address),
+	)
+	require.False(k.GetMissedReport(ctx, validators[0].Address, 0))
+	require.False(k.GetMissedReport(ctx, validators[0].Address, 1))
+}
+
+func (suite *KeeperTestSuite) TestMissReportWithoutWindow() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.setReportWindowParams(0, 50)
+
+	now := time.Now().UTC()
+	next := now.Add(time.Duration(10))
+	k.SetValidatorStatus(ctx, validators[0].Address, types.NewValidatorStatus(true, now))
+	k.FulfillReport(ctx.WithBlockTime(next), validators[0].Address, next)
+	k.MissReport(ctx.WithBlockTime(next), validators[0].Address, next)
+	require.Equal(types.NewValidatorStatus(false, next), k.GetValidatorStatus(ctx, validators[0].Address))
+	require.Equal(
+		types.NewValidatorReportInfo(validators[0].Address.String(), 0, 0, 0, 0, 0),
+		k.GetValidatorReportInfo(ctx, validators[0].Address),
+	)
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// SetDataSourceVersion saves the given data source version to the storage.
+func (k Keeper) SetDataSourceVersion(ctx sdk.Context, version types.DataSourceVersion) {
+	ctx.KVStore(k.storeKey).Set(
+		types.DataSourceVersionStoreKey(version.DataSourceID, version.Version),
+		k.cdc.MustMarshal(&version),
+	)
+}
+
+// GetDataSourceVersion returns the given version of a data source or error if not exists.
+func (k Keeper) GetDataSourceVersion(
+	ctx sdk.Context,
+	id types.DataSourceID,
+	version uint64,
+) (types.DataSourceVersion, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.DataSourceVersionStoreKey(id, version))
+	if bz == nil {
+		return types.DataSourceVersion{}, types.ErrDataSourceVersionNotFound.Wrapf("id: %d, version: %d", id, version)
+	}
+	var dsVersion types.DataSourceVersion
+	k.cdc.MustUnmarshal(bz, &dsVersion)
+	return dsVersion, nil
+}
+
+// GetLatestDataSourceVersion returns the latest version of a data source or error if it has no versions.
+func (k Keeper) GetLatestDataSourceVersion(ctx sdk.Context, id types.DataSourceID) (types.DataSourceVersion, error) {
+	iterator := storetypes.KVStoreReversePrefixIterator(
+		ctx.KVStore(k.storeKey),
+		types.DataSourceVersionsPrefixKey(id),
+	)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.DataSourceVersion{}, types.ErrDataSourceVersionNotFound.Wrapf("id: %d", id)
+	}
+	var dsVersion types.DataSourceVersion
+	k.cdc.MustUnmarshal(iterator.Value(), &dsVersion)
+	return dsVersion, nil
+}
+
+// GetAllDataSourceVersions returns the version histories of all data sources in the store.
+func (k Keeper) GetAllDataSourceVersions(ctx sdk.Context) (versions []types.DataSourceVersion) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DataSourceVersionStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var dsVersion types.DataSourceVersion
+		k.cdc.MustUnmarshal(iterator.Value(), &dsVersion)
+		versions = append(versions, dsVersion)
+	}
+	return versions
+}
+
+// addDataSourceVersion records the current state of a data source as its next version.
+func (k Keeper) addDataSourceVersion(
+	ctx sdk.Context,
+	id types.DataSourceID,
+	dataSource types.DataSource,
+	editor string,
+) {
+	next := uint64(1)
+	if latest, err := k.GetLatestDataSourceVersion(ctx, id); err == nil {
+		next = latest.Version + 1
+	}
+	k.SetDataSourceVersion(ctx, types.NewDataSourceVersion(id, next, dataSource, editor, ctx.BlockHeight()))
+}
+
+// SetOracleScriptVersion saves the given oracle script version to the storage.
+func (k Keeper) SetOracleScriptVersion(ctx sdk.Context, version types.OracleScriptVersion) {
+	ctx.KVStore(k.storeKey).Set(
+		types.OracleScriptVersionStoreKey(version.OracleScriptID, version.Version),
+		k.cdc.MustMarshal(&version),
+	)
+}
+
+// GetOracleScriptVersion returns the given version of an oracle script or error if not exists.
+func (k Keeper) GetOracleScriptVersion(
+	ctx sdk.Context,
+	id types.OracleScriptID,
+	version uint64,
+) (types.OracleScriptVersion, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.OracleScriptVersionStoreKey(id, version))
+	if bz == nil {
+		return types.OracleScriptVersion{}, types.ErrOracleScriptVersionNotFound.Wrapf(
+			"id: %d, version: %d",
+			id,
+			version,
+		)
+	}
+	var osVersion types.OracleScriptVersion
+	k.cdc.MustUnmarshal(bz, &osVersion)
+	return osVersion, nil
+}
+
+// GetLatestOracleScriptVersion returns the latest version of an oracle script or error if it has no versions.
+func (k Keeper) GetLatestOracleScriptVersion(
+	ctx sdk.Context,
+	id types.OracleScriptID,
+) (types.OracleScriptVersion, error) {
+	iterator := storetypes.KVStoreReversePrefixIterator(
+		ctx.KVStore(k.storeKey),
+		types.OracleScriptVersionsPrefixKey(id),
+	)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.OracleScriptVersion{}, types.ErrOracleScriptVersionNotFound.Wrapf("id: %d", id)
+	}
+	var osVersion types.OracleScriptVersion
+	k.cdc.MustUnmarshal(iterator.Value(), &osVersion)
+	return osVersion, nil
+}
+
+// GetAllOracleScriptVersions returns the version histories of all oracle scripts in the store.
+func (k Keeper) GetAllOracleScriptVersions(ctx sdk.Context) (versions []types.OracleScriptVersion) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.OracleScriptVersionStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var osVersion types.OracleScriptVersion
+		k.cdc.MustUnmarshal(iterator.Value(), &osVersion)
+		versions = append(versions, osVersion)
+	}
+	return versions
+}
+
+// addOracleScriptVersion records the current state of an oracle script as its next version.
+func (k Keeper) addOracleScriptVersion(
+	ctx sdk.Context,
+	id types.OracleScriptID,
+	oracleScript types.OracleScript,
+	editor string,
+) {
+	next := uint64(1)
+	if latest, err := k.GetLatestOracleScriptVersion(ctx, id); err == nil {
+		next = latest.Version + 1
+	}
+	k.SetOracleScriptVersion(ctx, types.NewOracleScriptVersion(id, next, oracleScript, editor, ctx.BlockHeight()))
+}
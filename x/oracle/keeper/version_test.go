@@ -0,0 +1,226 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func (suite *KeeperTestSuite) TestDataSourceVersions() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	dataSource := types.NewDataSource(owner, "name", "desc", "filename1", bandtesting.Coins1band, treasury)
+	id := k.AddDataSource(ctx, dataSource)
+
+	v1, err := k.GetLatestDataSourceVersion(ctx, id)
+	require.NoError(err)
+	require.Equal(types.NewDataSourceVersion(id, 1, dataSource, owner.String(), 10), v1)
+
+	// an edit by the owner that also transfers the ownership is recorded as a new version
+	ctx = ctx.WithBlockHeight(20)
+	k.MustEditDataSource(ctx, id, types.NewDataSource(
+		alice, types.DoNotModify, types.DoNotModify, "filename2", bandtesting.Coins100band, treasury,
+	))
+
+	v2, err := k.GetLatestDataSourceVersion(ctx, id)
+	require.NoError(err)
+	require.Equal(uint64(2), v2.Version)
+	require.Equal("filename2", v2.Filename)
+	require.Equal(bandtesting.Coins100band, v2.Fee)
+	require.Equal(owner.String(), v2.Editor)
+	require.Equal(int64(20), v2.Height)
+
+	// the previous version is kept as is
+	version, err := k.GetDataSourceVersion(ctx, id, 1)
+	require.NoError(err)
+	require.Equal(v1, version)
+
+	_, err = k.GetDataSourceVersion(ctx, id, 3)
+	require.ErrorIs(err, types.ErrDataSourceVersionNotFound)
+}
+
+func (suite *KeeperTestSuite) TestOracleScriptVersions() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	oracleScript := types.NewOracleScript(owner, "name", "desc", "filename1", "schema1", "url1")
+	id := k.AddOracleScript(ctx, oracleScript)
+
+	ctx = ctx.WithBlockHeight(20)
+	k.MustEditOracleScript(ctx, id, types.NewOracleScript(
+		owner, types.DoNotModify, types.DoNotModify, "filename2", "schema2", types.DoNotModify,
+	))
+
+	v1, err := k.GetOracleScriptVersion(ctx, id, 1)
+	require.NoError(err)
+	require.Equal(types.NewOracleScriptVersion(id, 1, oracleScript, owner.String(), 10), v1)
+
+	v2, err := k.GetLatestOracleScriptVersion(ctx, id)
+	require.NoError(err)
+	require.Equal(types.OracleScriptVersion{
+		OracleScriptID: id,
+		Version:        2,
+		Filename:       "filename2",
+		Schema:         "schema2",
+		SourceCodeURL:  "url1",
+		Editor:         owner.String(),
+		Height:         20,
+	}, v2)
+
+	_, err = k.GetOracleScriptVersion(ctx, id, 3)
+	require.ErrorIs(err, types.ErrOracleScriptVersionNotFound)
+}
+
+func (suite *KeeperTestSuite) TestPrepareRequestPinnedVersions() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx.WithBlockTime(bandtesting.ParseTime(1581589790)).WithBlockHeight(42)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+
+	// create the second versions of the oracle script and the first two data sources
+	k.MustEditOracleScript(ctx, 1, types.NewOracleScript(
+		owner, types.DoNotModify, types.DoNotModify, "new_filename", types.DoNotModify, types.DoNotModify,
+	))
+	for i := 1; i <= 2; i++ {
+		k.MustEditDataSource(ctx, types.DataSourceID(i), types.NewDataSource(
+			owner, types.DoNotModify, types.DoNotModify, "new_filename", bandtesting.Coins1band, treasury,
+		))
+	}
+
+	msg := types.NewMsgRequestData(
+		1, basicCalldata, 1, 1, basicClientID, bandtesting.Coins100band,
+		testDefaultPrepareGas, testDefaultExecuteGas, alice, 0,
+	)
+	msg.OracleScriptVersion = 1
+	msg.DataSourceVersions = []types.DataSourceVersionPin{types.NewDataSourceVersionPin(2, 1)}
+
+	suite.rollingseedKeeper.EXPECT().
+		GetRollingSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY")).
+		Times(2)
+	suite.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), alice, types.ModuleName, bandtesting.Coins1band).
+		Times(3)
+
+	id, err := k.PrepareRequest(ctx, msg, alice, nil)
+	require.NoError(err)
+
+	request := k.MustGetRequest(ctx, id)
+	require.Equal(uint64(1), request.OracleScriptVersion)
+	require.Equal([]uint64{2, 1, 1}, []uint64{
+		request.RawRequests[0].DataSourceVersion,
+		request.RawRequests[1].DataSourceVersion,
+		request.RawRequests[2].DataSourceVersion,
+	})
+
+	// the raw request event refers to the executable of the pinned version
+	dsVersion, err := k.GetDataSourceVersion(ctx, 2, 1)
+	require.NoError(err)
+	var hashes []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeRawRequest {
+			continue
+		}
+		attr, ok := event.GetAttribute(types.AttributeKeyDataSourceHash)
+		require.True(ok)
+		hashes = append(hashes, attr.Value)
+	}
+	require.Equal("new_filename", hashes[0])
+	require.Equal(dsVersion.Filename, hashes[1])
+
+	// the pinned version must exist
+	msg.OracleScriptVersion = 3
+	_, err = k.PrepareRequest(ctx, msg, alice, nil)
+	require.ErrorIs(err, types.ErrOracleScriptVersionNotFound)
+}
+
+func (suite *KeeperTestSuite) TestPrepareRequestPinnedDataSourceFee() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx.WithBlockTime(bandtesting.ParseTime(1581589790)).WithBlockHeight(42)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+
+	// the second version of data source 2 charges a higher fee to a new treasury
+	k.MustEditDataSource(ctx, 2, types.NewDataSource(
+		owner, types.DoNotModify, types.DoNotModify, types.DoNotModify, bandtesting.Coins100band, bob,
+	))
+
+	msg := types.NewMsgRequestData(
+		1, basicCalldata, 1, 1, basicClientID, bandtesting.Coins100band,
+		testDefaultPrepareGas, testDefaultExecuteGas, alice, 0,
+	)
+	msg.DataSourceVersions = []types.DataSourceVersionPin{types.NewDataSourceVersionPin(2, 1)}
+
+	suite.rollingseedKeeper.EXPECT().
+		GetRollingSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY"))
+	// the pinned version charges its own fee rather than the fee of the latest version
+	suite.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), alice, types.ModuleName, bandtesting.Coins1band).
+		Times(3)
+
+	id, err := k.PrepareRequest(ctx, msg, alice, nil)
+	require.NoError(err)
+
+	threeBand := sdk.NewCoins(sdk.NewInt64Coin("uband", 3000000))
+	escrow, found := k.GetFeeEscrow(ctx, id)
+	require.True(found)
+	require.Equal(threeBand, escrow.Total)
+	require.Equal([]types.TreasuryFee{types.NewTreasuryFee(treasury, threeBand)}, escrow.TreasuryFees)
+
+	// the raw request event reports the fee of the pinned version
+	var fees []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeRawRequest {
+			continue
+		}
+		attr, ok := event.GetAttribute(types.AttributeKeyFee)
+		require.True(ok)
+		fees = append(fees, attr.Value)
+	}
+	require.Equal([]string{"1000000uband", "1000000uband", "1000000uband"}, fees)
+
+	// the fee of the report is paid to the treasury of the pinned version
+	suite.bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), authtypes.NewModuleAddress(types.ModuleName), treasury, threeBand).
+		Return(nil)
+	require.NoError(k.ReleaseReportFee(ctx, id, validators[0].Address))
+}
+
+func (suite *KeeperTestSuite) TestQueryDataSourceVersions() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	querier := suite.queryClient
+	require := suite.Require()
+
+	k.MustEditDataSource(ctx, 1, types.NewDataSource(
+		owner, types.DoNotModify, types.DoNotModify, "new_filename", sdk.NewCoins(), treasury,
+	))
+
+	res, err := querier.DataSourceVersions(ctx, &types.QueryDataSourceVersionsRequest{DataSourceId: 1})
+	require.NoError(err)
+	require.Len(res.Versions, 2)
+	require.Equal(uint64(1), res.Versions[0].Version)
+	require.Equal(uint64(2), res.Versions[1].Version)
+	require.Equal("new_filename", res.Versions[1].Filename)
+
+	_, err = querier.DataSourceVersions(ctx, &types.QueryDataSourceVersionsRequest{DataSourceId: 999})
+	require.ErrorContains(err, "data source not found")
+
+	resOS, err := querier.OracleScriptVersions(ctx, &types.QueryOracleScriptVersionsRequest{OracleScriptId: 1})
+	require.NoError(err)
+	require.Len(resOS.Versions, 1)
+}
@@ -0,0 +1,44 @@
+package v3
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// Migrate migrates the x/oracle module state from the consensus version 2 to
+// version 3. Specifically, it records the current state of every existing data
+// source and oracle script as their first versions, edited by their owners at
+// the upgrade height.
+func Migrate(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	dsIterator := storetypes.KVStorePrefixIterator(store, types.DataSourceStoreKeyPrefix)
+	defer dsIterator.Close()
+	for ; dsIterator.Valid(); dsIterator.Next() {
+		var dataSource types.DataSource
+		if err := cdc.Unmarshal(dsIterator.Value(), &dataSource); err != nil {
+			return err
+		}
+
+		id := types.DataSourceID(sdk.BigEndianToUint64(dsIterator.Key()[1:]))
+		version := types.NewDataSourceVersion(id, 1, dataSource, dataSource.Owner, ctx.BlockHeight())
+		store.Set(types.DataSourceVersionStoreKey(id, 1), cdc.MustMarshal(&version))
+	}
+
+	osIterator := storetypes.KVStorePrefixIterator(store, types.OracleScriptStoreKeyPrefix)
+	defer osIterator.Close()
+	for ; osIterator.Valid(); osIterator.Next() {
+		var oracleScript types.OracleScript
+		if err := cdc.Unmarshal(osIterator.Value(), &oracleScript); err != nil {
+			return err
+		}
+
+		id := types.OracleScriptID(sdk.BigEndianToUint64(osIterator.Key()[1:]))
+		version := types.NewOracleScriptVersion(id, 1, oracleScript, oracleScript.Owner, ctx.BlockHeight())
+		store.Set(types.OracleScriptVersionStoreKey(id, 1), cdc.MustMarshal(&version))
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/bandprotocol/chain/v3/x/oracle"
+	v3 "github.com/bandprotocol/chain/v3/x/oracle/migrations/v3"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(oracle.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey).WithBlockHeight(100)
+	store := ctx.KVStore(storeKey)
+
+	owner := sdk.AccAddress([]byte("owner_______________"))
+	treasury := sdk.AccAddress([]byte("treasury____________"))
+	dataSource := types.NewDataSource(owner, "ds", "desc", "ds_file", sdk.NewCoins(sdk.NewInt64Coin("uband", 10)), treasury)
+	oracleScript := types.NewOracleScript(owner, "os", "desc", "os_file", "schema", "url")
+	store.Set(types.DataSourceStoreKey(3), cdc.MustMarshal(&dataSource))
+	store.Set(types.OracleScriptStoreKey(5), cdc.MustMarshal(&oracleScript))
+
+	require.NoError(t, v3.Migrate(ctx, store, cdc))
+
+	var dsVersion types.DataSourceVersion
+	require.NoError(t, cdc.Unmarshal(store.Get(types.DataSourceVersionStoreKey(3, 1)), &dsVersion))
+	require.Equal(t, types.NewDataSourceVersion(3, 1, dataSource, owner.String(), 100), dsVersion)
+
+	var osVersion types.OracleScriptVersion
+	require.NoError(t, cdc.Unmarshal(store.Get(types.OracleScriptVersionStoreKey(5, 1)), &osVersion))
+	require.Equal(t, types.NewOracleScriptVersion(5, 1, oracleScript, owner.String(), 100), osVersion)
+}
@@ -147,9 +147,21 @@ func RandomizedGenState(simState *module.SimulationState) {
 			oracleRewardPercentage,
 			inactivePenaltyDuration,
 			ibcRequestEnabled,
+			types.DefaultMaxLatestResultIndexSize,
+			types.DefaultPriceOracleScriptID,
+			types.DefaultReportWindowSize,
+			types.DefaultMaxMissedReportPercentage,
+			types.DefaultMaxCallbackGasLimit,
+			types.DefaultMinSubscriptionInterval,
+			types.DefaultSubscriptionBaseRequestFee,
+			types.DefaultMaxSubscriptionRequestsPerBlock,
 		),
 		[]types.DataSource{},
 		[]types.OracleScript{},
+		0,
+		[]types.RequestSubscription{},
+		[]types.DataSourceVersion{},
+		[]types.OracleScriptVersion{},
 	)
 
 	bz, err := json.MarshalIndent(&oracleGenesis, "", " ")
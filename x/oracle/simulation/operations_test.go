@@ -100,8 +100,14 @@ func (suite *SimTestSuite) TestSimulateMsgRequestData() {
 	// Prepare data sources for request
 	for i := 1; i <= 3; i++ {
 		ds, _ := suite.app.OracleKeeper.GetDataSource(suite.ctx, types.DataSourceID(i))
-		ds.Fee = sdk.NewCoins()
-		suite.app.OracleKeeper.SetDataSource(suite.ctx, types.DataSourceID(i), ds)
+		suite.app.OracleKeeper.MustEditDataSource(suite.ctx, types.DataSourceID(i), types.NewDataSource(
+			sdk.MustAccAddressFromBech32(ds.Owner),
+			types.DoNotModify,
+			types.DoNotModify,
+			types.DoNotModify,
+			sdk.NewCoins(),
+			sdk.MustAccAddressFromBech32(ds.Treasury),
+		))
 	}
 	// Prepare active validators
 	err := suite.app.StakingKeeper.IterateBondedValidatorsByPower(suite.ctx,
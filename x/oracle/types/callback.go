@@ -0,0 +1,93 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CallbackRouter is a struct that holds a map of OracleCallbackHooks objects for each callback target.
+type CallbackRouter struct {
+	routes map[string]OracleCallbackHooks
+	sealed bool
+}
+
+// NewCallbackRouter creates a new CallbackRouter instance.
+func NewCallbackRouter() *CallbackRouter {
+	return &CallbackRouter{
+		routes: make(map[string]OracleCallbackHooks),
+	}
+}
+
+// Seal seals the CallbackRouter which prohibits any subsequent OracleCallbackHooks to be added.
+// Seal will panic if called more than once.
+func (cbr *CallbackRouter) Seal() {
+	if cbr.sealed {
+		panic(errors.New("callback router is already sealed"))
+	}
+	cbr.sealed = true
+}
+
+// Sealed returns whether the CallbackRouter can be changed or not.
+func (cbr CallbackRouter) Sealed() bool {
+	return cbr.sealed
+}
+
+// AddRoute adds OracleCallbackHooks for a given callback target. It returns the CallbackRouter
+// so that the function can be chained. It will panic if the CallbackRouter is sealed.
+func (cbr *CallbackRouter) AddRoute(target string, hooks OracleCallbackHooks) *CallbackRouter {
+	if cbr.sealed {
+		panic(fmt.Errorf("callback router sealed; cannot register %s route hooks", target))
+	}
+	if !sdk.IsAlphaNumeric(target) {
+		panic(errors.New("callback route expressions can only contain alphanumeric characters"))
+	}
+	if cbr.HasRoute(target) {
+		panic(fmt.Errorf("route %s has already been registered", target))
+	}
+
+	cbr.routes[target] = hooks
+	return cbr
+}
+
+// HasRoute returns whether the given callback target is registered.
+func (cbr *CallbackRouter) HasRoute(target string) bool {
+	_, ok := cbr.routes[target]
+	return ok
+}
+
+// GetRoute returns an OracleCallbackHooks for a given callback target.
+func (cbr *CallbackRouter) GetRoute(target string) (OracleCallbackHooks, bool) {
+	if !cbr.HasRoute(target) {
+		return nil, false
+	}
+	return cbr.routes[target], true
+}
+
+// OracleCallbackHooks defines the expected interface for a module that registered in the
+// callbackRouter to receive the responses of requests that name it as their callback target.
+type OracleCallbackHooks interface {
+	// Must be called in EndBlock when a request with a callback to the module is resolved.
+	// The state changes are discarded if it returns an error.
+	OnOracleResponse(ctx sdk.Context, packet OracleResponsePacketData) error
+}
+
+// NewRequestCallback creates a new RequestCallback instance.
+func NewRequestCallback(target string, gasLimit uint64) *RequestCallback {
+	return &RequestCallback{
+		Target:   target,
+		GasLimit: gasLimit,
+	}
+}
+
+// Validate validates the callback of a request.
+func (c RequestCallback) Validate() error {
+	if !sdk.IsAlphaNumeric(c.Target) {
+		return ErrInvalidCallback.Wrapf("invalid target: %s", c.Target)
+	}
+	if c.GasLimit == 0 {
+		return ErrInvalidCallback.Wrap("gas limit must be positive")
+	}
+	return nil
+}
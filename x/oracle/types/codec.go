@@ -28,6 +28,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgEditOracleScript{}, "oracle/EditOracleScript")
 	legacy.RegisterAminoMsg(cdc, &MsgActivate{}, "oracle/Activate")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "oracle/UpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateRequestSubscription{}, "oracle/CreateRequestSubscription")
+	legacy.RegisterAminoMsg(cdc, &MsgTopUpRequestSubscription{}, "oracle/TopUpRequestSubscription")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelRequestSubscription{}, "oracle/CancelRequestSubscription")
 
 	cdc.RegisterConcrete(&OracleResultSignatureOrder{}, "oracle/OracleResultSignatureOrder", nil)
 	cdc.RegisterConcrete(Params{}, "oracle/Params", nil)
@@ -44,6 +47,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgEditOracleScript{},
 		&MsgActivate{},
 		&MsgUpdateParams{},
+		&MsgCreateRequestSubscription{},
+		&MsgTopUpRequestSubscription{},
+		&MsgCancelRequestSubscription{},
 	)
 
 	registry.RegisterImplementations(
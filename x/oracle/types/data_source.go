@@ -16,3 +16,25 @@ func NewDataSource(
 		Fee:         fee,
 	}
 }
+
+// NewDataSourceVersion creates a snapshot of the given data source as the given version.
+func NewDataSourceVersion(
+	id DataSourceID, version uint64, dataSource DataSource, editor string, height int64,
+) DataSourceVersion {
+	return DataSourceVersion{
+		DataSourceID: id,
+		Version:      version,
+		Filename:     dataSource.Filename,
+		Treasury:     dataSource.Treasury,
+		Fee:          dataSource.Fee,
+		Editor:       editor,
+		Height:       height,
+	}
+}
+
+func NewDataSourceVersionPin(id DataSourceID, version uint64) DataSourceVersionPin {
+	return DataSourceVersionPin{
+		DataSourceID: id,
+		Version:      version,
+	}
+}
@@ -16,6 +16,15 @@ const (
 	EventTypeSendPacketFail        = "send_packet_fail"
 	EventTypeUpdateParams          = "update_params"
 	EventTypeHandleRequestSignFail = "handle_request_sign_fail"
+	EventTypeCallback              = "callback"
+	EventTypeCallbackFail          = "callback_fail"
+	EventTypeCreateSubscription    = "create_request_subscription"
+	EventTypeTopUpSubscription     = "top_up_request_subscription"
+	EventTypeCancelSubscription    = "cancel_request_subscription"
+	EventTypeSubscriptionRequest   = "request_subscription_request"
+	EventTypeSubscriptionFail      = "request_subscription_fail"
+	EventTypeReleaseFee            = "release_fee"
+	EventTypeFeeReceipt            = "fee_receipt"
 
 	AttributeKeyID                  = "id"
 	AttributeKeySigningID           = "signing_id"
@@ -38,4 +47,13 @@ const (
 	AttributeKeyParams              = "params"
 	AttributeKeySigningErrCodespace = "signing_error_codespace"
 	AttributeKeySigningErrCode      = "signing_error_code"
+	AttributeKeyCallbackTarget      = "callback_target"
+	AttributeKeySubscriptionID      = "subscription_id"
+	AttributeKeyOwner               = "owner"
+	AttributeKeyFeePayer            = "fee_payer"
+	AttributeKeyInterval            = "interval"
+	AttributeKeyAmount              = "amount"
+	AttributeKeyRefund              = "refund"
+	AttributeKeyPayer               = "payer"
+	AttributeKeyReleasedFees        = "released_fees"
 )
@@ -20,6 +20,8 @@ import (
 type AccountKeeper interface {
 	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
+	NewAccount(ctx context.Context, account sdk.AccountI) sdk.AccountI
+	SetAccount(ctx context.Context, account sdk.AccountI)
 }
 
 // BankKeeper defines the expected bank keeper.
@@ -27,6 +29,12 @@ type BankKeeper interface {
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoins(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(
+		ctx context.Context,
+		senderAddr sdk.AccAddress,
+		recipientModule string,
+		amt sdk.Coins,
+	) error
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 }
 
@@ -82,5 +90,7 @@ type BandtssKeeper interface {
 		memo string,
 		sender sdk.AccAddress,
 		feeLimit sdk.Coins,
+		groupName string,
+		batch bool,
 	) (bandtsstypes.SigningID, error)
 }
@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTreasuryFee creates a new TreasuryFee instance.
+func NewTreasuryFee(treasury sdk.AccAddress, fee sdk.Coins) TreasuryFee {
+	return TreasuryFee{
+		Treasury: treasury.String(),
+		Fee:      fee,
+	}
+}
+
+// NewFeeEscrow creates a new FeeEscrow instance with nothing released yet.
+func NewFeeEscrow(payer sdk.AccAddress, treasuryFees []TreasuryFee, total sdk.Coins) FeeEscrow {
+	return FeeEscrow{
+		Payer:        payer.String(),
+		TreasuryFees: treasuryFees,
+		Total:        total,
+	}
+}
+
+// FeePerReport returns the sum of the fees released to the data source treasuries for each report.
+func (e FeeEscrow) FeePerReport() sdk.Coins {
+	fee := sdk.NewCoins()
+	for _, tf := range e.TreasuryFees {
+		fee = fee.Add(tf.Fee...)
+	}
+	return fee
+}
+
+// Remaining returns the fees that are still held in escrow.
+func (e FeeEscrow) Remaining() sdk.Coins {
+	return e.Total.Sub(e.Released...)
+}
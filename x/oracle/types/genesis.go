@@ -2,25 +2,41 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
 
 // NewGenesisState creates a new GenesisState instanc e
-func NewGenesisState(params Params, dataSources []DataSource, oracleScripts []OracleScript) *GenesisState {
+func NewGenesisState(
+	params Params,
+	dataSources []DataSource,
+	oracleScripts []OracleScript,
+	requestSubscriptionCount uint64,
+	requestSubscriptions []RequestSubscription,
+	dataSourceVersions []DataSourceVersion,
+	oracleScriptVersions []OracleScriptVersion,
+) *GenesisState {
 	return &GenesisState{
-		Params:        params,
-		DataSources:   dataSources,
-		OracleScripts: oracleScripts,
+		Params:                   params,
+		DataSources:              dataSources,
+		OracleScripts:            oracleScripts,
+		RequestSubscriptionCount: requestSubscriptionCount,
+		RequestSubscriptions:     requestSubscriptions,
+		DataSourceVersions:       dataSourceVersions,
+		OracleScriptVersions:     oracleScriptVersions,
 	}
 }
 
 // DefaultGenesisState returns the default oracle genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:        DefaultParams(),
-		DataSources:   []DataSource{},
-		OracleScripts: []OracleScript{},
+		Params:               DefaultParams(),
+		DataSources:          []DataSource{},
+		OracleScripts:        []OracleScript{},
+		RequestSubscriptions: []RequestSubscription{},
+		DataSourceVersions:   []DataSourceVersion{},
+		OracleScriptVersions: []OracleScriptVersion{},
 	}
 }
 
@@ -37,4 +53,51 @@ func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.R
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
-func (g GenesisState) Validate() error { return nil }
+func (g GenesisState) Validate() error {
+	ids := make(map[uint64]bool)
+	for _, sub := range g.RequestSubscriptions {
+		if sub.ID == 0 || sub.ID > g.RequestSubscriptionCount {
+			return fmt.Errorf(
+				"request subscription id %d is out of range: [1, %d]",
+				sub.ID,
+				g.RequestSubscriptionCount,
+			)
+		}
+		if ids[sub.ID] {
+			return fmt.Errorf("duplicate request subscription id: %d", sub.ID)
+		}
+		ids[sub.ID] = true
+	}
+
+	dsVersions := make(map[string]bool)
+	for _, v := range g.DataSourceVersions {
+		if v.DataSourceID == 0 || uint64(v.DataSourceID) > uint64(len(g.DataSources)) {
+			return fmt.Errorf("data source version of unknown data source id: %d", v.DataSourceID)
+		}
+		if v.Version == 0 {
+			return fmt.Errorf("invalid version of data source id %d: 0", v.DataSourceID)
+		}
+		key := fmt.Sprintf("%d/%d", v.DataSourceID, v.Version)
+		if dsVersions[key] {
+			return fmt.Errorf("duplicate data source version: %s", key)
+		}
+		dsVersions[key] = true
+	}
+
+	osVersions := make(map[string]bool)
+	for _, v := range g.OracleScriptVersions {
+		if v.OracleScriptID == 0 || uint64(v.OracleScriptID) > uint64(len(g.OracleScripts)) {
+			return fmt.Errorf("oracle script version of unknown oracle script id: %d", v.OracleScriptID)
+		}
+		if v.Version == 0 {
+			return fmt.Errorf("invalid version of oracle script id %d: 0", v.OracleScriptID)
+		}
+		key := fmt.Sprintf("%d/%d", v.OracleScriptID, v.Version)
+		if osVersions[key] {
+			return fmt.Errorf("duplicate oracle script version: %s", key)
+		}
+		osVersions[key] = true
+	}
+
+	return nil
+}
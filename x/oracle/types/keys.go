@@ -1,7 +1,10 @@
 package types
 
 import (
+	"crypto/sha256"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -17,6 +20,9 @@ const (
 	// QuerierRoute is the querier route for the oracle module
 	QuerierRoute = ModuleName
 
+	// SubscriptionAccountsKey is the key used to generate the fee payer accounts of request subscriptions.
+	SubscriptionAccountsKey = "request-subscription-accounts"
+
 	// RouterKey is the msg router key for the oracle module
 	RouterKey = ModuleName
 
@@ -39,6 +45,12 @@ var (
 	DataSourceCountStoreKey = append(GlobalStoreKeyPrefix, []byte("DataSourceCount")...)
 	// OracleScriptCountStoreKey is the key that keeps the total oracle script count.
 	OracleScriptCountStoreKey = append(GlobalStoreKeyPrefix, []byte("OracleScriptCount")...)
+	// LatestResultCountStoreKey is the key that keeps the number of entries in the latest result index.
+	LatestResultCountStoreKey = append(GlobalStoreKeyPrefix, []byte("LatestResultCount")...)
+	// LatestPriceCountStoreKey is the key that keeps the number of entries in the latest price index.
+	LatestPriceCountStoreKey = append(GlobalStoreKeyPrefix, []byte("LatestPriceCount")...)
+	// RequestSubscriptionCountStoreKey is the key that keeps the total request subscription count.
+	RequestSubscriptionCountStoreKey = append(GlobalStoreKeyPrefix, []byte("RequestSubscriptionCount")...)
 
 	// RequestStoreKeyPrefix is the prefix for request store.
 	RequestStoreKeyPrefix = []byte{0x01}
@@ -54,6 +66,31 @@ var (
 	ParamsKeyPrefix = []byte{0x06}
 	// SigningResultStoreKeyPrefix is the prefix for signing ID store.
 	SigningResultStoreKeyPrefix = []byte{0x07}
+	// LatestResultStoreKeyPrefix is the prefix for the latest result index.
+	LatestResultStoreKeyPrefix = []byte{0x08}
+	// LatestResultQueueStoreKeyPrefix is the prefix for the latest result index ordered by request ID.
+	LatestResultQueueStoreKeyPrefix = []byte{0x09}
+	// LatestPriceStoreKeyPrefix is the prefix for the latest price index.
+	LatestPriceStoreKeyPrefix = []byte{0x0a}
+	// LatestPriceQueueStoreKeyPrefix is the prefix for the latest price index ordered by request ID.
+	LatestPriceQueueStoreKeyPrefix = []byte{0x0b}
+	// ValidatorReportInfoKeyPrefix is the prefix for validator report info store.
+	ValidatorReportInfoKeyPrefix = []byte{0x0c}
+	// MissedReportKeyPrefix is the prefix for the missed reports in the report windows of validators.
+	MissedReportKeyPrefix = []byte{0x0d}
+	// RequestCallbackStoreKeyPrefix is the prefix for the callbacks of pending requests.
+	RequestCallbackStoreKeyPrefix = []byte{0x0e}
+	// RequestSubscriptionStoreKeyPrefix is the prefix for request subscription store.
+	RequestSubscriptionStoreKeyPrefix = []byte{0x0f}
+	// RequestSubscriptionQueueStoreKeyPrefix is the prefix for request subscriptions ordered by their next
+	// request height.
+	RequestSubscriptionQueueStoreKeyPrefix = []byte{0x10}
+	// FeeEscrowStoreKeyPrefix is the prefix for the escrowed data source fees of requests.
+	FeeEscrowStoreKeyPrefix = []byte{0x11}
+	// DataSourceVersionStoreKeyPrefix is the prefix for the version histories of data sources.
+	DataSourceVersionStoreKeyPrefix = []byte{0x12}
+	// OracleScriptVersionStoreKeyPrefix is the prefix for the version histories of oracle scripts.
+	OracleScriptVersionStoreKeyPrefix = []byte{0x13}
 	// ResultStoreKeyPrefix is the prefix for request result store.
 	ResultStoreKeyPrefix = []byte{0xff}
 
@@ -86,11 +123,31 @@ func ValidatorStatusStoreKey(v sdk.ValAddress) []byte {
 	return append(ValidatorStatusKeyPrefix, v.Bytes()...)
 }
 
+// ValidatorReportInfoStoreKey returns the key to a validator's report info.
+func ValidatorReportInfoStoreKey(v sdk.ValAddress) []byte {
+	return append(ValidatorReportInfoKeyPrefix, v.Bytes()...)
+}
+
+// MissedReportsPrefixKey returns the prefix key to get all missed reports in the report window of a validator.
+func MissedReportsPrefixKey(v sdk.ValAddress) []byte {
+	return append(MissedReportKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// MissedReportStoreKey returns the key to a missed report at the given index of the report window of a validator.
+func MissedReportStoreKey(v sdk.ValAddress, index uint64) []byte {
+	return append(MissedReportsPrefixKey(v), sdk.Uint64ToBigEndian(index)...)
+}
+
 // SigningResultStoreKey returns the key to a signing id in the store.
 func SigningResultStoreKey(requestID RequestID) []byte {
 	return append(SigningResultStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(requestID))...)
 }
 
+// RequestCallbackStoreKey returns the key to the callback of a request.
+func RequestCallbackStoreKey(requestID RequestID) []byte {
+	return append(RequestCallbackStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(requestID))...)
+}
+
 // ResultStoreKey returns the key to a request result in the store.
 func ResultStoreKey(requestID RequestID) []byte {
 	return append(ResultStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(requestID))...)
@@ -102,3 +159,73 @@ func ReportsOfValidatorPrefixKey(reqID RequestID, val sdk.ValAddress) []byte {
 	buf = append(buf, val.Bytes()...)
 	return buf
 }
+
+// LatestResultIndexKey returns the key of the requests with the given oracle script ID, calldata, ask count
+// and min count in the latest result index.
+func LatestResultIndexKey(oracleScriptID OracleScriptID, calldata []byte, askCount, minCount uint64) []byte {
+	calldataHash := sha256.Sum256(calldata)
+	buf := sdk.Uint64ToBigEndian(uint64(oracleScriptID))
+	buf = append(buf, calldataHash[:]...)
+	buf = append(buf, sdk.Uint64ToBigEndian(askCount)...)
+	buf = append(buf, sdk.Uint64ToBigEndian(minCount)...)
+	return buf
+}
+
+// LatestPriceIndexKey returns the key of the prices of the given symbol, ask count and min count in the
+// latest price index.
+func LatestPriceIndexKey(symbol string, askCount, minCount uint64) []byte {
+	buf := sdk.Uint64ToBigEndian(askCount)
+	buf = append(buf, sdk.Uint64ToBigEndian(minCount)...)
+	buf = append(buf, []byte(symbol)...)
+	return buf
+}
+
+// LatestIndexQueueKey returns the key of an entry of a latest index in the queue of the index, which
+// orders the entries by the ID of the request that they point to.
+func LatestIndexQueueKey(queuePrefix []byte, requestID RequestID, indexKey []byte) []byte {
+	buf := append(append([]byte{}, queuePrefix...), sdk.Uint64ToBigEndian(uint64(requestID))...)
+	return append(buf, indexKey...)
+}
+
+// FeeEscrowStoreKey returns the key to the escrowed data source fees of a request.
+func FeeEscrowStoreKey(requestID RequestID) []byte {
+	return append(FeeEscrowStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(requestID))...)
+}
+
+// DataSourceVersionsPrefixKey returns the prefix of all versions of a data source.
+func DataSourceVersionsPrefixKey(dataSourceID DataSourceID) []byte {
+	return append(DataSourceVersionStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(dataSourceID))...)
+}
+
+// DataSourceVersionStoreKey returns the key to a specific version of a data source.
+func DataSourceVersionStoreKey(dataSourceID DataSourceID, version uint64) []byte {
+	return append(DataSourceVersionsPrefixKey(dataSourceID), sdk.Uint64ToBigEndian(version)...)
+}
+
+// OracleScriptVersionsPrefixKey returns the prefix of all versions of an oracle script.
+func OracleScriptVersionsPrefixKey(oracleScriptID OracleScriptID) []byte {
+	return append(OracleScriptVersionStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(oracleScriptID))...)
+}
+
+// OracleScriptVersionStoreKey returns the key to a specific version of an oracle script.
+func OracleScriptVersionStoreKey(oracleScriptID OracleScriptID, version uint64) []byte {
+	return append(OracleScriptVersionsPrefixKey(oracleScriptID), sdk.Uint64ToBigEndian(version)...)
+}
+
+// RequestSubscriptionStoreKey returns the key to retrieve a specific request subscription from the store.
+func RequestSubscriptionStoreKey(id uint64) []byte {
+	return append(RequestSubscriptionStoreKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// RequestSubscriptionQueueStoreKey returns the key of a request subscription in the queue ordered by the
+// height of its next request.
+func RequestSubscriptionQueueStoreKey(height int64, id uint64) []byte {
+	buf := append(RequestSubscriptionQueueStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(buf, sdk.Uint64ToBigEndian(id)...)
+}
+
+// SplitRequestSubscriptionQueueStoreKey splits a key of the request subscription queue into the height and
+// the ID of the request subscription.
+func SplitRequestSubscriptionQueueStoreKey(key []byte) (int64, uint64) {
+	return int64(sdk.BigEndianToUint64(key[1:9])), sdk.BigEndianToUint64(key[9:])
+}
@@ -18,6 +18,9 @@ var (
 	_ sdk.Msg = (*MsgEditOracleScript)(nil)
 	_ sdk.Msg = (*MsgActivate)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgCreateRequestSubscription)(nil)
+	_ sdk.Msg = (*MsgTopUpRequestSubscription)(nil)
+	_ sdk.Msg = (*MsgCancelRequestSubscription)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgRequestData)(nil)
 	_ sdk.HasValidateBasic = (*MsgReportData)(nil)
@@ -27,6 +30,9 @@ var (
 	_ sdk.HasValidateBasic = (*MsgEditOracleScript)(nil)
 	_ sdk.HasValidateBasic = (*MsgActivate)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgCreateRequestSubscription)(nil)
+	_ sdk.HasValidateBasic = (*MsgTopUpRequestSubscription)(nil)
+	_ sdk.HasValidateBasic = (*MsgCancelRequestSubscription)(nil)
 )
 
 // NewMsgRequestData creates a new MsgRequestData instance.
@@ -63,34 +69,61 @@ func (m MsgRequestData) ValidateBasic() error {
 	if err := sdk.VerifyAddressFormat(sender); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("sender: %s", m.Sender)
 	}
-	if m.MinCount <= 0 {
-		return ErrInvalidMinCount.Wrapf("got: %d", m.MinCount)
+	if err := validateRequestSpec(&m); err != nil {
+		return err
+	}
+
+	if m.Callback != nil {
+		if err := m.Callback.Validate(); err != nil {
+			return err
+		}
+	}
+
+	pinned := make(map[DataSourceID]bool)
+	for _, pin := range m.DataSourceVersions {
+		if pin.Version == 0 {
+			return ErrInvalidVersionPin.Wrapf("data source %d: version must be positive", pin.DataSourceID)
+		}
+		if pinned[pin.DataSourceID] {
+			return ErrInvalidVersionPin.Wrapf("data source %d is pinned more than once", pin.DataSourceID)
+		}
+		pinned[pin.DataSourceID] = true
+	}
+
+	return nil
+}
+
+// validateRequestSpec checks the request parameters shared by MsgRequestData and
+// MsgCreateRequestSubscription.
+func validateRequestSpec(spec RequestSpec) error {
+	if spec.GetMinCount() <= 0 {
+		return ErrInvalidMinCount.Wrapf("got: %d", spec.GetMinCount())
 	}
-	if m.AskCount < m.MinCount {
-		return ErrInvalidAskCount.Wrapf("got: %d, min count: %d", m.AskCount, m.MinCount)
+	if spec.GetAskCount() < spec.GetMinCount() {
+		return ErrInvalidAskCount.Wrapf("got: %d, min count: %d", spec.GetAskCount(), spec.GetMinCount())
 	}
-	if len(m.ClientID) > MaxClientIDLength {
-		return WrapMaxError(ErrTooLongClientID, len(m.ClientID), MaxClientIDLength)
+	if len(spec.GetClientID()) > MaxClientIDLength {
+		return WrapMaxError(ErrTooLongClientID, len(spec.GetClientID()), MaxClientIDLength)
 	}
-	if m.PrepareGas <= 0 {
-		return ErrInvalidOwasmGas.Wrapf("invalid prepare gas: %d", m.PrepareGas)
+	if spec.GetPrepareGas() <= 0 {
+		return ErrInvalidOwasmGas.Wrapf("invalid prepare gas: %d", spec.GetPrepareGas())
 	}
-	if m.ExecuteGas <= 0 {
-		return ErrInvalidOwasmGas.Wrapf("invalid execute gas: %d", m.ExecuteGas)
+	if spec.GetExecuteGas() <= 0 {
+		return ErrInvalidOwasmGas.Wrapf("invalid execute gas: %d", spec.GetExecuteGas())
 	}
-	if m.PrepareGas+m.ExecuteGas > MaximumOwasmGas {
+	if spec.GetPrepareGas()+spec.GetExecuteGas() > MaximumOwasmGas {
 		return ErrInvalidOwasmGas.Wrapf(
 			"sum of prepare gas and execute gas (%d) exceed %d",
-			m.PrepareGas+m.ExecuteGas,
+			spec.GetPrepareGas()+spec.GetExecuteGas(),
 			MaximumOwasmGas,
 		)
 	}
-	if !m.FeeLimit.IsValid() {
-		return sdkerrors.ErrInvalidCoins.Wrap(m.FeeLimit.String())
+	if !spec.GetFeeLimit().IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrap(spec.GetFeeLimit().String())
 	}
 
-	if _, ok := Encoder_name[int32(m.TSSEncoder)]; !ok {
-		return ErrInvalidOracleEncoder.Wrapf("invalid encoder type: %d", m.TSSEncoder)
+	if _, ok := Encoder_name[int32(spec.GetTSSEncoder())]; !ok {
+		return ErrInvalidOracleEncoder.Wrapf("invalid encoder type: %d", spec.GetTSSEncoder())
 	}
 
 	return nil
@@ -398,3 +431,101 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgCreateRequestSubscription creates a new MsgCreateRequestSubscription instance
+func NewMsgCreateRequestSubscription(
+	oracleScriptID OracleScriptID,
+	calldata []byte,
+	askCount, minCount uint64,
+	clientID string,
+	feeLimit sdk.Coins,
+	prepareGas, executeGas uint64,
+	tssEncoder Encoder,
+	interval uint64,
+	initialDeposit sdk.Coins,
+	owner sdk.AccAddress,
+) *MsgCreateRequestSubscription {
+	return &MsgCreateRequestSubscription{
+		OracleScriptID: oracleScriptID,
+		Calldata:       calldata,
+		AskCount:       askCount,
+		MinCount:       minCount,
+		ClientID:       clientID,
+		FeeLimit:       feeLimit,
+		PrepareGas:     prepareGas,
+		ExecuteGas:     executeGas,
+		TSSEncoder:     tssEncoder,
+		Interval:       interval,
+		InitialDeposit: initialDeposit,
+		Owner:          owner.String(),
+	}
+}
+
+// ValidateBasic checks whether the given MsgCreateRequestSubscription instance (sdk.Msg interface).
+func (m MsgCreateRequestSubscription) ValidateBasic() error {
+	owner, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("owner: %s", m.Owner)
+	}
+	if err := validateRequestSpec(&m); err != nil {
+		return err
+	}
+	if m.Interval == 0 {
+		return ErrInvalidSubscriptionInterval.Wrap("interval must be positive")
+	}
+	if !m.InitialDeposit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrap(m.InitialDeposit.String())
+	}
+	return nil
+}
+
+// NewMsgTopUpRequestSubscription creates a new MsgTopUpRequestSubscription instance
+func NewMsgTopUpRequestSubscription(
+	subscriptionID uint64,
+	amount sdk.Coins,
+	sender sdk.AccAddress,
+) *MsgTopUpRequestSubscription {
+	return &MsgTopUpRequestSubscription{
+		SubscriptionID: subscriptionID,
+		Amount:         amount,
+		Sender:         sender.String(),
+	}
+}
+
+// ValidateBasic checks whether the given MsgTopUpRequestSubscription instance (sdk.Msg interface).
+func (m MsgTopUpRequestSubscription) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("sender: %s", m.Sender)
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return sdkerrors.ErrInvalidCoins.Wrap(m.Amount.String())
+	}
+	return nil
+}
+
+// NewMsgCancelRequestSubscription creates a new MsgCancelRequestSubscription instance
+func NewMsgCancelRequestSubscription(subscriptionID uint64, owner sdk.AccAddress) *MsgCancelRequestSubscription {
+	return &MsgCancelRequestSubscription{
+		SubscriptionID: subscriptionID,
+		Owner:          owner.String(),
+	}
+}
+
+// ValidateBasic checks whether the given MsgCancelRequestSubscription instance (sdk.Msg interface).
+func (m MsgCancelRequestSubscription) ValidateBasic() error {
+	owner, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("owner: %s", m.Owner)
+	}
+	return nil
+}
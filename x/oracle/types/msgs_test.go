@@ -371,9 +371,25 @@ func TestMsgRequestDataValidation(t *testing.T) {
 		{false, NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", BadCoins, 1, 1, GoodTestAddr, 0)},
 		{false, NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 0, 1, GoodTestAddr, 0)},
 		{false, NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 0, GoodTestAddr, 0)},
+		{true, withCallback(NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, GoodTestAddr, 0), "consumer", 1000)},
+		{false, withCallback(NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, GoodTestAddr, 0), "", 1000)},
+		{false, withCallback(NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, GoodTestAddr, 0), "consumer", 0)},
+		{true, withDataSourceVersions(NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, GoodTestAddr, 0), NewDataSourceVersionPin(1, 2), NewDataSourceVersionPin(2, 1))},
+		{false, withDataSourceVersions(NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, GoodTestAddr, 0), NewDataSourceVersionPin(1, 0))},
+		{false, withDataSourceVersions(NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, GoodTestAddr, 0), NewDataSourceVersionPin(1, 1), NewDataSourceVersionPin(1, 2))},
 	})
 }
 
+func withCallback(msg *MsgRequestData, target string, gasLimit uint64) *MsgRequestData {
+	msg.Callback = NewRequestCallback(target, gasLimit)
+	return msg
+}
+
+func withDataSourceVersions(msg *MsgRequestData, pins ...DataSourceVersionPin) *MsgRequestData {
+	msg.DataSourceVersions = pins
+	return msg
+}
+
 func TestMsgReportDataValidation(t *testing.T) {
 	performValidateTests(t, []validateTestCase{
 		{true, NewMsgReportData(1, []RawReport{{1, 1, []byte("data1")}, {2, 2, []byte("data2")}}, GoodTestValAddr)},
@@ -389,3 +405,31 @@ func TestMsgActivateValidation(t *testing.T) {
 		{false, NewMsgActivate(EmptyValAddr)},
 	})
 }
+
+func TestMsgCreateRequestSubscriptionValidation(t *testing.T) {
+	performValidateTests(t, []validateTestCase{
+		{true, NewMsgCreateRequestSubscription(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, 0, 10, FeeCoins, GoodTestAddr)},
+		{true, NewMsgCreateRequestSubscription(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, 0, 10, GoodCoins, GoodTestAddr)},
+		{false, NewMsgCreateRequestSubscription(1, []byte("calldata"), 2, 5, "client-id", GoodCoins, 1, 1, 0, 10, FeeCoins, GoodTestAddr)},
+		{false, NewMsgCreateRequestSubscription(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 0, 1, 0, 10, FeeCoins, GoodTestAddr)},
+		{false, NewMsgCreateRequestSubscription(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, 0, 0, FeeCoins, GoodTestAddr)},
+		{false, NewMsgCreateRequestSubscription(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, 0, 10, BadCoins, GoodTestAddr)},
+		{false, NewMsgCreateRequestSubscription(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, 0, 10, FeeCoins, EmptyAddr)},
+	})
+}
+
+func TestMsgTopUpRequestSubscriptionValidation(t *testing.T) {
+	performValidateTests(t, []validateTestCase{
+		{true, NewMsgTopUpRequestSubscription(1, FeeCoins, GoodTestAddr)},
+		{false, NewMsgTopUpRequestSubscription(1, GoodCoins, GoodTestAddr)},
+		{false, NewMsgTopUpRequestSubscription(1, BadCoins, GoodTestAddr)},
+		{false, NewMsgTopUpRequestSubscription(1, FeeCoins, EmptyAddr)},
+	})
+}
+
+func TestMsgCancelRequestSubscriptionValidation(t *testing.T) {
+	performValidateTests(t, []validateTestCase{
+		{true, NewMsgCancelRequestSubscription(1, GoodTestAddr)},
+		{false, NewMsgCancelRequestSubscription(1, EmptyAddr)},
+	})
+}
@@ -302,6 +302,9 @@ type RawRequest struct {
 	// Calldata is the data used as argument params for executing data source
 	// script
 	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// DataSourceVersion is the version of the data source script to be executed.
+	// It is zero for requests made before data sources were versioned.
+	DataSourceVersion uint64 `protobuf:"varint,4,opt,name=data_source_version,json=dataSourceVersion,proto3" json:"data_source_version,omitempty"`
 }
 
 func (m *RawRequest) Reset()         { *m = RawRequest{} }
@@ -358,6 +361,13 @@ func (m *RawRequest) GetCalldata() []byte {
 	return nil
 }
 
+func (m *RawRequest) GetDataSourceVersion() uint64 {
+	if m != nil {
+		return m.DataSourceVersion
+	}
+	return 0
+}
+
 // RawReport is the data structure for storing raw reporter in the storage.
 type RawReport struct {
 	// ExternalID is an ID of the raw request
@@ -460,6 +470,9 @@ type Request struct {
 	Requester string `protobuf:"bytes,12,opt,name=requester,proto3" json:"requester,omitempty"`
 	// FeeLimit is the maximum tokens that will be paid for this request.
 	FeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=fee_limit,json=feeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_limit"`
+	// OracleScriptVersion is the version of the oracle script used by this
+	// request. It is zero for requests made before oracle scripts were versioned.
+	OracleScriptVersion uint64 `protobuf:"varint,14,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
@@ -586,6 +599,13 @@ func (m *Request) GetFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Request) GetOracleScriptVersion() uint64 {
+	if m != nil {
+		return m.OracleScriptVersion
+	}
+	return 0
+}
+
 // Report is the data structure for storing reports in the storage.
 type Report struct {
 	// Validator is a validator address who submit the report
@@ -1202,6 +1222,99 @@ func (m *ValidatorStatus) GetSince() time.Time {
 	return time.Time{}
 }
 
+// ValidatorReportInfo tracks the reports of a validator over a sliding window
+// of its latest report assignments.
+type ValidatorReportInfo struct {
+	// Validator is the address of the validator
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// WindowSize is the report window size at which the window is recorded
+	WindowSize uint64 `protobuf:"varint,2,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
+	// IndexOffset is the number of report assignments recorded in the window
+	// since it was last reset
+	IndexOffset uint64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// MissedReportsCounter is the number of missed reports in the window
+	MissedReportsCounter uint64 `protobuf:"varint,4,opt,name=missed_reports_counter,json=missedReportsCounter,proto3" json:"missed_reports_counter,omitempty"`
+	// TotalReports is the total number of reports the validator has sent
+	TotalReports uint64 `protobuf:"varint,5,opt,name=total_reports,json=totalReports,proto3" json:"total_reports,omitempty"`
+	// TotalMissedReports is the total number of reports the validator has missed
+	TotalMissedReports uint64 `protobuf:"varint,6,opt,name=total_missed_reports,json=totalMissedReports,proto3" json:"total_missed_reports,omitempty"`
+}
+
+func (m *ValidatorReportInfo) Reset()         { *m = ValidatorReportInfo{} }
+func (m *ValidatorReportInfo) String() string { return proto.CompactTextString(m) }
+func (*ValidatorReportInfo) ProtoMessage()    {}
+func (*ValidatorReportInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{12}
+}
+func (m *ValidatorReportInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorReportInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorReportInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorReportInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorReportInfo.Merge(m, src)
+}
+func (m *ValidatorReportInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorReportInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorReportInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorReportInfo proto.InternalMessageInfo
+
+func (m *ValidatorReportInfo) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ValidatorReportInfo) GetWindowSize() uint64 {
+	if m != nil {
+		return m.WindowSize
+	}
+	return 0
+}
+
+func (m *ValidatorReportInfo) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ValidatorReportInfo) GetMissedReportsCounter() uint64 {
+	if m != nil {
+		return m.MissedReportsCounter
+	}
+	return 0
+}
+
+func (m *ValidatorReportInfo) GetTotalReports() uint64 {
+	if m != nil {
+		return m.TotalReports
+	}
+	return 0
+}
+
+func (m *ValidatorReportInfo) GetTotalMissedReports() uint64 {
+	if m != nil {
+		return m.TotalMissedReports
+	}
+	return 0
+}
+
 // ActiveValidator is information of currently active validator
 type ActiveValidator struct {
 	// Address is a validator address
@@ -1214,7 +1327,7 @@ func (m *ActiveValidator) Reset()         { *m = ActiveValidator{} }
 func (m *ActiveValidator) String() string { return proto.CompactTextString(m) }
 func (*ActiveValidator) ProtoMessage()    {}
 func (*ActiveValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{12}
+	return fileDescriptor_9714783eaff1514b, []int{13}
 }
 func (m *ActiveValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1290,13 +1403,44 @@ type Params struct {
 	// IBCRequestEnabled is a flag indicating whether sending oracle request via
 	// IBC is allowed
 	IBCRequestEnabled bool `protobuf:"varint,11,opt,name=ibc_request_enabled,json=ibcRequestEnabled,proto3" json:"ibc_request_enabled,omitempty"`
+	// MaxLatestResultIndexSize is the maximum number of entries in each of the
+	// latest result and the latest price indexes. The entries of the oldest
+	// requests are evicted first, also when it is lowered by a parameter update.
+	// Nothing is indexed if it is zero.
+	MaxLatestResultIndexSize uint64 `protobuf:"varint,12,opt,name=max_latest_result_index_size,json=maxLatestResultIndexSize,proto3" json:"max_latest_result_index_size,omitempty"`
+	// PriceOracleScriptID is the ID of the standard price reference oracle
+	// script whose results are indexed by symbol for the RequestPrice query.
+	// Prices are not indexed if it is zero.
+	PriceOracleScriptID OracleScriptID `protobuf:"varint,13,opt,name=price_oracle_script_id,json=priceOracleScriptId,proto3,casttype=OracleScriptID" json:"price_oracle_script_id,omitempty"`
+	// ReportWindowSize is the number of the latest report assignments of a
+	// validator that are kept to count its missed reports. A validator is
+	// deactivated on its first missed report if it is zero.
+	ReportWindowSize uint64 `protobuf:"varint,14,opt,name=report_window_size,json=reportWindowSize,proto3" json:"report_window_size,omitempty"`
+	// MaxMissedReportPercentage is the percentage of the report window that a
+	// validator can miss before it gets deactivated.
+	MaxMissedReportPercentage uint64 `protobuf:"varint,15,opt,name=max_missed_report_percentage,json=maxMissedReportPercentage,proto3" json:"max_missed_report_percentage,omitempty"`
+	// MaxCallbackGasLimit is the maximum gas limit of the callback of a request.
+	// Requests cannot have a callback if it is zero.
+	MaxCallbackGasLimit uint64 `protobuf:"varint,16,opt,name=max_callback_gas_limit,json=maxCallbackGasLimit,proto3" json:"max_callback_gas_limit,omitempty"`
+	// MinSubscriptionInterval is the minimum number of blocks between two
+	// requests spawned by a request subscription. Request subscriptions cannot be
+	// created if it is zero.
+	MinSubscriptionInterval uint64 `protobuf:"varint,17,opt,name=min_subscription_interval,json=minSubscriptionInterval,proto3" json:"min_subscription_interval,omitempty"`
+	// SubscriptionBaseRequestFee is the fee charged from the deposit of a
+	// request subscription for every request it spawns, on top of the data
+	// source fees of the request.
+	SubscriptionBaseRequestFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,18,rep,name=subscription_base_request_fee,json=subscriptionBaseRequestFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"subscription_base_request_fee"`
+	// MaxSubscriptionRequestsPerBlock is the maximum number of requests that
+	// request subscriptions can spawn in a block. Due subscriptions over the
+	// limit are carried over to the next block.
+	MaxSubscriptionRequestsPerBlock uint64 `protobuf:"varint,19,opt,name=max_subscription_requests_per_block,json=maxSubscriptionRequestsPerBlock,proto3" json:"max_subscription_requests_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{13}
+	return fileDescriptor_9714783eaff1514b, []int{14}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1402,6 +1546,62 @@ func (m *Params) GetIBCRequestEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMaxLatestResultIndexSize() uint64 {
+	if m != nil {
+		return m.MaxLatestResultIndexSize
+	}
+	return 0
+}
+
+func (m *Params) GetPriceOracleScriptID() OracleScriptID {
+	if m != nil {
+		return m.PriceOracleScriptID
+	}
+	return 0
+}
+
+func (m *Params) GetReportWindowSize() uint64 {
+	if m != nil {
+		return m.ReportWindowSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMissedReportPercentage() uint64 {
+	if m != nil {
+		return m.MaxMissedReportPercentage
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.MaxCallbackGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMinSubscriptionInterval() uint64 {
+	if m != nil {
+		return m.MinSubscriptionInterval
+	}
+	return 0
+}
+
+func (m *Params) GetSubscriptionBaseRequestFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SubscriptionBaseRequestFee
+	}
+	return nil
+}
+
+func (m *Params) GetMaxSubscriptionRequestsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxSubscriptionRequestsPerBlock
+	}
+	return 0
+}
+
 // PendingResolveList is a list of requests that are waiting to be resolved
 type PendingResolveList struct {
 	// RequestIDs is a list of request IDs that are waiting to be resolved
@@ -1412,7 +1612,7 @@ func (m *PendingResolveList) Reset()         { *m = PendingResolveList{} }
 func (m *PendingResolveList) String() string { return proto.CompactTextString(m) }
 func (*PendingResolveList) ProtoMessage()    {}
 func (*PendingResolveList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{14}
+	return fileDescriptor_9714783eaff1514b, []int{15}
 }
 func (m *PendingResolveList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1463,7 +1663,7 @@ func (m *IBCChannel) Reset()         { *m = IBCChannel{} }
 func (m *IBCChannel) String() string { return proto.CompactTextString(m) }
 func (*IBCChannel) ProtoMessage()    {}
 func (*IBCChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{15}
+	return fileDescriptor_9714783eaff1514b, []int{16}
 }
 func (m *IBCChannel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1506,6 +1706,64 @@ func (m *IBCChannel) GetChannelId() string {
 	return ""
 }
 
+// RequestCallback is information of a registered in-process module that
+// receives the response of a request when the request is resolved.
+type RequestCallback struct {
+	// Target is the name of the callback target registered on the oracle
+	// keeper.
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// GasLimit is the maximum gas that the callback target can use to handle the
+	// response.
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *RequestCallback) Reset()         { *m = RequestCallback{} }
+func (m *RequestCallback) String() string { return proto.CompactTextString(m) }
+func (*RequestCallback) ProtoMessage()    {}
+func (*RequestCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{17}
+}
+func (m *RequestCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestCallback.Merge(m, src)
+}
+func (m *RequestCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestCallback proto.InternalMessageInfo
+
+func (m *RequestCallback) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *RequestCallback) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 // RequestVerification is a message that is constructed and signed by a reporter
 // to be used as a part of verification of oracle request.
 type RequestVerification struct {
@@ -1525,7 +1783,7 @@ func (m *RequestVerification) Reset()         { *m = RequestVerification{} }
 func (m *RequestVerification) String() string { return proto.CompactTextString(m) }
 func (*RequestVerification) ProtoMessage()    {}
 func (*RequestVerification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{16}
+	return fileDescriptor_9714783eaff1514b, []int{18}
 }
 func (m *RequestVerification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1610,7 +1868,7 @@ func (m *PriceResult) Reset()         { *m = PriceResult{} }
 func (m *PriceResult) String() string { return proto.CompactTextString(m) }
 func (*PriceResult) ProtoMessage()    {}
 func (*PriceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{17}
+	return fileDescriptor_9714783eaff1514b, []int{19}
 }
 func (m *PriceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1686,7 +1944,7 @@ func (m *OracleResultSignatureOrder) Reset()         { *m = OracleResultSignatur
 func (m *OracleResultSignatureOrder) String() string { return proto.CompactTextString(m) }
 func (*OracleResultSignatureOrder) ProtoMessage()    {}
 func (*OracleResultSignatureOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{18}
+	return fileDescriptor_9714783eaff1514b, []int{20}
 }
 func (m *OracleResultSignatureOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1715,385 +1973,807 @@ func (m *OracleResultSignatureOrder) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OracleResultSignatureOrder proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("band.oracle.v1.ResolveStatus", ResolveStatus_name, ResolveStatus_value)
-	proto.RegisterEnum("band.oracle.v1.Encoder", Encoder_name, Encoder_value)
-	proto.RegisterType((*DataSource)(nil), "band.oracle.v1.DataSource")
-	proto.RegisterType((*OracleScript)(nil), "band.oracle.v1.OracleScript")
-	proto.RegisterType((*RawRequest)(nil), "band.oracle.v1.RawRequest")
-	proto.RegisterType((*RawReport)(nil), "band.oracle.v1.RawReport")
-	proto.RegisterType((*Request)(nil), "band.oracle.v1.Request")
-	proto.RegisterType((*Report)(nil), "band.oracle.v1.Report")
-	proto.RegisterType((*OracleRequestPacketData)(nil), "band.oracle.v1.OracleRequestPacketData")
-	proto.RegisterType((*OracleRequestPacketAcknowledgement)(nil), "band.oracle.v1.OracleRequestPacketAcknowledgement")
-	proto.RegisterType((*OracleResponsePacketData)(nil), "band.oracle.v1.OracleResponsePacketData")
-	proto.RegisterType((*Result)(nil), "band.oracle.v1.Result")
-	proto.RegisterType((*SigningResult)(nil), "band.oracle.v1.SigningResult")
-	proto.RegisterType((*ValidatorStatus)(nil), "band.oracle.v1.ValidatorStatus")
-	proto.RegisterType((*ActiveValidator)(nil), "band.oracle.v1.ActiveValidator")
-	proto.RegisterType((*Params)(nil), "band.oracle.v1.Params")
-	proto.RegisterType((*PendingResolveList)(nil), "band.oracle.v1.PendingResolveList")
-	proto.RegisterType((*IBCChannel)(nil), "band.oracle.v1.IBCChannel")
-	proto.RegisterType((*RequestVerification)(nil), "band.oracle.v1.RequestVerification")
-	proto.RegisterType((*PriceResult)(nil), "band.oracle.v1.PriceResult")
-	proto.RegisterType((*OracleResultSignatureOrder)(nil), "band.oracle.v1.OracleResultSignatureOrder")
+// TreasuryFee is the fee that is paid to the treasury of a data source for a
+// report of a request.
+type TreasuryFee struct {
+	// Treasury is the account address of the data source treasury.
+	Treasury string `protobuf:"bytes,1,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	// Fee is the fee paid to the treasury for each report.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
 }
 
-func init() { proto.RegisterFile("band/oracle/v1/oracle.proto", fileDescriptor_9714783eaff1514b) }
+func (m *TreasuryFee) Reset()         { *m = TreasuryFee{} }
+func (m *TreasuryFee) String() string { return proto.CompactTextString(m) }
+func (*TreasuryFee) ProtoMessage()    {}
+func (*TreasuryFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{21}
+}
+func (m *TreasuryFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TreasuryFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryFee.Merge(m, src)
+}
+func (m *TreasuryFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryFee.DiscardUnknown(m)
+}
 
-var fileDescriptor_9714783eaff1514b = []byte{
-	// 1965 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xdb, 0x6f, 0x63, 0x47,
-	0x19, 0xcf, 0xb1, 0x9d, 0xc4, 0xfe, 0xec, 0x38, 0xc9, 0x6c, 0xba, 0xf1, 0x7a, 0xb7, 0x71, 0x88,
-	0x0a, 0x2c, 0x2b, 0xb0, 0xc9, 0x16, 0x21, 0x76, 0x01, 0x89, 0xf8, 0xb2, 0xd4, 0x34, 0xda, 0x58,
-	0xe3, 0x64, 0x85, 0x90, 0xd0, 0xd1, 0xf8, 0x9c, 0x89, 0x33, 0xcd, 0xb9, 0x31, 0x73, 0x9c, 0x38,
-	0x7d, 0xe3, 0xad, 0xea, 0xd3, 0x3e, 0x23, 0x55, 0xaa, 0xd4, 0x37, 0x5e, 0x11, 0xff, 0x01, 0x88,
-	0xf2, 0x44, 0x1f, 0x91, 0x90, 0x52, 0xe4, 0x95, 0x10, 0xff, 0x00, 0x2f, 0xf0, 0x82, 0xe6, 0x72,
-	0x7c, 0xab, 0xe9, 0x76, 0x77, 0x0b, 0x0f, 0x3c, 0xf9, 0x7c, 0x97, 0x99, 0xf9, 0x6e, 0xbf, 0x6f,
-	0xbe, 0x31, 0xdc, 0xee, 0x91, 0xc0, 0xad, 0x85, 0x9c, 0x38, 0x1e, 0xad, 0x5d, 0xec, 0x9b, 0xaf,
-	0x6a, 0xc4, 0xc3, 0x38, 0x44, 0x45, 0x29, 0xac, 0x1a, 0xd6, 0xc5, 0x7e, 0x79, 0xab, 0x1f, 0xf6,
-	0x43, 0x25, 0xaa, 0xc9, 0x2f, 0xad, 0x55, 0xae, 0xf4, 0xc3, 0xb0, 0xef, 0xd1, 0x9a, 0xa2, 0x7a,
-	0x83, 0xd3, 0x5a, 0xcc, 0x7c, 0x2a, 0x62, 0xe2, 0x47, 0x46, 0x61, 0xc7, 0x09, 0x85, 0x1f, 0x8a,
-	0x5a, 0x8f, 0x08, 0x79, 0x46, 0x8f, 0xc6, 0x64, 0xbf, 0xe6, 0x84, 0x2c, 0xd0, 0xf2, 0xbd, 0x7f,
-	0x58, 0x00, 0x4d, 0x12, 0x93, 0x6e, 0x38, 0xe0, 0x0e, 0x45, 0x5b, 0xb0, 0x1c, 0x5e, 0x06, 0x94,
-	0x97, 0xac, 0x5d, 0xeb, 0x6e, 0x0e, 0x6b, 0x02, 0x21, 0xc8, 0x04, 0xc4, 0xa7, 0xa5, 0x94, 0x62,
-	0xaa, 0x6f, 0xb4, 0x0b, 0x79, 0x97, 0x0a, 0x87, 0xb3, 0x28, 0x66, 0x61, 0x50, 0x4a, 0x2b, 0xd1,
-	0x34, 0x0b, 0x95, 0x21, 0x7b, 0xca, 0x3c, 0xaa, 0x56, 0x66, 0x94, 0x78, 0x4c, 0x4b, 0x59, 0xcc,
-	0x29, 0x11, 0x03, 0x7e, 0x55, 0x5a, 0xd6, 0xb2, 0x84, 0x46, 0x3f, 0x87, 0xf4, 0x29, 0xa5, 0xa5,
-	0x95, 0xdd, 0xf4, 0xdd, 0xfc, 0xfd, 0x5b, 0x55, 0xed, 0x40, 0x55, 0x3a, 0x50, 0x35, 0x0e, 0x54,
-	0x1b, 0x21, 0x0b, 0xea, 0xdf, 0xfe, 0xf8, 0xba, 0xb2, 0xf4, 0xeb, 0x4f, 0x2b, 0x77, 0xfb, 0x2c,
-	0x3e, 0x1b, 0xf4, 0xaa, 0x4e, 0xe8, 0xd7, 0x8c, 0xb7, 0xfa, 0xe7, 0x5b, 0xc2, 0x3d, 0xaf, 0xc5,
-	0x57, 0x11, 0x15, 0x6a, 0x81, 0xc0, 0x72, 0xdf, 0x87, 0x99, 0xbf, 0x7f, 0x58, 0xb1, 0xf6, 0xfe,
-	0x64, 0x41, 0xe1, 0x48, 0x05, 0xb7, 0xab, 0x0c, 0xfe, 0x9f, 0x79, 0x7e, 0x13, 0x56, 0x84, 0x73,
-	0x46, 0x7d, 0x62, 0xfc, 0x36, 0x14, 0x7a, 0x00, 0xeb, 0x42, 0xe5, 0xc0, 0x76, 0x42, 0x97, 0xda,
-	0x03, 0xee, 0x95, 0x56, 0xa4, 0x42, 0x7d, 0x73, 0x74, 0x5d, 0x59, 0xd3, 0xe9, 0x69, 0x84, 0x2e,
-	0x3d, 0xc1, 0x87, 0x78, 0x4d, 0x4c, 0x48, 0xee, 0x19, 0x8f, 0x7e, 0x6b, 0x01, 0x60, 0x72, 0x89,
-	0xe9, 0x2f, 0x06, 0x54, 0xc4, 0xe8, 0x87, 0x90, 0xa7, 0xc3, 0x98, 0xf2, 0x80, 0x78, 0x36, 0x73,
-	0x95, 0x57, 0x99, 0xfa, 0x9d, 0xd1, 0x75, 0x05, 0x5a, 0x86, 0xdd, 0x6e, 0xfe, 0x73, 0x86, 0xc2,
-	0x90, 0x2c, 0x68, 0xbb, 0xe8, 0x11, 0x14, 0x5d, 0x12, 0x13, 0xdb, 0xd8, 0xc4, 0x5c, 0x15, 0x82,
-	0x4c, 0x7d, 0x77, 0x74, 0x5d, 0x29, 0x4c, 0x0a, 0x46, 0xed, 0x31, 0x43, 0xe3, 0x82, 0x3b, 0xa1,
-	0x5c, 0x19, 0x0a, 0x87, 0x78, 0x9e, 0xe4, 0xa9, 0x48, 0x15, 0xf0, 0x98, 0x36, 0x76, 0xff, 0xd2,
-	0x82, 0x9c, 0xb2, 0x3b, 0x0a, 0xf9, 0x2b, 0x9b, 0x7d, 0x1b, 0x72, 0x74, 0xc8, 0x62, 0x15, 0x43,
-	0x65, 0xf1, 0x1a, 0xce, 0x4a, 0x86, 0x0c, 0x95, 0x4c, 0xe6, 0x94, 0x1d, 0x99, 0x29, 0x1b, 0x7e,
-	0xbf, 0x0c, 0xab, 0x49, 0xe0, 0x1e, 0xc3, 0x86, 0x46, 0x9d, 0xad, 0x13, 0x3a, 0x31, 0xe3, 0x8d,
-	0xd1, 0x75, 0xa5, 0x38, 0x5d, 0x34, 0xca, 0x94, 0x39, 0x0e, 0x2e, 0x86, 0xd3, 0xf4, 0x6c, 0x04,
-	0x52, 0xb3, 0x11, 0x40, 0xfb, 0xb0, 0xc5, 0xf5, 0xb1, 0xd4, 0xb5, 0x2f, 0x88, 0xc7, 0x5c, 0x12,
-	0x87, 0x5c, 0x94, 0xd2, 0xbb, 0xe9, 0xbb, 0x39, 0x7c, 0x63, 0x2c, 0x7b, 0x32, 0x16, 0x49, 0x0f,
-	0x7d, 0x16, 0xd8, 0x4e, 0x38, 0x08, 0x62, 0x55, 0x5c, 0x19, 0x9c, 0xf5, 0x59, 0xd0, 0x90, 0x34,
-	0xfa, 0x2a, 0x14, 0xcd, 0x1a, 0xfb, 0x8c, 0xb2, 0xfe, 0x59, 0xac, 0x8a, 0x2c, 0x8d, 0xd7, 0x0c,
-	0xf7, 0x2d, 0xc5, 0x44, 0x5f, 0x81, 0x42, 0xa2, 0x26, 0xfb, 0x85, 0x2a, 0xb4, 0x34, 0xce, 0x1b,
-	0xde, 0x31, 0xf3, 0x29, 0xfa, 0x06, 0xe4, 0x1c, 0x8f, 0xd1, 0x40, 0xb9, 0xbf, 0xaa, 0x0a, 0xb1,
-	0x30, 0xba, 0xae, 0x64, 0x1b, 0x8a, 0xd9, 0x6e, 0xe2, 0xac, 0x16, 0xb7, 0x5d, 0xd4, 0x80, 0x02,
-	0x27, 0x97, 0xb6, 0x59, 0x2d, 0x4a, 0x59, 0x05, 0xdc, 0x72, 0x75, 0xb6, 0x81, 0x55, 0x27, 0xb5,
-	0x59, 0xcf, 0x48, 0xe4, 0xe2, 0x3c, 0x1f, 0x73, 0x04, 0x7a, 0x1b, 0xf2, 0xac, 0xe7, 0xd8, 0xce,
-	0x19, 0x09, 0x02, 0xea, 0x95, 0x72, 0xbb, 0xd6, 0xa2, 0x3d, 0xda, 0xf5, 0x46, 0x43, 0x6b, 0xd4,
-	0x8b, 0xb2, 0x26, 0x26, 0x34, 0x06, 0xd6, 0x73, 0xcc, 0x37, 0xaa, 0xc8, 0x22, 0xa2, 0xce, 0x20,
-	0xa6, 0x76, 0x9f, 0x88, 0x12, 0xa8, 0x28, 0x81, 0x61, 0xfd, 0x98, 0x08, 0xf4, 0x16, 0xe4, 0x63,
-	0x21, 0x6c, 0x1a, 0xc8, 0x3a, 0xe1, 0xa5, 0xfc, 0xae, 0x75, 0xb7, 0x78, 0x7f, 0x7b, 0xfe, 0xb4,
-	0x96, 0x16, 0xeb, 0xa3, 0x8e, 0xbb, 0x5d, 0x43, 0x63, 0x88, 0x85, 0x30, 0xdf, 0xe8, 0x0e, 0xe4,
-	0x92, 0x2c, 0xf1, 0x52, 0x41, 0x21, 0x7a, 0xc2, 0x40, 0x67, 0x90, 0x3b, 0xa5, 0xd4, 0xf6, 0x98,
-	0xcf, 0xe2, 0xd2, 0xda, 0x97, 0xdf, 0xd0, 0xb2, 0xa7, 0x94, 0x1e, 0xca, 0xcd, 0x4d, 0x1d, 0xff,
-	0xca, 0x82, 0x15, 0x03, 0xa4, 0x3b, 0x90, 0x1b, 0x17, 0x94, 0xe9, 0x69, 0x13, 0x06, 0xba, 0x07,
-	0x9b, 0x2c, 0xb0, 0x7b, 0xf4, 0x34, 0xe4, 0xd4, 0xe6, 0x54, 0x84, 0xde, 0x85, 0xc6, 0x4b, 0x16,
-	0xaf, 0xb3, 0xa0, 0xae, 0xf8, 0x58, 0xb3, 0xd1, 0x8f, 0x20, 0xaf, 0xf3, 0x2b, 0xf7, 0xd5, 0xb5,
-	0x29, 0xdd, 0x58, 0x94, 0x5e, 0xa9, 0x61, 0xb2, 0x0b, 0x3c, 0x61, 0x08, 0x63, 0xdc, 0xdf, 0xd2,
-	0xb0, 0xad, 0xb1, 0x62, 0xb2, 0xde, 0x21, 0xce, 0x39, 0x8d, 0x65, 0xf3, 0x98, 0x2d, 0x37, 0xeb,
-	0x73, 0xcb, 0x6d, 0x11, 0x3e, 0x53, 0x5f, 0x12, 0x3e, 0xe7, 0x3a, 0x94, 0x04, 0x1b, 0x11, 0xe7,
-	0xb3, 0x60, 0x23, 0xe2, 0x5c, 0x83, 0x6d, 0x06, 0x89, 0xcb, 0x73, 0x48, 0x9c, 0xc9, 0xfc, 0xca,
-	0x7f, 0x31, 0xf3, 0xb2, 0xd8, 0x23, 0x4e, 0x23, 0xc2, 0x75, 0xb1, 0xaf, 0xea, 0x62, 0x37, 0x2c,
-	0x59, 0xec, 0x73, 0x68, 0xc8, 0x3e, 0x0f, 0x0d, 0xb9, 0x97, 0x46, 0x83, 0x49, 0x34, 0x85, 0xbd,
-	0x05, 0x79, 0x3e, 0x70, 0xce, 0x83, 0xf0, 0xd2, 0xa3, 0x6e, 0x9f, 0xfa, 0x34, 0x88, 0xd1, 0x03,
-	0x80, 0xa4, 0x09, 0x8d, 0x3b, 0x6c, 0x79, 0x74, 0x5d, 0xc9, 0x99, 0x55, 0x2a, 0x79, 0x13, 0x62,
-	0x0c, 0xab, 0xb6, 0x6b, 0x8e, 0xf9, 0x43, 0x0a, 0x4a, 0xc9, 0x39, 0x22, 0x0a, 0x03, 0x41, 0x5f,
-	0xae, 0xa0, 0x66, 0x0d, 0x49, 0xbd, 0x80, 0x21, 0xaa, 0x3e, 0x02, 0x61, 0x4a, 0x20, 0x6d, 0xea,
-	0x23, 0x10, 0xba, 0x04, 0xe6, 0xbb, 0x6c, 0xe6, 0xb3, 0x5d, 0x56, 0xa9, 0x28, 0x94, 0x69, 0x95,
-	0xe5, 0x44, 0x45, 0xf1, 0x94, 0x4a, 0x53, 0xb6, 0x74, 0xad, 0x22, 0x62, 0x12, 0x0f, 0x84, 0xea,
-	0xd6, 0xc5, 0xfb, 0xaf, 0x7f, 0x06, 0x80, 0x5a, 0xab, 0xab, 0x94, 0x64, 0xc7, 0x9f, 0x22, 0xe5,
-	0xd4, 0xc1, 0xa9, 0x18, 0x78, 0xb1, 0xaa, 0x8f, 0x02, 0x36, 0x94, 0x89, 0xe4, 0x5f, 0xd2, 0xb2,
-	0x6d, 0x48, 0xc6, 0xff, 0x1f, 0x10, 0x67, 0xb3, 0xbb, 0xf2, 0xd2, 0xd9, 0x5d, 0x7d, 0x4e, 0x76,
-	0xb3, 0xcf, 0xcf, 0x6e, 0xee, 0x8b, 0x64, 0x17, 0x5e, 0x29, 0xbb, 0xf9, 0x05, 0xd9, 0xfd, 0xa3,
-	0x05, 0x6b, 0x5d, 0xd6, 0x0f, 0x58, 0xd0, 0x37, 0x49, 0x7e, 0x07, 0x40, 0x68, 0xc6, 0x04, 0x7a,
-	0x6f, 0xcb, 0x98, 0x18, 0x35, 0x15, 0x93, 0x87, 0x53, 0xbd, 0x48, 0x1a, 0xa3, 0xde, 0x0b, 0x4e,
-	0xe8, 0xd5, 0x9c, 0x33, 0xc2, 0x82, 0xda, 0xc5, 0x9b, 0xb5, 0xa1, 0xe2, 0xc7, 0x42, 0x98, 0xce,
-	0x34, 0x5e, 0x8d, 0x73, 0x66, 0xfb, 0xb6, 0x8b, 0xbe, 0x0e, 0xeb, 0x94, 0xf3, 0x90, 0xab, 0x91,
-	0x4c, 0x44, 0xc4, 0x49, 0x86, 0xe9, 0xa2, 0x62, 0x37, 0x12, 0x2e, 0x7a, 0x1d, 0x60, 0xa2, 0x68,
-	0xc0, 0x94, 0x1b, 0xeb, 0x18, 0x5f, 0x22, 0x58, 0x1f, 0xcf, 0x42, 0xc6, 0xf9, 0xdb, 0x90, 0x63,
-	0xc2, 0x26, 0x4e, 0xcc, 0x2e, 0xa8, 0xf2, 0x25, 0x8b, 0xb3, 0x4c, 0x1c, 0x28, 0x1a, 0x3d, 0x84,
-	0x65, 0xc1, 0x02, 0x73, 0xa6, 0x1c, 0x28, 0xf4, 0x7b, 0xa9, 0x9a, 0xbc, 0x97, 0xaa, 0xc7, 0xc9,
-	0x7b, 0xa9, 0x9e, 0x95, 0x3d, 0xf8, 0xe9, 0xa7, 0x15, 0x0b, 0xeb, 0x25, 0xe6, 0xc4, 0x03, 0x58,
-	0xd7, 0x7b, 0x8d, 0xcf, 0x45, 0x25, 0x58, 0x25, 0xae, 0xcb, 0xa9, 0x10, 0xe6, 0x62, 0x4d, 0x48,
-	0xf9, 0x88, 0x88, 0xc2, 0x4b, 0xca, 0x35, 0x0e, 0xb0, 0x26, 0xf6, 0x7e, 0x97, 0x81, 0x95, 0x0e,
-	0xe1, 0xc4, 0x17, 0x68, 0x1f, 0x5e, 0xf3, 0xc9, 0xd0, 0x9e, 0x9a, 0x97, 0x4c, 0x79, 0xa9, 0x24,
-	0x60, 0xe4, 0x93, 0xe1, 0x64, 0x4e, 0xd2, 0x85, 0xb6, 0x07, 0x6b, 0x72, 0xc9, 0xa4, 0xfc, 0xf5,
-	0xde, 0x79, 0x9f, 0x0c, 0x0f, 0x12, 0x04, 0xdc, 0x83, 0x4d, 0xa9, 0x93, 0xc0, 0xc5, 0x16, 0xec,
-	0xdd, 0x24, 0x84, 0xeb, 0x3e, 0x19, 0x36, 0x0c, 0xbf, 0xcb, 0xde, 0xa5, 0xa8, 0x06, 0x5b, 0xca,
-	0x04, 0x75, 0x37, 0xdb, 0x13, 0x75, 0x8d, 0x2a, 0xb9, 0x8f, 0xbe, 0xb6, 0x9b, 0xc9, 0x82, 0xef,
-	0xc0, 0x4d, 0x3a, 0x8c, 0x18, 0x27, 0xf2, 0x6d, 0x63, 0xf7, 0xbc, 0xd0, 0x39, 0x9f, 0xc1, 0xda,
-	0xd6, 0x44, 0x5a, 0x97, 0x42, 0x6d, 0xd2, 0x1b, 0x50, 0x94, 0xf7, 0x9c, 0x1d, 0x5e, 0x12, 0xe1,
-	0xab, 0x8b, 0x47, 0x61, 0x0f, 0x17, 0x24, 0xf7, 0x48, 0x32, 0xe5, 0xd5, 0xf3, 0x00, 0x6e, 0x45,
-	0x94, 0x4f, 0x46, 0xdf, 0x71, 0x54, 0x26, 0x57, 0xd9, 0xcd, 0x88, 0xf2, 0x71, 0xec, 0x4d, 0x64,
-	0xe4, 0xd2, 0x6f, 0x02, 0x12, 0xc4, 0x8f, 0x3c, 0x59, 0xc5, 0x31, 0xbf, 0x32, 0x26, 0xe9, 0xdb,
-	0x6d, 0x23, 0x91, 0x1c, 0xf3, 0x2b, 0x6d, 0xce, 0xf7, 0xa0, 0x64, 0x9a, 0x15, 0xa7, 0x97, 0x84,
-	0xbb, 0x76, 0x44, 0xb9, 0x43, 0x83, 0x98, 0xf4, 0x35, 0x2e, 0x33, 0xf8, 0x66, 0x68, 0xee, 0x12,
-	0x29, 0xee, 0x8c, 0xa5, 0xe8, 0x21, 0xdc, 0x62, 0x81, 0x2e, 0x2f, 0x3b, 0xa2, 0x01, 0xf1, 0xe2,
-	0x2b, 0xdb, 0x1d, 0x68, 0x7f, 0xcd, 0x68, 0xb9, 0x9d, 0x28, 0x74, 0xb4, 0xbc, 0x69, 0xc4, 0xa8,
-	0x05, 0x37, 0xe4, 0x54, 0x9b, 0x38, 0x45, 0x03, 0xd2, 0xf3, 0xa8, 0xab, 0x50, 0x9a, 0xad, 0xbf,
-	0x36, 0xba, 0xae, 0x6c, 0xb6, 0xeb, 0x0d, 0xe3, 0x53, 0x4b, 0x0b, 0xf1, 0x26, 0xeb, 0x39, 0xb3,
-	0x2c, 0x53, 0x89, 0xdf, 0x07, 0xd4, 0xa1, 0x81, 0xab, 0x61, 0x2c, 0xd1, 0x7f, 0xc8, 0x84, 0xba,
-	0xfe, 0x27, 0xfd, 0x4d, 0x16, 0x64, 0x5a, 0xde, 0xee, 0xe3, 0x26, 0x96, 0x0c, 0x5f, 0x3f, 0x81,
-	0xa9, 0x61, 0x19, 0x6d, 0xc3, 0xaa, 0xca, 0x7e, 0xd2, 0xe3, 0xf1, 0x8a, 0x24, 0xdb, 0xae, 0x04,
-	0xa1, 0x19, 0xc1, 0x93, 0x6e, 0x9e, 0xc3, 0x39, 0xc3, 0x19, 0x5f, 0xbc, 0x1f, 0xa5, 0xe0, 0x86,
-	0xb1, 0xf0, 0x09, 0xe5, 0xec, 0x94, 0x39, 0xda, 0xdb, 0xaf, 0x41, 0x56, 0xf5, 0x86, 0xc9, 0xd5,
-	0x91, 0x1f, 0x5d, 0x57, 0x56, 0x1b, 0x92, 0xd7, 0x6e, 0xe2, 0x55, 0x25, 0x6c, 0xbb, 0xb3, 0xa3,
-	0x69, 0x6a, 0x7e, 0x34, 0x9d, 0x6d, 0xd8, 0xe9, 0x17, 0x69, 0xd8, 0x73, 0x8f, 0xc7, 0xcc, 0x2b,
-	0xbf, 0x79, 0x97, 0x5f, 0xe6, 0xcd, 0x6b, 0xa2, 0xf4, 0x1b, 0x0b, 0xf2, 0x1d, 0xce, 0x1c, 0x6a,
-	0x9a, 0xae, 0x7c, 0xf8, 0x5f, 0xf9, 0xbd, 0xd0, 0x4b, 0x42, 0xae, 0x29, 0xb4, 0x03, 0xe0, 0x0f,
-	0xbc, 0x98, 0x45, 0x1e, 0x1b, 0x37, 0x8e, 0x29, 0x0e, 0x2a, 0x42, 0x2a, 0x1a, 0x1a, 0x30, 0xa7,
-	0xa2, 0xe1, 0x5c, 0x7c, 0x32, 0x2f, 0x12, 0x9f, 0xe7, 0x8f, 0x1b, 0x7b, 0x4f, 0x2d, 0x28, 0x8f,
-	0x87, 0xaa, 0x81, 0x17, 0xcb, 0x9e, 0x4e, 0xe2, 0x01, 0xa7, 0x47, 0x5c, 0x3e, 0x77, 0x5e, 0x7e,
-	0x68, 0x43, 0xfb, 0xb0, 0x9a, 0x4c, 0x98, 0xa9, 0xcf, 0x9d, 0x30, 0x71, 0xa2, 0xf7, 0x30, 0xf3,
-	0xde, 0x87, 0x95, 0xa5, 0x7b, 0xff, 0xb2, 0x60, 0x6d, 0xe6, 0xfa, 0x43, 0x3f, 0x80, 0x0a, 0x6e,
-	0x75, 0x8f, 0x0e, 0x9f, 0xb4, 0xec, 0xee, 0xf1, 0xc1, 0xf1, 0x49, 0xd7, 0x3e, 0xea, 0xb4, 0x1e,
-	0xdb, 0x27, 0x8f, 0xbb, 0x9d, 0x56, 0xa3, 0xfd, 0xa8, 0xdd, 0x6a, 0x6e, 0x2c, 0x95, 0xb7, 0xdf,
-	0xff, 0x60, 0xf7, 0xc6, 0x02, 0x35, 0xf4, 0x5d, 0xb8, 0x39, 0xc7, 0xee, 0x9e, 0x34, 0x1a, 0xad,
-	0x6e, 0x77, 0xc3, 0x2a, 0x97, 0xdf, 0xff, 0x60, 0xf7, 0x3f, 0x48, 0x17, 0xac, 0x7b, 0x74, 0xd0,
-	0x3e, 0x3c, 0xc1, 0xad, 0x8d, 0xd4, 0xc2, 0x75, 0x46, 0xba, 0x60, 0x5d, 0xeb, 0xa7, 0x9d, 0x36,
-	0x6e, 0x35, 0x37, 0xd2, 0x0b, 0xd7, 0x19, 0x69, 0x39, 0xf3, 0xde, 0x47, 0x3b, 0x4b, 0xf7, 0xde,
-	0x81, 0xd5, 0xe4, 0xad, 0xb9, 0x0d, 0x37, 0x5a, 0x8f, 0x1b, 0x47, 0xcd, 0x16, 0x9e, 0x75, 0x15,
-	0x6d, 0xc2, 0x5a, 0x22, 0xe8, 0xe0, 0xa3, 0xe3, 0xa3, 0x0d, 0x0b, 0x6d, 0xc1, 0x46, 0xc2, 0x7a,
-	0x74, 0x72, 0x78, 0x68, 0x1f, 0xd4, 0xdb, 0x1b, 0xa9, 0xe9, 0x1d, 0x3a, 0x07, 0xf8, 0xb8, 0x7d,
-	0xa0, 0x05, 0x69, 0x7d, 0x56, 0xbd, 0xfd, 0xf1, 0x68, 0xc7, 0xfa, 0x64, 0xb4, 0x63, 0xfd, 0x75,
-	0xb4, 0x63, 0x3d, 0x7d, 0xb6, 0xb3, 0xf4, 0xc9, 0xb3, 0x9d, 0xa5, 0x3f, 0x3f, 0xdb, 0x59, 0xfa,
-	0x59, 0xed, 0x0b, 0x0c, 0x03, 0xe6, 0x8f, 0x4c, 0x35, 0x0b, 0xf4, 0x56, 0x94, 0xc6, 0x9b, 0xff,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xb1, 0x6d, 0x99, 0xe4, 0x14, 0x00, 0x00,
+var xxx_messageInfo_TreasuryFee proto.InternalMessageInfo
+
+func (m *TreasuryFee) GetTreasury() string {
+	if m != nil {
+		return m.Treasury
+	}
+	return ""
 }
 
-func (this *DataSource) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *TreasuryFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
 	}
+	return nil
+}
 
-	that1, ok := that.(*DataSource)
-	if !ok {
-		that2, ok := that.(DataSource)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// FeeEscrow is the data source fees of a request that are held by the oracle
+// module until the requested validators report.
+type FeeEscrow struct {
+	// Payer is the account address that paid the fees and receives the refund.
+	Payer string `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	// TreasuryFees are the fees released to the data source treasuries when a
+	// requested validator reports.
+	TreasuryFees []TreasuryFee `protobuf:"bytes,2,rep,name=treasury_fees,json=treasuryFees,proto3" json:"treasury_fees"`
+	// Total is the total fees held in escrow for the request.
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+	// Released is the fees already released to the data source treasuries.
+	Released github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=released,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"released"`
+}
+
+func (m *FeeEscrow) Reset()         { *m = FeeEscrow{} }
+func (m *FeeEscrow) String() string { return proto.CompactTextString(m) }
+func (*FeeEscrow) ProtoMessage()    {}
+func (*FeeEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{22}
+}
+func (m *FeeEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Owner != that1.Owner {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
+}
+func (m *FeeEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeEscrow.Merge(m, src)
+}
+func (m *FeeEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeEscrow proto.InternalMessageInfo
+
+func (m *FeeEscrow) GetPayer() string {
+	if m != nil {
+		return m.Payer
 	}
-	if this.Description != that1.Description {
-		return false
+	return ""
+}
+
+func (m *FeeEscrow) GetTreasuryFees() []TreasuryFee {
+	if m != nil {
+		return m.TreasuryFees
 	}
-	if this.Filename != that1.Filename {
-		return false
+	return nil
+}
+
+func (m *FeeEscrow) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
 	}
-	if this.Treasury != that1.Treasury {
-		return false
+	return nil
+}
+
+func (m *FeeEscrow) GetReleased() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Released
 	}
-	if len(this.Fee) != len(that1.Fee) {
-		return false
-	}
-	for i := range this.Fee {
-		if !this.Fee[i].Equal(&that1.Fee[i]) {
-			return false
-		}
-	}
-	return true
+	return nil
 }
-func (this *OracleScript) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*OracleScript)
-	if !ok {
-		that2, ok := that.(OracleScript)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// RequestSubscription is a request template that spawns a new oracle request
+// every interval blocks, paid from the deposit of its fee payer account.
+type RequestSubscription struct {
+	// ID is the unique identifier of the request subscription.
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Owner is the account address that can cancel the request subscription.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// FeePayer is the account address that holds the deposit and pays the fees
+	// of the spawned requests.
+	FeePayer string `protobuf:"bytes,3,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// OracleScriptID is the identifier of the oracle script to be called.
+	OracleScriptID OracleScriptID `protobuf:"varint,4,opt,name=oracle_script_id,json=oracleScriptId,proto3,casttype=OracleScriptID" json:"oracle_script_id,omitempty"`
+	// Calldata is the OBI-encoded call parameters for the oracle script.
+	Calldata []byte `protobuf:"bytes,5,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is the number of validators to perform the oracle task.
+	AskCount uint64 `protobuf:"varint,6,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is the minimum number of validators sufficient to resolve the
+	// oracle tasks.
+	MinCount uint64 `protobuf:"varint,7,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// ClientID is the client-provided unique identifier to track the requests.
+	ClientID string `protobuf:"bytes,8,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// FeeLimit is the maximum tokens that will be paid to all data source
+	// providers of each request.
+	FeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=fee_limit,json=feeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_limit"`
+	// PrepareGas is amount of gas to pay to prepare raw requests
+	PrepareGas uint64 `protobuf:"varint,10,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
+	// ExecuteGas is amount of gas to reserve for executing
+	ExecuteGas uint64 `protobuf:"varint,11,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	// TSSEncoder is the mode of encoding oracle result signature order.
+	TSSEncoder Encoder `protobuf:"varint,12,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// Interval is the number of blocks between two spawned requests.
+	Interval uint64 `protobuf:"varint,13,opt,name=interval,proto3" json:"interval,omitempty"`
+	// NextRequestHeight is the block height at which the next request will be
+	// spawned.
+	NextRequestHeight int64 `protobuf:"varint,14,opt,name=next_request_height,json=nextRequestHeight,proto3" json:"next_request_height,omitempty"`
+	// IsActive is false if the subscription failed to spawn a request, e.g. its
+	// deposit ran out. It becomes active again on the next top-up.
+	IsActive bool `protobuf:"varint,15,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// LastRequestID is the identifier of the latest spawned request.
+	LastRequestID RequestID `protobuf:"varint,16,opt,name=last_request_id,json=lastRequestId,proto3,casttype=RequestID" json:"last_request_id,omitempty"`
+	// RequestCount is the number of requests spawned by the subscription.
+	RequestCount uint64 `protobuf:"varint,17,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+}
+
+func (m *RequestSubscription) Reset()         { *m = RequestSubscription{} }
+func (m *RequestSubscription) String() string { return proto.CompactTextString(m) }
+func (*RequestSubscription) ProtoMessage()    {}
+func (*RequestSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{23}
+}
+func (m *RequestSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *RequestSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestSubscription.Merge(m, src)
+}
+func (m *RequestSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestSubscription proto.InternalMessageInfo
+
+func (m *RequestSubscription) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	if this.Owner != that1.Owner {
-		return false
+	return 0
+}
+
+func (m *RequestSubscription) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if this.Name != that1.Name {
-		return false
+	return ""
+}
+
+func (m *RequestSubscription) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
 	}
-	if this.Description != that1.Description {
-		return false
+	return ""
+}
+
+func (m *RequestSubscription) GetOracleScriptID() OracleScriptID {
+	if m != nil {
+		return m.OracleScriptID
 	}
-	if this.Filename != that1.Filename {
-		return false
+	return 0
+}
+
+func (m *RequestSubscription) GetCalldata() []byte {
+	if m != nil {
+		return m.Calldata
 	}
-	if this.Schema != that1.Schema {
-		return false
+	return nil
+}
+
+func (m *RequestSubscription) GetAskCount() uint64 {
+	if m != nil {
+		return m.AskCount
 	}
-	if this.SourceCodeURL != that1.SourceCodeURL {
-		return false
+	return 0
+}
+
+func (m *RequestSubscription) GetMinCount() uint64 {
+	if m != nil {
+		return m.MinCount
 	}
-	return true
+	return 0
 }
-func (this *RawRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RequestSubscription) GetClientID() string {
+	if m != nil {
+		return m.ClientID
 	}
+	return ""
+}
 
-	that1, ok := that.(*RawRequest)
-	if !ok {
-		that2, ok := that.(RawRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *RequestSubscription) GetFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeLimit
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *RequestSubscription) GetPrepareGas() uint64 {
+	if m != nil {
+		return m.PrepareGas
 	}
-	if this.ExternalID != that1.ExternalID {
-		return false
+	return 0
+}
+
+func (m *RequestSubscription) GetExecuteGas() uint64 {
+	if m != nil {
+		return m.ExecuteGas
 	}
-	if this.DataSourceID != that1.DataSourceID {
-		return false
+	return 0
+}
+
+func (m *RequestSubscription) GetTSSEncoder() Encoder {
+	if m != nil {
+		return m.TSSEncoder
 	}
-	if !bytes.Equal(this.Calldata, that1.Calldata) {
-		return false
+	return ENCODER_UNSPECIFIED
+}
+
+func (m *RequestSubscription) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
 	}
-	return true
+	return 0
 }
-func (this *RawReport) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RequestSubscription) GetNextRequestHeight() int64 {
+	if m != nil {
+		return m.NextRequestHeight
 	}
+	return 0
+}
 
-	that1, ok := that.(*RawReport)
-	if !ok {
-		that2, ok := that.(RawReport)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *RequestSubscription) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return false
+}
+
+func (m *RequestSubscription) GetLastRequestID() RequestID {
+	if m != nil {
+		return m.LastRequestID
 	}
-	if this.ExternalID != that1.ExternalID {
-		return false
+	return 0
+}
+
+func (m *RequestSubscription) GetRequestCount() uint64 {
+	if m != nil {
+		return m.RequestCount
 	}
-	if this.ExitCode != that1.ExitCode {
-		return false
+	return 0
+}
+
+// DataSourceVersion is an immutable snapshot of a data source that is recorded
+// when the data source is created or edited.
+type DataSourceVersion struct {
+	// DataSourceID is the ID of the data source.
+	DataSourceID DataSourceID `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3,casttype=DataSourceID" json:"data_source_id,omitempty"`
+	// Version is the version number of the data source, starting from 1.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Filename is the file name of the data source executable of this version.
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Treasury is the account address who received the data source fee.
+	Treasury string `protobuf:"bytes,4,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	// Fee is the data source fee per ask_count of this version.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// Editor is the account address who created this version.
+	Editor string `protobuf:"bytes,6,opt,name=editor,proto3" json:"editor,omitempty"`
+	// Height is the block height at which this version was created.
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *DataSourceVersion) Reset()         { *m = DataSourceVersion{} }
+func (m *DataSourceVersion) String() string { return proto.CompactTextString(m) }
+func (*DataSourceVersion) ProtoMessage()    {}
+func (*DataSourceVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{24}
+}
+func (m *DataSourceVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataSourceVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataSourceVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.Data, that1.Data) {
-		return false
+}
+func (m *DataSourceVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSourceVersion.Merge(m, src)
+}
+func (m *DataSourceVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataSourceVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSourceVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSourceVersion proto.InternalMessageInfo
+
+func (m *DataSourceVersion) GetDataSourceID() DataSourceID {
+	if m != nil {
+		return m.DataSourceID
 	}
-	return true
+	return 0
 }
-func (this *Request) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *DataSourceVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
+	return 0
+}
 
-	that1, ok := that.(*Request)
-	if !ok {
-		that2, ok := that.(Request)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *DataSourceVersion) GetFilename() string {
+	if m != nil {
+		return m.Filename
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *DataSourceVersion) GetTreasury() string {
+	if m != nil {
+		return m.Treasury
 	}
-	if this.OracleScriptID != that1.OracleScriptID {
-		return false
+	return ""
+}
+
+func (m *DataSourceVersion) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
 	}
-	if !bytes.Equal(this.Calldata, that1.Calldata) {
-		return false
+	return nil
+}
+
+func (m *DataSourceVersion) GetEditor() string {
+	if m != nil {
+		return m.Editor
 	}
-	if len(this.RequestedValidators) != len(that1.RequestedValidators) {
-		return false
+	return ""
+}
+
+func (m *DataSourceVersion) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	for i := range this.RequestedValidators {
-		if this.RequestedValidators[i] != that1.RequestedValidators[i] {
-			return false
+	return 0
+}
+
+// OracleScriptVersion is an immutable snapshot of an oracle script that is
+// recorded when the oracle script is created or edited.
+type OracleScriptVersion struct {
+	// OracleScriptID is the ID of the oracle script.
+	OracleScriptID OracleScriptID `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3,casttype=OracleScriptID" json:"oracle_script_id,omitempty"`
+	// Version is the version number of the oracle script, starting from 1.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Filename is the file name of the compiled oracle script of this version.
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Schema is the schema of the oracle script input/output of this version.
+	Schema string `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	// SourceCodeURL is the URL of the oracle script's source code of this
+	// version.
+	SourceCodeURL string `protobuf:"bytes,5,opt,name=source_code_url,json=sourceCodeUrl,proto3" json:"source_code_url,omitempty"`
+	// Editor is the account address who created this version.
+	Editor string `protobuf:"bytes,6,opt,name=editor,proto3" json:"editor,omitempty"`
+	// Height is the block height at which this version was created.
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *OracleScriptVersion) Reset()         { *m = OracleScriptVersion{} }
+func (m *OracleScriptVersion) String() string { return proto.CompactTextString(m) }
+func (*OracleScriptVersion) ProtoMessage()    {}
+func (*OracleScriptVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{25}
+}
+func (m *OracleScriptVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleScriptVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleScriptVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.MinCount != that1.MinCount {
-		return false
-	}
-	if this.RequestHeight != that1.RequestHeight {
-		return false
-	}
-	if this.RequestTime != that1.RequestTime {
-		return false
+}
+func (m *OracleScriptVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleScriptVersion.Merge(m, src)
+}
+func (m *OracleScriptVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleScriptVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleScriptVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleScriptVersion proto.InternalMessageInfo
+
+func (m *OracleScriptVersion) GetOracleScriptID() OracleScriptID {
+	if m != nil {
+		return m.OracleScriptID
 	}
-	if this.ClientID != that1.ClientID {
-		return false
+	return 0
+}
+
+func (m *OracleScriptVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	if len(this.RawRequests) != len(that1.RawRequests) {
-		return false
+	return 0
+}
+
+func (m *OracleScriptVersion) GetFilename() string {
+	if m != nil {
+		return m.Filename
 	}
-	for i := range this.RawRequests {
-		if !this.RawRequests[i].Equal(&that1.RawRequests[i]) {
-			return false
-		}
+	return ""
+}
+
+func (m *OracleScriptVersion) GetSchema() string {
+	if m != nil {
+		return m.Schema
 	}
-	if !this.IBCChannel.Equal(that1.IBCChannel) {
-		return false
+	return ""
+}
+
+func (m *OracleScriptVersion) GetSourceCodeURL() string {
+	if m != nil {
+		return m.SourceCodeURL
 	}
-	if this.ExecuteGas != that1.ExecuteGas {
-		return false
+	return ""
+}
+
+func (m *OracleScriptVersion) GetEditor() string {
+	if m != nil {
+		return m.Editor
 	}
-	if this.TSSEncoder != that1.TSSEncoder {
-		return false
+	return ""
+}
+
+func (m *OracleScriptVersion) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if this.Requester != that1.Requester {
-		return false
+	return 0
+}
+
+// DataSourceVersionPin pins a data source called by an oracle script to a
+// specific version.
+type DataSourceVersionPin struct {
+	// DataSourceID is the ID of the data source.
+	DataSourceID DataSourceID `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3,casttype=DataSourceID" json:"data_source_id,omitempty"`
+	// Version is the version of the data source to be executed.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *DataSourceVersionPin) Reset()         { *m = DataSourceVersionPin{} }
+func (m *DataSourceVersionPin) String() string { return proto.CompactTextString(m) }
+func (*DataSourceVersionPin) ProtoMessage()    {}
+func (*DataSourceVersionPin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{26}
+}
+func (m *DataSourceVersionPin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataSourceVersionPin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataSourceVersionPin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(this.FeeLimit) != len(that1.FeeLimit) {
-		return false
+}
+func (m *DataSourceVersionPin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSourceVersionPin.Merge(m, src)
+}
+func (m *DataSourceVersionPin) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataSourceVersionPin) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSourceVersionPin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSourceVersionPin proto.InternalMessageInfo
+
+func (m *DataSourceVersionPin) GetDataSourceID() DataSourceID {
+	if m != nil {
+		return m.DataSourceID
 	}
-	for i := range this.FeeLimit {
-		if !this.FeeLimit[i].Equal(&that1.FeeLimit[i]) {
-			return false
-		}
+	return 0
+}
+
+func (m *DataSourceVersionPin) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return true
+	return 0
 }
-func (this *Report) Equal(that interface{}) bool {
+
+func init() {
+	proto.RegisterEnum("band.oracle.v1.ResolveStatus", ResolveStatus_name, ResolveStatus_value)
+	proto.RegisterEnum("band.oracle.v1.Encoder", Encoder_name, Encoder_value)
+	proto.RegisterType((*DataSource)(nil), "band.oracle.v1.DataSource")
+	proto.RegisterType((*OracleScript)(nil), "band.oracle.v1.OracleScript")
+	proto.RegisterType((*RawRequest)(nil), "band.oracle.v1.RawRequest")
+	proto.RegisterType((*RawReport)(nil), "band.oracle.v1.RawReport")
+	proto.RegisterType((*Request)(nil), "band.oracle.v1.Request")
+	proto.RegisterType((*Report)(nil), "band.oracle.v1.Report")
+	proto.RegisterType((*OracleRequestPacketData)(nil), "band.oracle.v1.OracleRequestPacketData")
+	proto.RegisterType((*OracleRequestPacketAcknowledgement)(nil), "band.oracle.v1.OracleRequestPacketAcknowledgement")
+	proto.RegisterType((*OracleResponsePacketData)(nil), "band.oracle.v1.OracleResponsePacketData")
+	proto.RegisterType((*Result)(nil), "band.oracle.v1.Result")
+	proto.RegisterType((*SigningResult)(nil), "band.oracle.v1.SigningResult")
+	proto.RegisterType((*ValidatorStatus)(nil), "band.oracle.v1.ValidatorStatus")
+	proto.RegisterType((*ValidatorReportInfo)(nil), "band.oracle.v1.ValidatorReportInfo")
+	proto.RegisterType((*ActiveValidator)(nil), "band.oracle.v1.ActiveValidator")
+	proto.RegisterType((*Params)(nil), "band.oracle.v1.Params")
+	proto.RegisterType((*PendingResolveList)(nil), "band.oracle.v1.PendingResolveList")
+	proto.RegisterType((*IBCChannel)(nil), "band.oracle.v1.IBCChannel")
+	proto.RegisterType((*RequestCallback)(nil), "band.oracle.v1.RequestCallback")
+	proto.RegisterType((*RequestVerification)(nil), "band.oracle.v1.RequestVerification")
+	proto.RegisterType((*PriceResult)(nil), "band.oracle.v1.PriceResult")
+	proto.RegisterType((*OracleResultSignatureOrder)(nil), "band.oracle.v1.OracleResultSignatureOrder")
+	proto.RegisterType((*TreasuryFee)(nil), "band.oracle.v1.TreasuryFee")
+	proto.RegisterType((*FeeEscrow)(nil), "band.oracle.v1.FeeEscrow")
+	proto.RegisterType((*RequestSubscription)(nil), "band.oracle.v1.RequestSubscription")
+	proto.RegisterType((*DataSourceVersion)(nil), "band.oracle.v1.DataSourceVersion")
+	proto.RegisterType((*OracleScriptVersion)(nil), "band.oracle.v1.OracleScriptVersion")
+	proto.RegisterType((*DataSourceVersionPin)(nil), "band.oracle.v1.DataSourceVersionPin")
+}
+
+func init() { proto.RegisterFile("band/oracle/v1/oracle.proto", fileDescriptor_9714783eaff1514b) }
+
+var fileDescriptor_9714783eaff1514b = []byte{
+	// 2692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x4b, 0x6f, 0x63, 0x57,
+	0x39, 0xd7, 0x76, 0xfc, 0xf8, 0xfc, 0x48, 0x72, 0x92, 0x66, 0x3c, 0x99, 0x69, 0x1c, 0xd2, 0x02,
+	0xd3, 0x11, 0xc4, 0x9d, 0x69, 0x85, 0xe8, 0xf0, 0x8c, 0x1d, 0xa7, 0x35, 0x0d, 0x13, 0xeb, 0x3a,
+	0x33, 0x20, 0x24, 0xb8, 0x3a, 0xbe, 0xf7, 0xc4, 0x39, 0xcd, 0xf5, 0xbd, 0xe6, 0x9e, 0xeb, 0xc4,
+	0xe9, 0x02, 0x09, 0xb1, 0xa9, 0xba, 0xa8, 0xba, 0x46, 0xaa, 0x54, 0xa9, 0xac, 0xd8, 0xb2, 0x64,
+	0xc7, 0x86, 0xb2, 0xa2, 0x4b, 0x24, 0xa4, 0x14, 0xb9, 0x02, 0xf1, 0x07, 0x58, 0x00, 0x1b, 0x74,
+	0x1e, 0xf7, 0xe5, 0x71, 0x93, 0x36, 0x4d, 0xbb, 0x60, 0x15, 0x7f, 0xdf, 0x77, 0x5e, 0xdf, 0xfb,
+	0x71, 0x03, 0xb7, 0x7a, 0xd8, 0xb1, 0xea, 0xae, 0x87, 0x4d, 0x9b, 0xd4, 0x4f, 0xee, 0xa9, 0x5f,
+	0x5b, 0x43, 0xcf, 0xf5, 0x5d, 0x54, 0xe1, 0xc4, 0x2d, 0x85, 0x3a, 0xb9, 0xb7, 0xb6, 0xd2, 0x77,
+	0xfb, 0xae, 0x20, 0xd5, 0xf9, 0x2f, 0xb9, 0x6a, 0xad, 0xd6, 0x77, 0xdd, 0xbe, 0x4d, 0xea, 0x02,
+	0xea, 0x8d, 0x0e, 0xeb, 0x3e, 0x1d, 0x10, 0xe6, 0xe3, 0xc1, 0x50, 0x2d, 0x58, 0x37, 0x5d, 0x36,
+	0x70, 0x59, 0xbd, 0x87, 0x19, 0xbf, 0xa3, 0x47, 0x7c, 0x7c, 0xaf, 0x6e, 0xba, 0xd4, 0x91, 0xf4,
+	0xcd, 0x7f, 0x69, 0x00, 0x3b, 0xd8, 0xc7, 0x5d, 0x77, 0xe4, 0x99, 0x04, 0xad, 0xc0, 0xbc, 0x7b,
+	0xea, 0x10, 0xaf, 0xaa, 0x6d, 0x68, 0x77, 0x0a, 0xba, 0x04, 0x10, 0x82, 0x8c, 0x83, 0x07, 0xa4,
+	0x9a, 0x12, 0x48, 0xf1, 0x1b, 0x6d, 0x40, 0xd1, 0x22, 0xcc, 0xf4, 0xe8, 0xd0, 0xa7, 0xae, 0x53,
+	0x4d, 0x0b, 0x52, 0x1c, 0x85, 0xd6, 0x20, 0x7f, 0x48, 0x6d, 0x22, 0x76, 0x66, 0x04, 0x39, 0x84,
+	0x39, 0xcd, 0xf7, 0x08, 0x66, 0x23, 0xef, 0xac, 0x3a, 0x2f, 0x69, 0x01, 0x8c, 0x7e, 0x0a, 0xe9,
+	0x43, 0x42, 0xaa, 0xd9, 0x8d, 0xf4, 0x9d, 0xe2, 0xfd, 0x9b, 0x5b, 0x92, 0x81, 0x2d, 0xce, 0xc0,
+	0x96, 0x62, 0x60, 0xab, 0xe9, 0x52, 0xa7, 0xf1, 0xfc, 0xfb, 0xe7, 0xb5, 0xb9, 0xdf, 0x7e, 0x58,
+	0xbb, 0xd3, 0xa7, 0xfe, 0xd1, 0xa8, 0xb7, 0x65, 0xba, 0x83, 0xba, 0xe2, 0x56, 0xfe, 0xf9, 0x3a,
+	0xb3, 0x8e, 0xeb, 0xfe, 0xd9, 0x90, 0x30, 0xb1, 0x81, 0xe9, 0xfc, 0xdc, 0x07, 0x99, 0x7f, 0xbe,
+	0x5b, 0xd3, 0x36, 0xff, 0xac, 0x41, 0x69, 0x5f, 0x08, 0xb7, 0x2b, 0x1e, 0xfc, 0x85, 0x71, 0xbe,
+	0x0a, 0x59, 0x66, 0x1e, 0x91, 0x01, 0x56, 0x7c, 0x2b, 0x08, 0xbd, 0x04, 0x0b, 0x4c, 0xe8, 0xc0,
+	0x30, 0x5d, 0x8b, 0x18, 0x23, 0xcf, 0xae, 0x66, 0xf9, 0x82, 0xc6, 0xd2, 0xe4, 0xbc, 0x56, 0x96,
+	0xea, 0x69, 0xba, 0x16, 0x79, 0xa4, 0xef, 0xe9, 0x65, 0x16, 0x81, 0x9e, 0xad, 0x38, 0xfa, 0xbb,
+	0x06, 0xa0, 0xe3, 0x53, 0x9d, 0xfc, 0x7c, 0x44, 0x98, 0x8f, 0xbe, 0x03, 0x45, 0x32, 0xf6, 0x89,
+	0xe7, 0x60, 0xdb, 0xa0, 0x96, 0xe0, 0x2a, 0xd3, 0xb8, 0x3d, 0x39, 0xaf, 0x41, 0x4b, 0xa1, 0xdb,
+	0x3b, 0xff, 0x49, 0x40, 0x3a, 0x04, 0x1b, 0xda, 0x16, 0xda, 0x85, 0x8a, 0x85, 0x7d, 0x6c, 0xa8,
+	0x37, 0x51, 0x4b, 0x88, 0x20, 0xd3, 0xd8, 0x98, 0x9c, 0xd7, 0x4a, 0x91, 0xc1, 0x88, 0x33, 0x12,
+	0xb0, 0x5e, 0xb2, 0x22, 0xc8, 0xe2, 0xa2, 0x30, 0xb1, 0x6d, 0x73, 0x9c, 0x90, 0x54, 0x49, 0x0f,
+	0x61, 0xb4, 0x05, 0xcb, 0xf1, 0x3b, 0x4e, 0x88, 0xc7, 0xb8, 0x40, 0xb9, 0xc4, 0x32, 0xfa, 0x52,
+	0x74, 0xcc, 0x63, 0x49, 0x50, 0x7c, 0xfe, 0x52, 0x83, 0x82, 0xe0, 0x73, 0xe8, 0x7a, 0x9f, 0x99,
+	0xcd, 0x5b, 0x50, 0x20, 0x63, 0xea, 0x0b, 0x99, 0x0b, 0x0e, 0xcb, 0x7a, 0x9e, 0x23, 0xb8, 0x68,
+	0xb9, 0xf2, 0x63, 0xef, 0x16, 0xbf, 0xd5, 0x1b, 0xfe, 0x3d, 0x0f, 0xb9, 0x40, 0xd0, 0x0f, 0x61,
+	0x51, 0x7a, 0xa9, 0x21, 0x0d, 0x20, 0x7a, 0xc6, 0xb3, 0x93, 0xf3, 0x5a, 0x25, 0x6e, 0x64, 0xe2,
+	0x29, 0x53, 0x18, 0xbd, 0xe2, 0xc6, 0xe1, 0xa4, 0xc4, 0x52, 0x53, 0x12, 0xbb, 0x07, 0x2b, 0x9e,
+	0xbc, 0x96, 0x58, 0xc6, 0x09, 0xb6, 0xa9, 0x85, 0x7d, 0xd7, 0x63, 0xd5, 0xf4, 0x46, 0xfa, 0x4e,
+	0x41, 0x5f, 0x0e, 0x69, 0x8f, 0x43, 0x12, 0xe7, 0x70, 0x40, 0x1d, 0xc3, 0x74, 0x47, 0x8e, 0xaf,
+	0x44, 0x9b, 0x1f, 0x50, 0xa7, 0xc9, 0x61, 0xf4, 0x65, 0xa8, 0xa8, 0x3d, 0xc6, 0x11, 0xa1, 0xfd,
+	0x23, 0x5f, 0x18, 0x65, 0x5a, 0x2f, 0x2b, 0xec, 0x2b, 0x02, 0x89, 0xbe, 0x04, 0xa5, 0x60, 0x19,
+	0x8f, 0x2f, 0xc2, 0x30, 0xd3, 0x7a, 0x51, 0xe1, 0x0e, 0xe8, 0x80, 0xa0, 0xe7, 0xa0, 0x60, 0xda,
+	0x94, 0x38, 0x82, 0xfd, 0x9c, 0x30, 0xdc, 0xd2, 0xe4, 0xbc, 0x96, 0x6f, 0x0a, 0x64, 0x7b, 0x47,
+	0xcf, 0x4b, 0x72, 0xdb, 0x42, 0x4d, 0x28, 0x79, 0xf8, 0xd4, 0x50, 0xbb, 0x59, 0x35, 0x2f, 0x1c,
+	0x7d, 0x6d, 0x2b, 0x19, 0xf0, 0xb6, 0x22, 0x5b, 0x6e, 0x64, 0xb8, 0xa7, 0xeb, 0x45, 0x2f, 0xc4,
+	0x30, 0xf4, 0x2a, 0x14, 0x69, 0xcf, 0x34, 0xcc, 0x23, 0xec, 0x38, 0xc4, 0xae, 0x16, 0x36, 0xb4,
+	0x59, 0x67, 0xb4, 0x1b, 0xcd, 0xa6, 0x5c, 0xd1, 0xa8, 0x70, 0x9b, 0x88, 0x60, 0x1d, 0x68, 0xcf,
+	0x54, 0xbf, 0x51, 0x8d, 0x1b, 0x11, 0x31, 0x47, 0x3e, 0x31, 0xfa, 0x98, 0x55, 0x41, 0x48, 0x09,
+	0x14, 0xea, 0x65, 0xcc, 0xd0, 0x2b, 0x50, 0xf4, 0x19, 0x33, 0x88, 0xc3, 0xed, 0xc4, 0xab, 0x16,
+	0x37, 0xb4, 0x3b, 0x95, 0xfb, 0x37, 0xa6, 0x6f, 0x6b, 0x49, 0xb2, 0xbc, 0xea, 0xa0, 0xdb, 0x55,
+	0xb0, 0x0e, 0x3e, 0x63, 0xea, 0x37, 0xba, 0x0d, 0x85, 0x40, 0x4b, 0x5e, 0xb5, 0x24, 0x22, 0x40,
+	0x84, 0x40, 0x47, 0x50, 0x38, 0x24, 0xc4, 0xb0, 0xe9, 0x80, 0xfa, 0xd5, 0xf2, 0xf5, 0x07, 0xc0,
+	0xfc, 0x21, 0x21, 0x7b, 0xfc, 0x70, 0x74, 0x1f, 0x9e, 0x4a, 0x5a, 0x6d, 0xe0, 0x7d, 0x15, 0xc1,
+	0xfc, 0x72, 0xdc, 0x28, 0x93, 0xfe, 0xf7, 0x6b, 0x0d, 0xb2, 0xca, 0xf9, 0x6e, 0x43, 0x21, 0x34,
+	0x42, 0x15, 0x37, 0x23, 0x04, 0xba, 0x0b, 0x4b, 0xd4, 0x31, 0x7a, 0xe4, 0xd0, 0xf5, 0x88, 0xe1,
+	0x11, 0xe6, 0xda, 0x27, 0xd2, 0xc7, 0xf2, 0xfa, 0x02, 0x75, 0x1a, 0x02, 0xaf, 0x4b, 0x34, 0xfa,
+	0x3e, 0x14, 0xa5, 0x4d, 0xf0, 0x73, 0xa5, 0x3d, 0x73, 0xd6, 0x67, 0x99, 0x04, 0x5f, 0xa1, 0x2c,
+	0x02, 0xbc, 0x00, 0xc1, 0xd4, 0xe3, 0xfe, 0x91, 0x86, 0x1b, 0xd2, 0xbf, 0x94, 0xa5, 0x74, 0xb0,
+	0x79, 0x4c, 0x7c, 0x1e, 0xa0, 0x92, 0x26, 0xaa, 0x5d, 0x68, 0xa2, 0xb3, 0x7c, 0x3a, 0x75, 0x4d,
+	0x3e, 0x3d, 0x1d, 0x05, 0x6f, 0x41, 0x01, 0xb3, 0xe3, 0xa4, 0x83, 0x62, 0x76, 0x2c, 0x1d, 0x34,
+	0xe1, 0xbd, 0xf3, 0x53, 0xde, 0x9b, 0xb0, 0x96, 0xec, 0xe7, 0x69, 0x2d, 0x35, 0x28, 0x0e, 0x3d,
+	0x32, 0xc4, 0x9e, 0x74, 0x90, 0x9c, 0x74, 0x10, 0x85, 0xe2, 0x0e, 0x32, 0xe5, 0x41, 0xf9, 0xcb,
+	0x3c, 0xa8, 0x70, 0x65, 0x0f, 0x52, 0x8a, 0x26, 0xb0, 0x39, 0x43, 0xcf, 0xdb, 0xe6, 0xb1, 0xe3,
+	0x9e, 0xda, 0xc4, 0xea, 0x93, 0x01, 0x71, 0x7c, 0xf4, 0x12, 0x40, 0x10, 0xb8, 0xc2, 0xa8, 0xbc,
+	0x36, 0x39, 0xaf, 0x15, 0xd4, 0x2e, 0xa1, 0xbc, 0x08, 0x08, 0x5d, 0xb1, 0x6d, 0xa9, 0x6b, 0xfe,
+	0x98, 0x82, 0x6a, 0x70, 0x0f, 0x1b, 0xba, 0x0e, 0x23, 0x57, 0x33, 0xa8, 0xe4, 0x43, 0x52, 0x9f,
+	0xe2, 0x21, 0xc2, 0x3e, 0x1c, 0xa6, 0x4c, 0x20, 0xad, 0xec, 0xc3, 0x61, 0xd2, 0x04, 0xa6, 0x23,
+	0x73, 0xe6, 0xc9, 0xc8, 0x2c, 0x96, 0x08, 0x2f, 0x93, 0x4b, 0xe6, 0x83, 0x25, 0x02, 0x27, 0x96,
+	0xec, 0x40, 0x45, 0x81, 0x06, 0xf3, 0xb1, 0x3f, 0x62, 0x22, 0xc2, 0x57, 0xee, 0x3f, 0xfd, 0x84,
+	0x03, 0xca, 0x55, 0x5d, 0xb1, 0x88, 0x67, 0x89, 0x18, 0xc8, 0x2b, 0x1b, 0x8f, 0xb0, 0x91, 0xed,
+	0x0b, 0xfb, 0x28, 0xe9, 0x0a, 0x52, 0x92, 0xfc, 0x6b, 0x9a, 0x87, 0x0d, 0x8e, 0xf8, 0xff, 0x73,
+	0xc4, 0xa4, 0x76, 0xb3, 0x57, 0xd6, 0x6e, 0xee, 0x12, 0xed, 0xe6, 0x2f, 0xd7, 0x6e, 0xe1, 0x93,
+	0x68, 0x17, 0x3e, 0x93, 0x76, 0x8b, 0x33, 0xb4, 0xfb, 0x27, 0x0d, 0xca, 0x5d, 0xda, 0x77, 0xa8,
+	0xd3, 0x57, 0x4a, 0x7e, 0x0d, 0x80, 0x49, 0x44, 0xe4, 0x7a, 0xaf, 0x72, 0x99, 0xa8, 0x65, 0x42,
+	0x26, 0x0f, 0x62, 0xb1, 0x88, 0x3f, 0x46, 0xf4, 0x24, 0xa6, 0x6b, 0xd7, 0xcd, 0x23, 0x4c, 0x9d,
+	0xfa, 0xc9, 0x0b, 0xf5, 0xb1, 0xc0, 0xfb, 0x8c, 0xa9, 0xc8, 0x14, 0xee, 0xd6, 0x0b, 0xea, 0xf8,
+	0xb6, 0x85, 0xbe, 0x0a, 0x0b, 0xc4, 0xf3, 0x5c, 0x4f, 0x94, 0x71, 0x6c, 0x88, 0xcd, 0xa0, 0x60,
+	0xaf, 0x08, 0x74, 0x33, 0xc0, 0xa2, 0xa7, 0x01, 0xa2, 0x85, 0xca, 0x99, 0x0a, 0xe1, 0x1a, 0xc5,
+	0xcb, 0x10, 0x16, 0xc2, 0xfa, 0x49, 0x31, 0x7f, 0x0b, 0x0a, 0x94, 0x19, 0xd8, 0xf4, 0xe9, 0x09,
+	0x11, 0xbc, 0xe4, 0xf5, 0x3c, 0x65, 0xdb, 0x02, 0x46, 0x0f, 0x60, 0x9e, 0x51, 0x47, 0xdd, 0xc9,
+	0x8b, 0x10, 0xd9, 0x93, 0x6d, 0x05, 0x3d, 0xd9, 0xd6, 0x41, 0xd0, 0x93, 0x35, 0xf2, 0x3c, 0x06,
+	0xbf, 0xfd, 0x61, 0x4d, 0xd3, 0xe5, 0x16, 0x75, 0xe3, 0x9b, 0x29, 0x58, 0x0e, 0xaf, 0x94, 0x09,
+	0xad, 0xed, 0x1c, 0xba, 0x97, 0xe4, 0xd7, 0x1a, 0x14, 0x4f, 0xa9, 0x63, 0xb9, 0xa7, 0x06, 0xa3,
+	0xaf, 0xcb, 0xdb, 0x33, 0x3a, 0x48, 0x54, 0x97, 0xbe, 0x2e, 0x6c, 0x83, 0x3a, 0x16, 0x19, 0x1b,
+	0xee, 0xe1, 0x21, 0x23, 0x41, 0xf0, 0x28, 0x0a, 0xdc, 0xbe, 0x40, 0xa1, 0x17, 0x61, 0x75, 0x40,
+	0x19, 0x23, 0x56, 0x90, 0x7a, 0xa5, 0x25, 0x12, 0x4f, 0x39, 0xc0, 0x8a, 0xa4, 0xaa, 0x24, 0xdb,
+	0x94, 0x34, 0xf4, 0x0c, 0x94, 0x7d, 0xd7, 0xc7, 0x76, 0x98, 0xaf, 0xa5, 0x43, 0x94, 0x04, 0x52,
+	0xad, 0x45, 0xcf, 0xc3, 0x8a, 0x5c, 0x94, 0xbc, 0x40, 0xba, 0x87, 0x8e, 0x04, 0xed, 0x87, 0xf1,
+	0xd3, 0x95, 0x30, 0xb6, 0x61, 0x41, 0x0a, 0x36, 0x94, 0x08, 0xaa, 0x42, 0x0e, 0x5b, 0x96, 0x47,
+	0x18, 0x53, 0x52, 0x08, 0x40, 0xde, 0xb5, 0x0d, 0xdd, 0x53, 0xe2, 0x29, 0xee, 0x25, 0xb0, 0xf9,
+	0x5e, 0x01, 0xb2, 0x1d, 0xec, 0xe1, 0x01, 0x43, 0xf7, 0xe0, 0xa9, 0x01, 0x1e, 0x1b, 0xb1, 0x82,
+	0x53, 0xf9, 0x9a, 0x26, 0x9f, 0x31, 0xc0, 0xe3, 0xa8, 0xd0, 0x94, 0x5e, 0xb7, 0x09, 0x65, 0xbe,
+	0x25, 0x8a, 0x05, 0xf2, 0xec, 0xe2, 0x00, 0x8f, 0xb7, 0x83, 0x70, 0x70, 0x17, 0x96, 0xf8, 0x9a,
+	0x20, 0x76, 0x48, 0x0d, 0x48, 0xf9, 0x2e, 0x0c, 0xf0, 0xb8, 0xa9, 0xf0, 0x42, 0x0d, 0x75, 0x58,
+	0x11, 0x4f, 0x10, 0x5c, 0x1a, 0xd1, 0x72, 0xd5, 0xe7, 0xf0, 0x17, 0x08, 0xd2, 0x4e, 0xb0, 0xe1,
+	0x45, 0x58, 0x25, 0xe3, 0x21, 0xf5, 0x30, 0x6f, 0x26, 0x8d, 0x9e, 0xed, 0x9a, 0xc7, 0x89, 0xc0,
+	0xb3, 0x12, 0x51, 0x1b, 0x9c, 0x28, 0x9f, 0xf4, 0x2c, 0x54, 0x78, 0xd2, 0x37, 0xdc, 0x53, 0xcc,
+	0x06, 0x22, 0x0b, 0x4b, 0x49, 0x97, 0x38, 0x76, 0x9f, 0x23, 0x79, 0x1e, 0x7e, 0x09, 0x6e, 0x0e,
+	0x89, 0x17, 0xf5, 0x0e, 0xa1, 0x54, 0xa2, 0xbc, 0xbe, 0x3a, 0x24, 0x5e, 0xcc, 0x1a, 0x05, 0x99,
+	0x6f, 0xfd, 0x1a, 0x20, 0x86, 0x07, 0x43, 0x9b, 0xbb, 0xb4, 0xef, 0x9d, 0xa9, 0x27, 0xc9, 0x54,
+	0xbf, 0x18, 0x50, 0x0e, 0xbc, 0x33, 0xf9, 0x9c, 0x6f, 0x42, 0x55, 0x45, 0x6e, 0x8f, 0x9c, 0x62,
+	0xcf, 0x32, 0x86, 0xc4, 0x33, 0x89, 0xe3, 0xe3, 0xbe, 0x0c, 0x52, 0x19, 0x7d, 0xd5, 0x55, 0x89,
+	0x95, 0x93, 0x3b, 0x21, 0x15, 0x3d, 0x80, 0x9b, 0xd4, 0x91, 0xbe, 0x66, 0x0c, 0x89, 0x83, 0x6d,
+	0xff, 0xcc, 0xb0, 0x46, 0x92, 0x5f, 0x55, 0x9b, 0xdf, 0x08, 0x16, 0x74, 0x24, 0x7d, 0x47, 0x91,
+	0x51, 0x0b, 0x96, 0x79, 0x5b, 0x10, 0x30, 0x45, 0x1c, 0xdc, 0xb3, 0x89, 0x25, 0x42, 0x56, 0xbe,
+	0xf1, 0xd4, 0xe4, 0xbc, 0xb6, 0xd4, 0x6e, 0x34, 0x15, 0x4f, 0x2d, 0x49, 0xd4, 0x97, 0x68, 0xcf,
+	0x4c, 0xa2, 0xd0, 0x77, 0xe1, 0x36, 0x57, 0x99, 0x8d, 0x7d, 0x7e, 0x8a, 0x8c, 0x74, 0x86, 0xf4,
+	0x25, 0xa1, 0xba, 0x92, 0x78, 0x45, 0x75, 0x80, 0xc7, 0x7b, 0x62, 0x89, 0x8c, 0x79, 0x6d, 0xbe,
+	0x40, 0x68, 0xf0, 0x67, 0xb0, 0x3a, 0xf4, 0xa8, 0x49, 0x8c, 0x27, 0x92, 0x57, 0x59, 0x04, 0xc2,
+	0xe7, 0x26, 0xe7, 0xb5, 0xe5, 0x0e, 0x5f, 0x71, 0x69, 0x06, 0x5b, 0x1e, 0x3e, 0xb1, 0xcc, 0xe2,
+	0xaa, 0x50, 0xe6, 0x14, 0x8f, 0x00, 0xb2, 0x74, 0x5f, 0x94, 0x94, 0x1f, 0x45, 0x71, 0xe0, 0x7b,
+	0x92, 0x9b, 0x84, 0x1f, 0xc6, 0xd5, 0xb1, 0x20, 0xf6, 0xdd, 0x1c, 0xe0, 0x71, 0xdc, 0x1f, 0x63,
+	0x1a, 0x79, 0x01, 0x56, 0x03, 0x6b, 0xef, 0x61, 0xf3, 0x98, 0xdb, 0x8a, 0xaa, 0x3a, 0x17, 0x65,
+	0xb7, 0xa0, 0x4c, 0x9e, 0x13, 0x5f, 0xc6, 0x4c, 0xd6, 0x8c, 0x0f, 0xe0, 0x26, 0xcf, 0x98, 0x6c,
+	0xd4, 0x0b, 0x07, 0x23, 0x06, 0xe5, 0xe1, 0xe3, 0x04, 0xdb, 0xd5, 0x25, 0xa9, 0xc6, 0x01, 0x75,
+	0xba, 0x31, 0x7a, 0x5b, 0x91, 0xd1, 0x5b, 0x1a, 0x3c, 0x9d, 0xd8, 0x28, 0x2c, 0x3b, 0xd0, 0x2a,
+	0x9f, 0x0e, 0xa1, 0xeb, 0x2f, 0x77, 0xd7, 0xe2, 0x37, 0x36, 0x30, 0x0b, 0x4a, 0xcb, 0x5d, 0x42,
+	0xd0, 0x1e, 0x3c, 0xc3, 0x25, 0x90, 0x78, 0x53, 0xd0, 0xc0, 0x72, 0x51, 0x4a, 0x2f, 0xad, 0x2e,
+	0x0b, 0xb6, 0x6a, 0x03, 0x3c, 0x8e, 0xb3, 0xa5, 0xce, 0x61, 0x1d, 0xe2, 0x09, 0x7f, 0x55, 0x81,
+	0xee, 0x5b, 0x80, 0x3a, 0xc4, 0xb1, 0x64, 0xca, 0xe4, 0x99, 0x76, 0x8f, 0x32, 0x51, 0x6a, 0x47,
+	0xb5, 0x04, 0x8f, 0x77, 0x69, 0x1e, 0xd5, 0xc3, 0x82, 0x21, 0x88, 0x92, 0x3f, 0x80, 0x58, 0x33,
+	0x8b, 0x6e, 0x40, 0x4e, 0x28, 0x35, 0xa8, 0xa7, 0xf4, 0x2c, 0x07, 0xdb, 0x16, 0x4f, 0x78, 0xaa,
+	0x45, 0x0e, 0x2a, 0xa7, 0x82, 0x5e, 0x50, 0x98, 0xb0, 0xc8, 0xdd, 0x83, 0x85, 0x20, 0x00, 0x2a,
+	0x25, 0xf2, 0x6c, 0xef, 0x63, 0xaf, 0x4f, 0xfc, 0xe0, 0x3c, 0x09, 0xf1, 0x44, 0x18, 0x29, 0x5f,
+	0xc6, 0xc5, 0x7c, 0x5f, 0x69, 0x5c, 0x9d, 0xf6, 0x5e, 0x0a, 0x96, 0xd5, 0x71, 0x8f, 0x89, 0x47,
+	0x0f, 0xa9, 0x29, 0x5d, 0xf3, 0x2b, 0x90, 0x17, 0x59, 0x3d, 0x2a, 0xfa, 0x8a, 0x93, 0xf3, 0x5a,
+	0xae, 0xc9, 0x71, 0xed, 0x1d, 0x3d, 0x27, 0x88, 0x6d, 0x2b, 0x99, 0xf4, 0x52, 0xd3, 0x49, 0x2f,
+	0x59, 0x6a, 0xa5, 0x3f, 0x4d, 0xa9, 0x35, 0x35, 0x2a, 0xca, 0x7c, 0xe6, 0x89, 0xd8, 0xfc, 0x55,
+	0x26, 0x62, 0x4a, 0x4a, 0xbf, 0xd3, 0xa0, 0x28, 0x02, 0x80, 0x2a, 0x97, 0xf8, 0x58, 0xf0, 0x6c,
+	0xd0, 0x73, 0xed, 0x40, 0xe0, 0x12, 0x42, 0xeb, 0x00, 0x83, 0x91, 0xed, 0xd3, 0xa1, 0x4d, 0xc3,
+	0x2c, 0x17, 0xc3, 0xa0, 0x0a, 0xa4, 0x86, 0x63, 0x95, 0x79, 0x52, 0xc3, 0xf1, 0x94, 0x7c, 0x32,
+	0x9f, 0x46, 0x3e, 0x97, 0x37, 0x0a, 0x9b, 0x6f, 0x6b, 0xb0, 0x16, 0xb6, 0x43, 0x23, 0xdb, 0xe7,
+	0xd5, 0x18, 0xf6, 0x47, 0x1e, 0xd9, 0xf7, 0xf8, 0x70, 0xe3, 0xea, 0xed, 0x16, 0xba, 0x07, 0xb9,
+	0xa0, 0x37, 0x4c, 0x5d, 0xd8, 0x1b, 0xea, 0xc1, 0xba, 0x07, 0x99, 0x37, 0xde, 0xad, 0xcd, 0x6d,
+	0xbe, 0xa5, 0x41, 0xf1, 0x40, 0x8d, 0x8e, 0x77, 0x49, 0x72, 0xb2, 0xac, 0xcd, 0x9e, 0x2c, 0xa7,
+	0x3e, 0xd7, 0xc9, 0xf2, 0xef, 0x53, 0x50, 0xd8, 0x25, 0xa4, 0xc5, 0x4c, 0xcf, 0x3d, 0x15, 0x05,
+	0x0a, 0x3e, 0x8b, 0xc6, 0xca, 0x02, 0x40, 0xbb, 0x50, 0x0e, 0x1e, 0xc5, 0xa3, 0x19, 0x53, 0x4f,
+	0xba, 0x35, 0xcd, 0x73, 0x8c, 0x31, 0x35, 0xf2, 0x28, 0xf9, 0x11, 0x8a, 0x21, 0x0c, 0xf3, 0xa2,
+	0x8e, 0x0a, 0x07, 0x26, 0xd7, 0xc8, 0x92, 0x3c, 0x19, 0xf5, 0x21, 0xef, 0x11, 0x9b, 0x60, 0x46,
+	0xb8, 0x39, 0x5d, 0xff, 0x8c, 0x21, 0x38, 0x5c, 0x49, 0xef, 0x57, 0xd9, 0x30, 0x7a, 0xc4, 0x43,
+	0x28, 0x5a, 0x85, 0x54, 0x68, 0x52, 0xd9, 0xc9, 0x79, 0x2d, 0xd5, 0xde, 0xd1, 0x53, 0xd4, 0x8a,
+	0xc6, 0xf6, 0xa9, 0xf8, 0xd8, 0xfe, 0x96, 0x9c, 0x8c, 0x48, 0xc9, 0xa7, 0xd5, 0x04, 0x9e, 0x90,
+	0x8e, 0x10, 0xfe, 0xac, 0x9e, 0x32, 0x73, 0x4d, 0x3d, 0xe5, 0xfc, 0x45, 0x3d, 0x65, 0xf6, 0xa2,
+	0x9e, 0x32, 0x37, 0xd5, 0x53, 0x26, 0x9a, 0xe4, 0xfc, 0x85, 0x4d, 0x72, 0x62, 0x0e, 0x54, 0xf8,
+	0x02, 0xe7, 0x40, 0x70, 0xd9, 0x1c, 0xa8, 0x78, 0xd9, 0x1c, 0xa8, 0x74, 0xf5, 0x49, 0xea, 0x1a,
+	0xe4, 0xc3, 0x72, 0xa2, 0x2c, 0x85, 0x17, 0xc0, 0xfc, 0xcb, 0x82, 0x43, 0xc6, 0xbe, 0x31, 0x35,
+	0xdc, 0xae, 0x88, 0x88, 0xb6, 0xc4, 0x49, 0x7a, 0x62, 0xc0, 0x9d, 0xe8, 0xef, 0x16, 0xa6, 0xfa,
+	0xbb, 0x5d, 0x58, 0xb0, 0x31, 0x8b, 0x0e, 0xa3, 0x96, 0x2c, 0x7b, 0x1a, 0xeb, 0xfc, 0xcb, 0xcc,
+	0x1e, 0x66, 0xfe, 0xc7, 0x84, 0xb7, 0xb2, 0x1d, 0xa3, 0x59, 0xbc, 0x6b, 0x4a, 0xb6, 0x20, 0xb2,
+	0x08, 0x0a, 0x5a, 0x7c, 0xa1, 0x76, 0xe5, 0x05, 0x7f, 0x48, 0xc1, 0xd2, 0xce, 0xf4, 0xf7, 0x8f,
+	0x19, 0x19, 0x48, 0xbb, 0xd2, 0x37, 0x99, 0x2a, 0xe4, 0x82, 0x69, 0xaf, 0x4c, 0x28, 0x01, 0x98,
+	0xf8, 0x70, 0x95, 0xbe, 0xe0, 0x93, 0x5d, 0x66, 0x76, 0x60, 0x9d, 0xff, 0x7c, 0x02, 0x2b, 0x4f,
+	0x8e, 0xc4, 0xa2, 0xbc, 0x1e, 0xc8, 0xca, 0xe4, 0x28, 0x21, 0x8e, 0x57, 0x9a, 0xcd, 0x09, 0xcd,
+	0x2a, 0x48, 0x09, 0xf1, 0x37, 0x29, 0x58, 0xde, 0x7f, 0x72, 0x8c, 0x7d, 0xed, 0x1f, 0x6c, 0xae,
+	0x26, 0xce, 0xe8, 0x3b, 0x60, 0xe6, 0xb2, 0xef, 0x80, 0xf3, 0x9f, 0xec, 0x3b, 0xe0, 0x15, 0xc5,
+	0xf4, 0x0b, 0x58, 0x79, 0xc2, 0xd4, 0x3a, 0xf4, 0x0b, 0xb0, 0x36, 0x79, 0xff, 0xdd, 0xff, 0x6a,
+	0x50, 0x4e, 0x4c, 0x9e, 0xd0, 0xb7, 0xa1, 0xa6, 0xb7, 0xba, 0xfb, 0x7b, 0x8f, 0x5b, 0x46, 0xf7,
+	0x60, 0xfb, 0xe0, 0x51, 0xd7, 0xd8, 0xef, 0xb4, 0x1e, 0x1a, 0x8f, 0x1e, 0x76, 0x3b, 0xad, 0x66,
+	0x7b, 0xb7, 0xdd, 0xda, 0x59, 0x9c, 0x5b, 0xbb, 0xf1, 0xe6, 0x3b, 0x1b, 0xcb, 0x33, 0x96, 0xa1,
+	0x6f, 0xc0, 0xea, 0x14, 0xba, 0xfb, 0xa8, 0xd9, 0x6c, 0x75, 0xbb, 0x8b, 0xda, 0xda, 0xda, 0x9b,
+	0xef, 0x6c, 0x7c, 0x0c, 0x75, 0xc6, 0xbe, 0xdd, 0xed, 0xf6, 0xde, 0x23, 0xbd, 0xb5, 0x98, 0x9a,
+	0xb9, 0x4f, 0x51, 0x67, 0xec, 0x6b, 0xfd, 0xb8, 0xd3, 0xd6, 0x5b, 0x3b, 0x8b, 0xe9, 0x99, 0xfb,
+	0x14, 0x75, 0x2d, 0xf3, 0xc6, 0x7b, 0xeb, 0x73, 0x77, 0x5f, 0x83, 0x5c, 0x10, 0xd0, 0x6e, 0xc0,
+	0x72, 0xeb, 0x61, 0x73, 0x7f, 0xa7, 0xa5, 0x27, 0x59, 0x45, 0x4b, 0x50, 0x0e, 0x08, 0x1d, 0x7d,
+	0xff, 0x60, 0x7f, 0x51, 0x43, 0x2b, 0xb0, 0x18, 0xa0, 0x76, 0x1f, 0xed, 0xed, 0x19, 0xdb, 0x8d,
+	0xf6, 0x62, 0x2a, 0x7e, 0x42, 0x67, 0x5b, 0x3f, 0x68, 0x6f, 0x4b, 0x42, 0x5a, 0xde, 0xd5, 0x68,
+	0xbf, 0x3f, 0x59, 0xd7, 0x3e, 0x98, 0xac, 0x6b, 0x7f, 0x9b, 0xac, 0x6b, 0x6f, 0x7f, 0xb4, 0x3e,
+	0xf7, 0xc1, 0x47, 0xeb, 0x73, 0x7f, 0xf9, 0x68, 0x7d, 0xee, 0x27, 0xf5, 0x4f, 0x30, 0x87, 0x53,
+	0xff, 0xa7, 0x20, 0x9c, 0xb3, 0x97, 0x15, 0x2b, 0x5e, 0xf8, 0xdf, 0x00, 0xc5, 0x70, 0x76, 0xac,
+	0xc3, 0x20, 0x00, 0x00,
+}
+
+func (this *DataSource) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Report)
+	that1, ok := that.(*DataSource)
 	if !ok {
-		that2, ok := that.(Report)
+		that2, ok := that.(DataSource)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2105,30 +2785,39 @@ func (this *Report) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Validator != that1.Validator {
+	if this.Owner != that1.Owner {
 		return false
 	}
-	if this.InBeforeResolve != that1.InBeforeResolve {
+	if this.Name != that1.Name {
 		return false
 	}
-	if len(this.RawReports) != len(that1.RawReports) {
+	if this.Description != that1.Description {
 		return false
 	}
-	for i := range this.RawReports {
-		if !this.RawReports[i].Equal(&that1.RawReports[i]) {
+	if this.Filename != that1.Filename {
+		return false
+	}
+	if this.Treasury != that1.Treasury {
+		return false
+	}
+	if len(this.Fee) != len(that1.Fee) {
+		return false
+	}
+	for i := range this.Fee {
+		if !this.Fee[i].Equal(&that1.Fee[i]) {
 			return false
 		}
 	}
 	return true
 }
-func (this *OracleRequestPacketData) Equal(that interface{}) bool {
+func (this *OracleScript) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*OracleRequestPacketData)
+	that1, ok := that.(*OracleScript)
 	if !ok {
-		that2, ok := that.(OracleRequestPacketData)
+		that2, ok := that.(OracleScript)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2140,48 +2829,34 @@ func (this *OracleRequestPacketData) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ClientID != that1.ClientID {
-		return false
-	}
-	if this.OracleScriptID != that1.OracleScriptID {
-		return false
-	}
-	if !bytes.Equal(this.Calldata, that1.Calldata) {
-		return false
-	}
-	if this.AskCount != that1.AskCount {
+	if this.Owner != that1.Owner {
 		return false
 	}
-	if this.MinCount != that1.MinCount {
+	if this.Name != that1.Name {
 		return false
 	}
-	if len(this.FeeLimit) != len(that1.FeeLimit) {
+	if this.Description != that1.Description {
 		return false
 	}
-	for i := range this.FeeLimit {
-		if !this.FeeLimit[i].Equal(&that1.FeeLimit[i]) {
-			return false
-		}
-	}
-	if this.PrepareGas != that1.PrepareGas {
+	if this.Filename != that1.Filename {
 		return false
 	}
-	if this.ExecuteGas != that1.ExecuteGas {
+	if this.Schema != that1.Schema {
 		return false
 	}
-	if this.TSSEncoder != that1.TSSEncoder {
+	if this.SourceCodeURL != that1.SourceCodeURL {
 		return false
 	}
 	return true
 }
-func (this *OracleRequestPacketAcknowledgement) Equal(that interface{}) bool {
+func (this *RawRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*OracleRequestPacketAcknowledgement)
+	that1, ok := that.(*RawRequest)
 	if !ok {
-		that2, ok := that.(OracleRequestPacketAcknowledgement)
+		that2, ok := that.(RawRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2193,19 +2868,28 @@ func (this *OracleRequestPacketAcknowledgement) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.RequestID != that1.RequestID {
+	if this.ExternalID != that1.ExternalID {
+		return false
+	}
+	if this.DataSourceID != that1.DataSourceID {
+		return false
+	}
+	if !bytes.Equal(this.Calldata, that1.Calldata) {
+		return false
+	}
+	if this.DataSourceVersion != that1.DataSourceVersion {
 		return false
 	}
 	return true
 }
-func (this *OracleResponsePacketData) Equal(that interface{}) bool {
+func (this *RawReport) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*OracleResponsePacketData)
+	that1, ok := that.(*RawReport)
 	if !ok {
-		that2, ok := that.(OracleResponsePacketData)
+		that2, ok := that.(RawReport)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2217,37 +2901,25 @@ func (this *OracleResponsePacketData) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ClientID != that1.ClientID {
-		return false
-	}
-	if this.RequestID != that1.RequestID {
-		return false
-	}
-	if this.AnsCount != that1.AnsCount {
-		return false
-	}
-	if this.RequestTime != that1.RequestTime {
-		return false
-	}
-	if this.ResolveTime != that1.ResolveTime {
+	if this.ExternalID != that1.ExternalID {
 		return false
 	}
-	if this.ResolveStatus != that1.ResolveStatus {
+	if this.ExitCode != that1.ExitCode {
 		return false
 	}
-	if !bytes.Equal(this.Result, that1.Result) {
+	if !bytes.Equal(this.Data, that1.Data) {
 		return false
 	}
 	return true
 }
-func (this *Result) Equal(that interface{}) bool {
+func (this *Request) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Result)
+	that1, ok := that.(*Request)
 	if !ok {
-		that2, ok := that.(Result)
+		that2, ok := that.(Request)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2259,49 +2931,73 @@ func (this *Result) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ClientID != that1.ClientID {
-		return false
-	}
 	if this.OracleScriptID != that1.OracleScriptID {
 		return false
 	}
 	if !bytes.Equal(this.Calldata, that1.Calldata) {
 		return false
 	}
-	if this.AskCount != that1.AskCount {
+	if len(this.RequestedValidators) != len(that1.RequestedValidators) {
 		return false
 	}
-	if this.MinCount != that1.MinCount {
-		return false
+	for i := range this.RequestedValidators {
+		if this.RequestedValidators[i] != that1.RequestedValidators[i] {
+			return false
+		}
 	}
-	if this.RequestID != that1.RequestID {
+	if this.MinCount != that1.MinCount {
 		return false
 	}
-	if this.AnsCount != that1.AnsCount {
+	if this.RequestHeight != that1.RequestHeight {
 		return false
 	}
 	if this.RequestTime != that1.RequestTime {
 		return false
 	}
-	if this.ResolveTime != that1.ResolveTime {
+	if this.ClientID != that1.ClientID {
 		return false
 	}
-	if this.ResolveStatus != that1.ResolveStatus {
+	if len(this.RawRequests) != len(that1.RawRequests) {
 		return false
 	}
-	if !bytes.Equal(this.Result, that1.Result) {
+	for i := range this.RawRequests {
+		if !this.RawRequests[i].Equal(&that1.RawRequests[i]) {
+			return false
+		}
+	}
+	if !this.IBCChannel.Equal(that1.IBCChannel) {
+		return false
+	}
+	if this.ExecuteGas != that1.ExecuteGas {
+		return false
+	}
+	if this.TSSEncoder != that1.TSSEncoder {
+		return false
+	}
+	if this.Requester != that1.Requester {
+		return false
+	}
+	if len(this.FeeLimit) != len(that1.FeeLimit) {
+		return false
+	}
+	for i := range this.FeeLimit {
+		if !this.FeeLimit[i].Equal(&that1.FeeLimit[i]) {
+			return false
+		}
+	}
+	if this.OracleScriptVersion != that1.OracleScriptVersion {
 		return false
 	}
 	return true
 }
-func (this *SigningResult) Equal(that interface{}) bool {
+func (this *Report) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SigningResult)
+	that1, ok := that.(*Report)
 	if !ok {
-		that2, ok := that.(SigningResult)
+		that2, ok := that.(Report)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2313,25 +3009,30 @@ func (this *SigningResult) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.SigningID != that1.SigningID {
+	if this.Validator != that1.Validator {
 		return false
 	}
-	if this.ErrorCodespace != that1.ErrorCodespace {
+	if this.InBeforeResolve != that1.InBeforeResolve {
 		return false
 	}
-	if this.ErrorCode != that1.ErrorCode {
+	if len(this.RawReports) != len(that1.RawReports) {
 		return false
 	}
+	for i := range this.RawReports {
+		if !this.RawReports[i].Equal(&that1.RawReports[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *ValidatorStatus) Equal(that interface{}) bool {
+func (this *OracleRequestPacketData) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ValidatorStatus)
+	that1, ok := that.(*OracleRequestPacketData)
 	if !ok {
-		that2, ok := that.(ValidatorStatus)
+		that2, ok := that.(OracleRequestPacketData)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2343,22 +3044,48 @@ func (this *ValidatorStatus) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.IsActive != that1.IsActive {
+	if this.ClientID != that1.ClientID {
 		return false
 	}
-	if !this.Since.Equal(that1.Since) {
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
+	}
+	if !bytes.Equal(this.Calldata, that1.Calldata) {
+		return false
+	}
+	if this.AskCount != that1.AskCount {
+		return false
+	}
+	if this.MinCount != that1.MinCount {
+		return false
+	}
+	if len(this.FeeLimit) != len(that1.FeeLimit) {
+		return false
+	}
+	for i := range this.FeeLimit {
+		if !this.FeeLimit[i].Equal(&that1.FeeLimit[i]) {
+			return false
+		}
+	}
+	if this.PrepareGas != that1.PrepareGas {
+		return false
+	}
+	if this.ExecuteGas != that1.ExecuteGas {
+		return false
+	}
+	if this.TSSEncoder != that1.TSSEncoder {
 		return false
 	}
 	return true
 }
-func (this *Params) Equal(that interface{}) bool {
+func (this *OracleRequestPacketAcknowledgement) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Params)
+	that1, ok := that.(*OracleRequestPacketAcknowledgement)
 	if !ok {
-		that2, ok := that.(Params)
+		that2, ok := that.(OracleRequestPacketAcknowledgement)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2370,49 +3097,61 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MaxRawRequestCount != that1.MaxRawRequestCount {
+	if this.RequestID != that1.RequestID {
 		return false
 	}
-	if this.MaxAskCount != that1.MaxAskCount {
-		return false
+	return true
+}
+func (this *OracleResponsePacketData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.MaxCalldataSize != that1.MaxCalldataSize {
-		return false
+
+	that1, ok := that.(*OracleResponsePacketData)
+	if !ok {
+		that2, ok := that.(OracleResponsePacketData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.MaxReportDataSize != that1.MaxReportDataSize {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.ExpirationBlockCount != that1.ExpirationBlockCount {
+	if this.ClientID != that1.ClientID {
 		return false
 	}
-	if this.BaseOwasmGas != that1.BaseOwasmGas {
+	if this.RequestID != that1.RequestID {
 		return false
 	}
-	if this.PerValidatorRequestGas != that1.PerValidatorRequestGas {
+	if this.AnsCount != that1.AnsCount {
 		return false
 	}
-	if this.SamplingTryCount != that1.SamplingTryCount {
+	if this.RequestTime != that1.RequestTime {
 		return false
 	}
-	if this.OracleRewardPercentage != that1.OracleRewardPercentage {
+	if this.ResolveTime != that1.ResolveTime {
 		return false
 	}
-	if this.InactivePenaltyDuration != that1.InactivePenaltyDuration {
+	if this.ResolveStatus != that1.ResolveStatus {
 		return false
 	}
-	if this.IBCRequestEnabled != that1.IBCRequestEnabled {
+	if !bytes.Equal(this.Result, that1.Result) {
 		return false
 	}
 	return true
 }
-func (this *PendingResolveList) Equal(that interface{}) bool {
+func (this *Result) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PendingResolveList)
+	that1, ok := that.(*Result)
 	if !ok {
-		that2, ok := that.(PendingResolveList)
+		that2, ok := that.(Result)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2424,24 +3163,79 @@ func (this *PendingResolveList) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.RequestIds) != len(that1.RequestIds) {
+	if this.ClientID != that1.ClientID {
 		return false
 	}
-	for i := range this.RequestIds {
-		if this.RequestIds[i] != that1.RequestIds[i] {
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
+	}
+	if !bytes.Equal(this.Calldata, that1.Calldata) {
+		return false
+	}
+	if this.AskCount != that1.AskCount {
+		return false
+	}
+	if this.MinCount != that1.MinCount {
+		return false
+	}
+	if this.RequestID != that1.RequestID {
+		return false
+	}
+	if this.AnsCount != that1.AnsCount {
+		return false
+	}
+	if this.RequestTime != that1.RequestTime {
+		return false
+	}
+	if this.ResolveTime != that1.ResolveTime {
+		return false
+	}
+	if this.ResolveStatus != that1.ResolveStatus {
+		return false
+	}
+	if !bytes.Equal(this.Result, that1.Result) {
+		return false
+	}
+	return true
+}
+func (this *SigningResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SigningResult)
+	if !ok {
+		that2, ok := that.(SigningResult)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SigningID != that1.SigningID {
+		return false
+	}
+	if this.ErrorCodespace != that1.ErrorCodespace {
+		return false
+	}
+	if this.ErrorCode != that1.ErrorCode {
+		return false
+	}
 	return true
 }
-func (this *IBCChannel) Equal(that interface{}) bool {
+func (this *ValidatorStatus) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*IBCChannel)
+	that1, ok := that.(*ValidatorStatus)
 	if !ok {
-		that2, ok := that.(IBCChannel)
+		that2, ok := that.(ValidatorStatus)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2453,22 +3247,22 @@ func (this *IBCChannel) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.PortId != that1.PortId {
+	if this.IsActive != that1.IsActive {
 		return false
 	}
-	if this.ChannelId != that1.ChannelId {
+	if !this.Since.Equal(that1.Since) {
 		return false
 	}
 	return true
 }
-func (this *RequestVerification) Equal(that interface{}) bool {
+func (this *ValidatorReportInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RequestVerification)
+	that1, ok := that.(*ValidatorReportInfo)
 	if !ok {
-		that2, ok := that.(RequestVerification)
+		that2, ok := that.(ValidatorReportInfo)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2480,754 +3274,574 @@ func (this *RequestVerification) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ChainID != that1.ChainID {
+	if this.Validator != that1.Validator {
 		return false
 	}
-	if this.Validator != that1.Validator {
+	if this.WindowSize != that1.WindowSize {
 		return false
 	}
-	if this.RequestID != that1.RequestID {
+	if this.IndexOffset != that1.IndexOffset {
 		return false
 	}
-	if this.ExternalID != that1.ExternalID {
+	if this.MissedReportsCounter != that1.MissedReportsCounter {
 		return false
 	}
-	if this.DataSourceID != that1.DataSourceID {
+	if this.TotalReports != that1.TotalReports {
+		return false
+	}
+	if this.TotalMissedReports != that1.TotalMissedReports {
 		return false
 	}
 	return true
 }
-func (m *DataSource) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DataSource) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *DataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fee) > 0 {
-		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.Treasury) > 0 {
-		i -= len(m.Treasury)
-		copy(dAtA[i:], m.Treasury)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Treasury)))
-		i--
-		dAtA[i] = 0x2a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Filename) > 0 {
-		i -= len(m.Filename)
-		copy(dAtA[i:], m.Filename)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Filename)))
-		i--
-		dAtA[i] = 0x22
+	if this.MaxRawRequestCount != that1.MaxRawRequestCount {
+		return false
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
+	if this.MaxAskCount != that1.MaxAskCount {
+		return false
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	if this.MaxCalldataSize != that1.MaxCalldataSize {
+		return false
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+	if this.MaxReportDataSize != that1.MaxReportDataSize {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *OracleScript) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.ExpirationBlockCount != that1.ExpirationBlockCount {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *OracleScript) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *OracleScript) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SourceCodeURL) > 0 {
-		i -= len(m.SourceCodeURL)
-		copy(dAtA[i:], m.SourceCodeURL)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.SourceCodeURL)))
-		i--
-		dAtA[i] = 0x32
+	if this.BaseOwasmGas != that1.BaseOwasmGas {
+		return false
 	}
-	if len(m.Schema) > 0 {
-		i -= len(m.Schema)
-		copy(dAtA[i:], m.Schema)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Schema)))
-		i--
-		dAtA[i] = 0x2a
+	if this.PerValidatorRequestGas != that1.PerValidatorRequestGas {
+		return false
 	}
-	if len(m.Filename) > 0 {
-		i -= len(m.Filename)
-		copy(dAtA[i:], m.Filename)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Filename)))
-		i--
-		dAtA[i] = 0x22
+	if this.SamplingTryCount != that1.SamplingTryCount {
+		return false
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
+	if this.OracleRewardPercentage != that1.OracleRewardPercentage {
+		return false
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	if this.InactivePenaltyDuration != that1.InactivePenaltyDuration {
+		return false
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+	if this.IBCRequestEnabled != that1.IBCRequestEnabled {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RawRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.MaxLatestResultIndexSize != that1.MaxLatestResultIndexSize {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RawRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Calldata) > 0 {
-		i -= len(m.Calldata)
-		copy(dAtA[i:], m.Calldata)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Calldata)))
-		i--
-		dAtA[i] = 0x1a
+	if this.PriceOracleScriptID != that1.PriceOracleScriptID {
+		return false
 	}
-	if m.DataSourceID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceID))
-		i--
-		dAtA[i] = 0x10
+	if this.ReportWindowSize != that1.ReportWindowSize {
+		return false
 	}
-	if m.ExternalID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ExternalID))
-		i--
-		dAtA[i] = 0x8
+	if this.MaxMissedReportPercentage != that1.MaxMissedReportPercentage {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RawReport) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.MaxCallbackGasLimit != that1.MaxCallbackGasLimit {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RawReport) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RawReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x1a
+	if this.MinSubscriptionInterval != that1.MinSubscriptionInterval {
+		return false
 	}
-	if m.ExitCode != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ExitCode))
-		i--
-		dAtA[i] = 0x10
+	if len(this.SubscriptionBaseRequestFee) != len(that1.SubscriptionBaseRequestFee) {
+		return false
 	}
-	if m.ExternalID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ExternalID))
-		i--
-		dAtA[i] = 0x8
+	for i := range this.SubscriptionBaseRequestFee {
+		if !this.SubscriptionBaseRequestFee[i].Equal(&that1.SubscriptionBaseRequestFee[i]) {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.MaxSubscriptionRequestsPerBlock != that1.MaxSubscriptionRequestsPerBlock {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *PendingResolveList) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeeLimit) > 0 {
-		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6a
+	that1, ok := that.(*PendingResolveList)
+	if !ok {
+		that2, ok := that.(PendingResolveList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.Requester) > 0 {
-		i -= len(m.Requester)
-		copy(dAtA[i:], m.Requester)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Requester)))
-		i--
-		dAtA[i] = 0x62
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.TSSEncoder != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.TSSEncoder))
-		i--
-		dAtA[i] = 0x58
+	if len(this.RequestIds) != len(that1.RequestIds) {
+		return false
 	}
-	if m.ExecuteGas != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ExecuteGas))
-		i--
-		dAtA[i] = 0x50
+	for i := range this.RequestIds {
+		if this.RequestIds[i] != that1.RequestIds[i] {
+			return false
+		}
 	}
-	if m.IBCChannel != nil {
-		{
-			size, err := m.IBCChannel.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOracle(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+	return true
+}
+func (this *IBCChannel) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.RawRequests) > 0 {
-		for iNdEx := len(m.RawRequests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RawRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
+
+	that1, ok := that.(*IBCChannel)
+	if !ok {
+		that2, ok := that.(IBCChannel)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.ClientID) > 0 {
-		i -= len(m.ClientID)
-		copy(dAtA[i:], m.ClientID)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.ClientID)))
-		i--
-		dAtA[i] = 0x3a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.RequestTime != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.RequestTime))
-		i--
-		dAtA[i] = 0x30
+	if this.PortId != that1.PortId {
+		return false
 	}
-	if m.RequestHeight != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.RequestHeight))
-		i--
-		dAtA[i] = 0x28
+	if this.ChannelId != that1.ChannelId {
+		return false
 	}
-	if m.MinCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MinCount))
-		i--
-		dAtA[i] = 0x20
+	return true
+}
+func (this *RequestCallback) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.RequestedValidators) > 0 {
-		for iNdEx := len(m.RequestedValidators) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RequestedValidators[iNdEx])
-			copy(dAtA[i:], m.RequestedValidators[iNdEx])
-			i = encodeVarintOracle(dAtA, i, uint64(len(m.RequestedValidators[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+
+	that1, ok := that.(*RequestCallback)
+	if !ok {
+		that2, ok := that.(RequestCallback)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.Calldata) > 0 {
-		i -= len(m.Calldata)
-		copy(dAtA[i:], m.Calldata)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Calldata)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.OracleScriptID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptID))
-		i--
-		dAtA[i] = 0x8
+	if this.Target != that1.Target {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Report) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.GasLimit != that1.GasLimit {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Report) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *Report) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RawReports) > 0 {
-		for iNdEx := len(m.RawReports) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RawReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func (this *RequestVerification) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.InBeforeResolve {
-		i--
-		if m.InBeforeResolve {
-			dAtA[i] = 1
+
+	that1, ok := that.(*RequestVerification)
+	if !ok {
+		that2, ok := that.(RequestVerification)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x10
 	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *OracleRequestPacketData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.ChainID != that1.ChainID {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *OracleRequestPacketData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *OracleRequestPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TSSEncoder != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.TSSEncoder))
-		i--
-		dAtA[i] = 0x48
+	if this.Validator != that1.Validator {
+		return false
 	}
-	if m.ExecuteGas != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ExecuteGas))
-		i--
-		dAtA[i] = 0x40
+	if this.RequestID != that1.RequestID {
+		return false
 	}
-	if m.PrepareGas != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.PrepareGas))
-		i--
-		dAtA[i] = 0x38
+	if this.ExternalID != that1.ExternalID {
+		return false
 	}
-	if len(m.FeeLimit) > 0 {
-		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+	if this.DataSourceID != that1.DataSourceID {
+		return false
 	}
-	if m.MinCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MinCount))
-		i--
-		dAtA[i] = 0x28
+	return true
+}
+func (this *TreasuryFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.AskCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.AskCount))
-		i--
-		dAtA[i] = 0x20
+
+	that1, ok := that.(*TreasuryFee)
+	if !ok {
+		that2, ok := that.(TreasuryFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Calldata) > 0 {
-		i -= len(m.Calldata)
-		copy(dAtA[i:], m.Calldata)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Calldata)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.OracleScriptID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptID))
-		i--
-		dAtA[i] = 0x10
+	if this.Treasury != that1.Treasury {
+		return false
 	}
-	if len(m.ClientID) > 0 {
-		i -= len(m.ClientID)
-		copy(dAtA[i:], m.ClientID)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.ClientID)))
-		i--
-		dAtA[i] = 0xa
+	if len(this.Fee) != len(that1.Fee) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *OracleRequestPacketAcknowledgement) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	for i := range this.Fee {
+		if !this.Fee[i].Equal(&that1.Fee[i]) {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *OracleRequestPacketAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *OracleRequestPacketAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RequestID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.RequestID))
-		i--
-		dAtA[i] = 0x8
+func (this *FeeEscrow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *OracleResponsePacketData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*FeeEscrow)
+	if !ok {
+		that2, ok := that.(FeeEscrow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *OracleResponsePacketData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *OracleResponsePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Result) > 0 {
-		i -= len(m.Result)
-		copy(dAtA[i:], m.Result)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Result)))
-		i--
-		dAtA[i] = 0x3a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.ResolveStatus != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ResolveStatus))
-		i--
-		dAtA[i] = 0x30
+	if this.Payer != that1.Payer {
+		return false
 	}
-	if m.ResolveTime != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ResolveTime))
-		i--
-		dAtA[i] = 0x28
+	if len(this.TreasuryFees) != len(that1.TreasuryFees) {
+		return false
 	}
-	if m.RequestTime != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.RequestTime))
-		i--
-		dAtA[i] = 0x20
+	for i := range this.TreasuryFees {
+		if !this.TreasuryFees[i].Equal(&that1.TreasuryFees[i]) {
+			return false
+		}
 	}
-	if m.AnsCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.AnsCount))
-		i--
-		dAtA[i] = 0x18
+	if len(this.Total) != len(that1.Total) {
+		return false
 	}
-	if m.RequestID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.RequestID))
-		i--
-		dAtA[i] = 0x10
+	for i := range this.Total {
+		if !this.Total[i].Equal(&that1.Total[i]) {
+			return false
+		}
 	}
-	if len(m.ClientID) > 0 {
-		i -= len(m.ClientID)
-		copy(dAtA[i:], m.ClientID)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.ClientID)))
-		i--
-		dAtA[i] = 0xa
+	if len(this.Released) != len(that1.Released) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Result) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	for i := range this.Released {
+		if !this.Released[i].Equal(&that1.Released[i]) {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Result) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *RequestSubscription) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Result) > 0 {
-		i -= len(m.Result)
-		copy(dAtA[i:], m.Result)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Result)))
-		i--
-		dAtA[i] = 0x5a
+	that1, ok := that.(*RequestSubscription)
+	if !ok {
+		that2, ok := that.(RequestSubscription)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.ResolveStatus != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ResolveStatus))
-		i--
-		dAtA[i] = 0x50
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.ResolveTime != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ResolveTime))
-		i--
-		dAtA[i] = 0x48
+	if this.ID != that1.ID {
+		return false
 	}
-	if m.RequestTime != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.RequestTime))
-		i--
-		dAtA[i] = 0x40
+	if this.Owner != that1.Owner {
+		return false
 	}
-	if m.AnsCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.AnsCount))
-		i--
-		dAtA[i] = 0x38
+	if this.FeePayer != that1.FeePayer {
+		return false
 	}
-	if m.RequestID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.RequestID))
-		i--
-		dAtA[i] = 0x30
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
 	}
-	if m.MinCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MinCount))
-		i--
-		dAtA[i] = 0x28
+	if !bytes.Equal(this.Calldata, that1.Calldata) {
+		return false
 	}
-	if m.AskCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.AskCount))
-		i--
-		dAtA[i] = 0x20
+	if this.AskCount != that1.AskCount {
+		return false
 	}
-	if len(m.Calldata) > 0 {
-		i -= len(m.Calldata)
-		copy(dAtA[i:], m.Calldata)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Calldata)))
-		i--
-		dAtA[i] = 0x1a
+	if this.MinCount != that1.MinCount {
+		return false
 	}
-	if m.OracleScriptID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptID))
-		i--
-		dAtA[i] = 0x10
+	if this.ClientID != that1.ClientID {
+		return false
 	}
-	if len(m.ClientID) > 0 {
-		i -= len(m.ClientID)
-		copy(dAtA[i:], m.ClientID)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.ClientID)))
-		i--
-		dAtA[i] = 0xa
+	if len(this.FeeLimit) != len(that1.FeeLimit) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SigningResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	for i := range this.FeeLimit {
+		if !this.FeeLimit[i].Equal(&that1.FeeLimit[i]) {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SigningResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SigningResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ErrorCode != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ErrorCode))
-		i--
-		dAtA[i] = 0x18
+	if this.PrepareGas != that1.PrepareGas {
+		return false
 	}
-	if len(m.ErrorCodespace) > 0 {
-		i -= len(m.ErrorCodespace)
-		copy(dAtA[i:], m.ErrorCodespace)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.ErrorCodespace)))
-		i--
-		dAtA[i] = 0x12
+	if this.ExecuteGas != that1.ExecuteGas {
+		return false
 	}
-	if m.SigningID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.SigningID))
-		i--
-		dAtA[i] = 0x8
+	if this.TSSEncoder != that1.TSSEncoder {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ValidatorStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.NextRequestHeight != that1.NextRequestHeight {
+		return false
+	}
+	if this.IsActive != that1.IsActive {
+		return false
+	}
+	if this.LastRequestID != that1.LastRequestID {
+		return false
+	}
+	if this.RequestCount != that1.RequestCount {
+		return false
+	}
+	return true
+}
+func (this *DataSourceVersion) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataSourceVersion)
+	if !ok {
+		that2, ok := that.(DataSourceVersion)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DataSourceID != that1.DataSourceID {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Filename != that1.Filename {
+		return false
+	}
+	if this.Treasury != that1.Treasury {
+		return false
+	}
+	if len(this.Fee) != len(that1.Fee) {
+		return false
+	}
+	for i := range this.Fee {
+		if !this.Fee[i].Equal(&that1.Fee[i]) {
+			return false
+		}
+	}
+	if this.Editor != that1.Editor {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (this *OracleScriptVersion) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleScriptVersion)
+	if !ok {
+		that2, ok := that.(OracleScriptVersion)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Filename != that1.Filename {
+		return false
+	}
+	if this.Schema != that1.Schema {
+		return false
+	}
+	if this.SourceCodeURL != that1.SourceCodeURL {
+		return false
+	}
+	if this.Editor != that1.Editor {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (this *DataSourceVersionPin) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataSourceVersionPin)
+	if !ok {
+		that2, ok := that.(DataSourceVersionPin)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DataSourceID != that1.DataSourceID {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (m *DataSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *DataSource) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Since, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Since):])
-	if err2 != nil {
-		return 0, err2
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	i -= n2
-	i = encodeVarintOracle(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x12
-	if m.IsActive {
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Treasury)))
 		i--
-		if m.IsActive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x2a
+	}
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Filename)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ActiveValidator) Marshal() (dAtA []byte, err error) {
+func (m *OracleScript) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3237,32 +3851,62 @@ func (m *ActiveValidator) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActiveValidator) MarshalTo(dAtA []byte) (int, error) {
+func (m *OracleScript) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ActiveValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OracleScript) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Power != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Power))
+	if len(m.SourceCodeURL) > 0 {
+		i -= len(m.SourceCodeURL)
+		copy(dAtA[i:], m.SourceCodeURL)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.SourceCodeURL)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x32
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Address)))
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Filename)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *RawRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3272,80 +3916,42 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *RawRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IBCRequestEnabled {
-		i--
-		if m.IBCRequestEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.DataSourceVersion != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceVersion))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x20
 	}
-	if m.InactivePenaltyDuration != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.InactivePenaltyDuration))
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Calldata)))
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x1a
 	}
-	if m.OracleRewardPercentage != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.OracleRewardPercentage))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.SamplingTryCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.SamplingTryCount))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.PerValidatorRequestGas != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.PerValidatorRequestGas))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.BaseOwasmGas != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.BaseOwasmGas))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.ExpirationBlockCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ExpirationBlockCount))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.MaxReportDataSize != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MaxReportDataSize))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.MaxCalldataSize != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MaxCalldataSize))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.MaxAskCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MaxAskCount))
+	if m.DataSourceID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceID))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.MaxRawRequestCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MaxRawRequestCount))
+	if m.ExternalID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExternalID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PendingResolveList) Marshal() (dAtA []byte, err error) {
+func (m *RawReport) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3355,38 +3961,37 @@ func (m *PendingResolveList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PendingResolveList) MarshalTo(dAtA []byte) (int, error) {
+func (m *RawReport) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PendingResolveList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RawReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RequestIds) > 0 {
-		dAtA4 := make([]byte, len(m.RequestIds)*10)
-		var j3 int
-		for _, num := range m.RequestIds {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintOracle(dAtA, i, uint64(j3))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Data)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	if m.ExitCode != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExitCode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExternalID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExternalID))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *IBCChannel) Marshal() (dAtA []byte, err error) {
+func (m *Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3396,34 +4001,125 @@ func (m *IBCChannel) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IBCChannel) MarshalTo(dAtA []byte) (int, error) {
+func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IBCChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.ChannelId)))
+	if m.OracleScriptVersion != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptVersion))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.FeeLimit) > 0 {
+		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.TSSEncoder != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TSSEncoder))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ExecuteGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExecuteGas))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.IBCChannel != nil {
+		{
+			size, err := m.IBCChannel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.RawRequests) > 0 {
+		for iNdEx := len(m.RawRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RawRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RequestTime != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RequestTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RequestHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RequestHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RequestedValidators) > 0 {
+		for iNdEx := len(m.RequestedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequestedValidators[iNdEx])
+			copy(dAtA[i:], m.RequestedValidators[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.RequestedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Calldata)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.PortId)))
+	if m.OracleScriptID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptID))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RequestVerification) Marshal() (dAtA []byte, err error) {
+func (m *Report) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3433,49 +4129,51 @@ func (m *RequestVerification) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RequestVerification) MarshalTo(dAtA []byte) (int, error) {
+func (m *Report) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RequestVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Report) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DataSourceID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceID))
-		i--
-		dAtA[i] = 0x28
+	if len(m.RawReports) > 0 {
+		for iNdEx := len(m.RawReports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RawReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.ExternalID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ExternalID))
+	if m.InBeforeResolve {
 		i--
-		dAtA[i] = 0x20
-	}
-	if m.RequestID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.RequestID))
+		if m.InBeforeResolve {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x10
 	}
 	if len(m.Validator) > 0 {
 		i -= len(m.Validator)
 		copy(dAtA[i:], m.Validator)
 		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.ChainID)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PriceResult) Marshal() (dAtA []byte, err error) {
+func (m *OracleRequestPacketData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3485,47 +4183,78 @@ func (m *PriceResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PriceResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *OracleRequestPacketData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OracleRequestPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ResolveTime != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ResolveTime))
+	if m.TSSEncoder != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TSSEncoder))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x48
 	}
-	if m.RequestID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.RequestID))
+	if m.ExecuteGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExecuteGas))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x40
 	}
-	if m.Px != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Px))
+	if m.PrepareGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PrepareGas))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x38
 	}
-	if m.Multiplier != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Multiplier))
+	if len(m.FeeLimit) > 0 {
+		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MinCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AskCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AskCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OracleScriptID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptID))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ClientID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OracleResultSignatureOrder) Marshal() (dAtA []byte, err error) {
+func (m *OracleRequestPacketAcknowledgement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3535,21 +4264,16 @@ func (m *OracleResultSignatureOrder) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OracleResultSignatureOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *OracleRequestPacketAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OracleResultSignatureOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OracleRequestPacketAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Encoder != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Encoder))
-		i--
-		dAtA[i] = 0x10
-	}
 	if m.RequestID != 0 {
 		i = encodeVarintOracle(dAtA, i, uint64(m.RequestID))
 		i--
@@ -3558,533 +4282,3785 @@ func (m *OracleResultSignatureOrder) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OracleResponsePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *DataSource) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *OracleResponsePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleResponsePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.ResolveStatus != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ResolveStatus))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.ResolveTime != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ResolveTime))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Filename)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.RequestTime != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RequestTime))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Treasury)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.AnsCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AnsCount))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Fee) > 0 {
-		for _, e := range m.Fee {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
-		}
+	if m.RequestID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OracleScript) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Result) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Result) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.ResolveStatus != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ResolveStatus))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.ResolveTime != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ResolveTime))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.Filename)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.RequestTime != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RequestTime))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.Schema)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.AnsCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AnsCount))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.SourceCodeURL)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.RequestID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-
-func (m *RawRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MinCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	if m.ExternalID != 0 {
-		n += 1 + sovOracle(uint64(m.ExternalID))
+	if m.AskCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AskCount))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.DataSourceID != 0 {
-		n += 1 + sovOracle(uint64(m.DataSourceID))
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Calldata)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.OracleScriptID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptID))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RawReport) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SigningResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return dAtA[:n], nil
+}
+
+func (m *SigningResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	if m.ExternalID != 0 {
-		n += 1 + sovOracle(uint64(m.ExternalID))
+	if m.ErrorCode != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ErrorCode))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.ExitCode != 0 {
-		n += 1 + sovOracle(uint64(m.ExitCode))
+	if len(m.ErrorCodespace) > 0 {
+		i -= len(m.ErrorCodespace)
+		copy(dAtA[i:], m.ErrorCodespace)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ErrorCodespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.SigningID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SigningID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Request) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OracleScriptID != 0 {
-		n += 1 + sovOracle(uint64(m.OracleScriptID))
-	}
-	l = len(m.Calldata)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Since, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Since):])
+	if err2 != nil {
+		return 0, err2
 	}
-	if len(m.RequestedValidators) > 0 {
-		for _, s := range m.RequestedValidators {
-			l = len(s)
-			n += 1 + l + sovOracle(uint64(l))
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.MinCount != 0 {
-		n += 1 + sovOracle(uint64(m.MinCount))
-	}
-	if m.RequestHeight != 0 {
-		n += 1 + sovOracle(uint64(m.RequestHeight))
-	}
-	if m.RequestTime != 0 {
-		n += 1 + sovOracle(uint64(m.RequestTime))
-	}
-	l = len(m.ClientID)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorReportInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RawRequests) > 0 {
-		for _, e := range m.RawRequests {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
-		}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorReportInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorReportInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalMissedReports != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TotalMissedReports))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.IBCChannel != nil {
-		l = m.IBCChannel.Size()
-		n += 1 + l + sovOracle(uint64(l))
+	if m.TotalReports != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TotalReports))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.ExecuteGas != 0 {
-		n += 1 + sovOracle(uint64(m.ExecuteGas))
+	if m.MissedReportsCounter != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MissedReportsCounter))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.TSSEncoder != 0 {
-		n += 1 + sovOracle(uint64(m.TSSEncoder))
+	if m.IndexOffset != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Requester)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.WindowSize != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.WindowSize))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.FeeLimit) > 0 {
-		for _, e := range m.FeeLimit {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
-		}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Report) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ActiveValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ActiveValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActiveValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	if m.InBeforeResolve {
-		n += 2
+	if m.Power != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.RawReports) > 0 {
-		for _, e := range m.RawReports {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
-		}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OracleRequestPacketData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClientID)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	if m.OracleScriptID != 0 {
-		n += 1 + sovOracle(uint64(m.OracleScriptID))
+	if m.MaxSubscriptionRequestsPerBlock != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxSubscriptionRequestsPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	l = len(m.Calldata)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.SubscriptionBaseRequestFee) > 0 {
+		for iNdEx := len(m.SubscriptionBaseRequestFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubscriptionBaseRequestFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	if m.AskCount != 0 {
-		n += 1 + sovOracle(uint64(m.AskCount))
+	if m.MinSubscriptionInterval != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinSubscriptionInterval))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	if m.MinCount != 0 {
-		n += 1 + sovOracle(uint64(m.MinCount))
+	if m.MaxCallbackGasLimit != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxCallbackGasLimit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	if len(m.FeeLimit) > 0 {
-		for _, e := range m.FeeLimit {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
-		}
+	if m.MaxMissedReportPercentage != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxMissedReportPercentage))
+		i--
+		dAtA[i] = 0x78
 	}
-	if m.PrepareGas != 0 {
-		n += 1 + sovOracle(uint64(m.PrepareGas))
+	if m.ReportWindowSize != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ReportWindowSize))
+		i--
+		dAtA[i] = 0x70
 	}
-	if m.ExecuteGas != 0 {
-		n += 1 + sovOracle(uint64(m.ExecuteGas))
+	if m.PriceOracleScriptID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PriceOracleScriptID))
+		i--
+		dAtA[i] = 0x68
 	}
-	if m.TSSEncoder != 0 {
-		n += 1 + sovOracle(uint64(m.TSSEncoder))
+	if m.MaxLatestResultIndexSize != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxLatestResultIndexSize))
+		i--
+		dAtA[i] = 0x60
 	}
-	return n
-}
-
-func (m *OracleRequestPacketAcknowledgement) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.IBCRequestEnabled {
+		i--
+		if m.IBCRequestEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
 	}
-	var l int
-	_ = l
-	if m.RequestID != 0 {
-		n += 1 + sovOracle(uint64(m.RequestID))
+	if m.InactivePenaltyDuration != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.InactivePenaltyDuration))
+		i--
+		dAtA[i] = 0x50
 	}
-	return n
-}
-
-func (m *OracleResponsePacketData) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.OracleRewardPercentage != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleRewardPercentage))
+		i--
+		dAtA[i] = 0x48
 	}
-	var l int
-	_ = l
-	l = len(m.ClientID)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.SamplingTryCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SamplingTryCount))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.RequestID != 0 {
-		n += 1 + sovOracle(uint64(m.RequestID))
+	if m.PerValidatorRequestGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PerValidatorRequestGas))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.AnsCount != 0 {
-		n += 1 + sovOracle(uint64(m.AnsCount))
+	if m.BaseOwasmGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.BaseOwasmGas))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.RequestTime != 0 {
-		n += 1 + sovOracle(uint64(m.RequestTime))
+	if m.ExpirationBlockCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExpirationBlockCount))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.ResolveTime != 0 {
-		n += 1 + sovOracle(uint64(m.ResolveTime))
+	if m.MaxReportDataSize != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxReportDataSize))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.ResolveStatus != 0 {
-		n += 1 + sovOracle(uint64(m.ResolveStatus))
+	if m.MaxCalldataSize != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxCalldataSize))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Result)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.MaxAskCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxAskCount))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.MaxRawRequestCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxRawRequestCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Result) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingResolveList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingResolveList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingResolveList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClientID)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	if m.OracleScriptID != 0 {
-		n += 1 + sovOracle(uint64(m.OracleScriptID))
-	}
-	l = len(m.Calldata)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	if m.AskCount != 0 {
-		n += 1 + sovOracle(uint64(m.AskCount))
-	}
-	if m.MinCount != 0 {
-		n += 1 + sovOracle(uint64(m.MinCount))
-	}
-	if m.RequestID != 0 {
-		n += 1 + sovOracle(uint64(m.RequestID))
-	}
-	if m.AnsCount != 0 {
-		n += 1 + sovOracle(uint64(m.AnsCount))
-	}
-	if m.RequestTime != 0 {
-		n += 1 + sovOracle(uint64(m.RequestTime))
-	}
-	if m.ResolveTime != 0 {
-		n += 1 + sovOracle(uint64(m.ResolveTime))
-	}
-	if m.ResolveStatus != 0 {
-		n += 1 + sovOracle(uint64(m.ResolveStatus))
-	}
-	l = len(m.Result)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.RequestIds) > 0 {
+		dAtA4 := make([]byte, len(m.RequestIds)*10)
+		var j3 int
+		for _, num := range m.RequestIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintOracle(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SigningResult) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IBCChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SigningID != 0 {
-		n += 1 + sovOracle(uint64(m.SigningID))
-	}
-	l = len(m.ErrorCodespace)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ErrorCode != 0 {
-		n += 1 + sovOracle(uint64(m.ErrorCode))
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsActive {
-		n += 2
+func (m *RequestCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Since)
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ActiveValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RequestCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.GasLimit != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Power != 0 {
-		n += 1 + sovOracle(uint64(m.Power))
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxRawRequestCount != 0 {
-		n += 1 + sovOracle(uint64(m.MaxRawRequestCount))
+func (m *RequestVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.MaxAskCount != 0 {
-		n += 1 + sovOracle(uint64(m.MaxAskCount))
-	}
-	if m.MaxCalldataSize != 0 {
-		n += 1 + sovOracle(uint64(m.MaxCalldataSize))
-	}
-	if m.MaxReportDataSize != 0 {
-		n += 1 + sovOracle(uint64(m.MaxReportDataSize))
-	}
-	if m.ExpirationBlockCount != 0 {
-		n += 1 + sovOracle(uint64(m.ExpirationBlockCount))
-	}
-	if m.BaseOwasmGas != 0 {
-		n += 1 + sovOracle(uint64(m.BaseOwasmGas))
-	}
-	if m.PerValidatorRequestGas != 0 {
-		n += 1 + sovOracle(uint64(m.PerValidatorRequestGas))
+	return dAtA[:n], nil
+}
+
+func (m *RequestVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DataSourceID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceID))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.SamplingTryCount != 0 {
-		n += 1 + sovOracle(uint64(m.SamplingTryCount))
+	if m.ExternalID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExternalID))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.OracleRewardPercentage != 0 {
-		n += 1 + sovOracle(uint64(m.OracleRewardPercentage))
+	if m.RequestID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.InactivePenaltyDuration != 0 {
-		n += 1 + sovOracle(uint64(m.InactivePenaltyDuration))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.IBCRequestEnabled {
-		n += 2
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PendingResolveList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.RequestIds) > 0 {
-		l = 0
-		for _, e := range m.RequestIds {
-			l += sovOracle(uint64(e))
-		}
-		n += 1 + sovOracle(uint64(l)) + l
+func (m *PriceResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *IBCChannel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	return n
+func (m *PriceResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RequestVerification) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PriceResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.ResolveTime != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ResolveTime))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.RequestID != 0 {
-		n += 1 + sovOracle(uint64(m.RequestID))
+		i = encodeVarintOracle(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.ExternalID != 0 {
-		n += 1 + sovOracle(uint64(m.ExternalID))
+	if m.Px != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Px))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.DataSourceID != 0 {
-		n += 1 + sovOracle(uint64(m.DataSourceID))
+	if m.Multiplier != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Multiplier))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PriceResult) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OracleResultSignatureOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleResultSignatureOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleResultSignatureOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.Encoder != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Encoder))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Multiplier != 0 {
-		n += 1 + sovOracle(uint64(m.Multiplier))
+	if m.RequestID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Px != 0 {
-		n += 1 + sovOracle(uint64(m.Px))
+	return len(dAtA) - i, nil
+}
+
+func (m *TreasuryFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.RequestID != 0 {
-		n += 1 + sovOracle(uint64(m.RequestID))
+	return dAtA[:n], nil
+}
+
+func (m *TreasuryFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.ResolveTime != 0 {
-		n += 1 + sovOracle(uint64(m.ResolveTime))
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OracleResultSignatureOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FeeEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RequestID != 0 {
-		n += 1 + sovOracle(uint64(m.RequestID))
+	if len(m.Released) > 0 {
+		for iNdEx := len(m.Released) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Released[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.Encoder != 0 {
-		n += 1 + sovOracle(uint64(m.Encoder))
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TreasuryFees) > 0 {
+		for iNdEx := len(m.TreasuryFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TreasuryFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequestCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RequestCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.LastRequestID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastRequestID))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.NextRequestHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.NextRequestHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Interval != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.TSSEncoder != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TSSEncoder))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ExecuteGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExecuteGas))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.PrepareGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PrepareGas))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.FeeLimit) > 0 {
+		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MinCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AskCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AskCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.OracleScriptID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataSourceVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataSourceVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataSourceVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Editor) > 0 {
+		i -= len(m.Editor)
+		copy(dAtA[i:], m.Editor)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Editor)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Filename)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Version != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DataSourceID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleScriptVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleScriptVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleScriptVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Editor) > 0 {
+		i -= len(m.Editor)
+		copy(dAtA[i:], m.Editor)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Editor)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SourceCodeURL) > 0 {
+		i -= len(m.SourceCodeURL)
+		copy(dAtA[i:], m.SourceCodeURL)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.SourceCodeURL)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Filename)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Version != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OracleScriptID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataSourceVersionPin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataSourceVersionPin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataSourceVersionPin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DataSourceID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DataSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OracleScript) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.SourceCodeURL)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *RawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExternalID != 0 {
+		n += 1 + sovOracle(uint64(m.ExternalID))
+	}
+	if m.DataSourceID != 0 {
+		n += 1 + sovOracle(uint64(m.DataSourceID))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.DataSourceVersion != 0 {
+		n += 1 + sovOracle(uint64(m.DataSourceVersion))
+	}
+	return n
+}
+
+func (m *RawReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExternalID != 0 {
+		n += 1 + sovOracle(uint64(m.ExternalID))
+	}
+	if m.ExitCode != 0 {
+		n += 1 + sovOracle(uint64(m.ExitCode))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScriptID != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptID))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.RequestedValidators) > 0 {
+		for _, s := range m.RequestedValidators {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovOracle(uint64(m.MinCount))
+	}
+	if m.RequestHeight != 0 {
+		n += 1 + sovOracle(uint64(m.RequestHeight))
+	}
+	if m.RequestTime != 0 {
+		n += 1 + sovOracle(uint64(m.RequestTime))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.RawRequests) > 0 {
+		for _, e := range m.RawRequests {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.IBCChannel != nil {
+		l = m.IBCChannel.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.ExecuteGas != 0 {
+		n += 1 + sovOracle(uint64(m.ExecuteGas))
+	}
+	if m.TSSEncoder != 0 {
+		n += 1 + sovOracle(uint64(m.TSSEncoder))
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.FeeLimit) > 0 {
+		for _, e := range m.FeeLimit {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.OracleScriptVersion != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptVersion))
+	}
+	return n
+}
+
+func (m *Report) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.InBeforeResolve {
+		n += 2
+	}
+	if len(m.RawReports) > 0 {
+		for _, e := range m.RawReports {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OracleRequestPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.OracleScriptID != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptID))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.AskCount != 0 {
+		n += 1 + sovOracle(uint64(m.AskCount))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovOracle(uint64(m.MinCount))
+	}
+	if len(m.FeeLimit) > 0 {
+		for _, e := range m.FeeLimit {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.PrepareGas != 0 {
+		n += 1 + sovOracle(uint64(m.PrepareGas))
+	}
+	if m.ExecuteGas != 0 {
+		n += 1 + sovOracle(uint64(m.ExecuteGas))
+	}
+	if m.TSSEncoder != 0 {
+		n += 1 + sovOracle(uint64(m.TSSEncoder))
+	}
+	return n
+}
+
+func (m *OracleRequestPacketAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestID != 0 {
+		n += 1 + sovOracle(uint64(m.RequestID))
+	}
+	return n
+}
+
+func (m *OracleResponsePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.RequestID != 0 {
+		n += 1 + sovOracle(uint64(m.RequestID))
+	}
+	if m.AnsCount != 0 {
+		n += 1 + sovOracle(uint64(m.AnsCount))
+	}
+	if m.RequestTime != 0 {
+		n += 1 + sovOracle(uint64(m.RequestTime))
+	}
+	if m.ResolveTime != 0 {
+		n += 1 + sovOracle(uint64(m.ResolveTime))
+	}
+	if m.ResolveStatus != 0 {
+		n += 1 + sovOracle(uint64(m.ResolveStatus))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *Result) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.OracleScriptID != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptID))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.AskCount != 0 {
+		n += 1 + sovOracle(uint64(m.AskCount))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovOracle(uint64(m.MinCount))
+	}
+	if m.RequestID != 0 {
+		n += 1 + sovOracle(uint64(m.RequestID))
+	}
+	if m.AnsCount != 0 {
+		n += 1 + sovOracle(uint64(m.AnsCount))
+	}
+	if m.RequestTime != 0 {
+		n += 1 + sovOracle(uint64(m.RequestTime))
+	}
+	if m.ResolveTime != 0 {
+		n += 1 + sovOracle(uint64(m.ResolveTime))
+	}
+	if m.ResolveStatus != 0 {
+		n += 1 + sovOracle(uint64(m.ResolveStatus))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *SigningResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SigningID != 0 {
+		n += 1 + sovOracle(uint64(m.SigningID))
+	}
+	l = len(m.ErrorCodespace)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.ErrorCode != 0 {
+		n += 1 + sovOracle(uint64(m.ErrorCode))
+	}
+	return n
+}
+
+func (m *ValidatorStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsActive {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Since)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *ValidatorReportInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.WindowSize != 0 {
+		n += 1 + sovOracle(uint64(m.WindowSize))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovOracle(uint64(m.IndexOffset))
+	}
+	if m.MissedReportsCounter != 0 {
+		n += 1 + sovOracle(uint64(m.MissedReportsCounter))
+	}
+	if m.TotalReports != 0 {
+		n += 1 + sovOracle(uint64(m.TotalReports))
+	}
+	if m.TotalMissedReports != 0 {
+		n += 1 + sovOracle(uint64(m.TotalMissedReports))
+	}
+	return n
+}
+
+func (m *ActiveValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovOracle(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxRawRequestCount != 0 {
+		n += 1 + sovOracle(uint64(m.MaxRawRequestCount))
+	}
+	if m.MaxAskCount != 0 {
+		n += 1 + sovOracle(uint64(m.MaxAskCount))
+	}
+	if m.MaxCalldataSize != 0 {
+		n += 1 + sovOracle(uint64(m.MaxCalldataSize))
+	}
+	if m.MaxReportDataSize != 0 {
+		n += 1 + sovOracle(uint64(m.MaxReportDataSize))
+	}
+	if m.ExpirationBlockCount != 0 {
+		n += 1 + sovOracle(uint64(m.ExpirationBlockCount))
+	}
+	if m.BaseOwasmGas != 0 {
+		n += 1 + sovOracle(uint64(m.BaseOwasmGas))
+	}
+	if m.PerValidatorRequestGas != 0 {
+		n += 1 + sovOracle(uint64(m.PerValidatorRequestGas))
+	}
+	if m.SamplingTryCount != 0 {
+		n += 1 + sovOracle(uint64(m.SamplingTryCount))
+	}
+	if m.OracleRewardPercentage != 0 {
+		n += 1 + sovOracle(uint64(m.OracleRewardPercentage))
+	}
+	if m.InactivePenaltyDuration != 0 {
+		n += 1 + sovOracle(uint64(m.InactivePenaltyDuration))
+	}
+	if m.IBCRequestEnabled {
+		n += 2
+	}
+	if m.MaxLatestResultIndexSize != 0 {
+		n += 1 + sovOracle(uint64(m.MaxLatestResultIndexSize))
+	}
+	if m.PriceOracleScriptID != 0 {
+		n += 1 + sovOracle(uint64(m.PriceOracleScriptID))
+	}
+	if m.ReportWindowSize != 0 {
+		n += 1 + sovOracle(uint64(m.ReportWindowSize))
+	}
+	if m.MaxMissedReportPercentage != 0 {
+		n += 1 + sovOracle(uint64(m.MaxMissedReportPercentage))
+	}
+	if m.MaxCallbackGasLimit != 0 {
+		n += 2 + sovOracle(uint64(m.MaxCallbackGasLimit))
+	}
+	if m.MinSubscriptionInterval != 0 {
+		n += 2 + sovOracle(uint64(m.MinSubscriptionInterval))
+	}
+	if len(m.SubscriptionBaseRequestFee) > 0 {
+		for _, e := range m.SubscriptionBaseRequestFee {
+			l = e.Size()
+			n += 2 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.MaxSubscriptionRequestsPerBlock != 0 {
+		n += 2 + sovOracle(uint64(m.MaxSubscriptionRequestsPerBlock))
+	}
+	return n
+}
+
+func (m *PendingResolveList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RequestIds) > 0 {
+		l = 0
+		for _, e := range m.RequestIds {
+			l += sovOracle(uint64(e))
+		}
+		n += 1 + sovOracle(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *IBCChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *RequestCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovOracle(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *RequestVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.RequestID != 0 {
+		n += 1 + sovOracle(uint64(m.RequestID))
+	}
+	if m.ExternalID != 0 {
+		n += 1 + sovOracle(uint64(m.ExternalID))
+	}
+	if m.DataSourceID != 0 {
+		n += 1 + sovOracle(uint64(m.DataSourceID))
+	}
+	return n
+}
+
+func (m *PriceResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Multiplier != 0 {
+		n += 1 + sovOracle(uint64(m.Multiplier))
+	}
+	if m.Px != 0 {
+		n += 1 + sovOracle(uint64(m.Px))
+	}
+	if m.RequestID != 0 {
+		n += 1 + sovOracle(uint64(m.RequestID))
+	}
+	if m.ResolveTime != 0 {
+		n += 1 + sovOracle(uint64(m.ResolveTime))
+	}
+	return n
+}
+
+func (m *OracleResultSignatureOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestID != 0 {
+		n += 1 + sovOracle(uint64(m.RequestID))
+	}
+	if m.Encoder != 0 {
+		n += 1 + sovOracle(uint64(m.Encoder))
+	}
+	return n
+}
+
+func (m *TreasuryFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.TreasuryFees) > 0 {
+		for _, e := range m.TreasuryFees {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.Released) > 0 {
+		for _, e := range m.Released {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RequestSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovOracle(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.OracleScriptID != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptID))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.AskCount != 0 {
+		n += 1 + sovOracle(uint64(m.AskCount))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovOracle(uint64(m.MinCount))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.FeeLimit) > 0 {
+		for _, e := range m.FeeLimit {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.PrepareGas != 0 {
+		n += 1 + sovOracle(uint64(m.PrepareGas))
+	}
+	if m.ExecuteGas != 0 {
+		n += 1 + sovOracle(uint64(m.ExecuteGas))
+	}
+	if m.TSSEncoder != 0 {
+		n += 1 + sovOracle(uint64(m.TSSEncoder))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovOracle(uint64(m.Interval))
+	}
+	if m.NextRequestHeight != 0 {
+		n += 1 + sovOracle(uint64(m.NextRequestHeight))
+	}
+	if m.IsActive {
+		n += 2
+	}
+	if m.LastRequestID != 0 {
+		n += 2 + sovOracle(uint64(m.LastRequestID))
+	}
+	if m.RequestCount != 0 {
+		n += 2 + sovOracle(uint64(m.RequestCount))
+	}
+	return n
+}
+
+func (m *DataSourceVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataSourceID != 0 {
+		n += 1 + sovOracle(uint64(m.DataSourceID))
+	}
+	if m.Version != 0 {
+		n += 1 + sovOracle(uint64(m.Version))
+	}
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.Editor)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovOracle(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *OracleScriptVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScriptID != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptID))
+	}
+	if m.Version != 0 {
+		n += 1 + sovOracle(uint64(m.Version))
+	}
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.SourceCodeURL)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Editor)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovOracle(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *DataSourceVersionPin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataSourceID != 0 {
+		n += 1 + sovOracle(uint64(m.DataSourceID))
+	}
+	if m.Version != 0 {
+		n += 1 + sovOracle(uint64(m.Version))
+	}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DataSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleScript) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleScript: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
+			}
+			m.ExternalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalID |= ExternalID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
+			}
+			m.DataSourceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSourceID |= DataSourceID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceVersion", wireType)
+			}
+			m.DataSourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
+			}
+			m.ExternalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalID |= ExternalID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			}
+			m.OracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestedValidators = append(m.RequestedValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
+			}
+			m.RequestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+			}
+			m.RequestTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawRequests = append(m.RawRequests, RawRequest{})
+			if err := m.RawRequests[len(m.RawRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCChannel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IBCChannel == nil {
+				m.IBCChannel = &IBCChannel{}
+			}
+			if err := m.IBCChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+			}
+			m.ExecuteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
+			}
+			m.TSSEncoder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TSSEncoder |= Encoder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeLimit = append(m.FeeLimit, types.Coin{})
+			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
+			}
+			m.OracleScriptVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Report) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Report: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InBeforeResolve", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InBeforeResolve = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawReports = append(m.RawReports, RawReport{})
+			if err := m.RawReports[len(m.RawReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleRequestPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleRequestPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			}
+			m.OracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+			}
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeLimit = append(m.FeeLimit, types.Coin{})
+			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+			}
+			m.PrepareGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrepareGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+			}
+			m.ExecuteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
+			}
+			m.TSSEncoder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TSSEncoder |= Encoder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleRequestPacketAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleRequestPacketAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleRequestPacketAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= RequestID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleResponsePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleResponsePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= RequestID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
+			}
+			m.AnsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+			}
+			m.RequestTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+			}
+			m.ResolveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
+			}
+			m.ResolveStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveStatus |= ResolveStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *DataSource) Unmarshal(dAtA []byte) error {
+func (m *Result) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4107,15 +8083,15 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4143,13 +8119,13 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.ClientID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
 			}
-			var stringLen uint64
+			m.OracleScriptID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4159,29 +8135,16 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4191,29 +8154,31 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
 			}
-			var stringLen uint64
+			m.AskCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4223,29 +8188,54 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AskCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			m.Filename = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= RequestID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
+			}
+			m.AnsCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4255,29 +8245,73 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AnsCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+			m.RequestTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
 			}
-			m.Treasury = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			m.ResolveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
+			}
+			m.ResolveStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveStatus |= ResolveStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4287,24 +8321,24 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = append(m.Fee, types.Coin{})
-			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4328,7 +8362,7 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleScript) Unmarshal(dAtA []byte) error {
+func (m *SigningResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4347,53 +8381,21 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OracleScript: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningID", wireType)
 			}
-			var stringLen uint64
+			m.SigningID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4403,27 +8405,14 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SigningID |= github_com_bandprotocol_chain_v3_x_bandtss_types.SigningID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCodespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4451,13 +8440,13 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ErrorCodespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
 			}
-			var stringLen uint64
+			m.ErrorCode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4467,29 +8456,66 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ErrorCode |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOracle
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filename = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4499,29 +8525,17 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			m.IsActive = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4531,23 +8545,24 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Since, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4570,7 +8585,7 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorReportInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4593,17 +8608,17 @@ func (m *RawRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorReportInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorReportInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			m.ExternalID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4613,16 +8628,29 @@ func (m *RawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExternalID |= ExternalID(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSize", wireType)
 			}
-			m.DataSourceID = 0
+			m.WindowSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4632,16 +8660,16 @@ func (m *RawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSourceID |= DataSourceID(b&0x7F) << shift
+				m.WindowSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
 			}
-			var byteLen int
+			m.IndexOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4651,26 +8679,68 @@ func (m *RawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.IndexOffset |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOracle
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedReportsCounter", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+			m.MissedReportsCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedReportsCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReports", wireType)
 			}
-			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Calldata == nil {
-				m.Calldata = []byte{}
+			m.TotalReports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalReports |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMissedReports", wireType)
+			}
+			m.TotalMissedReports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalMissedReports |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -4692,7 +8762,7 @@ func (m *RawRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawReport) Unmarshal(dAtA []byte) error {
+func (m *ActiveValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4715,36 +8785,17 @@ func (m *RawReport) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawReport: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActiveValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawReport: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
-			}
-			m.ExternalID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExternalID |= ExternalID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			return fmt.Errorf("proto: ActiveValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.ExitCode = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4754,16 +8805,29 @@ func (m *RawReport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExitCode |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			var byteLen int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4773,26 +8837,11 @@ func (m *RawReport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Power |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -4814,7 +8863,7 @@ func (m *RawReport) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Request) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4837,17 +8886,17 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRawRequestCount", wireType)
 			}
-			m.OracleScriptID = 0
+			m.MaxRawRequestCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4857,16 +8906,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				m.MaxRawRequestCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAskCount", wireType)
 			}
-			var byteLen int
+			m.MaxAskCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4876,31 +8925,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.MaxAskCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Calldata == nil {
-				m.Calldata = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedValidators", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCalldataSize", wireType)
 			}
-			var stringLen uint64
+			m.MaxCalldataSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4910,29 +8944,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxCalldataSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RequestedValidators = append(m.RequestedValidators, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReportDataSize", wireType)
 			}
-			m.MinCount = 0
+			m.MaxReportDataSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4942,16 +8963,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinCount |= uint64(b&0x7F) << shift
+				m.MaxReportDataSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlockCount", wireType)
 			}
-			m.RequestHeight = 0
+			m.ExpirationBlockCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4961,16 +8982,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestHeight |= int64(b&0x7F) << shift
+				m.ExpirationBlockCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseOwasmGas", wireType)
 			}
-			m.RequestTime = 0
+			m.BaseOwasmGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4980,16 +9001,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestTime |= int64(b&0x7F) << shift
+				m.BaseOwasmGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerValidatorRequestGas", wireType)
 			}
-			var stringLen uint64
+			m.PerValidatorRequestGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4999,29 +9020,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PerValidatorRequestGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawRequests", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SamplingTryCount", wireType)
 			}
-			var msglen int
+			m.SamplingTryCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5031,31 +9039,54 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SamplingTryCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleRewardPercentage", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+			m.OracleRewardPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleRewardPercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivePenaltyDuration", wireType)
 			}
-			m.RawRequests = append(m.RawRequests, RawRequest{})
-			if err := m.RawRequests[len(m.RawRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.InactivePenaltyDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactivePenaltyDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBCChannel", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCRequestEnabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5065,33 +9096,74 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
+			m.IBCRequestEnabled = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLatestResultIndexSize", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+			m.MaxLatestResultIndexSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLatestResultIndexSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceOracleScriptID", wireType)
 			}
-			if m.IBCChannel == nil {
-				m.IBCChannel = &IBCChannel{}
+			m.PriceOracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceOracleScriptID |= OracleScriptID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.IBCChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportWindowSize", wireType)
+			}
+			m.ReportWindowSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportWindowSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 10:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedReportPercentage", wireType)
 			}
-			m.ExecuteGas = 0
+			m.MaxMissedReportPercentage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5101,16 +9173,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExecuteGas |= uint64(b&0x7F) << shift
+				m.MaxMissedReportPercentage |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallbackGasLimit", wireType)
 			}
-			m.TSSEncoder = 0
+			m.MaxCallbackGasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5120,16 +9192,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TSSEncoder |= Encoder(b&0x7F) << shift
+				m.MaxCallbackGasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSubscriptionInterval", wireType)
 			}
-			var stringLen uint64
+			m.MinSubscriptionInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5139,27 +9211,14 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MinSubscriptionInterval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Requester = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionBaseRequestFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5186,11 +9245,30 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeLimit = append(m.FeeLimit, types.Coin{})
-			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SubscriptionBaseRequestFee = append(m.SubscriptionBaseRequestFee, types.Coin{})
+			if err := m.SubscriptionBaseRequestFee[len(m.SubscriptionBaseRequestFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSubscriptionRequestsPerBlock", wireType)
+			}
+			m.MaxSubscriptionRequestsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSubscriptionRequestsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -5212,7 +9290,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Report) Unmarshal(dAtA []byte) error {
+func (m *PendingResolveList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5235,98 +9313,88 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Report: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingResolveList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingResolveList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.RequestIds = append(m.RequestIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthOracle
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InBeforeResolve", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthOracle
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InBeforeResolve = bool(v != 0)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				elementCount = count
+				if elementCount != 0 && len(m.RequestIds) == 0 {
+					m.RequestIds = make([]uint64, 0, elementCount)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RequestIds = append(m.RequestIds, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestIds", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RawReports = append(m.RawReports, RawReport{})
-			if err := m.RawReports[len(m.RawReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -5348,7 +9416,7 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
+func (m *IBCChannel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5371,68 +9439,17 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleRequestPacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: IBCChannel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleRequestPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
-			}
-			m.OracleScriptID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+			return fmt.Errorf("proto: IBCChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5442,69 +9459,29 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Calldata == nil {
-				m.Calldata = []byte{}
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
-			}
-			m.AskCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AskCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
-			}
-			m.MinCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5514,83 +9491,24 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeLimit = append(m.FeeLimit, types.Coin{})
-			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
-			}
-			m.PrepareGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PrepareGas |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
-			}
-			m.ExecuteGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExecuteGas |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
-			}
-			m.TSSEncoder = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TSSEncoder |= Encoder(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -5612,7 +9530,7 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleRequestPacketAcknowledgement) Unmarshal(dAtA []byte) error {
+func (m *RequestCallback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5635,17 +9553,49 @@ func (m *OracleRequestPacketAcknowledgement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleRequestPacketAcknowledgement: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestCallback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleRequestPacketAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestCallback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			m.RequestID = 0
+			m.GasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5655,7 +9605,7 @@ func (m *OracleRequestPacketAcknowledgement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestID |= RequestID(b&0x7F) << shift
+				m.GasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5681,7 +9631,7 @@ func (m *OracleRequestPacketAcknowledgement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
+func (m *RequestVerification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5704,15 +9654,15 @@ func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleResponsePacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestVerification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleResponsePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestVerification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5740,13 +9690,13 @@ func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			m.RequestID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5756,16 +9706,29 @@ func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestID |= RequestID(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			m.AnsCount = 0
+			m.RequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5775,16 +9738,16 @@ func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AnsCount |= uint64(b&0x7F) << shift
+				m.RequestID |= RequestID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
 			}
-			m.RequestTime = 0
+			m.ExternalID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5794,54 +9757,16 @@ func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestTime |= int64(b&0x7F) << shift
+				m.ExternalID |= ExternalID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
-			}
-			m.ResolveTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ResolveTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
-			}
-			m.ResolveStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ResolveStatus |= ResolveStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
 			}
-			var byteLen int
+			m.DataSourceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5851,26 +9776,11 @@ func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.DataSourceID |= DataSourceID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
-			if m.Result == nil {
-				m.Result = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -5892,7 +9802,7 @@ func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Result) Unmarshal(dAtA []byte) error {
+func (m *PriceResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5915,15 +9825,15 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5951,13 +9861,13 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
 			}
-			m.OracleScriptID = 0
+			m.Multiplier = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5967,16 +9877,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				m.Multiplier |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Px", wireType)
 			}
-			var byteLen int
+			m.Px = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5986,31 +9896,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Px |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Calldata == nil {
-				m.Calldata = []byte{}
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			m.AskCount = 0
+			m.RequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6020,16 +9915,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AskCount |= uint64(b&0x7F) << shift
+				m.RequestID |= RequestID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
 			}
-			m.MinCount = 0
+			m.ResolveTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6039,73 +9934,66 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinCount |= uint64(b&0x7F) << shift
+				m.ResolveTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.RequestID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestID |= RequestID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.AnsCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AnsCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleResultSignatureOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.RequestTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleResultSignatureOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleResultSignatureOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			m.ResolveTime = 0
+			m.RequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6115,35 +10003,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResolveTime |= int64(b&0x7F) << shift
+				m.RequestID |= RequestID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
-			}
-			m.ResolveStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ResolveStatus |= ResolveStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
 			}
-			var byteLen int
+			m.Encoder = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6153,26 +10022,11 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Encoder |= Encoder(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
-			if m.Result == nil {
-				m.Result = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -6194,7 +10048,7 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SigningResult) Unmarshal(dAtA []byte) error {
+func (m *TreasuryFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6217,34 +10071,15 @@ func (m *SigningResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SigningResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreasuryFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SigningResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TreasuryFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningID", wireType)
-			}
-			m.SigningID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SigningID |= github_com_bandprotocol_chain_v3_x_bandtss_types.SigningID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCodespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6272,13 +10107,13 @@ func (m *SigningResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrorCodespace = string(dAtA[iNdEx:postIndex])
+			m.Treasury = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			m.ErrorCode = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6288,11 +10123,26 @@ func (m *SigningResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ErrorCode |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -6314,7 +10164,7 @@ func (m *SigningResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
+func (m *FeeEscrow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6337,17 +10187,17 @@ func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeEscrow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6357,15 +10207,27 @@ func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsActive = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6392,7 +10254,76 @@ func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Since, dAtA[iNdEx:postIndex]); err != nil {
+			m.TreasuryFees = append(m.TreasuryFees, TreasuryFee{})
+			if err := m.TreasuryFees[len(m.TreasuryFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Released = append(m.Released, types.Coin{})
+			if err := m.Released[len(m.Released)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6417,7 +10348,7 @@ func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActiveValidator) Unmarshal(dAtA []byte) error {
+func (m *RequestSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6440,15 +10371,34 @@ func (m *ActiveValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6476,13 +10426,13 @@ func (m *ActiveValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
 			}
-			m.Power = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6492,66 +10442,29 @@ func (m *ActiveValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRawRequestCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
 			}
-			m.MaxRawRequestCount = 0
+			m.OracleScriptID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6561,16 +10474,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxRawRequestCount |= uint64(b&0x7F) << shift
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAskCount", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 			}
-			m.MaxAskCount = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6580,16 +10493,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxAskCount |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxCalldataSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
 			}
-			m.MaxCalldataSize = 0
+			m.AskCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6599,16 +10527,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxCalldataSize |= uint64(b&0x7F) << shift
+				m.AskCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxReportDataSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
 			}
-			m.MaxReportDataSize = 0
+			m.MinCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6618,16 +10546,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxReportDataSize |= uint64(b&0x7F) << shift
+				m.MinCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlockCount", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
 			}
-			m.ExpirationBlockCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6637,16 +10565,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpirationBlockCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseOwasmGas", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.BaseOwasmGas = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6656,16 +10597,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BaseOwasmGas |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeLimit = append(m.FeeLimit, types.Coin{})
+			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerValidatorRequestGas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
 			}
-			m.PerValidatorRequestGas = 0
+			m.PrepareGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6675,16 +10631,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PerValidatorRequestGas |= uint64(b&0x7F) << shift
+				m.PrepareGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SamplingTryCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
 			}
-			m.SamplingTryCount = 0
+			m.ExecuteGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6694,16 +10650,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SamplingTryCount |= uint64(b&0x7F) << shift
+				m.ExecuteGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleRewardPercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
 			}
-			m.OracleRewardPercentage = 0
+			m.TSSEncoder = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6713,16 +10669,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleRewardPercentage |= uint64(b&0x7F) << shift
+				m.TSSEncoder |= Encoder(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InactivePenaltyDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			m.InactivePenaltyDuration = 0
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6732,16 +10688,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InactivePenaltyDuration |= uint64(b&0x7F) << shift
+				m.Interval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBCRequestEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRequestHeight", wireType)
 			}
-			var v int
+			m.NextRequestHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6751,193 +10707,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.NextRequestHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IBCRequestEnabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PendingResolveList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PendingResolveList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingResolveList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowOracle
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.RequestIds = append(m.RequestIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowOracle
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthOracle
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthOracle
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RequestIds) == 0 {
-					m.RequestIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowOracle
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RequestIds = append(m.RequestIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestIds", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IBCChannel) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IBCChannel: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IBCChannel: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6947,29 +10726,17 @@ func (m *IBCChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+					break
+				}
 			}
-			var stringLen uint64
+			m.IsActive = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRequestID", wireType)
+			}
+			m.LastRequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6979,24 +10746,30 @@ func (m *IBCChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastRequestID |= RequestID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestCount", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.RequestCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -7018,7 +10791,7 @@ func (m *IBCChannel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestVerification) Unmarshal(dAtA []byte) error {
+func (m *DataSourceVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7041,15 +10814,53 @@ func (m *RequestVerification) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestVerification: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataSourceVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataSourceVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
+			}
+			m.DataSourceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSourceID |= DataSourceID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7077,11 +10888,11 @@ func (m *RequestVerification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			m.Filename = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7109,13 +10920,13 @@ func (m *RequestVerification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Treasury = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			m.RequestID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7125,16 +10936,31 @@ func (m *RequestVerification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestID |= RequestID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.ExternalID = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Editor", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7144,16 +10970,29 @@ func (m *RequestVerification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExternalID |= ExternalID(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Editor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.DataSourceID = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7163,7 +11002,7 @@ func (m *RequestVerification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSourceID |= DataSourceID(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7189,7 +11028,7 @@ func (m *RequestVerification) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceResult) Unmarshal(dAtA []byte) error {
+func (m *OracleScriptVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7212,15 +11051,53 @@ func (m *PriceResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleScriptVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleScriptVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			}
+			m.OracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7248,13 +11125,13 @@ func (m *PriceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Filename = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			m.Multiplier = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7264,16 +11141,29 @@ func (m *PriceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Multiplier |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Px", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.Px = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7283,16 +11173,29 @@ func (m *PriceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Px |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.RequestID = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Editor", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7302,16 +11205,29 @@ func (m *PriceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestID |= RequestID(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Editor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.ResolveTime = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7321,7 +11237,7 @@ func (m *PriceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResolveTime |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7347,7 +11263,7 @@ func (m *PriceResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleResultSignatureOrder) Unmarshal(dAtA []byte) error {
+func (m *DataSourceVersionPin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7370,17 +11286,17 @@ func (m *OracleResultSignatureOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleResultSignatureOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataSourceVersionPin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleResultSignatureOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataSourceVersionPin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
 			}
-			m.RequestID = 0
+			m.DataSourceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7390,16 +11306,16 @@ func (m *OracleResultSignatureOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestID |= RequestID(b&0x7F) << shift
+				m.DataSourceID |= DataSourceID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.Encoder = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7409,7 +11325,7 @@ func (m *OracleResultSignatureOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Encoder |= Encoder(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
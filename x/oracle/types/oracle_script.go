@@ -21,3 +21,18 @@ func NewOracleScript(
 		SourceCodeURL: sourceCodeURL,
 	}
 }
+
+// NewOracleScriptVersion creates a snapshot of the given oracle script as the given version.
+func NewOracleScriptVersion(
+	id OracleScriptID, version uint64, oracleScript OracleScript, editor string, height int64,
+) OracleScriptVersion {
+	return OracleScriptVersion{
+		OracleScriptID: id,
+		Version:        version,
+		Filename:       oracleScript.Filename,
+		Schema:         oracleScript.Schema,
+		SourceCodeURL:  oracleScript.SourceCodeURL,
+		Editor:         editor,
+		Height:         height,
+	}
+}
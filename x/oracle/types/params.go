@@ -3,42 +3,69 @@ package types
 import (
 	"fmt"
 	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
 	// Each value below is the default value for each parameter when generating the default
 	// genesis file. See comments in types.proto for explanation for each parameter.
-	DefaultMaxRawRequestCount      = uint64(16)
-	DefaultMaxAskCount             = uint64(16)
-	DefaultMaxCalldataSize         = uint64(512) // 512B
-	DefaultMaxReportDataSize       = uint64(512) // 512B
-	DefaultExpirationBlockCount    = uint64(300)
-	DefaultBaseRequestGas          = uint64(50000)
-	DefaultPerValidatorRequestGas  = uint64(0)
-	DefaultSamplingTryCount        = uint64(3)
-	DefaultOracleRewardPercentage  = uint64(70)
-	DefaultInactivePenaltyDuration = uint64(10 * time.Minute)
-	DefaultIBCRequestEnabled       = true
+	DefaultMaxRawRequestCount       = uint64(16)
+	DefaultMaxAskCount              = uint64(16)
+	DefaultMaxCalldataSize          = uint64(512) // 512B
+	DefaultMaxReportDataSize        = uint64(512) // 512B
+	DefaultExpirationBlockCount     = uint64(300)
+	DefaultBaseRequestGas           = uint64(50000)
+	DefaultPerValidatorRequestGas   = uint64(0)
+	DefaultSamplingTryCount         = uint64(3)
+	DefaultOracleRewardPercentage   = uint64(70)
+	DefaultInactivePenaltyDuration  = uint64(10 * time.Minute)
+	DefaultIBCRequestEnabled        = true
+	DefaultMaxLatestResultIndexSize = uint64(10000)
+	DefaultPriceOracleScriptID      = OracleScriptID(0)
+	// A validator is deactivated on its first missed report by default, as the missed report percentage is zero.
+	DefaultReportWindowSize          = uint64(100)
+	DefaultMaxMissedReportPercentage = uint64(0)
+	DefaultMaxCallbackGasLimit       = uint64(300000)
+	DefaultMinSubscriptionInterval   = uint64(10)
+	// DefaultMaxSubscriptionRequestsPerBlock bounds the requests prepared in a begin block by subscriptions.
+	DefaultMaxSubscriptionRequestsPerBlock = uint64(20)
 )
 
+// DefaultSubscriptionBaseRequestFee is the default fee charged for each request spawned by a request subscription.
+var DefaultSubscriptionBaseRequestFee = sdk.NewCoins(sdk.NewInt64Coin("uband", 1000))
+
 // NewParams creates a new parameter configuration for the oracle module
 func NewParams(
 	maxRawRequestCount, maxAskCount, maxCalldataSize, maxReportDataSize, expirationBlockCount, baseRequestGas, perValidatorRequestGas,
 	samplingTryCount, oracleRewardPercentage, inactivePenaltyDuration uint64,
 	ibcRequestEnabled bool,
+	maxLatestResultIndexSize uint64,
+	priceOracleScriptID OracleScriptID,
+	reportWindowSize, maxMissedReportPercentage, maxCallbackGasLimit, minSubscriptionInterval uint64,
+	subscriptionBaseRequestFee sdk.Coins,
+	maxSubscriptionRequestsPerBlock uint64,
 ) Params {
 	return Params{
-		MaxRawRequestCount:      maxRawRequestCount,
-		MaxAskCount:             maxAskCount,
-		MaxCalldataSize:         maxCalldataSize,
-		MaxReportDataSize:       maxReportDataSize,
-		ExpirationBlockCount:    expirationBlockCount,
-		BaseOwasmGas:            baseRequestGas,
-		PerValidatorRequestGas:  perValidatorRequestGas,
-		SamplingTryCount:        samplingTryCount,
-		OracleRewardPercentage:  oracleRewardPercentage,
-		InactivePenaltyDuration: inactivePenaltyDuration,
-		IBCRequestEnabled:       ibcRequestEnabled,
+		MaxRawRequestCount:              maxRawRequestCount,
+		MaxAskCount:                     maxAskCount,
+		MaxCalldataSize:                 maxCalldataSize,
+		MaxReportDataSize:               maxReportDataSize,
+		ExpirationBlockCount:            expirationBlockCount,
+		BaseOwasmGas:                    baseRequestGas,
+		PerValidatorRequestGas:          perValidatorRequestGas,
+		SamplingTryCount:                samplingTryCount,
+		OracleRewardPercentage:          oracleRewardPercentage,
+		InactivePenaltyDuration:         inactivePenaltyDuration,
+		IBCRequestEnabled:               ibcRequestEnabled,
+		MaxLatestResultIndexSize:        maxLatestResultIndexSize,
+		PriceOracleScriptID:             priceOracleScriptID,
+		ReportWindowSize:                reportWindowSize,
+		MaxMissedReportPercentage:       maxMissedReportPercentage,
+		MaxCallbackGasLimit:             maxCallbackGasLimit,
+		MinSubscriptionInterval:         minSubscriptionInterval,
+		SubscriptionBaseRequestFee:      subscriptionBaseRequestFee,
+		MaxSubscriptionRequestsPerBlock: maxSubscriptionRequestsPerBlock,
 	}
 }
 
@@ -56,6 +83,14 @@ func DefaultParams() Params {
 		DefaultOracleRewardPercentage,
 		DefaultInactivePenaltyDuration,
 		DefaultIBCRequestEnabled,
+		DefaultMaxLatestResultIndexSize,
+		DefaultPriceOracleScriptID,
+		DefaultReportWindowSize,
+		DefaultMaxMissedReportPercentage,
+		DefaultMaxCallbackGasLimit,
+		DefaultMinSubscriptionInterval,
+		DefaultSubscriptionBaseRequestFee,
+		DefaultMaxSubscriptionRequestsPerBlock,
 	)
 }
 
@@ -94,6 +129,29 @@ func (p Params) Validate() error {
 	if err := validateBool()(p.IBCRequestEnabled); err != nil {
 		return err
 	}
+	if err := validateUint64("max latest result index size", false)(p.MaxLatestResultIndexSize); err != nil {
+		return err
+	}
+	if err := validateUint64("report window size", false)(p.ReportWindowSize); err != nil {
+		return err
+	}
+	if err := validateUint64("max callback gas limit", false)(p.MaxCallbackGasLimit); err != nil {
+		return err
+	}
+	if err := validateUint64("min subscription interval", false)(p.MinSubscriptionInterval); err != nil {
+		return err
+	}
+	if !p.SubscriptionBaseRequestFee.IsValid() {
+		return fmt.Errorf("invalid subscription base request fee: %s", p.SubscriptionBaseRequestFee)
+	}
+	if err := validateUint64("max subscription requests per block", false)(
+		p.MaxSubscriptionRequestsPerBlock,
+	); err != nil {
+		return err
+	}
+	if p.MaxMissedReportPercentage > 100 {
+		return fmt.Errorf("max missed report percentage must not exceed 100: %d", p.MaxMissedReportPercentage)
+	}
 
 	return nil
 }
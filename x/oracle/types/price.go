@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/bandprotocol/chain/v3/pkg/obi"
+)
+
+// PriceCalldata is the calldata of the standard price reference oracle script.
+type PriceCalldata struct {
+	Symbols    []string `json:"symbols"`
+	Multiplier uint64   `json:"multiplier"`
+}
+
+// PriceOutput is the result of the standard price reference oracle script.
+type PriceOutput struct {
+	Rates []uint64 `json:"rates"`
+}
+
+// DecodePriceResults decodes the calldata and the result of a request to the standard price reference
+// oracle script into a price result for each of its symbols.
+func DecodePriceResults(result Result) ([]PriceResult, error) {
+	// obi allocates a slice before decoding its elements, so the lengths are checked against the data first
+	// to avoid huge allocations on data that does not follow the schema.
+	if err := checkSliceLength(result.Calldata, 4); err != nil {
+		return nil, err
+	}
+	if err := checkSliceLength(result.Result, 8); err != nil {
+		return nil, err
+	}
+
+	var calldata PriceCalldata
+	if err := obi.Decode(result.Calldata, &calldata); err != nil {
+		return nil, err
+	}
+
+	var output PriceOutput
+	if err := obi.Decode(result.Result, &output); err != nil {
+		return nil, err
+	}
+
+	if len(calldata.Symbols) != len(output.Rates) {
+		return nil, fmt.Errorf(
+			"number of symbols (%d) does not match number of rates (%d)",
+			len(calldata.Symbols),
+			len(output.Rates),
+		)
+	}
+
+	priceResults := make([]PriceResult, 0, len(calldata.Symbols))
+	for i, symbol := range calldata.Symbols {
+		priceResults = append(priceResults, PriceResult{
+			Symbol:      symbol,
+			Multiplier:  calldata.Multiplier,
+			Px:          output.Rates[i],
+			RequestID:   result.RequestID,
+			ResolveTime: result.ResolveTime,
+		})
+	}
+
+	return priceResults, nil
+}
+
+// checkSliceLength checks that the obi-encoded slice at the start of the data can fit in the data, given the
+// minimum encoded size of its elements.
+func checkSliceLength(data []byte, minElemSize uint64) error {
+	length, rem, err := obi.DecodeUnsigned32(data)
+	if err != nil {
+		return err
+	}
+	if uint64(length)*minElemSize > uint64(len(rem)) {
+		return fmt.Errorf("slice length %d exceeds data size %d", length, len(rem))
+	}
+	return nil
+}
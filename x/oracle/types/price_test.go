@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/pkg/obi"
+)
+
+func TestDecodePriceResults(t *testing.T) {
+	result := NewResult(
+		"test",
+		1,
+		obi.MustEncode(PriceCalldata{Symbols: []string{"BTC", "ETH"}, Multiplier: 1000}),
+		1,
+		1,
+		2,
+		1,
+		1591622616,
+		1591622618,
+		RESOLVE_STATUS_SUCCESS,
+		obi.MustEncode(PriceOutput{Rates: []uint64{60000000, 3000000}}),
+	)
+
+	priceResults, err := DecodePriceResults(result)
+	require.NoError(t, err)
+	require.Equal(t, []PriceResult{
+		{Symbol: "BTC", Multiplier: 1000, Px: 60000000, RequestID: 2, ResolveTime: 1591622618},
+		{Symbol: "ETH", Multiplier: 1000, Px: 3000000, RequestID: 2, ResolveTime: 1591622618},
+	}, priceResults)
+
+	// the number of rates must match the number of symbols
+	result.Result = obi.MustEncode(PriceOutput{Rates: []uint64{60000000}})
+	_, err = DecodePriceResults(result)
+	require.Error(t, err)
+
+	// the result must follow the standard price schema
+	result.Result = []byte("BASIC_RESULT")
+	_, err = DecodePriceResults(result)
+	require.Error(t, err)
+}
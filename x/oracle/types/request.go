@@ -9,6 +9,8 @@ import (
 var (
 	_ RequestSpec = &MsgRequestData{}
 	_ RequestSpec = &OracleRequestPacketData{}
+
+	_ VersionedRequestSpec = &MsgRequestData{}
 )
 
 // RequestSpec captures the essence of what it means to be a request-making object.
@@ -24,6 +26,14 @@ type RequestSpec interface {
 	GetTSSEncoder() Encoder
 }
 
+// VersionedRequestSpec is a RequestSpec that pins the oracle script and data sources of the request to
+// specific versions. A zero oracle script version and unpinned data sources use their latest versions.
+type VersionedRequestSpec interface {
+	RequestSpec
+	GetOracleScriptVersion() uint64
+	GetDataSourceVersions() []DataSourceVersionPin
+}
+
 func NewRawRequest(
 	externalID ExternalID,
 	dataSourceID DataSourceID,
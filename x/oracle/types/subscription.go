@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ RequestSpec = &RequestSubscription{}
+
+// NewRequestSubscription creates a new RequestSubscription instance.
+func NewRequestSubscription(
+	id uint64,
+	owner sdk.AccAddress,
+	feePayer sdk.AccAddress,
+	oracleScriptID OracleScriptID,
+	calldata []byte,
+	askCount, minCount uint64,
+	clientID string,
+	feeLimit sdk.Coins,
+	prepareGas, executeGas uint64,
+	tssEncoder Encoder,
+	interval uint64,
+	nextRequestHeight int64,
+) RequestSubscription {
+	return RequestSubscription{
+		ID:                id,
+		Owner:             owner.String(),
+		FeePayer:          feePayer.String(),
+		OracleScriptID:    oracleScriptID,
+		Calldata:          calldata,
+		AskCount:          askCount,
+		MinCount:          minCount,
+		ClientID:          clientID,
+		FeeLimit:          feeLimit,
+		PrepareGas:        prepareGas,
+		ExecuteGas:        executeGas,
+		TSSEncoder:        tssEncoder,
+		Interval:          interval,
+		NextRequestHeight: nextRequestHeight,
+		IsActive:          true,
+	}
+}
@@ -58,6 +58,16 @@ type MsgRequestData struct {
 	Sender string `protobuf:"bytes,9,opt,name=sender,proto3" json:"sender,omitempty"`
 	// TSSEncoder is the mode of encoding oracle result signature order.
 	TSSEncoder Encoder `protobuf:"varint,10,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// Callback is the optional in-process module that receives the response of
+	// the request when the request is resolved.
+	Callback *RequestCallback `protobuf:"bytes,11,opt,name=callback,proto3" json:"callback,omitempty"`
+	// OracleScriptVersion is the version of the oracle script to be called. Zero
+	// means the latest version.
+	OracleScriptVersion uint64 `protobuf:"varint,12,opt,name=oracle_script_version,json=oracleScriptVersion,proto3" json:"oracle_script_version,omitempty"`
+	// DataSourceVersions pins the data sources called by the oracle script to
+	// specific versions. Data sources that are not pinned use their latest
+	// versions.
+	DataSourceVersions []DataSourceVersionPin `protobuf:"bytes,13,rep,name=data_source_versions,json=dataSourceVersions,proto3" json:"data_source_versions"`
 }
 
 func (m *MsgRequestData) Reset()         { *m = MsgRequestData{} }
@@ -163,6 +173,27 @@ func (m *MsgRequestData) GetTSSEncoder() Encoder {
 	return ENCODER_UNSPECIFIED
 }
 
+func (m *MsgRequestData) GetCallback() *RequestCallback {
+	if m != nil {
+		return m.Callback
+	}
+	return nil
+}
+
+func (m *MsgRequestData) GetOracleScriptVersion() uint64 {
+	if m != nil {
+		return m.OracleScriptVersion
+	}
+	return 0
+}
+
+func (m *MsgRequestData) GetDataSourceVersions() []DataSourceVersionPin {
+	if m != nil {
+		return m.DataSourceVersions
+	}
+	return nil
+}
+
 // MsgRequestDataResponse is response data for MsgRequestData message
 type MsgRequestDataResponse struct {
 }
@@ -969,30 +1000,50 @@ func (m *MsgActivateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgActivateResponse proto.InternalMessageInfo
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParams struct {
-	// authority is the address of the governance account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the x/oracle parameters to update.
-	//
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
-}
-
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+// MsgCreateRequestSubscription is a message for creating a request
+// subscription.
+type MsgCreateRequestSubscription struct {
+	// OracleScriptID is the identifier of the oracle script to be called.
+	OracleScriptID OracleScriptID `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3,casttype=OracleScriptID" json:"oracle_script_id,omitempty"`
+	// Calldata is the OBI-encoded call parameters for the oracle script.
+	Calldata []byte `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is the number of validators to perform the oracle task.
+	AskCount uint64 `protobuf:"varint,3,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is the minimum number of validators sufficient to resolve the
+	// oracle tasks.
+	MinCount uint64 `protobuf:"varint,4,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// ClientID is the client-provided unique identifier to track the requests.
+	ClientID string `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// FeeLimit is the maximum tokens that will be paid to all data source
+	// providers of each request.
+	FeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fee_limit,json=feeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_limit"`
+	// PrepareGas is amount of gas to pay to prepare raw requests
+	PrepareGas uint64 `protobuf:"varint,7,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
+	// ExecuteGas is amount of gas to reserve for executing
+	ExecuteGas uint64 `protobuf:"varint,8,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	// TSSEncoder is the mode of encoding oracle result signature order.
+	TSSEncoder Encoder `protobuf:"varint,9,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// Interval is the number of blocks between two spawned requests.
+	Interval uint64 `protobuf:"varint,10,opt,name=interval,proto3" json:"interval,omitempty"`
+	// InitialDeposit is the deposit transferred from the owner to the fee payer
+	// account of the subscription.
+	InitialDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=initial_deposit,json=initialDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_deposit"`
+	// Owner is an account address of message sender.
+	Owner string `protobuf:"bytes,12,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgCreateRequestSubscription) Reset()         { *m = MsgCreateRequestSubscription{} }
+func (m *MsgCreateRequestSubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateRequestSubscription) ProtoMessage()    {}
+func (*MsgCreateRequestSubscription) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3ffde65d794f19e2, []int{14}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateRequestSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateRequestSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateRequestSubscription.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1002,51 +1053,121 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgCreateRequestSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateRequestSubscription.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgCreateRequestSubscription) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgCreateRequestSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateRequestSubscription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateRequestSubscription proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgCreateRequestSubscription) GetOracleScriptID() OracleScriptID {
 	if m != nil {
-		return m.Authority
+		return m.OracleScriptID
+	}
+	return 0
+}
+
+func (m *MsgCreateRequestSubscription) GetCalldata() []byte {
+	if m != nil {
+		return m.Calldata
+	}
+	return nil
+}
+
+func (m *MsgCreateRequestSubscription) GetAskCount() uint64 {
+	if m != nil {
+		return m.AskCount
+	}
+	return 0
+}
+
+func (m *MsgCreateRequestSubscription) GetMinCount() uint64 {
+	if m != nil {
+		return m.MinCount
+	}
+	return 0
+}
+
+func (m *MsgCreateRequestSubscription) GetClientID() string {
+	if m != nil {
+		return m.ClientID
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgCreateRequestSubscription) GetFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Params
+		return m.FeeLimit
 	}
-	return Params{}
+	return nil
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParamsResponse struct {
+func (m *MsgCreateRequestSubscription) GetPrepareGas() uint64 {
+	if m != nil {
+		return m.PrepareGas
+	}
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreateRequestSubscription) GetExecuteGas() uint64 {
+	if m != nil {
+		return m.ExecuteGas
+	}
+	return 0
+}
+
+func (m *MsgCreateRequestSubscription) GetTSSEncoder() Encoder {
+	if m != nil {
+		return m.TSSEncoder
+	}
+	return ENCODER_UNSPECIFIED
+}
+
+func (m *MsgCreateRequestSubscription) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *MsgCreateRequestSubscription) GetInitialDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.InitialDeposit
+	}
+	return nil
+}
+
+func (m *MsgCreateRequestSubscription) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// MsgCreateRequestSubscriptionResponse is response data for
+// MsgCreateRequestSubscription message
+type MsgCreateRequestSubscriptionResponse struct {
+	// SubscriptionID is the identifier of the created request subscription.
+	SubscriptionID uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+}
+
+func (m *MsgCreateRequestSubscriptionResponse) Reset()         { *m = MsgCreateRequestSubscriptionResponse{} }
+func (m *MsgCreateRequestSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateRequestSubscriptionResponse) ProtoMessage()    {}
+func (*MsgCreateRequestSubscriptionResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3ffde65d794f19e2, []int{15}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateRequestSubscriptionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateRequestSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateRequestSubscriptionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1056,181 +1177,525 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgCreateRequestSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateRequestSubscriptionResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgCreateRequestSubscriptionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgCreateRequestSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateRequestSubscriptionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateRequestSubscriptionResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgRequestData)(nil), "band.oracle.v1.MsgRequestData")
-	proto.RegisterType((*MsgRequestDataResponse)(nil), "band.oracle.v1.MsgRequestDataResponse")
-	proto.RegisterType((*MsgReportData)(nil), "band.oracle.v1.MsgReportData")
-	proto.RegisterType((*MsgReportDataResponse)(nil), "band.oracle.v1.MsgReportDataResponse")
-	proto.RegisterType((*MsgCreateDataSource)(nil), "band.oracle.v1.MsgCreateDataSource")
-	proto.RegisterType((*MsgCreateDataSourceResponse)(nil), "band.oracle.v1.MsgCreateDataSourceResponse")
-	proto.RegisterType((*MsgEditDataSource)(nil), "band.oracle.v1.MsgEditDataSource")
-	proto.RegisterType((*MsgEditDataSourceResponse)(nil), "band.oracle.v1.MsgEditDataSourceResponse")
-	proto.RegisterType((*MsgCreateOracleScript)(nil), "band.oracle.v1.MsgCreateOracleScript")
-	proto.RegisterType((*MsgCreateOracleScriptResponse)(nil), "band.oracle.v1.MsgCreateOracleScriptResponse")
-	proto.RegisterType((*MsgEditOracleScript)(nil), "band.oracle.v1.MsgEditOracleScript")
-	proto.RegisterType((*MsgEditOracleScriptResponse)(nil), "band.oracle.v1.MsgEditOracleScriptResponse")
-	proto.RegisterType((*MsgActivate)(nil), "band.oracle.v1.MsgActivate")
-	proto.RegisterType((*MsgActivateResponse)(nil), "band.oracle.v1.MsgActivateResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "band.oracle.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "band.oracle.v1.MsgUpdateParamsResponse")
+func (m *MsgCreateRequestSubscriptionResponse) GetSubscriptionID() uint64 {
+	if m != nil {
+		return m.SubscriptionID
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("band/oracle/v1/tx.proto", fileDescriptor_3ffde65d794f19e2) }
-
-var fileDescriptor_3ffde65d794f19e2 = []byte{
-	// 1284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0x36, 0xad, 0x8f, 0x88, 0x23, 0x59, 0x49, 0x98, 0x0f, 0xc9, 0x34, 0x2c, 0x2a, 0xca, 0xc7,
-	0xab, 0x24, 0xb0, 0x98, 0x38, 0xc1, 0x0b, 0xc4, 0x97, 0x36, 0x72, 0xd2, 0xd6, 0x40, 0xd4, 0x16,
-	0x74, 0x53, 0x14, 0x05, 0x5a, 0x61, 0x45, 0x6e, 0x68, 0x36, 0x12, 0xa9, 0x72, 0x57, 0x4a, 0x72,
-	0x2b, 0x7a, 0xec, 0xa5, 0xed, 0x3f, 0xe8, 0xad, 0x40, 0x81, 0x02, 0x39, 0xe4, 0x2f, 0x14, 0xc8,
-	0x31, 0x48, 0x2f, 0x3d, 0xa9, 0x85, 0x72, 0x48, 0xef, 0x3d, 0x14, 0xe8, 0xa9, 0xd8, 0xe5, 0x8a,
-	0x22, 0x65, 0xca, 0x76, 0x8c, 0x34, 0x17, 0x9b, 0xb3, 0xcf, 0x33, 0xb3, 0xc3, 0x79, 0x66, 0x96,
-	0x2b, 0x28, 0x75, 0x90, 0x6b, 0xe9, 0x9e, 0x8f, 0xcc, 0x2e, 0xd6, 0x87, 0x57, 0x75, 0xfa, 0xb0,
-	0xd1, 0xf7, 0x3d, 0xea, 0x29, 0x45, 0x06, 0x34, 0x02, 0xa0, 0x31, 0xbc, 0xaa, 0x9e, 0xb4, 0x3d,
-	0xdb, 0xe3, 0x90, 0xce, 0x9e, 0x02, 0x96, 0xba, 0x32, 0xe3, 0x2e, 0xf8, 0x01, 0x58, 0x31, 0x3d,
-	0xd2, 0xf3, 0x88, 0xde, 0x41, 0x84, 0x81, 0x1d, 0x4c, 0xd1, 0x55, 0xdd, 0xf4, 0x1c, 0x57, 0xe0,
-	0xcb, 0x01, 0xde, 0x0e, 0xa2, 0x06, 0x86, 0x80, 0x4a, 0xc2, 0xb5, 0x47, 0x6c, 0x16, 0xb6, 0x47,
-	0x6c, 0x01, 0x1c, 0x47, 0x3d, 0xc7, 0xf5, 0x74, 0xfe, 0x37, 0x58, 0xaa, 0xfd, 0x9c, 0x86, 0x62,
-	0x8b, 0xd8, 0x06, 0xfe, 0x72, 0x80, 0x09, 0xbd, 0x85, 0x28, 0x52, 0xde, 0x87, 0x63, 0x41, 0x26,
-	0x6d, 0x62, 0xfa, 0x4e, 0x9f, 0xb6, 0x1d, 0xab, 0x2c, 0x55, 0xa5, 0x7a, 0xba, 0x79, 0x6e, 0x3c,
-	0xd2, 0x8a, 0x1f, 0x70, 0x6c, 0x9b, 0x43, 0x5b, 0xb7, 0xfe, 0xd9, 0xb5, 0x62, 0x14, 0xbd, 0xa8,
-	0x6d, 0x29, 0x2a, 0xe4, 0x4c, 0xd4, 0xed, 0x5a, 0x88, 0xa2, 0xf2, 0x62, 0x55, 0xaa, 0x17, 0x8c,
-	0xd0, 0x56, 0x56, 0x40, 0x46, 0xe4, 0x7e, 0xdb, 0xf4, 0x06, 0x2e, 0x2d, 0xa7, 0xd8, 0x26, 0x46,
-	0x0e, 0x91, 0xfb, 0x9b, 0xcc, 0x66, 0x60, 0xcf, 0x71, 0x05, 0x98, 0x0e, 0xc0, 0x9e, 0xe3, 0x06,
-	0xe0, 0x45, 0x90, 0xcd, 0xae, 0x83, 0x5d, 0x9e, 0x5e, 0xa6, 0x2a, 0xd5, 0xe5, 0x66, 0x61, 0x3c,
-	0xd2, 0x72, 0x9b, 0x7c, 0x71, 0xeb, 0x96, 0x91, 0x0b, 0xe0, 0x2d, 0x4b, 0xd9, 0x01, 0xf9, 0x1e,
-	0xc6, 0xed, 0xae, 0xd3, 0x73, 0x68, 0x39, 0x5b, 0x4d, 0xd5, 0xf3, 0xeb, 0xcb, 0x0d, 0x51, 0x31,
-	0x56, 0xde, 0x86, 0x28, 0x6f, 0x63, 0xd3, 0x73, 0xdc, 0xe6, 0x95, 0xa7, 0x23, 0x6d, 0xe1, 0xa7,
-	0xdf, 0xb5, 0xba, 0xed, 0xd0, 0x9d, 0x41, 0xa7, 0x61, 0x7a, 0x3d, 0x51, 0x5e, 0xf1, 0x6f, 0x8d,
-	0x58, 0xf7, 0x75, 0xfa, 0xa8, 0x8f, 0x09, 0x77, 0x20, 0x46, 0xee, 0x1e, 0xc6, 0x77, 0x58, 0x70,
-	0x45, 0x83, 0x7c, 0xdf, 0xc7, 0x7d, 0xe4, 0xe3, 0xb6, 0x8d, 0x48, 0xf9, 0x08, 0xcf, 0x19, 0xc4,
-	0xd2, 0xbb, 0x88, 0x30, 0x02, 0x7e, 0x88, 0xcd, 0x01, 0x0d, 0x08, 0xb9, 0x80, 0x20, 0x96, 0x18,
-	0xe1, 0x0a, 0x64, 0x09, 0x76, 0x2d, 0xec, 0x97, 0x65, 0xfe, 0x4e, 0xe5, 0xe7, 0x4f, 0xd6, 0x4e,
-	0x8a, 0x5c, 0x6f, 0x5a, 0x96, 0x8f, 0x09, 0xd9, 0xa6, 0xbe, 0xe3, 0xda, 0x86, 0xe0, 0x29, 0xef,
-	0x41, 0x9e, 0x12, 0xd2, 0xc6, 0xae, 0xe9, 0x31, 0x37, 0xa8, 0x4a, 0xf5, 0xe2, 0x7a, 0xa9, 0x11,
-	0xef, 0xc0, 0xc6, 0xed, 0x00, 0x6e, 0x16, 0xc7, 0x23, 0x0d, 0x3e, 0xda, 0xde, 0x16, 0xb6, 0x01,
-	0x94, 0x10, 0xf1, 0xbc, 0x51, 0xfb, 0xf3, 0x07, 0x4d, 0xfa, 0xfa, 0xe5, 0xe3, 0x4b, 0x22, 0xf4,
-	0x37, 0x2f, 0x1f, 0x5f, 0x12, 0x62, 0xea, 0xa2, 0x41, 0x6a, 0x65, 0x38, 0x1d, 0x6f, 0x17, 0x03,
-	0x93, 0xbe, 0xe7, 0x12, 0x5c, 0xfb, 0x4b, 0x82, 0x25, 0x0e, 0xf5, 0x3d, 0x3f, 0x68, 0xa4, 0x1b,
-	0x00, 0x7e, 0x40, 0x9c, 0xb6, 0x90, 0x3a, 0x1e, 0x69, 0xb2, 0x70, 0xe7, 0xdd, 0x33, 0x35, 0x0c,
-	0x59, 0xb0, 0xb7, 0x2c, 0xe5, 0x6d, 0xc8, 0xfb, 0xe8, 0x41, 0xdb, 0xe7, 0xc1, 0x48, 0x79, 0x51,
-	0x88, 0x36, 0xf3, 0x52, 0x06, 0x7a, 0x10, 0x6c, 0xd7, 0x4c, 0x33, 0xd1, 0x0c, 0xf0, 0x27, 0x0b,
-	0x44, 0x79, 0x0b, 0xe4, 0x21, 0xea, 0x3a, 0x16, 0xa2, 0x9e, 0xcf, 0x3b, 0x4b, 0x6e, 0x9e, 0x79,
-	0xfe, 0x64, 0x6d, 0x55, 0xd4, 0xf2, 0xe3, 0x09, 0x16, 0x2f, 0xea, 0xd4, 0x67, 0xe3, 0xdc, 0xa4,
-	0x1a, 0xd3, 0x35, 0x56, 0x90, 0xa5, 0xb0, 0x20, 0x6c, 0x9f, 0x5a, 0x09, 0x4e, 0xc5, 0x5e, 0x3a,
-	0x2c, 0xc7, 0xb7, 0x29, 0x38, 0xd1, 0x22, 0xf6, 0xa6, 0x8f, 0x11, 0xc5, 0x0c, 0xd9, 0xf6, 0x06,
-	0xbe, 0x89, 0x15, 0x05, 0xd2, 0x2e, 0xea, 0x61, 0x5e, 0x0e, 0xd9, 0xe0, 0xcf, 0x4a, 0x15, 0xf2,
-	0x16, 0x0e, 0x86, 0xcd, 0xf1, 0x5c, 0x3e, 0x24, 0xb2, 0x11, 0x5d, 0x52, 0x2a, 0x20, 0x9a, 0x04,
-	0x75, 0xba, 0x98, 0xbf, 0x4e, 0xc1, 0x88, 0xac, 0x28, 0x9f, 0x41, 0xea, 0x1e, 0xc6, 0xe5, 0xf4,
-	0xeb, 0x6f, 0x6e, 0x16, 0x57, 0xb9, 0x0e, 0x39, 0xea, 0x63, 0x44, 0x06, 0xfe, 0x23, 0x31, 0x6b,
-	0xf3, 0xfb, 0x32, 0x64, 0x2a, 0x0d, 0xc8, 0x78, 0x0f, 0x5c, 0xec, 0x97, 0xb3, 0xfb, 0xb8, 0x04,
-	0xb4, 0x48, 0xef, 0x1f, 0x39, 0x58, 0xef, 0x6f, 0x5c, 0x4e, 0xe8, 0xd8, 0x92, 0x10, 0x68, 0xb6,
-	0xf2, 0xb5, 0x55, 0x58, 0x49, 0x10, 0x24, 0x14, 0xec, 0xd7, 0x14, 0x1c, 0x6f, 0x11, 0xfb, 0xb6,
-	0xe5, 0xd0, 0x88, 0x5c, 0xef, 0x40, 0x91, 0x1d, 0x54, 0x6d, 0xc2, 0xcd, 0x69, 0x1f, 0x57, 0xc7,
-	0x23, 0xad, 0x30, 0xe5, 0xf1, 0x56, 0x8e, 0xd9, 0x46, 0xc1, 0x9a, 0x5a, 0x56, 0x28, 0xfb, 0xe2,
-	0x7c, 0xd9, 0x53, 0xfb, 0xc9, 0x9e, 0x9e, 0x27, 0x7b, 0xe6, 0x0d, 0xc8, 0x9e, 0x7d, 0x75, 0xd9,
-	0x8f, 0xbc, 0xaa, 0xec, 0xb9, 0x03, 0xca, 0x7e, 0x31, 0x41, 0xf6, 0x53, 0x42, 0xf6, 0xb8, 0x7e,
-	0xb5, 0x15, 0x58, 0xde, 0x25, 0x6a, 0x28, 0xf9, 0x2f, 0x8b, 0x7c, 0x7a, 0x83, 0x96, 0x88, 0x7e,
-	0xc5, 0x0e, 0x39, 0xa5, 0xa7, 0x21, 0x4b, 0xcc, 0x1d, 0xdc, 0x43, 0x42, 0x4b, 0x61, 0x29, 0x37,
-	0xe0, 0xa8, 0xe8, 0x1f, 0x76, 0xd0, 0xb6, 0x07, 0x7e, 0x97, 0x6b, 0x29, 0x37, 0x8f, 0x8f, 0x47,
-	0xda, 0x52, 0x90, 0xd4, 0xa6, 0x67, 0xe1, 0xbb, 0xc6, 0x1d, 0x63, 0x89, 0x4c, 0x4d, 0xbf, 0xcb,
-	0x12, 0x61, 0x3e, 0x7c, 0xea, 0x0a, 0x06, 0x7f, 0x7e, 0x03, 0x73, 0xb5, 0x96, 0x50, 0xe0, 0xe5,
-	0xd8, 0x5c, 0x45, 0xab, 0x55, 0xd3, 0x60, 0x35, 0xb1, 0x8c, 0x61, 0xa1, 0xbf, 0x0f, 0x0e, 0x43,
-	0x26, 0x43, 0xac, 0xcc, 0xaf, 0xfb, 0xaa, 0x71, 0xb8, 0x29, 0x9b, 0xca, 0x96, 0xde, 0x4f, 0xb6,
-	0xcc, 0x2b, 0xca, 0x96, 0x4d, 0x92, 0xed, 0x3f, 0x9b, 0x8b, 0xbd, 0x8e, 0xc3, 0xd9, 0xda, 0x8b,
-	0xe3, 0x70, 0x76, 0x39, 0x94, 0x6c, 0x08, 0xf9, 0x16, 0xb1, 0x6f, 0x9a, 0xd4, 0x19, 0x22, 0x8a,
-	0xe3, 0x9f, 0x53, 0xe9, 0x10, 0x9f, 0xd3, 0x0b, 0xc9, 0x9f, 0xd3, 0xa3, 0x22, 0xbd, 0xc9, 0x46,
-	0xb5, 0x53, 0xbc, 0x53, 0x26, 0x66, 0x98, 0xce, 0x8f, 0x12, 0x1c, 0x6d, 0x11, 0xfb, 0x6e, 0xdf,
-	0x42, 0x14, 0x7f, 0x88, 0x7c, 0xd4, 0x23, 0xca, 0xff, 0x41, 0x46, 0x03, 0xba, 0xe3, 0xf9, 0x0e,
-	0x7d, 0x24, 0x72, 0x9a, 0x5f, 0xa3, 0x29, 0x55, 0xb9, 0x0e, 0xd9, 0x3e, 0x8f, 0xc0, 0xfb, 0x24,
-	0xbf, 0x7e, 0x7a, 0xf6, 0x5e, 0x11, 0xc4, 0x17, 0x97, 0x0a, 0xc1, 0xdd, 0xb8, 0xc0, 0x93, 0x0f,
-	0xa3, 0xb0, 0xe4, 0x4f, 0x88, 0xe4, 0xa3, 0x59, 0xd5, 0x96, 0xa1, 0x34, 0x93, 0xe8, 0xe4, 0x25,
-	0xd6, 0xff, 0xce, 0x40, 0xaa, 0x45, 0x6c, 0xe5, 0x2e, 0xe4, 0xa3, 0x17, 0xee, 0xca, 0xec, 0xfe,
-	0xf1, 0x1b, 0x96, 0x7a, 0x61, 0x6f, 0x7c, 0x12, 0x5e, 0x31, 0x00, 0x22, 0xb7, 0xaf, 0xd5, 0x44,
-	0xaf, 0x09, 0xac, 0x9e, 0xdf, 0x13, 0x0e, 0x63, 0x5a, 0x70, 0x6c, 0xd7, 0x15, 0xe6, 0x6c, 0x82,
-	0xeb, 0x2c, 0x49, 0xbd, 0x7c, 0x00, 0x52, 0xb8, 0xcb, 0xe7, 0x50, 0x9c, 0xf9, 0xee, 0x9e, 0x49,
-	0x70, 0x8f, 0x53, 0xd4, 0x8b, 0xfb, 0x52, 0xc2, 0xf8, 0x5f, 0x80, 0x92, 0x70, 0xc8, 0x9f, 0x9f,
-	0x9b, 0x62, 0x94, 0xa6, 0xae, 0x1d, 0x88, 0x16, 0xad, 0xd8, 0xae, 0x73, 0xee, 0xec, 0x9c, 0x54,
-	0x63, 0xfb, 0x5c, 0x3e, 0x00, 0x29, 0xdc, 0xe5, 0x0e, 0xe4, 0xc2, 0xd9, 0x5c, 0x49, 0x70, 0x9c,
-	0x80, 0xea, 0xd9, 0x3d, 0xc0, 0x30, 0xda, 0x27, 0x50, 0x88, 0x4d, 0x96, 0x96, 0xe0, 0x14, 0x25,
-	0xa8, 0xff, 0xdb, 0x87, 0x30, 0x89, 0xac, 0x66, 0xbe, 0x7a, 0xf9, 0xf8, 0x92, 0xd4, 0xdc, 0x7a,
-	0x3a, 0xae, 0x48, 0xcf, 0xc6, 0x15, 0xe9, 0x8f, 0x71, 0x45, 0xfa, 0xee, 0x45, 0x65, 0xe1, 0xd9,
-	0x8b, 0xca, 0xc2, 0x6f, 0x2f, 0x2a, 0x0b, 0x9f, 0xea, 0x91, 0x2b, 0x09, 0x8b, 0xc9, 0x7f, 0x96,
-	0x9a, 0x5e, 0x57, 0x37, 0x77, 0x90, 0xe3, 0xea, 0xc3, 0x6b, 0xfa, 0xc3, 0xc9, 0x4f, 0x64, 0x7e,
-	0x3f, 0xe9, 0x64, 0x39, 0xe3, 0xda, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xab, 0x06, 0x21,
-	0x7d, 0x0f, 0x00, 0x00,
+// MsgTopUpRequestSubscription is a message for adding deposit to a request
+// subscription.
+type MsgTopUpRequestSubscription struct {
+	// SubscriptionID is the identifier of the request subscription.
+	SubscriptionID uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// Amount is the deposit to be transferred to the fee payer account of the
+	// subscription.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// Sender is an account address of message sender.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (this *MsgRequestData) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*MsgRequestData)
-	if !ok {
-		that2, ok := that.(MsgRequestData)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *MsgTopUpRequestSubscription) Reset()         { *m = MsgTopUpRequestSubscription{} }
+func (m *MsgTopUpRequestSubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpRequestSubscription) ProtoMessage()    {}
+func (*MsgTopUpRequestSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{16}
+}
+func (m *MsgTopUpRequestSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTopUpRequestSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpRequestSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.OracleScriptID != that1.OracleScriptID {
-		return false
-	}
-	if !bytes.Equal(this.Calldata, that1.Calldata) {
-		return false
-	}
-	if this.AskCount != that1.AskCount {
-		return false
-	}
-	if this.MinCount != that1.MinCount {
-		return false
+}
+func (m *MsgTopUpRequestSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpRequestSubscription.Merge(m, src)
+}
+func (m *MsgTopUpRequestSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUpRequestSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpRequestSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTopUpRequestSubscription proto.InternalMessageInfo
+
+func (m *MsgTopUpRequestSubscription) GetSubscriptionID() uint64 {
+	if m != nil {
+		return m.SubscriptionID
 	}
-	if this.ClientID != that1.ClientID {
-		return false
+	return 0
+}
+
+func (m *MsgTopUpRequestSubscription) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	if len(this.FeeLimit) != len(that1.FeeLimit) {
-		return false
+	return nil
+}
+
+func (m *MsgTopUpRequestSubscription) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	for i := range this.FeeLimit {
-		if !this.FeeLimit[i].Equal(&that1.FeeLimit[i]) {
-			return false
+	return ""
+}
+
+// MsgTopUpRequestSubscriptionResponse is response data for
+// MsgTopUpRequestSubscription message
+type MsgTopUpRequestSubscriptionResponse struct {
+}
+
+func (m *MsgTopUpRequestSubscriptionResponse) Reset()         { *m = MsgTopUpRequestSubscriptionResponse{} }
+func (m *MsgTopUpRequestSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpRequestSubscriptionResponse) ProtoMessage()    {}
+func (*MsgTopUpRequestSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{17}
+}
+func (m *MsgTopUpRequestSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTopUpRequestSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpRequestSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.PrepareGas != that1.PrepareGas {
-		return false
-	}
-	if this.ExecuteGas != that1.ExecuteGas {
-		return false
-	}
-	if this.Sender != that1.Sender {
-		return false
-	}
-	if this.TSSEncoder != that1.TSSEncoder {
-		return false
-	}
-	return true
 }
-func (this *MsgReportData) Equal(that interface{}) bool {
+func (m *MsgTopUpRequestSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpRequestSubscriptionResponse.Merge(m, src)
+}
+func (m *MsgTopUpRequestSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUpRequestSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpRequestSubscriptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTopUpRequestSubscriptionResponse proto.InternalMessageInfo
+
+// MsgCancelRequestSubscription is a message for cancelling a request
+// subscription.
+type MsgCancelRequestSubscription struct {
+	// SubscriptionID is the identifier of the request subscription.
+	SubscriptionID uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// Owner is the owner of the request subscription.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgCancelRequestSubscription) Reset()         { *m = MsgCancelRequestSubscription{} }
+func (m *MsgCancelRequestSubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRequestSubscription) ProtoMessage()    {}
+func (*MsgCancelRequestSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{18}
+}
+func (m *MsgCancelRequestSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRequestSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRequestSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRequestSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRequestSubscription.Merge(m, src)
+}
+func (m *MsgCancelRequestSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRequestSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRequestSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRequestSubscription proto.InternalMessageInfo
+
+func (m *MsgCancelRequestSubscription) GetSubscriptionID() uint64 {
+	if m != nil {
+		return m.SubscriptionID
+	}
+	return 0
+}
+
+func (m *MsgCancelRequestSubscription) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// MsgCancelRequestSubscriptionResponse is response data for
+// MsgCancelRequestSubscription message
+type MsgCancelRequestSubscriptionResponse struct {
+}
+
+func (m *MsgCancelRequestSubscriptionResponse) Reset()         { *m = MsgCancelRequestSubscriptionResponse{} }
+func (m *MsgCancelRequestSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRequestSubscriptionResponse) ProtoMessage()    {}
+func (*MsgCancelRequestSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{19}
+}
+func (m *MsgCancelRequestSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRequestSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRequestSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRequestSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRequestSubscriptionResponse.Merge(m, src)
+}
+func (m *MsgCancelRequestSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRequestSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRequestSubscriptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRequestSubscriptionResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/oracle parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{20}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{21}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRequestData)(nil), "band.oracle.v1.MsgRequestData")
+	proto.RegisterType((*MsgRequestDataResponse)(nil), "band.oracle.v1.MsgRequestDataResponse")
+	proto.RegisterType((*MsgReportData)(nil), "band.oracle.v1.MsgReportData")
+	proto.RegisterType((*MsgReportDataResponse)(nil), "band.oracle.v1.MsgReportDataResponse")
+	proto.RegisterType((*MsgCreateDataSource)(nil), "band.oracle.v1.MsgCreateDataSource")
+	proto.RegisterType((*MsgCreateDataSourceResponse)(nil), "band.oracle.v1.MsgCreateDataSourceResponse")
+	proto.RegisterType((*MsgEditDataSource)(nil), "band.oracle.v1.MsgEditDataSource")
+	proto.RegisterType((*MsgEditDataSourceResponse)(nil), "band.oracle.v1.MsgEditDataSourceResponse")
+	proto.RegisterType((*MsgCreateOracleScript)(nil), "band.oracle.v1.MsgCreateOracleScript")
+	proto.RegisterType((*MsgCreateOracleScriptResponse)(nil), "band.oracle.v1.MsgCreateOracleScriptResponse")
+	proto.RegisterType((*MsgEditOracleScript)(nil), "band.oracle.v1.MsgEditOracleScript")
+	proto.RegisterType((*MsgEditOracleScriptResponse)(nil), "band.oracle.v1.MsgEditOracleScriptResponse")
+	proto.RegisterType((*MsgActivate)(nil), "band.oracle.v1.MsgActivate")
+	proto.RegisterType((*MsgActivateResponse)(nil), "band.oracle.v1.MsgActivateResponse")
+	proto.RegisterType((*MsgCreateRequestSubscription)(nil), "band.oracle.v1.MsgCreateRequestSubscription")
+	proto.RegisterType((*MsgCreateRequestSubscriptionResponse)(nil), "band.oracle.v1.MsgCreateRequestSubscriptionResponse")
+	proto.RegisterType((*MsgTopUpRequestSubscription)(nil), "band.oracle.v1.MsgTopUpRequestSubscription")
+	proto.RegisterType((*MsgTopUpRequestSubscriptionResponse)(nil), "band.oracle.v1.MsgTopUpRequestSubscriptionResponse")
+	proto.RegisterType((*MsgCancelRequestSubscription)(nil), "band.oracle.v1.MsgCancelRequestSubscription")
+	proto.RegisterType((*MsgCancelRequestSubscriptionResponse)(nil), "band.oracle.v1.MsgCancelRequestSubscriptionResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "band.oracle.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "band.oracle.v1.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("band/oracle/v1/tx.proto", fileDescriptor_3ffde65d794f19e2) }
+
+var fileDescriptor_3ffde65d794f19e2 = []byte{
+	// 1602 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xfa, 0x57, 0xed, 0x67, 0xc7, 0x69, 0xa7, 0x4d, 0xb3, 0xd9, 0x7c, 0x63, 0xbb, 0x4e,
+	0x9a, 0x6f, 0xda, 0x7e, 0xe3, 0x6d, 0x93, 0xe8, 0x2b, 0x35, 0x3d, 0x40, 0x9d, 0x14, 0x88, 0xd4,
+	0x40, 0xb5, 0x69, 0x2a, 0x84, 0x00, 0x6b, 0xbc, 0x3b, 0x75, 0x96, 0xd8, 0xbb, 0x66, 0x67, 0xed,
+	0xb6, 0x12, 0x12, 0x88, 0x23, 0x17, 0xe0, 0x3f, 0xe0, 0x04, 0x12, 0xa7, 0x1e, 0xfa, 0x07, 0x70,
+	0x41, 0xea, 0xb1, 0x2a, 0x17, 0x0e, 0x28, 0x20, 0xf7, 0x50, 0xee, 0x1c, 0x90, 0x10, 0x07, 0xb4,
+	0xb3, 0xe3, 0xf5, 0xae, 0xb3, 0x4e, 0x9c, 0x34, 0x94, 0x0b, 0x97, 0xd6, 0x33, 0xef, 0xf3, 0xde,
+	0xbc, 0x79, 0x9f, 0xcf, 0x9b, 0x99, 0x0d, 0x4c, 0x54, 0xb1, 0xa1, 0xc9, 0xa6, 0x85, 0xd5, 0x3a,
+	0x91, 0xdb, 0x57, 0x64, 0xfb, 0x7e, 0xa9, 0x69, 0x99, 0xb6, 0x89, 0xb2, 0x8e, 0xa1, 0xe4, 0x1a,
+	0x4a, 0xed, 0x2b, 0xd2, 0x99, 0x9a, 0x59, 0x33, 0x99, 0x49, 0x76, 0x7e, 0xb9, 0x28, 0x69, 0xaa,
+	0xcf, 0x9d, 0xe3, 0x5d, 0x63, 0x4e, 0x35, 0x69, 0xc3, 0xa4, 0x72, 0x15, 0x53, 0xc7, 0x58, 0x25,
+	0x36, 0xbe, 0x22, 0xab, 0xa6, 0x6e, 0x70, 0xfb, 0xa4, 0x6b, 0xaf, 0xb8, 0x51, 0xdd, 0x01, 0x37,
+	0x4d, 0x70, 0xd7, 0x06, 0xad, 0x39, 0x61, 0x1b, 0xb4, 0xc6, 0x0d, 0xa7, 0x70, 0x43, 0x37, 0x4c,
+	0x99, 0xfd, 0xeb, 0x4e, 0x15, 0xff, 0x8c, 0x43, 0x76, 0x83, 0xd6, 0x14, 0xf2, 0x61, 0x8b, 0x50,
+	0x7b, 0x0d, 0xdb, 0x18, 0xbd, 0x09, 0x27, 0xdd, 0x4c, 0x2a, 0x54, 0xb5, 0xf4, 0xa6, 0x5d, 0xd1,
+	0x35, 0x51, 0x28, 0x08, 0xf3, 0xb1, 0xf2, 0x6c, 0x67, 0x37, 0x9f, 0x7d, 0x8b, 0xd9, 0x36, 0x99,
+	0x69, 0x7d, 0xed, 0x8f, 0x3d, 0x33, 0x4a, 0xd6, 0xf4, 0x8f, 0x35, 0x24, 0x41, 0x52, 0xc5, 0xf5,
+	0xba, 0x86, 0x6d, 0x2c, 0x46, 0x0a, 0xc2, 0x7c, 0x46, 0xf1, 0xc6, 0x68, 0x0a, 0x52, 0x98, 0xee,
+	0x54, 0x54, 0xb3, 0x65, 0xd8, 0x62, 0xd4, 0x59, 0x44, 0x49, 0x62, 0xba, 0xb3, 0xea, 0x8c, 0x1d,
+	0x63, 0x43, 0x37, 0xb8, 0x31, 0xe6, 0x1a, 0x1b, 0xba, 0xe1, 0x1a, 0x2f, 0x40, 0x4a, 0xad, 0xeb,
+	0xc4, 0x60, 0xe9, 0xc5, 0x0b, 0xc2, 0x7c, 0xaa, 0x9c, 0xe9, 0xec, 0xe6, 0x93, 0xab, 0x6c, 0x72,
+	0x7d, 0x4d, 0x49, 0xba, 0xe6, 0x75, 0x0d, 0x6d, 0x43, 0xea, 0x2e, 0x21, 0x95, 0xba, 0xde, 0xd0,
+	0x6d, 0x31, 0x51, 0x88, 0xce, 0xa7, 0x17, 0x27, 0x4b, 0xbc, 0x62, 0x4e, 0x79, 0x4b, 0xbc, 0xbc,
+	0xa5, 0x55, 0x53, 0x37, 0xca, 0x97, 0x1f, 0xef, 0xe6, 0x47, 0xbe, 0xfd, 0x39, 0x3f, 0x5f, 0xd3,
+	0xed, 0xed, 0x56, 0xb5, 0xa4, 0x9a, 0x0d, 0x5e, 0x5e, 0xfe, 0xdf, 0x02, 0xd5, 0x76, 0x64, 0xfb,
+	0x41, 0x93, 0x50, 0xe6, 0x40, 0x95, 0xe4, 0x5d, 0x42, 0x6e, 0x3a, 0xc1, 0x51, 0x1e, 0xd2, 0x4d,
+	0x8b, 0x34, 0xb1, 0x45, 0x2a, 0x35, 0x4c, 0xc5, 0x13, 0x2c, 0x67, 0xe0, 0x53, 0xaf, 0x63, 0xea,
+	0x00, 0xc8, 0x7d, 0xa2, 0xb6, 0x6c, 0x17, 0x90, 0x74, 0x01, 0x7c, 0xca, 0x01, 0x5c, 0x86, 0x04,
+	0x25, 0x86, 0x46, 0x2c, 0x31, 0xc5, 0xf6, 0x24, 0x3e, 0x7d, 0xb4, 0x70, 0x86, 0xe7, 0x7a, 0x5d,
+	0xd3, 0x2c, 0x42, 0xe9, 0xa6, 0x6d, 0xe9, 0x46, 0x4d, 0xe1, 0x38, 0xf4, 0x06, 0xa4, 0x6d, 0x4a,
+	0x2b, 0xc4, 0x50, 0x4d, 0xc7, 0x0d, 0x0a, 0xc2, 0x7c, 0x76, 0x71, 0xa2, 0x14, 0x54, 0x60, 0xe9,
+	0x86, 0x6b, 0x2e, 0x67, 0x3b, 0xbb, 0x79, 0xb8, 0xbd, 0xb9, 0xc9, 0xc7, 0x0a, 0xd8, 0x94, 0xf2,
+	0xdf, 0xe8, 0x9a, 0x4b, 0x54, 0x15, 0xab, 0x3b, 0x62, 0xba, 0x20, 0xcc, 0xa7, 0x17, 0xf3, 0xfd,
+	0x61, 0xb8, 0x4e, 0x56, 0x39, 0x4c, 0xf1, 0x1c, 0xd0, 0x22, 0x8c, 0x07, 0x55, 0xd3, 0x26, 0x16,
+	0xd5, 0x4d, 0x43, 0xcc, 0xb0, 0x3d, 0x9e, 0xf6, 0x8b, 0xe2, 0x8e, 0x6b, 0x42, 0xef, 0xc2, 0x19,
+	0x47, 0x05, 0x15, 0x6a, 0xb6, 0x2c, 0x95, 0x74, 0x3d, 0xa8, 0x38, 0xca, 0x38, 0x9a, 0xed, 0x5f,
+	0xdc, 0x51, 0xe7, 0x26, 0x83, 0xf2, 0x00, 0xb7, 0x74, 0xa3, 0x1c, 0x73, 0xe8, 0x52, 0x90, 0xd6,
+	0x6f, 0xa3, 0x2b, 0xc5, 0x5f, 0xbf, 0xca, 0x0b, 0x9f, 0x3e, 0x7f, 0x78, 0x91, 0x57, 0xea, 0xb3,
+	0xe7, 0x0f, 0x2f, 0x72, 0x6d, 0xca, 0x7c, 0x1f, 0x45, 0x11, 0xce, 0x06, 0xd5, 0xaf, 0x10, 0xda,
+	0x34, 0x0d, 0x4a, 0x8a, 0xbf, 0x09, 0x30, 0xca, 0x4c, 0x4d, 0xd3, 0x72, 0xfb, 0xe2, 0x2a, 0x80,
+	0xe5, 0x02, 0x7b, 0x1d, 0x21, 0x75, 0x76, 0xf3, 0x29, 0xee, 0xce, 0x9a, 0xa1, 0x37, 0x50, 0x52,
+	0x1c, 0xbd, 0xae, 0xa1, 0x57, 0x21, 0x6d, 0xe1, 0x7b, 0x15, 0x8b, 0x05, 0xa3, 0x62, 0x84, 0x6b,
+	0xb0, 0xbf, 0xb8, 0xf8, 0x9e, 0xbb, 0x1c, 0xdf, 0x14, 0x58, 0xdd, 0x09, 0x8a, 0x5e, 0x81, 0x54,
+	0x1b, 0xd7, 0x75, 0x0d, 0xdb, 0xa6, 0xc5, 0x1a, 0x25, 0x55, 0x3e, 0xf7, 0xf4, 0xd1, 0xc2, 0x34,
+	0x97, 0xc6, 0x9d, 0xae, 0x2d, 0xa8, 0x91, 0x9e, 0xcf, 0xca, 0x6c, 0xb7, 0x1a, 0xbd, 0x39, 0xa7,
+	0x20, 0xa3, 0x5e, 0x41, 0x9c, 0x75, 0x8a, 0x13, 0x30, 0x1e, 0xd8, 0xb4, 0x57, 0x8e, 0xcf, 0xa3,
+	0x70, 0x7a, 0x83, 0xd6, 0x56, 0x2d, 0x82, 0x6d, 0xd2, 0x23, 0x02, 0x21, 0x88, 0x19, 0xb8, 0x41,
+	0x58, 0x39, 0x52, 0x0a, 0xfb, 0x8d, 0x0a, 0x90, 0xd6, 0x88, 0xab, 0x02, 0x47, 0x00, 0x11, 0x66,
+	0xf2, 0x4f, 0xa1, 0x1c, 0x70, 0xcd, 0xe3, 0x6a, 0x9d, 0xb0, 0xed, 0x64, 0x14, 0xdf, 0x0c, 0x7a,
+	0x0f, 0xa2, 0x77, 0x09, 0x11, 0x63, 0xc7, 0xdf, 0xab, 0x4e, 0x5c, 0xb4, 0x0c, 0x49, 0xdb, 0x22,
+	0x98, 0xb6, 0xac, 0x07, 0x62, 0xfc, 0x80, 0x36, 0xf3, 0x90, 0xa8, 0x04, 0x71, 0xf3, 0x9e, 0x41,
+	0x2c, 0x31, 0x71, 0x80, 0x8b, 0x0b, 0xf3, 0xb5, 0xf2, 0x89, 0xe1, 0x5a, 0x79, 0xe5, 0x52, 0x88,
+	0x62, 0x27, 0x38, 0x41, 0xfd, 0x95, 0x2f, 0x4e, 0xc3, 0x54, 0x08, 0x21, 0x1e, 0x61, 0x3f, 0x44,
+	0xe1, 0xd4, 0x06, 0xad, 0xdd, 0xd0, 0x74, 0xdb, 0x47, 0xd7, 0x6b, 0x90, 0xf5, 0x77, 0x9c, 0xa7,
+	0xe3, 0x42, 0x67, 0x37, 0x9f, 0xe9, 0xe1, 0x98, 0x94, 0x03, 0x63, 0x25, 0xd3, 0xeb, 0xb0, 0x75,
+	0xcd, 0xa3, 0x3d, 0x32, 0x98, 0xf6, 0xe8, 0x41, 0xb4, 0xc7, 0x06, 0xd1, 0x1e, 0x7f, 0x09, 0xb4,
+	0x27, 0x0e, 0x4f, 0xfb, 0x89, 0xc3, 0xd2, 0x9e, 0x1c, 0x92, 0xf6, 0x0b, 0x21, 0xb4, 0xf3, 0xc3,
+	0x54, 0x0e, 0xf2, 0x57, 0x9c, 0x82, 0xc9, 0x3d, 0xa4, 0x7a, 0x94, 0x7f, 0x1f, 0x81, 0x71, 0x4f,
+	0x12, 0xfe, 0x4b, 0xf9, 0x88, 0x5d, 0x7a, 0x16, 0x12, 0x54, 0xdd, 0x26, 0x0d, 0xcc, 0xb9, 0xe4,
+	0x23, 0x74, 0x15, 0xc6, 0xb8, 0x7e, 0x9c, 0x7b, 0xa3, 0xd2, 0xb2, 0xea, 0x8c, 0xcb, 0x54, 0xf9,
+	0x54, 0x67, 0x37, 0x3f, 0xea, 0x26, 0xb5, 0x6a, 0x6a, 0x64, 0x4b, 0xb9, 0xa9, 0x8c, 0xd2, 0xde,
+	0xd0, 0xaa, 0x3b, 0x89, 0x38, 0x3e, 0xac, 0xeb, 0x32, 0x0a, 0xfb, 0xfd, 0x12, 0xfa, 0x6a, 0x21,
+	0xa4, 0xc0, 0x93, 0x81, 0xbe, 0xf2, 0x57, 0xab, 0x98, 0x87, 0xe9, 0xd0, 0x32, 0x7a, 0x85, 0xfe,
+	0xd2, 0x3d, 0x0c, 0x1d, 0x1a, 0x02, 0x65, 0x3e, 0xee, 0x97, 0xd3, 0xd1, 0xba, 0xac, 0x47, 0x5b,
+	0xec, 0x20, 0xda, 0xe2, 0x87, 0xa4, 0x2d, 0x11, 0x46, 0xdb, 0xdf, 0xd6, 0x17, 0xfb, 0x1d, 0x87,
+	0xfd, 0xb5, 0xe7, 0xc7, 0x61, 0xff, 0xb4, 0x47, 0x59, 0x1b, 0xd2, 0x1b, 0xb4, 0x76, 0x5d, 0xb5,
+	0xf5, 0x36, 0xb6, 0x49, 0xf0, 0x3a, 0x15, 0x8e, 0x70, 0x9d, 0xce, 0x85, 0x5f, 0xa7, 0x63, 0x3c,
+	0xbd, 0xee, 0x42, 0xc5, 0x71, 0xa6, 0x94, 0xee, 0xd0, 0x4b, 0xe7, 0xa7, 0x38, 0xfc, 0xc7, 0xd3,
+	0x18, 0x7f, 0x32, 0x6c, 0xb6, 0xaa, 0x3d, 0x12, 0xff, 0x7d, 0x84, 0xff, 0x83, 0x8f, 0xf0, 0xbe,
+	0x27, 0x75, 0xea, 0xe8, 0x4f, 0x6a, 0x09, 0x92, 0xba, 0x61, 0x13, 0xab, 0x8d, 0xeb, 0xec, 0x65,
+	0x1e, 0x53, 0xbc, 0x31, 0xb2, 0x61, 0x4c, 0x37, 0x74, 0x5b, 0xc7, 0xf5, 0x8a, 0x46, 0x9a, 0x26,
+	0xd5, 0x6d, 0x31, 0x7d, 0xfc, 0x75, 0xc9, 0xf2, 0x35, 0xd6, 0xdc, 0x25, 0x7a, 0x6d, 0x9b, 0x19,
+	0xaa, 0x6d, 0x57, 0x96, 0xba, 0x42, 0x77, 0xc7, 0x8e, 0xc8, 0x0b, 0x81, 0xa3, 0x33, 0x44, 0xbd,
+	0x45, 0x15, 0x66, 0xf7, 0x53, 0x77, 0xb7, 0x0d, 0xd0, 0x35, 0x18, 0xa3, 0xbe, 0xf9, 0x9e, 0xc8,
+	0x91, 0x23, 0x72, 0xbf, 0x8b, 0x23, 0x69, 0x3f, 0x74, 0x5d, 0x2b, 0x7e, 0x1d, 0x61, 0x2d, 0x7f,
+	0xdb, 0x6c, 0x6e, 0x35, 0xc3, 0x5a, 0xe8, 0x45, 0x82, 0x23, 0x15, 0x12, 0xb8, 0xc1, 0x34, 0x1f,
+	0x39, 0x7e, 0x4e, 0x78, 0x68, 0xdf, 0x91, 0x18, 0x1d, 0xf2, 0x48, 0x5c, 0x0a, 0x39, 0x12, 0xf3,
+	0x9c, 0x8e, 0x41, 0x85, 0x28, 0x9e, 0x87, 0x99, 0x7d, 0xea, 0xe4, 0x9d, 0x49, 0xdf, 0x09, 0xee,
+	0x99, 0x84, 0x0d, 0x95, 0xd4, 0x8f, 0xbd, 0xa0, 0x9e, 0xee, 0x22, 0x2f, 0xae, 0xbb, 0x41, 0x19,
+	0x16, 0xe7, 0x5c, 0xdd, 0x0d, 0xb2, 0x7b, 0x5b, 0xfd, 0x46, 0x80, 0xb1, 0x0d, 0x5a, 0xdb, 0x6a,
+	0x6a, 0xd8, 0x26, 0xb7, 0xb0, 0x85, 0x1b, 0x14, 0xfd, 0x1f, 0x52, 0xb8, 0x65, 0x6f, 0x9b, 0x96,
+	0x6e, 0x3f, 0x10, 0x85, 0x03, 0x92, 0xec, 0x41, 0xd1, 0x32, 0x24, 0x9a, 0x2c, 0x02, 0xdb, 0x59,
+	0x7a, 0xf1, 0x6c, 0xff, 0x39, 0xe1, 0xc6, 0xe7, 0xdf, 0x74, 0x1c, 0xbb, 0x32, 0xc7, 0xee, 0x0e,
+	0x2f, 0x8a, 0xb3, 0x3d, 0xfe, 0x89, 0x2c, 0xfb, 0xb3, 0x2a, 0x4e, 0xc2, 0x44, 0x5f, 0xa2, 0xdd,
+	0x4d, 0x2c, 0xfe, 0x9e, 0x84, 0xe8, 0x06, 0xad, 0xa1, 0x2d, 0x48, 0xfb, 0xff, 0x7c, 0x93, 0xeb,
+	0x5f, 0x3f, 0xf8, 0x81, 0x2b, 0xcd, 0xed, 0x6f, 0xf7, 0x7a, 0x53, 0x01, 0xf0, 0x7d, 0xfc, 0x4e,
+	0x87, 0x7a, 0x75, 0xcd, 0xd2, 0xf9, 0x7d, 0xcd, 0x5e, 0x4c, 0x0d, 0x4e, 0xee, 0xf9, 0x82, 0x9c,
+	0x09, 0x71, 0xed, 0x07, 0x49, 0x97, 0x86, 0x00, 0x79, 0xab, 0xbc, 0x0f, 0xd9, 0xbe, 0xcf, 0x9e,
+	0x73, 0x21, 0xee, 0x41, 0x88, 0x74, 0xe1, 0x40, 0x88, 0x17, 0xff, 0x03, 0x40, 0x21, 0x6f, 0xec,
+	0xf3, 0x03, 0x53, 0xf4, 0xc3, 0xa4, 0x85, 0xa1, 0x60, 0xfe, 0x8a, 0xed, 0x79, 0x66, 0xce, 0x0c,
+	0x48, 0x35, 0xb0, 0xce, 0xa5, 0x21, 0x40, 0xde, 0x2a, 0x37, 0x21, 0xe9, 0x3d, 0x8d, 0xa6, 0x42,
+	0x1c, 0xbb, 0x46, 0x69, 0x66, 0x1f, 0xa3, 0x17, 0xed, 0x63, 0x98, 0x1c, 0xfc, 0xb0, 0xf9, 0xdf,
+	0xc0, 0xfd, 0x87, 0xa0, 0xa5, 0xe5, 0xc3, 0xa0, 0xbd, 0x04, 0x3e, 0x02, 0x71, 0xe0, 0xad, 0x10,
+	0x56, 0x97, 0x41, 0x60, 0x69, 0xe9, 0x10, 0xe0, 0xc0, 0xf6, 0x07, 0x9e, 0xa1, 0xa1, 0xdb, 0x1f,
+	0x84, 0x96, 0x96, 0x0f, 0x83, 0xf6, 0x12, 0x78, 0x1b, 0x32, 0x81, 0x93, 0x2d, 0x1f, 0x12, 0xc5,
+	0x0f, 0x90, 0xfe, 0x7b, 0x00, 0xa0, 0x1b, 0x59, 0x8a, 0x7f, 0xf2, 0xfc, 0xe1, 0x45, 0xa1, 0xbc,
+	0xfe, 0xb8, 0x93, 0x13, 0x9e, 0x74, 0x72, 0xc2, 0x2f, 0x9d, 0x9c, 0xf0, 0xc5, 0xb3, 0xdc, 0xc8,
+	0x93, 0x67, 0xb9, 0x91, 0x1f, 0x9f, 0xe5, 0x46, 0xde, 0x91, 0x7d, 0x77, 0xa0, 0x13, 0x93, 0xfd,
+	0x91, 0x59, 0x35, 0xeb, 0xb2, 0xba, 0x8d, 0x75, 0x43, 0x6e, 0x2f, 0xc9, 0xf7, 0xbb, 0x7f, 0xf0,
+	0x66, 0x17, 0x62, 0x35, 0xc1, 0x10, 0x4b, 0x7f, 0x0d, 0x00, 0x3f, 0xd2, 0x67, 0xef, 0x4b, 0x17,
+	0x00, 0x00,
+}
+
+func (this *MsgRequestData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRequestData)
+	if !ok {
+		that2, ok := that.(MsgRequestData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
+	}
+	if !bytes.Equal(this.Calldata, that1.Calldata) {
+		return false
+	}
+	if this.AskCount != that1.AskCount {
+		return false
+	}
+	if this.MinCount != that1.MinCount {
+		return false
+	}
+	if this.ClientID != that1.ClientID {
+		return false
+	}
+	if len(this.FeeLimit) != len(that1.FeeLimit) {
+		return false
+	}
+	for i := range this.FeeLimit {
+		if !this.FeeLimit[i].Equal(&that1.FeeLimit[i]) {
+			return false
+		}
+	}
+	if this.PrepareGas != that1.PrepareGas {
+		return false
+	}
+	if this.ExecuteGas != that1.ExecuteGas {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.TSSEncoder != that1.TSSEncoder {
+		return false
+	}
+	if !this.Callback.Equal(that1.Callback) {
+		return false
+	}
+	if this.OracleScriptVersion != that1.OracleScriptVersion {
+		return false
+	}
+	if len(this.DataSourceVersions) != len(that1.DataSourceVersions) {
+		return false
+	}
+	for i := range this.DataSourceVersions {
+		if !this.DataSourceVersions[i].Equal(&that1.DataSourceVersions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgReportData) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
@@ -1473,14 +1938,143 @@ func (this *MsgActivate) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgCreateRequestSubscription) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+	that1, ok := that.(*MsgCreateRequestSubscription)
+	if !ok {
+		that2, ok := that.(MsgCreateRequestSubscription)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
+	}
+	if !bytes.Equal(this.Calldata, that1.Calldata) {
+		return false
+	}
+	if this.AskCount != that1.AskCount {
+		return false
+	}
+	if this.MinCount != that1.MinCount {
+		return false
+	}
+	if this.ClientID != that1.ClientID {
+		return false
+	}
+	if len(this.FeeLimit) != len(that1.FeeLimit) {
+		return false
+	}
+	for i := range this.FeeLimit {
+		if !this.FeeLimit[i].Equal(&that1.FeeLimit[i]) {
+			return false
+		}
+	}
+	if this.PrepareGas != that1.PrepareGas {
+		return false
+	}
+	if this.ExecuteGas != that1.ExecuteGas {
+		return false
+	}
+	if this.TSSEncoder != that1.TSSEncoder {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if len(this.InitialDeposit) != len(that1.InitialDeposit) {
+		return false
+	}
+	for i := range this.InitialDeposit {
+		if !this.InitialDeposit[i].Equal(&that1.InitialDeposit[i]) {
+			return false
+		}
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	return true
+}
+func (this *MsgTopUpRequestSubscription) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgTopUpRequestSubscription)
+	if !ok {
+		that2, ok := that.(MsgTopUpRequestSubscription)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubscriptionID != that1.SubscriptionID {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	return true
+}
+func (this *MsgCancelRequestSubscription) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCancelRequestSubscription)
+	if !ok {
+		that2, ok := that.(MsgCancelRequestSubscription)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubscriptionID != that1.SubscriptionID {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
 
 // MsgClient is the client API for Msg service.
 //
@@ -1500,6 +2094,15 @@ type MsgClient interface {
 	EditOracleScript(ctx context.Context, in *MsgEditOracleScript, opts ...grpc.CallOption) (*MsgEditOracleScriptResponse, error)
 	// Activate defines a method for applying to be an oracle validator.
 	Activate(ctx context.Context, in *MsgActivate, opts ...grpc.CallOption) (*MsgActivateResponse, error)
+	// CreateRequestSubscription defines a method for creating a request
+	// subscription that spawns requests at a fixed block interval.
+	CreateRequestSubscription(ctx context.Context, in *MsgCreateRequestSubscription, opts ...grpc.CallOption) (*MsgCreateRequestSubscriptionResponse, error)
+	// TopUpRequestSubscription defines a method for adding deposit to a request
+	// subscription.
+	TopUpRequestSubscription(ctx context.Context, in *MsgTopUpRequestSubscription, opts ...grpc.CallOption) (*MsgTopUpRequestSubscriptionResponse, error)
+	// CancelRequestSubscription defines a method for cancelling a request
+	// subscription and refunding its remaining deposit.
+	CancelRequestSubscription(ctx context.Context, in *MsgCancelRequestSubscription, opts ...grpc.CallOption) (*MsgCancelRequestSubscriptionResponse, error)
 	// UpdateParams defines a governance operation for updating the x/oracle module
 	// parameters.
 	//
@@ -1578,6 +2181,33 @@ func (c *msgClient) Activate(ctx context.Context, in *MsgActivate, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) CreateRequestSubscription(ctx context.Context, in *MsgCreateRequestSubscription, opts ...grpc.CallOption) (*MsgCreateRequestSubscriptionResponse, error) {
+	out := new(MsgCreateRequestSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Msg/CreateRequestSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TopUpRequestSubscription(ctx context.Context, in *MsgTopUpRequestSubscription, opts ...grpc.CallOption) (*MsgTopUpRequestSubscriptionResponse, error) {
+	out := new(MsgTopUpRequestSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Msg/TopUpRequestSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRequestSubscription(ctx context.Context, in *MsgCancelRequestSubscription, opts ...grpc.CallOption) (*MsgCancelRequestSubscriptionResponse, error) {
+	out := new(MsgCancelRequestSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Msg/CancelRequestSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/band.oracle.v1.Msg/UpdateParams", in, out, opts...)
@@ -1603,6 +2233,15 @@ type MsgServer interface {
 	EditOracleScript(context.Context, *MsgEditOracleScript) (*MsgEditOracleScriptResponse, error)
 	// Activate defines a method for applying to be an oracle validator.
 	Activate(context.Context, *MsgActivate) (*MsgActivateResponse, error)
+	// CreateRequestSubscription defines a method for creating a request
+	// subscription that spawns requests at a fixed block interval.
+	CreateRequestSubscription(context.Context, *MsgCreateRequestSubscription) (*MsgCreateRequestSubscriptionResponse, error)
+	// TopUpRequestSubscription defines a method for adding deposit to a request
+	// subscription.
+	TopUpRequestSubscription(context.Context, *MsgTopUpRequestSubscription) (*MsgTopUpRequestSubscriptionResponse, error)
+	// CancelRequestSubscription defines a method for cancelling a request
+	// subscription and refunding its remaining deposit.
+	CancelRequestSubscription(context.Context, *MsgCancelRequestSubscription) (*MsgCancelRequestSubscriptionResponse, error)
 	// UpdateParams defines a governance operation for updating the x/oracle module
 	// parameters.
 	//
@@ -1635,6 +2274,15 @@ func (*UnimplementedMsgServer) EditOracleScript(ctx context.Context, req *MsgEdi
 func (*UnimplementedMsgServer) Activate(ctx context.Context, req *MsgActivate) (*MsgActivateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Activate not implemented")
 }
+func (*UnimplementedMsgServer) CreateRequestSubscription(ctx context.Context, req *MsgCreateRequestSubscription) (*MsgCreateRequestSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRequestSubscription not implemented")
+}
+func (*UnimplementedMsgServer) TopUpRequestSubscription(ctx context.Context, req *MsgTopUpRequestSubscription) (*MsgTopUpRequestSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpRequestSubscription not implemented")
+}
+func (*UnimplementedMsgServer) CancelRequestSubscription(ctx context.Context, req *MsgCancelRequestSubscription) (*MsgCancelRequestSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRequestSubscription not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -1769,6 +2417,60 @@ func _Msg_Activate_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateRequestSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRequestSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRequestSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Msg/CreateRequestSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRequestSubscription(ctx, req.(*MsgCreateRequestSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TopUpRequestSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTopUpRequestSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TopUpRequestSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Msg/TopUpRequestSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TopUpRequestSubscription(ctx, req.(*MsgTopUpRequestSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRequestSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRequestSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRequestSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Msg/CancelRequestSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRequestSubscription(ctx, req.(*MsgCancelRequestSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -1819,6 +2521,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Activate",
 			Handler:    _Msg_Activate_Handler,
 		},
+		{
+			MethodName: "CreateRequestSubscription",
+			Handler:    _Msg_CreateRequestSubscription_Handler,
+		},
+		{
+			MethodName: "TopUpRequestSubscription",
+			Handler:    _Msg_TopUpRequestSubscription_Handler,
+		},
+		{
+			MethodName: "CancelRequestSubscription",
+			Handler:    _Msg_CancelRequestSubscription_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -1848,6 +2562,37 @@ func (m *MsgRequestData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DataSourceVersions) > 0 {
+		for iNdEx := len(m.DataSourceVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataSourceVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.OracleScriptVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OracleScriptVersion))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Callback != nil {
+		{
+			size, err := m.Callback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.TSSEncoder != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TSSEncoder))
 		i--
@@ -2468,7 +3213,7 @@ func (m *MsgActivateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateRequestSubscription) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2478,57 +3223,322 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateRequestSubscription) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateRequestSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.InitialDeposit) > 0 {
+		for iNdEx := len(m.InitialDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitialDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x50
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.TSSEncoder != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TSSEncoder))
+		i--
+		dAtA[i] = 0x48
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if m.ExecuteGas != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecuteGas))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PrepareGas != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PrepareGas))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.FeeLimit) > 0 {
+		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MinCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AskCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AskCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OracleScriptID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OracleScriptID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRequestSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRequestSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRequestSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubscriptionID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubscriptionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUpRequestSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpRequestSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpRequestSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SubscriptionID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubscriptionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUpRequestSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpRequestSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpRequestSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRequestSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRequestSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRequestSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubscriptionID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubscriptionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRequestSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRequestSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRequestSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
 }
 
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
@@ -2584,11 +3594,24 @@ func (m *MsgRequestData) Size() (n int) {
 	if m.TSSEncoder != 0 {
 		n += 1 + sovTx(uint64(m.TSSEncoder))
 	}
-	return n
-}
-
-func (m *MsgRequestDataResponse) Size() (n int) {
-	if m == nil {
+	if m.Callback != nil {
+		l = m.Callback.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OracleScriptVersion != 0 {
+		n += 1 + sovTx(uint64(m.OracleScriptVersion))
+	}
+	if len(m.DataSourceVersions) > 0 {
+		for _, e := range m.DataSourceVersions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRequestDataResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -2843,6 +3866,128 @@ func (m *MsgActivateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateRequestSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScriptID != 0 {
+		n += 1 + sovTx(uint64(m.OracleScriptID))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AskCount != 0 {
+		n += 1 + sovTx(uint64(m.AskCount))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovTx(uint64(m.MinCount))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FeeLimit) > 0 {
+		for _, e := range m.FeeLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.PrepareGas != 0 {
+		n += 1 + sovTx(uint64(m.PrepareGas))
+	}
+	if m.ExecuteGas != 0 {
+		n += 1 + sovTx(uint64(m.ExecuteGas))
+	}
+	if m.TSSEncoder != 0 {
+		n += 1 + sovTx(uint64(m.TSSEncoder))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if len(m.InitialDeposit) > 0 {
+		for _, e := range m.InitialDeposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateRequestSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubscriptionID != 0 {
+		n += 1 + sovTx(uint64(m.SubscriptionID))
+	}
+	return n
+}
+
+func (m *MsgTopUpRequestSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubscriptionID != 0 {
+		n += 1 + sovTx(uint64(m.SubscriptionID))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTopUpRequestSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelRequestSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubscriptionID != 0 {
+		n += 1 + sovTx(uint64(m.SubscriptionID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelRequestSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2853,27 +3998,462 @@ func (m *MsgUpdateParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			}
+			m.OracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+			}
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeLimit = append(m.FeeLimit, types.Coin{})
+			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+			}
+			m.PrepareGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrepareGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+			}
+			m.ExecuteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
+			}
+			m.TSSEncoder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TSSEncoder |= Encoder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Callback == nil {
+				m.Callback = &RequestCallback{}
+			}
+			if err := m.Callback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptVersion", wireType)
+			}
+			m.OracleScriptVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataSourceVersions = append(m.DataSourceVersions, DataSourceVersionPin{})
+			if err := m.DataSourceVersions[len(m.DataSourceVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
+func (m *MsgReportData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2896,17 +4476,17 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestData: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			m.OracleScriptID = 0
+			m.RequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2916,16 +4496,16 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				m.RequestID |= RequestID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2935,31 +4515,31 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Calldata == nil {
-				m.Calldata = []byte{}
+			m.RawReports = append(m.RawReports, RawReport{})
+			if err := m.RawReports[len(m.RawReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			m.AskCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2969,16 +4549,129 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AskCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.MinCount = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReportDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDataSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2988,14 +4681,27 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3023,11 +4729,45 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executable = append(m.Executable[:0], dAtA[iNdEx:postIndex]...)
+			if m.Executable == nil {
+				m.Executable = []byte{}
+			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3054,16 +4794,16 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeLimit = append(m.FeeLimit, types.Coin{})
-			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
 			}
-			m.PrepareGas = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3073,33 +4813,27 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrepareGas |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.ExecuteGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExecuteGas |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 9:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3127,13 +4861,13 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			m.TSSEncoder = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3143,11 +4877,24 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TSSEncoder |= Encoder(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3169,7 +4916,7 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestDataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateDataSourceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3192,10 +4939,10 @@ func (m *MsgRequestDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateDataSourceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateDataSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3219,7 +4966,7 @@ func (m *MsgRequestDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReportData) Unmarshal(dAtA []byte) error {
+func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3242,17 +4989,17 @@ func (m *MsgReportData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReportData: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEditDataSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReportData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEditDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
 			}
-			m.RequestID = 0
+			m.DataSourceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3262,16 +5009,180 @@ func (m *MsgReportData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestID |= RequestID(b&0x7F) << shift
+				m.DataSourceID |= DataSourceID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executable = append(m.Executable[:0], dAtA[iNdEx:postIndex]...)
+			if m.Executable == nil {
+				m.Executable = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3281,29 +5192,27 @@ func (m *MsgReportData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawReports = append(m.RawReports, RawReport{})
-			if err := m.RawReports[len(m.RawReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3331,7 +5240,7 @@ func (m *MsgReportData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3354,7 +5263,7 @@ func (m *MsgReportData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReportDataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEditDataSourceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3377,10 +5286,10 @@ func (m *MsgReportDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReportDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEditDataSourceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReportDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEditDataSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3404,7 +5313,7 @@ func (m *MsgReportDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3427,10 +5336,10 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDataSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateOracleScript: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateOracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3499,9 +5408,9 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3511,31 +5420,29 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Executable = append(m.Executable[:0], dAtA[iNdEx:postIndex]...)
-			if m.Executable == nil {
-				m.Executable = []byte{}
-			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3545,31 +5452,29 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = append(m.Fee, types.Coin{})
-			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3579,23 +5484,25 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Treasury = string(dAtA[iNdEx:postIndex])
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
@@ -3682,7 +5589,7 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDataSourceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateOracleScriptResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3705,10 +5612,10 @@ func (m *MsgCreateDataSourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDataSourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateOracleScriptResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDataSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3732,7 +5639,7 @@ func (m *MsgCreateDataSourceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
+func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3755,17 +5662,17 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditDataSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEditOracleScript: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEditOracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
 			}
-			m.DataSourceID = 0
+			m.OracleScriptID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3775,7 +5682,7 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSourceID |= DataSourceID(b&0x7F) << shift
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3846,7 +5753,71 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3873,16 +5844,16 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Executable = append(m.Executable[:0], dAtA[iNdEx:postIndex]...)
-			if m.Executable == nil {
-				m.Executable = []byte{}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3892,29 +5863,27 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = append(m.Fee, types.Coin{})
-			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3935,50 +5904,118 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditOracleScriptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditOracleScriptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Treasury = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4006,7 +6043,7 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4029,7 +6066,7 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditDataSourceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4052,10 +6089,10 @@ func (m *MsgEditDataSourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditDataSourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgActivateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditDataSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgActivateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4079,7 +6116,7 @@ func (m *MsgEditDataSourceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateRequestSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4102,17 +6139,17 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateOracleScript: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateRequestSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateOracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateRequestSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
 			}
-			var stringLen uint64
+			m.OracleScriptID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4122,29 +6159,16 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4154,27 +6178,67 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+			}
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4202,13 +6266,13 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
+			m.ClientID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4218,29 +6282,31 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
+			m.FeeLimit = append(m.FeeLimit, types.Coin{})
+			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
 			}
-			var byteLen int
+			m.PrepareGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4250,31 +6316,73 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.PrepareGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			m.ExecuteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
 			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
+			m.TSSEncoder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TSSEncoder |= Encoder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 6:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4284,27 +6392,29 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.InitialDeposit = append(m.InitialDeposit, types.Coin{})
+			if err := m.InitialDeposit[len(m.InitialDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4332,7 +6442,7 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4355,7 +6465,7 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateOracleScriptResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateRequestSubscriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4378,12 +6488,31 @@ func (m *MsgCreateOracleScriptResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateOracleScriptResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateRequestSubscriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateRequestSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionID", wireType)
+			}
+			m.SubscriptionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4405,7 +6534,7 @@ func (m *MsgCreateOracleScriptResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
+func (m *MsgTopUpRequestSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4428,17 +6557,17 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditOracleScript: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTopUpRequestSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditOracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTopUpRequestSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionID", wireType)
 			}
-			m.OracleScriptID = 0
+			m.SubscriptionID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4448,144 +6577,16 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				m.SubscriptionID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4595,59 +6596,27 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -4700,7 +6669,7 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditOracleScriptResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTopUpRequestSubscriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4723,10 +6692,10 @@ func (m *MsgEditOracleScriptResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditOracleScriptResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTopUpRequestSubscriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTopUpRequestSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4750,7 +6719,7 @@ func (m *MsgEditOracleScriptResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivate) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRequestSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4773,15 +6742,34 @@ func (m *MsgActivate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRequestSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRequestSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionID", wireType)
+			}
+			m.SubscriptionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4809,7 +6797,7 @@ func (m *MsgActivate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4832,7 +6820,7 @@ func (m *MsgActivate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRequestSubscriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4855,10 +6843,10 @@ func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRequestSubscriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRequestSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
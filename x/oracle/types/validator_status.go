@@ -11,3 +11,17 @@ func NewValidatorStatus(
 		Since:    since,
 	}
 }
+
+func NewValidatorReportInfo(
+	validator string,
+	windowSize, indexOffset, missedReportsCounter, totalReports, totalMissedReports uint64,
+) ValidatorReportInfo {
+	return ValidatorReportInfo{
+		Validator:            validator,
+		WindowSize:           windowSize,
+		IndexOffset:          indexOffset,
+		MissedReportsCounter: missedReportsCounter,
+		TotalReports:         totalReports,
+		TotalMissedReports:   totalMissedReports,
+	}
+}
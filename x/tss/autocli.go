@@ -79,6 +79,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "signing_id"},
 					},
 				},
+				{
+					RpcMethod: "BatchProof",
+					Use:       "batch-proof [id]",
+					Short:     "Query the merkle proof of a batched signing and the result of its batch",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "signing_id"},
+					},
+				},
 				{
 					RpcMethod: "Signings",
 					Use:       "signings",
@@ -21,6 +21,7 @@ import (
 
 const (
 	flagExpiration = "expiration"
+	flagCurve      = "curve"
 )
 
 // GetTxCmd returns a root CLI command handler for all x/tss transaction commands.
@@ -321,7 +322,7 @@ func GetTxCmdSubmitDEs() *cobra.Command {
 		Args:  cobra.MinimumNArgs(1),
 		Short: "Submit multiple DE containing address and DEs",
 		Example: fmt.Sprintf(
-			`%s tx tss submit-multi-de [d,e] [d,e] ...`,
+			`%s tx tss submit-multi-de [d,e] [d,e] ... --curve ed25519`,
 			version.AppName,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -330,6 +331,16 @@ func GetTxCmdSubmitDEs() *cobra.Command {
 				return err
 			}
 
+			curveName, err := cmd.Flags().GetString(flagCurve)
+			if err != nil {
+				return err
+			}
+
+			curve, err := types.ParseCurve(curveName)
+			if err != nil {
+				return err
+			}
+
 			var des []types.DE
 			for i := 0; i < len(args); i++ {
 				de := strings.Split(args[i], ",")
@@ -347,7 +358,7 @@ func GetTxCmdSubmitDEs() *cobra.Command {
 					return err
 				}
 
-				des = append(des, types.NewDE(d, e))
+				des = append(des, types.NewDE(d, e, curve))
 			}
 
 			msg := types.NewMsgSubmitDEs(des, clientCtx.GetFromAddress().String())
@@ -355,6 +366,7 @@ func GetTxCmdSubmitDEs() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagCurve, "secp256k1", "The curve of the DEs (secp256k1 or ed25519)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
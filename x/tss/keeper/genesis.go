@@ -33,18 +33,28 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	sort.Strings(addresses)
 
 	for _, addr := range addresses {
-		des := desMapping[addr]
-
-		if uint64(len(des)) > data.Params.MaxDESize {
-			panic(fmt.Sprintf("DEs of %s size exceeds MaxDESize", addr))
+		desByCurve := make(map[types.Curve][]types.DE)
+		for _, de := range desMapping[addr] {
+			desByCurve[de.Curve] = append(desByCurve[de.Curve], de)
 		}
 
 		acc := sdk.MustAccAddressFromBech32(addr)
-		deQueue := types.NewDEQueue(0, uint64(len(des)))
-		k.SetDEQueue(ctx, acc, deQueue)
+		for _, curve := range types.Curves() {
+			des := desByCurve[curve]
+			if len(des) == 0 {
+				continue
+			}
+
+			if uint64(len(des)) > data.Params.MaxDESize {
+				panic(fmt.Sprintf("DEs of %s size exceeds MaxDESize", addr))
+			}
+
+			deQueue := types.NewDEQueue(0, uint64(len(des)))
+			k.SetDEQueue(ctx, curve, acc, deQueue)
 
-		for i, de := range des {
-			k.SetDE(ctx, acc, uint64(i), de)
+			for i, de := range des {
+				k.SetDE(ctx, curve, acc, uint64(i), de)
+			}
 		}
 	}
 }
@@ -59,10 +69,19 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 }
 
-// GetDEsGenesis retrieves all DE from the context's KVStore.
+// GetDEsGenesis retrieves all DE of every curve from the context's KVStore.
 func (k Keeper) GetDEsGenesis(ctx sdk.Context) []types.DEGenesis {
 	var des []types.DEGenesis
-	iterator := k.GetDEQueueIterator(ctx)
+	for _, curve := range types.Curves() {
+		des = append(des, k.getDEsGenesisByCurve(ctx, curve)...)
+	}
+	return des
+}
+
+// getDEsGenesisByCurve retrieves all DE on the given curve from the context's KVStore.
+func (k Keeper) getDEsGenesisByCurve(ctx sdk.Context, curve types.Curve) []types.DEGenesis {
+	var des []types.DEGenesis
+	iterator := k.GetDEQueueIterator(ctx, curve)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
@@ -71,7 +90,7 @@ func (k Keeper) GetDEsGenesis(ctx sdk.Context) []types.DEGenesis {
 		k.cdc.MustUnmarshal(iterator.Value(), &deQueue)
 
 		for i := deQueue.Head; i < deQueue.Tail; i++ {
-			de, err := k.GetDE(ctx, address, i)
+			de, err := k.GetDE(ctx, curve, address, i)
 			if err != nil {
 				panic(err)
 			}
@@ -82,10 +82,10 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	})
 	s.Require().Equal(data.DEs, exported.DEs)
 
-	s.Require().Equal(types.DEQueue{Head: 0, Tail: 2}, k.GetDEQueue(ctx, addr1))
+	s.Require().Equal(types.DEQueue{Head: 0, Tail: 2}, k.GetDEQueue(ctx, types.CURVE_SECP256K1, addr1))
 	existingDEs := []types.DE{}
 	for i := 0; i < 2; i++ {
-		de, err := k.GetDE(ctx, addr1, uint64(i))
+		de, err := k.GetDE(ctx, types.CURVE_SECP256K1, addr1, uint64(i))
 		s.Require().NoError(err)
 		existingDEs = append(existingDEs, de)
 	}
@@ -100,7 +100,7 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 		},
 	})
 
-	k.SetDEQueue(ctx, addr1, types.DEQueue{Head: 1, Tail: 2})
+	k.SetDEQueue(ctx, types.CURVE_SECP256K1, addr1, types.DEQueue{Head: 1, Tail: 2})
 
 	exported = k.ExportGenesis(ctx)
 	s.Require().Len(exported.DEs, 2)
@@ -126,7 +126,7 @@ func (q queryServer) DE(goCtx context.Context, req *types.QueryDERequest) (*type
 	}
 
 	var des []types.DE
-	deStore := prefix.NewStore(ctx.KVStore(q.k.storeKey), types.DEsStoreKey(accAddress))
+	deStore := prefix.NewStore(ctx.KVStore(q.k.storeKey), types.DEsStoreKey(req.Curve, accAddress))
 	pageRes, err := query.Paginate(deStore, req.Pagination, func(key []byte, value []byte) error {
 		var de types.DE
 		if err := q.k.cdc.Unmarshal(value, &de); err != nil {
@@ -250,6 +250,30 @@ func (q queryServer) Signing(
 	}, nil
 }
 
+// BatchProof queries the merkle proof of a batched signing and the result of its batch signing.
+func (q queryServer) BatchProof(
+	goCtx context.Context,
+	req *types.QueryBatchProofRequest,
+) (*types.QueryBatchProofResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signingID := tss.SigningID(req.SigningId)
+
+	proof, err := q.k.GetBatchItemProof(ctx, signingID)
+	if err != nil {
+		return nil, err
+	}
+
+	batchSigningResult, err := q.k.GetSigningResult(ctx, proof.BatchSigningID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBatchProofResponse{
+		Proof:              proof,
+		BatchSigningResult: *batchSigningResult,
+	}, nil
+}
+
 // Signings queries all signings.
 func (q queryServer) Signings(
 	goCtx context.Context,
@@ -61,7 +61,7 @@ func (s *KeeperTestSuite) TestGRPCQueryGroup() {
 		s.Require().NoError(err)
 	}
 
-	groupID, err := k.CreateGroup(ctx, memberAddrs, 3, "bandtss")
+	groupID, err := k.CreateGroup(ctx, memberAddrs, 3, "bandtss", types.CURVE_SECP256K1)
 	s.Require().NoError(err)
 
 	// Add round 1 infos
@@ -294,7 +294,7 @@ func (s *KeeperTestSuite) TestGRPCQueryDE() {
 	})
 	s.Require().NoError(err)
 
-	de, err := k.DequeueDE(ctx, acc1)
+	de, err := k.DequeueDE(ctx, types.CURVE_SECP256K1, acc1)
 	s.Require().NoError(err)
 	s.Require().Equal(types.DE{PubD: tss.Point("pubD1"), PubE: tss.Point("pubE1")}, de)
 
@@ -395,7 +395,7 @@ func (s *KeeperTestSuite) TestGRPCQueryPendingGroups() {
 		memberAddrs = append(memberAddrs, address)
 	}
 
-	groupID, err := k.CreateGroup(ctx, memberAddrs, 2, "bandtss")
+	groupID, err := k.CreateGroup(ctx, memberAddrs, 2, "bandtss", types.CURVE_SECP256K1)
 	s.Require().NoError(err)
 
 	testCases := []struct {
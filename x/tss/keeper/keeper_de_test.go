@@ -12,9 +12,9 @@ func (s *KeeperTestSuite) TestGetSetDEQueue() {
 	address := "band1m5lq9u533qaya4q3nfyl6ulzqkpkhge9q8tpzs"
 	accAddress := sdk.MustAccAddressFromBech32(address)
 
-	k.SetDEQueue(ctx, accAddress, types.DEQueue{Head: 1, Tail: 10})
+	k.SetDEQueue(ctx, types.CURVE_SECP256K1, accAddress, types.DEQueue{Head: 1, Tail: 10})
 
-	got := k.GetDEQueue(ctx, accAddress)
+	got := k.GetDEQueue(ctx, types.CURVE_SECP256K1, accAddress)
 	s.Require().Equal(types.DEQueue{Head: 1, Tail: 10}, got)
 }
 
@@ -26,9 +26,9 @@ func (s *KeeperTestSuite) TestGetSetDE() {
 		PubD: []byte("D"),
 		PubE: []byte("E"),
 	}
-	k.SetDE(ctx, address, 1, de)
+	k.SetDE(ctx, types.CURVE_SECP256K1, address, 1, de)
 
-	got, err := k.GetDE(ctx, address, 1)
+	got, err := k.GetDE(ctx, types.CURVE_SECP256K1, address, 1)
 	s.Require().NoError(err)
 	s.Require().Equal(de, got)
 }
@@ -53,13 +53,13 @@ func (s *KeeperTestSuite) TestHandleSetDEs() {
 	s.Require().NoError(err)
 
 	// Get DEQueue
-	cnt := k.GetDEQueue(ctx, address)
+	cnt := k.GetDEQueue(ctx, types.CURVE_SECP256K1, address)
 	s.Require().Equal(types.DEQueue{Head: 0, Tail: 2}, cnt)
 
 	// Check that all DEs have been stored correctly
 	existingDEs := []types.DE{}
 	for i := 0; i < len(des); i++ {
-		de, err := k.GetDE(ctx, address, uint64(i))
+		de, err := k.GetDE(ctx, types.CURVE_SECP256K1, address, uint64(i))
 		s.Require().NoError(err)
 		existingDEs = append(existingDEs, de)
 	}
@@ -83,14 +83,14 @@ func (s *KeeperTestSuite) TestPollDE() {
 	s.Require().NoError(err)
 
 	// Poll DE
-	polledDE, err := k.DequeueDE(ctx, address)
+	polledDE, err := k.DequeueDE(ctx, types.CURVE_SECP256K1, address)
 	s.Require().NoError(err)
 
 	// Ensure polled DE is equal to original DE
 	s.Require().Equal(des[0], polledDE)
 
 	// Attempt to get deleted DE
-	got, err := k.DequeueDE(ctx, address)
+	got, err := k.DequeueDE(ctx, types.CURVE_SECP256K1, address)
 
 	// Should return error
 	s.Require().ErrorIs(types.ErrDENotFound, err)
@@ -131,7 +131,7 @@ func (s *KeeperTestSuite) TestHandlePollDEForAssignedMembers() {
 		s.Require().NoError(err)
 	}
 
-	des, err := k.DequeueDEs(ctx, members)
+	des, err := k.DequeueDEs(ctx, types.CURVE_SECP256K1, members)
 	s.Require().NoError(err)
 	s.Require().Equal([]types.DE{
 		{
@@ -163,7 +163,7 @@ func (s *KeeperTestSuite) TestResetDE() {
 	// Set DE
 	err := k.EnqueueDEs(ctx, address, des)
 	s.Require().NoError(err)
-	deQueue := k.GetDEQueue(ctx, address)
+	deQueue := k.GetDEQueue(ctx, types.CURVE_SECP256K1, address)
 	s.Require().Equal(types.DEQueue{Head: 0, Tail: 2}, deQueue)
 
 	// Reset DE
@@ -171,10 +171,10 @@ func (s *KeeperTestSuite) TestResetDE() {
 	s.Require().NoError(err)
 
 	// Ensure DEQueue is reset
-	deQueue = k.GetDEQueue(ctx, address)
+	deQueue = k.GetDEQueue(ctx, types.CURVE_SECP256K1, address)
 	s.Require().Equal(types.DEQueue{Head: 0, Tail: 0}, deQueue)
 
 	// Attempt to get deleted DE; should return error
-	_, err = k.DequeueDE(ctx, address)
+	_, err = k.DequeueDE(ctx, types.CURVE_SECP256K1, address)
 	s.Require().ErrorIs(types.ErrDENotFound, err)
 }
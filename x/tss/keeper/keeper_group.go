@@ -20,6 +20,7 @@ func (k Keeper) AddGroup(
 	size uint64,
 	threshold uint64,
 	moduleOwner string,
+	curve types.Curve,
 ) tss.GroupID {
 	group := types.NewGroup(
 		tss.GroupID(k.GetGroupCount(ctx)+1),
@@ -29,6 +30,7 @@ func (k Keeper) AddGroup(
 		types.GROUP_STATUS_ROUND_1,
 		uint64(ctx.BlockHeight()),
 		moduleOwner,
+		curve,
 	)
 
 	k.SetGroup(ctx, group)
@@ -37,12 +39,79 @@ func (k Keeper) AddGroup(
 	return group.ID
 }
 
-// CreateGroup creates a new group with the given members and threshold.
+// CreateGroup creates a new group with the given members and threshold on the given curve.
 func (k Keeper) CreateGroup(
 	ctx sdk.Context,
 	members []sdk.AccAddress,
 	threshold uint64,
 	moduleOwner string,
+	curve types.Curve,
+) (tss.GroupID, error) {
+	if err := curve.Validate(); err != nil {
+		return 0, types.ErrGroupCreationFailed.Wrap(err.Error())
+	}
+
+	return k.createGroup(ctx, members, threshold, moduleOwner, curve, 0, nil)
+}
+
+// CreateReshareGroup creates a new group with the given members and threshold that reshares the secret of
+// the given active source group. The members that are active members of the source group re-deal their
+// shares, so the new group has the same public key and curve as the source group.
+func (k Keeper) CreateReshareGroup(
+	ctx sdk.Context,
+	sourceGroupID tss.GroupID,
+	members []sdk.AccAddress,
+	threshold uint64,
+	moduleOwner string,
+) (tss.GroupID, error) {
+	sourceGroup, err := k.GetGroup(ctx, sourceGroupID)
+	if err != nil {
+		return 0, err
+	}
+	if sourceGroup.Status != types.GROUP_STATUS_ACTIVE {
+		return 0, types.ErrGroupCreationFailed.Wrapf("source group ID %d is not active", sourceGroupID)
+	}
+
+	// Find the member IDs in the source group of the members that can deal.
+	sourceMembers, err := k.GetGroupMembers(ctx, sourceGroupID)
+	if err != nil {
+		return 0, err
+	}
+
+	sourceMemberIDs := make(map[string]tss.MemberID)
+	for _, m := range sourceMembers {
+		if m.IsActive && !m.IsMalicious {
+			sourceMemberIDs[m.Address] = m.ID
+		}
+	}
+
+	dealerCount := uint64(0)
+	for _, addr := range members {
+		if _, ok := sourceMemberIDs[addr.String()]; ok {
+			dealerCount++
+		}
+	}
+	if dealerCount < sourceGroup.Threshold {
+		return 0, types.ErrGroupCreationFailed.Wrapf(
+			"the number of dealers (%d) is less than the threshold of the source group (%d)",
+			dealerCount,
+			sourceGroup.Threshold,
+		)
+	}
+
+	return k.createGroup(ctx, members, threshold, moduleOwner, sourceGroup.Curve, sourceGroupID, sourceMemberIDs)
+}
+
+// createGroup creates a new group with the given members and threshold. If the source group ID is given,
+// the members in the given source member IDs re-deal their shares of the source group.
+func (k Keeper) createGroup(
+	ctx sdk.Context,
+	members []sdk.AccAddress,
+	threshold uint64,
+	moduleOwner string,
+	curve types.Curve,
+	sourceGroupID tss.GroupID,
+	sourceMemberIDs map[string]tss.MemberID,
 ) (tss.GroupID, error) {
 	// Validate group size
 	groupSize := uint64(len(members))
@@ -68,11 +137,17 @@ func (k Keeper) CreateGroup(
 	}
 
 	// add new group
-	groupID := k.AddGroup(ctx, groupSize, threshold, moduleOwner)
+	groupID := k.AddGroup(ctx, groupSize, threshold, moduleOwner, curve)
+	if sourceGroupID != 0 {
+		group := k.MustGetGroup(ctx, groupID)
+		group.SourceGroupID = sourceGroupID
+		k.SetGroup(ctx, group)
+	}
 
 	// Set members; ID starts from 1
 	for i, addr := range members {
 		m := types.NewMember(tss.MemberID(i+1), groupID, addr, nil, false, true)
+		m.SourceMemberID = sourceMemberIDs[addr.String()]
 		k.SetMember(ctx, m)
 	}
 
@@ -94,7 +169,13 @@ func (k Keeper) CreateGroup(
 		sdk.NewAttribute(types.AttributeKeyStatus, types.GROUP_STATUS_ROUND_1.String()),
 		sdk.NewAttribute(types.AttributeKeyDKGContext, hex.EncodeToString(dkgContext)),
 		sdk.NewAttribute(types.AttributeKeyModuleOwner, moduleOwner),
+		sdk.NewAttribute(types.AttributeKeyCurve, curve.String()),
 	)
+	if sourceGroupID != 0 {
+		event = event.AppendAttributes(
+			sdk.NewAttribute(types.AttributeKeySourceGroupID, fmt.Sprintf("%d", sourceGroupID)),
+		)
+	}
 	for _, m := range members {
 		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyAddress, m.String()))
 	}
@@ -69,7 +69,7 @@ func (s *KeeperTestSuite) TestProcessExpiredGroups() {
 	s.Require().Equal(tss.GroupID(0), lastExpiredGroupID)
 
 	// Create group
-	groupID := k.AddGroup(ctx, 3, 2, "test")
+	groupID := k.AddGroup(ctx, 3, 2, "test", types.CURVE_SECP256K1)
 	k.SetMember(ctx, types.Member{
 		ID:          1,
 		GroupID:     groupID,
@@ -104,11 +104,11 @@ func (s *KeeperTestSuite) TestProcessExpiredOnlyFirstGroups() {
 	ctx, k := s.ctx, s.keeper
 
 	// Create a group
-	_ = k.AddGroup(ctx, 3, 2, "test")
+	_ = k.AddGroup(ctx, 3, 2, "test", types.CURVE_SECP256K1)
 
 	// skip to some height and create a new group
 	ctx = ctx.WithBlockHeight(int64(types.DefaultCreationPeriod) / 2)
-	_ = k.AddGroup(ctx, 3, 2, "test")
+	_ = k.AddGroup(ctx, 3, 2, "test", types.CURVE_SECP256K1)
 
 	// Set the current block height at which the first group is expired
 	ctx = ctx.WithBlockHeight(int64(types.DefaultCreationPeriod) + 1)
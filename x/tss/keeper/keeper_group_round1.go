@@ -14,23 +14,23 @@ import (
 // AddCoefficientCommits adds each coefficient commit into the accumulated commit of its index.
 func (k Keeper) AddCoefficientCommits(
 	ctx sdk.Context,
-	groupID tss.GroupID,
+	group types.Group,
 	coefficientCommits tss.Points,
 ) error {
 	for i, commit := range coefficientCommits {
 		points := []tss.Point{commit}
 
-		accCommit := k.GetAccumulatedCommit(ctx, groupID, uint64(i))
+		accCommit := k.GetAccumulatedCommit(ctx, group.ID, uint64(i))
 		if accCommit != nil {
 			points = append(points, accCommit)
 		}
 
-		total, err := tss.SumPoints(points...)
+		total, err := group.Curve.Scheme().SumPoints(points...)
 		if err != nil {
 			return types.ErrAddCoeffCommit.Wrapf("failed to sum points: %s", err)
 		}
 
-		k.SetAccumulatedCommit(ctx, groupID, uint64(i), total)
+		k.SetAccumulatedCommit(ctx, group.ID, uint64(i), total)
 	}
 
 	return nil
@@ -42,11 +42,29 @@ func (k Keeper) ValidateRound1Info(
 	group types.Group,
 	round1Info types.Round1Info,
 ) error {
-	// Check coefficients commit length
-	if uint64(len(round1Info.CoefficientCommits)) != group.Threshold {
+	member, err := k.GetMember(ctx, group.ID, round1Info.MemberID)
+	if err != nil {
+		return err
+	}
+
+	// Check coefficients commit length; a member that does not deal has no coefficients.
+	isDealer := group.IsDealer(member)
+	expectedLength := uint64(0)
+	if isDealer {
+		expectedLength = group.Threshold
+	}
+	if uint64(len(round1Info.CoefficientCommits)) != expectedLength {
 		return types.ErrInvalidLengthCoeffCommits
 	}
 
+	// Check that coefficient commits are on the curve of the group
+	scheme := group.Curve.Scheme()
+	for _, commit := range round1Info.CoefficientCommits {
+		if err := scheme.ValidatePoint(commit); err != nil {
+			return types.ErrInvalidCoefficientCommit.Wrapf("invalid coefficient commit: %v", err)
+		}
+	}
+
 	// Get dkg-context
 	dkgContext, err := k.GetDKGContext(ctx, group.ID)
 	if err != nil {
@@ -64,8 +82,12 @@ func (k Keeper) ValidateRound1Info(
 		return types.ErrVerifyOneTimeSignatureFailed.Wrapf("failed to verify one time signature: %v", err)
 	}
 
+	if !isDealer {
+		return nil
+	}
+
 	// Verify A0 signature
-	err = tss.VerifyA0Signature(
+	err = scheme.VerifyA0Signature(
 		round1Info.MemberID,
 		dkgContext,
 		round1Info.A0Signature,
@@ -75,6 +97,47 @@ func (k Keeper) ValidateRound1Info(
 		return types.ErrVerifyA0SignatureFailed.Wrapf("failed to verify A0 signature: %v", err)
 	}
 
+	// Verify that a dealer in a reshared group deals its share of the source group secret
+	if group.SourceGroupID != 0 {
+		return k.VerifyReshareCommit(ctx, group, member, round1Info.CoefficientCommits[0])
+	}
+
+	return nil
+}
+
+// VerifyReshareCommit verifies that the A0 commit of a dealer in a reshared group commits to its share of
+// the source group secret weighted by its Lagrange coefficient over all dealers, so the accumulated A0
+// commit of the group equals the public key of the source group.
+func (k Keeper) VerifyReshareCommit(
+	ctx sdk.Context,
+	group types.Group,
+	dealer types.Member,
+	a0Commit tss.Point,
+) error {
+	members, err := k.GetGroupMembers(ctx, group.ID)
+	if err != nil {
+		return err
+	}
+
+	sourceMember, err := k.GetMember(ctx, group.SourceGroupID, dealer.SourceMemberID)
+	if err != nil {
+		return err
+	}
+
+	err = group.Curve.Scheme().VerifyReshareCommit(
+		dealer.SourceMemberID,
+		types.Members(members).GetSourceIDs(),
+		sourceMember.PubKey,
+		a0Commit,
+	)
+	if err != nil {
+		return types.ErrInvalidReshareCommit.Wrapf(
+			"failed to verify reshare commit of memberID %d: %v",
+			dealer.ID,
+			err,
+		)
+	}
+
 	return nil
 }
 
@@ -71,6 +71,11 @@ func (k Keeper) VerifyComplaint(
 	groupID tss.GroupID,
 	complaint types.Complaint,
 ) error {
+	group, err := k.GetGroup(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
 	// Get round1Info from member Complainant
 	round1Complainant, err := k.GetRound1Info(ctx, groupID, complaint.Complainant)
 	if err != nil {
@@ -102,7 +107,7 @@ func (k Keeper) VerifyComplaint(
 	}
 
 	// Verify the complaint signature
-	err = tss.VerifyComplaint(
+	err = group.Curve.Scheme().VerifyComplaint(
 		round1Complainant.OneTimePubKey,
 		round1Respondent.OneTimePubKey,
 		complaint.KeySym,
@@ -131,6 +136,11 @@ func (k Keeper) VerifyOwnPubKeySignature(
 	memberID tss.MemberID,
 	ownPubKeySig tss.Signature,
 ) error {
+	group, err := k.GetGroup(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
 	// Get member public key
 	member, err := k.GetMember(ctx, groupID, memberID)
 	if err != nil {
@@ -144,7 +154,7 @@ func (k Keeper) VerifyOwnPubKeySignature(
 	}
 
 	// Verify own public key sig
-	err = tss.VerifyOwnPubKeySignature(memberID, dkgContext, ownPubKeySig, member.PubKey)
+	err = group.Curve.Scheme().VerifyOwnPubKeySignature(memberID, dkgContext, ownPubKeySig, member.PubKey)
 	if err != nil {
 		return types.ErrConfirmFailed.Wrapf(
 			"failed to verify own public key with memberID: %d; %v",
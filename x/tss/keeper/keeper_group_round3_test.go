@@ -13,6 +13,15 @@ func (s *KeeperTestSuite) TestHandleVerifyComplain() {
 
 	for _, tc := range testutil.TestCases {
 		s.Run(fmt.Sprintf("Case %s", tc.Name), func() {
+			// Set group
+			k.SetGroup(ctx, types.Group{
+				ID:        tc.Group.ID,
+				Size_:     uint64(len(tc.Group.Members)),
+				Threshold: tc.Group.Threshold,
+				Status:    types.GROUP_STATUS_ROUND_3,
+				Curve:     types.CURVE_SECP256K1,
+			})
+
 			for _, m := range tc.Group.Members {
 				// Set member
 				k.SetMember(ctx, types.Member{
@@ -77,6 +86,15 @@ func (s *KeeperTestSuite) TestHandleVerifyOwnPubKeySig() {
 	ctx, k := s.ctx, s.keeper
 
 	for _, tc := range testutil.TestCases {
+		// Set group
+		k.SetGroup(ctx, types.Group{
+			ID:        tc.Group.ID,
+			Size_:     uint64(len(tc.Group.Members)),
+			Threshold: tc.Group.Threshold,
+			Status:    types.GROUP_STATUS_ROUND_3,
+			Curve:     types.CURVE_SECP256K1,
+		})
+
 		// Set dkg context
 		k.SetDKGContext(ctx, tc.Group.ID, tc.Group.DKGContext)
 
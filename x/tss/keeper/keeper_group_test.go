@@ -1,13 +1,17 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/pkg/tss/testutil"
 	"github.com/bandprotocol/chain/v3/x/tss/types"
 )
 
 func (s *KeeperTestSuite) TestGetSetGroupCount() {
 	ctx, k := s.ctx, s.keeper
-	k.AddGroup(ctx, 3, 2, "test")
-	k.AddGroup(ctx, 4, 3, "test")
+	k.AddGroup(ctx, 3, 2, "test", types.CURVE_SECP256K1)
+	k.AddGroup(ctx, 4, 3, "test", types.CURVE_SECP256K1)
 
 	groupCount := k.GetGroupCount(ctx)
 	s.Require().Equal(uint64(2), groupCount)
@@ -54,7 +58,7 @@ func (s *KeeperTestSuite) TestCreateNewGroup() {
 	}
 
 	// Create new group
-	groupID := k.AddGroup(ctx, group.Size_, group.Threshold, group.ModuleOwner)
+	groupID := k.AddGroup(ctx, group.Size_, group.Threshold, group.ModuleOwner, types.CURVE_SECP256K1)
 
 	// init group ID
 	group.ID = groupID
@@ -76,7 +80,7 @@ func (s *KeeperTestSuite) TestSetGroup() {
 	}
 
 	// Set new group
-	groupID := k.AddGroup(ctx, group.Size_, group.Threshold, group.ModuleOwner)
+	groupID := k.AddGroup(ctx, group.Size_, group.Threshold, group.ModuleOwner, types.CURVE_SECP256K1)
 
 	// Update group size value
 	group.Size_ = 6
@@ -93,3 +97,44 @@ func (s *KeeperTestSuite) TestSetGroup() {
 	s.Require().NoError(err)
 	s.Require().Equal(group.Size_, got.Size_)
 }
+
+func (s *KeeperTestSuite) TestCreateReshareGroup() {
+	ctx, k := s.ctx, s.keeper
+	tc := testutil.TestCases[0]
+	newMember := sdk.AccAddress([]byte("new_member"))
+
+	// The source group is not active.
+	s.SetupWithPreparedTestCase(0, types.GROUP_STATUS_ROUND_1)
+	sourceMembers := []sdk.AccAddress{
+		sdk.AccAddress(tc.Group.Members[0].PubKey()),
+		sdk.AccAddress(tc.Group.Members[1].PubKey()),
+	}
+	_, err := k.CreateReshareGroup(ctx, tc.Group.ID, append(sourceMembers, newMember), 2, "test")
+	s.Require().ErrorIs(err, types.ErrGroupCreationFailed)
+
+	// The source group does not exist.
+	_, err = k.CreateReshareGroup(ctx, 100, append(sourceMembers, newMember), 2, "test")
+	s.Require().ErrorIs(err, types.ErrGroupNotFound)
+
+	group := k.MustGetGroup(ctx, tc.Group.ID)
+	group.Status = types.GROUP_STATUS_ACTIVE
+	k.SetGroup(ctx, group)
+
+	// The number of dealers is less than the threshold of the source group.
+	_, err = k.CreateReshareGroup(ctx, tc.Group.ID, []sdk.AccAddress{sourceMembers[0], newMember}, 2, "test")
+	s.Require().ErrorIs(err, types.ErrGroupCreationFailed)
+
+	// Success case
+	groupID, err := k.CreateReshareGroup(ctx, tc.Group.ID, append(sourceMembers, newMember), 2, "test")
+	s.Require().NoError(err)
+
+	got := k.MustGetGroup(ctx, groupID)
+	s.Require().Equal(tc.Group.ID, got.SourceGroupID)
+
+	members, err := k.GetGroupMembers(ctx, groupID)
+	s.Require().NoError(err)
+	s.Require().Equal([]tss.MemberID{1, 2}, types.Members(members).GetSourceIDs())
+	s.Require().True(got.IsDealer(members[0]))
+	s.Require().True(got.IsDealer(members[1]))
+	s.Require().False(got.IsDealer(members[2]))
+}
@@ -37,9 +37,10 @@ func (k Keeper) GetMemberByAddress(
 	)
 }
 
-// GetAvailableMembers retrieves all members in the given group that are active and have an existing DE.
-func (k Keeper) GetAvailableMembers(ctx sdk.Context, groupID tss.GroupID) []types.Member {
-	iterator := k.GetGroupMembersIterator(ctx, groupID)
+// GetAvailableMembers retrieves all members in the given group that are active and have an existing DE
+// on the curve of the group.
+func (k Keeper) GetAvailableMembers(ctx sdk.Context, group types.Group) []types.Member {
+	iterator := k.GetGroupMembersIterator(ctx, group.ID)
 	defer iterator.Close()
 
 	var availableMembers []types.Member
@@ -52,7 +53,7 @@ func (k Keeper) GetAvailableMembers(ctx sdk.Context, groupID tss.GroupID) []type
 		}
 
 		acc := sdk.MustAccAddressFromBech32(member.Address)
-		if !k.HasDE(ctx, acc) {
+		if !k.HasDE(ctx, group.Curve, acc) {
 			continue
 		}
 
@@ -76,7 +77,7 @@ func (k Keeper) GetRandomMembers(
 	}
 
 	// Get available members
-	members := k.GetAvailableMembers(ctx, groupID)
+	members := k.GetAvailableMembers(ctx, group)
 	members_size := uint64(len(members))
 	if group.Threshold > members_size {
 		return nil, types.ErrInsufficientSigners.Wrapf(
@@ -141,16 +142,16 @@ func (k Keeper) ValidateMemberID(
 }
 
 // UpdateMemberPubKey computes own public key and set it to the member.
-func (k Keeper) UpdateMemberPubKey(ctx sdk.Context, groupID tss.GroupID, memberID tss.MemberID) error {
+func (k Keeper) UpdateMemberPubKey(ctx sdk.Context, group types.Group, memberID tss.MemberID) error {
 	// Compute own public key
-	accCommits := k.GetAllAccumulatedCommits(ctx, groupID)
-	ownPubKey, err := tss.ComputeOwnPublicKey(accCommits, memberID)
+	accCommits := k.GetAllAccumulatedCommits(ctx, group.ID)
+	ownPubKey, err := group.Curve.Scheme().ComputeOwnPublicKey(accCommits, memberID)
 	if err != nil {
 		return types.ErrComputeOwnPubKeyFailed.Wrapf("compute own public key failed; %s", err)
 	}
 
 	// set own public key to member
-	member, err := k.GetMember(ctx, groupID, memberID)
+	member, err := k.GetMember(ctx, group.ID, memberID)
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,27 @@ func (k Keeper) RequestSigning(
 	groupID tss.GroupID,
 	originator types.Originator,
 	content types.Content,
+) (tss.SigningID, error) {
+	signingID, err := k.createSigningFromContent(ctx, groupID, originator, content)
+	if err != nil {
+		return 0, err
+	}
+
+	// initiate new signing round
+	if err = k.InitiateNewSigningRound(ctx, signingID); err != nil {
+		return 0, err
+	}
+
+	return signingID, nil
+}
+
+// createSigningFromContent converts the given content and originator into a message and creates
+// a signing object of the message without initiating a signing round.
+func (k Keeper) createSigningFromContent(
+	ctx sdk.Context,
+	groupID tss.GroupID,
+	originator types.Originator,
+	content types.Content,
 ) (tss.SigningID, error) {
 	// validate content
 	if err := content.ValidateBasic(); err != nil {
@@ -62,11 +83,6 @@ func (k Keeper) RequestSigning(
 		sdk.NewAttribute(types.AttributeKeyMessage, hex.EncodeToString(contentMsg)),
 	))
 
-	// initiate new signing round
-	if err = k.InitiateNewSigningRound(ctx, signingID); err != nil {
-		return 0, err
-	}
-
 	return signingID, nil
 }
 
@@ -77,14 +93,20 @@ func (k Keeper) AssignMembersForSigning(
 	msg []byte,
 	nonce []byte,
 ) (types.AssignedMembers, error) {
+	group, err := k.GetGroup(ctx, groupID)
+	if err != nil {
+		return types.AssignedMembers{}, err
+	}
+	scheme := group.Curve.Scheme()
+
 	// Random assigning members
 	selectedMembers, err := k.GetRandomMembers(ctx, groupID, nonce)
 	if err != nil {
 		return types.AssignedMembers{}, err
 	}
 
-	// Handle assigned members by polling DE and retrieve assigned members information.
-	des, err := k.DequeueDEs(ctx, selectedMembers)
+	// Handle assigned members by polling DE on the curve of the group and retrieve assigned members information.
+	des, err := k.DequeueDEs(ctx, group.Curve, selectedMembers)
 	if err != nil {
 		return types.AssignedMembers{}, err
 	}
@@ -110,7 +132,7 @@ func (k Keeper) AssignMembersForSigning(
 	// Compute binding factor and public nonce of each assigned member
 	for i, member := range assignedMembers {
 		// Compute binding factor
-		assignedMembers[i].BindingFactor, err = tss.ComputeOwnBindingFactor(member.MemberID, msg, commitment)
+		assignedMembers[i].BindingFactor, err = scheme.ComputeOwnBindingFactor(member.MemberID, msg, commitment)
 		if err != nil {
 			return types.AssignedMembers{}, types.ErrCreateSigningFailed.Wrapf(
 				"failed to compute binding factor: %v", err,
@@ -118,7 +140,7 @@ func (k Keeper) AssignMembersForSigning(
 		}
 
 		// Compute own public nonce
-		assignedMembers[i].PubNonce, err = tss.ComputeOwnPubNonce(
+		assignedMembers[i].PubNonce, err = scheme.ComputeOwnPubNonce(
 			member.PubD,
 			member.PubE,
 			assignedMembers[i].BindingFactor,
@@ -196,8 +218,13 @@ func (k Keeper) InitiateNewSigningRound(ctx sdk.Context, signingID tss.SigningID
 		return err
 	}
 
+	group, err := k.GetGroup(ctx, signing.GroupID)
+	if err != nil {
+		return err
+	}
+
 	// Compute group public nonce for this signing
-	groupPubNonce, err := tss.ComputeGroupPublicNonce(assignedMembers.PubNonces()...)
+	groupPubNonce, err := group.Curve.Scheme().ComputeGroupPublicNonce(assignedMembers.PubNonces()...)
 	if err != nil {
 		return types.ErrCreateSigningFailed.Wrapf("failed to compute group public nonce: %v", err)
 	}
@@ -219,6 +246,7 @@ func (k Keeper) InitiateNewSigningRound(ctx sdk.Context, signingID tss.SigningID
 	event := sdk.NewEvent(
 		types.EventTypeRequestSignature,
 		sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", signing.GroupID)),
+		sdk.NewAttribute(types.AttributeKeyCurve, group.Curve.String()),
 		sdk.NewAttribute(types.AttributeKeySigningID, fmt.Sprintf("%d", signing.ID)),
 		sdk.NewAttribute(types.AttributeKeyGroupPubNonce, hex.EncodeToString(signing.GroupPubNonce)),
 		sdk.NewAttribute(types.AttributeKeyAttempt, fmt.Sprintf("%d", signing.CurrentAttempt)),
@@ -0,0 +1,229 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/x/tss/types"
+)
+
+// RequestBatchSigning creates a signing request on the given content from the specific groupID
+// and adds it into the pending batch of the group. Signings in the batch are signed together
+// over a merkle root of their messages once the batch signing window ends. If batching is
+// disabled, the signing request is created as in RequestSigning.
+func (k Keeper) RequestBatchSigning(
+	ctx sdk.Context,
+	groupID tss.GroupID,
+	originator types.Originator,
+	content types.Content,
+) (tss.SigningID, error) {
+	if k.GetParams(ctx).BatchSigningWindow == 0 {
+		return k.RequestSigning(ctx, groupID, originator, content)
+	}
+
+	signingID, err := k.createSigningFromContent(ctx, groupID, originator, content)
+	if err != nil {
+		return 0, err
+	}
+
+	pb, found := k.GetPendingBatch(ctx, groupID)
+	if !found {
+		pb = types.NewPendingBatch(groupID, uint64(ctx.BlockHeight()), nil)
+	}
+	pb.SigningIDs = append(pb.SigningIDs, signingID)
+	k.SetPendingBatch(ctx, pb)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAddBatchSigning,
+		sdk.NewAttribute(types.AttributeKeySigningID, fmt.Sprintf("%d", signingID)),
+		sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", groupID)),
+	))
+
+	return signingID, nil
+}
+
+// HandlePendingBatches creates a batch signing for every pending batch whose signing window
+// ends at this block. If the batch signing cannot be created, the signings in the batch are
+// regarded as failed.
+func (k Keeper) HandlePendingBatches(ctx sdk.Context) {
+	window := k.GetParams(ctx).BatchSigningWindow
+	for _, pb := range k.GetPendingBatches(ctx) {
+		// if batching is disabled, the remaining batches are signed immediately.
+		if window != 0 && uint64(ctx.BlockHeight())+1 < pb.StartHeight+window {
+			continue
+		}
+
+		cacheCtx, writeFn := ctx.CacheContext()
+		if _, err := k.CreateBatchSigning(cacheCtx, pb); err != nil {
+			for _, sid := range pb.SigningIDs {
+				k.HandleFailedSigning(ctx, sid, err.Error())
+			}
+		} else {
+			writeFn()
+		}
+
+		k.DeletePendingBatch(ctx, pb.GroupID)
+	}
+}
+
+// CreateBatchSigning creates a signing on the merkle root of the messages of the signings in
+// the given batch, stores a merkle proof of every signing and initiates the signing round.
+func (k Keeper) CreateBatchSigning(ctx sdk.Context, pb types.PendingBatch) (tss.SigningID, error) {
+	leaves := make([][]byte, 0, len(pb.SigningIDs))
+	for _, sid := range pb.SigningIDs {
+		signing := k.MustGetSigning(ctx, sid)
+		leaves = append(leaves, tss.HashMerkleLeaf(signing.Message))
+	}
+
+	root, err := tss.ComputeMerkleRoot(leaves)
+	if err != nil {
+		return 0, types.ErrCreateBatchSigningFailed.Wrapf("failed to compute merkle root: %v", err)
+	}
+
+	batchSigningID, err := k.CreateSigning(ctx, pb.GroupID, nil, types.EncodeBatchContent(root))
+	if err != nil {
+		return 0, err
+	}
+
+	for i, sid := range pb.SigningIDs {
+		proof, err := tss.ComputeMerkleProof(leaves, i)
+		if err != nil {
+			return 0, types.ErrCreateBatchSigningFailed.Wrapf("failed to compute merkle proof: %v", err)
+		}
+		k.SetBatchItemProof(ctx, types.NewBatchItemProof(sid, batchSigningID, leaves[i], proof, root))
+
+		signing := k.MustGetSigning(ctx, sid)
+		signing.BatchSigningID = batchSigningID
+		k.SetSigning(ctx, signing)
+	}
+	k.SetBatchSigning(ctx, types.NewBatchSigning(batchSigningID, root, pb.SigningIDs))
+
+	event := sdk.NewEvent(
+		types.EventTypeCreateBatchSigning,
+		sdk.NewAttribute(types.AttributeKeyBatchSigningID, fmt.Sprintf("%d", batchSigningID)),
+		sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", pb.GroupID)),
+		sdk.NewAttribute(types.AttributeKeyMerkleRoot, hex.EncodeToString(root)),
+	)
+	for _, sid := range pb.SigningIDs {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeySigningID, fmt.Sprintf("%d", sid)))
+	}
+	ctx.EventManager().EmitEvent(event)
+
+	if err := k.InitiateNewSigningRound(ctx, batchSigningID); err != nil {
+		return 0, err
+	}
+
+	return batchSigningID, nil
+}
+
+// handleBatchItemsSuccess sets the status of the signings in the given batch to success.
+func (k Keeper) handleBatchItemsSuccess(ctx sdk.Context, batch types.BatchSigning) {
+	for _, sid := range batch.SigningIDs {
+		signing := k.MustGetSigning(ctx, sid)
+		signing.Status = types.SIGNING_STATUS_SUCCESS
+		k.SetSigning(ctx, signing)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSigningSuccess,
+				sdk.NewAttribute(types.AttributeKeySigningID, fmt.Sprintf("%d", sid)),
+				sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", signing.GroupID)),
+				sdk.NewAttribute(types.AttributeKeyBatchSigningID, fmt.Sprintf("%d", batch.ID)),
+			),
+		)
+	}
+}
+
+// =====================================
+// Pending batch store
+// =====================================
+
+// SetPendingBatch sets the pending batch of a group in the store.
+func (k Keeper) SetPendingBatch(ctx sdk.Context, pb types.PendingBatch) {
+	ctx.KVStore(k.storeKey).Set(types.PendingBatchStoreKey(pb.GroupID), k.cdc.MustMarshal(&pb))
+}
+
+// GetPendingBatch retrieves the pending batch of the given group from the store.
+func (k Keeper) GetPendingBatch(ctx sdk.Context, groupID tss.GroupID) (types.PendingBatch, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.PendingBatchStoreKey(groupID))
+	if bz == nil {
+		return types.PendingBatch{}, false
+	}
+
+	var pb types.PendingBatch
+	k.cdc.MustUnmarshal(bz, &pb)
+	return pb, true
+}
+
+// GetPendingBatchesIterator gets an iterator over all pending batches.
+func (k Keeper) GetPendingBatchesIterator(ctx sdk.Context) dbm.Iterator {
+	return storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PendingBatchStoreKeyPrefix)
+}
+
+// GetPendingBatches retrieves all pending batches from the store.
+func (k Keeper) GetPendingBatches(ctx sdk.Context) []types.PendingBatch {
+	iterator := k.GetPendingBatchesIterator(ctx)
+	defer iterator.Close()
+
+	var pbs []types.PendingBatch
+	for ; iterator.Valid(); iterator.Next() {
+		var pb types.PendingBatch
+		k.cdc.MustUnmarshal(iterator.Value(), &pb)
+		pbs = append(pbs, pb)
+	}
+	return pbs
+}
+
+// DeletePendingBatch deletes the pending batch of the given group from the store.
+func (k Keeper) DeletePendingBatch(ctx sdk.Context, groupID tss.GroupID) {
+	ctx.KVStore(k.storeKey).Delete(types.PendingBatchStoreKey(groupID))
+}
+
+// =====================================
+// Batch signing store
+// =====================================
+
+// SetBatchSigning sets the batch information of a batch signing in the store.
+func (k Keeper) SetBatchSigning(ctx sdk.Context, batch types.BatchSigning) {
+	ctx.KVStore(k.storeKey).Set(types.BatchSigningStoreKey(batch.ID), k.cdc.MustMarshal(&batch))
+}
+
+// GetBatchSigning retrieves the batch information of the given signing ID. It returns false if
+// the signing isn't a batch signing.
+func (k Keeper) GetBatchSigning(ctx sdk.Context, signingID tss.SigningID) (types.BatchSigning, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.BatchSigningStoreKey(signingID))
+	if bz == nil {
+		return types.BatchSigning{}, false
+	}
+
+	var batch types.BatchSigning
+	k.cdc.MustUnmarshal(bz, &batch)
+	return batch, true
+}
+
+// SetBatchItemProof sets the merkle proof of a batched signing in the store.
+func (k Keeper) SetBatchItemProof(ctx sdk.Context, proof types.BatchItemProof) {
+	ctx.KVStore(k.storeKey).Set(types.BatchItemProofStoreKey(proof.SigningID), k.cdc.MustMarshal(&proof))
+}
+
+// GetBatchItemProof retrieves the merkle proof of the given batched signing from the store.
+func (k Keeper) GetBatchItemProof(ctx sdk.Context, signingID tss.SigningID) (types.BatchItemProof, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.BatchItemProofStoreKey(signingID))
+	if bz == nil {
+		return types.BatchItemProof{}, types.ErrBatchItemProofNotFound.Wrapf(
+			"failed to get batch item proof with signing ID: %d",
+			signingID,
+		)
+	}
+
+	var proof types.BatchItemProof
+	k.cdc.MustUnmarshal(bz, &proof)
+	return proof, nil
+}
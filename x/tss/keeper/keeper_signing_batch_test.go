@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	tsstestutil "github.com/bandprotocol/chain/v3/x/tss/testutil"
+	"github.com/bandprotocol/chain/v3/x/tss/types"
+)
+
+func (s *KeeperTestSuite) TestRequestBatchSigningDisabled() {
+	ctx, k := s.ctx, s.keeper
+
+	groupCtx, err := tsstestutil.CompleteGroupCreation(ctx, k, 4, 2)
+	s.Require().NoError(err)
+
+	s.rollingseedKeeper.EXPECT().GetRollingSeed(gomock.Any()).
+		Return([]byte("RandomStringThatShouldBeLongEnough")).AnyTimes()
+
+	originator := types.NewDirectOriginator("targetChain", "band1m5lq9u533qaya4q3nfyl6ulzqkpkhge9q8tpzs", "test")
+
+	// batching is disabled by default; the signing round is initiated immediately.
+	signingID, err := k.RequestBatchSigning(
+		ctx,
+		groupCtx.GroupID,
+		&originator,
+		&types.TextSignatureOrder{Message: []byte("test")},
+	)
+	s.Require().NoError(err)
+
+	signing := k.MustGetSigning(ctx, signingID)
+	s.Require().Equal(uint64(1), signing.CurrentAttempt)
+
+	_, found := k.GetPendingBatch(ctx, groupCtx.GroupID)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestHandleSigningEndBlockBatchSigning() {
+	ctx, k := s.ctx, s.keeper
+
+	params := k.GetParams(ctx)
+	params.BatchSigningWindow = 2
+	s.Require().NoError(k.SetParams(ctx, params))
+
+	groupCtx, err := tsstestutil.CompleteGroupCreation(ctx, k, 4, 2)
+	s.Require().NoError(err)
+
+	s.rollingseedKeeper.EXPECT().GetRollingSeed(gomock.Any()).
+		Return([]byte("RandomStringThatShouldBeLongEnough")).AnyTimes()
+
+	originator := types.NewDirectOriginator("targetChain", "band1m5lq9u533qaya4q3nfyl6ulzqkpkhge9q8tpzs", "test")
+
+	var signingIDs []tss.SigningID
+	for i := 0; i < 3; i++ {
+		signingID, err := k.RequestBatchSigning(
+			ctx,
+			groupCtx.GroupID,
+			&originator,
+			&types.TextSignatureOrder{Message: []byte(fmt.Sprintf("test %d", i))},
+		)
+		s.Require().NoError(err)
+		signingIDs = append(signingIDs, signingID)
+
+		// no signing round is initiated for a batched signing.
+		signing := k.MustGetSigning(ctx, signingID)
+		s.Require().Equal(uint64(0), signing.CurrentAttempt)
+		s.Require().Equal(types.SIGNING_STATUS_WAITING, signing.Status)
+	}
+
+	pb, found := k.GetPendingBatch(ctx, groupCtx.GroupID)
+	s.Require().True(found)
+	s.Require().Equal(types.NewPendingBatch(groupCtx.GroupID, uint64(ctx.BlockHeight()), signingIDs), pb)
+
+	// the batch signing window hasn't ended yet.
+	k.HandleSigningEndBlock(ctx)
+	_, found = k.GetPendingBatch(ctx, groupCtx.GroupID)
+	s.Require().True(found)
+
+	// the batch is signed over the merkle root at the end of the window.
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.HandleSigningEndBlock(ctx)
+	_, found = k.GetPendingBatch(ctx, groupCtx.GroupID)
+	s.Require().False(found)
+
+	batchSigningID := tss.SigningID(k.GetSigningCount(ctx))
+	batch, found := k.GetBatchSigning(ctx, batchSigningID)
+	s.Require().True(found)
+	s.Require().Equal(signingIDs, batch.SigningIDs)
+
+	batchSigning := k.MustGetSigning(ctx, batchSigningID)
+	s.Require().Equal(uint64(1), batchSigning.CurrentAttempt)
+	s.Require().Equal(
+		types.EncodeSigning(ctx, uint64(batchSigningID), nil, types.EncodeBatchContent(batch.Root)),
+		[]byte(batchSigning.Message),
+	)
+
+	for _, sid := range signingIDs {
+		signing := k.MustGetSigning(ctx, sid)
+		s.Require().Equal(batchSigningID, signing.BatchSigningID)
+
+		proof, err := k.GetBatchItemProof(ctx, sid)
+		s.Require().NoError(err)
+		s.Require().Equal(batchSigningID, proof.BatchSigningID)
+		s.Require().Equal(tss.HashMerkleLeaf(signing.Message), []byte(proof.Leaf))
+
+		var siblings [][]byte
+		for _, p := range proof.Proof {
+			siblings = append(siblings, p)
+		}
+		s.Require().True(tss.VerifyMerkleProof(proof.Leaf, siblings, batch.Root))
+	}
+
+	// sign the merkle root once for the whole batch.
+	err = groupCtx.SubmitSignature(ctx, k, s.msgServer, batchSigningID)
+	s.Require().NoError(err)
+	k.HandleSigningEndBlock(ctx)
+
+	batchSigning = k.MustGetSigning(ctx, batchSigningID)
+	s.Require().Equal(types.SIGNING_STATUS_SUCCESS, batchSigning.Status)
+	for _, sid := range signingIDs {
+		s.Require().Equal(types.SIGNING_STATUS_SUCCESS, k.MustGetSigning(ctx, sid).Status)
+	}
+
+	res, err := s.queryServer.BatchProof(ctx, &types.QueryBatchProofRequest{SigningId: uint64(signingIDs[1])})
+	s.Require().NoError(err)
+	s.Require().Equal(signingIDs[1], res.Proof.SigningID)
+	s.Require().Equal(batchSigning.Signature, res.BatchSigningResult.Signing.Signature)
+	s.Require().NotNil(res.BatchSigningResult.EVMSignature)
+}
+
+func (s *KeeperTestSuite) TestHandleFailedBatchSigning() {
+	ctx, k := s.ctx, s.keeper
+
+	params := k.GetParams(ctx)
+	params.BatchSigningWindow = 1
+	s.Require().NoError(k.SetParams(ctx, params))
+
+	groupCtx, err := tsstestutil.CompleteGroupCreation(ctx, k, 4, 2)
+	s.Require().NoError(err)
+
+	s.rollingseedKeeper.EXPECT().GetRollingSeed(gomock.Any()).
+		Return([]byte("RandomStringThatShouldBeLongEnough")).AnyTimes()
+
+	originator := types.NewDirectOriginator("targetChain", "band1m5lq9u533qaya4q3nfyl6ulzqkpkhge9q8tpzs", "test")
+
+	var signingIDs []tss.SigningID
+	for i := 0; i < 2; i++ {
+		signingID, err := k.RequestBatchSigning(
+			ctx,
+			groupCtx.GroupID,
+			&originator,
+			&types.TextSignatureOrder{Message: []byte(fmt.Sprintf("test %d", i))},
+		)
+		s.Require().NoError(err)
+		signingIDs = append(signingIDs, signingID)
+	}
+
+	// a window of one block signs the batch at the end of the same block.
+	k.HandleSigningEndBlock(ctx)
+	batchSigningID := tss.SigningID(k.GetSigningCount(ctx))
+	_, found := k.GetBatchSigning(ctx, batchSigningID)
+	s.Require().True(found)
+
+	// the signings in the batch fail along with the batch signing.
+	k.HandleFailedSigning(ctx, batchSigningID, "failed")
+	s.Require().Equal(types.SIGNING_STATUS_FALLEN, k.MustGetSigning(ctx, batchSigningID).Status)
+	for _, sid := range signingIDs {
+		s.Require().Equal(types.SIGNING_STATUS_FALLEN, k.MustGetSigning(ctx, sid).Status)
+	}
+
+	// a signing that isn't in a batch has no proof.
+	_, err = k.GetBatchItemProof(ctx, batchSigningID)
+	s.Require().ErrorIs(err, types.ErrBatchItemProofNotFound)
+}
@@ -36,6 +36,9 @@ func (k Keeper) HandleSigningEndBlock(ctx sdk.Context) {
 			writeFn()
 		}
 	}
+
+	// sign the batches whose signing window ends at this block.
+	k.HandlePendingBatches(ctx)
 }
 
 // HandleExpiredSignings dequeues the first signing expiration from the store and returns
@@ -89,9 +92,14 @@ func (k Keeper) HandleFailedSigning(ctx sdk.Context, signingID tss.SigningID, re
 	signing.Status = types.SIGNING_STATUS_FALLEN
 	k.SetSigning(ctx, signing)
 
-	// Handle callback after signing failed; this shouldn't return any error.
+	// Handle callback after signing failed; this shouldn't return any error. The signings in
+	// a batch fail along with their batch signing, so the callback is called on them instead.
 	group := k.MustGetGroup(ctx, signing.GroupID)
-	if cb, ok := k.cbRouter.GetRoute(group.ModuleOwner); ok {
+	if batch, found := k.GetBatchSigning(ctx, signingID); found {
+		for _, sid := range batch.SigningIDs {
+			k.HandleFailedSigning(ctx, sid, reason)
+		}
+	} else if cb, ok := k.cbRouter.GetRoute(group.ModuleOwner); ok {
 		cb.OnSigningFailed(ctx, signing.ID)
 	}
 
@@ -145,13 +153,15 @@ func (k Keeper) GetPendingProcessSignings(ctx sdk.Context) []tss.SigningID {
 func (k Keeper) AggregatePartialSignatures(ctx sdk.Context, signingID tss.SigningID) error {
 	signing := k.MustGetSigning(ctx, signingID)
 	partialSigs := k.GetPartialSignatures(ctx, signingID, signing.CurrentAttempt)
+	group := k.MustGetGroup(ctx, signing.GroupID)
+	scheme := group.Curve.Scheme()
 
-	sig, err := tss.CombineSignatures(partialSigs...)
+	sig, err := scheme.CombineSignatures(partialSigs...)
 	if err != nil {
 		return types.ErrInvalidSignature.Wrapf("failed to combine partial signatures: %v", err)
 	}
 
-	if err = tss.VerifyGroupSignature(signing.GroupPubKey, signing.Message, sig); err != nil {
+	if err = scheme.VerifyGroupSignature(signing.GroupPubKey, signing.Message, sig); err != nil {
 		return types.ErrInvalidSignature.Wrapf("failed to verify group signature: %v", err)
 	}
 
@@ -160,11 +170,20 @@ func (k Keeper) AggregatePartialSignatures(ctx sdk.Context, signingID tss.Signin
 	signing.Status = types.SIGNING_STATUS_SUCCESS
 	k.SetSigning(ctx, signing)
 
+	// The signings in a batch are completed along with their batch signing, so the callback is
+	// called on them instead.
+	completedSigningIDs := []tss.SigningID{signing.ID}
+	if batch, found := k.GetBatchSigning(ctx, signingID); found {
+		k.handleBatchItemsSuccess(ctx, batch)
+		completedSigningIDs = batch.SigningIDs
+	}
+
 	// Handle callback after signing completed; this shouldn't return any error.
-	group := k.MustGetGroup(ctx, signing.GroupID)
 	if cb, ok := k.cbRouter.GetRoute(group.ModuleOwner); ok {
 		assignedMemberAddrs := k.MustGetCurrentAssignedMembers(ctx, signingID)
-		cb.OnSigningCompleted(ctx, signing.ID, assignedMemberAddrs)
+		for _, sid := range completedSigningIDs {
+			cb.OnSigningCompleted(ctx, sid, assignedMemberAddrs)
+		}
 	}
 
 	ctx.EventManager().EmitEvent(
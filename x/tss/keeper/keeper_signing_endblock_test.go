@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"crypto/ed25519"
+
 	"go.uber.org/mock/gomock"
 
 	"github.com/bandprotocol/chain/v3/pkg/tss"
@@ -205,6 +207,49 @@ func (s *KeeperTestSuite) TestHandleSigningEndblockMembersSubmitSignature() {
 	s.Require().Len(signingExpirations, 0)
 }
 
+func (s *KeeperTestSuite) TestHandleSigningEndblockEd25519Group() {
+	ctx, k := s.ctx, s.keeper
+
+	groupCtx, err := tsstestutil.CompleteGroupCreationWithCurve(ctx, k, 4, 2, types.CURVE_ED25519)
+	s.Require().NoError(err)
+
+	group := k.MustGetGroup(ctx, groupCtx.GroupID)
+	s.Require().Equal(types.CURVE_ED25519, group.Curve)
+
+	// DEs of the members are only in the ed25519 queue.
+	for _, acc := range groupCtx.Accounts {
+		s.Require().Equal(uint64(0), k.GetDEQueue(ctx, types.CURVE_SECP256K1, acc.Address).Tail)
+		s.Require().NotZero(k.GetDEQueue(ctx, types.CURVE_ED25519, acc.Address).Tail)
+	}
+
+	s.rollingseedKeeper.EXPECT().GetRollingSeed(gomock.Any()).
+		Return([]byte("RandomStringThatShouldBeLongEnough")).AnyTimes()
+
+	originator := types.NewDirectOriginator(
+		"targetChain",
+		"band1m5lq9u533qaya4q3nfyl6ulzqkpkhge9q8tpzs",
+		"test",
+	)
+
+	signingID, err := k.RequestSigning(
+		ctx,
+		groupCtx.GroupID,
+		&originator,
+		&types.TextSignatureOrder{Message: []byte("test")},
+	)
+	s.Require().NoError(err)
+	err = groupCtx.SubmitSignature(ctx, k, s.msgServer, signingID)
+	s.Require().NoError(err)
+
+	k.HandleSigningEndBlock(ctx)
+
+	// the group signature is a standard ed25519 signature of the signing message.
+	signing, err := k.GetSigning(ctx, signingID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.SIGNING_STATUS_SUCCESS, signing.Status)
+	s.Require().True(ed25519.Verify(ed25519.PublicKey(group.PubKey), signing.Message, signing.Signature))
+}
+
 func (s *KeeperTestSuite) TestHandleSigningEndblockTimeoutSigning() {
 	ctx, k := s.ctx, s.keeper
 
@@ -28,8 +28,14 @@ func (k Keeper) GetSigningResult(ctx sdk.Context, signingID tss.SigningID) (*typ
 
 	partialSigs := k.GetPartialSignaturesWithKey(ctx, signingID, signing.CurrentAttempt)
 
+	group, err := k.GetGroup(ctx, signing.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The EVM signature format only applies to the secp256k1 signatures.
 	var evmSignature *types.EVMSignature
-	if signing.Signature != nil {
+	if signing.Signature != nil && group.Curve == types.CURVE_SECP256K1 {
 		evmSig, err := types.NewEVMSignature(signing.Signature)
 		if err != nil {
 			return nil, err
@@ -21,7 +21,7 @@ func (s *KeeperTestSuite) TestAssignedMembers() {
 
 	DEQueuesBeforePolling := []types.DEQueue{}
 	for _, acc := range groupCtx.Accounts {
-		deQueue := k.GetDEQueue(ctx, acc.Address)
+		deQueue := k.GetDEQueue(ctx, types.CURVE_SECP256K1, acc.Address)
 		DEQueuesBeforePolling = append(DEQueuesBeforePolling, deQueue)
 	}
 
@@ -33,7 +33,7 @@ func (s *KeeperTestSuite) TestAssignedMembers() {
 
 	// check if DE is correctly polled; check DEQueue Head is shifted by 1.
 	for id, acc := range groupCtx.Accounts {
-		deQueue := k.GetDEQueue(ctx, acc.Address)
+		deQueue := k.GetDEQueue(ctx, types.CURVE_SECP256K1, acc.Address)
 		expectedHead := DEQueuesBeforePolling[id].Head
 		if slices.Contains(assignedMemberIDs, tss.MemberID(id+1)) {
 			expectedHead += 1
@@ -200,7 +200,7 @@ func (s *KeeperTestSuite) SetupWithPreparedTestCase(testCaseNo int, groupStatus
 	}
 
 	// Setup group
-	_, err := s.keeper.CreateGroup(s.ctx, members, testCase.Threshold, "test")
+	_, err := s.keeper.CreateGroup(s.ctx, members, testCase.Threshold, "test", types.CURVE_SECP256K1)
 	s.Require().NoError(err)
 	s.keeper.SetDKGContext(s.ctx, testCase.ID, testCase.DKGContext)
 
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place state migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator instance.
+func NewMigrator(k *Keeper) Migrator {
+	return Migrator{
+		keeper: k,
+	}
+}
+
+// Migrate1to2 migrates the x/tss module state from the consensus version 1 to
+// version 2. No stored data needs to be rewritten, as the zero values of the
+// fields and stores added in version 2 keep the behavior of version 1:
+//   - Group.source_group_id and Member.source_member_id are zero for groups that
+//     are created by a DKG process rather than by resharing.
+//   - Group.curve and DE.curve are zero, i.e. secp256k1, and the DEs and DE queues
+//     on secp256k1 keep their store prefixes, while Ed25519 ones have new prefixes.
+//   - Signing.batch_signing_id is zero for signings that are signed on their own,
+//     and the pending batch, batch signing and batch item proof stores start empty.
+//
+// The new batch_signing_window param is set by the v3_2 upgrade handler.
+func (m Migrator) Migrate1to2(_ sdk.Context) error {
+	return nil
+}
@@ -9,7 +9,6 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
-	"github.com/bandprotocol/chain/v3/pkg/tss"
 	"github.com/bandprotocol/chain/v3/x/tss/types"
 )
 
@@ -64,7 +63,7 @@ func (k msgServer) SubmitDKGRound1(
 	}
 
 	// Add commits to calculate accumulated commits for each index
-	if err = k.Keeper.AddCoefficientCommits(ctx, groupID, req.Round1Info.CoefficientCommits); err != nil {
+	if err = k.Keeper.AddCoefficientCommits(ctx, group, req.Round1Info.CoefficientCommits); err != nil {
 		return nil, err
 	}
 
@@ -129,13 +128,21 @@ func (k msgServer) SubmitDKGRound2(
 		)
 	}
 
-	// Check encrypted secret shares length
-	if uint64(len(req.Round2Info.EncryptedSecretShares)) != group.Size_-1 {
+	// Check encrypted secret shares length; a member that does not deal sends no shares.
+	member, err := k.Keeper.GetMember(ctx, groupID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	expectedLength := uint64(0)
+	if group.IsDealer(member) {
+		expectedLength = group.Size_ - 1
+	}
+	if uint64(len(req.Round2Info.EncryptedSecretShares)) != expectedLength {
 		return nil, types.ErrInvalidLengthEncryptedSecretShares
 	}
 
 	// Update member public key of the group.
-	if err := k.Keeper.UpdateMemberPubKey(ctx, groupID, memberID); err != nil {
+	if err := k.Keeper.UpdateMemberPubKey(ctx, group, memberID); err != nil {
 		return nil, err
 	}
 
@@ -355,6 +362,12 @@ func (k msgServer) SubmitSignature(
 	}
 	assignedMembers := types.AssignedMembers(sa.AssignedMembers)
 
+	group, err := k.Keeper.GetGroup(ctx, signing.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	scheme := group.Curve.Scheme()
+
 	// validate member address.
 	am, found := assignedMembers.FindAssignedMember(req.MemberID)
 	if !found || am.Address != req.Signer {
@@ -373,7 +386,7 @@ func (k msgServer) SubmitSignature(
 	}
 
 	// Verify signature R
-	if !assignedMembers.VerifySignatureR(req.MemberID, req.Signature.R()) {
+	if !assignedMembers.VerifySignatureR(req.MemberID, scheme.SignatureR(req.Signature)) {
 		return nil, types.ErrSubmitSigningSignatureFailed.Wrapf(
 			"public nonce from member ID %d is not equal signature r",
 			req.MemberID,
@@ -381,7 +394,7 @@ func (k msgServer) SubmitSignature(
 	}
 
 	// Compute lagrange coefficient
-	lagrange, err := tss.ComputeLagrangeCoefficient(req.MemberID, assignedMembers.MemberIDs())
+	lagrange, err := scheme.ComputeLagrangeCoefficient(req.MemberID, assignedMembers.MemberIDs())
 	if err != nil {
 		return nil, types.ErrSubmitSigningSignatureFailed.Wrapf(
 			"failed to compute lagrange coefficient: %v", err,
@@ -389,7 +402,7 @@ func (k msgServer) SubmitSignature(
 	}
 
 	// Verify signing signature
-	if err = tss.VerifySignature(
+	if err = scheme.VerifySignature(
 		signing.GroupPubNonce,
 		signing.GroupPubKey,
 		signing.Message,
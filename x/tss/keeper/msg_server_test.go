@@ -423,6 +423,200 @@ func (s *KeeperTestSuite) TestSuccessConfirmReq() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSuccessReshareDKG() {
+	ctx, msgSrvr, k := s.ctx, s.msgServer, s.keeper
+	tc := testutil.TestCases[0]
+	s.SetupWithPreparedTestCase(0, types.GROUP_STATUS_ACTIVE)
+
+	// The members of the source group re-deal their shares to a new member set.
+	var sourceDealerIDs []tss.MemberID
+	var members []sdk.AccAddress
+	for _, m := range tc.Group.Members {
+		sourceDealerIDs = append(sourceDealerIDs, m.ID)
+		members = append(members, sdk.AccAddress(m.PubKey()))
+	}
+	members = append(members, sdk.AccAddress([]byte("new_member")))
+	threshold := uint64(2)
+
+	groupID, err := k.CreateReshareGroup(ctx, tc.Group.ID, members, threshold, "test")
+	s.Require().NoError(err)
+	dkgContext, err := k.GetDKGContext(ctx, groupID)
+	s.Require().NoError(err)
+
+	// Submit round 1; the non-dealer submits only its one-time information.
+	var round1Infos []*tss.Round1Info
+	for i, addr := range members {
+		mid := tss.NewMemberID(i + 1)
+
+		var secret tss.Scalar
+		if i < len(tc.Group.Members) {
+			source := tc.Group.Members[i]
+			secret, err = tss.ComputeReshareSecret(source.ID, sourceDealerIDs, source.PrivKey)
+			s.Require().NoError(err)
+		}
+
+		data, err := tss.GenerateReshareRound1Info(mid, threshold, dkgContext, secret)
+		s.Require().NoError(err)
+		round1Infos = append(round1Infos, data)
+
+		// A dealer that does not deal its share of the source group secret is rejected.
+		if secret != nil {
+			invalidData, err := tss.GenerateRound1Info(mid, threshold, dkgContext)
+			s.Require().NoError(err)
+
+			_, err = msgSrvr.SubmitDKGRound1(ctx, &types.MsgSubmitDKGRound1{
+				GroupID: groupID,
+				Round1Info: types.NewRound1Info(
+					mid,
+					invalidData.CoefficientCommits,
+					invalidData.OneTimePubKey,
+					invalidData.A0Signature,
+					invalidData.OneTimeSignature,
+				),
+				Sender: addr.String(),
+			})
+			s.Require().ErrorIs(err, types.ErrInvalidReshareCommit)
+		}
+
+		_, err = msgSrvr.SubmitDKGRound1(ctx, &types.MsgSubmitDKGRound1{
+			GroupID: groupID,
+			Round1Info: types.NewRound1Info(
+				mid,
+				data.CoefficientCommits,
+				data.OneTimePubKey,
+				data.A0Signature,
+				data.OneTimeSignature,
+			),
+			Sender: addr.String(),
+		})
+		s.Require().NoError(err)
+	}
+
+	err = tssapp.EndBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+1), k)
+	s.Require().NoError(err)
+
+	group, err := k.GetGroup(ctx, groupID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.GROUP_STATUS_ROUND_2, group.Status)
+	s.Require().Equal(tc.Group.PubKey, group.PubKey)
+
+	// Submit round 2; the non-dealer sends no shares.
+	var oneTimePubKeys tss.Points
+	for _, data := range round1Infos {
+		oneTimePubKeys = append(oneTimePubKeys, data.OneTimePubKey)
+	}
+	for i, data := range round1Infos {
+		mid := tss.NewMemberID(i + 1)
+
+		var encSecretShares tss.EncSecretShares
+		if data.Coefficients != nil {
+			encSecretShares, err = tss.ComputeEncryptedSecretShares(
+				mid,
+				data.OneTimePrivKey,
+				oneTimePubKeys,
+				data.Coefficients,
+				tss.DefaultNonce16Generator{},
+			)
+			s.Require().NoError(err)
+		}
+
+		_, err = msgSrvr.SubmitDKGRound2(ctx, &types.MsgSubmitDKGRound2{
+			GroupID:    groupID,
+			Round2Info: types.NewRound2Info(mid, encSecretShares),
+			Sender:     members[i].String(),
+		})
+		s.Require().NoError(err)
+	}
+
+	err = tssapp.EndBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+1), k)
+	s.Require().NoError(err)
+
+	group, err = k.GetGroup(ctx, groupID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.GROUP_STATUS_ROUND_3, group.Status)
+
+	// Confirm with the own private keys summed from the shares of the dealers.
+	for i := range members {
+		mid := tss.NewMemberID(i + 1)
+
+		var secretShares tss.Scalars
+		for _, data := range round1Infos {
+			if data.Coefficients == nil {
+				continue
+			}
+
+			secretShare, err := tss.ComputeSecretShare(data.Coefficients, mid)
+			s.Require().NoError(err)
+			secretShares = append(secretShares, secretShare)
+		}
+
+		privKey, err := tss.ComputeOwnPrivateKey(secretShares...)
+		s.Require().NoError(err)
+
+		sig, err := tss.SignOwnPubKey(mid, dkgContext, privKey.Point(), privKey)
+		s.Require().NoError(err)
+
+		_, err = msgSrvr.Confirm(ctx, &types.MsgConfirm{
+			GroupID:      groupID,
+			MemberID:     mid,
+			OwnPubKeySig: sig,
+			Sender:       members[i].String(),
+		})
+		s.Require().NoError(err)
+	}
+
+	err = tssapp.EndBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+1), k)
+	s.Require().NoError(err)
+
+	group, err = k.GetGroup(ctx, groupID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.GROUP_STATUS_ACTIVE, group.Status)
+	s.Require().Equal(tc.Group.PubKey, group.PubKey)
+}
+
+func (s *KeeperTestSuite) TestFailedReshareSubmitDKGRound1Req() {
+	ctx, msgSrvr, k := s.ctx, s.msgServer, s.keeper
+	tc := testutil.TestCases[0]
+	s.SetupWithPreparedTestCase(0, types.GROUP_STATUS_ACTIVE)
+
+	var members []sdk.AccAddress
+	for _, m := range tc.Group.Members {
+		members = append(members, sdk.AccAddress(m.PubKey()))
+	}
+	newMember := sdk.AccAddress([]byte("new_member"))
+	members = append(members, newMember)
+
+	groupID, err := k.CreateReshareGroup(ctx, tc.Group.ID, members, 2, "test")
+	s.Require().NoError(err)
+	dkgContext, err := k.GetDKGContext(ctx, groupID)
+	s.Require().NoError(err)
+
+	// A member that does not deal cannot submit coefficient commits.
+	data, err := tss.GenerateRound1Info(3, 2, dkgContext)
+	s.Require().NoError(err)
+
+	_, err = msgSrvr.SubmitDKGRound1(ctx, &types.MsgSubmitDKGRound1{
+		GroupID: groupID,
+		Round1Info: types.NewRound1Info(
+			3,
+			data.CoefficientCommits,
+			data.OneTimePubKey,
+			data.A0Signature,
+			data.OneTimeSignature,
+		),
+		Sender: newMember.String(),
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidLengthCoeffCommits)
+
+	// A dealer must submit coefficient commits.
+	_, err = msgSrvr.SubmitDKGRound1(ctx, &types.MsgSubmitDKGRound1{
+		GroupID:    groupID,
+		Round1Info: types.NewRound1Info(1, nil, data.OneTimePubKey, nil, data.OneTimeSignature),
+		Sender:     members[0].String(),
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidLengthCoeffCommits)
+}
+
 func (s *KeeperTestSuite) TestFailedSubmitDEsReq() {
 	ctx, msgSrvr := s.ctx, s.msgServer
 
@@ -476,7 +670,7 @@ func (s *KeeperTestSuite) TestSuccessSubmitDEsReq() {
 	})
 	s.Require().NoError(err)
 
-	deQueue := k.GetDEQueue(ctx, sdk.MustAccAddressFromBech32("band1p40yh3zkmhcv0ecqp3mcazy83sa57rgjp07dun"))
+	deQueue := k.GetDEQueue(ctx, types.CURVE_SECP256K1, sdk.MustAccAddressFromBech32("band1p40yh3zkmhcv0ecqp3mcazy83sa57rgjp07dun"))
 	s.Require().True(deQueue.Head < deQueue.Tail)
 }
 
@@ -507,7 +701,7 @@ func (s *KeeperTestSuite) TestResetDEReq() {
 	s.Require().NoError(err)
 
 	// Check if DEs are reset
-	deQueue := k.GetDEQueue(ctx, sdk.MustAccAddressFromBech32("band1p40yh3zkmhcv0ecqp3mcazy83sa57rgjp07dun"))
+	deQueue := k.GetDEQueue(ctx, types.CURVE_SECP256K1, sdk.MustAccAddressFromBech32("band1p40yh3zkmhcv0ecqp3mcazy83sa57rgjp07dun"))
 	s.Require().Equal(types.DEQueue{Head: 0, Tail: 0}, deQueue)
 }
 
@@ -22,7 +22,7 @@ import (
 )
 
 // ConsensusVersion defines the current x/tss module consensus version.
-const ConsensusVersion uint64 = 1
+const ConsensusVersion uint64 = 2
 
 var (
 	_ module.AppModuleBasic = AppModuleBasic{}
@@ -124,6 +124,11 @@ func (AppModule) Name() string {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the module's invariants.
@@ -18,6 +18,7 @@ const (
 type GroupContext struct {
 	GroupID               tss.GroupID
 	Threshold             uint64
+	Curve                 types.Curve
 	Accounts              []bandtesting.Account
 	Round1Infos           []tss.Round1Info
 	EncryptedSecretShares []tss.EncSecretShares
@@ -33,17 +34,29 @@ func NewGroupContext(
 	threshold uint64,
 	dkgContext []byte,
 ) (*GroupContext, error) {
-	round1Infos, err := createRound1Info(uint64(len(accounts)), threshold, dkgContext)
+	return NewGroupContextWithCurve(accounts, groupID, threshold, dkgContext, types.CURVE_SECP256K1)
+}
+
+func NewGroupContextWithCurve(
+	accounts []bandtesting.Account,
+	groupID tss.GroupID,
+	threshold uint64,
+	dkgContext []byte,
+	curve types.Curve,
+) (*GroupContext, error) {
+	scheme := curve.Scheme()
+
+	round1Infos, err := createRound1Info(scheme, uint64(len(accounts)), threshold, dkgContext)
 	if err != nil {
 		return nil, err
 	}
 
-	encSecrets, err := createGroupEncryptedSecretShares(round1Infos)
+	encSecrets, err := createGroupEncryptedSecretShares(scheme, round1Infos)
 	if err != nil {
 		return nil, err
 	}
 
-	ownPrivKeys, ownPubKeySigs, err := createOwnPrivatePublicKeys(dkgContext, round1Infos, encSecrets)
+	ownPrivKeys, ownPubKeySigs, err := createOwnPrivatePublicKeys(scheme, dkgContext, round1Infos, encSecrets)
 	if err != nil {
 		return nil, err
 	}
@@ -65,6 +78,7 @@ func NewGroupContext(
 	return &GroupContext{
 		GroupID:               groupID,
 		Threshold:             threshold,
+		Curve:                 curve,
 		Accounts:              accounts,
 		Round1Infos:           round1Infos,
 		EncryptedSecretShares: encSecrets,
@@ -83,7 +97,7 @@ func (g *GroupContext) FillDEs(ctx sdk.Context, k *tsskeeper.Keeper) error {
 			continue
 		}
 
-		newDEs := createDEs(maxDE-len(g.DEs[i]), g.Secrets[i])
+		newDEs := createDEs(maxDE-len(g.DEs[i]), g.Secrets[i], g.Curve)
 		pubDEs := make([]types.DE, len(newDEs))
 		for j, newDE := range newDEs {
 			pubDEs[j] = newDE.PubDE
@@ -249,6 +263,16 @@ func CompleteGroupCreation(
 	k *tsskeeper.Keeper,
 	groupSize uint64,
 	threshold uint64,
+) (*GroupContext, error) {
+	return CompleteGroupCreationWithCurve(ctx, k, groupSize, threshold, types.CURVE_SECP256K1)
+}
+
+func CompleteGroupCreationWithCurve(
+	ctx sdk.Context,
+	k *tsskeeper.Keeper,
+	groupSize uint64,
+	threshold uint64,
+	curve types.Curve,
 ) (*GroupContext, error) {
 	accounts := GenerateAccounts(groupSize)
 	members := make([]sdk.AccAddress, groupSize)
@@ -257,7 +281,7 @@ func CompleteGroupCreation(
 	}
 
 	// Create Group
-	groupID, err := k.CreateGroup(ctx, members, threshold, "test")
+	groupID, err := k.CreateGroup(ctx, members, threshold, "test", curve)
 	if err != nil {
 		return nil, err
 	}
@@ -267,7 +291,7 @@ func CompleteGroupCreation(
 		return nil, err
 	}
 
-	groupCtx, err := NewGroupContext(accounts, groupID, threshold, dkgContext)
+	groupCtx, err := NewGroupContextWithCurve(accounts, groupID, threshold, dkgContext, curve)
 	if err != nil {
 		return nil, err
 	}
@@ -292,6 +316,7 @@ func CompleteGroupCreation(
 }
 
 func computeSecretShares(
+	scheme types.Scheme,
 	r1Infos []tss.Round1Info,
 	encSecrets []tss.EncSecretShares,
 	mid tss.MemberID,
@@ -301,7 +326,7 @@ func computeSecretShares(
 	secretShares := make(tss.Scalars, n)
 	for i := uint64(0); i < n; i++ {
 		if i == uint64(mid)-1 {
-			secretShare, err := tss.ComputeSecretShare(r1Infos[i].Coefficients, mid)
+			secretShare, err := scheme.ComputeSecretShare(r1Infos[i].Coefficients, mid)
 			if err != nil {
 				return tss.Scalars{}, err
 			}
@@ -325,7 +350,7 @@ func computeSecretShares(
 			return tss.Scalars{}, err
 		}
 
-		if err = tss.VerifySecretShare(mid, secretShare, r1Infos[i].CoefficientCommits); err != nil {
+		if err = scheme.VerifySecretShare(mid, secretShare, r1Infos[i].CoefficientCommits); err != nil {
 			return tss.Scalars{}, err
 		}
 		secretShares[i] = secretShare
@@ -334,11 +359,16 @@ func computeSecretShares(
 	return secretShares, nil
 }
 
-func createRound1Info(groupSize uint64, threshold uint64, dkgContext []byte) ([]tss.Round1Info, error) {
+func createRound1Info(
+	scheme types.Scheme,
+	groupSize uint64,
+	threshold uint64,
+	dkgContext []byte,
+) ([]tss.Round1Info, error) {
 	round1Infos := make([]tss.Round1Info, groupSize)
 	for i := range round1Infos {
 		mid := tss.MemberID(i + 1)
-		r1, err := tss.GenerateRound1Info(mid, threshold, dkgContext)
+		r1, err := scheme.GenerateRound1Info(mid, threshold, dkgContext)
 		if err != nil {
 			return nil, err
 		}
@@ -349,7 +379,7 @@ func createRound1Info(groupSize uint64, threshold uint64, dkgContext []byte) ([]
 	return round1Infos, nil
 }
 
-func createGroupEncryptedSecretShares(r1Infos []tss.Round1Info) ([]tss.EncSecretShares, error) {
+func createGroupEncryptedSecretShares(scheme types.Scheme, r1Infos []tss.Round1Info) ([]tss.EncSecretShares, error) {
 	// Get one-time public keys
 	oneTimePubKeys := make(tss.Points, len(r1Infos))
 	for i := range r1Infos {
@@ -361,7 +391,7 @@ func createGroupEncryptedSecretShares(r1Infos []tss.Round1Info) ([]tss.EncSecret
 		mid := tss.MemberID(i + 1)
 
 		// Compute encrypted secret shares
-		encSecretShares, err := tss.ComputeEncryptedSecretShares(
+		encSecretShares, err := scheme.ComputeEncryptedSecretShares(
 			mid,
 			r1Infos[i].OneTimePrivKey,
 			oneTimePubKeys,
@@ -379,6 +409,7 @@ func createGroupEncryptedSecretShares(r1Infos []tss.Round1Info) ([]tss.EncSecret
 }
 
 func createOwnPrivatePublicKeys(
+	scheme types.Scheme,
 	dkgContext []byte,
 	r1Infos []tss.Round1Info,
 	encSecrets []tss.EncSecretShares,
@@ -394,20 +425,25 @@ func createOwnPrivatePublicKeys(
 	for i := range r1Infos {
 		midI := tss.MemberID(i + 1)
 
-		secretShares, err := computeSecretShares(r1Infos, encSecrets, midI)
+		secretShares, err := computeSecretShares(scheme, r1Infos, encSecrets, midI)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		ownPrivKey, err := scheme.ComputeOwnPrivateKey(secretShares...)
 		if err != nil {
 			return nil, nil, err
 		}
 
-		ownPrivKey, err := tss.ComputeOwnPrivateKey(secretShares...)
+		ownPubKey, err := scheme.ComputePublicKey(ownPrivKey)
 		if err != nil {
 			return nil, nil, err
 		}
 
-		ownPubKeySig, err := tss.SignOwnPubKey(
+		ownPubKeySig, err := scheme.SignOwnPubKey(
 			midI,
 			dkgContext,
-			ownPrivKey.Point(),
+			ownPubKey,
 			ownPrivKey,
 		)
 		if err != nil {
@@ -421,10 +457,10 @@ func createOwnPrivatePublicKeys(
 	return ownPrivKeys, ownPubKeySigs, nil
 }
 
-func createDEs(n int, secret tss.Scalar) []DEWithPrivateNonce {
+func createDEs(n int, secret tss.Scalar, curve types.Curve) []DEWithPrivateNonce {
 	var des []DEWithPrivateNonce
 	for range n {
-		de := GenerateDE(secret)
+		de := GenerateDEWithCurve(secret, curve)
 		des = append(des, de)
 	}
 
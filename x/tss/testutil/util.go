@@ -28,16 +28,32 @@ func GenerateAccounts(n uint64) []bandtesting.Account {
 }
 
 func GenerateDE(secret tss.Scalar) DEWithPrivateNonce {
-	privD, err := tss.GenerateSigningNonce(secret)
+	return GenerateDEWithCurve(secret, types.CURVE_SECP256K1)
+}
+
+func GenerateDEWithCurve(secret tss.Scalar, curve types.Curve) DEWithPrivateNonce {
+	scheme := curve.Scheme()
+
+	privD, err := scheme.GenerateSigningNonce(secret)
+	if err != nil {
+		panic(err)
+	}
+
+	privE, err := scheme.GenerateSigningNonce(secret)
+	if err != nil {
+		panic(err)
+	}
+
+	pubD, err := scheme.ComputePublicKey(privD)
 	if err != nil {
 		panic(err)
 	}
 
-	privE, err := tss.GenerateSigningNonce(secret)
+	pubE, err := scheme.ComputePublicKey(privE)
 	if err != nil {
 		panic(err)
 	}
-	pubDE := types.NewDE(privD.Point(), privE.Point())
+	pubDE := types.NewDE(pubD, pubE, curve)
 
 	return DEWithPrivateNonce{PrivD: privD, PrivE: privE, PubDE: pubDE}
 }
@@ -60,19 +76,21 @@ func GenerateSignature(
 		return nil, fmt.Errorf("member not found")
 	}
 
+	scheme := de.PubDE.Curve.Scheme()
+
 	// Compute own private nonce
-	privNonce, err := tss.ComputeOwnPrivNonce(de.PrivD, de.PrivE, member.BindingFactor)
+	privNonce, err := scheme.ComputeOwnPrivNonce(de.PrivD, de.PrivE, member.BindingFactor)
 	if err != nil {
 		return nil, err
 	}
 
 	// Compute lagrange
-	lagrange, err := tss.ComputeLagrangeCoefficient(memberID, assignedMembers.MemberIDs())
+	lagrange, err := scheme.ComputeLagrangeCoefficient(memberID, assignedMembers.MemberIDs())
 	if err != nil {
 		return nil, err
 	}
 
-	return tss.SignSigning(
+	return scheme.SignSigning(
 		signing.GroupPubNonce,
 		signing.GroupPubKey,
 		signing.Message,
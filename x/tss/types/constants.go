@@ -6,6 +6,9 @@ import (
 
 const (
 	uint64Len = 8
+
+	// BatchSigningPath is the path whose hash prefixes the content message of a batch signing.
+	BatchSigningPath = "tss/batch"
 )
 
 // GetGrantMsgTypes get message types that can be granted.
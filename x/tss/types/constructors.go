@@ -21,6 +21,7 @@ func NewGroup(
 	status GroupStatus,
 	createdHeight uint64,
 	moduleOwner string,
+	curve Curve,
 ) Group {
 	return Group{
 		ID:            id,
@@ -30,6 +31,7 @@ func NewGroup(
 		Status:        status,
 		CreatedHeight: createdHeight,
 		ModuleOwner:   moduleOwner,
+		Curve:         curve,
 	}
 }
 
@@ -45,11 +47,16 @@ func (g Group) Validate() error {
 		return ErrInvalidGroup.Wrapf("group threshold (%d) is more than the group size (%d)", g.Threshold, g.Size_)
 	}
 
+	// validate group curve
+	if err := g.Curve.Validate(); err != nil {
+		return ErrInvalidGroup.Wrapf("invalid group curve: %v", err)
+	}
+
 	// validate group public key
 	if g.PubKey == nil {
 		return ErrInvalidGroup.Wrap("group public key must not be nil")
 	}
-	if err := g.PubKey.Validate(); err != nil {
+	if err := g.Curve.Scheme().ValidatePoint(g.PubKey); err != nil {
 		return ErrInvalidGroup.Wrapf("group public key is invalid: %v", err)
 	}
 
@@ -74,6 +81,13 @@ func (g Group) Validate() error {
 	return nil
 }
 
+// IsDealer returns whether the member deals a secret polynomial in the DKG process of the group. All members
+// deal in a new DKG process, while only the members that hold a share of the source group deal in a
+// reshared group.
+func (g Group) IsDealer(member Member) bool {
+	return g.SourceGroupID == 0 || member.SourceMemberID != 0
+}
+
 // ====================================
 // Round1Info
 // ====================================
@@ -104,7 +118,7 @@ func (r Round1Info) Validate() error {
 
 	// Validate coefficients commit
 	for _, c := range r.CoefficientCommits {
-		if err := c.Validate(); err != nil {
+		if err := ValidatePointOnAnyCurve(c); err != nil {
 			return ErrInvalidCoefficientCommit.Wrapf("invalid coefficient commit: %v", err)
 		}
 	}
@@ -114,9 +128,11 @@ func (r Round1Info) Validate() error {
 		return ErrInvalidPublicKey.Wrapf("invalid one-time public key: %v", err)
 	}
 
-	// Validate a0 signature
-	if err := r.A0Signature.Validate(); err != nil {
-		return ErrInvalidSignature.Wrapf("invalid a0 signature: %v", err)
+	// Validate a0 signature; a member that does not deal in a reshared group has no coefficients.
+	if len(r.CoefficientCommits) != 0 || len(r.A0Signature) != 0 {
+		if err := ValidateSignatureOnAnyCurve(r.A0Signature); err != nil {
+			return ErrInvalidSignature.Wrapf("invalid a0 signature: %v", err)
+		}
 	}
 
 	// Validate one time signature
@@ -230,20 +246,25 @@ func NewComplaintWithStatus(complaint Complaint, status ComplaintStatus) Complai
 // ====================================
 
 // NewDE creates a new DE instance.
-func NewDE(pubD tss.Point, pubE tss.Point) DE {
+func NewDE(pubD tss.Point, pubE tss.Point, curve Curve) DE {
 	return DE{
-		PubD: pubD,
-		PubE: pubE,
+		PubD:  pubD,
+		PubE:  pubE,
+		Curve: curve,
 	}
 }
 
 // Validate performs basic validation of de information.
 func (d DE) Validate() error {
-	if err := d.PubD.Validate(); err != nil {
+	if err := d.Curve.Validate(); err != nil {
+		return ErrInvalidDE.Wrapf("invalid curve: %v", err)
+	}
+
+	if err := d.Curve.Scheme().ValidatePoint(d.PubD); err != nil {
 		return ErrInvalidDE.Wrap("invalid pub d")
 	}
 
-	if err := d.PubE.Validate(); err != nil {
+	if err := d.Curve.Scheme().ValidatePoint(d.PubE); err != nil {
 		return ErrInvalidDE.Wrap("invalid pub e")
 	}
 
@@ -427,3 +448,47 @@ func NewSigningResult(
 		ReceivedPartialSignatures: receivedPartialSigs,
 	}
 }
+
+// ====================================
+// Batch signing
+// ====================================
+
+// NewPendingBatch creates a new PendingBatch instance.
+func NewPendingBatch(groupID tss.GroupID, startHeight uint64, signingIDs []tss.SigningID) PendingBatch {
+	return PendingBatch{
+		GroupID:     groupID,
+		StartHeight: startHeight,
+		SigningIDs:  signingIDs,
+	}
+}
+
+// NewBatchSigning creates a new BatchSigning instance.
+func NewBatchSigning(id tss.SigningID, root []byte, signingIDs []tss.SigningID) BatchSigning {
+	return BatchSigning{
+		ID:         id,
+		Root:       root,
+		SigningIDs: signingIDs,
+	}
+}
+
+// NewBatchItemProof creates a new BatchItemProof instance.
+func NewBatchItemProof(
+	signingID tss.SigningID,
+	batchSigningID tss.SigningID,
+	leaf []byte,
+	proof [][]byte,
+	root []byte,
+) BatchItemProof {
+	hexProof := make([]bytes.HexBytes, 0, len(proof))
+	for _, p := range proof {
+		hexProof = append(hexProof, p)
+	}
+
+	return BatchItemProof{
+		SigningID:      signingID,
+		BatchSigningID: batchSigningID,
+		Leaf:           leaf,
+		Proof:          hexProof,
+		Root:           root,
+	}
+}
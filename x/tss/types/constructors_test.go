@@ -81,6 +81,34 @@ func TestValidateGroup(t *testing.T) {
 			},
 			types.ErrInvalidGroup,
 		},
+		{
+			"invalid group - unsupported curve",
+			types.Group{
+				ID:            1,
+				Size_:         3,
+				Threshold:     2,
+				PubKey:        validTSSPoint,
+				Status:        types.GROUP_STATUS_ACTIVE,
+				CreatedHeight: 1,
+				ModuleOwner:   "module",
+				Curve:         types.Curve(99),
+			},
+			types.ErrInvalidGroup,
+		},
+		{
+			"invalid group - public key is not on the group curve",
+			types.Group{
+				ID:            1,
+				Size_:         3,
+				Threshold:     2,
+				PubKey:        validTSSPoint,
+				Status:        types.GROUP_STATUS_ACTIVE,
+				CreatedHeight: 1,
+				ModuleOwner:   "module",
+				Curve:         types.CURVE_ED25519,
+			},
+			types.ErrInvalidGroup,
+		},
 	}
 
 	for _, tc := range testcases {
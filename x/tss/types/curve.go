@@ -0,0 +1,217 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/pkg/tss/ed25519"
+)
+
+// Scheme is the set of functions of the threshold signature scheme on a curve. The module uses it to
+// verify the DKG and signing processes of a group, while the signers use it to take part in them.
+type Scheme struct {
+	ValidatePoint       func(p tss.Point) error
+	ValidateSignature   func(s tss.Signature) error
+	SignatureR          func(s tss.Signature) tss.Point
+	SumPoints           func(points ...tss.Point) (tss.Point, error)
+	VerifyA0Signature   func(mid tss.MemberID, dkgContext []byte, sig tss.Signature, a0Pub tss.Point) error
+	VerifyReshareCommit func(
+		sourceMid tss.MemberID,
+		sourceDealerIDs []tss.MemberID,
+		pubKey, a0Commit tss.Point,
+	) error
+	ComputeOwnPublicKey func(sumCommits tss.Points, mid tss.MemberID) (tss.Point, error)
+	VerifyComplaint     func(
+		oneTimePubI, oneTimePubJ, keySym tss.Point,
+		complaintSig tss.ComplaintSignature,
+		encSecretShare tss.EncSecretShare,
+		midI tss.MemberID,
+		commits tss.Points,
+	) error
+	VerifyOwnPubKeySignature   func(mid tss.MemberID, dkgContext []byte, sig tss.Signature, ownPub tss.Point) error
+	ComputeLagrangeCoefficient func(mid tss.MemberID, memberList []tss.MemberID) (tss.Scalar, error)
+	ComputeOwnBindingFactor    func(mid tss.MemberID, data []byte, commitment []byte) (tss.Scalar, error)
+	ComputeOwnPubNonce         func(pubD, pubE tss.Point, bindingFactor tss.Scalar) (tss.Point, error)
+	ComputeGroupPublicNonce    func(ownPubNonces ...tss.Point) (tss.Point, error)
+	CombineSignatures          func(sigs ...tss.Signature) (tss.Signature, error)
+	VerifySignature            func(
+		groupPubNonce, groupPubKey tss.Point,
+		data []byte,
+		lagrange tss.Scalar,
+		sig tss.Signature,
+		ownPubKey tss.Point,
+	) error
+	VerifyGroupSignature func(groupPubKey tss.Point, data []byte, sig tss.Signature) error
+
+	ComputePublicKey          func(privKey tss.Scalar) (tss.Point, error)
+	GenerateRound1Info        func(mid tss.MemberID, threshold uint64, dkgContext []byte) (*tss.Round1Info, error)
+	GenerateReshareRound1Info func(
+		mid tss.MemberID,
+		threshold uint64,
+		dkgContext []byte,
+		secret tss.Scalar,
+	) (*tss.Round1Info, error)
+	ComputeReshareSecret func(
+		sourceMid tss.MemberID,
+		sourceDealerIDs []tss.MemberID,
+		privKey tss.Scalar,
+	) (tss.Scalar, error)
+	ComputeEncryptedSecretShares func(
+		mid tss.MemberID,
+		privKey tss.Scalar,
+		pubKeys tss.Points,
+		coefficients tss.Scalars,
+		n16g tss.INonce16Generator,
+	) (tss.EncSecretShares, error)
+	ComputeSecretShare   func(coefficients tss.Scalars, mid tss.MemberID) (tss.Scalar, error)
+	VerifySecretShare    func(mid tss.MemberID, secretShare tss.Scalar, commits tss.Points) error
+	ComputeOwnPrivateKey func(secretShares ...tss.Scalar) (tss.Scalar, error)
+	SignOwnPubKey        func(
+		mid tss.MemberID,
+		dkgContext []byte,
+		ownPub tss.Point,
+		ownPriv tss.Scalar,
+	) (tss.Signature, error)
+	GenerateSigningNonce func(secret tss.Scalar) (tss.Scalar, error)
+	ComputeOwnPrivNonce  func(privD, privE tss.Scalar, bindingFactor tss.Scalar) (tss.Scalar, error)
+	SignSigning          func(
+		groupPubNonce, groupPubKey tss.Point,
+		data []byte,
+		lagrange tss.Scalar,
+		ownPrivNonce tss.Scalar,
+		ownPrivKey tss.Scalar,
+	) (tss.Signature, error)
+}
+
+// schemes maps each supported curve to its threshold signature scheme.
+var schemes = map[Curve]Scheme{
+	CURVE_SECP256K1: {
+		ValidatePoint:              func(p tss.Point) error { return p.Validate() },
+		ValidateSignature:          func(s tss.Signature) error { return s.Validate() },
+		SignatureR:                 func(s tss.Signature) tss.Point { return s.R() },
+		SumPoints:                  tss.SumPoints,
+		VerifyA0Signature:          tss.VerifyA0Signature,
+		VerifyReshareCommit:        tss.VerifyReshareCommit,
+		ComputeOwnPublicKey:        tss.ComputeOwnPublicKey,
+		VerifyComplaint:            tss.VerifyComplaint,
+		VerifyOwnPubKeySignature:   tss.VerifyOwnPubKeySignature,
+		ComputeLagrangeCoefficient: tss.ComputeLagrangeCoefficient,
+		ComputeOwnBindingFactor:    tss.ComputeOwnBindingFactor,
+		ComputeOwnPubNonce:         tss.ComputeOwnPubNonce,
+		ComputeGroupPublicNonce:    tss.ComputeGroupPublicNonce,
+		CombineSignatures:          tss.CombineSignatures,
+		VerifySignature:            tss.VerifySignature,
+		VerifyGroupSignature:       tss.VerifyGroupSignature,
+
+		ComputePublicKey:             func(s tss.Scalar) (tss.Point, error) { return s.Point(), nil },
+		GenerateRound1Info:           tss.GenerateRound1Info,
+		GenerateReshareRound1Info:    tss.GenerateReshareRound1Info,
+		ComputeReshareSecret:         tss.ComputeReshareSecret,
+		ComputeEncryptedSecretShares: tss.ComputeEncryptedSecretShares,
+		ComputeSecretShare:           tss.ComputeSecretShare,
+		VerifySecretShare:            tss.VerifySecretShare,
+		ComputeOwnPrivateKey:         tss.ComputeOwnPrivateKey,
+		SignOwnPubKey:                tss.SignOwnPubKey,
+		GenerateSigningNonce:         tss.GenerateSigningNonce,
+		ComputeOwnPrivNonce:          tss.ComputeOwnPrivNonce,
+		SignSigning:                  tss.SignSigning,
+	},
+	CURVE_ED25519: {
+		ValidatePoint:              ed25519.ValidatePoint,
+		ValidateSignature:          ed25519.ValidateSignature,
+		SignatureR:                 ed25519.SignatureR,
+		SumPoints:                  ed25519.SumPoints,
+		VerifyA0Signature:          ed25519.VerifyA0Signature,
+		VerifyReshareCommit:        ed25519.VerifyReshareCommit,
+		ComputeOwnPublicKey:        ed25519.ComputeOwnPublicKey,
+		VerifyComplaint:            ed25519.VerifyComplaint,
+		VerifyOwnPubKeySignature:   ed25519.VerifyOwnPubKeySignature,
+		ComputeLagrangeCoefficient: ed25519.ComputeLagrangeCoefficient,
+		ComputeOwnBindingFactor:    ed25519.ComputeOwnBindingFactor,
+		ComputeOwnPubNonce:         ed25519.ComputeOwnPubNonce,
+		ComputeGroupPublicNonce:    ed25519.ComputeGroupPublicNonce,
+		CombineSignatures:          ed25519.CombineSignatures,
+		VerifySignature:            ed25519.VerifySignature,
+		VerifyGroupSignature:       ed25519.VerifyGroupSignature,
+
+		ComputePublicKey:             ed25519.ComputePublicKey,
+		GenerateRound1Info:           ed25519.GenerateRound1Info,
+		GenerateReshareRound1Info:    ed25519.GenerateReshareRound1Info,
+		ComputeReshareSecret:         ed25519.ComputeReshareSecret,
+		ComputeEncryptedSecretShares: ed25519.ComputeEncryptedSecretShares,
+		ComputeSecretShare:           ed25519.ComputeSecretShare,
+		VerifySecretShare:            ed25519.VerifySecretShare,
+		ComputeOwnPrivateKey:         ed25519.ComputeOwnPrivateKey,
+		SignOwnPubKey:                ed25519.SignOwnPubKey,
+		GenerateSigningNonce:         ed25519.GenerateSigningNonce,
+		ComputeOwnPrivNonce:          ed25519.ComputeOwnPrivNonce,
+		SignSigning:                  ed25519.SignSigning,
+	},
+}
+
+// Curves returns all supported curves in a deterministic order.
+func Curves() []Curve {
+	return []Curve{CURVE_SECP256K1, CURVE_ED25519}
+}
+
+// ParseCurve parses a curve from its name, either the full enum name (e.g. CURVE_ED25519) or
+// the short name (e.g. ed25519).
+func ParseCurve(name string) (Curve, error) {
+	name = strings.ToUpper(name)
+	if !strings.HasPrefix(name, "CURVE_") {
+		name = "CURVE_" + name
+	}
+
+	value, ok := Curve_value[name]
+	if !ok {
+		return 0, ErrInvalidCurve.Wrapf("unknown curve: %s", name)
+	}
+
+	return Curve(value), nil
+}
+
+// Validate returns an error if the curve is not supported.
+func (c Curve) Validate() error {
+	if _, ok := schemes[c]; !ok {
+		return ErrInvalidCurve.Wrapf("unsupported curve: %d", c)
+	}
+
+	return nil
+}
+
+// Scheme returns the threshold signature scheme of the curve. It panics if the curve is not supported,
+// so the curve must be validated before it is stored.
+func (c Curve) Scheme() Scheme {
+	scheme, ok := schemes[c]
+	if !ok {
+		panic(ErrInvalidCurve.Wrapf("unsupported curve: %d", c))
+	}
+
+	return scheme
+}
+
+// ValidatePointOnAnyCurve returns an error if the point is not valid on any supported curve. It is used
+// in stateless validation, where the curve of the group is not known yet.
+func ValidatePointOnAnyCurve(p tss.Point) error {
+	var err error
+	for _, curve := range Curves() {
+		if err = curve.Scheme().ValidatePoint(p); err == nil {
+			return nil
+		}
+	}
+
+	return err
+}
+
+// ValidateSignatureOnAnyCurve returns an error if the signature is not valid on any supported curve. It is
+// used in stateless validation, where the curve of the group is not known yet.
+func ValidateSignatureOnAnyCurve(s tss.Signature) error {
+	var err error
+	for _, curve := range Curves() {
+		if err = curve.Scheme().ValidateSignature(s); err == nil {
+			return nil
+		}
+	}
+
+	return err
+}
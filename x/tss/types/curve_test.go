@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss/ed25519"
+	"github.com/bandprotocol/chain/v3/x/tss/types"
+)
+
+func TestParseCurve(t *testing.T) {
+	curve, err := types.ParseCurve("secp256k1")
+	require.NoError(t, err)
+	require.Equal(t, types.CURVE_SECP256K1, curve)
+
+	curve, err = types.ParseCurve("ed25519")
+	require.NoError(t, err)
+	require.Equal(t, types.CURVE_ED25519, curve)
+
+	curve, err = types.ParseCurve("CURVE_ED25519")
+	require.NoError(t, err)
+	require.Equal(t, types.CURVE_ED25519, curve)
+
+	_, err = types.ParseCurve("p256")
+	require.ErrorIs(t, err, types.ErrInvalidCurve)
+}
+
+func TestCurveValidate(t *testing.T) {
+	for _, curve := range types.Curves() {
+		require.NoError(t, curve.Validate())
+	}
+
+	require.ErrorIs(t, types.Curve(99).Validate(), types.ErrInvalidCurve)
+	require.Panics(t, func() { types.Curve(99).Scheme() })
+}
+
+func TestValidateDEOnCurve(t *testing.T) {
+	kp, err := ed25519.GenerateKeyPair()
+	require.NoError(t, err)
+	edPoint := kp.PubKey
+
+	require.NoError(t, types.NewDE(validTSSPoint, validTSSPoint, types.CURVE_SECP256K1).Validate())
+	require.NoError(t, types.NewDE(edPoint, edPoint, types.CURVE_ED25519).Validate())
+
+	// points must be on the curve of the DE.
+	require.ErrorIs(t, types.NewDE(edPoint, edPoint, types.CURVE_SECP256K1).Validate(), types.ErrInvalidDE)
+	require.ErrorIs(t, types.NewDE(validTSSPoint, validTSSPoint, types.CURVE_ED25519).Validate(), types.ErrInvalidDE)
+	require.ErrorIs(t, types.NewDE(edPoint, edPoint, types.Curve(99)).Validate(), types.ErrInvalidDE)
+
+	// stateless validation accepts a point on any supported curve.
+	require.NoError(t, types.ValidatePointOnAnyCurve(validTSSPoint))
+	require.NoError(t, types.ValidatePointOnAnyCurve(edPoint))
+}
@@ -55,4 +55,8 @@ var (
 	ErrInvalidGroup                 = errorsmod.Register(ModuleName, 46, "invalid group")
 	ErrInvalidSigning               = errorsmod.Register(ModuleName, 47, "invalid signing")
 	ErrCreateSigningFailed          = errorsmod.Register(ModuleName, 48, "failed to create signing")
+	ErrInvalidReshareCommit         = errorsmod.Register(ModuleName, 49, "invalid reshare commit")
+	ErrInvalidCurve                 = errorsmod.Register(ModuleName, 50, "invalid curve")
+	ErrBatchItemProofNotFound       = errorsmod.Register(ModuleName, 51, "batch item proof not found")
+	ErrCreateBatchSigningFailed     = errorsmod.Register(ModuleName, 52, "failed to create batch signing")
 )
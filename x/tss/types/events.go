@@ -1,24 +1,26 @@
 package types
 
 const (
-	EventTypeCreateGroup       = "create_group"
-	EventTypeExpiredGroup      = "expired_group"
-	EventTypeSubmitDKGRound1   = "submit_dkg_round1"
-	EventTypeRound1Success     = "round1_success"
-	EventTypeSubmitDKGRound2   = "submit_dkg_round2"
-	EventTypeRound2Success     = "round2_success"
-	EventTypeComplainSuccess   = "complain_success"
-	EventTypeComplainFailed    = "complain_failed"
-	EventTypeConfirmSuccess    = "confirm_success"
-	EventTypeRound3Success     = "round3_success"
-	EventTypeRound3Failed      = "round3_failed"
-	EventTypeRequestSignature  = "request_signature"
-	EventTypeCreateSigning     = "create_signing_request"
-	EventTypeSigningSuccess    = "signing_success"
-	EventTypeSigningFailed     = "signing_failed"
-	EventTypeSubmitSignature   = "submit_signature"
-	EventTypeDEDeleted         = "de_deleted"
-	EventTypeSetMemberIsActive = "set_member_is_active"
+	EventTypeCreateGroup        = "create_group"
+	EventTypeExpiredGroup       = "expired_group"
+	EventTypeSubmitDKGRound1    = "submit_dkg_round1"
+	EventTypeRound1Success      = "round1_success"
+	EventTypeSubmitDKGRound2    = "submit_dkg_round2"
+	EventTypeRound2Success      = "round2_success"
+	EventTypeComplainSuccess    = "complain_success"
+	EventTypeComplainFailed     = "complain_failed"
+	EventTypeConfirmSuccess     = "confirm_success"
+	EventTypeRound3Success      = "round3_success"
+	EventTypeRound3Failed       = "round3_failed"
+	EventTypeRequestSignature   = "request_signature"
+	EventTypeCreateSigning      = "create_signing_request"
+	EventTypeSigningSuccess     = "signing_success"
+	EventTypeSigningFailed      = "signing_failed"
+	EventTypeSubmitSignature    = "submit_signature"
+	EventTypeDEDeleted          = "de_deleted"
+	EventTypeSetMemberIsActive  = "set_member_is_active"
+	EventTypeAddBatchSigning    = "add_batch_signing"
+	EventTypeCreateBatchSigning = "create_batch_signing"
 
 	AttributeKeyGroupID        = "group_id"
 	AttributeKeyMemberID       = "member_id"
@@ -29,6 +31,8 @@ const (
 	AttributeKeyStatus         = "status"
 	AttributeKeyDKGContext     = "dkg_context"
 	AttributeKeyModuleOwner    = "module_owner"
+	AttributeKeySourceGroupID  = "source_group_id"
+	AttributeKeyCurve          = "curve"
 	AttributeKeyRound1Info     = "round1_info"
 	AttributeKeyRound2Info     = "round2_info"
 	AttributeKeyComplainantID  = "complainant_id"
@@ -50,4 +54,6 @@ const (
 	AttributeKeyOriginator     = "originator"
 	AttributeKeyOriginatorType = "originator_type"
 	AttributeKeyMemberStatus   = "member_status"
+	AttributeKeyBatchSigningID = "batch_signing_id"
+	AttributeKeyMerkleRoot     = "merkle_root"
 )
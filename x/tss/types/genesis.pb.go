@@ -113,6 +113,9 @@ type Params struct {
 	MaxMemoLength uint64 `protobuf:"varint,6,opt,name=max_memo_length,json=maxMemoLength,proto3" json:"max_memo_length,omitempty"`
 	// max_message_length is the maximum length of the message in the TextSignatureOrder.
 	MaxMessageLength uint64 `protobuf:"varint,7,opt,name=max_message_length,json=maxMessageLength,proto3" json:"max_message_length,omitempty"`
+	// batch_signing_window is the number of blocks that a batch collects signing requests before they
+	// are signed together over a merkle root. Zero disables batching.
+	BatchSigningWindow uint64 `protobuf:"varint,8,opt,name=batch_signing_window,json=batchSigningWindow,proto3" json:"batch_signing_window,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -197,6 +200,13 @@ func (m *Params) GetMaxMessageLength() uint64 {
 	return 0
 }
 
+func (m *Params) GetBatchSigningWindow() uint64 {
+	if m != nil {
+		return m.BatchSigningWindow
+	}
+	return 0
+}
+
 // DEGenesis defines an account address and de pair used in the tss module's genesis state.
 type DEGenesis struct {
 	// address is the address of the de holder.
@@ -261,41 +271,42 @@ func init() {
 func init() { proto.RegisterFile("band/tss/v1beta1/genesis.proto", fileDescriptor_26d9273eff41c101) }
 
 var fileDescriptor_26d9273eff41c101 = []byte{
-	// 538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x86, 0x63, 0x27, 0x5f, 0xaa, 0x4c, 0xfa, 0xf7, 0x0d, 0x91, 0x30, 0x41, 0x72, 0xaa, 0x88,
-	0x9f, 0x2e, 0x5a, 0x9b, 0xa6, 0x02, 0xa1, 0xee, 0x6a, 0x25, 0x74, 0x43, 0xa4, 0xca, 0xd9, 0xb1,
-	0x89, 0x26, 0xf1, 0x91, 0x63, 0xa9, 0xe3, 0x31, 0x9e, 0x69, 0x65, 0x7a, 0x15, 0x5c, 0x02, 0x17,
-	0xc1, 0x86, 0x3b, 0xe8, 0xb2, 0x62, 0xc5, 0x2a, 0x42, 0xc9, 0x86, 0x3d, 0x37, 0x80, 0xe6, 0x8c,
-	0xcd, 0x82, 0x74, 0x97, 0x9c, 0xe7, 0x79, 0xcf, 0x99, 0xf1, 0x1c, 0xe2, 0xce, 0x58, 0x1a, 0xf9,
-	0x4a, 0x4a, 0xff, 0xe6, 0x64, 0x06, 0x8a, 0x9d, 0xf8, 0x31, 0xa4, 0x20, 0x13, 0xe9, 0x65, 0xb9,
-	0x50, 0x82, 0xee, 0x6b, 0xee, 0x29, 0x29, 0xbd, 0x92, 0x77, 0x3b, 0xb1, 0x88, 0x05, 0x42, 0x5f,
-	0xff, 0x32, 0x5e, 0xf7, 0xc9, 0x5c, 0x48, 0x2e, 0xe4, 0xd4, 0x00, 0xf3, 0xa7, 0x44, 0xdd, 0x8d,
-	0x11, 0xba, 0x1d, 0xb2, 0xfe, 0x6f, 0x8b, 0x6c, 0x5f, 0x98, 0x81, 0x13, 0xc5, 0x14, 0xd0, 0x37,
-	0xa4, 0x99, 0xb1, 0x9c, 0x71, 0xe9, 0x58, 0x07, 0xd6, 0x61, 0x7b, 0xe0, 0x78, 0xff, 0x1e, 0xc0,
-	0xbb, 0x44, 0x1e, 0x34, 0xee, 0x96, 0xbd, 0x5a, 0x58, 0xda, 0xf4, 0x35, 0x69, 0xc6, 0xb9, 0xb8,
-	0xce, 0xa4, 0x63, 0x1f, 0xd4, 0x0f, 0xdb, 0x83, 0xc7, 0x9b, 0xb9, 0x0b, 0xcd, 0xab, 0x98, 0x91,
-	0xe9, 0x5b, 0xb2, 0xc5, 0x81, 0xcf, 0x20, 0x97, 0x4e, 0x1d, 0x73, 0x0f, 0xcc, 0x1b, 0xa3, 0x50,
-	0x06, 0x2b, 0x9d, 0x9e, 0x91, 0x7a, 0x04, 0xd2, 0x69, 0x60, 0xea, 0xe9, 0x66, 0x6a, 0x38, 0x2a,
-	0xef, 0x15, 0xb4, 0x75, 0x70, 0xb5, 0xec, 0xd5, 0x87, 0x23, 0x19, 0xea, 0x50, 0xff, 0x9b, 0x4d,
-	0x9a, 0xe6, 0x16, 0xf4, 0x19, 0xd9, 0xe5, 0xac, 0x98, 0xe2, 0x71, 0xa6, 0x32, 0xb9, 0x05, 0xbc,
-	0x77, 0x23, 0xdc, 0xe6, 0xac, 0xc0, 0x03, 0x4f, 0x92, 0x5b, 0xa0, 0xc7, 0xa4, 0xad, 0xad, 0x08,
-	0x8c, 0x62, 0x6b, 0x25, 0xd8, 0x59, 0x2d, 0x7b, 0xad, 0x31, 0x2b, 0x86, 0x23, 0xed, 0x84, 0x2d,
-	0xce, 0x8a, 0x21, 0xa0, 0xfe, 0x92, 0xec, 0xcd, 0x73, 0x60, 0x2a, 0x11, 0xe9, 0x34, 0x83, 0x3c,
-	0x11, 0x91, 0x53, 0xc7, 0xae, 0xbb, 0x55, 0xf9, 0x12, 0xab, 0xf4, 0x39, 0xd9, 0x95, 0x49, 0x9c,
-	0x26, 0x69, 0x5c, 0x79, 0x0d, 0xf4, 0x76, 0xca, 0x6a, 0xa9, 0x79, 0xe4, 0x91, 0x1e, 0x5f, 0xa9,
-	0x4c, 0x29, 0xe0, 0x99, 0x72, 0xfe, 0x43, 0xf7, 0x7f, 0xce, 0x8a, 0x89, 0x21, 0xe7, 0x06, 0xd0,
-	0x17, 0x64, 0x4f, 0xfb, 0x1c, 0xb8, 0x98, 0x5e, 0x41, 0x1a, 0xab, 0x85, 0xd3, 0x34, 0x7d, 0x39,
-	0x2b, 0xc6, 0xc0, 0xc5, 0x7b, 0x2c, 0xd2, 0x23, 0x42, 0x8d, 0x27, 0x25, 0x8b, 0xa1, 0x52, 0xb7,
-	0x50, 0xdd, 0x47, 0x15, 0x81, 0xb1, 0xcf, 0x1a, 0xbf, 0xbe, 0xf4, 0xac, 0xfe, 0x47, 0xd2, 0xfa,
-	0xfb, 0x69, 0xe9, 0x80, 0x6c, 0xb1, 0x28, 0xca, 0x41, 0x9a, 0x75, 0x69, 0x05, 0xce, 0xf7, 0xaf,
-	0xc7, 0x9d, 0x72, 0xfb, 0xce, 0x0d, 0x99, 0xa8, 0x3c, 0x49, 0xe3, 0xb0, 0x12, 0xe9, 0x2b, 0x62,
-	0x47, 0xe6, 0x13, 0xb6, 0x07, 0x9d, 0x87, 0xde, 0x2d, 0x20, 0xe5, 0x83, 0xd9, 0xc3, 0x51, 0x68,
-	0x47, 0x10, 0xbc, 0xbb, 0x5b, 0xb9, 0xd6, 0xfd, 0xca, 0xb5, 0x7e, 0xae, 0x5c, 0xeb, 0xf3, 0xda,
-	0xad, 0xdd, 0xaf, 0xdd, 0xda, 0x8f, 0xb5, 0x5b, 0xfb, 0x70, 0x14, 0x27, 0x6a, 0x71, 0x3d, 0xf3,
-	0xe6, 0x82, 0xfb, 0xba, 0x13, 0x2e, 0xf5, 0x5c, 0x5c, 0xf9, 0xf3, 0x05, 0x4b, 0x52, 0xff, 0xe6,
-	0xd4, 0x2f, 0x70, 0xf1, 0xd5, 0xa7, 0x0c, 0xe4, 0xac, 0x89, 0xf8, 0xf4, 0x4f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xde, 0xb5, 0xb6, 0x84, 0x74, 0x03, 0x00, 0x00,
+	// 559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x86, 0x63, 0x3b, 0x5f, 0xf2, 0x65, 0xd2, 0xa6, 0x65, 0x88, 0x84, 0x09, 0x92, 0x13, 0x45,
+	0xfc, 0x74, 0xd1, 0xda, 0x6d, 0x2a, 0x10, 0xea, 0xae, 0x56, 0x42, 0x37, 0x44, 0xaa, 0x9c, 0x05,
+	0x12, 0x9b, 0x68, 0x6c, 0x8f, 0x1c, 0x4b, 0x1d, 0x8f, 0xf1, 0x4c, 0x5b, 0xd3, 0xab, 0xe0, 0x12,
+	0xb8, 0x08, 0x2e, 0xa2, 0xcb, 0x8a, 0x15, 0xab, 0x08, 0x25, 0x1b, 0x24, 0x96, 0xdc, 0x00, 0x9a,
+	0x1f, 0x77, 0x41, 0xba, 0x4b, 0xce, 0xf3, 0xbc, 0x73, 0xce, 0x78, 0x66, 0x80, 0x13, 0xa2, 0x2c,
+	0xf6, 0x38, 0x63, 0xde, 0xd5, 0x51, 0x88, 0x39, 0x3a, 0xf2, 0x12, 0x9c, 0x61, 0x96, 0x32, 0x37,
+	0x2f, 0x28, 0xa7, 0x70, 0x57, 0x70, 0x97, 0x33, 0xe6, 0x6a, 0xde, 0xeb, 0x26, 0x34, 0xa1, 0x12,
+	0x7a, 0xe2, 0x97, 0xf2, 0x7a, 0x4f, 0x23, 0xca, 0x08, 0x65, 0x73, 0x05, 0xd4, 0x1f, 0x8d, 0x7a,
+	0x1b, 0x2d, 0x38, 0xd3, 0x6c, 0xf8, 0xc7, 0x00, 0x5b, 0x67, 0xaa, 0xe1, 0x8c, 0x23, 0x8e, 0xe1,
+	0x1b, 0xd0, 0xc8, 0x51, 0x81, 0x08, 0xb3, 0x8d, 0x81, 0xb1, 0xd7, 0x1e, 0xd9, 0xee, 0xbf, 0x03,
+	0xb8, 0xe7, 0x92, 0xfb, 0xf5, 0xdb, 0x65, 0xbf, 0x16, 0x68, 0x1b, 0xbe, 0x06, 0x8d, 0xa4, 0xa0,
+	0x97, 0x39, 0xb3, 0xcd, 0x81, 0xb5, 0xd7, 0x1e, 0x3d, 0xd9, 0xcc, 0x9d, 0x09, 0x5e, 0xc5, 0x94,
+	0x0c, 0xdf, 0x82, 0x26, 0xc1, 0x24, 0xc4, 0x05, 0xb3, 0xad, 0x81, 0xf5, 0x70, 0xbf, 0xa9, 0x14,
+	0x74, 0xb0, 0xd2, 0xe1, 0x09, 0xb0, 0x62, 0xcc, 0xec, 0xba, 0x4c, 0x3d, 0xdb, 0x4c, 0x8d, 0x27,
+	0x7a, 0x5f, 0x7e, 0x5b, 0x04, 0x57, 0xcb, 0xbe, 0x35, 0x9e, 0xb0, 0x40, 0x84, 0x86, 0xbf, 0x4d,
+	0xd0, 0x50, 0xbb, 0x80, 0xcf, 0x41, 0x87, 0xa0, 0x72, 0x2e, 0xc7, 0x99, 0xb3, 0xf4, 0x06, 0xcb,
+	0x7d, 0xd7, 0x83, 0x2d, 0x82, 0x4a, 0x39, 0xf0, 0x2c, 0xbd, 0xc1, 0xf0, 0x00, 0xb4, 0x85, 0x15,
+	0x63, 0xa5, 0x98, 0x42, 0xf1, 0xb7, 0x57, 0xcb, 0x7e, 0x6b, 0x8a, 0xca, 0xf1, 0x44, 0x38, 0x41,
+	0x8b, 0xa0, 0x72, 0x8c, 0xa5, 0xfe, 0x0a, 0xec, 0x44, 0x05, 0x46, 0x3c, 0xa5, 0xd9, 0x3c, 0xc7,
+	0x45, 0x4a, 0x63, 0xdb, 0x92, 0xab, 0x76, 0xaa, 0xf2, 0xb9, 0xac, 0xc2, 0x17, 0xa0, 0xc3, 0xd2,
+	0x24, 0x4b, 0xb3, 0xa4, 0xf2, 0xea, 0xd2, 0xdb, 0xd6, 0x55, 0xad, 0xb9, 0xe0, 0xb1, 0x68, 0x5f,
+	0xa9, 0x88, 0x73, 0x4c, 0x72, 0x6e, 0xff, 0x27, 0xdd, 0x47, 0x04, 0x95, 0x33, 0x45, 0x4e, 0x15,
+	0x80, 0x2f, 0xc1, 0x8e, 0xf0, 0x09, 0x26, 0x74, 0x7e, 0x81, 0xb3, 0x84, 0x2f, 0xec, 0x86, 0x5a,
+	0x97, 0xa0, 0x72, 0x8a, 0x09, 0x7d, 0x2f, 0x8b, 0x70, 0x1f, 0x40, 0xe5, 0x31, 0x86, 0x12, 0x5c,
+	0xa9, 0x4d, 0xa9, 0xee, 0x4a, 0x55, 0x02, 0x6d, 0x1f, 0x82, 0x6e, 0x88, 0x78, 0xb4, 0xb8, 0x9f,
+	0xe3, 0x3a, 0xcd, 0x62, 0x7a, 0x6d, 0xff, 0x2f, 0x7d, 0x28, 0x99, 0x1e, 0xe4, 0x83, 0x24, 0x27,
+	0xf5, 0x5f, 0x5f, 0xfb, 0xc6, 0xf0, 0x13, 0x68, 0xdd, 0x1f, 0x06, 0x1c, 0x81, 0x26, 0x8a, 0xe3,
+	0x02, 0x33, 0x75, 0xc1, 0x5a, 0xbe, 0xfd, 0xfd, 0xdb, 0x41, 0x57, 0xdf, 0xd7, 0x53, 0x45, 0x66,
+	0xbc, 0x48, 0xb3, 0x24, 0xa8, 0x44, 0x78, 0x08, 0xcc, 0x58, 0x7d, 0xf4, 0xf6, 0xa8, 0xfb, 0xd0,
+	0x49, 0xfb, 0x40, 0x1f, 0xb1, 0x39, 0x9e, 0x04, 0x66, 0x8c, 0xfd, 0x77, 0xb7, 0x2b, 0xc7, 0xb8,
+	0x5b, 0x39, 0xc6, 0xcf, 0x95, 0x63, 0x7c, 0x59, 0x3b, 0xb5, 0xbb, 0xb5, 0x53, 0xfb, 0xb1, 0x76,
+	0x6a, 0x1f, 0xf7, 0x93, 0x94, 0x2f, 0x2e, 0x43, 0x37, 0xa2, 0xc4, 0x13, 0x2b, 0xc9, 0x67, 0x10,
+	0xd1, 0x0b, 0x2f, 0x5a, 0xa0, 0x34, 0xf3, 0xae, 0x8e, 0xbd, 0x52, 0x3e, 0x15, 0xfe, 0x39, 0xc7,
+	0x2c, 0x6c, 0x48, 0x7c, 0xfc, 0x77, 0x00, 0xc0, 0x0d, 0x01, 0xfd, 0xa6, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -338,6 +349,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.MaxMessageLength != that1.MaxMessageLength {
 		return false
 	}
+	if this.BatchSigningWindow != that1.BatchSigningWindow {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -435,6 +449,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BatchSigningWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchSigningWindow))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.MaxMessageLength != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxMessageLength))
 		i--
@@ -580,6 +599,9 @@ func (m *Params) Size() (n int) {
 	if m.MaxMessageLength != 0 {
 		n += 1 + sovGenesis(uint64(m.MaxMessageLength))
 	}
+	if m.BatchSigningWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.BatchSigningWindow))
+	}
 	return n
 }
 
@@ -951,6 +973,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSigningWindow", wireType)
+			}
+			m.BatchSigningWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSigningWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -36,3 +36,10 @@ func EncodeSigning(
 		contentMsg,
 	}, []byte(""))
 }
+
+// EncodeBatchContent forms a content message of a batch signing from the merkle root of its
+// signings. It is prefixed with a selector in the same way as the content of a signature order.
+func EncodeBatchContent(root []byte) []byte {
+	selector := tss.Hash([]byte(BatchSigningPath))[:4]
+	return append(selector, root...)
+}
@@ -56,6 +56,15 @@ var (
 	PartialSignatureStoreKeyPrefix      = []byte{0x1f}
 	SigningAttemptStoreKeyPrefix        = []byte{0x20}
 
+	// store prefixes for DE of Ed25519 groups
+	ED25519DEStoreKeyPrefix      = []byte{0x21}
+	ED25519DEQueueStoreKeyPrefix = []byte{0x22}
+
+	// store prefixes for batch signing
+	PendingBatchStoreKeyPrefix   = []byte{0x23}
+	BatchSigningStoreKeyPrefix   = []byte{0x24}
+	BatchItemProofStoreKeyPrefix = []byte{0x25}
+
 	// param store key
 	ParamsKey = []byte{0x90}
 )
@@ -161,20 +170,37 @@ func ConfirmComplainCountStoreKey(groupID tss.GroupID) []byte {
 // DE
 // ====================================
 
-// DEsStoreKey returns the prefix of the key for user's DE.
-func DEsStoreKey(address sdk.AccAddress) []byte {
-	return append(DEStoreKeyPrefix, sdkaddress.MustLengthPrefix(address)...)
+// DEStoreKeyPrefixByCurve returns the store prefix of DEs on the given curve. Each curve has its own
+// DE queue, as a DE can only be used to sign for a group on the same curve.
+func DEStoreKeyPrefixByCurve(curve Curve) []byte {
+	if curve == CURVE_ED25519 {
+		return ED25519DEStoreKeyPrefix
+	}
+	return DEStoreKeyPrefix
+}
+
+// DEQueueStoreKeyPrefixByCurve returns the store prefix of the DE queues on the given curve.
+func DEQueueStoreKeyPrefixByCurve(curve Curve) []byte {
+	if curve == CURVE_ED25519 {
+		return ED25519DEQueueStoreKeyPrefix
+	}
+	return DEQueueStoreKeyPrefix
+}
+
+// DEsStoreKey returns the prefix of the key for user's DE on the given curve.
+func DEsStoreKey(curve Curve, address sdk.AccAddress) []byte {
+	return append(DEStoreKeyPrefixByCurve(curve), sdkaddress.MustLengthPrefix(address)...)
 }
 
 // DEStoreKey returns the key for storing whether DE exists or not.
-func DEStoreKey(address sdk.AccAddress, index uint64) []byte {
-	return append(DEsStoreKey(address), sdk.Uint64ToBigEndian(index)...)
+func DEStoreKey(curve Curve, address sdk.AccAddress, index uint64) []byte {
+	return append(DEsStoreKey(curve, address), sdk.Uint64ToBigEndian(index)...)
 }
 
 // DEQueueStoreKey returns the key for storing the queue information (head and tail index)
-// of DE of specific address.
-func DEQueueStoreKey(address sdk.AccAddress) []byte {
-	return append(DEQueueStoreKeyPrefix, sdkaddress.MustLengthPrefix(address)...)
+// of DE of specific address on the given curve.
+func DEQueueStoreKey(curve Curve, address sdk.AccAddress) []byte {
+	return append(DEQueueStoreKeyPrefixByCurve(curve), sdkaddress.MustLengthPrefix(address)...)
 }
 
 // ====================================
@@ -221,6 +247,21 @@ func SigningAttemptStoreKey(signingID tss.SigningID, attempt uint64) []byte {
 	return append(SigningAttemptsStoreKey(signingID), sdk.Uint64ToBigEndian(attempt)...)
 }
 
+// PendingBatchStoreKey returns the key for storing the pending batch of a given group.
+func PendingBatchStoreKey(groupID tss.GroupID) []byte {
+	return append(PendingBatchStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(groupID))...)
+}
+
+// BatchSigningStoreKey returns the key for storing batch signing information.
+func BatchSigningStoreKey(signingID tss.SigningID) []byte {
+	return append(BatchSigningStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(signingID))...)
+}
+
+// BatchItemProofStoreKey returns the key for storing the merkle proof of a batched signing.
+func BatchItemProofStoreKey(signingID tss.SigningID) []byte {
+	return append(BatchItemProofStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(signingID))...)
+}
+
 // MemberIDFromPartialSignatureStoreKey returns the memberID that is retrieved from the key.
 func MemberIDFromPartialSignatureStoreKey(key []byte) tss.MemberID {
 	kv.AssertKeyLength(key, 1+3*uint64Len)
@@ -39,7 +39,7 @@ func (m Member) Validate() error {
 		return ErrInvalidMember.Wrapf("invalid member address: %v", err)
 	}
 
-	if err := m.PubKey.Validate(); err != nil {
+	if err := ValidatePointOnAnyCurve(m.PubKey); err != nil {
 		return ErrInvalidMember.Wrapf("invalid member public key: %v", err)
 	}
 
@@ -59,6 +59,18 @@ func (ms Members) GetIDs() []tss.MemberID {
 	return mids
 }
 
+// GetSourceIDs returns an array of MemberIDs in the source group of the members that deal in a reshared group
+func (ms Members) GetSourceIDs() []tss.MemberID {
+	var mids []tss.MemberID
+	for _, member := range ms {
+		if member.SourceMemberID != 0 {
+			mids = append(mids, member.SourceMemberID)
+		}
+	}
+
+	return mids
+}
+
 // HaveMalicious checks if any member in the collection is marked as malicious
 func (ms Members) HaveMalicious() bool {
 	for _, m := range ms {
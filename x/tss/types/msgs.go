@@ -172,7 +172,7 @@ func (m MsgConfirm) ValidateBasic() error {
 	}
 
 	// Validate own pub key sig
-	if err := m.OwnPubKeySig.Validate(); err != nil {
+	if err := ValidateSignatureOnAnyCurve(m.OwnPubKeySig); err != nil {
 		return ErrInvalidPublicKey.Wrapf("invalid own public key signature: %s", err)
 	}
 
@@ -271,7 +271,7 @@ func (m MsgSubmitSignature) ValidateBasic() error {
 	}
 
 	// Validate member signature
-	if err := m.Signature.Validate(); err != nil {
+	if err := ValidateSignatureOnAnyCurve(m.Signature); err != nil {
 		return ErrInvalidSignature.Wrapf("invalid signature :%s", err)
 	}
 
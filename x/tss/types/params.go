@@ -5,13 +5,14 @@ import (
 )
 
 const (
-	DefaultMaxGroupSize      = uint64(20)
-	DefaultMaxDESize         = uint64(600)
-	DefaultCreationPeriod    = uint64(43200) // 12 hours
-	DefaultSigningPeriod     = uint64(100)
-	DefaultMaxSigningAttempt = uint64(5)
-	DefaultMaxMemoLength     = uint64(100)
-	DefaultMaxMessageLength  = uint64(1000)
+	DefaultMaxGroupSize       = uint64(20)
+	DefaultMaxDESize          = uint64(600)
+	DefaultCreationPeriod     = uint64(43200) // 12 hours
+	DefaultSigningPeriod      = uint64(100)
+	DefaultMaxSigningAttempt  = uint64(5)
+	DefaultMaxMemoLength      = uint64(100)
+	DefaultMaxMessageLength   = uint64(1000)
+	DefaultBatchSigningWindow = uint64(0) // batching is disabled by default
 )
 
 // NewParams creates a new Params instance
@@ -23,15 +24,17 @@ func NewParams(
 	maxSigningAttempt uint64,
 	maxMemoLength uint64,
 	maxMessageLength uint64,
+	batchSigningWindow uint64,
 ) Params {
 	return Params{
-		MaxGroupSize:      maxGroupSize,
-		MaxDESize:         maxDESize,
-		CreationPeriod:    creatingPeriod,
-		SigningPeriod:     signingPeriod,
-		MaxSigningAttempt: maxSigningAttempt,
-		MaxMemoLength:     maxMemoLength,
-		MaxMessageLength:  maxMessageLength,
+		MaxGroupSize:       maxGroupSize,
+		MaxDESize:          maxDESize,
+		CreationPeriod:     creatingPeriod,
+		SigningPeriod:      signingPeriod,
+		MaxSigningAttempt:  maxSigningAttempt,
+		MaxMemoLength:      maxMemoLength,
+		MaxMessageLength:   maxMessageLength,
+		BatchSigningWindow: batchSigningWindow,
 	}
 }
 
@@ -45,6 +48,7 @@ func DefaultParams() Params {
 		DefaultMaxSigningAttempt,
 		DefaultMaxMemoLength,
 		DefaultMaxMessageLength,
+		DefaultBatchSigningWindow,
 	)
 }
 
@@ -62,6 +66,7 @@ func (p Params) Validate() error {
 		{"max signing attempt", p.MaxSigningAttempt, false},
 		{"max memo length", p.MaxMemoLength, true},
 		{"max message length", p.MaxMessageLength, true},
+		{"batch signing window", p.BatchSigningWindow, false},
 	}
 
 	for _, f := range fields {
@@ -515,6 +515,8 @@ type QueryDERequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// pagination defines pagination settings for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// curve is the curve of the DEs to query.
+	Curve Curve `protobuf:"varint,3,opt,name=curve,proto3,enum=band.tss.v1beta1.Curve" json:"curve,omitempty"`
 }
 
 func (m *QueryDERequest) Reset()         { *m = QueryDERequest{} }
@@ -564,6 +566,13 @@ func (m *QueryDERequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryDERequest) GetCurve() Curve {
+	if m != nil {
+		return m.Curve
+	}
+	return CURVE_SECP256K1
+}
+
 // QueryDEResponse is the response type for the Query/DE RPC method.
 type QueryDEResponse struct {
 	// des is a list of DEs.
@@ -895,6 +904,107 @@ func (m *QuerySigningResponse) GetSigningResult() SigningResult {
 	return SigningResult{}
 }
 
+// QueryBatchProofRequest is the request type for the Query/BatchProof RPC method.
+type QueryBatchProofRequest struct {
+	// signing_id is the ID of the signing included in a batch.
+	SigningId uint64 `protobuf:"varint,1,opt,name=signing_id,json=signingId,proto3" json:"signing_id,omitempty"`
+}
+
+func (m *QueryBatchProofRequest) Reset()         { *m = QueryBatchProofRequest{} }
+func (m *QueryBatchProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBatchProofRequest) ProtoMessage()    {}
+func (*QueryBatchProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_297ba1d2a9889c1d, []int{18}
+}
+func (m *QueryBatchProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBatchProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBatchProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBatchProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBatchProofRequest.Merge(m, src)
+}
+func (m *QueryBatchProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBatchProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBatchProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBatchProofRequest proto.InternalMessageInfo
+
+func (m *QueryBatchProofRequest) GetSigningId() uint64 {
+	if m != nil {
+		return m.SigningId
+	}
+	return 0
+}
+
+// QueryBatchProofResponse is the response type for the Query/BatchProof RPC method.
+type QueryBatchProofResponse struct {
+	// proof is the merkle inclusion proof of the signing's message in the batch.
+	Proof BatchItemProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
+	// batch_signing_result is the result of the signing over the merkle root.
+	BatchSigningResult SigningResult `protobuf:"bytes,2,opt,name=batch_signing_result,json=batchSigningResult,proto3" json:"batch_signing_result"`
+}
+
+func (m *QueryBatchProofResponse) Reset()         { *m = QueryBatchProofResponse{} }
+func (m *QueryBatchProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBatchProofResponse) ProtoMessage()    {}
+func (*QueryBatchProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_297ba1d2a9889c1d, []int{19}
+}
+func (m *QueryBatchProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBatchProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBatchProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBatchProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBatchProofResponse.Merge(m, src)
+}
+func (m *QueryBatchProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBatchProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBatchProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBatchProofResponse proto.InternalMessageInfo
+
+func (m *QueryBatchProofResponse) GetProof() BatchItemProof {
+	if m != nil {
+		return m.Proof
+	}
+	return BatchItemProof{}
+}
+
+func (m *QueryBatchProofResponse) GetBatchSigningResult() SigningResult {
+	if m != nil {
+		return m.BatchSigningResult
+	}
+	return SigningResult{}
+}
+
 // QuerySigningsRequest is the request type for the Query/Signings RPC method.
 type QuerySigningsRequest struct {
 	// pagination defines pagination settings for the request.
@@ -905,7 +1015,7 @@ func (m *QuerySigningsRequest) Reset()         { *m = QuerySigningsRequest{} }
 func (m *QuerySigningsRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningsRequest) ProtoMessage()    {}
 func (*QuerySigningsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_297ba1d2a9889c1d, []int{18}
+	return fileDescriptor_297ba1d2a9889c1d, []int{20}
 }
 func (m *QuerySigningsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -953,7 +1063,7 @@ func (m *QuerySigningsResponse) Reset()         { *m = QuerySigningsResponse{} }
 func (m *QuerySigningsResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningsResponse) ProtoMessage()    {}
 func (*QuerySigningsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_297ba1d2a9889c1d, []int{19}
+	return fileDescriptor_297ba1d2a9889c1d, []int{21}
 }
 func (m *QuerySigningsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1004,7 +1114,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_297ba1d2a9889c1d, []int{20}
+	return fileDescriptor_297ba1d2a9889c1d, []int{22}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1043,7 +1153,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_297ba1d2a9889c1d, []int{21}
+	return fileDescriptor_297ba1d2a9889c1d, []int{23}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1098,6 +1208,8 @@ func init() {
 	proto.RegisterType((*QueryPendingSigningsResponse)(nil), "band.tss.v1beta1.QueryPendingSigningsResponse")
 	proto.RegisterType((*QuerySigningRequest)(nil), "band.tss.v1beta1.QuerySigningRequest")
 	proto.RegisterType((*QuerySigningResponse)(nil), "band.tss.v1beta1.QuerySigningResponse")
+	proto.RegisterType((*QueryBatchProofRequest)(nil), "band.tss.v1beta1.QueryBatchProofRequest")
+	proto.RegisterType((*QueryBatchProofResponse)(nil), "band.tss.v1beta1.QueryBatchProofResponse")
 	proto.RegisterType((*QuerySigningsRequest)(nil), "band.tss.v1beta1.QuerySigningsRequest")
 	proto.RegisterType((*QuerySigningsResponse)(nil), "band.tss.v1beta1.QuerySigningsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "band.tss.v1beta1.QueryParamsRequest")
@@ -1107,76 +1219,82 @@ func init() {
 func init() { proto.RegisterFile("band/tss/v1beta1/query.proto", fileDescriptor_297ba1d2a9889c1d) }
 
 var fileDescriptor_297ba1d2a9889c1d = []byte{
-	// 1093 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4f, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xc7, 0xb3, 0x4e, 0x6a, 0x27, 0x4f, 0x9a, 0xa4, 0xbf, 0x69, 0xf2, 0x23, 0xdd, 0x26, 0x76,
-	0xd8, 0x26, 0x71, 0x68, 0x9b, 0x5d, 0x9a, 0xd0, 0x96, 0x73, 0xea, 0x24, 0x44, 0x50, 0x29, 0x2c,
-	0x37, 0x40, 0x44, 0x6b, 0xef, 0x68, 0xbb, 0xc2, 0xde, 0xdd, 0xec, 0xac, 0x2b, 0xda, 0xca, 0x07,
-	0x10, 0x07, 0x6e, 0x20, 0x55, 0x1c, 0x38, 0x72, 0xe5, 0x05, 0xf0, 0x1a, 0x7a, 0xac, 0xc4, 0x85,
-	0x53, 0x84, 0x12, 0x5e, 0x05, 0x27, 0xb4, 0x33, 0xcf, 0xac, 0x3d, 0xb6, 0xd7, 0x8e, 0x50, 0x6e,
-	0xde, 0x79, 0xfe, 0x7d, 0xe6, 0x99, 0x99, 0xe7, 0x6b, 0x58, 0xa9, 0x3b, 0x81, 0x6b, 0x25, 0x8c,
-	0x59, 0xcf, 0x1f, 0xd4, 0x69, 0xe2, 0x3c, 0xb0, 0x4e, 0xdb, 0x34, 0x7e, 0x61, 0x46, 0x71, 0x98,
-	0x84, 0xe4, 0x46, 0x6a, 0x35, 0x13, 0xc6, 0x4c, 0xb4, 0xea, 0x8b, 0x5e, 0xe8, 0x85, 0xdc, 0x68,
-	0xa5, 0xbf, 0x84, 0x9f, 0x7e, 0xb7, 0x11, 0xb2, 0x56, 0xc8, 0xac, 0xba, 0xc3, 0xa8, 0x48, 0x90,
-	0xa5, 0x8b, 0x1c, 0xcf, 0x0f, 0x9c, 0xc4, 0x0f, 0x03, 0xf4, 0x5d, 0xf1, 0xc2, 0xd0, 0x6b, 0x52,
-	0xcb, 0x89, 0x7c, 0xcb, 0x09, 0x82, 0x30, 0xe1, 0x46, 0x86, 0x56, 0x7d, 0x80, 0x27, 0xad, 0x2e,
-	0x6c, 0xe5, 0x01, 0x9b, 0x47, 0x03, 0xca, 0x7c, 0xb4, 0x1b, 0x8b, 0x40, 0x3e, 0x4d, 0x6b, 0x3f,
-	0x09, 0xdb, 0x41, 0xc2, 0x6c, 0x7a, 0xda, 0xa6, 0x2c, 0x31, 0xbe, 0x80, 0x9b, 0xca, 0x2a, 0x8b,
-	0xc2, 0x80, 0x51, 0x52, 0x81, 0x59, 0x2f, 0x0e, 0xdb, 0xd1, 0x49, 0x23, 0x5d, 0x5f, 0xd6, 0xd6,
-	0xb4, 0xad, 0x29, 0x1b, 0xf8, 0x12, 0xf7, 0x24, 0x77, 0x60, 0x8e, 0xf9, 0x5e, 0xe0, 0x07, 0x1e,
-	0xba, 0x14, 0xb8, 0xcb, 0x75, 0x5c, 0xe4, 0x4e, 0x86, 0x09, 0xff, 0xe3, 0xc9, 0x0f, 0xd3, 0x38,
-	0xac, 0x48, 0x6e, 0xc1, 0xb4, 0x48, 0xed, 0xbb, 0x98, 0xb7, 0xc4, 0xbf, 0x8f, 0x5c, 0xe3, 0x4b,
-	0x44, 0x44, 0x7f, 0x64, 0x39, 0x80, 0xeb, 0x22, 0x20, 0xa6, 0xac, 0xdd, 0x14, 0x30, 0xb3, 0x3b,
-	0xab, 0x66, 0x7f, 0xf7, 0x4d, 0x19, 0xd6, 0x6e, 0x26, 0x7b, 0x53, 0x6f, 0xce, 0x2a, 0x13, 0xb6,
-	0xd8, 0x84, 0x58, 0x52, 0xb3, 0xcb, 0x06, 0x90, 0x03, 0x80, 0xee, 0x21, 0x60, 0xee, 0x4d, 0x53,
-	0x9c, 0x98, 0x99, 0x9e, 0x98, 0x29, 0x8e, 0x5c, 0x16, 0x39, 0x76, 0x3c, 0x8a, 0xb1, 0x76, 0x4f,
-	0xa4, 0xf1, 0xb3, 0x86, 0x9d, 0x94, 0xe9, 0x91, 0xfe, 0x21, 0x14, 0x39, 0x04, 0x5b, 0xd6, 0xd6,
-	0x26, 0xc7, 0x72, 0xdb, 0xe8, 0x4c, 0x0e, 0x15, 0xac, 0x02, 0xc7, 0xaa, 0x8e, 0xc5, 0x12, 0x35,
-	0x15, 0xae, 0xf7, 0x11, 0xeb, 0x29, 0x6d, 0xd5, 0x69, 0xcc, 0x2e, 0x71, 0x0a, 0xc7, 0xb0, 0xa8,
-	0x46, 0xe0, 0x4e, 0x3e, 0x84, 0x52, 0x4b, 0x2c, 0xe1, 0x56, 0x96, 0x07, 0xb7, 0x22, 0x62, 0xb0,
-	0xfb, 0xd2, 0xdd, 0xf8, 0x18, 0x96, 0x78, 0xc6, 0x23, 0x76, 0x18, 0x3b, 0x41, 0x42, 0x65, 0x03,
-	0xc9, 0x32, 0x94, 0x3c, 0xbe, 0x12, 0x73, 0x88, 0x19, 0x5b, 0x7e, 0x76, 0x2d, 0x94, 0x6f, 0x3e,
-	0xb3, 0x50, 0xe3, 0x31, 0xfc, 0xbf, 0x3f, 0x19, 0x02, 0xae, 0x02, 0xf8, 0xec, 0x44, 0x86, 0xa5,
-	0x09, 0xa7, 0xed, 0x19, 0x5f, 0xba, 0x19, 0x31, 0xcc, 0xf3, 0xc0, 0xda, 0x7e, 0x4f, 0x79, 0xc7,
-	0x75, 0x63, 0xca, 0x98, 0x2c, 0x8f, 0x9f, 0x7d, 0xb7, 0xa2, 0xf0, 0x9f, 0x6f, 0xc5, 0x8f, 0x1a,
-	0x2c, 0x64, 0x45, 0x11, 0x73, 0x17, 0x26, 0x5d, 0x2a, 0x7b, 0xb8, 0x38, 0xd8, 0xc3, 0xda, 0xfe,
-	0xde, 0x6c, 0xda, 0xbf, 0xf3, 0xb3, 0xca, 0x64, 0x6d, 0x9f, 0xd9, 0xa9, 0xf7, 0xd5, 0xdd, 0x87,
-	0x87, 0x70, 0x8b, 0x03, 0x1d, 0xd3, 0xc0, 0xf5, 0x03, 0x4f, 0x7d, 0x0c, 0xb9, 0x0d, 0x31, 0x9e,
-	0x80, 0x3e, 0x2c, 0x0c, 0xb7, 0xb4, 0x01, 0xf3, 0x91, 0x30, 0x9c, 0xf4, 0x5c, 0xf6, 0x29, 0x7b,
-	0x2e, 0xea, 0x75, 0x37, 0x1e, 0xc3, 0xed, 0xde, 0x24, 0x9f, 0x89, 0x59, 0x71, 0x89, 0xea, 0xdf,
-	0x6a, 0xb0, 0x32, 0x3c, 0x12, 0x01, 0x1c, 0xb8, 0x21, 0x01, 0x70, 0x02, 0x21, 0xc2, 0xde, 0xa3,
-	0x7f, 0xce, 0x2a, 0x3b, 0x9e, 0x9f, 0x3c, 0x6b, 0xd7, 0xcd, 0x46, 0xd8, 0xb2, 0xd2, 0x76, 0xf3,
-	0x81, 0xd8, 0x08, 0x9b, 0x56, 0xe3, 0x99, 0xe3, 0x07, 0xd6, 0xf3, 0x5d, 0x2b, 0xfa, 0xda, 0xe3,
-	0xa3, 0x14, 0x13, 0x1f, 0xd5, 0xec, 0x85, 0x48, 0x2d, 0x65, 0x7c, 0x80, 0x0f, 0x09, 0x17, 0x24,
-	0xf4, 0x2a, 0x80, 0x1c, 0x84, 0xd9, 0x53, 0x9a, 0xc1, 0x95, 0x23, 0xd7, 0x70, 0xf1, 0x31, 0x65,
-	0x51, 0x08, 0xfc, 0x09, 0xcc, 0xcb, 0x30, 0x65, 0xac, 0x55, 0x06, 0xef, 0x43, 0x37, 0xb4, 0x3b,
-	0xd8, 0xe4, 0xf0, 0xc5, 0xd1, 0xf6, 0x95, 0x5a, 0xe5, 0xca, 0x87, 0xdb, 0x6f, 0x1a, 0xbe, 0xe0,
-	0x81, 0xc6, 0x7f, 0x04, 0x0b, 0xea, 0x3e, 0xe4, 0xc5, 0x1e, 0xb7, 0x11, 0x7b, 0x5e, 0xd9, 0xc2,
-	0x15, 0xde, 0x70, 0x29, 0x74, 0xc7, 0x4e, 0xec, 0xb4, 0x32, 0xa1, 0x7b, 0x8a, 0xc7, 0x27, 0x57,
-	0x91, 0xff, 0x11, 0x14, 0x23, 0xbe, 0x82, 0xdd, 0x19, 0x32, 0xd3, 0x44, 0x04, 0x36, 0x1e, 0xbd,
-	0x77, 0x7e, 0x9f, 0x85, 0x6b, 0x3c, 0x1f, 0x89, 0xa1, 0x28, 0xc4, 0x93, 0xac, 0x0f, 0xc6, 0x0e,
-	0x2a, 0xae, 0xbe, 0x31, 0xc6, 0x4b, 0x80, 0x19, 0xb7, 0xbf, 0xfb, 0xe3, 0xef, 0xd7, 0x85, 0x25,
-	0x72, 0x53, 0x91, 0xf4, 0x86, 0xa8, 0x14, 0x43, 0x51, 0x3c, 0xa9, 0xdc, 0x9a, 0xca, 0xbb, 0xce,
-	0xad, 0xa9, 0x3e, 0xe3, 0x9c, 0x9a, 0xa8, 0x48, 0x2f, 0xe1, 0x1a, 0x77, 0x27, 0x77, 0x46, 0x25,
-	0x93, 0x15, 0xd7, 0x47, 0x3b, 0x61, 0xc1, 0x4d, 0x5e, 0x70, 0x8d, 0x94, 0x87, 0x14, 0xb4, 0x5e,
-	0x49, 0x81, 0xea, 0x90, 0x1f, 0x34, 0x28, 0xa1, 0x1c, 0x91, 0xbc, 0xbd, 0xa8, 0x02, 0xa7, 0x6f,
-	0x8e, 0x73, 0x43, 0x04, 0x93, 0x23, 0x6c, 0x91, 0xcd, 0xd1, 0x08, 0x16, 0x6a, 0x19, 0x79, 0xad,
-	0xc1, 0x4c, 0x26, 0x3d, 0xa4, 0x9a, 0x53, 0xa5, 0x5f, 0xe9, 0xf4, 0xad, 0xf1, 0x8e, 0x08, 0xb4,
-	0xcb, 0x81, 0xb6, 0xc9, 0x3d, 0x05, 0xa8, 0x2b, 0x6c, 0x29, 0x14, 0xd7, 0xc8, 0x8e, 0xfc, 0x45,
-	0x3b, 0xe4, 0x14, 0x0a, 0xb5, 0x7d, 0xb2, 0x96, 0x53, 0x24, 0x53, 0x3c, 0xfd, 0xdd, 0x11, 0x1e,
-	0x23, 0xcf, 0xc4, 0xa5, 0x27, 0x4d, 0x9f, 0x25, 0xd6, 0x2b, 0x1c, 0xc9, 0x1d, 0xf2, 0x8b, 0x06,
-	0x73, 0x8a, 0x1a, 0x90, 0x7b, 0x39, 0xc9, 0x87, 0x49, 0x8d, 0x7e, 0xff, 0x72, 0xce, 0x08, 0xb5,
-	0xcd, 0xa1, 0xaa, 0x64, 0x43, 0x81, 0x52, 0x35, 0xa7, 0x87, 0xed, 0x57, 0x0d, 0x16, 0xfa, 0xa4,
-	0x82, 0x6c, 0x8f, 0x2e, 0xd8, 0x37, 0x3a, 0x75, 0xf3, 0xb2, 0xee, 0x48, 0x68, 0x71, 0xc2, 0xf7,
-	0x48, 0x75, 0x28, 0xa1, 0x14, 0xa5, 0x1e, 0xc6, 0xef, 0x35, 0x28, 0x61, 0x96, 0xdc, 0x3b, 0xad,
-	0x6a, 0x4d, 0xee, 0x9d, 0xee, 0x13, 0x17, 0xe3, 0x2e, 0x67, 0x59, 0x27, 0x86, 0xc2, 0xd2, 0x65,
-	0xe8, 0x0a, 0x56, 0x87, 0xbc, 0x84, 0xe9, 0xac, 0x45, 0x63, 0xf2, 0x67, 0xbd, 0xa9, 0x8e, 0xf5,
-	0x43, 0x90, 0x55, 0x0e, 0xf2, 0x0e, 0x59, 0x1a, 0x0a, 0x92, 0x8e, 0x31, 0x31, 0x5c, 0x73, 0xc7,
-	0x98, 0x32, 0xc3, 0x73, 0xc7, 0x98, 0x3a, 0xd3, 0x73, 0xc6, 0x98, 0x18, 0xdc, 0x7b, 0x07, 0x6f,
-	0xce, 0xcb, 0xda, 0xdb, 0xf3, 0xb2, 0xf6, 0xd7, 0x79, 0x59, 0xfb, 0xe9, 0xa2, 0x3c, 0xf1, 0xf6,
-	0xa2, 0x3c, 0xf1, 0xe7, 0x45, 0x79, 0xe2, 0xf3, 0xfb, 0x63, 0xff, 0x25, 0x7c, 0xc3, 0x13, 0x26,
-	0x2f, 0x22, 0xca, 0xea, 0x45, 0x6e, 0xde, 0xfd, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb8, 0xf4, 0x47,
-	0x33, 0x23, 0x0e, 0x00, 0x00,
+	// 1197 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4b, 0x6f, 0x23, 0x45,
+	0x10, 0xc7, 0x33, 0xce, 0xbb, 0xf2, 0x5a, 0x3a, 0x0e, 0xf1, 0xce, 0x26, 0x8e, 0x99, 0xcd, 0x6b,
+	0x1f, 0x99, 0xd9, 0x4d, 0xd8, 0x2c, 0x07, 0x4e, 0x79, 0x12, 0xc1, 0x4a, 0x61, 0x38, 0x20, 0x01,
+	0x22, 0x1a, 0xdb, 0xcd, 0x64, 0x44, 0x3c, 0x33, 0x99, 0x1e, 0x47, 0xec, 0x46, 0x39, 0x80, 0x38,
+	0x70, 0x03, 0x69, 0xc5, 0x81, 0xe3, 0x5e, 0x39, 0xf1, 0x31, 0xf6, 0xb8, 0x82, 0x0b, 0xa7, 0x08,
+	0x25, 0x7c, 0x0a, 0x4e, 0x68, 0xba, 0xab, 0x6d, 0xb7, 0x5f, 0x63, 0x50, 0x6e, 0x76, 0xd7, 0xbf,
+	0xaa, 0x7e, 0x5d, 0x3d, 0x5d, 0xd5, 0x30, 0x57, 0x74, 0xfc, 0xb2, 0x15, 0x33, 0x66, 0x9d, 0x3d,
+	0x2e, 0xd2, 0xd8, 0x79, 0x6c, 0x9d, 0x56, 0x69, 0xf4, 0xdc, 0x0c, 0xa3, 0x20, 0x0e, 0xc8, 0xad,
+	0xc4, 0x6a, 0xc6, 0x8c, 0x99, 0x68, 0xd5, 0xb3, 0x6e, 0xe0, 0x06, 0xdc, 0x68, 0x25, 0xbf, 0x84,
+	0x4e, 0xbf, 0x5f, 0x0a, 0x58, 0x25, 0x60, 0x56, 0xd1, 0x61, 0x54, 0x04, 0xa8, 0x85, 0x0b, 0x1d,
+	0xd7, 0xf3, 0x9d, 0xd8, 0x0b, 0x7c, 0xd4, 0xce, 0xb9, 0x41, 0xe0, 0x9e, 0x50, 0xcb, 0x09, 0x3d,
+	0xcb, 0xf1, 0xfd, 0x20, 0xe6, 0x46, 0x86, 0x56, 0xbd, 0x85, 0x27, 0x66, 0xd2, 0x96, 0x6f, 0xb1,
+	0xb9, 0xd4, 0xa7, 0xcc, 0x43, 0xbb, 0x91, 0x05, 0xf2, 0x71, 0x92, 0x7b, 0x3b, 0xa8, 0xfa, 0x31,
+	0xb3, 0xe9, 0x69, 0x95, 0xb2, 0xd8, 0xf8, 0x1c, 0xa6, 0x95, 0x55, 0x16, 0x06, 0x3e, 0xa3, 0x64,
+	0x01, 0xc6, 0xdc, 0x28, 0xa8, 0x86, 0x47, 0xa5, 0x64, 0x3d, 0xa7, 0x15, 0xb4, 0xd5, 0x01, 0x1b,
+	0xf8, 0x12, 0x57, 0x92, 0xbb, 0x30, 0xc1, 0x3c, 0xd7, 0xf7, 0x7c, 0x17, 0x25, 0x19, 0x2e, 0x19,
+	0xc7, 0x45, 0x2e, 0x32, 0x4c, 0x78, 0x8b, 0x07, 0xdf, 0x4f, 0xfc, 0x30, 0x23, 0xb9, 0x0d, 0x23,
+	0x22, 0xb4, 0x57, 0xc6, 0xb8, 0xc3, 0xfc, 0xff, 0x41, 0xd9, 0xf8, 0x02, 0x48, 0xa3, 0x1e, 0x59,
+	0xf6, 0x60, 0x5c, 0x38, 0x44, 0x94, 0x55, 0x4f, 0x04, 0xcc, 0xd8, 0xfa, 0xbc, 0xd9, 0x5c, 0x7d,
+	0x53, 0xba, 0x55, 0x4f, 0xe2, 0xad, 0x81, 0xd7, 0x97, 0x0b, 0x7d, 0xf6, 0x98, 0x5b, 0x5f, 0x52,
+	0xa3, 0xcb, 0x02, 0x90, 0x3d, 0x80, 0xfa, 0x21, 0x60, 0xec, 0x65, 0x53, 0x9c, 0x98, 0x99, 0x9c,
+	0x98, 0x29, 0x8e, 0x5c, 0x26, 0x39, 0x74, 0x5c, 0x8a, 0xbe, 0x76, 0x83, 0xa7, 0xf1, 0xb3, 0x06,
+	0xd3, 0x4a, 0x78, 0xa4, 0x7f, 0x02, 0x43, 0x1c, 0x82, 0xe5, 0xb4, 0x42, 0x7f, 0x2a, 0xb7, 0x8d,
+	0x62, 0xb2, 0xaf, 0x60, 0x65, 0x38, 0xd6, 0x4a, 0x2a, 0x96, 0xc8, 0xa9, 0x70, 0x3d, 0x42, 0xac,
+	0x67, 0xb4, 0x52, 0xa4, 0x11, 0xeb, 0xe1, 0x14, 0x0e, 0x21, 0xab, 0x7a, 0xe0, 0x4e, 0xde, 0x83,
+	0xe1, 0x8a, 0x58, 0xc2, 0xad, 0xe4, 0x5a, 0xb7, 0x22, 0x7c, 0xb0, 0xfa, 0x52, 0x6e, 0x7c, 0x08,
+	0x33, 0x3c, 0xe2, 0x01, 0xdb, 0x8f, 0x1c, 0x3f, 0xa6, 0xb2, 0x80, 0x24, 0x07, 0xc3, 0x2e, 0x5f,
+	0x89, 0x38, 0xc4, 0xa8, 0x2d, 0xff, 0xd6, 0x2d, 0x34, 0x97, 0x69, 0xb4, 0x50, 0xe3, 0x29, 0xbc,
+	0xdd, 0x1c, 0x0c, 0x01, 0xe7, 0x01, 0x3c, 0x76, 0x24, 0xdd, 0x92, 0x80, 0x23, 0xf6, 0xa8, 0x27,
+	0x65, 0xc6, 0x2b, 0x0d, 0x26, 0xb9, 0xe7, 0xce, 0x6e, 0x43, 0x7e, 0xa7, 0x5c, 0x8e, 0x28, 0x63,
+	0x32, 0x3f, 0xfe, 0x6d, 0xfa, 0x2c, 0x32, 0xff, 0xf7, 0xb3, 0x20, 0x6b, 0x30, 0x58, 0xaa, 0x46,
+	0x67, 0x34, 0xd7, 0x5f, 0xd0, 0x56, 0x27, 0xd7, 0x67, 0x5b, 0x4b, 0xb6, 0x9d, 0x98, 0x6d, 0xa1,
+	0x32, 0x7e, 0xd4, 0x60, 0xaa, 0xc6, 0x88, 0xdb, 0xda, 0x80, 0xfe, 0x32, 0x95, 0x35, 0xcf, 0xb6,
+	0x06, 0xd8, 0xd9, 0xdd, 0x1a, 0x4b, 0xea, 0x7d, 0x75, 0xb9, 0xd0, 0xbf, 0xb3, 0xcb, 0xec, 0x44,
+	0x7d, 0x73, 0xdf, 0xcf, 0x13, 0xb8, 0xcd, 0x81, 0x0e, 0xa9, 0x5f, 0xf6, 0x7c, 0x57, 0xbd, 0x3c,
+	0x1d, 0xeb, 0x67, 0x6c, 0x83, 0xde, 0xce, 0x0d, 0xb7, 0xb4, 0x04, 0x93, 0xa1, 0x30, 0x1c, 0x35,
+	0x5c, 0x8e, 0x01, 0x7b, 0x22, 0x6c, 0x94, 0x1b, 0x4f, 0xe1, 0x4e, 0x63, 0x90, 0x4f, 0x44, 0x6f,
+	0xe9, 0x21, 0xfb, 0xb7, 0x1a, 0xcc, 0xb5, 0xf7, 0x44, 0x00, 0x07, 0x6e, 0x49, 0x00, 0xec, 0x58,
+	0x88, 0xb0, 0xb5, 0xf9, 0xcf, 0xe5, 0xc2, 0xba, 0xeb, 0xc5, 0xc7, 0xd5, 0xa2, 0x59, 0x0a, 0x2a,
+	0x56, 0x52, 0x6e, 0xde, 0x40, 0x4b, 0xc1, 0x89, 0x55, 0x3a, 0x76, 0x3c, 0xdf, 0x3a, 0xdb, 0xb0,
+	0xc2, 0xaf, 0x5d, 0xde, 0x7a, 0x31, 0xf0, 0xc1, 0x8e, 0x3d, 0x15, 0xaa, 0xa9, 0x8c, 0x77, 0xf1,
+	0xe2, 0xe1, 0x82, 0x84, 0x9e, 0x07, 0x90, 0x8d, 0xb3, 0x76, 0xf5, 0x46, 0x71, 0xe5, 0xa0, 0x6c,
+	0x94, 0x21, 0xab, 0x7a, 0x21, 0xf0, 0x47, 0x30, 0x29, 0xdd, 0x94, 0x36, 0xb8, 0xd0, 0xfa, 0x3d,
+	0xd4, 0x5d, 0xeb, 0x8d, 0x70, 0x82, 0x35, 0x2e, 0xd6, 0xee, 0xd0, 0x96, 0x13, 0x97, 0x8e, 0x0f,
+	0xa3, 0x20, 0xf8, 0xaa, 0x47, 0xbc, 0xdf, 0x34, 0x98, 0x6d, 0xf1, 0x44, 0xc4, 0xf7, 0x61, 0x30,
+	0x4c, 0x16, 0x90, 0xac, 0xd0, 0x4a, 0xc6, 0x9d, 0x0e, 0x62, 0x5a, 0xe1, 0x8e, 0x88, 0x26, 0x9c,
+	0xc8, 0xa7, 0x90, 0x2d, 0x26, 0xe6, 0xa3, 0xa6, 0x6d, 0x66, 0xfe, 0xcb, 0x36, 0x09, 0x0f, 0xa1,
+	0x58, 0x8c, 0x2f, 0xd5, 0x8a, 0xde, 0x78, 0xe3, 0xff, 0x55, 0x83, 0x99, 0xa6, 0x04, 0x58, 0x90,
+	0x0f, 0x60, 0x4a, 0xdd, 0x8c, 0xbc, 0xc4, 0x69, 0xbb, 0xb1, 0x27, 0x95, 0xe3, 0xba, 0xc1, 0xdb,
+	0x2c, 0x1f, 0x01, 0x87, 0x4e, 0xe4, 0x54, 0x6a, 0x8f, 0x80, 0x67, 0x30, 0xad, 0xac, 0x22, 0xff,
+	0x26, 0x0c, 0x85, 0x7c, 0x05, 0xab, 0xd3, 0xa6, 0xdf, 0x0b, 0x0f, 0xac, 0x3e, 0xaa, 0xd7, 0x7f,
+	0x1f, 0x87, 0x41, 0x1e, 0x8f, 0x44, 0x30, 0x24, 0x1e, 0x16, 0x64, 0xb1, 0xd5, 0xb7, 0xf5, 0x35,
+	0xa2, 0x2f, 0xa5, 0xa8, 0x04, 0x98, 0x71, 0xe7, 0xbb, 0x3f, 0xfe, 0x7e, 0x99, 0x99, 0x21, 0xd3,
+	0xca, 0x73, 0xa7, 0x24, 0x32, 0x45, 0x30, 0x24, 0xda, 0x47, 0xc7, 0x9c, 0x4a, 0x0f, 0xd3, 0x97,
+	0x52, 0x54, 0x5d, 0x73, 0xe2, 0xb4, 0x7e, 0x01, 0x83, 0x5c, 0x4e, 0xee, 0x76, 0x0b, 0x26, 0x33,
+	0x2e, 0x76, 0x17, 0x61, 0xc2, 0x65, 0x9e, 0xb0, 0x40, 0xf2, 0x6d, 0x12, 0x5a, 0xe7, 0x72, 0x78,
+	0x5f, 0x90, 0x1f, 0x34, 0x18, 0xc6, 0x51, 0x4d, 0x3a, 0xed, 0x45, 0x1d, 0xfe, 0xfa, 0x72, 0x9a,
+	0x0c, 0x11, 0x4c, 0x8e, 0xb0, 0x4a, 0x96, 0xbb, 0x23, 0x58, 0x38, 0xe7, 0xc9, 0x4b, 0x0d, 0x46,
+	0x6b, 0x63, 0x99, 0xac, 0x74, 0xc8, 0xd2, 0xfc, 0x0a, 0xd0, 0x57, 0xd3, 0x85, 0x08, 0xb4, 0xc1,
+	0x81, 0xd6, 0xc8, 0x03, 0x05, 0xa8, 0x3e, 0xf4, 0xad, 0x73, 0xf1, 0x23, 0xba, 0x90, 0xbf, 0xe8,
+	0x05, 0x39, 0x85, 0xcc, 0xce, 0x2e, 0x29, 0x74, 0x48, 0x52, 0x7b, 0x0c, 0xe8, 0xef, 0x74, 0x51,
+	0x74, 0x3d, 0x93, 0x32, 0x3d, 0x3a, 0xf1, 0x58, 0x6c, 0x9d, 0xe3, 0xf8, 0xb9, 0x20, 0xbf, 0x68,
+	0x30, 0xa1, 0x4c, 0x3e, 0xf2, 0xa0, 0x43, 0xf0, 0x76, 0x63, 0x55, 0x7f, 0xd8, 0x9b, 0x18, 0xa1,
+	0xd6, 0x38, 0xd4, 0x0a, 0x59, 0x52, 0xa0, 0xd4, 0xf9, 0xda, 0xc0, 0xf6, 0x4a, 0x83, 0xa9, 0xa6,
+	0xb1, 0x48, 0xd6, 0xba, 0x27, 0x6c, 0x6a, 0x9d, 0xba, 0xd9, 0xab, 0x1c, 0x09, 0x2d, 0x4e, 0x78,
+	0x8f, 0xac, 0xb4, 0x25, 0x94, 0x03, 0xb8, 0x81, 0xf1, 0x7b, 0x0d, 0x86, 0x31, 0x4a, 0xc7, 0x6f,
+	0x5a, 0x9d, 0xab, 0xfa, 0x72, 0x9a, 0x0c, 0x59, 0xee, 0x73, 0x96, 0x45, 0x62, 0x28, 0x2c, 0x75,
+	0x86, 0xfa, 0xf4, 0xe3, 0xc7, 0x08, 0xf5, 0x41, 0x47, 0x3a, 0x7d, 0xa7, 0x2d, 0x53, 0x54, 0xbf,
+	0xd7, 0x83, 0x12, 0x79, 0x36, 0x39, 0xcf, 0x23, 0x62, 0xa6, 0xf3, 0x58, 0x62, 0x40, 0x8a, 0x79,
+	0xf9, 0x02, 0x46, 0x6a, 0xc7, 0x97, 0xb2, 0xf7, 0xda, 0xb9, 0xad, 0xa4, 0xea, 0x10, 0x6a, 0x9e,
+	0x43, 0xcd, 0x92, 0x99, 0xb6, 0x50, 0x49, 0x8b, 0x15, 0x8d, 0xbf, 0x63, 0x8b, 0x55, 0xe6, 0x8b,
+	0xbe, 0x94, 0xa2, 0xea, 0xda, 0x62, 0xc5, 0x50, 0xd9, 0xda, 0x7b, 0x7d, 0x95, 0xd7, 0xde, 0x5c,
+	0xe5, 0xb5, 0xbf, 0xae, 0xf2, 0xda, 0x4f, 0xd7, 0xf9, 0xbe, 0x37, 0xd7, 0xf9, 0xbe, 0x3f, 0xaf,
+	0xf3, 0x7d, 0x9f, 0x3d, 0x4c, 0x7d, 0xad, 0x7d, 0xc3, 0x03, 0xc6, 0xcf, 0x43, 0xca, 0x8a, 0x43,
+	0xdc, 0xbc, 0xf1, 0xef, 0x00, 0x42, 0x05, 0xf1, 0x7f, 0xdb, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1209,6 +1327,8 @@ type QueryClient interface {
 	PendingSignings(ctx context.Context, in *QueryPendingSigningsRequest, opts ...grpc.CallOption) (*QueryPendingSigningsResponse, error)
 	// Signing queries signing details from the given id.
 	Signing(ctx context.Context, in *QuerySigningRequest, opts ...grpc.CallOption) (*QuerySigningResponse, error)
+	// BatchProof queries the merkle inclusion proof of a batched signing and the signature of its batch.
+	BatchProof(ctx context.Context, in *QueryBatchProofRequest, opts ...grpc.CallOption) (*QueryBatchProofResponse, error)
 	// Signings queries signings details.
 	Signings(ctx context.Context, in *QuerySigningsRequest, opts ...grpc.CallOption) (*QuerySigningsResponse, error)
 	// Params returns all parameters of the module.
@@ -1304,6 +1424,15 @@ func (c *queryClient) Signing(ctx context.Context, in *QuerySigningRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) BatchProof(ctx context.Context, in *QueryBatchProofRequest, opts ...grpc.CallOption) (*QueryBatchProofResponse, error) {
+	out := new(QueryBatchProofResponse)
+	err := c.cc.Invoke(ctx, "/band.tss.v1beta1.Query/BatchProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Signings(ctx context.Context, in *QuerySigningsRequest, opts ...grpc.CallOption) (*QuerySigningsResponse, error) {
 	out := new(QuerySigningsResponse)
 	err := c.cc.Invoke(ctx, "/band.tss.v1beta1.Query/Signings", in, out, opts...)
@@ -1342,6 +1471,8 @@ type QueryServer interface {
 	PendingSignings(context.Context, *QueryPendingSigningsRequest) (*QueryPendingSigningsResponse, error)
 	// Signing queries signing details from the given id.
 	Signing(context.Context, *QuerySigningRequest) (*QuerySigningResponse, error)
+	// BatchProof queries the merkle inclusion proof of a batched signing and the signature of its batch.
+	BatchProof(context.Context, *QueryBatchProofRequest) (*QueryBatchProofResponse, error)
 	// Signings queries signings details.
 	Signings(context.Context, *QuerySigningsRequest) (*QuerySigningsResponse, error)
 	// Params returns all parameters of the module.
@@ -1379,6 +1510,9 @@ func (*UnimplementedQueryServer) PendingSignings(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) Signing(ctx context.Context, req *QuerySigningRequest) (*QuerySigningResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Signing not implemented")
 }
+func (*UnimplementedQueryServer) BatchProof(ctx context.Context, req *QueryBatchProofRequest) (*QueryBatchProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchProof not implemented")
+}
 func (*UnimplementedQueryServer) Signings(ctx context.Context, req *QuerySigningsRequest) (*QuerySigningsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Signings not implemented")
 }
@@ -1552,6 +1686,24 @@ func _Query_Signing_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BatchProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tss.v1beta1.Query/BatchProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchProof(ctx, req.(*QueryBatchProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Signings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySigningsRequest)
 	if err := dec(in); err != nil {
@@ -1628,6 +1780,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Signing",
 			Handler:    _Query_Signing_Handler,
 		},
+		{
+			MethodName: "BatchProof",
+			Handler:    _Query_BatchProof_Handler,
+		},
 		{
 			MethodName: "Signings",
 			Handler:    _Query_Signings_Handler,
@@ -1997,6 +2153,11 @@ func (m *QueryDERequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Curve != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Curve))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -2271,6 +2432,77 @@ func (m *QuerySigningResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBatchProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBatchProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBatchProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SigningId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SigningId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBatchProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBatchProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBatchProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BatchSigningResult.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QuerySigningsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2571,6 +2803,9 @@ func (m *QueryDERequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Curve != 0 {
+		n += 1 + sovQuery(uint64(m.Curve))
+	}
 	return n
 }
 
@@ -2674,6 +2909,31 @@ func (m *QuerySigningResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBatchProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SigningId != 0 {
+		n += 1 + sovQuery(uint64(m.SigningId))
+	}
+	return n
+}
+
+func (m *QueryBatchProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BatchSigningResult.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QuerySigningsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3662,6 +3922,25 @@ func (m *QueryDERequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			m.Curve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Curve |= Curve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4371,6 +4650,191 @@ func (m *QuerySigningResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBatchProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBatchProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBatchProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningId", wireType)
+			}
+			m.SigningId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigningId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBatchProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBatchProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBatchProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSigningResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BatchSigningResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QuerySigningsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
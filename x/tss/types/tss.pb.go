@@ -67,6 +67,34 @@ func (SigningStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_26231ff63bcc8f4b, []int{0}
 }
 
+// Curve is an enumeration of the elliptic curves that a group can generate its key and sign on.
+type Curve int32
+
+const (
+	// CURVE_SECP256K1 is the secp256k1 curve with the schnorr signature scheme of the module.
+	CURVE_SECP256K1 Curve = 0
+	// CURVE_ED25519 is the Ed25519 curve with FROST signatures that verify as standard Ed25519 signatures.
+	CURVE_ED25519 Curve = 1
+)
+
+var Curve_name = map[int32]string{
+	0: "CURVE_SECP256K1",
+	1: "CURVE_ED25519",
+}
+
+var Curve_value = map[string]int32{
+	"CURVE_SECP256K1": 0,
+	"CURVE_ED25519":   1,
+}
+
+func (x Curve) String() string {
+	return proto.EnumName(Curve_name, int32(x))
+}
+
+func (Curve) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_26231ff63bcc8f4b, []int{1}
+}
+
 // GroupStatus is an enumeration of the possible statuses of a group.
 type GroupStatus int32
 
@@ -112,7 +140,7 @@ func (x GroupStatus) String() string {
 }
 
 func (GroupStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_26231ff63bcc8f4b, []int{1}
+	return fileDescriptor_26231ff63bcc8f4b, []int{2}
 }
 
 // ComplaintStatus represents the status of a complaint.
@@ -144,7 +172,7 @@ func (x ComplaintStatus) String() string {
 }
 
 func (ComplaintStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_26231ff63bcc8f4b, []int{2}
+	return fileDescriptor_26231ff63bcc8f4b, []int{3}
 }
 
 // Group is a type representing a participant group in a Distributed Key Generation or signing process.
@@ -163,6 +191,11 @@ type Group struct {
 	CreatedHeight uint64 `protobuf:"varint,6,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
 	// module_owner is the module that creates this group.
 	ModuleOwner string `protobuf:"bytes,7,opt,name=module_owner,json=moduleOwner,proto3" json:"module_owner,omitempty"`
+	// source_group_id is the ID of the group whose secret is reshared to this group, or 0 if the group is
+	// created by a new DKG process.
+	SourceGroupID github_com_bandprotocol_chain_v3_pkg_tss.GroupID `protobuf:"varint,8,opt,name=source_group_id,json=sourceGroupId,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.GroupID" json:"source_group_id,omitempty"`
+	// curve is the elliptic curve that the group generates its key and signs on.
+	Curve Curve `protobuf:"varint,9,opt,name=curve,proto3,enum=band.tss.v1beta1.Curve" json:"curve,omitempty"`
 }
 
 func (m *Group) Reset()         { *m = Group{} }
@@ -247,6 +280,20 @@ func (m *Group) GetModuleOwner() string {
 	return ""
 }
 
+func (m *Group) GetSourceGroupID() github_com_bandprotocol_chain_v3_pkg_tss.GroupID {
+	if m != nil {
+		return m.SourceGroupID
+	}
+	return 0
+}
+
+func (m *Group) GetCurve() Curve {
+	if m != nil {
+		return m.Curve
+	}
+	return CURVE_SECP256K1
+}
+
 // GroupResult is a tss group result from querying tss group information.
 type GroupResult struct {
 	// group defines the group object containing group information.
@@ -490,6 +537,8 @@ type DE struct {
 	PubD github_com_bandprotocol_chain_v3_pkg_tss.Point `protobuf:"bytes,1,opt,name=pub_d,json=pubD,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.Point" json:"pub_d,omitempty"`
 	// pub_e is the public value of own commitment (E).
 	PubE github_com_bandprotocol_chain_v3_pkg_tss.Point `protobuf:"bytes,2,opt,name=pub_e,json=pubE,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.Point" json:"pub_e,omitempty"`
+	// curve is the elliptic curve of the commitment, which must match the curve of the group it signs for.
+	Curve Curve `protobuf:"varint,3,opt,name=curve,proto3,enum=band.tss.v1beta1.Curve" json:"curve,omitempty"`
 }
 
 func (m *DE) Reset()         { *m = DE{} }
@@ -539,6 +588,13 @@ func (m *DE) GetPubE() github_com_bandprotocol_chain_v3_pkg_tss.Point {
 	return nil
 }
 
+func (m *DE) GetCurve() Curve {
+	if m != nil {
+		return m.Curve
+	}
+	return CURVE_SECP256K1
+}
+
 // DEQueue is a simple queue data structure contains index of existing DE objects of each member.
 type DEQueue struct {
 	// head is the current index of the first element in the queue.
@@ -616,6 +672,9 @@ type Signing struct {
 	CreatedHeight uint64 `protobuf:"varint,9,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
 	// created_timestamp is the block timestamp when the signing was created.
 	CreatedTimestamp time.Time `protobuf:"bytes,10,opt,name=created_timestamp,json=createdTimestamp,proto3,stdtime" json:"created_timestamp"`
+	// batch_signing_id is the ID of the batch signing that signs the merkle root covering this signing's
+	// message. It is zero if the signing is signed on its own.
+	BatchSigningID github_com_bandprotocol_chain_v3_pkg_tss.SigningID `protobuf:"varint,11,opt,name=batch_signing_id,json=batchSigningId,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.SigningID" json:"batch_signing_id,omitempty"`
 }
 
 func (m *Signing) Reset()         { *m = Signing{} }
@@ -721,6 +780,13 @@ func (m *Signing) GetCreatedTimestamp() time.Time {
 	return time.Time{}
 }
 
+func (m *Signing) GetBatchSigningID() github_com_bandprotocol_chain_v3_pkg_tss.SigningID {
+	if m != nil {
+		return m.BatchSigningID
+	}
+	return 0
+}
+
 // SigningAttempt contains a member that has been assigned to and expiration block height of
 // the specific attempt.
 type SigningAttempt struct {
@@ -955,6 +1021,9 @@ type Member struct {
 	IsMalicious bool `protobuf:"varint,5,opt,name=is_malicious,json=isMalicious,proto3" json:"is_malicious,omitempty"`
 	// is_active is a boolean flag indicating whether the member is currently active in the protocol.
 	IsActive bool `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// source_member_id is the member ID in the source group of a member that re-deals its share of the
+	// source group secret in a reshared group, or 0 if the member does not deal.
+	SourceMemberID github_com_bandprotocol_chain_v3_pkg_tss.MemberID `protobuf:"varint,7,opt,name=source_member_id,json=sourceMemberId,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.MemberID" json:"source_member_id,omitempty"`
 }
 
 func (m *Member) Reset()         { *m = Member{} }
@@ -1032,6 +1101,13 @@ func (m *Member) GetIsActive() bool {
 	return false
 }
 
+func (m *Member) GetSourceMemberID() github_com_bandprotocol_chain_v3_pkg_tss.MemberID {
+	if m != nil {
+		return m.SourceMemberID
+	}
+	return 0
+}
+
 // Confirm is a message type used to confirm participation in the protocol.
 type Confirm struct {
 	// member_id is the unique identifier of a group member.
@@ -1710,8 +1786,219 @@ func (m *SigningExpirations) GetSigningExpirations() []SigningExpiration {
 	return nil
 }
 
+// PendingBatch is a list of signings of a group that wait to be signed together in a batch.
+type PendingBatch struct {
+	// group_id is the ID of the group that signs the batch.
+	GroupID github_com_bandprotocol_chain_v3_pkg_tss.GroupID `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.GroupID" json:"group_id,omitempty"`
+	// start_height is the block height when the first signing is added into the batch.
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// signing_ids is a list of signing IDs in the batch.
+	SigningIDs []github_com_bandprotocol_chain_v3_pkg_tss.SigningID `protobuf:"varint,3,rep,packed,name=signing_ids,json=signingIds,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.SigningID" json:"signing_ids,omitempty"`
+}
+
+func (m *PendingBatch) Reset()         { *m = PendingBatch{} }
+func (m *PendingBatch) String() string { return proto.CompactTextString(m) }
+func (*PendingBatch) ProtoMessage()    {}
+func (*PendingBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26231ff63bcc8f4b, []int{23}
+}
+func (m *PendingBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingBatch.Merge(m, src)
+}
+func (m *PendingBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingBatch proto.InternalMessageInfo
+
+func (m *PendingBatch) GetGroupID() github_com_bandprotocol_chain_v3_pkg_tss.GroupID {
+	if m != nil {
+		return m.GroupID
+	}
+	return 0
+}
+
+func (m *PendingBatch) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *PendingBatch) GetSigningIDs() []github_com_bandprotocol_chain_v3_pkg_tss.SigningID {
+	if m != nil {
+		return m.SigningIDs
+	}
+	return nil
+}
+
+// BatchSigning is a signing over the merkle root of the messages of many signings.
+type BatchSigning struct {
+	// id is the ID of the signing that signs the merkle root.
+	ID github_com_bandprotocol_chain_v3_pkg_tss.SigningID `protobuf:"varint,1,opt,name=id,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.SigningID" json:"id,omitempty"`
+	// root is the merkle root of the messages of the signings in the batch.
+	Root github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=root,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"root,omitempty"`
+	// signing_ids is a list of signing IDs in the batch, ordered by their leaf index.
+	SigningIDs []github_com_bandprotocol_chain_v3_pkg_tss.SigningID `protobuf:"varint,3,rep,packed,name=signing_ids,json=signingIds,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.SigningID" json:"signing_ids,omitempty"`
+}
+
+func (m *BatchSigning) Reset()         { *m = BatchSigning{} }
+func (m *BatchSigning) String() string { return proto.CompactTextString(m) }
+func (*BatchSigning) ProtoMessage()    {}
+func (*BatchSigning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26231ff63bcc8f4b, []int{24}
+}
+func (m *BatchSigning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchSigning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchSigning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchSigning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchSigning.Merge(m, src)
+}
+func (m *BatchSigning) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchSigning) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchSigning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchSigning proto.InternalMessageInfo
+
+func (m *BatchSigning) GetID() github_com_bandprotocol_chain_v3_pkg_tss.SigningID {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *BatchSigning) GetRoot() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *BatchSigning) GetSigningIDs() []github_com_bandprotocol_chain_v3_pkg_tss.SigningID {
+	if m != nil {
+		return m.SigningIDs
+	}
+	return nil
+}
+
+// BatchItemProof is a merkle inclusion proof of a signing's message in a batch signing.
+type BatchItemProof struct {
+	// signing_id is the ID of the signing included in the batch.
+	SigningID github_com_bandprotocol_chain_v3_pkg_tss.SigningID `protobuf:"varint,1,opt,name=signing_id,json=signingId,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.SigningID" json:"signing_id,omitempty"`
+	// batch_signing_id is the ID of the signing that signs the merkle root.
+	BatchSigningID github_com_bandprotocol_chain_v3_pkg_tss.SigningID `protobuf:"varint,2,opt,name=batch_signing_id,json=batchSigningId,proto3,casttype=github.com/bandprotocol/chain/v3/pkg/tss.SigningID" json:"batch_signing_id,omitempty"`
+	// leaf is the merkle leaf of the signing's message.
+	Leaf github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=leaf,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"leaf,omitempty"`
+	// proof is a list of sibling hashes from the leaf up to the merkle root.
+	Proof []github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,4,rep,name=proof,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"proof,omitempty"`
+	// root is the merkle root of the batch.
+	Root github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,5,opt,name=root,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"root,omitempty"`
+}
+
+func (m *BatchItemProof) Reset()         { *m = BatchItemProof{} }
+func (m *BatchItemProof) String() string { return proto.CompactTextString(m) }
+func (*BatchItemProof) ProtoMessage()    {}
+func (*BatchItemProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26231ff63bcc8f4b, []int{25}
+}
+func (m *BatchItemProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchItemProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchItemProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchItemProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchItemProof.Merge(m, src)
+}
+func (m *BatchItemProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchItemProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchItemProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchItemProof proto.InternalMessageInfo
+
+func (m *BatchItemProof) GetSigningID() github_com_bandprotocol_chain_v3_pkg_tss.SigningID {
+	if m != nil {
+		return m.SigningID
+	}
+	return 0
+}
+
+func (m *BatchItemProof) GetBatchSigningID() github_com_bandprotocol_chain_v3_pkg_tss.SigningID {
+	if m != nil {
+		return m.BatchSigningID
+	}
+	return 0
+}
+
+func (m *BatchItemProof) GetLeaf() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.Leaf
+	}
+	return nil
+}
+
+func (m *BatchItemProof) GetProof() []github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *BatchItemProof) GetRoot() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("band.tss.v1beta1.SigningStatus", SigningStatus_name, SigningStatus_value)
+	proto.RegisterEnum("band.tss.v1beta1.Curve", Curve_name, Curve_value)
 	proto.RegisterEnum("band.tss.v1beta1.GroupStatus", GroupStatus_name, GroupStatus_value)
 	proto.RegisterEnum("band.tss.v1beta1.ComplaintStatus", ComplaintStatus_name, ComplaintStatus_value)
 	proto.RegisterType((*Group)(nil), "band.tss.v1beta1.Group")
@@ -1737,138 +2024,157 @@ func init() {
 	proto.RegisterType((*SigningResult)(nil), "band.tss.v1beta1.SigningResult")
 	proto.RegisterType((*SigningExpiration)(nil), "band.tss.v1beta1.SigningExpiration")
 	proto.RegisterType((*SigningExpirations)(nil), "band.tss.v1beta1.SigningExpirations")
+	proto.RegisterType((*PendingBatch)(nil), "band.tss.v1beta1.PendingBatch")
+	proto.RegisterType((*BatchSigning)(nil), "band.tss.v1beta1.BatchSigning")
+	proto.RegisterType((*BatchItemProof)(nil), "band.tss.v1beta1.BatchItemProof")
 }
 
 func init() { proto.RegisterFile("band/tss/v1beta1/tss.proto", fileDescriptor_26231ff63bcc8f4b) }
 
 var fileDescriptor_26231ff63bcc8f4b = []byte{
-	// 2007 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x6f, 0xdb, 0xc8,
-	0x15, 0x37, 0x25, 0xd9, 0x92, 0x9e, 0x64, 0x5b, 0x3b, 0x71, 0x62, 0xd9, 0x71, 0x25, 0x87, 0xc5,
-	0x76, 0x8d, 0x45, 0x6b, 0xc5, 0x72, 0xb7, 0xdd, 0x6e, 0x16, 0x48, 0x25, 0x4b, 0xf1, 0x6a, 0xe3,
-	0xd8, 0x0e, 0x25, 0x27, 0x5b, 0x17, 0x5b, 0x82, 0x22, 0xc7, 0x12, 0x61, 0x91, 0x54, 0x39, 0x23,
-	0xc7, 0xee, 0xa5, 0xd7, 0x1c, 0x73, 0xda, 0x73, 0x81, 0xf6, 0x50, 0xec, 0xb9, 0xfd, 0x0e, 0x41,
-	0x7b, 0x59, 0xa0, 0x28, 0xb0, 0xbd, 0x28, 0x85, 0x82, 0xa2, 0xe8, 0xad, 0x67, 0x9f, 0x0a, 0x0e,
-	0x87, 0xa4, 0x64, 0xc9, 0x59, 0x47, 0x4a, 0x82, 0xde, 0x38, 0xef, 0xbd, 0xf9, 0xcd, 0x9b, 0x37,
-	0xef, 0xaf, 0x04, 0xcb, 0x75, 0xc5, 0xd4, 0x72, 0x94, 0x90, 0xdc, 0xc9, 0x46, 0x1d, 0x53, 0x65,
-	0xc3, 0xf9, 0x5e, 0x6f, 0xdb, 0x16, 0xb5, 0x50, 0xca, 0xe1, 0xad, 0x3b, 0x6b, 0xce, 0x5b, 0x5e,
-	0x68, 0x58, 0x0d, 0x8b, 0x31, 0x73, 0xce, 0x97, 0x2b, 0xb7, 0xbc, 0xa4, 0x5a, 0xc4, 0xb0, 0x88,
-	0xec, 0x32, 0xdc, 0x05, 0x67, 0x65, 0x1b, 0x96, 0xd5, 0x68, 0xe1, 0x1c, 0x5b, 0xd5, 0x3b, 0x47,
-	0x39, 0xaa, 0x1b, 0x98, 0x50, 0xc5, 0x68, 0xbb, 0x02, 0xe2, 0xdf, 0x42, 0x30, 0xbd, 0x6d, 0x5b,
-	0x9d, 0x36, 0xfa, 0x1c, 0x42, 0xba, 0x96, 0x16, 0x56, 0x85, 0xb5, 0x48, 0xf1, 0x93, 0x5e, 0x37,
-	0x1b, 0xaa, 0x94, 0xce, 0xbb, 0xd9, 0xdb, 0x0d, 0x9d, 0x36, 0x3b, 0xf5, 0x75, 0xd5, 0x32, 0x72,
-	0x8e, 0x3a, 0x6c, 0x97, 0x6a, 0xb5, 0x72, 0x6a, 0x53, 0xd1, 0xcd, 0xdc, 0xc9, 0x66, 0xae, 0x7d,
-	0xdc, 0x60, 0x3a, 0x33, 0x94, 0x4a, 0x49, 0x0a, 0xe9, 0x1a, 0x42, 0x10, 0x21, 0xfa, 0x6f, 0x70,
-	0x3a, 0xe4, 0xa0, 0x49, 0xec, 0x1b, 0xad, 0x40, 0x9c, 0x36, 0x6d, 0x4c, 0x9a, 0x56, 0x4b, 0x4b,
-	0x87, 0x19, 0x23, 0x20, 0xa0, 0xfb, 0x10, 0x6d, 0x77, 0xea, 0xf2, 0x31, 0x3e, 0x4b, 0x47, 0x56,
-	0x85, 0xb5, 0x64, 0x31, 0x7f, 0xde, 0xcd, 0xae, 0x5f, 0xf9, 0xf0, 0x7d, 0x4b, 0x37, 0xa9, 0x34,
-	0xd3, 0xee, 0xd4, 0xef, 0xe3, 0x33, 0xf4, 0x11, 0xcc, 0x10, 0xaa, 0xd0, 0x0e, 0x49, 0x4f, 0xaf,
-	0x0a, 0x6b, 0x73, 0xf9, 0xef, 0xad, 0x5f, 0xb4, 0xa4, 0xab, 0x6d, 0x95, 0x09, 0x49, 0x5c, 0x18,
-	0xbd, 0x0f, 0x73, 0xaa, 0x8d, 0x15, 0x8a, 0x35, 0xb9, 0x89, 0xf5, 0x46, 0x93, 0xa6, 0x67, 0x98,
-	0x9a, 0xb3, 0x9c, 0xfa, 0x19, 0x23, 0xa2, 0x5b, 0x90, 0x34, 0x2c, 0xad, 0xd3, 0xc2, 0xb2, 0xf5,
-	0xc4, 0xc4, 0x76, 0x3a, 0xba, 0x2a, 0xac, 0xc5, 0xa5, 0x84, 0x4b, 0xdb, 0x73, 0x48, 0xe2, 0xb3,
-	0x08, 0x24, 0xd8, 0x09, 0x12, 0x26, 0x9d, 0x16, 0x45, 0x9b, 0x30, 0xdd, 0x70, 0x96, 0xcc, 0xbc,
-	0x89, 0xfc, 0xe2, 0x25, 0xfa, 0x14, 0x23, 0xcf, 0xbb, 0xd9, 0x29, 0xc9, 0x95, 0x45, 0x2a, 0x24,
-	0xb4, 0xe3, 0x86, 0xac, 0x5a, 0x26, 0xc5, 0xa7, 0x94, 0xd9, 0x32, 0x59, 0x2c, 0xf6, 0xba, 0x59,
-	0x28, 0xdd, 0xdf, 0xde, 0x72, 0xa9, 0x17, 0x5e, 0x48, 0xb5, 0x0c, 0x4c, 0xeb, 0x47, 0x34, 0xf8,
-	0x68, 0xe9, 0x75, 0x92, 0xab, 0x9f, 0x51, 0x4c, 0xd6, 0x3f, 0xc3, 0xa7, 0x45, 0xe7, 0x43, 0x02,
-	0xed, 0xb8, 0xc1, 0xf7, 0xa3, 0x8f, 0x21, 0x6a, 0x60, 0xa3, 0x8e, 0x6d, 0x92, 0x0e, 0xaf, 0x86,
-	0xd7, 0x12, 0xf9, 0xf4, 0xb0, 0x6e, 0x0f, 0x98, 0x00, 0x57, 0xce, 0x13, 0x47, 0x65, 0x48, 0xda,
-	0x56, 0xc7, 0xd4, 0x36, 0x64, 0xdd, 0x3c, 0xb2, 0x48, 0x3a, 0xc2, 0xb6, 0xaf, 0x0c, 0x6f, 0x97,
-	0x98, 0x54, 0xc5, 0x3c, 0xb2, 0x38, 0x44, 0xc2, 0xf6, 0x29, 0x01, 0x4c, 0x9e, 0xc3, 0x4c, 0xbf,
-	0x12, 0x26, 0x3f, 0x04, 0x93, 0x77, 0x61, 0xea, 0x70, 0x43, 0xb5, 0x8c, 0x76, 0x4b, 0xd1, 0x4d,
-	0x4a, 0xe4, 0x27, 0x3a, 0x6d, 0xca, 0xdc, 0x05, 0x66, 0x18, 0xe0, 0x0f, 0x86, 0x01, 0xb7, 0x7c,
-	0xf9, 0xc7, 0x3a, 0x6d, 0xba, 0xbe, 0xc0, 0xa1, 0x17, 0xd4, 0x11, 0x3c, 0x74, 0x07, 0x62, 0xaa,
-	0x65, 0x1e, 0xe9, 0xb6, 0x41, 0xd2, 0x51, 0x86, 0xba, 0x34, 0x0a, 0x95, 0x49, 0x70, 0x20, 0x7f,
-	0x83, 0xf8, 0xdf, 0x30, 0x40, 0x60, 0x09, 0xf4, 0x2b, 0x88, 0xbb, 0x86, 0x94, 0xfd, 0xa0, 0x2b,
-	0xf4, 0xba, 0xd9, 0x98, 0x6b, 0x6b, 0x16, 0x7a, 0x1b, 0x57, 0xf6, 0x7e, 0x6f, 0x93, 0x14, 0x73,
-	0x31, 0x2b, 0x1a, 0xd2, 0xe0, 0x9a, 0x6a, 0xe1, 0xa3, 0x23, 0x5d, 0xd5, 0xb1, 0x49, 0x65, 0xd5,
-	0x32, 0x0c, 0x9d, 0x92, 0x74, 0x68, 0x35, 0xbc, 0x96, 0x2c, 0x6e, 0x7e, 0xfd, 0x22, 0x9b, 0x7b,
-	0xbd, 0xd8, 0x22, 0x12, 0xea, 0xc3, 0xdb, 0x72, 0xe1, 0xd0, 0x2f, 0x21, 0x65, 0x99, 0x58, 0x76,
-	0x92, 0x8a, 0xec, 0x85, 0x6f, 0x78, 0xec, 0xf0, 0x9d, 0xb5, 0x4c, 0x5c, 0xd3, 0x0d, 0xbc, 0xef,
-	0x46, 0xf1, 0x2f, 0x20, 0xa9, 0xdc, 0x96, 0x89, 0xde, 0x30, 0x15, 0xda, 0xb1, 0x31, 0xcf, 0x0b,
-	0x3f, 0x39, 0xef, 0x66, 0xf3, 0x57, 0x06, 0xae, 0x7a, 0xbb, 0xa5, 0x84, 0x72, 0xdb, 0x5f, 0x20,
-	0x0d, 0x90, 0xaf, 0x77, 0x70, 0xc0, 0xf4, 0x44, 0x07, 0xa4, 0xb8, 0xf6, 0x3e, 0x45, 0xec, 0x09,
-	0xfc, 0xc9, 0xf3, 0xef, 0xe4, 0xc9, 0x29, 0x2c, 0x62, 0x53, 0xb5, 0xcf, 0xda, 0x4e, 0x02, 0x23,
-	0x58, 0xb5, 0x31, 0x95, 0x49, 0x53, 0xb1, 0xb1, 0xf7, 0xec, 0x9f, 0x7e, 0xfd, 0x22, 0xfb, 0xf1,
-	0x95, 0x4f, 0x28, 0x9b, 0x6a, 0x95, 0x81, 0x54, 0x19, 0x86, 0x74, 0xdd, 0x07, 0xef, 0x27, 0x8b,
-	0x5f, 0x09, 0x10, 0x2a, 0x95, 0xd1, 0x36, 0x4c, 0x3b, 0x0e, 0xe0, 0x5e, 0x6c, 0xbc, 0xe7, 0x8f,
-	0xb4, 0x3b, 0xf5, 0x92, 0x07, 0x84, 0x79, 0xbe, 0x1b, 0x17, 0xa8, 0x2c, 0x6e, 0x40, 0xb4, 0x54,
-	0x7e, 0xd8, 0xc1, 0x1d, 0xec, 0x94, 0xa3, 0x26, 0x56, 0xb8, 0xd1, 0x25, 0xf6, 0xed, 0xd0, 0xa8,
-	0xa2, 0xb7, 0xbc, 0x12, 0xe5, 0x7c, 0x8b, 0xff, 0x99, 0x86, 0xa8, 0xf3, 0x7c, 0xba, 0xd9, 0x40,
-	0x3b, 0x7d, 0xe5, 0xf0, 0x53, 0xbf, 0x1c, 0xbe, 0x9e, 0x63, 0xe8, 0x66, 0x83, 0x17, 0xc4, 0x0f,
-	0x60, 0x5e, 0xed, 0xd8, 0xb6, 0x13, 0x8a, 0x0a, 0xa5, 0xd8, 0x68, 0x53, 0x7e, 0xf0, 0x1c, 0x27,
-	0x17, 0x5c, 0x2a, 0x3a, 0x84, 0x18, 0xcb, 0xfe, 0x8e, 0x8f, 0xb0, 0x22, 0x59, 0xbc, 0xdb, 0xeb,
-	0x66, 0xa3, 0xbc, 0xb8, 0x8e, 0x55, 0x90, 0xa3, 0x0c, 0xb0, 0xa2, 0xa1, 0x47, 0x30, 0xeb, 0x62,
-	0x4f, 0x5e, 0x69, 0x13, 0x0c, 0x88, 0x07, 0xea, 0xae, 0x53, 0x43, 0x08, 0x51, 0x1a, 0x5e, 0x08,
-	0xfd, 0x78, 0xac, 0xb2, 0xe4, 0x81, 0xa0, 0x43, 0x98, 0x0f, 0xf4, 0x34, 0x2d, 0x53, 0xc5, 0xac,
-	0x10, 0x8f, 0x99, 0x54, 0x3c, 0x4d, 0x77, 0x1d, 0x20, 0x54, 0x83, 0x78, 0x10, 0xf0, 0xd1, 0x89,
-	0x02, 0x3e, 0x00, 0x42, 0x3f, 0xf5, 0x1b, 0x8e, 0x18, 0x6b, 0x38, 0xb2, 0xc3, 0x75, 0x81, 0xfb,
-	0xc3, 0x77, 0xb6, 0x1c, 0xf1, 0x51, 0x2d, 0xc7, 0x43, 0x78, 0xcf, 0x13, 0xf3, 0x1b, 0xb8, 0x34,
-	0xb0, 0x5e, 0x62, 0x79, 0xdd, 0x6d, 0xf1, 0xd6, 0xbd, 0x16, 0x6f, 0xbd, 0xe6, 0x49, 0x14, 0x63,
-	0x4e, 0x0d, 0x7a, 0xf6, 0x22, 0x2b, 0x48, 0x29, 0xbe, 0xdd, 0xe7, 0x89, 0x4f, 0x43, 0x30, 0xc7,
-	0x75, 0xf2, 0x7c, 0xaf, 0x0e, 0x40, 0x5c, 0x4a, 0x90, 0xa1, 0xb6, 0x7a, 0xdd, 0x6c, 0xdc, 0xf7,
-	0xe5, 0x31, 0x23, 0x20, 0xce, 0x61, 0x2b, 0x1a, 0x4a, 0x43, 0x74, 0x30, 0x00, 0xbc, 0xa5, 0x63,
-	0x0a, 0x7c, 0xda, 0xd6, 0xed, 0xc0, 0x14, 0x6e, 0x93, 0x38, 0xcb, 0xa9, 0xbe, 0x29, 0x52, 0x0a,
-	0x71, 0xf0, 0xb0, 0x26, 0x7b, 0x9d, 0x8b, 0xdb, 0x7a, 0xac, 0x0e, 0x1b, 0xbd, 0xc0, 0x25, 0x07,
-	0x3a, 0x98, 0x79, 0x65, 0x80, 0x4a, 0xc4, 0xaf, 0x22, 0x30, 0x37, 0x28, 0xf9, 0xd6, 0x73, 0xb5,
-	0x63, 0x06, 0x4d, 0xb3, 0x31, 0x21, 0xcc, 0x0c, 0x71, 0xc9, 0x5b, 0xf6, 0x37, 0xc2, 0xe1, 0x89,
-	0x1b, 0x61, 0x3f, 0x2b, 0x47, 0xde, 0x54, 0x56, 0x9e, 0x9e, 0x2c, 0x2b, 0xa3, 0x43, 0x98, 0xab,
-	0xeb, 0xa6, 0xe6, 0xf8, 0xd8, 0x91, 0xa2, 0x52, 0xcb, 0xe6, 0xa1, 0xbd, 0x79, 0xde, 0x7d, 0x8d,
-	0x96, 0xa4, 0xaa, 0x2a, 0x2d, 0xc5, 0x96, 0x66, 0x39, 0xd4, 0x3d, 0x86, 0x84, 0xf6, 0x20, 0x1e,
-	0x64, 0x8c, 0xe8, 0xd8, 0x8a, 0xc6, 0xda, 0x3c, 0x59, 0x88, 0x79, 0x98, 0xdf, 0xc7, 0xec, 0x04,
-	0xee, 0xcb, 0x04, 0x65, 0x21, 0x11, 0xc4, 0x08, 0x49, 0x0b, 0xab, 0xe1, 0xb5, 0x88, 0x04, 0xbe,
-	0x7f, 0x13, 0xf1, 0xdb, 0x10, 0xcc, 0x70, 0x27, 0xba, 0xdf, 0x57, 0x42, 0xee, 0xf8, 0x25, 0x64,
-	0x0c, 0xbf, 0x71, 0x2a, 0x48, 0x7f, 0x61, 0x08, 0xbd, 0xe1, 0xc2, 0xd0, 0xe7, 0x8d, 0xe1, 0x4b,
-	0xbd, 0x71, 0xf2, 0xb1, 0xec, 0x16, 0x24, 0x75, 0x22, 0x1b, 0x4a, 0x4b, 0x57, 0x75, 0x8b, 0x0f,
-	0x67, 0x31, 0x29, 0xa1, 0x93, 0x07, 0x1e, 0x09, 0xdd, 0x84, 0xb8, 0x4e, 0x64, 0x45, 0xa5, 0xfa,
-	0x89, 0x9b, 0xf4, 0x63, 0x52, 0x4c, 0x27, 0x05, 0xb6, 0x16, 0x9f, 0x0b, 0x10, 0xe5, 0xed, 0xf5,
-	0x5b, 0x0f, 0xd0, 0x2f, 0x61, 0xde, 0x7a, 0x62, 0x7a, 0x95, 0xd2, 0x69, 0x12, 0x79, 0x43, 0x32,
-	0x6e, 0xb5, 0x48, 0x5a, 0x4f, 0x4c, 0xb7, 0x5e, 0x56, 0xf5, 0x86, 0xf8, 0xef, 0x10, 0xc4, 0xfd,
-	0xf9, 0x03, 0x3d, 0x86, 0x84, 0x37, 0x70, 0x28, 0x26, 0xe5, 0xd7, 0xf9, 0x68, 0xbc, 0x2b, 0xf4,
-	0x23, 0xa1, 0x03, 0x00, 0x1b, 0x93, 0xb6, 0x65, 0x6a, 0xd8, 0xe4, 0x09, 0x77, 0x5c, 0xdc, 0x3e,
-	0x20, 0xc7, 0x2b, 0x98, 0x51, 0xce, 0x8c, 0x49, 0x72, 0xd4, 0x31, 0x3e, 0xab, 0x9e, 0x19, 0xe8,
-	0xcb, 0xfe, 0x8a, 0xec, 0x3a, 0xd9, 0xdd, 0xf3, 0x6e, 0xf6, 0xce, 0x95, 0xe1, 0x7c, 0x3b, 0x8e,
-	0x2a, 0xcd, 0xe2, 0x1f, 0x04, 0xb8, 0xe6, 0x4b, 0xf4, 0x0d, 0x73, 0x77, 0x21, 0xee, 0x0f, 0x79,
-	0x7c, 0x2c, 0xbf, 0xf9, 0x8a, 0x19, 0x91, 0xd7, 0x8e, 0x60, 0x0f, 0xda, 0x81, 0x94, 0xbf, 0xf0,
-	0x66, 0xcd, 0x10, 0xab, 0xfe, 0xb7, 0x5e, 0x81, 0xc3, 0xeb, 0xff, 0xbc, 0x3a, 0x48, 0x10, 0xff,
-	0x21, 0xc0, 0xc2, 0xa8, 0x81, 0xf4, 0xad, 0x3b, 0xba, 0x72, 0xe9, 0xe0, 0x1c, 0x62, 0x55, 0xf5,
-	0xfd, 0x57, 0x5c, 0xe6, 0x6a, 0x73, 0xb3, 0xd8, 0x81, 0x05, 0x9e, 0x46, 0xf7, 0x6d, 0x4b, 0xc5,
-	0x84, 0xb0, 0x04, 0x44, 0x9c, 0x97, 0xf7, 0x52, 0x1a, 0xcf, 0xa4, 0xc5, 0x9f, 0x3b, 0x57, 0xe3,
-	0xf9, 0x89, 0x8c, 0x95, 0xd4, 0x62, 0x3c, 0xa9, 0x11, 0xf1, 0xb7, 0x70, 0x63, 0xf0, 0x58, 0x3f,
-	0x89, 0xe3, 0x11, 0x49, 0xbc, 0x58, 0xea, 0x75, 0xb3, 0xe0, 0xf7, 0x2c, 0x64, 0xcc, 0x56, 0xa7,
-	0xbf, 0x14, 0xfc, 0x3d, 0x04, 0xa9, 0x7d, 0xc5, 0xa6, 0xba, 0xd2, 0x0a, 0x46, 0xcf, 0x77, 0xd1,
-	0x64, 0x7d, 0x00, 0xf3, 0xde, 0x19, 0x17, 0xa6, 0x0d, 0x32, 0xd8, 0xf1, 0x0d, 0x38, 0x57, 0xf8,
-	0xcd, 0x3b, 0x57, 0x6d, 0x38, 0xb6, 0x27, 0xef, 0xb6, 0xc5, 0x5f, 0x03, 0xaa, 0xe1, 0xd3, 0x20,
-	0xdc, 0xf7, 0x6c, 0x0d, 0xdb, 0xfd, 0x53, 0x88, 0xf0, 0x06, 0xa6, 0x90, 0x4f, 0x12, 0x7f, 0xf9,
-	0xd3, 0x8f, 0xa2, 0xec, 0x67, 0x32, 0x93, 0x8a, 0x7f, 0x16, 0x20, 0x59, 0x7e, 0xf4, 0x20, 0x78,
-	0xc6, 0x87, 0x10, 0xb7, 0x65, 0xaf, 0x68, 0x4e, 0x72, 0x5e, 0xcc, 0x2e, 0xf0, 0x5a, 0x2b, 0xf5,
-	0x1b, 0x2b, 0x34, 0x01, 0x64, 0x9f, 0xa9, 0xfe, 0x15, 0x82, 0x59, 0xee, 0x22, 0xfc, 0xa7, 0xc8,
-	0x9f, 0x41, 0x94, 0x3b, 0x01, 0xcf, 0x7a, 0x4b, 0x97, 0xce, 0x2a, 0xde, 0x2f, 0x7e, 0x5c, 0x1e,
-	0x7d, 0x01, 0x8b, 0xde, 0x10, 0x3b, 0xca, 0xbd, 0x46, 0x76, 0xe0, 0x83, 0x23, 0x86, 0x74, 0x9d,
-	0x03, 0x5c, 0x98, 0x3c, 0x0e, 0x60, 0x16, 0x9f, 0x18, 0x7d, 0x3f, 0xc5, 0x84, 0x19, 0x5e, 0x66,
-	0x18, 0xaf, 0xff, 0x11, 0x8a, 0xa9, 0x5e, 0x37, 0x3b, 0xf0, 0x2c, 0x52, 0x12, 0x9f, 0x18, 0xc1,
-	0x23, 0x35, 0xe1, 0xa6, 0x8d, 0x55, 0xac, 0x9f, 0x60, 0x4d, 0x6e, 0xbb, 0x81, 0x18, 0x9c, 0xe1,
-	0x8d, 0x0d, 0xe2, 0xf0, 0x21, 0x17, 0x83, 0x96, 0x1b, 0x62, 0xc9, 0x03, 0xbb, 0xc8, 0x27, 0xe2,
-	0xef, 0x04, 0x78, 0x8f, 0xdf, 0xa9, 0xec, 0x8c, 0x2b, 0x0a, 0xd5, 0x2d, 0xf3, 0xff, 0x2a, 0xd6,
-	0xc5, 0x36, 0xa0, 0x21, 0x0d, 0x09, 0x3a, 0x84, 0x6b, 0xde, 0x76, 0x1c, 0x90, 0x59, 0x4a, 0x4c,
-	0xe4, 0xbf, 0x7f, 0xe9, 0x7b, 0x06, 0x10, 0xdc, 0x36, 0x88, 0x0c, 0x61, 0x7f, 0xf8, 0x54, 0xf0,
-	0x9d, 0x8f, 0x17, 0xb3, 0x0c, 0x2c, 0x57, 0x2b, 0xdb, 0xbb, 0x95, 0xdd, 0x6d, 0xb9, 0x5a, 0x2b,
-	0xd4, 0x0e, 0xaa, 0xf2, 0xc1, 0x6e, 0x75, 0xbf, 0xbc, 0x55, 0xb9, 0x57, 0x29, 0x97, 0x52, 0x53,
-	0x68, 0x19, 0x6e, 0x5c, 0xe0, 0x3f, 0x2e, 0x54, 0x6a, 0x95, 0xdd, 0xed, 0x94, 0x30, 0x82, 0x57,
-	0x3d, 0xd8, 0xda, 0x2a, 0x57, 0xab, 0xa9, 0x10, 0x5a, 0x82, 0xeb, 0x17, 0x78, 0xf7, 0x0a, 0x3b,
-	0x3b, 0xe5, 0xdd, 0x54, 0x78, 0x39, 0xf2, 0xf4, 0xf7, 0x99, 0xa9, 0x0f, 0xff, 0x2a, 0xf0, 0x1f,
-	0xe4, 0xb9, 0x22, 0x2b, 0x90, 0xde, 0x96, 0xf6, 0x0e, 0xf6, 0x47, 0xab, 0x91, 0x86, 0x85, 0x01,
-	0xae, 0xb4, 0x77, 0xb0, 0x5b, 0x92, 0x37, 0x52, 0xc2, 0x25, 0x9c, 0x7c, 0x2a, 0x74, 0x09, 0x67,
-	0x33, 0x15, 0x46, 0x8b, 0x70, 0x6d, 0x80, 0x53, 0xd8, 0xaa, 0x55, 0x1e, 0x95, 0x53, 0x91, 0xa1,
-	0x2d, 0xe5, 0x2f, 0xf6, 0x2b, 0x52, 0xb9, 0x94, 0x9a, 0x1e, 0xda, 0xc2, 0x6f, 0x33, 0xc3, 0x6f,
-	0x43, 0x61, 0xfe, 0x42, 0x43, 0x81, 0x56, 0x61, 0x65, 0x6b, 0xef, 0xc1, 0xfe, 0x4e, 0xa1, 0xb2,
-	0x5b, 0x1b, 0x7d, 0xa9, 0x15, 0x48, 0x0f, 0x49, 0x78, 0x16, 0x14, 0xd0, 0x4d, 0x58, 0x1c, 0xe2,
-	0xde, 0x2b, 0x54, 0x76, 0xca, 0xa5, 0x54, 0xc8, 0x3d, 0xb5, 0xf8, 0xf9, 0x1f, 0x7b, 0x19, 0xe1,
-	0x79, 0x2f, 0x23, 0x7c, 0xd3, 0xcb, 0x08, 0xff, 0xec, 0x65, 0x84, 0x67, 0x2f, 0x33, 0x53, 0xdf,
-	0xbc, 0xcc, 0x4c, 0x7d, 0xfb, 0x32, 0x33, 0x75, 0xf8, 0xc3, 0xef, 0x74, 0xe3, 0x53, 0xf6, 0x37,
-	0x17, 0x3d, 0x6b, 0x63, 0x52, 0x9f, 0x61, 0xec, 0xcd, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x55,
-	0x02, 0x06, 0x92, 0xff, 0x1a, 0x00, 0x00,
+	// 2269 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0xf2, 0x43, 0x24, 0x1f, 0x29, 0x69, 0x33, 0x76, 0xe2, 0xb5, 0xec, 0x8a, 0xf2, 0x16,
+	0x69, 0x84, 0xa0, 0x91, 0x2c, 0xaa, 0x4a, 0x93, 0x38, 0x80, 0xcb, 0x2f, 0xcb, 0xb4, 0x65, 0x89,
+	0x5e, 0x4a, 0x76, 0xea, 0x22, 0x5d, 0x2c, 0x77, 0x47, 0xe4, 0xc2, 0xe4, 0x2e, 0xb3, 0x33, 0x94,
+	0xad, 0x5e, 0x7a, 0xf5, 0xa5, 0x80, 0x4f, 0x3d, 0x17, 0x68, 0x0f, 0x45, 0xce, 0xed, 0x9f, 0x50,
+	0xc0, 0x68, 0x2e, 0xb9, 0x14, 0x68, 0x0f, 0xa5, 0x0b, 0x1a, 0x45, 0xaf, 0x2d, 0xd0, 0x93, 0x4f,
+	0xc5, 0xce, 0xce, 0x2e, 0x97, 0x22, 0xe5, 0xc8, 0xa4, 0x6c, 0xf4, 0xb6, 0xf3, 0xde, 0x9b, 0xdf,
+	0xcc, 0xbc, 0xf7, 0xe6, 0x7d, 0x0c, 0x09, 0x8b, 0x75, 0xcd, 0x32, 0xd6, 0x28, 0x21, 0x6b, 0x87,
+	0xeb, 0x75, 0x4c, 0xb5, 0x75, 0xf7, 0x7b, 0xb5, 0xe3, 0xd8, 0xd4, 0x46, 0xa2, 0xcb, 0x5b, 0x75,
+	0xc7, 0x9c, 0xb7, 0x78, 0xbe, 0x61, 0x37, 0x6c, 0xc6, 0x5c, 0x73, 0xbf, 0x3c, 0xb9, 0xc5, 0x8b,
+	0xba, 0x4d, 0xda, 0x36, 0x51, 0x3d, 0x86, 0x37, 0xe0, 0xac, 0x6c, 0xc3, 0xb6, 0x1b, 0x2d, 0xbc,
+	0xc6, 0x46, 0xf5, 0xee, 0xc1, 0x1a, 0x35, 0xdb, 0x98, 0x50, 0xad, 0xdd, 0xf1, 0x04, 0xe4, 0xff,
+	0x44, 0x21, 0xbe, 0xe5, 0xd8, 0xdd, 0x0e, 0xba, 0x05, 0x11, 0xd3, 0x90, 0x84, 0x65, 0x61, 0x25,
+	0x56, 0xf8, 0xac, 0xdf, 0xcb, 0x46, 0x2a, 0xa5, 0x97, 0xbd, 0xec, 0xd5, 0x86, 0x49, 0x9b, 0xdd,
+	0xfa, 0xaa, 0x6e, 0xb7, 0xd7, 0xdc, 0xed, 0xb0, 0x59, 0xba, 0xdd, 0x5a, 0xd3, 0x9b, 0x9a, 0x69,
+	0xad, 0x1d, 0x6e, 0xac, 0x75, 0x1e, 0x36, 0xd8, 0x9e, 0x19, 0x4a, 0xa5, 0xa4, 0x44, 0x4c, 0x03,
+	0x21, 0x88, 0x11, 0xf3, 0x17, 0x58, 0x8a, 0xb8, 0x68, 0x0a, 0xfb, 0x46, 0x97, 0x21, 0x45, 0x9b,
+	0x0e, 0x26, 0x4d, 0xbb, 0x65, 0x48, 0x51, 0xc6, 0x18, 0x10, 0xd0, 0x6d, 0x48, 0x74, 0xba, 0x75,
+	0xf5, 0x21, 0x3e, 0x92, 0x62, 0xcb, 0xc2, 0x4a, 0xa6, 0x90, 0x7b, 0xd9, 0xcb, 0xae, 0x9e, 0x7a,
+	0xf1, 0xaa, 0x6d, 0x5a, 0x54, 0x99, 0xed, 0x74, 0xeb, 0xb7, 0xf1, 0x11, 0xda, 0x84, 0x59, 0x42,
+	0x35, 0xda, 0x25, 0x52, 0x7c, 0x59, 0x58, 0x99, 0xcf, 0x7d, 0x6f, 0xf5, 0xb8, 0x26, 0xbd, 0xdd,
+	0xd6, 0x98, 0x90, 0xc2, 0x85, 0xd1, 0xfb, 0x30, 0xaf, 0x3b, 0x58, 0xa3, 0xd8, 0x50, 0x9b, 0xd8,
+	0x6c, 0x34, 0xa9, 0x34, 0xcb, 0xb6, 0x39, 0xc7, 0xa9, 0x37, 0x19, 0x11, 0x5d, 0x81, 0x4c, 0xdb,
+	0x36, 0xba, 0x2d, 0xac, 0xda, 0x8f, 0x2c, 0xec, 0x48, 0x89, 0x65, 0x61, 0x25, 0xa5, 0xa4, 0x3d,
+	0xda, 0xae, 0x4b, 0x42, 0x6d, 0x58, 0x20, 0x76, 0xd7, 0xd1, 0xb1, 0xda, 0x70, 0xd7, 0x51, 0x4d,
+	0x43, 0x4a, 0x32, 0xc5, 0x96, 0xfb, 0xbd, 0xec, 0x5c, 0x8d, 0xb1, 0xb8, 0xbe, 0x26, 0xd2, 0xf1,
+	0x1c, 0x09, 0x41, 0x18, 0xe8, 0x23, 0x88, 0xeb, 0x5d, 0xe7, 0x10, 0x4b, 0x29, 0x76, 0xdc, 0x0b,
+	0xa3, 0xc7, 0x2d, 0xba, 0x6c, 0xc5, 0x93, 0x92, 0x9f, 0xc6, 0x20, 0xcd, 0xa6, 0x2a, 0x98, 0x74,
+	0x5b, 0x14, 0x6d, 0x40, 0x9c, 0x6d, 0x93, 0x19, 0x3f, 0x3d, 0x6e, 0x3a, 0x93, 0x2e, 0xc4, 0x9e,
+	0xf5, 0xb2, 0x33, 0x8a, 0x27, 0x8b, 0x74, 0x48, 0x1b, 0x0f, 0x1b, 0xaa, 0x6e, 0x5b, 0x14, 0x3f,
+	0xa6, 0xcc, 0xd2, 0x99, 0x42, 0xa1, 0xdf, 0xcb, 0x42, 0xe9, 0xf6, 0x56, 0xd1, 0xa3, 0x1e, 0x3b,
+	0x9b, 0x6e, 0xb7, 0x31, 0xad, 0x1f, 0xd0, 0xc1, 0x47, 0xcb, 0xac, 0x93, 0xb5, 0xfa, 0x11, 0xc5,
+	0x64, 0xf5, 0x26, 0x7e, 0x5c, 0x70, 0x3f, 0x14, 0x30, 0x1e, 0x36, 0xf8, 0x7c, 0xf4, 0x09, 0x24,
+	0xda, 0xb8, 0x5d, 0xc7, 0x0e, 0x91, 0xa2, 0xcb, 0xd1, 0x95, 0x74, 0x4e, 0x1a, 0xdd, 0xdb, 0x1d,
+	0x26, 0xc0, 0x37, 0xe7, 0x8b, 0xa3, 0x32, 0x64, 0x1c, 0xbb, 0x6b, 0x19, 0xeb, 0xaa, 0x69, 0x1d,
+	0xd8, 0x44, 0x8a, 0xb1, 0xe9, 0x97, 0x47, 0xa7, 0x2b, 0x4c, 0xaa, 0x62, 0x1d, 0xd8, 0x1c, 0x22,
+	0xed, 0x04, 0x94, 0x01, 0x4c, 0x8e, 0xc3, 0xc4, 0x5f, 0x09, 0x93, 0x1b, 0x81, 0xc9, 0x79, 0x30,
+	0x75, 0x78, 0x4f, 0xb7, 0xdb, 0x9d, 0x96, 0x66, 0x5a, 0x94, 0xa8, 0x8f, 0x4c, 0xda, 0x54, 0xb9,
+	0x83, 0xce, 0x32, 0xc0, 0x1f, 0x8c, 0xb1, 0x58, 0x20, 0x7f, 0xdf, 0xa4, 0x4d, 0xcf, 0x53, 0x39,
+	0xf4, 0x79, 0x7d, 0x0c, 0x0f, 0x5d, 0x83, 0xa4, 0x6e, 0x5b, 0x07, 0xa6, 0xd3, 0x26, 0x52, 0x82,
+	0xa1, 0x5e, 0x1c, 0x87, 0xca, 0x24, 0x38, 0x50, 0x30, 0x41, 0xfe, 0x77, 0x14, 0x60, 0xa0, 0x09,
+	0xf4, 0x73, 0x48, 0x79, 0x8a, 0x54, 0x83, 0x90, 0x90, 0xef, 0xf7, 0xb2, 0x49, 0x4f, 0xd7, 0xcc,
+	0x69, 0xd7, 0x4f, 0xed, 0xb4, 0xfe, 0x24, 0x25, 0xe9, 0x61, 0x56, 0x0c, 0x64, 0xc0, 0x39, 0xdd,
+	0xc6, 0x07, 0x07, 0xa6, 0x6e, 0x62, 0x8b, 0xaa, 0xba, 0xdd, 0x6e, 0x9b, 0x94, 0x48, 0x91, 0xe5,
+	0xe8, 0x4a, 0xa6, 0xb0, 0xf1, 0xf5, 0xf3, 0xec, 0xda, 0xeb, 0xdd, 0x7c, 0xa2, 0xa0, 0x10, 0x5e,
+	0xd1, 0x83, 0x43, 0x3f, 0x03, 0xd1, 0xb6, 0xb0, 0xea, 0x86, 0x3c, 0xd5, 0x0f, 0x2e, 0xd1, 0x89,
+	0x83, 0xcb, 0x9c, 0x6d, 0xe1, 0x3d, 0xb3, 0x8d, 0xab, 0x5e, 0x8c, 0xf9, 0x29, 0x64, 0xb4, 0xab,
+	0x2a, 0x31, 0x1b, 0x96, 0x46, 0xbb, 0x0e, 0xe6, 0x51, 0xeb, 0xe3, 0x97, 0xbd, 0x6c, 0xee, 0xd4,
+	0xc0, 0x35, 0x7f, 0xb6, 0x92, 0xd6, 0xae, 0x06, 0x03, 0x64, 0x00, 0x0a, 0xf6, 0x3d, 0x58, 0x20,
+	0x3e, 0xd5, 0x02, 0x22, 0xdf, 0x7d, 0x40, 0x91, 0xfb, 0x02, 0x37, 0x79, 0xee, 0xad, 0x98, 0x9c,
+	0xc2, 0x05, 0x6c, 0xe9, 0xce, 0x51, 0xc7, 0x0d, 0xaf, 0x04, 0xeb, 0x0e, 0xa6, 0x2a, 0x69, 0x6a,
+	0x0e, 0xf6, 0xcd, 0xfe, 0xf9, 0xd7, 0xcf, 0xb3, 0x9f, 0x9c, 0x7a, 0x85, 0xb2, 0xa5, 0xd7, 0x18,
+	0x48, 0x8d, 0x61, 0x28, 0xef, 0x06, 0xe0, 0x61, 0xb2, 0xfc, 0x27, 0x01, 0x22, 0xa5, 0x32, 0xda,
+	0x82, 0xb8, 0xeb, 0x00, 0xde, 0xc1, 0x26, 0x33, 0x7f, 0xac, 0xd3, 0xad, 0x97, 0x7c, 0x20, 0x2c,
+	0x45, 0xa6, 0x02, 0x2a, 0x0f, 0x42, 0x76, 0xf4, 0x54, 0x21, 0x7b, 0x1d, 0x12, 0xa5, 0xf2, 0xdd,
+	0x2e, 0xee, 0x62, 0x37, 0xb7, 0x36, 0xb1, 0xc6, 0x6d, 0xa4, 0xb0, 0x6f, 0x97, 0x46, 0x35, 0xb3,
+	0xe5, 0xe7, 0x5b, 0xf7, 0x5b, 0xfe, 0xfb, 0x2c, 0x24, 0x5c, 0x6b, 0x9b, 0x56, 0x03, 0x6d, 0x87,
+	0x72, 0xfb, 0xe7, 0x41, 0x6e, 0x7f, 0x3d, 0x3f, 0x32, 0xad, 0x06, 0xcf, 0xee, 0x1f, 0xc0, 0x82,
+	0xde, 0x75, 0x1c, 0xf7, 0xe6, 0x6a, 0x94, 0xe2, 0x76, 0x87, 0xf2, 0x85, 0xe7, 0x39, 0x39, 0xef,
+	0x51, 0xd1, 0x03, 0x48, 0x06, 0xf9, 0x8f, 0x65, 0xfc, 0xc2, 0xf5, 0x7e, 0x2f, 0x9b, 0x98, 0x26,
+	0xf3, 0x25, 0x1a, 0x3c, 0xe7, 0xdd, 0x83, 0x39, 0x0f, 0x7b, 0xfa, 0xb2, 0x21, 0xcd, 0x80, 0xf8,
+	0xbd, 0xde, 0x71, 0x53, 0x0e, 0x21, 0x5a, 0xc3, 0xbf, 0x71, 0x3f, 0x9a, 0x28, 0x8b, 0xf9, 0x20,
+	0xe8, 0x01, 0x2c, 0x0c, 0xf6, 0x69, 0xd9, 0x96, 0x8e, 0xa5, 0xd9, 0x89, 0x77, 0x3a, 0xe7, 0xef,
+	0x74, 0xc7, 0x05, 0x42, 0x7b, 0x90, 0x1a, 0xc4, 0x87, 0xc4, 0x54, 0xf1, 0x61, 0x00, 0x84, 0x7e,
+	0x1c, 0x54, 0x4f, 0x49, 0xe6, 0x9b, 0xd9, 0x51, 0xdf, 0xe4, 0xfe, 0xf0, 0x9d, 0xf5, 0x53, 0x6a,
+	0x5c, 0xfd, 0x74, 0x17, 0xde, 0xf1, 0xc5, 0x82, 0x6a, 0x54, 0x02, 0x56, 0x7a, 0x2c, 0xae, 0x7a,
+	0xf5, 0xea, 0xaa, 0x5f, 0xaf, 0xae, 0xee, 0xf9, 0x12, 0x85, 0xa4, 0x9b, 0xb2, 0x9e, 0x3e, 0xcf,
+	0x0a, 0x8a, 0xc8, 0xa7, 0x07, 0x3c, 0xe4, 0x80, 0x58, 0xd7, 0xa8, 0xde, 0x64, 0xe1, 0xd2, 0xb4,
+	0x1a, 0xae, 0xc3, 0xa5, 0x99, 0xc3, 0xdd, 0xec, 0xf7, 0xb2, 0xf3, 0x05, 0x97, 0x17, 0xf8, 0xf0,
+	0x84, 0x9e, 0x3f, 0x5f, 0x0f, 0xa3, 0x18, 0xf2, 0x93, 0x08, 0xcc, 0xf3, 0x91, 0xef, 0xef, 0x75,
+	0x80, 0xd0, 0x06, 0xbc, 0xeb, 0x56, 0xec, 0xf7, 0xb2, 0xa9, 0x69, 0xd7, 0x4e, 0x11, 0x7f, 0x59,
+	0x24, 0x41, 0x62, 0xf8, 0xd2, 0xf9, 0x43, 0x57, 0xfd, 0xf8, 0x71, 0xc7, 0x74, 0x06, 0xea, 0xf7,
+	0xaa, 0xec, 0x39, 0x4e, 0x0d, 0xd4, 0x2f, 0x6a, 0xc4, 0xc5, 0xc3, 0x86, 0xea, 0x17, 0x57, 0x5e,
+	0x75, 0xb4, 0x3c, 0x6a, 0xe8, 0x3c, 0x97, 0x1c, 0x2a, 0xb2, 0x16, 0xb4, 0x21, 0x2a, 0x91, 0x7f,
+	0x1d, 0x83, 0xf9, 0x61, 0xc9, 0x37, 0x9e, 0x4e, 0x5c, 0x35, 0x18, 0x86, 0x83, 0x09, 0x61, 0x6a,
+	0x48, 0x29, 0xfe, 0x30, 0xdc, 0x49, 0x44, 0xa7, 0xee, 0x24, 0x82, 0xc4, 0x11, 0x3b, 0xab, 0xc4,
+	0x11, 0x9f, 0x32, 0x71, 0x3c, 0x80, 0xf9, 0xba, 0x69, 0x19, 0xae, 0x8f, 0x1d, 0x68, 0x3a, 0xb5,
+	0x1d, 0x1e, 0x4e, 0x36, 0x5e, 0xf6, 0x5e, 0xa3, 0x6a, 0xaa, 0xe9, 0x5a, 0x4b, 0x73, 0x94, 0x39,
+	0x0e, 0x75, 0x83, 0x21, 0xa1, 0x5d, 0x48, 0x0d, 0xa2, 0x54, 0x62, 0xe2, 0x8d, 0x26, 0x3b, 0x3c,
+	0x40, 0xc9, 0x39, 0x58, 0xa8, 0x62, 0xb6, 0x02, 0xf7, 0x65, 0x82, 0xb2, 0x90, 0x1e, 0xdc, 0x11,
+	0x22, 0x09, 0xcb, 0xd1, 0x95, 0x98, 0x02, 0x81, 0x7f, 0x13, 0xf9, 0x9b, 0x28, 0xcc, 0x72, 0x27,
+	0xba, 0x1d, 0x4a, 0x5b, 0xd7, 0x82, 0xb4, 0x35, 0x81, 0xdf, 0xb8, 0x59, 0x2b, 0x9c, 0x8c, 0x22,
+	0x67, 0x9c, 0x8c, 0x42, 0xde, 0x18, 0x3d, 0xd1, 0x1b, 0xa7, 0xef, 0x6b, 0xaf, 0x40, 0xc6, 0x24,
+	0x6a, 0x5b, 0x6b, 0x99, 0xba, 0x69, 0xf3, 0xee, 0x36, 0xa9, 0xa4, 0x4d, 0x72, 0xc7, 0x27, 0xa1,
+	0x4b, 0x90, 0x32, 0x89, 0xaa, 0xe9, 0xd4, 0x3c, 0xf4, 0x12, 0x4d, 0x52, 0x49, 0x9a, 0x24, 0xcf,
+	0xc6, 0xe8, 0x2b, 0x10, 0x79, 0x5b, 0x3a, 0xb8, 0x9b, 0x09, 0xa6, 0x8a, 0x2d, 0x37, 0x4c, 0x7a,
+	0x7d, 0xe9, 0x74, 0x37, 0x74, 0x9e, 0x84, 0x41, 0x0c, 0xf9, 0x99, 0x00, 0x09, 0xde, 0x74, 0xbc,
+	0xf1, 0x98, 0xf0, 0x25, 0x2c, 0xd8, 0x8f, 0x2c, 0xbf, 0x20, 0x70, 0x73, 0x81, 0x14, 0x99, 0x2a,
+	0x29, 0x66, 0xec, 0x47, 0x96, 0x57, 0x16, 0xd4, 0xcc, 0x86, 0xfc, 0xaf, 0x08, 0xa4, 0x82, 0xae,
+	0x0c, 0xdd, 0x87, 0xb4, 0xdf, 0x86, 0x69, 0x16, 0xe5, 0xc7, 0xd9, 0x9c, 0xec, 0x08, 0x61, 0x24,
+	0xb4, 0x0f, 0xe0, 0x60, 0xd2, 0xb1, 0x2d, 0x03, 0x5b, 0x3c, 0xc6, 0x4f, 0x8a, 0x1b, 0x02, 0x72,
+	0x1d, 0x91, 0x29, 0xe5, 0xa8, 0x3d, 0x4d, 0x58, 0x7c, 0x88, 0x8f, 0x6a, 0x47, 0x6d, 0xf4, 0x65,
+	0xb8, 0xf0, 0xf0, 0xfc, 0xfa, 0xfa, 0xcb, 0x5e, 0xf6, 0xda, 0xa9, 0xe1, 0x02, 0x3d, 0x8e, 0xab,
+	0x40, 0xe4, 0xdf, 0x09, 0x70, 0x2e, 0x90, 0x08, 0xb5, 0xb8, 0xd7, 0x21, 0x15, 0xb4, 0xbe, 0xfc,
+	0xb1, 0xe2, 0xd2, 0x2b, 0x3a, 0x67, 0x9e, 0xae, 0x06, 0x73, 0xd0, 0x36, 0x88, 0xc1, 0xc0, 0xef,
+	0xc0, 0x23, 0xac, 0xc8, 0xb9, 0xf2, 0x0a, 0x1c, 0x5e, 0xe6, 0x2c, 0xe8, 0xc3, 0x04, 0xf9, 0x6f,
+	0x02, 0x9c, 0x1f, 0xd7, 0xa6, 0xbf, 0x71, 0x47, 0xd7, 0x4e, 0x7c, 0x4e, 0x88, 0xb0, 0x44, 0xfe,
+	0xfe, 0x2b, 0x0e, 0x73, 0xba, 0xd7, 0x04, 0xb9, 0x0b, 0xe7, 0x79, 0xe4, 0xae, 0x3a, 0xb6, 0x8e,
+	0x09, 0x61, 0x31, 0x8f, 0xb8, 0x96, 0xf7, 0xa3, 0x28, 0x0f, 0xde, 0x85, 0x9f, 0xb8, 0x47, 0xe3,
+	0x21, 0x91, 0x4c, 0x14, 0x47, 0x93, 0x3c, 0x8e, 0x12, 0xf9, 0x97, 0xf0, 0xde, 0xf0, 0xb2, 0x41,
+	0xde, 0xc0, 0x63, 0xf2, 0x46, 0xa1, 0xe4, 0xbe, 0x37, 0x05, 0x65, 0x12, 0x99, 0xb0, 0xba, 0x0a,
+	0x67, 0x9f, 0xbf, 0x44, 0x40, 0xac, 0x6a, 0x0e, 0x35, 0xb5, 0xd6, 0xa0, 0x21, 0x7f, 0x1b, 0x75,
+	0xdd, 0x07, 0xb0, 0xe0, 0xaf, 0x71, 0xac, 0xa9, 0x22, 0xc3, 0x45, 0xe6, 0x90, 0x73, 0x45, 0xcf,
+	0xde, 0xb9, 0xf6, 0x46, 0xef, 0xf6, 0xf4, 0x4d, 0x85, 0xfc, 0x15, 0xa0, 0x3d, 0xfc, 0x78, 0x70,
+	0xdd, 0x77, 0x1d, 0x03, 0x3b, 0xe1, 0x66, 0x4b, 0x38, 0x83, 0x66, 0xeb, 0xb3, 0xf4, 0x9f, 0xff,
+	0xf0, 0x51, 0x82, 0x3d, 0x1e, 0x5a, 0x54, 0xfe, 0xa3, 0x00, 0x99, 0xf2, 0xbd, 0x3b, 0x03, 0x33,
+	0xde, 0x85, 0x94, 0xa3, 0xfa, 0x79, 0x7a, 0x9a, 0xf5, 0x92, 0x4e, 0x9e, 0xa7, 0x77, 0x25, 0xac,
+	0xac, 0xc8, 0x14, 0x90, 0x21, 0x55, 0xfd, 0x33, 0x02, 0x73, 0xdc, 0x45, 0xf8, 0x03, 0xed, 0xa7,
+	0x90, 0xe0, 0x4e, 0xc0, 0xa3, 0xde, 0xc5, 0x13, 0x5b, 0x32, 0xff, 0x1d, 0x94, 0xcb, 0xa3, 0x2f,
+	0xe0, 0x82, 0xdf, 0xab, 0x8f, 0x73, 0xaf, 0xb1, 0x45, 0xff, 0x70, 0x57, 0xa3, 0xbc, 0xcb, 0x01,
+	0x86, 0xc9, 0x68, 0x1f, 0xe6, 0xf0, 0x61, 0x3b, 0xf4, 0x40, 0x15, 0x65, 0x78, 0x4b, 0xa3, 0x78,
+	0x61, 0x23, 0x14, 0xc4, 0x7e, 0x2f, 0x3b, 0x64, 0x16, 0x25, 0x83, 0x0f, 0xdb, 0x03, 0x23, 0x35,
+	0xe1, 0x92, 0x83, 0x75, 0x6c, 0x1e, 0x62, 0x43, 0xed, 0x78, 0x17, 0x71, 0xb0, 0x86, 0xdf, 0xa9,
+	0xc8, 0xa3, 0x8b, 0x1c, 0xbf, 0xb4, 0x5c, 0x11, 0x17, 0x7d, 0xb0, 0xe3, 0x7c, 0x22, 0xff, 0x46,
+	0x80, 0x77, 0xf8, 0x99, 0xca, 0x6e, 0x87, 0xa4, 0x51, 0xd3, 0xb6, 0xfe, 0xaf, 0xee, 0xba, 0xdc,
+	0x01, 0x34, 0xb2, 0x43, 0x82, 0x1e, 0xc0, 0x39, 0x7f, 0x3a, 0x1e, 0x90, 0x59, 0x48, 0x4c, 0xe7,
+	0xbe, 0x7f, 0xa2, 0x3d, 0x07, 0x10, 0x5c, 0x37, 0x88, 0x8c, 0x60, 0xcb, 0xff, 0x15, 0x20, 0xc3,
+	0x23, 0x30, 0xeb, 0x9a, 0x87, 0xca, 0x66, 0xe1, 0x8c, 0xcb, 0xe6, 0x2b, 0x90, 0x21, 0x54, 0x73,
+	0xa8, 0xdf, 0xaf, 0x7a, 0x4a, 0x48, 0x33, 0x1a, 0xef, 0x56, 0x8f, 0x85, 0xfd, 0xe8, 0x1b, 0x0a,
+	0xfb, 0xbf, 0x8a, 0x40, 0x26, 0xfc, 0x4a, 0x70, 0xc6, 0x2f, 0x66, 0x37, 0x21, 0xe6, 0xd8, 0x36,
+	0x9d, 0x2a, 0x42, 0x30, 0x84, 0xb7, 0xa5, 0x8f, 0x67, 0x51, 0xf0, 0x5e, 0x4d, 0x2a, 0x14, 0xb7,
+	0xab, 0x8e, 0x6d, 0x1f, 0xbc, 0x95, 0x8b, 0x31, 0xee, 0x1d, 0x27, 0xf2, 0x66, 0xdf, 0x71, 0x5c,
+	0xdb, 0xb4, 0xb0, 0x76, 0x20, 0x45, 0xa7, 0xb1, 0x8d, 0x8b, 0x80, 0x6e, 0x41, 0xbc, 0xe3, 0xaa,
+	0x8a, 0x05, 0xa9, 0x49, 0xa1, 0x3c, 0x88, 0xc0, 0x63, 0xe2, 0xd3, 0x7a, 0xcc, 0x87, 0x4f, 0x84,
+	0x20, 0x9d, 0xf0, 0xf2, 0x74, 0x09, 0x16, 0x6b, 0x95, 0xad, 0x9d, 0xca, 0xce, 0x96, 0x5a, 0xdb,
+	0xcb, 0xef, 0xed, 0xd7, 0xd4, 0xfd, 0x9d, 0x5a, 0xb5, 0x5c, 0xac, 0xdc, 0xa8, 0x94, 0x4b, 0xe2,
+	0x0c, 0x5a, 0x84, 0xf7, 0x8e, 0xf1, 0xef, 0xe7, 0x2b, 0x7b, 0x95, 0x9d, 0x2d, 0x51, 0x18, 0xc3,
+	0xab, 0xed, 0x17, 0x8b, 0xe5, 0x5a, 0x4d, 0x8c, 0xa0, 0x8b, 0xf0, 0xee, 0x31, 0xde, 0x8d, 0xfc,
+	0xf6, 0x76, 0x79, 0x47, 0x8c, 0x2e, 0xc6, 0x9e, 0xfc, 0x76, 0x69, 0xe6, 0xc3, 0x4d, 0x88, 0xb3,
+	0x57, 0x6d, 0x74, 0x0e, 0x16, 0x8a, 0xfb, 0xca, 0xbd, 0xb2, 0x5a, 0x2b, 0x17, 0xab, 0xb9, 0xcd,
+	0x8f, 0x6f, 0xaf, 0x8b, 0x33, 0xe8, 0x1d, 0x98, 0xf3, 0x88, 0xe5, 0x52, 0x6e, 0x73, 0x73, 0xfd,
+	0x53, 0x51, 0xe0, 0xd3, 0xbe, 0x11, 0xf8, 0xef, 0x95, 0x7c, 0xff, 0x97, 0x41, 0xda, 0x52, 0x76,
+	0xf7, 0xab, 0xe3, 0x77, 0x2f, 0xc1, 0xf9, 0x21, 0xae, 0xb2, 0xbb, 0xbf, 0x53, 0x52, 0xd7, 0x45,
+	0xe1, 0x04, 0x4e, 0x4e, 0x8c, 0x9c, 0xc0, 0xd9, 0x10, 0xa3, 0xe8, 0x02, 0x9c, 0x1b, 0xe2, 0xe4,
+	0x8b, 0x7b, 0x95, 0x7b, 0x65, 0x31, 0x36, 0x32, 0xa5, 0xfc, 0x45, 0xb5, 0xa2, 0x94, 0x4b, 0x62,
+	0x7c, 0x64, 0x0a, 0x57, 0xc2, 0x2c, 0x3f, 0x0d, 0x85, 0x85, 0x63, 0x9d, 0x05, 0x5a, 0x86, 0xcb,
+	0xc5, 0xdd, 0x3b, 0xd5, 0xed, 0x7c, 0x65, 0x67, 0x6f, 0xfc, 0xa1, 0x2e, 0x83, 0x34, 0x22, 0xe1,
+	0x2b, 0x5e, 0x40, 0x97, 0xe0, 0xc2, 0x08, 0xf7, 0x46, 0xbe, 0xb2, 0x5d, 0x2e, 0x89, 0x11, 0x6f,
+	0xd5, 0xc2, 0xad, 0xdf, 0xf7, 0x97, 0x84, 0x67, 0xfd, 0x25, 0xe1, 0xdb, 0xfe, 0x92, 0xf0, 0x8f,
+	0xfe, 0x92, 0xf0, 0xf4, 0xc5, 0xd2, 0xcc, 0xb7, 0x2f, 0x96, 0x66, 0xfe, 0xfa, 0x62, 0x69, 0xe6,
+	0xc1, 0x0f, 0xbf, 0xf3, 0x1e, 0x3d, 0x66, 0xff, 0x51, 0xa0, 0x47, 0x1d, 0x4c, 0xea, 0xb3, 0x8c,
+	0xbd, 0xf1, 0xbf, 0x01, 0x00, 0xbb, 0xee, 0xec, 0x7d, 0xbc, 0x20, 0x00, 0x00,
 }
 
 func (this *Group) Equal(that interface{}) bool {
@@ -1911,6 +2217,12 @@ func (this *Group) Equal(that interface{}) bool {
 	if this.ModuleOwner != that1.ModuleOwner {
 		return false
 	}
+	if this.SourceGroupID != that1.SourceGroupID {
+		return false
+	}
+	if this.Curve != that1.Curve {
+		return false
+	}
 	return true
 }
 func (this *GroupResult) Equal(that interface{}) bool {
@@ -2078,6 +2390,9 @@ func (this *DE) Equal(that interface{}) bool {
 	if !bytes.Equal(this.PubE, that1.PubE) {
 		return false
 	}
+	if this.Curve != that1.Curve {
+		return false
+	}
 	return true
 }
 func (this *DEQueue) Equal(that interface{}) bool {
@@ -2156,6 +2471,9 @@ func (this *Signing) Equal(that interface{}) bool {
 	if !this.CreatedTimestamp.Equal(that1.CreatedTimestamp) {
 		return false
 	}
+	if this.BatchSigningID != that1.BatchSigningID {
+		return false
+	}
 	return true
 }
 func (this *SigningAttempt) Equal(that interface{}) bool {
@@ -2304,6 +2622,9 @@ func (this *Member) Equal(that interface{}) bool {
 	if this.IsActive != that1.IsActive {
 		return false
 	}
+	if this.SourceMemberID != that1.SourceMemberID {
+		return false
+	}
 	return true
 }
 func (this *Confirm) Equal(that interface{}) bool {
@@ -2661,76 +2982,197 @@ func (this *SigningExpirations) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (m *Group) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *PendingBatch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Group) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ModuleOwner) > 0 {
-		i -= len(m.ModuleOwner)
-		copy(dAtA[i:], m.ModuleOwner)
-		i = encodeVarintTss(dAtA, i, uint64(len(m.ModuleOwner)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.CreatedHeight != 0 {
-		i = encodeVarintTss(dAtA, i, uint64(m.CreatedHeight))
-		i--
-		dAtA[i] = 0x30
+	that1, ok := that.(*PendingBatch)
+	if !ok {
+		that2, ok := that.(PendingBatch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Status != 0 {
-		i = encodeVarintTss(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x28
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintTss(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0x22
+	if this.GroupID != that1.GroupID {
+		return false
 	}
-	if m.Threshold != 0 {
-		i = encodeVarintTss(dAtA, i, uint64(m.Threshold))
-		i--
-		dAtA[i] = 0x18
+	if this.StartHeight != that1.StartHeight {
+		return false
 	}
-	if m.Size_ != 0 {
-		i = encodeVarintTss(dAtA, i, uint64(m.Size_))
-		i--
-		dAtA[i] = 0x10
+	if len(this.SigningIDs) != len(that1.SigningIDs) {
+		return false
 	}
-	if m.ID != 0 {
-		i = encodeVarintTss(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+	for i := range this.SigningIDs {
+		if this.SigningIDs[i] != that1.SigningIDs[i] {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *GroupResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *BatchSigning) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
+
+	that1, ok := that.(*BatchSigning)
+	if !ok {
+		that2, ok := that.(BatchSigning)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if !bytes.Equal(this.Root, that1.Root) {
+		return false
+	}
+	if len(this.SigningIDs) != len(that1.SigningIDs) {
+		return false
+	}
+	for i := range this.SigningIDs {
+		if this.SigningIDs[i] != that1.SigningIDs[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *BatchItemProof) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BatchItemProof)
+	if !ok {
+		that2, ok := that.(BatchItemProof)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SigningID != that1.SigningID {
+		return false
+	}
+	if this.BatchSigningID != that1.BatchSigningID {
+		return false
+	}
+	if !bytes.Equal(this.Leaf, that1.Leaf) {
+		return false
+	}
+	if len(this.Proof) != len(that1.Proof) {
+		return false
+	}
+	for i := range this.Proof {
+		if !bytes.Equal(this.Proof[i], that1.Proof[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Root, that1.Root) {
+		return false
+	}
+	return true
+}
+func (m *Group) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Group) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Curve != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Curve))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SourceGroupID != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.SourceGroupID))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ModuleOwner) > 0 {
+		i -= len(m.ModuleOwner)
+		copy(dAtA[i:], m.ModuleOwner)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.ModuleOwner)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Status != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
 func (m *GroupResult) MarshalTo(dAtA []byte) (int, error) {
@@ -2948,6 +3390,11 @@ func (m *DE) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Curve != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.Curve))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.PubE) > 0 {
 		i -= len(m.PubE)
 		copy(dAtA[i:], m.PubE)
@@ -3018,6 +3465,11 @@ func (m *Signing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BatchSigningID != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.BatchSigningID))
+		i--
+		dAtA[i] = 0x58
+	}
 	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedTimestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedTimestamp):])
 	if err2 != nil {
 		return 0, err2
@@ -3265,6 +3717,11 @@ func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SourceMemberID != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.SourceMemberID))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.IsActive {
 		i--
 		if m.IsActive {
@@ -3809,6 +4266,166 @@ func (m *SigningExpirations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SigningIDs) > 0 {
+		dAtA14 := make([]byte, len(m.SigningIDs)*10)
+		var j13 int
+		for _, num := range m.SigningIDs {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintTss(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GroupID != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.GroupID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchSigning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchSigning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchSigning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SigningIDs) > 0 {
+		dAtA16 := make([]byte, len(m.SigningIDs)*10)
+		var j15 int
+		for _, num := range m.SigningIDs {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintTss(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchItemProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchItemProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchItemProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTss(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Leaf) > 0 {
+		i -= len(m.Leaf)
+		copy(dAtA[i:], m.Leaf)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.Leaf)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BatchSigningID != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.BatchSigningID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SigningID != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.SigningID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTss(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTss(v)
 	base := offset
@@ -3849,7 +4466,13 @@ func (m *Group) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTss(uint64(l))
 	}
-	return n
+	if m.SourceGroupID != 0 {
+		n += 1 + sovTss(uint64(m.SourceGroupID))
+	}
+	if m.Curve != 0 {
+		n += 1 + sovTss(uint64(m.Curve))
+	}
+	return n
 }
 
 func (m *GroupResult) Size() (n int) {
@@ -3959,6 +4582,9 @@ func (m *DE) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTss(uint64(l))
 	}
+	if m.Curve != 0 {
+		n += 1 + sovTss(uint64(m.Curve))
+	}
 	return n
 }
 
@@ -4016,6 +4642,9 @@ func (m *Signing) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedTimestamp)
 	n += 1 + l + sovTss(uint64(l))
+	if m.BatchSigningID != 0 {
+		n += 1 + sovTss(uint64(m.BatchSigningID))
+	}
 	return n
 }
 
@@ -4121,6 +4750,9 @@ func (m *Member) Size() (n int) {
 	if m.IsActive {
 		n += 2
 	}
+	if m.SourceMemberID != 0 {
+		n += 1 + sovTss(uint64(m.SourceMemberID))
+	}
 	return n
 }
 
@@ -4334,6 +4966,80 @@ func (m *SigningExpirations) Size() (n int) {
 	return n
 }
 
+func (m *PendingBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupID != 0 {
+		n += 1 + sovTss(uint64(m.GroupID))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTss(uint64(m.StartHeight))
+	}
+	if len(m.SigningIDs) > 0 {
+		l = 0
+		for _, e := range m.SigningIDs {
+			l += sovTss(uint64(e))
+		}
+		n += 1 + sovTss(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *BatchSigning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTss(uint64(m.ID))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if len(m.SigningIDs) > 0 {
+		l = 0
+		for _, e := range m.SigningIDs {
+			l += sovTss(uint64(e))
+		}
+		n += 1 + sovTss(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *BatchItemProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SigningID != 0 {
+		n += 1 + sovTss(uint64(m.SigningID))
+	}
+	if m.BatchSigningID != 0 {
+		n += 1 + sovTss(uint64(m.BatchSigningID))
+	}
+	l = len(m.Leaf)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovTss(uint64(l))
+		}
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	return n
+}
+
 func sovTss(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4530,6 +5236,44 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 			}
 			m.ModuleOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceGroupID", wireType)
+			}
+			m.SourceGroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceGroupID |= github_com_bandprotocol_chain_v3_pkg_tss.GroupID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			m.Curve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Curve |= Curve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTss(dAtA[iNdEx:])
@@ -5239,6 +5983,25 @@ func (m *DE) Unmarshal(dAtA []byte) error {
 				m.PubE = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			m.Curve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Curve |= Curve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTss(dAtA[iNdEx:])
@@ -5641,6 +6404,25 @@ func (m *Signing) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSigningID", wireType)
+			}
+			m.BatchSigningID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSigningID |= github_com_bandprotocol_chain_v3_pkg_tss.SigningID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTss(dAtA[iNdEx:])
@@ -6373,6 +7155,25 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsActive = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceMemberID", wireType)
+			}
+			m.SourceMemberID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceMemberID |= github_com_bandprotocol_chain_v3_pkg_tss.MemberID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTss(dAtA[iNdEx:])
@@ -7814,6 +8615,537 @@ func (m *SigningExpirations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			m.GroupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupID |= github_com_bandprotocol_chain_v3_pkg_tss.GroupID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v github_com_bandprotocol_chain_v3_pkg_tss.SigningID
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTss
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_bandprotocol_chain_v3_pkg_tss.SigningID(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SigningIDs = append(m.SigningIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTss
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTss
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTss
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SigningIDs) == 0 {
+					m.SigningIDs = make([]github_com_bandprotocol_chain_v3_pkg_tss.SigningID, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_bandprotocol_chain_v3_pkg_tss.SigningID
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTss
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_bandprotocol_chain_v3_pkg_tss.SigningID(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SigningIDs = append(m.SigningIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningIDs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchSigning) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchSigning: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchSigning: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= github_com_bandprotocol_chain_v3_pkg_tss.SigningID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v github_com_bandprotocol_chain_v3_pkg_tss.SigningID
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTss
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_bandprotocol_chain_v3_pkg_tss.SigningID(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SigningIDs = append(m.SigningIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTss
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTss
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTss
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SigningIDs) == 0 {
+					m.SigningIDs = make([]github_com_bandprotocol_chain_v3_pkg_tss.SigningID, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_bandprotocol_chain_v3_pkg_tss.SigningID
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTss
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_bandprotocol_chain_v3_pkg_tss.SigningID(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SigningIDs = append(m.SigningIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningIDs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchItemProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchItemProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchItemProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningID", wireType)
+			}
+			m.SigningID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigningID |= github_com_bandprotocol_chain_v3_pkg_tss.SigningID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSigningID", wireType)
+			}
+			m.BatchSigningID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSigningID |= github_com_bandprotocol_chain_v3_pkg_tss.SigningID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaf", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leaf = append(m.Leaf[:0], dAtA[iNdEx:postIndex]...)
+			if m.Leaf == nil {
+				m.Leaf = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTss(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
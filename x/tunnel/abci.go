@@ -11,6 +11,9 @@ import (
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker)
 
+	// Retry the failed route deliveries of packets that are due at this block.
+	k.ProcessPacketRetries(ctx)
+
 	// Produce packets for all tunnels that are active and have passed the interval time trigger
 	// or deviated from the last price to destination route.
 	// Error should not happen here since the tunnel is already validated.
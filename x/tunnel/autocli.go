@@ -64,6 +64,46 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "sequence"},
 					},
 				},
+				{
+					RpcMethod: "NextTrigger",
+					Use:       "next-trigger [tunnel-id]",
+					Short:     "Query the next scheduled trigger time of a tunnel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "tunnel_id"},
+					},
+				},
+				{
+					RpcMethod: "PacketRetries",
+					Use:       "packet-retries [tunnel-id]",
+					Short:     "Query all pending packet retries of a tunnel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "tunnel_id"},
+					},
+				},
+				{
+					RpcMethod: "DeadLetterPackets",
+					Use:       "dead-letter-packets [tunnel-id]",
+					Short:     "Query all dead-lettered packets of a tunnel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "tunnel_id"},
+					},
+				},
+				{
+					RpcMethod: "TopUpConfig",
+					Use:       "top-up-config [tunnel-id]",
+					Short:     "Query the top-up config of a tunnel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "tunnel_id"},
+					},
+				},
+				{
+					RpcMethod: "RemainingPackets",
+					Use:       "remaining-packets [tunnel-id]",
+					Short:     "Estimate the number of packets that the fee payer of a tunnel can pay for",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "tunnel_id"},
+					},
+				},
 				{
 					RpcMethod: "TotalFees",
 					Use:       "total-fees",
@@ -14,6 +14,17 @@ import (
 	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
+const (
+	flagRouteIndex = "route-index"
+	flagSchedule   = "schedule"
+	flagEncoder    = "encoder"
+	flagFunder     = "funder"
+	flagGrantType  = "grant-type"
+	flagTopUp      = "top-up-amount"
+	flagBatch      = "batch"
+	flagGroupName  = "group-name"
+)
+
 // GetTxCmd returns a root CLI command handler for all x/tunnel transaction commands.
 func GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -32,6 +43,9 @@ func GetTxCmd() *cobra.Command {
 		GetTxCmdActivateTunnel(),
 		GetTxCmdDeactivateTunnel(),
 		GetTxCmdTriggerTunnel(),
+		GetTxCmdRetryPacket(),
+		GetTxCmdSetTopUpConfig(),
+		GetTxCmdRemoveTopUpConfig(),
 		GetTxCmdDepositToTunnel(),
 		GetTxCmdWithdrawFromTunnel(),
 	)
@@ -54,6 +68,8 @@ func GetTxCmdCreateTunnel() *cobra.Command {
 		GetTxCmdCreateIBCHookTunnel(),
 		GetTxCmdCreateRouterTunnel(),
 		GetTxCmdCreateAxelarTunnel(),
+		GetTxCmdCreateLocalTunnel(),
+		GetTxCmdCreateMultiRouteTunnel(),
 	)
 
 	return txCmd
@@ -93,12 +109,30 @@ func GetTxCmdCreateTSSTunnel() *cobra.Command {
 				return err
 			}
 
+			schedule, err := cmd.Flags().GetString(flagSchedule)
+			if err != nil {
+				return err
+			}
+
+			batch, err := cmd.Flags().GetBool(flagBatch)
+			if err != nil {
+				return err
+			}
+
+			groupName, err := cmd.Flags().GetString(flagGroupName)
+			if err != nil {
+				return err
+			}
+
 			msg, err := types.NewMsgCreateTSSTunnel(
 				signalDeviations.ToSignalDeviations(),
 				interval,
+				schedule,
 				destChainID,
 				destContractAddr,
 				feedstypes.Encoder(encoder),
+				batch,
+				groupName,
 				initialDeposit,
 				clientCtx.GetFromAddress().String(),
 			)
@@ -110,6 +144,9 @@ func GetTxCmdCreateTSSTunnel() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagSchedule, "", "The cron expression in UTC for delivering the signal prices instead of the interval")
+	cmd.Flags().Bool(flagBatch, false, "Sign packets together with other requests over a merkle root")
+	cmd.Flags().String(flagGroupName, "", "The name of the bandtss named group to sign packets, if not the current group")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -141,9 +178,15 @@ func GetTxCmdCreateIBCTunnel() *cobra.Command {
 				return err
 			}
 
+			schedule, err := cmd.Flags().GetString(flagSchedule)
+			if err != nil {
+				return err
+			}
+
 			msg, err := types.NewMsgCreateIBCTunnel(
 				signalDeviations.ToSignalDeviations(),
 				interval,
+				schedule,
 				initialDeposit,
 				clientCtx.GetFromAddress().String(),
 			)
@@ -155,6 +198,7 @@ func GetTxCmdCreateIBCTunnel() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagSchedule, "", "The cron expression in UTC for delivering the signal prices instead of the interval")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -188,11 +232,23 @@ func GetTxCmdCreateIBCHookTunnel() *cobra.Command {
 				return err
 			}
 
+			schedule, err := cmd.Flags().GetString(flagSchedule)
+			if err != nil {
+				return err
+			}
+
+			encoder, err := cmd.Flags().GetInt32(flagEncoder)
+			if err != nil {
+				return err
+			}
+
 			msg, err := types.NewMsgCreateIBCHookTunnel(
 				signalDeviations.ToSignalDeviations(),
 				interval,
+				schedule,
 				channelID,
 				destinationContractAddress,
+				feedstypes.Encoder(encoder),
 				initialDeposit,
 				clientCtx.GetFromAddress().String(),
 			)
@@ -204,6 +260,8 @@ func GetTxCmdCreateIBCHookTunnel() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagSchedule, "", "The cron expression in UTC for delivering the signal prices instead of the interval")
+	cmd.Flags().Int32(flagEncoder, 0, "The encoder of the packet data; the default format of the route is used if it is 0")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -242,12 +300,24 @@ func GetTxCmdCreateRouterTunnel() *cobra.Command {
 				return err
 			}
 
+			schedule, err := cmd.Flags().GetString(flagSchedule)
+			if err != nil {
+				return err
+			}
+
+			encoder, err := cmd.Flags().GetInt32(flagEncoder)
+			if err != nil {
+				return err
+			}
+
 			msg, err := types.NewMsgCreateRouterTunnel(
 				signalDeviations.ToSignalDeviations(),
 				interval,
+				schedule,
 				destinationChainID,
 				destinationContractAddress,
 				destinationGasLimit,
+				feedstypes.Encoder(encoder),
 				initialDeposit,
 				clientCtx.GetFromAddress().String(),
 			)
@@ -259,6 +329,8 @@ func GetTxCmdCreateRouterTunnel() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagSchedule, "", "The cron expression in UTC for delivering the signal prices instead of the interval")
+	cmd.Flags().Int32(flagEncoder, 0, "The encoder of the packet data; the default format of the route is used if it is 0")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -297,9 +369,15 @@ func GetTxCmdCreateAxelarTunnel() *cobra.Command {
 				return err
 			}
 
+			schedule, err := cmd.Flags().GetString(flagSchedule)
+			if err != nil {
+				return err
+			}
+
 			msg, err := types.NewMsgCreateAxelarTunnel(
 				signalInfos.ToSignalDeviations(),
 				interval,
+				schedule,
 				destinationChainID,
 				destinationContractAddress,
 				axelarFee,
@@ -314,6 +392,118 @@ func GetTxCmdCreateAxelarTunnel() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagSchedule, "", "The cron expression in UTC for delivering the signal prices instead of the interval")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetTxCmdCreateLocalTunnel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "local [consumer] [initial-deposit] [interval] [signal-deviations-json-file]",
+		Short: "Create a new local tunnel",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			consumer := args[0]
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			interval, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			signalDeviations, err := parseSignalDeviations(args[3])
+			if err != nil {
+				return err
+			}
+
+			schedule, err := cmd.Flags().GetString(flagSchedule)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgCreateLocalTunnel(
+				signalDeviations.ToSignalDeviations(),
+				interval,
+				schedule,
+				consumer,
+				initialDeposit,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagSchedule, "", "The cron expression in UTC for delivering the signal prices instead of the interval")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetTxCmdCreateMultiRouteTunnel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi [routes-json-file] [initial-deposit] [interval] [signal-deviations-json-file]",
+		Short: "Create a new tunnel with multiple routes",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			routes, err := parseRoutes(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			interval, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			signalDeviations, err := parseSignalDeviations(args[3])
+			if err != nil {
+				return err
+			}
+
+			schedule, err := cmd.Flags().GetString(flagSchedule)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgCreateTunnel(
+				signalDeviations.ToSignalDeviations(),
+				interval,
+				schedule,
+				routes,
+				initialDeposit,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagSchedule, "", "The cron expression in UTC for delivering the signal prices instead of the interval")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -332,6 +522,7 @@ func GetTxCmdUpdateRoute() *cobra.Command {
 		GetTxCmdUpdateIBCHookRoute(),
 		GetTxCmdUpdateRouterRoute(),
 		GetTxCmdUpdateAxelarRoute(),
+		GetTxCmdUpdateLocalRoute(),
 	)
 
 	return txCmd
@@ -353,8 +544,14 @@ func GetTxCmdUpdateIBCRoute() *cobra.Command {
 				return err
 			}
 
+			routeIndex, err := cmd.Flags().GetUint64(flagRouteIndex)
+			if err != nil {
+				return err
+			}
+
 			msg, err := types.NewMsgUpdateIBCRoute(
 				id,
+				routeIndex,
 				args[1],
 				clientCtx.GetFromAddress().String(),
 			)
@@ -366,6 +563,7 @@ func GetTxCmdUpdateIBCRoute() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(flagRouteIndex, 0, "The index of the route to update in the routes of the tunnel")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -387,13 +585,25 @@ func GetTxCmdUpdateIBCHookRoute() *cobra.Command {
 				return err
 			}
 
+			routeIndex, err := cmd.Flags().GetUint64(flagRouteIndex)
+			if err != nil {
+				return err
+			}
+
 			channelID := args[1]
 			destContractAddr := args[2]
 
+			encoder, err := cmd.Flags().GetInt32(flagEncoder)
+			if err != nil {
+				return err
+			}
+
 			msg, err := types.NewMsgUpdateIBCHookRoute(
 				id,
+				routeIndex,
 				channelID,
 				destContractAddr,
+				feedstypes.Encoder(encoder),
 				clientCtx.GetFromAddress().String(),
 			)
 			if err != nil {
@@ -404,6 +614,8 @@ func GetTxCmdUpdateIBCHookRoute() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(flagRouteIndex, 0, "The index of the route to update in the routes of the tunnel")
+	cmd.Flags().Int32(flagEncoder, 0, "The encoder of the packet data; the default format of the route is used if it is 0")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -425,6 +637,11 @@ func GetTxCmdUpdateRouterRoute() *cobra.Command {
 				return err
 			}
 
+			routeIndex, err := cmd.Flags().GetUint64(flagRouteIndex)
+			if err != nil {
+				return err
+			}
+
 			destinationChainID := args[1]
 			destinationContractAddr := args[2]
 
@@ -433,11 +650,18 @@ func GetTxCmdUpdateRouterRoute() *cobra.Command {
 				return err
 			}
 
+			encoder, err := cmd.Flags().GetInt32(flagEncoder)
+			if err != nil {
+				return err
+			}
+
 			msg, err := types.NewMsgUpdateRouterRoute(
 				id,
+				routeIndex,
 				destinationChainID,
 				destinationContractAddr,
 				destinationGasLimit,
+				feedstypes.Encoder(encoder),
 				clientCtx.GetFromAddress().String(),
 			)
 			if err != nil {
@@ -448,6 +672,8 @@ func GetTxCmdUpdateRouterRoute() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(flagRouteIndex, 0, "The index of the route to update in the routes of the tunnel")
+	cmd.Flags().Int32(flagEncoder, 0, "The encoder of the packet data; the default format of the route is used if it is 0")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -469,6 +695,11 @@ func GetTxCmdUpdateAxelarRoute() *cobra.Command {
 				return err
 			}
 
+			routeIndex, err := cmd.Flags().GetUint64(flagRouteIndex)
+			if err != nil {
+				return err
+			}
+
 			destinationChainID := args[1]
 			destinationContractAddress := args[2]
 
@@ -479,6 +710,7 @@ func GetTxCmdUpdateAxelarRoute() *cobra.Command {
 
 			msg, err := types.NewMsgUpdateAxelarRoute(
 				id,
+				routeIndex,
 				destinationChainID,
 				destinationContractAddress,
 				axelarFee,
@@ -492,6 +724,48 @@ func GetTxCmdUpdateAxelarRoute() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(flagRouteIndex, 0, "The index of the route to update in the routes of the tunnel")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetTxCmdUpdateLocalRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "local [tunnel-id] [consumer]",
+		Short: "Update local route of a local tunnel",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			routeIndex, err := cmd.Flags().GetUint64(flagRouteIndex)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgUpdateLocalRoute(
+				id,
+				routeIndex,
+				args[1],
+				clientCtx.GetFromAddress().String(),
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagRouteIndex, 0, "The index of the route to update in the routes of the tunnel")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -523,16 +797,23 @@ func GetTxCmdUpdateSignalsAndInterval() *cobra.Command {
 				return err
 			}
 
+			schedule, err := cmd.Flags().GetString(flagSchedule)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgUpdateSignalsAndInterval(
 				id,
 				signalDeviations.ToSignalDeviations(),
 				interval,
+				schedule,
 				clientCtx.GetFromAddress().String(),
 			)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(flagSchedule, "", "The cron expression in UTC for delivering the signal prices instead of the interval")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -647,6 +928,134 @@ func GetTxCmdTriggerTunnel() *cobra.Command {
 	return cmd
 }
 
+func GetTxCmdRetryPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry-packet [tunnel-id] [sequence] [route-index]",
+		Short: "Retry a dead-lettered packet delivery of a tunnel",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tunnelID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			routeIndex, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRetryPacket(tunnelID, sequence, routeIndex, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetTxCmdSetTopUpConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-top-up-config [tunnel-id] [low-balance-threshold]",
+		Short: "Set the low balance threshold of the fee payer of a tunnel and an optional funder to top it up",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tunnelID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			threshold, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			funder, err := cmd.Flags().GetString(flagFunder)
+			if err != nil {
+				return err
+			}
+
+			grantTypeStr, err := cmd.Flags().GetString(flagGrantType)
+			if err != nil {
+				return err
+			}
+
+			grantType, err := parseFunderGrantType(grantTypeStr)
+			if err != nil {
+				return err
+			}
+
+			topUpAmountStr, err := cmd.Flags().GetString(flagTopUp)
+			if err != nil {
+				return err
+			}
+
+			topUpAmount, err := sdk.ParseCoinsNormalized(topUpAmountStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetTopUpConfig(
+				tunnelID,
+				threshold,
+				funder,
+				grantType,
+				topUpAmount,
+				clientCtx.GetFromAddress().String(),
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagFunder, "", "The address of the account that tops up the fee payer")
+	cmd.Flags().String(flagGrantType, "", "The type of grant from the funder to the fee payer: feegrant or authz")
+	cmd.Flags().String(flagTopUp, "", "The amount that the funder sends to the fee payer for each top-up")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetTxCmdRemoveTopUpConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-top-up-config [tunnel-id]",
+		Short: "Remove the top-up config of a tunnel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tunnelID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveTopUpConfig(tunnelID, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetTxCmdDepositToTunnel() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "deposit-to-tunnel [tunnel-id] [amount]",
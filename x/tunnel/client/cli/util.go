@@ -3,8 +3,11 @@ package cli
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+
 	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
@@ -15,8 +18,16 @@ type SignalDeviations struct {
 
 // SignalDeviation represents the signal information without soft deviation, which may be utilized in the future for deviation adjustments
 type SignalDeviation struct {
-	SignalID     string `json:"signal_id"`
-	DeviationBPS uint64 `json:"deviation_bps"`
+	SignalID     string      `json:"signal_id"`
+	DeviationBPS uint64      `json:"deviation_bps"`
+	Derivation   *Derivation `json:"derivation,omitempty"`
+}
+
+// Derivation represents the derivation of a derived signal in the file
+type Derivation struct {
+	Method          string   `json:"method"`
+	SourceSignalIDs []string `json:"source_signal_ids"`
+	Exponent        int32    `json:"exponent,omitempty"`
 }
 
 // ToSignalDeviations converts signal information to types.SignalDeviation, excluding soft deviation.
@@ -29,6 +40,15 @@ func (ss SignalDeviations) ToSignalDeviations() []types.SignalDeviation {
 			SoftDeviationBPS: sd.DeviationBPS,
 			HardDeviationBPS: sd.DeviationBPS,
 		}
+
+		if sd.Derivation != nil {
+			signalDeviation.Derivation = types.NewDerivation(
+				types.DerivationMethod(types.DerivationMethod_value[sd.Derivation.Method]),
+				sd.Derivation.SourceSignalIDs,
+				sd.Derivation.Exponent,
+			)
+		}
+
 		signalDeviations = append(signalDeviations, signalDeviation)
 	}
 	return signalDeviations
@@ -51,5 +71,66 @@ func parseSignalDeviations(signalDeviationsFile string) (SignalDeviations, error
 		return SignalDeviations{}, err
 	}
 
+	for _, sd := range signalDeviations.SignalDeviations {
+		if sd.Derivation == nil {
+			continue
+		}
+		if _, ok := types.DerivationMethod_value[sd.Derivation.Method]; !ok {
+			return SignalDeviations{}, fmt.Errorf(
+				"invalid derivation method of signal %s: %s",
+				sd.SignalID,
+				sd.Derivation.Method,
+			)
+		}
+	}
+
 	return signalDeviations, nil
 }
+
+// Routes represents the routes in the file
+type Routes struct {
+	Routes []json.RawMessage `json:"routes"`
+}
+
+// parseRoutes parses the routes from the given file
+func parseRoutes(cdc codec.JSONCodec, routesFile string) ([]types.RouteI, error) {
+	var routes Routes
+
+	if routesFile == "" {
+		return nil, errors.New("routes file is required")
+	}
+
+	contents, err := os.ReadFile(routesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(contents, &routes); err != nil {
+		return nil, err
+	}
+
+	routeValues := make([]types.RouteI, 0, len(routes.Routes))
+	for _, raw := range routes.Routes {
+		var route types.RouteI
+		if err := cdc.UnmarshalInterfaceJSON(raw, &route); err != nil {
+			return nil, err
+		}
+		routeValues = append(routeValues, route)
+	}
+
+	return routeValues, nil
+}
+
+// parseFunderGrantType parses the grant type of a funder from its short name
+func parseFunderGrantType(grantType string) (types.FunderGrantType, error) {
+	switch grantType {
+	case "":
+		return types.FUNDER_GRANT_TYPE_UNSPECIFIED, nil
+	case "feegrant":
+		return types.FUNDER_GRANT_TYPE_FEEGRANT, nil
+	case "authz":
+		return types.FUNDER_GRANT_TYPE_AUTHZ, nil
+	default:
+		return types.FUNDER_GRANT_TYPE_UNSPECIFIED, fmt.Errorf("unknown grant type: %s", grantType)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
 func TestParseSignalDeviations(t *testing.T) {
@@ -21,6 +23,47 @@ func TestParseSignalDeviations(t *testing.T) {
 	require.Equal(t, signalDeviations, result.SignalDeviations)
 }
 
+func TestParseSignalDeviationsWithDerivation(t *testing.T) {
+	signalDeviations := []SignalDeviation{
+		{SignalID: "CS:BTC-USD", DeviationBPS: 2000},
+		{
+			SignalID:     "DS:ETH-BTC",
+			DeviationBPS: 4000,
+			Derivation: &Derivation{
+				Method:          "DERIVATION_METHOD_CROSS",
+				SourceSignalIDs: []string{"CS:ETH-USD", "CS:BTC-USD"},
+			},
+		},
+	}
+	file, cleanup := createTempSignalDeviationFile(signalDeviations)
+	defer cleanup()
+
+	result, err := parseSignalDeviations(file)
+	require.NoError(t, err)
+	require.Equal(t, signalDeviations, result.SignalDeviations)
+	require.Equal(t, []types.SignalDeviation{
+		{SignalID: "CS:BTC-USD", SoftDeviationBPS: 2000, HardDeviationBPS: 2000},
+		{
+			SignalID:         "DS:ETH-BTC",
+			SoftDeviationBPS: 4000,
+			HardDeviationBPS: 4000,
+			Derivation: types.NewDerivation(
+				types.DERIVATION_METHOD_CROSS,
+				[]string{"CS:ETH-USD", "CS:BTC-USD"},
+				0,
+			),
+		},
+	}, result.ToSignalDeviations())
+
+	// invalid derivation method
+	signalDeviations[1].Derivation.Method = "DERIVATION_METHOD_UNKNOWN"
+	file, cleanup = createTempSignalDeviationFile(signalDeviations)
+	defer cleanup()
+
+	_, err = parseSignalDeviations(file)
+	require.ErrorContains(t, err, "invalid derivation method")
+}
+
 // createTempSignalDeviationFile is a helper function to create a temporary file with signal info JSON content
 func createTempSignalDeviationFile(signalDeviations []SignalDeviation) (string, func()) {
 	file, err := os.CreateTemp("", "signalDeviations*.json")
@@ -43,3 +86,20 @@ func createTempSignalDeviationFile(signalDeviations []SignalDeviation) (string,
 
 	return filePath, func() { os.Remove(filePath) }
 }
+
+func TestParseFunderGrantType(t *testing.T) {
+	grantType, err := parseFunderGrantType("feegrant")
+	require.NoError(t, err)
+	require.Equal(t, types.FUNDER_GRANT_TYPE_FEEGRANT, grantType)
+
+	grantType, err = parseFunderGrantType("authz")
+	require.NoError(t, err)
+	require.Equal(t, types.FUNDER_GRANT_TYPE_AUTHZ, grantType)
+
+	grantType, err = parseFunderGrantType("")
+	require.NoError(t, err)
+	require.Equal(t, types.FUNDER_GRANT_TYPE_UNSPECIFIED, grantType)
+
+	_, err = parseFunderGrantType("vesting")
+	require.ErrorContains(t, err, "unknown grant type")
+}
@@ -32,12 +32,12 @@ func InitGenesis(ctx sdk.Context, k Keeper, data *types.GenesisState) {
 			k.SetActiveTunnelID(ctx, t.ID)
 		}
 
-		route, err := t.GetRouteValue()
+		routes, err := t.GetRouteValues()
 		if err != nil {
-			panic(fmt.Sprintf("cannot get route for tunnel ID: %d", t.ID))
+			panic(fmt.Sprintf("cannot get routes for tunnel ID: %d", t.ID))
 		}
 
-		if _, ok := route.(*types.IBCRoute); ok {
+		if hasIBCRoute(routes) {
 			_, err = k.ensureIBCPort(ctx, t.ID)
 			if err != nil {
 				panic(fmt.Sprintf("cannot bind port for tunnel ID: %d", t.ID))
@@ -52,6 +52,11 @@ func InitGenesis(ctx sdk.Context, k Keeper, data *types.GenesisState) {
 		totalDeposits = totalDeposits.Add(deposit.Amount...)
 	}
 
+	// set the top-up configs
+	for _, config := range data.TopUpConfigs {
+		k.SetTopUpConfig(ctx, config)
+	}
+
 	// set the total fees
 	k.SetTotalFees(ctx, data.TotalFees)
 
@@ -70,10 +75,11 @@ func InitGenesis(ctx sdk.Context, k Keeper, data *types.GenesisState) {
 // ExportGenesis returns the module's exported genesis
 func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:      k.GetParams(ctx),
-		TunnelCount: k.GetTunnelCount(ctx),
-		Tunnels:     k.GetTunnels(ctx),
-		Deposits:    k.GetAllDeposits(ctx),
-		TotalFees:   k.GetTotalFees(ctx),
+		Params:       k.GetParams(ctx),
+		TunnelCount:  k.GetTunnelCount(ctx),
+		Tunnels:      k.GetTunnels(ctx),
+		Deposits:     k.GetAllDeposits(ctx),
+		TotalFees:    k.GetTotalFees(ctx),
+		TopUpConfigs: k.GetAllTopUpConfigs(ctx),
 	}
 }
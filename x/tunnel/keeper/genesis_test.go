@@ -32,7 +32,7 @@ func (s *KeeperTestSuite) TestInitExportGenesis() {
 		Return(sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(100)))).
 		AnyTimes()
 
-	t, err := types.NewTunnel(1, 0, types.NewIBCRoute("channel-0"), "", nil, 0, nil, false, 0, "")
+	t, err := types.NewTunnel(1, 0, []types.RouteI{types.NewIBCRoute("channel-0")}, "", nil, 0, "", nil, false, 0, "")
 	s.Require().NoError(err)
 	// Create a valid genesis state
 	genesisState := &types.GenesisState{
@@ -44,6 +44,15 @@ func (s *KeeperTestSuite) TestInitExportGenesis() {
 		TotalFees: types.TotalFees{
 			TotalBasePacketFee: sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(100))),
 		},
+		TopUpConfigs: []types.TopUpConfig{
+			types.NewTopUpConfig(
+				1,
+				sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(1000))),
+				"",
+				types.FUNDER_GRANT_TYPE_UNSPECIFIED,
+				nil,
+			),
+		},
 	}
 
 	// Initialize the genesis state
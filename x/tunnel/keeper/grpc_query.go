@@ -141,7 +141,126 @@ func (q queryServer) Packet(c context.Context, req *types.QueryPacketRequest) (*
 	if err != nil {
 		return nil, err
 	}
-	return &types.QueryPacketResponse{Packet: &packet}, nil
+	return &types.QueryPacketResponse{Packet: &packet, FailedRouteIndices: packet.GetFailedRouteIndices()}, nil
+}
+
+// NextTrigger queries the next scheduled trigger of a tunnel.
+func (q queryServer) NextTrigger(
+	c context.Context,
+	req *types.QueryNextTriggerRequest,
+) (*types.QueryNextTriggerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	tunnel, err := q.k.GetTunnel(ctx, req.TunnelId)
+	if err != nil {
+		return nil, err
+	}
+
+	latestPrices, err := q.k.GetLatestPrices(ctx, req.TunnelId)
+	if err != nil {
+		return nil, err
+	}
+
+	nextTrigger, err := tunnel.NextTriggerTime(latestPrices.LastInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextTriggerTime int64
+	if !nextTrigger.IsZero() {
+		nextTriggerTime = nextTrigger.Unix()
+	}
+
+	return &types.QueryNextTriggerResponse{NextTriggerTime: nextTriggerTime}, nil
+}
+
+// PacketRetries queries all pending packet retries of a tunnel.
+func (q queryServer) PacketRetries(
+	c context.Context,
+	req *types.QueryPacketRetriesRequest,
+) (*types.QueryPacketRetriesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(q.k.storeKey), types.TunnelPacketRetriesStoreKey(req.TunnelId))
+	filteredRetries, pageRes, err := query.GenericFilteredPaginate(
+		q.k.cdc,
+		store,
+		req.Pagination,
+		func(key []byte, r *types.PacketRetry) (*types.PacketRetry, error) {
+			return r, nil
+		}, func() *types.PacketRetry {
+			return &types.PacketRetry{}
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryPacketRetriesResponse{PacketRetries: filteredRetries, Pagination: pageRes}, nil
+}
+
+// TopUpConfig queries the top-up config of a tunnel.
+func (q queryServer) TopUpConfig(
+	c context.Context,
+	req *types.QueryTopUpConfigRequest,
+) (*types.QueryTopUpConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	config, found := q.k.GetTopUpConfig(ctx, req.TunnelId)
+	if !found {
+		return nil, types.ErrTopUpConfigNotFound.Wrapf("tunnelID %d", req.TunnelId)
+	}
+
+	return &types.QueryTopUpConfigResponse{TopUpConfig: config}, nil
+}
+
+// RemainingPackets estimates the number of packets that the fee payer of a tunnel can pay for.
+func (q queryServer) RemainingPackets(
+	c context.Context,
+	req *types.QueryRemainingPacketsRequest,
+) (*types.QueryRemainingPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	tunnel, err := q.k.GetTunnel(ctx, req.TunnelId)
+	if err != nil {
+		return nil, err
+	}
+
+	feePerPacket, err := q.k.EstimateFeePerPacket(ctx, tunnel)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := q.k.bankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(tunnel.FeePayer))
+
+	return &types.QueryRemainingPacketsResponse{
+		RemainingPackets: EstimateRemainingPackets(balance, feePerPacket),
+		FeePerPacket:     feePerPacket,
+		FeePayerBalance:  balance,
+	}, nil
+}
+
+// DeadLetterPackets queries all dead-lettered packets of a tunnel.
+func (q queryServer) DeadLetterPackets(
+	c context.Context,
+	req *types.QueryDeadLetterPacketsRequest,
+) (*types.QueryDeadLetterPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(q.k.storeKey), types.TunnelDeadLetterPacketsStoreKey(req.TunnelId))
+	filteredDeadLetters, pageRes, err := query.GenericFilteredPaginate(
+		q.k.cdc,
+		store,
+		req.Pagination,
+		func(key []byte, d *types.DeadLetterPacket) (*types.DeadLetterPacket, error) {
+			return d, nil
+		}, func() *types.DeadLetterPacket {
+			return &types.DeadLetterPacket{}
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryDeadLetterPacketsResponse{DeadLetterPackets: filteredDeadLetters, Pagination: pageRes}, nil
 }
 
 func (q queryServer) TotalFees(
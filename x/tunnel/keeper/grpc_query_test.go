@@ -1,10 +1,15 @@
 package keeper_test
 
 import (
+	"time"
+
+	"go.uber.org/mock/gomock"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
@@ -55,7 +60,7 @@ func (s *KeeperTestSuite) TestGRPCQueryDeposits() {
 		DestinationChainID:         "1",
 		DestinationContractAddress: "0x123",
 	}
-	err := tunnel.SetRoute(&r)
+	err := tunnel.SetRoutes(&r)
 	s.Require().NoError(err)
 	k.SetTunnel(ctx, tunnel)
 
@@ -93,7 +98,7 @@ func (s *KeeperTestSuite) TestGRPCQueryDeposit() {
 		DestinationChainID:         "1",
 		DestinationContractAddress: "0x123",
 	}
-	err := tunnel.SetRoute(&r)
+	err := tunnel.SetRoutes(&r)
 	s.Require().NoError(err)
 	k.SetTunnel(ctx, tunnel)
 
@@ -131,7 +136,7 @@ func (s *KeeperTestSuite) TestGRPCQueryPackets() {
 		DestinationChainID:         "1",
 		DestinationContractAddress: "0x123",
 	}
-	err := tunnel.SetRoute(&r)
+	err := tunnel.SetRoutes(&r)
 	s.Require().NoError(err)
 
 	k.SetTunnel(ctx, tunnel)
@@ -148,15 +153,15 @@ func (s *KeeperTestSuite) TestGRPCQueryPackets() {
 		TunnelID: 2,
 		Sequence: 1,
 	}
-	err = packet1.SetReceipt(&types.TSSPacketReceipt{
+	err = packet1.AddReceipt(&types.TSSPacketReceipt{
 		SigningID: 1,
 	})
 	s.Require().NoError(err)
-	err = packet2.SetReceipt(&types.TSSPacketReceipt{
+	err = packet2.AddReceipt(&types.TSSPacketReceipt{
 		SigningID: 2,
 	})
 	s.Require().NoError(err)
-	err = packet3.SetReceipt(&types.TSSPacketReceipt{
+	err = packet3.AddReceipt(&types.TSSPacketReceipt{
 		SigningID: 3,
 	})
 	s.Require().NoError(err)
@@ -187,7 +192,7 @@ func (s *KeeperTestSuite) TestGRPCQueryPacket() {
 		DestinationChainID:         "1",
 		DestinationContractAddress: "0x123",
 	}
-	err := tunnel.SetRoute(&r)
+	err := tunnel.SetRoutes(&r)
 	s.Require().NoError(err)
 	k.SetTunnel(ctx, tunnel)
 
@@ -195,7 +200,7 @@ func (s *KeeperTestSuite) TestGRPCQueryPacket() {
 		TunnelID: 1,
 		Sequence: 1,
 	}
-	err = packet1.SetReceipt(&types.TSSPacketReceipt{
+	err = packet1.AddReceipt(&types.TSSPacketReceipt{
 		SigningID: 1,
 	})
 	s.Require().NoError(err)
@@ -205,7 +210,9 @@ func (s *KeeperTestSuite) TestGRPCQueryPacket() {
 		TunnelID: 1,
 		Sequence: 2,
 	}
-	err = packet2.SetReceipt(&types.TSSPacketReceipt{})
+	err = packet2.AddReceipt(&types.TSSPacketReceipt{})
+	s.Require().NoError(err)
+	err = packet2.AddReceipt(types.NewFailedPacketReceipt("route failed"))
 	s.Require().NoError(err)
 	k.SetPacket(ctx, packet2)
 
@@ -216,6 +223,112 @@ func (s *KeeperTestSuite) TestGRPCQueryPacket() {
 	s.Require().NoError(err)
 	s.Require().NotNil(res)
 	s.Require().Equal(packet1, *res.Packet)
+	s.Require().Empty(res.FailedRouteIndices)
+
+	res, err = q.Packet(ctx, &types.QueryPacketRequest{
+		TunnelId: 1,
+		Sequence: 2,
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(res)
+	s.Require().Len(res.Packet.Receipts, 2)
+	s.Require().Equal([]uint64{1}, res.FailedRouteIndices)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryNextTrigger() {
+	ctx, k, q := s.ctx, s.keeper, s.queryServer
+
+	s.AddSampleTunnel(false)
+	lastInterval := time.Date(2024, 11, 29, 16, 0, 30, 0, time.UTC).Unix()
+	k.SetLatestPrices(ctx, types.NewLatestPrices(1, []feedstypes.Price{}, lastInterval))
+
+	// the sample tunnel triggers on its interval
+	res, err := q.NextTrigger(ctx, &types.QueryNextTriggerRequest{TunnelId: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(lastInterval+10, res.NextTriggerTime)
+
+	// weekdays 16:00 UTC
+	err = k.UpdateSignalsAndInterval(ctx, 1, []types.SignalDeviation{
+		{SignalID: "CS:BAND-USD", SoftDeviationBPS: 100, HardDeviationBPS: 100},
+	}, 10, "0 16 * * 1-5")
+	s.Require().NoError(err)
+	k.SetLatestPrices(ctx, types.NewLatestPrices(1, []feedstypes.Price{}, lastInterval))
+
+	res, err = q.NextTrigger(ctx, &types.QueryNextTriggerRequest{TunnelId: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(time.Date(2024, 12, 2, 16, 0, 0, 0, time.UTC).Unix(), res.NextTriggerTime)
+
+	_, err = q.NextTrigger(ctx, &types.QueryNextTriggerRequest{TunnelId: 2})
+	s.Require().ErrorIs(err, types.ErrTunnelNotFound)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryPacketRetries() {
+	ctx, k, q := s.ctx, s.keeper, s.queryServer
+
+	retry1 := types.NewPacketRetry(1, 1, 0, 0, 10, "route failed", nil)
+	retry2 := types.NewPacketRetry(1, 2, 1, 1, 30, "route failed", nil)
+	k.SetPacketRetry(ctx, retry1)
+	k.SetPacketRetry(ctx, retry2)
+	k.SetPacketRetry(ctx, types.NewPacketRetry(2, 1, 0, 0, 10, "route failed", nil))
+
+	res, err := q.PacketRetries(ctx, &types.QueryPacketRetriesRequest{TunnelId: 1})
+	s.Require().NoError(err)
+	s.Require().Equal([]*types.PacketRetry{&retry1, &retry2}, res.PacketRetries)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryDeadLetterPackets() {
+	ctx, k, q := s.ctx, s.keeper, s.queryServer
+
+	deadLetter1 := types.NewDeadLetterPacket(1, 1, 0, 5, "route failed", 100, nil)
+	deadLetter2 := types.NewDeadLetterPacket(1, 2, 1, 5, "route failed", 200, nil)
+	k.SetDeadLetterPacket(ctx, deadLetter1)
+	k.SetDeadLetterPacket(ctx, deadLetter2)
+	k.SetDeadLetterPacket(ctx, types.NewDeadLetterPacket(2, 1, 0, 5, "route failed", 100, nil))
+
+	res, err := q.DeadLetterPackets(ctx, &types.QueryDeadLetterPacketsRequest{TunnelId: 1})
+	s.Require().NoError(err)
+	s.Require().Equal([]*types.DeadLetterPacket{&deadLetter1, &deadLetter2}, res.DeadLetterPackets)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryTopUpConfig() {
+	ctx, k, q := s.ctx, s.keeper, s.queryServer
+
+	s.AddSampleTunnel(false)
+
+	_, err := q.TopUpConfig(ctx, &types.QueryTopUpConfigRequest{TunnelId: 1})
+	s.Require().ErrorIs(err, types.ErrTopUpConfigNotFound)
+
+	config := types.NewTopUpConfig(
+		1,
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 1000)),
+		"",
+		types.FUNDER_GRANT_TYPE_UNSPECIFIED,
+		nil,
+	)
+	k.SetTopUpConfig(ctx, config)
+
+	res, err := q.TopUpConfig(ctx, &types.QueryTopUpConfigRequest{TunnelId: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(config, res.TopUpConfig)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryRemainingPackets() {
+	ctx, q := s.ctx, s.queryServer
+
+	s.AddSampleTunnel(false)
+
+	balance := sdk.NewCoins(sdk.NewInt64Coin("uband", 1000))
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), gomock.Any()).Return(balance)
+	s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any(), "").Return(sdk.NewCoins(sdk.NewInt64Coin("uband", 20)), nil)
+
+	res, err := q.RemainingPackets(ctx, &types.QueryRemainingPacketsRequest{TunnelId: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(8), res.RemainingPackets)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uband", 120)), res.FeePerPacket)
+	s.Require().Equal(balance, res.FeePayerBalance)
+
+	_, err = q.RemainingPackets(ctx, &types.QueryRemainingPacketsRequest{TunnelId: 2})
+	s.Require().ErrorIs(err, types.ErrTunnelNotFound)
 }
 
 func (s *KeeperTestSuite) TestGRPCQueryTotalFees() {
@@ -4,8 +4,8 @@ import (
 	"math"
 
 	sdkmath "cosmossdk.io/math"
-	storetypes "cosmossdk.io/store/types"
 
+	"github.com/bandprotocol/chain/v3/pkg/gas"
 	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
@@ -19,6 +19,24 @@ func CreatePricesMap(prices []feedstypes.Price) map[string]feedstypes.Price {
 	return pricesMap
 }
 
+// CreateTunnelPricesMap returns a copy of the feeds prices map with the prices of the derived signals of
+// the given signal deviations computed from their source prices.
+func CreateTunnelPricesMap(
+	signalDeviations []types.SignalDeviation,
+	feedsPricesMap map[string]feedstypes.Price,
+	timestamp int64,
+) map[string]feedstypes.Price {
+	pricesMap := make(map[string]feedstypes.Price, len(signalDeviations))
+	for _, sd := range signalDeviations {
+		if sd.Derivation != nil {
+			pricesMap[sd.SignalID] = sd.Derivation.Compute(sd.SignalID, feedsPricesMap, timestamp)
+		} else if price, ok := feedsPricesMap[sd.SignalID]; ok {
+			pricesMap[sd.SignalID] = price
+		}
+	}
+	return pricesMap
+}
+
 // GenerateNewPrices generates new prices based on the current prices and signal deviations.
 func GenerateNewPrices(
 	signalDeviations []types.SignalDeviation,
@@ -76,12 +94,15 @@ func calculateDeviationBPS(oldPrice, newPrice sdkmath.Int) sdkmath.Int {
 
 // IsOutOfGasError checks if the error object is an out of gas or gas overflow error type
 func IsOutOfGasError(err any) (bool, string) {
-	switch e := err.(type) {
-	case storetypes.ErrorOutOfGas:
-		return true, e.Descriptor
-	case storetypes.ErrorGasOverflow:
-		return true, e.Descriptor
-	default:
-		return false, ""
+	return gas.IsOutOfGasError(err)
+}
+
+// hasIBCRoute returns true if any of the routes is an IBCRoute
+func hasIBCRoute(routes []types.RouteI) bool {
+	for _, route := range routes {
+		if _, ok := route.(*types.IBCRoute); ok {
+			return true
+		}
 	}
+	return false
 }
@@ -158,3 +158,40 @@ func (s *KeeperTestSuite) TestGeneratePricesNotMeetHardDeviation() {
 	)
 	s.Require().Len(newPrices, 0)
 }
+
+func (s *KeeperTestSuite) TestCreateTunnelPricesMap() {
+	feedsPricesMap := map[string]feedstypes.Price{
+		"CS:ETH-USD": feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:ETH-USD", 3_000_000_000_000, 1733000000),
+		"CS:BTC-USD": feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BTC-USD", 60_000_000_000_000, 1733000000),
+	}
+	signalDeviations := []types.SignalDeviation{
+		{SignalID: "CS:BTC-USD", SoftDeviationBPS: 1000, HardDeviationBPS: 1000},
+		{
+			SignalID:         "DS:ETH-BTC",
+			SoftDeviationBPS: 1000,
+			HardDeviationBPS: 1000,
+			Derivation: types.NewDerivation(
+				types.DERIVATION_METHOD_CROSS,
+				[]string{"CS:ETH-USD", "CS:BTC-USD"},
+				0,
+			),
+		},
+	}
+
+	pricesMap := keeper.CreateTunnelPricesMap(signalDeviations, feedsPricesMap, 1733000000)
+	s.Require().Equal(map[string]feedstypes.Price{
+		"CS:BTC-USD": feedsPricesMap["CS:BTC-USD"],
+		"DS:ETH-BTC": feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "DS:ETH-BTC", 50_000_000, 1733000000),
+	}, pricesMap)
+
+	// the feeds prices map is not modified
+	s.Require().Len(feedsPricesMap, 2)
+
+	// the deviation is checked against the derived price
+	latestPricesMap := map[string]feedstypes.Price{
+		"CS:BTC-USD": feedsPricesMap["CS:BTC-USD"],
+		"DS:ETH-BTC": feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "DS:ETH-BTC", 40_000_000, 1733000000),
+	}
+	newPrices := keeper.GenerateNewPrices(signalDeviations, latestPricesMap, pricesMap, 1733000000, false)
+	s.Require().Equal([]feedstypes.Price{pricesMap["DS:ETH-BTC"]}, newPrices)
+}
@@ -18,6 +18,8 @@ type Keeper struct {
 
 	authKeeper     types.AccountKeeper
 	bankKeeper     types.BankKeeper
+	feegrantKeeper types.FeegrantKeeper
+	authzKeeper    types.AuthzKeeper
 	feedsKeeper    types.FeedsKeeper
 	bandtssKeeper  types.BandtssKeeper
 	channelKeeper  types.ChannelKeeper
@@ -26,6 +28,8 @@ type Keeper struct {
 	scopedKeeper   types.ScopedKeeper
 	transferKeeper types.TransferKeeper
 
+	consumerRouter *types.ConsumerRouter
+
 	authority string
 }
 
@@ -35,6 +39,8 @@ func NewKeeper(
 	key storetypes.StoreKey,
 	authKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	feegrantKeeper types.FeegrantKeeper,
+	authzKeeper types.AuthzKeeper,
 	feedsKeeper types.FeedsKeeper,
 	bandtssKeeper types.BandtssKeeper,
 	channelKeeper types.ChannelKeeper,
@@ -42,6 +48,7 @@ func NewKeeper(
 	portKeeper types.PortKeeper,
 	scopedKeeper types.ScopedKeeper,
 	transferKeeper types.TransferKeeper,
+	consumerRouter *types.ConsumerRouter,
 	authority string,
 ) Keeper {
 	// ensure tunnel module account is set
@@ -59,6 +66,8 @@ func NewKeeper(
 		storeKey:       key,
 		authKeeper:     authKeeper,
 		bankKeeper:     bankKeeper,
+		feegrantKeeper: feegrantKeeper,
+		authzKeeper:    authzKeeper,
 		feedsKeeper:    feedsKeeper,
 		bandtssKeeper:  bandtssKeeper,
 		channelKeeper:  channelKeeper,
@@ -66,6 +75,7 @@ func NewKeeper(
 		portKeeper:     portKeeper,
 		scopedKeeper:   scopedKeeper,
 		transferKeeper: transferKeeper,
+		consumerRouter: consumerRouter,
 		authority:      authority,
 	}
 }
@@ -11,7 +11,7 @@ import (
 	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
-// DeductBasePacketFee deducts the base packet fee from the fee payer of the packet
+// DeductBasePacketFee deducts the base packet fee of a route from the fee payer of the packet
 func (k Keeper) DeductBasePacketFee(ctx sdk.Context, feePayer sdk.AccAddress) error {
 	basePacketFee := k.GetParams(ctx).BasePacketFee
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, feePayer, types.ModuleName, basePacketFee); err != nil {
@@ -95,15 +95,21 @@ func (k Keeper) ProducePacket(
 	}
 	latestPricesMap := CreatePricesMap(latestPrices.Prices)
 
-	// check if the interval has passed
-	diffCycle := (unixNow - latestPrices.LastInterval) / int64(tunnel.Interval)
-	sendAll := diffCycle > 0
+	// check if the interval has passed or the schedule is due
+	nextTrigger, err := tunnel.NextTriggerTime(latestPrices.LastInterval)
+	if err != nil {
+		return err
+	}
+	sendAll := !nextTrigger.IsZero() && !ctx.BlockTime().Before(nextTrigger)
+
+	// compute the prices of the derived signals of the tunnel
+	pricesMap := CreateTunnelPricesMap(tunnel.SignalDeviations, feedsPricesMap, unixNow)
 
 	// generate newPrices; if no newPrices, stop the process.
 	newPrices := GenerateNewPrices(
 		tunnel.SignalDeviations,
 		latestPricesMap,
-		feedsPricesMap,
+		pricesMap,
 		ctx.BlockTime().Unix(),
 		sendAll,
 	)
@@ -125,11 +131,12 @@ func (k Keeper) ProducePacket(
 	// update latest price info.
 	latestPrices.UpdatePrices(newPrices)
 	if sendAll {
-		if latestPrices.LastInterval == 0 {
+		if latestPrices.LastInterval == 0 || tunnel.Schedule != "" {
 			// If the last interval is 0, it means this is the first time we are producing a packet.
-			// We set the last interval to the current time.
+			// A scheduled tunnel also computes its next trigger from the current time.
 			latestPrices.LastInterval = unixNow
 		} else {
+			diffCycle := (unixNow - latestPrices.LastInterval) / int64(tunnel.Interval)
 			latestPrices.LastInterval += diffCycle * int64(tunnel.Interval)
 		}
 	}
@@ -146,8 +153,8 @@ func (k Keeper) ProducePacket(
 	return nil
 }
 
-// CreatePacket creates a new packet of the given tunnel. Creating a packet charges
-// the base packet fee to the tunnel's fee payer.
+// CreatePacket creates a new packet of the given tunnel. The base packet fee is charged
+// for each route when the packet is sent.
 func (k Keeper) CreatePacket(
 	ctx sdk.Context,
 	tunnelID uint64,
@@ -158,12 +165,6 @@ func (k Keeper) CreatePacket(
 		return types.Packet{}, err
 	}
 
-	// deduct base packet fee from the fee payer,
-	feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
-	if err := k.DeductBasePacketFee(ctx, feePayer); err != nil {
-		return types.Packet{}, sdkerrors.Wrapf(err, "failed to deduct base packet fee for tunnel %d", tunnel.ID)
-	}
-
 	tunnel.Sequence++
 	packet := types.NewPacket(
 		tunnelID,
@@ -179,8 +180,81 @@ func (k Keeper) CreatePacket(
 	return packet, nil
 }
 
-// SendPacket sends a packet to the destination route
-func (k Keeper) SendPacket(ctx sdk.Context, packet types.Packet) (err error) {
+// SendPacket sends a packet to every route of the tunnel and charges the base packet fee for each route.
+// The fee payer is topped up beforehand if the tunnel has a top-up config and its balance is low.
+// A route that fails to send the packet gets a failed receipt, its state changes are discarded and
+// it is scheduled for retry.
+func (k Keeper) SendPacket(ctx sdk.Context, packet types.Packet) error {
+	tunnel, err := k.GetTunnel(ctx, packet.TunnelID)
+	if err != nil {
+		return err
+	}
+
+	routes, err := tunnel.GetRouteValues()
+	if err != nil {
+		return err
+	}
+
+	// top up the fee payer if its balance is low
+	k.TopUpFeePayer(ctx, tunnel)
+
+	feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
+	for i, route := range routes {
+		// deduct base packet fee from the fee payer
+		if err := k.DeductBasePacketFee(ctx, feePayer); err != nil {
+			return sdkerrors.Wrapf(err, "failed to deduct base packet fee for tunnel %d", tunnel.ID)
+		}
+
+		cacheCtx, writeFn := ctx.CacheContext()
+
+		receipt, err := k.sendRoutePacket(cacheCtx, tunnel, route, packet)
+		if err != nil {
+			receipt = types.NewFailedPacketReceipt(err.Error())
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeSendPacketFail,
+				sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", packet.TunnelID)),
+				sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+				sdk.NewAttribute(types.AttributeKeyRouteIndex, fmt.Sprintf("%d", i)),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			))
+
+			k.SchedulePacketRetry(
+				ctx,
+				packet.TunnelID,
+				packet.Sequence,
+				uint64(i),
+				types.RouteHash(tunnel.Routes[i]),
+				0,
+				err.Error(),
+			)
+		} else {
+			writeFn()
+		}
+
+		// add the receipt of the route to the packet
+		if err := packet.AddReceipt(receipt); err != nil {
+			return sdkerrors.Wrapf(
+				err,
+				"failed to set packet receipt for tunnel %d, sequence %d",
+				packet.TunnelID,
+				packet.Sequence,
+			)
+		}
+	}
+
+	k.SetPacket(ctx, packet)
+
+	return nil
+}
+
+// sendRoutePacket sends a packet to the given route of the tunnel
+func (k Keeper) sendRoutePacket(
+	ctx sdk.Context,
+	tunnel types.Tunnel,
+	route types.RouteI,
+	packet types.Packet,
+) (receipt types.PacketReceiptI, err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			if isErr, _ := IsOutOfGasError(r); isErr {
@@ -193,54 +267,23 @@ func (k Keeper) SendPacket(ctx sdk.Context, packet types.Packet) (err error) {
 		}
 	}()
 
-	tunnel, err := k.GetTunnel(ctx, packet.TunnelID)
-	if err != nil {
-		return err
-	}
-
-	// get the route
-	route, err := tunnel.GetRouteValue()
-	if err != nil {
-		return err
-	}
+	feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
 
 	// send packet to the destination route and get the route result
-	var receipt types.PacketReceiptI
 	switch r := route.(type) {
 	case *types.TSSRoute:
-		receipt, err = k.SendTSSPacket(
-			ctx,
-			r,
-			packet,
-			sdk.MustAccAddressFromBech32(tunnel.FeePayer),
-		)
+		return k.SendTSSPacket(ctx, r, packet, feePayer)
 	case *types.IBCRoute:
-		receipt, err = k.SendIBCPacket(ctx, r, packet, tunnel.Interval)
+		return k.SendIBCPacket(ctx, r, packet, tunnel.Interval)
 	case *types.IBCHookRoute:
-		receipt, err = k.SendIBCHookPacket(ctx, r, packet, sdk.MustAccAddressFromBech32(tunnel.FeePayer), tunnel.Interval)
+		return k.SendIBCHookPacket(ctx, r, packet, feePayer, tunnel.Interval)
 	case *types.RouterRoute:
-		receipt, err = k.SendRouterPacket(ctx, r, packet, sdk.MustAccAddressFromBech32(tunnel.FeePayer), tunnel.Interval)
+		return k.SendRouterPacket(ctx, r, packet, feePayer, tunnel.Interval)
 	case *types.AxelarRoute:
-		receipt, err = k.SendAxelarPacket(ctx, r, packet, sdk.MustAccAddressFromBech32(tunnel.FeePayer), tunnel.Interval)
+		return k.SendAxelarPacket(ctx, r, packet, feePayer, tunnel.Interval)
+	case *types.LocalRoute:
+		return k.SendLocalPacket(ctx, r, packet)
 	default:
-		return types.ErrInvalidRoute.Wrapf("no route found for tunnel ID: %d", tunnel.ID)
-	}
-	// return error if failed to send packet
-	if err != nil {
-		return err
-	}
-
-	// set the receipt to the packet
-	if err := packet.SetReceipt(receipt); err != nil {
-		return sdkerrors.Wrapf(
-			err,
-			"failed to set packet receipt for tunnel %d, sequence %d",
-			packet.TunnelID,
-			packet.Sequence,
-		)
+		return nil, types.ErrInvalidRoute.Wrapf("no route found for tunnel ID: %d", tunnel.ID)
 	}
-
-	k.SetPacket(ctx, packet)
-
-	return nil
 }
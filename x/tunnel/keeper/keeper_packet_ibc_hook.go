@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/base64"
 	"time"
 
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -8,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
@@ -20,11 +22,13 @@ func (k Keeper) SendIBCHookPacket(
 	interval uint64,
 ) (types.PacketReceiptI, error) {
 	// create memo string for ibc transfer
-	pricePacket := types.NewTunnelPricesPacketData(packet.TunnelID, packet.Sequence, packet.Prices, packet.CreatedAt)
-	memoStr := types.NewIBCHookMemo(route.DestinationContractAddress, pricePacket).JSONString()
+	memoStr, err := ibcHookMemoString(route, packet)
+	if err != nil {
+		return nil, err
+	}
 
 	// mint coin to the fee payer
-	err := k.MintIBCHookCoinToAccount(ctx, packet.TunnelID, feePayer, types.HookTransferAmount)
+	err = k.MintIBCHookCoinToAccount(ctx, packet.TunnelID, feePayer, types.HookTransferAmount)
 	if err != nil {
 		return nil, err
 	}
@@ -50,6 +54,31 @@ func (k Keeper) SendIBCHookPacket(
 	return types.NewIBCHookPacketReceipt(res.Sequence), nil
 }
 
+// ibcHookMemoString returns the memo of the IBC hook packet. The packet is sent as JSON if the encoder
+// of the route is unspecified; otherwise it is sent as the base64 of the encoded packet.
+func ibcHookMemoString(route *types.IBCHookRoute, packet types.Packet) (string, error) {
+	if route.Encoder == feedstypes.ENCODER_UNSPECIFIED {
+		pricePacket := types.NewTunnelPricesPacketData(
+			packet.TunnelID,
+			packet.Sequence,
+			packet.Prices,
+			packet.CreatedAt,
+		)
+		return types.NewIBCHookMemo(route.DestinationContractAddress, pricePacket).JSONString(), nil
+	}
+
+	payload, err := types.EncodePacket(packet, route.Encoder)
+	if err != nil {
+		return "", err
+	}
+
+	return types.NewIBCHookEncodedMemo(
+		route.DestinationContractAddress,
+		route.Encoder,
+		base64.StdEncoding.EncodeToString(payload),
+	).JSONString(), nil
+}
+
 // MintIBCHookCoinToAccount mints hook coin to the account
 func (k Keeper) MintIBCHookCoinToAccount(
 	ctx sdk.Context,
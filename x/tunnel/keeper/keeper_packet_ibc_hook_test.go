@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"context"
+	"encoding/base64"
+
 	"go.uber.org/mock/gomock"
 
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -57,6 +60,50 @@ func (s *KeeperTestSuite) TestSendIBCHookPacket() {
 	s.Require().Equal(expectedPacketReceipt, *receipt)
 }
 
+func (s *KeeperTestSuite) TestSendIBCHookPacketWithEncoder() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnelID := uint64(1)
+	route := types.NewIBCHookRoute(
+		"channel-0",
+		"wasm1vjq0k3fj47s8wns4a7zw5c4lsjd8l6r2kzzlpk",
+		feedstypes.ENCODER_FIXED_POINT_PACKED,
+	)
+	packet := types.Packet{
+		TunnelID:  tunnelID,
+		Sequence:  1,
+		Prices:    []feedstypes.Price{{SignalID: "BTC", Price: 72163}},
+		CreatedAt: 1730358471,
+	}
+	feePayer := sdk.AccAddress([]byte("feePayer"))
+	hookCoins := sdk.NewCoins(
+		sdk.NewInt64Coin(types.FormatHookDenomIdentifier(tunnelID), int64(types.HookTransferAmount)),
+	)
+
+	payload, err := types.EncodingPacketPacked(packet)
+	s.Require().NoError(err)
+	expectedMemo := types.NewIBCHookEncodedMemo(
+		route.DestinationContractAddress,
+		feedstypes.ENCODER_FIXED_POINT_PACKED,
+		base64.StdEncoding.EncodeToString(payload),
+	).JSONString()
+
+	s.transferKeeper.EXPECT().Transfer(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, msg *ibctransfertypes.MsgTransfer) (*ibctransfertypes.MsgTransferResponse, error) {
+			s.Require().Equal(expectedMemo, msg.Memo)
+			return &ibctransfertypes.MsgTransferResponse{Sequence: 1}, nil
+		},
+	)
+	s.bankKeeper.EXPECT().MintCoins(ctx, types.ModuleName, hookCoins).Return(nil)
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(ctx, types.ModuleName, feePayer, hookCoins).
+		Return(nil)
+
+	content, err := k.SendIBCHookPacket(ctx, route, packet, feePayer, 60)
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewIBCHookPacketReceipt(1), content)
+}
+
 func (s *KeeperTestSuite) TestMintIBCHookCoinToAccount() {
 	ctx, k := s.ctx, s.keeper
 
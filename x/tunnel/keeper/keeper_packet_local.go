@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/pkg/gas"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// SendLocalPacket delivers a packet to the consumer module of the local route synchronously.
+// The consumer can use gas up to the local route gas limit, and its failure is recorded in the receipt
+// without failing the packet.
+func (k Keeper) SendLocalPacket(
+	ctx sdk.Context,
+	route *types.LocalRoute,
+	packet types.Packet,
+) (types.PacketReceiptI, error) {
+	consumer, found := k.consumerRouter.GetRoute(route.Consumer)
+	if !found {
+		return nil, types.ErrConsumerNotFound.Wrapf("consumer: %s", route.Consumer)
+	}
+
+	pricePacket := types.NewTunnelPricesPacketData(packet.TunnelID, packet.Sequence, packet.Prices, packet.CreatedAt)
+
+	// invoke the consumer with a capped gas meter in a cached context
+	gasMeter := storetypes.NewGasMeter(k.GetParams(ctx).LocalRouteGasLimit)
+	cacheCtx, writeFn := ctx.CacheContext()
+	err := gas.CallWithRecovery("consumer", func() error {
+		return consumer.OnReceiveTunnelPacket(cacheCtx.WithGasMeter(gasMeter), pricePacket)
+	})
+
+	// charge the gas used by the consumer to the parent context
+	gasUsed := gasMeter.GasConsumedToLimit()
+	ctx.GasMeter().ConsumeGas(gasUsed, "tunnel local route")
+
+	if err != nil {
+		return types.NewLocalPacketReceipt(false, gasUsed, err.Error()), nil
+	}
+
+	writeFn()
+
+	return types.NewLocalPacketReceipt(true, gasUsed, ""), nil
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"errors"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+var consumerStateKey = []byte("consumer_state")
+
+// mockConsumer is a TunnelConsumerHooks that writes the received packet sequence to the store
+// before returning its configured result.
+type mockConsumer struct {
+	storeKey storetypes.StoreKey
+	gasToUse uint64
+	err      error
+}
+
+func (c mockConsumer) OnReceiveTunnelPacket(ctx sdk.Context, packet types.TunnelPricesPacketData) error {
+	ctx.KVStore(c.storeKey).Set(consumerStateKey, sdk.Uint64ToBigEndian(packet.Sequence))
+	ctx.GasMeter().ConsumeGas(c.gasToUse, "mock consumer")
+
+	return c.err
+}
+
+func (s *KeeperTestSuite) TestSendLocalPacket() {
+	packet := types.Packet{
+		TunnelID: 1,
+		Sequence: 2,
+		Prices: []feedstypes.Price{
+			{Status: feedstypes.PRICE_STATUS_AVAILABLE, SignalID: "CS:BAND-USD", Price: 50000, Timestamp: 1733000000},
+		},
+		CreatedAt: 1730358471,
+	}
+
+	cases := map[string]struct {
+		consumer   *mockConsumer
+		expErr     error
+		expSuccess bool
+		expErrMsg  string
+		expGasUsed uint64
+	}{
+		"consumer not found": {
+			consumer: nil,
+			expErr:   types.ErrConsumerNotFound,
+		},
+		"consumer returns error": {
+			consumer:  &mockConsumer{gasToUse: 1000, err: errors.New("consumer error")},
+			expErrMsg: "consumer error",
+		},
+		"consumer out of gas": {
+			consumer:   &mockConsumer{gasToUse: types.DefaultLocalRouteGasLimit + 1},
+			expErrMsg:  "out of gas: mock consumer",
+			expGasUsed: types.DefaultLocalRouteGasLimit,
+		},
+		"all good": {
+			consumer:   &mockConsumer{gasToUse: 1000},
+			expSuccess: true,
+		},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			s.reset()
+			ctx, k := s.ctx, s.keeper
+
+			if tc.consumer != nil {
+				tc.consumer.storeKey = s.storeKey
+				s.consumerRouter.AddRoute("consumer", tc.consumer)
+			}
+
+			gasBefore := ctx.GasMeter().GasConsumed()
+			receipt, err := k.SendLocalPacket(ctx, types.NewLocalRoute("consumer"), packet)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			localReceipt, ok := receipt.(*types.LocalPacketReceipt)
+			s.Require().True(ok)
+			s.Require().Equal(tc.expSuccess, localReceipt.Success)
+			s.Require().Equal(tc.expErrMsg, localReceipt.ErrorMessage)
+			s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, localReceipt.GasUsed)
+			if tc.expGasUsed != 0 {
+				s.Require().Equal(tc.expGasUsed, localReceipt.GasUsed)
+			}
+
+			// state changes of the consumer are kept only when it succeeds
+			state := ctx.KVStore(s.storeKey).Get(consumerStateKey)
+			if tc.expSuccess {
+				s.Require().Equal(sdk.Uint64ToBigEndian(packet.Sequence), state)
+			} else {
+				s.Require().Nil(state)
+			}
+		})
+	}
+}
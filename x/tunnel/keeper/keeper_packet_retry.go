@@ -0,0 +1,320 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// SetPacketRetry sets a packet retry in the store and puts it into the packet retry queue
+func (k Keeper) SetPacketRetry(ctx sdk.Context, retry types.PacketRetry) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(
+		types.PacketRetryStoreKey(retry.TunnelID, retry.Sequence, retry.RouteIndex),
+		k.cdc.MustMarshal(&retry),
+	)
+	store.Set(
+		types.PacketRetryQueueKey(retry.NextRetryHeight, retry.TunnelID, retry.Sequence, retry.RouteIndex),
+		[]byte{0x01},
+	)
+}
+
+// GetPacketRetry retrieves a packet retry by its tunnel ID, sequence and route index
+func (k Keeper) GetPacketRetry(
+	ctx sdk.Context,
+	tunnelID uint64,
+	sequence uint64,
+	routeIndex uint64,
+) (types.PacketRetry, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.PacketRetryStoreKey(tunnelID, sequence, routeIndex))
+	if bz == nil {
+		return types.PacketRetry{}, types.ErrPacketRetryNotFound.Wrapf(
+			"tunnelID: %d, sequence: %d, routeIndex: %d",
+			tunnelID,
+			sequence,
+			routeIndex,
+		)
+	}
+
+	var retry types.PacketRetry
+	k.cdc.MustUnmarshal(bz, &retry)
+	return retry, nil
+}
+
+// DeletePacketRetry deletes a packet retry from the store and the packet retry queue
+func (k Keeper) DeletePacketRetry(ctx sdk.Context, retry types.PacketRetry) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PacketRetryStoreKey(retry.TunnelID, retry.Sequence, retry.RouteIndex))
+	store.Delete(types.PacketRetryQueueKey(retry.NextRetryHeight, retry.TunnelID, retry.Sequence, retry.RouteIndex))
+}
+
+// GetPacketRetries returns all packet retries of a tunnel
+func (k Keeper) GetPacketRetries(ctx sdk.Context, tunnelID uint64) []types.PacketRetry {
+	var retries []types.PacketRetry
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.TunnelPacketRetriesStoreKey(tunnelID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var retry types.PacketRetry
+		k.cdc.MustUnmarshal(iterator.Value(), &retry)
+		retries = append(retries, retry)
+	}
+
+	return retries
+}
+
+// GetDuePacketRetries returns all packet retries that are due at or before the given height
+func (k Keeper) GetDuePacketRetries(ctx sdk.Context, height int64) []types.PacketRetry {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.PacketRetryQueueKeyPrefix, types.PacketRetryQueueByHeightPrefix(height+1))
+	defer iterator.Close()
+
+	var retries []types.PacketRetry
+	for ; iterator.Valid(); iterator.Next() {
+		// the queue key is prefix | height | tunnel ID | sequence | route index
+		key := iterator.Key()[len(types.PacketRetryQueueKeyPrefix)+8:]
+		bz := store.Get(append(types.PacketRetryStoreKeyPrefix, key...))
+		if bz == nil {
+			continue
+		}
+
+		var retry types.PacketRetry
+		k.cdc.MustUnmarshal(bz, &retry)
+		retries = append(retries, retry)
+	}
+
+	return retries
+}
+
+// SetDeadLetterPacket sets a dead-lettered packet in the store
+func (k Keeper) SetDeadLetterPacket(ctx sdk.Context, deadLetter types.DeadLetterPacket) {
+	ctx.KVStore(k.storeKey).Set(
+		types.DeadLetterPacketStoreKey(deadLetter.TunnelID, deadLetter.Sequence, deadLetter.RouteIndex),
+		k.cdc.MustMarshal(&deadLetter),
+	)
+}
+
+// GetDeadLetterPacket retrieves a dead-lettered packet by its tunnel ID, sequence and route index
+func (k Keeper) GetDeadLetterPacket(
+	ctx sdk.Context,
+	tunnelID uint64,
+	sequence uint64,
+	routeIndex uint64,
+) (types.DeadLetterPacket, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.DeadLetterPacketStoreKey(tunnelID, sequence, routeIndex))
+	if bz == nil {
+		return types.DeadLetterPacket{}, types.ErrDeadLetterPacketNotFound.Wrapf(
+			"tunnelID: %d, sequence: %d, routeIndex: %d",
+			tunnelID,
+			sequence,
+			routeIndex,
+		)
+	}
+
+	var deadLetter types.DeadLetterPacket
+	k.cdc.MustUnmarshal(bz, &deadLetter)
+	return deadLetter, nil
+}
+
+// DeleteDeadLetterPacket deletes a dead-lettered packet from the store
+func (k Keeper) DeleteDeadLetterPacket(ctx sdk.Context, tunnelID uint64, sequence uint64, routeIndex uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.DeadLetterPacketStoreKey(tunnelID, sequence, routeIndex))
+}
+
+// GetDeadLetterPackets returns all dead-lettered packets of a tunnel
+func (k Keeper) GetDeadLetterPackets(ctx sdk.Context, tunnelID uint64) []types.DeadLetterPacket {
+	var deadLetters []types.DeadLetterPacket
+	iterator := storetypes.KVStorePrefixIterator(
+		ctx.KVStore(k.storeKey),
+		types.TunnelDeadLetterPacketsStoreKey(tunnelID),
+	)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var deadLetter types.DeadLetterPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &deadLetter)
+		deadLetters = append(deadLetters, deadLetter)
+	}
+
+	return deadLetters
+}
+
+// SchedulePacketRetry schedules the next retry of a failed route delivery of a packet. If the number of
+// attempts has reached the max packet retry attempts, the delivery is moved to the dead-letter store instead.
+func (k Keeper) SchedulePacketRetry(
+	ctx sdk.Context,
+	tunnelID uint64,
+	sequence uint64,
+	routeIndex uint64,
+	routeHash []byte,
+	attempts uint64,
+	reason string,
+) {
+	params := k.GetParams(ctx)
+
+	if attempts >= params.MaxPacketRetryAttempts {
+		k.deadLetterPacket(ctx, tunnelID, sequence, routeIndex, routeHash, attempts, reason)
+		return
+	}
+
+	nextRetryHeight := types.NextPacketRetryHeight(ctx.BlockHeight(), params.PacketRetryBaseBlocks, attempts)
+	k.SetPacketRetry(
+		ctx,
+		types.NewPacketRetry(tunnelID, sequence, routeIndex, attempts, nextRetryHeight, reason, routeHash),
+	)
+}
+
+// deadLetterPacket moves a failed route delivery of a packet to the dead-letter store
+func (k Keeper) deadLetterPacket(
+	ctx sdk.Context,
+	tunnelID uint64,
+	sequence uint64,
+	routeIndex uint64,
+	routeHash []byte,
+	attempts uint64,
+	reason string,
+) {
+	k.SetDeadLetterPacket(
+		ctx,
+		types.NewDeadLetterPacket(tunnelID, sequence, routeIndex, attempts, reason, ctx.BlockTime().Unix(), routeHash),
+	)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDeadLetterPacket,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnelID)),
+		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", sequence)),
+		sdk.NewAttribute(types.AttributeKeyRouteIndex, fmt.Sprintf("%d", routeIndex)),
+		sdk.NewAttribute(types.AttributeKeyAttempts, fmt.Sprintf("%d", attempts)),
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
+	))
+}
+
+// ProcessPacketRetries retries all failed route deliveries that are due at the current block height.
+// Each retry is charged the base packet fee, so a delivery that keeps failing pays for every attempt.
+// A delivery of an inactive tunnel or of a route that has been changed since the delivery failed is
+// moved to the dead-letter store right away, as retrying it automatically cannot succeed.
+func (k Keeper) ProcessPacketRetries(ctx sdk.Context) {
+	for _, retry := range k.GetDuePacketRetries(ctx, ctx.BlockHeight()) {
+		k.DeletePacketRetry(ctx, retry)
+
+		err := k.RetryRoutePacket(ctx, retry.TunnelID, retry.Sequence, retry.RouteIndex, retry.RouteHash)
+		if err == nil {
+			continue
+		}
+
+		attempts := retry.Attempts + 1
+		if errors.Is(err, types.ErrInactiveTunnel) || errors.Is(err, types.ErrRouteChanged) {
+			k.deadLetterPacket(
+				ctx,
+				retry.TunnelID,
+				retry.Sequence,
+				retry.RouteIndex,
+				retry.RouteHash,
+				attempts,
+				err.Error(),
+			)
+		} else {
+			k.SchedulePacketRetry(
+				ctx,
+				retry.TunnelID,
+				retry.Sequence,
+				retry.RouteIndex,
+				retry.RouteHash,
+				attempts,
+				err.Error(),
+			)
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeRetryPacketFail,
+			sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", retry.TunnelID)),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", retry.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyRouteIndex, fmt.Sprintf("%d", retry.RouteIndex)),
+			sdk.NewAttribute(types.AttributeKeyAttempts, fmt.Sprintf("%d", attempts)),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		))
+	}
+}
+
+// RetryRoutePacket sends a packet again to the route at the given index of the tunnel. The tunnel must be
+// active and, if a route hash is given, the route must not have been changed since the delivery failed.
+// As in SendPacket, the base packet fee is charged for every attempt, whether the delivery succeeds or not.
+// If it succeeds, the receipt of the route in the packet is replaced; otherwise the state changes of the
+// delivery itself are discarded, but the fee is kept.
+func (k Keeper) RetryRoutePacket(
+	ctx sdk.Context,
+	tunnelID uint64,
+	sequence uint64,
+	routeIndex uint64,
+	routeHash []byte,
+) error {
+	tunnel, err := k.GetTunnel(ctx, tunnelID)
+	if err != nil {
+		return err
+	}
+
+	if !tunnel.IsActive {
+		return types.ErrInactiveTunnel.Wrapf("tunnelID: %d", tunnelID)
+	}
+
+	packet, err := k.GetPacket(ctx, tunnelID, sequence)
+	if err != nil {
+		return err
+	}
+
+	currentHash, err := tunnel.GetRouteHash(routeIndex)
+	if err != nil {
+		return err
+	}
+	// retries recorded before route hashes were stored have no hash to compare
+	if len(routeHash) > 0 && !bytes.Equal(routeHash, currentHash) {
+		return types.ErrRouteChanged.Wrapf("tunnelID: %d, routeIndex: %d", tunnelID, routeIndex)
+	}
+
+	routes, err := tunnel.GetRouteValues()
+	if err != nil {
+		return err
+	}
+
+	// deduct base packet fee from the fee payer outside of the cache context, so that it is kept even if
+	// the delivery fails
+	feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
+	if err := k.DeductBasePacketFee(ctx, feePayer); err != nil {
+		return sdkerrors.Wrapf(err, "failed to deduct base packet fee for tunnel %d", tunnel.ID)
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	receipt, err := k.sendRoutePacket(cacheCtx, tunnel, routes[routeIndex], packet)
+	if err != nil {
+		return err
+	}
+
+	if err := packet.SetReceipt(routeIndex, receipt); err != nil {
+		return sdkerrors.Wrapf(
+			err,
+			"failed to set packet receipt for tunnel %d, sequence %d",
+			packet.TunnelID,
+			packet.Sequence,
+		)
+	}
+
+	writeFn()
+	k.SetPacket(ctx, packet)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRetryPacketSuccess,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnelID)),
+		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", sequence)),
+		sdk.NewAttribute(types.AttributeKeyRouteIndex, fmt.Sprintf("%d", routeIndex)),
+	))
+
+	return nil
+}
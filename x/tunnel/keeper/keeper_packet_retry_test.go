@@ -0,0 +1,258 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// AddSampleFailedPacket adds a tunnel with a local route to the given consumer and a packet
+// whose delivery to that route has failed.
+func (s *KeeperTestSuite) AddSampleFailedPacket(consumer string) types.Packet {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := types.Tunnel{
+		ID:       1,
+		Sequence: 1,
+		FeePayer: sdk.AccAddress([]byte("fee_payer_address")).String(),
+		IsActive: true,
+		SignalDeviations: []types.SignalDeviation{
+			{SignalID: "CS:BAND-USD", SoftDeviationBPS: 1000, HardDeviationBPS: 1000},
+		},
+		Interval:  60,
+		CreatedAt: ctx.BlockTime().Unix(),
+		Creator:   sdk.AccAddress([]byte("creator_address")).String(),
+	}
+	err := tunnel.SetRoutes(types.NewLocalRoute(consumer))
+	s.Require().NoError(err)
+	k.SetTunnel(ctx, tunnel)
+
+	packet := types.NewPacket(tunnel.ID, tunnel.Sequence, []feedstypes.Price{
+		{Status: feedstypes.PRICE_STATUS_AVAILABLE, SignalID: "CS:BAND-USD", Price: 50000, Timestamp: 1733000000},
+	}, ctx.BlockTime().Unix())
+	err = packet.AddReceipt(types.NewFailedPacketReceipt("consumer not found"))
+	s.Require().NoError(err)
+	k.SetPacket(ctx, packet)
+
+	return packet
+}
+
+func (s *KeeperTestSuite) TestSchedulePacketRetry() {
+	ctx, k := s.ctx.WithBlockHeight(100), s.keeper
+	routeHash := []byte("route_hash")
+
+	k.SchedulePacketRetry(ctx, 1, 2, 0, routeHash, 0, "route failed")
+
+	retry, err := k.GetPacketRetry(ctx, 1, 2, 0)
+	s.Require().NoError(err)
+	s.Require().Equal(
+		types.NewPacketRetry(1, 2, 0, 0, 100+int64(types.DefaultPacketRetryBaseBlocks), "route failed", routeHash),
+		retry,
+	)
+	s.Require().Empty(k.GetDuePacketRetries(ctx, retry.NextRetryHeight-1))
+	s.Require().Equal([]types.PacketRetry{retry}, k.GetDuePacketRetries(ctx, retry.NextRetryHeight))
+
+	// a delivery that has exhausted its retries is moved to the dead-letter store
+	k.SchedulePacketRetry(ctx, 1, 2, 1, routeHash, types.DefaultMaxPacketRetryAttempts, "route failed")
+
+	_, err = k.GetPacketRetry(ctx, 1, 2, 1)
+	s.Require().ErrorIs(err, types.ErrPacketRetryNotFound)
+
+	deadLetter, err := k.GetDeadLetterPacket(ctx, 1, 2, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(
+		types.NewDeadLetterPacket(
+			1,
+			2,
+			1,
+			types.DefaultMaxPacketRetryAttempts,
+			"route failed",
+			ctx.BlockTime().Unix(),
+			routeHash,
+		),
+		deadLetter,
+	)
+	s.Require().Equal([]types.DeadLetterPacket{deadLetter}, k.GetDeadLetterPackets(ctx, 1))
+}
+
+func (s *KeeperTestSuite) TestProcessPacketRetries() {
+	feePayer := sdk.AccAddress([]byte("fee_payer_address"))
+
+	cases := map[string]struct {
+		attempts          uint64
+		consumerFound     bool
+		inactive          bool
+		routeChanged      bool
+		expFeeDeducted    bool
+		expRetry          *types.PacketRetry
+		expDeadLetter     *types.DeadLetterPacket
+		expFailedReceipts []uint64
+	}{
+		"retry succeeds": {
+			attempts:          1,
+			consumerFound:     true,
+			expFeeDeducted:    true,
+			expFailedReceipts: []uint64{},
+		},
+		"retry fails and is rescheduled": {
+			attempts:       1,
+			consumerFound:  false,
+			expFeeDeducted: true,
+			expRetry: &types.PacketRetry{
+				TunnelID:        1,
+				Sequence:        1,
+				RouteIndex:      0,
+				Attempts:        2,
+				NextRetryHeight: 100 + int64(types.DefaultPacketRetryBaseBlocks<<2),
+				LastError:       "consumer not found",
+			},
+			expFailedReceipts: []uint64{0},
+		},
+		"retry fails and is dead-lettered": {
+			attempts:       types.DefaultMaxPacketRetryAttempts - 1,
+			consumerFound:  false,
+			expFeeDeducted: true,
+			expDeadLetter: &types.DeadLetterPacket{
+				Attempts:  types.DefaultMaxPacketRetryAttempts,
+				LastError: "consumer not found",
+			},
+			expFailedReceipts: []uint64{0},
+		},
+		"inactive tunnel is dead-lettered without charging the fee": {
+			attempts:      1,
+			consumerFound: true,
+			inactive:      true,
+			expDeadLetter: &types.DeadLetterPacket{
+				Attempts:  2,
+				LastError: "inactive tunnel",
+			},
+			expFailedReceipts: []uint64{0},
+		},
+		"changed route is dead-lettered without charging the fee": {
+			attempts:      1,
+			consumerFound: true,
+			routeChanged:  true,
+			expDeadLetter: &types.DeadLetterPacket{
+				Attempts:  2,
+				LastError: "route changed",
+			},
+			expFailedReceipts: []uint64{0},
+		},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			s.reset()
+			ctx, k := s.ctx.WithBlockHeight(100), s.keeper
+
+			if tc.consumerFound {
+				s.consumerRouter.AddRoute("consumer", mockConsumer{storeKey: s.storeKey})
+			}
+			packet := s.AddSampleFailedPacket("consumer")
+
+			tunnel, err := k.GetTunnel(ctx, 1)
+			s.Require().NoError(err)
+			routeHash, err := tunnel.GetRouteHash(0)
+			s.Require().NoError(err)
+
+			if tc.inactive {
+				tunnel.IsActive = false
+				k.SetTunnel(ctx, tunnel)
+			}
+			if tc.routeChanged {
+				err := tunnel.SetRoutes(types.NewLocalRoute("other_consumer"))
+				s.Require().NoError(err)
+				k.SetTunnel(ctx, tunnel)
+			}
+			if tc.expFeeDeducted {
+				s.bankKeeper.EXPECT().
+					SendCoinsFromAccountToModule(gomock.Any(), feePayer, types.ModuleName, types.DefaultBasePacketFee).
+					Return(nil)
+			}
+
+			k.SetPacketRetry(
+				ctx,
+				types.NewPacketRetry(1, packet.Sequence, 0, tc.attempts, 100, "consumer not found", routeHash),
+			)
+
+			k.ProcessPacketRetries(ctx)
+
+			storedPacket, err := k.GetPacket(ctx, 1, packet.Sequence)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expFailedReceipts, storedPacket.GetFailedRouteIndices())
+
+			retries := k.GetPacketRetries(ctx, 1)
+			if tc.expRetry != nil {
+				s.Require().Len(retries, 1)
+				s.Require().Equal(tc.expRetry.Attempts, retries[0].Attempts)
+				s.Require().Equal(tc.expRetry.NextRetryHeight, retries[0].NextRetryHeight)
+				s.Require().Equal(routeHash, retries[0].RouteHash)
+				s.Require().Contains(retries[0].LastError, tc.expRetry.LastError)
+			} else {
+				s.Require().Empty(retries)
+			}
+			s.Require().Empty(k.GetDuePacketRetries(ctx, ctx.BlockHeight()))
+
+			deadLetters := k.GetDeadLetterPackets(ctx, 1)
+			if tc.expDeadLetter != nil {
+				s.Require().Len(deadLetters, 1)
+				s.Require().Equal(tc.expDeadLetter.Attempts, deadLetters[0].Attempts)
+				s.Require().Equal(routeHash, deadLetters[0].RouteHash)
+				s.Require().Contains(deadLetters[0].LastError, tc.expDeadLetter.LastError)
+			} else {
+				s.Require().Empty(deadLetters)
+			}
+
+			totalFees := k.GetTotalFees(ctx)
+			if tc.expFeeDeducted {
+				s.Require().Equal(types.DefaultBasePacketFee, totalFees.TotalBasePacketFee)
+			} else {
+				s.Require().True(totalFees.TotalBasePacketFee.IsZero())
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestProcessPacketRetriesChargesEachFailedAttempt() {
+	ctx, k := s.ctx.WithBlockHeight(100), s.keeper
+	feePayer := sdk.AccAddress([]byte("fee_payer_address"))
+
+	// the consumer is not registered, so every retry fails
+	packet := s.AddSampleFailedPacket("consumer")
+	tunnel, err := k.GetTunnel(ctx, 1)
+	s.Require().NoError(err)
+	routeHash, err := tunnel.GetRouteHash(0)
+	s.Require().NoError(err)
+
+	// the fee payer is debited the base packet fee once per attempt
+	s.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), feePayer, types.ModuleName, types.DefaultBasePacketFee).
+		Return(nil).
+		Times(2)
+
+	k.SetPacketRetry(ctx, types.NewPacketRetry(1, packet.Sequence, 0, 1, 100, "consumer not found", routeHash))
+	k.ProcessPacketRetries(ctx)
+
+	retries := k.GetPacketRetries(ctx, 1)
+	s.Require().Len(retries, 1)
+	s.Require().Equal(types.DefaultBasePacketFee, k.GetTotalFees(ctx).TotalBasePacketFee)
+
+	ctx = ctx.WithBlockHeight(retries[0].NextRetryHeight)
+	k.ProcessPacketRetries(ctx)
+
+	retries = k.GetPacketRetries(ctx, 1)
+	s.Require().Len(retries, 1)
+	s.Require().Equal(uint64(3), retries[0].Attempts)
+	s.Require().Equal(
+		types.DefaultBasePacketFee.Add(types.DefaultBasePacketFee...),
+		k.GetTotalFees(ctx).TotalBasePacketFee,
+	)
+
+	// the failed deliveries leave the packet receipt as it was
+	storedPacket, err := k.GetPacket(ctx, 1, packet.Sequence)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{0}, storedPacket.GetFailedRouteIndices())
+}
@@ -20,7 +20,7 @@ func (k Keeper) SendRouterPacket(
 	feePayer sdk.AccAddress,
 	interval uint64,
 ) (types.PacketReceiptI, error) {
-	payload, err := types.EncodingPacketABI(packet)
+	payload, err := types.EncodePacket(packet, route.Encoder)
 	if err != nil {
 		return nil, err
 	}
@@ -12,6 +12,7 @@ import (
 
 	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
 	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	"github.com/bandprotocol/chain/v3/x/tunnel/keeper"
 	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
@@ -75,11 +76,7 @@ func (s *KeeperTestSuite) TestCreatePacket() {
 		{Status: feedstypes.PRICE_STATUS_AVAILABLE, SignalID: "CS:BAND-USD", Price: 5000000, Timestamp: 1733000000},
 	}
 
-	s.bankKeeper.EXPECT().
-		SendCoinsFromAccountToModule(ctx, feePayer, types.ModuleName, k.GetParams(ctx).BasePacketFee).
-		Return(nil)
-
-	err := tunnel.SetRoute(route)
+	err := tunnel.SetRoutes(route)
 	s.Require().NoError(err)
 
 	// set tunnel to the store
@@ -132,9 +129,9 @@ func (s *KeeperTestSuite) TestProducePacket() {
 	}
 
 	s.bankKeeper.EXPECT().
-		SendCoinsFromAccountToModule(ctx, feePayer, types.ModuleName, k.GetParams(ctx).BasePacketFee).
+		SendCoinsFromAccountToModule(gomock.Any(), feePayer, types.ModuleName, k.GetParams(ctx).BasePacketFee).
 		Return(nil)
-	s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any()).Return(
+	s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any(), "").Return(
 		sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))), nil,
 	)
 	s.bandtssKeeper.EXPECT().CreateTunnelSigningRequest(
@@ -145,9 +142,11 @@ func (s *KeeperTestSuite) TestProducePacket() {
 		gomock.Any(),
 		feePayer,
 		sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))),
+		"",
+		false,
 	).Return(bandtsstypes.SigningID(1), nil)
 
-	err := tunnel.SetRoute(route)
+	err := tunnel.SetRoutes(route)
 	s.Require().NoError(err)
 
 	// set deposit to the tunnel to be able to activate
@@ -166,6 +165,74 @@ func (s *KeeperTestSuite) TestProducePacket() {
 	s.Require().NoError(err)
 }
 
+func (s *KeeperTestSuite) TestProducePacketTriggerSchedule() {
+	feePayer := sdk.AccAddress([]byte("fee_payer_address"))
+	prices := []feedstypes.Price{
+		{Status: feedstypes.PRICE_STATUS_AVAILABLE, SignalID: "CS:BAND-USD", Price: 50000, Timestamp: 1733000000},
+	}
+	lastInterval := time.Date(2024, 11, 29, 15, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		blockTime   time.Time
+		expSequence uint64
+	}{
+		"schedule not due": {
+			blockTime:   time.Date(2024, 11, 29, 15, 59, 59, 0, time.UTC),
+			expSequence: 0,
+		},
+		"schedule due": {
+			blockTime:   time.Date(2024, 11, 29, 16, 0, 5, 0, time.UTC),
+			expSequence: 1,
+		},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			s.reset()
+			ctx, k := s.ctx.WithBlockTime(tc.blockTime), s.keeper
+
+			s.consumerRouter.AddRoute("consumer", mockConsumer{storeKey: s.storeKey})
+
+			tunnel := types.Tunnel{
+				ID:       1,
+				FeePayer: feePayer.String(),
+				IsActive: true,
+				SignalDeviations: []types.SignalDeviation{
+					{SignalID: "CS:BAND-USD", SoftDeviationBPS: 1000, HardDeviationBPS: 1000},
+				},
+				// the interval alone would trigger a packet on every block
+				Interval: 1,
+				Schedule: "0 16 * * *",
+			}
+			err := tunnel.SetRoutes(types.NewLocalRoute("consumer"))
+			s.Require().NoError(err)
+			k.SetTunnel(ctx, tunnel)
+			k.SetLatestPrices(ctx, types.NewLatestPrices(tunnel.ID, prices, lastInterval.Unix()))
+
+			if tc.expSequence > 0 {
+				s.bankKeeper.EXPECT().
+					SendCoinsFromAccountToModule(gomock.Any(), feePayer, types.ModuleName, types.DefaultBasePacketFee).
+					Return(nil)
+			}
+
+			err = k.ProducePacket(ctx, tunnel.ID, keeper.CreatePricesMap(prices))
+			s.Require().NoError(err)
+
+			newTunnel, err := k.GetTunnel(ctx, tunnel.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expSequence, newTunnel.Sequence)
+
+			latestPrices, err := k.GetLatestPrices(ctx, tunnel.ID)
+			s.Require().NoError(err)
+			if tc.expSequence > 0 {
+				s.Require().Equal(tc.blockTime.Unix(), latestPrices.LastInterval)
+			} else {
+				s.Require().Equal(lastInterval.Unix(), latestPrices.LastInterval)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestProducePacketTriggerInterval() {
 	ctx, k := s.ctx, s.keeper
 
@@ -198,7 +265,7 @@ func (s *KeeperTestSuite) TestProducePacketTriggerInterval() {
 		SendCoinsFromAccountToModule(gomock.Any(), feePayer, types.ModuleName, k.GetParams(ctx).BasePacketFee).
 		Return(nil).Times(2)
 
-	s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any()).Return(
+	s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any(), "").Return(
 		sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))), nil,
 	).Times(2)
 	s.bandtssKeeper.EXPECT().CreateTunnelSigningRequest(
@@ -209,9 +276,11 @@ func (s *KeeperTestSuite) TestProducePacketTriggerInterval() {
 		gomock.Any(),
 		feePayer,
 		sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))),
+		"",
+		false,
 	).Return(bandtsstypes.SigningID(1), nil).Times(2)
 
-	err := tunnel.SetRoute(route)
+	err := tunnel.SetRoutes(route)
 	s.Require().NoError(err)
 
 	// set deposit to the tunnel to be able to activate
@@ -265,7 +334,7 @@ func (s *KeeperTestSuite) TestProduceActiveTunnelPackets() {
 		DestinationContractAddress: "0x",
 	}
 
-	s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any()).Return(
+	s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any(), "").Return(
 		sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))), nil,
 	)
 
@@ -285,10 +354,12 @@ func (s *KeeperTestSuite) TestProduceActiveTunnelPackets() {
 		gomock.Any(),
 		feePayer,
 		sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))),
+		"",
+		false,
 	).Return(bandtsstypes.SigningID(1), nil)
 
 	// set tunnel & latest price
-	err := tunnel.SetRoute(route)
+	err := tunnel.SetRoutes(route)
 	s.Require().NoError(err)
 
 	// set deposit to the tunnel to be able to activate
@@ -342,7 +413,7 @@ func (s *KeeperTestSuite) TestProduceActiveTunnelPacketsNotEnoughMoney() {
 		SendCoinsFromAccountToModule(gomock.Any(), feePayer, types.ModuleName, types.DefaultBasePacketFee).
 		Return(sdkerrors.ErrInsufficientFunds)
 
-	err := tunnel.SetRoute(route)
+	err := tunnel.SetRoutes(route)
 	s.Require().NoError(err)
 
 	defaultParams := types.DefaultParams()
@@ -371,3 +442,106 @@ func (s *KeeperTestSuite) TestProduceActiveTunnelPacketsNotEnoughMoney() {
 	activeTunnels := k.GetActiveTunnelIDs(ctx)
 	s.Require().Len(activeTunnels, 0)
 }
+
+func (s *KeeperTestSuite) TestSendPacketMultipleRoutes() {
+	feePayer := sdk.AccAddress([]byte("fee_payer_address"))
+	tssRoute := &types.TSSRoute{
+		DestinationChainID:         "chain-1",
+		DestinationContractAddress: "0x1234567890abcdef",
+	}
+	localRoute := types.NewLocalRoute("consumer")
+	prices := []feedstypes.Price{
+		{Status: feedstypes.PRICE_STATUS_AVAILABLE, SignalID: "CS:BAND-USD", Price: 50000, Timestamp: 1733000000},
+	}
+
+	cases := map[string]struct {
+		preRun          func()
+		expErr          bool
+		expFailedRoutes []uint64
+	}{
+		"all routes succeed": {
+			preRun: func() {
+				s.bankKeeper.EXPECT().
+					SendCoinsFromAccountToModule(gomock.Any(), feePayer, types.ModuleName, types.DefaultBasePacketFee).
+					Return(nil).Times(2)
+				s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any(), "").Return(
+					sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))), nil,
+				)
+				s.bandtssKeeper.EXPECT().CreateTunnelSigningRequest(
+					gomock.Any(), uint64(1), "chain-1", "0x1234567890abcdef", gomock.Any(), feePayer, gomock.Any(), "", false,
+				).Return(bandtsstypes.SigningID(1), nil)
+			},
+			expFailedRoutes: []uint64{},
+		},
+		"one route fails": {
+			preRun: func() {
+				s.bankKeeper.EXPECT().
+					SendCoinsFromAccountToModule(gomock.Any(), feePayer, types.ModuleName, types.DefaultBasePacketFee).
+					Return(nil).Times(2)
+				s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any(), "").Return(nil, bandtsstypes.ErrNoActiveGroup)
+			},
+			expFailedRoutes: []uint64{0},
+		},
+		"base packet fee cannot be paid": {
+			preRun: func() {
+				s.bankKeeper.EXPECT().
+					SendCoinsFromAccountToModule(gomock.Any(), feePayer, types.ModuleName, types.DefaultBasePacketFee).
+					Return(sdkerrors.ErrInsufficientFunds)
+			},
+			expErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			s.reset()
+			ctx, k := s.ctx, s.keeper
+
+			s.consumerRouter.AddRoute("consumer", mockConsumer{storeKey: s.storeKey})
+
+			tunnel := types.Tunnel{
+				ID:       1,
+				FeePayer: feePayer.String(),
+				IsActive: true,
+				SignalDeviations: []types.SignalDeviation{
+					{SignalID: "CS:BAND-USD", SoftDeviationBPS: 1000, HardDeviationBPS: 1000},
+				},
+				Interval:  60,
+				CreatedAt: ctx.BlockTime().Unix(),
+			}
+			err := tunnel.SetRoutes(tssRoute, localRoute)
+			s.Require().NoError(err)
+			k.SetTunnel(ctx, tunnel)
+
+			tc.preRun()
+
+			packet, err := k.CreatePacket(ctx, tunnel.ID, prices)
+			s.Require().NoError(err)
+
+			err = k.SendPacket(ctx, packet)
+			if tc.expErr {
+				s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+				return
+			}
+			s.Require().NoError(err)
+
+			storedPacket, err := k.GetPacket(ctx, tunnel.ID, packet.Sequence)
+			s.Require().NoError(err)
+			s.Require().Len(storedPacket.Receipts, 2)
+			s.Require().Equal(tc.expFailedRoutes, storedPacket.GetFailedRouteIndices())
+
+			// the base packet fee is charged for every route
+			expTotalFee := types.DefaultBasePacketFee.MulInt(sdkmath.NewInt(2))
+			s.Require().Equal(expTotalFee, k.GetTotalFees(ctx).TotalBasePacketFee)
+
+			// the failed routes are scheduled for retry
+			retries := k.GetPacketRetries(ctx, tunnel.ID)
+			s.Require().Len(retries, len(tc.expFailedRoutes))
+			for i, routeIndex := range tc.expFailedRoutes {
+				s.Require().Equal(routeIndex, retries[i].RouteIndex)
+				s.Require().Equal(packet.Sequence, retries[i].Sequence)
+				s.Require().Equal(uint64(0), retries[i].Attempts)
+			}
+		})
+	}
+}
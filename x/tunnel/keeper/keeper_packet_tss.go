@@ -20,7 +20,7 @@ func (k Keeper) SendTSSPacket(
 		route.Encoder,
 	)
 
-	tssFee, err := k.bandtssKeeper.GetSigningFee(ctx)
+	tssFee, err := k.bandtssKeeper.GetSigningFee(ctx, route.GroupName)
 	if err != nil {
 		return nil, err
 	}
@@ -34,6 +34,8 @@ func (k Keeper) SendTSSPacket(
 		content,
 		feePayer,
 		tssFee,
+		route.GroupName,
+		route.Batch,
 	)
 	if err != nil {
 		return nil, err
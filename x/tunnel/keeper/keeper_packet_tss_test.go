@@ -30,7 +30,7 @@ func (s *KeeperTestSuite) TestSendTSSPacket() {
 		time.Now().Unix(),
 	)
 
-	s.bandtssKeeper.EXPECT().GetSigningFee(ctx).Return(sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))), nil)
+	s.bandtssKeeper.EXPECT().GetSigningFee(ctx, "").Return(sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))), nil)
 
 	// Mock the tss keeper and set the state for checking later
 	s.bandtssKeeper.EXPECT().CreateTunnelSigningRequest(
@@ -41,6 +41,8 @@ func (s *KeeperTestSuite) TestSendTSSPacket() {
 		gomock.Any(),
 		bandtesting.Alice.Address,
 		sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))),
+		"",
+		false,
 	).Return(bandtsstypes.SigningID(1), nil)
 
 	// Send the tss packet
@@ -56,3 +58,70 @@ func (s *KeeperTestSuite) TestSendTSSPacket() {
 	s.Require().True(ok)
 	s.Require().Equal(bandtsstypes.SigningID(1), packetReceipt.SigningID)
 }
+
+func (s *KeeperTestSuite) TestSendTSSPacketBatch() {
+	ctx, k := s.ctx, s.keeper
+
+	route := types.TSSRoute{
+		DestinationChainID:         "chain-1",
+		DestinationContractAddress: "0x1234567890abcdef",
+		Encoder:                    feedstypes.ENCODER_FIXED_POINT_ABI,
+		Batch:                      true,
+	}
+	packet := types.NewPacket(1, 1, []feedstypes.Price{}, time.Now().Unix())
+
+	s.bandtssKeeper.EXPECT().GetSigningFee(ctx, "").Return(sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))), nil)
+
+	// The signing request should be created in batch mode.
+	s.bandtssKeeper.EXPECT().CreateTunnelSigningRequest(
+		gomock.Any(),
+		uint64(1),
+		"chain-1",
+		"0x1234567890abcdef",
+		gomock.Any(),
+		bandtesting.Alice.Address,
+		sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))),
+		"",
+		true,
+	).Return(bandtsstypes.SigningID(2), nil)
+
+	content, err := k.SendTSSPacket(ctx, &route, packet, bandtesting.Alice.Address)
+	s.Require().NoError(err)
+
+	packetReceipt, ok := content.(*types.TSSPacketReceipt)
+	s.Require().True(ok)
+	s.Require().Equal(bandtsstypes.SigningID(2), packetReceipt.SigningID)
+}
+
+func (s *KeeperTestSuite) TestSendTSSPacketNamedGroup() {
+	ctx, k := s.ctx, s.keeper
+
+	route := types.TSSRoute{
+		DestinationChainID:         "chain-1",
+		DestinationContractAddress: "0x1234567890abcdef",
+		Encoder:                    feedstypes.ENCODER_FIXED_POINT_ABI,
+		GroupName:                  "fast",
+	}
+	packet := types.NewPacket(1, 1, []feedstypes.Price{}, time.Now().Unix())
+
+	// The signing fee and the signing request should be of the named group.
+	s.bandtssKeeper.EXPECT().GetSigningFee(ctx, "fast").Return(sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(5))), nil)
+	s.bandtssKeeper.EXPECT().CreateTunnelSigningRequest(
+		gomock.Any(),
+		uint64(1),
+		"chain-1",
+		"0x1234567890abcdef",
+		gomock.Any(),
+		bandtesting.Alice.Address,
+		sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(5))),
+		"fast",
+		false,
+	).Return(bandtsstypes.SigningID(3), nil)
+
+	content, err := k.SendTSSPacket(ctx, &route, packet, bandtesting.Alice.Address)
+	s.Require().NoError(err)
+
+	packetReceipt, ok := content.(*types.TSSPacketReceipt)
+	s.Require().True(ok)
+	s.Require().Equal(bandtsstypes.SigningID(3), packetReceipt.SigningID)
+}
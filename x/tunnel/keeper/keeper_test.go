@@ -40,6 +40,8 @@ type KeeperTestSuite struct {
 
 	accountKeeper  *testutil.MockAccountKeeper
 	bankKeeper     *testutil.MockBankKeeper
+	feegrantKeeper *testutil.MockFeegrantKeeper
+	authzKeeper    *testutil.MockAuthzKeeper
 	feedsKeeper    *testutil.MockFeedsKeeper
 	bandtssKeeper  *testutil.MockBandtssKeeper
 	icsWrapper     *testutil.MockICS4Wrapper
@@ -47,6 +49,7 @@ type KeeperTestSuite struct {
 	channelKeeper  *testutil.MockChannelKeeper
 	scopedKeeper   *testutil.MockScopedKeeper
 	transferKeeper *testutil.MockTransferKeeper
+	consumerRouter *types.ConsumerRouter
 
 	ctx       sdk.Context
 	authority sdk.AccAddress
@@ -64,6 +67,8 @@ func (s *KeeperTestSuite) reset() {
 
 	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
 	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	feegrantKeeper := testutil.NewMockFeegrantKeeper(ctrl)
+	authzKeeper := testutil.NewMockAuthzKeeper(ctrl)
 	feedsKeeper := testutil.NewMockFeedsKeeper(ctrl)
 	bandtssKeeper := testutil.NewMockBandtssKeeper(ctrl)
 	icsWrapper := testutil.NewMockICS4Wrapper(ctrl)
@@ -71,6 +76,7 @@ func (s *KeeperTestSuite) reset() {
 	channelKeeper := testutil.NewMockChannelKeeper(ctrl)
 	scopedKeeper := testutil.NewMockScopedKeeper(ctrl)
 	transferKeeper := testutil.NewMockTransferKeeper(ctrl)
+	consumerRouter := types.NewConsumerRouter()
 
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
 
@@ -82,6 +88,8 @@ func (s *KeeperTestSuite) reset() {
 		key,
 		accountKeeper,
 		bankKeeper,
+		feegrantKeeper,
+		authzKeeper,
 		feedsKeeper,
 		bandtssKeeper,
 		channelKeeper,
@@ -89,12 +97,15 @@ func (s *KeeperTestSuite) reset() {
 		portKeeper,
 		scopedKeeper,
 		transferKeeper,
+		consumerRouter,
 		authority.String(),
 	)
 	s.queryServer = keeper.NewQueryServer(s.keeper)
 	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
 	s.accountKeeper = accountKeeper
 	s.bankKeeper = bankKeeper
+	s.feegrantKeeper = feegrantKeeper
+	s.authzKeeper = authzKeeper
 	s.feedsKeeper = feedsKeeper
 	s.bandtssKeeper = bandtssKeeper
 	s.icsWrapper = icsWrapper
@@ -102,6 +113,7 @@ func (s *KeeperTestSuite) reset() {
 	s.channelKeeper = channelKeeper
 	s.scopedKeeper = scopedKeeper
 	s.transferKeeper = transferKeeper
+	s.consumerRouter = consumerRouter
 
 	s.ctx = testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now().UTC()})
 	s.authority = authority
@@ -134,9 +146,10 @@ func (s *KeeperTestSuite) AddSampleTunnel(isActive bool) *types.Tunnel {
 
 	tunnel, err := k.AddTunnel(
 		ctx,
-		route,
+		[]types.RouteI{route},
 		signalDeviations,
 		10,
+		"",
 		sdk.AccAddress([]byte("creator_address")),
 	)
 	s.Require().NoError(err)
@@ -179,9 +192,10 @@ func (s *KeeperTestSuite) AddSampleIBCTunnel(isActive bool) {
 
 	tunnel, err := k.AddTunnel(
 		ctx,
-		route,
+		[]types.RouteI{route},
 		signalDeviations,
 		10,
+		"",
 		sdk.AccAddress([]byte("creator_address")),
 	)
 	s.Require().NoError(err)
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// SetTopUpConfig sets the top-up config of a tunnel in the store
+func (k Keeper) SetTopUpConfig(ctx sdk.Context, config types.TopUpConfig) {
+	ctx.KVStore(k.storeKey).Set(types.TopUpConfigStoreKey(config.TunnelID), k.cdc.MustMarshal(&config))
+}
+
+// GetTopUpConfig retrieves the top-up config of a tunnel
+func (k Keeper) GetTopUpConfig(ctx sdk.Context, tunnelID uint64) (config types.TopUpConfig, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.TopUpConfigStoreKey(tunnelID))
+	if bz == nil {
+		return types.TopUpConfig{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &config)
+	return config, true
+}
+
+// GetAllTopUpConfigs returns all top-up configs in the store
+func (k Keeper) GetAllTopUpConfigs(ctx sdk.Context) []types.TopUpConfig {
+	var configs []types.TopUpConfig
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.TopUpConfigStoreKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var config types.TopUpConfig
+		k.cdc.MustUnmarshal(iterator.Value(), &config)
+		configs = append(configs, config)
+	}
+
+	return configs
+}
+
+// DeleteTopUpConfig deletes the top-up config of a tunnel from the store
+func (k Keeper) DeleteTopUpConfig(ctx sdk.Context, tunnelID uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.TopUpConfigStoreKey(tunnelID))
+}
+
+// TopUpFeePayer checks the balance of the fee payer of the tunnel against its top-up config. If the balance
+// is below the low balance threshold, it emits an event and tops up the fee payer from the funder. A failed
+// top-up only emits an event, so that the packet can still be paid from the remaining balance.
+func (k Keeper) TopUpFeePayer(ctx sdk.Context, tunnel types.Tunnel) {
+	config, found := k.GetTopUpConfig(ctx, tunnel.ID)
+	if !found {
+		return
+	}
+
+	feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
+	balance := k.bankKeeper.GetAllBalances(ctx, feePayer)
+	if !config.IsLowBalance(balance) {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeLowFeePayerBalance,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnel.ID)),
+		sdk.NewAttribute(types.AttributeKeyFeePayer, tunnel.FeePayer),
+		sdk.NewAttribute(types.AttributeKeyBalance, balance.String()),
+		sdk.NewAttribute(types.AttributeKeyThreshold, config.LowBalanceThreshold.String()),
+	))
+
+	if !config.HasFunder() {
+		return
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := k.topUpFromFunder(cacheCtx, config, feePayer); err != nil {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeTopUpFeePayerFail,
+			sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnel.ID)),
+			sdk.NewAttribute(types.AttributeKeyFunder, config.Funder),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		))
+		return
+	}
+	writeFn()
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTopUpFeePayer,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnel.ID)),
+		sdk.NewAttribute(types.AttributeKeyFunder, config.Funder),
+		sdk.NewAttribute(types.AttributeKeyGrantType, config.GrantType.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, config.TopUpAmount.String()),
+	))
+}
+
+// topUpFromFunder sends the top-up amount from the funder to the fee payer under the grant of the config
+func (k Keeper) topUpFromFunder(ctx sdk.Context, config types.TopUpConfig, feePayer sdk.AccAddress) error {
+	funder, err := sdk.AccAddressFromBech32(config.Funder)
+	if err != nil {
+		return err
+	}
+
+	switch config.GrantType {
+	case types.FUNDER_GRANT_TYPE_FEEGRANT:
+		// the allowance of the fee grant is spent on the top-up amount
+		if err := k.feegrantKeeper.UseGrantedFees(ctx, funder, feePayer, config.TopUpAmount, nil); err != nil {
+			return err
+		}
+		return k.bankKeeper.SendCoins(ctx, funder, feePayer, config.TopUpAmount)
+	case types.FUNDER_GRANT_TYPE_AUTHZ:
+		// the fee payer executes a send from the funder under its authorization
+		msg := banktypes.NewMsgSend(funder, feePayer, config.TopUpAmount)
+		_, err := k.authzKeeper.DispatchActions(ctx, feePayer, []sdk.Msg{msg})
+		return err
+	default:
+		return types.ErrInvalidTopUpConfig.Wrapf("invalid grant type: %s", config.GrantType)
+	}
+}
+
+// EstimateFeePerPacket estimates the fee of a packet of the tunnel at the current params, i.e. the base packet
+// fee for each route plus the fees of the routes.
+func (k Keeper) EstimateFeePerPacket(ctx sdk.Context, tunnel types.Tunnel) (sdk.Coins, error) {
+	routes, err := tunnel.GetRouteValues()
+	if err != nil {
+		return nil, err
+	}
+
+	basePacketFee := k.GetParams(ctx).BasePacketFee
+	fee := sdk.NewCoins()
+	for _, route := range routes {
+		fee = fee.Add(basePacketFee...)
+
+		switch r := route.(type) {
+		case *types.TSSRoute:
+			signingFee, err := k.bandtssKeeper.GetSigningFee(ctx, r.GroupName)
+			if err != nil {
+				return nil, err
+			}
+			fee = fee.Add(signingFee...)
+		case *types.AxelarRoute:
+			fee = fee.Add(r.Fee)
+		}
+	}
+
+	return fee, nil
+}
+
+// EstimateRemainingPackets estimates the number of packets that the given balance can pay for. If the fee
+// per packet is zero, the number of packets is unlimited and the maximum uint64 is returned.
+func EstimateRemainingPackets(balance sdk.Coins, feePerPacket sdk.Coins) uint64 {
+	remaining := sdkmath.NewIntFromUint64(math.MaxUint64)
+	for _, fee := range feePerPacket {
+		if fee.IsZero() {
+			continue
+		}
+		remaining = sdkmath.MinInt(remaining, balance.AmountOf(fee.Denom).Quo(fee.Amount))
+	}
+
+	return remaining.Uint64()
+}
@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"math"
+
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	"github.com/bandprotocol/chain/v3/x/tunnel/keeper"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func (s *KeeperTestSuite) TestGetSetDeleteTopUpConfig() {
+	ctx, k := s.ctx, s.keeper
+
+	config := types.NewTopUpConfig(
+		1,
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 1000)),
+		sdk.AccAddress([]byte("funder_address")).String(),
+		types.FUNDER_GRANT_TYPE_FEEGRANT,
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 5000)),
+	)
+	k.SetTopUpConfig(ctx, config)
+
+	storedConfig, found := k.GetTopUpConfig(ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(config, storedConfig)
+	s.Require().Equal([]types.TopUpConfig{config}, k.GetAllTopUpConfigs(ctx))
+
+	k.DeleteTopUpConfig(ctx, 1)
+	_, found = k.GetTopUpConfig(ctx, 1)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestTopUpFeePayer() {
+	feePayer := sdk.AccAddress([]byte("fee_payer_address"))
+	funder := sdk.AccAddress([]byte("funder_address"))
+	threshold := sdk.NewCoins(sdk.NewInt64Coin("uband", 1000))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uband", 5000))
+	lowBalance := sdk.NewCoins(sdk.NewInt64Coin("uband", 500))
+
+	cases := map[string]struct {
+		preRun        func()
+		expEventTypes []string
+	}{
+		"no top-up config": {
+			preRun:        func() {},
+			expEventTypes: []string{},
+		},
+		"balance above the threshold": {
+			preRun: func() {
+				s.keeper.SetTopUpConfig(
+					s.ctx,
+					types.NewTopUpConfig(1, threshold, funder.String(), types.FUNDER_GRANT_TYPE_FEEGRANT, amount),
+				)
+				s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feePayer).Return(threshold)
+			},
+			expEventTypes: []string{},
+		},
+		"low balance without funder": {
+			preRun: func() {
+				s.keeper.SetTopUpConfig(
+					s.ctx,
+					types.NewTopUpConfig(1, threshold, "", types.FUNDER_GRANT_TYPE_UNSPECIFIED, nil),
+				)
+				s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feePayer).Return(lowBalance)
+			},
+			expEventTypes: []string{types.EventTypeLowFeePayerBalance},
+		},
+		"top up from fee grant": {
+			preRun: func() {
+				s.keeper.SetTopUpConfig(
+					s.ctx,
+					types.NewTopUpConfig(1, threshold, funder.String(), types.FUNDER_GRANT_TYPE_FEEGRANT, amount),
+				)
+				s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feePayer).Return(lowBalance)
+				s.feegrantKeeper.EXPECT().UseGrantedFees(gomock.Any(), funder, feePayer, amount, nil).Return(nil)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), funder, feePayer, amount).Return(nil)
+			},
+			expEventTypes: []string{types.EventTypeLowFeePayerBalance, types.EventTypeTopUpFeePayer},
+		},
+		"top up from authz grant": {
+			preRun: func() {
+				s.keeper.SetTopUpConfig(
+					s.ctx,
+					types.NewTopUpConfig(1, threshold, funder.String(), types.FUNDER_GRANT_TYPE_AUTHZ, amount),
+				)
+				s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feePayer).Return(lowBalance)
+				s.authzKeeper.EXPECT().
+					DispatchActions(gomock.Any(), feePayer, []sdk.Msg{banktypes.NewMsgSend(funder, feePayer, amount)}).
+					Return(nil, nil)
+			},
+			expEventTypes: []string{types.EventTypeLowFeePayerBalance, types.EventTypeTopUpFeePayer},
+		},
+		"fee grant exhausted": {
+			preRun: func() {
+				s.keeper.SetTopUpConfig(
+					s.ctx,
+					types.NewTopUpConfig(1, threshold, funder.String(), types.FUNDER_GRANT_TYPE_FEEGRANT, amount),
+				)
+				s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feePayer).Return(lowBalance)
+				s.feegrantKeeper.EXPECT().
+					UseGrantedFees(gomock.Any(), funder, feePayer, amount, nil).
+					Return(sdkerrors.ErrInsufficientFee)
+			},
+			expEventTypes: []string{types.EventTypeLowFeePayerBalance, types.EventTypeTopUpFeePayerFail},
+		},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			s.reset()
+			ctx, k := s.ctx, s.keeper
+
+			tc.preRun()
+
+			k.TopUpFeePayer(ctx, types.Tunnel{ID: 1, FeePayer: feePayer.String()})
+
+			eventTypes := []string{}
+			for _, event := range ctx.EventManager().Events() {
+				eventTypes = append(eventTypes, event.Type)
+			}
+			s.Require().Equal(tc.expEventTypes, eventTypes)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestEstimateFeePerPacket() {
+	ctx, k := s.ctx, s.keeper
+
+	tunnel := types.Tunnel{ID: 1}
+	tssRoute := types.NewTSSRoute("chain-1", "0x1234567890abcdef", feedstypes.ENCODER_FIXED_POINT_ABI, false, "")
+	axelarRoute := types.NewAxelarRoute("chain-2", "0x1234567890abcdef", sdk.NewInt64Coin("uaxl", 50))
+	err := tunnel.SetRoutes(&tssRoute, axelarRoute, types.NewLocalRoute("consumer"))
+	s.Require().NoError(err)
+
+	s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any(), "").Return(sdk.NewCoins(sdk.NewInt64Coin("uband", 20)), nil)
+
+	fee, err := k.EstimateFeePerPacket(ctx, tunnel)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uaxl", 50), sdk.NewInt64Coin("uband", 320)), fee)
+}
+
+func (s *KeeperTestSuite) TestEstimateRemainingPackets() {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uaxl", 50), sdk.NewInt64Coin("uband", 320))
+
+	// the scarcest denom limits the number of packets
+	balance := sdk.NewCoins(sdk.NewInt64Coin("uaxl", 1000), sdk.NewInt64Coin("uband", 1000))
+	s.Require().Equal(uint64(3), keeper.EstimateRemainingPackets(balance, fee))
+
+	// a missing denom cannot pay for any packet
+	s.Require().Equal(uint64(0), keeper.EstimateRemainingPackets(sdk.NewCoins(sdk.NewInt64Coin("uband", 1000)), fee))
+
+	// a zero fee is unlimited
+	s.Require().Equal(uint64(math.MaxUint64), keeper.EstimateRemainingPackets(balance, sdk.NewCoins()))
+}
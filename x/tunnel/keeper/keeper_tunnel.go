@@ -15,9 +15,10 @@ import (
 // AddTunnel adds a new tunnel
 func (k Keeper) AddTunnel(
 	ctx sdk.Context,
-	route types.RouteI,
+	routes []types.RouteI,
 	signalDeviations []types.SignalDeviation,
 	interval uint64,
+	schedule string,
 	creator sdk.AccAddress,
 ) (*types.Tunnel, error) {
 	id := k.GetTunnelCount(ctx)
@@ -36,10 +37,11 @@ func (k Keeper) AddTunnel(
 	tunnel, err := types.NewTunnel(
 		newID,
 		0,
-		route,
+		routes,
 		feePayer.String(),
 		signalDeviations,
 		interval,
+		schedule,
 		sdk.NewCoins(),
 		false,
 		ctx.BlockTime().Unix(),
@@ -58,12 +60,15 @@ func (k Keeper) AddTunnel(
 		types.EventTypeCreateTunnel,
 		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnel.ID)),
 		sdk.NewAttribute(types.AttributeKeyInterval, fmt.Sprintf("%d", tunnel.Interval)),
-		sdk.NewAttribute(types.AttributeKeyRoute, tunnel.Route.String()),
+		sdk.NewAttribute(types.AttributeKeySchedule, tunnel.Schedule),
 		sdk.NewAttribute(types.AttributeKeyFeePayer, tunnel.FeePayer),
 		sdk.NewAttribute(types.AttributeKeyIsActive, fmt.Sprintf("%t", tunnel.IsActive)),
 		sdk.NewAttribute(types.AttributeKeyCreatedAt, fmt.Sprintf("%d", tunnel.CreatedAt)),
 		sdk.NewAttribute(types.AttributeKeyCreator, tunnel.Creator),
 	)
+	for _, route := range tunnel.Routes {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyRoute, route.String()))
+	}
 	for _, sd := range tunnel.SignalDeviations {
 		event = event.AppendAttributes(
 			sdk.NewAttribute(types.AttributeKeySignalID, sd.SignalID),
@@ -82,6 +87,7 @@ func (k Keeper) UpdateSignalsAndInterval(
 	tunnelID uint64,
 	signalDeviations []types.SignalDeviation,
 	interval uint64,
+	schedule string,
 ) error {
 	tunnel, err := k.GetTunnel(ctx, tunnelID)
 	if err != nil {
@@ -91,6 +97,7 @@ func (k Keeper) UpdateSignalsAndInterval(
 	// edit the tunnel
 	tunnel.SignalDeviations = signalDeviations
 	tunnel.Interval = interval
+	tunnel.Schedule = schedule
 	k.SetTunnel(ctx, tunnel)
 
 	// edit the prices info
@@ -100,6 +107,7 @@ func (k Keeper) UpdateSignalsAndInterval(
 		types.EventTypeUpdateSignalsAndInterval,
 		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", tunnel.ID)),
 		sdk.NewAttribute(types.AttributeKeyInterval, fmt.Sprintf("%d", tunnel.Interval)),
+		sdk.NewAttribute(types.AttributeKeySchedule, tunnel.Schedule),
 	)
 	for _, sd := range signalDeviations {
 		event = event.AppendAttributes(
@@ -29,6 +29,7 @@ func (s *KeeperTestSuite) TestAddTunnel() {
 	expectedTunnel := types.Tunnel{
 		ID:               1,
 		Route:            any,
+		Routes:           []*codectypes.Any{any},
 		FeePayer:         "band1mdnfc2ehu7vkkg5nttc8tuvwpa9f3dxskf75yxfr7zwhevvcj62q2yggu0",
 		Creator:          creator.String(),
 		Interval:         interval,
@@ -50,7 +51,7 @@ func (s *KeeperTestSuite) TestAddTunnel() {
 	s.accountKeeper.EXPECT().NewAccount(ctx, gomock.Any()).Times(1)
 	s.accountKeeper.EXPECT().SetAccount(ctx, gomock.Any()).Times(1)
 
-	tunnel, err := k.AddTunnel(ctx, route, signalDeviations, interval, creator)
+	tunnel, err := k.AddTunnel(ctx, []types.RouteI{route}, signalDeviations, interval, "", creator)
 	s.Require().NoError(err)
 	s.Require().Equal(expectedTunnel, *tunnel)
 
@@ -83,9 +84,10 @@ func (s *KeeperTestSuite) TestUpdateSignalsAndInterval() {
 
 	initialTunnel, err := k.AddTunnel(
 		ctx,
-		initialRoute,
+		[]types.RouteI{initialRoute},
 		initialSignalDeviations,
 		initialInterval,
+		"",
 		creator,
 	)
 	s.Require().NoError(err)
@@ -98,7 +100,7 @@ func (s *KeeperTestSuite) TestUpdateSignalsAndInterval() {
 	newInterval := uint64(20)
 
 	// call the UpdateSignalsAndInterval function
-	err = k.UpdateSignalsAndInterval(ctx, initialTunnel.ID, newSignalDeviations, newInterval)
+	err = k.UpdateSignalsAndInterval(ctx, initialTunnel.ID, newSignalDeviations, newInterval, "")
 	s.Require().NoError(err)
 
 	// validate the edited tunnel
@@ -177,7 +179,7 @@ func (s *KeeperTestSuite) TestActivateTunnel() {
 
 	tunnel := types.Tunnel{
 		ID:               tunnelID,
-		Route:            route,
+		Routes:           []*codectypes.Any{route},
 		SignalDeviations: signalDeviations,
 		Interval:         interval,
 		TotalDeposit:     k.GetParams(ctx).MinDeposit,
@@ -216,7 +218,7 @@ func (s *KeeperTestSuite) TestDeactivateTunnel() {
 	// add a tunnel to the store
 	tunnel := types.Tunnel{
 		ID:               tunnelID,
-		Route:            route,
+		Routes:           []*codectypes.Any{route},
 		SignalDeviations: signalDeviations,
 		Interval:         interval,
 		Creator:          creator,
@@ -49,42 +49,50 @@ func (k msgServer) CreateTunnel(
 		return nil, err
 	}
 
-	route, err := msg.GetRouteValue()
+	routes, err := msg.GetRouteValues()
 	if err != nil {
 		return nil, err
 	}
 
-	// isIBCRoute is true if the route is IBCRoute
-	var isIBCRoute bool
-
-	// validate the route based on the route type
-	switch r := route.(type) {
-	case *types.IBCRoute:
-		if r.ChannelID != "" {
-			return nil, types.ErrInvalidRoute.Wrap("channel id should be set after create tunnel")
-		}
-		isIBCRoute = true
-	case *types.IBCHookRoute:
-		_, found := k.channelKeeper.GetChannel(ctx, ibctransfertypes.PortID, r.ChannelID)
-		if !found {
-			return nil, types.ErrInvalidChannelID
+	// validate the number of routes
+	if uint64(len(routes)) > params.MaxRoutes {
+		return nil, types.ErrMaxRoutesExceeded.Wrapf("max routes %d, got %d", params.MaxRoutes, len(routes))
+	}
+
+	// validate each route based on the route type
+	for _, route := range routes {
+		switch r := route.(type) {
+		case *types.IBCRoute:
+			if r.ChannelID != "" {
+				return nil, types.ErrInvalidRoute.Wrap("channel id should be set after create tunnel")
+			}
+		case *types.IBCHookRoute:
+			_, found := k.channelKeeper.GetChannel(ctx, ibctransfertypes.PortID, r.ChannelID)
+			if !found {
+				return nil, types.ErrInvalidChannelID
+			}
+		case *types.LocalRoute:
+			if !k.consumerRouter.HasRoute(r.Consumer) {
+				return nil, types.ErrConsumerNotFound.Wrapf("consumer: %s", r.Consumer)
+			}
 		}
 	}
 
 	// add a new tunnel
 	tunnel, err := k.Keeper.AddTunnel(
 		ctx,
-		route,
+		routes,
 		msg.SignalDeviations,
 		msg.Interval,
+		msg.Schedule,
 		creator,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	// bind ibc port for the tunnel if the route is IBCRoute
-	if isIBCRoute {
+	// bind ibc port for the tunnel if any route is IBCRoute
+	if hasIBCRoute(routes) {
 		_, err = k.ensureIBCPort(ctx, tunnel.ID)
 		if err != nil {
 			return nil, err
@@ -119,7 +127,11 @@ func (k msgServer) UpdateRoute(
 		return nil, types.ErrInvalidTunnelCreator.Wrapf("creator %s, tunnelID %d", msg.Creator, msg.TunnelID)
 	}
 
-	if tunnel.Route.TypeUrl != msg.Route.TypeUrl {
+	if msg.RouteIndex >= uint64(len(tunnel.Routes)) {
+		return nil, types.ErrInvalidRoute.Wrapf("route index %d out of range", msg.RouteIndex)
+	}
+
+	if tunnel.Routes[msg.RouteIndex].TypeUrl != msg.Route.TypeUrl {
 		return nil, types.ErrInvalidRoute.Wrap("cannot change route type")
 	}
 
@@ -134,17 +146,22 @@ func (k msgServer) UpdateRoute(
 		if !found {
 			return nil, types.ErrInvalidChannelID
 		}
-		tunnel.Route = msg.Route
+		tunnel.UpdateRoute(msg.RouteIndex, msg.Route)
 	case *types.IBCHookRoute:
 		_, found := k.channelKeeper.GetChannel(ctx, ibctransfertypes.PortID, r.ChannelID)
 		if !found {
 			return nil, types.ErrInvalidChannelID
 		}
-		tunnel.Route = msg.Route
+		tunnel.UpdateRoute(msg.RouteIndex, msg.Route)
 	case *types.RouterRoute:
-		tunnel.Route = msg.Route
+		tunnel.UpdateRoute(msg.RouteIndex, msg.Route)
 	case *types.AxelarRoute:
-		tunnel.Route = msg.Route
+		tunnel.UpdateRoute(msg.RouteIndex, msg.Route)
+	case *types.LocalRoute:
+		if !k.consumerRouter.HasRoute(r.Consumer) {
+			return nil, types.ErrConsumerNotFound.Wrapf("consumer: %s", r.Consumer)
+		}
+		tunnel.UpdateRoute(msg.RouteIndex, msg.Route)
 	default:
 		return nil, types.ErrInvalidRoute.Wrap("cannot update route on this route type")
 	}
@@ -182,7 +199,13 @@ func (k msgServer) UpdateSignalsAndInterval(
 		return nil, types.ErrInvalidTunnelCreator.Wrapf("creator %s, tunnelID %d", msg.Creator, msg.TunnelID)
 	}
 
-	err = k.Keeper.UpdateSignalsAndInterval(ctx, msg.TunnelID, msg.SignalDeviations, msg.Interval)
+	err = k.Keeper.UpdateSignalsAndInterval(
+		ctx,
+		msg.TunnelID,
+		msg.SignalDeviations,
+		msg.Interval,
+		msg.Schedule,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -309,8 +332,10 @@ func (k msgServer) TriggerTunnel(
 		return nil, types.ErrInactiveTunnel.Wrapf("tunnelID %d", msg.TunnelID)
 	}
 
-	signalIDs := tunnel.GetSignalIDs()
-	prices := k.Keeper.feedsKeeper.GetPrices(ctx, signalIDs)
+	// get the prices of all signals of the tunnel, including the derived ones
+	feedsPrices := k.Keeper.feedsKeeper.GetPrices(ctx, tunnel.GetFeedSignalIDs())
+	pricesMap := CreateTunnelPricesMap(tunnel.SignalDeviations, CreatePricesMap(feedsPrices), ctx.BlockTime().Unix())
+	prices := GenerateNewPrices(tunnel.SignalDeviations, nil, pricesMap, ctx.BlockTime().Unix(), true)
 
 	// create a new packet
 	packet, err := k.Keeper.CreatePacket(ctx, tunnel.ID, prices)
@@ -342,6 +367,120 @@ func (k msgServer) TriggerTunnel(
 	return &types.MsgTriggerTunnelResponse{}, nil
 }
 
+// RetryPacket manually retries a dead-lettered packet delivery of a tunnel.
+func (k msgServer) RetryPacket(
+	goCtx context.Context,
+	msg *types.MsgRetryPacket,
+) (*types.MsgRetryPacketResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tunnel, err := k.Keeper.GetTunnel(ctx, msg.TunnelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Creator != tunnel.Creator {
+		return nil, types.ErrInvalidTunnelCreator.Wrapf(
+			"creator %s, tunnelID %d",
+			msg.Creator,
+			msg.TunnelID,
+		)
+	}
+
+	deadLetter, err := k.Keeper.GetDeadLetterPacket(ctx, msg.TunnelID, msg.Sequence, msg.RouteIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.RetryRoutePacket(ctx, msg.TunnelID, msg.Sequence, msg.RouteIndex, deadLetter.RouteHash)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to retry packet for tunnel %d", tunnel.ID)
+	}
+
+	k.Keeper.DeleteDeadLetterPacket(ctx, msg.TunnelID, msg.Sequence, msg.RouteIndex)
+
+	return &types.MsgRetryPacketResponse{}, nil
+}
+
+// SetTopUpConfig sets the low balance threshold and the funder of a tunnel.
+func (k msgServer) SetTopUpConfig(
+	goCtx context.Context,
+	msg *types.MsgSetTopUpConfig,
+) (*types.MsgSetTopUpConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tunnel, err := k.Keeper.GetTunnel(ctx, msg.TunnelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Creator != tunnel.Creator {
+		return nil, types.ErrInvalidTunnelCreator.Wrapf(
+			"creator %s, tunnelID %d",
+			msg.Creator,
+			msg.TunnelID,
+		)
+	}
+
+	if msg.Funder == tunnel.FeePayer {
+		return nil, types.ErrInvalidTopUpConfig.Wrap("funder cannot be the fee payer of the tunnel")
+	}
+
+	config := types.NewTopUpConfig(
+		msg.TunnelID,
+		msg.LowBalanceThreshold,
+		msg.Funder,
+		msg.GrantType,
+		msg.TopUpAmount,
+	)
+	k.Keeper.SetTopUpConfig(ctx, config)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetTopUpConfig,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", msg.TunnelID)),
+		sdk.NewAttribute(types.AttributeKeyThreshold, msg.LowBalanceThreshold.String()),
+		sdk.NewAttribute(types.AttributeKeyFunder, msg.Funder),
+		sdk.NewAttribute(types.AttributeKeyGrantType, msg.GrantType.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, msg.TopUpAmount.String()),
+	))
+
+	return &types.MsgSetTopUpConfigResponse{}, nil
+}
+
+// RemoveTopUpConfig removes the low balance threshold and the funder of a tunnel.
+func (k msgServer) RemoveTopUpConfig(
+	goCtx context.Context,
+	msg *types.MsgRemoveTopUpConfig,
+) (*types.MsgRemoveTopUpConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tunnel, err := k.Keeper.GetTunnel(ctx, msg.TunnelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Creator != tunnel.Creator {
+		return nil, types.ErrInvalidTunnelCreator.Wrapf(
+			"creator %s, tunnelID %d",
+			msg.Creator,
+			msg.TunnelID,
+		)
+	}
+
+	if _, found := k.Keeper.GetTopUpConfig(ctx, msg.TunnelID); !found {
+		return nil, types.ErrTopUpConfigNotFound.Wrapf("tunnelID %d", msg.TunnelID)
+	}
+
+	k.Keeper.DeleteTopUpConfig(ctx, msg.TunnelID)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveTopUpConfig,
+		sdk.NewAttribute(types.AttributeKeyTunnelID, fmt.Sprintf("%d", msg.TunnelID)),
+	))
+
+	return &types.MsgRemoveTopUpConfigResponse{}, nil
+}
+
 // DepositToTunnel adds deposit to the tunnel.
 func (k msgServer) DepositToTunnel(
 	goCtx context.Context,
@@ -49,7 +49,8 @@ func (s *KeeperTestSuite) TestMsgCreateTunnel() {
 				return types.NewMsgCreateTunnel(
 					signalDeviations,
 					60,
-					route,
+					"",
+					[]types.RouteI{route},
 					sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(100))),
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
@@ -67,7 +68,8 @@ func (s *KeeperTestSuite) TestMsgCreateTunnel() {
 				return types.NewMsgCreateTunnel(
 					signalDeviations,
 					60,
-					route,
+					"",
+					[]types.RouteI{route},
 					sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(100))),
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
@@ -84,7 +86,8 @@ func (s *KeeperTestSuite) TestMsgCreateTunnel() {
 				return types.NewMsgCreateTunnel(
 					signalDeviations,
 					1,
-					route,
+					"",
+					[]types.RouteI{route},
 					sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(100))),
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
@@ -92,6 +95,24 @@ func (s *KeeperTestSuite) TestMsgCreateTunnel() {
 			expErr:    true,
 			expErrMsg: "interval out of range",
 		},
+		"max routes exceeded": {
+			preRun: func() (*types.MsgCreateTunnel, error) {
+				params := types.DefaultParams()
+				params.MaxRoutes = 1
+				s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+				return types.NewMsgCreateTunnel(
+					signalDeviations,
+					60,
+					"",
+					[]types.RouteI{route, types.NewIBCRoute("")},
+					sdk.NewCoins(),
+					sdk.AccAddress([]byte("creator_address")).String(),
+				)
+			},
+			expErr:    true,
+			expErrMsg: "max routes exceeded",
+		},
 		"channel id should be set after create tunnel": {
 			preRun: func() (*types.MsgCreateTunnel, error) {
 				depositor := sdk.AccAddress([]byte("creator_address"))
@@ -100,7 +121,8 @@ func (s *KeeperTestSuite) TestMsgCreateTunnel() {
 				return types.NewMsgCreateTunnel(
 					signalDeviations,
 					60,
-					types.NewIBCRoute("channel-0"),
+					"",
+					[]types.RouteI{types.NewIBCRoute("channel-0")},
 					depositAmount,
 					depositor.String(),
 				)
@@ -122,7 +144,66 @@ func (s *KeeperTestSuite) TestMsgCreateTunnel() {
 				return types.NewMsgCreateTunnel(
 					signalDeviations,
 					60,
-					types.NewIBCRoute(""),
+					"",
+					[]types.RouteI{types.NewIBCRoute("")},
+					sdk.NewCoins(),
+					sdk.AccAddress([]byte("creator_address")).String(),
+				)
+			},
+			expErr:    false,
+			expErrMsg: "",
+		},
+		"consumer not found (local route)": {
+			preRun: func() (*types.MsgCreateTunnel, error) {
+				return types.NewMsgCreateLocalTunnel(
+					signalDeviations,
+					60,
+					"",
+					"consumer",
+					sdk.NewCoins(),
+					sdk.AccAddress([]byte("creator_address")).String(),
+				)
+			},
+			expErr:    true,
+			expErrMsg: "consumer not found",
+		},
+		"all good (multiple routes)": {
+			preRun: func() (*types.MsgCreateTunnel, error) {
+				s.accountKeeper.EXPECT().
+					GetAccount(s.ctx, gomock.Any()).
+					Return(nil).Times(1)
+				s.accountKeeper.EXPECT().NewAccount(s.ctx, gomock.Any()).Times(1)
+				s.accountKeeper.EXPECT().SetAccount(s.ctx, gomock.Any()).Times(1)
+				s.scopedKeeper.EXPECT().
+					GetCapability(s.ctx, "ports/tunnel.1").
+					Return(&capabilitytypes.Capability{}, true)
+
+				return types.NewMsgCreateTunnel(
+					signalDeviations,
+					60,
+					"",
+					[]types.RouteI{route, types.NewIBCRoute("")},
+					sdk.NewCoins(),
+					sdk.AccAddress([]byte("creator_address")).String(),
+				)
+			},
+			expErr:    false,
+			expErrMsg: "",
+		},
+		"all good (local route)": {
+			preRun: func() (*types.MsgCreateTunnel, error) {
+				s.accountKeeper.EXPECT().
+					GetAccount(s.ctx, gomock.Any()).
+					Return(nil).Times(1)
+				s.accountKeeper.EXPECT().NewAccount(s.ctx, gomock.Any()).Times(1)
+				s.accountKeeper.EXPECT().SetAccount(s.ctx, gomock.Any()).Times(1)
+				s.consumerRouter.AddRoute("consumer", mockConsumer{})
+
+				return types.NewMsgCreateLocalTunnel(
+					signalDeviations,
+					60,
+					"",
+					"consumer",
 					sdk.NewCoins(),
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
@@ -141,7 +222,8 @@ func (s *KeeperTestSuite) TestMsgCreateTunnel() {
 				return types.NewMsgCreateTunnel(
 					signalDeviations,
 					60,
-					route,
+					"",
+					[]types.RouteI{route},
 					sdk.NewCoins(),
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
@@ -166,7 +248,8 @@ func (s *KeeperTestSuite) TestMsgCreateTunnel() {
 				return types.NewMsgCreateTunnel(
 					signalDeviations,
 					60,
-					route,
+					"",
+					[]types.RouteI{route},
 					depositAmount,
 					depositor.String(),
 				)
@@ -204,6 +287,7 @@ func (s *KeeperTestSuite) TestMsgUpdateRoute() {
 			preRun: func() (*types.MsgUpdateRoute, error) {
 				return types.NewMsgUpdateIBCRoute(
 					1,
+					0,
 					"channel-0",
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
@@ -211,12 +295,27 @@ func (s *KeeperTestSuite) TestMsgUpdateRoute() {
 			expErr:    true,
 			expErrMsg: "tunnel not found",
 		},
+		"route index out of range": {
+			preRun: func() (*types.MsgUpdateRoute, error) {
+				s.AddSampleIBCTunnel(false)
+
+				return types.NewMsgUpdateIBCRoute(
+					1,
+					1,
+					"channel-0",
+					sdk.AccAddress([]byte("creator_address")).String(),
+				)
+			},
+			expErr:    true,
+			expErrMsg: "route index 1 out of range",
+		},
 		"mismatch route type": {
 			preRun: func() (*types.MsgUpdateRoute, error) {
 				s.AddSampleTunnel(false)
 
 				return types.NewMsgUpdateIBCRoute(
 					1,
+					0,
 					"channel-0",
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
@@ -230,6 +329,7 @@ func (s *KeeperTestSuite) TestMsgUpdateRoute() {
 
 				return types.NewMsgUpdateIBCRoute(
 					1,
+					0,
 					"channel-0",
 					sdk.AccAddress([]byte("wrong_creator_address")).String(),
 				)
@@ -247,6 +347,7 @@ func (s *KeeperTestSuite) TestMsgUpdateRoute() {
 
 				return types.NewMsgUpdateIBCRoute(
 					1,
+					0,
 					"channel-0",
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
@@ -305,6 +406,7 @@ func (s *KeeperTestSuite) TestMsgUpdateSignalsAndInterval() {
 					1,
 					editedSignalDeviations,
 					60,
+					"",
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
 			},
@@ -333,6 +435,7 @@ func (s *KeeperTestSuite) TestMsgUpdateSignalsAndInterval() {
 					1,
 					editedSignalDeviations,
 					60,
+					"",
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
 			},
@@ -360,6 +463,7 @@ func (s *KeeperTestSuite) TestMsgUpdateSignalsAndInterval() {
 					1,
 					editedSignalDeviations,
 					1,
+					"",
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
 			},
@@ -372,6 +476,7 @@ func (s *KeeperTestSuite) TestMsgUpdateSignalsAndInterval() {
 					1,
 					[]types.SignalDeviation{},
 					60,
+					"",
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
 			},
@@ -386,6 +491,7 @@ func (s *KeeperTestSuite) TestMsgUpdateSignalsAndInterval() {
 					1,
 					[]types.SignalDeviation{},
 					60,
+					"",
 					sdk.AccAddress([]byte("wrong_creator_address")).String(),
 				)
 			},
@@ -408,6 +514,7 @@ func (s *KeeperTestSuite) TestMsgUpdateSignalsAndInterval() {
 					1,
 					editedSignalDeviations,
 					60,
+					"",
 					sdk.AccAddress([]byte("creator_address")).String(),
 				)
 			},
@@ -692,7 +799,7 @@ func (s *KeeperTestSuite) TestMsgTriggerTunnel() {
 				feePayer := sdk.MustAccAddressFromBech32(tunnel.FeePayer)
 				s.Require().NoError(err)
 
-				s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any()).Return(
+				s.bandtssKeeper.EXPECT().GetSigningFee(gomock.Any(), "").Return(
 					sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))), nil,
 				)
 
@@ -704,6 +811,8 @@ func (s *KeeperTestSuite) TestMsgTriggerTunnel() {
 					gomock.Any(),
 					feePayer,
 					sdk.NewCoins(sdk.NewCoin("uband", sdkmath.NewInt(20))),
+					"",
+					false,
 				).Return(bandtsstypes.SigningID(1), nil)
 
 				s.feedsKeeper.EXPECT().
@@ -743,6 +852,273 @@ func (s *KeeperTestSuite) TestMsgTriggerTunnel() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgRetryPacket() {
+	creator := sdk.AccAddress([]byte("creator_address")).String()
+	feePayer := sdk.AccAddress([]byte("fee_payer_address"))
+
+	cases := map[string]struct {
+		preRun    func() *types.MsgRetryPacket
+		expErr    bool
+		expErrMsg string
+	}{
+		"tunnel not found": {
+			preRun: func() *types.MsgRetryPacket {
+				return types.NewMsgRetryPacket(1, 1, 0, creator)
+			},
+			expErr:    true,
+			expErrMsg: "tunnel not found",
+		},
+		"invalid creator of the tunnel": {
+			preRun: func() *types.MsgRetryPacket {
+				s.AddSampleFailedPacket("consumer")
+
+				return types.NewMsgRetryPacket(1, 1, 0, sdk.AccAddress([]byte("wrong_creator_address")).String())
+			},
+			expErr:    true,
+			expErrMsg: "invalid creator of the tunnel",
+		},
+		"dead letter packet not found": {
+			preRun: func() *types.MsgRetryPacket {
+				s.AddSampleFailedPacket("consumer")
+
+				return types.NewMsgRetryPacket(1, 1, 0, creator)
+			},
+			expErr:    true,
+			expErrMsg: "dead letter packet not found",
+		},
+		"inactive tunnel": {
+			preRun: func() *types.MsgRetryPacket {
+				s.consumerRouter.AddRoute("consumer", mockConsumer{storeKey: s.storeKey})
+				s.AddSampleFailedPacket("consumer")
+				s.keeper.SetDeadLetterPacket(s.ctx, types.NewDeadLetterPacket(1, 1, 0, 5, "consumer not found", 0, nil))
+
+				tunnel, err := s.keeper.GetTunnel(s.ctx, 1)
+				s.Require().NoError(err)
+				tunnel.IsActive = false
+				s.keeper.SetTunnel(s.ctx, tunnel)
+
+				return types.NewMsgRetryPacket(1, 1, 0, creator)
+			},
+			expErr:    true,
+			expErrMsg: "inactive tunnel",
+		},
+		"route changed": {
+			preRun: func() *types.MsgRetryPacket {
+				s.consumerRouter.AddRoute("consumer", mockConsumer{storeKey: s.storeKey})
+				s.AddSampleFailedPacket("consumer")
+				s.keeper.SetDeadLetterPacket(
+					s.ctx,
+					types.NewDeadLetterPacket(1, 1, 0, 5, "consumer not found", 0, []byte("old_route_hash")),
+				)
+
+				return types.NewMsgRetryPacket(1, 1, 0, creator)
+			},
+			expErr:    true,
+			expErrMsg: "route changed",
+		},
+		"route still fails": {
+			preRun: func() *types.MsgRetryPacket {
+				s.AddSampleFailedPacket("consumer")
+				s.keeper.SetDeadLetterPacket(s.ctx, types.NewDeadLetterPacket(1, 1, 0, 5, "consumer not found", 0, nil))
+				s.bankKeeper.EXPECT().
+					SendCoinsFromAccountToModule(gomock.Any(), feePayer, types.ModuleName, types.DefaultBasePacketFee).
+					Return(nil)
+
+				return types.NewMsgRetryPacket(1, 1, 0, creator)
+			},
+			expErr:    true,
+			expErrMsg: "consumer not found",
+		},
+		"all good": {
+			preRun: func() *types.MsgRetryPacket {
+				s.consumerRouter.AddRoute("consumer", mockConsumer{storeKey: s.storeKey})
+				packet := s.AddSampleFailedPacket("consumer")
+
+				tunnel, err := s.keeper.GetTunnel(s.ctx, packet.TunnelID)
+				s.Require().NoError(err)
+				routeHash, err := tunnel.GetRouteHash(0)
+				s.Require().NoError(err)
+
+				s.keeper.SetDeadLetterPacket(
+					s.ctx,
+					types.NewDeadLetterPacket(1, 1, 0, 5, "consumer not found", 0, routeHash),
+				)
+				s.bankKeeper.EXPECT().
+					SendCoinsFromAccountToModule(gomock.Any(), feePayer, types.ModuleName, types.DefaultBasePacketFee).
+					Return(nil)
+
+				return types.NewMsgRetryPacket(1, 1, 0, creator)
+			},
+			expErr: false,
+		},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			s.reset()
+			msg := tc.preRun()
+
+			_, err := s.msgServer.RetryPacket(s.ctx, msg)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			_, err = s.keeper.GetDeadLetterPacket(s.ctx, 1, 1, 0)
+			s.Require().ErrorIs(err, types.ErrDeadLetterPacketNotFound)
+
+			packet, err := s.keeper.GetPacket(s.ctx, 1, 1)
+			s.Require().NoError(err)
+			s.Require().Empty(packet.GetFailedRouteIndices())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgSetTopUpConfig() {
+	creator := sdk.AccAddress([]byte("creator_address")).String()
+	funder := sdk.AccAddress([]byte("funder_address")).String()
+	threshold := sdk.NewCoins(sdk.NewInt64Coin("uband", 1000))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uband", 5000))
+
+	cases := map[string]struct {
+		preRun    func() *types.MsgSetTopUpConfig
+		expErr    bool
+		expErrMsg string
+	}{
+		"tunnel not found": {
+			preRun: func() *types.MsgSetTopUpConfig {
+				return types.NewMsgSetTopUpConfig(1, threshold, funder, types.FUNDER_GRANT_TYPE_FEEGRANT, amount, creator)
+			},
+			expErr:    true,
+			expErrMsg: "tunnel not found",
+		},
+		"invalid creator of the tunnel": {
+			preRun: func() *types.MsgSetTopUpConfig {
+				s.AddSampleTunnel(false)
+
+				return types.NewMsgSetTopUpConfig(
+					1,
+					threshold,
+					funder,
+					types.FUNDER_GRANT_TYPE_FEEGRANT,
+					amount,
+					sdk.AccAddress([]byte("wrong_creator_address")).String(),
+				)
+			},
+			expErr:    true,
+			expErrMsg: "invalid creator of the tunnel",
+		},
+		"funder is the fee payer": {
+			preRun: func() *types.MsgSetTopUpConfig {
+				tunnel := s.AddSampleTunnel(false)
+
+				return types.NewMsgSetTopUpConfig(
+					1,
+					threshold,
+					tunnel.FeePayer,
+					types.FUNDER_GRANT_TYPE_FEEGRANT,
+					amount,
+					creator,
+				)
+			},
+			expErr:    true,
+			expErrMsg: "funder cannot be the fee payer",
+		},
+		"all good": {
+			preRun: func() *types.MsgSetTopUpConfig {
+				s.AddSampleTunnel(false)
+
+				return types.NewMsgSetTopUpConfig(1, threshold, funder, types.FUNDER_GRANT_TYPE_FEEGRANT, amount, creator)
+			},
+			expErr: false,
+		},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			s.reset()
+			msg := tc.preRun()
+
+			_, err := s.msgServer.SetTopUpConfig(s.ctx, msg)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			config, found := s.keeper.GetTopUpConfig(s.ctx, 1)
+			s.Require().True(found)
+			s.Require().Equal(
+				types.NewTopUpConfig(1, threshold, funder, types.FUNDER_GRANT_TYPE_FEEGRANT, amount),
+				config,
+			)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgRemoveTopUpConfig() {
+	creator := sdk.AccAddress([]byte("creator_address")).String()
+
+	cases := map[string]struct {
+		preRun    func() *types.MsgRemoveTopUpConfig
+		expErr    bool
+		expErrMsg string
+	}{
+		"tunnel not found": {
+			preRun: func() *types.MsgRemoveTopUpConfig {
+				return types.NewMsgRemoveTopUpConfig(1, creator)
+			},
+			expErr:    true,
+			expErrMsg: "tunnel not found",
+		},
+		"top-up config not found": {
+			preRun: func() *types.MsgRemoveTopUpConfig {
+				s.AddSampleTunnel(false)
+
+				return types.NewMsgRemoveTopUpConfig(1, creator)
+			},
+			expErr:    true,
+			expErrMsg: "top-up config not found",
+		},
+		"all good": {
+			preRun: func() *types.MsgRemoveTopUpConfig {
+				s.AddSampleTunnel(false)
+				s.keeper.SetTopUpConfig(s.ctx, types.NewTopUpConfig(
+					1,
+					sdk.NewCoins(sdk.NewInt64Coin("uband", 1000)),
+					"",
+					types.FUNDER_GRANT_TYPE_UNSPECIFIED,
+					nil,
+				))
+
+				return types.NewMsgRemoveTopUpConfig(1, creator)
+			},
+			expErr: false,
+		},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			s.reset()
+			msg := tc.preRun()
+
+			_, err := s.msgServer.RemoveTopUpConfig(s.ctx, msg)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			_, found := s.keeper.GetTopUpConfig(s.ctx, 1)
+			s.Require().False(found)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestMsgDepositToTunnel() {
 	cases := map[string]struct {
 		preRun    func() *types.MsgDepositToTunnel
@@ -0,0 +1,38 @@
+package v2
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// Migrate migrates the x/tunnel module state from the consensus version 1 to
+// version 2. Specifically, it copies the single route of every existing tunnel
+// into the routes of the tunnel. Existing packets are not changed as their
+// single receipt is still read as the receipts of the packet.
+func Migrate(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	iterator := storetypes.KVStorePrefixIterator(store, types.TunnelStoreKeyPrefix)
+	defer iterator.Close()
+
+	var tunnels []types.Tunnel
+	for ; iterator.Valid(); iterator.Next() {
+		var tunnel types.Tunnel
+		if err := cdc.Unmarshal(iterator.Value(), &tunnel); err != nil {
+			return err
+		}
+
+		if tunnel.Route != nil && len(tunnel.Routes) == 0 {
+			tunnel.Routes = []*codectypes.Any{tunnel.Route}
+			tunnels = append(tunnels, tunnel)
+		}
+	}
+
+	for _, tunnel := range tunnels {
+		store.Set(types.TunnelStoreKey(tunnel.ID), cdc.MustMarshal(&tunnel))
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel"
+	v2 "github.com/bandprotocol/chain/v3/x/tunnel/migrations/v2"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(tunnel.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// a tunnel stored before a tunnel can have multiple routes only has its single route
+	legacyTunnel, err := types.NewTunnel(1, 0, []types.RouteI{types.NewLocalRoute("consumer")}, "", nil, 60, "", nil, true, 0, "")
+	require.NoError(t, err)
+	legacyTunnel.Routes = nil
+	store.Set(types.TunnelStoreKey(1), cdc.MustMarshal(&legacyTunnel))
+
+	tssRoute := types.NewTSSRoute("chain-1", "0x1234", 1, false, "")
+	multiRouteTunnel, err := types.NewTunnel(
+		2, 0, []types.RouteI{&tssRoute, types.NewLocalRoute("consumer")}, "", nil, 60, "", nil, true, 0, "",
+	)
+	require.NoError(t, err)
+	store.Set(types.TunnelStoreKey(2), cdc.MustMarshal(&multiRouteTunnel))
+
+	require.NoError(t, v2.Migrate(store, cdc))
+
+	var tunnel types.Tunnel
+	require.NoError(t, cdc.Unmarshal(store.Get(types.TunnelStoreKey(1)), &tunnel))
+	require.Len(t, tunnel.Routes, 1)
+	require.Equal(t, tunnel.Route.Value, tunnel.Routes[0].Value)
+
+	require.NoError(t, cdc.Unmarshal(store.Get(types.TunnelStoreKey(2)), &tunnel))
+	require.Len(t, tunnel.Routes, 2)
+	require.Equal(t, multiRouteTunnel.Routes[1].Value, tunnel.Routes[1].Value)
+}
@@ -222,6 +222,83 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// MockFeegrantKeeper is a mock of FeegrantKeeper interface.
+type MockFeegrantKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeegrantKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockFeegrantKeeperMockRecorder is the mock recorder for MockFeegrantKeeper.
+type MockFeegrantKeeperMockRecorder struct {
+	mock *MockFeegrantKeeper
+}
+
+// NewMockFeegrantKeeper creates a new mock instance.
+func NewMockFeegrantKeeper(ctrl *gomock.Controller) *MockFeegrantKeeper {
+	mock := &MockFeegrantKeeper{ctrl: ctrl}
+	mock.recorder = &MockFeegrantKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeegrantKeeper) EXPECT() *MockFeegrantKeeperMockRecorder {
+	return m.recorder
+}
+
+// UseGrantedFees mocks base method.
+func (m *MockFeegrantKeeper) UseGrantedFees(ctx context.Context, granter, grantee types2.AccAddress, fee types2.Coins, msgs []types2.Msg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseGrantedFees", ctx, granter, grantee, fee, msgs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseGrantedFees indicates an expected call of UseGrantedFees.
+func (mr *MockFeegrantKeeperMockRecorder) UseGrantedFees(ctx, granter, grantee, fee, msgs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseGrantedFees", reflect.TypeOf((*MockFeegrantKeeper)(nil).UseGrantedFees), ctx, granter, grantee, fee, msgs)
+}
+
+// MockAuthzKeeper is a mock of AuthzKeeper interface.
+type MockAuthzKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthzKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthzKeeperMockRecorder is the mock recorder for MockAuthzKeeper.
+type MockAuthzKeeperMockRecorder struct {
+	mock *MockAuthzKeeper
+}
+
+// NewMockAuthzKeeper creates a new mock instance.
+func NewMockAuthzKeeper(ctrl *gomock.Controller) *MockAuthzKeeper {
+	mock := &MockAuthzKeeper{ctrl: ctrl}
+	mock.recorder = &MockAuthzKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthzKeeper) EXPECT() *MockAuthzKeeperMockRecorder {
+	return m.recorder
+}
+
+// DispatchActions mocks base method.
+func (m *MockAuthzKeeper) DispatchActions(ctx context.Context, grantee types2.AccAddress, msgs []types2.Msg) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispatchActions", ctx, grantee, msgs)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DispatchActions indicates an expected call of DispatchActions.
+func (mr *MockAuthzKeeperMockRecorder) DispatchActions(ctx, grantee, msgs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchActions", reflect.TypeOf((*MockAuthzKeeper)(nil).DispatchActions), ctx, grantee, msgs)
+}
+
 // MockICS4Wrapper is a mock of ICS4Wrapper interface.
 type MockICS4Wrapper struct {
 	ctrl     *gomock.Controller
@@ -521,31 +598,31 @@ func (m *MockBandtssKeeper) EXPECT() *MockBandtssKeeperMockRecorder {
 }
 
 // CreateTunnelSigningRequest mocks base method.
-func (m *MockBandtssKeeper) CreateTunnelSigningRequest(ctx types2.Context, tunnelID uint64, destinationChainID, destinationContractAddr string, content types1.Content, sender types2.AccAddress, feeLimit types2.Coins) (types.SigningID, error) {
+func (m *MockBandtssKeeper) CreateTunnelSigningRequest(ctx types2.Context, tunnelID uint64, destinationChainID, destinationContractAddr string, content types1.Content, sender types2.AccAddress, feeLimit types2.Coins, groupName string, batch bool) (types.SigningID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTunnelSigningRequest", ctx, tunnelID, destinationChainID, destinationContractAddr, content, sender, feeLimit)
+	ret := m.ctrl.Call(m, "CreateTunnelSigningRequest", ctx, tunnelID, destinationChainID, destinationContractAddr, content, sender, feeLimit, groupName, batch)
 	ret0, _ := ret[0].(types.SigningID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateTunnelSigningRequest indicates an expected call of CreateTunnelSigningRequest.
-func (mr *MockBandtssKeeperMockRecorder) CreateTunnelSigningRequest(ctx, tunnelID, destinationChainID, destinationContractAddr, content, sender, feeLimit any) *gomock.Call {
+func (mr *MockBandtssKeeperMockRecorder) CreateTunnelSigningRequest(ctx, tunnelID, destinationChainID, destinationContractAddr, content, sender, feeLimit, groupName, batch any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTunnelSigningRequest", reflect.TypeOf((*MockBandtssKeeper)(nil).CreateTunnelSigningRequest), ctx, tunnelID, destinationChainID, destinationContractAddr, content, sender, feeLimit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTunnelSigningRequest", reflect.TypeOf((*MockBandtssKeeper)(nil).CreateTunnelSigningRequest), ctx, tunnelID, destinationChainID, destinationContractAddr, content, sender, feeLimit, groupName, batch)
 }
 
 // GetSigningFee mocks base method.
-func (m *MockBandtssKeeper) GetSigningFee(ctx types2.Context) (types2.Coins, error) {
+func (m *MockBandtssKeeper) GetSigningFee(ctx types2.Context, groupName string) (types2.Coins, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSigningFee", ctx)
+	ret := m.ctrl.Call(m, "GetSigningFee", ctx, groupName)
 	ret0, _ := ret[0].(types2.Coins)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSigningFee indicates an expected call of GetSigningFee.
-func (mr *MockBandtssKeeperMockRecorder) GetSigningFee(ctx any) *gomock.Call {
+func (mr *MockBandtssKeeperMockRecorder) GetSigningFee(ctx, groupName any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSigningFee", reflect.TypeOf((*MockBandtssKeeper)(nil).GetSigningFee), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSigningFee", reflect.TypeOf((*MockBandtssKeeper)(nil).GetSigningFee), ctx, groupName)
 }
@@ -25,6 +25,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgActivateTunnel{}, "tunnel/MsgActivateTunnel")
 	legacy.RegisterAminoMsg(cdc, &MsgDeactivateTunnel{}, "tunnel/MsgDeactivateTunnel")
 	legacy.RegisterAminoMsg(cdc, &MsgTriggerTunnel{}, "tunnel/MsgTriggerTunnel")
+	legacy.RegisterAminoMsg(cdc, &MsgRetryPacket{}, "tunnel/MsgRetryPacket")
+	legacy.RegisterAminoMsg(cdc, &MsgSetTopUpConfig{}, "tunnel/MsgSetTopUpConfig")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveTopUpConfig{}, "tunnel/MsgRemoveTopUpConfig")
 	legacy.RegisterAminoMsg(cdc, &MsgDepositToTunnel{}, "tunnel/MsgDepositToTunnel")
 	legacy.RegisterAminoMsg(cdc, &MsgWithdrawFromTunnel{}, "tunnel/MsgWithdrawFromTunnel")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "tunnel/MsgUpdateParams")
@@ -35,6 +38,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&IBCHookRoute{}, "tunnel/IBCHookRoute", nil)
 	cdc.RegisterConcrete(&RouterRoute{}, "tunnel/RouterRoute", nil)
 	cdc.RegisterConcrete(&AxelarRoute{}, "tunnel/AxelarRoute", nil)
+	cdc.RegisterConcrete(&LocalRoute{}, "tunnel/LocalRoute", nil)
 
 	cdc.RegisterInterface((*PacketReceiptI)(nil), nil)
 	cdc.RegisterConcrete(&TSSPacketReceipt{}, "tunnel/TSSPacketReceipt", nil)
@@ -42,6 +46,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&IBCHookPacketReceipt{}, "tunnel/IBCHookPacketReceipt", nil)
 	cdc.RegisterConcrete(&RouterPacketReceipt{}, "tunnel/RouterPacketReceipt", nil)
 	cdc.RegisterConcrete(&AxelarPacketReceipt{}, "tunnel/AxelarPacketReceipt", nil)
+	cdc.RegisterConcrete(&LocalPacketReceipt{}, "tunnel/LocalPacketReceipt", nil)
+	cdc.RegisterConcrete(&FailedPacketReceipt{}, "tunnel/FailedPacketReceipt", nil)
 
 	cdc.RegisterConcrete(Params{}, "tunnel/Params", nil)
 }
@@ -57,6 +63,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgActivateTunnel{},
 		&MsgDeactivateTunnel{},
 		&MsgTriggerTunnel{},
+		&MsgRetryPacket{},
+		&MsgSetTopUpConfig{},
+		&MsgRemoveTopUpConfig{},
 		&MsgDepositToTunnel{},
 		&MsgWithdrawFromTunnel{},
 		&MsgUpdateParams{},
@@ -70,6 +79,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&IBCHookRoute{},
 		&RouterRoute{},
 		&AxelarRoute{},
+		&LocalRoute{},
 	)
 
 	registry.RegisterInterface(
@@ -80,6 +90,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&IBCHookPacketReceipt{},
 		&RouterPacketReceipt{},
 		&AxelarPacketReceipt{},
+		&LocalPacketReceipt{},
+		&FailedPacketReceipt{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ConsumerRouter is a struct that holds a map of TunnelConsumerHooks objects for each consumer module.
+type ConsumerRouter struct {
+	routes map[string]TunnelConsumerHooks
+	sealed bool
+}
+
+// NewConsumerRouter creates a new ConsumerRouter instance.
+func NewConsumerRouter() *ConsumerRouter {
+	return &ConsumerRouter{
+		routes: make(map[string]TunnelConsumerHooks),
+	}
+}
+
+// Seal seals the ConsumerRouter which prohibits any subsequent TunnelConsumerHooks to be added.
+// Seal will panic if called more than once.
+func (cr *ConsumerRouter) Seal() {
+	if cr.sealed {
+		panic(errors.New("consumer router is already sealed"))
+	}
+	cr.sealed = true
+}
+
+// Sealed returns whether the ConsumerRouter can be changed or not.
+func (cr ConsumerRouter) Sealed() bool {
+	return cr.sealed
+}
+
+// AddRoute adds TunnelConsumerHooks for a given consumer name. It returns the ConsumerRouter
+// so that the function can be chained. It will panic if the ConsumerRouter is sealed.
+func (cr *ConsumerRouter) AddRoute(consumer string, hooks TunnelConsumerHooks) *ConsumerRouter {
+	if cr.sealed {
+		panic(fmt.Errorf("consumer router sealed; cannot register %s route hooks", consumer))
+	}
+	if !sdk.IsAlphaNumeric(consumer) {
+		panic(errors.New("consumer route expressions can only contain alphanumeric characters"))
+	}
+	if cr.HasRoute(consumer) {
+		panic(fmt.Errorf("route %s has already been registered", consumer))
+	}
+
+	cr.routes[consumer] = hooks
+	return cr
+}
+
+// HasRoute returns whether the given consumer is registered.
+func (cr *ConsumerRouter) HasRoute(consumer string) bool {
+	_, ok := cr.routes[consumer]
+	return ok
+}
+
+// GetRoute returns a TunnelConsumerHooks for a given consumer.
+func (cr *ConsumerRouter) GetRoute(consumer string) (TunnelConsumerHooks, bool) {
+	if !cr.HasRoute(consumer) {
+		return nil, false
+	}
+	return cr.routes[consumer], true
+}
+
+// TunnelConsumerHooks defines the expected interface for a consumer module that registered
+// in the consumerRouter to receive packets of tunnels with a local route.
+type TunnelConsumerHooks interface {
+	// Must be called when a tunnel with a local route to the consumer produces a packet.
+	// The state changes are discarded if it returns an error.
+	OnReceiveTunnelPacket(ctx sdk.Context, packet TunnelPricesPacketData) error
+}
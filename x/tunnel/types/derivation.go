@@ -0,0 +1,123 @@
+package types
+
+import (
+	"fmt"
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// MaxDerivationExponent is the maximum absolute exponent of the rescale derivation method.
+const MaxDerivationExponent = 18
+
+// priceMultiplier is the fixed-point multiplier of the prices from the feeds module.
+var priceMultiplier = sdkmath.NewInt(1_000_000_000)
+
+// NewDerivation creates a new Derivation instance.
+func NewDerivation(method DerivationMethod, sourceSignalIDs []string, exponent int32) *Derivation {
+	return &Derivation{
+		Method:          method,
+		SourceSignalIDs: sourceSignalIDs,
+		Exponent:        exponent,
+	}
+}
+
+// Validate validates the derivation.
+func (d Derivation) Validate() error {
+	var sourceCount int
+	switch d.Method {
+	case DERIVATION_METHOD_CROSS:
+		sourceCount = 2
+	case DERIVATION_METHOD_INVERSE, DERIVATION_METHOD_RESCALE:
+		sourceCount = 1
+	default:
+		return fmt.Errorf("invalid derivation method: %s", d.Method)
+	}
+
+	if len(d.SourceSignalIDs) != sourceCount {
+		return fmt.Errorf(
+			"derivation method %s requires %d source signals, got %d",
+			d.Method,
+			sourceCount,
+			len(d.SourceSignalIDs),
+		)
+	}
+
+	for _, signalID := range d.SourceSignalIDs {
+		if signalID == "" {
+			return fmt.Errorf("source signal ID cannot be empty")
+		}
+	}
+
+	if d.Method == DERIVATION_METHOD_RESCALE {
+		if d.Exponent == 0 || d.Exponent > MaxDerivationExponent || d.Exponent < -MaxDerivationExponent {
+			return fmt.Errorf(
+				"rescale exponent must be non-zero and between -%d and %d, got %d",
+				MaxDerivationExponent,
+				MaxDerivationExponent,
+				d.Exponent,
+			)
+		}
+	} else if d.Exponent != 0 {
+		return fmt.Errorf("exponent is only allowed for the rescale method")
+	}
+
+	return nil
+}
+
+// Compute computes the price of the derived signal from the prices of its source signals. The result is
+// rounded down. If a source price is not available, the derived price has the status of that source; if
+// the result is undefined or does not fit in uint64, the derived price is not ready.
+func (d Derivation) Compute(
+	signalID string,
+	pricesMap map[string]feedstypes.Price,
+	timestamp int64,
+) feedstypes.Price {
+	sources := make([]sdkmath.Int, 0, len(d.SourceSignalIDs))
+	sourceTimestamp := int64(math.MaxInt64)
+	for _, sourceID := range d.SourceSignalIDs {
+		source, ok := pricesMap[sourceID]
+		if !ok {
+			return feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_IN_CURRENT_FEEDS, signalID, 0, timestamp)
+		}
+		if source.Status != feedstypes.PRICE_STATUS_AVAILABLE {
+			return feedstypes.NewPrice(source.Status, signalID, 0, timestamp)
+		}
+
+		sources = append(sources, sdkmath.NewIntFromUint64(source.Price))
+		sourceTimestamp = min(sourceTimestamp, source.Timestamp)
+	}
+
+	var price sdkmath.Int
+	switch {
+	case d.Method == DERIVATION_METHOD_CROSS && len(sources) == 2 && !sources[1].IsZero():
+		price = sources[0].Mul(priceMultiplier).Quo(sources[1])
+	case d.Method == DERIVATION_METHOD_INVERSE && len(sources) == 1 && !sources[0].IsZero():
+		price = priceMultiplier.Mul(priceMultiplier).Quo(sources[0])
+	case d.Method == DERIVATION_METHOD_RESCALE && len(sources) == 1:
+		factor := sdkmath.NewIntWithDecimal(1, int(abs(d.Exponent)))
+		if d.Exponent > 0 {
+			price = sources[0].Mul(factor)
+		} else {
+			price = sources[0].Quo(factor)
+		}
+	default:
+		return feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, signalID, 0, timestamp)
+	}
+
+	if !price.IsUint64() {
+		return feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, signalID, 0, timestamp)
+	}
+
+	return feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, signalID, price.Uint64(), sourceTimestamp)
+}
+
+// abs returns the absolute value of x.
+func abs(x int32) int32 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
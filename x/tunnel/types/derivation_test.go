@@ -0,0 +1,147 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func TestDerivationValidate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		derivation *types.Derivation
+		expErr     string
+	}{
+		{
+			name:       "valid cross",
+			derivation: types.NewDerivation(types.DERIVATION_METHOD_CROSS, []string{"CS:ETH-USD", "CS:BTC-USD"}, 0),
+		},
+		{
+			name:       "valid inverse",
+			derivation: types.NewDerivation(types.DERIVATION_METHOD_INVERSE, []string{"CS:BTC-USD"}, 0),
+		},
+		{
+			name:       "valid rescale",
+			derivation: types.NewDerivation(types.DERIVATION_METHOD_RESCALE, []string{"CS:BTC-USD"}, -18),
+		},
+		{
+			name:       "unspecified method",
+			derivation: types.NewDerivation(types.DERIVATION_METHOD_UNSPECIFIED, []string{"CS:BTC-USD"}, 0),
+			expErr:     "invalid derivation method",
+		},
+		{
+			name:       "cross with one source",
+			derivation: types.NewDerivation(types.DERIVATION_METHOD_CROSS, []string{"CS:ETH-USD"}, 0),
+			expErr:     "requires 2 source signals",
+		},
+		{
+			name:       "empty source",
+			derivation: types.NewDerivation(types.DERIVATION_METHOD_INVERSE, []string{""}, 0),
+			expErr:     "source signal ID cannot be empty",
+		},
+		{
+			name:       "rescale with zero exponent",
+			derivation: types.NewDerivation(types.DERIVATION_METHOD_RESCALE, []string{"CS:BTC-USD"}, 0),
+			expErr:     "rescale exponent must be non-zero",
+		},
+		{
+			name:       "rescale with too large exponent",
+			derivation: types.NewDerivation(types.DERIVATION_METHOD_RESCALE, []string{"CS:BTC-USD"}, 19),
+			expErr:     "rescale exponent must be non-zero",
+		},
+		{
+			name:       "exponent with inverse",
+			derivation: types.NewDerivation(types.DERIVATION_METHOD_INVERSE, []string{"CS:BTC-USD"}, 2),
+			expErr:     "exponent is only allowed for the rescale method",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.derivation.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDerivationCompute(t *testing.T) {
+	pricesMap := map[string]feedstypes.Price{
+		"CS:ETH-USD":  feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:ETH-USD", 3_000_000_000_000, 1733000010),
+		"CS:BTC-USD":  feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:BTC-USD", 60_000_000_000_000, 1733000000),
+		"CS:ZERO-USD": feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "CS:ZERO-USD", 0, 1733000000),
+		"CS:MAX-USD": feedstypes.NewPrice(
+			feedstypes.PRICE_STATUS_AVAILABLE,
+			"CS:MAX-USD",
+			math.MaxUint64,
+			1733000000,
+		),
+		"CS:NEW-USD": feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, "CS:NEW-USD", 0, 1733000000),
+	}
+	timestamp := int64(1733000020)
+
+	testCases := []struct {
+		name       string
+		derivation *types.Derivation
+		expPrice   feedstypes.Price
+	}{
+		{
+			name:       "cross",
+			derivation: types.NewDerivation(types.DERIVATION_METHOD_CROSS, []string{"CS:ETH-USD", "CS:BTC-USD"}, 0),
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "DERIVED", 50_000_000, 1733000000),
+		},
+		{
+			name:       "inverse",
+			derivation: types.NewDerivation(types.DERIVATION_METHOD_INVERSE, []string{"CS:BTC-USD"}, 0),
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "DERIVED", 16_666, 1733000000),
+		},
+		{
+			name:       "rescale up",
+			derivation: types.NewDerivation(types.DERIVATION_METHOD_RESCALE, []string{"CS:ETH-USD"}, 2),
+			expPrice: feedstypes.NewPrice(
+				feedstypes.PRICE_STATUS_AVAILABLE,
+				"DERIVED",
+				300_000_000_000_000,
+				1733000010,
+			),
+		},
+		{
+			name:       "rescale down",
+			derivation: types.NewDerivation(types.DERIVATION_METHOD_RESCALE, []string{"CS:ETH-USD"}, -9),
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_AVAILABLE, "DERIVED", 3_000, 1733000010),
+		},
+		{
+			name:       "source not in current feeds",
+			derivation: types.NewDerivation(types.DERIVATION_METHOD_CROSS, []string{"CS:ETH-USD", "CS:DOGE-USD"}, 0),
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_IN_CURRENT_FEEDS, "DERIVED", 0, timestamp),
+		},
+		{
+			name:       "source not ready",
+			derivation: types.NewDerivation(types.DERIVATION_METHOD_INVERSE, []string{"CS:NEW-USD"}, 0),
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, "DERIVED", 0, timestamp),
+		},
+		{
+			name:       "division by zero",
+			derivation: types.NewDerivation(types.DERIVATION_METHOD_CROSS, []string{"CS:ETH-USD", "CS:ZERO-USD"}, 0),
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, "DERIVED", 0, timestamp),
+		},
+		{
+			name:       "overflow",
+			derivation: types.NewDerivation(types.DERIVATION_METHOD_RESCALE, []string{"CS:MAX-USD"}, 1),
+			expPrice:   feedstypes.NewPrice(feedstypes.PRICE_STATUS_NOT_READY, "DERIVED", 0, timestamp),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expPrice, tc.derivation.Compute("DERIVED", pricesMap, timestamp))
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"encoding/binary"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// EncodeBorsh encodes the TSS packet to the Borsh layout of the struct
+// { sequence: u64, relay_prices: Vec<([u8; 32], u64)>, created_at: i64 }.
+func (p TSSPacket) EncodeBorsh() []byte {
+	bz := binary.LittleEndian.AppendUint64(nil, p.Sequence)
+	bz = feedstypes.AppendBorshRelayPrices(bz, p.RelayPrices)
+	return binary.LittleEndian.AppendUint64(bz, uint64(p.CreatedAt))
+}
+
+// EncodingPacketBorsh encodes the packet to the Borsh layout of the struct
+// { tunnel_id: u64, sequence: u64, relay_prices: Vec<([u8; 32], u64)>, created_at: i64 }.
+func EncodingPacketBorsh(p Packet) ([]byte, error) {
+	relayPrices, err := feedstypes.ToRelayPrices(p.Prices)
+	if err != nil {
+		return nil, err
+	}
+
+	bz := binary.LittleEndian.AppendUint64(nil, p.TunnelID)
+	bz = binary.LittleEndian.AppendUint64(bz, p.Sequence)
+	bz = feedstypes.AppendBorshRelayPrices(bz, relayPrices)
+	return binary.LittleEndian.AppendUint64(bz, uint64(p.CreatedAt)), nil
+}
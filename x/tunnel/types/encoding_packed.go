@@ -0,0 +1,29 @@
+package types
+
+import (
+	"encoding/binary"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// EncodePacked encodes the TSS packet to the packed layout, which is the sequence, the relay prices and
+// the creation time with all integers in big-endian and without any padding.
+func (p TSSPacket) EncodePacked() []byte {
+	bz := binary.BigEndian.AppendUint64(nil, p.Sequence)
+	bz = feedstypes.AppendPackedRelayPrices(bz, p.RelayPrices)
+	return binary.BigEndian.AppendUint64(bz, uint64(p.CreatedAt))
+}
+
+// EncodingPacketPacked encodes the packet to the packed layout, which is the tunnel ID, the sequence,
+// the relay prices and the creation time with all integers in big-endian and without any padding.
+func EncodingPacketPacked(p Packet) ([]byte, error) {
+	relayPrices, err := feedstypes.ToRelayPrices(p.Prices)
+	if err != nil {
+		return nil, err
+	}
+
+	bz := binary.BigEndian.AppendUint64(nil, p.TunnelID)
+	bz = binary.BigEndian.AppendUint64(bz, p.Sequence)
+	bz = feedstypes.AppendPackedRelayPrices(bz, relayPrices)
+	return binary.BigEndian.AppendUint64(bz, uint64(p.CreatedAt)), nil
+}
@@ -59,3 +59,31 @@ func EncodingPacketABI(p Packet) ([]byte, error) {
 	abiPacket := NewPacketABI(p.TunnelID, p.Sequence, relayPrices, p.CreatedAt)
 	return packetArgs.Pack(&abiPacket)
 }
+
+// EncodingPacketTickABI encodes the packet to abi message with the prices converted to ticks
+func EncodingPacketTickABI(p Packet) ([]byte, error) {
+	relayPrices, err := feedstypes.ToRelayTickPrices(p.Prices)
+	if err != nil {
+		return nil, err
+	}
+
+	abiPacket := NewPacketABI(p.TunnelID, p.Sequence, relayPrices, p.CreatedAt)
+	return packetArgs.Pack(&abiPacket)
+}
+
+// EncodePacket encodes the packet with the given encoder. The fixed-point abi encoder is used if the
+// encoder is unspecified.
+func EncodePacket(p Packet, encoder feedstypes.Encoder) ([]byte, error) {
+	switch encoder {
+	case feedstypes.ENCODER_UNSPECIFIED, feedstypes.ENCODER_FIXED_POINT_ABI:
+		return EncodingPacketABI(p)
+	case feedstypes.ENCODER_TICK_ABI:
+		return EncodingPacketTickABI(p)
+	case feedstypes.ENCODER_FIXED_POINT_PACKED:
+		return EncodingPacketPacked(p)
+	case feedstypes.ENCODER_FIXED_POINT_BORSH:
+		return EncodingPacketBorsh(p)
+	default:
+		return nil, ErrInvalidEncoder.Wrapf("invalid encoder mode: %s", encoder.String())
+	}
+}
@@ -41,3 +41,68 @@ func TestEncodingPacketABI(t *testing.T) {
 
 	require.Equal(t, expectedMsg, hex.EncodeToString(encodingPacket))
 }
+
+func TestEncodePacket(t *testing.T) {
+	packet := types.Packet{
+		TunnelID:  1,
+		Sequence:  1,
+		Prices:    []feedstypes.Price{{SignalID: "BTC", Price: 72163}},
+		CreatedAt: 1730358471,
+	}
+
+	testCases := []struct {
+		name        string
+		encoder     feedstypes.Encoder
+		expectedMsg string
+	}{
+		{
+			name:    "packed",
+			encoder: feedstypes.ENCODER_FIXED_POINT_PACKED,
+			expectedMsg: (
+			// TunnelID: 1 (uint64)
+			"0000000000000001" +
+				// Sequence: 1 (uint64)
+				"0000000000000001" +
+				// Length of SignalPrices array: 1 (uint32)
+				"00000001" +
+				// SignalPrices[0].SignalID: "BTC" (bytes32)
+				"0000000000000000000000000000000000000000000000000000000000425443" +
+				// SignalPrices[0].Price: 72163 (uint64)
+				"00000000000119e3" +
+				// CreatedAt: 1730358471 (int64)
+				"0000000067232cc7"),
+		},
+		{
+			name:    "borsh",
+			encoder: feedstypes.ENCODER_FIXED_POINT_BORSH,
+			expectedMsg: (
+			// TunnelID: 1 (u64 little-endian)
+			"0100000000000000" +
+				// Sequence: 1 (u64 little-endian)
+				"0100000000000000" +
+				// Length of SignalPrices array: 1 (u32 little-endian)
+				"01000000" +
+				// SignalPrices[0].SignalID: "BTC" ([u8; 32])
+				"0000000000000000000000000000000000000000000000000000000000425443" +
+				// SignalPrices[0].Price: 72163 (u64 little-endian)
+				"e319010000000000" +
+				// CreatedAt: 1730358471 (i64 little-endian)
+				"c72c236700000000"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			encodingPacket, err := types.EncodePacket(packet, tc.encoder)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedMsg, hex.EncodeToString(encodingPacket))
+		})
+	}
+
+	// the fixed-point abi encoder is used if the encoder is unspecified
+	encodingPacket, err := types.EncodePacket(packet, feedstypes.ENCODER_UNSPECIFIED)
+	require.NoError(t, err)
+	expectedPacket, err := types.EncodingPacketABI(packet)
+	require.NoError(t, err)
+	require.Equal(t, expectedPacket, encodingPacket)
+}
@@ -82,6 +82,22 @@ func EncodeTSS(
 		}
 
 		return append([]byte(feedstypes.EncoderTickABIPrefix), bz...), nil
+	case feedstypes.ENCODER_FIXED_POINT_PACKED:
+		relayPrices, err := feedstypes.ToRelayPrices(prices)
+		if err != nil {
+			return nil, err
+		}
+
+		tssPacket := NewTSSPacket(sequence, relayPrices, createdAt)
+		return append([]byte(feedstypes.EncoderFixedPointPackedPrefix), tssPacket.EncodePacked()...), nil
+	case feedstypes.ENCODER_FIXED_POINT_BORSH:
+		relayPrices, err := feedstypes.ToRelayPrices(prices)
+		if err != nil {
+			return nil, err
+		}
+
+		tssPacket := NewTSSPacket(sequence, relayPrices, createdAt)
+		return append([]byte(feedstypes.EncoderFixedPointBorshPrefix), tssPacket.EncodeBorsh()...), nil
 	default:
 		return nil, ErrInvalidEncoder.Wrapf("invalid encoder mode: %s", encoder.String())
 	}
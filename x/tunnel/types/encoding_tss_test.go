@@ -55,3 +55,53 @@ func TestEncodeTSSTick(t *testing.T) {
 
 	require.Equal(t, expectedMsg, hex.EncodeToString(msg))
 }
+
+func TestEncodeTSSFixedPointPacked(t *testing.T) {
+	expectedMsg := ("eba9e89b" +
+		// sequence (uint64)
+		"0000000000000003" +
+		// number of prices (uint32)
+		"00000001" +
+		// signal ID (bytes32) and price (uint64)
+		"00000000000000000000000000000000000000000043533a42414e442d555344" +
+		"0000000000000002" +
+		// created at (int64)
+		"000000000000007b")
+
+	msg, err := types.EncodeTSS(
+		3,
+		[]feedstypes.Price{
+			{SignalID: "CS:BAND-USD", Price: 2, Status: feedstypes.PRICE_STATUS_AVAILABLE},
+		},
+		123,
+		feedstypes.ENCODER_FIXED_POINT_PACKED,
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, expectedMsg, hex.EncodeToString(msg))
+}
+
+func TestEncodeTSSFixedPointBorsh(t *testing.T) {
+	expectedMsg := ("a84ea174" +
+		// sequence (u64 little-endian)
+		"0300000000000000" +
+		// number of prices (u32 little-endian)
+		"01000000" +
+		// signal ID ([u8; 32]) and price (u64 little-endian)
+		"00000000000000000000000000000000000000000043533a42414e442d555344" +
+		"0200000000000000" +
+		// created at (i64 little-endian)
+		"7b00000000000000")
+
+	msg, err := types.EncodeTSS(
+		3,
+		[]feedstypes.Price{
+			{SignalID: "CS:BAND-USD", Price: 2, Status: feedstypes.PRICE_STATUS_AVAILABLE},
+		},
+		123,
+		feedstypes.ENCODER_FIXED_POINT_BORSH,
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, expectedMsg, hex.EncodeToString(msg))
+}
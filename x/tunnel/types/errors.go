@@ -31,4 +31,13 @@ var (
 	ErrSendPacketPanic           = errorsmod.Register(ModuleName, 24, "panic in sending packet")
 	ErrInvalidChannelID          = errorsmod.Register(ModuleName, 25, "invalid channel id")
 	ErrInvalidPortID             = errorsmod.Register(ModuleName, 26, "invalid port id")
+	ErrConsumerNotFound          = errorsmod.Register(ModuleName, 27, "consumer not found")
+	ErrMaxRoutesExceeded         = errorsmod.Register(ModuleName, 28, "max routes exceeded")
+	ErrPacketRetryNotFound       = errorsmod.Register(ModuleName, 29, "packet retry not found")
+	ErrDeadLetterPacketNotFound  = errorsmod.Register(ModuleName, 30, "dead letter packet not found")
+	ErrRouteChanged              = errorsmod.Register(ModuleName, 31, "route changed")
+	ErrInvalidSchedule           = errorsmod.Register(ModuleName, 32, "invalid schedule")
+	ErrInvalidDerivation         = errorsmod.Register(ModuleName, 33, "invalid derivation")
+	ErrInvalidTopUpConfig        = errorsmod.Register(ModuleName, 34, "invalid top-up config")
+	ErrTopUpConfigNotFound       = errorsmod.Register(ModuleName, 35, "top-up config not found")
 )
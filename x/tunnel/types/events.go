@@ -10,14 +10,25 @@ const (
 	EventTypeTriggerTunnel            = "trigger_tunnel"
 	EventTypeProducePacketFail        = "produce_packet_fail"
 	EventTypeProducePacketSuccess     = "produce_packet_success"
+	EventTypeSendPacketFail           = "send_packet_fail"
+	EventTypeRetryPacketSuccess       = "retry_packet_success"
+	EventTypeRetryPacketFail          = "retry_packet_fail"
+	EventTypeDeadLetterPacket         = "dead_letter_packet"
 	EventTypeDepositToTunnel          = "deposit_to_tunnel"
 	EventTypeWithdrawFromTunnel       = "withdraw_from_tunnel"
+	EventTypeSetTopUpConfig           = "set_top_up_config"
+	EventTypeRemoveTopUpConfig        = "remove_top_up_config"
+	EventTypeLowFeePayerBalance       = "low_fee_payer_balance"
+	EventTypeTopUpFeePayer            = "top_up_fee_payer"
+	EventTypeTopUpFeePayerFail        = "top_up_fee_payer_fail"
 
 	AttributeKeyParams           = "params"
 	AttributeKeyTunnelID         = "tunnel_id"
 	AttributeKeySequence         = "sequence"
 	AttributeKeyInterval         = "interval"
+	AttributeKeySchedule         = "schedule"
 	AttributeKeyRoute            = "route"
+	AttributeKeyRouteIndex       = "route_index"
 	AttributeKeyEncoder          = "encoder"
 	AttributeKeyInitialDeposit   = "initial_deposit"
 	AttributeKeyFeePayer         = "fee_payer"
@@ -31,4 +42,9 @@ const (
 	AttributeKeyWithdrawer       = "withdrawer"
 	AttributeKeyAmount           = "amount"
 	AttributeKeyReason           = "reason"
+	AttributeKeyAttempts         = "attempts"
+	AttributeKeyFunder           = "funder"
+	AttributeKeyGrantType        = "grant_type"
+	AttributeKeyBalance          = "balance"
+	AttributeKeyThreshold        = "threshold"
 )
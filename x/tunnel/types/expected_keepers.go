@@ -44,6 +44,16 @@ type BankKeeper interface {
 	MintCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error
 }
 
+// FeegrantKeeper defines the expected feegrant keeper
+type FeegrantKeeper interface {
+	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+}
+
+// AuthzKeeper defines the expected authz keeper
+type AuthzKeeper interface {
+	DispatchActions(ctx context.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error)
+}
+
 type ICS4Wrapper interface {
 	SendPacket(
 		ctx sdk.Context,
@@ -90,6 +100,8 @@ type BandtssKeeper interface {
 		content tsstypes.Content,
 		sender sdk.AccAddress,
 		feeLimit sdk.Coins,
+		groupName string,
+		batch bool,
 	) (bandtsstypes.SigningID, error)
-	GetSigningFee(ctx sdk.Context) (sdk.Coins, error)
+	GetSigningFee(ctx sdk.Context, groupName string) (sdk.Coins, error)
 }
@@ -71,6 +71,21 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	// validate the top-up configs
+	topUpConfigs := make(map[uint64]bool)
+	for _, c := range data.TopUpConfigs {
+		if _, ok := tunnelIDs[c.TunnelID]; !ok {
+			return ErrInvalidGenesis.Wrapf("top-up config has non-existent tunnel id: %d", c.TunnelID)
+		}
+		if _, ok := topUpConfigs[c.TunnelID]; ok {
+			return ErrInvalidGenesis.Wrapf("duplicate top-up config for tunnel %d", c.TunnelID)
+		}
+		if err := c.Validate(); err != nil {
+			return ErrInvalidGenesis.Wrapf("invalid top-up config for tunnel %d: %s", c.TunnelID, err)
+		}
+		topUpConfigs[c.TunnelID] = true
+	}
+
 	// validate the total fees
 	if err := data.TotalFees.Validate(); err != nil {
 		return ErrInvalidGenesis.Wrapf("invalid total fees: %s", err.Error())
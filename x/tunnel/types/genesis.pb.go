@@ -35,6 +35,8 @@ type GenesisState struct {
 	Deposits []Deposit `protobuf:"bytes,4,rep,name=deposits,proto3" json:"deposits"`
 	// total_fees is the type for the total fees collected by the tunnel
 	TotalFees TotalFees `protobuf:"bytes,5,opt,name=total_fees,json=totalFees,proto3" json:"total_fees"`
+	// top_up_configs is the list of top-up configs of the tunnels.
+	TopUpConfigs []TopUpConfig `protobuf:"bytes,6,rep,name=top_up_configs,json=topUpConfigs,proto3" json:"top_up_configs"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -105,6 +107,13 @@ func (m *GenesisState) GetTotalFees() TotalFees {
 	return TotalFees{}
 }
 
+func (m *GenesisState) GetTopUpConfigs() []TopUpConfig {
+	if m != nil {
+		return m.TopUpConfigs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "band.tunnel.v1beta1.GenesisState")
 }
@@ -112,27 +121,29 @@ func init() {
 func init() { proto.RegisterFile("band/tunnel/v1beta1/genesis.proto", fileDescriptor_e920a50d5f95d889) }
 
 var fileDescriptor_e920a50d5f95d889 = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0xe3, 0xb6, 0x14, 0x70, 0x3b, 0x19, 0x86, 0xa8, 0x20, 0x93, 0x32, 0x75, 0xb2, 0x55,
-	0x3a, 0x21, 0x24, 0x06, 0x8a, 0x40, 0x6c, 0xa8, 0x30, 0xb1, 0x54, 0x4e, 0x6a, 0xd2, 0x48, 0xad,
-	0x1d, 0xd5, 0xd7, 0x0a, 0xde, 0x82, 0x27, 0xe1, 0x39, 0x3a, 0x76, 0x64, 0x42, 0xa8, 0x79, 0x11,
-	0x14, 0x3b, 0xe9, 0x14, 0xd8, 0x72, 0x97, 0xef, 0xff, 0x7c, 0x67, 0xe3, 0x6e, 0x28, 0xd4, 0x84,
-	0xc3, 0x52, 0x29, 0x39, 0xe3, 0xab, 0x7e, 0x28, 0x41, 0xf4, 0x79, 0x2c, 0x95, 0x34, 0x89, 0x61,
-	0xe9, 0x42, 0x83, 0x26, 0x47, 0x39, 0xc2, 0x1c, 0xc2, 0x0a, 0xa4, 0x73, 0x1c, 0xeb, 0x58, 0xdb,
-	0xff, 0x3c, 0xff, 0x72, 0x68, 0x27, 0xa8, 0xb2, 0xa5, 0x62, 0x21, 0xe6, 0xe6, 0x3f, 0xa2, 0x70,
-	0x5b, 0xe2, 0xfc, 0xb3, 0x86, 0xdb, 0xf7, 0x6e, 0x80, 0x27, 0x10, 0x20, 0xc9, 0x25, 0x6e, 0x3a,
-	0x85, 0x8f, 0x02, 0xd4, 0x6b, 0x5d, 0x9c, 0xb0, 0x8a, 0x81, 0xd8, 0xa3, 0x45, 0x6e, 0x1a, 0xeb,
-	0xef, 0x33, 0x6f, 0x54, 0x04, 0x48, 0x17, 0xb7, 0x1d, 0x36, 0x8e, 0xf4, 0x52, 0x81, 0x5f, 0x0b,
-	0x50, 0xaf, 0x31, 0x6a, 0xb9, 0xde, 0x30, 0x6f, 0x91, 0x2b, 0xbc, 0xef, 0x4a, 0xe3, 0xd7, 0x83,
-	0xfa, 0x9f, 0xfa, 0x67, 0x5b, 0x16, 0xfa, 0x32, 0x41, 0xae, 0xf1, 0xc1, 0x44, 0xa6, 0xda, 0x24,
-	0x60, 0xfc, 0x86, 0x4d, 0x9f, 0x56, 0xa6, 0x6f, 0x1d, 0x54, 0xc4, 0x77, 0x19, 0x32, 0xc4, 0x18,
-	0x34, 0x88, 0xd9, 0xf8, 0x55, 0x4a, 0xe3, 0xef, 0xd9, 0xf5, 0x68, 0xf5, 0xf9, 0x39, 0x76, 0x27,
-	0x65, 0xb9, 0xe1, 0x21, 0xec, 0x1a, 0x0f, 0xeb, 0x2d, 0x45, 0x9b, 0x2d, 0x45, 0x3f, 0x5b, 0x8a,
-	0x3e, 0x32, 0xea, 0x6d, 0x32, 0xea, 0x7d, 0x65, 0xd4, 0x7b, 0xe1, 0x71, 0x02, 0xd3, 0x65, 0xc8,
-	0x22, 0x3d, 0xe7, 0xb9, 0xd4, 0x5e, 0x70, 0xa4, 0x67, 0x3c, 0x9a, 0x8a, 0x44, 0xf1, 0xd5, 0x80,
-	0xbf, 0x95, 0x4f, 0x01, 0xef, 0xa9, 0x34, 0x61, 0xd3, 0x12, 0x83, 0xdf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x47, 0x82, 0xdd, 0x45, 0x16, 0x02, 0x00, 0x00,
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x31, 0x4f, 0xc2, 0x40,
+	0x18, 0x86, 0x5b, 0x40, 0xd4, 0x83, 0x38, 0x54, 0x87, 0x06, 0xcd, 0x59, 0x9c, 0x98, 0x7a, 0x41,
+	0x26, 0x63, 0xe2, 0x00, 0x46, 0x63, 0xe2, 0x60, 0x50, 0x17, 0x97, 0xe6, 0x5a, 0x8e, 0xd2, 0x04,
+	0xee, 0x2e, 0xdc, 0x57, 0xa2, 0xff, 0xc2, 0x9f, 0xc5, 0xc8, 0xe8, 0x64, 0x0c, 0xfc, 0x0d, 0x07,
+	0xd3, 0xbb, 0x56, 0x97, 0xe2, 0xd6, 0x7b, 0xfb, 0xbc, 0xcf, 0x7d, 0x97, 0x0f, 0xb5, 0x43, 0xca,
+	0x47, 0x04, 0x52, 0xce, 0xd9, 0x94, 0x2c, 0xba, 0x21, 0x03, 0xda, 0x25, 0x31, 0xe3, 0x4c, 0x25,
+	0xca, 0x97, 0x73, 0x01, 0xc2, 0x39, 0xcc, 0x10, 0xdf, 0x20, 0x7e, 0x8e, 0xb4, 0x8e, 0x62, 0x11,
+	0x0b, 0xfd, 0x9f, 0x64, 0x5f, 0x06, 0x6d, 0x79, 0x65, 0x36, 0x49, 0xe7, 0x74, 0xa6, 0xfe, 0x23,
+	0x72, 0xb7, 0x26, 0xce, 0xbe, 0x2b, 0xa8, 0x79, 0x6b, 0x06, 0x78, 0x04, 0x0a, 0xcc, 0xb9, 0x40,
+	0x75, 0xa3, 0x70, 0x6d, 0xcf, 0xee, 0x34, 0xce, 0x8f, 0xfd, 0x92, 0x81, 0xfc, 0x07, 0x8d, 0xf4,
+	0x6b, 0xcb, 0xcf, 0x53, 0x6b, 0x98, 0x17, 0x9c, 0x36, 0x6a, 0x1a, 0x2c, 0x88, 0x44, 0xca, 0xc1,
+	0xad, 0x78, 0x76, 0xa7, 0x36, 0x6c, 0x98, 0x6c, 0x90, 0x45, 0xce, 0x25, 0xda, 0x35, 0x47, 0xe5,
+	0x56, 0xbd, 0xea, 0x56, 0xfd, 0x93, 0x3e, 0xe6, 0xfa, 0xa2, 0xe1, 0x5c, 0xa1, 0xbd, 0x11, 0x93,
+	0x42, 0x25, 0xa0, 0xdc, 0x9a, 0x6e, 0x9f, 0x94, 0xb6, 0xaf, 0x0d, 0x94, 0xd7, 0x7f, 0x3b, 0xce,
+	0x00, 0x21, 0x10, 0x40, 0xa7, 0xc1, 0x98, 0x31, 0xe5, 0xee, 0xe8, 0xe7, 0xe1, 0xf2, 0xfb, 0x33,
+	0xec, 0x86, 0xb1, 0xe2, 0x85, 0xfb, 0x50, 0x04, 0xce, 0x3d, 0x3a, 0x00, 0x21, 0x83, 0x54, 0x06,
+	0x91, 0xe0, 0xe3, 0x24, 0x56, 0x6e, 0x5d, 0x8f, 0xe2, 0x6d, 0x11, 0xc9, 0x67, 0x39, 0xd0, 0x60,
+	0xae, 0x6a, 0xc2, 0x5f, 0xa4, 0xfa, 0x77, 0xcb, 0x35, 0xb6, 0x57, 0x6b, 0x6c, 0x7f, 0xad, 0xb1,
+	0xfd, 0xbe, 0xc1, 0xd6, 0x6a, 0x83, 0xad, 0x8f, 0x0d, 0xb6, 0x5e, 0x48, 0x9c, 0xc0, 0x24, 0x0d,
+	0xfd, 0x48, 0xcc, 0x48, 0x66, 0xd6, 0xeb, 0x8a, 0xc4, 0x94, 0x44, 0x13, 0x9a, 0x70, 0xb2, 0xe8,
+	0x91, 0xd7, 0x62, 0xb1, 0xf0, 0x26, 0x99, 0x0a, 0xeb, 0x9a, 0xe8, 0xfd, 0x0c, 0x00, 0xa9, 0x58,
+	0x5c, 0x1f, 0x64, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -155,6 +166,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TopUpConfigs) > 0 {
+		for iNdEx := len(m.TopUpConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopUpConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size, err := m.TotalFees.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -247,6 +272,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.TotalFees.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TopUpConfigs) > 0 {
+		for _, e := range m.TopUpConfigs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -438,6 +469,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopUpConfigs = append(m.TopUpConfigs, TopUpConfig{})
+			if err := m.TopUpConfigs[len(m.TopUpConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
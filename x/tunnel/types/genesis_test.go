@@ -93,6 +93,34 @@ func TestValidateGenesis(t *testing.T) {
 			expErr:    true,
 			expErrMsg: "duplicate deposit",
 		},
+		"top-up config has non-existent tunnel": {
+			genesisState: types.GenesisState{
+				Params:      types.DefaultParams(),
+				TunnelCount: 1,
+				Tunnels: []types.Tunnel{
+					{ID: 1},
+				},
+				TopUpConfigs: []types.TopUpConfig{
+					{TunnelID: 2, LowBalanceThreshold: sdk.NewCoins(sdk.NewInt64Coin("uband", 100))},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "top-up config has non-existent tunnel id",
+		},
+		"invalid top-up config": {
+			genesisState: types.GenesisState{
+				Params:      types.DefaultParams(),
+				TunnelCount: 1,
+				Tunnels: []types.Tunnel{
+					{ID: 1},
+				},
+				TopUpConfigs: []types.TopUpConfig{
+					{TunnelID: 1},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "invalid top-up config for tunnel 1",
+		},
 		"deposits mismatch total deposit for tunnel": {
 			genesisState: types.GenesisState{
 				Params:      types.DefaultParams(),
@@ -36,6 +36,10 @@ var (
 	PacketStoreKeyPrefix         = []byte{0x12}
 	LatestPricesStoreKeyPrefix   = []byte{0x13}
 	DepositStoreKeyPrefix        = []byte{0x14}
+	PacketRetryStoreKeyPrefix    = []byte{0x15}
+	PacketRetryQueueKeyPrefix    = []byte{0x16}
+	DeadLetterPacketKeyPrefix    = []byte{0x17}
+	TopUpConfigStoreKeyPrefix    = []byte{0x18}
 
 	// params store keys
 	ParamsKey = []byte{0x90}
@@ -75,3 +79,44 @@ func DepositsStoreKey(tunnelID uint64) []byte {
 func DepositStoreKey(tunnelID uint64, depositor sdk.AccAddress) []byte {
 	return append(DepositsStoreKey(tunnelID), address.MustLengthPrefix(depositor)...)
 }
+
+// packetRouteKey returns the key suffix of a route delivery of a packet.
+func packetRouteKey(tunnelID uint64, sequence uint64, routeIndex uint64) []byte {
+	key := append(sdk.Uint64ToBigEndian(tunnelID), sdk.Uint64ToBigEndian(sequence)...)
+	return append(key, sdk.Uint64ToBigEndian(routeIndex)...)
+}
+
+// TunnelPacketRetriesStoreKey returns the key to retrieve all packet retries of a tunnel from the store.
+func TunnelPacketRetriesStoreKey(tunnelID uint64) []byte {
+	return append(PacketRetryStoreKeyPrefix, sdk.Uint64ToBigEndian(tunnelID)...)
+}
+
+// PacketRetryStoreKey returns the key to retrieve a packet retry from the store.
+func PacketRetryStoreKey(tunnelID uint64, sequence uint64, routeIndex uint64) []byte {
+	return append(PacketRetryStoreKeyPrefix, packetRouteKey(tunnelID, sequence, routeIndex)...)
+}
+
+// PacketRetryQueueByHeightPrefix returns the prefix of the packet retry queue for the given height.
+func PacketRetryQueueByHeightPrefix(height int64) []byte {
+	return append(PacketRetryQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// PacketRetryQueueKey returns the key of a packet retry in the packet retry queue.
+func PacketRetryQueueKey(height int64, tunnelID uint64, sequence uint64, routeIndex uint64) []byte {
+	return append(PacketRetryQueueByHeightPrefix(height), packetRouteKey(tunnelID, sequence, routeIndex)...)
+}
+
+// TunnelDeadLetterPacketsStoreKey returns the key to retrieve all dead-lettered packets of a tunnel from the store.
+func TunnelDeadLetterPacketsStoreKey(tunnelID uint64) []byte {
+	return append(DeadLetterPacketKeyPrefix, sdk.Uint64ToBigEndian(tunnelID)...)
+}
+
+// DeadLetterPacketStoreKey returns the key to retrieve a dead-lettered packet from the store.
+func DeadLetterPacketStoreKey(tunnelID uint64, sequence uint64, routeIndex uint64) []byte {
+	return append(DeadLetterPacketKeyPrefix, packetRouteKey(tunnelID, sequence, routeIndex)...)
+}
+
+// TopUpConfigStoreKey returns the key to retrieve the top-up config of a tunnel from the store.
+func TopUpConfigStoreKey(tunnelID uint64) []byte {
+	return append(TopUpConfigStoreKeyPrefix, sdk.Uint64ToBigEndian(tunnelID)...)
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 )
 
 // NewIBCHookMemo creates a new IBCHookMemo instance.
@@ -25,3 +27,27 @@ func NewIBCHookMemo(
 func (r IBCHookMemo) JSONString() string {
 	return string(sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&r)))
 }
+
+// NewIBCHookEncodedMemo creates a new IBCHookEncodedMemo instance.
+func NewIBCHookEncodedMemo(
+	contract string,
+	encoder feedstypes.Encoder,
+	payload string,
+) IBCHookEncodedMemo {
+	return IBCHookEncodedMemo{
+		Wasm: IBCHookEncodedMemo_Payload{
+			Contract: contract,
+			Msg: IBCHookEncodedMemo_Payload_Msg{
+				ReceiveEncodedPacket: IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket{
+					Encoder: encoder,
+					Payload: payload,
+				},
+			},
+		},
+	}
+}
+
+// JSONString returns the JSON string representation of the IBCHookEncodedMemo
+func (r IBCHookEncodedMemo) JSONString() string {
+	return string(sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&r)))
+}
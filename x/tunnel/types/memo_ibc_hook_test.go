@@ -31,3 +31,17 @@ func TestStringIBCHookMemo(t *testing.T) {
 		memoStr,
 	)
 }
+
+func TestStringIBCHookEncodedMemo(t *testing.T) {
+	memo := types.NewIBCHookEncodedMemo(
+		"wasm1vjq0k3fj47s8wns4a7zw5c4lsjd8l6r2kzzlpk",
+		feedstypes.ENCODER_FIXED_POINT_BORSH,
+		"AQAAAAAAAAA=",
+	)
+	memoStr := memo.JSONString()
+	require.Equal(
+		t,
+		`{"wasm":{"contract":"wasm1vjq0k3fj47s8wns4a7zw5c4lsjd8l6r2kzzlpk","msg":{"receive_encoded_packet":{"encoder":"ENCODER_FIXED_POINT_BORSH","payload":"AQAAAAAAAAA="}}}}`,
+		memoStr,
+	)
+}
@@ -11,24 +11,21 @@ import (
 )
 
 var (
-	_, _, _, _, _, _, _, _, _, _ sdk.Msg                       = &MsgCreateTunnel{}, &MsgUpdateRoute{}, &MsgUpdateSignalsAndInterval{}, &MsgWithdrawFeePayerFunds{}, &MsgActivateTunnel{}, &MsgDeactivateTunnel{}, &MsgTriggerTunnel{}, &MsgDepositToTunnel{}, &MsgWithdrawFromTunnel{}, &MsgUpdateParams{}
-	_, _, _, _, _, _, _, _, _    sdk.HasValidateBasic          = &MsgCreateTunnel{}, &MsgUpdateRoute{}, &MsgUpdateSignalsAndInterval{}, &MsgActivateTunnel{}, &MsgDeactivateTunnel{}, &MsgTriggerTunnel{}, &MsgDepositToTunnel{}, &MsgWithdrawFromTunnel{}, &MsgUpdateParams{}
-	_, _                         types.UnpackInterfacesMessage = &MsgCreateTunnel{}, &MsgUpdateRoute{}
+	_, _, _, _, _, _, _, _, _, _, _, _, _ sdk.Msg                       = &MsgCreateTunnel{}, &MsgUpdateRoute{}, &MsgUpdateSignalsAndInterval{}, &MsgWithdrawFeePayerFunds{}, &MsgActivateTunnel{}, &MsgDeactivateTunnel{}, &MsgTriggerTunnel{}, &MsgRetryPacket{}, &MsgSetTopUpConfig{}, &MsgRemoveTopUpConfig{}, &MsgDepositToTunnel{}, &MsgWithdrawFromTunnel{}, &MsgUpdateParams{}
+	_, _, _, _, _, _, _, _, _, _, _, _    sdk.HasValidateBasic          = &MsgCreateTunnel{}, &MsgUpdateRoute{}, &MsgUpdateSignalsAndInterval{}, &MsgActivateTunnel{}, &MsgDeactivateTunnel{}, &MsgTriggerTunnel{}, &MsgRetryPacket{}, &MsgSetTopUpConfig{}, &MsgRemoveTopUpConfig{}, &MsgDepositToTunnel{}, &MsgWithdrawFromTunnel{}, &MsgUpdateParams{}
+	_, _                                  types.UnpackInterfacesMessage = &MsgCreateTunnel{}, &MsgUpdateRoute{}
 )
 
 // NewMsgCreateTunnel creates a new MsgCreateTunnel instance.
 func NewMsgCreateTunnel(
 	signalDeviations []SignalDeviation,
 	interval uint64,
-	route RouteI,
+	schedule string,
+	routes []RouteI,
 	initialDeposit sdk.Coins,
 	creator string,
 ) (*MsgCreateTunnel, error) {
-	msg, ok := route.(proto.Message)
-	if !ok {
-		return nil, sdkerrors.ErrPackAny.Wrapf("cannot proto marshal %T", msg)
-	}
-	any, err := types.NewAnyWithValue(msg)
+	anys, err := PackRoutes(routes)
 	if err != nil {
 		return nil, err
 	}
@@ -36,9 +33,10 @@ func NewMsgCreateTunnel(
 	return &MsgCreateTunnel{
 		SignalDeviations: signalDeviations,
 		Interval:         interval,
-		Route:            any,
+		Routes:           anys,
 		InitialDeposit:   initialDeposit,
 		Creator:          creator,
+		Schedule:         schedule,
 	}, nil
 }
 
@@ -46,56 +44,60 @@ func NewMsgCreateTunnel(
 func NewMsgCreateTSSTunnel(
 	signalDeviations []SignalDeviation,
 	interval uint64,
+	schedule string,
 	destinationChainID string,
 	destinationContractAddress string,
 	encoder feedstypes.Encoder,
+	batch bool,
+	groupName string,
 	initialDeposit sdk.Coins,
 	creator string,
 ) (*MsgCreateTunnel, error) {
-	r := NewTSSRoute(destinationChainID, destinationContractAddress, encoder)
-	return NewMsgCreateTunnel(signalDeviations, interval, &r, initialDeposit, creator)
+	r := NewTSSRoute(destinationChainID, destinationContractAddress, encoder, batch, groupName)
+	return NewMsgCreateTunnel(signalDeviations, interval, schedule, []RouteI{&r}, initialDeposit, creator)
 }
 
 // NewMsgCreateIBCTunnel creates a new MsgCreateTunnel instance with IBC route type.
 func NewMsgCreateIBCTunnel(
 	signalDeviations []SignalDeviation,
 	interval uint64,
+	schedule string,
 	deposit sdk.Coins,
 	creator string,
 ) (*MsgCreateTunnel, error) {
 	r := NewIBCRoute("")
-	return NewMsgCreateTunnel(signalDeviations, interval, r, deposit, creator)
+	return NewMsgCreateTunnel(signalDeviations, interval, schedule, []RouteI{r}, deposit, creator)
 }
 
 // NewMsgCreateIBCHookTunnel creates a new MsgCreateTunnel instance for IBC hook tunnel.
 func NewMsgCreateIBCHookTunnel(
 	signalDeviations []SignalDeviation,
 	interval uint64,
+	schedule string,
 	channelID string,
 	destinationContractAddress string,
+	encoder feedstypes.Encoder,
 	initialDeposit sdk.Coins,
 	creator string,
 ) (*MsgCreateTunnel, error) {
-	r := NewIBCHookRoute(channelID, destinationContractAddress)
-	return NewMsgCreateTunnel(signalDeviations, interval, r, initialDeposit, creator)
+	r := NewIBCHookRoute(channelID, destinationContractAddress, encoder)
+	return NewMsgCreateTunnel(signalDeviations, interval, schedule, []RouteI{r}, initialDeposit, creator)
 }
 
 // NewMsgCreateRouterTunnel creates a new MsgCreateTunnel instance for Router tunnel.
 func NewMsgCreateRouterTunnel(
 	signalDeviations []SignalDeviation,
 	interval uint64,
+	schedule string,
 	destinationChainID string,
 	destinationContractAddress string,
 	destinationGasLimit uint64,
+	encoder feedstypes.Encoder,
 	initialDeposit sdk.Coins,
 	creator string,
 ) (*MsgCreateTunnel, error) {
-	r := &RouterRoute{
-		DestinationChainID:         destinationChainID,
-		DestinationContractAddress: destinationContractAddress,
-		DestinationGasLimit:        destinationGasLimit,
-	}
-	m, err := NewMsgCreateTunnel(signalDeviations, interval, r, initialDeposit, creator)
+	r := NewRouterRoute(destinationChainID, destinationContractAddress, destinationGasLimit, encoder)
+	m, err := NewMsgCreateTunnel(signalDeviations, interval, schedule, []RouteI{r}, initialDeposit, creator)
 	if err != nil {
 		return nil, err
 	}
@@ -107,6 +109,7 @@ func NewMsgCreateRouterTunnel(
 func NewMsgCreateAxelarTunnel(
 	signalDeviations []SignalDeviation,
 	interval uint64,
+	schedule string,
 	destinationChainID string,
 	destinationContractAddress string,
 	fee sdk.Coin,
@@ -114,7 +117,7 @@ func NewMsgCreateAxelarTunnel(
 	creator string,
 ) (*MsgCreateTunnel, error) {
 	r := NewAxelarRoute(destinationChainID, destinationContractAddress, fee)
-	m, err := NewMsgCreateTunnel(signalDeviations, interval, r, initialDeposit, creator)
+	m, err := NewMsgCreateTunnel(signalDeviations, interval, schedule, []RouteI{r}, initialDeposit, creator)
 	if err != nil {
 		return nil, err
 	}
@@ -122,14 +125,32 @@ func NewMsgCreateAxelarTunnel(
 	return m, nil
 }
 
-// GetRouteValue returns the route of the tunnel.
-func (m MsgCreateTunnel) GetRouteValue() (RouteI, error) {
-	r, ok := m.Route.GetCachedValue().(RouteI)
-	if !ok {
-		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (RouteI)(nil), m.Route.GetCachedValue())
+// NewMsgCreateLocalTunnel creates a new MsgCreateTunnel instance for local tunnel.
+func NewMsgCreateLocalTunnel(
+	signalDeviations []SignalDeviation,
+	interval uint64,
+	schedule string,
+	consumer string,
+	initialDeposit sdk.Coins,
+	creator string,
+) (*MsgCreateTunnel, error) {
+	r := NewLocalRoute(consumer)
+	return NewMsgCreateTunnel(signalDeviations, interval, schedule, []RouteI{r}, initialDeposit, creator)
+}
+
+// GetRouteAnys returns the routes of the tunnel, which is the single route if it is set by a client of
+// single route tunnels.
+func (m MsgCreateTunnel) GetRouteAnys() []*types.Any {
+	if m.Route != nil {
+		return []*types.Any{m.Route}
 	}
 
-	return r, nil
+	return m.Routes
+}
+
+// GetRouteValues returns the routes of the tunnel.
+func (m MsgCreateTunnel) GetRouteValues() ([]RouteI, error) {
+	return UnpackRoutes(m.GetRouteAnys())
 }
 
 // ValidateBasic does a sanity check on the provided data
@@ -144,6 +165,13 @@ func (m MsgCreateTunnel) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("interval must be positive")
 	}
 
+	// schedule must be a valid cron expression if set
+	if m.Schedule != "" {
+		if _, err := ParseSchedule(m.Schedule); err != nil {
+			return ErrInvalidSchedule.Wrap(err.Error())
+		}
+	}
+
 	// signal deviations cannot be empty
 	if len(m.SignalDeviations) == 0 {
 		return sdkerrors.ErrInvalidRequest.Wrapf("signal deviations cannot be empty")
@@ -154,16 +182,33 @@ func (m MsgCreateTunnel) ValidateBasic() error {
 		return err
 	}
 
-	// route must be valid
-	r, err := m.GetRouteValue()
-	if err != nil {
+	// derived signals must be valid
+	if err := ValidateDerivations(m.SignalDeviations); err != nil {
 		return err
 	}
 
-	if err := r.ValidateBasic(); err != nil {
+	// route and routes cannot be set together
+	if m.Route != nil && len(m.Routes) > 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("route and routes cannot be set together")
+	}
+
+	// routes cannot be empty
+	if len(m.GetRouteAnys()) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("routes cannot be empty")
+	}
+
+	// routes must be valid
+	routes, err := m.GetRouteValues()
+	if err != nil {
 		return err
 	}
 
+	for _, r := range routes {
+		if err := r.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	// initial deposit must be valid
 	if !m.InitialDeposit.IsValid() {
 		return sdkerrors.ErrInvalidCoins.Wrapf("invalid initial deposit: %s", m.InitialDeposit)
@@ -174,13 +219,13 @@ func (m MsgCreateTunnel) ValidateBasic() error {
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (m MsgCreateTunnel) UnpackInterfaces(unpacker types.AnyUnpacker) error {
-	var route RouteI
-	return unpacker.UnpackAny(m.Route, &route)
+	return unpackRouteInterfaces(unpacker, m.GetRouteAnys())
 }
 
 // NewMsgUpdateRoute creates a new MsgUpdateRoute instance.
 func NewMsgUpdateRoute(
 	tunnelID uint64,
+	routeIndex uint64,
 	route RouteI,
 	creator string,
 ) (*MsgUpdateRoute, error) {
@@ -194,42 +239,64 @@ func NewMsgUpdateRoute(
 	}
 
 	return &MsgUpdateRoute{
-		TunnelID: tunnelID,
-		Route:    any,
-		Creator:  creator,
+		TunnelID:   tunnelID,
+		Route:      any,
+		Creator:    creator,
+		RouteIndex: routeIndex,
 	}, nil
 }
 
 // NewMsgUpdateIBCRoute creates a new MsgUpdateRoute instance.
 func NewMsgUpdateIBCRoute(
 	tunnelID uint64,
+	routeIndex uint64,
 	channelID string,
 	creator string,
 ) (*MsgUpdateRoute, error) {
-	return NewMsgUpdateRoute(tunnelID, NewIBCRoute(channelID), creator)
+	return NewMsgUpdateRoute(tunnelID, routeIndex, NewIBCRoute(channelID), creator)
 }
 
 // NewMsgUpdateIBCHookRoute creates a new MsgUpdateRoute instance.
 func NewMsgUpdateIBCHookRoute(
 	tunnelID uint64,
+	routeIndex uint64,
 	channelID string,
 	destinationContractAddress string,
+	encoder feedstypes.Encoder,
+	creator string,
+) (*MsgUpdateRoute, error) {
+	return NewMsgUpdateRoute(
+		tunnelID,
+		routeIndex,
+		NewIBCHookRoute(channelID, destinationContractAddress, encoder),
+		creator,
+	)
+}
+
+// NewMsgUpdateLocalRoute creates a new MsgUpdateRoute instance.
+func NewMsgUpdateLocalRoute(
+	tunnelID uint64,
+	routeIndex uint64,
+	consumer string,
 	creator string,
 ) (*MsgUpdateRoute, error) {
-	return NewMsgUpdateRoute(tunnelID, NewIBCHookRoute(channelID, destinationContractAddress), creator)
+	return NewMsgUpdateRoute(tunnelID, routeIndex, NewLocalRoute(consumer), creator)
 }
 
 // NewMsgUpdateRouterRoute creates a new MsgUpdateRoute instance.
 func NewMsgUpdateRouterRoute(
 	tunnelID uint64,
+	routeIndex uint64,
 	destChainID string,
 	destContractAddress string,
 	destGasLimit uint64,
+	encoder feedstypes.Encoder,
 	creator string,
 ) (*MsgUpdateRoute, error) {
 	return NewMsgUpdateRoute(
 		tunnelID,
-		NewRouterRoute(destChainID, destContractAddress, destGasLimit),
+		routeIndex,
+		NewRouterRoute(destChainID, destContractAddress, destGasLimit, encoder),
 		creator,
 	)
 }
@@ -237,6 +304,7 @@ func NewMsgUpdateRouterRoute(
 // NewMsgUpdateAxelarRoute creates a new MsgUpdateRoute instance.
 func NewMsgUpdateAxelarRoute(
 	tunnelID uint64,
+	routeIndex uint64,
 	destinationChainID string,
 	destinationContractAddress string,
 	fee sdk.Coin,
@@ -244,6 +312,7 @@ func NewMsgUpdateAxelarRoute(
 ) (*MsgUpdateRoute, error) {
 	return NewMsgUpdateRoute(
 		tunnelID,
+		routeIndex,
 		NewAxelarRoute(destinationChainID, destinationContractAddress, fee),
 		creator,
 	)
@@ -289,6 +358,7 @@ func NewMsgUpdateSignalsAndInterval(
 	tunnelID uint64,
 	signalDeviations []SignalDeviation,
 	interval uint64,
+	schedule string,
 	creator string,
 ) *MsgUpdateSignalsAndInterval {
 	return &MsgUpdateSignalsAndInterval{
@@ -296,6 +366,7 @@ func NewMsgUpdateSignalsAndInterval(
 		SignalDeviations: signalDeviations,
 		Interval:         interval,
 		Creator:          creator,
+		Schedule:         schedule,
 	}
 }
 
@@ -311,6 +382,13 @@ func (m MsgUpdateSignalsAndInterval) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("interval must be positive")
 	}
 
+	// schedule must be a valid cron expression if set
+	if m.Schedule != "" {
+		if _, err := ParseSchedule(m.Schedule); err != nil {
+			return ErrInvalidSchedule.Wrap(err.Error())
+		}
+	}
+
 	// signal deviations cannot be empty
 	if len(m.SignalDeviations) == 0 {
 		return sdkerrors.ErrInvalidRequest.Wrapf("signal deviations cannot be empty")
@@ -320,6 +398,11 @@ func (m MsgUpdateSignalsAndInterval) ValidateBasic() error {
 		return err
 	}
 
+	// derived signals must be valid
+	if err := ValidateDerivations(m.SignalDeviations); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -407,6 +490,83 @@ func (m MsgTriggerTunnel) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgRetryPacket creates a new MsgRetryPacket instance.
+func NewMsgRetryPacket(
+	tunnelID uint64,
+	sequence uint64,
+	routeIndex uint64,
+	creator string,
+) *MsgRetryPacket {
+	return &MsgRetryPacket{
+		TunnelID:   tunnelID,
+		Sequence:   sequence,
+		RouteIndex: routeIndex,
+		Creator:    creator,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgRetryPacket) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgSetTopUpConfig creates a new MsgSetTopUpConfig instance.
+func NewMsgSetTopUpConfig(
+	tunnelID uint64,
+	lowBalanceThreshold sdk.Coins,
+	funder string,
+	grantType FunderGrantType,
+	topUpAmount sdk.Coins,
+	creator string,
+) *MsgSetTopUpConfig {
+	return &MsgSetTopUpConfig{
+		TunnelID:            tunnelID,
+		LowBalanceThreshold: lowBalanceThreshold,
+		Funder:              funder,
+		GrantType:           grantType,
+		TopUpAmount:         topUpAmount,
+		Creator:             creator,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgSetTopUpConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	config := NewTopUpConfig(m.TunnelID, m.LowBalanceThreshold, m.Funder, m.GrantType, m.TopUpAmount)
+	if err := config.Validate(); err != nil {
+		return ErrInvalidTopUpConfig.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgRemoveTopUpConfig creates a new MsgRemoveTopUpConfig instance.
+func NewMsgRemoveTopUpConfig(
+	tunnelID uint64,
+	creator string,
+) *MsgRemoveTopUpConfig {
+	return &MsgRemoveTopUpConfig{
+		TunnelID: tunnelID,
+		Creator:  creator,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgRemoveTopUpConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return nil
+}
+
 // NewMsgDepositToTunnel creates a new MsgDepositToTunnel instance.
 func NewMsgDepositToTunnel(
 	tunnelID uint64,
@@ -1,10 +1,12 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
@@ -28,11 +30,12 @@ func TestMsgCreateTunnel_ValidateBasic(t *testing.T) {
 	}
 	initialDeposit := sdk.NewCoins(sdk.NewInt64Coin("uband", 100))
 
-	route := types.NewTSSRoute("chain-1", "contract-1", feedstypes.ENCODER_FIXED_POINT_ABI)
+	route := types.NewTSSRoute("chain-1", "contract-1", feedstypes.ENCODER_FIXED_POINT_ABI, false, "")
 	msg, err := types.NewMsgCreateTunnel(
 		signalDeviations,
 		10,
-		&route,
+		"",
+		[]types.RouteI{&route},
 		initialDeposit,
 		sdk.AccAddress([]byte("creator1")).String(),
 	)
@@ -42,10 +45,121 @@ func TestMsgCreateTunnel_ValidateBasic(t *testing.T) {
 	err = msg.ValidateBasic()
 	require.NoError(t, err)
 
+	// Valid schedule
+	msg.Schedule = "0 16 * * 1-5"
+	err = msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid schedule
+	msg.Schedule = "0 25 * * *"
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidSchedule)
+	msg.Schedule = ""
+
+	// Valid derived signal
+	msg.SignalDeviations = append(signalDeviations, types.SignalDeviation{
+		SignalID:         "DS:signal1-inverse",
+		SoftDeviationBPS: 5000,
+		HardDeviationBPS: 1000,
+		Derivation:       types.NewDerivation(types.DERIVATION_METHOD_INVERSE, []string{"signal1"}, 0),
+	})
+	err = msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid derived signal
+	msg.SignalDeviations[1].Derivation = types.NewDerivation(types.DERIVATION_METHOD_CROSS, []string{"signal1"}, 0)
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidDerivation)
+
+	// Derived signal as a source
+	msg.SignalDeviations[1].Derivation = types.NewDerivation(
+		types.DERIVATION_METHOD_INVERSE,
+		[]string{"DS:signal1-inverse"},
+		0,
+	)
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidDerivation)
+
+	// Derived signal without the reserved prefix
+	msg.SignalDeviations[1].SignalID = "signal1-inverse"
+	msg.SignalDeviations[1].Derivation = types.NewDerivation(types.DERIVATION_METHOD_INVERSE, []string{"signal1"}, 0)
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidDerivation)
+
+	// Derived signal with only the reserved prefix
+	msg.SignalDeviations[1].SignalID = "DS:"
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidDerivation)
+
+	// Derived signal ID that fits in bytes32
+	msg.SignalDeviations[1].SignalID = "DS:" + strings.Repeat("a", 29)
+	err = msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Derived signal ID that does not fit in bytes32
+	msg.SignalDeviations[1].SignalID = "DS:" + strings.Repeat("a", 30)
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidDerivation)
+
+	// Feed signal with the reserved prefix
+	msg.SignalDeviations[1].SignalID = "DS:signal1-inverse"
+	msg.SignalDeviations[1].Derivation = nil
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidDerivation)
+	msg.SignalDeviations = signalDeviations
+
 	// Invalid creator
 	msg.Creator = "invalidCreator"
 	err = msg.ValidateBasic()
 	require.Error(t, err)
+
+	// Empty routes
+	msg, err = types.NewMsgCreateTunnel(
+		signalDeviations,
+		10,
+		"",
+		[]types.RouteI{},
+		initialDeposit,
+		sdk.AccAddress([]byte("creator1")).String(),
+	)
+	require.NoError(t, err)
+	err = msg.ValidateBasic()
+	require.ErrorContains(t, err, "routes cannot be empty")
+
+	// Multiple routes with an invalid route
+	msg, err = types.NewMsgCreateTunnel(
+		signalDeviations,
+		10,
+		"",
+		[]types.RouteI{&route, types.NewIBCRoute("invalid channel")},
+		initialDeposit,
+		sdk.AccAddress([]byte("creator1")).String(),
+	)
+	require.NoError(t, err)
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+
+	// Single route set by a client of single route tunnels
+	msg, err = types.NewMsgCreateTunnel(
+		signalDeviations,
+		10,
+		"",
+		[]types.RouteI{&route},
+		initialDeposit,
+		sdk.AccAddress([]byte("creator1")).String(),
+	)
+	require.NoError(t, err)
+	msg.Route, msg.Routes = msg.Routes[0], nil
+	err = msg.ValidateBasic()
+	require.NoError(t, err)
+	routes, err := msg.GetRouteValues()
+	require.NoError(t, err)
+	require.Equal(t, []types.RouteI{&route}, routes)
+
+	// Route and routes cannot be set together
+	msg.Routes = []*codectypes.Any{msg.Route}
+	err = msg.ValidateBasic()
+	require.ErrorContains(t, err, "route and routes cannot be set together")
 }
 
 // ====================================
@@ -55,14 +169,14 @@ func TestMsgCreateTunnel_ValidateBasic(t *testing.T) {
 func TestMsgUpdateRoute_ValidateBasic(t *testing.T) {
 	// Valid case - empty channel
 	route := types.NewIBCRoute("")
-	msg, err := types.NewMsgUpdateRoute(1, route, validCreator.String())
+	msg, err := types.NewMsgUpdateRoute(1, 0, route, validCreator.String())
 	require.NoError(t, err)
 	err = msg.ValidateBasic()
 	require.NoError(t, err)
 
 	// Valid case - correct channel format
 	route = types.NewIBCRoute("channel-0")
-	msg, err = types.NewMsgUpdateRoute(1, route, validCreator.String())
+	msg, err = types.NewMsgUpdateRoute(1, 0, route, validCreator.String())
 	require.NoError(t, err)
 	err = msg.ValidateBasic()
 	require.NoError(t, err)
@@ -74,7 +188,7 @@ func TestMsgUpdateRoute_ValidateBasic(t *testing.T) {
 
 	// Invalid route
 	invalidRoute := types.NewIBCRoute("invalid channel")
-	msg, err = types.NewMsgUpdateRoute(1, invalidRoute, validCreator.String())
+	msg, err = types.NewMsgUpdateRoute(1, 0, invalidRoute, validCreator.String())
 	require.NoError(t, err)
 	err = msg.ValidateBasic()
 	require.Error(t, err)
@@ -88,7 +202,7 @@ func TestMsgUpdateSignalsAndInterval_ValidateBasic(t *testing.T) {
 	signalDeviations := []types.SignalDeviation{
 		{SignalID: "signal1", SoftDeviationBPS: 5000, HardDeviationBPS: 1000},
 	}
-	msg := types.NewMsgUpdateSignalsAndInterval(1, signalDeviations, 10, validCreator.String())
+	msg := types.NewMsgUpdateSignalsAndInterval(1, signalDeviations, 10, "", validCreator.String())
 
 	// Valid case
 	err := msg.ValidateBasic()
@@ -99,8 +213,14 @@ func TestMsgUpdateSignalsAndInterval_ValidateBasic(t *testing.T) {
 	err = msg.ValidateBasic()
 	require.Error(t, err)
 
-	// Empty signal deviations
+	// Invalid schedule
 	msg.Creator = validCreator.String()
+	msg.Schedule = "every day"
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidSchedule)
+
+	// Empty signal deviations
+	msg.Schedule = ""
 	msg.SignalDeviations = []types.SignalDeviation{}
 	err = msg.ValidateBasic()
 	require.Error(t, err)
@@ -157,6 +277,83 @@ func TestMsgTriggerTunnel_ValidateBasic(t *testing.T) {
 	require.Error(t, err)
 }
 
+// ====================================
+// MsgRetryPacket
+// ====================================
+
+func TestMsgRetryPacket_ValidateBasic(t *testing.T) {
+	msg := types.NewMsgRetryPacket(1, 1, 0, validCreator.String())
+
+	// Valid case
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid creator
+	msg.Creator = "invalidCreator"
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+}
+
+// ====================================
+// MsgSetTopUpConfig
+// ====================================
+
+func TestMsgSetTopUpConfig_ValidateBasic(t *testing.T) {
+	threshold := sdk.NewCoins(sdk.NewInt64Coin("uband", 1000))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uband", 5000))
+	msg := types.NewMsgSetTopUpConfig(
+		1,
+		threshold,
+		validDepositor.String(),
+		types.FUNDER_GRANT_TYPE_FEEGRANT,
+		amount,
+		validCreator.String(),
+	)
+
+	// Valid case
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Valid case without a funder
+	err = types.NewMsgSetTopUpConfig(
+		1,
+		threshold,
+		"",
+		types.FUNDER_GRANT_TYPE_UNSPECIFIED,
+		nil,
+		validCreator.String(),
+	).ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid grant type
+	msg.GrantType = types.FUNDER_GRANT_TYPE_UNSPECIFIED
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidTopUpConfig)
+
+	// Invalid creator
+	msg.GrantType = types.FUNDER_GRANT_TYPE_AUTHZ
+	msg.Creator = "invalidCreator"
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+}
+
+// ====================================
+// MsgRemoveTopUpConfig
+// ====================================
+
+func TestMsgRemoveTopUpConfig_ValidateBasic(t *testing.T) {
+	msg := types.NewMsgRemoveTopUpConfig(1, validCreator.String())
+
+	// Valid case
+	err := msg.ValidateBasic()
+	require.NoError(t, err)
+
+	// Invalid creator
+	msg.Creator = "invalidCreator"
+	err = msg.ValidateBasic()
+	require.Error(t, err)
+}
+
 // ====================================
 // MsgWithdrawFromTunnel
 // ====================================
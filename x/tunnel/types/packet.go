@@ -23,19 +23,30 @@ func NewPacket(
 		TunnelID:  tunnelID,
 		Sequence:  sequence,
 		Prices:    prices,
-		Receipt:   nil,
+		Receipts:  nil,
 		CreatedAt: createdAt,
 	}
 }
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Packet) UnpackInterfaces(unpacker types.AnyUnpacker) error {
-	var receipt PacketReceiptI
-	return unpacker.UnpackAny(p.Receipt, &receipt)
+	anys := p.Receipts
+	if p.Receipt != nil {
+		anys = append([]*types.Any{p.Receipt}, anys...)
+	}
+
+	for _, any := range anys {
+		var receipt PacketReceiptI
+		if err := unpacker.UnpackAny(any, &receipt); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-// SetReceipt sets the packet's receipt.
-func (p *Packet) SetReceipt(receipt PacketReceiptI) error {
+// AddReceipt appends the receipt of the next route to the packet's receipts.
+func (p *Packet) AddReceipt(receipt PacketReceiptI) error {
 	msg, ok := receipt.(proto.Message)
 	if !ok {
 		return fmt.Errorf("can't proto marshal %T", msg)
@@ -45,21 +56,70 @@ func (p *Packet) SetReceipt(receipt PacketReceiptI) error {
 	if err != nil {
 		return err
 	}
-	p.Receipt = any
+	p.Receipts = append(p.Receipts, any)
+	p.Receipt = p.Receipts[0]
 
 	return nil
 }
 
-// GetReceiptValue returns the packet's receipt.
-func (p Packet) GetReceiptValue() (PacketReceiptI, error) {
-	r, ok := p.Receipt.GetCachedValue().(PacketReceiptI)
+// SetReceipt replaces the receipt of the route at the given index.
+func (p *Packet) SetReceipt(routeIndex uint64, receipt PacketReceiptI) error {
+	if routeIndex >= uint64(len(p.Receipts)) {
+		return fmt.Errorf("route index %d out of range", routeIndex)
+	}
+
+	msg, ok := receipt.(proto.Message)
 	if !ok {
-		return nil, sdkerrors.ErrInvalidType.Wrapf(
-			"expected %T, got %T",
-			(PacketReceiptI)(nil),
-			p.Receipt.GetCachedValue(),
-		)
+		return fmt.Errorf("can't proto marshal %T", msg)
+	}
+
+	any, err := types.NewAnyWithValue(receipt)
+	if err != nil {
+		return err
+	}
+	p.Receipts[routeIndex] = any
+	p.Receipt = p.Receipts[0]
+
+	return nil
+}
+
+// getReceiptAnys returns the receipts of the packet. A packet that is created before a tunnel can have
+// multiple routes only has the receipt of its single route.
+func (p Packet) getReceiptAnys() []*types.Any {
+	if len(p.Receipts) == 0 && p.Receipt != nil {
+		return []*types.Any{p.Receipt}
+	}
+
+	return p.Receipts
+}
+
+// GetReceiptValues returns the packet's receipts.
+func (p Packet) GetReceiptValues() ([]PacketReceiptI, error) {
+	anys := p.getReceiptAnys()
+	receipts := make([]PacketReceiptI, 0, len(anys))
+	for _, any := range anys {
+		r, ok := any.GetCachedValue().(PacketReceiptI)
+		if !ok {
+			return nil, sdkerrors.ErrInvalidType.Wrapf(
+				"expected %T, got %T",
+				(PacketReceiptI)(nil),
+				any.GetCachedValue(),
+			)
+		}
+		receipts = append(receipts, r)
+	}
+
+	return receipts, nil
+}
+
+// GetFailedRouteIndices returns the indices of the routes that failed to deliver the packet.
+func (p Packet) GetFailedRouteIndices() []uint64 {
+	indices := []uint64{}
+	for i, any := range p.getReceiptAnys() {
+		if any.TypeUrl == "/"+proto.MessageName(&FailedPacketReceipt{}) {
+			indices = append(indices, uint64(i))
+		}
 	}
 
-	return r, nil
+	return indices
 }
@@ -6,3 +6,12 @@ import "github.com/cosmos/gogoproto/proto"
 type PacketReceiptI interface {
 	proto.Message
 }
+
+var _ PacketReceiptI = &FailedPacketReceipt{}
+
+// NewFailedPacketReceipt creates a new FailedPacketReceipt instance.
+func NewFailedPacketReceipt(reason string) *FailedPacketReceipt {
+	return &FailedPacketReceipt{
+		Reason: reason,
+	}
+}
@@ -0,0 +1,57 @@
+package types
+
+// MaxPacketRetryAttemptsLimit is the upper bound of the max packet retry attempts parameter
+// so that the exponential backoff cannot overflow.
+const MaxPacketRetryAttemptsLimit = uint64(32)
+
+// NewPacketRetry creates a new PacketRetry instance.
+func NewPacketRetry(
+	tunnelID uint64,
+	sequence uint64,
+	routeIndex uint64,
+	attempts uint64,
+	nextRetryHeight int64,
+	lastError string,
+	routeHash []byte,
+) PacketRetry {
+	return PacketRetry{
+		TunnelID:        tunnelID,
+		Sequence:        sequence,
+		RouteIndex:      routeIndex,
+		Attempts:        attempts,
+		NextRetryHeight: nextRetryHeight,
+		LastError:       lastError,
+		RouteHash:       routeHash,
+	}
+}
+
+// NewDeadLetterPacket creates a new DeadLetterPacket instance.
+func NewDeadLetterPacket(
+	tunnelID uint64,
+	sequence uint64,
+	routeIndex uint64,
+	attempts uint64,
+	lastError string,
+	createdAt int64,
+	routeHash []byte,
+) DeadLetterPacket {
+	return DeadLetterPacket{
+		TunnelID:   tunnelID,
+		Sequence:   sequence,
+		RouteIndex: routeIndex,
+		Attempts:   attempts,
+		LastError:  lastError,
+		CreatedAt:  createdAt,
+		RouteHash:  routeHash,
+	}
+}
+
+// NextPacketRetryHeight returns the height of the next retry after the given number of attempts,
+// doubling the base backoff on every attempt.
+func NextPacketRetryHeight(height int64, baseBlocks uint64, attempts uint64) int64 {
+	if attempts > MaxPacketRetryAttemptsLimit {
+		attempts = MaxPacketRetryAttemptsLimit
+	}
+
+	return height + int64(baseBlocks<<attempts)
+}
@@ -0,0 +1,22 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func TestNextPacketRetryHeight(t *testing.T) {
+	require.Equal(t, int64(110), types.NextPacketRetryHeight(100, 10, 0))
+	require.Equal(t, int64(120), types.NextPacketRetryHeight(100, 10, 1))
+	require.Equal(t, int64(180), types.NextPacketRetryHeight(100, 10, 3))
+
+	// the backoff is capped at the max packet retry attempts limit
+	require.Equal(
+		t,
+		types.NextPacketRetryHeight(100, 1, types.MaxPacketRetryAttemptsLimit),
+		types.NextPacketRetryHeight(100, 1, types.MaxPacketRetryAttemptsLimit+10),
+	)
+}
@@ -10,23 +10,64 @@ import (
 	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
-func TestGetSetPacketReceipt(t *testing.T) {
+func TestGetAddPacketReceipts(t *testing.T) {
 	packet := types.NewPacket(1, 1, nil, 0)
 	receipt := &types.TSSPacketReceipt{SigningID: 1}
+	failedReceipt := types.NewFailedPacketReceipt("route failed")
 
-	err := packet.SetReceipt(receipt)
+	err := packet.AddReceipt(receipt)
 	require.NoError(t, err)
 
-	receiptValue, err := packet.GetReceiptValue()
+	err = packet.AddReceipt(failedReceipt)
 	require.NoError(t, err)
-	require.Equal(t, receipt, receiptValue)
+
+	receiptValues, err := packet.GetReceiptValues()
+	require.NoError(t, err)
+	require.Equal(t, []types.PacketReceiptI{receipt, failedReceipt}, receiptValues)
+	require.Equal(t, []uint64{1}, packet.GetFailedRouteIndices())
+
+	// the receipt of the first route is kept for clients of single route tunnels
+	require.Equal(t, packet.Receipts[0], packet.Receipt)
+}
+
+func TestGetLegacyPacketReceipts(t *testing.T) {
+	packet := types.NewPacket(1, 1, nil, 0)
+	receipt := &types.TSSPacketReceipt{SigningID: 1}
+
+	// a packet that is created before a tunnel can have multiple routes only has a single receipt
+	any, err := codectypes.NewAnyWithValue(receipt)
+	require.NoError(t, err)
+	packet.Receipt = any
+
+	receiptValues, err := packet.GetReceiptValues()
+	require.NoError(t, err)
+	require.Equal(t, []types.PacketReceiptI{receipt}, receiptValues)
+	require.Empty(t, packet.GetFailedRouteIndices())
+}
+
+func TestSetPacketReceipt(t *testing.T) {
+	packet := types.NewPacket(1, 1, nil, 0)
+	err := packet.AddReceipt(types.NewFailedPacketReceipt("route failed"))
+	require.NoError(t, err)
+
+	receipt := &types.TSSPacketReceipt{SigningID: 1}
+	err = packet.SetReceipt(0, receipt)
+	require.NoError(t, err)
+
+	receiptValues, err := packet.GetReceiptValues()
+	require.NoError(t, err)
+	require.Equal(t, []types.PacketReceiptI{receipt}, receiptValues)
+	require.Empty(t, packet.GetFailedRouteIndices())
+
+	err = packet.SetReceipt(1, receipt)
+	require.ErrorContains(t, err, "route index 1 out of range")
 }
 
 func TestPacketUnpackInterfaces(t *testing.T) {
 	packet := types.NewPacket(1, 1, nil, 0)
 	packetResult := &types.TSSPacketReceipt{SigningID: 1}
 
-	err := packet.SetReceipt(packetResult)
+	err := packet.AddReceipt(packetResult)
 	require.NoError(t, err)
 
 	unpacker := codectypes.NewInterfaceRegistry()
@@ -23,6 +23,10 @@ var (
 	DefaultAxelarIBCChannel          = ""
 	DefaultAxelarGMPAccount          = "axelar1dv4u5k73pzqrxlzujxg3qp8kvc3pje7jtdvu72npnt5zhq05ejcsn5qme5"
 	DefaultAxelarFeeRecipient        = ""
+	DefaultLocalRouteGasLimit        = uint64(300_000)
+	DefaultMaxRoutes                 = uint64(5)
+	DefaultMaxPacketRetryAttempts    = uint64(5)
+	DefaultPacketRetryBaseBlocks     = uint64(10)
 )
 
 // NewParams creates a new Params instance
@@ -39,6 +43,10 @@ func NewParams(
 	axelarIBCChannel string,
 	axelarGMPAccount string,
 	axelarFeeRecipient string,
+	localRouteGasLimit uint64,
+	maxRoutes uint64,
+	maxPacketRetryAttempts uint64,
+	packetRetryBaseBlocks uint64,
 ) Params {
 	return Params{
 		MinDeposit:                minDeposit,
@@ -53,6 +61,10 @@ func NewParams(
 		AxelarIBCChannel:          axelarIBCChannel,
 		AxelarGMPAccount:          axelarGMPAccount,
 		AxelarFeeRecipient:        axelarFeeRecipient,
+		LocalRouteGasLimit:        localRouteGasLimit,
+		MaxRoutes:                 maxRoutes,
+		MaxPacketRetryAttempts:    maxPacketRetryAttempts,
+		PacketRetryBaseBlocks:     packetRetryBaseBlocks,
 	}
 }
 
@@ -71,6 +83,10 @@ func DefaultParams() Params {
 		DefaultAxelarIBCChannel,
 		DefaultAxelarGMPAccount,
 		DefaultAxelarFeeRecipient,
+		DefaultLocalRouteGasLimit,
+		DefaultMaxRoutes,
+		DefaultMaxPacketRetryAttempts,
+		DefaultPacketRetryBaseBlocks,
 	)
 }
 
@@ -139,6 +155,35 @@ func (p Params) Validate() error {
 		return fmt.Errorf("channel axelar identifier is not in the format: `channel-{N}` or be empty string")
 	}
 
+	// validate LocalRouteGasLimit
+	if err := validateUint64("local route gas limit", true)(p.LocalRouteGasLimit); err != nil {
+		return err
+	}
+
+	// validate MaxRoutes
+	if err := validateUint64("max routes", true)(p.MaxRoutes); err != nil {
+		return err
+	}
+
+	// validate MaxPacketRetryAttempts
+	if err := validateUint64("max packet retry attempts", false)(p.MaxPacketRetryAttempts); err != nil {
+		return err
+	}
+
+	// validate max packet retry attempts does not overflow the backoff
+	if p.MaxPacketRetryAttempts > MaxPacketRetryAttemptsLimit {
+		return fmt.Errorf(
+			"max packet retry attempts must not exceed %d: %d",
+			MaxPacketRetryAttemptsLimit,
+			p.MaxPacketRetryAttempts,
+		)
+	}
+
+	// validate PacketRetryBaseBlocks
+	if err := validateUint64("packet retry base blocks", true)(p.PacketRetryBaseBlocks); err != nil {
+		return err
+	}
+
 	return nil
 }
 
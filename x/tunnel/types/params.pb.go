@@ -52,6 +52,15 @@ type Params struct {
 	AxelarGMPAccount string `protobuf:"bytes,11,opt,name=axelar_gmp_account,json=axelarGmpAccount,proto3" json:"axelar_gmp_account,omitempty"`
 	// axelar_fee_recipient is the account address on axelar chain that receive fee from tunnel.
 	AxelarFeeRecipient string `protobuf:"bytes,12,opt,name=axelar_fee_recipient,json=axelarFeeRecipient,proto3" json:"axelar_fee_recipient,omitempty"`
+	// local_route_gas_limit is the maximum gas that a consumer module can use to process a packet of a local route.
+	LocalRouteGasLimit uint64 `protobuf:"varint,13,opt,name=local_route_gas_limit,json=localRouteGasLimit,proto3" json:"local_route_gas_limit,omitempty"`
+	// max_routes defines the maximum number of routes allowed per tunnel.
+	MaxRoutes uint64 `protobuf:"varint,14,opt,name=max_routes,json=maxRoutes,proto3" json:"max_routes,omitempty"`
+	// max_packet_retry_attempts is the maximum number of retries of a failed route delivery before it is moved to
+	// the dead-letter store.
+	MaxPacketRetryAttempts uint64 `protobuf:"varint,15,opt,name=max_packet_retry_attempts,json=maxPacketRetryAttempts,proto3" json:"max_packet_retry_attempts,omitempty"`
+	// packet_retry_base_blocks is the number of blocks to wait before the first retry. The wait doubles on each retry.
+	PacketRetryBaseBlocks uint64 `protobuf:"varint,16,opt,name=packet_retry_base_blocks,json=packetRetryBaseBlocks,proto3" json:"packet_retry_base_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -171,6 +180,34 @@ func (m *Params) GetAxelarFeeRecipient() string {
 	return ""
 }
 
+func (m *Params) GetLocalRouteGasLimit() uint64 {
+	if m != nil {
+		return m.LocalRouteGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRoutes() uint64 {
+	if m != nil {
+		return m.MaxRoutes
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPacketRetryAttempts() uint64 {
+	if m != nil {
+		return m.MaxPacketRetryAttempts
+	}
+	return 0
+}
+
+func (m *Params) GetPacketRetryBaseBlocks() uint64 {
+	if m != nil {
+		return m.PacketRetryBaseBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "band.tunnel.v1beta1.Params")
 }
@@ -178,41 +215,47 @@ func init() {
 func init() { proto.RegisterFile("band/tunnel/v1beta1/params.proto", fileDescriptor_842b3bf03f22bf82) }
 
 var fileDescriptor_842b3bf03f22bf82 = []byte{
-	// 535 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x63, 0x1a, 0x02, 0xbd, 0x14, 0xa5, 0xb8, 0x19, 0xdc, 0x22, 0xd9, 0x81, 0x29, 0x0b,
-	0x76, 0x4b, 0x37, 0x06, 0x50, 0x1d, 0xd4, 0x2a, 0x43, 0xa5, 0xc8, 0xdd, 0x58, 0xac, 0xf3, 0xe5,
-	0xea, 0x9c, 0x6a, 0xdf, 0x9d, 0x7c, 0x97, 0xc8, 0x7c, 0x0b, 0x46, 0x46, 0x66, 0x3e, 0x49, 0xc7,
-	0x8e, 0x4c, 0x01, 0x25, 0x0b, 0x1f, 0x03, 0xdd, 0x9f, 0x34, 0x26, 0x33, 0x53, 0xa2, 0xf7, 0xf9,
-	0xf9, 0xf7, 0x3e, 0x39, 0xe7, 0xc0, 0x20, 0x83, 0x74, 0x1a, 0xc9, 0x39, 0xa5, 0xb8, 0x88, 0x16,
-	0x67, 0x19, 0x96, 0xf0, 0x2c, 0xe2, 0xb0, 0x82, 0xa5, 0x08, 0x79, 0xc5, 0x24, 0x73, 0x8f, 0x14,
-	0x11, 0x1a, 0x22, 0xb4, 0xc4, 0x49, 0x3f, 0x67, 0x39, 0xd3, 0x79, 0xa4, 0xbe, 0x19, 0xf4, 0xc4,
-	0x47, 0x4c, 0x94, 0x4c, 0x44, 0x19, 0x14, 0xf8, 0x51, 0x86, 0x18, 0xa1, 0x26, 0x7f, 0xf3, 0xad,
-	0x03, 0x3a, 0x13, 0xed, 0x76, 0x0b, 0xd0, 0x2d, 0x09, 0x4d, 0xa7, 0x98, 0x33, 0x41, 0xa4, 0xe7,
-	0x0c, 0xf6, 0x86, 0xdd, 0x77, 0xc7, 0xa1, 0x11, 0x84, 0x4a, 0xb0, 0xd9, 0x15, 0x8e, 0x18, 0xa1,
-	0xf1, 0xe9, 0xfd, 0x32, 0x68, 0xfd, 0xf8, 0x15, 0x0c, 0x73, 0x22, 0x67, 0xf3, 0x2c, 0x44, 0xac,
-	0x8c, 0xec, 0x36, 0xf3, 0xf1, 0x56, 0x4c, 0xef, 0x22, 0xf9, 0x85, 0x63, 0xa1, 0x1f, 0x10, 0x09,
-	0x28, 0x09, 0xfd, 0x64, 0xf4, 0xee, 0x6b, 0x70, 0xa0, 0xb6, 0x11, 0x2a, 0x71, 0xb5, 0x80, 0x85,
-	0xf7, 0x64, 0xe0, 0x0c, 0xdb, 0x89, 0x6a, 0x30, 0xb6, 0x23, 0x8d, 0xc0, 0x7a, 0x8b, 0xec, 0x59,
-	0x04, 0xd6, 0x8f, 0xc8, 0x47, 0xf0, 0xd2, 0x74, 0x5e, 0x10, 0x28, 0x09, 0xa3, 0x69, 0xc6, 0x85,
-	0xd7, 0x56, 0x5c, 0x7c, 0xb4, 0x5a, 0x06, 0xbd, 0x6b, 0xb5, 0xd0, 0x66, 0xf1, 0xe4, 0x26, 0xe9,
-	0x95, 0xcd, 0x01, 0x17, 0x5a, 0x00, 0xeb, 0x1d, 0xc1, 0xd3, 0x86, 0x00, 0xd6, 0x3b, 0x82, 0xe6,
-	0x80, 0x0b, 0x37, 0x00, 0xaa, 0x50, 0x2a, 0x48, 0x4e, 0x61, 0x21, 0xbc, 0x8e, 0xee, 0x08, 0x4a,
-	0x58, 0xdf, 0x98, 0x89, 0x2b, 0x40, 0x4f, 0x9d, 0x5d, 0xca, 0x21, 0xba, 0xc3, 0x32, 0xbd, 0xc5,
-	0xd8, 0x7b, 0xf6, 0xff, 0x8f, 0xf6, 0x85, 0x92, 0x4c, 0xf4, 0x8a, 0x4b, 0x8c, 0xdd, 0x18, 0xb8,
-	0x15, 0x9b, 0x4b, 0x5c, 0xa5, 0x24, 0x43, 0x29, 0x9a, 0x41, 0xf5, 0x57, 0xf1, 0x9e, 0x0f, 0x9c,
-	0xe1, 0x7e, 0xdc, 0x5f, 0x2d, 0x83, 0xc3, 0x44, 0xa7, 0xe3, 0x78, 0x34, 0x32, 0x59, 0x72, 0x68,
-	0xf8, 0x71, 0x86, 0xec, 0xc4, 0xfd, 0x00, 0x5e, 0x6d, 0x1c, 0x54, 0xe2, 0xbc, 0x32, 0xe7, 0x83,
-	0x18, 0x95, 0x15, 0x44, 0xd2, 0xdb, 0x57, 0xb2, 0xe4, 0xd8, 0x3e, 0xb6, 0x25, 0x46, 0x16, 0x50,
-	0x1d, 0x60, 0x8d, 0x0b, 0xf8, 0x6f, 0x07, 0xb0, 0xed, 0x70, 0xa1, 0xd3, 0x66, 0x07, 0xc3, 0x37,
-	0x3a, 0x6c, 0x1d, 0x79, 0xc9, 0x53, 0x88, 0x10, 0x9b, 0x53, 0xe9, 0x75, 0x77, 0x1d, 0x57, 0xd7,
-	0x93, 0x0b, 0x93, 0x6d, 0x1c, 0x57, 0x25, 0xb7, 0x13, 0xf7, 0x14, 0xf4, 0xad, 0xe3, 0x16, 0xe3,
-	0xb4, 0xc2, 0x88, 0x70, 0x82, 0xa9, 0xf4, 0x0e, 0xf4, 0x0f, 0xb0, 0xfe, 0x4b, 0x8c, 0x93, 0x4d,
-	0xf2, 0xbe, 0xfd, 0xe7, 0x7b, 0xe0, 0xc4, 0xe3, 0xfb, 0x95, 0xef, 0x3c, 0xac, 0x7c, 0xe7, 0xf7,
-	0xca, 0x77, 0xbe, 0xae, 0xfd, 0xd6, 0xc3, 0xda, 0x6f, 0xfd, 0x5c, 0xfb, 0xad, 0xcf, 0x51, 0xe3,
-	0xb5, 0xa8, 0xab, 0xa8, 0xaf, 0x12, 0x62, 0x45, 0x84, 0x66, 0x90, 0xd0, 0x68, 0x71, 0x1e, 0xd5,
-	0x9b, 0xfb, 0xab, 0xdf, 0x51, 0xd6, 0xd1, 0xc4, 0xf9, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc2,
-	0xa8, 0x22, 0x47, 0xdb, 0x03, 0x00, 0x00,
+	// 636 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xc1, 0x4e, 0xdb, 0x4a,
+	0x14, 0x86, 0xe3, 0x0b, 0x17, 0x2e, 0x03, 0xdc, 0x70, 0x07, 0xb8, 0x1a, 0xa8, 0xea, 0xa4, 0x5d,
+	0x65, 0x53, 0x1b, 0xca, 0xa2, 0x6a, 0x17, 0xad, 0x70, 0x2a, 0x50, 0xa4, 0x22, 0x45, 0x66, 0xd7,
+	0x8d, 0x75, 0x3c, 0x0c, 0x66, 0x84, 0x3d, 0x63, 0x79, 0x26, 0x91, 0x79, 0x8b, 0x3e, 0x42, 0xd7,
+	0x7d, 0x12, 0x96, 0x2c, 0xbb, 0x4a, 0xab, 0xb0, 0xe9, 0x63, 0x54, 0x33, 0xe3, 0x90, 0xc0, 0xba,
+	0xab, 0x44, 0xe7, 0xff, 0xcf, 0x77, 0x7e, 0xcf, 0x1c, 0x0d, 0xea, 0xa6, 0x20, 0x2e, 0x42, 0x3d,
+	0x12, 0x82, 0xe5, 0xe1, 0xf8, 0x30, 0x65, 0x1a, 0x0e, 0xc3, 0x12, 0x2a, 0x28, 0x54, 0x50, 0x56,
+	0x52, 0x4b, 0xbc, 0x6d, 0x1c, 0x81, 0x73, 0x04, 0x8d, 0x63, 0x7f, 0x27, 0x93, 0x99, 0xb4, 0x7a,
+	0x68, 0xfe, 0x39, 0xeb, 0xbe, 0x4f, 0xa5, 0x2a, 0xa4, 0x0a, 0x53, 0x50, 0xec, 0x01, 0x46, 0x25,
+	0x17, 0x4e, 0x7f, 0x39, 0x59, 0x45, 0x2b, 0x43, 0xcb, 0xc6, 0x39, 0x5a, 0x2f, 0xb8, 0x48, 0x2e,
+	0x58, 0x29, 0x15, 0xd7, 0xc4, 0xeb, 0x2e, 0xf5, 0xd6, 0x5f, 0xef, 0x05, 0x0e, 0x10, 0x18, 0xc0,
+	0x6c, 0x56, 0xd0, 0x97, 0x5c, 0x44, 0x07, 0xb7, 0x93, 0x4e, 0xeb, 0xdb, 0x8f, 0x4e, 0x2f, 0xe3,
+	0xfa, 0x6a, 0x94, 0x06, 0x54, 0x16, 0x61, 0x33, 0xcd, 0xfd, 0xbc, 0x52, 0x17, 0xd7, 0xa1, 0xbe,
+	0x29, 0x99, 0xb2, 0x0d, 0x2a, 0x46, 0x05, 0x17, 0x1f, 0x1d, 0x1e, 0xbf, 0x40, 0x1b, 0x66, 0x1a,
+	0x17, 0x9a, 0x55, 0x63, 0xc8, 0xc9, 0x5f, 0x5d, 0xaf, 0xb7, 0x1c, 0x9b, 0x04, 0x83, 0xa6, 0x64,
+	0x2d, 0x50, 0xcf, 0x2d, 0x4b, 0x8d, 0x05, 0xea, 0x07, 0xcb, 0x07, 0xf4, 0x9f, 0xcb, 0x3c, 0xe6,
+	0xa0, 0xb9, 0x14, 0x49, 0x5a, 0x2a, 0xb2, 0x6c, 0x7c, 0xd1, 0xf6, 0x74, 0xd2, 0x69, 0x9f, 0x99,
+	0x81, 0x8d, 0x16, 0x0d, 0xcf, 0xe3, 0x76, 0xb1, 0x58, 0x28, 0x95, 0x05, 0x40, 0xfd, 0x04, 0xf0,
+	0xf7, 0x02, 0x00, 0xea, 0x27, 0x80, 0xc5, 0x42, 0xa9, 0x70, 0x07, 0x99, 0x40, 0x89, 0xe2, 0x99,
+	0x80, 0x5c, 0x91, 0x15, 0x9b, 0x11, 0x15, 0x50, 0x9f, 0xbb, 0x0a, 0x56, 0xa8, 0x6d, 0xce, 0x2e,
+	0x29, 0x81, 0x5e, 0x33, 0x9d, 0x5c, 0x32, 0x46, 0x56, 0xff, 0xfc, 0xd1, 0x6e, 0x1a, 0xc8, 0xd0,
+	0x8e, 0x38, 0x61, 0x0c, 0x47, 0x08, 0x57, 0x72, 0xa4, 0x59, 0x95, 0xf0, 0x94, 0x26, 0xf4, 0x0a,
+	0xcc, 0xaa, 0x90, 0x7f, 0xba, 0x5e, 0x6f, 0x2d, 0xda, 0x99, 0x4e, 0x3a, 0x5b, 0xb1, 0x55, 0x07,
+	0x51, 0xbf, 0xef, 0xb4, 0x78, 0xcb, 0xf9, 0x07, 0x29, 0x6d, 0x2a, 0xf8, 0x3d, 0x7a, 0x36, 0x63,
+	0x08, 0xcd, 0xb2, 0xca, 0x9d, 0x0f, 0x95, 0x42, 0x57, 0x40, 0x35, 0x59, 0x33, 0xb0, 0x78, 0xaf,
+	0x69, 0x9b, 0x3b, 0xfa, 0x8d, 0xc1, 0x64, 0x80, 0x9a, 0xe5, 0xf0, 0x38, 0x03, 0x9a, 0x67, 0x38,
+	0xb6, 0xea, 0x62, 0x06, 0xe7, 0x5f, 0xc8, 0x30, 0x67, 0x64, 0x45, 0x99, 0x00, 0xa5, 0x72, 0x24,
+	0x34, 0x59, 0x7f, 0xca, 0x38, 0x3d, 0x1b, 0x1e, 0x3b, 0x6d, 0xc6, 0x38, 0x2d, 0xca, 0xa6, 0x82,
+	0x0f, 0xd0, 0x4e, 0xc3, 0xb8, 0x64, 0x2c, 0xa9, 0x18, 0xe5, 0x25, 0x67, 0x42, 0x93, 0x0d, 0xfb,
+	0x01, 0x0d, 0xff, 0x84, 0xb1, 0x78, 0xa6, 0xe0, 0x43, 0xb4, 0x9b, 0x4b, 0x0a, 0x79, 0x62, 0x3f,
+	0x2e, 0xc9, 0x40, 0x25, 0x39, 0x2f, 0xb8, 0x26, 0x9b, 0xf6, 0x76, 0xb1, 0x15, 0xed, 0x09, 0x9e,
+	0x82, 0xfa, 0x64, 0x14, 0xfc, 0x1c, 0x99, 0x3b, 0x77, 0x0d, 0x8a, 0xfc, 0x6b, 0x7d, 0x6b, 0x05,
+	0xd4, 0xd6, 0xa5, 0xf0, 0x5b, 0xb4, 0x67, 0xe4, 0x66, 0x07, 0x2a, 0xa6, 0xab, 0x9b, 0x04, 0xb4,
+	0x66, 0x45, 0xa9, 0x15, 0x69, 0x5b, 0xf7, 0xff, 0x05, 0xd4, 0xee, 0x02, 0x63, 0x23, 0x1f, 0x37,
+	0x2a, 0x7e, 0x83, 0xc8, 0xa3, 0x36, 0xbb, 0x4c, 0x69, 0x2e, 0xe9, 0xb5, 0x22, 0x5b, 0xb6, 0x73,
+	0xb7, 0x9c, 0xb7, 0x45, 0xa0, 0x58, 0x64, 0xc5, 0x77, 0xcb, 0xbf, 0xbe, 0x76, 0xbc, 0x68, 0x70,
+	0x3b, 0xf5, 0xbd, 0xbb, 0xa9, 0xef, 0xfd, 0x9c, 0xfa, 0xde, 0x97, 0x7b, 0xbf, 0x75, 0x77, 0xef,
+	0xb7, 0xbe, 0xdf, 0xfb, 0xad, 0xcf, 0xe1, 0xc2, 0x72, 0x99, 0x07, 0xc5, 0x3e, 0x08, 0x54, 0xe6,
+	0x21, 0xbd, 0x02, 0x2e, 0xc2, 0xf1, 0x51, 0x58, 0xcf, 0x5e, 0x21, 0xbb, 0x69, 0xe9, 0x8a, 0x75,
+	0x1c, 0xfd, 0x1e, 0x00, 0xa4, 0xde, 0x24, 0x21, 0xa1, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -280,6 +323,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.AxelarFeeRecipient != that1.AxelarFeeRecipient {
 		return false
 	}
+	if this.LocalRouteGasLimit != that1.LocalRouteGasLimit {
+		return false
+	}
+	if this.MaxRoutes != that1.MaxRoutes {
+		return false
+	}
+	if this.MaxPacketRetryAttempts != that1.MaxPacketRetryAttempts {
+		return false
+	}
+	if this.PacketRetryBaseBlocks != that1.PacketRetryBaseBlocks {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -302,6 +357,28 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PacketRetryBaseBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PacketRetryBaseBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxPacketRetryAttempts != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPacketRetryAttempts))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxRoutes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRoutes))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.LocalRouteGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LocalRouteGasLimit))
+		i--
+		dAtA[i] = 0x68
+	}
 	if len(m.AxelarFeeRecipient) > 0 {
 		i -= len(m.AxelarFeeRecipient)
 		copy(dAtA[i:], m.AxelarFeeRecipient)
@@ -457,6 +534,18 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.LocalRouteGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.LocalRouteGasLimit))
+	}
+	if m.MaxRoutes != 0 {
+		n += 1 + sovParams(uint64(m.MaxRoutes))
+	}
+	if m.MaxPacketRetryAttempts != 0 {
+		n += 1 + sovParams(uint64(m.MaxPacketRetryAttempts))
+	}
+	if m.PacketRetryBaseBlocks != 0 {
+		n += 2 + sovParams(uint64(m.PacketRetryBaseBlocks))
+	}
 	return n
 }
 
@@ -818,6 +907,82 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AxelarFeeRecipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalRouteGasLimit", wireType)
+			}
+			m.LocalRouteGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalRouteGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRoutes", wireType)
+			}
+			m.MaxRoutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRoutes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketRetryAttempts", wireType)
+			}
+			m.MaxPacketRetryAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketRetryAttempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketRetryBaseBlocks", wireType)
+			}
+			m.PacketRetryBaseBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketRetryBaseBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
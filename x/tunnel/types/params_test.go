@@ -79,6 +79,42 @@ func TestParams_Validate(t *testing.T) {
 			expErr:    true,
 			expErrMsg: "max signals must be positive",
 		},
+		"invalid LocalRouteGasLimit": {
+			genesisState: func() types.Params {
+				p := types.DefaultParams()
+				p.LocalRouteGasLimit = 0
+				return p
+			}(),
+			expErr:    true,
+			expErrMsg: "local route gas limit must be positive",
+		},
+		"invalid MaxRoutes": {
+			genesisState: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxRoutes = 0
+				return p
+			}(),
+			expErr:    true,
+			expErrMsg: "max routes must be positive",
+		},
+		"invalid MaxPacketRetryAttempts": {
+			genesisState: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxPacketRetryAttempts = types.MaxPacketRetryAttemptsLimit + 1
+				return p
+			}(),
+			expErr:    true,
+			expErrMsg: "max packet retry attempts must not exceed",
+		},
+		"invalid PacketRetryBaseBlocks": {
+			genesisState: func() types.Params {
+				p := types.DefaultParams()
+				p.PacketRetryBaseBlocks = 0
+				return p
+			}(),
+			expErr:    true,
+			expErrMsg: "packet retry base blocks must be positive",
+		},
 		"valid params": {
 			genesisState: types.DefaultParams(),
 			expErr:       false,
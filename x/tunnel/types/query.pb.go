@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -644,6 +646,8 @@ func (m *QueryPacketRequest) GetSequence() uint64 {
 type QueryPacketResponse struct {
 	// packet is the packet with the given tunnel ID and sequence.
 	Packet *Packet `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet,omitempty"`
+	// failed_route_indices is the list of indices of the routes that failed to deliver the packet.
+	FailedRouteIndices []uint64 `protobuf:"varint,2,rep,packed,name=failed_route_indices,json=failedRouteIndices,proto3" json:"failed_route_indices,omitempty"`
 }
 
 func (m *QueryPacketResponse) Reset()         { *m = QueryPacketResponse{} }
@@ -686,22 +690,31 @@ func (m *QueryPacketResponse) GetPacket() *Packet {
 	return nil
 }
 
-// QueryTotalFeesRequest is the request type for the Query/TotalFees RPC method.
-type QueryTotalFeesRequest struct {
+func (m *QueryPacketResponse) GetFailedRouteIndices() []uint64 {
+	if m != nil {
+		return m.FailedRouteIndices
+	}
+	return nil
 }
 
-func (m *QueryTotalFeesRequest) Reset()         { *m = QueryTotalFeesRequest{} }
-func (m *QueryTotalFeesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalFeesRequest) ProtoMessage()    {}
-func (*QueryTotalFeesRequest) Descriptor() ([]byte, []int) {
+// QueryNextTriggerRequest is the request type for the Query/NextTrigger RPC method.
+type QueryNextTriggerRequest struct {
+	// tunnel_id is the ID of the tunnel to query the next trigger.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+}
+
+func (m *QueryNextTriggerRequest) Reset()         { *m = QueryNextTriggerRequest{} }
+func (m *QueryNextTriggerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextTriggerRequest) ProtoMessage()    {}
+func (*QueryNextTriggerRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f80b85392d1440ac, []int{12}
 }
-func (m *QueryTotalFeesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryNextTriggerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryNextTriggerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalFeesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryNextTriggerRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -711,36 +724,43 @@ func (m *QueryTotalFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalFeesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalFeesRequest.Merge(m, src)
+func (m *QueryNextTriggerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextTriggerRequest.Merge(m, src)
 }
-func (m *QueryTotalFeesRequest) XXX_Size() int {
+func (m *QueryNextTriggerRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalFeesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalFeesRequest.DiscardUnknown(m)
+func (m *QueryNextTriggerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextTriggerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalFeesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryNextTriggerRequest proto.InternalMessageInfo
 
-// QueryTotalFeesResponse is the response type for the Query/TotalFees RPC method.
-type QueryTotalFeesResponse struct {
-	// total_fees is the total fees collected by the tunnel.
-	TotalFees TotalFees `protobuf:"bytes,1,opt,name=total_fees,json=totalFees,proto3" json:"total_fees"`
+func (m *QueryNextTriggerRequest) GetTunnelId() uint64 {
+	if m != nil {
+		return m.TunnelId
+	}
+	return 0
 }
 
-func (m *QueryTotalFeesResponse) Reset()         { *m = QueryTotalFeesResponse{} }
-func (m *QueryTotalFeesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalFeesResponse) ProtoMessage()    {}
-func (*QueryTotalFeesResponse) Descriptor() ([]byte, []int) {
+// QueryNextTriggerResponse is the response type for the Query/NextTrigger RPC method.
+type QueryNextTriggerResponse struct {
+	// next_trigger_time is the unix timestamp of the next scheduled trigger of the tunnel.
+	NextTriggerTime int64 `protobuf:"varint,1,opt,name=next_trigger_time,json=nextTriggerTime,proto3" json:"next_trigger_time,omitempty"`
+}
+
+func (m *QueryNextTriggerResponse) Reset()         { *m = QueryNextTriggerResponse{} }
+func (m *QueryNextTriggerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextTriggerResponse) ProtoMessage()    {}
+func (*QueryNextTriggerResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f80b85392d1440ac, []int{13}
 }
-func (m *QueryTotalFeesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryNextTriggerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryNextTriggerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalFeesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryNextTriggerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -750,41 +770,45 @@ func (m *QueryTotalFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalFeesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalFeesResponse.Merge(m, src)
+func (m *QueryNextTriggerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextTriggerResponse.Merge(m, src)
 }
-func (m *QueryTotalFeesResponse) XXX_Size() int {
+func (m *QueryNextTriggerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalFeesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalFeesResponse.DiscardUnknown(m)
+func (m *QueryNextTriggerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextTriggerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalFeesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryNextTriggerResponse proto.InternalMessageInfo
 
-func (m *QueryTotalFeesResponse) GetTotalFees() TotalFees {
+func (m *QueryNextTriggerResponse) GetNextTriggerTime() int64 {
 	if m != nil {
-		return m.TotalFees
+		return m.NextTriggerTime
 	}
-	return TotalFees{}
+	return 0
 }
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+// QueryPacketRetriesRequest is the request type for the Query/PacketRetries RPC method.
+type QueryPacketRetriesRequest struct {
+	// tunnel_id is the ID of the tunnel to query packet retries.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPacketRetriesRequest) Reset()         { *m = QueryPacketRetriesRequest{} }
+func (m *QueryPacketRetriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketRetriesRequest) ProtoMessage()    {}
+func (*QueryPacketRetriesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f80b85392d1440ac, []int{14}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPacketRetriesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPacketRetriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPacketRetriesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -794,36 +818,52 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryPacketRetriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketRetriesRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryPacketRetriesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryPacketRetriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketRetriesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPacketRetriesRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is the response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params is the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryPacketRetriesRequest) GetTunnelId() uint64 {
+	if m != nil {
+		return m.TunnelId
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPacketRetriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPacketRetriesResponse is the response type for the Query/PacketRetries RPC method.
+type QueryPacketRetriesResponse struct {
+	// packet_retries is a list of pending packet retries.
+	PacketRetries []*PacketRetry `protobuf:"bytes,1,rep,name=packet_retries,json=packetRetries,proto3" json:"packet_retries,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPacketRetriesResponse) Reset()         { *m = QueryPacketRetriesResponse{} }
+func (m *QueryPacketRetriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketRetriesResponse) ProtoMessage()    {}
+func (*QueryPacketRetriesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f80b85392d1440ac, []int{15}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPacketRetriesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPacketRetriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPacketRetriesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -833,946 +873,1179 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryPacketRetriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketRetriesResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryPacketRetriesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryPacketRetriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketRetriesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPacketRetriesResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryPacketRetriesResponse) GetPacketRetries() []*PacketRetry {
 	if m != nil {
-		return m.Params
+		return m.PacketRetries
 	}
-	return Params{}
+	return nil
 }
 
-func init() {
-	proto.RegisterEnum("band.tunnel.v1beta1.TunnelStatusFilter", TunnelStatusFilter_name, TunnelStatusFilter_value)
-	proto.RegisterType((*QueryTunnelsRequest)(nil), "band.tunnel.v1beta1.QueryTunnelsRequest")
-	proto.RegisterType((*QueryTunnelsResponse)(nil), "band.tunnel.v1beta1.QueryTunnelsResponse")
-	proto.RegisterType((*QueryTunnelRequest)(nil), "band.tunnel.v1beta1.QueryTunnelRequest")
-	proto.RegisterType((*QueryTunnelResponse)(nil), "band.tunnel.v1beta1.QueryTunnelResponse")
-	proto.RegisterType((*QueryDepositsRequest)(nil), "band.tunnel.v1beta1.QueryDepositsRequest")
-	proto.RegisterType((*QueryDepositsResponse)(nil), "band.tunnel.v1beta1.QueryDepositsResponse")
-	proto.RegisterType((*QueryDepositRequest)(nil), "band.tunnel.v1beta1.QueryDepositRequest")
-	proto.RegisterType((*QueryDepositResponse)(nil), "band.tunnel.v1beta1.QueryDepositResponse")
-	proto.RegisterType((*QueryPacketsRequest)(nil), "band.tunnel.v1beta1.QueryPacketsRequest")
-	proto.RegisterType((*QueryPacketsResponse)(nil), "band.tunnel.v1beta1.QueryPacketsResponse")
-	proto.RegisterType((*QueryPacketRequest)(nil), "band.tunnel.v1beta1.QueryPacketRequest")
-	proto.RegisterType((*QueryPacketResponse)(nil), "band.tunnel.v1beta1.QueryPacketResponse")
-	proto.RegisterType((*QueryTotalFeesRequest)(nil), "band.tunnel.v1beta1.QueryTotalFeesRequest")
-	proto.RegisterType((*QueryTotalFeesResponse)(nil), "band.tunnel.v1beta1.QueryTotalFeesResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "band.tunnel.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "band.tunnel.v1beta1.QueryParamsResponse")
+func (m *QueryPacketRetriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("band/tunnel/v1beta1/query.proto", fileDescriptor_f80b85392d1440ac) }
-
-var fileDescriptor_f80b85392d1440ac = []byte{
-	// 944 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x18, 0xf5, 0x84, 0x60, 0xc7, 0x53, 0x40, 0xd5, 0x34, 0xb4, 0x61, 0x13, 0x36, 0x66, 0xf9, 0x11,
-	0x37, 0x81, 0x1d, 0x25, 0x01, 0x14, 0x10, 0x42, 0xb4, 0xa9, 0x8d, 0x8c, 0x42, 0x64, 0x36, 0x0e,
-	0x07, 0x24, 0x64, 0xad, 0x9d, 0xa9, 0xbb, 0xc2, 0xd9, 0xd9, 0x7a, 0xd6, 0x15, 0xc5, 0x8a, 0x90,
-	0x10, 0x87, 0x1e, 0x38, 0x20, 0x55, 0x02, 0x09, 0x2e, 0x48, 0x1c, 0xf9, 0x47, 0x7a, 0xac, 0xc4,
-	0x85, 0x13, 0x42, 0x09, 0x7f, 0x08, 0xda, 0x99, 0x6f, 0xd6, 0x5e, 0x77, 0x59, 0x6f, 0xa4, 0xa8,
-	0x37, 0x7b, 0xf6, 0x7d, 0xf3, 0xde, 0xf7, 0xbe, 0x99, 0xb7, 0x8b, 0x57, 0x3b, 0xae, 0x7f, 0x44,
-	0xc3, 0xa1, 0xef, 0xb3, 0x3e, 0xbd, 0xb7, 0xd9, 0x61, 0xa1, 0xbb, 0x49, 0xef, 0x0e, 0xd9, 0xe0,
-	0xbe, 0x1d, 0x0c, 0x78, 0xc8, 0xc9, 0x95, 0x08, 0x60, 0x2b, 0x80, 0x0d, 0x00, 0x63, 0xb1, 0xc7,
-	0x7b, 0x5c, 0x3e, 0xa7, 0xd1, 0x2f, 0x05, 0x35, 0xd6, 0xbb, 0x5c, 0x1c, 0x73, 0x41, 0x3b, 0xae,
-	0x60, 0x6a, 0x8f, 0x78, 0xc7, 0xc0, 0xed, 0x79, 0xbe, 0x1b, 0x7a, 0xdc, 0x07, 0xec, 0x4a, 0x8f,
-	0xf3, 0x5e, 0x9f, 0x51, 0x37, 0xf0, 0xa8, 0xeb, 0xfb, 0x3c, 0x94, 0x0f, 0x05, 0x3c, 0xad, 0xa4,
-	0xa9, 0x0a, 0xdc, 0x81, 0x7b, 0x9c, 0x89, 0x00, 0x95, 0x12, 0x61, 0xfd, 0x81, 0xf0, 0x95, 0xcf,
-	0x22, 0x11, 0x2d, 0xb9, 0x2a, 0x1c, 0x76, 0x77, 0xc8, 0x44, 0x48, 0xf6, 0xf0, 0xf3, 0x22, 0x74,
-	0xc3, 0xa1, 0x68, 0xdf, 0xf6, 0xfa, 0x21, 0x1b, 0x2c, 0xa1, 0x0a, 0xaa, 0xbe, 0xb0, 0xb5, 0x66,
-	0xa7, 0x34, 0x6a, 0xab, 0xda, 0x03, 0x89, 0xaf, 0x4b, 0xb8, 0xf3, 0x9c, 0x98, 0xf8, 0x47, 0xea,
-	0x18, 0x8f, 0x7b, 0x5b, 0x9a, 0xab, 0xa0, 0xea, 0xa5, 0xad, 0x37, 0x6c, 0x65, 0x84, 0x1d, 0x19,
-	0x61, 0x2b, 0x33, 0xf5, 0x86, 0x4d, 0xb7, 0xc7, 0x40, 0x89, 0x33, 0x51, 0x69, 0xfd, 0x84, 0xf0,
-	0x62, 0x52, 0xad, 0x08, 0xb8, 0x2f, 0x18, 0x79, 0x07, 0x97, 0x94, 0x26, 0xb1, 0x84, 0x2a, 0xcf,
-	0x54, 0x2f, 0x6d, 0x2d, 0x67, 0x08, 0x75, 0x34, 0x96, 0x7c, 0x9c, 0xa2, 0x6b, 0x6d, 0xa6, 0x2e,
-	0xc5, 0x99, 0x10, 0xb6, 0x89, 0xc9, 0x84, 0x2e, 0x6d, 0xe2, 0x32, 0x2e, 0x2b, 0xa6, 0xb6, 0x77,
-	0x24, 0x0d, 0x9c, 0x77, 0x16, 0xd4, 0x42, 0xe3, 0xc8, 0x6a, 0x26, 0x8c, 0x8f, 0x3b, 0x79, 0x0f,
-	0x17, 0x15, 0x44, 0x16, 0x64, 0x37, 0x72, 0x73, 0xfe, 0xd1, 0xdf, 0xab, 0x05, 0x07, 0x0a, 0xac,
-	0x11, 0x98, 0x73, 0x8b, 0x05, 0x5c, 0x78, 0xa1, 0xc8, 0x23, 0xe3, 0xc2, 0x46, 0xf3, 0x0b, 0xc2,
-	0x2f, 0x4e, 0xb1, 0x43, 0x47, 0x3b, 0x78, 0xe1, 0x08, 0xd6, 0x60, 0x38, 0x2b, 0xa9, 0x3d, 0x41,
-	0xa1, 0x13, 0xa3, 0x2f, 0x6e, 0x3c, 0xda, 0x6b, 0x4d, 0x91, 0xc7, 0x98, 0x15, 0x5c, 0x06, 0x21,
-	0x7c, 0x20, 0xb9, 0xcb, 0xce, 0x78, 0xc1, 0x6a, 0x25, 0xbd, 0x8e, 0x9b, 0xfd, 0x00, 0x97, 0x00,
-	0x04, 0xf3, 0xcb, 0xec, 0x15, 0x06, 0xa8, 0x4b, 0xac, 0x6f, 0x40, 0x67, 0xd3, 0xed, 0x7e, 0xc5,
-	0x9e, 0xf2, 0x00, 0xe3, 0xbb, 0x15, 0x93, 0x8f, 0xef, 0x56, 0xa0, 0x96, 0x32, 0xef, 0x96, 0x2a,
-	0x73, 0x34, 0xf6, 0xe2, 0x86, 0xf7, 0x29, 0xdc, 0x2d, 0x20, 0xc8, 0xe3, 0x89, 0x81, 0x17, 0x44,
-	0x84, 0xf3, 0xbb, 0x4c, 0x32, 0xcf, 0x3b, 0xf1, 0x7f, 0xeb, 0x93, 0x84, 0xc7, 0x71, 0x97, 0xdb,
-	0xb8, 0xa8, 0x94, 0x67, 0xde, 0x3b, 0x28, 0x02, 0xa8, 0x75, 0x0d, 0xce, 0x7c, 0x8b, 0x87, 0x6e,
-	0xbf, 0xce, 0x98, 0x9e, 0x98, 0xf5, 0x25, 0xbe, 0x3a, 0xfd, 0x00, 0x78, 0x76, 0x31, 0x0e, 0xa3,
-	0xc5, 0xf6, 0x6d, 0xc6, 0x04, 0x70, 0x99, 0xe9, 0x77, 0x5c, 0xd7, 0xc2, 0x29, 0x29, 0x87, 0x7a,
-	0xc1, 0x5a, 0x8c, 0x2d, 0x89, 0xc2, 0x5e, 0x93, 0x36, 0xe3, 0xce, 0xd4, 0xea, 0x38, 0x51, 0xd4,
-	0x4b, 0x61, 0x46, 0x67, 0x11, 0x44, 0x27, 0x8a, 0x2a, 0x58, 0xff, 0x1e, 0x61, 0xf2, 0x64, 0xb8,
-	0x93, 0xd7, 0x70, 0xa5, 0x75, 0xb8, 0xbf, 0x5f, 0xdb, 0x6b, 0x1f, 0xb4, 0x6e, 0xb4, 0x0e, 0x0f,
-	0xda, 0xf5, 0xc6, 0x5e, 0xab, 0xe6, 0xb4, 0x0f, 0xf7, 0x0f, 0x9a, 0xb5, 0xdd, 0x46, 0xbd, 0x51,
-	0xbb, 0x75, 0xb9, 0x40, 0x56, 0xf1, 0x72, 0x2a, 0xea, 0xc6, 0x6e, 0xab, 0xf1, 0x79, 0xed, 0x32,
-	0x22, 0xaf, 0xe0, 0x97, 0x53, 0x01, 0x8d, 0x7d, 0x80, 0xcc, 0x19, 0xf3, 0x0f, 0x7e, 0x37, 0x0b,
-	0x5b, 0x3f, 0x94, 0xf1, 0xb3, 0xb2, 0x33, 0xf2, 0x2d, 0x2e, 0x41, 0xf4, 0x93, 0x6a, 0x6a, 0x1b,
-	0x29, 0xef, 0x32, 0xe3, 0x7a, 0x0e, 0xa4, 0xf2, 0xca, 0x5a, 0xfd, 0xee, 0xcf, 0x7f, 0x1f, 0xce,
-	0xbd, 0x44, 0xae, 0xa5, 0xbf, 0x34, 0x05, 0x79, 0x80, 0x70, 0x51, 0x15, 0x91, 0xb5, 0x59, 0xdb,
-	0x6a, 0xfe, 0xea, 0x6c, 0x20, 0xd0, 0x6f, 0x48, 0xfa, 0xd7, 0xc9, 0xab, 0xff, 0x43, 0x4f, 0x47,
-	0xf1, 0x99, 0x3f, 0x21, 0x3f, 0x23, 0xbc, 0xa0, 0xc3, 0x96, 0x64, 0xf4, 0x38, 0xf5, 0x3a, 0x30,
-	0xd6, 0xf3, 0x40, 0x41, 0xd0, 0xdb, 0x52, 0x90, 0x4d, 0xde, 0xcc, 0x21, 0x88, 0xc6, 0xb9, 0xfd,
-	0x1b, 0xc2, 0x25, 0xd8, 0x2a, 0x6b, 0x4c, 0xc9, 0x34, 0x36, 0xae, 0xe7, 0x40, 0x82, 0xac, 0x8f,
-	0xa4, 0xac, 0xf7, 0xc9, 0xce, 0x79, 0x64, 0xd1, 0x51, 0x1c, 0xdf, 0x27, 0xe4, 0x21, 0xc2, 0x25,
-	0x08, 0xba, 0x2c, 0x89, 0xc9, 0x20, 0xce, 0x92, 0x38, 0x95, 0x9a, 0xd6, 0xb6, 0x94, 0xf8, 0x16,
-	0xd9, 0xc8, 0x23, 0x51, 0x67, 0xe6, 0xaf, 0x08, 0x17, 0xd5, 0x46, 0x59, 0xa7, 0x2b, 0x11, 0x84,
-	0x46, 0x75, 0x36, 0x10, 0x24, 0x7d, 0x28, 0x25, 0xed, 0x90, 0x77, 0xcf, 0x21, 0x89, 0x8e, 0x74,
-	0x70, 0x9e, 0x44, 0x67, 0xbf, 0x1c, 0x87, 0x12, 0xc9, 0x38, 0x46, 0xd3, 0x71, 0x68, 0x6c, 0xe4,
-	0xc2, 0x82, 0x4c, 0x4b, 0xca, 0x5c, 0x21, 0xc6, 0x13, 0x32, 0xe3, 0xdc, 0x24, 0xa3, 0xc8, 0xa7,
-	0x28, 0xa2, 0xb2, 0x7d, 0x9a, 0x48, 0xc7, 0x6c, 0x9f, 0x26, 0x03, 0xd3, 0x32, 0xa5, 0x80, 0x25,
-	0x72, 0x35, 0xfd, 0xdb, 0xfa, 0x66, 0xe3, 0xd1, 0xa9, 0x89, 0x1e, 0x9f, 0x9a, 0xe8, 0x9f, 0x53,
-	0x13, 0xfd, 0x78, 0x66, 0x16, 0x1e, 0x9f, 0x99, 0x85, 0xbf, 0xce, 0xcc, 0xc2, 0x17, 0xb4, 0xe7,
-	0x85, 0x77, 0x86, 0x1d, 0xbb, 0xcb, 0x8f, 0x69, 0xc4, 0x26, 0xbf, 0xb1, 0xbb, 0xbc, 0x4f, 0xbb,
-	0x77, 0x5c, 0xcf, 0xa7, 0xf7, 0xb6, 0xe9, 0xd7, 0x7a, 0xcf, 0xf0, 0x7e, 0xc0, 0x44, 0xa7, 0x28,
-	0x11, 0xdb, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x75, 0xeb, 0x1c, 0x48, 0x61, 0x0c, 0x00, 0x00,
+// QueryDeadLetterPacketsRequest is the request type for the Query/DeadLetterPackets RPC method.
+type QueryDeadLetterPacketsRequest struct {
+	// tunnel_id is the ID of the tunnel to query dead-lettered packets.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Tunnels is a RPC method that returns all tunnels.
-	Tunnels(ctx context.Context, in *QueryTunnelsRequest, opts ...grpc.CallOption) (*QueryTunnelsResponse, error)
-	// Tunnel is a RPC method that returns a tunnel by its ID.
-	Tunnel(ctx context.Context, in *QueryTunnelRequest, opts ...grpc.CallOption) (*QueryTunnelResponse, error)
-	// Deposits queries all deposits of a single tunnel.
-	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
-	// Deposit queries single deposit information based tunnelID, depositAddr.
-	Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error)
-	// Packets is a RPC method that returns all packets of a tunnel.
-	Packets(ctx context.Context, in *QueryPacketsRequest, opts ...grpc.CallOption) (*QueryPacketsResponse, error)
-	// Packet is a RPC method that returns a packet by its tunnel ID and sequence.
-	Packet(ctx context.Context, in *QueryPacketRequest, opts ...grpc.CallOption) (*QueryPacketResponse, error)
-	// TotalFees is a RPC method that returns the total fees collected by the tunnel
-	TotalFees(ctx context.Context, in *QueryTotalFeesRequest, opts ...grpc.CallOption) (*QueryTotalFeesResponse, error)
-	// Params is a RPC method that returns all parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryDeadLetterPacketsRequest) Reset()         { *m = QueryDeadLetterPacketsRequest{} }
+func (m *QueryDeadLetterPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeadLetterPacketsRequest) ProtoMessage()    {}
+func (*QueryDeadLetterPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{16}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryDeadLetterPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryDeadLetterPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeadLetterPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeadLetterPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeadLetterPacketsRequest.Merge(m, src)
+}
+func (m *QueryDeadLetterPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeadLetterPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeadLetterPacketsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Tunnels(ctx context.Context, in *QueryTunnelsRequest, opts ...grpc.CallOption) (*QueryTunnelsResponse, error) {
-	out := new(QueryTunnelsResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Tunnels", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDeadLetterPacketsRequest proto.InternalMessageInfo
+
+func (m *QueryDeadLetterPacketsRequest) GetTunnelId() uint64 {
+	if m != nil {
+		return m.TunnelId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Tunnel(ctx context.Context, in *QueryTunnelRequest, opts ...grpc.CallOption) (*QueryTunnelResponse, error) {
-	out := new(QueryTunnelResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Tunnel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDeadLetterPacketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
-	out := new(QueryDepositsResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Deposits", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryDeadLetterPacketsResponse is the response type for the Query/DeadLetterPackets RPC method.
+type QueryDeadLetterPacketsResponse struct {
+	// dead_letter_packets is a list of dead-lettered packets.
+	DeadLetterPackets []*DeadLetterPacket `protobuf:"bytes,1,rep,name=dead_letter_packets,json=deadLetterPackets,proto3" json:"dead_letter_packets,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error) {
-	out := new(QueryDepositResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Deposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDeadLetterPacketsResponse) Reset()         { *m = QueryDeadLetterPacketsResponse{} }
+func (m *QueryDeadLetterPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeadLetterPacketsResponse) ProtoMessage()    {}
+func (*QueryDeadLetterPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{17}
+}
+func (m *QueryDeadLetterPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeadLetterPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeadLetterPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDeadLetterPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeadLetterPacketsResponse.Merge(m, src)
+}
+func (m *QueryDeadLetterPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeadLetterPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeadLetterPacketsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Packets(ctx context.Context, in *QueryPacketsRequest, opts ...grpc.CallOption) (*QueryPacketsResponse, error) {
-	out := new(QueryPacketsResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Packets", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDeadLetterPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryDeadLetterPacketsResponse) GetDeadLetterPackets() []*DeadLetterPacket {
+	if m != nil {
+		return m.DeadLetterPackets
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Packet(ctx context.Context, in *QueryPacketRequest, opts ...grpc.CallOption) (*QueryPacketResponse, error) {
-	out := new(QueryPacketResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Packet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDeadLetterPacketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TotalFees(ctx context.Context, in *QueryTotalFeesRequest, opts ...grpc.CallOption) (*QueryTotalFeesResponse, error) {
-	out := new(QueryTotalFeesResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/TotalFees", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryTopUpConfigRequest is the request type for the Query/TopUpConfig RPC method.
+type QueryTopUpConfigRequest struct {
+	// tunnel_id is the ID of the tunnel to query the top-up config.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTopUpConfigRequest) Reset()         { *m = QueryTopUpConfigRequest{} }
+func (m *QueryTopUpConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopUpConfigRequest) ProtoMessage()    {}
+func (*QueryTopUpConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{18}
+}
+func (m *QueryTopUpConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopUpConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopUpConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Tunnels is a RPC method that returns all tunnels.
-	Tunnels(context.Context, *QueryTunnelsRequest) (*QueryTunnelsResponse, error)
-	// Tunnel is a RPC method that returns a tunnel by its ID.
-	Tunnel(context.Context, *QueryTunnelRequest) (*QueryTunnelResponse, error)
-	// Deposits queries all deposits of a single tunnel.
-	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
-	// Deposit queries single deposit information based tunnelID, depositAddr.
-	Deposit(context.Context, *QueryDepositRequest) (*QueryDepositResponse, error)
-	// Packets is a RPC method that returns all packets of a tunnel.
-	Packets(context.Context, *QueryPacketsRequest) (*QueryPacketsResponse, error)
-	// Packet is a RPC method that returns a packet by its tunnel ID and sequence.
-	Packet(context.Context, *QueryPacketRequest) (*QueryPacketResponse, error)
-	// TotalFees is a RPC method that returns the total fees collected by the tunnel
-	TotalFees(context.Context, *QueryTotalFeesRequest) (*QueryTotalFeesResponse, error)
-	// Params is a RPC method that returns all parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (m *QueryTopUpConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopUpConfigRequest.Merge(m, src)
+}
+func (m *QueryTopUpConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopUpConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopUpConfigRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryTopUpConfigRequest proto.InternalMessageInfo
+
+func (m *QueryTopUpConfigRequest) GetTunnelId() uint64 {
+	if m != nil {
+		return m.TunnelId
+	}
+	return 0
 }
 
-func (*UnimplementedQueryServer) Tunnels(ctx context.Context, req *QueryTunnelsRequest) (*QueryTunnelsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Tunnels not implemented")
+// QueryTopUpConfigResponse is the response type for the Query/TopUpConfig RPC method.
+type QueryTopUpConfigResponse struct {
+	// top_up_config is the top-up config of the tunnel.
+	TopUpConfig TopUpConfig `protobuf:"bytes,1,opt,name=top_up_config,json=topUpConfig,proto3" json:"top_up_config"`
 }
-func (*UnimplementedQueryServer) Tunnel(ctx context.Context, req *QueryTunnelRequest) (*QueryTunnelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Tunnel not implemented")
+
+func (m *QueryTopUpConfigResponse) Reset()         { *m = QueryTopUpConfigResponse{} }
+func (m *QueryTopUpConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopUpConfigResponse) ProtoMessage()    {}
+func (*QueryTopUpConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{19}
 }
-func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
+func (m *QueryTopUpConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Deposit(ctx context.Context, req *QueryDepositRequest) (*QueryDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+func (m *QueryTopUpConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopUpConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Packets(ctx context.Context, req *QueryPacketsRequest) (*QueryPacketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Packets not implemented")
+func (m *QueryTopUpConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopUpConfigResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Packet(ctx context.Context, req *QueryPacketRequest) (*QueryPacketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Packet not implemented")
+func (m *QueryTopUpConfigResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) TotalFees(ctx context.Context, req *QueryTotalFeesRequest) (*QueryTotalFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalFees not implemented")
+func (m *QueryTopUpConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopUpConfigResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+
+var xxx_messageInfo_QueryTopUpConfigResponse proto.InternalMessageInfo
+
+func (m *QueryTopUpConfigResponse) GetTopUpConfig() TopUpConfig {
+	if m != nil {
+		return m.TopUpConfig
+	}
+	return TopUpConfig{}
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryRemainingPacketsRequest is the request type for the Query/RemainingPackets RPC method.
+type QueryRemainingPacketsRequest struct {
+	// tunnel_id is the ID of the tunnel to estimate the remaining packets.
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
 }
 
-func _Query_Tunnels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTunnelsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Tunnels(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Tunnels",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Tunnels(ctx, req.(*QueryTunnelsRequest))
+func (m *QueryRemainingPacketsRequest) Reset()         { *m = QueryRemainingPacketsRequest{} }
+func (m *QueryRemainingPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainingPacketsRequest) ProtoMessage()    {}
+func (*QueryRemainingPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{20}
+}
+func (m *QueryRemainingPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemainingPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainingPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRemainingPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainingPacketsRequest.Merge(m, src)
+}
+func (m *QueryRemainingPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainingPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainingPacketsRequest.DiscardUnknown(m)
 }
 
-func _Query_Tunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTunnelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Tunnel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Tunnel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Tunnel(ctx, req.(*QueryTunnelRequest))
+var xxx_messageInfo_QueryRemainingPacketsRequest proto.InternalMessageInfo
+
+func (m *QueryRemainingPacketsRequest) GetTunnelId() uint64 {
+	if m != nil {
+		return m.TunnelId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDepositsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Deposits(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Deposits",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Deposits(ctx, req.(*QueryDepositsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryRemainingPacketsResponse is the response type for the Query/RemainingPackets RPC method.
+type QueryRemainingPacketsResponse struct {
+	// remaining_packets is the estimated number of packets that the fee payer can pay for. It is the maximum
+	// uint64 if the fee per packet is zero.
+	RemainingPackets uint64 `protobuf:"varint,1,opt,name=remaining_packets,json=remainingPackets,proto3" json:"remaining_packets,omitempty"`
+	// fee_per_packet is the estimated fee of a packet to all routes of the tunnel.
+	FeePerPacket github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee_per_packet,json=feePerPacket,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_per_packet"`
+	// fee_payer_balance is the balance of the fee payer.
+	FeePayerBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fee_payer_balance,json=feePayerBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_payer_balance"`
 }
 
-func _Query_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDepositRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Deposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Deposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Deposit(ctx, req.(*QueryDepositRequest))
+func (m *QueryRemainingPacketsResponse) Reset()         { *m = QueryRemainingPacketsResponse{} }
+func (m *QueryRemainingPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainingPacketsResponse) ProtoMessage()    {}
+func (*QueryRemainingPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{21}
+}
+func (m *QueryRemainingPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemainingPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainingPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRemainingPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainingPacketsResponse.Merge(m, src)
+}
+func (m *QueryRemainingPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainingPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainingPacketsResponse.DiscardUnknown(m)
 }
 
-func _Query_Packets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPacketsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Packets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Packets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Packets(ctx, req.(*QueryPacketsRequest))
+var xxx_messageInfo_QueryRemainingPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryRemainingPacketsResponse) GetRemainingPackets() uint64 {
+	if m != nil {
+		return m.RemainingPackets
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Packet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPacketRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Packet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Packet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Packet(ctx, req.(*QueryPacketRequest))
+func (m *QueryRemainingPacketsResponse) GetFeePerPacket() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeePerPacket
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_TotalFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalFeesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/TotalFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalFees(ctx, req.(*QueryTotalFeesRequest))
+func (m *QueryRemainingPacketsResponse) GetFeePayerBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeePayerBalance
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.tunnel.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryTotalFeesRequest is the request type for the Query/TotalFees RPC method.
+type QueryTotalFeesRequest struct {
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "band.tunnel.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Tunnels",
-			Handler:    _Query_Tunnels_Handler,
-		},
-		{
-			MethodName: "Tunnel",
-			Handler:    _Query_Tunnel_Handler,
-		},
-		{
-			MethodName: "Deposits",
-			Handler:    _Query_Deposits_Handler,
-		},
-		{
-			MethodName: "Deposit",
-			Handler:    _Query_Deposit_Handler,
-		},
-		{
-			MethodName: "Packets",
-			Handler:    _Query_Packets_Handler,
-		},
-		{
-			MethodName: "Packet",
-			Handler:    _Query_Packet_Handler,
-		},
-		{
-			MethodName: "TotalFees",
-			Handler:    _Query_TotalFees_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "band/tunnel/v1beta1/query.proto",
-}
-
-func (m *QueryTunnelsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTotalFeesRequest) Reset()         { *m = QueryTotalFeesRequest{} }
+func (m *QueryTotalFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalFeesRequest) ProtoMessage()    {}
+func (*QueryTotalFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{22}
 }
-
-func (m *QueryTunnelsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTotalFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryTunnelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTotalFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.StatusFilter != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StatusFilter))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTunnelsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTotalFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalFeesRequest.Merge(m, src)
+}
+func (m *QueryTotalFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalFeesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTunnelsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryTotalFeesRequest proto.InternalMessageInfo
+
+// QueryTotalFeesResponse is the response type for the Query/TotalFees RPC method.
+type QueryTotalFeesResponse struct {
+	// total_fees is the total fees collected by the tunnel.
+	TotalFees TotalFees `protobuf:"bytes,1,opt,name=total_fees,json=totalFees,proto3" json:"total_fees"`
 }
 
-func (m *QueryTunnelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Tunnels) > 0 {
-		for iNdEx := len(m.Tunnels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tunnels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryTotalFeesResponse) Reset()         { *m = QueryTotalFeesResponse{} }
+func (m *QueryTotalFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalFeesResponse) ProtoMessage()    {}
+func (*QueryTotalFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{23}
+}
+func (m *QueryTotalFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTunnelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTotalFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalFeesResponse.Merge(m, src)
 }
-
-func (m *QueryTunnelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTotalFeesResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryTunnelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TunnelId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryTotalFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalFeesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTunnelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTotalFeesResponse proto.InternalMessageInfo
+
+func (m *QueryTotalFeesResponse) GetTotalFees() TotalFees {
+	if m != nil {
+		return m.TotalFees
 	}
-	return dAtA[:n], nil
+	return TotalFees{}
 }
 
-func (m *QueryTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
 }
 
-func (m *QueryTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{24}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDepositsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params is the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80b85392d1440ac, []int{25}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.TunnelId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return len(dAtA) - i, nil
+	return Params{}
 }
 
-func (m *QueryDepositsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterEnum("band.tunnel.v1beta1.TunnelStatusFilter", TunnelStatusFilter_name, TunnelStatusFilter_value)
+	proto.RegisterType((*QueryTunnelsRequest)(nil), "band.tunnel.v1beta1.QueryTunnelsRequest")
+	proto.RegisterType((*QueryTunnelsResponse)(nil), "band.tunnel.v1beta1.QueryTunnelsResponse")
+	proto.RegisterType((*QueryTunnelRequest)(nil), "band.tunnel.v1beta1.QueryTunnelRequest")
+	proto.RegisterType((*QueryTunnelResponse)(nil), "band.tunnel.v1beta1.QueryTunnelResponse")
+	proto.RegisterType((*QueryDepositsRequest)(nil), "band.tunnel.v1beta1.QueryDepositsRequest")
+	proto.RegisterType((*QueryDepositsResponse)(nil), "band.tunnel.v1beta1.QueryDepositsResponse")
+	proto.RegisterType((*QueryDepositRequest)(nil), "band.tunnel.v1beta1.QueryDepositRequest")
+	proto.RegisterType((*QueryDepositResponse)(nil), "band.tunnel.v1beta1.QueryDepositResponse")
+	proto.RegisterType((*QueryPacketsRequest)(nil), "band.tunnel.v1beta1.QueryPacketsRequest")
+	proto.RegisterType((*QueryPacketsResponse)(nil), "band.tunnel.v1beta1.QueryPacketsResponse")
+	proto.RegisterType((*QueryPacketRequest)(nil), "band.tunnel.v1beta1.QueryPacketRequest")
+	proto.RegisterType((*QueryPacketResponse)(nil), "band.tunnel.v1beta1.QueryPacketResponse")
+	proto.RegisterType((*QueryNextTriggerRequest)(nil), "band.tunnel.v1beta1.QueryNextTriggerRequest")
+	proto.RegisterType((*QueryNextTriggerResponse)(nil), "band.tunnel.v1beta1.QueryNextTriggerResponse")
+	proto.RegisterType((*QueryPacketRetriesRequest)(nil), "band.tunnel.v1beta1.QueryPacketRetriesRequest")
+	proto.RegisterType((*QueryPacketRetriesResponse)(nil), "band.tunnel.v1beta1.QueryPacketRetriesResponse")
+	proto.RegisterType((*QueryDeadLetterPacketsRequest)(nil), "band.tunnel.v1beta1.QueryDeadLetterPacketsRequest")
+	proto.RegisterType((*QueryDeadLetterPacketsResponse)(nil), "band.tunnel.v1beta1.QueryDeadLetterPacketsResponse")
+	proto.RegisterType((*QueryTopUpConfigRequest)(nil), "band.tunnel.v1beta1.QueryTopUpConfigRequest")
+	proto.RegisterType((*QueryTopUpConfigResponse)(nil), "band.tunnel.v1beta1.QueryTopUpConfigResponse")
+	proto.RegisterType((*QueryRemainingPacketsRequest)(nil), "band.tunnel.v1beta1.QueryRemainingPacketsRequest")
+	proto.RegisterType((*QueryRemainingPacketsResponse)(nil), "band.tunnel.v1beta1.QueryRemainingPacketsResponse")
+	proto.RegisterType((*QueryTotalFeesRequest)(nil), "band.tunnel.v1beta1.QueryTotalFeesRequest")
+	proto.RegisterType((*QueryTotalFeesResponse)(nil), "band.tunnel.v1beta1.QueryTotalFeesResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "band.tunnel.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "band.tunnel.v1beta1.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("band/tunnel/v1beta1/query.proto", fileDescriptor_f80b85392d1440ac) }
+
+var fileDescriptor_f80b85392d1440ac = []byte{
+	// 1443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x24, 0x21, 0x3f, 0x5e, 0x9a, 0x36, 0x99, 0x84, 0xd6, 0xdd, 0xa6, 0x8e, 0x59, 0x28,
+	0x71, 0x93, 0xc6, 0xdb, 0x38, 0xfd, 0x91, 0x96, 0x9f, 0x4d, 0x9a, 0x54, 0x46, 0x21, 0x0a, 0x1b,
+	0x87, 0x03, 0x12, 0x5a, 0x6d, 0xec, 0xb1, 0xbb, 0xaa, 0xb3, 0xbb, 0xdd, 0x1d, 0x97, 0x86, 0x50,
+	0x81, 0x50, 0x91, 0x7a, 0x44, 0xaa, 0x04, 0x12, 0x5c, 0x90, 0x80, 0x0b, 0x20, 0xce, 0xbd, 0x20,
+	0x71, 0xec, 0xb1, 0x12, 0x17, 0x4e, 0x80, 0x5a, 0xfe, 0x10, 0xb4, 0x33, 0xb3, 0x9b, 0xdd, 0x78,
+	0xbd, 0xde, 0x48, 0x51, 0x39, 0xb5, 0x99, 0xf9, 0xde, 0x7c, 0xdf, 0x7b, 0x6f, 0xde, 0xf8, 0xb3,
+	0x61, 0x72, 0x4b, 0x37, 0xab, 0x0a, 0x6d, 0x9a, 0x26, 0x69, 0x28, 0x77, 0xe6, 0xb6, 0x08, 0xd5,
+	0xe7, 0x94, 0xdb, 0x4d, 0xe2, 0xec, 0x14, 0x6c, 0xc7, 0xa2, 0x16, 0x1e, 0xf3, 0x00, 0x05, 0x0e,
+	0x28, 0x08, 0x80, 0x34, 0x5e, 0xb7, 0xea, 0x16, 0xdb, 0x57, 0xbc, 0xff, 0x71, 0xa8, 0x34, 0x5d,
+	0xb1, 0xdc, 0x6d, 0xcb, 0x55, 0xb6, 0x74, 0x97, 0xf0, 0x33, 0x82, 0x13, 0x6d, 0xbd, 0x6e, 0x98,
+	0x3a, 0x35, 0x2c, 0x53, 0x60, 0xb3, 0x61, 0xac, 0x8f, 0xaa, 0x58, 0x86, 0xbf, 0x3f, 0x51, 0xb7,
+	0xac, 0x7a, 0x83, 0x28, 0xba, 0x6d, 0x28, 0xba, 0x69, 0x5a, 0x94, 0x05, 0xbb, 0x62, 0x37, 0x17,
+	0xa7, 0xda, 0xd6, 0x1d, 0x7d, 0x3b, 0x11, 0x21, 0xb2, 0x60, 0x08, 0xf9, 0x67, 0x04, 0x63, 0xef,
+	0x79, 0x22, 0xcb, 0x6c, 0xd5, 0x55, 0xc9, 0xed, 0x26, 0x71, 0x29, 0x5e, 0x85, 0x61, 0x97, 0xea,
+	0xb4, 0xe9, 0x6a, 0x35, 0xa3, 0x41, 0x89, 0x93, 0x41, 0x39, 0x94, 0x3f, 0x5a, 0x9c, 0x2a, 0xc4,
+	0x14, 0xa2, 0xc0, 0x63, 0x37, 0x18, 0x7e, 0x85, 0xc1, 0xd5, 0x23, 0x6e, 0xe8, 0x2f, 0xbc, 0x02,
+	0xb0, 0x97, 0x7b, 0xa6, 0x3b, 0x87, 0xf2, 0x43, 0xc5, 0x57, 0x0b, 0x3c, 0xf9, 0x82, 0x97, 0x7c,
+	0x81, 0x17, 0xdb, 0x3f, 0x70, 0x5d, 0xaf, 0x13, 0xa1, 0x44, 0x0d, 0x45, 0xca, 0x5f, 0x21, 0x18,
+	0x8f, 0xaa, 0x75, 0x6d, 0xcb, 0x74, 0x09, 0xbe, 0x08, 0xfd, 0x5c, 0x93, 0x9b, 0x41, 0xb9, 0x9e,
+	0xfc, 0x50, 0xf1, 0x54, 0x82, 0x50, 0xd5, 0xc7, 0xe2, 0x1b, 0x31, 0xba, 0xa6, 0x3a, 0xea, 0xe2,
+	0x9c, 0x11, 0x61, 0x73, 0x80, 0x43, 0xba, 0xfc, 0x22, 0x9e, 0x82, 0x41, 0xce, 0xa4, 0x19, 0x55,
+	0x56, 0xc0, 0x5e, 0x75, 0x80, 0x2f, 0x94, 0xaa, 0xf2, 0x7a, 0xa4, 0xf0, 0x41, 0x26, 0x57, 0xa0,
+	0x8f, 0x43, 0x58, 0x40, 0x72, 0x22, 0x8b, 0xbd, 0x8f, 0xff, 0x9a, 0xec, 0x52, 0x45, 0x80, 0xbc,
+	0x2b, 0x8a, 0x73, 0x9d, 0xd8, 0x96, 0x6b, 0x50, 0x37, 0x8d, 0x8c, 0x43, 0x6b, 0xcd, 0x37, 0x08,
+	0x5e, 0xdc, 0xc7, 0x2e, 0x32, 0x5a, 0x80, 0x81, 0xaa, 0x58, 0x13, 0xcd, 0x99, 0x88, 0xcd, 0x49,
+	0x04, 0xaa, 0x01, 0xfa, 0xf0, 0xda, 0xe3, 0xd7, 0xda, 0xa7, 0x48, 0x53, 0x98, 0x09, 0x18, 0x14,
+	0x42, 0x2c, 0x87, 0x71, 0x0f, 0xaa, 0x7b, 0x0b, 0x72, 0x39, 0x5a, 0xeb, 0x20, 0xd9, 0xd7, 0xa1,
+	0x5f, 0x80, 0x44, 0xff, 0x12, 0x73, 0x15, 0x0d, 0xf4, 0x43, 0xe4, 0x8f, 0x85, 0xce, 0x75, 0xbd,
+	0x72, 0x8b, 0x3c, 0xe7, 0x06, 0x06, 0xb3, 0x15, 0x90, 0xef, 0xcd, 0x96, 0xcd, 0x97, 0x12, 0x67,
+	0x8b, 0x87, 0xa9, 0x3e, 0xf6, 0xf0, 0x9a, 0xf7, 0xae, 0x98, 0x2d, 0x41, 0x90, 0xa6, 0x26, 0x12,
+	0x0c, 0xb8, 0x1e, 0xce, 0xac, 0x10, 0xc6, 0xdc, 0xab, 0x06, 0x7f, 0xcb, 0x9f, 0x44, 0x6a, 0x1c,
+	0x64, 0x39, 0x0f, 0x7d, 0x5c, 0x79, 0xe2, 0xdc, 0x89, 0x20, 0x01, 0xc5, 0xe7, 0x61, 0xbc, 0xa6,
+	0x1b, 0x0d, 0x52, 0xd5, 0x1c, 0xab, 0x49, 0x89, 0x66, 0x98, 0x55, 0xa3, 0x42, 0xdc, 0x4c, 0x77,
+	0xae, 0x27, 0xdf, 0xab, 0x62, 0xbe, 0xa7, 0x7a, 0x5b, 0x25, 0xbe, 0x23, 0x5f, 0x82, 0x13, 0x8c,
+	0x7d, 0x8d, 0xdc, 0xa5, 0x65, 0xc7, 0xa8, 0xd7, 0x89, 0x93, 0xea, 0xb5, 0x58, 0x81, 0x4c, 0x6b,
+	0x9c, 0x90, 0x3e, 0x0d, 0xa3, 0x26, 0xb9, 0x4b, 0x35, 0xca, 0xd7, 0x35, 0x6a, 0x6c, 0x13, 0x76,
+	0x40, 0x8f, 0x7a, 0xcc, 0xdc, 0xc3, 0x97, 0x8d, 0x6d, 0x22, 0x7f, 0x86, 0xe0, 0x64, 0x24, 0x7d,
+	0xea, 0x18, 0xe4, 0xf9, 0x5e, 0xb4, 0x5f, 0x11, 0x48, 0x71, 0x12, 0x44, 0x36, 0x37, 0xe0, 0x28,
+	0xaf, 0xae, 0xe6, 0xf0, 0x1d, 0x71, 0xeb, 0x72, 0x49, 0x0d, 0x21, 0xd4, 0xd9, 0x51, 0x87, 0xed,
+	0xf0, 0x81, 0x87, 0x77, 0x01, 0xef, 0x23, 0x38, 0x2d, 0x86, 0x5d, 0xaf, 0xae, 0x12, 0x4a, 0x89,
+	0xf3, 0x7f, 0x0c, 0xe8, 0xef, 0x08, 0xb2, 0xed, 0x64, 0x88, 0xda, 0x6d, 0xc2, 0x58, 0x95, 0xe8,
+	0x55, 0xad, 0xc1, 0x76, 0xb5, 0xe8, 0xd8, 0x9e, 0x69, 0xf3, 0x12, 0x45, 0x0f, 0x53, 0x47, 0xab,
+	0xfb, 0x8f, 0x3f, 0xbc, 0x4a, 0xfa, 0xb7, 0xbf, 0x6c, 0xd9, 0x9b, 0xf6, 0x92, 0x65, 0xd6, 0x8c,
+	0x7a, 0xaa, 0xdb, 0x5f, 0x83, 0x4c, 0x6b, 0x9c, 0xc8, 0xf9, 0x1d, 0x18, 0xa6, 0x96, 0xad, 0x35,
+	0x6d, 0xad, 0xc2, 0x36, 0xc4, 0xfc, 0xc6, 0x5f, 0x97, 0xd0, 0x01, 0xe2, 0xed, 0x1d, 0xa2, 0x7b,
+	0x4b, 0xf2, 0x6b, 0x30, 0xc1, 0x78, 0x54, 0xb2, 0xad, 0x1b, 0xa6, 0x61, 0xd6, 0x0f, 0xd0, 0x67,
+	0xf9, 0x51, 0x37, 0x9c, 0x6e, 0x13, 0x2d, 0xa4, 0xce, 0xc0, 0xa8, 0xe3, 0xef, 0x85, 0x9a, 0xe3,
+	0x1d, 0x33, 0xe2, 0xec, 0x0b, 0xc2, 0xb7, 0xe1, 0x68, 0x8d, 0x10, 0xcd, 0x0e, 0xfa, 0xc8, 0x5e,
+	0x95, 0xa1, 0xe2, 0xc9, 0x48, 0xe1, 0xfd, 0xc4, 0x96, 0x2c, 0xc3, 0x5c, 0x3c, 0xef, 0x65, 0xf4,
+	0xd3, 0xdf, 0x93, 0xf9, 0xba, 0x41, 0x6f, 0x36, 0xb7, 0x0a, 0x15, 0x6b, 0x5b, 0xe1, 0x60, 0xf1,
+	0xcf, 0xac, 0x5b, 0xbd, 0xa5, 0xd0, 0x1d, 0x9b, 0xb8, 0x2c, 0xc0, 0x55, 0x8f, 0xd4, 0x08, 0x59,
+	0xf7, 0x1b, 0x8d, 0x3f, 0x82, 0x51, 0x46, 0xa9, 0xef, 0x10, 0x47, 0xdb, 0xd2, 0x1b, 0xba, 0xf7,
+	0x7e, 0xf6, 0x1c, 0x3e, 0xeb, 0x31, 0x8f, 0xd5, 0x23, 0x59, 0xe4, 0x1c, 0xf2, 0x09, 0xe1, 0x1d,
+	0xca, 0x16, 0xd5, 0x1b, 0x2b, 0x24, 0x78, 0x90, 0xe4, 0x0f, 0xe1, 0xf8, 0xfe, 0x0d, 0x51, 0xcb,
+	0x25, 0x00, 0xea, 0x2d, 0x6a, 0x35, 0x42, 0x5c, 0xd1, 0xf3, 0x6c, 0x9b, 0x9e, 0x8b, 0x58, 0xd1,
+	0xf1, 0x41, 0xea, 0x2f, 0xc8, 0xe3, 0xc1, 0x47, 0x8b, 0x67, 0x9a, 0x7d, 0xd2, 0x75, 0x18, 0x8b,
+	0xac, 0xee, 0x39, 0x33, 0x6e, 0xae, 0x3b, 0x7c, 0x42, 0x78, 0x10, 0xdf, 0x99, 0xf1, 0x80, 0xe9,
+	0xfb, 0x08, 0x70, 0xab, 0x49, 0xc6, 0xaf, 0x40, 0xae, 0xbc, 0xb9, 0xb6, 0xb6, 0xbc, 0xaa, 0x6d,
+	0x94, 0xaf, 0x95, 0x37, 0x37, 0xb4, 0x95, 0xd2, 0x6a, 0x79, 0x59, 0xd5, 0x36, 0xd7, 0x36, 0xd6,
+	0x97, 0x97, 0x4a, 0x2b, 0xa5, 0xe5, 0xeb, 0x23, 0x5d, 0x78, 0x12, 0x4e, 0xc5, 0xa2, 0xae, 0x2d,
+	0x95, 0x4b, 0xef, 0x2f, 0x8f, 0x20, 0xfc, 0x12, 0x9c, 0x8e, 0x05, 0x94, 0xd6, 0x04, 0xa4, 0x5b,
+	0xea, 0x7d, 0xf0, 0x7d, 0xb6, 0xab, 0xf8, 0xc5, 0x08, 0xbc, 0xc0, 0x32, 0xc3, 0x9f, 0x42, 0x7f,
+	0x59, 0x78, 0xe0, 0x7c, 0x6c, 0x1a, 0x31, 0xdf, 0x09, 0xa4, 0xb3, 0x29, 0x90, 0xbc, 0x56, 0xf2,
+	0xe4, 0xe7, 0x7f, 0xfc, 0xfb, 0xb0, 0xfb, 0x24, 0x3e, 0x11, 0xff, 0xe5, 0xc3, 0xc5, 0x0f, 0x10,
+	0xf4, 0xf1, 0x20, 0x3c, 0xd5, 0xe9, 0x58, 0x9f, 0x3f, 0xdf, 0x19, 0x28, 0xe8, 0x67, 0x18, 0xfd,
+	0x19, 0xfc, 0x72, 0x1b, 0x7a, 0x65, 0x37, 0x18, 0xe3, 0x7b, 0xf8, 0x6b, 0x04, 0x03, 0xbe, 0x69,
+	0xc5, 0x09, 0x39, 0xee, 0xb3, 0xd5, 0xd2, 0x74, 0x1a, 0xa8, 0x10, 0x74, 0x81, 0x09, 0x2a, 0xe0,
+	0x73, 0x29, 0x04, 0x29, 0x81, 0xff, 0xfd, 0x0e, 0x41, 0xbf, 0x38, 0x2a, 0xa9, 0x4d, 0x51, 0x57,
+	0x2b, 0x9d, 0x4d, 0x81, 0x14, 0xb2, 0xde, 0x66, 0xb2, 0xae, 0xe2, 0x85, 0x83, 0xc8, 0x52, 0x76,
+	0x03, 0x1b, 0x7c, 0x0f, 0x3f, 0x44, 0xd0, 0xef, 0xbf, 0x58, 0x09, 0x12, 0xa3, 0xef, 0xa8, 0x74,
+	0x36, 0x05, 0x52, 0x48, 0x9c, 0x67, 0x12, 0x67, 0xf1, 0x4c, 0x1a, 0x89, 0xbe, 0xf7, 0xfc, 0x16,
+	0x41, 0x9f, 0x78, 0xd3, 0xa6, 0x3a, 0x51, 0xa5, 0xb8, 0x5d, 0x51, 0xab, 0x28, 0xbf, 0xc9, 0x24,
+	0x2d, 0xe0, 0x4b, 0x07, 0x90, 0xa4, 0xec, 0xfa, 0x06, 0xf4, 0x1e, 0xfe, 0x01, 0xc1, 0x50, 0xc8,
+	0xc7, 0xe1, 0x73, 0xed, 0x99, 0x5b, 0x6d, 0xa2, 0x34, 0x9b, 0x12, 0x2d, 0xc4, 0x2e, 0x30, 0xb1,
+	0x45, 0x7c, 0x3e, 0x8d, 0xd8, 0xb0, 0x8d, 0xc4, 0xbf, 0x20, 0x18, 0x8e, 0x58, 0x34, 0x5c, 0xe8,
+	0x5c, 0xa2, 0xb0, 0x9d, 0x94, 0x94, 0xd4, 0x78, 0x21, 0xf6, 0x2a, 0x13, 0x7b, 0x01, 0x17, 0xd3,
+	0x57, 0xd6, 0x77, 0x89, 0xf8, 0x37, 0x04, 0xa3, 0x2d, 0xce, 0x08, 0x17, 0x93, 0x86, 0x21, 0xde,
+	0xcd, 0x49, 0xf3, 0x07, 0x8a, 0x11, 0xd2, 0xdf, 0x62, 0xd2, 0xaf, 0xe0, 0xcb, 0xe9, 0x46, 0xa9,
+	0xc5, 0xa4, 0xe1, 0x1f, 0x11, 0x0c, 0x85, 0xec, 0x49, 0xd2, 0xad, 0x68, 0xb5, 0x4f, 0xd2, 0x6c,
+	0x4a, 0xb4, 0x50, 0x7b, 0x85, 0xa9, 0x9d, 0xc7, 0x73, 0x69, 0xd4, 0x46, 0xec, 0x15, 0x7e, 0x84,
+	0x60, 0x64, 0xbf, 0xc3, 0xc1, 0x73, 0xed, 0xe9, 0xdb, 0x78, 0x29, 0xa9, 0x78, 0x90, 0x10, 0x21,
+	0xfb, 0x0d, 0x26, 0xfb, 0x32, 0xbe, 0x98, 0x46, 0x76, 0x8b, 0xd5, 0xf2, 0x3e, 0x74, 0x06, 0x03,
+	0x37, 0x80, 0xa7, 0x93, 0x4a, 0x16, 0xf5, 0x21, 0xd2, 0x4c, 0x2a, 0xac, 0x50, 0x29, 0x33, 0x95,
+	0x13, 0x58, 0x6a, 0x51, 0x19, 0x18, 0x16, 0xbc, 0xeb, 0x3d, 0x50, 0x9e, 0x37, 0x48, 0x7e, 0xa0,
+	0x42, 0xb6, 0x44, 0xca, 0x77, 0x06, 0x0a, 0x01, 0x59, 0x26, 0x20, 0x83, 0x8f, 0xc7, 0xff, 0x38,
+	0xb8, 0x58, 0x7a, 0xfc, 0x34, 0x8b, 0x9e, 0x3c, 0xcd, 0xa2, 0x7f, 0x9e, 0x66, 0xd1, 0x97, 0xcf,
+	0xb2, 0x5d, 0x4f, 0x9e, 0x65, 0xbb, 0xfe, 0x7c, 0x96, 0xed, 0xfa, 0x40, 0x09, 0x79, 0x38, 0x8f,
+	0x8d, 0xfd, 0x48, 0x58, 0xb1, 0x1a, 0x4a, 0xe5, 0xa6, 0x6e, 0x98, 0xca, 0x9d, 0x79, 0xe5, 0xae,
+	0x7f, 0x26, 0x33, 0x74, 0x5b, 0x7d, 0x0c, 0x31, 0xff, 0xdf, 0x00, 0x9f, 0x2a, 0x73, 0x9f, 0x42,
+	0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Tunnels is a RPC method that returns all tunnels.
+	Tunnels(ctx context.Context, in *QueryTunnelsRequest, opts ...grpc.CallOption) (*QueryTunnelsResponse, error)
+	// Tunnel is a RPC method that returns a tunnel by its ID.
+	Tunnel(ctx context.Context, in *QueryTunnelRequest, opts ...grpc.CallOption) (*QueryTunnelResponse, error)
+	// Deposits queries all deposits of a single tunnel.
+	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
+	// Deposit queries single deposit information based tunnelID, depositAddr.
+	Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error)
+	// Packets is a RPC method that returns all packets of a tunnel.
+	Packets(ctx context.Context, in *QueryPacketsRequest, opts ...grpc.CallOption) (*QueryPacketsResponse, error)
+	// Packet is a RPC method that returns a packet by its tunnel ID and sequence.
+	Packet(ctx context.Context, in *QueryPacketRequest, opts ...grpc.CallOption) (*QueryPacketResponse, error)
+	// NextTrigger is a RPC method that returns the next scheduled trigger of a tunnel.
+	NextTrigger(ctx context.Context, in *QueryNextTriggerRequest, opts ...grpc.CallOption) (*QueryNextTriggerResponse, error)
+	// PacketRetries is a RPC method that returns all pending packet retries of a tunnel.
+	PacketRetries(ctx context.Context, in *QueryPacketRetriesRequest, opts ...grpc.CallOption) (*QueryPacketRetriesResponse, error)
+	// DeadLetterPackets is a RPC method that returns all dead-lettered packets of a tunnel.
+	DeadLetterPackets(ctx context.Context, in *QueryDeadLetterPacketsRequest, opts ...grpc.CallOption) (*QueryDeadLetterPacketsResponse, error)
+	// TopUpConfig is a RPC method that returns the top-up config of a tunnel.
+	TopUpConfig(ctx context.Context, in *QueryTopUpConfigRequest, opts ...grpc.CallOption) (*QueryTopUpConfigResponse, error)
+	// RemainingPackets is a RPC method that estimates the number of packets that the fee payer of a tunnel
+	// can pay for at the current params.
+	RemainingPackets(ctx context.Context, in *QueryRemainingPacketsRequest, opts ...grpc.CallOption) (*QueryRemainingPacketsResponse, error)
+	// TotalFees is a RPC method that returns the total fees collected by the tunnel
+	TotalFees(ctx context.Context, in *QueryTotalFeesRequest, opts ...grpc.CallOption) (*QueryTotalFeesResponse, error)
+	// Params is a RPC method that returns all parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Tunnels(ctx context.Context, in *QueryTunnelsRequest, opts ...grpc.CallOption) (*QueryTunnelsResponse, error) {
+	out := new(QueryTunnelsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Tunnels", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Tunnel(ctx context.Context, in *QueryTunnelRequest, opts ...grpc.CallOption) (*QueryTunnelResponse, error) {
+	out := new(QueryTunnelResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Tunnel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Deposits) > 0 {
-		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
+	out := new(QueryDepositsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Deposits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryDepositRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error) {
+	out := new(QueryDepositResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Deposit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryDepositRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Packets(ctx context.Context, in *QueryPacketsRequest, opts ...grpc.CallOption) (*QueryPacketsResponse, error) {
+	out := new(QueryPacketsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Packets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TunnelId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) Packet(ctx context.Context, in *QueryPacketRequest, opts ...grpc.CallOption) (*QueryPacketResponse, error) {
+	out := new(QueryPacketResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Packet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) NextTrigger(ctx context.Context, in *QueryNextTriggerRequest, opts ...grpc.CallOption) (*QueryNextTriggerResponse, error) {
+	out := new(QueryNextTriggerResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/NextTrigger", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PacketRetries(ctx context.Context, in *QueryPacketRetriesRequest, opts ...grpc.CallOption) (*QueryPacketRetriesResponse, error) {
+	out := new(QueryPacketRetriesResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/PacketRetries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) DeadLetterPackets(ctx context.Context, in *QueryDeadLetterPacketsRequest, opts ...grpc.CallOption) (*QueryDeadLetterPacketsResponse, error) {
+	out := new(QueryDeadLetterPacketsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/DeadLetterPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPacketsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TopUpConfig(ctx context.Context, in *QueryTopUpConfigRequest, opts ...grpc.CallOption) (*QueryTopUpConfigResponse, error) {
+	out := new(QueryTopUpConfigResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/TopUpConfig", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RemainingPackets(ctx context.Context, in *QueryRemainingPacketsRequest, opts ...grpc.CallOption) (*QueryRemainingPacketsResponse, error) {
+	out := new(QueryRemainingPacketsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/RemainingPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TunnelId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) TotalFees(ctx context.Context, in *QueryTotalFeesRequest, opts ...grpc.CallOption) (*QueryTotalFeesResponse, error) {
+	out := new(QueryTotalFeesResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/TotalFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPacketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Tunnels is a RPC method that returns all tunnels.
+	Tunnels(context.Context, *QueryTunnelsRequest) (*QueryTunnelsResponse, error)
+	// Tunnel is a RPC method that returns a tunnel by its ID.
+	Tunnel(context.Context, *QueryTunnelRequest) (*QueryTunnelResponse, error)
+	// Deposits queries all deposits of a single tunnel.
+	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
+	// Deposit queries single deposit information based tunnelID, depositAddr.
+	Deposit(context.Context, *QueryDepositRequest) (*QueryDepositResponse, error)
+	// Packets is a RPC method that returns all packets of a tunnel.
+	Packets(context.Context, *QueryPacketsRequest) (*QueryPacketsResponse, error)
+	// Packet is a RPC method that returns a packet by its tunnel ID and sequence.
+	Packet(context.Context, *QueryPacketRequest) (*QueryPacketResponse, error)
+	// NextTrigger is a RPC method that returns the next scheduled trigger of a tunnel.
+	NextTrigger(context.Context, *QueryNextTriggerRequest) (*QueryNextTriggerResponse, error)
+	// PacketRetries is a RPC method that returns all pending packet retries of a tunnel.
+	PacketRetries(context.Context, *QueryPacketRetriesRequest) (*QueryPacketRetriesResponse, error)
+	// DeadLetterPackets is a RPC method that returns all dead-lettered packets of a tunnel.
+	DeadLetterPackets(context.Context, *QueryDeadLetterPacketsRequest) (*QueryDeadLetterPacketsResponse, error)
+	// TopUpConfig is a RPC method that returns the top-up config of a tunnel.
+	TopUpConfig(context.Context, *QueryTopUpConfigRequest) (*QueryTopUpConfigResponse, error)
+	// RemainingPackets is a RPC method that estimates the number of packets that the fee payer of a tunnel
+	// can pay for at the current params.
+	RemainingPackets(context.Context, *QueryRemainingPacketsRequest) (*QueryRemainingPacketsResponse, error)
+	// TotalFees is a RPC method that returns the total fees collected by the tunnel
+	TotalFees(context.Context, *QueryTotalFeesRequest) (*QueryTotalFeesResponse, error)
+	// Params is a RPC method that returns all parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
-func (m *QueryPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Packets) > 0 {
-		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryPacketRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Tunnels(ctx context.Context, req *QueryTunnelsRequest) (*QueryTunnelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tunnels not implemented")
+}
+func (*UnimplementedQueryServer) Tunnel(ctx context.Context, req *QueryTunnelRequest) (*QueryTunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tunnel not implemented")
+}
+func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
+}
+func (*UnimplementedQueryServer) Deposit(ctx context.Context, req *QueryDepositRequest) (*QueryDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (*UnimplementedQueryServer) Packets(ctx context.Context, req *QueryPacketsRequest) (*QueryPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Packets not implemented")
+}
+func (*UnimplementedQueryServer) Packet(ctx context.Context, req *QueryPacketRequest) (*QueryPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Packet not implemented")
+}
+func (*UnimplementedQueryServer) NextTrigger(ctx context.Context, req *QueryNextTriggerRequest) (*QueryNextTriggerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextTrigger not implemented")
+}
+func (*UnimplementedQueryServer) PacketRetries(ctx context.Context, req *QueryPacketRetriesRequest) (*QueryPacketRetriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PacketRetries not implemented")
+}
+func (*UnimplementedQueryServer) DeadLetterPackets(ctx context.Context, req *QueryDeadLetterPacketsRequest) (*QueryDeadLetterPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeadLetterPackets not implemented")
+}
+func (*UnimplementedQueryServer) TopUpConfig(ctx context.Context, req *QueryTopUpConfigRequest) (*QueryTopUpConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpConfig not implemented")
+}
+func (*UnimplementedQueryServer) RemainingPackets(ctx context.Context, req *QueryRemainingPacketsRequest) (*QueryRemainingPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemainingPackets not implemented")
+}
+func (*UnimplementedQueryServer) TotalFees(ctx context.Context, req *QueryTotalFeesRequest) (*QueryTotalFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalFees not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
 
-func (m *QueryPacketRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x10
+func _Query_Tunnels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTunnelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TunnelId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).Tunnels(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Tunnels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tunnels(ctx, req.(*QueryTunnelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPacketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Tunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTunnelRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Tunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Tunnel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tunnel(ctx, req.(*QueryTunnelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPacketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Deposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Deposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Deposits(ctx, req.(*QueryDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Packet != nil {
-		{
-			size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Deposit(ctx, req.(*QueryDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalFeesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Packets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Packets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Packets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Packets(ctx, req.(*QueryPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalFeesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func _Query_Packet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Packet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Packet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Packet(ctx, req.(*QueryPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (m *QueryTotalFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_NextTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/NextTrigger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextTrigger(ctx, req.(*QueryNextTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalFeesResponse) Marshal() (dAtA []byte, err error) {
+func _Query_PacketRetries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketRetriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PacketRetries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/PacketRetries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PacketRetries(ctx, req.(*QueryPacketRetriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeadLetterPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeadLetterPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeadLetterPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/DeadLetterPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeadLetterPackets(ctx, req.(*QueryDeadLetterPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TopUpConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopUpConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TopUpConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/TopUpConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TopUpConfig(ctx, req.(*QueryTopUpConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemainingPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemainingPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemainingPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/RemainingPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemainingPackets(ctx, req.(*QueryRemainingPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/TotalFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalFees(ctx, req.(*QueryTotalFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "band.tunnel.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Tunnels",
+			Handler:    _Query_Tunnels_Handler,
+		},
+		{
+			MethodName: "Tunnel",
+			Handler:    _Query_Tunnel_Handler,
+		},
+		{
+			MethodName: "Deposits",
+			Handler:    _Query_Deposits_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _Query_Deposit_Handler,
+		},
+		{
+			MethodName: "Packets",
+			Handler:    _Query_Packets_Handler,
+		},
+		{
+			MethodName: "Packet",
+			Handler:    _Query_Packet_Handler,
+		},
+		{
+			MethodName: "NextTrigger",
+			Handler:    _Query_NextTrigger_Handler,
+		},
+		{
+			MethodName: "PacketRetries",
+			Handler:    _Query_PacketRetries_Handler,
+		},
+		{
+			MethodName: "DeadLetterPackets",
+			Handler:    _Query_DeadLetterPackets_Handler,
+		},
+		{
+			MethodName: "TopUpConfig",
+			Handler:    _Query_TopUpConfig_Handler,
+		},
+		{
+			MethodName: "RemainingPackets",
+			Handler:    _Query_RemainingPackets_Handler,
+		},
+		{
+			MethodName: "TotalFees",
+			Handler:    _Query_TotalFees_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "band/tunnel/v1beta1/query.proto",
+}
+
+func (m *QueryTunnelsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1782,30 +2055,37 @@ func (m *QueryTotalFeesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTunnelsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTunnelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.TotalFees.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StatusFilter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StatusFilter))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTunnelsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1815,20 +2095,46 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTunnelsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTunnelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tunnels) > 0 {
+		for iNdEx := len(m.Tunnels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tunnels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTunnelRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1838,270 +2144,2067 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTunnelRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTunnelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTunnelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryTunnelsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StatusFilter != 0 {
-		n += 1 + sovQuery(uint64(m.StatusFilter))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryTunnelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTunnelsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Tunnels) > 0 {
-		for _, e := range m.Tunnels {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTunnelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TunnelId != 0 {
-		n += 1 + sovQuery(uint64(m.TunnelId))
+func (m *QueryDepositsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTunnelResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Tunnel.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDepositsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TunnelId != 0 {
-		n += 1 + sovQuery(uint64(m.TunnelId))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDepositsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Deposits) > 0 {
-		for _, e := range m.Deposits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDepositRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TunnelId != 0 {
-		n += 1 + sovQuery(uint64(m.TunnelId))
-	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Deposit.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPacketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TunnelId != 0 {
-		n += 1 + sovQuery(uint64(m.TunnelId))
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPacketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Packets) > 0 {
-		for _, e := range m.Packets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPacketRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.TunnelId != 0 {
-		n += 1 + sovQuery(uint64(m.TunnelId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPacketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPacketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.FailedRouteIndices) > 0 {
+		dAtA10 := make([]byte, len(m.FailedRouteIndices)*10)
+		var j9 int
+		for _, num := range m.FailedRouteIndices {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintQuery(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Packet != nil {
-		l = m.Packet.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNextTriggerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextTriggerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextTriggerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNextTriggerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextTriggerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextTriggerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TotalFees.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.NextTriggerTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextTriggerTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPacketRetriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketRetriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketRetriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPacketRetriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketRetriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketRetriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PacketRetries) > 0 {
+		for iNdEx := len(m.PacketRetries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketRetries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeadLetterPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeadLetterPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeadLetterPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeadLetterPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeadLetterPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeadLetterPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DeadLetterPackets) > 0 {
+		for iNdEx := len(m.DeadLetterPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeadLetterPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopUpConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopUpConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopUpConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopUpConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopUpConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopUpConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TopUpConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemainingPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemainingPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemainingPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemainingPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemainingPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemainingPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeePayerBalance) > 0 {
+		for iNdEx := len(m.FeePayerBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeePayerBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FeePerPacket) > 0 {
+		for iNdEx := len(m.FeePerPacket) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeePerPacket[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.RemainingPackets != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingPackets))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTunnelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StatusFilter != 0 {
+		n += 1 + sovQuery(uint64(m.StatusFilter))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTunnelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tunnels) > 0 {
+		for _, e := range m.Tunnels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTunnelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	return n
+}
+
+func (m *QueryTunnelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tunnel.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Deposit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		l = m.Packet.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.FailedRouteIndices) > 0 {
+		l = 0
+		for _, e := range m.FailedRouteIndices {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryNextTriggerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	return n
+}
+
+func (m *QueryNextTriggerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextTriggerTime != 0 {
+		n += 1 + sovQuery(uint64(m.NextTriggerTime))
+	}
+	return n
+}
+
+func (m *QueryPacketRetriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPacketRetriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PacketRetries) > 0 {
+		for _, e := range m.PacketRetries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeadLetterPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeadLetterPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeadLetterPackets) > 0 {
+		for _, e := range m.DeadLetterPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTopUpConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	return n
+}
+
+func (m *QueryTopUpConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TopUpConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRemainingPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovQuery(uint64(m.TunnelId))
+	}
+	return n
+}
+
+func (m *QueryRemainingPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemainingPackets != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingPackets))
+	}
+	if len(m.FeePerPacket) > 0 {
+		for _, e := range m.FeePerPacket {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.FeePayerBalance) > 0 {
+		for _, e := range m.FeePayerBalance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTunnelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTunnelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTunnelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusFilter", wireType)
+			}
+			m.StatusFilter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatusFilter |= TunnelStatusFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTunnelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTunnelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTunnelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tunnels = append(m.Tunnels, &Tunnel{})
+			if err := m.Tunnels[len(m.Tunnels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTunnelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTunnelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTunnelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+			}
+			m.TunnelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTunnelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTunnelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+			}
+			m.TunnelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, &Deposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+			}
+			m.TunnelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryTunnelsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPacketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2124,17 +4227,17 @@ func (m *QueryTunnelsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTunnelsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPacketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTunnelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
 			}
-			m.StatusFilter = 0
+			m.TunnelId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2144,7 +4247,7 @@ func (m *QueryTunnelsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StatusFilter |= TunnelStatusFilter(b&0x7F) << shift
+				m.TunnelId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2206,7 +4309,7 @@ func (m *QueryTunnelsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTunnelsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPacketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2229,15 +4332,15 @@ func (m *QueryTunnelsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTunnelsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPacketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTunnelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tunnels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2264,8 +4367,8 @@ func (m *QueryTunnelsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tunnels = append(m.Tunnels, &Tunnel{})
-			if err := m.Tunnels[len(m.Tunnels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Packets = append(m.Packets, &Packet{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2304,7 +4407,257 @@ func (m *QueryTunnelsResponse) Unmarshal(dAtA []byte) error {
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+			}
+			m.TunnelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Packet == nil {
+				m.Packet = &Packet{}
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FailedRouteIndices = append(m.FailedRouteIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.FailedRouteIndices) == 0 {
+					m.FailedRouteIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FailedRouteIndices = append(m.FailedRouteIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedRouteIndices", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2326,7 +4679,7 @@ func (m *QueryTunnelsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTunnelRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNextTriggerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2349,10 +4702,10 @@ func (m *QueryTunnelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTunnelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextTriggerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTunnelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextTriggerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2395,7 +4748,7 @@ func (m *QueryTunnelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTunnelResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNextTriggerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2418,17 +4771,17 @@ func (m *QueryTunnelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTunnelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextTriggerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTunnelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextTriggerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTriggerTime", wireType)
 			}
-			var msglen int
+			m.NextTriggerTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2438,25 +4791,11 @@ func (m *QueryTunnelResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NextTriggerTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2478,7 +4817,7 @@ func (m *QueryTunnelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPacketRetriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2501,10 +4840,10 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPacketRetriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPacketRetriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2583,7 +4922,7 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPacketRetriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2606,15 +4945,15 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPacketRetriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPacketRetriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketRetries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2641,8 +4980,8 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposits = append(m.Deposits, &Deposit{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PacketRetries = append(m.PacketRetries, &PacketRetry{})
+			if err := m.PacketRetries[len(m.PacketRetries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2703,7 +5042,7 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDeadLetterPacketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2726,10 +5065,10 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeadLetterPacketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeadLetterPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2753,9 +5092,9 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2765,23 +5104,27 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2804,7 +5147,7 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeadLetterPacketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2827,15 +5170,15 @@ func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeadLetterPacketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeadLetterPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetterPackets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2862,7 +5205,44 @@ func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DeadLetterPackets = append(m.DeadLetterPackets, &DeadLetterPacket{})
+			if err := m.DeadLetterPackets[len(m.DeadLetterPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2887,7 +5267,7 @@ func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPacketsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTopUpConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2910,10 +5290,10 @@ func (m *QueryPacketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPacketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopUpConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopUpConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2935,42 +5315,6 @@ func (m *QueryPacketsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2992,7 +5336,7 @@ func (m *QueryPacketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPacketsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTopUpConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3015,49 +5359,15 @@ func (m *QueryPacketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPacketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopUpConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopUpConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Packets = append(m.Packets, &Packet{})
-			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3084,10 +5394,7 @@ func (m *QueryPacketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TopUpConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3112,7 +5419,7 @@ func (m *QueryPacketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPacketRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRemainingPacketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3135,10 +5442,10 @@ func (m *QueryPacketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPacketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRemainingPacketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRemainingPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3160,25 +5467,6 @@ func (m *QueryPacketRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3200,7 +5488,7 @@ func (m *QueryPacketRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPacketResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRemainingPacketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3223,15 +5511,34 @@ func (m *QueryPacketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPacketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRemainingPacketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRemainingPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingPackets", wireType)
+			}
+			m.RemainingPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerPacket", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3258,10 +5565,42 @@ func (m *QueryPacketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Packet == nil {
-				m.Packet = &Packet{}
+			m.FeePerPacket = append(m.FeePerPacket, types.Coin{})
+			if err := m.FeePerPacket[len(m.FeePerPacket)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayerBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayerBalance = append(m.FeePayerBalance, types.Coin{})
+			if err := m.FeePayerBalance[len(m.FeePayerBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
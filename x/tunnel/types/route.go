@@ -1,7 +1,12 @@
 package types
 
 import (
+	"crypto/sha256"
+
 	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // RouteI defines a routing path to deliver data to the destination.
@@ -10,3 +15,69 @@ type RouteI interface {
 
 	ValidateBasic() error
 }
+
+// PackRoutes packs the routes into a list of Any.
+func PackRoutes(routes []RouteI) ([]*types.Any, error) {
+	anys := make([]*types.Any, 0, len(routes))
+	for _, route := range routes {
+		msg, ok := route.(proto.Message)
+		if !ok {
+			return nil, sdkerrors.ErrPackAny.Wrapf("cannot proto marshal %T", msg)
+		}
+
+		any, err := types.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys = append(anys, any)
+	}
+
+	return anys, nil
+}
+
+// UnpackRoutes returns the route values of the given list of Any.
+func UnpackRoutes(anys []*types.Any) ([]RouteI, error) {
+	routes := make([]RouteI, 0, len(anys))
+	for _, any := range anys {
+		r, ok := any.GetCachedValue().(RouteI)
+		if !ok {
+			return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (RouteI)(nil), any.GetCachedValue())
+		}
+		routes = append(routes, r)
+	}
+
+	return routes, nil
+}
+
+// firstAny returns the first Any of the given list, or nil if the list is empty.
+func firstAny(anys []*types.Any) *types.Any {
+	if len(anys) == 0 {
+		return nil
+	}
+
+	return anys[0]
+}
+
+// RouteHash returns the hash of the given packed route, which changes whenever the route is updated.
+func RouteHash(any *types.Any) []byte {
+	if any == nil {
+		return nil
+	}
+
+	h := sha256.New()
+	h.Write([]byte(any.TypeUrl))
+	h.Write(any.Value)
+	return h.Sum(nil)
+}
+
+// unpackRouteInterfaces unpacks the route interfaces of the given list of Any.
+func unpackRouteInterfaces(unpacker types.AnyUnpacker, anys []*types.Any) error {
+	for _, any := range anys {
+		var route RouteI
+		if err := unpacker.UnpackAny(any, &route); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -35,6 +35,11 @@ type TSSRoute struct {
 	DestinationContractAddress string `protobuf:"bytes,2,opt,name=destination_contract_address,json=destinationContractAddress,proto3" json:"destination_contract_address,omitempty"`
 	// encoder is the mode of encoding packet data.
 	Encoder types.Encoder `protobuf:"varint,3,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
+	// batch indicates whether packets are signed together with other requests over a merkle root.
+	Batch bool `protobuf:"varint,4,opt,name=batch,proto3" json:"batch,omitempty"`
+	// group_name is the name of the bandtss named group that signs packets. If empty, packets are
+	// signed by the current group.
+	GroupName string `protobuf:"bytes,5,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
 }
 
 func (m *TSSRoute) Reset()         { *m = TSSRoute{} }
@@ -91,6 +96,20 @@ func (m *TSSRoute) GetEncoder() types.Encoder {
 	return types.ENCODER_UNSPECIFIED
 }
 
+func (m *TSSRoute) GetBatch() bool {
+	if m != nil {
+		return m.Batch
+	}
+	return false
+}
+
+func (m *TSSRoute) GetGroupName() string {
+	if m != nil {
+		return m.GroupName
+	}
+	return ""
+}
+
 // TSSPacketReceipt represents a receipt for a tss packet and implements the PacketReceiptI interface.
 type TSSPacketReceipt struct {
 	// signing_id is the signing ID
@@ -308,6 +327,9 @@ type IBCHookRoute struct {
 	ChannelID string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 	// destination_contract_address is the destination contract address
 	DestinationContractAddress string `protobuf:"bytes,2,opt,name=destination_contract_address,json=destinationContractAddress,proto3" json:"destination_contract_address,omitempty"`
+	// encoder is the mode of encoding packet data. If it is unspecified, the packet is sent as JSON in
+	// the memo; otherwise the encoded packet is sent by IBCHookEncodedMemo.
+	Encoder types.Encoder `protobuf:"varint,3,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
 }
 
 func (m *IBCHookRoute) Reset()         { *m = IBCHookRoute{} }
@@ -357,6 +379,13 @@ func (m *IBCHookRoute) GetDestinationContractAddress() string {
 	return ""
 }
 
+func (m *IBCHookRoute) GetEncoder() types.Encoder {
+	if m != nil {
+		return m.Encoder
+	}
+	return types.ENCODER_UNSPECIFIED
+}
+
 // IBCHookPacketReceipt represents a receipt for a IBC hook packet and implements the PacketReceiptI interface.
 type IBCHookPacketReceipt struct {
 	// sequence is representing the sequence of the IBC packet.
@@ -596,6 +625,212 @@ func (m *IBCHookMemo_Payload_Msg_ReceivePacket) GetPacket() TunnelPricesPacketDa
 	return TunnelPricesPacketData{}
 }
 
+// IBCHookEncodedMemo is the type for an encoded data packet that will be stringtify to be a memo of IBC hook packet
+type IBCHookEncodedMemo struct {
+	// wasm is the payload for calling destination contract
+	Wasm IBCHookEncodedMemo_Payload `protobuf:"bytes,1,opt,name=wasm,proto3" json:"wasm"`
+}
+
+func (m *IBCHookEncodedMemo) Reset()         { *m = IBCHookEncodedMemo{} }
+func (m *IBCHookEncodedMemo) String() string { return proto.CompactTextString(m) }
+func (*IBCHookEncodedMemo) ProtoMessage()    {}
+func (*IBCHookEncodedMemo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_543238289d94b7a6, []int{8}
+}
+func (m *IBCHookEncodedMemo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCHookEncodedMemo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCHookEncodedMemo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCHookEncodedMemo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCHookEncodedMemo.Merge(m, src)
+}
+func (m *IBCHookEncodedMemo) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCHookEncodedMemo) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCHookEncodedMemo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCHookEncodedMemo proto.InternalMessageInfo
+
+func (m *IBCHookEncodedMemo) GetWasm() IBCHookEncodedMemo_Payload {
+	if m != nil {
+		return m.Wasm
+	}
+	return IBCHookEncodedMemo_Payload{}
+}
+
+// Payload defines target contract and detail of function call (msg).
+type IBCHookEncodedMemo_Payload struct {
+	// contract is destination contract address
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// msg is the ibc hook message
+	Msg IBCHookEncodedMemo_Payload_Msg `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg"`
+}
+
+func (m *IBCHookEncodedMemo_Payload) Reset()         { *m = IBCHookEncodedMemo_Payload{} }
+func (m *IBCHookEncodedMemo_Payload) String() string { return proto.CompactTextString(m) }
+func (*IBCHookEncodedMemo_Payload) ProtoMessage()    {}
+func (*IBCHookEncodedMemo_Payload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_543238289d94b7a6, []int{8, 0}
+}
+func (m *IBCHookEncodedMemo_Payload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCHookEncodedMemo_Payload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCHookEncodedMemo_Payload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCHookEncodedMemo_Payload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCHookEncodedMemo_Payload.Merge(m, src)
+}
+func (m *IBCHookEncodedMemo_Payload) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCHookEncodedMemo_Payload) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCHookEncodedMemo_Payload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCHookEncodedMemo_Payload proto.InternalMessageInfo
+
+func (m *IBCHookEncodedMemo_Payload) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *IBCHookEncodedMemo_Payload) GetMsg() IBCHookEncodedMemo_Payload_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return IBCHookEncodedMemo_Payload_Msg{}
+}
+
+// Msg defines function name (`receive_encoded_packet`) and a type of function arguments.
+type IBCHookEncodedMemo_Payload_Msg struct {
+	// receive_encoded_packet is the function name on the destination contract
+	ReceiveEncodedPacket IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket `protobuf:"bytes,1,opt,name=receive_encoded_packet,json=receiveEncodedPacket,proto3" json:"receive_encoded_packet"`
+}
+
+func (m *IBCHookEncodedMemo_Payload_Msg) Reset()         { *m = IBCHookEncodedMemo_Payload_Msg{} }
+func (m *IBCHookEncodedMemo_Payload_Msg) String() string { return proto.CompactTextString(m) }
+func (*IBCHookEncodedMemo_Payload_Msg) ProtoMessage()    {}
+func (*IBCHookEncodedMemo_Payload_Msg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_543238289d94b7a6, []int{8, 0, 0}
+}
+func (m *IBCHookEncodedMemo_Payload_Msg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCHookEncodedMemo_Payload_Msg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCHookEncodedMemo_Payload_Msg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCHookEncodedMemo_Payload_Msg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCHookEncodedMemo_Payload_Msg.Merge(m, src)
+}
+func (m *IBCHookEncodedMemo_Payload_Msg) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCHookEncodedMemo_Payload_Msg) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCHookEncodedMemo_Payload_Msg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCHookEncodedMemo_Payload_Msg proto.InternalMessageInfo
+
+func (m *IBCHookEncodedMemo_Payload_Msg) GetReceiveEncodedPacket() IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket {
+	if m != nil {
+		return m.ReceiveEncodedPacket
+	}
+	return IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket{}
+}
+
+// ReceiveEncodedPacket represents the arguments of `receive_encoded_packet` function
+type IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket struct {
+	// encoder is the mode of encoding the packet
+	Encoder types.Encoder `protobuf:"varint,1,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
+	// payload is the base64-encoded packet
+	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) Reset() {
+	*m = IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket{}
+}
+func (m *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) String() string {
+	return proto.CompactTextString(m)
+}
+func (*IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) ProtoMessage() {}
+func (*IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_543238289d94b7a6, []int{8, 0, 0, 0}
+}
+func (m *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket.Merge(m, src)
+}
+func (m *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket proto.InternalMessageInfo
+
+func (m *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) GetEncoder() types.Encoder {
+	if m != nil {
+		return m.Encoder
+	}
+	return types.ENCODER_UNSPECIFIED
+}
+
+func (m *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
 // RouterRoute is the type for a Router route
 type RouterRoute struct {
 	// destination_chain_id is the destination chain ID
@@ -604,13 +839,15 @@ type RouterRoute struct {
 	DestinationContractAddress string `protobuf:"bytes,2,opt,name=destination_contract_address,json=destinationContractAddress,proto3" json:"destination_contract_address,omitempty"`
 	// destination_gas_limit is the destination gas limit
 	DestinationGasLimit uint64 `protobuf:"varint,3,opt,name=destination_gas_limit,json=destinationGasLimit,proto3" json:"destination_gas_limit,omitempty"`
+	// encoder is the mode of encoding packet data. If it is unspecified, the fixed-point abi encoder is used.
+	Encoder types.Encoder `protobuf:"varint,4,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
 }
 
 func (m *RouterRoute) Reset()         { *m = RouterRoute{} }
 func (m *RouterRoute) String() string { return proto.CompactTextString(m) }
 func (*RouterRoute) ProtoMessage()    {}
 func (*RouterRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_543238289d94b7a6, []int{8}
+	return fileDescriptor_543238289d94b7a6, []int{9}
 }
 func (m *RouterRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,6 +897,13 @@ func (m *RouterRoute) GetDestinationGasLimit() uint64 {
 	return 0
 }
 
+func (m *RouterRoute) GetEncoder() types.Encoder {
+	if m != nil {
+		return m.Encoder
+	}
+	return types.ENCODER_UNSPECIFIED
+}
+
 // RouterPacketReceipt represents a receipt for a Router packet and implements the PacketReceiptI interface.
 type RouterPacketReceipt struct {
 	// sequence is representing the sequence of the IBC packet.
@@ -670,7 +914,7 @@ func (m *RouterPacketReceipt) Reset()         { *m = RouterPacketReceipt{} }
 func (m *RouterPacketReceipt) String() string { return proto.CompactTextString(m) }
 func (*RouterPacketReceipt) ProtoMessage()    {}
 func (*RouterPacketReceipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_543238289d94b7a6, []int{9}
+	return fileDescriptor_543238289d94b7a6, []int{10}
 }
 func (m *RouterPacketReceipt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,7 +960,7 @@ func (m *RouterMemo) Reset()         { *m = RouterMemo{} }
 func (m *RouterMemo) String() string { return proto.CompactTextString(m) }
 func (*RouterMemo) ProtoMessage()    {}
 func (*RouterMemo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_543238289d94b7a6, []int{10}
+	return fileDescriptor_543238289d94b7a6, []int{11}
 }
 func (m *RouterMemo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -764,7 +1008,7 @@ func (m *RouterMemo_Payload) Reset()         { *m = RouterMemo_Payload{} }
 func (m *RouterMemo_Payload) String() string { return proto.CompactTextString(m) }
 func (*RouterMemo_Payload) ProtoMessage()    {}
 func (*RouterMemo_Payload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_543238289d94b7a6, []int{10, 0}
+	return fileDescriptor_543238289d94b7a6, []int{11, 0}
 }
 func (m *RouterMemo_Payload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,7 +1061,7 @@ func (m *RouterMemo_Payload_Msg) Reset()         { *m = RouterMemo_Payload_Msg{}
 func (m *RouterMemo_Payload_Msg) String() string { return proto.CompactTextString(m) }
 func (*RouterMemo_Payload_Msg) ProtoMessage()    {}
 func (*RouterMemo_Payload_Msg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_543238289d94b7a6, []int{10, 0, 0}
+	return fileDescriptor_543238289d94b7a6, []int{11, 0, 0}
 }
 func (m *RouterMemo_Payload_Msg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -873,7 +1117,7 @@ func (m *RouterMemo_Payload_Msg_ReceiveBandDataArgs) String() string {
 }
 func (*RouterMemo_Payload_Msg_ReceiveBandDataArgs) ProtoMessage() {}
 func (*RouterMemo_Payload_Msg_ReceiveBandDataArgs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_543238289d94b7a6, []int{10, 0, 0, 0}
+	return fileDescriptor_543238289d94b7a6, []int{11, 0, 0, 0}
 }
 func (m *RouterMemo_Payload_Msg_ReceiveBandDataArgs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -944,7 +1188,7 @@ func (m *AxelarRoute) Reset()         { *m = AxelarRoute{} }
 func (m *AxelarRoute) String() string { return proto.CompactTextString(m) }
 func (*AxelarRoute) ProtoMessage()    {}
 func (*AxelarRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_543238289d94b7a6, []int{11}
+	return fileDescriptor_543238289d94b7a6, []int{12}
 }
 func (m *AxelarRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1004,7 +1248,7 @@ func (m *AxelarPacketReceipt) Reset()         { *m = AxelarPacketReceipt{} }
 func (m *AxelarPacketReceipt) String() string { return proto.CompactTextString(m) }
 func (*AxelarPacketReceipt) ProtoMessage()    {}
 func (*AxelarPacketReceipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_543238289d94b7a6, []int{12}
+	return fileDescriptor_543238289d94b7a6, []int{13}
 }
 func (m *AxelarPacketReceipt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1040,6 +1284,164 @@ func (m *AxelarPacketReceipt) GetSequence() uint64 {
 	return 0
 }
 
+// LocalRoute represents a route for delivering packets to a consumer module on BandChain and implements the RouteI
+// interface.
+type LocalRoute struct {
+	// consumer is the name of the consumer module registered with the tunnel keeper
+	Consumer string `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+}
+
+func (m *LocalRoute) Reset()         { *m = LocalRoute{} }
+func (m *LocalRoute) String() string { return proto.CompactTextString(m) }
+func (*LocalRoute) ProtoMessage()    {}
+func (*LocalRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_543238289d94b7a6, []int{14}
+}
+func (m *LocalRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LocalRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LocalRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LocalRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalRoute.Merge(m, src)
+}
+func (m *LocalRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *LocalRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocalRoute proto.InternalMessageInfo
+
+func (m *LocalRoute) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+// LocalPacketReceipt represents a receipt for a local packet and implements the PacketReceiptI interface.
+type LocalPacketReceipt struct {
+	// success is true if the consumer module processed the packet successfully
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// gas_used is the gas used by the consumer module to process the packet
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// error_message is the reason of the failure if the consumer module failed to process the packet
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (m *LocalPacketReceipt) Reset()         { *m = LocalPacketReceipt{} }
+func (m *LocalPacketReceipt) String() string { return proto.CompactTextString(m) }
+func (*LocalPacketReceipt) ProtoMessage()    {}
+func (*LocalPacketReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_543238289d94b7a6, []int{15}
+}
+func (m *LocalPacketReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LocalPacketReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LocalPacketReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LocalPacketReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalPacketReceipt.Merge(m, src)
+}
+func (m *LocalPacketReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *LocalPacketReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalPacketReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocalPacketReceipt proto.InternalMessageInfo
+
+func (m *LocalPacketReceipt) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *LocalPacketReceipt) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *LocalPacketReceipt) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+// FailedPacketReceipt represents a receipt of a route that failed to deliver a packet and implements the
+// PacketReceiptI interface.
+type FailedPacketReceipt struct {
+	// reason is the reason of the failure
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *FailedPacketReceipt) Reset()         { *m = FailedPacketReceipt{} }
+func (m *FailedPacketReceipt) String() string { return proto.CompactTextString(m) }
+func (*FailedPacketReceipt) ProtoMessage()    {}
+func (*FailedPacketReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_543238289d94b7a6, []int{16}
+}
+func (m *FailedPacketReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedPacketReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedPacketReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedPacketReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedPacketReceipt.Merge(m, src)
+}
+func (m *FailedPacketReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedPacketReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedPacketReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedPacketReceipt proto.InternalMessageInfo
+
+func (m *FailedPacketReceipt) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*TSSRoute)(nil), "band.tunnel.v1beta1.TSSRoute")
 	proto.RegisterType((*TSSPacketReceipt)(nil), "band.tunnel.v1beta1.TSSPacketReceipt")
@@ -1052,6 +1454,10 @@ func init() {
 	proto.RegisterType((*IBCHookMemo_Payload)(nil), "band.tunnel.v1beta1.IBCHookMemo.Payload")
 	proto.RegisterType((*IBCHookMemo_Payload_Msg)(nil), "band.tunnel.v1beta1.IBCHookMemo.Payload.Msg")
 	proto.RegisterType((*IBCHookMemo_Payload_Msg_ReceivePacket)(nil), "band.tunnel.v1beta1.IBCHookMemo.Payload.Msg.ReceivePacket")
+	proto.RegisterType((*IBCHookEncodedMemo)(nil), "band.tunnel.v1beta1.IBCHookEncodedMemo")
+	proto.RegisterType((*IBCHookEncodedMemo_Payload)(nil), "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload")
+	proto.RegisterType((*IBCHookEncodedMemo_Payload_Msg)(nil), "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg")
+	proto.RegisterType((*IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket)(nil), "band.tunnel.v1beta1.IBCHookEncodedMemo.Payload.Msg.ReceiveEncodedPacket")
 	proto.RegisterType((*RouterRoute)(nil), "band.tunnel.v1beta1.RouterRoute")
 	proto.RegisterType((*RouterPacketReceipt)(nil), "band.tunnel.v1beta1.RouterPacketReceipt")
 	proto.RegisterType((*RouterMemo)(nil), "band.tunnel.v1beta1.RouterMemo")
@@ -1060,72 +1466,89 @@ func init() {
 	proto.RegisterType((*RouterMemo_Payload_Msg_ReceiveBandDataArgs)(nil), "band.tunnel.v1beta1.RouterMemo.Payload.Msg.ReceiveBandDataArgs")
 	proto.RegisterType((*AxelarRoute)(nil), "band.tunnel.v1beta1.AxelarRoute")
 	proto.RegisterType((*AxelarPacketReceipt)(nil), "band.tunnel.v1beta1.AxelarPacketReceipt")
+	proto.RegisterType((*LocalRoute)(nil), "band.tunnel.v1beta1.LocalRoute")
+	proto.RegisterType((*LocalPacketReceipt)(nil), "band.tunnel.v1beta1.LocalPacketReceipt")
+	proto.RegisterType((*FailedPacketReceipt)(nil), "band.tunnel.v1beta1.FailedPacketReceipt")
 }
 
 func init() { proto.RegisterFile("band/tunnel/v1beta1/route.proto", fileDescriptor_543238289d94b7a6) }
 
 var fileDescriptor_543238289d94b7a6 = []byte{
-	// 958 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x66, 0xad, 0xc4, 0x7e, 0x6e, 0xd2, 0xb2, 0x09, 0x95, 0xeb, 0x82, 0x6d, 0x99, 0x03,
-	0x46, 0x6d, 0x77, 0x15, 0x47, 0x08, 0x29, 0x17, 0xf0, 0xda, 0x85, 0xae, 0x20, 0x28, 0x5a, 0x87,
-	0x4b, 0x2f, 0xd6, 0x78, 0x67, 0xb2, 0x59, 0x6a, 0xef, 0xb8, 0x3b, 0x93, 0xd0, 0xfe, 0x0b, 0x88,
-	0x03, 0xe2, 0xce, 0x9d, 0x23, 0x87, 0x22, 0xf8, 0x13, 0xaa, 0x9c, 0x7a, 0x84, 0x8b, 0x85, 0x1c,
-	0x71, 0xe6, 0xde, 0x13, 0x9a, 0x1f, 0xeb, 0x78, 0xad, 0x05, 0xa5, 0x8d, 0x90, 0x7a, 0xf3, 0xbc,
-	0xf7, 0xcd, 0xf7, 0xe6, 0x7b, 0xf3, 0xcd, 0xf3, 0x42, 0x7d, 0x88, 0x62, 0xec, 0xf0, 0x93, 0x38,
-	0x26, 0x23, 0xe7, 0x74, 0x67, 0x48, 0x38, 0xda, 0x71, 0x12, 0x7a, 0xc2, 0x89, 0x3d, 0x49, 0x28,
-	0xa7, 0xd6, 0x96, 0x00, 0xd8, 0x0a, 0x60, 0x6b, 0x40, 0xf5, 0x56, 0x40, 0xd9, 0x98, 0xb2, 0x81,
-	0x84, 0x38, 0x6a, 0xa1, 0xf0, 0xd5, 0x9a, 0x5a, 0x39, 0x43, 0xc4, 0xc8, 0x9c, 0x30, 0xa0, 0x51,
-	0xac, 0xf3, 0xdb, 0x21, 0x0d, 0xa9, 0xda, 0x27, 0x7e, 0xe9, 0x68, 0x43, 0x1e, 0xe3, 0x88, 0x10,
-	0xcc, 0xe6, 0x9b, 0x48, 0x1c, 0x50, 0x4c, 0x92, 0x94, 0x37, 0x07, 0x21, 0x57, 0x2a, 0xdf, 0xfc,
-	0xc3, 0x80, 0xe2, 0x61, 0xbf, 0xef, 0x8b, 0xa3, 0x5b, 0x0f, 0x60, 0x1b, 0x13, 0xc6, 0xa3, 0x18,
-	0xf1, 0x88, 0xc6, 0x83, 0xe0, 0x18, 0x45, 0xf1, 0x20, 0xc2, 0x15, 0xa3, 0x61, 0xb4, 0x4a, 0xee,
-	0xcd, 0xd9, 0xb4, 0x6e, 0xf5, 0x2e, 0xf2, 0x5d, 0x91, 0xf6, 0x7a, 0xbe, 0x85, 0x97, 0x63, 0xd8,
-	0xfa, 0x04, 0xde, 0xc9, 0x30, 0xd1, 0x98, 0x27, 0x28, 0xe0, 0x03, 0x84, 0x71, 0x42, 0x18, 0xab,
-	0xac, 0x0a, 0x46, 0xbf, 0xba, 0xb8, 0x53, 0x43, 0x3a, 0x0a, 0x61, 0x7d, 0x08, 0xeb, 0x5a, 0x49,
-	0xc5, 0x6c, 0x18, 0xad, 0xcd, 0xf6, 0x6d, 0x5b, 0xb6, 0x54, 0x1d, 0x5e, 0x4b, 0xb1, 0xef, 0x2b,
-	0x88, 0x9f, 0x62, 0xf7, 0xe0, 0xec, 0xd9, 0xbd, 0x35, 0xa9, 0xc6, 0x6b, 0xfe, 0x60, 0xc0, 0x8d,
-	0xc3, 0x7e, 0xff, 0x00, 0x05, 0x8f, 0x08, 0xf7, 0x49, 0x40, 0xa2, 0x09, 0xb7, 0xbe, 0x06, 0x60,
-	0x51, 0x18, 0x47, 0x71, 0x98, 0x2a, 0x2b, 0xb8, 0x9f, 0xcf, 0xa6, 0xf5, 0x52, 0x5f, 0x45, 0xbd,
-	0xde, 0xcb, 0x69, 0x7d, 0x2f, 0x8c, 0xf8, 0xf1, 0xc9, 0xd0, 0x0e, 0xe8, 0xd8, 0x11, 0x55, 0x65,
-	0xaf, 0x02, 0x3a, 0x72, 0x64, 0x4b, 0x9c, 0xd3, 0x5d, 0xe7, 0x89, 0x8c, 0x73, 0xc6, 0x1c, 0xfe,
-	0x74, 0x42, 0x98, 0x3d, 0xdf, 0xed, 0x97, 0x34, 0xbd, 0x87, 0xf7, 0xac, 0xb3, 0x67, 0xf7, 0x36,
-	0x33, 0xe5, 0xbd, 0x66, 0x0f, 0x8a, 0x9e, 0xdb, 0x55, 0xfd, 0xbe, 0x0b, 0x10, 0x1c, 0x23, 0x61,
-	0x91, 0x8b, 0x2e, 0x6f, 0x88, 0xb3, 0x74, 0x55, 0x54, 0xb0, 0x69, 0x80, 0x87, 0x33, 0xd2, 0x5c,
-	0xb8, 0xe1, 0xb9, 0xdd, 0xac, 0xb2, 0x2a, 0x14, 0x19, 0x79, 0x7c, 0x42, 0xe2, 0x80, 0x28, 0x5d,
-	0xfe, 0x7c, 0x9d, 0x7b, 0x92, 0x5f, 0x0d, 0xb8, 0x79, 0x28, 0x0d, 0x7a, 0x90, 0x44, 0x01, 0x61,
-	0x2a, 0xdd, 0x43, 0x1c, 0x59, 0x1f, 0x40, 0x49, 0x59, 0xf7, 0xa2, 0x47, 0xd7, 0x66, 0xd3, 0x7a,
-	0x51, 0xc1, 0xbd, 0x9e, 0x5f, 0x54, 0x69, 0x0f, 0x67, 0xaa, 0xae, 0x66, 0xab, 0x5a, 0x1f, 0xc1,
-	0xda, 0x44, 0x52, 0x57, 0xcc, 0x86, 0xd9, 0x2a, 0xb7, 0x6f, 0xe5, 0x5d, 0xa1, 0x2c, 0xee, 0x16,
-	0x9e, 0x4f, 0xeb, 0x2b, 0xbe, 0x86, 0x5b, 0xef, 0x02, 0x04, 0x09, 0x41, 0x9c, 0xe0, 0x01, 0xe2,
-	0x95, 0x42, 0xc3, 0x68, 0x99, 0x7e, 0x49, 0x47, 0x3a, 0xbc, 0xf9, 0xad, 0x01, 0xd7, 0x3c, 0xb7,
-	0xfb, 0x80, 0xd2, 0x47, 0xaf, 0xd1, 0xc8, 0xab, 0x9b, 0x33, 0x73, 0x15, 0x9f, 0xc2, 0xb6, 0x3e,
-	0xcb, 0x6b, 0x5d, 0x87, 0xa0, 0x26, 0x31, 0xf7, 0x9a, 0xdf, 0x99, 0x50, 0xd6, 0x44, 0xfb, 0x64,
-	0x4c, 0x2d, 0x17, 0x0a, 0xdf, 0x20, 0x36, 0x96, 0x7b, 0xcb, 0xed, 0x96, 0x9d, 0x33, 0x50, 0xec,
-	0x05, 0xbc, 0x7d, 0x80, 0x9e, 0x8e, 0x28, 0xc2, 0xba, 0x93, 0x72, 0x6f, 0xf5, 0xb7, 0x55, 0x58,
-	0xd7, 0x71, 0x71, 0x9e, 0x54, 0xa9, 0xea, 0x90, 0x3f, 0x5f, 0x5b, 0x3d, 0x30, 0xc7, 0x2c, 0x94,
-	0xc2, 0xcb, 0xed, 0xbb, 0x97, 0x2d, 0x65, 0xef, 0xb3, 0x50, 0x97, 0x13, 0xdb, 0xab, 0x67, 0x06,
-	0x98, 0xfb, 0x2c, 0xb4, 0x42, 0xd8, 0x4c, 0x44, 0x13, 0x4e, 0xc9, 0x60, 0x22, 0x35, 0x6a, 0x0d,
-	0x7b, 0xaf, 0x42, 0x6c, 0xfb, 0x8a, 0x42, 0x75, 0x49, 0x97, 0xd9, 0x48, 0x16, 0x83, 0xd5, 0x87,
-	0xb0, 0x91, 0x41, 0x59, 0x1e, 0xac, 0x65, 0x2a, 0xde, 0xc9, 0xad, 0x98, 0x6f, 0xfa, 0xb9, 0x05,
-	0x65, 0x44, 0x0c, 0xc6, 0xb2, 0xbc, 0xe1, 0xe4, 0xcd, 0x9b, 0x8d, 0x6d, 0x78, 0x7b, 0x91, 0x21,
-	0x44, 0x6c, 0x30, 0x8a, 0xc6, 0x11, 0x97, 0x93, 0xb2, 0xe0, 0x6f, 0x2d, 0x24, 0x3f, 0x43, 0xec,
-	0x0b, 0x91, 0xca, 0x58, 0xf6, 0x3e, 0x6c, 0x29, 0x69, 0x57, 0x1b, 0x20, 0x7f, 0x9b, 0x00, 0x8a,
-	0x47, 0x1a, 0xb6, 0x93, 0x31, 0xec, 0xfb, 0xb9, 0xad, 0xbf, 0x80, 0xe7, 0xfa, 0xf5, 0x47, 0xf3,
-	0x72, 0x7e, 0xed, 0x2e, 0xfa, 0xf5, 0xce, 0x25, 0x2b, 0x2d, 0xdb, 0xf5, 0xe7, 0x55, 0x65, 0xd7,
-	0xc7, 0xf0, 0x56, 0x6a, 0x57, 0x41, 0x34, 0xc0, 0x88, 0x23, 0x2d, 0xe2, 0xe3, 0x57, 0xa0, 0x4e,
-	0x0d, 0xeb, 0xa2, 0x18, 0x0b, 0x3f, 0x75, 0x92, 0x90, 0xe9, 0x72, 0xd7, 0x93, 0x6c, 0xaa, 0xfa,
-	0x8b, 0x01, 0x5b, 0x39, 0x70, 0x6b, 0x17, 0x36, 0xc4, 0xdd, 0x2d, 0xbb, 0xeb, 0xfa, 0x6c, 0x5a,
-	0x2f, 0x0b, 0x77, 0xa5, 0xb6, 0x2a, 0xe3, 0xf9, 0x02, 0xa7, 0x6e, 0xf8, 0x37, 0x23, 0x49, 0x37,
-	0x2c, 0x3b, 0xe8, 0x36, 0x94, 0x96, 0x5d, 0x53, 0x0c, 0xb5, 0x55, 0xac, 0x0a, 0xac, 0x4f, 0x94,
-	0x2e, 0x39, 0x7a, 0x4b, 0x7e, 0xba, 0x6c, 0xfe, 0x65, 0x40, 0xb9, 0xf3, 0x84, 0x8c, 0x90, 0x7e,
-	0x14, 0x5f, 0xfd, 0xe7, 0xa3, 0x78, 0x2f, 0xff, 0x51, 0xbc, 0x54, 0x73, 0x39, 0x8a, 0xbf, 0x44,
-	0x63, 0xf2, 0x3f, 0xbd, 0x90, 0x1d, 0x30, 0x8f, 0x08, 0x91, 0xca, 0xc4, 0xdf, 0x8e, 0xfe, 0xd4,
-	0x12, 0x1f, 0x57, 0xf3, 0x5b, 0xec, 0xd2, 0x28, 0x4e, 0xed, 0x70, 0x44, 0xc8, 0xf2, 0x03, 0x51,
-	0x32, 0xaf, 0xf4, 0x40, 0xdc, 0xfd, 0x9f, 0x66, 0x35, 0xe3, 0xf9, 0xac, 0x66, 0xbc, 0x98, 0xd5,
-	0x8c, 0x3f, 0x67, 0x35, 0xe3, 0xfb, 0xf3, 0xda, 0xca, 0x8b, 0xf3, 0xda, 0xca, 0xef, 0xe7, 0xb5,
-	0x95, 0x87, 0xce, 0x25, 0x3e, 0x32, 0xf4, 0x17, 0xa6, 0xfc, 0xc6, 0x18, 0xae, 0x49, 0xc4, 0xee,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x43, 0x69, 0xbb, 0xf6, 0x7d, 0x0a, 0x00, 0x00,
+	// 1176 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x3b, 0x6c, 0xdb, 0xc6,
+	0x1b, 0x37, 0x25, 0xc5, 0x96, 0x3e, 0xc5, 0x49, 0xfe, 0xb4, 0xfe, 0x86, 0xac, 0xb4, 0x92, 0xa1,
+	0x0c, 0x75, 0x91, 0x84, 0x84, 0xed, 0x16, 0x05, 0xbc, 0xb4, 0x7a, 0x24, 0x35, 0x91, 0xb8, 0x30,
+	0x68, 0x67, 0xc9, 0x42, 0x9c, 0x78, 0x67, 0x9a, 0x8d, 0x44, 0x2a, 0xbc, 0x93, 0x9b, 0xac, 0x45,
+	0xc7, 0xa2, 0x08, 0xba, 0x77, 0xef, 0xd8, 0x21, 0x45, 0xbb, 0x76, 0x0b, 0x8c, 0x0e, 0x19, 0x3b,
+	0x09, 0x85, 0x8c, 0x2e, 0x5d, 0x3a, 0x75, 0xc9, 0x54, 0xdc, 0x83, 0x32, 0x29, 0xb0, 0x81, 0xed,
+	0xa0, 0x68, 0x37, 0x7d, 0x8f, 0xfb, 0x5e, 0xbf, 0xef, 0xc7, 0x3b, 0x41, 0xa3, 0x87, 0x02, 0x6c,
+	0xb2, 0x51, 0x10, 0x90, 0xbe, 0x79, 0xb4, 0xde, 0x23, 0x0c, 0xad, 0x9b, 0x51, 0x38, 0x62, 0xc4,
+	0x18, 0x46, 0x21, 0x0b, 0xf5, 0x25, 0xee, 0x60, 0x48, 0x07, 0x43, 0x39, 0xd4, 0x56, 0xdc, 0x90,
+	0x0e, 0x42, 0xea, 0x08, 0x17, 0x53, 0x0a, 0xd2, 0xbf, 0x56, 0x97, 0x92, 0xd9, 0x43, 0x94, 0x4c,
+	0x03, 0xba, 0xa1, 0x1f, 0x28, 0x7b, 0xc5, 0x0b, 0xbd, 0x50, 0x9e, 0xe3, 0xbf, 0x94, 0x76, 0x55,
+	0x94, 0x71, 0x40, 0x08, 0xa6, 0xd3, 0x43, 0x24, 0x70, 0x43, 0x4c, 0xa2, 0x38, 0x6e, 0x86, 0x87,
+	0x90, 0xa4, 0xbd, 0xf9, 0x55, 0x0e, 0x8a, 0xfb, 0x7b, 0x7b, 0x36, 0x2f, 0x5d, 0xdf, 0x86, 0x0a,
+	0x26, 0x94, 0xf9, 0x01, 0x62, 0x7e, 0x18, 0x38, 0xee, 0x21, 0xf2, 0x03, 0xc7, 0xc7, 0x55, 0x6d,
+	0x55, 0x5b, 0x2b, 0xb5, 0x97, 0x27, 0xe3, 0x86, 0xde, 0x3d, 0xb5, 0x77, 0xb8, 0xd9, 0xea, 0xda,
+	0x3a, 0x9e, 0xd5, 0x61, 0xfd, 0x23, 0x78, 0x2b, 0x15, 0x29, 0x0c, 0x58, 0x84, 0x5c, 0xe6, 0x20,
+	0x8c, 0x23, 0x42, 0x69, 0x35, 0xc7, 0x23, 0xda, 0xb5, 0xe4, 0x49, 0xe5, 0xd2, 0x92, 0x1e, 0xfa,
+	0xfb, 0xb0, 0xa0, 0x3a, 0xa9, 0xe6, 0x57, 0xb5, 0xb5, 0x2b, 0x1b, 0xd7, 0x0d, 0x31, 0x52, 0x59,
+	0xbc, 0x6a, 0xc5, 0xb8, 0x23, 0x5d, 0xec, 0xd8, 0x57, 0xaf, 0xc0, 0xa5, 0x1e, 0x62, 0xee, 0x61,
+	0xb5, 0xb0, 0xaa, 0xad, 0x15, 0x6d, 0x29, 0xe8, 0x6f, 0x03, 0x78, 0x51, 0x38, 0x1a, 0x3a, 0x01,
+	0x1a, 0x90, 0xea, 0x25, 0x91, 0xbc, 0x24, 0x34, 0x9f, 0xa0, 0x01, 0xd9, 0x82, 0xe3, 0xe7, 0xb7,
+	0xe7, 0xc5, 0x08, 0xac, 0xe6, 0xd7, 0x1a, 0x5c, 0xdb, 0xdf, 0xdb, 0xdb, 0x45, 0xee, 0x23, 0xc2,
+	0x6c, 0xe2, 0x12, 0x7f, 0xc8, 0xf4, 0x4f, 0x01, 0xa8, 0xef, 0x05, 0x7e, 0xe0, 0xc5, 0xe3, 0x28,
+	0xb4, 0xef, 0x4d, 0xc6, 0x8d, 0xd2, 0x9e, 0xd4, 0x5a, 0xdd, 0x57, 0xe3, 0xc6, 0x96, 0xe7, 0xb3,
+	0xc3, 0x51, 0xcf, 0x70, 0xc3, 0x81, 0xc9, 0x4b, 0x15, 0x03, 0x76, 0xc3, 0xbe, 0x29, 0xe6, 0x68,
+	0x1e, 0x6d, 0x9a, 0x4f, 0x84, 0x9e, 0x51, 0x6a, 0xb2, 0xa7, 0x43, 0x42, 0x8d, 0xe9, 0x69, 0xbb,
+	0xa4, 0xc2, 0x5b, 0x78, 0x4b, 0x3f, 0x7e, 0x7e, 0xfb, 0x4a, 0x2a, 0xbd, 0xd5, 0xec, 0x42, 0xd1,
+	0x6a, 0x77, 0x24, 0x48, 0xb7, 0x00, 0xdc, 0x43, 0xc4, 0xf7, 0xea, 0x14, 0x9a, 0x45, 0x5e, 0x4b,
+	0x47, 0x6a, 0x79, 0x34, 0xe5, 0x60, 0xe1, 0x54, 0x6b, 0x6d, 0xb8, 0x66, 0xb5, 0x3b, 0xe9, 0xce,
+	0x6a, 0x50, 0xa4, 0xe4, 0xf1, 0x88, 0x04, 0x2e, 0x91, 0x7d, 0xd9, 0x53, 0x39, 0xb3, 0x92, 0x1f,
+	0x34, 0x58, 0xde, 0x17, 0x5b, 0xbd, 0x1b, 0xf9, 0x2e, 0xa1, 0xd2, 0xdc, 0x45, 0x0c, 0xe9, 0xef,
+	0x42, 0x89, 0x8d, 0x92, 0x75, 0x15, 0xda, 0x97, 0x27, 0xe3, 0x46, 0x51, 0xba, 0x5b, 0x5d, 0xbb,
+	0x28, 0xcd, 0x16, 0x4e, 0x65, 0xcd, 0xa5, 0xb3, 0xea, 0x1f, 0xc0, 0xfc, 0x50, 0x84, 0xae, 0xe6,
+	0x57, 0xf3, 0x6b, 0xe5, 0x8d, 0x95, 0x2c, 0xdc, 0x45, 0xf2, 0x76, 0xe1, 0xc5, 0xb8, 0x31, 0x67,
+	0x2b, 0x77, 0x0e, 0xb2, 0x1b, 0x11, 0xc4, 0x08, 0x76, 0x10, 0x13, 0xf8, 0xe7, 0xed, 0x92, 0xd2,
+	0xb4, 0x58, 0xf3, 0x27, 0x0d, 0x2e, 0x5b, 0xed, 0xce, 0x76, 0x18, 0x3e, 0xba, 0xc0, 0x20, 0xff,
+	0xb5, 0x8d, 0x4e, 0x21, 0x78, 0x17, 0x2a, 0xaa, 0x85, 0x0b, 0xa1, 0xc8, 0x2b, 0x22, 0x01, 0xb3,
+	0x9a, 0x5f, 0xe6, 0xa1, 0xac, 0x02, 0xed, 0x90, 0x41, 0xa8, 0xb7, 0xa1, 0xf0, 0x19, 0xa2, 0x03,
+	0x71, 0xb6, 0xbc, 0xb1, 0x66, 0x64, 0x7c, 0xbc, 0x8c, 0x84, 0xbf, 0xb1, 0x8b, 0x9e, 0xf6, 0x43,
+	0x84, 0x15, 0x00, 0xe2, 0x6c, 0xed, 0xc7, 0x1c, 0x2c, 0x28, 0x3d, 0xaf, 0x27, 0x1e, 0x90, 0x1c,
+	0xac, 0x3d, 0x95, 0xf5, 0x2e, 0xe4, 0x07, 0xd4, 0x13, 0xf3, 0x2a, 0x6f, 0xdc, 0x3a, 0x6b, 0x2a,
+	0x63, 0x87, 0x7a, 0x2a, 0x1d, 0x3f, 0x5e, 0x3b, 0xd6, 0x20, 0xbf, 0x43, 0x3d, 0xdd, 0x83, 0x2b,
+	0x11, 0x1f, 0xc2, 0x11, 0x71, 0x86, 0xa2, 0x47, 0xd5, 0xc3, 0xd6, 0x79, 0x02, 0x1b, 0xb6, 0x0c,
+	0x21, 0xa7, 0xa4, 0xd2, 0x2c, 0x46, 0x49, 0x65, 0xed, 0x21, 0x2c, 0xa6, 0xbc, 0x74, 0x0b, 0xe6,
+	0x53, 0x19, 0x6f, 0x66, 0x66, 0xcc, 0xe6, 0xca, 0x74, 0x73, 0x85, 0xa6, 0xf9, 0x73, 0x1e, 0x74,
+	0x55, 0x9a, 0x84, 0x1f, 0x0b, 0x54, 0xac, 0x14, 0x2a, 0xe6, 0xeb, 0x3a, 0x4a, 0x1c, 0xcb, 0x04,
+	0xe7, 0xf7, 0x33, 0x82, 0x73, 0x2f, 0x09, 0xce, 0xe6, 0x39, 0x33, 0xce, 0x62, 0xf4, 0xa7, 0xc2,
+	0xe8, 0x0b, 0x0d, 0x96, 0x63, 0x90, 0xe4, 0x56, 0xe3, 0x34, 0x58, 0xdb, 0x17, 0x48, 0x14, 0x63,
+	0xa6, 0xcc, 0x29, 0xe8, 0x2a, 0x51, 0x86, 0xad, 0xe6, 0x41, 0x25, 0xeb, 0x4c, 0x92, 0x97, 0xda,
+	0x39, 0x6e, 0x9a, 0x2a, 0x2c, 0x0c, 0x65, 0x39, 0x8a, 0xfb, 0xb1, 0xd8, 0x7c, 0x96, 0x83, 0xb2,
+	0x20, 0x6c, 0xf4, 0xdf, 0xbb, 0x56, 0x37, 0xe0, 0xff, 0xc9, 0x08, 0x1e, 0xa2, 0x4e, 0xdf, 0x1f,
+	0xf8, 0x4c, 0x7c, 0x92, 0x0a, 0xf6, 0x52, 0xc2, 0xf8, 0x31, 0xa2, 0xf7, 0xb9, 0x29, 0x39, 0xa0,
+	0xc2, 0x05, 0x3f, 0x5c, 0x77, 0x60, 0x49, 0xfc, 0x8a, 0xde, 0xec, 0xf6, 0xf9, 0x23, 0x0f, 0x20,
+	0xe3, 0x08, 0x82, 0xb4, 0x52, 0x04, 0x79, 0x27, 0x73, 0x8b, 0x4e, 0xdd, 0x33, 0x89, 0xf1, 0x4d,
+	0xfe, 0x6c, 0xc4, 0xe8, 0x24, 0x89, 0x71, 0xf3, 0x8c, 0x99, 0x66, 0x09, 0xf1, 0x5d, 0x4e, 0x12,
+	0xe2, 0x31, 0xfc, 0x2f, 0xe6, 0x03, 0x0f, 0xe4, 0x60, 0xc4, 0x90, 0x6a, 0xe2, 0xc3, 0x73, 0x84,
+	0x8e, 0x29, 0xd0, 0x46, 0x01, 0xe6, 0x5f, 0x95, 0x56, 0xe4, 0x51, 0x95, 0xee, 0x6a, 0x94, 0x36,
+	0xd5, 0xbe, 0xd7, 0x60, 0x29, 0xc3, 0x5d, 0xdf, 0x84, 0x45, 0x0e, 0xf9, 0xec, 0x52, 0x5e, 0x9d,
+	0x8c, 0x1b, 0x65, 0xbe, 0x94, 0xf1, 0x36, 0x96, 0xf1, 0x54, 0xc0, 0xf1, 0x12, 0xfd, 0xdd, 0xfe,
+	0x89, 0x25, 0x9a, 0x5d, 0xbc, 0xeb, 0x50, 0x9a, 0x5d, 0xb6, 0xa2, 0x17, 0x6f, 0x58, 0x82, 0x4b,
+	0x85, 0x34, 0x97, 0x7e, 0xd3, 0xa0, 0xdc, 0x7a, 0x42, 0xfa, 0x48, 0x71, 0xe9, 0xc1, 0x6b, 0xb9,
+	0x74, 0x23, 0x9b, 0x4b, 0xaf, 0xe4, 0xa5, 0xee, 0x07, 0xfc, 0xb5, 0xf7, 0x0f, 0x11, 0x6b, 0x1d,
+	0xf2, 0x07, 0x84, 0x88, 0xce, 0xf8, 0x9b, 0x45, 0x3d, 0xee, 0xf9, 0x73, 0x7e, 0x8a, 0x62, 0x27,
+	0xf4, 0x83, 0x78, 0x1d, 0x0e, 0x08, 0x99, 0x25, 0x88, 0x6c, 0xf3, 0xcd, 0x08, 0xf2, 0x1e, 0xc0,
+	0xfd, 0xd0, 0x45, 0x7d, 0x39, 0x2c, 0xb9, 0xd0, 0x74, 0x34, 0x20, 0x51, 0x62, 0xa1, 0x85, 0x9c,
+	0x4a, 0xfe, 0xb9, 0x06, 0xba, 0x38, 0x96, 0x4e, 0x5e, 0x85, 0x05, 0x3a, 0x72, 0x5d, 0xde, 0xbf,
+	0x26, 0x5e, 0xd3, 0xb1, 0xa8, 0xaf, 0x00, 0xc7, 0xce, 0x19, 0x51, 0x82, 0xd5, 0xfb, 0x6d, 0xc1,
+	0x43, 0xf4, 0x01, 0x25, 0x58, 0xbf, 0x01, 0x8b, 0x24, 0x8a, 0xc2, 0xc8, 0x19, 0x10, 0x4a, 0x91,
+	0x27, 0x27, 0x52, 0xb2, 0x2f, 0x0b, 0xe5, 0x8e, 0xd4, 0x65, 0x96, 0xde, 0x82, 0xa5, 0xbb, 0xc8,
+	0xef, 0x13, 0x9c, 0xd2, 0xeb, 0xcb, 0x30, 0x1f, 0x11, 0x44, 0xc3, 0x40, 0x75, 0xa0, 0xa4, 0xac,
+	0x10, 0xed, 0x9d, 0x6f, 0x27, 0x75, 0xed, 0xc5, 0xa4, 0xae, 0xbd, 0x9c, 0xd4, 0xb5, 0x5f, 0x27,
+	0x75, 0xed, 0xd9, 0x49, 0x7d, 0xee, 0xe5, 0x49, 0x7d, 0xee, 0x97, 0x93, 0xfa, 0xdc, 0x43, 0xf3,
+	0x0c, 0xef, 0x73, 0xf5, 0x8f, 0x4e, 0x3c, 0xcf, 0x7b, 0xf3, 0xc2, 0x63, 0xf3, 0xaf, 0x01, 0x00,
+	0x24, 0x72, 0x99, 0x7e, 0xed, 0x0d, 0x00, 0x00,
 }
 
 func (this *TSSRoute) Equal(that interface{}) bool {
@@ -1156,6 +1579,12 @@ func (this *TSSRoute) Equal(that interface{}) bool {
 	if this.Encoder != that1.Encoder {
 		return false
 	}
+	if this.Batch != that1.Batch {
+		return false
+	}
+	if this.GroupName != that1.GroupName {
+		return false
+	}
 	return true
 }
 func (this *TSSPacketReceipt) Equal(that interface{}) bool {
@@ -1293,6 +1722,9 @@ func (this *IBCHookRoute) Equal(that interface{}) bool {
 	if this.DestinationContractAddress != that1.DestinationContractAddress {
 		return false
 	}
+	if this.Encoder != that1.Encoder {
+		return false
+	}
 	return true
 }
 func (this *IBCHookPacketReceipt) Equal(that interface{}) bool {
@@ -1418,14 +1850,14 @@ func (this *IBCHookMemo_Payload_Msg_ReceivePacket) Equal(that interface{}) bool
 	}
 	return true
 }
-func (this *RouterRoute) Equal(that interface{}) bool {
+func (this *IBCHookEncodedMemo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RouterRoute)
+	that1, ok := that.(*IBCHookEncodedMemo)
 	if !ok {
-		that2, ok := that.(RouterRoute)
+		that2, ok := that.(IBCHookEncodedMemo)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1437,15 +1869,120 @@ func (this *RouterRoute) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.DestinationChainID != that1.DestinationChainID {
+	if !this.Wasm.Equal(&that1.Wasm) {
 		return false
 	}
-	if this.DestinationContractAddress != that1.DestinationContractAddress {
+	return true
+}
+func (this *IBCHookEncodedMemo_Payload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IBCHookEncodedMemo_Payload)
+	if !ok {
+		that2, ok := that.(IBCHookEncodedMemo_Payload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if !this.Msg.Equal(&that1.Msg) {
+		return false
+	}
+	return true
+}
+func (this *IBCHookEncodedMemo_Payload_Msg) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IBCHookEncodedMemo_Payload_Msg)
+	if !ok {
+		that2, ok := that.(IBCHookEncodedMemo_Payload_Msg)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ReceiveEncodedPacket.Equal(&that1.ReceiveEncodedPacket) {
+		return false
+	}
+	return true
+}
+func (this *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket)
+	if !ok {
+		that2, ok := that.(IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Encoder != that1.Encoder {
+		return false
+	}
+	if this.Payload != that1.Payload {
+		return false
+	}
+	return true
+}
+func (this *RouterRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RouterRoute)
+	if !ok {
+		that2, ok := that.(RouterRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DestinationChainID != that1.DestinationChainID {
+		return false
+	}
+	if this.DestinationContractAddress != that1.DestinationContractAddress {
 		return false
 	}
 	if this.DestinationGasLimit != that1.DestinationGasLimit {
 		return false
 	}
+	if this.Encoder != that1.Encoder {
+		return false
+	}
 	return true
 }
 func (this *RouterPacketReceipt) Equal(that interface{}) bool {
@@ -1634,6 +2171,84 @@ func (this *AxelarPacketReceipt) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *LocalRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LocalRoute)
+	if !ok {
+		that2, ok := that.(LocalRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Consumer != that1.Consumer {
+		return false
+	}
+	return true
+}
+func (this *LocalPacketReceipt) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LocalPacketReceipt)
+	if !ok {
+		that2, ok := that.(LocalPacketReceipt)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.GasUsed != that1.GasUsed {
+		return false
+	}
+	if this.ErrorMessage != that1.ErrorMessage {
+		return false
+	}
+	return true
+}
+func (this *FailedPacketReceipt) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FailedPacketReceipt)
+	if !ok {
+		that2, ok := that.(FailedPacketReceipt)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
 func (m *TSSRoute) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1654,6 +2269,23 @@ func (m *TSSRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintRoute(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Batch {
+		i--
+		if m.Batch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Encoder != 0 {
 		i = encodeVarintRoute(dAtA, i, uint64(m.Encoder))
 		i--
@@ -1834,6 +2466,11 @@ func (m *IBCHookRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Encoder != 0 {
+		i = encodeVarintRoute(dAtA, i, uint64(m.Encoder))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.DestinationContractAddress) > 0 {
 		i -= len(m.DestinationContractAddress)
 		copy(dAtA[i:], m.DestinationContractAddress)
@@ -2018,77 +2655,7 @@ func (m *IBCHookMemo_Payload_Msg_ReceivePacket) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *RouterRoute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RouterRoute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RouterRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DestinationGasLimit != 0 {
-		i = encodeVarintRoute(dAtA, i, uint64(m.DestinationGasLimit))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.DestinationContractAddress) > 0 {
-		i -= len(m.DestinationContractAddress)
-		copy(dAtA[i:], m.DestinationContractAddress)
-		i = encodeVarintRoute(dAtA, i, uint64(len(m.DestinationContractAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DestinationChainID) > 0 {
-		i -= len(m.DestinationChainID)
-		copy(dAtA[i:], m.DestinationChainID)
-		i = encodeVarintRoute(dAtA, i, uint64(len(m.DestinationChainID)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RouterPacketReceipt) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RouterPacketReceipt) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RouterPacketReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintRoute(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RouterMemo) Marshal() (dAtA []byte, err error) {
+func (m *IBCHookEncodedMemo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2098,12 +2665,12 @@ func (m *RouterMemo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RouterMemo) MarshalTo(dAtA []byte) (int, error) {
+func (m *IBCHookEncodedMemo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RouterMemo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IBCHookEncodedMemo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2121,7 +2688,7 @@ func (m *RouterMemo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RouterMemo_Payload) Marshal() (dAtA []byte, err error) {
+func (m *IBCHookEncodedMemo_Payload) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2131,12 +2698,12 @@ func (m *RouterMemo_Payload) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RouterMemo_Payload) MarshalTo(dAtA []byte) (int, error) {
+func (m *IBCHookEncodedMemo_Payload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RouterMemo_Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IBCHookEncodedMemo_Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2161,7 +2728,7 @@ func (m *RouterMemo_Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RouterMemo_Payload_Msg) Marshal() (dAtA []byte, err error) {
+func (m *IBCHookEncodedMemo_Payload_Msg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2171,18 +2738,18 @@ func (m *RouterMemo_Payload_Msg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RouterMemo_Payload_Msg) MarshalTo(dAtA []byte) (int, error) {
+func (m *IBCHookEncodedMemo_Payload_Msg) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RouterMemo_Payload_Msg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IBCHookEncodedMemo_Payload_Msg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.ReceiveBandData.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ReceiveEncodedPacket.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2194,7 +2761,7 @@ func (m *RouterMemo_Payload_Msg) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *RouterMemo_Payload_Msg_ReceiveBandDataArgs) Marshal() (dAtA []byte, err error) {
+func (m *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2204,12 +2771,228 @@ func (m *RouterMemo_Payload_Msg_ReceiveBandDataArgs) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *RouterMemo_Payload_Msg_ReceiveBandDataArgs) MarshalTo(dAtA []byte) (int, error) {
+func (m *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RouterMemo_Payload_Msg_ReceiveBandDataArgs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintRoute(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Encoder != 0 {
+		i = encodeVarintRoute(dAtA, i, uint64(m.Encoder))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RouterRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouterRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouterRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Encoder != 0 {
+		i = encodeVarintRoute(dAtA, i, uint64(m.Encoder))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DestinationGasLimit != 0 {
+		i = encodeVarintRoute(dAtA, i, uint64(m.DestinationGasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DestinationContractAddress) > 0 {
+		i -= len(m.DestinationContractAddress)
+		copy(dAtA[i:], m.DestinationContractAddress)
+		i = encodeVarintRoute(dAtA, i, uint64(len(m.DestinationContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DestinationChainID) > 0 {
+		i -= len(m.DestinationChainID)
+		copy(dAtA[i:], m.DestinationChainID)
+		i = encodeVarintRoute(dAtA, i, uint64(len(m.DestinationChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RouterPacketReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouterPacketReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouterPacketReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintRoute(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RouterMemo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouterMemo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouterMemo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Wasm.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RouterMemo_Payload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouterMemo_Payload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouterMemo_Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintRoute(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RouterMemo_Payload_Msg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouterMemo_Payload_Msg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouterMemo_Payload_Msg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ReceiveBandData.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RouterMemo_Payload_Msg_ReceiveBandDataArgs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouterMemo_Payload_Msg_ReceiveBandDataArgs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouterMemo_Payload_Msg_ReceiveBandDataArgs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2318,35 +3101,147 @@ func (m *AxelarPacketReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRoute(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRoute(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *LocalRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *TSSRoute) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *LocalRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LocalRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DestinationChainID)
-	if l > 0 {
-		n += 1 + l + sovRoute(uint64(l))
-	}
-	l = len(m.DestinationContractAddress)
-	if l > 0 {
-		n += 1 + l + sovRoute(uint64(l))
-	}
-	if m.Encoder != 0 {
-		n += 1 + sovRoute(uint64(m.Encoder))
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintRoute(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *LocalPacketReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LocalPacketReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LocalPacketReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintRoute(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintRoute(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedPacketReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedPacketReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedPacketReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRoute(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRoute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRoute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TSSRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DestinationChainID)
+	if l > 0 {
+		n += 1 + l + sovRoute(uint64(l))
+	}
+	l = len(m.DestinationContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRoute(uint64(l))
+	}
+	if m.Encoder != 0 {
+		n += 1 + sovRoute(uint64(m.Encoder))
+	}
+	if m.Batch {
+		n += 2
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovRoute(uint64(l))
+	}
+	return n
 }
 
 func (m *TSSPacketReceipt) Size() (n int) {
@@ -2424,6 +3319,9 @@ func (m *IBCHookRoute) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRoute(uint64(l))
 	}
+	if m.Encoder != 0 {
+		n += 1 + sovRoute(uint64(m.Encoder))
+	}
 	return n
 }
 
@@ -2487,6 +3385,59 @@ func (m *IBCHookMemo_Payload_Msg_ReceivePacket) Size() (n int) {
 	return n
 }
 
+func (m *IBCHookEncodedMemo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Wasm.Size()
+	n += 1 + l + sovRoute(uint64(l))
+	return n
+}
+
+func (m *IBCHookEncodedMemo_Payload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovRoute(uint64(l))
+	}
+	l = m.Msg.Size()
+	n += 1 + l + sovRoute(uint64(l))
+	return n
+}
+
+func (m *IBCHookEncodedMemo_Payload_Msg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReceiveEncodedPacket.Size()
+	n += 1 + l + sovRoute(uint64(l))
+	return n
+}
+
+func (m *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Encoder != 0 {
+		n += 1 + sovRoute(uint64(m.Encoder))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovRoute(uint64(l))
+	}
+	return n
+}
+
 func (m *RouterRoute) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2504,6 +3455,9 @@ func (m *RouterRoute) Size() (n int) {
 	if m.DestinationGasLimit != 0 {
 		n += 1 + sovRoute(uint64(m.DestinationGasLimit))
 	}
+	if m.Encoder != 0 {
+		n += 1 + sovRoute(uint64(m.Encoder))
+	}
 	return n
 }
 
@@ -2611,6 +3565,51 @@ func (m *AxelarPacketReceipt) Size() (n int) {
 	return n
 }
 
+func (m *LocalRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovRoute(uint64(l))
+	}
+	return n
+}
+
+func (m *LocalPacketReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovRoute(uint64(m.GasUsed))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovRoute(uint64(l))
+	}
+	return n
+}
+
+func (m *FailedPacketReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRoute(uint64(l))
+	}
+	return n
+}
+
 func sovRoute(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2673,16 +3672,1013 @@ func (m *TSSRoute) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthRoute
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+			}
+			m.Encoder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Encoder |= types.Encoder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Batch = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TSSPacketReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TSSPacketReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TSSPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningID", wireType)
+			}
+			m.SigningID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigningID |= github_com_bandprotocol_chain_v3_x_bandtss_types.SigningID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCPacketReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCPacketReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TunnelPricesPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TunnelPricesPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TunnelPricesPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, types.Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCHookRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCHookRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCHookRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+			}
+			m.Encoder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Encoder |= types.Encoder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCHookPacketReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCHookPacketReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCHookPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCHookMemo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCHookMemo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCHookMemo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Wasm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCHookMemo_Payload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Payload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Payload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCHookMemo_Payload_Msg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Msg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Msg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivePacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReceivePacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCHookMemo_Payload_Msg_ReceivePacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoute
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestinationChainID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReceivePacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReceivePacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRoute
@@ -2692,43 +4688,25 @@ func (m *TSSRoute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRoute
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRoute
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestinationContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
-			}
-			m.Encoder = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRoute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Encoder |= types.Encoder(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRoute(dAtA[iNdEx:])
@@ -2750,7 +4728,7 @@ func (m *TSSRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TSSPacketReceipt) Unmarshal(dAtA []byte) error {
+func (m *IBCHookEncodedMemo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2773,17 +4751,17 @@ func (m *TSSPacketReceipt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TSSPacketReceipt: wiretype end group for non-group")
+			return fmt.Errorf("proto: IBCHookEncodedMemo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TSSPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IBCHookEncodedMemo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
 			}
-			m.SigningID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRoute
@@ -2793,11 +4771,25 @@ func (m *TSSPacketReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SigningID |= github_com_bandprotocol_chain_v3_x_bandtss_types.SigningID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Wasm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRoute(dAtA[iNdEx:])
@@ -2819,7 +4811,7 @@ func (m *TSSPacketReceipt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IBCRoute) Unmarshal(dAtA []byte) error {
+func (m *IBCHookEncodedMemo_Payload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2842,15 +4834,15 @@ func (m *IBCRoute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IBCRoute: wiretype end group for non-group")
+			return fmt.Errorf("proto: Payload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IBCRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Payload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2878,7 +4870,40 @@ func (m *IBCRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2901,7 +4926,7 @@ func (m *IBCRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IBCPacketReceipt) Unmarshal(dAtA []byte) error {
+func (m *IBCHookEncodedMemo_Payload_Msg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2924,17 +4949,17 @@ func (m *IBCPacketReceipt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IBCPacketReceipt: wiretype end group for non-group")
+			return fmt.Errorf("proto: Msg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IBCPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Msg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEncodedPacket", wireType)
 			}
-			m.Sequence = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRoute
@@ -2944,11 +4969,25 @@ func (m *IBCPacketReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReceiveEncodedPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRoute(dAtA[iNdEx:])
@@ -2970,7 +5009,7 @@ func (m *IBCPacketReceipt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TunnelPricesPacketData) Unmarshal(dAtA []byte) error {
+func (m *IBCHookEncodedMemo_Payload_Msg_ReceiveEncodedPacket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2993,17 +5032,17 @@ func (m *TunnelPricesPacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TunnelPricesPacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReceiveEncodedPacket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TunnelPricesPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReceiveEncodedPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
 			}
-			m.TunnelID = 0
+			m.Encoder = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRoute
@@ -3013,35 +5052,16 @@ func (m *TunnelPricesPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TunnelID |= uint64(b&0x7F) << shift
+				m.Encoder |= types.Encoder(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRoute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRoute
@@ -3051,45 +5071,24 @@ func (m *TunnelPricesPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRoute
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRoute
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, types.Price{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Payload = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-			}
-			m.CreatedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRoute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRoute(dAtA[iNdEx:])
@@ -3111,7 +5110,7 @@ func (m *TunnelPricesPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IBCHookRoute) Unmarshal(dAtA []byte) error {
+func (m *RouterRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3134,15 +5133,15 @@ func (m *IBCHookRoute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IBCHookRoute: wiretype end group for non-group")
+			return fmt.Errorf("proto: RouterRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IBCHookRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RouterRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3170,7 +5169,7 @@ func (m *IBCHookRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			m.DestinationChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3202,8 +5201,46 @@ func (m *IBCHookRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestinationContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.DestinationContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationGasLimit", wireType)
+			}
+			m.DestinationGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+			}
+			m.Encoder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Encoder |= types.Encoder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRoute(dAtA[iNdEx:])
@@ -3225,7 +5262,7 @@ func (m *IBCHookRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IBCHookPacketReceipt) Unmarshal(dAtA []byte) error {
+func (m *RouterPacketReceipt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3248,10 +5285,10 @@ func (m *IBCHookPacketReceipt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IBCHookPacketReceipt: wiretype end group for non-group")
+			return fmt.Errorf("proto: RouterPacketReceipt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IBCHookPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RouterPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3294,7 +5331,7 @@ func (m *IBCHookPacketReceipt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IBCHookMemo) Unmarshal(dAtA []byte) error {
+func (m *RouterMemo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3317,10 +5354,10 @@ func (m *IBCHookMemo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IBCHookMemo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RouterMemo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IBCHookMemo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RouterMemo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3377,7 +5414,7 @@ func (m *IBCHookMemo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IBCHookMemo_Payload) Unmarshal(dAtA []byte) error {
+func (m *RouterMemo_Payload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3492,7 +5529,7 @@ func (m *IBCHookMemo_Payload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IBCHookMemo_Payload_Msg) Unmarshal(dAtA []byte) error {
+func (m *RouterMemo_Payload_Msg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3523,90 +5560,7 @@ func (m *IBCHookMemo_Payload_Msg) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceivePacket", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRoute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRoute
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRoute
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ReceivePacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRoute(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRoute
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IBCHookMemo_Payload_Msg_ReceivePacket) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRoute
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReceivePacket: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReceivePacket: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveBandData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3633,7 +5587,7 @@ func (m *IBCHookMemo_Payload_Msg_ReceivePacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReceiveBandData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3658,7 +5612,7 @@ func (m *IBCHookMemo_Payload_Msg_ReceivePacket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RouterRoute) Unmarshal(dAtA []byte) error {
+func (m *RouterMemo_Payload_Msg_ReceiveBandDataArgs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3681,15 +5635,15 @@ func (m *RouterRoute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RouterRoute: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReceiveBandDataArgs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RouterRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReceiveBandDataArgs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3717,11 +5671,11 @@ func (m *RouterRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestinationChainID = string(dAtA[iNdEx:postIndex])
+			m.DestChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3749,82 +5703,13 @@ func (m *RouterRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestinationContractAddress = string(dAtA[iNdEx:postIndex])
+			m.DestContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationGasLimit", wireType)
-			}
-			m.DestinationGasLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRoute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DestinationGasLimit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRoute(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRoute
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RouterPacketReceipt) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRoute
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RouterPacketReceipt: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RouterPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			m.Sequence = 0
+			m.GasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRoute
@@ -3834,66 +5719,16 @@ func (m *RouterPacketReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRoute(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRoute
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RouterMemo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRoute
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RouterMemo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RouterMemo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRoute
@@ -3903,24 +5738,23 @@ func (m *RouterMemo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRoute
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRoute
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Wasm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Payload = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3943,7 +5777,7 @@ func (m *RouterMemo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RouterMemo_Payload) Unmarshal(dAtA []byte) error {
+func (m *AxelarRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3966,15 +5800,15 @@ func (m *RouterMemo_Payload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Payload: wiretype end group for non-group")
+			return fmt.Errorf("proto: AxelarRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Payload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AxelarRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4002,11 +5836,43 @@ func (m *RouterMemo_Payload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.DestinationChainID = ChainName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4033,7 +5899,7 @@ func (m *RouterMemo_Payload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4058,7 +5924,7 @@ func (m *RouterMemo_Payload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RouterMemo_Payload_Msg) Unmarshal(dAtA []byte) error {
+func (m *AxelarPacketReceipt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4081,17 +5947,17 @@ func (m *RouterMemo_Payload_Msg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Msg: wiretype end group for non-group")
+			return fmt.Errorf("proto: AxelarPacketReceipt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Msg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AxelarPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveBandData", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var msglen int
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRoute
@@ -4101,25 +5967,11 @@ func (m *RouterMemo_Payload_Msg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRoute
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRoute
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ReceiveBandData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRoute(dAtA[iNdEx:])
@@ -4141,7 +5993,7 @@ func (m *RouterMemo_Payload_Msg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RouterMemo_Payload_Msg_ReceiveBandDataArgs) Unmarshal(dAtA []byte) error {
+func (m *LocalRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4164,98 +6016,15 @@ func (m *RouterMemo_Payload_Msg_ReceiveBandDataArgs) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReceiveBandDataArgs: wiretype end group for non-group")
+			return fmt.Errorf("proto: LocalRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReceiveBandDataArgs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LocalRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestChainID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRoute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRoute
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRoute
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DestChainID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestContractAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRoute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRoute
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRoute
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DestContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
-			}
-			m.GasLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRoute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasLimit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4283,7 +6052,7 @@ func (m *RouterMemo_Payload_Msg_ReceiveBandDataArgs) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = string(dAtA[iNdEx:postIndex])
+			m.Consumer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4306,7 +6075,7 @@ func (m *RouterMemo_Payload_Msg_ReceiveBandDataArgs) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *AxelarRoute) Unmarshal(dAtA []byte) error {
+func (m *LocalPacketReceipt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4329,17 +6098,17 @@ func (m *AxelarRoute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AxelarRoute: wiretype end group for non-group")
+			return fmt.Errorf("proto: LocalPacketReceipt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AxelarRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LocalPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChainID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRoute
@@ -4349,29 +6118,17 @@ func (m *AxelarRoute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRoute
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRoute
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DestinationChainID = ChainName(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Success = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationContractAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			var stringLen uint64
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRoute
@@ -4381,29 +6138,16 @@ func (m *AxelarRoute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRoute
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRoute
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DestinationContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRoute
@@ -4413,24 +6157,23 @@ func (m *AxelarRoute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRoute
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRoute
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4453,7 +6196,7 @@ func (m *AxelarRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AxelarPacketReceipt) Unmarshal(dAtA []byte) error {
+func (m *FailedPacketReceipt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4476,17 +6219,17 @@ func (m *AxelarPacketReceipt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AxelarPacketReceipt: wiretype end group for non-group")
+			return fmt.Errorf("proto: FailedPacketReceipt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AxelarPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FailedPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.Sequence = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRoute
@@ -4496,11 +6239,24 @@ func (m *AxelarPacketReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRoute(dAtA[iNdEx:])
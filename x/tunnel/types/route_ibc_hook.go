@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 )
 
 var (
@@ -17,10 +19,15 @@ var (
 var _ RouteI = &IBCHookRoute{}
 
 // NewIBCHookRoute creates a new IBCHookRoute instance. It is used to create the IBC hook route data
-func NewIBCHookRoute(channelID, destinationContractAddress string) *IBCHookRoute {
+func NewIBCHookRoute(
+	channelID string,
+	destinationContractAddress string,
+	encoder feedstypes.Encoder,
+) *IBCHookRoute {
 	return &IBCHookRoute{
 		ChannelID:                  channelID,
 		DestinationContractAddress: destinationContractAddress,
+		Encoder:                    encoder,
 	}
 }
 
@@ -36,6 +43,13 @@ func (r *IBCHookRoute) ValidateBasic() error {
 		return fmt.Errorf("destination contract address cannot be empty")
 	}
 
+	// Validate the Encoder if it is set
+	if r.Encoder != feedstypes.ENCODER_UNSPECIFIED {
+		if err := feedstypes.ValidateEncoder(r.Encoder); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
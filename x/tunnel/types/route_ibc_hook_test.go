@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
@@ -48,6 +49,25 @@ func TestIBCHookRoute_ValidateBasic(t *testing.T) {
 			},
 			expErr: false,
 		},
+		{
+			name: "invalid encoder",
+			route: types.IBCHookRoute{
+				ChannelID:                  "channel-1",
+				DestinationContractAddress: "mock17evppqj3qsx7c2z9vhpd3m3r0p9a7ghs6ht4vvtzn4v2xx6vxhhsuqvf0w",
+				Encoder:                    feedstypes.Encoder(99),
+			},
+			expErr: true,
+			errMsg: "invalid encoder: 99: invalid encoder",
+		},
+		{
+			name: "all good with encoder",
+			route: types.IBCHookRoute{
+				ChannelID:                  "channel-1",
+				DestinationContractAddress: "mock17evppqj3qsx7c2z9vhpd3m3r0p9a7ghs6ht4vvtzn4v2xx6vxhhsuqvf0w",
+				Encoder:                    feedstypes.ENCODER_FIXED_POINT_PACKED,
+			},
+			expErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LocalRoute defines the local route for the tunnel module
+var _ RouteI = &LocalRoute{}
+
+// NewLocalRoute creates a new LocalRoute instance.
+func NewLocalRoute(consumer string) *LocalRoute {
+	return &LocalRoute{
+		Consumer: consumer,
+	}
+}
+
+// ValidateBasic validates the LocalRoute
+func (r *LocalRoute) ValidateBasic() error {
+	// Validate the Consumer cannot be empty
+	if r.Consumer == "" {
+		return fmt.Errorf("consumer cannot be empty")
+	}
+
+	// Validate the Consumer format
+	if !sdk.IsAlphaNumeric(r.Consumer) {
+		return fmt.Errorf("consumer can only contain alphanumeric characters")
+	}
+
+	return nil
+}
+
+// NewLocalPacketReceipt creates a new LocalPacketReceipt instance.
+func NewLocalPacketReceipt(success bool, gasUsed uint64, errorMessage string) *LocalPacketReceipt {
+	return &LocalPacketReceipt{
+		Success:      success,
+		GasUsed:      gasUsed,
+		ErrorMessage: errorMessage,
+	}
+}
@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func TestLocalRoute_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		route  types.LocalRoute
+		expErr bool
+		errMsg string
+	}{
+		{
+			name: "empty consumer",
+			route: types.LocalRoute{
+				Consumer: "",
+			},
+			expErr: true,
+			errMsg: "consumer cannot be empty",
+		},
+		{
+			name: "invalid consumer",
+			route: types.LocalRoute{
+				Consumer: "my-consumer",
+			},
+			expErr: true,
+			errMsg: "consumer can only contain alphanumeric characters",
+		},
+		{
+			name: "all good",
+			route: types.LocalRoute{
+				Consumer: "restake",
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.route.ValidateBasic()
+			if tt.expErr {
+				require.Error(t, err)
+				require.Equal(t, tt.errMsg, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConsumerRouter(t *testing.T) {
+	router := types.NewConsumerRouter()
+	router.AddRoute("restake", nil)
+
+	require.True(t, router.HasRoute("restake"))
+	require.False(t, router.HasRoute("oracle"))
+
+	_, found := router.GetRoute("oracle")
+	require.False(t, found)
+
+	require.Panics(t, func() { router.AddRoute("restake", nil) })
+	require.Panics(t, func() { router.AddRoute("my-consumer", nil) })
+
+	router.Seal()
+	require.True(t, router.Sealed())
+	require.Panics(t, func() { router.AddRoute("oracle", nil) })
+	require.Panics(t, func() { router.Seal() })
+}
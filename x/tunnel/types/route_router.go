@@ -1,5 +1,9 @@
 package types
 
+import (
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
 // RouterRoute defines the Router route for the tunnel module
 var _ RouteI = &RouterRoute{}
 
@@ -8,16 +12,25 @@ func NewRouterRoute(
 	destinationChinID string,
 	destinationContractAddress string,
 	destinationGasLimit uint64,
+	encoder feedstypes.Encoder,
 ) *RouterRoute {
 	return &RouterRoute{
 		DestinationChainID:         destinationChinID,
 		DestinationContractAddress: destinationContractAddress,
 		DestinationGasLimit:        destinationGasLimit,
+		Encoder:                    encoder,
 	}
 }
 
 // ValidateBasic validates the RouterRoute
 func (r *RouterRoute) ValidateBasic() error {
+	// Validate the Encoder if it is set
+	if r.Encoder != feedstypes.ENCODER_UNSPECIFIED {
+		if err := feedstypes.ValidateEncoder(r.Encoder); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
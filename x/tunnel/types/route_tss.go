@@ -12,11 +12,15 @@ func NewTSSRoute(
 	destinationChainID string,
 	destinationContractAddress string,
 	encoder feedstypes.Encoder,
+	batch bool,
+	groupName string,
 ) TSSRoute {
 	return TSSRoute{
 		DestinationChainID:         destinationChainID,
 		DestinationContractAddress: destinationContractAddress,
 		Encoder:                    encoder,
+		Batch:                      batch,
+		GroupName:                  groupName,
 	}
 }
 
@@ -34,6 +38,12 @@ func (r *TSSRoute) ValidateBasic() error {
 		return err
 	}
 
+	if r.GroupName != "" {
+		if err := bandtsstypes.ValidateGroupName(r.GroupName); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
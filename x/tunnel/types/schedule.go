@@ -0,0 +1,196 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// scheduleSearchYears is the number of years to search for the next trigger of a schedule.
+const scheduleSearchYears = 5
+
+// scheduleMacros are the supported shorthands of schedule expressions.
+var scheduleMacros = map[string]string{
+	"@hourly":  "0 * * * *",
+	"@daily":   "0 0 * * *",
+	"@weekly":  "0 0 * * 0",
+	"@monthly": "0 0 1 * *",
+}
+
+// scheduleField defines the valid range of a field of a schedule expression.
+type scheduleField struct {
+	name string
+	min  uint64
+	max  uint64
+}
+
+var scheduleFields = []scheduleField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 6},
+}
+
+// Schedule is a parsed cron expression evaluated in UTC. Each field is a bit set of the allowed values.
+type Schedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+	// anyDayOfMonth and anyDayOfWeek tell whether the day fields are wildcards. If both day fields are
+	// restricted, a day matches if either of them matches.
+	anyDayOfMonth bool
+	anyDayOfWeek  bool
+}
+
+// ParseSchedule parses a standard five-field cron expression (minute, hour, day of month, month and
+// day of week) or one of the @hourly, @daily, @weekly and @monthly shorthands.
+func ParseSchedule(expr string) (Schedule, error) {
+	if macro, ok := scheduleMacros[expr]; ok {
+		expr = macro
+	}
+
+	parts := strings.Fields(expr)
+	if len(parts) != len(scheduleFields) {
+		return Schedule{}, fmt.Errorf("schedule must have %d fields: %s", len(scheduleFields), expr)
+	}
+
+	bits := make([]uint64, len(parts))
+	for i, part := range parts {
+		b, err := parseScheduleField(part, scheduleFields[i])
+		if err != nil {
+			return Schedule{}, err
+		}
+		bits[i] = b
+	}
+
+	schedule := Schedule{
+		minute:        bits[0],
+		hour:          bits[1],
+		dayOfMonth:    bits[2],
+		month:         bits[3],
+		dayOfWeek:     bits[4],
+		anyDayOfMonth: parts[2] == "*",
+		anyDayOfWeek:  parts[4] == "*",
+	}
+
+	if schedule.Next(time.Unix(0, 0)).IsZero() {
+		return Schedule{}, fmt.Errorf("schedule never triggers: %s", expr)
+	}
+
+	return schedule, nil
+}
+
+// parseScheduleField parses a comma-separated list of values, ranges and steps of a field.
+func parseScheduleField(expr string, field scheduleField) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(expr, ",") {
+		rangeExpr, stepExpr, hasStep := strings.Cut(item, "/")
+
+		step := uint64(1)
+		if hasStep {
+			s, err := strconv.ParseUint(stepExpr, 10, 64)
+			if err != nil || s == 0 {
+				return 0, fmt.Errorf("invalid step of %s: %s", field.name, item)
+			}
+			step = s
+		}
+
+		start, end := field.min, field.max
+		switch {
+		case rangeExpr == "*":
+		case strings.Contains(rangeExpr, "-"):
+			startExpr, endExpr, _ := strings.Cut(rangeExpr, "-")
+			s, err := parseScheduleValue(startExpr, field)
+			if err != nil {
+				return 0, err
+			}
+			e, err := parseScheduleValue(endExpr, field)
+			if err != nil {
+				return 0, err
+			}
+			if s > e {
+				return 0, fmt.Errorf("invalid range of %s: %s", field.name, item)
+			}
+			start, end = s, e
+		default:
+			v, err := parseScheduleValue(rangeExpr, field)
+			if err != nil {
+				return 0, err
+			}
+			start = v
+			if !hasStep {
+				end = v
+			}
+		}
+
+		for v := start; v <= end; v += step {
+			bits |= 1 << v
+		}
+	}
+
+	return bits, nil
+}
+
+// parseScheduleValue parses a single value of a field and checks that it is in range.
+func parseScheduleValue(expr string, field scheduleField) (uint64, error) {
+	v, err := strconv.ParseUint(expr, 10, 64)
+	if err != nil || v < field.min || v > field.max {
+		return 0, fmt.Errorf("%s must be between %d and %d: %s", field.name, field.min, field.max, expr)
+	}
+
+	return v, nil
+}
+
+// Next returns the first time strictly after t that matches the schedule, or the zero time if the
+// schedule does not trigger within the search limit.
+func (s Schedule) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	yearLimit := t.Year() + scheduleSearchYears
+
+	for t.Year() <= yearLimit {
+		if s.month&(1<<uint64(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if !s.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if s.hour&(1<<uint64(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+
+		if s.minute&(1<<uint64(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+// matchDay returns whether the day of t matches the day fields of the schedule.
+func (s Schedule) matchDay(t time.Time) bool {
+	domMatch := s.dayOfMonth&(1<<uint64(t.Day())) != 0
+	dowMatch := s.dayOfWeek&(1<<uint64(t.Weekday())) != 0
+
+	switch {
+	case s.anyDayOfMonth && s.anyDayOfWeek:
+		return true
+	case s.anyDayOfMonth:
+		return dowMatch
+	case s.anyDayOfWeek:
+		return domMatch
+	default:
+		return domMatch || dowMatch
+	}
+}
@@ -0,0 +1,85 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func TestParseSchedule(t *testing.T) {
+	tests := []struct {
+		name   string
+		expr   string
+		expErr bool
+		errMsg string
+	}{
+		{name: "every hour at :00", expr: "0 * * * *"},
+		{name: "weekdays 16:00", expr: "0 16 * * 1-5"},
+		{name: "steps and lists", expr: "*/15 0,12 1-10/3 * *"},
+		{name: "macro", expr: "@daily"},
+		{name: "wrong number of fields", expr: "0 16 * *", expErr: true, errMsg: "schedule must have 5 fields"},
+		{name: "out of range", expr: "60 * * * *", expErr: true, errMsg: "minute must be between 0 and 59"},
+		{name: "invalid range", expr: "0 10-5 * * *", expErr: true, errMsg: "invalid range of hour"},
+		{name: "invalid step", expr: "*/0 * * * *", expErr: true, errMsg: "invalid step of minute"},
+		{name: "never triggers", expr: "0 0 31 2 *", expErr: true, errMsg: "schedule never triggers"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := types.ParseSchedule(tt.expr)
+			if tt.expErr {
+				require.ErrorContains(t, err, tt.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	// 2024-11-29 is a Friday
+	from := time.Date(2024, 11, 29, 16, 0, 30, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		expr string
+		exp  time.Time
+	}{
+		{
+			name: "every hour at :00",
+			expr: "0 * * * *",
+			exp:  time.Date(2024, 11, 29, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "weekdays 16:00 skips the weekend",
+			expr: "0 16 * * 1-5",
+			exp:  time.Date(2024, 12, 2, 16, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "first day of month",
+			expr: "@monthly",
+			exp:  time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day of month or day of week",
+			expr: "30 8 15 * 6",
+			exp:  time.Date(2024, 11, 30, 8, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "leap day",
+			expr: "0 0 29 2 *",
+			exp:  time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := types.ParseSchedule(tt.expr)
+			require.NoError(t, err)
+			require.Equal(t, tt.exp, schedule.Next(from))
+		})
+	}
+}
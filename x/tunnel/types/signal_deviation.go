@@ -1,6 +1,12 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
 
 // NewSignalDeviation creates a new SignalDeviation instance.
 func NewSignalDeviation(
@@ -75,3 +81,56 @@ func IsDeviationOutOfRange(
 		signalDeviation.HardDeviationBPS > maxDeviationBPS ||
 		signalDeviation.SoftDeviationBPS > signalDeviation.HardDeviationBPS
 }
+
+// ValidateDerivations validates the derivations of the signal deviations. A derived signal must have a signal
+// ID with the reserved derived signal prefix, so that it never collides with a signal of the feeds module, that
+// fits in the bytes32 signal ID of a packet, and must not be a source of another derived signal of the same list.
+func ValidateDerivations(signalDeviations []SignalDeviation) error {
+	for _, sd := range signalDeviations {
+		isDerivedID := strings.HasPrefix(sd.SignalID, feedstypes.DerivedSignalIDPrefix)
+
+		if sd.Derivation == nil {
+			if isDerivedID {
+				return ErrInvalidDerivation.Wrapf(
+					"signal ID %s: signal ID prefix %s is reserved for derived signals",
+					sd.SignalID,
+					feedstypes.DerivedSignalIDPrefix,
+				)
+			}
+			continue
+		}
+
+		if !isDerivedID || len(sd.SignalID) == len(feedstypes.DerivedSignalIDPrefix) {
+			return ErrInvalidDerivation.Wrapf(
+				"signal ID %s: derived signal ID must start with %s",
+				sd.SignalID,
+				feedstypes.DerivedSignalIDPrefix,
+			)
+		}
+
+		// the signal ID is encoded as bytes32 in packets, as the signal IDs of the feeds module are
+		if uint64(len(sd.SignalID)) > feedstypes.MaxSignalIDCharacters {
+			return ErrInvalidDerivation.Wrapf(
+				"signal ID %s: derived signal ID exceeds %d characters",
+				sd.SignalID,
+				feedstypes.MaxSignalIDCharacters,
+			)
+		}
+
+		if err := sd.Derivation.Validate(); err != nil {
+			return ErrInvalidDerivation.Wrapf("signal ID %s: %s", sd.SignalID, err)
+		}
+
+		for _, sourceID := range sd.Derivation.SourceSignalIDs {
+			if strings.HasPrefix(sourceID, feedstypes.DerivedSignalIDPrefix) {
+				return ErrInvalidDerivation.Wrapf(
+					"signal ID %s: source signal %s cannot be a derived signal",
+					sd.SignalID,
+					sourceID,
+				)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTopUpConfig creates a new TopUpConfig instance.
+func NewTopUpConfig(
+	tunnelID uint64,
+	lowBalanceThreshold sdk.Coins,
+	funder string,
+	grantType FunderGrantType,
+	topUpAmount sdk.Coins,
+) TopUpConfig {
+	return TopUpConfig{
+		TunnelID:            tunnelID,
+		LowBalanceThreshold: lowBalanceThreshold,
+		Funder:              funder,
+		GrantType:           grantType,
+		TopUpAmount:         topUpAmount,
+	}
+}
+
+// HasFunder returns true if the top-up config has a funder.
+func (c TopUpConfig) HasFunder() bool {
+	return c.Funder != ""
+}
+
+// IsLowBalance returns true if the given balance is below the low balance threshold.
+func (c TopUpConfig) IsLowBalance(balance sdk.Coins) bool {
+	return !balance.IsAllGTE(c.LowBalanceThreshold)
+}
+
+// Validate validates the top-up config.
+func (c TopUpConfig) Validate() error {
+	if c.LowBalanceThreshold.Empty() || !c.LowBalanceThreshold.IsValid() {
+		return fmt.Errorf("invalid low balance threshold: %s", c.LowBalanceThreshold)
+	}
+
+	// a config without a funder only emits low balance events
+	if !c.HasFunder() {
+		if c.GrantType != FUNDER_GRANT_TYPE_UNSPECIFIED || !c.TopUpAmount.Empty() {
+			return fmt.Errorf("grant type and top-up amount require a funder")
+		}
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(c.Funder); err != nil {
+		return fmt.Errorf("invalid funder address: %s", err)
+	}
+
+	if _, ok := FunderGrantType_name[int32(c.GrantType)]; !ok || c.GrantType == FUNDER_GRANT_TYPE_UNSPECIFIED {
+		return fmt.Errorf("invalid grant type: %s", c.GrantType)
+	}
+
+	if c.TopUpAmount.Empty() || !c.TopUpAmount.IsValid() {
+		return fmt.Errorf("invalid top-up amount: %s", c.TopUpAmount)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+func TestTopUpConfigValidate(t *testing.T) {
+	funder := sdk.AccAddress([]byte("funder_address")).String()
+	threshold := sdk.NewCoins(sdk.NewInt64Coin("uband", 1000))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uband", 5000))
+
+	testCases := []struct {
+		name   string
+		config types.TopUpConfig
+		expErr string
+	}{
+		{
+			name:   "valid feegrant funder",
+			config: types.NewTopUpConfig(1, threshold, funder, types.FUNDER_GRANT_TYPE_FEEGRANT, amount),
+		},
+		{
+			name:   "valid authz funder",
+			config: types.NewTopUpConfig(1, threshold, funder, types.FUNDER_GRANT_TYPE_AUTHZ, amount),
+		},
+		{
+			name:   "valid without funder",
+			config: types.NewTopUpConfig(1, threshold, "", types.FUNDER_GRANT_TYPE_UNSPECIFIED, nil),
+		},
+		{
+			name:   "empty threshold",
+			config: types.NewTopUpConfig(1, nil, funder, types.FUNDER_GRANT_TYPE_FEEGRANT, amount),
+			expErr: "invalid low balance threshold",
+		},
+		{
+			name:   "top-up amount without funder",
+			config: types.NewTopUpConfig(1, threshold, "", types.FUNDER_GRANT_TYPE_UNSPECIFIED, amount),
+			expErr: "grant type and top-up amount require a funder",
+		},
+		{
+			name:   "invalid funder",
+			config: types.NewTopUpConfig(1, threshold, "funder", types.FUNDER_GRANT_TYPE_FEEGRANT, amount),
+			expErr: "invalid funder address",
+		},
+		{
+			name:   "unspecified grant type",
+			config: types.NewTopUpConfig(1, threshold, funder, types.FUNDER_GRANT_TYPE_UNSPECIFIED, amount),
+			expErr: "invalid grant type",
+		},
+		{
+			name:   "empty top-up amount",
+			config: types.NewTopUpConfig(1, threshold, funder, types.FUNDER_GRANT_TYPE_AUTHZ, nil),
+			expErr: "invalid top-up amount",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTopUpConfigIsLowBalance(t *testing.T) {
+	config := types.NewTopUpConfig(
+		1,
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 1000)),
+		"",
+		types.FUNDER_GRANT_TYPE_UNSPECIFIED,
+		nil,
+	)
+
+	require.True(t, config.IsLowBalance(sdk.NewCoins()))
+	require.True(t, config.IsLowBalance(sdk.NewCoins(sdk.NewInt64Coin("uband", 999))))
+	require.False(t, config.IsLowBalance(sdk.NewCoins(sdk.NewInt64Coin("uband", 1000))))
+}
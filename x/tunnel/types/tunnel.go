@@ -1,13 +1,10 @@
 package types
 
 import (
-	"fmt"
-
-	"github.com/cosmos/gogoproto/proto"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ types.UnpackInterfacesMessage = Tunnel{}
@@ -16,20 +13,17 @@ var _ types.UnpackInterfacesMessage = Tunnel{}
 func NewTunnel(
 	id uint64,
 	sequence uint64,
-	route RouteI,
+	routes []RouteI,
 	feePayer string,
 	signalDeviations []SignalDeviation,
 	interval uint64,
+	schedule string,
 	totalDeposit []sdk.Coin,
 	isActive bool,
 	createdAt int64,
 	creator string,
 ) (Tunnel, error) {
-	msg, ok := route.(proto.Message)
-	if !ok {
-		return Tunnel{}, fmt.Errorf("can't proto marshal %T", msg)
-	}
-	any, err := types.NewAnyWithValue(msg)
+	anys, err := PackRoutes(routes)
 	if err != nil {
 		return Tunnel{}, err
 	}
@@ -37,7 +31,8 @@ func NewTunnel(
 	return Tunnel{
 		ID:               id,
 		Sequence:         sequence,
-		Route:            any,
+		Route:            firstAny(anys),
+		Routes:           anys,
 		FeePayer:         feePayer,
 		SignalDeviations: signalDeviations,
 		Interval:         interval,
@@ -45,43 +40,52 @@ func NewTunnel(
 		IsActive:         isActive,
 		CreatedAt:        createdAt,
 		Creator:          creator,
+		Schedule:         schedule,
 	}, nil
 }
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (t Tunnel) UnpackInterfaces(unpacker types.AnyUnpacker) error {
-	var route RouteI
-	return unpacker.UnpackAny(t.Route, &route)
-}
-
-// SetRoute sets the route of the tunnel.
-func (t *Tunnel) SetRoute(route RouteI) error {
-	msg, ok := route.(proto.Message)
-	if !ok {
-		return fmt.Errorf("can't proto marshal %T", msg)
+	if t.Route != nil {
+		var route RouteI
+		if err := unpacker.UnpackAny(t.Route, &route); err != nil {
+			return err
+		}
 	}
 
-	any, err := types.NewAnyWithValue(msg)
+	return unpackRouteInterfaces(unpacker, t.Routes)
+}
+
+// SetRoutes sets the routes of the tunnel.
+func (t *Tunnel) SetRoutes(routes ...RouteI) error {
+	anys, err := PackRoutes(routes)
 	if err != nil {
 		return err
 	}
-	t.Route = any
+	t.Route = firstAny(anys)
+	t.Routes = anys
 
 	return nil
 }
 
-// GetRouteValue returns the route value of the tunnel.
-func (t Tunnel) GetRouteValue() (RouteI, error) {
-	r, ok := t.Route.GetCachedValue().(RouteI)
-	if !ok {
-		return nil, sdkerrors.ErrInvalidType.Wrapf(
-			"expected %T, got %T",
-			(RouteI)(nil),
-			t.Route.GetCachedValue(),
-		)
+// UpdateRoute replaces the route of the tunnel at the given index.
+func (t *Tunnel) UpdateRoute(index uint64, route *types.Any) {
+	t.Routes[index] = route
+	t.Route = firstAny(t.Routes)
+}
+
+// GetRouteValues returns the route values of the tunnel.
+func (t Tunnel) GetRouteValues() ([]RouteI, error) {
+	return UnpackRoutes(t.Routes)
+}
+
+// GetRouteHash returns the hash of the route of the tunnel at the given index.
+func (t Tunnel) GetRouteHash(index uint64) ([]byte, error) {
+	if index >= uint64(len(t.Routes)) {
+		return nil, ErrInvalidRoute.Wrapf("route index %d out of range", index)
 	}
 
-	return r, nil
+	return RouteHash(t.Routes[index]), nil
 }
 
 // GetSignalDeviationMap returns the signal deviation map of the tunnel.
@@ -102,6 +106,30 @@ func (t Tunnel) GetSignalIDs() []string {
 	return signalIDs
 }
 
+// GetFeedSignalIDs returns the signal IDs whose prices are read from the feeds module, which are the
+// signal IDs that are not derived and the source signal IDs of the derived signals.
+func (t Tunnel) GetFeedSignalIDs() []string {
+	signalIDs := make([]string, 0, len(t.SignalDeviations))
+	seen := make(map[string]bool)
+	add := func(signalID string) {
+		if !seen[signalID] {
+			seen[signalID] = true
+			signalIDs = append(signalIDs, signalID)
+		}
+	}
+
+	for _, sd := range t.SignalDeviations {
+		if sd.Derivation == nil {
+			add(sd.SignalID)
+			continue
+		}
+		for _, sourceID := range sd.Derivation.SourceSignalIDs {
+			add(sourceID)
+		}
+	}
+	return signalIDs
+}
+
 // ValidateInterval validates the interval of the tunnel.
 func ValidateInterval(interval, maxInterval, minInterval uint64) error {
 	if interval < minInterval || interval > maxInterval {
@@ -114,3 +142,18 @@ func ValidateInterval(interval, maxInterval, minInterval uint64) error {
 	}
 	return nil
 }
+
+// NextTriggerTime returns the next time that the tunnel sends all signal prices, given the last time
+// it did. It follows the schedule of the tunnel if it is set; otherwise it follows the interval.
+func (t Tunnel) NextTriggerTime(lastInterval int64) (time.Time, error) {
+	if t.Schedule == "" {
+		return time.Unix(lastInterval+int64(t.Interval), 0).UTC(), nil
+	}
+
+	schedule, err := ParseSchedule(t.Schedule)
+	if err != nil {
+		return time.Time{}, ErrInvalidSchedule.Wrap(err.Error())
+	}
+
+	return schedule.Next(time.Unix(lastInterval, 0)), nil
+}
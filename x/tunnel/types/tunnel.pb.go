@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	types2 "github.com/bandprotocol/chain/v3/x/feeds/types"
 	_ "github.com/cosmos/cosmos-proto"
@@ -29,13 +30,82 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FunderGrantType defines the types of grant that a funder gives to the fee payer of a tunnel for top-ups.
+type FunderGrantType int32
+
+const (
+	// FUNDER_GRANT_TYPE_UNSPECIFIED is an unspecified grant type.
+	FUNDER_GRANT_TYPE_UNSPECIFIED FunderGrantType = 0
+	// FUNDER_GRANT_TYPE_FEEGRANT is an x/feegrant allowance from the funder to the fee payer.
+	FUNDER_GRANT_TYPE_FEEGRANT FunderGrantType = 1
+	// FUNDER_GRANT_TYPE_AUTHZ is an x/authz send authorization from the funder to the fee payer.
+	FUNDER_GRANT_TYPE_AUTHZ FunderGrantType = 2
+)
+
+var FunderGrantType_name = map[int32]string{
+	0: "FUNDER_GRANT_TYPE_UNSPECIFIED",
+	1: "FUNDER_GRANT_TYPE_FEEGRANT",
+	2: "FUNDER_GRANT_TYPE_AUTHZ",
+}
+
+var FunderGrantType_value = map[string]int32{
+	"FUNDER_GRANT_TYPE_UNSPECIFIED": 0,
+	"FUNDER_GRANT_TYPE_FEEGRANT":    1,
+	"FUNDER_GRANT_TYPE_AUTHZ":       2,
+}
+
+func (x FunderGrantType) String() string {
+	return proto.EnumName(FunderGrantType_name, int32(x))
+}
+
+func (FunderGrantType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{0}
+}
+
+// DerivationMethod defines the methods to compute the price of a derived signal.
+type DerivationMethod int32
+
+const (
+	// DERIVATION_METHOD_UNSPECIFIED is an unspecified derivation method.
+	DERIVATION_METHOD_UNSPECIFIED DerivationMethod = 0
+	// DERIVATION_METHOD_CROSS is the cross rate of two signals, i.e. price(source 0) / price(source 1).
+	DERIVATION_METHOD_CROSS DerivationMethod = 1
+	// DERIVATION_METHOD_INVERSE is the inverse of a signal, i.e. 1 / price(source 0).
+	DERIVATION_METHOD_INVERSE DerivationMethod = 2
+	// DERIVATION_METHOD_RESCALE is a signal rescaled by a power of ten, i.e. price(source 0) * 10^exponent.
+	DERIVATION_METHOD_RESCALE DerivationMethod = 3
+)
+
+var DerivationMethod_name = map[int32]string{
+	0: "DERIVATION_METHOD_UNSPECIFIED",
+	1: "DERIVATION_METHOD_CROSS",
+	2: "DERIVATION_METHOD_INVERSE",
+	3: "DERIVATION_METHOD_RESCALE",
+}
+
+var DerivationMethod_value = map[string]int32{
+	"DERIVATION_METHOD_UNSPECIFIED": 0,
+	"DERIVATION_METHOD_CROSS":       1,
+	"DERIVATION_METHOD_INVERSE":     2,
+	"DERIVATION_METHOD_RESCALE":     3,
+}
+
+func (x DerivationMethod) String() string {
+	return proto.EnumName(DerivationMethod_name, int32(x))
+}
+
+func (DerivationMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{1}
+}
+
 // Tunnel contains the information of the tunnel that is created by the user
 type Tunnel struct {
 	// id is the tunnel ID
 	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// sequence is representing the sequence of the tunnel packet.
 	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	// route is the route for delivering the signal prices
+	// route is the first route of the tunnel. It is kept for clients of single route tunnels; routes holds all
+	// routes of the tunnel including this one.
 	Route *types.Any `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
 	// fee_payer is the address of the fee payer
 	FeePayer string `protobuf:"bytes,4,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
@@ -51,6 +121,11 @@ type Tunnel struct {
 	CreatedAt int64 `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// creator is the address of the creator
 	Creator string `protobuf:"bytes,10,opt,name=creator,proto3" json:"creator,omitempty"`
+	// routes is the list of routes for delivering the signal prices
+	Routes []*types.Any `protobuf:"bytes,11,rep,name=routes,proto3" json:"routes,omitempty"`
+	// schedule is an optional cron expression in UTC for delivering the signal prices. If it is set,
+	// it replaces the interval trigger.
+	Schedule string `protobuf:"bytes,12,opt,name=schedule,proto3" json:"schedule,omitempty"`
 }
 
 func (m *Tunnel) Reset()         { *m = Tunnel{} }
@@ -156,6 +231,20 @@ func (m *Tunnel) GetCreator() string {
 	return ""
 }
 
+func (m *Tunnel) GetRoutes() []*types.Any {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *Tunnel) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
 // LatestPrices is the type for prices that tunnel produces
 type LatestPrices struct {
 	// tunnel_id is the tunnel ID
@@ -274,10 +363,14 @@ type Packet struct {
 	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	// prices is the list of prices information from feeds module.
 	Prices []types2.Price `protobuf:"bytes,3,rep,name=prices,proto3" json:"prices"`
-	// receipt represents the confirmation of the packet delivery to the destination via the specified route.
+	// receipt is the receipt of the first route of the tunnel. It is kept for clients of single route tunnels;
+	// receipts holds the receipts of all routes including this one.
 	Receipt *types.Any `protobuf:"bytes,4,opt,name=receipt,proto3" json:"receipt,omitempty"`
 	// created_at is the timestamp when the packet is created
 	CreatedAt int64 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// receipts represent the confirmations of the packet delivery to the destinations, one for each route of the
+	// tunnel in the same order. A route that failed to deliver the packet has a FailedPacketReceipt.
+	Receipts []*types.Any `protobuf:"bytes,6,rep,name=receipts,proto3" json:"receipts,omitempty"`
 }
 
 func (m *Packet) Reset()         { *m = Packet{} }
@@ -348,6 +441,297 @@ func (m *Packet) GetCreatedAt() int64 {
 	return 0
 }
 
+func (m *Packet) GetReceipts() []*types.Any {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+// PacketRetry is a route delivery of a packet that failed and is waiting to be retried.
+type PacketRetry struct {
+	// tunnel_id is the tunnel ID
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// sequence is the sequence of the packet
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// route_index is the index of the route of the tunnel that failed to deliver the packet
+	RouteIndex uint64 `protobuf:"varint,3,opt,name=route_index,json=routeIndex,proto3" json:"route_index,omitempty"`
+	// attempts is the number of retries that have been made
+	Attempts uint64 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// next_retry_height is the block height at which the packet will be retried
+	NextRetryHeight int64 `protobuf:"varint,5,opt,name=next_retry_height,json=nextRetryHeight,proto3" json:"next_retry_height,omitempty"`
+	// last_error is the error of the latest delivery attempt
+	LastError string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// route_hash is the hash of the route at the time the delivery failed
+	RouteHash []byte `protobuf:"bytes,7,opt,name=route_hash,json=routeHash,proto3" json:"route_hash,omitempty"`
+}
+
+func (m *PacketRetry) Reset()         { *m = PacketRetry{} }
+func (m *PacketRetry) String() string { return proto.CompactTextString(m) }
+func (*PacketRetry) ProtoMessage()    {}
+func (*PacketRetry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{4}
+}
+func (m *PacketRetry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketRetry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketRetry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketRetry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketRetry.Merge(m, src)
+}
+func (m *PacketRetry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketRetry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketRetry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketRetry proto.InternalMessageInfo
+
+func (m *PacketRetry) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
+	}
+	return 0
+}
+
+func (m *PacketRetry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PacketRetry) GetRouteIndex() uint64 {
+	if m != nil {
+		return m.RouteIndex
+	}
+	return 0
+}
+
+func (m *PacketRetry) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *PacketRetry) GetNextRetryHeight() int64 {
+	if m != nil {
+		return m.NextRetryHeight
+	}
+	return 0
+}
+
+func (m *PacketRetry) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *PacketRetry) GetRouteHash() []byte {
+	if m != nil {
+		return m.RouteHash
+	}
+	return nil
+}
+
+// DeadLetterPacket is a route delivery of a packet that has exhausted all of its retries.
+type DeadLetterPacket struct {
+	// tunnel_id is the tunnel ID
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// sequence is the sequence of the packet
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// route_index is the index of the route of the tunnel that failed to deliver the packet
+	RouteIndex uint64 `protobuf:"varint,3,opt,name=route_index,json=routeIndex,proto3" json:"route_index,omitempty"`
+	// attempts is the number of retries that have been made
+	Attempts uint64 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// last_error is the error of the latest delivery attempt
+	LastError string `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// created_at is the timestamp when the packet is moved to the dead-letter store
+	CreatedAt int64 `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// route_hash is the hash of the route at the time the delivery failed
+	RouteHash []byte `protobuf:"bytes,7,opt,name=route_hash,json=routeHash,proto3" json:"route_hash,omitempty"`
+}
+
+func (m *DeadLetterPacket) Reset()         { *m = DeadLetterPacket{} }
+func (m *DeadLetterPacket) String() string { return proto.CompactTextString(m) }
+func (*DeadLetterPacket) ProtoMessage()    {}
+func (*DeadLetterPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{5}
+}
+func (m *DeadLetterPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeadLetterPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeadLetterPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeadLetterPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadLetterPacket.Merge(m, src)
+}
+func (m *DeadLetterPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeadLetterPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadLetterPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadLetterPacket proto.InternalMessageInfo
+
+func (m *DeadLetterPacket) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
+	}
+	return 0
+}
+
+func (m *DeadLetterPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *DeadLetterPacket) GetRouteIndex() uint64 {
+	if m != nil {
+		return m.RouteIndex
+	}
+	return 0
+}
+
+func (m *DeadLetterPacket) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *DeadLetterPacket) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *DeadLetterPacket) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *DeadLetterPacket) GetRouteHash() []byte {
+	if m != nil {
+		return m.RouteHash
+	}
+	return nil
+}
+
+// TopUpConfig defines the low balance threshold of the fee payer of a tunnel and an optional funder
+// that tops up the fee payer when its balance falls below the threshold.
+type TopUpConfig struct {
+	// tunnel_id is the tunnel ID
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// low_balance_threshold is the balance of the fee payer below which a low balance event is emitted
+	// and the fee payer is topped up.
+	LowBalanceThreshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=low_balance_threshold,json=lowBalanceThreshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"low_balance_threshold"`
+	// funder is the address of the account that tops up the fee payer. It is optional.
+	Funder string `protobuf:"bytes,3,opt,name=funder,proto3" json:"funder,omitempty"`
+	// grant_type is the type of grant that the funder gives to the fee payer.
+	GrantType FunderGrantType `protobuf:"varint,4,opt,name=grant_type,json=grantType,proto3,enum=band.tunnel.v1beta1.FunderGrantType" json:"grant_type,omitempty"`
+	// top_up_amount is the amount that the funder sends to the fee payer for each top-up.
+	TopUpAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=top_up_amount,json=topUpAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"top_up_amount"`
+}
+
+func (m *TopUpConfig) Reset()         { *m = TopUpConfig{} }
+func (m *TopUpConfig) String() string { return proto.CompactTextString(m) }
+func (*TopUpConfig) ProtoMessage()    {}
+func (*TopUpConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{6}
+}
+func (m *TopUpConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopUpConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopUpConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopUpConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopUpConfig.Merge(m, src)
+}
+func (m *TopUpConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopUpConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopUpConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopUpConfig proto.InternalMessageInfo
+
+func (m *TopUpConfig) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
+	}
+	return 0
+}
+
+func (m *TopUpConfig) GetLowBalanceThreshold() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LowBalanceThreshold
+	}
+	return nil
+}
+
+func (m *TopUpConfig) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *TopUpConfig) GetGrantType() FunderGrantType {
+	if m != nil {
+		return m.GrantType
+	}
+	return FUNDER_GRANT_TYPE_UNSPECIFIED
+}
+
+func (m *TopUpConfig) GetTopUpAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TopUpAmount
+	}
+	return nil
+}
+
 // Deposit defines an amount deposited by an account address to the tunnel.
 type Deposit struct {
 	// tunnel_id defines the unique id of the tunnel.
@@ -362,7 +746,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bb6151451ba2f25, []int{4}
+	return fileDescriptor_6bb6151451ba2f25, []int{7}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -420,13 +804,16 @@ type SignalDeviation struct {
 	SoftDeviationBPS uint64 `protobuf:"varint,2,opt,name=soft_deviation_bps,json=softDeviationBps,proto3" json:"soft_deviation_bps,omitempty"`
 	// hard_deviation_bps is the hard deviation in basis points
 	HardDeviationBPS uint64 `protobuf:"varint,3,opt,name=hard_deviation_bps,json=hardDeviationBps,proto3" json:"hard_deviation_bps,omitempty"`
+	// derivation is an optional derivation to compute the price of the signal from the prices of other signals.
+	// If it is set, the signal ID is the name of the derived signal.
+	Derivation *Derivation `protobuf:"bytes,4,opt,name=derivation,proto3" json:"derivation,omitempty"`
 }
 
 func (m *SignalDeviation) Reset()         { *m = SignalDeviation{} }
 func (m *SignalDeviation) String() string { return proto.CompactTextString(m) }
 func (*SignalDeviation) ProtoMessage()    {}
 func (*SignalDeviation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bb6151451ba2f25, []int{5}
+	return fileDescriptor_6bb6151451ba2f25, []int{8}
 }
 func (m *SignalDeviation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -476,30 +863,35 @@ func (m *SignalDeviation) GetHardDeviationBPS() uint64 {
 	return 0
 }
 
-// TunnelSignatureOrder defines a general signature order for sending signature to tss group.
-type TunnelSignatureOrder struct {
-	// sequence is the sequence of the packet
-	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	// prices is the list of prices information from feeds module.
-	Prices []types2.Price `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices"`
-	// created_at is the timestamp when the packet is created
-	CreatedAt int64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// encoder is the mode of encoding data.
-	Encoder types2.Encoder `protobuf:"varint,4,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
+func (m *SignalDeviation) GetDerivation() *Derivation {
+	if m != nil {
+		return m.Derivation
+	}
+	return nil
 }
 
-func (m *TunnelSignatureOrder) Reset()         { *m = TunnelSignatureOrder{} }
-func (m *TunnelSignatureOrder) String() string { return proto.CompactTextString(m) }
-func (*TunnelSignatureOrder) ProtoMessage()    {}
-func (*TunnelSignatureOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6bb6151451ba2f25, []int{6}
+// Derivation defines how the price of a derived signal is computed from the prices of its source signals.
+type Derivation struct {
+	// method is the method to compute the price of the derived signal.
+	Method DerivationMethod `protobuf:"varint,1,opt,name=method,proto3,enum=band.tunnel.v1beta1.DerivationMethod" json:"method,omitempty"`
+	// source_signal_ids is the list of signal IDs of the source prices.
+	SourceSignalIDs []string `protobuf:"bytes,2,rep,name=source_signal_ids,json=sourceSignalIds,proto3" json:"source_signal_ids,omitempty"`
+	// exponent is the power of ten to rescale the source price by. It is only used by the rescale method.
+	Exponent int32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
 }
-func (m *TunnelSignatureOrder) XXX_Unmarshal(b []byte) error {
+
+func (m *Derivation) Reset()         { *m = Derivation{} }
+func (m *Derivation) String() string { return proto.CompactTextString(m) }
+func (*Derivation) ProtoMessage()    {}
+func (*Derivation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{9}
+}
+func (m *Derivation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TunnelSignatureOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Derivation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TunnelSignatureOrder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Derivation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -509,89 +901,193 @@ func (m *TunnelSignatureOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *TunnelSignatureOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TunnelSignatureOrder.Merge(m, src)
+func (m *Derivation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Derivation.Merge(m, src)
 }
-func (m *TunnelSignatureOrder) XXX_Size() int {
+func (m *Derivation) XXX_Size() int {
 	return m.Size()
 }
-func (m *TunnelSignatureOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_TunnelSignatureOrder.DiscardUnknown(m)
+func (m *Derivation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Derivation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TunnelSignatureOrder proto.InternalMessageInfo
+var xxx_messageInfo_Derivation proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Tunnel)(nil), "band.tunnel.v1beta1.Tunnel")
-	proto.RegisterType((*LatestPrices)(nil), "band.tunnel.v1beta1.LatestPrices")
-	proto.RegisterType((*TotalFees)(nil), "band.tunnel.v1beta1.TotalFees")
-	proto.RegisterType((*Packet)(nil), "band.tunnel.v1beta1.Packet")
-	proto.RegisterType((*Deposit)(nil), "band.tunnel.v1beta1.Deposit")
-	proto.RegisterType((*SignalDeviation)(nil), "band.tunnel.v1beta1.SignalDeviation")
-	proto.RegisterType((*TunnelSignatureOrder)(nil), "band.tunnel.v1beta1.TunnelSignatureOrder")
+func (m *Derivation) GetMethod() DerivationMethod {
+	if m != nil {
+		return m.Method
+	}
+	return DERIVATION_METHOD_UNSPECIFIED
+}
+
+func (m *Derivation) GetSourceSignalIDs() []string {
+	if m != nil {
+		return m.SourceSignalIDs
+	}
+	return nil
+}
+
+func (m *Derivation) GetExponent() int32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+// TunnelSignatureOrder defines a general signature order for sending signature to tss group.
+type TunnelSignatureOrder struct {
+	// sequence is the sequence of the packet
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// prices is the list of prices information from feeds module.
+	Prices []types2.Price `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices"`
+	// created_at is the timestamp when the packet is created
+	CreatedAt int64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// encoder is the mode of encoding data.
+	Encoder types2.Encoder `protobuf:"varint,4,opt,name=encoder,proto3,enum=band.feeds.v1beta1.Encoder" json:"encoder,omitempty"`
+}
+
+func (m *TunnelSignatureOrder) Reset()         { *m = TunnelSignatureOrder{} }
+func (m *TunnelSignatureOrder) String() string { return proto.CompactTextString(m) }
+func (*TunnelSignatureOrder) ProtoMessage()    {}
+func (*TunnelSignatureOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bb6151451ba2f25, []int{10}
+}
+func (m *TunnelSignatureOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TunnelSignatureOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TunnelSignatureOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TunnelSignatureOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TunnelSignatureOrder.Merge(m, src)
+}
+func (m *TunnelSignatureOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *TunnelSignatureOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TunnelSignatureOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TunnelSignatureOrder proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("band.tunnel.v1beta1.FunderGrantType", FunderGrantType_name, FunderGrantType_value)
+	proto.RegisterEnum("band.tunnel.v1beta1.DerivationMethod", DerivationMethod_name, DerivationMethod_value)
+	proto.RegisterType((*Tunnel)(nil), "band.tunnel.v1beta1.Tunnel")
+	proto.RegisterType((*LatestPrices)(nil), "band.tunnel.v1beta1.LatestPrices")
+	proto.RegisterType((*TotalFees)(nil), "band.tunnel.v1beta1.TotalFees")
+	proto.RegisterType((*Packet)(nil), "band.tunnel.v1beta1.Packet")
+	proto.RegisterType((*PacketRetry)(nil), "band.tunnel.v1beta1.PacketRetry")
+	proto.RegisterType((*DeadLetterPacket)(nil), "band.tunnel.v1beta1.DeadLetterPacket")
+	proto.RegisterType((*TopUpConfig)(nil), "band.tunnel.v1beta1.TopUpConfig")
+	proto.RegisterType((*Deposit)(nil), "band.tunnel.v1beta1.Deposit")
+	proto.RegisterType((*SignalDeviation)(nil), "band.tunnel.v1beta1.SignalDeviation")
+	proto.RegisterType((*Derivation)(nil), "band.tunnel.v1beta1.Derivation")
+	proto.RegisterType((*TunnelSignatureOrder)(nil), "band.tunnel.v1beta1.TunnelSignatureOrder")
 }
 
 func init() { proto.RegisterFile("band/tunnel/v1beta1/tunnel.proto", fileDescriptor_6bb6151451ba2f25) }
 
 var fileDescriptor_6bb6151451ba2f25 = []byte{
-	// 898 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xd8, 0x8e, 0xed, 0x9d, 0xa6, 0x25, 0x1d, 0x0c, 0xda, 0xa6, 0xc2, 0xb6, 0x02, 0x07,
-	0x83, 0x94, 0x5d, 0x9a, 0x2a, 0x45, 0xea, 0x2d, 0x4b, 0xa8, 0xb0, 0x04, 0x22, 0xda, 0x54, 0x42,
-	0xe2, 0xb2, 0x1a, 0xef, 0x3e, 0xdb, 0xa3, 0x3a, 0x3b, 0xcb, 0xcc, 0xd8, 0x22, 0x17, 0xce, 0x5c,
-	0x90, 0xe0, 0x03, 0x20, 0xf5, 0x58, 0x71, 0xe2, 0x90, 0xef, 0x40, 0x95, 0x53, 0xc5, 0x89, 0x03,
-	0x0a, 0xc8, 0x39, 0x80, 0xc4, 0x97, 0x40, 0x3b, 0x33, 0x6b, 0x63, 0x2b, 0x6d, 0x64, 0xa9, 0x97,
-	0xc4, 0xef, 0xbd, 0xdf, 0xbc, 0x7f, 0xbf, 0xdf, 0xcc, 0xe2, 0x4e, 0x9f, 0xa6, 0x89, 0xaf, 0x26,
-	0x69, 0x0a, 0x63, 0x7f, 0x7a, 0xaf, 0x0f, 0x8a, 0xde, 0xb3, 0xa6, 0x97, 0x09, 0xae, 0x38, 0x79,
-	0x33, 0x47, 0x78, 0xd6, 0x65, 0x11, 0xdb, 0xb7, 0xe9, 0x09, 0x4b, 0xb9, 0xaf, 0xff, 0x1a, 0xdc,
-	0x76, 0x2b, 0xe6, 0xf2, 0x84, 0x4b, 0xbf, 0x4f, 0x25, 0xcc, 0x33, 0xc5, 0x9c, 0xa5, 0x36, 0x7e,
-	0xc7, 0xc4, 0x23, 0x6d, 0xf9, 0xc6, 0xb0, 0xa1, 0xe6, 0x90, 0x0f, 0xb9, 0xf1, 0xe7, 0xbf, 0x8a,
-	0x03, 0x43, 0xce, 0x87, 0x63, 0xf0, 0xb5, 0xd5, 0x9f, 0x0c, 0x7c, 0x9a, 0x9e, 0xda, 0x90, 0xe9,
-	0x7a, 0x00, 0x90, 0xc8, 0x79, 0x29, 0x48, 0x63, 0x9e, 0x80, 0x28, 0xba, 0xb9, 0x02, 0xa1, 0x2d,
-	0x13, 0xdf, 0xf9, 0xbe, 0x8a, 0x6b, 0x8f, 0xf5, 0x4c, 0xe4, 0x6d, 0x5c, 0x66, 0x89, 0x8b, 0x3a,
-	0xa8, 0x5b, 0x0d, 0x6a, 0xb3, 0x8b, 0x76, 0xb9, 0x77, 0x18, 0x96, 0x59, 0x42, 0xb6, 0x71, 0x43,
-	0xc2, 0xd7, 0x13, 0x48, 0x63, 0x70, 0xcb, 0x79, 0x34, 0x9c, 0xdb, 0xe4, 0x01, 0xde, 0x10, 0x7c,
-	0xa2, 0xc0, 0xad, 0x74, 0x50, 0xf7, 0xc6, 0x5e, 0xd3, 0x33, 0xbd, 0x7a, 0x45, 0xaf, 0xde, 0x41,
-	0x7a, 0x1a, 0xe0, 0xf3, 0xb3, 0xdd, 0x5a, 0x98, 0xc3, 0x7a, 0xa1, 0x81, 0x93, 0x7d, 0xec, 0x0c,
-	0x00, 0xa2, 0x8c, 0x9e, 0x82, 0x70, 0xab, 0x1d, 0xd4, 0x75, 0x02, 0xf7, 0xb7, 0xb3, 0xdd, 0xa6,
-	0x5d, 0xc7, 0x41, 0x92, 0x08, 0x90, 0xf2, 0x58, 0x09, 0x96, 0x0e, 0xc3, 0xc6, 0x00, 0xe0, 0x28,
-	0x47, 0x92, 0x2f, 0xf1, 0x6d, 0xc9, 0x86, 0x29, 0x1d, 0x47, 0x09, 0x4c, 0x19, 0x55, 0x8c, 0xa7,
-	0xd2, 0xdd, 0xe8, 0x54, 0xba, 0x37, 0xf6, 0xde, 0xf3, 0xae, 0xe0, 0xc7, 0x3b, 0xd6, 0xe8, 0xc3,
-	0x02, 0x1c, 0x54, 0x9f, 0x5f, 0xb4, 0x4b, 0xe1, 0x96, 0x5c, 0x76, 0xcb, 0x7c, 0x46, 0x96, 0x2a,
-	0x10, 0x53, 0x3a, 0x76, 0x6b, 0x66, 0xc6, 0xc2, 0x26, 0x13, 0x7c, 0x53, 0x71, 0xa5, 0x6b, 0x66,
-	0x5c, 0x32, 0xe5, 0xd6, 0x75, 0xc1, 0x3b, 0x9e, 0x6d, 0x36, 0x27, 0x7a, 0x5e, 0xf0, 0x63, 0xce,
-	0xd2, 0x60, 0x3f, 0xaf, 0xf2, 0xf3, 0x9f, 0xed, 0xee, 0x90, 0xa9, 0xd1, 0xa4, 0xef, 0xc5, 0xfc,
-	0xc4, 0x12, 0x6d, 0xff, 0xed, 0xca, 0xe4, 0x89, 0xaf, 0x4e, 0x33, 0x90, 0xfa, 0x80, 0x7c, 0xf6,
-	0xf7, 0x2f, 0x1f, 0xa0, 0x70, 0x53, 0x97, 0x39, 0x34, 0x55, 0xc8, 0x5d, 0xec, 0x30, 0x19, 0xd1,
-	0x58, 0xb1, 0x29, 0xb8, 0x8d, 0x0e, 0xea, 0x36, 0xc2, 0x06, 0x93, 0x07, 0xda, 0x26, 0xef, 0x60,
-	0x1c, 0x0b, 0xa0, 0x0a, 0x92, 0x88, 0x2a, 0xd7, 0xe9, 0xa0, 0x6e, 0x25, 0x74, 0xac, 0xe7, 0x40,
-	0x91, 0x3d, 0x5c, 0xd7, 0x06, 0x17, 0x2e, 0xbe, 0x66, 0xb9, 0x05, 0xf0, 0x61, 0xf5, 0x9f, 0xa7,
-	0x6d, 0xb4, 0xf3, 0x13, 0xc2, 0x9b, 0x9f, 0x51, 0x05, 0x52, 0x1d, 0x09, 0x16, 0x83, 0x24, 0xef,
-	0x63, 0xc7, 0xec, 0x34, 0x9a, 0x8b, 0x63, 0x73, 0x76, 0xd1, 0x6e, 0x18, 0xd1, 0xf4, 0x0e, 0xc3,
-	0x86, 0x09, 0xf7, 0x12, 0xf2, 0x11, 0xae, 0x65, 0xfa, 0x90, 0x5b, 0xb6, 0x1b, 0xd2, 0x94, 0x18,
-	0xb9, 0x15, 0x0b, 0xd2, 0x69, 0x2d, 0x0f, 0x16, 0x4e, 0xde, 0xc5, 0x37, 0xc7, 0x54, 0xaa, 0x68,
-	0x4e, 0x41, 0x45, 0x0f, 0xb4, 0x99, 0x3b, 0x7b, 0xd6, 0x67, 0xfb, 0xfb, 0x11, 0x61, 0xe7, 0x71,
-	0xbe, 0xa6, 0x47, 0x00, 0x92, 0x7c, 0x8b, 0xdf, 0x32, 0xd4, 0xe4, 0x1c, 0x44, 0x19, 0x8d, 0x9f,
-	0x80, 0x8a, 0x06, 0x00, 0x2e, 0xba, 0x8e, 0xa2, 0x0f, 0xd7, 0xa5, 0x28, 0x24, 0xba, 0x52, 0x40,
-	0x25, 0x1c, 0xe9, 0x3a, 0x8f, 0x00, 0x6c, 0x4f, 0xff, 0x22, 0x5c, 0x33, 0xbe, 0x75, 0xb6, 0xf5,
-	0xaa, 0x6b, 0xb5, 0xd8, 0x64, 0x65, 0xbd, 0x4d, 0x06, 0xb8, 0x2e, 0x20, 0x06, 0x96, 0x29, 0x7d,
-	0xab, 0x5e, 0x76, 0x23, 0xc9, 0xf9, 0xd9, 0xee, 0x2d, 0xd3, 0x72, 0x68, 0xe0, 0xbd, 0xb0, 0x38,
-	0xb8, 0xa2, 0xad, 0x8d, 0x15, 0x6d, 0xed, 0xfc, 0x81, 0x70, 0xbd, 0xd0, 0xe8, 0x1a, 0xe3, 0x3e,
-	0xc0, 0x8e, 0xbd, 0x3f, 0x5c, 0xe8, 0x79, 0x5f, 0x25, 0xca, 0x05, 0x94, 0x8c, 0x70, 0x8d, 0x9e,
-	0xf0, 0x49, 0xaa, 0xe6, 0xab, 0x78, 0xdd, 0xd7, 0xce, 0xe6, 0xb7, 0x64, 0x9e, 0x23, 0xfc, 0xc6,
-	0xca, 0xab, 0x91, 0x8f, 0x69, 0x9f, 0x1d, 0x3b, 0xa6, 0x63, 0xc6, 0x34, 0xb8, 0x7c, 0x4c, 0x13,
-	0xee, 0x25, 0x24, 0xc0, 0x44, 0xf2, 0x81, 0x5a, 0xbc, 0x4f, 0x51, 0x3f, 0x93, 0x86, 0xdf, 0xa0,
-	0x39, 0xbb, 0x68, 0x6f, 0x1d, 0xf3, 0x81, 0x5a, 0xbc, 0x47, 0x47, 0xc7, 0xe1, 0x96, 0x5c, 0xf2,
-	0x64, 0x39, 0x89, 0x64, 0x44, 0x45, 0xb2, 0x92, 0xa3, 0xb2, 0xc8, 0xf1, 0x29, 0x15, 0xc9, 0x72,
-	0x8e, 0xd1, 0x92, 0x27, 0x93, 0x76, 0x98, 0x5f, 0x11, 0x6e, 0x1a, 0x2e, 0x74, 0xab, 0x6a, 0x22,
-	0xe0, 0x0b, 0x91, 0x80, 0x58, 0x12, 0x1f, 0x7a, 0xa9, 0xf8, 0xd6, 0xbc, 0xc6, 0xcb, 0xc2, 0xa9,
-	0xac, 0x3e, 0x4a, 0xfb, 0xb8, 0x6e, 0xbf, 0x4d, 0x5a, 0x9b, 0xb7, 0xf6, 0xee, 0x5e, 0x95, 0xf8,
-	0x13, 0x03, 0x09, 0x0b, 0xec, 0xc3, 0xea, 0x77, 0x4f, 0xdb, 0xa5, 0xe0, 0xf3, 0x67, 0xb3, 0x16,
-	0x7a, 0x3e, 0x6b, 0xa1, 0x17, 0xb3, 0x16, 0xfa, 0x6b, 0xd6, 0x42, 0x3f, 0x5c, 0xb6, 0x4a, 0x2f,
-	0x2e, 0x5b, 0xa5, 0xdf, 0x2f, 0x5b, 0xa5, 0xaf, 0xfc, 0xff, 0x31, 0x9e, 0xe7, 0xd4, 0x62, 0x8f,
-	0xf9, 0xd8, 0x8f, 0x47, 0x94, 0xa5, 0xfe, 0xf4, 0xbe, 0xff, 0x4d, 0xf1, 0x6d, 0xd7, 0xf4, 0xf7,
-	0x6b, 0x1a, 0x71, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x38, 0x1b, 0xc5, 0xfa, 0xf7, 0x07,
-	0x00, 0x00,
+	// 1418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0x8e, 0x63, 0x4f, 0xd2, 0xc6, 0x99, 0xa6, 0x74, 0x9b, 0xa8, 0xb6, 0x29, 0x20,
+	0x99, 0x48, 0xb1, 0xdb, 0x54, 0x2d, 0xa8, 0x12, 0xaa, 0xec, 0xd8, 0x69, 0x2c, 0xb5, 0x49, 0x34,
+	0x76, 0x8a, 0xe8, 0x65, 0x35, 0xde, 0x1d, 0x7b, 0x57, 0x75, 0x76, 0x96, 0x9d, 0x71, 0x9a, 0x5c,
+	0x90, 0x90, 0x38, 0x54, 0xe2, 0x02, 0x17, 0x6e, 0x48, 0x95, 0xb8, 0x54, 0x1c, 0x10, 0xa0, 0x7e,
+	0x07, 0xaa, 0x9e, 0x2a, 0x4e, 0x1c, 0x50, 0x40, 0xce, 0x01, 0xbe, 0x02, 0x37, 0x34, 0x7f, 0xd6,
+	0x49, 0x9c, 0x94, 0xd4, 0xa2, 0xbd, 0x24, 0xfb, 0xde, 0xfb, 0xbd, 0x79, 0xef, 0xfd, 0xde, 0x9b,
+	0x3f, 0x06, 0xf9, 0x16, 0xf6, 0x9d, 0x12, 0xef, 0xf9, 0x3e, 0xe9, 0x96, 0xb6, 0xaf, 0xb6, 0x08,
+	0xc7, 0x57, 0xb5, 0x58, 0x0c, 0x42, 0xca, 0x29, 0x3c, 0x27, 0x10, 0x45, 0xad, 0xd2, 0x88, 0xb9,
+	0x19, 0xbc, 0xe5, 0xf9, 0xb4, 0x24, 0xff, 0x2a, 0xdc, 0x5c, 0xd6, 0xa6, 0x6c, 0x8b, 0xb2, 0x52,
+	0x0b, 0x33, 0x32, 0x58, 0xc9, 0xa6, 0x9e, 0xaf, 0xed, 0x17, 0x95, 0xdd, 0x92, 0x52, 0x49, 0x09,
+	0xda, 0x34, 0xdb, 0xa1, 0x1d, 0xaa, 0xf4, 0xe2, 0x2b, 0x72, 0xe8, 0x50, 0xda, 0xe9, 0x92, 0x92,
+	0x94, 0x5a, 0xbd, 0x76, 0x09, 0xfb, 0xbb, 0xda, 0xa4, 0xb2, 0x6e, 0x13, 0xe2, 0xb0, 0x41, 0x28,
+	0xe2, 0xdb, 0xd4, 0x21, 0x61, 0x94, 0xcd, 0x09, 0x08, 0x29, 0x29, 0xfb, 0xe5, 0xfd, 0x04, 0x48,
+	0x36, 0x65, 0x4d, 0xf0, 0x2d, 0x10, 0xf3, 0x1c, 0xd3, 0xc8, 0x1b, 0x85, 0x44, 0x25, 0xd9, 0xdf,
+	0xcb, 0xc5, 0xea, 0x55, 0x14, 0xf3, 0x1c, 0x38, 0x07, 0x52, 0x8c, 0x7c, 0xda, 0x23, 0xbe, 0x4d,
+	0xcc, 0x98, 0xb0, 0xa2, 0x81, 0x0c, 0x6f, 0x80, 0xf1, 0x90, 0xf6, 0x38, 0x31, 0xe3, 0x79, 0xa3,
+	0x30, 0xb9, 0x34, 0x5b, 0x54, 0xb9, 0x16, 0xa3, 0x5c, 0x8b, 0x65, 0x7f, 0xb7, 0x02, 0x9e, 0x3f,
+	0x5d, 0x4c, 0x22, 0x01, 0xab, 0x23, 0x05, 0x87, 0xd7, 0x41, 0xba, 0x4d, 0x88, 0x15, 0xe0, 0x5d,
+	0x12, 0x9a, 0x89, 0xbc, 0x51, 0x48, 0x57, 0xcc, 0x5f, 0x9f, 0x2e, 0xce, 0x6a, 0x3a, 0xca, 0x8e,
+	0x13, 0x12, 0xc6, 0x1a, 0x3c, 0xf4, 0xfc, 0x0e, 0x4a, 0xb5, 0x09, 0xd9, 0x10, 0x48, 0xf8, 0x31,
+	0x98, 0x61, 0x5e, 0xc7, 0xc7, 0x5d, 0xcb, 0x21, 0xdb, 0x1e, 0xe6, 0x1e, 0xf5, 0x99, 0x39, 0x9e,
+	0x8f, 0x17, 0x26, 0x97, 0xde, 0x2d, 0x9e, 0xd0, 0x9f, 0x62, 0x43, 0xa2, 0xab, 0x11, 0xb8, 0x92,
+	0x78, 0xb6, 0x97, 0x1b, 0x43, 0x19, 0x76, 0x54, 0xcd, 0x44, 0x8d, 0x9e, 0xcf, 0x49, 0xb8, 0x8d,
+	0xbb, 0x66, 0x52, 0xd5, 0x18, 0xc9, 0xb0, 0x07, 0xce, 0x70, 0xca, 0x65, 0xcc, 0x80, 0x32, 0x8f,
+	0x9b, 0x13, 0x32, 0xe0, 0xc5, 0xa2, 0x4e, 0x56, 0x34, 0x7a, 0x10, 0x70, 0x99, 0x7a, 0x7e, 0xe5,
+	0xba, 0x88, 0xf2, 0xfd, 0x1f, 0xb9, 0x42, 0xc7, 0xe3, 0x6e, 0xaf, 0x55, 0xb4, 0xe9, 0x96, 0x6e,
+	0xb4, 0xfe, 0xb7, 0xc8, 0x9c, 0x07, 0x25, 0xbe, 0x1b, 0x10, 0x26, 0x1d, 0xd8, 0x93, 0xbf, 0x7e,
+	0x5c, 0x30, 0xd0, 0x94, 0x0c, 0x53, 0x55, 0x51, 0xe0, 0x3c, 0x48, 0x7b, 0xcc, 0xc2, 0x36, 0xf7,
+	0xb6, 0x89, 0x99, 0xca, 0x1b, 0x85, 0x14, 0x4a, 0x79, 0xac, 0x2c, 0x65, 0x78, 0x09, 0x00, 0x3b,
+	0x24, 0x98, 0x13, 0xc7, 0xc2, 0xdc, 0x4c, 0xe7, 0x8d, 0x42, 0x1c, 0xa5, 0xb5, 0xa6, 0xcc, 0xe1,
+	0x12, 0x98, 0x90, 0x02, 0x0d, 0x4d, 0x70, 0x0a, 0xb9, 0x11, 0x10, 0x7e, 0x08, 0x92, 0xb2, 0x37,
+	0xcc, 0x9c, 0xcc, 0xc7, 0x5f, 0xa9, 0x97, 0x1a, 0x2f, 0x07, 0xc4, 0x76, 0x89, 0xd3, 0xeb, 0x12,
+	0x73, 0x4a, 0x84, 0x43, 0x03, 0xf9, 0x66, 0xe2, 0xef, 0xc7, 0x39, 0xe3, 0xf2, 0xb7, 0x06, 0x98,
+	0xba, 0x83, 0x39, 0x61, 0x7c, 0x23, 0xf4, 0x6c, 0xc2, 0xe0, 0xfb, 0x20, 0xad, 0x3a, 0x65, 0x0d,
+	0x46, 0x6e, 0xaa, 0xbf, 0x97, 0x4b, 0xa9, 0x51, 0xac, 0x57, 0x51, 0x4a, 0x99, 0xeb, 0x0e, 0xfc,
+	0x00, 0x24, 0x03, 0xe9, 0x64, 0xc6, 0x34, 0xef, 0xb2, 0xd1, 0x6a, 0x88, 0x23, 0xda, 0xe5, 0xb2,
+	0xba, 0xbb, 0x1a, 0x0e, 0xdf, 0x01, 0x67, 0xba, 0x98, 0x71, 0x6b, 0xd0, 0xd8, 0xb8, 0xa4, 0x69,
+	0x4a, 0x28, 0xeb, 0x5a, 0xa7, 0xf3, 0xfb, 0xda, 0x00, 0xe9, 0xa6, 0x20, 0x7f, 0x85, 0x10, 0x06,
+	0x3f, 0x03, 0xe7, 0x55, 0xc3, 0x45, 0x67, 0xad, 0x00, 0xdb, 0x0f, 0x08, 0xb7, 0xda, 0x84, 0x98,
+	0xc6, 0x69, 0x8d, 0xbf, 0x32, 0x6a, 0xe3, 0x11, 0x94, 0x91, 0x2a, 0x98, 0x91, 0x0d, 0x19, 0x67,
+	0x85, 0x44, 0x9c, 0xfd, 0x14, 0x03, 0x49, 0xa5, 0x1b, 0x85, 0xad, 0xff, 0xda, 0xac, 0x07, 0x4c,
+	0xc6, 0x47, 0x63, 0xb2, 0x02, 0x26, 0x42, 0x62, 0x13, 0x2f, 0xe0, 0x72, 0xaf, 0xbe, 0x6c, 0x36,
+	0xe0, 0xf3, 0xa7, 0x8b, 0x67, 0x55, 0xca, 0x48, 0xc1, 0xeb, 0x28, 0x72, 0x1c, 0x9a, 0xd8, 0xf1,
+	0xe1, 0x89, 0xad, 0x82, 0x94, 0x46, 0x32, 0x33, 0x99, 0x8f, 0x8f, 0x14, 0x63, 0xe0, 0x79, 0xf9,
+	0xf3, 0x18, 0x98, 0x8c, 0x8c, 0x3c, 0xdc, 0x7d, 0x5d, 0xc4, 0xe5, 0xc0, 0xa4, 0x1c, 0x75, 0xcb,
+	0xf3, 0x1d, 0xb2, 0x23, 0xe7, 0x28, 0x81, 0x80, 0x54, 0xd5, 0x85, 0x46, 0x38, 0x63, 0xce, 0xc9,
+	0x96, 0xc8, 0x3e, 0xa1, 0x9c, 0x23, 0x19, 0x2e, 0x80, 0x19, 0x9f, 0xec, 0x70, 0x2b, 0x14, 0x19,
+	0x59, 0x2e, 0xf1, 0x3a, 0x6e, 0x54, 0xff, 0xb4, 0x30, 0xc8, 0x4c, 0x57, 0xa5, 0x5a, 0x90, 0x24,
+	0x47, 0x96, 0x84, 0x21, 0x0d, 0xe5, 0x41, 0x94, 0x46, 0x69, 0xa1, 0xa9, 0x09, 0x85, 0x30, 0xab,
+	0x3c, 0x5c, 0xcc, 0x5c, 0x73, 0x22, 0x6f, 0x14, 0xa6, 0x50, 0x5a, 0x6a, 0x56, 0x31, 0x73, 0xf5,
+	0xdc, 0xfc, 0x63, 0x80, 0x4c, 0x95, 0x60, 0xe7, 0x0e, 0xe1, 0x9c, 0x84, 0xaf, 0x77, 0x82, 0xfe,
+	0x17, 0x11, 0x47, 0x8b, 0x1b, 0x3f, 0xa1, 0xb8, 0x43, 0x03, 0x92, 0x1c, 0x1e, 0x90, 0x57, 0xaa,
+	0xfd, 0xe7, 0x38, 0x98, 0x6c, 0xd2, 0x60, 0x33, 0x58, 0xa6, 0x7e, 0xdb, 0xeb, 0x8c, 0x52, 0xf6,
+	0x17, 0x06, 0x38, 0xdf, 0xa5, 0x0f, 0xad, 0x16, 0xee, 0x62, 0xdf, 0x26, 0x16, 0x77, 0x43, 0xc2,
+	0x5c, 0xda, 0x75, 0x06, 0xc7, 0xce, 0xeb, 0x3e, 0xee, 0xcf, 0x75, 0xe9, 0xc3, 0x8a, 0x8a, 0xd6,
+	0x8c, 0x82, 0xc1, 0x2b, 0x20, 0xd9, 0xee, 0xf9, 0x0e, 0x09, 0xcd, 0xf8, 0x29, 0x07, 0xb7, 0xc6,
+	0xc1, 0x65, 0x00, 0x3a, 0x21, 0xf6, 0xb9, 0x25, 0x02, 0x48, 0xd2, 0xcf, 0xbe, 0xe4, 0x32, 0x5c,
+	0x91, 0x0e, 0xb7, 0x05, 0xb8, 0xb9, 0x1b, 0x10, 0x94, 0xee, 0x44, 0x9f, 0x90, 0x8b, 0x3b, 0x2e,
+	0xb0, 0x7a, 0x81, 0x85, 0xb7, 0x68, 0xcf, 0xe7, 0xe6, 0xf8, 0x1b, 0x2a, 0x7a, 0x92, 0x8b, 0xf6,
+	0x94, 0x65, 0x10, 0xdd, 0xb4, 0xdf, 0x0d, 0x30, 0x11, 0x5d, 0x7a, 0x23, 0x34, 0xec, 0x06, 0x48,
+	0xeb, 0x0b, 0x99, 0x86, 0x66, 0xec, 0x14, 0xb2, 0x0e, 0xa0, 0xd0, 0x05, 0x49, 0x5d, 0x63, 0xfc,
+	0x0d, 0xd5, 0x98, 0xc4, 0x87, 0xcb, 0xfb, 0x32, 0x06, 0xa6, 0x87, 0x9e, 0x21, 0xa2, 0x4c, 0xfd,
+	0x8e, 0xd1, 0x65, 0xa6, 0x55, 0x99, 0x0a, 0x27, 0xca, 0x54, 0xe6, 0xba, 0x03, 0x2b, 0x00, 0x32,
+	0xda, 0xe6, 0x07, 0x0f, 0x1e, 0xab, 0x15, 0x30, 0xb5, 0x31, 0x2b, 0xb3, 0xfd, 0xbd, 0x5c, 0xa6,
+	0x41, 0xdb, 0xfc, 0xe0, 0x81, 0xb3, 0xd1, 0x40, 0x19, 0x76, 0x44, 0x13, 0x88, 0xf3, 0x1b, 0xba,
+	0x38, 0x74, 0x86, 0xd6, 0x88, 0x1f, 0xac, 0xb1, 0x8a, 0x43, 0xe7, 0xe8, 0x1a, 0xee, 0x11, 0x4d,
+	0xc0, 0xe0, 0x2d, 0x00, 0x1c, 0x12, 0x7a, 0xdb, 0x52, 0xa1, 0xaf, 0x81, 0xdc, 0x89, 0x63, 0x56,
+	0x1d, 0xc0, 0xd0, 0x21, 0x17, 0xcd, 0xc6, 0x0f, 0x06, 0x00, 0x07, 0x00, 0xf8, 0x11, 0x48, 0x6e,
+	0x11, 0xee, 0x52, 0xc5, 0xc2, 0xd9, 0xa5, 0xf7, 0x4e, 0x59, 0xf1, 0xae, 0x04, 0x23, 0xed, 0x04,
+	0x6f, 0x81, 0x19, 0x46, 0x7b, 0xa1, 0x4d, 0xac, 0x01, 0x9d, 0xea, 0x99, 0x90, 0xae, 0x9c, 0xeb,
+	0xef, 0xe5, 0xa6, 0x1b, 0xd2, 0x18, 0xb1, 0xca, 0xd0, 0x34, 0x3b, 0xac, 0x70, 0xe4, 0xd3, 0x85,
+	0xec, 0x04, 0xd4, 0x27, 0x72, 0x1c, 0x8c, 0xc2, 0x38, 0x1a, 0xc8, 0x3a, 0xe1, 0x5f, 0x0c, 0x30,
+	0xab, 0xa6, 0x4f, 0x7a, 0xf1, 0x5e, 0x48, 0xd6, 0x43, 0xb1, 0xef, 0x0e, 0x9f, 0x93, 0xc6, 0x4b,
+	0x6f, 0xda, 0x11, 0xdf, 0x2c, 0x47, 0x0f, 0xc1, 0xf8, 0xf0, 0x21, 0x78, 0x1d, 0x4c, 0xe8, 0xe7,
+	0xbd, 0xde, 0xe8, 0xf3, 0x27, 0x2d, 0x5c, 0x53, 0x10, 0x14, 0x61, 0x6f, 0x26, 0x1e, 0x3d, 0xce,
+	0x8d, 0x2d, 0x3c, 0x04, 0xd3, 0x43, 0x27, 0x00, 0x7c, 0x1b, 0x5c, 0x5a, 0xd9, 0x5c, 0xab, 0xd6,
+	0x90, 0x75, 0x1b, 0x95, 0xd7, 0x9a, 0x56, 0xf3, 0x93, 0x8d, 0x9a, 0xb5, 0xb9, 0xd6, 0xd8, 0xa8,
+	0x2d, 0xd7, 0x57, 0xea, 0xb5, 0x6a, 0x66, 0x0c, 0x66, 0xc1, 0xdc, 0x71, 0xc8, 0x4a, 0xad, 0x26,
+	0xa5, 0x8c, 0x01, 0xe7, 0xc1, 0x85, 0xe3, 0xf6, 0xf2, 0x66, 0x73, 0xf5, 0x7e, 0x26, 0x36, 0x97,
+	0x78, 0xf4, 0x5d, 0x76, 0x6c, 0xe1, 0x1b, 0x79, 0x23, 0x1d, 0x6d, 0xa1, 0x08, 0x5d, 0xad, 0xa1,
+	0xfa, 0xbd, 0x72, 0xb3, 0xbe, 0xbe, 0x66, 0xdd, 0xad, 0x35, 0x57, 0xd7, 0xab, 0x43, 0xa1, 0xe7,
+	0xc1, 0x85, 0xe3, 0x90, 0x65, 0xb4, 0xde, 0x68, 0x64, 0x0c, 0x78, 0x09, 0x5c, 0x3c, 0x6e, 0xac,
+	0xaf, 0xdd, 0xab, 0xa1, 0x46, 0x2d, 0x13, 0x3b, 0xd9, 0x8c, 0x6a, 0x8d, 0xe5, 0xf2, 0x9d, 0x5a,
+	0x26, 0xae, 0x12, 0xab, 0xdc, 0x7d, 0xd2, 0xcf, 0x1a, 0xcf, 0xfa, 0x59, 0xe3, 0x45, 0x3f, 0x6b,
+	0xfc, 0xd9, 0xcf, 0x1a, 0x5f, 0xed, 0x67, 0xc7, 0x5e, 0xec, 0x67, 0xc7, 0x7e, 0xdb, 0xcf, 0x8e,
+	0xdd, 0x2f, 0x1d, 0xda, 0xf5, 0x82, 0x65, 0xf9, 0x0e, 0xb1, 0x69, 0xb7, 0x64, 0xbb, 0xd8, 0xf3,
+	0x4b, 0xdb, 0xd7, 0x4a, 0x3b, 0xd1, 0x0f, 0x46, 0x79, 0x04, 0xb4, 0x92, 0x12, 0x71, 0xed, 0xdf,
+	0x01, 0x00, 0x6c, 0xf0, 0xea, 0x2a, 0x4c, 0x0e, 0x00, 0x00,
 }
 
 func (this *Tunnel) Equal(that interface{}) bool {
@@ -653,6 +1149,17 @@ func (this *Tunnel) Equal(that interface{}) bool {
 	if this.Creator != that1.Creator {
 		return false
 	}
+	if len(this.Routes) != len(that1.Routes) {
+		return false
+	}
+	for i := range this.Routes {
+		if !this.Routes[i].Equal(that1.Routes[i]) {
+			return false
+		}
+	}
+	if this.Schedule != that1.Schedule {
+		return false
+	}
 	return true
 }
 func (this *LatestPrices) Equal(that interface{}) bool {
@@ -758,6 +1265,144 @@ func (this *Packet) Equal(that interface{}) bool {
 	if this.CreatedAt != that1.CreatedAt {
 		return false
 	}
+	if len(this.Receipts) != len(that1.Receipts) {
+		return false
+	}
+	for i := range this.Receipts {
+		if !this.Receipts[i].Equal(that1.Receipts[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PacketRetry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PacketRetry)
+	if !ok {
+		that2, ok := that.(PacketRetry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TunnelID != that1.TunnelID {
+		return false
+	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	if this.RouteIndex != that1.RouteIndex {
+		return false
+	}
+	if this.Attempts != that1.Attempts {
+		return false
+	}
+	if this.NextRetryHeight != that1.NextRetryHeight {
+		return false
+	}
+	if this.LastError != that1.LastError {
+		return false
+	}
+	if !bytes.Equal(this.RouteHash, that1.RouteHash) {
+		return false
+	}
+	return true
+}
+func (this *DeadLetterPacket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeadLetterPacket)
+	if !ok {
+		that2, ok := that.(DeadLetterPacket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TunnelID != that1.TunnelID {
+		return false
+	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	if this.RouteIndex != that1.RouteIndex {
+		return false
+	}
+	if this.Attempts != that1.Attempts {
+		return false
+	}
+	if this.LastError != that1.LastError {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if !bytes.Equal(this.RouteHash, that1.RouteHash) {
+		return false
+	}
+	return true
+}
+func (this *TopUpConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TopUpConfig)
+	if !ok {
+		that2, ok := that.(TopUpConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TunnelID != that1.TunnelID {
+		return false
+	}
+	if len(this.LowBalanceThreshold) != len(that1.LowBalanceThreshold) {
+		return false
+	}
+	for i := range this.LowBalanceThreshold {
+		if !this.LowBalanceThreshold[i].Equal(&that1.LowBalanceThreshold[i]) {
+			return false
+		}
+	}
+	if this.Funder != that1.Funder {
+		return false
+	}
+	if this.GrantType != that1.GrantType {
+		return false
+	}
+	if len(this.TopUpAmount) != len(that1.TopUpAmount) {
+		return false
+	}
+	for i := range this.TopUpAmount {
+		if !this.TopUpAmount[i].Equal(&that1.TopUpAmount[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *Deposit) Equal(that interface{}) bool {
@@ -823,6 +1468,44 @@ func (this *SignalDeviation) Equal(that interface{}) bool {
 	if this.HardDeviationBPS != that1.HardDeviationBPS {
 		return false
 	}
+	if !this.Derivation.Equal(that1.Derivation) {
+		return false
+	}
+	return true
+}
+func (this *Derivation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Derivation)
+	if !ok {
+		that2, ok := that.(Derivation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Method != that1.Method {
+		return false
+	}
+	if len(this.SourceSignalIDs) != len(that1.SourceSignalIDs) {
+		return false
+	}
+	for i := range this.SourceSignalIDs {
+		if this.SourceSignalIDs[i] != that1.SourceSignalIDs[i] {
+			return false
+		}
+	}
+	if this.Exponent != that1.Exponent {
+		return false
+	}
 	return true
 }
 func (this *TunnelSignatureOrder) Equal(that interface{}) bool {
@@ -883,6 +1566,27 @@ func (m *Tunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Schedule) > 0 {
+		i -= len(m.Schedule)
+		copy(dAtA[i:], m.Schedule)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.Schedule)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTunnel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -1074,6 +1778,20 @@ func (m *Packet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTunnel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.CreatedAt != 0 {
 		i = encodeVarintTunnel(dAtA, i, uint64(m.CreatedAt))
 		i--
@@ -1118,7 +1836,7 @@ func (m *Packet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Deposit) Marshal() (dAtA []byte, err error) {
+func (m *PacketRetry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1128,41 +1846,233 @@ func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *PacketRetry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PacketRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTunnel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintTunnel(dAtA, i, uint64(len(m.Depositor)))
+	if len(m.RouteHash) > 0 {
+		i -= len(m.RouteHash)
+		copy(dAtA[i:], m.RouteHash)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.RouteHash)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x3a
 	}
-	if m.TunnelID != 0 {
-		i = encodeVarintTunnel(dAtA, i, uint64(m.TunnelID))
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.LastError)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x32
+	}
+	if m.NextRetryHeight != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.NextRetryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RouteIndex != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.RouteIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeadLetterPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeadLetterPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeadLetterPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RouteHash) > 0 {
+		i -= len(m.RouteHash)
+		copy(dAtA[i:], m.RouteHash)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.RouteHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RouteIndex != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.RouteIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopUpConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopUpConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopUpConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TopUpAmount) > 0 {
+		for iNdEx := len(m.TopUpAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopUpAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTunnel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.GrantType != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.GrantType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LowBalanceThreshold) > 0 {
+		for iNdEx := len(m.LowBalanceThreshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LowBalanceThreshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTunnel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTunnel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTunnel(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
@@ -1187,6 +2097,18 @@ func (m *SignalDeviation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Derivation != nil {
+		{
+			size, err := m.Derivation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTunnel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.HardDeviationBPS != 0 {
 		i = encodeVarintTunnel(dAtA, i, uint64(m.HardDeviationBPS))
 		i--
@@ -1207,6 +2129,48 @@ func (m *SignalDeviation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Derivation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Derivation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Derivation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exponent != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SourceSignalIDs) > 0 {
+		for iNdEx := len(m.SourceSignalIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SourceSignalIDs[iNdEx])
+			copy(dAtA[i:], m.SourceSignalIDs[iNdEx])
+			i = encodeVarintTunnel(dAtA, i, uint64(len(m.SourceSignalIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Method != 0 {
+		i = encodeVarintTunnel(dAtA, i, uint64(m.Method))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TunnelSignatureOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1315,6 +2279,16 @@ func (m *Tunnel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTunnel(uint64(l))
 	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTunnel(uint64(l))
+		}
+	}
+	l = len(m.Schedule)
+	if l > 0 {
+		n += 1 + l + sovTunnel(uint64(l))
+	}
 	return n
 }
 
@@ -1379,10 +2353,16 @@ func (m *Packet) Size() (n int) {
 	if m.CreatedAt != 0 {
 		n += 1 + sovTunnel(uint64(m.CreatedAt))
 	}
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovTunnel(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *Deposit) Size() (n int) {
+func (m *PacketRetry) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1391,48 +2371,168 @@ func (m *Deposit) Size() (n int) {
 	if m.TunnelID != 0 {
 		n += 1 + sovTunnel(uint64(m.TunnelID))
 	}
-	l = len(m.Depositor)
+	if m.Sequence != 0 {
+		n += 1 + sovTunnel(uint64(m.Sequence))
+	}
+	if m.RouteIndex != 0 {
+		n += 1 + sovTunnel(uint64(m.RouteIndex))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovTunnel(uint64(m.Attempts))
+	}
+	if m.NextRetryHeight != 0 {
+		n += 1 + sovTunnel(uint64(m.NextRetryHeight))
+	}
+	l = len(m.LastError)
 	if l > 0 {
 		n += 1 + l + sovTunnel(uint64(l))
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTunnel(uint64(l))
-		}
+	l = len(m.RouteHash)
+	if l > 0 {
+		n += 1 + l + sovTunnel(uint64(l))
 	}
 	return n
 }
 
-func (m *SignalDeviation) Size() (n int) {
+func (m *DeadLetterPacket) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.SignalID)
+	if m.TunnelID != 0 {
+		n += 1 + sovTunnel(uint64(m.TunnelID))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTunnel(uint64(m.Sequence))
+	}
+	if m.RouteIndex != 0 {
+		n += 1 + sovTunnel(uint64(m.RouteIndex))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovTunnel(uint64(m.Attempts))
+	}
+	l = len(m.LastError)
 	if l > 0 {
 		n += 1 + l + sovTunnel(uint64(l))
 	}
-	if m.SoftDeviationBPS != 0 {
-		n += 1 + sovTunnel(uint64(m.SoftDeviationBPS))
+	if m.CreatedAt != 0 {
+		n += 1 + sovTunnel(uint64(m.CreatedAt))
 	}
-	if m.HardDeviationBPS != 0 {
-		n += 1 + sovTunnel(uint64(m.HardDeviationBPS))
+	l = len(m.RouteHash)
+	if l > 0 {
+		n += 1 + l + sovTunnel(uint64(l))
 	}
 	return n
 }
 
-func (m *TunnelSignatureOrder) Size() (n int) {
+func (m *TopUpConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovTunnel(uint64(m.Sequence))
+	if m.TunnelID != 0 {
+		n += 1 + sovTunnel(uint64(m.TunnelID))
 	}
-	if len(m.Prices) > 0 {
+	if len(m.LowBalanceThreshold) > 0 {
+		for _, e := range m.LowBalanceThreshold {
+			l = e.Size()
+			n += 1 + l + sovTunnel(uint64(l))
+		}
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovTunnel(uint64(l))
+	}
+	if m.GrantType != 0 {
+		n += 1 + sovTunnel(uint64(m.GrantType))
+	}
+	if len(m.TopUpAmount) > 0 {
+		for _, e := range m.TopUpAmount {
+			l = e.Size()
+			n += 1 + l + sovTunnel(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTunnel(uint64(m.TunnelID))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTunnel(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTunnel(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignalDeviation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalID)
+	if l > 0 {
+		n += 1 + l + sovTunnel(uint64(l))
+	}
+	if m.SoftDeviationBPS != 0 {
+		n += 1 + sovTunnel(uint64(m.SoftDeviationBPS))
+	}
+	if m.HardDeviationBPS != 0 {
+		n += 1 + sovTunnel(uint64(m.HardDeviationBPS))
+	}
+	if m.Derivation != nil {
+		l = m.Derivation.Size()
+		n += 1 + l + sovTunnel(uint64(l))
+	}
+	return n
+}
+
+func (m *Derivation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Method != 0 {
+		n += 1 + sovTunnel(uint64(m.Method))
+	}
+	if len(m.SourceSignalIDs) > 0 {
+		for _, s := range m.SourceSignalIDs {
+			l = len(s)
+			n += 1 + l + sovTunnel(uint64(l))
+		}
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovTunnel(uint64(m.Exponent))
+	}
+	return n
+}
+
+func (m *TunnelSignatureOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTunnel(uint64(m.Sequence))
+	}
+	if len(m.Prices) > 0 {
 		for _, e := range m.Prices {
 			l = e.Size()
 			n += 1 + l + sovTunnel(uint64(l))
@@ -1453,7 +2553,804 @@ func sovTunnel(x uint64) (n int) {
 func sozTunnel(x uint64) (n int) {
 	return sovTunnel(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Tunnel) Unmarshal(dAtA []byte) error {
+func (m *Tunnel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTunnel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tunnel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Route == nil {
+				m.Route = &types.Any{}
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalDeviations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalDeviations = append(m.SignalDeviations, SignalDeviation{})
+			if err := m.SignalDeviations[len(m.SignalDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalDeposit = append(m.TotalDeposit, types1.Coin{})
+			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, &types.Any{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTunnel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTunnel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, types2.Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInterval", wireType)
+			}
+			m.LastInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTunnel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTunnel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBasePacketFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBasePacketFee = append(m.TotalBasePacketFee, types1.Coin{})
+			if err := m.TotalBasePacketFee[len(m.TotalBasePacketFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTunnel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Packet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTunnel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Packet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Packet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, types2.Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Receipt == nil {
+				m.Receipt = &types.Any{}
+			}
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipts = append(m.Receipts, &types.Any{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTunnel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketRetry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1476,17 +3373,17 @@ func (m *Tunnel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tunnel: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketRetry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketRetry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
 			}
-			m.ID = 0
+			m.TunnelID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -1496,7 +3393,7 @@ func (m *Tunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.TunnelID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1521,10 +3418,10 @@ func (m *Tunnel) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteIndex", wireType)
 			}
-			var msglen int
+			m.RouteIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -1534,33 +3431,16 @@ func (m *Tunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RouteIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTunnel
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTunnel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Route == nil {
-				m.Route = &types.Any{}
-			}
-			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
-			var stringLen uint64
+			m.Attempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -1570,63 +3450,16 @@ func (m *Tunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Attempts |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTunnel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTunnel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FeePayer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalDeviations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTunnel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTunnel
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTunnel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SignalDeviations = append(m.SignalDeviations, SignalDeviation{})
-			if err := m.SignalDeviations[len(m.SignalDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryHeight", wireType)
 			}
-			m.Interval = 0
+			m.NextRetryHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -1636,16 +3469,16 @@ func (m *Tunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Interval |= uint64(b&0x7F) << shift
+				m.NextRetryHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -1655,70 +3488,29 @@ func (m *Tunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTunnel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTunnel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDeposit = append(m.TotalDeposit, types1.Coin{})
-			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTunnel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsActive = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-			}
-			m.CreatedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTunnel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -1728,23 +3520,25 @@ func (m *Tunnel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTunnel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTunnel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.RouteHash = append(m.RouteHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RouteHash == nil {
+				m.RouteHash = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1767,7 +3561,7 @@ func (m *Tunnel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LatestPrices) Unmarshal(dAtA []byte) error {
+func (m *DeadLetterPacket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1790,10 +3584,10 @@ func (m *LatestPrices) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LatestPrices: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeadLetterPacket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LatestPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeadLetterPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1816,10 +3610,10 @@ func (m *LatestPrices) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var msglen int
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -1829,31 +3623,35 @@ func (m *LatestPrices) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTunnel
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTunnel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteIndex", wireType)
 			}
-			m.Prices = append(m.Prices, types2.Price{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RouteIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RouteIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
-			m.LastInterval = 0
+			m.Attempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -1863,66 +3661,67 @@ func (m *LatestPrices) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastInterval |= int64(b&0x7F) << shift
+				m.Attempts |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTunnel(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTunnel
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTunnel
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TotalFees) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTunnel
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TotalFees: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalFees: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBasePacketFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -1932,24 +3731,24 @@ func (m *TotalFees) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTunnel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTunnel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalBasePacketFee = append(m.TotalBasePacketFee, types1.Coin{})
-			if err := m.TotalBasePacketFee[len(m.TotalBasePacketFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RouteHash = append(m.RouteHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RouteHash == nil {
+				m.RouteHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1973,7 +3772,7 @@ func (m *TotalFees) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Packet) Unmarshal(dAtA []byte) error {
+func (m *TopUpConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1996,10 +3795,10 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Packet: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopUpConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Packet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopUpConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2022,27 +3821,8 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTunnel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LowBalanceThreshold", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2069,16 +3849,16 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, types2.Price{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LowBalanceThreshold = append(m.LowBalanceThreshold, types1.Coin{})
+			if err := m.LowBalanceThreshold[len(m.LowBalanceThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -2088,33 +3868,48 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTunnel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTunnel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Receipt == nil {
-				m.Receipt = &types.Any{}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantType", wireType)
 			}
-			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.GrantType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GrantType |= FunderGrantType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpAmount", wireType)
 			}
-			m.CreatedAt = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTunnel
@@ -2124,11 +3919,26 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopUpAmount = append(m.TopUpAmount, types1.Coin{})
+			if err := m.TopUpAmount[len(m.TopUpAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTunnel(dAtA[iNdEx:])
@@ -2384,6 +4194,162 @@ func (m *SignalDeviation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Derivation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Derivation == nil {
+				m.Derivation = &Derivation{}
+			}
+			if err := m.Derivation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTunnel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Derivation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTunnel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Derivation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Derivation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			m.Method = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Method |= DerivationMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceSignalIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTunnel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceSignalIDs = append(m.SourceSignalIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTunnel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTunnel(dAtA[iNdEx:])
@@ -2,22 +2,58 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
 	"github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
-func TestGetSetRoute(t *testing.T) {
+func TestGetSetRoutes(t *testing.T) {
 	tunnel := types.Tunnel{}
-	route := &types.TSSRoute{DestinationChainID: "chain-1", DestinationContractAddress: "contract-1"}
+	tssRoute := &types.TSSRoute{DestinationChainID: "chain-1", DestinationContractAddress: "contract-1"}
+	ibcRoute := types.NewIBCRoute("channel-0")
+
+	err := tunnel.SetRoutes(tssRoute, ibcRoute)
+	require.NoError(t, err)
 
-	err := tunnel.SetRoute(route)
+	routeValues, err := tunnel.GetRouteValues()
 	require.NoError(t, err)
+	require.Equal(t, []types.RouteI{tssRoute, ibcRoute}, routeValues)
+	require.Equal(t, tunnel.Routes[0], tunnel.Route)
 
-	routeValue, err := tunnel.GetRouteValue()
+	// updating the first route also updates the route kept for clients of single route tunnels
+	any, err := codectypes.NewAnyWithValue(types.NewIBCRoute("channel-1"))
 	require.NoError(t, err)
-	require.Equal(t, route, routeValue)
+	tunnel.UpdateRoute(0, any)
+	require.Equal(t, any, tunnel.Route)
+	require.Equal(t, any, tunnel.Routes[0])
+}
+
+func TestGetRouteHash(t *testing.T) {
+	tunnel := types.Tunnel{}
+	err := tunnel.SetRoutes(types.NewIBCRoute("channel-0"), types.NewIBCRoute("channel-1"))
+	require.NoError(t, err)
+
+	hash0, err := tunnel.GetRouteHash(0)
+	require.NoError(t, err)
+	hash1, err := tunnel.GetRouteHash(1)
+	require.NoError(t, err)
+	require.NotEqual(t, hash0, hash1)
+
+	_, err = tunnel.GetRouteHash(2)
+	require.ErrorIs(t, err, types.ErrInvalidRoute)
+
+	// the hash changes when the route is updated
+	any, err := codectypes.NewAnyWithValue(types.NewIBCRoute("channel-2"))
+	require.NoError(t, err)
+	tunnel.UpdateRoute(0, any)
+
+	updatedHash, err := tunnel.GetRouteHash(0)
+	require.NoError(t, err)
+	require.NotEqual(t, hash0, updatedHash)
 }
 
 func TestGetSignalDeviationMap(t *testing.T) {
@@ -42,6 +78,44 @@ func TestGetSignalIDs(t *testing.T) {
 	require.Contains(t, signalIDs, "signal2")
 }
 
+func TestGetFeedSignalIDs(t *testing.T) {
+	tunnel := types.Tunnel{SignalDeviations: []types.SignalDeviation{
+		{SignalID: "CS:BTC-USD", SoftDeviationBPS: 100, HardDeviationBPS: 200},
+		{
+			SignalID:         "DS:ETH-BTC",
+			SoftDeviationBPS: 100,
+			HardDeviationBPS: 200,
+			Derivation: types.NewDerivation(
+				types.DERIVATION_METHOD_CROSS,
+				[]string{"CS:ETH-USD", "CS:BTC-USD"},
+				0,
+			),
+		},
+	}}
+
+	require.Equal(t, []string{"CS:BTC-USD", "CS:ETH-USD"}, tunnel.GetFeedSignalIDs())
+}
+
+func TestNextTriggerTime(t *testing.T) {
+	lastInterval := time.Date(2024, 11, 29, 16, 0, 30, 0, time.UTC).Unix()
+
+	// without a schedule, the next trigger follows the interval
+	tunnel := types.Tunnel{Interval: 60}
+	next, err := tunnel.NextTriggerTime(lastInterval)
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(lastInterval+60, 0).UTC(), next)
+
+	// with a schedule, the next trigger follows the schedule
+	tunnel.Schedule = "0 16 * * 1-5"
+	next, err = tunnel.NextTriggerTime(lastInterval)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 12, 2, 16, 0, 0, 0, time.UTC), next)
+
+	tunnel.Schedule = "invalid"
+	_, err = tunnel.NextTriggerTime(lastInterval)
+	require.ErrorIs(t, err, types.ErrInvalidSchedule)
+}
+
 func TestValidateInterval(t *testing.T) {
 	tests := []struct {
 		name        string
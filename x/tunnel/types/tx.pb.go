@@ -40,12 +40,17 @@ type MsgCreateTunnel struct {
 	SignalDeviations []SignalDeviation `protobuf:"bytes,1,rep,name=signal_deviations,json=signalDeviations,proto3" json:"signal_deviations"`
 	// interval is the interval for delivering the signal prices in seconds.
 	Interval uint64 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
-	// route is the route for delivering the signal prices
+	// route is the route for delivering the signal prices. It is kept for clients of single route tunnels and
+	// cannot be set together with routes.
 	Route *types.Any `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
 	// initial_deposit is the deposit value that must be paid at tunnel creation.
 	InitialDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=initial_deposit,json=initialDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_deposit"`
 	// creator is the address of the creator.
 	Creator string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	// routes is the list of routes for delivering the signal prices
+	Routes []*types.Any `protobuf:"bytes,6,rep,name=routes,proto3" json:"routes,omitempty"`
+	// schedule is an optional cron expression in UTC for delivering the signal prices.
+	Schedule string `protobuf:"bytes,7,opt,name=schedule,proto3" json:"schedule,omitempty"`
 }
 
 func (m *MsgCreateTunnel) Reset()         { *m = MsgCreateTunnel{} }
@@ -116,6 +121,20 @@ func (m *MsgCreateTunnel) GetCreator() string {
 	return ""
 }
 
+func (m *MsgCreateTunnel) GetRoutes() []*types.Any {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *MsgCreateTunnel) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
 // MsgCreateTunnelResponse is the response type for the Msg/CreateTunnel RPC method.
 type MsgCreateTunnelResponse struct {
 	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
@@ -169,6 +188,8 @@ type MsgUpdateRoute struct {
 	Route *types.Any `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
 	// creator is the address of the creator.
 	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// route_index is the index of the route to update in the routes of the tunnel.
+	RouteIndex uint64 `protobuf:"varint,4,opt,name=route_index,json=routeIndex,proto3" json:"route_index,omitempty"`
 }
 
 func (m *MsgUpdateRoute) Reset()         { *m = MsgUpdateRoute{} }
@@ -225,6 +246,13 @@ func (m *MsgUpdateRoute) GetCreator() string {
 	return ""
 }
 
+func (m *MsgUpdateRoute) GetRouteIndex() uint64 {
+	if m != nil {
+		return m.RouteIndex
+	}
+	return 0
+}
+
 // MsgUpdateRouteResponse is the response type for the Msg/UpdateRoute RPC method.
 type MsgUpdateRouteResponse struct {
 }
@@ -272,6 +300,8 @@ type MsgUpdateSignalsAndInterval struct {
 	Interval uint64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
 	// creator is the address of the creator.
 	Creator string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	// schedule is an optional cron expression in UTC for delivering the signal prices.
+	Schedule string `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"`
 }
 
 func (m *MsgUpdateSignalsAndInterval) Reset()         { *m = MsgUpdateSignalsAndInterval{} }
@@ -335,6 +365,13 @@ func (m *MsgUpdateSignalsAndInterval) GetCreator() string {
 	return ""
 }
 
+func (m *MsgUpdateSignalsAndInterval) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
 // MsgUpdateSignalsAndIntervalResponse is the response type for the Msg/UpdateSignalsAndInterval RPC method.
 type MsgUpdateSignalsAndIntervalResponse struct {
 }
@@ -749,6 +786,337 @@ func (m *MsgTriggerTunnelResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTriggerTunnelResponse proto.InternalMessageInfo
 
+// MsgRetryPacket is the transaction message to manually retry a dead-lettered packet delivery of a tunnel.
+type MsgRetryPacket struct {
+	// tunnel_id is the ID of the tunnel of the packet.
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// sequence is the sequence of the packet to retry.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// route_index is the index of the route to retry.
+	RouteIndex uint64 `protobuf:"varint,3,opt,name=route_index,json=routeIndex,proto3" json:"route_index,omitempty"`
+	// creator is the address of the creator.
+	Creator string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *MsgRetryPacket) Reset()         { *m = MsgRetryPacket{} }
+func (m *MsgRetryPacket) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryPacket) ProtoMessage()    {}
+func (*MsgRetryPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{14}
+}
+func (m *MsgRetryPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryPacket.Merge(m, src)
+}
+func (m *MsgRetryPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryPacket proto.InternalMessageInfo
+
+func (m *MsgRetryPacket) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
+	}
+	return 0
+}
+
+func (m *MsgRetryPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *MsgRetryPacket) GetRouteIndex() uint64 {
+	if m != nil {
+		return m.RouteIndex
+	}
+	return 0
+}
+
+func (m *MsgRetryPacket) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// MsgRetryPacketResponse is the response type for the Msg/RetryPacket RPC method.
+type MsgRetryPacketResponse struct {
+}
+
+func (m *MsgRetryPacketResponse) Reset()         { *m = MsgRetryPacketResponse{} }
+func (m *MsgRetryPacketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryPacketResponse) ProtoMessage()    {}
+func (*MsgRetryPacketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{15}
+}
+func (m *MsgRetryPacketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryPacketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryPacketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryPacketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryPacketResponse.Merge(m, src)
+}
+func (m *MsgRetryPacketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryPacketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryPacketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryPacketResponse proto.InternalMessageInfo
+
+// MsgSetTopUpConfig is the transaction message to set the low balance threshold and the funder of a tunnel.
+type MsgSetTopUpConfig struct {
+	// tunnel_id is the ID of the tunnel.
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// low_balance_threshold is the balance of the fee payer below which a low balance event is emitted
+	// and the fee payer is topped up.
+	LowBalanceThreshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=low_balance_threshold,json=lowBalanceThreshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"low_balance_threshold"`
+	// funder is the address of the account that tops up the fee payer. It is optional.
+	Funder string `protobuf:"bytes,3,opt,name=funder,proto3" json:"funder,omitempty"`
+	// grant_type is the type of grant that the funder gives to the fee payer.
+	GrantType FunderGrantType `protobuf:"varint,4,opt,name=grant_type,json=grantType,proto3,enum=band.tunnel.v1beta1.FunderGrantType" json:"grant_type,omitempty"`
+	// top_up_amount is the amount that the funder sends to the fee payer for each top-up.
+	TopUpAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=top_up_amount,json=topUpAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"top_up_amount"`
+	// creator is the address of the creator.
+	Creator string `protobuf:"bytes,6,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *MsgSetTopUpConfig) Reset()         { *m = MsgSetTopUpConfig{} }
+func (m *MsgSetTopUpConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTopUpConfig) ProtoMessage()    {}
+func (*MsgSetTopUpConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{16}
+}
+func (m *MsgSetTopUpConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTopUpConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTopUpConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTopUpConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTopUpConfig.Merge(m, src)
+}
+func (m *MsgSetTopUpConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTopUpConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTopUpConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTopUpConfig proto.InternalMessageInfo
+
+func (m *MsgSetTopUpConfig) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
+	}
+	return 0
+}
+
+func (m *MsgSetTopUpConfig) GetLowBalanceThreshold() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LowBalanceThreshold
+	}
+	return nil
+}
+
+func (m *MsgSetTopUpConfig) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *MsgSetTopUpConfig) GetGrantType() FunderGrantType {
+	if m != nil {
+		return m.GrantType
+	}
+	return FUNDER_GRANT_TYPE_UNSPECIFIED
+}
+
+func (m *MsgSetTopUpConfig) GetTopUpAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TopUpAmount
+	}
+	return nil
+}
+
+func (m *MsgSetTopUpConfig) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// MsgSetTopUpConfigResponse is the response type for the Msg/SetTopUpConfig RPC method.
+type MsgSetTopUpConfigResponse struct {
+}
+
+func (m *MsgSetTopUpConfigResponse) Reset()         { *m = MsgSetTopUpConfigResponse{} }
+func (m *MsgSetTopUpConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTopUpConfigResponse) ProtoMessage()    {}
+func (*MsgSetTopUpConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{17}
+}
+func (m *MsgSetTopUpConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTopUpConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTopUpConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTopUpConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTopUpConfigResponse.Merge(m, src)
+}
+func (m *MsgSetTopUpConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTopUpConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTopUpConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTopUpConfigResponse proto.InternalMessageInfo
+
+// MsgRemoveTopUpConfig is the transaction message to remove the low balance threshold and the funder of a tunnel.
+type MsgRemoveTopUpConfig struct {
+	// tunnel_id is the ID of the tunnel.
+	TunnelID uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// creator is the address of the creator.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *MsgRemoveTopUpConfig) Reset()         { *m = MsgRemoveTopUpConfig{} }
+func (m *MsgRemoveTopUpConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveTopUpConfig) ProtoMessage()    {}
+func (*MsgRemoveTopUpConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{18}
+}
+func (m *MsgRemoveTopUpConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveTopUpConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveTopUpConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveTopUpConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveTopUpConfig.Merge(m, src)
+}
+func (m *MsgRemoveTopUpConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveTopUpConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveTopUpConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveTopUpConfig proto.InternalMessageInfo
+
+func (m *MsgRemoveTopUpConfig) GetTunnelID() uint64 {
+	if m != nil {
+		return m.TunnelID
+	}
+	return 0
+}
+
+func (m *MsgRemoveTopUpConfig) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// MsgRemoveTopUpConfigResponse is the response type for the Msg/RemoveTopUpConfig RPC method.
+type MsgRemoveTopUpConfigResponse struct {
+}
+
+func (m *MsgRemoveTopUpConfigResponse) Reset()         { *m = MsgRemoveTopUpConfigResponse{} }
+func (m *MsgRemoveTopUpConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveTopUpConfigResponse) ProtoMessage()    {}
+func (*MsgRemoveTopUpConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18351d83b4705d0, []int{19}
+}
+func (m *MsgRemoveTopUpConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveTopUpConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveTopUpConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveTopUpConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveTopUpConfigResponse.Merge(m, src)
+}
+func (m *MsgRemoveTopUpConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveTopUpConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveTopUpConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveTopUpConfigResponse proto.InternalMessageInfo
+
 // MsgDepositToTunnel defines a message to deposit to an existing tunnel.
 type MsgDepositToTunnel struct {
 	// tunnel_id defines the unique id of the tunnel.
@@ -763,7 +1131,7 @@ func (m *MsgDepositToTunnel) Reset()         { *m = MsgDepositToTunnel{} }
 func (m *MsgDepositToTunnel) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositToTunnel) ProtoMessage()    {}
 func (*MsgDepositToTunnel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d18351d83b4705d0, []int{14}
+	return fileDescriptor_d18351d83b4705d0, []int{20}
 }
 func (m *MsgDepositToTunnel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -821,7 +1189,7 @@ func (m *MsgDepositToTunnelResponse) Reset()         { *m = MsgDepositToTunnelRe
 func (m *MsgDepositToTunnelResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositToTunnelResponse) ProtoMessage()    {}
 func (*MsgDepositToTunnelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d18351d83b4705d0, []int{15}
+	return fileDescriptor_d18351d83b4705d0, []int{21}
 }
 func (m *MsgDepositToTunnelResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -864,7 +1232,7 @@ func (m *MsgWithdrawFromTunnel) Reset()         { *m = MsgWithdrawFromTunnel{} }
 func (m *MsgWithdrawFromTunnel) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawFromTunnel) ProtoMessage()    {}
 func (*MsgWithdrawFromTunnel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d18351d83b4705d0, []int{16}
+	return fileDescriptor_d18351d83b4705d0, []int{22}
 }
 func (m *MsgWithdrawFromTunnel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -922,7 +1290,7 @@ func (m *MsgWithdrawFromTunnelResponse) Reset()         { *m = MsgWithdrawFromTu
 func (m *MsgWithdrawFromTunnelResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawFromTunnelResponse) ProtoMessage()    {}
 func (*MsgWithdrawFromTunnelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d18351d83b4705d0, []int{17}
+	return fileDescriptor_d18351d83b4705d0, []int{23}
 }
 func (m *MsgWithdrawFromTunnelResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,7 +1331,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d18351d83b4705d0, []int{18}
+	return fileDescriptor_d18351d83b4705d0, []int{24}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1014,7 +1382,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d18351d83b4705d0, []int{19}
+	return fileDescriptor_d18351d83b4705d0, []int{25}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1058,6 +1426,12 @@ func init() {
 	proto.RegisterType((*MsgDeactivateTunnelResponse)(nil), "band.tunnel.v1beta1.MsgDeactivateTunnelResponse")
 	proto.RegisterType((*MsgTriggerTunnel)(nil), "band.tunnel.v1beta1.MsgTriggerTunnel")
 	proto.RegisterType((*MsgTriggerTunnelResponse)(nil), "band.tunnel.v1beta1.MsgTriggerTunnelResponse")
+	proto.RegisterType((*MsgRetryPacket)(nil), "band.tunnel.v1beta1.MsgRetryPacket")
+	proto.RegisterType((*MsgRetryPacketResponse)(nil), "band.tunnel.v1beta1.MsgRetryPacketResponse")
+	proto.RegisterType((*MsgSetTopUpConfig)(nil), "band.tunnel.v1beta1.MsgSetTopUpConfig")
+	proto.RegisterType((*MsgSetTopUpConfigResponse)(nil), "band.tunnel.v1beta1.MsgSetTopUpConfigResponse")
+	proto.RegisterType((*MsgRemoveTopUpConfig)(nil), "band.tunnel.v1beta1.MsgRemoveTopUpConfig")
+	proto.RegisterType((*MsgRemoveTopUpConfigResponse)(nil), "band.tunnel.v1beta1.MsgRemoveTopUpConfigResponse")
 	proto.RegisterType((*MsgDepositToTunnel)(nil), "band.tunnel.v1beta1.MsgDepositToTunnel")
 	proto.RegisterType((*MsgDepositToTunnelResponse)(nil), "band.tunnel.v1beta1.MsgDepositToTunnelResponse")
 	proto.RegisterType((*MsgWithdrawFromTunnel)(nil), "band.tunnel.v1beta1.MsgWithdrawFromTunnel")
@@ -1069,77 +1443,96 @@ func init() {
 func init() { proto.RegisterFile("band/tunnel/v1beta1/tx.proto", fileDescriptor_d18351d83b4705d0) }
 
 var fileDescriptor_d18351d83b4705d0 = []byte{
-	// 1117 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xbd, 0x6f, 0xdb, 0x46,
-	0x14, 0x37, 0x25, 0xdb, 0xb5, 0x9f, 0x5d, 0x7f, 0x30, 0x76, 0x4c, 0xd1, 0x8e, 0x2c, 0x30, 0x5f,
-	0x8a, 0x13, 0x93, 0xfe, 0x40, 0x82, 0x34, 0x9b, 0x15, 0x23, 0x80, 0x07, 0x01, 0x81, 0xe2, 0x22,
-	0x40, 0x17, 0x83, 0x12, 0xaf, 0x14, 0x11, 0x8b, 0x27, 0xf0, 0x4e, 0x4a, 0x34, 0x14, 0x28, 0x3a,
-	0x74, 0xe8, 0xd2, 0x2e, 0x2d, 0x0a, 0x14, 0x1d, 0xba, 0x14, 0x45, 0x97, 0x7a, 0xc8, 0x1f, 0x11,
-	0x74, 0x69, 0xd0, 0xa9, 0x53, 0xda, 0xda, 0x83, 0xff, 0x8d, 0x82, 0xc7, 0xd3, 0xf1, 0xc3, 0x94,
-	0x43, 0x15, 0x81, 0x91, 0x45, 0x12, 0xdf, 0xfb, 0xdd, 0xbd, 0xdf, 0xfb, 0x1d, 0xdf, 0xbd, 0x27,
-	0x58, 0xa9, 0x9b, 0xae, 0x65, 0xd0, 0x8e, 0xeb, 0xa2, 0x43, 0xa3, 0xbb, 0x59, 0x47, 0xd4, 0xdc,
-	0x34, 0xe8, 0x0b, 0xbd, 0xed, 0x61, 0x8a, 0xe5, 0x4b, 0xbe, 0x57, 0x0f, 0xbc, 0x3a, 0xf7, 0xaa,
-	0x05, 0x1b, 0x63, 0xfb, 0x10, 0x19, 0x0c, 0x52, 0xef, 0x7c, 0x6a, 0x98, 0x6e, 0x2f, 0xc0, 0xab,
-	0x0b, 0x36, 0xb6, 0x31, 0xfb, 0x69, 0xf8, 0xbf, 0xb8, 0xb5, 0xd0, 0xc0, 0xa4, 0x85, 0xc9, 0x41,
-	0xe0, 0x08, 0x1e, 0xb8, 0xab, 0x18, 0x3c, 0x19, 0x75, 0x93, 0x20, 0x11, 0xbe, 0x81, 0x1d, 0x97,
-	0xfb, 0x97, 0xb8, 0xbf, 0x45, 0x6c, 0xa3, 0xbb, 0xe9, 0x7f, 0x71, 0xc7, 0xbc, 0xd9, 0x72, 0x5c,
-	0x6c, 0xb0, 0x4f, 0x6e, 0x2a, 0xa5, 0xa5, 0xd2, 0x36, 0x3d, 0xb3, 0x45, 0xce, 0x43, 0xf0, 0xec,
-	0x18, 0x42, 0xfb, 0x2e, 0x0f, 0xb3, 0x55, 0x62, 0x3f, 0xf4, 0x90, 0x49, 0xd1, 0x3e, 0xf3, 0xc8,
-	0x4f, 0x61, 0x9e, 0x38, 0xb6, 0x6b, 0x1e, 0x1e, 0x58, 0xa8, 0xeb, 0x98, 0xd4, 0xc1, 0x2e, 0x51,
-	0xa4, 0x52, 0xbe, 0x3c, 0xb5, 0x75, 0x4d, 0x4f, 0x11, 0x48, 0x7f, 0xc2, 0xd0, 0xbb, 0x7d, 0x70,
-	0x65, 0xf4, 0xd5, 0x9b, 0xd5, 0x91, 0xda, 0x1c, 0x89, 0x9b, 0x89, 0xac, 0xc2, 0x84, 0xe3, 0x52,
-	0xe4, 0x75, 0xcd, 0x43, 0x25, 0x57, 0x92, 0xca, 0xa3, 0x35, 0xf1, 0x2c, 0xdf, 0x83, 0x31, 0x0f,
-	0x77, 0x28, 0x52, 0xf2, 0x25, 0xa9, 0x3c, 0xb5, 0xb5, 0xa0, 0x07, 0xa2, 0xeb, 0x7d, 0xd1, 0xf5,
-	0x1d, 0xb7, 0x57, 0x81, 0xdf, 0x5f, 0xae, 0x8f, 0xd7, 0x7c, 0xd8, 0x5e, 0x2d, 0x80, 0xcb, 0x3d,
-	0x98, 0x75, 0x5c, 0x87, 0x3a, 0x8c, 0x6d, 0x1b, 0x13, 0x87, 0x2a, 0xa3, 0x8c, 0x6a, 0x41, 0xe7,
-	0xc2, 0xfb, 0x52, 0x0b, 0xaa, 0x0f, 0xb1, 0xe3, 0x56, 0xee, 0xfa, 0xfc, 0x7e, 0xfd, 0x7b, 0xb5,
-	0x6c, 0x3b, 0xb4, 0xd9, 0xa9, 0xeb, 0x0d, 0xdc, 0xe2, 0xa7, 0xc4, 0xbf, 0xd6, 0x89, 0xf5, 0xcc,
-	0xa0, 0xbd, 0x36, 0x22, 0x6c, 0x01, 0xf9, 0xe5, 0xf4, 0x68, 0x4d, 0xaa, 0xcd, 0xf0, 0x40, 0xbb,
-	0x41, 0x1c, 0x79, 0x0b, 0x3e, 0x68, 0xf8, 0xba, 0x61, 0x4f, 0x19, 0x2b, 0x49, 0xe5, 0xc9, 0x8a,
-	0xf2, 0xe7, 0xcb, 0xf5, 0x05, 0x1e, 0x75, 0xc7, 0xb2, 0x3c, 0x44, 0xc8, 0x13, 0xea, 0x39, 0xae,
-	0x5d, 0xeb, 0x03, 0x1f, 0xdc, 0xfc, 0xe2, 0xf4, 0x68, 0xad, 0xff, 0xf4, 0xd5, 0xe9, 0xd1, 0xda,
-	0x65, 0x7e, 0x3a, 0x89, 0x43, 0xd0, 0x76, 0x61, 0x29, 0x61, 0xaa, 0x21, 0xd2, 0xc6, 0x2e, 0x41,
-	0xf2, 0x2d, 0x98, 0x0c, 0x16, 0x1d, 0x38, 0x96, 0x22, 0xf9, 0x3a, 0x56, 0xa6, 0x8f, 0xdf, 0xac,
-	0x4e, 0x04, 0xb0, 0xbd, 0xdd, 0xda, 0x44, 0xe0, 0xde, 0xb3, 0xb4, 0x3f, 0x24, 0x98, 0xa9, 0x12,
-	0xfb, 0xe3, 0xb6, 0x65, 0x52, 0xc4, 0x84, 0x1b, 0x62, 0x75, 0x78, 0x26, 0xb9, 0xe1, 0xce, 0x24,
-	0x22, 0x4c, 0x3e, 0xab, 0x30, 0x37, 0x92, 0xc2, 0x2c, 0x86, 0xc2, 0x44, 0xe8, 0x6b, 0x0a, 0x5c,
-	0x8e, 0x5b, 0xfa, 0xb2, 0x68, 0x3f, 0xe7, 0x60, 0x59, 0xb8, 0x82, 0x57, 0x92, 0xec, 0xb8, 0xd6,
-	0x5e, 0xff, 0x0d, 0x1b, 0x22, 0xf1, 0xd4, 0x0a, 0xc8, 0xbd, 0xe3, 0x0a, 0xc8, 0x27, 0x2a, 0x20,
-	0xa2, 0xda, 0x68, 0x56, 0xd5, 0xb6, 0x93, 0xaa, 0x69, 0x49, 0xd5, 0xce, 0x0a, 0xa1, 0x5d, 0x87,
-	0xab, 0xe7, 0xb8, 0x85, 0x9e, 0xdf, 0xe6, 0x40, 0xa9, 0x12, 0xfb, 0xa9, 0x43, 0x9b, 0x96, 0x67,
-	0x3e, 0x7f, 0x84, 0xd0, 0x63, 0xb3, 0x87, 0xbc, 0x47, 0x1d, 0xd7, 0x22, 0xc3, 0x88, 0xd9, 0x84,
-	0x71, 0xb3, 0x85, 0x3b, 0x2e, 0xe5, 0x0a, 0xbe, 0xfb, 0xc2, 0xe4, 0xfb, 0xff, 0xaf, 0xf7, 0x6e,
-	0x23, 0xa9, 0xe0, 0x6a, 0xa8, 0x60, 0x6a, 0xea, 0x9a, 0x06, 0xa5, 0x41, 0x3e, 0xa1, 0xdd, 0x8f,
-	0x12, 0xcc, 0x57, 0x89, 0xbd, 0xd3, 0xa0, 0x4e, 0x37, 0xbc, 0x58, 0x87, 0x10, 0x2d, 0x92, 0x4a,
-	0x2e, 0x6b, 0x2a, 0xb7, 0x92, 0xa9, 0x28, 0x61, 0x2a, 0x71, 0x26, 0xda, 0x32, 0x14, 0xce, 0x18,
-	0x05, 0xf9, 0x9f, 0x24, 0xb8, 0x54, 0x25, 0xf6, 0x2e, 0x32, 0x2f, 0x94, 0xfe, 0xed, 0x24, 0x7d,
-	0x35, 0xa4, 0x9f, 0xe4, 0xa2, 0x5d, 0x61, 0xb5, 0x9e, 0x34, 0x8b, 0x14, 0x7e, 0x90, 0x60, 0xae,
-	0x4a, 0xec, 0x7d, 0xcf, 0xb1, 0x6d, 0xe4, 0x5d, 0x0c, 0xff, 0x72, 0x92, 0xff, 0x52, 0xc8, 0x3f,
-	0x46, 0x44, 0x53, 0x59, 0x61, 0xc5, 0x6c, 0x82, 0xf9, 0xd7, 0x39, 0x90, 0x59, 0x66, 0xac, 0xc7,
-	0xec, 0xe3, 0xe1, 0xb9, 0x5f, 0x5c, 0xbd, 0xdd, 0x83, 0x49, 0xde, 0x73, 0x33, 0x54, 0x5c, 0x08,
-	0x7d, 0x70, 0xc7, 0x57, 0x2a, 0x7c, 0xf6, 0xb5, 0x2a, 0x44, 0xcf, 0x3a, 0x96, 0xba, 0xb6, 0x02,
-	0xea, 0x59, 0xab, 0xd0, 0xeb, 0xfb, 0x1c, 0x2c, 0x46, 0xcb, 0xd1, 0xc3, 0xad, 0xf7, 0x59, 0xb2,
-	0xfb, 0x00, 0xcf, 0x39, 0x55, 0xf4, 0x76, 0xcd, 0x22, 0xd8, 0xe0, 0xa2, 0x8a, 0x18, 0x7c, 0xd5,
-	0x56, 0x52, 0xee, 0x2a, 0x21, 0x80, 0xb6, 0x0a, 0x57, 0x52, 0x1d, 0x42, 0xbb, 0xdf, 0x24, 0x36,
-	0xfc, 0x05, 0x9d, 0xe0, 0x31, 0x1b, 0x1c, 0xfd, 0x33, 0x35, 0x3b, 0xb4, 0x89, 0x3d, 0x87, 0xf6,
-	0x98, 0x6a, 0xe7, 0x9e, 0xa9, 0x80, 0xca, 0x1f, 0xc1, 0x78, 0x30, 0x7a, 0xf2, 0x61, 0x61, 0x39,
-	0xb5, 0x4f, 0x06, 0x41, 0x78, 0x7b, 0xe4, 0x0b, 0x82, 0x7b, 0x2b, 0xdc, 0x2a, 0x31, 0x15, 0x45,
-	0xd9, 0x69, 0x05, 0x36, 0x15, 0x45, 0x4d, 0xfd, 0x64, 0xb6, 0xfe, 0x9d, 0x80, 0x7c, 0x95, 0xd8,
-	0x72, 0x1d, 0xa6, 0x63, 0xd3, 0x6c, 0x7a, 0xc3, 0x4e, 0xcc, 0x56, 0xea, 0x9d, 0x2c, 0x28, 0x31,
-	0x81, 0x1d, 0xc0, 0x54, 0x74, 0xa4, 0xba, 0x3a, 0x68, 0x71, 0x04, 0xa4, 0xde, 0xce, 0x00, 0x12,
-	0x01, 0xbe, 0x94, 0x40, 0x19, 0x38, 0xc8, 0x6c, 0x9c, 0xbf, 0xd3, 0xd9, 0x15, 0xea, 0xfd, 0x61,
-	0x57, 0x08, 0x22, 0x9f, 0xc1, 0x62, 0xfa, 0x00, 0xb0, 0x3e, 0x68, 0xcb, 0x54, 0xb8, 0x7a, 0x77,
-	0x28, 0xb8, 0x08, 0xdf, 0x84, 0x99, 0x44, 0x0f, 0xbd, 0x31, 0x68, 0xa3, 0x38, 0x4e, 0xd5, 0xb3,
-	0xe1, 0x44, 0x24, 0x17, 0xe6, 0xce, 0x34, 0xbc, 0xf2, 0xa0, 0x3d, 0x92, 0x48, 0x75, 0x23, 0x2b,
-	0x52, 0xc4, 0x43, 0xf0, 0x61, 0xbc, 0x3b, 0x5d, 0x1f, 0xb4, 0x45, 0x0c, 0xa6, 0xae, 0x67, 0x82,
-	0x89, 0x30, 0xcf, 0x60, 0x36, 0xd9, 0x4a, 0x6e, 0x0e, 0xe6, 0x1a, 0x03, 0xaa, 0x46, 0x46, 0xa0,
-	0x08, 0x46, 0x41, 0x4e, 0xb9, 0x87, 0xd7, 0xde, 0x7a, 0xf4, 0x02, 0xab, 0x6e, 0x65, 0xc7, 0x8a,
-	0xa8, 0x75, 0x98, 0x8e, 0xdd, 0x60, 0xd7, 0xce, 0x7f, 0xd9, 0x03, 0xd4, 0xe0, 0x82, 0x4f, 0xbb,
-	0x5c, 0xd4, 0xb1, 0xcf, 0xfd, 0x5b, 0xbc, 0xb2, 0xf7, 0xea, 0xb8, 0x28, 0xbd, 0x3e, 0x2e, 0x4a,
-	0xff, 0x1c, 0x17, 0xa5, 0x6f, 0x4e, 0x8a, 0x23, 0xaf, 0x4f, 0x8a, 0x23, 0x7f, 0x9d, 0x14, 0x47,
-	0x3e, 0x31, 0x22, 0xed, 0xc0, 0xdf, 0x98, 0xfd, 0x45, 0x6a, 0xe0, 0x43, 0xa3, 0xd1, 0x34, 0x1d,
-	0xd7, 0xe8, 0x6e, 0x1b, 0x2f, 0xfa, 0xff, 0xc3, 0x59, 0x6f, 0xa8, 0x8f, 0x33, 0xc4, 0xf6, 0x7f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x8c, 0xdd, 0x4e, 0xda, 0x90, 0x10, 0x00, 0x00,
+	// 1423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xbf, 0x6f, 0xdb, 0x46,
+	0x1b, 0x36, 0x25, 0x5b, 0x89, 0x5f, 0x27, 0x76, 0xcc, 0xd8, 0x31, 0x4d, 0x3b, 0xb2, 0xc0, 0xfc,
+	0x52, 0x9c, 0x58, 0xb4, 0x1d, 0x24, 0xc8, 0x97, 0xcd, 0xb2, 0x91, 0x0f, 0x1e, 0x04, 0x04, 0x8a,
+	0x83, 0x00, 0xdf, 0x22, 0x50, 0xe2, 0x85, 0x22, 0x22, 0xf1, 0x14, 0xde, 0x49, 0x8e, 0x86, 0x0f,
+	0xf8, 0xf0, 0xa1, 0xe8, 0xd0, 0xa5, 0xed, 0x50, 0xa0, 0x40, 0x51, 0x14, 0x45, 0x97, 0xa2, 0x4b,
+	0x33, 0xe4, 0x2f, 0xe8, 0x14, 0x74, 0x0a, 0x8a, 0x0e, 0x9d, 0xd2, 0xc2, 0x19, 0xb2, 0x74, 0xe8,
+	0x9f, 0x50, 0x90, 0x3c, 0x9e, 0xc8, 0x33, 0xe5, 0x50, 0x46, 0x12, 0x74, 0xb1, 0x79, 0xef, 0xfb,
+	0xdc, 0xbd, 0xcf, 0xfb, 0xdc, 0xdd, 0x7b, 0x77, 0x82, 0xe5, 0xba, 0xe1, 0x98, 0x3a, 0xed, 0x3a,
+	0x0e, 0x6a, 0xe9, 0xbd, 0x8d, 0x3a, 0xa2, 0xc6, 0x86, 0x4e, 0x9f, 0x96, 0x3a, 0x2e, 0xa6, 0x58,
+	0x3e, 0xeb, 0x79, 0x4b, 0x81, 0xb7, 0xc4, 0xbc, 0xea, 0xa2, 0x85, 0xb1, 0xd5, 0x42, 0xba, 0x0f,
+	0xa9, 0x77, 0x1f, 0xe9, 0x86, 0xd3, 0x0f, 0xf0, 0xea, 0x9c, 0x85, 0x2d, 0xec, 0x7f, 0xea, 0xde,
+	0x17, 0xb3, 0x2e, 0x36, 0x30, 0x69, 0x63, 0x52, 0x0b, 0x1c, 0x41, 0x83, 0xb9, 0xf2, 0x41, 0x4b,
+	0xaf, 0x1b, 0x04, 0xf1, 0xf0, 0x0d, 0x6c, 0x3b, 0xcc, 0xbf, 0xc0, 0xfc, 0x6d, 0x62, 0xe9, 0xbd,
+	0x0d, 0xef, 0x1f, 0x73, 0xcc, 0x1a, 0x6d, 0xdb, 0xc1, 0xba, 0xff, 0x97, 0x99, 0x0a, 0x49, 0xa9,
+	0x74, 0x0c, 0xd7, 0x68, 0x93, 0xa3, 0x10, 0x2c, 0x3b, 0x1f, 0xa1, 0xfd, 0x99, 0x85, 0x99, 0x0a,
+	0xb1, 0xb6, 0x5d, 0x64, 0x50, 0xb4, 0xe7, 0x7b, 0xe4, 0x87, 0x30, 0x4b, 0x6c, 0xcb, 0x31, 0x5a,
+	0x35, 0x13, 0xf5, 0x6c, 0x83, 0xda, 0xd8, 0x21, 0x8a, 0x54, 0xc8, 0x16, 0xa7, 0x36, 0x2f, 0x96,
+	0x12, 0x04, 0x2a, 0xdd, 0xf7, 0xd1, 0x3b, 0x21, 0xb8, 0x3c, 0xfe, 0xe2, 0xd5, 0xca, 0x58, 0xf5,
+	0x0c, 0x89, 0x9b, 0x89, 0xac, 0xc2, 0x49, 0xdb, 0xa1, 0xc8, 0xed, 0x19, 0x2d, 0x25, 0x53, 0x90,
+	0x8a, 0xe3, 0x55, 0xde, 0x96, 0x6f, 0xc1, 0x84, 0x8b, 0xbb, 0x14, 0x29, 0xd9, 0x82, 0x54, 0x9c,
+	0xda, 0x9c, 0x2b, 0x05, 0xa2, 0x97, 0x42, 0xd1, 0x4b, 0x5b, 0x4e, 0xbf, 0x0c, 0x3f, 0x3f, 0x5f,
+	0xcb, 0x55, 0x3d, 0xd8, 0x6e, 0x35, 0x80, 0xcb, 0x7d, 0x98, 0xb1, 0x1d, 0x9b, 0xda, 0x3e, 0xdb,
+	0x0e, 0x26, 0x36, 0x55, 0xc6, 0x7d, 0xaa, 0x8b, 0x25, 0x26, 0xbc, 0x27, 0x35, 0xa7, 0xba, 0x8d,
+	0x6d, 0xa7, 0x7c, 0xd3, 0xe3, 0xf7, 0xc3, 0xef, 0x2b, 0x45, 0xcb, 0xa6, 0xcd, 0x6e, 0xbd, 0xd4,
+	0xc0, 0x6d, 0x36, 0x4b, 0xec, 0xdf, 0x1a, 0x31, 0x1f, 0xeb, 0xb4, 0xdf, 0x41, 0xc4, 0xef, 0x40,
+	0xbe, 0x7f, 0xf3, 0x6c, 0x55, 0xaa, 0x4e, 0xb3, 0x40, 0x3b, 0x41, 0x1c, 0x79, 0x13, 0x4e, 0x34,
+	0x3c, 0xdd, 0xb0, 0xab, 0x4c, 0x14, 0xa4, 0xe2, 0x64, 0x59, 0xf9, 0xe5, 0xf9, 0xda, 0x1c, 0x8b,
+	0xba, 0x65, 0x9a, 0x2e, 0x22, 0xe4, 0x3e, 0x75, 0x6d, 0xc7, 0xaa, 0x86, 0x40, 0xf9, 0x36, 0xe4,
+	0x7c, 0xde, 0x44, 0xc9, 0x15, 0xb2, 0xa9, 0xf2, 0x64, 0x78, 0x4f, 0x3c, 0xd2, 0x68, 0x22, 0xb3,
+	0xdb, 0x42, 0xca, 0x09, 0x2f, 0x5c, 0x95, 0xb7, 0xef, 0x5c, 0xf9, 0xff, 0x9b, 0x67, 0xab, 0x61,
+	0x8c, 0x4f, 0xde, 0x3c, 0x5b, 0x3d, 0xc7, 0xe6, 0x5c, 0x98, 0x5a, 0x6d, 0x07, 0x16, 0x04, 0x53,
+	0x15, 0x91, 0x0e, 0x76, 0x08, 0x92, 0xaf, 0xc2, 0x64, 0xd0, 0xa9, 0x66, 0x9b, 0x8a, 0xe4, 0xcd,
+	0x4e, 0xf9, 0xd4, 0xc1, 0xab, 0x95, 0x93, 0x01, 0x6c, 0x77, 0xa7, 0x7a, 0x32, 0x70, 0xef, 0x9a,
+	0xda, 0x5f, 0x12, 0x4c, 0x57, 0x88, 0xf5, 0xa0, 0x63, 0x1a, 0x14, 0xf9, 0x34, 0x47, 0xe8, 0x3d,
+	0x98, 0xe9, 0xcc, 0x68, 0x33, 0x1d, 0x91, 0x3b, 0x9b, 0x56, 0xee, 0x15, 0x98, 0xf2, 0x3b, 0xd7,
+	0x6c, 0xc7, 0x44, 0x4f, 0x95, 0x71, 0x7f, 0xd1, 0x81, 0x6f, 0xda, 0xf5, 0x2c, 0x77, 0x2e, 0x8b,
+	0xca, 0xcd, 0x0f, 0x94, 0x8b, 0xe4, 0xa7, 0x29, 0x70, 0x2e, 0x6e, 0x09, 0x75, 0xd3, 0x7e, 0xca,
+	0xc0, 0x12, 0x77, 0x05, 0x3b, 0x81, 0x6c, 0x39, 0xe6, 0x6e, 0xb8, 0xb0, 0x47, 0x50, 0x26, 0x71,
+	0xe3, 0x65, 0xde, 0xf1, 0xc6, 0xcb, 0x0a, 0x1b, 0x2f, 0x22, 0xeb, 0x78, 0x5a, 0x59, 0xa3, 0x6b,
+	0x71, 0x42, 0x58, 0x8b, 0x37, 0x44, 0x45, 0x35, 0x51, 0xd1, 0xc3, 0x22, 0x69, 0x97, 0xe0, 0xc2,
+	0x11, 0x6e, 0xae, 0xf5, 0x17, 0x19, 0x50, 0x2a, 0xc4, 0x7a, 0x68, 0xd3, 0xa6, 0xe9, 0x1a, 0xfb,
+	0x77, 0x11, 0xba, 0x67, 0xf4, 0x91, 0x7b, 0xb7, 0xeb, 0x98, 0x64, 0x14, 0xa1, 0x9b, 0x90, 0x33,
+	0xda, 0xb8, 0xeb, 0x50, 0x25, 0xf3, 0x9e, 0x6a, 0x05, 0x1b, 0xff, 0x38, 0x8b, 0xf6, 0xce, 0xba,
+	0xa8, 0xe0, 0xca, 0x40, 0xc1, 0xc4, 0xd4, 0x35, 0x0d, 0x0a, 0xc3, 0x7c, 0x5c, 0xbb, 0xaf, 0x25,
+	0x98, 0xad, 0x10, 0x6b, 0xab, 0x41, 0xed, 0xde, 0xa0, 0xd6, 0x8f, 0x20, 0x5a, 0x24, 0x95, 0x4c,
+	0xda, 0x54, 0xae, 0x8a, 0xa9, 0x28, 0x83, 0x54, 0xe2, 0x4c, 0xb4, 0x25, 0x58, 0x3c, 0x64, 0xe4,
+	0xe4, 0xbf, 0x95, 0xe0, 0x6c, 0x85, 0x58, 0x3b, 0xc8, 0xf8, 0xa0, 0xf4, 0xaf, 0x89, 0xf4, 0xd5,
+	0x01, 0x7d, 0x91, 0x8b, 0x76, 0x1e, 0x96, 0x12, 0xcc, 0x3c, 0x85, 0xaf, 0x24, 0x38, 0x53, 0x21,
+	0xd6, 0x9e, 0x6b, 0x5b, 0x16, 0x72, 0x3f, 0x0c, 0xff, 0xa2, 0xc8, 0x7f, 0x61, 0xc0, 0x3f, 0x46,
+	0x44, 0x53, 0x41, 0x11, 0x6d, 0x9c, 0xf9, 0xaf, 0x41, 0xb9, 0xaf, 0x22, 0xea, 0xf6, 0xef, 0x19,
+	0x8d, 0xc7, 0x88, 0x8e, 0xc2, 0xdb, 0xab, 0x15, 0xe8, 0x49, 0x17, 0x39, 0x0d, 0x14, 0x1e, 0xfa,
+	0x61, 0x5b, 0x2c, 0xcf, 0x59, 0xb1, 0x3c, 0x1f, 0xa7, 0x38, 0x1d, 0x59, 0xd2, 0x23, 0x39, 0xb0,
+	0x92, 0x1e, 0xb1, 0xf0, 0x84, 0x3f, 0x1f, 0xf7, 0xb7, 0xca, 0x7d, 0x44, 0xf7, 0x70, 0xe7, 0x41,
+	0x67, 0x1b, 0x3b, 0x8f, 0x6c, 0x6b, 0x94, 0x9c, 0x3f, 0x92, 0x60, 0xbe, 0x85, 0xf7, 0x6b, 0x75,
+	0xa3, 0x65, 0x38, 0x0d, 0x54, 0xa3, 0x4d, 0x17, 0x91, 0x26, 0x6e, 0x99, 0xef, 0xad, 0xde, 0x9c,
+	0x6d, 0xe1, 0xfd, 0x72, 0x10, 0x6d, 0x2f, 0x0c, 0x26, 0xaf, 0x43, 0xee, 0x51, 0xd7, 0x31, 0xd1,
+	0xdb, 0x6b, 0x0f, 0xc3, 0xc9, 0xdb, 0x00, 0x96, 0x6b, 0x38, 0xb4, 0xe6, 0x05, 0xf0, 0x25, 0x9f,
+	0x1e, 0x72, 0xf4, 0xdc, 0xf5, 0x3b, 0xfc, 0xdb, 0x03, 0xef, 0xf5, 0x3b, 0xa8, 0x3a, 0x69, 0x85,
+	0x9f, 0x32, 0x85, 0xd3, 0x14, 0x77, 0x6a, 0xdd, 0x4e, 0x8d, 0x15, 0xd9, 0x89, 0xf7, 0x94, 0xf4,
+	0x14, 0xf5, 0xa6, 0x67, 0xeb, 0x50, 0xa5, 0xcd, 0xbd, 0x8b, 0xf2, 0x14, 0x9f, 0x7d, 0x56, 0x9e,
+	0xe2, 0x46, 0xbe, 0x60, 0xbe, 0x93, 0x60, 0xce, 0x5f, 0x4b, 0x6d, 0xdc, 0x43, 0xc7, 0x5c, 0x33,
+	0xc7, 0xd9, 0xdf, 0xd7, 0x45, 0xfe, 0x4b, 0xd1, 0xa5, 0x2e, 0x90, 0xd1, 0xf2, 0xb0, 0x9c, 0x64,
+	0xe7, 0x59, 0x7c, 0x9a, 0x01, 0xd9, 0xaf, 0x60, 0xfe, 0xf5, 0x76, 0x0f, 0x8f, 0x5e, 0xa3, 0x3e,
+	0xdc, 0xb9, 0x7a, 0x0b, 0x26, 0xd9, 0x75, 0x3f, 0xc5, 0xc9, 0x3a, 0x80, 0x06, 0x8a, 0x0d, 0xda,
+	0x9e, 0x66, 0x8b, 0xd1, 0x9a, 0x1e, 0x4b, 0x5d, 0x5b, 0x06, 0xf5, 0xb0, 0x95, 0xeb, 0xf5, 0x65,
+	0x06, 0xe6, 0xa3, 0xc7, 0xae, 0x8b, 0xdb, 0xff, 0x64, 0xc9, 0x6e, 0x03, 0xec, 0x33, 0xaa, 0x29,
+	0x2a, 0x42, 0x04, 0x1b, 0x5c, 0x48, 0x22, 0x06, 0x4f, 0xb5, 0xe5, 0x84, 0x3b, 0x09, 0x17, 0x40,
+	0x5b, 0x81, 0xf3, 0x89, 0x0e, 0xae, 0xdd, 0x8f, 0x12, 0xcc, 0xf0, 0x1b, 0xdf, 0x3d, 0xff, 0xcd,
+	0xea, 0xcd, 0xa9, 0xd1, 0xa5, 0x4d, 0xec, 0xda, 0xb4, 0xaf, 0x48, 0x6f, 0xe1, 0x37, 0x80, 0xca,
+	0xff, 0x82, 0x5c, 0xf0, 0xea, 0x65, 0x2f, 0x8a, 0xa5, 0xc4, 0x82, 0x15, 0x04, 0x61, 0x57, 0x64,
+	0xd6, 0x21, 0x28, 0x00, 0x83, 0xa1, 0x84, 0xa7, 0x53, 0x94, 0x9d, 0xb6, 0x08, 0x0b, 0x82, 0x29,
+	0x4c, 0x66, 0xf3, 0x9b, 0x29, 0xc8, 0x56, 0x88, 0x25, 0xd7, 0xe1, 0x54, 0xec, 0x21, 0x9d, 0x5c,
+	0x39, 0x85, 0x07, 0x98, 0x7a, 0x3d, 0x0d, 0x8a, 0x3f, 0xd3, 0x6a, 0x30, 0x15, 0x7d, 0x77, 0x5d,
+	0x18, 0xd6, 0x39, 0x02, 0x52, 0xaf, 0xa5, 0x00, 0xf1, 0x00, 0x1f, 0x4b, 0xa0, 0x0c, 0x7d, 0xcc,
+	0xac, 0x1f, 0x3d, 0xd2, 0xe1, 0x1e, 0xea, 0xed, 0x51, 0x7b, 0x70, 0x22, 0xff, 0x85, 0xf9, 0xe4,
+	0x8b, 0xfe, 0xda, 0xb0, 0x21, 0x13, 0xe1, 0xea, 0xcd, 0x91, 0xe0, 0x3c, 0x7c, 0x13, 0xa6, 0x85,
+	0xbb, 0xf2, 0xe5, 0x61, 0x03, 0xc5, 0x71, 0x6a, 0x29, 0x1d, 0x8e, 0x47, 0x72, 0xe0, 0xcc, 0xa1,
+	0x8b, 0x6d, 0x71, 0xd8, 0x18, 0x22, 0x52, 0x5d, 0x4f, 0x8b, 0xe4, 0xf1, 0x10, 0x9c, 0x8e, 0xdf,
+	0x42, 0x2f, 0x0d, 0x1b, 0x22, 0x06, 0x53, 0xd7, 0x52, 0xc1, 0xa2, 0x2b, 0x35, 0x7a, 0x65, 0x1c,
+	0xba, 0x52, 0x23, 0x20, 0xf5, 0x5a, 0x0a, 0x50, 0x74, 0x86, 0x84, 0x2b, 0xda, 0xd0, 0x19, 0x8a,
+	0xe3, 0xd4, 0x52, 0x3a, 0x1c, 0x8f, 0xf4, 0x04, 0x66, 0x13, 0xce, 0xf6, 0xe1, 0x5c, 0x05, 0xa8,
+	0xba, 0x91, 0x1a, 0xca, 0x43, 0x3e, 0x86, 0x19, 0xf1, 0x20, 0xbe, 0x32, 0x7c, 0xa6, 0x63, 0x40,
+	0x55, 0x4f, 0x09, 0xe4, 0xc1, 0x28, 0xc8, 0x09, 0xa7, 0xd8, 0xea, 0x5b, 0x37, 0x0e, 0xc7, 0xaa,
+	0x9b, 0xe9, 0xb1, 0x3c, 0x6a, 0x1d, 0x4e, 0xc5, 0xea, 0xff, 0xc5, 0xa3, 0x4b, 0x45, 0x80, 0x52,
+	0xaf, 0xa7, 0x41, 0x85, 0x31, 0xd4, 0x89, 0xff, 0x79, 0x67, 0x60, 0x79, 0xf7, 0xc5, 0x41, 0x5e,
+	0x7a, 0x79, 0x90, 0x97, 0xfe, 0x38, 0xc8, 0x4b, 0x9f, 0xbd, 0xce, 0x8f, 0xbd, 0x7c, 0x9d, 0x1f,
+	0xfb, 0xed, 0x75, 0x7e, 0xec, 0x3f, 0x7a, 0xe4, 0x30, 0xf5, 0x06, 0xf6, 0x7f, 0x85, 0x6a, 0xe0,
+	0x96, 0xde, 0x68, 0x1a, 0xb6, 0xa3, 0xf7, 0x6e, 0xe8, 0x4f, 0xc3, 0x1f, 0x50, 0xfd, 0x93, 0xb5,
+	0x9e, 0xf3, 0x11, 0x37, 0xfe, 0x1e, 0x00, 0xb4, 0x1f, 0x4e, 0x06, 0x49, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1168,6 +1561,12 @@ type MsgClient interface {
 	DeactivateTunnel(ctx context.Context, in *MsgDeactivateTunnel, opts ...grpc.CallOption) (*MsgDeactivateTunnelResponse, error)
 	// TriggerTunnel is a RPC method to manually trigger a tunnel.
 	TriggerTunnel(ctx context.Context, in *MsgTriggerTunnel, opts ...grpc.CallOption) (*MsgTriggerTunnelResponse, error)
+	// RetryPacket is a RPC method to manually retry a dead-lettered packet delivery of a tunnel.
+	RetryPacket(ctx context.Context, in *MsgRetryPacket, opts ...grpc.CallOption) (*MsgRetryPacketResponse, error)
+	// SetTopUpConfig is a RPC method to set the low balance threshold and the funder of a tunnel.
+	SetTopUpConfig(ctx context.Context, in *MsgSetTopUpConfig, opts ...grpc.CallOption) (*MsgSetTopUpConfigResponse, error)
+	// RemoveTopUpConfig is a RPC method to remove the low balance threshold and the funder of a tunnel.
+	RemoveTopUpConfig(ctx context.Context, in *MsgRemoveTopUpConfig, opts ...grpc.CallOption) (*MsgRemoveTopUpConfigResponse, error)
 	// DepositToTunnel is a RPC method to deposit to an existing tunnel.
 	DepositToTunnel(ctx context.Context, in *MsgDepositToTunnel, opts ...grpc.CallOption) (*MsgDepositToTunnelResponse, error)
 	// WithdrawFromTunnel is a RPC method to withdraw a deposit from an existing tunnel.
@@ -1247,6 +1646,33 @@ func (c *msgClient) TriggerTunnel(ctx context.Context, in *MsgTriggerTunnel, opt
 	return out, nil
 }
 
+func (c *msgClient) RetryPacket(ctx context.Context, in *MsgRetryPacket, opts ...grpc.CallOption) (*MsgRetryPacketResponse, error) {
+	out := new(MsgRetryPacketResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/RetryPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTopUpConfig(ctx context.Context, in *MsgSetTopUpConfig, opts ...grpc.CallOption) (*MsgSetTopUpConfigResponse, error) {
+	out := new(MsgSetTopUpConfigResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/SetTopUpConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveTopUpConfig(ctx context.Context, in *MsgRemoveTopUpConfig, opts ...grpc.CallOption) (*MsgRemoveTopUpConfigResponse, error) {
+	out := new(MsgRemoveTopUpConfigResponse)
+	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/RemoveTopUpConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) DepositToTunnel(ctx context.Context, in *MsgDepositToTunnel, opts ...grpc.CallOption) (*MsgDepositToTunnelResponse, error) {
 	out := new(MsgDepositToTunnelResponse)
 	err := c.cc.Invoke(ctx, "/band.tunnel.v1beta1.Msg/DepositToTunnel", in, out, opts...)
@@ -1290,6 +1716,12 @@ type MsgServer interface {
 	DeactivateTunnel(context.Context, *MsgDeactivateTunnel) (*MsgDeactivateTunnelResponse, error)
 	// TriggerTunnel is a RPC method to manually trigger a tunnel.
 	TriggerTunnel(context.Context, *MsgTriggerTunnel) (*MsgTriggerTunnelResponse, error)
+	// RetryPacket is a RPC method to manually retry a dead-lettered packet delivery of a tunnel.
+	RetryPacket(context.Context, *MsgRetryPacket) (*MsgRetryPacketResponse, error)
+	// SetTopUpConfig is a RPC method to set the low balance threshold and the funder of a tunnel.
+	SetTopUpConfig(context.Context, *MsgSetTopUpConfig) (*MsgSetTopUpConfigResponse, error)
+	// RemoveTopUpConfig is a RPC method to remove the low balance threshold and the funder of a tunnel.
+	RemoveTopUpConfig(context.Context, *MsgRemoveTopUpConfig) (*MsgRemoveTopUpConfigResponse, error)
 	// DepositToTunnel is a RPC method to deposit to an existing tunnel.
 	DepositToTunnel(context.Context, *MsgDepositToTunnel) (*MsgDepositToTunnelResponse, error)
 	// WithdrawFromTunnel is a RPC method to withdraw a deposit from an existing tunnel.
@@ -1323,6 +1755,15 @@ func (*UnimplementedMsgServer) DeactivateTunnel(ctx context.Context, req *MsgDea
 func (*UnimplementedMsgServer) TriggerTunnel(ctx context.Context, req *MsgTriggerTunnel) (*MsgTriggerTunnelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TriggerTunnel not implemented")
 }
+func (*UnimplementedMsgServer) RetryPacket(ctx context.Context, req *MsgRetryPacket) (*MsgRetryPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryPacket not implemented")
+}
+func (*UnimplementedMsgServer) SetTopUpConfig(ctx context.Context, req *MsgSetTopUpConfig) (*MsgSetTopUpConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTopUpConfig not implemented")
+}
+func (*UnimplementedMsgServer) RemoveTopUpConfig(ctx context.Context, req *MsgRemoveTopUpConfig) (*MsgRemoveTopUpConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTopUpConfig not implemented")
+}
 func (*UnimplementedMsgServer) DepositToTunnel(ctx context.Context, req *MsgDepositToTunnel) (*MsgDepositToTunnelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositToTunnel not implemented")
 }
@@ -1463,13 +1904,67 @@ func _Msg_TriggerTunnel_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_DepositToTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDepositToTunnel)
+func _Msg_RetryPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryPacket)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).DepositToTunnel(ctx, in)
+		return srv.(MsgServer).RetryPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/RetryPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryPacket(ctx, req.(*MsgRetryPacket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTopUpConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTopUpConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTopUpConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/SetTopUpConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTopUpConfig(ctx, req.(*MsgSetTopUpConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveTopUpConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveTopUpConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveTopUpConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.tunnel.v1beta1.Msg/RemoveTopUpConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveTopUpConfig(ctx, req.(*MsgRemoveTopUpConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositToTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositToTunnel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositToTunnel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -1549,6 +2044,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TriggerTunnel",
 			Handler:    _Msg_TriggerTunnel_Handler,
 		},
+		{
+			MethodName: "RetryPacket",
+			Handler:    _Msg_RetryPacket_Handler,
+		},
+		{
+			MethodName: "SetTopUpConfig",
+			Handler:    _Msg_SetTopUpConfig_Handler,
+		},
+		{
+			MethodName: "RemoveTopUpConfig",
+			Handler:    _Msg_RemoveTopUpConfig_Handler,
+		},
 		{
 			MethodName: "DepositToTunnel",
 			Handler:    _Msg_DepositToTunnel_Handler,
@@ -1586,6 +2093,27 @@ func (m *MsgCreateTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Schedule) > 0 {
+		i -= len(m.Schedule)
+		copy(dAtA[i:], m.Schedule)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Schedule)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -1689,6 +2217,11 @@ func (m *MsgUpdateRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RouteIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RouteIndex))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -1759,6 +2292,13 @@ func (m *MsgUpdateSignalsAndInterval) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.Schedule) > 0 {
+		i -= len(m.Schedule)
+		copy(dAtA[i:], m.Schedule)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Schedule)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -2062,6 +2602,230 @@ func (m *MsgTriggerTunnelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRetryPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RouteIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RouteIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTopUpConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTopUpConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTopUpConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TopUpAmount) > 0 {
+		for iNdEx := len(m.TopUpAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopUpAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.GrantType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GrantType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LowBalanceThreshold) > 0 {
+		for iNdEx := len(m.LowBalanceThreshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LowBalanceThreshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTopUpConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTopUpConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTopUpConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveTopUpConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveTopUpConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveTopUpConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TunnelID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TunnelID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveTopUpConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveTopUpConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveTopUpConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDepositToTunnel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2309,10 +3073,20 @@ func (m *MsgCreateTunnel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
-
-func (m *MsgCreateTunnelResponse) Size() (n int) {
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Schedule)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateTunnelResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2341,6 +3115,9 @@ func (m *MsgUpdateRoute) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.RouteIndex != 0 {
+		n += 1 + sovTx(uint64(m.RouteIndex))
+	}
 	return n
 }
 
@@ -2375,6 +3152,10 @@ func (m *MsgUpdateSignalsAndInterval) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Schedule)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2493,6 +3274,106 @@ func (m *MsgTriggerTunnelResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRetryPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	if m.RouteIndex != 0 {
+		n += 1 + sovTx(uint64(m.RouteIndex))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRetryPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTopUpConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	if len(m.LowBalanceThreshold) > 0 {
+		for _, e := range m.LowBalanceThreshold {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GrantType != 0 {
+		n += 1 + sovTx(uint64(m.GrantType))
+	}
+	if len(m.TopUpAmount) > 0 {
+		for _, e := range m.TopUpAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetTopUpConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveTopUpConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelID != 0 {
+		n += 1 + sovTx(uint64(m.TunnelID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveTopUpConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgDepositToTunnel) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2769,6 +3650,72 @@ func (m *MsgCreateTunnel) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, &types.Any{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2975,6 +3922,25 @@ func (m *MsgUpdateRoute) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteIndex", wireType)
+			}
+			m.RouteIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RouteIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3179,6 +4145,38 @@ func (m *MsgUpdateSignalsAndInterval) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3888,6 +4886,616 @@ func (m *MsgTriggerTunnelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRetryPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteIndex", wireType)
+			}
+			m.RouteIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RouteIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetryPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTopUpConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTopUpConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTopUpConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowBalanceThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LowBalanceThreshold = append(m.LowBalanceThreshold, types1.Coin{})
+			if err := m.LowBalanceThreshold[len(m.LowBalanceThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantType", wireType)
+			}
+			m.GrantType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GrantType |= FunderGrantType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopUpAmount = append(m.TopUpAmount, types1.Coin{})
+			if err := m.TopUpAmount[len(m.TopUpAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTopUpConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTopUpConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTopUpConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveTopUpConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveTopUpConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveTopUpConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelID", wireType)
+			}
+			m.TunnelID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveTopUpConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveTopUpConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveTopUpConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgDepositToTunnel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -37,6 +37,8 @@ type Context struct {
 	gasPrices        string
 	keys             []*keyring.Record
 	executor         executor.Executor
+	execCache        *executor.Cache
+	execCacheWindow  int64
 	fileCache        filecache.Cache
 	broadcastTimeout time.Duration
 	maxTry           uint64
@@ -54,6 +56,8 @@ type Context struct {
 	pendingGauge   int64
 	errorCount     int64
 	submittedCount int64
+	cacheHitCount  int64
+	cacheMissCount int64
 	home           string
 }
 
@@ -85,3 +89,15 @@ func (c *Context) updateSubmittedCount(amount int64) {
 		atomic.AddInt64(&c.submittedCount, amount)
 	}
 }
+
+func (c *Context) updateCacheHitCount(amount int64) {
+	if c.metricsEnabled {
+		atomic.AddInt64(&c.cacheHitCount, amount)
+	}
+}
+
+func (c *Context) updateCacheMissCount(amount int64) {
+	if c.metricsEnabled {
+		atomic.AddInt64(&c.cacheMissCount, amount)
+	}
+}
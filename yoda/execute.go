@@ -238,8 +238,27 @@ func GetExecutable(c *Context, l *Logger, hash string) ([]byte, error) {
 	return resValue, nil
 }
 
-// GetDataSourceHash fetches data source hash by id
-func GetDataSourceHash(c *Context, l *Logger, id types.DataSourceID) (string, error) {
+// GetDataSourceHash fetches data source hash by id and version. A zero version refers to the current
+// data source, for requests made before data sources were versioned.
+func GetDataSourceHash(c *Context, l *Logger, id types.DataSourceID, version uint64) (string, error) {
+	if version != 0 {
+		res, err := abciQuery(
+			c,
+			l,
+			fmt.Sprintf("/store/%s/key", types.StoreKey),
+			types.DataSourceVersionStoreKey(id, version),
+		)
+		if err != nil {
+			l.Error(":skull: Failed to get data source version with error: %s", c, err.Error())
+			return "", err
+		}
+
+		var v types.DataSourceVersion
+		c.encodingConfig.Codec.MustUnmarshal(res.Response.Value, &v)
+
+		return v.Filename, nil
+	}
+
 	res, err := abciQuery(c, l, fmt.Sprintf("/store/%s/key", types.StoreKey), types.DataSourceStoreKey(id))
 	if err != nil {
 		l.Error(":skull: Failed to get data source with error: %s", c, err.Error())
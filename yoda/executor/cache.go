@@ -0,0 +1,91 @@
+package executor
+
+import (
+	"sync"
+	"time"
+)
+
+// CacheKey identifies executions that are expected to return the same result: the same data source
+// executable with the same calldata within the same window of blocks. The environment of the execution is
+// not part of the key.
+type CacheKey struct {
+	DataSourceHash string
+	Calldata       string
+	Window         int64
+}
+
+// cacheEntry is a cached result with its expiration time.
+type cacheEntry struct {
+	result    ExecResult
+	expiredAt time.Time
+}
+
+// cacheCall is an in-flight execution that concurrent executions of the same key wait for.
+type cacheCall struct {
+	done   chan struct{}
+	result ExecResult
+	err    error
+}
+
+// Cache caches the results of successful executions for a short TTL and deduplicates concurrent
+// executions of the same key, so that they share a single call to the underlying executor.
+type Cache struct {
+	ttl     time.Duration
+	now     func() time.Time // Replaceable for testing.
+	mtx     sync.Mutex
+	entries map[CacheKey]cacheEntry
+	calls   map[CacheKey]*cacheCall
+}
+
+// NewCache creates a new Cache that keeps results for the given TTL.
+func NewCache(ttl time.Duration) *Cache {
+	return &Cache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[CacheKey]cacheEntry),
+		calls:   make(map[CacheKey]*cacheCall),
+	}
+}
+
+// Exec returns the cached result of the given key, waits for the in-flight execution of the same key, or
+// runs the given execution and caches its result. It also returns whether the result is shared with
+// another execution instead of coming from its own call. Errors are shared with in-flight waiters but are
+// never cached.
+func (c *Cache) Exec(key CacheKey, exec func() (ExecResult, error)) (ExecResult, bool, error) {
+	c.mtx.Lock()
+	if entry, ok := c.entries[key]; ok && c.now().Before(entry.expiredAt) {
+		c.mtx.Unlock()
+		return entry.result, true, nil
+	}
+	if call, ok := c.calls[key]; ok {
+		c.mtx.Unlock()
+		<-call.done
+		return call.result, true, call.err
+	}
+	call := &cacheCall{done: make(chan struct{})}
+	c.calls[key] = call
+	c.mtx.Unlock()
+
+	call.result, call.err = exec()
+
+	c.mtx.Lock()
+	delete(c.calls, key)
+	if call.err == nil {
+		c.prune()
+		c.entries[key] = cacheEntry{result: call.result, expiredAt: c.now().Add(c.ttl)}
+	}
+	c.mtx.Unlock()
+	close(call.done)
+
+	return call.result, false, call.err
+}
+
+// prune removes all expired entries. The caller must hold the lock.
+func (c *Cache) prune() {
+	now := c.now()
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiredAt) {
+			delete(c.entries, key)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package executor
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheHitAndExpire(t *testing.T) {
+	now := time.Unix(1000, 0)
+	cache := NewCache(10 * time.Second)
+	cache.now = func() time.Time { return now }
+	exec := newMockExec([]byte("output"), 0, nil)
+	run := func() (ExecResult, error) { return exec.Exec(nil, "", nil) }
+
+	key := CacheKey{DataSourceHash: "hash", Calldata: "BTC", Window: 1}
+	res, shared, err := cache.Exec(key, run)
+	require.NoError(t, err)
+	require.False(t, shared)
+	require.Equal(t, ExecResult{Output: []byte("output"), Code: 0}, res)
+
+	res, shared, err = cache.Exec(key, run)
+	require.NoError(t, err)
+	require.True(t, shared)
+	require.Equal(t, ExecResult{Output: []byte("output"), Code: 0}, res)
+	require.Equal(t, 1, exec.called)
+
+	// A different calldata or window is a different execution.
+	_, shared, err = cache.Exec(CacheKey{DataSourceHash: "hash", Calldata: "ETH", Window: 1}, run)
+	require.NoError(t, err)
+	require.False(t, shared)
+	_, shared, err = cache.Exec(CacheKey{DataSourceHash: "hash", Calldata: "BTC", Window: 2}, run)
+	require.NoError(t, err)
+	require.False(t, shared)
+	require.Equal(t, 3, exec.called)
+
+	// The result expires after the TTL and expired results are pruned.
+	now = now.Add(10 * time.Second)
+	_, shared, err = cache.Exec(key, run)
+	require.NoError(t, err)
+	require.False(t, shared)
+	require.Equal(t, 4, exec.called)
+	require.Len(t, cache.entries, 1)
+}
+
+func TestCacheNotCacheError(t *testing.T) {
+	cache := NewCache(10 * time.Second)
+	exec := newMockExec(nil, 0, errors.New("error"))
+	run := func() (ExecResult, error) { return exec.Exec(nil, "", nil) }
+
+	key := CacheKey{DataSourceHash: "hash", Calldata: "BTC", Window: 1}
+	_, _, err := cache.Exec(key, run)
+	require.EqualError(t, err, "error")
+	_, shared, err := cache.Exec(key, run)
+	require.EqualError(t, err, "error")
+	require.False(t, shared)
+	require.Equal(t, 2, exec.called)
+}
+
+func TestCacheDeduplicateInFlight(t *testing.T) {
+	cache := NewCache(10 * time.Second)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	calls := 0
+	run := func() (ExecResult, error) {
+		calls++
+		close(started)
+		<-release
+		return ExecResult{Output: []byte("output")}, nil
+	}
+
+	key := CacheKey{DataSourceHash: "hash", Calldata: "BTC", Window: 1}
+	var wg sync.WaitGroup
+	results := make([]bool, 5)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, results[0], _ = cache.Exec(key, run)
+	}()
+	<-started
+
+	for i := 1; i < len(results); i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res, shared, err := cache.Exec(key, run)
+			require.NoError(t, err)
+			require.Equal(t, []byte("output"), res.Output)
+			results[i] = shared
+		}(i)
+	}
+	// Wait until the executions are waiting for the in-flight call.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, 1, calls)
+	require.Equal(t, []bool{false, true, true, true, true}, results)
+}
@@ -10,6 +10,9 @@ import (
 
 const (
 	flagQueryTimeout = "timeout"
+
+	// timeoutCode is the exit code of an execution that exceeds the timeout.
+	timeoutCode = 111
 )
 
 var (
@@ -31,8 +34,17 @@ var testProgram []byte = []byte(
 	"#!/usr/bin/env python3\nimport os\nimport sys\nprint(sys.argv[1], os.getenv('BAND_CHAIN_ID'))",
 )
 
-// NewExecutor returns executor by name and executor URL
+// NewExecutor returns executor by name and executor URL, or a MultiExec of executors in the form of
+// "multi:<strategy>;<executor>;<executor>..."
 func NewExecutor(executor string) (exec Executor, err error) {
+	if multi, ok := strings.CutPrefix(executor, "multi:"); ok {
+		exec, err = newMultiExecutor(multi)
+		if err != nil {
+			return nil, err
+		}
+		return exec, nil
+	}
+
 	name, base, timeout, err := parseExecutor(executor)
 	if err != nil {
 		return nil, err
@@ -40,6 +52,11 @@ func NewExecutor(executor string) (exec Executor, err error) {
 	switch name {
 	case "rest":
 		exec = NewRestExec(base, timeout)
+	case "sandbox":
+		exec, err = NewSandboxExec(base, timeout)
+		if err != nil {
+			return nil, err
+		}
 	case "docker":
 		return nil, fmt.Errorf("docker executor is currently not supported")
 	default:
@@ -67,6 +84,24 @@ func NewExecutor(executor string) (exec Executor, err error) {
 	return exec, nil
 }
 
+// newMultiExecutor creates a MultiExec from the strategy and executors separated by semicolons.
+func newMultiExecutor(multi string) (*MultiExec, error) {
+	parts := strings.Split(multi, ";")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("invalid multi executor, requires strategy and executors: %s", multi)
+	}
+
+	execs := make([]Executor, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		exec, err := NewExecutor(part)
+		if err != nil {
+			return nil, err
+		}
+		execs = append(execs, exec)
+	}
+	return NewMultiExec(execs, parts[0])
+}
+
 // parseExecutor splits the executor string in the form of "name:base?timeout=" into parts.
 func parseExecutor(executorStr string) (name string, base string, timeout time.Duration, err error) {
 	executor := strings.SplitN(executorStr, ":", 2)
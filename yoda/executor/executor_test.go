@@ -41,3 +41,11 @@ func TestParseExecutorInvalidTimeoutError(t *testing.T) {
 	_, _, _, err := parseExecutor("test:www.bandprotocol.com?timeout=test")
 	require.EqualError(t, err, "invalid timeout, cannot parse duration with error: time: invalid duration \"test\"")
 }
+
+func TestNewMultiExecutorInvalid(t *testing.T) {
+	_, err := NewExecutor("multi:health")
+	require.EqualError(t, err, "invalid multi executor, requires strategy and executors: health")
+
+	_, err = NewExecutor("multi:health;test")
+	require.EqualError(t, err, "invalid executor, cannot parse executor: test")
+}
@@ -0,0 +1,143 @@
+package executor
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// healthFailureThreshold is the number of consecutive failures that trips the circuit of an executor.
+	healthFailureThreshold = 3
+	// healthOpenDuration is the duration that a tripped circuit stays open before it is probed again.
+	healthOpenDuration = 30 * time.Second
+	// healthEWMAWeight is the weight of the latest execution in the moving averages of latency and error rate.
+	healthEWMAWeight = 0.2
+)
+
+// CircuitState is the state of the circuit breaker of an executor.
+type CircuitState int
+
+const (
+	// CircuitClosed means the executor is healthy and is routed to.
+	CircuitClosed CircuitState = iota
+	// CircuitHalfOpen means the executor is being probed by a single execution after being open.
+	CircuitHalfOpen
+	// CircuitOpen means the executor failed too many times in a row and is only used as a last resort.
+	CircuitOpen
+)
+
+// String implements fmt.Stringer for CircuitState.
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitHalfOpen:
+		return "half-open"
+	case CircuitOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// ExecutorHealth is the health of an underlying executor of MultiExec with the "health" strategy.
+type ExecutorHealth struct {
+	State               CircuitState  // The state of the circuit breaker.
+	Latency             time.Duration // The moving average of the latency of executions that returned a result.
+	ErrorRate           float64       // The moving average of the failure rate of executions.
+	ConsecutiveFailures int           // The number of failures since the last success.
+}
+
+// healthCandidate is an executor to try in the order given by healthTracker.
+type healthCandidate struct {
+	index int  // The index of the executor.
+	probe bool // Whether the execution probes a half-open circuit.
+}
+
+// healthTracker tracks the health of executors and orders them for the "health" strategy.
+type healthTracker struct {
+	mtx      sync.Mutex
+	now      func() time.Time // Replaceable for testing.
+	health   []ExecutorHealth
+	openedAt []time.Time
+}
+
+// newHealthTracker creates a new healthTracker for the given number of executors.
+func newHealthTracker(size int) *healthTracker {
+	return &healthTracker{
+		now:      time.Now,
+		health:   make([]ExecutorHealth, size),
+		openedAt: make([]time.Time, size),
+	}
+}
+
+// order returns the executors to try: the executor whose open circuit is due for a probe first, then the
+// healthy executors from the fastest, and finally the other executors as a last resort.
+func (t *healthTracker) order() []healthCandidate {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	var probes, healthy, others []healthCandidate
+	for i, h := range t.health {
+		switch {
+		case h.State == CircuitClosed:
+			healthy = append(healthy, healthCandidate{index: i})
+		case h.State == CircuitOpen && t.now().Sub(t.openedAt[i]) >= healthOpenDuration && len(probes) == 0:
+			t.health[i].State = CircuitHalfOpen
+			probes = append(probes, healthCandidate{index: i, probe: true})
+		default:
+			others = append(others, healthCandidate{index: i})
+		}
+	}
+	byLatency := func(candidates []healthCandidate) {
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return t.health[candidates[i].index].Latency < t.health[candidates[j].index].Latency
+		})
+	}
+	byLatency(healthy)
+	byLatency(others)
+
+	return append(append(probes, healthy...), others...)
+}
+
+// record updates the health of the given executor with the outcome of an execution. An execution that
+// returned a result has its latency recorded, even if the result is a timeout.
+func (t *healthTracker) record(index int, latency time.Duration, hasResult bool, failed bool) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	h := &t.health[index]
+	if hasResult {
+		if h.Latency == 0 {
+			h.Latency = latency
+		} else {
+			h.Latency = time.Duration((1-healthEWMAWeight)*float64(h.Latency) + healthEWMAWeight*float64(latency))
+		}
+	}
+
+	failure := 0.0
+	if failed {
+		failure = 1
+	}
+	h.ErrorRate = (1-healthEWMAWeight)*h.ErrorRate + healthEWMAWeight*failure
+
+	if !failed {
+		h.ConsecutiveFailures = 0
+		h.State = CircuitClosed
+		return
+	}
+	h.ConsecutiveFailures++
+	if h.State == CircuitHalfOpen || h.ConsecutiveFailures >= healthFailureThreshold {
+		h.State = CircuitOpen
+		t.openedAt[index] = t.now()
+	}
+}
+
+// snapshot returns a copy of the health of all executors.
+func (t *healthTracker) snapshot() []ExecutorHealth {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	return append([]ExecutorHealth{}, t.health...)
+}
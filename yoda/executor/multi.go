@@ -9,10 +9,12 @@ import (
 // MultiExec is a higher-order executor that utilizes the underlying executors to perform Exec.
 type MultiExec struct {
 	execs    []Executor // The underlying executors (duplicated if strategy is round-robin).
-	strategy string     // Execution strategy. Can be "order" or "round-robin".
+	strategy string     // Execution strategy. Can be "order", "round-robin" or "health".
 	// Round-robin specific state variables.
 	rIndex  int64 // Current round-robin starting index (need to mod rLength).
 	rLength int64 // Total number of available executors.
+	// Health specific state variables.
+	health *healthTracker // The health of the underlying executors.
 }
 
 // MultiError encapsulates error messages from the underlying executors into one error.
@@ -33,6 +35,8 @@ func NewMultiExec(execs []Executor, strategy string) (*MultiExec, error) {
 			rIndex:   -1,
 			rLength:  int64(len(execs)),
 		}, nil
+	case "health":
+		return &MultiExec{execs: execs, strategy: strategy, health: newHealthTracker(len(execs))}, nil
 	default:
 		return &MultiExec{}, fmt.Errorf("unknown MultiExec strategy: %s", strategy)
 	}
@@ -66,6 +70,10 @@ func (e *MultiExec) nextExecOrder() []Executor {
 
 // Exec implements Executor interface for MultiExec.
 func (e *MultiExec) Exec(code []byte, arg string, env interface{}) (ExecResult, error) {
+	if e.strategy == "health" {
+		return e.execByHealth(code, arg, env)
+	}
+
 	errs := []error{}
 	for _, each := range e.nextExecOrder() {
 		res, err := each.Exec(code, arg, env)
@@ -77,3 +85,40 @@ func (e *MultiExec) Exec(code []byte, arg string, env interface{}) (ExecResult,
 	}
 	return ExecResult{}, &MultiError{errs: errs}
 }
+
+// execByHealth performs Exec with the "health" strategy. It routes to the fastest healthy executor, records
+// the latency and outcome of every execution, and treats errors and timeouts as failures that eventually
+// trip the circuit of the executor. A failed probe of a half-open circuit falls through to the next
+// executor, so that probing does not cost the execution its result.
+func (e *MultiExec) execByHealth(code []byte, arg string, env interface{}) (ExecResult, error) {
+	errs := []error{}
+	var probeResult *ExecResult
+	for _, candidate := range e.health.order() {
+		start := e.health.now()
+		res, err := e.execs[candidate.index].Exec(code, arg, env)
+		failed := err != nil || res.Code == timeoutCode
+		e.health.record(candidate.index, e.health.now().Sub(start), err == nil, failed)
+
+		switch {
+		case err == nil && failed && candidate.probe:
+			probeResult = &res
+		case err == nil || err == ErrExecutionimeout:
+			return res, err
+		default:
+			errs = append(errs, err)
+		}
+	}
+	if probeResult != nil {
+		return *probeResult, nil
+	}
+	return ExecResult{}, &MultiError{errs: errs}
+}
+
+// Health returns the health of the underlying executors in their given order, or nil if the strategy
+// is not "health".
+func (e *MultiExec) Health() []ExecutorHealth {
+	if e.health == nil {
+		return nil
+	}
+	return e.health.snapshot()
+}
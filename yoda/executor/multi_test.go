@@ -3,6 +3,7 @@ package executor
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -105,3 +106,133 @@ func TestMultiExecAllErrors(t *testing.T) {
 	_, err = exec.Exec(nil, "", nil)
 	require.EqualError(t, err, "MultiError: error3, error1, error2")
 }
+
+// slowExec is a mock executor that advances the clock of the health tracker by its latency.
+type slowExec struct {
+	*mockExec
+	now     *time.Time
+	latency time.Duration
+}
+
+func (e *slowExec) Exec(code []byte, arg string, env interface{}) (ExecResult, error) {
+	*e.now = e.now.Add(e.latency)
+	return e.mockExec.Exec(code, arg, env)
+}
+
+func newHealthMultiExec(t *testing.T, execs ...Executor) (*MultiExec, *time.Time) {
+	exec, err := NewMultiExec(execs, "health")
+	require.NoError(t, err)
+	now := time.Unix(1000, 0)
+	exec.health.now = func() time.Time { return now }
+	return exec, &now
+}
+
+func TestMultiExecHealthStrategyFastest(t *testing.T) {
+	mock1 := newMockExec([]byte("output1"), 0, nil)
+	mock2 := newMockExec([]byte("output2"), 0, nil)
+	exec1 := &slowExec{mockExec: mock1, latency: 2 * time.Second}
+	exec2 := &slowExec{mockExec: mock2, latency: time.Second}
+	exec, now := newHealthMultiExec(t, exec1, exec2)
+	exec1.now, exec2.now = now, now
+
+	// Exec for the first time. Should go to exec1.
+	result, err := exec.Exec(nil, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, ExecResult{Output: []byte("output1"), Code: 0}, result)
+	// Doing it again. Should go to exec2 because its latency is not measured yet.
+	result, err = exec.Exec(nil, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, ExecResult{Output: []byte("output2"), Code: 0}, result)
+	// Doing it again. Should go to exec2 because it is the fastest.
+	result, err = exec.Exec(nil, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, ExecResult{Output: []byte("output2"), Code: 0}, result)
+	require.Equal(t, 1, mock1.called)
+	require.Equal(t, 2, mock2.called)
+
+	// An error of exec2 falls through to exec1.
+	mock2.err = errors.New("error2")
+	result, err = exec.Exec(nil, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, ExecResult{Output: []byte("output1"), Code: 0}, result)
+
+	health := exec.Health()
+	require.Equal(t, CircuitClosed, health[0].State)
+	require.Equal(t, 2*time.Second, health[0].Latency)
+	require.Equal(t, 0.0, health[0].ErrorRate)
+	require.Equal(t, CircuitClosed, health[1].State)
+	require.Equal(t, time.Second, health[1].Latency)
+	require.InDelta(t, 0.2, health[1].ErrorRate, 1e-9)
+	require.Equal(t, 1, health[1].ConsecutiveFailures)
+}
+
+func TestMultiExecHealthStrategyCircuitBreaker(t *testing.T) {
+	exec1 := newMockExec(nil, 0, errors.New("error1"))
+	exec2 := newMockExec([]byte("output2"), 0, nil)
+	exec, now := newHealthMultiExec(t, exec1, exec2)
+
+	// Consecutive failures trip the circuit of exec1.
+	for i := 0; i < healthFailureThreshold; i++ {
+		result, err := exec.Exec(nil, "", nil)
+		require.NoError(t, err)
+		require.Equal(t, ExecResult{Output: []byte("output2"), Code: 0}, result)
+	}
+	require.Equal(t, CircuitOpen, exec.Health()[0].State)
+	require.Equal(t, healthFailureThreshold, exec.Health()[0].ConsecutiveFailures)
+
+	// An open circuit is skipped.
+	_, err := exec.Exec(nil, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, healthFailureThreshold, exec1.called)
+
+	// A failed probe falls through to the next executor and opens the circuit again.
+	*now = now.Add(healthOpenDuration)
+	result, err := exec.Exec(nil, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, ExecResult{Output: []byte("output2"), Code: 0}, result)
+	require.Equal(t, healthFailureThreshold+1, exec1.called)
+	require.Equal(t, CircuitOpen, exec.Health()[0].State)
+
+	// A timeout of a probe is a failure but does not cost the execution its result.
+	*now = now.Add(healthOpenDuration)
+	exec1.result, exec1.err = ExecResult{Output: []byte{}, Code: timeoutCode}, nil
+	result, err = exec.Exec(nil, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, ExecResult{Output: []byte("output2"), Code: 0}, result)
+	require.Equal(t, CircuitOpen, exec.Health()[0].State)
+
+	// A successful probe closes the circuit.
+	*now = now.Add(healthOpenDuration)
+	exec1.result = ExecResult{Output: []byte("output1"), Code: 0}
+	result, err = exec.Exec(nil, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, ExecResult{Output: []byte("output1"), Code: 0}, result)
+	require.Equal(t, CircuitClosed, exec.Health()[0].State)
+	require.Equal(t, 0, exec.Health()[0].ConsecutiveFailures)
+}
+
+func TestMultiExecHealthStrategyLastResort(t *testing.T) {
+	exec1 := newMockExec(nil, 0, errors.New("error1"))
+	exec2 := newMockExec(nil, 0, errors.New("error2"))
+	exec, _ := newHealthMultiExec(t, exec1, exec2)
+
+	for i := 0; i < healthFailureThreshold; i++ {
+		_, err := exec.Exec(nil, "", nil)
+		require.EqualError(t, err, "MultiError: error1, error2")
+	}
+	require.Equal(t, CircuitOpen, exec.Health()[0].State)
+	require.Equal(t, CircuitOpen, exec.Health()[1].State)
+
+	// Executors with open circuits are still tried when no executor is healthy.
+	exec2.result, exec2.err = ExecResult{Output: []byte("output2"), Code: 0}, nil
+	result, err := exec.Exec(nil, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, ExecResult{Output: []byte("output2"), Code: 0}, result)
+	require.Equal(t, CircuitClosed, exec.Health()[1].State)
+}
+
+func TestMultiExecHealthNotHealthStrategy(t *testing.T) {
+	exec, err := NewMultiExec([]Executor{newMockExec(nil, 0, nil)}, "order")
+	require.NoError(t, err)
+	require.Nil(t, exec.Health())
+}
@@ -47,7 +47,7 @@ func (e *RestExec) Exec(code []byte, arg string, env interface{}) (ExecResult, e
 			return ExecResult{}, err
 		}
 		// Return timeout code
-		return ExecResult{Output: []byte{}, Code: 111}, nil
+		return ExecResult{Output: []byte{}, Code: timeoutCode}, nil
 	}
 
 	if !resp.Ok {
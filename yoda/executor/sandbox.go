@@ -0,0 +1,233 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/shlex"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+const (
+	flagSandboxCPU      = "cpu"
+	flagSandboxMemory   = "memory"
+	flagSandboxTmpfs    = "tmpfs"
+	flagSandboxAllow    = "allow"
+	flagSandboxReadOnly = "ro"
+
+	// SandboxVersion is the version reported for executions of the sandbox executor.
+	SandboxVersion = "sandbox"
+
+	// sandboxInitErrorCode is the exit code of the sandbox init if it fails to set up the sandbox.
+	sandboxInitErrorCode = 125
+	// sandboxInitErrorPrefix prefixes the error message written to stderr by the sandbox init.
+	sandboxInitErrorPrefix = "sandbox init: "
+
+	defaultSandboxMemory = 256 << 20
+	defaultSandboxTmpfs  = 64 << 20
+)
+
+// ErrSandboxNotSupported is returned when the sandbox executor is used on an unsupported platform.
+var ErrSandboxNotSupported = errors.New("sandbox executor is only supported on linux")
+
+// SandboxExec runs data source executables in isolated subprocesses on the local machine. Each execution
+// gets its own temporary root filesystem with read-only system directories, CPU time and memory limits,
+// and no network access except to the allowlisted hosts through a filtering proxy.
+type SandboxExec struct {
+	dir           string          // The directory in which the temporary directories of executions are created.
+	timeout       time.Duration   // The wall-clock time limit of an execution.
+	cpuTime       time.Duration   // The CPU time limit of an execution.
+	memory        uint64          // The virtual memory limit of an execution in bytes.
+	tmpfsSize     uint64          // The size of the temporary root filesystem of an execution in bytes.
+	allowlist     map[string]bool // The hosts that executions can connect to.
+	readOnlyPaths []string        // The extra host paths mounted read-only into the root filesystem.
+	self          string          // The path of the current binary that is used as the sandbox init.
+}
+
+// sandboxConfig is the configuration of an execution that is passed to the sandbox init.
+type sandboxConfig struct {
+	Dir           string   `json:"dir"`
+	CPUSeconds    uint64   `json:"cpu_seconds"`
+	MemoryKB      uint64   `json:"memory_kb"`
+	TmpfsSize     uint64   `json:"tmpfs_size"`
+	ProxySocket   string   `json:"proxy_socket"`
+	ReadOnlyPaths []string `json:"read_only_paths"`
+}
+
+// NewSandboxExec creates a new SandboxExec from the base of the executor URL in the form of
+// "<dir>?cpu=<duration>&memory=<bytes>&tmpfs=<bytes>&allow=<host>,<host>&ro=<path>,<path>".
+func NewSandboxExec(base string, timeout time.Duration) (*SandboxExec, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sandbox base, cannot parse %s to url with error: %s", base, err.Error())
+	}
+
+	e := &SandboxExec{
+		dir:       u.Path,
+		timeout:   timeout,
+		cpuTime:   timeout,
+		memory:    defaultSandboxMemory,
+		tmpfsSize: defaultSandboxTmpfs,
+		allowlist: map[string]bool{},
+	}
+	if e.dir == "" {
+		e.dir = os.TempDir()
+	}
+
+	query := u.Query()
+	if cpu := query.Get(flagSandboxCPU); cpu != "" {
+		e.cpuTime, err = time.ParseDuration(cpu)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cpu, cannot parse duration with error: %s", err.Error())
+		}
+	}
+	if memory := query.Get(flagSandboxMemory); memory != "" {
+		e.memory, err = strconv.ParseUint(memory, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid memory, cannot parse bytes with error: %s", err.Error())
+		}
+	}
+	if tmpfs := query.Get(flagSandboxTmpfs); tmpfs != "" {
+		e.tmpfsSize, err = strconv.ParseUint(tmpfs, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tmpfs, cannot parse bytes with error: %s", err.Error())
+		}
+	}
+	for _, host := range splitList(query.Get(flagSandboxAllow)) {
+		e.allowlist[strings.ToLower(host)] = true
+	}
+	for _, path := range splitList(query.Get(flagSandboxReadOnly)) {
+		if !filepath.IsAbs(path) {
+			return nil, fmt.Errorf("invalid ro, path must be absolute: %s", path)
+		}
+		e.readOnlyPaths = append(e.readOnlyPaths, path)
+	}
+
+	e.self, err = os.Executable()
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// Exec implements Executor interface for SandboxExec.
+func (e *SandboxExec) Exec(code []byte, arg string, env interface{}) (ExecResult, error) {
+	args, err := shlex.Split(arg)
+	if err != nil {
+		return ExecResult{}, err
+	}
+
+	dir, err := os.MkdirTemp(e.dir, "sandbox")
+	if err != nil {
+		return ExecResult{}, err
+	}
+	defer os.RemoveAll(dir)
+	err = os.WriteFile(filepath.Join(dir, "exec"), code, 0o700)
+	if err != nil {
+		return ExecResult{}, err
+	}
+
+	config := sandboxConfig{
+		Dir:           dir,
+		CPUSeconds:    uint64((e.cpuTime + time.Second - 1) / time.Second),
+		MemoryKB:      e.memory / 1024,
+		TmpfsSize:     e.tmpfsSize,
+		ReadOnlyPaths: e.readOnlyPaths,
+	}
+	if len(e.allowlist) > 0 {
+		config.ProxySocket = filepath.Join(dir, "proxy.sock")
+		proxy, err := newAllowlistProxy(config.ProxySocket, e.allowlist)
+		if err != nil {
+			return ExecResult{}, err
+		}
+		defer proxy.Close()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+	cmd, err := e.command(ctx, config, args, sandboxEnv(env))
+	if err != nil {
+		return ExecResult{}, err
+	}
+	stdout := &limitedBuffer{limit: int(types.DefaultMaxReportDataSize)}
+	stderr := &limitedBuffer{limit: int(types.DefaultMaxReportDataSize)}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	err = cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return ExecResult{Output: []byte{}, Code: timeoutCode, Version: SandboxVersion}, nil
+	}
+	exitCode := uint32(0)
+	if err != nil {
+		exitError, ok := err.(*exec.ExitError)
+		if !ok {
+			return ExecResult{}, err
+		}
+		// Failing to set up the sandbox is an error of the executor, not of the data source.
+		if exitError.ExitCode() == sandboxInitErrorCode &&
+			strings.HasPrefix(stderr.String(), sandboxInitErrorPrefix) {
+			return ExecResult{}, errors.New(strings.TrimSpace(stderr.String()))
+		}
+		exitCode = uint32(exitError.ExitCode())
+	}
+
+	if exitCode == 0 {
+		return ExecResult{Output: stdout.Bytes(), Code: 0, Version: SandboxVersion}, nil
+	} else {
+		return ExecResult{Output: stderr.Bytes(), Code: exitCode, Version: SandboxVersion}, nil
+	}
+}
+
+// sandboxEnv converts the environment variables of an execution to the "key=value" form.
+func sandboxEnv(env interface{}) []string {
+	vars, ok := env.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	result := make([]string, 0, len(vars))
+	for key, value := range vars {
+		result = append(result, fmt.Sprintf("%s=%v", key, value))
+	}
+	return result
+}
+
+// splitList splits a comma-separated list and drops empty elements.
+func splitList(list string) []string {
+	var result []string
+	for _, each := range strings.Split(list, ",") {
+		if each = strings.TrimSpace(each); each != "" {
+			result = append(result, each)
+		}
+	}
+	return result
+}
+
+// limitedBuffer is a bytes.Buffer that silently discards everything written beyond its limit.
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+// Write implements io.Writer for limitedBuffer.
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.limit - b.Len(); remaining < len(p) {
+		if remaining > 0 {
+			b.Buffer.Write(p[:remaining])
+		}
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
@@ -0,0 +1,290 @@
+//go:build linux
+
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// sandboxInitArg is the argv[0] that makes the current binary run as the sandbox init.
+	sandboxInitArg = "yoda-sandbox-init"
+	// sandboxConfigEnv is the environment variable that carries the sandboxConfig to the sandbox init.
+	sandboxConfigEnv = "YODA_SANDBOX_CONFIG"
+	// sandboxPath is the PATH of the executable inside the sandbox.
+	sandboxPath = "/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+)
+
+// sandboxSystemPaths are the host paths mounted read-only into the root filesystem of the sandbox.
+var sandboxSystemPaths = []string{"/bin", "/etc", "/lib", "/lib32", "/lib64", "/sbin", "/usr"}
+
+// sandboxDevices are the host devices bound into the root filesystem of the sandbox.
+var sandboxDevices = []string{"/dev/null", "/dev/zero", "/dev/random", "/dev/urandom"}
+
+func init() {
+	// The sandbox executor re-executes the current binary in new namespaces to set up the sandbox.
+	if len(os.Args) > 0 && os.Args[0] == sandboxInitArg {
+		os.Exit(runSandboxInit(os.Args[1:]))
+	}
+}
+
+// command returns the command that runs the sandbox init, which in turn runs the executable, in new user,
+// mount, network, PID, IPC and UTS namespaces.
+func (e *SandboxExec) command(
+	ctx context.Context,
+	config sandboxConfig,
+	args []string,
+	env []string,
+) (*exec.Cmd, error) {
+	bz, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.CommandContext(ctx, e.self, args...)
+	cmd.Args[0] = sandboxInitArg
+	cmd.Env = append(env, fmt.Sprintf("%s=%s", sandboxConfigEnv, bz))
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Cloneflags: syscall.CLONE_NEWUSER | syscall.CLONE_NEWNS | syscall.CLONE_NEWNET |
+			syscall.CLONE_NEWPID | syscall.CLONE_NEWIPC | syscall.CLONE_NEWUTS,
+		UidMappings:                []syscall.SysProcIDMap{{ContainerID: 0, HostID: os.Getuid(), Size: 1}},
+		GidMappings:                []syscall.SysProcIDMap{{ContainerID: 0, HostID: os.Getgid(), Size: 1}},
+		GidMappingsEnableSetgroups: false,
+		Pdeathsig:                  syscall.SIGKILL,
+	}
+	return cmd, nil
+}
+
+// runSandboxInit sets up the sandbox, runs the executable with the given arguments inside it and returns
+// the exit code of the executable. It runs as PID 1 of the new PID namespace, so every process of the
+// sandbox is killed when it exits.
+func runSandboxInit(args []string) int {
+	var config sandboxConfig
+	if err := json.Unmarshal([]byte(os.Getenv(sandboxConfigEnv)), &config); err != nil {
+		return sandboxInitError(err)
+	}
+	os.Unsetenv(sandboxConfigEnv)
+
+	root := filepath.Join(config.Dir, "root")
+	if err := setupSandboxRoot(root, config); err != nil {
+		return sandboxInitError(err)
+	}
+
+	env := append(os.Environ(), "PATH="+sandboxPath, "HOME=/tmp", "TMPDIR=/tmp")
+	if err := setupSandboxNetwork(); err != nil {
+		return sandboxInitError(err)
+	}
+	if config.ProxySocket != "" {
+		proxy, err := forwardToProxy(config.ProxySocket)
+		if err != nil {
+			return sandboxInitError(err)
+		}
+		for _, key := range []string{"HTTP_PROXY", "HTTPS_PROXY", "http_proxy", "https_proxy"} {
+			env = append(env, fmt.Sprintf("%s=http://%s", key, proxy))
+		}
+	}
+
+	// The limits are set by the shell right before it is replaced by the executable, so that they do
+	// not apply to the sandbox init itself.
+	limits := fmt.Sprintf(`ulimit -t %d && ulimit -v %d && exec "$0" "$@"`, config.CPUSeconds, config.MemoryKB)
+	cmd := exec.Command("/bin/sh", append([]string{"-c", limits, "/tmp/exec"}, args...)...)
+	cmd.Dir = "/tmp"
+	cmd.Env = env
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.SysProcAttr = &syscall.SysProcAttr{Chroot: root, Pdeathsig: syscall.SIGKILL}
+
+	err := cmd.Run()
+	if err == nil {
+		return 0
+	}
+	exitError, ok := err.(*exec.ExitError)
+	if !ok {
+		return sandboxInitError(err)
+	}
+	if status, ok := exitError.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		// Follow the shell convention for an executable killed by a signal, e.g. by exceeding the CPU limit.
+		return 128 + int(status.Signal())
+	}
+	return exitError.ExitCode()
+}
+
+// sandboxInitError writes the given error for SandboxExec to stderr and returns sandboxInitErrorCode.
+func sandboxInitError(err error) int {
+	fmt.Fprintf(os.Stderr, "%s%s\n", sandboxInitErrorPrefix, err.Error())
+	return sandboxInitErrorCode
+}
+
+// setupSandboxRoot creates the root filesystem of the sandbox on a new tmpfs with the executable at
+// /tmp/exec, read-only system paths and a few devices.
+func setupSandboxRoot(root string, config sandboxConfig) error {
+	// Do not propagate any mount of the sandbox back to the host.
+	if err := unix.Mount("", "/", "", unix.MS_REC|unix.MS_PRIVATE, ""); err != nil {
+		return fmt.Errorf("failed to make mounts private: %w", err)
+	}
+	if err := os.Mkdir(root, 0o700); err != nil {
+		return err
+	}
+	if err := unix.Mount("tmpfs", root, "tmpfs", unix.MS_NOSUID|unix.MS_NODEV, fmt.Sprintf(
+		"size=%d,mode=0755",
+		config.TmpfsSize,
+	)); err != nil {
+		return fmt.Errorf("failed to mount tmpfs: %w", err)
+	}
+
+	tmp := filepath.Join(root, "tmp")
+	if err := os.Mkdir(tmp, 0o777); err != nil {
+		return err
+	}
+	code, err := os.ReadFile(filepath.Join(config.Dir, "exec"))
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(tmp, "exec"), code, 0o755); err != nil {
+		return err
+	}
+
+	for _, path := range append(sandboxSystemPaths, config.ReadOnlyPaths...) {
+		if err := bindReadOnly(path, filepath.Join(root, path)); err != nil {
+			return err
+		}
+	}
+	for _, device := range sandboxDevices {
+		if err := bindDevice(device, filepath.Join(root, device)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bindReadOnly mounts the given host path read-only at the given target. A symlink is copied as is, and
+// a path that does not exist on the host is skipped.
+func bindReadOnly(path string, target string) error {
+	info, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+
+	if info.Mode()&os.ModeSymlink != 0 {
+		link, err := os.Readlink(path)
+		if err != nil {
+			return err
+		}
+		return os.Symlink(link, target)
+	}
+
+	if info.IsDir() {
+		err = os.Mkdir(target, 0o755)
+	} else {
+		err = os.WriteFile(target, nil, 0o644)
+	}
+	if err != nil && !os.IsExist(err) {
+		return err
+	}
+
+	if err := unix.Mount(path, target, "", unix.MS_BIND|unix.MS_REC, ""); err != nil {
+		return fmt.Errorf("failed to bind %s: %w", path, err)
+	}
+	// The flags locked by the host mount must be kept when remounting inside a user namespace.
+	var stat unix.Statfs_t
+	if err := unix.Statfs(target, &stat); err != nil {
+		return err
+	}
+	flags := uintptr(unix.MS_BIND | unix.MS_REMOUNT | unix.MS_RDONLY)
+	for st, ms := range map[int64]uintptr{
+		unix.ST_NOSUID:      unix.MS_NOSUID,
+		unix.ST_NODEV:       unix.MS_NODEV,
+		unix.ST_NOEXEC:      unix.MS_NOEXEC,
+		unix.ST_NOATIME:     unix.MS_NOATIME,
+		unix.ST_NODIRATIME:  unix.MS_NODIRATIME,
+		unix.ST_RELATIME:    unix.MS_RELATIME,
+		unix.ST_SYNCHRONOUS: unix.MS_SYNCHRONOUS,
+	} {
+		if stat.Flags&st != 0 {
+			flags |= ms
+		}
+	}
+	if err := unix.Mount("", target, "", flags, ""); err != nil {
+		return fmt.Errorf("failed to remount %s read-only: %w", path, err)
+	}
+	return nil
+}
+
+// bindDevice binds the given host device at the given target.
+func bindDevice(device string, target string) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(target, nil, 0o644); err != nil {
+		return err
+	}
+	if err := unix.Mount(device, target, "", unix.MS_BIND, ""); err != nil {
+		return fmt.Errorf("failed to bind %s: %w", device, err)
+	}
+	return nil
+}
+
+// setupSandboxNetwork brings up the loopback interface of the new network namespace, which has no other
+// interface, so the executable can only reach the proxy forwarder.
+func setupSandboxNetwork() error {
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(fd)
+
+	ifreq, err := unix.NewIfreq("lo")
+	if err != nil {
+		return err
+	}
+	if err := unix.IoctlIfreq(fd, unix.SIOCGIFFLAGS, ifreq); err != nil {
+		return err
+	}
+	ifreq.SetUint16(ifreq.Uint16() | unix.IFF_UP)
+	if err := unix.IoctlIfreq(fd, unix.SIOCSIFFLAGS, ifreq); err != nil {
+		return fmt.Errorf("failed to bring up loopback: %w", err)
+	}
+	return nil
+}
+
+// forwardToProxy listens on the loopback interface of the sandbox and forwards every connection to the
+// allowlist proxy of the host on the given unix socket. It returns the address it listens on.
+func forwardToProxy(socket string) (string, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				upstream, err := net.Dial("unix", socket)
+				if err != nil {
+					conn.Close()
+					return
+				}
+				pipe(conn, upstream)
+			}()
+		}
+	}()
+
+	return listener.Addr().String(), nil
+}
@@ -0,0 +1,18 @@
+//go:build !linux
+
+package executor
+
+import (
+	"context"
+	"os/exec"
+)
+
+// command returns ErrSandboxNotSupported because the sandbox relies on Linux namespaces.
+func (e *SandboxExec) command(
+	ctx context.Context,
+	config sandboxConfig,
+	args []string,
+	env []string,
+) (*exec.Cmd, error) {
+	return nil, ErrSandboxNotSupported
+}
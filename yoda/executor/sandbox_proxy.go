@@ -0,0 +1,138 @@
+package executor
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const sandboxDialTimeout = 5 * time.Second
+
+// allowlistProxy is an HTTP proxy listening on a unix socket that only forwards requests to the
+// allowlisted hosts. It is the only way out of the sandbox to the network.
+type allowlistProxy struct {
+	listener  net.Listener
+	server    *http.Server
+	allowlist map[string]bool
+	transport *http.Transport
+}
+
+// newAllowlistProxy starts a new allowlistProxy on the given unix socket.
+func newAllowlistProxy(socket string, allowlist map[string]bool) (*allowlistProxy, error) {
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &allowlistProxy{
+		listener:  listener,
+		allowlist: allowlist,
+		transport: &http.Transport{
+			Proxy:       nil,
+			DialContext: (&net.Dialer{Timeout: sandboxDialTimeout}).DialContext,
+		},
+	}
+	p.server = &http.Server{Handler: p, ReadHeaderTimeout: sandboxDialTimeout}
+	go func() { _ = p.server.Serve(listener) }()
+
+	return p, nil
+}
+
+// Close stops the proxy and closes all of its connections.
+func (p *allowlistProxy) Close() {
+	_ = p.server.Close()
+	p.transport.CloseIdleConnections()
+}
+
+// isAllowed returns true if the given host, with or without port, is in the allowlist.
+func (p *allowlistProxy) isAllowed(hostport string) bool {
+	host := hostport
+	if h, _, err := net.SplitHostPort(hostport); err == nil {
+		host = h
+	}
+	return p.allowlist[strings.ToLower(host)]
+}
+
+// ServeHTTP implements http.Handler for allowlistProxy.
+func (p *allowlistProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodConnect {
+		p.serveConnect(w, r)
+		return
+	}
+
+	if !r.URL.IsAbs() || !p.isAllowed(r.URL.Host) {
+		http.Error(w, "host is not allowed by the sandbox", http.StatusForbidden)
+		return
+	}
+
+	outReq := r.Clone(r.Context())
+	outReq.RequestURI = ""
+	outReq.Header.Del("Proxy-Connection")
+	outReq.Header.Del("Proxy-Authorization")
+	resp, err := p.transport.RoundTrip(outReq)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+
+	for key, values := range resp.Header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	w.WriteHeader(resp.StatusCode)
+	_, _ = io.Copy(w, resp.Body)
+}
+
+// serveConnect tunnels a CONNECT request, e.g. for HTTPS, to an allowlisted host.
+func (p *allowlistProxy) serveConnect(w http.ResponseWriter, r *http.Request) {
+	if !p.isAllowed(r.Host) {
+		http.Error(w, "host is not allowed by the sandbox", http.StatusForbidden)
+		return
+	}
+
+	upstream, err := net.DialTimeout("tcp", r.Host, sandboxDialTimeout)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		upstream.Close()
+		http.Error(w, "hijacking is not supported", http.StatusInternalServerError)
+		return
+	}
+	client, buf, err := hijacker.Hijack()
+	if err != nil {
+		upstream.Close()
+		return
+	}
+
+	_, _ = client.Write([]byte("HTTP/1.1 200 Connection Established\r\n\r\n"))
+	// Forward the bytes that the client may have sent right after the CONNECT request.
+	if buffered := buf.Reader.Buffered(); buffered > 0 {
+		data, _ := buf.Reader.Peek(buffered)
+		_, _ = upstream.Write(data)
+	}
+	pipe(client, upstream)
+}
+
+// pipe copies data between the two connections in both directions until either side is done.
+func pipe(a net.Conn, b net.Conn) {
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(a, b)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(b, a)
+		done <- struct{}{}
+	}()
+	<-done
+	a.Close()
+	b.Close()
+}
@@ -0,0 +1,201 @@
+//go:build linux
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSandboxExec creates a SandboxExec with the given query and skips the test if the platform does not
+// allow creating the sandbox, e.g. because unprivileged user namespaces are disabled.
+func newTestSandboxExec(t *testing.T, query string, timeout time.Duration) *SandboxExec {
+	e, err := NewSandboxExec(t.TempDir()+"?"+query, timeout)
+	require.NoError(t, err)
+
+	res, err := e.Exec([]byte("#!/bin/sh\ntrue"), "", nil)
+	if err != nil && strings.Contains(err.Error(), "operation not permitted") {
+		t.Skipf("sandbox is not supported on this platform: %s", err)
+	}
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code, string(res.Output))
+
+	return e
+}
+
+func TestNewSandboxExec(t *testing.T) {
+	e, err := NewSandboxExec("/var/yoda?cpu=2s&memory=1048576&tmpfs=2048&allow=API.example.com,,b.com&ro=/opt", time.Second)
+	require.NoError(t, err)
+	require.Equal(t, "/var/yoda", e.dir)
+	require.Equal(t, 2*time.Second, e.cpuTime)
+	require.Equal(t, uint64(1048576), e.memory)
+	require.Equal(t, uint64(2048), e.tmpfsSize)
+	require.Equal(t, map[string]bool{"api.example.com": true, "b.com": true}, e.allowlist)
+	require.Equal(t, []string{"/opt"}, e.readOnlyPaths)
+
+	e, err = NewSandboxExec("", 3*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, os.TempDir(), e.dir)
+	require.Equal(t, 3*time.Second, e.cpuTime)
+	require.Equal(t, uint64(defaultSandboxMemory), e.memory)
+
+	_, err = NewSandboxExec("?memory=1GB", time.Second)
+	require.ErrorContains(t, err, "invalid memory")
+
+	_, err = NewSandboxExec("?ro=opt", time.Second)
+	require.EqualError(t, err, "invalid ro, path must be absolute: opt")
+}
+
+func TestSandboxExecSuccess(t *testing.T) {
+	e := newTestSandboxExec(t, "", 5*time.Second)
+
+	res, err := e.Exec([]byte("#!/bin/sh\necho $1 $2 $BAND_CHAIN_ID $HOME"), "TEST_ARG 'second arg'", map[string]interface{}{
+		"BAND_CHAIN_ID": "test-chain-id",
+	})
+	require.NoError(t, err)
+	require.Equal(t, ExecResult{
+		Output:  []byte("TEST_ARG second arg test-chain-id /tmp\n"),
+		Code:    0,
+		Version: SandboxVersion,
+	}, res)
+}
+
+func TestSandboxExecNonZeroCode(t *testing.T) {
+	e := newTestSandboxExec(t, "", 5*time.Second)
+
+	res, err := e.Exec([]byte("#!/bin/sh\necho out\necho error >&2\nexit 3"), "", nil)
+	require.NoError(t, err)
+	require.Equal(t, ExecResult{Output: []byte("error\n"), Code: 3, Version: SandboxVersion}, res)
+}
+
+func TestSandboxExecTimeout(t *testing.T) {
+	e := newTestSandboxExec(t, "", 5*time.Second)
+	e.timeout = time.Second
+
+	res, err := e.Exec([]byte("#!/bin/sh\nsleep 10"), "", nil)
+	require.NoError(t, err)
+	require.Equal(t, ExecResult{Output: []byte{}, Code: timeoutCode, Version: SandboxVersion}, res)
+}
+
+func TestSandboxExecCPULimit(t *testing.T) {
+	e := newTestSandboxExec(t, "cpu=1s", 10*time.Second)
+
+	res, err := e.Exec([]byte("#!/bin/sh\nwhile true; do :; done"), "", nil)
+	require.NoError(t, err)
+	// The executable is killed by SIGXCPU (24) or SIGKILL (9) once it exceeds the CPU limit.
+	require.Contains(t, []uint32{128 + 24, 128 + 9}, res.Code)
+}
+
+func TestSandboxExecFilesystem(t *testing.T) {
+	e := newTestSandboxExec(t, "", 5*time.Second)
+
+	hostFile := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(hostFile, []byte("secret"), 0o600))
+
+	res, err := e.Exec([]byte(`#!/bin/sh
+echo hello > /tmp/file && cat /tmp/file
+touch /usr/file 2>/dev/null || echo read-only
+test -e "$1" || echo hidden`), hostFile, nil)
+	require.NoError(t, err)
+	require.Equal(t, "hello\nread-only\nhidden\n", string(res.Output))
+}
+
+func TestSandboxExecNetwork(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("price"))
+	}))
+	defer server.Close()
+
+	script := []byte(fmt.Sprintf(`#!/usr/bin/env python3
+import urllib.request
+try:
+    print(urllib.request.urlopen("%s", timeout=2).read().decode())
+except Exception:
+    print("blocked")`, server.URL))
+
+	// The network is unreachable without an allowlist.
+	e := newTestSandboxExec(t, "", 10*time.Second)
+	res, err := e.Exec(script, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, "blocked\n", string(res.Output))
+
+	// Allowlisted hosts are reachable through the proxy.
+	e = newTestSandboxExec(t, "allow=127.0.0.1", 10*time.Second)
+	res, err = e.Exec(script, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, "price\n", string(res.Output))
+}
+
+func TestAllowlistProxy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	socket := filepath.Join(t.TempDir(), "proxy.sock")
+	proxy, err := newAllowlistProxy(socket, map[string]bool{"127.0.0.1": true})
+	require.NoError(t, err)
+	defer proxy.Close()
+
+	client := &http.Client{Transport: &http.Transport{
+		Proxy: http.ProxyURL(&url.URL{Scheme: "http", Host: "proxy"}),
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", socket)
+		},
+	}}
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, err = client.Get("http://example.com")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestSandboxExecInMultiExec(t *testing.T) {
+	// A sandbox that cannot be set up returns an error, so MultiExec falls back to the next executor.
+	broken, err := NewSandboxExec("/nonexistent", time.Second)
+	require.NoError(t, err)
+	fallback := newMockExec([]byte("output"), 0, nil)
+
+	exec, err := NewMultiExec([]Executor{broken, fallback}, "order")
+	require.NoError(t, err)
+
+	res, err := exec.Exec([]byte("#!/bin/sh\ntrue"), "", nil)
+	require.NoError(t, err)
+	require.Equal(t, ExecResult{Output: []byte("output"), Code: 0}, res)
+	require.Equal(t, 1, fallback.called)
+
+	// An executable that fails in the sandbox is a result, not an error of the executor.
+	e := newTestSandboxExec(t, "", 5*time.Second)
+	exec, err = NewMultiExec([]Executor{e, fallback}, "order")
+	require.NoError(t, err)
+
+	res, err = exec.Exec([]byte("#!/bin/sh\nexit 1"), "", nil)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), res.Code)
+	require.Equal(t, 1, fallback.called)
+}
+
+func TestNewExecutorSandbox(t *testing.T) {
+	newTestSandboxExec(t, "", 5*time.Second)
+
+	exec, err := NewExecutor(fmt.Sprintf("sandbox:%s?timeout=5s&memory=536870912", t.TempDir()))
+	require.NoError(t, err)
+	require.IsType(t, &SandboxExec{}, exec)
+	require.Equal(t, uint64(536870912), exec.(*SandboxExec).memory)
+}
@@ -7,11 +7,13 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/yoda/executor"
 )
 
 type processingResult struct {
@@ -35,7 +37,15 @@ func handleTransaction(c *Context, l *Logger, tx abci.TxResult) {
 		return
 	}
 
-	events := tx.Result.Events
+	handleRequestEvents(c, l, tx.Result.Events)
+}
+
+func handleBlockEvents(c *Context, l *Logger, block cmttypes.EventDataNewBlockEvents) {
+	l.Debug(":eyes: Inspecting incoming block events at height: %d", block.Height)
+	handleRequestEvents(c, l, block.Events)
+}
+
+func handleRequestEvents(c *Context, l *Logger, events []abci.Event) {
 	idStrs := GetEventValues(events, types.EventTypeRequest, types.AttributeKeyID)
 	for _, idStr := range idStrs {
 		id, err := strconv.Atoi(idStr)
@@ -78,7 +88,7 @@ func handleRequest(c *Context, l *Logger, id types.RequestID) {
 
 	// prepare raw requests
 	for _, raw := range req.RawRequests {
-		hash, err := GetDataSourceHash(c, l, raw.DataSourceID)
+		hash, err := GetDataSourceHash(c, l, raw.DataSourceID, raw.DataSourceVersion)
 		if err != nil {
 			l.Error(":skull: Failed to get data source hash with error: %s", c, err.Error())
 			return
@@ -93,7 +103,7 @@ func handleRequest(c *Context, l *Logger, id types.RequestID) {
 	}
 
 	// process raw requests
-	reports, execVersions := handleRawRequests(c, l, id, rawRequests, key)
+	reports, execVersions := handleRawRequests(c, l, id, req.RequestHeight, rawRequests, key)
 
 	c.pendingMsgs <- ReportMsgWithKey{
 		msg:         types.NewMsgReportData(id, reports, c.validator),
@@ -113,6 +123,7 @@ func handleRawRequests(
 	c *Context,
 	l *Logger,
 	id types.RequestID,
+	height int64,
 	reqs []rawRequest,
 	key *keyring.Record,
 ) (reports []types.RawReport, execVersions []string) {
@@ -124,6 +135,7 @@ func handleRawRequests(
 			req,
 			key,
 			id,
+			height,
 			resultsChan,
 		)
 	}
@@ -151,6 +163,7 @@ func handleRawRequest(
 	req rawRequest,
 	key *keyring.Record,
 	id types.RequestID,
+	height int64,
 	processingResultCh chan processingResult,
 ) {
 	c.updateHandlingGauge(1)
@@ -179,7 +192,7 @@ func handleRawRequest(
 		return
 	}
 
-	result, err := c.executor.Exec(exec, req.calldata, map[string]interface{}{
+	result, err := execute(c, l, req, height, exec, map[string]interface{}{
 		"BAND_CHAIN_ID":       vmsg.ChainID,
 		"BAND_DATA_SOURCE_ID": strconv.Itoa(int(vmsg.DataSourceID)),
 		"BAND_VALIDATOR":      vmsg.Validator,
@@ -207,3 +220,38 @@ func handleRawRequest(
 		}
 	}
 }
+
+// execute runs the data source executable of the given raw request of a request made at the given height.
+// If the cache is enabled, identical executions in the same block window share the result of one call to
+// the executor, so the executable sees the environment of whichever request ran it first. The cache key
+// deliberately leaves out the per-request environment (BAND_REQUEST_ID, BAND_EXTERNAL_ID, BAND_REPORTER and
+// BAND_SIGNATURE), as no two requests would share a result otherwise. The cache is therefore opt-in and must
+// not be enabled if a data source depends on that environment, e.g. to verify the request with its provider.
+func execute(
+	c *Context,
+	l *Logger,
+	req rawRequest,
+	height int64,
+	exec []byte,
+	env map[string]interface{},
+) (executor.ExecResult, error) {
+	if c.execCache == nil {
+		return c.executor.Exec(exec, req.calldata, env)
+	}
+
+	key := executor.CacheKey{
+		DataSourceHash: req.dataSourceHash,
+		Calldata:       req.calldata,
+		Window:         height / c.execCacheWindow,
+	}
+	result, shared, err := c.execCache.Exec(key, func() (executor.ExecResult, error) {
+		return c.executor.Exec(exec, req.calldata, env)
+	})
+	if shared {
+		l.Debug(":card_file_box: Reuse execution result of data source hash: %s", req.dataSourceHash)
+		c.updateCacheHitCount(1)
+	} else {
+		c.updateCacheMissCount(1)
+	}
+	return result, err
+}
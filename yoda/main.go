@@ -30,6 +30,8 @@ const (
 	flagRPCPollInterval  = "rpc-poll-interval"
 	flagMaxTry           = "max-try"
 	flagMaxReport        = "max-report"
+	flagExecCacheTTL     = "exec-cache-ttl"
+	flagExecCacheWindow  = "exec-cache-window"
 )
 
 // Config data structure for yoda daemon.
@@ -45,6 +47,8 @@ type Config struct {
 	MaxTry            uint64 `mapstructure:"max-try"`             // The maximum number of tries to submit a report transaction
 	MaxReport         uint64 `mapstructure:"max-report"`          // The maximum number of reports in one transaction
 	MetricsListenAddr string `mapstructure:"metrics-listen-addr"` // Address to listen on for prometheus metrics
+	ExecCacheTTL      string `mapstructure:"exec-cache-ttl"`      // The duration that execution results are cached, zero (default) to disable
+	ExecCacheWindow   uint64 `mapstructure:"exec-cache-window"`   // The number of blocks in which identical executions share results
 }
 
 // Global instances.
@@ -2,11 +2,14 @@ package yoda
 
 import (
 	"net/http"
+	"strconv"
 	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/bandprotocol/chain/v3/yoda/executor"
 )
 
 type yodaCollector struct {
@@ -15,6 +18,11 @@ type yodaCollector struct {
 	reportsPendingGaugeDesc   *prometheus.Desc
 	reportsErrorCountDesc     *prometheus.Desc
 	reportsSubmittedCountDesc *prometheus.Desc
+	execCacheHitCountDesc     *prometheus.Desc
+	execCacheMissCountDesc    *prometheus.Desc
+	executorStateDesc         *prometheus.Desc
+	executorLatencyDesc       *prometheus.Desc
+	executorErrorRateDesc     *prometheus.Desc
 }
 
 func NewYodaCollector(c *Context) prometheus.Collector {
@@ -36,6 +44,26 @@ func NewYodaCollector(c *Context) prometheus.Collector {
 			"yoda_reports_submitted_total",
 			"Number of reports submitted since last yoda restart",
 			nil, nil),
+		execCacheHitCountDesc: prometheus.NewDesc(
+			"yoda_exec_cache_hit_total",
+			"Number of executions served from the cache or a duplicate in-flight execution since last yoda restart",
+			nil, nil),
+		execCacheMissCountDesc: prometheus.NewDesc(
+			"yoda_exec_cache_miss_total",
+			"Number of executions that called the executor since last yoda restart",
+			nil, nil),
+		executorStateDesc: prometheus.NewDesc(
+			"yoda_executor_circuit_state",
+			"Circuit state of each executor of the health strategy (0: closed, 1: half-open, 2: open)",
+			[]string{"executor"}, nil),
+		executorLatencyDesc: prometheus.NewDesc(
+			"yoda_executor_latency_seconds",
+			"Moving average latency of each executor of the health strategy",
+			[]string{"executor"}, nil),
+		executorErrorRateDesc: prometheus.NewDesc(
+			"yoda_executor_error_rate",
+			"Moving average error rate of each executor of the health strategy",
+			[]string{"executor"}, nil),
 	}
 }
 
@@ -44,6 +72,11 @@ func (collector yodaCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- collector.reportsPendingGaugeDesc
 	ch <- collector.reportsErrorCountDesc
 	ch <- collector.reportsSubmittedCountDesc
+	ch <- collector.execCacheHitCountDesc
+	ch <- collector.execCacheMissCountDesc
+	ch <- collector.executorStateDesc
+	ch <- collector.executorLatencyDesc
+	ch <- collector.executorErrorRateDesc
 }
 
 func (collector yodaCollector) Collect(ch chan<- prometheus.Metric) {
@@ -55,6 +88,22 @@ func (collector yodaCollector) Collect(ch chan<- prometheus.Metric) {
 		float64(atomic.LoadInt64(&collector.context.errorCount)))
 	ch <- prometheus.MustNewConstMetric(collector.reportsSubmittedCountDesc, prometheus.CounterValue,
 		float64(atomic.LoadInt64(&collector.context.submittedCount)))
+	ch <- prometheus.MustNewConstMetric(collector.execCacheHitCountDesc, prometheus.CounterValue,
+		float64(atomic.LoadInt64(&collector.context.cacheHitCount)))
+	ch <- prometheus.MustNewConstMetric(collector.execCacheMissCountDesc, prometheus.CounterValue,
+		float64(atomic.LoadInt64(&collector.context.cacheMissCount)))
+
+	if multi, ok := collector.context.executor.(*executor.MultiExec); ok {
+		for i, health := range multi.Health() {
+			label := strconv.Itoa(i)
+			ch <- prometheus.MustNewConstMetric(collector.executorStateDesc, prometheus.GaugeValue,
+				float64(health.State), label)
+			ch <- prometheus.MustNewConstMetric(collector.executorLatencyDesc, prometheus.GaugeValue,
+				health.Latency.Seconds(), label)
+			ch <- prometheus.MustNewConstMetric(collector.executorErrorRateDesc, prometheus.GaugeValue,
+				health.ErrorRate, label)
+		}
+	}
 }
 
 func metricsListen(listenAddr string, c *Context) {
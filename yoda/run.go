@@ -24,6 +24,8 @@ import (
 
 const (
 	TxQuery = "tm.event = 'Tx' AND request.id EXISTS"
+	// BlockQuery matches the requests created outside of transactions, e.g. by request subscriptions
+	BlockQuery = "tm.event = 'NewBlockEvents' AND request.id EXISTS"
 	// EventChannelCapacity is a buffer size of channel between node and this program
 	EventChannelCapacity = 2000
 )
@@ -45,6 +47,12 @@ func runImpl(c *Context, l *Logger) error {
 		return err
 	}
 
+	l.Info(":ear: Subscribing to events with query: %s...", BlockQuery)
+	blockEventChan, err := c.client.Subscribe(ctx, "", BlockQuery, EventChannelCapacity)
+	if err != nil {
+		return err
+	}
+
 	if c.metricsEnabled {
 		l.Info(":eyes: Starting Prometheus listener")
 		go metricsListen(cfg.MetricsListenAddr, c)
@@ -77,6 +85,8 @@ func runImpl(c *Context, l *Logger) error {
 		select {
 		case ev := <-eventChan:
 			go handleTransaction(c, l, ev.Data.(cmttypes.EventDataTx).TxResult)
+		case ev := <-blockEventChan:
+			go handleBlockEvents(c, l, ev.Data.(cmttypes.EventDataNewBlockEvents))
 		case keyIndex := <-c.freeKeys:
 			if len(waitingMsgs[keyIndex]) != 0 {
 				if uint64(len(waitingMsgs[keyIndex])) > c.maxReport {
@@ -155,6 +165,22 @@ func runCmd(c *Context) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			// the execution cache is disabled if its TTL is not set, e.g. in a config file written before
+			// the cache was introduced
+			var execCacheTTL time.Duration
+			if cfg.ExecCacheTTL != "" {
+				execCacheTTL, err = time.ParseDuration(cfg.ExecCacheTTL)
+				if err != nil {
+					return err
+				}
+			}
+			if execCacheTTL > 0 {
+				if cfg.ExecCacheWindow == 0 {
+					return errors.New("exec cache window must be positive")
+				}
+				c.execCache = executor.NewCache(execCacheTTL)
+				c.execCacheWindow = int64(cfg.ExecCacheWindow)
+			}
 			c.pendingMsgs = make(chan ReportMsgWithKey)
 			c.freeKeys = make(chan int64, len(keys))
 			c.keyRoundRobinIndex = -1
@@ -173,6 +199,13 @@ func runCmd(c *Context) *cobra.Command {
 	cmd.Flags().String(flagRPCPollInterval, "1s", "The duration of rpc poll interval")
 	cmd.Flags().Uint64(flagMaxTry, 5, "The maximum number of tries to submit a report transaction")
 	cmd.Flags().Uint64(flagMaxReport, 10, "The maximum number of reports in one transaction")
+	cmd.Flags().String(
+		flagExecCacheTTL,
+		"0",
+		"The duration that execution results are cached, zero to disable. Only enable it if no data source "+
+			"depends on the per-request environment, e.g. BAND_REQUEST_ID or BAND_SIGNATURE",
+	)
+	cmd.Flags().Uint64(flagExecCacheWindow, 1, "The number of blocks in which identical executions share results")
 	_ = viper.BindPFlag(flags.FlagChainID, cmd.Flags().Lookup(flags.FlagChainID))
 	_ = viper.BindPFlag(flags.FlagNode, cmd.Flags().Lookup(flags.FlagNode))
 	_ = viper.BindPFlag(flagValidator, cmd.Flags().Lookup(flagValidator))
@@ -183,6 +216,8 @@ func runCmd(c *Context) *cobra.Command {
 	_ = viper.BindPFlag(flagRPCPollInterval, cmd.Flags().Lookup(flagRPCPollInterval))
 	_ = viper.BindPFlag(flagMaxTry, cmd.Flags().Lookup(flagMaxTry))
 	_ = viper.BindPFlag(flagMaxReport, cmd.Flags().Lookup(flagMaxReport))
+	_ = viper.BindPFlag(flagExecCacheTTL, cmd.Flags().Lookup(flagExecCacheTTL))
+	_ = viper.BindPFlag(flagExecCacheWindow, cmd.Flags().Lookup(flagExecCacheWindow))
 
 	return cmd
 }